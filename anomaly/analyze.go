@@ -0,0 +1,175 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// window represents the statistics for the
+// builds of a repo within a window of time.
+type window struct {
+	// durations of the successful builds in seconds
+	durations []float64
+	// number of builds with a conclusive status
+	total int
+	// number of builds that failed or errored
+	failures int
+}
+
+// add records the provided build in the window.
+func (w *window) add(b *library.Build) {
+	switch b.GetStatus() {
+	case constants.StatusSuccess:
+		w.total++
+
+		if b.GetStarted() > 0 && b.GetFinished() >= b.GetStarted() {
+			w.durations = append(w.durations, float64(b.GetFinished()-b.GetStarted()))
+		}
+	case constants.StatusFailure, constants.StatusError:
+		w.total++
+		w.failures++
+	}
+}
+
+// rate returns the failure rate for the window.
+func (w *window) rate() float64 {
+	if w.total == 0 {
+		return 0
+	}
+
+	return float64(w.failures) / float64(w.total)
+}
+
+// analyze compares the recent builds for each repo against the
+// builds in the baseline window and returns an anomaly for each
+// repo whose duration or failure rate deviates by at least the
+// configured threshold.
+func (c *config) analyze(builds []*library.Build, now time.Time) []*types.Anomaly {
+	recentStart := now.Add(-c.Recent).Unix()
+	baselineStart := now.Add(-c.Recent - c.Baseline).Unix()
+
+	baselines := make(map[int64]*window)
+	recents := make(map[int64]*window)
+
+	// partition the builds into windows by repo
+	for _, b := range builds {
+		var windows map[int64]*window
+
+		switch {
+		case b.GetCreated() >= recentStart:
+			windows = recents
+		case b.GetCreated() >= baselineStart:
+			windows = baselines
+		default:
+			continue
+		}
+
+		w, ok := windows[b.GetRepoID()]
+		if !ok {
+			w = new(window)
+			windows[b.GetRepoID()] = w
+		}
+
+		w.add(b)
+	}
+
+	anomalies := []*types.Anomaly{}
+
+	for id, recent := range recents {
+		baseline, ok := baselines[id]
+		if !ok {
+			continue
+		}
+
+		// check the build durations for the repo
+		if len(baseline.durations) >= c.MinSamples && len(recent.durations) >= c.MinSamples {
+			mean, stddev := stats(baseline.durations)
+			current, _ := stats(recent.durations)
+
+			// avoid dividing by zero for repos with perfectly consistent durations
+			if stddev == 0 {
+				stddev = math.Max(1, mean*0.1)
+			}
+
+			score := (current - mean) / stddev
+			if score >= c.Threshold {
+				anomalies = append(anomalies, anomaly(id, types.AnomalyDuration, mean, current, score, len(recent.durations), now))
+			}
+		}
+
+		// check the build failure rate for the repo
+		if baseline.total >= c.MinSamples && recent.total >= c.MinSamples {
+			// bound the baseline rate to avoid dividing by zero
+			expected := math.Min(0.99, math.Max(0.01, baseline.rate()))
+			current := recent.rate()
+
+			score := (current - expected) / math.Sqrt(expected*(1-expected)/float64(recent.total))
+			if score >= c.Threshold {
+				anomalies = append(anomalies, anomaly(id, types.AnomalyFailureRate, baseline.rate(), current, score, recent.total, now))
+			}
+		}
+	}
+
+	// sort the anomalies to provide consistent results
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		if anomalies[i].GetRepoID() == anomalies[j].GetRepoID() {
+			return anomalies[i].GetKind() < anomalies[j].GetKind()
+		}
+
+		return anomalies[i].GetRepoID() < anomalies[j].GetRepoID()
+	})
+
+	return anomalies
+}
+
+// anomaly is a helper function to create an anomaly with the provided values.
+func anomaly(repo int64, kind string, baseline, recent, score float64, samples int, now time.Time) *types.Anomaly {
+	a := new(types.Anomaly)
+
+	a.SetRepoID(repo)
+	a.SetKind(kind)
+	a.SetBaseline(round(baseline))
+	a.SetRecent(round(recent))
+	a.SetScore(round(score))
+	a.SetSamples(int64(samples))
+	a.SetDetected(now.Unix())
+
+	return a
+}
+
+// stats is a helper function to calculate the mean
+// and standard deviation for the provided values.
+func stats(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+
+	mean := sum / float64(len(values))
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
+
+// round is a helper function to round the
+// provided value to two decimal places.
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
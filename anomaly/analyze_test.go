@@ -0,0 +1,142 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestAnomaly_config_analyze(t *testing.T) {
+	// setup types
+	now := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	c := &config{
+		Baseline:   14 * 24 * time.Hour,
+		Recent:     24 * time.Hour,
+		Threshold:  3,
+		MinSamples: 5,
+	}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		builds []*library.Build
+		want   []*types.Anomaly
+	}{
+		{
+			name:   "no builds",
+			builds: []*library.Build{},
+			want:   []*types.Anomaly{},
+		},
+		{
+			name: "consistent builds",
+			builds: append(
+				testBuilds(1, now.Add(-48*time.Hour), 10, constants.StatusSuccess, 60),
+				testBuilds(1, now.Add(-time.Hour), 5, constants.StatusSuccess, 62)...,
+			),
+			want: []*types.Anomaly{},
+		},
+		{
+			name: "slower builds",
+			builds: append(
+				testBuilds(1, now.Add(-48*time.Hour), 10, constants.StatusSuccess, 60),
+				testBuilds(1, now.Add(-time.Hour), 5, constants.StatusSuccess, 120)...,
+			),
+			want: []*types.Anomaly{
+				testAnomaly(1, types.AnomalyDuration, 60, 120, 10, 5, now),
+			},
+		},
+		{
+			name: "faster builds",
+			builds: append(
+				testBuilds(1, now.Add(-48*time.Hour), 10, constants.StatusSuccess, 120),
+				testBuilds(1, now.Add(-time.Hour), 5, constants.StatusSuccess, 60)...,
+			),
+			want: []*types.Anomaly{},
+		},
+		{
+			name: "failing builds",
+			builds: append(
+				append(
+					testBuilds(2, now.Add(-48*time.Hour), 20, constants.StatusSuccess, 60),
+					testBuilds(2, now.Add(-time.Hour), 1, constants.StatusSuccess, 60)...,
+				),
+				testBuilds(2, now.Add(-time.Hour), 5, constants.StatusFailure, 60)...,
+			),
+			want: []*types.Anomaly{
+				testAnomaly(2, types.AnomalyFailureRate, 0, 0.83, 20.27, 6, now),
+			},
+		},
+		{
+			name: "not enough samples",
+			builds: append(
+				testBuilds(3, now.Add(-48*time.Hour), 4, constants.StatusSuccess, 60),
+				testBuilds(3, now.Add(-time.Hour), 4, constants.StatusFailure, 600)...,
+			),
+			want: []*types.Anomaly{},
+		},
+		{
+			name: "builds outside windows",
+			builds: append(
+				testBuilds(4, now.Add(-30*24*time.Hour), 10, constants.StatusSuccess, 60),
+				testBuilds(4, now.Add(-time.Hour), 10, constants.StatusSuccess, 600)...,
+			),
+			want: []*types.Anomaly{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := c.analyze(test.builds, now)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("analyze is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// testBuilds is a test helper function to create a list
+// of builds for a repo with the provided status and duration.
+func testBuilds(repo int64, created time.Time, count int, status string, duration int64) []*library.Build {
+	builds := []*library.Build{}
+
+	for i := 0; i < count; i++ {
+		b := new(library.Build)
+
+		b.SetRepoID(repo)
+		b.SetStatus(status)
+		b.SetCreated(created.Unix() + int64(i))
+		b.SetStarted(created.Unix() + int64(i))
+		b.SetFinished(created.Unix() + int64(i) + duration)
+
+		builds = append(builds, b)
+	}
+
+	return builds
+}
+
+// testAnomaly is a test helper function to create
+// an anomaly with the provided values.
+func testAnomaly(repo int64, kind string, baseline, recent, score float64, samples int64, now time.Time) *types.Anomaly {
+	a := new(types.Anomaly)
+
+	a.SetRepoID(repo)
+	a.SetKind(kind)
+	a.SetBaseline(baseline)
+	a.SetRecent(recent)
+	a.SetScore(score)
+	a.SetSamples(samples)
+	a.SetDetected(now.Unix())
+
+	return a
+}
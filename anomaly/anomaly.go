@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the detector.
+	config struct {
+		// specifies the interval at which to check for anomalies
+		Interval time.Duration
+		// specifies the window of builds used to establish the baseline
+		Baseline time.Duration
+		// specifies the window of recent builds compared against the baseline
+		Recent time.Duration
+		// specifies the number of standard deviations required to flag an anomaly
+		Threshold float64
+		// specifies the minimum number of builds required in each window
+		MinSamples int
+		// specifies the url to send newly detected anomalies to
+		Webhook string
+	}
+
+	// Detector represents the functionality for
+	// detecting build anomalies across repos.
+	Detector struct {
+		// detector configuration settings
+		config *config
+
+		// database service used to capture builds and repos
+		database database.Service
+
+		// http client used to send notifications
+		client *http.Client
+
+		// mutex protecting the detected anomalies
+		mu sync.RWMutex
+
+		// anomalies detected by the most recent check
+		anomalies []*types.Anomaly
+	}
+)
+
+// New creates and returns a detector for build anomalies.
+func New(opts ...Opt) (*Detector, error) {
+	// create new detector
+	d := new(Detector)
+
+	// create new fields
+	d.client = &http.Client{Timeout: 10 * time.Second}
+	d.config = &config{
+		Baseline:   14 * 24 * time.Hour,
+		Recent:     24 * time.Hour,
+		Threshold:  3,
+		MinSamples: 5,
+	}
+	d.anomalies = []*types.Anomaly{}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// Enabled returns whether the detector is
+// configured to periodically check for anomalies.
+func (d *Detector) Enabled() bool {
+	return d.config.Interval > 0
+}
+
+// Anomalies returns the anomalies detected
+// by the most recent check for anomalies.
+func (d *Detector) Anomalies() []*types.Anomaly {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.anomalies
+}
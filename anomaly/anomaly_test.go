@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnomaly_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		enabled bool
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+		},
+		{
+			name:    "with interval",
+			failure: false,
+			opts: []Opt{
+				WithInterval(time.Hour),
+				WithWindows(7*24*time.Hour, 12*time.Hour),
+				WithThreshold(2.5),
+				WithMinSamples(10),
+				WithWebhook("https://hooks.example.com/anomalies"),
+			},
+			enabled: true,
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Hour)},
+		},
+		{
+			name:    "invalid windows",
+			failure: true,
+			opts:    []Opt{WithWindows(0, time.Hour)},
+		},
+		{
+			name:    "invalid threshold",
+			failure: true,
+			opts:    []Opt{WithThreshold(0)},
+		},
+		{
+			name:    "invalid min samples",
+			failure: true,
+			opts:    []Opt{WithMinSamples(0)},
+		},
+		{
+			name:    "invalid webhook",
+			failure: true,
+			opts:    []Opt{WithWebhook("!@#$%^&*()")},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+
+			if len(got.Anomalies()) != 0 {
+				t.Errorf("Anomalies is %v, want empty", got.Anomalies())
+			}
+		})
+	}
+}
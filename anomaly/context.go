@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the anomaly Detector in the context.
+const key = "anomaly"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the anomaly Detector
+// associated with this context.
+func FromContext(c context.Context) *Detector {
+	// get anomaly value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast anomaly value to expected Detector type
+	s, ok := v.(*Detector)
+	if !ok {
+		return nil
+	}
+
+	return s
+}
+
+// ToContext adds the anomaly Detector to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, d *Detector) {
+	c.Set(key, d)
+}
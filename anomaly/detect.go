@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/sirupsen/logrus"
+)
+
+// Start checks for anomalies at the configured
+// interval until the provided channel is closed.
+func (d *Detector) Start(dying <-chan struct{}) error {
+	logrus.Infof("checking for build anomalies every %s", d.config.Interval)
+
+	ticker := time.NewTicker(d.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, err := d.Detect(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to detect build anomalies: %v", err)
+			}
+		}
+	}
+}
+
+// Detect checks the builds within the configured windows
+// for anomalies, stores the result and sends a notification
+// for any anomalies that were not previously detected.
+func (d *Detector) Detect(now time.Time) ([]*types.Anomaly, error) {
+	logrus.Trace("checking for build anomalies")
+
+	// send API call to capture the builds within the windows
+	builds, err := d.database.GetFinishedBuildListSince(context.Background(), now.Add(-d.config.Recent-d.config.Baseline).Unix())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get builds: %w", err)
+	}
+
+	anomalies := d.config.analyze(builds, now)
+
+	for _, a := range anomalies {
+		// send API call to capture the repo for the anomaly
+		r, err := d.database.GetRepo(context.Background(), a.GetRepoID())
+		if err != nil {
+			logrus.Errorf("unable to get repo %d for anomaly: %v", a.GetRepoID(), err)
+
+			continue
+		}
+
+		a.SetRepo(r.GetFullName())
+	}
+
+	d.mu.Lock()
+	previous := d.anomalies
+	d.anomalies = anomalies
+	d.mu.Unlock()
+
+	// capture the anomalies that were not previously detected
+	detected := make(map[string]bool)
+	for _, a := range previous {
+		detected[fmt.Sprintf("%d/%s", a.GetRepoID(), a.GetKind())] = true
+	}
+
+	added := []*types.Anomaly{}
+
+	for _, a := range anomalies {
+		if !detected[fmt.Sprintf("%d/%s", a.GetRepoID(), a.GetKind())] {
+			added = append(added, a)
+		}
+	}
+
+	if len(added) > 0 {
+		logrus.Warnf("detected %d new build anomalies", len(added))
+
+		err = d.notify(added)
+		if err != nil {
+			logrus.Errorf("unable to send notification for build anomalies: %v", err)
+		}
+	}
+
+	return anomalies, nil
+}
+
+// notify sends the provided anomalies to the configured webhook.
+func (d *Detector) notify(anomalies []*types.Anomaly) error {
+	// skip the notification if no webhook is configured
+	if len(d.config.Webhook) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(anomalies)
+	if err != nil {
+		return err
+	}
+
+	resp, err := d.client.Post(d.config.Webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook %s responded with status %d", d.config.Webhook, resp.StatusCode)
+	}
+
+	return nil
+}
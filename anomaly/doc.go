@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package anomaly provides the ability for Vela to detect
+// repos whose build durations or failure rates deviate
+// sharply from their baseline.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/anomaly"
+package anomaly
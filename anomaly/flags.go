@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for anomaly detection.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Anomaly Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_ANOMALY_INTERVAL", "ANOMALY_INTERVAL"},
+		FilePath: "/vela/anomaly/interval",
+		Name:     "anomaly.interval",
+		Usage:    "interval at which to check for build anomalies (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_ANOMALY_BASELINE", "ANOMALY_BASELINE"},
+		FilePath: "/vela/anomaly/baseline",
+		Name:     "anomaly.baseline",
+		Usage:    "window of builds, before the recent window, used to establish the baseline",
+		Value:    14 * 24 * time.Hour,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_ANOMALY_RECENT", "ANOMALY_RECENT"},
+		FilePath: "/vela/anomaly/recent",
+		Name:     "anomaly.recent",
+		Usage:    "window of recent builds compared against the baseline",
+		Value:    24 * time.Hour,
+	},
+	&cli.Float64Flag{
+		EnvVars:  []string{"VELA_ANOMALY_THRESHOLD", "ANOMALY_THRESHOLD"},
+		FilePath: "/vela/anomaly/threshold",
+		Name:     "anomaly.threshold",
+		Usage:    "number of standard deviations from the baseline required to flag an anomaly",
+		Value:    3,
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_ANOMALY_MIN_SAMPLES", "ANOMALY_MIN_SAMPLES"},
+		FilePath: "/vela/anomaly/min_samples",
+		Name:     "anomaly.min-samples",
+		Usage:    "minimum number of builds required in both windows to evaluate a repo",
+		Value:    5,
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_ANOMALY_WEBHOOK", "ANOMALY_WEBHOOK"},
+		FilePath: "/vela/anomaly/webhook",
+		Name:     "anomaly.webhook",
+		Usage:    "optional url to send newly detected anomalies to as a JSON payload",
+	},
+}
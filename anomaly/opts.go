@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package anomaly
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the detector.
+type Opt func(*Detector) error
+
+// WithDatabase sets the database service in the detector.
+func WithDatabase(db database.Service) Opt {
+	return func(d *Detector) error {
+		// set the database service in the detector
+		d.database = db
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to check for anomalies in the detector.
+func WithInterval(interval time.Duration) Opt {
+	return func(d *Detector) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid anomaly interval provided: %s", interval)
+		}
+
+		// set the interval in the detector
+		d.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithWindows sets the baseline and recent windows in the detector.
+func WithWindows(baseline, recent time.Duration) Opt {
+	return func(d *Detector) error {
+		// check if the windows provided are positive
+		if baseline <= 0 || recent <= 0 {
+			return fmt.Errorf("invalid anomaly windows provided: baseline %s recent %s", baseline, recent)
+		}
+
+		// set the windows in the detector
+		d.config.Baseline = baseline
+		d.config.Recent = recent
+
+		return nil
+	}
+}
+
+// WithThreshold sets the threshold to flag an anomaly in the detector.
+func WithThreshold(threshold float64) Opt {
+	return func(d *Detector) error {
+		// check if the threshold provided is positive
+		if threshold <= 0 {
+			return fmt.Errorf("invalid anomaly threshold provided: %v", threshold)
+		}
+
+		// set the threshold in the detector
+		d.config.Threshold = threshold
+
+		return nil
+	}
+}
+
+// WithMinSamples sets the minimum number of builds per window in the detector.
+func WithMinSamples(samples int) Opt {
+	return func(d *Detector) error {
+		// check if the samples provided is positive
+		if samples <= 0 {
+			return fmt.Errorf("invalid anomaly min samples provided: %d", samples)
+		}
+
+		// set the min samples in the detector
+		d.config.MinSamples = samples
+
+		return nil
+	}
+}
+
+// WithWebhook sets the url to send newly detected anomalies to in the detector.
+func WithWebhook(webhook string) Opt {
+	return func(d *Detector) error {
+		// skip validation if no webhook was provided
+		if len(webhook) == 0 {
+			return nil
+		}
+
+		// check if the webhook provided is a valid url
+		_, err := url.ParseRequestURI(webhook)
+		if err != nil {
+			return fmt.Errorf("invalid anomaly webhook provided: %w", err)
+		}
+
+		// set the webhook in the detector
+		d.config.Webhook = webhook
+
+		return nil
+	}
+}
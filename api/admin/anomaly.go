@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-vela/server/anomaly"
+	"github.com/go-vela/server/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/anomalies admin AllAnomalies
+//
+// Get the build anomalies detected by the most recent check
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the detected build anomalies
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Anomaly"
+
+// AllAnomalies represents the API handler to capture
+// the build anomalies detected by the most recent check.
+func AllAnomalies(c *gin.Context) {
+	logrus.Info("Admin: reading build anomalies")
+
+	c.JSON(http.StatusOK, anomaly.FromContext(c).Anomalies())
+}
+
+// swagger:operation POST /api/v1/admin/anomalies admin DetectAnomalies
+//
+// Check the builds in the database for anomalies
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully checked the builds for anomalies
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Anomaly"
+//   '500':
+//     description: Unable to check the builds for anomalies
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DetectAnomalies represents the API handler to
+// check the builds in the database for anomalies.
+func DetectAnomalies(c *gin.Context) {
+	logrus.Info("Admin: checking for build anomalies")
+
+	// send API call to check for build anomalies
+	a, err := anomaly.FromContext(c).Detect(time.Now().UTC())
+	if err != nil {
+		retErr := fmt.Errorf("unable to check for build anomalies: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, a)
+}
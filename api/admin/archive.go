@@ -0,0 +1,534 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/retention"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/archived/repos admin AllArchivedRepos
+//
+// Get the repos archived when they were deleted
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 100
+//   default: 10
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the archived repos
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Repo"
+//     headers:
+//       X-Total-Count:
+//         description: Total number of results
+//         type: integer
+//       Link:
+//         description: see https://tools.ietf.org/html/rfc5988
+//         type: string
+//   '400':
+//     description: Unable to retrieve the archived repos
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the archived repos
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllArchivedRepos represents the API handler to capture
+// the repos archived when they were deleted.
+func AllArchivedRepos(c *gin.Context) {
+	logrus.Info("Admin: reading archived repos")
+
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter for archived repos: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter for archived repos: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure per_page isn't above or below allowed values
+	perPage = util.MaxInt(1, util.MinInt(100, perPage))
+
+	// send API call to capture the list of archived repos
+	r, t, err := database.FromContext(c).ListArchivedRepos(c, page, perPage)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get archived repos: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// create pagination object
+	pagination := api.Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   t,
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	c.JSON(http.StatusOK, r)
+}
+
+// swagger:operation POST /api/v1/admin/archived/repos/{org}/{repo}/restore admin RestoreArchivedRepo
+//
+// Restore an archived repo along with its builds
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully restored the archived repo
+//     schema:
+//       "$ref": "#/definitions/Repo"
+//   '404':
+//     description: Unable to restore the archived repo
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to restore the archived repo
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RestoreArchivedRepo represents the API handler to restore an
+// archived repo along with its builds. The repo stays inactive
+// until it is enabled again so the webhook is recreated.
+func RestoreArchivedRepo(c *gin.Context) {
+	r, ok := archivedRepo(c)
+	if !ok {
+		return
+	}
+
+	logrus.Infof("Admin: restoring archived repo %s", r.GetFullName())
+
+	// send API call to restore the repo
+	err := database.FromContext(c).RestoreRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to restore repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to restore the builds for the repo
+	_, err = database.FromContext(c).RestoreRepoBuilds(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to restore builds for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the restored repo
+	restored, err := database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get restored repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, restored)
+}
+
+// swagger:operation DELETE /api/v1/admin/archived/repos/{org}/{repo} admin PurgeArchivedRepo
+//
+// Permanently delete an archived repo along with its builds
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully purged the archived repo
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to purge the archived repo
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to purge the archived repo
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// PurgeArchivedRepo represents the API handler to permanently
+// delete an archived repo along with its builds and the logs,
+// steps, services and artifacts for the builds.
+func PurgeArchivedRepo(c *gin.Context) {
+	r, ok := archivedRepo(c)
+	if !ok {
+		return
+	}
+
+	logrus.Infof("Admin: purging archived repo %s", r.GetFullName())
+
+	// send API call to remove the repo along with its builds
+	builds, err := retention.PurgeRepo(c, database.FromContext(c), r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to purge archived repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("archived repo %s purged along with %d builds", r.GetFullName(), builds))
+}
+
+// archivedRepo is a helper function to capture the archived repo
+// from the path parameters of the request. It handles the error
+// and returns false when the archived repo doesn't exist.
+func archivedRepo(c *gin.Context) (*library.Repo, bool) {
+	org := util.PathParameter(c, "org")
+	name := util.PathParameter(c, "repo")
+
+	// send API call to capture the archived repo
+	r, err := database.FromContext(c).GetArchivedRepoForOrg(c, org, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get archived repo %s/%s: %w", org, name, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil, false
+	}
+
+	return r, true
+}
+
+// swagger:operation GET /api/v1/admin/archived/secrets admin AllArchivedSecrets
+//
+// Get the native secrets archived when they were deleted
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 100
+//   default: 10
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the archived secrets
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Secret"
+//     headers:
+//       X-Total-Count:
+//         description: Total number of results
+//         type: integer
+//       Link:
+//         description: see https://tools.ietf.org/html/rfc5988
+//         type: string
+//   '400':
+//     description: Unable to retrieve the archived secrets
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the archived secrets
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllArchivedSecrets represents the API handler to capture the
+// native secrets archived when they were deleted. The values
+// of the archived secrets are never returned.
+func AllArchivedSecrets(c *gin.Context) {
+	logrus.Info("Admin: reading archived secrets")
+
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter for archived secrets: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter for archived secrets: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure per_page isn't above or below allowed values
+	perPage = util.MaxInt(1, util.MinInt(100, perPage))
+
+	// send API call to capture the list of archived secrets
+	s, t, err := database.FromContext(c).ListArchivedSecrets(c, page, perPage)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get archived secrets: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// create pagination object
+	pagination := api.Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   t,
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	c.JSON(http.StatusOK, s)
+}
+
+// swagger:operation POST /api/v1/admin/archived/secrets/{type}/{org}/{name}/{secret}/restore admin RestoreArchivedSecret
+//
+// Restore an archived native secret
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: type
+//   description: Secret type (e.g. org, repo, or shared)
+//   required: true
+//   type: string
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: name
+//   description: Name of the repo if a repo secret, team name if a shared secret, or '*' if an org secret
+//   required: true
+//   type: string
+// - in: path
+//   name: secret
+//   description: Name of the secret
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully restored the archived secret
+//     schema:
+//       "$ref": "#/definitions/Secret"
+//   '404':
+//     description: Unable to restore the archived secret
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to restore the archived secret
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RestoreArchivedSecret represents the API handler
+// to restore an archived native secret.
+func RestoreArchivedSecret(c *gin.Context) {
+	s, entry, ok := archivedSecret(c)
+	if !ok {
+		return
+	}
+
+	logrus.Infof("Admin: restoring archived %s secret %s", s.GetType(), entry)
+
+	// send API call to restore the secret
+	err := database.FromContext(c).RestoreSecret(c, s.GetID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to restore %s secret %s: %w", s.GetType(), entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the restored secret
+	restored, err := database.FromContext(c).GetSecret(c, s.GetType(), s.GetOrg(), util.PathParameter(c, "name"), s.GetName())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get restored %s secret %s: %w", s.GetType(), entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, restored.Sanitize())
+}
+
+// swagger:operation DELETE /api/v1/admin/archived/secrets/{type}/{org}/{name}/{secret} admin PurgeArchivedSecret
+//
+// Permanently delete an archived native secret
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: type
+//   description: Secret type (e.g. org, repo, or shared)
+//   required: true
+//   type: string
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: name
+//   description: Name of the repo if a repo secret, team name if a shared secret, or '*' if an org secret
+//   required: true
+//   type: string
+// - in: path
+//   name: secret
+//   description: Name of the secret
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully purged the archived secret
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to purge the archived secret
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to purge the archived secret
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// PurgeArchivedSecret represents the API handler
+// to permanently delete an archived native secret.
+func PurgeArchivedSecret(c *gin.Context) {
+	s, entry, ok := archivedSecret(c)
+	if !ok {
+		return
+	}
+
+	logrus.Infof("Admin: purging archived %s secret %s", s.GetType(), entry)
+
+	// send API call to remove the secret
+	err := database.FromContext(c).DeleteSecret(c, s.GetID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete %s secret %s: %w", s.GetType(), entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("archived %s secret %s purged", s.GetType(), entry))
+}
+
+// archivedSecret is a helper function to capture the archived secret
+// from the path parameters of the request along with the entry used
+// to identify it. It handles the error and returns false when the
+// archived secret doesn't exist.
+func archivedSecret(c *gin.Context) (*library.Secret, string, bool) {
+	t := util.PathParameter(c, "type")
+	o := util.PathParameter(c, "org")
+	n := util.PathParameter(c, "name")
+	s := util.PathParameter(c, "secret")
+
+	entry := fmt.Sprintf("%s/%s/%s", o, n, s)
+
+	// send API call to capture the archived secret
+	secret, err := database.FromContext(c).GetArchivedSecret(c, t, o, n, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get archived %s secret %s: %w", t, entry, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil, entry, false
+	}
+
+	return secret, entry, true
+}
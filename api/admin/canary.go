@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/canary"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/canaries admin AllCanaryRuns
+//
+// Get the results of the canary builds run through each route
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 100
+//   default: 10
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the canary runs
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/CanaryRun"
+//     headers:
+//       X-Total-Count:
+//         description: Total number of results
+//         type: integer
+//       Link:
+//         description: see https://tools.ietf.org/html/rfc5988
+//         type: string
+//   '400':
+//     description: Unable to retrieve the canary runs
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the canary runs
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllCanaryRuns represents the API handler to capture the
+// results of the canary builds run through each route.
+func AllCanaryRuns(c *gin.Context) {
+	logrus.Info("Admin: reading canary runs")
+
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter for canary runs: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter for canary runs: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure per_page isn't above or below allowed values
+	perPage = util.MaxInt(1, util.MinInt(100, perPage))
+
+	// send API call to capture the list of canary runs
+	r, t, err := database.FromContext(c).ListCanaryRuns(c, page, perPage)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get canary runs: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// create pagination object
+	pagination := api.Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   t,
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	c.JSON(http.StatusOK, r)
+}
+
+// swagger:operation POST /api/v1/admin/canaries admin RunCanaries
+//
+// Run a canary build through each route
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully launched the canary builds
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/CanaryRun"
+//   '400':
+//     description: Canary builds are not configured for the server
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to launch the canary builds
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RunCanaries represents the API handler to
+// run a canary build through each route.
+func RunCanaries(c *gin.Context) {
+	logrus.Info("Admin: running canary builds")
+
+	s := canary.FromContext(c)
+
+	// check if the server is configured to run canary builds
+	if s == nil || !s.Enabled() {
+		retErr := fmt.Errorf("unable to run canary builds: canary builds are not configured")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to run the canary builds
+	r, err := s.Run(time.Now().UTC())
+	if err != nil {
+		retErr := fmt.Errorf("unable to run canary builds: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
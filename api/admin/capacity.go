@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-vela/server/capacity"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/capacity admin AllCapacitySnapshots
+//
+// Get the snapshots of the worker capacity recorded within a time range
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: after
+//   description: Unix timestamp to limit snapshots returned (defaults to 24 hours ago)
+//   required: false
+//   type: integer
+// - in: query
+//   name: before
+//   description: Unix timestamp to limit snapshots returned (defaults to now)
+//   required: false
+//   type: integer
+// - in: query
+//   name: interval
+//   description: Duration (i.e. 1h) to average the snapshots over
+//   required: false
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the worker capacity snapshots
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/CapacitySnapshot"
+//   '400':
+//     description: Unable to retrieve the worker capacity snapshots
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the worker capacity snapshots
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllCapacitySnapshots represents the API handler to capture
+// the snapshots of the worker capacity within a time range.
+func AllCapacitySnapshots(c *gin.Context) {
+	logrus.Info("Admin: reading worker capacity snapshots")
+
+	now := time.Now().UTC()
+
+	// capture after query parameter, defaulting to 24 hours ago
+	after, err := strconv.ParseInt(c.DefaultQuery("after", strconv.FormatInt(now.Add(-24*time.Hour).Unix(), 10)), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert after query parameter for capacity snapshots: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture before query parameter, defaulting to now
+	before, err := strconv.ParseInt(c.DefaultQuery("before", strconv.FormatInt(now.Unix(), 10)), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert before query parameter for capacity snapshots: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture interval query parameter if present
+	interval, err := time.ParseDuration(c.DefaultQuery("interval", "0s"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert interval query parameter for capacity snapshots: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the capacity snapshots
+	s, err := database.FromContext(c).ListCapacitySnapshots(c, after, before)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get capacity snapshots: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, capacity.Downsample(s, interval))
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/catalog"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/admin/catalog admin PushCatalog
+//
+// Push every active repo as a component to the service catalog
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully pushed the components
+//     schema:
+//       type: string
+//   '400':
+//     description: The service catalog is not configured for the server
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to push the components
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// PushCatalog represents the API handler to push every
+// active repo as a component to the service catalog.
+func PushCatalog(c *gin.Context) {
+	logrus.Info("Admin: pushing service catalog components")
+
+	ca := catalog.FromContext(c)
+
+	// check if the server is configured to push to a service catalog
+	if ca == nil || !ca.Enabled() {
+		retErr := fmt.Errorf("unable to push service catalog components: service catalog is not configured")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to push the components
+	count, err := ca.Push()
+	if err != nil {
+		retErr := fmt.Errorf("unable to push service catalog components: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("%d components pushed to the service catalog", count))
+}
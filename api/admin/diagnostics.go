@@ -0,0 +1,216 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/diagnostics"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/diagnostics/pprof/{profile} admin GetDiagnosticsProfile
+//
+// Capture a runtime profile for the server
+//
+// ---
+// produces:
+// - application/octet-stream
+// - text/plain
+// parameters:
+// - in: path
+//   name: profile
+//   description: >-
+//     Name of the profile (allocs, block, goroutine, heap,
+//     mutex, profile, threadcreate or trace)
+//   required: true
+//   type: string
+// - in: query
+//   name: seconds
+//   description: Seconds to capture the cpu profile or trace for (capped at the max duration)
+//   required: false
+//   type: integer
+//   default: 10
+// - in: query
+//   name: debug
+//   description: Format of the point in time profiles, 0 for pprof or greater for text
+//   required: false
+//   type: integer
+//   default: 0
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully captured the profile
+//     schema:
+//       type: file
+//   '400':
+//     description: Unable to capture the profile
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Another profile or diagnostics bundle is being captured
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to capture the profile
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetDiagnosticsProfile represents the API handler to capture a
+// runtime profile for the server. Only one profile or diagnostics
+// bundle is captured at a time to limit the overhead on the server.
+func GetDiagnosticsProfile(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	profile := util.PathParameter(c, "profile")
+
+	logrus.Infof("Admin: user %s capturing %s profile", u.GetName(), profile)
+
+	d := diagnostics.FromContext(c)
+
+	// check if the server is configured to capture diagnostics
+	if d == nil || !d.Enabled() {
+		retErr := fmt.Errorf("unable to capture %s profile: diagnostics are not enabled", profile)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture seconds query parameter
+	seconds, err := strconv.Atoi(c.DefaultQuery("seconds", "10"))
+	if err != nil || seconds <= 0 {
+		retErr := fmt.Errorf("unable to capture %s profile: seconds query parameter must be a positive integer", profile)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture debug query parameter
+	debug, err := strconv.Atoi(c.DefaultQuery("debug", "0"))
+	if err != nil || debug < 0 {
+		retErr := fmt.Errorf("unable to capture %s profile: debug query parameter must not be negative", profile)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture the profile before writing the response
+	// so a failure can still be returned as an error
+	buffer := new(bytes.Buffer)
+
+	err = d.Profile(c, buffer, profile, time.Duration(seconds)*time.Second, debug)
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture %s profile: %w", profile, err)
+
+		switch {
+		case errors.Is(err, diagnostics.ErrUnknownProfile):
+			util.HandleError(c, http.StatusBadRequest, retErr)
+		case errors.Is(err, diagnostics.ErrRunning):
+			util.HandleError(c, http.StatusConflict, retErr)
+		default:
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+		}
+
+		return
+	}
+
+	contentType := "application/octet-stream"
+	filename := profile + ".pb.gz"
+
+	switch {
+	case profile == diagnostics.ProfileTrace:
+		filename = profile + ".out"
+	case debug > 0 && !diagnostics.Timed(profile):
+		contentType = "text/plain; charset=utf-8"
+		filename = profile + ".txt"
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, contentType, buffer.Bytes())
+}
+
+// swagger:operation GET /api/v1/admin/diagnostics/bundle admin GetDiagnosticsBundle
+//
+// Download the diagnostics bundle for the server
+//
+// ---
+// produces:
+// - application/zip
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: >-
+//       Successfully captured the diagnostics bundle, a zip archive with the
+//       configuration without secrets, the recent errors, the health of the
+//       dependencies, the version, runtime statistics and goroutine and heap profiles
+//     schema:
+//       type: file
+//   '400':
+//     description: Diagnostics are not enabled for the server
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Another profile or diagnostics bundle is being captured
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to capture the diagnostics bundle
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetDiagnosticsBundle represents the API handler to
+// download the diagnostics bundle for the server.
+func GetDiagnosticsBundle(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	logrus.Infof("Admin: user %s capturing diagnostics bundle", u.GetName())
+
+	d := diagnostics.FromContext(c)
+
+	// check if the server is configured to capture diagnostics
+	if d == nil || !d.Enabled() {
+		retErr := fmt.Errorf("unable to capture diagnostics bundle: diagnostics are not enabled")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	buffer := new(bytes.Buffer)
+
+	err := d.Bundle(c, buffer)
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture diagnostics bundle: %w", err)
+
+		if errors.Is(err, diagnostics.ErrRunning) {
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	filename := fmt.Sprintf("vela-diagnostics-%d.zip", time.Now().UTC().Unix())
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/zip", buffer.Bytes())
+}
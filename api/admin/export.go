@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/export"
+	"github.com/go-vela/server/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/exports admin AllExports
+//
+// Get the manifest of the build results exported to object storage
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: dataset
+//   description: Dataset (builds or steps) to limit exports returned
+//   required: false
+//   type: string
+// - in: query
+//   name: after
+//   description: Unix timestamp to limit exports returned to those created after it
+//   required: false
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the exports
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Export"
+//   '400':
+//     description: Unable to retrieve the exports
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the exports
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllExports represents the API handler to capture the manifest
+// of the build results exported to object storage.
+func AllExports(c *gin.Context) {
+	logrus.Info("Admin: reading build result exports")
+
+	dataset := c.Query("dataset")
+
+	// capture after query parameter, defaulting to the first export
+	after, err := strconv.ParseInt(c.DefaultQuery("after", "0"), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert after query parameter for exports: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the exports
+	x, err := database.FromContext(c).ListExports(c, dataset, after)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get exports: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, x)
+}
+
+// swagger:operation POST /api/v1/admin/exports admin RunExport
+//
+// Export the build results finished since the last export to object storage
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully exported the build results
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Export"
+//   '400':
+//     description: Exports are not configured for the server
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to export the build results
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RunExport represents the API handler to export the build
+// results finished since the last export to object storage.
+func RunExport(c *gin.Context) {
+	logrus.Info("Admin: exporting build results")
+
+	e := export.FromContext(c)
+
+	// check if the server is configured to export build results
+	if e == nil || !e.Enabled() {
+		retErr := fmt.Errorf("unable to export build results: exports are not configured")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to export the build results
+	x, err := e.Export(time.Now().UTC())
+	if err != nil {
+		retErr := fmt.Errorf("unable to export build results: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, x)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/server/fault"
+	"github.com/go-vela/server/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/faults admin AllFaults
+//
+// Get the faults injected into the server for testing
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the injected faults
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Fault"
+
+// AllFaults represents the API handler to capture
+// the faults injected into the server for testing.
+func AllFaults(c *gin.Context) {
+	logrus.Info("Admin: reading injected faults")
+
+	c.JSON(http.StatusOK, fault.List())
+}
+
+// swagger:operation PUT /api/v1/admin/fault admin UpdateFault
+//
+// Inject a fault into the server for testing
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the fault to inject
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Fault"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully injected the fault
+//     schema:
+//       "$ref": "#/definitions/Fault"
+//   '400':
+//     description: Unable to inject the fault
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateFault represents the API handler to
+// inject a fault into the server for testing.
+func UpdateFault(c *gin.Context) {
+	logrus.Info("Admin: injecting fault")
+
+	// capture body from API request
+	input := new(fault.Fault)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for fault: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// inject the fault for the target
+	err = fault.Set(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to inject fault: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, input)
+}
+
+// swagger:operation DELETE /api/v1/admin/faults admin DeleteFaults
+//
+// Remove the faults injected into the server for testing
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: target
+//   description: Target to remove the fault for (all targets when omitted)
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully removed the injected faults
+//     schema:
+//       type: string
+
+// DeleteFaults represents the API handler to remove
+// the faults injected into the server for testing.
+func DeleteFaults(c *gin.Context) {
+	target := c.Query("target")
+
+	logrus.Infof("Admin: removing injected faults %s", target)
+
+	fault.Clear(target)
+
+	if len(target) == 0 {
+		c.JSON(http.StatusOK, "all faults removed")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("fault for %s removed", target))
+}
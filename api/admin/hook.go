@@ -64,7 +64,7 @@ func UpdateHook(c *gin.Context) {
 	}
 
 	// send API call to update the hook
-	err = database.FromContext(c).UpdateHook(input)
+	err = database.FromContext(c).UpdateHook(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update hook %d: %w", input.GetID(), err)
 
@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/leak"
+	"github.com/go-vela/server/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/leaks admin AllLeakFindings
+//
+// Get the leaked credentials found in the logs for builds
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 100
+//   default: 10
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the leak findings
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LeakFinding"
+//     headers:
+//       X-Total-Count:
+//         description: Total number of results
+//         type: integer
+//       Link:
+//         description: see https://tools.ietf.org/html/rfc5988
+//         type: string
+//   '400':
+//     description: Unable to retrieve the leak findings
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the leak findings
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllLeakFindings represents the API handler to capture the
+// leaked credentials found in the logs for builds.
+func AllLeakFindings(c *gin.Context) {
+	logrus.Info("Admin: reading leak findings")
+
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter for leak findings: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter for leak findings: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure per_page isn't above or below allowed values
+	perPage = util.MaxInt(1, util.MinInt(100, perPage))
+
+	// send API call to capture the list of leak findings
+	f, t, err := database.FromContext(c).ListLeakFindings(c, page, perPage)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get leak findings: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// create pagination object
+	pagination := api.Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   t,
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	c.JSON(http.StatusOK, f)
+}
+
+// swagger:operation POST /api/v1/admin/leaks admin RunLeakScan
+//
+// Scan the logs for the builds finished since the last scan for leaked credentials
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully scanned the logs for leaked credentials
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LeakFinding"
+//   '400':
+//     description: The leak scanner is not configured for the server
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Another leak scan is running
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to scan the logs for leaked credentials
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RunLeakScan represents the API handler to scan the logs for
+// the builds finished since the last scan for leaked credentials.
+func RunLeakScan(c *gin.Context) {
+	logrus.Info("Admin: scanning logs for leaked credentials")
+
+	s := leak.FromContext(c)
+
+	// check if the server is configured with the scanner
+	if s == nil {
+		retErr := fmt.Errorf("unable to scan logs: the leak scanner is not configured")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to scan the logs
+	findings, err := s.Run(time.Now().UTC())
+	if err != nil {
+		retErr := fmt.Errorf("unable to scan logs: %w", err)
+
+		if errors.Is(err, leak.ErrRunning) {
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, findings)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/leases admin AllLeases
+//
+// Get the leases held by the server replicas to run singleton components
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the leases
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Lease"
+//   '500':
+//     description: Unable to retrieve the leases
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllLeases represents the API handler to capture the leases
+// held by the server replicas to run singleton components,
+// showing which replica leads each component.
+func AllLeases(c *gin.Context) {
+	logrus.Info("Admin: reading leases")
+
+	// send API call to capture the leases
+	l, err := database.FromContext(c).ListLeases(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list leases: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, l)
+}
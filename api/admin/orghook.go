@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/admin/orghooks admin AllOrgHooks
+//
+// Get all of the org hooks in the database
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved all org hooks from the database
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/OrgHook"
+//   '500':
+//     description: Unable to retrieve all org hooks from the database
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllOrgHooks represents the API handler to
+// capture all org hooks stored in the database.
+func AllOrgHooks(c *gin.Context) {
+	logrus.Info("Admin: reading all org hooks")
+
+	// send API call to capture all org hooks
+	hooks, err := database.FromContext(c).ListOrgHooks(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture all org hooks: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// remove the secrets from the org hooks
+	sanitized := []*api.OrgHook{}
+	for _, oh := range hooks {
+		sanitized = append(sanitized, oh.Sanitize())
+	}
+
+	c.JSON(http.StatusOK, sanitized)
+}
+
+// swagger:operation PUT /api/v1/admin/orghook admin AdminUpdateOrgHook
+//
+// Update an org hook in the database
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing org hook to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/OrgHook"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the org hook in the database
+//     schema:
+//       "$ref": "#/definitions/OrgHook"
+//   '400':
+//     description: Unable to update the org hook in the database
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the org hook in the database
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the org hook in the database
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateOrgHook represents the API handler to update any org hook
+// stored in the database. Only the auto enable, active and owner
+// settings can be updated, so the secret and webhook for the org
+// hook are never overwritten by a sanitized org hook.
+func UpdateOrgHook(c *gin.Context) {
+	logrus.Info("Admin: updating org hook in database")
+
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.OrgHook)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for org hook for org %s: %w", input.GetOrg(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the org hook
+	oh, err := database.FromContext(c).GetOrgHookForOrg(c, input.GetOrg())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get org hook for org %s: %w", input.GetOrg(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	if input.AutoEnable != nil {
+		// update auto enable if set
+		oh.SetAutoEnable(input.GetAutoEnable())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		oh.SetActive(input.GetActive())
+	}
+
+	if input.GetUserID() > 0 {
+		// send API call to capture the new owner for the org hook
+		owner, err := database.FromContext(c).GetUser(c, input.GetUserID())
+		if err != nil {
+			retErr := fmt.Errorf("unable to get user %d for org hook for org %s: %w", input.GetUserID(), oh.GetOrg(), err)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		// repos are auto enabled on behalf of the owner
+		oh.SetUserID(owner.GetID())
+	}
+
+	oh.SetUpdatedAt(time.Now().UTC().Unix())
+	oh.SetUpdatedBy(u.GetName())
+
+	// send API call to update the org hook
+	err = database.FromContext(c).UpdateOrgHook(c, oh)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update org hook for org %s: %w", oh.GetOrg(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, oh.Sanitize())
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// maxQueueItems represents the max number of items
+// sampled from each route when inspecting the queue.
+const maxQueueItems = 100
+
+// swagger:operation GET /api/v1/admin/queue admin InspectQueue
+//
+// Get the memory used by the queue and the items waiting on its routes
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: route
+//   description: Route to inspect, may be provided multiple times (defaults to the configured routes)
+//   required: false
+//   type: string
+// - in: query
+//   name: items
+//   description: Number of items to sample from each route (max 100)
+//   required: false
+//   type: integer
+//   default: 10
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully inspected the queue
+//     schema:
+//       "$ref": "#/definitions/QueueSummary"
+//   '400':
+//     description: Unable to inspect the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to inspect the queue
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// InspectQueue represents the API handler to capture the memory
+// used by the queue and the items waiting on its routes. Only the
+// metadata for the items is returned, never the pipeline or the
+// credentials stored within the items.
+func InspectQueue(c *gin.Context) {
+	logrus.Info("Admin: inspecting queue")
+
+	// capture items query parameter
+	items, err := strconv.Atoi(c.DefaultQuery("items", "10"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert items query parameter for queue: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure items is within the bounds
+	if items < 0 {
+		items = 0
+	}
+
+	if items > maxQueueItems {
+		items = maxQueueItems
+	}
+
+	// send API call to inspect the queue
+	s, err := queue.FromGinContext(c).Inspect(c, c.QueryArray("route"), items)
+	if err != nil {
+		retErr := fmt.Errorf("unable to inspect queue: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, s)
+}
+
+// swagger:operation DELETE /api/v1/admin/queue/routes/{route} admin PurgeQueueRoute
+//
+// Delete the items waiting on a route in the queue
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: route
+//   description: Name of the route
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully purged the route
+//     schema:
+//       type: string
+//   '500':
+//     description: Unable to purge the route
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// PurgeQueueRoute represents the API handler to
+// delete the items waiting on a route in the queue.
+func PurgeQueueRoute(c *gin.Context) {
+	route := util.PathParameter(c, "route")
+
+	logrus.Infof("Admin: purging queue route %s", route)
+
+	// send API call to delete the items on the route
+	count, err := queue.FromGinContext(c).Purge(c, route)
+	if err != nil {
+		retErr := fmt.Errorf("unable to purge queue route %s: %w", route, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("%d items purged from queue route %s", count, route))
+}
+
+// swagger:operation POST /api/v1/admin/queue/routes/{route}/move admin MoveQueueRoute
+//
+// Move the items waiting on a route to another route in the queue
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: route
+//   description: Name of the route to move the items from
+//   required: true
+//   type: string
+// - in: query
+//   name: to
+//   description: Name of the route to move the items to
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully moved the items on the route
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to move the items on the route
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to move the items on the route
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// MoveQueueRoute represents the API handler to move the
+// items waiting on a route to another route in the queue.
+func MoveQueueRoute(c *gin.Context) {
+	route := util.PathParameter(c, "route")
+	to := util.QueryParameter(c, "to", "")
+
+	logrus.Infof("Admin: moving queue route %s to %s", route, to)
+
+	// ensure the items are moved to another route
+	if len(to) == 0 || strings.EqualFold(route, to) {
+		retErr := fmt.Errorf("unable to move queue route %s: to query parameter must be a different route", route)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to move the items to the route
+	count, err := queue.FromGinContext(c).Move(c, route, to)
+	if err != nil {
+		retErr := fmt.Errorf("unable to move queue route %s to %s: %w", route, to, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("%d items moved from queue route %s to %s", count, route, to))
+}
@@ -0,0 +1,454 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/retention"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation GET /api/v1/admin/retention admin AllRetentionPolicies
+//
+// Get the global retention policy and every repo retention policy
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the retention policies
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/RetentionPolicy"
+//   '500':
+//     description: Unable to retrieve the retention policies
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// AllRetentionPolicies represents the API handler to capture
+// the global retention policy and every repo retention policy.
+func AllRetentionPolicies(c *gin.Context) {
+	logrus.Info("Admin: reading retention policies")
+
+	// send API call to capture the retention policies
+	p, err := database.FromContext(c).ListRetentionPolicies(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list retention policies: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, p)
+}
+
+// swagger:operation PUT /api/v1/admin/retention admin UpdateGlobalRetentionPolicy
+//
+// Create or update the global retention policy applied to every repo without its own policy
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the global retention policy
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RetentionPolicy"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the global retention policy
+//     schema:
+//       "$ref": "#/definitions/RetentionPolicy"
+//   '400':
+//     description: Unable to update the global retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the global retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateGlobalRetentionPolicy represents the API handler to create
+// or update the global retention policy applied to every repo
+// without its own policy.
+func UpdateGlobalRetentionPolicy(c *gin.Context) {
+	logrus.Info("Admin: updating global retention policy")
+
+	// send API call to capture the global retention policy
+	p, err := database.FromContext(c).GetGlobalRetentionPolicy(c)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		retErr := fmt.Errorf("unable to get global retention policy: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	upsertRetentionPolicy(c, p, nil)
+}
+
+// swagger:operation GET /api/v1/admin/retention/repos/{org}/{repo} admin GetRetentionPolicy
+//
+// Get the retention policy overriding the global policy for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the retention policy
+//     schema:
+//       "$ref": "#/definitions/RetentionPolicy"
+//   '404':
+//     description: Unable to retrieve the retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetRetentionPolicy represents the API handler to capture
+// the retention policy overriding the global policy for a repo.
+func GetRetentionPolicy(c *gin.Context) {
+	r, ok := retentionRepo(c)
+	if !ok {
+		return
+	}
+
+	logrus.Infof("Admin: reading retention policy for repo %s", r.GetFullName())
+
+	// send API call to capture the retention policy for the repo
+	p, err := database.FromContext(c).GetRetentionPolicyForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get retention policy for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, p)
+}
+
+// swagger:operation PUT /api/v1/admin/retention/repos/{org}/{repo} admin UpdateRetentionPolicy
+//
+// Create or update the retention policy overriding the global policy for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the retention policy for the repo
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RetentionPolicy"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the retention policy
+//     schema:
+//       "$ref": "#/definitions/RetentionPolicy"
+//   '400':
+//     description: Unable to update the retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateRetentionPolicy represents the API handler to create or
+// update the retention policy overriding the global policy for a repo.
+func UpdateRetentionPolicy(c *gin.Context) {
+	r, ok := retentionRepo(c)
+	if !ok {
+		return
+	}
+
+	logrus.Infof("Admin: updating retention policy for repo %s", r.GetFullName())
+
+	// send API call to capture the retention policy for the repo
+	p, err := database.FromContext(c).GetRetentionPolicyForRepo(c, r)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		retErr := fmt.Errorf("unable to get retention policy for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	upsertRetentionPolicy(c, p, r)
+}
+
+// swagger:operation DELETE /api/v1/admin/retention/repos/{org}/{repo} admin DeleteRetentionPolicy
+//
+// Delete the retention policy for a repo so the global policy applies again
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the retention policy
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to delete the retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the retention policy
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteRetentionPolicy represents the API handler to delete the
+// retention policy for a repo so the global policy applies again.
+func DeleteRetentionPolicy(c *gin.Context) {
+	r, ok := retentionRepo(c)
+	if !ok {
+		return
+	}
+
+	logrus.Infof("Admin: deleting retention policy for repo %s", r.GetFullName())
+
+	// send API call to capture the retention policy for the repo
+	p, err := database.FromContext(c).GetRetentionPolicyForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get retention policy for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the retention policy
+	err = database.FromContext(c).DeleteRetentionPolicy(c, p)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete retention policy for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("retention policy for repo %s deleted", r.GetFullName()))
+}
+
+// swagger:operation POST /api/v1/admin/retention/sweep admin RunRetentionSweep
+//
+// Delete the builds, logs and hooks that are expired based on the retention policies
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully swept the expired builds, logs and hooks
+//     schema:
+//       "$ref": "#/definitions/RetentionSweep"
+//   '400':
+//     description: Retention is not configured for the server
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Another retention sweep is running
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to sweep the expired builds, logs and hooks
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RunRetentionSweep represents the API handler to delete the builds,
+// logs and hooks that are expired based on the retention policies.
+func RunRetentionSweep(c *gin.Context) {
+	logrus.Info("Admin: sweeping expired builds, logs and hooks")
+
+	s := retention.FromContext(c)
+
+	// check if the server is configured with the sweeper
+	if s == nil {
+		retErr := fmt.Errorf("unable to sweep expired resources: retention is not configured")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to sweep the expired resources
+	sweep, err := s.Run(time.Now().UTC())
+	if err != nil {
+		retErr := fmt.Errorf("unable to sweep expired resources: %w", err)
+
+		if errors.Is(err, retention.ErrRunning) {
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, sweep)
+}
+
+// retentionRepo is a helper function to capture the repo from
+// the path parameters of the request. It handles the error and
+// returns false when the repo doesn't exist.
+func retentionRepo(c *gin.Context) (*library.Repo, bool) {
+	org := util.PathParameter(c, "org")
+	name := util.PathParameter(c, "repo")
+
+	// send API call to capture the repo
+	r, err := database.FromContext(c).GetRepoForOrg(c, org, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get repo %s/%s: %w", org, name, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil, false
+	}
+
+	return r, true
+}
+
+// upsertRetentionPolicy is a helper function to apply the limits from the
+// request body to the existing retention policy, or a new one when nil, and
+// store it in the database. The policy is global when no repo is provided.
+func upsertRetentionPolicy(c *gin.Context, p *api.RetentionPolicy, r *library.Repo) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.RetentionPolicy)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for retention policy: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// check if the limits provided are negative
+	if input.GetKeepBuilds() < 0 || input.GetLogDays() < 0 || input.GetHookDays() < 0 {
+		retErr := fmt.Errorf("unable to update retention policy: limits must not be negative")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	create := p == nil
+	if create {
+		p = new(api.RetentionPolicy)
+
+		if r != nil {
+			p.SetRepoID(r.GetID())
+		}
+	}
+
+	if input.KeepBuilds != nil {
+		// update keep builds if set
+		p.SetKeepBuilds(input.GetKeepBuilds())
+	}
+
+	if input.LogDays != nil {
+		// update log days if set
+		p.SetLogDays(input.GetLogDays())
+	}
+
+	if input.HookDays != nil {
+		// update hook days if set
+		p.SetHookDays(input.GetHookDays())
+	}
+
+	p.SetUpdatedAt(time.Now().UTC().Unix())
+	p.SetUpdatedBy(u.GetName())
+
+	if create {
+		// send API call to create the retention policy
+		err = database.FromContext(c).CreateRetentionPolicy(c, p)
+	} else {
+		// send API call to update the retention policy
+		err = database.FromContext(c).UpdateRetentionPolicy(c, p)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to update retention policy: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, p)
+}
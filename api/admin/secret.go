@@ -66,7 +66,7 @@ func UpdateSecret(c *gin.Context) {
 	}
 
 	// send API call to update the secret
-	err = database.FromContext(c).UpdateSecret(input)
+	err = database.FromContext(c).UpdateSecret(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update secret %d: %w", input.GetID(), err)
 
@@ -67,7 +67,7 @@ func UpdateService(c *gin.Context) {
 	}
 
 	// send API call to update the service
-	err = database.FromContext(c).UpdateService(input)
+	err = database.FromContext(c).UpdateService(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update service %d: %w", input.GetID(), err)
 
@@ -66,7 +66,7 @@ func UpdateStep(c *gin.Context) {
 	}
 
 	// send API call to update the step
-	err = database.FromContext(c).UpdateStep(input)
+	err = database.FromContext(c).UpdateStep(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update step %d: %w", input.GetID(), err)
 
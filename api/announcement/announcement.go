@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+)
+
+// capture is a helper function to capture the announcement
+// for the ID in the path of the request. When the announcement
+// can't be captured, the error is handled and it returns nil.
+func capture(c *gin.Context) *api.Announcement {
+	param := util.PathParameter(c, "announcement")
+
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid announcement parameter provided: %s", param)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return nil
+	}
+
+	// send API call to capture the announcement
+	a, err := database.FromContext(c).GetAnnouncement(c, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get announcement %d: %w", id, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	return a
+}
+
+// validate is a helper function to verify the kind,
+// message and window for the announcement are supported.
+func validate(a *api.Announcement) error {
+	switch a.GetKind() {
+	case api.AnnouncementKindInfo, api.AnnouncementKindMaintenance, api.AnnouncementKindDeprecation:
+	default:
+		return fmt.Errorf("invalid kind %s: must be one of %s, %s or %s", a.GetKind(),
+			api.AnnouncementKindInfo, api.AnnouncementKindMaintenance, api.AnnouncementKindDeprecation)
+	}
+
+	if len(a.GetMessage()) == 0 {
+		return fmt.Errorf("message is required")
+	}
+
+	if a.GetEnds() > 0 && a.GetEnds() <= a.GetStarts() {
+		return fmt.Errorf("ends %d must be after starts %d", a.GetEnds(), a.GetStarts())
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/announcements announcements CreateAnnouncement
+//
+// Create an announcement in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the announcement to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Announcement"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the announcement
+//     schema:
+//       "$ref": "#/definitions/Announcement"
+//   '400':
+//     description: Unable to create the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateAnnouncement represents the API handler to create an
+// announcement in the configured backend. The announcement is
+// returned to the users it targets while it is active and the
+// time is within the window for the announcement.
+func CreateAnnouncement(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.Announcement)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new announcement: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"kind":  input.GetKind(),
+		"title": input.GetTitle(),
+		"user":  u.GetName(),
+	}).Infof("creating new announcement %s", input.GetTitle())
+
+	err = validate(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create announcement: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// default the announcement to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in announcement object
+	input.SetID(0)
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the announcement
+	err = database.FromContext(c).CreateAnnouncement(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create announcement %s: %w", input.GetTitle(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
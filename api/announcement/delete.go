@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/announcements/{announcement} announcements DeleteAnnouncement
+//
+// Delete an announcement from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: announcement
+//   description: ID of the announcement
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the announcement
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to delete the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to delete the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteAnnouncement represents the API handler to
+// remove an announcement from the configured backend.
+func DeleteAnnouncement(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"announcement": util.PathParameter(c, "announcement"),
+		"user":         u.GetName(),
+	}).Infof("deleting announcement %s", util.PathParameter(c, "announcement"))
+
+	a := capture(c)
+	if a == nil {
+		return
+	}
+
+	// send API call to remove the announcement
+	err := database.FromContext(c).DeleteAnnouncement(c, a)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete announcement %d: %w", a.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("announcement %d deleted", a.GetID()))
+}
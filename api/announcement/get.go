@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/announcements/{announcement} announcements GetAnnouncement
+//
+// Get an announcement in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: announcement
+//   description: ID of the announcement
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the announcement
+//     schema:
+//       "$ref": "#/definitions/Announcement"
+//   '400':
+//     description: Unable to retrieve the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetAnnouncement represents the API handler to capture
+// an announcement from the configured backend.
+func GetAnnouncement(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"announcement": util.PathParameter(c, "announcement"),
+		"user":         u.GetName(),
+	}).Infof("reading announcement %s", util.PathParameter(c, "announcement"))
+
+	a := capture(c)
+	if a == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, a)
+}
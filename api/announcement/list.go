@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/announcements announcements ListAnnouncements
+//
+// Get all announcements in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the announcements
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Announcement"
+//   '500':
+//     description: Unable to retrieve the announcements
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListAnnouncements represents the API handler to capture
+// the list of announcements from the configured backend.
+func ListAnnouncements(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Info("listing announcements")
+
+	// send API call to capture the list of announcements
+	announcements, err := database.FromContext(c).ListAnnouncements(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list announcements: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, announcements)
+}
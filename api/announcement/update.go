@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation PUT /api/v1/announcements/{announcement} announcements UpdateAnnouncement
+//
+// Update an announcement in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: announcement
+//   description: ID of the announcement
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the announcement fields to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Announcement"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the announcement
+//     schema:
+//       "$ref": "#/definitions/Announcement"
+//   '400':
+//     description: Unable to update the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the announcement
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateAnnouncement represents the API handler to
+// update an announcement in the configured backend.
+func UpdateAnnouncement(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"announcement": util.PathParameter(c, "announcement"),
+		"user":         u.GetName(),
+	}).Infof("updating announcement %s", util.PathParameter(c, "announcement"))
+
+	// capture body from API request
+	input := new(api.Announcement)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for announcement %s: %w", util.PathParameter(c, "announcement"), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	a := capture(c)
+	if a == nil {
+		return
+	}
+
+	if input.Kind != nil {
+		// update kind if set
+		a.SetKind(input.GetKind())
+	}
+
+	if input.Title != nil {
+		// update title if set
+		a.SetTitle(input.GetTitle())
+	}
+
+	if input.Message != nil {
+		// update message if set
+		a.SetMessage(input.GetMessage())
+	}
+
+	if input.Orgs != nil {
+		// update orgs if set
+		a.SetOrgs(input.GetOrgs())
+	}
+
+	if input.Starts != nil {
+		// update starts if set
+		a.SetStarts(input.GetStarts())
+	}
+
+	if input.Ends != nil {
+		// update ends if set
+		a.SetEnds(input.GetEnds())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		a.SetActive(input.GetActive())
+	}
+
+	err = validate(a)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update announcement %d: %w", a.GetID(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	a.SetUpdatedAt(time.Now().UTC().Unix())
+	a.SetUpdatedBy(u.GetName())
+
+	// send API call to update the announcement
+	err = database.FromContext(c).UpdateAnnouncement(c, a)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update announcement %d: %w", a.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated announcement
+	a, _ = database.FromContext(c).GetAnnouncement(c, a.GetID())
+
+	c.JSON(http.StatusOK, a)
+}
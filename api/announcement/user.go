@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/user/announcements users ListUserAnnouncements
+//
+// Get the active announcements targeting the current user
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the announcements
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Announcement"
+//   '500':
+//     description: Unable to retrieve the announcements
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListUserAnnouncements represents the API handler to capture the
+// active announcements targeting the current user. An announcement
+// targets the user when it has no orgs, or the user is a member of
+// one of the orgs for the announcement. The endpoint is intended
+// to be polled by the UI and CLI.
+func ListUserAnnouncements(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Infof("listing announcements for user %s", u.GetName())
+
+	// send API call to capture the list of active announcements
+	announcements, err := database.FromContext(c).ListActiveAnnouncements(c, time.Now().UTC().Unix())
+	if err != nil {
+		retErr := fmt.Errorf("unable to list announcements for user %s: %w", u.GetName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// cache the membership of the user for each org
+	members := make(map[string]bool)
+
+	targeted := []*api.Announcement{}
+
+	for _, a := range announcements {
+		if targets(c, u, a, members) {
+			targeted = append(targeted, a)
+		}
+	}
+
+	c.JSON(http.StatusOK, targeted)
+}
+
+// targets is a helper function to determine if the announcement
+// targets the user. The membership of the user for each org is
+// captured from the scm once and stored in the provided map.
+func targets(c *gin.Context, u *library.User, a *api.Announcement, members map[string]bool) bool {
+	if len(a.GetOrgs()) == 0 {
+		return true
+	}
+
+	for _, org := range a.GetOrgs() {
+		member, ok := members[org]
+		if !ok {
+			// send API call to capture the access level of the user for the org
+			perm, err := scm.FromContext(c).OrgAccess(u, org)
+			if err != nil {
+				logrus.Errorf("unable to get user %s access level for org %s: %v", u.GetName(), org, err)
+			}
+
+			member = len(perm) > 0
+			members[org] = member
+		}
+
+		if member {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// artifactDigest represents the pattern an artifact
+// digest must match, i.e. sha256:<hex>.
+var artifactDigest = regexp.MustCompile(`^[a-z0-9]+:[a-f0-9]{32,128}$`)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/artifacts builds CreateArtifact
+//
+// Record an artifact produced by a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the artifact to record
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Artifact"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully recorded the artifact
+//     schema:
+//       "$ref": "#/definitions/Artifact"
+//   '400':
+//     description: Unable to record the artifact
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to record the artifact
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateArtifact represents the API handler to record an
+// artifact, i.e. a binary or image, produced by a build.
+// The artifact digest links the artifact back to the build
+// for provenance lookups.
+func CreateArtifact(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// capture body from API request
+	input := new(types.Artifact)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for artifact for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("recording artifact %s for build %s", input.GetName(), entry)
+
+	err = validateArtifact(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to record artifact for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture the user or worker recording the artifact
+	createdBy := u.GetName()
+	if len(createdBy) == 0 {
+		createdBy = claims.Retrieve(c).Subject
+	}
+
+	// update fields in artifact object
+	input.SetID(0)
+	input.SetRepoID(r.GetID())
+	input.SetBuildID(b.GetID())
+	input.SetCreatedBy(createdBy)
+	input.SetCreated(time.Now().UTC().Unix())
+
+	// send API call to create the artifact
+	err = database.FromContext(c).CreateArtifact(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to record artifact %s for build %s: %w", input.GetName(), entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the recorded artifact
+	artifacts, _ := database.FromContext(c).ListArtifactsForBuild(c, b)
+	for _, artifact := range artifacts {
+		if artifact.GetName() == input.GetName() {
+			input = artifact
+
+			break
+		}
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/artifacts builds GetBuildArtifacts
+//
+// Get the artifacts produced by a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the artifacts
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Artifact"
+//   '500':
+//     description: Unable to retrieve the artifacts
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildArtifacts represents the API handler to
+// capture the artifacts produced by a build.
+func GetBuildArtifacts(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading artifacts for build %s", entry)
+
+	// send API call to capture the artifacts for the build
+	artifacts, err := database.FromContext(c).ListArtifactsForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get artifacts for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, artifacts)
+}
+
+// validateArtifact is a helper function to verify
+// the provided artifact can be recorded for a build.
+func validateArtifact(a *types.Artifact) error {
+	if len(a.GetName()) == 0 {
+		return fmt.Errorf("no name provided")
+	}
+
+	if !artifactDigest.MatchString(a.GetDigest()) {
+		return fmt.Errorf("invalid digest %q provided", a.GetDigest())
+	}
+
+	return nil
+}
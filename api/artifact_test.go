@@ -0,0 +1,48 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+)
+
+func TestAPI_validateArtifact(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		digest  string
+		failure bool
+	}{
+		{name: "vela-server", digest: "sha256:5d41402abc4b2a76b9719d911017c5925d41402abc4b2a76b9719d911017c592"},
+		{name: "vela-server", digest: "md5:5d41402abc4b2a76b9719d911017c592"},
+		{name: "", digest: "sha256:5d41402abc4b2a76b9719d911017c5925d41402abc4b2a76b9719d911017c592", failure: true},
+		{name: "vela-server", digest: "", failure: true},
+		{name: "vela-server", digest: "5d41402abc4b2a76b9719d911017c592", failure: true},
+		{name: "vela-server", digest: "sha256:5D41402ABC4B2A76B9719D911017C592", failure: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		a := new(types.Artifact)
+		a.SetName(test.name)
+		a.SetDigest(test.digest)
+
+		err := validateArtifact(a)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("validateArtifact for %q should have returned err", test.digest)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("validateArtifact for %q returned err: %v", test.digest, err)
+		}
+	}
+}
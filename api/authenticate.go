@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/internal/token"
 	"github.com/go-vela/server/scm"
@@ -99,8 +100,18 @@ func Authenticate(c *gin.Context) {
 		return
 	}
 
+	// verify the token for the user is granted the required scopes
+	scopes, err := checkScopes(c, newUser)
+	if err != nil {
+		retErr := fmt.Errorf("unable to authenticate user: %w", err)
+
+		util.HandleError(c, http.StatusUnauthorized, retErr)
+
+		return
+	}
+
 	// send API call to capture the user logging in
-	u, err := database.FromContext(c).GetUserForName(newUser.GetName())
+	u, err := database.FromContext(c).GetUserForName(c, newUser.GetName())
 	// create a new user account
 	if len(u.GetName()) == 0 || err != nil {
 		// create the user account
@@ -124,7 +135,7 @@ func Authenticate(c *gin.Context) {
 		u.SetRefreshToken(rt)
 
 		// send API call to create the user in the database
-		err = database.FromContext(c).CreateUser(u)
+		err = database.FromContext(c).CreateUser(c, u)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create user %s: %w", u.GetName(), err)
 
@@ -133,6 +144,15 @@ func Authenticate(c *gin.Context) {
 			return
 		}
 
+		// send API call to store the scopes granted to the user
+		err = updateScopes(c, u, scopes)
+		if err != nil {
+			logrus.Errorf("unable to update scopes for user %s: %v", u.GetName(), err)
+		}
+
+		// record the user logging in
+		recordSession(c, u, api.UserSessionLogin)
+
 		// return the jwt access token
 		c.JSON(http.StatusOK, library.Token{Token: &at})
 
@@ -157,7 +177,7 @@ func Authenticate(c *gin.Context) {
 	u.SetRefreshToken(rt)
 
 	// send API call to update the user in the database
-	err = database.FromContext(c).UpdateUser(u)
+	err = database.FromContext(c).UpdateUser(c, u)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update user %s: %w", u.GetName(), err)
 
@@ -166,6 +186,15 @@ func Authenticate(c *gin.Context) {
 		return
 	}
 
+	// send API call to store the scopes granted to the user
+	err = updateScopes(c, u, scopes)
+	if err != nil {
+		logrus.Errorf("unable to update scopes for user %s: %v", u.GetName(), err)
+	}
+
+	// record the user logging in
+	recordSession(c, u, api.UserSessionLogin)
+
 	// return the user with their jwt access token
 	c.JSON(http.StatusOK, library.Token{Token: &at})
 }
@@ -299,8 +328,18 @@ func AuthenticateToken(c *gin.Context) {
 		return
 	}
 
+	// verify the token is granted the required scopes
+	_, err = checkScopes(c, u)
+	if err != nil {
+		retErr := fmt.Errorf("unable to authenticate user: %w", err)
+
+		util.HandleError(c, http.StatusUnauthorized, retErr)
+
+		return
+	}
+
 	// check if the user exists
-	u, err = database.FromContext(c).GetUserForName(u.GetName())
+	u, err = database.FromContext(c).GetUserForName(c, u.GetName())
 	if err != nil {
 		retErr := fmt.Errorf("user %s not found", u.GetName())
 
@@ -325,8 +364,13 @@ func AuthenticateToken(c *gin.Context) {
 		retErr := fmt.Errorf("unable to compose token for user %s: %w", u.GetName(), err)
 
 		util.HandleError(c, http.StatusServiceUnavailable, retErr)
+
+		return
 	}
 
+	// record the user logging in
+	recordSession(c, u, api.UserSessionLogin)
+
 	// return the user with their jwt access token
 	c.JSON(http.StatusOK, library.Token{Token: &at})
 }
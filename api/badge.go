@@ -5,6 +5,8 @@
 package api
 
 import (
+	"fmt"
+	"html"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -57,7 +59,7 @@ func GetBadge(c *gin.Context) {
 	}).Infof("creating latest build badge for repo %s on branch %s", r.GetFullName(), branch)
 
 	// send API call to capture the last build for the repo and branch
-	b, err := database.FromContext(c).GetLastBuildByBranch(r, branch)
+	b, err := database.FromContext(c).GetLastBuildByBranch(c, r, branch)
 	if err != nil {
 		c.String(http.StatusOK, constants.BadgeUnknown)
 		return
@@ -88,3 +90,43 @@ func badgeForStatus(s string) string {
 		return constants.BadgeUnknown
 	}
 }
+
+// badgeForDeploy is a helper to create a badge with the version
+// deployed to the environment. When no version was deployed to
+// the environment, the badge shows the environment as unknown.
+func badgeForDeploy(environment, version string) string {
+	color := "#44cc11"
+
+	if len(version) == 0 {
+		color = "#9f9f9f"
+		version = "unknown"
+	}
+
+	label := html.EscapeString(environment)
+	message := html.EscapeString(version)
+
+	// estimate the width of each side of the badge from the text
+	labelWidth := 6*len(environment) + 10
+	messageWidth := 6*len(version) + 10
+	width := labelWidth + messageWidth
+
+	return fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20">`+
+			`<linearGradient id="a" x2="0" y2="100%%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`+
+			`<rect rx="3" width="%d" height="20" fill="#555555"/>`+
+			`<rect rx="3" x="%d" width="%d" height="20" fill="%s"/>`+
+			`<path d="M%d 0h4v20h-4z" fill="%s"/>`+
+			`<rect rx="3" width="%d" height="20" fill="url(#a)"/>`+
+			`<g fill="#fff" text-anchor="middle" font-family="DejaVu Sans,Verdana,Geneva,sans-serif" font-size="11">`+
+			`<text x="%d" y="14" fill="#010101" fill-opacity=".3">%s</text><text x="%d" y="13">%s</text>`+
+			`<text x="%d" y="14" fill="#010101" fill-opacity=".3">%s</text><text x="%d" y="13">%s</text>`+
+			`</g></svg>`,
+		width,
+		width,
+		labelWidth, messageWidth, color,
+		labelWidth, color,
+		width,
+		labelWidth/2, label, labelWidth/2, label,
+		labelWidth+messageWidth/2, message, labelWidth+messageWidth/2, message,
+	)
+}
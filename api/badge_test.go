@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAPI_badgeForDeploy(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name        string
+		environment string
+		version     string
+		want        []string
+	}{
+		{
+			name:        "deployed",
+			environment: "production",
+			version:     "v1.0.0",
+			want:        []string{"production", "v1.0.0", "#44cc11"},
+		},
+		{
+			name:        "not deployed",
+			environment: "staging",
+			want:        []string{"staging", "unknown", "#9f9f9f"},
+		},
+		{
+			name:        "escaped",
+			environment: "<prod>",
+			version:     "v1&2",
+			want:        []string{"&lt;prod&gt;", "v1&amp;2"},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := badgeForDeploy(test.environment, test.version)
+
+			for _, want := range test.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("badgeForDeploy is %s, want it to contain %s", got, want)
+				}
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -19,7 +20,9 @@ import (
 	"github.com/go-vela/server/router/middleware/org"
 
 	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/report"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/placement"
 	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/executors"
@@ -61,6 +64,18 @@ import (
 //   required: true
 //   schema:
 //     "$ref": "#/definitions/Build"
+// - in: header
+//   name: Idempotency-Key
+//   description: Unique key to deduplicate retries of the request
+//   type: string
+// - in: query
+//   name: override_freeze
+//   description: Override the active change freezes as an admin
+//   type: boolean
+// - in: query
+//   name: pipeline_ref
+//   description: Ref to source the pipeline configuration from instead of the commit for the build
+//   type: string
 // security:
 //   - ApiKeyAuth: []
 // responses:
@@ -77,10 +92,22 @@ import (
 //     description: Unable to create the build
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '403':
+//     description: The build is blocked by a change freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
 //   '404':
 //     description: Unable to create the build
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Request with the idempotency key is still being processed
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '422':
+//     description: Idempotency key was already used for a different request
+//     schema:
+//       "$ref": "#/definitions/Error"
 //   '500':
 //     description: Unable to create the build
 //     schema:
@@ -132,7 +159,7 @@ func CreateBuild(c *gin.Context) {
 	}
 
 	// send API call to capture the repo owner
-	u, err = database.FromContext(c).GetUser(r.GetUserID())
+	u, err = database.FromContext(c).GetUser(c, r.GetUserID())
 	if err != nil {
 		retErr := fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
 
@@ -147,7 +174,7 @@ func CreateBuild(c *gin.Context) {
 	}
 
 	// send API call to capture the number of pending or running builds for the repo
-	builds, err := database.FromContext(c).GetRepoBuildCount(r, filters)
+	builds, err := database.FromContext(c).GetRepoBuildCount(c, r, filters)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create new build: unable to get count of builds for repo %s", r.GetFullName())
 
@@ -165,6 +192,16 @@ func CreateBuild(c *gin.Context) {
 		return
 	}
 
+	// check if the number of pending and running builds exceeds the limit for the worker group
+	err = verifyWorkerGroupLimit(c, database.FromContext(c), r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create new build: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	// update fields in build object
 	input.SetRepoID(r.GetID())
 	input.SetStatus(constants.StatusPending)
@@ -190,8 +227,11 @@ func CreateBuild(c *gin.Context) {
 		)
 	}
 
-	// variable to store changeset files
-	var files []string
+	// variables to store changeset files and pull request number
+	var (
+		files  []string
+		number int
+	)
 	// check if the build event is not issue_comment or pull_request
 	if !strings.EqualFold(input.GetEvent(), constants.EventComment) &&
 		!strings.EqualFold(input.GetEvent(), constants.EventPull) {
@@ -209,7 +249,7 @@ func CreateBuild(c *gin.Context) {
 	// check if the build event is a pull_request
 	if strings.EqualFold(input.GetEvent(), constants.EventPull) {
 		// capture number from build
-		number, err := getPRNumberFromBuild(input)
+		number, err = getPRNumberFromBuild(input)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create new build: failed to get pull_request number for %s: %w", r.GetFullName(), err)
 
@@ -229,6 +269,36 @@ func CreateBuild(c *gin.Context) {
 		}
 	}
 
+	// send API calls to compute the context for the build
+	buildCtx, err := buildContext(scm.FromContext(c), u, r, input, number, files)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create new build: failed to get build context for %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// verify the deployment has the approvals required by the server
+	err = verifyDeployApproval(c, u, r, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create new build: %w", err)
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		return
+	}
+
+	// verify the build is not blocked by a change freeze
+	err = verifyFreeze(c, u, r, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create new build: %w", err)
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		return
+	}
+
 	var (
 		// variable to store the raw pipeline configuration
 		config []byte
@@ -240,11 +310,21 @@ func CreateBuild(c *gin.Context) {
 		pipelineType = r.GetPipelineType()
 	)
 
+	// capture the commit and ref to source the pipeline configuration from
+	pipelineCommit, pipelineRef, err := pipelineSource(scm.FromContext(c), u, r, input, c.Query("pipeline_ref"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to create new build: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	// send API call to attempt to capture the pipeline
-	pipeline, err = database.FromContext(c).GetPipelineForRepo(input.GetCommit(), r)
+	pipeline, err = database.FromContext(c).GetPipelineForRepo(c, pipelineCommit, r)
 	if err != nil { // assume the pipeline doesn't exist in the database yet
 		// send API call to capture the pipeline configuration file
-		config, err = scm.FromContext(c).ConfigBackoff(u, r, input.GetCommit())
+		config, err = scm.FromContext(c).ConfigBackoff(u, r, pipelineCommit)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create new build: failed to get pipeline configuration for %s: %w", r.GetFullName(), err)
 
@@ -267,10 +347,13 @@ func CreateBuild(c *gin.Context) {
 	}
 
 	var compiled *library.Pipeline
+	// create a compiler to capture the report for the pipeline
+	engine := compiler.FromContext(c).Duplicate()
 	// parse and compile the pipeline configuration file
-	p, compiled, err = compiler.FromContext(c).
-		Duplicate().
+	p, compiled, err = engine.
 		WithBuild(input).
+		WithBuildContext(buildCtx).
+		WithContext(c.Request.Context()).
 		WithFiles(files).
 		WithMetadata(m).
 		WithRepo(r).
@@ -314,11 +397,11 @@ func CreateBuild(c *gin.Context) {
 	if pipeline == nil {
 		pipeline = compiled
 		pipeline.SetRepoID(r.GetID())
-		pipeline.SetCommit(input.GetCommit())
-		pipeline.SetRef(input.GetRef())
+		pipeline.SetCommit(pipelineCommit)
+		pipeline.SetRef(pipelineRef)
 
 		// send API call to create the pipeline
-		err = database.FromContext(c).CreatePipeline(pipeline)
+		err = database.FromContext(c).CreatePipeline(c, pipeline)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create new build: failed to create pipeline for %s: %w", r.GetFullName(), err)
 
@@ -328,7 +411,7 @@ func CreateBuild(c *gin.Context) {
 		}
 
 		// send API call to capture the created pipeline
-		pipeline, err = database.FromContext(c).GetPipelineForRepo(pipeline.GetCommit(), r)
+		pipeline, err = database.FromContext(c).GetPipelineForRepo(c, pipeline.GetCommit(), r)
 		if err != nil {
 			//nolint:lll // ignore long line length due to error message
 			retErr := fmt.Errorf("unable to create new build: failed to get new pipeline %s/%s: %w", r.GetFullName(), pipeline.GetCommit(), err)
@@ -341,44 +424,29 @@ func CreateBuild(c *gin.Context) {
 
 	input.SetPipelineID(pipeline.GetID())
 
-	// create the objects from the pipeline in the database
-	err = planBuild(database.FromContext(c), p, input, r)
-	if err != nil {
-		util.HandleError(c, http.StatusInternalServerError, err)
-
-		return
-	}
-
-	// send API call to update repo for ensuring counter is incremented
-	err = database.FromContext(c).UpdateRepo(r)
+	// create the build and publish it to the queue
+	input, err = createBuild(
+		c,
+		database.FromContext(c),
+		report.FromContext(c),
+		scm.FromContext(c),
+		queue.FromGinContext(c),
+		placement.FromContext(c),
+		engine,
+		p,
+		input,
+		r,
+		u,
+	)
 	if err != nil {
-		retErr := fmt.Errorf("unable to create new build: failed to update repo %s: %w", r.GetFullName(), err)
+		retErr := fmt.Errorf("unable to create new build: %w", err)
 
-		util.HandleError(c, http.StatusBadRequest, retErr)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
 
 		return
 	}
 
-	// send API call to capture the created build
-	input, _ = database.FromContext(c).GetBuild(input.GetNumber(), r)
-
 	c.JSON(http.StatusCreated, input)
-
-	// send API call to set the status on the commit
-	err = scm.FromContext(c).Status(u, input, r.GetOrg(), r.GetName())
-	if err != nil {
-		logger.Errorf("unable to set commit status for build %s/%d: %v", r.GetFullName(), input.GetNumber(), err)
-	}
-
-	// publish the build to the queue
-	go publishToQueue(
-		queue.FromGinContext(c),
-		database.FromContext(c),
-		p,
-		input,
-		r,
-		u,
-	)
 }
 
 // skipEmptyBuild checks if the build should be skipped due to it
@@ -413,6 +481,43 @@ func skipEmptyBuild(p *pipeline.Build) string {
 	return ""
 }
 
+// skipLabelBuild checks if the build should be skipped due to it
+// being triggered by a labeled or unlabeled pull request action
+// without any container explicitly opting in to that action.
+func skipLabelBuild(p *pipeline.Build, b *library.Build) string {
+	if !strings.EqualFold(b.GetEvent(), constants.EventPull) {
+		return ""
+	}
+
+	action := b.GetEventAction()
+	if !strings.EqualFold(action, "labeled") && !strings.EqualFold(action, "unlabeled") {
+		return ""
+	}
+
+	event := fmt.Sprintf("%s:%s", constants.EventPull, action)
+
+	// collect the containers from the steps and stages
+	containers := p.Steps
+	for _, stage := range p.Stages {
+		containers = append(containers, stage.Steps...)
+	}
+
+	for _, container := range containers {
+		for _, e := range container.Ruleset.If.Event {
+			// only explicit pull request actions opt in to the event
+			if !strings.HasPrefix(e, constants.EventPull+":") {
+				continue
+			}
+
+			if ok, _ := filepath.Match(e, event); ok {
+				return ""
+			}
+		}
+	}
+
+	return fmt.Sprintf("skipping build since no steps run for the %s event", event)
+}
+
 // swagger:operation GET /api/v1/search/builds/{id} builds GetBuildByID
 //
 // Get a single build by its id in the configured backend
@@ -474,7 +579,7 @@ func GetBuildByID(c *gin.Context) {
 	}).Infof("reading build %d", id)
 
 	// Get build from database
-	b, err = database.FromContext(c).GetBuildByID(id)
+	b, err = database.FromContext(c).GetBuildByID(c, id)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get build: %w", err)
 
@@ -484,7 +589,7 @@ func GetBuildByID(c *gin.Context) {
 	}
 
 	// Get repo from database using repo ID field from build
-	r, err = database.FromContext(c).GetRepo(b.GetRepoID())
+	r, err = database.FromContext(c).GetRepo(c, b.GetRepoID())
 	if err != nil {
 		retErr := fmt.Errorf("unable to get repo: %w", err)
 
@@ -495,7 +600,7 @@ func GetBuildByID(c *gin.Context) {
 
 	// Capture user access from SCM. We do this in order to ensure user has access and is not
 	// just retrieving any build using a random id number.
-	perm, err := scm.FromContext(c).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
+	perm, err := scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
 	if err != nil {
 		logrus.Errorf("unable to get user %s access level for repo %s", u.GetName(), r.GetFullName())
 	}
@@ -727,7 +832,7 @@ func GetBuilds(c *gin.Context) {
 		return
 	}
 
-	b, t, err = database.FromContext(c).GetRepoBuildList(r, filters, before, after, page, perPage)
+	b, t, err = database.FromContext(c).GetRepoBuildList(c, r, filters, before, after, page, perPage)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get builds for repo %s: %w", r.GetFullName(), err)
 
@@ -900,7 +1005,7 @@ func GetOrgBuilds(c *gin.Context) {
 	}
 
 	// send API call to capture the list of builds for the org (and event type if passed in)
-	b, t, err = database.FromContext(c).GetOrgBuildList(o, filters, page, perPage)
+	b, t, err = database.FromContext(c).GetOrgBuildList(c, o, filters, page, perPage)
 
 	if err != nil {
 		retErr := fmt.Errorf("unable to get builds for org %s: %w", o, err)
@@ -999,6 +1104,14 @@ func GetBuild(c *gin.Context) {
 //   description: Build number to restart
 //   required: true
 //   type: integer
+// - in: header
+//   name: Idempotency-Key
+//   description: Unique key to deduplicate retries of the request
+//   type: string
+// - in: query
+//   name: override_freeze
+//   description: Override the active change freezes as an admin
+//   type: boolean
 // security:
 //   - ApiKeyAuth: []
 // responses:
@@ -1014,10 +1127,22 @@ func GetBuild(c *gin.Context) {
 //     description: Unable to restart the build
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '403':
+//     description: The build is blocked by a change freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
 //   '404':
 //     description: Unable to restart the build
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Request with the idempotency key is still being processed
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '422':
+//     description: Idempotency key was already used for a different request
+//     schema:
+//       "$ref": "#/definitions/Error"
 //   '500':
 //     description: Unable to restart the build
 //     schema:
@@ -1049,7 +1174,7 @@ func RestartBuild(c *gin.Context) {
 	logger.Infof("restarting build %s", entry)
 
 	// send API call to capture the repo owner
-	u, err := database.FromContext(c).GetUser(r.GetUserID())
+	u, err := database.FromContext(c).GetUser(c, r.GetUserID())
 	if err != nil {
 		retErr := fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
 
@@ -1064,7 +1189,7 @@ func RestartBuild(c *gin.Context) {
 	}
 
 	// send API call to capture the number of pending or running builds for the repo
-	builds, err := database.FromContext(c).GetRepoBuildCount(r, filters)
+	builds, err := database.FromContext(c).GetRepoBuildCount(c, r, filters)
 	if err != nil {
 		retErr := fmt.Errorf("unable to restart build: unable to get count of builds for repo %s", r.GetFullName())
 
@@ -1082,6 +1207,16 @@ func RestartBuild(c *gin.Context) {
 		return
 	}
 
+	// check if the number of pending and running builds exceeds the limit for the worker group
+	err = verifyWorkerGroupLimit(c, database.FromContext(c), r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to restart build: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
 	// update fields in build object
 	b.SetID(0)
 	b.SetCreated(time.Now().UTC().Unix())
@@ -1116,8 +1251,11 @@ func RestartBuild(c *gin.Context) {
 		)
 	}
 
-	// variable to store changeset files
-	var files []string
+	// variables to store changeset files and pull request number
+	var (
+		files  []string
+		number int
+	)
 	// check if the build event is not issue_comment or pull_request
 	if !strings.EqualFold(b.GetEvent(), constants.EventComment) &&
 		!strings.EqualFold(b.GetEvent(), constants.EventPull) {
@@ -1135,7 +1273,7 @@ func RestartBuild(c *gin.Context) {
 	// check if the build event is a pull_request
 	if strings.EqualFold(b.GetEvent(), constants.EventPull) {
 		// capture number from build
-		number, err := getPRNumberFromBuild(b)
+		number, err = getPRNumberFromBuild(b)
 		if err != nil {
 			retErr := fmt.Errorf("unable to restart build: failed to get pull_request number for %s: %w", r.GetFullName(), err)
 
@@ -1155,6 +1293,36 @@ func RestartBuild(c *gin.Context) {
 		}
 	}
 
+	// send API calls to compute the context for the build
+	buildCtx, err := buildContext(scm.FromContext(c), u, r, b, number, files)
+	if err != nil {
+		retErr := fmt.Errorf("unable to restart build: failed to get build context for %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// verify the deployment has the approvals required by the server
+	err = verifyDeployApproval(c, u, r, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to restart build: %w", err)
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		return
+	}
+
+	// verify the build is not blocked by a change freeze
+	err = verifyFreeze(c, u, r, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to restart build: %w", err)
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		return
+	}
+
 	// variables to store pipeline configuration
 	var (
 		// variable to store the raw pipeline configuration
@@ -1167,8 +1335,8 @@ func RestartBuild(c *gin.Context) {
 		pipelineType = r.GetPipelineType()
 	)
 
-	// send API call to attempt to capture the pipeline
-	pipeline, err = database.FromContext(c).GetPipelineForRepo(b.GetCommit(), r)
+	// send API call to attempt to capture the pipeline recorded on the build
+	pipeline, err = buildPipeline(c, database.FromContext(c), r, b)
 	if err != nil { // assume the pipeline doesn't exist in the database yet (before pipeline support was added)
 		// send API call to capture the pipeline configuration file
 		config, err = scm.FromContext(c).ConfigBackoff(u, r, b.GetCommit())
@@ -1194,10 +1362,13 @@ func RestartBuild(c *gin.Context) {
 	}
 
 	var compiled *library.Pipeline
+	// create a compiler to capture the report for the pipeline
+	engine := compiler.FromContext(c).Duplicate()
 	// parse and compile the pipeline configuration file
-	p, compiled, err = compiler.FromContext(c).
-		Duplicate().
+	p, compiled, err = engine.
 		WithBuild(b).
+		WithBuildContext(buildCtx).
+		WithContext(c.Request.Context()).
 		WithFiles(files).
 		WithMetadata(m).
 		WithRepo(r).
@@ -1245,7 +1416,7 @@ func RestartBuild(c *gin.Context) {
 		pipeline.SetRef(b.GetRef())
 
 		// send API call to create the pipeline
-		err = database.FromContext(c).CreatePipeline(pipeline)
+		err = database.FromContext(c).CreatePipeline(c, pipeline)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create pipeline for %s: %w", r.GetFullName(), err)
 
@@ -1255,7 +1426,7 @@ func RestartBuild(c *gin.Context) {
 		}
 
 		// send API call to capture the created pipeline
-		pipeline, err = database.FromContext(c).GetPipelineForRepo(pipeline.GetCommit(), r)
+		pipeline, err = database.FromContext(c).GetPipelineForRepo(c, pipeline.GetCommit(), r)
 		if err != nil {
 			//nolint:lll // ignore long line length due to error message
 			retErr := fmt.Errorf("unable to get new pipeline %s/%s: %w", r.GetFullName(), pipeline.GetCommit(), err)
@@ -1268,43 +1439,29 @@ func RestartBuild(c *gin.Context) {
 
 	b.SetPipelineID(pipeline.GetID())
 
-	// create the objects from the pipeline in the database
-	err = planBuild(database.FromContext(c), p, b, r)
+	// create the build and publish it to the queue
+	b, err = createBuild(
+		c,
+		database.FromContext(c),
+		report.FromContext(c),
+		scm.FromContext(c),
+		queue.FromGinContext(c),
+		placement.FromContext(c),
+		engine,
+		p,
+		b,
+		r,
+		u,
+	)
 	if err != nil {
-		util.HandleError(c, http.StatusInternalServerError, err)
+		retErr := fmt.Errorf("unable to restart build %s: %w", entry, err)
 
-		return
-	}
-
-	// send API call to update repo for ensuring counter is incremented
-	err = database.FromContext(c).UpdateRepo(r)
-	if err != nil {
-		retErr := fmt.Errorf("unable to restart build: failed to update repo %s: %w", r.GetFullName(), err)
-		util.HandleError(c, http.StatusBadRequest, retErr)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
 
 		return
 	}
 
-	// send API call to capture the restarted build
-	b, _ = database.FromContext(c).GetBuild(b.GetNumber(), r)
-
 	c.JSON(http.StatusCreated, b)
-
-	// send API call to set the status on the commit
-	err = scm.FromContext(c).Status(u, b, r.GetOrg(), r.GetName())
-	if err != nil {
-		logger.Errorf("unable to set commit status for build %s: %v", entry, err)
-	}
-
-	// publish the build to the queue
-	go publishToQueue(
-		queue.FromGinContext(c),
-		database.FromContext(c),
-		p,
-		b,
-		r,
-		u,
-	)
 }
 
 // swagger:operation PUT /api/v1/repos/{org}/{repo}/builds/{build} builds UpdateBuild
@@ -1437,7 +1594,7 @@ func UpdateBuild(c *gin.Context) {
 	}
 
 	// send API call to update the build
-	err = database.FromContext(c).UpdateBuild(b)
+	err = database.FromContext(c).UpdateBuild(c, b)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update build %s: %w", entry, err)
 
@@ -1447,7 +1604,7 @@ func UpdateBuild(c *gin.Context) {
 	}
 
 	// send API call to capture the updated build
-	b, _ = database.FromContext(c).GetBuild(b.GetNumber(), r)
+	b, _ = database.FromContext(c).GetBuild(c, b.GetNumber(), r)
 
 	c.JSON(http.StatusOK, b)
 
@@ -1457,8 +1614,11 @@ func UpdateBuild(c *gin.Context) {
 		b.GetStatus() == constants.StatusCanceled ||
 		b.GetStatus() == constants.StatusKilled ||
 		b.GetStatus() == constants.StatusError {
+		// tear down the ephemeral worker bound to the build
+		completeEphemeralWorker(c, database.FromContext(c), b)
+
 		// send API call to capture the repo owner
-		u, err := database.FromContext(c).GetUser(r.GetUserID())
+		u, err := database.FromContext(c).GetUser(c, r.GetUserID())
 		if err != nil {
 			logrus.Errorf("unable to get owner for build %s: %v", entry, err)
 		}
@@ -1468,6 +1628,43 @@ func UpdateBuild(c *gin.Context) {
 		if err != nil {
 			logrus.Errorf("unable to set commit status for build %s: %v", entry, err)
 		}
+
+		// retrieve the steps for the build from the step table
+		steps := []*library.Step{}
+		page := 1
+		perPage := 100
+
+		for page > 0 {
+			// retrieve build steps (per page) from the database
+			stepsPart, err := database.FromContext(c).GetBuildStepList(c, b, page, perPage)
+			if err != nil {
+				logrus.Errorf("unable to retrieve steps for build %s: %v", entry, err)
+
+				return
+			}
+
+			// add page of steps to list steps
+			steps = append(steps, stepsPart...)
+
+			// assume no more pages exist if under 100 results are returned
+			if len(stepsPart) < 100 {
+				page = 0
+			} else {
+				page++
+			}
+		}
+
+		// send API call to report the steps for the build
+		err = scm.FromContext(c).ReportSteps(u, b, steps, r.GetOrg(), r.GetName())
+		if err != nil {
+			logrus.Errorf("unable to report steps for build %s: %v", entry, err)
+		}
+
+		// send API call to comment the build summary on the pull request
+		err = scm.FromContext(c).CommentOnPR(u, r, b, steps)
+		if err != nil {
+			logrus.Errorf("unable to comment on pull request for build %s: %v", entry, err)
+		}
 	}
 }
 
@@ -1532,7 +1729,7 @@ func DeleteBuild(c *gin.Context) {
 	}).Infof("deleting build %s", entry)
 
 	// send API call to remove the build
-	err := database.FromContext(c).DeleteBuild(b.GetID())
+	err := database.FromContext(c).DeleteBuild(c, b.GetID())
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete build %s: %w", entry, err)
 
@@ -1566,82 +1763,132 @@ func getPRNumberFromBuild(b *library.Build) (int, error) {
 
 // planBuild is a helper function to plan the build for
 // execution. This creates all resources, like steps
-// and services, for the build in the configured backend.
+// and services, for the build in the configured backend
+// within a single database transaction, so a failure
+// midway never leaves a partially created build behind.
 // TODO:
 // - return build and error.
-func planBuild(database database.Service, p *pipeline.Build, b *library.Build, r *library.Repo) error {
+func planBuild(ctx context.Context, db database.Service, p *pipeline.Build, b *library.Build, r *library.Repo) error {
 	// update fields in build object
 	b.SetCreated(time.Now().UTC().Unix())
 
-	// send API call to create the build
-	// TODO: return created build and error instead of just error
-	err := database.CreateBuild(b)
-	if err != nil {
-		// clean up the objects from the pipeline in the database
-		// TODO:
-		// - return build in CreateBuild
-		// - even if it was created, we need to get the new build id
-		//   otherwise it will be 0, which attempts to INSERT instead
-		//   of UPDATE-ing the existing build - which results in
-		//   a constraint error (repo_id, number)
-		// - do we want to update the build or just delete it?
-		cleanBuild(database, b, nil, nil)
+	return database.Transaction(ctx, db, func(tx database.Service) error {
+		// send API call to create the build
+		// TODO: return created build and error instead of just error
+		err := tx.CreateBuild(ctx, b)
+		if err != nil {
+			return fmt.Errorf("unable to create new build for %s: %w", r.GetFullName(), err)
+		}
 
-		return fmt.Errorf("unable to create new build for %s: %w", r.GetFullName(), err)
-	}
+		// send API call to capture the created build
+		// TODO: this can be dropped once we return
+		// the created build above
+		build, err := tx.GetBuild(ctx, b.GetNumber(), r)
+		if err != nil {
+			return fmt.Errorf("unable to get new build for %s: %w", r.GetFullName(), err)
+		}
 
-	// send API call to capture the created build
-	// TODO: this can be dropped once we return
-	// the created build above
-	b, err = database.GetBuild(b.GetNumber(), r)
+		// plan all services for the build
+		_, err = planServices(ctx, tx, p, build)
+		if err != nil {
+			return err
+		}
+
+		// plan all steps for the build
+		_, err = planSteps(ctx, tx, p, build)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// createBuild is a helper function to create the build from the
+// compiled pipeline and publish it to the queue. It plans the build
+// in the database, increments the counter for the repo, saves the
+// records produced when compiling the pipeline, sets the commit
+// status and publishes the build. Every flow creating a build uses
+// it, so each build ends up with the same records.
+func createBuild(
+	ctx context.Context,
+	db database.Service,
+	rs *report.Store,
+	s scm.Service,
+	q queue.Service,
+	pl *placement.Placer,
+	e compiler.Engine,
+	p *pipeline.Build,
+	b *library.Build,
+	r *library.Repo,
+	u *library.User,
+) (*library.Build, error) {
+	// create the objects from the pipeline in the database
+	err := planBuild(ctx, db, p, b, r)
 	if err != nil {
-		return fmt.Errorf("unable to get new build for %s: %w", r.GetFullName(), err)
+		return nil, err
 	}
 
-	// plan all services for the build
-	services, err := planServices(database, p, b)
+	// send API call to update repo for ensuring counter is incremented
+	err = db.UpdateRepo(ctx, r)
 	if err != nil {
-		// clean up the objects from the pipeline in the database
-		cleanBuild(database, b, services, nil)
-
-		return err
+		return nil, fmt.Errorf("failed to update repo %s: %w", r.GetFullName(), err)
 	}
 
-	// plan all steps for the build
-	steps, err := planSteps(database, p, b)
+	// send API call to capture the created build
+	b, err = db.GetBuild(ctx, b.GetNumber(), r)
 	if err != nil {
-		// clean up the objects from the pipeline in the database
-		cleanBuild(database, b, services, steps)
+		return nil, fmt.Errorf("failed to get new build for %s: %w", r.GetFullName(), err)
+	}
 
-		return err
+	saveCompileReport(rs, e, b)
+	saveBuildCredentials(ctx, db, r, b, p)
+	saveBuildImages(ctx, db, e, b)
+	saveBuildDeprecations(ctx, db, s, e, r, b)
+	saveStepSkips(ctx, db, e, b)
+
+	// send API call to set the status on the commit
+	err = s.Status(u, b, r.GetOrg(), r.GetName())
+	if err != nil {
+		logrus.Errorf("unable to set commit status for build %s/%d: %v", r.GetFullName(), b.GetNumber(), err)
 	}
 
-	return nil
+	// publish the build to the queue
+	go publishToQueue(context.Background(), q, db, pl, p, b, r, u)
+
+	return b, nil
 }
 
 // cleanBuild is a helper function to kill the build
 // without execution. This will kill all resources,
 // like steps and services, for the build in the
-// configured backend.
-func cleanBuild(database database.Service, b *library.Build, services []*library.Service, steps []*library.Step) {
+// configured backend. When provided, the error is
+// included in the error for the build.
+func cleanBuild(ctx context.Context, database database.Service, b *library.Build, services []*library.Service, steps []*library.Step, e error) {
 	// update fields in build object
 	b.SetError("unable to publish build to queue")
+	if e != nil {
+		b.SetError(fmt.Sprintf("unable to publish build to queue: %v", e))
+	}
 	b.SetStatus(constants.StatusError)
 	b.SetFinished(time.Now().UTC().Unix())
 
 	// send API call to update the build
-	err := database.UpdateBuild(b)
+	err := database.UpdateBuild(ctx, b)
 	if err != nil {
 		logrus.Errorf("unable to kill build %d: %v", b.GetNumber(), err)
 	}
 
+	// tear down the ephemeral worker bound to the build
+	completeEphemeralWorker(ctx, database, b)
+
 	for _, s := range services {
 		// update fields in service object
 		s.SetStatus(constants.StatusKilled)
 		s.SetFinished(time.Now().UTC().Unix())
 
 		// send API call to update the service
-		err := database.UpdateService(s)
+		err := database.UpdateService(ctx, s)
 		if err != nil {
 			logrus.Errorf("unable to kill service %s for build %d: %v", s.GetName(), b.GetNumber(), err)
 		}
@@ -1653,7 +1900,7 @@ func cleanBuild(database database.Service, b *library.Build, services []*library
 		s.SetFinished(time.Now().UTC().Unix())
 
 		// send API call to update the step
-		err := database.UpdateStep(s)
+		err := database.UpdateStep(ctx, s)
 		if err != nil {
 			logrus.Errorf("unable to kill step %s for build %d: %v", s.GetName(), b.GetNumber(), err)
 		}
@@ -1738,7 +1985,7 @@ func CancelBuild(c *gin.Context) {
 	}
 
 	// retrieve the worker info
-	w, err := database.FromContext(c).GetWorkerForHostname(b.GetHost())
+	w, err := database.FromContext(c).GetWorkerForHostname(c, b.GetHost())
 	if err != nil {
 		retErr := fmt.Errorf("unable to get worker for build %s: %w", entry, err)
 		util.HandleError(c, http.StatusNotFound, retErr)
@@ -1822,7 +2069,7 @@ func CancelBuild(c *gin.Context) {
 	// update the status in the build table
 	b.SetStatus(constants.StatusCanceled)
 
-	err = database.FromContext(c).UpdateBuild(b)
+	err = database.FromContext(c).UpdateBuild(c, b)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update status for build %s: %w", entry, err)
 		util.HandleError(c, http.StatusInternalServerError, retErr)
@@ -1830,6 +2077,9 @@ func CancelBuild(c *gin.Context) {
 		return
 	}
 
+	// tear down the ephemeral worker bound to the build
+	completeEphemeralWorker(c, database.FromContext(c), b)
+
 	// retrieve the steps for the build from the step table
 	steps := []*library.Step{}
 	page := 1
@@ -1837,7 +2087,7 @@ func CancelBuild(c *gin.Context) {
 
 	for page > 0 {
 		// retrieve build steps (per page) from the database
-		stepsPart, err := database.FromContext(c).GetBuildStepList(b, page, perPage)
+		stepsPart, err := database.FromContext(c).GetBuildStepList(c, b, page, perPage)
 		if err != nil {
 			retErr := fmt.Errorf("unable to retrieve steps for build %s: %w", entry, err)
 			util.HandleError(c, http.StatusNotFound, retErr)
@@ -1862,7 +2112,7 @@ func CancelBuild(c *gin.Context) {
 		if step.GetStatus() == constants.StatusRunning || step.GetStatus() == constants.StatusPending {
 			step.SetStatus(constants.StatusCanceled)
 
-			err = database.FromContext(c).UpdateStep(step)
+			err = database.FromContext(c).UpdateStep(c, step)
 			if err != nil {
 				retErr := fmt.Errorf("unable to update step %s for build %s: %w", step.GetName(), entry, err)
 				util.HandleError(c, http.StatusNotFound, retErr)
@@ -1878,7 +2128,7 @@ func CancelBuild(c *gin.Context) {
 
 	for page > 0 {
 		// retrieve build services (per page) from the database
-		servicesPart, err := database.FromContext(c).GetBuildServiceList(b, page, perPage)
+		servicesPart, err := database.FromContext(c).GetBuildServiceList(c, b, page, perPage)
 		if err != nil {
 			retErr := fmt.Errorf("unable to retrieve services for build %s: %w", entry, err)
 			util.HandleError(c, http.StatusNotFound, retErr)
@@ -1903,7 +2153,7 @@ func CancelBuild(c *gin.Context) {
 		if service.GetStatus() == constants.StatusRunning || service.GetStatus() == constants.StatusPending {
 			service.SetStatus(constants.StatusCanceled)
 
-			err = database.FromContext(c).UpdateService(service)
+			err = database.FromContext(c).UpdateService(c, service)
 			if err != nil {
 				retErr := fmt.Errorf("unable to update service %s for build %s: %w",
 					service.GetName(),
@@ -1985,6 +2235,14 @@ func GetBuildToken(c *gin.Context) {
 		return
 	}
 
+	// an ephemeral worker can only run the build it was registered for
+	if cl.BuildID > 0 && cl.BuildID != b.GetID() {
+		retErr := fmt.Errorf("unable to mint build token: worker %s is bound to build %d", cl.Subject, cl.BuildID)
+		util.HandleError(c, http.StatusUnauthorized, retErr)
+
+		return
+	}
+
 	// retrieve token manager from context
 	tm := c.MustGet("token-manager").(*token.Manager)
 
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/library"
+)
+
+// issuePattern represents the pattern for references to issues
+// in a commit message like #1 or github/octocat#1.
+var issuePattern = regexp.MustCompile(`(?:^|[\s(\[,])((?:[\w.-]+/[\w.-]+)?)#(\d+)\b`)
+
+// buildContext is a helper function to compute the context for a build
+// from the metadata available in the source control provider. The labels
+// and reviews are only captured when a pull request number is provided.
+//
+// The git submodules and Git LFS usage for the commit are detected from
+// the .gitmodules and .gitattributes files in the repo, so the compiler
+// can configure the clone step to fetch them.
+func buildContext(s scm.Service, u *library.User, r *library.Repo, b *library.Build, number int, files []string) (*types.BuildContext, error) {
+	ctx := new(types.BuildContext)
+
+	ctx.SetFiles(files)
+	ctx.SetIssues(parseIssues(b.GetMessage(), r.GetFullName()))
+
+	// send API call to capture the submodules for the commit
+	modules, err := s.GetFile(u, r, ".gitmodules", b.GetCommit())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get .gitmodules for %s: %w", r.GetFullName(), err)
+	}
+
+	ctx.SetSubmodules(parseSubmodules(modules))
+
+	// send API call to capture the attributes for the commit
+	attributes, err := s.GetFile(u, r, ".gitattributes", b.GetCommit())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get .gitattributes for %s: %w", r.GetFullName(), err)
+	}
+
+	ctx.SetLFS(usesLFS(attributes))
+
+	if number == 0 {
+		return ctx, nil
+	}
+
+	// send API call to capture the labels for the pull request
+	labels, err := s.ListPullRequestLabels(u, r, number)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list labels for %s/pull/%d: %w", r.GetFullName(), number, err)
+	}
+
+	ctx.SetLabels(labels)
+
+	// send API call to capture the reviews for the pull request
+	reviewers, approvals, err := s.ListPullRequestReviews(u, r, number)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list reviews for %s/pull/%d: %w", r.GetFullName(), number, err)
+	}
+
+	ctx.SetReviewers(reviewers)
+	ctx.SetApprovals(approvals)
+
+	return ctx, nil
+}
+
+// parseIssues is a helper function to capture the unique issues referenced
+// in the provided commit message. References without a repo are
+// qualified with the provided repo.
+func parseIssues(message, repo string) []string {
+	issues := []string{}
+	seen := make(map[string]bool)
+
+	for _, match := range issuePattern.FindAllStringSubmatch(message, -1) {
+		name := match[1]
+		if len(name) == 0 {
+			name = repo
+		}
+
+		issue := fmt.Sprintf("%s#%s", name, match[2])
+
+		if !seen[issue] {
+			seen[issue] = true
+
+			issues = append(issues, issue)
+		}
+	}
+
+	return issues
+}
+
+// parseSubmodules is a helper function to capture the urls
+// for the submodules declared in the provided .gitmodules file.
+func parseSubmodules(data []byte) []string {
+	submodules := []string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if !found || strings.TrimSpace(key) != "url" {
+			continue
+		}
+
+		submodules = append(submodules, strings.TrimSpace(value))
+	}
+
+	return submodules
+}
+
+// usesLFS is a helper function to determine if the provided
+// .gitattributes file tracks any paths with Git LFS.
+func usesLFS(data []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		for _, attribute := range strings.Fields(line) {
+			if attribute == "filter=lfs" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
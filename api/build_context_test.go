@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAPI_parseIssues(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		message string
+		want    []string
+	}{
+		{
+			name:    "no issues",
+			message: "update README.md",
+			want:    []string{},
+		},
+		{
+			name:    "local and remote issues",
+			message: "fix build (#12)\n\nCloses #12, relates to github/hello-world#3 and [#7]",
+			want:    []string{"github/octocat#12", "github/hello-world#3", "github/octocat#7"},
+		},
+		{
+			name:    "ignores anchors",
+			message: "see https://example.com/docs#1 and C#10",
+			want:    []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseIssues(test.message, "github/octocat")
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseIssues is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAPI_parseSubmodules(t *testing.T) {
+	// setup types
+	data := []byte(`[submodule "octokit"]
+	path = vendor/octokit
+	url = https://github.com/github/octokit.git
+[submodule "hello-world"]
+	path = vendor/hello-world
+	url=../hello-world.git
+	branch = main
+`)
+
+	// setup tests
+	tests := []struct {
+		name string
+		data []byte
+		want []string
+	}{
+		{
+			name: "no .gitmodules",
+			data: nil,
+			want: []string{},
+		},
+		{
+			name: "submodules",
+			data: data,
+			want: []string{"https://github.com/github/octokit.git", "../hello-world.git"},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := parseSubmodules(test.data)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseSubmodules is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAPI_usesLFS(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		data []byte
+		want bool
+	}{
+		{
+			name: "no .gitattributes",
+			data: nil,
+			want: false,
+		},
+		{
+			name: "no lfs",
+			data: []byte("*.go text eol=lf\n# *.png filter=lfs diff=lfs merge=lfs -text\n"),
+			want: false,
+		},
+		{
+			name: "lfs",
+			data: []byte("*.go text eol=lf\n*.png filter=lfs diff=lfs merge=lfs -text\n"),
+			want: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := usesLFS(test.data)
+
+			if got != test.want {
+				t.Errorf("usesLFS is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
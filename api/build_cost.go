@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/cost builds GetBuildCost
+//
+// Get the estimated cost of a build based on the class of the worker it ran on
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to estimate the cost for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully estimated the cost of the build
+//     schema:
+//       "$ref": "#/definitions/BuildCost"
+//   '500':
+//     description: Unable to estimate the cost of the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildCost represents the API handler to estimate the cost
+// of a build from the rate for the class of the worker it ran on.
+func GetBuildCost(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading cost for build %s", entry)
+
+	var w *library.Worker
+
+	// send API call to capture the worker the build ran on
+	if len(b.GetHost()) > 0 {
+		var err error
+
+		w, err = database.FromContext(c).GetWorkerForHostname(c, b.GetHost())
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			retErr := fmt.Errorf("unable to get worker %s for build %s: %w", b.GetHost(), entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+	}
+
+	// send API call to capture the cost rates
+	rates, err := database.FromContext(c).ListCostRates(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list cost rates for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, buildCost(b, w, rates, time.Now().UTC().Unix()))
+}
+
+// buildCost is a helper function to estimate the cost of the build
+// from the rate for the class of the worker it ran on. The cost of
+// a running build is estimated up to now, and the cost of a build
+// on a worker without a rate is attributed to the default class.
+func buildCost(b *library.Build, w *library.Worker, rates []*types.CostRate, now int64) *types.BuildCost {
+	cost := new(types.BuildCost)
+	cost.SetBuildID(b.GetID())
+	cost.SetHost(b.GetHost())
+	cost.SetClass(types.CostRateDefault)
+
+	rate := types.RateFor(w.GetRoutes(), rates)
+	if rate != nil {
+		cost.SetClass(rate.GetClass())
+	}
+
+	var seconds int64
+
+	switch {
+	case b.GetStarted() > 0 && b.GetFinished() > 0:
+		seconds = b.GetFinished() - b.GetStarted()
+	case b.GetStarted() > 0:
+		seconds = now - b.GetStarted()
+	}
+
+	cost.SetMinutes(float64(seconds) / 60)
+	cost.SetRate(rate.GetRate())
+	cost.SetCost(cost.GetMinutes() * cost.GetRate())
+
+	return cost
+}
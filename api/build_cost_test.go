@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func Test_buildCost(t *testing.T) {
+	// setup types
+	_worker := new(library.Worker)
+	_worker.SetHostname("worker-1.example.com")
+	_worker.SetRoutes([]string{"large:linux"})
+
+	_rate := new(types.CostRate)
+	_rate.SetClass("large:linux")
+	_rate.SetRate(0.5)
+
+	_finished := new(library.Build)
+	_finished.SetID(1)
+	_finished.SetHost("worker-1.example.com")
+	_finished.SetStarted(60)
+	_finished.SetFinished(660)
+
+	_running := new(library.Build)
+	_running.SetID(2)
+	_running.SetHost("worker-1.example.com")
+	_running.SetStarted(60)
+
+	_pending := new(library.Build)
+	_pending.SetID(3)
+
+	// setup tests
+	tests := []struct {
+		name   string
+		build  *library.Build
+		worker *library.Worker
+		want   *types.BuildCost
+	}{
+		{
+			name:   "finished",
+			build:  _finished,
+			worker: _worker,
+			want:   testBuildCost(1, "worker-1.example.com", "large:linux", 10, 0.5),
+		},
+		{
+			name:   "running",
+			build:  _running,
+			worker: _worker,
+			want:   testBuildCost(2, "worker-1.example.com", "large:linux", 20, 0.5),
+		},
+		{
+			name:   "pending",
+			build:  _pending,
+			worker: nil,
+			want:   testBuildCost(3, "", types.CostRateDefault, 0, 0),
+		},
+		{
+			name:   "unknown worker",
+			build:  _finished,
+			worker: nil,
+			want:   testBuildCost(1, "worker-1.example.com", types.CostRateDefault, 10, 0),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := buildCost(test.build, test.worker, []*types.CostRate{_rate}, 1260)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("buildCost is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// testBuildCost is a test helper function to create a BuildCost
+// type for the provided build, worker and rate.
+func testBuildCost(id int64, host, class string, minutes, rate float64) *types.BuildCost {
+	cost := new(types.BuildCost)
+	cost.SetBuildID(id)
+	cost.SetHost(host)
+	cost.SetClass(class)
+	cost.SetMinutes(minutes)
+	cost.SetRate(rate)
+	cost.SetCost(minutes * rate)
+
+	return cost
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/claims"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/registry-credentials builds GetBuildRegistryCredentials
+//
+// Get the image pull credentials granted to a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to retrieve the credentials for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the credentials for the build
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/RegistryCredential"
+//   '400':
+//     description: Unable to retrieve the credentials for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the credentials for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildRegistryCredentials represents the API handler to capture
+// the image pull credentials for the registries granted to a build.
+// Credentials are only handed out while the build is in progress.
+func GetBuildRegistryCredentials(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	cl := claims.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  cl.Subject,
+	}).Infof("reading registry credentials for build %s", entry)
+
+	// only hand out credentials for builds in progress
+	if b.GetStatus() != constants.StatusPending && b.GetStatus() != constants.StatusRunning {
+		retErr := fmt.Errorf("unable to get registry credentials for build %s: build is %s", entry, b.GetStatus())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	credentials := []*types.RegistryCredential{}
+
+	// send API call to capture the registries granted to the build
+	bc, err := database.FromContext(c).GetBuildCredentialsForBuild(c, b)
+	if err != nil {
+		// builds without any matching registries have nothing granted
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusOK, credentials)
+
+			return
+		}
+
+		retErr := fmt.Errorf("unable to get registry credentials for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	for _, registry := range bc.GetRegistries() {
+		// send API call to capture the credential for the registry
+		rc, err := database.FromContext(c).GetRegistryCredentialForOrg(c, r.GetOrg(), registry)
+		if err != nil {
+			// the credential was removed after the build was compiled
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+
+			retErr := fmt.Errorf("unable to get registry credential %s for build %s: %w", registry, entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		if !rc.GetActive() {
+			continue
+		}
+
+		credentials = append(credentials, rc)
+	}
+
+	c.JSON(http.StatusOK, credentials)
+}
+
+// saveBuildCredentials is a helper function to grant the build
+// access to the active registry credentials for the org that
+// match the registries of the images in the compiled pipeline.
+func saveBuildCredentials(ctx context.Context, db database.Service, r *library.Repo, b *library.Build, p *pipeline.Build) {
+	// send API call to capture the registry credentials for the org
+	credentials, err := db.ListRegistryCredentialsForOrg(ctx, r.GetOrg())
+	if err != nil {
+		logrus.Errorf("unable to list registry credentials for org %s: %v", r.GetOrg(), err)
+
+		return
+	}
+
+	registries := pipelineRegistries(credentials, p)
+	if len(registries) == 0 {
+		return
+	}
+
+	bc := new(types.BuildCredentials)
+	bc.SetBuildID(b.GetID())
+	bc.SetRepoID(b.GetRepoID())
+	bc.SetRegistries(registries)
+	bc.SetCreated(time.Now().UTC().Unix())
+
+	// send API call to create the credentials for the build
+	err = db.CreateBuildCredentials(ctx, bc)
+	if err != nil {
+		logrus.Errorf("unable to create registry credentials for build %d: %v", b.GetID(), err)
+	}
+}
+
+// pipelineRegistries is a helper function to capture the sorted
+// registries of the images in the pipeline that have an active
+// credential in the provided list.
+func pipelineRegistries(credentials []*types.RegistryCredential, p *pipeline.Build) []string {
+	active := make(map[string]bool)
+
+	for _, rc := range credentials {
+		if rc.GetActive() {
+			active[rc.GetRegistry()] = true
+		}
+	}
+
+	if len(active) == 0 || p == nil {
+		return nil
+	}
+
+	containers := pipeline.ContainerSlice{}
+	containers = append(containers, p.Services...)
+	containers = append(containers, p.Steps...)
+
+	for _, s := range p.Stages {
+		containers = append(containers, s.Steps...)
+	}
+
+	found := make(map[string]bool)
+
+	for _, ctn := range containers {
+		registry := imageRegistry(ctn.Image)
+
+		if active[registry] {
+			found[registry] = true
+		}
+	}
+
+	registries := []string{}
+	for registry := range found {
+		registries = append(registries, registry)
+	}
+
+	sort.Strings(registries)
+
+	return registries
+}
+
+// imageRegistry is a helper function to capture the registry
+// hosting the provided image. Images without a registry host
+// are pulled from Docker Hub.
+func imageRegistry(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0]
+	}
+
+	return "docker.io"
+}
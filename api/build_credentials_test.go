@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/pipeline"
+)
+
+func TestAPI_imageRegistry(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "alpine:latest", want: "docker.io"},
+		{image: "target/vela-git:v0.8.0", want: "docker.io"},
+		{image: "ghcr.io/go-vela/server:latest", want: "ghcr.io"},
+		{image: "localhost/alpine", want: "localhost"},
+		{image: "registry:5000/alpine", want: "registry:5000"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			got := imageRegistry(test.image)
+
+			if got != test.want {
+				t.Errorf("imageRegistry is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAPI_pipelineRegistries(t *testing.T) {
+	// setup types
+	hub := new(types.RegistryCredential)
+	hub.SetRegistry("docker.io")
+	hub.SetActive(true)
+
+	ghcr := new(types.RegistryCredential)
+	ghcr.SetRegistry("ghcr.io")
+	ghcr.SetActive(true)
+
+	quay := new(types.RegistryCredential)
+	quay.SetRegistry("quay.io")
+	quay.SetActive(false)
+
+	unused := new(types.RegistryCredential)
+	unused.SetRegistry("registry.example.com")
+	unused.SetActive(true)
+
+	credentials := []*types.RegistryCredential{hub, ghcr, quay, unused}
+
+	p := &pipeline.Build{
+		Services: pipeline.ContainerSlice{
+			{Name: "redis", Image: "redis:7"},
+		},
+		Stages: pipeline.StageSlice{
+			{
+				Name: "build",
+				Steps: pipeline.ContainerSlice{
+					{Name: "build", Image: "ghcr.io/go-vela/builder:latest"},
+					{Name: "scan", Image: "quay.io/scanner:latest"},
+				},
+			},
+		},
+	}
+
+	// setup tests
+	tests := []struct {
+		name        string
+		credentials []*types.RegistryCredential
+		pipeline    *pipeline.Build
+		want        []string
+	}{
+		{
+			name:        "matching registries",
+			credentials: credentials,
+			pipeline:    p,
+			want:        []string{"docker.io", "ghcr.io"},
+		},
+		{
+			name:        "no credentials",
+			credentials: []*types.RegistryCredential{},
+			pipeline:    p,
+			want:        nil,
+		},
+		{
+			name:        "no matching registries",
+			credentials: []*types.RegistryCredential{quay, unused},
+			pipeline:    p,
+			want:        []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := pipelineRegistries(test.credentials, test.pipeline)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("pipelineRegistries is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// deprecationCommentKey represents the key for the pull
+// request comment listing the deprecations for a build.
+const deprecationCommentKey = "deprecations"
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/deprecations builds GetBuildDeprecations
+//
+// Get the warnings for the deprecated images, templates and syntax used by a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to retrieve the deprecations for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the deprecations for the build
+//     schema:
+//       "$ref": "#/definitions/BuildDeprecations"
+//   '404':
+//     description: Unable to retrieve the deprecations for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the deprecations for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildDeprecations represents the API handler to capture the
+// warnings for the deprecated images, templates and syntax used
+// by a build when it was compiled.
+func GetBuildDeprecations(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading deprecations for build %s", entry)
+
+	// send API call to capture the deprecations for the build
+	d, err := database.FromContext(c).GetBuildDeprecationsForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get deprecations for build %s: %w", entry, err)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, d)
+}
+
+// saveBuildDeprecations is a helper function to persist the warnings
+// for the deprecations found by the provided compiler for the build
+// and comment them on the pull request for the build when the rules
+// for the deprecations ask for it.
+func saveBuildDeprecations(ctx context.Context, db database.Service, s scm.Service, e compiler.Engine, r *library.Repo, b *library.Build) {
+	if e == nil || len(e.Deprecated()) == 0 {
+		return
+	}
+
+	warnings := []string{}
+
+	for _, deprecation := range e.Deprecated() {
+		warnings = append(warnings, deprecation.Warning())
+	}
+
+	d := new(types.BuildDeprecations)
+	d.SetBuildID(b.GetID())
+	d.SetRepoID(b.GetRepoID())
+	d.SetWarnings(warnings)
+	d.SetCreated(time.Now().UTC().Unix())
+
+	// send API call to create the deprecations for the build
+	err := db.CreateBuildDeprecations(ctx, d)
+	if err != nil {
+		logrus.Errorf("unable to create deprecations for build %d: %v", b.GetID(), err)
+	}
+
+	reportDeprecations(ctx, db, s, r, b, e.Deprecated())
+}
+
+// reportDeprecations is a helper function to surface the deprecations
+// for a pull request build in a comment on the pull request. Failing to
+// comment on the pull request is logged and doesn't fail the request.
+func reportDeprecations(ctx context.Context, db database.Service, s scm.Service, r *library.Repo, b *library.Build, deprecations []*types.Deprecation) {
+	if !strings.EqualFold(b.GetEvent(), constants.EventPull) {
+		return
+	}
+
+	comment := deprecationComment(deprecations)
+	if len(comment) == 0 {
+		return
+	}
+
+	number, err := getPRNumberFromBuild(b)
+	if err != nil {
+		logrus.Errorf("unable to get pull request number for build %d: %v", b.GetID(), err)
+
+		return
+	}
+
+	// send API call to capture the repo owner
+	owner, err := db.GetUser(ctx, r.GetUserID())
+	if err != nil {
+		logrus.Errorf("unable to get owner for %s: %v", r.GetFullName(), err)
+
+		return
+	}
+
+	// send API call to create or update the comment on the pull request
+	err = s.UpsertPullRequestComment(owner, r, number, deprecationCommentKey, comment)
+	if err != nil {
+		logrus.Errorf("unable to comment deprecations on %s/pull/%d: %v", r.GetFullName(), number, err)
+	}
+}
+
+// deprecationComment is a helper function to render the deprecations
+// with rules that ask for a comment as a markdown pull request comment.
+func deprecationComment(deprecations []*types.Deprecation) string {
+	var b strings.Builder
+
+	for _, deprecation := range deprecations {
+		if !deprecation.GetComment() {
+			continue
+		}
+
+		if b.Len() == 0 {
+			b.WriteString("### Deprecations\n\n")
+			b.WriteString("| Kind | Used | Sunset | Message |\n")
+			b.WriteString("| --- | --- | --- | --- |\n")
+		}
+
+		sunset := "-"
+		if deprecation.GetSunset() > 0 {
+			sunset = time.Unix(deprecation.GetSunset(), 0).UTC().Format("2006-01-02")
+		}
+
+		fmt.Fprintf(&b, "| %s | `%s` | %s | %s |\n",
+			deprecation.GetKind(), deprecation.GetSubject(), sunset, deprecation.GetMessage())
+	}
+
+	return b.String()
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+)
+
+func TestAPI_deprecationComment(t *testing.T) {
+	// setup types
+	image := new(types.Deprecation)
+	image.SetKind(types.DeprecationKindImage)
+	image.SetSubject("golang:1.15")
+	image.SetMessage("upgrade to golang:1.21")
+	image.SetSunset(1704067200)
+	image.SetComment(true)
+
+	syntax := new(types.Deprecation)
+	syntax.SetKind(types.DeprecationKindSyntax)
+	syntax.SetSubject("steps.ruleset.branch")
+	syntax.SetMessage("use steps.ruleset.if.branch")
+	syntax.SetComment(true)
+
+	silent := new(types.Deprecation)
+	silent.SetKind(types.DeprecationKindImage)
+	silent.SetSubject("node:14")
+	silent.SetComment(false)
+
+	want := "### Deprecations\n\n" +
+		"| Kind | Used | Sunset | Message |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| image | `golang:1.15` | 2024-01-01 | upgrade to golang:1.21 |\n" +
+		"| syntax | `steps.ruleset.branch` | - | use steps.ruleset.if.branch |\n"
+
+	// run test
+	got := deprecationComment([]*types.Deprecation{image, silent, syntax})
+
+	if got != want {
+		t.Errorf("deprecationComment is %v, want %v", got, want)
+	}
+
+	got = deprecationComment([]*types.Deprecation{silent})
+
+	if len(got) > 0 {
+		t.Errorf("deprecationComment is %v, want empty", got)
+	}
+}
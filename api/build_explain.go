@@ -0,0 +1,282 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/report"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/go-vela/types/yaml"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// explainStepLimit represents the maximum number
+// of steps captured when explaining a build.
+const explainStepLimit = 500
+
+// swagger:operation GET /api/v1/admin/builds/{id}/explain admin ExplainBuild
+//
+// Get everything that went into the decisions made for a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: id
+//   description: ID of the build
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully explained the build
+//     schema:
+//       "$ref": "#/definitions/BuildExplanation"
+//   '400':
+//     description: Unable to explain the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to explain the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to explain the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ExplainBuild represents the API handler to aggregate everything
+// that went into the decisions made for a build in one response: the
+// webhook that created it, the stages and steps skipped by their
+// rulesets, the warnings from compiling it, the queue route and worker
+// it was assigned, the time spent in each phase and any errors.
+//
+// Failing to capture one of the parts is recorded in the errors
+// of the explanation rather than failing the request.
+func ExplainBuild(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	id, err := strconv.ParseInt(util.PathParameter(c, "id"), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("unable to parse build id %s: %w", util.PathParameter(c, "id"), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build_id": id,
+		"user":     u.GetName(),
+	}).Infof("explaining build %d", id)
+
+	// send API call to capture the build
+	b, err := database.FromContext(c).GetBuildByID(c, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get build %d: %w", id, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to capture the repo for the build
+	r, err := database.FromContext(c).GetRepo(c, b.GetRepoID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get repo for build %d: %w", id, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, explainBuild(c, r, b))
+}
+
+// explainBuild is a helper function to capture the
+// parts of the explanation for the build in the repo.
+func explainBuild(c *gin.Context, r *library.Repo, b *library.Build) *types.BuildExplanation {
+	db := database.FromContext(c)
+
+	e := new(types.BuildExplanation)
+	e.SetRepo(r.GetFullName())
+	e.SetBuild(b)
+	e.SetSkips([]*types.StepSkip{})
+	e.SetDeprecations([]string{})
+	e.SetSteps([]*library.Step{})
+
+	errs := []string{}
+
+	if len(b.GetError()) > 0 {
+		errs = append(errs, fmt.Sprintf("build: %s", b.GetError()))
+	}
+
+	// send API call to capture the webhook that created the build
+	h, err := db.GetHookForBuild(c, b)
+	if err != nil {
+		errs = appendExplainError(errs, "unable to get hook", err)
+	} else {
+		e.SetHook(h)
+
+		if len(h.GetError()) > 0 {
+			errs = append(errs, fmt.Sprintf("hook %d: %s", h.GetNumber(), h.GetError()))
+		}
+	}
+
+	// send API call to capture the stages and steps skipped by their rulesets
+	skips, err := db.ListStepSkipsForBuild(c, b)
+	if err != nil {
+		errs = appendExplainError(errs, "unable to list step skips", err)
+	} else {
+		e.SetSkips(skips)
+	}
+
+	// capture the report retained from compiling the build
+	e.SetReport(report.FromContext(c).Get(b.GetID()))
+
+	// send API call to capture the deprecations for the build
+	d, err := db.GetBuildDeprecationsForBuild(c, b)
+	if err != nil {
+		errs = appendExplainError(errs, "unable to get deprecations", err)
+	} else {
+		e.SetDeprecations(d.GetWarnings())
+	}
+
+	route, group, err := explainRoute(c, r, b)
+	if err != nil {
+		errs = appendExplainError(errs, "unable to explain route", err)
+	}
+
+	e.SetRoute(route)
+	e.SetWorkerGroup(group)
+
+	if len(b.GetHost()) > 0 {
+		// send API call to capture the worker assigned the build
+		w, err := db.GetWorkerForHostname(c, b.GetHost())
+		if err != nil {
+			errs = appendExplainError(errs, fmt.Sprintf("unable to get worker %s", b.GetHost()), err)
+		} else {
+			e.SetWorker(w)
+		}
+	}
+
+	// send API call to capture the steps for the build
+	steps, err := db.GetBuildStepList(c, b, 1, explainStepLimit)
+	if err != nil {
+		errs = appendExplainError(errs, "unable to list steps", err)
+	} else {
+		e.SetSteps(steps)
+	}
+
+	for _, s := range e.GetSteps() {
+		if len(s.GetError()) > 0 {
+			errs = append(errs, fmt.Sprintf("step %s: %s", s.GetName(), s.GetError()))
+		}
+	}
+
+	enqueue, wait, run := explainDurations(b)
+	e.SetEnqueueDuration(enqueue)
+	e.SetWaitDuration(wait)
+	e.SetRunDuration(run)
+
+	e.SetErrors(errs)
+
+	return e
+}
+
+// explainRoute is a helper function to decide the queue route the
+// build was published to along with the worker group the repo is
+// pinned to. The route for the worker group takes precedence over
+// the route for the worker flavor and platform of the pipeline.
+func explainRoute(c *gin.Context, r *library.Repo, b *library.Build) (string, string, error) {
+	db := database.FromContext(c)
+
+	// send API call to capture the worker group for the repo
+	g, err := workerGroupForRepo(c, db, r)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(g.GetRoute()) > 0 {
+		return g.GetRoute(), g.GetName(), nil
+	}
+
+	// send API call to capture the pipeline for the build
+	p, err := db.GetPipeline(c, b.GetPipelineID())
+	if err != nil {
+		return "", "", fmt.Errorf("unable to get pipeline for build %d: %w", b.GetNumber(), err)
+	}
+
+	// send API call to capture the repo owner
+	owner, err := db.GetUser(c, r.GetUserID())
+	if err != nil {
+		return "", "", fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
+	}
+
+	// parse the pipeline configuration to capture the worker
+	parsed, _, err := compiler.FromContext(c).Duplicate().WithContext(c.Request.Context()).WithRepo(r).WithUser(owner).
+		Parse(p.GetData(), p.GetType(), new(yaml.Template))
+	if err != nil {
+		return "", "", fmt.Errorf("unable to parse pipeline for build %d: %w", b.GetNumber(), err)
+	}
+
+	route, err := queue.FromGinContext(c).Route(&pipeline.Worker{
+		Flavor:   parsed.Worker.Flavor,
+		Platform: parsed.Worker.Platform,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return route, "", nil
+}
+
+// explainDurations is a helper function to break the time for the
+// build down into the seconds spent compiling and publishing it,
+// waiting in the queue for a worker and running on the worker.
+// The duration for a phase the build hasn't finished is 0.
+func explainDurations(b *library.Build) (int64, int64, int64) {
+	between := func(start, end int64) int64 {
+		if start <= 0 || end < start {
+			return 0
+		}
+
+		return end - start
+	}
+
+	return between(b.GetCreated(), b.GetEnqueued()),
+		between(b.GetEnqueued(), b.GetStarted()),
+		between(b.GetStarted(), b.GetFinished())
+}
+
+// appendExplainError is a helper function to record the failure to
+// capture a part of the explanation. Parts that don't exist for the
+// build, like deprecations for a build without any, aren't errors.
+func appendExplainError(errs []string, msg string, err error) []string {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errs
+	}
+
+	return append(errs, fmt.Sprintf("%s: %v", msg, err))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/library"
+	"gorm.io/gorm"
+)
+
+func TestAPI_explainDurations(t *testing.T) {
+	// setup types
+	finished := new(library.Build)
+	finished.SetCreated(1563474076)
+	finished.SetEnqueued(1563474077)
+	finished.SetStarted(1563474080)
+	finished.SetFinished(1563474140)
+
+	pending := new(library.Build)
+	pending.SetCreated(1563474076)
+	pending.SetEnqueued(1563474077)
+
+	// setup tests
+	tests := []struct {
+		name  string
+		build *library.Build
+		want  []int64
+	}{
+		{
+			name:  "finished build",
+			build: finished,
+			want:  []int64{1, 3, 60},
+		},
+		{
+			name:  "pending build",
+			build: pending,
+			want:  []int64{1, 0, 0},
+		},
+		{
+			name:  "empty build",
+			build: new(library.Build),
+			want:  []int64{0, 0, 0},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enqueue, wait, run := explainDurations(test.build)
+
+			got := []int64{enqueue, wait, run}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("explainDurations is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAPI_appendExplainError(t *testing.T) {
+	// run test
+	got := appendExplainError([]string{}, "unable to get hook", gorm.ErrRecordNotFound)
+	got = appendExplainError(got, "unable to list steps", errors.New("database error"))
+
+	want := []string{"unable to list steps: database error"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("appendExplainError is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/enrich"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// verifyFreeze is a helper function to verify the build is not blocked by
+// an active change freeze for the org of the repo or for every org. When
+// the override_freeze query parameter is set, platform admins may override
+// any freeze and org admins may override the freezes for their org, which
+// is recorded in the audits for the freeze.
+func verifyFreeze(c *gin.Context, u *library.User, r *library.Repo, b *library.Build) error {
+	now := time.Now().UTC()
+
+	// send API call to capture the active freezes for the org
+	freezes, err := database.FromContext(c).ListActiveFreezes(c, r.GetOrg(), now.Unix())
+	if err != nil {
+		return fmt.Errorf("unable to list freezes for org %s: %w", r.GetOrg(), err)
+	}
+
+	override, _ := strconv.ParseBool(c.Query("override_freeze"))
+
+	for _, f := range freezes {
+		if !f.Blocks(r.GetFullName(), b.GetEvent(), now.Unix()) {
+			continue
+		}
+
+		if !override || !freezeAdmin(c, u, f) {
+			return freezeError(r, b, f)
+		}
+
+		audit := new(types.FreezeAudit)
+		audit.SetFreezeID(f.GetID())
+		audit.SetOrg(f.GetOrg())
+		audit.SetAction(types.FreezeAuditOverridden)
+		audit.SetActor(u.GetName())
+		audit.SetRepo(r.GetFullName())
+		audit.SetEvent(b.GetEvent())
+		audit.SetMessage(fmt.Sprintf("%s build for commit %s overrode freeze %s", b.GetEvent(), b.GetCommit(), f.GetName()))
+		audit.SetCreated(now.Unix())
+
+		// capture the details about the client that made the request
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/enrich?tab=doc#Enricher.Enrich
+		d := enrich.FromContext(c).Enrich(c, c.Request, c.ClientIP())
+		audit.SetIP(d.IP)
+		audit.SetUserAgent(d.UserAgent)
+		audit.SetClient(d.Client)
+		audit.SetLocation(d.Location)
+
+		// send API call to record the override of the freeze
+		err = database.FromContext(c).CreateFreezeAudit(c, audit)
+		if err != nil {
+			return fmt.Errorf("unable to record override of freeze %s: %w", f.GetName(), err)
+		}
+
+		logrus.Infof("user %s overrode freeze %s for %s", u.GetName(), f.GetName(), r.GetFullName())
+	}
+
+	return nil
+}
+
+// freezeAdmin is a helper function to check if the user may override the
+// freeze, which requires admin access to the platform or, for a freeze
+// belonging to an org, admin access to the org.
+func freezeAdmin(c *gin.Context, u *library.User, f *types.Freeze) bool {
+	if u.GetAdmin() {
+		return true
+	}
+
+	if len(f.GetOrg()) == 0 || u.GetID() == 0 {
+		return false
+	}
+
+	// send API call to capture the access level of the user for the org
+	perm, err := scm.FromContext(c).OrgAccess(u, f.GetOrg())
+	if err != nil {
+		logrus.Errorf("unable to get user %s access level for org %s: %v", u.GetName(), f.GetOrg(), err)
+	}
+
+	return strings.EqualFold(perm, "admin")
+}
+
+// freezeError is a helper function to describe why the build
+// for the repo is blocked by the freeze and when it ends.
+func freezeError(r *library.Repo, b *library.Build, f *types.Freeze) error {
+	scope := fmt.Sprintf("org %s", f.GetOrg())
+	if len(f.GetOrg()) == 0 {
+		scope = "every org"
+	}
+
+	err := fmt.Errorf("%s builds for %s are frozen by freeze %s for %s until %s",
+		b.GetEvent(), r.GetFullName(), f.GetName(), scope,
+		time.Unix(f.GetEnds(), 0).UTC().Format(time.RFC3339),
+	)
+
+	if len(f.GetReason()) > 0 {
+		err = fmt.Errorf("%w: %s", err, f.GetReason())
+	}
+
+	return err
+}
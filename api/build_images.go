@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/images builds GetBuildImages
+//
+// Get the images pinned to digests for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to retrieve the images for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the images for the build
+//     schema:
+//       "$ref": "#/definitions/BuildImages"
+//   '404':
+//     description: Unable to retrieve the images for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the images for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildImages represents the API handler to capture the
+// images pinned to digests when a build was compiled.
+func GetBuildImages(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading images for build %s", entry)
+
+	// send API call to capture the images for the build
+	i, err := database.FromContext(c).GetBuildImagesForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get images for build %s: %w", entry, err)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, i)
+}
+
+// saveBuildImages is a helper function to persist the images
+// pinned to digests by the provided compiler for the build.
+func saveBuildImages(ctx context.Context, db database.Service, e compiler.Engine, b *library.Build) {
+	if e == nil || len(e.Images()) == 0 {
+		return
+	}
+
+	i := new(types.BuildImages)
+	i.SetBuildID(b.GetID())
+	i.SetRepoID(b.GetRepoID())
+	i.SetImages(e.Images())
+	i.SetCreated(time.Now().UTC().Unix())
+
+	// send API call to create the images for the build
+	err := db.CreateBuildImages(ctx, i)
+	if err != nil {
+		logrus.Errorf("unable to create images for build %d: %v", b.GetID(), err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/labels builds GetBuildLabels
+//
+// Get the pull request labels captured for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to retrieve the labels for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the labels for the build
+//     schema:
+//       "$ref": "#/definitions/BuildLabels"
+//   '404':
+//     description: Unable to retrieve the labels for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the labels for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildLabels represents the API handler to capture the
+// pull request labels persisted for a build.
+func GetBuildLabels(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading labels for build %s", entry)
+
+	// send API call to capture the labels for the build
+	l, err := database.FromContext(c).GetBuildLabelsForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get labels for build %s: %w", entry, err)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, l)
+}
+
+// saveBuildLabels is a helper function to persist the pull
+// request labels from the provided context for the build.
+func saveBuildLabels(ctx context.Context, db database.Service, b *library.Build, bc *types.BuildContext) {
+	// only pull request builds carry labels
+	if b.GetEvent() != constants.EventPull {
+		return
+	}
+
+	l := new(types.BuildLabels)
+	l.SetBuildID(b.GetID())
+	l.SetRepoID(b.GetRepoID())
+	l.SetAction(b.GetEventAction())
+	l.SetLabels(bc.GetLabels())
+	l.SetCreated(time.Now().UTC().Unix())
+
+	// send API call to create the labels for the build
+	err := db.CreateBuildLabels(ctx, l)
+	if err != nil {
+		logrus.Errorf("unable to create labels for build %d: %v", b.GetID(), err)
+	}
+}
@@ -0,0 +1,515 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/raw"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/redactions builds CreateBuildRedaction
+//
+// Redact values from, or delete, the data captured for a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the action, targets and values to redact
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/BuildRedaction"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully redacted the data for the build
+//     schema:
+//       "$ref": "#/definitions/BuildRedaction"
+//   '400':
+//     description: Unable to redact the data for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to redact the data for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateBuildRedaction represents the API handler to redact the provided
+// values from, or delete, the logs, artifacts and environment captured
+// for a build. This covers secrets that were accidentally printed by
+// a build. A record of the redaction is kept, without the values.
+func CreateBuildRedaction(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// capture body from API request
+	input := new(types.BuildRedaction)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for redaction for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// default the action to redacting the values
+	if input.Action == nil {
+		input.SetAction(types.BuildRedactionRedacted)
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logger := logrus.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"org":     o,
+		"repo":    r.GetName(),
+		"user":    u.GetName(),
+		"action":  input.GetAction(),
+		"targets": input.GetTargets(),
+	})
+
+	logger.Infof("redacting %s for build %s", strings.Join(input.GetTargets(), ", "), entry)
+
+	err = validateBuildRedaction(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to redact build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	var matches int64
+
+	for _, target := range input.GetTargets() {
+		var n int64
+
+		switch input.GetAction() {
+		case types.BuildRedactionDeleted:
+			n, err = deleteBuildTarget(c, database.FromContext(c), b, target)
+		default:
+			n, err = redactBuildTarget(c, database.FromContext(c), b, target, input.GetValues())
+		}
+
+		if err != nil {
+			retErr := fmt.Errorf("unable to redact %s for build %s: %w", target, entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		matches += n
+	}
+
+	// create the record of the redaction without the values
+	redaction := new(types.BuildRedaction)
+	redaction.SetRepoID(r.GetID())
+	redaction.SetBuildID(b.GetID())
+	redaction.SetAction(input.GetAction())
+	redaction.SetTargets(input.GetTargets())
+	redaction.SetReason(input.GetReason())
+	redaction.SetMatches(matches)
+	redaction.SetActor(u.GetName())
+	redaction.SetCreated(time.Now().UTC().Unix())
+
+	// send API call to create the record of the redaction
+	err = database.FromContext(c).CreateBuildRedaction(c, redaction)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create redaction record for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	logger.WithField("matches", matches).Infof("%s %s for build %s", input.GetAction(), strings.Join(input.GetTargets(), ", "), entry)
+
+	c.JSON(http.StatusCreated, redaction)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/redactions builds ListBuildRedactions
+//
+// Get the records of the data for a build being redacted or deleted
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the redactions for the build
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/BuildRedaction"
+//   '500':
+//     description: Unable to retrieve the redactions for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListBuildRedactions represents the API handler to capture the
+// records of the data for a build being redacted or deleted.
+func ListBuildRedactions(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("listing redactions for build %s", entry)
+
+	// send API call to capture the redactions for the build
+	redactions, err := database.FromContext(c).ListBuildRedactionsForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list redactions for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, redactions)
+}
+
+// validateBuildRedaction is a helper function to verify
+// the provided redaction can be applied to a build.
+func validateBuildRedaction(r *types.BuildRedaction) error {
+	if len(r.GetTargets()) == 0 {
+		return fmt.Errorf("no targets provided")
+	}
+
+	for _, target := range r.GetTargets() {
+		switch target {
+		case types.BuildRedactionTargetLogs, types.BuildRedactionTargetEnvironment:
+		case types.BuildRedactionTargetArtifacts:
+			// artifacts only reference the outputs of the build
+			// so the values can not be redacted from them
+			if r.GetAction() == types.BuildRedactionRedacted {
+				return fmt.Errorf("target %s can only be deleted", target)
+			}
+		default:
+			return fmt.Errorf("invalid target provided: %s", target)
+		}
+	}
+
+	switch r.GetAction() {
+	case types.BuildRedactionRedacted:
+		if len(r.GetValues()) == 0 {
+			return fmt.Errorf("no values provided to redact")
+		}
+
+		for _, value := range r.GetValues() {
+			if len(value) == 0 {
+				return fmt.Errorf("empty value provided to redact")
+			}
+		}
+	case types.BuildRedactionDeleted:
+	default:
+		return fmt.Errorf("invalid action provided: %s", r.GetAction())
+	}
+
+	return nil
+}
+
+// redactValues is a helper function to replace every occurrence
+// of the provided values in the data with the mask used for
+// secrets. The longest values are replaced first, so a value
+// that contains another value is masked as a whole. The number
+// of occurrences that were replaced is also returned.
+func redactValues(data []byte, values []string) ([]byte, int64) {
+	sorted := append([]string{}, values...)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+
+	var matches int64
+
+	for _, value := range sorted {
+		n := bytes.Count(data, []byte(value))
+		if n == 0 {
+			continue
+		}
+
+		matches += int64(n)
+		data = maskValues(data, []string{value})
+	}
+
+	return data, matches
+}
+
+// redactBuildTarget is a helper function to redact the provided
+// values from the data captured for the target of the build.
+func redactBuildTarget(ctx context.Context, db database.Service, b *library.Build, target string, values []string) (int64, error) {
+	switch target {
+	case types.BuildRedactionTargetLogs:
+		logs, err := buildLogs(ctx, db, b)
+		if err != nil {
+			return 0, err
+		}
+
+		var matches int64
+
+		for _, l := range logs {
+			data, n := redactValues(l.GetData(), values)
+			if n == 0 {
+				continue
+			}
+
+			l.SetData(data)
+
+			// send API call to update the log
+			err = db.UpdateLog(ctx, l)
+			if err != nil {
+				return 0, err
+			}
+
+			err = redactLogLines(ctx, db, l, values)
+			if err != nil {
+				return 0, err
+			}
+
+			matches += n
+		}
+
+		return matches, nil
+	case types.BuildRedactionTargetEnvironment:
+		var matches int64
+
+		redact := func(value string) string {
+			data, n := redactValues([]byte(value), values)
+			matches += n
+
+			return string(data)
+		}
+
+		payload := raw.StringSliceMap{}
+		for key, value := range b.GetDeployPayload() {
+			payload[key] = redact(value)
+		}
+
+		b.SetDeployPayload(payload)
+		b.SetMessage(redact(b.GetMessage()))
+		b.SetTitle(redact(b.GetTitle()))
+		b.SetError(redact(b.GetError()))
+
+		if matches == 0 {
+			return 0, nil
+		}
+
+		// send API call to update the build
+		return matches, db.UpdateBuild(ctx, b)
+	default:
+		return 0, fmt.Errorf("invalid target provided: %s", target)
+	}
+}
+
+// deleteBuildTarget is a helper function to delete the data
+// captured for the target of the build. The number of
+// entries that were deleted is returned.
+func deleteBuildTarget(ctx context.Context, db database.Service, b *library.Build, target string) (int64, error) {
+	switch target {
+	case types.BuildRedactionTargetLogs:
+		logs, err := buildLogs(ctx, db, b)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, l := range logs {
+			// send API call to remove the lines for the log
+			err = db.DeleteLinesForLog(ctx, l)
+			if err != nil {
+				return 0, err
+			}
+
+			// send API call to remove the log
+			err = db.DeleteLog(ctx, l)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		return int64(len(logs)), nil
+	case types.BuildRedactionTargetArtifacts:
+		// send API call to capture the artifacts for the build
+		artifacts, err := db.ListArtifactsForBuild(ctx, b)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(artifacts) == 0 {
+			return 0, nil
+		}
+
+		// send API call to remove the artifacts for the build
+		return int64(len(artifacts)), db.DeleteArtifactsForBuild(ctx, b)
+	case types.BuildRedactionTargetEnvironment:
+		matches := int64(len(b.GetDeployPayload()))
+		if matches == 0 {
+			return 0, nil
+		}
+
+		b.SetDeployPayload(raw.StringSliceMap{})
+
+		// send API call to update the build
+		return matches, db.UpdateBuild(ctx, b)
+	default:
+		return 0, fmt.Errorf("invalid target provided: %s", target)
+	}
+}
+
+// buildLogs is a helper function to capture
+// every log for the steps and services of a build.
+func buildLogs(ctx context.Context, db database.Service, b *library.Build) ([]*library.Log, error) {
+	logs := []*library.Log{}
+	page := 1
+	perPage := 100
+
+	for page > 0 {
+		// send API call to capture the logs (per page) for the build
+		logsPart, _, err := db.ListLogsForBuild(ctx, b, page, perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		// add page of logs to list logs
+		logs = append(logs, logsPart...)
+
+		// assume no more pages exist if under 100 results are returned
+		if len(logsPart) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	return logs, nil
+}
+
+// redactLogLines is a helper function to replace the
+// structured lines for the log with redacted copies.
+func redactLogLines(ctx context.Context, db database.Service, l *library.Log, values []string) error {
+	lines := []*types.LogLine{}
+	page := 1
+
+	for page > 0 {
+		// send API call to capture the lines (per page) for the log
+		linesPart, _, err := db.ListLinesForLog(ctx, l, page, maxLogLines)
+		if err != nil {
+			return err
+		}
+
+		// add page of lines to list lines
+		lines = append(lines, linesPart...)
+
+		if len(linesPart) < maxLogLines {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	var matches int64
+
+	for _, line := range lines {
+		data, n := redactValues([]byte(line.GetData()), values)
+
+		line.SetData(string(data))
+		matches += n
+	}
+
+	if matches == 0 {
+		return nil
+	}
+
+	// send API call to remove the lines for the log
+	err := db.DeleteLinesForLog(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(lines); start += maxLogLines {
+		end := util.MinInt(start+maxLogLines, len(lines))
+
+		// send API call to create the redacted lines (per page) for the log
+		err = db.CreateLogLines(ctx, lines[start:end])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
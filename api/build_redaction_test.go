@@ -0,0 +1,321 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/raw"
+)
+
+func Test_validateBuildRedaction(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name      string
+		failure   bool
+		redaction *types.BuildRedaction
+	}{
+		{
+			name:    "redact logs and environment",
+			failure: false,
+			redaction: &types.BuildRedaction{
+				Action:  stringPtr(types.BuildRedactionRedacted),
+				Targets: &[]string{"logs", "environment"},
+				Values:  &[]string{"abc123"},
+			},
+		},
+		{
+			name:    "delete logs and artifacts",
+			failure: false,
+			redaction: &types.BuildRedaction{
+				Action:  stringPtr(types.BuildRedactionDeleted),
+				Targets: &[]string{"logs", "artifacts"},
+			},
+		},
+		{
+			name:    "no targets",
+			failure: true,
+			redaction: &types.BuildRedaction{
+				Action: stringPtr(types.BuildRedactionDeleted),
+			},
+		},
+		{
+			name:    "invalid target",
+			failure: true,
+			redaction: &types.BuildRedaction{
+				Action:  stringPtr(types.BuildRedactionDeleted),
+				Targets: &[]string{"secrets"},
+			},
+		},
+		{
+			name:    "redact artifacts",
+			failure: true,
+			redaction: &types.BuildRedaction{
+				Action:  stringPtr(types.BuildRedactionRedacted),
+				Targets: &[]string{"artifacts"},
+				Values:  &[]string{"abc123"},
+			},
+		},
+		{
+			name:    "redact without values",
+			failure: true,
+			redaction: &types.BuildRedaction{
+				Action:  stringPtr(types.BuildRedactionRedacted),
+				Targets: &[]string{"logs"},
+			},
+		},
+		{
+			name:    "redact empty value",
+			failure: true,
+			redaction: &types.BuildRedaction{
+				Action:  stringPtr(types.BuildRedactionRedacted),
+				Targets: &[]string{"logs"},
+				Values:  &[]string{""},
+			},
+		},
+		{
+			name:    "invalid action",
+			failure: true,
+			redaction: &types.BuildRedaction{
+				Action:  stringPtr("updated"),
+				Targets: &[]string{"logs"},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateBuildRedaction(test.redaction)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("validateBuildRedaction for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("validateBuildRedaction for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
+
+func Test_redactValues(t *testing.T) {
+	// setup types
+	data := []byte("token abc123 for https://deploy.example.com/hooks/abc123")
+	want := []byte("token [secure] for [secure]")
+
+	// run test
+	got, matches := redactValues(data, []string{"abc123", "https://deploy.example.com/hooks/abc123"})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("redactValues is %s, want %s", got, want)
+	}
+
+	if matches != 2 {
+		t.Errorf("redactValues matches is %d, want %d", matches, 2)
+	}
+}
+
+func Test_redactBuildTarget(t *testing.T) {
+	// setup types
+	_build := testRedactionBuild()
+
+	_log := new(library.Log)
+	_log.SetID(1)
+	_log.SetBuildID(1)
+	_log.SetRepoID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("echo abc123\nabc123\n"))
+
+	_line := new(types.LogLine)
+	_line.SetBuildID(1)
+	_line.SetLogID(1)
+	_line.SetNumber(1)
+	_line.SetStream(types.LogStreamStdout)
+	_line.SetData("abc123")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	err = db.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log: %v", err)
+	}
+
+	err = db.CreateLogLines(context.TODO(), []*types.LogLine{_line})
+	if err != nil {
+		t.Errorf("unable to create test log line: %v", err)
+	}
+
+	// run test for logs
+	matches, err := redactBuildTarget(context.TODO(), db, _build, types.BuildRedactionTargetLogs, []string{"abc123"})
+	if err != nil {
+		t.Errorf("redactBuildTarget for logs returned err: %v", err)
+	}
+
+	if matches != 2 {
+		t.Errorf("redactBuildTarget for logs matches is %d, want %d", matches, 2)
+	}
+
+	gotLog, err := db.GetLogForStep(context.TODO(), &library.Step{ID: _log.StepID})
+	if err != nil {
+		t.Errorf("unable to get step log: %v", err)
+	}
+
+	if !reflect.DeepEqual(gotLog.GetData(), []byte("echo [secure]\n[secure]\n")) {
+		t.Errorf("redactBuildTarget log data is %s", gotLog.GetData())
+	}
+
+	gotLines, _, err := db.ListLinesForLog(context.TODO(), gotLog, 1, 10)
+	if err != nil {
+		t.Errorf("unable to list log lines: %v", err)
+	}
+
+	if len(gotLines) != 1 || gotLines[0].GetData() != "[secure]" {
+		t.Errorf("redactBuildTarget log lines are %v", gotLines)
+	}
+
+	// run test for environment
+	matches, err = redactBuildTarget(context.TODO(), db, _build, types.BuildRedactionTargetEnvironment, []string{"abc123"})
+	if err != nil {
+		t.Errorf("redactBuildTarget for environment returned err: %v", err)
+	}
+
+	if matches != 2 {
+		t.Errorf("redactBuildTarget for environment matches is %d, want %d", matches, 2)
+	}
+
+	gotBuild, err := db.GetBuildByID(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("unable to get build: %v", err)
+	}
+
+	if gotBuild.GetDeployPayload()["token"] != "[secure]" || gotBuild.GetMessage() != "rotate [secure]" {
+		t.Errorf("redactBuildTarget build is %v", gotBuild)
+	}
+}
+
+func Test_deleteBuildTarget(t *testing.T) {
+	// setup types
+	_build := testRedactionBuild()
+
+	_log := new(library.Log)
+	_log.SetID(1)
+	_log.SetBuildID(1)
+	_log.SetRepoID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("abc123"))
+
+	_artifact := new(types.Artifact)
+	_artifact.SetRepoID(1)
+	_artifact.SetBuildID(1)
+	_artifact.SetName("vela-server")
+	_artifact.SetKind("binary")
+	_artifact.SetDigest("sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1")
+	_artifact.SetURI("https://artifacts.example.com/vela-server?token=abc123")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	err = db.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log: %v", err)
+	}
+
+	err = db.CreateArtifact(context.TODO(), _artifact)
+	if err != nil {
+		t.Errorf("unable to create test artifact: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		target string
+		want   int64
+	}{
+		{
+			target: types.BuildRedactionTargetLogs,
+			want:   1,
+		},
+		{
+			target: types.BuildRedactionTargetArtifacts,
+			want:   1,
+		},
+		{
+			target: types.BuildRedactionTargetEnvironment,
+			want:   1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.target, func(t *testing.T) {
+			got, err := deleteBuildTarget(context.TODO(), db, _build, test.target)
+			if err != nil {
+				t.Errorf("deleteBuildTarget for %s returned err: %v", test.target, err)
+			}
+
+			if got != test.want {
+				t.Errorf("deleteBuildTarget for %s is %d, want %d", test.target, got, test.want)
+			}
+
+			// deleting again finds nothing left to delete
+			got, err = deleteBuildTarget(context.TODO(), db, _build, test.target)
+			if err != nil {
+				t.Errorf("deleteBuildTarget for %s returned err: %v", test.target, err)
+			}
+
+			if got != 0 {
+				t.Errorf("deleteBuildTarget for %s is %d, want %d", test.target, got, 0)
+			}
+		})
+	}
+}
+
+// testRedactionBuild is a test helper function to create
+// a build with a secret printed in the environment.
+func testRedactionBuild() *library.Build {
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetRepoID(1)
+	b.SetNumber(1)
+	b.SetEvent("deployment")
+	b.SetMessage("rotate abc123")
+	b.SetDeployPayload(raw.StringSliceMap{"token": "abc123"})
+
+	return b
+}
+
+// stringPtr is a test helper function to
+// return a pointer to the provided string.
+func stringPtr(v string) *string {
+	return &v
+}
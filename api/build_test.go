@@ -5,8 +5,23 @@
 package api
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/report"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/queue/redis"
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/pipeline"
 )
 
@@ -78,3 +93,301 @@ func Test_skipEmptyBuild(t *testing.T) {
 		})
 	}
 }
+
+func Test_skipLabelBuild(t *testing.T) {
+	// setup types
+	opted := &pipeline.Build{Steps: []*pipeline.Container{
+		{
+			Name: "preview",
+			Ruleset: pipeline.Ruleset{
+				If: pipeline.Rules{Event: []string{"pull_request:labeled"}},
+			},
+		},
+	}}
+
+	other := &pipeline.Build{Stages: []*pipeline.Stage{
+		{
+			Name: "test",
+			Steps: []*pipeline.Container{
+				{
+					Name: "test",
+					Ruleset: pipeline.Ruleset{
+						If: pipeline.Rules{Event: []string{"pull_request", "push"}},
+					},
+				},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name   string
+		p      *pipeline.Build
+		event  string
+		action string
+		want   string
+	}{
+		{"push event", other, "push", "", ""},
+		{"opened action", other, "pull_request", "opened", ""},
+		{"labeled action opted in", opted, "pull_request", "labeled", ""},
+		{"labeled action not opted in", other, "pull_request", "labeled",
+			"skipping build since no steps run for the pull_request:labeled event"},
+		{"unlabeled action not opted in", opted, "pull_request", "unlabeled",
+			"skipping build since no steps run for the pull_request:unlabeled event"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(library.Build)
+			b.SetEvent(tt.event)
+			b.SetEventAction(tt.action)
+
+			if got := skipLabelBuild(tt.p, b); got != tt.want {
+				t.Errorf("skipLabelBuild() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_planBuild(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+
+	_pipeline := &pipeline.Build{
+		Services: pipeline.ContainerSlice{
+			{Name: "postgres", Image: "postgres:latest", Number: 1, Environment: map[string]string{}},
+		},
+		Steps: pipeline.ContainerSlice{
+			{Name: "clone", Image: "target/vela-git:v0.4.0", Number: 1, Environment: map[string]string{}},
+			{Name: "test", Image: "golang:latest", Number: 2, Environment: map[string]string{}},
+		},
+	}
+
+	// the step without a name fails validation after the build and services are created
+	_invalid := &pipeline.Build{
+		Services: _pipeline.Services,
+		Steps: pipeline.ContainerSlice{
+			{Name: "clone", Image: "target/vela-git:v0.4.0", Number: 1, Environment: map[string]string{}},
+			{Image: "golang:latest", Number: 2, Environment: map[string]string{}},
+		},
+	}
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		name     string
+		number   int
+		pipeline *pipeline.Build
+		failure  bool
+	}{
+		{
+			name:     "build created",
+			number:   1,
+			pipeline: _pipeline,
+		},
+		{
+			name:     "build rolled back",
+			number:   2,
+			pipeline: _invalid,
+			failure:  true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_build := new(library.Build)
+			_build.SetRepoID(1)
+			_build.SetNumber(test.number)
+
+			err := planBuild(context.TODO(), db, test.pipeline, _build, _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("planBuild should have returned err")
+				}
+
+				_, err = db.GetBuild(context.TODO(), test.number, _repo)
+				if err == nil {
+					t.Errorf("planBuild should not have left build %d behind", test.number)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("planBuild returned err: %v", err)
+			}
+
+			b, err := db.GetBuild(context.TODO(), test.number, _repo)
+			if err != nil {
+				t.Errorf("unable to get build %d: %v", test.number, err)
+			}
+
+			steps, err := db.GetBuildStepList(context.TODO(), b, 1, 10)
+			if err != nil {
+				t.Errorf("unable to list steps for build %d: %v", test.number, err)
+			}
+
+			if len(steps) != 2 {
+				t.Errorf("planBuild created %d steps, want %d", len(steps), 2)
+			}
+		})
+	}
+
+	// the services for the rolled back build are not left behind
+	services, err := db.GetServiceList(context.TODO())
+	if err != nil {
+		t.Errorf("unable to list services: %v", err)
+	}
+
+	if len(services) != 1 {
+		t.Errorf("planBuild left %d services, want %d", len(services), 1)
+	}
+}
+
+// testEngine represents a compiler that only reports
+// the records produced when compiling the pipeline.
+type testEngine struct {
+	compiler.Engine
+
+	deprecated []*types.Deprecation
+	report     *types.CompileReport
+}
+
+func (e *testEngine) Deprecated() []*types.Deprecation { return e.deprecated }
+func (e *testEngine) Images() []string                 { return nil }
+func (e *testEngine) Report() *types.CompileReport     { return e.report }
+func (e *testEngine) Skipped() []*types.StepSkip       { return nil }
+
+func Test_createBuild(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	// setup mock server
+	engine.POST("/api/v3/repos/github/octocat/statuses/:sha", func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := github.NewTest(s.URL)
+
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+	_repo.SetVisibility("public")
+	_repo.SetCounter(1)
+
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+	_user.SetToken("foo")
+
+	_build := new(library.Build)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetEvent(constants.EventPush)
+	_build.SetStatus(constants.StatusPending)
+	_build.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+	_pipeline := &pipeline.Build{
+		Steps: pipeline.ContainerSlice{
+			{Name: "clone", Image: "target/vela-git:v0.4.0", Number: 1, Environment: map[string]string{}},
+		},
+	}
+
+	_deprecation := new(types.Deprecation)
+	_deprecation.SetKind("image")
+	_deprecation.SetSubject("target/vela-git:v0.4.0")
+
+	_engine := &testEngine{
+		deprecated: []*types.Deprecation{_deprecation},
+		report:     new(types.CompileReport),
+	}
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	_queue, err := redis.New(
+		redis.WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+		redis.WithChannels(constants.DefaultRoute),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	reports := report.New(10)
+
+	// run test
+	got, err := createBuild(context.TODO(), db, reports, client, _queue, nil, _engine, _pipeline, _build, _repo, _user)
+	if err != nil {
+		t.Errorf("createBuild returned err: %v", err)
+	}
+
+	if got.GetID() == 0 {
+		t.Errorf("createBuild should have returned the created build")
+	}
+
+	if reports.Get(got.GetID()) == nil {
+		t.Errorf("createBuild should have retained the compile report")
+	}
+
+	d, err := db.GetBuildDeprecationsForBuild(context.TODO(), got)
+	if err != nil {
+		t.Errorf("createBuild should have saved the deprecations: %v", err)
+	}
+
+	if len(d.GetWarnings()) != 1 {
+		t.Errorf("createBuild deprecation warnings are %v, want 1", d.GetWarnings())
+	}
+
+	// wait for the build to be published to the queue
+	for i := 0; i < 50; i++ {
+		got, _ = db.GetBuild(context.TODO(), 1, _repo)
+		if got.GetEnqueued() > 0 {
+			break
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	if got.GetEnqueued() == 0 {
+		t.Errorf("createBuild should have published the build to the queue")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/catalog"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/catalog/components catalog ListCatalogComponents
+//
+// Get every active repo as a component for a service catalog
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the components
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/CatalogComponent"
+//   '500':
+//     description: Unable to retrieve the components
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListCatalogComponents represents the API handler to capture
+// every active repo as a component for a service catalog.
+func ListCatalogComponents(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Info("reading service catalog components")
+
+	// send API call to capture the components
+	components, err := catalog.FromContext(c).Components()
+	if err != nil {
+		retErr := fmt.Errorf("unable to get service catalog components: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, components)
+}
+
+// swagger:operation GET /api/v1/catalog/components/{org}/{repo} catalog GetCatalogComponent
+//
+// Get a repo as a component for a service catalog
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the component
+//     schema:
+//       "$ref": "#/definitions/CatalogComponent"
+//   '500':
+//     description: Unable to retrieve the component
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetCatalogComponent represents the API handler to
+// capture a repo as a component for a service catalog.
+func GetCatalogComponent(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("reading service catalog component for repo %s", r.GetFullName())
+
+	// send API call to capture the component
+	component, err := catalog.FromContext(c).Component(r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get service catalog component for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, component)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/report"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/report builds GetCompileReport
+//
+// Get the compilation report for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to retrieve the report for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the compilation report for the build
+//     schema:
+//       "$ref": "#/definitions/CompileReport"
+//   '404':
+//     description: Unable to retrieve the compilation report for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetCompileReport represents the API handler to capture the
+// report from compiling the pipeline for a build.
+func GetCompileReport(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading compilation report for build %s", entry)
+
+	// capture the report for the build
+	rep := report.FromContext(c).Get(b.GetID())
+	if rep == nil {
+		retErr := fmt.Errorf("unable to get compilation report for build %s: report not retained", entry)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rep)
+}
+
+// saveCompileReport is a helper function to retain the report
+// from the provided compiler for the provided build.
+func saveCompileReport(rs *report.Store, e compiler.Engine, b *library.Build) {
+	if e == nil || e.Report() == nil {
+		return
+	}
+
+	rep := e.Report()
+	rep.SetBuildID(b.GetID())
+
+	rs.Add(rep)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// verifyDeployApproval is a helper function to verify the commit for a
+// deployment build was approved on a pull request according to the policy
+// attached by the DeployApprovals middleware. Builds for other events and
+// servers without a policy are always allowed.
+func verifyDeployApproval(c *gin.Context, u *library.User, r *library.Repo, b *library.Build) error {
+	if !strings.EqualFold(b.GetEvent(), constants.EventDeploy) {
+		return nil
+	}
+
+	// capture the policy for deployment builds
+	approvals, _ := c.Value("deployApprovals").(int)
+	team, _ := c.Value("deployApprovalTeam").(string)
+
+	if approvals <= 0 && len(team) == 0 {
+		return nil
+	}
+
+	s := scm.FromContext(c)
+
+	// send API call to capture the pull requests for the commit
+	numbers, err := s.ListCommitPullRequests(u, r, b.GetCommit())
+	if err != nil {
+		return fmt.Errorf("unable to list pull requests for %s commit %s: %w", r.GetFullName(), b.GetCommit(), err)
+	}
+
+	members := []string{}
+
+	if len(team) > 0 {
+		// send API call to capture the members of the team
+		members, err = s.ListTeamMembers(u, r.GetOrg(), team)
+		if err != nil {
+			return fmt.Errorf("unable to list members of team %s/%s: %w", r.GetOrg(), team, err)
+		}
+	}
+
+	for _, number := range numbers {
+		// send API call to capture the approvers for the pull request
+		approvers, err := s.ListPullRequestApprovers(u, r, number)
+		if err != nil {
+			return fmt.Errorf("unable to list approvers for %s/pull/%d: %w", r.GetFullName(), number, err)
+		}
+
+		if deployApproved(approvers, members, approvals, team) {
+			return nil
+		}
+	}
+
+	policy := fmt.Sprintf("%d approvals", approvals)
+	if len(team) > 0 {
+		policy = fmt.Sprintf("%s and an approval from team %s/%s", policy, r.GetOrg(), team)
+	}
+
+	return fmt.Errorf("commit %s for %s has no pull request with %s", b.GetCommit(), r.GetFullName(), policy)
+}
+
+// deployApproved is a helper function to check if the approvers for
+// a pull request satisfy the number of approvals and, when a team is
+// provided, include at least one member of the team.
+func deployApproved(approvers, members []string, approvals int, team string) bool {
+	if len(approvers) < approvals {
+		return false
+	}
+
+	if len(team) == 0 {
+		return true
+	}
+
+	for _, approver := range approvers {
+		for _, member := range members {
+			if strings.EqualFold(approver, member) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"testing"
+)
+
+func TestAPI_deployApproved(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name      string
+		approvers []string
+		members   []string
+		approvals int
+		team      string
+		want      bool
+	}{
+		{
+			name:      "enough approvals",
+			approvers: []string{"octocat", "hubot"},
+			approvals: 2,
+			want:      true,
+		},
+		{
+			name:      "not enough approvals",
+			approvers: []string{"octocat"},
+			approvals: 2,
+			want:      false,
+		},
+		{
+			name:      "team member approved",
+			approvers: []string{"octocat"},
+			members:   []string{"Octocat", "monalisa"},
+			team:      "release",
+			want:      true,
+		},
+		{
+			name:      "no team member approved",
+			approvers: []string{"octocat", "hubot"},
+			members:   []string{"monalisa"},
+			approvals: 1,
+			team:      "release",
+			want:      false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := deployApproved(test.approvers, test.members, test.approvals, test.team)
+
+			if got != test.want {
+				t.Errorf("deployApproved is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
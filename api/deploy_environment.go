@@ -0,0 +1,146 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/catalog"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/deployments/{org}/{repo}/environments deployment GetDeployEnvironments
+//
+// Get the last successful deployment for each environment of a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the last deployment for each environment
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/EnvironmentDeploy"
+//   '500':
+//     description: Unable to retrieve the last deployment for each environment
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetDeployEnvironments represents the API handler to capture the
+// last successful deployment for each environment of a repo.
+func GetDeployEnvironments(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("reading deployed environments for repo %s", r.GetFullName())
+
+	// send API call to capture the last deployment build for each environment
+	b, err := database.FromContext(c).GetLastDeploymentBuildList(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get deployed environments for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, catalog.Deploys(b))
+}
+
+// swagger:operation GET /badge/{org}/{repo}/deploy.svg base GetDeployBadge
+//
+// Get a badge for the last successful deployment of the repo to an environment
+//
+// ---
+// produces:
+// - image/svg+xml
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org the repo belongs to
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo to get the badge for
+//   required: true
+//   type: string
+// - in: query
+//   name: environment
+//   description: Name of the environment to get the badge for
+//   type: string
+//   default: production
+// responses:
+//   '200':
+//     description: Successfully retrieved a deployment Badge
+//     schema:
+//       type: string
+
+// GetDeployBadge represents the API handler to return a badge with
+// the version last successfully deployed to an environment.
+func GetDeployBadge(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	environment := util.QueryParameter(c, "environment", "production")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+	}).Infof("creating deployment badge for repo %s on environment %s", r.GetFullName(), environment)
+
+	version := ""
+
+	// send API call to capture the last deployment build for each environment
+	b, err := database.FromContext(c).GetLastDeploymentBuildList(c, r)
+	if err == nil {
+		for _, d := range catalog.Deploys(b) {
+			if d.GetEnvironment() == environment {
+				version = d.GetVersion()
+			}
+		}
+	}
+
+	// set headers to prevent caching
+	c.Header("Content-Type", "image/svg+xml")
+	c.Header("Cache-Control", "no-cache, no-store, must-revalidate")
+	c.Header("Expires", "0") // passing invalid date sets resource as expired
+
+	c.String(http.StatusOK, badgeForDeploy(environment, version))
+}
@@ -225,7 +225,7 @@ func GetDeployments(c *gin.Context) {
 	dWithBs := []*library.Deployment{}
 
 	for _, deployment := range d {
-		b, err := database.FromContext(c).GetDeploymentBuildList(*deployment.URL)
+		b, err := database.FromContext(c).GetDeploymentBuildList(c, *deployment.URL)
 		if err != nil {
 			retErr := fmt.Errorf("unable to get builds for deployment %d: %w", deployment.GetID(), err)
 
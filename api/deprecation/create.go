@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecation
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/deprecations deprecations CreateDeprecationRule
+//
+// Create a deprecation rule in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the deprecation rule to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/DeprecationRule"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/DeprecationRule"
+//   '400':
+//     description: Unable to create the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The deprecation rule already exists
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateDeprecationRule represents the API handler to create a
+// deprecation rule in the configured backend. Builds matching
+// the rule are warned until the sunset for the rule has passed
+// and fail to compile after the sunset.
+func CreateDeprecationRule(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.DeprecationRule)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new deprecation rule: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"kind":    input.GetKind(),
+		"pattern": input.GetPattern(),
+		"user":    u.GetName(),
+	}).Infof("creating new deprecation rule for %s", input.GetPattern())
+
+	err = validate(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create deprecation rule: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the list of deprecation rules
+	rules, err := database.FromContext(c).ListDeprecationRules(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list deprecation rules: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	for _, r := range rules {
+		if strings.EqualFold(r.GetKind(), input.GetKind()) && r.GetPattern() == input.GetPattern() {
+			retErr := fmt.Errorf("unable to create deprecation rule for %s: rule %d already exists", input.GetPattern(), r.GetID())
+
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+	}
+
+	// default the deprecation rule to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in deprecation rule object
+	input.SetID(0)
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the deprecation rule
+	err = database.FromContext(c).CreateDeprecationRule(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create deprecation rule for %s: %w", input.GetPattern(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
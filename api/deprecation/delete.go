@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecation
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/deprecations/{deprecation} deprecations DeleteDeprecationRule
+//
+// Delete a deprecation rule from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: deprecation
+//   description: ID of the deprecation rule
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the deprecation rule
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to delete the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to delete the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteDeprecationRule represents the API handler to
+// remove a deprecation rule from the configured backend.
+func DeleteDeprecationRule(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"deprecation": util.PathParameter(c, "deprecation"),
+		"user":        u.GetName(),
+	}).Infof("deleting deprecation rule %s", util.PathParameter(c, "deprecation"))
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	// send API call to remove the deprecation rule
+	err := database.FromContext(c).DeleteDeprecationRule(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete deprecation rule %d: %w", r.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("deprecation rule %d deleted", r.GetID()))
+}
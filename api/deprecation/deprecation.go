@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecation
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+)
+
+// capture is a helper function to capture the deprecation rule
+// for the ID in the path of the request. When the deprecation rule
+// can't be captured, the error is handled and it returns nil.
+func capture(c *gin.Context) *api.DeprecationRule {
+	param := util.PathParameter(c, "deprecation")
+
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid deprecation parameter provided: %s", param)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return nil
+	}
+
+	// send API call to capture the deprecation rule
+	r, err := database.FromContext(c).GetDeprecationRule(c, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get deprecation rule %d: %w", id, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	return r
+}
+
+// validate is a helper function to verify the kind and
+// pattern for the deprecation rule are supported.
+func validate(r *api.DeprecationRule) error {
+	switch r.GetKind() {
+	case api.DeprecationKindImage, api.DeprecationKindTemplate:
+		// verify the pattern is a valid glob for images and templates
+		_, err := path.Match(r.GetPattern(), "")
+		if err != nil {
+			return fmt.Errorf("invalid pattern %s: %w", r.GetPattern(), err)
+		}
+	case api.DeprecationKindSyntax:
+	default:
+		return fmt.Errorf("invalid kind %s: must be one of %s, %s or %s", r.GetKind(),
+			api.DeprecationKindImage, api.DeprecationKindTemplate, api.DeprecationKindSyntax)
+	}
+
+	if len(r.GetPattern()) == 0 {
+		return fmt.Errorf("pattern is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecation
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/deprecations/{deprecation} deprecations GetDeprecationRule
+//
+// Get a deprecation rule in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: deprecation
+//   description: ID of the deprecation rule
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/DeprecationRule"
+//   '400':
+//     description: Unable to retrieve the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetDeprecationRule represents the API handler to capture
+// a deprecation rule from the configured backend.
+func GetDeprecationRule(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"deprecation": util.PathParameter(c, "deprecation"),
+		"user":        u.GetName(),
+	}).Infof("reading deprecation rule %s", util.PathParameter(c, "deprecation"))
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecation
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/deprecations deprecations ListDeprecationRules
+//
+// Get all deprecation rules in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the deprecation rules
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/DeprecationRule"
+//   '500':
+//     description: Unable to retrieve the deprecation rules
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListDeprecationRules represents the API handler to capture
+// the list of deprecation rules from the configured backend.
+func ListDeprecationRules(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Info("listing deprecation rules")
+
+	// send API call to capture the list of deprecation rules
+	rules, err := database.FromContext(c).ListDeprecationRules(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list deprecation rules: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rules)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecation
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation PUT /api/v1/deprecations/{deprecation} deprecations UpdateDeprecationRule
+//
+// Update a deprecation rule in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: deprecation
+//   description: ID of the deprecation rule
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the deprecation rule fields to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/DeprecationRule"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/DeprecationRule"
+//   '400':
+//     description: Unable to update the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the deprecation rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateDeprecationRule represents the API handler to
+// update a deprecation rule in the configured backend.
+func UpdateDeprecationRule(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"deprecation": util.PathParameter(c, "deprecation"),
+		"user":        u.GetName(),
+	}).Infof("updating deprecation rule %s", util.PathParameter(c, "deprecation"))
+
+	// capture body from API request
+	input := new(api.DeprecationRule)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for deprecation rule %s: %w", util.PathParameter(c, "deprecation"), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	if len(input.GetPattern()) > 0 {
+		// update pattern if set
+		r.SetPattern(input.GetPattern())
+	}
+
+	if input.Message != nil {
+		// update message if set
+		r.SetMessage(input.GetMessage())
+	}
+
+	if input.Sunset != nil {
+		// update sunset if set
+		r.SetSunset(input.GetSunset())
+	}
+
+	if input.Comment != nil {
+		// update comment if set
+		r.SetComment(input.GetComment())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		r.SetActive(input.GetActive())
+	}
+
+	err = validate(r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update deprecation rule %d: %w", r.GetID(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r.SetUpdatedAt(time.Now().UTC().Unix())
+	r.SetUpdatedBy(u.GetName())
+
+	// send API call to update the deprecation rule
+	err = database.FromContext(c).UpdateDeprecationRule(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update deprecation rule %d: %w", r.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated deprecation rule
+	r, _ = database.FromContext(c).GetDeprecationRule(c, r.GetID())
+
+	c.JSON(http.StatusOK, r)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egress
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/egress/{org} egress CreateEgressRule
+//
+// Create an egress rule for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the egress rule to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/EgressRule"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the egress rule
+//     schema:
+//       "$ref": "#/definitions/EgressRule"
+//   '400':
+//     description: Unable to create the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The egress rule already exists
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateEgressRule represents the API handler to create an egress
+// rule for an org in the configured backend. The active rules for
+// the org are enforced on the steps of every build for the org.
+func CreateEgressRule(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.EgressRule)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new egress rule for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("creating new egress rule to %s for org %s", input.GetDestination(), o)
+
+	err = validate(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create egress rule for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the list of egress rules
+	rules, err := database.FromContext(c).ListEgressRulesForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list egress rules for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	for _, r := range rules {
+		if r.GetAction() == input.GetAction() && strings.EqualFold(r.GetDestination(), input.GetDestination()) {
+			retErr := fmt.Errorf("unable to create egress rule for org %s: rule %d already exists", o, r.GetID())
+
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+	}
+
+	// default the egress rule to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in egress rule object
+	input.SetID(0)
+	input.SetOrg(o)
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the egress rule
+	err = database.FromContext(c).CreateEgressRule(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create egress rule to %s for org %s: %w", input.GetDestination(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created egress rule
+	rules, _ = database.FromContext(c).ListEgressRulesForOrg(c, o)
+	for _, r := range rules {
+		if r.GetAction() == input.GetAction() && r.GetDestination() == input.GetDestination() {
+			input = r
+
+			break
+		}
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
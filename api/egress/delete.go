@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egress
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/egress/{org}/{rule} egress DeleteEgressRule
+//
+// Delete an egress rule for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: rule
+//   description: ID of the egress rule
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the egress rule
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to delete the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to delete the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteEgressRule represents the API handler to remove
+// an egress rule for an org from the configured backend.
+func DeleteEgressRule(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"rule": util.PathParameter(c, "rule"),
+		"user": u.GetName(),
+	}).Infof("deleting egress rule %s for org %s", util.PathParameter(c, "rule"), o)
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	// send API call to remove the egress rule
+	err := database.FromContext(c).DeleteEgressRule(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete egress rule %d for org %s: %w", r.GetID(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("egress rule %d deleted for org %s", r.GetID(), o))
+}
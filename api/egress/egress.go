@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egress
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/util"
+)
+
+// capture is a helper function to capture the egress rule for the
+// ID in the path of the request that belongs to the org. When the
+// egress rule can't be captured, the error is handled and it returns nil.
+func capture(c *gin.Context) *api.EgressRule {
+	o := org.Retrieve(c)
+	param := util.PathParameter(c, "rule")
+
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid rule parameter provided: %s", param)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return nil
+	}
+
+	// send API call to capture the egress rule
+	r, err := database.FromContext(c).GetEgressRule(c, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get egress rule %d for org %s: %w", id, o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	// verify the egress rule belongs to the org
+	if r.GetOrg() != o {
+		retErr := fmt.Errorf("unable to get egress rule %d for org %s: rule belongs to another org", id, o)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	return r
+}
+
+// validate is a helper function to verify the
+// action and destination of the egress rule.
+func validate(r *api.EgressRule) error {
+	if r.GetAction() != api.EgressActionAllow && r.GetAction() != api.EgressActionDeny {
+		return fmt.Errorf("action must be %s or %s", api.EgressActionAllow, api.EgressActionDeny)
+	}
+
+	return api.ValidateEgressDestination(r.GetDestination())
+}
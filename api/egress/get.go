@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egress
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/egress/{org}/{rule} egress GetEgressRule
+//
+// Get an egress rule for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: rule
+//   description: ID of the egress rule
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the egress rule
+//     schema:
+//       "$ref": "#/definitions/EgressRule"
+//   '400':
+//     description: Unable to retrieve the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetEgressRule represents the API handler to capture
+// an egress rule for an org from the configured backend.
+func GetEgressRule(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"rule": util.PathParameter(c, "rule"),
+		"user": u.GetName(),
+	}).Infof("reading egress rule %s for org %s", util.PathParameter(c, "rule"), o)
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egress
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation PUT /api/v1/egress/{org}/{rule} egress UpdateEgressRule
+//
+// Update an egress rule for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: rule
+//   description: ID of the egress rule
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the egress rule to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/EgressRule"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the egress rule
+//     schema:
+//       "$ref": "#/definitions/EgressRule"
+//   '400':
+//     description: Unable to update the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the egress rule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateEgressRule represents the API handler to
+// update an egress rule for an org in the configured backend.
+func UpdateEgressRule(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"rule": util.PathParameter(c, "rule"),
+		"user": u.GetName(),
+	}).Infof("updating egress rule %s for org %s", util.PathParameter(c, "rule"), o)
+
+	// capture body from API request
+	input := new(api.EgressRule)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for egress rule %s for org %s: %w", util.PathParameter(c, "rule"), o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	if len(input.GetAction()) > 0 {
+		// update action if set
+		r.SetAction(input.GetAction())
+	}
+
+	if len(input.GetDestination()) > 0 {
+		// update destination if set
+		r.SetDestination(input.GetDestination())
+	}
+
+	if input.Description != nil {
+		// update description if set
+		r.SetDescription(input.GetDescription())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		r.SetActive(input.GetActive())
+	}
+
+	err = validate(r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update egress rule %d for org %s: %w", r.GetID(), o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r.SetUpdatedAt(time.Now().UTC().Unix())
+	r.SetUpdatedBy(u.GetName())
+
+	// send API call to update the egress rule
+	err = database.FromContext(c).UpdateEgressRule(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update egress rule %d for org %s: %w", r.GetID(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/freezes/{org}/{freeze}/audits freezes ListFreezeAudits
+//
+// Get the audits of a change freeze for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: freeze
+//   description: ID of the freeze
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the audits for the freeze
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/FreezeAudit"
+//   '400':
+//     description: Unable to retrieve the audits for the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the audits for the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the audits for the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// swagger:operation GET /api/v1/admin/freezes/{freeze}/audits admin ListAdminFreezeAudits
+//
+// Get the audits of a change freeze for every org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: freeze
+//   description: ID of the freeze
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the audits for the freeze
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/FreezeAudit"
+//   '400':
+//     description: Unable to retrieve the audits for the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the audits for the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the audits for the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListFreezeAudits represents the API handler to capture the records
+// of a change freeze being created, deleted or overridden from the
+// configured backend.
+func ListFreezeAudits(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"freeze": util.PathParameter(c, "freeze"),
+		"org":    o,
+		"user":   u.GetName(),
+	}).Infof("listing audits for freeze %s for org %s", util.PathParameter(c, "freeze"), o)
+
+	f := capture(c)
+	if f == nil {
+		return
+	}
+
+	// send API call to capture the audits for the freeze
+	audits, err := database.FromContext(c).ListFreezeAuditsForFreeze(c, f)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list audits for freeze %d: %w", f.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, audits)
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/freezes/{org} freezes CreateFreeze
+//
+// Create a change freeze for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the freeze to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Freeze"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the freeze
+//     schema:
+//       "$ref": "#/definitions/Freeze"
+//   '400':
+//     description: Unable to create the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// swagger:operation POST /api/v1/admin/freezes admin CreateAdminFreeze
+//
+// Create a change freeze for every org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the freeze to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Freeze"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the freeze
+//     schema:
+//       "$ref": "#/definitions/Freeze"
+//   '400':
+//     description: Unable to create the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateFreeze represents the API handler to create a change freeze
+// for an org, or for every org from the admin endpoints, in the
+// configured backend. Builds for the org are blocked while the
+// freeze is active unless the repo or event is exempt.
+func CreateFreeze(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.Freeze)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new freeze: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("creating new freeze %s", input.GetName())
+
+	now := time.Now().UTC().Unix()
+
+	// default the freeze to only block deployments
+	if len(input.GetScope()) == 0 {
+		input.SetScope(api.FreezeScopeDeploy)
+	}
+
+	// default the freeze to start immediately
+	if input.GetStarts() == 0 {
+		input.SetStarts(now)
+	}
+
+	err = validate(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create freeze: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update fields in freeze object
+	input.SetID(0)
+	input.SetOrg(o)
+	input.SetCreatedAt(now)
+	input.SetCreatedBy(u.GetName())
+
+	// send API call to create the freeze
+	err = database.FromContext(c).CreateFreeze(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create freeze %s: %w", input.GetName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created freeze
+	freezes, _ := database.FromContext(c).ListFreezes(c, o)
+	for _, f := range freezes {
+		if f.GetName() == input.GetName() && f.GetCreatedAt() == now && f.GetCreatedBy() == u.GetName() {
+			input = f
+
+			break
+		}
+	}
+
+	// record the creation of the freeze
+	err = audit(c, u, input, api.FreezeAuditCreated, fmt.Sprintf("freeze %s created", input.GetName()))
+	if err != nil {
+		retErr := fmt.Errorf("unable to record creation of freeze %s: %w", input.GetName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
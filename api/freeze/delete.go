@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/freezes/{org}/{freeze} freezes DeleteFreeze
+//
+// Delete a change freeze for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: freeze
+//   description: ID of the freeze
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the freeze
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to delete the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to delete the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// swagger:operation DELETE /api/v1/admin/freezes/{freeze} admin DeleteAdminFreeze
+//
+// Delete a change freeze for every org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: freeze
+//   description: ID of the freeze
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the freeze
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to delete the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to delete the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteFreeze represents the API handler to remove a change freeze
+// for an org, or for every org from the admin endpoints, from the
+// configured backend. The audits for the freeze are kept.
+func DeleteFreeze(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"freeze": util.PathParameter(c, "freeze"),
+		"org":    o,
+		"user":   u.GetName(),
+	}).Infof("deleting freeze %s for org %s", util.PathParameter(c, "freeze"), o)
+
+	f := capture(c)
+	if f == nil {
+		return
+	}
+
+	// send API call to remove the freeze
+	err := database.FromContext(c).DeleteFreeze(c, f)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete freeze %d: %w", f.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// record the deletion of the freeze
+	err = audit(c, u, f, api.FreezeAuditDeleted, fmt.Sprintf("freeze %s deleted", f.GetName()))
+	if err != nil {
+		retErr := fmt.Errorf("unable to record deletion of freeze %d: %w", f.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("freeze %d deleted", f.GetID()))
+}
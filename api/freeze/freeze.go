@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/enrich"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+)
+
+// capture is a helper function to capture the freeze for the ID in
+// the path of the request that belongs to the org, or to every org
+// for the admin endpoints. When the freeze can't be captured, the
+// error is handled and it returns nil.
+func capture(c *gin.Context) *api.Freeze {
+	o := org.Retrieve(c)
+	param := util.PathParameter(c, "freeze")
+
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid freeze parameter provided: %s", param)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return nil
+	}
+
+	// send API call to capture the freeze
+	f, err := database.FromContext(c).GetFreeze(c, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get freeze %d: %w", id, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	// verify the freeze belongs to the org
+	if f.GetOrg() != o {
+		retErr := fmt.Errorf("unable to get freeze %d: freeze belongs to another org", id)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	return f
+}
+
+// validate is a helper function to verify the
+// name, scope and window of the freeze.
+func validate(f *api.Freeze) error {
+	if len(f.GetName()) == 0 {
+		return fmt.Errorf("no name provided")
+	}
+
+	if f.GetScope() != api.FreezeScopeDeploy && f.GetScope() != api.FreezeScopeAll {
+		return fmt.Errorf("scope must be %s or %s", api.FreezeScopeDeploy, api.FreezeScopeAll)
+	}
+
+	if f.GetEnds() <= f.GetStarts() {
+		return fmt.Errorf("ends must be after starts")
+	}
+
+	return nil
+}
+
+// audit is a helper function to record the
+// action taken on the freeze by the user.
+func audit(c *gin.Context, u *library.User, f *api.Freeze, action, message string) error {
+	a := new(api.FreezeAudit)
+	a.SetFreezeID(f.GetID())
+	a.SetOrg(f.GetOrg())
+	a.SetAction(action)
+	a.SetActor(u.GetName())
+	a.SetMessage(message)
+	a.SetCreated(time.Now().UTC().Unix())
+
+	// capture the details about the client that made the request
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/enrich?tab=doc#Enricher.Enrich
+	d := enrich.FromContext(c).Enrich(c, c.Request, c.ClientIP())
+	a.SetIP(d.IP)
+	a.SetUserAgent(d.UserAgent)
+	a.SetClient(d.Client)
+	a.SetLocation(d.Location)
+
+	// send API call to record the action taken on the freeze
+	return database.FromContext(c).CreateFreezeAudit(c, a)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/freezes/{org}/{freeze} freezes GetFreeze
+//
+// Get a change freeze for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: freeze
+//   description: ID of the freeze
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the freeze
+//     schema:
+//       "$ref": "#/definitions/Freeze"
+//   '400':
+//     description: Unable to retrieve the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// swagger:operation GET /api/v1/admin/freezes/{freeze} admin GetAdminFreeze
+//
+// Get a change freeze for every org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: freeze
+//   description: ID of the freeze
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the freeze
+//     schema:
+//       "$ref": "#/definitions/Freeze"
+//   '400':
+//     description: Unable to retrieve the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the freeze
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetFreeze represents the API handler to capture a change freeze
+// for an org, or for every org from the admin endpoints, from
+// the configured backend.
+func GetFreeze(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"freeze": util.PathParameter(c, "freeze"),
+		"org":    o,
+		"user":   u.GetName(),
+	}).Infof("reading freeze %s for org %s", util.PathParameter(c, "freeze"), o)
+
+	f := capture(c)
+	if f == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, f)
+}
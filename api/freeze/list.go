@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/freezes/{org} freezes ListFreezes
+//
+// Get the change freezes for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the freezes
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Freeze"
+//   '500':
+//     description: Unable to retrieve the freezes
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// swagger:operation GET /api/v1/admin/freezes admin ListAdminFreezes
+//
+// Get the change freezes for every org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the freezes
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Freeze"
+//   '500':
+//     description: Unable to retrieve the freezes
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListFreezes represents the API handler to capture the change
+// freezes for an org, or for every org from the admin endpoints,
+// from the configured backend.
+func ListFreezes(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("listing freezes for org %s", o)
+
+	// send API call to capture the list of freezes
+	freezes, err := database.FromContext(c).ListFreezes(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list freezes for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, freezes)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
 	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/verify"
 	"github.com/go-vela/server/util"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
@@ -91,7 +92,7 @@ func CreateHook(c *gin.Context) {
 	}
 
 	// send API call to capture the last hook for the repo
-	lastHook, err := database.FromContext(c).LastHookForRepo(r)
+	lastHook, err := database.FromContext(c).LastHookForRepo(c, r)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get last hook for repo %s: %w", r.GetFullName(), err)
 
@@ -115,7 +116,7 @@ func CreateHook(c *gin.Context) {
 	}
 
 	// send API call to create the webhook
-	err = database.FromContext(c).CreateHook(input)
+	err = database.FromContext(c).CreateHook(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create hook for repo %s: %w", r.GetFullName(), err)
 
@@ -125,7 +126,7 @@ func CreateHook(c *gin.Context) {
 	}
 
 	// send API call to capture the created webhook
-	h, _ := database.FromContext(c).GetHookForRepo(r, input.GetNumber())
+	h, _ := database.FromContext(c).GetHookForRepo(c, r, input.GetNumber())
 
 	c.JSON(http.StatusCreated, h)
 }
@@ -225,7 +226,7 @@ func GetHooks(c *gin.Context) {
 	perPage = util.MaxInt(1, util.MinInt(100, perPage))
 
 	// send API call to capture the list of steps for the build
-	h, t, err := database.FromContext(c).ListHooksForRepo(r, page, perPage)
+	h, t, err := database.FromContext(c).ListHooksForRepo(c, r, page, perPage)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get hooks for repo %s: %w", r.GetFullName(), err)
 
@@ -316,7 +317,7 @@ func GetHook(c *gin.Context) {
 	}
 
 	// send API call to capture the webhook
-	h, err := database.FromContext(c).GetHookForRepo(r, number)
+	h, err := database.FromContext(c).GetHookForRepo(c, r, number)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get hook %s: %w", entry, err)
 
@@ -420,7 +421,7 @@ func UpdateHook(c *gin.Context) {
 	}
 
 	// send API call to capture the webhook
-	h, err := database.FromContext(c).GetHookForRepo(r, number)
+	h, err := database.FromContext(c).GetHookForRepo(c, r, number)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get hook %s: %w", entry, err)
 
@@ -466,7 +467,7 @@ func UpdateHook(c *gin.Context) {
 	}
 
 	// send API call to update the webhook
-	err = database.FromContext(c).UpdateHook(h)
+	err = database.FromContext(c).UpdateHook(c, h)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update hook %s: %w", entry, err)
 
@@ -476,7 +477,7 @@ func UpdateHook(c *gin.Context) {
 	}
 
 	// send API call to capture the updated user
-	h, _ = database.FromContext(c).GetHookForRepo(r, h.GetNumber())
+	h, _ = database.FromContext(c).GetHookForRepo(c, r, h.GetNumber())
 
 	c.JSON(http.StatusOK, h)
 }
@@ -555,7 +556,7 @@ func DeleteHook(c *gin.Context) {
 	}
 
 	// send API call to capture the webhook
-	h, err := database.FromContext(c).GetHookForRepo(r, number)
+	h, err := database.FromContext(c).GetHookForRepo(c, r, number)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get hook %s: %w", hook, err)
 
@@ -565,7 +566,7 @@ func DeleteHook(c *gin.Context) {
 	}
 
 	// send API call to remove the webhook
-	err = database.FromContext(c).DeleteHook(h)
+	err = database.FromContext(c).DeleteHook(c, h)
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete hook %s: %w", hook, err)
 
@@ -652,7 +653,7 @@ func RedeliverHook(c *gin.Context) {
 	}
 
 	// send API call to capture the webhook
-	h, err := database.FromContext(c).GetHookForRepo(r, number)
+	h, err := database.FromContext(c).GetHookForRepo(c, r, number)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get hook %s: %w", entry, err)
 
@@ -661,6 +662,21 @@ func RedeliverHook(c *gin.Context) {
 		return
 	}
 
+	// release the delivery of the webhook so the
+	// redelivery is not rejected as a replay
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/verify?tab=doc#Verifier.Release
+	if v := verify.FromContext(c); v != nil {
+		err = v.Release(c, h.GetSourceID())
+		if err != nil {
+			retErr := fmt.Errorf("unable to release delivery for hook %s: %w", entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+	}
+
 	err = scm.FromContext(c).RedeliverWebhook(c, u, r, h)
 	if err != nil {
 		retErr := fmt.Errorf("unable to redeliver hook %s: %w", entry, err)
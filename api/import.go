@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/importer"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultImportImage is the image used for the steps
+// of a pipeline imported from a source without images.
+const defaultImportImage = "alpine:latest"
+
+// swagger:operation POST /api/v1/import/drone import ImportDrone
+//
+// Convert a Drone configuration into Vela repo settings, secrets and a pipeline
+//
+// ---
+// consumes:
+// - application/x-yaml
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Drone configuration to convert
+//   required: true
+//   schema:
+//     type: string
+// - in: query
+//   name: repo
+//   description: Full name of the repo the configuration belongs to
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully converted the configuration
+//     schema:
+//       "$ref": "#/definitions/Migration"
+//   '400':
+//     description: Unable to convert the configuration
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ImportDrone represents the API handler to convert
+// a Drone configuration into a migration for Vela.
+func ImportDrone(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	repo := c.Query("repo")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"repo": repo,
+		"user": u.GetName(),
+	}).Info("importing drone configuration")
+
+	// capture body from API request
+	data, err := c.GetRawData()
+	if err != nil {
+		retErr := fmt.Errorf("unable to read drone configuration: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	m, err := importer.Drone(repo, data)
+	if err != nil {
+		retErr := fmt.Errorf("unable to import drone configuration: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, m)
+}
+
+// swagger:operation POST /api/v1/import/jenkins import ImportJenkins
+//
+// Convert a Jenkins freestyle job into Vela repo settings, secrets and a pipeline
+//
+// ---
+// consumes:
+// - application/xml
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Jenkins job configuration (config.xml) to convert
+//   required: true
+//   schema:
+//     type: string
+// - in: query
+//   name: repo
+//   description: Full name of the repo the job belongs to
+//   type: string
+// - in: query
+//   name: image
+//   description: Image to run the shell steps of the job in
+//   type: string
+//   default: alpine:latest
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully converted the job
+//     schema:
+//       "$ref": "#/definitions/Migration"
+//   '400':
+//     description: Unable to convert the job
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ImportJenkins represents the API handler to convert
+// a Jenkins freestyle job into a migration for Vela.
+func ImportJenkins(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	repo := c.Query("repo")
+	image := c.DefaultQuery("image", defaultImportImage)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"repo": repo,
+		"user": u.GetName(),
+	}).Info("importing jenkins job")
+
+	// capture body from API request
+	data, err := c.GetRawData()
+	if err != nil {
+		retErr := fmt.Errorf("unable to read jenkins job: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	m, err := importer.Jenkins(repo, image, data)
+	if err != nil {
+		retErr := fmt.Errorf("unable to import jenkins job: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, m)
+}
@@ -5,6 +5,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -85,7 +86,7 @@ func GetBuildLogs(c *gin.Context) {
 	// send API call to capture the list of logs for the build
 	//
 	// TODO: add page and per_page query parameters
-	l, t, err := database.FromContext(c).ListLogsForBuild(b, 1, 100)
+	l, t, err := database.FromContext(c).ListLogsForBuild(c, b, 1, 100)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get logs for build %s: %w", entry, err)
 
@@ -106,6 +107,185 @@ func GetBuildLogs(c *gin.Context) {
 	c.JSON(http.StatusOK, l)
 }
 
+// maxBulkLogEntries represents the maximum number of
+// log entries accepted in a single bulk log request.
+const maxBulkLogEntries = 100
+
+// BulkLogResult is the API representation of the outcome
+// for a single entry provided in a bulk log request.
+//
+// swagger:model BulkLogResult
+type BulkLogResult struct {
+	ServiceID int64  `json:"service_id,omitempty"`
+	StepID    int64  `json:"step_id,omitempty"`
+	Status    int    `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// swagger:operation PUT /api/v1/repos/{org}/{repo}/builds/{build}/logs builds UpdateBuildLogs
+//
+// Update the logs for multiple steps and services of a build in one request
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the logs to update, identified by step_id or service_id
+//   required: true
+//   schema:
+//     type: array
+//     items:
+//       "$ref": "#/definitions/Log"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully processed the logs for the build
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/BulkLogResult"
+//   '400':
+//     description: Unable to process the logs for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateBuildLogs represents the API handler to update the logs
+// for multiple steps and services of a build in the configured backend.
+// Each entry is processed independently and the outcome for every
+// entry is returned in the same order it was provided.
+func UpdateBuildLogs(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("updating logs for build %s", entry)
+
+	// capture body from API request
+	input := []*library.Log{}
+
+	err := c.Bind(&input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// verify the number of entries provided is within the limit
+	if len(input) == 0 || len(input) > maxBulkLogEntries {
+		retErr := fmt.Errorf("unable to update logs for build %s: must provide between 1 and %d entries", entry, maxBulkLogEntries)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	results := make([]*BulkLogResult, 0, len(input))
+
+	// capture the values for the sensitive variables of the repo to mask
+	values := sensitiveValues(c, database.FromContext(c), r)
+
+	for _, in := range input {
+		results = append(results, updateBuildLog(c, database.FromContext(c), b, in, values))
+	}
+
+	c.JSON(http.StatusOK, results)
+}
+
+// updateBuildLog is a helper function to update the log
+// for a single step or service from a bulk log request,
+// masking the provided values in the data for the log.
+func updateBuildLog(ctx context.Context, db database.Service, b *library.Build, in *library.Log, values []string) *BulkLogResult {
+	result := &BulkLogResult{
+		ServiceID: in.GetServiceID(),
+		StepID:    in.GetStepID(),
+	}
+
+	// verify exactly one of the step or service was provided
+	if (in.GetStepID() > 0) == (in.GetServiceID() > 0) {
+		result.Status = http.StatusBadRequest
+		result.Error = "exactly one of step_id or service_id must be provided"
+
+		return result
+	}
+
+	var (
+		l   *library.Log
+		err error
+	)
+
+	// capture the existing log for the step or service
+	if in.GetStepID() > 0 {
+		l, err = db.GetLogForStep(ctx, &library.Step{ID: in.StepID})
+	} else {
+		l, err = db.GetLogForService(ctx, &library.Service{ID: in.ServiceID})
+	}
+
+	if err != nil {
+		result.Status = http.StatusNotFound
+		result.Error = err.Error()
+
+		return result
+	}
+
+	// verify the log belongs to the build being updated
+	if l.GetBuildID() != b.GetID() {
+		result.Status = http.StatusNotFound
+		result.Error = fmt.Sprintf("log does not exist for build %d", b.GetNumber())
+
+		return result
+	}
+
+	// update data if set
+	if len(in.GetData()) > 0 {
+		l.SetData(maskValues(in.GetData(), values))
+	}
+
+	// send API call to update the log
+	err = db.UpdateLog(ctx, l)
+	if err != nil {
+		result.Status = http.StatusInternalServerError
+		result.Error = err.Error()
+
+		return result
+	}
+
+	result.Status = http.StatusOK
+
+	return result
+}
+
 //
 // swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/logs services CreateServiceLogs
 //
@@ -195,13 +375,16 @@ func CreateServiceLog(c *gin.Context) {
 		return
 	}
 
+	// mask the values for the sensitive variables of the repo
+	input.SetData(maskValues(input.GetData(), sensitiveValues(c, database.FromContext(c), r)))
+
 	// update fields in log object
 	input.SetServiceID(s.GetID())
 	input.SetBuildID(b.GetID())
 	input.SetRepoID(r.GetID())
 
 	// send API call to create the logs
-	err = database.FromContext(c).CreateLog(input)
+	err = database.FromContext(c).CreateLog(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create logs for service %s: %w", entry, err)
 
@@ -211,7 +394,7 @@ func CreateServiceLog(c *gin.Context) {
 	}
 
 	// send API call to capture the created log
-	l, _ := database.FromContext(c).GetLogForService(s)
+	l, _ := database.FromContext(c).GetLogForService(c, s)
 
 	c.JSON(http.StatusCreated, l)
 }
@@ -281,7 +464,7 @@ func GetServiceLog(c *gin.Context) {
 	}).Infof("reading logs for service %s", entry)
 
 	// send API call to capture the service logs
-	l, err := database.FromContext(c).GetLogForService(s)
+	l, err := database.FromContext(c).GetLogForService(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get logs for service %s: %w", entry, err)
 
@@ -369,7 +552,7 @@ func UpdateServiceLog(c *gin.Context) {
 	}).Infof("updating logs for service %s", entry)
 
 	// send API call to capture the service logs
-	l, err := database.FromContext(c).GetLogForService(s)
+	l, err := database.FromContext(c).GetLogForService(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get logs for service %s: %w", entry, err)
 
@@ -392,12 +575,13 @@ func UpdateServiceLog(c *gin.Context) {
 
 	// update log fields if provided
 	if len(input.GetData()) > 0 {
-		// update data if set
-		l.SetData(input.GetData())
+		// update data if set, masking the values
+		// for the sensitive variables of the repo
+		l.SetData(maskValues(input.GetData(), sensitiveValues(c, database.FromContext(c), r)))
 	}
 
 	// send API call to update the log
-	err = database.FromContext(c).UpdateLog(l)
+	err = database.FromContext(c).UpdateLog(c, l)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update logs for service %s: %w", entry, err)
 
@@ -407,7 +591,7 @@ func UpdateServiceLog(c *gin.Context) {
 	}
 
 	// send API call to capture the updated log
-	l, _ = database.FromContext(c).GetLogForService(s)
+	l, _ = database.FromContext(c).GetLogForService(c, s)
 
 	c.JSON(http.StatusOK, l)
 }
@@ -477,7 +661,7 @@ func DeleteServiceLog(c *gin.Context) {
 	}).Infof("deleting logs for service %s", entry)
 
 	// send API call to capture the service logs
-	l, err := database.FromContext(c).GetLogForService(s)
+	l, err := database.FromContext(c).GetLogForService(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get logs for service %s: %w", entry, err)
 
@@ -486,8 +670,18 @@ func DeleteServiceLog(c *gin.Context) {
 		return
 	}
 
+	// send API call to remove the log lines
+	err = database.FromContext(c).DeleteLinesForLog(c, l)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete log lines for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
 	// send API call to remove the log
-	err = database.FromContext(c).DeleteLog(l)
+	err = database.FromContext(c).DeleteLog(c, l)
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete logs for service %s: %w", entry, err)
 
@@ -588,13 +782,16 @@ func CreateStepLog(c *gin.Context) {
 		return
 	}
 
+	// mask the values for the sensitive variables of the repo
+	input.SetData(maskValues(input.GetData(), sensitiveValues(c, database.FromContext(c), r)))
+
 	// update fields in log object
 	input.SetStepID(s.GetID())
 	input.SetBuildID(b.GetID())
 	input.SetRepoID(r.GetID())
 
 	// send API call to create the logs
-	err = database.FromContext(c).CreateLog(input)
+	err = database.FromContext(c).CreateLog(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create logs for step %s: %w", entry, err)
 
@@ -604,7 +801,7 @@ func CreateStepLog(c *gin.Context) {
 	}
 
 	// send API call to capture the created log
-	l, _ := database.FromContext(c).GetLogForStep(s)
+	l, _ := database.FromContext(c).GetLogForStep(c, s)
 
 	c.JSON(http.StatusCreated, l)
 }
@@ -675,7 +872,7 @@ func GetStepLog(c *gin.Context) {
 	}).Infof("reading logs for step %s", entry)
 
 	// send API call to capture the step logs
-	l, err := database.FromContext(c).GetLogForStep(s)
+	l, err := database.FromContext(c).GetLogForStep(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get logs for step %s: %w", entry, err)
 
@@ -763,7 +960,7 @@ func UpdateStepLog(c *gin.Context) {
 	}).Infof("updating logs for step %s", entry)
 
 	// send API call to capture the step logs
-	l, err := database.FromContext(c).GetLogForStep(s)
+	l, err := database.FromContext(c).GetLogForStep(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get logs for step %s: %w", entry, err)
 
@@ -786,12 +983,13 @@ func UpdateStepLog(c *gin.Context) {
 
 	// update log fields if provided
 	if len(input.GetData()) > 0 {
-		// update data if set
-		l.SetData(input.GetData())
+		// update data if set, masking the values
+		// for the sensitive variables of the repo
+		l.SetData(maskValues(input.GetData(), sensitiveValues(c, database.FromContext(c), r)))
 	}
 
 	// send API call to update the log
-	err = database.FromContext(c).UpdateLog(l)
+	err = database.FromContext(c).UpdateLog(c, l)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update logs for step %s: %w", entry, err)
 
@@ -801,7 +999,7 @@ func UpdateStepLog(c *gin.Context) {
 	}
 
 	// send API call to capture the updated log
-	l, _ = database.FromContext(c).GetLogForStep(s)
+	l, _ = database.FromContext(c).GetLogForStep(c, s)
 
 	c.JSON(http.StatusOK, l)
 }
@@ -871,7 +1069,7 @@ func DeleteStepLog(c *gin.Context) {
 	}).Infof("deleting logs for step %s", entry)
 
 	// send API call to capture the step logs
-	l, err := database.FromContext(c).GetLogForStep(s)
+	l, err := database.FromContext(c).GetLogForStep(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get logs for step %s: %w", entry, err)
 
@@ -880,8 +1078,18 @@ func DeleteStepLog(c *gin.Context) {
 		return
 	}
 
+	// send API call to remove the log lines
+	err = database.FromContext(c).DeleteLinesForLog(c, l)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete log lines for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
 	// send API call to remove the log
-	err = database.FromContext(c).DeleteLog(l)
+	err = database.FromContext(c).DeleteLog(c, l)
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete logs for step %s: %w", entry, err)
 
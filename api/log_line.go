@@ -0,0 +1,556 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// maxLogLines represents the maximum number of
+// log lines accepted in a single request.
+const maxLogLines = 1000
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/logs/lines services CreateServiceLogLines
+//
+// Append lines with timestamp and stream metadata to the logs for a service
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: service
+//   description: ID of the service
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the log lines to append
+//   required: true
+//   schema:
+//     type: array
+//     items:
+//       "$ref": "#/definitions/LogLine"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully appended the log lines for the service
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LogLine"
+//   '400':
+//     description: Unable to append the log lines for the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to append the log lines for the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateServiceLogLines represents the API handler to append
+// lines to the logs for a service in the configured backend.
+func CreateServiceLogLines(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := service.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"org":     o,
+		"repo":    r.GetName(),
+		"service": s.GetNumber(),
+		"user":    u.GetName(),
+	}).Infof("appending log lines for service %s", entry)
+
+	// send API call to capture the service logs
+	l, err := database.FromContext(c).GetLogForService(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get logs for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	createLogLines(c, l, fmt.Sprintf("service %s", entry))
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/logs/lines services ListServiceLogLines
+//
+// Get the lines with timestamp and stream metadata for the logs of a service
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: service
+//   description: ID of the service
+//   required: true
+//   type: integer
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 1000
+//   default: 500
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the log lines for the service
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LogLine"
+//   '400':
+//     description: Unable to retrieve the log lines for the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the log lines for the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListServiceLogLines represents the API handler to capture
+// the lines for the logs of a service from the configured backend.
+func ListServiceLogLines(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := service.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"org":     o,
+		"repo":    r.GetName(),
+		"service": s.GetNumber(),
+		"user":    u.GetName(),
+	}).Infof("reading log lines for service %s", entry)
+
+	// send API call to capture the service logs
+	l, err := database.FromContext(c).GetLogForService(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get logs for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	listLogLines(c, l, fmt.Sprintf("service %s", entry))
+}
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/logs/lines steps CreateStepLogLines
+//
+// Append lines with timestamp and stream metadata to the logs for a step
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the log lines to append
+//   required: true
+//   schema:
+//     type: array
+//     items:
+//       "$ref": "#/definitions/LogLine"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully appended the log lines for the step
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LogLine"
+//   '400':
+//     description: Unable to append the log lines for the step
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to append the log lines for the step
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateStepLogLines represents the API handler to append
+// lines to the logs for a step in the configured backend.
+func CreateStepLogLines(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"step":  s.GetNumber(),
+		"user":  u.GetName(),
+	}).Infof("appending log lines for step %s", entry)
+
+	// send API call to capture the step logs
+	l, err := database.FromContext(c).GetLogForStep(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get logs for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	createLogLines(c, l, fmt.Sprintf("step %s", entry))
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/logs/lines steps ListStepLogLines
+//
+// Get the lines with timestamp and stream metadata for the logs of a step
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 1000
+//   default: 500
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the log lines for the step
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/LogLine"
+//   '400':
+//     description: Unable to retrieve the log lines for the step
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the log lines for the step
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListStepLogLines represents the API handler to capture
+// the lines for the logs of a step from the configured backend.
+func ListStepLogLines(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"step":  s.GetNumber(),
+		"user":  u.GetName(),
+	}).Infof("reading log lines for step %s", entry)
+
+	// send API call to capture the step logs
+	l, err := database.FromContext(c).GetLogForStep(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get logs for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	listLogLines(c, l, fmt.Sprintf("step %s", entry))
+}
+
+// createLogLines is a helper function to append the lines provided
+// in the request body to the provided log. The raw log data is
+// updated with the content of each line so existing consumers of
+// the log continue to receive the full output.
+func createLogLines(c *gin.Context, l *library.Log, entry string) {
+	// capture body from API request
+	input := []*types.LogLine{}
+
+	err := c.Bind(&input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for log lines for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// verify the number of lines provided is within the limit
+	if len(input) == 0 || len(input) > maxLogLines {
+		retErr := fmt.Errorf("unable to append log lines for %s: must provide between 1 and %d lines", entry, maxLogLines)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the number of existing lines for the log
+	count, err := database.FromContext(c).CountLinesForLog(c, l)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get count of log lines for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// mask the values for the sensitive variables of the repo
+	values := sensitiveValues(c, database.FromContext(c), repo.Retrieve(c))
+
+	for _, line := range input {
+		line.SetData(string(maskValues([]byte(line.GetData()), values)))
+	}
+
+	err = prepareLogLines(l, input, int(count), time.Now().UTC().UnixMilli())
+	if err != nil {
+		retErr := fmt.Errorf("unable to append log lines for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to create the log lines
+	err = database.FromContext(c).CreateLogLines(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create log lines for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to update the log
+	err = database.FromContext(c).UpdateLog(c, l)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update logs for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
+
+// prepareLogLines is a helper function to validate the provided
+// lines and populate their metadata for the provided log. Lines
+// are numbered sequentially after the existing lines for the log
+// and their content is appended to the raw log data.
+func prepareLogLines(l *library.Log, lines []*types.LogLine, existing int, now int64) error {
+	for i, line := range lines {
+		// default the stream to standard out
+		if len(line.GetStream()) == 0 {
+			line.SetStream(types.LogStreamStdout)
+		}
+
+		// verify the stream provided is supported
+		if line.GetStream() != types.LogStreamStdout && line.GetStream() != types.LogStreamStderr {
+			return fmt.Errorf("invalid stream %s provided for line %d", line.GetStream(), i)
+		}
+
+		// default the timestamp to when the line was received
+		if line.GetTimestamp() <= 0 {
+			line.SetTimestamp(now)
+		}
+
+		// the line identifier is assigned by the database
+		line.ID = nil
+
+		line.SetBuildID(l.GetBuildID())
+		line.SetLogID(l.GetID())
+		line.SetNumber(existing + i + 1)
+	}
+
+	// append the content of each line to the raw log data
+	for _, line := range lines {
+		l.AppendData([]byte(line.GetData() + "\n"))
+	}
+
+	return nil
+}
+
+// listLogLines is a helper function to respond with the
+// requested page of lines for the provided log.
+func listLogLines(c *gin.Context, l *library.Log, entry string) {
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "500"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure page and per_page aren't above or below allowed values
+	page = util.MaxInt(1, page)
+	perPage = util.MaxInt(1, util.MinInt(maxLogLines, perPage))
+
+	// send API call to capture the lines for the log
+	lines, t, err := database.FromContext(c).ListLinesForLog(c, l, page, perPage)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get log lines for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// create pagination object
+	pagination := Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   t,
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	c.JSON(http.StatusOK, lines)
+}
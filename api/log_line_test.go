@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func Test_prepareLogLines(t *testing.T) {
+	// setup types
+	_log := new(library.Log)
+	_log.SetID(1)
+	_log.SetBuildID(2)
+	_log.SetData([]byte("existing\n"))
+
+	_stdout := new(types.LogLine)
+	_stdout.SetID(5)
+	_stdout.SetData("hello")
+
+	_stderr := new(types.LogLine)
+	_stderr.SetStream(types.LogStreamStderr)
+	_stderr.SetTimestamp(1563474078000)
+	_stderr.SetData("world")
+
+	// run test
+	err := prepareLogLines(_log, []*types.LogLine{_stdout, _stderr}, 1, 1563474077000)
+	if err != nil {
+		t.Errorf("prepareLogLines returned err: %v", err)
+	}
+
+	if _stdout.ID != nil {
+		t.Errorf("prepareLogLines ID is %v, want nil", _stdout.GetID())
+	}
+
+	if _stdout.GetNumber() != 2 || _stderr.GetNumber() != 3 {
+		t.Errorf("prepareLogLines numbers are %d and %d, want 2 and 3", _stdout.GetNumber(), _stderr.GetNumber())
+	}
+
+	if _stdout.GetStream() != types.LogStreamStdout {
+		t.Errorf("prepareLogLines stream is %s, want %s", _stdout.GetStream(), types.LogStreamStdout)
+	}
+
+	if _stdout.GetTimestamp() != 1563474077000 || _stderr.GetTimestamp() != 1563474078000 {
+		t.Errorf("prepareLogLines timestamps are %d and %d", _stdout.GetTimestamp(), _stderr.GetTimestamp())
+	}
+
+	if _stderr.GetLogID() != 1 || _stderr.GetBuildID() != 2 {
+		t.Errorf("prepareLogLines log and build are %d and %d, want 1 and 2", _stderr.GetLogID(), _stderr.GetBuildID())
+	}
+
+	if string(_log.GetData()) != "existing\nhello\nworld\n" {
+		t.Errorf("prepareLogLines data is %q", _log.GetData())
+	}
+
+	// run test with an invalid stream
+	_invalid := new(types.LogLine)
+	_invalid.SetStream("stdin")
+
+	err = prepareLogLines(_log, []*types.LogLine{_invalid}, 0, 1563474077000)
+	if err == nil {
+		t.Errorf("prepareLogLines should have returned err")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// sensitiveValues is a helper function to capture the values
+// for the sensitive variables of the repo that are masked in
+// the logs. The longest values are first, so a value that
+// contains another value is masked as a whole.
+func sensitiveValues(ctx context.Context, db database.Service, r *library.Repo) []string {
+	// send API call to capture the variables for the repo
+	variables, err := db.ListRepoVariablesForRepo(ctx, r)
+	if err != nil {
+		logrus.Errorf("unable to list variables for repo %s: %v", r.GetFullName(), err)
+
+		return nil
+	}
+
+	values := []string{}
+
+	for _, v := range variables {
+		if v.GetSensitive() && len(v.GetValue()) > 0 {
+			values = append(values, v.GetValue())
+		}
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+
+	return values
+}
+
+// maskValues is a helper function to replace every
+// occurrence of the provided values in the data
+// with the mask used for secrets.
+func maskValues(data []byte, values []string) []byte {
+	for _, value := range values {
+		data = bytes.ReplaceAll(data, []byte(value), []byte(constants.SecretMask))
+	}
+
+	return data
+}
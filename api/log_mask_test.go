@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func TestAPI_sensitiveValues(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetFullName("github/octocat")
+
+	_region := new(types.RepoVariable)
+	_region.SetRepoID(1)
+	_region.SetName("DEPLOY_REGION")
+	_region.SetValue("us-east-1")
+	_region.SetSensitive(false)
+
+	_token := new(types.RepoVariable)
+	_token.SetRepoID(1)
+	_token.SetName("DEPLOY_TOKEN")
+	_token.SetValue("abc123")
+	_token.SetSensitive(true)
+
+	_url := new(types.RepoVariable)
+	_url.SetRepoID(1)
+	_url.SetName("DEPLOY_URL")
+	_url.SetValue("https://deploy.example.com/hooks/abc123")
+	_url.SetSensitive(true)
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	for _, v := range []*types.RepoVariable{_region, _token, _url} {
+		err = db.CreateRepoVariable(context.TODO(), v)
+		if err != nil {
+			t.Errorf("unable to create test repo variable: %v", err)
+		}
+	}
+
+	want := []string{"https://deploy.example.com/hooks/abc123", "abc123"}
+
+	// run test
+	got := sensitiveValues(context.TODO(), db, _repo)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sensitiveValues is %v, want %v", got, want)
+	}
+}
+
+func TestAPI_maskValues(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		data   string
+		values []string
+		want   string
+	}{
+		{
+			name:   "no values",
+			data:   "deploying to https://deploy.example.com/hooks/abc123",
+			values: nil,
+			want:   "deploying to https://deploy.example.com/hooks/abc123",
+		},
+		{
+			name:   "values",
+			data:   "deploying to https://deploy.example.com/hooks/abc123\nusing token abc123",
+			values: []string{"https://deploy.example.com/hooks/abc123", "abc123"},
+			want:   "deploying to [secure]\nusing token [secure]",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := maskValues([]byte(test.data), test.values)
+
+			if string(got) != test.want {
+				t.Errorf("maskValues is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
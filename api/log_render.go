@@ -0,0 +1,286 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/ansi"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// renderFormatHTML represents the format for
+	// rendering log lines as an HTML fragment.
+	renderFormatHTML = "html"
+
+	// renderFormatJSON represents the format for
+	// rendering log lines as styled JSON segments.
+	renderFormatJSON = "json"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/logs/render services RenderServiceLog
+//
+// Retrieve the logs for a service rendered with ANSI escape sequences parsed
+//
+// ---
+// produces:
+// - application/json
+// - text/html
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: service
+//   description: ID of the service
+//   required: true
+//   type: integer
+// - in: query
+//   name: format
+//   description: Format to render the log lines in (json or html)
+//   type: string
+//   default: json
+// - in: query
+//   name: page
+//   description: The page of lines to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many lines per page to return
+//   type: integer
+//   maximum: 5000
+//   default: 500
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully rendered the service logs
+//   '400':
+//     description: Unable to render the service logs
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to render the service logs
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RenderServiceLog represents the API handler to capture and
+// render the logs for a service from the configured backend.
+func RenderServiceLog(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := service.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"org":     o,
+		"repo":    r.GetName(),
+		"service": s.GetNumber(),
+		"user":    u.GetName(),
+	}).Infof("rendering logs for service %s", entry)
+
+	// send API call to capture the service logs
+	l, err := database.FromContext(c).GetLogForService(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get logs for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	renderLog(c, l, fmt.Sprintf("service %s", entry))
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/logs/render steps RenderStepLog
+//
+// Retrieve the logs for a step rendered with ANSI escape sequences parsed
+//
+// ---
+// produces:
+// - application/json
+// - text/html
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// - in: query
+//   name: format
+//   description: Format to render the log lines in (json or html)
+//   type: string
+//   default: json
+// - in: query
+//   name: page
+//   description: The page of lines to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many lines per page to return
+//   type: integer
+//   maximum: 5000
+//   default: 500
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully rendered the step logs
+//   '400':
+//     description: Unable to render the step logs
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to render the step logs
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// RenderStepLog represents the API handler to capture and
+// render the logs for a step from the configured backend.
+func RenderStepLog(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"step":  s.GetNumber(),
+		"user":  u.GetName(),
+	}).Infof("rendering logs for step %s", entry)
+
+	// send API call to capture the step logs
+	l, err := database.FromContext(c).GetLogForStep(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get logs for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	renderLog(c, l, fmt.Sprintf("step %s", entry))
+}
+
+// renderLog is a helper function to parse the ANSI escape sequences
+// within the provided log and respond with the requested page of
+// lines in the format provided by the format query parameter.
+func renderLog(c *gin.Context, l *library.Log, entry string) {
+	// capture format query parameter if present
+	format := c.DefaultQuery("format", renderFormatJSON)
+	if format != renderFormatJSON && format != renderFormatHTML {
+		retErr := fmt.Errorf("unable to render logs for %s: invalid format %s", entry, format)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "500"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure page and per_page aren't above or below allowed values
+	page = util.MaxInt(1, page)
+	perPage = util.MaxInt(1, util.MinInt(5000, perPage))
+
+	lines := ansi.Parse(l.GetData())
+
+	// capture the requested page of lines
+	start := util.MinInt((page-1)*perPage, len(lines))
+	end := util.MinInt(start+perPage, len(lines))
+
+	// create pagination object
+	pagination := Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   int64(len(lines)),
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	if format == renderFormatHTML {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(ansi.HTML(lines[start:end])))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, lines[start:end])
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func Test_updateBuildLog(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetNumber(1)
+
+	_stepLog := new(library.Log)
+	_stepLog.SetID(1)
+	_stepLog.SetBuildID(1)
+	_stepLog.SetRepoID(1)
+	_stepLog.SetStepID(1)
+	_stepLog.SetData([]byte{})
+
+	_serviceLog := new(library.Log)
+	_serviceLog.SetID(2)
+	_serviceLog.SetBuildID(1)
+	_serviceLog.SetRepoID(1)
+	_serviceLog.SetServiceID(1)
+	_serviceLog.SetData([]byte{})
+
+	_otherLog := new(library.Log)
+	_otherLog.SetID(3)
+	_otherLog.SetBuildID(2)
+	_otherLog.SetRepoID(1)
+	_otherLog.SetStepID(2)
+	_otherLog.SetData([]byte{})
+
+	_missingLog := new(library.Log)
+	_missingLog.SetStepID(99)
+
+	_data := []byte("foo abc123")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	for _, l := range []*library.Log{_stepLog, _serviceLog, _otherLog} {
+		err = db.CreateLog(context.TODO(), l)
+		if err != nil {
+			t.Errorf("unable to create test log: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name string
+		log  *library.Log
+		want int
+	}{
+		{
+			name: "step",
+			log:  &library.Log{StepID: _stepLog.StepID, Data: &_data},
+			want: http.StatusOK,
+		},
+		{
+			name: "service",
+			log:  &library.Log{ServiceID: _serviceLog.ServiceID, Data: &[]byte{'b', 'a', 'r'}},
+			want: http.StatusOK,
+		},
+		{
+			name: "step and service",
+			log:  &library.Log{StepID: _stepLog.StepID, ServiceID: _serviceLog.ServiceID},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "no step or service",
+			log:  new(library.Log),
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "step from another build",
+			log:  &library.Log{StepID: _otherLog.StepID},
+			want: http.StatusNotFound,
+		},
+		{
+			name: "missing step",
+			log:  _missingLog,
+			want: http.StatusNotFound,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := updateBuildLog(context.TODO(), db, _build, test.log, []string{"abc123"})
+
+			if got.Status != test.want {
+				t.Errorf("updateBuildLog status is %d, want %d: %s", got.Status, test.want, got.Error)
+			}
+		})
+	}
+
+	// verify the data was stored for the step
+	got, err := db.GetLogForStep(context.TODO(), &library.Step{ID: _stepLog.StepID})
+	if err != nil {
+		t.Errorf("unable to get step log: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.GetData(), []byte("foo [secure]")) {
+		t.Errorf("updateBuildLog data is %s, want %s", got.GetData(), "foo [secure]")
+	}
+}
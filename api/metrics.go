@@ -5,14 +5,20 @@
 package api
 
 import (
+	"crypto/subtle"
+	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/auth"
+	"github.com/go-vela/server/util"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/sirupsen/logrus"
 )
 
@@ -172,15 +178,148 @@ var (
 //   description: Indicates a request for inactive worker count
 //   type: boolean
 //   default: false
+// - in: query
+//   name: include
+//   description: >-
+//     Metric families to return, may be provided multiple times or comma separated
+//     with a trailing * matching the families starting with the name (defaults to every family)
+//   type: string
+// - in: query
+//   name: exclude
+//   description: >-
+//     Metric families to leave out, may be provided multiple times or comma separated
+//     with a trailing * matching the families starting with the name
+//   type: string
+// security:
+//   - ApiKeyAuth: []
 // responses:
 //   '200':
 //     description: Successfully retrieved the Vela metrics
 //     schema:
 //       type: string
+//   '401':
+//     description: Unable to authenticate the scrape
+//     schema:
+//       "$ref": "#/definitions/Error"
 
-// BaseMetrics returns a Prometheus handler for serving go metrics.
+// BaseMetrics returns a Prometheus handler for serving go metrics,
+// limited to the metric families included and not excluded by the
+// query parameters of each scrape.
 func BaseMetrics() http.Handler {
-	return promhttp.Handler()
+	return promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer,
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			g := &metricsFilter{
+				gatherer: prometheus.DefaultGatherer,
+				include:  metricsNames(r.URL.Query()["include"]),
+				exclude:  metricsNames(r.URL.Query()["exclude"]),
+			}
+
+			promhttp.HandlerFor(g, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+		}),
+	)
+}
+
+// AuthorizeMetrics represents the API handler to authenticate scrapes
+// of the metrics endpoint with the configured bearer token or a client
+// certificate verified against the configured CA bundle. Scrapes are
+// allowed without authentication when neither is configured.
+func AuthorizeMetrics(c *gin.Context) {
+	token, _ := c.Value("metrics-token").(string)
+	mtls, _ := c.Value("metrics-mtls").(bool)
+
+	// allow anonymous scrapes when authentication isn't configured
+	if len(token) == 0 && !mtls {
+		return
+	}
+
+	// allow scrapes with a verified client certificate
+	if mtls && c.Request.TLS != nil && len(c.Request.TLS.VerifiedChains) > 0 {
+		return
+	}
+
+	if len(token) > 0 {
+		// capture the bearer token from the request
+		got, err := auth.RetrieveAccessToken(c.Request)
+		if err == nil && subtle.ConstantTimeCompare([]byte(got), []byte(token)) == 1 {
+			return
+		}
+
+		c.Header("WWW-Authenticate", `Bearer realm="metrics"`)
+	}
+
+	util.HandleError(c, http.StatusUnauthorized, errors.New("unable to authenticate scrape of metrics"))
+}
+
+// metricsFilter represents a Prometheus gatherer that only returns
+// the metric families included and not excluded by the scrape.
+type metricsFilter struct {
+	gatherer prometheus.Gatherer
+	include  []string
+	exclude  []string
+}
+
+// Gather returns the metric families from the wrapped gatherer
+// that are included and not excluded by the scrape.
+func (f *metricsFilter) Gather() ([]*dto.MetricFamily, error) {
+	families, err := f.gatherer.Gather()
+
+	filtered := []*dto.MetricFamily{}
+
+	for _, family := range families {
+		if len(f.include) > 0 && !metricsMatch(f.include, family.GetName()) {
+			continue
+		}
+
+		if metricsMatch(f.exclude, family.GetName()) {
+			continue
+		}
+
+		filtered = append(filtered, family)
+	}
+
+	// the gatherer may return the families it could
+	// gather along with the error for the others
+	return filtered, err
+}
+
+// metricsNames is a helper function to split the metric family
+// names provided as repeated or comma separated query parameters.
+func metricsNames(values []string) []string {
+	names := []string{}
+
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+
+			if len(name) > 0 {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
+// metricsMatch is a helper function to determine if the metric family
+// name matches any of the patterns, where a pattern with a trailing *
+// matches the families starting with the rest of the pattern.
+func metricsMatch(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(name, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+
+			continue
+		}
+
+		if pattern == name {
+			return true
+		}
+	}
+
+	return false
 }
 
 // CustomMetrics returns custom Prometheus metrics from private functions.
@@ -206,7 +345,7 @@ func recordGauges(c *gin.Context) {
 	// user_count
 	if q.UserCount {
 		// send API call to capture the total number of users
-		u, err := database.FromContext(c).CountUsers()
+		u, err := database.FromContext(c).CountUsers(c)
 		if err != nil {
 			logrus.Errorf("unable to get count of all users: %v", err)
 		}
@@ -217,7 +356,7 @@ func recordGauges(c *gin.Context) {
 	// repo_count
 	if q.RepoCount {
 		// send API call to capture the total number of repos
-		r, err := database.FromContext(c).CountRepos()
+		r, err := database.FromContext(c).CountRepos(c)
 		if err != nil {
 			logrus.Errorf("unable to get count of all repos: %v", err)
 		}
@@ -228,7 +367,7 @@ func recordGauges(c *gin.Context) {
 	// build_count
 	if q.BuildCount {
 		// send API call to capture the total number of builds
-		b, err := database.FromContext(c).GetBuildCount()
+		b, err := database.FromContext(c).GetBuildCount(c)
 		if err != nil {
 			logrus.Errorf("unable to get count of all builds: %v", err)
 		}
@@ -239,7 +378,7 @@ func recordGauges(c *gin.Context) {
 	// running_build_count
 	if q.RunningBuildCount {
 		// send API call to capture the total number of running builds
-		bRun, err := database.FromContext(c).GetBuildCountByStatus("running")
+		bRun, err := database.FromContext(c).GetBuildCountByStatus(c, "running")
 		if err != nil {
 			logrus.Errorf("unable to get count of all running builds: %v", err)
 		}
@@ -250,7 +389,7 @@ func recordGauges(c *gin.Context) {
 	// pending_build_count
 	if q.PendingBuildCount {
 		// send API call to capture the total number of pending builds
-		bPen, err := database.FromContext(c).GetBuildCountByStatus("pending")
+		bPen, err := database.FromContext(c).GetBuildCountByStatus(c, "pending")
 		if err != nil {
 			logrus.Errorf("unable to get count of all pending builds: %v", err)
 		}
@@ -261,7 +400,7 @@ func recordGauges(c *gin.Context) {
 	// failure_build_count
 	if q.FailureBuildCount {
 		// send API call to capture the total number of failure builds
-		bFail, err := database.FromContext(c).GetBuildCountByStatus("failure")
+		bFail, err := database.FromContext(c).GetBuildCountByStatus(c, "failure")
 		if err != nil {
 			logrus.Errorf("unable to get count of all failure builds: %v", err)
 		}
@@ -272,7 +411,7 @@ func recordGauges(c *gin.Context) {
 	// killed_build_count
 	if q.KilledBuildCount {
 		// send API call to capture the total number of killed builds
-		bKill, err := database.FromContext(c).GetBuildCountByStatus("killed")
+		bKill, err := database.FromContext(c).GetBuildCountByStatus(c, "killed")
 		if err != nil {
 			logrus.Errorf("unable to get count of all killed builds: %v", err)
 		}
@@ -283,7 +422,7 @@ func recordGauges(c *gin.Context) {
 	// success_build_count
 	if q.SuccessBuildCount {
 		// send API call to capture the total number of success builds
-		bSucc, err := database.FromContext(c).GetBuildCountByStatus("success")
+		bSucc, err := database.FromContext(c).GetBuildCountByStatus(c, "success")
 		if err != nil {
 			logrus.Errorf("unable to get count of all success builds: %v", err)
 		}
@@ -294,7 +433,7 @@ func recordGauges(c *gin.Context) {
 	// error_build_count
 	if q.ErrorBuildCount {
 		// send API call to capture the total number of error builds
-		bErr, err := database.FromContext(c).GetBuildCountByStatus("error")
+		bErr, err := database.FromContext(c).GetBuildCountByStatus(c, "error")
 		if err != nil {
 			logrus.Errorf("unable to get count of all error builds: %v", err)
 		}
@@ -305,7 +444,7 @@ func recordGauges(c *gin.Context) {
 	// step_image_count
 	if q.StepImageCount {
 		// send API call to capture the total number of step images
-		stepImageMap, err := database.FromContext(c).GetStepImageCount()
+		stepImageMap, err := database.FromContext(c).GetStepImageCount(c)
 		if err != nil {
 			logrus.Errorf("unable to get count of all step images: %v", err)
 		}
@@ -318,7 +457,7 @@ func recordGauges(c *gin.Context) {
 	// step_status_count
 	if q.StepStatusCount {
 		// send API call to capture the total number of step statuses
-		stepStatusMap, err := database.FromContext(c).GetStepStatusCount()
+		stepStatusMap, err := database.FromContext(c).GetStepStatusCount(c)
 		if err != nil {
 			logrus.Errorf("unable to get count of all step statuses: %v", err)
 		}
@@ -331,7 +470,7 @@ func recordGauges(c *gin.Context) {
 	// service_image_count
 	if q.ServiceImageCount {
 		// send API call to capture the total number of service images
-		serviceImageMap, err := database.FromContext(c).GetServiceImageCount()
+		serviceImageMap, err := database.FromContext(c).GetServiceImageCount(c)
 		if err != nil {
 			logrus.Errorf("unable to get count of all service images: %v", err)
 		}
@@ -344,7 +483,7 @@ func recordGauges(c *gin.Context) {
 	// service_status_count
 	if q.ServiceStatusCount {
 		// send API call to capture the total number of service statuses
-		serviceStatusMap, err := database.FromContext(c).GetServiceStatusCount()
+		serviceStatusMap, err := database.FromContext(c).GetServiceStatusCount(c)
 		if err != nil {
 			logrus.Errorf("unable to get count of all service statuses: %v", err)
 		}
@@ -365,7 +504,7 @@ func recordGauges(c *gin.Context) {
 	// worker_build_limit, active_worker_count, inactive_worker_count
 	if q.WorkerBuildLimit || q.ActiveWorkerCount || q.InactiveWorkerCount {
 		// send API call to capture the workers
-		workers, err := database.FromContext(c).ListWorkers()
+		workers, err := database.FromContext(c).ListWorkers(c)
 		if err != nil {
 			logrus.Errorf("unable to get workers: %v", err)
 		}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAPI_AuthorizeMetrics(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		token    string
+		mtls     bool
+		header   string
+		verified bool
+		want     int
+	}{
+		{
+			name: "without authentication",
+			want: http.StatusOK,
+		},
+		{
+			name:   "with valid token",
+			token:  "foobar",
+			header: "Bearer foobar",
+			want:   http.StatusOK,
+		},
+		{
+			name:   "with invalid token",
+			token:  "foobar",
+			header: "Bearer barfoo",
+			want:   http.StatusUnauthorized,
+		},
+		{
+			name:  "with missing token",
+			token: "foobar",
+			want:  http.StatusUnauthorized,
+		},
+		{
+			name:     "with verified client certificate",
+			token:    "foobar",
+			mtls:     true,
+			verified: true,
+			want:     http.StatusOK,
+		},
+		{
+			name: "with missing client certificate",
+			mtls: true,
+			want: http.StatusUnauthorized,
+		},
+	}
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+			context, engine := gin.CreateTestContext(resp)
+			context.Request, _ = http.NewRequest(http.MethodGet, "/metrics", nil)
+
+			if len(test.header) > 0 {
+				context.Request.Header.Set("Authorization", test.header)
+			}
+
+			if test.verified {
+				context.Request.TLS = &tls.ConnectionState{
+					VerifiedChains: [][]*x509.Certificate{{new(x509.Certificate)}},
+				}
+			}
+
+			// setup mock server
+			engine.Use(func(c *gin.Context) {
+				c.Set("metrics-token", test.token)
+				c.Set("metrics-mtls", test.mtls)
+				c.Next()
+			})
+			engine.GET("/metrics", AuthorizeMetrics, func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			engine.ServeHTTP(context.Writer, context.Request)
+
+			if resp.Code != test.want {
+				t.Errorf("AuthorizeMetrics returned %v, want %v", resp.Code, test.want)
+			}
+		})
+	}
+}
+
+func TestAPI_BaseMetrics_Filter(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		query   string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:  "without filters",
+			query: "",
+			want:  []string{"go_goroutines", "vela_totals"},
+		},
+		{
+			name:    "with include",
+			query:   "include=go_goroutines,go_threads",
+			want:    []string{"go_goroutines", "go_threads"},
+			notWant: []string{"vela_totals", "go_gc_duration_seconds"},
+		},
+		{
+			name:    "with include prefix",
+			query:   "include=go_*&include=process_*",
+			want:    []string{"go_goroutines", "go_threads"},
+			notWant: []string{"vela_totals"},
+		},
+		{
+			name:    "with exclude",
+			query:   "exclude=vela_*,step_images",
+			want:    []string{"go_goroutines"},
+			notWant: []string{"vela_totals", "step_images"},
+		},
+	}
+
+	// record a gauge so the vela families are gathered
+	totals.WithLabelValues("user", "count", "total").Set(1)
+	stepImages.WithLabelValues("alpine").Set(1)
+
+	handler := BaseMetrics()
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/metrics?"+test.query, nil)
+
+			handler.ServeHTTP(resp, req)
+
+			if resp.Code != http.StatusOK {
+				t.Errorf("BaseMetrics returned %v, want %v", resp.Code, http.StatusOK)
+			}
+
+			body := resp.Body.String()
+
+			for _, name := range test.want {
+				if !strings.Contains(body, "# TYPE "+name+" ") {
+					t.Errorf("BaseMetrics is missing %s", name)
+				}
+			}
+
+			for _, name := range test.notWant {
+				if strings.Contains(body, "# TYPE "+name+" ") {
+					t.Errorf("BaseMetrics should not contain %s", name)
+				}
+			}
+		})
+	}
+}
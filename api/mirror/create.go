@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/mirrors mirrors CreateRegistryMirror
+//
+// Create a registry mirror in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the registry mirror to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RegistryMirror"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the registry mirror
+//     schema:
+//       "$ref": "#/definitions/RegistryMirror"
+//   '400':
+//     description: Unable to create the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The registry mirror already exists
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateRegistryMirror represents the API handler to create a
+// registry mirror in the configured backend. Mirrors without an
+// org apply to every org, while mirrors with an org override
+// the mirror for the same registry for the repos in the org.
+func CreateRegistryMirror(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.RegistryMirror)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new registry mirror: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      input.GetOrg(),
+		"registry": input.GetRegistry(),
+		"user":     u.GetName(),
+	}).Infof("creating new registry mirror for %s", input.GetRegistry())
+
+	if len(input.GetRegistry()) == 0 || len(input.GetMirror()) == 0 {
+		retErr := fmt.Errorf("unable to create registry mirror: registry and mirror are required")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the list of registry mirrors
+	mirrors, err := database.FromContext(c).ListRegistryMirrors(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list registry mirrors: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	for _, m := range mirrors {
+		if strings.EqualFold(m.GetOrg(), input.GetOrg()) && strings.EqualFold(m.GetRegistry(), input.GetRegistry()) {
+			retErr := fmt.Errorf("unable to create registry mirror for %s: mirror %d already exists", input.GetRegistry(), m.GetID())
+
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+	}
+
+	// default the registry mirror to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in registry mirror object
+	input.SetID(0)
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the registry mirror
+	err = database.FromContext(c).CreateRegistryMirror(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create registry mirror for %s: %w", input.GetRegistry(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/mirrors/{mirror} mirrors DeleteRegistryMirror
+//
+// Delete a registry mirror from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: mirror
+//   description: ID of the registry mirror
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the registry mirror
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to delete the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to delete the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteRegistryMirror represents the API handler to
+// remove a registry mirror from the configured backend.
+func DeleteRegistryMirror(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"mirror": util.PathParameter(c, "mirror"),
+		"user":   u.GetName(),
+	}).Infof("deleting registry mirror %s", util.PathParameter(c, "mirror"))
+
+	m := capture(c)
+	if m == nil {
+		return
+	}
+
+	// send API call to remove the registry mirror
+	err := database.FromContext(c).DeleteRegistryMirror(c, m)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete registry mirror %d: %w", m.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("registry mirror %d deleted", m.GetID()))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mirror
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/mirrors/{mirror} mirrors GetRegistryMirror
+//
+// Get a registry mirror in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: mirror
+//   description: ID of the registry mirror
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the registry mirror
+//     schema:
+//       "$ref": "#/definitions/RegistryMirror"
+//   '400':
+//     description: Unable to retrieve the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetRegistryMirror represents the API handler to capture
+// a registry mirror from the configured backend.
+func GetRegistryMirror(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"mirror": util.PathParameter(c, "mirror"),
+		"user":   u.GetName(),
+	}).Infof("reading registry mirror %s", util.PathParameter(c, "mirror"))
+
+	m := capture(c)
+	if m == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, m)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/mirrors mirrors ListRegistryMirrors
+//
+// Get all registry mirrors in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the registry mirrors
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/RegistryMirror"
+//   '500':
+//     description: Unable to retrieve the registry mirrors
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListRegistryMirrors represents the API handler to capture
+// the list of registry mirrors from the configured backend.
+func ListRegistryMirrors(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Info("listing registry mirrors")
+
+	// send API call to capture the list of registry mirrors
+	mirrors, err := database.FromContext(c).ListRegistryMirrors(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list registry mirrors: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, mirrors)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/util"
+)
+
+// capture is a helper function to capture the registry mirror
+// for the ID in the path of the request. When the registry mirror
+// can't be captured, the error is handled and it returns nil.
+func capture(c *gin.Context) *api.RegistryMirror {
+	param := util.PathParameter(c, "mirror")
+
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid mirror parameter provided: %s", param)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return nil
+	}
+
+	// send API call to capture the registry mirror
+	m, err := database.FromContext(c).GetRegistryMirror(c, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get registry mirror %d: %w", id, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	return m
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mirror
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation PUT /api/v1/mirrors/{mirror} mirrors UpdateRegistryMirror
+//
+// Update a registry mirror in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: mirror
+//   description: ID of the registry mirror
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the registry mirror fields to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RegistryMirror"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the registry mirror
+//     schema:
+//       "$ref": "#/definitions/RegistryMirror"
+//   '400':
+//     description: Unable to update the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the registry mirror
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateRegistryMirror represents the API handler to
+// update a registry mirror in the configured backend.
+func UpdateRegistryMirror(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"mirror": util.PathParameter(c, "mirror"),
+		"user":   u.GetName(),
+	}).Infof("updating registry mirror %s", util.PathParameter(c, "mirror"))
+
+	// capture body from API request
+	input := new(api.RegistryMirror)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for registry mirror %s: %w", util.PathParameter(c, "mirror"), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	m := capture(c)
+	if m == nil {
+		return
+	}
+
+	if len(input.GetMirror()) > 0 {
+		// update mirror if set
+		m.SetMirror(input.GetMirror())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		m.SetActive(input.GetActive())
+	}
+
+	m.SetUpdatedAt(time.Now().UTC().Unix())
+	m.SetUpdatedBy(u.GetName())
+
+	// send API call to update the registry mirror
+	err = database.FromContext(c).UpdateRegistryMirror(c, m)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update registry mirror %d: %w", m.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated registry mirror
+	m, _ = database.FromContext(c).GetRegistryMirror(c, m.GetID())
+
+	c.JSON(http.StatusOK, m)
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/orghooks/{org} orghooks CreateOrgHook
+//
+// Create the webhook for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the org hook to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/OrgHook"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the org hook
+//     schema:
+//       "$ref": "#/definitions/OrgHook"
+//   '400':
+//     description: Unable to create the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The org hook already exists
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '503':
+//     description: Unable to create the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateOrgHook represents the API handler to create the webhook
+// for an org in the configured backend. Once created, the org hook
+// delivers the events for every repo in the org so the repos no
+// longer need a webhook of their own.
+func CreateOrgHook(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.OrgHook)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("creating new org hook for org %s", o)
+
+	// send API call to check if the org hook already exists
+	_, err = database.FromContext(c).GetOrgHookForOrg(c, o)
+	if err == nil {
+		retErr := fmt.Errorf("unable to create org hook for org %s: org hook already exists", o)
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	// create unique secret for the org hook
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		retErr := fmt.Errorf("unable to create secret for org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusServiceUnavailable, retErr)
+
+		return
+	}
+
+	input.SetSecret(
+		base64.StdEncoding.EncodeToString(
+			[]byte(strings.TrimSpace(uid.String())),
+		),
+	)
+
+	// send API call to create the webhook for the org
+	webhookID, err := scm.FromContext(c).EnableOrg(u, o, input.GetSecret())
+	if err != nil {
+		retErr := fmt.Errorf("unable to create webhook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// default the org hook to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in org hook object
+	input.SetID(0)
+	input.SetOrg(o)
+	input.SetWebhookID(webhookID)
+	input.SetUserID(u.GetID())
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the org hook
+	err = database.FromContext(c).CreateOrgHook(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created org hook
+	oh, _ := database.FromContext(c).GetOrgHookForOrg(c, o)
+
+	c.JSON(http.StatusCreated, oh.Sanitize())
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/orghooks/{org} orghooks DeleteOrgHook
+//
+// Delete the webhook for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the org hook
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to delete the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteOrgHook represents the API handler to remove the
+// webhook for an org from the configured backend. Repos
+// without a webhook of their own stop receiving events.
+func DeleteOrgHook(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("deleting org hook for org %s", o)
+
+	// send API call to capture the org hook
+	oh, err := database.FromContext(c).GetOrgHookForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the webhook for the org
+	err = scm.FromContext(c).DisableOrg(u, o, oh.GetWebhookID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete webhook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to remove the org hook
+	err = database.FromContext(c).DeleteOrgHook(c, oh)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("org hook for org %s deleted", o))
+}
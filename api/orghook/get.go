@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/orghooks/{org} orghooks GetOrgHook
+//
+// Get the webhook for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the org hook
+//     schema:
+//       "$ref": "#/definitions/OrgHook"
+//   '404':
+//     description: Unable to retrieve the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetOrgHook represents the API handler to capture
+// the webhook for an org from the configured backend.
+func GetOrgHook(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("reading org hook for org %s", o)
+
+	// send API call to capture the org hook
+	oh, err := database.FromContext(c).GetOrgHookForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, oh.Sanitize())
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation PUT /api/v1/orghooks/{org} orghooks UpdateOrgHook
+//
+// Update the webhook for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the org hook to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/OrgHook"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the org hook
+//     schema:
+//       "$ref": "#/definitions/OrgHook"
+//   '400':
+//     description: Unable to update the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the org hook
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateOrgHook represents the API handler to update the
+// webhook for an org in the configured backend. Only the
+// auto enable and active settings can be updated.
+func UpdateOrgHook(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("updating org hook for org %s", o)
+
+	// capture body from API request
+	input := new(api.OrgHook)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the org hook
+	oh, err := database.FromContext(c).GetOrgHookForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	if input.AutoEnable != nil {
+		// update auto enable if set
+		oh.SetAutoEnable(input.GetAutoEnable())
+
+		// repos are auto enabled on behalf of the user
+		oh.SetUserID(u.GetID())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		oh.SetActive(input.GetActive())
+	}
+
+	oh.SetUpdatedAt(time.Now().UTC().Unix())
+	oh.SetUpdatedBy(u.GetName())
+
+	// send API call to update the org hook
+	err = database.FromContext(c).UpdateOrgHook(c, oh)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update org hook for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated org hook
+	oh, _ = database.FromContext(c).GetOrgHookForOrg(c, o)
+
+	c.JSON(http.StatusOK, oh.Sanitize())
+}
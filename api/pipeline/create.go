@@ -98,7 +98,7 @@ func CreatePipeline(c *gin.Context) {
 	input.SetRepoID(r.GetID())
 
 	// send API call to create the pipeline
-	err = database.FromContext(c).CreatePipeline(input)
+	err = database.FromContext(c).CreatePipeline(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create pipeline %s/%s: %w", r.GetFullName(), input.GetCommit(), err)
 
@@ -108,7 +108,7 @@ func CreatePipeline(c *gin.Context) {
 	}
 
 	// send API call to capture the created pipeline
-	p, err := database.FromContext(c).GetPipelineForRepo(input.GetCommit(), r)
+	p, err := database.FromContext(c).GetPipelineForRepo(c, input.GetCommit(), r)
 	if err != nil {
 		retErr := fmt.Errorf("unable to capture pipeline %s/%s: %w", r.GetFullName(), input.GetCommit(), err)
 
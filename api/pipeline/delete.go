@@ -79,7 +79,7 @@ func DeletePipeline(c *gin.Context) {
 	}).Infof("deleting pipeline %s", entry)
 
 	// send API call to remove the build
-	err := database.FromContext(c).DeletePipeline(p)
+	err := database.FromContext(c).DeletePipeline(c, p)
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete pipeline %s: %w", entry, err)
 
@@ -0,0 +1,132 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package pipeline
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/compiler/expression"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/pipeline"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/pipelines/{org}/{repo}/{pipeline}/explain pipelines ExplainPipeline
+//
+// Evaluate the conditional expressions for a pipeline and explain the result of each
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: pipeline
+//   description: Commit SHA for pipeline to retrieve
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Build information to evaluate the expressions against
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/ExpressionData"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully evaluated the conditional expressions
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/ExpressionExplanation"
+//   '400':
+//     description: Unable to evaluate the conditional expressions
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ExplainPipeline represents the API handler to evaluate the
+// conditional expressions declared on the stages and steps
+// of a pipeline and explain the result of each expression.
+func ExplainPipeline(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	p := pipeline.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%s", r.GetFullName(), p.GetCommit())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"pipeline": p.GetCommit(),
+		"repo":     r.GetName(),
+		"user":     u.GetName(),
+	}).Infof("explaining conditional expressions for pipeline %s", entry)
+
+	// capture body from API request
+	input := new(expression.Data)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for pipeline %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// expressions can only be captured from yaml pipelines
+	if p.GetType() != constants.PipelineTypeYAML && len(p.GetType()) > 0 {
+		retErr := fmt.Errorf("unable to explain pipeline %s: conditional expressions are not supported for %s pipelines", entry, p.GetType())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// default the repo to the one the pipeline belongs to
+	if len(input.Repo) == 0 {
+		input.Repo = r.GetFullName()
+	}
+
+	conditions, err := expression.Conditions(p.GetData())
+	if err != nil {
+		retErr := fmt.Errorf("unable to explain pipeline %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	explanations, err := expression.ExplainAll(conditions, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to explain pipeline %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, explanations)
+}
@@ -117,7 +117,7 @@ func ListPipelines(c *gin.Context) {
 	//nolint:gomnd // ignore magic number
 	perPage = util.MaxInt(1, util.MinInt(100, perPage))
 
-	p, t, err := database.FromContext(c).ListPipelinesForRepo(r, page, perPage)
+	p, t, err := database.FromContext(c).ListPipelinesForRepo(c, r, page, perPage)
 	if err != nil {
 		retErr := fmt.Errorf("unable to list pipelines for repo %s: %w", r.GetFullName(), err)
 
@@ -96,7 +96,7 @@ func GetTemplates(c *gin.Context) {
 	}).Infof("reading templates from pipeline %s", entry)
 
 	// create the compiler object
-	compiler := compiler.FromContext(c).Duplicate().WithMetadata(m).WithRepo(r).WithUser(u)
+	compiler := compiler.FromContext(c).Duplicate().WithContext(c.Request.Context()).WithMetadata(m).WithRepo(r).WithUser(u)
 
 	// parse the pipeline configuration
 	pipeline, _, err := compiler.Parse(p.GetData(), p.GetType(), new(yaml.Template))
@@ -107,7 +107,7 @@ func GetTemplates(c *gin.Context) {
 	}
 
 	// send API call to capture the repo owner
-	user, err := database.FromContext(c).GetUser(r.GetUserID())
+	user, err := database.FromContext(c).GetUser(c, r.GetUserID())
 	if err != nil {
 		util.HandleError(c, http.StatusBadRequest, fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err))
 
@@ -136,6 +136,11 @@ func GetTemplates(c *gin.Context) {
 			source = fmt.Sprintf("%s%s/%s/%s@%s", registry.URL, o, r.GetName(), source, p.GetCommit())
 		}
 
+		// if type is org, compose a source string from the shared repo for the org
+		if strings.EqualFold(template.Type, "org") {
+			source = fmt.Sprintf("%s%s/%s/%s", registry.URL, o, c.Value("orgTemplateRepo"), source)
+		}
+
 		// parse the source for the template using the compiler registry client
 		src, err := registry.Parse(source)
 		if err != nil {
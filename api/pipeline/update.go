@@ -170,7 +170,7 @@ func UpdatePipeline(c *gin.Context) {
 	}
 
 	// send API call to update the pipeline
-	err = database.FromContext(c).UpdatePipeline(p)
+	err = database.FromContext(c).UpdatePipeline(c, p)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update pipeline %s: %w", entry, err)
 
@@ -180,7 +180,7 @@ func UpdatePipeline(c *gin.Context) {
 	}
 
 	// send API call to capture the updated pipeline
-	p, err = database.FromContext(c).GetPipelineForRepo(p.GetCommit(), r)
+	p, err = database.FromContext(c).GetPipelineForRepo(c, p.GetCommit(), r)
 	if err != nil {
 		retErr := fmt.Errorf("unable to capture pipeline %s: %w", entry, err)
 
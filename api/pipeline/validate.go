@@ -94,7 +94,7 @@ func ValidatePipeline(c *gin.Context) {
 	r.SetPipelineType(p.GetType())
 
 	// create the compiler object
-	compiler := compiler.FromContext(c).Duplicate().WithMetadata(m).WithRepo(r).WithUser(u)
+	compiler := compiler.FromContext(c).Duplicate().WithContext(c.Request.Context()).WithMetadata(m).WithRepo(r).WithUser(u)
 
 	// capture optional template query parameter
 	template, err := strconv.ParseBool(c.DefaultQuery("template", "true"))
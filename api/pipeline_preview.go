@@ -0,0 +1,251 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/sirupsen/logrus"
+)
+
+// pipelinePreviewCommentKey represents the key for the pull request
+// comment previewing the changes to the pipeline for a pull request.
+const pipelinePreviewCommentKey = "pipeline"
+
+// pipelineFiles represents the pipeline configuration
+// files captured from the root of a repo.
+var pipelineFiles = []string{".vela.yml", ".vela.yaml", ".vela.star", ".vela.py"}
+
+// pipelineChange represents a change to a container
+// in the pipeline shown in the preview comment.
+type pipelineChange struct {
+	// specifies how the container changed (added, removed or changed)
+	Change string
+	// specifies the type of container (service or step)
+	Kind string
+	// specifies the name of the container
+	Name string
+	// specifies the image for the container
+	Image string
+}
+
+// previewPipeline is a helper function to compile the pipeline configuration
+// from a pull request that modifies the pipeline, and the pipeline configuration
+// from the base of the pull request, against the context for the pull request.
+// The changes to the pipeline, or the errors compiling the pipeline, are posted
+// in a comment on the pull request. Nothing from the preview is run and failing
+// to comment on the pull request is logged and doesn't fail the request.
+//
+//nolint:lll // ignore long line length due to parameters
+func previewPipeline(c *gin.Context, m *types.Metadata, u *library.User, r *library.Repo, b *library.Build, buildCtx *api.BuildContext, files []string, number int) {
+	// check if the server is configured to preview pipelines
+	enabled, _ := c.Value("pipelinePreview").(bool)
+	if !enabled {
+		return
+	}
+
+	// skip builds not from a pull request that modifies the pipeline
+	if !strings.EqualFold(b.GetEvent(), constants.EventPull) || number == 0 || !pipelineChanged(files) {
+		return
+	}
+
+	logrus.Debugf("previewing pipeline changes for %s/pull/%d", r.GetFullName(), number)
+
+	compile := func(ref string) (*pipeline.Build, error) {
+		// send API call to capture the pipeline configuration file
+		config, err := scm.FromContext(c).Config(u, r, ref)
+		if err != nil {
+			return nil, err
+		}
+
+		// parse and compile the pipeline configuration file without running it
+		p, _, err := compiler.FromContext(c).Duplicate().
+			WithBuild(b).
+			WithBuildContext(buildCtx).
+			WithContext(c.Request.Context()).
+			WithFiles(files).
+			WithMetadata(m).
+			WithRepo(r).
+			WithUser(u).
+			Compile(config)
+
+		return p, err
+	}
+
+	var comment string
+
+	head, err := compile(b.GetCommit())
+	if err != nil {
+		comment = pipelinePreviewErrorComment(err)
+	} else {
+		// compile the pipeline from the base of the pull request, which
+		// doesn't exist when the pull request adds the pipeline
+		base, err := compile(b.GetBaseRef())
+		if err != nil {
+			logrus.Debugf("unable to compile base pipeline for %s/pull/%d: %v", r.GetFullName(), number, err)
+
+			base = nil
+		}
+
+		comment = pipelinePreviewComment(diffPipelines(base, head), len(containers(head)))
+	}
+
+	// send API call to create or update the comment on the pull request
+	err = scm.FromContext(c).UpsertPullRequestComment(u, r, number, pipelinePreviewCommentKey, comment)
+	if err != nil {
+		logrus.Errorf("unable to comment pipeline preview on %s/pull/%d: %v", r.GetFullName(), number, err)
+	}
+}
+
+// pipelineChanged is a helper function to check if the provided
+// files include the pipeline configuration file or templates.
+func pipelineChanged(files []string) bool {
+	for _, file := range files {
+		for _, name := range pipelineFiles {
+			if file == name {
+				return true
+			}
+		}
+
+		// templates are commonly stored in the .vela directory
+		// or use the .tmpl extension within the repo
+		if strings.HasPrefix(file, ".vela/") || path.Ext(file) == ".tmpl" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containers is a helper function to capture the services
+// and steps, including the steps in stages, for the
+// pipeline keyed by the type and name of the container.
+func containers(p *pipeline.Build) map[string]*pipeline.Container {
+	result := make(map[string]*pipeline.Container)
+
+	if p == nil {
+		return result
+	}
+
+	for _, service := range p.Services {
+		result["service/"+service.Name] = service
+	}
+
+	for _, stage := range p.Stages {
+		for _, step := range stage.Steps {
+			result["step/"+path.Join(stage.Name, step.Name)] = step
+		}
+	}
+
+	for _, step := range p.Steps {
+		result["step/"+step.Name] = step
+	}
+
+	return result
+}
+
+// diffPipelines is a helper function to capture the services and steps
+// added, removed or changed between the base and head pipelines. A
+// container is changed when the image or commands for it change.
+func diffPipelines(base, head *pipeline.Build) []*pipelineChange {
+	before := containers(base)
+	after := containers(head)
+
+	changes := []*pipelineChange{}
+
+	add := func(change, key string, c *pipeline.Container) {
+		kind, name, _ := strings.Cut(key, "/")
+
+		changes = append(changes, &pipelineChange{
+			Change: change,
+			Kind:   kind,
+			Name:   name,
+			Image:  c.Image,
+		})
+	}
+
+	for key, c := range after {
+		old, ok := before[key]
+
+		switch {
+		case !ok:
+			add("added", key, c)
+		case old.Image != c.Image || !reflect.DeepEqual(old.Commands, c.Commands):
+			add("changed", key, c)
+		}
+	}
+
+	for key, c := range before {
+		if _, ok := after[key]; !ok {
+			add("removed", key, c)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return changes[i].Kind < changes[j].Kind
+		}
+
+		return changes[i].Name < changes[j].Name
+	})
+
+	return changes
+}
+
+// pipelinePreviewComment is a helper function to render the changes
+// to the pipeline as a markdown pull request comment.
+func pipelinePreviewComment(changes []*pipelineChange, total int) string {
+	var b strings.Builder
+
+	b.WriteString("### Vela pipeline preview\n\n")
+	b.WriteString(":white_check_mark: The pipeline for this pull request compiles. Nothing was run for this preview.\n")
+
+	if len(changes) == 0 {
+		b.WriteString("\nNo services or steps changed.\n")
+
+		return b.String()
+	}
+
+	b.WriteString("\n| Change | Type | Name | Image |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+
+	unchanged := total
+
+	for _, change := range changes {
+		fmt.Fprintf(&b, "| %s | %s | %s | `%s` |\n", change.Change, change.Kind, change.Name, change.Image)
+
+		if change.Change != "removed" {
+			unchanged--
+		}
+	}
+
+	fmt.Fprintf(&b, "\n%d services and steps are unchanged.\n", unchanged)
+
+	return b.String()
+}
+
+// pipelinePreviewErrorComment is a helper function to render the error compiling
+// the pipeline for a pull request as a markdown pull request comment.
+func pipelinePreviewErrorComment(err error) string {
+	var b strings.Builder
+
+	b.WriteString("### Vela pipeline preview\n\n")
+	b.WriteString(":x: The pipeline for this pull request fails to compile:\n\n")
+	fmt.Fprintf(&b, "```\n%s\n```\n", err.Error())
+
+	return b.String()
+}
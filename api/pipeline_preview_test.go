@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/pipeline"
+)
+
+func TestAPI_pipelineChanged(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		files []string
+		want  bool
+	}{
+		{files: []string{"README.md", ".vela.yml"}, want: true},
+		{files: []string{".vela.star"}, want: true},
+		{files: []string{".vela/templates/go.yml"}, want: true},
+		{files: []string{"ci/build.tmpl"}, want: true},
+		{files: []string{"README.md", "main.go"}, want: false},
+		{files: []string{"docs/.vela.yml.md"}, want: false},
+		{files: nil, want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := pipelineChanged(test.files)
+
+		if got != test.want {
+			t.Errorf("pipelineChanged for %v is %v, want %v", test.files, got, test.want)
+		}
+	}
+}
+
+func TestAPI_diffPipelines(t *testing.T) {
+	// setup types
+	base := &pipeline.Build{
+		Services: pipeline.ContainerSlice{
+			{Name: "postgres", Image: "postgres:14"},
+		},
+		Steps: pipeline.ContainerSlice{
+			{Name: "build", Image: "golang:1.20", Commands: []string{"go build"}},
+			{Name: "lint", Image: "golangci/golangci-lint:latest"},
+			{Name: "test", Image: "golang:1.20", Commands: []string{"go test"}},
+		},
+	}
+
+	head := &pipeline.Build{
+		Services: pipeline.ContainerSlice{
+			{Name: "postgres", Image: "postgres:14"},
+		},
+		Steps: pipeline.ContainerSlice{
+			{Name: "build", Image: "golang:1.21", Commands: []string{"go build"}},
+			{Name: "publish", Image: "target/vela-docker:latest"},
+			{Name: "test", Image: "golang:1.20", Commands: []string{"go test ./..."}},
+		},
+	}
+
+	want := []*pipelineChange{
+		{Change: "changed", Kind: "step", Name: "build", Image: "golang:1.21"},
+		{Change: "removed", Kind: "step", Name: "lint", Image: "golangci/golangci-lint:latest"},
+		{Change: "added", Kind: "step", Name: "publish", Image: "target/vela-docker:latest"},
+		{Change: "changed", Kind: "step", Name: "test", Image: "golang:1.20"},
+	}
+
+	// run test
+	got := diffPipelines(base, head)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffPipelines is %v, want %v", got, want)
+	}
+
+	// the pipeline is added when the base doesn't exist
+	got = diffPipelines(nil, head)
+
+	if len(got) != 4 {
+		t.Errorf("diffPipelines without base returned %d changes, want 4", len(got))
+	}
+
+	for _, change := range got {
+		if change.Change != "added" {
+			t.Errorf("diffPipelines without base returned %s change, want added", change.Change)
+		}
+	}
+}
+
+func TestAPI_diffPipelines_Stages(t *testing.T) {
+	// setup types
+	base := &pipeline.Build{
+		Stages: pipeline.StageSlice{
+			{Name: "test", Steps: pipeline.ContainerSlice{{Name: "test", Image: "golang:1.20"}}},
+		},
+	}
+
+	head := &pipeline.Build{
+		Stages: pipeline.StageSlice{
+			{Name: "test", Steps: pipeline.ContainerSlice{{Name: "test", Image: "golang:1.20"}}},
+			{Name: "build", Steps: pipeline.ContainerSlice{{Name: "test", Image: "golang:1.20"}}},
+		},
+	}
+
+	want := []*pipelineChange{
+		{Change: "added", Kind: "step", Name: "build/test", Image: "golang:1.20"},
+	}
+
+	// run test
+	got := diffPipelines(base, head)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffPipelines is %v, want %v", got, want)
+	}
+}
+
+func TestAPI_pipelinePreviewComment(t *testing.T) {
+	// setup types
+	changes := []*pipelineChange{
+		{Change: "changed", Kind: "step", Name: "build", Image: "golang:1.21"},
+		{Change: "removed", Kind: "step", Name: "lint", Image: "golangci/golangci-lint:latest"},
+	}
+
+	want := "### Vela pipeline preview\n\n" +
+		":white_check_mark: The pipeline for this pull request compiles. Nothing was run for this preview.\n" +
+		"\n| Change | Type | Name | Image |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| changed | step | build | `golang:1.21` |\n" +
+		"| removed | step | lint | `golangci/golangci-lint:latest` |\n" +
+		"\n2 services and steps are unchanged.\n"
+
+	// run test
+	got := pipelinePreviewComment(changes, 3)
+
+	if got != want {
+		t.Errorf("pipelinePreviewComment is %v, want %v", got, want)
+	}
+
+	want = "### Vela pipeline preview\n\n" +
+		":white_check_mark: The pipeline for this pull request compiles. Nothing was run for this preview.\n" +
+		"\nNo services or steps changed.\n"
+
+	got = pipelinePreviewComment(nil, 3)
+
+	if got != want {
+		t.Errorf("pipelinePreviewComment is %v, want %v", got, want)
+	}
+}
+
+func TestAPI_pipelinePreviewErrorComment(t *testing.T) {
+	// setup types
+	want := "### Vela pipeline preview\n\n" +
+		":x: The pipeline for this pull request fails to compile:\n\n" +
+		"```\nunable to parse yaml\n```\n"
+
+	// run test
+	got := pipelinePreviewErrorComment(errors.New("unable to parse yaml"))
+
+	if got != want {
+		t.Errorf("pipelinePreviewErrorComment is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// pipelineSource is a helper function to capture the commit and ref to
+// source the pipeline configuration for a build from. When the pipeline
+// ref is provided, the pipeline configuration from the commit the ref
+// points to is built against the commit for the build, i.e. building a
+// release branch with the pipeline from the default branch. Otherwise,
+// the pipeline configuration is sourced from the commit for the build.
+func pipelineSource(s scm.Service, u *library.User, r *library.Repo, b *library.Build, ref string) (string, string, error) {
+	if len(ref) == 0 {
+		return b.GetCommit(), b.GetRef(), nil
+	}
+
+	// send API call to capture the commit the pipeline ref points to
+	commit, err := s.GetCommitSHA(u, r, ref)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to get commit for pipeline ref %s for %s: %w", ref, r.GetFullName(), err)
+	}
+
+	logrus.Debugf("sourcing pipeline for %s build from %s (%s)", r.GetFullName(), ref, commit)
+
+	return commit, ref, nil
+}
+
+// buildPipeline is a helper function to capture the pipeline recorded on
+// a build, which may be sourced from a different ref than the commit for
+// the build. Builds without a pipeline recorded fall back to the pipeline
+// for the commit for the build.
+func buildPipeline(ctx context.Context, db database.Service, r *library.Repo, b *library.Build) (*library.Pipeline, error) {
+	if b.GetPipelineID() > 0 {
+		// send API call to capture the pipeline recorded on the build
+		p, err := db.GetPipeline(ctx, b.GetPipelineID())
+		if err == nil && p.GetRepoID() == r.GetID() {
+			return p, nil
+		}
+	}
+
+	// send API call to capture the pipeline for the commit
+	return db.GetPipelineForRepo(ctx, b.GetCommit(), r)
+}
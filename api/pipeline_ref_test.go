@@ -0,0 +1,182 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/types/library"
+)
+
+func TestAPI_pipelineSource(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	// setup mock server
+	engine.GET("/api/v3/repos/github/octocat/commits/:ref", func(c *gin.Context) {
+		if c.Param("ref") != "main" {
+			c.Status(http.StatusNotFound)
+
+			return
+		}
+
+		c.String(http.StatusOK, "9b1d8e0ac8e4e4bd6e7f9a4b1c5f4a3b2e1d0c9f")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	_user := new(library.User)
+	_user.SetName("octocat")
+	_user.SetToken("foo")
+
+	_repo := new(library.Repo)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+
+	_build := new(library.Build)
+	_build.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	_build.SetRef("refs/heads/release")
+
+	client, _ := github.NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		name       string
+		ref        string
+		wantCommit string
+		wantRef    string
+		failure    bool
+	}{
+		{
+			name:       "without pipeline ref",
+			ref:        "",
+			wantCommit: "48afb5bdc41ad69bf22588491333f7cf71135163",
+			wantRef:    "refs/heads/release",
+		},
+		{
+			name:       "with pipeline ref",
+			ref:        "main",
+			wantCommit: "9b1d8e0ac8e4e4bd6e7f9a4b1c5f4a3b2e1d0c9f",
+			wantRef:    "main",
+		},
+		{
+			name:    "with missing pipeline ref",
+			ref:     "missing",
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			commit, ref, err := pipelineSource(client, _user, _repo, _build, test.ref)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("pipelineSource should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("pipelineSource returned err: %v", err)
+			}
+
+			if commit != test.wantCommit {
+				t.Errorf("pipelineSource commit is %v, want %v", commit, test.wantCommit)
+			}
+
+			if ref != test.wantRef {
+				t.Errorf("pipelineSource ref is %v, want %v", ref, test.wantRef)
+			}
+		})
+	}
+}
+
+func TestAPI_buildPipeline(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+
+	_commit := new(library.Pipeline)
+	_commit.SetRepoID(1)
+	_commit.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	_commit.SetRef("refs/heads/release")
+	_commit.SetType("yaml")
+	_commit.SetVersion("1")
+	_commit.SetData([]byte("version: 1"))
+
+	_ref := new(library.Pipeline)
+	_ref.SetRepoID(1)
+	_ref.SetCommit("9b1d8e0ac8e4e4bd6e7f9a4b1c5f4a3b2e1d0c9f")
+	_ref.SetRef("main")
+	_ref.SetType("yaml")
+	_ref.SetVersion("1")
+	_ref.SetData([]byte("version: 1"))
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	for _, p := range []*library.Pipeline{_commit, _ref} {
+		err = db.CreatePipeline(context.TODO(), p)
+		if err != nil {
+			t.Errorf("unable to create pipeline %s: %v", p.GetCommit(), err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name       string
+		pipelineID int64
+		want       string
+	}{
+		{
+			name:       "pipeline recorded on build",
+			pipelineID: 2,
+			want:       "main",
+		},
+		{
+			name:       "without pipeline recorded on build",
+			pipelineID: 0,
+			want:       "refs/heads/release",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_build := new(library.Build)
+			_build.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+			_build.SetPipelineID(test.pipelineID)
+
+			got, err := buildPipeline(context.TODO(), db, _repo, _build)
+			if err != nil {
+				t.Errorf("buildPipeline returned err: %v", err)
+			}
+
+			if got.GetRef() != test.want {
+				t.Errorf("buildPipeline ref is %v, want %v", got.GetRef(), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,634 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// previewCommentKey represents the key for the pull request
+// comment that surfaces the links to the preview environments.
+const previewCommentKey = "previews"
+
+// previewStatuses represents the statuses that can be
+// reported for a preview environment.
+var previewStatuses = map[string]bool{
+	types.PreviewStatusPending:   true,
+	types.PreviewStatusActive:    true,
+	types.PreviewStatusFailed:    true,
+	types.PreviewStatusTeardown:  true,
+	types.PreviewStatusDestroyed: true,
+}
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/previews builds CreatePreviewEnvironment
+//
+// Register a preview environment deployed by a pull request build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the preview environment to register
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/PreviewEnvironment"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully registered the preview environment
+//     schema:
+//       "$ref": "#/definitions/PreviewEnvironment"
+//   '400':
+//     description: Unable to register the preview environment
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to register the preview environment
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreatePreviewEnvironment represents the API handler to register a
+// preview environment deployed by a pull request build. A preview
+// environment with the same name for the pull request is replaced,
+// so later builds for the pull request update the same environment.
+func CreatePreviewEnvironment(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// capture body from API request
+	input := new(types.PreviewEnvironment)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for preview environment for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("registering preview environment %s for build %s", input.GetName(), entry)
+
+	// preview environments are only supported for pull request builds
+	number, err := getPRNumberFromBuild(b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to register preview environment for build %s: build is not for a pull request", entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// default the preview environment to active
+	if input.Status == nil {
+		input.SetStatus(types.PreviewStatusActive)
+	}
+
+	err = validatePreviewEnvironment(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to register preview environment for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the preview environments for the pull request
+	previews, err := database.FromContext(c).ListPreviewEnvironmentsForPull(c, r, number)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list preview environments for %s/pull/%d: %w", r.GetFullName(), number, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// update fields in preview environment object
+	input.SetID(0)
+	input.SetRepoID(r.GetID())
+	input.SetBuildID(b.GetID())
+	input.SetNumber(number)
+	input.SetCreated(time.Now().UTC().Unix())
+	input.SetUpdated(time.Now().UTC().Unix())
+
+	for _, preview := range previews {
+		if preview.GetName() == input.GetName() {
+			input.SetID(preview.GetID())
+			input.SetCreated(preview.GetCreated())
+
+			break
+		}
+	}
+
+	if input.GetID() == 0 {
+		// send API call to create the preview environment
+		err = database.FromContext(c).CreatePreviewEnvironment(c, input)
+	} else {
+		// send API call to update the preview environment
+		err = database.FromContext(c).UpdatePreviewEnvironment(c, input)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to register preview environment %s for build %s: %w", input.GetName(), entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the registered preview environments for the pull request
+	previews, _ = database.FromContext(c).ListPreviewEnvironmentsForPull(c, r, number)
+	for _, preview := range previews {
+		if preview.GetName() == input.GetName() {
+			input = preview
+
+			break
+		}
+	}
+
+	reportPreviewEnvironments(c, r, number, previews)
+
+	c.JSON(http.StatusCreated, input)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/previews builds GetBuildPreviewEnvironments
+//
+// Get the preview environments for the pull request of a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the preview environments
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/PreviewEnvironment"
+//   '400':
+//     description: Unable to retrieve the preview environments
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the preview environments
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildPreviewEnvironments represents the API handler to capture
+// the preview environments for the pull request of a build.
+func GetBuildPreviewEnvironments(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading preview environments for build %s", entry)
+
+	number, err := getPRNumberFromBuild(b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get preview environments for build %s: build is not for a pull request", entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the preview environments for the pull request
+	previews, err := database.FromContext(c).ListPreviewEnvironmentsForPull(c, r, number)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get preview environments for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, previews)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/previews repos ListPreviewEnvironments
+//
+// Get the preview environments for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: query
+//   name: number
+//   description: Filter by the number of the pull request
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the preview environments
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/PreviewEnvironment"
+//   '400':
+//     description: Unable to retrieve the preview environments
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the preview environments
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListPreviewEnvironments represents the API handler to capture
+// the preview environments for a repo. Controllers use the list
+// to find the preview environments waiting to be torn down.
+func ListPreviewEnvironments(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("reading preview environments for repo %s", r.GetFullName())
+
+	var (
+		previews []*types.PreviewEnvironment
+		err      error
+	)
+
+	if param := c.Query("number"); len(param) > 0 {
+		number, convErr := strconv.Atoi(param)
+		if convErr != nil {
+			retErr := fmt.Errorf("invalid number query parameter provided: %s", param)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		// send API call to capture the preview environments for the pull request
+		previews, err = database.FromContext(c).ListPreviewEnvironmentsForPull(c, r, number)
+	} else {
+		// send API call to capture the preview environments for the repo
+		previews, err = database.FromContext(c).ListPreviewEnvironmentsForRepo(c, r)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to get preview environments for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, previews)
+}
+
+// swagger:operation PUT /api/v1/repos/{org}/{repo}/previews/{preview} repos UpdatePreviewEnvironment
+//
+// Update the status of a preview environment for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: preview
+//   description: ID of the preview environment
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the preview environment to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/PreviewEnvironment"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the preview environment
+//     schema:
+//       "$ref": "#/definitions/PreviewEnvironment"
+//   '400':
+//     description: Unable to update the preview environment
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to find the preview environment
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the preview environment
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdatePreviewEnvironment represents the API handler to update the
+// URL, status or expiry of a preview environment for a repo. Controllers
+// mark the preview environment destroyed once it has been torn down.
+func UpdatePreviewEnvironment(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	param := util.PathParameter(c, "preview")
+
+	entry := fmt.Sprintf("%s/%s", r.GetFullName(), param)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("updating preview environment %s", entry)
+
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid preview parameter provided: %s", param)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the preview environment
+	preview, err := database.FromContext(c).GetPreviewEnvironment(c, id)
+	if err != nil || preview.GetRepoID() != r.GetID() {
+		retErr := fmt.Errorf("unable to get preview environment %s", entry)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// capture body from API request
+	input := new(types.PreviewEnvironment)
+
+	err = c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for preview environment %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update fields in preview environment object
+	if input.URL != nil {
+		preview.SetURL(input.GetURL())
+	}
+
+	if input.Status != nil {
+		preview.SetStatus(input.GetStatus())
+	}
+
+	if input.Expires != nil {
+		preview.SetExpires(input.GetExpires())
+	}
+
+	err = validatePreviewEnvironment(preview)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update preview environment %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	preview.SetUpdated(time.Now().UTC().Unix())
+
+	// send API call to update the preview environment
+	err = database.FromContext(c).UpdatePreviewEnvironment(c, preview)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update preview environment %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated preview environment
+	preview, _ = database.FromContext(c).GetPreviewEnvironment(c, id)
+
+	// send API call to capture the preview environments for the pull request
+	previews, err := database.FromContext(c).ListPreviewEnvironmentsForPull(c, r, preview.GetNumber())
+	if err == nil {
+		reportPreviewEnvironments(c, r, preview.GetNumber(), previews)
+	}
+
+	c.JSON(http.StatusOK, preview)
+}
+
+// teardownPreviewEnvironments is a helper function to mark the preview
+// environments for a pull request to be torn down once the pull request
+// is closed. Controllers watching the preview environments destroy them
+// and report the destroyed status back.
+func teardownPreviewEnvironments(c *gin.Context, r *library.Repo, number int) error {
+	// send API call to capture the preview environments for the pull request
+	previews, err := database.FromContext(c).ListPreviewEnvironmentsForPull(c, r, number)
+	if err != nil {
+		return err
+	}
+
+	changed := false
+
+	for _, preview := range previews {
+		if preview.GetStatus() == types.PreviewStatusTeardown ||
+			preview.GetStatus() == types.PreviewStatusDestroyed {
+			continue
+		}
+
+		logrus.Infof("tearing down preview environment %s for %s/pull/%d", preview.GetName(), r.GetFullName(), number)
+
+		preview.SetStatus(types.PreviewStatusTeardown)
+		preview.SetUpdated(time.Now().UTC().Unix())
+
+		// send API call to update the preview environment
+		err = database.FromContext(c).UpdatePreviewEnvironment(c, preview)
+		if err != nil {
+			return err
+		}
+
+		changed = true
+	}
+
+	if changed {
+		reportPreviewEnvironments(c, r, number, previews)
+	}
+
+	return nil
+}
+
+// reportPreviewEnvironments is a helper function to surface the links to
+// the preview environments in a comment on the pull request. Failing to
+// comment on the pull request is logged and doesn't fail the request.
+func reportPreviewEnvironments(c *gin.Context, r *library.Repo, number int, previews []*types.PreviewEnvironment) {
+	if len(previews) == 0 {
+		return
+	}
+
+	// send API call to capture the repo owner
+	owner, err := database.FromContext(c).GetUser(c, r.GetUserID())
+	if err != nil {
+		logrus.Errorf("unable to get owner for %s: %v", r.GetFullName(), err)
+
+		return
+	}
+
+	// send API call to create or update the comment on the pull request
+	err = scm.FromContext(c).UpsertPullRequestComment(owner, r, number, previewCommentKey, previewComment(previews))
+	if err != nil {
+		logrus.Errorf("unable to comment preview environments on %s/pull/%d: %v", r.GetFullName(), number, err)
+	}
+}
+
+// previewComment is a helper function to render the
+// preview environments as a markdown pull request comment.
+func previewComment(previews []*types.PreviewEnvironment) string {
+	var b strings.Builder
+
+	b.WriteString("### Preview Environments\n\n")
+	b.WriteString("| Name | URL | Status | Expires |\n")
+	b.WriteString("| --- | --- | --- | --- |\n")
+
+	for _, preview := range previews {
+		link := "-"
+		if len(preview.GetURL()) > 0 && preview.GetStatus() != types.PreviewStatusDestroyed {
+			link = fmt.Sprintf("[%s](%s)", preview.GetURL(), preview.GetURL())
+		}
+
+		expires := "-"
+		if preview.GetExpires() > 0 {
+			expires = time.Unix(preview.GetExpires(), 0).UTC().Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", preview.GetName(), link, preview.GetStatus(), expires)
+	}
+
+	return b.String()
+}
+
+// validatePreviewEnvironment is a helper function to verify
+// the name, URL and status of a preview environment.
+func validatePreviewEnvironment(p *types.PreviewEnvironment) error {
+	if len(p.GetName()) == 0 {
+		return fmt.Errorf("no name provided")
+	}
+
+	if !previewStatuses[p.GetStatus()] {
+		return fmt.Errorf("invalid status %q provided", p.GetStatus())
+	}
+
+	if len(p.GetURL()) > 0 {
+		u, err := url.ParseRequestURI(p.GetURL())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("invalid url %q provided", p.GetURL())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+)
+
+func TestAPI_validatePreviewEnvironment(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		url     string
+		status  string
+		failure bool
+	}{
+		{name: "web", url: "https://pr-1.preview.example.com", status: types.PreviewStatusActive},
+		{name: "web", status: types.PreviewStatusPending},
+		{name: "", url: "https://pr-1.preview.example.com", status: types.PreviewStatusActive, failure: true},
+		{name: "web", url: "https://pr-1.preview.example.com", status: "running", failure: true},
+		{name: "web", url: "ftp://pr-1.preview.example.com", status: types.PreviewStatusActive, failure: true},
+		{name: "web", url: "pr-1.preview.example.com", status: types.PreviewStatusActive, failure: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		p := new(types.PreviewEnvironment)
+		p.SetName(test.name)
+		p.SetURL(test.url)
+		p.SetStatus(test.status)
+
+		err := validatePreviewEnvironment(p)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("validatePreviewEnvironment for %q should have returned err", test.url)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("validatePreviewEnvironment for %q returned err: %v", test.url, err)
+		}
+	}
+}
+
+func TestAPI_previewComment(t *testing.T) {
+	// setup types
+	web := new(types.PreviewEnvironment)
+	web.SetName("web")
+	web.SetURL("https://pr-1.preview.example.com")
+	web.SetStatus(types.PreviewStatusActive)
+	web.SetExpires(1563474077)
+
+	worker := new(types.PreviewEnvironment)
+	worker.SetName("worker")
+	worker.SetURL("https://pr-1.worker.preview.example.com")
+	worker.SetStatus(types.PreviewStatusDestroyed)
+
+	want := `### Preview Environments
+
+| Name | URL | Status | Expires |
+| --- | --- | --- | --- |
+| web | [https://pr-1.preview.example.com](https://pr-1.preview.example.com) | active | 2019-07-18T18:21:17Z |
+| worker | - | destroyed | - |
+`
+
+	// run test
+	got := previewComment([]*types.PreviewEnvironment{web, worker})
+
+	if got != want {
+		t.Errorf("previewComment is %v, want %v", got, want)
+	}
+}
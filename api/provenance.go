@@ -0,0 +1,375 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/yaml"
+	"github.com/sirupsen/logrus"
+)
+
+// provenanceBuildLimit represents the maximum number of
+// builds captured for a commit when tracing provenance.
+const provenanceBuildLimit = 100
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/provenance/artifacts/{digest} repos GetArtifactProvenance
+//
+// Trace an artifact back to the builds that produced it
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: digest
+//   description: Digest of the artifact, i.e. sha256:<hex>
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully traced the artifact
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Provenance"
+//   '400':
+//     description: Unable to trace the artifact
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to find the artifact
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to trace the artifact
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetArtifactProvenance represents the API handler to trace an
+// artifact digest back to the builds that produced it, along with
+// the commit, pipeline, templates and images used by each build
+// and the deployments of the commit.
+func GetArtifactProvenance(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	digest := util.PathParameter(c, "digest")
+
+	entry := fmt.Sprintf("%s@%s", r.GetFullName(), digest)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("reading provenance for artifact %s", entry)
+
+	if !artifactDigest.MatchString(digest) {
+		retErr := fmt.Errorf("invalid digest parameter provided: %s", digest)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the artifacts for the digest
+	artifacts, err := database.FromContext(c).ListArtifactsForDigest(c, r, digest)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get artifacts for %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	if len(artifacts) == 0 {
+		retErr := fmt.Errorf("unable to find artifact %s", entry)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	provenance := []*types.Provenance{}
+	seen := make(map[int64]bool)
+
+	for _, artifact := range artifacts {
+		// artifacts are ordered by build so skip builds already traced
+		if seen[artifact.GetBuildID()] {
+			continue
+		}
+
+		seen[artifact.GetBuildID()] = true
+
+		// send API call to capture the build that produced the artifact
+		b, err := database.FromContext(c).GetBuildByID(c, artifact.GetBuildID())
+		if err != nil {
+			retErr := fmt.Errorf("unable to get build %d for %s: %w", artifact.GetBuildID(), entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		p, err := buildProvenance(c, r, u, b)
+		if err != nil {
+			retErr := fmt.Errorf("unable to trace %s: %w", entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		provenance = append(provenance, p)
+	}
+
+	c.JSON(http.StatusOK, provenance)
+}
+
+// swagger:operation GET /api/v1/deployments/{org}/{repo}/{deployment}/provenance deployment GetDeploymentProvenance
+//
+// Trace a deployment back to the builds that produced it
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: deployment
+//   description: Number of the deployment
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully traced the deployment
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Provenance"
+//   '400':
+//     description: Unable to trace the deployment
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to trace the deployment
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetDeploymentProvenance represents the API handler to trace a
+// deployment back to the builds for the deployed commit that
+// recorded artifacts. When no build for the commit recorded
+// artifacts, the builds for the deployment itself are traced.
+func GetDeploymentProvenance(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	deployment := util.PathParameter(c, "deployment")
+
+	entry := fmt.Sprintf("%s/%s", r.GetFullName(), deployment)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("reading provenance for deployment %s", entry)
+
+	number, err := strconv.Atoi(deployment)
+	if err != nil {
+		retErr := fmt.Errorf("invalid deployment parameter provided: %s", deployment)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the deployment
+	d, err := scm.FromContext(c).GetDeployment(u, r, int64(number))
+	if err != nil {
+		retErr := fmt.Errorf("unable to get deployment %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the builds for the deployed commit
+	builds, _, err := database.FromContext(c).GetRepoBuildList(
+		c, r, map[string]interface{}{"commit": d.GetCommit()},
+		time.Now().UTC().Unix(), 0, 1, provenanceBuildLimit,
+	)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get builds for deployment %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	provenance := []*types.Provenance{}
+
+	for _, b := range builds {
+		if b.GetEvent() == constants.EventDeploy {
+			continue
+		}
+
+		// send API call to capture the artifacts for the build
+		artifacts, err := database.FromContext(c).ListArtifactsForBuild(c, b)
+		if err != nil {
+			retErr := fmt.Errorf("unable to get artifacts for build %s/%d: %w", r.GetFullName(), b.GetNumber(), err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		if len(artifacts) == 0 {
+			continue
+		}
+
+		p, err := buildProvenance(c, r, u, b)
+		if err != nil {
+			retErr := fmt.Errorf("unable to trace deployment %s: %w", entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		provenance = append(provenance, p)
+	}
+
+	// fall back to tracing the builds for the deployment
+	if len(provenance) == 0 {
+		// send API call to capture the builds for the deployment
+		builds, err = database.FromContext(c).GetDeploymentBuildList(c, d.GetURL())
+		if err != nil {
+			retErr := fmt.Errorf("unable to get builds for deployment %s: %w", entry, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		for _, b := range builds {
+			p, err := buildProvenance(c, r, u, b)
+			if err != nil {
+				retErr := fmt.Errorf("unable to trace deployment %s: %w", entry, err)
+
+				util.HandleError(c, http.StatusInternalServerError, retErr)
+
+				return
+			}
+
+			provenance = append(provenance, p)
+		}
+	}
+
+	c.JSON(http.StatusOK, provenance)
+}
+
+// buildProvenance is a helper function to capture the pipeline,
+// templates, images, artifacts and deployments for a build.
+func buildProvenance(c *gin.Context, r *library.Repo, u *library.User, b *library.Build) (*types.Provenance, error) {
+	p := new(types.Provenance)
+	p.SetRepo(r.GetFullName())
+	p.SetBuild(b)
+	p.SetTemplates([]*library.Template{})
+	p.SetImages([]string{})
+
+	// send API call to capture the pipeline for the build
+	pipeline, err := database.FromContext(c).GetPipeline(c, b.GetPipelineID())
+	if err == nil {
+		// parse the pipeline configuration to capture the templates
+		parsed, _, err := compiler.FromContext(c).Duplicate().WithContext(c.Request.Context()).WithRepo(r).WithUser(u).
+			Parse(pipeline.GetData(), pipeline.GetType(), new(yaml.Template))
+		if err == nil {
+			templates := []*library.Template{}
+
+			for _, template := range parsed.Templates {
+				templates = append(templates, template.ToLibrary())
+			}
+
+			p.SetTemplates(templates)
+		}
+
+		// the raw configuration is available from the pipeline endpoints
+		pipeline.SetData(nil)
+
+		p.SetPipeline(pipeline)
+	}
+
+	// send API call to capture the images for the build
+	images, err := database.FromContext(c).GetBuildImagesForBuild(c, b)
+	if err == nil {
+		p.SetImages(images.GetImages())
+	}
+
+	// send API call to capture the artifacts for the build
+	artifacts, err := database.FromContext(c).ListArtifactsForBuild(c, b)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get artifacts for build %d: %w", b.GetNumber(), err)
+	}
+
+	p.SetArtifacts(artifacts)
+
+	// send API call to capture the deployment builds for the commit
+	deployments, _, err := database.FromContext(c).GetRepoBuildList(
+		c, r, map[string]interface{}{"event": constants.EventDeploy, "commit": b.GetCommit()},
+		time.Now().UTC().Unix(), 0, 1, provenanceBuildLimit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get deployments for build %d: %w", b.GetNumber(), err)
+	}
+
+	p.SetDeployments(deployments)
+
+	return p, nil
+}
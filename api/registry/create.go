@@ -0,0 +1,133 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/registries/{org} registries CreateRegistryCredential
+//
+// Create an image registry credential for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the registry credential to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RegistryCredential"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the registry credential
+//     schema:
+//       "$ref": "#/definitions/RegistryCredential"
+//   '400':
+//     description: Unable to create the registry credential
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The registry credential already exists
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the registry credential
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateRegistryCredential represents the API handler to create
+// an image registry credential for an org in the configured backend.
+func CreateRegistryCredential(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.RegistryCredential)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new registry credential for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"registry": input.GetRegistry(),
+		"user":     u.GetName(),
+	}).Infof("creating new registry credential %s for org %s", input.GetRegistry(), o)
+
+	if len(input.GetRegistry()) == 0 || len(input.GetUsername()) == 0 || len(input.GetPassword()) == 0 {
+		retErr := fmt.Errorf("unable to create registry credential for org %s: registry, username and password are required", o)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to check if the registry credential already exists
+	_, err = database.FromContext(c).GetRegistryCredentialForOrg(c, o, input.GetRegistry())
+	if err == nil {
+		retErr := fmt.Errorf("unable to create registry credential %s for org %s: credential already exists", input.GetRegistry(), o)
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	// default the registry credential to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in registry credential object
+	input.SetID(0)
+	input.SetOrg(o)
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the registry credential
+	err = database.FromContext(c).CreateRegistryCredential(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create registry credential %s for org %s: %w", input.GetRegistry(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created registry credential
+	rc, _ := database.FromContext(c).GetRegistryCredentialForOrg(c, o, input.GetRegistry())
+
+	c.JSON(http.StatusCreated, rc.Sanitize())
+}
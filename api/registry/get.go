@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registry
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/registries/{org}/{registry} registries GetRegistryCredential
+//
+// Get an image registry credential for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: registry
+//   description: Host of the image registry
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the registry credential
+//     schema:
+//       "$ref": "#/definitions/RegistryCredential"
+//   '404':
+//     description: Unable to retrieve the registry credential
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetRegistryCredential represents the API handler to capture
+// an image registry credential for an org from the configured
+// backend. The password is always masked in the response.
+func GetRegistryCredential(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+	registry := util.PathParameter(c, "registry")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"registry": registry,
+		"user":     u.GetName(),
+	}).Infof("reading registry credential %s for org %s", registry, o)
+
+	// send API call to capture the registry credential
+	rc, err := database.FromContext(c).GetRegistryCredentialForOrg(c, o, registry)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get registry credential %s for org %s: %w", registry, o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rc.Sanitize())
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registry
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/registries/{org} registries ListRegistryCredentials
+//
+// Get the image registry credentials for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the registry credentials
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/RegistryCredential"
+//   '500':
+//     description: Unable to retrieve the registry credentials
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListRegistryCredentials represents the API handler to capture
+// the image registry credentials for an org from the configured
+// backend. The passwords are always masked in the response.
+func ListRegistryCredentials(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("listing registry credentials for org %s", o)
+
+	// send API call to capture the list of registry credentials
+	credentials, err := database.FromContext(c).ListRegistryCredentialsForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list registry credentials for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	sanitized := []*api.RegistryCredential{}
+	for _, rc := range credentials {
+		sanitized = append(sanitized, rc.Sanitize())
+	}
+
+	c.JSON(http.StatusOK, sanitized)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registry
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation PUT /api/v1/registries/{org}/{registry} registries UpdateRegistryCredential
+//
+// Update an image registry credential for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: registry
+//   description: Host of the image registry
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the registry credential fields to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RegistryCredential"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the registry credential
+//     schema:
+//       "$ref": "#/definitions/RegistryCredential"
+//   '400':
+//     description: Unable to update the registry credential
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the registry credential
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the registry credential
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateRegistryCredential represents the API handler to update
+// an image registry credential for an org in the configured backend.
+// The password is only replaced when a new one is provided.
+func UpdateRegistryCredential(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+	registry := util.PathParameter(c, "registry")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"registry": registry,
+		"user":     u.GetName(),
+	}).Infof("updating registry credential %s for org %s", registry, o)
+
+	// capture body from API request
+	input := new(api.RegistryCredential)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for registry credential %s for org %s: %w", registry, o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the registry credential
+	rc, err := database.FromContext(c).GetRegistryCredentialForOrg(c, o, registry)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get registry credential %s for org %s: %w", registry, o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	if len(input.GetUsername()) > 0 {
+		// update username if set
+		rc.SetUsername(input.GetUsername())
+	}
+
+	if len(input.GetPassword()) > 0 {
+		// update password if set
+		rc.SetPassword(input.GetPassword())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		rc.SetActive(input.GetActive())
+	}
+
+	rc.SetUpdatedAt(time.Now().UTC().Unix())
+	rc.SetUpdatedBy(u.GetName())
+
+	// send API call to update the registry credential
+	err = database.FromContext(c).UpdateRegistryCredential(c, rc)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update registry credential %s for org %s: %w", registry, o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated registry credential
+	rc, _ = database.FromContext(c).GetRegistryCredentialForOrg(c, o, registry)
+
+	c.JSON(http.StatusOK, rc.Sanitize())
+}
@@ -68,7 +68,7 @@ func ChownRepo(c *gin.Context) {
 	r.SetUserID(u.GetID())
 
 	// send API call to update the repo
-	err := database.FromContext(c).UpdateRepo(r)
+	err := database.FromContext(c).UpdateRepo(c, r)
 	if err != nil {
 		retErr := fmt.Errorf("unable to change owner of repo %s to %s: %w", r.GetFullName(), u.GetName(), err)
 
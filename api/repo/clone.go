@@ -0,0 +1,591 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/clone repos GetCloneSetting
+//
+// Get the settings controlling how the clone step fetches a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the clone settings
+//     schema:
+//       "$ref": "#/definitions/CloneSetting"
+//   '500':
+//     description: Unable to retrieve the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetCloneSetting represents the API handler to capture the settings
+// controlling how the clone step fetches a repo. Repos without
+// settings use the auto mode, which falls back to the org defaults.
+func GetCloneSetting(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("reading clone settings for repo %s", r.GetFullName())
+
+	s, err := cloneSetting(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get clone settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, s)
+}
+
+// swagger:operation PUT /api/v1/repos/{org}/{repo}/clone repos UpdateCloneSetting
+//
+// Update the settings controlling how the clone step fetches a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the clone settings to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/CloneSetting"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the clone settings
+//     schema:
+//       "$ref": "#/definitions/CloneSetting"
+//   '400':
+//     description: Unable to update the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateCloneSetting represents the API handler to create or update
+// the settings controlling how the clone step fetches a repo.
+func UpdateCloneSetting(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("updating clone settings for repo %s", r.GetFullName())
+
+	// capture body from API request
+	input := new(types.CloneSetting)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for clone settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	s, err := cloneSetting(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get clone settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// update fields in clone setting object
+	updateCloneSetting(s, input)
+
+	err = validateCloneSetting(s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update clone settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	err = saveCloneSetting(c, u, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update clone settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated clone setting
+	s, _ = database.FromContext(c).GetCloneSettingForRepo(c, r)
+
+	c.JSON(http.StatusOK, s)
+}
+
+// swagger:operation DELETE /api/v1/repos/{org}/{repo}/clone repos DeleteCloneSetting
+//
+// Delete the settings controlling how the clone step fetches a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the clone settings
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to find the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteCloneSetting represents the API handler to remove the settings
+// controlling how the clone step fetches a repo, restoring the auto mode.
+func DeleteCloneSetting(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("deleting clone settings for repo %s", r.GetFullName())
+
+	// send API call to capture the clone setting
+	s, err := database.FromContext(c).GetCloneSettingForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get clone settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the clone setting
+	err = database.FromContext(c).DeleteCloneSetting(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete clone settings for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("clone settings for repo %s deleted", r.GetFullName()))
+}
+
+// swagger:operation GET /api/v1/repos/{org}/clone repos GetOrgCloneSetting
+//
+// Get the default settings controlling how the clone step fetches the repos in an org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the clone settings
+//     schema:
+//       "$ref": "#/definitions/CloneSetting"
+//   '500':
+//     description: Unable to retrieve the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetOrgCloneSetting represents the API handler to capture the default
+// settings controlling how the clone step fetches the repos in an org.
+func GetOrgCloneSetting(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("reading clone settings for org %s", o)
+
+	s, err := orgCloneSetting(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get clone settings for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, s)
+}
+
+// swagger:operation PUT /api/v1/repos/{org}/clone repos UpdateOrgCloneSetting
+//
+// Update the default settings controlling how the clone step fetches the repos in an org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the clone settings to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/CloneSetting"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the clone settings
+//     schema:
+//       "$ref": "#/definitions/CloneSetting"
+//   '400':
+//     description: Unable to update the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateOrgCloneSetting represents the API handler to create or update the
+// default settings controlling how the clone step fetches the repos in an org.
+func UpdateOrgCloneSetting(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("updating clone settings for org %s", o)
+
+	// capture body from API request
+	input := new(types.CloneSetting)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for clone settings for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	s, err := orgCloneSetting(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get clone settings for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// update fields in clone setting object
+	updateCloneSetting(s, input)
+
+	err = validateCloneSetting(s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update clone settings for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	err = saveCloneSetting(c, u, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update clone settings for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated clone setting
+	s, _ = database.FromContext(c).GetCloneSettingForOrg(c, o)
+
+	c.JSON(http.StatusOK, s)
+}
+
+// swagger:operation DELETE /api/v1/repos/{org}/clone repos DeleteOrgCloneSetting
+//
+// Delete the default settings controlling how the clone step fetches the repos in an org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the clone settings
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to find the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the clone settings
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteOrgCloneSetting represents the API handler to remove the default
+// settings controlling how the clone step fetches the repos in an org.
+func DeleteOrgCloneSetting(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("deleting clone settings for org %s", o)
+
+	// send API call to capture the clone setting
+	s, err := database.FromContext(c).GetCloneSettingForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get clone settings for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the clone setting
+	err = database.FromContext(c).DeleteCloneSetting(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete clone settings for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("clone settings for org %s deleted", o))
+}
+
+// cloneSetting is a helper function to capture the clone setting
+// for the repo, defaulting to the auto mode when none exists.
+func cloneSetting(c *gin.Context, r *library.Repo) (*types.CloneSetting, error) {
+	// send API call to capture the clone setting
+	s, err := database.FromContext(c).GetCloneSettingForRepo(c, r)
+	if err == nil {
+		return s, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	s = defaultCloneSetting()
+	s.SetRepoID(r.GetID())
+
+	return s, nil
+}
+
+// orgCloneSetting is a helper function to capture the default clone
+// setting for the org, defaulting to the auto mode when none exists.
+func orgCloneSetting(c *gin.Context, org string) (*types.CloneSetting, error) {
+	// send API call to capture the clone setting
+	s, err := database.FromContext(c).GetCloneSettingForOrg(c, org)
+	if err == nil {
+		return s, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	s = defaultCloneSetting()
+	s.SetOrg(org)
+
+	return s, nil
+}
+
+// defaultCloneSetting is a helper function to create
+// a clone setting with the auto mode for every mode.
+func defaultCloneSetting() *types.CloneSetting {
+	s := new(types.CloneSetting)
+	s.SetSubmodules(types.CloneModeAuto)
+	s.SetLFS(types.CloneModeAuto)
+	s.SetSingleBranch(types.CloneModeAuto)
+	s.SetTags(types.CloneModeAuto)
+
+	return s
+}
+
+// updateCloneSetting is a helper function to update the
+// clone setting with the fields provided in the input.
+func updateCloneSetting(s, input *types.CloneSetting) {
+	if input.Submodules != nil {
+		s.SetSubmodules(input.GetSubmodules())
+	}
+
+	if input.SubmoduleDepth != nil {
+		s.SetSubmoduleDepth(input.GetSubmoduleDepth())
+	}
+
+	if input.LFS != nil {
+		s.SetLFS(input.GetLFS())
+	}
+
+	if input.Depth != nil {
+		s.SetDepth(input.GetDepth())
+	}
+
+	if input.SingleBranch != nil {
+		s.SetSingleBranch(input.GetSingleBranch())
+	}
+
+	if input.Tags != nil {
+		s.SetTags(input.GetTags())
+	}
+}
+
+// saveCloneSetting is a helper function to create
+// or update the clone setting on behalf of the user.
+func saveCloneSetting(c *gin.Context, u *library.User, s *types.CloneSetting) error {
+	s.SetUpdatedAt(time.Now().UTC().Unix())
+	s.SetUpdatedBy(u.GetName())
+
+	// send API call to create the clone setting
+	if s.GetID() == 0 {
+		return database.FromContext(c).CreateCloneSetting(c, s)
+	}
+
+	// send API call to update the clone setting
+	return database.FromContext(c).UpdateCloneSetting(c, s)
+}
+
+// validateCloneSetting is a helper function to verify
+// the modes and depths for the clone setting are valid.
+func validateCloneSetting(s *types.CloneSetting) error {
+	for _, mode := range []string{s.GetSubmodules(), s.GetLFS(), s.GetSingleBranch(), s.GetTags()} {
+		switch mode {
+		case "", types.CloneModeAuto, types.CloneModeEnabled, types.CloneModeDisabled:
+		default:
+			return fmt.Errorf("invalid mode %q provided: must be %s, %s or %s",
+				mode, types.CloneModeAuto, types.CloneModeEnabled, types.CloneModeDisabled)
+		}
+	}
+
+	if s.GetDepth() < 0 || s.GetSubmoduleDepth() < 0 {
+		return errors.New("invalid depth provided: must not be negative")
+	}
+
+	return nil
+}
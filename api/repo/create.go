@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/requiredcontext"
 	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/user"
 	"github.com/go-vela/server/scm"
@@ -214,7 +215,7 @@ func CreateRepo(c *gin.Context) {
 	)
 
 	// ensure repo is allowed to be activated
-	if !checkAllowlist(r, allowlist) {
+	if !util.CheckAllowlist(r, allowlist) {
 		retErr := fmt.Errorf("unable to activate repo: %s is not on allowlist", r.GetFullName())
 
 		util.HandleError(c, http.StatusForbidden, retErr)
@@ -223,7 +224,17 @@ func CreateRepo(c *gin.Context) {
 	}
 
 	// send API call to capture the repo from the database
-	dbRepo, err := database.FromContext(c).GetRepoForOrg(r.GetOrg(), r.GetName())
+	dbRepo, err := database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
+	if err != nil {
+		// send API call to capture the archived repo from the database
+		//
+		// an archived repo is restored when it is enabled again
+		archived, aErr := database.FromContext(c).GetArchivedRepoForOrg(c, r.GetOrg(), r.GetName())
+		if aErr == nil {
+			dbRepo = archived
+		}
+	}
+
 	if err == nil && dbRepo.GetActive() {
 		retErr := fmt.Errorf("unable to activate repo: %s is already active", r.GetFullName())
 
@@ -270,8 +281,28 @@ func CreateRepo(c *gin.Context) {
 		// activate the repo
 		dbRepo.SetActive(true)
 
+		// send API call to restore the repo in case it was archived
+		err = database.FromContext(c).RestoreRepo(c, dbRepo)
+		if err != nil {
+			retErr := fmt.Errorf("unable to restore repo %s: %w", dbRepo.GetFullName(), err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		// send API call to restore the builds for the repo
+		_, err = database.FromContext(c).RestoreRepoBuilds(c, dbRepo)
+		if err != nil {
+			retErr := fmt.Errorf("unable to restore builds for repo %s: %w", dbRepo.GetFullName(), err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
 		// send API call to update the repo
-		err = database.FromContext(c).UpdateRepo(dbRepo)
+		err = database.FromContext(c).UpdateRepo(c, dbRepo)
 		if err != nil {
 			retErr := fmt.Errorf("unable to set repo %s to active: %w", dbRepo.GetFullName(), err)
 
@@ -281,10 +312,10 @@ func CreateRepo(c *gin.Context) {
 		}
 
 		// send API call to capture the updated repo
-		r, _ = database.FromContext(c).GetRepoForOrg(dbRepo.GetOrg(), dbRepo.GetName())
+		r, _ = database.FromContext(c).GetRepoForOrg(c, dbRepo.GetOrg(), dbRepo.GetName())
 	} else {
 		// send API call to create the repo
-		err = database.FromContext(c).CreateRepo(r)
+		err = database.FromContext(c).CreateRepo(c, r)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create new repo %s: %w", r.GetFullName(), err)
 
@@ -294,7 +325,7 @@ func CreateRepo(c *gin.Context) {
 		}
 
 		// send API call to capture the created repo
-		r, _ = database.FromContext(c).GetRepoForOrg(r.GetOrg(), r.GetName())
+		r, _ = database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
 	}
 
 	// create init hook in the DB after repo has been added in order to capture its ID
@@ -302,7 +333,7 @@ func CreateRepo(c *gin.Context) {
 		// update initialization hook
 		hook.SetRepoID(r.GetID())
 		// create first hook for repo in the database
-		err = database.FromContext(c).CreateHook(hook)
+		err = database.FromContext(c).CreateHook(c, hook)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create initialization webhook for %s: %w", r.GetFullName(), err)
 
@@ -312,32 +343,11 @@ func CreateRepo(c *gin.Context) {
 		}
 	}
 
-	c.JSON(http.StatusCreated, r)
-}
-
-// checkAllowlist is a helper function to ensure only repos in the
-// allowlist are allowed to enable repos.
-//
-// a single entry of '*' allows any repo to be enabled.
-func checkAllowlist(r *library.Repo, allowlist []string) bool {
-	// check if all repos are allowed to be enabled
-	if len(allowlist) == 1 && allowlist[0] == "*" {
-		return true
-	}
-
-	for _, repo := range allowlist {
-		// allow all repos in org
-		if strings.Contains(repo, "/*") {
-			if strings.HasPrefix(repo, r.GetOrg()) {
-				return true
-			}
-		}
-
-		// allow specific repo within org
-		if repo == r.GetFullName() {
-			return true
-		}
+	// configure the branch protection to require the status contexts for the repo
+	err = requiredcontext.Protect(c, u, r)
+	if err != nil {
+		logrus.Warnf("unable to configure branch protection for %s: %v", r.GetFullName(), err)
 	}
 
-	return false
+	c.JSON(http.StatusCreated, r)
 }
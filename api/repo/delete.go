@@ -53,7 +53,8 @@ import (
 //       "$ref": "#/definitions/Error"
 
 // DeleteRepo represents the API handler to remove
-// a repo from the configured backend.
+// a repo from the configured backend. The repo and
+// its builds are archived rather than removed.
 func DeleteRepo(c *gin.Context) {
 	// capture middleware values
 	o := org.Retrieve(c)
@@ -70,7 +71,7 @@ func DeleteRepo(c *gin.Context) {
 	}).Infof("deleting repo %s", r.GetFullName())
 
 	// send API call to remove the webhook
-	err := scm.FromContext(c).Disable(u, r.GetOrg(), r.GetName())
+	err := scm.ForRepo(scm.FromContext(c), r).Disable(u, r.GetOrg(), r.GetName())
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete webhook for %s: %w", r.GetFullName(), err)
 
@@ -88,7 +89,7 @@ func DeleteRepo(c *gin.Context) {
 	// Mark the repo as inactive
 	r.SetActive(false)
 
-	err = database.FromContext(c).UpdateRepo(r)
+	err = database.FromContext(c).UpdateRepo(c, r)
 	if err != nil {
 		retErr := fmt.Errorf("unable to set repo %s to inactive: %w", r.GetFullName(), err)
 
@@ -97,13 +98,26 @@ func DeleteRepo(c *gin.Context) {
 		return
 	}
 
-	// Comment out actual delete until delete mechanism is fleshed out
-	// err = database.FromContext(c).DeleteRepo(r.ID)
-	// if err != nil {
-	// 	retErr := fmt.Errorf("Error while deleting repo %s: %w", r.FullName, err)
-	// 	util.HandleError(c, http.StatusInternalServerError, retErr)
-	// 	return
-	// }
+	// send API call to archive the repo instead of removing it
+	// so it can be restored or purged by a platform admin
+	err = database.FromContext(c).ArchiveRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to archive repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to archive the builds for the repo
+	_, err = database.FromContext(c).ArchiveRepoBuilds(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to archive builds for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
 
-	c.JSON(http.StatusOK, fmt.Sprintf("repo %s set to inactive", r.GetFullName()))
+	c.JSON(http.StatusOK, fmt.Sprintf("repo %s set to inactive and archived", r.GetFullName()))
 }
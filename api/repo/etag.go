@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+)
+
+// etag is a helper function to create the entity tag for the
+// settings of the repo at the provided version. The counter is
+// left out since it changes with every build created for the repo.
+func etag(r *library.Repo, version int64) string {
+	return util.ETag(map[string]interface{}{
+		"id":            r.GetID(),
+		"version":       version,
+		"branch":        r.GetBranch(),
+		"build_limit":   r.GetBuildLimit(),
+		"timeout":       r.GetTimeout(),
+		"visibility":    r.GetVisibility(),
+		"private":       r.GetPrivate(),
+		"trusted":       r.GetTrusted(),
+		"active":        r.GetActive(),
+		"allow_pull":    r.GetAllowPull(),
+		"allow_push":    r.GetAllowPush(),
+		"allow_deploy":  r.GetAllowDeploy(),
+		"allow_tag":     r.GetAllowTag(),
+		"allow_comment": r.GetAllowComment(),
+		"pipeline_type": r.GetPipelineType(),
+	})
+}
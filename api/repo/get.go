@@ -5,12 +5,15 @@
 package repo
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
 	"github.com/go-vela/server/router/middleware/org"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
 	"github.com/sirupsen/logrus"
 )
 
@@ -39,6 +42,14 @@ import (
 //     description: Successfully retrieved the repo
 //     schema:
 //       "$ref": "#/definitions/Repo"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
+//   '500':
+//     description: Unable to retrieve the repo
+//     schema:
+//       "$ref": "#/definitions/Error"
 
 // GetRepo represents the API handler to
 // capture a repo from the configured backend.
@@ -57,5 +68,17 @@ func GetRepo(c *gin.Context) {
 		"user": u.GetName(),
 	}).Infof("reading repo %s", r.GetFullName())
 
+	// send API call to capture the version of the repo settings
+	version, err := database.FromContext(c).GetRepoVersion(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get version of repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	util.SetETag(c, etag(r, version))
+
 	c.JSON(http.StatusOK, r)
 }
@@ -141,7 +141,7 @@ func ListReposForOrg(c *gin.Context) {
 	}
 
 	// send API call to capture the list of repos for the org
-	r, t, err := database.FromContext(c).ListReposForOrg(o, sortBy, filters, page, perPage)
+	r, t, err := database.FromContext(c).ListReposForOrg(c, o, sortBy, filters, page, perPage)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get repos for org %s: %w", o, err)
 
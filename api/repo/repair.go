@@ -68,7 +68,7 @@ func RepairRepo(c *gin.Context) {
 	// check if we should create the webhook
 	if c.Value("webhookvalidation").(bool) {
 		// send API call to remove the webhook
-		err := scm.FromContext(c).Disable(u, r.GetOrg(), r.GetName())
+		err := scm.ForRepo(scm.FromContext(c), r).Disable(u, r.GetOrg(), r.GetName())
 		if err != nil {
 			retErr := fmt.Errorf("unable to delete webhook for %s: %w", r.GetFullName(), err)
 
@@ -98,7 +98,7 @@ func RepairRepo(c *gin.Context) {
 
 		hook.SetRepoID(r.GetID())
 
-		err = database.FromContext(c).CreateHook(hook)
+		err = database.FromContext(c).CreateHook(c, hook)
 		if err != nil {
 			retErr := fmt.Errorf("unable to create initialization webhook for %s: %w", r.GetFullName(), err)
 
@@ -113,7 +113,7 @@ func RepairRepo(c *gin.Context) {
 		r.SetActive(true)
 
 		// send API call to update the repo
-		err := database.FromContext(c).UpdateRepo(r)
+		err := database.FromContext(c).UpdateRepo(c, r)
 		if err != nil {
 			retErr := fmt.Errorf("unable to set repo %s to active: %w", r.GetFullName(), err)
 
@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/reposync"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation POST /api/v1/repos/{org}/sync repos SyncReposForOrg
+//
+// Reconcile the active repos for an org with the SCM
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully synced the repos for the org
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/RepoSync"
+//   '500':
+//     description: Unable to sync the repos for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// SyncReposForOrg represents the API handler to reconcile the
+// active repos for an org in the configured backend with the SCM,
+// following renamed or transferred repos, deactivating archived
+// or deleted repos and refreshing the default branch and visibility.
+func SyncReposForOrg(c *gin.Context) {
+	// capture middleware values
+	m := c.MustGet("metadata").(*types.Metadata)
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("syncing repos for org %s", o)
+
+	// create the syncer for the repos
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/reposync?tab=doc#New
+	syncer, err := reposync.New(
+		reposync.WithDatabase(database.FromContext(c)),
+		reposync.WithSCM(scm.FromContext(c)),
+		reposync.WithWebAddress(m.Vela.WebAddress),
+	)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create repo syncer for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// sync the active repos for the org with the scm
+	s, err := syncer.SyncOrg(o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to sync repos for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, s)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/sync repos GetRepoSync
+//
+// Get the result of the last sync of a repo with the SCM
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the last sync of the repo
+//     schema:
+//       "$ref": "#/definitions/RepoSync"
+//   '404':
+//     description: Unable to retrieve the last sync of the repo
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the last sync of the repo
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetRepoSync represents the API handler to capture the
+// result of the last sync of a repo with the SCM.
+func GetRepoSync(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("reading sync for repo %s", r.GetFullName())
+
+	// send API call to capture the last sync for the repo
+	s, err := database.FromContext(c).GetRepoSyncForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get sync for repo %s: %w", r.GetFullName(), err)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, s)
+}
@@ -0,0 +1,461 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/trigger-token repos CreateRepoTriggerToken
+//
+// Create a token that can only create or restart builds for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully created the trigger token
+//     schema:
+//       "$ref": "#/definitions/Token"
+//   '500':
+//     description: Unable to create the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateRepoTriggerToken represents the API handler to mint a
+// token that can only create or restart builds for a repo.
+func CreateRepoTriggerToken(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("creating trigger token for repo %s", r.GetFullName())
+
+	mintTriggerToken(c, u, r.GetFullName())
+}
+
+// swagger:operation POST /api/v1/repos/{org}/trigger-token repos CreateOrgTriggerToken
+//
+// Create a token that can only create or restart builds for the repos in an org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully created the trigger token
+//     schema:
+//       "$ref": "#/definitions/Token"
+//   '401':
+//     description: User is not an admin of the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateOrgTriggerToken represents the API handler to mint a token
+// that can only create or restart builds for the repos in an org.
+func CreateOrgTriggerToken(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("creating trigger token for org %s", o)
+
+	mintTriggerToken(c, u, token.OrgTrigger(o))
+}
+
+// mintTriggerToken is a helper function to mint a repo trigger
+// token on behalf of the user for the provided repo claim.
+func mintTriggerToken(c *gin.Context, u *library.User, claim string) {
+	// only users may mint trigger tokens
+	if u.GetID() == 0 {
+		retErr := fmt.Errorf("unable to create trigger token for %s: a user access token is required", claim)
+
+		util.HandleError(c, http.StatusUnauthorized, retErr)
+
+		return
+	}
+
+	// retrieve token manager from context
+	tm := c.MustGet("token-manager").(*token.Manager)
+
+	// the identifier embedded in the token that is stored
+	// so the token can be listed and revoked before it expires
+	id := uuid.NewString()
+
+	// set mint token options
+	mto := &token.MintTokenOpts{
+		Repo:          claim,
+		TokenDuration: tm.RepoTriggerTokenDuration,
+		TokenID:       id,
+		TokenType:     token.RepoTriggerTokenType,
+		User:          u,
+	}
+
+	// mint token
+	tkn, err := tm.MintToken(mto)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create trigger token for %s: %w", claim, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	now := time.Now()
+
+	t := new(api.TriggerToken)
+	t.SetRepo(claim)
+	t.SetTokenID(id)
+	t.SetCreatedAt(now.UTC().Unix())
+	t.SetCreatedBy(u.GetName())
+	t.SetExpiresAt(now.Add(tm.RepoTriggerTokenDuration).UTC().Unix())
+
+	// send API call to store the trigger token, a token
+	// that isn't stored is rejected when it is used
+	err = database.FromContext(c).CreateTriggerToken(c, t)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create trigger token for %s: %w", claim, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, library.Token{Token: &tkn})
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/trigger-tokens repos ListRepoTriggerTokens
+//
+// Get the trigger tokens minted for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the trigger tokens
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/TriggerToken"
+//   '500':
+//     description: Unable to retrieve the trigger tokens
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListRepoTriggerTokens represents the API handler to
+// capture the trigger tokens minted for a repo.
+func ListRepoTriggerTokens(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("listing trigger tokens for repo %s", r.GetFullName())
+
+	listTriggerTokens(c, r.GetFullName())
+}
+
+// swagger:operation DELETE /api/v1/repos/{org}/{repo}/trigger-tokens/{token} repos DeleteRepoTriggerToken
+//
+// Revoke a trigger token minted for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: token
+//   description: ID of the trigger token
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully revoked the trigger token
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to revoke the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to revoke the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to revoke the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteRepoTriggerToken represents the API handler
+// to revoke a trigger token minted for a repo.
+func DeleteRepoTriggerToken(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	id := util.PathParameter(c, "token")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":   o,
+		"repo":  r.GetName(),
+		"token": id,
+		"user":  u.GetName(),
+	}).Infof("revoking trigger token %s for repo %s", id, r.GetFullName())
+
+	revokeTriggerToken(c, r.GetFullName(), id)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/trigger-tokens repos ListOrgTriggerTokens
+//
+// Get the trigger tokens minted for the repos in an org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the trigger tokens
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/TriggerToken"
+//   '401':
+//     description: User is not an admin of the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the trigger tokens
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListOrgTriggerTokens represents the API handler to capture
+// the trigger tokens minted for the repos in an org.
+func ListOrgTriggerTokens(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("listing trigger tokens for org %s", o)
+
+	listTriggerTokens(c, token.OrgTrigger(o))
+}
+
+// swagger:operation DELETE /api/v1/repos/{org}/trigger-tokens/{token} repos DeleteOrgTriggerToken
+//
+// Revoke a trigger token minted for the repos in an org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: token
+//   description: ID of the trigger token
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully revoked the trigger token
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to revoke the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '401':
+//     description: User is not an admin of the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to revoke the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to revoke the trigger token
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteOrgTriggerToken represents the API handler to revoke
+// a trigger token minted for the repos in an org.
+func DeleteOrgTriggerToken(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+	id := util.PathParameter(c, "token")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":   o,
+		"token": id,
+		"user":  u.GetName(),
+	}).Infof("revoking trigger token %s for org %s", id, o)
+
+	revokeTriggerToken(c, token.OrgTrigger(o), id)
+}
+
+// listTriggerTokens is a helper function to
+// list the trigger tokens for the repo claim.
+func listTriggerTokens(c *gin.Context, claim string) {
+	// send API call to capture the list of trigger tokens
+	tokens, err := database.FromContext(c).ListTriggerTokensForRepo(c, claim)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list trigger tokens for %s: %w", claim, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// revokeTriggerToken is a helper function to revoke
+// the trigger token with the ID for the repo claim.
+func revokeTriggerToken(c *gin.Context, claim, id string) {
+	number, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid trigger token parameter provided: %s", id)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the trigger token
+	t, err := database.FromContext(c).GetTriggerToken(c, number)
+	if err != nil || t.GetRepo() != claim {
+		retErr := fmt.Errorf("unable to get trigger token %d for %s", number, claim)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the trigger token
+	err = database.FromContext(c).DeleteTriggerToken(c, t)
+	if err != nil {
+		retErr := fmt.Errorf("unable to revoke trigger token %d for %s: %w", number, claim, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("trigger token %d for %s revoked", number, claim))
+}
@@ -6,12 +6,14 @@ package repo
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/server/router/middleware/org"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
@@ -47,6 +49,10 @@ import (
 //   required: true
 //   schema:
 //     "$ref": "#/definitions/Repo"
+// - in: header
+//   name: If-Match
+//   description: Entity tag the resource must match to be updated
+//   type: string
 // security:
 //   - ApiKeyAuth: []
 // responses:
@@ -54,10 +60,22 @@ import (
 //     description: Successfully updated the repo
 //     schema:
 //       "$ref": "#/definitions/Repo"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
 //   '400':
 //     description: Unable to update the repo
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The repo was updated by another request
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '412':
+//     description: The repo was modified since it was last read
+//     schema:
+//       "$ref": "#/definitions/Error"
 //   '500':
 //     description: Unable to update the repo
 //     schema:
@@ -87,10 +105,44 @@ func UpdateRepo(c *gin.Context) {
 		"user": u.GetName(),
 	}).Infof("updating repo %s", r.GetFullName())
 
+	// send API call to capture the version of the repo settings
+	version, err := database.FromContext(c).GetRepoVersion(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get version of repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the repo settings at the version
+	//
+	// the repo is captured after the version so an update made in
+	// between is rejected instead of being silently overwritten
+	current, err := database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	r = current
+
+	// check if the repo was changed since it was last read
+	if !util.MatchETag(c, etag(r, version)) {
+		retErr := fmt.Errorf("unable to update repo %s: repo was modified", r.GetFullName())
+
+		util.HandleError(c, http.StatusPreconditionFailed, retErr)
+
+		return
+	}
+
 	// capture body from API request
 	input := new(library.Repo)
 
-	err := c.Bind(input)
+	err = c.Bind(input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to decode JSON for repo %s: %w", r.GetFullName(), err)
 
@@ -136,8 +188,9 @@ func UpdateRepo(c *gin.Context) {
 		)
 	}
 
-	if input.GetCounter() > 0 {
-		if input.GetCounter() <= r.GetCounter() {
+	// skip the counter if it's unchanged so the update is idempotent
+	if input.GetCounter() > 0 && input.GetCounter() != r.GetCounter() {
+		if input.GetCounter() < r.GetCounter() {
 			retErr := fmt.Errorf("unable to set counter for repo %s: must be greater than current %d",
 				r.GetFullName(), r.GetCounter())
 
@@ -240,7 +293,7 @@ func UpdateRepo(c *gin.Context) {
 	}
 
 	// grab last hook from repo to fetch the webhook ID
-	lastHook, err := database.FromContext(c).LastHookForRepo(r)
+	lastHook, err := database.FromContext(c).LastHookForRepo(c, r)
 	if err != nil {
 		retErr := fmt.Errorf("unable to retrieve last hook for repo %s: %w", r.GetFullName(), err)
 
@@ -256,7 +309,7 @@ func UpdateRepo(c *gin.Context) {
 			// capture admin name for logging
 			admn := u.GetName()
 
-			u, err = database.FromContext(c).GetUser(r.GetUserID())
+			u, err = database.FromContext(c).GetUser(c, r.GetUserID())
 			if err != nil {
 				retErr := fmt.Errorf("unable to get repo owner of %s for platform admin webhook update: %w", r.GetFullName(), err)
 
@@ -283,18 +336,27 @@ func UpdateRepo(c *gin.Context) {
 		}
 	}
 
-	// send API call to update the repo
-	err = database.FromContext(c).UpdateRepo(r)
+	// send API call to update the repo when it wasn't updated since the version
+	version, err = database.FromContext(c).UpdateRepoIfVersion(c, r, version)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update repo %s: %w", r.GetFullName(), err)
 
+		// check if the repo was updated by another request
+		if errors.Is(err, types.ErrVersionConflict) {
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+
 		util.HandleError(c, http.StatusInternalServerError, retErr)
 
 		return
 	}
 
 	// send API call to capture the updated repo
-	r, _ = database.FromContext(c).GetRepoForOrg(r.GetOrg(), r.GetName())
+	r, _ = database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
+
+	util.SetETag(c, etag(r, version))
 
 	c.JSON(http.StatusOK, r)
 }
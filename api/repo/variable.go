@@ -0,0 +1,507 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/variables repos CreateRepoVariable
+//
+// Create an environment variable injected into the builds for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the variable to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RepoVariable"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the variable
+//     schema:
+//       "$ref": "#/definitions/RepoVariable"
+//   '400':
+//     description: Unable to create the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The variable already exists
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateRepoVariable represents the API handler to create an
+// environment variable injected into the builds for a repo. The
+// value for a sensitive variable is encrypted at rest and masked
+// in the logs for the builds.
+func CreateRepoVariable(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(types.RepoVariable)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new variable for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"repo":     r.GetName(),
+		"user":     u.GetName(),
+		"variable": input.GetName(),
+	}).Infof("creating new variable %s for repo %s", input.GetName(), r.GetFullName())
+
+	if len(input.GetName()) == 0 || len(input.GetValue()) == 0 {
+		retErr := fmt.Errorf("unable to create variable for repo %s: name and value are required", r.GetFullName())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to check if the variable already exists
+	_, err = database.FromContext(c).GetRepoVariableForRepo(c, r, input.GetName())
+	if err == nil {
+		retErr := fmt.Errorf("unable to create variable %s for repo %s: variable already exists", input.GetName(), r.GetFullName())
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	// update fields in variable object
+	input.SetID(0)
+	input.SetRepoID(r.GetID())
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the variable
+	err = database.FromContext(c).CreateRepoVariable(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create variable %s for repo %s: %w", input.GetName(), r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the created variable
+	v, _ := database.FromContext(c).GetRepoVariableForRepo(c, r, input.GetName())
+
+	c.JSON(http.StatusCreated, v)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/variables repos ListRepoVariables
+//
+// Get the environment variables injected into the builds for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the variables
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/RepoVariable"
+//   '500':
+//     description: Unable to retrieve the variables
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListRepoVariables represents the API handler to capture the
+// environment variables injected into the builds for a repo. The
+// values for sensitive variables are masked unless the user is
+// an admin for the repo.
+func ListRepoVariables(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("listing variables for repo %s", r.GetFullName())
+
+	// send API call to capture the list of variables
+	variables, err := database.FromContext(c).ListRepoVariablesForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list variables for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// check if the user may read the sensitive values
+	if !variableAdmin(c, r, u) {
+		for i, v := range variables {
+			variables[i] = v.Sanitize()
+		}
+	}
+
+	c.JSON(http.StatusOK, variables)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/variables/{variable} repos GetRepoVariable
+//
+// Get an environment variable injected into the builds for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: variable
+//   description: Name of the variable
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the variable
+//     schema:
+//       "$ref": "#/definitions/RepoVariable"
+//   '404':
+//     description: Unable to retrieve the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetRepoVariable represents the API handler to capture an
+// environment variable injected into the builds for a repo. The
+// value for a sensitive variable is masked unless the user is
+// an admin for the repo.
+func GetRepoVariable(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	name := util.PathParameter(c, "variable")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"repo":     r.GetName(),
+		"user":     u.GetName(),
+		"variable": name,
+	}).Infof("reading variable %s for repo %s", name, r.GetFullName())
+
+	// send API call to capture the variable
+	v, err := database.FromContext(c).GetRepoVariableForRepo(c, r, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get variable %s for repo %s: %w", name, r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// check if the user may read the sensitive value
+	if !variableAdmin(c, r, u) {
+		v = v.Sanitize()
+	}
+
+	c.JSON(http.StatusOK, v)
+}
+
+// swagger:operation PUT /api/v1/repos/{org}/{repo}/variables/{variable} repos UpdateRepoVariable
+//
+// Update an environment variable injected into the builds for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: variable
+//   description: Name of the variable
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the variable to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RepoVariable"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the variable
+//     schema:
+//       "$ref": "#/definitions/RepoVariable"
+//   '400':
+//     description: Unable to update the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateRepoVariable represents the API handler to update an
+// environment variable injected into the builds for a repo. The
+// value is only replaced when a new one is provided, so a masked
+// value sent back to the API leaves the value unchanged.
+func UpdateRepoVariable(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	name := util.PathParameter(c, "variable")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"repo":     r.GetName(),
+		"user":     u.GetName(),
+		"variable": name,
+	}).Infof("updating variable %s for repo %s", name, r.GetFullName())
+
+	// capture body from API request
+	input := new(types.RepoVariable)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for variable %s for repo %s: %w", name, r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the variable
+	v, err := database.FromContext(c).GetRepoVariableForRepo(c, r, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get variable %s for repo %s: %w", name, r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	if len(input.GetValue()) > 0 && input.GetValue() != constants.SecretMask {
+		// update value if set
+		v.SetValue(input.GetValue())
+	}
+
+	if input.Sensitive != nil {
+		// update sensitive if set
+		v.SetSensitive(input.GetSensitive())
+	}
+
+	v.SetUpdatedAt(time.Now().UTC().Unix())
+	v.SetUpdatedBy(u.GetName())
+
+	// send API call to update the variable
+	err = database.FromContext(c).UpdateRepoVariable(c, v)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update variable %s for repo %s: %w", name, r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated variable
+	v, _ = database.FromContext(c).GetRepoVariableForRepo(c, r, name)
+
+	c.JSON(http.StatusOK, v)
+}
+
+// swagger:operation DELETE /api/v1/repos/{org}/{repo}/variables/{variable} repos DeleteRepoVariable
+//
+// Delete an environment variable injected into the builds for a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: variable
+//   description: Name of the variable
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the variable
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to delete the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the variable
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteRepoVariable represents the API handler to remove an
+// environment variable injected into the builds for a repo.
+func DeleteRepoVariable(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+	name := util.PathParameter(c, "variable")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"repo":     r.GetName(),
+		"user":     u.GetName(),
+		"variable": name,
+	}).Infof("deleting variable %s for repo %s", name, r.GetFullName())
+
+	// send API call to capture the variable
+	v, err := database.FromContext(c).GetRepoVariableForRepo(c, r, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get variable %s for repo %s: %w", name, r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the variable
+	err = database.FromContext(c).DeleteRepoVariable(c, v)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete variable %s for repo %s: %w", name, r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("variable %s for repo %s deleted", name, r.GetFullName()))
+}
+
+// variableAdmin is a helper function to determine if the
+// user is an admin for the repo, who may read the values
+// for the sensitive variables.
+func variableAdmin(c *gin.Context, r *library.Repo, u *library.User) bool {
+	if u.GetAdmin() {
+		return true
+	}
+
+	// send API call to capture the access level for the user
+	perm, err := scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
+	if err != nil {
+		logrus.Errorf("unable to get user %s access level for repo %s: %v", u.GetName(), r.GetFullName(), err)
+
+		return false
+	}
+
+	return perm == "admin"
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/requiredcontexts/{org} requiredcontexts CreateRequiredContext
+//
+// Create a required context for a repo in an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the required context to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RequiredContext"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the required context
+//     schema:
+//       "$ref": "#/definitions/RequiredContext"
+//   '400':
+//     description: Unable to create the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The required context already exists
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateRequiredContext represents the API handler to create a required
+// context for a repo in an org in the configured backend. The contexts are
+// required by the branch protection for the repo when it is enabled.
+func CreateRequiredContext(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.RequiredContext)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new required context for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("creating new required context for repo %s in org %s", input.GetRepo(), o)
+
+	err = validate(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create required context for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the required context for the repo
+	existing, err := database.FromContext(c).GetRequiredContextForRepo(c, o, input.GetRepo())
+	if err == nil {
+		retErr := fmt.Errorf("unable to create required context for org %s: context %d already exists for repo %s", o, existing.GetID(), input.GetRepo())
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	// default the required context to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in required context object
+	input.SetID(0)
+	input.SetOrg(o)
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the required context
+	err = database.FromContext(c).CreateRequiredContext(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create required context for repo %s in org %s: %w", input.GetRepo(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created required context
+	rc, _ := database.FromContext(c).GetRequiredContextForRepo(c, o, input.GetRepo())
+
+	c.JSON(http.StatusCreated, rc)
+}
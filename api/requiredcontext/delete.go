@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/requiredcontexts/{org}/{context} requiredcontexts DeleteRequiredContext
+//
+// Delete a required context for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: context
+//   description: ID of the required context
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the required context
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to delete the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to delete the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteRequiredContext represents the API handler to remove
+// a required context for an org from the configured backend.
+func DeleteRequiredContext(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":     o,
+		"context": util.PathParameter(c, "context"),
+		"user":    u.GetName(),
+	}).Infof("deleting required context %s for org %s", util.PathParameter(c, "context"), o)
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	// send API call to remove the required context
+	err := database.FromContext(c).DeleteRequiredContext(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete required context %d for org %s: %w", r.GetID(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("required context %d deleted for org %s", r.GetID(), o))
+}
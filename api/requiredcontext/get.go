@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/requiredcontexts/{org}/{context} requiredcontexts GetRequiredContext
+//
+// Get a required context for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: context
+//   description: ID of the required context
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the required context
+//     schema:
+//       "$ref": "#/definitions/RequiredContext"
+//   '400':
+//     description: Unable to retrieve the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetRequiredContext represents the API handler to capture
+// a required context for an org from the configured backend.
+func GetRequiredContext(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":     o,
+		"context": util.PathParameter(c, "context"),
+		"user":    u.GetName(),
+	}).Infof("reading required context %s for org %s", util.PathParameter(c, "context"), o)
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
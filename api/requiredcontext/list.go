@@ -0,0 +1,72 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/requiredcontexts/{org} requiredcontexts ListRequiredContexts
+//
+// Get the required contexts for an org from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the required contexts
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/RequiredContext"
+//   '500':
+//     description: Unable to retrieve the required contexts
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListRequiredContexts represents the API handler to capture
+// the required contexts for an org from the configured backend.
+func ListRequiredContexts(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("listing required contexts for org %s", o)
+
+	// send API call to capture the list of required contexts
+	contexts, err := database.FromContext(c).ListRequiredContextsForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list required contexts for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, contexts)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"gorm.io/gorm"
+)
+
+// capture is a helper function to capture the required context for
+// the ID in the path of the request that belongs to the org. When the
+// required context can't be captured, the error is handled and it returns nil.
+func capture(c *gin.Context) *api.RequiredContext {
+	o := org.Retrieve(c)
+	param := util.PathParameter(c, "context")
+
+	id, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid context parameter provided: %s", param)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return nil
+	}
+
+	// send API call to capture the required context
+	r, err := database.FromContext(c).GetRequiredContext(c, id)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get required context %d for org %s: %w", id, o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	// verify the required context belongs to the org
+	if r.GetOrg() != o {
+		retErr := fmt.Errorf("unable to get required context %d for org %s: context belongs to another org", id, o)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	return r
+}
+
+// validate is a helper function to verify the
+// repo and contexts of the required context.
+func validate(r *api.RequiredContext) error {
+	if len(r.GetRepo()) == 0 {
+		return fmt.Errorf("repo must be the name of a repo or %s", api.RequiredContextRepoAny)
+	}
+
+	if strings.Contains(r.GetRepo(), "/") {
+		return fmt.Errorf("repo must not include the org: %s", r.GetRepo())
+	}
+
+	for _, context := range r.GetContexts() {
+		if len(strings.TrimSpace(context)) == 0 {
+			return errors.New("contexts must not be empty")
+		}
+	}
+
+	return nil
+}
+
+// lookup is a helper function to capture the active required context
+// for the repo. The required context for the repo takes precedence over
+// the required context for every repo in the org. It returns nil when
+// no active required context applies to the repo.
+func lookup(c *gin.Context, r *library.Repo) (*api.RequiredContext, error) {
+	for _, name := range []string{r.GetName(), api.RequiredContextRepoAny} {
+		// send API call to capture the required context
+		rc, err := database.FromContext(c).GetRequiredContextForRepo(c, r.GetOrg(), name)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if !rc.GetActive() {
+			return nil, nil
+		}
+
+		return rc, nil
+	}
+
+	return nil, nil
+}
+
+// Protect configures the branch protection for the repo to require the
+// status contexts registered for the repo, or for every repo in the org,
+// with the source provider. It is a no-op when no active required context
+// applies to the repo.
+func Protect(c *gin.Context, u *library.User, r *library.Repo) error {
+	rc, err := lookup(c, r)
+	if err != nil {
+		return fmt.Errorf("unable to get required context for %s: %w", r.GetFullName(), err)
+	}
+
+	if rc == nil {
+		return nil
+	}
+
+	// send API call to protect the branch for the repo
+	err = scm.FromContext(c).ProtectBranch(u, r, rc.GetBranch(), rc.GetContexts(), rc.GetStrict())
+	if err != nil {
+		return fmt.Errorf("unable to protect branch for %s: %w", r.GetFullName(), err)
+	}
+
+	return nil
+}
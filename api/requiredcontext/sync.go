@@ -0,0 +1,187 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/requiredcontexts/{org}/{context}/sync requiredcontexts SyncRequiredContext
+//
+// Sync a required context to the branch protection for the enabled repos in the source provider
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: context
+//   description: ID of the required context
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully synced the required context
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to sync the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to sync the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to sync the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// SyncRequiredContext represents the API handler to configure the branch
+// protection in the source provider to require the status contexts for the
+// enabled repos the required context applies to. The branch protection is
+// configured with the credentials of the owner of each repo.
+func SyncRequiredContext(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":     o,
+		"context": util.PathParameter(c, "context"),
+		"user":    u.GetName(),
+	}).Infof("syncing required context %s for org %s", util.PathParameter(c, "context"), o)
+
+	rc := capture(c)
+	if rc == nil {
+		return
+	}
+
+	if !rc.GetActive() {
+		retErr := fmt.Errorf("unable to sync required context %d for org %s: context is not active", rc.GetID(), o)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	repos, err := syncRepos(c, rc)
+	if err != nil {
+		retErr := fmt.Errorf("unable to sync required context %d for org %s: %w", rc.GetID(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	failures := []string{}
+
+	for _, r := range repos {
+		// send API call to capture the owner of the repo
+		owner, err := database.FromContext(c).GetUser(c, r.GetUserID())
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: unable to get owner: %v", r.GetFullName(), err))
+
+			continue
+		}
+
+		// send API call to protect the branch for the repo
+		err = scm.FromContext(c).ProtectBranch(owner, r, rc.GetBranch(), rc.GetContexts(), rc.GetStrict())
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", r.GetFullName(), err))
+		}
+	}
+
+	if len(failures) > 0 {
+		retErr := fmt.Errorf("unable to sync required context %d for %d of %d repos in org %s: %s",
+			rc.GetID(), len(failures), len(repos), o, strings.Join(failures, "; "))
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("required context %d synced for %d repos in org %s", rc.GetID(), len(repos), o))
+}
+
+// syncRepos is a helper function to capture the enabled repos the required
+// context applies to. The required context for every repo in the org does
+// not apply to the repos with a required context of their own.
+func syncRepos(c *gin.Context, rc *api.RequiredContext) ([]*library.Repo, error) {
+	if rc.GetRepo() != api.RequiredContextRepoAny {
+		// send API call to capture the repo
+		r, err := database.FromContext(c).GetRepoForOrg(c, rc.GetOrg(), rc.GetRepo())
+		if err != nil {
+			return nil, fmt.Errorf("unable to get repo %s/%s: %w", rc.GetOrg(), rc.GetRepo(), err)
+		}
+
+		if !r.GetActive() {
+			return []*library.Repo{}, nil
+		}
+
+		return []*library.Repo{r}, nil
+	}
+
+	// send API call to capture the required contexts for the org
+	contexts, err := database.FromContext(c).ListRequiredContextsForOrg(c, rc.GetOrg())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list required contexts: %w", err)
+	}
+
+	skip := make(map[string]bool)
+
+	for _, context := range contexts {
+		skip[context.GetRepo()] = true
+	}
+
+	filters := map[string]interface{}{
+		"active": "true",
+	}
+
+	repos := []*library.Repo{}
+
+	for page := 1; ; page++ {
+		// send API call to capture the page of enabled repos for the org
+		results, _, err := database.FromContext(c).ListReposForOrg(c, rc.GetOrg(), "name", filters, page, 100)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list repos: %w", err)
+		}
+
+		for _, r := range results {
+			if !skip[r.GetName()] {
+				repos = append(repos, r)
+			}
+		}
+
+		// break the loop if there is no more results to page through
+		if len(results) < 100 {
+			break
+		}
+	}
+
+	return repos, nil
+}
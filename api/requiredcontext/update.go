@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation PUT /api/v1/requiredcontexts/{org}/{context} requiredcontexts UpdateRequiredContext
+//
+// Update a required context for an org in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: context
+//   description: ID of the required context
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the required context to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/RequiredContext"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the required context
+//     schema:
+//       "$ref": "#/definitions/RequiredContext"
+//   '400':
+//     description: Unable to update the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the required context
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateRequiredContext represents the API handler to
+// update a required context for an org in the configured backend.
+func UpdateRequiredContext(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":     o,
+		"context": util.PathParameter(c, "context"),
+		"user":    u.GetName(),
+	}).Infof("updating required context %s for org %s", util.PathParameter(c, "context"), o)
+
+	// capture body from API request
+	input := new(api.RequiredContext)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for required context %s for org %s: %w", util.PathParameter(c, "context"), o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r := capture(c)
+	if r == nil {
+		return
+	}
+
+	if input.Branch != nil {
+		// update branch if set
+		r.SetBranch(input.GetBranch())
+	}
+
+	if input.Contexts != nil {
+		// update contexts if set
+		r.SetContexts(input.GetContexts())
+	}
+
+	if input.Strict != nil {
+		// update strict if set
+		r.SetStrict(input.GetStrict())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		r.SetActive(input.GetActive())
+	}
+
+	err = validate(r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update required context %d for org %s: %w", r.GetID(), o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r.SetUpdatedAt(time.Now().UTC().Unix())
+	r.SetUpdatedBy(u.GetName())
+
+	// send API call to update the required context
+	err = database.FromContext(c).UpdateRequiredContext(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update required context %d for org %s: %w", r.GetID(), o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/report"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/placement"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scheduler"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// ScheduleTrigger returns the function used by the scheduler to trigger
+// a push build for the head of the branch of a schedule, defaulting
+// to the branch of the repo, and publish it to the queue.
+func ScheduleTrigger(comp compiler.Engine, db database.Service, m *types.Metadata, q queue.Service, pl *placement.Placer, rs *report.Store, s scm.Service) scheduler.Trigger {
+	return func(ctx context.Context, schedule *api.Schedule, r *library.Repo) (*library.Build, error) {
+		return triggerSchedule(ctx, comp, db, m, q, pl, rs, s, schedule, r)
+	}
+}
+
+// triggerSchedule is a helper function to create a push build for
+// the head of the branch of the schedule and publish it to the queue.
+//
+//nolint:funlen // ignore function length
+func triggerSchedule(
+	ctx context.Context,
+	comp compiler.Engine,
+	db database.Service,
+	m *types.Metadata,
+	q queue.Service,
+	pl *placement.Placer,
+	rs *report.Store,
+	s scm.Service,
+	schedule *api.Schedule,
+	r *library.Repo,
+) (*library.Build, error) {
+	// send API call to capture the repo owner
+	u, err := db.GetUser(ctx, r.GetUserID())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
+	}
+
+	// create SQL filters for querying pending and running builds for repo
+	filters := map[string]interface{}{
+		"status": []string{constants.StatusPending, constants.StatusRunning},
+	}
+
+	// send API call to capture the number of pending or running builds for the repo
+	builds, err := db.GetRepoBuildCount(ctx, r, filters)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get count of builds for repo %s: %w", r.GetFullName(), err)
+	}
+
+	// check if the number of pending and running builds exceeds the limit for the repo
+	if builds >= r.GetBuildLimit() {
+		return nil, fmt.Errorf("repo %s has exceeded the concurrent build limit of %d", r.GetFullName(), r.GetBuildLimit())
+	}
+
+	// check if the number of pending and running builds exceeds the limit for the worker group
+	err = verifyWorkerGroupLimit(ctx, db, r)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := schedule.GetBranch()
+	if len(branch) == 0 {
+		branch = r.GetBranch()
+	}
+
+	// send API call to capture the commit at the head of the branch
+	commit, err := s.GetCommitSHA(u, r, branch)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get commit for branch %s: %w", branch, err)
+	}
+
+	sender := schedule.GetUpdatedBy()
+	if len(sender) == 0 {
+		sender = schedule.GetCreatedBy()
+	}
+
+	b := new(library.Build)
+	b.SetRepoID(r.GetID())
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusPending)
+	b.SetBranch(branch)
+	b.SetRef(fmt.Sprintf("refs/heads/%s", branch))
+	b.SetCommit(commit)
+	b.SetClone(r.GetClone())
+	b.SetMessage(fmt.Sprintf("scheduled build for schedule %s", schedule.GetName()))
+	b.SetAuthor(sender)
+	b.SetSender(sender)
+	b.SetCreated(time.Now().UTC().Unix())
+
+	// set the parent equal to the current repo counter
+	b.SetParent(r.GetCounter())
+	// check if the parent is set to 0
+	if b.GetParent() == 0 {
+		// parent should be "1" if it's the first build ran
+		b.SetParent(1)
+	}
+
+	// update the build numbers based off repo counter
+	inc := r.GetCounter() + 1
+	r.SetCounter(inc)
+	b.SetNumber(inc)
+
+	// populate the build link if a web address is provided
+	if m != nil && len(m.Vela.WebAddress) > 0 {
+		b.SetLink(fmt.Sprintf("%s/%s/%d", m.Vela.WebAddress, r.GetFullName(), b.GetNumber()))
+	}
+
+	// send API call to capture list of files changed for the commit
+	files, err := s.Changeset(u, r, commit)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get changeset for %s: %w", r.GetFullName(), err)
+	}
+
+	// send API calls to compute the context for the build
+	buildCtx, err := buildContext(s, u, r, b, 0, files)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get build context for %s: %w", r.GetFullName(), err)
+	}
+
+	var (
+		// variable to store the raw pipeline configuration
+		config []byte
+		// variable to store the pipeline type for the repository
+		pipelineType = r.GetPipelineType()
+	)
+
+	// send API call to attempt to capture the pipeline
+	pipeline, err := db.GetPipelineForRepo(ctx, commit, r)
+	if err != nil { // assume the pipeline doesn't exist in the database yet
+		// send API call to capture the pipeline configuration file
+		config, err = s.ConfigBackoff(u, r, commit)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get pipeline configuration for %s: %w", r.GetFullName(), err)
+		}
+	} else {
+		config = pipeline.GetData()
+	}
+
+	// ensure we use the expected pipeline type when compiling
+	if len(pipeline.GetType()) > 0 {
+		r.SetPipelineType(pipeline.GetType())
+	}
+
+	// create a compiler to capture the report for the pipeline
+	engine := comp.Duplicate()
+	// parse and compile the pipeline configuration file
+	p, compiled, err := engine.
+		WithBuild(b).
+		WithBuildContext(buildCtx).
+		WithContext(ctx).
+		WithFiles(files).
+		WithMetadata(m).
+		WithRepo(r).
+		WithUser(u).
+		Compile(config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile pipeline configuration for %s/%d: %w", r.GetFullName(), b.GetNumber(), err)
+	}
+
+	// reset the pipeline type for the repo
+	r.SetPipelineType(pipelineType)
+
+	// skip the build if only the init or clone steps are found
+	skip := skipEmptyBuild(p)
+	if len(skip) > 0 {
+		return nil, errors.New(skip)
+	}
+
+	// check if the pipeline did not already exist in the database
+	if pipeline == nil {
+		pipeline = compiled
+		pipeline.SetRepoID(r.GetID())
+		pipeline.SetCommit(commit)
+		pipeline.SetRef(b.GetRef())
+
+		// send API call to create the pipeline
+		err = db.CreatePipeline(ctx, pipeline)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create pipeline for %s: %w", r.GetFullName(), err)
+		}
+
+		// send API call to capture the created pipeline
+		pipeline, err = db.GetPipelineForRepo(ctx, pipeline.GetCommit(), r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get new pipeline %s/%s: %w", r.GetFullName(), commit, err)
+		}
+	}
+
+	b.SetPipelineID(pipeline.GetID())
+
+	// create the build and publish it to the queue
+	return createBuild(ctx, db, rs, s, q, pl, engine, p, b, r, u)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/schedules/{org}/{repo} schedules CreateSchedule
+//
+// Create a schedule for a repo in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the schedule to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Schedule"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the schedule
+//     schema:
+//       "$ref": "#/definitions/Schedule"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
+//   '400':
+//     description: Unable to create the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Unable to create the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateSchedule represents the API handler to create a schedule
+// for a repo in the configured backend. The cron entry of the
+// schedule is evaluated in its time zone, defaulting to UTC.
+func CreateSchedule(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.Schedule)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new schedule for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	entry := fmt.Sprintf("%s/%s", r.GetFullName(), input.GetName())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"repo":     r.GetName(),
+		"schedule": input.GetName(),
+		"user":     u.GetName(),
+	}).Infof("creating new schedule %s", entry)
+
+	// send API call to check if the schedule already exists
+	_, err = database.FromContext(c).GetScheduleForRepo(c, r, input.GetName())
+	if err == nil {
+		retErr := fmt.Errorf("unable to create schedule %s: schedule already exists", entry)
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	create(c, r, u, input)
+}
+
+// create is a helper function to set the defaults for the
+// new schedule, create the schedule and respond with the
+// created schedule.
+func create(c *gin.Context, r *library.Repo, u *library.User, input *api.Schedule) {
+	entry := fmt.Sprintf("%s/%s", r.GetFullName(), input.GetName())
+
+	// default the schedule to the UTC time zone
+	if len(input.GetTimeZone()) == 0 {
+		input.SetTimeZone("UTC")
+	}
+
+	err := validate(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	now := time.Now().UTC().Unix()
+
+	// default the schedule to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in schedule object
+	input.SetID(0)
+	input.SetRepoID(r.GetID())
+	input.SetCreatedAt(now)
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(now)
+	input.SetUpdatedBy(u.GetName())
+	input.SetScheduledAt(0)
+
+	// send API call to create the schedule
+	err = database.FromContext(c).CreateSchedule(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created schedule
+	s, err := database.FromContext(c).GetScheduleForRepo(c, r, input.GetName())
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// new schedules start at version 0
+	util.SetETag(c, etag(s, 0))
+
+	c.JSON(http.StatusCreated, s)
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/util"
+)
+
+// etag is a helper function to create the entity tag for the fields
+// of the schedule at the provided version, leaving out the audit fields
+// and the time it was last scheduled since that changes with every run
+// of the schedule.
+func etag(s *api.Schedule, version int64) string {
+	return util.ETag(map[string]interface{}{
+		"id":        s.GetID(),
+		"version":   version,
+		"name":      s.GetName(),
+		"active":    s.GetActive(),
+		"entry":     s.GetEntry(),
+		"time_zone": s.GetTimeZone(),
+		"branch":    s.GetBranch(),
+	})
+}
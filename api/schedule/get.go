@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/schedules/{org}/{repo}/{schedule} schedules GetSchedule
+//
+// Get a schedule for a repo from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: schedule
+//   description: Name of the schedule
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the schedule
+//     schema:
+//       "$ref": "#/definitions/Schedule"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
+//   '404':
+//     description: Unable to retrieve the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetSchedule represents the API handler to capture
+// a schedule for a repo from the configured backend.
+func GetSchedule(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"repo":     r.GetName(),
+		"schedule": util.PathParameter(c, "schedule"),
+		"user":     u.GetName(),
+	}).Infof("reading schedule %s/%s", r.GetFullName(), util.PathParameter(c, "schedule"))
+
+	s := capture(c)
+	if s == nil {
+		return
+	}
+
+	// send API call to capture the version of the schedule
+	version, err := database.FromContext(c).GetScheduleVersion(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get version of schedule %s/%s: %w", r.GetFullName(), s.GetName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	util.SetETag(c, etag(s, version))
+
+	c.JSON(http.StatusOK, s)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/schedules/{org}/{repo} schedules ListSchedules
+//
+// Get the schedules for a repo from the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the schedules
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/Schedule"
+//   '500':
+//     description: Unable to retrieve the schedules
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListSchedules represents the API handler to capture
+// the schedules for a repo from the configured backend.
+func ListSchedules(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("listing schedules for repo %s", r.GetFullName())
+
+	// send API call to capture the schedules for the repo
+	schedules, err := database.FromContext(c).ListSchedulesForRepo(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list schedules for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, schedules)
+}
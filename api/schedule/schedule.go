@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/scheduler"
+	"github.com/go-vela/server/util"
+)
+
+// capture is a helper function to capture the schedule for the
+// name in the path of the request that belongs to the repo. When
+// the schedule can't be captured, the error is handled and it
+// returns nil.
+func capture(c *gin.Context) *api.Schedule {
+	r := repo.Retrieve(c)
+	name := util.PathParameter(c, "schedule")
+
+	// send API call to capture the schedule
+	s, err := database.FromContext(c).GetScheduleForRepo(c, r, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get schedule %s/%s: %w", r.GetFullName(), name, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return nil
+	}
+
+	return s
+}
+
+// validate is a helper function to verify the name,
+// cron entry and time zone of the schedule.
+func validate(s *api.Schedule) error {
+	if len(s.GetName()) == 0 {
+		return fmt.Errorf("no name provided")
+	}
+
+	// the local time zone depends on the server the scheduler runs on
+	if s.GetTimeZone() == "Local" {
+		return fmt.Errorf("invalid time zone %q: must be an IANA time zone", s.GetTimeZone())
+	}
+
+	loc, err := time.LoadLocation(s.GetTimeZone())
+	if err != nil {
+		return fmt.Errorf("invalid time zone %q: %w", s.GetTimeZone(), err)
+	}
+
+	cron, err := scheduler.Parse(s.GetEntry())
+	if err != nil {
+		return err
+	}
+
+	// check if the entry is ever due, like an entry for the 30th of February
+	if cron.Next(time.Now().In(loc)).IsZero() {
+		return fmt.Errorf("cron entry %q is never due", s.GetEntry())
+	}
+
+	return nil
+}
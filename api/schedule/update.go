@@ -0,0 +1,248 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation PUT /api/v1/schedules/{org}/{repo}/{schedule} schedules UpdateSchedule
+//
+// Update a schedule for a repo in the configured backend or create it when it doesn't exist
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: schedule
+//   description: Name of the schedule
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the schedule to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/Schedule"
+// - in: header
+//   name: If-Match
+//   description: Entity tag the resource must match to be updated
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the schedule
+//     schema:
+//       "$ref": "#/definitions/Schedule"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
+//   '201':
+//     description: Successfully created the schedule
+//     schema:
+//       "$ref": "#/definitions/Schedule"
+//   '400':
+//     description: Unable to update the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The schedule was updated by another request
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '412':
+//     description: The schedule was modified since it was last read
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the schedule
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateSchedule represents the API handler to update a schedule
+// for a repo in the configured backend. The schedule is next due
+// from the time it was updated. When the schedule doesn't exist,
+// it's created with the provided fields so the same request can
+// be repeated to manage the schedule.
+func UpdateSchedule(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":      o,
+		"repo":     r.GetName(),
+		"schedule": util.PathParameter(c, "schedule"),
+		"user":     u.GetName(),
+	}).Infof("updating schedule %s/%s", r.GetFullName(), util.PathParameter(c, "schedule"))
+
+	name := util.PathParameter(c, "schedule")
+	entry := fmt.Sprintf("%s/%s", r.GetFullName(), name)
+
+	// capture body from API request
+	input := new(api.Schedule)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the schedule
+	s, err := database.FromContext(c).GetScheduleForRepo(c, r, name)
+	if err != nil {
+		// create the schedule when it doesn't exist, unless the
+		// request expected to update an existing schedule
+		if errors.Is(err, gorm.ErrRecordNotFound) && len(c.GetHeader("If-Match")) == 0 {
+			input.SetName(name)
+
+			create(c, r, u, input)
+
+			return
+		}
+
+		retErr := fmt.Errorf("unable to get schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to capture the version of the schedule
+	version, err := database.FromContext(c).GetScheduleVersion(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get version of schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the schedule at the version
+	//
+	// the schedule is captured again after the version so an update
+	// made in between is rejected instead of being silently overwritten
+	s, err = database.FromContext(c).GetSchedule(c, s.GetID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// check if the schedule was changed since it was last read
+	if !util.MatchETag(c, etag(s, version)) {
+		retErr := fmt.Errorf("unable to update schedule %s: schedule was modified", entry)
+
+		util.HandleError(c, http.StatusPreconditionFailed, retErr)
+
+		return
+	}
+
+	// check if the Active field in the schedule was provided
+	if input.Active != nil {
+		// update the Active field
+		s.SetActive(input.GetActive())
+	}
+
+	// check if the Entry field in the schedule was provided
+	if len(input.GetEntry()) > 0 {
+		// update the Entry field
+		s.SetEntry(input.GetEntry())
+	}
+
+	// check if the TimeZone field in the schedule was provided
+	if len(input.GetTimeZone()) > 0 {
+		// update the TimeZone field
+		s.SetTimeZone(input.GetTimeZone())
+	}
+
+	// check if the Branch field in the schedule was provided
+	if input.Branch != nil {
+		// update the Branch field
+		s.SetBranch(input.GetBranch())
+	}
+
+	err = validate(s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	s.SetUpdatedAt(time.Now().UTC().Unix())
+	s.SetUpdatedBy(u.GetName())
+
+	// send API call to update the schedule when it wasn't updated since the version
+	version, err = database.FromContext(c).UpdateScheduleIfVersion(c, s, version)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update schedule %s: %w", entry, err)
+
+		// check if the schedule was updated by another request
+		if errors.Is(err, types.ErrVersionConflict) {
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated schedule
+	s, err = database.FromContext(c).GetSchedule(c, s.GetID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture schedule %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	util.SetETag(c, etag(s, version))
+
+	c.JSON(http.StatusOK, s)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/types/library"
+)
+
+func TestAPI_triggerSchedule(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	// setup mock server
+	engine.GET("/api/v3/repos/github/octocat/commits/:ref", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := github.NewTest(s.URL)
+
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from users;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+	_user.SetToken("foo")
+	_user.SetHash("baz")
+	_user.SetActive(true)
+
+	err := db.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	_schedule := new(api.Schedule)
+	_schedule.SetName("nightly")
+	_schedule.SetBranch("release")
+
+	// setup tests
+	tests := []struct {
+		name       string
+		buildLimit int64
+		want       string
+	}{
+		{
+			name:       "exceeded build limit",
+			buildLimit: 0,
+			want:       "exceeded the concurrent build limit",
+		},
+		{
+			name:       "missing branch",
+			buildLimit: 10,
+			want:       "unable to get commit for branch release",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_repo := new(library.Repo)
+			_repo.SetID(1)
+			_repo.SetUserID(1)
+			_repo.SetOrg("github")
+			_repo.SetName("octocat")
+			_repo.SetFullName("github/octocat")
+			_repo.SetBranch("main")
+			_repo.SetBuildLimit(test.buildLimit)
+
+			_, err := triggerSchedule(context.TODO(), nil, db, nil, nil, nil, nil, client, _schedule, _repo)
+			if err == nil {
+				t.Errorf("triggerSchedule should have returned err")
+
+				return
+			}
+
+			if !strings.Contains(err.Error(), test.want) {
+				t.Errorf("triggerSchedule returned err %v, want %s", err, test.want)
+			}
+		})
+	}
+}
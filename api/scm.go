@@ -77,7 +77,7 @@ func SyncRepos(c *gin.Context) {
 	}
 
 	// send API call to capture the total number of repos for the org
-	t, err := database.FromContext(c).CountReposForOrg(o, filters)
+	t, err := database.FromContext(c).CountReposForOrg(c, o, filters)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get repo count for org %s: %w", o, err)
 
@@ -90,7 +90,7 @@ func SyncRepos(c *gin.Context) {
 	page := 0
 	// capture all repos belonging to a certain org in database
 	for orgRepos := int64(0); orgRepos < t; orgRepos += 100 {
-		r, _, err := database.FromContext(c).ListReposForOrg(o, "name", filters, page, 100)
+		r, _, err := database.FromContext(c).ListReposForOrg(c, o, "name", filters, page, 100)
 		if err != nil {
 			retErr := fmt.Errorf("unable to get repo count for org %s: %w", o, err)
 
@@ -111,7 +111,7 @@ func SyncRepos(c *gin.Context) {
 		if err != nil {
 			repo.SetActive(false)
 
-			err := database.FromContext(c).UpdateRepo(repo)
+			err := database.FromContext(c).UpdateRepo(c, repo)
 			if err != nil {
 				retErr := fmt.Errorf("unable to update repo for org %s: %w", o, err)
 
@@ -185,7 +185,7 @@ func SyncRepo(c *gin.Context) {
 		r.SetActive(false)
 
 		// update repo in database
-		err := database.FromContext(c).UpdateRepo(r)
+		err := database.FromContext(c).UpdateRepo(c, r)
 		if err != nil {
 			retErr := fmt.Errorf("unable to update repo for org %s: %w", o, err)
 
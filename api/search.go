@@ -0,0 +1,242 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// searchLinesLimit represents the maximum number of matching
+// log lines returned for each build in the search results.
+const searchLinesLimit = 10
+
+// swagger:operation GET /api/v1/search search SearchBuilds
+//
+// Search for builds by commit message, branch and author in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: query
+//   name: q
+//   description: The text to search for
+//   required: true
+//   type: string
+// - in: query
+//   name: org
+//   description: Name of the org to limit the search to
+//   type: string
+// - in: query
+//   name: repo
+//   description: Name of the repo to limit the search to, requires org
+//   type: string
+// - in: query
+//   name: logs
+//   description: Search within the stored logs for the builds
+//   type: boolean
+//   default: false
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 100
+//   default: 10
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the search results
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/SearchResult"
+//     headers:
+//       X-Total-Count:
+//         description: Total number of results
+//         type: integer
+//       Link:
+//         description: see https://tools.ietf.org/html/rfc5988
+//         type: string
+//   '400':
+//     description: Unable to search for builds
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to search for builds
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// SearchBuilds represents the API handler to capture a list
+// of builds matching a search query from the configured backend.
+func SearchBuilds(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture the search query parameters
+	q := c.Query("q")
+	o := c.Query("org")
+	r := c.Query("repo")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r,
+		"user": u.GetName(),
+	}).Infof("searching builds for %s", q)
+
+	if len(q) == 0 {
+		retErr := fmt.Errorf("unable to search builds: no q query parameter provided")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if len(r) > 0 && len(o) == 0 {
+		retErr := fmt.Errorf("unable to search builds for repo %s: no org query parameter provided", r)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture logs query parameter if present
+	logs, err := strconv.ParseBool(c.DefaultQuery("logs", "false"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert logs query parameter: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure per_page isn't above or below allowed values
+	perPage = util.MaxInt(1, util.MinInt(100, perPage))
+
+	filters := map[string]interface{}{}
+
+	if len(o) > 0 {
+		filters["org"] = o
+	}
+
+	if len(r) > 0 {
+		filters["name"] = r
+	}
+
+	// only show public repos to users that aren't platform or org admins
+	if !u.GetAdmin() {
+		perm := ""
+
+		if len(o) > 0 {
+			perm, err = scm.FromContext(c).OrgAccess(u, o)
+			if err != nil {
+				logrus.Errorf("unable to get user %s access level for org %s", u.GetName(), o)
+			}
+		}
+
+		if perm != "admin" {
+			filters["visibility"] = constants.VisibilityPublic
+		}
+	}
+
+	b, t, err := database.FromContext(c).SearchBuilds(c, q, filters, logs, page, perPage)
+	if err != nil {
+		retErr := fmt.Errorf("unable to search builds for %s: %w", q, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	results := []*api.SearchResult{}
+	repos := map[int64]*library.Repo{}
+
+	for _, build := range b {
+		// capture the repo for the build once for all of its builds
+		repo, ok := repos[build.GetRepoID()]
+		if !ok {
+			repo, err = database.FromContext(c).GetRepo(c, build.GetRepoID())
+			if err != nil {
+				retErr := fmt.Errorf("unable to get repo %d for build %d: %w", build.GetRepoID(), build.GetID(), err)
+
+				util.HandleError(c, http.StatusInternalServerError, retErr)
+
+				return
+			}
+
+			repos[build.GetRepoID()] = repo
+		}
+
+		result := new(api.SearchResult)
+		result.SetRepo(repo.GetFullName())
+		result.SetBuild(build)
+
+		if logs {
+			lines, err := database.FromContext(c).SearchLinesForBuild(c, build, q, searchLinesLimit)
+			if err != nil {
+				retErr := fmt.Errorf("unable to search logs for build %s/%d: %w", repo.GetFullName(), build.GetNumber(), err)
+
+				util.HandleError(c, http.StatusInternalServerError, retErr)
+
+				return
+			}
+
+			result.SetLines(lines)
+		}
+
+		results = append(results, result)
+	}
+
+	// create pagination object
+	pagination := Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   t,
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	c.JSON(http.StatusOK, results)
+}
@@ -5,6 +5,8 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -12,6 +14,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/server/router/middleware/claims"
 	"github.com/go-vela/server/router/middleware/user"
 	"github.com/go-vela/server/scm"
@@ -68,6 +71,10 @@ import (
 //     description: Successfully created the secret
 //     schema:
 //       "$ref": "#/definitions/Secret"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
 //   '400':
 //     description: Unable to create the secret
 //     schema:
@@ -231,7 +238,7 @@ func CreateSecret(c *gin.Context) {
 	}
 
 	// send API call to create the secret
-	err = secret.FromContext(c, e).Create(t, o, n, input)
+	err = secret.FromContext(c, e).Create(c.Request.Context(), t, o, n, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create secret %s for %s service: %w", entry, e, err)
 
@@ -240,7 +247,10 @@ func CreateSecret(c *gin.Context) {
 		return
 	}
 
-	s, _ := secret.FromContext(c, e).Get(t, o, n, input.GetName())
+	s, _ := secret.FromContext(c, e).Get(c.Request.Context(), t, o, n, input.GetName())
+
+	// new secrets always start at version 0
+	util.SetETag(c, secretETag(s, 0))
 
 	c.JSON(http.StatusOK, s.Sanitize())
 }
@@ -388,7 +398,7 @@ func GetSecrets(c *gin.Context) {
 	}
 
 	// send API call to capture the total number of secrets
-	total, err := secret.FromContext(c, e).Count(t, o, n, teams)
+	total, err := secret.FromContext(c, e).Count(c.Request.Context(), t, o, n, teams)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get secret count for %s from %s service: %w", entry, e, err)
 
@@ -401,7 +411,7 @@ func GetSecrets(c *gin.Context) {
 	perPage = util.MaxInt(1, util.MinInt(100, perPage))
 
 	// send API call to capture the list of secrets
-	s, err := secret.FromContext(c, e).List(t, o, n, page, perPage, teams)
+	s, err := secret.FromContext(c, e).List(c.Request.Context(), t, o, n, page, perPage, teams)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get secrets for %s from %s service: %w", entry, e, err)
 
@@ -478,6 +488,10 @@ func GetSecrets(c *gin.Context) {
 //     description: Successfully retrieved the secret
 //     schema:
 //       "$ref": "#/definitions/Secret"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
 //   '500':
 //     description: Unable to retrieve the secret
 //     schema:
@@ -524,8 +538,18 @@ func GetSecret(c *gin.Context) {
 	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
 	logrus.WithFields(fields).Infof("reading secret %s from %s service", entry, e)
 
+	// send API call to capture the version of the secret
+	version, err := secretVersion(c.Request.Context(), secret.FromContext(c, e), t, o, n, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get version of secret %s from %s service: %w", entry, e, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
 	// send API call to capture the secret
-	secret, err := secret.FromContext(c, e).Get(t, o, n, s)
+	secret, err := secret.FromContext(c, e).Get(c.Request.Context(), t, o, n, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get secret %s from %s service: %w", entry, e, err)
 
@@ -534,6 +558,8 @@ func GetSecret(c *gin.Context) {
 		return
 	}
 
+	util.SetETag(c, secretETag(secret, version))
+
 	// only allow workers to access the full secret with the value
 	if strings.EqualFold(cl.TokenType, constants.WorkerBuildTokenType) {
 		c.JSON(http.StatusOK, secret)
@@ -588,6 +614,10 @@ func GetSecret(c *gin.Context) {
 //   required: true
 //   schema:
 //     "$ref": "#/definitions/Secret"
+// - in: header
+//   name: If-Match
+//   description: Entity tag the resource must match to be updated
+//   type: string
 // security:
 //   - ApiKeyAuth: []
 // responses:
@@ -595,10 +625,26 @@ func GetSecret(c *gin.Context) {
 //     description: Successfully updated the secret
 //     schema:
 //       "$ref": "#/definitions/Secret"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
 //   '400':
 //     description: Unable to update the secret
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the secret
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The secret was updated by another request
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '412':
+//     description: The secret was modified since it was last read
+//     schema:
+//       "$ref": "#/definitions/Error"
 //   '500':
 //     description: Unable to update the secret
 //     schema:
@@ -644,10 +690,42 @@ func UpdateSecret(c *gin.Context) {
 	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
 	logrus.WithFields(fields).Infof("updating secret %s for %s service", entry, e)
 
+	// send API call to capture the version of the secret
+	version, err := secretVersion(c.Request.Context(), secret.FromContext(c, e), t, o, n, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get version of secret %s from %s service: %w", entry, e, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to capture the secret at the version
+	//
+	// the secret is captured after the version so an update made
+	// in between is rejected instead of being silently overwritten
+	current, err := secret.FromContext(c, e).Get(c.Request.Context(), t, o, n, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get secret %s from %s service: %w", entry, e, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// check if the secret was changed since it was last read
+	if !util.MatchETag(c, secretETag(current, version)) {
+		retErr := fmt.Errorf("unable to update secret %s for %s service: secret was modified", entry, e)
+
+		util.HandleError(c, http.StatusPreconditionFailed, retErr)
+
+		return
+	}
+
 	// capture body from API request
 	input := new(library.Secret)
 
-	err := c.Bind(input)
+	err = c.Bind(input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to decode JSON for secret %s for %s service: %w", entry, e, err)
 
@@ -685,18 +763,33 @@ func UpdateSecret(c *gin.Context) {
 		input.Repo = nil
 	}
 
-	// send API call to update the secret
-	err = secret.FromContext(c, e).Update(t, o, n, input)
+	// send API call to update the secret, only when it wasn't
+	// updated since the version for services tracking the version
+	if v, ok := secret.FromContext(c, e).(secret.Versioned); ok {
+		version, err = v.UpdateIfVersion(c.Request.Context(), t, o, n, input, version)
+	} else {
+		err = secret.FromContext(c, e).Update(c.Request.Context(), t, o, n, input)
+	}
+
 	if err != nil {
 		retErr := fmt.Errorf("unable to update secret %s for %s service: %w", entry, e, err)
 
+		// check if the secret was updated by another request
+		if errors.Is(err, types.ErrVersionConflict) {
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+
 		util.HandleError(c, http.StatusInternalServerError, retErr)
 
 		return
 	}
 
 	// send API call to capture the updated secret
-	secret, _ := secret.FromContext(c, e).Get(t, o, n, input.GetName())
+	secret, _ := secret.FromContext(c, e).Get(c.Request.Context(), t, o, n, input.GetName())
+
+	util.SetETag(c, secretETag(secret, version))
 
 	c.JSON(http.StatusOK, secret.Sanitize())
 }
@@ -752,6 +845,8 @@ func UpdateSecret(c *gin.Context) {
 //       "$ref": "#/definitions/Error"
 
 // DeleteSecret deletes a secret from the provided secrets service.
+// Native secrets are archived so an admin can restore them until
+// the retention sweeper purges them.
 func DeleteSecret(c *gin.Context) {
 	// capture middleware values
 	u := user.Retrieve(c)
@@ -792,7 +887,7 @@ func DeleteSecret(c *gin.Context) {
 	logrus.WithFields(fields).Infof("deleting secret %s from %s service", entry, e)
 
 	// send API call to remove the secret
-	err := secret.FromContext(c, e).Delete(t, o, n, s)
+	err := secret.FromContext(c, e).Delete(c.Request.Context(), t, o, n, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete secret %s from %s service: %w", entry, e, err)
 
@@ -820,3 +915,34 @@ func unique(stringSlice []string) []string {
 
 	return list
 }
+
+// secretETag is a helper function to create the entity tag for the
+// metadata of the secret at the provided version. The value is left
+// out since it's never returned from the API, along with the audit
+// timestamps.
+func secretETag(s *library.Secret, version int64) string {
+	return util.ETag(map[string]interface{}{
+		"id":            s.GetID(),
+		"version":       version,
+		"org":           s.GetOrg(),
+		"repo":          s.GetRepo(),
+		"team":          s.GetTeam(),
+		"name":          s.GetName(),
+		"type":          s.GetType(),
+		"images":        s.GetImages(),
+		"events":        s.GetEvents(),
+		"allow_command": s.GetAllowCommand(),
+	})
+}
+
+// secretVersion is a helper function to capture the version of the
+// secret from the secrets service. Services that don't track the
+// version of their secrets always return version 0.
+func secretVersion(ctx context.Context, service secret.Service, t, o, n, s string) (int64, error) {
+	v, ok := service.(secret.Versioned)
+	if !ok {
+		return 0, nil
+	}
+
+	return v.Version(ctx, t, o, n, s)
+}
@@ -5,6 +5,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -120,7 +121,7 @@ func CreateService(c *gin.Context) {
 	}
 
 	// send API call to create the service
-	err = database.FromContext(c).CreateService(input)
+	err = database.FromContext(c).CreateService(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create service for build %s: %w", entry, err)
 
@@ -130,7 +131,7 @@ func CreateService(c *gin.Context) {
 	}
 
 	// send API call to capture the created service
-	s, _ := database.FromContext(c).GetService(input.GetNumber(), b)
+	s, _ := database.FromContext(c).GetService(c, input.GetNumber(), b)
 
 	c.JSON(http.StatusCreated, s)
 }
@@ -239,7 +240,7 @@ func GetServices(c *gin.Context) {
 	perPage = util.MaxInt(1, util.MinInt(100, perPage))
 
 	// send API call to capture the total number of services for the build
-	t, err := database.FromContext(c).GetBuildServiceCount(b)
+	t, err := database.FromContext(c).GetBuildServiceCount(c, b)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get services count for build %s: %w", entry, err)
 
@@ -249,7 +250,7 @@ func GetServices(c *gin.Context) {
 	}
 
 	// send API call to capture the list of services for the build
-	s, err := database.FromContext(c).GetBuildServiceList(b, page, perPage)
+	s, err := database.FromContext(c).GetBuildServiceList(c, b, page, perPage)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get services for build %s: %w", entry, err)
 
@@ -452,7 +453,7 @@ func UpdateService(c *gin.Context) {
 	}
 
 	// send API call to update the service
-	err = database.FromContext(c).UpdateService(s)
+	err = database.FromContext(c).UpdateService(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update service %s: %w", entry, err)
 
@@ -462,7 +463,7 @@ func UpdateService(c *gin.Context) {
 	}
 
 	// send API call to capture the updated service
-	s, _ = database.FromContext(c).GetService(s.GetNumber(), b)
+	s, _ = database.FromContext(c).GetService(c, s.GetNumber(), b)
 
 	c.JSON(http.StatusOK, s)
 }
@@ -534,7 +535,7 @@ func DeleteService(c *gin.Context) {
 	}).Infof("deleting service %s", entry)
 
 	// send API call to remove the service
-	err := database.FromContext(c).DeleteService(s.GetID())
+	err := database.FromContext(c).DeleteService(c, s.GetID())
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete service %s: %w", entry, err)
 
@@ -547,9 +548,10 @@ func DeleteService(c *gin.Context) {
 }
 
 // planServices is a helper function to plan all services
-// in the build for execution. This creates the services
-// for the build in the configured backend.
-func planServices(database database.Service, p *pipeline.Build, b *library.Build) ([]*library.Service, error) {
+// in the build for execution. This creates the services,
+// and the logs for the services, for the build in the
+// configured backend with a single query for each.
+func planServices(ctx context.Context, database database.Service, p *pipeline.Build, b *library.Build) ([]*library.Service, error) {
 	// variable to store planned services
 	services := []*library.Service{}
 
@@ -565,22 +567,23 @@ func planServices(database database.Service, p *pipeline.Build, b *library.Build
 		s.SetStatus(constants.StatusPending)
 		s.SetCreated(time.Now().UTC().Unix())
 
-		// send API call to create the service
-		err := database.CreateService(s)
-		if err != nil {
-			return services, fmt.Errorf("unable to create service %s: %w", s.GetName(), err)
-		}
+		services = append(services, s)
+	}
 
-		// send API call to capture the created service
-		s, err = database.GetService(s.GetNumber(), b)
-		if err != nil {
-			return services, fmt.Errorf("unable to get service %s: %w", s.GetName(), err)
-		}
+	// send API call to create the services
+	err := database.CreateServices(ctx, services)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create services: %w", err)
+	}
 
+	// variable to store the logs for the planned services
+	logs := make([]*library.Log, 0, len(services))
+
+	for i, s := range services {
 		// populate environment variables from service library
 		//
 		// https://pkg.go.dev/github.com/go-vela/types/library#Service.Environment
-		err = service.MergeEnv(s.Environment())
+		err = p.Services[i].MergeEnv(s.Environment())
 		if err != nil {
 			return services, err
 		}
@@ -592,11 +595,13 @@ func planServices(database database.Service, p *pipeline.Build, b *library.Build
 		l.SetRepoID(b.GetRepoID())
 		l.SetData([]byte{})
 
-		// send API call to create the service logs
-		err = database.CreateLog(l)
-		if err != nil {
-			return services, fmt.Errorf("unable to create service logs for service %s: %w", s.GetName(), err)
-		}
+		logs = append(logs, l)
+	}
+
+	// send API call to create the service logs
+	err = database.CreateLogs(ctx, logs)
+	if err != nil {
+		return services, fmt.Errorf("unable to create logs for services: %w", err)
 	}
 
 	return services, nil
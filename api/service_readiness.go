@@ -0,0 +1,337 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/service"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// readinessStatuses represents the statuses a worker
+// can report for the readiness checks of a service.
+var readinessStatuses = map[string]bool{
+	"waiting": true,
+	"ready":   true,
+	"failure": true,
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/readiness services GetBuildServiceReadiness
+//
+// Get the readiness of the services for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to retrieve the service readiness for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the readiness of the services for the build
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/ServiceReadiness"
+//   '500':
+//     description: Unable to retrieve the readiness of the services for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBuildServiceReadiness represents the API handler to capture
+// the readiness reported for the services of a build.
+func GetBuildServiceReadiness(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading service readiness for build %s", entry)
+
+	// send API call to capture the readiness of the services for the build
+	readiness, err := database.FromContext(c).ListServiceReadinessForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get service readiness for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, readiness)
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/readiness services GetServiceReadiness
+//
+// Get the readiness of a service for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: service
+//   description: Service number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the readiness of the service
+//     schema:
+//       "$ref": "#/definitions/ServiceReadiness"
+//   '404':
+//     description: Unable to retrieve the readiness of the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the readiness of the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetServiceReadiness represents the API handler to capture
+// the readiness reported for a service of a build.
+func GetServiceReadiness(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := service.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"org":     o,
+		"repo":    r.GetName(),
+		"service": s.GetNumber(),
+		"user":    u.GetName(),
+	}).Infof("reading readiness for service %s", entry)
+
+	// send API call to capture the readiness of the service
+	readiness, err := database.FromContext(c).GetServiceReadinessForService(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get readiness for service %s: %w", entry, err)
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			util.HandleError(c, http.StatusNotFound, retErr)
+
+			return
+		}
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, readiness)
+}
+
+// swagger:operation PUT /api/v1/repos/{org}/{repo}/builds/{build}/services/{service}/readiness services UpdateServiceReadiness
+//
+// Record the readiness of a service for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: service
+//   description: Service number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the readiness of the service
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/ServiceReadiness"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully recorded the readiness of the service
+//     schema:
+//       "$ref": "#/definitions/ServiceReadiness"
+//   '400':
+//     description: Unable to record the readiness of the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to record the readiness of the service
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateServiceReadiness represents the API handler to record the
+// readiness reported by the worker for a service of a build. The
+// worker reports the readiness while it waits for the service and
+// once the service is ready or the readiness checks have failed.
+func UpdateServiceReadiness(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := service.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"org":     o,
+		"repo":    r.GetName(),
+		"service": s.GetNumber(),
+		"user":    u.GetName(),
+	}).Infof("recording readiness for service %s", entry)
+
+	// capture body from API request
+	input := new(types.ServiceReadiness)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for readiness of service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if !readinessStatuses[input.GetStatus()] {
+		retErr := fmt.Errorf("unable to record readiness for service %s: invalid status %q provided", entry, input.GetStatus())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if input.GetReady() > 0 && input.GetReady() < input.GetStarted() {
+		retErr := fmt.Errorf("unable to record readiness for service %s: ready is before started", entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the readiness recorded for the service
+	readiness, err := database.FromContext(c).GetServiceReadinessForService(c, s)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		retErr := fmt.Errorf("unable to get readiness for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// the service and build are always taken from the path
+	input.SetServiceID(s.GetID())
+	input.SetBuildID(b.GetID())
+	input.SetRepoID(r.GetID())
+
+	if readiness == nil {
+		input.SetID(0)
+
+		// send API call to create the readiness for the service
+		err = database.FromContext(c).CreateServiceReadiness(c, input)
+	} else {
+		input.SetID(readiness.GetID())
+
+		// keep the start of the checks from the first report
+		if input.GetStarted() == 0 {
+			input.SetStarted(readiness.GetStarted())
+		}
+
+		// send API call to update the readiness for the service
+		err = database.FromContext(c).UpdateServiceReadiness(c, input)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to record readiness for service %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the recorded readiness for the service
+	readiness, _ = database.FromContext(c).GetServiceReadinessForService(c, s)
+
+	c.JSON(http.StatusOK, readiness)
+}
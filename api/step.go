@@ -5,6 +5,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -117,7 +118,7 @@ func CreateStep(c *gin.Context) {
 	}
 
 	// send API call to create the step
-	err = database.FromContext(c).CreateStep(input)
+	err = database.FromContext(c).CreateStep(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create step for build %s: %w", entry, err)
 
@@ -127,7 +128,7 @@ func CreateStep(c *gin.Context) {
 	}
 
 	// send API call to capture the created step
-	s, _ := database.FromContext(c).GetStep(input.GetNumber(), b)
+	s, _ := database.FromContext(c).GetStep(c, input.GetNumber(), b)
 
 	c.JSON(http.StatusCreated, s)
 }
@@ -236,7 +237,7 @@ func GetSteps(c *gin.Context) {
 	perPage = util.MaxInt(1, util.MinInt(100, perPage))
 
 	// send API call to capture the total number of steps for the build
-	t, err := database.FromContext(c).GetBuildStepCount(b)
+	t, err := database.FromContext(c).GetBuildStepCount(c, b)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get steps count for build %s: %w", entry, err)
 
@@ -246,7 +247,7 @@ func GetSteps(c *gin.Context) {
 	}
 
 	// send API call to capture the list of steps for the build
-	s, err := database.FromContext(c).GetBuildStepList(b, page, perPage)
+	s, err := database.FromContext(c).GetBuildStepList(c, b, page, perPage)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get steps for build %s: %w", entry, err)
 
@@ -454,7 +455,7 @@ func UpdateStep(c *gin.Context) {
 	}
 
 	// send API call to update the step
-	err = database.FromContext(c).UpdateStep(s)
+	err = database.FromContext(c).UpdateStep(c, s)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update step %s: %w", entry, err)
 
@@ -464,7 +465,7 @@ func UpdateStep(c *gin.Context) {
 	}
 
 	// send API call to capture the updated step
-	s, _ = database.FromContext(c).GetStep(s.GetNumber(), b)
+	s, _ = database.FromContext(c).GetStep(c, s.GetNumber(), b)
 
 	c.JSON(http.StatusOK, s)
 }
@@ -535,7 +536,7 @@ func DeleteStep(c *gin.Context) {
 	}).Infof("deleting step %s", entry)
 
 	// send API call to remove the step
-	err := database.FromContext(c).DeleteStep(s.GetID())
+	err := database.FromContext(c).DeleteStep(c, s.GetID())
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete step %s: %w", entry, err)
 
@@ -548,92 +549,49 @@ func DeleteStep(c *gin.Context) {
 }
 
 // planSteps is a helper function to plan all steps
-// in the build for execution. This creates the steps
-// for the build in the configured backend.
-func planSteps(database database.Service, p *pipeline.Build, b *library.Build) ([]*library.Step, error) {
+// in the build for execution. This creates the steps,
+// and the logs for the steps, for the build in the
+// configured backend with a single query for each.
+func planSteps(ctx context.Context, database database.Service, p *pipeline.Build, b *library.Build) ([]*library.Step, error) {
 	// variable to store planned steps
 	steps := []*library.Step{}
+	// variable to store the containers for the planned steps
+	containers := pipeline.ContainerSlice{}
 
 	// iterate through all pipeline stages
 	for _, stage := range p.Stages {
 		// iterate through all steps for each pipeline stage
 		for _, step := range stage.Steps {
 			// create the step object
-			s := new(library.Step)
-			s.SetBuildID(b.GetID())
-			s.SetRepoID(b.GetRepoID())
-			s.SetNumber(step.Number)
-			s.SetName(step.Name)
-			s.SetImage(step.Image)
+			s := newStep(b, step)
 			s.SetStage(stage.Name)
-			s.SetStatus(constants.StatusPending)
-			s.SetCreated(time.Now().UTC().Unix())
-
-			// send API call to create the step
-			err := database.CreateStep(s)
-			if err != nil {
-				return steps, fmt.Errorf("unable to create step %s: %w", s.GetName(), err)
-			}
-
-			// send API call to capture the created step
-			s, err = database.GetStep(s.GetNumber(), b)
-			if err != nil {
-				return steps, fmt.Errorf("unable to get step %s: %w", s.GetName(), err)
-			}
-
-			// populate environment variables from step library
-			//
-			// https://pkg.go.dev/github.com/go-vela/types/library#step.Environment
-			err = step.MergeEnv(s.Environment())
-			if err != nil {
-				return steps, err
-			}
-
-			// create the log object
-			l := new(library.Log)
-			l.SetStepID(s.GetID())
-			l.SetBuildID(b.GetID())
-			l.SetRepoID(b.GetRepoID())
-			l.SetData([]byte{})
-
-			// send API call to create the step logs
-			err = database.CreateLog(l)
-			if err != nil {
-				return nil, fmt.Errorf("unable to create logs for step %s: %w", s.GetName(), err)
-			}
 
 			steps = append(steps, s)
+			containers = append(containers, step)
 		}
 	}
 
 	// iterate through all pipeline steps
 	for _, step := range p.Steps {
 		// create the step object
-		s := new(library.Step)
-		s.SetBuildID(b.GetID())
-		s.SetRepoID(b.GetRepoID())
-		s.SetNumber(step.Number)
-		s.SetName(step.Name)
-		s.SetImage(step.Image)
-		s.SetStatus(constants.StatusPending)
-		s.SetCreated(time.Now().UTC().Unix())
-
-		// send API call to create the step
-		err := database.CreateStep(s)
-		if err != nil {
-			return steps, fmt.Errorf("unable to create step %s: %w", s.GetName(), err)
-		}
+		steps = append(steps, newStep(b, step))
+		containers = append(containers, step)
+	}
 
-		// send API call to capture the created step
-		s, err = database.GetStep(s.GetNumber(), b)
-		if err != nil {
-			return steps, fmt.Errorf("unable to get step %s: %w", s.GetName(), err)
-		}
+	// send API call to create the steps
+	err := database.CreateSteps(ctx, steps)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create steps: %w", err)
+	}
 
+	// variable to store the logs for the planned steps
+	logs := make([]*library.Log, 0, len(steps))
+
+	for i, s := range steps {
 		// populate environment variables from step library
 		//
 		// https://pkg.go.dev/github.com/go-vela/types/library#step.Environment
-		err = step.MergeEnv(s.Environment())
+		err = containers[i].MergeEnv(s.Environment())
 		if err != nil {
 			return steps, err
 		}
@@ -645,14 +603,29 @@ func planSteps(database database.Service, p *pipeline.Build, b *library.Build) (
 		l.SetRepoID(b.GetRepoID())
 		l.SetData([]byte{})
 
-		// send API call to create the step logs
-		err = database.CreateLog(l)
-		if err != nil {
-			return steps, fmt.Errorf("unable to create logs for step %s: %w", s.GetName(), err)
-		}
+		logs = append(logs, l)
+	}
 
-		steps = append(steps, s)
+	// send API call to create the step logs
+	err = database.CreateLogs(ctx, logs)
+	if err != nil {
+		return steps, fmt.Errorf("unable to create logs for steps: %w", err)
 	}
 
 	return steps, nil
 }
+
+// newStep is a helper function to create
+// the step for the container in the build.
+func newStep(b *library.Build, c *pipeline.Container) *library.Step {
+	s := new(library.Step)
+	s.SetBuildID(b.GetID())
+	s.SetRepoID(b.GetRepoID())
+	s.SetNumber(c.Number)
+	s.SetName(c.Name)
+	s.SetImage(c.Image)
+	s.SetStatus(constants.StatusPending)
+	s.SetCreated(time.Now().UTC().Unix())
+
+	return s
+}
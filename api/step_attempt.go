@@ -0,0 +1,296 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/step"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// attemptStatuses represents the statuses a worker
+// can report for a finished attempt of a step.
+var attemptStatuses = map[string]bool{
+	constants.StatusSuccess:  true,
+	constants.StatusFailure:  true,
+	constants.StatusError:    true,
+	constants.StatusKilled:   true,
+	constants.StatusCanceled: true,
+}
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/attempts steps GetStepAttempts
+//
+// Get the attempts of a step for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the attempts of the step
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/StepAttempt"
+//   '500':
+//     description: Unable to retrieve the attempts of the step
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetStepAttempts represents the API handler to capture
+// the attempts recorded for a step of a build.
+func GetStepAttempts(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"step":  s.GetNumber(),
+		"user":  u.GetName(),
+	}).Infof("reading attempts for step %s", entry)
+
+	// send API call to capture the attempts of the step
+	attempts, err := database.FromContext(c).ListStepAttemptsForStep(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get attempts for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, attempts)
+}
+
+// swagger:operation POST /api/v1/repos/{org}/{repo}/builds/{build}/steps/{step}/attempts steps CreateStepAttempt
+//
+// Record an attempt of a step for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number
+//   required: true
+//   type: integer
+// - in: path
+//   name: step
+//   description: Step number
+//   required: true
+//   type: integer
+// - in: body
+//   name: body
+//   description: Payload containing the attempt of the step
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/StepAttempt"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully recorded the attempt of the step
+//     schema:
+//       "$ref": "#/definitions/StepAttempt"
+//   '400':
+//     description: Unable to record the attempt of the step
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: The attempt of the step was already recorded
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to record the attempt of the step
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateStepAttempt represents the API handler to record an attempt
+// reported by the worker once it finishes for a step run under a
+// retry policy. The status of the step is aggregated from the
+// recorded attempts.
+func CreateStepAttempt(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	s := step.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d/%d", r.GetFullName(), b.GetNumber(), s.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"step":  s.GetNumber(),
+		"user":  u.GetName(),
+	}).Infof("recording attempt for step %s", entry)
+
+	// capture body from API request
+	input := new(types.StepAttempt)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for attempt of step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if input.GetAttempt() < 1 {
+		retErr := fmt.Errorf("unable to record attempt for step %s: attempt must be at least 1", entry)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if !attemptStatuses[input.GetStatus()] {
+		retErr := fmt.Errorf("unable to record attempt for step %s: invalid status %q provided", entry, input.GetStatus())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the attempts recorded for the step
+	attempts, err := database.FromContext(c).ListStepAttemptsForStep(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get attempts for step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	for _, attempt := range attempts {
+		if attempt.GetAttempt() == input.GetAttempt() {
+			retErr := fmt.Errorf("unable to record attempt %d for step %s: attempt already recorded", input.GetAttempt(), entry)
+
+			util.HandleError(c, http.StatusConflict, retErr)
+
+			return
+		}
+	}
+
+	// the step and build are always taken from the path
+	input.SetID(0)
+	input.SetStepID(s.GetID())
+	input.SetBuildID(b.GetID())
+	input.SetRepoID(r.GetID())
+
+	// send API call to create the attempt for the step
+	err = database.FromContext(c).CreateStepAttempt(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to record attempt %d for step %s: %w", input.GetAttempt(), entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// aggregate the status of the step from the attempts
+	aggregateStepAttempts(s, append(attempts, input))
+
+	// send API call to update the step
+	err = database.FromContext(c).UpdateStep(c, s)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update step %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
+
+// aggregateStepAttempts is a helper function to set the status of the
+// step from the latest attempt, while the step starts with the first
+// attempt so the duration of the step covers every attempt.
+func aggregateStepAttempts(s *library.Step, attempts []*types.StepAttempt) {
+	var latest *types.StepAttempt
+
+	for _, attempt := range attempts {
+		if attempt.GetStarted() > 0 && (s.GetStarted() == 0 || attempt.GetStarted() < s.GetStarted()) {
+			s.SetStarted(attempt.GetStarted())
+		}
+
+		if latest == nil || attempt.GetAttempt() > latest.GetAttempt() {
+			latest = attempt
+		}
+	}
+
+	if latest == nil {
+		return
+	}
+
+	s.SetStatus(latest.GetStatus())
+	s.SetError(latest.GetError())
+	s.SetExitCode(int(latest.GetExitCode()))
+	s.SetFinished(latest.GetFinished())
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestAPI_aggregateStepAttempts(t *testing.T) {
+	// setup types
+	first := new(types.StepAttempt)
+	first.SetAttempt(1)
+	first.SetStatus("failure")
+	first.SetError("exit code 1")
+	first.SetExitCode(1)
+	first.SetStarted(10)
+	first.SetFinished(20)
+
+	second := new(types.StepAttempt)
+	second.SetAttempt(2)
+	second.SetStatus("success")
+	second.SetStarted(25)
+	second.SetFinished(30)
+
+	timeout := new(types.StepAttempt)
+	timeout.SetAttempt(2)
+	timeout.SetStatus("error")
+	timeout.SetError("step timed out")
+	timeout.SetStarted(25)
+	timeout.SetFinished(90)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		attempts []*types.StepAttempt
+		status   string
+		err      string
+		exitCode int
+		started  int64
+		finished int64
+	}{
+		{
+			name:     "retried to success",
+			attempts: []*types.StepAttempt{second, first},
+			status:   "success",
+			started:  10,
+			finished: 30,
+		},
+		{
+			name:     "single failure",
+			attempts: []*types.StepAttempt{first},
+			status:   "failure",
+			err:      "exit code 1",
+			exitCode: 1,
+			started:  10,
+			finished: 20,
+		},
+		{
+			name:     "retried to error",
+			attempts: []*types.StepAttempt{first, timeout},
+			status:   "error",
+			err:      "step timed out",
+			started:  10,
+			finished: 90,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := new(library.Step)
+
+			aggregateStepAttempts(s, test.attempts)
+
+			if s.GetStatus() != test.status {
+				t.Errorf("aggregateStepAttempts status is %v, want %v", s.GetStatus(), test.status)
+			}
+
+			if s.GetError() != test.err {
+				t.Errorf("aggregateStepAttempts error is %v, want %v", s.GetError(), test.err)
+			}
+
+			if s.GetExitCode() != test.exitCode {
+				t.Errorf("aggregateStepAttempts exit code is %v, want %v", s.GetExitCode(), test.exitCode)
+			}
+
+			if s.GetStarted() != test.started {
+				t.Errorf("aggregateStepAttempts started is %v, want %v", s.GetStarted(), test.started)
+			}
+
+			if s.GetFinished() != test.finished {
+				t.Errorf("aggregateStepAttempts finished is %v, want %v", s.GetFinished(), test.finished)
+			}
+		})
+	}
+}
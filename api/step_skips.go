@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/build"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/repos/{org}/{repo}/builds/{build}/skips builds ListStepSkips
+//
+// Get the stages and steps skipped for a build in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo
+//   required: true
+//   type: string
+// - in: path
+//   name: build
+//   description: Build number to retrieve the skipped stages and steps for
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the skipped stages and steps for the build
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/StepSkip"
+//   '500':
+//     description: Unable to retrieve the skipped stages and steps for the build
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListStepSkips represents the API handler to capture the stages and
+// steps skipped when a build was compiled, along with the reason each
+// was skipped.
+func ListStepSkips(c *gin.Context) {
+	// capture middleware values
+	b := build.Retrieve(c)
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), b.GetNumber())
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   o,
+		"repo":  r.GetName(),
+		"user":  u.GetName(),
+	}).Infof("reading skipped steps for build %s", entry)
+
+	// send API call to capture the skipped stages and steps for the build
+	s, err := database.FromContext(c).ListStepSkipsForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list skipped steps for build %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, s)
+}
+
+// saveStepSkips is a helper function to persist the stages and
+// steps skipped by the provided compiler for the build.
+func saveStepSkips(ctx context.Context, db database.Service, e compiler.Engine, b *library.Build) {
+	if e == nil {
+		return
+	}
+
+	created := time.Now().UTC().Unix()
+
+	for _, s := range e.Skipped() {
+		s.SetBuildID(b.GetID())
+		s.SetRepoID(b.GetRepoID())
+		s.SetCreated(created)
+
+		// send API call to create the skipped stage or step for the build
+		err := db.CreateStepSkip(ctx, s)
+		if err != nil {
+			logrus.Errorf("unable to create skipped step %s for build %d: %v", s.GetStep(), b.GetID(), err)
+		}
+	}
+}
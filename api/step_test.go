@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+)
+
+func Test_planSteps(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+
+	_pipeline := &pipeline.Build{
+		Stages: pipeline.StageSlice{
+			{
+				Name: "init",
+				Steps: pipeline.ContainerSlice{
+					{Name: "init", Image: "#init", Number: 1, Environment: map[string]string{}},
+				},
+			},
+		},
+		Steps: pipeline.ContainerSlice{
+			{Name: "clone", Image: "target/vela-git:v0.4.0", Number: 2, Environment: map[string]string{}},
+			{Name: "test", Image: "golang:latest", Number: 3, Environment: map[string]string{}},
+		},
+	}
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	// run test
+	got, err := planSteps(context.TODO(), db, _pipeline, _build)
+	if err != nil {
+		t.Errorf("planSteps returned err: %v", err)
+	}
+
+	if len(got) != 3 {
+		t.Errorf("planSteps returned %d steps, want %d", len(got), 3)
+	}
+
+	for i, s := range got {
+		if s.GetID() != int64(i+1) {
+			t.Errorf("planSteps step %s ID is %d, want %d", s.GetName(), s.GetID(), i+1)
+		}
+
+		_, err = db.GetLogForStep(context.TODO(), s)
+		if err != nil {
+			t.Errorf("unable to get log for step %s: %v", s.GetName(), err)
+		}
+	}
+
+	if got[0].GetStage() != "init" {
+		t.Errorf("planSteps step stage is %s, want %s", got[0].GetStage(), "init")
+	}
+
+	if _pipeline.Steps[1].Environment["VELA_STEP_NAME"] != "test" {
+		t.Errorf("planSteps environment is %v", _pipeline.Steps[1].Environment)
+	}
+}
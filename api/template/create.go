@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/templates/{org}/{repo}/deprecations templates CreateTemplateDeprecation
+//
+// Mark a version of a template hosted in a repo as deprecated
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org hosting the templates
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo hosting the templates
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the path and ref of the template to deprecate
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/TemplateDeprecation"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully deprecated the template
+//     schema:
+//       "$ref": "#/definitions/TemplateDeprecation"
+//   '400':
+//     description: Unable to deprecate the template
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to deprecate the template
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateTemplateDeprecation represents the API handler to mark a
+// version of a template as deprecated in the configured backend.
+//
+// Omitting the ref deprecates every version of the template.
+func CreateTemplateDeprecation(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logger := logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	})
+
+	// capture body from API request
+	input := new(api.TemplateDeprecation)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new template deprecation for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if len(input.GetPath()) == 0 {
+		retErr := fmt.Errorf("unable to create template deprecation for repo %s: no path provided", r.GetFullName())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	logger.Infof("deprecating template %s/%s@%s", r.GetFullName(), input.GetPath(), input.GetRef())
+
+	// update fields in template deprecation object
+	input.SetID(0)
+	input.SetOrg(r.GetOrg())
+	input.SetRepo(r.GetName())
+	input.SetCreatedBy(u.GetName())
+	input.SetCreatedAt(time.Now().UTC().Unix())
+
+	// send API call to create the template deprecation
+	err = database.FromContext(c).CreateTemplateDeprecation(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create template deprecation for %s/%s: %w", r.GetFullName(), input.GetPath(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created template deprecation
+	deprecations, err := database.FromContext(c).ListTemplateDeprecationsForRepo(c, r.GetOrg(), r.GetName())
+	if err != nil {
+		retErr := fmt.Errorf("unable to capture template deprecation for %s/%s: %w", r.GetFullName(), input.GetPath(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	for _, d := range deprecations {
+		if d.GetPath() == input.GetPath() && d.GetRef() == input.GetRef() {
+			c.JSON(http.StatusCreated, d)
+
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, input)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/templates/{org}/{repo}/deprecations/{deprecation} templates DeleteTemplateDeprecation
+//
+// Remove the deprecation for a version of a template hosted in a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org hosting the templates
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo hosting the templates
+//   required: true
+//   type: string
+// - in: path
+//   name: deprecation
+//   description: ID of the template deprecation
+//   required: true
+//   type: integer
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully removed the template deprecation
+//     schema:
+//       type: string
+//   '400':
+//     description: Unable to remove the template deprecation
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to remove the template deprecation
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to remove the template deprecation
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteTemplateDeprecation represents the API handler to remove the
+// deprecation for a version of a template from the configured backend.
+func DeleteTemplateDeprecation(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// capture the deprecation path parameter
+	id, err := strconv.ParseInt(c.Param("deprecation"), 10, 64)
+	if err != nil {
+		retErr := fmt.Errorf("invalid deprecation parameter provided: %s", c.Param("deprecation"))
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	entry := fmt.Sprintf("%s/%d", r.GetFullName(), id)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("deleting template deprecation %s", entry)
+
+	// send API call to capture the template deprecation
+	d, err := database.FromContext(c).GetTemplateDeprecation(c, id)
+
+	// ensure the deprecation belongs to the repo from the path
+	if err != nil || d.GetOrg() != r.GetOrg() || d.GetRepo() != r.GetName() {
+		retErr := fmt.Errorf("unable to get template deprecation %s", entry)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the template deprecation
+	err = database.FromContext(c).DeleteTemplateDeprecation(c, d)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete template deprecation %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("template deprecation %s deleted", entry))
+}
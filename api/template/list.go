@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/templates/{org}/{repo}/deprecations templates ListTemplateDeprecations
+//
+// Get the deprecated versions of templates hosted in a repo
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org hosting the templates
+//   required: true
+//   type: string
+// - in: path
+//   name: repo
+//   description: Name of the repo hosting the templates
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the template deprecations
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/TemplateDeprecation"
+//   '500':
+//     description: Unable to retrieve the template deprecations
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListTemplateDeprecations represents the API handler to capture the
+// deprecated versions of templates for a repo from the configured backend.
+func ListTemplateDeprecations(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	r := repo.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("listing template deprecations for repo %s", r.GetFullName())
+
+	// send API call to capture the list of template deprecations for the repo
+	d, err := database.FromContext(c).ListTemplateDeprecationsForRepo(c, r.GetOrg(), r.GetName())
+	if err != nil {
+		retErr := fmt.Errorf("unable to list template deprecations for repo %s: %w", r.GetFullName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, d)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/internal/token"
 	"github.com/go-vela/server/router/middleware/auth"
 	"github.com/go-vela/server/router/middleware/claims"
@@ -65,6 +66,14 @@ func RefreshAccessToken(c *gin.Context) {
 		return
 	}
 
+	// record the user refreshing their access token
+	if cl, err := tm.ParseToken(rt); err == nil {
+		u := new(library.User)
+		u.SetName(cl.Subject)
+
+		recordSession(c, u, api.UserSessionRefresh)
+	}
+
 	c.JSON(http.StatusOK, library.Token{Token: &newAccessToken})
 }
 
@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// ActorUsage is the API representation of the build capacity
+// consumed by an actor that triggered builds.
+//
+// swagger:model ActorUsage
+type ActorUsage struct {
+	Actor   *string  `json:"actor,omitempty"`
+	Builds  *int64   `json:"builds,omitempty"`
+	Steps   *int64   `json:"steps,omitempty"`
+	Minutes *float64 `json:"minutes,omitempty"`
+}
+
+// GetActor returns the Actor field.
+//
+// When the provided ActorUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *ActorUsage) GetActor() string {
+	// return zero value if ActorUsage type or Actor field is nil
+	if a == nil || a.Actor == nil {
+		return ""
+	}
+
+	return *a.Actor
+}
+
+// GetBuilds returns the Builds field.
+//
+// When the provided ActorUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *ActorUsage) GetBuilds() int64 {
+	// return zero value if ActorUsage type or Builds field is nil
+	if a == nil || a.Builds == nil {
+		return 0
+	}
+
+	return *a.Builds
+}
+
+// GetSteps returns the Steps field.
+//
+// When the provided ActorUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *ActorUsage) GetSteps() int64 {
+	// return zero value if ActorUsage type or Steps field is nil
+	if a == nil || a.Steps == nil {
+		return 0
+	}
+
+	return *a.Steps
+}
+
+// GetMinutes returns the Minutes field.
+//
+// When the provided ActorUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *ActorUsage) GetMinutes() float64 {
+	// return zero value if ActorUsage type or Minutes field is nil
+	if a == nil || a.Minutes == nil {
+		return 0
+	}
+
+	return *a.Minutes
+}
+
+// SetActor sets the Actor field.
+//
+// When the provided ActorUsage type is nil, it
+// will set nothing and immediately return.
+func (a *ActorUsage) SetActor(v string) {
+	// return if ActorUsage type is nil
+	if a == nil {
+		return
+	}
+
+	a.Actor = &v
+}
+
+// SetBuilds sets the Builds field.
+//
+// When the provided ActorUsage type is nil, it
+// will set nothing and immediately return.
+func (a *ActorUsage) SetBuilds(v int64) {
+	// return if ActorUsage type is nil
+	if a == nil {
+		return
+	}
+
+	a.Builds = &v
+}
+
+// SetSteps sets the Steps field.
+//
+// When the provided ActorUsage type is nil, it
+// will set nothing and immediately return.
+func (a *ActorUsage) SetSteps(v int64) {
+	// return if ActorUsage type is nil
+	if a == nil {
+		return
+	}
+
+	a.Steps = &v
+}
+
+// SetMinutes sets the Minutes field.
+//
+// When the provided ActorUsage type is nil, it
+// will set nothing and immediately return.
+func (a *ActorUsage) SetMinutes(v float64) {
+	// return if ActorUsage type is nil
+	if a == nil {
+		return
+	}
+
+	a.Minutes = &v
+}
+
+// String implements the Stringer interface for the ActorUsage type.
+func (a *ActorUsage) String() string {
+	return fmt.Sprintf(`{
+  Actor: %s,
+  Builds: %d,
+  Steps: %d,
+  Minutes: %v,
+}`,
+		a.GetActor(),
+		a.GetBuilds(),
+		a.GetSteps(),
+		a.GetMinutes(),
+	)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestActorUsage_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		a    *ActorUsage
+		want *ActorUsage
+	}{
+		{
+			a:    testActorUsage(),
+			want: testActorUsage(),
+		},
+		{
+			a:    new(ActorUsage),
+			want: new(ActorUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.a.GetActor() != test.want.GetActor() {
+			t.Errorf("GetActor is %v, want %v", test.a.GetActor(), test.want.GetActor())
+		}
+
+		if test.a.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("GetBuilds is %v, want %v", test.a.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.a.GetSteps() != test.want.GetSteps() {
+			t.Errorf("GetSteps is %v, want %v", test.a.GetSteps(), test.want.GetSteps())
+		}
+
+		if test.a.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("GetMinutes is %v, want %v", test.a.GetMinutes(), test.want.GetMinutes())
+		}
+	}
+}
+
+func TestActorUsage_Setters(t *testing.T) {
+	// setup types
+	var a *ActorUsage
+
+	// setup tests
+	tests := []struct {
+		a    *ActorUsage
+		want *ActorUsage
+	}{
+		{
+			a:    testActorUsage(),
+			want: testActorUsage(),
+		},
+		{
+			a:    a,
+			want: new(ActorUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.a.SetActor(test.want.GetActor())
+		test.a.SetBuilds(test.want.GetBuilds())
+		test.a.SetSteps(test.want.GetSteps())
+		test.a.SetMinutes(test.want.GetMinutes())
+
+		if test.a.GetActor() != test.want.GetActor() {
+			t.Errorf("SetActor is %v, want %v", test.a.GetActor(), test.want.GetActor())
+		}
+
+		if test.a.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("SetBuilds is %v, want %v", test.a.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.a.GetSteps() != test.want.GetSteps() {
+			t.Errorf("SetSteps is %v, want %v", test.a.GetSteps(), test.want.GetSteps())
+		}
+
+		if test.a.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("SetMinutes is %v, want %v", test.a.GetMinutes(), test.want.GetMinutes())
+		}
+	}
+}
+
+func TestActorUsage_String(t *testing.T) {
+	// setup types
+	a := testActorUsage()
+
+	want := fmt.Sprintf(`{
+  Actor: %s,
+  Builds: %d,
+  Steps: %d,
+  Minutes: %v,
+}`,
+		a.GetActor(),
+		a.GetBuilds(),
+		a.GetSteps(),
+		a.GetMinutes(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testActorUsage is a test helper function to create a ActorUsage
+// type with all fields set to a fake value.
+func testActorUsage() *ActorUsage {
+	a := new(ActorUsage)
+
+	a.SetActor("octocat")
+	a.SetBuilds(3)
+	a.SetSteps(12)
+	a.SetMinutes(7.5)
+
+	return a
+}
@@ -0,0 +1,386 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// AnnouncementKindInfo represents an announcement
+	// sharing general information with the users.
+	AnnouncementKindInfo = "info"
+
+	// AnnouncementKindMaintenance represents an announcement
+	// for a maintenance window of the platform.
+	AnnouncementKindMaintenance = "maintenance"
+
+	// AnnouncementKindDeprecation represents an announcement
+	// for functionality that is deprecated on the platform.
+	AnnouncementKindDeprecation = "deprecation"
+)
+
+// Announcement is the API representation of a banner published by
+// an admin for the users of the platform. The announcement targets
+// every user, or only the members of the orgs, when orgs are provided.
+//
+// swagger:model Announcement
+type Announcement struct {
+	ID        *int64    `json:"id,omitempty"`
+	Kind      *string   `json:"kind,omitempty"`
+	Title     *string   `json:"title,omitempty"`
+	Message   *string   `json:"message,omitempty"`
+	Orgs      *[]string `json:"orgs,omitempty"`
+	Starts    *int64    `json:"starts,omitempty"`
+	Ends      *int64    `json:"ends,omitempty"`
+	Active    *bool     `json:"active,omitempty"`
+	CreatedAt *int64    `json:"created_at,omitempty"`
+	CreatedBy *string   `json:"created_by,omitempty"`
+	UpdatedAt *int64    `json:"updated_at,omitempty"`
+	UpdatedBy *string   `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetID() int64 {
+	// return zero value if Announcement type or ID field is nil
+	if a == nil || a.ID == nil {
+		return 0
+	}
+
+	return *a.ID
+}
+
+// GetKind returns the Kind field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetKind() string {
+	// return zero value if Announcement type or Kind field is nil
+	if a == nil || a.Kind == nil {
+		return ""
+	}
+
+	return *a.Kind
+}
+
+// GetTitle returns the Title field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetTitle() string {
+	// return zero value if Announcement type or Title field is nil
+	if a == nil || a.Title == nil {
+		return ""
+	}
+
+	return *a.Title
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetMessage() string {
+	// return zero value if Announcement type or Message field is nil
+	if a == nil || a.Message == nil {
+		return ""
+	}
+
+	return *a.Message
+}
+
+// GetOrgs returns the Orgs field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetOrgs() []string {
+	// return zero value if Announcement type or Orgs field is nil
+	if a == nil || a.Orgs == nil {
+		return []string{}
+	}
+
+	return *a.Orgs
+}
+
+// GetStarts returns the Starts field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetStarts() int64 {
+	// return zero value if Announcement type or Starts field is nil
+	if a == nil || a.Starts == nil {
+		return 0
+	}
+
+	return *a.Starts
+}
+
+// GetEnds returns the Ends field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetEnds() int64 {
+	// return zero value if Announcement type or Ends field is nil
+	if a == nil || a.Ends == nil {
+		return 0
+	}
+
+	return *a.Ends
+}
+
+// GetActive returns the Active field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetActive() bool {
+	// return zero value if Announcement type or Active field is nil
+	if a == nil || a.Active == nil {
+		return false
+	}
+
+	return *a.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetCreatedAt() int64 {
+	// return zero value if Announcement type or CreatedAt field is nil
+	if a == nil || a.CreatedAt == nil {
+		return 0
+	}
+
+	return *a.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetCreatedBy() string {
+	// return zero value if Announcement type or CreatedBy field is nil
+	if a == nil || a.CreatedBy == nil {
+		return ""
+	}
+
+	return *a.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetUpdatedAt() int64 {
+	// return zero value if Announcement type or UpdatedAt field is nil
+	if a == nil || a.UpdatedAt == nil {
+		return 0
+	}
+
+	return *a.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided Announcement type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Announcement) GetUpdatedBy() string {
+	// return zero value if Announcement type or UpdatedBy field is nil
+	if a == nil || a.UpdatedBy == nil {
+		return ""
+	}
+
+	return *a.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetID(v int64) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.ID = &v
+}
+
+// SetKind sets the Kind field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetKind(v string) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.Kind = &v
+}
+
+// SetTitle sets the Title field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetTitle(v string) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.Title = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetMessage(v string) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.Message = &v
+}
+
+// SetOrgs sets the Orgs field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetOrgs(v []string) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.Orgs = &v
+}
+
+// SetStarts sets the Starts field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetStarts(v int64) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.Starts = &v
+}
+
+// SetEnds sets the Ends field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetEnds(v int64) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.Ends = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetActive(v bool) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetCreatedAt(v int64) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetCreatedBy(v string) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetUpdatedAt(v int64) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided Announcement type is nil, it
+// will set nothing and immediately return.
+func (a *Announcement) SetUpdatedBy(v string) {
+	// return if Announcement type is nil
+	if a == nil {
+		return
+	}
+
+	a.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the Announcement type.
+func (a *Announcement) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Kind: %s,
+  Title: %s,
+  Message: %s,
+  Orgs: %s,
+  Starts: %d,
+  Ends: %d,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		a.GetID(),
+		a.GetKind(),
+		a.GetTitle(),
+		a.GetMessage(),
+		a.GetOrgs(),
+		a.GetStarts(),
+		a.GetEnds(),
+		a.GetActive(),
+		a.GetCreatedAt(),
+		a.GetCreatedBy(),
+		a.GetUpdatedAt(),
+		a.GetUpdatedBy(),
+	)
+}
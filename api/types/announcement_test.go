@@ -0,0 +1,224 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAnnouncement_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		announcement *Announcement
+		want         *Announcement
+	}{
+		{
+			announcement: testAnnouncement(),
+			want:         testAnnouncement(),
+		},
+		{
+			announcement: new(Announcement),
+			want:         new(Announcement),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.announcement.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.announcement.GetID(), test.want.GetID())
+		}
+
+		if test.announcement.GetKind() != test.want.GetKind() {
+			t.Errorf("GetKind is %v, want %v", test.announcement.GetKind(), test.want.GetKind())
+		}
+
+		if test.announcement.GetTitle() != test.want.GetTitle() {
+			t.Errorf("GetTitle is %v, want %v", test.announcement.GetTitle(), test.want.GetTitle())
+		}
+
+		if test.announcement.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.announcement.GetMessage(), test.want.GetMessage())
+		}
+
+		if !reflect.DeepEqual(test.announcement.GetOrgs(), test.want.GetOrgs()) {
+			t.Errorf("GetOrgs is %v, want %v", test.announcement.GetOrgs(), test.want.GetOrgs())
+		}
+
+		if test.announcement.GetStarts() != test.want.GetStarts() {
+			t.Errorf("GetStarts is %v, want %v", test.announcement.GetStarts(), test.want.GetStarts())
+		}
+
+		if test.announcement.GetEnds() != test.want.GetEnds() {
+			t.Errorf("GetEnds is %v, want %v", test.announcement.GetEnds(), test.want.GetEnds())
+		}
+
+		if test.announcement.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.announcement.GetActive(), test.want.GetActive())
+		}
+
+		if test.announcement.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.announcement.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.announcement.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.announcement.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.announcement.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.announcement.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.announcement.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.announcement.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestAnnouncement_Setters(t *testing.T) {
+	// setup types
+	var a *Announcement
+
+	// setup tests
+	tests := []struct {
+		announcement *Announcement
+		want         *Announcement
+	}{
+		{
+			announcement: testAnnouncement(),
+			want:         testAnnouncement(),
+		},
+		{
+			announcement: a,
+			want:         new(Announcement),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.announcement.SetID(test.want.GetID())
+		test.announcement.SetKind(test.want.GetKind())
+		test.announcement.SetTitle(test.want.GetTitle())
+		test.announcement.SetMessage(test.want.GetMessage())
+		test.announcement.SetOrgs(test.want.GetOrgs())
+		test.announcement.SetStarts(test.want.GetStarts())
+		test.announcement.SetEnds(test.want.GetEnds())
+		test.announcement.SetActive(test.want.GetActive())
+		test.announcement.SetCreatedAt(test.want.GetCreatedAt())
+		test.announcement.SetCreatedBy(test.want.GetCreatedBy())
+		test.announcement.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.announcement.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.announcement.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.announcement.GetID(), test.want.GetID())
+		}
+
+		if test.announcement.GetKind() != test.want.GetKind() {
+			t.Errorf("SetKind is %v, want %v", test.announcement.GetKind(), test.want.GetKind())
+		}
+
+		if test.announcement.GetTitle() != test.want.GetTitle() {
+			t.Errorf("SetTitle is %v, want %v", test.announcement.GetTitle(), test.want.GetTitle())
+		}
+
+		if test.announcement.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.announcement.GetMessage(), test.want.GetMessage())
+		}
+
+		if !reflect.DeepEqual(test.announcement.GetOrgs(), test.want.GetOrgs()) {
+			t.Errorf("SetOrgs is %v, want %v", test.announcement.GetOrgs(), test.want.GetOrgs())
+		}
+
+		if test.announcement.GetStarts() != test.want.GetStarts() {
+			t.Errorf("SetStarts is %v, want %v", test.announcement.GetStarts(), test.want.GetStarts())
+		}
+
+		if test.announcement.GetEnds() != test.want.GetEnds() {
+			t.Errorf("SetEnds is %v, want %v", test.announcement.GetEnds(), test.want.GetEnds())
+		}
+
+		if test.announcement.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.announcement.GetActive(), test.want.GetActive())
+		}
+
+		if test.announcement.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.announcement.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.announcement.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.announcement.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.announcement.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.announcement.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.announcement.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.announcement.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestAnnouncement_String(t *testing.T) {
+	// setup types
+	a := testAnnouncement()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Kind: %s,
+  Title: %s,
+  Message: %s,
+  Orgs: %s,
+  Starts: %d,
+  Ends: %d,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		a.GetID(),
+		a.GetKind(),
+		a.GetTitle(),
+		a.GetMessage(),
+		a.GetOrgs(),
+		a.GetStarts(),
+		a.GetEnds(),
+		a.GetActive(),
+		a.GetCreatedAt(),
+		a.GetCreatedBy(),
+		a.GetUpdatedAt(),
+		a.GetUpdatedBy(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testAnnouncement is a test helper function to create an Announcement
+// type with all fields set to a fake value.
+func testAnnouncement() *Announcement {
+	a := new(Announcement)
+
+	a.SetID(1)
+	a.SetKind("maintenance")
+	a.SetTitle("database upgrade")
+	a.SetMessage("builds will be paused during the upgrade")
+	a.SetOrgs([]string{"github"})
+	a.SetStarts(1563474076)
+	a.SetEnds(1563477676)
+	a.SetActive(true)
+	a.SetCreatedAt(1563474076)
+	a.SetCreatedBy("octocat")
+	a.SetUpdatedAt(1563474077)
+	a.SetUpdatedBy("octocat")
+
+	return a
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// AnomalyDuration represents an anomaly where the recent
+	// build durations deviate from the baseline durations.
+	AnomalyDuration = "duration"
+
+	// AnomalyFailureRate represents an anomaly where the recent
+	// build failure rate deviates from the baseline failure rate.
+	AnomalyFailureRate = "failure_rate"
+)
+
+// Anomaly is the API representation of a repo whose recent
+// builds deviate sharply from their baseline.
+//
+// swagger:model Anomaly
+type Anomaly struct {
+	RepoID   *int64   `json:"repo_id,omitempty"`
+	Repo     *string  `json:"repo,omitempty"`
+	Kind     *string  `json:"kind,omitempty"`
+	Baseline *float64 `json:"baseline,omitempty"`
+	Recent   *float64 `json:"recent,omitempty"`
+	Score    *float64 `json:"score,omitempty"`
+	Samples  *int64   `json:"samples,omitempty"`
+	Detected *int64   `json:"detected,omitempty"`
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetRepoID() int64 {
+	// return zero value if Anomaly type or RepoID field is nil
+	if a == nil || a.RepoID == nil {
+		return 0
+	}
+
+	return *a.RepoID
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetRepo() string {
+	// return zero value if Anomaly type or Repo field is nil
+	if a == nil || a.Repo == nil {
+		return ""
+	}
+
+	return *a.Repo
+}
+
+// GetKind returns the Kind field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetKind() string {
+	// return zero value if Anomaly type or Kind field is nil
+	if a == nil || a.Kind == nil {
+		return ""
+	}
+
+	return *a.Kind
+}
+
+// GetBaseline returns the Baseline field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetBaseline() float64 {
+	// return zero value if Anomaly type or Baseline field is nil
+	if a == nil || a.Baseline == nil {
+		return 0
+	}
+
+	return *a.Baseline
+}
+
+// GetRecent returns the Recent field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetRecent() float64 {
+	// return zero value if Anomaly type or Recent field is nil
+	if a == nil || a.Recent == nil {
+		return 0
+	}
+
+	return *a.Recent
+}
+
+// GetScore returns the Score field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetScore() float64 {
+	// return zero value if Anomaly type or Score field is nil
+	if a == nil || a.Score == nil {
+		return 0
+	}
+
+	return *a.Score
+}
+
+// GetSamples returns the Samples field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetSamples() int64 {
+	// return zero value if Anomaly type or Samples field is nil
+	if a == nil || a.Samples == nil {
+		return 0
+	}
+
+	return *a.Samples
+}
+
+// GetDetected returns the Detected field.
+//
+// When the provided Anomaly type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Anomaly) GetDetected() int64 {
+	// return zero value if Anomaly type or Detected field is nil
+	if a == nil || a.Detected == nil {
+		return 0
+	}
+
+	return *a.Detected
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetRepoID(v int64) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.RepoID = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetRepo(v string) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.Repo = &v
+}
+
+// SetKind sets the Kind field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetKind(v string) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.Kind = &v
+}
+
+// SetBaseline sets the Baseline field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetBaseline(v float64) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.Baseline = &v
+}
+
+// SetRecent sets the Recent field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetRecent(v float64) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.Recent = &v
+}
+
+// SetScore sets the Score field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetScore(v float64) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.Score = &v
+}
+
+// SetSamples sets the Samples field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetSamples(v int64) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.Samples = &v
+}
+
+// SetDetected sets the Detected field.
+//
+// When the provided Anomaly type is nil, it
+// will set nothing and immediately return.
+func (a *Anomaly) SetDetected(v int64) {
+	// return if Anomaly type is nil
+	if a == nil {
+		return
+	}
+
+	a.Detected = &v
+}
+
+// String implements the Stringer interface for the Anomaly type.
+func (a *Anomaly) String() string {
+	return fmt.Sprintf(`{
+  RepoID: %d,
+  Repo: %s,
+  Kind: %s,
+  Baseline: %v,
+  Recent: %v,
+  Score: %v,
+  Samples: %d,
+  Detected: %d,
+}`,
+		a.GetRepoID(),
+		a.GetRepo(),
+		a.GetKind(),
+		a.GetBaseline(),
+		a.GetRecent(),
+		a.GetScore(),
+		a.GetSamples(),
+		a.GetDetected(),
+	)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAnomaly_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		a    *Anomaly
+		want *Anomaly
+	}{
+		{
+			a:    testAnomaly(),
+			want: testAnomaly(),
+		},
+		{
+			a:    new(Anomaly),
+			want: new(Anomaly),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.a.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.a.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.a.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.a.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.a.GetKind() != test.want.GetKind() {
+			t.Errorf("GetKind is %v, want %v", test.a.GetKind(), test.want.GetKind())
+		}
+
+		if test.a.GetBaseline() != test.want.GetBaseline() {
+			t.Errorf("GetBaseline is %v, want %v", test.a.GetBaseline(), test.want.GetBaseline())
+		}
+
+		if test.a.GetRecent() != test.want.GetRecent() {
+			t.Errorf("GetRecent is %v, want %v", test.a.GetRecent(), test.want.GetRecent())
+		}
+
+		if test.a.GetScore() != test.want.GetScore() {
+			t.Errorf("GetScore is %v, want %v", test.a.GetScore(), test.want.GetScore())
+		}
+
+		if test.a.GetSamples() != test.want.GetSamples() {
+			t.Errorf("GetSamples is %v, want %v", test.a.GetSamples(), test.want.GetSamples())
+		}
+
+		if test.a.GetDetected() != test.want.GetDetected() {
+			t.Errorf("GetDetected is %v, want %v", test.a.GetDetected(), test.want.GetDetected())
+		}
+	}
+}
+
+func TestAnomaly_Setters(t *testing.T) {
+	// setup types
+	var a *Anomaly
+
+	// setup tests
+	tests := []struct {
+		a    *Anomaly
+		want *Anomaly
+	}{
+		{
+			a:    testAnomaly(),
+			want: testAnomaly(),
+		},
+		{
+			a:    a,
+			want: new(Anomaly),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.a.SetRepoID(test.want.GetRepoID())
+		test.a.SetRepo(test.want.GetRepo())
+		test.a.SetKind(test.want.GetKind())
+		test.a.SetBaseline(test.want.GetBaseline())
+		test.a.SetRecent(test.want.GetRecent())
+		test.a.SetScore(test.want.GetScore())
+		test.a.SetSamples(test.want.GetSamples())
+		test.a.SetDetected(test.want.GetDetected())
+
+		if test.a.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.a.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.a.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.a.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.a.GetKind() != test.want.GetKind() {
+			t.Errorf("SetKind is %v, want %v", test.a.GetKind(), test.want.GetKind())
+		}
+
+		if test.a.GetBaseline() != test.want.GetBaseline() {
+			t.Errorf("SetBaseline is %v, want %v", test.a.GetBaseline(), test.want.GetBaseline())
+		}
+
+		if test.a.GetRecent() != test.want.GetRecent() {
+			t.Errorf("SetRecent is %v, want %v", test.a.GetRecent(), test.want.GetRecent())
+		}
+
+		if test.a.GetScore() != test.want.GetScore() {
+			t.Errorf("SetScore is %v, want %v", test.a.GetScore(), test.want.GetScore())
+		}
+
+		if test.a.GetSamples() != test.want.GetSamples() {
+			t.Errorf("SetSamples is %v, want %v", test.a.GetSamples(), test.want.GetSamples())
+		}
+
+		if test.a.GetDetected() != test.want.GetDetected() {
+			t.Errorf("SetDetected is %v, want %v", test.a.GetDetected(), test.want.GetDetected())
+		}
+	}
+}
+
+func TestAnomaly_String(t *testing.T) {
+	// setup types
+	a := testAnomaly()
+
+	want := fmt.Sprintf(`{
+  RepoID: %d,
+  Repo: %s,
+  Kind: %s,
+  Baseline: %v,
+  Recent: %v,
+  Score: %v,
+  Samples: %d,
+  Detected: %d,
+}`,
+		a.GetRepoID(),
+		a.GetRepo(),
+		a.GetKind(),
+		a.GetBaseline(),
+		a.GetRecent(),
+		a.GetScore(),
+		a.GetSamples(),
+		a.GetDetected(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testAnomaly is a test helper function to create a Anomaly
+// type with all fields set to a fake value.
+func testAnomaly() *Anomaly {
+	a := new(Anomaly)
+
+	a.SetRepoID(1)
+	a.SetRepo("github/octocat")
+	a.SetKind("duration")
+	a.SetBaseline(60)
+	a.SetRecent(300)
+	a.SetScore(4.5)
+	a.SetSamples(5)
+	a.SetDetected(1563474077)
+
+	return a
+}
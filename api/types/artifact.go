@@ -0,0 +1,284 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// Artifact is the API representation of an artifact produced
+// by a build, identified by the digest of its contents.
+//
+// swagger:model Artifact
+type Artifact struct {
+	ID        *int64  `json:"id,omitempty"`
+	RepoID    *int64  `json:"repo_id,omitempty"`
+	BuildID   *int64  `json:"build_id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Digest    *string `json:"digest,omitempty"`
+	URI       *string `json:"uri,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	Created   *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetID() int64 {
+	// return zero value if Artifact type or ID field is nil
+	if a == nil || a.ID == nil {
+		return 0
+	}
+
+	return *a.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetRepoID() int64 {
+	// return zero value if Artifact type or RepoID field is nil
+	if a == nil || a.RepoID == nil {
+		return 0
+	}
+
+	return *a.RepoID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetBuildID() int64 {
+	// return zero value if Artifact type or BuildID field is nil
+	if a == nil || a.BuildID == nil {
+		return 0
+	}
+
+	return *a.BuildID
+}
+
+// GetName returns the Name field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetName() string {
+	// return zero value if Artifact type or Name field is nil
+	if a == nil || a.Name == nil {
+		return ""
+	}
+
+	return *a.Name
+}
+
+// GetKind returns the Kind field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetKind() string {
+	// return zero value if Artifact type or Kind field is nil
+	if a == nil || a.Kind == nil {
+		return ""
+	}
+
+	return *a.Kind
+}
+
+// GetDigest returns the Digest field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetDigest() string {
+	// return zero value if Artifact type or Digest field is nil
+	if a == nil || a.Digest == nil {
+		return ""
+	}
+
+	return *a.Digest
+}
+
+// GetURI returns the URI field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetURI() string {
+	// return zero value if Artifact type or URI field is nil
+	if a == nil || a.URI == nil {
+		return ""
+	}
+
+	return *a.URI
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetCreatedBy() string {
+	// return zero value if Artifact type or CreatedBy field is nil
+	if a == nil || a.CreatedBy == nil {
+		return ""
+	}
+
+	return *a.CreatedBy
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided Artifact type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *Artifact) GetCreated() int64 {
+	// return zero value if Artifact type or Created field is nil
+	if a == nil || a.Created == nil {
+		return 0
+	}
+
+	return *a.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetID(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetRepoID(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.RepoID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetBuildID(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.BuildID = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetName(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Name = &v
+}
+
+// SetKind sets the Kind field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetKind(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Kind = &v
+}
+
+// SetDigest sets the Digest field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetDigest(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Digest = &v
+}
+
+// SetURI sets the URI field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetURI(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.URI = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetCreatedBy(v string) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.CreatedBy = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided Artifact type is nil, it
+// will set nothing and immediately return.
+func (a *Artifact) SetCreated(v int64) {
+	// return if Artifact type is nil
+	if a == nil {
+		return
+	}
+
+	a.Created = &v
+}
+
+// String implements the Stringer interface for the Artifact type.
+func (a *Artifact) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  Name: %s,
+  Kind: %s,
+  Digest: %s,
+  URI: %s,
+  CreatedBy: %s,
+  Created: %d,
+}`,
+		a.GetID(),
+		a.GetRepoID(),
+		a.GetBuildID(),
+		a.GetName(),
+		a.GetKind(),
+		a.GetDigest(),
+		a.GetURI(),
+		a.GetCreatedBy(),
+		a.GetCreated(),
+	)
+}
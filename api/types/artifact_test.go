@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestArtifact_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		artifact *Artifact
+		want     *Artifact
+	}{
+		{
+			artifact: testArtifact(),
+			want:     testArtifact(),
+		},
+		{
+			artifact: new(Artifact),
+			want:     new(Artifact),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.artifact.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.artifact.GetID(), test.want.GetID())
+		}
+
+		if test.artifact.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.artifact.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.artifact.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.artifact.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.artifact.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.artifact.GetName(), test.want.GetName())
+		}
+
+		if test.artifact.GetKind() != test.want.GetKind() {
+			t.Errorf("GetKind is %v, want %v", test.artifact.GetKind(), test.want.GetKind())
+		}
+
+		if test.artifact.GetDigest() != test.want.GetDigest() {
+			t.Errorf("GetDigest is %v, want %v", test.artifact.GetDigest(), test.want.GetDigest())
+		}
+
+		if test.artifact.GetURI() != test.want.GetURI() {
+			t.Errorf("GetURI is %v, want %v", test.artifact.GetURI(), test.want.GetURI())
+		}
+
+		if test.artifact.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.artifact.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.artifact.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.artifact.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestArtifact_Setters(t *testing.T) {
+	// setup types
+	var a *Artifact
+
+	// setup tests
+	tests := []struct {
+		artifact *Artifact
+		want     *Artifact
+	}{
+		{
+			artifact: testArtifact(),
+			want:     testArtifact(),
+		},
+		{
+			artifact: a,
+			want:     new(Artifact),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.artifact.SetID(test.want.GetID())
+		test.artifact.SetRepoID(test.want.GetRepoID())
+		test.artifact.SetBuildID(test.want.GetBuildID())
+		test.artifact.SetName(test.want.GetName())
+		test.artifact.SetKind(test.want.GetKind())
+		test.artifact.SetDigest(test.want.GetDigest())
+		test.artifact.SetURI(test.want.GetURI())
+		test.artifact.SetCreatedBy(test.want.GetCreatedBy())
+		test.artifact.SetCreated(test.want.GetCreated())
+
+		if test.artifact.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.artifact.GetID(), test.want.GetID())
+		}
+
+		if test.artifact.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.artifact.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.artifact.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.artifact.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.artifact.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.artifact.GetName(), test.want.GetName())
+		}
+
+		if test.artifact.GetKind() != test.want.GetKind() {
+			t.Errorf("SetKind is %v, want %v", test.artifact.GetKind(), test.want.GetKind())
+		}
+
+		if test.artifact.GetDigest() != test.want.GetDigest() {
+			t.Errorf("SetDigest is %v, want %v", test.artifact.GetDigest(), test.want.GetDigest())
+		}
+
+		if test.artifact.GetURI() != test.want.GetURI() {
+			t.Errorf("SetURI is %v, want %v", test.artifact.GetURI(), test.want.GetURI())
+		}
+
+		if test.artifact.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.artifact.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.artifact.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.artifact.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestArtifact_String(t *testing.T) {
+	// setup types
+	a := testArtifact()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  Name: %s,
+  Kind: %s,
+  Digest: %s,
+  URI: %s,
+  CreatedBy: %s,
+  Created: %d,
+}`,
+		a.GetID(),
+		a.GetRepoID(),
+		a.GetBuildID(),
+		a.GetName(),
+		a.GetKind(),
+		a.GetDigest(),
+		a.GetURI(),
+		a.GetCreatedBy(),
+		a.GetCreated(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testArtifact is a test helper function to create a Artifact
+// type with all fields set to a fake value.
+func testArtifact() *Artifact {
+	a := new(Artifact)
+
+	a.SetID(1)
+	a.SetRepoID(1)
+	a.SetBuildID(1)
+	a.SetName("vela-server")
+	a.SetKind("binary")
+	a.SetDigest("sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1")
+	a.SetURI("https://artifacts.example.com/vela-server")
+	a.SetCreatedBy("octocat")
+	a.SetCreated(1563474076)
+
+	return a
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// BudgetUsage is the API representation of the build minutes
+// used by an org within the current period of its monthly budget.
+//
+// swagger:model BudgetUsage
+type BudgetUsage struct {
+	Org       *string  `json:"org,omitempty"`
+	Period    *string  `json:"period,omitempty"`
+	Budget    *int64   `json:"budget,omitempty"`
+	Used      *float64 `json:"used,omitempty"`
+	Remaining *float64 `json:"remaining,omitempty"`
+	Percent   *float64 `json:"percent,omitempty"`
+	Alerted   *int64   `json:"alerted,omitempty"`
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided BudgetUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BudgetUsage) GetOrg() string {
+	// return zero value if BudgetUsage type or Org field is nil
+	if b == nil || b.Org == nil {
+		return ""
+	}
+
+	return *b.Org
+}
+
+// GetPeriod returns the Period field.
+//
+// When the provided BudgetUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BudgetUsage) GetPeriod() string {
+	// return zero value if BudgetUsage type or Period field is nil
+	if b == nil || b.Period == nil {
+		return ""
+	}
+
+	return *b.Period
+}
+
+// GetBudget returns the Budget field.
+//
+// When the provided BudgetUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BudgetUsage) GetBudget() int64 {
+	// return zero value if BudgetUsage type or Budget field is nil
+	if b == nil || b.Budget == nil {
+		return 0
+	}
+
+	return *b.Budget
+}
+
+// GetUsed returns the Used field.
+//
+// When the provided BudgetUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BudgetUsage) GetUsed() float64 {
+	// return zero value if BudgetUsage type or Used field is nil
+	if b == nil || b.Used == nil {
+		return 0
+	}
+
+	return *b.Used
+}
+
+// GetRemaining returns the Remaining field.
+//
+// When the provided BudgetUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BudgetUsage) GetRemaining() float64 {
+	// return zero value if BudgetUsage type or Remaining field is nil
+	if b == nil || b.Remaining == nil {
+		return 0
+	}
+
+	return *b.Remaining
+}
+
+// GetPercent returns the Percent field.
+//
+// When the provided BudgetUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BudgetUsage) GetPercent() float64 {
+	// return zero value if BudgetUsage type or Percent field is nil
+	if b == nil || b.Percent == nil {
+		return 0
+	}
+
+	return *b.Percent
+}
+
+// GetAlerted returns the Alerted field.
+//
+// When the provided BudgetUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BudgetUsage) GetAlerted() int64 {
+	// return zero value if BudgetUsage type or Alerted field is nil
+	if b == nil || b.Alerted == nil {
+		return 0
+	}
+
+	return *b.Alerted
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided BudgetUsage type is nil, it
+// will set nothing and immediately return.
+func (b *BudgetUsage) SetOrg(v string) {
+	// return if BudgetUsage type is nil
+	if b == nil {
+		return
+	}
+
+	b.Org = &v
+}
+
+// SetPeriod sets the Period field.
+//
+// When the provided BudgetUsage type is nil, it
+// will set nothing and immediately return.
+func (b *BudgetUsage) SetPeriod(v string) {
+	// return if BudgetUsage type is nil
+	if b == nil {
+		return
+	}
+
+	b.Period = &v
+}
+
+// SetBudget sets the Budget field.
+//
+// When the provided BudgetUsage type is nil, it
+// will set nothing and immediately return.
+func (b *BudgetUsage) SetBudget(v int64) {
+	// return if BudgetUsage type is nil
+	if b == nil {
+		return
+	}
+
+	b.Budget = &v
+}
+
+// SetUsed sets the Used field.
+//
+// When the provided BudgetUsage type is nil, it
+// will set nothing and immediately return.
+func (b *BudgetUsage) SetUsed(v float64) {
+	// return if BudgetUsage type is nil
+	if b == nil {
+		return
+	}
+
+	b.Used = &v
+}
+
+// SetRemaining sets the Remaining field.
+//
+// When the provided BudgetUsage type is nil, it
+// will set nothing and immediately return.
+func (b *BudgetUsage) SetRemaining(v float64) {
+	// return if BudgetUsage type is nil
+	if b == nil {
+		return
+	}
+
+	b.Remaining = &v
+}
+
+// SetPercent sets the Percent field.
+//
+// When the provided BudgetUsage type is nil, it
+// will set nothing and immediately return.
+func (b *BudgetUsage) SetPercent(v float64) {
+	// return if BudgetUsage type is nil
+	if b == nil {
+		return
+	}
+
+	b.Percent = &v
+}
+
+// SetAlerted sets the Alerted field.
+//
+// When the provided BudgetUsage type is nil, it
+// will set nothing and immediately return.
+func (b *BudgetUsage) SetAlerted(v int64) {
+	// return if BudgetUsage type is nil
+	if b == nil {
+		return
+	}
+
+	b.Alerted = &v
+}
+
+// String implements the Stringer interface for the BudgetUsage type.
+func (b *BudgetUsage) String() string {
+	return fmt.Sprintf(`{
+  Org: %s,
+  Period: %s,
+  Budget: %d,
+  Used: %v,
+  Remaining: %v,
+  Percent: %v,
+  Alerted: %d,
+}`,
+		b.GetOrg(),
+		b.GetPeriod(),
+		b.GetBudget(),
+		b.GetUsed(),
+		b.GetRemaining(),
+		b.GetPercent(),
+		b.GetAlerted(),
+	)
+}
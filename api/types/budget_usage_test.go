@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBudgetUsage_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		budgetUsage *BudgetUsage
+		want        *BudgetUsage
+	}{
+		{
+			budgetUsage: testBudgetUsage(),
+			want:        testBudgetUsage(),
+		},
+		{
+			budgetUsage: new(BudgetUsage),
+			want:        new(BudgetUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.budgetUsage.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.budgetUsage.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.budgetUsage.GetPeriod() != test.want.GetPeriod() {
+			t.Errorf("GetPeriod is %v, want %v", test.budgetUsage.GetPeriod(), test.want.GetPeriod())
+		}
+
+		if test.budgetUsage.GetBudget() != test.want.GetBudget() {
+			t.Errorf("GetBudget is %v, want %v", test.budgetUsage.GetBudget(), test.want.GetBudget())
+		}
+
+		if test.budgetUsage.GetUsed() != test.want.GetUsed() {
+			t.Errorf("GetUsed is %v, want %v", test.budgetUsage.GetUsed(), test.want.GetUsed())
+		}
+
+		if test.budgetUsage.GetRemaining() != test.want.GetRemaining() {
+			t.Errorf("GetRemaining is %v, want %v", test.budgetUsage.GetRemaining(), test.want.GetRemaining())
+		}
+
+		if test.budgetUsage.GetPercent() != test.want.GetPercent() {
+			t.Errorf("GetPercent is %v, want %v", test.budgetUsage.GetPercent(), test.want.GetPercent())
+		}
+
+		if test.budgetUsage.GetAlerted() != test.want.GetAlerted() {
+			t.Errorf("GetAlerted is %v, want %v", test.budgetUsage.GetAlerted(), test.want.GetAlerted())
+		}
+	}
+}
+
+func TestBudgetUsage_Setters(t *testing.T) {
+	// setup types
+	var b *BudgetUsage
+
+	// setup tests
+	tests := []struct {
+		budgetUsage *BudgetUsage
+		want        *BudgetUsage
+	}{
+		{
+			budgetUsage: testBudgetUsage(),
+			want:        testBudgetUsage(),
+		},
+		{
+			budgetUsage: b,
+			want:        new(BudgetUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.budgetUsage.SetOrg(test.want.GetOrg())
+		test.budgetUsage.SetPeriod(test.want.GetPeriod())
+		test.budgetUsage.SetBudget(test.want.GetBudget())
+		test.budgetUsage.SetUsed(test.want.GetUsed())
+		test.budgetUsage.SetRemaining(test.want.GetRemaining())
+		test.budgetUsage.SetPercent(test.want.GetPercent())
+		test.budgetUsage.SetAlerted(test.want.GetAlerted())
+
+		if test.budgetUsage.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.budgetUsage.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.budgetUsage.GetPeriod() != test.want.GetPeriod() {
+			t.Errorf("SetPeriod is %v, want %v", test.budgetUsage.GetPeriod(), test.want.GetPeriod())
+		}
+
+		if test.budgetUsage.GetBudget() != test.want.GetBudget() {
+			t.Errorf("SetBudget is %v, want %v", test.budgetUsage.GetBudget(), test.want.GetBudget())
+		}
+
+		if test.budgetUsage.GetUsed() != test.want.GetUsed() {
+			t.Errorf("SetUsed is %v, want %v", test.budgetUsage.GetUsed(), test.want.GetUsed())
+		}
+
+		if test.budgetUsage.GetRemaining() != test.want.GetRemaining() {
+			t.Errorf("SetRemaining is %v, want %v", test.budgetUsage.GetRemaining(), test.want.GetRemaining())
+		}
+
+		if test.budgetUsage.GetPercent() != test.want.GetPercent() {
+			t.Errorf("SetPercent is %v, want %v", test.budgetUsage.GetPercent(), test.want.GetPercent())
+		}
+
+		if test.budgetUsage.GetAlerted() != test.want.GetAlerted() {
+			t.Errorf("SetAlerted is %v, want %v", test.budgetUsage.GetAlerted(), test.want.GetAlerted())
+		}
+	}
+}
+
+func TestBudgetUsage_String(t *testing.T) {
+	// setup types
+	b := testBudgetUsage()
+
+	want := fmt.Sprintf(`{
+  Org: %s,
+  Period: %s,
+  Budget: %d,
+  Used: %v,
+  Remaining: %v,
+  Percent: %v,
+  Alerted: %d,
+}`,
+		b.GetOrg(),
+		b.GetPeriod(),
+		b.GetBudget(),
+		b.GetUsed(),
+		b.GetRemaining(),
+		b.GetPercent(),
+		b.GetAlerted(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBudgetUsage is a test helper function to create a BudgetUsage
+// type with all fields set to a fake value.
+func testBudgetUsage() *BudgetUsage {
+	b := new(BudgetUsage)
+
+	b.SetOrg("github")
+	b.SetPeriod("2023-10")
+	b.SetBudget(1000)
+	b.SetUsed(812.5)
+	b.SetRemaining(187.5)
+	b.SetPercent(81.25)
+	b.SetAlerted(80)
+
+	return b
+}
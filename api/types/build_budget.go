@@ -0,0 +1,314 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// BuildBudget is the API representation of the monthly budget
+// of build minutes for an org. The org is alerted as the builds
+// for the org use each threshold percentage of the budget.
+//
+// swagger:model BuildBudget
+type BuildBudget struct {
+	ID        *int64  `json:"id,omitempty"`
+	Org       *string `json:"org,omitempty"`
+	Minutes   *int64  `json:"minutes,omitempty"`
+	Webhook   *string `json:"webhook,omitempty"`
+	Period    *string `json:"period,omitempty"`
+	Alerted   *int64  `json:"alerted,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	UpdatedAt *int64  `json:"updated_at,omitempty"`
+	UpdatedBy *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetID() int64 {
+	// return zero value if BuildBudget type or ID field is nil
+	if b == nil || b.ID == nil {
+		return 0
+	}
+
+	return *b.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetOrg() string {
+	// return zero value if BuildBudget type or Org field is nil
+	if b == nil || b.Org == nil {
+		return ""
+	}
+
+	return *b.Org
+}
+
+// GetMinutes returns the Minutes field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetMinutes() int64 {
+	// return zero value if BuildBudget type or Minutes field is nil
+	if b == nil || b.Minutes == nil {
+		return 0
+	}
+
+	return *b.Minutes
+}
+
+// GetWebhook returns the Webhook field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetWebhook() string {
+	// return zero value if BuildBudget type or Webhook field is nil
+	if b == nil || b.Webhook == nil {
+		return ""
+	}
+
+	return *b.Webhook
+}
+
+// GetPeriod returns the Period field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetPeriod() string {
+	// return zero value if BuildBudget type or Period field is nil
+	if b == nil || b.Period == nil {
+		return ""
+	}
+
+	return *b.Period
+}
+
+// GetAlerted returns the Alerted field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetAlerted() int64 {
+	// return zero value if BuildBudget type or Alerted field is nil
+	if b == nil || b.Alerted == nil {
+		return 0
+	}
+
+	return *b.Alerted
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetCreatedAt() int64 {
+	// return zero value if BuildBudget type or CreatedAt field is nil
+	if b == nil || b.CreatedAt == nil {
+		return 0
+	}
+
+	return *b.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetCreatedBy() string {
+	// return zero value if BuildBudget type or CreatedBy field is nil
+	if b == nil || b.CreatedBy == nil {
+		return ""
+	}
+
+	return *b.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetUpdatedAt() int64 {
+	// return zero value if BuildBudget type or UpdatedAt field is nil
+	if b == nil || b.UpdatedAt == nil {
+		return 0
+	}
+
+	return *b.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided BuildBudget type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildBudget) GetUpdatedBy() string {
+	// return zero value if BuildBudget type or UpdatedBy field is nil
+	if b == nil || b.UpdatedBy == nil {
+		return ""
+	}
+
+	return *b.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetID(v int64) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetOrg(v string) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.Org = &v
+}
+
+// SetMinutes sets the Minutes field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetMinutes(v int64) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.Minutes = &v
+}
+
+// SetWebhook sets the Webhook field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetWebhook(v string) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.Webhook = &v
+}
+
+// SetPeriod sets the Period field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetPeriod(v string) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.Period = &v
+}
+
+// SetAlerted sets the Alerted field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetAlerted(v int64) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.Alerted = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetCreatedAt(v int64) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetCreatedBy(v string) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetUpdatedAt(v int64) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided BuildBudget type is nil, it
+// will set nothing and immediately return.
+func (b *BuildBudget) SetUpdatedBy(v string) {
+	// return if BuildBudget type is nil
+	if b == nil {
+		return
+	}
+
+	b.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the BuildBudget type.
+func (b *BuildBudget) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Minutes: %d,
+  Webhook: %s,
+  Period: %s,
+  Alerted: %d,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		b.GetID(),
+		b.GetOrg(),
+		b.GetMinutes(),
+		b.GetWebhook(),
+		b.GetPeriod(),
+		b.GetAlerted(),
+		b.GetCreatedAt(),
+		b.GetCreatedBy(),
+		b.GetUpdatedAt(),
+		b.GetUpdatedBy(),
+	)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildBudget_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		buildBudget *BuildBudget
+		want        *BuildBudget
+	}{
+		{
+			buildBudget: testBuildBudget(),
+			want:        testBuildBudget(),
+		},
+		{
+			buildBudget: new(BuildBudget),
+			want:        new(BuildBudget),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.buildBudget.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.buildBudget.GetID(), test.want.GetID())
+		}
+
+		if test.buildBudget.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.buildBudget.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.buildBudget.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("GetMinutes is %v, want %v", test.buildBudget.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if test.buildBudget.GetWebhook() != test.want.GetWebhook() {
+			t.Errorf("GetWebhook is %v, want %v", test.buildBudget.GetWebhook(), test.want.GetWebhook())
+		}
+
+		if test.buildBudget.GetPeriod() != test.want.GetPeriod() {
+			t.Errorf("GetPeriod is %v, want %v", test.buildBudget.GetPeriod(), test.want.GetPeriod())
+		}
+
+		if test.buildBudget.GetAlerted() != test.want.GetAlerted() {
+			t.Errorf("GetAlerted is %v, want %v", test.buildBudget.GetAlerted(), test.want.GetAlerted())
+		}
+
+		if test.buildBudget.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.buildBudget.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.buildBudget.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.buildBudget.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.buildBudget.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.buildBudget.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.buildBudget.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.buildBudget.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestBuildBudget_Setters(t *testing.T) {
+	// setup types
+	var b *BuildBudget
+
+	// setup tests
+	tests := []struct {
+		buildBudget *BuildBudget
+		want        *BuildBudget
+	}{
+		{
+			buildBudget: testBuildBudget(),
+			want:        testBuildBudget(),
+		},
+		{
+			buildBudget: b,
+			want:        new(BuildBudget),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.buildBudget.SetID(test.want.GetID())
+		test.buildBudget.SetOrg(test.want.GetOrg())
+		test.buildBudget.SetMinutes(test.want.GetMinutes())
+		test.buildBudget.SetWebhook(test.want.GetWebhook())
+		test.buildBudget.SetPeriod(test.want.GetPeriod())
+		test.buildBudget.SetAlerted(test.want.GetAlerted())
+		test.buildBudget.SetCreatedAt(test.want.GetCreatedAt())
+		test.buildBudget.SetCreatedBy(test.want.GetCreatedBy())
+		test.buildBudget.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.buildBudget.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.buildBudget.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.buildBudget.GetID(), test.want.GetID())
+		}
+
+		if test.buildBudget.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.buildBudget.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.buildBudget.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("SetMinutes is %v, want %v", test.buildBudget.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if test.buildBudget.GetWebhook() != test.want.GetWebhook() {
+			t.Errorf("SetWebhook is %v, want %v", test.buildBudget.GetWebhook(), test.want.GetWebhook())
+		}
+
+		if test.buildBudget.GetPeriod() != test.want.GetPeriod() {
+			t.Errorf("SetPeriod is %v, want %v", test.buildBudget.GetPeriod(), test.want.GetPeriod())
+		}
+
+		if test.buildBudget.GetAlerted() != test.want.GetAlerted() {
+			t.Errorf("SetAlerted is %v, want %v", test.buildBudget.GetAlerted(), test.want.GetAlerted())
+		}
+
+		if test.buildBudget.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.buildBudget.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.buildBudget.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.buildBudget.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.buildBudget.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.buildBudget.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.buildBudget.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.buildBudget.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestBuildBudget_String(t *testing.T) {
+	// setup types
+	b := testBuildBudget()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Minutes: %d,
+  Webhook: %s,
+  Period: %s,
+  Alerted: %d,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		b.GetID(),
+		b.GetOrg(),
+		b.GetMinutes(),
+		b.GetWebhook(),
+		b.GetPeriod(),
+		b.GetAlerted(),
+		b.GetCreatedAt(),
+		b.GetCreatedBy(),
+		b.GetUpdatedAt(),
+		b.GetUpdatedBy(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildBudget is a test helper function to create a BuildBudget
+// type with all fields set to a fake value.
+func testBuildBudget() *BuildBudget {
+	b := new(BuildBudget)
+
+	b.SetID(1)
+	b.SetOrg("github")
+	b.SetMinutes(1000)
+	b.SetWebhook("https://alerts.example.com/vela")
+	b.SetPeriod("2023-10")
+	b.SetAlerted(50)
+	b.SetCreatedAt(1563474076)
+	b.SetCreatedBy("octocat")
+	b.SetUpdatedAt(1563474077)
+	b.SetUpdatedBy("octocat")
+
+	return b
+}
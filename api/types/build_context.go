@@ -0,0 +1,245 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BuildContext is the API representation of the metadata computed from
+// the source control provider when a build is created.
+//
+// swagger:model BuildContext
+type BuildContext struct {
+	Files      *[]string `json:"files,omitempty"`
+	Labels     *[]string `json:"labels,omitempty"`
+	Reviewers  *[]string `json:"reviewers,omitempty"`
+	Approvals  *int      `json:"approvals,omitempty"`
+	Issues     *[]string `json:"issues,omitempty"`
+	Submodules *[]string `json:"submodules,omitempty"`
+	LFS        *bool     `json:"lfs,omitempty"`
+}
+
+// Environment returns a list of environment variables
+// provided from the fields of the BuildContext type.
+func (b *BuildContext) Environment() map[string]string {
+	// return an empty map if BuildContext type is nil
+	if b == nil {
+		return map[string]string{}
+	}
+
+	return map[string]string{
+		"VELA_BUILD_CHANGED_FILES":    strings.Join(b.GetFiles(), ","),
+		"VELA_BUILD_ISSUES":           strings.Join(b.GetIssues(), ","),
+		"VELA_PULL_REQUEST_APPROVALS": strconv.Itoa(b.GetApprovals()),
+		"VELA_PULL_REQUEST_LABELS":    strings.Join(b.GetLabels(), ","),
+		"VELA_PULL_REQUEST_REVIEWERS": strings.Join(b.GetReviewers(), ","),
+	}
+}
+
+// GetFiles returns the Files field.
+//
+// When the provided BuildContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildContext) GetFiles() []string {
+	// return zero value if BuildContext type or Files field is nil
+	if b == nil || b.Files == nil {
+		return []string{}
+	}
+
+	return *b.Files
+}
+
+// GetLabels returns the Labels field.
+//
+// When the provided BuildContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildContext) GetLabels() []string {
+	// return zero value if BuildContext type or Labels field is nil
+	if b == nil || b.Labels == nil {
+		return []string{}
+	}
+
+	return *b.Labels
+}
+
+// GetReviewers returns the Reviewers field.
+//
+// When the provided BuildContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildContext) GetReviewers() []string {
+	// return zero value if BuildContext type or Reviewers field is nil
+	if b == nil || b.Reviewers == nil {
+		return []string{}
+	}
+
+	return *b.Reviewers
+}
+
+// GetApprovals returns the Approvals field.
+//
+// When the provided BuildContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildContext) GetApprovals() int {
+	// return zero value if BuildContext type or Approvals field is nil
+	if b == nil || b.Approvals == nil {
+		return 0
+	}
+
+	return *b.Approvals
+}
+
+// GetIssues returns the Issues field.
+//
+// When the provided BuildContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildContext) GetIssues() []string {
+	// return zero value if BuildContext type or Issues field is nil
+	if b == nil || b.Issues == nil {
+		return []string{}
+	}
+
+	return *b.Issues
+}
+
+// GetSubmodules returns the Submodules field.
+//
+// When the provided BuildContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildContext) GetSubmodules() []string {
+	// return zero value if BuildContext type or Submodules field is nil
+	if b == nil || b.Submodules == nil {
+		return []string{}
+	}
+
+	return *b.Submodules
+}
+
+// GetLFS returns the LFS field.
+//
+// When the provided BuildContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildContext) GetLFS() bool {
+	// return zero value if BuildContext type or LFS field is nil
+	if b == nil || b.LFS == nil {
+		return false
+	}
+
+	return *b.LFS
+}
+
+// SetFiles sets the Files field.
+//
+// When the provided BuildContext type is nil, it
+// will set nothing and immediately return.
+func (b *BuildContext) SetFiles(v []string) {
+	// return if BuildContext type is nil
+	if b == nil {
+		return
+	}
+
+	b.Files = &v
+}
+
+// SetLabels sets the Labels field.
+//
+// When the provided BuildContext type is nil, it
+// will set nothing and immediately return.
+func (b *BuildContext) SetLabels(v []string) {
+	// return if BuildContext type is nil
+	if b == nil {
+		return
+	}
+
+	b.Labels = &v
+}
+
+// SetReviewers sets the Reviewers field.
+//
+// When the provided BuildContext type is nil, it
+// will set nothing and immediately return.
+func (b *BuildContext) SetReviewers(v []string) {
+	// return if BuildContext type is nil
+	if b == nil {
+		return
+	}
+
+	b.Reviewers = &v
+}
+
+// SetApprovals sets the Approvals field.
+//
+// When the provided BuildContext type is nil, it
+// will set nothing and immediately return.
+func (b *BuildContext) SetApprovals(v int) {
+	// return if BuildContext type is nil
+	if b == nil {
+		return
+	}
+
+	b.Approvals = &v
+}
+
+// SetIssues sets the Issues field.
+//
+// When the provided BuildContext type is nil, it
+// will set nothing and immediately return.
+func (b *BuildContext) SetIssues(v []string) {
+	// return if BuildContext type is nil
+	if b == nil {
+		return
+	}
+
+	b.Issues = &v
+}
+
+// SetSubmodules sets the Submodules field.
+//
+// When the provided BuildContext type is nil, it
+// will set nothing and immediately return.
+func (b *BuildContext) SetSubmodules(v []string) {
+	// return if BuildContext type is nil
+	if b == nil {
+		return
+	}
+
+	b.Submodules = &v
+}
+
+// SetLFS sets the LFS field.
+//
+// When the provided BuildContext type is nil, it
+// will set nothing and immediately return.
+func (b *BuildContext) SetLFS(v bool) {
+	// return if BuildContext type is nil
+	if b == nil {
+		return
+	}
+
+	b.LFS = &v
+}
+
+// String implements the Stringer interface for the BuildContext type.
+func (b *BuildContext) String() string {
+	return fmt.Sprintf(`{
+  Files: %s,
+  Labels: %s,
+  Reviewers: %s,
+  Approvals: %d,
+  Issues: %s,
+  Submodules: %s,
+  LFS: %t,
+}`,
+		b.GetFiles(),
+		b.GetLabels(),
+		b.GetReviewers(),
+		b.GetApprovals(),
+		b.GetIssues(),
+		b.GetSubmodules(),
+		b.GetLFS(),
+	)
+}
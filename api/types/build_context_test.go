@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildContext_Environment(t *testing.T) {
+	// setup types
+	want := map[string]string{
+		"VELA_BUILD_CHANGED_FILES":    "README.md,src/main.go",
+		"VELA_BUILD_ISSUES":           "github/octocat#1",
+		"VELA_PULL_REQUEST_APPROVALS": "1",
+		"VELA_PULL_REQUEST_LABELS":    "deploy-preview",
+		"VELA_PULL_REQUEST_REVIEWERS": "octocat",
+	}
+
+	// run test
+	got := testBuildContext().Environment()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Environment is %v, want %v", got, want)
+	}
+
+	var b *BuildContext
+
+	if len(b.Environment()) != 0 {
+		t.Errorf("Environment is %v, want empty map", b.Environment())
+	}
+}
+
+func TestBuildContext_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		bc   *BuildContext
+		want *BuildContext
+	}{
+		{
+			bc:   testBuildContext(),
+			want: testBuildContext(),
+		},
+		{
+			bc:   new(BuildContext),
+			want: new(BuildContext),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.bc.GetFiles(), test.want.GetFiles()) {
+			t.Errorf("GetFiles is %v, want %v", test.bc.GetFiles(), test.want.GetFiles())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("GetLabels is %v, want %v", test.bc.GetLabels(), test.want.GetLabels())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetReviewers(), test.want.GetReviewers()) {
+			t.Errorf("GetReviewers is %v, want %v", test.bc.GetReviewers(), test.want.GetReviewers())
+		}
+
+		if test.bc.GetApprovals() != test.want.GetApprovals() {
+			t.Errorf("GetApprovals is %v, want %v", test.bc.GetApprovals(), test.want.GetApprovals())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetIssues(), test.want.GetIssues()) {
+			t.Errorf("GetIssues is %v, want %v", test.bc.GetIssues(), test.want.GetIssues())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetSubmodules(), test.want.GetSubmodules()) {
+			t.Errorf("GetSubmodules is %v, want %v", test.bc.GetSubmodules(), test.want.GetSubmodules())
+		}
+
+		if test.bc.GetLFS() != test.want.GetLFS() {
+			t.Errorf("GetLFS is %v, want %v", test.bc.GetLFS(), test.want.GetLFS())
+		}
+	}
+}
+
+func TestBuildContext_Setters(t *testing.T) {
+	// setup types
+	var b *BuildContext
+
+	// setup tests
+	tests := []struct {
+		bc   *BuildContext
+		want *BuildContext
+	}{
+		{
+			bc:   testBuildContext(),
+			want: testBuildContext(),
+		},
+		{
+			bc:   b,
+			want: new(BuildContext),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.bc.SetFiles(test.want.GetFiles())
+		test.bc.SetLabels(test.want.GetLabels())
+		test.bc.SetReviewers(test.want.GetReviewers())
+		test.bc.SetApprovals(test.want.GetApprovals())
+		test.bc.SetIssues(test.want.GetIssues())
+		test.bc.SetSubmodules(test.want.GetSubmodules())
+		test.bc.SetLFS(test.want.GetLFS())
+
+		if !reflect.DeepEqual(test.bc.GetFiles(), test.want.GetFiles()) {
+			t.Errorf("SetFiles is %v, want %v", test.bc.GetFiles(), test.want.GetFiles())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("SetLabels is %v, want %v", test.bc.GetLabels(), test.want.GetLabels())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetReviewers(), test.want.GetReviewers()) {
+			t.Errorf("SetReviewers is %v, want %v", test.bc.GetReviewers(), test.want.GetReviewers())
+		}
+
+		if test.bc.GetApprovals() != test.want.GetApprovals() {
+			t.Errorf("SetApprovals is %v, want %v", test.bc.GetApprovals(), test.want.GetApprovals())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetIssues(), test.want.GetIssues()) {
+			t.Errorf("SetIssues is %v, want %v", test.bc.GetIssues(), test.want.GetIssues())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetSubmodules(), test.want.GetSubmodules()) {
+			t.Errorf("SetSubmodules is %v, want %v", test.bc.GetSubmodules(), test.want.GetSubmodules())
+		}
+
+		if test.bc.GetLFS() != test.want.GetLFS() {
+			t.Errorf("SetLFS is %v, want %v", test.bc.GetLFS(), test.want.GetLFS())
+		}
+	}
+}
+
+func TestBuildContext_String(t *testing.T) {
+	// setup types
+	b := testBuildContext()
+
+	want := fmt.Sprintf(`{
+  Files: %s,
+  Labels: %s,
+  Reviewers: %s,
+  Approvals: %d,
+  Issues: %s,
+  Submodules: %s,
+  LFS: %t,
+}`,
+		b.GetFiles(),
+		b.GetLabels(),
+		b.GetReviewers(),
+		b.GetApprovals(),
+		b.GetIssues(),
+		b.GetSubmodules(),
+		b.GetLFS(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildContext is a test helper function to create a BuildContext
+// type with all fields set to a fake value.
+func testBuildContext() *BuildContext {
+	b := new(BuildContext)
+
+	b.SetFiles([]string{"README.md", "src/main.go"})
+	b.SetLabels([]string{"deploy-preview"})
+	b.SetReviewers([]string{"octocat"})
+	b.SetApprovals(1)
+	b.SetIssues([]string{"github/octocat#1"})
+	b.SetSubmodules([]string{"https://github.com/github/octokit.git"})
+	b.SetLFS(true)
+
+	return b
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// BuildCost is the API representation of the estimated cost
+// of a build based on the class of the worker it ran on.
+//
+// swagger:model BuildCost
+type BuildCost struct {
+	BuildID *int64   `json:"build_id,omitempty"`
+	Host    *string  `json:"host,omitempty"`
+	Class   *string  `json:"class,omitempty"`
+	Minutes *float64 `json:"minutes,omitempty"`
+	Rate    *float64 `json:"rate,omitempty"`
+	Cost    *float64 `json:"cost,omitempty"`
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided BuildCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCost) GetBuildID() int64 {
+	// return zero value if BuildCost type or BuildID field is nil
+	if b == nil || b.BuildID == nil {
+		return 0
+	}
+
+	return *b.BuildID
+}
+
+// GetHost returns the Host field.
+//
+// When the provided BuildCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCost) GetHost() string {
+	// return zero value if BuildCost type or Host field is nil
+	if b == nil || b.Host == nil {
+		return ""
+	}
+
+	return *b.Host
+}
+
+// GetClass returns the Class field.
+//
+// When the provided BuildCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCost) GetClass() string {
+	// return zero value if BuildCost type or Class field is nil
+	if b == nil || b.Class == nil {
+		return ""
+	}
+
+	return *b.Class
+}
+
+// GetMinutes returns the Minutes field.
+//
+// When the provided BuildCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCost) GetMinutes() float64 {
+	// return zero value if BuildCost type or Minutes field is nil
+	if b == nil || b.Minutes == nil {
+		return 0
+	}
+
+	return *b.Minutes
+}
+
+// GetRate returns the Rate field.
+//
+// When the provided BuildCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCost) GetRate() float64 {
+	// return zero value if BuildCost type or Rate field is nil
+	if b == nil || b.Rate == nil {
+		return 0
+	}
+
+	return *b.Rate
+}
+
+// GetCost returns the Cost field.
+//
+// When the provided BuildCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCost) GetCost() float64 {
+	// return zero value if BuildCost type or Cost field is nil
+	if b == nil || b.Cost == nil {
+		return 0
+	}
+
+	return *b.Cost
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided BuildCost type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCost) SetBuildID(v int64) {
+	// return if BuildCost type is nil
+	if b == nil {
+		return
+	}
+
+	b.BuildID = &v
+}
+
+// SetHost sets the Host field.
+//
+// When the provided BuildCost type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCost) SetHost(v string) {
+	// return if BuildCost type is nil
+	if b == nil {
+		return
+	}
+
+	b.Host = &v
+}
+
+// SetClass sets the Class field.
+//
+// When the provided BuildCost type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCost) SetClass(v string) {
+	// return if BuildCost type is nil
+	if b == nil {
+		return
+	}
+
+	b.Class = &v
+}
+
+// SetMinutes sets the Minutes field.
+//
+// When the provided BuildCost type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCost) SetMinutes(v float64) {
+	// return if BuildCost type is nil
+	if b == nil {
+		return
+	}
+
+	b.Minutes = &v
+}
+
+// SetRate sets the Rate field.
+//
+// When the provided BuildCost type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCost) SetRate(v float64) {
+	// return if BuildCost type is nil
+	if b == nil {
+		return
+	}
+
+	b.Rate = &v
+}
+
+// SetCost sets the Cost field.
+//
+// When the provided BuildCost type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCost) SetCost(v float64) {
+	// return if BuildCost type is nil
+	if b == nil {
+		return
+	}
+
+	b.Cost = &v
+}
+
+// String implements the Stringer interface for the BuildCost type.
+func (b *BuildCost) String() string {
+	return fmt.Sprintf(`{
+  BuildID: %d,
+  Host: %s,
+  Class: %s,
+  Minutes: %v,
+  Rate: %v,
+  Cost: %v,
+}`,
+		b.GetBuildID(),
+		b.GetHost(),
+		b.GetClass(),
+		b.GetMinutes(),
+		b.GetRate(),
+		b.GetCost(),
+	)
+}
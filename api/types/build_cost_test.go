@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildCost_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		cost *BuildCost
+		want *BuildCost
+	}{
+		{
+			cost: testBuildCost(),
+			want: testBuildCost(),
+		},
+		{
+			cost: new(BuildCost),
+			want: new(BuildCost),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.cost.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.cost.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.cost.GetHost() != test.want.GetHost() {
+			t.Errorf("GetHost is %v, want %v", test.cost.GetHost(), test.want.GetHost())
+		}
+
+		if test.cost.GetClass() != test.want.GetClass() {
+			t.Errorf("GetClass is %v, want %v", test.cost.GetClass(), test.want.GetClass())
+		}
+
+		if test.cost.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("GetMinutes is %v, want %v", test.cost.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if test.cost.GetRate() != test.want.GetRate() {
+			t.Errorf("GetRate is %v, want %v", test.cost.GetRate(), test.want.GetRate())
+		}
+
+		if test.cost.GetCost() != test.want.GetCost() {
+			t.Errorf("GetCost is %v, want %v", test.cost.GetCost(), test.want.GetCost())
+		}
+	}
+}
+
+func TestBuildCost_Setters(t *testing.T) {
+	// setup types
+	var b *BuildCost
+
+	// setup tests
+	tests := []struct {
+		cost *BuildCost
+		want *BuildCost
+	}{
+		{
+			cost: testBuildCost(),
+			want: testBuildCost(),
+		},
+		{
+			cost: b,
+			want: new(BuildCost),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.cost.SetBuildID(test.want.GetBuildID())
+		test.cost.SetHost(test.want.GetHost())
+		test.cost.SetClass(test.want.GetClass())
+		test.cost.SetMinutes(test.want.GetMinutes())
+		test.cost.SetRate(test.want.GetRate())
+		test.cost.SetCost(test.want.GetCost())
+
+		if test.cost.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.cost.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.cost.GetHost() != test.want.GetHost() {
+			t.Errorf("SetHost is %v, want %v", test.cost.GetHost(), test.want.GetHost())
+		}
+
+		if test.cost.GetClass() != test.want.GetClass() {
+			t.Errorf("SetClass is %v, want %v", test.cost.GetClass(), test.want.GetClass())
+		}
+
+		if test.cost.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("SetMinutes is %v, want %v", test.cost.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if test.cost.GetRate() != test.want.GetRate() {
+			t.Errorf("SetRate is %v, want %v", test.cost.GetRate(), test.want.GetRate())
+		}
+
+		if test.cost.GetCost() != test.want.GetCost() {
+			t.Errorf("SetCost is %v, want %v", test.cost.GetCost(), test.want.GetCost())
+		}
+	}
+}
+
+func TestBuildCost_String(t *testing.T) {
+	// setup types
+	b := testBuildCost()
+
+	want := fmt.Sprintf(`{
+  BuildID: %d,
+  Host: %s,
+  Class: %s,
+  Minutes: %v,
+  Rate: %v,
+  Cost: %v,
+}`,
+		b.GetBuildID(),
+		b.GetHost(),
+		b.GetClass(),
+		b.GetMinutes(),
+		b.GetRate(),
+		b.GetCost(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildCost is a test helper function to create a BuildCost
+// type with all fields set to a fake value.
+func testBuildCost() *BuildCost {
+	b := new(BuildCost)
+
+	b.SetBuildID(1)
+	b.SetHost("worker-1.example.com")
+	b.SetClass("large:linux")
+	b.SetMinutes(12.5)
+	b.SetRate(0.016)
+	b.SetCost(0.2)
+
+	return b
+}
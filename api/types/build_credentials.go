@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// BuildCredentials is the API representation of the registries a build
+// was granted image pull credentials for when it was compiled.
+//
+// swagger:model BuildCredentials
+type BuildCredentials struct {
+	ID         *int64    `json:"id,omitempty"`
+	BuildID    *int64    `json:"build_id,omitempty"`
+	RepoID     *int64    `json:"repo_id,omitempty"`
+	Registries *[]string `json:"registries,omitempty"`
+	Created    *int64    `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided BuildCredentials type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCredentials) GetID() int64 {
+	// return zero value if BuildCredentials type or ID field is nil
+	if b == nil || b.ID == nil {
+		return 0
+	}
+
+	return *b.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided BuildCredentials type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCredentials) GetBuildID() int64 {
+	// return zero value if BuildCredentials type or BuildID field is nil
+	if b == nil || b.BuildID == nil {
+		return 0
+	}
+
+	return *b.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided BuildCredentials type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCredentials) GetRepoID() int64 {
+	// return zero value if BuildCredentials type or RepoID field is nil
+	if b == nil || b.RepoID == nil {
+		return 0
+	}
+
+	return *b.RepoID
+}
+
+// GetRegistries returns the Registries field.
+//
+// When the provided BuildCredentials type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCredentials) GetRegistries() []string {
+	// return zero value if BuildCredentials type or Registries field is nil
+	if b == nil || b.Registries == nil {
+		return []string{}
+	}
+
+	return *b.Registries
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided BuildCredentials type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildCredentials) GetCreated() int64 {
+	// return zero value if BuildCredentials type or Created field is nil
+	if b == nil || b.Created == nil {
+		return 0
+	}
+
+	return *b.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided BuildCredentials type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCredentials) SetID(v int64) {
+	// return if BuildCredentials type is nil
+	if b == nil {
+		return
+	}
+
+	b.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided BuildCredentials type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCredentials) SetBuildID(v int64) {
+	// return if BuildCredentials type is nil
+	if b == nil {
+		return
+	}
+
+	b.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided BuildCredentials type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCredentials) SetRepoID(v int64) {
+	// return if BuildCredentials type is nil
+	if b == nil {
+		return
+	}
+
+	b.RepoID = &v
+}
+
+// SetRegistries sets the Registries field.
+//
+// When the provided BuildCredentials type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCredentials) SetRegistries(v []string) {
+	// return if BuildCredentials type is nil
+	if b == nil {
+		return
+	}
+
+	b.Registries = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided BuildCredentials type is nil, it
+// will set nothing and immediately return.
+func (b *BuildCredentials) SetCreated(v int64) {
+	// return if BuildCredentials type is nil
+	if b == nil {
+		return
+	}
+
+	b.Created = &v
+}
+
+// String implements the Stringer interface for the BuildCredentials type.
+func (b *BuildCredentials) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Registries: %s,
+  Created: %d,
+}`,
+		b.GetID(),
+		b.GetBuildID(),
+		b.GetRepoID(),
+		b.GetRegistries(),
+		b.GetCreated(),
+	)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildCredentials_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		bc   *BuildCredentials
+		want *BuildCredentials
+	}{
+		{
+			bc:   testBuildCredentials(),
+			want: testBuildCredentials(),
+		},
+		{
+			bc:   new(BuildCredentials),
+			want: new(BuildCredentials),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.bc.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.bc.GetID(), test.want.GetID())
+		}
+
+		if test.bc.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.bc.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.bc.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.bc.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetRegistries(), test.want.GetRegistries()) {
+			t.Errorf("GetRegistries is %v, want %v", test.bc.GetRegistries(), test.want.GetRegistries())
+		}
+
+		if test.bc.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.bc.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildCredentials_Setters(t *testing.T) {
+	// setup types
+	var b *BuildCredentials
+
+	// setup tests
+	tests := []struct {
+		bc   *BuildCredentials
+		want *BuildCredentials
+	}{
+		{
+			bc:   testBuildCredentials(),
+			want: testBuildCredentials(),
+		},
+		{
+			bc:   b,
+			want: new(BuildCredentials),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.bc.SetID(test.want.GetID())
+		test.bc.SetBuildID(test.want.GetBuildID())
+		test.bc.SetRepoID(test.want.GetRepoID())
+		test.bc.SetRegistries(test.want.GetRegistries())
+		test.bc.SetCreated(test.want.GetCreated())
+
+		if test.bc.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.bc.GetID(), test.want.GetID())
+		}
+
+		if test.bc.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.bc.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.bc.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.bc.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if !reflect.DeepEqual(test.bc.GetRegistries(), test.want.GetRegistries()) {
+			t.Errorf("SetRegistries is %v, want %v", test.bc.GetRegistries(), test.want.GetRegistries())
+		}
+
+		if test.bc.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.bc.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildCredentials_String(t *testing.T) {
+	// setup types
+	b := testBuildCredentials()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Registries: %s,
+  Created: %d,
+}`,
+		b.GetID(),
+		b.GetBuildID(),
+		b.GetRepoID(),
+		b.GetRegistries(),
+		b.GetCreated(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildCredentials is a test helper function to create a BuildCredentials
+// type with all fields set to a fake value.
+func testBuildCredentials() *BuildCredentials {
+	b := new(BuildCredentials)
+
+	b.SetID(1)
+	b.SetBuildID(1)
+	b.SetRepoID(1)
+	b.SetRegistries([]string{"ghcr.io"})
+	b.SetCreated(1563474077)
+
+	return b
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// BuildDeprecations is the API representation of the warnings for
+// the deprecated images, templates and pipeline syntax used by a
+// build when it was compiled.
+//
+// swagger:model BuildDeprecations
+type BuildDeprecations struct {
+	ID       *int64    `json:"id,omitempty"`
+	BuildID  *int64    `json:"build_id,omitempty"`
+	RepoID   *int64    `json:"repo_id,omitempty"`
+	Warnings *[]string `json:"warnings,omitempty"`
+	Created  *int64    `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided BuildDeprecations type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildDeprecations) GetID() int64 {
+	// return zero value if BuildDeprecations type or ID field is nil
+	if b == nil || b.ID == nil {
+		return 0
+	}
+
+	return *b.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided BuildDeprecations type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildDeprecations) GetBuildID() int64 {
+	// return zero value if BuildDeprecations type or BuildID field is nil
+	if b == nil || b.BuildID == nil {
+		return 0
+	}
+
+	return *b.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided BuildDeprecations type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildDeprecations) GetRepoID() int64 {
+	// return zero value if BuildDeprecations type or RepoID field is nil
+	if b == nil || b.RepoID == nil {
+		return 0
+	}
+
+	return *b.RepoID
+}
+
+// GetWarnings returns the Warnings field.
+//
+// When the provided BuildDeprecations type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildDeprecations) GetWarnings() []string {
+	// return zero value if BuildDeprecations type or Warnings field is nil
+	if b == nil || b.Warnings == nil {
+		return []string{}
+	}
+
+	return *b.Warnings
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided BuildDeprecations type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildDeprecations) GetCreated() int64 {
+	// return zero value if BuildDeprecations type or Created field is nil
+	if b == nil || b.Created == nil {
+		return 0
+	}
+
+	return *b.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided BuildDeprecations type is nil, it
+// will set nothing and immediately return.
+func (b *BuildDeprecations) SetID(v int64) {
+	// return if BuildDeprecations type is nil
+	if b == nil {
+		return
+	}
+
+	b.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided BuildDeprecations type is nil, it
+// will set nothing and immediately return.
+func (b *BuildDeprecations) SetBuildID(v int64) {
+	// return if BuildDeprecations type is nil
+	if b == nil {
+		return
+	}
+
+	b.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided BuildDeprecations type is nil, it
+// will set nothing and immediately return.
+func (b *BuildDeprecations) SetRepoID(v int64) {
+	// return if BuildDeprecations type is nil
+	if b == nil {
+		return
+	}
+
+	b.RepoID = &v
+}
+
+// SetWarnings sets the Warnings field.
+//
+// When the provided BuildDeprecations type is nil, it
+// will set nothing and immediately return.
+func (b *BuildDeprecations) SetWarnings(v []string) {
+	// return if BuildDeprecations type is nil
+	if b == nil {
+		return
+	}
+
+	b.Warnings = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided BuildDeprecations type is nil, it
+// will set nothing and immediately return.
+func (b *BuildDeprecations) SetCreated(v int64) {
+	// return if BuildDeprecations type is nil
+	if b == nil {
+		return
+	}
+
+	b.Created = &v
+}
+
+// String implements the Stringer interface for the BuildDeprecations type.
+func (b *BuildDeprecations) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Warnings: %s,
+  Created: %d,
+}`,
+		b.GetID(),
+		b.GetBuildID(),
+		b.GetRepoID(),
+		b.GetWarnings(),
+		b.GetCreated(),
+	)
+}
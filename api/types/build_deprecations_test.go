@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildDeprecations_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		deprecations *BuildDeprecations
+		want         *BuildDeprecations
+	}{
+		{
+			deprecations: testBuildDeprecations(),
+			want:         testBuildDeprecations(),
+		},
+		{
+			deprecations: new(BuildDeprecations),
+			want:         new(BuildDeprecations),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.deprecations.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.deprecations.GetID(), test.want.GetID())
+		}
+
+		if test.deprecations.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.deprecations.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.deprecations.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.deprecations.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if !reflect.DeepEqual(test.deprecations.GetWarnings(), test.want.GetWarnings()) {
+			t.Errorf("GetWarnings is %v, want %v", test.deprecations.GetWarnings(), test.want.GetWarnings())
+		}
+
+		if test.deprecations.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.deprecations.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildDeprecations_Setters(t *testing.T) {
+	// setup types
+	var b *BuildDeprecations
+
+	// setup tests
+	tests := []struct {
+		deprecations *BuildDeprecations
+		want         *BuildDeprecations
+	}{
+		{
+			deprecations: testBuildDeprecations(),
+			want:         testBuildDeprecations(),
+		},
+		{
+			deprecations: b,
+			want:         new(BuildDeprecations),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.deprecations.SetID(test.want.GetID())
+		test.deprecations.SetBuildID(test.want.GetBuildID())
+		test.deprecations.SetRepoID(test.want.GetRepoID())
+		test.deprecations.SetWarnings(test.want.GetWarnings())
+		test.deprecations.SetCreated(test.want.GetCreated())
+
+		if test.deprecations.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.deprecations.GetID(), test.want.GetID())
+		}
+
+		if test.deprecations.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.deprecations.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.deprecations.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.deprecations.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if !reflect.DeepEqual(test.deprecations.GetWarnings(), test.want.GetWarnings()) {
+			t.Errorf("SetWarnings is %v, want %v", test.deprecations.GetWarnings(), test.want.GetWarnings())
+		}
+
+		if test.deprecations.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.deprecations.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildDeprecations_String(t *testing.T) {
+	// setup types
+	b := testBuildDeprecations()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Warnings: %s,
+  Created: %d,
+}`,
+		b.GetID(),
+		b.GetBuildID(),
+		b.GetRepoID(),
+		b.GetWarnings(),
+		b.GetCreated(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildDeprecations is a test helper function to create a BuildDeprecations
+// type with all fields set to a fake value.
+func testBuildDeprecations() *BuildDeprecations {
+	b := new(BuildDeprecations)
+
+	b.SetID(1)
+	b.SetBuildID(1)
+	b.SetRepoID(1)
+	b.SetWarnings([]string{"image golang:1.15 is deprecated and sunsets on 2024-01-01: upgrade to golang:1.21"})
+	b.SetCreated(1563474076)
+
+	return b
+}
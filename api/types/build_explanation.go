@@ -0,0 +1,432 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/library"
+)
+
+// BuildExplanation is the API representation of everything that went
+// into the decisions made for a build: the webhook that created it,
+// the stages and steps skipped by their rulesets, the warnings from
+// compiling it, the queue route and worker it was assigned, the time
+// spent in each phase and the errors it ran into.
+//
+// swagger:model BuildExplanation
+type BuildExplanation struct {
+	Repo            *string          `json:"repo,omitempty"`
+	Build           *library.Build   `json:"build,omitempty"`
+	Hook            *library.Hook    `json:"hook,omitempty"`
+	Skips           *[]*StepSkip     `json:"skips,omitempty"`
+	Report          *CompileReport   `json:"report,omitempty"`
+	Deprecations    *[]string        `json:"deprecations,omitempty"`
+	Route           *string          `json:"route,omitempty"`
+	WorkerGroup     *string          `json:"worker_group,omitempty"`
+	Worker          *library.Worker  `json:"worker,omitempty"`
+	Steps           *[]*library.Step `json:"steps,omitempty"`
+	EnqueueDuration *int64           `json:"enqueue_duration,omitempty"`
+	WaitDuration    *int64           `json:"wait_duration,omitempty"`
+	RunDuration     *int64           `json:"run_duration,omitempty"`
+	Errors          *[]string        `json:"errors,omitempty"`
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetRepo() string {
+	// return zero value if BuildExplanation type or Repo field is nil
+	if e == nil || e.Repo == nil {
+		return ""
+	}
+
+	return *e.Repo
+}
+
+// GetBuild returns the Build field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetBuild() *library.Build {
+	// return zero value if BuildExplanation type or Build field is nil
+	if e == nil || e.Build == nil {
+		return new(library.Build)
+	}
+
+	return e.Build
+}
+
+// GetHook returns the Hook field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetHook() *library.Hook {
+	// return zero value if BuildExplanation type or Hook field is nil
+	if e == nil || e.Hook == nil {
+		return new(library.Hook)
+	}
+
+	return e.Hook
+}
+
+// GetSkips returns the Skips field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetSkips() []*StepSkip {
+	// return zero value if BuildExplanation type or Skips field is nil
+	if e == nil || e.Skips == nil {
+		return []*StepSkip{}
+	}
+
+	return *e.Skips
+}
+
+// GetReport returns the Report field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetReport() *CompileReport {
+	// return zero value if BuildExplanation type or Report field is nil
+	if e == nil || e.Report == nil {
+		return new(CompileReport)
+	}
+
+	return e.Report
+}
+
+// GetDeprecations returns the Deprecations field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetDeprecations() []string {
+	// return zero value if BuildExplanation type or Deprecations field is nil
+	if e == nil || e.Deprecations == nil {
+		return []string{}
+	}
+
+	return *e.Deprecations
+}
+
+// GetRoute returns the Route field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetRoute() string {
+	// return zero value if BuildExplanation type or Route field is nil
+	if e == nil || e.Route == nil {
+		return ""
+	}
+
+	return *e.Route
+}
+
+// GetWorkerGroup returns the WorkerGroup field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetWorkerGroup() string {
+	// return zero value if BuildExplanation type or WorkerGroup field is nil
+	if e == nil || e.WorkerGroup == nil {
+		return ""
+	}
+
+	return *e.WorkerGroup
+}
+
+// GetWorker returns the Worker field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetWorker() *library.Worker {
+	// return zero value if BuildExplanation type or Worker field is nil
+	if e == nil || e.Worker == nil {
+		return new(library.Worker)
+	}
+
+	return e.Worker
+}
+
+// GetSteps returns the Steps field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetSteps() []*library.Step {
+	// return zero value if BuildExplanation type or Steps field is nil
+	if e == nil || e.Steps == nil {
+		return []*library.Step{}
+	}
+
+	return *e.Steps
+}
+
+// GetEnqueueDuration returns the EnqueueDuration field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetEnqueueDuration() int64 {
+	// return zero value if BuildExplanation type or EnqueueDuration field is nil
+	if e == nil || e.EnqueueDuration == nil {
+		return 0
+	}
+
+	return *e.EnqueueDuration
+}
+
+// GetWaitDuration returns the WaitDuration field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetWaitDuration() int64 {
+	// return zero value if BuildExplanation type or WaitDuration field is nil
+	if e == nil || e.WaitDuration == nil {
+		return 0
+	}
+
+	return *e.WaitDuration
+}
+
+// GetRunDuration returns the RunDuration field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetRunDuration() int64 {
+	// return zero value if BuildExplanation type or RunDuration field is nil
+	if e == nil || e.RunDuration == nil {
+		return 0
+	}
+
+	return *e.RunDuration
+}
+
+// GetErrors returns the Errors field.
+//
+// When the provided BuildExplanation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *BuildExplanation) GetErrors() []string {
+	// return zero value if BuildExplanation type or Errors field is nil
+	if e == nil || e.Errors == nil {
+		return []string{}
+	}
+
+	return *e.Errors
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetRepo(v string) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Repo = &v
+}
+
+// SetBuild sets the Build field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetBuild(v *library.Build) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Build = v
+}
+
+// SetHook sets the Hook field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetHook(v *library.Hook) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Hook = v
+}
+
+// SetSkips sets the Skips field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetSkips(v []*StepSkip) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Skips = &v
+}
+
+// SetReport sets the Report field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetReport(v *CompileReport) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Report = v
+}
+
+// SetDeprecations sets the Deprecations field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetDeprecations(v []string) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Deprecations = &v
+}
+
+// SetRoute sets the Route field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetRoute(v string) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Route = &v
+}
+
+// SetWorkerGroup sets the WorkerGroup field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetWorkerGroup(v string) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.WorkerGroup = &v
+}
+
+// SetWorker sets the Worker field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetWorker(v *library.Worker) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Worker = v
+}
+
+// SetSteps sets the Steps field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetSteps(v []*library.Step) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Steps = &v
+}
+
+// SetEnqueueDuration sets the EnqueueDuration field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetEnqueueDuration(v int64) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.EnqueueDuration = &v
+}
+
+// SetWaitDuration sets the WaitDuration field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetWaitDuration(v int64) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.WaitDuration = &v
+}
+
+// SetRunDuration sets the RunDuration field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetRunDuration(v int64) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.RunDuration = &v
+}
+
+// SetErrors sets the Errors field.
+//
+// When the provided BuildExplanation type is nil, it
+// will set nothing and immediately return.
+func (e *BuildExplanation) SetErrors(v []string) {
+	// return if BuildExplanation type is nil
+	if e == nil {
+		return
+	}
+
+	e.Errors = &v
+}
+
+// String implements the Stringer interface for the BuildExplanation type.
+func (e *BuildExplanation) String() string {
+	return fmt.Sprintf(`{
+  Repo: %s,
+  Build: %d,
+  Hook: %d,
+  Skips: %d,
+  Deprecations: %d,
+  Route: %s,
+  WorkerGroup: %s,
+  Worker: %s,
+  Steps: %d,
+  EnqueueDuration: %d,
+  WaitDuration: %d,
+  RunDuration: %d,
+  Errors: %s,
+}`,
+		e.GetRepo(),
+		e.GetBuild().GetNumber(),
+		e.GetHook().GetNumber(),
+		len(e.GetSkips()),
+		len(e.GetDeprecations()),
+		e.GetRoute(),
+		e.GetWorkerGroup(),
+		e.GetWorker().GetHostname(),
+		len(e.GetSteps()),
+		e.GetEnqueueDuration(),
+		e.GetWaitDuration(),
+		e.GetRunDuration(),
+		e.GetErrors(),
+	)
+}
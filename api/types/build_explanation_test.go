@@ -0,0 +1,268 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestBuildExplanation_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		explanation *BuildExplanation
+		want        *BuildExplanation
+	}{
+		{
+			explanation: testBuildExplanation(),
+			want:        testBuildExplanation(),
+		},
+		{
+			explanation: new(BuildExplanation),
+			want:        new(BuildExplanation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.explanation.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.explanation.GetRepo(), test.want.GetRepo())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetBuild(), test.want.GetBuild()) {
+			t.Errorf("GetBuild is %v, want %v", test.explanation.GetBuild(), test.want.GetBuild())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetHook(), test.want.GetHook()) {
+			t.Errorf("GetHook is %v, want %v", test.explanation.GetHook(), test.want.GetHook())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetSkips(), test.want.GetSkips()) {
+			t.Errorf("GetSkips is %v, want %v", test.explanation.GetSkips(), test.want.GetSkips())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetReport(), test.want.GetReport()) {
+			t.Errorf("GetReport is %v, want %v", test.explanation.GetReport(), test.want.GetReport())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetDeprecations(), test.want.GetDeprecations()) {
+			t.Errorf("GetDeprecations is %v, want %v", test.explanation.GetDeprecations(), test.want.GetDeprecations())
+		}
+
+		if test.explanation.GetRoute() != test.want.GetRoute() {
+			t.Errorf("GetRoute is %v, want %v", test.explanation.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.explanation.GetWorkerGroup() != test.want.GetWorkerGroup() {
+			t.Errorf("GetWorkerGroup is %v, want %v", test.explanation.GetWorkerGroup(), test.want.GetWorkerGroup())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetWorker(), test.want.GetWorker()) {
+			t.Errorf("GetWorker is %v, want %v", test.explanation.GetWorker(), test.want.GetWorker())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetSteps(), test.want.GetSteps()) {
+			t.Errorf("GetSteps is %v, want %v", test.explanation.GetSteps(), test.want.GetSteps())
+		}
+
+		if test.explanation.GetEnqueueDuration() != test.want.GetEnqueueDuration() {
+			t.Errorf("GetEnqueueDuration is %v, want %v", test.explanation.GetEnqueueDuration(), test.want.GetEnqueueDuration())
+		}
+
+		if test.explanation.GetWaitDuration() != test.want.GetWaitDuration() {
+			t.Errorf("GetWaitDuration is %v, want %v", test.explanation.GetWaitDuration(), test.want.GetWaitDuration())
+		}
+
+		if test.explanation.GetRunDuration() != test.want.GetRunDuration() {
+			t.Errorf("GetRunDuration is %v, want %v", test.explanation.GetRunDuration(), test.want.GetRunDuration())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetErrors(), test.want.GetErrors()) {
+			t.Errorf("GetErrors is %v, want %v", test.explanation.GetErrors(), test.want.GetErrors())
+		}
+	}
+}
+
+func TestBuildExplanation_Setters(t *testing.T) {
+	// setup types
+	var e *BuildExplanation
+
+	// setup tests
+	tests := []struct {
+		explanation *BuildExplanation
+		want        *BuildExplanation
+	}{
+		{
+			explanation: testBuildExplanation(),
+			want:        testBuildExplanation(),
+		},
+		{
+			explanation: e,
+			want:        new(BuildExplanation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.explanation.SetRepo(test.want.GetRepo())
+		test.explanation.SetBuild(test.want.GetBuild())
+		test.explanation.SetHook(test.want.GetHook())
+		test.explanation.SetSkips(test.want.GetSkips())
+		test.explanation.SetReport(test.want.GetReport())
+		test.explanation.SetDeprecations(test.want.GetDeprecations())
+		test.explanation.SetRoute(test.want.GetRoute())
+		test.explanation.SetWorkerGroup(test.want.GetWorkerGroup())
+		test.explanation.SetWorker(test.want.GetWorker())
+		test.explanation.SetSteps(test.want.GetSteps())
+		test.explanation.SetEnqueueDuration(test.want.GetEnqueueDuration())
+		test.explanation.SetWaitDuration(test.want.GetWaitDuration())
+		test.explanation.SetRunDuration(test.want.GetRunDuration())
+		test.explanation.SetErrors(test.want.GetErrors())
+
+		if test.explanation.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.explanation.GetRepo(), test.want.GetRepo())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetBuild(), test.want.GetBuild()) {
+			t.Errorf("SetBuild is %v, want %v", test.explanation.GetBuild(), test.want.GetBuild())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetHook(), test.want.GetHook()) {
+			t.Errorf("SetHook is %v, want %v", test.explanation.GetHook(), test.want.GetHook())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetSkips(), test.want.GetSkips()) {
+			t.Errorf("SetSkips is %v, want %v", test.explanation.GetSkips(), test.want.GetSkips())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetReport(), test.want.GetReport()) {
+			t.Errorf("SetReport is %v, want %v", test.explanation.GetReport(), test.want.GetReport())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetDeprecations(), test.want.GetDeprecations()) {
+			t.Errorf("SetDeprecations is %v, want %v", test.explanation.GetDeprecations(), test.want.GetDeprecations())
+		}
+
+		if test.explanation.GetRoute() != test.want.GetRoute() {
+			t.Errorf("SetRoute is %v, want %v", test.explanation.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.explanation.GetWorkerGroup() != test.want.GetWorkerGroup() {
+			t.Errorf("SetWorkerGroup is %v, want %v", test.explanation.GetWorkerGroup(), test.want.GetWorkerGroup())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetWorker(), test.want.GetWorker()) {
+			t.Errorf("SetWorker is %v, want %v", test.explanation.GetWorker(), test.want.GetWorker())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetSteps(), test.want.GetSteps()) {
+			t.Errorf("SetSteps is %v, want %v", test.explanation.GetSteps(), test.want.GetSteps())
+		}
+
+		if test.explanation.GetEnqueueDuration() != test.want.GetEnqueueDuration() {
+			t.Errorf("SetEnqueueDuration is %v, want %v", test.explanation.GetEnqueueDuration(), test.want.GetEnqueueDuration())
+		}
+
+		if test.explanation.GetWaitDuration() != test.want.GetWaitDuration() {
+			t.Errorf("SetWaitDuration is %v, want %v", test.explanation.GetWaitDuration(), test.want.GetWaitDuration())
+		}
+
+		if test.explanation.GetRunDuration() != test.want.GetRunDuration() {
+			t.Errorf("SetRunDuration is %v, want %v", test.explanation.GetRunDuration(), test.want.GetRunDuration())
+		}
+
+		if !reflect.DeepEqual(test.explanation.GetErrors(), test.want.GetErrors()) {
+			t.Errorf("SetErrors is %v, want %v", test.explanation.GetErrors(), test.want.GetErrors())
+		}
+	}
+}
+
+func TestBuildExplanation_String(t *testing.T) {
+	// setup types
+	e := testBuildExplanation()
+
+	want := fmt.Sprintf(`{
+  Repo: %s,
+  Build: %d,
+  Hook: %d,
+  Skips: %d,
+  Deprecations: %d,
+  Route: %s,
+  WorkerGroup: %s,
+  Worker: %s,
+  Steps: %d,
+  EnqueueDuration: %d,
+  WaitDuration: %d,
+  RunDuration: %d,
+  Errors: %s,
+}`,
+		e.GetRepo(),
+		e.GetBuild().GetNumber(),
+		e.GetHook().GetNumber(),
+		len(e.GetSkips()),
+		len(e.GetDeprecations()),
+		e.GetRoute(),
+		e.GetWorkerGroup(),
+		e.GetWorker().GetHostname(),
+		len(e.GetSteps()),
+		e.GetEnqueueDuration(),
+		e.GetWaitDuration(),
+		e.GetRunDuration(),
+		e.GetErrors(),
+	)
+
+	// run test
+	got := e.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildExplanation is a test helper function to create a
+// BuildExplanation type with all fields set to a fake value.
+func testBuildExplanation() *BuildExplanation {
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetNumber(1)
+	b.SetStatus("success")
+
+	h := new(library.Hook)
+	h.SetID(1)
+	h.SetNumber(1)
+	h.SetBuildID(1)
+	h.SetEvent("push")
+
+	w := new(library.Worker)
+	w.SetID(1)
+	w.SetHostname("worker_0")
+
+	s := new(library.Step)
+	s.SetID(1)
+	s.SetNumber(1)
+	s.SetName("clone")
+
+	e := new(BuildExplanation)
+
+	e.SetRepo("github/octocat")
+	e.SetBuild(b)
+	e.SetHook(h)
+	e.SetSkips([]*StepSkip{testStepSkip()})
+	e.SetReport(testCompileReport())
+	e.SetDeprecations([]string{"image golang:1.15 is deprecated: please upgrade"})
+	e.SetRoute("vela")
+	e.SetWorkerGroup("gpu")
+	e.SetWorker(w)
+	e.SetSteps([]*library.Step{s})
+	e.SetEnqueueDuration(1)
+	e.SetWaitDuration(5)
+	e.SetRunDuration(60)
+	e.SetErrors([]string{"unable to get worker worker_0: record not found"})
+
+	return e
+}
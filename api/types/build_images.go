@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// BuildImages is the API representation of the images of a build
+// pinned to the digests they resolved to when it was compiled.
+//
+// swagger:model BuildImages
+type BuildImages struct {
+	ID      *int64    `json:"id,omitempty"`
+	BuildID *int64    `json:"build_id,omitempty"`
+	RepoID  *int64    `json:"repo_id,omitempty"`
+	Images  *[]string `json:"images,omitempty"`
+	Created *int64    `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided BuildImages type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildImages) GetID() int64 {
+	// return zero value if BuildImages type or ID field is nil
+	if b == nil || b.ID == nil {
+		return 0
+	}
+
+	return *b.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided BuildImages type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildImages) GetBuildID() int64 {
+	// return zero value if BuildImages type or BuildID field is nil
+	if b == nil || b.BuildID == nil {
+		return 0
+	}
+
+	return *b.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided BuildImages type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildImages) GetRepoID() int64 {
+	// return zero value if BuildImages type or RepoID field is nil
+	if b == nil || b.RepoID == nil {
+		return 0
+	}
+
+	return *b.RepoID
+}
+
+// GetImages returns the Images field.
+//
+// When the provided BuildImages type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildImages) GetImages() []string {
+	// return zero value if BuildImages type or Images field is nil
+	if b == nil || b.Images == nil {
+		return []string{}
+	}
+
+	return *b.Images
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided BuildImages type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (b *BuildImages) GetCreated() int64 {
+	// return zero value if BuildImages type or Created field is nil
+	if b == nil || b.Created == nil {
+		return 0
+	}
+
+	return *b.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided BuildImages type is nil, it
+// will set nothing and immediately return.
+func (b *BuildImages) SetID(v int64) {
+	// return if BuildImages type is nil
+	if b == nil {
+		return
+	}
+
+	b.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided BuildImages type is nil, it
+// will set nothing and immediately return.
+func (b *BuildImages) SetBuildID(v int64) {
+	// return if BuildImages type is nil
+	if b == nil {
+		return
+	}
+
+	b.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided BuildImages type is nil, it
+// will set nothing and immediately return.
+func (b *BuildImages) SetRepoID(v int64) {
+	// return if BuildImages type is nil
+	if b == nil {
+		return
+	}
+
+	b.RepoID = &v
+}
+
+// SetImages sets the Images field.
+//
+// When the provided BuildImages type is nil, it
+// will set nothing and immediately return.
+func (b *BuildImages) SetImages(v []string) {
+	// return if BuildImages type is nil
+	if b == nil {
+		return
+	}
+
+	b.Images = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided BuildImages type is nil, it
+// will set nothing and immediately return.
+func (b *BuildImages) SetCreated(v int64) {
+	// return if BuildImages type is nil
+	if b == nil {
+		return
+	}
+
+	b.Created = &v
+}
+
+// String implements the Stringer interface for the BuildImages type.
+func (b *BuildImages) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Images: %s,
+  Created: %d,
+}`,
+		b.GetID(),
+		b.GetBuildID(),
+		b.GetRepoID(),
+		b.GetImages(),
+		b.GetCreated(),
+	)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildImages_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		bi   *BuildImages
+		want *BuildImages
+	}{
+		{
+			bi:   testBuildImages(),
+			want: testBuildImages(),
+		},
+		{
+			bi:   new(BuildImages),
+			want: new(BuildImages),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.bi.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.bi.GetID(), test.want.GetID())
+		}
+
+		if test.bi.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.bi.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.bi.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.bi.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if !reflect.DeepEqual(test.bi.GetImages(), test.want.GetImages()) {
+			t.Errorf("GetImages is %v, want %v", test.bi.GetImages(), test.want.GetImages())
+		}
+
+		if test.bi.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.bi.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildImages_Setters(t *testing.T) {
+	// setup types
+	var b *BuildImages
+
+	// setup tests
+	tests := []struct {
+		bi   *BuildImages
+		want *BuildImages
+	}{
+		{
+			bi:   testBuildImages(),
+			want: testBuildImages(),
+		},
+		{
+			bi:   b,
+			want: new(BuildImages),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.bi.SetID(test.want.GetID())
+		test.bi.SetBuildID(test.want.GetBuildID())
+		test.bi.SetRepoID(test.want.GetRepoID())
+		test.bi.SetImages(test.want.GetImages())
+		test.bi.SetCreated(test.want.GetCreated())
+
+		if test.bi.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.bi.GetID(), test.want.GetID())
+		}
+
+		if test.bi.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.bi.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.bi.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.bi.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if !reflect.DeepEqual(test.bi.GetImages(), test.want.GetImages()) {
+			t.Errorf("SetImages is %v, want %v", test.bi.GetImages(), test.want.GetImages())
+		}
+
+		if test.bi.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.bi.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildImages_String(t *testing.T) {
+	// setup types
+	b := testBuildImages()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Images: %s,
+  Created: %d,
+}`,
+		b.GetID(),
+		b.GetBuildID(),
+		b.GetRepoID(),
+		b.GetImages(),
+		b.GetCreated(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildImages is a test helper function to create a BuildImages
+// type with all fields set to a fake value.
+func testBuildImages() *BuildImages {
+	b := new(BuildImages)
+
+	b.SetID(1)
+	b.SetBuildID(1)
+	b.SetRepoID(1)
+	b.SetImages([]string{"alpine:latest@sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b"})
+	b.SetCreated(1563474077)
+
+	return b
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// BuildLabels is the API representation of the pull request labels
+// captured when a build was created.
+//
+// swagger:model BuildLabels
+type BuildLabels struct {
+	ID      *int64    `json:"id,omitempty"`
+	BuildID *int64    `json:"build_id,omitempty"`
+	RepoID  *int64    `json:"repo_id,omitempty"`
+	Action  *string   `json:"action,omitempty"`
+	Labels  *[]string `json:"labels,omitempty"`
+	Created *int64    `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided BuildLabels type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *BuildLabels) GetID() int64 {
+	// return zero value if BuildLabels type or ID field is nil
+	if l == nil || l.ID == nil {
+		return 0
+	}
+
+	return *l.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided BuildLabels type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *BuildLabels) GetBuildID() int64 {
+	// return zero value if BuildLabels type or BuildID field is nil
+	if l == nil || l.BuildID == nil {
+		return 0
+	}
+
+	return *l.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided BuildLabels type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *BuildLabels) GetRepoID() int64 {
+	// return zero value if BuildLabels type or RepoID field is nil
+	if l == nil || l.RepoID == nil {
+		return 0
+	}
+
+	return *l.RepoID
+}
+
+// GetAction returns the Action field.
+//
+// When the provided BuildLabels type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *BuildLabels) GetAction() string {
+	// return zero value if BuildLabels type or Action field is nil
+	if l == nil || l.Action == nil {
+		return ""
+	}
+
+	return *l.Action
+}
+
+// GetLabels returns the Labels field.
+//
+// When the provided BuildLabels type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *BuildLabels) GetLabels() []string {
+	// return zero value if BuildLabels type or Labels field is nil
+	if l == nil || l.Labels == nil {
+		return []string{}
+	}
+
+	return *l.Labels
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided BuildLabels type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *BuildLabels) GetCreated() int64 {
+	// return zero value if BuildLabels type or Created field is nil
+	if l == nil || l.Created == nil {
+		return 0
+	}
+
+	return *l.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided BuildLabels type is nil, it
+// will set nothing and immediately return.
+func (l *BuildLabels) SetID(v int64) {
+	// return if BuildLabels type is nil
+	if l == nil {
+		return
+	}
+
+	l.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided BuildLabels type is nil, it
+// will set nothing and immediately return.
+func (l *BuildLabels) SetBuildID(v int64) {
+	// return if BuildLabels type is nil
+	if l == nil {
+		return
+	}
+
+	l.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided BuildLabels type is nil, it
+// will set nothing and immediately return.
+func (l *BuildLabels) SetRepoID(v int64) {
+	// return if BuildLabels type is nil
+	if l == nil {
+		return
+	}
+
+	l.RepoID = &v
+}
+
+// SetAction sets the Action field.
+//
+// When the provided BuildLabels type is nil, it
+// will set nothing and immediately return.
+func (l *BuildLabels) SetAction(v string) {
+	// return if BuildLabels type is nil
+	if l == nil {
+		return
+	}
+
+	l.Action = &v
+}
+
+// SetLabels sets the Labels field.
+//
+// When the provided BuildLabels type is nil, it
+// will set nothing and immediately return.
+func (l *BuildLabels) SetLabels(v []string) {
+	// return if BuildLabels type is nil
+	if l == nil {
+		return
+	}
+
+	l.Labels = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided BuildLabels type is nil, it
+// will set nothing and immediately return.
+func (l *BuildLabels) SetCreated(v int64) {
+	// return if BuildLabels type is nil
+	if l == nil {
+		return
+	}
+
+	l.Created = &v
+}
+
+// String implements the Stringer interface for the BuildLabels type.
+func (l *BuildLabels) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Action: %s,
+  Labels: %s,
+  Created: %d,
+}`,
+		l.GetID(),
+		l.GetBuildID(),
+		l.GetRepoID(),
+		l.GetAction(),
+		l.GetLabels(),
+		l.GetCreated(),
+	)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildLabels_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		b    *BuildLabels
+		want *BuildLabels
+	}{
+		{
+			b:    testBuildLabels(),
+			want: testBuildLabels(),
+		},
+		{
+			b:    new(BuildLabels),
+			want: new(BuildLabels),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.b.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.b.GetID(), test.want.GetID())
+		}
+
+		if test.b.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.b.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.b.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.b.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.b.GetAction() != test.want.GetAction() {
+			t.Errorf("GetAction is %v, want %v", test.b.GetAction(), test.want.GetAction())
+		}
+
+		if !reflect.DeepEqual(test.b.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("GetLabels is %v, want %v", test.b.GetLabels(), test.want.GetLabels())
+		}
+
+		if test.b.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.b.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildLabels_Setters(t *testing.T) {
+	// setup types
+	var b *BuildLabels
+
+	// setup tests
+	tests := []struct {
+		b    *BuildLabels
+		want *BuildLabels
+	}{
+		{
+			b:    testBuildLabels(),
+			want: testBuildLabels(),
+		},
+		{
+			b:    b,
+			want: new(BuildLabels),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.b.SetID(test.want.GetID())
+		test.b.SetBuildID(test.want.GetBuildID())
+		test.b.SetRepoID(test.want.GetRepoID())
+		test.b.SetAction(test.want.GetAction())
+		test.b.SetLabels(test.want.GetLabels())
+		test.b.SetCreated(test.want.GetCreated())
+
+		if test.b.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.b.GetID(), test.want.GetID())
+		}
+
+		if test.b.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.b.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.b.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.b.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.b.GetAction() != test.want.GetAction() {
+			t.Errorf("SetAction is %v, want %v", test.b.GetAction(), test.want.GetAction())
+		}
+
+		if !reflect.DeepEqual(test.b.GetLabels(), test.want.GetLabels()) {
+			t.Errorf("SetLabels is %v, want %v", test.b.GetLabels(), test.want.GetLabels())
+		}
+
+		if test.b.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.b.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildLabels_String(t *testing.T) {
+	// setup types
+	b := testBuildLabels()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Action: %s,
+  Labels: %s,
+  Created: %d,
+}`,
+		b.GetID(),
+		b.GetBuildID(),
+		b.GetRepoID(),
+		b.GetAction(),
+		b.GetLabels(),
+		b.GetCreated(),
+	)
+
+	// run test
+	got := b.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildLabels is a test helper function to create a BuildLabels
+// type with all fields set to a fake value.
+func testBuildLabels() *BuildLabels {
+	b := new(BuildLabels)
+
+	b.SetID(1)
+	b.SetBuildID(1)
+	b.SetRepoID(1)
+	b.SetAction("labeled")
+	b.SetLabels([]string{"deploy-preview"})
+	b.SetCreated(1563474077)
+
+	return b
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// BuildRedactionRedacted represents the values being
+	// redacted from the data captured for a build.
+	BuildRedactionRedacted = "redacted"
+
+	// BuildRedactionDeleted represents the data
+	// captured for a build being deleted.
+	BuildRedactionDeleted = "deleted"
+)
+
+const (
+	// BuildRedactionTargetLogs represents the logs
+	// for the steps and services of a build.
+	BuildRedactionTargetLogs = "logs"
+
+	// BuildRedactionTargetArtifacts represents the
+	// artifacts produced by a build.
+	BuildRedactionTargetArtifacts = "artifacts"
+
+	// BuildRedactionTargetEnvironment represents the deployment
+	// parameters and commit details injected into the
+	// environment of a build.
+	BuildRedactionTargetEnvironment = "environment"
+)
+
+// BuildRedaction is the API representation of a record of the
+// data captured for a build being redacted or deleted.
+//
+// The Values field is only provided when requesting a redaction
+// and is never stored or returned from the API.
+//
+// swagger:model BuildRedaction
+type BuildRedaction struct {
+	ID      *int64    `json:"id,omitempty"`
+	RepoID  *int64    `json:"repo_id,omitempty"`
+	BuildID *int64    `json:"build_id,omitempty"`
+	Action  *string   `json:"action,omitempty"`
+	Targets *[]string `json:"targets,omitempty"`
+	Values  *[]string `json:"values,omitempty"`
+	Reason  *string   `json:"reason,omitempty"`
+	Matches *int64    `json:"matches,omitempty"`
+	Actor   *string   `json:"actor,omitempty"`
+	Created *int64    `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetID() int64 {
+	// return zero value if BuildRedaction type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetRepoID() int64 {
+	// return zero value if BuildRedaction type or RepoID field is nil
+	if r == nil || r.RepoID == nil {
+		return 0
+	}
+
+	return *r.RepoID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetBuildID() int64 {
+	// return zero value if BuildRedaction type or BuildID field is nil
+	if r == nil || r.BuildID == nil {
+		return 0
+	}
+
+	return *r.BuildID
+}
+
+// GetAction returns the Action field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetAction() string {
+	// return zero value if BuildRedaction type or Action field is nil
+	if r == nil || r.Action == nil {
+		return ""
+	}
+
+	return *r.Action
+}
+
+// GetTargets returns the Targets field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetTargets() []string {
+	// return zero value if BuildRedaction type or Targets field is nil
+	if r == nil || r.Targets == nil {
+		return []string{}
+	}
+
+	return *r.Targets
+}
+
+// GetValues returns the Values field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetValues() []string {
+	// return zero value if BuildRedaction type or Values field is nil
+	if r == nil || r.Values == nil {
+		return []string{}
+	}
+
+	return *r.Values
+}
+
+// GetReason returns the Reason field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetReason() string {
+	// return zero value if BuildRedaction type or Reason field is nil
+	if r == nil || r.Reason == nil {
+		return ""
+	}
+
+	return *r.Reason
+}
+
+// GetMatches returns the Matches field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetMatches() int64 {
+	// return zero value if BuildRedaction type or Matches field is nil
+	if r == nil || r.Matches == nil {
+		return 0
+	}
+
+	return *r.Matches
+}
+
+// GetActor returns the Actor field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetActor() string {
+	// return zero value if BuildRedaction type or Actor field is nil
+	if r == nil || r.Actor == nil {
+		return ""
+	}
+
+	return *r.Actor
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided BuildRedaction type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *BuildRedaction) GetCreated() int64 {
+	// return zero value if BuildRedaction type or Created field is nil
+	if r == nil || r.Created == nil {
+		return 0
+	}
+
+	return *r.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetID(v int64) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetRepoID(v int64) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.RepoID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetBuildID(v int64) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.BuildID = &v
+}
+
+// SetAction sets the Action field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetAction(v string) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.Action = &v
+}
+
+// SetTargets sets the Targets field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetTargets(v []string) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.Targets = &v
+}
+
+// SetValues sets the Values field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetValues(v []string) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.Values = &v
+}
+
+// SetReason sets the Reason field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetReason(v string) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.Reason = &v
+}
+
+// SetMatches sets the Matches field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetMatches(v int64) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.Matches = &v
+}
+
+// SetActor sets the Actor field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetActor(v string) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.Actor = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided BuildRedaction type is nil, it
+// will set nothing and immediately return.
+func (r *BuildRedaction) SetCreated(v int64) {
+	// return if BuildRedaction type is nil
+	if r == nil {
+		return
+	}
+
+	r.Created = &v
+}
+
+// String implements the Stringer interface for the BuildRedaction type.
+func (r *BuildRedaction) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  Action: %s,
+  Targets: %s,
+  Reason: %s,
+  Matches: %d,
+  Actor: %s,
+  Created: %d,
+}`,
+		r.GetID(),
+		r.GetRepoID(),
+		r.GetBuildID(),
+		r.GetAction(),
+		r.GetTargets(),
+		r.GetReason(),
+		r.GetMatches(),
+		r.GetActor(),
+		r.GetCreated(),
+	)
+}
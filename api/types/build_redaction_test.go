@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestBuildRedaction_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		redaction *BuildRedaction
+		want      *BuildRedaction
+	}{
+		{
+			redaction: testBuildRedaction(),
+			want:      testBuildRedaction(),
+		},
+		{
+			redaction: new(BuildRedaction),
+			want:      new(BuildRedaction),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.redaction.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.redaction.GetID(), test.want.GetID())
+		}
+
+		if test.redaction.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.redaction.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.redaction.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.redaction.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.redaction.GetAction() != test.want.GetAction() {
+			t.Errorf("GetAction is %v, want %v", test.redaction.GetAction(), test.want.GetAction())
+		}
+
+		if !reflect.DeepEqual(test.redaction.GetTargets(), test.want.GetTargets()) {
+			t.Errorf("GetTargets is %v, want %v", test.redaction.GetTargets(), test.want.GetTargets())
+		}
+
+		if !reflect.DeepEqual(test.redaction.GetValues(), test.want.GetValues()) {
+			t.Errorf("GetValues is %v, want %v", test.redaction.GetValues(), test.want.GetValues())
+		}
+
+		if test.redaction.GetReason() != test.want.GetReason() {
+			t.Errorf("GetReason is %v, want %v", test.redaction.GetReason(), test.want.GetReason())
+		}
+
+		if test.redaction.GetMatches() != test.want.GetMatches() {
+			t.Errorf("GetMatches is %v, want %v", test.redaction.GetMatches(), test.want.GetMatches())
+		}
+
+		if test.redaction.GetActor() != test.want.GetActor() {
+			t.Errorf("GetActor is %v, want %v", test.redaction.GetActor(), test.want.GetActor())
+		}
+
+		if test.redaction.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.redaction.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildRedaction_Setters(t *testing.T) {
+	// setup types
+	var r *BuildRedaction
+
+	// setup tests
+	tests := []struct {
+		redaction *BuildRedaction
+		want      *BuildRedaction
+	}{
+		{
+			redaction: testBuildRedaction(),
+			want:      testBuildRedaction(),
+		},
+		{
+			redaction: r,
+			want:      new(BuildRedaction),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.redaction.SetID(test.want.GetID())
+		test.redaction.SetRepoID(test.want.GetRepoID())
+		test.redaction.SetBuildID(test.want.GetBuildID())
+		test.redaction.SetAction(test.want.GetAction())
+		test.redaction.SetTargets(test.want.GetTargets())
+		test.redaction.SetValues(test.want.GetValues())
+		test.redaction.SetReason(test.want.GetReason())
+		test.redaction.SetMatches(test.want.GetMatches())
+		test.redaction.SetActor(test.want.GetActor())
+		test.redaction.SetCreated(test.want.GetCreated())
+
+		if test.redaction.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.redaction.GetID(), test.want.GetID())
+		}
+
+		if test.redaction.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.redaction.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.redaction.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.redaction.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.redaction.GetAction() != test.want.GetAction() {
+			t.Errorf("SetAction is %v, want %v", test.redaction.GetAction(), test.want.GetAction())
+		}
+
+		if !reflect.DeepEqual(test.redaction.GetTargets(), test.want.GetTargets()) {
+			t.Errorf("SetTargets is %v, want %v", test.redaction.GetTargets(), test.want.GetTargets())
+		}
+
+		if !reflect.DeepEqual(test.redaction.GetValues(), test.want.GetValues()) {
+			t.Errorf("SetValues is %v, want %v", test.redaction.GetValues(), test.want.GetValues())
+		}
+
+		if test.redaction.GetReason() != test.want.GetReason() {
+			t.Errorf("SetReason is %v, want %v", test.redaction.GetReason(), test.want.GetReason())
+		}
+
+		if test.redaction.GetMatches() != test.want.GetMatches() {
+			t.Errorf("SetMatches is %v, want %v", test.redaction.GetMatches(), test.want.GetMatches())
+		}
+
+		if test.redaction.GetActor() != test.want.GetActor() {
+			t.Errorf("SetActor is %v, want %v", test.redaction.GetActor(), test.want.GetActor())
+		}
+
+		if test.redaction.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.redaction.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestBuildRedaction_String(t *testing.T) {
+	// setup types
+	r := testBuildRedaction()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  Action: %s,
+  Targets: %s,
+  Reason: %s,
+  Matches: %d,
+  Actor: %s,
+  Created: %d,
+}`,
+		r.GetID(),
+		r.GetRepoID(),
+		r.GetBuildID(),
+		r.GetAction(),
+		r.GetTargets(),
+		r.GetReason(),
+		r.GetMatches(),
+		r.GetActor(),
+		r.GetCreated(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testBuildRedaction is a test helper function to create a BuildRedaction
+// type with all fields set to a fake value.
+func testBuildRedaction() *BuildRedaction {
+	r := new(BuildRedaction)
+
+	r.SetID(1)
+	r.SetRepoID(1)
+	r.SetBuildID(1)
+	r.SetAction("redacted")
+	r.SetTargets([]string{"logs", "environment"})
+	r.SetValues([]string{"hunter2"})
+	r.SetReason("token printed by debug step")
+	r.SetMatches(2)
+	r.SetActor("octocat")
+	r.SetCreated(1563474076)
+
+	return r
+}
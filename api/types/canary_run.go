@@ -0,0 +1,284 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// CanaryRun is the API representation of a synthetic build run through
+// a route to verify the workers for the route are healthy.
+//
+// swagger:model CanaryRun
+type CanaryRun struct {
+	ID          *int64  `json:"id,omitempty"`
+	Route       *string `json:"route,omitempty"`
+	WorkerGroup *string `json:"worker_group,omitempty"`
+	RepoID      *int64  `json:"repo_id,omitempty"`
+	BuildID     *int64  `json:"build_id,omitempty"`
+	Status      *string `json:"status,omitempty"`
+	Error       *string `json:"error,omitempty"`
+	Created     *int64  `json:"created,omitempty"`
+	Finished    *int64  `json:"finished,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetID() int64 {
+	// return zero value if CanaryRun type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetRoute returns the Route field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetRoute() string {
+	// return zero value if CanaryRun type or Route field is nil
+	if r == nil || r.Route == nil {
+		return ""
+	}
+
+	return *r.Route
+}
+
+// GetWorkerGroup returns the WorkerGroup field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetWorkerGroup() string {
+	// return zero value if CanaryRun type or WorkerGroup field is nil
+	if r == nil || r.WorkerGroup == nil {
+		return ""
+	}
+
+	return *r.WorkerGroup
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetRepoID() int64 {
+	// return zero value if CanaryRun type or RepoID field is nil
+	if r == nil || r.RepoID == nil {
+		return 0
+	}
+
+	return *r.RepoID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetBuildID() int64 {
+	// return zero value if CanaryRun type or BuildID field is nil
+	if r == nil || r.BuildID == nil {
+		return 0
+	}
+
+	return *r.BuildID
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetStatus() string {
+	// return zero value if CanaryRun type or Status field is nil
+	if r == nil || r.Status == nil {
+		return ""
+	}
+
+	return *r.Status
+}
+
+// GetError returns the Error field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetError() string {
+	// return zero value if CanaryRun type or Error field is nil
+	if r == nil || r.Error == nil {
+		return ""
+	}
+
+	return *r.Error
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetCreated() int64 {
+	// return zero value if CanaryRun type or Created field is nil
+	if r == nil || r.Created == nil {
+		return 0
+	}
+
+	return *r.Created
+}
+
+// GetFinished returns the Finished field.
+//
+// When the provided CanaryRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CanaryRun) GetFinished() int64 {
+	// return zero value if CanaryRun type or Finished field is nil
+	if r == nil || r.Finished == nil {
+		return 0
+	}
+
+	return *r.Finished
+}
+
+// SetID sets the ID field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetID(v int64) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetRoute sets the Route field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetRoute(v string) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.Route = &v
+}
+
+// SetWorkerGroup sets the WorkerGroup field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetWorkerGroup(v string) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.WorkerGroup = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetRepoID(v int64) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.RepoID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetBuildID(v int64) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.BuildID = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetStatus(v string) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.Status = &v
+}
+
+// SetError sets the Error field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetError(v string) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.Error = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetCreated(v int64) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.Created = &v
+}
+
+// SetFinished sets the Finished field.
+//
+// When the provided CanaryRun type is nil, it
+// will set nothing and immediately return.
+func (r *CanaryRun) SetFinished(v int64) {
+	// return if CanaryRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.Finished = &v
+}
+
+// String implements the Stringer interface for the CanaryRun type.
+func (r *CanaryRun) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Route: %s,
+  WorkerGroup: %s,
+  RepoID: %d,
+  BuildID: %d,
+  Status: %s,
+  Error: %s,
+  Created: %d,
+  Finished: %d,
+}`,
+		r.GetID(),
+		r.GetRoute(),
+		r.GetWorkerGroup(),
+		r.GetRepoID(),
+		r.GetBuildID(),
+		r.GetStatus(),
+		r.GetError(),
+		r.GetCreated(),
+		r.GetFinished(),
+	)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCanaryRun_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		cr   *CanaryRun
+		want *CanaryRun
+	}{
+		{
+			cr:   testCanaryRun(),
+			want: testCanaryRun(),
+		},
+		{
+			cr:   new(CanaryRun),
+			want: new(CanaryRun),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.cr.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.cr.GetID(), test.want.GetID())
+		}
+
+		if test.cr.GetRoute() != test.want.GetRoute() {
+			t.Errorf("GetRoute is %v, want %v", test.cr.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.cr.GetWorkerGroup() != test.want.GetWorkerGroup() {
+			t.Errorf("GetWorkerGroup is %v, want %v", test.cr.GetWorkerGroup(), test.want.GetWorkerGroup())
+		}
+
+		if test.cr.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.cr.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.cr.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.cr.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.cr.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.cr.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.cr.GetError() != test.want.GetError() {
+			t.Errorf("GetError is %v, want %v", test.cr.GetError(), test.want.GetError())
+		}
+
+		if test.cr.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.cr.GetCreated(), test.want.GetCreated())
+		}
+
+		if test.cr.GetFinished() != test.want.GetFinished() {
+			t.Errorf("GetFinished is %v, want %v", test.cr.GetFinished(), test.want.GetFinished())
+		}
+	}
+}
+
+func TestCanaryRun_Setters(t *testing.T) {
+	// setup types
+	var c *CanaryRun
+
+	// setup tests
+	tests := []struct {
+		cr   *CanaryRun
+		want *CanaryRun
+	}{
+		{
+			cr:   testCanaryRun(),
+			want: testCanaryRun(),
+		},
+		{
+			cr:   c,
+			want: new(CanaryRun),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.cr.SetID(test.want.GetID())
+		test.cr.SetRoute(test.want.GetRoute())
+		test.cr.SetWorkerGroup(test.want.GetWorkerGroup())
+		test.cr.SetRepoID(test.want.GetRepoID())
+		test.cr.SetBuildID(test.want.GetBuildID())
+		test.cr.SetStatus(test.want.GetStatus())
+		test.cr.SetError(test.want.GetError())
+		test.cr.SetCreated(test.want.GetCreated())
+		test.cr.SetFinished(test.want.GetFinished())
+
+		if test.cr.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.cr.GetID(), test.want.GetID())
+		}
+
+		if test.cr.GetRoute() != test.want.GetRoute() {
+			t.Errorf("SetRoute is %v, want %v", test.cr.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.cr.GetWorkerGroup() != test.want.GetWorkerGroup() {
+			t.Errorf("SetWorkerGroup is %v, want %v", test.cr.GetWorkerGroup(), test.want.GetWorkerGroup())
+		}
+
+		if test.cr.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.cr.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.cr.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.cr.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.cr.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.cr.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.cr.GetError() != test.want.GetError() {
+			t.Errorf("SetError is %v, want %v", test.cr.GetError(), test.want.GetError())
+		}
+
+		if test.cr.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.cr.GetCreated(), test.want.GetCreated())
+		}
+
+		if test.cr.GetFinished() != test.want.GetFinished() {
+			t.Errorf("SetFinished is %v, want %v", test.cr.GetFinished(), test.want.GetFinished())
+		}
+	}
+}
+
+func TestCanaryRun_String(t *testing.T) {
+	// setup types
+	c := testCanaryRun()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Route: %s,
+  WorkerGroup: %s,
+  RepoID: %d,
+  BuildID: %d,
+  Status: %s,
+  Error: %s,
+  Created: %d,
+  Finished: %d,
+}`,
+		c.GetID(),
+		c.GetRoute(),
+		c.GetWorkerGroup(),
+		c.GetRepoID(),
+		c.GetBuildID(),
+		c.GetStatus(),
+		c.GetError(),
+		c.GetCreated(),
+		c.GetFinished(),
+	)
+
+	// run test
+	got := c.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testCanaryRun is a test helper function to create a CanaryRun
+// type with all fields set to a fake value.
+func testCanaryRun() *CanaryRun {
+	c := new(CanaryRun)
+
+	c.SetID(1)
+	c.SetRoute("vela")
+	c.SetWorkerGroup("compliance")
+	c.SetRepoID(1)
+	c.SetBuildID(1)
+	c.SetStatus("success")
+	c.SetError("")
+	c.SetCreated(1563474077)
+	c.SetFinished(1563474087)
+
+	return c
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// CapacitySnapshot is the API representation of the capacity of the worker
+// fleet compared to the builds it was running at a point in time.
+//
+// swagger:model CapacitySnapshot
+type CapacitySnapshot struct {
+	ID              *int64   `json:"id,omitempty"`
+	ActiveWorkers   *int64   `json:"active_workers,omitempty"`
+	InactiveWorkers *int64   `json:"inactive_workers,omitempty"`
+	BuildLimit      *int64   `json:"build_limit,omitempty"`
+	Running         *int64   `json:"running,omitempty"`
+	Pending         *int64   `json:"pending,omitempty"`
+	Utilization     *float64 `json:"utilization,omitempty"`
+	Created         *int64   `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetID() int64 {
+	// return zero value if CapacitySnapshot type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetActiveWorkers returns the ActiveWorkers field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetActiveWorkers() int64 {
+	// return zero value if CapacitySnapshot type or ActiveWorkers field is nil
+	if s == nil || s.ActiveWorkers == nil {
+		return 0
+	}
+
+	return *s.ActiveWorkers
+}
+
+// GetInactiveWorkers returns the InactiveWorkers field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetInactiveWorkers() int64 {
+	// return zero value if CapacitySnapshot type or InactiveWorkers field is nil
+	if s == nil || s.InactiveWorkers == nil {
+		return 0
+	}
+
+	return *s.InactiveWorkers
+}
+
+// GetBuildLimit returns the BuildLimit field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetBuildLimit() int64 {
+	// return zero value if CapacitySnapshot type or BuildLimit field is nil
+	if s == nil || s.BuildLimit == nil {
+		return 0
+	}
+
+	return *s.BuildLimit
+}
+
+// GetRunning returns the Running field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetRunning() int64 {
+	// return zero value if CapacitySnapshot type or Running field is nil
+	if s == nil || s.Running == nil {
+		return 0
+	}
+
+	return *s.Running
+}
+
+// GetPending returns the Pending field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetPending() int64 {
+	// return zero value if CapacitySnapshot type or Pending field is nil
+	if s == nil || s.Pending == nil {
+		return 0
+	}
+
+	return *s.Pending
+}
+
+// GetUtilization returns the Utilization field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetUtilization() float64 {
+	// return zero value if CapacitySnapshot type or Utilization field is nil
+	if s == nil || s.Utilization == nil {
+		return 0
+	}
+
+	return *s.Utilization
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided CapacitySnapshot type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CapacitySnapshot) GetCreated() int64 {
+	// return zero value if CapacitySnapshot type or Created field is nil
+	if s == nil || s.Created == nil {
+		return 0
+	}
+
+	return *s.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetID(v int64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetActiveWorkers sets the ActiveWorkers field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetActiveWorkers(v int64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.ActiveWorkers = &v
+}
+
+// SetInactiveWorkers sets the InactiveWorkers field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetInactiveWorkers(v int64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.InactiveWorkers = &v
+}
+
+// SetBuildLimit sets the BuildLimit field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetBuildLimit(v int64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.BuildLimit = &v
+}
+
+// SetRunning sets the Running field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetRunning(v int64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.Running = &v
+}
+
+// SetPending sets the Pending field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetPending(v int64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.Pending = &v
+}
+
+// SetUtilization sets the Utilization field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetUtilization(v float64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.Utilization = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided CapacitySnapshot type is nil, it
+// will set nothing and immediately return.
+func (s *CapacitySnapshot) SetCreated(v int64) {
+	// return if CapacitySnapshot type is nil
+	if s == nil {
+		return
+	}
+
+	s.Created = &v
+}
+
+// String implements the Stringer interface for the CapacitySnapshot type.
+func (s *CapacitySnapshot) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  ActiveWorkers: %d,
+  InactiveWorkers: %d,
+  BuildLimit: %d,
+  Running: %d,
+  Pending: %d,
+  Utilization: %v,
+  Created: %d,
+}`,
+		s.GetID(),
+		s.GetActiveWorkers(),
+		s.GetInactiveWorkers(),
+		s.GetBuildLimit(),
+		s.GetRunning(),
+		s.GetPending(),
+		s.GetUtilization(),
+		s.GetCreated(),
+	)
+}
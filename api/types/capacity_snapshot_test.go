@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCapacitySnapshot_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		cs   *CapacitySnapshot
+		want *CapacitySnapshot
+	}{
+		{
+			cs:   testCapacitySnapshot(),
+			want: testCapacitySnapshot(),
+		},
+		{
+			cs:   new(CapacitySnapshot),
+			want: new(CapacitySnapshot),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.cs.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.cs.GetID(), test.want.GetID())
+		}
+
+		if test.cs.GetActiveWorkers() != test.want.GetActiveWorkers() {
+			t.Errorf("GetActiveWorkers is %v, want %v", test.cs.GetActiveWorkers(), test.want.GetActiveWorkers())
+		}
+
+		if test.cs.GetInactiveWorkers() != test.want.GetInactiveWorkers() {
+			t.Errorf("GetInactiveWorkers is %v, want %v", test.cs.GetInactiveWorkers(), test.want.GetInactiveWorkers())
+		}
+
+		if test.cs.GetBuildLimit() != test.want.GetBuildLimit() {
+			t.Errorf("GetBuildLimit is %v, want %v", test.cs.GetBuildLimit(), test.want.GetBuildLimit())
+		}
+
+		if test.cs.GetRunning() != test.want.GetRunning() {
+			t.Errorf("GetRunning is %v, want %v", test.cs.GetRunning(), test.want.GetRunning())
+		}
+
+		if test.cs.GetPending() != test.want.GetPending() {
+			t.Errorf("GetPending is %v, want %v", test.cs.GetPending(), test.want.GetPending())
+		}
+
+		if test.cs.GetUtilization() != test.want.GetUtilization() {
+			t.Errorf("GetUtilization is %v, want %v", test.cs.GetUtilization(), test.want.GetUtilization())
+		}
+
+		if test.cs.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.cs.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestCapacitySnapshot_Setters(t *testing.T) {
+	// setup types
+	var c *CapacitySnapshot
+
+	// setup tests
+	tests := []struct {
+		cs   *CapacitySnapshot
+		want *CapacitySnapshot
+	}{
+		{
+			cs:   testCapacitySnapshot(),
+			want: testCapacitySnapshot(),
+		},
+		{
+			cs:   c,
+			want: new(CapacitySnapshot),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.cs.SetID(test.want.GetID())
+		test.cs.SetActiveWorkers(test.want.GetActiveWorkers())
+		test.cs.SetInactiveWorkers(test.want.GetInactiveWorkers())
+		test.cs.SetBuildLimit(test.want.GetBuildLimit())
+		test.cs.SetRunning(test.want.GetRunning())
+		test.cs.SetPending(test.want.GetPending())
+		test.cs.SetUtilization(test.want.GetUtilization())
+		test.cs.SetCreated(test.want.GetCreated())
+
+		if test.cs.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.cs.GetID(), test.want.GetID())
+		}
+
+		if test.cs.GetActiveWorkers() != test.want.GetActiveWorkers() {
+			t.Errorf("SetActiveWorkers is %v, want %v", test.cs.GetActiveWorkers(), test.want.GetActiveWorkers())
+		}
+
+		if test.cs.GetInactiveWorkers() != test.want.GetInactiveWorkers() {
+			t.Errorf("SetInactiveWorkers is %v, want %v", test.cs.GetInactiveWorkers(), test.want.GetInactiveWorkers())
+		}
+
+		if test.cs.GetBuildLimit() != test.want.GetBuildLimit() {
+			t.Errorf("SetBuildLimit is %v, want %v", test.cs.GetBuildLimit(), test.want.GetBuildLimit())
+		}
+
+		if test.cs.GetRunning() != test.want.GetRunning() {
+			t.Errorf("SetRunning is %v, want %v", test.cs.GetRunning(), test.want.GetRunning())
+		}
+
+		if test.cs.GetPending() != test.want.GetPending() {
+			t.Errorf("SetPending is %v, want %v", test.cs.GetPending(), test.want.GetPending())
+		}
+
+		if test.cs.GetUtilization() != test.want.GetUtilization() {
+			t.Errorf("SetUtilization is %v, want %v", test.cs.GetUtilization(), test.want.GetUtilization())
+		}
+
+		if test.cs.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.cs.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestCapacitySnapshot_String(t *testing.T) {
+	// setup types
+	c := testCapacitySnapshot()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  ActiveWorkers: %d,
+  InactiveWorkers: %d,
+  BuildLimit: %d,
+  Running: %d,
+  Pending: %d,
+  Utilization: %v,
+  Created: %d,
+}`,
+		c.GetID(),
+		c.GetActiveWorkers(),
+		c.GetInactiveWorkers(),
+		c.GetBuildLimit(),
+		c.GetRunning(),
+		c.GetPending(),
+		c.GetUtilization(),
+		c.GetCreated(),
+	)
+
+	// run test
+	got := c.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testCapacitySnapshot is a test helper function to create a CapacitySnapshot
+// type with all fields set to a fake value.
+func testCapacitySnapshot() *CapacitySnapshot {
+	c := new(CapacitySnapshot)
+
+	c.SetID(1)
+	c.SetActiveWorkers(3)
+	c.SetInactiveWorkers(1)
+	c.SetBuildLimit(6)
+	c.SetRunning(3)
+	c.SetPending(2)
+	c.SetUtilization(0.5)
+	c.SetCreated(1563474077)
+
+	return c
+}
@@ -0,0 +1,458 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// CatalogComponent is the API representation of a repo as a component
+// in a service catalog.
+//
+// swagger:model CatalogComponent
+type CatalogComponent struct {
+	Org           *string               `json:"org,omitempty"`
+	Repo          *string               `json:"repo,omitempty"`
+	FullName      *string               `json:"full_name,omitempty"`
+	Owner         *string               `json:"owner,omitempty"`
+	Link          *string               `json:"link,omitempty"`
+	Branch        *string               `json:"branch,omitempty"`
+	Visibility    *string               `json:"visibility,omitempty"`
+	Active        *bool                 `json:"active,omitempty"`
+	BuildNumber   *int64                `json:"build_number,omitempty"`
+	BuildStatus   *string               `json:"build_status,omitempty"`
+	BuildCommit   *string               `json:"build_commit,omitempty"`
+	BuildEvent    *string               `json:"build_event,omitempty"`
+	BuildFinished *int64                `json:"build_finished,omitempty"`
+	BuildLink     *string               `json:"build_link,omitempty"`
+	Deploys       *[]*EnvironmentDeploy `json:"deploys,omitempty"`
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetOrg() string {
+	// return zero value if CatalogComponent type or Org field is nil
+	if c == nil || c.Org == nil {
+		return ""
+	}
+
+	return *c.Org
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetRepo() string {
+	// return zero value if CatalogComponent type or Repo field is nil
+	if c == nil || c.Repo == nil {
+		return ""
+	}
+
+	return *c.Repo
+}
+
+// GetFullName returns the FullName field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetFullName() string {
+	// return zero value if CatalogComponent type or FullName field is nil
+	if c == nil || c.FullName == nil {
+		return ""
+	}
+
+	return *c.FullName
+}
+
+// GetOwner returns the Owner field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetOwner() string {
+	// return zero value if CatalogComponent type or Owner field is nil
+	if c == nil || c.Owner == nil {
+		return ""
+	}
+
+	return *c.Owner
+}
+
+// GetLink returns the Link field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetLink() string {
+	// return zero value if CatalogComponent type or Link field is nil
+	if c == nil || c.Link == nil {
+		return ""
+	}
+
+	return *c.Link
+}
+
+// GetBranch returns the Branch field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetBranch() string {
+	// return zero value if CatalogComponent type or Branch field is nil
+	if c == nil || c.Branch == nil {
+		return ""
+	}
+
+	return *c.Branch
+}
+
+// GetVisibility returns the Visibility field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetVisibility() string {
+	// return zero value if CatalogComponent type or Visibility field is nil
+	if c == nil || c.Visibility == nil {
+		return ""
+	}
+
+	return *c.Visibility
+}
+
+// GetActive returns the Active field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetActive() bool {
+	// return zero value if CatalogComponent type or Active field is nil
+	if c == nil || c.Active == nil {
+		return false
+	}
+
+	return *c.Active
+}
+
+// GetBuildNumber returns the BuildNumber field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetBuildNumber() int64 {
+	// return zero value if CatalogComponent type or BuildNumber field is nil
+	if c == nil || c.BuildNumber == nil {
+		return 0
+	}
+
+	return *c.BuildNumber
+}
+
+// GetBuildStatus returns the BuildStatus field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetBuildStatus() string {
+	// return zero value if CatalogComponent type or BuildStatus field is nil
+	if c == nil || c.BuildStatus == nil {
+		return ""
+	}
+
+	return *c.BuildStatus
+}
+
+// GetBuildCommit returns the BuildCommit field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetBuildCommit() string {
+	// return zero value if CatalogComponent type or BuildCommit field is nil
+	if c == nil || c.BuildCommit == nil {
+		return ""
+	}
+
+	return *c.BuildCommit
+}
+
+// GetBuildEvent returns the BuildEvent field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetBuildEvent() string {
+	// return zero value if CatalogComponent type or BuildEvent field is nil
+	if c == nil || c.BuildEvent == nil {
+		return ""
+	}
+
+	return *c.BuildEvent
+}
+
+// GetBuildFinished returns the BuildFinished field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetBuildFinished() int64 {
+	// return zero value if CatalogComponent type or BuildFinished field is nil
+	if c == nil || c.BuildFinished == nil {
+		return 0
+	}
+
+	return *c.BuildFinished
+}
+
+// GetBuildLink returns the BuildLink field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetBuildLink() string {
+	// return zero value if CatalogComponent type or BuildLink field is nil
+	if c == nil || c.BuildLink == nil {
+		return ""
+	}
+
+	return *c.BuildLink
+}
+
+// GetDeploys returns the Deploys field.
+//
+// When the provided CatalogComponent type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *CatalogComponent) GetDeploys() []*EnvironmentDeploy {
+	// return zero value if CatalogComponent type or Deploys field is nil
+	if c == nil || c.Deploys == nil {
+		return []*EnvironmentDeploy{}
+	}
+
+	return *c.Deploys
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetOrg(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Org = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetRepo(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Repo = &v
+}
+
+// SetFullName sets the FullName field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetFullName(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.FullName = &v
+}
+
+// SetOwner sets the Owner field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetOwner(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Owner = &v
+}
+
+// SetLink sets the Link field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetLink(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Link = &v
+}
+
+// SetBranch sets the Branch field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetBranch(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Branch = &v
+}
+
+// SetVisibility sets the Visibility field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetVisibility(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Visibility = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetActive(v bool) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Active = &v
+}
+
+// SetBuildNumber sets the BuildNumber field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetBuildNumber(v int64) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.BuildNumber = &v
+}
+
+// SetBuildStatus sets the BuildStatus field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetBuildStatus(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.BuildStatus = &v
+}
+
+// SetBuildCommit sets the BuildCommit field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetBuildCommit(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.BuildCommit = &v
+}
+
+// SetBuildEvent sets the BuildEvent field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetBuildEvent(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.BuildEvent = &v
+}
+
+// SetBuildFinished sets the BuildFinished field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetBuildFinished(v int64) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.BuildFinished = &v
+}
+
+// SetBuildLink sets the BuildLink field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetBuildLink(v string) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.BuildLink = &v
+}
+
+// SetDeploys sets the Deploys field.
+//
+// When the provided CatalogComponent type is nil, it
+// will set nothing and immediately return.
+func (c *CatalogComponent) SetDeploys(v []*EnvironmentDeploy) {
+	// return if CatalogComponent type is nil
+	if c == nil {
+		return
+	}
+
+	c.Deploys = &v
+}
+
+// String implements the Stringer interface for the CatalogComponent type.
+func (c *CatalogComponent) String() string {
+	return fmt.Sprintf(`{
+  Org: %s,
+  Repo: %s,
+  FullName: %s,
+  Owner: %s,
+  Link: %s,
+  Branch: %s,
+  Visibility: %s,
+  Active: %t,
+  BuildNumber: %d,
+  BuildStatus: %s,
+  BuildCommit: %s,
+  BuildEvent: %s,
+  BuildFinished: %d,
+  BuildLink: %s,
+  Deploys: %v,
+}`,
+		c.GetOrg(),
+		c.GetRepo(),
+		c.GetFullName(),
+		c.GetOwner(),
+		c.GetLink(),
+		c.GetBranch(),
+		c.GetVisibility(),
+		c.GetActive(),
+		c.GetBuildNumber(),
+		c.GetBuildStatus(),
+		c.GetBuildCommit(),
+		c.GetBuildEvent(),
+		c.GetBuildFinished(),
+		c.GetBuildLink(),
+		c.GetDeploys(),
+	)
+}
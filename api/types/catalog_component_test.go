@@ -0,0 +1,260 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCatalogComponent_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		cc   *CatalogComponent
+		want *CatalogComponent
+	}{
+		{
+			cc:   testCatalogComponent(),
+			want: testCatalogComponent(),
+		},
+		{
+			cc:   new(CatalogComponent),
+			want: new(CatalogComponent),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.cc.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.cc.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.cc.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.cc.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.cc.GetFullName() != test.want.GetFullName() {
+			t.Errorf("GetFullName is %v, want %v", test.cc.GetFullName(), test.want.GetFullName())
+		}
+
+		if test.cc.GetOwner() != test.want.GetOwner() {
+			t.Errorf("GetOwner is %v, want %v", test.cc.GetOwner(), test.want.GetOwner())
+		}
+
+		if test.cc.GetLink() != test.want.GetLink() {
+			t.Errorf("GetLink is %v, want %v", test.cc.GetLink(), test.want.GetLink())
+		}
+
+		if test.cc.GetBranch() != test.want.GetBranch() {
+			t.Errorf("GetBranch is %v, want %v", test.cc.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.cc.GetVisibility() != test.want.GetVisibility() {
+			t.Errorf("GetVisibility is %v, want %v", test.cc.GetVisibility(), test.want.GetVisibility())
+		}
+
+		if test.cc.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.cc.GetActive(), test.want.GetActive())
+		}
+
+		if test.cc.GetBuildNumber() != test.want.GetBuildNumber() {
+			t.Errorf("GetBuildNumber is %v, want %v", test.cc.GetBuildNumber(), test.want.GetBuildNumber())
+		}
+
+		if test.cc.GetBuildStatus() != test.want.GetBuildStatus() {
+			t.Errorf("GetBuildStatus is %v, want %v", test.cc.GetBuildStatus(), test.want.GetBuildStatus())
+		}
+
+		if test.cc.GetBuildCommit() != test.want.GetBuildCommit() {
+			t.Errorf("GetBuildCommit is %v, want %v", test.cc.GetBuildCommit(), test.want.GetBuildCommit())
+		}
+
+		if test.cc.GetBuildEvent() != test.want.GetBuildEvent() {
+			t.Errorf("GetBuildEvent is %v, want %v", test.cc.GetBuildEvent(), test.want.GetBuildEvent())
+		}
+
+		if test.cc.GetBuildFinished() != test.want.GetBuildFinished() {
+			t.Errorf("GetBuildFinished is %v, want %v", test.cc.GetBuildFinished(), test.want.GetBuildFinished())
+		}
+
+		if test.cc.GetBuildLink() != test.want.GetBuildLink() {
+			t.Errorf("GetBuildLink is %v, want %v", test.cc.GetBuildLink(), test.want.GetBuildLink())
+		}
+
+		if !reflect.DeepEqual(test.cc.GetDeploys(), test.want.GetDeploys()) {
+			t.Errorf("GetDeploys is %v, want %v", test.cc.GetDeploys(), test.want.GetDeploys())
+		}
+	}
+}
+
+func TestCatalogComponent_Setters(t *testing.T) {
+	// setup types
+	var c *CatalogComponent
+
+	// setup tests
+	tests := []struct {
+		cc   *CatalogComponent
+		want *CatalogComponent
+	}{
+		{
+			cc:   testCatalogComponent(),
+			want: testCatalogComponent(),
+		},
+		{
+			cc:   c,
+			want: new(CatalogComponent),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.cc.SetOrg(test.want.GetOrg())
+		test.cc.SetRepo(test.want.GetRepo())
+		test.cc.SetFullName(test.want.GetFullName())
+		test.cc.SetOwner(test.want.GetOwner())
+		test.cc.SetLink(test.want.GetLink())
+		test.cc.SetBranch(test.want.GetBranch())
+		test.cc.SetVisibility(test.want.GetVisibility())
+		test.cc.SetActive(test.want.GetActive())
+		test.cc.SetBuildNumber(test.want.GetBuildNumber())
+		test.cc.SetBuildStatus(test.want.GetBuildStatus())
+		test.cc.SetBuildCommit(test.want.GetBuildCommit())
+		test.cc.SetBuildEvent(test.want.GetBuildEvent())
+		test.cc.SetBuildFinished(test.want.GetBuildFinished())
+		test.cc.SetBuildLink(test.want.GetBuildLink())
+		test.cc.SetDeploys(test.want.GetDeploys())
+
+		if test.cc.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.cc.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.cc.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.cc.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.cc.GetFullName() != test.want.GetFullName() {
+			t.Errorf("SetFullName is %v, want %v", test.cc.GetFullName(), test.want.GetFullName())
+		}
+
+		if test.cc.GetOwner() != test.want.GetOwner() {
+			t.Errorf("SetOwner is %v, want %v", test.cc.GetOwner(), test.want.GetOwner())
+		}
+
+		if test.cc.GetLink() != test.want.GetLink() {
+			t.Errorf("SetLink is %v, want %v", test.cc.GetLink(), test.want.GetLink())
+		}
+
+		if test.cc.GetBranch() != test.want.GetBranch() {
+			t.Errorf("SetBranch is %v, want %v", test.cc.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.cc.GetVisibility() != test.want.GetVisibility() {
+			t.Errorf("SetVisibility is %v, want %v", test.cc.GetVisibility(), test.want.GetVisibility())
+		}
+
+		if test.cc.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.cc.GetActive(), test.want.GetActive())
+		}
+
+		if test.cc.GetBuildNumber() != test.want.GetBuildNumber() {
+			t.Errorf("SetBuildNumber is %v, want %v", test.cc.GetBuildNumber(), test.want.GetBuildNumber())
+		}
+
+		if test.cc.GetBuildStatus() != test.want.GetBuildStatus() {
+			t.Errorf("SetBuildStatus is %v, want %v", test.cc.GetBuildStatus(), test.want.GetBuildStatus())
+		}
+
+		if test.cc.GetBuildCommit() != test.want.GetBuildCommit() {
+			t.Errorf("SetBuildCommit is %v, want %v", test.cc.GetBuildCommit(), test.want.GetBuildCommit())
+		}
+
+		if test.cc.GetBuildEvent() != test.want.GetBuildEvent() {
+			t.Errorf("SetBuildEvent is %v, want %v", test.cc.GetBuildEvent(), test.want.GetBuildEvent())
+		}
+
+		if test.cc.GetBuildFinished() != test.want.GetBuildFinished() {
+			t.Errorf("SetBuildFinished is %v, want %v", test.cc.GetBuildFinished(), test.want.GetBuildFinished())
+		}
+
+		if test.cc.GetBuildLink() != test.want.GetBuildLink() {
+			t.Errorf("SetBuildLink is %v, want %v", test.cc.GetBuildLink(), test.want.GetBuildLink())
+		}
+
+		if !reflect.DeepEqual(test.cc.GetDeploys(), test.want.GetDeploys()) {
+			t.Errorf("SetDeploys is %v, want %v", test.cc.GetDeploys(), test.want.GetDeploys())
+		}
+	}
+}
+
+func TestCatalogComponent_String(t *testing.T) {
+	// setup types
+	c := testCatalogComponent()
+
+	want := fmt.Sprintf(`{
+  Org: %s,
+  Repo: %s,
+  FullName: %s,
+  Owner: %s,
+  Link: %s,
+  Branch: %s,
+  Visibility: %s,
+  Active: %t,
+  BuildNumber: %d,
+  BuildStatus: %s,
+  BuildCommit: %s,
+  BuildEvent: %s,
+  BuildFinished: %d,
+  BuildLink: %s,
+  Deploys: %v,
+}`,
+		c.GetOrg(),
+		c.GetRepo(),
+		c.GetFullName(),
+		c.GetOwner(),
+		c.GetLink(),
+		c.GetBranch(),
+		c.GetVisibility(),
+		c.GetActive(),
+		c.GetBuildNumber(),
+		c.GetBuildStatus(),
+		c.GetBuildCommit(),
+		c.GetBuildEvent(),
+		c.GetBuildFinished(),
+		c.GetBuildLink(),
+		c.GetDeploys(),
+	)
+
+	// run test
+	got := c.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testCatalogComponent is a test helper function to create a CatalogComponent
+// type with all fields set to a fake value.
+func testCatalogComponent() *CatalogComponent {
+	c := new(CatalogComponent)
+
+	c.SetOrg("github")
+	c.SetRepo("octocat")
+	c.SetFullName("github/octocat")
+	c.SetOwner("group:platform")
+	c.SetLink("https://github.com/github/octocat")
+	c.SetBranch("main")
+	c.SetVisibility("public")
+	c.SetActive(true)
+	c.SetBuildNumber(1)
+	c.SetBuildStatus("success")
+	c.SetBuildCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	c.SetBuildEvent("push")
+	c.SetBuildFinished(1563474086)
+	c.SetBuildLink("https://example.company.com/github/octocat/1")
+	c.SetDeploys([]*EnvironmentDeploy{testEnvironmentDeploy()})
+
+	return c
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// ClassCost is the API representation of the estimated cost
+// of the builds that ran on a class of workers.
+//
+// swagger:model ClassCost
+type ClassCost struct {
+	Class   *string  `json:"class,omitempty"`
+	Builds  *int64   `json:"builds,omitempty"`
+	Minutes *float64 `json:"minutes,omitempty"`
+	Rate    *float64 `json:"rate,omitempty"`
+	Cost    *float64 `json:"cost,omitempty"`
+}
+
+// GetClass returns the Class field.
+//
+// When the provided ClassCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *ClassCost) GetClass() string {
+	// return zero value if ClassCost type or Class field is nil
+	if c == nil || c.Class == nil {
+		return ""
+	}
+
+	return *c.Class
+}
+
+// GetBuilds returns the Builds field.
+//
+// When the provided ClassCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *ClassCost) GetBuilds() int64 {
+	// return zero value if ClassCost type or Builds field is nil
+	if c == nil || c.Builds == nil {
+		return 0
+	}
+
+	return *c.Builds
+}
+
+// GetMinutes returns the Minutes field.
+//
+// When the provided ClassCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *ClassCost) GetMinutes() float64 {
+	// return zero value if ClassCost type or Minutes field is nil
+	if c == nil || c.Minutes == nil {
+		return 0
+	}
+
+	return *c.Minutes
+}
+
+// GetRate returns the Rate field.
+//
+// When the provided ClassCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *ClassCost) GetRate() float64 {
+	// return zero value if ClassCost type or Rate field is nil
+	if c == nil || c.Rate == nil {
+		return 0
+	}
+
+	return *c.Rate
+}
+
+// GetCost returns the Cost field.
+//
+// When the provided ClassCost type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (c *ClassCost) GetCost() float64 {
+	// return zero value if ClassCost type or Cost field is nil
+	if c == nil || c.Cost == nil {
+		return 0
+	}
+
+	return *c.Cost
+}
+
+// SetClass sets the Class field.
+//
+// When the provided ClassCost type is nil, it
+// will set nothing and immediately return.
+func (c *ClassCost) SetClass(v string) {
+	// return if ClassCost type is nil
+	if c == nil {
+		return
+	}
+
+	c.Class = &v
+}
+
+// SetBuilds sets the Builds field.
+//
+// When the provided ClassCost type is nil, it
+// will set nothing and immediately return.
+func (c *ClassCost) SetBuilds(v int64) {
+	// return if ClassCost type is nil
+	if c == nil {
+		return
+	}
+
+	c.Builds = &v
+}
+
+// SetMinutes sets the Minutes field.
+//
+// When the provided ClassCost type is nil, it
+// will set nothing and immediately return.
+func (c *ClassCost) SetMinutes(v float64) {
+	// return if ClassCost type is nil
+	if c == nil {
+		return
+	}
+
+	c.Minutes = &v
+}
+
+// SetRate sets the Rate field.
+//
+// When the provided ClassCost type is nil, it
+// will set nothing and immediately return.
+func (c *ClassCost) SetRate(v float64) {
+	// return if ClassCost type is nil
+	if c == nil {
+		return
+	}
+
+	c.Rate = &v
+}
+
+// SetCost sets the Cost field.
+//
+// When the provided ClassCost type is nil, it
+// will set nothing and immediately return.
+func (c *ClassCost) SetCost(v float64) {
+	// return if ClassCost type is nil
+	if c == nil {
+		return
+	}
+
+	c.Cost = &v
+}
+
+// String implements the Stringer interface for the ClassCost type.
+func (c *ClassCost) String() string {
+	return fmt.Sprintf(`{
+  Class: %s,
+  Builds: %d,
+  Minutes: %v,
+  Rate: %v,
+  Cost: %v,
+}`,
+		c.GetClass(),
+		c.GetBuilds(),
+		c.GetMinutes(),
+		c.GetRate(),
+		c.GetCost(),
+	)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestClassCost_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		cost *ClassCost
+		want *ClassCost
+	}{
+		{
+			cost: testClassCost(),
+			want: testClassCost(),
+		},
+		{
+			cost: new(ClassCost),
+			want: new(ClassCost),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.cost.GetClass() != test.want.GetClass() {
+			t.Errorf("GetClass is %v, want %v", test.cost.GetClass(), test.want.GetClass())
+		}
+
+		if test.cost.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("GetBuilds is %v, want %v", test.cost.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.cost.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("GetMinutes is %v, want %v", test.cost.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if test.cost.GetRate() != test.want.GetRate() {
+			t.Errorf("GetRate is %v, want %v", test.cost.GetRate(), test.want.GetRate())
+		}
+
+		if test.cost.GetCost() != test.want.GetCost() {
+			t.Errorf("GetCost is %v, want %v", test.cost.GetCost(), test.want.GetCost())
+		}
+	}
+}
+
+func TestClassCost_Setters(t *testing.T) {
+	// setup types
+	var c *ClassCost
+
+	// setup tests
+	tests := []struct {
+		cost *ClassCost
+		want *ClassCost
+	}{
+		{
+			cost: testClassCost(),
+			want: testClassCost(),
+		},
+		{
+			cost: c,
+			want: new(ClassCost),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.cost.SetClass(test.want.GetClass())
+		test.cost.SetBuilds(test.want.GetBuilds())
+		test.cost.SetMinutes(test.want.GetMinutes())
+		test.cost.SetRate(test.want.GetRate())
+		test.cost.SetCost(test.want.GetCost())
+
+		if test.cost.GetClass() != test.want.GetClass() {
+			t.Errorf("SetClass is %v, want %v", test.cost.GetClass(), test.want.GetClass())
+		}
+
+		if test.cost.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("SetBuilds is %v, want %v", test.cost.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.cost.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("SetMinutes is %v, want %v", test.cost.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if test.cost.GetRate() != test.want.GetRate() {
+			t.Errorf("SetRate is %v, want %v", test.cost.GetRate(), test.want.GetRate())
+		}
+
+		if test.cost.GetCost() != test.want.GetCost() {
+			t.Errorf("SetCost is %v, want %v", test.cost.GetCost(), test.want.GetCost())
+		}
+	}
+}
+
+func TestClassCost_String(t *testing.T) {
+	// setup types
+	c := testClassCost()
+
+	want := fmt.Sprintf(`{
+  Class: %s,
+  Builds: %d,
+  Minutes: %v,
+  Rate: %v,
+  Cost: %v,
+}`,
+		c.GetClass(),
+		c.GetBuilds(),
+		c.GetMinutes(),
+		c.GetRate(),
+		c.GetCost(),
+	)
+
+	// run test
+	got := c.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testClassCost is a test helper function to create a ClassCost
+// type with all fields set to a fake value.
+func testClassCost() *ClassCost {
+	c := new(ClassCost)
+
+	c.SetClass("large:linux")
+	c.SetBuilds(10)
+	c.SetMinutes(125.5)
+	c.SetRate(0.016)
+	c.SetCost(2.008)
+
+	return c
+}
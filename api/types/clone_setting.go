@@ -0,0 +1,359 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// CloneModeAuto represents a clone setting that uses the default
+	// for the org, or that is enabled when the usage is detected
+	// in the repo for the build.
+	CloneModeAuto = "auto"
+
+	// CloneModeEnabled represents a clone setting that is
+	// always enabled for the builds for the repo.
+	CloneModeEnabled = "enabled"
+
+	// CloneModeDisabled represents a clone setting that is
+	// never enabled for the builds for the repo.
+	CloneModeDisabled = "disabled"
+)
+
+// CloneSetting is the API representation of the settings controlling
+// how the injected clone step fetches a repo for its builds. The
+// settings for an org have no repo and are the defaults for the
+// repos in the org.
+//
+// swagger:model CloneSetting
+type CloneSetting struct {
+	ID             *int64  `json:"id,omitempty"`
+	RepoID         *int64  `json:"repo_id,omitempty"`
+	Org            *string `json:"org,omitempty"`
+	Submodules     *string `json:"submodules,omitempty"`
+	SubmoduleDepth *int64  `json:"submodule_depth,omitempty"`
+	LFS            *string `json:"lfs,omitempty"`
+	Depth          *int64  `json:"depth,omitempty"`
+	SingleBranch   *string `json:"single_branch,omitempty"`
+	Tags           *string `json:"tags,omitempty"`
+	UpdatedAt      *int64  `json:"updated_at,omitempty"`
+	UpdatedBy      *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetID() int64 {
+	// return zero value if CloneSetting type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetRepoID() int64 {
+	// return zero value if CloneSetting type or RepoID field is nil
+	if s == nil || s.RepoID == nil {
+		return 0
+	}
+
+	return *s.RepoID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetOrg() string {
+	// return zero value if CloneSetting type or Org field is nil
+	if s == nil || s.Org == nil {
+		return ""
+	}
+
+	return *s.Org
+}
+
+// GetSubmodules returns the Submodules field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetSubmodules() string {
+	// return zero value if CloneSetting type or Submodules field is nil
+	if s == nil || s.Submodules == nil {
+		return ""
+	}
+
+	return *s.Submodules
+}
+
+// GetSubmoduleDepth returns the SubmoduleDepth field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetSubmoduleDepth() int64 {
+	// return zero value if CloneSetting type or SubmoduleDepth field is nil
+	if s == nil || s.SubmoduleDepth == nil {
+		return 0
+	}
+
+	return *s.SubmoduleDepth
+}
+
+// GetLFS returns the LFS field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetLFS() string {
+	// return zero value if CloneSetting type or LFS field is nil
+	if s == nil || s.LFS == nil {
+		return ""
+	}
+
+	return *s.LFS
+}
+
+// GetDepth returns the Depth field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetDepth() int64 {
+	// return zero value if CloneSetting type or Depth field is nil
+	if s == nil || s.Depth == nil {
+		return 0
+	}
+
+	return *s.Depth
+}
+
+// GetSingleBranch returns the SingleBranch field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetSingleBranch() string {
+	// return zero value if CloneSetting type or SingleBranch field is nil
+	if s == nil || s.SingleBranch == nil {
+		return ""
+	}
+
+	return *s.SingleBranch
+}
+
+// GetTags returns the Tags field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetTags() string {
+	// return zero value if CloneSetting type or Tags field is nil
+	if s == nil || s.Tags == nil {
+		return ""
+	}
+
+	return *s.Tags
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetUpdatedAt() int64 {
+	// return zero value if CloneSetting type or UpdatedAt field is nil
+	if s == nil || s.UpdatedAt == nil {
+		return 0
+	}
+
+	return *s.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided CloneSetting type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *CloneSetting) GetUpdatedBy() string {
+	// return zero value if CloneSetting type or UpdatedBy field is nil
+	if s == nil || s.UpdatedBy == nil {
+		return ""
+	}
+
+	return *s.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetID(v int64) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetRepoID(v int64) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.RepoID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetOrg(v string) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.Org = &v
+}
+
+// SetSubmodules sets the Submodules field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetSubmodules(v string) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.Submodules = &v
+}
+
+// SetSubmoduleDepth sets the SubmoduleDepth field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetSubmoduleDepth(v int64) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.SubmoduleDepth = &v
+}
+
+// SetLFS sets the LFS field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetLFS(v string) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.LFS = &v
+}
+
+// SetDepth sets the Depth field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetDepth(v int64) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.Depth = &v
+}
+
+// SetSingleBranch sets the SingleBranch field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetSingleBranch(v string) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.SingleBranch = &v
+}
+
+// SetTags sets the Tags field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetTags(v string) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.Tags = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetUpdatedAt(v int64) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided CloneSetting type is nil, it
+// will set nothing and immediately return.
+func (s *CloneSetting) SetUpdatedBy(v string) {
+	// return if CloneSetting type is nil
+	if s == nil {
+		return
+	}
+
+	s.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the CloneSetting type.
+func (s *CloneSetting) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Org: %s,
+  Submodules: %s,
+  SubmoduleDepth: %d,
+  LFS: %s,
+  Depth: %d,
+  SingleBranch: %s,
+  Tags: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		s.GetID(),
+		s.GetRepoID(),
+		s.GetOrg(),
+		s.GetSubmodules(),
+		s.GetSubmoduleDepth(),
+		s.GetLFS(),
+		s.GetDepth(),
+		s.GetSingleBranch(),
+		s.GetTags(),
+		s.GetUpdatedAt(),
+		s.GetUpdatedBy(),
+	)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCloneSetting_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		setting *CloneSetting
+		want    *CloneSetting
+	}{
+		{
+			setting: testCloneSetting(),
+			want:    testCloneSetting(),
+		},
+		{
+			setting: new(CloneSetting),
+			want:    new(CloneSetting),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.setting.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.setting.GetID(), test.want.GetID())
+		}
+
+		if test.setting.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.setting.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.setting.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.setting.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.setting.GetSubmodules() != test.want.GetSubmodules() {
+			t.Errorf("GetSubmodules is %v, want %v", test.setting.GetSubmodules(), test.want.GetSubmodules())
+		}
+
+		if test.setting.GetSubmoduleDepth() != test.want.GetSubmoduleDepth() {
+			t.Errorf("GetSubmoduleDepth is %v, want %v", test.setting.GetSubmoduleDepth(), test.want.GetSubmoduleDepth())
+		}
+
+		if test.setting.GetLFS() != test.want.GetLFS() {
+			t.Errorf("GetLFS is %v, want %v", test.setting.GetLFS(), test.want.GetLFS())
+		}
+
+		if test.setting.GetDepth() != test.want.GetDepth() {
+			t.Errorf("GetDepth is %v, want %v", test.setting.GetDepth(), test.want.GetDepth())
+		}
+
+		if test.setting.GetSingleBranch() != test.want.GetSingleBranch() {
+			t.Errorf("GetSingleBranch is %v, want %v", test.setting.GetSingleBranch(), test.want.GetSingleBranch())
+		}
+
+		if test.setting.GetTags() != test.want.GetTags() {
+			t.Errorf("GetTags is %v, want %v", test.setting.GetTags(), test.want.GetTags())
+		}
+
+		if test.setting.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.setting.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.setting.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.setting.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestCloneSetting_Setters(t *testing.T) {
+	// setup types
+	var s *CloneSetting
+
+	// setup tests
+	tests := []struct {
+		setting *CloneSetting
+		want    *CloneSetting
+	}{
+		{
+			setting: testCloneSetting(),
+			want:    testCloneSetting(),
+		},
+		{
+			setting: s,
+			want:    new(CloneSetting),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.setting.SetID(test.want.GetID())
+		test.setting.SetRepoID(test.want.GetRepoID())
+		test.setting.SetOrg(test.want.GetOrg())
+		test.setting.SetSubmodules(test.want.GetSubmodules())
+		test.setting.SetSubmoduleDepth(test.want.GetSubmoduleDepth())
+		test.setting.SetLFS(test.want.GetLFS())
+		test.setting.SetDepth(test.want.GetDepth())
+		test.setting.SetSingleBranch(test.want.GetSingleBranch())
+		test.setting.SetTags(test.want.GetTags())
+		test.setting.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.setting.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.setting.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.setting.GetID(), test.want.GetID())
+		}
+
+		if test.setting.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.setting.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.setting.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.setting.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.setting.GetSubmodules() != test.want.GetSubmodules() {
+			t.Errorf("SetSubmodules is %v, want %v", test.setting.GetSubmodules(), test.want.GetSubmodules())
+		}
+
+		if test.setting.GetSubmoduleDepth() != test.want.GetSubmoduleDepth() {
+			t.Errorf("SetSubmoduleDepth is %v, want %v", test.setting.GetSubmoduleDepth(), test.want.GetSubmoduleDepth())
+		}
+
+		if test.setting.GetLFS() != test.want.GetLFS() {
+			t.Errorf("SetLFS is %v, want %v", test.setting.GetLFS(), test.want.GetLFS())
+		}
+
+		if test.setting.GetDepth() != test.want.GetDepth() {
+			t.Errorf("SetDepth is %v, want %v", test.setting.GetDepth(), test.want.GetDepth())
+		}
+
+		if test.setting.GetSingleBranch() != test.want.GetSingleBranch() {
+			t.Errorf("SetSingleBranch is %v, want %v", test.setting.GetSingleBranch(), test.want.GetSingleBranch())
+		}
+
+		if test.setting.GetTags() != test.want.GetTags() {
+			t.Errorf("SetTags is %v, want %v", test.setting.GetTags(), test.want.GetTags())
+		}
+
+		if test.setting.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.setting.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.setting.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.setting.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestCloneSetting_String(t *testing.T) {
+	// setup types
+	s := testCloneSetting()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Org: %s,
+  Submodules: %s,
+  SubmoduleDepth: %d,
+  LFS: %s,
+  Depth: %d,
+  SingleBranch: %s,
+  Tags: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		s.GetID(),
+		s.GetRepoID(),
+		s.GetOrg(),
+		s.GetSubmodules(),
+		s.GetSubmoduleDepth(),
+		s.GetLFS(),
+		s.GetDepth(),
+		s.GetSingleBranch(),
+		s.GetTags(),
+		s.GetUpdatedAt(),
+		s.GetUpdatedBy(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testCloneSetting is a test helper function to create a CloneSetting
+// type with all fields set to a fake value.
+func testCloneSetting() *CloneSetting {
+	s := new(CloneSetting)
+
+	s.SetID(1)
+	s.SetRepoID(1)
+	s.SetOrg("github")
+	s.SetSubmodules("auto")
+	s.SetSubmoduleDepth(1)
+	s.SetLFS("auto")
+	s.SetDepth(1)
+	s.SetSingleBranch("auto")
+	s.SetTags("auto")
+	s.SetUpdatedAt(1563474076)
+	s.SetUpdatedBy("octocat")
+
+	return s
+}
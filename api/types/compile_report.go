@@ -0,0 +1,371 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// CompileReport is the API representation of the measurements captured
+// while compiling the pipeline for a build.
+//
+// swagger:model CompileReport
+type CompileReport struct {
+	BuildID           *int64    `json:"build_id,omitempty"`
+	Repo              *string   `json:"repo,omitempty"`
+	Number            *int      `json:"number,omitempty"`
+	Duration          *int64    `json:"duration,omitempty"`
+	ConfigSize        *int64    `json:"config_size,omitempty"`
+	ExpandedSize      *int64    `json:"expanded_size,omitempty"`
+	Templates         *int      `json:"templates,omitempty"`
+	TemplateFetches   *int      `json:"template_fetches,omitempty"`
+	TemplateCacheHits *int      `json:"template_cache_hits,omitempty"`
+	CacheHitRate      *float64  `json:"cache_hit_rate,omitempty"`
+	Warnings          *[]string `json:"warnings,omitempty"`
+	Created           *int64    `json:"created,omitempty"`
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetBuildID() int64 {
+	// return zero value if CompileReport type or BuildID field is nil
+	if r == nil || r.BuildID == nil {
+		return 0
+	}
+
+	return *r.BuildID
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetRepo() string {
+	// return zero value if CompileReport type or Repo field is nil
+	if r == nil || r.Repo == nil {
+		return ""
+	}
+
+	return *r.Repo
+}
+
+// GetNumber returns the Number field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetNumber() int {
+	// return zero value if CompileReport type or Number field is nil
+	if r == nil || r.Number == nil {
+		return 0
+	}
+
+	return *r.Number
+}
+
+// GetDuration returns the Duration field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetDuration() int64 {
+	// return zero value if CompileReport type or Duration field is nil
+	if r == nil || r.Duration == nil {
+		return 0
+	}
+
+	return *r.Duration
+}
+
+// GetConfigSize returns the ConfigSize field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetConfigSize() int64 {
+	// return zero value if CompileReport type or ConfigSize field is nil
+	if r == nil || r.ConfigSize == nil {
+		return 0
+	}
+
+	return *r.ConfigSize
+}
+
+// GetExpandedSize returns the ExpandedSize field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetExpandedSize() int64 {
+	// return zero value if CompileReport type or ExpandedSize field is nil
+	if r == nil || r.ExpandedSize == nil {
+		return 0
+	}
+
+	return *r.ExpandedSize
+}
+
+// GetTemplates returns the Templates field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetTemplates() int {
+	// return zero value if CompileReport type or Templates field is nil
+	if r == nil || r.Templates == nil {
+		return 0
+	}
+
+	return *r.Templates
+}
+
+// GetTemplateFetches returns the TemplateFetches field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetTemplateFetches() int {
+	// return zero value if CompileReport type or TemplateFetches field is nil
+	if r == nil || r.TemplateFetches == nil {
+		return 0
+	}
+
+	return *r.TemplateFetches
+}
+
+// GetTemplateCacheHits returns the TemplateCacheHits field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetTemplateCacheHits() int {
+	// return zero value if CompileReport type or TemplateCacheHits field is nil
+	if r == nil || r.TemplateCacheHits == nil {
+		return 0
+	}
+
+	return *r.TemplateCacheHits
+}
+
+// GetCacheHitRate returns the CacheHitRate field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetCacheHitRate() float64 {
+	// return zero value if CompileReport type or CacheHitRate field is nil
+	if r == nil || r.CacheHitRate == nil {
+		return 0
+	}
+
+	return *r.CacheHitRate
+}
+
+// GetWarnings returns the Warnings field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetWarnings() []string {
+	// return zero value if CompileReport type or Warnings field is nil
+	if r == nil || r.Warnings == nil {
+		return []string{}
+	}
+
+	return *r.Warnings
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided CompileReport type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CompileReport) GetCreated() int64 {
+	// return zero value if CompileReport type or Created field is nil
+	if r == nil || r.Created == nil {
+		return 0
+	}
+
+	return *r.Created
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetBuildID(v int64) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.BuildID = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetRepo(v string) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.Repo = &v
+}
+
+// SetNumber sets the Number field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetNumber(v int) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.Number = &v
+}
+
+// SetDuration sets the Duration field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetDuration(v int64) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.Duration = &v
+}
+
+// SetConfigSize sets the ConfigSize field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetConfigSize(v int64) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.ConfigSize = &v
+}
+
+// SetExpandedSize sets the ExpandedSize field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetExpandedSize(v int64) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.ExpandedSize = &v
+}
+
+// SetTemplates sets the Templates field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetTemplates(v int) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.Templates = &v
+}
+
+// SetTemplateFetches sets the TemplateFetches field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetTemplateFetches(v int) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.TemplateFetches = &v
+}
+
+// SetTemplateCacheHits sets the TemplateCacheHits field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetTemplateCacheHits(v int) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.TemplateCacheHits = &v
+}
+
+// SetCacheHitRate sets the CacheHitRate field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetCacheHitRate(v float64) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.CacheHitRate = &v
+}
+
+// SetWarnings sets the Warnings field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetWarnings(v []string) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.Warnings = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided CompileReport type is nil, it
+// will set nothing and immediately return.
+func (r *CompileReport) SetCreated(v int64) {
+	// return if CompileReport type is nil
+	if r == nil {
+		return
+	}
+
+	r.Created = &v
+}
+
+// String implements the Stringer interface for the CompileReport type.
+func (r *CompileReport) String() string {
+	return fmt.Sprintf(`{
+  BuildID: %d,
+  Repo: %s,
+  Number: %d,
+  Duration: %d,
+  ConfigSize: %d,
+  ExpandedSize: %d,
+  Templates: %d,
+  TemplateFetches: %d,
+  TemplateCacheHits: %d,
+  CacheHitRate: %v,
+  Warnings: %s,
+  Created: %d,
+}`,
+		r.GetBuildID(),
+		r.GetRepo(),
+		r.GetNumber(),
+		r.GetDuration(),
+		r.GetConfigSize(),
+		r.GetExpandedSize(),
+		r.GetTemplates(),
+		r.GetTemplateFetches(),
+		r.GetTemplateCacheHits(),
+		r.GetCacheHitRate(),
+		r.GetWarnings(),
+		r.GetCreated(),
+	)
+}
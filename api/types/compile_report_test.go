@@ -0,0 +1,224 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCompileReport_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		c    *CompileReport
+		want *CompileReport
+	}{
+		{
+			c:    testCompileReport(),
+			want: testCompileReport(),
+		},
+		{
+			c:    new(CompileReport),
+			want: new(CompileReport),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.c.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.c.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.c.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.c.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.c.GetNumber() != test.want.GetNumber() {
+			t.Errorf("GetNumber is %v, want %v", test.c.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.c.GetDuration() != test.want.GetDuration() {
+			t.Errorf("GetDuration is %v, want %v", test.c.GetDuration(), test.want.GetDuration())
+		}
+
+		if test.c.GetConfigSize() != test.want.GetConfigSize() {
+			t.Errorf("GetConfigSize is %v, want %v", test.c.GetConfigSize(), test.want.GetConfigSize())
+		}
+
+		if test.c.GetExpandedSize() != test.want.GetExpandedSize() {
+			t.Errorf("GetExpandedSize is %v, want %v", test.c.GetExpandedSize(), test.want.GetExpandedSize())
+		}
+
+		if test.c.GetTemplates() != test.want.GetTemplates() {
+			t.Errorf("GetTemplates is %v, want %v", test.c.GetTemplates(), test.want.GetTemplates())
+		}
+
+		if test.c.GetTemplateFetches() != test.want.GetTemplateFetches() {
+			t.Errorf("GetTemplateFetches is %v, want %v", test.c.GetTemplateFetches(), test.want.GetTemplateFetches())
+		}
+
+		if test.c.GetTemplateCacheHits() != test.want.GetTemplateCacheHits() {
+			t.Errorf("GetTemplateCacheHits is %v, want %v", test.c.GetTemplateCacheHits(), test.want.GetTemplateCacheHits())
+		}
+
+		if test.c.GetCacheHitRate() != test.want.GetCacheHitRate() {
+			t.Errorf("GetCacheHitRate is %v, want %v", test.c.GetCacheHitRate(), test.want.GetCacheHitRate())
+		}
+
+		if !reflect.DeepEqual(test.c.GetWarnings(), test.want.GetWarnings()) {
+			t.Errorf("GetWarnings is %v, want %v", test.c.GetWarnings(), test.want.GetWarnings())
+		}
+
+		if test.c.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.c.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestCompileReport_Setters(t *testing.T) {
+	// setup types
+	var c *CompileReport
+
+	// setup tests
+	tests := []struct {
+		c    *CompileReport
+		want *CompileReport
+	}{
+		{
+			c:    testCompileReport(),
+			want: testCompileReport(),
+		},
+		{
+			c:    c,
+			want: new(CompileReport),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.c.SetBuildID(test.want.GetBuildID())
+		test.c.SetRepo(test.want.GetRepo())
+		test.c.SetNumber(test.want.GetNumber())
+		test.c.SetDuration(test.want.GetDuration())
+		test.c.SetConfigSize(test.want.GetConfigSize())
+		test.c.SetExpandedSize(test.want.GetExpandedSize())
+		test.c.SetTemplates(test.want.GetTemplates())
+		test.c.SetTemplateFetches(test.want.GetTemplateFetches())
+		test.c.SetTemplateCacheHits(test.want.GetTemplateCacheHits())
+		test.c.SetCacheHitRate(test.want.GetCacheHitRate())
+		test.c.SetWarnings(test.want.GetWarnings())
+		test.c.SetCreated(test.want.GetCreated())
+
+		if test.c.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.c.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.c.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.c.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.c.GetNumber() != test.want.GetNumber() {
+			t.Errorf("SetNumber is %v, want %v", test.c.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.c.GetDuration() != test.want.GetDuration() {
+			t.Errorf("SetDuration is %v, want %v", test.c.GetDuration(), test.want.GetDuration())
+		}
+
+		if test.c.GetConfigSize() != test.want.GetConfigSize() {
+			t.Errorf("SetConfigSize is %v, want %v", test.c.GetConfigSize(), test.want.GetConfigSize())
+		}
+
+		if test.c.GetExpandedSize() != test.want.GetExpandedSize() {
+			t.Errorf("SetExpandedSize is %v, want %v", test.c.GetExpandedSize(), test.want.GetExpandedSize())
+		}
+
+		if test.c.GetTemplates() != test.want.GetTemplates() {
+			t.Errorf("SetTemplates is %v, want %v", test.c.GetTemplates(), test.want.GetTemplates())
+		}
+
+		if test.c.GetTemplateFetches() != test.want.GetTemplateFetches() {
+			t.Errorf("SetTemplateFetches is %v, want %v", test.c.GetTemplateFetches(), test.want.GetTemplateFetches())
+		}
+
+		if test.c.GetTemplateCacheHits() != test.want.GetTemplateCacheHits() {
+			t.Errorf("SetTemplateCacheHits is %v, want %v", test.c.GetTemplateCacheHits(), test.want.GetTemplateCacheHits())
+		}
+
+		if test.c.GetCacheHitRate() != test.want.GetCacheHitRate() {
+			t.Errorf("SetCacheHitRate is %v, want %v", test.c.GetCacheHitRate(), test.want.GetCacheHitRate())
+		}
+
+		if !reflect.DeepEqual(test.c.GetWarnings(), test.want.GetWarnings()) {
+			t.Errorf("SetWarnings is %v, want %v", test.c.GetWarnings(), test.want.GetWarnings())
+		}
+
+		if test.c.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.c.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestCompileReport_String(t *testing.T) {
+	// setup types
+	c := testCompileReport()
+
+	want := fmt.Sprintf(`{
+  BuildID: %d,
+  Repo: %s,
+  Number: %d,
+  Duration: %d,
+  ConfigSize: %d,
+  ExpandedSize: %d,
+  Templates: %d,
+  TemplateFetches: %d,
+  TemplateCacheHits: %d,
+  CacheHitRate: %v,
+  Warnings: %s,
+  Created: %d,
+}`,
+		c.GetBuildID(),
+		c.GetRepo(),
+		c.GetNumber(),
+		c.GetDuration(),
+		c.GetConfigSize(),
+		c.GetExpandedSize(),
+		c.GetTemplates(),
+		c.GetTemplateFetches(),
+		c.GetTemplateCacheHits(),
+		c.GetCacheHitRate(),
+		c.GetWarnings(),
+		c.GetCreated(),
+	)
+
+	// run test
+	got := c.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testCompileReport is a test helper function to create a CompileReport
+// type with all fields set to a fake value.
+func testCompileReport() *CompileReport {
+	c := new(CompileReport)
+
+	c.SetBuildID(1)
+	c.SetRepo("github/octocat")
+	c.SetNumber(1)
+	c.SetDuration(250)
+	c.SetConfigSize(512)
+	c.SetExpandedSize(2048)
+	c.SetTemplates(2)
+	c.SetTemplateFetches(2)
+	c.SetTemplateCacheHits(3)
+	c.SetCacheHitRate(0.6)
+	c.SetWarnings([]string{"template github/octocat/template.yml@v1 is deprecated"})
+	c.SetCreated(1563474077)
+
+	return c
+}
@@ -0,0 +1,292 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// CostRateDefault represents the class of the rate used for
+	// builds that ran on workers without a route matching a class.
+	CostRateDefault = "default"
+)
+
+// CostRate is the API representation of the cost in dollars per
+// build minute for a class of workers. The class matches the
+// routes of the workers that builds run on.
+//
+// swagger:model CostRate
+type CostRate struct {
+	ID          *int64   `json:"id,omitempty"`
+	Class       *string  `json:"class,omitempty"`
+	Rate        *float64 `json:"rate,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	CreatedAt   *int64   `json:"created_at,omitempty"`
+	CreatedBy   *string  `json:"created_by,omitempty"`
+	UpdatedAt   *int64   `json:"updated_at,omitempty"`
+	UpdatedBy   *string  `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetID() int64 {
+	// return zero value if CostRate type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetClass returns the Class field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetClass() string {
+	// return zero value if CostRate type or Class field is nil
+	if r == nil || r.Class == nil {
+		return ""
+	}
+
+	return *r.Class
+}
+
+// GetRate returns the Rate field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetRate() float64 {
+	// return zero value if CostRate type or Rate field is nil
+	if r == nil || r.Rate == nil {
+		return 0
+	}
+
+	return *r.Rate
+}
+
+// GetDescription returns the Description field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetDescription() string {
+	// return zero value if CostRate type or Description field is nil
+	if r == nil || r.Description == nil {
+		return ""
+	}
+
+	return *r.Description
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetCreatedAt() int64 {
+	// return zero value if CostRate type or CreatedAt field is nil
+	if r == nil || r.CreatedAt == nil {
+		return 0
+	}
+
+	return *r.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetCreatedBy() string {
+	// return zero value if CostRate type or CreatedBy field is nil
+	if r == nil || r.CreatedBy == nil {
+		return ""
+	}
+
+	return *r.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetUpdatedAt() int64 {
+	// return zero value if CostRate type or UpdatedAt field is nil
+	if r == nil || r.UpdatedAt == nil {
+		return 0
+	}
+
+	return *r.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided CostRate type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *CostRate) GetUpdatedBy() string {
+	// return zero value if CostRate type or UpdatedBy field is nil
+	if r == nil || r.UpdatedBy == nil {
+		return ""
+	}
+
+	return *r.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetID(v int64) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetClass sets the Class field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetClass(v string) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.Class = &v
+}
+
+// SetRate sets the Rate field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetRate(v float64) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.Rate = &v
+}
+
+// SetDescription sets the Description field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetDescription(v string) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.Description = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetCreatedAt(v int64) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetCreatedBy(v string) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetUpdatedAt(v int64) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided CostRate type is nil, it
+// will set nothing and immediately return.
+func (r *CostRate) SetUpdatedBy(v string) {
+	// return if CostRate type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the CostRate type.
+func (r *CostRate) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Class: %s,
+  Rate: %v,
+  Description: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetClass(),
+		r.GetRate(),
+		r.GetDescription(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+}
+
+// RateFor returns the rate for the class of a worker with the
+// provided routes. When more than one route matches a class, the
+// highest rate is returned so the cost is not underestimated. The
+// default rate is returned when no route matches a class, or nil
+// when no default rate exists.
+func RateFor(routes []string, rates []*CostRate) *CostRate {
+	var match, fallback *CostRate
+
+	for _, rate := range rates {
+		if strings.EqualFold(rate.GetClass(), CostRateDefault) {
+			fallback = rate
+
+			continue
+		}
+
+		for _, route := range routes {
+			if strings.EqualFold(rate.GetClass(), route) && (match == nil || rate.GetRate() > match.GetRate()) {
+				match = rate
+			}
+		}
+	}
+
+	if match != nil {
+		return match
+	}
+
+	return fallback
+}
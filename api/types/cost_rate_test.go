@@ -0,0 +1,247 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCostRate_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rate *CostRate
+		want *CostRate
+	}{
+		{
+			rate: testCostRate(),
+			want: testCostRate(),
+		},
+		{
+			rate: new(CostRate),
+			want: new(CostRate),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.rate.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.rate.GetID(), test.want.GetID())
+		}
+
+		if test.rate.GetClass() != test.want.GetClass() {
+			t.Errorf("GetClass is %v, want %v", test.rate.GetClass(), test.want.GetClass())
+		}
+
+		if test.rate.GetRate() != test.want.GetRate() {
+			t.Errorf("GetRate is %v, want %v", test.rate.GetRate(), test.want.GetRate())
+		}
+
+		if test.rate.GetDescription() != test.want.GetDescription() {
+			t.Errorf("GetDescription is %v, want %v", test.rate.GetDescription(), test.want.GetDescription())
+		}
+
+		if test.rate.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.rate.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rate.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.rate.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rate.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.rate.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rate.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.rate.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestCostRate_Setters(t *testing.T) {
+	// setup types
+	var r *CostRate
+
+	// setup tests
+	tests := []struct {
+		rate *CostRate
+		want *CostRate
+	}{
+		{
+			rate: testCostRate(),
+			want: testCostRate(),
+		},
+		{
+			rate: r,
+			want: new(CostRate),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.rate.SetID(test.want.GetID())
+		test.rate.SetClass(test.want.GetClass())
+		test.rate.SetRate(test.want.GetRate())
+		test.rate.SetDescription(test.want.GetDescription())
+		test.rate.SetCreatedAt(test.want.GetCreatedAt())
+		test.rate.SetCreatedBy(test.want.GetCreatedBy())
+		test.rate.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.rate.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.rate.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.rate.GetID(), test.want.GetID())
+		}
+
+		if test.rate.GetClass() != test.want.GetClass() {
+			t.Errorf("SetClass is %v, want %v", test.rate.GetClass(), test.want.GetClass())
+		}
+
+		if test.rate.GetRate() != test.want.GetRate() {
+			t.Errorf("SetRate is %v, want %v", test.rate.GetRate(), test.want.GetRate())
+		}
+
+		if test.rate.GetDescription() != test.want.GetDescription() {
+			t.Errorf("SetDescription is %v, want %v", test.rate.GetDescription(), test.want.GetDescription())
+		}
+
+		if test.rate.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.rate.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rate.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.rate.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rate.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.rate.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rate.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.rate.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestCostRate_RateFor(t *testing.T) {
+	// setup types
+	small := testCostRate()
+	small.SetClass("vela")
+	small.SetRate(0.008)
+
+	large := testCostRate()
+	large.SetClass("large:linux")
+	large.SetRate(0.016)
+
+	free := testCostRate()
+	free.SetClass("donated")
+	free.SetRate(0)
+
+	fallback := testCostRate()
+	fallback.SetClass(CostRateDefault)
+	fallback.SetRate(0.004)
+
+	rates := []*CostRate{fallback, small, large, free}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		routes []string
+		rates  []*CostRate
+		want   *CostRate
+	}{
+		{
+			name:   "match",
+			routes: []string{"vela"},
+			rates:  rates,
+			want:   small,
+		},
+		{
+			name:   "highest match",
+			routes: []string{"vela", "Large:Linux"},
+			rates:  rates,
+			want:   large,
+		},
+		{
+			name:   "zero rate match",
+			routes: []string{"donated"},
+			rates:  rates,
+			want:   free,
+		},
+		{
+			name:   "default",
+			routes: []string{"gpu"},
+			rates:  rates,
+			want:   fallback,
+		},
+		{
+			name:   "no default",
+			routes: []string{"gpu"},
+			rates:  []*CostRate{small, large},
+			want:   nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := RateFor(test.routes, test.rates)
+
+			if got != test.want {
+				t.Errorf("RateFor is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCostRate_String(t *testing.T) {
+	// setup types
+	r := testCostRate()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Class: %s,
+  Rate: %v,
+  Description: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetClass(),
+		r.GetRate(),
+		r.GetDescription(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testCostRate is a test helper function to create a CostRate
+// type with all fields set to a fake value.
+func testCostRate() *CostRate {
+	r := new(CostRate)
+
+	r.SetID(1)
+	r.SetClass("large:linux")
+	r.SetRate(0.016)
+	r.SetDescription("8 vCPU linux workers")
+	r.SetCreatedAt(1563474076)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	return r
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Deprecation is the API representation of a deprecated image,
+// template or pipeline syntax used by a build that matched a
+// deprecation rule when the build was compiled.
+//
+// swagger:model Deprecation
+type Deprecation struct {
+	RuleID  *int64  `json:"rule_id,omitempty"`
+	Kind    *string `json:"kind,omitempty"`
+	Subject *string `json:"subject,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Sunset  *int64  `json:"sunset,omitempty"`
+	Comment *bool   `json:"comment,omitempty"`
+}
+
+// GetRuleID returns the RuleID field.
+//
+// When the provided Deprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *Deprecation) GetRuleID() int64 {
+	// return zero value if Deprecation type or RuleID field is nil
+	if d == nil || d.RuleID == nil {
+		return 0
+	}
+
+	return *d.RuleID
+}
+
+// GetKind returns the Kind field.
+//
+// When the provided Deprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *Deprecation) GetKind() string {
+	// return zero value if Deprecation type or Kind field is nil
+	if d == nil || d.Kind == nil {
+		return ""
+	}
+
+	return *d.Kind
+}
+
+// GetSubject returns the Subject field.
+//
+// When the provided Deprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *Deprecation) GetSubject() string {
+	// return zero value if Deprecation type or Subject field is nil
+	if d == nil || d.Subject == nil {
+		return ""
+	}
+
+	return *d.Subject
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided Deprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *Deprecation) GetMessage() string {
+	// return zero value if Deprecation type or Message field is nil
+	if d == nil || d.Message == nil {
+		return ""
+	}
+
+	return *d.Message
+}
+
+// GetSunset returns the Sunset field.
+//
+// When the provided Deprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *Deprecation) GetSunset() int64 {
+	// return zero value if Deprecation type or Sunset field is nil
+	if d == nil || d.Sunset == nil {
+		return 0
+	}
+
+	return *d.Sunset
+}
+
+// GetComment returns the Comment field.
+//
+// When the provided Deprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *Deprecation) GetComment() bool {
+	// return zero value if Deprecation type or Comment field is nil
+	if d == nil || d.Comment == nil {
+		return false
+	}
+
+	return *d.Comment
+}
+
+// SetRuleID sets the RuleID field.
+//
+// When the provided Deprecation type is nil, it
+// will set nothing and immediately return.
+func (d *Deprecation) SetRuleID(v int64) {
+	// return if Deprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.RuleID = &v
+}
+
+// SetKind sets the Kind field.
+//
+// When the provided Deprecation type is nil, it
+// will set nothing and immediately return.
+func (d *Deprecation) SetKind(v string) {
+	// return if Deprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Kind = &v
+}
+
+// SetSubject sets the Subject field.
+//
+// When the provided Deprecation type is nil, it
+// will set nothing and immediately return.
+func (d *Deprecation) SetSubject(v string) {
+	// return if Deprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Subject = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided Deprecation type is nil, it
+// will set nothing and immediately return.
+func (d *Deprecation) SetMessage(v string) {
+	// return if Deprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Message = &v
+}
+
+// SetSunset sets the Sunset field.
+//
+// When the provided Deprecation type is nil, it
+// will set nothing and immediately return.
+func (d *Deprecation) SetSunset(v int64) {
+	// return if Deprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Sunset = &v
+}
+
+// SetComment sets the Comment field.
+//
+// When the provided Deprecation type is nil, it
+// will set nothing and immediately return.
+func (d *Deprecation) SetComment(v bool) {
+	// return if Deprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Comment = &v
+}
+
+// Warning returns the warning for the Deprecation type
+// attached to the build that used the deprecated subject.
+func (d *Deprecation) Warning() string {
+	w := fmt.Sprintf("%s %s is deprecated", d.GetKind(), d.GetSubject())
+
+	if d.GetSunset() > 0 {
+		w = fmt.Sprintf("%s and sunsets on %s", w, time.Unix(d.GetSunset(), 0).UTC().Format("2006-01-02"))
+	}
+
+	if len(d.GetMessage()) > 0 {
+		w = fmt.Sprintf("%s: %s", w, d.GetMessage())
+	}
+
+	return w
+}
+
+// String implements the Stringer interface for the Deprecation type.
+func (d *Deprecation) String() string {
+	return fmt.Sprintf(`{
+  RuleID: %d,
+  Kind: %s,
+  Subject: %s,
+  Message: %s,
+  Sunset: %d,
+  Comment: %t,
+}`,
+		d.GetRuleID(),
+		d.GetKind(),
+		d.GetSubject(),
+		d.GetMessage(),
+		d.GetSunset(),
+		d.GetComment(),
+	)
+}
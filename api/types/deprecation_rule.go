@@ -0,0 +1,358 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// DeprecationKindImage represents a rule matching
+	// the images for the steps and services of a build.
+	DeprecationKindImage = "image"
+
+	// DeprecationKindTemplate represents a rule matching
+	// the sources of the templates for a build.
+	DeprecationKindTemplate = "template"
+
+	// DeprecationKindSyntax represents a rule matching
+	// a key declared in the pipeline for a build.
+	DeprecationKindSyntax = "syntax"
+)
+
+// DeprecationRule is the API representation of a rule registered by
+// an admin that attaches a warning to the builds using a deprecated
+// image, template or pipeline syntax. Builds matching the rule fail
+// to compile once the sunset for the rule has passed.
+//
+// swagger:model DeprecationRule
+type DeprecationRule struct {
+	ID        *int64  `json:"id,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Pattern   *string `json:"pattern,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	Sunset    *int64  `json:"sunset,omitempty"`
+	Comment   *bool   `json:"comment,omitempty"`
+	Active    *bool   `json:"active,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	UpdatedAt *int64  `json:"updated_at,omitempty"`
+	UpdatedBy *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetID() int64 {
+	// return zero value if DeprecationRule type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetKind returns the Kind field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetKind() string {
+	// return zero value if DeprecationRule type or Kind field is nil
+	if r == nil || r.Kind == nil {
+		return ""
+	}
+
+	return *r.Kind
+}
+
+// GetPattern returns the Pattern field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetPattern() string {
+	// return zero value if DeprecationRule type or Pattern field is nil
+	if r == nil || r.Pattern == nil {
+		return ""
+	}
+
+	return *r.Pattern
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetMessage() string {
+	// return zero value if DeprecationRule type or Message field is nil
+	if r == nil || r.Message == nil {
+		return ""
+	}
+
+	return *r.Message
+}
+
+// GetSunset returns the Sunset field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetSunset() int64 {
+	// return zero value if DeprecationRule type or Sunset field is nil
+	if r == nil || r.Sunset == nil {
+		return 0
+	}
+
+	return *r.Sunset
+}
+
+// GetComment returns the Comment field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetComment() bool {
+	// return zero value if DeprecationRule type or Comment field is nil
+	if r == nil || r.Comment == nil {
+		return false
+	}
+
+	return *r.Comment
+}
+
+// GetActive returns the Active field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetActive() bool {
+	// return zero value if DeprecationRule type or Active field is nil
+	if r == nil || r.Active == nil {
+		return false
+	}
+
+	return *r.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetCreatedAt() int64 {
+	// return zero value if DeprecationRule type or CreatedAt field is nil
+	if r == nil || r.CreatedAt == nil {
+		return 0
+	}
+
+	return *r.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetCreatedBy() string {
+	// return zero value if DeprecationRule type or CreatedBy field is nil
+	if r == nil || r.CreatedBy == nil {
+		return ""
+	}
+
+	return *r.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetUpdatedAt() int64 {
+	// return zero value if DeprecationRule type or UpdatedAt field is nil
+	if r == nil || r.UpdatedAt == nil {
+		return 0
+	}
+
+	return *r.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided DeprecationRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *DeprecationRule) GetUpdatedBy() string {
+	// return zero value if DeprecationRule type or UpdatedBy field is nil
+	if r == nil || r.UpdatedBy == nil {
+		return ""
+	}
+
+	return *r.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetID(v int64) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetKind sets the Kind field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetKind(v string) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.Kind = &v
+}
+
+// SetPattern sets the Pattern field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetPattern(v string) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.Pattern = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetMessage(v string) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.Message = &v
+}
+
+// SetSunset sets the Sunset field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetSunset(v int64) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.Sunset = &v
+}
+
+// SetComment sets the Comment field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetComment(v bool) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.Comment = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetActive(v bool) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetCreatedAt(v int64) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetCreatedBy(v string) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetUpdatedAt(v int64) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided DeprecationRule type is nil, it
+// will set nothing and immediately return.
+func (r *DeprecationRule) SetUpdatedBy(v string) {
+	// return if DeprecationRule type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the DeprecationRule type.
+func (r *DeprecationRule) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Kind: %s,
+  Pattern: %s,
+  Message: %s,
+  Sunset: %d,
+  Comment: %t,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetKind(),
+		r.GetPattern(),
+		r.GetMessage(),
+		r.GetSunset(),
+		r.GetComment(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestDeprecationRule_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rule *DeprecationRule
+		want *DeprecationRule
+	}{
+		{
+			rule: testDeprecationRule(),
+			want: testDeprecationRule(),
+		},
+		{
+			rule: new(DeprecationRule),
+			want: new(DeprecationRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.rule.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.rule.GetID(), test.want.GetID())
+		}
+
+		if test.rule.GetKind() != test.want.GetKind() {
+			t.Errorf("GetKind is %v, want %v", test.rule.GetKind(), test.want.GetKind())
+		}
+
+		if test.rule.GetPattern() != test.want.GetPattern() {
+			t.Errorf("GetPattern is %v, want %v", test.rule.GetPattern(), test.want.GetPattern())
+		}
+
+		if test.rule.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.rule.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.rule.GetSunset() != test.want.GetSunset() {
+			t.Errorf("GetSunset is %v, want %v", test.rule.GetSunset(), test.want.GetSunset())
+		}
+
+		if test.rule.GetComment() != test.want.GetComment() {
+			t.Errorf("GetComment is %v, want %v", test.rule.GetComment(), test.want.GetComment())
+		}
+
+		if test.rule.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.rule.GetActive(), test.want.GetActive())
+		}
+
+		if test.rule.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.rule.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rule.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.rule.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rule.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.rule.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rule.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.rule.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestDeprecationRule_Setters(t *testing.T) {
+	// setup types
+	var r *DeprecationRule
+
+	// setup tests
+	tests := []struct {
+		rule *DeprecationRule
+		want *DeprecationRule
+	}{
+		{
+			rule: testDeprecationRule(),
+			want: testDeprecationRule(),
+		},
+		{
+			rule: r,
+			want: new(DeprecationRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.rule.SetID(test.want.GetID())
+		test.rule.SetKind(test.want.GetKind())
+		test.rule.SetPattern(test.want.GetPattern())
+		test.rule.SetMessage(test.want.GetMessage())
+		test.rule.SetSunset(test.want.GetSunset())
+		test.rule.SetComment(test.want.GetComment())
+		test.rule.SetActive(test.want.GetActive())
+		test.rule.SetCreatedAt(test.want.GetCreatedAt())
+		test.rule.SetCreatedBy(test.want.GetCreatedBy())
+		test.rule.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.rule.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.rule.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.rule.GetID(), test.want.GetID())
+		}
+
+		if test.rule.GetKind() != test.want.GetKind() {
+			t.Errorf("SetKind is %v, want %v", test.rule.GetKind(), test.want.GetKind())
+		}
+
+		if test.rule.GetPattern() != test.want.GetPattern() {
+			t.Errorf("SetPattern is %v, want %v", test.rule.GetPattern(), test.want.GetPattern())
+		}
+
+		if test.rule.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.rule.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.rule.GetSunset() != test.want.GetSunset() {
+			t.Errorf("SetSunset is %v, want %v", test.rule.GetSunset(), test.want.GetSunset())
+		}
+
+		if test.rule.GetComment() != test.want.GetComment() {
+			t.Errorf("SetComment is %v, want %v", test.rule.GetComment(), test.want.GetComment())
+		}
+
+		if test.rule.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.rule.GetActive(), test.want.GetActive())
+		}
+
+		if test.rule.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.rule.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rule.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.rule.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rule.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.rule.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rule.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.rule.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestDeprecationRule_String(t *testing.T) {
+	// setup types
+	r := testDeprecationRule()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Kind: %s,
+  Pattern: %s,
+  Message: %s,
+  Sunset: %d,
+  Comment: %t,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetKind(),
+		r.GetPattern(),
+		r.GetMessage(),
+		r.GetSunset(),
+		r.GetComment(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testDeprecationRule is a test helper function to create a DeprecationRule
+// type with all fields set to a fake value.
+func testDeprecationRule() *DeprecationRule {
+	r := new(DeprecationRule)
+
+	r.SetID(1)
+	r.SetKind("image")
+	r.SetPattern("golang:1.1*")
+	r.SetMessage("upgrade to golang:1.21")
+	r.SetSunset(1704067200)
+	r.SetComment(true)
+	r.SetActive(true)
+	r.SetCreatedAt(1563474076)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	return r
+}
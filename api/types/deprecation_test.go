@@ -0,0 +1,183 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestDeprecation_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		deprecation *Deprecation
+		want        *Deprecation
+	}{
+		{
+			deprecation: testDeprecation(),
+			want:        testDeprecation(),
+		},
+		{
+			deprecation: new(Deprecation),
+			want:        new(Deprecation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.deprecation.GetRuleID() != test.want.GetRuleID() {
+			t.Errorf("GetRuleID is %v, want %v", test.deprecation.GetRuleID(), test.want.GetRuleID())
+		}
+
+		if test.deprecation.GetKind() != test.want.GetKind() {
+			t.Errorf("GetKind is %v, want %v", test.deprecation.GetKind(), test.want.GetKind())
+		}
+
+		if test.deprecation.GetSubject() != test.want.GetSubject() {
+			t.Errorf("GetSubject is %v, want %v", test.deprecation.GetSubject(), test.want.GetSubject())
+		}
+
+		if test.deprecation.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.deprecation.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.deprecation.GetSunset() != test.want.GetSunset() {
+			t.Errorf("GetSunset is %v, want %v", test.deprecation.GetSunset(), test.want.GetSunset())
+		}
+
+		if test.deprecation.GetComment() != test.want.GetComment() {
+			t.Errorf("GetComment is %v, want %v", test.deprecation.GetComment(), test.want.GetComment())
+		}
+	}
+}
+
+func TestDeprecation_Setters(t *testing.T) {
+	// setup types
+	var d *Deprecation
+
+	// setup tests
+	tests := []struct {
+		deprecation *Deprecation
+		want        *Deprecation
+	}{
+		{
+			deprecation: testDeprecation(),
+			want:        testDeprecation(),
+		},
+		{
+			deprecation: d,
+			want:        new(Deprecation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.deprecation.SetRuleID(test.want.GetRuleID())
+		test.deprecation.SetKind(test.want.GetKind())
+		test.deprecation.SetSubject(test.want.GetSubject())
+		test.deprecation.SetMessage(test.want.GetMessage())
+		test.deprecation.SetSunset(test.want.GetSunset())
+		test.deprecation.SetComment(test.want.GetComment())
+
+		if test.deprecation.GetRuleID() != test.want.GetRuleID() {
+			t.Errorf("SetRuleID is %v, want %v", test.deprecation.GetRuleID(), test.want.GetRuleID())
+		}
+
+		if test.deprecation.GetKind() != test.want.GetKind() {
+			t.Errorf("SetKind is %v, want %v", test.deprecation.GetKind(), test.want.GetKind())
+		}
+
+		if test.deprecation.GetSubject() != test.want.GetSubject() {
+			t.Errorf("SetSubject is %v, want %v", test.deprecation.GetSubject(), test.want.GetSubject())
+		}
+
+		if test.deprecation.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.deprecation.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.deprecation.GetSunset() != test.want.GetSunset() {
+			t.Errorf("SetSunset is %v, want %v", test.deprecation.GetSunset(), test.want.GetSunset())
+		}
+
+		if test.deprecation.GetComment() != test.want.GetComment() {
+			t.Errorf("SetComment is %v, want %v", test.deprecation.GetComment(), test.want.GetComment())
+		}
+	}
+}
+
+func TestDeprecation_String(t *testing.T) {
+	// setup types
+	d := testDeprecation()
+
+	want := fmt.Sprintf(`{
+  RuleID: %d,
+  Kind: %s,
+  Subject: %s,
+  Message: %s,
+  Sunset: %d,
+  Comment: %t,
+}`,
+		d.GetRuleID(),
+		d.GetKind(),
+		d.GetSubject(),
+		d.GetMessage(),
+		d.GetSunset(),
+		d.GetComment(),
+	)
+
+	// run test
+	got := d.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+func TestDeprecation_Warning(t *testing.T) {
+	// setup types
+	noSunset := testDeprecation()
+	noSunset.SetSunset(0)
+	noSunset.SetMessage("")
+
+	// setup tests
+	tests := []struct {
+		deprecation *Deprecation
+		want        string
+	}{
+		{
+			deprecation: testDeprecation(),
+			want:        "image golang:1.15 is deprecated and sunsets on 2024-01-01: upgrade to golang:1.21",
+		},
+		{
+			deprecation: noSunset,
+			want:        "image golang:1.15 is deprecated",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.deprecation.Warning()
+
+		if got != test.want {
+			t.Errorf("Warning is %v, want %v", got, test.want)
+		}
+	}
+}
+
+// testDeprecation is a test helper function to create a Deprecation
+// type with all fields set to a fake value.
+func testDeprecation() *Deprecation {
+	d := new(Deprecation)
+
+	d.SetRuleID(1)
+	d.SetKind("image")
+	d.SetSubject("golang:1.15")
+	d.SetMessage("upgrade to golang:1.21")
+	d.SetSunset(1704067200)
+	d.SetComment(true)
+
+	return d
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package types provides the API representations
+// of resources managed by the Vela server.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/api/types"
+package types
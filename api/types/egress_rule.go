@@ -0,0 +1,445 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+const (
+	// EgressActionAllow represents a rule that allows
+	// outbound network access to the destination.
+	EgressActionAllow = "allow"
+
+	// EgressActionDeny represents a rule that denies
+	// outbound network access to the destination.
+	EgressActionDeny = "deny"
+
+	// EgressDestinationAny represents a destination
+	// that matches every outbound network destination.
+	EgressDestinationAny = "*"
+)
+
+// EgressRule is the API representation of a rule of the policy
+// for outbound network access from the steps of the builds for an org.
+//
+// swagger:model EgressRule
+type EgressRule struct {
+	ID          *int64  `json:"id,omitempty"`
+	Org         *string `json:"org,omitempty"`
+	Action      *string `json:"action,omitempty"`
+	Destination *string `json:"destination,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Active      *bool   `json:"active,omitempty"`
+	CreatedAt   *int64  `json:"created_at,omitempty"`
+	CreatedBy   *string `json:"created_by,omitempty"`
+	UpdatedAt   *int64  `json:"updated_at,omitempty"`
+	UpdatedBy   *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetID() int64 {
+	// return zero value if EgressRule type or ID field is nil
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetOrg() string {
+	// return zero value if EgressRule type or Org field is nil
+	if e == nil || e.Org == nil {
+		return ""
+	}
+
+	return *e.Org
+}
+
+// GetAction returns the Action field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetAction() string {
+	// return zero value if EgressRule type or Action field is nil
+	if e == nil || e.Action == nil {
+		return ""
+	}
+
+	return *e.Action
+}
+
+// GetDestination returns the Destination field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetDestination() string {
+	// return zero value if EgressRule type or Destination field is nil
+	if e == nil || e.Destination == nil {
+		return ""
+	}
+
+	return *e.Destination
+}
+
+// GetDescription returns the Description field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetDescription() string {
+	// return zero value if EgressRule type or Description field is nil
+	if e == nil || e.Description == nil {
+		return ""
+	}
+
+	return *e.Description
+}
+
+// GetActive returns the Active field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetActive() bool {
+	// return zero value if EgressRule type or Active field is nil
+	if e == nil || e.Active == nil {
+		return false
+	}
+
+	return *e.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetCreatedAt() int64 {
+	// return zero value if EgressRule type or CreatedAt field is nil
+	if e == nil || e.CreatedAt == nil {
+		return 0
+	}
+
+	return *e.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetCreatedBy() string {
+	// return zero value if EgressRule type or CreatedBy field is nil
+	if e == nil || e.CreatedBy == nil {
+		return ""
+	}
+
+	return *e.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetUpdatedAt() int64 {
+	// return zero value if EgressRule type or UpdatedAt field is nil
+	if e == nil || e.UpdatedAt == nil {
+		return 0
+	}
+
+	return *e.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided EgressRule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EgressRule) GetUpdatedBy() string {
+	// return zero value if EgressRule type or UpdatedBy field is nil
+	if e == nil || e.UpdatedBy == nil {
+		return ""
+	}
+
+	return *e.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetID(v int64) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetOrg(v string) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.Org = &v
+}
+
+// SetAction sets the Action field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetAction(v string) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.Action = &v
+}
+
+// SetDestination sets the Destination field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetDestination(v string) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.Destination = &v
+}
+
+// SetDescription sets the Description field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetDescription(v string) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.Description = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetActive(v bool) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetCreatedAt(v int64) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetCreatedBy(v string) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetUpdatedAt(v int64) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided EgressRule type is nil, it
+// will set nothing and immediately return.
+func (e *EgressRule) SetUpdatedBy(v string) {
+	// return if EgressRule type is nil
+	if e == nil {
+		return
+	}
+
+	e.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the EgressRule type.
+func (e *EgressRule) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Action: %s,
+  Destination: %s,
+  Description: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		e.GetID(),
+		e.GetOrg(),
+		e.GetAction(),
+		e.GetDestination(),
+		e.GetDescription(),
+		e.GetActive(),
+		e.GetCreatedAt(),
+		e.GetCreatedBy(),
+		e.GetUpdatedAt(),
+		e.GetUpdatedBy(),
+	)
+}
+
+// Covers returns true when the Destination field of the provided
+// EgressRule type matches every address of the provided destination.
+//
+// Destinations are either a hostname, a hostname with a leading
+// wildcard label (*.example.com), an IP address or a CIDR block,
+// each optionally followed by a port for hostnames. The "*"
+// destination matches every destination.
+func (e *EgressRule) Covers(destination string) bool {
+	pattern := e.GetDestination()
+
+	if pattern == EgressDestinationAny {
+		return true
+	}
+
+	if destination == EgressDestinationAny {
+		return false
+	}
+
+	// compare the destinations as networks when both are addresses
+	network, err := parsePrefix(pattern)
+	if err == nil {
+		prefix, err := parsePrefix(destination)
+		if err != nil {
+			return false
+		}
+
+		return network.Bits() <= prefix.Bits() && network.Contains(prefix.Addr())
+	}
+
+	host, port := splitDestination(pattern)
+	destHost, destPort := splitDestination(destination)
+
+	// a rule with a port only matches the same port
+	if len(port) > 0 && port != destPort {
+		return false
+	}
+
+	if strings.EqualFold(host, destHost) {
+		return true
+	}
+
+	// a rule with a wildcard label matches every subdomain
+	if strings.HasPrefix(host, "*.") {
+		return strings.HasSuffix(strings.ToLower(destHost), strings.ToLower(host[1:]))
+	}
+
+	return false
+}
+
+// ValidateEgressDestination verifies the provided destination
+// is a supported destination for outbound network access.
+func ValidateEgressDestination(destination string) error {
+	if destination == EgressDestinationAny {
+		return nil
+	}
+
+	if len(destination) == 0 {
+		return errors.New("empty destination")
+	}
+
+	// addresses and networks are valid destinations
+	if _, err := parsePrefix(destination); err == nil {
+		return nil
+	}
+
+	host, port := splitDestination(destination)
+
+	if len(port) > 0 {
+		p, err := strconv.Atoi(port)
+		if err != nil || p < 1 || p > 65535 {
+			return fmt.Errorf("invalid port %s for destination %s", port, destination)
+		}
+	}
+
+	host = strings.TrimPrefix(host, "*.")
+
+	if len(host) == 0 || strings.ContainsAny(host, "*/ ") {
+		return fmt.Errorf("invalid host for destination %s", destination)
+	}
+
+	return nil
+}
+
+// parsePrefix is a helper function to parse
+// an IP address or CIDR block as a network.
+func parsePrefix(destination string) (netip.Prefix, error) {
+	if strings.Contains(destination, "/") {
+		prefix, err := netip.ParsePrefix(destination)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(destination)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// splitDestination is a helper function to split
+// a hostname destination into its host and port.
+func splitDestination(destination string) (string, string) {
+	i := strings.LastIndex(destination, ":")
+	if i < 0 {
+		return destination, ""
+	}
+
+	return destination[:i], destination[i+1:]
+}
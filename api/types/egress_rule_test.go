@@ -0,0 +1,274 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEgressRule_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		er   *EgressRule
+		want *EgressRule
+	}{
+		{
+			er:   testEgressRule(),
+			want: testEgressRule(),
+		},
+		{
+			er:   new(EgressRule),
+			want: new(EgressRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.er.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.er.GetID(), test.want.GetID())
+		}
+
+		if test.er.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.er.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.er.GetAction() != test.want.GetAction() {
+			t.Errorf("GetAction is %v, want %v", test.er.GetAction(), test.want.GetAction())
+		}
+
+		if test.er.GetDestination() != test.want.GetDestination() {
+			t.Errorf("GetDestination is %v, want %v", test.er.GetDestination(), test.want.GetDestination())
+		}
+
+		if test.er.GetDescription() != test.want.GetDescription() {
+			t.Errorf("GetDescription is %v, want %v", test.er.GetDescription(), test.want.GetDescription())
+		}
+
+		if test.er.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.er.GetActive(), test.want.GetActive())
+		}
+
+		if test.er.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.er.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.er.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.er.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.er.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.er.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.er.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.er.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestEgressRule_Setters(t *testing.T) {
+	// setup types
+	var e *EgressRule
+
+	// setup tests
+	tests := []struct {
+		er   *EgressRule
+		want *EgressRule
+	}{
+		{
+			er:   testEgressRule(),
+			want: testEgressRule(),
+		},
+		{
+			er:   e,
+			want: new(EgressRule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.er.SetID(test.want.GetID())
+		test.er.SetOrg(test.want.GetOrg())
+		test.er.SetAction(test.want.GetAction())
+		test.er.SetDestination(test.want.GetDestination())
+		test.er.SetDescription(test.want.GetDescription())
+		test.er.SetActive(test.want.GetActive())
+		test.er.SetCreatedAt(test.want.GetCreatedAt())
+		test.er.SetCreatedBy(test.want.GetCreatedBy())
+		test.er.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.er.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.er.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.er.GetID(), test.want.GetID())
+		}
+
+		if test.er.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.er.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.er.GetAction() != test.want.GetAction() {
+			t.Errorf("SetAction is %v, want %v", test.er.GetAction(), test.want.GetAction())
+		}
+
+		if test.er.GetDestination() != test.want.GetDestination() {
+			t.Errorf("SetDestination is %v, want %v", test.er.GetDestination(), test.want.GetDestination())
+		}
+
+		if test.er.GetDescription() != test.want.GetDescription() {
+			t.Errorf("SetDescription is %v, want %v", test.er.GetDescription(), test.want.GetDescription())
+		}
+
+		if test.er.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.er.GetActive(), test.want.GetActive())
+		}
+
+		if test.er.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.er.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.er.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.er.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.er.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.er.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.er.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.er.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestEgressRule_Covers(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rule        string
+		destination string
+		want        bool
+	}{
+		{rule: "*", destination: "github.com", want: true},
+		{rule: "github.com", destination: "*", want: false},
+		{rule: "github.com", destination: "GitHub.com:443", want: true},
+		{rule: "github.com:443", destination: "github.com:443", want: true},
+		{rule: "github.com:443", destination: "github.com:22", want: false},
+		{rule: "github.com:443", destination: "github.com", want: false},
+		{rule: "*.github.com", destination: "api.github.com", want: true},
+		{rule: "*.github.com", destination: "*.api.github.com", want: true},
+		{rule: "*.github.com", destination: "github.com", want: false},
+		{rule: "10.0.0.0/8", destination: "10.1.2.3", want: true},
+		{rule: "10.0.0.0/8", destination: "10.1.0.0/16", want: true},
+		{rule: "10.1.0.0/16", destination: "10.0.0.0/8", want: false},
+		{rule: "10.0.0.0/8", destination: "192.168.1.1", want: false},
+		{rule: "10.0.0.0/8", destination: "example.com", want: false},
+		{rule: "fd00::/8", destination: "fd00::1", want: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		e := new(EgressRule)
+		e.SetDestination(test.rule)
+
+		got := e.Covers(test.destination)
+
+		if got != test.want {
+			t.Errorf("Covers for %s with %s is %v, want %v", test.rule, test.destination, got, test.want)
+		}
+	}
+}
+
+func TestTypes_ValidateEgressDestination(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		destination string
+		failure     bool
+	}{
+		{destination: "*", failure: false},
+		{destination: "github.com", failure: false},
+		{destination: "*.github.com:443", failure: false},
+		{destination: "10.0.0.0/8", failure: false},
+		{destination: "fd00::1", failure: false},
+		{destination: "", failure: true},
+		{destination: "github.com:0", failure: true},
+		{destination: "github.com:https", failure: true},
+		{destination: "git*hub.com", failure: true},
+		{destination: "10.0.0.0/33", failure: true},
+		{destination: "*.", failure: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := ValidateEgressDestination(test.destination)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("ValidateEgressDestination for %s should have returned err", test.destination)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("ValidateEgressDestination for %s returned err: %v", test.destination, err)
+		}
+	}
+}
+
+func TestEgressRule_String(t *testing.T) {
+	// setup types
+	e := testEgressRule()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Action: %s,
+  Destination: %s,
+  Description: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		e.GetID(),
+		e.GetOrg(),
+		e.GetAction(),
+		e.GetDestination(),
+		e.GetDescription(),
+		e.GetActive(),
+		e.GetCreatedAt(),
+		e.GetCreatedBy(),
+		e.GetUpdatedAt(),
+		e.GetUpdatedBy(),
+	)
+
+	// run test
+	got := e.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testEgressRule is a test helper function to create a EgressRule
+// type with all fields set to a fake value.
+func testEgressRule() *EgressRule {
+	e := new(EgressRule)
+
+	e.SetID(1)
+	e.SetOrg("github")
+	e.SetAction("allow")
+	e.SetDestination("*.github.com:443")
+	e.SetDescription("GitHub API")
+	e.SetActive(true)
+	e.SetCreatedAt(1563474077)
+	e.SetCreatedBy("octocat")
+	e.SetUpdatedAt(1563474077)
+	e.SetUpdatedBy("octocat")
+
+	return e
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// EncryptionAudit is the API representation of the encrypted
+// columns for a table in the database with the number of
+// rows that can and can't be decrypted with the current key.
+//
+// swagger:model EncryptionAudit
+type EncryptionAudit struct {
+	Table      *string   `json:"table,omitempty"`
+	Columns    *[]string `json:"columns,omitempty"`
+	Total      *int64    `json:"total,omitempty"`
+	Readable   *int64    `json:"readable,omitempty"`
+	Unreadable *int64    `json:"unreadable,omitempty"`
+	Sealed     *int64    `json:"sealed,omitempty"`
+}
+
+// GetTable returns the Table field.
+//
+// When the provided EncryptionAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *EncryptionAudit) GetTable() string {
+	// return zero value if EncryptionAudit type or Table field is nil
+	if a == nil || a.Table == nil {
+		return ""
+	}
+
+	return *a.Table
+}
+
+// GetColumns returns the Columns field.
+//
+// When the provided EncryptionAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *EncryptionAudit) GetColumns() []string {
+	// return zero value if EncryptionAudit type or Columns field is nil
+	if a == nil || a.Columns == nil {
+		return []string{}
+	}
+
+	return *a.Columns
+}
+
+// GetTotal returns the Total field.
+//
+// When the provided EncryptionAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *EncryptionAudit) GetTotal() int64 {
+	// return zero value if EncryptionAudit type or Total field is nil
+	if a == nil || a.Total == nil {
+		return 0
+	}
+
+	return *a.Total
+}
+
+// GetReadable returns the Readable field.
+//
+// When the provided EncryptionAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *EncryptionAudit) GetReadable() int64 {
+	// return zero value if EncryptionAudit type or Readable field is nil
+	if a == nil || a.Readable == nil {
+		return 0
+	}
+
+	return *a.Readable
+}
+
+// GetUnreadable returns the Unreadable field.
+//
+// When the provided EncryptionAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *EncryptionAudit) GetUnreadable() int64 {
+	// return zero value if EncryptionAudit type or Unreadable field is nil
+	if a == nil || a.Unreadable == nil {
+		return 0
+	}
+
+	return *a.Unreadable
+}
+
+// GetSealed returns the Sealed field.
+//
+// When the provided EncryptionAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *EncryptionAudit) GetSealed() int64 {
+	// return zero value if EncryptionAudit type or Sealed field is nil
+	if a == nil || a.Sealed == nil {
+		return 0
+	}
+
+	return *a.Sealed
+}
+
+// SetTable sets the Table field.
+//
+// When the provided EncryptionAudit type is nil, it
+// will set nothing and immediately return.
+func (a *EncryptionAudit) SetTable(v string) {
+	// return if EncryptionAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Table = &v
+}
+
+// SetColumns sets the Columns field.
+//
+// When the provided EncryptionAudit type is nil, it
+// will set nothing and immediately return.
+func (a *EncryptionAudit) SetColumns(v []string) {
+	// return if EncryptionAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Columns = &v
+}
+
+// SetTotal sets the Total field.
+//
+// When the provided EncryptionAudit type is nil, it
+// will set nothing and immediately return.
+func (a *EncryptionAudit) SetTotal(v int64) {
+	// return if EncryptionAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Total = &v
+}
+
+// SetReadable sets the Readable field.
+//
+// When the provided EncryptionAudit type is nil, it
+// will set nothing and immediately return.
+func (a *EncryptionAudit) SetReadable(v int64) {
+	// return if EncryptionAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Readable = &v
+}
+
+// SetUnreadable sets the Unreadable field.
+//
+// When the provided EncryptionAudit type is nil, it
+// will set nothing and immediately return.
+func (a *EncryptionAudit) SetUnreadable(v int64) {
+	// return if EncryptionAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Unreadable = &v
+}
+
+// SetSealed sets the Sealed field.
+//
+// When the provided EncryptionAudit type is nil, it
+// will set nothing and immediately return.
+func (a *EncryptionAudit) SetSealed(v int64) {
+	// return if EncryptionAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Sealed = &v
+}
+
+// String implements the Stringer interface for the EncryptionAudit type.
+func (a *EncryptionAudit) String() string {
+	return fmt.Sprintf(`{
+  Table: %s,
+  Columns: %d,
+  Total: %d,
+  Readable: %d,
+  Unreadable: %d,
+  Sealed: %d,
+}`,
+		a.GetTable(),
+		len(a.GetColumns()),
+		a.GetTotal(),
+		a.GetReadable(),
+		a.GetUnreadable(),
+		a.GetSealed(),
+	)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEncryptionAudit_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		audit *EncryptionAudit
+		want  *EncryptionAudit
+	}{
+		{
+			audit: testEncryptionAudit(),
+			want:  testEncryptionAudit(),
+		},
+		{
+			audit: new(EncryptionAudit),
+			want:  new(EncryptionAudit),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.audit.GetTable() != test.want.GetTable() {
+			t.Errorf("GetTable is %v, want %v", test.audit.GetTable(), test.want.GetTable())
+		}
+
+		if !reflect.DeepEqual(test.audit.GetColumns(), test.want.GetColumns()) {
+			t.Errorf("GetColumns is %v, want %v", test.audit.GetColumns(), test.want.GetColumns())
+		}
+
+		if test.audit.GetTotal() != test.want.GetTotal() {
+			t.Errorf("GetTotal is %v, want %v", test.audit.GetTotal(), test.want.GetTotal())
+		}
+
+		if test.audit.GetReadable() != test.want.GetReadable() {
+			t.Errorf("GetReadable is %v, want %v", test.audit.GetReadable(), test.want.GetReadable())
+		}
+
+		if test.audit.GetUnreadable() != test.want.GetUnreadable() {
+			t.Errorf("GetUnreadable is %v, want %v", test.audit.GetUnreadable(), test.want.GetUnreadable())
+		}
+
+		if test.audit.GetSealed() != test.want.GetSealed() {
+			t.Errorf("GetSealed is %v, want %v", test.audit.GetSealed(), test.want.GetSealed())
+		}
+	}
+}
+
+func TestEncryptionAudit_Setters(t *testing.T) {
+	// setup types
+	var a *EncryptionAudit
+
+	// setup tests
+	tests := []struct {
+		audit *EncryptionAudit
+		want  *EncryptionAudit
+	}{
+		{
+			audit: testEncryptionAudit(),
+			want:  testEncryptionAudit(),
+		},
+		{
+			audit: a,
+			want:  new(EncryptionAudit),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.audit.SetTable(test.want.GetTable())
+		test.audit.SetColumns(test.want.GetColumns())
+		test.audit.SetTotal(test.want.GetTotal())
+		test.audit.SetReadable(test.want.GetReadable())
+		test.audit.SetUnreadable(test.want.GetUnreadable())
+		test.audit.SetSealed(test.want.GetSealed())
+
+		if test.audit.GetTable() != test.want.GetTable() {
+			t.Errorf("SetTable is %v, want %v", test.audit.GetTable(), test.want.GetTable())
+		}
+
+		if !reflect.DeepEqual(test.audit.GetColumns(), test.want.GetColumns()) {
+			t.Errorf("SetColumns is %v, want %v", test.audit.GetColumns(), test.want.GetColumns())
+		}
+
+		if test.audit.GetTotal() != test.want.GetTotal() {
+			t.Errorf("SetTotal is %v, want %v", test.audit.GetTotal(), test.want.GetTotal())
+		}
+
+		if test.audit.GetReadable() != test.want.GetReadable() {
+			t.Errorf("SetReadable is %v, want %v", test.audit.GetReadable(), test.want.GetReadable())
+		}
+
+		if test.audit.GetUnreadable() != test.want.GetUnreadable() {
+			t.Errorf("SetUnreadable is %v, want %v", test.audit.GetUnreadable(), test.want.GetUnreadable())
+		}
+
+		if test.audit.GetSealed() != test.want.GetSealed() {
+			t.Errorf("SetSealed is %v, want %v", test.audit.GetSealed(), test.want.GetSealed())
+		}
+	}
+}
+
+func TestEncryptionAudit_String(t *testing.T) {
+	// setup types
+	a := testEncryptionAudit()
+
+	want := fmt.Sprintf(`{
+  Table: %s,
+  Columns: %d,
+  Total: %d,
+  Readable: %d,
+  Unreadable: %d,
+  Sealed: %d,
+}`,
+		a.GetTable(),
+		len(a.GetColumns()),
+		a.GetTotal(),
+		a.GetReadable(),
+		a.GetUnreadable(),
+		a.GetSealed(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testEncryptionAudit is a test helper function to create a EncryptionAudit
+// type with all fields set to a fake value.
+func testEncryptionAudit() *EncryptionAudit {
+	a := new(EncryptionAudit)
+
+	a.SetTable("repos")
+	a.SetColumns([]string{"hash"})
+	a.SetTotal(3)
+	a.SetReadable(2)
+	a.SetUnreadable(1)
+	a.SetSealed(1)
+
+	return a
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// EnvironmentDeploy is the API representation of the last successful
+// deployment of a repo to an environment.
+//
+// swagger:model EnvironmentDeploy
+type EnvironmentDeploy struct {
+	Environment *string `json:"environment,omitempty"`
+	Commit      *string `json:"commit,omitempty"`
+	Ref         *string `json:"ref,omitempty"`
+	Version     *string `json:"version,omitempty"`
+	Actor       *string `json:"actor,omitempty"`
+	Build       *int64  `json:"build,omitempty"`
+	Deployed    *int64  `json:"deployed,omitempty"`
+	Link        *string `json:"link,omitempty"`
+}
+
+// GetEnvironment returns the Environment field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetEnvironment() string {
+	// return zero value if EnvironmentDeploy type or Environment field is nil
+	if e == nil || e.Environment == nil {
+		return ""
+	}
+
+	return *e.Environment
+}
+
+// GetCommit returns the Commit field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetCommit() string {
+	// return zero value if EnvironmentDeploy type or Commit field is nil
+	if e == nil || e.Commit == nil {
+		return ""
+	}
+
+	return *e.Commit
+}
+
+// GetRef returns the Ref field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetRef() string {
+	// return zero value if EnvironmentDeploy type or Ref field is nil
+	if e == nil || e.Ref == nil {
+		return ""
+	}
+
+	return *e.Ref
+}
+
+// GetVersion returns the Version field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetVersion() string {
+	// return zero value if EnvironmentDeploy type or Version field is nil
+	if e == nil || e.Version == nil {
+		return ""
+	}
+
+	return *e.Version
+}
+
+// GetActor returns the Actor field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetActor() string {
+	// return zero value if EnvironmentDeploy type or Actor field is nil
+	if e == nil || e.Actor == nil {
+		return ""
+	}
+
+	return *e.Actor
+}
+
+// GetBuild returns the Build field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetBuild() int64 {
+	// return zero value if EnvironmentDeploy type or Build field is nil
+	if e == nil || e.Build == nil {
+		return 0
+	}
+
+	return *e.Build
+}
+
+// GetDeployed returns the Deployed field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetDeployed() int64 {
+	// return zero value if EnvironmentDeploy type or Deployed field is nil
+	if e == nil || e.Deployed == nil {
+		return 0
+	}
+
+	return *e.Deployed
+}
+
+// GetLink returns the Link field.
+//
+// When the provided EnvironmentDeploy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EnvironmentDeploy) GetLink() string {
+	// return zero value if EnvironmentDeploy type or Link field is nil
+	if e == nil || e.Link == nil {
+		return ""
+	}
+
+	return *e.Link
+}
+
+// SetEnvironment sets the Environment field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetEnvironment(v string) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Environment = &v
+}
+
+// SetCommit sets the Commit field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetCommit(v string) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Commit = &v
+}
+
+// SetRef sets the Ref field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetRef(v string) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Ref = &v
+}
+
+// SetVersion sets the Version field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetVersion(v string) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Version = &v
+}
+
+// SetActor sets the Actor field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetActor(v string) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Actor = &v
+}
+
+// SetBuild sets the Build field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetBuild(v int64) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Build = &v
+}
+
+// SetDeployed sets the Deployed field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetDeployed(v int64) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Deployed = &v
+}
+
+// SetLink sets the Link field.
+//
+// When the provided EnvironmentDeploy type is nil, it
+// will set nothing and immediately return.
+func (e *EnvironmentDeploy) SetLink(v string) {
+	// return if EnvironmentDeploy type is nil
+	if e == nil {
+		return
+	}
+
+	e.Link = &v
+}
+
+// String implements the Stringer interface for the EnvironmentDeploy type.
+func (e *EnvironmentDeploy) String() string {
+	return fmt.Sprintf(`{
+  Environment: %s,
+  Commit: %s,
+  Ref: %s,
+  Version: %s,
+  Actor: %s,
+  Build: %d,
+  Deployed: %d,
+  Link: %s,
+}`,
+		e.GetEnvironment(),
+		e.GetCommit(),
+		e.GetRef(),
+		e.GetVersion(),
+		e.GetActor(),
+		e.GetBuild(),
+		e.GetDeployed(),
+		e.GetLink(),
+	)
+}
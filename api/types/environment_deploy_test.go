@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEnvironmentDeploy_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		ed   *EnvironmentDeploy
+		want *EnvironmentDeploy
+	}{
+		{
+			ed:   testEnvironmentDeploy(),
+			want: testEnvironmentDeploy(),
+		},
+		{
+			ed:   new(EnvironmentDeploy),
+			want: new(EnvironmentDeploy),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.ed.GetEnvironment() != test.want.GetEnvironment() {
+			t.Errorf("GetEnvironment is %v, want %v", test.ed.GetEnvironment(), test.want.GetEnvironment())
+		}
+
+		if test.ed.GetCommit() != test.want.GetCommit() {
+			t.Errorf("GetCommit is %v, want %v", test.ed.GetCommit(), test.want.GetCommit())
+		}
+
+		if test.ed.GetRef() != test.want.GetRef() {
+			t.Errorf("GetRef is %v, want %v", test.ed.GetRef(), test.want.GetRef())
+		}
+
+		if test.ed.GetVersion() != test.want.GetVersion() {
+			t.Errorf("GetVersion is %v, want %v", test.ed.GetVersion(), test.want.GetVersion())
+		}
+
+		if test.ed.GetActor() != test.want.GetActor() {
+			t.Errorf("GetActor is %v, want %v", test.ed.GetActor(), test.want.GetActor())
+		}
+
+		if test.ed.GetBuild() != test.want.GetBuild() {
+			t.Errorf("GetBuild is %v, want %v", test.ed.GetBuild(), test.want.GetBuild())
+		}
+
+		if test.ed.GetDeployed() != test.want.GetDeployed() {
+			t.Errorf("GetDeployed is %v, want %v", test.ed.GetDeployed(), test.want.GetDeployed())
+		}
+
+		if test.ed.GetLink() != test.want.GetLink() {
+			t.Errorf("GetLink is %v, want %v", test.ed.GetLink(), test.want.GetLink())
+		}
+	}
+}
+
+func TestEnvironmentDeploy_Setters(t *testing.T) {
+	// setup types
+	var e *EnvironmentDeploy
+
+	// setup tests
+	tests := []struct {
+		ed   *EnvironmentDeploy
+		want *EnvironmentDeploy
+	}{
+		{
+			ed:   testEnvironmentDeploy(),
+			want: testEnvironmentDeploy(),
+		},
+		{
+			ed:   e,
+			want: new(EnvironmentDeploy),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.ed.SetEnvironment(test.want.GetEnvironment())
+		test.ed.SetCommit(test.want.GetCommit())
+		test.ed.SetRef(test.want.GetRef())
+		test.ed.SetVersion(test.want.GetVersion())
+		test.ed.SetActor(test.want.GetActor())
+		test.ed.SetBuild(test.want.GetBuild())
+		test.ed.SetDeployed(test.want.GetDeployed())
+		test.ed.SetLink(test.want.GetLink())
+
+		if test.ed.GetEnvironment() != test.want.GetEnvironment() {
+			t.Errorf("SetEnvironment is %v, want %v", test.ed.GetEnvironment(), test.want.GetEnvironment())
+		}
+
+		if test.ed.GetCommit() != test.want.GetCommit() {
+			t.Errorf("SetCommit is %v, want %v", test.ed.GetCommit(), test.want.GetCommit())
+		}
+
+		if test.ed.GetRef() != test.want.GetRef() {
+			t.Errorf("SetRef is %v, want %v", test.ed.GetRef(), test.want.GetRef())
+		}
+
+		if test.ed.GetVersion() != test.want.GetVersion() {
+			t.Errorf("SetVersion is %v, want %v", test.ed.GetVersion(), test.want.GetVersion())
+		}
+
+		if test.ed.GetActor() != test.want.GetActor() {
+			t.Errorf("SetActor is %v, want %v", test.ed.GetActor(), test.want.GetActor())
+		}
+
+		if test.ed.GetBuild() != test.want.GetBuild() {
+			t.Errorf("SetBuild is %v, want %v", test.ed.GetBuild(), test.want.GetBuild())
+		}
+
+		if test.ed.GetDeployed() != test.want.GetDeployed() {
+			t.Errorf("SetDeployed is %v, want %v", test.ed.GetDeployed(), test.want.GetDeployed())
+		}
+
+		if test.ed.GetLink() != test.want.GetLink() {
+			t.Errorf("SetLink is %v, want %v", test.ed.GetLink(), test.want.GetLink())
+		}
+	}
+}
+
+func TestEnvironmentDeploy_String(t *testing.T) {
+	// setup types
+	e := testEnvironmentDeploy()
+
+	want := fmt.Sprintf(`{
+  Environment: %s,
+  Commit: %s,
+  Ref: %s,
+  Version: %s,
+  Actor: %s,
+  Build: %d,
+  Deployed: %d,
+  Link: %s,
+}`,
+		e.GetEnvironment(),
+		e.GetCommit(),
+		e.GetRef(),
+		e.GetVersion(),
+		e.GetActor(),
+		e.GetBuild(),
+		e.GetDeployed(),
+		e.GetLink(),
+	)
+
+	// run test
+	got := e.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testEnvironmentDeploy is a test helper function to create a EnvironmentDeploy
+// type with all fields set to a fake value.
+func testEnvironmentDeploy() *EnvironmentDeploy {
+	e := new(EnvironmentDeploy)
+
+	e.SetEnvironment("production")
+	e.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	e.SetRef("refs/tags/v1.0.0")
+	e.SetVersion("v1.0.0")
+	e.SetActor("octocat")
+	e.SetBuild(1)
+	e.SetDeployed(1563474077)
+	e.SetLink("https://vela.example.com/github/octocat/1")
+
+	return e
+}
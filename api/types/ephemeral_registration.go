@@ -0,0 +1,286 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// EphemeralRegistration is the API representation of a single-use
+// worker registration bound to one build. The registration is
+// consumed when the worker registers and torn down, along with
+// the worker, when the build completes.
+//
+// swagger:model EphemeralRegistration
+type EphemeralRegistration struct {
+	ID         *int64  `json:"id,omitempty"`
+	BuildID    *int64  `json:"build_id,omitempty"`
+	RepoID     *int64  `json:"repo_id,omitempty"`
+	Hostname   *string `json:"hostname,omitempty"`
+	Route      *string `json:"route,omitempty"`
+	Token      *string `json:"token,omitempty"`
+	Registered *int64  `json:"registered,omitempty"`
+	Created    *int64  `json:"created,omitempty"`
+	CreatedBy  *string `json:"created_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetID() int64 {
+	// return zero value if EphemeralRegistration type or ID field is nil
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetBuildID() int64 {
+	// return zero value if EphemeralRegistration type or BuildID field is nil
+	if e == nil || e.BuildID == nil {
+		return 0
+	}
+
+	return *e.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetRepoID() int64 {
+	// return zero value if EphemeralRegistration type or RepoID field is nil
+	if e == nil || e.RepoID == nil {
+		return 0
+	}
+
+	return *e.RepoID
+}
+
+// GetHostname returns the Hostname field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetHostname() string {
+	// return zero value if EphemeralRegistration type or Hostname field is nil
+	if e == nil || e.Hostname == nil {
+		return ""
+	}
+
+	return *e.Hostname
+}
+
+// GetRoute returns the Route field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetRoute() string {
+	// return zero value if EphemeralRegistration type or Route field is nil
+	if e == nil || e.Route == nil {
+		return ""
+	}
+
+	return *e.Route
+}
+
+// GetToken returns the Token field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetToken() string {
+	// return zero value if EphemeralRegistration type or Token field is nil
+	if e == nil || e.Token == nil {
+		return ""
+	}
+
+	return *e.Token
+}
+
+// GetRegistered returns the Registered field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetRegistered() int64 {
+	// return zero value if EphemeralRegistration type or Registered field is nil
+	if e == nil || e.Registered == nil {
+		return 0
+	}
+
+	return *e.Registered
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetCreated() int64 {
+	// return zero value if EphemeralRegistration type or Created field is nil
+	if e == nil || e.Created == nil {
+		return 0
+	}
+
+	return *e.Created
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided EphemeralRegistration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *EphemeralRegistration) GetCreatedBy() string {
+	// return zero value if EphemeralRegistration type or CreatedBy field is nil
+	if e == nil || e.CreatedBy == nil {
+		return ""
+	}
+
+	return *e.CreatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetID(v int64) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetBuildID(v int64) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetRepoID(v int64) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.RepoID = &v
+}
+
+// SetHostname sets the Hostname field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetHostname(v string) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.Hostname = &v
+}
+
+// SetRoute sets the Route field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetRoute(v string) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.Route = &v
+}
+
+// SetToken sets the Token field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetToken(v string) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.Token = &v
+}
+
+// SetRegistered sets the Registered field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetRegistered(v int64) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.Registered = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetCreated(v int64) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.Created = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided EphemeralRegistration type is nil, it
+// will set nothing and immediately return.
+func (e *EphemeralRegistration) SetCreatedBy(v string) {
+	// return if EphemeralRegistration type is nil
+	if e == nil {
+		return
+	}
+
+	e.CreatedBy = &v
+}
+
+// String implements the Stringer interface for the EphemeralRegistration type.
+func (e *EphemeralRegistration) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Hostname: %s,
+  Route: %s,
+  Token: %s,
+  Registered: %d,
+  Created: %d,
+  CreatedBy: %s,
+}`,
+		e.GetID(),
+		e.GetBuildID(),
+		e.GetRepoID(),
+		e.GetHostname(),
+		e.GetRoute(),
+		e.GetToken(),
+		e.GetRegistered(),
+		e.GetCreated(),
+		e.GetCreatedBy(),
+	)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestEphemeralRegistration_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		registration *EphemeralRegistration
+		want         *EphemeralRegistration
+	}{
+		{
+			registration: testEphemeralRegistration(),
+			want:         testEphemeralRegistration(),
+		},
+		{
+			registration: new(EphemeralRegistration),
+			want:         new(EphemeralRegistration),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.registration.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.registration.GetID(), test.want.GetID())
+		}
+
+		if test.registration.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.registration.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.registration.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.registration.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.registration.GetHostname() != test.want.GetHostname() {
+			t.Errorf("GetHostname is %v, want %v", test.registration.GetHostname(), test.want.GetHostname())
+		}
+
+		if test.registration.GetRoute() != test.want.GetRoute() {
+			t.Errorf("GetRoute is %v, want %v", test.registration.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.registration.GetToken() != test.want.GetToken() {
+			t.Errorf("GetToken is %v, want %v", test.registration.GetToken(), test.want.GetToken())
+		}
+
+		if test.registration.GetRegistered() != test.want.GetRegistered() {
+			t.Errorf("GetRegistered is %v, want %v", test.registration.GetRegistered(), test.want.GetRegistered())
+		}
+
+		if test.registration.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.registration.GetCreated(), test.want.GetCreated())
+		}
+
+		if test.registration.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.registration.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+	}
+}
+
+func TestEphemeralRegistration_Setters(t *testing.T) {
+	// setup types
+	var e *EphemeralRegistration
+
+	// setup tests
+	tests := []struct {
+		registration *EphemeralRegistration
+		want         *EphemeralRegistration
+	}{
+		{
+			registration: testEphemeralRegistration(),
+			want:         testEphemeralRegistration(),
+		},
+		{
+			registration: e,
+			want:         new(EphemeralRegistration),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.registration.SetID(test.want.GetID())
+		test.registration.SetBuildID(test.want.GetBuildID())
+		test.registration.SetRepoID(test.want.GetRepoID())
+		test.registration.SetHostname(test.want.GetHostname())
+		test.registration.SetRoute(test.want.GetRoute())
+		test.registration.SetToken(test.want.GetToken())
+		test.registration.SetRegistered(test.want.GetRegistered())
+		test.registration.SetCreated(test.want.GetCreated())
+		test.registration.SetCreatedBy(test.want.GetCreatedBy())
+
+		if test.registration.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.registration.GetID(), test.want.GetID())
+		}
+
+		if test.registration.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.registration.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.registration.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.registration.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.registration.GetHostname() != test.want.GetHostname() {
+			t.Errorf("SetHostname is %v, want %v", test.registration.GetHostname(), test.want.GetHostname())
+		}
+
+		if test.registration.GetRoute() != test.want.GetRoute() {
+			t.Errorf("SetRoute is %v, want %v", test.registration.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.registration.GetToken() != test.want.GetToken() {
+			t.Errorf("SetToken is %v, want %v", test.registration.GetToken(), test.want.GetToken())
+		}
+
+		if test.registration.GetRegistered() != test.want.GetRegistered() {
+			t.Errorf("SetRegistered is %v, want %v", test.registration.GetRegistered(), test.want.GetRegistered())
+		}
+
+		if test.registration.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.registration.GetCreated(), test.want.GetCreated())
+		}
+
+		if test.registration.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.registration.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+	}
+}
+
+func TestEphemeralRegistration_String(t *testing.T) {
+	// setup types
+	e := testEphemeralRegistration()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Hostname: %s,
+  Route: %s,
+  Token: %s,
+  Registered: %d,
+  Created: %d,
+  CreatedBy: %s,
+}`,
+		e.GetID(),
+		e.GetBuildID(),
+		e.GetRepoID(),
+		e.GetHostname(),
+		e.GetRoute(),
+		e.GetToken(),
+		e.GetRegistered(),
+		e.GetCreated(),
+		e.GetCreatedBy(),
+	)
+
+	// run test
+	got := e.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testEphemeralRegistration is a test helper function to create a EphemeralRegistration
+// type with all fields set to a fake value.
+func testEphemeralRegistration() *EphemeralRegistration {
+	e := new(EphemeralRegistration)
+
+	e.SetID(1)
+	e.SetBuildID(1)
+	e.SetRepoID(1)
+	e.SetHostname("vela-ephemeral-1")
+	e.SetRoute("vela")
+	e.SetToken("foo")
+	e.SetRegistered(1563474077)
+	e.SetCreated(1563474076)
+	e.SetCreatedBy("octocat")
+
+	return e
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// Export is the API representation of a file of build
+// results exported to object storage for a data warehouse.
+//
+// swagger:model Export
+type Export struct {
+	ID        *int64  `json:"id,omitempty"`
+	Dataset   *string `json:"dataset,omitempty"`
+	Format    *string `json:"format,omitempty"`
+	Bucket    *string `json:"bucket,omitempty"`
+	Object    *string `json:"object,omitempty"`
+	Records   *int64  `json:"records,omitempty"`
+	Since     *int64  `json:"since,omitempty"`
+	Watermark *int64  `json:"watermark,omitempty"`
+	Created   *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetID() int64 {
+	// return zero value if Export type or ID field is nil
+	if e == nil || e.ID == nil {
+		return 0
+	}
+
+	return *e.ID
+}
+
+// GetDataset returns the Dataset field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetDataset() string {
+	// return zero value if Export type or Dataset field is nil
+	if e == nil || e.Dataset == nil {
+		return ""
+	}
+
+	return *e.Dataset
+}
+
+// GetFormat returns the Format field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetFormat() string {
+	// return zero value if Export type or Format field is nil
+	if e == nil || e.Format == nil {
+		return ""
+	}
+
+	return *e.Format
+}
+
+// GetBucket returns the Bucket field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetBucket() string {
+	// return zero value if Export type or Bucket field is nil
+	if e == nil || e.Bucket == nil {
+		return ""
+	}
+
+	return *e.Bucket
+}
+
+// GetObject returns the Object field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetObject() string {
+	// return zero value if Export type or Object field is nil
+	if e == nil || e.Object == nil {
+		return ""
+	}
+
+	return *e.Object
+}
+
+// GetRecords returns the Records field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetRecords() int64 {
+	// return zero value if Export type or Records field is nil
+	if e == nil || e.Records == nil {
+		return 0
+	}
+
+	return *e.Records
+}
+
+// GetSince returns the Since field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetSince() int64 {
+	// return zero value if Export type or Since field is nil
+	if e == nil || e.Since == nil {
+		return 0
+	}
+
+	return *e.Since
+}
+
+// GetWatermark returns the Watermark field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetWatermark() int64 {
+	// return zero value if Export type or Watermark field is nil
+	if e == nil || e.Watermark == nil {
+		return 0
+	}
+
+	return *e.Watermark
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided Export type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (e *Export) GetCreated() int64 {
+	// return zero value if Export type or Created field is nil
+	if e == nil || e.Created == nil {
+		return 0
+	}
+
+	return *e.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetID(v int64) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.ID = &v
+}
+
+// SetDataset sets the Dataset field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetDataset(v string) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Dataset = &v
+}
+
+// SetFormat sets the Format field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetFormat(v string) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Format = &v
+}
+
+// SetBucket sets the Bucket field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetBucket(v string) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Bucket = &v
+}
+
+// SetObject sets the Object field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetObject(v string) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Object = &v
+}
+
+// SetRecords sets the Records field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetRecords(v int64) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Records = &v
+}
+
+// SetSince sets the Since field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetSince(v int64) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Since = &v
+}
+
+// SetWatermark sets the Watermark field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetWatermark(v int64) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Watermark = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided Export type is nil, it
+// will set nothing and immediately return.
+func (e *Export) SetCreated(v int64) {
+	// return if Export type is nil
+	if e == nil {
+		return
+	}
+
+	e.Created = &v
+}
+
+// String implements the Stringer interface for the Export type.
+func (e *Export) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Dataset: %s,
+  Format: %s,
+  Bucket: %s,
+  Object: %s,
+  Records: %d,
+  Since: %d,
+  Watermark: %d,
+  Created: %d,
+}`,
+		e.GetID(),
+		e.GetDataset(),
+		e.GetFormat(),
+		e.GetBucket(),
+		e.GetObject(),
+		e.GetRecords(),
+		e.GetSince(),
+		e.GetWatermark(),
+		e.GetCreated(),
+	)
+}
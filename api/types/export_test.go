@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestExport_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		export *Export
+		want   *Export
+	}{
+		{
+			export: testExport(),
+			want:   testExport(),
+		},
+		{
+			export: new(Export),
+			want:   new(Export),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.export.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.export.GetID(), test.want.GetID())
+		}
+
+		if test.export.GetDataset() != test.want.GetDataset() {
+			t.Errorf("GetDataset is %v, want %v", test.export.GetDataset(), test.want.GetDataset())
+		}
+
+		if test.export.GetFormat() != test.want.GetFormat() {
+			t.Errorf("GetFormat is %v, want %v", test.export.GetFormat(), test.want.GetFormat())
+		}
+
+		if test.export.GetBucket() != test.want.GetBucket() {
+			t.Errorf("GetBucket is %v, want %v", test.export.GetBucket(), test.want.GetBucket())
+		}
+
+		if test.export.GetObject() != test.want.GetObject() {
+			t.Errorf("GetObject is %v, want %v", test.export.GetObject(), test.want.GetObject())
+		}
+
+		if test.export.GetRecords() != test.want.GetRecords() {
+			t.Errorf("GetRecords is %v, want %v", test.export.GetRecords(), test.want.GetRecords())
+		}
+
+		if test.export.GetSince() != test.want.GetSince() {
+			t.Errorf("GetSince is %v, want %v", test.export.GetSince(), test.want.GetSince())
+		}
+
+		if test.export.GetWatermark() != test.want.GetWatermark() {
+			t.Errorf("GetWatermark is %v, want %v", test.export.GetWatermark(), test.want.GetWatermark())
+		}
+
+		if test.export.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.export.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestExport_Setters(t *testing.T) {
+	// setup types
+	var e *Export
+
+	// setup tests
+	tests := []struct {
+		export *Export
+		want   *Export
+	}{
+		{
+			export: testExport(),
+			want:   testExport(),
+		},
+		{
+			export: e,
+			want:   new(Export),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.export.SetID(test.want.GetID())
+		test.export.SetDataset(test.want.GetDataset())
+		test.export.SetFormat(test.want.GetFormat())
+		test.export.SetBucket(test.want.GetBucket())
+		test.export.SetObject(test.want.GetObject())
+		test.export.SetRecords(test.want.GetRecords())
+		test.export.SetSince(test.want.GetSince())
+		test.export.SetWatermark(test.want.GetWatermark())
+		test.export.SetCreated(test.want.GetCreated())
+
+		if test.export.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.export.GetID(), test.want.GetID())
+		}
+
+		if test.export.GetDataset() != test.want.GetDataset() {
+			t.Errorf("SetDataset is %v, want %v", test.export.GetDataset(), test.want.GetDataset())
+		}
+
+		if test.export.GetFormat() != test.want.GetFormat() {
+			t.Errorf("SetFormat is %v, want %v", test.export.GetFormat(), test.want.GetFormat())
+		}
+
+		if test.export.GetBucket() != test.want.GetBucket() {
+			t.Errorf("SetBucket is %v, want %v", test.export.GetBucket(), test.want.GetBucket())
+		}
+
+		if test.export.GetObject() != test.want.GetObject() {
+			t.Errorf("SetObject is %v, want %v", test.export.GetObject(), test.want.GetObject())
+		}
+
+		if test.export.GetRecords() != test.want.GetRecords() {
+			t.Errorf("SetRecords is %v, want %v", test.export.GetRecords(), test.want.GetRecords())
+		}
+
+		if test.export.GetSince() != test.want.GetSince() {
+			t.Errorf("SetSince is %v, want %v", test.export.GetSince(), test.want.GetSince())
+		}
+
+		if test.export.GetWatermark() != test.want.GetWatermark() {
+			t.Errorf("SetWatermark is %v, want %v", test.export.GetWatermark(), test.want.GetWatermark())
+		}
+
+		if test.export.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.export.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestExport_String(t *testing.T) {
+	// setup types
+	e := testExport()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Dataset: %s,
+  Format: %s,
+  Bucket: %s,
+  Object: %s,
+  Records: %d,
+  Since: %d,
+  Watermark: %d,
+  Created: %d,
+}`,
+		e.GetID(),
+		e.GetDataset(),
+		e.GetFormat(),
+		e.GetBucket(),
+		e.GetObject(),
+		e.GetRecords(),
+		e.GetSince(),
+		e.GetWatermark(),
+		e.GetCreated(),
+	)
+
+	// run test
+	got := e.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testExport is a test helper function to create a Export
+// type with all fields set to a fake value.
+func testExport() *Export {
+	e := new(Export)
+
+	e.SetID(1)
+	e.SetDataset("builds")
+	e.SetFormat("jsonl")
+	e.SetBucket("vela-exports")
+	e.SetObject("vela/builds/builds-1563474000-1563477600.jsonl")
+	e.SetRecords(10)
+	e.SetSince(1563474000)
+	e.SetWatermark(1563477600)
+	e.SetCreated(1563477601)
+
+	return e
+}
@@ -0,0 +1,389 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// FreezeScopeDeploy represents a freeze that
+	// only blocks builds for the deployment event.
+	FreezeScopeDeploy = "deploy"
+
+	// FreezeScopeAll represents a freeze that
+	// blocks builds for every event.
+	FreezeScopeAll = "all"
+)
+
+// Freeze is the API representation of a change freeze that blocks
+// builds for an org, or for every org when the org is empty,
+// during a window of time.
+//
+// swagger:model Freeze
+type Freeze struct {
+	ID           *int64    `json:"id,omitempty"`
+	Org          *string   `json:"org,omitempty"`
+	Name         *string   `json:"name,omitempty"`
+	Reason       *string   `json:"reason,omitempty"`
+	Scope        *string   `json:"scope,omitempty"`
+	Starts       *int64    `json:"starts,omitempty"`
+	Ends         *int64    `json:"ends,omitempty"`
+	ExemptRepos  *[]string `json:"exempt_repos,omitempty"`
+	ExemptEvents *[]string `json:"exempt_events,omitempty"`
+	CreatedAt    *int64    `json:"created_at,omitempty"`
+	CreatedBy    *string   `json:"created_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetID() int64 {
+	// return zero value if Freeze type or ID field is nil
+	if f == nil || f.ID == nil {
+		return 0
+	}
+
+	return *f.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetOrg() string {
+	// return zero value if Freeze type or Org field is nil
+	if f == nil || f.Org == nil {
+		return ""
+	}
+
+	return *f.Org
+}
+
+// GetName returns the Name field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetName() string {
+	// return zero value if Freeze type or Name field is nil
+	if f == nil || f.Name == nil {
+		return ""
+	}
+
+	return *f.Name
+}
+
+// GetReason returns the Reason field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetReason() string {
+	// return zero value if Freeze type or Reason field is nil
+	if f == nil || f.Reason == nil {
+		return ""
+	}
+
+	return *f.Reason
+}
+
+// GetScope returns the Scope field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetScope() string {
+	// return zero value if Freeze type or Scope field is nil
+	if f == nil || f.Scope == nil {
+		return ""
+	}
+
+	return *f.Scope
+}
+
+// GetStarts returns the Starts field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetStarts() int64 {
+	// return zero value if Freeze type or Starts field is nil
+	if f == nil || f.Starts == nil {
+		return 0
+	}
+
+	return *f.Starts
+}
+
+// GetEnds returns the Ends field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetEnds() int64 {
+	// return zero value if Freeze type or Ends field is nil
+	if f == nil || f.Ends == nil {
+		return 0
+	}
+
+	return *f.Ends
+}
+
+// GetExemptRepos returns the ExemptRepos field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetExemptRepos() []string {
+	// return zero value if Freeze type or ExemptRepos field is nil
+	if f == nil || f.ExemptRepos == nil {
+		return []string{}
+	}
+
+	return *f.ExemptRepos
+}
+
+// GetExemptEvents returns the ExemptEvents field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetExemptEvents() []string {
+	// return zero value if Freeze type or ExemptEvents field is nil
+	if f == nil || f.ExemptEvents == nil {
+		return []string{}
+	}
+
+	return *f.ExemptEvents
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetCreatedAt() int64 {
+	// return zero value if Freeze type or CreatedAt field is nil
+	if f == nil || f.CreatedAt == nil {
+		return 0
+	}
+
+	return *f.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided Freeze type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *Freeze) GetCreatedBy() string {
+	// return zero value if Freeze type or CreatedBy field is nil
+	if f == nil || f.CreatedBy == nil {
+		return ""
+	}
+
+	return *f.CreatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetID(v int64) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetOrg(v string) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.Org = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetName(v string) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.Name = &v
+}
+
+// SetReason sets the Reason field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetReason(v string) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.Reason = &v
+}
+
+// SetScope sets the Scope field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetScope(v string) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.Scope = &v
+}
+
+// SetStarts sets the Starts field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetStarts(v int64) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.Starts = &v
+}
+
+// SetEnds sets the Ends field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetEnds(v int64) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.Ends = &v
+}
+
+// SetExemptRepos sets the ExemptRepos field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetExemptRepos(v []string) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.ExemptRepos = &v
+}
+
+// SetExemptEvents sets the ExemptEvents field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetExemptEvents(v []string) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.ExemptEvents = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetCreatedAt(v int64) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided Freeze type is nil, it
+// will set nothing and immediately return.
+func (f *Freeze) SetCreatedBy(v string) {
+	// return if Freeze type is nil
+	if f == nil {
+		return
+	}
+
+	f.CreatedBy = &v
+}
+
+// Active returns true when the provided unix
+// time is within the window of the Freeze.
+func (f *Freeze) Active(now int64) bool {
+	return f.GetStarts() <= now && now < f.GetEnds()
+}
+
+// Blocks returns true when the Freeze is active at the provided
+// unix time and blocks builds for the event of the repo, by its
+// full name, because neither the repo nor the event are exempt.
+func (f *Freeze) Blocks(fullName, event string, now int64) bool {
+	if !f.Active(now) {
+		return false
+	}
+
+	if f.GetScope() != FreezeScopeAll && !strings.EqualFold(event, constants.EventDeploy) {
+		return false
+	}
+
+	for _, r := range f.GetExemptRepos() {
+		if strings.EqualFold(r, fullName) {
+			return false
+		}
+	}
+
+	for _, e := range f.GetExemptEvents() {
+		if strings.EqualFold(e, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String implements the Stringer interface for the Freeze type.
+func (f *Freeze) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Name: %s,
+  Reason: %s,
+  Scope: %s,
+  Starts: %d,
+  Ends: %d,
+  ExemptRepos: %s,
+  ExemptEvents: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+}`,
+		f.GetID(),
+		f.GetOrg(),
+		f.GetName(),
+		f.GetReason(),
+		f.GetScope(),
+		f.GetStarts(),
+		f.GetEnds(),
+		f.GetExemptRepos(),
+		f.GetExemptEvents(),
+		f.GetCreatedAt(),
+		f.GetCreatedBy(),
+	)
+}
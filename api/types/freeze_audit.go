@@ -0,0 +1,412 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// FreezeAuditCreated represents the creation of a freeze.
+	FreezeAuditCreated = "created"
+
+	// FreezeAuditDeleted represents the deletion of a freeze.
+	FreezeAuditDeleted = "deleted"
+
+	// FreezeAuditOverridden represents a build that was
+	// allowed to run during a freeze by an admin.
+	FreezeAuditOverridden = "overridden"
+)
+
+// FreezeAudit is the API representation of a record of
+// a change freeze being created, deleted or overridden.
+//
+// swagger:model FreezeAudit
+type FreezeAudit struct {
+	ID        *int64  `json:"id,omitempty"`
+	FreezeID  *int64  `json:"freeze_id,omitempty"`
+	Org       *string `json:"org,omitempty"`
+	Action    *string `json:"action,omitempty"`
+	Actor     *string `json:"actor,omitempty"`
+	Repo      *string `json:"repo,omitempty"`
+	Event     *string `json:"event,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	IP        *string `json:"ip,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+	Client    *string `json:"client,omitempty"`
+	Location  *string `json:"location,omitempty"`
+	Created   *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetID() int64 {
+	// return zero value if FreezeAudit type or ID field is nil
+	if a == nil || a.ID == nil {
+		return 0
+	}
+
+	return *a.ID
+}
+
+// GetFreezeID returns the FreezeID field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetFreezeID() int64 {
+	// return zero value if FreezeAudit type or FreezeID field is nil
+	if a == nil || a.FreezeID == nil {
+		return 0
+	}
+
+	return *a.FreezeID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetOrg() string {
+	// return zero value if FreezeAudit type or Org field is nil
+	if a == nil || a.Org == nil {
+		return ""
+	}
+
+	return *a.Org
+}
+
+// GetAction returns the Action field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetAction() string {
+	// return zero value if FreezeAudit type or Action field is nil
+	if a == nil || a.Action == nil {
+		return ""
+	}
+
+	return *a.Action
+}
+
+// GetActor returns the Actor field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetActor() string {
+	// return zero value if FreezeAudit type or Actor field is nil
+	if a == nil || a.Actor == nil {
+		return ""
+	}
+
+	return *a.Actor
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetRepo() string {
+	// return zero value if FreezeAudit type or Repo field is nil
+	if a == nil || a.Repo == nil {
+		return ""
+	}
+
+	return *a.Repo
+}
+
+// GetEvent returns the Event field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetEvent() string {
+	// return zero value if FreezeAudit type or Event field is nil
+	if a == nil || a.Event == nil {
+		return ""
+	}
+
+	return *a.Event
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetMessage() string {
+	// return zero value if FreezeAudit type or Message field is nil
+	if a == nil || a.Message == nil {
+		return ""
+	}
+
+	return *a.Message
+}
+
+// GetIP returns the IP field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetIP() string {
+	// return zero value if FreezeAudit type or IP field is nil
+	if a == nil || a.IP == nil {
+		return ""
+	}
+
+	return *a.IP
+}
+
+// GetUserAgent returns the UserAgent field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetUserAgent() string {
+	// return zero value if FreezeAudit type or UserAgent field is nil
+	if a == nil || a.UserAgent == nil {
+		return ""
+	}
+
+	return *a.UserAgent
+}
+
+// GetClient returns the Client field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetClient() string {
+	// return zero value if FreezeAudit type or Client field is nil
+	if a == nil || a.Client == nil {
+		return ""
+	}
+
+	return *a.Client
+}
+
+// GetLocation returns the Location field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetLocation() string {
+	// return zero value if FreezeAudit type or Location field is nil
+	if a == nil || a.Location == nil {
+		return ""
+	}
+
+	return *a.Location
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided FreezeAudit type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (a *FreezeAudit) GetCreated() int64 {
+	// return zero value if FreezeAudit type or Created field is nil
+	if a == nil || a.Created == nil {
+		return 0
+	}
+
+	return *a.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetID(v int64) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.ID = &v
+}
+
+// SetFreezeID sets the FreezeID field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetFreezeID(v int64) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.FreezeID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetOrg(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Org = &v
+}
+
+// SetAction sets the Action field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetAction(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Action = &v
+}
+
+// SetActor sets the Actor field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetActor(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Actor = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetRepo(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Repo = &v
+}
+
+// SetEvent sets the Event field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetEvent(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Event = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetMessage(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Message = &v
+}
+
+// SetIP sets the IP field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetIP(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.IP = &v
+}
+
+// SetUserAgent sets the UserAgent field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetUserAgent(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.UserAgent = &v
+}
+
+// SetClient sets the Client field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetClient(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Client = &v
+}
+
+// SetLocation sets the Location field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetLocation(v string) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Location = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided FreezeAudit type is nil, it
+// will set nothing and immediately return.
+func (a *FreezeAudit) SetCreated(v int64) {
+	// return if FreezeAudit type is nil
+	if a == nil {
+		return
+	}
+
+	a.Created = &v
+}
+
+// String implements the Stringer interface for the FreezeAudit type.
+func (a *FreezeAudit) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  FreezeID: %d,
+  Org: %s,
+  Action: %s,
+  Actor: %s,
+  Repo: %s,
+  Event: %s,
+  Message: %s,
+  IP: %s,
+  UserAgent: %s,
+  Client: %s,
+  Location: %s,
+  Created: %d,
+}`,
+		a.GetID(),
+		a.GetFreezeID(),
+		a.GetOrg(),
+		a.GetAction(),
+		a.GetActor(),
+		a.GetRepo(),
+		a.GetEvent(),
+		a.GetMessage(),
+		a.GetIP(),
+		a.GetUserAgent(),
+		a.GetClient(),
+		a.GetLocation(),
+		a.GetCreated(),
+	)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFreezeAudit_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		audit *FreezeAudit
+		want  *FreezeAudit
+	}{
+		{
+			audit: testFreezeAudit(),
+			want:  testFreezeAudit(),
+		},
+		{
+			audit: new(FreezeAudit),
+			want:  new(FreezeAudit),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.audit.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.audit.GetID(), test.want.GetID())
+		}
+
+		if test.audit.GetFreezeID() != test.want.GetFreezeID() {
+			t.Errorf("GetFreezeID is %v, want %v", test.audit.GetFreezeID(), test.want.GetFreezeID())
+		}
+
+		if test.audit.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.audit.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.audit.GetAction() != test.want.GetAction() {
+			t.Errorf("GetAction is %v, want %v", test.audit.GetAction(), test.want.GetAction())
+		}
+
+		if test.audit.GetActor() != test.want.GetActor() {
+			t.Errorf("GetActor is %v, want %v", test.audit.GetActor(), test.want.GetActor())
+		}
+
+		if test.audit.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.audit.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.audit.GetEvent() != test.want.GetEvent() {
+			t.Errorf("GetEvent is %v, want %v", test.audit.GetEvent(), test.want.GetEvent())
+		}
+
+		if test.audit.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.audit.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.audit.GetIP() != test.want.GetIP() {
+			t.Errorf("GetIP is %v, want %v", test.audit.GetIP(), test.want.GetIP())
+		}
+
+		if test.audit.GetUserAgent() != test.want.GetUserAgent() {
+			t.Errorf("GetUserAgent is %v, want %v", test.audit.GetUserAgent(), test.want.GetUserAgent())
+		}
+
+		if test.audit.GetClient() != test.want.GetClient() {
+			t.Errorf("GetClient is %v, want %v", test.audit.GetClient(), test.want.GetClient())
+		}
+
+		if test.audit.GetLocation() != test.want.GetLocation() {
+			t.Errorf("GetLocation is %v, want %v", test.audit.GetLocation(), test.want.GetLocation())
+		}
+
+		if test.audit.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.audit.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestFreezeAudit_Setters(t *testing.T) {
+	// setup types
+	var a *FreezeAudit
+
+	// setup tests
+	tests := []struct {
+		audit *FreezeAudit
+		want  *FreezeAudit
+	}{
+		{
+			audit: testFreezeAudit(),
+			want:  testFreezeAudit(),
+		},
+		{
+			audit: a,
+			want:  new(FreezeAudit),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.audit.SetID(test.want.GetID())
+		test.audit.SetFreezeID(test.want.GetFreezeID())
+		test.audit.SetOrg(test.want.GetOrg())
+		test.audit.SetAction(test.want.GetAction())
+		test.audit.SetActor(test.want.GetActor())
+		test.audit.SetRepo(test.want.GetRepo())
+		test.audit.SetEvent(test.want.GetEvent())
+		test.audit.SetMessage(test.want.GetMessage())
+		test.audit.SetIP(test.want.GetIP())
+		test.audit.SetUserAgent(test.want.GetUserAgent())
+		test.audit.SetClient(test.want.GetClient())
+		test.audit.SetLocation(test.want.GetLocation())
+		test.audit.SetCreated(test.want.GetCreated())
+
+		if test.audit.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.audit.GetID(), test.want.GetID())
+		}
+
+		if test.audit.GetFreezeID() != test.want.GetFreezeID() {
+			t.Errorf("SetFreezeID is %v, want %v", test.audit.GetFreezeID(), test.want.GetFreezeID())
+		}
+
+		if test.audit.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.audit.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.audit.GetAction() != test.want.GetAction() {
+			t.Errorf("SetAction is %v, want %v", test.audit.GetAction(), test.want.GetAction())
+		}
+
+		if test.audit.GetActor() != test.want.GetActor() {
+			t.Errorf("SetActor is %v, want %v", test.audit.GetActor(), test.want.GetActor())
+		}
+
+		if test.audit.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.audit.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.audit.GetEvent() != test.want.GetEvent() {
+			t.Errorf("SetEvent is %v, want %v", test.audit.GetEvent(), test.want.GetEvent())
+		}
+
+		if test.audit.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.audit.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.audit.GetIP() != test.want.GetIP() {
+			t.Errorf("SetIP is %v, want %v", test.audit.GetIP(), test.want.GetIP())
+		}
+
+		if test.audit.GetUserAgent() != test.want.GetUserAgent() {
+			t.Errorf("SetUserAgent is %v, want %v", test.audit.GetUserAgent(), test.want.GetUserAgent())
+		}
+
+		if test.audit.GetClient() != test.want.GetClient() {
+			t.Errorf("SetClient is %v, want %v", test.audit.GetClient(), test.want.GetClient())
+		}
+
+		if test.audit.GetLocation() != test.want.GetLocation() {
+			t.Errorf("SetLocation is %v, want %v", test.audit.GetLocation(), test.want.GetLocation())
+		}
+
+		if test.audit.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.audit.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestFreezeAudit_String(t *testing.T) {
+	// setup types
+	a := testFreezeAudit()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  FreezeID: %d,
+  Org: %s,
+  Action: %s,
+  Actor: %s,
+  Repo: %s,
+  Event: %s,
+  Message: %s,
+  IP: %s,
+  UserAgent: %s,
+  Client: %s,
+  Location: %s,
+  Created: %d,
+}`,
+		a.GetID(),
+		a.GetFreezeID(),
+		a.GetOrg(),
+		a.GetAction(),
+		a.GetActor(),
+		a.GetRepo(),
+		a.GetEvent(),
+		a.GetMessage(),
+		a.GetIP(),
+		a.GetUserAgent(),
+		a.GetClient(),
+		a.GetLocation(),
+		a.GetCreated(),
+	)
+
+	// run test
+	got := a.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testFreezeAudit is a test helper function to create a FreezeAudit
+// type with all fields set to a fake value.
+func testFreezeAudit() *FreezeAudit {
+	a := new(FreezeAudit)
+
+	a.SetID(1)
+	a.SetFreezeID(1)
+	a.SetOrg("github")
+	a.SetAction("overridden")
+	a.SetActor("octocat")
+	a.SetRepo("github/octocat")
+	a.SetEvent("deployment")
+	a.SetMessage("deploying hotfix for outage")
+	a.SetIP("1.2.3.4")
+	a.SetUserAgent("vela-cli")
+	a.SetClient("Vela CLI")
+	a.SetLocation("Minneapolis, Minnesota, US")
+	a.SetCreated(1563474076)
+
+	return a
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFreeze_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		freeze *Freeze
+		want   *Freeze
+	}{
+		{
+			freeze: testFreeze(),
+			want:   testFreeze(),
+		},
+		{
+			freeze: new(Freeze),
+			want:   new(Freeze),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.freeze.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.freeze.GetID(), test.want.GetID())
+		}
+
+		if test.freeze.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.freeze.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.freeze.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.freeze.GetName(), test.want.GetName())
+		}
+
+		if test.freeze.GetReason() != test.want.GetReason() {
+			t.Errorf("GetReason is %v, want %v", test.freeze.GetReason(), test.want.GetReason())
+		}
+
+		if test.freeze.GetScope() != test.want.GetScope() {
+			t.Errorf("GetScope is %v, want %v", test.freeze.GetScope(), test.want.GetScope())
+		}
+
+		if test.freeze.GetStarts() != test.want.GetStarts() {
+			t.Errorf("GetStarts is %v, want %v", test.freeze.GetStarts(), test.want.GetStarts())
+		}
+
+		if test.freeze.GetEnds() != test.want.GetEnds() {
+			t.Errorf("GetEnds is %v, want %v", test.freeze.GetEnds(), test.want.GetEnds())
+		}
+
+		if !reflect.DeepEqual(test.freeze.GetExemptRepos(), test.want.GetExemptRepos()) {
+			t.Errorf("GetExemptRepos is %v, want %v", test.freeze.GetExemptRepos(), test.want.GetExemptRepos())
+		}
+
+		if !reflect.DeepEqual(test.freeze.GetExemptEvents(), test.want.GetExemptEvents()) {
+			t.Errorf("GetExemptEvents is %v, want %v", test.freeze.GetExemptEvents(), test.want.GetExemptEvents())
+		}
+
+		if test.freeze.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.freeze.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.freeze.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.freeze.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+	}
+}
+
+func TestFreeze_Setters(t *testing.T) {
+	// setup types
+	var f *Freeze
+
+	// setup tests
+	tests := []struct {
+		freeze *Freeze
+		want   *Freeze
+	}{
+		{
+			freeze: testFreeze(),
+			want:   testFreeze(),
+		},
+		{
+			freeze: f,
+			want:   new(Freeze),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.freeze.SetID(test.want.GetID())
+		test.freeze.SetOrg(test.want.GetOrg())
+		test.freeze.SetName(test.want.GetName())
+		test.freeze.SetReason(test.want.GetReason())
+		test.freeze.SetScope(test.want.GetScope())
+		test.freeze.SetStarts(test.want.GetStarts())
+		test.freeze.SetEnds(test.want.GetEnds())
+		test.freeze.SetExemptRepos(test.want.GetExemptRepos())
+		test.freeze.SetExemptEvents(test.want.GetExemptEvents())
+		test.freeze.SetCreatedAt(test.want.GetCreatedAt())
+		test.freeze.SetCreatedBy(test.want.GetCreatedBy())
+
+		if test.freeze.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.freeze.GetID(), test.want.GetID())
+		}
+
+		if test.freeze.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.freeze.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.freeze.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.freeze.GetName(), test.want.GetName())
+		}
+
+		if test.freeze.GetReason() != test.want.GetReason() {
+			t.Errorf("SetReason is %v, want %v", test.freeze.GetReason(), test.want.GetReason())
+		}
+
+		if test.freeze.GetScope() != test.want.GetScope() {
+			t.Errorf("SetScope is %v, want %v", test.freeze.GetScope(), test.want.GetScope())
+		}
+
+		if test.freeze.GetStarts() != test.want.GetStarts() {
+			t.Errorf("SetStarts is %v, want %v", test.freeze.GetStarts(), test.want.GetStarts())
+		}
+
+		if test.freeze.GetEnds() != test.want.GetEnds() {
+			t.Errorf("SetEnds is %v, want %v", test.freeze.GetEnds(), test.want.GetEnds())
+		}
+
+		if !reflect.DeepEqual(test.freeze.GetExemptRepos(), test.want.GetExemptRepos()) {
+			t.Errorf("SetExemptRepos is %v, want %v", test.freeze.GetExemptRepos(), test.want.GetExemptRepos())
+		}
+
+		if !reflect.DeepEqual(test.freeze.GetExemptEvents(), test.want.GetExemptEvents()) {
+			t.Errorf("SetExemptEvents is %v, want %v", test.freeze.GetExemptEvents(), test.want.GetExemptEvents())
+		}
+
+		if test.freeze.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.freeze.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.freeze.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.freeze.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+	}
+}
+
+func TestFreeze_Blocks(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		scope    string
+		fullName string
+		event    string
+		now      int64
+		want     bool
+	}{
+		{scope: FreezeScopeDeploy, fullName: "github/server", event: "deployment", now: 1563474076, want: true},
+		{scope: FreezeScopeDeploy, fullName: "github/server", event: "push", now: 1563474076, want: false},
+		{scope: FreezeScopeAll, fullName: "github/server", event: "push", now: 1563474076, want: true},
+		{scope: FreezeScopeAll, fullName: "github/server", event: "pull_request", now: 1563474076, want: false},
+		{scope: FreezeScopeDeploy, fullName: "github/octocat", event: "deployment", now: 1563474076, want: false},
+		{scope: FreezeScopeDeploy, fullName: "github/server", event: "deployment", now: 1563474075, want: false},
+		{scope: FreezeScopeDeploy, fullName: "github/server", event: "deployment", now: 1563560476, want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		f := testFreeze()
+		f.SetScope(test.scope)
+
+		if got := f.Blocks(test.fullName, test.event, test.now); got != test.want {
+			t.Errorf("Blocks for %s %s %s at %d is %v, want %v", test.scope, test.fullName, test.event, test.now, got, test.want)
+		}
+	}
+}
+
+func TestFreeze_String(t *testing.T) {
+	// setup types
+	f := testFreeze()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Name: %s,
+  Reason: %s,
+  Scope: %s,
+  Starts: %d,
+  Ends: %d,
+  ExemptRepos: %s,
+  ExemptEvents: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+}`,
+		f.GetID(),
+		f.GetOrg(),
+		f.GetName(),
+		f.GetReason(),
+		f.GetScope(),
+		f.GetStarts(),
+		f.GetEnds(),
+		f.GetExemptRepos(),
+		f.GetExemptEvents(),
+		f.GetCreatedAt(),
+		f.GetCreatedBy(),
+	)
+
+	// run test
+	got := f.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testFreeze is a test helper function to create a Freeze
+// type with all fields set to a fake value.
+func testFreeze() *Freeze {
+	f := new(Freeze)
+
+	f.SetID(1)
+	f.SetOrg("github")
+	f.SetName("holiday")
+	f.SetReason("end of year change freeze")
+	f.SetScope("deploy")
+	f.SetStarts(1563474076)
+	f.SetEnds(1563560476)
+	f.SetExemptRepos([]string{"github/octocat"})
+	f.SetExemptEvents([]string{"pull_request"})
+	f.SetCreatedAt(1563474000)
+	f.SetCreatedBy("octocat")
+
+	return f
+}
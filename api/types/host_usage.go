@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// HostUsage is the API representation of the build capacity
+// consumed on a worker host.
+//
+// swagger:model HostUsage
+type HostUsage struct {
+	Host    *string  `json:"host,omitempty"`
+	Builds  *int64   `json:"builds,omitempty"`
+	Minutes *float64 `json:"minutes,omitempty"`
+}
+
+// GetHost returns the Host field.
+//
+// When the provided HostUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (h *HostUsage) GetHost() string {
+	// return zero value if HostUsage type or Host field is nil
+	if h == nil || h.Host == nil {
+		return ""
+	}
+
+	return *h.Host
+}
+
+// GetBuilds returns the Builds field.
+//
+// When the provided HostUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (h *HostUsage) GetBuilds() int64 {
+	// return zero value if HostUsage type or Builds field is nil
+	if h == nil || h.Builds == nil {
+		return 0
+	}
+
+	return *h.Builds
+}
+
+// GetMinutes returns the Minutes field.
+//
+// When the provided HostUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (h *HostUsage) GetMinutes() float64 {
+	// return zero value if HostUsage type or Minutes field is nil
+	if h == nil || h.Minutes == nil {
+		return 0
+	}
+
+	return *h.Minutes
+}
+
+// SetHost sets the Host field.
+//
+// When the provided HostUsage type is nil, it
+// will set nothing and immediately return.
+func (h *HostUsage) SetHost(v string) {
+	// return if HostUsage type is nil
+	if h == nil {
+		return
+	}
+
+	h.Host = &v
+}
+
+// SetBuilds sets the Builds field.
+//
+// When the provided HostUsage type is nil, it
+// will set nothing and immediately return.
+func (h *HostUsage) SetBuilds(v int64) {
+	// return if HostUsage type is nil
+	if h == nil {
+		return
+	}
+
+	h.Builds = &v
+}
+
+// SetMinutes sets the Minutes field.
+//
+// When the provided HostUsage type is nil, it
+// will set nothing and immediately return.
+func (h *HostUsage) SetMinutes(v float64) {
+	// return if HostUsage type is nil
+	if h == nil {
+		return
+	}
+
+	h.Minutes = &v
+}
+
+// String implements the Stringer interface for the HostUsage type.
+func (h *HostUsage) String() string {
+	return fmt.Sprintf(`{
+  Host: %s,
+  Builds: %d,
+  Minutes: %v,
+}`,
+		h.GetHost(),
+		h.GetBuilds(),
+		h.GetMinutes(),
+	)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestHostUsage_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		usage *HostUsage
+		want  *HostUsage
+	}{
+		{
+			usage: testHostUsage(),
+			want:  testHostUsage(),
+		},
+		{
+			usage: new(HostUsage),
+			want:  new(HostUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.usage.GetHost() != test.want.GetHost() {
+			t.Errorf("GetHost is %v, want %v", test.usage.GetHost(), test.want.GetHost())
+		}
+
+		if test.usage.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("GetBuilds is %v, want %v", test.usage.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.usage.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("GetMinutes is %v, want %v", test.usage.GetMinutes(), test.want.GetMinutes())
+		}
+	}
+}
+
+func TestHostUsage_Setters(t *testing.T) {
+	// setup types
+	var h *HostUsage
+
+	// setup tests
+	tests := []struct {
+		usage *HostUsage
+		want  *HostUsage
+	}{
+		{
+			usage: testHostUsage(),
+			want:  testHostUsage(),
+		},
+		{
+			usage: h,
+			want:  new(HostUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.usage.SetHost(test.want.GetHost())
+		test.usage.SetBuilds(test.want.GetBuilds())
+		test.usage.SetMinutes(test.want.GetMinutes())
+
+		if test.usage.GetHost() != test.want.GetHost() {
+			t.Errorf("SetHost is %v, want %v", test.usage.GetHost(), test.want.GetHost())
+		}
+
+		if test.usage.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("SetBuilds is %v, want %v", test.usage.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.usage.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("SetMinutes is %v, want %v", test.usage.GetMinutes(), test.want.GetMinutes())
+		}
+	}
+}
+
+func TestHostUsage_String(t *testing.T) {
+	// setup types
+	h := testHostUsage()
+
+	want := fmt.Sprintf(`{
+  Host: %s,
+  Builds: %d,
+  Minutes: %v,
+}`,
+		h.GetHost(),
+		h.GetBuilds(),
+		h.GetMinutes(),
+	)
+
+	// run test
+	got := h.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testHostUsage is a test helper function to create a HostUsage
+// type with all fields set to a fake value.
+func testHostUsage() *HostUsage {
+	h := new(HostUsage)
+
+	h.SetHost("worker-1.example.com")
+	h.SetBuilds(10)
+	h.SetMinutes(125.5)
+
+	return h
+}
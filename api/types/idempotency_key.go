@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// IdempotencyKey is the API representation of a key provided with a build-triggering
+// request used to deduplicate retries of the request.
+//
+// swagger:model IdempotencyKey
+type IdempotencyKey struct {
+	ID          *int64  `json:"id,omitempty"`
+	RepoID      *int64  `json:"repo_id,omitempty"`
+	Key         *string `json:"key,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty"`
+	Status      *int    `json:"status,omitempty"`
+	Response    *string `json:"response,omitempty"`
+	Created     *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided IdempotencyKey type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (k *IdempotencyKey) GetID() int64 {
+	// return zero value if IdempotencyKey type or ID field is nil
+	if k == nil || k.ID == nil {
+		return 0
+	}
+
+	return *k.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided IdempotencyKey type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (k *IdempotencyKey) GetRepoID() int64 {
+	// return zero value if IdempotencyKey type or RepoID field is nil
+	if k == nil || k.RepoID == nil {
+		return 0
+	}
+
+	return *k.RepoID
+}
+
+// GetKey returns the Key field.
+//
+// When the provided IdempotencyKey type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (k *IdempotencyKey) GetKey() string {
+	// return zero value if IdempotencyKey type or Key field is nil
+	if k == nil || k.Key == nil {
+		return ""
+	}
+
+	return *k.Key
+}
+
+// GetFingerprint returns the Fingerprint field.
+//
+// When the provided IdempotencyKey type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (k *IdempotencyKey) GetFingerprint() string {
+	// return zero value if IdempotencyKey type or Fingerprint field is nil
+	if k == nil || k.Fingerprint == nil {
+		return ""
+	}
+
+	return *k.Fingerprint
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided IdempotencyKey type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (k *IdempotencyKey) GetStatus() int {
+	// return zero value if IdempotencyKey type or Status field is nil
+	if k == nil || k.Status == nil {
+		return 0
+	}
+
+	return *k.Status
+}
+
+// GetResponse returns the Response field.
+//
+// When the provided IdempotencyKey type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (k *IdempotencyKey) GetResponse() string {
+	// return zero value if IdempotencyKey type or Response field is nil
+	if k == nil || k.Response == nil {
+		return ""
+	}
+
+	return *k.Response
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided IdempotencyKey type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (k *IdempotencyKey) GetCreated() int64 {
+	// return zero value if IdempotencyKey type or Created field is nil
+	if k == nil || k.Created == nil {
+		return 0
+	}
+
+	return *k.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided IdempotencyKey type is nil, it
+// will set nothing and immediately return.
+func (k *IdempotencyKey) SetID(v int64) {
+	// return if IdempotencyKey type is nil
+	if k == nil {
+		return
+	}
+
+	k.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided IdempotencyKey type is nil, it
+// will set nothing and immediately return.
+func (k *IdempotencyKey) SetRepoID(v int64) {
+	// return if IdempotencyKey type is nil
+	if k == nil {
+		return
+	}
+
+	k.RepoID = &v
+}
+
+// SetKey sets the Key field.
+//
+// When the provided IdempotencyKey type is nil, it
+// will set nothing and immediately return.
+func (k *IdempotencyKey) SetKey(v string) {
+	// return if IdempotencyKey type is nil
+	if k == nil {
+		return
+	}
+
+	k.Key = &v
+}
+
+// SetFingerprint sets the Fingerprint field.
+//
+// When the provided IdempotencyKey type is nil, it
+// will set nothing and immediately return.
+func (k *IdempotencyKey) SetFingerprint(v string) {
+	// return if IdempotencyKey type is nil
+	if k == nil {
+		return
+	}
+
+	k.Fingerprint = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided IdempotencyKey type is nil, it
+// will set nothing and immediately return.
+func (k *IdempotencyKey) SetStatus(v int) {
+	// return if IdempotencyKey type is nil
+	if k == nil {
+		return
+	}
+
+	k.Status = &v
+}
+
+// SetResponse sets the Response field.
+//
+// When the provided IdempotencyKey type is nil, it
+// will set nothing and immediately return.
+func (k *IdempotencyKey) SetResponse(v string) {
+	// return if IdempotencyKey type is nil
+	if k == nil {
+		return
+	}
+
+	k.Response = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided IdempotencyKey type is nil, it
+// will set nothing and immediately return.
+func (k *IdempotencyKey) SetCreated(v int64) {
+	// return if IdempotencyKey type is nil
+	if k == nil {
+		return
+	}
+
+	k.Created = &v
+}
+
+// String implements the Stringer interface for the IdempotencyKey type.
+func (k *IdempotencyKey) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Key: %s,
+  Fingerprint: %s,
+  Status: %d,
+  Response: %s,
+  Created: %d,
+}`,
+		k.GetID(),
+		k.GetRepoID(),
+		k.GetKey(),
+		k.GetFingerprint(),
+		k.GetStatus(),
+		k.GetResponse(),
+		k.GetCreated(),
+	)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestIdempotencyKey_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		idempotencyKey *IdempotencyKey
+		want           *IdempotencyKey
+	}{
+		{
+			idempotencyKey: testIdempotencyKey(),
+			want:           testIdempotencyKey(),
+		},
+		{
+			idempotencyKey: new(IdempotencyKey),
+			want:           new(IdempotencyKey),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.idempotencyKey.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.idempotencyKey.GetID(), test.want.GetID())
+		}
+
+		if test.idempotencyKey.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.idempotencyKey.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.idempotencyKey.GetKey() != test.want.GetKey() {
+			t.Errorf("GetKey is %v, want %v", test.idempotencyKey.GetKey(), test.want.GetKey())
+		}
+
+		if test.idempotencyKey.GetFingerprint() != test.want.GetFingerprint() {
+			t.Errorf("GetFingerprint is %v, want %v", test.idempotencyKey.GetFingerprint(), test.want.GetFingerprint())
+		}
+
+		if test.idempotencyKey.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.idempotencyKey.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.idempotencyKey.GetResponse() != test.want.GetResponse() {
+			t.Errorf("GetResponse is %v, want %v", test.idempotencyKey.GetResponse(), test.want.GetResponse())
+		}
+
+		if test.idempotencyKey.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.idempotencyKey.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestIdempotencyKey_Setters(t *testing.T) {
+	// setup types
+	var k *IdempotencyKey
+
+	// setup tests
+	tests := []struct {
+		idempotencyKey *IdempotencyKey
+		want           *IdempotencyKey
+	}{
+		{
+			idempotencyKey: testIdempotencyKey(),
+			want:           testIdempotencyKey(),
+		},
+		{
+			idempotencyKey: k,
+			want:           new(IdempotencyKey),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.idempotencyKey.SetID(test.want.GetID())
+		test.idempotencyKey.SetRepoID(test.want.GetRepoID())
+		test.idempotencyKey.SetKey(test.want.GetKey())
+		test.idempotencyKey.SetFingerprint(test.want.GetFingerprint())
+		test.idempotencyKey.SetStatus(test.want.GetStatus())
+		test.idempotencyKey.SetResponse(test.want.GetResponse())
+		test.idempotencyKey.SetCreated(test.want.GetCreated())
+
+		if test.idempotencyKey.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.idempotencyKey.GetID(), test.want.GetID())
+		}
+
+		if test.idempotencyKey.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.idempotencyKey.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.idempotencyKey.GetKey() != test.want.GetKey() {
+			t.Errorf("SetKey is %v, want %v", test.idempotencyKey.GetKey(), test.want.GetKey())
+		}
+
+		if test.idempotencyKey.GetFingerprint() != test.want.GetFingerprint() {
+			t.Errorf("SetFingerprint is %v, want %v", test.idempotencyKey.GetFingerprint(), test.want.GetFingerprint())
+		}
+
+		if test.idempotencyKey.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.idempotencyKey.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.idempotencyKey.GetResponse() != test.want.GetResponse() {
+			t.Errorf("SetResponse is %v, want %v", test.idempotencyKey.GetResponse(), test.want.GetResponse())
+		}
+
+		if test.idempotencyKey.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.idempotencyKey.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestIdempotencyKey_String(t *testing.T) {
+	// setup types
+	k := testIdempotencyKey()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Key: %s,
+  Fingerprint: %s,
+  Status: %d,
+  Response: %s,
+  Created: %d,
+}`,
+		k.GetID(),
+		k.GetRepoID(),
+		k.GetKey(),
+		k.GetFingerprint(),
+		k.GetStatus(),
+		k.GetResponse(),
+		k.GetCreated(),
+	)
+
+	// run test
+	got := k.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testIdempotencyKey is a test helper function to create an IdempotencyKey
+// type with all fields set to a fake value.
+func testIdempotencyKey() *IdempotencyKey {
+	k := new(IdempotencyKey)
+
+	k.SetID(1)
+	k.SetRepoID(1)
+	k.SetKey("7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a")
+	k.SetFingerprint("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	k.SetStatus(201)
+	k.SetResponse(`{"id":1}`)
+	k.SetCreated(1563474076)
+
+	return k
+}
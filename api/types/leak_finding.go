@@ -0,0 +1,302 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// LeakKindAWSAccessKey represents an AWS access key ID found in a log.
+	LeakKindAWSAccessKey = "aws_access_key"
+
+	// LeakKindAWSSecretKey represents an AWS secret access key found in a log.
+	LeakKindAWSSecretKey = "aws_secret_key"
+
+	// LeakKindJWT represents a JSON web token found in a log.
+	LeakKindJWT = "jwt"
+
+	// LeakKindPrivateKey represents a PEM encoded private key found in a log.
+	LeakKindPrivateKey = "private_key"
+)
+
+// LeakFinding is the API representation of a credential found in
+// the log for a step or service of a build by the leak scanner.
+//
+// The credential itself is never stored. The Fingerprint field
+// holds a hash of the credential so repeated leaks of the same
+// credential can be correlated.
+//
+// swagger:model LeakFinding
+type LeakFinding struct {
+	ID          *int64  `json:"id,omitempty"`
+	RepoID      *int64  `json:"repo_id,omitempty"`
+	BuildID     *int64  `json:"build_id,omitempty"`
+	LogID       *int64  `json:"log_id,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Line        *int64  `json:"line,omitempty"`
+	Fingerprint *string `json:"fingerprint,omitempty"`
+	Redacted    *bool   `json:"redacted,omitempty"`
+	Created     *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetID() int64 {
+	// return zero value if LeakFinding type or ID field is nil
+	if f == nil || f.ID == nil {
+		return 0
+	}
+
+	return *f.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetRepoID() int64 {
+	// return zero value if LeakFinding type or RepoID field is nil
+	if f == nil || f.RepoID == nil {
+		return 0
+	}
+
+	return *f.RepoID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetBuildID() int64 {
+	// return zero value if LeakFinding type or BuildID field is nil
+	if f == nil || f.BuildID == nil {
+		return 0
+	}
+
+	return *f.BuildID
+}
+
+// GetLogID returns the LogID field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetLogID() int64 {
+	// return zero value if LeakFinding type or LogID field is nil
+	if f == nil || f.LogID == nil {
+		return 0
+	}
+
+	return *f.LogID
+}
+
+// GetKind returns the Kind field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetKind() string {
+	// return zero value if LeakFinding type or Kind field is nil
+	if f == nil || f.Kind == nil {
+		return ""
+	}
+
+	return *f.Kind
+}
+
+// GetLine returns the Line field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetLine() int64 {
+	// return zero value if LeakFinding type or Line field is nil
+	if f == nil || f.Line == nil {
+		return 0
+	}
+
+	return *f.Line
+}
+
+// GetFingerprint returns the Fingerprint field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetFingerprint() string {
+	// return zero value if LeakFinding type or Fingerprint field is nil
+	if f == nil || f.Fingerprint == nil {
+		return ""
+	}
+
+	return *f.Fingerprint
+}
+
+// GetRedacted returns the Redacted field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetRedacted() bool {
+	// return zero value if LeakFinding type or Redacted field is nil
+	if f == nil || f.Redacted == nil {
+		return false
+	}
+
+	return *f.Redacted
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided LeakFinding type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (f *LeakFinding) GetCreated() int64 {
+	// return zero value if LeakFinding type or Created field is nil
+	if f == nil || f.Created == nil {
+		return 0
+	}
+
+	return *f.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetID(v int64) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetRepoID(v int64) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.RepoID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetBuildID(v int64) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.BuildID = &v
+}
+
+// SetLogID sets the LogID field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetLogID(v int64) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.LogID = &v
+}
+
+// SetKind sets the Kind field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetKind(v string) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.Kind = &v
+}
+
+// SetLine sets the Line field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetLine(v int64) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.Line = &v
+}
+
+// SetFingerprint sets the Fingerprint field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetFingerprint(v string) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.Fingerprint = &v
+}
+
+// SetRedacted sets the Redacted field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetRedacted(v bool) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.Redacted = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided LeakFinding type is nil, it
+// will set nothing and immediately return.
+func (f *LeakFinding) SetCreated(v int64) {
+	// return if LeakFinding type is nil
+	if f == nil {
+		return
+	}
+
+	f.Created = &v
+}
+
+// String implements the Stringer interface for the LeakFinding type.
+func (f *LeakFinding) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  LogID: %d,
+  Kind: %s,
+  Line: %d,
+  Fingerprint: %s,
+  Redacted: %t,
+  Created: %d,
+}`,
+		f.GetID(),
+		f.GetRepoID(),
+		f.GetBuildID(),
+		f.GetLogID(),
+		f.GetKind(),
+		f.GetLine(),
+		f.GetFingerprint(),
+		f.GetRedacted(),
+		f.GetCreated(),
+	)
+}
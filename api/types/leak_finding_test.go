@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLeakFinding_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		finding *LeakFinding
+		want    *LeakFinding
+	}{
+		{
+			finding: testLeakFinding(),
+			want:    testLeakFinding(),
+		},
+		{
+			finding: new(LeakFinding),
+			want:    new(LeakFinding),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.finding.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.finding.GetID(), test.want.GetID())
+		}
+
+		if test.finding.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.finding.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.finding.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.finding.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.finding.GetLogID() != test.want.GetLogID() {
+			t.Errorf("GetLogID is %v, want %v", test.finding.GetLogID(), test.want.GetLogID())
+		}
+
+		if test.finding.GetKind() != test.want.GetKind() {
+			t.Errorf("GetKind is %v, want %v", test.finding.GetKind(), test.want.GetKind())
+		}
+
+		if test.finding.GetLine() != test.want.GetLine() {
+			t.Errorf("GetLine is %v, want %v", test.finding.GetLine(), test.want.GetLine())
+		}
+
+		if test.finding.GetFingerprint() != test.want.GetFingerprint() {
+			t.Errorf("GetFingerprint is %v, want %v", test.finding.GetFingerprint(), test.want.GetFingerprint())
+		}
+
+		if test.finding.GetRedacted() != test.want.GetRedacted() {
+			t.Errorf("GetRedacted is %v, want %v", test.finding.GetRedacted(), test.want.GetRedacted())
+		}
+
+		if test.finding.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.finding.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestLeakFinding_Setters(t *testing.T) {
+	// setup types
+	var f *LeakFinding
+
+	// setup tests
+	tests := []struct {
+		finding *LeakFinding
+		want    *LeakFinding
+	}{
+		{
+			finding: testLeakFinding(),
+			want:    testLeakFinding(),
+		},
+		{
+			finding: f,
+			want:    new(LeakFinding),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.finding.SetID(test.want.GetID())
+		test.finding.SetRepoID(test.want.GetRepoID())
+		test.finding.SetBuildID(test.want.GetBuildID())
+		test.finding.SetLogID(test.want.GetLogID())
+		test.finding.SetKind(test.want.GetKind())
+		test.finding.SetLine(test.want.GetLine())
+		test.finding.SetFingerprint(test.want.GetFingerprint())
+		test.finding.SetRedacted(test.want.GetRedacted())
+		test.finding.SetCreated(test.want.GetCreated())
+
+		if test.finding.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.finding.GetID(), test.want.GetID())
+		}
+
+		if test.finding.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.finding.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.finding.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.finding.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.finding.GetLogID() != test.want.GetLogID() {
+			t.Errorf("SetLogID is %v, want %v", test.finding.GetLogID(), test.want.GetLogID())
+		}
+
+		if test.finding.GetKind() != test.want.GetKind() {
+			t.Errorf("SetKind is %v, want %v", test.finding.GetKind(), test.want.GetKind())
+		}
+
+		if test.finding.GetLine() != test.want.GetLine() {
+			t.Errorf("SetLine is %v, want %v", test.finding.GetLine(), test.want.GetLine())
+		}
+
+		if test.finding.GetFingerprint() != test.want.GetFingerprint() {
+			t.Errorf("SetFingerprint is %v, want %v", test.finding.GetFingerprint(), test.want.GetFingerprint())
+		}
+
+		if test.finding.GetRedacted() != test.want.GetRedacted() {
+			t.Errorf("SetRedacted is %v, want %v", test.finding.GetRedacted(), test.want.GetRedacted())
+		}
+
+		if test.finding.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.finding.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestLeakFinding_String(t *testing.T) {
+	// setup types
+	f := testLeakFinding()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  LogID: %d,
+  Kind: %s,
+  Line: %d,
+  Fingerprint: %s,
+  Redacted: %t,
+  Created: %d,
+}`,
+		f.GetID(),
+		f.GetRepoID(),
+		f.GetBuildID(),
+		f.GetLogID(),
+		f.GetKind(),
+		f.GetLine(),
+		f.GetFingerprint(),
+		f.GetRedacted(),
+		f.GetCreated(),
+	)
+
+	// run test
+	got := f.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testLeakFinding is a test helper function to create a LeakFinding
+// type with all fields set to a fake value.
+func testLeakFinding() *LeakFinding {
+	f := new(LeakFinding)
+
+	f.SetID(1)
+	f.SetRepoID(1)
+	f.SetBuildID(1)
+	f.SetLogID(1)
+	f.SetKind(LeakKindAWSAccessKey)
+	f.SetLine(3)
+	f.SetFingerprint("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	f.SetRedacted(true)
+	f.SetCreated(1563474077)
+
+	return f
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// Lease is the API representation of the lease a server
+// replica holds to run a singleton background component.
+//
+// swagger:model Lease
+type Lease struct {
+	ID       *int64  `json:"id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Holder   *string `json:"holder,omitempty"`
+	State    *string `json:"state,omitempty"`
+	Acquired *int64  `json:"acquired,omitempty"`
+	Renewed  *int64  `json:"renewed,omitempty"`
+	Expires  *int64  `json:"expires,omitempty"`
+	Changes  *int64  `json:"changes,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetID() int64 {
+	// return zero value if Lease type or ID field is nil
+	if l == nil || l.ID == nil {
+		return 0
+	}
+
+	return *l.ID
+}
+
+// GetName returns the Name field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetName() string {
+	// return zero value if Lease type or Name field is nil
+	if l == nil || l.Name == nil {
+		return ""
+	}
+
+	return *l.Name
+}
+
+// GetHolder returns the Holder field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetHolder() string {
+	// return zero value if Lease type or Holder field is nil
+	if l == nil || l.Holder == nil {
+		return ""
+	}
+
+	return *l.Holder
+}
+
+// GetState returns the State field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetState() string {
+	// return zero value if Lease type or State field is nil
+	if l == nil || l.State == nil {
+		return ""
+	}
+
+	return *l.State
+}
+
+// GetAcquired returns the Acquired field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetAcquired() int64 {
+	// return zero value if Lease type or Acquired field is nil
+	if l == nil || l.Acquired == nil {
+		return 0
+	}
+
+	return *l.Acquired
+}
+
+// GetRenewed returns the Renewed field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetRenewed() int64 {
+	// return zero value if Lease type or Renewed field is nil
+	if l == nil || l.Renewed == nil {
+		return 0
+	}
+
+	return *l.Renewed
+}
+
+// GetExpires returns the Expires field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetExpires() int64 {
+	// return zero value if Lease type or Expires field is nil
+	if l == nil || l.Expires == nil {
+		return 0
+	}
+
+	return *l.Expires
+}
+
+// GetChanges returns the Changes field.
+//
+// When the provided Lease type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *Lease) GetChanges() int64 {
+	// return zero value if Lease type or Changes field is nil
+	if l == nil || l.Changes == nil {
+		return 0
+	}
+
+	return *l.Changes
+}
+
+// SetID sets the ID field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetID(v int64) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.ID = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetName(v string) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.Name = &v
+}
+
+// SetHolder sets the Holder field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetHolder(v string) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.Holder = &v
+}
+
+// SetState sets the State field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetState(v string) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.State = &v
+}
+
+// SetAcquired sets the Acquired field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetAcquired(v int64) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.Acquired = &v
+}
+
+// SetRenewed sets the Renewed field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetRenewed(v int64) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.Renewed = &v
+}
+
+// SetExpires sets the Expires field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetExpires(v int64) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.Expires = &v
+}
+
+// SetChanges sets the Changes field.
+//
+// When the provided Lease type is nil, it
+// will set nothing and immediately return.
+func (l *Lease) SetChanges(v int64) {
+	// return if Lease type is nil
+	if l == nil {
+		return
+	}
+
+	l.Changes = &v
+}
+
+// String implements the Stringer interface for the Lease type.
+func (l *Lease) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Name: %s,
+  Holder: %s,
+  State: %s,
+  Acquired: %d,
+  Renewed: %d,
+  Expires: %d,
+  Changes: %d,
+}`,
+		l.GetID(),
+		l.GetName(),
+		l.GetHolder(),
+		l.GetState(),
+		l.GetAcquired(),
+		l.GetRenewed(),
+		l.GetExpires(),
+		l.GetChanges(),
+	)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLease_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		lease *Lease
+		want  *Lease
+	}{
+		{
+			lease: testLease(),
+			want:  testLease(),
+		},
+		{
+			lease: new(Lease),
+			want:  new(Lease),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.lease.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.lease.GetID(), test.want.GetID())
+		}
+
+		if test.lease.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.lease.GetName(), test.want.GetName())
+		}
+
+		if test.lease.GetHolder() != test.want.GetHolder() {
+			t.Errorf("GetHolder is %v, want %v", test.lease.GetHolder(), test.want.GetHolder())
+		}
+
+		if test.lease.GetState() != test.want.GetState() {
+			t.Errorf("GetState is %v, want %v", test.lease.GetState(), test.want.GetState())
+		}
+
+		if test.lease.GetAcquired() != test.want.GetAcquired() {
+			t.Errorf("GetAcquired is %v, want %v", test.lease.GetAcquired(), test.want.GetAcquired())
+		}
+
+		if test.lease.GetRenewed() != test.want.GetRenewed() {
+			t.Errorf("GetRenewed is %v, want %v", test.lease.GetRenewed(), test.want.GetRenewed())
+		}
+
+		if test.lease.GetExpires() != test.want.GetExpires() {
+			t.Errorf("GetExpires is %v, want %v", test.lease.GetExpires(), test.want.GetExpires())
+		}
+
+		if test.lease.GetChanges() != test.want.GetChanges() {
+			t.Errorf("GetChanges is %v, want %v", test.lease.GetChanges(), test.want.GetChanges())
+		}
+	}
+}
+
+func TestLease_Setters(t *testing.T) {
+	// setup types
+	var l *Lease
+
+	// setup tests
+	tests := []struct {
+		lease *Lease
+		want  *Lease
+	}{
+		{
+			lease: testLease(),
+			want:  testLease(),
+		},
+		{
+			lease: l,
+			want:  new(Lease),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.lease.SetID(test.want.GetID())
+		test.lease.SetName(test.want.GetName())
+		test.lease.SetHolder(test.want.GetHolder())
+		test.lease.SetState(test.want.GetState())
+		test.lease.SetAcquired(test.want.GetAcquired())
+		test.lease.SetRenewed(test.want.GetRenewed())
+		test.lease.SetExpires(test.want.GetExpires())
+		test.lease.SetChanges(test.want.GetChanges())
+
+		if test.lease.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.lease.GetID(), test.want.GetID())
+		}
+
+		if test.lease.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.lease.GetName(), test.want.GetName())
+		}
+
+		if test.lease.GetHolder() != test.want.GetHolder() {
+			t.Errorf("SetHolder is %v, want %v", test.lease.GetHolder(), test.want.GetHolder())
+		}
+
+		if test.lease.GetState() != test.want.GetState() {
+			t.Errorf("SetState is %v, want %v", test.lease.GetState(), test.want.GetState())
+		}
+
+		if test.lease.GetAcquired() != test.want.GetAcquired() {
+			t.Errorf("SetAcquired is %v, want %v", test.lease.GetAcquired(), test.want.GetAcquired())
+		}
+
+		if test.lease.GetRenewed() != test.want.GetRenewed() {
+			t.Errorf("SetRenewed is %v, want %v", test.lease.GetRenewed(), test.want.GetRenewed())
+		}
+
+		if test.lease.GetExpires() != test.want.GetExpires() {
+			t.Errorf("SetExpires is %v, want %v", test.lease.GetExpires(), test.want.GetExpires())
+		}
+
+		if test.lease.GetChanges() != test.want.GetChanges() {
+			t.Errorf("SetChanges is %v, want %v", test.lease.GetChanges(), test.want.GetChanges())
+		}
+	}
+}
+
+func TestLease_String(t *testing.T) {
+	// setup types
+	l := testLease()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Name: %s,
+  Holder: %s,
+  State: %s,
+  Acquired: %d,
+  Renewed: %d,
+  Expires: %d,
+  Changes: %d,
+}`,
+		l.GetID(),
+		l.GetName(),
+		l.GetHolder(),
+		l.GetState(),
+		l.GetAcquired(),
+		l.GetRenewed(),
+		l.GetExpires(),
+		l.GetChanges(),
+	)
+
+	// run test
+	got := l.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testLease is a test helper function to create a Lease
+// type with all fields set to a fake value.
+func testLease() *Lease {
+	l := new(Lease)
+
+	l.SetID(1)
+	l.SetName("janitor")
+	l.SetHolder("vela-server-0")
+	l.SetState("1563474076")
+	l.SetAcquired(1563474076)
+	l.SetRenewed(1563474086)
+	l.SetExpires(1563474116)
+	l.SetChanges(1)
+
+	return l
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// LogStreamStdout represents the stream for
+	// output written to standard out.
+	LogStreamStdout = "stdout"
+
+	// LogStreamStderr represents the stream for
+	// output written to standard error.
+	LogStreamStderr = "stderr"
+)
+
+// LogLine is the API representation of a single line of output
+// captured from a step or service with its metadata.
+//
+// swagger:model LogLine
+type LogLine struct {
+	ID        *int64  `json:"id,omitempty"`
+	BuildID   *int64  `json:"build_id,omitempty"`
+	LogID     *int64  `json:"log_id,omitempty"`
+	Number    *int    `json:"number,omitempty"`
+	Stream    *string `json:"stream,omitempty"`
+	Timestamp *int64  `json:"timestamp,omitempty"`
+	Data      *string `json:"data,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided LogLine type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *LogLine) GetID() int64 {
+	// return zero value if LogLine type or ID field is nil
+	if l == nil || l.ID == nil {
+		return 0
+	}
+
+	return *l.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided LogLine type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *LogLine) GetBuildID() int64 {
+	// return zero value if LogLine type or BuildID field is nil
+	if l == nil || l.BuildID == nil {
+		return 0
+	}
+
+	return *l.BuildID
+}
+
+// GetLogID returns the LogID field.
+//
+// When the provided LogLine type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *LogLine) GetLogID() int64 {
+	// return zero value if LogLine type or LogID field is nil
+	if l == nil || l.LogID == nil {
+		return 0
+	}
+
+	return *l.LogID
+}
+
+// GetNumber returns the Number field.
+//
+// When the provided LogLine type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *LogLine) GetNumber() int {
+	// return zero value if LogLine type or Number field is nil
+	if l == nil || l.Number == nil {
+		return 0
+	}
+
+	return *l.Number
+}
+
+// GetStream returns the Stream field.
+//
+// When the provided LogLine type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *LogLine) GetStream() string {
+	// return zero value if LogLine type or Stream field is nil
+	if l == nil || l.Stream == nil {
+		return ""
+	}
+
+	return *l.Stream
+}
+
+// GetTimestamp returns the Timestamp field.
+//
+// When the provided LogLine type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *LogLine) GetTimestamp() int64 {
+	// return zero value if LogLine type or Timestamp field is nil
+	if l == nil || l.Timestamp == nil {
+		return 0
+	}
+
+	return *l.Timestamp
+}
+
+// GetData returns the Data field.
+//
+// When the provided LogLine type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (l *LogLine) GetData() string {
+	// return zero value if LogLine type or Data field is nil
+	if l == nil || l.Data == nil {
+		return ""
+	}
+
+	return *l.Data
+}
+
+// SetID sets the ID field.
+//
+// When the provided LogLine type is nil, it
+// will set nothing and immediately return.
+func (l *LogLine) SetID(v int64) {
+	// return if LogLine type is nil
+	if l == nil {
+		return
+	}
+
+	l.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided LogLine type is nil, it
+// will set nothing and immediately return.
+func (l *LogLine) SetBuildID(v int64) {
+	// return if LogLine type is nil
+	if l == nil {
+		return
+	}
+
+	l.BuildID = &v
+}
+
+// SetLogID sets the LogID field.
+//
+// When the provided LogLine type is nil, it
+// will set nothing and immediately return.
+func (l *LogLine) SetLogID(v int64) {
+	// return if LogLine type is nil
+	if l == nil {
+		return
+	}
+
+	l.LogID = &v
+}
+
+// SetNumber sets the Number field.
+//
+// When the provided LogLine type is nil, it
+// will set nothing and immediately return.
+func (l *LogLine) SetNumber(v int) {
+	// return if LogLine type is nil
+	if l == nil {
+		return
+	}
+
+	l.Number = &v
+}
+
+// SetStream sets the Stream field.
+//
+// When the provided LogLine type is nil, it
+// will set nothing and immediately return.
+func (l *LogLine) SetStream(v string) {
+	// return if LogLine type is nil
+	if l == nil {
+		return
+	}
+
+	l.Stream = &v
+}
+
+// SetTimestamp sets the Timestamp field.
+//
+// When the provided LogLine type is nil, it
+// will set nothing and immediately return.
+func (l *LogLine) SetTimestamp(v int64) {
+	// return if LogLine type is nil
+	if l == nil {
+		return
+	}
+
+	l.Timestamp = &v
+}
+
+// SetData sets the Data field.
+//
+// When the provided LogLine type is nil, it
+// will set nothing and immediately return.
+func (l *LogLine) SetData(v string) {
+	// return if LogLine type is nil
+	if l == nil {
+		return
+	}
+
+	l.Data = &v
+}
+
+// String implements the Stringer interface for the LogLine type.
+func (l *LogLine) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  LogID: %d,
+  Number: %d,
+  Stream: %s,
+  Timestamp: %d,
+  Data: %s,
+}`,
+		l.GetID(),
+		l.GetBuildID(),
+		l.GetLogID(),
+		l.GetNumber(),
+		l.GetStream(),
+		l.GetTimestamp(),
+		l.GetData(),
+	)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestLogLine_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		l    *LogLine
+		want *LogLine
+	}{
+		{
+			l:    testLogLine(),
+			want: testLogLine(),
+		},
+		{
+			l:    new(LogLine),
+			want: new(LogLine),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.l.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.l.GetID(), test.want.GetID())
+		}
+
+		if test.l.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.l.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.l.GetLogID() != test.want.GetLogID() {
+			t.Errorf("GetLogID is %v, want %v", test.l.GetLogID(), test.want.GetLogID())
+		}
+
+		if test.l.GetNumber() != test.want.GetNumber() {
+			t.Errorf("GetNumber is %v, want %v", test.l.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.l.GetStream() != test.want.GetStream() {
+			t.Errorf("GetStream is %v, want %v", test.l.GetStream(), test.want.GetStream())
+		}
+
+		if test.l.GetTimestamp() != test.want.GetTimestamp() {
+			t.Errorf("GetTimestamp is %v, want %v", test.l.GetTimestamp(), test.want.GetTimestamp())
+		}
+
+		if test.l.GetData() != test.want.GetData() {
+			t.Errorf("GetData is %v, want %v", test.l.GetData(), test.want.GetData())
+		}
+	}
+}
+
+func TestLogLine_Setters(t *testing.T) {
+	// setup types
+	var l *LogLine
+
+	// setup tests
+	tests := []struct {
+		l    *LogLine
+		want *LogLine
+	}{
+		{
+			l:    testLogLine(),
+			want: testLogLine(),
+		},
+		{
+			l:    l,
+			want: new(LogLine),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.l.SetID(test.want.GetID())
+		test.l.SetBuildID(test.want.GetBuildID())
+		test.l.SetLogID(test.want.GetLogID())
+		test.l.SetNumber(test.want.GetNumber())
+		test.l.SetStream(test.want.GetStream())
+		test.l.SetTimestamp(test.want.GetTimestamp())
+		test.l.SetData(test.want.GetData())
+
+		if test.l.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.l.GetID(), test.want.GetID())
+		}
+
+		if test.l.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.l.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.l.GetLogID() != test.want.GetLogID() {
+			t.Errorf("SetLogID is %v, want %v", test.l.GetLogID(), test.want.GetLogID())
+		}
+
+		if test.l.GetNumber() != test.want.GetNumber() {
+			t.Errorf("SetNumber is %v, want %v", test.l.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.l.GetStream() != test.want.GetStream() {
+			t.Errorf("SetStream is %v, want %v", test.l.GetStream(), test.want.GetStream())
+		}
+
+		if test.l.GetTimestamp() != test.want.GetTimestamp() {
+			t.Errorf("SetTimestamp is %v, want %v", test.l.GetTimestamp(), test.want.GetTimestamp())
+		}
+
+		if test.l.GetData() != test.want.GetData() {
+			t.Errorf("SetData is %v, want %v", test.l.GetData(), test.want.GetData())
+		}
+	}
+}
+
+func TestLogLine_String(t *testing.T) {
+	// setup types
+	l := testLogLine()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  LogID: %d,
+  Number: %d,
+  Stream: %s,
+  Timestamp: %d,
+  Data: %s,
+}`,
+		l.GetID(),
+		l.GetBuildID(),
+		l.GetLogID(),
+		l.GetNumber(),
+		l.GetStream(),
+		l.GetTimestamp(),
+		l.GetData(),
+	)
+
+	// run test
+	got := l.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testLogLine is a test helper function to create a LogLine
+// type with all fields set to a fake value.
+func testLogLine() *LogLine {
+	l := new(LogLine)
+
+	l.SetID(1)
+	l.SetBuildID(1)
+	l.SetLogID(1)
+	l.SetNumber(1)
+	l.SetStream("stdout")
+	l.SetTimestamp(1563474077000)
+	l.SetData("hello world")
+
+	return l
+}
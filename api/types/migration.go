@@ -0,0 +1,227 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// Migration is the API representation of the Vela repo settings,
+// secrets and pipeline generated from the configuration of a job
+// in another CI system.
+//
+// swagger:model Migration
+type Migration struct {
+	Source   *string   `json:"source,omitempty"`
+	Branch   *string   `json:"branch,omitempty"`
+	Timeout  *int64    `json:"timeout,omitempty"`
+	Events   *[]string `json:"events,omitempty"`
+	Secrets  *[]string `json:"secrets,omitempty"`
+	Pipeline *string   `json:"pipeline,omitempty"`
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// GetSource returns the Source field.
+//
+// When the provided Migration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *Migration) GetSource() string {
+	// return zero value if Migration type or Source field is nil
+	if m == nil || m.Source == nil {
+		return ""
+	}
+
+	return *m.Source
+}
+
+// GetBranch returns the Branch field.
+//
+// When the provided Migration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *Migration) GetBranch() string {
+	// return zero value if Migration type or Branch field is nil
+	if m == nil || m.Branch == nil {
+		return ""
+	}
+
+	return *m.Branch
+}
+
+// GetTimeout returns the Timeout field.
+//
+// When the provided Migration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *Migration) GetTimeout() int64 {
+	// return zero value if Migration type or Timeout field is nil
+	if m == nil || m.Timeout == nil {
+		return 0
+	}
+
+	return *m.Timeout
+}
+
+// GetEvents returns the Events field.
+//
+// When the provided Migration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *Migration) GetEvents() []string {
+	// return zero value if Migration type or Events field is nil
+	if m == nil || m.Events == nil {
+		return []string{}
+	}
+
+	return *m.Events
+}
+
+// GetSecrets returns the Secrets field.
+//
+// When the provided Migration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *Migration) GetSecrets() []string {
+	// return zero value if Migration type or Secrets field is nil
+	if m == nil || m.Secrets == nil {
+		return []string{}
+	}
+
+	return *m.Secrets
+}
+
+// GetPipeline returns the Pipeline field.
+//
+// When the provided Migration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *Migration) GetPipeline() string {
+	// return zero value if Migration type or Pipeline field is nil
+	if m == nil || m.Pipeline == nil {
+		return ""
+	}
+
+	return *m.Pipeline
+}
+
+// GetWarnings returns the Warnings field.
+//
+// When the provided Migration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *Migration) GetWarnings() []string {
+	// return zero value if Migration type or Warnings field is nil
+	if m == nil || m.Warnings == nil {
+		return []string{}
+	}
+
+	return *m.Warnings
+}
+
+// SetSource sets the Source field.
+//
+// When the provided Migration type is nil, it
+// will set nothing and immediately return.
+func (m *Migration) SetSource(v string) {
+	// return if Migration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Source = &v
+}
+
+// SetBranch sets the Branch field.
+//
+// When the provided Migration type is nil, it
+// will set nothing and immediately return.
+func (m *Migration) SetBranch(v string) {
+	// return if Migration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Branch = &v
+}
+
+// SetTimeout sets the Timeout field.
+//
+// When the provided Migration type is nil, it
+// will set nothing and immediately return.
+func (m *Migration) SetTimeout(v int64) {
+	// return if Migration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Timeout = &v
+}
+
+// SetEvents sets the Events field.
+//
+// When the provided Migration type is nil, it
+// will set nothing and immediately return.
+func (m *Migration) SetEvents(v []string) {
+	// return if Migration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Events = &v
+}
+
+// SetSecrets sets the Secrets field.
+//
+// When the provided Migration type is nil, it
+// will set nothing and immediately return.
+func (m *Migration) SetSecrets(v []string) {
+	// return if Migration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Secrets = &v
+}
+
+// SetPipeline sets the Pipeline field.
+//
+// When the provided Migration type is nil, it
+// will set nothing and immediately return.
+func (m *Migration) SetPipeline(v string) {
+	// return if Migration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Pipeline = &v
+}
+
+// SetWarnings sets the Warnings field.
+//
+// When the provided Migration type is nil, it
+// will set nothing and immediately return.
+func (m *Migration) SetWarnings(v []string) {
+	// return if Migration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Warnings = &v
+}
+
+// String implements the Stringer interface for the Migration type.
+func (m *Migration) String() string {
+	return fmt.Sprintf(`{
+  Source: %s,
+  Branch: %s,
+  Timeout: %d,
+  Events: %s,
+  Secrets: %s,
+  Pipeline: %s,
+  Warnings: %s,
+}`,
+		m.GetSource(),
+		m.GetBranch(),
+		m.GetTimeout(),
+		m.GetEvents(),
+		m.GetSecrets(),
+		m.GetPipeline(),
+		m.GetWarnings(),
+	)
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMigration_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		m    *Migration
+		want *Migration
+	}{
+		{
+			m:    testMigration(),
+			want: testMigration(),
+		},
+		{
+			m:    new(Migration),
+			want: new(Migration),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.m.GetSource() != test.want.GetSource() {
+			t.Errorf("GetSource is %v, want %v", test.m.GetSource(), test.want.GetSource())
+		}
+
+		if test.m.GetBranch() != test.want.GetBranch() {
+			t.Errorf("GetBranch is %v, want %v", test.m.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.m.GetTimeout() != test.want.GetTimeout() {
+			t.Errorf("GetTimeout is %v, want %v", test.m.GetTimeout(), test.want.GetTimeout())
+		}
+
+		if !reflect.DeepEqual(test.m.GetEvents(), test.want.GetEvents()) {
+			t.Errorf("GetEvents is %v, want %v", test.m.GetEvents(), test.want.GetEvents())
+		}
+
+		if !reflect.DeepEqual(test.m.GetSecrets(), test.want.GetSecrets()) {
+			t.Errorf("GetSecrets is %v, want %v", test.m.GetSecrets(), test.want.GetSecrets())
+		}
+
+		if test.m.GetPipeline() != test.want.GetPipeline() {
+			t.Errorf("GetPipeline is %v, want %v", test.m.GetPipeline(), test.want.GetPipeline())
+		}
+
+		if !reflect.DeepEqual(test.m.GetWarnings(), test.want.GetWarnings()) {
+			t.Errorf("GetWarnings is %v, want %v", test.m.GetWarnings(), test.want.GetWarnings())
+		}
+	}
+}
+
+func TestMigration_Setters(t *testing.T) {
+	// setup types
+	var m *Migration
+
+	// setup tests
+	tests := []struct {
+		m    *Migration
+		want *Migration
+	}{
+		{
+			m:    testMigration(),
+			want: testMigration(),
+		},
+		{
+			m:    m,
+			want: new(Migration),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.m.SetSource(test.want.GetSource())
+		test.m.SetBranch(test.want.GetBranch())
+		test.m.SetTimeout(test.want.GetTimeout())
+		test.m.SetEvents(test.want.GetEvents())
+		test.m.SetSecrets(test.want.GetSecrets())
+		test.m.SetPipeline(test.want.GetPipeline())
+		test.m.SetWarnings(test.want.GetWarnings())
+
+		if test.m.GetSource() != test.want.GetSource() {
+			t.Errorf("SetSource is %v, want %v", test.m.GetSource(), test.want.GetSource())
+		}
+
+		if test.m.GetBranch() != test.want.GetBranch() {
+			t.Errorf("SetBranch is %v, want %v", test.m.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.m.GetTimeout() != test.want.GetTimeout() {
+			t.Errorf("SetTimeout is %v, want %v", test.m.GetTimeout(), test.want.GetTimeout())
+		}
+
+		if !reflect.DeepEqual(test.m.GetEvents(), test.want.GetEvents()) {
+			t.Errorf("SetEvents is %v, want %v", test.m.GetEvents(), test.want.GetEvents())
+		}
+
+		if !reflect.DeepEqual(test.m.GetSecrets(), test.want.GetSecrets()) {
+			t.Errorf("SetSecrets is %v, want %v", test.m.GetSecrets(), test.want.GetSecrets())
+		}
+
+		if test.m.GetPipeline() != test.want.GetPipeline() {
+			t.Errorf("SetPipeline is %v, want %v", test.m.GetPipeline(), test.want.GetPipeline())
+		}
+
+		if !reflect.DeepEqual(test.m.GetWarnings(), test.want.GetWarnings()) {
+			t.Errorf("SetWarnings is %v, want %v", test.m.GetWarnings(), test.want.GetWarnings())
+		}
+	}
+}
+
+func TestMigration_String(t *testing.T) {
+	// setup types
+	m := testMigration()
+
+	want := fmt.Sprintf(`{
+  Source: %s,
+  Branch: %s,
+  Timeout: %d,
+  Events: %s,
+  Secrets: %s,
+  Pipeline: %s,
+  Warnings: %s,
+}`,
+		m.GetSource(),
+		m.GetBranch(),
+		m.GetTimeout(),
+		m.GetEvents(),
+		m.GetSecrets(),
+		m.GetPipeline(),
+		m.GetWarnings(),
+	)
+
+	// run test
+	got := m.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testMigration is a test helper function to create a Migration
+// type with all fields set to a fake value.
+func testMigration() *Migration {
+	m := new(Migration)
+
+	m.SetSource("drone")
+	m.SetBranch("main")
+	m.SetTimeout(30)
+	m.SetEvents([]string{"push", "pull_request"})
+	m.SetSecrets([]string{"docker_password"})
+	m.SetPipeline("version: '1'")
+	m.SetWarnings([]string{"step publish uses the Drone plugin plugins/docker"})
+
+	return m
+}
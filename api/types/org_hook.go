@@ -0,0 +1,368 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+)
+
+// OrgHook is the API representation of a webhook registered
+// for an org in the scm that delivers the events for every
+// repo in the org, replacing the webhooks for each repo.
+//
+// swagger:model OrgHook
+type OrgHook struct {
+	ID         *int64  `json:"id,omitempty"`
+	Org        *string `json:"org,omitempty"`
+	WebhookID  *int64  `json:"webhook_id,omitempty"`
+	Secret     *string `json:"secret,omitempty"`
+	AutoEnable *bool   `json:"auto_enable,omitempty"`
+	UserID     *int64  `json:"user_id,omitempty"`
+	Active     *bool   `json:"active,omitempty"`
+	CreatedAt  *int64  `json:"created_at,omitempty"`
+	CreatedBy  *string `json:"created_by,omitempty"`
+	UpdatedAt  *int64  `json:"updated_at,omitempty"`
+	UpdatedBy  *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetID() int64 {
+	// return zero value if OrgHook type or ID field is nil
+	if o == nil || o.ID == nil {
+		return 0
+	}
+
+	return *o.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetOrg() string {
+	// return zero value if OrgHook type or Org field is nil
+	if o == nil || o.Org == nil {
+		return ""
+	}
+
+	return *o.Org
+}
+
+// GetWebhookID returns the WebhookID field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetWebhookID() int64 {
+	// return zero value if OrgHook type or WebhookID field is nil
+	if o == nil || o.WebhookID == nil {
+		return 0
+	}
+
+	return *o.WebhookID
+}
+
+// GetSecret returns the Secret field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetSecret() string {
+	// return zero value if OrgHook type or Secret field is nil
+	if o == nil || o.Secret == nil {
+		return ""
+	}
+
+	return *o.Secret
+}
+
+// GetAutoEnable returns the AutoEnable field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetAutoEnable() bool {
+	// return zero value if OrgHook type or AutoEnable field is nil
+	if o == nil || o.AutoEnable == nil {
+		return false
+	}
+
+	return *o.AutoEnable
+}
+
+// GetUserID returns the UserID field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetUserID() int64 {
+	// return zero value if OrgHook type or UserID field is nil
+	if o == nil || o.UserID == nil {
+		return 0
+	}
+
+	return *o.UserID
+}
+
+// GetActive returns the Active field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetActive() bool {
+	// return zero value if OrgHook type or Active field is nil
+	if o == nil || o.Active == nil {
+		return false
+	}
+
+	return *o.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetCreatedAt() int64 {
+	// return zero value if OrgHook type or CreatedAt field is nil
+	if o == nil || o.CreatedAt == nil {
+		return 0
+	}
+
+	return *o.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetCreatedBy() string {
+	// return zero value if OrgHook type or CreatedBy field is nil
+	if o == nil || o.CreatedBy == nil {
+		return ""
+	}
+
+	return *o.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetUpdatedAt() int64 {
+	// return zero value if OrgHook type or UpdatedAt field is nil
+	if o == nil || o.UpdatedAt == nil {
+		return 0
+	}
+
+	return *o.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided OrgHook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (o *OrgHook) GetUpdatedBy() string {
+	// return zero value if OrgHook type or UpdatedBy field is nil
+	if o == nil || o.UpdatedBy == nil {
+		return ""
+	}
+
+	return *o.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetID(v int64) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetOrg(v string) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.Org = &v
+}
+
+// SetWebhookID sets the WebhookID field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetWebhookID(v int64) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.WebhookID = &v
+}
+
+// SetSecret sets the Secret field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetSecret(v string) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.Secret = &v
+}
+
+// SetAutoEnable sets the AutoEnable field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetAutoEnable(v bool) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.AutoEnable = &v
+}
+
+// SetUserID sets the UserID field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetUserID(v int64) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.UserID = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetActive(v bool) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetCreatedAt(v int64) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetCreatedBy(v string) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetUpdatedAt(v int64) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided OrgHook type is nil, it
+// will set nothing and immediately return.
+func (o *OrgHook) SetUpdatedBy(v string) {
+	// return if OrgHook type is nil
+	if o == nil {
+		return
+	}
+
+	o.UpdatedBy = &v
+}
+
+// Sanitize creates a duplicate of the OrgHook
+// without the secret for returning to users.
+func (o *OrgHook) Sanitize() *OrgHook {
+	// create a variable since constants can not be addressable
+	//
+	// https://golang.org/ref/spec#Address_operators
+	secret := constants.SecretMask
+
+	return &OrgHook{
+		ID:         o.ID,
+		Org:        o.Org,
+		WebhookID:  o.WebhookID,
+		Secret:     &secret,
+		AutoEnable: o.AutoEnable,
+		UserID:     o.UserID,
+		Active:     o.Active,
+		CreatedAt:  o.CreatedAt,
+		CreatedBy:  o.CreatedBy,
+		UpdatedAt:  o.UpdatedAt,
+		UpdatedBy:  o.UpdatedBy,
+	}
+}
+
+// String implements the Stringer interface for the OrgHook type.
+func (o *OrgHook) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  WebhookID: %d,
+  Secret: %s,
+  AutoEnable: %t,
+  UserID: %d,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		o.GetID(),
+		o.GetOrg(),
+		o.GetWebhookID(),
+		o.GetSecret(),
+		o.GetAutoEnable(),
+		o.GetUserID(),
+		o.GetActive(),
+		o.GetCreatedAt(),
+		o.GetCreatedBy(),
+		o.GetUpdatedAt(),
+		o.GetUpdatedBy(),
+	)
+}
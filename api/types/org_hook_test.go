@@ -0,0 +1,231 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestOrgHook_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		orgHook *OrgHook
+		want    *OrgHook
+	}{
+		{
+			orgHook: testOrgHook(),
+			want:    testOrgHook(),
+		},
+		{
+			orgHook: new(OrgHook),
+			want:    new(OrgHook),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.orgHook.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.orgHook.GetID(), test.want.GetID())
+		}
+
+		if test.orgHook.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.orgHook.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.orgHook.GetWebhookID() != test.want.GetWebhookID() {
+			t.Errorf("GetWebhookID is %v, want %v", test.orgHook.GetWebhookID(), test.want.GetWebhookID())
+		}
+
+		if test.orgHook.GetSecret() != test.want.GetSecret() {
+			t.Errorf("GetSecret is %v, want %v", test.orgHook.GetSecret(), test.want.GetSecret())
+		}
+
+		if test.orgHook.GetAutoEnable() != test.want.GetAutoEnable() {
+			t.Errorf("GetAutoEnable is %v, want %v", test.orgHook.GetAutoEnable(), test.want.GetAutoEnable())
+		}
+
+		if test.orgHook.GetUserID() != test.want.GetUserID() {
+			t.Errorf("GetUserID is %v, want %v", test.orgHook.GetUserID(), test.want.GetUserID())
+		}
+
+		if test.orgHook.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.orgHook.GetActive(), test.want.GetActive())
+		}
+
+		if test.orgHook.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.orgHook.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.orgHook.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.orgHook.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.orgHook.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.orgHook.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.orgHook.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.orgHook.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestOrgHook_Setters(t *testing.T) {
+	// setup types
+	var o *OrgHook
+
+	// setup tests
+	tests := []struct {
+		orgHook *OrgHook
+		want    *OrgHook
+	}{
+		{
+			orgHook: testOrgHook(),
+			want:    testOrgHook(),
+		},
+		{
+			orgHook: o,
+			want:    new(OrgHook),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.orgHook.SetID(test.want.GetID())
+		test.orgHook.SetOrg(test.want.GetOrg())
+		test.orgHook.SetWebhookID(test.want.GetWebhookID())
+		test.orgHook.SetSecret(test.want.GetSecret())
+		test.orgHook.SetAutoEnable(test.want.GetAutoEnable())
+		test.orgHook.SetUserID(test.want.GetUserID())
+		test.orgHook.SetActive(test.want.GetActive())
+		test.orgHook.SetCreatedAt(test.want.GetCreatedAt())
+		test.orgHook.SetCreatedBy(test.want.GetCreatedBy())
+		test.orgHook.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.orgHook.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.orgHook.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.orgHook.GetID(), test.want.GetID())
+		}
+
+		if test.orgHook.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.orgHook.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.orgHook.GetWebhookID() != test.want.GetWebhookID() {
+			t.Errorf("SetWebhookID is %v, want %v", test.orgHook.GetWebhookID(), test.want.GetWebhookID())
+		}
+
+		if test.orgHook.GetSecret() != test.want.GetSecret() {
+			t.Errorf("SetSecret is %v, want %v", test.orgHook.GetSecret(), test.want.GetSecret())
+		}
+
+		if test.orgHook.GetAutoEnable() != test.want.GetAutoEnable() {
+			t.Errorf("SetAutoEnable is %v, want %v", test.orgHook.GetAutoEnable(), test.want.GetAutoEnable())
+		}
+
+		if test.orgHook.GetUserID() != test.want.GetUserID() {
+			t.Errorf("SetUserID is %v, want %v", test.orgHook.GetUserID(), test.want.GetUserID())
+		}
+
+		if test.orgHook.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.orgHook.GetActive(), test.want.GetActive())
+		}
+
+		if test.orgHook.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.orgHook.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.orgHook.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.orgHook.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.orgHook.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.orgHook.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.orgHook.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.orgHook.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestOrgHook_Sanitize(t *testing.T) {
+	// setup types
+	o := testOrgHook()
+
+	want := testOrgHook()
+	want.SetSecret("[secure]")
+
+	// run test
+	got := o.Sanitize()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sanitize is %v, want %v", got, want)
+	}
+
+	if o.GetSecret() != "c8da61302e3d0a3d9c2a8b9d4f0e2f1a" {
+		t.Errorf("Sanitize modified secret to %s", o.GetSecret())
+	}
+}
+
+func TestOrgHook_String(t *testing.T) {
+	// setup types
+	o := testOrgHook()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  WebhookID: %d,
+  Secret: %s,
+  AutoEnable: %t,
+  UserID: %d,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		o.GetID(),
+		o.GetOrg(),
+		o.GetWebhookID(),
+		o.GetSecret(),
+		o.GetAutoEnable(),
+		o.GetUserID(),
+		o.GetActive(),
+		o.GetCreatedAt(),
+		o.GetCreatedBy(),
+		o.GetUpdatedAt(),
+		o.GetUpdatedBy(),
+	)
+
+	// run test
+	got := o.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testOrgHook is a test helper function to create a OrgHook
+// type with all fields set to a fake value.
+func testOrgHook() *OrgHook {
+	o := new(OrgHook)
+
+	o.SetID(1)
+	o.SetOrg("github")
+	o.SetWebhookID(123456)
+	o.SetSecret("c8da61302e3d0a3d9c2a8b9d4f0e2f1a")
+	o.SetAutoEnable(true)
+	o.SetUserID(1)
+	o.SetActive(true)
+	o.SetCreatedAt(1563474076)
+	o.SetCreatedBy("octocat")
+	o.SetUpdatedAt(1563474077)
+	o.SetUpdatedBy("octocat")
+
+	return o
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// Partition is the API representation of a partition of a table
+// in the database that holds the rows created within a range of
+// time. The start of the range is inclusive and the end of the
+// range is exclusive. The partition holding the rows outside of
+// every range has no start or end.
+//
+// swagger:model Partition
+type Partition struct {
+	Table *string `json:"table,omitempty"`
+	Name  *string `json:"name,omitempty"`
+	Start *int64  `json:"start,omitempty"`
+	End   *int64  `json:"end,omitempty"`
+}
+
+// GetTable returns the Table field.
+//
+// When the provided Partition type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Partition) GetTable() string {
+	// return zero value if Partition type or Table field is nil
+	if p == nil || p.Table == nil {
+		return ""
+	}
+
+	return *p.Table
+}
+
+// GetName returns the Name field.
+//
+// When the provided Partition type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Partition) GetName() string {
+	// return zero value if Partition type or Name field is nil
+	if p == nil || p.Name == nil {
+		return ""
+	}
+
+	return *p.Name
+}
+
+// GetStart returns the Start field.
+//
+// When the provided Partition type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Partition) GetStart() int64 {
+	// return zero value if Partition type or Start field is nil
+	if p == nil || p.Start == nil {
+		return 0
+	}
+
+	return *p.Start
+}
+
+// GetEnd returns the End field.
+//
+// When the provided Partition type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Partition) GetEnd() int64 {
+	// return zero value if Partition type or End field is nil
+	if p == nil || p.End == nil {
+		return 0
+	}
+
+	return *p.End
+}
+
+// SetTable sets the Table field.
+//
+// When the provided Partition type is nil, it
+// will set nothing and immediately return.
+func (p *Partition) SetTable(v string) {
+	// return if Partition type is nil
+	if p == nil {
+		return
+	}
+
+	p.Table = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided Partition type is nil, it
+// will set nothing and immediately return.
+func (p *Partition) SetName(v string) {
+	// return if Partition type is nil
+	if p == nil {
+		return
+	}
+
+	p.Name = &v
+}
+
+// SetStart sets the Start field.
+//
+// When the provided Partition type is nil, it
+// will set nothing and immediately return.
+func (p *Partition) SetStart(v int64) {
+	// return if Partition type is nil
+	if p == nil {
+		return
+	}
+
+	p.Start = &v
+}
+
+// SetEnd sets the End field.
+//
+// When the provided Partition type is nil, it
+// will set nothing and immediately return.
+func (p *Partition) SetEnd(v int64) {
+	// return if Partition type is nil
+	if p == nil {
+		return
+	}
+
+	p.End = &v
+}
+
+// String implements the Stringer interface for the Partition type.
+func (p *Partition) String() string {
+	return fmt.Sprintf(`{
+  Table: %s,
+  Name: %s,
+  Start: %d,
+  End: %d,
+}`,
+		p.GetTable(),
+		p.GetName(),
+		p.GetStart(),
+		p.GetEnd(),
+	)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPartition_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		partition *Partition
+		want      *Partition
+	}{
+		{
+			partition: testPartition(),
+			want:      testPartition(),
+		},
+		{
+			partition: new(Partition),
+			want:      new(Partition),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.partition.GetTable() != test.want.GetTable() {
+			t.Errorf("GetTable is %v, want %v", test.partition.GetTable(), test.want.GetTable())
+		}
+
+		if test.partition.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.partition.GetName(), test.want.GetName())
+		}
+
+		if test.partition.GetStart() != test.want.GetStart() {
+			t.Errorf("GetStart is %v, want %v", test.partition.GetStart(), test.want.GetStart())
+		}
+
+		if test.partition.GetEnd() != test.want.GetEnd() {
+			t.Errorf("GetEnd is %v, want %v", test.partition.GetEnd(), test.want.GetEnd())
+		}
+	}
+}
+
+func TestPartition_Setters(t *testing.T) {
+	// setup types
+	var p *Partition
+
+	// setup tests
+	tests := []struct {
+		partition *Partition
+		want      *Partition
+	}{
+		{
+			partition: testPartition(),
+			want:      testPartition(),
+		},
+		{
+			partition: p,
+			want:      new(Partition),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.partition.SetTable(test.want.GetTable())
+		test.partition.SetName(test.want.GetName())
+		test.partition.SetStart(test.want.GetStart())
+		test.partition.SetEnd(test.want.GetEnd())
+
+		if test.partition.GetTable() != test.want.GetTable() {
+			t.Errorf("SetTable is %v, want %v", test.partition.GetTable(), test.want.GetTable())
+		}
+
+		if test.partition.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.partition.GetName(), test.want.GetName())
+		}
+
+		if test.partition.GetStart() != test.want.GetStart() {
+			t.Errorf("SetStart is %v, want %v", test.partition.GetStart(), test.want.GetStart())
+		}
+
+		if test.partition.GetEnd() != test.want.GetEnd() {
+			t.Errorf("SetEnd is %v, want %v", test.partition.GetEnd(), test.want.GetEnd())
+		}
+	}
+}
+
+func TestPartition_String(t *testing.T) {
+	// setup types
+	p := testPartition()
+
+	want := fmt.Sprintf(`{
+  Table: %s,
+  Name: %s,
+  Start: %d,
+  End: %d,
+}`,
+		p.GetTable(),
+		p.GetName(),
+		p.GetStart(),
+		p.GetEnd(),
+	)
+
+	// run test
+	got := p.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testPartition is a test helper function to create a Partition
+// type with all fields set to a fake value.
+func testPartition() *Partition {
+	p := new(Partition)
+
+	p.SetTable("builds")
+	p.SetName("builds_y2023m10")
+	p.SetStart(1696118400)
+	p.SetEnd(1698796800)
+
+	return p
+}
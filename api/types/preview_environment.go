@@ -0,0 +1,335 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// PreviewStatusPending defines the status for a preview
+	// environment that is still being deployed.
+	PreviewStatusPending = "pending"
+
+	// PreviewStatusActive defines the status for a preview
+	// environment that is deployed and reachable.
+	PreviewStatusActive = "active"
+
+	// PreviewStatusFailed defines the status for a preview
+	// environment that failed to deploy.
+	PreviewStatusFailed = "failed"
+
+	// PreviewStatusTeardown defines the status for a preview
+	// environment that is waiting to be torn down.
+	PreviewStatusTeardown = "teardown"
+
+	// PreviewStatusDestroyed defines the status for a preview
+	// environment that has been torn down.
+	PreviewStatusDestroyed = "destroyed"
+)
+
+// PreviewEnvironment is the API representation of an ephemeral environment
+// deployed by a pull request build for previewing changes.
+//
+// swagger:model PreviewEnvironment
+type PreviewEnvironment struct {
+	ID      *int64  `json:"id,omitempty"`
+	RepoID  *int64  `json:"repo_id,omitempty"`
+	BuildID *int64  `json:"build_id,omitempty"`
+	Number  *int    `json:"number,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	Status  *string `json:"status,omitempty"`
+	Expires *int64  `json:"expires,omitempty"`
+	Created *int64  `json:"created,omitempty"`
+	Updated *int64  `json:"updated,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetID() int64 {
+	// return zero value if PreviewEnvironment type or ID field is nil
+	if p == nil || p.ID == nil {
+		return 0
+	}
+
+	return *p.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetRepoID() int64 {
+	// return zero value if PreviewEnvironment type or RepoID field is nil
+	if p == nil || p.RepoID == nil {
+		return 0
+	}
+
+	return *p.RepoID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetBuildID() int64 {
+	// return zero value if PreviewEnvironment type or BuildID field is nil
+	if p == nil || p.BuildID == nil {
+		return 0
+	}
+
+	return *p.BuildID
+}
+
+// GetNumber returns the Number field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetNumber() int {
+	// return zero value if PreviewEnvironment type or Number field is nil
+	if p == nil || p.Number == nil {
+		return 0
+	}
+
+	return *p.Number
+}
+
+// GetName returns the Name field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetName() string {
+	// return zero value if PreviewEnvironment type or Name field is nil
+	if p == nil || p.Name == nil {
+		return ""
+	}
+
+	return *p.Name
+}
+
+// GetURL returns the URL field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetURL() string {
+	// return zero value if PreviewEnvironment type or URL field is nil
+	if p == nil || p.URL == nil {
+		return ""
+	}
+
+	return *p.URL
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetStatus() string {
+	// return zero value if PreviewEnvironment type or Status field is nil
+	if p == nil || p.Status == nil {
+		return ""
+	}
+
+	return *p.Status
+}
+
+// GetExpires returns the Expires field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetExpires() int64 {
+	// return zero value if PreviewEnvironment type or Expires field is nil
+	if p == nil || p.Expires == nil {
+		return 0
+	}
+
+	return *p.Expires
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetCreated() int64 {
+	// return zero value if PreviewEnvironment type or Created field is nil
+	if p == nil || p.Created == nil {
+		return 0
+	}
+
+	return *p.Created
+}
+
+// GetUpdated returns the Updated field.
+//
+// When the provided PreviewEnvironment type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *PreviewEnvironment) GetUpdated() int64 {
+	// return zero value if PreviewEnvironment type or Updated field is nil
+	if p == nil || p.Updated == nil {
+		return 0
+	}
+
+	return *p.Updated
+}
+
+// SetID sets the ID field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetID(v int64) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetRepoID(v int64) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.RepoID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetBuildID(v int64) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.BuildID = &v
+}
+
+// SetNumber sets the Number field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetNumber(v int) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.Number = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetName(v string) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.Name = &v
+}
+
+// SetURL sets the URL field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetURL(v string) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.URL = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetStatus(v string) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.Status = &v
+}
+
+// SetExpires sets the Expires field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetExpires(v int64) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.Expires = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetCreated(v int64) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.Created = &v
+}
+
+// SetUpdated sets the Updated field.
+//
+// When the provided PreviewEnvironment type is nil, it
+// will set nothing and immediately return.
+func (p *PreviewEnvironment) SetUpdated(v int64) {
+	// return if PreviewEnvironment type is nil
+	if p == nil {
+		return
+	}
+
+	p.Updated = &v
+}
+
+// String implements the Stringer interface for the PreviewEnvironment type.
+func (p *PreviewEnvironment) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  Number: %d,
+  Name: %s,
+  URL: %s,
+  Status: %s,
+  Expires: %d,
+  Created: %d,
+  Updated: %d,
+}`,
+		p.GetID(),
+		p.GetRepoID(),
+		p.GetBuildID(),
+		p.GetNumber(),
+		p.GetName(),
+		p.GetURL(),
+		p.GetStatus(),
+		p.GetExpires(),
+		p.GetCreated(),
+		p.GetUpdated(),
+	)
+}
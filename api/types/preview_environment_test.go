@@ -0,0 +1,200 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPreviewEnvironment_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		previewEnvironment *PreviewEnvironment
+		want               *PreviewEnvironment
+	}{
+		{
+			previewEnvironment: testPreviewEnvironment(),
+			want:               testPreviewEnvironment(),
+		},
+		{
+			previewEnvironment: new(PreviewEnvironment),
+			want:               new(PreviewEnvironment),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.previewEnvironment.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.previewEnvironment.GetID(), test.want.GetID())
+		}
+
+		if test.previewEnvironment.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.previewEnvironment.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.previewEnvironment.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.previewEnvironment.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.previewEnvironment.GetNumber() != test.want.GetNumber() {
+			t.Errorf("GetNumber is %v, want %v", test.previewEnvironment.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.previewEnvironment.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.previewEnvironment.GetName(), test.want.GetName())
+		}
+
+		if test.previewEnvironment.GetURL() != test.want.GetURL() {
+			t.Errorf("GetURL is %v, want %v", test.previewEnvironment.GetURL(), test.want.GetURL())
+		}
+
+		if test.previewEnvironment.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.previewEnvironment.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.previewEnvironment.GetExpires() != test.want.GetExpires() {
+			t.Errorf("GetExpires is %v, want %v", test.previewEnvironment.GetExpires(), test.want.GetExpires())
+		}
+
+		if test.previewEnvironment.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.previewEnvironment.GetCreated(), test.want.GetCreated())
+		}
+
+		if test.previewEnvironment.GetUpdated() != test.want.GetUpdated() {
+			t.Errorf("GetUpdated is %v, want %v", test.previewEnvironment.GetUpdated(), test.want.GetUpdated())
+		}
+	}
+}
+
+func TestPreviewEnvironment_Setters(t *testing.T) {
+	// setup types
+	var p *PreviewEnvironment
+
+	// setup tests
+	tests := []struct {
+		previewEnvironment *PreviewEnvironment
+		want               *PreviewEnvironment
+	}{
+		{
+			previewEnvironment: testPreviewEnvironment(),
+			want:               testPreviewEnvironment(),
+		},
+		{
+			previewEnvironment: p,
+			want:               new(PreviewEnvironment),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.previewEnvironment.SetID(test.want.GetID())
+		test.previewEnvironment.SetRepoID(test.want.GetRepoID())
+		test.previewEnvironment.SetBuildID(test.want.GetBuildID())
+		test.previewEnvironment.SetNumber(test.want.GetNumber())
+		test.previewEnvironment.SetName(test.want.GetName())
+		test.previewEnvironment.SetURL(test.want.GetURL())
+		test.previewEnvironment.SetStatus(test.want.GetStatus())
+		test.previewEnvironment.SetExpires(test.want.GetExpires())
+		test.previewEnvironment.SetCreated(test.want.GetCreated())
+		test.previewEnvironment.SetUpdated(test.want.GetUpdated())
+
+		if test.previewEnvironment.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.previewEnvironment.GetID(), test.want.GetID())
+		}
+
+		if test.previewEnvironment.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.previewEnvironment.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.previewEnvironment.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.previewEnvironment.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.previewEnvironment.GetNumber() != test.want.GetNumber() {
+			t.Errorf("SetNumber is %v, want %v", test.previewEnvironment.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.previewEnvironment.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.previewEnvironment.GetName(), test.want.GetName())
+		}
+
+		if test.previewEnvironment.GetURL() != test.want.GetURL() {
+			t.Errorf("SetURL is %v, want %v", test.previewEnvironment.GetURL(), test.want.GetURL())
+		}
+
+		if test.previewEnvironment.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.previewEnvironment.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.previewEnvironment.GetExpires() != test.want.GetExpires() {
+			t.Errorf("SetExpires is %v, want %v", test.previewEnvironment.GetExpires(), test.want.GetExpires())
+		}
+
+		if test.previewEnvironment.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.previewEnvironment.GetCreated(), test.want.GetCreated())
+		}
+
+		if test.previewEnvironment.GetUpdated() != test.want.GetUpdated() {
+			t.Errorf("SetUpdated is %v, want %v", test.previewEnvironment.GetUpdated(), test.want.GetUpdated())
+		}
+	}
+}
+
+func TestPreviewEnvironment_String(t *testing.T) {
+	// setup types
+	p := testPreviewEnvironment()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  BuildID: %d,
+  Number: %d,
+  Name: %s,
+  URL: %s,
+  Status: %s,
+  Expires: %d,
+  Created: %d,
+  Updated: %d,
+}`,
+		p.GetID(),
+		p.GetRepoID(),
+		p.GetBuildID(),
+		p.GetNumber(),
+		p.GetName(),
+		p.GetURL(),
+		p.GetStatus(),
+		p.GetExpires(),
+		p.GetCreated(),
+		p.GetUpdated(),
+	)
+
+	// run test
+	got := p.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testPreviewEnvironment is a test helper function to create a PreviewEnvironment
+// type with all fields set to a fake value.
+func testPreviewEnvironment() *PreviewEnvironment {
+	p := new(PreviewEnvironment)
+
+	p.SetID(1)
+	p.SetRepoID(1)
+	p.SetBuildID(1)
+	p.SetNumber(1)
+	p.SetName("web")
+	p.SetURL("https://pr-1.preview.example.com")
+	p.SetStatus("active")
+	p.SetExpires(1563474077)
+	p.SetCreated(1563474076)
+	p.SetUpdated(1563474077)
+
+	return p
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/library"
+)
+
+// Provenance is the API representation of the chain that links a
+// build to the commit, pipeline, templates and images it was run
+// with and to the artifacts and deployments it produced.
+//
+// swagger:model Provenance
+type Provenance struct {
+	Repo        *string              `json:"repo,omitempty"`
+	Build       *library.Build       `json:"build,omitempty"`
+	Pipeline    *library.Pipeline    `json:"pipeline,omitempty"`
+	Templates   *[]*library.Template `json:"templates,omitempty"`
+	Images      *[]string            `json:"images,omitempty"`
+	Artifacts   *[]*Artifact         `json:"artifacts,omitempty"`
+	Deployments *[]*library.Build    `json:"deployments,omitempty"`
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided Provenance type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Provenance) GetRepo() string {
+	// return zero value if Provenance type or Repo field is nil
+	if p == nil || p.Repo == nil {
+		return ""
+	}
+
+	return *p.Repo
+}
+
+// GetBuild returns the Build field.
+//
+// When the provided Provenance type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Provenance) GetBuild() *library.Build {
+	// return zero value if Provenance type or Build field is nil
+	if p == nil || p.Build == nil {
+		return new(library.Build)
+	}
+
+	return p.Build
+}
+
+// GetPipeline returns the Pipeline field.
+//
+// When the provided Provenance type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Provenance) GetPipeline() *library.Pipeline {
+	// return zero value if Provenance type or Pipeline field is nil
+	if p == nil || p.Pipeline == nil {
+		return new(library.Pipeline)
+	}
+
+	return p.Pipeline
+}
+
+// GetTemplates returns the Templates field.
+//
+// When the provided Provenance type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Provenance) GetTemplates() []*library.Template {
+	// return zero value if Provenance type or Templates field is nil
+	if p == nil || p.Templates == nil {
+		return []*library.Template{}
+	}
+
+	return *p.Templates
+}
+
+// GetImages returns the Images field.
+//
+// When the provided Provenance type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Provenance) GetImages() []string {
+	// return zero value if Provenance type or Images field is nil
+	if p == nil || p.Images == nil {
+		return []string{}
+	}
+
+	return *p.Images
+}
+
+// GetArtifacts returns the Artifacts field.
+//
+// When the provided Provenance type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Provenance) GetArtifacts() []*Artifact {
+	// return zero value if Provenance type or Artifacts field is nil
+	if p == nil || p.Artifacts == nil {
+		return []*Artifact{}
+	}
+
+	return *p.Artifacts
+}
+
+// GetDeployments returns the Deployments field.
+//
+// When the provided Provenance type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *Provenance) GetDeployments() []*library.Build {
+	// return zero value if Provenance type or Deployments field is nil
+	if p == nil || p.Deployments == nil {
+		return []*library.Build{}
+	}
+
+	return *p.Deployments
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided Provenance type is nil, it
+// will set nothing and immediately return.
+func (p *Provenance) SetRepo(v string) {
+	// return if Provenance type is nil
+	if p == nil {
+		return
+	}
+
+	p.Repo = &v
+}
+
+// SetBuild sets the Build field.
+//
+// When the provided Provenance type is nil, it
+// will set nothing and immediately return.
+func (p *Provenance) SetBuild(v *library.Build) {
+	// return if Provenance type is nil
+	if p == nil {
+		return
+	}
+
+	p.Build = v
+}
+
+// SetPipeline sets the Pipeline field.
+//
+// When the provided Provenance type is nil, it
+// will set nothing and immediately return.
+func (p *Provenance) SetPipeline(v *library.Pipeline) {
+	// return if Provenance type is nil
+	if p == nil {
+		return
+	}
+
+	p.Pipeline = v
+}
+
+// SetTemplates sets the Templates field.
+//
+// When the provided Provenance type is nil, it
+// will set nothing and immediately return.
+func (p *Provenance) SetTemplates(v []*library.Template) {
+	// return if Provenance type is nil
+	if p == nil {
+		return
+	}
+
+	p.Templates = &v
+}
+
+// SetImages sets the Images field.
+//
+// When the provided Provenance type is nil, it
+// will set nothing and immediately return.
+func (p *Provenance) SetImages(v []string) {
+	// return if Provenance type is nil
+	if p == nil {
+		return
+	}
+
+	p.Images = &v
+}
+
+// SetArtifacts sets the Artifacts field.
+//
+// When the provided Provenance type is nil, it
+// will set nothing and immediately return.
+func (p *Provenance) SetArtifacts(v []*Artifact) {
+	// return if Provenance type is nil
+	if p == nil {
+		return
+	}
+
+	p.Artifacts = &v
+}
+
+// SetDeployments sets the Deployments field.
+//
+// When the provided Provenance type is nil, it
+// will set nothing and immediately return.
+func (p *Provenance) SetDeployments(v []*library.Build) {
+	// return if Provenance type is nil
+	if p == nil {
+		return
+	}
+
+	p.Deployments = &v
+}
+
+// String implements the Stringer interface for the Provenance type.
+func (p *Provenance) String() string {
+	return fmt.Sprintf(`{
+  Repo: %s,
+  Build: %d,
+  Commit: %s,
+  Pipeline: %d,
+  Templates: %d,
+  Images: %s,
+  Artifacts: %d,
+  Deployments: %d,
+}`,
+		p.GetRepo(),
+		p.GetBuild().GetNumber(),
+		p.GetBuild().GetCommit(),
+		p.GetPipeline().GetID(),
+		len(p.GetTemplates()),
+		p.GetImages(),
+		len(p.GetArtifacts()),
+		len(p.GetDeployments()),
+	)
+}
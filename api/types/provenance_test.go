@@ -0,0 +1,187 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestProvenance_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		provenance *Provenance
+		want       *Provenance
+	}{
+		{
+			provenance: testProvenance(),
+			want:       testProvenance(),
+		},
+		{
+			provenance: new(Provenance),
+			want:       new(Provenance),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.provenance.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.provenance.GetRepo(), test.want.GetRepo())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetBuild(), test.want.GetBuild()) {
+			t.Errorf("GetBuild is %v, want %v", test.provenance.GetBuild(), test.want.GetBuild())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetPipeline(), test.want.GetPipeline()) {
+			t.Errorf("GetPipeline is %v, want %v", test.provenance.GetPipeline(), test.want.GetPipeline())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetTemplates(), test.want.GetTemplates()) {
+			t.Errorf("GetTemplates is %v, want %v", test.provenance.GetTemplates(), test.want.GetTemplates())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetImages(), test.want.GetImages()) {
+			t.Errorf("GetImages is %v, want %v", test.provenance.GetImages(), test.want.GetImages())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetArtifacts(), test.want.GetArtifacts()) {
+			t.Errorf("GetArtifacts is %v, want %v", test.provenance.GetArtifacts(), test.want.GetArtifacts())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetDeployments(), test.want.GetDeployments()) {
+			t.Errorf("GetDeployments is %v, want %v", test.provenance.GetDeployments(), test.want.GetDeployments())
+		}
+	}
+}
+
+func TestProvenance_Setters(t *testing.T) {
+	// setup types
+	var p *Provenance
+
+	// setup tests
+	tests := []struct {
+		provenance *Provenance
+		want       *Provenance
+	}{
+		{
+			provenance: testProvenance(),
+			want:       testProvenance(),
+		},
+		{
+			provenance: p,
+			want:       new(Provenance),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.provenance.SetRepo(test.want.GetRepo())
+		test.provenance.SetBuild(test.want.GetBuild())
+		test.provenance.SetPipeline(test.want.GetPipeline())
+		test.provenance.SetTemplates(test.want.GetTemplates())
+		test.provenance.SetImages(test.want.GetImages())
+		test.provenance.SetArtifacts(test.want.GetArtifacts())
+		test.provenance.SetDeployments(test.want.GetDeployments())
+
+		if test.provenance.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.provenance.GetRepo(), test.want.GetRepo())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetBuild(), test.want.GetBuild()) {
+			t.Errorf("SetBuild is %v, want %v", test.provenance.GetBuild(), test.want.GetBuild())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetPipeline(), test.want.GetPipeline()) {
+			t.Errorf("SetPipeline is %v, want %v", test.provenance.GetPipeline(), test.want.GetPipeline())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetTemplates(), test.want.GetTemplates()) {
+			t.Errorf("SetTemplates is %v, want %v", test.provenance.GetTemplates(), test.want.GetTemplates())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetImages(), test.want.GetImages()) {
+			t.Errorf("SetImages is %v, want %v", test.provenance.GetImages(), test.want.GetImages())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetArtifacts(), test.want.GetArtifacts()) {
+			t.Errorf("SetArtifacts is %v, want %v", test.provenance.GetArtifacts(), test.want.GetArtifacts())
+		}
+
+		if !reflect.DeepEqual(test.provenance.GetDeployments(), test.want.GetDeployments()) {
+			t.Errorf("SetDeployments is %v, want %v", test.provenance.GetDeployments(), test.want.GetDeployments())
+		}
+	}
+}
+
+func TestProvenance_String(t *testing.T) {
+	// setup types
+	p := testProvenance()
+
+	want := fmt.Sprintf(`{
+  Repo: %s,
+  Build: %d,
+  Commit: %s,
+  Pipeline: %d,
+  Templates: %d,
+  Images: %s,
+  Artifacts: %d,
+  Deployments: %d,
+}`,
+		p.GetRepo(),
+		p.GetBuild().GetNumber(),
+		p.GetBuild().GetCommit(),
+		p.GetPipeline().GetID(),
+		len(p.GetTemplates()),
+		p.GetImages(),
+		len(p.GetArtifacts()),
+		len(p.GetDeployments()),
+	)
+
+	// run test
+	got := p.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testProvenance is a test helper function to create a Provenance
+// type with all fields set to a fake value.
+func testProvenance() *Provenance {
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetNumber(1)
+	b.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+	pipeline := new(library.Pipeline)
+	pipeline.SetID(1)
+	pipeline.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+
+	template := new(library.Template)
+	template.SetName("go")
+	template.SetSource("github.com/go-vela/templates/go.yml@v1.0.0")
+	template.SetType("github")
+
+	deploy := new(library.Build)
+	deploy.SetID(2)
+	deploy.SetNumber(2)
+	deploy.SetEvent("deployment")
+
+	p := new(Provenance)
+
+	p.SetRepo("github/octocat")
+	p.SetBuild(b)
+	p.SetPipeline(pipeline)
+	p.SetTemplates([]*library.Template{template})
+	p.SetImages([]string{"golang@sha256:8b1bd8c3c42d2a9ba0dd5ce8fcb77a1b7a6cf8a4d6e6e9d7c6e1d11b1d0e0a9f"})
+	p.SetArtifacts([]*Artifact{testArtifact()})
+	p.SetDeployments([]*library.Build{deploy})
+
+	return p
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// QueueItem is the API representation of the metadata for
+// an item waiting in the queue, without the pipeline or
+// credentials stored within the item.
+//
+// swagger:model QueueItem
+type QueueItem struct {
+	BuildID    *int64  `json:"build_id,omitempty"`
+	Repo       *string `json:"repo,omitempty"`
+	Number     *int64  `json:"number,omitempty"`
+	Enqueued   *int64  `json:"enqueued,omitempty"`
+	Size       *int64  `json:"size,omitempty"`
+	Compressed *bool   `json:"compressed,omitempty"`
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided QueueItem type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (i *QueueItem) GetBuildID() int64 {
+	// return zero value if QueueItem type or BuildID field is nil
+	if i == nil || i.BuildID == nil {
+		return 0
+	}
+
+	return *i.BuildID
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided QueueItem type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (i *QueueItem) GetRepo() string {
+	// return zero value if QueueItem type or Repo field is nil
+	if i == nil || i.Repo == nil {
+		return ""
+	}
+
+	return *i.Repo
+}
+
+// GetNumber returns the Number field.
+//
+// When the provided QueueItem type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (i *QueueItem) GetNumber() int64 {
+	// return zero value if QueueItem type or Number field is nil
+	if i == nil || i.Number == nil {
+		return 0
+	}
+
+	return *i.Number
+}
+
+// GetEnqueued returns the Enqueued field.
+//
+// When the provided QueueItem type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (i *QueueItem) GetEnqueued() int64 {
+	// return zero value if QueueItem type or Enqueued field is nil
+	if i == nil || i.Enqueued == nil {
+		return 0
+	}
+
+	return *i.Enqueued
+}
+
+// GetSize returns the Size field.
+//
+// When the provided QueueItem type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (i *QueueItem) GetSize() int64 {
+	// return zero value if QueueItem type or Size field is nil
+	if i == nil || i.Size == nil {
+		return 0
+	}
+
+	return *i.Size
+}
+
+// GetCompressed returns the Compressed field.
+//
+// When the provided QueueItem type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (i *QueueItem) GetCompressed() bool {
+	// return zero value if QueueItem type or Compressed field is nil
+	if i == nil || i.Compressed == nil {
+		return false
+	}
+
+	return *i.Compressed
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided QueueItem type is nil, it
+// will set nothing and immediately return.
+func (i *QueueItem) SetBuildID(v int64) {
+	// return if QueueItem type is nil
+	if i == nil {
+		return
+	}
+
+	i.BuildID = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided QueueItem type is nil, it
+// will set nothing and immediately return.
+func (i *QueueItem) SetRepo(v string) {
+	// return if QueueItem type is nil
+	if i == nil {
+		return
+	}
+
+	i.Repo = &v
+}
+
+// SetNumber sets the Number field.
+//
+// When the provided QueueItem type is nil, it
+// will set nothing and immediately return.
+func (i *QueueItem) SetNumber(v int64) {
+	// return if QueueItem type is nil
+	if i == nil {
+		return
+	}
+
+	i.Number = &v
+}
+
+// SetEnqueued sets the Enqueued field.
+//
+// When the provided QueueItem type is nil, it
+// will set nothing and immediately return.
+func (i *QueueItem) SetEnqueued(v int64) {
+	// return if QueueItem type is nil
+	if i == nil {
+		return
+	}
+
+	i.Enqueued = &v
+}
+
+// SetSize sets the Size field.
+//
+// When the provided QueueItem type is nil, it
+// will set nothing and immediately return.
+func (i *QueueItem) SetSize(v int64) {
+	// return if QueueItem type is nil
+	if i == nil {
+		return
+	}
+
+	i.Size = &v
+}
+
+// SetCompressed sets the Compressed field.
+//
+// When the provided QueueItem type is nil, it
+// will set nothing and immediately return.
+func (i *QueueItem) SetCompressed(v bool) {
+	// return if QueueItem type is nil
+	if i == nil {
+		return
+	}
+
+	i.Compressed = &v
+}
+
+// String implements the Stringer interface for the QueueItem type.
+func (i *QueueItem) String() string {
+	return fmt.Sprintf(`{
+  BuildID: %d,
+  Repo: %s,
+  Number: %d,
+  Enqueued: %d,
+  Size: %d,
+  Compressed: %t,
+}`,
+		i.GetBuildID(),
+		i.GetRepo(),
+		i.GetNumber(),
+		i.GetEnqueued(),
+		i.GetSize(),
+		i.GetCompressed(),
+	)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestQueueItem_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		item *QueueItem
+		want *QueueItem
+	}{
+		{
+			item: testQueueItem(),
+			want: testQueueItem(),
+		},
+		{
+			item: new(QueueItem),
+			want: new(QueueItem),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.item.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.item.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.item.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.item.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.item.GetNumber() != test.want.GetNumber() {
+			t.Errorf("GetNumber is %v, want %v", test.item.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.item.GetEnqueued() != test.want.GetEnqueued() {
+			t.Errorf("GetEnqueued is %v, want %v", test.item.GetEnqueued(), test.want.GetEnqueued())
+		}
+
+		if test.item.GetSize() != test.want.GetSize() {
+			t.Errorf("GetSize is %v, want %v", test.item.GetSize(), test.want.GetSize())
+		}
+
+		if test.item.GetCompressed() != test.want.GetCompressed() {
+			t.Errorf("GetCompressed is %v, want %v", test.item.GetCompressed(), test.want.GetCompressed())
+		}
+	}
+}
+
+func TestQueueItem_Setters(t *testing.T) {
+	// setup types
+	var i *QueueItem
+
+	// setup tests
+	tests := []struct {
+		item *QueueItem
+		want *QueueItem
+	}{
+		{
+			item: testQueueItem(),
+			want: testQueueItem(),
+		},
+		{
+			item: i,
+			want: new(QueueItem),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.item.SetBuildID(test.want.GetBuildID())
+		test.item.SetRepo(test.want.GetRepo())
+		test.item.SetNumber(test.want.GetNumber())
+		test.item.SetEnqueued(test.want.GetEnqueued())
+		test.item.SetSize(test.want.GetSize())
+		test.item.SetCompressed(test.want.GetCompressed())
+
+		if test.item.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.item.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.item.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.item.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.item.GetNumber() != test.want.GetNumber() {
+			t.Errorf("SetNumber is %v, want %v", test.item.GetNumber(), test.want.GetNumber())
+		}
+
+		if test.item.GetEnqueued() != test.want.GetEnqueued() {
+			t.Errorf("SetEnqueued is %v, want %v", test.item.GetEnqueued(), test.want.GetEnqueued())
+		}
+
+		if test.item.GetSize() != test.want.GetSize() {
+			t.Errorf("SetSize is %v, want %v", test.item.GetSize(), test.want.GetSize())
+		}
+
+		if test.item.GetCompressed() != test.want.GetCompressed() {
+			t.Errorf("SetCompressed is %v, want %v", test.item.GetCompressed(), test.want.GetCompressed())
+		}
+	}
+}
+
+func TestQueueItem_String(t *testing.T) {
+	// setup types
+	i := testQueueItem()
+
+	want := fmt.Sprintf(`{
+  BuildID: %d,
+  Repo: %s,
+  Number: %d,
+  Enqueued: %d,
+  Size: %d,
+  Compressed: %t,
+}`,
+		i.GetBuildID(),
+		i.GetRepo(),
+		i.GetNumber(),
+		i.GetEnqueued(),
+		i.GetSize(),
+		i.GetCompressed(),
+	)
+
+	// run test
+	got := i.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testQueueItem is a test helper function to create a QueueItem
+// type with all fields set to a fake value.
+func testQueueItem() *QueueItem {
+	i := new(QueueItem)
+
+	i.SetBuildID(1)
+	i.SetRepo("github/octocat")
+	i.SetNumber(1)
+	i.SetEnqueued(1563474076)
+	i.SetSize(2048)
+	i.SetCompressed(true)
+
+	return i
+}
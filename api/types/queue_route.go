@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// QueueRoute is the API representation of the items waiting
+// on a route in the queue with the age of the oldest item
+// and a sample of the items at the front of the route.
+//
+// swagger:model QueueRoute
+type QueueRoute struct {
+	Route     *string       `json:"route,omitempty"`
+	Length    *int64        `json:"length,omitempty"`
+	OldestAge *int64        `json:"oldest_age,omitempty"`
+	Items     *[]*QueueItem `json:"items,omitempty"`
+}
+
+// GetRoute returns the Route field.
+//
+// When the provided QueueRoute type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *QueueRoute) GetRoute() string {
+	// return zero value if QueueRoute type or Route field is nil
+	if r == nil || r.Route == nil {
+		return ""
+	}
+
+	return *r.Route
+}
+
+// GetLength returns the Length field.
+//
+// When the provided QueueRoute type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *QueueRoute) GetLength() int64 {
+	// return zero value if QueueRoute type or Length field is nil
+	if r == nil || r.Length == nil {
+		return 0
+	}
+
+	return *r.Length
+}
+
+// GetOldestAge returns the OldestAge field.
+//
+// When the provided QueueRoute type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *QueueRoute) GetOldestAge() int64 {
+	// return zero value if QueueRoute type or OldestAge field is nil
+	if r == nil || r.OldestAge == nil {
+		return 0
+	}
+
+	return *r.OldestAge
+}
+
+// GetItems returns the Items field.
+//
+// When the provided QueueRoute type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *QueueRoute) GetItems() []*QueueItem {
+	// return zero value if QueueRoute type or Items field is nil
+	if r == nil || r.Items == nil {
+		return []*QueueItem{}
+	}
+
+	return *r.Items
+}
+
+// SetRoute sets the Route field.
+//
+// When the provided QueueRoute type is nil, it
+// will set nothing and immediately return.
+func (r *QueueRoute) SetRoute(v string) {
+	// return if QueueRoute type is nil
+	if r == nil {
+		return
+	}
+
+	r.Route = &v
+}
+
+// SetLength sets the Length field.
+//
+// When the provided QueueRoute type is nil, it
+// will set nothing and immediately return.
+func (r *QueueRoute) SetLength(v int64) {
+	// return if QueueRoute type is nil
+	if r == nil {
+		return
+	}
+
+	r.Length = &v
+}
+
+// SetOldestAge sets the OldestAge field.
+//
+// When the provided QueueRoute type is nil, it
+// will set nothing and immediately return.
+func (r *QueueRoute) SetOldestAge(v int64) {
+	// return if QueueRoute type is nil
+	if r == nil {
+		return
+	}
+
+	r.OldestAge = &v
+}
+
+// SetItems sets the Items field.
+//
+// When the provided QueueRoute type is nil, it
+// will set nothing and immediately return.
+func (r *QueueRoute) SetItems(v []*QueueItem) {
+	// return if QueueRoute type is nil
+	if r == nil {
+		return
+	}
+
+	r.Items = &v
+}
+
+// String implements the Stringer interface for the QueueRoute type.
+func (r *QueueRoute) String() string {
+	return fmt.Sprintf(`{
+  Route: %s,
+  Length: %d,
+  OldestAge: %d,
+  Items: %d,
+}`,
+		r.GetRoute(),
+		r.GetLength(),
+		r.GetOldestAge(),
+		len(r.GetItems()),
+	)
+}
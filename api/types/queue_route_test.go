@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestQueueRoute_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		route *QueueRoute
+		want  *QueueRoute
+	}{
+		{
+			route: testQueueRoute(),
+			want:  testQueueRoute(),
+		},
+		{
+			route: new(QueueRoute),
+			want:  new(QueueRoute),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.route.GetRoute() != test.want.GetRoute() {
+			t.Errorf("GetRoute is %v, want %v", test.route.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.route.GetLength() != test.want.GetLength() {
+			t.Errorf("GetLength is %v, want %v", test.route.GetLength(), test.want.GetLength())
+		}
+
+		if test.route.GetOldestAge() != test.want.GetOldestAge() {
+			t.Errorf("GetOldestAge is %v, want %v", test.route.GetOldestAge(), test.want.GetOldestAge())
+		}
+
+		if !reflect.DeepEqual(test.route.GetItems(), test.want.GetItems()) {
+			t.Errorf("GetItems is %v, want %v", test.route.GetItems(), test.want.GetItems())
+		}
+	}
+}
+
+func TestQueueRoute_Setters(t *testing.T) {
+	// setup types
+	var r *QueueRoute
+
+	// setup tests
+	tests := []struct {
+		route *QueueRoute
+		want  *QueueRoute
+	}{
+		{
+			route: testQueueRoute(),
+			want:  testQueueRoute(),
+		},
+		{
+			route: r,
+			want:  new(QueueRoute),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.route.SetRoute(test.want.GetRoute())
+		test.route.SetLength(test.want.GetLength())
+		test.route.SetOldestAge(test.want.GetOldestAge())
+		test.route.SetItems(test.want.GetItems())
+
+		if test.route.GetRoute() != test.want.GetRoute() {
+			t.Errorf("SetRoute is %v, want %v", test.route.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.route.GetLength() != test.want.GetLength() {
+			t.Errorf("SetLength is %v, want %v", test.route.GetLength(), test.want.GetLength())
+		}
+
+		if test.route.GetOldestAge() != test.want.GetOldestAge() {
+			t.Errorf("SetOldestAge is %v, want %v", test.route.GetOldestAge(), test.want.GetOldestAge())
+		}
+
+		if !reflect.DeepEqual(test.route.GetItems(), test.want.GetItems()) {
+			t.Errorf("SetItems is %v, want %v", test.route.GetItems(), test.want.GetItems())
+		}
+	}
+}
+
+func TestQueueRoute_String(t *testing.T) {
+	// setup types
+	r := testQueueRoute()
+
+	want := fmt.Sprintf(`{
+  Route: %s,
+  Length: %d,
+  OldestAge: %d,
+  Items: %d,
+}`,
+		r.GetRoute(),
+		r.GetLength(),
+		r.GetOldestAge(),
+		len(r.GetItems()),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testQueueRoute is a test helper function to create a QueueRoute
+// type with all fields set to a fake value.
+func testQueueRoute() *QueueRoute {
+	r := new(QueueRoute)
+
+	r.SetRoute("vela")
+	r.SetLength(3)
+	r.SetOldestAge(60)
+	r.SetItems([]*QueueItem{testQueueItem()})
+
+	return r
+}
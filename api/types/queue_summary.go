@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// QueueSummary is the API representation of the memory
+// used by the queue and the items waiting on its routes.
+//
+// swagger:model QueueSummary
+type QueueSummary struct {
+	UsedMemory *int64         `json:"used_memory,omitempty"`
+	Routes     *[]*QueueRoute `json:"routes,omitempty"`
+}
+
+// GetUsedMemory returns the UsedMemory field.
+//
+// When the provided QueueSummary type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *QueueSummary) GetUsedMemory() int64 {
+	// return zero value if QueueSummary type or UsedMemory field is nil
+	if s == nil || s.UsedMemory == nil {
+		return 0
+	}
+
+	return *s.UsedMemory
+}
+
+// GetRoutes returns the Routes field.
+//
+// When the provided QueueSummary type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *QueueSummary) GetRoutes() []*QueueRoute {
+	// return zero value if QueueSummary type or Routes field is nil
+	if s == nil || s.Routes == nil {
+		return []*QueueRoute{}
+	}
+
+	return *s.Routes
+}
+
+// SetUsedMemory sets the UsedMemory field.
+//
+// When the provided QueueSummary type is nil, it
+// will set nothing and immediately return.
+func (s *QueueSummary) SetUsedMemory(v int64) {
+	// return if QueueSummary type is nil
+	if s == nil {
+		return
+	}
+
+	s.UsedMemory = &v
+}
+
+// SetRoutes sets the Routes field.
+//
+// When the provided QueueSummary type is nil, it
+// will set nothing and immediately return.
+func (s *QueueSummary) SetRoutes(v []*QueueRoute) {
+	// return if QueueSummary type is nil
+	if s == nil {
+		return
+	}
+
+	s.Routes = &v
+}
+
+// String implements the Stringer interface for the QueueSummary type.
+func (s *QueueSummary) String() string {
+	return fmt.Sprintf(`{
+  UsedMemory: %d,
+  Routes: %d,
+}`,
+		s.GetUsedMemory(),
+		len(s.GetRoutes()),
+	)
+}
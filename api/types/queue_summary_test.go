@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestQueueSummary_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		summary *QueueSummary
+		want    *QueueSummary
+	}{
+		{
+			summary: testQueueSummary(),
+			want:    testQueueSummary(),
+		},
+		{
+			summary: new(QueueSummary),
+			want:    new(QueueSummary),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.summary.GetUsedMemory() != test.want.GetUsedMemory() {
+			t.Errorf("GetUsedMemory is %v, want %v", test.summary.GetUsedMemory(), test.want.GetUsedMemory())
+		}
+
+		if !reflect.DeepEqual(test.summary.GetRoutes(), test.want.GetRoutes()) {
+			t.Errorf("GetRoutes is %v, want %v", test.summary.GetRoutes(), test.want.GetRoutes())
+		}
+	}
+}
+
+func TestQueueSummary_Setters(t *testing.T) {
+	// setup types
+	var s *QueueSummary
+
+	// setup tests
+	tests := []struct {
+		summary *QueueSummary
+		want    *QueueSummary
+	}{
+		{
+			summary: testQueueSummary(),
+			want:    testQueueSummary(),
+		},
+		{
+			summary: s,
+			want:    new(QueueSummary),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.summary.SetUsedMemory(test.want.GetUsedMemory())
+		test.summary.SetRoutes(test.want.GetRoutes())
+
+		if test.summary.GetUsedMemory() != test.want.GetUsedMemory() {
+			t.Errorf("SetUsedMemory is %v, want %v", test.summary.GetUsedMemory(), test.want.GetUsedMemory())
+		}
+
+		if !reflect.DeepEqual(test.summary.GetRoutes(), test.want.GetRoutes()) {
+			t.Errorf("SetRoutes is %v, want %v", test.summary.GetRoutes(), test.want.GetRoutes())
+		}
+	}
+}
+
+func TestQueueSummary_String(t *testing.T) {
+	// setup types
+	s := testQueueSummary()
+
+	want := fmt.Sprintf(`{
+  UsedMemory: %d,
+  Routes: %d,
+}`,
+		s.GetUsedMemory(),
+		len(s.GetRoutes()),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testQueueSummary is a test helper function to create a QueueSummary
+// type with all fields set to a fake value.
+func testQueueSummary() *QueueSummary {
+	s := new(QueueSummary)
+
+	s.SetUsedMemory(1048576)
+	s.SetRoutes([]*QueueRoute{testQueueRoute()})
+
+	return s
+}
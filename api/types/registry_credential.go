@@ -0,0 +1,337 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+)
+
+// RegistryCredential is the API representation of the credentials for pulling
+// images from a container registry for the repos in an org.
+//
+// swagger:model RegistryCredential
+type RegistryCredential struct {
+	ID        *int64  `json:"id,omitempty"`
+	Org       *string `json:"org,omitempty"`
+	Registry  *string `json:"registry,omitempty"`
+	Username  *string `json:"username,omitempty"`
+	Password  *string `json:"password,omitempty"`
+	Active    *bool   `json:"active,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	UpdatedAt *int64  `json:"updated_at,omitempty"`
+	UpdatedBy *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetID() int64 {
+	// return zero value if RegistryCredential type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetOrg() string {
+	// return zero value if RegistryCredential type or Org field is nil
+	if r == nil || r.Org == nil {
+		return ""
+	}
+
+	return *r.Org
+}
+
+// GetRegistry returns the Registry field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetRegistry() string {
+	// return zero value if RegistryCredential type or Registry field is nil
+	if r == nil || r.Registry == nil {
+		return ""
+	}
+
+	return *r.Registry
+}
+
+// GetUsername returns the Username field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetUsername() string {
+	// return zero value if RegistryCredential type or Username field is nil
+	if r == nil || r.Username == nil {
+		return ""
+	}
+
+	return *r.Username
+}
+
+// GetPassword returns the Password field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetPassword() string {
+	// return zero value if RegistryCredential type or Password field is nil
+	if r == nil || r.Password == nil {
+		return ""
+	}
+
+	return *r.Password
+}
+
+// GetActive returns the Active field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetActive() bool {
+	// return zero value if RegistryCredential type or Active field is nil
+	if r == nil || r.Active == nil {
+		return false
+	}
+
+	return *r.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetCreatedAt() int64 {
+	// return zero value if RegistryCredential type or CreatedAt field is nil
+	if r == nil || r.CreatedAt == nil {
+		return 0
+	}
+
+	return *r.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetCreatedBy() string {
+	// return zero value if RegistryCredential type or CreatedBy field is nil
+	if r == nil || r.CreatedBy == nil {
+		return ""
+	}
+
+	return *r.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetUpdatedAt() int64 {
+	// return zero value if RegistryCredential type or UpdatedAt field is nil
+	if r == nil || r.UpdatedAt == nil {
+		return 0
+	}
+
+	return *r.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided RegistryCredential type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryCredential) GetUpdatedBy() string {
+	// return zero value if RegistryCredential type or UpdatedBy field is nil
+	if r == nil || r.UpdatedBy == nil {
+		return ""
+	}
+
+	return *r.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetID(v int64) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetOrg(v string) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.Org = &v
+}
+
+// SetRegistry sets the Registry field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetRegistry(v string) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.Registry = &v
+}
+
+// SetUsername sets the Username field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetUsername(v string) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.Username = &v
+}
+
+// SetPassword sets the Password field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetPassword(v string) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.Password = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetActive(v bool) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetCreatedAt(v int64) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetCreatedBy(v string) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetUpdatedAt(v int64) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided RegistryCredential type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryCredential) SetUpdatedBy(v string) {
+	// return if RegistryCredential type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedBy = &v
+}
+
+// Sanitize creates a duplicate of the RegistryCredential
+// without the password for returning to users.
+func (r *RegistryCredential) Sanitize() *RegistryCredential {
+	// create a variable since constants can not be addressable
+	//
+	// https://golang.org/ref/spec#Address_operators
+	password := constants.SecretMask
+
+	return &RegistryCredential{
+		ID:        r.ID,
+		Org:       r.Org,
+		Registry:  r.Registry,
+		Username:  r.Username,
+		Password:  &password,
+		Active:    r.Active,
+		CreatedAt: r.CreatedAt,
+		CreatedBy: r.CreatedBy,
+		UpdatedAt: r.UpdatedAt,
+		UpdatedBy: r.UpdatedBy,
+	}
+}
+
+// String implements the Stringer interface for the RegistryCredential type.
+func (r *RegistryCredential) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Registry: %s,
+  Username: %s,
+  Password: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetOrg(),
+		r.GetRegistry(),
+		r.GetUsername(),
+		r.GetPassword(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRegistryCredential_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rc   *RegistryCredential
+		want *RegistryCredential
+	}{
+		{
+			rc:   testRegistryCredential(),
+			want: testRegistryCredential(),
+		},
+		{
+			rc:   new(RegistryCredential),
+			want: new(RegistryCredential),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.rc.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.rc.GetID(), test.want.GetID())
+		}
+
+		if test.rc.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.rc.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.rc.GetRegistry() != test.want.GetRegistry() {
+			t.Errorf("GetRegistry is %v, want %v", test.rc.GetRegistry(), test.want.GetRegistry())
+		}
+
+		if test.rc.GetUsername() != test.want.GetUsername() {
+			t.Errorf("GetUsername is %v, want %v", test.rc.GetUsername(), test.want.GetUsername())
+		}
+
+		if test.rc.GetPassword() != test.want.GetPassword() {
+			t.Errorf("GetPassword is %v, want %v", test.rc.GetPassword(), test.want.GetPassword())
+		}
+
+		if test.rc.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.rc.GetActive(), test.want.GetActive())
+		}
+
+		if test.rc.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.rc.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rc.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.rc.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rc.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.rc.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rc.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.rc.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRegistryCredential_Setters(t *testing.T) {
+	// setup types
+	var r *RegistryCredential
+
+	// setup tests
+	tests := []struct {
+		rc   *RegistryCredential
+		want *RegistryCredential
+	}{
+		{
+			rc:   testRegistryCredential(),
+			want: testRegistryCredential(),
+		},
+		{
+			rc:   r,
+			want: new(RegistryCredential),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.rc.SetID(test.want.GetID())
+		test.rc.SetOrg(test.want.GetOrg())
+		test.rc.SetRegistry(test.want.GetRegistry())
+		test.rc.SetUsername(test.want.GetUsername())
+		test.rc.SetPassword(test.want.GetPassword())
+		test.rc.SetActive(test.want.GetActive())
+		test.rc.SetCreatedAt(test.want.GetCreatedAt())
+		test.rc.SetCreatedBy(test.want.GetCreatedBy())
+		test.rc.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.rc.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.rc.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.rc.GetID(), test.want.GetID())
+		}
+
+		if test.rc.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.rc.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.rc.GetRegistry() != test.want.GetRegistry() {
+			t.Errorf("SetRegistry is %v, want %v", test.rc.GetRegistry(), test.want.GetRegistry())
+		}
+
+		if test.rc.GetUsername() != test.want.GetUsername() {
+			t.Errorf("SetUsername is %v, want %v", test.rc.GetUsername(), test.want.GetUsername())
+		}
+
+		if test.rc.GetPassword() != test.want.GetPassword() {
+			t.Errorf("SetPassword is %v, want %v", test.rc.GetPassword(), test.want.GetPassword())
+		}
+
+		if test.rc.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.rc.GetActive(), test.want.GetActive())
+		}
+
+		if test.rc.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.rc.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rc.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.rc.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rc.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.rc.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rc.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.rc.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRegistryCredential_Sanitize(t *testing.T) {
+	// setup types
+	r := testRegistryCredential()
+
+	want := testRegistryCredential()
+	want.SetPassword("[secure]")
+
+	// run test
+	got := r.Sanitize()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sanitize is %v, want %v", got, want)
+	}
+
+	if r.GetPassword() != "superSecretPassword" {
+		t.Errorf("Sanitize modified password to %s", r.GetPassword())
+	}
+}
+
+func TestRegistryCredential_String(t *testing.T) {
+	// setup types
+	r := testRegistryCredential()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Registry: %s,
+  Username: %s,
+  Password: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetOrg(),
+		r.GetRegistry(),
+		r.GetUsername(),
+		r.GetPassword(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRegistryCredential is a test helper function to create a RegistryCredential
+// type with all fields set to a fake value.
+func testRegistryCredential() *RegistryCredential {
+	r := new(RegistryCredential)
+
+	r.SetID(1)
+	r.SetOrg("github")
+	r.SetRegistry("ghcr.io")
+	r.SetUsername("octocat")
+	r.SetPassword("superSecretPassword")
+	r.SetActive(true)
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	return r
+}
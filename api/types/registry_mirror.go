@@ -0,0 +1,284 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// RegistryMirror is the API representation of a rule for rewriting
+// the images hosted in a container registry to pull from a mirror.
+//
+// swagger:model RegistryMirror
+type RegistryMirror struct {
+	ID        *int64  `json:"id,omitempty"`
+	Org       *string `json:"org,omitempty"`
+	Registry  *string `json:"registry,omitempty"`
+	Mirror    *string `json:"mirror,omitempty"`
+	Active    *bool   `json:"active,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	UpdatedAt *int64  `json:"updated_at,omitempty"`
+	UpdatedBy *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetID() int64 {
+	// return zero value if RegistryMirror type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetOrg() string {
+	// return zero value if RegistryMirror type or Org field is nil
+	if r == nil || r.Org == nil {
+		return ""
+	}
+
+	return *r.Org
+}
+
+// GetRegistry returns the Registry field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetRegistry() string {
+	// return zero value if RegistryMirror type or Registry field is nil
+	if r == nil || r.Registry == nil {
+		return ""
+	}
+
+	return *r.Registry
+}
+
+// GetMirror returns the Mirror field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetMirror() string {
+	// return zero value if RegistryMirror type or Mirror field is nil
+	if r == nil || r.Mirror == nil {
+		return ""
+	}
+
+	return *r.Mirror
+}
+
+// GetActive returns the Active field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetActive() bool {
+	// return zero value if RegistryMirror type or Active field is nil
+	if r == nil || r.Active == nil {
+		return false
+	}
+
+	return *r.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetCreatedAt() int64 {
+	// return zero value if RegistryMirror type or CreatedAt field is nil
+	if r == nil || r.CreatedAt == nil {
+		return 0
+	}
+
+	return *r.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetCreatedBy() string {
+	// return zero value if RegistryMirror type or CreatedBy field is nil
+	if r == nil || r.CreatedBy == nil {
+		return ""
+	}
+
+	return *r.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetUpdatedAt() int64 {
+	// return zero value if RegistryMirror type or UpdatedAt field is nil
+	if r == nil || r.UpdatedAt == nil {
+		return 0
+	}
+
+	return *r.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided RegistryMirror type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RegistryMirror) GetUpdatedBy() string {
+	// return zero value if RegistryMirror type or UpdatedBy field is nil
+	if r == nil || r.UpdatedBy == nil {
+		return ""
+	}
+
+	return *r.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetID(v int64) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetOrg(v string) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.Org = &v
+}
+
+// SetRegistry sets the Registry field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetRegistry(v string) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.Registry = &v
+}
+
+// SetMirror sets the Mirror field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetMirror(v string) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.Mirror = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetActive(v bool) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetCreatedAt(v int64) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetCreatedBy(v string) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetUpdatedAt(v int64) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided RegistryMirror type is nil, it
+// will set nothing and immediately return.
+func (r *RegistryMirror) SetUpdatedBy(v string) {
+	// return if RegistryMirror type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the RegistryMirror type.
+func (r *RegistryMirror) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Registry: %s,
+  Mirror: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetOrg(),
+		r.GetRegistry(),
+		r.GetMirror(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+}
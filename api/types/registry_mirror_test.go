@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRegistryMirror_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		rm   *RegistryMirror
+		want *RegistryMirror
+	}{
+		{
+			rm:   testRegistryMirror(),
+			want: testRegistryMirror(),
+		},
+		{
+			rm:   new(RegistryMirror),
+			want: new(RegistryMirror),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.rm.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.rm.GetID(), test.want.GetID())
+		}
+
+		if test.rm.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.rm.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.rm.GetRegistry() != test.want.GetRegistry() {
+			t.Errorf("GetRegistry is %v, want %v", test.rm.GetRegistry(), test.want.GetRegistry())
+		}
+
+		if test.rm.GetMirror() != test.want.GetMirror() {
+			t.Errorf("GetMirror is %v, want %v", test.rm.GetMirror(), test.want.GetMirror())
+		}
+
+		if test.rm.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.rm.GetActive(), test.want.GetActive())
+		}
+
+		if test.rm.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.rm.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rm.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.rm.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rm.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.rm.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rm.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.rm.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRegistryMirror_Setters(t *testing.T) {
+	// setup types
+	var r *RegistryMirror
+
+	// setup tests
+	tests := []struct {
+		rm   *RegistryMirror
+		want *RegistryMirror
+	}{
+		{
+			rm:   testRegistryMirror(),
+			want: testRegistryMirror(),
+		},
+		{
+			rm:   r,
+			want: new(RegistryMirror),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.rm.SetID(test.want.GetID())
+		test.rm.SetOrg(test.want.GetOrg())
+		test.rm.SetRegistry(test.want.GetRegistry())
+		test.rm.SetMirror(test.want.GetMirror())
+		test.rm.SetActive(test.want.GetActive())
+		test.rm.SetCreatedAt(test.want.GetCreatedAt())
+		test.rm.SetCreatedBy(test.want.GetCreatedBy())
+		test.rm.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.rm.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.rm.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.rm.GetID(), test.want.GetID())
+		}
+
+		if test.rm.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.rm.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.rm.GetRegistry() != test.want.GetRegistry() {
+			t.Errorf("SetRegistry is %v, want %v", test.rm.GetRegistry(), test.want.GetRegistry())
+		}
+
+		if test.rm.GetMirror() != test.want.GetMirror() {
+			t.Errorf("SetMirror is %v, want %v", test.rm.GetMirror(), test.want.GetMirror())
+		}
+
+		if test.rm.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.rm.GetActive(), test.want.GetActive())
+		}
+
+		if test.rm.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.rm.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.rm.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.rm.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.rm.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.rm.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.rm.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.rm.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRegistryMirror_String(t *testing.T) {
+	// setup types
+	r := testRegistryMirror()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Registry: %s,
+  Mirror: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetOrg(),
+		r.GetRegistry(),
+		r.GetMirror(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRegistryMirror is a test helper function to create a RegistryMirror
+// type with all fields set to a fake value.
+func testRegistryMirror() *RegistryMirror {
+	r := new(RegistryMirror)
+
+	r.SetID(1)
+	r.SetOrg("github")
+	r.SetRegistry("docker.io")
+	r.SetMirror("mirror.example.com/dockerhub")
+	r.SetActive(true)
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	return r
+}
@@ -0,0 +1,455 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// RepoSyncUnchanged represents a repo that was already
+	// in sync with the SCM when it was synced.
+	RepoSyncUnchanged = "unchanged"
+
+	// RepoSyncUpdated represents a repo that had its default
+	// branch or visibility refreshed from the SCM when it was synced.
+	RepoSyncUpdated = "updated"
+
+	// RepoSyncRenamed represents a repo that was renamed or
+	// transferred to another org in the SCM when it was synced.
+	RepoSyncRenamed = "renamed"
+
+	// RepoSyncArchived represents a repo that was deactivated
+	// because it is archived in the SCM when it was synced.
+	RepoSyncArchived = "archived"
+
+	// RepoSyncDeleted represents a repo that was deactivated
+	// because it no longer exists in the SCM when it was synced.
+	RepoSyncDeleted = "deleted"
+
+	// RepoSyncFailed represents a repo that could not be
+	// captured from the SCM when it was synced.
+	RepoSyncFailed = "failed"
+)
+
+// RepoSync is the API representation of the state of a repo
+// in the SCM the last time it was synced with the database.
+//
+// swagger:model RepoSync
+type RepoSync struct {
+	ID       *int64    `json:"id,omitempty"`
+	RepoID   *int64    `json:"repo_id,omitempty"`
+	Org      *string   `json:"org,omitempty"`
+	Name     *string   `json:"name,omitempty"`
+	Link     *string   `json:"link,omitempty"`
+	Clone    *string   `json:"clone,omitempty"`
+	Branch   *string   `json:"branch,omitempty"`
+	Private  *bool     `json:"private,omitempty"`
+	Archived *bool     `json:"archived,omitempty"`
+	Topics   *[]string `json:"topics,omitempty"`
+	Status   *string   `json:"status,omitempty"`
+	Changes  *[]string `json:"changes,omitempty"`
+	Error    *string   `json:"error,omitempty"`
+	Synced   *int64    `json:"synced,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetID() int64 {
+	// return zero value if RepoSync type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetRepoID() int64 {
+	// return zero value if RepoSync type or RepoID field is nil
+	if r == nil || r.RepoID == nil {
+		return 0
+	}
+
+	return *r.RepoID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetOrg() string {
+	// return zero value if RepoSync type or Org field is nil
+	if r == nil || r.Org == nil {
+		return ""
+	}
+
+	return *r.Org
+}
+
+// GetName returns the Name field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetName() string {
+	// return zero value if RepoSync type or Name field is nil
+	if r == nil || r.Name == nil {
+		return ""
+	}
+
+	return *r.Name
+}
+
+// GetLink returns the Link field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetLink() string {
+	// return zero value if RepoSync type or Link field is nil
+	if r == nil || r.Link == nil {
+		return ""
+	}
+
+	return *r.Link
+}
+
+// GetClone returns the Clone field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetClone() string {
+	// return zero value if RepoSync type or Clone field is nil
+	if r == nil || r.Clone == nil {
+		return ""
+	}
+
+	return *r.Clone
+}
+
+// GetBranch returns the Branch field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetBranch() string {
+	// return zero value if RepoSync type or Branch field is nil
+	if r == nil || r.Branch == nil {
+		return ""
+	}
+
+	return *r.Branch
+}
+
+// GetPrivate returns the Private field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetPrivate() bool {
+	// return zero value if RepoSync type or Private field is nil
+	if r == nil || r.Private == nil {
+		return false
+	}
+
+	return *r.Private
+}
+
+// GetArchived returns the Archived field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetArchived() bool {
+	// return zero value if RepoSync type or Archived field is nil
+	if r == nil || r.Archived == nil {
+		return false
+	}
+
+	return *r.Archived
+}
+
+// GetTopics returns the Topics field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetTopics() []string {
+	// return zero value if RepoSync type or Topics field is nil
+	if r == nil || r.Topics == nil {
+		return []string{}
+	}
+
+	return *r.Topics
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetStatus() string {
+	// return zero value if RepoSync type or Status field is nil
+	if r == nil || r.Status == nil {
+		return ""
+	}
+
+	return *r.Status
+}
+
+// GetChanges returns the Changes field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetChanges() []string {
+	// return zero value if RepoSync type or Changes field is nil
+	if r == nil || r.Changes == nil {
+		return []string{}
+	}
+
+	return *r.Changes
+}
+
+// GetError returns the Error field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetError() string {
+	// return zero value if RepoSync type or Error field is nil
+	if r == nil || r.Error == nil {
+		return ""
+	}
+
+	return *r.Error
+}
+
+// GetSynced returns the Synced field.
+//
+// When the provided RepoSync type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoSync) GetSynced() int64 {
+	// return zero value if RepoSync type or Synced field is nil
+	if r == nil || r.Synced == nil {
+		return 0
+	}
+
+	return *r.Synced
+}
+
+// SetID sets the ID field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetID(v int64) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetRepoID(v int64) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.RepoID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetOrg(v string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Org = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetName(v string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Name = &v
+}
+
+// SetLink sets the Link field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetLink(v string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Link = &v
+}
+
+// SetClone sets the Clone field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetClone(v string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Clone = &v
+}
+
+// SetBranch sets the Branch field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetBranch(v string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Branch = &v
+}
+
+// SetPrivate sets the Private field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetPrivate(v bool) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Private = &v
+}
+
+// SetArchived sets the Archived field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetArchived(v bool) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Archived = &v
+}
+
+// SetTopics sets the Topics field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetTopics(v []string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Topics = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetStatus(v string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Status = &v
+}
+
+// SetChanges sets the Changes field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetChanges(v []string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Changes = &v
+}
+
+// SetError sets the Error field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetError(v string) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Error = &v
+}
+
+// SetSynced sets the Synced field.
+//
+// When the provided RepoSync type is nil, it
+// will set nothing and immediately return.
+func (r *RepoSync) SetSynced(v int64) {
+	// return if RepoSync type is nil
+	if r == nil {
+		return
+	}
+
+	r.Synced = &v
+}
+
+// String implements the Stringer interface for the RepoSync type.
+func (r *RepoSync) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Org: %s,
+  Name: %s,
+  Link: %s,
+  Clone: %s,
+  Branch: %s,
+  Private: %t,
+  Archived: %t,
+  Topics: %s,
+  Status: %s,
+  Changes: %s,
+  Error: %s,
+  Synced: %d,
+}`,
+		r.GetID(),
+		r.GetRepoID(),
+		r.GetOrg(),
+		r.GetName(),
+		r.GetLink(),
+		r.GetClone(),
+		r.GetBranch(),
+		r.GetPrivate(),
+		r.GetArchived(),
+		r.GetTopics(),
+		r.GetStatus(),
+		r.GetChanges(),
+		r.GetError(),
+		r.GetSynced(),
+	)
+}
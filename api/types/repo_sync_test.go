@@ -0,0 +1,248 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRepoSync_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		sync *RepoSync
+		want *RepoSync
+	}{
+		{
+			sync: testRepoSync(),
+			want: testRepoSync(),
+		},
+		{
+			sync: new(RepoSync),
+			want: new(RepoSync),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.sync.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.sync.GetID(), test.want.GetID())
+		}
+
+		if test.sync.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.sync.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.sync.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.sync.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.sync.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.sync.GetName(), test.want.GetName())
+		}
+
+		if test.sync.GetLink() != test.want.GetLink() {
+			t.Errorf("GetLink is %v, want %v", test.sync.GetLink(), test.want.GetLink())
+		}
+
+		if test.sync.GetClone() != test.want.GetClone() {
+			t.Errorf("GetClone is %v, want %v", test.sync.GetClone(), test.want.GetClone())
+		}
+
+		if test.sync.GetBranch() != test.want.GetBranch() {
+			t.Errorf("GetBranch is %v, want %v", test.sync.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.sync.GetPrivate() != test.want.GetPrivate() {
+			t.Errorf("GetPrivate is %v, want %v", test.sync.GetPrivate(), test.want.GetPrivate())
+		}
+
+		if test.sync.GetArchived() != test.want.GetArchived() {
+			t.Errorf("GetArchived is %v, want %v", test.sync.GetArchived(), test.want.GetArchived())
+		}
+
+		if !reflect.DeepEqual(test.sync.GetTopics(), test.want.GetTopics()) {
+			t.Errorf("GetTopics is %v, want %v", test.sync.GetTopics(), test.want.GetTopics())
+		}
+
+		if test.sync.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.sync.GetStatus(), test.want.GetStatus())
+		}
+
+		if !reflect.DeepEqual(test.sync.GetChanges(), test.want.GetChanges()) {
+			t.Errorf("GetChanges is %v, want %v", test.sync.GetChanges(), test.want.GetChanges())
+		}
+
+		if test.sync.GetError() != test.want.GetError() {
+			t.Errorf("GetError is %v, want %v", test.sync.GetError(), test.want.GetError())
+		}
+
+		if test.sync.GetSynced() != test.want.GetSynced() {
+			t.Errorf("GetSynced is %v, want %v", test.sync.GetSynced(), test.want.GetSynced())
+		}
+	}
+}
+
+func TestRepoSync_Setters(t *testing.T) {
+	// setup types
+	var r *RepoSync
+
+	// setup tests
+	tests := []struct {
+		sync *RepoSync
+		want *RepoSync
+	}{
+		{
+			sync: testRepoSync(),
+			want: testRepoSync(),
+		},
+		{
+			sync: r,
+			want: new(RepoSync),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.sync.SetID(test.want.GetID())
+		test.sync.SetRepoID(test.want.GetRepoID())
+		test.sync.SetOrg(test.want.GetOrg())
+		test.sync.SetName(test.want.GetName())
+		test.sync.SetLink(test.want.GetLink())
+		test.sync.SetClone(test.want.GetClone())
+		test.sync.SetBranch(test.want.GetBranch())
+		test.sync.SetPrivate(test.want.GetPrivate())
+		test.sync.SetArchived(test.want.GetArchived())
+		test.sync.SetTopics(test.want.GetTopics())
+		test.sync.SetStatus(test.want.GetStatus())
+		test.sync.SetChanges(test.want.GetChanges())
+		test.sync.SetError(test.want.GetError())
+		test.sync.SetSynced(test.want.GetSynced())
+
+		if test.sync.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.sync.GetID(), test.want.GetID())
+		}
+
+		if test.sync.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.sync.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.sync.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.sync.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.sync.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.sync.GetName(), test.want.GetName())
+		}
+
+		if test.sync.GetLink() != test.want.GetLink() {
+			t.Errorf("SetLink is %v, want %v", test.sync.GetLink(), test.want.GetLink())
+		}
+
+		if test.sync.GetClone() != test.want.GetClone() {
+			t.Errorf("SetClone is %v, want %v", test.sync.GetClone(), test.want.GetClone())
+		}
+
+		if test.sync.GetBranch() != test.want.GetBranch() {
+			t.Errorf("SetBranch is %v, want %v", test.sync.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.sync.GetPrivate() != test.want.GetPrivate() {
+			t.Errorf("SetPrivate is %v, want %v", test.sync.GetPrivate(), test.want.GetPrivate())
+		}
+
+		if test.sync.GetArchived() != test.want.GetArchived() {
+			t.Errorf("SetArchived is %v, want %v", test.sync.GetArchived(), test.want.GetArchived())
+		}
+
+		if !reflect.DeepEqual(test.sync.GetTopics(), test.want.GetTopics()) {
+			t.Errorf("SetTopics is %v, want %v", test.sync.GetTopics(), test.want.GetTopics())
+		}
+
+		if test.sync.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.sync.GetStatus(), test.want.GetStatus())
+		}
+
+		if !reflect.DeepEqual(test.sync.GetChanges(), test.want.GetChanges()) {
+			t.Errorf("SetChanges is %v, want %v", test.sync.GetChanges(), test.want.GetChanges())
+		}
+
+		if test.sync.GetError() != test.want.GetError() {
+			t.Errorf("SetError is %v, want %v", test.sync.GetError(), test.want.GetError())
+		}
+
+		if test.sync.GetSynced() != test.want.GetSynced() {
+			t.Errorf("SetSynced is %v, want %v", test.sync.GetSynced(), test.want.GetSynced())
+		}
+	}
+}
+
+func TestRepoSync_String(t *testing.T) {
+	// setup types
+	r := testRepoSync()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Org: %s,
+  Name: %s,
+  Link: %s,
+  Clone: %s,
+  Branch: %s,
+  Private: %t,
+  Archived: %t,
+  Topics: %s,
+  Status: %s,
+  Changes: %s,
+  Error: %s,
+  Synced: %d,
+}`,
+		r.GetID(),
+		r.GetRepoID(),
+		r.GetOrg(),
+		r.GetName(),
+		r.GetLink(),
+		r.GetClone(),
+		r.GetBranch(),
+		r.GetPrivate(),
+		r.GetArchived(),
+		r.GetTopics(),
+		r.GetStatus(),
+		r.GetChanges(),
+		r.GetError(),
+		r.GetSynced(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRepoSync is a test helper function to create a RepoSync
+// type with all fields set to a fake value.
+func testRepoSync() *RepoSync {
+	r := new(RepoSync)
+
+	r.SetID(1)
+	r.SetRepoID(1)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetLink("https://github.com/github/octocat")
+	r.SetClone("https://github.com/github/octocat.git")
+	r.SetBranch("main")
+	r.SetPrivate(true)
+	r.SetArchived(false)
+	r.SetTopics([]string{"go", "vela"})
+	r.SetStatus("updated")
+	r.SetChanges([]string{"branch changed from master to main"})
+	r.SetError("")
+	r.SetSynced(1563474076)
+
+	return r
+}
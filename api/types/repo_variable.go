@@ -0,0 +1,315 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+)
+
+// RepoVariable is the API representation of an environment variable
+// injected into the builds for a repo. A sensitive variable is
+// encrypted at rest and masked in the logs for the builds, but
+// unlike a secret its value remains readable by the repo admins.
+//
+// swagger:model RepoVariable
+type RepoVariable struct {
+	ID        *int64  `json:"id,omitempty"`
+	RepoID    *int64  `json:"repo_id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	Value     *string `json:"value,omitempty"`
+	Sensitive *bool   `json:"sensitive,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	UpdatedAt *int64  `json:"updated_at,omitempty"`
+	UpdatedBy *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetID() int64 {
+	// return zero value if RepoVariable type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetRepoID() int64 {
+	// return zero value if RepoVariable type or RepoID field is nil
+	if r == nil || r.RepoID == nil {
+		return 0
+	}
+
+	return *r.RepoID
+}
+
+// GetName returns the Name field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetName() string {
+	// return zero value if RepoVariable type or Name field is nil
+	if r == nil || r.Name == nil {
+		return ""
+	}
+
+	return *r.Name
+}
+
+// GetValue returns the Value field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetValue() string {
+	// return zero value if RepoVariable type or Value field is nil
+	if r == nil || r.Value == nil {
+		return ""
+	}
+
+	return *r.Value
+}
+
+// GetSensitive returns the Sensitive field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetSensitive() bool {
+	// return zero value if RepoVariable type or Sensitive field is nil
+	if r == nil || r.Sensitive == nil {
+		return false
+	}
+
+	return *r.Sensitive
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetCreatedAt() int64 {
+	// return zero value if RepoVariable type or CreatedAt field is nil
+	if r == nil || r.CreatedAt == nil {
+		return 0
+	}
+
+	return *r.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetCreatedBy() string {
+	// return zero value if RepoVariable type or CreatedBy field is nil
+	if r == nil || r.CreatedBy == nil {
+		return ""
+	}
+
+	return *r.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetUpdatedAt() int64 {
+	// return zero value if RepoVariable type or UpdatedAt field is nil
+	if r == nil || r.UpdatedAt == nil {
+		return 0
+	}
+
+	return *r.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided RepoVariable type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RepoVariable) GetUpdatedBy() string {
+	// return zero value if RepoVariable type or UpdatedBy field is nil
+	if r == nil || r.UpdatedBy == nil {
+		return ""
+	}
+
+	return *r.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetID(v int64) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetRepoID(v int64) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.RepoID = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetName(v string) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.Name = &v
+}
+
+// SetValue sets the Value field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetValue(v string) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.Value = &v
+}
+
+// SetSensitive sets the Sensitive field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetSensitive(v bool) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.Sensitive = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetCreatedAt(v int64) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetCreatedBy(v string) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetUpdatedAt(v int64) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided RepoVariable type is nil, it
+// will set nothing and immediately return.
+func (r *RepoVariable) SetUpdatedBy(v string) {
+	// return if RepoVariable type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedBy = &v
+}
+
+// Sanitize creates a duplicate of the RepoVariable with the
+// value masked when the variable is sensitive for returning
+// to users that are not admins for the repo.
+func (r *RepoVariable) Sanitize() *RepoVariable {
+	value := r.Value
+
+	if r.GetSensitive() {
+		// create a variable since constants can not be addressable
+		//
+		// https://golang.org/ref/spec#Address_operators
+		mask := constants.SecretMask
+		value = &mask
+	}
+
+	return &RepoVariable{
+		ID:        r.ID,
+		RepoID:    r.RepoID,
+		Name:      r.Name,
+		Value:     value,
+		Sensitive: r.Sensitive,
+		CreatedAt: r.CreatedAt,
+		CreatedBy: r.CreatedBy,
+		UpdatedAt: r.UpdatedAt,
+		UpdatedBy: r.UpdatedBy,
+	}
+}
+
+// String implements the Stringer interface for the RepoVariable type.
+func (r *RepoVariable) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Name: %s,
+  Value: %s,
+  Sensitive: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetRepoID(),
+		r.GetName(),
+		r.GetValue(),
+		r.GetSensitive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRepoVariable_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		variable *RepoVariable
+		want     *RepoVariable
+	}{
+		{
+			variable: testRepoVariable(),
+			want:     testRepoVariable(),
+		},
+		{
+			variable: new(RepoVariable),
+			want:     new(RepoVariable),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.variable.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.variable.GetID(), test.want.GetID())
+		}
+
+		if test.variable.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.variable.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.variable.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.variable.GetName(), test.want.GetName())
+		}
+
+		if test.variable.GetValue() != test.want.GetValue() {
+			t.Errorf("GetValue is %v, want %v", test.variable.GetValue(), test.want.GetValue())
+		}
+
+		if test.variable.GetSensitive() != test.want.GetSensitive() {
+			t.Errorf("GetSensitive is %v, want %v", test.variable.GetSensitive(), test.want.GetSensitive())
+		}
+
+		if test.variable.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.variable.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.variable.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.variable.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.variable.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.variable.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.variable.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.variable.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRepoVariable_Setters(t *testing.T) {
+	// setup types
+	var r *RepoVariable
+
+	// setup tests
+	tests := []struct {
+		variable *RepoVariable
+		want     *RepoVariable
+	}{
+		{
+			variable: testRepoVariable(),
+			want:     testRepoVariable(),
+		},
+		{
+			variable: r,
+			want:     new(RepoVariable),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.variable.SetID(test.want.GetID())
+		test.variable.SetRepoID(test.want.GetRepoID())
+		test.variable.SetName(test.want.GetName())
+		test.variable.SetValue(test.want.GetValue())
+		test.variable.SetSensitive(test.want.GetSensitive())
+		test.variable.SetCreatedAt(test.want.GetCreatedAt())
+		test.variable.SetCreatedBy(test.want.GetCreatedBy())
+		test.variable.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.variable.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.variable.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.variable.GetID(), test.want.GetID())
+		}
+
+		if test.variable.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.variable.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.variable.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.variable.GetName(), test.want.GetName())
+		}
+
+		if test.variable.GetValue() != test.want.GetValue() {
+			t.Errorf("SetValue is %v, want %v", test.variable.GetValue(), test.want.GetValue())
+		}
+
+		if test.variable.GetSensitive() != test.want.GetSensitive() {
+			t.Errorf("SetSensitive is %v, want %v", test.variable.GetSensitive(), test.want.GetSensitive())
+		}
+
+		if test.variable.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.variable.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.variable.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.variable.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.variable.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.variable.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.variable.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.variable.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRepoVariable_Sanitize(t *testing.T) {
+	// setup types
+	r := testRepoVariable()
+
+	want := testRepoVariable()
+	want.SetValue("[secure]")
+
+	plain := testRepoVariable()
+	plain.SetSensitive(false)
+
+	// run test
+	got := r.Sanitize()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sanitize is %v, want %v", got, want)
+	}
+
+	if r.GetValue() != "us-east-1" {
+		t.Errorf("Sanitize modified value to %s", r.GetValue())
+	}
+
+	got = plain.Sanitize()
+
+	if !reflect.DeepEqual(got, plain) {
+		t.Errorf("Sanitize is %v, want %v", got, plain)
+	}
+}
+
+func TestRepoVariable_String(t *testing.T) {
+	// setup types
+	r := testRepoVariable()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Name: %s,
+  Value: %s,
+  Sensitive: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetRepoID(),
+		r.GetName(),
+		r.GetValue(),
+		r.GetSensitive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRepoVariable is a test helper function to create a RepoVariable
+// type with all fields set to a fake value.
+func testRepoVariable() *RepoVariable {
+	r := new(RepoVariable)
+
+	r.SetID(1)
+	r.SetRepoID(1)
+	r.SetName("DEPLOY_REGION")
+	r.SetValue("us-east-1")
+	r.SetSensitive(true)
+	r.SetCreatedAt(1563474076)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	return r
+}
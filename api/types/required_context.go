@@ -0,0 +1,348 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// RequiredContextRepoAny represents the repo for the status
+// contexts required for every repo in the org.
+const RequiredContextRepoAny = "*"
+
+// RequiredContext is the API representation of the status contexts
+// required by the branch protection for a repo in an org. The Repo
+// field may be the wildcard to require the contexts for every repo
+// in the org without an entry of its own.
+//
+// swagger:model RequiredContext
+type RequiredContext struct {
+	ID        *int64    `json:"id,omitempty"`
+	Org       *string   `json:"org,omitempty"`
+	Repo      *string   `json:"repo,omitempty"`
+	Branch    *string   `json:"branch,omitempty"`
+	Contexts  *[]string `json:"contexts,omitempty"`
+	Strict    *bool     `json:"strict,omitempty"`
+	Active    *bool     `json:"active,omitempty"`
+	CreatedAt *int64    `json:"created_at,omitempty"`
+	CreatedBy *string   `json:"created_by,omitempty"`
+	UpdatedAt *int64    `json:"updated_at,omitempty"`
+	UpdatedBy *string   `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetID() int64 {
+	// return zero value if RequiredContext type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetOrg() string {
+	// return zero value if RequiredContext type or Org field is nil
+	if r == nil || r.Org == nil {
+		return ""
+	}
+
+	return *r.Org
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetRepo() string {
+	// return zero value if RequiredContext type or Repo field is nil
+	if r == nil || r.Repo == nil {
+		return ""
+	}
+
+	return *r.Repo
+}
+
+// GetBranch returns the Branch field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetBranch() string {
+	// return zero value if RequiredContext type or Branch field is nil
+	if r == nil || r.Branch == nil {
+		return ""
+	}
+
+	return *r.Branch
+}
+
+// GetContexts returns the Contexts field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetContexts() []string {
+	// return zero value if RequiredContext type or Contexts field is nil
+	if r == nil || r.Contexts == nil {
+		return []string{}
+	}
+
+	return *r.Contexts
+}
+
+// GetStrict returns the Strict field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetStrict() bool {
+	// return zero value if RequiredContext type or Strict field is nil
+	if r == nil || r.Strict == nil {
+		return false
+	}
+
+	return *r.Strict
+}
+
+// GetActive returns the Active field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetActive() bool {
+	// return zero value if RequiredContext type or Active field is nil
+	if r == nil || r.Active == nil {
+		return false
+	}
+
+	return *r.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetCreatedAt() int64 {
+	// return zero value if RequiredContext type or CreatedAt field is nil
+	if r == nil || r.CreatedAt == nil {
+		return 0
+	}
+
+	return *r.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetCreatedBy() string {
+	// return zero value if RequiredContext type or CreatedBy field is nil
+	if r == nil || r.CreatedBy == nil {
+		return ""
+	}
+
+	return *r.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetUpdatedAt() int64 {
+	// return zero value if RequiredContext type or UpdatedAt field is nil
+	if r == nil || r.UpdatedAt == nil {
+		return 0
+	}
+
+	return *r.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided RequiredContext type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *RequiredContext) GetUpdatedBy() string {
+	// return zero value if RequiredContext type or UpdatedBy field is nil
+	if r == nil || r.UpdatedBy == nil {
+		return ""
+	}
+
+	return *r.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetID(v int64) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetOrg(v string) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.Org = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetRepo(v string) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.Repo = &v
+}
+
+// SetBranch sets the Branch field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetBranch(v string) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.Branch = &v
+}
+
+// SetContexts sets the Contexts field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetContexts(v []string) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.Contexts = &v
+}
+
+// SetStrict sets the Strict field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetStrict(v bool) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.Strict = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetActive(v bool) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetCreatedAt(v int64) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetCreatedBy(v string) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetUpdatedAt(v int64) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided RequiredContext type is nil, it
+// will set nothing and immediately return.
+func (r *RequiredContext) SetUpdatedBy(v string) {
+	// return if RequiredContext type is nil
+	if r == nil {
+		return
+	}
+
+	r.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the RequiredContext type.
+func (r *RequiredContext) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Repo: %s,
+  Branch: %s,
+  Contexts: %s,
+  Strict: %t,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetOrg(),
+		r.GetRepo(),
+		r.GetBranch(),
+		r.GetContexts(),
+		r.GetStrict(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+}
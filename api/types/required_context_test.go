@@ -0,0 +1,212 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRequiredContext_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		requiredContext *RequiredContext
+		want            *RequiredContext
+	}{
+		{
+			requiredContext: testRequiredContext(),
+			want:            testRequiredContext(),
+		},
+		{
+			requiredContext: new(RequiredContext),
+			want:            new(RequiredContext),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.requiredContext.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.requiredContext.GetID(), test.want.GetID())
+		}
+
+		if test.requiredContext.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.requiredContext.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.requiredContext.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.requiredContext.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.requiredContext.GetBranch() != test.want.GetBranch() {
+			t.Errorf("GetBranch is %v, want %v", test.requiredContext.GetBranch(), test.want.GetBranch())
+		}
+
+		if !reflect.DeepEqual(test.requiredContext.GetContexts(), test.want.GetContexts()) {
+			t.Errorf("GetContexts is %v, want %v", test.requiredContext.GetContexts(), test.want.GetContexts())
+		}
+
+		if test.requiredContext.GetStrict() != test.want.GetStrict() {
+			t.Errorf("GetStrict is %v, want %v", test.requiredContext.GetStrict(), test.want.GetStrict())
+		}
+
+		if test.requiredContext.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.requiredContext.GetActive(), test.want.GetActive())
+		}
+
+		if test.requiredContext.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.requiredContext.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.requiredContext.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.requiredContext.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.requiredContext.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.requiredContext.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.requiredContext.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.requiredContext.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRequiredContext_Setters(t *testing.T) {
+	// setup types
+	var r *RequiredContext
+
+	// setup tests
+	tests := []struct {
+		requiredContext *RequiredContext
+		want            *RequiredContext
+	}{
+		{
+			requiredContext: testRequiredContext(),
+			want:            testRequiredContext(),
+		},
+		{
+			requiredContext: r,
+			want:            new(RequiredContext),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.requiredContext.SetID(test.want.GetID())
+		test.requiredContext.SetOrg(test.want.GetOrg())
+		test.requiredContext.SetRepo(test.want.GetRepo())
+		test.requiredContext.SetBranch(test.want.GetBranch())
+		test.requiredContext.SetContexts(test.want.GetContexts())
+		test.requiredContext.SetStrict(test.want.GetStrict())
+		test.requiredContext.SetActive(test.want.GetActive())
+		test.requiredContext.SetCreatedAt(test.want.GetCreatedAt())
+		test.requiredContext.SetCreatedBy(test.want.GetCreatedBy())
+		test.requiredContext.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.requiredContext.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.requiredContext.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.requiredContext.GetID(), test.want.GetID())
+		}
+
+		if test.requiredContext.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.requiredContext.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.requiredContext.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.requiredContext.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.requiredContext.GetBranch() != test.want.GetBranch() {
+			t.Errorf("SetBranch is %v, want %v", test.requiredContext.GetBranch(), test.want.GetBranch())
+		}
+
+		if !reflect.DeepEqual(test.requiredContext.GetContexts(), test.want.GetContexts()) {
+			t.Errorf("SetContexts is %v, want %v", test.requiredContext.GetContexts(), test.want.GetContexts())
+		}
+
+		if test.requiredContext.GetStrict() != test.want.GetStrict() {
+			t.Errorf("SetStrict is %v, want %v", test.requiredContext.GetStrict(), test.want.GetStrict())
+		}
+
+		if test.requiredContext.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.requiredContext.GetActive(), test.want.GetActive())
+		}
+
+		if test.requiredContext.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.requiredContext.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.requiredContext.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.requiredContext.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.requiredContext.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.requiredContext.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.requiredContext.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.requiredContext.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRequiredContext_String(t *testing.T) {
+	// setup types
+	r := testRequiredContext()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Repo: %s,
+  Branch: %s,
+  Contexts: %s,
+  Strict: %t,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		r.GetID(),
+		r.GetOrg(),
+		r.GetRepo(),
+		r.GetBranch(),
+		r.GetContexts(),
+		r.GetStrict(),
+		r.GetActive(),
+		r.GetCreatedAt(),
+		r.GetCreatedBy(),
+		r.GetUpdatedAt(),
+		r.GetUpdatedBy(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRequiredContext is a test helper function to create a RequiredContext
+// type with all fields set to a fake value.
+func testRequiredContext() *RequiredContext {
+	r := new(RequiredContext)
+
+	r.SetID(1)
+	r.SetOrg("github")
+	r.SetRepo("octocat")
+	r.SetBranch("main")
+	r.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	r.SetStrict(true)
+	r.SetActive(true)
+	r.SetCreatedAt(1563474076)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	return r
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// RetentionPolicy is the API representation of the policy controlling
+// how long the builds, logs and hooks for a repo are kept before they
+// are deleted. The global policy has no repo and applies to every
+// repo without its own policy. A limit set to zero is never enforced.
+//
+// swagger:model RetentionPolicy
+type RetentionPolicy struct {
+	ID         *int64  `json:"id,omitempty"`
+	RepoID     *int64  `json:"repo_id,omitempty"`
+	KeepBuilds *int64  `json:"keep_builds,omitempty"`
+	LogDays    *int64  `json:"log_days,omitempty"`
+	HookDays   *int64  `json:"hook_days,omitempty"`
+	UpdatedAt  *int64  `json:"updated_at,omitempty"`
+	UpdatedBy  *string `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided RetentionPolicy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *RetentionPolicy) GetID() int64 {
+	// return zero value if RetentionPolicy type or ID field is nil
+	if p == nil || p.ID == nil {
+		return 0
+	}
+
+	return *p.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided RetentionPolicy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *RetentionPolicy) GetRepoID() int64 {
+	// return zero value if RetentionPolicy type or RepoID field is nil
+	if p == nil || p.RepoID == nil {
+		return 0
+	}
+
+	return *p.RepoID
+}
+
+// GetKeepBuilds returns the KeepBuilds field.
+//
+// When the provided RetentionPolicy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *RetentionPolicy) GetKeepBuilds() int64 {
+	// return zero value if RetentionPolicy type or KeepBuilds field is nil
+	if p == nil || p.KeepBuilds == nil {
+		return 0
+	}
+
+	return *p.KeepBuilds
+}
+
+// GetLogDays returns the LogDays field.
+//
+// When the provided RetentionPolicy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *RetentionPolicy) GetLogDays() int64 {
+	// return zero value if RetentionPolicy type or LogDays field is nil
+	if p == nil || p.LogDays == nil {
+		return 0
+	}
+
+	return *p.LogDays
+}
+
+// GetHookDays returns the HookDays field.
+//
+// When the provided RetentionPolicy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *RetentionPolicy) GetHookDays() int64 {
+	// return zero value if RetentionPolicy type or HookDays field is nil
+	if p == nil || p.HookDays == nil {
+		return 0
+	}
+
+	return *p.HookDays
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided RetentionPolicy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *RetentionPolicy) GetUpdatedAt() int64 {
+	// return zero value if RetentionPolicy type or UpdatedAt field is nil
+	if p == nil || p.UpdatedAt == nil {
+		return 0
+	}
+
+	return *p.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided RetentionPolicy type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (p *RetentionPolicy) GetUpdatedBy() string {
+	// return zero value if RetentionPolicy type or UpdatedBy field is nil
+	if p == nil || p.UpdatedBy == nil {
+		return ""
+	}
+
+	return *p.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided RetentionPolicy type is nil, it
+// will set nothing and immediately return.
+func (p *RetentionPolicy) SetID(v int64) {
+	// return if RetentionPolicy type is nil
+	if p == nil {
+		return
+	}
+
+	p.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided RetentionPolicy type is nil, it
+// will set nothing and immediately return.
+func (p *RetentionPolicy) SetRepoID(v int64) {
+	// return if RetentionPolicy type is nil
+	if p == nil {
+		return
+	}
+
+	p.RepoID = &v
+}
+
+// SetKeepBuilds sets the KeepBuilds field.
+//
+// When the provided RetentionPolicy type is nil, it
+// will set nothing and immediately return.
+func (p *RetentionPolicy) SetKeepBuilds(v int64) {
+	// return if RetentionPolicy type is nil
+	if p == nil {
+		return
+	}
+
+	p.KeepBuilds = &v
+}
+
+// SetLogDays sets the LogDays field.
+//
+// When the provided RetentionPolicy type is nil, it
+// will set nothing and immediately return.
+func (p *RetentionPolicy) SetLogDays(v int64) {
+	// return if RetentionPolicy type is nil
+	if p == nil {
+		return
+	}
+
+	p.LogDays = &v
+}
+
+// SetHookDays sets the HookDays field.
+//
+// When the provided RetentionPolicy type is nil, it
+// will set nothing and immediately return.
+func (p *RetentionPolicy) SetHookDays(v int64) {
+	// return if RetentionPolicy type is nil
+	if p == nil {
+		return
+	}
+
+	p.HookDays = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided RetentionPolicy type is nil, it
+// will set nothing and immediately return.
+func (p *RetentionPolicy) SetUpdatedAt(v int64) {
+	// return if RetentionPolicy type is nil
+	if p == nil {
+		return
+	}
+
+	p.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided RetentionPolicy type is nil, it
+// will set nothing and immediately return.
+func (p *RetentionPolicy) SetUpdatedBy(v string) {
+	// return if RetentionPolicy type is nil
+	if p == nil {
+		return
+	}
+
+	p.UpdatedBy = &v
+}
+
+// String implements the Stringer interface for the RetentionPolicy type.
+func (p *RetentionPolicy) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  KeepBuilds: %d,
+  LogDays: %d,
+  HookDays: %d,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		p.GetID(),
+		p.GetRepoID(),
+		p.GetKeepBuilds(),
+		p.GetLogDays(),
+		p.GetHookDays(),
+		p.GetUpdatedAt(),
+		p.GetUpdatedBy(),
+	)
+}
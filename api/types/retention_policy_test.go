@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRetentionPolicy_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		policy *RetentionPolicy
+		want   *RetentionPolicy
+	}{
+		{
+			policy: testRetentionPolicy(),
+			want:   testRetentionPolicy(),
+		},
+		{
+			policy: new(RetentionPolicy),
+			want:   new(RetentionPolicy),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.policy.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.policy.GetID(), test.want.GetID())
+		}
+
+		if test.policy.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.policy.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.policy.GetKeepBuilds() != test.want.GetKeepBuilds() {
+			t.Errorf("GetKeepBuilds is %v, want %v", test.policy.GetKeepBuilds(), test.want.GetKeepBuilds())
+		}
+
+		if test.policy.GetLogDays() != test.want.GetLogDays() {
+			t.Errorf("GetLogDays is %v, want %v", test.policy.GetLogDays(), test.want.GetLogDays())
+		}
+
+		if test.policy.GetHookDays() != test.want.GetHookDays() {
+			t.Errorf("GetHookDays is %v, want %v", test.policy.GetHookDays(), test.want.GetHookDays())
+		}
+
+		if test.policy.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.policy.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.policy.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.policy.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRetentionPolicy_Setters(t *testing.T) {
+	// setup types
+	var p *RetentionPolicy
+
+	// setup tests
+	tests := []struct {
+		policy *RetentionPolicy
+		want   *RetentionPolicy
+	}{
+		{
+			policy: testRetentionPolicy(),
+			want:   testRetentionPolicy(),
+		},
+		{
+			policy: p,
+			want:   new(RetentionPolicy),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.policy.SetID(test.want.GetID())
+		test.policy.SetRepoID(test.want.GetRepoID())
+		test.policy.SetKeepBuilds(test.want.GetKeepBuilds())
+		test.policy.SetLogDays(test.want.GetLogDays())
+		test.policy.SetHookDays(test.want.GetHookDays())
+		test.policy.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.policy.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.policy.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.policy.GetID(), test.want.GetID())
+		}
+
+		if test.policy.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.policy.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.policy.GetKeepBuilds() != test.want.GetKeepBuilds() {
+			t.Errorf("SetKeepBuilds is %v, want %v", test.policy.GetKeepBuilds(), test.want.GetKeepBuilds())
+		}
+
+		if test.policy.GetLogDays() != test.want.GetLogDays() {
+			t.Errorf("SetLogDays is %v, want %v", test.policy.GetLogDays(), test.want.GetLogDays())
+		}
+
+		if test.policy.GetHookDays() != test.want.GetHookDays() {
+			t.Errorf("SetHookDays is %v, want %v", test.policy.GetHookDays(), test.want.GetHookDays())
+		}
+
+		if test.policy.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.policy.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.policy.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.policy.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestRetentionPolicy_String(t *testing.T) {
+	// setup types
+	p := testRetentionPolicy()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  KeepBuilds: %d,
+  LogDays: %d,
+  HookDays: %d,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		p.GetID(),
+		p.GetRepoID(),
+		p.GetKeepBuilds(),
+		p.GetLogDays(),
+		p.GetHookDays(),
+		p.GetUpdatedAt(),
+		p.GetUpdatedBy(),
+	)
+
+	// run test
+	got := p.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRetentionPolicy is a test helper function to create a RetentionPolicy
+// type with all fields set to a fake value.
+func testRetentionPolicy() *RetentionPolicy {
+	p := new(RetentionPolicy)
+
+	p.SetID(1)
+	p.SetRepoID(1)
+	p.SetKeepBuilds(100)
+	p.SetLogDays(30)
+	p.SetHookDays(90)
+	p.SetUpdatedAt(1563474077)
+	p.SetUpdatedBy("octocat")
+
+	return p
+}
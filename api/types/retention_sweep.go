@@ -0,0 +1,257 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// RetentionSweep is the API representation of the result of
+// enforcing the retention policies with the number of repos
+// swept and the builds, logs and hooks that were deleted along
+// with the archived repos and secrets that were purged.
+//
+// swagger:model RetentionSweep
+type RetentionSweep struct {
+	Repos           *int64 `json:"repos,omitempty"`
+	Builds          *int64 `json:"builds,omitempty"`
+	Logs            *int64 `json:"logs,omitempty"`
+	Hooks           *int64 `json:"hooks,omitempty"`
+	ArchivedRepos   *int64 `json:"archived_repos,omitempty"`
+	ArchivedSecrets *int64 `json:"archived_secrets,omitempty"`
+	Started         *int64 `json:"started,omitempty"`
+	Finished        *int64 `json:"finished,omitempty"`
+}
+
+// GetRepos returns the Repos field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetRepos() int64 {
+	// return zero value if RetentionSweep type or Repos field is nil
+	if s == nil || s.Repos == nil {
+		return 0
+	}
+
+	return *s.Repos
+}
+
+// GetBuilds returns the Builds field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetBuilds() int64 {
+	// return zero value if RetentionSweep type or Builds field is nil
+	if s == nil || s.Builds == nil {
+		return 0
+	}
+
+	return *s.Builds
+}
+
+// GetLogs returns the Logs field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetLogs() int64 {
+	// return zero value if RetentionSweep type or Logs field is nil
+	if s == nil || s.Logs == nil {
+		return 0
+	}
+
+	return *s.Logs
+}
+
+// GetHooks returns the Hooks field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetHooks() int64 {
+	// return zero value if RetentionSweep type or Hooks field is nil
+	if s == nil || s.Hooks == nil {
+		return 0
+	}
+
+	return *s.Hooks
+}
+
+// GetArchivedRepos returns the ArchivedRepos field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetArchivedRepos() int64 {
+	// return zero value if RetentionSweep type or ArchivedRepos field is nil
+	if s == nil || s.ArchivedRepos == nil {
+		return 0
+	}
+
+	return *s.ArchivedRepos
+}
+
+// GetArchivedSecrets returns the ArchivedSecrets field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetArchivedSecrets() int64 {
+	// return zero value if RetentionSweep type or ArchivedSecrets field is nil
+	if s == nil || s.ArchivedSecrets == nil {
+		return 0
+	}
+
+	return *s.ArchivedSecrets
+}
+
+// GetStarted returns the Started field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetStarted() int64 {
+	// return zero value if RetentionSweep type or Started field is nil
+	if s == nil || s.Started == nil {
+		return 0
+	}
+
+	return *s.Started
+}
+
+// GetFinished returns the Finished field.
+//
+// When the provided RetentionSweep type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *RetentionSweep) GetFinished() int64 {
+	// return zero value if RetentionSweep type or Finished field is nil
+	if s == nil || s.Finished == nil {
+		return 0
+	}
+
+	return *s.Finished
+}
+
+// SetRepos sets the Repos field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetRepos(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.Repos = &v
+}
+
+// SetBuilds sets the Builds field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetBuilds(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.Builds = &v
+}
+
+// SetLogs sets the Logs field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetLogs(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.Logs = &v
+}
+
+// SetHooks sets the Hooks field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetHooks(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.Hooks = &v
+}
+
+// SetArchivedRepos sets the ArchivedRepos field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetArchivedRepos(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.ArchivedRepos = &v
+}
+
+// SetArchivedSecrets sets the ArchivedSecrets field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetArchivedSecrets(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.ArchivedSecrets = &v
+}
+
+// SetStarted sets the Started field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetStarted(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.Started = &v
+}
+
+// SetFinished sets the Finished field.
+//
+// When the provided RetentionSweep type is nil, it
+// will set nothing and immediately return.
+func (s *RetentionSweep) SetFinished(v int64) {
+	// return if RetentionSweep type is nil
+	if s == nil {
+		return
+	}
+
+	s.Finished = &v
+}
+
+// String implements the Stringer interface for the RetentionSweep type.
+func (s *RetentionSweep) String() string {
+	return fmt.Sprintf(`{
+  Repos: %d,
+  Builds: %d,
+  Logs: %d,
+  Hooks: %d,
+  ArchivedRepos: %d,
+  ArchivedSecrets: %d,
+  Started: %d,
+  Finished: %d,
+}`,
+		s.GetRepos(),
+		s.GetBuilds(),
+		s.GetLogs(),
+		s.GetHooks(),
+		s.GetArchivedRepos(),
+		s.GetArchivedSecrets(),
+		s.GetStarted(),
+		s.GetFinished(),
+	)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRetentionSweep_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		sweep *RetentionSweep
+		want  *RetentionSweep
+	}{
+		{
+			sweep: testRetentionSweep(),
+			want:  testRetentionSweep(),
+		},
+		{
+			sweep: new(RetentionSweep),
+			want:  new(RetentionSweep),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.sweep.GetRepos() != test.want.GetRepos() {
+			t.Errorf("GetRepos is %v, want %v", test.sweep.GetRepos(), test.want.GetRepos())
+		}
+
+		if test.sweep.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("GetBuilds is %v, want %v", test.sweep.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.sweep.GetLogs() != test.want.GetLogs() {
+			t.Errorf("GetLogs is %v, want %v", test.sweep.GetLogs(), test.want.GetLogs())
+		}
+
+		if test.sweep.GetHooks() != test.want.GetHooks() {
+			t.Errorf("GetHooks is %v, want %v", test.sweep.GetHooks(), test.want.GetHooks())
+		}
+
+		if test.sweep.GetArchivedRepos() != test.want.GetArchivedRepos() {
+			t.Errorf("GetArchivedRepos is %v, want %v", test.sweep.GetArchivedRepos(), test.want.GetArchivedRepos())
+		}
+
+		if test.sweep.GetArchivedSecrets() != test.want.GetArchivedSecrets() {
+			t.Errorf("GetArchivedSecrets is %v, want %v", test.sweep.GetArchivedSecrets(), test.want.GetArchivedSecrets())
+		}
+
+		if test.sweep.GetStarted() != test.want.GetStarted() {
+			t.Errorf("GetStarted is %v, want %v", test.sweep.GetStarted(), test.want.GetStarted())
+		}
+
+		if test.sweep.GetFinished() != test.want.GetFinished() {
+			t.Errorf("GetFinished is %v, want %v", test.sweep.GetFinished(), test.want.GetFinished())
+		}
+	}
+}
+
+func TestRetentionSweep_Setters(t *testing.T) {
+	// setup types
+	var s *RetentionSweep
+
+	// setup tests
+	tests := []struct {
+		sweep *RetentionSweep
+		want  *RetentionSweep
+	}{
+		{
+			sweep: testRetentionSweep(),
+			want:  testRetentionSweep(),
+		},
+		{
+			sweep: s,
+			want:  new(RetentionSweep),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.sweep.SetRepos(test.want.GetRepos())
+		test.sweep.SetBuilds(test.want.GetBuilds())
+		test.sweep.SetLogs(test.want.GetLogs())
+		test.sweep.SetHooks(test.want.GetHooks())
+		test.sweep.SetArchivedRepos(test.want.GetArchivedRepos())
+		test.sweep.SetArchivedSecrets(test.want.GetArchivedSecrets())
+		test.sweep.SetStarted(test.want.GetStarted())
+		test.sweep.SetFinished(test.want.GetFinished())
+
+		if test.sweep.GetRepos() != test.want.GetRepos() {
+			t.Errorf("SetRepos is %v, want %v", test.sweep.GetRepos(), test.want.GetRepos())
+		}
+
+		if test.sweep.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("SetBuilds is %v, want %v", test.sweep.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.sweep.GetLogs() != test.want.GetLogs() {
+			t.Errorf("SetLogs is %v, want %v", test.sweep.GetLogs(), test.want.GetLogs())
+		}
+
+		if test.sweep.GetHooks() != test.want.GetHooks() {
+			t.Errorf("SetHooks is %v, want %v", test.sweep.GetHooks(), test.want.GetHooks())
+		}
+
+		if test.sweep.GetArchivedRepos() != test.want.GetArchivedRepos() {
+			t.Errorf("SetArchivedRepos is %v, want %v", test.sweep.GetArchivedRepos(), test.want.GetArchivedRepos())
+		}
+
+		if test.sweep.GetArchivedSecrets() != test.want.GetArchivedSecrets() {
+			t.Errorf("SetArchivedSecrets is %v, want %v", test.sweep.GetArchivedSecrets(), test.want.GetArchivedSecrets())
+		}
+
+		if test.sweep.GetStarted() != test.want.GetStarted() {
+			t.Errorf("SetStarted is %v, want %v", test.sweep.GetStarted(), test.want.GetStarted())
+		}
+
+		if test.sweep.GetFinished() != test.want.GetFinished() {
+			t.Errorf("SetFinished is %v, want %v", test.sweep.GetFinished(), test.want.GetFinished())
+		}
+	}
+}
+
+func TestRetentionSweep_String(t *testing.T) {
+	// setup types
+	s := testRetentionSweep()
+
+	want := fmt.Sprintf(`{
+  Repos: %d,
+  Builds: %d,
+  Logs: %d,
+  Hooks: %d,
+  ArchivedRepos: %d,
+  ArchivedSecrets: %d,
+  Started: %d,
+  Finished: %d,
+}`,
+		s.GetRepos(),
+		s.GetBuilds(),
+		s.GetLogs(),
+		s.GetHooks(),
+		s.GetArchivedRepos(),
+		s.GetArchivedSecrets(),
+		s.GetStarted(),
+		s.GetFinished(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testRetentionSweep is a test helper function to create a RetentionSweep
+// type with all fields set to a fake value.
+func testRetentionSweep() *RetentionSweep {
+	s := new(RetentionSweep)
+
+	s.SetRepos(2)
+	s.SetBuilds(10)
+	s.SetLogs(25)
+	s.SetHooks(12)
+	s.SetArchivedRepos(1)
+	s.SetArchivedSecrets(3)
+	s.SetStarted(1563474077)
+	s.SetFinished(1563474078)
+
+	return s
+}
@@ -0,0 +1,371 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// Schedule is the API representation of a cron schedule that
+// triggers builds for the branch of a repo in a time zone.
+//
+// swagger:model Schedule
+type Schedule struct {
+	ID          *int64  `json:"id,omitempty"`
+	RepoID      *int64  `json:"repo_id,omitempty"`
+	Active      *bool   `json:"active,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Entry       *string `json:"entry,omitempty"`
+	TimeZone    *string `json:"time_zone,omitempty"`
+	Branch      *string `json:"branch,omitempty"`
+	CreatedAt   *int64  `json:"created_at,omitempty"`
+	CreatedBy   *string `json:"created_by,omitempty"`
+	UpdatedAt   *int64  `json:"updated_at,omitempty"`
+	UpdatedBy   *string `json:"updated_by,omitempty"`
+	ScheduledAt *int64  `json:"scheduled_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetID() int64 {
+	// return zero value if Schedule type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetRepoID() int64 {
+	// return zero value if Schedule type or RepoID field is nil
+	if s == nil || s.RepoID == nil {
+		return 0
+	}
+
+	return *s.RepoID
+}
+
+// GetActive returns the Active field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetActive() bool {
+	// return zero value if Schedule type or Active field is nil
+	if s == nil || s.Active == nil {
+		return false
+	}
+
+	return *s.Active
+}
+
+// GetName returns the Name field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetName() string {
+	// return zero value if Schedule type or Name field is nil
+	if s == nil || s.Name == nil {
+		return ""
+	}
+
+	return *s.Name
+}
+
+// GetEntry returns the Entry field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetEntry() string {
+	// return zero value if Schedule type or Entry field is nil
+	if s == nil || s.Entry == nil {
+		return ""
+	}
+
+	return *s.Entry
+}
+
+// GetTimeZone returns the TimeZone field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetTimeZone() string {
+	// return zero value if Schedule type or TimeZone field is nil
+	if s == nil || s.TimeZone == nil {
+		return ""
+	}
+
+	return *s.TimeZone
+}
+
+// GetBranch returns the Branch field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetBranch() string {
+	// return zero value if Schedule type or Branch field is nil
+	if s == nil || s.Branch == nil {
+		return ""
+	}
+
+	return *s.Branch
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetCreatedAt() int64 {
+	// return zero value if Schedule type or CreatedAt field is nil
+	if s == nil || s.CreatedAt == nil {
+		return 0
+	}
+
+	return *s.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetCreatedBy() string {
+	// return zero value if Schedule type or CreatedBy field is nil
+	if s == nil || s.CreatedBy == nil {
+		return ""
+	}
+
+	return *s.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetUpdatedAt() int64 {
+	// return zero value if Schedule type or UpdatedAt field is nil
+	if s == nil || s.UpdatedAt == nil {
+		return 0
+	}
+
+	return *s.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetUpdatedBy() string {
+	// return zero value if Schedule type or UpdatedBy field is nil
+	if s == nil || s.UpdatedBy == nil {
+		return ""
+	}
+
+	return *s.UpdatedBy
+}
+
+// GetScheduledAt returns the ScheduledAt field.
+//
+// When the provided Schedule type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *Schedule) GetScheduledAt() int64 {
+	// return zero value if Schedule type or ScheduledAt field is nil
+	if s == nil || s.ScheduledAt == nil {
+		return 0
+	}
+
+	return *s.ScheduledAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetID(v int64) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetRepoID(v int64) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.RepoID = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetActive(v bool) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.Active = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetName(v string) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.Name = &v
+}
+
+// SetEntry sets the Entry field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetEntry(v string) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.Entry = &v
+}
+
+// SetTimeZone sets the TimeZone field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetTimeZone(v string) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.TimeZone = &v
+}
+
+// SetBranch sets the Branch field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetBranch(v string) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.Branch = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetCreatedAt(v int64) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetCreatedBy(v string) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetUpdatedAt(v int64) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetUpdatedBy(v string) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.UpdatedBy = &v
+}
+
+// SetScheduledAt sets the ScheduledAt field.
+//
+// When the provided Schedule type is nil, it
+// will set nothing and immediately return.
+func (s *Schedule) SetScheduledAt(v int64) {
+	// return if Schedule type is nil
+	if s == nil {
+		return
+	}
+
+	s.ScheduledAt = &v
+}
+
+// String implements the Stringer interface for the Schedule type.
+func (s *Schedule) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Active: %t,
+  Name: %s,
+  Entry: %s,
+  TimeZone: %s,
+  Branch: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+  ScheduledAt: %d,
+}`,
+		s.GetID(),
+		s.GetRepoID(),
+		s.GetActive(),
+		s.GetName(),
+		s.GetEntry(),
+		s.GetTimeZone(),
+		s.GetBranch(),
+		s.GetCreatedAt(),
+		s.GetCreatedBy(),
+		s.GetUpdatedAt(),
+		s.GetUpdatedBy(),
+		s.GetScheduledAt(),
+	)
+}
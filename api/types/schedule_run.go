@@ -0,0 +1,268 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ScheduleRunTriggered represents a scheduled run that triggered a build.
+	ScheduleRunTriggered = "triggered"
+
+	// ScheduleRunSkipped represents a scheduled run that was skipped
+	// because it fell within a blackout window for the repo.
+	ScheduleRunSkipped = "skipped"
+
+	// ScheduleRunFailed represents a scheduled run that
+	// was unable to trigger a build.
+	ScheduleRunFailed = "failed"
+)
+
+// ScheduleRun is the API representation of a time a schedule came
+// due, recording whether a build was triggered or the run was skipped.
+//
+// swagger:model ScheduleRun
+type ScheduleRun struct {
+	ID           *int64  `json:"id,omitempty"`
+	ScheduleID   *int64  `json:"schedule_id,omitempty"`
+	RepoID       *int64  `json:"repo_id,omitempty"`
+	ScheduledFor *int64  `json:"scheduled_for,omitempty"`
+	Status       *string `json:"status,omitempty"`
+	Reason       *string `json:"reason,omitempty"`
+	BuildNumber  *int64  `json:"build_number,omitempty"`
+	CreatedAt    *int64  `json:"created_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetID() int64 {
+	// return zero value if ScheduleRun type or ID field is nil
+	if r == nil || r.ID == nil {
+		return 0
+	}
+
+	return *r.ID
+}
+
+// GetScheduleID returns the ScheduleID field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetScheduleID() int64 {
+	// return zero value if ScheduleRun type or ScheduleID field is nil
+	if r == nil || r.ScheduleID == nil {
+		return 0
+	}
+
+	return *r.ScheduleID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetRepoID() int64 {
+	// return zero value if ScheduleRun type or RepoID field is nil
+	if r == nil || r.RepoID == nil {
+		return 0
+	}
+
+	return *r.RepoID
+}
+
+// GetScheduledFor returns the ScheduledFor field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetScheduledFor() int64 {
+	// return zero value if ScheduleRun type or ScheduledFor field is nil
+	if r == nil || r.ScheduledFor == nil {
+		return 0
+	}
+
+	return *r.ScheduledFor
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetStatus() string {
+	// return zero value if ScheduleRun type or Status field is nil
+	if r == nil || r.Status == nil {
+		return ""
+	}
+
+	return *r.Status
+}
+
+// GetReason returns the Reason field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetReason() string {
+	// return zero value if ScheduleRun type or Reason field is nil
+	if r == nil || r.Reason == nil {
+		return ""
+	}
+
+	return *r.Reason
+}
+
+// GetBuildNumber returns the BuildNumber field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetBuildNumber() int64 {
+	// return zero value if ScheduleRun type or BuildNumber field is nil
+	if r == nil || r.BuildNumber == nil {
+		return 0
+	}
+
+	return *r.BuildNumber
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided ScheduleRun type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *ScheduleRun) GetCreatedAt() int64 {
+	// return zero value if ScheduleRun type or CreatedAt field is nil
+	if r == nil || r.CreatedAt == nil {
+		return 0
+	}
+
+	return *r.CreatedAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetID(v int64) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.ID = &v
+}
+
+// SetScheduleID sets the ScheduleID field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetScheduleID(v int64) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.ScheduleID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetRepoID(v int64) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.RepoID = &v
+}
+
+// SetScheduledFor sets the ScheduledFor field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetScheduledFor(v int64) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.ScheduledFor = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetStatus(v string) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.Status = &v
+}
+
+// SetReason sets the Reason field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetReason(v string) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.Reason = &v
+}
+
+// SetBuildNumber sets the BuildNumber field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetBuildNumber(v int64) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.BuildNumber = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided ScheduleRun type is nil, it
+// will set nothing and immediately return.
+func (r *ScheduleRun) SetCreatedAt(v int64) {
+	// return if ScheduleRun type is nil
+	if r == nil {
+		return
+	}
+
+	r.CreatedAt = &v
+}
+
+// String implements the Stringer interface for the ScheduleRun type.
+func (r *ScheduleRun) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  ScheduleID: %d,
+  RepoID: %d,
+  ScheduledFor: %d,
+  Status: %s,
+  Reason: %s,
+  BuildNumber: %d,
+  CreatedAt: %d,
+}`,
+		r.GetID(),
+		r.GetScheduleID(),
+		r.GetRepoID(),
+		r.GetScheduledFor(),
+		r.GetStatus(),
+		r.GetReason(),
+		r.GetBuildNumber(),
+		r.GetCreatedAt(),
+	)
+}
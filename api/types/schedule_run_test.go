@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestScheduleRun_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		run  *ScheduleRun
+		want *ScheduleRun
+	}{
+		{
+			run:  testScheduleRun(),
+			want: testScheduleRun(),
+		},
+		{
+			run:  new(ScheduleRun),
+			want: new(ScheduleRun),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.run.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.run.GetID(), test.want.GetID())
+		}
+
+		if test.run.GetScheduleID() != test.want.GetScheduleID() {
+			t.Errorf("GetScheduleID is %v, want %v", test.run.GetScheduleID(), test.want.GetScheduleID())
+		}
+
+		if test.run.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.run.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.run.GetScheduledFor() != test.want.GetScheduledFor() {
+			t.Errorf("GetScheduledFor is %v, want %v", test.run.GetScheduledFor(), test.want.GetScheduledFor())
+		}
+
+		if test.run.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.run.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.run.GetReason() != test.want.GetReason() {
+			t.Errorf("GetReason is %v, want %v", test.run.GetReason(), test.want.GetReason())
+		}
+
+		if test.run.GetBuildNumber() != test.want.GetBuildNumber() {
+			t.Errorf("GetBuildNumber is %v, want %v", test.run.GetBuildNumber(), test.want.GetBuildNumber())
+		}
+
+		if test.run.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.run.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestScheduleRun_Setters(t *testing.T) {
+	// setup types
+	var r *ScheduleRun
+
+	// setup tests
+	tests := []struct {
+		run  *ScheduleRun
+		want *ScheduleRun
+	}{
+		{
+			run:  testScheduleRun(),
+			want: testScheduleRun(),
+		},
+		{
+			run:  r,
+			want: new(ScheduleRun),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.run.SetID(test.want.GetID())
+		test.run.SetScheduleID(test.want.GetScheduleID())
+		test.run.SetRepoID(test.want.GetRepoID())
+		test.run.SetScheduledFor(test.want.GetScheduledFor())
+		test.run.SetStatus(test.want.GetStatus())
+		test.run.SetReason(test.want.GetReason())
+		test.run.SetBuildNumber(test.want.GetBuildNumber())
+		test.run.SetCreatedAt(test.want.GetCreatedAt())
+
+		if test.run.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.run.GetID(), test.want.GetID())
+		}
+
+		if test.run.GetScheduleID() != test.want.GetScheduleID() {
+			t.Errorf("SetScheduleID is %v, want %v", test.run.GetScheduleID(), test.want.GetScheduleID())
+		}
+
+		if test.run.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.run.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.run.GetScheduledFor() != test.want.GetScheduledFor() {
+			t.Errorf("SetScheduledFor is %v, want %v", test.run.GetScheduledFor(), test.want.GetScheduledFor())
+		}
+
+		if test.run.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.run.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.run.GetReason() != test.want.GetReason() {
+			t.Errorf("SetReason is %v, want %v", test.run.GetReason(), test.want.GetReason())
+		}
+
+		if test.run.GetBuildNumber() != test.want.GetBuildNumber() {
+			t.Errorf("SetBuildNumber is %v, want %v", test.run.GetBuildNumber(), test.want.GetBuildNumber())
+		}
+
+		if test.run.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.run.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestScheduleRun_String(t *testing.T) {
+	// setup types
+	r := testScheduleRun()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  ScheduleID: %d,
+  RepoID: %d,
+  ScheduledFor: %d,
+  Status: %s,
+  Reason: %s,
+  BuildNumber: %d,
+  CreatedAt: %d,
+}`,
+		r.GetID(),
+		r.GetScheduleID(),
+		r.GetRepoID(),
+		r.GetScheduledFor(),
+		r.GetStatus(),
+		r.GetReason(),
+		r.GetBuildNumber(),
+		r.GetCreatedAt(),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testScheduleRun is a test helper function to create a ScheduleRun
+// type with all fields set to a fake value.
+func testScheduleRun() *ScheduleRun {
+	r := new(ScheduleRun)
+
+	r.SetID(1)
+	r.SetScheduleID(1)
+	r.SetRepoID(1)
+	r.SetScheduledFor(1563474000)
+	r.SetStatus(ScheduleRunSkipped)
+	r.SetReason("blackout window is active for github/octocat")
+	r.SetBuildNumber(0)
+	r.SetCreatedAt(1563474077)
+
+	return r
+}
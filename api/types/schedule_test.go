@@ -0,0 +1,224 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSchedule_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		schedule *Schedule
+		want     *Schedule
+	}{
+		{
+			schedule: testSchedule(),
+			want:     testSchedule(),
+		},
+		{
+			schedule: new(Schedule),
+			want:     new(Schedule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.schedule.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.schedule.GetID(), test.want.GetID())
+		}
+
+		if test.schedule.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.schedule.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.schedule.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.schedule.GetActive(), test.want.GetActive())
+		}
+
+		if test.schedule.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.schedule.GetName(), test.want.GetName())
+		}
+
+		if test.schedule.GetEntry() != test.want.GetEntry() {
+			t.Errorf("GetEntry is %v, want %v", test.schedule.GetEntry(), test.want.GetEntry())
+		}
+
+		if test.schedule.GetTimeZone() != test.want.GetTimeZone() {
+			t.Errorf("GetTimeZone is %v, want %v", test.schedule.GetTimeZone(), test.want.GetTimeZone())
+		}
+
+		if test.schedule.GetBranch() != test.want.GetBranch() {
+			t.Errorf("GetBranch is %v, want %v", test.schedule.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.schedule.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.schedule.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.schedule.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.schedule.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.schedule.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.schedule.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.schedule.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.schedule.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+
+		if test.schedule.GetScheduledAt() != test.want.GetScheduledAt() {
+			t.Errorf("GetScheduledAt is %v, want %v", test.schedule.GetScheduledAt(), test.want.GetScheduledAt())
+		}
+	}
+}
+
+func TestSchedule_Setters(t *testing.T) {
+	// setup types
+	var s *Schedule
+
+	// setup tests
+	tests := []struct {
+		schedule *Schedule
+		want     *Schedule
+	}{
+		{
+			schedule: testSchedule(),
+			want:     testSchedule(),
+		},
+		{
+			schedule: s,
+			want:     new(Schedule),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.schedule.SetID(test.want.GetID())
+		test.schedule.SetRepoID(test.want.GetRepoID())
+		test.schedule.SetActive(test.want.GetActive())
+		test.schedule.SetName(test.want.GetName())
+		test.schedule.SetEntry(test.want.GetEntry())
+		test.schedule.SetTimeZone(test.want.GetTimeZone())
+		test.schedule.SetBranch(test.want.GetBranch())
+		test.schedule.SetCreatedAt(test.want.GetCreatedAt())
+		test.schedule.SetCreatedBy(test.want.GetCreatedBy())
+		test.schedule.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.schedule.SetUpdatedBy(test.want.GetUpdatedBy())
+		test.schedule.SetScheduledAt(test.want.GetScheduledAt())
+
+		if test.schedule.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.schedule.GetID(), test.want.GetID())
+		}
+
+		if test.schedule.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.schedule.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.schedule.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.schedule.GetActive(), test.want.GetActive())
+		}
+
+		if test.schedule.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.schedule.GetName(), test.want.GetName())
+		}
+
+		if test.schedule.GetEntry() != test.want.GetEntry() {
+			t.Errorf("SetEntry is %v, want %v", test.schedule.GetEntry(), test.want.GetEntry())
+		}
+
+		if test.schedule.GetTimeZone() != test.want.GetTimeZone() {
+			t.Errorf("SetTimeZone is %v, want %v", test.schedule.GetTimeZone(), test.want.GetTimeZone())
+		}
+
+		if test.schedule.GetBranch() != test.want.GetBranch() {
+			t.Errorf("SetBranch is %v, want %v", test.schedule.GetBranch(), test.want.GetBranch())
+		}
+
+		if test.schedule.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.schedule.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.schedule.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.schedule.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.schedule.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.schedule.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.schedule.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.schedule.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+
+		if test.schedule.GetScheduledAt() != test.want.GetScheduledAt() {
+			t.Errorf("SetScheduledAt is %v, want %v", test.schedule.GetScheduledAt(), test.want.GetScheduledAt())
+		}
+	}
+}
+
+func TestSchedule_String(t *testing.T) {
+	// setup types
+	s := testSchedule()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  RepoID: %d,
+  Active: %t,
+  Name: %s,
+  Entry: %s,
+  TimeZone: %s,
+  Branch: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+  ScheduledAt: %d,
+}`,
+		s.GetID(),
+		s.GetRepoID(),
+		s.GetActive(),
+		s.GetName(),
+		s.GetEntry(),
+		s.GetTimeZone(),
+		s.GetBranch(),
+		s.GetCreatedAt(),
+		s.GetCreatedBy(),
+		s.GetUpdatedAt(),
+		s.GetUpdatedBy(),
+		s.GetScheduledAt(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testSchedule is a test helper function to create a Schedule
+// type with all fields set to a fake value.
+func testSchedule() *Schedule {
+	s := new(Schedule)
+
+	s.SetID(1)
+	s.SetRepoID(1)
+	s.SetActive(true)
+	s.SetName("nightly")
+	s.SetEntry("0 2 * * *")
+	s.SetTimeZone("America/Chicago")
+	s.SetBranch("main")
+	s.SetCreatedAt(1563474077)
+	s.SetCreatedBy("octocat")
+	s.SetUpdatedAt(1563474078)
+	s.SetUpdatedBy("octocat")
+	s.SetScheduledAt(1563474079)
+
+	return s
+}
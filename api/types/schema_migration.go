@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// SchemaMigration is the API representation of a versioned
+// schema migration for the database and when it was
+// applied. A migration that wasn't applied yet has
+// no applied timestamp.
+//
+// swagger:model SchemaMigration
+type SchemaMigration struct {
+	Version *int64  `json:"version,omitempty"`
+	Name    *string `json:"name,omitempty"`
+	Applied *int64  `json:"applied,omitempty"`
+}
+
+// GetVersion returns the Version field.
+//
+// When the provided SchemaMigration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *SchemaMigration) GetVersion() int64 {
+	// return zero value if SchemaMigration type or Version field is nil
+	if m == nil || m.Version == nil {
+		return 0
+	}
+
+	return *m.Version
+}
+
+// GetName returns the Name field.
+//
+// When the provided SchemaMigration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *SchemaMigration) GetName() string {
+	// return zero value if SchemaMigration type or Name field is nil
+	if m == nil || m.Name == nil {
+		return ""
+	}
+
+	return *m.Name
+}
+
+// GetApplied returns the Applied field.
+//
+// When the provided SchemaMigration type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (m *SchemaMigration) GetApplied() int64 {
+	// return zero value if SchemaMigration type or Applied field is nil
+	if m == nil || m.Applied == nil {
+		return 0
+	}
+
+	return *m.Applied
+}
+
+// SetVersion sets the Version field.
+//
+// When the provided SchemaMigration type is nil, it
+// will set nothing and immediately return.
+func (m *SchemaMigration) SetVersion(v int64) {
+	// return if SchemaMigration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Version = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided SchemaMigration type is nil, it
+// will set nothing and immediately return.
+func (m *SchemaMigration) SetName(v string) {
+	// return if SchemaMigration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Name = &v
+}
+
+// SetApplied sets the Applied field.
+//
+// When the provided SchemaMigration type is nil, it
+// will set nothing and immediately return.
+func (m *SchemaMigration) SetApplied(v int64) {
+	// return if SchemaMigration type is nil
+	if m == nil {
+		return
+	}
+
+	m.Applied = &v
+}
+
+// String implements the Stringer interface for the SchemaMigration type.
+func (m *SchemaMigration) String() string {
+	return fmt.Sprintf(`{
+  Version: %d,
+  Name: %s,
+  Applied: %d,
+}`,
+		m.GetVersion(),
+		m.GetName(),
+		m.GetApplied(),
+	)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestSchemaMigration_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		migration *SchemaMigration
+		want      *SchemaMigration
+	}{
+		{
+			migration: testSchemaMigration(),
+			want:      testSchemaMigration(),
+		},
+		{
+			migration: new(SchemaMigration),
+			want:      new(SchemaMigration),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.migration.GetVersion() != test.want.GetVersion() {
+			t.Errorf("GetVersion is %v, want %v", test.migration.GetVersion(), test.want.GetVersion())
+		}
+
+		if test.migration.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.migration.GetName(), test.want.GetName())
+		}
+
+		if test.migration.GetApplied() != test.want.GetApplied() {
+			t.Errorf("GetApplied is %v, want %v", test.migration.GetApplied(), test.want.GetApplied())
+		}
+	}
+}
+
+func TestSchemaMigration_Setters(t *testing.T) {
+	// setup types
+	var m *SchemaMigration
+
+	// setup tests
+	tests := []struct {
+		migration *SchemaMigration
+		want      *SchemaMigration
+	}{
+		{
+			migration: testSchemaMigration(),
+			want:      testSchemaMigration(),
+		},
+		{
+			migration: m,
+			want:      new(SchemaMigration),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.migration.SetVersion(test.want.GetVersion())
+		test.migration.SetName(test.want.GetName())
+		test.migration.SetApplied(test.want.GetApplied())
+
+		if test.migration.GetVersion() != test.want.GetVersion() {
+			t.Errorf("SetVersion is %v, want %v", test.migration.GetVersion(), test.want.GetVersion())
+		}
+
+		if test.migration.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.migration.GetName(), test.want.GetName())
+		}
+
+		if test.migration.GetApplied() != test.want.GetApplied() {
+			t.Errorf("SetApplied is %v, want %v", test.migration.GetApplied(), test.want.GetApplied())
+		}
+	}
+}
+
+func TestSchemaMigration_String(t *testing.T) {
+	// setup types
+	m := testSchemaMigration()
+
+	want := fmt.Sprintf(`{
+  Version: %d,
+  Name: %s,
+  Applied: %d,
+}`,
+		m.GetVersion(),
+		m.GetName(),
+		m.GetApplied(),
+	)
+
+	// run test
+	got := m.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testSchemaMigration is a test helper function to create a SchemaMigration
+// type with all fields set to a fake value.
+func testSchemaMigration() *SchemaMigration {
+	m := new(SchemaMigration)
+
+	m.SetVersion(1)
+	m.SetName("baseline")
+	m.SetApplied(1563474076)
+
+	return m
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/library"
+)
+
+// SearchResult is the API representation of a build matching
+// a search query along with the log lines matching the query
+// when searching within the stored logs for the build.
+//
+// swagger:model SearchResult
+type SearchResult struct {
+	Repo  *string        `json:"repo,omitempty"`
+	Build *library.Build `json:"build,omitempty"`
+	Lines *[]*LogLine    `json:"lines,omitempty"`
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided SearchResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *SearchResult) GetRepo() string {
+	// return zero value if SearchResult type or Repo field is nil
+	if r == nil || r.Repo == nil {
+		return ""
+	}
+
+	return *r.Repo
+}
+
+// GetBuild returns the Build field.
+//
+// When the provided SearchResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *SearchResult) GetBuild() *library.Build {
+	// return zero value if SearchResult type or Build field is nil
+	if r == nil || r.Build == nil {
+		return new(library.Build)
+	}
+
+	return r.Build
+}
+
+// GetLines returns the Lines field.
+//
+// When the provided SearchResult type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (r *SearchResult) GetLines() []*LogLine {
+	// return zero value if SearchResult type or Lines field is nil
+	if r == nil || r.Lines == nil {
+		return []*LogLine{}
+	}
+
+	return *r.Lines
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided SearchResult type is nil, it
+// will set nothing and immediately return.
+func (r *SearchResult) SetRepo(v string) {
+	// return if SearchResult type is nil
+	if r == nil {
+		return
+	}
+
+	r.Repo = &v
+}
+
+// SetBuild sets the Build field.
+//
+// When the provided SearchResult type is nil, it
+// will set nothing and immediately return.
+func (r *SearchResult) SetBuild(v *library.Build) {
+	// return if SearchResult type is nil
+	if r == nil {
+		return
+	}
+
+	r.Build = v
+}
+
+// SetLines sets the Lines field.
+//
+// When the provided SearchResult type is nil, it
+// will set nothing and immediately return.
+func (r *SearchResult) SetLines(v []*LogLine) {
+	// return if SearchResult type is nil
+	if r == nil {
+		return
+	}
+
+	r.Lines = &v
+}
+
+// String implements the Stringer interface for the SearchResult type.
+func (r *SearchResult) String() string {
+	return fmt.Sprintf(`{
+  Repo: %s,
+  Build: %d,
+  Lines: %d,
+}`,
+		r.GetRepo(),
+		r.GetBuild().GetNumber(),
+		len(r.GetLines()),
+	)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestSearchResult_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		result *SearchResult
+		want   *SearchResult
+	}{
+		{
+			result: testSearchResult(),
+			want:   testSearchResult(),
+		},
+		{
+			result: new(SearchResult),
+			want:   new(SearchResult),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.result.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.result.GetRepo(), test.want.GetRepo())
+		}
+
+		if !reflect.DeepEqual(test.result.GetBuild(), test.want.GetBuild()) {
+			t.Errorf("GetBuild is %v, want %v", test.result.GetBuild(), test.want.GetBuild())
+		}
+
+		if !reflect.DeepEqual(test.result.GetLines(), test.want.GetLines()) {
+			t.Errorf("GetLines is %v, want %v", test.result.GetLines(), test.want.GetLines())
+		}
+	}
+}
+
+func TestSearchResult_Setters(t *testing.T) {
+	// setup types
+	var r *SearchResult
+
+	// setup tests
+	tests := []struct {
+		result *SearchResult
+		want   *SearchResult
+	}{
+		{
+			result: testSearchResult(),
+			want:   testSearchResult(),
+		},
+		{
+			result: r,
+			want:   new(SearchResult),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.result.SetRepo(test.want.GetRepo())
+		test.result.SetBuild(test.want.GetBuild())
+		test.result.SetLines(test.want.GetLines())
+
+		if test.result.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.result.GetRepo(), test.want.GetRepo())
+		}
+
+		if !reflect.DeepEqual(test.result.GetBuild(), test.want.GetBuild()) {
+			t.Errorf("SetBuild is %v, want %v", test.result.GetBuild(), test.want.GetBuild())
+		}
+
+		if !reflect.DeepEqual(test.result.GetLines(), test.want.GetLines()) {
+			t.Errorf("SetLines is %v, want %v", test.result.GetLines(), test.want.GetLines())
+		}
+	}
+}
+
+func TestSearchResult_String(t *testing.T) {
+	// setup types
+	r := testSearchResult()
+
+	want := fmt.Sprintf(`{
+  Repo: %s,
+  Build: %d,
+  Lines: %d,
+}`,
+		r.GetRepo(),
+		r.GetBuild().GetNumber(),
+		len(r.GetLines()),
+	)
+
+	// run test
+	got := r.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testSearchResult is a test helper function to create a
+// SearchResult type with all fields set to a fake value.
+func testSearchResult() *SearchResult {
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetNumber(1)
+	b.SetMessage("fix the flaky test")
+
+	r := new(SearchResult)
+
+	r.SetRepo("github/octocat")
+	r.SetBuild(b)
+	r.SetLines([]*LogLine{testLogLine()})
+
+	return r
+}
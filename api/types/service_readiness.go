@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// ServiceReadiness is the API representation of the readiness of a service
+// reported by the worker before the steps of a build start.
+//
+// swagger:model ServiceReadiness
+type ServiceReadiness struct {
+	ID        *int64  `json:"id,omitempty"`
+	ServiceID *int64  `json:"service_id,omitempty"`
+	BuildID   *int64  `json:"build_id,omitempty"`
+	RepoID    *int64  `json:"repo_id,omitempty"`
+	Status    *string `json:"status,omitempty"`
+	Attempts  *int64  `json:"attempts,omitempty"`
+	Started   *int64  `json:"started,omitempty"`
+	Ready     *int64  `json:"ready,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetID() int64 {
+	// return zero value if ServiceReadiness type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetServiceID returns the ServiceID field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetServiceID() int64 {
+	// return zero value if ServiceReadiness type or ServiceID field is nil
+	if s == nil || s.ServiceID == nil {
+		return 0
+	}
+
+	return *s.ServiceID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetBuildID() int64 {
+	// return zero value if ServiceReadiness type or BuildID field is nil
+	if s == nil || s.BuildID == nil {
+		return 0
+	}
+
+	return *s.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetRepoID() int64 {
+	// return zero value if ServiceReadiness type or RepoID field is nil
+	if s == nil || s.RepoID == nil {
+		return 0
+	}
+
+	return *s.RepoID
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetStatus() string {
+	// return zero value if ServiceReadiness type or Status field is nil
+	if s == nil || s.Status == nil {
+		return ""
+	}
+
+	return *s.Status
+}
+
+// GetAttempts returns the Attempts field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetAttempts() int64 {
+	// return zero value if ServiceReadiness type or Attempts field is nil
+	if s == nil || s.Attempts == nil {
+		return 0
+	}
+
+	return *s.Attempts
+}
+
+// GetStarted returns the Started field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetStarted() int64 {
+	// return zero value if ServiceReadiness type or Started field is nil
+	if s == nil || s.Started == nil {
+		return 0
+	}
+
+	return *s.Started
+}
+
+// GetReady returns the Ready field.
+//
+// When the provided ServiceReadiness type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *ServiceReadiness) GetReady() int64 {
+	// return zero value if ServiceReadiness type or Ready field is nil
+	if s == nil || s.Ready == nil {
+		return 0
+	}
+
+	return *s.Ready
+}
+
+// SetID sets the ID field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetID(v int64) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetServiceID sets the ServiceID field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetServiceID(v int64) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.ServiceID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetBuildID(v int64) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetRepoID(v int64) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.RepoID = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetStatus(v string) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.Status = &v
+}
+
+// SetAttempts sets the Attempts field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetAttempts(v int64) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.Attempts = &v
+}
+
+// SetStarted sets the Started field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetStarted(v int64) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.Started = &v
+}
+
+// SetReady sets the Ready field.
+//
+// When the provided ServiceReadiness type is nil, it
+// will set nothing and immediately return.
+func (s *ServiceReadiness) SetReady(v int64) {
+	// return if ServiceReadiness type is nil
+	if s == nil {
+		return
+	}
+
+	s.Ready = &v
+}
+
+// String implements the Stringer interface for the ServiceReadiness type.
+func (s *ServiceReadiness) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  ServiceID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Status: %s,
+  Attempts: %d,
+  Started: %d,
+  Ready: %d,
+}`,
+		s.GetID(),
+		s.GetServiceID(),
+		s.GetBuildID(),
+		s.GetRepoID(),
+		s.GetStatus(),
+		s.GetAttempts(),
+		s.GetStarted(),
+		s.GetReady(),
+	)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestServiceReadiness_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		sr   *ServiceReadiness
+		want *ServiceReadiness
+	}{
+		{
+			sr:   testServiceReadiness(),
+			want: testServiceReadiness(),
+		},
+		{
+			sr:   new(ServiceReadiness),
+			want: new(ServiceReadiness),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.sr.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.sr.GetID(), test.want.GetID())
+		}
+
+		if test.sr.GetServiceID() != test.want.GetServiceID() {
+			t.Errorf("GetServiceID is %v, want %v", test.sr.GetServiceID(), test.want.GetServiceID())
+		}
+
+		if test.sr.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.sr.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.sr.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.sr.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.sr.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.sr.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.sr.GetAttempts() != test.want.GetAttempts() {
+			t.Errorf("GetAttempts is %v, want %v", test.sr.GetAttempts(), test.want.GetAttempts())
+		}
+
+		if test.sr.GetStarted() != test.want.GetStarted() {
+			t.Errorf("GetStarted is %v, want %v", test.sr.GetStarted(), test.want.GetStarted())
+		}
+
+		if test.sr.GetReady() != test.want.GetReady() {
+			t.Errorf("GetReady is %v, want %v", test.sr.GetReady(), test.want.GetReady())
+		}
+	}
+}
+
+func TestServiceReadiness_Setters(t *testing.T) {
+	// setup types
+	var s *ServiceReadiness
+
+	// setup tests
+	tests := []struct {
+		sr   *ServiceReadiness
+		want *ServiceReadiness
+	}{
+		{
+			sr:   testServiceReadiness(),
+			want: testServiceReadiness(),
+		},
+		{
+			sr:   s,
+			want: new(ServiceReadiness),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.sr.SetID(test.want.GetID())
+		test.sr.SetServiceID(test.want.GetServiceID())
+		test.sr.SetBuildID(test.want.GetBuildID())
+		test.sr.SetRepoID(test.want.GetRepoID())
+		test.sr.SetStatus(test.want.GetStatus())
+		test.sr.SetAttempts(test.want.GetAttempts())
+		test.sr.SetStarted(test.want.GetStarted())
+		test.sr.SetReady(test.want.GetReady())
+
+		if test.sr.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.sr.GetID(), test.want.GetID())
+		}
+
+		if test.sr.GetServiceID() != test.want.GetServiceID() {
+			t.Errorf("SetServiceID is %v, want %v", test.sr.GetServiceID(), test.want.GetServiceID())
+		}
+
+		if test.sr.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.sr.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.sr.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.sr.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.sr.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.sr.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.sr.GetAttempts() != test.want.GetAttempts() {
+			t.Errorf("SetAttempts is %v, want %v", test.sr.GetAttempts(), test.want.GetAttempts())
+		}
+
+		if test.sr.GetStarted() != test.want.GetStarted() {
+			t.Errorf("SetStarted is %v, want %v", test.sr.GetStarted(), test.want.GetStarted())
+		}
+
+		if test.sr.GetReady() != test.want.GetReady() {
+			t.Errorf("SetReady is %v, want %v", test.sr.GetReady(), test.want.GetReady())
+		}
+	}
+}
+
+func TestServiceReadiness_String(t *testing.T) {
+	// setup types
+	s := testServiceReadiness()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  ServiceID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Status: %s,
+  Attempts: %d,
+  Started: %d,
+  Ready: %d,
+}`,
+		s.GetID(),
+		s.GetServiceID(),
+		s.GetBuildID(),
+		s.GetRepoID(),
+		s.GetStatus(),
+		s.GetAttempts(),
+		s.GetStarted(),
+		s.GetReady(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testServiceReadiness is a test helper function to create a ServiceReadiness
+// type with all fields set to a fake value.
+func testServiceReadiness() *ServiceReadiness {
+	s := new(ServiceReadiness)
+
+	s.SetID(1)
+	s.SetServiceID(1)
+	s.SetBuildID(1)
+	s.SetRepoID(1)
+	s.SetStatus("ready")
+	s.SetAttempts(3)
+	s.SetStarted(1563474077)
+	s.SetReady(1563474083)
+
+	return s
+}
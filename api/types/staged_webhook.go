@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// StagedWebhook is the API representation of a webhook that was still being
+// processed when the server shut down and is resumed on startup.
+//
+// swagger:model StagedWebhook
+type StagedWebhook struct {
+	ID      *int64  `json:"id,omitempty"`
+	Source  *string `json:"source,omitempty"`
+	Header  *string `json:"header,omitempty"`
+	Payload *string `json:"payload,omitempty"`
+	Created *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided StagedWebhook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *StagedWebhook) GetID() int64 {
+	// return zero value if StagedWebhook type or ID field is nil
+	if w == nil || w.ID == nil {
+		return 0
+	}
+
+	return *w.ID
+}
+
+// GetSource returns the Source field.
+//
+// When the provided StagedWebhook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *StagedWebhook) GetSource() string {
+	// return zero value if StagedWebhook type or Source field is nil
+	if w == nil || w.Source == nil {
+		return ""
+	}
+
+	return *w.Source
+}
+
+// GetHeader returns the Header field.
+//
+// When the provided StagedWebhook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *StagedWebhook) GetHeader() string {
+	// return zero value if StagedWebhook type or Header field is nil
+	if w == nil || w.Header == nil {
+		return ""
+	}
+
+	return *w.Header
+}
+
+// GetPayload returns the Payload field.
+//
+// When the provided StagedWebhook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *StagedWebhook) GetPayload() string {
+	// return zero value if StagedWebhook type or Payload field is nil
+	if w == nil || w.Payload == nil {
+		return ""
+	}
+
+	return *w.Payload
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided StagedWebhook type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *StagedWebhook) GetCreated() int64 {
+	// return zero value if StagedWebhook type or Created field is nil
+	if w == nil || w.Created == nil {
+		return 0
+	}
+
+	return *w.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided StagedWebhook type is nil, it
+// will set nothing and immediately return.
+func (w *StagedWebhook) SetID(v int64) {
+	// return if StagedWebhook type is nil
+	if w == nil {
+		return
+	}
+
+	w.ID = &v
+}
+
+// SetSource sets the Source field.
+//
+// When the provided StagedWebhook type is nil, it
+// will set nothing and immediately return.
+func (w *StagedWebhook) SetSource(v string) {
+	// return if StagedWebhook type is nil
+	if w == nil {
+		return
+	}
+
+	w.Source = &v
+}
+
+// SetHeader sets the Header field.
+//
+// When the provided StagedWebhook type is nil, it
+// will set nothing and immediately return.
+func (w *StagedWebhook) SetHeader(v string) {
+	// return if StagedWebhook type is nil
+	if w == nil {
+		return
+	}
+
+	w.Header = &v
+}
+
+// SetPayload sets the Payload field.
+//
+// When the provided StagedWebhook type is nil, it
+// will set nothing and immediately return.
+func (w *StagedWebhook) SetPayload(v string) {
+	// return if StagedWebhook type is nil
+	if w == nil {
+		return
+	}
+
+	w.Payload = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided StagedWebhook type is nil, it
+// will set nothing and immediately return.
+func (w *StagedWebhook) SetCreated(v int64) {
+	// return if StagedWebhook type is nil
+	if w == nil {
+		return
+	}
+
+	w.Created = &v
+}
+
+// String implements the Stringer interface for the StagedWebhook type.
+func (w *StagedWebhook) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Source: %s,
+  Header: %s,
+  Payload: %s,
+  Created: %d,
+}`,
+		w.GetID(),
+		w.GetSource(),
+		w.GetHeader(),
+		w.GetPayload(),
+		w.GetCreated(),
+	)
+}
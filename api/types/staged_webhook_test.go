@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestStagedWebhook_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		stagedWebhook *StagedWebhook
+		want          *StagedWebhook
+	}{
+		{
+			stagedWebhook: testStagedWebhook(),
+			want:          testStagedWebhook(),
+		},
+		{
+			stagedWebhook: new(StagedWebhook),
+			want:          new(StagedWebhook),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.stagedWebhook.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.stagedWebhook.GetID(), test.want.GetID())
+		}
+
+		if test.stagedWebhook.GetSource() != test.want.GetSource() {
+			t.Errorf("GetSource is %v, want %v", test.stagedWebhook.GetSource(), test.want.GetSource())
+		}
+
+		if test.stagedWebhook.GetHeader() != test.want.GetHeader() {
+			t.Errorf("GetHeader is %v, want %v", test.stagedWebhook.GetHeader(), test.want.GetHeader())
+		}
+
+		if test.stagedWebhook.GetPayload() != test.want.GetPayload() {
+			t.Errorf("GetPayload is %v, want %v", test.stagedWebhook.GetPayload(), test.want.GetPayload())
+		}
+
+		if test.stagedWebhook.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.stagedWebhook.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestStagedWebhook_Setters(t *testing.T) {
+	// setup types
+	var w *StagedWebhook
+
+	// setup tests
+	tests := []struct {
+		stagedWebhook *StagedWebhook
+		want          *StagedWebhook
+	}{
+		{
+			stagedWebhook: testStagedWebhook(),
+			want:          testStagedWebhook(),
+		},
+		{
+			stagedWebhook: w,
+			want:          new(StagedWebhook),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.stagedWebhook.SetID(test.want.GetID())
+		test.stagedWebhook.SetSource(test.want.GetSource())
+		test.stagedWebhook.SetHeader(test.want.GetHeader())
+		test.stagedWebhook.SetPayload(test.want.GetPayload())
+		test.stagedWebhook.SetCreated(test.want.GetCreated())
+
+		if test.stagedWebhook.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.stagedWebhook.GetID(), test.want.GetID())
+		}
+
+		if test.stagedWebhook.GetSource() != test.want.GetSource() {
+			t.Errorf("SetSource is %v, want %v", test.stagedWebhook.GetSource(), test.want.GetSource())
+		}
+
+		if test.stagedWebhook.GetHeader() != test.want.GetHeader() {
+			t.Errorf("SetHeader is %v, want %v", test.stagedWebhook.GetHeader(), test.want.GetHeader())
+		}
+
+		if test.stagedWebhook.GetPayload() != test.want.GetPayload() {
+			t.Errorf("SetPayload is %v, want %v", test.stagedWebhook.GetPayload(), test.want.GetPayload())
+		}
+
+		if test.stagedWebhook.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.stagedWebhook.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestStagedWebhook_String(t *testing.T) {
+	// setup types
+	w := testStagedWebhook()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Source: %s,
+  Header: %s,
+  Payload: %s,
+  Created: %d,
+}`,
+		w.GetID(),
+		w.GetSource(),
+		w.GetHeader(),
+		w.GetPayload(),
+		w.GetCreated(),
+	)
+
+	// run test
+	got := w.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testStagedWebhook is a test helper function to create a StagedWebhook
+// type with all fields set to a fake value.
+func testStagedWebhook() *StagedWebhook {
+	w := new(StagedWebhook)
+
+	w.SetID(1)
+	w.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	w.SetHeader(`{"X-Github-Event":["push"]}`)
+	w.SetPayload(`{"ref":"refs/heads/main"}`)
+	w.SetCreated(1563474076)
+
+	return w
+}
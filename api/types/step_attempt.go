@@ -0,0 +1,313 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// StepAttempt is the API representation of a single attempt of a step
+// run by the worker under the retry policy for the step.
+//
+// swagger:model StepAttempt
+type StepAttempt struct {
+	ID       *int64  `json:"id,omitempty"`
+	StepID   *int64  `json:"step_id,omitempty"`
+	BuildID  *int64  `json:"build_id,omitempty"`
+	RepoID   *int64  `json:"repo_id,omitempty"`
+	Attempt  *int64  `json:"attempt,omitempty"`
+	Status   *string `json:"status,omitempty"`
+	Error    *string `json:"error,omitempty"`
+	ExitCode *int64  `json:"exit_code,omitempty"`
+	Started  *int64  `json:"started,omitempty"`
+	Finished *int64  `json:"finished,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetID() int64 {
+	// return zero value if StepAttempt type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetStepID returns the StepID field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetStepID() int64 {
+	// return zero value if StepAttempt type or StepID field is nil
+	if s == nil || s.StepID == nil {
+		return 0
+	}
+
+	return *s.StepID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetBuildID() int64 {
+	// return zero value if StepAttempt type or BuildID field is nil
+	if s == nil || s.BuildID == nil {
+		return 0
+	}
+
+	return *s.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetRepoID() int64 {
+	// return zero value if StepAttempt type or RepoID field is nil
+	if s == nil || s.RepoID == nil {
+		return 0
+	}
+
+	return *s.RepoID
+}
+
+// GetAttempt returns the Attempt field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetAttempt() int64 {
+	// return zero value if StepAttempt type or Attempt field is nil
+	if s == nil || s.Attempt == nil {
+		return 0
+	}
+
+	return *s.Attempt
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetStatus() string {
+	// return zero value if StepAttempt type or Status field is nil
+	if s == nil || s.Status == nil {
+		return ""
+	}
+
+	return *s.Status
+}
+
+// GetError returns the Error field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetError() string {
+	// return zero value if StepAttempt type or Error field is nil
+	if s == nil || s.Error == nil {
+		return ""
+	}
+
+	return *s.Error
+}
+
+// GetExitCode returns the ExitCode field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetExitCode() int64 {
+	// return zero value if StepAttempt type or ExitCode field is nil
+	if s == nil || s.ExitCode == nil {
+		return 0
+	}
+
+	return *s.ExitCode
+}
+
+// GetStarted returns the Started field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetStarted() int64 {
+	// return zero value if StepAttempt type or Started field is nil
+	if s == nil || s.Started == nil {
+		return 0
+	}
+
+	return *s.Started
+}
+
+// GetFinished returns the Finished field.
+//
+// When the provided StepAttempt type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepAttempt) GetFinished() int64 {
+	// return zero value if StepAttempt type or Finished field is nil
+	if s == nil || s.Finished == nil {
+		return 0
+	}
+
+	return *s.Finished
+}
+
+// SetID sets the ID field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetID(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetStepID sets the StepID field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetStepID(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.StepID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetBuildID(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetRepoID(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.RepoID = &v
+}
+
+// SetAttempt sets the Attempt field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetAttempt(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.Attempt = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetStatus(v string) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.Status = &v
+}
+
+// SetError sets the Error field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetError(v string) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.Error = &v
+}
+
+// SetExitCode sets the ExitCode field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetExitCode(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.ExitCode = &v
+}
+
+// SetStarted sets the Started field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetStarted(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.Started = &v
+}
+
+// SetFinished sets the Finished field.
+//
+// When the provided StepAttempt type is nil, it
+// will set nothing and immediately return.
+func (s *StepAttempt) SetFinished(v int64) {
+	// return if StepAttempt type is nil
+	if s == nil {
+		return
+	}
+
+	s.Finished = &v
+}
+
+// String implements the Stringer interface for the StepAttempt type.
+func (s *StepAttempt) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  StepID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Attempt: %d,
+  Status: %s,
+  Error: %s,
+  ExitCode: %d,
+  Started: %d,
+  Finished: %d,
+}`,
+		s.GetID(),
+		s.GetStepID(),
+		s.GetBuildID(),
+		s.GetRepoID(),
+		s.GetAttempt(),
+		s.GetStatus(),
+		s.GetError(),
+		s.GetExitCode(),
+		s.GetStarted(),
+		s.GetFinished(),
+	)
+}
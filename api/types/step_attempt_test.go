@@ -0,0 +1,200 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestStepAttempt_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		sa   *StepAttempt
+		want *StepAttempt
+	}{
+		{
+			sa:   testStepAttempt(),
+			want: testStepAttempt(),
+		},
+		{
+			sa:   new(StepAttempt),
+			want: new(StepAttempt),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.sa.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.sa.GetID(), test.want.GetID())
+		}
+
+		if test.sa.GetStepID() != test.want.GetStepID() {
+			t.Errorf("GetStepID is %v, want %v", test.sa.GetStepID(), test.want.GetStepID())
+		}
+
+		if test.sa.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.sa.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.sa.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.sa.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.sa.GetAttempt() != test.want.GetAttempt() {
+			t.Errorf("GetAttempt is %v, want %v", test.sa.GetAttempt(), test.want.GetAttempt())
+		}
+
+		if test.sa.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.sa.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.sa.GetError() != test.want.GetError() {
+			t.Errorf("GetError is %v, want %v", test.sa.GetError(), test.want.GetError())
+		}
+
+		if test.sa.GetExitCode() != test.want.GetExitCode() {
+			t.Errorf("GetExitCode is %v, want %v", test.sa.GetExitCode(), test.want.GetExitCode())
+		}
+
+		if test.sa.GetStarted() != test.want.GetStarted() {
+			t.Errorf("GetStarted is %v, want %v", test.sa.GetStarted(), test.want.GetStarted())
+		}
+
+		if test.sa.GetFinished() != test.want.GetFinished() {
+			t.Errorf("GetFinished is %v, want %v", test.sa.GetFinished(), test.want.GetFinished())
+		}
+	}
+}
+
+func TestStepAttempt_Setters(t *testing.T) {
+	// setup types
+	var s *StepAttempt
+
+	// setup tests
+	tests := []struct {
+		sa   *StepAttempt
+		want *StepAttempt
+	}{
+		{
+			sa:   testStepAttempt(),
+			want: testStepAttempt(),
+		},
+		{
+			sa:   s,
+			want: new(StepAttempt),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.sa.SetID(test.want.GetID())
+		test.sa.SetStepID(test.want.GetStepID())
+		test.sa.SetBuildID(test.want.GetBuildID())
+		test.sa.SetRepoID(test.want.GetRepoID())
+		test.sa.SetAttempt(test.want.GetAttempt())
+		test.sa.SetStatus(test.want.GetStatus())
+		test.sa.SetError(test.want.GetError())
+		test.sa.SetExitCode(test.want.GetExitCode())
+		test.sa.SetStarted(test.want.GetStarted())
+		test.sa.SetFinished(test.want.GetFinished())
+
+		if test.sa.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.sa.GetID(), test.want.GetID())
+		}
+
+		if test.sa.GetStepID() != test.want.GetStepID() {
+			t.Errorf("SetStepID is %v, want %v", test.sa.GetStepID(), test.want.GetStepID())
+		}
+
+		if test.sa.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.sa.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.sa.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.sa.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.sa.GetAttempt() != test.want.GetAttempt() {
+			t.Errorf("SetAttempt is %v, want %v", test.sa.GetAttempt(), test.want.GetAttempt())
+		}
+
+		if test.sa.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.sa.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.sa.GetError() != test.want.GetError() {
+			t.Errorf("SetError is %v, want %v", test.sa.GetError(), test.want.GetError())
+		}
+
+		if test.sa.GetExitCode() != test.want.GetExitCode() {
+			t.Errorf("SetExitCode is %v, want %v", test.sa.GetExitCode(), test.want.GetExitCode())
+		}
+
+		if test.sa.GetStarted() != test.want.GetStarted() {
+			t.Errorf("SetStarted is %v, want %v", test.sa.GetStarted(), test.want.GetStarted())
+		}
+
+		if test.sa.GetFinished() != test.want.GetFinished() {
+			t.Errorf("SetFinished is %v, want %v", test.sa.GetFinished(), test.want.GetFinished())
+		}
+	}
+}
+
+func TestStepAttempt_String(t *testing.T) {
+	// setup types
+	s := testStepAttempt()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  StepID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Attempt: %d,
+  Status: %s,
+  Error: %s,
+  ExitCode: %d,
+  Started: %d,
+  Finished: %d,
+}`,
+		s.GetID(),
+		s.GetStepID(),
+		s.GetBuildID(),
+		s.GetRepoID(),
+		s.GetAttempt(),
+		s.GetStatus(),
+		s.GetError(),
+		s.GetExitCode(),
+		s.GetStarted(),
+		s.GetFinished(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testStepAttempt is a test helper function to create a StepAttempt
+// type with all fields set to a fake value.
+func testStepAttempt() *StepAttempt {
+	s := new(StepAttempt)
+
+	s.SetID(1)
+	s.SetStepID(1)
+	s.SetBuildID(1)
+	s.SetRepoID(1)
+	s.SetAttempt(1)
+	s.SetStatus("failure")
+	s.SetError("exit code 1")
+	s.SetExitCode(1)
+	s.SetStarted(1563474077)
+	s.SetFinished(1563474083)
+
+	return s
+}
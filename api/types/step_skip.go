@@ -0,0 +1,270 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// SkipReasonRulesetIf represents a stage or step skipped
+	// because the if rules for the ruleset did not match.
+	SkipReasonRulesetIf = "ruleset_if"
+
+	// SkipReasonRulesetUnless represents a stage or step
+	// skipped because the unless rules for the ruleset matched.
+	SkipReasonRulesetUnless = "ruleset_unless"
+
+	// SkipReasonCondition represents a stage or step skipped
+	// because the conditional expression did not hold.
+	SkipReasonCondition = "condition"
+)
+
+// StepSkip is the API representation of a stage or step of a
+// build that was skipped when the build was compiled, along with
+// the machine-readable reason it was skipped.
+//
+// swagger:model StepSkip
+type StepSkip struct {
+	ID      *int64  `json:"id,omitempty"`
+	BuildID *int64  `json:"build_id,omitempty"`
+	RepoID  *int64  `json:"repo_id,omitempty"`
+	Stage   *string `json:"stage,omitempty"`
+	Step    *string `json:"step,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Created *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetID() int64 {
+	// return zero value if StepSkip type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetBuildID returns the BuildID field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetBuildID() int64 {
+	// return zero value if StepSkip type or BuildID field is nil
+	if s == nil || s.BuildID == nil {
+		return 0
+	}
+
+	return *s.BuildID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetRepoID() int64 {
+	// return zero value if StepSkip type or RepoID field is nil
+	if s == nil || s.RepoID == nil {
+		return 0
+	}
+
+	return *s.RepoID
+}
+
+// GetStage returns the Stage field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetStage() string {
+	// return zero value if StepSkip type or Stage field is nil
+	if s == nil || s.Stage == nil {
+		return ""
+	}
+
+	return *s.Stage
+}
+
+// GetStep returns the Step field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetStep() string {
+	// return zero value if StepSkip type or Step field is nil
+	if s == nil || s.Step == nil {
+		return ""
+	}
+
+	return *s.Step
+}
+
+// GetReason returns the Reason field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetReason() string {
+	// return zero value if StepSkip type or Reason field is nil
+	if s == nil || s.Reason == nil {
+		return ""
+	}
+
+	return *s.Reason
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetMessage() string {
+	// return zero value if StepSkip type or Message field is nil
+	if s == nil || s.Message == nil {
+		return ""
+	}
+
+	return *s.Message
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided StepSkip type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *StepSkip) GetCreated() int64 {
+	// return zero value if StepSkip type or Created field is nil
+	if s == nil || s.Created == nil {
+		return 0
+	}
+
+	return *s.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetID(v int64) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetBuildID sets the BuildID field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetBuildID(v int64) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.BuildID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetRepoID(v int64) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.RepoID = &v
+}
+
+// SetStage sets the Stage field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetStage(v string) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.Stage = &v
+}
+
+// SetStep sets the Step field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetStep(v string) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.Step = &v
+}
+
+// SetReason sets the Reason field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetReason(v string) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.Reason = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetMessage(v string) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.Message = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided StepSkip type is nil, it
+// will set nothing and immediately return.
+func (s *StepSkip) SetCreated(v int64) {
+	// return if StepSkip type is nil
+	if s == nil {
+		return
+	}
+
+	s.Created = &v
+}
+
+// String implements the Stringer interface for the StepSkip type.
+func (s *StepSkip) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Stage: %s,
+  Step: %s,
+  Reason: %s,
+  Message: %s,
+  Created: %d,
+}`,
+		s.GetID(),
+		s.GetBuildID(),
+		s.GetRepoID(),
+		s.GetStage(),
+		s.GetStep(),
+		s.GetReason(),
+		s.GetMessage(),
+		s.GetCreated(),
+	)
+}
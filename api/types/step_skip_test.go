@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestStepSkip_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		skip *StepSkip
+		want *StepSkip
+	}{
+		{
+			skip: testStepSkip(),
+			want: testStepSkip(),
+		},
+		{
+			skip: new(StepSkip),
+			want: new(StepSkip),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.skip.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.skip.GetID(), test.want.GetID())
+		}
+
+		if test.skip.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("GetBuildID is %v, want %v", test.skip.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.skip.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.skip.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.skip.GetStage() != test.want.GetStage() {
+			t.Errorf("GetStage is %v, want %v", test.skip.GetStage(), test.want.GetStage())
+		}
+
+		if test.skip.GetStep() != test.want.GetStep() {
+			t.Errorf("GetStep is %v, want %v", test.skip.GetStep(), test.want.GetStep())
+		}
+
+		if test.skip.GetReason() != test.want.GetReason() {
+			t.Errorf("GetReason is %v, want %v", test.skip.GetReason(), test.want.GetReason())
+		}
+
+		if test.skip.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.skip.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.skip.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.skip.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestStepSkip_Setters(t *testing.T) {
+	// setup types
+	var s *StepSkip
+
+	// setup tests
+	tests := []struct {
+		skip *StepSkip
+		want *StepSkip
+	}{
+		{
+			skip: testStepSkip(),
+			want: testStepSkip(),
+		},
+		{
+			skip: s,
+			want: new(StepSkip),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.skip.SetID(test.want.GetID())
+		test.skip.SetBuildID(test.want.GetBuildID())
+		test.skip.SetRepoID(test.want.GetRepoID())
+		test.skip.SetStage(test.want.GetStage())
+		test.skip.SetStep(test.want.GetStep())
+		test.skip.SetReason(test.want.GetReason())
+		test.skip.SetMessage(test.want.GetMessage())
+		test.skip.SetCreated(test.want.GetCreated())
+
+		if test.skip.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.skip.GetID(), test.want.GetID())
+		}
+
+		if test.skip.GetBuildID() != test.want.GetBuildID() {
+			t.Errorf("SetBuildID is %v, want %v", test.skip.GetBuildID(), test.want.GetBuildID())
+		}
+
+		if test.skip.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.skip.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.skip.GetStage() != test.want.GetStage() {
+			t.Errorf("SetStage is %v, want %v", test.skip.GetStage(), test.want.GetStage())
+		}
+
+		if test.skip.GetStep() != test.want.GetStep() {
+			t.Errorf("SetStep is %v, want %v", test.skip.GetStep(), test.want.GetStep())
+		}
+
+		if test.skip.GetReason() != test.want.GetReason() {
+			t.Errorf("SetReason is %v, want %v", test.skip.GetReason(), test.want.GetReason())
+		}
+
+		if test.skip.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.skip.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.skip.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.skip.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestStepSkip_String(t *testing.T) {
+	// setup types
+	s := testStepSkip()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  BuildID: %d,
+  RepoID: %d,
+  Stage: %s,
+  Step: %s,
+  Reason: %s,
+  Message: %s,
+  Created: %d,
+}`,
+		s.GetID(),
+		s.GetBuildID(),
+		s.GetRepoID(),
+		s.GetStage(),
+		s.GetStep(),
+		s.GetReason(),
+		s.GetMessage(),
+		s.GetCreated(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testStepSkip is a test helper function to create a StepSkip
+// type with all fields set to a fake value.
+func testStepSkip() *StepSkip {
+	s := new(StepSkip)
+
+	s.SetID(1)
+	s.SetBuildID(1)
+	s.SetRepoID(1)
+	s.SetStage("test")
+	s.SetStep("deploy")
+	s.SetReason("ruleset_if")
+	s.SetMessage("ruleset if rules do not match the build")
+	s.SetCreated(1563474076)
+
+	return s
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// TeamUsage is the API representation of the build capacity
+// consumed by the members of a team.
+//
+// swagger:model TeamUsage
+type TeamUsage struct {
+	Org     *string        `json:"org,omitempty"`
+	Team    *string        `json:"team,omitempty"`
+	Builds  *int64         `json:"builds,omitempty"`
+	Steps   *int64         `json:"steps,omitempty"`
+	Minutes *float64       `json:"minutes,omitempty"`
+	Actors  *[]*ActorUsage `json:"actors,omitempty"`
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided TeamUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TeamUsage) GetOrg() string {
+	// return zero value if TeamUsage type or Org field is nil
+	if t == nil || t.Org == nil {
+		return ""
+	}
+
+	return *t.Org
+}
+
+// GetTeam returns the Team field.
+//
+// When the provided TeamUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TeamUsage) GetTeam() string {
+	// return zero value if TeamUsage type or Team field is nil
+	if t == nil || t.Team == nil {
+		return ""
+	}
+
+	return *t.Team
+}
+
+// GetBuilds returns the Builds field.
+//
+// When the provided TeamUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TeamUsage) GetBuilds() int64 {
+	// return zero value if TeamUsage type or Builds field is nil
+	if t == nil || t.Builds == nil {
+		return 0
+	}
+
+	return *t.Builds
+}
+
+// GetSteps returns the Steps field.
+//
+// When the provided TeamUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TeamUsage) GetSteps() int64 {
+	// return zero value if TeamUsage type or Steps field is nil
+	if t == nil || t.Steps == nil {
+		return 0
+	}
+
+	return *t.Steps
+}
+
+// GetMinutes returns the Minutes field.
+//
+// When the provided TeamUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TeamUsage) GetMinutes() float64 {
+	// return zero value if TeamUsage type or Minutes field is nil
+	if t == nil || t.Minutes == nil {
+		return 0
+	}
+
+	return *t.Minutes
+}
+
+// GetActors returns the Actors field.
+//
+// When the provided TeamUsage type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TeamUsage) GetActors() []*ActorUsage {
+	// return zero value if TeamUsage type or Actors field is nil
+	if t == nil || t.Actors == nil {
+		return []*ActorUsage{}
+	}
+
+	return *t.Actors
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided TeamUsage type is nil, it
+// will set nothing and immediately return.
+func (t *TeamUsage) SetOrg(v string) {
+	// return if TeamUsage type is nil
+	if t == nil {
+		return
+	}
+
+	t.Org = &v
+}
+
+// SetTeam sets the Team field.
+//
+// When the provided TeamUsage type is nil, it
+// will set nothing and immediately return.
+func (t *TeamUsage) SetTeam(v string) {
+	// return if TeamUsage type is nil
+	if t == nil {
+		return
+	}
+
+	t.Team = &v
+}
+
+// SetBuilds sets the Builds field.
+//
+// When the provided TeamUsage type is nil, it
+// will set nothing and immediately return.
+func (t *TeamUsage) SetBuilds(v int64) {
+	// return if TeamUsage type is nil
+	if t == nil {
+		return
+	}
+
+	t.Builds = &v
+}
+
+// SetSteps sets the Steps field.
+//
+// When the provided TeamUsage type is nil, it
+// will set nothing and immediately return.
+func (t *TeamUsage) SetSteps(v int64) {
+	// return if TeamUsage type is nil
+	if t == nil {
+		return
+	}
+
+	t.Steps = &v
+}
+
+// SetMinutes sets the Minutes field.
+//
+// When the provided TeamUsage type is nil, it
+// will set nothing and immediately return.
+func (t *TeamUsage) SetMinutes(v float64) {
+	// return if TeamUsage type is nil
+	if t == nil {
+		return
+	}
+
+	t.Minutes = &v
+}
+
+// SetActors sets the Actors field.
+//
+// When the provided TeamUsage type is nil, it
+// will set nothing and immediately return.
+func (t *TeamUsage) SetActors(v []*ActorUsage) {
+	// return if TeamUsage type is nil
+	if t == nil {
+		return
+	}
+
+	t.Actors = &v
+}
+
+// String implements the Stringer interface for the TeamUsage type.
+func (t *TeamUsage) String() string {
+	return fmt.Sprintf(`{
+  Org: %s,
+  Team: %s,
+  Builds: %d,
+  Steps: %d,
+  Minutes: %v,
+  Actors: %v,
+}`,
+		t.GetOrg(),
+		t.GetTeam(),
+		t.GetBuilds(),
+		t.GetSteps(),
+		t.GetMinutes(),
+		t.GetActors(),
+	)
+}
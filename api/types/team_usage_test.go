@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTeamUsage_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		u    *TeamUsage
+		want *TeamUsage
+	}{
+		{
+			u:    testTeamUsage(),
+			want: testTeamUsage(),
+		},
+		{
+			u:    new(TeamUsage),
+			want: new(TeamUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.u.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.u.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.u.GetTeam() != test.want.GetTeam() {
+			t.Errorf("GetTeam is %v, want %v", test.u.GetTeam(), test.want.GetTeam())
+		}
+
+		if test.u.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("GetBuilds is %v, want %v", test.u.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.u.GetSteps() != test.want.GetSteps() {
+			t.Errorf("GetSteps is %v, want %v", test.u.GetSteps(), test.want.GetSteps())
+		}
+
+		if test.u.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("GetMinutes is %v, want %v", test.u.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if !reflect.DeepEqual(test.u.GetActors(), test.want.GetActors()) {
+			t.Errorf("GetActors is %v, want %v", test.u.GetActors(), test.want.GetActors())
+		}
+	}
+}
+
+func TestTeamUsage_Setters(t *testing.T) {
+	// setup types
+	var u *TeamUsage
+
+	// setup tests
+	tests := []struct {
+		u    *TeamUsage
+		want *TeamUsage
+	}{
+		{
+			u:    testTeamUsage(),
+			want: testTeamUsage(),
+		},
+		{
+			u:    u,
+			want: new(TeamUsage),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.u.SetOrg(test.want.GetOrg())
+		test.u.SetTeam(test.want.GetTeam())
+		test.u.SetBuilds(test.want.GetBuilds())
+		test.u.SetSteps(test.want.GetSteps())
+		test.u.SetMinutes(test.want.GetMinutes())
+		test.u.SetActors(test.want.GetActors())
+
+		if test.u.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.u.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.u.GetTeam() != test.want.GetTeam() {
+			t.Errorf("SetTeam is %v, want %v", test.u.GetTeam(), test.want.GetTeam())
+		}
+
+		if test.u.GetBuilds() != test.want.GetBuilds() {
+			t.Errorf("SetBuilds is %v, want %v", test.u.GetBuilds(), test.want.GetBuilds())
+		}
+
+		if test.u.GetSteps() != test.want.GetSteps() {
+			t.Errorf("SetSteps is %v, want %v", test.u.GetSteps(), test.want.GetSteps())
+		}
+
+		if test.u.GetMinutes() != test.want.GetMinutes() {
+			t.Errorf("SetMinutes is %v, want %v", test.u.GetMinutes(), test.want.GetMinutes())
+		}
+
+		if !reflect.DeepEqual(test.u.GetActors(), test.want.GetActors()) {
+			t.Errorf("SetActors is %v, want %v", test.u.GetActors(), test.want.GetActors())
+		}
+	}
+}
+
+func TestTeamUsage_String(t *testing.T) {
+	// setup types
+	u := testTeamUsage()
+
+	want := fmt.Sprintf(`{
+  Org: %s,
+  Team: %s,
+  Builds: %d,
+  Steps: %d,
+  Minutes: %v,
+  Actors: %v,
+}`,
+		u.GetOrg(),
+		u.GetTeam(),
+		u.GetBuilds(),
+		u.GetSteps(),
+		u.GetMinutes(),
+		u.GetActors(),
+	)
+
+	// run test
+	got := u.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testTeamUsage is a test helper function to create a TeamUsage
+// type with all fields set to a fake value.
+func testTeamUsage() *TeamUsage {
+	u := new(TeamUsage)
+
+	u.SetOrg("github")
+	u.SetTeam("octokitties")
+	u.SetBuilds(3)
+	u.SetSteps(12)
+	u.SetMinutes(7.5)
+	u.SetActors([]*ActorUsage{testActorUsage()})
+
+	return u
+}
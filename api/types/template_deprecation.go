@@ -0,0 +1,255 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// TemplateDeprecation is the API representation of a version of a template
+// that has been marked deprecated by its owners.
+//
+// swagger:model TemplateDeprecation
+type TemplateDeprecation struct {
+	ID        *int64  `json:"id,omitempty"`
+	Org       *string `json:"org,omitempty"`
+	Repo      *string `json:"repo,omitempty"`
+	Path      *string `json:"path,omitempty"`
+	Ref       *string `json:"ref,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetID() int64 {
+	// return zero value if TemplateDeprecation type or ID field is nil
+	if d == nil || d.ID == nil {
+		return 0
+	}
+
+	return *d.ID
+}
+
+// GetOrg returns the Org field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetOrg() string {
+	// return zero value if TemplateDeprecation type or Org field is nil
+	if d == nil || d.Org == nil {
+		return ""
+	}
+
+	return *d.Org
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetRepo() string {
+	// return zero value if TemplateDeprecation type or Repo field is nil
+	if d == nil || d.Repo == nil {
+		return ""
+	}
+
+	return *d.Repo
+}
+
+// GetPath returns the Path field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetPath() string {
+	// return zero value if TemplateDeprecation type or Path field is nil
+	if d == nil || d.Path == nil {
+		return ""
+	}
+
+	return *d.Path
+}
+
+// GetRef returns the Ref field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetRef() string {
+	// return zero value if TemplateDeprecation type or Ref field is nil
+	if d == nil || d.Ref == nil {
+		return ""
+	}
+
+	return *d.Ref
+}
+
+// GetMessage returns the Message field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetMessage() string {
+	// return zero value if TemplateDeprecation type or Message field is nil
+	if d == nil || d.Message == nil {
+		return ""
+	}
+
+	return *d.Message
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetCreatedBy() string {
+	// return zero value if TemplateDeprecation type or CreatedBy field is nil
+	if d == nil || d.CreatedBy == nil {
+		return ""
+	}
+
+	return *d.CreatedBy
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided TemplateDeprecation type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *TemplateDeprecation) GetCreatedAt() int64 {
+	// return zero value if TemplateDeprecation type or CreatedAt field is nil
+	if d == nil || d.CreatedAt == nil {
+		return 0
+	}
+
+	return *d.CreatedAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetID(v int64) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.ID = &v
+}
+
+// SetOrg sets the Org field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetOrg(v string) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Org = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetRepo(v string) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Repo = &v
+}
+
+// SetPath sets the Path field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetPath(v string) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Path = &v
+}
+
+// SetRef sets the Ref field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetRef(v string) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Ref = &v
+}
+
+// SetMessage sets the Message field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetMessage(v string) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.Message = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetCreatedBy(v string) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.CreatedBy = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided TemplateDeprecation type is nil, it
+// will set nothing and immediately return.
+func (d *TemplateDeprecation) SetCreatedAt(v int64) {
+	// return if TemplateDeprecation type is nil
+	if d == nil {
+		return
+	}
+
+	d.CreatedAt = &v
+}
+
+// String implements the Stringer interface for the TemplateDeprecation type.
+func (d *TemplateDeprecation) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Repo: %s,
+  Path: %s,
+  Ref: %s,
+  Message: %s,
+  CreatedBy: %s,
+  CreatedAt: %d,
+}`,
+		d.GetID(),
+		d.GetOrg(),
+		d.GetRepo(),
+		d.GetPath(),
+		d.GetRef(),
+		d.GetMessage(),
+		d.GetCreatedBy(),
+		d.GetCreatedAt(),
+	)
+}
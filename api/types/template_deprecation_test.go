@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTemplateDeprecation_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		d    *TemplateDeprecation
+		want *TemplateDeprecation
+	}{
+		{
+			d:    testTemplateDeprecation(),
+			want: testTemplateDeprecation(),
+		},
+		{
+			d:    new(TemplateDeprecation),
+			want: new(TemplateDeprecation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.d.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.d.GetID(), test.want.GetID())
+		}
+
+		if test.d.GetOrg() != test.want.GetOrg() {
+			t.Errorf("GetOrg is %v, want %v", test.d.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.d.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.d.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.d.GetPath() != test.want.GetPath() {
+			t.Errorf("GetPath is %v, want %v", test.d.GetPath(), test.want.GetPath())
+		}
+
+		if test.d.GetRef() != test.want.GetRef() {
+			t.Errorf("GetRef is %v, want %v", test.d.GetRef(), test.want.GetRef())
+		}
+
+		if test.d.GetMessage() != test.want.GetMessage() {
+			t.Errorf("GetMessage is %v, want %v", test.d.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.d.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.d.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.d.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.d.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestTemplateDeprecation_Setters(t *testing.T) {
+	// setup types
+	var d *TemplateDeprecation
+
+	// setup tests
+	tests := []struct {
+		d    *TemplateDeprecation
+		want *TemplateDeprecation
+	}{
+		{
+			d:    testTemplateDeprecation(),
+			want: testTemplateDeprecation(),
+		},
+		{
+			d:    d,
+			want: new(TemplateDeprecation),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.d.SetID(test.want.GetID())
+		test.d.SetOrg(test.want.GetOrg())
+		test.d.SetRepo(test.want.GetRepo())
+		test.d.SetPath(test.want.GetPath())
+		test.d.SetRef(test.want.GetRef())
+		test.d.SetMessage(test.want.GetMessage())
+		test.d.SetCreatedBy(test.want.GetCreatedBy())
+		test.d.SetCreatedAt(test.want.GetCreatedAt())
+
+		if test.d.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.d.GetID(), test.want.GetID())
+		}
+
+		if test.d.GetOrg() != test.want.GetOrg() {
+			t.Errorf("SetOrg is %v, want %v", test.d.GetOrg(), test.want.GetOrg())
+		}
+
+		if test.d.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.d.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.d.GetPath() != test.want.GetPath() {
+			t.Errorf("SetPath is %v, want %v", test.d.GetPath(), test.want.GetPath())
+		}
+
+		if test.d.GetRef() != test.want.GetRef() {
+			t.Errorf("SetRef is %v, want %v", test.d.GetRef(), test.want.GetRef())
+		}
+
+		if test.d.GetMessage() != test.want.GetMessage() {
+			t.Errorf("SetMessage is %v, want %v", test.d.GetMessage(), test.want.GetMessage())
+		}
+
+		if test.d.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.d.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.d.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.d.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+	}
+}
+
+func TestTemplateDeprecation_String(t *testing.T) {
+	// setup types
+	d := testTemplateDeprecation()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Org: %s,
+  Repo: %s,
+  Path: %s,
+  Ref: %s,
+  Message: %s,
+  CreatedBy: %s,
+  CreatedAt: %d,
+}`,
+		d.GetID(),
+		d.GetOrg(),
+		d.GetRepo(),
+		d.GetPath(),
+		d.GetRef(),
+		d.GetMessage(),
+		d.GetCreatedBy(),
+		d.GetCreatedAt(),
+	)
+
+	// run test
+	got := d.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testTemplateDeprecation is a test helper function to create a TemplateDeprecation
+// type with all fields set to a fake value.
+func testTemplateDeprecation() *TemplateDeprecation {
+	d := new(TemplateDeprecation)
+
+	d.SetID(1)
+	d.SetOrg("github")
+	d.SetRepo("octocat")
+	d.SetPath("template.yml")
+	d.SetRef("v1.0.0")
+	d.SetMessage("use v2.0.0 instead")
+	d.SetCreatedBy("octocat")
+	d.SetCreatedAt(1563474077)
+
+	return d
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// TriggerToken is the API representation of a repo trigger token
+// minted for a repo or an org. Only the identifier embedded in the
+// token is stored, the signed token itself is never persisted.
+//
+// swagger:model TriggerToken
+type TriggerToken struct {
+	ID        *int64  `json:"id,omitempty"`
+	Repo      *string `json:"repo,omitempty"`
+	TokenID   *string `json:"token_id,omitempty"`
+	CreatedAt *int64  `json:"created_at,omitempty"`
+	CreatedBy *string `json:"created_by,omitempty"`
+	ExpiresAt *int64  `json:"expires_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided TriggerToken type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TriggerToken) GetID() int64 {
+	// return zero value if TriggerToken type or ID field is nil
+	if t == nil || t.ID == nil {
+		return 0
+	}
+
+	return *t.ID
+}
+
+// GetRepo returns the Repo field.
+//
+// When the provided TriggerToken type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TriggerToken) GetRepo() string {
+	// return zero value if TriggerToken type or Repo field is nil
+	if t == nil || t.Repo == nil {
+		return ""
+	}
+
+	return *t.Repo
+}
+
+// GetTokenID returns the TokenID field.
+//
+// When the provided TriggerToken type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TriggerToken) GetTokenID() string {
+	// return zero value if TriggerToken type or TokenID field is nil
+	if t == nil || t.TokenID == nil {
+		return ""
+	}
+
+	return *t.TokenID
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided TriggerToken type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TriggerToken) GetCreatedAt() int64 {
+	// return zero value if TriggerToken type or CreatedAt field is nil
+	if t == nil || t.CreatedAt == nil {
+		return 0
+	}
+
+	return *t.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided TriggerToken type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TriggerToken) GetCreatedBy() string {
+	// return zero value if TriggerToken type or CreatedBy field is nil
+	if t == nil || t.CreatedBy == nil {
+		return ""
+	}
+
+	return *t.CreatedBy
+}
+
+// GetExpiresAt returns the ExpiresAt field.
+//
+// When the provided TriggerToken type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (t *TriggerToken) GetExpiresAt() int64 {
+	// return zero value if TriggerToken type or ExpiresAt field is nil
+	if t == nil || t.ExpiresAt == nil {
+		return 0
+	}
+
+	return *t.ExpiresAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided TriggerToken type is nil, it
+// will set nothing and immediately return.
+func (t *TriggerToken) SetID(v int64) {
+	// return if TriggerToken type is nil
+	if t == nil {
+		return
+	}
+
+	t.ID = &v
+}
+
+// SetRepo sets the Repo field.
+//
+// When the provided TriggerToken type is nil, it
+// will set nothing and immediately return.
+func (t *TriggerToken) SetRepo(v string) {
+	// return if TriggerToken type is nil
+	if t == nil {
+		return
+	}
+
+	t.Repo = &v
+}
+
+// SetTokenID sets the TokenID field.
+//
+// When the provided TriggerToken type is nil, it
+// will set nothing and immediately return.
+func (t *TriggerToken) SetTokenID(v string) {
+	// return if TriggerToken type is nil
+	if t == nil {
+		return
+	}
+
+	t.TokenID = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided TriggerToken type is nil, it
+// will set nothing and immediately return.
+func (t *TriggerToken) SetCreatedAt(v int64) {
+	// return if TriggerToken type is nil
+	if t == nil {
+		return
+	}
+
+	t.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided TriggerToken type is nil, it
+// will set nothing and immediately return.
+func (t *TriggerToken) SetCreatedBy(v string) {
+	// return if TriggerToken type is nil
+	if t == nil {
+		return
+	}
+
+	t.CreatedBy = &v
+}
+
+// SetExpiresAt sets the ExpiresAt field.
+//
+// When the provided TriggerToken type is nil, it
+// will set nothing and immediately return.
+func (t *TriggerToken) SetExpiresAt(v int64) {
+	// return if TriggerToken type is nil
+	if t == nil {
+		return
+	}
+
+	t.ExpiresAt = &v
+}
+
+// String implements the Stringer interface for the TriggerToken type.
+func (t *TriggerToken) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Repo: %s,
+  TokenID: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  ExpiresAt: %d,
+}`,
+		t.GetID(),
+		t.GetRepo(),
+		t.GetTokenID(),
+		t.GetCreatedAt(),
+		t.GetCreatedBy(),
+		t.GetExpiresAt(),
+	)
+}
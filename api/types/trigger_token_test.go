@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestTriggerToken_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		token *TriggerToken
+		want  *TriggerToken
+	}{
+		{
+			token: testTriggerToken(),
+			want:  testTriggerToken(),
+		},
+		{
+			token: new(TriggerToken),
+			want:  new(TriggerToken),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.token.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.token.GetID(), test.want.GetID())
+		}
+
+		if test.token.GetRepo() != test.want.GetRepo() {
+			t.Errorf("GetRepo is %v, want %v", test.token.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.token.GetTokenID() != test.want.GetTokenID() {
+			t.Errorf("GetTokenID is %v, want %v", test.token.GetTokenID(), test.want.GetTokenID())
+		}
+
+		if test.token.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.token.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.token.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.token.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.token.GetExpiresAt() != test.want.GetExpiresAt() {
+			t.Errorf("GetExpiresAt is %v, want %v", test.token.GetExpiresAt(), test.want.GetExpiresAt())
+		}
+	}
+}
+
+func TestTriggerToken_Setters(t *testing.T) {
+	// setup types
+	var tkn *TriggerToken
+
+	// setup tests
+	tests := []struct {
+		token *TriggerToken
+		want  *TriggerToken
+	}{
+		{
+			token: testTriggerToken(),
+			want:  testTriggerToken(),
+		},
+		{
+			token: tkn,
+			want:  new(TriggerToken),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.token.SetID(test.want.GetID())
+		test.token.SetRepo(test.want.GetRepo())
+		test.token.SetTokenID(test.want.GetTokenID())
+		test.token.SetCreatedAt(test.want.GetCreatedAt())
+		test.token.SetCreatedBy(test.want.GetCreatedBy())
+		test.token.SetExpiresAt(test.want.GetExpiresAt())
+
+		if test.token.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.token.GetID(), test.want.GetID())
+		}
+
+		if test.token.GetRepo() != test.want.GetRepo() {
+			t.Errorf("SetRepo is %v, want %v", test.token.GetRepo(), test.want.GetRepo())
+		}
+
+		if test.token.GetTokenID() != test.want.GetTokenID() {
+			t.Errorf("SetTokenID is %v, want %v", test.token.GetTokenID(), test.want.GetTokenID())
+		}
+
+		if test.token.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.token.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.token.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.token.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.token.GetExpiresAt() != test.want.GetExpiresAt() {
+			t.Errorf("SetExpiresAt is %v, want %v", test.token.GetExpiresAt(), test.want.GetExpiresAt())
+		}
+	}
+}
+
+func TestTriggerToken_String(t *testing.T) {
+	// setup types
+	token := testTriggerToken()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Repo: %s,
+  TokenID: %s,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  ExpiresAt: %d,
+}`,
+		token.GetID(),
+		token.GetRepo(),
+		token.GetTokenID(),
+		token.GetCreatedAt(),
+		token.GetCreatedBy(),
+		token.GetExpiresAt(),
+	)
+
+	// run test
+	got := token.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testTriggerToken is a test helper function to create a TriggerToken
+// type with all fields set to a fake value.
+func testTriggerToken() *TriggerToken {
+	token := new(TriggerToken)
+
+	token.SetID(1)
+	token.SetRepo("github/octocat")
+	token.SetTokenID("4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29")
+	token.SetCreatedAt(1563474077)
+	token.SetCreatedBy("octocat")
+	token.SetExpiresAt(1563477677)
+
+	return token
+}
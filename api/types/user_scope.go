@@ -0,0 +1,228 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// UserScope is the API representation of the OAuth scopes granted
+// to the scm token for a user when the user last logged in, compared
+// against the scopes required by Vela. The user must log in again to
+// re-consent when the granted scopes are missing required scopes.
+//
+// swagger:model UserScope
+type UserScope struct {
+	ID         *int64    `json:"id,omitempty"`
+	UserID     *int64    `json:"user_id,omitempty"`
+	Scopes     *[]string `json:"scopes,omitempty"`
+	Required   *[]string `json:"required,omitempty"`
+	Missing    *[]string `json:"missing,omitempty"`
+	ConsentURL *string   `json:"consent_url,omitempty"`
+	UpdatedAt  *int64    `json:"updated_at,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided UserScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserScope) GetID() int64 {
+	// return zero value if UserScope type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetUserID returns the UserID field.
+//
+// When the provided UserScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserScope) GetUserID() int64 {
+	// return zero value if UserScope type or UserID field is nil
+	if s == nil || s.UserID == nil {
+		return 0
+	}
+
+	return *s.UserID
+}
+
+// GetScopes returns the Scopes field.
+//
+// When the provided UserScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserScope) GetScopes() []string {
+	// return zero value if UserScope type or Scopes field is nil
+	if s == nil || s.Scopes == nil {
+		return []string{}
+	}
+
+	return *s.Scopes
+}
+
+// GetRequired returns the Required field.
+//
+// When the provided UserScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserScope) GetRequired() []string {
+	// return zero value if UserScope type or Required field is nil
+	if s == nil || s.Required == nil {
+		return []string{}
+	}
+
+	return *s.Required
+}
+
+// GetMissing returns the Missing field.
+//
+// When the provided UserScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserScope) GetMissing() []string {
+	// return zero value if UserScope type or Missing field is nil
+	if s == nil || s.Missing == nil {
+		return []string{}
+	}
+
+	return *s.Missing
+}
+
+// GetConsentURL returns the ConsentURL field.
+//
+// When the provided UserScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserScope) GetConsentURL() string {
+	// return zero value if UserScope type or ConsentURL field is nil
+	if s == nil || s.ConsentURL == nil {
+		return ""
+	}
+
+	return *s.ConsentURL
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided UserScope type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserScope) GetUpdatedAt() int64 {
+	// return zero value if UserScope type or UpdatedAt field is nil
+	if s == nil || s.UpdatedAt == nil {
+		return 0
+	}
+
+	return *s.UpdatedAt
+}
+
+// SetID sets the ID field.
+//
+// When the provided UserScope type is nil, it
+// will set nothing and immediately return.
+func (s *UserScope) SetID(v int64) {
+	// return if UserScope type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetUserID sets the UserID field.
+//
+// When the provided UserScope type is nil, it
+// will set nothing and immediately return.
+func (s *UserScope) SetUserID(v int64) {
+	// return if UserScope type is nil
+	if s == nil {
+		return
+	}
+
+	s.UserID = &v
+}
+
+// SetScopes sets the Scopes field.
+//
+// When the provided UserScope type is nil, it
+// will set nothing and immediately return.
+func (s *UserScope) SetScopes(v []string) {
+	// return if UserScope type is nil
+	if s == nil {
+		return
+	}
+
+	s.Scopes = &v
+}
+
+// SetRequired sets the Required field.
+//
+// When the provided UserScope type is nil, it
+// will set nothing and immediately return.
+func (s *UserScope) SetRequired(v []string) {
+	// return if UserScope type is nil
+	if s == nil {
+		return
+	}
+
+	s.Required = &v
+}
+
+// SetMissing sets the Missing field.
+//
+// When the provided UserScope type is nil, it
+// will set nothing and immediately return.
+func (s *UserScope) SetMissing(v []string) {
+	// return if UserScope type is nil
+	if s == nil {
+		return
+	}
+
+	s.Missing = &v
+}
+
+// SetConsentURL sets the ConsentURL field.
+//
+// When the provided UserScope type is nil, it
+// will set nothing and immediately return.
+func (s *UserScope) SetConsentURL(v string) {
+	// return if UserScope type is nil
+	if s == nil {
+		return
+	}
+
+	s.ConsentURL = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided UserScope type is nil, it
+// will set nothing and immediately return.
+func (s *UserScope) SetUpdatedAt(v int64) {
+	// return if UserScope type is nil
+	if s == nil {
+		return
+	}
+
+	s.UpdatedAt = &v
+}
+
+// String implements the Stringer interface for the UserScope type.
+func (s *UserScope) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  UserID: %d,
+  Scopes: %s,
+  Required: %s,
+  Missing: %s,
+  ConsentURL: %s,
+  UpdatedAt: %d,
+}`,
+		s.GetID(),
+		s.GetUserID(),
+		s.GetScopes(),
+		s.GetRequired(),
+		s.GetMissing(),
+		s.GetConsentURL(),
+		s.GetUpdatedAt(),
+	)
+}
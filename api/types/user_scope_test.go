@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestUserScope_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		scope *UserScope
+		want  *UserScope
+	}{
+		{
+			scope: testUserScope(),
+			want:  testUserScope(),
+		},
+		{
+			scope: new(UserScope),
+			want:  new(UserScope),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.scope.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.scope.GetID(), test.want.GetID())
+		}
+
+		if test.scope.GetUserID() != test.want.GetUserID() {
+			t.Errorf("GetUserID is %v, want %v", test.scope.GetUserID(), test.want.GetUserID())
+		}
+
+		if !reflect.DeepEqual(test.scope.GetScopes(), test.want.GetScopes()) {
+			t.Errorf("GetScopes is %v, want %v", test.scope.GetScopes(), test.want.GetScopes())
+		}
+
+		if !reflect.DeepEqual(test.scope.GetRequired(), test.want.GetRequired()) {
+			t.Errorf("GetRequired is %v, want %v", test.scope.GetRequired(), test.want.GetRequired())
+		}
+
+		if !reflect.DeepEqual(test.scope.GetMissing(), test.want.GetMissing()) {
+			t.Errorf("GetMissing is %v, want %v", test.scope.GetMissing(), test.want.GetMissing())
+		}
+
+		if test.scope.GetConsentURL() != test.want.GetConsentURL() {
+			t.Errorf("GetConsentURL is %v, want %v", test.scope.GetConsentURL(), test.want.GetConsentURL())
+		}
+
+		if test.scope.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.scope.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+	}
+}
+
+func TestUserScope_Setters(t *testing.T) {
+	// setup types
+	var s *UserScope
+
+	// setup tests
+	tests := []struct {
+		scope *UserScope
+		want  *UserScope
+	}{
+		{
+			scope: testUserScope(),
+			want:  testUserScope(),
+		},
+		{
+			scope: s,
+			want:  new(UserScope),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.scope.SetID(test.want.GetID())
+		test.scope.SetUserID(test.want.GetUserID())
+		test.scope.SetScopes(test.want.GetScopes())
+		test.scope.SetRequired(test.want.GetRequired())
+		test.scope.SetMissing(test.want.GetMissing())
+		test.scope.SetConsentURL(test.want.GetConsentURL())
+		test.scope.SetUpdatedAt(test.want.GetUpdatedAt())
+
+		if test.scope.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.scope.GetID(), test.want.GetID())
+		}
+
+		if test.scope.GetUserID() != test.want.GetUserID() {
+			t.Errorf("SetUserID is %v, want %v", test.scope.GetUserID(), test.want.GetUserID())
+		}
+
+		if !reflect.DeepEqual(test.scope.GetScopes(), test.want.GetScopes()) {
+			t.Errorf("SetScopes is %v, want %v", test.scope.GetScopes(), test.want.GetScopes())
+		}
+
+		if !reflect.DeepEqual(test.scope.GetRequired(), test.want.GetRequired()) {
+			t.Errorf("SetRequired is %v, want %v", test.scope.GetRequired(), test.want.GetRequired())
+		}
+
+		if !reflect.DeepEqual(test.scope.GetMissing(), test.want.GetMissing()) {
+			t.Errorf("SetMissing is %v, want %v", test.scope.GetMissing(), test.want.GetMissing())
+		}
+
+		if test.scope.GetConsentURL() != test.want.GetConsentURL() {
+			t.Errorf("SetConsentURL is %v, want %v", test.scope.GetConsentURL(), test.want.GetConsentURL())
+		}
+
+		if test.scope.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.scope.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+	}
+}
+
+func TestUserScope_String(t *testing.T) {
+	// setup types
+	s := testUserScope()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  UserID: %d,
+  Scopes: %s,
+  Required: %s,
+  Missing: %s,
+  ConsentURL: %s,
+  UpdatedAt: %d,
+}`,
+		s.GetID(),
+		s.GetUserID(),
+		s.GetScopes(),
+		s.GetRequired(),
+		s.GetMissing(),
+		s.GetConsentURL(),
+		s.GetUpdatedAt(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testUserScope is a test helper function to create a UserScope
+// type with all fields set to a fake value.
+func testUserScope() *UserScope {
+	s := new(UserScope)
+
+	s.SetID(1)
+	s.SetUserID(1)
+	s.SetScopes([]string{"repo", "read:org"})
+	s.SetRequired([]string{"repo", "read:org", "user:email"})
+	s.SetMissing([]string{"user:email"})
+	s.SetConsentURL("https://vela.example.com/login?type=web")
+	s.SetUpdatedAt(1563474076)
+
+	return s
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// UserSessionLogin represents a session
+	// started by the user logging in.
+	UserSessionLogin = "login"
+
+	// UserSessionRefresh represents a session extended
+	// by the user refreshing their access token.
+	UserSessionRefresh = "refresh"
+)
+
+// UserSession is the API representation of a record of a user
+// logging in or refreshing their access token, enriched with
+// details about the client used to make the request.
+//
+// swagger:model UserSession
+type UserSession struct {
+	ID        *int64  `json:"id,omitempty"`
+	UserID    *int64  `json:"user_id,omitempty"`
+	Action    *string `json:"action,omitempty"`
+	IP        *string `json:"ip,omitempty"`
+	UserAgent *string `json:"user_agent,omitempty"`
+	Client    *string `json:"client,omitempty"`
+	Location  *string `json:"location,omitempty"`
+	Created   *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetID() int64 {
+	// return zero value if UserSession type or ID field is nil
+	if s == nil || s.ID == nil {
+		return 0
+	}
+
+	return *s.ID
+}
+
+// GetUserID returns the UserID field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetUserID() int64 {
+	// return zero value if UserSession type or UserID field is nil
+	if s == nil || s.UserID == nil {
+		return 0
+	}
+
+	return *s.UserID
+}
+
+// GetAction returns the Action field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetAction() string {
+	// return zero value if UserSession type or Action field is nil
+	if s == nil || s.Action == nil {
+		return ""
+	}
+
+	return *s.Action
+}
+
+// GetIP returns the IP field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetIP() string {
+	// return zero value if UserSession type or IP field is nil
+	if s == nil || s.IP == nil {
+		return ""
+	}
+
+	return *s.IP
+}
+
+// GetUserAgent returns the UserAgent field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetUserAgent() string {
+	// return zero value if UserSession type or UserAgent field is nil
+	if s == nil || s.UserAgent == nil {
+		return ""
+	}
+
+	return *s.UserAgent
+}
+
+// GetClient returns the Client field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetClient() string {
+	// return zero value if UserSession type or Client field is nil
+	if s == nil || s.Client == nil {
+		return ""
+	}
+
+	return *s.Client
+}
+
+// GetLocation returns the Location field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetLocation() string {
+	// return zero value if UserSession type or Location field is nil
+	if s == nil || s.Location == nil {
+		return ""
+	}
+
+	return *s.Location
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided UserSession type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (s *UserSession) GetCreated() int64 {
+	// return zero value if UserSession type or Created field is nil
+	if s == nil || s.Created == nil {
+		return 0
+	}
+
+	return *s.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetID(v int64) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.ID = &v
+}
+
+// SetUserID sets the UserID field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetUserID(v int64) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.UserID = &v
+}
+
+// SetAction sets the Action field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetAction(v string) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.Action = &v
+}
+
+// SetIP sets the IP field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetIP(v string) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.IP = &v
+}
+
+// SetUserAgent sets the UserAgent field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetUserAgent(v string) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.UserAgent = &v
+}
+
+// SetClient sets the Client field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetClient(v string) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.Client = &v
+}
+
+// SetLocation sets the Location field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetLocation(v string) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.Location = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided UserSession type is nil, it
+// will set nothing and immediately return.
+func (s *UserSession) SetCreated(v int64) {
+	// return if UserSession type is nil
+	if s == nil {
+		return
+	}
+
+	s.Created = &v
+}
+
+// String implements the Stringer interface for the UserSession type.
+func (s *UserSession) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  UserID: %d,
+  Action: %s,
+  IP: %s,
+  UserAgent: %s,
+  Client: %s,
+  Location: %s,
+  Created: %d,
+}`,
+		s.GetID(),
+		s.GetUserID(),
+		s.GetAction(),
+		s.GetIP(),
+		s.GetUserAgent(),
+		s.GetClient(),
+		s.GetLocation(),
+		s.GetCreated(),
+	)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestUserSession_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		session *UserSession
+		want    *UserSession
+	}{
+		{
+			session: testUserSession(),
+			want:    testUserSession(),
+		},
+		{
+			session: new(UserSession),
+			want:    new(UserSession),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.session.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.session.GetID(), test.want.GetID())
+		}
+
+		if test.session.GetUserID() != test.want.GetUserID() {
+			t.Errorf("GetUserID is %v, want %v", test.session.GetUserID(), test.want.GetUserID())
+		}
+
+		if test.session.GetAction() != test.want.GetAction() {
+			t.Errorf("GetAction is %v, want %v", test.session.GetAction(), test.want.GetAction())
+		}
+
+		if test.session.GetIP() != test.want.GetIP() {
+			t.Errorf("GetIP is %v, want %v", test.session.GetIP(), test.want.GetIP())
+		}
+
+		if test.session.GetUserAgent() != test.want.GetUserAgent() {
+			t.Errorf("GetUserAgent is %v, want %v", test.session.GetUserAgent(), test.want.GetUserAgent())
+		}
+
+		if test.session.GetClient() != test.want.GetClient() {
+			t.Errorf("GetClient is %v, want %v", test.session.GetClient(), test.want.GetClient())
+		}
+
+		if test.session.GetLocation() != test.want.GetLocation() {
+			t.Errorf("GetLocation is %v, want %v", test.session.GetLocation(), test.want.GetLocation())
+		}
+
+		if test.session.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.session.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestUserSession_Setters(t *testing.T) {
+	// setup types
+	var s *UserSession
+
+	// setup tests
+	tests := []struct {
+		session *UserSession
+		want    *UserSession
+	}{
+		{
+			session: testUserSession(),
+			want:    testUserSession(),
+		},
+		{
+			session: s,
+			want:    new(UserSession),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.session.SetID(test.want.GetID())
+		test.session.SetUserID(test.want.GetUserID())
+		test.session.SetAction(test.want.GetAction())
+		test.session.SetIP(test.want.GetIP())
+		test.session.SetUserAgent(test.want.GetUserAgent())
+		test.session.SetClient(test.want.GetClient())
+		test.session.SetLocation(test.want.GetLocation())
+		test.session.SetCreated(test.want.GetCreated())
+
+		if test.session.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.session.GetID(), test.want.GetID())
+		}
+
+		if test.session.GetUserID() != test.want.GetUserID() {
+			t.Errorf("SetUserID is %v, want %v", test.session.GetUserID(), test.want.GetUserID())
+		}
+
+		if test.session.GetAction() != test.want.GetAction() {
+			t.Errorf("SetAction is %v, want %v", test.session.GetAction(), test.want.GetAction())
+		}
+
+		if test.session.GetIP() != test.want.GetIP() {
+			t.Errorf("SetIP is %v, want %v", test.session.GetIP(), test.want.GetIP())
+		}
+
+		if test.session.GetUserAgent() != test.want.GetUserAgent() {
+			t.Errorf("SetUserAgent is %v, want %v", test.session.GetUserAgent(), test.want.GetUserAgent())
+		}
+
+		if test.session.GetClient() != test.want.GetClient() {
+			t.Errorf("SetClient is %v, want %v", test.session.GetClient(), test.want.GetClient())
+		}
+
+		if test.session.GetLocation() != test.want.GetLocation() {
+			t.Errorf("SetLocation is %v, want %v", test.session.GetLocation(), test.want.GetLocation())
+		}
+
+		if test.session.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.session.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestUserSession_String(t *testing.T) {
+	// setup types
+	s := testUserSession()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  UserID: %d,
+  Action: %s,
+  IP: %s,
+  UserAgent: %s,
+  Client: %s,
+  Location: %s,
+  Created: %d,
+}`,
+		s.GetID(),
+		s.GetUserID(),
+		s.GetAction(),
+		s.GetIP(),
+		s.GetUserAgent(),
+		s.GetClient(),
+		s.GetLocation(),
+		s.GetCreated(),
+	)
+
+	// run test
+	got := s.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testUserSession is a test helper function to create a UserSession
+// type with all fields set to a fake value.
+func testUserSession() *UserSession {
+	s := new(UserSession)
+
+	s.SetID(1)
+	s.SetUserID(1)
+	s.SetAction("login")
+	s.SetIP("1.2.3.4")
+	s.SetUserAgent("vela-cli")
+	s.SetClient("Vela CLI")
+	s.SetLocation("Minneapolis, Minnesota, US")
+	s.SetCreated(1563474076)
+
+	return s
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+// WebhookDelivery is the API representation of a webhook
+// delivered by the scm, recorded to reject replayed deliveries.
+//
+// swagger:model WebhookDelivery
+type WebhookDelivery struct {
+	ID         *int64  `json:"id,omitempty"`
+	Source     *string `json:"source,omitempty"`
+	DeliveryID *string `json:"delivery_id,omitempty"`
+	RepoID     *int64  `json:"repo_id,omitempty"`
+	Created    *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided WebhookDelivery type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *WebhookDelivery) GetID() int64 {
+	// return zero value if WebhookDelivery type or ID field is nil
+	if d == nil || d.ID == nil {
+		return 0
+	}
+
+	return *d.ID
+}
+
+// GetSource returns the Source field.
+//
+// When the provided WebhookDelivery type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *WebhookDelivery) GetSource() string {
+	// return zero value if WebhookDelivery type or Source field is nil
+	if d == nil || d.Source == nil {
+		return ""
+	}
+
+	return *d.Source
+}
+
+// GetDeliveryID returns the DeliveryID field.
+//
+// When the provided WebhookDelivery type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *WebhookDelivery) GetDeliveryID() string {
+	// return zero value if WebhookDelivery type or DeliveryID field is nil
+	if d == nil || d.DeliveryID == nil {
+		return ""
+	}
+
+	return *d.DeliveryID
+}
+
+// GetRepoID returns the RepoID field.
+//
+// When the provided WebhookDelivery type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *WebhookDelivery) GetRepoID() int64 {
+	// return zero value if WebhookDelivery type or RepoID field is nil
+	if d == nil || d.RepoID == nil {
+		return 0
+	}
+
+	return *d.RepoID
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided WebhookDelivery type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (d *WebhookDelivery) GetCreated() int64 {
+	// return zero value if WebhookDelivery type or Created field is nil
+	if d == nil || d.Created == nil {
+		return 0
+	}
+
+	return *d.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided WebhookDelivery type is nil, it
+// will set nothing and immediately return.
+func (d *WebhookDelivery) SetID(v int64) {
+	// return if WebhookDelivery type is nil
+	if d == nil {
+		return
+	}
+
+	d.ID = &v
+}
+
+// SetSource sets the Source field.
+//
+// When the provided WebhookDelivery type is nil, it
+// will set nothing and immediately return.
+func (d *WebhookDelivery) SetSource(v string) {
+	// return if WebhookDelivery type is nil
+	if d == nil {
+		return
+	}
+
+	d.Source = &v
+}
+
+// SetDeliveryID sets the DeliveryID field.
+//
+// When the provided WebhookDelivery type is nil, it
+// will set nothing and immediately return.
+func (d *WebhookDelivery) SetDeliveryID(v string) {
+	// return if WebhookDelivery type is nil
+	if d == nil {
+		return
+	}
+
+	d.DeliveryID = &v
+}
+
+// SetRepoID sets the RepoID field.
+//
+// When the provided WebhookDelivery type is nil, it
+// will set nothing and immediately return.
+func (d *WebhookDelivery) SetRepoID(v int64) {
+	// return if WebhookDelivery type is nil
+	if d == nil {
+		return
+	}
+
+	d.RepoID = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided WebhookDelivery type is nil, it
+// will set nothing and immediately return.
+func (d *WebhookDelivery) SetCreated(v int64) {
+	// return if WebhookDelivery type is nil
+	if d == nil {
+		return
+	}
+
+	d.Created = &v
+}
+
+// String implements the Stringer interface for the WebhookDelivery type.
+func (d *WebhookDelivery) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Source: %s,
+  DeliveryID: %s,
+  RepoID: %d,
+  Created: %d,
+}`,
+		d.GetID(),
+		d.GetSource(),
+		d.GetDeliveryID(),
+		d.GetRepoID(),
+		d.GetCreated(),
+	)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestWebhookDelivery_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		delivery *WebhookDelivery
+		want     *WebhookDelivery
+	}{
+		{
+			delivery: testWebhookDelivery(),
+			want:     testWebhookDelivery(),
+		},
+		{
+			delivery: new(WebhookDelivery),
+			want:     new(WebhookDelivery),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.delivery.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.delivery.GetID(), test.want.GetID())
+		}
+
+		if test.delivery.GetSource() != test.want.GetSource() {
+			t.Errorf("GetSource is %v, want %v", test.delivery.GetSource(), test.want.GetSource())
+		}
+
+		if test.delivery.GetDeliveryID() != test.want.GetDeliveryID() {
+			t.Errorf("GetDeliveryID is %v, want %v", test.delivery.GetDeliveryID(), test.want.GetDeliveryID())
+		}
+
+		if test.delivery.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("GetRepoID is %v, want %v", test.delivery.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.delivery.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.delivery.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestWebhookDelivery_Setters(t *testing.T) {
+	// setup types
+	var d *WebhookDelivery
+
+	// setup tests
+	tests := []struct {
+		delivery *WebhookDelivery
+		want     *WebhookDelivery
+	}{
+		{
+			delivery: testWebhookDelivery(),
+			want:     testWebhookDelivery(),
+		},
+		{
+			delivery: d,
+			want:     new(WebhookDelivery),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.delivery.SetID(test.want.GetID())
+		test.delivery.SetSource(test.want.GetSource())
+		test.delivery.SetDeliveryID(test.want.GetDeliveryID())
+		test.delivery.SetRepoID(test.want.GetRepoID())
+		test.delivery.SetCreated(test.want.GetCreated())
+
+		if test.delivery.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.delivery.GetID(), test.want.GetID())
+		}
+
+		if test.delivery.GetSource() != test.want.GetSource() {
+			t.Errorf("SetSource is %v, want %v", test.delivery.GetSource(), test.want.GetSource())
+		}
+
+		if test.delivery.GetDeliveryID() != test.want.GetDeliveryID() {
+			t.Errorf("SetDeliveryID is %v, want %v", test.delivery.GetDeliveryID(), test.want.GetDeliveryID())
+		}
+
+		if test.delivery.GetRepoID() != test.want.GetRepoID() {
+			t.Errorf("SetRepoID is %v, want %v", test.delivery.GetRepoID(), test.want.GetRepoID())
+		}
+
+		if test.delivery.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.delivery.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestWebhookDelivery_String(t *testing.T) {
+	// setup types
+	d := testWebhookDelivery()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Source: %s,
+  DeliveryID: %s,
+  RepoID: %d,
+  Created: %d,
+}`,
+		d.GetID(),
+		d.GetSource(),
+		d.GetDeliveryID(),
+		d.GetRepoID(),
+		d.GetCreated(),
+	)
+
+	// run test
+	got := d.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testWebhookDelivery is a test helper function to create a WebhookDelivery
+// type with all fields set to a fake value.
+func testWebhookDelivery() *WebhookDelivery {
+	d := new(WebhookDelivery)
+
+	d.SetID(1)
+	d.SetSource("github")
+	d.SetDeliveryID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	d.SetRepoID(1)
+	d.SetCreated(1563474076)
+
+	return d
+}
@@ -0,0 +1,297 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// WebhookIntakePending represents a webhook in the intake
+	// that is waiting to be processed or retried.
+	WebhookIntakePending = "pending"
+
+	// WebhookIntakeFailed represents a webhook in the intake
+	// that failed to be processed after all of the retries.
+	WebhookIntakeFailed = "failed"
+)
+
+// WebhookIntake is the API representation of a webhook received from
+// the scm that is buffered in the intake to be processed asynchronously.
+// The webhook is not available to be claimed for processing until the
+// available timestamp, which delays retries and hides the webhook from
+// other replicas while it is being processed.
+//
+// swagger:model WebhookIntake
+type WebhookIntake struct {
+	ID        *int64  `json:"id,omitempty"`
+	Source    *string `json:"source,omitempty"`
+	Header    *string `json:"header,omitempty"`
+	Payload   *string `json:"payload,omitempty"`
+	Status    *string `json:"status,omitempty"`
+	Attempts  *int64  `json:"attempts,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	Available *int64  `json:"available,omitempty"`
+	Created   *int64  `json:"created,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetID() int64 {
+	// return zero value if WebhookIntake type or ID field is nil
+	if w == nil || w.ID == nil {
+		return 0
+	}
+
+	return *w.ID
+}
+
+// GetSource returns the Source field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetSource() string {
+	// return zero value if WebhookIntake type or Source field is nil
+	if w == nil || w.Source == nil {
+		return ""
+	}
+
+	return *w.Source
+}
+
+// GetHeader returns the Header field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetHeader() string {
+	// return zero value if WebhookIntake type or Header field is nil
+	if w == nil || w.Header == nil {
+		return ""
+	}
+
+	return *w.Header
+}
+
+// GetPayload returns the Payload field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetPayload() string {
+	// return zero value if WebhookIntake type or Payload field is nil
+	if w == nil || w.Payload == nil {
+		return ""
+	}
+
+	return *w.Payload
+}
+
+// GetStatus returns the Status field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetStatus() string {
+	// return zero value if WebhookIntake type or Status field is nil
+	if w == nil || w.Status == nil {
+		return ""
+	}
+
+	return *w.Status
+}
+
+// GetAttempts returns the Attempts field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetAttempts() int64 {
+	// return zero value if WebhookIntake type or Attempts field is nil
+	if w == nil || w.Attempts == nil {
+		return 0
+	}
+
+	return *w.Attempts
+}
+
+// GetError returns the Error field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetError() string {
+	// return zero value if WebhookIntake type or Error field is nil
+	if w == nil || w.Error == nil {
+		return ""
+	}
+
+	return *w.Error
+}
+
+// GetAvailable returns the Available field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetAvailable() int64 {
+	// return zero value if WebhookIntake type or Available field is nil
+	if w == nil || w.Available == nil {
+		return 0
+	}
+
+	return *w.Available
+}
+
+// GetCreated returns the Created field.
+//
+// When the provided WebhookIntake type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (w *WebhookIntake) GetCreated() int64 {
+	// return zero value if WebhookIntake type or Created field is nil
+	if w == nil || w.Created == nil {
+		return 0
+	}
+
+	return *w.Created
+}
+
+// SetID sets the ID field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetID(v int64) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.ID = &v
+}
+
+// SetSource sets the Source field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetSource(v string) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Source = &v
+}
+
+// SetHeader sets the Header field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetHeader(v string) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Header = &v
+}
+
+// SetPayload sets the Payload field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetPayload(v string) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Payload = &v
+}
+
+// SetStatus sets the Status field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetStatus(v string) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Status = &v
+}
+
+// SetAttempts sets the Attempts field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetAttempts(v int64) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Attempts = &v
+}
+
+// SetError sets the Error field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetError(v string) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Error = &v
+}
+
+// SetAvailable sets the Available field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetAvailable(v int64) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Available = &v
+}
+
+// SetCreated sets the Created field.
+//
+// When the provided WebhookIntake type is nil, it
+// will set nothing and immediately return.
+func (w *WebhookIntake) SetCreated(v int64) {
+	// return if WebhookIntake type is nil
+	if w == nil {
+		return
+	}
+
+	w.Created = &v
+}
+
+// String implements the Stringer interface for the WebhookIntake type.
+func (w *WebhookIntake) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Source: %s,
+  Header: %s,
+  Payload: %s,
+  Status: %s,
+  Attempts: %d,
+  Error: %s,
+  Available: %d,
+  Created: %d,
+}`,
+		w.GetID(),
+		w.GetSource(),
+		w.GetHeader(),
+		w.GetPayload(),
+		w.GetStatus(),
+		w.GetAttempts(),
+		w.GetError(),
+		w.GetAvailable(),
+		w.GetCreated(),
+	)
+}
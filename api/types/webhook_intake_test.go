@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestWebhookIntake_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		intake *WebhookIntake
+		want   *WebhookIntake
+	}{
+		{
+			intake: testWebhookIntake(),
+			want:   testWebhookIntake(),
+		},
+		{
+			intake: new(WebhookIntake),
+			want:   new(WebhookIntake),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.intake.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.intake.GetID(), test.want.GetID())
+		}
+
+		if test.intake.GetSource() != test.want.GetSource() {
+			t.Errorf("GetSource is %v, want %v", test.intake.GetSource(), test.want.GetSource())
+		}
+
+		if test.intake.GetHeader() != test.want.GetHeader() {
+			t.Errorf("GetHeader is %v, want %v", test.intake.GetHeader(), test.want.GetHeader())
+		}
+
+		if test.intake.GetPayload() != test.want.GetPayload() {
+			t.Errorf("GetPayload is %v, want %v", test.intake.GetPayload(), test.want.GetPayload())
+		}
+
+		if test.intake.GetStatus() != test.want.GetStatus() {
+			t.Errorf("GetStatus is %v, want %v", test.intake.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.intake.GetAttempts() != test.want.GetAttempts() {
+			t.Errorf("GetAttempts is %v, want %v", test.intake.GetAttempts(), test.want.GetAttempts())
+		}
+
+		if test.intake.GetError() != test.want.GetError() {
+			t.Errorf("GetError is %v, want %v", test.intake.GetError(), test.want.GetError())
+		}
+
+		if test.intake.GetAvailable() != test.want.GetAvailable() {
+			t.Errorf("GetAvailable is %v, want %v", test.intake.GetAvailable(), test.want.GetAvailable())
+		}
+
+		if test.intake.GetCreated() != test.want.GetCreated() {
+			t.Errorf("GetCreated is %v, want %v", test.intake.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestWebhookIntake_Setters(t *testing.T) {
+	// setup types
+	var w *WebhookIntake
+
+	// setup tests
+	tests := []struct {
+		intake *WebhookIntake
+		want   *WebhookIntake
+	}{
+		{
+			intake: testWebhookIntake(),
+			want:   testWebhookIntake(),
+		},
+		{
+			intake: w,
+			want:   new(WebhookIntake),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.intake.SetID(test.want.GetID())
+		test.intake.SetSource(test.want.GetSource())
+		test.intake.SetHeader(test.want.GetHeader())
+		test.intake.SetPayload(test.want.GetPayload())
+		test.intake.SetStatus(test.want.GetStatus())
+		test.intake.SetAttempts(test.want.GetAttempts())
+		test.intake.SetError(test.want.GetError())
+		test.intake.SetAvailable(test.want.GetAvailable())
+		test.intake.SetCreated(test.want.GetCreated())
+
+		if test.intake.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.intake.GetID(), test.want.GetID())
+		}
+
+		if test.intake.GetSource() != test.want.GetSource() {
+			t.Errorf("SetSource is %v, want %v", test.intake.GetSource(), test.want.GetSource())
+		}
+
+		if test.intake.GetHeader() != test.want.GetHeader() {
+			t.Errorf("SetHeader is %v, want %v", test.intake.GetHeader(), test.want.GetHeader())
+		}
+
+		if test.intake.GetPayload() != test.want.GetPayload() {
+			t.Errorf("SetPayload is %v, want %v", test.intake.GetPayload(), test.want.GetPayload())
+		}
+
+		if test.intake.GetStatus() != test.want.GetStatus() {
+			t.Errorf("SetStatus is %v, want %v", test.intake.GetStatus(), test.want.GetStatus())
+		}
+
+		if test.intake.GetAttempts() != test.want.GetAttempts() {
+			t.Errorf("SetAttempts is %v, want %v", test.intake.GetAttempts(), test.want.GetAttempts())
+		}
+
+		if test.intake.GetError() != test.want.GetError() {
+			t.Errorf("SetError is %v, want %v", test.intake.GetError(), test.want.GetError())
+		}
+
+		if test.intake.GetAvailable() != test.want.GetAvailable() {
+			t.Errorf("SetAvailable is %v, want %v", test.intake.GetAvailable(), test.want.GetAvailable())
+		}
+
+		if test.intake.GetCreated() != test.want.GetCreated() {
+			t.Errorf("SetCreated is %v, want %v", test.intake.GetCreated(), test.want.GetCreated())
+		}
+	}
+}
+
+func TestWebhookIntake_String(t *testing.T) {
+	// setup types
+	w := testWebhookIntake()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Source: %s,
+  Header: %s,
+  Payload: %s,
+  Status: %s,
+  Attempts: %d,
+  Error: %s,
+  Available: %d,
+  Created: %d,
+}`,
+		w.GetID(),
+		w.GetSource(),
+		w.GetHeader(),
+		w.GetPayload(),
+		w.GetStatus(),
+		w.GetAttempts(),
+		w.GetError(),
+		w.GetAvailable(),
+		w.GetCreated(),
+	)
+
+	// run test
+	got := w.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testWebhookIntake is a test helper function to create a WebhookIntake
+// type with all fields set to a fake value.
+func testWebhookIntake() *WebhookIntake {
+	w := new(WebhookIntake)
+
+	w.SetID(1)
+	w.SetSource("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	w.SetHeader("{}")
+	w.SetPayload("{}")
+	w.SetStatus("pending")
+	w.SetAttempts(1)
+	w.SetError("")
+	w.SetAvailable(1563474086)
+	w.SetCreated(1563474076)
+
+	return w
+}
@@ -0,0 +1,395 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WorkerGroup is the API representation of a pool of workers dedicated
+// to the builds for a set of orgs and repos.
+//
+// swagger:model WorkerGroup
+type WorkerGroup struct {
+	ID          *int64    `json:"id,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Route       *string   `json:"route,omitempty"`
+	BuildLimit  *int64    `json:"build_limit,omitempty"`
+	Orgs        *[]string `json:"orgs,omitempty"`
+	Repos       *[]string `json:"repos,omitempty"`
+	Active      *bool     `json:"active,omitempty"`
+	CreatedAt   *int64    `json:"created_at,omitempty"`
+	CreatedBy   *string   `json:"created_by,omitempty"`
+	UpdatedAt   *int64    `json:"updated_at,omitempty"`
+	UpdatedBy   *string   `json:"updated_by,omitempty"`
+}
+
+// GetID returns the ID field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetID() int64 {
+	// return zero value if WorkerGroup type or ID field is nil
+	if g == nil || g.ID == nil {
+		return 0
+	}
+
+	return *g.ID
+}
+
+// GetName returns the Name field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetName() string {
+	// return zero value if WorkerGroup type or Name field is nil
+	if g == nil || g.Name == nil {
+		return ""
+	}
+
+	return *g.Name
+}
+
+// GetDescription returns the Description field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetDescription() string {
+	// return zero value if WorkerGroup type or Description field is nil
+	if g == nil || g.Description == nil {
+		return ""
+	}
+
+	return *g.Description
+}
+
+// GetRoute returns the Route field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetRoute() string {
+	// return zero value if WorkerGroup type or Route field is nil
+	if g == nil || g.Route == nil {
+		return ""
+	}
+
+	return *g.Route
+}
+
+// GetBuildLimit returns the BuildLimit field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetBuildLimit() int64 {
+	// return zero value if WorkerGroup type or BuildLimit field is nil
+	if g == nil || g.BuildLimit == nil {
+		return 0
+	}
+
+	return *g.BuildLimit
+}
+
+// GetOrgs returns the Orgs field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetOrgs() []string {
+	// return zero value if WorkerGroup type or Orgs field is nil
+	if g == nil || g.Orgs == nil {
+		return []string{}
+	}
+
+	return *g.Orgs
+}
+
+// GetRepos returns the Repos field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetRepos() []string {
+	// return zero value if WorkerGroup type or Repos field is nil
+	if g == nil || g.Repos == nil {
+		return []string{}
+	}
+
+	return *g.Repos
+}
+
+// GetActive returns the Active field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetActive() bool {
+	// return zero value if WorkerGroup type or Active field is nil
+	if g == nil || g.Active == nil {
+		return false
+	}
+
+	return *g.Active
+}
+
+// GetCreatedAt returns the CreatedAt field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetCreatedAt() int64 {
+	// return zero value if WorkerGroup type or CreatedAt field is nil
+	if g == nil || g.CreatedAt == nil {
+		return 0
+	}
+
+	return *g.CreatedAt
+}
+
+// GetCreatedBy returns the CreatedBy field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetCreatedBy() string {
+	// return zero value if WorkerGroup type or CreatedBy field is nil
+	if g == nil || g.CreatedBy == nil {
+		return ""
+	}
+
+	return *g.CreatedBy
+}
+
+// GetUpdatedAt returns the UpdatedAt field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetUpdatedAt() int64 {
+	// return zero value if WorkerGroup type or UpdatedAt field is nil
+	if g == nil || g.UpdatedAt == nil {
+		return 0
+	}
+
+	return *g.UpdatedAt
+}
+
+// GetUpdatedBy returns the UpdatedBy field.
+//
+// When the provided WorkerGroup type is nil, or the field within
+// the type is nil, it returns the zero value for the field.
+func (g *WorkerGroup) GetUpdatedBy() string {
+	// return zero value if WorkerGroup type or UpdatedBy field is nil
+	if g == nil || g.UpdatedBy == nil {
+		return ""
+	}
+
+	return *g.UpdatedBy
+}
+
+// SetID sets the ID field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetID(v int64) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.ID = &v
+}
+
+// SetName sets the Name field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetName(v string) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.Name = &v
+}
+
+// SetDescription sets the Description field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetDescription(v string) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.Description = &v
+}
+
+// SetRoute sets the Route field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetRoute(v string) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.Route = &v
+}
+
+// SetBuildLimit sets the BuildLimit field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetBuildLimit(v int64) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.BuildLimit = &v
+}
+
+// SetOrgs sets the Orgs field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetOrgs(v []string) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.Orgs = &v
+}
+
+// SetRepos sets the Repos field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetRepos(v []string) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.Repos = &v
+}
+
+// SetActive sets the Active field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetActive(v bool) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.Active = &v
+}
+
+// SetCreatedAt sets the CreatedAt field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetCreatedAt(v int64) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.CreatedAt = &v
+}
+
+// SetCreatedBy sets the CreatedBy field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetCreatedBy(v string) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.CreatedBy = &v
+}
+
+// SetUpdatedAt sets the UpdatedAt field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetUpdatedAt(v int64) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.UpdatedAt = &v
+}
+
+// SetUpdatedBy sets the UpdatedBy field.
+//
+// When the provided WorkerGroup type is nil, it
+// will set nothing and immediately return.
+func (g *WorkerGroup) SetUpdatedBy(v string) {
+	// return if WorkerGroup type is nil
+	if g == nil {
+		return
+	}
+
+	g.UpdatedBy = &v
+}
+
+// PinsOrg returns true when the org is pinned to the WorkerGroup.
+func (g *WorkerGroup) PinsOrg(org string) bool {
+	for _, o := range g.GetOrgs() {
+		if strings.EqualFold(o, org) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PinsRepo returns true when the repo, by its full name,
+// is pinned to the WorkerGroup.
+func (g *WorkerGroup) PinsRepo(fullName string) bool {
+	for _, r := range g.GetRepos() {
+		if strings.EqualFold(r, fullName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface for the WorkerGroup type.
+func (g *WorkerGroup) String() string {
+	return fmt.Sprintf(`{
+  ID: %d,
+  Name: %s,
+  Description: %s,
+  Route: %s,
+  BuildLimit: %d,
+  Orgs: %s,
+  Repos: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		g.GetID(),
+		g.GetName(),
+		g.GetDescription(),
+		g.GetRoute(),
+		g.GetBuildLimit(),
+		g.GetOrgs(),
+		g.GetRepos(),
+		g.GetActive(),
+		g.GetCreatedAt(),
+		g.GetCreatedBy(),
+		g.GetUpdatedAt(),
+		g.GetUpdatedBy(),
+	)
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestWorkerGroup_Getters(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		wg   *WorkerGroup
+		want *WorkerGroup
+	}{
+		{
+			wg:   testWorkerGroup(),
+			want: testWorkerGroup(),
+		},
+		{
+			wg:   new(WorkerGroup),
+			want: new(WorkerGroup),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if test.wg.GetID() != test.want.GetID() {
+			t.Errorf("GetID is %v, want %v", test.wg.GetID(), test.want.GetID())
+		}
+
+		if test.wg.GetName() != test.want.GetName() {
+			t.Errorf("GetName is %v, want %v", test.wg.GetName(), test.want.GetName())
+		}
+
+		if test.wg.GetDescription() != test.want.GetDescription() {
+			t.Errorf("GetDescription is %v, want %v", test.wg.GetDescription(), test.want.GetDescription())
+		}
+
+		if test.wg.GetRoute() != test.want.GetRoute() {
+			t.Errorf("GetRoute is %v, want %v", test.wg.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.wg.GetBuildLimit() != test.want.GetBuildLimit() {
+			t.Errorf("GetBuildLimit is %v, want %v", test.wg.GetBuildLimit(), test.want.GetBuildLimit())
+		}
+
+		if !reflect.DeepEqual(test.wg.GetOrgs(), test.want.GetOrgs()) {
+			t.Errorf("GetOrgs is %v, want %v", test.wg.GetOrgs(), test.want.GetOrgs())
+		}
+
+		if !reflect.DeepEqual(test.wg.GetRepos(), test.want.GetRepos()) {
+			t.Errorf("GetRepos is %v, want %v", test.wg.GetRepos(), test.want.GetRepos())
+		}
+
+		if test.wg.GetActive() != test.want.GetActive() {
+			t.Errorf("GetActive is %v, want %v", test.wg.GetActive(), test.want.GetActive())
+		}
+
+		if test.wg.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("GetCreatedAt is %v, want %v", test.wg.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.wg.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("GetCreatedBy is %v, want %v", test.wg.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.wg.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("GetUpdatedAt is %v, want %v", test.wg.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.wg.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("GetUpdatedBy is %v, want %v", test.wg.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestWorkerGroup_Setters(t *testing.T) {
+	// setup types
+	var w *WorkerGroup
+
+	// setup tests
+	tests := []struct {
+		wg   *WorkerGroup
+		want *WorkerGroup
+	}{
+		{
+			wg:   testWorkerGroup(),
+			want: testWorkerGroup(),
+		},
+		{
+			wg:   w,
+			want: new(WorkerGroup),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		test.wg.SetID(test.want.GetID())
+		test.wg.SetName(test.want.GetName())
+		test.wg.SetDescription(test.want.GetDescription())
+		test.wg.SetRoute(test.want.GetRoute())
+		test.wg.SetBuildLimit(test.want.GetBuildLimit())
+		test.wg.SetOrgs(test.want.GetOrgs())
+		test.wg.SetRepos(test.want.GetRepos())
+		test.wg.SetActive(test.want.GetActive())
+		test.wg.SetCreatedAt(test.want.GetCreatedAt())
+		test.wg.SetCreatedBy(test.want.GetCreatedBy())
+		test.wg.SetUpdatedAt(test.want.GetUpdatedAt())
+		test.wg.SetUpdatedBy(test.want.GetUpdatedBy())
+
+		if test.wg.GetID() != test.want.GetID() {
+			t.Errorf("SetID is %v, want %v", test.wg.GetID(), test.want.GetID())
+		}
+
+		if test.wg.GetName() != test.want.GetName() {
+			t.Errorf("SetName is %v, want %v", test.wg.GetName(), test.want.GetName())
+		}
+
+		if test.wg.GetDescription() != test.want.GetDescription() {
+			t.Errorf("SetDescription is %v, want %v", test.wg.GetDescription(), test.want.GetDescription())
+		}
+
+		if test.wg.GetRoute() != test.want.GetRoute() {
+			t.Errorf("SetRoute is %v, want %v", test.wg.GetRoute(), test.want.GetRoute())
+		}
+
+		if test.wg.GetBuildLimit() != test.want.GetBuildLimit() {
+			t.Errorf("SetBuildLimit is %v, want %v", test.wg.GetBuildLimit(), test.want.GetBuildLimit())
+		}
+
+		if !reflect.DeepEqual(test.wg.GetOrgs(), test.want.GetOrgs()) {
+			t.Errorf("SetOrgs is %v, want %v", test.wg.GetOrgs(), test.want.GetOrgs())
+		}
+
+		if !reflect.DeepEqual(test.wg.GetRepos(), test.want.GetRepos()) {
+			t.Errorf("SetRepos is %v, want %v", test.wg.GetRepos(), test.want.GetRepos())
+		}
+
+		if test.wg.GetActive() != test.want.GetActive() {
+			t.Errorf("SetActive is %v, want %v", test.wg.GetActive(), test.want.GetActive())
+		}
+
+		if test.wg.GetCreatedAt() != test.want.GetCreatedAt() {
+			t.Errorf("SetCreatedAt is %v, want %v", test.wg.GetCreatedAt(), test.want.GetCreatedAt())
+		}
+
+		if test.wg.GetCreatedBy() != test.want.GetCreatedBy() {
+			t.Errorf("SetCreatedBy is %v, want %v", test.wg.GetCreatedBy(), test.want.GetCreatedBy())
+		}
+
+		if test.wg.GetUpdatedAt() != test.want.GetUpdatedAt() {
+			t.Errorf("SetUpdatedAt is %v, want %v", test.wg.GetUpdatedAt(), test.want.GetUpdatedAt())
+		}
+
+		if test.wg.GetUpdatedBy() != test.want.GetUpdatedBy() {
+			t.Errorf("SetUpdatedBy is %v, want %v", test.wg.GetUpdatedBy(), test.want.GetUpdatedBy())
+		}
+	}
+}
+
+func TestWorkerGroup_Pins(t *testing.T) {
+	// setup types
+	wg := testWorkerGroup()
+
+	// setup tests
+	tests := []struct {
+		org      string
+		fullName string
+		wantOrg  bool
+		wantRepo bool
+	}{
+		{org: "github", fullName: "github/octocat", wantOrg: true, wantRepo: false},
+		{org: "octocat", fullName: "octocat/Hello-World", wantOrg: false, wantRepo: true},
+		{org: "foo", fullName: "foo/bar", wantOrg: false, wantRepo: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		if got := wg.PinsOrg(test.org); got != test.wantOrg {
+			t.Errorf("PinsOrg for %s is %v, want %v", test.org, got, test.wantOrg)
+		}
+
+		if got := wg.PinsRepo(test.fullName); got != test.wantRepo {
+			t.Errorf("PinsRepo for %s is %v, want %v", test.fullName, got, test.wantRepo)
+		}
+	}
+}
+
+func TestWorkerGroup_String(t *testing.T) {
+	// setup types
+	w := testWorkerGroup()
+
+	want := fmt.Sprintf(`{
+  ID: %d,
+  Name: %s,
+  Description: %s,
+  Route: %s,
+  BuildLimit: %d,
+  Orgs: %s,
+  Repos: %s,
+  Active: %t,
+  CreatedAt: %d,
+  CreatedBy: %s,
+  UpdatedAt: %d,
+  UpdatedBy: %s,
+}`,
+		w.GetID(),
+		w.GetName(),
+		w.GetDescription(),
+		w.GetRoute(),
+		w.GetBuildLimit(),
+		w.GetOrgs(),
+		w.GetRepos(),
+		w.GetActive(),
+		w.GetCreatedAt(),
+		w.GetCreatedBy(),
+		w.GetUpdatedAt(),
+		w.GetUpdatedBy(),
+	)
+
+	// run test
+	got := w.String()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("String is %v, want %v", got, want)
+	}
+}
+
+// testWorkerGroup is a test helper function to create a WorkerGroup
+// type with all fields set to a fake value.
+func testWorkerGroup() *WorkerGroup {
+	w := new(WorkerGroup)
+
+	w.SetID(1)
+	w.SetName("compliance")
+	w.SetDescription("builds subject to compliance review")
+	w.SetRoute("compliance")
+	w.SetBuildLimit(10)
+	w.SetOrgs([]string{"github"})
+	w.SetRepos([]string{"octocat/hello-world"})
+	w.SetActive(true)
+	w.SetCreatedAt(1563474077)
+	w.SetCreatedBy("octocat")
+	w.SetUpdatedAt(1563474078)
+	w.SetUpdatedBy("octocat")
+
+	return w
+}
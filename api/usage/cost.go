@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"sort"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// classCosts is a helper function to estimate the cost of the
+// build minutes used on each worker host by the rate for the
+// class of the worker. The usage for workers without a rate is
+// attributed to the default class at no cost.
+func classCosts(usage []*types.HostUsage, workers []*library.Worker, rates []*types.CostRate) []*types.ClassCost {
+	routes := make(map[string][]string)
+	for _, w := range workers {
+		routes[w.GetHostname()] = w.GetRoutes()
+	}
+
+	classes := make(map[string]*types.ClassCost)
+	costs := []*types.ClassCost{}
+
+	for _, h := range usage {
+		class := types.CostRateDefault
+
+		rate := types.RateFor(routes[h.GetHost()], rates)
+		if rate != nil {
+			class = rate.GetClass()
+		}
+
+		cost, ok := classes[class]
+		if !ok {
+			cost = new(types.ClassCost)
+			cost.SetClass(class)
+			cost.SetRate(rate.GetRate())
+
+			classes[class] = cost
+			costs = append(costs, cost)
+		}
+
+		cost.SetBuilds(cost.GetBuilds() + h.GetBuilds())
+		cost.SetMinutes(cost.GetMinutes() + h.GetMinutes())
+		cost.SetCost(cost.GetMinutes() * cost.GetRate())
+	}
+
+	sort.SliceStable(costs, func(i, j int) bool {
+		return costs[i].GetCost() > costs[j].GetCost()
+	})
+
+	return costs
+}
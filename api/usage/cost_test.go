@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func Test_classCosts(t *testing.T) {
+	// setup types
+	_small := new(library.Worker)
+	_small.SetHostname("worker-1.example.com")
+	_small.SetRoutes([]string{"vela"})
+
+	_large := new(library.Worker)
+	_large.SetHostname("worker-2.example.com")
+	_large.SetRoutes([]string{"vela", "large:linux"})
+
+	_smallRate := new(types.CostRate)
+	_smallRate.SetClass("vela")
+	_smallRate.SetRate(0.5)
+
+	_largeRate := new(types.CostRate)
+	_largeRate.SetClass("large:linux")
+	_largeRate.SetRate(2)
+
+	_one := new(types.HostUsage)
+	_one.SetHost("worker-1.example.com")
+	_one.SetBuilds(2)
+	_one.SetMinutes(10)
+
+	_two := new(types.HostUsage)
+	_two.SetHost("worker-2.example.com")
+	_two.SetBuilds(1)
+	_two.SetMinutes(4)
+
+	_gone := new(types.HostUsage)
+	_gone.SetHost("worker-3.example.com")
+	_gone.SetBuilds(3)
+	_gone.SetMinutes(6)
+
+	_largeCost := new(types.ClassCost)
+	_largeCost.SetClass("large:linux")
+	_largeCost.SetBuilds(1)
+	_largeCost.SetMinutes(4)
+	_largeCost.SetRate(2)
+	_largeCost.SetCost(8)
+
+	_smallCost := new(types.ClassCost)
+	_smallCost.SetClass("vela")
+	_smallCost.SetBuilds(2)
+	_smallCost.SetMinutes(10)
+	_smallCost.SetRate(0.5)
+	_smallCost.SetCost(5)
+
+	_defaultCost := new(types.ClassCost)
+	_defaultCost.SetClass(types.CostRateDefault)
+	_defaultCost.SetBuilds(3)
+	_defaultCost.SetMinutes(6)
+	_defaultCost.SetRate(0)
+	_defaultCost.SetCost(0)
+
+	want := []*types.ClassCost{_largeCost, _smallCost, _defaultCost}
+
+	// run test
+	got := classCosts(
+		[]*types.HostUsage{_one, _gone, _two},
+		[]*library.Worker{_small, _large},
+		[]*types.CostRate{_smallRate, _largeRate},
+	)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("classCosts is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/usage/orgs/{org}/budget usage DeleteBuildBudget
+//
+// Remove the monthly budget of build minutes for the provided org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully removed the build budget for the org
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to remove the build budget for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to remove the build budget for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteBuildBudget represents the API handler to remove
+// the monthly budget of build minutes for an org.
+func DeleteBuildBudget(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("deleting build budget for org %s", o)
+
+	// send API call to capture the budget for the org
+	b, err := database.FromContext(c).GetBuildBudgetForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get build budget for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the budget
+	err = database.FromContext(c).DeleteBuildBudget(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete build budget for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("build budget deleted for org %s", o))
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/usage/rates/{class} usage DeleteCostRate
+//
+// Remove the cost per build minute for the provided class of workers
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: class
+//   description: Class of workers
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully removed the cost rate for the class
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to remove the cost rate for the class
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to remove the cost rate for the class
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteCostRate represents the API handler to remove
+// the cost per build minute for a class of workers.
+func DeleteCostRate(c *gin.Context) {
+	// capture middleware values
+	class := util.PathParameter(c, "class")
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"class": class,
+		"user":  u.GetName(),
+	}).Infof("deleting cost rate for class %s", class)
+
+	// send API call to capture the rate for the class
+	r, err := database.FromContext(c).GetCostRateForClass(c, class)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get cost rate for class %s: %w", class, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the rate
+	err = database.FromContext(c).DeleteCostRate(c, r)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete cost rate for class %s: %w", class, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("cost rate deleted for class %s", class))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/budget"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/usage/orgs/{org}/budget usage GetBudgetUsage
+//
+// Get the build minutes used and remaining in the monthly budget for the provided org
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// responses:
+//   '200':
+//     description: Successfully retrieved the budget usage for the org
+//     schema:
+//       "$ref": "#/definitions/BudgetUsage"
+//   '403':
+//     description: Unable to retrieve the budget usage for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the budget usage for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the budget usage for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetBudgetUsage represents the API handler to capture the build
+// minutes used and remaining in the monthly budget for an org.
+func GetBudgetUsage(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("reading budget usage for org %s", o)
+
+	// verify the user is able to view usage for the org
+	if !orgAdmin(c, u, o) {
+		retErr := fmt.Errorf("unable to get budget usage for org %s: user %s is not an org admin", o, u.GetName())
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		return
+	}
+
+	// send API call to capture the budget for the org
+	b, err := database.FromContext(c).GetBuildBudgetForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get build budget for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// calculate the usage for the budget in the current month
+	usage, err := budget.Usage(database.FromContext(c), b, time.Now().UTC())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get budget usage for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, usage)
+}
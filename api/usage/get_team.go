@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/usage/orgs/{org}/teams/{team} usage GetTeamUsage
+//
+// Get the build minutes and step counts consumed by the members of a team
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: path
+//   name: team
+//   description: Slug of the team
+//   required: true
+//   type: string
+// - in: query
+//   name: after
+//   description: Unix timestamp to limit usage to builds created after (defaults to 30 days ago)
+//   type: integer
+// - in: query
+//   name: before
+//   description: Unix timestamp to limit usage to builds created before (defaults to now)
+//   type: integer
+// responses:
+//   '200':
+//     description: Successfully retrieved the usage for the team
+//     schema:
+//       "$ref": "#/definitions/TeamUsage"
+//   '400':
+//     description: Unable to retrieve the usage for the team
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Unable to retrieve the usage for the team
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the usage for the team
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetTeamUsage represents the API handler to capture the
+// usage for the members of a team within an org.
+func GetTeamUsage(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+	team := util.PathParameter(c, "team")
+
+	entry := fmt.Sprintf("%s/%s", o, team)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"team": team,
+		"user": u.GetName(),
+	}).Infof("reading usage for team %s", entry)
+
+	// verify the user is able to view usage for the org
+	if !orgAdmin(c, u, o) {
+		retErr := fmt.Errorf("unable to get usage for team %s: user %s is not an org admin", entry, u.GetName())
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		return
+	}
+
+	// capture the time window for the usage
+	after, before, err := window(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get usage for team %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the members of the team
+	members, err := scm.FromContext(c).ListTeamMembers(u, o, team)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get members for team %s: %w", entry, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the usage for the org
+	usage, err := database.FromContext(c).GetOrgActorUsage(c, o, after, before)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get actor usage for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, teamUsage(o, team, members, usage))
+}
+
+// teamUsage is a helper function to aggregate the
+// provided actor usage for the members of a team.
+func teamUsage(o, team string, members []string, usage []*types.ActorUsage) *types.TeamUsage {
+	var (
+		builds  int64
+		steps   int64
+		minutes float64
+		actors  = []*types.ActorUsage{}
+	)
+
+	for _, actor := range usage {
+		for _, member := range members {
+			// skip the actor if they are not a member of the team
+			if !strings.EqualFold(actor.GetActor(), member) {
+				continue
+			}
+
+			builds += actor.GetBuilds()
+			steps += actor.GetSteps()
+			minutes += actor.GetMinutes()
+
+			actors = append(actors, actor)
+
+			break
+		}
+	}
+
+	t := new(types.TeamUsage)
+
+	t.SetOrg(o)
+	t.SetTeam(team)
+	t.SetBuilds(builds)
+	t.SetSteps(steps)
+	t.SetMinutes(minutes)
+	t.SetActors(actors)
+
+	return t
+}
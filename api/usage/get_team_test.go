@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+)
+
+func Test_teamUsage(t *testing.T) {
+	// setup types
+	_octocat := new(types.ActorUsage)
+	_octocat.SetActor("octocat")
+	_octocat.SetBuilds(2)
+	_octocat.SetSteps(6)
+	_octocat.SetMinutes(3)
+
+	_hubot := new(types.ActorUsage)
+	_hubot.SetActor("hubot")
+	_hubot.SetBuilds(1)
+	_hubot.SetSteps(2)
+	_hubot.SetMinutes(1.5)
+
+	_outsider := new(types.ActorUsage)
+	_outsider.SetActor("outsider")
+	_outsider.SetBuilds(10)
+	_outsider.SetSteps(20)
+	_outsider.SetMinutes(30)
+
+	want := new(types.TeamUsage)
+	want.SetOrg("github")
+	want.SetTeam("justice-league")
+	want.SetBuilds(3)
+	want.SetSteps(8)
+	want.SetMinutes(4.5)
+	want.SetActors([]*types.ActorUsage{_octocat, _hubot})
+
+	// run test
+	got := teamUsage(
+		"github",
+		"justice-league",
+		[]string{"Octocat", "hubot"},
+		[]*types.ActorUsage{_octocat, _outsider, _hubot},
+	)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("teamUsage is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/usage/orgs/{org}/costs usage ListClassCostForOrg
+//
+// Get the estimated cost of the builds for the provided org by the class of worker they ran on
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: query
+//   name: after
+//   description: Unix timestamp to limit usage to builds created after (defaults to 30 days ago)
+//   type: integer
+// - in: query
+//   name: before
+//   description: Unix timestamp to limit usage to builds created before (defaults to now)
+//   type: integer
+// responses:
+//   '200':
+//     description: Successfully retrieved the costs for the org
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/ClassCost"
+//   '400':
+//     description: Unable to retrieve the costs for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '403':
+//     description: Unable to retrieve the costs for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the costs for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListClassCostForOrg represents the API handler to estimate the
+// cost of the builds for an org by the class of worker they ran on.
+func ListClassCostForOrg(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("listing costs for org %s", o)
+
+	// verify the user is able to view usage for the org
+	if !orgAdmin(c, u, o) {
+		retErr := fmt.Errorf("unable to list costs for org %s: user %s is not an org admin", o, u.GetName())
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		return
+	}
+
+	// capture the time window for the usage
+	after, before, err := window(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list costs for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the usage for each worker host for the org
+	usage, err := database.FromContext(c).GetOrgHostUsage(c, o, after, before)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get host usage for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the workers
+	workers, err := database.FromContext(c).ListWorkers(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list workers: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the cost rates
+	rates, err := database.FromContext(c).ListCostRates(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list cost rates: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, classCosts(usage, workers, rates))
+}
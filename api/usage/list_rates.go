@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/usage/rates usage ListCostRates
+//
+// Get the cost per build minute for each class of workers
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the cost rates
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/CostRate"
+//   '500':
+//     description: Unable to retrieve the cost rates
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListCostRates represents the API handler to capture
+// the cost per build minute for each class of workers.
+func ListCostRates(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Info("listing cost rates")
+
+	// send API call to capture the cost rates
+	rates, err := database.FromContext(c).ListCostRates(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to list cost rates: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, rates)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation PUT /api/v1/usage/orgs/{org}/budget usage UpdateBuildBudget
+//
+// Set the monthly budget of build minutes for the provided org
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: org
+//   description: Name of the org
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the build budget to set
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/BuildBudget"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully set the build budget for the org
+//     schema:
+//       "$ref": "#/definitions/BuildBudget"
+//   '400':
+//     description: Unable to set the build budget for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to set the build budget for the org
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateBuildBudget represents the API handler to create
+// or update the monthly budget of build minutes for an org.
+func UpdateBuildBudget(c *gin.Context) {
+	// capture middleware values
+	o := org.Retrieve(c)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Infof("updating build budget for org %s", o)
+
+	// capture body from API request
+	input := new(types.BuildBudget)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for build budget for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the existing budget for the org
+	b, err := database.FromContext(c).GetBuildBudgetForOrg(c, o)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		retErr := fmt.Errorf("unable to get build budget for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	exists := err == nil
+
+	if !exists {
+		b = new(types.BuildBudget)
+		b.SetOrg(o)
+		b.SetCreatedAt(time.Now().UTC().Unix())
+		b.SetCreatedBy(u.GetName())
+	}
+
+	if input.Minutes != nil && input.GetMinutes() != b.GetMinutes() {
+		// update minutes if set and reset the alerts sent for the previous budget
+		b.SetMinutes(input.GetMinutes())
+		b.SetAlerted(0)
+	}
+
+	if input.Webhook != nil {
+		// update webhook if set
+		b.SetWebhook(input.GetWebhook())
+	}
+
+	if b.GetMinutes() <= 0 {
+		retErr := fmt.Errorf("unable to set build budget for org %s: minutes must be greater than 0", o)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if len(b.GetWebhook()) > 0 {
+		_, err = url.ParseRequestURI(b.GetWebhook())
+		if err != nil {
+			retErr := fmt.Errorf("unable to set build budget for org %s: invalid webhook: %w", o, err)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+	}
+
+	b.SetUpdatedAt(time.Now().UTC().Unix())
+	b.SetUpdatedBy(u.GetName())
+
+	if exists {
+		// send API call to update the budget
+		err = database.FromContext(c).UpdateBuildBudget(c, b)
+	} else {
+		// send API call to create the budget
+		err = database.FromContext(c).CreateBuildBudget(c, b)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to set build budget for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the budget for the org
+	b, err = database.FromContext(c).GetBuildBudgetForOrg(c, o)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get build budget for org %s: %w", o, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, b)
+}
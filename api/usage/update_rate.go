@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation PUT /api/v1/usage/rates/{class} usage UpdateCostRate
+//
+// Set the cost per build minute for the provided class of workers
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: class
+//   description: Class of workers matching a route of the workers, or default for workers without a matching class
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the cost rate to set
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/CostRate"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully set the cost rate for the class
+//     schema:
+//       "$ref": "#/definitions/CostRate"
+//   '400':
+//     description: Unable to set the cost rate for the class
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to set the cost rate for the class
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateCostRate represents the API handler to create or update
+// the cost per build minute for a class of workers.
+func UpdateCostRate(c *gin.Context) {
+	// capture middleware values
+	class := util.PathParameter(c, "class")
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"class": class,
+		"user":  u.GetName(),
+	}).Infof("updating cost rate for class %s", class)
+
+	// capture body from API request
+	input := new(types.CostRate)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for cost rate for class %s: %w", class, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the existing rate for the class
+	r, err := database.FromContext(c).GetCostRateForClass(c, class)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		retErr := fmt.Errorf("unable to get cost rate for class %s: %w", class, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	exists := err == nil
+
+	if !exists {
+		r = new(types.CostRate)
+		r.SetClass(class)
+		r.SetCreatedAt(time.Now().UTC().Unix())
+		r.SetCreatedBy(u.GetName())
+	}
+
+	if input.Rate != nil {
+		// update rate if set
+		r.SetRate(input.GetRate())
+	}
+
+	if input.Description != nil {
+		// update description if set
+		r.SetDescription(input.GetDescription())
+	}
+
+	if r.GetRate() < 0 {
+		retErr := fmt.Errorf("unable to set cost rate for class %s: rate must not be negative", class)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	r.SetUpdatedAt(time.Now().UTC().Unix())
+	r.SetUpdatedBy(u.GetName())
+
+	if exists {
+		// send API call to update the rate
+		err = database.FromContext(c).UpdateCostRate(c, r)
+	} else {
+		// send API call to create the rate
+		err = database.FromContext(c).CreateCostRate(c, r)
+	}
+
+	if err != nil {
+		retErr := fmt.Errorf("unable to set cost rate for class %s: %w", class, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the rate for the class
+	r, err = database.FromContext(c).GetCostRateForClass(c, class)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get cost rate for class %s: %w", class, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, r)
+}
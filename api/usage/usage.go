@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usage
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultWindow represents the default amount of time
+// to aggregate usage for when no window is provided.
+const defaultWindow = 30 * 24 * time.Hour
+
+// window is a helper function to capture the after and before
+// query parameters that bound the time window for usage.
+func window(c *gin.Context) (int64, int64, error) {
+	now := time.Now().UTC()
+
+	// capture the after query parameter if present
+	after, err := strconv.ParseInt(c.DefaultQuery("after", strconv.FormatInt(now.Add(-defaultWindow).Unix(), 10)), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to convert after query parameter: %w", err)
+	}
+
+	// capture the before query parameter if present
+	before, err := strconv.ParseInt(c.DefaultQuery("before", strconv.FormatInt(now.Unix(), 10)), 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to convert before query parameter: %w", err)
+	}
+
+	if after >= before {
+		return 0, 0, fmt.Errorf("after query parameter %d must be less than before query parameter %d", after, before)
+	}
+
+	return after, before, nil
+}
+
+// orgAdmin is a helper function to determine if the
+// user is a platform admin or an admin of the org.
+func orgAdmin(c *gin.Context, u *library.User, o string) bool {
+	// platform admins are able to view usage for any org
+	if u.GetAdmin() {
+		return true
+	}
+
+	// capture the user's access level for the org
+	perm, err := scm.FromContext(c).OrgAccess(u, o)
+	if err != nil {
+		logrus.Errorf("unable to get user %s access level for org %s: %v", u.GetName(), o, err)
+
+		return false
+	}
+
+	return perm == "admin"
+}
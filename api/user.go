@@ -75,7 +75,7 @@ func CreateUser(c *gin.Context) {
 	}).Infof("creating new user %s", input.GetName())
 
 	// send API call to create the user
-	err = database.FromContext(c).CreateUser(input)
+	err = database.FromContext(c).CreateUser(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create user: %w", err)
 
@@ -85,7 +85,7 @@ func CreateUser(c *gin.Context) {
 	}
 
 	// send API call to capture the created user
-	user, _ := database.FromContext(c).GetUserForName(input.GetName())
+	user, _ := database.FromContext(c).GetUserForName(c, input.GetName())
 
 	c.JSON(http.StatusCreated, user)
 }
@@ -171,7 +171,7 @@ func GetUsers(c *gin.Context) {
 	perPage = util.MaxInt(1, util.MinInt(100, perPage))
 
 	// send API call to capture the list of users
-	users, t, err := database.FromContext(c).ListLiteUsers(page, perPage)
+	users, t, err := database.FromContext(c).ListLiteUsers(c, page, perPage)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get users: %w", err)
 
@@ -289,7 +289,7 @@ func UpdateCurrentUser(c *gin.Context) {
 	}
 
 	// send API call to update the user
-	err = database.FromContext(c).UpdateUser(u)
+	err = database.FromContext(c).UpdateUser(c, u)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update user %s: %w", u.GetName(), err)
 
@@ -299,7 +299,7 @@ func UpdateCurrentUser(c *gin.Context) {
 	}
 
 	// send API call to capture the updated user
-	u, err = database.FromContext(c).GetUserForName(u.GetName())
+	u, err = database.FromContext(c).GetUserForName(c, u.GetName())
 	if err != nil {
 		retErr := fmt.Errorf("unable to get updated user %s: %w", u.GetName(), err)
 
@@ -351,7 +351,7 @@ func GetUser(c *gin.Context) {
 	}).Infof("reading user %s", user)
 
 	// send API call to capture the user
-	u, err := database.FromContext(c).GetUserForName(user)
+	u, err := database.FromContext(c).GetUserForName(c, user)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get user %s: %w", user, err)
 
@@ -434,7 +434,7 @@ func GetUserSourceRepos(c *gin.Context) {
 
 		for page > 0 {
 			// send API call to capture the list of repos for the org
-			dbReposPart, _, err := database.FromContext(c).ListReposForOrg(org, "name", filters, page, 100)
+			dbReposPart, _, err := database.FromContext(c).ListReposForOrg(c, org, "name", filters, page, 100)
 			if err != nil {
 				retErr := fmt.Errorf("unable to get repos for org %s: %w", org, err)
 
@@ -536,7 +536,7 @@ func UpdateUser(c *gin.Context) {
 	}
 
 	// send API call to capture the user
-	u, err = database.FromContext(c).GetUserForName(user)
+	u, err = database.FromContext(c).GetUserForName(c, user)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get user %s: %w", user, err)
 
@@ -562,7 +562,7 @@ func UpdateUser(c *gin.Context) {
 	}
 
 	// send API call to update the user
-	err = database.FromContext(c).UpdateUser(u)
+	err = database.FromContext(c).UpdateUser(c, u)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update user %s: %w", user, err)
 
@@ -572,7 +572,7 @@ func UpdateUser(c *gin.Context) {
 	}
 
 	// send API call to capture the updated user
-	u, _ = database.FromContext(c).GetUserForName(user)
+	u, _ = database.FromContext(c).GetUserForName(c, user)
 
 	c.JSON(http.StatusOK, u)
 }
@@ -621,7 +621,7 @@ func DeleteUser(c *gin.Context) {
 	}).Infof("deleting user %s", user)
 
 	// send API call to capture the user
-	u, err := database.FromContext(c).GetUserForName(user)
+	u, err := database.FromContext(c).GetUserForName(c, user)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get user %s: %w", user, err)
 
@@ -631,7 +631,7 @@ func DeleteUser(c *gin.Context) {
 	}
 
 	// send API call to remove the user
-	err = database.FromContext(c).DeleteUser(u)
+	err = database.FromContext(c).DeleteUser(c, u)
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete user %s: %w", u.GetName(), err)
 
@@ -692,7 +692,7 @@ func CreateToken(c *gin.Context) {
 	u.SetRefreshToken(rt)
 
 	// send API call to update the user
-	err = database.FromContext(c).UpdateUser(u)
+	err = database.FromContext(c).UpdateUser(c, u)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update user %s: %w", u.GetName(), err)
 
@@ -753,7 +753,7 @@ func DeleteToken(c *gin.Context) {
 	u.SetRefreshToken(rt)
 
 	// send API call to update the user
-	err = database.FromContext(c).UpdateUser(u)
+	err = database.FromContext(c).UpdateUser(c, u)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update user %s: %w", u.GetName(), err)
 
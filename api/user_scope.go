@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation GET /api/v1/user/scopes users GetCurrentUserScopes
+//
+// Retrieve the OAuth scopes granted to the current authenticated user
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the scopes for the current user
+//     schema:
+//       "$ref": "#/definitions/UserScope"
+//   '500':
+//     description: Unable to retrieve the scopes for the current user
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetCurrentUserScopes represents the API handler to capture the
+// OAuth scopes granted to the scm token for the currently
+// authenticated user when they last logged in. When required
+// scopes are missing, the user must re-consent by logging in
+// again with the consent URL from the response.
+func GetCurrentUserScopes(c *gin.Context) {
+	// capture middleware values
+	m := c.MustGet("metadata").(*types.Metadata)
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Infof("reading scopes for current user %s", u.GetName())
+
+	// send API call to capture the scopes granted to the user
+	s, err := database.FromContext(c).GetUserScopeForUser(c, u)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		retErr := fmt.Errorf("unable to get scopes for user %s: %w", u.GetName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// the scopes are not captured when the scm does not report them
+	if err != nil {
+		s = new(api.UserScope)
+		s.SetUserID(u.GetID())
+	}
+
+	// the scopes required are the scopes missing when none are granted
+	s.SetRequired(scm.FromContext(c).MissingScopes([]string{}))
+	s.SetMissing([]string{})
+
+	if s.Scopes != nil {
+		s.SetMissing(scm.FromContext(c).MissingScopes(s.GetScopes()))
+	}
+
+	if len(s.GetMissing()) > 0 {
+		s.SetConsentURL(consentURL(m))
+	}
+
+	c.JSON(http.StatusOK, s)
+}
+
+// consentURL is a helper function to create the URL for
+// the user to log in again to re-consent to the OAuth
+// scopes required by Vela.
+func consentURL(m *types.Metadata) string {
+	return fmt.Sprintf("%s/login?type=web", m.Vela.Address)
+}
+
+// checkScopes is a helper function to capture the OAuth scopes
+// granted to the scm token for the user and verify the token
+// is granted the scopes required by Vela. No scopes are
+// returned when the scm does not report the scopes.
+func checkScopes(c *gin.Context, u *library.User) ([]string, error) {
+	m := c.MustGet("metadata").(*types.Metadata)
+
+	// send API call to capture the scopes granted to the token
+	scopes, err := scm.FromContext(c).GetScopes(u)
+	if err != nil {
+		return nil, fmt.Errorf("unable to capture scopes for user %s: %w", u.GetName(), err)
+	}
+
+	if scopes == nil {
+		return nil, nil
+	}
+
+	missing := scm.FromContext(c).MissingScopes(scopes)
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("token for user %s is missing the required scopes %s: log in at %s to re-consent",
+			u.GetName(), strings.Join(missing, ", "), consentURL(m))
+	}
+
+	return scopes, nil
+}
+
+// updateScopes is a helper function to store the OAuth
+// scopes granted to the scm token for the user.
+func updateScopes(c *gin.Context, u *library.User, scopes []string) error {
+	if scopes == nil {
+		return nil
+	}
+
+	// capture the ID for a user that was just created
+	if u.GetID() == 0 {
+		dbUser, err := database.FromContext(c).GetUserForName(c, u.GetName())
+		if err != nil {
+			return err
+		}
+
+		u = dbUser
+	}
+
+	// send API call to capture the existing scopes for the user
+	s, err := database.FromContext(c).GetUserScopeForUser(c, u)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	exists := err == nil
+
+	if !exists {
+		s = new(api.UserScope)
+		s.SetUserID(u.GetID())
+	}
+
+	s.SetScopes(scopes)
+	s.SetUpdatedAt(time.Now().UTC().Unix())
+
+	if exists {
+		// send API call to update the scopes for the user
+		return database.FromContext(c).UpdateUserScope(c, s)
+	}
+
+	// send API call to create the scopes for the user
+	return database.FromContext(c).CreateUserScope(c, s)
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/enrich"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/user/sessions users GetCurrentUserSessions
+//
+// Retrieve the sessions for the current authenticated user
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// parameters:
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 100
+//   default: 10
+// responses:
+//   '200':
+//     description: Successfully retrieved the sessions for the current user
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/UserSession"
+//     headers:
+//       X-Total-Count:
+//         description: Total number of results
+//         type: integer
+//       Link:
+//         description: see https://tools.ietf.org/html/rfc5988
+//         type: string
+//   '400':
+//     description: Unable to retrieve the sessions for the current user
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the sessions for the current user
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetCurrentUserSessions represents the API handler to capture the
+// records of the currently authenticated user logging in and
+// refreshing their access token, with the most recent first.
+func GetCurrentUserSessions(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Infof("reading sessions for current user %s", u.GetName())
+
+	listSessions(c, u)
+}
+
+// swagger:operation GET /api/v1/users/{user}/sessions users GetUserSessions
+//
+// Retrieve the sessions for a user
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// parameters:
+// - in: path
+//   name: user
+//   description: Name of the user
+//   required: true
+//   type: string
+// - in: query
+//   name: page
+//   description: The page of results to retrieve
+//   type: integer
+//   default: 1
+// - in: query
+//   name: per_page
+//   description: How many results per page to return
+//   type: integer
+//   maximum: 100
+//   default: 10
+// responses:
+//   '200':
+//     description: Successfully retrieved the sessions for the user
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/UserSession"
+//     headers:
+//       X-Total-Count:
+//         description: Total number of results
+//         type: integer
+//       Link:
+//         description: see https://tools.ietf.org/html/rfc5988
+//         type: string
+//   '400':
+//     description: Unable to retrieve the sessions for the user
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to retrieve the sessions for the user
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to retrieve the sessions for the user
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetUserSessions represents the API handler to capture the
+// records of a user logging in and refreshing their access
+// token, with the most recent first, for security reviews.
+func GetUserSessions(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	name := util.PathParameter(c, "user")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Infof("reading sessions for user %s", name)
+
+	// send API call to capture the user
+	dbUser, err := database.FromContext(c).GetUserForName(c, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get user %s: %w", name, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	listSessions(c, dbUser)
+}
+
+// listSessions is a helper function to respond with
+// a page of the sessions for the provided user.
+func listSessions(c *gin.Context, u *library.User) {
+	// capture page query parameter if present
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert page query parameter for user %s: %w", u.GetName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// capture per_page query parameter if present
+	perPage, err := strconv.Atoi(c.DefaultQuery("per_page", "10"))
+	if err != nil {
+		retErr := fmt.Errorf("unable to convert per_page query parameter for user %s: %w", u.GetName(), err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// ensure per_page isn't above or below allowed values
+	perPage = util.MaxInt(1, util.MinInt(100, perPage))
+
+	// send API call to capture the sessions for the user
+	sessions, t, err := database.FromContext(c).ListUserSessionsForUser(c, u, page, perPage)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get sessions for user %s: %w", u.GetName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// create pagination object
+	pagination := Pagination{
+		Page:    page,
+		PerPage: perPage,
+		Total:   t,
+	}
+	// set pagination headers
+	pagination.SetHeaderLink(c)
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// recordSession is a helper function to record the user logging in
+// or refreshing their access token, enriched with the details about
+// the client that made the request. Failing to record the session
+// does not fail the request for the user.
+func recordSession(c *gin.Context, u *library.User, action string) {
+	// capture the ID for a user that was just created
+	if u.GetID() == 0 {
+		if len(u.GetName()) == 0 {
+			return
+		}
+
+		dbUser, err := database.FromContext(c).GetUserForName(c, u.GetName())
+		if err != nil {
+			logrus.Errorf("unable to record %s session for user %s: %v", action, u.GetName(), err)
+
+			return
+		}
+
+		u = dbUser
+	}
+
+	// capture the details about the client that made the request
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/enrich?tab=doc#Enricher.Enrich
+	d := enrich.FromContext(c).Enrich(c, c.Request, c.ClientIP())
+
+	s := new(api.UserSession)
+	s.SetUserID(u.GetID())
+	s.SetAction(action)
+	s.SetIP(d.IP)
+	s.SetUserAgent(d.UserAgent)
+	s.SetClient(d.Client)
+	s.SetLocation(d.Location)
+	s.SetCreated(time.Now().UTC().Unix())
+
+	// send API call to record the session for the user
+	err := database.FromContext(c).CreateUserSession(c, s)
+	if err != nil {
+		logrus.Errorf("unable to record %s session for user %s: %v", action, u.GetName(), err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,9 +16,14 @@ import (
 	"time"
 
 	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/report"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/fault"
+	"github.com/go-vela/server/intake"
+	"github.com/go-vela/server/placement"
 	"github.com/go-vela/server/queue"
 	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/staging"
 	"github.com/go-vela/server/util"
 
 	"github.com/go-vela/types"
@@ -50,6 +56,10 @@ var baseErr = "unable to process webhook"
 //     description: Successfully received the webhook
 //     schema:
 //       "$ref": "#/definitions/Build"
+//   '202':
+//     description: Successfully buffered the webhook for processing
+//     schema:
+//       type: string
 //   '400':
 //     description: Malformed webhook payload
 //     schema:
@@ -66,6 +76,10 @@ var baseErr = "unable to process webhook"
 //     description: Unable to receive the webhook
 //     schema:
 //       "$ref": "#/definitions/Error"
+//   '503':
+//     description: Unable to buffer the webhook
+//     schema:
+//       "$ref": "#/definitions/Error"
 
 // PostWebhook represents the API handler to capture
 // a webhook from a source control provider and
@@ -75,6 +89,17 @@ var baseErr = "unable to process webhook"
 func PostWebhook(c *gin.Context) {
 	logrus.Info("webhook received")
 
+	// drop the webhook if a fault is injected for testing
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/fault?tab=doc#Inject
+	err := fault.Inject(fault.TargetWebhook)
+	if err != nil {
+		retErr := fmt.Errorf("%s: %w", baseErr, err)
+		util.HandleError(c, http.StatusServiceUnavailable, retErr)
+
+		return
+	}
+
 	// capture middleware values
 	m := c.MustGet("metadata").(*types.Metadata)
 
@@ -96,7 +121,7 @@ func PostWebhook(c *gin.Context) {
 	var buf bytes.Buffer
 
 	// read the request body for duplication
-	_, err := buf.ReadFrom(c.Request.Body)
+	_, err = buf.ReadFrom(c.Request.Body)
 	if err != nil {
 		retErr := fmt.Errorf("unable to read webhook body: %w", err)
 		util.HandleError(c, http.StatusBadRequest, retErr)
@@ -112,6 +137,49 @@ func PostWebhook(c *gin.Context) {
 	//
 	// -------------------- End of TODO: --------------------
 
+	// buffer the webhook so it is processed asynchronously by
+	// the intake workers, unless it is already being processed
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/intake?tab=doc#Buffer.Accept
+	if i := intake.FromContext(c); i != nil && i.Enabled() && !intake.Processing(c.Request.Context()) {
+		// verify the webhook from the source control provider
+		// before it is buffered so unsigned webhooks are rejected
+		if c.Value("webhookvalidation").(bool) {
+			err = verifyIntake(c, dupRequest, buf.Bytes())
+			if err != nil {
+				retErr := fmt.Errorf("unable to verify webhook: %w", err)
+				util.HandleError(c, http.StatusUnauthorized, retErr)
+
+				return
+			}
+		}
+
+		err = i.Accept(c, c.Request, buf.Bytes())
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, intake.ErrFull) {
+				status = http.StatusServiceUnavailable
+			}
+
+			retErr := fmt.Errorf("unable to buffer webhook: %w", err)
+			util.HandleError(c, status, retErr)
+
+			return
+		}
+
+		c.JSON(http.StatusAccepted, "webhook accepted for processing")
+
+		return
+	}
+
+	// track the webhook so it can be staged and resumed
+	// if the server shuts down before it is processed
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/staging?tab=doc#Tracker.Track
+	if t := staging.FromContext(c); t != nil {
+		defer t.Done(t.Track(c.Request, buf.Bytes()))
+	}
+
 	// process the webhook from the source control provider
 	// comment, number, h, r, b
 	webhook, err := scm.FromContext(c).ProcessWebhook(c.Request)
@@ -142,6 +210,24 @@ func PostWebhook(c *gin.Context) {
 			b.GetAuthor(), b.GetBranch(), b.GetCommit(), b.GetRef())
 	}
 
+	// capture the active org hook for the repo
+	orgHook := captureOrgHook(c, r)
+
+	// skip webhooks delivered by a repo hook when the
+	// org hook already delivers the events for the repo
+	if orgHook != nil && h.GetWebhookID() != orgHook.GetWebhookID() {
+		c.JSON(http.StatusOK, fmt.Sprintf("no build to process, webhooks for %s are delivered by the org hook", r.GetFullName()))
+
+		return
+	}
+
+	// tear down the preview environments for a closed pull request
+	if b == nil && h.GetEvent() == constants.EventPull && h.GetEventAction() == "closed" && r != nil {
+		closePullRequest(c, dupRequest, h, r, webhook.PRNumber)
+
+		return
+	}
+
 	// check if build was parsed from webhook.
 	// build will be nil on repository events, but
 	// for renaming, we want to continue.
@@ -163,7 +249,7 @@ func PostWebhook(c *gin.Context) {
 
 	defer func() {
 		// send API call to update the webhook
-		err = database.FromContext(c).UpdateHook(h)
+		err = database.FromContext(c).UpdateHook(c, h)
 		if err != nil {
 			logrus.Errorf("unable to update webhook %s/%d: %v", r.GetFullName(), h.GetNumber(), err)
 		}
@@ -186,7 +272,7 @@ func PostWebhook(c *gin.Context) {
 		// if action is archived, unarchived, or edited, perform edits to relevant repo fields
 		case "archived", "unarchived", constants.ActionEdited:
 			// send call to get repository from database
-			dbRepo, err := database.FromContext(c).GetRepoForOrg(r.GetOrg(), r.GetName())
+			dbRepo, err := database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
 			if err != nil {
 				retErr := fmt.Errorf("%s: failed to get repo %s: %w", baseErr, r.GetFullName(), err)
 				util.HandleError(c, http.StatusBadRequest, retErr)
@@ -210,7 +296,7 @@ func PostWebhook(c *gin.Context) {
 			}
 
 			// update repo object in the database after applying edits
-			err = database.FromContext(c).UpdateRepo(dbRepo)
+			err = database.FromContext(c).UpdateRepo(c, dbRepo)
 			if err != nil {
 				retErr := fmt.Errorf("%s: failed to update repo %s: %w", baseErr, r.GetFullName(), err)
 				util.HandleError(c, http.StatusInternalServerError, retErr)
@@ -223,6 +309,28 @@ func PostWebhook(c *gin.Context) {
 
 			c.JSON(http.StatusOK, retMsg)
 
+			return
+		// if action is created, enable the repo when the org hook is configured to enable repos
+		case constants.ActionCreated:
+			if !orgHook.GetAutoEnable() {
+				c.JSON(http.StatusOK, "no build to process")
+
+				return
+			}
+
+			err = enableOrgHookRepo(c, dupRequest, r, orgHook)
+			if err != nil {
+				retErr := fmt.Errorf("%s: failed to enable repo %s: %w", baseErr, r.GetFullName(), err)
+				util.HandleError(c, http.StatusBadRequest, retErr)
+
+				h.SetStatus(constants.StatusFailure)
+				h.SetError(retErr.Error())
+
+				return
+			}
+
+			c.JSON(http.StatusOK, fmt.Sprintf("no build to process, repository %s enabled by the org hook", r.GetFullName()))
+
 			return
 		// all other repo event actions are skippable
 		default:
@@ -232,8 +340,25 @@ func PostWebhook(c *gin.Context) {
 		}
 	}
 
+	// enable the repo when the org hook is configured to enable repos
+	if orgHook.GetAutoEnable() {
+		err = enableOrgHookRepo(c, dupRequest, r, orgHook)
+		if err != nil {
+			retErr := fmt.Errorf("%s: failed to enable repo %s: %w", baseErr, r.GetFullName(), err)
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			h.SetStatus(constants.StatusFailure)
+			h.SetError(retErr.Error())
+
+			return
+		}
+
+		// reset the request body after verifying the webhook
+		dupRequest.Body = io.NopCloser(bytes.NewReader(buf.Bytes()))
+	}
+
 	// send API call to capture parsed repo from webhook
-	r, err = database.FromContext(c).GetRepoForOrg(r.GetOrg(), r.GetName())
+	r, err = database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
 	if err != nil {
 		retErr := fmt.Errorf("%s: failed to get repo %s: %w", baseErr, r.GetFullName(), err)
 		util.HandleError(c, http.StatusBadRequest, retErr)
@@ -249,7 +374,7 @@ func PostWebhook(c *gin.Context) {
 	h.SetRepoID(r.GetID())
 
 	// send API call to capture the last hook for the repo
-	lastHook, err := database.FromContext(c).LastHookForRepo(r)
+	lastHook, err := database.FromContext(c).LastHookForRepo(c, r)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get last hook for repo %s: %w", r.GetFullName(), err)
 		util.HandleError(c, http.StatusInternalServerError, retErr)
@@ -268,7 +393,7 @@ func PostWebhook(c *gin.Context) {
 	}
 
 	// send API call to create the webhook
-	err = database.FromContext(c).CreateHook(h)
+	err = database.FromContext(c).CreateHook(c, h)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create webhook %s/%d: %w", r.GetFullName(), h.GetNumber(), err)
 		util.HandleError(c, http.StatusInternalServerError, retErr)
@@ -280,11 +405,11 @@ func PostWebhook(c *gin.Context) {
 	}
 
 	// send API call to capture the created webhook
-	h, _ = database.FromContext(c).GetHookForRepo(r, h.GetNumber())
+	h, _ = database.FromContext(c).GetHookForRepo(c, r, h.GetNumber())
 
 	// verify the webhook from the source control provider
 	if c.Value("webhookvalidation").(bool) {
-		err = scm.FromContext(c).VerifyWebhook(dupRequest, r)
+		err = verifyWebhook(c, dupRequest, r, orgHook)
 		if err != nil {
 			retErr := fmt.Errorf("unable to verify webhook: %w", err)
 			util.HandleError(c, http.StatusUnauthorized, retErr)
@@ -294,6 +419,19 @@ func PostWebhook(c *gin.Context) {
 
 			return
 		}
+
+		status, err := verifyDelivery(c, dupRequest, r, h)
+		if err != nil {
+			retErr := fmt.Errorf("unable to verify webhook delivery: %w", err)
+			util.HandleError(c, status, retErr)
+
+			h.SetStatus(constants.StatusFailure)
+			h.SetError(retErr.Error())
+
+			return
+		}
+
+		defer releaseDelivery(c, h)
 	}
 
 	// check if the repo is active
@@ -336,7 +474,7 @@ func PostWebhook(c *gin.Context) {
 	// send API call to capture repo owner
 	logrus.Debugf("capturing owner of repository %s", r.GetFullName())
 
-	u, err := database.FromContext(c).GetUser(r.GetUserID())
+	u, err := database.FromContext(c).GetUser(c, r.GetUserID())
 	if err != nil {
 		retErr := fmt.Errorf("%s: failed to get owner for %s: %w", baseErr, r.GetFullName(), err)
 		util.HandleError(c, http.StatusBadRequest, retErr)
@@ -353,7 +491,7 @@ func PostWebhook(c *gin.Context) {
 	}
 
 	// send API call to capture the number of pending or running builds for the repo
-	builds, err := database.FromContext(c).GetRepoBuildCount(r, filters)
+	builds, err := database.FromContext(c).GetRepoBuildCount(c, r, filters)
 	if err != nil {
 		retErr := fmt.Errorf("%s: unable to get count of builds for repo %s", baseErr, r.GetFullName())
 		util.HandleError(c, http.StatusBadRequest, retErr)
@@ -377,6 +515,18 @@ func PostWebhook(c *gin.Context) {
 		return
 	}
 
+	// check if the number of pending and running builds exceeds the limit for the worker group
+	err = verifyWorkerGroupLimit(c, database.FromContext(c), r)
+	if err != nil {
+		retErr := fmt.Errorf("%s: %w", baseErr, err)
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
 	// update fields in build object
 	logrus.Debugf("updating build number to %d", r.GetCounter())
 	b.SetNumber(r.GetCounter())
@@ -439,6 +589,55 @@ func PostWebhook(c *gin.Context) {
 		}
 	}
 
+	// send API calls to compute the context for the build
+	buildCtx, err := buildContext(scm.FromContext(c), u, r, b, webhook.PRNumber, files)
+	if err != nil {
+		retErr := fmt.Errorf("%s: failed to get build context for %s: %w", baseErr, r.GetFullName(), err)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
+	// comment a preview of the changes to the pipeline on the pull request
+	previewPipeline(c, m, u, r, b, buildCtx, files, webhook.PRNumber)
+
+	// verify the deployment has the approvals required by the server
+	err = verifyDeployApproval(c, u, r, b)
+	if err != nil {
+		retErr := fmt.Errorf("%s: %w", baseErr, err)
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		return
+	}
+
+	// verify the build is not blocked by a change freeze
+	err = verifyFreeze(c, u, r, b)
+	if err != nil {
+		retErr := fmt.Errorf("%s: %w", baseErr, err)
+		util.HandleError(c, http.StatusForbidden, retErr)
+
+		h.SetStatus(constants.StatusFailure)
+		h.SetError(retErr.Error())
+
+		// report the freeze on the commit
+		b.SetStatus(constants.StatusError)
+		b.SetError(err.Error())
+
+		// send API call to set the status on the commit
+		err = scm.FromContext(c).Status(u, b, r.GetOrg(), r.GetName())
+		if err != nil {
+			logrus.Errorf("unable to set commit status for %s/%d: %v", r.GetFullName(), b.GetNumber(), err)
+		}
+
+		return
+	}
+
 	var (
 		// variable to store the raw pipeline configuration
 		config []byte
@@ -446,6 +645,8 @@ func PostWebhook(c *gin.Context) {
 		p *pipeline.Build
 		// variable to store pipeline configuration
 		pipeline *library.Pipeline
+		// variable to store the compiler used for the pipeline
+		engine compiler.Engine
 		// variable to control number of times to retry processing pipeline
 		retryLimit = 3
 		// variable to store the pipeline type for the repository
@@ -466,7 +667,7 @@ func PostWebhook(c *gin.Context) {
 		}
 
 		// send API call to attempt to capture the pipeline
-		pipeline, err = database.FromContext(c).GetPipelineForRepo(b.GetCommit(), r)
+		pipeline, err = database.FromContext(c).GetPipelineForRepo(c, b.GetCommit(), r)
 		if err != nil { // assume the pipeline doesn't exist in the database yet
 			// send API call to capture the pipeline configuration file
 			config, err = scm.FromContext(c).ConfigBackoff(u, r, b.GetCommit())
@@ -485,7 +686,7 @@ func PostWebhook(c *gin.Context) {
 		}
 
 		// send API call to capture repo for the counter
-		r, err = database.FromContext(c).GetRepoForOrg(r.GetOrg(), r.GetName())
+		r, err = database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
 		if err != nil {
 			retErr := fmt.Errorf("%s: unable to get repo %s: %w", baseErr, r.GetFullName(), err)
 
@@ -537,11 +738,14 @@ func PostWebhook(c *gin.Context) {
 		}
 
 		var compiled *library.Pipeline
+		// create a compiler to capture the report for the pipeline
+		engine = compiler.FromContext(c).Duplicate()
 		// parse and compile the pipeline configuration file
-		p, compiled, err = compiler.FromContext(c).
-			Duplicate().
+		p, compiled, err = engine.
 			WithBuild(b).
+			WithBuildContext(buildCtx).
 			WithComment(webhook.Comment).
+			WithContext(c.Request.Context()).
 			WithFiles(files).
 			WithMetadata(m).
 			WithRepo(r).
@@ -573,6 +777,11 @@ func PostWebhook(c *gin.Context) {
 
 		// skip the build if only the init or clone steps are found
 		skip := skipEmptyBuild(p)
+		if skip == "" {
+			// skip the build if no steps opt in to the label action
+			skip = skipLabelBuild(p, b)
+		}
+
 		if skip != "" {
 			// set build to successful status
 			b.SetStatus(constants.StatusSkipped)
@@ -596,7 +805,7 @@ func PostWebhook(c *gin.Context) {
 			pipeline.SetRef(b.GetRef())
 
 			// send API call to create the pipeline
-			err = database.FromContext(c).CreatePipeline(pipeline)
+			err = database.FromContext(c).CreatePipeline(c, pipeline)
 			if err != nil {
 				retErr := fmt.Errorf("%s: failed to create pipeline for %s: %w", baseErr, r.GetFullName(), err)
 
@@ -617,7 +826,7 @@ func PostWebhook(c *gin.Context) {
 			}
 
 			// send API call to capture the created pipeline
-			pipeline, err = database.FromContext(c).GetPipelineForRepo(pipeline.GetCommit(), r)
+			pipeline, err = database.FromContext(c).GetPipelineForRepo(c, pipeline.GetCommit(), r)
 			if err != nil {
 				//nolint:lll // ignore long line length due to error message
 				retErr := fmt.Errorf("%s: failed to get new pipeline %s/%s: %w", baseErr, r.GetFullName(), pipeline.GetCommit(), err)
@@ -632,14 +841,24 @@ func PostWebhook(c *gin.Context) {
 
 		b.SetPipelineID(pipeline.GetID())
 
-		// create the objects from the pipeline in the database
-		// TODO:
-		// - if a build gets created and something else fails midway,
-		//   the next loop will attempt to create the same build,
-		//   using the same Number and thus create a constraint
-		//   conflict; consider deleting the partially created
-		//   build object in the database
-		err = planBuild(database.FromContext(c), p, b, r)
+		// create the build and publish it to the queue
+		//
+		// the objects are created in a single transaction, so the
+		// next loop can create the same build when something fails
+		// midway without a constraint conflict on the build number
+		created, err := createBuild(
+			c,
+			database.FromContext(c),
+			report.FromContext(c),
+			scm.FromContext(c),
+			queue.FromGinContext(c),
+			placement.FromContext(c),
+			engine,
+			p,
+			b,
+			r,
+			u,
+		)
 		if err != nil {
 			retErr := fmt.Errorf("%s: %w", baseErr, err)
 
@@ -647,11 +866,6 @@ func PostWebhook(c *gin.Context) {
 			if i < retryLimit-1 {
 				logrus.WithError(retErr).Warningf("retrying #%d", i+1)
 
-				// reset fields set by cleanBuild for retry
-				b.SetError("")
-				b.SetStatus(constants.StatusPending)
-				b.SetFinished(0)
-
 				// continue to the next iteration of the loop
 				continue
 			}
@@ -664,81 +878,43 @@ func PostWebhook(c *gin.Context) {
 			return
 		}
 
+		b = created
+
 		// break the loop because everything was successful
 		break
 	} // end of retry loop
 
-	// send API call to update repo for ensuring counter is incremented
-	err = database.FromContext(c).UpdateRepo(r)
-	if err != nil {
-		retErr := fmt.Errorf("%s: failed to update repo %s: %w", baseErr, r.GetFullName(), err)
-		util.HandleError(c, http.StatusBadRequest, retErr)
-
-		h.SetStatus(constants.StatusFailure)
-		h.SetError(retErr.Error())
-
-		return
-	}
-
-	// return error if pipeline didn't get populated
-	if p == nil {
-		retErr := fmt.Errorf("%s: failed to set pipeline for %s: %w", baseErr, r.GetFullName(), err)
-		util.HandleError(c, http.StatusBadRequest, retErr)
-
-		h.SetStatus(constants.StatusFailure)
-		h.SetError(retErr.Error())
-
-		return
-	}
-
-	// return error if build didn't get populated
-	if b == nil {
-		retErr := fmt.Errorf("%s: failed to set build for %s: %w", baseErr, r.GetFullName(), err)
-		util.HandleError(c, http.StatusBadRequest, retErr)
-
-		h.SetStatus(constants.StatusFailure)
-		h.SetError(retErr.Error())
+	// set the BuildID field
+	h.SetBuildID(b.GetID())
 
-		return
-	}
+	saveBuildLabels(c, database.FromContext(c), b, buildCtx)
 
-	// send API call to capture the triggered build
-	b, err = database.FromContext(c).GetBuild(b.GetNumber(), r)
-	if err != nil {
-		retErr := fmt.Errorf("%s: failed to get new build %s/%d: %w", baseErr, r.GetFullName(), b.GetNumber(), err)
-		util.HandleError(c, http.StatusInternalServerError, retErr)
+	c.JSON(http.StatusOK, b)
+}
 
-		h.SetStatus(constants.StatusFailure)
-		h.SetError(retErr.Error())
+// publishToQueue is a helper function that publishes the build
+// to the queue and errors out the build when it can't be published.
+func publishToQueue(ctx context.Context, q queue.Service, db database.Service, pl *placement.Placer, p *pipeline.Build, b *library.Build, r *library.Repo, u *library.User) {
+	err := PublishToQueue(ctx, q, db, pl, p, b, r, u)
+	if errors.Is(err, queue.ErrItemTooLarge) {
+		// error out the build without retrying since the item
+		// will be rejected until the pipeline is made smaller
+		cleanBuild(ctx, db, b, nil, nil, fmt.Errorf("%w: reduce the size of the pipeline and its environment", err))
 
 		return
 	}
 
-	// set the BuildID field
-	h.SetBuildID(b.GetID())
-
-	c.JSON(http.StatusOK, b)
-
-	// send API call to set the status on the commit
-	err = scm.FromContext(c).Status(u, b, r.GetOrg(), r.GetName())
 	if err != nil {
-		logrus.Errorf("unable to set commit status for %s/%d: %v", r.GetFullName(), b.GetNumber(), err)
+		// error out the build
+		cleanBuild(ctx, db, b, nil, nil, nil)
 	}
-
-	// publish the build to the queue
-	go publishToQueue(
-		queue.FromGinContext(c),
-		database.FromContext(c),
-		p,
-		b,
-		r,
-		u,
-	)
 }
 
-// publishToQueue is a helper function that creates
-// a build item and publishes it to the queue.
-func publishToQueue(queue queue.Service, db database.Service, p *pipeline.Build, b *library.Build, r *library.Repo, u *library.User) {
+// PublishToQueue creates a build item and publishes it to the queue
+// on the route for the worker group the repo is pinned to or the
+// route preferred by the placer, then records when the build was
+// enqueued. The item is published once more if the first attempt fails.
+func PublishToQueue(ctx context.Context, q queue.Service, db database.Service, pl *placement.Placer, p *pipeline.Build, b *library.Build, r *library.Repo, u *library.User) error {
 	item := types.ToItem(p, b, r, u)
 
 	logrus.Infof("Converting queue item to json for build %d for %s", b.GetNumber(), r.GetFullName())
@@ -747,38 +923,51 @@ func publishToQueue(queue queue.Service, db database.Service, p *pipeline.Build,
 	if err != nil {
 		logrus.Errorf("Failed to convert item to json for build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
 
-		// error out the build
-		cleanBuild(db, b, nil, nil)
-
-		return
+		return fmt.Errorf("unable to convert item to json: %w", err)
 	}
 
 	logrus.Infof("Establishing route for build %d for %s", b.GetNumber(), r.GetFullName())
 
-	route, err := queue.Route(&p.Worker)
+	route, err := q.Route(&p.Worker)
 	if err != nil {
 		logrus.Errorf("unable to set route for build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
 
-		// error out the build
-		cleanBuild(db, b, nil, nil)
+		return fmt.Errorf("unable to set route: %w", err)
+	}
 
-		return
+	// send API call to capture the worker group for the repo
+	g, err := workerGroupForRepo(ctx, db, r)
+	if err != nil {
+		logrus.Errorf("unable to get worker group for build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
+	}
+
+	// route the build to the worker group the repo is pinned to
+	if len(g.GetRoute()) > 0 {
+		logrus.Infof("Routing build %d for %s to worker group %s", b.GetNumber(), r.GetFullName(), g.GetName())
+
+		route = g.GetRoute()
+	} else {
+		// prefer a worker for the build based on its history
+		route = pl.Route(ctx, r, route)
 	}
 
 	logrus.Infof("Publishing item for build %d for %s to queue %s", b.GetNumber(), r.GetFullName(), route)
 
-	err = queue.Push(context.Background(), route, byteItem)
+	err = q.Push(context.Background(), route, byteItem)
+	if errors.Is(err, queue.ErrItemTooLarge) {
+		logrus.Errorf("Failed to publish build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
+
+		return err
+	}
+
 	if err != nil {
 		logrus.Errorf("Retrying; Failed to publish build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
 
-		err = queue.Push(context.Background(), route, byteItem)
+		err = q.Push(context.Background(), route, byteItem)
 		if err != nil {
 			logrus.Errorf("Failed to publish build %d for %s: %v", b.GetNumber(), r.GetFullName(), err)
 
-			// error out the build
-			cleanBuild(db, b, nil, nil)
-
-			return
+			return fmt.Errorf("unable to publish to queue %s: %w", route, err)
 		}
 	}
 
@@ -786,10 +975,56 @@ func publishToQueue(queue queue.Service, db database.Service, p *pipeline.Build,
 	b.SetEnqueued(time.Now().UTC().Unix())
 
 	// update the build in the db to reflect the time it was enqueued
-	err = db.UpdateBuild(b)
+	err = db.UpdateBuild(ctx, b)
 	if err != nil {
 		logrus.Errorf("Failed to update build %d during publish to queue for %s: %v", b.GetNumber(), r.GetFullName(), err)
 	}
+
+	return nil
+}
+
+// closePullRequest is a helper function that verifies the webhook for
+// a closed pull request and triggers the teardown of the preview
+// environments that were deployed by the builds for the pull request.
+func closePullRequest(c *gin.Context, dupRequest *http.Request, h *library.Hook, r *library.Repo, number int) {
+	// send API call to capture parsed repo from webhook
+	dbRepo, err := database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
+	if err != nil {
+		c.JSON(http.StatusOK, "no build to process")
+
+		return
+	}
+
+	// verify the webhook from the source control provider
+	if c.Value("webhookvalidation").(bool) {
+		err = verifyWebhook(c, dupRequest, dbRepo, captureOrgHook(c, dbRepo))
+		if err != nil {
+			retErr := fmt.Errorf("unable to verify webhook: %w", err)
+			util.HandleError(c, http.StatusUnauthorized, retErr)
+
+			return
+		}
+
+		status, err := verifyDelivery(c, dupRequest, dbRepo, h)
+		if err != nil {
+			retErr := fmt.Errorf("unable to verify webhook delivery: %w", err)
+			util.HandleError(c, status, retErr)
+
+			return
+		}
+
+		defer releaseDelivery(c, h)
+	}
+
+	err = teardownPreviewEnvironments(c, dbRepo, number)
+	if err != nil {
+		retErr := fmt.Errorf("unable to tear down preview environments for %s/pull/%d: %w", dbRepo.GetFullName(), number, err)
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("no build to process, pull request %s/pull/%d closed", dbRepo.GetFullName(), number))
 }
 
 // renameRepository is a helper function that takes the old name of the repo,
@@ -801,7 +1036,7 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 	// get the old name of the repo
 	previousName := r.GetPreviousName()
 	// get the repo from the database that matches the old name
-	dbR, err := database.FromContext(c).GetRepoForOrg(r.GetOrg(), previousName)
+	dbR, err := database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), previousName)
 	if err != nil {
 		retErr := fmt.Errorf("%s: failed to get repo %s/%s from database", baseErr, r.GetOrg(), previousName)
 		util.HandleError(c, http.StatusBadRequest, retErr)
@@ -820,7 +1055,7 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 	dbR.SetPreviousName(previousName)
 
 	// update the repo in the database
-	err = database.FromContext(c).UpdateRepo(dbR)
+	err = database.FromContext(c).UpdateRepo(c, dbR)
 	if err != nil {
 		retErr := fmt.Errorf("%s: failed to update repo %s/%s in database", baseErr, r.GetOrg(), previousName)
 		util.HandleError(c, http.StatusBadRequest, retErr)
@@ -835,7 +1070,7 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 	h.SetRepoID(r.GetID())
 
 	// send API call to capture the last hook for the repo
-	lastHook, err := database.FromContext(c).LastHookForRepo(dbR)
+	lastHook, err := database.FromContext(c).LastHookForRepo(c, dbR)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get last hook for repo %s: %w", r.GetFullName(), err)
 		util.HandleError(c, http.StatusInternalServerError, retErr)
@@ -854,7 +1089,7 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 	}
 
 	// get total number of secrets associated with repository
-	t, err := database.FromContext(c).GetTypeSecretCount(constants.SecretRepo, r.GetOrg(), previousName, []string{})
+	t, err := database.FromContext(c).GetTypeSecretCount(c, constants.SecretRepo, r.GetOrg(), previousName, []string{})
 	if err != nil {
 		return fmt.Errorf("unable to get secret count for repo %s/%s: %w", r.GetOrg(), previousName, err)
 	}
@@ -863,7 +1098,7 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 	page := 1
 	// capture all secrets belonging to certain repo in database
 	for repoSecrets := int64(0); repoSecrets < t; repoSecrets += 100 {
-		s, err := database.FromContext(c).GetTypeSecretList(constants.SecretRepo, r.GetOrg(), previousName, page, 100, []string{})
+		s, err := database.FromContext(c).GetTypeSecretList(c, constants.SecretRepo, r.GetOrg(), previousName, page, 100, []string{})
 		if err != nil {
 			return fmt.Errorf("unable to get secret list for repo %s/%s: %w", r.GetOrg(), previousName, err)
 		}
@@ -877,14 +1112,14 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 	for _, secret := range secrets {
 		secret.SetRepo(r.GetName())
 
-		err = database.FromContext(c).UpdateSecret(secret)
+		err = database.FromContext(c).UpdateSecret(c, secret)
 		if err != nil {
 			return fmt.Errorf("unable to update secret for repo %s/%s: %w", r.GetOrg(), previousName, err)
 		}
 	}
 
 	// get total number of builds associated with repository
-	t, err = database.FromContext(c).GetRepoBuildCount(dbR, nil)
+	t, err = database.FromContext(c).GetRepoBuildCount(c, dbR, nil)
 	if err != nil {
 		return fmt.Errorf("unable to get build count for repo %s: %w", dbR.GetFullName(), err)
 	}
@@ -893,7 +1128,7 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 	page = 1
 	// capture all builds belonging to repo in database
 	for build := int64(0); build < t; build += 100 {
-		b, _, err := database.FromContext(c).GetRepoBuildList(dbR, nil, time.Now().Unix(), 0, page, 100)
+		b, _, err := database.FromContext(c).GetRepoBuildList(c, dbR, nil, time.Now().Unix(), 0, page, 100)
 		if err != nil {
 			return fmt.Errorf("unable to get build list for repo %s: %w", dbR.GetFullName(), err)
 		}
@@ -909,7 +1144,7 @@ func renameRepository(h *library.Hook, r *library.Repo, c *gin.Context, m *types
 			fmt.Sprintf("%s/%s/%d", m.Vela.WebAddress, dbR.GetFullName(), build.GetNumber()),
 		)
 
-		err = database.FromContext(c).UpdateBuild(build)
+		err = database.FromContext(c).UpdateBuild(c, build)
 		if err != nil {
 			return fmt.Errorf("unable to update build for repo %s: %w", dbR.GetFullName(), err)
 		}
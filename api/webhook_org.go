@@ -0,0 +1,276 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/requiredcontext"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/verify"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// captureOrgHook is a helper function to capture the active
+// org hook for the org of the repo parsed from the webhook.
+func captureOrgHook(c *gin.Context, r *library.Repo) *types.OrgHook {
+	if r == nil {
+		return nil
+	}
+
+	// send API call to capture the org hook for the org
+	oh, err := database.FromContext(c).GetOrgHookForOrg(c, r.GetOrg())
+	if err != nil || !oh.GetActive() {
+		return nil
+	}
+
+	return oh
+}
+
+// verifyWebhook is a helper function to verify the webhook from
+// the source control provider. Webhooks delivered by the org hook
+// are signed with the secret for the org hook instead of the repo hash.
+func verifyWebhook(c *gin.Context, request *http.Request, r *library.Repo, oh *types.OrgHook) error {
+	if oh != nil {
+		// copy the repo to avoid overwriting the repo hash
+		orgRepo := *r
+		orgRepo.SetHash(oh.GetSecret())
+
+		r = &orgRepo
+	}
+
+	return scm.FromContext(c).VerifyWebhook(request, r)
+}
+
+// verifyIntake is a helper function to verify the webhook from the
+// source control provider before it is buffered in the intake. The
+// repo parsed from the webhook is captured to verify the signature
+// with the secret for the repo or the org hook delivering it.
+func verifyIntake(c *gin.Context, request *http.Request, payload []byte) error {
+	// process the webhook to capture the repo it was delivered for
+	webhook, err := scm.FromContext(c).ProcessWebhook(request)
+	if err != nil {
+		return fmt.Errorf("unable to parse webhook: %w", err)
+	}
+
+	// reset the request body after processing the webhook
+	request.Body = io.NopCloser(bytes.NewReader(payload))
+
+	r := webhook.Repo
+	if r == nil {
+		return errors.New("failed to parse repo from webhook")
+	}
+
+	// capture the active org hook for the repo
+	orgHook := captureOrgHook(c, r)
+	if orgHook == nil {
+		// send API call to capture the repo with the secret for the webhook
+		r, err = database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
+		if err != nil {
+			return fmt.Errorf("failed to get repo %s: %w", webhook.Repo.GetFullName(), err)
+		}
+	}
+
+	err = verifyWebhook(c, request, r, orgHook)
+
+	// reset the request body after verifying the webhook
+	request.Body = io.NopCloser(bytes.NewReader(payload))
+
+	return err
+}
+
+// verifyDelivery is a helper function to record the delivery of the
+// webhook from the source control provider and reject the webhook
+// when the delivery was already received or is stale. It returns the
+// status code to respond with when the delivery is rejected.
+func verifyDelivery(c *gin.Context, request *http.Request, r *library.Repo, h *library.Hook) (int, error) {
+	// check if the verifier is configured
+	v := verify.FromContext(c)
+	if v == nil {
+		return http.StatusOK, nil
+	}
+
+	// use the context of the request received by the server
+	// to capture when the webhook was received
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/verify?tab=doc#Received
+	request = request.WithContext(c.Request.Context())
+
+	err := v.Delivery(request, scm.FromContext(c).Driver(), h.GetSourceID(), r.GetID())
+	if err != nil {
+		if errors.Is(err, verify.ErrReplayed) {
+			return http.StatusConflict, err
+		}
+
+		return http.StatusUnauthorized, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// releaseDelivery is a helper function to release the delivery of
+// the webhook when it was not processed successfully, so the source
+// control provider is able to redeliver the webhook.
+func releaseDelivery(c *gin.Context, h *library.Hook) {
+	// check if the webhook was processed successfully
+	if c.Writer.Status() >= http.StatusOK && c.Writer.Status() < http.StatusMultipleChoices {
+		return
+	}
+
+	if v := verify.FromContext(c); v != nil {
+		err := v.Release(c, h.GetSourceID())
+		if err != nil {
+			logrus.Errorf("unable to release webhook delivery %s: %v", h.GetSourceID(), err)
+		}
+	}
+}
+
+// enableOrgHookRepo is a helper function to enable the repo parsed
+// from a webhook delivered by the org hook. The repo is owned by
+// the user that configured the org hook to enable repos and uses
+// the same defaults as a repo enabled through the API.
+func enableOrgHookRepo(c *gin.Context, request *http.Request, r *library.Repo, oh *types.OrgHook) error {
+	// capture middleware values
+	allowlist := c.Value("allowlist").([]string)
+	defaultBuildLimit := c.Value("defaultBuildLimit").(int64)
+	defaultTimeout := c.Value("defaultTimeout").(int64)
+	defaultRepoEvents := c.Value("defaultRepoEvents").([]string)
+
+	// send API call to capture the repo from the database
+	dbRepo, err := database.FromContext(c).GetRepoForOrg(c, r.GetOrg(), r.GetName())
+	if err == nil && dbRepo.GetActive() {
+		return nil
+	}
+
+	// verify the webhook before enabling the repo
+	if c.Value("webhookvalidation").(bool) {
+		err = verifyWebhook(c, request, r, oh)
+		if err != nil {
+			return fmt.Errorf("unable to verify webhook: %w", err)
+		}
+	}
+
+	// ensure repo is allowed to be activated
+	if !util.CheckAllowlist(r, allowlist) {
+		return fmt.Errorf("%s is not on allowlist", r.GetFullName())
+	}
+
+	// send API call to capture the user that owns the org hook
+	u, err := database.FromContext(c).GetUser(c, oh.GetUserID())
+	if err != nil {
+		return fmt.Errorf("unable to get owner for org hook for org %s: %w", oh.GetOrg(), err)
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Infof("enabling repo %s for org hook", r.GetFullName())
+
+	// repo exists but is inactive
+	if len(dbRepo.GetOrg()) > 0 {
+		// update the repo owner
+		dbRepo.SetUserID(u.GetID())
+		// update the default branch
+		dbRepo.SetBranch(r.GetBranch())
+		// activate the repo
+		dbRepo.SetActive(true)
+
+		// send API call to update the repo
+		err = database.FromContext(c).UpdateRepo(c, dbRepo)
+		if err != nil {
+			return err
+		}
+
+		protectOrgHookRepo(c, u, dbRepo)
+
+		return nil
+	}
+
+	// send API call to capture the repo from the source
+	sr, err := scm.FromContext(c).GetRepo(u, r)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve repo info for %s from source: %w", r.GetFullName(), err)
+	}
+
+	sr.SetUserID(u.GetID())
+	sr.SetActive(true)
+	sr.SetBuildLimit(defaultBuildLimit)
+	sr.SetPipelineType(constants.PipelineTypeYAML)
+
+	if defaultTimeout == 0 {
+		// default build timeout to 30m
+		sr.SetTimeout(constants.BuildTimeoutDefault)
+	} else {
+		sr.SetTimeout(defaultTimeout)
+	}
+
+	// private repos are not made public by enabling them
+	if sr.GetPrivate() {
+		sr.SetVisibility(constants.VisibilityPrivate)
+	} else {
+		sr.SetVisibility(constants.VisibilityPublic)
+	}
+
+	for _, event := range defaultRepoEvents {
+		switch event {
+		case constants.EventPull:
+			sr.SetAllowPull(true)
+		case constants.EventPush:
+			sr.SetAllowPush(true)
+		case constants.EventDeploy:
+			sr.SetAllowDeploy(true)
+		case constants.EventTag:
+			sr.SetAllowTag(true)
+		case constants.EventComment:
+			sr.SetAllowComment(true)
+		}
+	}
+
+	// create unique id for the repo
+	uid, err := uuid.NewRandom()
+	if err != nil {
+		return fmt.Errorf("unable to create UID for repo %s: %w", r.GetFullName(), err)
+	}
+
+	sr.SetHash(
+		base64.StdEncoding.EncodeToString(
+			[]byte(strings.TrimSpace(uid.String())),
+		),
+	)
+
+	// send API call to create the repo
+	err = database.FromContext(c).CreateRepo(c, sr)
+	if err != nil {
+		return err
+	}
+
+	protectOrgHookRepo(c, u, sr)
+
+	return nil
+}
+
+// protectOrgHookRepo is a helper function to configure the branch
+// protection for a repo enabled by the org hook to require the status
+// contexts for the repo. Failures are logged without failing the webhook.
+func protectOrgHookRepo(c *gin.Context, u *library.User, r *library.Repo) {
+	err := requiredcontext.Protect(c, u, r)
+	if err != nil {
+		logrus.Warnf("unable to configure branch protection for %s: %v", r.GetFullName(), err)
+	}
+}
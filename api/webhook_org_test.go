@@ -0,0 +1,359 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/server/scm/verify"
+	"github.com/go-vela/types/library"
+)
+
+func Test_captureOrgHook(t *testing.T) {
+	// setup types
+	_active := new(types.OrgHook)
+	_active.SetOrg("foo")
+	_active.SetWebhookID(1)
+	_active.SetSecret("baz")
+	_active.SetUserID(1)
+	_active.SetActive(true)
+
+	_inactive := new(types.OrgHook)
+	_inactive.SetOrg("bar")
+	_inactive.SetWebhookID(2)
+	_inactive.SetSecret("baz")
+	_inactive.SetUserID(1)
+	_inactive.SetActive(false)
+
+	_missing := new(types.OrgHook)
+	_missing.SetOrg("baz")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	for _, oh := range []*types.OrgHook{_active, _inactive} {
+		err = db.CreateOrgHook(context.TODO(), oh)
+		if err != nil {
+			t.Errorf("unable to create test org hook: %v", err)
+		}
+	}
+
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	database.ToContext(context, db)
+
+	// setup tests
+	tests := []struct {
+		name string
+		repo *library.Repo
+		want int64
+	}{
+		{
+			name: "active",
+			repo: &library.Repo{Org: _active.Org},
+			want: 1,
+		},
+		{
+			name: "inactive",
+			repo: &library.Repo{Org: _inactive.Org},
+			want: 0,
+		},
+		{
+			name: "missing",
+			repo: &library.Repo{Org: _missing.Org},
+			want: 0,
+		},
+		{
+			name: "nil repo",
+			repo: nil,
+			want: 0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := captureOrgHook(context, test.repo)
+
+			if got.GetWebhookID() != test.want {
+				t.Errorf("captureOrgHook webhook ID is %d, want %d", got.GetWebhookID(), test.want)
+			}
+
+			if got != nil && got.GetSecret() != "baz" {
+				t.Errorf("captureOrgHook secret is %s, want %s", got.GetSecret(), "baz")
+			}
+		})
+	}
+}
+
+func Test_verifyIntake(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("secret")
+	_repo.SetOrg("Codertocat")
+	_repo.SetName("Hello-World")
+	_repo.SetFullName("Codertocat/Hello-World")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+
+	payload, err := os.ReadFile("../scm/github/testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to read payload: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	client, _ := github.NewTest("https://github.com/")
+
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	database.ToContext(context, db)
+	scm.ToContext(context, client)
+
+	// setup tests
+	tests := []struct {
+		name      string
+		signature string
+		repo      bool
+		failure   bool
+	}{
+		{
+			name:      "signed webhook",
+			signature: signature,
+			repo:      true,
+			failure:   false,
+		},
+		{
+			name:      "unsigned webhook",
+			signature: "",
+			repo:      true,
+			failure:   true,
+		},
+		{
+			name:      "forged webhook",
+			signature: "sha256=" + hex.EncodeToString(make([]byte, sha256.Size)),
+			repo:      true,
+			failure:   true,
+		},
+		{
+			name:      "unknown repo",
+			signature: signature,
+			repo:      false,
+			failure:   true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db.Sqlite.Exec("delete from repos;")
+
+			if test.repo {
+				err := db.CreateRepo(context, _repo)
+				if err != nil {
+					t.Errorf("unable to create test repo: %v", err)
+				}
+			}
+
+			request := httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(payload))
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-GitHub-Event", "push")
+			request.Header.Set("X-GitHub-Hook-ID", "123456")
+			request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+			if len(test.signature) > 0 {
+				request.Header.Set("X-Hub-Signature-256", test.signature)
+			}
+
+			err := verifyIntake(context, request, payload)
+
+			if test.failure != (err != nil) {
+				t.Errorf("verifyIntake returned err: %v", err)
+			}
+		})
+	}
+}
+
+func Test_verifyDelivery(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+
+	_hook := new(library.Hook)
+	_hook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	client, _ := github.NewTest("https://github.com/")
+
+	verifier, _ := verify.New(verify.WithDatabase(db))
+
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(httptest.NewRecorder())
+	scm.ToContext(context, client)
+
+	request := httptest.NewRequest(http.MethodPost, "/webhook", nil)
+	context.Request = request
+
+	// run test without the verifier
+	status, err := verifyDelivery(context, request, _repo, _hook)
+	if err != nil || status != http.StatusOK {
+		t.Errorf("verifyDelivery without verifier is %d: %v, want %d", status, err, http.StatusOK)
+	}
+
+	verify.ToContext(context, verifier)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		date    string
+		want    int
+		failure bool
+	}{
+		{
+			name:    "first delivery",
+			want:    http.StatusOK,
+			failure: false,
+		},
+		{
+			name:    "replayed delivery",
+			want:    http.StatusConflict,
+			failure: true,
+		},
+		{
+			name:    "stale delivery",
+			date:    "Mon, 02 Jan 2006 15:04:05 GMT",
+			want:    http.StatusUnauthorized,
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if len(test.date) > 0 {
+				request.Header.Set("Date", test.date)
+			}
+
+			status, err := verifyDelivery(context, request, _repo, _hook)
+
+			if status != test.want {
+				t.Errorf("verifyDelivery status is %d, want %d", status, test.want)
+			}
+
+			if test.failure != (err != nil) {
+				t.Errorf("verifyDelivery returned err: %v", err)
+			}
+		})
+	}
+}
+
+func Test_releaseDelivery(t *testing.T) {
+	// setup types
+	_hook := new(library.Hook)
+	_hook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	verifier, _ := verify.New(verify.WithDatabase(db))
+
+	gin.SetMode(gin.TestMode)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		status   int
+		released bool
+	}{
+		{
+			name:     "success",
+			status:   http.StatusOK,
+			released: false,
+		},
+		{
+			name:     "created",
+			status:   http.StatusCreated,
+			released: false,
+		},
+		{
+			name:     "client error",
+			status:   http.StatusBadRequest,
+			released: true,
+		},
+		{
+			name:     "server error",
+			status:   http.StatusInternalServerError,
+			released: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			context, _ := gin.CreateTestContext(httptest.NewRecorder())
+			context.Request = httptest.NewRequest(http.MethodPost, "/webhook", nil)
+			verify.ToContext(context, verifier)
+
+			err := verifier.Delivery(context.Request, "github", _hook.GetSourceID(), 1)
+			if err != nil {
+				t.Errorf("Delivery returned err: %v", err)
+			}
+
+			context.Status(test.status)
+
+			releaseDelivery(context, _hook)
+
+			_, err = db.GetWebhookDelivery(context, _hook.GetSourceID())
+			if test.released != (err != nil) {
+				t.Errorf("releaseDelivery released is %v, want %v", err != nil, test.released)
+			}
+
+			_ = verifier.Release(context, _hook.GetSourceID())
+		})
+	}
+}
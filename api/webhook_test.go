@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/queue/redis"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+)
+
+func Test_publishToQueue_ItemTooLarge(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+
+	_build := new(library.Build)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetStatus(constants.StatusPending)
+
+	_pipeline := &pipeline.Build{
+		Steps: pipeline.ContainerSlice{
+			{Name: "clone", Image: "target/vela-git:v0.4.0", Number: 1, Environment: map[string]string{}},
+		},
+	}
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	_build, err = db.GetBuild(context.TODO(), 1, _repo)
+	if err != nil {
+		t.Errorf("unable to get test build: %v", err)
+	}
+
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	_queue, err := redis.New(
+		redis.WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+		redis.WithChannels(constants.DefaultRoute),
+		redis.WithMaxItemSize(1),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	// run test
+	publishToQueue(context.TODO(), _queue, db, nil, _pipeline, _build, _repo, new(library.User))
+
+	got, err := db.GetBuild(context.TODO(), 1, _repo)
+	if err != nil {
+		t.Errorf("unable to get test build: %v", err)
+	}
+
+	if got.GetStatus() != constants.StatusError {
+		t.Errorf("publishToQueue build status is %s, want %s", got.GetStatus(), constants.StatusError)
+	}
+
+	if !strings.Contains(got.GetError(), "max item size") {
+		t.Errorf("publishToQueue build error is %s, want max item size error", got.GetError())
+	}
+
+	if _redis.Exists(constants.DefaultRoute) {
+		t.Errorf("publishToQueue pushed item exceeding the max item size")
+	}
+}
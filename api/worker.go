@@ -81,7 +81,19 @@ func CreateWorker(c *gin.Context) {
 		"worker": input.GetHostname(),
 	}).Infof("creating new worker %s", input.GetHostname())
 
-	err = database.FromContext(c).CreateWorker(input)
+	// consume the single-use registration for an ephemeral worker
+	if cl.IsEphemeralRegister() {
+		err = registerEphemeralWorker(c, database.FromContext(c), cl, input)
+		if err != nil {
+			retErr := fmt.Errorf("unable to register ephemeral worker %s: %w", input.GetHostname(), err)
+
+			util.HandleError(c, http.StatusUnauthorized, retErr)
+
+			return
+		}
+	}
+
+	err = database.FromContext(c).CreateWorker(c, input)
 	if err != nil {
 		retErr := fmt.Errorf("unable to create worker: %w", err)
 
@@ -109,7 +121,9 @@ func CreateWorker(c *gin.Context) {
 	default:
 		tm := c.MustGet("token-manager").(*token.Manager)
 
+		// the build id binds an ephemeral worker to its build
 		wmto := &token.MintTokenOpts{
+			BuildID:       cl.BuildID,
 			TokenType:     constants.WorkerAuthTokenType,
 			TokenDuration: tm.WorkerAuthTokenDuration,
 			Hostname:      cl.Subject,
@@ -166,7 +180,7 @@ func GetWorkers(c *gin.Context) {
 		"user": u.GetName(),
 	}).Info("reading workers")
 
-	w, err := database.FromContext(c).ListWorkers()
+	w, err := database.FromContext(c).ListWorkers(c)
 	if err != nil {
 		retErr := fmt.Errorf("unable to get workers: %w", err)
 
@@ -218,7 +232,7 @@ func GetWorker(c *gin.Context) {
 		"worker": w.GetHostname(),
 	}).Infof("reading worker %s", w.GetHostname())
 
-	w, err := database.FromContext(c).GetWorkerForHostname(w.GetHostname())
+	w, err := database.FromContext(c).GetWorkerForHostname(c, w.GetHostname())
 	if err != nil {
 		retErr := fmt.Errorf("unable to get workers: %w", err)
 
@@ -312,7 +326,7 @@ func UpdateWorker(c *gin.Context) {
 	}
 
 	// send API call to update the worker
-	err = database.FromContext(c).UpdateWorker(w)
+	err = database.FromContext(c).UpdateWorker(c, w)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update worker %s: %w", w.GetHostname(), err)
 
@@ -322,7 +336,7 @@ func UpdateWorker(c *gin.Context) {
 	}
 
 	// send API call to capture the updated worker
-	w, _ = database.FromContext(c).GetWorkerForHostname(w.GetHostname())
+	w, _ = database.FromContext(c).GetWorkerForHostname(c, w.GetHostname())
 
 	c.JSON(http.StatusOK, w)
 }
@@ -371,7 +385,7 @@ func RefreshWorkerAuth(c *gin.Context) {
 	w.SetLastCheckedIn(time.Now().Unix())
 
 	// send API call to update the worker
-	err := database.FromContext(c).UpdateWorker(w)
+	err := database.FromContext(c).UpdateWorker(c, w)
 	if err != nil {
 		retErr := fmt.Errorf("unable to update worker %s: %w", w.GetHostname(), err)
 
@@ -407,6 +421,7 @@ func RefreshWorkerAuth(c *gin.Context) {
 		tm := c.MustGet("token-manager").(*token.Manager)
 
 		wmto := &token.MintTokenOpts{
+			BuildID:       cl.BuildID,
 			TokenType:     constants.WorkerAuthTokenType,
 			TokenDuration: tm.WorkerAuthTokenDuration,
 			Hostname:      cl.Subject,
@@ -470,7 +485,7 @@ func DeleteWorker(c *gin.Context) {
 	}).Infof("deleting worker %s", w.GetHostname())
 
 	// send API call to remove the step
-	err := database.FromContext(c).DeleteWorker(w)
+	err := database.FromContext(c).DeleteWorker(c, w)
 	if err != nil {
 		retErr := fmt.Errorf("unable to delete worker %s: %w", w.GetHostname(), err)
 
@@ -0,0 +1,350 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation POST /api/v1/admin/workers/ephemeral admin CreateEphemeralRegistration
+//
+// Create a single-use registration for an ephemeral worker bound to a build
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the build for the ephemeral worker
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/EphemeralRegistration"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the ephemeral registration with its registration token
+//     schema:
+//       "$ref": "#/definitions/EphemeralRegistration"
+//   '400':
+//     description: Unable to create the ephemeral registration
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to create the ephemeral registration
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the ephemeral registration
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateEphemeralRegistration represents the API handler to create a
+// single-use registration for an ephemeral worker bound to a pending
+// build. A controller, like one scheduling a Kubernetes job for every
+// build, uses the returned token to register the worker. The worker
+// and the registration are torn down when the build completes.
+func CreateEphemeralRegistration(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(types.EphemeralRegistration)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new ephemeral registration: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	if input.GetBuildID() <= 0 {
+		retErr := fmt.Errorf("unable to create ephemeral registration: no build_id provided")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"build_id": input.GetBuildID(),
+		"user":     u.GetName(),
+	}).Infof("creating ephemeral registration for build %d", input.GetBuildID())
+
+	// send API call to capture the build for the registration
+	b, err := database.FromContext(c).GetBuildByID(c, input.GetBuildID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get build %d: %w", input.GetBuildID(), err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// an ephemeral worker can only be registered for a build that hasn't started
+	if !strings.EqualFold(b.GetStatus(), constants.StatusPending) {
+		retErr := fmt.Errorf("unable to create ephemeral registration: build %d is not in pending state", b.GetID())
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the repo for the build
+	r, err := database.FromContext(c).GetRepo(c, b.GetRepoID())
+	if err != nil {
+		retErr := fmt.Errorf("unable to get repo for build %d: %w", b.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	if len(input.GetHostname()) == 0 {
+		input.SetHostname(fmt.Sprintf("vela-ephemeral-%d", b.GetID()))
+	}
+
+	if len(input.GetRoute()) == 0 {
+		input.SetRoute(ephemeralRoute(c, database.FromContext(c), r))
+	}
+
+	input.SetRepoID(r.GetID())
+	input.SetRegistered(0)
+	input.SetCreated(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+
+	// send API call to create the ephemeral registration
+	err = database.FromContext(c).CreateEphemeralRegistration(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create ephemeral registration for build %d: %w", b.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created ephemeral registration
+	reg, err := database.FromContext(c).GetEphemeralRegistrationForBuild(c, b)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get ephemeral registration for build %d: %w", b.GetID(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	tm := c.MustGet("token-manager").(*token.Manager)
+
+	emto := &token.MintTokenOpts{
+		BuildID:       b.GetID(),
+		Hostname:      reg.GetHostname(),
+		TokenType:     token.EphemeralRegisterTokenType,
+		TokenDuration: tm.WorkerRegisterTokenDuration,
+	}
+
+	et, err := tm.MintToken(emto)
+	if err != nil {
+		retErr := fmt.Errorf("unable to generate registration token for worker %s: %w", reg.GetHostname(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	reg.SetToken(et)
+
+	c.JSON(http.StatusCreated, reg)
+}
+
+// swagger:operation DELETE /api/v1/admin/workers/ephemeral/{worker} admin DeleteEphemeralRegistration
+//
+// Tear down an ephemeral worker and its registration
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: worker
+//   description: Hostname of the ephemeral worker
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the ephemeral worker and its registration
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to delete the ephemeral registration
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the ephemeral registration
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteEphemeralRegistration represents the API handler to tear down
+// an ephemeral worker and its registration before the build completes,
+// like when the controller was unable to schedule the worker.
+func DeleteEphemeralRegistration(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	host := util.PathParameter(c, "worker")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user":   u.GetName(),
+		"worker": host,
+	}).Infof("deleting ephemeral registration for worker %s", host)
+
+	// send API call to capture the ephemeral registration
+	reg, err := database.FromContext(c).GetEphemeralRegistrationForHostname(c, host)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get ephemeral registration for worker %s: %w", host, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	err = teardownEphemeralWorker(c, database.FromContext(c), reg)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete ephemeral registration for worker %s: %w", host, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("ephemeral registration for worker %s deleted", host))
+}
+
+// ephemeralRoute is a helper function to decide the queue route an
+// ephemeral worker for a build in the repo should pull from. The route
+// for the worker group the repo is pinned to takes precedence over
+// the default route.
+func ephemeralRoute(ctx context.Context, db database.Service, r *library.Repo) string {
+	g, err := workerGroupForRepo(ctx, db, r)
+	if err != nil {
+		logrus.Errorf("unable to get worker group for %s: %v", r.GetFullName(), err)
+	}
+
+	if len(g.GetRoute()) > 0 {
+		return g.GetRoute()
+	}
+
+	return constants.DefaultRoute
+}
+
+// registerEphemeralWorker is a helper function to consume the ephemeral
+// registration for the worker being registered with the provided claims.
+// The worker is limited to the queue route for the registration.
+func registerEphemeralWorker(ctx context.Context, db database.Service, cl *token.Claims, w *library.Worker) error {
+	// send API call to capture the ephemeral registration
+	reg, err := db.GetEphemeralRegistrationForHostname(ctx, cl.Subject)
+	if err != nil {
+		return fmt.Errorf("unable to get ephemeral registration for worker %s: %w", cl.Subject, err)
+	}
+
+	err = validateEphemeralRegistration(reg, cl, w)
+	if err != nil {
+		return err
+	}
+
+	w.SetRoutes([]string{reg.GetRoute()})
+
+	reg.SetRegistered(time.Now().UTC().Unix())
+
+	// send API call to mark the ephemeral registration as used
+	return db.UpdateEphemeralRegistration(ctx, reg)
+}
+
+// validateEphemeralRegistration is a helper function to verify the
+// ephemeral registration can be used by the worker being registered
+// with the provided claims. A registration can only be used once.
+func validateEphemeralRegistration(reg *types.EphemeralRegistration, cl *token.Claims, w *library.Worker) error {
+	if reg.GetBuildID() != cl.BuildID {
+		return fmt.Errorf("ephemeral registration for worker %s is not bound to build %d", cl.Subject, cl.BuildID)
+	}
+
+	if reg.GetRegistered() > 0 {
+		return fmt.Errorf("ephemeral registration for worker %s was already used", cl.Subject)
+	}
+
+	if !strings.EqualFold(w.GetHostname(), reg.GetHostname()) {
+		return fmt.Errorf("worker %s does not match the ephemeral registration for worker %s", w.GetHostname(), reg.GetHostname())
+	}
+
+	return nil
+}
+
+// completeEphemeralWorker is a helper function to tear down the ephemeral
+// worker bound to the completed build. Failing to tear down the worker is
+// logged and doesn't fail the request.
+func completeEphemeralWorker(ctx context.Context, db database.Service, b *library.Build) {
+	// send API call to capture the ephemeral registration for the build
+	reg, err := db.GetEphemeralRegistrationForBuild(ctx, b)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logrus.Errorf("unable to get ephemeral registration for build %d: %v", b.GetID(), err)
+		}
+
+		return
+	}
+
+	err = teardownEphemeralWorker(ctx, db, reg)
+	if err != nil {
+		logrus.Errorf("unable to tear down ephemeral worker for build %d: %v", b.GetID(), err)
+	}
+}
+
+// teardownEphemeralWorker is a helper function to delete the
+// ephemeral worker, if it was registered, and its registration.
+func teardownEphemeralWorker(ctx context.Context, db database.Service, reg *types.EphemeralRegistration) error {
+	logrus.Infof("tearing down ephemeral worker %s for build %d", reg.GetHostname(), reg.GetBuildID())
+
+	// send API call to capture the ephemeral worker
+	w, err := db.GetWorkerForHostname(ctx, reg.GetHostname())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("unable to get worker %s: %w", reg.GetHostname(), err)
+	}
+
+	if w != nil {
+		// send API call to remove the ephemeral worker
+		err = db.DeleteWorker(ctx, w)
+		if err != nil {
+			return fmt.Errorf("unable to delete worker %s: %w", reg.GetHostname(), err)
+		}
+	}
+
+	// send API call to remove the ephemeral registration
+	return db.DeleteEphemeralRegistration(ctx, reg)
+}
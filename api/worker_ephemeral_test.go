@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/types/library"
+)
+
+func TestAPI_validateEphemeralRegistration(t *testing.T) {
+	// setup types
+	reg := new(types.EphemeralRegistration)
+	reg.SetBuildID(1)
+	reg.SetHostname("vela-ephemeral-1")
+	reg.SetRoute("vela")
+
+	used := new(types.EphemeralRegistration)
+	used.SetBuildID(1)
+	used.SetHostname("vela-ephemeral-1")
+	used.SetRegistered(1563474077)
+
+	cl := &token.Claims{BuildID: 1, TokenType: token.EphemeralRegisterTokenType}
+	cl.Subject = "vela-ephemeral-1"
+
+	w := new(library.Worker)
+	w.SetHostname("vela-ephemeral-1")
+
+	other := new(library.Worker)
+	other.SetHostname("vela-worker")
+
+	// setup tests
+	tests := []struct {
+		name    string
+		reg     *types.EphemeralRegistration
+		claims  *token.Claims
+		worker  *library.Worker
+		failure bool
+	}{
+		{
+			name:   "unused registration",
+			reg:    reg,
+			claims: cl,
+			worker: w,
+		},
+		{
+			name:    "used registration",
+			reg:     used,
+			claims:  cl,
+			worker:  w,
+			failure: true,
+		},
+		{
+			name:    "different build",
+			reg:     reg,
+			claims:  &token.Claims{BuildID: 2, TokenType: token.EphemeralRegisterTokenType},
+			worker:  w,
+			failure: true,
+		},
+		{
+			name:    "different worker",
+			reg:     reg,
+			claims:  cl,
+			worker:  other,
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateEphemeralRegistration(test.reg, test.claims, test.worker)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("validateEphemeralRegistration should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("validateEphemeralRegistration returned err: %v", err)
+			}
+		})
+	}
+}
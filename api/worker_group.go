@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// workerGroupForRepo is a helper function to capture the active worker
+// group the repo is pinned to. Pinning the repo by its full name takes
+// precedence over pinning the org for the repo. When the repo is not
+// pinned to any worker group, it returns nil.
+func workerGroupForRepo(ctx context.Context, db database.Service, r *library.Repo) (*types.WorkerGroup, error) {
+	// send API call to capture the worker groups
+	groups, err := db.ListWorkerGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list worker groups: %w", err)
+	}
+
+	return pinnedWorkerGroup(groups, r), nil
+}
+
+// pinnedWorkerGroup is a helper function to select the active
+// worker group from the provided list the repo is pinned to.
+func pinnedWorkerGroup(groups []*types.WorkerGroup, r *library.Repo) *types.WorkerGroup {
+	var pinned *types.WorkerGroup
+
+	for _, g := range groups {
+		if !g.GetActive() {
+			continue
+		}
+
+		if g.PinsRepo(r.GetFullName()) {
+			return g
+		}
+
+		if pinned == nil && g.PinsOrg(r.GetOrg()) {
+			pinned = g
+		}
+	}
+
+	return pinned
+}
+
+// verifyWorkerGroupLimit is a helper function to verify the number
+// of pending and running builds for the orgs and repos pinned to the
+// worker group for the repo doesn't exceed the build limit of the group.
+func verifyWorkerGroupLimit(ctx context.Context, db database.Service, r *library.Repo) error {
+	g, err := workerGroupForRepo(ctx, db, r)
+	if err != nil {
+		return err
+	}
+
+	// skip when the repo isn't pinned or the group has no limit
+	if g == nil || g.GetBuildLimit() <= 0 {
+		return nil
+	}
+
+	// create SQL filters for querying pending and running builds
+	filters := map[string]interface{}{
+		"status": []string{constants.StatusPending, constants.StatusRunning},
+	}
+
+	var builds int64
+
+	for _, org := range g.GetOrgs() {
+		// send API call to capture the number of pending or running builds for the org
+		count, err := db.GetOrgBuildCount(ctx, org, filters)
+		if err != nil {
+			return fmt.Errorf("unable to get count of builds for org %s: %w", org, err)
+		}
+
+		builds += count
+	}
+
+	for _, fullName := range g.GetRepos() {
+		parts := strings.SplitN(fullName, "/", 2)
+
+		// skip repos already counted with their org
+		if len(parts) != 2 || g.PinsOrg(parts[0]) {
+			continue
+		}
+
+		// send API call to capture the pinned repo
+		repo, err := db.GetRepoForOrg(ctx, parts[0], parts[1])
+		if err != nil {
+			continue
+		}
+
+		// send API call to capture the number of pending or running builds for the repo
+		count, err := db.GetRepoBuildCount(ctx, repo, filters)
+		if err != nil {
+			return fmt.Errorf("unable to get count of builds for repo %s: %w", fullName, err)
+		}
+
+		builds += count
+	}
+
+	if builds >= g.GetBuildLimit() {
+		return fmt.Errorf("worker group %s has exceeded the concurrent build limit of %d", g.GetName(), g.GetBuildLimit())
+	}
+
+	return nil
+}
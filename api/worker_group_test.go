@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package api
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestAPI_pinnedWorkerGroup(t *testing.T) {
+	// setup types
+	orgGroup := new(types.WorkerGroup)
+	orgGroup.SetName("general")
+	orgGroup.SetOrgs([]string{"github"})
+	orgGroup.SetActive(true)
+
+	repoGroup := new(types.WorkerGroup)
+	repoGroup.SetName("compliance")
+	repoGroup.SetRepos([]string{"github/octocat"})
+	repoGroup.SetActive(true)
+
+	inactiveGroup := new(types.WorkerGroup)
+	inactiveGroup.SetName("retired")
+	inactiveGroup.SetOrgs([]string{"octocat"})
+	inactiveGroup.SetActive(false)
+
+	groups := []*types.WorkerGroup{orgGroup, repoGroup, inactiveGroup}
+
+	// setup tests
+	tests := []struct {
+		name string
+		org  string
+		repo string
+		want *types.WorkerGroup
+	}{
+		{
+			name: "pinned repo",
+			org:  "github",
+			repo: "octocat",
+			want: repoGroup,
+		},
+		{
+			name: "pinned org",
+			org:  "github",
+			repo: "hello-world",
+			want: orgGroup,
+		},
+		{
+			name: "inactive group",
+			org:  "octocat",
+			repo: "hello-world",
+			want: nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := new(library.Repo)
+			r.SetOrg(test.org)
+			r.SetName(test.repo)
+			r.SetFullName(test.org + "/" + test.repo)
+
+			got := pinnedWorkerGroup(groups, r)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("pinnedWorkerGroup is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation POST /api/v1/workergroups workergroups CreateWorkerGroup
+//
+// Create a worker group in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: body
+//   name: body
+//   description: Payload containing the worker group to create
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/WorkerGroup"
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '201':
+//     description: Successfully created the worker group
+//     schema:
+//       "$ref": "#/definitions/WorkerGroup"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
+//   '400':
+//     description: Unable to create the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '409':
+//     description: Unable to create the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to create the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// CreateWorkerGroup represents the API handler to
+// create a worker group in the configured backend.
+func CreateWorkerGroup(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// capture body from API request
+	input := new(api.WorkerGroup)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for new worker group: %w", err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"group": input.GetName(),
+		"user":  u.GetName(),
+	}).Infof("creating new worker group %s", input.GetName())
+
+	if len(input.GetName()) == 0 {
+		retErr := fmt.Errorf("unable to create worker group: no name provided")
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to check if the worker group already exists
+	_, err = database.FromContext(c).GetWorkerGroupForName(c, input.GetName())
+	if err == nil {
+		retErr := fmt.Errorf("unable to create worker group %s: group already exists", input.GetName())
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	// new worker groups are always active
+	input.SetActive(true)
+
+	create(c, u, input)
+}
+
+// create is a helper function to set the defaults for the
+// new worker group, create the worker group and respond
+// with the created worker group.
+func create(c *gin.Context, u *library.User, input *api.WorkerGroup) {
+	// default the route to the name of the worker group
+	if len(input.GetRoute()) == 0 {
+		input.SetRoute(input.GetName())
+	}
+
+	// default the worker group to active
+	if input.Active == nil {
+		input.SetActive(true)
+	}
+
+	// update fields in worker group object
+	input.SetID(0)
+	input.SetCreatedAt(time.Now().UTC().Unix())
+	input.SetCreatedBy(u.GetName())
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy(u.GetName())
+
+	// send API call to create the worker group
+	err := database.FromContext(c).CreateWorkerGroup(c, input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to create worker group %s: %w", input.GetName(), err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the created worker group
+	g, _ := database.FromContext(c).GetWorkerGroupForName(c, input.GetName())
+
+	util.SetETag(c, etag(g))
+
+	c.JSON(http.StatusCreated, g)
+}
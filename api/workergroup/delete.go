@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation DELETE /api/v1/workergroups/{group} workergroups DeleteWorkerGroup
+//
+// Delete a worker group in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: group
+//   description: Name of the worker group
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully deleted the worker group
+//     schema:
+//       type: string
+//   '404':
+//     description: Unable to delete the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to delete the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// DeleteWorkerGroup represents the API handler to
+// remove a worker group from the configured backend.
+func DeleteWorkerGroup(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	name := util.PathParameter(c, "group")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"group": name,
+		"user":  u.GetName(),
+	}).Infof("deleting worker group %s", name)
+
+	// send API call to capture the worker group
+	g, err := database.FromContext(c).GetWorkerGroupForName(c, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get worker group %s: %w", name, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// send API call to remove the worker group
+	err = database.FromContext(c).DeleteWorkerGroup(c, g)
+	if err != nil {
+		retErr := fmt.Errorf("unable to delete worker group %s: %w", name, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("worker group %s deleted", name))
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/util"
+)
+
+// etag is a helper function to create the entity tag for the
+// fields of the worker group, leaving out the audit fields.
+func etag(g *api.WorkerGroup) string {
+	return util.ETag(map[string]interface{}{
+		"id":          g.GetID(),
+		"name":        g.GetName(),
+		"description": g.GetDescription(),
+		"route":       g.GetRoute(),
+		"build_limit": g.GetBuildLimit(),
+		"orgs":        g.GetOrgs(),
+		"repos":       g.GetRepos(),
+		"active":      g.GetActive(),
+	})
+}
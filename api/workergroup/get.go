@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/workergroups/{group} workergroups GetWorkerGroup
+//
+// Get a worker group in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: group
+//   description: Name of the worker group
+//   required: true
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the worker group
+//     schema:
+//       "$ref": "#/definitions/WorkerGroup"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
+//   '404':
+//     description: Unable to retrieve the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// GetWorkerGroup represents the API handler to capture
+// a worker group from the configured backend.
+func GetWorkerGroup(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	name := util.PathParameter(c, "group")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"group": name,
+		"user":  u.GetName(),
+	}).Infof("reading worker group %s", name)
+
+	// send API call to capture the worker group
+	g, err := database.FromContext(c).GetWorkerGroupForName(c, name)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get worker group %s: %w", name, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	util.SetETag(c, etag(g))
+
+	c.JSON(http.StatusOK, g)
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+// swagger:operation GET /api/v1/workergroups workergroups ListWorkerGroups
+//
+// Get the worker groups in the configured backend
+//
+// ---
+// produces:
+// - application/json
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully retrieved the worker groups
+//     schema:
+//       type: array
+//       items:
+//         "$ref": "#/definitions/WorkerGroup"
+//   '500':
+//     description: Unable to retrieve the worker groups
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// ListWorkerGroups represents the API handler to capture
+// the worker groups from the configured backend.
+func ListWorkerGroups(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Info("reading worker groups")
+
+	// send API call to capture the worker groups
+	groups, err := database.FromContext(c).ListWorkerGroups(c)
+	if err != nil {
+		retErr := fmt.Errorf("unable to get worker groups: %w", err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, groups)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/user"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// swagger:operation PUT /api/v1/workergroups/{group} workergroups UpdateWorkerGroup
+//
+// Update a worker group in the configured backend or create it when it doesn't exist
+//
+// ---
+// produces:
+// - application/json
+// parameters:
+// - in: path
+//   name: group
+//   description: Name of the worker group
+//   required: true
+//   type: string
+// - in: body
+//   name: body
+//   description: Payload containing the worker group fields to update
+//   required: true
+//   schema:
+//     "$ref": "#/definitions/WorkerGroup"
+// - in: header
+//   name: If-Match
+//   description: Entity tag the resource must match to be updated
+//   type: string
+// security:
+//   - ApiKeyAuth: []
+// responses:
+//   '200':
+//     description: Successfully updated the worker group
+//     schema:
+//       "$ref": "#/definitions/WorkerGroup"
+//     headers:
+//       ETag:
+//         description: Entity tag for the managed fields of the resource
+//         type: string
+//   '201':
+//     description: Successfully created the worker group
+//     schema:
+//       "$ref": "#/definitions/WorkerGroup"
+//   '400':
+//     description: Unable to update the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '404':
+//     description: Unable to update the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '412':
+//     description: The worker group was modified since it was last read
+//     schema:
+//       "$ref": "#/definitions/Error"
+//   '500':
+//     description: Unable to update the worker group
+//     schema:
+//       "$ref": "#/definitions/Error"
+
+// UpdateWorkerGroup represents the API handler to update a
+// worker group in the configured backend. When the worker group
+// doesn't exist, it's created with the provided fields so the
+// same request can be repeated to manage the worker group.
+func UpdateWorkerGroup(c *gin.Context) {
+	// capture middleware values
+	u := user.Retrieve(c)
+	name := util.PathParameter(c, "group")
+
+	// update engine logger with API metadata
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+	logrus.WithFields(logrus.Fields{
+		"group": name,
+		"user":  u.GetName(),
+	}).Infof("updating worker group %s", name)
+
+	// capture body from API request
+	input := new(api.WorkerGroup)
+
+	err := c.Bind(input)
+	if err != nil {
+		retErr := fmt.Errorf("unable to decode JSON for worker group %s: %w", name, err)
+
+		util.HandleError(c, http.StatusBadRequest, retErr)
+
+		return
+	}
+
+	// send API call to capture the worker group
+	g, err := database.FromContext(c).GetWorkerGroupForName(c, name)
+	if err != nil {
+		// create the worker group when it doesn't exist, unless the
+		// request expected to update an existing worker group
+		if errors.Is(err, gorm.ErrRecordNotFound) && len(c.GetHeader("If-Match")) == 0 {
+			input.SetName(name)
+
+			create(c, u, input)
+
+			return
+		}
+
+		retErr := fmt.Errorf("unable to get worker group %s: %w", name, err)
+
+		util.HandleError(c, http.StatusNotFound, retErr)
+
+		return
+	}
+
+	// check if the worker group was changed since it was last read
+	if !util.MatchETag(c, etag(g)) {
+		retErr := fmt.Errorf("unable to update worker group %s: group was modified", name)
+
+		util.HandleError(c, http.StatusPreconditionFailed, retErr)
+
+		return
+	}
+
+	if input.Description != nil {
+		// update description if set
+		g.SetDescription(input.GetDescription())
+	}
+
+	if len(input.GetRoute()) > 0 {
+		// update route if set
+		g.SetRoute(input.GetRoute())
+	}
+
+	if input.BuildLimit != nil {
+		// update build limit if set
+		g.SetBuildLimit(input.GetBuildLimit())
+	}
+
+	if input.Orgs != nil {
+		// update pinned orgs if set
+		g.SetOrgs(input.GetOrgs())
+	}
+
+	if input.Repos != nil {
+		// update pinned repos if set
+		g.SetRepos(input.GetRepos())
+	}
+
+	if input.Active != nil {
+		// update active if set
+		g.SetActive(input.GetActive())
+	}
+
+	g.SetUpdatedAt(time.Now().UTC().Unix())
+	g.SetUpdatedBy(u.GetName())
+
+	// send API call to update the worker group
+	err = database.FromContext(c).UpdateWorkerGroup(c, g)
+	if err != nil {
+		retErr := fmt.Errorf("unable to update worker group %s: %w", name, err)
+
+		util.HandleError(c, http.StatusInternalServerError, retErr)
+
+		return
+	}
+
+	// send API call to capture the updated worker group
+	g, _ = database.FromContext(c).GetWorkerGroupForName(c, name)
+
+	util.SetETag(c, etag(g))
+
+	c.JSON(http.StatusOK, g)
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package blob
+
+import (
+	"fmt"
+
+	"github.com/go-vela/server/blob/s3blob"
+
+	"github.com/sirupsen/logrus"
+)
+
+// New creates and returns a Vela service capable of
+// integrating with the configured blob provider.
+//
+// Currently the following blob providers are supported:
+//
+// * S3 (and compatible, like MinIO or Google Cloud Storage)
+// .
+func New(s *Setup) (Service, error) {
+	// validate the setup being provided
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/blob?tab=doc#Setup.Validate
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debug("creating blob service from setup")
+	// process the blob driver being provided
+	switch s.Driver {
+	case s3blob.DriverS3:
+		// handle the S3 blob driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/blob?tab=doc#Setup.S3
+		return s.S3()
+	default:
+		// handle an invalid blob driver being provided
+		return nil, fmt.Errorf("invalid blob driver provided: %s", s.Driver)
+	}
+}
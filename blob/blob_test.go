@@ -0,0 +1,56 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package blob
+
+import (
+	"testing"
+)
+
+func TestBlob_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:   "s3",
+				S3Bucket: "vela",
+				S3Region: "us-east-1",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:   "gcs",
+				S3Bucket: "vela",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(test.setup)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package blob provides the ability for Vela to integrate
+// with different supported blob storage backends used to
+// store large data, like logs, outside of the database.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/blob"
+package blob
@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package blob
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the blob storage.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Blob Flags
+
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BLOB_DRIVER", "BLOB_DRIVER"},
+		FilePath: "/vela/blob/driver",
+		Name:     "blob.driver",
+		Usage:    "driver used to store log data outside of the database (s3) - logs are stored in the database when not set",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BLOB_S3_BUCKET", "BLOB_S3_BUCKET"},
+		FilePath: "/vela/blob/s3/bucket",
+		Name:     "blob.s3.bucket",
+		Usage:    "bucket used to store the log data",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BLOB_S3_PREFIX", "BLOB_S3_PREFIX"},
+		FilePath: "/vela/blob/s3/prefix",
+		Name:     "blob.s3.prefix",
+		Usage:    "prefix for the objects storing the log data in the bucket",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BLOB_S3_ENDPOINT", "BLOB_S3_ENDPOINT"},
+		FilePath: "/vela/blob/s3/endpoint",
+		Name:     "blob.s3.endpoint",
+		Usage:    "fully qualified url (<scheme>://<host>) for an S3 compatible endpoint, like MinIO or Google Cloud Storage (i.e. https://storage.googleapis.com)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BLOB_S3_REGION", "BLOB_S3_REGION"},
+		FilePath: "/vela/blob/s3/region",
+		Name:     "blob.s3.region",
+		Usage:    "region of the bucket used to store the log data",
+		Value:    "us-east-1",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BLOB_S3_ACCESS_KEY", "BLOB_S3_ACCESS_KEY"},
+		FilePath: "/vela/blob/s3/access_key",
+		Name:     "blob.s3.access-key",
+		Usage:    "access key used to authenticate with the bucket (defaults to the AWS credential chain)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BLOB_S3_SECRET_KEY", "BLOB_S3_SECRET_KEY"},
+		FilePath: "/vela/blob/s3/secret_key",
+		Name:     "blob.s3.secret-key",
+		Usage:    "secret key used to authenticate with the bucket",
+	},
+}
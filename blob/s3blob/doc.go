@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package s3blob provides the ability for Vela to store data
+// in Amazon S3 or S3 compatible object storage, like MinIO
+// or the interoperability API for Google Cloud Storage.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/blob/s3blob"
+package s3blob
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package s3blob
+
+// DriverS3 defines the driver type when integrating with S3.
+const DriverS3 = "s3"
+
+// Driver outputs the configured blob driver.
+func (c *client) Driver() string {
+	return DriverS3
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package s3blob
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestS3_Driver(t *testing.T) {
+	// setup types
+	want := DriverS3
+
+	_service, err := New(
+		WithBucket("vela"),
+		WithAPI(newMockS3()),
+	)
+	if err != nil {
+		t.Errorf("unable to create blob service: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
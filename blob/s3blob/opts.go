@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package s3blob
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// ClientOpt represents a configuration option to initialize the blob client for S3.
+type ClientOpt func(*client) error
+
+// WithBucket sets the bucket in the blob client for S3.
+func WithBucket(bucket string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring bucket in s3 blob client")
+
+		// check if the S3 bucket provided is empty
+		if len(bucket) == 0 {
+			return fmt.Errorf("no S3 bucket provided")
+		}
+
+		// set the bucket in the s3 blob client
+		c.config.Bucket = bucket
+
+		return nil
+	}
+}
+
+// WithPrefix sets the prefix for the objects in the blob client for S3.
+func WithPrefix(prefix string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring prefix in s3 blob client")
+
+		// set the prefix in the s3 blob client
+		c.config.Prefix = strings.Trim(prefix, "/")
+
+		return nil
+	}
+}
+
+// WithEndpoint sets the S3 compatible endpoint in the blob client for S3.
+func WithEndpoint(endpoint string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring endpoint in s3 blob client")
+
+		// set the endpoint in the s3 blob client
+		c.config.Endpoint = endpoint
+
+		return nil
+	}
+}
+
+// WithRegion sets the region in the blob client for S3.
+func WithRegion(region string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring region in s3 blob client")
+
+		// check if the S3 region provided is empty
+		if len(region) == 0 {
+			return nil
+		}
+
+		// set the region in the s3 blob client
+		c.config.Region = region
+
+		return nil
+	}
+}
+
+// WithAccessKey sets the access key in the blob client for S3.
+func WithAccessKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring access key in s3 blob client")
+
+		// set the access key in the s3 blob client
+		c.config.AccessKey = key
+
+		return nil
+	}
+}
+
+// WithSecretKey sets the secret key in the blob client for S3.
+func WithSecretKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring secret key in s3 blob client")
+
+		// set the secret key in the s3 blob client
+		c.config.SecretKey = key
+
+		return nil
+	}
+}
+
+// WithAPI sets the S3 API client in the blob client for S3.
+//
+// This function is intended for running tests only.
+func WithAPI(api s3iface.S3API) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring api in s3 blob client")
+
+		// set the api in the s3 blob client
+		c.S3 = api
+
+		return nil
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package s3blob
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestS3_ClientOpt_WithBucket(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		bucket  string
+		want    string
+	}{
+		{
+			failure: false,
+			bucket:  "vela",
+			want:    "vela",
+		},
+		{
+			failure: true,
+			bucket:  "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithBucket(test.bucket),
+			WithAPI(newMockS3()),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithBucket should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithBucket returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Bucket, test.want) {
+			t.Errorf("WithBucket is %v, want %v", _service.config.Bucket, test.want)
+		}
+	}
+}
+
+func TestS3_ClientOpt_WithPrefix(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{
+			prefix: "/vela/logs/",
+			want:   "vela/logs",
+		},
+		{
+			prefix: "",
+			want:   "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithBucket("vela"),
+			WithPrefix(test.prefix),
+			WithAPI(newMockS3()),
+		)
+		if err != nil {
+			t.Errorf("WithPrefix returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Prefix, test.want) {
+			t.Errorf("WithPrefix is %v, want %v", _service.config.Prefix, test.want)
+		}
+	}
+}
+
+func TestS3_ClientOpt_WithRegion(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{
+			region: "us-west-2",
+			want:   "us-west-2",
+		},
+		{
+			region: "",
+			want:   "us-east-1",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithBucket("vela"),
+			WithRegion(test.region),
+			WithAPI(newMockS3()),
+		)
+		if err != nil {
+			t.Errorf("WithRegion returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Region, test.want) {
+			t.Errorf("WithRegion is %v, want %v", _service.config.Region, test.want)
+		}
+	}
+}
+
+func TestS3_ClientOpt_WithCredentials(t *testing.T) {
+	// setup types
+	_service, err := New(
+		WithBucket("vela"),
+		WithEndpoint("http://minio:9000"),
+		WithAccessKey("foo"),
+		WithSecretKey("bar"),
+		WithAPI(newMockS3()),
+	)
+	if err != nil {
+		t.Errorf("unable to create blob service: %v", err)
+	}
+
+	// run test
+	if !reflect.DeepEqual(_service.config.Endpoint, "http://minio:9000") {
+		t.Errorf("WithEndpoint is %v, want %v", _service.config.Endpoint, "http://minio:9000")
+	}
+
+	if !reflect.DeepEqual(_service.config.AccessKey, "foo") {
+		t.Errorf("WithAccessKey is %v, want %v", _service.config.AccessKey, "foo")
+	}
+
+	if !reflect.DeepEqual(_service.config.SecretKey, "bar") {
+		t.Errorf("WithSecretKey is %v, want %v", _service.config.SecretKey, "bar")
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package s3blob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	config struct {
+		// specifies the bucket to use for the S3 client
+		Bucket string
+		// specifies the prefix for the objects to use for the S3 client
+		Prefix string
+		// specifies the S3 compatible endpoint to use for the S3 client
+		Endpoint string
+		// specifies the region to use for the S3 client
+		Region string
+		// specifies the access key to use for the S3 client
+		AccessKey string
+		// specifies the secret key to use for the S3 client
+		SecretKey string
+	}
+
+	client struct {
+		config *config
+		S3     s3iface.S3API
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		Logger *logrus.Entry
+	}
+)
+
+// New returns a Blob implementation that integrates with S3.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new S3 client
+	c := new(client)
+
+	// create new fields
+	c.config = &config{
+		Region: "us-east-1",
+	}
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("blob", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if an S3 API client was provided
+	if c.S3 != nil {
+		return c, nil
+	}
+
+	cfg := &aws.Config{
+		Region: aws.String(c.config.Region),
+	}
+
+	// use path style addressing for S3 compatible endpoints
+	if len(c.config.Endpoint) > 0 {
+		cfg.Endpoint = aws.String(c.config.Endpoint)
+		cfg.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	// use static credentials when provided, otherwise
+	// fall back to the default AWS credential chain
+	if len(c.config.AccessKey) > 0 {
+		cfg.Credentials = credentials.NewStaticCredentials(c.config.AccessKey, c.config.SecretKey, "")
+	}
+
+	// create new AWS session
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/aws/session#NewSession
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// create new S3 API client
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/service/s3#New
+	c.S3 = s3.New(sess)
+
+	return c, nil
+}
+
+// Put stores the data in the object for the key in the bucket.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObject.html
+func (c *client) Put(ctx context.Context, key string, data []byte) error {
+	c.Logger.Tracef("putting object %s in bucket %s", c.object(key), c.config.Bucket)
+
+	// send API call to upload the object
+	_, err := c.S3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(data),
+		Bucket: aws.String(c.config.Bucket),
+		Key:    aws.String(c.object(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to put object %s: %w", c.object(key), err)
+	}
+
+	return nil
+}
+
+// Get captures the data in the object for the key from the bucket.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObject.html
+func (c *client) Get(ctx context.Context, key string) ([]byte, error) {
+	c.Logger.Tracef("getting object %s from bucket %s", c.object(key), c.config.Bucket)
+
+	// send API call to download the object
+	out, err := c.S3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.config.Bucket),
+		Key:    aws.String(c.object(key)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get object %s: %w", c.object(key), err)
+	}
+
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read object %s: %w", c.object(key), err)
+	}
+
+	return data, nil
+}
+
+// Delete removes the object for the key from the bucket.
+//
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObject.html
+func (c *client) Delete(ctx context.Context, key string) error {
+	c.Logger.Tracef("deleting object %s from bucket %s", c.object(key), c.config.Bucket)
+
+	// send API call to remove the object
+	_, err := c.S3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.config.Bucket),
+		Key:    aws.String(c.object(key)),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete object %s: %w", c.object(key), err)
+	}
+
+	return nil
+}
+
+// object is a helper function to create
+// the name of the object for the key.
+func (c *client) object(key string) string {
+	if len(c.config.Prefix) == 0 {
+		return key
+	}
+
+	return path.Join(c.config.Prefix, key)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package s3blob
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+// mockS3 represents a fake S3 API that
+// stores the objects for a bucket in memory.
+type mockS3 struct {
+	s3iface.S3API
+
+	bucket  string
+	objects map[string][]byte
+}
+
+func newMockS3() *mockS3 {
+	return &mockS3{objects: make(map[string][]byte)}
+}
+
+func (m *mockS3) PutObjectWithContext(ctx aws.Context, in *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+	m.bucket = aws.StringValue(in.Bucket)
+
+	data, err := io.ReadAll(in.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	m.objects[aws.StringValue(in.Key)] = data
+
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (m *mockS3) GetObjectWithContext(ctx aws.Context, in *s3.GetObjectInput, opts ...request.Option) (*s3.GetObjectOutput, error) {
+	m.bucket = aws.StringValue(in.Bucket)
+
+	data, ok := m.objects[aws.StringValue(in.Key)]
+	if !ok {
+		return nil, errors.New(s3.ErrCodeNoSuchKey)
+	}
+
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(data))}, nil
+}
+
+func (m *mockS3) DeleteObjectWithContext(ctx aws.Context, in *s3.DeleteObjectInput, opts ...request.Option) (*s3.DeleteObjectOutput, error) {
+	m.bucket = aws.StringValue(in.Bucket)
+
+	delete(m.objects, aws.StringValue(in.Key))
+
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+func TestS3_PutGetDelete(t *testing.T) {
+	// setup types
+	data := []byte("hello world")
+	api := newMockS3()
+
+	_service, err := New(
+		WithBucket("vela"),
+		WithPrefix("/logs/"),
+		WithAPI(api),
+	)
+	if err != nil {
+		t.Errorf("unable to create blob service: %v", err)
+	}
+
+	// run test
+	err = _service.Put(context.Background(), "1/step/1", data)
+	if err != nil {
+		t.Errorf("Put returned err: %v", err)
+	}
+
+	if api.bucket != "vela" {
+		t.Errorf("Put used bucket %v, want vela", api.bucket)
+	}
+
+	if _, ok := api.objects["logs/1/step/1"]; !ok {
+		t.Errorf("Put objects are %v, want logs/1/step/1", api.objects)
+	}
+
+	got, err := _service.Get(context.Background(), "1/step/1")
+	if err != nil {
+		t.Errorf("Get returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("Get is %s, want %s", got, data)
+	}
+
+	err = _service.Delete(context.Background(), "1/step/1")
+	if err != nil {
+		t.Errorf("Delete returned err: %v", err)
+	}
+
+	_, err = _service.Get(context.Background(), "1/step/1")
+	if err == nil {
+		t.Errorf("Get should have returned err")
+	}
+}
+
+func TestS3_New(t *testing.T) {
+	// run test
+	_service, err := New(
+		WithBucket("vela"),
+		WithEndpoint("http://minio:9000"),
+		WithAccessKey("foo"),
+		WithSecretKey("bar"),
+	)
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	if _service.S3 == nil {
+		t.Errorf("New S3 API is nil")
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package blob
+
+import "context"
+
+// Service represents the interface for Vela integrating
+// with the different supported blob storage providers.
+type Service interface {
+	// Service Interface Functions
+
+	// Driver defines a function that outputs
+	// the configured blob driver.
+	Driver() string
+
+	// Put defines a function that stores
+	// the data for the provided key.
+	Put(context.Context, string, []byte) error
+	// Get defines a function that captures
+	// the data stored for the provided key.
+	Get(context.Context, string) ([]byte, error)
+	// Delete defines a function that removes
+	// the data stored for the provided key.
+	Delete(context.Context, string) error
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package blob
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/blob/s3blob"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Setup represents the configuration necessary for
+// creating a Vela service capable of integrating
+// with a configured blob system.
+type Setup struct {
+	// Blob Configuration
+
+	// specifies the driver to use for the blob client
+	Driver string
+
+	// specifies the bucket to use for the s3 blob client
+	S3Bucket string
+	// specifies the prefix for the objects to use for the s3 blob client
+	S3Prefix string
+	// specifies the S3 compatible endpoint to use for the s3 blob client
+	S3Endpoint string
+	// specifies the region to use for the s3 blob client
+	S3Region string
+	// specifies the access key to use for the s3 blob client
+	S3AccessKey string
+	// specifies the secret key to use for the s3 blob client
+	S3SecretKey string
+}
+
+// S3 creates and returns a Vela service capable of
+// integrating with S3 compatible object storage.
+func (s *Setup) S3() (Service, error) {
+	logrus.Trace("creating s3 blob client from setup")
+
+	// create new S3 blob service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/blob/s3blob?tab=doc#New
+	return s3blob.New(
+		s3blob.WithBucket(s.S3Bucket),
+		s3blob.WithPrefix(s.S3Prefix),
+		s3blob.WithEndpoint(s.S3Endpoint),
+		s3blob.WithRegion(s.S3Region),
+		s3blob.WithAccessKey(s.S3AccessKey),
+		s3blob.WithSecretKey(s.S3SecretKey),
+	)
+}
+
+// Validate verifies the necessary fields for the
+// provided configuration are populated correctly.
+func (s *Setup) Validate() error {
+	logrus.Trace("validating blob setup for client")
+
+	// verify a blob driver was provided
+	if len(s.Driver) == 0 {
+		return fmt.Errorf("no blob driver provided")
+	}
+
+	// process the blob driver being provided
+	switch s.Driver {
+	case s3blob.DriverS3:
+		// verify a blob bucket was provided
+		if len(s.S3Bucket) == 0 {
+			return fmt.Errorf("no blob s3 bucket provided")
+		}
+
+		// check if the blob endpoint has a scheme
+		if len(s.S3Endpoint) > 0 && !strings.Contains(s.S3Endpoint, "://") {
+			return fmt.Errorf("blob s3 endpoint must be fully qualified (<scheme>://<host>)")
+		}
+
+		// verify a blob secret key was provided with the access key
+		if len(s.S3AccessKey) > 0 && len(s.S3SecretKey) == 0 {
+			return fmt.Errorf("no blob s3 secret key provided")
+		}
+	default:
+		return fmt.Errorf("invalid blob driver provided: %s", s.Driver)
+	}
+
+	// setup is valid
+	return nil
+}
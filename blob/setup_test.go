@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package blob
+
+import (
+	"testing"
+)
+
+func TestBlob_Setup_S3(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:      "s3",
+		S3Bucket:    "vela",
+		S3Prefix:    "logs",
+		S3Endpoint:  "http://minio:9000",
+		S3Region:    "us-east-1",
+		S3AccessKey: "foo",
+		S3SecretKey: "bar",
+	}
+
+	// run test
+	got, err := _setup.S3()
+	if err != nil {
+		t.Errorf("S3 returned err: %v", err)
+	}
+
+	if got.Driver() != "s3" {
+		t.Errorf("S3 driver is %v, want s3", got.Driver())
+	}
+}
+
+func TestBlob_Setup_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:   "s3",
+				S3Bucket: "vela",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:      "s3",
+				S3Bucket:    "vela",
+				S3Endpoint:  "https://storage.googleapis.com",
+				S3AccessKey: "foo",
+				S3SecretKey: "bar",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "s3",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:     "s3",
+				S3Bucket:   "vela",
+				S3Endpoint: "storage.googleapis.com",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:      "s3",
+				S3Bucket:    "vela",
+				S3AccessKey: "foo",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:   "gcs",
+				S3Bucket: "vela",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.setup.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package budget
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the monitor.
+	config struct {
+		// specifies the interval at which to check the budgets
+		Interval time.Duration
+		// specifies the percentages of the budget that trigger an alert
+		Thresholds []int64
+		// specifies the url to send every budget alert to
+		Webhook string
+	}
+
+	// Monitor represents the functionality for alerting
+	// orgs as they consume their budget of build minutes.
+	Monitor struct {
+		// monitor configuration settings
+		config *config
+
+		// database service used to capture budgets and usage
+		database database.Service
+
+		// http client used to send alerts
+		client *http.Client
+	}
+)
+
+// New creates and returns a monitor for build budgets.
+func New(opts ...Opt) (*Monitor, error) {
+	// create new monitor
+	m := new(Monitor)
+
+	// create new fields
+	m.client = &http.Client{Timeout: 10 * time.Second}
+	m.config = &config{
+		Thresholds: []int64{50, 80, 100},
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Enabled returns whether the monitor is
+// configured to periodically check the budgets.
+func (m *Monitor) Enabled() bool {
+	return m.config.Interval > 0
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package budget
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBudget_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name       string
+		failure    bool
+		opts       []Opt
+		enabled    bool
+		thresholds []int64
+	}{
+		{
+			name:       "defaults",
+			failure:    false,
+			opts:       []Opt{},
+			enabled:    false,
+			thresholds: []int64{50, 80, 100},
+		},
+		{
+			name:    "with interval",
+			failure: false,
+			opts: []Opt{
+				WithInterval(time.Hour),
+				WithThresholds([]int64{100, 75}),
+				WithWebhook("https://hooks.example.com/budgets"),
+			},
+			enabled:    true,
+			thresholds: []int64{75, 100},
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Hour)},
+		},
+		{
+			name:    "empty thresholds",
+			failure: true,
+			opts:    []Opt{WithThresholds([]int64{})},
+		},
+		{
+			name:    "invalid threshold",
+			failure: true,
+			opts:    []Opt{WithThresholds([]int64{50, 0})},
+		},
+		{
+			name:    "invalid webhook",
+			failure: true,
+			opts:    []Opt{WithWebhook("!@#$%^&*()")},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+
+			if !reflect.DeepEqual(got.config.Thresholds, test.thresholds) {
+				t.Errorf("Thresholds is %v, want %v", got.config.Thresholds, test.thresholds)
+			}
+		})
+	}
+}
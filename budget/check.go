@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package budget
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/sirupsen/logrus"
+)
+
+// periodLayout represents the layout used to
+// format the month a budget applies to.
+const periodLayout = "2006-01"
+
+// Period returns the month, formatted as YYYY-MM,
+// that a budget applies to at the provided time.
+func Period(now time.Time) string {
+	return now.UTC().Format(periodLayout)
+}
+
+// Usage calculates the build minutes consumed by the org
+// for the budget in the month of the provided time.
+func Usage(db database.Service, b *types.BuildBudget, now time.Time) (*types.BudgetUsage, error) {
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	// send API call to capture the usage for the org since the start of the month
+	//
+	// the bounds are exclusive so they are widened by a second
+	actors, err := db.GetOrgActorUsage(context.Background(), b.GetOrg(), start.Unix()-1, now.Unix()+1)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get usage for org %s: %w", b.GetOrg(), err)
+	}
+
+	used := 0.0
+	for _, a := range actors {
+		used += a.GetMinutes()
+	}
+
+	remaining := math.Max(float64(b.GetMinutes())-used, 0)
+
+	percent := 0.0
+	if b.GetMinutes() > 0 {
+		percent = used / float64(b.GetMinutes()) * 100
+	}
+
+	// alerts sent for a previous month do not apply
+	alerted := int64(0)
+	if b.GetPeriod() == Period(now) {
+		alerted = b.GetAlerted()
+	}
+
+	u := new(types.BudgetUsage)
+
+	u.SetOrg(b.GetOrg())
+	u.SetPeriod(Period(now))
+	u.SetBudget(b.GetMinutes())
+	u.SetUsed(round(used))
+	u.SetRemaining(round(remaining))
+	u.SetPercent(round(percent))
+	u.SetAlerted(alerted)
+
+	return u, nil
+}
+
+// round is a helper function to round the value to two decimal places.
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// Start checks the budgets at the configured
+// interval until the provided channel is closed.
+func (m *Monitor) Start(dying <-chan struct{}) error {
+	logrus.Infof("checking build budgets every %s", m.config.Interval)
+
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, err := m.Check(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to check build budgets: %v", err)
+			}
+		}
+	}
+}
+
+// Check calculates the usage for every budget and sends an
+// alert for each org that crossed a threshold of its budget
+// not already alerted on in the month. The usage for the orgs
+// that were alerted is returned.
+func (m *Monitor) Check(now time.Time) ([]*types.BudgetUsage, error) {
+	logrus.Trace("checking build budgets")
+
+	// send API call to capture the budgets
+	budgets, err := m.database.ListBuildBudgets(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list build budgets: %w", err)
+	}
+
+	alerts := []*types.BudgetUsage{}
+
+	for _, b := range budgets {
+		usage, err := Usage(m.database, b, now)
+		if err != nil {
+			logrus.Errorf("unable to check build budget for org %s: %v", b.GetOrg(), err)
+
+			continue
+		}
+
+		threshold := m.crossed(usage.GetPercent())
+
+		// skip orgs without a new threshold crossed or a new month started
+		if threshold <= usage.GetAlerted() && b.GetPeriod() == usage.GetPeriod() {
+			continue
+		}
+
+		if threshold > usage.GetAlerted() {
+			logrus.Warnf("org %s has used %v%% of its build budget", b.GetOrg(), usage.GetPercent())
+
+			usage.SetAlerted(threshold)
+
+			for _, webhook := range []string{b.GetWebhook(), m.config.Webhook} {
+				err = m.notify(webhook, usage)
+				if err != nil {
+					logrus.Errorf("unable to send build budget alert for org %s: %v", b.GetOrg(), err)
+				}
+			}
+
+			alerts = append(alerts, usage)
+		}
+
+		// record the alerts sent for the month
+		b.SetPeriod(usage.GetPeriod())
+		b.SetAlerted(usage.GetAlerted())
+
+		// send API call to update the budget
+		err = m.database.UpdateBuildBudget(context.Background(), b)
+		if err != nil {
+			logrus.Errorf("unable to update build budget for org %s: %v", b.GetOrg(), err)
+		}
+	}
+
+	return alerts, nil
+}
+
+// crossed is a helper function to capture the highest
+// threshold reached by the percentage of the budget used.
+func (m *Monitor) crossed(percent float64) int64 {
+	threshold := int64(0)
+
+	for _, t := range m.config.Thresholds {
+		if percent >= float64(t) {
+			threshold = t
+		}
+	}
+
+	return threshold
+}
+
+// notify sends the provided usage to the webhook.
+func (m *Monitor) notify(webhook string, usage *types.BudgetUsage) error {
+	// skip the notification if no webhook is provided
+	if len(webhook) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Post(webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook %s responded with status %d", webhook, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package budget
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func TestBudget_Check(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from repos;")
+		db.Sqlite.Exec("delete from builds;")
+		db.Sqlite.Exec("delete from build_budgets;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+	_repo.SetVisibility("public")
+	_repo.SetActive(true)
+
+	err := db.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// create builds using 90 minutes this month and 60 minutes last month
+	for i, created := range []time.Time{now.Add(-time.Hour), now.Add(-2 * time.Hour), now.AddDate(0, -1, 0)} {
+		minutes := int64(45)
+		if i == 2 {
+			minutes = 60
+		}
+
+		b := new(library.Build)
+		b.SetID(int64(i + 1))
+		b.SetRepoID(1)
+		b.SetNumber(i + 1)
+		b.SetSender("octocat")
+		b.SetCreated(created.Unix())
+		b.SetStarted(created.Unix())
+		b.SetFinished(created.Unix() + minutes*60)
+
+		err = db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	received := []*types.BudgetUsage{}
+
+	// setup mock server
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		usage := new(types.BudgetUsage)
+
+		_ = json.NewDecoder(r.Body).Decode(usage)
+
+		received = append(received, usage)
+	}))
+	defer s.Close()
+
+	_budget := new(types.BuildBudget)
+	_budget.SetOrg("github")
+	_budget.SetMinutes(100)
+	_budget.SetWebhook(s.URL)
+	_budget.SetPeriod("2023-09")
+	_budget.SetAlerted(100)
+
+	err = db.CreateBuildBudget(context.TODO(), _budget)
+	if err != nil {
+		t.Errorf("unable to create test build budget: %v", err)
+	}
+
+	m, err := New(WithDatabase(db), WithWebhook(s.URL))
+	if err != nil {
+		t.Errorf("unable to create monitor: %v", err)
+	}
+
+	// run test
+	got, err := m.Check(now)
+	if err != nil {
+		t.Errorf("Check returned err: %v", err)
+	}
+
+	if len(got) != 1 || got[0].GetAlerted() != 80 || got[0].GetUsed() != 90 || got[0].GetRemaining() != 10 {
+		t.Errorf("Check is %v, want alert at 80%% with 90 minutes used", got)
+	}
+
+	// the alert is sent to the org and the global webhooks
+	if len(received) != 2 {
+		t.Errorf("Check sent %d alerts, want %d", len(received), 2)
+	}
+
+	b, err := db.GetBuildBudgetForOrg(context.TODO(), "github")
+	if err != nil {
+		t.Errorf("unable to get build budget: %v", err)
+	}
+
+	if b.GetPeriod() != "2023-10" || b.GetAlerted() != 80 {
+		t.Errorf("Check recorded period %s alerted %d, want 2023-10 alerted 80", b.GetPeriod(), b.GetAlerted())
+	}
+
+	// run test again without a new threshold crossed
+	got, err = m.Check(now)
+	if err != nil {
+		t.Errorf("Check returned err: %v", err)
+	}
+
+	if len(got) != 0 || len(received) != 2 {
+		t.Errorf("Check is %v, want no alerts", got)
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package budget provides the ability for Vela to alert
+// orgs as their builds consume the monthly budget of
+// build minutes configured for the org.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/budget"
+package budget
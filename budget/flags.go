@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package budget
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for build budget alerts.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Budget Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_BUDGET_INTERVAL", "BUDGET_INTERVAL"},
+		FilePath: "/vela/budget/interval",
+		Name:     "budget.interval",
+		Usage:    "interval at which to check the build minutes budgets for orgs (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.Int64SliceFlag{
+		EnvVars:  []string{"VELA_BUDGET_THRESHOLDS", "BUDGET_THRESHOLDS"},
+		FilePath: "/vela/budget/thresholds",
+		Name:     "budget.thresholds",
+		Usage:    "percentages of the monthly build minutes budget for an org that trigger an alert",
+		Value:    cli.NewInt64Slice(50, 80, 100),
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_BUDGET_WEBHOOK", "BUDGET_WEBHOOK"},
+		FilePath: "/vela/budget/webhook",
+		Name:     "budget.webhook",
+		Usage:    "optional url to send every build budget alert to as a JSON payload",
+	},
+}
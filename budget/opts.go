@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package budget
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the monitor.
+type Opt func(*Monitor) error
+
+// WithDatabase sets the database service in the monitor.
+func WithDatabase(db database.Service) Opt {
+	return func(m *Monitor) error {
+		// set the database service in the monitor
+		m.database = db
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to check the budgets in the monitor.
+func WithInterval(interval time.Duration) Opt {
+	return func(m *Monitor) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid budget interval provided: %s", interval)
+		}
+
+		// set the interval in the monitor
+		m.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithThresholds sets the percentages of the budget that trigger an alert in the monitor.
+func WithThresholds(thresholds []int64) Opt {
+	return func(m *Monitor) error {
+		// check if any thresholds were provided
+		if len(thresholds) == 0 {
+			return fmt.Errorf("no budget thresholds provided")
+		}
+
+		// check if the thresholds provided are positive
+		for _, threshold := range thresholds {
+			if threshold <= 0 {
+				return fmt.Errorf("invalid budget threshold provided: %d", threshold)
+			}
+		}
+
+		// set the thresholds, in ascending order, in the monitor
+		m.config.Thresholds = append([]int64{}, thresholds...)
+
+		sort.Slice(m.config.Thresholds, func(i, j int) bool {
+			return m.config.Thresholds[i] < m.config.Thresholds[j]
+		})
+
+		return nil
+	}
+}
+
+// WithWebhook sets the url to send every budget alert to in the monitor.
+func WithWebhook(webhook string) Opt {
+	return func(m *Monitor) error {
+		// skip validation if no webhook was provided
+		if len(webhook) == 0 {
+			return nil
+		}
+
+		// check if the webhook provided is a valid url
+		_, err := url.ParseRequestURI(webhook)
+		if err != nil {
+			return fmt.Errorf("invalid budget webhook provided: %w", err)
+		}
+
+		// set the webhook in the monitor
+		m.config.Webhook = webhook
+
+		return nil
+	}
+}
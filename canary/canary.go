@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types"
+)
+
+type (
+	// config represents the settings required to create the scheduler.
+	config struct {
+		// specifies the interval at which to run canary builds
+		Interval time.Duration
+		// specifies the duration a canary build has to finish before it times out
+		Timeout time.Duration
+		// specifies the org of the repo that owns the canary builds
+		Org string
+		// specifies the name of the repo that owns the canary builds
+		Repo string
+		// specifies the image used for the step in the canary builds
+		Image string
+	}
+
+	// Scheduler represents the functionality for running
+	// canary builds through each route and worker group.
+	Scheduler struct {
+		// scheduler configuration settings
+		config *config
+
+		// compiler service used to compile the canary pipeline
+		compiler compiler.Engine
+
+		// database service used to capture and record canary runs
+		database database.Service
+
+		// metadata used to compile the canary pipeline
+		metadata *types.Metadata
+
+		// queue service used to publish canary builds
+		queue queue.Service
+
+		// protects the time of the last scheduled canary builds
+		mutex sync.Mutex
+
+		// time of the last scheduled canary builds
+		last time.Time
+	}
+)
+
+// New creates and returns a scheduler for canary builds.
+func New(opts ...Opt) (*Scheduler, error) {
+	// create new scheduler
+	s := new(Scheduler)
+
+	// create new fields
+	s.config = &config{
+		Timeout: 30 * time.Minute,
+		Image:   "alpine:latest",
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if the scheduler is enabled without a repo for the canary builds
+	if s.Enabled() && len(s.config.Repo) == 0 {
+		return nil, fmt.Errorf("no canary repo provided")
+	}
+
+	return s, nil
+}
+
+// Enabled returns whether the scheduler is
+// configured to periodically run canary builds.
+func (s *Scheduler) Enabled() bool {
+	return s.config.Interval > 0
+}
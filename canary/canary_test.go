@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCanary_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		enabled bool
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+		},
+		{
+			name:    "with interval",
+			failure: false,
+			opts: []Opt{
+				WithInterval(time.Hour),
+				WithTimeout(10 * time.Minute),
+				WithRepo("vela/canary"),
+				WithImage("busybox:latest"),
+			},
+			enabled: true,
+		},
+		{
+			name:    "interval without repo",
+			failure: true,
+			opts:    []Opt{WithInterval(time.Hour)},
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Hour)},
+		},
+		{
+			name:    "invalid timeout",
+			failure: true,
+			opts:    []Opt{WithTimeout(0)},
+		},
+		{
+			name:    "invalid repo",
+			failure: true,
+			opts:    []Opt{WithRepo("canary")},
+		},
+		{
+			name:    "empty image",
+			failure: true,
+			opts:    []Opt{WithImage("")},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+		})
+	}
+}
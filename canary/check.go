@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// StatusTimeout represents the status recorded for a canary
+// run when the build didn't finish within the configured timeout.
+const StatusTimeout = "timeout"
+
+// Check records the result for each pending canary run
+// whose build has finished or exceeded the configured timeout.
+func (s *Scheduler) Check(now time.Time) error {
+	logrus.Trace("checking pending canary runs")
+
+	// send API call to capture the pending canary runs
+	runs, err := s.database.ListPendingCanaryRuns(context.Background())
+	if err != nil {
+		return fmt.Errorf("unable to list pending canary runs: %w", err)
+	}
+
+	for _, run := range runs {
+		// send API call to capture the build for the canary run
+		b, err := s.database.GetBuildByID(context.Background(), run.GetBuildID())
+		if err != nil {
+			logrus.Errorf("unable to get build %d for canary run %d: %v", run.GetBuildID(), run.GetID(), err)
+
+			continue
+		}
+
+		if !s.config.evaluate(run, b, now) {
+			continue
+		}
+
+		if run.GetStatus() != constants.StatusSuccess {
+			logrus.Warnf("canary build for route %s finished with status %s: %s", run.GetRoute(), run.GetStatus(), run.GetError())
+		}
+
+		// error out canary builds that were never picked up by a worker
+		if run.GetStatus() == StatusTimeout && b.GetStatus() == constants.StatusPending {
+			b.SetError(run.GetError())
+			b.SetStatus(constants.StatusError)
+			b.SetFinished(now.Unix())
+
+			// send API call to update the build
+			err = s.database.UpdateBuild(context.Background(), b)
+			if err != nil {
+				logrus.Errorf("unable to update build %d for canary run %d: %v", b.GetID(), run.GetID(), err)
+			}
+		}
+
+		// send API call to record the result for the canary run
+		err = s.database.UpdateCanaryRun(context.Background(), run)
+		if err != nil {
+			logrus.Errorf("unable to update canary run %d: %v", run.GetID(), err)
+		}
+	}
+
+	return nil
+}
+
+// evaluate is a helper function to record the result of the
+// build on the canary run. It returns false when the build is
+// still pending or running within the configured timeout.
+func (c *config) evaluate(run *api.CanaryRun, b *library.Build, now time.Time) bool {
+	switch b.GetStatus() {
+	case constants.StatusPending, constants.StatusRunning:
+		// check if the build is still within the timeout
+		if now.Sub(time.Unix(run.GetCreated(), 0)) < c.Timeout {
+			return false
+		}
+
+		run.SetStatus(StatusTimeout)
+		run.SetError(fmt.Sprintf("canary build did not finish within %s", c.Timeout))
+		run.SetFinished(now.Unix())
+	default:
+		run.SetStatus(b.GetStatus())
+		run.SetError(b.GetError())
+		run.SetFinished(b.GetFinished())
+
+		// use the current time if the build has no finished time
+		if run.GetFinished() == 0 {
+			run.SetFinished(now.Unix())
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestCanary_evaluate(t *testing.T) {
+	// setup types
+	c := &config{Timeout: 30 * time.Minute}
+	now := time.Unix(1563474077, 0)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		status   string
+		finished int64
+		created  int64
+		want     bool
+		result   string
+		done     int64
+	}{
+		{
+			name:    "running within timeout",
+			status:  constants.StatusRunning,
+			created: now.Add(-time.Minute).Unix(),
+			want:    false,
+			result:  constants.StatusPending,
+		},
+		{
+			name:    "pending past timeout",
+			status:  constants.StatusPending,
+			created: now.Add(-time.Hour).Unix(),
+			want:    true,
+			result:  StatusTimeout,
+			done:    now.Unix(),
+		},
+		{
+			name:     "success",
+			status:   constants.StatusSuccess,
+			finished: now.Add(-time.Minute).Unix(),
+			created:  now.Add(-time.Hour).Unix(),
+			want:     true,
+			result:   constants.StatusSuccess,
+			done:     now.Add(-time.Minute).Unix(),
+		},
+		{
+			name:    "error without finished",
+			status:  constants.StatusError,
+			created: now.Add(-time.Minute).Unix(),
+			want:    true,
+			result:  constants.StatusError,
+			done:    now.Unix(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			run := new(api.CanaryRun)
+			run.SetStatus(constants.StatusPending)
+			run.SetCreated(test.created)
+
+			b := new(library.Build)
+			b.SetStatus(test.status)
+			b.SetFinished(test.finished)
+
+			got := c.evaluate(run, b, now)
+
+			if got != test.want {
+				t.Errorf("evaluate is %v, want %v", got, test.want)
+			}
+
+			if run.GetStatus() != test.result {
+				t.Errorf("evaluate status is %s, want %s", run.GetStatus(), test.result)
+			}
+
+			if run.GetFinished() != test.done {
+				t.Errorf("evaluate finished is %d, want %d", run.GetFinished(), test.done)
+			}
+		})
+	}
+}
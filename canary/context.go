@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the canary Scheduler in the context.
+const key = "canary"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the canary Scheduler
+// associated with this context.
+func FromContext(c context.Context) *Scheduler {
+	// get canary value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast canary value to expected Scheduler type
+	s, ok := v.(*Scheduler)
+	if !ok {
+		return nil
+	}
+
+	return s
+}
+
+// ToContext adds the canary Scheduler to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, s *Scheduler) {
+	c.Set(key, s)
+}
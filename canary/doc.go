@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package canary provides the ability for Vela to periodically
+// run a trivial build through each route and worker group to
+// surface broken workers before they fail real builds.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/canary"
+package canary
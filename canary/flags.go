@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for canary builds.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Canary Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_CANARY_INTERVAL", "CANARY_INTERVAL"},
+		FilePath: "/vela/canary/interval",
+		Name:     "canary.interval",
+		Usage:    "interval at which to run a canary build through each route (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_CANARY_TIMEOUT", "CANARY_TIMEOUT"},
+		FilePath: "/vela/canary/timeout",
+		Name:     "canary.timeout",
+		Usage:    "duration a canary build has to finish before the route is reported as unhealthy",
+		Value:    30 * time.Minute,
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_CANARY_REPO", "CANARY_REPO"},
+		FilePath: "/vela/canary/repo",
+		Name:     "canary.repo",
+		Usage:    "full name (org/repo) of the active repo that owns the canary builds",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_CANARY_IMAGE", "CANARY_IMAGE"},
+		FilePath: "/vela/canary/image",
+		Name:     "canary.image",
+		Usage:    "image used for the step in the canary builds",
+		Value:    "alpine:latest",
+	},
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types"
+)
+
+// Opt represents a configuration option to initialize the scheduler.
+type Opt func(*Scheduler) error
+
+// WithCompiler sets the compiler service in the scheduler.
+func WithCompiler(c compiler.Engine) Opt {
+	return func(s *Scheduler) error {
+		// set the compiler service in the scheduler
+		s.compiler = c
+
+		return nil
+	}
+}
+
+// WithDatabase sets the database service in the scheduler.
+func WithDatabase(db database.Service) Opt {
+	return func(s *Scheduler) error {
+		// set the database service in the scheduler
+		s.database = db
+
+		return nil
+	}
+}
+
+// WithMetadata sets the metadata in the scheduler.
+func WithMetadata(m *types.Metadata) Opt {
+	return func(s *Scheduler) error {
+		// set the metadata in the scheduler
+		s.metadata = m
+
+		return nil
+	}
+}
+
+// WithQueue sets the queue service in the scheduler.
+func WithQueue(q queue.Service) Opt {
+	return func(s *Scheduler) error {
+		// set the queue service in the scheduler
+		s.queue = q
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to run canary builds in the scheduler.
+func WithInterval(interval time.Duration) Opt {
+	return func(s *Scheduler) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid canary interval provided: %s", interval)
+		}
+
+		// set the interval in the scheduler
+		s.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithTimeout sets the duration a canary build has to finish in the scheduler.
+func WithTimeout(timeout time.Duration) Opt {
+	return func(s *Scheduler) error {
+		// check if the timeout provided is positive
+		if timeout <= 0 {
+			return fmt.Errorf("invalid canary timeout provided: %s", timeout)
+		}
+
+		// set the timeout in the scheduler
+		s.config.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithRepo sets the full name of the repo that owns the canary builds in the scheduler.
+func WithRepo(repo string) Opt {
+	return func(s *Scheduler) error {
+		// skip validation if no repo was provided
+		if len(repo) == 0 {
+			return nil
+		}
+
+		// check if the repo provided is in the org/repo format
+		parts := strings.SplitN(repo, "/", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return fmt.Errorf("invalid canary repo provided: %s", repo)
+		}
+
+		// set the repo in the scheduler
+		s.config.Org = parts[0]
+		s.config.Repo = parts[1]
+
+		return nil
+	}
+}
+
+// WithImage sets the image used for the canary builds in the scheduler.
+func WithImage(image string) Opt {
+	return func(s *Scheduler) error {
+		// check if the image provided is empty
+		if len(image) == 0 {
+			return fmt.Errorf("no canary image provided")
+		}
+
+		// set the image in the scheduler
+		s.config.Image = image
+
+		return nil
+	}
+}
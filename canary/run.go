@@ -0,0 +1,353 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/sirupsen/logrus"
+)
+
+// target represents a route, and the worker
+// group for the route, to run a canary build through.
+type target struct {
+	Route string
+	Group string
+}
+
+// Start runs canary builds at the configured
+// interval until the provided channel is closed.
+func (s *Scheduler) Start(dying <-chan struct{}) error {
+	logrus.Infof("running canary builds every %s", s.config.Interval)
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	// catch up on the canary builds missed while
+	// the lease for the scheduler was handed off
+	if s.overdue(time.Now().UTC()) {
+		s.schedule()
+	}
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			s.schedule()
+		}
+	}
+}
+
+// schedule is a helper function to run the canary
+// builds and record the time they were scheduled.
+func (s *Scheduler) schedule() {
+	now := time.Now().UTC()
+
+	_, err := s.Run(now)
+	if err != nil {
+		logrus.Errorf("unable to run canary builds: %v", err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.last = now
+}
+
+// overdue is a helper function to check if the canary builds
+// were last scheduled more than an interval ago.
+func (s *Scheduler) overdue(now time.Time) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return !s.last.IsZero() && now.Sub(s.last) >= s.config.Interval
+}
+
+// State returns the unix time the canary builds were last scheduled
+// to hand off to the server replica that next runs the scheduler.
+func (s *Scheduler) State() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.last.IsZero() {
+		return ""
+	}
+
+	return strconv.FormatInt(s.last.Unix(), 10)
+}
+
+// Resume restores the unix time the canary builds were last
+// scheduled, handed off by the server replica that previously
+// ran the scheduler.
+func (s *Scheduler) Resume(state string) {
+	last, err := strconv.ParseInt(state, 10, 64)
+	if err != nil {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.last = time.Unix(last, 0).UTC()
+}
+
+// Run records the result for the pending canary runs
+// and launches a new canary build through the default
+// route and the route for each active worker group.
+func (s *Scheduler) Run(now time.Time) ([]*api.CanaryRun, error) {
+	logrus.Trace("running canary builds")
+
+	err := s.Check(now)
+	if err != nil {
+		return nil, err
+	}
+
+	// send API call to capture the repo for the canary builds
+	r, err := s.database.GetRepoForOrg(context.Background(), s.config.Org, s.config.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get canary repo %s/%s: %w", s.config.Org, s.config.Repo, err)
+	}
+
+	// check if the repo for the canary builds is active
+	if !r.GetActive() {
+		return nil, fmt.Errorf("canary repo %s is not active", r.GetFullName())
+	}
+
+	// send API call to capture the owner of the repo
+	u, err := s.database.GetUser(context.Background(), r.GetUserID())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get owner for canary repo %s: %w", r.GetFullName(), err)
+	}
+
+	// send API call to capture the worker groups
+	groups, err := s.database.ListWorkerGroups(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list worker groups: %w", err)
+	}
+
+	runs := []*api.CanaryRun{}
+
+	for _, t := range targets(groups) {
+		run := new(api.CanaryRun)
+		run.SetRoute(t.Route)
+		run.SetWorkerGroup(t.Group)
+		run.SetRepoID(r.GetID())
+		run.SetStatus(constants.StatusPending)
+		run.SetCreated(now.Unix())
+
+		b, err := s.launch(r, u, t.Route, now)
+		if err != nil {
+			logrus.Errorf("unable to launch canary build for route %s: %v", t.Route, err)
+
+			run.SetStatus(constants.StatusError)
+			run.SetError(err.Error())
+			run.SetFinished(now.Unix())
+		}
+
+		run.SetBuildID(b.GetID())
+
+		// send API call to record the canary run
+		err = s.database.CreateCanaryRun(context.Background(), run)
+		if err != nil {
+			logrus.Errorf("unable to create canary run for route %s: %v", t.Route, err)
+		}
+
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// targets is a helper function to capture the default
+// route and the unique routes for the active worker groups.
+func targets(groups []*api.WorkerGroup) []target {
+	t := []target{{Route: constants.DefaultRoute}}
+	seen := map[string]bool{constants.DefaultRoute: true}
+
+	for _, g := range groups {
+		if !g.GetActive() || seen[g.GetRoute()] {
+			continue
+		}
+
+		seen[g.GetRoute()] = true
+
+		t = append(t, target{Route: g.GetRoute(), Group: g.GetName()})
+	}
+
+	return t
+}
+
+// launch creates a canary build for the repo and
+// publishes it to the queue for the provided route.
+func (s *Scheduler) launch(r *library.Repo, u *library.User, route string, now time.Time) (*library.Build, error) {
+	b := new(library.Build)
+	b.SetRepoID(r.GetID())
+	b.SetNumber(r.GetCounter() + 1)
+	b.SetParent(r.GetCounter())
+	b.SetEvent(constants.EventPush)
+	b.SetStatus(constants.StatusPending)
+	b.SetBranch(r.GetBranch())
+	b.SetRef(fmt.Sprintf("refs/heads/%s", r.GetBranch()))
+	b.SetMessage(fmt.Sprintf("canary build for route %s", route))
+	b.SetAuthor(u.GetName())
+	b.SetSender(u.GetName())
+	b.SetCreated(now.Unix())
+
+	// parent should be "1" if it's the first build ran
+	if b.GetParent() == 0 {
+		b.SetParent(1)
+	}
+
+	// populate the build link if a web address is provided
+	if s.metadata != nil && len(s.metadata.Vela.WebAddress) > 0 {
+		b.SetLink(fmt.Sprintf("%s/%s/%d", s.metadata.Vela.WebAddress, r.GetFullName(), b.GetNumber()))
+	}
+
+	// compile the canary pipeline configuration
+	p, _, err := s.compiler.
+		Duplicate().
+		WithBuild(b).
+		WithMetadata(s.metadata).
+		WithRepo(r).
+		WithUser(u).
+		Compile(s.config.pipeline())
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile canary pipeline: %w", err)
+	}
+
+	// send API call to create the build
+	err = s.database.CreateBuild(context.Background(), b)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create canary build: %w", err)
+	}
+
+	// send API call to capture the created build
+	b, err = s.database.GetBuild(context.Background(), b.GetNumber(), r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get canary build: %w", err)
+	}
+
+	// send API call to update repo for ensuring counter is incremented
+	r.SetCounter(b.GetNumber())
+
+	err = s.database.UpdateRepo(context.Background(), r)
+	if err != nil {
+		return b, fmt.Errorf("unable to update canary repo %s: %w", r.GetFullName(), err)
+	}
+
+	err = s.plan(p, b)
+	if err != nil {
+		return b, s.fail(b, err)
+	}
+
+	item, err := json.Marshal(types.ToItem(p, b, r, u))
+	if err != nil {
+		return b, s.fail(b, err)
+	}
+
+	// publish the build to the queue for the route
+	err = s.queue.Push(context.Background(), route, item)
+	if err != nil {
+		return b, s.fail(b, fmt.Errorf("unable to publish canary build to queue %s: %w", route, err))
+	}
+
+	// update fields in build object
+	b.SetEnqueued(time.Now().UTC().Unix())
+
+	// send API call to update the build
+	err = s.database.UpdateBuild(context.Background(), b)
+	if err != nil {
+		logrus.Errorf("unable to update canary build %d: %v", b.GetNumber(), err)
+	}
+
+	return b, nil
+}
+
+// plan creates the steps, and the logs for the
+// steps, from the canary pipeline for the build.
+func (s *Scheduler) plan(p *pipeline.Build, b *library.Build) error {
+	for _, step := range p.Steps {
+		// create the step object
+		st := new(library.Step)
+		st.SetBuildID(b.GetID())
+		st.SetRepoID(b.GetRepoID())
+		st.SetNumber(step.Number)
+		st.SetName(step.Name)
+		st.SetImage(step.Image)
+		st.SetStatus(constants.StatusPending)
+		st.SetCreated(time.Now().UTC().Unix())
+
+		// send API call to create the step
+		err := s.database.CreateStep(context.Background(), st)
+		if err != nil {
+			return fmt.Errorf("unable to create step %s: %w", st.GetName(), err)
+		}
+
+		// send API call to capture the created step
+		st, err = s.database.GetStep(context.Background(), st.GetNumber(), b)
+		if err != nil {
+			return fmt.Errorf("unable to get step %s: %w", step.Name, err)
+		}
+
+		// create the log object
+		l := new(library.Log)
+		l.SetStepID(st.GetID())
+		l.SetBuildID(b.GetID())
+		l.SetRepoID(b.GetRepoID())
+		l.SetData([]byte{})
+
+		// send API call to create the step logs
+		err = s.database.CreateLog(context.Background(), l)
+		if err != nil {
+			return fmt.Errorf("unable to create logs for step %s: %w", st.GetName(), err)
+		}
+	}
+
+	return nil
+}
+
+// fail errors out the canary build without execution
+// and returns the provided error.
+func (s *Scheduler) fail(b *library.Build, err error) error {
+	// update fields in build object
+	b.SetError(err.Error())
+	b.SetStatus(constants.StatusError)
+	b.SetFinished(time.Now().UTC().Unix())
+
+	// send API call to update the build
+	uErr := s.database.UpdateBuild(context.Background(), b)
+	if uErr != nil {
+		logrus.Errorf("unable to kill canary build %d: %v", b.GetNumber(), uErr)
+	}
+
+	return err
+}
+
+// pipeline returns the configuration for a canary
+// build running a single step with the configured image.
+func (c *config) pipeline() []byte {
+	return []byte(fmt.Sprintf(`version: "1"
+
+metadata:
+  clone: false
+
+steps:
+  - name: canary
+    image: %s
+    pull: not_present
+    commands:
+      - echo ok
+`, c.Image))
+}
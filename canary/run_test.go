@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canary
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+)
+
+func TestCanary_targets(t *testing.T) {
+	// setup types
+	compliance := new(api.WorkerGroup)
+	compliance.SetName("compliance")
+	compliance.SetRoute("compliance")
+	compliance.SetActive(true)
+
+	shared := new(api.WorkerGroup)
+	shared.SetName("shared")
+	shared.SetRoute("compliance")
+	shared.SetActive(true)
+
+	inactive := new(api.WorkerGroup)
+	inactive.SetName("legacy")
+	inactive.SetRoute("legacy")
+	inactive.SetActive(false)
+
+	want := []target{
+		{Route: constants.DefaultRoute},
+		{Route: "compliance", Group: "compliance"},
+	}
+
+	// run test
+	got := targets([]*api.WorkerGroup{compliance, shared, inactive})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("targets is %v, want %v", got, want)
+	}
+}
+
+func TestCanary_Handoff(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	s, err := New(WithInterval(time.Hour), WithRepo("github/canary"))
+	if err != nil {
+		t.Errorf("unable to create scheduler: %v", err)
+	}
+
+	// run test
+	if s.overdue(now) {
+		t.Errorf("overdue is true, want false without a handed off state")
+	}
+
+	if s.State() != "" {
+		t.Errorf("State is %s, want empty", s.State())
+	}
+
+	s.Resume(strconv.FormatInt(now.Add(-2*time.Hour).Unix(), 10))
+
+	if !s.overdue(now) {
+		t.Errorf("overdue is false, want true when last scheduled two intervals ago")
+	}
+
+	if s.State() != strconv.FormatInt(now.Add(-2*time.Hour).Unix(), 10) {
+		t.Errorf("State is %s, want %d", s.State(), now.Add(-2*time.Hour).Unix())
+	}
+
+	s.Resume(strconv.FormatInt(now.Add(-time.Minute).Unix(), 10))
+
+	if s.overdue(now) {
+		t.Errorf("overdue is true, want false when last scheduled within the interval")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the recorder.
+	config struct {
+		// specifies the interval at which to record capacity snapshots
+		Interval time.Duration
+		// specifies the duration to keep capacity snapshots for
+		Retention time.Duration
+		// specifies the duration since the last check-in a worker is considered active for
+		ActiveInterval time.Duration
+	}
+
+	// Recorder represents the functionality for recording
+	// the capacity of the worker fleet over time.
+	Recorder struct {
+		// recorder configuration settings
+		config *config
+
+		// database service used to capture workers and builds
+		database database.Service
+	}
+)
+
+// New creates and returns a recorder for capacity snapshots.
+func New(opts ...Opt) (*Recorder, error) {
+	// create new recorder
+	r := new(Recorder)
+
+	// create new fields
+	r.config = &config{
+		Retention:      30 * 24 * time.Hour,
+		ActiveInterval: 5 * time.Minute,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Enabled returns whether the recorder is
+// configured to periodically record capacity snapshots.
+func (r *Recorder) Enabled() bool {
+	return r.config.Interval > 0
+}
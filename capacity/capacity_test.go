@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCapacity_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		enabled bool
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+		},
+		{
+			name:    "with interval",
+			failure: false,
+			opts: []Opt{
+				WithInterval(time.Minute),
+				WithRetention(7 * 24 * time.Hour),
+				WithActiveInterval(time.Minute),
+			},
+			enabled: true,
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Minute)},
+		},
+		{
+			name:    "negative retention",
+			failure: true,
+			opts:    []Opt{WithRetention(-time.Hour)},
+		},
+		{
+			name:    "invalid active interval",
+			failure: true,
+			opts:    []Opt{WithActiveInterval(0)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+		})
+	}
+}
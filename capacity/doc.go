@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package capacity provides the ability for Vela to periodically
+// record the capacity of the worker fleet compared to the builds
+// it is running for capacity planning.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/capacity"
+package capacity
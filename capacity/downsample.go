@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"math"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// Downsample averages the provided capacity snapshots, ordered by
+// the time they were created, into one snapshot for each interval.
+// The created time for each averaged snapshot is the start of the
+// interval. When the interval is not positive, the snapshots are
+// returned unchanged.
+func Downsample(snapshots []*api.CapacitySnapshot, interval time.Duration) []*api.CapacitySnapshot {
+	seconds := int64(interval / time.Second)
+	if seconds <= 0 {
+		return snapshots
+	}
+
+	buckets := []*api.CapacitySnapshot{}
+	bucket := []*api.CapacitySnapshot{}
+
+	for _, s := range snapshots {
+		// check if the snapshot belongs in a new interval
+		if len(bucket) > 0 && s.GetCreated()/seconds != bucket[0].GetCreated()/seconds {
+			buckets = append(buckets, average(bucket, seconds))
+			bucket = []*api.CapacitySnapshot{}
+		}
+
+		bucket = append(bucket, s)
+	}
+
+	if len(bucket) > 0 {
+		buckets = append(buckets, average(bucket, seconds))
+	}
+
+	return buckets
+}
+
+// average is a helper function to create a capacity snapshot
+// from the average of the values for the provided snapshots.
+func average(snapshots []*api.CapacitySnapshot, seconds int64) *api.CapacitySnapshot {
+	var active, inactive, limit, running, pending int64
+
+	var utilization float64
+
+	for _, s := range snapshots {
+		active += s.GetActiveWorkers()
+		inactive += s.GetInactiveWorkers()
+		limit += s.GetBuildLimit()
+		running += s.GetRunning()
+		pending += s.GetPending()
+		utilization += s.GetUtilization()
+	}
+
+	n := float64(len(snapshots))
+
+	s := new(api.CapacitySnapshot)
+	s.SetActiveWorkers(int64(math.Round(float64(active) / n)))
+	s.SetInactiveWorkers(int64(math.Round(float64(inactive) / n)))
+	s.SetBuildLimit(int64(math.Round(float64(limit) / n)))
+	s.SetRunning(int64(math.Round(float64(running) / n)))
+	s.SetPending(int64(math.Round(float64(pending) / n)))
+	s.SetUtilization(utilization / n)
+	s.SetCreated(snapshots[0].GetCreated() / seconds * seconds)
+
+	return s
+}
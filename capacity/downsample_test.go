@@ -0,0 +1,67 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCapacity_Downsample(t *testing.T) {
+	// setup types
+	snapshot := func(created, limit, running int64, utilization float64) *api.CapacitySnapshot {
+		s := new(api.CapacitySnapshot)
+		s.SetActiveWorkers(2)
+		s.SetInactiveWorkers(0)
+		s.SetBuildLimit(limit)
+		s.SetRunning(running)
+		s.SetPending(0)
+		s.SetUtilization(utilization)
+		s.SetCreated(created)
+
+		return s
+	}
+
+	snapshots := []*api.CapacitySnapshot{
+		snapshot(3600, 4, 1, 0.25),
+		snapshot(3900, 4, 3, 0.75),
+		snapshot(7300, 4, 4, 1),
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		interval time.Duration
+		want     []*api.CapacitySnapshot
+	}{
+		{
+			name:     "no interval",
+			interval: 0,
+			want:     snapshots,
+		},
+		{
+			name:     "hourly",
+			interval: time.Hour,
+			want: []*api.CapacitySnapshot{
+				snapshot(3600, 4, 2, 0.5),
+				snapshot(7200, 4, 4, 1),
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Downsample(snapshots, test.interval)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Downsample is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
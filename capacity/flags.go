@@ -0,0 +1,34 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for capacity snapshots.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Capacity Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_CAPACITY_INTERVAL", "CAPACITY_INTERVAL"},
+		FilePath: "/vela/capacity/interval",
+		Name:     "capacity.interval",
+		Usage:    "interval at which to record a snapshot of the worker capacity (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_CAPACITY_RETENTION", "CAPACITY_RETENTION"},
+		FilePath: "/vela/capacity/retention",
+		Name:     "capacity.retention",
+		Usage:    "duration to keep worker capacity snapshots for (kept forever when set to 0)",
+		Value:    30 * 24 * time.Hour,
+	},
+}
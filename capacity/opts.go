@@ -0,0 +1,71 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the recorder.
+type Opt func(*Recorder) error
+
+// WithDatabase sets the database service in the recorder.
+func WithDatabase(db database.Service) Opt {
+	return func(r *Recorder) error {
+		// set the database service in the recorder
+		r.database = db
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to record capacity snapshots in the recorder.
+func WithInterval(interval time.Duration) Opt {
+	return func(r *Recorder) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid capacity interval provided: %s", interval)
+		}
+
+		// set the interval in the recorder
+		r.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithRetention sets the duration to keep capacity snapshots for in the recorder.
+func WithRetention(retention time.Duration) Opt {
+	return func(r *Recorder) error {
+		// check if the retention provided is negative
+		if retention < 0 {
+			return fmt.Errorf("invalid capacity retention provided: %s", retention)
+		}
+
+		// set the retention in the recorder
+		r.config.Retention = retention
+
+		return nil
+	}
+}
+
+// WithActiveInterval sets the duration since the last check-in
+// a worker is considered active for in the recorder.
+func WithActiveInterval(interval time.Duration) Opt {
+	return func(r *Recorder) error {
+		// check if the active interval provided is positive
+		if interval <= 0 {
+			return fmt.Errorf("invalid worker active interval provided: %s", interval)
+		}
+
+		// set the active interval in the recorder
+		r.config.ActiveInterval = interval
+
+		return nil
+	}
+}
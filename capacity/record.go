@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// Start records capacity snapshots at the configured
+// interval until the provided channel is closed.
+func (r *Recorder) Start(dying <-chan struct{}) error {
+	logrus.Infof("recording worker capacity every %s", r.config.Interval)
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, err := r.Record(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to record worker capacity: %v", err)
+			}
+		}
+	}
+}
+
+// Record captures the capacity of the workers and the number of
+// pending and running builds, stores the snapshot and removes
+// the snapshots older than the configured retention.
+func (r *Recorder) Record(now time.Time) (*api.CapacitySnapshot, error) {
+	logrus.Trace("recording worker capacity")
+
+	// send API call to capture the workers
+	workers, err := r.database.ListWorkers(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list workers: %w", err)
+	}
+
+	// send API call to capture the number of running builds
+	running, err := r.database.GetBuildCountByStatus(context.Background(), constants.StatusRunning)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get count of running builds: %w", err)
+	}
+
+	// send API call to capture the number of pending builds
+	pending, err := r.database.GetBuildCountByStatus(context.Background(), constants.StatusPending)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get count of pending builds: %w", err)
+	}
+
+	s := r.config.snapshot(workers, running, pending, now)
+
+	// send API call to store the capacity snapshot
+	err = r.database.CreateCapacitySnapshot(context.Background(), s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create capacity snapshot: %w", err)
+	}
+
+	// skip removing snapshots if they are kept forever
+	if r.config.Retention == 0 {
+		return s, nil
+	}
+
+	// send API call to remove the snapshots older than the retention
+	err = r.database.DeleteCapacitySnapshotsBefore(context.Background(), now.Add(-r.config.Retention).Unix())
+	if err != nil {
+		logrus.Errorf("unable to delete expired capacity snapshots: %v", err)
+	}
+
+	return s, nil
+}
+
+// snapshot is a helper function to create a capacity snapshot
+// from the workers and the number of running and pending builds.
+func (c *config) snapshot(workers []*library.Worker, running, pending int64, now time.Time) *api.CapacitySnapshot {
+	var active, inactive, limit int64
+
+	// capture the unix time from the active interval ago
+	before := now.Add(-c.ActiveInterval).Unix()
+
+	for _, w := range workers {
+		// check if the worker checked in within the active interval
+		if w.GetLastCheckedIn() >= before {
+			limit += w.GetBuildLimit()
+			active++
+		} else {
+			inactive++
+		}
+	}
+
+	s := new(api.CapacitySnapshot)
+	s.SetActiveWorkers(active)
+	s.SetInactiveWorkers(inactive)
+	s.SetBuildLimit(limit)
+	s.SetRunning(running)
+	s.SetPending(pending)
+	s.SetCreated(now.Unix())
+
+	if limit > 0 {
+		s.SetUtilization(float64(running) / float64(limit))
+	}
+
+	return s
+}
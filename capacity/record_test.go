@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacity
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestCapacity_snapshot(t *testing.T) {
+	// setup types
+	c := &config{ActiveInterval: 5 * time.Minute}
+	now := time.Unix(1563474077, 0)
+
+	_active := new(library.Worker)
+	_active.SetBuildLimit(4)
+	_active.SetLastCheckedIn(now.Add(-time.Minute).Unix())
+
+	_stale := new(library.Worker)
+	_stale.SetBuildLimit(2)
+	_stale.SetLastCheckedIn(now.Add(-time.Hour).Unix())
+
+	want := new(api.CapacitySnapshot)
+	want.SetActiveWorkers(1)
+	want.SetInactiveWorkers(1)
+	want.SetBuildLimit(4)
+	want.SetRunning(3)
+	want.SetPending(2)
+	want.SetUtilization(0.75)
+	want.SetCreated(now.Unix())
+
+	// run test
+	got := c.snapshot([]*library.Worker{_active, _stale}, 3, 2, now)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("snapshot is %v, want %v", got, want)
+	}
+}
+
+func TestCapacity_snapshot_NoWorkers(t *testing.T) {
+	// setup types
+	c := &config{ActiveInterval: 5 * time.Minute}
+
+	// run test
+	got := c.snapshot([]*library.Worker{}, 0, 5, time.Now())
+
+	if got.Utilization != nil {
+		t.Errorf("snapshot utilization is %v, want nil", got.GetUtilization())
+	}
+
+	if got.GetPending() != 5 {
+		t.Errorf("snapshot pending is %d, want 5", got.GetPending())
+	}
+}
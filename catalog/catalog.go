@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the catalog.
+	config struct {
+		// specifies the URL to push the components to
+		URL string
+		// specifies the token used to authenticate with the URL
+		Token string
+		// specifies the interval at which to push the components
+		Interval time.Duration
+		// specifies the owners of the components by org or repo full name
+		Owners map[string]string
+	}
+
+	// Catalog represents the functionality for describing
+	// repos as components in a service catalog.
+	Catalog struct {
+		// catalog configuration settings
+		config *config
+
+		// database service used to capture repos, builds and deployments
+		database database.Service
+
+		// http client used to push the components
+		client *http.Client
+	}
+)
+
+// New creates and returns a catalog for components.
+func New(opts ...Opt) (*Catalog, error) {
+	// create new catalog
+	c := new(Catalog)
+
+	// create new fields
+	c.config = &config{
+		Owners: make(map[string]string),
+	}
+	c.client = &http.Client{Timeout: 30 * time.Second}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if the push is enabled without a URL for the components
+	if c.Enabled() && len(c.config.URL) == 0 {
+		return nil, fmt.Errorf("no catalog url provided")
+	}
+
+	return c, nil
+}
+
+// Enabled returns whether the catalog is configured
+// to periodically push the components to a URL.
+func (c *Catalog) Enabled() bool {
+	return c.config.Interval > 0
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCatalog_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		enabled bool
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+		},
+		{
+			name:    "with interval",
+			failure: false,
+			opts: []Opt{
+				WithURL("https://backstage.example.com/api/vela/components"),
+				WithToken("superSecretToken"),
+				WithInterval(time.Hour),
+				WithOwners([]string{"github=group:platform", "github/octocat=group:octo"}),
+			},
+			enabled: true,
+		},
+		{
+			name:    "interval without url",
+			failure: true,
+			opts:    []Opt{WithInterval(time.Hour)},
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Hour)},
+		},
+		{
+			name:    "invalid url",
+			failure: true,
+			opts:    []Opt{WithURL("backstage")},
+		},
+		{
+			name:    "invalid owner",
+			failure: true,
+			opts:    []Opt{WithOwners([]string{"github"})},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+		})
+	}
+}
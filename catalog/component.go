@@ -0,0 +1,148 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// Component captures the last build and the last successful
+// deployment to each environment for the repo and describes
+// the repo as a component in the service catalog.
+func (c *Catalog) Component(r *library.Repo) (*api.CatalogComponent, error) {
+	// send API call to capture the last build for the repo
+	b, err := c.database.GetLastBuild(context.Background(), r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get last build for %s: %w", r.GetFullName(), err)
+	}
+
+	// send API call to capture the last deployment build for each environment
+	d, err := c.database.GetLastDeploymentBuildList(context.Background(), r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get last deployments for %s: %w", r.GetFullName(), err)
+	}
+
+	return c.config.component(r, b, Deploys(d)), nil
+}
+
+// Components describes every active repo as
+// a component in the service catalog.
+func (c *Catalog) Components() ([]*api.CatalogComponent, error) {
+	// send API call to capture the repos
+	repos, err := c.database.ListRepos(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list repos: %w", err)
+	}
+
+	components := []*api.CatalogComponent{}
+
+	for _, r := range repos {
+		// skip repos that are no longer enabled in Vela
+		if !r.GetActive() {
+			continue
+		}
+
+		component, err := c.Component(r)
+		if err != nil {
+			return nil, err
+		}
+
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// component is a helper function to create a component
+// from the repo, the last build for the repo and the
+// last successful deployment to each environment.
+func (c *config) component(r *library.Repo, b *library.Build, deploys []*api.EnvironmentDeploy) *api.CatalogComponent {
+	component := new(api.CatalogComponent)
+	component.SetOrg(r.GetOrg())
+	component.SetRepo(r.GetName())
+	component.SetFullName(r.GetFullName())
+	component.SetOwner(c.owner(r))
+	component.SetLink(r.GetLink())
+	component.SetBranch(r.GetBranch())
+	component.SetVisibility(r.GetVisibility())
+	component.SetActive(r.GetActive())
+	component.SetDeploys(deploys)
+
+	// the last build will not exist if it's a new repo
+	if b != nil {
+		component.SetBuildNumber(int64(b.GetNumber()))
+		component.SetBuildStatus(b.GetStatus())
+		component.SetBuildCommit(b.GetCommit())
+		component.SetBuildEvent(b.GetEvent())
+		component.SetBuildFinished(b.GetFinished())
+		component.SetBuildLink(b.GetLink())
+	}
+
+	return component
+}
+
+// owner is a helper function to capture the owner of the repo.
+// The owner configured for the repo by its full name takes
+// precedence over the owner configured for the org, falling
+// back to the org for the repo.
+func (c *config) owner(r *library.Repo) string {
+	if owner, ok := c.Owners[r.GetFullName()]; ok {
+		return owner
+	}
+
+	if owner, ok := c.Owners[r.GetOrg()]; ok {
+		return owner
+	}
+
+	return r.GetOrg()
+}
+
+// Deploys converts the last deployment build for each
+// environment into the facts about the deployment
+// to the environment.
+func Deploys(builds []*library.Build) []*api.EnvironmentDeploy {
+	deploys := []*api.EnvironmentDeploy{}
+
+	for _, b := range builds {
+		d := new(api.EnvironmentDeploy)
+		d.SetEnvironment(b.GetDeploy())
+		d.SetCommit(b.GetCommit())
+		d.SetRef(b.GetRef())
+		d.SetVersion(deployVersion(b))
+		d.SetActor(b.GetSender())
+		d.SetBuild(int64(b.GetNumber()))
+		d.SetDeployed(b.GetFinished())
+		d.SetLink(b.GetLink())
+
+		deploys = append(deploys, d)
+	}
+
+	return deploys
+}
+
+// deployVersion is a helper function to capture the version for
+// a deployment build. The version provided in the deployment
+// payload takes precedence over the tag for the deployment,
+// falling back to the short commit for the deployment.
+func deployVersion(b *library.Build) string {
+	if v, ok := b.GetDeployPayload()["version"]; ok && len(v) > 0 {
+		return v
+	}
+
+	if strings.HasPrefix(b.GetRef(), "refs/tags/") {
+		return strings.TrimPrefix(b.GetRef(), "refs/tags/")
+	}
+
+	if len(b.GetCommit()) > 7 {
+		return b.GetCommit()[:7]
+	}
+
+	return b.GetCommit()
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/raw"
+)
+
+func TestCatalog_owner(t *testing.T) {
+	// setup types
+	c := &config{
+		Owners: map[string]string{
+			"github":         "group:platform",
+			"github/octocat": "group:octo",
+		},
+	}
+
+	// setup tests
+	tests := []struct {
+		name string
+		org  string
+		repo string
+		want string
+	}{
+		{
+			name: "repo owner",
+			org:  "github",
+			repo: "octocat",
+			want: "group:octo",
+		},
+		{
+			name: "org owner",
+			org:  "github",
+			repo: "hello-world",
+			want: "group:platform",
+		},
+		{
+			name: "no owner",
+			org:  "go-vela",
+			repo: "server",
+			want: "go-vela",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := new(library.Repo)
+			r.SetOrg(test.org)
+			r.SetName(test.repo)
+			r.SetFullName(test.org + "/" + test.repo)
+
+			got := c.owner(r)
+
+			if got != test.want {
+				t.Errorf("owner is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCatalog_component(t *testing.T) {
+	// setup types
+	c := &config{Owners: map[string]string{}}
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetLink("https://github.com/github/octocat")
+	r.SetBranch("main")
+	r.SetVisibility("public")
+	r.SetActive(true)
+
+	b := new(library.Build)
+	b.SetNumber(1)
+	b.SetStatus("success")
+	b.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	b.SetEvent("push")
+	b.SetFinished(1563474086)
+	b.SetLink("https://example.company.com/github/octocat/1")
+
+	d := new(api.EnvironmentDeploy)
+	d.SetEnvironment("production")
+
+	want := new(api.CatalogComponent)
+	want.SetOrg("github")
+	want.SetRepo("octocat")
+	want.SetFullName("github/octocat")
+	want.SetOwner("github")
+	want.SetLink("https://github.com/github/octocat")
+	want.SetBranch("main")
+	want.SetVisibility("public")
+	want.SetActive(true)
+	want.SetBuildNumber(1)
+	want.SetBuildStatus("success")
+	want.SetBuildCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	want.SetBuildEvent("push")
+	want.SetBuildFinished(1563474086)
+	want.SetBuildLink("https://example.company.com/github/octocat/1")
+	want.SetDeploys([]*api.EnvironmentDeploy{d})
+
+	// run test
+	got := c.component(r, b, []*api.EnvironmentDeploy{d})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("component is %v, want %v", got, want)
+	}
+}
+
+func TestCatalog_component_NoBuild(t *testing.T) {
+	// setup types
+	c := &config{Owners: map[string]string{}}
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	// run test
+	got := c.component(r, nil, []*api.EnvironmentDeploy{})
+
+	if got.BuildNumber != nil {
+		t.Errorf("component build number is %d, want nil", got.GetBuildNumber())
+	}
+
+	if got.GetOwner() != "github" {
+		t.Errorf("component owner is %s, want github", got.GetOwner())
+	}
+}
+
+func TestCatalog_deployVersion(t *testing.T) {
+	// setup types
+	payload := new(library.Build)
+	payload.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	payload.SetRef("refs/tags/v1.0.0")
+	payload.SetDeployPayload(raw.StringSliceMap{"version": "1.0.0-rc1"})
+
+	tag := new(library.Build)
+	tag.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	tag.SetRef("refs/tags/v1.0.0")
+
+	commit := new(library.Build)
+	commit.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	commit.SetRef("refs/heads/main")
+
+	// setup tests
+	tests := []struct {
+		name  string
+		build *library.Build
+		want  string
+	}{
+		{
+			name:  "payload version",
+			build: payload,
+			want:  "1.0.0-rc1",
+		},
+		{
+			name:  "tag",
+			build: tag,
+			want:  "v1.0.0",
+		},
+		{
+			name:  "short commit",
+			build: commit,
+			want:  "48afb5b",
+		},
+		{
+			name:  "empty build",
+			build: new(library.Build),
+			want:  "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := deployVersion(test.build)
+
+			if got != test.want {
+				t.Errorf("deployVersion is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCatalog_Deploys(t *testing.T) {
+	// setup types
+	b := new(library.Build)
+	b.SetNumber(2)
+	b.SetDeploy("production")
+	b.SetCommit("48afb5bdc41ad69bf22588491333f7cf71135163")
+	b.SetRef("refs/tags/v1.0.0")
+	b.SetSender("OctoKitty")
+	b.SetFinished(1563474086)
+	b.SetLink("https://example.company.com/github/octocat/2")
+
+	// run test
+	got := Deploys([]*library.Build{b})
+
+	if len(got) != 1 {
+		t.Fatalf("Deploys returned %d deploys, want 1", len(got))
+	}
+
+	if got[0].GetEnvironment() != "production" {
+		t.Errorf("Deploys environment is %s, want production", got[0].GetEnvironment())
+	}
+
+	if got[0].GetVersion() != "v1.0.0" {
+		t.Errorf("Deploys version is %s, want v1.0.0", got[0].GetVersion())
+	}
+
+	if got[0].GetBuild() != 2 {
+		t.Errorf("Deploys build is %d, want 2", got[0].GetBuild())
+	}
+
+	if got[0].GetDeployed() != 1563474086 {
+		t.Errorf("Deploys deployed is %d, want 1563474086", got[0].GetDeployed())
+	}
+}
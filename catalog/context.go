@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the catalog in the context.
+const key = "catalog"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the catalog
+// associated with this context.
+func FromContext(c context.Context) *Catalog {
+	// get catalog value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast catalog value to expected Catalog type
+	ca, ok := v.(*Catalog)
+	if !ok {
+		return nil
+	}
+
+	return ca
+}
+
+// ToContext adds the catalog to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, ca *Catalog) {
+	c.Set(key, ca)
+}
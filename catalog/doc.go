@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package catalog provides the ability for Vela to describe repos
+// as components in a service catalog, like Backstage, and to
+// periodically push the components to the catalog.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/catalog"
+package catalog
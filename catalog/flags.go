@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the service catalog.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Catalog Flags
+
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_CATALOG_URL", "CATALOG_URL"},
+		FilePath: "/vela/catalog/url",
+		Name:     "catalog.url",
+		Usage:    "url to push the components for the service catalog to",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_CATALOG_TOKEN", "CATALOG_TOKEN"},
+		FilePath: "/vela/catalog/token",
+		Name:     "catalog.token",
+		Usage:    "bearer token used to authenticate when pushing the components for the service catalog",
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_CATALOG_INTERVAL", "CATALOG_INTERVAL"},
+		FilePath: "/vela/catalog/interval",
+		Name:     "catalog.interval",
+		Usage:    "interval at which to push the components for the service catalog (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_CATALOG_OWNERS", "CATALOG_OWNERS"},
+		FilePath: "/vela/catalog/owners",
+		Name:     "catalog.owners",
+		Usage:    "owners of the components for the service catalog in the form <org>=<owner> or <org>/<repo>=<owner>",
+	},
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the catalog.
+type Opt func(*Catalog) error
+
+// WithDatabase sets the database service in the catalog.
+func WithDatabase(db database.Service) Opt {
+	return func(c *Catalog) error {
+		// set the database service in the catalog
+		c.database = db
+
+		return nil
+	}
+}
+
+// WithURL sets the URL to push the components to in the catalog.
+func WithURL(address string) Opt {
+	return func(c *Catalog) error {
+		// skip validating the URL if none is provided
+		if len(address) == 0 {
+			return nil
+		}
+
+		// check if the URL provided is valid
+		u, err := url.ParseRequestURI(address)
+		if err != nil || len(u.Host) == 0 {
+			return fmt.Errorf("invalid catalog url provided: %s", address)
+		}
+
+		// set the URL in the catalog
+		c.config.URL = address
+
+		return nil
+	}
+}
+
+// WithToken sets the token used to authenticate with the URL in the catalog.
+func WithToken(token string) Opt {
+	return func(c *Catalog) error {
+		// set the token in the catalog
+		c.config.Token = token
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to push the components in the catalog.
+func WithInterval(interval time.Duration) Opt {
+	return func(c *Catalog) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid catalog interval provided: %s", interval)
+		}
+
+		// set the interval in the catalog
+		c.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithOwners sets the owners of the components in the catalog.
+//
+// Each owner is provided in the form "<org>=<owner>" or
+// "<org>/<repo>=<owner>", where the owner for the repo
+// takes precedence over the owner for the org.
+func WithOwners(owners []string) Opt {
+	return func(c *Catalog) error {
+		for _, owner := range owners {
+			parts := strings.SplitN(owner, "=", 2)
+
+			// check if the owner provided is in the expected form
+			if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+				return fmt.Errorf("invalid catalog owner provided: %s", owner)
+			}
+
+			// set the owner in the catalog
+			c.config.Owners[parts[0]] = parts[1]
+		}
+
+		return nil
+	}
+}
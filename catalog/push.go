@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Start pushes the components at the configured
+// interval until the provided channel is closed.
+func (c *Catalog) Start(dying <-chan struct{}) error {
+	logrus.Infof("pushing service catalog components to %s every %s", c.config.URL, c.config.Interval)
+
+	ticker := time.NewTicker(c.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, err := c.Push()
+			if err != nil {
+				logrus.Errorf("unable to push service catalog components: %v", err)
+			}
+		}
+	}
+}
+
+// Push describes every active repo as a component and sends
+// the components to the configured URL. It returns the
+// number of components pushed.
+func (c *Catalog) Push() (int, error) {
+	logrus.Trace("pushing service catalog components")
+
+	components, err := c.Components()
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := json.Marshal(components)
+	if err != nil {
+		return 0, fmt.Errorf("unable to marshal components: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("unable to create request for %s: %w", c.config.URL, err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if len(c.config.Token) > 0 {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.config.Token))
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to push components to %s: %w", c.config.URL, err)
+	}
+	defer resp.Body.Close()
+
+	// check if the catalog accepted the components
+	if resp.StatusCode >= http.StatusBadRequest {
+		return 0, fmt.Errorf("unable to push components to %s: received status %d", c.config.URL, resp.StatusCode)
+	}
+
+	return len(components), nil
+}
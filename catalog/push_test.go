@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func TestCatalog_Push(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from repos;")
+		db.Sqlite.Exec("delete from builds;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+	_repo.SetVisibility("public")
+	_repo.SetActive(true)
+
+	_inactive := new(library.Repo)
+	_inactive.SetID(2)
+	_inactive.SetUserID(1)
+	_inactive.SetHash("baz")
+	_inactive.SetOrg("github")
+	_inactive.SetName("hello-world")
+	_inactive.SetFullName("github/hello-world")
+	_inactive.SetVisibility("public")
+	_inactive.SetActive(false)
+
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetEvent("deployment")
+	_build.SetStatus("success")
+	_build.SetDeploy("production")
+	_build.SetRef("refs/tags/v1.0.0")
+
+	for _, r := range []*library.Repo{_repo, _inactive} {
+		err := db.CreateRepo(context.TODO(), r)
+		if err != nil {
+			t.Fatalf("unable to create repo %s: %v", r.GetFullName(), err)
+		}
+	}
+
+	err := db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Fatalf("unable to create build: %v", err)
+	}
+
+	var got []*api.CatalogComponent
+
+	// setup server
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer superSecretToken" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_ = json.NewDecoder(r.Body).Decode(&got)
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer s.Close()
+
+	c, err := New(
+		WithDatabase(db),
+		WithURL(s.URL),
+		WithToken("superSecretToken"),
+	)
+	if err != nil {
+		t.Fatalf("New returned err: %v", err)
+	}
+
+	// run test
+	count, err := c.Push()
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	if count != 1 || len(got) != 1 {
+		t.Fatalf("Push sent %d components, want 1", len(got))
+	}
+
+	if got[0].GetFullName() != "github/octocat" {
+		t.Errorf("Push component is %s, want github/octocat", got[0].GetFullName())
+	}
+
+	if got[0].GetBuildNumber() != 1 {
+		t.Errorf("Push component build is %d, want 1", got[0].GetBuildNumber())
+	}
+
+	if len(got[0].GetDeploys()) != 1 || got[0].GetDeploys()[0].GetVersion() != "v1.0.0" {
+		t.Errorf("Push component deploys are %v, want version v1.0.0", got[0].GetDeploys())
+	}
+}
+
+func TestCatalog_Push_Failure(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	// setup server
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer s.Close()
+
+	c, err := New(
+		WithDatabase(db),
+		WithURL(s.URL),
+	)
+	if err != nil {
+		t.Fatalf("New returned err: %v", err)
+	}
+
+	// run test
+	_, err = c.Push()
+	if err == nil {
+		t.Errorf("Push should have returned err")
+	}
+}
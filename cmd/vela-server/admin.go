@@ -0,0 +1,581 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scmmigrate"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// backfillBatchSize represents the maximum number of
+// log lines created in a single database call when
+// backfilling the lines for existing logs.
+const backfillBatchSize = 1000
+
+// admin represents the subcommands for performing
+// maintenance against the configured database and queue.
+var admin = &cli.Command{
+	Name:  "admin",
+	Usage: "perform maintenance against the database and queue",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "migrate",
+			Usage:  "create any missing tables and indexes and apply pending schema migrations in the database",
+			Action: adminMigrate,
+		},
+		{
+			Name:   "rotate-keys",
+			Usage:  "re-encrypt users, repos and secrets in the database with a new encryption key (same as rekey)",
+			Action: rekeyRun,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					EnvVars:  []string{"VELA_DATABASE_ENCRYPTION_NEW_KEY", "DATABASE_ENCRYPTION_NEW_KEY"},
+					Name:     "new-key",
+					Usage:    "AES-256 key to re-encrypt the database with",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  "force",
+					Usage: "skip the rows that can't be decrypted with the current key instead of aborting",
+				},
+			},
+		},
+		{
+			Name:   "requeue",
+			Usage:  "publish pending builds to the queue",
+			Action: adminRequeue,
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:  "since",
+					Usage: "only publish pending builds created within the duration",
+					Value: 24 * time.Hour,
+				},
+			},
+		},
+		{
+			Name:   "prune-logs",
+			Usage:  "remove the logs for builds that finished before a cutoff",
+			Action: adminPruneLogs,
+			Flags: []cli.Flag{
+				&cli.DurationFlag{
+					Name:  "older-than",
+					Usage: "remove logs for builds that finished longer ago than the duration",
+					Value: 90 * 24 * time.Hour,
+				},
+				&cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "report the logs that would be removed without removing them",
+				},
+			},
+		},
+		{
+			Name:   "migrate-scm",
+			Usage:  "move the repos, users and webhooks from one scm address to another",
+			Action: adminMigrateSCM,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "from-addr",
+					Usage:    "address of the scm to migrate from, i.e. https://github.example.com",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "to-addr",
+					Usage:    "address of the scm to migrate to, i.e. https://github.com",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "report the repos, users and webhooks that would be migrated without migrating them",
+				},
+			},
+		},
+		{
+			Name:   "backfill",
+			Usage:  "create the log lines for logs captured before log lines were supported",
+			Action: adminBackfill,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "report the logs that would be backfilled without backfilling them",
+				},
+			},
+		},
+	},
+}
+
+// adminMigrate applies the pending schema migrations in the database,
+// starting with the baseline that creates the tables and indexes.
+func adminMigrate(c *cli.Context) error {
+	_setup, err := databaseSetup(c)
+	if err != nil {
+		return err
+	}
+
+	// always create the schema_migrations table when migrating
+	_setup.SkipCreation = false
+
+	// setup the database
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database?tab=doc#New
+	db, err := database.New(_setup)
+	if err != nil {
+		return err
+	}
+
+	// apply the pending schema migrations
+	_, err = db.MigrateUp(c.Context, 0)
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("migrated %s database", _setup.Driver)
+
+	return nil
+}
+
+// adminRequeue compiles and publishes the pending builds
+// created within the duration provided by the since flag.
+//
+// This is intended to recover builds lost from the queue,
+// so the steps and services created for each build when it
+// was first compiled are left untouched.
+//
+//nolint:funlen,gocyclo // ignore function length and cyclomatic complexity
+func adminRequeue(c *cli.Context) error {
+	db, err := setupDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	queue, err := setupQueue(c)
+	if err != nil {
+		return err
+	}
+
+	compiler, err := setupCompiler(c)
+	if err != nil {
+		return err
+	}
+
+	// capture the template deprecations marked by template owners
+	compiler.WithTemplateDeprecations(db)
+
+	// capture the deprecation rules registered by admins
+	compiler.WithDeprecationRules(db)
+
+	// capture the registry mirrors images are rewritten to pull from
+	compiler.WithRegistryMirrors(db)
+
+	// capture the egress rules enforced on the steps of builds
+	compiler.WithEgressRules(db)
+
+	// capture the clone settings for submodules and Git LFS
+	compiler.WithCloneSettings(db)
+
+	// capture the variables injected into the builds for repos
+	compiler.WithRepoVariables(db)
+
+	scm, err := setupSCM(c)
+	if err != nil {
+		return err
+	}
+
+	metadata, err := setupMetadata(c)
+	if err != nil {
+		return err
+	}
+
+	after := time.Now().Add(-c.Duration("since")).Unix()
+
+	// send API call to capture the pending and running builds
+	queued, err := db.GetPendingAndRunningBuilds(c.Context, strconv.FormatInt(after, 10))
+	if err != nil {
+		return fmt.Errorf("unable to list pending builds: %w", err)
+	}
+
+	count := 0
+
+	for _, q := range queued {
+		if !strings.EqualFold(q.GetStatus(), constants.StatusPending) {
+			continue
+		}
+
+		entry := fmt.Sprintf("%s/%d", q.GetFullName(), q.GetNumber())
+
+		parts := strings.SplitN(q.GetFullName(), "/", 2)
+		if len(parts) != 2 {
+			logrus.Errorf("unable to requeue build %s: invalid repo name", entry)
+
+			continue
+		}
+
+		// send API call to capture the repo for the build
+		r, err := db.GetRepoForOrg(c.Context, parts[0], parts[1])
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		// send API call to capture the build
+		b, err := db.GetBuild(c.Context, int(q.GetNumber()), r)
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		// send API call to capture the repo owner
+		u, err := db.GetUser(c.Context, r.GetUserID())
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		// send API call to capture the pipeline for the build
+		pipeline, err := db.GetPipelineForRepo(c.Context, b.GetCommit(), r)
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		var files []string
+
+		switch b.GetEvent() {
+		case constants.EventComment:
+		case constants.EventPull:
+			// parse out pull request number from base ref
+			//
+			// pattern: refs/pull/1/head
+			number, err := strconv.Atoi(strings.TrimPrefix(strings.TrimSuffix(b.GetRef(), "/head"), "refs/pull/"))
+			if err != nil {
+				logrus.Errorf("unable to requeue build %s: invalid ref %s", entry, b.GetRef())
+
+				continue
+			}
+
+			// send API call to capture list of files changed for the pull request
+			files, err = scm.ChangesetPR(u, r, number)
+			if err != nil {
+				logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+				continue
+			}
+		default:
+			// send API call to capture list of files changed for the commit
+			files, err = scm.Changeset(u, r, b.GetCommit())
+			if err != nil {
+				logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+				continue
+			}
+		}
+
+		// ensure we use the expected pipeline type when compiling
+		if len(pipeline.GetType()) > 0 {
+			r.SetPipelineType(pipeline.GetType())
+		}
+
+		// parse and compile the pipeline configuration file
+		p, _, err := compiler.
+			Duplicate().
+			WithBuild(b).
+			WithFiles(files).
+			WithMetadata(metadata).
+			WithRepo(r).
+			WithUser(u).
+			Compile(pipeline.GetData())
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		byteItem, err := json.Marshal(types.ToItem(p, b, r, u))
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		route, err := queue.Route(&p.Worker)
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		err = queue.Push(context.Background(), route, byteItem)
+		if err != nil {
+			logrus.Errorf("unable to requeue build %s: %v", entry, err)
+
+			continue
+		}
+
+		// update the build to reflect the time it was enqueued
+		b.SetEnqueued(time.Now().UTC().Unix())
+
+		err = db.UpdateBuild(c.Context, b)
+		if err != nil {
+			logrus.Errorf("unable to update build %s: %v", entry, err)
+		}
+
+		logrus.Infof("requeued build %s to queue %s", entry, route)
+
+		count++
+	}
+
+	logrus.Infof("requeued %d builds", count)
+
+	return nil
+}
+
+// adminPruneLogs removes the logs and log lines for builds that
+// finished longer ago than the duration provided by the older-than flag.
+func adminPruneLogs(c *cli.Context) error {
+	if c.Duration("older-than") <= 0 {
+		return fmt.Errorf("invalid older-than provided: %s", c.Duration("older-than"))
+	}
+
+	db, err := setupDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	before := time.Now().Add(-c.Duration("older-than")).Unix()
+
+	// send API call to capture all builds
+	builds, err := db.GetBuildList(c.Context)
+	if err != nil {
+		return fmt.Errorf("unable to list builds: %w", err)
+	}
+
+	count := 0
+
+	for _, b := range builds {
+		// skip builds that haven't finished or finished after the cutoff
+		if b.GetFinished() == 0 || b.GetFinished() >= before {
+			continue
+		}
+
+		logs, err := listLogsForBuild(c.Context, db, b)
+		if err != nil {
+			return err
+		}
+
+		for _, l := range logs {
+			count++
+
+			if c.Bool("dry-run") {
+				continue
+			}
+
+			// send API call to remove the lines for the log
+			err = db.DeleteLinesForLog(c.Context, l)
+			if err != nil {
+				return fmt.Errorf("unable to delete lines for log %d: %w", l.GetID(), err)
+			}
+
+			// send API call to remove the log
+			err = db.DeleteLog(c.Context, l)
+			if err != nil {
+				return fmt.Errorf("unable to delete log %d: %w", l.GetID(), err)
+			}
+		}
+	}
+
+	if c.Bool("dry-run") {
+		logrus.Infof("would prune %d logs finished before %d", count, before)
+
+		return nil
+	}
+
+	logrus.Infof("pruned %d logs finished before %d", count, before)
+
+	return nil
+}
+
+// adminMigrateSCM moves the repos, users and webhooks from the scm
+// address provided by the from-addr flag to the address provided by
+// the to-addr flag and outputs a report of the changes.
+//
+// The scm flags must be configured for the scm migrated to. Users
+// are logged out until they log in to the new scm, and the webhooks
+// for the repos they own are recreated when the command is repeated.
+func adminMigrateSCM(c *cli.Context) error {
+	db, err := setupDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	scm, err := setupSCM(c)
+	if err != nil {
+		return err
+	}
+
+	// setup the migrator
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scmmigrate?tab=doc#New
+	m, err := scmmigrate.New(
+		scmmigrate.WithDatabase(db),
+		scmmigrate.WithSCM(scm),
+		scmmigrate.WithFromAddress(c.String("from-addr")),
+		scmmigrate.WithToAddress(c.String("to-addr")),
+		scmmigrate.WithDryRun(c.Bool("dry-run")),
+	)
+	if err != nil {
+		return err
+	}
+
+	report, err := m.Run()
+	if err != nil {
+		return err
+	}
+
+	verb := "migrated"
+	if c.Bool("dry-run") {
+		verb = "would migrate"
+	}
+
+	logrus.Infof("%s %d repos, %d users and %d webhooks with %d pending",
+		verb, len(report.Repos), len(report.Users), len(report.Hooks)+len(report.OrgHooks), len(report.Pending))
+
+	// serialize the report as pretty JSON
+	bytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// output the report to stdout
+	fmt.Fprintf(os.Stdout, "%s\n", string(bytes))
+
+	return nil
+}
+
+// adminBackfill creates the log lines for logs that were captured
+// before log lines were supported, so the lines endpoints return
+// the same output as the raw log data.
+func adminBackfill(c *cli.Context) error {
+	db, err := setupDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	// send API call to capture all logs
+	logs, err := db.ListLogs(c.Context)
+	if err != nil {
+		return fmt.Errorf("unable to list logs: %w", err)
+	}
+
+	count := 0
+
+	for _, l := range logs {
+		if len(l.GetData()) == 0 {
+			continue
+		}
+
+		// send API call to capture the number of lines for the log
+		existing, err := db.CountLinesForLog(c.Context, l)
+		if err != nil {
+			return fmt.Errorf("unable to count lines for log %d: %w", l.GetID(), err)
+		}
+
+		// skip logs that already have lines
+		if existing > 0 {
+			continue
+		}
+
+		count++
+
+		if c.Bool("dry-run") {
+			continue
+		}
+
+		lines := backfillLines(l)
+
+		for start := 0; start < len(lines); start += backfillBatchSize {
+			end := start + backfillBatchSize
+			if end > len(lines) {
+				end = len(lines)
+			}
+
+			// send API call to create the lines for the log
+			err = db.CreateLogLines(c.Context, lines[start:end])
+			if err != nil {
+				return fmt.Errorf("unable to create lines for log %d: %w", l.GetID(), err)
+			}
+		}
+	}
+
+	if c.Bool("dry-run") {
+		logrus.Infof("would backfill lines for %d logs", count)
+
+		return nil
+	}
+
+	logrus.Infof("backfilled lines for %d logs", count)
+
+	return nil
+}
+
+// helper function to capture every log for the provided build.
+func listLogsForBuild(ctx context.Context, db database.Service, b *library.Build) ([]*library.Log, error) {
+	logs := []*library.Log{}
+
+	for page := 1; ; page++ {
+		// send API call to capture a page of logs for the build
+		l, total, err := db.ListLogsForBuild(ctx, b, page, 100)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list logs for build %d: %w", b.GetID(), err)
+		}
+
+		logs = append(logs, l...)
+
+		if len(l) == 0 || int64(len(logs)) >= total {
+			return logs, nil
+		}
+	}
+}
+
+// helper function to split the raw data for the provided
+// log into lines written to standard out. The original
+// time each line was written is unknown so it is omitted.
+func backfillLines(l *library.Log) []*api.LogLine {
+	data := strings.TrimSuffix(string(l.GetData()), "\n")
+
+	lines := []*api.LogLine{}
+
+	for i, text := range strings.Split(data, "\n") {
+		line := new(api.LogLine)
+
+		line.SetBuildID(l.GetBuildID())
+		line.SetLogID(l.GetID())
+		line.SetNumber(i + 1)
+		line.SetStream(api.LogStreamStdout)
+		line.SetData(text)
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/anomaly"
+	"github.com/go-vela/server/database"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the anomaly detector from the CLI arguments.
+func setupAnomaly(c *cli.Context, d database.Service) (*anomaly.Detector, error) {
+	logrus.Debug("Creating anomaly detector from CLI configuration")
+
+	// setup the anomaly detector
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/anomaly?tab=doc#New
+	return anomaly.New(
+		anomaly.WithDatabase(d),
+		anomaly.WithInterval(c.Duration("anomaly.interval")),
+		anomaly.WithWindows(c.Duration("anomaly.baseline"), c.Duration("anomaly.recent")),
+		anomaly.WithThreshold(c.Float64("anomaly.threshold")),
+		anomaly.WithMinSamples(c.Int("anomaly.min-samples")),
+		anomaly.WithWebhook(c.String("anomaly.webhook")),
+	)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/blob"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the blob storage from the CLI arguments.
+func setupBlob(c *cli.Context) (blob.Service, error) {
+	// check if the blob storage is disabled
+	if len(c.String("blob.driver")) == 0 {
+		logrus.Debug("blob storage is disabled, storing log data in the database")
+
+		return nil, nil
+	}
+
+	logrus.Debug("Creating blob storage client from CLI configuration")
+
+	// blob configuration
+	_setup := &blob.Setup{
+		Driver:      c.String("blob.driver"),
+		S3Bucket:    c.String("blob.s3.bucket"),
+		S3Prefix:    c.String("blob.s3.prefix"),
+		S3Endpoint:  c.String("blob.s3.endpoint"),
+		S3Region:    c.String("blob.s3.region"),
+		S3AccessKey: c.String("blob.s3.access-key"),
+		S3SecretKey: c.String("blob.s3.secret-key"),
+	}
+
+	// setup the blob storage
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/blob?tab=doc#New
+	return blob.New(_setup)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/budget"
+	"github.com/go-vela/server/database"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the build budget monitor from the CLI arguments.
+func setupBudget(c *cli.Context, d database.Service) (*budget.Monitor, error) {
+	logrus.Debug("Creating build budget monitor from CLI configuration")
+
+	// setup the build budget monitor
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/budget?tab=doc#New
+	return budget.New(
+		budget.WithDatabase(d),
+		budget.WithInterval(c.Duration("budget.interval")),
+		budget.WithThresholds(c.Int64Slice("budget.thresholds")),
+		budget.WithWebhook(c.String("budget.webhook")),
+	)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/canary"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the canary scheduler from the CLI arguments.
+func setupCanary(c *cli.Context, comp compiler.Engine, d database.Service, m *types.Metadata, q queue.Service) (*canary.Scheduler, error) {
+	logrus.Debug("Creating canary scheduler from CLI configuration")
+
+	// setup the canary scheduler
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/canary?tab=doc#New
+	return canary.New(
+		canary.WithCompiler(comp),
+		canary.WithDatabase(d),
+		canary.WithMetadata(m),
+		canary.WithQueue(q),
+		canary.WithInterval(c.Duration("canary.interval")),
+		canary.WithTimeout(c.Duration("canary.timeout")),
+		canary.WithRepo(c.String("canary.repo")),
+		canary.WithImage(c.String("canary.image")),
+	)
+}
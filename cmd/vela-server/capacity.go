@@ -0,0 +1,28 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/capacity"
+	"github.com/go-vela/server/database"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the capacity recorder from the CLI arguments.
+func setupCapacity(c *cli.Context, d database.Service) (*capacity.Recorder, error) {
+	logrus.Debug("Creating capacity recorder from CLI configuration")
+
+	// setup the capacity recorder
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/capacity?tab=doc#New
+	return capacity.New(
+		capacity.WithDatabase(d),
+		capacity.WithInterval(c.Duration("capacity.interval")),
+		capacity.WithRetention(c.Duration("capacity.retention")),
+		capacity.WithActiveInterval(c.Duration("worker-active-interval")),
+	)
+}
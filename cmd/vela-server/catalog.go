@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/catalog"
+	"github.com/go-vela/server/database"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the service catalog from the CLI arguments.
+func setupCatalog(c *cli.Context, d database.Service) (*catalog.Catalog, error) {
+	logrus.Debug("Creating service catalog from CLI configuration")
+
+	// setup the service catalog
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/catalog?tab=doc#New
+	return catalog.New(
+		catalog.WithDatabase(d),
+		catalog.WithURL(c.String("catalog.url")),
+		catalog.WithToken(c.String("catalog.token")),
+		catalog.WithInterval(c.Duration("catalog.interval")),
+		catalog.WithOwners(c.StringSlice("catalog.owners")),
+	)
+}
@@ -16,20 +16,66 @@ import (
 func setupDatabase(c *cli.Context) (database.Service, error) {
 	logrus.Debug("Creating database client from CLI configuration")
 
+	// setup the database
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database?tab=doc#New
+	_setup, err := databaseSetup(c)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := database.New(_setup)
+	if err != nil {
+		return nil, err
+	}
+
+	// skip applying the schema migrations, which create the tables
+	if _setup.SkipCreation {
+		return db, nil
+	}
+
+	// apply the pending schema migrations
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database?tab=doc#Service
+	_, err = db.MigrateUp(c.Context, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// helper function to capture the database configuration from the CLI arguments.
+func databaseSetup(c *cli.Context) (*database.Setup, error) {
+	// setup the kms used to encrypt fields in the database
+	_kms, err := setupKMS(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// setup the blob storage used to store log data outside of the database
+	_blob, err := setupBlob(c)
+	if err != nil {
+		return nil, err
+	}
+
 	// database configuration
 	_setup := &database.Setup{
-		Driver:           c.String("database.driver"),
-		Address:          c.String("database.addr"),
-		CompressionLevel: c.Int("database.compression.level"),
-		ConnectionLife:   c.Duration("database.connection.life"),
-		ConnectionIdle:   c.Int("database.connection.idle"),
-		ConnectionOpen:   c.Int("database.connection.open"),
-		EncryptionKey:    c.String("database.encryption.key"),
-		SkipCreation:     c.Bool("database.skip_creation"),
+		Driver:               c.String("database.driver"),
+		Address:              c.String("database.addr"),
+		CompressionAlgorithm: c.String("database.compression.algorithm"),
+		CompressionLevel:     c.Int("database.compression.level"),
+		ConnectionLife:       c.Duration("database.connection.life"),
+		ConnectionIdle:       c.Int("database.connection.idle"),
+		ConnectionOpen:       c.Int("database.connection.open"),
+		StatementTimeout:     c.Duration("database.statement.timeout"),
+		SlowQueryThreshold:   c.Duration("database.statement.slow_threshold"),
+		Replicas:             c.StringSlice("database.replicas"),
+		EncryptionKey:        c.String("database.encryption.key"),
+		SkipCreation:         c.Bool("database.skip_creation"),
+		KMS:                  _kms,
+		Blob:                 _blob,
 	}
 
-	// setup the database
-	//
-	// https://pkg.go.dev/github.com/go-vela/server/database?tab=doc#New
-	return database.New(_setup)
+	return _setup, nil
 }
@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/diagnostics"
+	"github.com/go-vela/server/queue"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the diagnostics collector from the CLI arguments.
+func setupDiagnostics(c *cli.Context, d database.Service, q queue.Service) (*diagnostics.Collector, error) {
+	logrus.Debug("Creating diagnostics collector from CLI configuration")
+
+	// capture the value for every flag, the collector
+	// redacts the secrets from the configuration summary
+	summary := make(map[string]string)
+
+	for _, flag := range c.App.Flags {
+		name := flag.Names()[0]
+
+		summary[name] = c.String(name)
+	}
+
+	// setup the diagnostics collector
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/diagnostics?tab=doc#New
+	collector, err := diagnostics.New(
+		diagnostics.WithConfig(summary),
+		diagnostics.WithDatabase(d),
+		diagnostics.WithEnabled(c.Bool("diagnostics.enabled")),
+		diagnostics.WithErrors(c.Int("diagnostics.errors")),
+		diagnostics.WithMaxDuration(c.Duration("diagnostics.max-duration")),
+		diagnostics.WithQueue(q),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// record the recent errors logged by the server
+	if collector.Enabled() {
+		logrus.AddHook(collector)
+	}
+
+	return collector, nil
+}
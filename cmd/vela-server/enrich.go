@@ -0,0 +1,27 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/enrich"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the client enricher from the CLI arguments.
+func setupEnrich(c *cli.Context) (*enrich.Enricher, error) {
+	logrus.Debug("Creating client enricher from CLI configuration")
+
+	// setup the client enricher
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/enrich?tab=doc#New
+	return enrich.New(
+		enrich.WithUserAgent(c.Bool("enrich.user-agent")),
+		enrich.WithLocationHeaders(c.StringSlice("enrich.location.headers")),
+		enrich.WithLocationURL(c.String("enrich.location.url")),
+		enrich.WithLocationTimeout(c.Duration("enrich.location.timeout")),
+	)
+}
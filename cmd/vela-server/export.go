@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/export"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the exporter from the CLI arguments.
+func setupExport(c *cli.Context, d database.Service) (*export.Exporter, error) {
+	logrus.Debug("Creating exporter from CLI configuration")
+
+	// setup the exporter
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/export?tab=doc#New
+	return export.New(
+		export.WithDatabase(d),
+		export.WithBucket(c.String("export.bucket")),
+		export.WithPrefix(c.String("export.prefix")),
+		export.WithEndpoint(c.String("export.endpoint")),
+		export.WithRegion(c.String("export.region")),
+		export.WithCredentials(c.String("export.access-key"), c.String("export.secret-key")),
+		export.WithFormat(c.String("export.format")),
+		export.WithInterval(c.Duration("export.interval")),
+	)
+}
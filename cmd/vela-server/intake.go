@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/intake"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the webhook intake buffer from the CLI arguments.
+func setupIntake(c *cli.Context, d database.Service) (*intake.Buffer, error) {
+	logrus.Debug("Creating webhook intake buffer from CLI configuration")
+
+	// setup the webhook intake buffer
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/intake?tab=doc#New
+	return intake.New(
+		intake.WithDatabase(d),
+		intake.WithWorkers(c.Int("webhook.intake.workers")),
+		intake.WithCapacity(c.Int64("webhook.intake.capacity")),
+		intake.WithRetries(c.Int64("webhook.intake.retries")),
+		intake.WithInterval(c.Duration("webhook.intake.interval")),
+		intake.WithVisibility(c.Duration("webhook.intake.visibility")),
+	)
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/janitor"
+	"github.com/go-vela/server/placement"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the janitor from the CLI arguments.
+func setupJanitor(c *cli.Context, comp compiler.Engine, d database.Service, m *types.Metadata, p *placement.Placer, q queue.Service, s scm.Service) (*janitor.Janitor, error) {
+	logrus.Debug("Creating janitor from CLI configuration")
+
+	// setup the janitor
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/janitor?tab=doc#New
+	return janitor.New(
+		janitor.WithCompiler(comp),
+		janitor.WithDatabase(d),
+		janitor.WithMetadata(m),
+		janitor.WithPlacer(p),
+		janitor.WithQueue(q),
+		janitor.WithSCM(s),
+		janitor.WithInterval(c.Duration("janitor.interval")),
+		janitor.WithThreshold(c.Duration("janitor.threshold")),
+		janitor.WithRequeue(c.Bool("janitor.requeue")),
+	)
+}
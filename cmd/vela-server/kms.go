@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/kms"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the kms from the CLI arguments.
+func setupKMS(c *cli.Context) (kms.Service, error) {
+	logrus.Debug("Creating kms client from CLI configuration")
+
+	// kms configuration
+	_setup := &kms.Setup{
+		Driver:            c.String("kms.driver"),
+		LocalKey:          c.String("kms.local.key"),
+		LocalPreviousKeys: c.StringSlice("kms.local.previous-keys"),
+		VaultAddress:      c.String("kms.vault.addr"),
+		VaultKey:          c.String("kms.vault.key"),
+		VaultMount:        c.String("kms.vault.mount"),
+		VaultToken:        c.String("kms.vault.token"),
+		AWSKeyID:          c.String("kms.aws.key-id"),
+		AWSRegion:         c.String("kms.aws.region"),
+	}
+
+	// check if a key was provided for the local driver
+	if len(_setup.LocalKey) == 0 {
+		// default to the database encryption key
+		_setup.LocalKey = c.String("database.encryption.key")
+	}
+
+	// setup the kms
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/kms?tab=doc#New
+	return kms.New(_setup)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/leader"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the leader elector from the CLI arguments.
+func setupLeader(c *cli.Context, d database.Service) (*leader.Elector, error) {
+	logrus.Debug("Creating leader elector from CLI configuration")
+
+	// setup the leader elector
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/leader?tab=doc#New
+	return leader.New(
+		leader.WithDatabase(d),
+		leader.WithHolder(c.String("leader.holder")),
+		leader.WithInterval(c.Duration("leader.interval")),
+		leader.WithTTL(c.Duration("leader.ttl")),
+	)
+}
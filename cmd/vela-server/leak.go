@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/leak"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the leak scanner from the CLI arguments.
+func setupLeak(c *cli.Context, d database.Service) (*leak.Scanner, error) {
+	logrus.Debug("Creating leak scanner from CLI configuration")
+
+	// setup the leak scanner
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/leak?tab=doc#New
+	return leak.New(
+		leak.WithDatabase(d),
+		leak.WithInterval(c.Duration("leak.interval")),
+		leak.WithLookback(c.Duration("leak.lookback")),
+		leak.WithRedact(c.Bool("leak.redact")),
+		leak.WithWebhook(c.String("leak.webhook")),
+	)
+}
@@ -12,8 +12,28 @@ import (
 
 	"github.com/go-vela/types/constants"
 
+	"github.com/go-vela/server/anomaly"
+	"github.com/go-vela/server/blob"
+	"github.com/go-vela/server/budget"
+	"github.com/go-vela/server/canary"
+	"github.com/go-vela/server/capacity"
+	"github.com/go-vela/server/catalog"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/diagnostics"
+	"github.com/go-vela/server/enrich"
+	"github.com/go-vela/server/export"
+	"github.com/go-vela/server/intake"
+	"github.com/go-vela/server/janitor"
+	"github.com/go-vela/server/kms"
+	"github.com/go-vela/server/leader"
+	"github.com/go-vela/server/leak"
+	"github.com/go-vela/server/partitioner"
+	"github.com/go-vela/server/placement"
 	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/reencrypt"
+	"github.com/go-vela/server/reposync"
+	"github.com/go-vela/server/retention"
+	"github.com/go-vela/server/scheduler"
 	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/secret"
 	"github.com/go-vela/server/version"
@@ -70,6 +90,38 @@ func main() {
 			Usage:   "web ui oauth callback path",
 			Value:   "/account/authenticate",
 		},
+		&cli.StringSliceFlag{
+			EnvVars: []string{"VELA_CORS_ALLOWED_ORIGINS"},
+			Name:    "cors-allowed-origins",
+			Usage:   "additional origins (<scheme>://<host>) allowed to make cross-origin requests to the API, like other ui deployments or browser-based tools (use <scheme>://*.<domain> for all subdomains)",
+			Value:   &cli.StringSlice{},
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_CONTENT_SECURITY_POLICY"},
+			Name:    "content-security-policy",
+			Usage:   "Content-Security-Policy header set on every response (set to empty to disable)",
+			Value:   "default-src 'none'; style-src 'unsafe-inline'; frame-ancestors 'none'",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_SERVER_TLS_CERT"},
+			Name:    "server-tls-cert",
+			Usage:   "path to the certificate for the server to serve the API over TLS",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_SERVER_TLS_KEY"},
+			Name:    "server-tls-key",
+			Usage:   "path to the private key for the server to serve the API over TLS",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_METRICS_TOKEN"},
+			Name:    "metrics-token",
+			Usage:   "bearer token required to scrape the metrics endpoint (leave empty to allow anonymous scrapes)",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_METRICS_CLIENT_CA"},
+			Name:    "metrics-client-ca",
+			Usage:   "path to the CA bundle for client certificates allowed to scrape the metrics endpoint (requires server-tls-cert and server-tls-key)",
+		},
 		&cli.StringFlag{
 			EnvVars: []string{"VELA_SECRET"},
 			Name:    "vela-secret",
@@ -86,24 +138,80 @@ func main() {
 			Usage:   "the clone image to use for the injected clone step",
 			Value:   "target/vela-git:v0.7.0@sha256:c2e8794556d6debceeaa2c82ff3cc9e8e6ed045b723419e3ff050409f25cc258",
 		},
+		&cli.StringSliceFlag{
+			EnvVars: []string{"VELA_TEMPLATE_PIN_ORGS", "TEMPLATE_PIN_ORGS"},
+			Name:    "template-pin-orgs",
+			Usage:   "orgs that must pin templates to a tag or commit SHA (use '*' for all orgs)",
+		},
+		&cli.StringSliceFlag{
+			EnvVars: []string{"VELA_IMMUTABLE_IMAGE_ORGS", "IMMUTABLE_IMAGE_ORGS"},
+			Name:    "immutable-image-orgs",
+			Usage:   "orgs that forbid images with mutable tags like latest in pipelines (use '*' for all orgs)",
+		},
+		&cli.BoolFlag{
+			EnvVars: []string{"VELA_IMAGE_DIGEST_RESOLUTION", "IMAGE_DIGEST_RESOLUTION"},
+			Name:    "image-digest-resolution",
+			Usage:   "enables pinning the tags of images in pipelines to the digests they resolve to at compile time",
+		},
+		&cli.DurationFlag{
+			EnvVars: []string{"VELA_IMAGE_DIGEST_CACHE_DURATION", "IMAGE_DIGEST_CACHE_DURATION"},
+			Name:    "image-digest-cache-duration",
+			Usage:   "duration to cache the digests images resolve to between compilations (0 disables caching)",
+			Value:   5 * time.Minute,
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_ORG_TEMPLATE_REPO", "ORG_TEMPLATE_REPO"},
+			Name:    "org-template-repo",
+			Usage:   "name of the repo in each org that shared templates and pipeline fragments resolve from (empty disables)",
+			Value:   ".vela",
+		},
+		&cli.DurationFlag{
+			EnvVars: []string{"VELA_ORG_TEMPLATE_CACHE_DURATION", "ORG_TEMPLATE_CACHE_DURATION"},
+			Name:    "org-template-cache-duration",
+			Usage:   "duration to cache templates from the shared repo for an org between compilations (0 disables caching)",
+			Value:   5 * time.Minute,
+		},
 		&cli.StringSliceFlag{
 			EnvVars: []string{"VELA_REPO_ALLOWLIST"},
 			Name:    "vela-repo-allowlist",
 			Usage:   "allowlist is used to limit which repos can be activated within the system",
 			Value:   &cli.StringSlice{},
 		},
+		&cli.DurationFlag{
+			EnvVars: []string{"VELA_SHUTDOWN_TIMEOUT", "SHUTDOWN_TIMEOUT"},
+			Name:    "shutdown-timeout",
+			Usage:   "duration to wait for in-flight requests to complete on shutdown before staging the webhooks still being processed",
+			Value:   30 * time.Second,
+		},
 		&cli.BoolFlag{
 			EnvVars: []string{"VELA_DISABLE_WEBHOOK_VALIDATION"},
 			Name:    "vela-disable-webhook-validation",
 			Usage:   "determines whether or not webhook validation is disabled.  useful for local development.",
 			Value:   false,
 		},
+		&cli.DurationFlag{
+			EnvVars: []string{"VELA_WEBHOOK_MAX_AGE", "WEBHOOK_MAX_AGE"},
+			Name:    "webhook-max-age",
+			Usage:   "duration a webhook delivery is accepted for after the scm sent it before it is rejected as stale",
+			Value:   5 * time.Minute,
+		},
+		&cli.DurationFlag{
+			EnvVars: []string{"VELA_WEBHOOK_DELIVERY_RETENTION", "WEBHOOK_DELIVERY_RETENTION"},
+			Name:    "webhook-delivery-retention",
+			Usage:   "duration a webhook delivery is recorded for to reject replays of the delivery",
+			Value:   72 * time.Hour,
+		},
 		&cli.BoolFlag{
 			EnvVars: []string{"VELA_ENABLE_SECURE_COOKIE"},
 			Name:    "vela-enable-secure-cookie",
 			Usage:   "determines whether or not use cookies with secure flag set.  useful for testing.",
 			Value:   true,
 		},
+		&cli.BoolFlag{
+			EnvVars: []string{"VELA_PIPELINE_PREVIEW_COMMENTS", "PIPELINE_PREVIEW_COMMENTS"},
+			Name:    "pipeline-preview-comments",
+			Usage:   "enables comments on pull requests previewing the changes to the compiled pipeline",
+		},
 		&cli.Int64Flag{
 			EnvVars: []string{"VELA_DEFAULT_BUILD_LIMIT"},
 			Name:    "default-build-limit",
@@ -128,6 +236,16 @@ func main() {
 			Usage:   "override default events for newly activated repositories",
 			Value:   cli.NewStringSlice(constants.EventPush),
 		},
+		&cli.IntFlag{
+			EnvVars: []string{"VELA_DEPLOY_REQUIRED_APPROVALS", "DEPLOY_REQUIRED_APPROVALS"},
+			Name:    "deploy-required-approvals",
+			Usage:   "number of pull request approvals required for a commit before a deployment build runs (0 disables)",
+		},
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_DEPLOY_REQUIRED_TEAM", "DEPLOY_REQUIRED_TEAM"},
+			Name:    "deploy-required-team",
+			Usage:   "slug of the team in the repo org that must approve a pull request for a commit before a deployment build runs (empty disables)",
+		},
 		// Token Manager Flags
 		&cli.DurationFlag{
 			EnvVars: []string{"VELA_USER_ACCESS_TOKEN_DURATION", "USER_ACCESS_TOKEN_DURATION"},
@@ -147,6 +265,12 @@ func main() {
 			Usage:   "sets the duration of the buffer for build token expiration based on repo build timeout",
 			Value:   5 * time.Minute,
 		},
+		&cli.DurationFlag{
+			EnvVars: []string{"VELA_REPO_TRIGGER_TOKEN_DURATION", "REPO_TRIGGER_TOKEN_DURATION"},
+			Name:    "repo-trigger-token-duration",
+			Usage:   "sets the duration of the tokens that can only create or restart builds for a repo or org",
+			Value:   24 * time.Hour,
+		},
 		&cli.DurationFlag{
 			EnvVars: []string{"VELA_WORKER_AUTH_TOKEN_DURATION", "WORKER_AUTH_TOKEN_DURATION"},
 			Name:    "worker-auth-token-duration",
@@ -197,6 +321,12 @@ func main() {
 			Usage:   "modification retries, used by compiler, number of http requires that the modification http request will fail after",
 			Value:   5,
 		},
+		&cli.IntFlag{
+			EnvVars: []string{"VELA_COMPILE_REPORT_LIMIT", "COMPILE_REPORT_LIMIT"},
+			Name:    "compile-report-limit",
+			Usage:   "number of recent builds to retain compilation reports for (0 disables reports)",
+			Value:   1000,
+		},
 		&cli.DurationFlag{
 			EnvVars: []string{"VELA_WORKER_ACTIVE_INTERVAL", "WORKER_ACTIVE_INTERVAL"},
 			Name:    "worker-active-interval",
@@ -207,6 +337,9 @@ func main() {
 	// Add Database Flags
 	app.Flags = append(app.Flags, database.Flags...)
 
+	// Add KMS Flags
+	app.Flags = append(app.Flags, kms.Flags...)
+
 	// Add Queue Flags
 	app.Flags = append(app.Flags, queue.Flags...)
 
@@ -216,6 +349,66 @@ func main() {
 	// Add Source Flags
 	app.Flags = append(app.Flags, scm.Flags...)
 
+	// Add Anomaly Flags
+	app.Flags = append(app.Flags, anomaly.Flags...)
+
+	// Add Budget Flags
+	app.Flags = append(app.Flags, budget.Flags...)
+
+	// Add Blob Flags
+	app.Flags = append(app.Flags, blob.Flags...)
+
+	// Add Canary Flags
+	app.Flags = append(app.Flags, canary.Flags...)
+
+	// Add Capacity Flags
+	app.Flags = append(app.Flags, capacity.Flags...)
+
+	// Add Catalog Flags
+	app.Flags = append(app.Flags, catalog.Flags...)
+
+	// Add Diagnostics Flags
+	app.Flags = append(app.Flags, diagnostics.Flags...)
+
+	// Add Enrich Flags
+	app.Flags = append(app.Flags, enrich.Flags...)
+
+	// Add Export Flags
+	app.Flags = append(app.Flags, export.Flags...)
+
+	// Add Webhook Intake Flags
+	app.Flags = append(app.Flags, intake.Flags...)
+
+	// Add Janitor Flags
+	app.Flags = append(app.Flags, janitor.Flags...)
+
+	// Add Leader Flags
+	app.Flags = append(app.Flags, leader.Flags...)
+
+	// Add Leak Flags
+	app.Flags = append(app.Flags, leak.Flags...)
+
+	// Add Partitioner Flags
+	app.Flags = append(app.Flags, partitioner.Flags...)
+
+	// Add Placement Flags
+	app.Flags = append(app.Flags, placement.Flags...)
+
+	// Add Retention Flags
+	app.Flags = append(app.Flags, retention.Flags...)
+
+	// Add Re-encrypt Flags
+	app.Flags = append(app.Flags, reencrypt.Flags...)
+
+	// Add Repo Sync Flags
+	app.Flags = append(app.Flags, reposync.Flags...)
+
+	// Add Scheduler Flags
+	app.Flags = append(app.Flags, scheduler.Flags...)
+
+	// Add Admin, Migrate and Rekey Commands
+	app.Commands = []*cli.Command{admin, migrate, rekey}
+
 	// set logrus to log in JSON format
 	logrus.SetFormatter(&logrus.JSONFormatter{})
 
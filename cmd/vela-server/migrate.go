@@ -0,0 +1,146 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// migrate represents the subcommands for applying and
+// rolling back the versioned schema migrations for the
+// configured database.
+var migrate = &cli.Command{
+	Name:  "migrate",
+	Usage: "apply and roll back versioned schema migrations for the database",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "up",
+			Usage:  "apply the pending schema migrations",
+			Action: migrateUp,
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:  "version",
+					Usage: "apply the pending migrations up to and including the version, 0 applies every pending migration",
+				},
+			},
+		},
+		{
+			Name:   "down",
+			Usage:  "roll back the applied schema migrations",
+			Action: migrateDown,
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:     "version",
+					Usage:    "roll back the applied migrations newer than the version",
+					Required: true,
+				},
+			},
+		},
+		{
+			Name:   "status",
+			Usage:  "list the schema migrations and when they were applied",
+			Action: migrateStatus,
+		},
+	},
+}
+
+// migrateUp applies the pending schema migrations in the database.
+func migrateUp(c *cli.Context) error {
+	db, err := setupMigrationDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := db.MigrateUp(c.Context, c.Int64("version"))
+
+	for _, m := range migrations {
+		logrus.Infof("applied schema migration %d %s", m.GetVersion(), m.GetName())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("applied %d schema migrations", len(migrations))
+
+	return nil
+}
+
+// migrateDown rolls back the applied schema migrations in the database.
+func migrateDown(c *cli.Context) error {
+	if c.Int64("version") < 0 {
+		return fmt.Errorf("invalid version %d provided: must not be negative", c.Int64("version"))
+	}
+
+	db, err := setupMigrationDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := db.MigrateDown(c.Context, c.Int64("version"))
+
+	for _, m := range migrations {
+		logrus.Infof("rolled back schema migration %d %s", m.GetVersion(), m.GetName())
+	}
+
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("rolled back %d schema migrations", len(migrations))
+
+	return nil
+}
+
+// migrateStatus lists the schema migrations and when they were applied.
+func migrateStatus(c *cli.Context) error {
+	db, err := setupMigrationDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := db.ListMigrations(c.Context)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.GetApplied() == 0 {
+			fmt.Fprintf(c.App.Writer, "%d\t%s\tpending\n", m.GetVersion(), m.GetName())
+
+			continue
+		}
+
+		fmt.Fprintf(c.App.Writer, "%d\t%s\t%s\n", m.GetVersion(), m.GetName(),
+			time.Unix(m.GetApplied(), 0).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// helper function to setup the database for the migrate subcommands
+// without applying the pending migrations on startup. The
+// schema_migrations table recording the migrations is always created.
+func setupMigrationDatabase(c *cli.Context) (database.Service, error) {
+	_setup, err := databaseSetup(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// always create the schema_migrations table when migrating
+	_setup.SkipCreation = false
+
+	// setup the database
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database?tab=doc#New
+	return database.New(_setup)
+}
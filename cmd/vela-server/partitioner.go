@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/partitioner"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the partitioner from the CLI arguments.
+func setupPartitioner(c *cli.Context, d database.Service) (*partitioner.Partitioner, error) {
+	logrus.Debug("Creating partitioner from CLI configuration")
+
+	// setup the partitioner
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/partitioner?tab=doc#New
+	return partitioner.New(
+		partitioner.WithDatabase(d),
+		partitioner.WithEnabled(c.Bool("partition.enabled")),
+		partitioner.WithInterval(c.Duration("partition.interval")),
+		partitioner.WithPremake(c.Int("partition.premake")),
+		partitioner.WithRetention(c.Duration("partition.retention")),
+	)
+}
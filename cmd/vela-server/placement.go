@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/placement"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the build placer from the CLI arguments.
+func setupPlacement(c *cli.Context, d database.Service) (*placement.Placer, error) {
+	logrus.Debug("Creating build placer from CLI configuration")
+
+	// setup the build placer
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/placement?tab=doc#New
+	return placement.New(
+		placement.WithDatabase(d),
+		placement.WithStrategy(c.String("placement.strategy")),
+		placement.WithLongBuild(c.Duration("placement.long-build")),
+		placement.WithHistory(c.Int("placement.history")),
+		placement.WithActiveInterval(c.Duration("worker-active-interval")),
+	)
+}
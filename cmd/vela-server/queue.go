@@ -5,6 +5,7 @@
 package main
 
 import (
+	"github.com/go-vela/server/fault"
 	"github.com/go-vela/server/queue"
 
 	"github.com/sirupsen/logrus"
@@ -18,15 +19,28 @@ func setupQueue(c *cli.Context) (queue.Service, error) {
 
 	// queue configuration
 	_setup := &queue.Setup{
-		Driver:  c.String("queue.driver"),
-		Address: c.String("queue.addr"),
-		Cluster: c.Bool("queue.cluster"),
-		Routes:  c.StringSlice("queue.routes"),
-		Timeout: c.Duration("queue.pop.timeout"),
+		Driver:             c.String("queue.driver"),
+		Address:            c.String("queue.addr"),
+		Cluster:            c.Bool("queue.cluster"),
+		Routes:             c.StringSlice("queue.routes"),
+		Timeout:            c.Duration("queue.pop.timeout"),
+		FairShare:          c.String("queue.fair-share"),
+		ShareWeights:       c.StringSlice("queue.share-weights"),
+		DefaultShareWeight: c.Int64("queue.default-share-weight"),
+		Compression:        c.Bool("queue.compression"),
+		MaxItemSize:        c.Int64("queue.max-item-size"),
 	}
 
 	// setup the queue
 	//
 	// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#New
-	return queue.New(_setup)
+	q, err := queue.New(_setup)
+	if err != nil {
+		return nil, err
+	}
+
+	// wrap the queue to inject faults when built for testing
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/fault?tab=doc#Queue
+	return fault.Queue(q), nil
 }
@@ -0,0 +1,27 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/reencrypt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the re-encrypter from the CLI arguments.
+func setupReencrypt(c *cli.Context, d database.Service) (*reencrypt.Reencrypter, error) {
+	logrus.Debug("Creating re-encrypter from CLI configuration")
+
+	// setup the re-encrypter
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/reencrypt?tab=doc#New
+	return reencrypt.New(
+		reencrypt.WithDatabase(d),
+		reencrypt.WithInterval(c.Duration("reencrypt.interval")),
+		reencrypt.WithBatchSize(c.Int("reencrypt.batch-size")),
+	)
+}
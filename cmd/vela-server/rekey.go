@@ -0,0 +1,210 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// rekey represents the subcommands for rotating the
+// key used to encrypt fields in the configured database.
+var rekey = &cli.Command{
+	Name:   "rekey",
+	Usage:  "re-encrypt user tokens, repo hashes and secrets in the database with a new encryption key",
+	Action: rekeyRun,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			EnvVars: []string{"VELA_DATABASE_ENCRYPTION_NEW_KEY", "DATABASE_ENCRYPTION_NEW_KEY"},
+			Name:    "new-key",
+			Usage:   "AES-256 key to re-encrypt the database with",
+		},
+		&cli.BoolFlag{
+			Name:  "force",
+			Usage: "skip the rows that can't be decrypted with the current key instead of aborting",
+		},
+	},
+	Subcommands: []*cli.Command{
+		{
+			Name:   "audit",
+			Usage:  "count the encrypted rows that can and can't be decrypted with the current key",
+			Action: rekeyAudit,
+		},
+	},
+}
+
+// rekeyAudit prints the number of rows for each table with encrypted
+// fields that can and can't be decrypted with the configured key.
+func rekeyAudit(c *cli.Context) error {
+	db, err := setupRekeyDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	audits, err := auditEncryption(c.Context, db)
+	if err != nil {
+		return err
+	}
+
+	printAudits(c, audits)
+
+	return nil
+}
+
+// rekeyRun re-encrypts the users, repos and secrets, including archived
+// repos, in the database with the key provided by the new-key flag.
+//
+// The fields are decrypted with the configured encryption key, or the
+// kms when they were sealed by it, so it must be the key currently used
+// by the database. The fields are re-encrypted with the new key instead
+// of the kms so they can be opened once the server is started with the
+// new key, where the reencrypt job seals them again when it is enabled.
+func rekeyRun(c *cli.Context) error {
+	key := c.String("new-key")
+	if len(key) == 0 {
+		return errors.New("no new-key provided")
+	}
+
+	_rotated, err := databaseSetup(c)
+	if err != nil {
+		return err
+	}
+
+	if key == _rotated.EncryptionKey {
+		return errors.New("invalid new-key provided: must be different from the current key")
+	}
+
+	_rotated.EncryptionKey = key
+	_rotated.SkipCreation = true
+	_rotated.KMS = nil
+
+	// validate the new key before touching any data
+	err = _rotated.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid new-key provided: %w", err)
+	}
+
+	current, err := setupRekeyDatabase(c)
+	if err != nil {
+		return err
+	}
+
+	// audit the fields before re-keying to
+	// detect rows that would be left behind
+	audits, err := auditEncryption(c.Context, current)
+	if err != nil {
+		return err
+	}
+
+	printAudits(c, audits)
+
+	unreadable := int64(0)
+
+	for _, a := range audits {
+		unreadable += a.GetUnreadable()
+	}
+
+	if unreadable > 0 && !c.Bool("force") {
+		return fmt.Errorf("unable to rekey database: %d rows can't be decrypted with the current key, use --force to skip them", unreadable)
+	}
+
+	// send API call to re-encrypt the users
+	users, err := current.RekeyUsers(c.Context, key)
+	if err != nil {
+		return fmt.Errorf("unable to rekey users: %w", err)
+	}
+
+	logrus.Infof("rotated key for %d users", users)
+
+	// send API call to re-encrypt the repos
+	repos, err := current.RekeyRepos(c.Context, key)
+	if err != nil {
+		return fmt.Errorf("unable to rekey repos: %w", err)
+	}
+
+	logrus.Infof("rotated key for %d repos", repos)
+
+	// send API call to re-encrypt the secrets
+	secrets, err := current.RekeySecrets(c.Context, key)
+	if err != nil {
+		return fmt.Errorf("unable to rekey secrets: %w", err)
+	}
+
+	logrus.Infof("rotated key for %d secrets", secrets)
+
+	rotated, err := database.New(_rotated)
+	if err != nil {
+		return err
+	}
+
+	// audit the fields with the new key to verify the rotation
+	audits, err = auditEncryption(c.Context, rotated)
+	if err != nil {
+		return err
+	}
+
+	printAudits(c, audits)
+
+	for _, a := range audits {
+		if a.GetUnreadable() > 0 {
+			logrus.Warnf("%d rows in %s can't be decrypted with the new key", a.GetUnreadable(), a.GetTable())
+		}
+	}
+
+	return nil
+}
+
+// setupRekeyDatabase is a helper function to setup the database
+// without creating tables or applying the schema migrations.
+func setupRekeyDatabase(c *cli.Context) (database.Service, error) {
+	_setup, err := databaseSetup(c)
+	if err != nil {
+		return nil, err
+	}
+
+	_setup.SkipCreation = true
+
+	return database.New(_setup)
+}
+
+// auditEncryption is a helper function to audit the encrypted
+// fields for the users, repos and secrets in the database.
+func auditEncryption(ctx context.Context, db database.Service) ([]*api.EncryptionAudit, error) {
+	users, err := db.AuditUserEncryption(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to audit users: %w", err)
+	}
+
+	repos, err := db.AuditRepoEncryption(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to audit repos: %w", err)
+	}
+
+	secrets, err := db.AuditSecretEncryption(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to audit secrets: %w", err)
+	}
+
+	return []*api.EncryptionAudit{users, repos, secrets}, nil
+}
+
+// printAudits is a helper function to print the audits for the tables.
+func printAudits(c *cli.Context, audits []*api.EncryptionAudit) {
+	for _, a := range audits {
+		fmt.Fprintf(c.App.Writer, "%s\t%s\t%d total\t%d readable\t%d unreadable\t%d sealed\n",
+			a.GetTable(), strings.Join(a.GetColumns(), ","),
+			a.GetTotal(), a.GetReadable(), a.GetUnreadable(), a.GetSealed(),
+		)
+	}
+}
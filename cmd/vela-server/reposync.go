@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/reposync"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the repo syncer from the CLI arguments.
+func setupRepoSync(c *cli.Context, d database.Service, m *types.Metadata, s scm.Service) (*reposync.Syncer, error) {
+	logrus.Debug("Creating repo syncer from CLI configuration")
+
+	// setup the repo syncer
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/reposync?tab=doc#New
+	return reposync.New(
+		reposync.WithDatabase(d),
+		reposync.WithSCM(s),
+		reposync.WithInterval(c.Duration("repo-sync.interval")),
+		reposync.WithWebAddress(m.Vela.WebAddress),
+	)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/retention"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the retention sweeper from the CLI arguments.
+func setupRetention(c *cli.Context, d database.Service) (*retention.Sweeper, error) {
+	logrus.Debug("Creating retention sweeper from CLI configuration")
+
+	// setup the retention sweeper
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/retention?tab=doc#New
+	return retention.New(
+		retention.WithDatabase(d),
+		retention.WithInterval(c.Duration("retention.interval")),
+		retention.WithLimit(c.Int("retention.limit")),
+		retention.WithArchiveWindow(c.Duration("retention.archive-window")),
+	)
+}
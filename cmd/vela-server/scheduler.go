@@ -0,0 +1,34 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/report"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/placement"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scheduler"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the scheduler for the cron schedules of repos from the CLI arguments.
+func setupScheduler(c *cli.Context, comp compiler.Engine, d database.Service, m *types.Metadata, q queue.Service, pl *placement.Placer, rs *report.Store, s scm.Service) (*scheduler.Scheduler, error) {
+	logrus.Debug("Creating scheduler from CLI configuration")
+
+	// setup the scheduler
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scheduler?tab=doc#New
+	return scheduler.New(
+		scheduler.WithDatabase(d),
+		scheduler.WithTrigger(api.ScheduleTrigger(comp, d, m, q, pl, rs, s)),
+		scheduler.WithInterval(c.Duration("schedule.interval")),
+	)
+}
@@ -5,6 +5,9 @@
 package main
 
 import (
+	"fmt"
+
+	"github.com/go-vela/server/fault"
 	"github.com/go-vela/server/scm"
 	"github.com/sirupsen/logrus"
 
@@ -26,10 +29,61 @@ func setupSCM(c *cli.Context) (scm.Service, error) {
 		StatusContext:        c.String("scm.context"),
 		WebUIAddress:         c.String("webui-addr"),
 		Scopes:               c.StringSlice("scm.scopes"),
+		AppID:                c.Int64("scm.app.id"),
+		AppPrivateKey:        c.String("scm.app.private-key"),
+		UseChecks:            c.Bool("scm.checks"),
+		UseComments:          c.Bool("scm.comments"),
+		Retries:              c.Int("scm.retries"),
+		RetryBackoff:         c.Duration("scm.retry.backoff"),
+		RetryMaxWait:         c.Duration("scm.retry.max-wait"),
 	}
 
 	// setup the scm
 	//
 	// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#New
-	return scm.New(_setup)
+	s, err := scm.New(_setup)
+	if err != nil {
+		return nil, err
+	}
+
+	// wrap the scm to inject faults when built for testing
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/fault?tab=doc#SCM
+	s = fault.SCM(s)
+
+	// parse the additional scms hosting repos alongside the scm
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#ParseSources
+	sources, err := scm.ParseSources(c.String("scm.sources"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sources) == 0 {
+		return s, nil
+	}
+
+	// create the registry hosting the scm along with the additional scms
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#NewRegistry
+	registry, err := scm.NewRegistry(s, _setup.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, src := range sources {
+		logrus.Debugf("Creating scm client for %s from CLI configuration", src.Address)
+
+		source, err := scm.New(_setup.ForSource(src))
+		if err != nil {
+			return nil, fmt.Errorf("unable to setup scm for %s: %w", src.Address, err)
+		}
+
+		err = registry.Register(src.Address, fault.SCM(source))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return registry, nil
 }
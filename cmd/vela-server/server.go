@@ -9,8 +9,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
+	"github.com/go-vela/server/compiler/report"
 	"github.com/go-vela/server/router"
 	"github.com/go-vela/server/router/middleware"
 
@@ -63,6 +67,24 @@ func server(c *cli.Context) error {
 		return err
 	}
 
+	// capture the template deprecations marked by template owners
+	compiler.WithTemplateDeprecations(database)
+
+	// capture the deprecation rules registered by admins
+	compiler.WithDeprecationRules(database)
+
+	// capture the registry mirrors images are rewritten to pull from
+	compiler.WithRegistryMirrors(database)
+
+	// capture the egress rules enforced on the steps of builds
+	compiler.WithEgressRules(database)
+
+	// capture the clone settings for submodules and Git LFS
+	compiler.WithCloneSettings(database)
+
+	// capture the variables injected into the builds for repos
+	compiler.WithRepoVariables(database)
+
 	queue, err := setupQueue(c)
 	if err != nil {
 		return err
@@ -83,8 +105,113 @@ func server(c *cli.Context) error {
 		return err
 	}
 
+	detector, err := setupAnomaly(c, database)
+	if err != nil {
+		return err
+	}
+
+	monitor, err := setupBudget(c, database)
+	if err != nil {
+		return err
+	}
+
+	scheduler, err := setupCanary(c, compiler, database, metadata, queue)
+	if err != nil {
+		return err
+	}
+
+	recorder, err := setupCapacity(c, database)
+	if err != nil {
+		return err
+	}
+
+	serviceCatalog, err := setupCatalog(c, database)
+	if err != nil {
+		return err
+	}
+
+	collector, err := setupDiagnostics(c, database, queue)
+	if err != nil {
+		return err
+	}
+
+	exporter, err := setupExport(c, database)
+	if err != nil {
+		return err
+	}
+
+	scanner, err := setupLeak(c, database)
+	if err != nil {
+		return err
+	}
+
+	maintainer, err := setupPartitioner(c, database)
+	if err != nil {
+		return err
+	}
+
+	placer, err := setupPlacement(c, database)
+	if err != nil {
+		return err
+	}
+
+	cleaner, err := setupJanitor(c, compiler, database, metadata, placer, queue, scm)
+	if err != nil {
+		return err
+	}
+
+	sweeper, err := setupRetention(c, database)
+	if err != nil {
+		return err
+	}
+
+	reencrypter, err := setupReencrypt(c, database)
+	if err != nil {
+		return err
+	}
+
+	syncer, err := setupRepoSync(c, database, metadata, scm)
+	if err != nil {
+		return err
+	}
+
+	// create the store retaining the compile reports
+	// for builds created by the api and the scheduler
+	reports := report.New(c.Int("compile-report-limit"))
+
+	cron, err := setupScheduler(c, compiler, database, metadata, queue, placer, reports, scm)
+	if err != nil {
+		return err
+	}
+
+	elector, err := setupLeader(c, database)
+	if err != nil {
+		return err
+	}
+
+	enricher, err := setupEnrich(c)
+	if err != nil {
+		return err
+	}
+
+	buffer, err := setupIntake(c, database)
+	if err != nil {
+		return err
+	}
+
+	tracker, err := setupStaging(database)
+	if err != nil {
+		return err
+	}
+
+	verifier, err := setupVerifier(c, database)
+	if err != nil {
+		return err
+	}
+
 	router := router.Load(
 		middleware.Compiler(compiler),
+		middleware.CompileReports(reports),
 		middleware.Database(database),
 		middleware.Logger(logrus.StandardLogger(), time.RFC3339),
 		middleware.Metadata(metadata),
@@ -100,10 +227,33 @@ func server(c *cli.Context) error {
 		middleware.MaxBuildLimit(c.Int64("max-build-limit")),
 		middleware.WebhookValidation(!c.Bool("vela-disable-webhook-validation")),
 		middleware.SecureCookie(c.Bool("vela-enable-secure-cookie")),
+		middleware.PipelinePreview(c.Bool("pipeline-preview-comments")),
 		middleware.Worker(c.Duration("worker-active-interval")),
 		middleware.DefaultRepoEvents(c.StringSlice("default-repo-events")),
+		middleware.DeployApprovals(c.Int("deploy-required-approvals"), c.String("deploy-required-team")),
+		middleware.OrgTemplateRepo(c.String("org-template-repo")),
+		middleware.Anomaly(detector),
+		middleware.Canary(scheduler),
+		middleware.Catalog(serviceCatalog),
+		middleware.Diagnostics(collector),
+		middleware.Enrich(enricher),
+		middleware.Export(exporter),
+		middleware.Intake(buffer),
+		middleware.Leak(scanner),
+		middleware.Placement(placer),
+		middleware.Retention(sweeper),
+		middleware.Staging(tracker),
+		middleware.Verifier(verifier),
+		middleware.CorsOrigins(c.StringSlice("cors-allowed-origins")),
+		middleware.ContentSecurityPolicy(c.String("content-security-policy")),
+		middleware.Metrics(c.String("metrics-token"), len(c.String("metrics-client-ca")) > 0),
 	)
 
+	tlsConfig, err := setupTLS(c)
+	if err != nil {
+		return err
+	}
+
 	addr, err := url.Parse(c.String("server-addr"))
 	if err != nil {
 		return err
@@ -124,12 +274,22 @@ func server(c *cli.Context) error {
 			Addr:              fmt.Sprintf(":%s", port),
 			Handler:           router,
 			ReadHeaderTimeout: 60 * time.Second,
+			TLSConfig:         tlsConfig,
 		}
 
 		logrus.Infof("running server on %s", addr.Host)
 		go func() {
 			logrus.Info("Starting HTTP server...")
-			err := srv.ListenAndServe()
+
+			var err error
+
+			// serve the API over TLS when a certificate is provided
+			if tlsConfig != nil {
+				err = srv.ListenAndServeTLS(c.String("server-tls-cert"), c.String("server-tls-key"))
+			} else {
+				err = srv.ListenAndServe()
+			}
+
 			if err != nil {
 				tomb.Kill(err)
 			}
@@ -140,11 +300,150 @@ func server(c *cli.Context) error {
 			select {
 			case <-tomb.Dying():
 				logrus.Info("Stopping HTTP server...")
-				return srv.Shutdown(context.Background())
+
+				// wait for the in-flight requests to complete up to the shutdown timeout
+				ctx, cancel := context.WithTimeout(context.Background(), c.Duration("shutdown-timeout"))
+				defer cancel()
+
+				err := srv.Shutdown(ctx)
+				if err != nil {
+					logrus.Errorf("unable to gracefully stop HTTP server: %v", err)
+				}
+
+				// stage the webhooks that are still being processed
+				return tracker.Persist()
 			}
 		}
 	})
 
+	// stop the server when a termination signal is received
+	tomb.Go(func() error {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(signals)
+
+		select {
+		case sig := <-signals:
+			logrus.Infof("received %s signal, shutting down server", sig)
+
+			tomb.Kill(nil)
+		case <-tomb.Dying():
+		}
+
+		return nil
+	})
+
+	// resume the webhooks staged when the server last shut down
+	tomb.Go(func() error {
+		err := tracker.Resume(router)
+		if err != nil {
+			logrus.Errorf("unable to resume staged webhooks: %v", err)
+		}
+
+		return nil
+	})
+
+	// start webhook intake workers
+	//
+	// the workers run on every replica since each
+	// webhook is claimed before it is processed
+	if buffer.Enabled() {
+		tomb.Go(func() error {
+			return buffer.Start(router, tomb.Dying())
+		})
+	}
+
+	// start anomaly detector
+	if detector.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("anomaly", detector, tomb.Dying())
+		})
+	}
+
+	// start build budget alerts
+	if monitor.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("budget", monitor, tomb.Dying())
+		})
+	}
+
+	// start canary scheduler
+	if scheduler.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("canary", scheduler, tomb.Dying())
+		})
+	}
+
+	// start capacity recorder
+	if recorder.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("capacity", recorder, tomb.Dying())
+		})
+	}
+
+	// start service catalog push
+	if serviceCatalog.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("catalog", serviceCatalog, tomb.Dying())
+		})
+	}
+
+	// start build result exports
+	if exporter.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("export", exporter, tomb.Dying())
+		})
+	}
+
+	// start dangling build cleanup
+	if cleaner.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("janitor", cleaner, tomb.Dying())
+		})
+	}
+
+	// start scans of build logs for leaked credentials
+	if scanner.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("leak", scanner, tomb.Dying())
+		})
+	}
+
+	// start monthly partition maintenance
+	if maintainer.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("partitioner", maintainer, tomb.Dying())
+		})
+	}
+
+	// start retention policy sweeps
+	if sweeper.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("retention", sweeper, tomb.Dying())
+		})
+	}
+
+	// start repo sync with the scm
+	if syncer.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("reposync", syncer, tomb.Dying())
+		})
+	}
+
+	// start builds for the cron schedules of repos
+	if cron.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("scheduler", cron, tomb.Dying())
+		})
+	}
+
+	// start user token and repo hash re-encryption
+	if reencrypter.Enabled() {
+		tomb.Go(func() error {
+			return elector.Run("reencrypt", reencrypter, tomb.Dying())
+		})
+	}
+
 	// Wait for stuff and watch for errors
 	err = tomb.Wait()
 	if err != nil {
@@ -0,0 +1,23 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/staging"
+	"github.com/sirupsen/logrus"
+)
+
+// helper function to setup the tracker for in-flight webhooks.
+func setupStaging(d database.Service) (*staging.Tracker, error) {
+	logrus.Debug("Creating webhook staging tracker")
+
+	// setup the webhook staging tracker
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/staging?tab=doc#New
+	return staging.New(
+		staging.WithDatabase(d),
+	)
+}
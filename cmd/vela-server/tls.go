@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the TLS configuration for the
+// server from the CLI arguments. It returns nil when the
+// server should not serve the API over TLS.
+func setupTLS(c *cli.Context) (*tls.Config, error) {
+	if len(c.String("server-tls-cert")) == 0 || len(c.String("server-tls-key")) == 0 {
+		// the client certificates for the metrics endpoint can
+		// only be verified when the server serves the API over TLS
+		if len(c.String("metrics-client-ca")) > 0 {
+			return nil, fmt.Errorf("metrics-client-ca flag requires the server-tls-cert and server-tls-key flags")
+		}
+
+		return nil, nil
+	}
+
+	logrus.Debug("Creating TLS configuration from CLI configuration")
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	// skip requesting client certificates when
+	// they aren't used to authenticate requests
+	if len(c.String("metrics-client-ca")) == 0 {
+		return config, nil
+	}
+
+	bundle, err := os.ReadFile(c.String("metrics-client-ca"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read metrics client CA bundle: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("unable to parse metrics client CA bundle %s", c.String("metrics-client-ca"))
+	}
+
+	// verify the client certificate when one is provided, so only
+	// the endpoints that require a certificate reject the request
+	config.ClientCAs = pool
+	config.ClientAuth = tls.VerifyClientCertIfGiven
+
+	return config, nil
+}
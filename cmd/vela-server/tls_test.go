@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+func TestMain_setupTLS(t *testing.T) {
+	// setup types
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Errorf("unable to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "vela"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Errorf("unable to create certificate: %v", err)
+	}
+
+	err = os.WriteFile(ca, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0600)
+	if err != nil {
+		t.Errorf("unable to write CA bundle: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		cert    string
+		key     string
+		ca      string
+		tls     bool
+		auth    tls.ClientAuthType
+	}{
+		{
+			failure: false,
+			name:    "without tls",
+			tls:     false,
+		},
+		{
+			failure: false,
+			name:    "with tls",
+			cert:    "server.crt",
+			key:     "server.key",
+			tls:     true,
+			auth:    tls.NoClientCert,
+		},
+		{
+			failure: false,
+			name:    "with tls and metrics client ca",
+			cert:    "server.crt",
+			key:     "server.key",
+			ca:      ca,
+			tls:     true,
+			auth:    tls.VerifyClientCertIfGiven,
+		},
+		{
+			failure: true,
+			name:    "metrics client ca without tls",
+			ca:      ca,
+		},
+		{
+			failure: true,
+			name:    "metrics client ca without tls key",
+			cert:    "server.crt",
+			ca:      ca,
+		},
+		{
+			failure: true,
+			name:    "missing metrics client ca",
+			cert:    "server.crt",
+			key:     "server.key",
+			ca:      filepath.Join(t.TempDir(), "missing.pem"),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", 0)
+			set.String("server-tls-cert", test.cert, "doc")
+			set.String("server-tls-key", test.key, "doc")
+			set.String("metrics-client-ca", test.ca, "doc")
+
+			got, err := setupTLS(cli.NewContext(nil, set, nil))
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("setupTLS for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("setupTLS for %s returned err: %v", test.name, err)
+			}
+
+			if (got != nil) != test.tls {
+				t.Errorf("setupTLS for %s is %v, want TLS %v", test.name, got, test.tls)
+			}
+
+			if got != nil && got.ClientAuth != test.auth {
+				t.Errorf("setupTLS for %s client auth is %v, want %v", test.name, got.ClientAuth, test.auth)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ func setupTokenManager(c *cli.Context) *token.Manager {
 		UserAccessTokenDuration:     c.Duration("user-access-token-duration"),
 		UserRefreshTokenDuration:    c.Duration("user-refresh-token-duration"),
 		BuildTokenBufferDuration:    c.Duration("build-token-buffer-duration"),
+		RepoTriggerTokenDuration:    c.Duration("repo-trigger-token-duration"),
 		WorkerAuthTokenDuration:     c.Duration("worker-auth-token-duration"),
 		WorkerRegisterTokenDuration: c.Duration("worker-register-token-duration"),
 	}
@@ -72,6 +72,24 @@ func validateCore(c *cli.Context) error {
 		}
 	}
 
+	for _, origin := range c.StringSlice("cors-allowed-origins") {
+		if !strings.Contains(origin, "://") {
+			return fmt.Errorf("cors-allowed-origins (VELA_CORS_ALLOWED_ORIGINS) flag must be <scheme>://<hostname> format: %s", origin)
+		}
+
+		if strings.HasSuffix(origin, "/") {
+			return fmt.Errorf("cors-allowed-origins (VELA_CORS_ALLOWED_ORIGINS) flag must not have trailing slash: %s", origin)
+		}
+	}
+
+	if (len(c.String("server-tls-cert")) == 0) != (len(c.String("server-tls-key")) == 0) {
+		return fmt.Errorf("server-tls-cert (VELA_SERVER_TLS_CERT) and server-tls-key (VELA_SERVER_TLS_KEY) flags must be provided together")
+	}
+
+	if len(c.String("metrics-client-ca")) > 0 && (len(c.String("server-tls-cert")) == 0 || len(c.String("server-tls-key")) == 0) {
+		return fmt.Errorf("metrics-client-ca (VELA_METRICS_CLIENT_CA) flag requires the server-tls-cert (VELA_SERVER_TLS_CERT) and server-tls-key (VELA_SERVER_TLS_KEY) flags")
+	}
+
 	if c.Duration("user-refresh-token-duration").Seconds() <= c.Duration("user-access-token-duration").Seconds() {
 		return fmt.Errorf("user-refresh-token-duration (VELA_USER_REFRESH_TOKEN_DURATION) must be larger than the user-access-token-duration (VELA_USER_ACCESS_TOKEN_DURATION)")
 	}
@@ -0,0 +1,26 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package main
+
+import (
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scm/verify"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// helper function to setup the verifier for webhook deliveries.
+func setupVerifier(c *cli.Context, d database.Service) (*verify.Verifier, error) {
+	logrus.Debug("Creating webhook delivery verifier")
+
+	// setup the webhook delivery verifier
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/verify?tab=doc#New
+	return verify.New(
+		verify.WithDatabase(d),
+		verify.WithMaxAge(c.Duration("webhook-max-age")),
+		verify.WithRetention(c.Duration("webhook-delivery-retention")),
+	)
+}
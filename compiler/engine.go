@@ -5,6 +5,10 @@
 package compiler
 
 import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/pipeline"
@@ -27,10 +31,18 @@ type Engine interface {
 	// Parse internally to convert the object to a yaml configuration.
 	CompileLite(interface{}, bool, bool, []string) (*yaml.Build, *library.Pipeline, error)
 
+	// Deprecated defines a function that returns the deprecated images,
+	// templates and syntax used by the pipeline for the most recent Compile.
+	Deprecated() []*api.Deprecation
+
 	// Duplicate defines a function that
 	// creates a clone of the Engine.
 	Duplicate() Engine
 
+	// Images defines a function that returns the images
+	// pinned to digests by the most recent Compile.
+	Images() []string
+
 	// Parse defines a function that converts
 	// an object to a yaml configuration.
 	Parse(interface{}, string, *yaml.Template) (*yaml.Build, []byte, error)
@@ -39,6 +51,14 @@ type Engine interface {
 	// an object to a string.
 	ParseRaw(interface{}) (string, error)
 
+	// Report defines a function that returns the
+	// measurements captured by the most recent Compile.
+	Report() *api.CompileReport
+
+	// Skipped defines a function that returns the stages and
+	// steps skipped by the most recent Compile and why.
+	Skipped() []*api.StepSkip
+
 	// Validate defines a function that verifies
 	// the yaml configuration is accurate.
 	Validate(*yaml.Build) error
@@ -67,6 +87,15 @@ type Engine interface {
 	// variables for each service into a yaml configuration.
 	EnvironmentServices(yaml.ServiceSlice, raw.StringSliceMap) (yaml.ServiceSlice, error)
 
+	// Condition Compiler Interface Functions
+
+	// ConditionStages defines a function that removes the stages
+	// and steps whose conditional expressions do not hold.
+	ConditionStages(yaml.StageSlice, *pipeline.RuleData) (yaml.StageSlice, error)
+	// ConditionSteps defines a function that removes the
+	// steps whose conditional expressions do not hold.
+	ConditionSteps(yaml.StepSlice, *pipeline.RuleData) (yaml.StepSlice, error)
+
 	// Expand Compiler Interface Functions
 
 	// ExpandStages defines a function that injects the template
@@ -118,9 +147,24 @@ type Engine interface {
 	// WithBuild defines a function that sets
 	// the library build type in the Engine.
 	WithBuild(*library.Build) Engine
+	// WithBuildContext defines a function that sets
+	// the computed build context in the Engine.
+	WithBuildContext(*api.BuildContext) Engine
+	// WithCloneSettings defines a function that sets
+	// the service for capturing clone settings in the Engine.
+	WithCloneSettings(CloneSettingService) Engine
 	// WithComment defines a function that sets
 	// the comment in the Engine.
 	WithComment(string) Engine
+	// WithContext defines a function that sets
+	// the context for the requests made by the Engine.
+	WithContext(context.Context) Engine
+	// WithDeprecationRules defines a function that sets
+	// the service for capturing deprecation rules in the Engine.
+	WithDeprecationRules(DeprecationRuleService) Engine
+	// WithEgressRules defines a function that sets
+	// the service for capturing egress rules in the Engine.
+	WithEgressRules(EgressRuleService) Engine
 	// WithFiles defines a function that sets
 	// the changeset files in the Engine.
 	WithFiles([]string) Engine
@@ -130,9 +174,18 @@ type Engine interface {
 	// WithMetadata defines a function that sets
 	// the compiler Metadata type in the Engine.
 	WithMetadata(*types.Metadata) Engine
+	// WithRegistryMirrors defines a function that sets
+	// the service for capturing registry mirrors in the Engine.
+	WithRegistryMirrors(RegistryMirrorService) Engine
 	// WithRepo defines a function that sets
 	// the library repo type in the Engine.
 	WithRepo(*library.Repo) Engine
+	// WithRepoVariables defines a function that sets
+	// the service for capturing repo variables in the Engine.
+	WithRepoVariables(RepoVariableService) Engine
+	// WithTemplateDeprecations defines a function that sets
+	// the service for capturing template deprecations in the Engine.
+	WithTemplateDeprecations(TemplateDeprecationService) Engine
 	// WithUser defines a function that sets
 	// the library user type in the Engine.
 	WithUser(*library.User) Engine
@@ -140,3 +193,55 @@ type Engine interface {
 	// the private github client in the Engine.
 	WithPrivateGitHub(string, string) Engine
 }
+
+// TemplateDeprecationService represents an interface for capturing
+// the versions of a template that its owners have marked deprecated.
+type TemplateDeprecationService interface {
+	// ListTemplateDeprecationsForRepo defines a function that gets
+	// a list of template deprecations by org and repo name.
+	ListTemplateDeprecationsForRepo(context.Context, string, string) ([]*api.TemplateDeprecation, error)
+}
+
+// DeprecationRuleService represents an interface for capturing the rules
+// for warning about the deprecated images, templates and syntax in a pipeline.
+type DeprecationRuleService interface {
+	// ListDeprecationRules defines a function that
+	// gets a list of all deprecation rules.
+	ListDeprecationRules(context.Context) ([]*api.DeprecationRule, error)
+}
+
+// RegistryMirrorService represents an interface for capturing the
+// rules for rewriting images to pull from a registry mirror.
+type RegistryMirrorService interface {
+	// ListRegistryMirrors defines a function that
+	// gets a list of all registry mirrors.
+	ListRegistryMirrors(context.Context) ([]*api.RegistryMirror, error)
+}
+
+// EgressRuleService represents an interface for capturing the rules
+// of the policy for outbound network access from the steps of a build.
+type EgressRuleService interface {
+	// ListEgressRulesForOrg defines a function that
+	// gets a list of egress rules for an org.
+	ListEgressRulesForOrg(context.Context, string) ([]*api.EgressRule, error)
+}
+
+// CloneSettingService represents an interface for capturing the settings
+// for repos and the defaults for orgs controlling how the clone step
+// fetches a repo.
+type CloneSettingService interface {
+	// GetCloneSettingForOrg defines a function that
+	// gets the default clone setting for an org.
+	GetCloneSettingForOrg(context.Context, string) (*api.CloneSetting, error)
+	// GetCloneSettingForRepo defines a function that
+	// gets the clone setting for a repo.
+	GetCloneSettingForRepo(context.Context, *library.Repo) (*api.CloneSetting, error)
+}
+
+// RepoVariableService represents an interface for capturing the
+// environment variables injected into the builds for a repo.
+type RepoVariableService interface {
+	// ListRepoVariablesForRepo defines a function that
+	// gets a list of repo variables for a repo.
+	ListRepoVariablesForRepo(context.Context, *library.Repo) ([]*api.RepoVariable, error)
+}
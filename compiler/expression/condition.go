@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/buildkite/yaml"
+)
+
+// Condition represents an expression declared on a stage or step.
+//
+// swagger:model ExpressionCondition
+type Condition struct {
+	Stage      string `json:"stage,omitempty"`
+	Step       string `json:"step,omitempty"`
+	Expression string `json:"expression"`
+}
+
+type (
+	// config represents the subset of a pipeline
+	// configuration that can declare expressions.
+	config struct {
+		Stages yaml.MapSlice `yaml:"stages"`
+		Steps  []*container  `yaml:"steps"`
+	}
+
+	// stage represents the subset of a stage
+	// that can declare expressions.
+	stage struct {
+		Ruleset ruleset      `yaml:"ruleset"`
+		Steps   []*container `yaml:"steps"`
+	}
+
+	// container represents the subset of a step
+	// that can declare expressions.
+	container struct {
+		Name    string  `yaml:"name"`
+		Ruleset ruleset `yaml:"ruleset"`
+	}
+
+	// ruleset represents the expression and label keys within a ruleset.
+	ruleset struct {
+		Expr   string     `yaml:"expr"`
+		Label  labelSlice `yaml:"label"`
+		If     rules      `yaml:"if"`
+		Unless rules      `yaml:"unless"`
+	}
+
+	// rules represents the label key within the if
+	// and unless sections of a ruleset.
+	rules struct {
+		Label labelSlice `yaml:"label"`
+	}
+
+	// labelSlice represents the labels for a rule
+	// which can be provided as a string or a list.
+	labelSlice []string
+)
+
+// UnmarshalYAML implements the Unmarshaler interface for the labelSlice type.
+func (l *labelSlice) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// attempt to unmarshal as a single label
+	single := ""
+
+	if err := unmarshal(&single); err == nil {
+		*l = []string{single}
+
+		return nil
+	}
+
+	// attempt to unmarshal as a list of labels
+	list := []string{}
+
+	err := unmarshal(&list)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal label rule: %w", err)
+	}
+
+	*l = list
+
+	return nil
+}
+
+// expression returns the expression for the ruleset combining the
+// declared expression with the label rules. An empty string is
+// returned when the ruleset declares neither.
+func (r ruleset) expression() string {
+	parts := []string{}
+
+	if len(r.Expr) > 0 {
+		parts = append(parts, r.Expr)
+	}
+
+	if labels := append(append([]string{}, r.Label...), r.If.Label...); len(labels) > 0 {
+		parts = append(parts, anyLabel(labels))
+	}
+
+	if len(r.Unless.Label) > 0 {
+		parts = append(parts, "!"+anyLabel(r.Unless.Label))
+	}
+
+	// avoid wrapping a single expression so it is reported as written
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	for i, part := range parts {
+		if !strings.HasPrefix(part, "!") {
+			parts[i] = "(" + part + ")"
+		}
+	}
+
+	return strings.Join(parts, " && ")
+}
+
+// anyLabel returns an expression that holds when
+// any of the provided labels is applied.
+func anyLabel(labels []string) string {
+	checks := make([]string, 0, len(labels))
+
+	for _, label := range labels {
+		// escape the label for use within a quoted string
+		quoted := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(label)
+
+		checks = append(checks, fmt.Sprintf(`contains(labels, "%s")`, quoted))
+	}
+
+	return "(" + strings.Join(checks, " || ") + ")"
+}
+
+// Conditions returns the expressions declared on the stages and steps
+// of the provided pipeline configuration. Each expression is parsed
+// to ensure invalid expressions are reported before evaluation.
+func Conditions(data []byte) ([]*Condition, error) {
+	cfg := new(config)
+
+	err := yaml.Unmarshal(data, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal yaml: %w", err)
+	}
+
+	conditions := []*Condition{}
+
+	for _, item := range cfg.Stages {
+		name := fmt.Sprint(item.Key)
+
+		// marshal the stage back to yaml to capture the expressions
+		out, err := yaml.Marshal(item.Value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal stage %s: %w", name, err)
+		}
+
+		s := new(stage)
+
+		err = yaml.Unmarshal(out, s)
+		if err != nil {
+			return nil, fmt.Errorf("unable to unmarshal stage %s: %w", name, err)
+		}
+
+		if expr := s.Ruleset.expression(); len(expr) > 0 {
+			conditions = append(conditions, &Condition{Stage: name, Expression: expr})
+		}
+
+		for _, step := range s.Steps {
+			if expr := step.Ruleset.expression(); len(expr) > 0 {
+				conditions = append(conditions, &Condition{Stage: name, Step: step.Name, Expression: expr})
+			}
+		}
+	}
+
+	for _, step := range cfg.Steps {
+		if expr := step.Ruleset.expression(); len(expr) > 0 {
+			conditions = append(conditions, &Condition{Step: step.Name, Expression: expr})
+		}
+	}
+
+	// validate each of the expressions
+	for _, c := range conditions {
+		_, err := Parse(c.Expression)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return conditions, nil
+}
+
+// Explanation represents the result of evaluating a Condition.
+//
+// swagger:model ExpressionExplanation
+type Explanation struct {
+	Stage      string `json:"stage,omitempty"`
+	Step       string `json:"step,omitempty"`
+	Expression string `json:"expression"`
+	Result     bool   `json:"result"`
+	Trace      *Trace `json:"trace,omitempty"`
+}
+
+// ExplainAll evaluates each of the provided conditions against
+// the provided data and returns an explanation of each result.
+func ExplainAll(conditions []*Condition, d *Data) ([]*Explanation, error) {
+	explanations := make([]*Explanation, 0, len(conditions))
+
+	for _, c := range conditions {
+		e, err := Parse(c.Expression)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := e.Explain(d)
+		if err != nil {
+			return nil, err
+		}
+
+		explanations = append(explanations, &Explanation{
+			Stage:      c.Stage,
+			Step:       c.Step,
+			Expression: c.Expression,
+			Result:     truthy(t.Value),
+			Trace:      t,
+		})
+	}
+
+	return explanations, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpression_Conditions(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		data    string
+		want    []*Condition
+		wantErr string
+	}{
+		{
+			name: "steps",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: alpine
+  - name: publish
+    image: alpine
+    ruleset:
+      event: push
+      expr: branch == "main"
+`,
+			want: []*Condition{{Step: "publish", Expression: `branch == "main"`}},
+		},
+		{
+			name: "stages",
+			data: `
+version: "1"
+stages:
+  test:
+    steps:
+      - name: test
+        image: alpine
+        ruleset:
+          expr: anyPath("src/*")
+  publish:
+    ruleset:
+      expr: event == "tag"
+    steps:
+      - name: publish
+        image: alpine
+`,
+			want: []*Condition{
+				{Stage: "test", Step: "test", Expression: `anyPath("src/*")`},
+				{Stage: "publish", Expression: `event == "tag"`},
+			},
+		},
+		{
+			name: "labels",
+			data: `
+version: "1"
+steps:
+  - name: preview
+    image: alpine
+    ruleset:
+      event: pull_request:labeled
+      label: deploy-preview
+  - name: deploy
+    image: alpine
+    ruleset:
+      if:
+        label: [ deploy, "ship \"it\"" ]
+      unless:
+        label: hold
+      expr: approvals > 0
+`,
+			want: []*Condition{
+				{Step: "preview", Expression: `(contains(labels, "deploy-preview"))`},
+				{Step: "deploy", Expression: `(approvals > 0) && ((contains(labels, "deploy") || contains(labels, "ship \"it\""))) && !(contains(labels, "hold"))`},
+			},
+		},
+		{
+			name: "invalid expression",
+			data: `
+version: "1"
+steps:
+  - name: publish
+    image: alpine
+    ruleset:
+      expr: branch = "main"
+`,
+			wantErr: "unexpected character '=' at position 7",
+		},
+		{
+			name:    "invalid yaml",
+			data:    "steps: [",
+			wantErr: "unable to unmarshal yaml",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Conditions([]byte(test.data))
+
+			if len(test.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("Conditions error is %v, want %s", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Conditions returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Conditions is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExpression_ExplainAll(t *testing.T) {
+	// setup types
+	conditions := []*Condition{
+		{Step: "publish", Expression: `branch == "main"`},
+		{Stage: "deploy", Expression: `in(target, "staging", "production")`},
+	}
+
+	// run test
+	got, err := ExplainAll(conditions, &Data{Branch: "main"})
+	if err != nil {
+		t.Errorf("ExplainAll returned err: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("ExplainAll returned %d explanations, want 2", len(got))
+	}
+
+	if !got[0].Result || got[0].Step != "publish" || got[0].Trace == nil {
+		t.Errorf("ExplainAll is %+v, want passing result for step publish", got[0])
+	}
+
+	if got[1].Result || got[1].Stage != "deploy" {
+		t.Errorf("ExplainAll is %+v, want failing result for stage deploy", got[1])
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package expression provides the ability for Vela to evaluate
+// conditional expressions declared on stages and steps.
+//
+// An expression is declared under the ruleset for
+// a stage or a step with the expr key:
+//
+//	steps:
+//	  - name: publish
+//	    image: alpine
+//	    ruleset:
+//	      expr: >
+//	        event == "push" && (branch == "main" || startsWith(branch, "release/"))
+//	        && anyPath("src/*") && env.DEPLOY != "false"
+//
+// Expressions support the &&, || and ! boolean operators, the
+// == and != comparison operators, the <, <=, > and >= operators
+// for integers, the =~ and !~ regular expression operators and
+// the functions documented on Functions.
+//
+// The branch, comment, event, repo, tag and target fields along
+// with the paths, labels, reviewers, approvals and issues fields
+// computed from the build context can be referenced by name.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/compiler/expression"
+package expression
@@ -0,0 +1,279 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// fieldEnv represents the field for referencing
+// environment variables with env.<name>.
+const fieldEnv = "env"
+
+// Data represents the build information an expression is evaluated against.
+//
+// swagger:model ExpressionData
+type Data struct {
+	Branch    string            `json:"branch,omitempty"`
+	Comment   string            `json:"comment,omitempty"`
+	Event     string            `json:"event,omitempty"`
+	Repo      string            `json:"repo,omitempty"`
+	Tag       string            `json:"tag,omitempty"`
+	Target    string            `json:"target,omitempty"`
+	Paths     []string          `json:"paths,omitempty"`
+	Labels    []string          `json:"labels,omitempty"`
+	Reviewers []string          `json:"reviewers,omitempty"`
+	Approvals int               `json:"approvals,omitempty"`
+	Issues    []string          `json:"issues,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Trace represents the value produced by a single
+// element of an expression while it was evaluated.
+//
+// swagger:model ExpressionTrace
+type Trace struct {
+	Expression string      `json:"expression"`
+	Value      interface{} `json:"value"`
+	Children   []*Trace    `json:"children,omitempty"`
+}
+
+// fields represents the fields of the data that can be referenced.
+var fields = map[string]func(d *Data) interface{}{
+	"branch":  func(d *Data) interface{} { return d.Branch },
+	"comment": func(d *Data) interface{} { return d.Comment },
+	"event":   func(d *Data) interface{} { return d.Event },
+	"repo":    func(d *Data) interface{} { return d.Repo },
+	"tag":     func(d *Data) interface{} { return d.Tag },
+	"target":  func(d *Data) interface{} { return d.Target },
+	"paths":   func(d *Data) interface{} { return d.Paths },
+	// fields computed from the build context
+	"labels":    func(d *Data) interface{} { return d.Labels },
+	"reviewers": func(d *Data) interface{} { return d.Reviewers },
+	"approvals": func(d *Data) interface{} { return d.Approvals },
+	"issues":    func(d *Data) interface{} { return d.Issues },
+}
+
+// Evaluate returns whether the Expression holds for the provided data.
+func (e *Expression) Evaluate(d *Data) (bool, error) {
+	t, err := e.Explain(d)
+	if err != nil {
+		return false, err
+	}
+
+	return truthy(t.Value), nil
+}
+
+// Explain evaluates the Expression against the provided data and
+// returns the value produced by each element of the Expression.
+func (e *Expression) Explain(d *Data) (*Trace, error) {
+	if d == nil {
+		d = new(Data)
+	}
+
+	t, err := e.root.eval(d)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate expression %q: %w", e.source, err)
+	}
+
+	return t, nil
+}
+
+// eval evaluates the binary operation against the provided data.
+func (n *binaryNode) eval(d *Data) (*Trace, error) {
+	left, err := n.left.eval(d)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Trace{Expression: n.String(), Children: []*Trace{left}}
+
+	// short circuit the boolean operators
+	switch {
+	case n.op == "&&" && !truthy(left.Value):
+		t.Value = false
+
+		return t, nil
+	case n.op == "||" && truthy(left.Value):
+		t.Value = true
+
+		return t, nil
+	}
+
+	right, err := n.right.eval(d)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Children = append(t.Children, right)
+
+	switch n.op {
+	case "&&", "||":
+		t.Value = truthy(right.Value)
+	case "==", "!=":
+		equal, err := equals(left.Value, right.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", n, err)
+		}
+
+		t.Value = equal == (n.op == "==")
+	case "=~", "!~":
+		match, err := matches(left.Value, right.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", n, err)
+		}
+
+		t.Value = match == (n.op == "=~")
+	case "<", "<=", ">", ">=":
+		result, err := order(n.op, left.Value, right.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", n, err)
+		}
+
+		t.Value = result
+	}
+
+	return t, nil
+}
+
+// eval evaluates the negation against the provided data.
+func (n *notNode) eval(d *Data) (*Trace, error) {
+	operand, err := n.operand.eval(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Trace{Expression: n.String(), Value: !truthy(operand.Value), Children: []*Trace{operand}}, nil
+}
+
+// eval returns the value of the literal.
+func (n *literalNode) eval(d *Data) (*Trace, error) {
+	return &Trace{Expression: n.String(), Value: n.value}, nil
+}
+
+// eval returns the value of the referenced field from the provided data.
+func (n *refNode) eval(d *Data) (*Trace, error) {
+	if n.path[0] == fieldEnv {
+		return &Trace{Expression: n.String(), Value: d.Env[n.path[1]]}, nil
+	}
+
+	return &Trace{Expression: n.String(), Value: fields[n.path[0]](d)}, nil
+}
+
+// eval evaluates the function call against the provided data.
+func (n *callNode) eval(d *Data) (*Trace, error) {
+	t := &Trace{Expression: n.String()}
+
+	args := make([]interface{}, 0, len(n.args))
+
+	for _, arg := range n.args {
+		a, err := arg.eval(d)
+		if err != nil {
+			return nil, err
+		}
+
+		t.Children = append(t.Children, a)
+		args = append(args, a.Value)
+	}
+
+	value, err := Functions[n.name].call(d, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", n, err)
+	}
+
+	t.Value = value
+
+	return t, nil
+}
+
+// truthy returns whether the provided value is considered true.
+func truthy(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case string:
+		return len(v) > 0
+	case int:
+		return v != 0
+	case []string:
+		return len(v) > 0
+	default:
+		return false
+	}
+}
+
+// equals returns whether the provided values are equal.
+func equals(left, right interface{}) (bool, error) {
+	switch l := left.(type) {
+	case string:
+		if r, ok := right.(string); ok {
+			return l == r, nil
+		}
+	case int:
+		if r, ok := right.(int); ok {
+			return l == r, nil
+		}
+	case bool:
+		if r, ok := right.(bool); ok {
+			return l == r, nil
+		}
+	}
+
+	return false, fmt.Errorf("unable to compare %T to %T", left, right)
+}
+
+// order returns the result of the provided ordering
+// operator applied to the provided integers.
+func order(op string, left, right interface{}) (bool, error) {
+	l, lok := left.(int)
+	r, rok := right.(int)
+
+	if !lok || !rok {
+		return false, fmt.Errorf("unable to order %T and %T", left, right)
+	}
+
+	switch op {
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	default:
+		return l >= r, nil
+	}
+}
+
+// matches returns whether the provided value matches
+// the provided regular expression.
+func matches(value, pattern interface{}) (bool, error) {
+	s, ok := value.(string)
+	if !ok {
+		return false, fmt.Errorf("unable to match %T against a regular expression", value)
+	}
+
+	re, err := compile(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	return re.MatchString(s), nil
+}
+
+// compile converts the provided value into a regular expression.
+func compile(pattern interface{}) (*regexp.Regexp, error) {
+	s, ok := pattern.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid regular expression of type %T", pattern)
+	}
+
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q: %w", s, err)
+	}
+
+	return re, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpression_Evaluate(t *testing.T) {
+	// setup types
+	d := &Data{
+		Branch:    "release/v1",
+		Event:     "push",
+		Repo:      "github/octocat",
+		Paths:     []string{"src/main.go", "src/util.go"},
+		Labels:    []string{"deploy-preview"},
+		Approvals: 2,
+		Env:       map[string]string{"DEPLOY": "true", "PATTERN": "("},
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		source  string
+		want    bool
+		wantErr string
+	}{
+		{name: "equal", source: `event == "push"`, want: true},
+		{name: "not equal", source: `event != "push"`, want: false},
+		{name: "regexp", source: `branch =~ "^release/v[0-9]+$"`, want: true},
+		{name: "not regexp", source: `repo !~ "^github/"`, want: false},
+		{name: "and", source: `event == "push" && branch == "main"`, want: false},
+		{name: "or", source: `branch == "main" || startsWith(branch, "release/")`, want: true},
+		{name: "not", source: `!(tag == "")`, want: false},
+		{name: "empty field", source: `tag`, want: false},
+		{name: "env", source: `env.DEPLOY == "true" && !env.MISSING`, want: true},
+		{name: "contains string", source: `contains(repo, "octo")`, want: true},
+		{name: "contains list", source: `contains(paths, "src/main.go")`, want: true},
+		{name: "endsWith", source: `endsWith(branch, "v1")`, want: true},
+		{name: "matches", source: `matches(branch, "^main$")`, want: false},
+		{name: "glob", source: `glob(branch, "release/*")`, want: true},
+		{name: "in", source: `in(event, "pull_request", "push")`, want: true},
+		{name: "anyPath", source: `anyPath("docs/*", "src/*.go")`, want: true},
+		{name: "allPaths", source: `allPaths("src/*.go")`, want: true},
+		{name: "not allPaths", source: `allPaths("src/main.go")`, want: false},
+		{name: "booleans", source: `true != false`, want: true},
+		{name: "labels", source: `contains(labels, "deploy-preview") && !reviewers`, want: true},
+		{name: "approvals", source: `approvals >= 2 && approvals < 3 && approvals != 0`, want: true},
+		{name: "issues", source: `issues || approvals > 2`, want: false},
+		{name: "invalid ordering", source: `branch > 1`, wantErr: "unable to order string and int"},
+		{name: "invalid comparison", source: `paths == "src"`, wantErr: "unable to compare []string to string"},
+		{name: "invalid argument", source: `startsWith(paths, "src")`, wantErr: "expected string argument but got []string"},
+		{name: "invalid dynamic regexp", source: `branch =~ env.PATTERN`, wantErr: `invalid regular expression "("`},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e, err := Parse(test.source)
+			if err != nil {
+				t.Fatalf("Parse returned err: %v", err)
+			}
+
+			got, err := e.Evaluate(d)
+
+			if len(test.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("Evaluate error is %v, want %s", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Evaluate returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Evaluate is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestExpression_Explain(t *testing.T) {
+	// setup types
+	e, err := Parse(`branch == "main" && event == "push" || tag != ""`)
+	if err != nil {
+		t.Fatalf("Parse returned err: %v", err)
+	}
+
+	want := &Trace{
+		Expression: `(((branch == "main") && (event == "push")) || (tag != ""))`,
+		Value:      true,
+		Children: []*Trace{
+			{
+				Expression: `((branch == "main") && (event == "push"))`,
+				Value:      false,
+				Children: []*Trace{
+					{
+						Expression: `(branch == "main")`,
+						Value:      false,
+						Children: []*Trace{
+							{Expression: "branch", Value: "dev"},
+							{Expression: `"main"`, Value: "main"},
+						},
+					},
+				},
+			},
+			{
+				Expression: `(tag != "")`,
+				Value:      true,
+				Children: []*Trace{
+					{Expression: "tag", Value: "v1.0.0"},
+					{Expression: `""`, Value: ""},
+				},
+			},
+		},
+	}
+
+	// run test
+	got, err := e.Explain(&Data{Branch: "dev", Event: "tag", Tag: "v1.0.0"})
+	if err != nil {
+		t.Errorf("Explain returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Explain is %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Function represents a function that can be called from an expression.
+type Function struct {
+	// Usage describes how the function is called.
+	Usage string
+	// MinArgs is the minimum number of arguments accepted.
+	MinArgs int
+	// MaxArgs is the maximum number of arguments accepted
+	// or -1 when the function accepts any number.
+	MaxArgs int
+
+	call func(d *Data, args []interface{}) (interface{}, error)
+}
+
+// Functions represents the functions that can be called from an expression.
+var Functions = map[string]*Function{
+	"contains": {
+		Usage:   "contains(value, search) returns true when the string contains the substring or the list contains the item",
+		MinArgs: 2,
+		MaxArgs: 2,
+		call: func(d *Data, args []interface{}) (interface{}, error) {
+			search, err := str(args[1])
+			if err != nil {
+				return nil, err
+			}
+
+			switch v := args[0].(type) {
+			case string:
+				return strings.Contains(v, search), nil
+			case []string:
+				for _, item := range v {
+					if item == search {
+						return true, nil
+					}
+				}
+
+				return false, nil
+			default:
+				return nil, fmt.Errorf("unable to search value of type %T", args[0])
+			}
+		},
+	},
+	"startsWith": {
+		Usage:   "startsWith(value, prefix) returns true when the string begins with the prefix",
+		MinArgs: 2,
+		MaxArgs: 2,
+		call:    strings2(strings.HasPrefix),
+	},
+	"endsWith": {
+		Usage:   "endsWith(value, suffix) returns true when the string ends with the suffix",
+		MinArgs: 2,
+		MaxArgs: 2,
+		call:    strings2(strings.HasSuffix),
+	},
+	"matches": {
+		Usage:   "matches(value, pattern) returns true when the string matches the regular expression",
+		MinArgs: 2,
+		MaxArgs: 2,
+		call: func(d *Data, args []interface{}) (interface{}, error) {
+			return matches(args[0], args[1])
+		},
+	},
+	"glob": {
+		Usage:   "glob(value, pattern) returns true when the string matches the file path pattern",
+		MinArgs: 2,
+		MaxArgs: 2,
+		call: strings2(func(value, pattern string) bool {
+			match, _ := filepath.Match(pattern, value)
+
+			return match
+		}),
+	},
+	"in": {
+		Usage:   "in(value, candidates...) returns true when the string equals any of the candidates",
+		MinArgs: 2,
+		MaxArgs: -1,
+		call: func(d *Data, args []interface{}) (interface{}, error) {
+			value, err := str(args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			for _, arg := range args[1:] {
+				candidate, err := str(arg)
+				if err != nil {
+					return nil, err
+				}
+
+				if value == candidate {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		},
+	},
+	"anyPath": {
+		Usage:   "anyPath(patterns...) returns true when any changed file matches any of the file path patterns",
+		MinArgs: 1,
+		MaxArgs: -1,
+		call: func(d *Data, args []interface{}) (interface{}, error) {
+			patterns, err := strs(args)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, path := range d.Paths {
+				if matchAny(path, patterns) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		},
+	},
+	"allPaths": {
+		Usage:   "allPaths(patterns...) returns true when every changed file matches one of the file path patterns",
+		MinArgs: 1,
+		MaxArgs: -1,
+		call: func(d *Data, args []interface{}) (interface{}, error) {
+			patterns, err := strs(args)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(d.Paths) == 0 {
+				return false, nil
+			}
+
+			for _, path := range d.Paths {
+				if !matchAny(path, patterns) {
+					return false, nil
+				}
+			}
+
+			return true, nil
+		},
+	},
+}
+
+// strings2 adapts a function accepting two strings into a Function call.
+func strings2(fn func(string, string) bool) func(d *Data, args []interface{}) (interface{}, error) {
+	return func(d *Data, args []interface{}) (interface{}, error) {
+		values, err := strs(args)
+		if err != nil {
+			return nil, err
+		}
+
+		return fn(values[0], values[1]), nil
+	}
+}
+
+// str converts the provided argument into a string.
+func str(arg interface{}) (string, error) {
+	s, ok := arg.(string)
+	if !ok {
+		return "", fmt.Errorf("expected string argument but got %T", arg)
+	}
+
+	return s, nil
+}
+
+// strs converts the provided arguments into strings.
+func strs(args []interface{}) ([]string, error) {
+	values := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		s, err := str(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, s)
+	}
+
+	return values, nil
+}
+
+// matchAny returns whether the provided path
+// matches any of the file path patterns.
+func matchAny(path string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if match, _ := filepath.Match(pattern, path); match {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// kind represents the category of a token.
+type kind int
+
+const (
+	kindEOF kind = iota
+	kindIdent
+	kindString
+	kindNumber
+	kindOperator
+	kindLParen
+	kindRParen
+	kindComma
+	kindDot
+)
+
+// token represents a single lexical element of an expression.
+type token struct {
+	kind  kind
+	value string
+	pos   int
+}
+
+// operators represents the operators recognized by
+// the lexer ordered so the longest match wins.
+var operators = []string{"&&", "||", "==", "!=", "=~", "!~", "<=", ">=", "<", ">", "!"}
+
+// lex splits the provided expression into tokens.
+func lex(input string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(input); {
+		ch := rune(input[i])
+
+		switch {
+		case unicode.IsSpace(ch):
+			i++
+		case ch == '(':
+			tokens = append(tokens, token{kind: kindLParen, value: "(", pos: i})
+			i++
+		case ch == ')':
+			tokens = append(tokens, token{kind: kindRParen, value: ")", pos: i})
+			i++
+		case ch == ',':
+			tokens = append(tokens, token{kind: kindComma, value: ",", pos: i})
+			i++
+		case ch == '.':
+			tokens = append(tokens, token{kind: kindDot, value: ".", pos: i})
+			i++
+		case ch == '"' || ch == '\'':
+			value, end, err := lexString(input, i)
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, token{kind: kindString, value: value, pos: i})
+			i = end
+		case unicode.IsDigit(ch):
+			start := i
+
+			for i < len(input) && unicode.IsDigit(rune(input[i])) {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: kindNumber, value: input[start:i], pos: start})
+		case ch == '_' || unicode.IsLetter(ch):
+			start := i
+
+			for i < len(input) && (input[i] == '_' || unicode.IsLetter(rune(input[i])) || unicode.IsDigit(rune(input[i]))) {
+				i++
+			}
+
+			tokens = append(tokens, token{kind: kindIdent, value: input[start:i], pos: start})
+		default:
+			op := ""
+
+			for _, candidate := range operators {
+				if strings.HasPrefix(input[i:], candidate) {
+					op = candidate
+
+					break
+				}
+			}
+
+			if len(op) == 0 {
+				return nil, fmt.Errorf("unexpected character %q at position %d", ch, i)
+			}
+
+			tokens = append(tokens, token{kind: kindOperator, value: op, pos: i})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, token{kind: kindEOF, pos: len(input)}), nil
+}
+
+// lexString captures the quoted string beginning at the provided
+// position and returns the unquoted value along with the position
+// immediately after the closing quote.
+func lexString(input string, start int) (string, int, error) {
+	quote := input[start]
+
+	var b strings.Builder
+
+	for i := start + 1; i < len(input); i++ {
+		switch input[i] {
+		case '\\':
+			if i+1 < len(input) {
+				i++
+				b.WriteByte(input[i])
+			}
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(input[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated string at position %d", start)
+}
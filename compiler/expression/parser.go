@@ -0,0 +1,349 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type (
+	// node represents a single element of a parsed expression.
+	node interface {
+		// String returns the canonical form of the element.
+		String() string
+		// eval evaluates the element against the provided data.
+		eval(d *Data) (*Trace, error)
+	}
+
+	// binaryNode represents an operator applied to two operands.
+	binaryNode struct {
+		op    string
+		left  node
+		right node
+	}
+
+	// notNode represents the negation of an operand.
+	notNode struct {
+		operand node
+	}
+
+	// literalNode represents a string, integer or boolean literal.
+	literalNode struct {
+		value interface{}
+	}
+
+	// refNode represents a reference to a field of the data.
+	refNode struct {
+		path []string
+	}
+
+	// callNode represents a call to one of the Functions.
+	callNode struct {
+		name string
+		args []node
+	}
+)
+
+// Expression represents a parsed conditional expression.
+type Expression struct {
+	source string
+	root   node
+}
+
+// parser represents the state while parsing a list of tokens.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse converts the provided source into an Expression.
+func Parse(source string) (*Expression, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse expression %q: %w", source, err)
+	}
+
+	p := &parser{tokens: tokens}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse expression %q: %w", source, err)
+	}
+
+	if p.peek().kind != kindEOF {
+		return nil, fmt.Errorf("unable to parse expression %q: unexpected %q at position %d", source, p.peek().value, p.peek().pos)
+	}
+
+	return &Expression{source: source, root: root}, nil
+}
+
+// String returns the source the Expression was parsed from.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the current token.
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+
+	if t.kind != kindEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+// expect consumes the current token and returns an
+// error if it is not of the provided kind.
+func (p *parser) expect(k kind, desc string) (token, error) {
+	t := p.next()
+	if t.kind != k {
+		return t, fmt.Errorf("expected %s at position %d", desc, t.pos)
+	}
+
+	return t, nil
+}
+
+// parseOr parses operands joined by the || operator.
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == kindOperator && p.peek().value == "||" {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &binaryNode{op: "||", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses operands joined by the && operator.
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == kindOperator && p.peek().value == "&&" {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &binaryNode{op: "&&", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseUnary parses an operand optionally negated by the ! operator.
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().kind == kindOperator && p.peek().value == "!" {
+		p.next()
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &notNode{operand: operand}, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parseComparison parses an operand optionally compared to another operand.
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	if t.kind != kindOperator {
+		return left, nil
+	}
+
+	switch t.value {
+	case "==", "!=", "=~", "!~", "<", "<=", ">", ">=":
+		p.next()
+
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+
+		// validate the regular expression up front when it is a literal
+		if lit, ok := right.(*literalNode); ok && (t.value == "=~" || t.value == "!~") {
+			if _, err := compile(lit.value); err != nil {
+				return nil, err
+			}
+		}
+
+		return &binaryNode{op: t.value, left: left, right: right}, nil
+	default:
+		return left, nil
+	}
+}
+
+// parsePrimary parses a literal, reference, function call or grouping.
+func (p *parser) parsePrimary() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case kindString:
+		return &literalNode{value: t.value}, nil
+	case kindNumber:
+		n, err := strconv.Atoi(t.value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s at position %d", t.value, t.pos)
+		}
+
+		return &literalNode{value: n}, nil
+	case kindLParen:
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = p.expect(kindRParen, "closing parenthesis")
+		if err != nil {
+			return nil, err
+		}
+
+		return n, nil
+	case kindIdent:
+		switch t.value {
+		case "true", "false":
+			return &literalNode{value: t.value == "true"}, nil
+		}
+
+		if p.peek().kind == kindLParen {
+			return p.parseCall(t)
+		}
+
+		return p.parseRef(t)
+	case kindEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	default:
+		return nil, fmt.Errorf("unexpected %q at position %d", t.value, t.pos)
+	}
+}
+
+// parseCall parses the arguments for a call to one of the Functions.
+func (p *parser) parseCall(name token) (node, error) {
+	fn, ok := Functions[name.value]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s at position %d", name.value, name.pos)
+	}
+
+	// consume the opening parenthesis
+	p.next()
+
+	call := &callNode{name: name.value}
+
+	if p.peek().kind != kindRParen {
+		for {
+			arg, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+
+			call.args = append(call.args, arg)
+
+			if p.peek().kind != kindComma {
+				break
+			}
+
+			p.next()
+		}
+	}
+
+	_, err := p.expect(kindRParen, "closing parenthesis")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(call.args) < fn.MinArgs || (fn.MaxArgs >= 0 && len(call.args) > fn.MaxArgs) {
+		return nil, fmt.Errorf("invalid number of arguments for %s at position %d: %s", name.value, name.pos, fn.Usage)
+	}
+
+	return call, nil
+}
+
+// parseRef parses a reference to a field of the data.
+func (p *parser) parseRef(name token) (node, error) {
+	if name.value == fieldEnv {
+		_, err := p.expect(kindDot, "env variable name")
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := p.expect(kindIdent, "env variable name")
+		if err != nil {
+			return nil, err
+		}
+
+		return &refNode{path: []string{fieldEnv, key.value}}, nil
+	}
+
+	if _, ok := fields[name.value]; !ok {
+		return nil, fmt.Errorf("unknown field %s at position %d", name.value, name.pos)
+	}
+
+	return &refNode{path: []string{name.value}}, nil
+}
+
+// String returns the canonical form of the binary operation.
+func (n *binaryNode) String() string {
+	return fmt.Sprintf("(%s %s %s)", n.left, n.op, n.right)
+}
+
+// String returns the canonical form of the negation.
+func (n *notNode) String() string {
+	return "!" + n.operand.String()
+}
+
+// String returns the canonical form of the literal.
+func (n *literalNode) String() string {
+	if s, ok := n.value.(string); ok {
+		return strconv.Quote(s)
+	}
+
+	return fmt.Sprint(n.value)
+}
+
+// String returns the canonical form of the reference.
+func (n *refNode) String() string {
+	return strings.Join(n.path, ".")
+}
+
+// String returns the canonical form of the function call.
+func (n *callNode) String() string {
+	args := make([]string, 0, len(n.args))
+
+	for _, arg := range n.args {
+		args = append(args, arg.String())
+	}
+
+	return fmt.Sprintf("%s(%s)", n.name, strings.Join(args, ", "))
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package expression
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpression_Parse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		source  string
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "comparison",
+			source: `branch == 'main'`,
+			want:   `(branch == "main")`,
+		},
+		{
+			name:   "precedence",
+			source: `event == "push" || event == "tag" && !contains(paths, "README.md")`,
+			want:   `((event == "push") || ((event == "tag") && !contains(paths, "README.md")))`,
+		},
+		{
+			name:   "grouping and env",
+			source: `(branch =~ "^release/" || tag != "") && env.DEPLOY`,
+			want:   `(((branch =~ "^release/") || (tag != "")) && env.DEPLOY)`,
+		},
+		{
+			name:   "integer",
+			source: `approvals>=2`,
+			want:   `(approvals >= 2)`,
+		},
+		{
+			name:   "escaped quote",
+			source: `comment == "say \"hi\""`,
+			want:   `(comment == "say \"hi\"")`,
+		},
+		{
+			name:    "unknown field",
+			source:  `commit == "abc"`,
+			wantErr: "unknown field commit at position 0",
+		},
+		{
+			name:    "unknown function",
+			source:  `lower(branch)`,
+			wantErr: "unknown function lower at position 0",
+		},
+		{
+			name:    "invalid arguments",
+			source:  `startsWith(branch)`,
+			wantErr: "invalid number of arguments for startsWith",
+		},
+		{
+			name:    "invalid regular expression",
+			source:  `branch =~ "("`,
+			wantErr: "invalid regular expression",
+		},
+		{
+			name:    "unterminated string",
+			source:  `branch == "main`,
+			wantErr: "unterminated string at position 10",
+		},
+		{
+			name:    "unexpected character",
+			source:  `branch == main & true`,
+			wantErr: "unexpected character '&' at position 15",
+		},
+		{
+			name:    "trailing tokens",
+			source:  `branch == "main" "dev"`,
+			wantErr: `unexpected "dev" at position 17`,
+		},
+		{
+			name:    "unclosed group",
+			source:  `(branch == "main"`,
+			wantErr: "expected closing parenthesis at position 17",
+		},
+		{
+			name:    "missing env name",
+			source:  `env == "foo"`,
+			wantErr: "expected env variable name at position 4",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.source)
+
+			if len(test.wantErr) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("Parse error is %v, want %s", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			if got.root.String() != test.want {
+				t.Errorf("Parse is %s, want %s", got.root, test.want)
+			}
+
+			if got.String() != test.source {
+				t.Errorf("String is %s, want %s", got, test.source)
+			}
+		})
+	}
+}
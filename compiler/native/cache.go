@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"sync"
+	"time"
+)
+
+type (
+	// templateCache represents a cache for templates
+	// that is shared between compilations.
+	templateCache struct {
+		mu      sync.RWMutex
+		ttl     time.Duration
+		entries map[string]*cacheEntry
+	}
+
+	// cacheEntry represents a template stored in the cache.
+	cacheEntry struct {
+		data    []byte
+		expires time.Time
+	}
+)
+
+// newTemplateCache returns a cache that retains templates
+// for the provided duration. A duration of zero or
+// less disables the cache.
+func newTemplateCache(ttl time.Duration) *templateCache {
+	return &templateCache{
+		ttl:     ttl,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// get returns the unexpired template stored in the cache for the key.
+func (tc *templateCache) get(key string) ([]byte, bool) {
+	if tc == nil || tc.ttl <= 0 {
+		return nil, false
+	}
+
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+
+	entry, ok := tc.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+
+	return entry.data, true
+}
+
+// set stores the template in the cache for the key.
+func (tc *templateCache) set(key string, data []byte) {
+	if tc == nil || tc.ttl <= 0 {
+		return
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	now := time.Now()
+
+	// remove expired entries so the cache does not grow unbounded
+	for k, entry := range tc.entries {
+		if now.After(entry.expires) {
+			delete(tc.entries, k)
+		}
+	}
+
+	tc.entries[key] = &cacheEntry{
+		data:    data,
+		expires: now.Add(tc.ttl),
+	}
+}
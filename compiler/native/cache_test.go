@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNative_templateCache(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		cache  *templateCache
+		wantOk bool
+	}{
+		{
+			name:   "enabled",
+			cache:  newTemplateCache(time.Minute),
+			wantOk: true,
+		},
+		{
+			name:   "disabled",
+			cache:  newTemplateCache(0),
+			wantOk: false,
+		},
+		{
+			name:   "nil",
+			cache:  nil,
+			wantOk: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.cache.set("foo", []byte("bar"))
+
+			got, ok := test.cache.get("foo")
+
+			if ok != test.wantOk {
+				t.Errorf("get returned %v, want %v", ok, test.wantOk)
+			}
+
+			if ok && !reflect.DeepEqual(got, []byte("bar")) {
+				t.Errorf("get is %s, want bar", got)
+			}
+		})
+	}
+}
+
+func TestNative_templateCache_Expired(t *testing.T) {
+	// setup types
+	cache := newTemplateCache(time.Minute)
+
+	cache.set("foo", []byte("bar"))
+
+	// expire the entry
+	cache.entries["foo"].expires = time.Now().Add(-time.Second)
+
+	// run test
+	_, ok := cache.get("foo")
+	if ok {
+		t.Errorf("get returned an expired template")
+	}
+
+	cache.set("baz", []byte("qux"))
+
+	if _, ok := cache.entries["foo"]; ok {
+		t.Errorf("set did not remove the expired template")
+	}
+}
@@ -5,8 +5,19 @@
 package native
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	yml "github.com/buildkite/yaml"
+
+	api "github.com/go-vela/server/api/types"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/yaml"
+
+	"gorm.io/gorm"
 )
 
 const (
@@ -26,6 +37,11 @@ func (c *client) CloneStage(p *yaml.Build) (*yaml.Build, error) {
 
 	stages := yaml.StageSlice{}
 
+	params, err := c.cloneParameters()
+	if err != nil {
+		return nil, err
+	}
+
 	// create new clone stage
 	clone := &yaml.Stage{
 		Name: cloneStageName,
@@ -34,6 +50,7 @@ func (c *client) CloneStage(p *yaml.Build) (*yaml.Build, error) {
 				Detach:     false,
 				Image:      c.CloneImage,
 				Name:       cloneStepName,
+				Parameters: params,
 				Privileged: false,
 				Pull:       constants.PullNotPresent,
 			},
@@ -62,11 +79,17 @@ func (c *client) CloneStep(p *yaml.Build) (*yaml.Build, error) {
 
 	steps := yaml.StepSlice{}
 
+	params, err := c.cloneParameters()
+	if err != nil {
+		return nil, err
+	}
+
 	// create new clone step
 	clone := &yaml.Step{
 		Detach:     false,
 		Image:      c.CloneImage,
 		Name:       cloneStepName,
+		Parameters: params,
 		Privileged: false,
 		Pull:       constants.PullNotPresent,
 	}
@@ -82,3 +105,203 @@ func (c *client) CloneStep(p *yaml.Build) (*yaml.Build, error) {
 
 	return p, nil
 }
+
+// cloneConfig represents the subset of a pipeline
+// configuration that can declare clone options.
+type cloneConfig struct {
+	Clone *struct {
+		Depth        int64 `yaml:"depth"`
+		SingleBranch *bool `yaml:"single_branch"`
+		Tags         *bool `yaml:"tags"`
+	} `yaml:"clone"`
+}
+
+// captureClone captures and validates the clone options
+// declared for the provided pipeline configuration.
+//
+// Clone options can only be captured from yaml pipelines since
+// the raw configuration for other pipeline types is a template.
+func (c *client) captureClone(data []byte, pipelineType string) error {
+	c.clone = nil
+
+	if pipelineType != constants.PipelineTypeYAML && pipelineType != "" {
+		return nil
+	}
+
+	cfg := new(cloneConfig)
+
+	err := yml.Unmarshal(data, cfg)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal yaml: %w", err)
+	}
+
+	if cfg.Clone == nil {
+		return nil
+	}
+
+	if cfg.Clone.Depth < 0 {
+		return fmt.Errorf("invalid clone depth %d: must not be negative", cfg.Clone.Depth)
+	}
+
+	c.clone = new(api.CloneSetting)
+	c.clone.SetDepth(cfg.Clone.Depth)
+	c.clone.SetSingleBranch(cloneMode(cfg.Clone.SingleBranch))
+	c.clone.SetTags(cloneMode(cfg.Clone.Tags))
+
+	return nil
+}
+
+// cloneMode is a helper function to convert an
+// optional clone option to the mode for the option.
+func cloneMode(option *bool) string {
+	switch {
+	case option == nil:
+		return api.CloneModeAuto
+	case *option:
+		return api.CloneModeEnabled
+	default:
+		return api.CloneModeDisabled
+	}
+}
+
+// cloneParameters is a helper function to create the parameters
+// for the clone step to control how the clone step fetches the repo.
+//
+// The clone options for the pipeline take precedence over the clone
+// settings for the repo, which take precedence over the defaults for
+// the org. The auto mode for submodules and Git LFS fetches them when
+// their usage was detected in the build context.
+func (c *client) cloneParameters() (map[string]interface{}, error) {
+	settings := []*api.CloneSetting{c.clone}
+
+	if c.CloneSettings != nil && c.repo != nil {
+		s, err := c.CloneSettings.GetCloneSettingForRepo(c.requestContext(), c.repo)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("unable to get clone setting for %s: %w", c.repo.GetFullName(), err)
+		}
+
+		settings = append(settings, s)
+
+		s, err = c.CloneSettings.GetCloneSettingForOrg(c.requestContext(), c.repo.GetOrg())
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("unable to get clone setting for org %s: %w", c.repo.GetOrg(), err)
+		}
+
+		settings = append(settings, s)
+	}
+
+	params := make(map[string]interface{})
+
+	if depth := firstDepth(settings, (*api.CloneSetting).GetDepth); depth > 0 {
+		params["depth"] = depth
+	}
+
+	if mode := firstMode(settings, (*api.CloneSetting).GetSingleBranch); mode != api.CloneModeAuto {
+		params["single_branch"] = mode == api.CloneModeEnabled
+	}
+
+	if mode := firstMode(settings, (*api.CloneSetting).GetTags); mode != api.CloneModeAuto {
+		params["tags"] = mode == api.CloneModeEnabled
+	}
+
+	if cloneEnabled(firstMode(settings, (*api.CloneSetting).GetSubmodules), len(c.context.GetSubmodules()) > 0) {
+		params["submodules"] = true
+
+		if depth := firstDepth(settings, (*api.CloneSetting).GetSubmoduleDepth); depth > 0 {
+			params["submodule_depth"] = depth
+		}
+
+		c.warnSubmodules()
+	}
+
+	if cloneEnabled(firstMode(settings, (*api.CloneSetting).GetLFS), c.context.GetLFS()) {
+		params["lfs"] = true
+	}
+
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	return params, nil
+}
+
+// firstMode is a helper function to capture the first mode
+// from the settings that isn't the auto mode.
+func firstMode(settings []*api.CloneSetting, mode func(*api.CloneSetting) string) string {
+	for _, s := range settings {
+		if m := mode(s); len(m) > 0 && m != api.CloneModeAuto {
+			return m
+		}
+	}
+
+	return api.CloneModeAuto
+}
+
+// firstDepth is a helper function to capture the
+// first depth from the settings that is set.
+func firstDepth(settings []*api.CloneSetting, depth func(*api.CloneSetting) int64) int64 {
+	for _, s := range settings {
+		if d := depth(s); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// cloneEnabled is a helper function to determine if the clone step
+// fetches the content for the mode when it was detected or not.
+func cloneEnabled(mode string, detected bool) bool {
+	switch mode {
+	case api.CloneModeEnabled:
+		return true
+	case api.CloneModeDisabled:
+		return false
+	default:
+		return detected
+	}
+}
+
+// warnSubmodules is a helper function to record a warning for the
+// submodules hosted outside of the source control provider since the
+// credentials for the build can only clone from the provider.
+func (c *client) warnSubmodules() {
+	if c.metadata == nil || c.metadata.Source == nil {
+		return
+	}
+
+	for _, submodule := range c.context.GetSubmodules() {
+		host := submoduleHost(submodule)
+
+		if len(host) > 0 && !strings.EqualFold(host, c.metadata.Source.Host) {
+			c.recordWarning(fmt.Sprintf("submodule %s is not hosted on %s and may fail to clone", submodule, c.metadata.Source.Host))
+		}
+	}
+}
+
+// submoduleHost is a helper function to capture the host for the url
+// of a submodule. Relative urls are hosted with the repo so the
+// host is empty for them.
+func submoduleHost(submodule string) string {
+	if strings.Contains(submodule, "://") {
+		u, err := url.Parse(submodule)
+		if err != nil {
+			return ""
+		}
+
+		return u.Host
+	}
+
+	// scp-like urls have the form user@host:path
+	user, rest, found := strings.Cut(submodule, "@")
+	if !found || strings.Contains(user, "/") {
+		return ""
+	}
+
+	host, _, found := strings.Cut(rest, ":")
+	if !found {
+		return ""
+	}
+
+	return host
+}
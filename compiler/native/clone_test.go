@@ -5,12 +5,18 @@
 package native
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"reflect"
 	"testing"
 
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/yaml"
 	"github.com/urfave/cli/v2"
+	"gorm.io/gorm"
 )
 
 const defaultCloneImage = "target/vela-git:latest"
@@ -195,3 +201,258 @@ func TestNative_CloneStep(t *testing.T) {
 		}
 	}
 }
+
+// testCloneSettings represents a clone setting service for tests.
+type testCloneSettings struct {
+	setting *api.CloneSetting
+	org     *api.CloneSetting
+	err     error
+}
+
+// GetCloneSettingForOrg returns the default clone setting for tests.
+func (s *testCloneSettings) GetCloneSettingForOrg(context.Context, string) (*api.CloneSetting, error) {
+	if s.org == nil && s.err == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.org, s.err
+}
+
+// GetCloneSettingForRepo returns the clone setting for tests.
+func (s *testCloneSettings) GetCloneSettingForRepo(context.Context, *library.Repo) (*api.CloneSetting, error) {
+	return s.setting, s.err
+}
+
+func TestNative_cloneParameters(t *testing.T) {
+	// setup types
+	r := new(library.Repo)
+	r.SetFullName("github/octocat")
+
+	m := &types.Metadata{Source: &types.Source{Host: "github.com"}}
+
+	detected := new(api.BuildContext)
+	detected.SetSubmodules([]string{"../hello-world.git", "https://gitlab.com/octocat/octokit.git"})
+	detected.SetLFS(true)
+
+	setting := new(api.CloneSetting)
+	setting.SetSubmodules(api.CloneModeEnabled)
+	setting.SetSubmoduleDepth(1)
+	setting.SetLFS(api.CloneModeDisabled)
+	setting.SetSingleBranch(api.CloneModeAuto)
+	setting.SetTags(api.CloneModeEnabled)
+
+	org := new(api.CloneSetting)
+	org.SetOrg("github")
+	org.SetSubmodules(api.CloneModeDisabled)
+	org.SetDepth(50)
+	org.SetSingleBranch(api.CloneModeEnabled)
+	org.SetTags(api.CloneModeDisabled)
+
+	pipeline := new(api.CloneSetting)
+	pipeline.SetDepth(1)
+	pipeline.SetSingleBranch(api.CloneModeAuto)
+	pipeline.SetTags(api.CloneModeAuto)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		settings *testCloneSettings
+		clone    *api.CloneSetting
+		context  *api.BuildContext
+		want     map[string]interface{}
+		warnings []string
+		failure  bool
+	}{
+		{
+			name:     "nothing detected",
+			settings: &testCloneSettings{err: gorm.ErrRecordNotFound},
+			context:  new(api.BuildContext),
+			want:     nil,
+			warnings: []string{},
+		},
+		{
+			name:     "auto detected",
+			settings: &testCloneSettings{err: gorm.ErrRecordNotFound},
+			context:  detected,
+			want:     map[string]interface{}{"submodules": true, "lfs": true},
+			warnings: []string{"submodule https://gitlab.com/octocat/octokit.git is not hosted on github.com and may fail to clone"},
+		},
+		{
+			name:     "repo settings",
+			settings: &testCloneSettings{setting: setting},
+			context:  detected,
+			want:     map[string]interface{}{"tags": true, "submodules": true, "submodule_depth": int64(1)},
+			warnings: []string{"submodule https://gitlab.com/octocat/octokit.git is not hosted on github.com and may fail to clone"},
+		},
+		{
+			name:     "org defaults",
+			settings: &testCloneSettings{err: gorm.ErrRecordNotFound, org: org},
+			context:  detected,
+			want:     map[string]interface{}{"depth": int64(50), "single_branch": true, "tags": false, "lfs": true},
+			warnings: []string{},
+		},
+		{
+			name:     "pipeline and repo settings override org defaults",
+			settings: &testCloneSettings{setting: setting, org: org},
+			clone:    pipeline,
+			context:  detected,
+			want: map[string]interface{}{
+				"depth":           int64(1),
+				"single_branch":   true,
+				"tags":            true,
+				"submodules":      true,
+				"submodule_depth": int64(1),
+			},
+			warnings: []string{"submodule https://gitlab.com/octocat/octokit.git is not hosted on github.com and may fail to clone"},
+		},
+		{
+			name:     "failure getting settings",
+			settings: &testCloneSettings{err: errors.New("database error")},
+			context:  detected,
+			failure:  true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &client{
+				CloneSettings: test.settings,
+				clone:         test.clone,
+				context:       test.context,
+				metadata:      m,
+				repo:          r,
+				report:        new(api.CompileReport),
+			}
+
+			got, err := c.cloneParameters()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("cloneParameters should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("cloneParameters returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("cloneParameters is %v, want %v", got, test.want)
+			}
+
+			if !reflect.DeepEqual(c.report.GetWarnings(), test.warnings) {
+				t.Errorf("cloneParameters warnings are %v, want %v", c.report.GetWarnings(), test.warnings)
+			}
+		})
+	}
+}
+
+func TestNative_submoduleHost(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		submodule string
+		want      string
+	}{
+		{submodule: "https://github.com/github/octokit.git", want: "github.com"},
+		{submodule: "git@gitlab.com:octocat/octokit.git", want: "gitlab.com"},
+		{submodule: "../octokit.git", want: ""},
+		{submodule: "./vendor/octokit", want: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := submoduleHost(test.submodule)
+
+		if got != test.want {
+			t.Errorf("submoduleHost for %s is %v, want %v", test.submodule, got, test.want)
+		}
+	}
+}
+
+func TestNative_captureClone(t *testing.T) {
+	// setup types
+	want := new(api.CloneSetting)
+	want.SetDepth(1)
+	want.SetSingleBranch(api.CloneModeEnabled)
+	want.SetTags(api.CloneModeAuto)
+
+	// setup tests
+	tests := []struct {
+		name         string
+		data         string
+		pipelineType string
+		want         *api.CloneSetting
+		failure      bool
+	}{
+		{
+			name: "clone options",
+			data: `
+version: "1"
+
+clone:
+  depth: 1
+  single_branch: true
+
+steps:
+  - name: test
+    image: alpine
+`,
+			want: want,
+		},
+		{
+			name: "no clone options",
+			data: `
+version: "1"
+
+steps:
+  - name: test
+    image: alpine
+`,
+			want: nil,
+		},
+		{
+			name:         "starlark pipeline",
+			data:         `def main(ctx): return {}`,
+			pipelineType: "starlark",
+			want:         nil,
+		},
+		{
+			name: "negative depth",
+			data: `
+version: "1"
+
+clone:
+  depth: -1
+`,
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := new(client)
+
+			err := c.captureClone([]byte(test.data), test.pipelineType)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("captureClone should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("captureClone returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(c.clone, test.want) {
+				t.Errorf("captureClone is %v, want %v", c.clone, test.want)
+			}
+		})
+	}
+}
@@ -42,11 +42,17 @@ type ModifyResponse struct {
 
 // Compile produces an executable pipeline from a yaml configuration.
 func (c *client) Compile(v interface{}) (*pipeline.Build, *library.Pipeline, error) {
+	// capture the measurements for the compilation
+	c.startReport()
+	defer c.finishReport(time.Now())
+
 	p, data, err := c.Parse(v, c.repo.GetPipelineType(), new(yaml.Template))
 	if err != nil {
 		return nil, nil, err
 	}
 
+	c.report.SetConfigSize(int64(len(data)))
+
 	// create the library pipeline object from the yaml configuration
 	_pipeline := p.ToPipelineLibrary()
 	_pipeline.SetData(data)
@@ -58,6 +64,42 @@ func (c *client) Compile(v interface{}) (*pipeline.Build, *library.Pipeline, err
 		return nil, _pipeline, err
 	}
 
+	// capture the conditional expressions for the stages and steps
+	err = c.captureConditions(data, c.repo.GetPipelineType())
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// capture the readiness checks for the services
+	err = c.captureReadiness(data, c.repo.GetPipelineType())
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// capture the retry policies for the steps
+	err = c.captureRetries(data, c.repo.GetPipelineType())
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// capture the egress policies for the steps
+	err = c.captureEgress(data, c.repo.GetPipelineType())
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// capture the clone options for the pipeline
+	err = c.captureClone(data, c.repo.GetPipelineType())
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// warn about the deprecated templates and syntax in the pipeline
+	err = c.captureDeprecations(p.Templates, data, c.repo.GetPipelineType())
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	// create map of templates for easy lookup
 	templates := mapFromTemplates(p.Templates)
 
@@ -312,6 +354,8 @@ func (c *client) compileSteps(p *yaml.Build, _pipeline *library.Pipeline, tmpls
 		return nil, _pipeline, err
 	}
 
+	c.recordExpansion(p)
+
 	if c.ModificationService.Endpoint != "" {
 		// send config to external endpoint for modification
 		p, err = c.modifyConfig(p, c.build, c.repo)
@@ -326,6 +370,12 @@ func (c *client) compileSteps(p *yaml.Build, _pipeline *library.Pipeline, tmpls
 		return nil, _pipeline, err
 	}
 
+	// inject the variables for the repo into the global environment
+	err = c.repoVariables(p)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	// Create some default global environment inject vars
 	// these are used below to overwrite to an empty
 	// map if they should not be injected into a container
@@ -373,12 +423,48 @@ func (c *client) compileSteps(p *yaml.Build, _pipeline *library.Pipeline, tmpls
 		return nil, _pipeline, err
 	}
 
+	// remove the steps whose conditional expressions do not hold
+	p.Steps, err = c.ConditionSteps(p.Steps, r)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	// create executable representation
 	build, err := c.TransformSteps(r, p)
 	if err != nil {
 		return nil, _pipeline, err
 	}
 
+	// inject the readiness checks for the services
+	c.applyReadiness(build)
+
+	// inject the retry policies for the steps
+	c.applyRetries(build)
+
+	// validate and inject the egress policies for the steps
+	err = c.applyEgress(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// warn about the deprecated images in the pipeline
+	err = c.deprecateImages(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// rewrite the images to pull from the registry mirrors
+	err = c.mirrorImages(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// enforce the image policy and pin the images to digests
+	err = c.pinImages(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	return build, _pipeline, nil
 }
 
@@ -409,6 +495,8 @@ func (c *client) compileStages(p *yaml.Build, _pipeline *library.Pipeline, tmpls
 		return nil, _pipeline, err
 	}
 
+	c.recordExpansion(p)
+
 	if c.ModificationService.Endpoint != "" {
 		// send config to external endpoint for modification
 		p, err = c.modifyConfig(p, c.build, c.repo)
@@ -423,6 +511,12 @@ func (c *client) compileStages(p *yaml.Build, _pipeline *library.Pipeline, tmpls
 		return nil, _pipeline, err
 	}
 
+	// inject the variables for the repo into the global environment
+	err = c.repoVariables(p)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	// Create some default global environment inject vars
 	// these are used below to overwrite to an empty
 	// map if they should not be injected into a container
@@ -470,12 +564,48 @@ func (c *client) compileStages(p *yaml.Build, _pipeline *library.Pipeline, tmpls
 		return nil, _pipeline, err
 	}
 
+	// remove the stages and steps whose conditional expressions do not hold
+	p.Stages, err = c.ConditionStages(p.Stages, r)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	// create executable representation
 	build, err := c.TransformStages(r, p)
 	if err != nil {
 		return nil, _pipeline, err
 	}
 
+	// inject the readiness checks for the services
+	c.applyReadiness(build)
+
+	// inject the retry policies for the steps
+	c.applyRetries(build)
+
+	// validate and inject the egress policies for the steps
+	err = c.applyEgress(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// warn about the deprecated images in the pipeline
+	err = c.deprecateImages(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// rewrite the images to pull from the registry mirrors
+	err = c.mirrorImages(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
+	// enforce the image policy and pin the images to digests
+	err = c.pinImages(build)
+	if err != nil {
+		return nil, _pipeline, err
+	}
+
 	return build, _pipeline, nil
 }
 
@@ -522,7 +652,7 @@ func (c *client) modifyConfig(build *yaml.Build, libraryBuild *library.Build, re
 	}
 
 	// ensure the overall request(s) do not take over the defined timeout
-	ctx, cancel := context.WithTimeout(context.Background(), c.ModificationService.Timeout)
+	ctx, cancel := context.WithTimeout(c.requestContext(), c.ModificationService.Timeout)
 	defer cancel()
 
 	// create POST request
@@ -0,0 +1,156 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/expression"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/pipeline"
+	"github.com/go-vela/types/raw"
+	"github.com/go-vela/types/yaml"
+)
+
+// captureConditions captures the conditional expressions declared
+// on the stages and steps of the provided pipeline configuration.
+//
+// Expressions can only be captured from yaml pipelines since
+// the raw configuration for other pipeline types is a template.
+func (c *client) captureConditions(data []byte, pipelineType string) error {
+	c.conditions = nil
+
+	if pipelineType != constants.PipelineTypeYAML && pipelineType != "" {
+		return nil
+	}
+
+	conditions, err := expression.Conditions(data)
+	if err != nil {
+		return err
+	}
+
+	c.conditions = conditions
+
+	return nil
+}
+
+// ConditionStages removes the stages, and the steps within them,
+// whose conditional expressions do not hold for the build.
+func (c *client) ConditionStages(s yaml.StageSlice, r *pipeline.RuleData) (yaml.StageSlice, error) {
+	stages := yaml.StageSlice{}
+
+	for _, stage := range s {
+		ok, err := c.evaluateCondition(stage.Name, "", r, stage.Environment)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			c.recordSkip(stage.Name, "", api.SkipReasonCondition,
+				fmt.Sprintf("condition for %s does not hold for the build", conditionName(stage.Name, "")))
+
+			continue
+		}
+
+		steps := yaml.StepSlice{}
+
+		for _, step := range stage.Steps {
+			ok, err := c.evaluateCondition(stage.Name, step.Name, r, step.Environment)
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				c.recordSkip(stage.Name, step.Name, api.SkipReasonCondition,
+					fmt.Sprintf("condition for %s does not hold for the build", conditionName(stage.Name, step.Name)))
+
+				continue
+			}
+
+			steps = append(steps, step)
+		}
+
+		stage.Steps = steps
+
+		stages = append(stages, stage)
+	}
+
+	return stages, nil
+}
+
+// ConditionSteps removes the steps whose conditional
+// expressions do not hold for the build.
+func (c *client) ConditionSteps(s yaml.StepSlice, r *pipeline.RuleData) (yaml.StepSlice, error) {
+	steps := yaml.StepSlice{}
+
+	for _, step := range s {
+		ok, err := c.evaluateCondition("", step.Name, r, step.Environment)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			c.recordSkip("", step.Name, api.SkipReasonCondition,
+				fmt.Sprintf("condition for %s does not hold for the build", conditionName("", step.Name)))
+
+			continue
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// evaluateCondition returns whether the conditional expression declared
+// for the provided stage and step holds for the build. When no expression
+// is declared, the stage or step is always kept.
+func (c *client) evaluateCondition(stage, step string, r *pipeline.RuleData, env raw.StringSliceMap) (bool, error) {
+	for _, condition := range c.conditions {
+		if condition.Stage != stage || condition.Step != step {
+			continue
+		}
+
+		e, err := expression.Parse(condition.Expression)
+		if err != nil {
+			return false, err
+		}
+
+		ok, err := e.Evaluate(&expression.Data{
+			Branch:    r.Branch,
+			Comment:   r.Comment,
+			Event:     r.Event,
+			Repo:      r.Repo,
+			Tag:       r.Tag,
+			Target:    r.Target,
+			Paths:     r.Path,
+			Labels:    c.context.GetLabels(),
+			Reviewers: c.context.GetReviewers(),
+			Approvals: c.context.GetApprovals(),
+			Issues:    c.context.GetIssues(),
+			Env:       env,
+		})
+		if err != nil {
+			return false, fmt.Errorf("unable to evaluate condition for %s: %w", conditionName(stage, step), err)
+		}
+
+		return ok, nil
+	}
+
+	return true, nil
+}
+
+// conditionName returns a description of the stage or step a condition is declared for.
+func conditionName(stage, step string) string {
+	switch {
+	case len(stage) == 0:
+		return "step " + step
+	case len(step) == 0:
+		return "stage " + stage
+	default:
+		return fmt.Sprintf("step %s in stage %s", step, stage)
+	}
+}
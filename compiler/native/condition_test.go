@@ -0,0 +1,197 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"flag"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/expression"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/go-vela/types/raw"
+	"github.com/go-vela/types/yaml"
+
+	"github.com/urfave/cli/v2"
+)
+
+func TestNative_captureConditions(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	data := []byte(`
+version: "1"
+steps:
+  - name: publish
+    image: alpine
+    ruleset:
+      expr: branch == "main"
+`)
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	// run tests
+	err = compiler.captureConditions(data, "yaml")
+	if err != nil {
+		t.Errorf("captureConditions returned err: %v", err)
+	}
+
+	want := []*expression.Condition{{Step: "publish", Expression: `branch == "main"`}}
+
+	if !reflect.DeepEqual(compiler.conditions, want) {
+		t.Errorf("captureConditions is %v, want %v", compiler.conditions, want)
+	}
+
+	err = compiler.captureConditions(data, "go")
+	if err != nil {
+		t.Errorf("captureConditions returned err: %v", err)
+	}
+
+	if compiler.conditions != nil {
+		t.Errorf("captureConditions is %v, want nil", compiler.conditions)
+	}
+}
+
+func TestNative_ConditionSteps(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.conditions = []*expression.Condition{
+		{Step: "publish", Expression: `branch == "main" && env.DRY_RUN != "true" && approvals > 0`},
+		{Step: "docs", Expression: `anyPath("docs/*")`},
+	}
+
+	compiler.context = new(api.BuildContext)
+	compiler.context.SetApprovals(1)
+
+	r := &pipeline.RuleData{Branch: "main", Event: "push", Path: []string{"src/main.go"}}
+
+	s := yaml.StepSlice{
+		&yaml.Step{Name: "test", Image: "golang"},
+		&yaml.Step{Name: "publish", Image: "alpine", Environment: raw.StringSliceMap{"DRY_RUN": "false"}},
+		&yaml.Step{Name: "docs", Image: "alpine"},
+	}
+
+	want := yaml.StepSlice{s[0], s[1]}
+
+	// run test
+	got, err := compiler.ConditionSteps(s, r)
+	if err != nil {
+		t.Errorf("ConditionSteps returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConditionSteps is %v, want %v", got, want)
+	}
+
+	// evaluate with an invalid comparison
+	compiler.conditions = []*expression.Condition{{Step: "test", Expression: `paths == "src"`}}
+
+	_, err = compiler.ConditionSteps(s, r)
+	if err == nil || !strings.Contains(err.Error(), "unable to evaluate condition for step test") {
+		t.Errorf("ConditionSteps error is %v, want evaluation error", err)
+	}
+}
+
+func TestNative_ConditionStages(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.conditions = []*expression.Condition{
+		{Stage: "deploy", Expression: `event == "deployment"`},
+		{Stage: "test", Step: "lint", Expression: `!startsWith(branch, "release/")`},
+	}
+
+	r := &pipeline.RuleData{Branch: "release/v1", Event: "push"}
+
+	test := &yaml.Stage{
+		Name: "test",
+		Steps: yaml.StepSlice{
+			&yaml.Step{Name: "lint", Image: "golang"},
+			&yaml.Step{Name: "test", Image: "golang"},
+		},
+	}
+
+	s := yaml.StageSlice{
+		test,
+		&yaml.Stage{Name: "deploy", Steps: yaml.StepSlice{&yaml.Step{Name: "deploy", Image: "alpine"}}},
+	}
+
+	want := yaml.StageSlice{
+		&yaml.Stage{Name: "test", Steps: yaml.StepSlice{test.Steps[1]}},
+	}
+
+	// run test
+	got, err := compiler.ConditionStages(s, r)
+	if err != nil {
+		t.Errorf("ConditionStages returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConditionStages is %v, want %v", got, want)
+	}
+}
+
+func TestNative_Compile_Conditions(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+	name := "foo"
+	author := "author"
+	number := 1
+	branch := "release/v1"
+	event := "push"
+
+	// run test
+	data, err := os.ReadFile("testdata/steps_pipeline_conditions.yml")
+	if err != nil {
+		t.Errorf("Reading yaml file return err: %v", err)
+	}
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.repo = &library.Repo{Name: &author}
+	compiler.build = &library.Build{Author: &name, Number: &number, Branch: &branch, Event: &event}
+
+	got, _, err := compiler.Compile(data)
+	if err != nil {
+		t.Errorf("Compile returned err: %v", err)
+	}
+
+	steps := []string{}
+
+	for _, step := range got.Steps {
+		steps = append(steps, step.Name)
+	}
+
+	want := []string{"init", "test", "publish"}
+
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("Compile steps are %v, want %v", steps, want)
+	}
+}
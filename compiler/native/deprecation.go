@@ -0,0 +1,186 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	yml "github.com/buildkite/yaml"
+
+	api "github.com/go-vela/server/api/types"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/pipeline"
+	"github.com/go-vela/types/yaml"
+	"github.com/sirupsen/logrus"
+)
+
+// Deprecated returns the deprecated images, templates and syntax
+// used by the pipeline for the most recent compilation.
+func (c *client) Deprecated() []*api.Deprecation {
+	return c.deprecated
+}
+
+// captureDeprecations is a helper function to capture the active
+// deprecation rules for the compilation and record a warning for
+// the deprecated templates and syntax in the yaml configuration.
+func (c *client) captureDeprecations(templates yaml.TemplateSlice, data []byte, pipelineType string) error {
+	c.rules = nil
+
+	if c.DeprecationRules == nil {
+		return nil
+	}
+
+	rules, err := c.DeprecationRules.ListDeprecationRules(c.requestContext())
+	if err != nil {
+		// a failure to capture deprecation rules should not fail the compilation
+		logrus.Errorf("unable to list deprecation rules: %v", err)
+
+		return nil
+	}
+
+	for _, rule := range rules {
+		if rule.GetActive() {
+			c.rules = append(c.rules, rule)
+		}
+	}
+
+	for _, tmpl := range templates {
+		err = c.deprecate(api.DeprecationKindTemplate, tmpl.Source, matchPattern)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pipelineType != constants.PipelineTypeYAML && pipelineType != "" {
+		return nil
+	}
+
+	var config interface{}
+
+	// the yaml configuration was already validated so an error
+	// here means the syntax can't be inspected for deprecations
+	err = yml.Unmarshal(data, &config)
+	if err != nil {
+		return nil
+	}
+
+	return c.deprecate(api.DeprecationKindSyntax, "", func(pattern, _ string) bool {
+		return hasKey(config, strings.Split(pattern, "."))
+	})
+}
+
+// deprecateImages is a helper function to record a warning for the
+// deprecated images for the services and steps in the executable pipeline.
+func (c *client) deprecateImages(p *pipeline.Build) error {
+	for _, ctn := range containers(p) {
+		// skip the injected init steps
+		if ctn.Image == initImage {
+			continue
+		}
+
+		err := c.deprecate(api.DeprecationKindImage, ctn.Image, matchPattern)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deprecate is a helper function to record a warning for the subject
+// for each active deprecation rule of the kind the subject matches.
+// When the sunset for the rule has passed, it returns an error.
+func (c *client) deprecate(kind, subject string, match func(pattern, subject string) bool) error {
+	for _, rule := range c.rules {
+		if rule.GetKind() != kind || !match(rule.GetPattern(), subject) {
+			continue
+		}
+
+		// syntax rules use the key path as the subject
+		name := subject
+		if len(name) == 0 {
+			name = rule.GetPattern()
+		}
+
+		if rule.GetSunset() > 0 && time.Now().UTC().Unix() >= rule.GetSunset() {
+			sunset := time.Unix(rule.GetSunset(), 0).UTC().Format("2006-01-02")
+
+			return fmt.Errorf("%s %s is deprecated and was sunset on %s: %s", kind, name, sunset, rule.GetMessage())
+		}
+
+		// record the subject once for each rule
+		if c.isDeprecated(rule.GetID(), name) {
+			continue
+		}
+
+		d := new(api.Deprecation)
+		d.SetRuleID(rule.GetID())
+		d.SetKind(kind)
+		d.SetSubject(name)
+		d.SetMessage(rule.GetMessage())
+		d.SetSunset(rule.GetSunset())
+		d.SetComment(rule.GetComment())
+
+		c.deprecated = append(c.deprecated, d)
+
+		c.recordWarning(d.Warning())
+	}
+
+	return nil
+}
+
+// isDeprecated is a helper function to determine if the subject
+// was already recorded as deprecated for the rule.
+func (c *client) isDeprecated(rule int64, subject string) bool {
+	for _, d := range c.deprecated {
+		if d.GetRuleID() == rule && d.GetSubject() == subject {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchPattern is a helper function to determine if the
+// subject matches the glob pattern for a deprecation rule.
+func matchPattern(pattern, subject string) bool {
+	matched, err := path.Match(pattern, subject)
+
+	return err == nil && matched
+}
+
+// hasKey is a helper function to determine if the key path is
+// declared in the yaml configuration. Every item in a list is
+// searched and a * in the key path matches any key.
+func hasKey(v interface{}, keys []string) bool {
+	if len(keys) == 0 {
+		return true
+	}
+
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if hasKey(item, keys) {
+				return true
+			}
+		}
+	case map[interface{}]interface{}:
+		for k, item := range value {
+			if keys[0] != "*" && fmt.Sprint(k) != keys[0] {
+				continue
+			}
+
+			if hasKey(item, keys[1:]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/pipeline"
+	"github.com/go-vela/types/yaml"
+)
+
+// testDeprecationRules represents a deprecation rule service for tests.
+type testDeprecationRules struct {
+	rules []*api.DeprecationRule
+	err   error
+}
+
+// ListDeprecationRules returns the deprecation rules for tests.
+func (d *testDeprecationRules) ListDeprecationRules(context.Context) ([]*api.DeprecationRule, error) {
+	return d.rules, d.err
+}
+
+// testDeprecationRule is a helper function to create
+// an active deprecation rule for tests.
+func testDeprecationRule(id int64, kind, pattern string, sunset int64) *api.DeprecationRule {
+	r := new(api.DeprecationRule)
+	r.SetID(id)
+	r.SetKind(kind)
+	r.SetPattern(pattern)
+	r.SetMessage("please upgrade")
+	r.SetSunset(sunset)
+	r.SetComment(true)
+	r.SetActive(true)
+
+	return r
+}
+
+func TestNative_captureDeprecations(t *testing.T) {
+	// setup types
+	future := time.Now().UTC().Add(24 * time.Hour).Unix()
+	past := time.Now().UTC().Add(-24 * time.Hour).Unix()
+
+	inactive := testDeprecationRule(4, api.DeprecationKindSyntax, "version", 0)
+	inactive.SetActive(false)
+
+	data := []byte(`
+version: "1"
+
+templates:
+  - name: go
+    source: github.com/go-vela/templates/go.yml@v1
+    type: github
+
+steps:
+  - name: test
+    image: golang:1.15
+    ruleset:
+      branch: main
+`)
+
+	templates := yaml.TemplateSlice{
+		{Name: "go", Source: "github.com/go-vela/templates/go.yml@v1", Type: "github"},
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		rules   *testDeprecationRules
+		want    []string
+		failure bool
+	}{
+		{
+			name: "deprecated template and syntax",
+			rules: &testDeprecationRules{rules: []*api.DeprecationRule{
+				testDeprecationRule(1, api.DeprecationKindTemplate, "github.com/go-vela/templates/*@v1", future),
+				testDeprecationRule(2, api.DeprecationKindSyntax, "steps.ruleset.branch", 0),
+				testDeprecationRule(3, api.DeprecationKindSyntax, "stages.*.steps", 0),
+				inactive,
+			}},
+			want: []string{"github.com/go-vela/templates/go.yml@v1", "steps.ruleset.branch"},
+		},
+		{
+			name: "sunset passed",
+			rules: &testDeprecationRules{rules: []*api.DeprecationRule{
+				testDeprecationRule(1, api.DeprecationKindSyntax, "steps.ruleset", past),
+			}},
+			failure: true,
+		},
+		{
+			name:  "failure listing rules",
+			rules: &testDeprecationRules{err: errors.New("database error")},
+			want:  []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &client{DeprecationRules: test.rules}
+
+			err := c.captureDeprecations(templates, data, "")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("captureDeprecations should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("captureDeprecations returned err: %v", err)
+			}
+
+			got := []string{}
+			for _, d := range c.Deprecated() {
+				got = append(got, d.GetSubject())
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("captureDeprecations is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNative_deprecateImages(t *testing.T) {
+	// setup types
+	rule := testDeprecationRule(1, api.DeprecationKindImage, "golang:1.1*", 0)
+
+	c := &client{rules: []*api.DeprecationRule{rule}}
+
+	p := &pipeline.Build{
+		Services: pipeline.ContainerSlice{
+			{Name: "redis", Image: "redis:7"},
+		},
+		Steps: pipeline.ContainerSlice{
+			{Name: "init", Image: initImage},
+			{Name: "build", Image: "golang:1.15"},
+			{Name: "test", Image: "golang:1.15"},
+			{Name: "lint", Image: "golang:1.21"},
+		},
+	}
+
+	want := new(api.Deprecation)
+	want.SetRuleID(1)
+	want.SetKind(api.DeprecationKindImage)
+	want.SetSubject("golang:1.15")
+	want.SetMessage("please upgrade")
+	want.SetSunset(0)
+	want.SetComment(true)
+
+	// run test
+	err := c.deprecateImages(p)
+	if err != nil {
+		t.Errorf("deprecateImages returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(c.Deprecated(), []*api.Deprecation{want}) {
+		t.Errorf("deprecateImages is %v, want %v", c.Deprecated(), want)
+	}
+
+	// the sunset for the rule has passed
+	rule.SetSunset(time.Now().UTC().Add(-time.Hour).Unix())
+
+	err = c.deprecateImages(p)
+	if err == nil {
+		t.Errorf("deprecateImages should have returned err")
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// dockerHub represents the registry for images without a registry host.
+	dockerHub = "docker.io"
+
+	// dockerHubHost represents the host serving the registry API for Docker Hub.
+	dockerHubHost = "registry-1.docker.io"
+)
+
+// manifestTypes represents the media types accepted when
+// capturing the manifest for an image so the digest for
+// multi-platform images matches the digest of the index.
+var manifestTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// challengeParam represents the regular expression for
+// capturing the parameters from an authenticate challenge.
+var challengeParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+type (
+	// imageRef represents the components of an image reference.
+	imageRef struct {
+		registry   string
+		repository string
+		tag        string
+		digest     string
+	}
+
+	// digestResolver represents a client for resolving the tags
+	// of images to digests with the registries hosting the images.
+	digestResolver struct {
+		client *http.Client
+		scheme string
+		cache  *templateCache
+	}
+)
+
+// parseImage is a helper function to split the image
+// into the components of the reference. Images without
+// a registry host are hosted on Docker Hub.
+func parseImage(image string) *imageRef {
+	ref := new(imageRef)
+
+	name, digest, _ := strings.Cut(image, "@")
+	ref.digest = digest
+
+	// the tag follows the last colon after the last slash
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		ref.tag = name[i+1:]
+		name = name[:i]
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		ref.registry = parts[0]
+		name = parts[1]
+	} else {
+		ref.registry = dockerHub
+	}
+
+	// official images on Docker Hub belong to the library namespace
+	if ref.registry == dockerHub && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+
+	ref.repository = name
+
+	return ref
+}
+
+// mutable returns true when the reference isn't pinned
+// to a digest and uses a tag expected to move to new
+// images, i.e. the latest tag or no tag at all.
+func (r *imageRef) mutable() bool {
+	if len(r.digest) > 0 {
+		return false
+	}
+
+	return len(r.tag) == 0 || strings.EqualFold(r.tag, "latest")
+}
+
+// newDigestResolver returns a resolver that caches the
+// resolved digests for the provided duration.
+func newDigestResolver(ttl time.Duration) *digestResolver {
+	return &digestResolver{
+		client: &http.Client{Timeout: 10 * time.Second},
+		scheme: "https",
+		cache:  newTemplateCache(ttl),
+	}
+}
+
+// resolve returns the digest of the manifest the tag
+// for the image reference currently points to.
+func (d *digestResolver) resolve(ref *imageRef) (string, error) {
+	tag := ref.tag
+	if len(tag) == 0 {
+		tag = "latest"
+	}
+
+	key := fmt.Sprintf("%s/%s:%s", ref.registry, ref.repository, tag)
+
+	data, ok := d.cache.get(key)
+	if ok {
+		return string(data), nil
+	}
+
+	host := ref.registry
+	if host == dockerHub {
+		host = dockerHubHost
+	}
+
+	manifest := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", d.scheme, host, ref.repository, tag)
+
+	resp, err := d.head(manifest, "")
+	if err != nil {
+		return "", err
+	}
+
+	// registries requiring a token respond with a challenge
+	if resp.StatusCode == http.StatusUnauthorized {
+		token, err := d.token(resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return "", err
+		}
+
+		resp, err = d.head(manifest, token)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to capture manifest for %s: registry responded with %d", key, resp.StatusCode)
+	}
+
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if len(digest) == 0 {
+		return "", fmt.Errorf("unable to capture manifest for %s: registry responded without a digest", key)
+	}
+
+	d.cache.set(key, []byte(digest))
+
+	return digest, nil
+}
+
+// head is a helper function to send a HEAD request
+// for the manifest with the optional bearer token.
+func (d *digestResolver) head(manifest, token string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodHead, manifest, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", strings.Join(manifestTypes, ", "))
+
+	if len(token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body.Close()
+
+	return resp, nil
+}
+
+// token is a helper function to capture an anonymous
+// pull token for the provided authenticate challenge.
+func (d *digestResolver) token(challenge string) (string, error) {
+	if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+		return "", fmt.Errorf("unsupported authenticate challenge from registry: %s", challenge)
+	}
+
+	params := make(map[string]string)
+	for _, match := range challengeParam.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(match[1])] = match[2]
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || len(realm.Host) == 0 {
+		return "", fmt.Errorf("invalid realm in authenticate challenge from registry: %s", challenge)
+	}
+
+	query := realm.Query()
+
+	if len(params["service"]) > 0 {
+		query.Set("service", params["service"])
+	}
+
+	if len(params["scope"]) > 0 {
+		query.Set("scope", params["scope"])
+	}
+
+	realm.RawQuery = query.Encode()
+
+	resp, err := d.client.Get(realm.String())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to capture token from %s: responded with %d", realm.Host, resp.StatusCode)
+	}
+
+	body := new(struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	})
+
+	err = json.NewDecoder(resp.Body).Decode(body)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode token from %s: %w", realm.Host, err)
+	}
+
+	if len(body.Token) > 0 {
+		return body.Token, nil
+	}
+
+	return body.AccessToken, nil
+}
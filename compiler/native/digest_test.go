@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestNative_parseImage(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		image string
+		want  *imageRef
+	}{
+		{
+			image: "alpine",
+			want:  &imageRef{registry: "docker.io", repository: "library/alpine"},
+		},
+		{
+			image: "target/vela-git:v0.8.0",
+			want:  &imageRef{registry: "docker.io", repository: "target/vela-git", tag: "v0.8.0"},
+		},
+		{
+			image: "ghcr.io/go-vela/server:latest",
+			want:  &imageRef{registry: "ghcr.io", repository: "go-vela/server", tag: "latest"},
+		},
+		{
+			image: "localhost:5000/alpine:3.18@sha256:123abc",
+			want:  &imageRef{registry: "localhost:5000", repository: "alpine", tag: "3.18", digest: "sha256:123abc"},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			got := parseImage(test.image)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("parseImage is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNative_imageRef_mutable(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		image string
+		want  bool
+	}{
+		{image: "alpine", want: true},
+		{image: "alpine:latest", want: true},
+		{image: "alpine:3.18", want: false},
+		{image: "alpine:latest@sha256:123abc", want: false},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			got := parseImage(test.image).mutable()
+
+			if got != test.want {
+				t.Errorf("mutable is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNative_digestResolver_resolve(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	requests := 0
+
+	// setup mock server
+	engine.GET("/token", func(c *gin.Context) {
+		if c.Query("scope") != "repository:octocat/hello-world:pull" {
+			c.Status(http.StatusForbidden)
+
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"token": "foo"})
+	})
+	engine.HEAD("/v2/octocat/hello-world/manifests/:tag", func(c *gin.Context) {
+		requests++
+
+		if c.GetHeader("Authorization") != "Bearer foo" {
+			c.Header("WWW-Authenticate", fmt.Sprintf(
+				`Bearer realm="http://%s/token",service="registry",scope="repository:octocat/hello-world:pull"`, c.Request.Host))
+			c.Status(http.StatusUnauthorized)
+
+			return
+		}
+
+		if c.Param("tag") != "latest" {
+			c.Status(http.StatusNotFound)
+
+			return
+		}
+
+		c.Header("Docker-Content-Digest", "sha256:123abc")
+		c.Status(http.StatusOK)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	host := strings.TrimPrefix(s.URL, "http://")
+
+	d := newDigestResolver(time.Minute)
+	d.scheme = "http"
+
+	// run test
+	got, err := d.resolve(parseImage(host + "/octocat/hello-world"))
+	if err != nil {
+		t.Errorf("resolve returned err: %v", err)
+	}
+
+	if got != "sha256:123abc" {
+		t.Errorf("resolve is %v, want %v", got, "sha256:123abc")
+	}
+
+	// resolve from the cache
+	_, err = d.resolve(parseImage(host + "/octocat/hello-world:latest"))
+	if err != nil {
+		t.Errorf("resolve returned err: %v", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("resolve sent %d requests, want %d", requests, 2)
+	}
+
+	_, err = d.resolve(parseImage(host + "/octocat/hello-world:v1"))
+	if err == nil {
+		t.Errorf("resolve should have returned err")
+	}
+}
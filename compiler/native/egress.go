@@ -0,0 +1,300 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/buildkite/yaml"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/pipeline"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// egressDefault represents the environment variable for the action
+	// the worker takes for destinations that match no egress rule.
+	egressDefault = "VELA_STEP_EGRESS_DEFAULT"
+
+	// egressAllow represents the environment variable for the comma
+	// separated destinations the worker allows the step to reach.
+	egressAllow = "VELA_STEP_EGRESS_ALLOW"
+
+	// egressDeny represents the environment variable for the comma
+	// separated destinations the worker denies the step from reaching.
+	egressDeny = "VELA_STEP_EGRESS_DENY"
+)
+
+type (
+	// egressConfig represents the subset of a pipeline
+	// configuration that can declare egress policies.
+	egressConfig struct {
+		Stages yaml.MapSlice `yaml:"stages"`
+		Steps  []*egressStep `yaml:"steps"`
+	}
+
+	// egressStage represents the subset of a stage
+	// that can declare egress policies.
+	egressStage struct {
+		Steps []*egressStep `yaml:"steps"`
+	}
+
+	// egressStep represents the subset of a step
+	// that can declare an egress policy.
+	egressStep struct {
+		Name   string  `yaml:"name"`
+		Egress *egress `yaml:"egress"`
+	}
+
+	// egress represents the destinations a step
+	// is allowed or denied outbound network access to.
+	egress struct {
+		Allow []string `yaml:"allow"`
+		Deny  []string `yaml:"deny"`
+	}
+
+	// egressKey represents the stage and step an egress policy is declared for.
+	egressKey struct {
+		stage string
+		step  string
+	}
+
+	// egressPolicy represents the allowed and denied
+	// destinations from the active egress rules for an org.
+	egressPolicy struct {
+		allow []*api.EgressRule
+		deny  []*api.EgressRule
+	}
+)
+
+// captureEgress captures and validates the egress policies
+// declared on the steps of the provided pipeline configuration.
+//
+// Egress policies can only be captured from yaml pipelines since
+// the raw configuration for other pipeline types is a template.
+func (c *client) captureEgress(data []byte, pipelineType string) error {
+	c.egress = nil
+
+	if pipelineType != constants.PipelineTypeYAML && pipelineType != "" {
+		return nil
+	}
+
+	cfg := new(egressConfig)
+
+	err := yaml.Unmarshal(data, cfg)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal yaml: %w", err)
+	}
+
+	var result error
+
+	policies := make(map[egressKey]*egress)
+
+	capture := func(stage string, steps []*egressStep) {
+		for _, step := range steps {
+			if step == nil || step.Egress == nil {
+				continue
+			}
+
+			err := step.Egress.validate()
+			if err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid egress for %s: %w", conditionName(stage, step.Name), err))
+
+				continue
+			}
+
+			policies[egressKey{stage: stage, step: step.Name}] = step.Egress
+		}
+	}
+
+	for _, item := range cfg.Stages {
+		name := fmt.Sprint(item.Key)
+
+		// marshal the stage back to yaml to capture the egress policies
+		out, err := yaml.Marshal(item.Value)
+		if err != nil {
+			return fmt.Errorf("unable to marshal stage %s: %w", name, err)
+		}
+
+		s := new(egressStage)
+
+		err = yaml.Unmarshal(out, s)
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal stage %s: %w", name, err)
+		}
+
+		capture(name, s.Steps)
+	}
+
+	capture("", cfg.Steps)
+
+	if result != nil {
+		return result
+	}
+
+	c.egress = policies
+
+	return nil
+}
+
+// validate verifies the destinations of the egress policy are supported.
+func (e *egress) validate() error {
+	for _, destination := range append(append([]string{}, e.Allow...), e.Deny...) {
+		err := api.ValidateEgressDestination(destination)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// egressForOrg is a helper function to capture the
+// allowed and denied destinations from the active
+// egress rules that apply to the org.
+func egressForOrg(rules []*api.EgressRule, org string) *egressPolicy {
+	policy := new(egressPolicy)
+
+	for _, r := range rules {
+		if !r.GetActive() || !strings.EqualFold(r.GetOrg(), org) {
+			continue
+		}
+
+		switch r.GetAction() {
+		case api.EgressActionAllow:
+			policy.allow = append(policy.allow, r)
+		case api.EgressActionDeny:
+			policy.deny = append(policy.deny, r)
+		}
+	}
+
+	return policy
+}
+
+// permits returns an error when the org policy does not
+// permit the step to be allowed access to the destination.
+//
+// A destination matching a deny rule is never permitted and,
+// when the org has allow rules, a destination must match one.
+func (p *egressPolicy) permits(destination string) error {
+	for _, r := range p.deny {
+		if r.Covers(destination) {
+			return fmt.Errorf("egress to %s is denied by org rule %d", destination, r.GetID())
+		}
+	}
+
+	if len(p.allow) == 0 {
+		return nil
+	}
+
+	for _, r := range p.allow {
+		if r.Covers(destination) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("egress to %s is not allowed by an org rule", destination)
+}
+
+// applyEgress validates the captured egress policies for the steps
+// against the egress rules for the org of the repo and injects the
+// resulting policy into the environment of the steps in the
+// executable pipeline so the worker can enforce it.
+func (c *client) applyEgress(p *pipeline.Build) error {
+	policy := new(egressPolicy)
+
+	if c.Egress != nil {
+		rules, err := c.Egress.ListEgressRulesForOrg(c.requestContext(), c.repo.GetOrg())
+		if err != nil {
+			return fmt.Errorf("unable to list egress rules for org %s: %w", c.repo.GetOrg(), err)
+		}
+
+		policy = egressForOrg(rules, c.repo.GetOrg())
+	}
+
+	if len(c.egress) == 0 && len(policy.allow) == 0 && len(policy.deny) == 0 {
+		return nil
+	}
+
+	var result error
+
+	for _, ctn := range p.Steps {
+		err := c.applyStepEgress("", ctn, policy)
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	for _, stage := range p.Stages {
+		for _, ctn := range stage.Steps {
+			err := c.applyStepEgress(stage.Name, ctn, policy)
+			if err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+	}
+
+	return result
+}
+
+// applyStepEgress is a helper function to inject the egress
+// policy for the stage and step into the container.
+//
+// The destinations the step allows replace the destinations the
+// org allows, while the destinations the step denies are added to
+// the destinations the org denies.
+func (c *client) applyStepEgress(stage string, ctn *pipeline.Container, policy *egressPolicy) error {
+	// skip the injected init steps
+	if ctn.Image == initImage {
+		return nil
+	}
+
+	allow := []string{}
+	deny := []string{}
+
+	for _, r := range policy.allow {
+		allow = append(allow, r.GetDestination())
+	}
+
+	for _, r := range policy.deny {
+		deny = append(deny, r.GetDestination())
+	}
+
+	if e, ok := c.egress[egressKey{stage: stage, step: ctn.Name}]; ok {
+		for _, destination := range e.Allow {
+			err := policy.permits(destination)
+			if err != nil {
+				return fmt.Errorf("invalid egress for %s: %w", conditionName(stage, ctn.Name), err)
+			}
+		}
+
+		if len(e.Allow) > 0 {
+			allow = e.Allow
+		}
+
+		deny = append(deny, e.Deny...)
+	}
+
+	if len(allow) == 0 && len(deny) == 0 {
+		return nil
+	}
+
+	if ctn.Environment == nil {
+		ctn.Environment = make(map[string]string)
+	}
+
+	// steps with allowed destinations deny every other destination
+	ctn.Environment[egressDefault] = api.EgressActionAllow
+	if len(allow) > 0 {
+		ctn.Environment[egressDefault] = api.EgressActionDeny
+	}
+
+	ctn.Environment[egressAllow] = strings.Join(allow, ",")
+	ctn.Environment[egressDeny] = strings.Join(deny, ",")
+
+	return nil
+}
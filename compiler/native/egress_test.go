@@ -0,0 +1,254 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+
+	"github.com/urfave/cli/v2"
+)
+
+// testEgress represents an egress rule service for tests.
+type testEgress struct {
+	rules []*api.EgressRule
+	err   error
+}
+
+// ListEgressRulesForOrg returns the egress rules for tests.
+func (e *testEgress) ListEgressRulesForOrg(context.Context, string) ([]*api.EgressRule, error) {
+	return e.rules, e.err
+}
+
+func TestNative_captureEgress(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+		want    map[egressKey]*egress
+	}{
+		{
+			name: "steps",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: golang:1.19
+    egress:
+      allow: [ proxy.golang.org:443 ]
+      deny: [ 10.0.0.0/8 ]
+  - name: build
+    image: golang:1.19
+`,
+			want: map[egressKey]*egress{
+				{step: "test"}: {Allow: []string{"proxy.golang.org:443"}, Deny: []string{"10.0.0.0/8"}},
+			},
+		},
+		{
+			name: "stages",
+			data: `
+version: "1"
+stages:
+  test:
+    steps:
+      - name: integration
+        image: golang:1.19
+        egress:
+          allow: [ "*.github.com" ]
+`,
+			want: map[egressKey]*egress{
+				{stage: "test", step: "integration"}: {Allow: []string{"*.github.com"}},
+			},
+		},
+		{
+			name: "invalid destination",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: golang:1.19
+    egress:
+      allow: [ "github.com:https" ]
+`,
+			failure: true,
+		},
+	}
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := compiler.captureEgress([]byte(test.data), "yaml")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("captureEgress should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("captureEgress returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(compiler.egress, test.want) {
+				t.Errorf("captureEgress is %v, want %v", compiler.egress, test.want)
+			}
+		})
+	}
+}
+
+func TestNative_applyEgress(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	allowGitHub := new(api.EgressRule)
+	allowGitHub.SetID(1)
+	allowGitHub.SetOrg("octocat")
+	allowGitHub.SetAction(api.EgressActionAllow)
+	allowGitHub.SetDestination("*.github.com")
+	allowGitHub.SetActive(true)
+
+	denyInternal := new(api.EgressRule)
+	denyInternal.SetID(2)
+	denyInternal.SetOrg("octocat")
+	denyInternal.SetAction(api.EgressActionDeny)
+	denyInternal.SetDestination("10.0.0.0/8")
+	denyInternal.SetActive(true)
+
+	inactive := new(api.EgressRule)
+	inactive.SetID(3)
+	inactive.SetOrg("octocat")
+	inactive.SetAction(api.EgressActionAllow)
+	inactive.SetDestination("*")
+	inactive.SetActive(false)
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+
+	// setup tests
+	tests := []struct {
+		name    string
+		egress  *testEgress
+		steps   map[egressKey]*egress
+		failure bool
+		want    []map[string]string
+	}{
+		{
+			name:   "org rules",
+			egress: &testEgress{rules: []*api.EgressRule{allowGitHub, denyInternal, inactive}},
+			steps: map[egressKey]*egress{
+				{step: "test"}: {Allow: []string{"api.github.com:443"}, Deny: []string{"gist.github.com"}},
+			},
+			want: []map[string]string{
+				{
+					egressDefault: "deny",
+					egressAllow:   "api.github.com:443",
+					egressDeny:    "10.0.0.0/8,gist.github.com",
+				},
+				{
+					egressDefault: "deny",
+					egressAllow:   "*.github.com",
+					egressDeny:    "10.0.0.0/8",
+				},
+			},
+		},
+		{
+			name:   "step rules",
+			egress: &testEgress{},
+			steps: map[egressKey]*egress{
+				{step: "build"}: {Deny: []string{"*"}},
+			},
+			want: []map[string]string{
+				nil,
+				{
+					egressDefault: "allow",
+					egressAllow:   "",
+					egressDeny:    "*",
+				},
+			},
+		},
+		{
+			name:   "step allow denied by org",
+			egress: &testEgress{rules: []*api.EgressRule{denyInternal}},
+			steps: map[egressKey]*egress{
+				{step: "test"}: {Allow: []string{"10.1.2.3"}},
+			},
+			failure: true,
+		},
+		{
+			name:   "step allow not allowed by org",
+			egress: &testEgress{rules: []*api.EgressRule{allowGitHub}},
+			steps: map[egressKey]*egress{
+				{step: "test"}: {Allow: []string{"example.com"}},
+			},
+			failure: true,
+		},
+		{
+			name:    "error listing rules",
+			egress:  &testEgress{err: errors.New("database error")},
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compiler, err := New(c)
+			if err != nil {
+				t.Errorf("Creating compiler returned err: %v", err)
+			}
+
+			compiler.WithRepo(r).WithEgressRules(test.egress)
+			compiler.egress = test.steps
+
+			p := &pipeline.Build{
+				Steps: pipeline.ContainerSlice{
+					{Name: "test", Image: "golang:1.19"},
+					{Name: "build", Image: "golang:1.19"},
+				},
+			}
+
+			err = compiler.applyEgress(p)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("applyEgress should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("applyEgress returned err: %v", err)
+			}
+
+			for i, ctn := range p.Steps {
+				if !reflect.DeepEqual(ctn.Environment, test.want[i]) {
+					t.Errorf("applyEgress environment for %s is %v, want %v", ctn.Name, ctn.Environment, test.want[i])
+				}
+			}
+		})
+	}
+}
@@ -36,7 +36,7 @@ func (c *client) EnvironmentStage(s *yaml.Stage, globalEnv raw.StringSliceMap) (
 	// make empty map of environment variables
 	env := make(map[string]string)
 	// gather set of default environment variables
-	defaultEnv := environment(c.build, c.metadata, c.repo, c.user)
+	defaultEnv := c.defaultEnvironment()
 
 	// inject the declared global environment
 	// WARNING: local env can override global
@@ -90,7 +90,7 @@ func (c *client) EnvironmentStep(s *yaml.Step, stageEnv raw.StringSliceMap) (*ya
 	// make empty map of environment variables
 	env := make(map[string]string)
 	// gather set of default environment variables
-	defaultEnv := environment(c.build, c.metadata, c.repo, c.user)
+	defaultEnv := c.defaultEnvironment()
 
 	// check if the compiler is setup for a local pipeline
 	// and the step isn't setup to run in a detached state
@@ -152,7 +152,7 @@ func (c *client) EnvironmentServices(s yaml.ServiceSlice, globalEnv raw.StringSl
 		// make empty map of environment variables
 		env := make(map[string]string)
 		// gather set of default environment variables
-		defaultEnv := environment(c.build, c.metadata, c.repo, c.user)
+		defaultEnv := c.defaultEnvironment()
 
 		// inject the declared global environment
 		// WARNING: local env can override global
@@ -192,7 +192,7 @@ func (c *client) EnvironmentSecrets(s yaml.SecretSlice, globalEnv raw.StringSlic
 		// make empty map of environment variables
 		env := make(map[string]string)
 		// gather set of default environment variables
-		defaultEnv := environment(c.build, c.metadata, c.repo, c.user)
+		defaultEnv := c.defaultEnvironment()
 
 		// check if the compiler is setup for a local pipeline
 		if c.local {
@@ -250,7 +250,7 @@ func (c *client) EnvironmentBuild() map[string]string {
 	// make empty map of environment variables
 	env := make(map[string]string)
 	// gather set of default environment variables
-	defaultEnv := environment(c.build, c.metadata, c.repo, c.user)
+	defaultEnv := c.defaultEnvironment()
 
 	// check if the compiler is setup for a local pipeline
 	if c.local {
@@ -274,6 +274,13 @@ func (c *client) EnvironmentBuild() map[string]string {
 	return env
 }
 
+// defaultEnvironment returns the default environment variables
+// for the build along with the variables computed from the
+// build context.
+func (c *client) defaultEnvironment() map[string]string {
+	return appendMap(environment(c.build, c.metadata, c.repo, c.user), c.context.Environment())
+}
+
 // helper function to merge two maps together.
 func appendMap(originalMap, otherMap map[string]string) map[string]string {
 	for key, value := range otherMap {
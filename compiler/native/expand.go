@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-vela/server/compiler/registry"
 	"github.com/go-vela/server/compiler/template/native"
+	"github.com/go-vela/server/compiler/template/schema"
 	"github.com/go-vela/server/compiler/template/starlark"
 	"github.com/spf13/afero"
 
@@ -23,7 +24,7 @@ import (
 // ExpandStages injects the template for each
 // templated step in every stage in a yaml configuration.
 func (c *client) ExpandStages(s *yaml.Build, tmpls map[string]*yaml.Template) (*yaml.Build, error) {
-	if len(tmpls) == 0 {
+	if len(tmpls) == 0 && !c.orgTemplatesEnabled() {
 		return s, nil
 	}
 
@@ -47,7 +48,7 @@ func (c *client) ExpandStages(s *yaml.Build, tmpls map[string]*yaml.Template) (*
 // ExpandSteps injects the template for each
 // templated step in a yaml configuration.
 func (c *client) ExpandSteps(s *yaml.Build, tmpls map[string]*yaml.Template) (*yaml.Build, error) {
-	if len(tmpls) == 0 {
+	if len(tmpls) == 0 && !c.orgTemplatesEnabled() {
 		return s, nil
 	}
 
@@ -71,6 +72,12 @@ func (c *client) ExpandSteps(s *yaml.Build, tmpls map[string]*yaml.Template) (*y
 
 		// lookup step template name
 		tmpl, ok := tmpls[step.Template.Name]
+
+		// fall back to the shared repo for the org when the template is not declared
+		if !ok && c.orgTemplatesEnabled() {
+			tmpl, ok = implicitTemplate(step.Template.Name), true
+		}
+
 		if !ok {
 			return s, fmt.Errorf("missing template source for template %s in pipeline for step %s", step.Template.Name, step.Name)
 		}
@@ -167,6 +174,14 @@ func (c *client) getTemplate(tmpl *yaml.Template, name string) ([]byte, error) {
 		err   error
 	)
 
+	// templates referenced multiple times are only fetched once per compilation
+	key := fmt.Sprintf("%s:%s", tmpl.Type, tmpl.Source)
+
+	bytes, ok := c.cachedTemplate(key)
+	if ok {
+		return bytes, nil
+	}
+
 	switch {
 	case c.local:
 		a := &afero.Afero{
@@ -194,6 +209,11 @@ func (c *client) getTemplate(tmpl *yaml.Template, name string) ([]byte, error) {
 				"host": src.Host,
 			}).Tracef("Using GitHub client to pull template")
 
+			err = c.enforceTemplatePolicy(c.Github, nil, src, name)
+			if err != nil {
+				return bytes, err
+			}
+
 			bytes, err = c.Github.Template(nil, src)
 			if err != nil {
 				return bytes, err
@@ -206,6 +226,11 @@ func (c *client) getTemplate(tmpl *yaml.Template, name string) ([]byte, error) {
 				"host": src.Host,
 			}).Tracef("Using authenticated GitHub client to pull template")
 
+			err = c.enforceTemplatePolicy(c.PrivateGithub, c.user, src, name)
+			if err != nil {
+				return bytes, err
+			}
+
 			// use private (authenticated) github instance to pull from
 			bytes, err = c.PrivateGithub.Template(c.user, src)
 			if err != nil {
@@ -213,6 +238,12 @@ func (c *client) getTemplate(tmpl *yaml.Template, name string) ([]byte, error) {
 			}
 		}
 
+	case strings.EqualFold(tmpl.Type, templateTypeOrg):
+		bytes, err = c.getOrgTemplate(tmpl, name)
+		if err != nil {
+			return bytes, err
+		}
+
 	case strings.EqualFold(tmpl.Type, "file"):
 		src := &registry.Source{
 			Org:  c.repo.GetOrg(),
@@ -250,18 +281,30 @@ func (c *client) getTemplate(tmpl *yaml.Template, name string) ([]byte, error) {
 		return bytes, fmt.Errorf("unsupported template type: %v", tmpl.Type)
 	}
 
+	c.cacheTemplate(key, bytes)
+
 	return bytes, nil
 }
 
 //nolint:lll // ignore long line length due to input arguments
 func (c *client) mergeTemplate(bytes []byte, tmpl *yaml.Template, step *yaml.Step) (*yaml.Build, error) {
+	// separate the parameter schema from the template body
+	s, body, err := schema.Parse(string(bytes))
+	if err != nil {
+		return &yaml.Build{}, fmt.Errorf("unable to parse template %s for step %s: %w", tmpl.Name, step.Name, err)
+	}
+
+	// validate the variables provided by the step against the schema
+	variables, err := s.Validate(step.Template.Variables)
+	if err != nil {
+		return &yaml.Build{}, fmt.Errorf("unable to expand template %s for step %s: %w", tmpl.Name, step.Name, err)
+	}
+
 	switch tmpl.Format {
 	case constants.PipelineTypeGo, "golang", "":
-		//nolint:lll // ignore long line length due to return
-		return native.Render(string(bytes), step.Name, step.Template.Name, step.Environment, step.Template.Variables)
+		return native.Render(body, step.Name, step.Template.Name, step.Environment, variables)
 	case constants.PipelineTypeStarlark:
-		//nolint:lll // ignore long line length due to return
-		return starlark.Render(string(bytes), step.Name, step.Template.Name, step.Environment, step.Template.Variables)
+		return starlark.Render(body, step.Name, step.Template.Name, step.Environment, variables)
 	default:
 		//nolint:lll // ignore long line length due to return
 		return &yaml.Build{}, fmt.Errorf("format of %s is unsupported", tmpl.Format)
@@ -666,6 +666,95 @@ func TestNative_ExpandStepsStarlark(t *testing.T) {
 	}
 }
 
+func TestNative_ExpandStepsSchema(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	tmpls := map[string]*yaml.Template{
+		"gradle": {
+			Name:   "gradle",
+			Source: "testdata/template_schema.yml",
+			Type:   "file",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		variables map[string]interface{}
+		want      yaml.StepSlice
+		wantErr   string
+	}{
+		{
+			name:      "defaults applied",
+			variables: map[string]interface{}{"image": "openjdk:latest"},
+			want: yaml.StepSlice{
+				&yaml.Step{
+					Commands: []string{"./gradlew build"},
+					Image:    "openjdk:latest",
+					Name:     "sample_build",
+					Pull:     "not_present",
+				},
+			},
+		},
+		{
+			name:      "missing required",
+			variables: map[string]interface{}{"pull_policy": "always"},
+			wantErr:   "unable to expand template gradle for step sample: invalid template variables: parameter image is required",
+		},
+		{
+			name:      "invalid enum and type",
+			variables: map[string]interface{}{"image": 11, "pull_policy": "never"},
+			wantErr: "unable to expand template gradle for step sample: invalid template variables: " +
+				"parameter image must be of type string, got integer 11; " +
+				"parameter pull_policy must be one of [always not_present], got never",
+		},
+		{
+			name:      "undeclared",
+			variables: map[string]interface{}{"image": "openjdk:latest", "tag": "latest"},
+			wantErr:   "unable to expand template gradle for step sample: invalid template variables: parameter tag is not declared by the template",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compiler, err := New(c)
+			if err != nil {
+				t.Errorf("Creating new compiler returned err: %v", err)
+			}
+
+			steps := yaml.StepSlice{
+				&yaml.Step{
+					Name: "sample",
+					Template: yaml.StepTemplate{
+						Name:      "gradle",
+						Variables: test.variables,
+					},
+				},
+			}
+
+			build, err := compiler.WithLocal(true).ExpandSteps(&yaml.Build{Steps: steps, Services: yaml.ServiceSlice{}}, tmpls)
+
+			if len(test.wantErr) > 0 {
+				if err == nil || err.Error() != test.wantErr {
+					t.Errorf("ExpandSteps returned err %v, want %s", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ExpandSteps returned err: %v", err)
+			}
+
+			if diff := cmp.Diff(build.Steps, test.want); diff != "" {
+				t.Errorf("ExpandSteps() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestNative_mapFromTemplates(t *testing.T) {
 	// setup types
 	str := "foo"
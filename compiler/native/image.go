@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/types/pipeline"
+)
+
+// Images returns the images pinned to digests by the most recent compilation.
+func (c *client) Images() []string {
+	return c.images
+}
+
+// containers is a helper function to capture the services
+// and the steps in every stage of the executable pipeline.
+func containers(p *pipeline.Build) pipeline.ContainerSlice {
+	containers := pipeline.ContainerSlice{}
+	containers = append(containers, p.Services...)
+	containers = append(containers, p.Steps...)
+
+	for _, s := range p.Stages {
+		containers = append(containers, s.Steps...)
+	}
+
+	return containers
+}
+
+// requiresImmutableImages is a helper function to determine if the
+// org for the repo forbids images with mutable tags like latest.
+func (c *client) requiresImmutableImages() bool {
+	for _, org := range c.ImmutableImageOrgs {
+		if org == pinAllOrgs || strings.EqualFold(org, c.repo.GetOrg()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pinImages is a helper function to verify the images in the executable
+// pipeline satisfy the image policy for the org and, when digest resolution
+// is enabled, to pin the tag for each image to the digest it resolves to.
+//
+// An image that can't be resolved is left on its tag with a warning
+// in the report so an unavailable registry doesn't fail the build.
+func (c *client) pinImages(p *pipeline.Build) error {
+	// capture the pinned reference for each distinct image
+	pinned := make(map[string]string)
+
+	for _, ctn := range containers(p) {
+		// skip the injected init steps
+		if ctn.Image == initImage {
+			continue
+		}
+
+		ref := parseImage(ctn.Image)
+
+		if ref.mutable() && c.requiresImmutableImages() {
+			return fmt.Errorf("image %s for %s must not use a mutable tag for org %s", ctn.Image, ctn.Name, c.repo.GetOrg())
+		}
+
+		if c.digests == nil {
+			continue
+		}
+
+		image, ok := pinned[ctn.Image]
+		if !ok {
+			image = c.pinImage(ctn.Image, ref)
+			pinned[ctn.Image] = image
+
+			if len(image) > 0 {
+				c.images = append(c.images, image)
+			}
+		}
+
+		if len(image) > 0 {
+			ctn.Image = image
+		}
+	}
+
+	return nil
+}
+
+// pinImage is a helper function to capture the reference for
+// the image pinned to a digest. It returns an empty reference
+// when the digest for the image can't be resolved.
+func (c *client) pinImage(image string, ref *imageRef) string {
+	if len(ref.digest) > 0 {
+		return image
+	}
+
+	digest, err := c.digests.resolve(ref)
+	if err != nil {
+		c.recordWarning(fmt.Sprintf("unable to resolve digest for image %s: %v", image, err))
+
+		return ""
+	}
+
+	return fmt.Sprintf("%s@%s", image, digest)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+)
+
+func TestNative_pinImages(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.HEAD("/v2/:org/:repo/manifests/:tag", func(c *gin.Context) {
+		if c.Param("repo") != "hello-world" {
+			c.Status(http.StatusNotFound)
+
+			return
+		}
+
+		c.Header("Docker-Content-Digest", "sha256:123abc")
+		c.Status(http.StatusOK)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	host := strings.TrimPrefix(s.URL, "http://")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+
+	// setup tests
+	tests := []struct {
+		name      string
+		immutable []string
+		resolve   bool
+		image     string
+		want      string
+		images    []string
+		warnings  int
+		failure   bool
+	}{
+		{
+			name:  "resolution disabled",
+			image: host + "/octocat/hello-world:latest",
+			want:  host + "/octocat/hello-world:latest",
+		},
+		{
+			name:    "resolved image",
+			resolve: true,
+			image:   host + "/octocat/hello-world:v1",
+			want:    host + "/octocat/hello-world:v1@sha256:123abc",
+			images:  []string{host + "/octocat/hello-world:v1@sha256:123abc"},
+		},
+		{
+			name:    "pinned image",
+			resolve: true,
+			image:   host + "/octocat/hello-world:v1@sha256:456def",
+			want:    host + "/octocat/hello-world:v1@sha256:456def",
+			images:  []string{host + "/octocat/hello-world:v1@sha256:456def"},
+		},
+		{
+			name:     "unresolved image",
+			resolve:  true,
+			image:    host + "/octocat/goodbye-world:v1",
+			want:     host + "/octocat/goodbye-world:v1",
+			warnings: 1,
+		},
+		{
+			name:      "mutable tag for immutable org",
+			immutable: []string{"octocat"},
+			image:     host + "/octocat/hello-world:latest",
+			failure:   true,
+		},
+		{
+			name:      "immutable tag for immutable org",
+			immutable: []string{"*"},
+			image:     host + "/octocat/hello-world:v1",
+			want:      host + "/octocat/hello-world:v1",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &client{ImmutableImageOrgs: test.immutable, repo: r}
+
+			if test.resolve {
+				c.digests = newDigestResolver(time.Minute)
+				c.digests.scheme = "http"
+			}
+
+			c.startReport()
+
+			p := &pipeline.Build{
+				Stages: pipeline.StageSlice{
+					{
+						Name: "init",
+						Steps: pipeline.ContainerSlice{
+							{Name: "init", Image: initImage},
+						},
+					},
+					{
+						Name: "test",
+						Steps: pipeline.ContainerSlice{
+							{Name: "test", Image: test.image},
+							{Name: "lint", Image: test.image},
+						},
+					},
+				},
+			}
+
+			err := c.pinImages(p)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("pinImages should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("pinImages returned err: %v", err)
+			}
+
+			if got := p.Stages[1].Steps[0].Image; got != test.want {
+				t.Errorf("pinImages image is %v, want %v", got, test.want)
+			}
+
+			if !reflect.DeepEqual(c.Images(), test.images) {
+				t.Errorf("Images is %v, want %v", c.Images(), test.images)
+			}
+
+			if len(c.Report().GetWarnings()) != test.warnings {
+				t.Errorf("pinImages warnings are %v, want %d", c.Report().GetWarnings(), test.warnings)
+			}
+		})
+	}
+}
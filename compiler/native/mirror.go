@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/pipeline"
+)
+
+// mirrorImages is a helper function to rewrite the images in the
+// executable pipeline to pull from the active mirror for the registry
+// hosting each image. A mirror for the org of the repo takes
+// precedence over the mirror for every org.
+func (c *client) mirrorImages(p *pipeline.Build) error {
+	if c.Mirrors == nil {
+		return nil
+	}
+
+	mirrors, err := c.Mirrors.ListRegistryMirrors(c.requestContext())
+	if err != nil {
+		return fmt.Errorf("unable to list registry mirrors: %w", err)
+	}
+
+	rules := mirrorsForOrg(mirrors, c.repo.GetOrg())
+	if len(rules) == 0 {
+		return nil
+	}
+
+	for _, ctn := range containers(p) {
+		// skip the injected init steps
+		if ctn.Image == initImage {
+			continue
+		}
+
+		ref := parseImage(ctn.Image)
+
+		mirror, ok := rules[strings.ToLower(ref.registry)]
+		if !ok {
+			continue
+		}
+
+		ctn.Image = ref.mirrored(mirror)
+	}
+
+	return nil
+}
+
+// mirrorsForOrg is a helper function to capture the mirror for each
+// registry from the active registry mirrors that apply to the org.
+func mirrorsForOrg(mirrors []*api.RegistryMirror, org string) map[string]string {
+	rules := make(map[string]string)
+
+	for _, m := range mirrors {
+		if !m.GetActive() {
+			continue
+		}
+
+		registry := strings.ToLower(m.GetRegistry())
+		mirror := strings.TrimSuffix(m.GetMirror(), "/")
+
+		switch {
+		case len(m.GetOrg()) == 0:
+			// mirrors for every org never replace the mirror for the org
+			if _, ok := rules[registry]; !ok {
+				rules[registry] = mirror
+			}
+		case strings.EqualFold(m.GetOrg(), org):
+			rules[registry] = mirror
+		}
+	}
+
+	return rules
+}
+
+// mirrored returns the reference for the image when it is
+// pulled from the provided mirror instead of its registry.
+func (r *imageRef) mirrored(mirror string) string {
+	image := fmt.Sprintf("%s/%s", mirror, r.repository)
+
+	if len(r.tag) > 0 {
+		image = fmt.Sprintf("%s:%s", image, r.tag)
+	}
+
+	if len(r.digest) > 0 {
+		image = fmt.Sprintf("%s@%s", image, r.digest)
+	}
+
+	return image
+}
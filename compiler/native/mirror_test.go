@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+)
+
+// testMirrors represents a registry mirror service for tests.
+type testMirrors struct {
+	mirrors []*api.RegistryMirror
+	err     error
+}
+
+// ListRegistryMirrors returns the registry mirrors for tests.
+func (m *testMirrors) ListRegistryMirrors(context.Context) ([]*api.RegistryMirror, error) {
+	return m.mirrors, m.err
+}
+
+func TestNative_mirrorImages(t *testing.T) {
+	// setup types
+	hub := new(api.RegistryMirror)
+	hub.SetRegistry("docker.io")
+	hub.SetMirror("mirror.example.com/dockerhub/")
+	hub.SetActive(true)
+
+	orgHub := new(api.RegistryMirror)
+	orgHub.SetOrg("octocat")
+	orgHub.SetRegistry("docker.io")
+	orgHub.SetMirror("octocat.example.com/dockerhub")
+	orgHub.SetActive(true)
+
+	ghcr := new(api.RegistryMirror)
+	ghcr.SetRegistry("ghcr.io")
+	ghcr.SetMirror("mirror.example.com/ghcr")
+	ghcr.SetActive(false)
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("hello-world")
+
+	orgRepo := new(library.Repo)
+	orgRepo.SetOrg("octocat")
+	orgRepo.SetName("hello-world")
+
+	// setup tests
+	tests := []struct {
+		name    string
+		repo    *library.Repo
+		mirrors *testMirrors
+		image   string
+		want    string
+		failure bool
+	}{
+		{
+			name:    "mirror for every org",
+			repo:    r,
+			mirrors: &testMirrors{mirrors: []*api.RegistryMirror{orgHub, hub, ghcr}},
+			image:   "alpine:3.18@sha256:123abc",
+			want:    "mirror.example.com/dockerhub/library/alpine:3.18@sha256:123abc",
+		},
+		{
+			name:    "mirror for org",
+			repo:    orgRepo,
+			mirrors: &testMirrors{mirrors: []*api.RegistryMirror{orgHub, hub, ghcr}},
+			image:   "target/vela-git",
+			want:    "octocat.example.com/dockerhub/target/vela-git",
+		},
+		{
+			name:    "inactive mirror",
+			repo:    r,
+			mirrors: &testMirrors{mirrors: []*api.RegistryMirror{orgHub, hub, ghcr}},
+			image:   "ghcr.io/go-vela/server:latest",
+			want:    "ghcr.io/go-vela/server:latest",
+		},
+		{
+			name:    "failure listing mirrors",
+			repo:    r,
+			mirrors: &testMirrors{err: errors.New("database error")},
+			image:   "alpine",
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &client{Mirrors: test.mirrors, repo: test.repo}
+
+			p := &pipeline.Build{
+				Steps: pipeline.ContainerSlice{
+					{Name: "init", Image: initImage},
+					{Name: "test", Image: test.image},
+				},
+			}
+
+			err := c.mirrorImages(p)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("mirrorImages should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("mirrorImages returned err: %v", err)
+			}
+
+			if p.Steps[0].Image != initImage {
+				t.Errorf("mirrorImages init image is %v, want %v", p.Steps[0].Image, initImage)
+			}
+
+			if p.Steps[1].Image != test.want {
+				t.Errorf("mirrorImages image is %v, want %v", p.Steps[1].Image, test.want)
+			}
+		})
+	}
+}
@@ -5,9 +5,12 @@
 package native
 
 import (
+	"context"
 	"time"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/compiler/expression"
 
 	"github.com/go-vela/server/compiler/registry"
 	"github.com/go-vela/server/compiler/registry/github"
@@ -32,14 +35,39 @@ type client struct {
 	UsePrivateGithub    bool
 	ModificationService ModificationConfig
 	CloneImage          string
+	TemplatePinOrgs     []string
+	ImmutableImageOrgs  []string
+	OrgTemplateRepo     string
+	Deprecations        compiler.TemplateDeprecationService
+	DeprecationRules    compiler.DeprecationRuleService
+	Mirrors             compiler.RegistryMirrorService
+	Egress              compiler.EgressRuleService
+	CloneSettings       compiler.CloneSettingService
+	Variables           compiler.RepoVariableService
 
 	build    *library.Build
+	context  *api.BuildContext
+	ctx      context.Context
 	comment  string
 	files    []string
 	local    bool
 	metadata *types.Metadata
 	repo     *library.Repo
 	user     *library.User
+
+	conditions   []*expression.Condition
+	readiness    map[string]*readiness
+	retries      map[retryKey]*retry
+	egress       map[egressKey]*egress
+	clone        *api.CloneSetting
+	report       *api.CompileReport
+	templates    map[string][]byte
+	images       []string
+	skipped      []*api.StepSkip
+	rules        []*api.DeprecationRule
+	deprecated   []*api.Deprecation
+	orgTemplates *templateCache
+	digests      *digestResolver
 }
 
 // New returns a Pipeline implementation that integrates with the supported registries.
@@ -70,6 +98,21 @@ func New(ctx *cli.Context) (*client, error) {
 	// set the clone image to use for the injected clone step
 	c.CloneImage = ctx.String("clone-image")
 
+	// set the orgs that require templates be pinned to a tag or commit SHA
+	c.TemplatePinOrgs = ctx.StringSlice("template-pin-orgs")
+
+	// set the shared repo in each org for templates and pipeline fragments
+	c.OrgTemplateRepo = ctx.String("org-template-repo")
+	c.orgTemplates = newTemplateCache(ctx.Duration("org-template-cache-duration"))
+
+	// set the orgs that forbid images with mutable tags
+	c.ImmutableImageOrgs = ctx.StringSlice("immutable-image-orgs")
+
+	if ctx.Bool("image-digest-resolution") {
+		// resolve the tags for images to digests while compiling
+		c.digests = newDigestResolver(ctx.Duration("image-digest-cache-duration"))
+	}
+
 	if ctx.Bool("github-driver") {
 		logrus.Tracef("setting up Private GitHub Client for %s", ctx.String("github-url"))
 		// setup private github service
@@ -109,10 +152,32 @@ func (c *client) Duplicate() compiler.Engine {
 	cc.UsePrivateGithub = c.UsePrivateGithub
 	cc.ModificationService = c.ModificationService
 	cc.CloneImage = c.CloneImage
+	cc.TemplatePinOrgs = c.TemplatePinOrgs
+	cc.ImmutableImageOrgs = c.ImmutableImageOrgs
+	cc.Deprecations = c.Deprecations
+	cc.DeprecationRules = c.DeprecationRules
+	cc.Mirrors = c.Mirrors
+	cc.Egress = c.Egress
+	cc.CloneSettings = c.CloneSettings
+	cc.Variables = c.Variables
+	cc.OrgTemplateRepo = c.OrgTemplateRepo
+	cc.orgTemplates = c.orgTemplates
+	cc.digests = c.digests
 
 	return cc
 }
 
+// requestContext is a helper function to capture the context
+// for the requests made while compiling the pipeline, which
+// is the context of the API request when one is provided.
+func (c *client) requestContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
 // WithBuild sets the library build type in the Engine.
 func (c *client) WithBuild(b *library.Build) compiler.Engine {
 	if b != nil {
@@ -122,6 +187,24 @@ func (c *client) WithBuild(b *library.Build) compiler.Engine {
 	return c
 }
 
+// WithBuildContext sets the computed build context in the Engine.
+func (c *client) WithBuildContext(ctx *api.BuildContext) compiler.Engine {
+	if ctx != nil {
+		c.context = ctx
+	}
+
+	return c
+}
+
+// WithCloneSettings sets the service for capturing clone settings in the Engine.
+func (c *client) WithCloneSettings(s compiler.CloneSettingService) compiler.Engine {
+	if s != nil {
+		c.CloneSettings = s
+	}
+
+	return c
+}
+
 // WithComment sets the comment in the Engine.
 func (c *client) WithComment(cmt string) compiler.Engine {
 	if cmt != "" {
@@ -131,6 +214,33 @@ func (c *client) WithComment(cmt string) compiler.Engine {
 	return c
 }
 
+// WithContext sets the context for the requests made by the Engine.
+func (c *client) WithContext(ctx context.Context) compiler.Engine {
+	if ctx != nil {
+		c.ctx = ctx
+	}
+
+	return c
+}
+
+// WithDeprecationRules sets the service for capturing deprecation rules in the Engine.
+func (c *client) WithDeprecationRules(d compiler.DeprecationRuleService) compiler.Engine {
+	if d != nil {
+		c.DeprecationRules = d
+	}
+
+	return c
+}
+
+// WithEgressRules sets the service for capturing egress rules in the Engine.
+func (c *client) WithEgressRules(e compiler.EgressRuleService) compiler.Engine {
+	if e != nil {
+		c.Egress = e
+	}
+
+	return c
+}
+
 // WithFiles sets the changeset files in the Engine.
 func (c *client) WithFiles(f []string) compiler.Engine {
 	if f != nil {
@@ -167,6 +277,15 @@ func (c *client) WithPrivateGitHub(url, token string) compiler.Engine {
 	return c
 }
 
+// WithRegistryMirrors sets the service for capturing registry mirrors in the Engine.
+func (c *client) WithRegistryMirrors(m compiler.RegistryMirrorService) compiler.Engine {
+	if m != nil {
+		c.Mirrors = m
+	}
+
+	return c
+}
+
 // WithRepo sets the library repo type in the Engine.
 func (c *client) WithRepo(r *library.Repo) compiler.Engine {
 	if r != nil {
@@ -176,6 +295,24 @@ func (c *client) WithRepo(r *library.Repo) compiler.Engine {
 	return c
 }
 
+// WithRepoVariables sets the service for capturing repo variables in the Engine.
+func (c *client) WithRepoVariables(v compiler.RepoVariableService) compiler.Engine {
+	if v != nil {
+		c.Variables = v
+	}
+
+	return c
+}
+
+// WithTemplateDeprecations sets the service for capturing template deprecations in the Engine.
+func (c *client) WithTemplateDeprecations(d compiler.TemplateDeprecationService) compiler.Engine {
+	if d != nil {
+		c.Deprecations = d
+	}
+
+	return c
+}
+
 // WithUser sets the library user type in the Engine.
 func (c *client) WithUser(u *library.User) compiler.Engine {
 	if u != nil {
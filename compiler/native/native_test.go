@@ -5,10 +5,12 @@
 package native
 
 import (
+	"context"
 	"flag"
 	"reflect"
 	"testing"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/compiler/registry/github"
 
 	"github.com/go-vela/types"
@@ -23,7 +25,8 @@ func TestNative_New(t *testing.T) {
 	c := cli.NewContext(nil, set, nil)
 	public, _ := github.New("", "")
 	want := &client{
-		Github: public,
+		Github:       public,
+		orgTemplates: newTemplateCache(0),
 	}
 
 	// run test
@@ -53,6 +56,7 @@ func TestNative_New_PrivateGithub(t *testing.T) {
 		Github:           public,
 		PrivateGithub:    private,
 		UsePrivateGithub: true,
+		orgTemplates:     newTemplateCache(0),
 	}
 
 	// run test
@@ -82,6 +86,7 @@ func TestNative_DuplicateRetainSettings(t *testing.T) {
 		Github:           public,
 		PrivateGithub:    private,
 		UsePrivateGithub: true,
+		orgTemplates:     newTemplateCache(0),
 	}
 
 	// run test
@@ -162,6 +167,70 @@ func TestNative_WithFiles(t *testing.T) {
 	}
 }
 
+func TestNative_WithBuildContext(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	ctx := new(api.BuildContext)
+	ctx.SetLabels([]string{"deploy-preview"})
+
+	want, _ := New(c)
+	want.context = ctx
+
+	// run test
+	got, err := New(c)
+	if err != nil {
+		t.Errorf("Unable to create new compiler: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.WithBuildContext(ctx), want) {
+		t.Errorf("WithBuildContext is %v, want %v", got, want)
+	}
+}
+
+func TestNative_WithCloneSettings(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	s := &testCloneSettings{}
+
+	want, _ := New(c)
+	want.CloneSettings = s
+
+	// run test
+	got, err := New(c)
+	if err != nil {
+		t.Errorf("Unable to create new compiler: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.WithCloneSettings(s), want) {
+		t.Errorf("WithCloneSettings is %v, want %v", got, want)
+	}
+}
+
+func TestNative_WithRepoVariables(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	v := &testVariables{}
+
+	want, _ := New(c)
+	want.Variables = v
+
+	// run test
+	got, err := New(c)
+	if err != nil {
+		t.Errorf("Unable to create new compiler: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.WithRepoVariables(v), want) {
+		t.Errorf("WithRepoVariables is %v, want %v", got, want)
+	}
+}
+
 func TestNative_WithComment(t *testing.T) {
 	// setup types
 	set := flag.NewFlagSet("test", 0)
@@ -182,6 +251,36 @@ func TestNative_WithComment(t *testing.T) {
 	}
 }
 
+func TestNative_WithContext(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	want, _ := New(c)
+	want.ctx = ctx
+
+	// run test
+	got, err := New(c)
+	if err != nil {
+		t.Errorf("Unable to create new compiler: %v", err)
+	}
+
+	if got.requestContext() != context.Background() {
+		t.Errorf("requestContext is %v, want %v", got.requestContext(), context.Background())
+	}
+
+	if !reflect.DeepEqual(got.WithContext(ctx), want) {
+		t.Errorf("WithContext is %v, want %v", got, want)
+	}
+
+	if got.requestContext() != ctx {
+		t.Errorf("requestContext is %v, want %v", got.requestContext(), ctx)
+	}
+}
+
 func TestNative_WithLocal(t *testing.T) {
 	// setup types
 	set := flag.NewFlagSet("test", 0)
@@ -289,6 +388,27 @@ func TestNative_WithRepo(t *testing.T) {
 	}
 }
 
+func TestNative_WithTemplateDeprecations(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	d := testDeprecations{}
+
+	want, _ := New(c)
+	want.Deprecations = d
+
+	// run test
+	got, err := New(c)
+	if err != nil {
+		t.Errorf("Unable to create new compiler: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.WithTemplateDeprecations(d), want) {
+		t.Errorf("WithTemplateDeprecations is %v, want %v", got, want)
+	}
+}
+
 func TestNative_WithUser(t *testing.T) {
 	// setup types
 	set := flag.NewFlagSet("test", 0)
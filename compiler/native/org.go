@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/go-vela/server/compiler/registry"
+
+	"github.com/go-vela/types/yaml"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// templateTypeOrg represents the type for templates and pipeline
+	// fragments hosted in the shared repo for the org of the repo.
+	templateTypeOrg = "org"
+
+	// orgTemplateDir represents the directory in the shared repo for the
+	// org that templates referenced without a declaration resolve from.
+	orgTemplateDir = "templates"
+)
+
+// orgTemplatesEnabled is a helper function to determine if templates
+// can be resolved from the shared repo for the org of the repo.
+func (c *client) orgTemplatesEnabled() bool {
+	return len(c.OrgTemplateRepo) > 0 && len(c.repo.GetOrg()) > 0
+}
+
+// implicitTemplate is a helper function to create the template for a
+// step that references a template the pipeline does not declare. The
+// template resolves from the templates directory of the shared repo for
+// the org, e.g. <org>/.vela/templates/<name>.yml.
+func implicitTemplate(name string) *yaml.Template {
+	return &yaml.Template{
+		Name:   name,
+		Source: path.Join(orgTemplateDir, name+".yml"),
+		Type:   templateTypeOrg,
+	}
+}
+
+// orgSource is a helper function to create the registry source for a
+// template hosted in the shared repo for the org of the repo.
+//
+// The source for the template is the path to the template within the
+// shared repo, optionally followed by a reference, e.g. go.yml@v1.0.0.
+func (c *client) orgSource(tmpl *yaml.Template) (*registry.Source, error) {
+	if !c.orgTemplatesEnabled() {
+		return nil, fmt.Errorf("unable to resolve template %s: org templates are not enabled", tmpl.Name)
+	}
+
+	name, ref, _ := strings.Cut(tmpl.Source, "@")
+
+	// clean the path to prevent escaping the shared repo
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if len(name) == 0 || name == "." {
+		return nil, fmt.Errorf("invalid template source provided for %s: no path provided", tmpl.Name)
+	}
+
+	// templates are only resolved from the org the repo belongs to
+	return &registry.Source{
+		Org:  c.repo.GetOrg(),
+		Repo: c.OrgTemplateRepo,
+		Name: name,
+		Ref:  ref,
+	}, nil
+}
+
+// getOrgTemplate is a helper function to capture a template hosted in the
+// shared repo for the org of the repo. Templates are cached between
+// compilations since every repo in the org references the same repo.
+func (c *client) getOrgTemplate(tmpl *yaml.Template, name string) ([]byte, error) {
+	src, err := c.orgSource(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	// use the same registry as the repo so private shared repos are accessible
+	r := c.Github
+	if c.UsePrivateGithub {
+		r = c.PrivateGithub
+	}
+
+	err = c.enforceTemplatePolicy(r, c.user, src, name)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s/%s/%s@%s", src.Org, src.Repo, src.Name, src.Ref)
+
+	bytes, ok := c.orgTemplates.get(key)
+	if ok {
+		return bytes, nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"org":  src.Org,
+		"repo": src.Repo,
+		"path": src.Name,
+	}).Tracef("Using authenticated GitHub client to pull org template")
+
+	// pull with the token for the user so access to the shared repo is enforced
+	bytes, err = r.Template(c.user, src)
+	if err != nil {
+		return nil, err
+	}
+
+	c.orgTemplates.set(key, bytes)
+
+	return bytes, nil
+}
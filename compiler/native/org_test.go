@@ -0,0 +1,189 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/compiler/registry"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/yaml"
+	"github.com/urfave/cli/v2"
+)
+
+func TestNative_ExpandStepsOrg(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	fetches := map[string]int{}
+
+	// setup mock server
+	engine.GET("/api/v3/repos/octocat/.vela/contents/*path", func(c *gin.Context) {
+		fetches[c.Param("path")]++
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/template.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("github-driver", true, "doc")
+	set.String("github-url", s.URL, "doc")
+	set.String("github-token", "", "doc")
+	set.String("org-template-repo", ".vela", "doc")
+	set.Duration("org-template-cache-duration", time.Minute, "doc")
+	c := cli.NewContext(nil, set, nil)
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+
+	variables := map[string]interface{}{
+		"image":       "openjdk:latest",
+		"environment": "{ GRADLE_USER_HOME: .gradle }",
+		"pull_policy": "pull: true",
+	}
+
+	tests := []struct {
+		name  string
+		tmpls map[string]*yaml.Template
+		want  string
+	}{
+		{
+			name: "declared",
+			tmpls: map[string]*yaml.Template{
+				"gradle": {
+					Name:   "gradle",
+					Source: "shared/gradle.yml",
+					Type:   "org",
+				},
+			},
+			want: "/shared/gradle.yml",
+		},
+		{
+			name:  "undeclared",
+			tmpls: map[string]*yaml.Template{},
+			want:  "/templates/gradle.yml",
+		},
+	}
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating new compiler returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// compile the pipeline twice to verify the template is cached between compilations
+			for i := 0; i < 2; i++ {
+				steps := yaml.StepSlice{
+					&yaml.Step{
+						Name: "sample",
+						Template: yaml.StepTemplate{
+							Name:      "gradle",
+							Variables: variables,
+						},
+					},
+				}
+
+				build, err := compiler.Duplicate().WithRepo(r).ExpandSteps(&yaml.Build{Steps: steps, Services: yaml.ServiceSlice{}}, test.tmpls)
+				if err != nil {
+					t.Errorf("ExpandSteps returned err: %v", err)
+				}
+
+				if len(build.Steps) != 3 {
+					t.Errorf("ExpandSteps returned %d steps, want 3", len(build.Steps))
+				}
+			}
+
+			if fetches[test.want] != 1 {
+				t.Errorf("ExpandSteps fetched %s %d times, want 1", test.want, fetches[test.want])
+			}
+		})
+	}
+}
+
+func TestNative_orgSource(t *testing.T) {
+	// setup types
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+
+	tests := []struct {
+		name    string
+		repo    string
+		source  string
+		want    *registry.Source
+		wantErr bool
+	}{
+		{
+			name:   "path",
+			repo:   ".vela",
+			source: "go.yml",
+			want:   &registry.Source{Org: "octocat", Repo: ".vela", Name: "go.yml"},
+		},
+		{
+			name:   "path with ref",
+			repo:   ".vela",
+			source: "templates/go.yml@v1.0.0",
+			want:   &registry.Source{Org: "octocat", Repo: ".vela", Name: "templates/go.yml", Ref: "v1.0.0"},
+		},
+		{
+			name:   "path escaping repo",
+			repo:   ".vela",
+			source: "../../other/repo/go.yml",
+			want:   &registry.Source{Org: "octocat", Repo: ".vela", Name: "other/repo/go.yml"},
+		},
+		{
+			name:    "no path",
+			repo:    ".vela",
+			source:  "@v1.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "disabled",
+			source:  "go.yml",
+			wantErr: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &client{OrgTemplateRepo: test.repo, repo: r}
+
+			got, err := c.orgSource(&yaml.Template{Name: "go", Source: test.source, Type: "org"})
+
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("orgSource should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("orgSource returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("orgSource is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/compiler/registry"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// pinAllOrgs represents the value for requiring
+// every org to pin the templates they reference.
+const pinAllOrgs = "*"
+
+// requiresPinnedTemplates is a helper function to determine if the
+// org for the repo must pin templates to a tag or commit SHA.
+func (c *client) requiresPinnedTemplates() bool {
+	for _, org := range c.TemplatePinOrgs {
+		if org == pinAllOrgs || strings.EqualFold(org, c.repo.GetOrg()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// enforceTemplatePolicy is a helper function to verify the template source
+// satisfies the pinning policy for the org and to record a warning in the
+// report when the owners of the template have deprecated the version.
+func (c *client) enforceTemplatePolicy(r registry.Service, u *library.User, src *registry.Source, name string) error {
+	if c.requiresPinnedTemplates() {
+		pinned, err := r.Pinned(u, src)
+		if err != nil {
+			return fmt.Errorf("unable to verify ref for template %s: %w", name, err)
+		}
+
+		if !pinned {
+			return fmt.Errorf("template %s must be pinned to a tag or commit SHA for org %s", name, c.repo.GetOrg())
+		}
+	}
+
+	if c.Deprecations == nil {
+		return nil
+	}
+
+	deprecations, err := c.Deprecations.ListTemplateDeprecationsForRepo(c.requestContext(), src.Org, src.Repo)
+	if err != nil {
+		// a failure to capture deprecations should not fail the compilation
+		logrus.Errorf("unable to list deprecations for template %s: %v", name, err)
+
+		return nil
+	}
+
+	for _, d := range deprecations {
+		if !strings.EqualFold(d.GetPath(), src.Name) {
+			continue
+		}
+
+		// deprecations without a ref apply to every version of the template
+		if len(d.GetRef()) > 0 && d.GetRef() != src.Ref {
+			continue
+		}
+
+		version := src.Ref
+		if len(version) == 0 {
+			version = "default branch"
+		}
+
+		c.recordWarning(fmt.Sprintf("template %s (%s/%s/%s@%s) is deprecated: %s",
+			name, src.Org, src.Repo, src.Name, version, d.GetMessage()))
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/yaml"
+	"github.com/urfave/cli/v2"
+)
+
+func TestNative_EnforceTemplatePolicy(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/contents/:path", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/template.json")
+	})
+	engine.GET("/api/v3/repos/foo/bar/git/ref/tags/:tag", func(c *gin.Context) {
+		if c.Param("tag") != "v1.0.0" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "Not Found"})
+
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"ref": "refs/tags/v1.0.0"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("github-driver", true, "doc")
+	set.String("github-url", s.URL, "doc")
+	set.String("github-token", "", "doc")
+	c := cli.NewContext(nil, set, nil)
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("hello-world")
+
+	d := new(api.TemplateDeprecation)
+	d.SetOrg("foo")
+	d.SetRepo("bar")
+	d.SetPath("template.yml")
+	d.SetRef("v1.0.0")
+	d.SetMessage("use v2.0.0 instead")
+
+	tests := []struct {
+		name         string
+		ref          string
+		pinOrgs      []string
+		deprecations testDeprecations
+		wantErr      bool
+		wantWarnings []string
+	}{
+		{
+			name:         "no policy",
+			ref:          "main",
+			wantWarnings: []string{},
+		},
+		{
+			name:         "pinned to branch",
+			ref:          "main",
+			pinOrgs:      []string{"octocat"},
+			wantErr:      true,
+			wantWarnings: []string{},
+		},
+		{
+			name:         "unpinned for all orgs",
+			pinOrgs:      []string{"*"},
+			wantErr:      true,
+			wantWarnings: []string{},
+		},
+		{
+			name:         "pinned to tag",
+			ref:          "v1.0.0",
+			pinOrgs:      []string{"octocat"},
+			wantWarnings: []string{},
+		},
+		{
+			name:         "deprecated version",
+			ref:          "v1.0.0",
+			deprecations: testDeprecations{d},
+			wantWarnings: []string{
+				"template gradle (foo/bar/template.yml@v1.0.0) is deprecated: use v2.0.0 instead",
+			},
+		},
+		{
+			name:         "other version",
+			ref:          "v2.0.0",
+			deprecations: testDeprecations{d},
+			wantWarnings: []string{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source := "github.example.com/foo/bar/template.yml"
+			if len(test.ref) > 0 {
+				source += "@" + test.ref
+			}
+
+			tmpls := map[string]*yaml.Template{
+				"gradle": {
+					Name:   "gradle",
+					Source: source,
+					Type:   "github",
+				},
+			}
+
+			steps := yaml.StepSlice{
+				&yaml.Step{
+					Name: "sample",
+					Template: yaml.StepTemplate{
+						Name: "gradle",
+						Variables: map[string]interface{}{
+							"image":       "openjdk:latest",
+							"environment": "{ GRADLE_USER_HOME: .gradle }",
+							"pull_policy": "pull: true",
+						},
+					},
+				},
+			}
+
+			compiler, err := New(c)
+			if err != nil {
+				t.Errorf("Creating new compiler returned err: %v", err)
+			}
+
+			compiler.TemplatePinOrgs = test.pinOrgs
+			compiler.WithRepo(r).WithTemplateDeprecations(test.deprecations)
+			compiler.startReport()
+
+			_, err = compiler.ExpandSteps(&yaml.Build{Steps: steps, Services: yaml.ServiceSlice{}}, tmpls)
+
+			if test.wantErr && err == nil {
+				t.Errorf("ExpandSteps should have returned err")
+			}
+
+			if !test.wantErr && err != nil {
+				t.Errorf("ExpandSteps returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(compiler.Report().GetWarnings(), test.wantWarnings) {
+				t.Errorf("Warnings are %v, want %v", compiler.Report().GetWarnings(), test.wantWarnings)
+			}
+		})
+	}
+}
+
+// testDeprecations represents a fake service
+// for capturing template deprecations.
+type testDeprecations []*api.TemplateDeprecation
+
+// ListTemplateDeprecationsForRepo returns the deprecations for the org and repo.
+func (d testDeprecations) ListTemplateDeprecationsForRepo(_ context.Context, org, repo string) ([]*api.TemplateDeprecation, error) {
+	deprecations := []*api.TemplateDeprecation{}
+
+	for _, deprecation := range d {
+		if deprecation.GetOrg() == org && deprecation.GetRepo() == repo {
+			deprecations = append(deprecations, deprecation)
+		}
+	}
+
+	return deprecations, nil
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buildkite/yaml"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/pipeline"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// readinessCommand represents the environment variable for the
+	// command the worker runs in the service to check its readiness.
+	readinessCommand = "VELA_SERVICE_READINESS_COMMAND"
+
+	// readinessTCP represents the environment variable for the
+	// port the worker connects to on the service to check its readiness.
+	readinessTCP = "VELA_SERVICE_READINESS_TCP"
+
+	// readinessHTTP represents the environment variable for the
+	// URL the worker requests from the service to check its readiness.
+	readinessHTTP = "VELA_SERVICE_READINESS_HTTP"
+
+	// readinessInterval represents the environment variable for
+	// the duration the worker waits between readiness checks.
+	readinessInterval = "VELA_SERVICE_READINESS_INTERVAL"
+
+	// readinessTimeout represents the environment variable for the duration
+	// the worker waits for the service to become ready before failing the build.
+	readinessTimeout = "VELA_SERVICE_READINESS_TIMEOUT"
+
+	// readinessRetries represents the environment variable for the
+	// number of failed readiness checks before failing the build.
+	readinessRetries = "VELA_SERVICE_READINESS_RETRIES"
+
+	// defaultReadinessInterval represents the default duration between readiness checks.
+	defaultReadinessInterval = 2 * time.Second
+
+	// defaultReadinessTimeout represents the default duration to wait for a service to become ready.
+	defaultReadinessTimeout = time.Minute
+
+	// maxReadinessTimeout represents the longest duration a build can wait for a service to become ready.
+	maxReadinessTimeout = 30 * time.Minute
+)
+
+type (
+	// readinessConfig represents the subset of a pipeline
+	// configuration that can declare readiness checks.
+	readinessConfig struct {
+		Services []*readinessService `yaml:"services"`
+	}
+
+	// readinessService represents the subset of a
+	// service that can declare a readiness check.
+	readinessService struct {
+		Name      string     `yaml:"name"`
+		Readiness *readiness `yaml:"readiness"`
+	}
+
+	// readiness represents the check the worker must satisfy
+	// for a service before the steps of the build start.
+	readiness struct {
+		Command  string `yaml:"command"`
+		TCP      int    `yaml:"tcp"`
+		HTTP     string `yaml:"http"`
+		Interval string `yaml:"interval"`
+		Timeout  string `yaml:"timeout"`
+		Retries  int    `yaml:"retries"`
+	}
+)
+
+// captureReadiness captures and validates the readiness
+// checks declared on the services of the provided pipeline
+// configuration.
+//
+// Readiness checks can only be captured from yaml pipelines since
+// the raw configuration for other pipeline types is a template.
+func (c *client) captureReadiness(data []byte, pipelineType string) error {
+	c.readiness = nil
+
+	if pipelineType != constants.PipelineTypeYAML && pipelineType != "" {
+		return nil
+	}
+
+	cfg := new(readinessConfig)
+
+	err := yaml.Unmarshal(data, cfg)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal yaml: %w", err)
+	}
+
+	var result error
+
+	checks := make(map[string]*readiness)
+
+	for _, service := range cfg.Services {
+		if service == nil || service.Readiness == nil {
+			continue
+		}
+
+		err = service.Readiness.validate()
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("invalid readiness for service %s: %w", service.Name, err))
+
+			continue
+		}
+
+		checks[service.Name] = service.Readiness
+	}
+
+	if result != nil {
+		return result
+	}
+
+	c.readiness = checks
+
+	return nil
+}
+
+// validate verifies the readiness check declares exactly one
+// probe and the timing for the check is within the limits.
+func (r *readiness) validate() error {
+	probes := 0
+
+	if len(strings.TrimSpace(r.Command)) > 0 {
+		probes++
+	}
+
+	if r.TCP != 0 {
+		probes++
+
+		if r.TCP < 1 || r.TCP > 65535 {
+			return fmt.Errorf("tcp port %d is not between 1 and 65535", r.TCP)
+		}
+	}
+
+	if len(r.HTTP) > 0 {
+		probes++
+
+		u, err := url.Parse(r.HTTP)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("http check %s is not a valid http or https URL", r.HTTP)
+		}
+	}
+
+	if probes != 1 {
+		return fmt.Errorf("exactly one of command, tcp or http must be provided")
+	}
+
+	interval, err := r.interval()
+	if err != nil {
+		return err
+	}
+
+	timeout, err := r.timeout()
+	if err != nil {
+		return err
+	}
+
+	if timeout < interval {
+		return fmt.Errorf("timeout %s is shorter than interval %s", timeout, interval)
+	}
+
+	if timeout > maxReadinessTimeout {
+		return fmt.Errorf("timeout %s exceeds the maximum of %s", timeout, maxReadinessTimeout)
+	}
+
+	if r.Retries < 0 {
+		return fmt.Errorf("retries must not be negative")
+	}
+
+	return nil
+}
+
+// interval returns the duration between readiness checks.
+func (r *readiness) interval() (time.Duration, error) {
+	return parseReadinessDuration("interval", r.Interval, defaultReadinessInterval)
+}
+
+// timeout returns the duration to wait for the service to become ready.
+func (r *readiness) timeout() (time.Duration, error) {
+	return parseReadinessDuration("timeout", r.Timeout, defaultReadinessTimeout)
+}
+
+// parseReadinessDuration is a helper function to parse a positive
+// duration for a readiness check, returning the provided default
+// when the duration isn't set.
+func parseReadinessDuration(name, value string, def time.Duration) (time.Duration, error) {
+	if len(value) == 0 {
+		return def, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %s: %w", name, value, err)
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("%s %s must be positive", name, value)
+	}
+
+	return d, nil
+}
+
+// applyReadiness injects the captured readiness checks into the
+// environment of the services in the executable pipeline so the
+// worker can wait for them before starting the steps.
+func (c *client) applyReadiness(p *pipeline.Build) {
+	for _, ctn := range p.Services {
+		r, ok := c.readiness[ctn.Name]
+		if !ok {
+			continue
+		}
+
+		if ctn.Environment == nil {
+			ctn.Environment = make(map[string]string)
+		}
+
+		// the durations were verified when the checks were captured
+		interval, _ := r.interval()
+		timeout, _ := r.timeout()
+
+		switch {
+		case len(strings.TrimSpace(r.Command)) > 0:
+			ctn.Environment[readinessCommand] = r.Command
+		case r.TCP != 0:
+			ctn.Environment[readinessTCP] = strconv.Itoa(r.TCP)
+		default:
+			ctn.Environment[readinessHTTP] = r.HTTP
+		}
+
+		ctn.Environment[readinessInterval] = interval.String()
+		ctn.Environment[readinessTimeout] = timeout.String()
+
+		if r.Retries > 0 {
+			ctn.Environment[readinessRetries] = strconv.Itoa(r.Retries)
+		}
+	}
+}
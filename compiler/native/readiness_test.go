@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/pipeline"
+
+	"github.com/urfave/cli/v2"
+)
+
+func TestNative_captureReadiness(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+		want    map[string]*readiness
+	}{
+		{
+			name: "command",
+			data: `
+version: "1"
+services:
+  - name: postgres
+    image: postgres:15
+    readiness:
+      command: pg_isready -U postgres
+      interval: 1s
+      timeout: 30s
+  - name: redis
+    image: redis:7
+`,
+			want: map[string]*readiness{
+				"postgres": {Command: "pg_isready -U postgres", Interval: "1s", Timeout: "30s"},
+			},
+		},
+		{
+			name: "tcp and http",
+			data: `
+version: "1"
+services:
+  - name: redis
+    image: redis:7
+    readiness:
+      tcp: 6379
+  - name: api
+    image: octocat/api:v1
+    readiness:
+      http: http://localhost:8080/healthz
+      retries: 5
+`,
+			want: map[string]*readiness{
+				"redis": {TCP: 6379},
+				"api":   {HTTP: "http://localhost:8080/healthz", Retries: 5},
+			},
+		},
+		{
+			name: "no probe",
+			data: `
+version: "1"
+services:
+  - name: redis
+    image: redis:7
+    readiness:
+      interval: 1s
+`,
+			failure: true,
+		},
+		{
+			name: "multiple probes",
+			data: `
+version: "1"
+services:
+  - name: redis
+    image: redis:7
+    readiness:
+      tcp: 6379
+      command: redis-cli ping
+`,
+			failure: true,
+		},
+		{
+			name: "invalid port",
+			data: `
+version: "1"
+services:
+  - name: redis
+    image: redis:7
+    readiness:
+      tcp: 70000
+`,
+			failure: true,
+		},
+		{
+			name: "invalid url",
+			data: `
+version: "1"
+services:
+  - name: api
+    image: octocat/api:v1
+    readiness:
+      http: localhost:8080
+`,
+			failure: true,
+		},
+		{
+			name: "timeout shorter than interval",
+			data: `
+version: "1"
+services:
+  - name: redis
+    image: redis:7
+    readiness:
+      tcp: 6379
+      interval: 10s
+      timeout: 5s
+`,
+			failure: true,
+		},
+		{
+			name: "timeout exceeds maximum",
+			data: `
+version: "1"
+services:
+  - name: redis
+    image: redis:7
+    readiness:
+      tcp: 6379
+      timeout: 2h
+`,
+			failure: true,
+		},
+		{
+			name: "invalid interval",
+			data: `
+version: "1"
+services:
+  - name: redis
+    image: redis:7
+    readiness:
+      tcp: 6379
+      interval: soon
+`,
+			failure: true,
+		},
+	}
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := compiler.captureReadiness([]byte(test.data), "yaml")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("captureReadiness should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("captureReadiness returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(compiler.readiness, test.want) {
+				t.Errorf("captureReadiness is %v, want %v", compiler.readiness, test.want)
+			}
+		})
+	}
+}
+
+func TestNative_applyReadiness(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.readiness = map[string]*readiness{
+		"postgres": {Command: "pg_isready", Timeout: "30s"},
+		"api":      {HTTP: "http://localhost:8080/healthz", Interval: "5s", Retries: 3},
+	}
+
+	p := &pipeline.Build{
+		Services: pipeline.ContainerSlice{
+			{Name: "postgres", Image: "postgres:15", Environment: map[string]string{"POSTGRES_DB": "vela"}},
+			{Name: "api", Image: "octocat/api:v1"},
+			{Name: "redis", Image: "redis:7"},
+		},
+	}
+
+	want := []map[string]string{
+		{
+			"POSTGRES_DB":     "vela",
+			readinessCommand:  "pg_isready",
+			readinessInterval: "2s",
+			readinessTimeout:  "30s",
+		},
+		{
+			readinessHTTP:     "http://localhost:8080/healthz",
+			readinessInterval: "5s",
+			readinessTimeout:  "1m0s",
+			readinessRetries:  "3",
+		},
+		nil,
+	}
+
+	// run test
+	compiler.applyReadiness(p)
+
+	for i, ctn := range p.Services {
+		if !reflect.DeepEqual(ctn.Environment, want[i]) {
+			t.Errorf("applyReadiness environment for %s is %v, want %v", ctn.Name, ctn.Environment, want[i])
+		}
+	}
+}
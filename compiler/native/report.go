@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"time"
+
+	yml "github.com/buildkite/yaml"
+
+	api "github.com/go-vela/server/api/types"
+
+	"github.com/go-vela/types/yaml"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	compileDuration = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "vela_compile_duration_seconds",
+			Help:    "The time taken to compile the pipeline for a build.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+		},
+	)
+
+	compileExpansion = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "vela_compile_expansion_bytes",
+			Help:    "The size of the pipeline for a build after templates are expanded.",
+			Buckets: prometheus.ExponentialBuckets(1024, 4, 8),
+		},
+	)
+
+	templateFetches = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "vela_compile_template_fetches_total",
+			Help: "The number of templates fetched from their source while compiling pipelines.",
+		},
+	)
+
+	templateCacheHits = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "vela_compile_template_cache_hits_total",
+			Help: "The number of template references resolved from the cache while compiling pipelines.",
+		},
+	)
+)
+
+// Report returns the report for the most recent compilation.
+func (c *client) Report() *api.CompileReport {
+	return c.report
+}
+
+// startReport is a helper function to reset the report, template cache,
+// pinned images, skipped steps and deprecations for a new compilation.
+func (c *client) startReport() {
+	c.report = new(api.CompileReport)
+	c.templates = make(map[string][]byte)
+	c.images = nil
+	c.skipped = nil
+	c.rules = nil
+	c.deprecated = nil
+
+	c.report.SetRepo(c.repo.GetFullName())
+	c.report.SetNumber(c.build.GetNumber())
+	c.report.SetTemplates(0)
+	c.report.SetTemplateFetches(0)
+	c.report.SetTemplateCacheHits(0)
+}
+
+// finishReport is a helper function to complete the report
+// for a compilation that began at the provided time and
+// record the measurements in the compile metrics.
+func (c *client) finishReport(start time.Time) {
+	duration := time.Since(start)
+
+	c.report.SetDuration(duration.Milliseconds())
+	c.report.SetTemplates(len(c.templates))
+	c.report.SetCreated(time.Now().UTC().Unix())
+
+	hits := c.report.GetTemplateCacheHits()
+	total := hits + c.report.GetTemplateFetches()
+
+	if total > 0 {
+		c.report.SetCacheHitRate(float64(hits) / float64(total))
+	}
+
+	compileDuration.Observe(duration.Seconds())
+
+	if c.report.GetExpandedSize() > 0 {
+		compileExpansion.Observe(float64(c.report.GetExpandedSize()))
+	}
+}
+
+// recordExpansion is a helper function to capture the
+// size of the pipeline after templates are expanded.
+func (c *client) recordExpansion(p *yaml.Build) {
+	if c.report == nil {
+		return
+	}
+
+	data, err := yml.Marshal(p)
+	if err != nil {
+		return
+	}
+
+	c.report.SetExpandedSize(int64(len(data)))
+}
+
+// cachedTemplate is a helper function to capture the template
+// from the cache for the compilation if it was already fetched.
+func (c *client) cachedTemplate(key string) ([]byte, bool) {
+	if c.templates == nil {
+		return nil, false
+	}
+
+	bytes, ok := c.templates[key]
+	if ok {
+		c.report.SetTemplateCacheHits(c.report.GetTemplateCacheHits() + 1)
+		templateCacheHits.Inc()
+	}
+
+	return bytes, ok
+}
+
+// cacheTemplate is a helper function to store a fetched
+// template in the cache for the compilation.
+func (c *client) cacheTemplate(key string, bytes []byte) {
+	if c.templates == nil {
+		return
+	}
+
+	c.templates[key] = bytes
+
+	c.report.SetTemplateFetches(c.report.GetTemplateFetches() + 1)
+	templateFetches.Inc()
+}
+
+// recordWarning is a helper function to attach
+// a warning for the compilation to the report.
+func (c *client) recordWarning(warning string) {
+	logrus.Warn(warning)
+
+	if c.report == nil {
+		return
+	}
+
+	c.report.SetWarnings(append(c.report.GetWarnings(), warning))
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+	"github.com/urfave/cli/v2"
+)
+
+func TestNative_Report(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	fetches := 0
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/contents/:path", func(c *gin.Context) {
+		fetches++
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/template.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("github-driver", true, "doc")
+	set.String("github-url", s.URL, "doc")
+	set.String("github-token", "", "doc")
+	set.String("clone-image", defaultCloneImage, "doc")
+	c := cli.NewContext(nil, set, nil)
+
+	r := new(library.Repo)
+	r.SetFullName("foo/bar")
+
+	b := new(library.Build)
+	b.SetNumber(1)
+
+	yaml, err := os.ReadFile("testdata/steps_pipeline_template_reused.yml")
+	if err != nil {
+		t.Errorf("Reading yaml file return err: %v", err)
+	}
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	// run test
+	if compiler.Report() != nil {
+		t.Errorf("Report should be nil before compiling")
+	}
+
+	_, _, err = compiler.WithRepo(r).WithBuild(b).Compile(yaml)
+	if err != nil {
+		t.Errorf("Compile returned err: %v", err)
+	}
+
+	got := compiler.Report()
+
+	if fetches != 1 {
+		t.Errorf("Compile fetched the template %d times, want 1", fetches)
+	}
+
+	if got.GetRepo() != "foo/bar" || got.GetNumber() != 1 {
+		t.Errorf("Report is for %s/%d, want foo/bar/1", got.GetRepo(), got.GetNumber())
+	}
+
+	if got.GetTemplates() != 1 {
+		t.Errorf("Report templates is %d, want 1", got.GetTemplates())
+	}
+
+	if got.GetTemplateFetches() != 1 {
+		t.Errorf("Report template fetches is %d, want 1", got.GetTemplateFetches())
+	}
+
+	if got.GetTemplateCacheHits() != 1 {
+		t.Errorf("Report template cache hits is %d, want 1", got.GetTemplateCacheHits())
+	}
+
+	if got.GetCacheHitRate() != 0.5 {
+		t.Errorf("Report cache hit rate is %v, want 0.5", got.GetCacheHitRate())
+	}
+
+	if got.GetConfigSize() != int64(len(yaml)) {
+		t.Errorf("Report config size is %d, want %d", got.GetConfigSize(), len(yaml))
+	}
+
+	if got.GetExpandedSize() == 0 {
+		t.Errorf("Report expanded size is 0, want greater than 0")
+	}
+
+	if got.GetCreated() == 0 {
+		t.Errorf("Report created is 0, want greater than 0")
+	}
+}
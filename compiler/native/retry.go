@@ -0,0 +1,225 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buildkite/yaml"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/pipeline"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	// retryAttempts represents the environment variable for the
+	// maximum number of times the worker runs the step.
+	retryAttempts = "VELA_STEP_RETRY_ATTEMPTS"
+
+	// retryBackoff represents the environment variable for the
+	// duration the worker waits between attempts of the step.
+	retryBackoff = "VELA_STEP_RETRY_BACKOFF"
+
+	// retryOn represents the environment variable for the comma
+	// separated outcomes of an attempt the worker retries the step on.
+	retryOn = "VELA_STEP_RETRY_ON"
+
+	// retryOnFailure represents retrying a step when it exits with a non-zero code.
+	retryOnFailure = "failure"
+
+	// retryOnTimeout represents retrying a step when it exceeds its timeout.
+	retryOnTimeout = "timeout"
+
+	// maxRetryAttempts represents the most attempts a step can declare.
+	maxRetryAttempts = 10
+
+	// maxRetryBackoff represents the longest backoff a step can declare.
+	maxRetryBackoff = 10 * time.Minute
+)
+
+type (
+	// retryConfig represents the subset of a pipeline
+	// configuration that can declare retry policies.
+	retryConfig struct {
+		Stages yaml.MapSlice `yaml:"stages"`
+		Steps  []*retryStep  `yaml:"steps"`
+	}
+
+	// retryStage represents the subset of a stage
+	// that can declare retry policies.
+	retryStage struct {
+		Steps []*retryStep `yaml:"steps"`
+	}
+
+	// retryStep represents the subset of a step
+	// that can declare a retry policy.
+	retryStep struct {
+		Name  string `yaml:"name"`
+		Retry *retry `yaml:"retry"`
+	}
+
+	// retry represents the policy the worker follows
+	// to run a step again after an attempt fails.
+	retry struct {
+		Attempts int      `yaml:"attempts"`
+		Backoff  string   `yaml:"backoff"`
+		On       []string `yaml:"on"`
+	}
+
+	// retryKey represents the stage and step a retry policy is declared for.
+	retryKey struct {
+		stage string
+		step  string
+	}
+)
+
+// captureRetries captures and validates the retry policies
+// declared on the steps of the provided pipeline configuration.
+//
+// Retry policies can only be captured from yaml pipelines since
+// the raw configuration for other pipeline types is a template.
+func (c *client) captureRetries(data []byte, pipelineType string) error {
+	c.retries = nil
+
+	if pipelineType != constants.PipelineTypeYAML && pipelineType != "" {
+		return nil
+	}
+
+	cfg := new(retryConfig)
+
+	err := yaml.Unmarshal(data, cfg)
+	if err != nil {
+		return fmt.Errorf("unable to unmarshal yaml: %w", err)
+	}
+
+	var result error
+
+	retries := make(map[retryKey]*retry)
+
+	capture := func(stage string, steps []*retryStep) {
+		for _, step := range steps {
+			if step == nil || step.Retry == nil {
+				continue
+			}
+
+			err := step.Retry.validate()
+			if err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid retry for %s: %w", conditionName(stage, step.Name), err))
+
+				continue
+			}
+
+			retries[retryKey{stage: stage, step: step.Name}] = step.Retry
+		}
+	}
+
+	for _, item := range cfg.Stages {
+		name := fmt.Sprint(item.Key)
+
+		// marshal the stage back to yaml to capture the retry policies
+		out, err := yaml.Marshal(item.Value)
+		if err != nil {
+			return fmt.Errorf("unable to marshal stage %s: %w", name, err)
+		}
+
+		s := new(retryStage)
+
+		err = yaml.Unmarshal(out, s)
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal stage %s: %w", name, err)
+		}
+
+		capture(name, s.Steps)
+	}
+
+	capture("", cfg.Steps)
+
+	if result != nil {
+		return result
+	}
+
+	c.retries = retries
+
+	return nil
+}
+
+// validate verifies the attempts and backoff for the retry
+// policy are within the limits and the outcomes are supported.
+func (r *retry) validate() error {
+	if r.Attempts < 1 || r.Attempts > maxRetryAttempts {
+		return fmt.Errorf("attempts %d is not between 1 and %d", r.Attempts, maxRetryAttempts)
+	}
+
+	if len(r.Backoff) > 0 {
+		backoff, err := time.ParseDuration(r.Backoff)
+		if err != nil {
+			return fmt.Errorf("invalid backoff %s: %w", r.Backoff, err)
+		}
+
+		if backoff < 0 || backoff > maxRetryBackoff {
+			return fmt.Errorf("backoff %s is not between 0s and %s", r.Backoff, maxRetryBackoff)
+		}
+	}
+
+	for _, on := range r.On {
+		if on != retryOnFailure && on != retryOnTimeout {
+			return fmt.Errorf("unsupported outcome %s: must be %s or %s", on, retryOnFailure, retryOnTimeout)
+		}
+	}
+
+	return nil
+}
+
+// on returns the outcomes the step is retried on,
+// defaulting to retrying the step on failure.
+func (r *retry) on() []string {
+	if len(r.On) == 0 {
+		return []string{retryOnFailure}
+	}
+
+	return r.On
+}
+
+// applyRetries injects the captured retry policies into the
+// environment of the steps in the executable pipeline so the
+// worker can run the steps again after a failed attempt.
+func (c *client) applyRetries(p *pipeline.Build) {
+	if len(c.retries) == 0 {
+		return
+	}
+
+	for _, ctn := range p.Steps {
+		c.applyRetry("", ctn)
+	}
+
+	for _, stage := range p.Stages {
+		for _, ctn := range stage.Steps {
+			c.applyRetry(stage.Name, ctn)
+		}
+	}
+}
+
+// applyRetry is a helper function to inject the retry
+// policy for the stage and step into the container.
+func (c *client) applyRetry(stage string, ctn *pipeline.Container) {
+	r, ok := c.retries[retryKey{stage: stage, step: ctn.Name}]
+	if !ok {
+		return
+	}
+
+	if ctn.Environment == nil {
+		ctn.Environment = make(map[string]string)
+	}
+
+	ctn.Environment[retryAttempts] = strconv.Itoa(r.Attempts)
+	ctn.Environment[retryOn] = strings.Join(r.on(), ",")
+
+	if len(r.Backoff) > 0 {
+		ctn.Environment[retryBackoff] = r.Backoff
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/pipeline"
+
+	"github.com/urfave/cli/v2"
+)
+
+func TestNative_captureRetries(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		data    string
+		failure bool
+		want    map[retryKey]*retry
+	}{
+		{
+			name: "steps",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: golang:1.19
+    retry:
+      attempts: 3
+      backoff: 10s
+      on: [ failure, timeout ]
+  - name: build
+    image: golang:1.19
+`,
+			want: map[retryKey]*retry{
+				{step: "test"}: {Attempts: 3, Backoff: "10s", On: []string{"failure", "timeout"}},
+			},
+		},
+		{
+			name: "stages",
+			data: `
+version: "1"
+stages:
+  test:
+    steps:
+      - name: integration
+        image: golang:1.19
+        retry:
+          attempts: 2
+`,
+			want: map[retryKey]*retry{
+				{stage: "test", step: "integration"}: {Attempts: 2},
+			},
+		},
+		{
+			name: "too many attempts",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: golang:1.19
+    retry:
+      attempts: 50
+`,
+			failure: true,
+		},
+		{
+			name: "no attempts",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: golang:1.19
+    retry:
+      backoff: 5s
+`,
+			failure: true,
+		},
+		{
+			name: "invalid backoff",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: golang:1.19
+    retry:
+      attempts: 2
+      backoff: 1h
+`,
+			failure: true,
+		},
+		{
+			name: "unsupported outcome",
+			data: `
+version: "1"
+steps:
+  - name: test
+    image: golang:1.19
+    retry:
+      attempts: 2
+      on: [ success ]
+`,
+			failure: true,
+		},
+	}
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := compiler.captureRetries([]byte(test.data), "yaml")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("captureRetries should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("captureRetries returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(compiler.retries, test.want) {
+				t.Errorf("captureRetries is %v, want %v", compiler.retries, test.want)
+			}
+		})
+	}
+}
+
+func TestNative_applyRetries(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.retries = map[retryKey]*retry{
+		{stage: "test", step: "integration"}: {Attempts: 3, Backoff: "5s", On: []string{"failure", "timeout"}},
+		{stage: "test", step: "unit"}:        {Attempts: 2},
+	}
+
+	p := &pipeline.Build{
+		Stages: pipeline.StageSlice{
+			{
+				Name: "test",
+				Steps: pipeline.ContainerSlice{
+					{Name: "integration", Image: "golang:1.19"},
+					{Name: "unit", Image: "golang:1.19", Environment: map[string]string{"GOOS": "linux"}},
+					{Name: "lint", Image: "golang:1.19"},
+				},
+			},
+		},
+	}
+
+	want := []map[string]string{
+		{
+			retryAttempts: "3",
+			retryBackoff:  "5s",
+			retryOn:       "failure,timeout",
+		},
+		{
+			"GOOS":        "linux",
+			retryAttempts: "2",
+			retryOn:       "failure",
+		},
+		nil,
+	}
+
+	// run test
+	compiler.applyRetries(p)
+
+	for i, ctn := range p.Stages[0].Steps {
+		if !reflect.DeepEqual(ctn.Environment, want[i]) {
+			t.Errorf("applyRetries environment for %s is %v, want %v", ctn.Name, ctn.Environment, want[i])
+		}
+	}
+}
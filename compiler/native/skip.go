@@ -0,0 +1,66 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+
+	"github.com/go-vela/types/pipeline"
+)
+
+// Skipped returns the stages and steps skipped by the most recent compilation.
+func (c *client) Skipped() []*api.StepSkip {
+	return c.skipped
+}
+
+// recordSkip is a helper function to record the stage or
+// step skipped for the reason in the current compilation.
+func (c *client) recordSkip(stage, step, reason, message string) {
+	s := new(api.StepSkip)
+	s.SetStage(stage)
+	s.SetStep(step)
+	s.SetReason(reason)
+	s.SetMessage(message)
+
+	c.skipped = append(c.skipped, s)
+}
+
+// skipRulesets is a helper function to record the steps in the
+// executable pipeline whose ruleset does not match the build,
+// before they are purged from the pipeline.
+func (c *client) skipRulesets(p *pipeline.Build, r *pipeline.RuleData) {
+	for _, stage := range p.Stages {
+		for _, step := range stage.Steps {
+			c.skipRuleset(stage.Name, step, r)
+		}
+	}
+
+	for _, step := range p.Steps {
+		c.skipRuleset("", step, r)
+	}
+}
+
+// skipRuleset is a helper function to record the step when
+// its ruleset does not match the build, along with whether the
+// unless rules matched or the if rules did not match.
+func (c *client) skipRuleset(stage string, ctn *pipeline.Container, r *pipeline.RuleData) {
+	ruleset := ctn.Ruleset
+
+	if ruleset.Match(r) {
+		return
+	}
+
+	if !ruleset.Unless.Empty() && ruleset.Unless.Match(r, ruleset.Matcher, ruleset.Operator) {
+		c.recordSkip(stage, ctn.Name, api.SkipReasonRulesetUnless,
+			fmt.Sprintf("unless rules for %s match the build", conditionName(stage, ctn.Name)))
+
+		return
+	}
+
+	c.recordSkip(stage, ctn.Name, api.SkipReasonRulesetIf,
+		fmt.Sprintf("if rules for %s do not match the build", conditionName(stage, ctn.Name)))
+}
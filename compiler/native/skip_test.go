@@ -0,0 +1,131 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"flag"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/compiler/expression"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/go-vela/types/yaml"
+
+	"github.com/urfave/cli/v2"
+)
+
+func TestNative_Skipped_Rulesets(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.WithBuild(new(library.Build)).WithRepo(new(library.Repo))
+
+	p := &yaml.Build{
+		Version: "1",
+		Stages: yaml.StageSlice{
+			{
+				Name: "test",
+				Steps: yaml.StepSlice{
+					{Name: "lint", Image: "alpine"},
+					{
+						Name:  "publish",
+						Image: "alpine",
+						Ruleset: yaml.Ruleset{
+							If: yaml.Rules{Branch: []string{"main"}},
+						},
+					},
+					{
+						Name:  "preview",
+						Image: "alpine",
+						Ruleset: yaml.Ruleset{
+							Unless: yaml.Rules{Event: []string{"push"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	r := &pipeline.RuleData{Branch: "dev", Event: "push"}
+
+	want := []*api.StepSkip{
+		testStepSkip("test", "publish", api.SkipReasonRulesetIf, "if rules for step publish in stage test do not match the build"),
+		testStepSkip("test", "preview", api.SkipReasonRulesetUnless, "unless rules for step preview in stage test match the build"),
+	}
+
+	// run test
+	got, err := compiler.TransformStages(r, p)
+	if err != nil {
+		t.Errorf("TransformStages returned err: %v", err)
+	}
+
+	if len(got.Stages) != 1 || len(got.Stages[0].Steps) != 1 {
+		t.Errorf("TransformStages is %v, want only step lint", got.Stages)
+	}
+
+	if !reflect.DeepEqual(compiler.Skipped(), want) {
+		t.Errorf("Skipped is %v, want %v", compiler.Skipped(), want)
+	}
+}
+
+func TestNative_Skipped_Conditions(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	compiler, err := New(c)
+	if err != nil {
+		t.Errorf("Creating compiler returned err: %v", err)
+	}
+
+	compiler.conditions = []*expression.Condition{
+		{Stage: "deploy", Expression: `branch == "main"`},
+		{Stage: "test", Step: "docs", Expression: `anyPath("docs/*")`},
+	}
+
+	compiler.context = new(api.BuildContext)
+
+	s := yaml.StageSlice{
+		{Name: "deploy", Steps: yaml.StepSlice{{Name: "publish"}}},
+		{Name: "test", Steps: yaml.StepSlice{{Name: "docs"}, {Name: "unit"}}},
+	}
+
+	r := &pipeline.RuleData{Branch: "dev", Path: []string{"main.go"}}
+
+	want := []*api.StepSkip{
+		testStepSkip("deploy", "", api.SkipReasonCondition, "condition for stage deploy does not hold for the build"),
+		testStepSkip("test", "docs", api.SkipReasonCondition, "condition for step docs in stage test does not hold for the build"),
+	}
+
+	// run test
+	_, err = compiler.ConditionStages(s, r)
+	if err != nil {
+		t.Errorf("ConditionStages returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(compiler.Skipped(), want) {
+		t.Errorf("Skipped is %v, want %v", compiler.Skipped(), want)
+	}
+}
+
+// testStepSkip is a test helper function to create
+// the skip recorded for the stage or step.
+func testStepSkip(stage, step, reason, message string) *api.StepSkip {
+	s := new(api.StepSkip)
+	s.SetStage(stage)
+	s.SetStep(step)
+	s.SetReason(reason)
+	s.SetMessage(message)
+
+	return s
+}
@@ -115,6 +115,9 @@ func (c *client) TransformStages(r *pipeline.RuleData, p *yaml.Build) (*pipeline
 		secret.Origin.ID = pattern
 	}
 
+	// record the steps skipped by rulesets before purging them
+	c.skipRulesets(pipeline, r)
+
 	return pipeline.Purge(r), nil
 }
 
@@ -194,5 +197,8 @@ func (c *client) TransformSteps(r *pipeline.RuleData, p *yaml.Build) (*pipeline.
 		secret.Origin.ID = pattern
 	}
 
+	// record the steps skipped by rulesets before purging them
+	c.skipRulesets(pipeline, r)
+
 	return pipeline.Purge(r), nil
 }
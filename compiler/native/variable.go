@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/raw"
+	"github.com/go-vela/types/yaml"
+)
+
+// repoVariables is a helper function to inject the environment
+// variables for the repo into the global environment for the
+// pipeline. The environment declared in the pipeline takes
+// precedence over the variables for the repo.
+//
+// The values for the sensitive variables are injected like any
+// other variable, and it is up to the log handlers to mask them.
+func (c *client) repoVariables(p *yaml.Build) error {
+	if c.Variables == nil || c.repo == nil {
+		return nil
+	}
+
+	variables, err := c.Variables.ListRepoVariablesForRepo(c.requestContext(), c.repo)
+	if err != nil {
+		return fmt.Errorf("unable to list variables for repo %s: %w", c.repo.GetFullName(), err)
+	}
+
+	if len(variables) == 0 {
+		return nil
+	}
+
+	if p.Environment == nil {
+		p.Environment = make(raw.StringSliceMap)
+	}
+
+	for _, v := range variables {
+		// skip the variables declared in the pipeline
+		if _, ok := p.Environment[v.GetName()]; ok {
+			continue
+		}
+
+		p.Environment[v.GetName()] = v.GetValue()
+	}
+
+	return nil
+}
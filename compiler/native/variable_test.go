@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package native
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/raw"
+	"github.com/go-vela/types/yaml"
+
+	"github.com/urfave/cli/v2"
+)
+
+// testVariables represents a repo variable service for tests.
+type testVariables struct {
+	variables []*api.RepoVariable
+	err       error
+}
+
+// ListRepoVariablesForRepo returns the repo variables for tests.
+func (v *testVariables) ListRepoVariablesForRepo(context.Context, *library.Repo) ([]*api.RepoVariable, error) {
+	return v.variables, v.err
+}
+
+func TestNative_repoVariables(t *testing.T) {
+	// setup types
+	set := flag.NewFlagSet("test", 0)
+	c := cli.NewContext(nil, set, nil)
+
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetFullName("github/octocat")
+
+	region := new(api.RepoVariable)
+	region.SetName("DEPLOY_REGION")
+	region.SetValue("us-east-1")
+
+	url := new(api.RepoVariable)
+	url.SetName("DEPLOY_URL")
+	url.SetValue("https://deploy.example.com/hooks/abc123")
+	url.SetSensitive(true)
+
+	// setup tests
+	tests := []struct {
+		name      string
+		variables *testVariables
+		env       raw.StringSliceMap
+		failure   bool
+		want      raw.StringSliceMap
+	}{
+		{
+			name:      "no variables",
+			variables: &testVariables{},
+			env:       nil,
+			want:      nil,
+		},
+		{
+			name:      "variables",
+			variables: &testVariables{variables: []*api.RepoVariable{region, url}},
+			env:       nil,
+			want: raw.StringSliceMap{
+				"DEPLOY_REGION": "us-east-1",
+				"DEPLOY_URL":    "https://deploy.example.com/hooks/abc123",
+			},
+		},
+		{
+			name:      "declared environment takes precedence",
+			variables: &testVariables{variables: []*api.RepoVariable{region, url}},
+			env:       raw.StringSliceMap{"DEPLOY_REGION": "us-west-2"},
+			want: raw.StringSliceMap{
+				"DEPLOY_REGION": "us-west-2",
+				"DEPLOY_URL":    "https://deploy.example.com/hooks/abc123",
+			},
+		},
+		{
+			name:      "error",
+			variables: &testVariables{err: errors.New("database unavailable")},
+			failure:   true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			compiler, err := New(c)
+			if err != nil {
+				t.Errorf("Unable to create new compiler: %v", err)
+			}
+
+			compiler.WithRepo(r).WithRepoVariables(test.variables)
+
+			p := &yaml.Build{Environment: test.env}
+
+			err = compiler.repoVariables(p)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("repoVariables should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("repoVariables returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(p.Environment, test.want) {
+				t.Errorf("repoVariables is %v, want %v", p.Environment, test.want)
+			}
+		})
+	}
+}
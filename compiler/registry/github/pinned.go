@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/go-vela/server/compiler/registry"
+
+	"github.com/go-vela/types/library"
+)
+
+// shaPattern represents the pattern for a full commit SHA.
+var shaPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// Pinned reports whether the ref for the template is a tag or a commit SHA in the GitHub repo.
+func (c *client) Pinned(u *library.User, s *registry.Source) (bool, error) {
+	// templates without a ref follow the default branch
+	if len(s.Ref) == 0 {
+		return false, nil
+	}
+
+	// a full commit SHA can never move
+	if shaPattern.MatchString(s.Ref) {
+		return true, nil
+	}
+
+	// use default GitHub OAuth client we provide
+	cli := c.Github
+	if u != nil {
+		// create GitHub OAuth client with user's token
+		cli = c.newClientToken(u.GetToken())
+	}
+
+	// send API call to capture the tag for the ref
+	_, resp, err := cli.Git.GetRef(context.Background(), s.Org, s.Repo, "tags/"+s.Ref)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("unable to capture tag %s for %s/%s: %w", s.Ref, s.Org, s.Repo, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-vela/server/compiler/registry"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGithub_Pinned(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:owner/:name/git/ref/tags/:tag", func(c *gin.Context) {
+		if c.Param("tag") != "v1.0.0" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "Not Found"})
+
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"ref": "refs/tags/v1.0.0"})
+	})
+
+	s := httptest.NewServer(engine)
+
+	defer s.Close()
+
+	// setup tests
+	tests := []struct {
+		name string
+		ref  string
+		want bool
+	}{
+		{
+			name: "no ref",
+			ref:  "",
+			want: false,
+		},
+		{
+			name: "commit sha",
+			ref:  "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+			want: true,
+		},
+		{
+			name: "tag",
+			ref:  "v1.0.0",
+			want: true,
+		},
+		{
+			name: "branch",
+			ref:  "main",
+			want: false,
+		},
+	}
+
+	c, err := New(s.URL, "")
+	if err != nil {
+		t.Errorf("Creating client returned err: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			src := &registry.Source{
+				Org:  "github",
+				Repo: "octocat",
+				Name: "template.yml",
+				Ref:  test.ref,
+			}
+
+			got, err := c.Pinned(nil, src)
+			if err != nil {
+				t.Errorf("Pinned returned err: %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Pinned is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -16,4 +16,8 @@ type Service interface {
 	// Template defines a function that captures the
 	// templated pipeline configuration from a repo.
 	Template(*library.User, *Source) ([]byte, error)
+
+	// Pinned defines a function that reports whether the
+	// ref for a template is a tag or a commit SHA.
+	Pinned(*library.User, *Source) (bool, error)
 }
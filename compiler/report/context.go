@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package report
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the report Store in the context.
+const key = "report"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the report Store
+// associated with this context.
+func FromContext(c context.Context) *Store {
+	// get report value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast report value to expected Store type
+	s, ok := v.(*Store)
+	if !ok {
+		return nil
+	}
+
+	return s
+}
+
+// ToContext adds the report Store to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, s *Store) {
+	c.Set(key, s)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package report
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestReport_FromContext(t *testing.T) {
+	// setup types
+	want := New(10)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+	context.Set(key, want)
+
+	// run test
+	got := FromContext(context)
+
+	if got != want {
+		t.Errorf("FromContext is %v, want %v", got, want)
+	}
+}
+
+func TestReport_FromContext_Bad(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+	context.Set(key, nil)
+
+	// run test
+	got := FromContext(context)
+
+	if got != nil {
+		t.Errorf("FromContext is %v, want nil", got)
+	}
+}
+
+func TestReport_FromContext_WrongType(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+	context.Set(key, 1)
+
+	// run test
+	got := FromContext(context)
+
+	if got != nil {
+		t.Errorf("FromContext is %v, want nil", got)
+	}
+}
+
+func TestReport_FromContext_Empty(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+
+	// run test
+	got := FromContext(context)
+
+	if got != nil {
+		t.Errorf("FromContext is %v, want nil", got)
+	}
+}
+
+func TestReport_ToContext(t *testing.T) {
+	// setup types
+	want := New(10)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	context, _ := gin.CreateTestContext(nil)
+	ToContext(context, want)
+
+	// run test
+	got := context.Value(key)
+
+	if got != want {
+		t.Errorf("ToContext is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package report provides the ability for Vela to retain
+// the compilation reports for the most recent builds.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/compiler/report"
+package report
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package report
+
+import (
+	"sync"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// Store represents a bounded collection of compilation
+// reports for the most recent builds.
+type Store struct {
+	mu sync.RWMutex
+
+	// maximum number of reports to retain
+	limit int
+	// build IDs in the order their reports were added
+	order []int64
+	// reports keyed by build ID
+	reports map[int64]*api.CompileReport
+}
+
+// New returns a Store that retains the reports
+// for up to the provided number of builds.
+//
+// A limit of zero returns a Store that retains no reports.
+func New(limit int) *Store {
+	if limit < 0 {
+		limit = 0
+	}
+
+	return &Store{
+		limit:   limit,
+		reports: make(map[int64]*api.CompileReport),
+	}
+}
+
+// Enabled returns true when the Store retains reports.
+func (s *Store) Enabled() bool {
+	return s != nil && s.limit > 0
+}
+
+// Add retains the provided report for its build, removing
+// the report for the oldest build when the limit is reached.
+func (s *Store) Add(r *api.CompileReport) {
+	if !s.Enabled() || r == nil || r.GetBuildID() == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// replace the existing report for a recompiled build
+	if _, ok := s.reports[r.GetBuildID()]; !ok {
+		s.order = append(s.order, r.GetBuildID())
+	}
+
+	s.reports[r.GetBuildID()] = r
+
+	for len(s.order) > s.limit {
+		delete(s.reports, s.order[0])
+
+		s.order = s.order[1:]
+	}
+}
+
+// Get returns the report for the provided build ID
+// or nil when no report is retained for the build.
+func (s *Store) Get(id int64) *api.CompileReport {
+	if !s.Enabled() {
+		return nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.reports[id]
+}
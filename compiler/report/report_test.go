@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package report
+
+import (
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestReport_Store(t *testing.T) {
+	// setup types
+	one := testReport(1)
+	two := testReport(2)
+	three := testReport(3)
+	again := testReport(2)
+
+	s := New(2)
+
+	// run test
+	s.Add(one)
+	s.Add(two)
+	s.Add(again)
+
+	if s.Get(1) != one {
+		t.Errorf("Get is %v, want %v", s.Get(1), one)
+	}
+
+	if s.Get(2) != again {
+		t.Errorf("Get is %v, want %v", s.Get(2), again)
+	}
+
+	s.Add(three)
+
+	if s.Get(1) != nil {
+		t.Errorf("Get is %v, want nil", s.Get(1))
+	}
+
+	if s.Get(3) != three {
+		t.Errorf("Get is %v, want %v", s.Get(3), three)
+	}
+
+	// reports without a build are ignored
+	s.Add(new(api.CompileReport))
+
+	if s.Get(2) != again {
+		t.Errorf("Get is %v, want %v", s.Get(2), again)
+	}
+}
+
+func TestReport_Store_Disabled(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name  string
+		store *Store
+	}{
+		{
+			name:  "zero limit",
+			store: New(0),
+		},
+		{
+			name:  "negative limit",
+			store: New(-1),
+		},
+		{
+			name:  "nil store",
+			store: nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.store.Add(testReport(1))
+
+			if test.store.Enabled() {
+				t.Errorf("Enabled is true, want false")
+			}
+
+			if test.store.Get(1) != nil {
+				t.Errorf("Get is %v, want nil", test.store.Get(1))
+			}
+		})
+	}
+}
+
+// testReport is a test helper function to create a
+// CompileReport type for the provided build ID.
+func testReport(id int64) *api.CompileReport {
+	r := new(api.CompileReport)
+
+	r.SetBuildID(id)
+	r.SetRepo("github/octocat")
+
+	return r
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package schema provides the ability for Vela to validate
+// the variables provided to a template against the
+// parameters the template declares.
+//
+// A template declares its parameters in a front matter
+// block at the beginning of the template:
+//
+//	---
+//	parameters:
+//	  image:
+//	    type: string
+//	    required: true
+//	  pull_policy:
+//	    type: string
+//	    enum: [ "pull: always", "pull: not_present" ]
+//	    default: "pull: not_present"
+//	---
+//	steps:
+//	  ...
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/compiler/template/schema"
+package schema
@@ -0,0 +1,124 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/buildkite/yaml"
+)
+
+// delimiter represents the line that opens and
+// closes the front matter block for a template.
+const delimiter = "---"
+
+const (
+	// TypeString represents a parameter that accepts a string.
+	TypeString = "string"
+	// TypeInteger represents a parameter that accepts a whole number.
+	TypeInteger = "integer"
+	// TypeNumber represents a parameter that accepts any number.
+	TypeNumber = "number"
+	// TypeBoolean represents a parameter that accepts true or false.
+	TypeBoolean = "boolean"
+	// TypeArray represents a parameter that accepts a list.
+	TypeArray = "array"
+	// TypeObject represents a parameter that accepts a map.
+	TypeObject = "object"
+)
+
+type (
+	// Schema represents the parameters declared by a template.
+	Schema struct {
+		Parameters map[string]*Parameter `yaml:"parameters"`
+	}
+
+	// Parameter represents a single parameter declared by a template.
+	Parameter struct {
+		Description string        `yaml:"description,omitempty"`
+		Type        string        `yaml:"type,omitempty"`
+		Required    bool          `yaml:"required,omitempty"`
+		Enum        []interface{} `yaml:"enum,omitempty"`
+		Default     interface{}   `yaml:"default,omitempty"`
+	}
+)
+
+// Parse separates the parameter schema from the body of the
+// provided template. When the template does not begin with
+// a front matter block declaring parameters, a nil Schema
+// and the unmodified template are returned.
+func Parse(tmpl string) (*Schema, string, error) {
+	// the front matter block must open on the first line
+	first, rest, ok := strings.Cut(tmpl, "\n")
+	if !ok || strings.TrimSpace(first) != delimiter {
+		return nil, tmpl, nil
+	}
+
+	// find the line that closes the front matter block
+	var block []string
+
+	lines := strings.Split(rest, "\n")
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) != delimiter {
+			block = append(block, line)
+
+			continue
+		}
+
+		// only treat the block as front matter when it declares parameters
+		//
+		// This avoids mistaking a template that begins with a yaml
+		// document marker followed by a second document for a schema.
+		raw := make(map[string]interface{})
+
+		err := yaml.Unmarshal([]byte(strings.Join(block, "\n")), &raw)
+		if err != nil || len(raw) != 1 || raw["parameters"] == nil {
+			return nil, tmpl, nil
+		}
+
+		s := new(Schema)
+
+		err = yaml.Unmarshal([]byte(strings.Join(block, "\n")), s)
+		if err != nil {
+			return nil, tmpl, fmt.Errorf("unable to parse template parameters: %w", err)
+		}
+
+		err = s.verify()
+		if err != nil {
+			return nil, tmpl, err
+		}
+
+		return s, strings.Join(lines[i+1:], "\n"), nil
+	}
+
+	return nil, tmpl, nil
+}
+
+// verify ensures the parameters declared in the schema are valid.
+func (s *Schema) verify() error {
+	for name, p := range s.Parameters {
+		if p == nil {
+			return fmt.Errorf("invalid template parameter %s: no definition provided", name)
+		}
+
+		switch p.Type {
+		case "", TypeString, TypeInteger, TypeNumber, TypeBoolean, TypeArray, TypeObject:
+		default:
+			return fmt.Errorf("invalid template parameter %s: unsupported type %s", name, p.Type)
+		}
+
+		// ensure the default satisfies the declared type and enum
+		if p.Default != nil {
+			err := p.check(p.Default)
+			if err != nil {
+				return fmt.Errorf("invalid default for template parameter %s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
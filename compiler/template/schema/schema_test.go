@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSchema_Parse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		tmpl     string
+		want     *Schema
+		wantBody string
+		wantErr  bool
+	}{
+		{
+			name:     "no front matter",
+			tmpl:     "steps:\n  - name: test\n",
+			wantBody: "steps:\n  - name: test\n",
+		},
+		{
+			name: "front matter",
+			tmpl: "---\nparameters:\n  image:\n    type: string\n    required: true\n---\nsteps:\n  - name: test\n",
+			want: &Schema{Parameters: map[string]*Parameter{
+				"image": {Type: TypeString, Required: true},
+			}},
+			wantBody: "steps:\n  - name: test\n",
+		},
+		{
+			name:     "document marker without parameters",
+			tmpl:     "---\nversion: \"1\"\n---\nsteps:\n  - name: test\n",
+			wantBody: "---\nversion: \"1\"\n---\nsteps:\n  - name: test\n",
+		},
+		{
+			name:     "unclosed front matter",
+			tmpl:     "---\nparameters:\n  image:\n    type: string\n",
+			wantBody: "---\nparameters:\n  image:\n    type: string\n",
+		},
+		{
+			name:    "unsupported type",
+			tmpl:    "---\nparameters:\n  image:\n    type: text\n---\nsteps: []\n",
+			wantErr: true,
+		},
+		{
+			name:    "invalid default",
+			tmpl:    "---\nparameters:\n  pull:\n    type: string\n    enum: [ always ]\n    default: never\n---\nsteps: []\n",
+			wantErr: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, body, err := Parse(test.tmpl)
+
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("Parse should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse is %v, want %v", got, test.want)
+			}
+
+			if body != test.wantBody {
+				t.Errorf("Parse body is %q, want %q", body, test.wantBody)
+			}
+		})
+	}
+}
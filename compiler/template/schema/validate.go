@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Validate verifies the provided variables satisfy the parameters
+// declared in the schema. It returns a copy of the variables with
+// the defaults applied for any parameters that were not provided.
+//
+// Every violation is reported in the returned error so a user can
+// correct all of them at once.
+func (s *Schema) Validate(variables map[string]interface{}) (map[string]interface{}, error) {
+	if s == nil {
+		return variables, nil
+	}
+
+	result := make(map[string]interface{}, len(variables))
+	for key, value := range variables {
+		result[key] = value
+	}
+
+	violations := []string{}
+
+	// check the variables in a consistent order
+	names := make([]string, 0, len(s.Parameters))
+	for name := range s.Parameters {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		p := s.Parameters[name]
+
+		value, ok := variables[name]
+		if !ok || value == nil {
+			switch {
+			case p.Default != nil:
+				result[name] = p.Default
+			case p.Required:
+				violations = append(violations, fmt.Sprintf("parameter %s is required", name))
+			}
+
+			continue
+		}
+
+		err := p.check(value)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("parameter %s %v", name, err))
+		}
+	}
+
+	// check for variables the template does not declare
+	unknown := []string{}
+
+	for name := range variables {
+		if _, ok := s.Parameters[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+
+	sort.Strings(unknown)
+
+	for _, name := range unknown {
+		violations = append(violations, fmt.Sprintf("parameter %s is not declared by the template", name))
+	}
+
+	if len(violations) > 0 {
+		return nil, fmt.Errorf("invalid template variables: %s", strings.Join(violations, "; "))
+	}
+
+	return result, nil
+}
+
+// check verifies the provided value satisfies the parameter.
+func (p *Parameter) check(value interface{}) error {
+	if len(p.Type) > 0 && !matches(p.Type, value) {
+		return fmt.Errorf("must be of type %s, got %s %v", p.Type, describe(value), value)
+	}
+
+	if len(p.Enum) == 0 {
+		return nil
+	}
+
+	for _, allowed := range p.Enum {
+		if reflect.DeepEqual(normalize(allowed), normalize(value)) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("must be one of %v, got %v", p.Enum, value)
+}
+
+// matches returns true when the provided value is of the provided type.
+func matches(kind string, value interface{}) bool {
+	switch kind {
+	case TypeString:
+		_, ok := value.(string)
+
+		return ok
+	case TypeInteger:
+		switch v := normalize(value).(type) {
+		case int64:
+			return true
+		case float64:
+			return v == float64(int64(v))
+		}
+
+		return false
+	case TypeNumber:
+		switch normalize(value).(type) {
+		case int64, float64:
+			return true
+		}
+
+		return false
+	case TypeBoolean:
+		_, ok := value.(bool)
+
+		return ok
+	case TypeArray:
+		return reflect.ValueOf(value).Kind() == reflect.Slice
+	case TypeObject:
+		return reflect.ValueOf(value).Kind() == reflect.Map
+	}
+
+	return true
+}
+
+// describe returns the schema type name for the provided value.
+func describe(value interface{}) string {
+	switch normalize(value).(type) {
+	case string:
+		return TypeString
+	case int64:
+		return TypeInteger
+	case float64:
+		return TypeNumber
+	case bool:
+		return TypeBoolean
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Slice:
+		return TypeArray
+	case reflect.Map:
+		return TypeObject
+	}
+
+	return fmt.Sprintf("%T", value)
+}
+
+// normalize converts numeric values to a common type so values
+// decoded from different sources can be compared.
+func normalize(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	case float32:
+		return float64(v)
+	}
+
+	return value
+}
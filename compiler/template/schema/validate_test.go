@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSchema_Validate(t *testing.T) {
+	// setup types
+	s := &Schema{Parameters: map[string]*Parameter{
+		"image":   {Type: TypeString, Required: true},
+		"pull":    {Type: TypeString, Enum: []interface{}{"always", "not_present"}, Default: "not_present"},
+		"retries": {Type: TypeInteger, Enum: []interface{}{1, 2, 3}},
+		"ratio":   {Type: TypeNumber},
+		"debug":   {Type: TypeBoolean},
+		"tags":    {Type: TypeArray},
+		"env":     {Type: TypeObject},
+	}}
+
+	// setup tests
+	tests := []struct {
+		name      string
+		schema    *Schema
+		variables map[string]interface{}
+		want      map[string]interface{}
+		wantErr   string
+	}{
+		{
+			name:      "nil schema",
+			variables: map[string]interface{}{"foo": "bar"},
+			want:      map[string]interface{}{"foo": "bar"},
+		},
+		{
+			name:   "valid",
+			schema: s,
+			variables: map[string]interface{}{
+				"image":   "alpine",
+				"retries": 2,
+				"ratio":   0.5,
+				"debug":   true,
+				"tags":    []interface{}{"latest"},
+				"env":     map[interface{}]interface{}{"FOO": "bar"},
+			},
+			want: map[string]interface{}{
+				"image":   "alpine",
+				"pull":    "not_present",
+				"retries": 2,
+				"ratio":   0.5,
+				"debug":   true,
+				"tags":    []interface{}{"latest"},
+				"env":     map[interface{}]interface{}{"FOO": "bar"},
+			},
+		},
+		{
+			name:      "missing required",
+			schema:    s,
+			variables: map[string]interface{}{},
+			wantErr:   "invalid template variables: parameter image is required",
+		},
+		{
+			name:   "invalid values",
+			schema: s,
+			variables: map[string]interface{}{
+				"image":   "alpine",
+				"pull":    "never",
+				"retries": 1.5,
+				"debug":   "true",
+				"tags":    "latest",
+				"foo":     "bar",
+			},
+			wantErr: "invalid template variables: " +
+				"parameter debug must be of type boolean, got string true; " +
+				"parameter pull must be one of [always not_present], got never; " +
+				"parameter retries must be of type integer, got number 1.5; " +
+				"parameter tags must be of type array, got string latest; " +
+				"parameter foo is not declared by the template",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.schema.Validate(test.variables)
+
+			if len(test.wantErr) > 0 {
+				if err == nil || err.Error() != test.wantErr {
+					t.Errorf("Validate returned err %v, want %s", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Validate returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Validate is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateAnnouncement creates a new announcement in the database.
+func (e *engine) CreateAnnouncement(ctx context.Context, a *api.Announcement) error {
+	e.logger.WithFields(logrus.Fields{
+		"announcement": a.GetID(),
+	}).Tracef("creating announcement %d in the database", a.GetID())
+
+	// cast the API type to database type
+	announcement := types.AnnouncementFromAPI(a)
+
+	// validate the necessary fields are populated
+	err := announcement.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableAnnouncement).
+		Create(announcement).
+		Error
+}
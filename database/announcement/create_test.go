@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAnnouncement_Engine_CreateAnnouncement(t *testing.T) {
+	// setup types
+	_announcement := testAnnouncement()
+	_announcement.SetID(1)
+	_announcement.SetKind("maintenance")
+	_announcement.SetTitle("upgrade")
+	_announcement.SetMessage("builds paused")
+	_announcement.SetOrgs([]string{"github"})
+	_announcement.SetStarts(1)
+	_announcement.SetEnds(3)
+	_announcement.SetActive(true)
+	_announcement.SetCreatedAt(1)
+	_announcement.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "announcements"
+("kind","title","message","orgs","starts","ends","active","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`).
+		WithArgs("maintenance", "upgrade", "builds paused", `{"github"}`, 1, 3, true, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateAnnouncement(context.TODO(), _announcement)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateAnnouncement for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateAnnouncement for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteAnnouncement deletes an existing announcement from the database.
+func (e *engine) DeleteAnnouncement(ctx context.Context, a *api.Announcement) error {
+	e.logger.WithFields(logrus.Fields{
+		"announcement": a.GetID(),
+	}).Tracef("deleting announcement %d from the database", a.GetID())
+
+	// cast the API type to database type
+	announcement := types.AnnouncementFromAPI(a)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableAnnouncement).
+		Delete(announcement).
+		Error
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAnnouncement_Engine_DeleteAnnouncement(t *testing.T) {
+	// setup types
+	_announcement := testAnnouncement()
+	_announcement.SetID(1)
+	_announcement.SetKind("maintenance")
+	_announcement.SetTitle("upgrade")
+	_announcement.SetMessage("builds paused")
+	_announcement.SetOrgs([]string{"github"})
+	_announcement.SetStarts(1)
+	_announcement.SetEnds(3)
+	_announcement.SetActive(true)
+	_announcement.SetCreatedAt(1)
+	_announcement.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "announcements" WHERE "announcements"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateAnnouncement(context.TODO(), _announcement)
+	if err != nil {
+		t.Errorf("unable to create test announcement for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteAnnouncement(context.TODO(), _announcement)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteAnnouncement for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteAnnouncement for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
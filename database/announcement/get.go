@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetAnnouncement gets a announcement by ID from the database.
+func (e *engine) GetAnnouncement(ctx context.Context, id int64) (*api.Announcement, error) {
+	e.logger.Tracef("getting announcement %d from the database", id)
+
+	// variable to store query results
+	a := new(types.Announcement)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableAnnouncement).
+		Where("id = ?", id).
+		Take(a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return a.ToAPI(), nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestAnnouncement_Engine_GetAnnouncement(t *testing.T) {
+	// setup types
+	_announcement := testAnnouncement()
+	_announcement.SetID(1)
+	_announcement.SetKind("maintenance")
+	_announcement.SetTitle("upgrade")
+	_announcement.SetMessage("builds paused")
+	_announcement.SetOrgs([]string{"github"})
+	_announcement.SetStarts(1)
+	_announcement.SetEnds(3)
+	_announcement.SetActive(true)
+	_announcement.SetCreatedAt(1)
+	_announcement.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "kind", "title", "message", "orgs", "starts", "ends", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "maintenance", "upgrade", "builds paused", `{"github"}`, 1, 3, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "announcements" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateAnnouncement(context.TODO(), _announcement)
+	if err != nil {
+		t.Errorf("unable to create test announcement for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.Announcement
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _announcement,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _announcement,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetAnnouncement(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetAnnouncement for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetAnnouncement for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetAnnouncement for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateActiveIndex represents a query to create an
+	// index on the announcements table for the active column.
+	CreateActiveIndex = `
+CREATE INDEX
+IF NOT EXISTS
+announcements_active
+ON announcements (active);
+`
+)
+
+// CreateAnnouncementIndexes creates the indexes for the announcements table in the database.
+func (e *engine) CreateAnnouncementIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for announcements table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the active column index for the announcements table
+	return e.client.WithContext(ctx).Exec(CreateActiveIndex).Error
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListAnnouncements gets a list of all announcements from the database.
+func (e *engine) ListAnnouncements(ctx context.Context) ([]*api.Announcement, error) {
+	e.logger.Trace("listing all announcements from the database")
+
+	// variables to store query results and return value
+	a := new([]types.Announcement)
+	announcements := []*api.Announcement{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableAnnouncement).
+		Order("id").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, announcement := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := announcement
+
+		// convert query result to API type
+		announcements = append(announcements, tmp.ToAPI())
+	}
+
+	return announcements, nil
+}
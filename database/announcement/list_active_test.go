@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestAnnouncement_Engine_ListActiveAnnouncements(t *testing.T) {
+	// setup types
+	_announcementOne := testAnnouncement()
+	_announcementOne.SetID(1)
+	_announcementOne.SetKind("maintenance")
+	_announcementOne.SetTitle("upgrade")
+	_announcementOne.SetMessage("builds paused")
+	_announcementOne.SetOrgs([]string{"github"})
+	_announcementOne.SetStarts(1)
+	_announcementOne.SetEnds(3)
+	_announcementOne.SetActive(true)
+	_announcementOne.SetCreatedAt(1)
+	_announcementOne.SetCreatedBy("octocat")
+
+	_announcementTwo := testAnnouncement()
+	_announcementTwo.SetID(2)
+	_announcementTwo.SetKind("info")
+	_announcementTwo.SetTitle("welcome")
+	_announcementTwo.SetMessage("read the docs")
+	_announcementTwo.SetActive(true)
+	_announcementTwo.SetCreatedAt(1)
+	_announcementTwo.SetCreatedBy("octocat")
+
+	_announcementThree := testAnnouncement()
+	_announcementThree.SetID(3)
+	_announcementThree.SetKind("maintenance")
+	_announcementThree.SetTitle("outage")
+	_announcementThree.SetMessage("builds paused")
+	_announcementThree.SetStarts(2)
+	_announcementThree.SetEnds(4)
+	_announcementThree.SetActive(true)
+	_announcementThree.SetCreatedAt(1)
+	_announcementThree.SetCreatedBy("octocat")
+
+	_announcementFour := testAnnouncement()
+	_announcementFour.SetID(4)
+	_announcementFour.SetKind("deprecation")
+	_announcementFour.SetTitle("templates")
+	_announcementFour.SetMessage("upgrade your templates")
+	_announcementFour.SetActive(false)
+	_announcementFour.SetCreatedAt(1)
+	_announcementFour.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "kind", "title", "message", "orgs", "starts", "ends", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "maintenance", "upgrade", "builds paused", `{"github"}`, 1, 3, true, 1, "octocat", 0, "").
+		AddRow(2, "info", "welcome", "read the docs", nil, nil, nil, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "announcements" WHERE active = $1 AND (starts IS NULL OR starts <= $2) AND (ends IS NULL OR ends > $3) ORDER BY id`).
+		WithArgs(true, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, announcement := range []*api.Announcement{_announcementOne, _announcementTwo, _announcementThree, _announcementFour} {
+		err := _sqlite.CreateAnnouncement(context.TODO(), announcement)
+		if err != nil {
+			t.Errorf("unable to create test announcement for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Announcement
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Announcement{_announcementOne, _announcementTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Announcement{_announcementOne, _announcementTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListActiveAnnouncements(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListActiveAnnouncements for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListActiveAnnouncements for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListActiveAnnouncements for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
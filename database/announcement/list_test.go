@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestAnnouncement_Engine_ListAnnouncements(t *testing.T) {
+	// setup types
+	_announcementOne := testAnnouncement()
+	_announcementOne.SetID(1)
+	_announcementOne.SetKind("maintenance")
+	_announcementOne.SetTitle("upgrade")
+	_announcementOne.SetMessage("builds paused")
+	_announcementOne.SetOrgs([]string{"github"})
+	_announcementOne.SetStarts(1)
+	_announcementOne.SetEnds(3)
+	_announcementOne.SetActive(true)
+	_announcementOne.SetCreatedAt(1)
+	_announcementOne.SetCreatedBy("octocat")
+
+	_announcementTwo := testAnnouncement()
+	_announcementTwo.SetID(2)
+	_announcementTwo.SetKind("maintenance")
+	_announcementTwo.SetTitle("outage")
+	_announcementTwo.SetMessage("builds paused")
+	_announcementTwo.SetOrgs([]string{"github"})
+	_announcementTwo.SetStarts(1)
+	_announcementTwo.SetEnds(3)
+	_announcementTwo.SetActive(true)
+	_announcementTwo.SetCreatedAt(1)
+	_announcementTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "kind", "title", "message", "orgs", "starts", "ends", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "maintenance", "upgrade", "builds paused", `{"github"}`, 1, 3, true, 1, "octocat", 0, "").
+		AddRow(2, "maintenance", "outage", "builds paused", `{"github"}`, 1, 3, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "announcements" ORDER BY id`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateAnnouncement(context.TODO(), _announcementOne)
+	if err != nil {
+		t.Errorf("unable to create test announcement for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateAnnouncement(context.TODO(), _announcementTwo)
+	if err != nil {
+		t.Errorf("unable to create test announcement for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Announcement
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Announcement{_announcementOne, _announcementTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Announcement{_announcementOne, _announcementTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListAnnouncements(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListAnnouncements for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListAnnouncements for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListAnnouncements for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
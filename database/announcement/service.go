@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// AnnouncementService represents the Vela interface for announcement
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type AnnouncementService interface {
+	// Announcement Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateAnnouncementIndexes defines a function that creates the indexes for the announcements table.
+	CreateAnnouncementIndexes(context.Context) error
+	// CreateAnnouncementTable defines a function that creates the announcements table.
+	CreateAnnouncementTable(context.Context, string) error
+
+	// Announcement Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateAnnouncement defines a function that creates a new announcement.
+	CreateAnnouncement(context.Context, *api.Announcement) error
+	// DeleteAnnouncement defines a function that deletes an existing announcement.
+	DeleteAnnouncement(context.Context, *api.Announcement) error
+	// GetAnnouncement defines a function that gets a announcement by ID.
+	GetAnnouncement(context.Context, int64) (*api.Announcement, error)
+	// ListActiveAnnouncements defines a function that gets a list of the active announcements at a time.
+	ListActiveAnnouncements(context.Context, int64) ([]*api.Announcement, error)
+	// ListAnnouncements defines a function that gets a list of all announcements.
+	ListAnnouncements(context.Context) ([]*api.Announcement, error)
+	// UpdateAnnouncement defines a function that updates an existing announcement.
+	UpdateAnnouncement(context.Context, *api.Announcement) error
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package announcement
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableAnnouncement represents the name of the table for announcements.
+	TableAnnouncement = "announcements"
+
+	// CreatePostgresTable represents a query to create the Postgres announcements table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+announcements (
+	id            SERIAL PRIMARY KEY,
+	kind          VARCHAR(250),
+	title         VARCHAR(250),
+	message       VARCHAR(5000),
+	orgs          VARCHAR(1000),
+	starts        INTEGER,
+	ends          INTEGER,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite announcements table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+announcements (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	kind          TEXT,
+	title         TEXT,
+	message       TEXT,
+	orgs          TEXT,
+	starts        INTEGER,
+	ends          INTEGER,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL announcements table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+announcements (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	kind          VARCHAR(250),
+	title         VARCHAR(250),
+	message       VARCHAR(5000),
+	orgs          VARCHAR(1000),
+	starts        INTEGER,
+	ends          INTEGER,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	INDEX announcements_active (active)
+);
+`
+)
+
+// CreateAnnouncementTable creates the announcements table in the database.
+func (e *engine) CreateAnnouncementTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating announcements table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the announcements table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the announcements table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the announcements table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
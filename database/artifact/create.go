@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateArtifact creates a new artifact in the database.
+func (e *engine) CreateArtifact(ctx context.Context, a *api.Artifact) error {
+	e.logger.WithFields(logrus.Fields{
+		"build":    a.GetBuildID(),
+		"artifact": a.GetName(),
+	}).Tracef("creating artifact %s in the database", a.GetName())
+
+	// cast the API type to database type
+	artifact := types.ArtifactFromAPI(a)
+
+	// validate the necessary fields are populated
+	err := artifact.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableArtifact).
+		Create(artifact).
+		Error
+}
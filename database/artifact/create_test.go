@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestArtifact_Engine_CreateArtifact(t *testing.T) {
+	// setup types
+	_artifact := testArtifact()
+	_artifact.SetID(1)
+	_artifact.SetRepoID(1)
+	_artifact.SetBuildID(1)
+	_artifact.SetName("vela-server")
+	_artifact.SetKind("binary")
+	_artifact.SetDigest("sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1")
+	_artifact.SetURI("https://artifacts.example.com/vela-server")
+	_artifact.SetCreatedBy("octocat")
+	_artifact.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "artifacts"
+("repo_id","build_id","name","kind","digest","uri","created_by","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs(1, 1, "vela-server", "binary", "sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1",
+			"https://artifacts.example.com/vela-server", "octocat", 1563474076, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateArtifact(context.TODO(), _artifact)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateArtifact for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateArtifact for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// DeleteArtifactsForBuild deletes all artifacts by build ID from the database.
+func (e *engine) DeleteArtifactsForBuild(ctx context.Context, b *library.Build) error {
+	e.logger.Tracef("deleting artifacts for build %d from the database", b.GetID())
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableArtifact).
+		Where("build_id = ?", b.GetID()).
+		Delete(&types.Artifact{}).
+		Error
+}
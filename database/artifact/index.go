@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the artifacts table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+artifacts_build_id
+ON artifacts (build_id);
+`
+
+	// CreateRepoIDDigestIndex represents a query to create an
+	// index on the artifacts table for the repo_id and digest columns.
+	CreateRepoIDDigestIndex = `
+CREATE INDEX
+IF NOT EXISTS
+artifacts_repo_id_digest
+ON artifacts (repo_id, digest);
+`
+)
+
+// CreateArtifactIndexes creates the indexes for the artifacts table in the database.
+func (e *engine) CreateArtifactIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for artifacts table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the build_id column index for the artifacts table
+	err := e.client.WithContext(ctx).Exec(CreateBuildIDIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the repo_id and digest columns index for the artifacts table
+	return e.client.WithContext(ctx).Exec(CreateRepoIDDigestIndex).Error
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListArtifactsForBuild gets a list of artifacts by build ID from the database.
+func (e *engine) ListArtifactsForBuild(ctx context.Context, b *library.Build) ([]*api.Artifact, error) {
+	e.logger.Tracef("listing artifacts for build %d from the database", b.GetID())
+
+	// variables to store query results and return value
+	a := new([]types.Artifact)
+	artifacts := []*api.Artifact{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableArtifact).
+		Where("build_id = ?", b.GetID()).
+		Order("name").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, artifact := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := artifact
+
+		// convert query result to API type
+		artifacts = append(artifacts, tmp.ToAPI())
+	}
+
+	return artifacts, nil
+}
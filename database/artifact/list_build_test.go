@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestArtifact_Engine_ListArtifactsForBuild(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+
+	_artifactOne := testArtifact()
+	_artifactOne.SetID(1)
+	_artifactOne.SetRepoID(1)
+	_artifactOne.SetBuildID(1)
+	_artifactOne.SetName("vela-server")
+	_artifactOne.SetKind("binary")
+	_artifactOne.SetDigest("sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1")
+	_artifactOne.SetURI("https://artifacts.example.com/vela-server")
+	_artifactOne.SetCreatedBy("octocat")
+	_artifactOne.SetCreated(1563474076)
+
+	_artifactTwo := testArtifact()
+	_artifactTwo.SetID(2)
+	_artifactTwo.SetRepoID(1)
+	_artifactTwo.SetBuildID(1)
+	_artifactTwo.SetName("vela-worker")
+	_artifactTwo.SetKind("binary")
+	_artifactTwo.SetDigest("sha256:1f0c7a3e1b2d4c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6")
+	_artifactTwo.SetURI("https://artifacts.example.com/vela-worker")
+	_artifactTwo.SetCreatedBy("octocat")
+	_artifactTwo.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "name", "kind", "digest", "uri", "created_by", "created"}).
+		AddRow(1, 1, 1, "vela-server", "binary", "sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1", "https://artifacts.example.com/vela-server", "octocat", 1563474076).
+		AddRow(2, 1, 1, "vela-worker", "binary", "sha256:1f0c7a3e1b2d4c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6", "https://artifacts.example.com/vela-worker", "octocat", 1563474076)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "artifacts" WHERE build_id = $1 ORDER BY name`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, a := range []*api.Artifact{_artifactOne, _artifactTwo} {
+		err := _sqlite.CreateArtifact(context.TODO(), a)
+		if err != nil {
+			t.Errorf("unable to create test artifact for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Artifact
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Artifact{_artifactOne, _artifactTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Artifact{_artifactOne, _artifactTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListArtifactsForBuild(context.TODO(), _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListArtifactsForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListArtifactsForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListArtifactsForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListArtifactsForDigest gets a list of artifacts by repo ID and digest from the database.
+//
+// The same digest can be produced by more than one build, so the
+// artifacts are ordered with the most recently produced first.
+func (e *engine) ListArtifactsForDigest(ctx context.Context, r *library.Repo, digest string) ([]*api.Artifact, error) {
+	e.logger.Tracef("listing artifacts for repo %s with digest %s from the database", r.GetFullName(), digest)
+
+	// variables to store query results and return value
+	a := new([]types.Artifact)
+	artifacts := []*api.Artifact{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableArtifact).
+		Where("repo_id = ?", r.GetID()).
+		Where("digest = ?", digest).
+		Order("build_id DESC").
+		Order("name").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, artifact := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := artifact
+
+		// convert query result to API type
+		artifacts = append(artifacts, tmp.ToAPI())
+	}
+
+	return artifacts, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestArtifact_Engine_ListArtifactsForDigest(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	_digest := "sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1"
+
+	_artifactOne := testArtifact()
+	_artifactOne.SetID(1)
+	_artifactOne.SetRepoID(1)
+	_artifactOne.SetBuildID(1)
+	_artifactOne.SetName("vela-server")
+	_artifactOne.SetKind("binary")
+	_artifactOne.SetDigest(_digest)
+	_artifactOne.SetURI("https://artifacts.example.com/1/vela-server")
+	_artifactOne.SetCreatedBy("octocat")
+	_artifactOne.SetCreated(1563474076)
+
+	_artifactTwo := testArtifact()
+	_artifactTwo.SetID(2)
+	_artifactTwo.SetRepoID(1)
+	_artifactTwo.SetBuildID(2)
+	_artifactTwo.SetName("vela-server")
+	_artifactTwo.SetKind("binary")
+	_artifactTwo.SetDigest(_digest)
+	_artifactTwo.SetURI("https://artifacts.example.com/2/vela-server")
+	_artifactTwo.SetCreatedBy("octocat")
+	_artifactTwo.SetCreated(1563474077)
+
+	_other := testArtifact()
+	_other.SetID(3)
+	_other.SetRepoID(1)
+	_other.SetBuildID(2)
+	_other.SetName("vela-worker")
+	_other.SetKind("binary")
+	_other.SetDigest("sha256:1f0c7a3e1b2d4c5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6")
+	_other.SetURI("https://artifacts.example.com/2/vela-worker")
+	_other.SetCreatedBy("octocat")
+	_other.SetCreated(1563474077)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "name", "kind", "digest", "uri", "created_by", "created"}).
+		AddRow(2, 1, 2, "vela-server", "binary", _digest, "https://artifacts.example.com/2/vela-server", "octocat", 1563474077).
+		AddRow(1, 1, 1, "vela-server", "binary", _digest, "https://artifacts.example.com/1/vela-server", "octocat", 1563474076)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "artifacts" WHERE repo_id = $1 AND digest = $2 ORDER BY build_id DESC,name`).
+		WithArgs(1, _digest).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, a := range []*api.Artifact{_artifactOne, _artifactTwo, _other} {
+		err := _sqlite.CreateArtifact(context.TODO(), a)
+		if err != nil {
+			t.Errorf("unable to create test artifact for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Artifact
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Artifact{_artifactTwo, _artifactOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Artifact{_artifactTwo, _artifactOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListArtifactsForDigest(context.TODO(), _repo, _digest)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListArtifactsForDigest for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListArtifactsForDigest for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListArtifactsForDigest for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
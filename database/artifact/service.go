@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// ArtifactService represents the Vela interface for artifact
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type ArtifactService interface {
+	// Artifact Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateArtifactIndexes defines a function that creates the indexes for the artifacts table.
+	CreateArtifactIndexes(context.Context) error
+	// CreateArtifactTable defines a function that creates the artifacts table.
+	CreateArtifactTable(context.Context, string) error
+
+	// Artifact Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateArtifact defines a function that creates a new artifact.
+	CreateArtifact(context.Context, *api.Artifact) error
+	// DeleteArtifactsForBuild defines a function that deletes all artifacts produced by a build.
+	DeleteArtifactsForBuild(context.Context, *library.Build) error
+	// ListArtifactsForBuild defines a function that gets a list of artifacts produced by a build.
+	ListArtifactsForBuild(context.Context, *library.Build) ([]*api.Artifact, error)
+	// ListArtifactsForDigest defines a function that gets a list of artifacts for a repo by digest.
+	ListArtifactsForDigest(context.Context, *library.Repo, string) ([]*api.Artifact, error)
+}
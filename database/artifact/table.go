@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package artifact
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableArtifact represents the name of the table for artifacts.
+	TableArtifact = "artifacts"
+
+	// CreatePostgresTable represents a query to create the Postgres artifacts table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+artifacts (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	name          VARCHAR(250),
+	kind          VARCHAR(50),
+	digest        VARCHAR(200),
+	uri           VARCHAR(1000),
+	created_by    VARCHAR(250),
+	created       INTEGER,
+	UNIQUE(build_id, name)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite artifacts table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+artifacts (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	name          TEXT,
+	kind          TEXT,
+	digest        TEXT,
+	uri           TEXT,
+	created_by    TEXT,
+	created       INTEGER,
+	UNIQUE(build_id, name)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL artifacts table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+artifacts (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	name          VARCHAR(250),
+	kind          VARCHAR(50),
+	digest        VARCHAR(200),
+	uri           VARCHAR(1000),
+	created_by    VARCHAR(250),
+	created       INTEGER,
+	UNIQUE(build_id, name),
+	INDEX artifacts_build_id (build_id),
+	INDEX artifacts_repo_id_digest (repo_id, digest)
+);
+`
+)
+
+// CreateArtifactTable creates the artifacts table in the database.
+func (e *engine) CreateArtifactTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating artifacts table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the artifacts table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the artifacts table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the artifacts table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBuildBudget_Engine_CreateBuildBudget(t *testing.T) {
+	// setup types
+	_budget := testBuildBudget()
+	_budget.SetID(1)
+	_budget.SetOrg("github")
+	_budget.SetMinutes(1000)
+	_budget.SetWebhook("https://alerts.example.com/vela")
+	_budget.SetPeriod("2023-10")
+	_budget.SetAlerted(50)
+	_budget.SetCreatedAt(1)
+	_budget.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "build_budgets"
+("org","minutes","webhook","period","alerted","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`).
+		WithArgs("github", 1000, "https://alerts.example.com/vela", "2023-10", 50, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateBuildBudget(context.TODO(), _budget)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateBuildBudget for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateBuildBudget for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
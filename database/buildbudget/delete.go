@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteBuildBudget deletes an existing build budget from the database.
+func (e *engine) DeleteBuildBudget(ctx context.Context, b *api.BuildBudget) error {
+	e.logger.WithFields(logrus.Fields{
+		"org": b.GetOrg(),
+	}).Tracef("deleting build budget for org %s from the database", b.GetOrg())
+
+	// cast the API type to database type
+	budget := types.BuildBudgetFromAPI(b)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableBuildBudget).
+		Delete(budget).
+		Error
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBuildBudget_Engine_DeleteBuildBudget(t *testing.T) {
+	// setup types
+	_budget := testBuildBudget()
+	_budget.SetID(1)
+	_budget.SetOrg("github")
+	_budget.SetMinutes(1000)
+	_budget.SetWebhook("https://alerts.example.com/vela")
+	_budget.SetPeriod("2023-10")
+	_budget.SetAlerted(50)
+	_budget.SetCreatedAt(1)
+	_budget.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "build_budgets" WHERE "build_budgets"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateBuildBudget(context.TODO(), _budget)
+	if err != nil {
+		t.Errorf("unable to create test build budget for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteBuildBudget(context.TODO(), _budget)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteBuildBudget for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteBuildBudget for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
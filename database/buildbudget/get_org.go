@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetBuildBudgetForOrg gets a build budget by org name from the database.
+func (e *engine) GetBuildBudgetForOrg(ctx context.Context, org string) (*api.BuildBudget, error) {
+	e.logger.Tracef("getting build budget for org %s from the database", org)
+
+	// variable to store query results
+	b := new(types.BuildBudget)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableBuildBudget).
+		Where("org = ?", org).
+		Take(b).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return b.ToAPI(), nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestBuildBudget_Engine_GetBuildBudgetForOrg(t *testing.T) {
+	// setup types
+	_budget := testBuildBudget()
+	_budget.SetID(1)
+	_budget.SetOrg("github")
+	_budget.SetMinutes(1000)
+	_budget.SetWebhook("https://alerts.example.com/vela")
+	_budget.SetPeriod("2023-10")
+	_budget.SetAlerted(50)
+	_budget.SetCreatedAt(1)
+	_budget.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "minutes", "webhook", "period", "alerted", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", 1000, "https://alerts.example.com/vela", "2023-10", 50, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "build_budgets" WHERE org = $1 LIMIT 1`).WithArgs("github").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateBuildBudget(context.TODO(), _budget)
+	if err != nil {
+		t.Errorf("unable to create test build budget for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.BuildBudget
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _budget,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _budget,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetBuildBudgetForOrg(context.TODO(), "github")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetBuildBudgetForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetBuildBudgetForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetBuildBudgetForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListBuildBudgets gets a list of all build budgets from the database.
+func (e *engine) ListBuildBudgets(ctx context.Context) ([]*api.BuildBudget, error) {
+	e.logger.Tracef("listing all build budgets from the database")
+
+	// variables to store query results and return value
+	b := new([]types.BuildBudget)
+	budgets := []*api.BuildBudget{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableBuildBudget).
+		Order("org").
+		Find(&b).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, budget := range *b {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := budget
+
+		// convert query result to API type
+		budgets = append(budgets, tmp.ToAPI())
+	}
+
+	return budgets, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestBuildBudget_Engine_ListBuildBudgets(t *testing.T) {
+	// setup types
+	_budgetOne := testBuildBudget()
+	_budgetOne.SetID(1)
+	_budgetOne.SetOrg("github")
+	_budgetOne.SetMinutes(1000)
+	_budgetOne.SetPeriod("2023-10")
+	_budgetOne.SetAlerted(50)
+	_budgetOne.SetCreatedAt(1)
+	_budgetOne.SetCreatedBy("octocat")
+
+	_budgetTwo := testBuildBudget()
+	_budgetTwo.SetID(2)
+	_budgetTwo.SetOrg("octocat")
+	_budgetTwo.SetMinutes(500)
+	_budgetTwo.SetWebhook("https://alerts.example.com/vela")
+	_budgetTwo.SetCreatedAt(1)
+	_budgetTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "minutes", "webhook", "period", "alerted", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", 1000, "", "2023-10", 50, 1, "octocat", 0, "").
+		AddRow(2, "octocat", 500, "https://alerts.example.com/vela", "", 0, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "build_budgets" ORDER BY org`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateBuildBudget(context.TODO(), _budgetOne)
+	if err != nil {
+		t.Errorf("unable to create test build budget for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateBuildBudget(context.TODO(), _budgetTwo)
+	if err != nil {
+		t.Errorf("unable to create test build budget for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.BuildBudget
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.BuildBudget{_budgetOne, _budgetTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.BuildBudget{_budgetOne, _budgetTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListBuildBudgets(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListBuildBudgets for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListBuildBudgets for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListBuildBudgets for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
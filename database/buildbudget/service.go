@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// BuildBudgetService represents the Vela interface for build budget
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type BuildBudgetService interface {
+	// BuildBudget Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateBuildBudgetTable defines a function that creates the build_budgets table.
+	CreateBuildBudgetTable(context.Context, string) error
+
+	// BuildBudget Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateBuildBudget defines a function that creates a new build budget.
+	CreateBuildBudget(context.Context, *api.BuildBudget) error
+	// DeleteBuildBudget defines a function that deletes an existing build budget.
+	DeleteBuildBudget(context.Context, *api.BuildBudget) error
+	// GetBuildBudgetForOrg defines a function that gets a build budget by org name.
+	GetBuildBudgetForOrg(context.Context, string) (*api.BuildBudget, error)
+	// ListBuildBudgets defines a function that gets a list of all build budgets.
+	ListBuildBudgets(context.Context) ([]*api.BuildBudget, error)
+	// UpdateBuildBudget defines a function that updates an existing build budget.
+	UpdateBuildBudget(context.Context, *api.BuildBudget) error
+}
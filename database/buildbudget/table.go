@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableBuildBudget represents the name of the table for build budgets.
+	TableBuildBudget = "build_budgets"
+
+	// CreatePostgresTable represents a query to create the Postgres build_budgets table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_budgets (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	minutes       INTEGER,
+	webhook       VARCHAR(1000),
+	period        VARCHAR(250),
+	alerted       INTEGER,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite build_budgets table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_budgets (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	minutes       INTEGER,
+	webhook       TEXT,
+	period        TEXT,
+	alerted       INTEGER,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(org)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL build_budgets table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_budgets (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	minutes       INTEGER,
+	webhook       VARCHAR(1000),
+	period        VARCHAR(250),
+	alerted       INTEGER,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org)
+);
+`
+)
+
+// CreateBuildBudgetTable creates the build_budgets table in the database.
+func (e *engine) CreateBuildBudgetTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating build_budgets table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the build_budgets table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the build_budgets table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the build_budgets table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
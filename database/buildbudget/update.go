@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateBuildBudget updates an existing build budget in the database.
+func (e *engine) UpdateBuildBudget(ctx context.Context, b *api.BuildBudget) error {
+	e.logger.WithFields(logrus.Fields{
+		"org": b.GetOrg(),
+	}).Tracef("updating build budget for org %s in the database", b.GetOrg())
+
+	// cast the API type to database type
+	budget := types.BuildBudgetFromAPI(b)
+
+	// validate the necessary fields are populated
+	err := budget.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableBuildBudget).
+		Save(budget).
+		Error
+}
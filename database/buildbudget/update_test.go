@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildbudget
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBuildBudget_Engine_UpdateBuildBudget(t *testing.T) {
+	// setup types
+	_budget := testBuildBudget()
+	_budget.SetID(1)
+	_budget.SetOrg("github")
+	_budget.SetMinutes(1000)
+	_budget.SetWebhook("https://alerts.example.com/vela")
+	_budget.SetPeriod("2023-10")
+	_budget.SetAlerted(50)
+	_budget.SetCreatedAt(1)
+	_budget.SetCreatedBy("octocat")
+	_budget.SetUpdatedAt(2)
+	_budget.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "build_budgets"
+SET "org"=$1,"minutes"=$2,"webhook"=$3,"period"=$4,"alerted"=$5,"created_at"=$6,"created_by"=$7,"updated_at"=$8,"updated_by"=$9
+WHERE "id" = $10`).
+		WithArgs("github", 1000, "https://alerts.example.com/vela", "2023-10", 50, 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateBuildBudget(context.TODO(), _budget)
+	if err != nil {
+		t.Errorf("unable to create test build budget for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateBuildBudget(context.TODO(), _budget)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateBuildBudget for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateBuildBudget for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildcredential
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestBuildCredential_New(t *testing.T) {
+	// setup types
+	logger := logrus.NewEntry(logrus.StandardLogger())
+
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("unable to create new SQL mock: %v", err)
+	}
+	defer _sql.Close()
+
+	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_config := &gorm.Config{SkipDefaultTransaction: true}
+
+	_postgres, err := gorm.Open(postgres.New(postgres.Config{Conn: _sql}), _config)
+	if err != nil {
+		t.Errorf("unable to create new postgres database: %v", err)
+	}
+
+	_sqlite, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), _config)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure      bool
+		name         string
+		client       *gorm.DB
+		key          string
+		logger       *logrus.Entry
+		skipCreation bool
+		want         *engine
+	}{
+		{
+			failure:      false,
+			name:         "postgres",
+			client:       _postgres,
+			logger:       logger,
+			skipCreation: false,
+			want: &engine{
+				client: _postgres,
+				config: &config{SkipCreation: false},
+				logger: logger,
+			},
+		},
+		{
+			failure:      false,
+			name:         "sqlite3",
+			client:       _sqlite,
+			logger:       logger,
+			skipCreation: false,
+			want: &engine{
+				client: _sqlite,
+				config: &config{SkipCreation: false},
+				logger: logger,
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(
+				WithClient(test.client),
+				WithLogger(test.logger),
+				WithSkipCreation(test.skipCreation),
+			)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("New for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+// testPostgres is a helper function to create a Postgres engine for testing.
+func testPostgres(t *testing.T) (*engine, sqlmock.Sqlmock) {
+	// create the new mock sql database
+	//
+	// https://pkg.go.dev/github.com/DATA-DOG/go-sqlmock#New
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("unable to create new SQL mock: %v", err)
+	}
+
+	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// create the new mock Postgres database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	_postgres, err := gorm.Open(
+		postgres.New(postgres.Config{Conn: _sql}),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new postgres database: %v", err)
+	}
+
+	_engine, err := New(
+		WithClient(_postgres),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithSkipCreation(false),
+	)
+	if err != nil {
+		t.Errorf("unable to create new postgres build credential engine: %v", err)
+	}
+
+	return _engine, _mock
+}
+
+// testSqlite is a helper function to create a Sqlite engine for testing.
+func testSqlite(t *testing.T) *engine {
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	_engine, err := New(
+		WithClient(_sqlite),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithSkipCreation(false),
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite build credential engine: %v", err)
+	}
+
+	return _engine
+}
+
+// testBuildCredentials is a test helper function to create an API
+// BuildCredentials type with all fields set to their zero values.
+func testBuildCredentials() *api.BuildCredentials {
+	return &api.BuildCredentials{
+		ID:         new(int64),
+		BuildID:    new(int64),
+		RepoID:     new(int64),
+		Registries: new([]string),
+		Created:    new(int64),
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildcredential
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateBuildCredentials creates the registry credentials granted to a build in the database.
+func (e *engine) CreateBuildCredentials(ctx context.Context, b *api.BuildCredentials) error {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetBuildID(),
+	}).Tracef("creating registry credentials for build %d in the database", b.GetBuildID())
+
+	// cast the API type to database type
+	credentials := types.BuildCredentialsFromAPI(b)
+
+	// validate the necessary fields are populated
+	err := credentials.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableBuildCredentials).
+		Create(credentials).
+		Error
+}
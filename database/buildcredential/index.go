@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildcredential
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateRepoIDIndex represents a query to create an
+	// index on the build_credentials table for the repo_id column.
+	CreateRepoIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+build_credentials_repo_id
+ON build_credentials (repo_id);
+`
+)
+
+// CreateBuildCredentialsIndexes creates the indexes for the build_credentials table in the database.
+func (e *engine) CreateBuildCredentialsIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for build_credentials table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the repo_id column index for the build_credentials table
+	return e.client.WithContext(ctx).Exec(CreateRepoIDIndex).Error
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildcredential
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// BuildCredentialService represents the Vela interface for build credential
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type BuildCredentialService interface {
+	// BuildCredential Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateBuildCredentialsIndexes defines a function that creates the indexes for the build_credentials table.
+	CreateBuildCredentialsIndexes(context.Context) error
+	// CreateBuildCredentialsTable defines a function that creates the build_credentials table.
+	CreateBuildCredentialsTable(context.Context, string) error
+
+	// BuildCredential Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateBuildCredentials defines a function that creates the registry credentials granted to a build.
+	CreateBuildCredentials(context.Context, *api.BuildCredentials) error
+	// GetBuildCredentialsForBuild defines a function that gets the registry credentials granted to a build.
+	GetBuildCredentialsForBuild(context.Context, *library.Build) (*api.BuildCredentials, error)
+}
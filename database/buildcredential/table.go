@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildcredential
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableBuildCredentials represents the name of the table for build credentials.
+	TableBuildCredentials = "build_credentials"
+
+	// CreatePostgresTable represents a query to create the Postgres build_credentials table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_credentials (
+	id            SERIAL PRIMARY KEY,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	registries    VARCHAR(1000),
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite build_credentials table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_credentials (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	registries    TEXT,
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL build_credentials table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_credentials (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	registries    VARCHAR(1000),
+	created       INTEGER,
+	UNIQUE(build_id),
+	INDEX build_credentials_repo_id (repo_id)
+);
+`
+)
+
+// CreateBuildCredentialsTable creates the build_credentials table in the database.
+func (e *engine) CreateBuildCredentialsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating build_credentials table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the build_credentials table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the build_credentials table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the build_credentials table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package builddeprecation
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateBuildDeprecations creates the deprecation warnings for a build in the database.
+func (e *engine) CreateBuildDeprecations(ctx context.Context, b *api.BuildDeprecations) error {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetBuildID(),
+	}).Tracef("creating deprecation warnings for build %d in the database", b.GetBuildID())
+
+	// cast the API type to database type
+	warnings := types.BuildDeprecationsFromAPI(b)
+
+	// validate the necessary fields are populated
+	err := warnings.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableBuildDeprecations).
+		Create(warnings).
+		Error
+}
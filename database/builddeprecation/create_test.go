@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package builddeprecation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBuildDeprecation_Engine_CreateBuildDeprecations(t *testing.T) {
+	// setup types
+	_warnings := testBuildDeprecations()
+	_warnings.SetID(1)
+	_warnings.SetBuildID(1)
+	_warnings.SetRepoID(1)
+	_warnings.SetWarnings([]string{"image golang:1.15 is deprecated"})
+	_warnings.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "build_deprecations"
+("build_id","repo_id","warnings","created","id")
+VALUES ($1,$2,$3,$4,$5) RETURNING "id"`).
+		WithArgs(1, 1, `{"image golang:1.15 is deprecated"}`, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateBuildDeprecations(context.TODO(), _warnings)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateBuildDeprecations for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateBuildDeprecations for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package builddeprecation
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// GetBuildDeprecationsForBuild gets the deprecation warnings for a build from the database.
+func (e *engine) GetBuildDeprecationsForBuild(ctx context.Context, b *library.Build) (*api.BuildDeprecations, error) {
+	e.logger.Tracef("getting deprecation warnings for build %d from the database", b.GetID())
+
+	// variable to store query results
+	c := new(types.BuildDeprecations)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableBuildDeprecations).
+		Where("build_id = ?", b.GetID()).
+		Take(c).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ToAPI(), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package builddeprecation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestBuildDeprecation_Engine_GetBuildDeprecationsForBuild(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_warnings := testBuildDeprecations()
+	_warnings.SetID(1)
+	_warnings.SetBuildID(1)
+	_warnings.SetRepoID(1)
+	_warnings.SetWarnings([]string{"image golang:1.15 is deprecated"})
+	_warnings.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "build_id", "repo_id", "warnings", "created"}).
+		AddRow(1, 1, 1, `{"image golang:1.15 is deprecated"}`, 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "build_deprecations" WHERE build_id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateBuildDeprecations(context.TODO(), _warnings)
+	if err != nil {
+		t.Errorf("unable to create test build deprecations for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.BuildDeprecations
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _warnings,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _warnings,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetBuildDeprecationsForBuild(context.TODO(), _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetBuildDeprecationsForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetBuildDeprecationsForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetBuildDeprecationsForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package builddeprecation
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// EngineOpt represents a configuration option to initialize the database engine for BuildDeprecations.
+type EngineOpt func(*engine) error
+
+// WithClient sets the gorm.io/gorm client in the database engine for BuildDeprecations.
+func WithClient(client *gorm.DB) EngineOpt {
+	return func(e *engine) error {
+		// set the gorm.io/gorm client in the build deprecation engine
+		e.client = client
+
+		return nil
+	}
+}
+
+// WithLogger sets the github.com/sirupsen/logrus logger in the database engine for BuildDeprecations.
+func WithLogger(logger *logrus.Entry) EngineOpt {
+	return func(e *engine) error {
+		// set the github.com/sirupsen/logrus logger in the build deprecation engine
+		e.logger = logger
+
+		return nil
+	}
+}
+
+// WithSkipCreation sets the skip creation logic in the database engine for BuildDeprecations.
+func WithSkipCreation(skipCreation bool) EngineOpt {
+	return func(e *engine) error {
+		// set to skip creating tables and indexes in the build deprecation engine
+		e.config.SkipCreation = skipCreation
+
+		return nil
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package builddeprecation
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// BuildDeprecationService represents the Vela interface for build deprecation
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type BuildDeprecationService interface {
+	// BuildDeprecation Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateBuildDeprecationsIndexes defines a function that creates the indexes for the build_deprecations table.
+	CreateBuildDeprecationsIndexes(context.Context) error
+	// CreateBuildDeprecationsTable defines a function that creates the build_deprecations table.
+	CreateBuildDeprecationsTable(context.Context, string) error
+
+	// BuildDeprecation Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateBuildDeprecations defines a function that creates the deprecation warnings for a build.
+	CreateBuildDeprecations(context.Context, *api.BuildDeprecations) error
+	// GetBuildDeprecationsForBuild defines a function that gets the deprecation warnings for a build.
+	GetBuildDeprecationsForBuild(context.Context, *library.Build) (*api.BuildDeprecations, error)
+}
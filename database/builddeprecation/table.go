@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package builddeprecation
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableBuildDeprecations represents the name of the table for build deprecations.
+	TableBuildDeprecations = "build_deprecations"
+
+	// CreatePostgresTable represents a query to create the Postgres build_deprecations table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_deprecations (
+	id            SERIAL PRIMARY KEY,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	warnings      VARCHAR(5000),
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite build_deprecations table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_deprecations (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	warnings      TEXT,
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL build_deprecations table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_deprecations (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	warnings      VARCHAR(5000),
+	created       INTEGER,
+	UNIQUE(build_id),
+	INDEX build_deprecations_repo_id (repo_id)
+);
+`
+)
+
+// CreateBuildDeprecationsTable creates the build_deprecations table in the database.
+func (e *engine) CreateBuildDeprecationsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating build_deprecations table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the build_deprecations table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the build_deprecations table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the build_deprecations table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
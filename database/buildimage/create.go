@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildimage
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateBuildImages creates the images pinned for a build in the database.
+func (e *engine) CreateBuildImages(ctx context.Context, b *api.BuildImages) error {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetBuildID(),
+	}).Tracef("creating pinned images for build %d in the database", b.GetBuildID())
+
+	// cast the API type to database type
+	images := types.BuildImagesFromAPI(b)
+
+	// validate the necessary fields are populated
+	err := images.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableBuildImages).
+		Create(images).
+		Error
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildimage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBuildImage_Engine_CreateBuildImages(t *testing.T) {
+	// setup types
+	_images := testBuildImages()
+	_images.SetID(1)
+	_images.SetBuildID(1)
+	_images.SetRepoID(1)
+	_images.SetImages([]string{"alpine:latest@sha256:c5b1261d6d3e"})
+	_images.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "build_images"
+("build_id","repo_id","images","created","id")
+VALUES ($1,$2,$3,$4,$5) RETURNING "id"`).
+		WithArgs(1, 1, `{"alpine:latest@sha256:c5b1261d6d3e"}`, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateBuildImages(context.TODO(), _images)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateBuildImages for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateBuildImages for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
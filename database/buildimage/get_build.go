@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildimage
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// GetBuildImagesForBuild gets the images pinned for a build from the database.
+func (e *engine) GetBuildImagesForBuild(ctx context.Context, b *library.Build) (*api.BuildImages, error) {
+	e.logger.Tracef("getting pinned images for build %d from the database", b.GetID())
+
+	// variable to store query results
+	c := new(types.BuildImages)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableBuildImages).
+		Where("build_id = ?", b.GetID()).
+		Take(c).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ToAPI(), nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildimage
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateRepoIDIndex represents a query to create an
+	// index on the build_images table for the repo_id column.
+	CreateRepoIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+build_images_repo_id
+ON build_images (repo_id);
+`
+)
+
+// CreateBuildImagesIndexes creates the indexes for the build_images table in the database.
+func (e *engine) CreateBuildImagesIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for build_images table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the repo_id column index for the build_images table
+	return e.client.WithContext(ctx).Exec(CreateRepoIDIndex).Error
+}
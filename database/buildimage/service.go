@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildimage
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// BuildImageService represents the Vela interface for build image
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type BuildImageService interface {
+	// BuildImage Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateBuildImagesIndexes defines a function that creates the indexes for the build_images table.
+	CreateBuildImagesIndexes(context.Context) error
+	// CreateBuildImagesTable defines a function that creates the build_images table.
+	CreateBuildImagesTable(context.Context, string) error
+
+	// BuildImage Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateBuildImages defines a function that creates the images pinned for a build.
+	CreateBuildImages(context.Context, *api.BuildImages) error
+	// GetBuildImagesForBuild defines a function that gets the images pinned for a build.
+	GetBuildImagesForBuild(context.Context, *library.Build) (*api.BuildImages, error)
+}
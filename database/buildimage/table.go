@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildimage
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableBuildImages represents the name of the table for build images.
+	TableBuildImages = "build_images"
+
+	// CreatePostgresTable represents a query to create the Postgres build_images table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_images (
+	id            SERIAL PRIMARY KEY,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	images        VARCHAR(5000),
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite build_images table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_images (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	images        TEXT,
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL build_images table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_images (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	images        VARCHAR(5000),
+	created       INTEGER,
+	UNIQUE(build_id),
+	INDEX build_images_repo_id (repo_id)
+);
+`
+)
+
+// CreateBuildImagesTable creates the build_images table in the database.
+func (e *engine) CreateBuildImagesTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating build_images table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the build_images table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the build_images table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the build_images table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the BuildRedactionService interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the BuildRedaction engine
+		SkipCreation bool
+	}
+
+	// engine represents the build redaction functionality that implements the BuildRedactionService interface.
+	engine struct {
+		// engine configuration settings used in build redaction functions
+		config *config
+
+		// gorm.io/gorm database client used in build redaction functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in build redaction functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with build redactions in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new BuildRedaction engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating build redaction database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of build_redactions table and indexes in the database")
+
+		return e, nil
+	}
+
+	// create the build_redactions table
+	err := e.CreateBuildRedactionsTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableBuildRedactions, err)
+	}
+
+	// create the indexes for the build_redactions table
+	err = e.CreateBuildRedactionsIndexes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create indexes for %s table: %w", TableBuildRedactions, err)
+	}
+
+	return e, nil
+}
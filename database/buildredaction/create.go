@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateBuildRedaction creates a record of the data captured
+// for a build being redacted or deleted in the database.
+func (e *engine) CreateBuildRedaction(ctx context.Context, r *api.BuildRedaction) error {
+	e.logger.WithFields(logrus.Fields{
+		"build":  r.GetBuildID(),
+		"action": r.GetAction(),
+		"actor":  r.GetActor(),
+	}).Tracef("creating %s record for build %d in the database", r.GetAction(), r.GetBuildID())
+
+	// cast the API type to database type
+	redaction := types.BuildRedactionFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := redaction.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableBuildRedactions).
+		Create(redaction).
+		Error
+}
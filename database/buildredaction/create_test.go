@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBuildRedaction_Engine_CreateBuildRedaction(t *testing.T) {
+	// setup types
+	_redaction := testBuildRedaction()
+	_redaction.SetID(1)
+	_redaction.SetRepoID(1)
+	_redaction.SetBuildID(1)
+	_redaction.SetAction("redacted")
+	_redaction.SetTargets([]string{"logs"})
+	_redaction.SetReason("token printed by debug step")
+	_redaction.SetMatches(2)
+	_redaction.SetActor("octocat")
+	_redaction.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "build_redactions"
+("repo_id","build_id","action","targets","reason","matches","actor","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs(1, 1, "redacted", `{"logs"}`, "token printed by debug step", 2, "octocat", 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateBuildRedaction(context.TODO(), _redaction)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateBuildRedaction for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateBuildRedaction for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the build_redactions table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+build_redactions_build_id
+ON build_redactions (build_id);
+`
+)
+
+// CreateBuildRedactionsIndexes creates the indexes for the build_redactions table in the database.
+func (e *engine) CreateBuildRedactionsIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for build_redactions table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the build_id column index for the build_redactions table
+	return e.client.WithContext(ctx).Exec(CreateBuildIDIndex).Error
+}
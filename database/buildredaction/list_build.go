@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListBuildRedactionsForBuild gets the records of the data captured
+// for a build being redacted or deleted from the database.
+func (e *engine) ListBuildRedactionsForBuild(ctx context.Context, b *library.Build) ([]*api.BuildRedaction, error) {
+	e.logger.Tracef("listing redactions for build %d from the database", b.GetID())
+
+	// variables to store query results and return value
+	r := new([]types.BuildRedaction)
+	redactions := []*api.BuildRedaction{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableBuildRedactions).
+		Where("build_id = ?", b.GetID()).
+		Order("id").
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, redaction := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := redaction
+
+		// convert query result to API type
+		redactions = append(redactions, tmp.ToAPI())
+	}
+
+	return redactions, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestBuildRedaction_Engine_ListBuildRedactionsForBuild(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+
+	_redactionOne := testBuildRedaction()
+	_redactionOne.SetID(1)
+	_redactionOne.SetRepoID(1)
+	_redactionOne.SetBuildID(1)
+	_redactionOne.SetAction("redacted")
+	_redactionOne.SetTargets([]string{"logs", "environment"})
+	_redactionOne.SetReason("token printed by debug step")
+	_redactionOne.SetMatches(2)
+	_redactionOne.SetActor("octocat")
+	_redactionOne.SetCreated(1)
+
+	_redactionTwo := testBuildRedaction()
+	_redactionTwo.SetID(2)
+	_redactionTwo.SetRepoID(1)
+	_redactionTwo.SetBuildID(1)
+	_redactionTwo.SetAction("deleted")
+	_redactionTwo.SetTargets([]string{"artifacts"})
+	_redactionTwo.SetMatches(1)
+	_redactionTwo.SetActor("octocat")
+	_redactionTwo.SetCreated(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "action", "targets", "reason", "matches", "actor", "created"}).
+		AddRow(1, 1, 1, "redacted", `{"logs","environment"}`, "token printed by debug step", 2, "octocat", 1).
+		AddRow(2, 1, 1, "deleted", `{"artifacts"}`, "", 1, "octocat", 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "build_redactions" WHERE build_id = $1 ORDER BY id`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, redaction := range []*api.BuildRedaction{_redactionOne, _redactionTwo} {
+		err := _sqlite.CreateBuildRedaction(context.TODO(), redaction)
+		if err != nil {
+			t.Errorf("unable to create test build redaction for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.BuildRedaction
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.BuildRedaction{_redactionOne, _redactionTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.BuildRedaction{_redactionOne, _redactionTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListBuildRedactionsForBuild(context.TODO(), _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListBuildRedactionsForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListBuildRedactionsForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListBuildRedactionsForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// BuildRedactionService represents the Vela interface for build redaction
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type BuildRedactionService interface {
+	// BuildRedaction Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateBuildRedactionsIndexes defines a function that creates the indexes for the build_redactions table.
+	CreateBuildRedactionsIndexes(context.Context) error
+	// CreateBuildRedactionsTable defines a function that creates the build_redactions table.
+	CreateBuildRedactionsTable(context.Context, string) error
+
+	// BuildRedaction Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateBuildRedaction defines a function that creates a record of the data for a build being redacted or deleted.
+	CreateBuildRedaction(context.Context, *api.BuildRedaction) error
+	// ListBuildRedactionsForBuild defines a function that gets the records of the data for a build being redacted or deleted.
+	ListBuildRedactionsForBuild(context.Context, *library.Build) ([]*api.BuildRedaction, error)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package buildredaction
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableBuildRedactions represents the name of the table for build redactions.
+	TableBuildRedactions = "build_redactions"
+
+	// CreatePostgresTable represents a query to create the Postgres build_redactions table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_redactions (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	action        VARCHAR(250),
+	targets       VARCHAR(1000),
+	reason        VARCHAR(1000),
+	matches       INTEGER,
+	actor         VARCHAR(250),
+	created       INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite build_redactions table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_redactions (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	action        TEXT,
+	targets       TEXT,
+	reason        TEXT,
+	matches       INTEGER,
+	actor         TEXT,
+	created       INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL build_redactions table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_redactions (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	action        VARCHAR(250),
+	targets       VARCHAR(1000),
+	reason        VARCHAR(1000),
+	matches       INTEGER,
+	actor         VARCHAR(250),
+	created       INTEGER,
+	INDEX build_redactions_build_id (build_id)
+);
+`
+)
+
+// CreateBuildRedactionsTable creates the build_redactions table in the database.
+func (e *engine) CreateBuildRedactionsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating build_redactions table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the build_redactions table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the build_redactions table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the build_redactions table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import "context"
+
+// CountCanaryRuns gets the count of all canary runs from the database.
+func (e *engine) CountCanaryRuns(ctx context.Context) (int64, error) {
+	e.logger.Tracef("getting count of all canary runs from the database")
+
+	// variable to store query results
+	var r int64
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableCanaryRun).
+		Count(&r).
+		Error
+
+	return r, err
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateCanaryRun creates a new canary run in the database.
+func (e *engine) CreateCanaryRun(ctx context.Context, r *api.CanaryRun) error {
+	e.logger.WithFields(logrus.Fields{
+		"route": r.GetRoute(),
+	}).Tracef("creating canary run for route %s in the database", r.GetRoute())
+
+	// cast the API type to database type
+	run := types.CanaryRunFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := run.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableCanaryRun).
+		Create(run).
+		Error
+}
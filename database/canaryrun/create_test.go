@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCanaryRun_Engine_CreateCanaryRun(t *testing.T) {
+	// setup types
+	_runOne := testCanaryRun()
+	_runOne.SetID(1)
+	_runOne.SetRoute("vela")
+	_runOne.SetRepoID(1)
+	_runOne.SetBuildID(1)
+	_runOne.SetStatus("success")
+	_runOne.SetCreated(1)
+	_runOne.SetFinished(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "canary_runs"
+("route","worker_group","repo_id","build_id","status","error","created","finished","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs("vela", nil, 1, 1, "success", nil, 1, 2, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateCanaryRun(context.TODO(), _runOne)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateCanaryRun for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateCanaryRun for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateRouteCreatedIndex represents a query to create an
+	// index on the canary_runs table for the route and created columns.
+	CreateRouteCreatedIndex = `
+CREATE INDEX
+IF NOT EXISTS
+canary_runs_route_created
+ON canary_runs (route, created);
+`
+
+	// CreateStatusIndex represents a query to create an
+	// index on the canary_runs table for the status column.
+	CreateStatusIndex = `
+CREATE INDEX
+IF NOT EXISTS
+canary_runs_status
+ON canary_runs (status);
+`
+)
+
+// CreateCanaryRunIndexes creates the indexes for the canary_runs table in the database.
+func (e *engine) CreateCanaryRunIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for canary_runs table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the route and created columns index for the canary_runs table
+	err := e.client.WithContext(ctx).Exec(CreateRouteCreatedIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the status column index for the canary_runs table
+	return e.client.WithContext(ctx).Exec(CreateStatusIndex).Error
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListCanaryRuns gets a list of all canary runs from the database.
+func (e *engine) ListCanaryRuns(ctx context.Context, page, perPage int) ([]*api.CanaryRun, int64, error) {
+	e.logger.Trace("listing all canary runs from the database")
+
+	// variables to store query results and return value
+	count := int64(0)
+	r := new([]types.CanaryRun)
+	runs := []*api.CanaryRun{}
+
+	// count the results
+	count, err := e.CountCanaryRuns(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return runs, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = e.client.WithContext(ctx).
+		Table(TableCanaryRun).
+		Order("id DESC").
+		Limit(perPage).
+		Offset(offset).
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, count, err
+	}
+
+	// iterate through all query results
+	for _, run := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := run
+
+		// convert query result to API type
+		runs = append(runs, tmp.ToAPI())
+	}
+
+	return runs, count, nil
+}
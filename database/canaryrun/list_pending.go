@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+// ListPendingCanaryRuns gets a list of all canary runs
+// that have not recorded a result from the database.
+func (e *engine) ListPendingCanaryRuns(ctx context.Context) ([]*api.CanaryRun, error) {
+	e.logger.Trace("listing all pending canary runs from the database")
+
+	// variables to store query results and return value
+	r := new([]types.CanaryRun)
+	runs := []*api.CanaryRun{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableCanaryRun).
+		Where("status = ?", constants.StatusPending).
+		Order("id").
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, run := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := run
+
+		// convert query result to API type
+		runs = append(runs, tmp.ToAPI())
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCanaryRun_Engine_ListPendingCanaryRuns(t *testing.T) {
+	// setup types
+	_runOne := testCanaryRun()
+	_runOne.SetID(1)
+	_runOne.SetRoute("vela")
+	_runOne.SetRepoID(1)
+	_runOne.SetBuildID(1)
+	_runOne.SetStatus("success")
+	_runOne.SetCreated(1)
+	_runOne.SetFinished(2)
+
+	_runTwo := testCanaryRun()
+	_runTwo.SetID(2)
+	_runTwo.SetRoute("compliance")
+	_runTwo.SetWorkerGroup("compliance")
+	_runTwo.SetRepoID(1)
+	_runTwo.SetBuildID(2)
+	_runTwo.SetStatus("pending")
+	_runTwo.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "route", "worker_group", "repo_id", "build_id", "status", "error", "created", "finished"}).
+		AddRow(2, "compliance", "compliance", 1, 2, "pending", "", 1, 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "canary_runs" WHERE status = $1 ORDER BY id`).WithArgs("pending").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCanaryRun(context.TODO(), _runOne)
+	if err != nil {
+		t.Errorf("unable to create test canary run for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateCanaryRun(context.TODO(), _runTwo)
+	if err != nil {
+		t.Errorf("unable to create test canary run for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.CanaryRun
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.CanaryRun{_runTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.CanaryRun{_runTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListPendingCanaryRuns(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListPendingCanaryRuns for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListPendingCanaryRuns for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListPendingCanaryRuns for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
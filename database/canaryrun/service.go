@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// CanaryRunService represents the Vela interface for canary run
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type CanaryRunService interface {
+	// CanaryRun Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateCanaryRunIndexes defines a function that creates the indexes for the canary_runs table.
+	CreateCanaryRunIndexes(context.Context) error
+	// CreateCanaryRunTable defines a function that creates the canary_runs table.
+	CreateCanaryRunTable(context.Context, string) error
+
+	// CanaryRun Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CountCanaryRuns defines a function that gets the count of all canary runs.
+	CountCanaryRuns(context.Context) (int64, error)
+	// CreateCanaryRun defines a function that creates a new canary run.
+	CreateCanaryRun(context.Context, *api.CanaryRun) error
+	// ListCanaryRuns defines a function that gets a list of all canary runs.
+	ListCanaryRuns(context.Context, int, int) ([]*api.CanaryRun, int64, error)
+	// ListPendingCanaryRuns defines a function that gets a list of pending canary runs.
+	ListPendingCanaryRuns(context.Context) ([]*api.CanaryRun, error)
+	// UpdateCanaryRun defines a function that updates an existing canary run.
+	UpdateCanaryRun(context.Context, *api.CanaryRun) error
+}
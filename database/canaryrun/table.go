@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableCanaryRun represents the name of the table for canary runs.
+	TableCanaryRun = "canary_runs"
+
+	// CreatePostgresTable represents a query to create the Postgres canary_runs table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+canary_runs (
+	id            SERIAL PRIMARY KEY,
+	route         VARCHAR(250),
+	worker_group  VARCHAR(250),
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	status        VARCHAR(250),
+	error         VARCHAR(1000),
+	created       INTEGER,
+	finished      INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite canary_runs table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+canary_runs (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	route         TEXT,
+	worker_group  TEXT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	status        TEXT,
+	error         TEXT,
+	created       INTEGER,
+	finished      INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL canary_runs table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+canary_runs (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	route         VARCHAR(250),
+	worker_group  VARCHAR(250),
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	status        VARCHAR(250),
+	error         VARCHAR(1000),
+	created       INTEGER,
+	finished      INTEGER,
+	INDEX canary_runs_route_created (route, created),
+	INDEX canary_runs_status (status)
+);
+`
+)
+
+// CreateCanaryRunTable creates the canary_runs table in the database.
+func (e *engine) CreateCanaryRunTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating canary_runs table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the canary_runs table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the canary_runs table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the canary_runs table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
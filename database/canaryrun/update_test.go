@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package canaryrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCanaryRun_Engine_UpdateCanaryRun(t *testing.T) {
+	// setup types
+	_runOne := testCanaryRun()
+	_runOne.SetID(1)
+	_runOne.SetRoute("vela")
+	_runOne.SetRepoID(1)
+	_runOne.SetBuildID(1)
+	_runOne.SetStatus("success")
+	_runOne.SetCreated(1)
+	_runOne.SetFinished(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "canary_runs"
+SET "route"=$1,"worker_group"=$2,"repo_id"=$3,"build_id"=$4,"status"=$5,"error"=$6,"created"=$7,"finished"=$8
+WHERE "id" = $9`).
+		WithArgs("vela", nil, 1, 1, "success", nil, 1, 2, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCanaryRun(context.TODO(), _runOne)
+	if err != nil {
+		t.Errorf("unable to create test canary run for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateCanaryRun(context.TODO(), _runOne)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateCanaryRun for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateCanaryRun for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
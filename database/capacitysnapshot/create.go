@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// CreateCapacitySnapshot creates a new capacity snapshot in the database.
+func (e *engine) CreateCapacitySnapshot(ctx context.Context, s *api.CapacitySnapshot) error {
+	e.logger.Tracef("creating capacity snapshot for %d in the database", s.GetCreated())
+
+	// cast the API type to database type
+	snapshot := types.CapacitySnapshotFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := snapshot.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableCapacitySnapshot).
+		Create(snapshot).
+		Error
+}
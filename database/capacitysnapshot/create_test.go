@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCapacitySnapshot_Engine_CreateCapacitySnapshot(t *testing.T) {
+	// setup types
+	_snapshotOne := testCapacitySnapshot()
+	_snapshotOne.SetID(1)
+	_snapshotOne.SetActiveWorkers(2)
+	_snapshotOne.SetBuildLimit(4)
+	_snapshotOne.SetRunning(2)
+	_snapshotOne.SetUtilization(0.5)
+	_snapshotOne.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "capacity_snapshots"
+("active_workers","inactive_workers","build_limit","running","pending","utilization","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs(2, nil, 4, 2, nil, 0.5, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateCapacitySnapshot(context.TODO(), _snapshotOne)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateCapacitySnapshot for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateCapacitySnapshot for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
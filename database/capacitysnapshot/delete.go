@@ -0,0 +1,24 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+// DeleteCapacitySnapshotsBefore deletes the capacity snapshots created
+// before the provided unix timestamp from the database.
+func (e *engine) DeleteCapacitySnapshotsBefore(ctx context.Context, before int64) error {
+	e.logger.Tracef("deleting capacity snapshots created before %d from the database", before)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableCapacitySnapshot).
+		Where("created < ?", before).
+		Delete(&types.CapacitySnapshot{}).
+		Error
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCapacitySnapshot_Engine_DeleteCapacitySnapshotsBefore(t *testing.T) {
+	// setup types
+	_snapshotOne := testCapacitySnapshot()
+	_snapshotOne.SetID(1)
+	_snapshotOne.SetActiveWorkers(2)
+	_snapshotOne.SetBuildLimit(4)
+	_snapshotOne.SetRunning(2)
+	_snapshotOne.SetUtilization(0.5)
+	_snapshotOne.SetCreated(1)
+
+	_snapshotTwo := testCapacitySnapshot()
+	_snapshotTwo.SetID(2)
+	_snapshotTwo.SetActiveWorkers(2)
+	_snapshotTwo.SetInactiveWorkers(1)
+	_snapshotTwo.SetBuildLimit(4)
+	_snapshotTwo.SetRunning(4)
+	_snapshotTwo.SetPending(3)
+	_snapshotTwo.SetUtilization(1)
+	_snapshotTwo.SetCreated(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "capacity_snapshots" WHERE created < $1`).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCapacitySnapshot(context.TODO(), _snapshotOne)
+	if err != nil {
+		t.Errorf("unable to create test capacity snapshot for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateCapacitySnapshot(context.TODO(), _snapshotTwo)
+	if err != nil {
+		t.Errorf("unable to create test capacity snapshot for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteCapacitySnapshotsBefore(context.TODO(), 2)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteCapacitySnapshotsBefore for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteCapacitySnapshotsBefore for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateCreatedIndex represents a query to create an
+	// index on the capacity_snapshots table for the created column.
+	CreateCreatedIndex = `
+CREATE INDEX
+IF NOT EXISTS
+capacity_snapshots_created
+ON capacity_snapshots (created);
+`
+)
+
+// CreateCapacitySnapshotIndexes creates the indexes for the capacity_snapshots table in the database.
+func (e *engine) CreateCapacitySnapshotIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for capacity_snapshots table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the created column index for the capacity_snapshots table
+	return e.client.WithContext(ctx).Exec(CreateCreatedIndex).Error
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListCapacitySnapshots gets a list of the capacity snapshots created
+// after and before the provided unix timestamps from the database.
+func (e *engine) ListCapacitySnapshots(ctx context.Context, after, before int64) ([]*api.CapacitySnapshot, error) {
+	e.logger.Tracef("listing capacity snapshots created between %d and %d from the database", after, before)
+
+	// variables to store query results and return value
+	s := new([]types.CapacitySnapshot)
+	snapshots := []*api.CapacitySnapshot{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableCapacitySnapshot).
+		Where("created > ?", after).
+		Where("created < ?", before).
+		Order("created").
+		Find(&s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, snapshot := range *s {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := snapshot
+
+		// convert query result to API type
+		snapshots = append(snapshots, tmp.ToAPI())
+	}
+
+	return snapshots, nil
+}
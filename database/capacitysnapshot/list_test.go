@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCapacitySnapshot_Engine_ListCapacitySnapshots(t *testing.T) {
+	// setup types
+	_snapshotOne := testCapacitySnapshot()
+	_snapshotOne.SetID(1)
+	_snapshotOne.SetActiveWorkers(2)
+	_snapshotOne.SetBuildLimit(4)
+	_snapshotOne.SetRunning(2)
+	_snapshotOne.SetUtilization(0.5)
+	_snapshotOne.SetCreated(1)
+
+	_snapshotTwo := testCapacitySnapshot()
+	_snapshotTwo.SetID(2)
+	_snapshotTwo.SetActiveWorkers(2)
+	_snapshotTwo.SetInactiveWorkers(1)
+	_snapshotTwo.SetBuildLimit(4)
+	_snapshotTwo.SetRunning(4)
+	_snapshotTwo.SetPending(3)
+	_snapshotTwo.SetUtilization(1)
+	_snapshotTwo.SetCreated(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "active_workers", "inactive_workers", "build_limit", "running", "pending", "utilization", "created"}).
+		AddRow(1, 2, 0, 4, 2, 0, 0.5, 1).
+		AddRow(2, 2, 1, 4, 4, 3, 1, 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "capacity_snapshots" WHERE created > $1 AND created < $2 ORDER BY created`).
+		WithArgs(0, 3).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCapacitySnapshot(context.TODO(), _snapshotOne)
+	if err != nil {
+		t.Errorf("unable to create test capacity snapshot for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateCapacitySnapshot(context.TODO(), _snapshotTwo)
+	if err != nil {
+		t.Errorf("unable to create test capacity snapshot for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.CapacitySnapshot
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.CapacitySnapshot{_snapshotOne, _snapshotTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.CapacitySnapshot{_snapshotOne, _snapshotTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListCapacitySnapshots(context.TODO(), 0, 3)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListCapacitySnapshots for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListCapacitySnapshots for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListCapacitySnapshots for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
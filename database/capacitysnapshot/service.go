@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// CapacitySnapshotService represents the Vela interface for capacity
+// snapshot functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type CapacitySnapshotService interface {
+	// CapacitySnapshot Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateCapacitySnapshotIndexes defines a function that creates the indexes for the capacity_snapshots table.
+	CreateCapacitySnapshotIndexes(context.Context) error
+	// CreateCapacitySnapshotTable defines a function that creates the capacity_snapshots table.
+	CreateCapacitySnapshotTable(context.Context, string) error
+
+	// CapacitySnapshot Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateCapacitySnapshot defines a function that creates a new capacity snapshot.
+	CreateCapacitySnapshot(context.Context, *api.CapacitySnapshot) error
+	// DeleteCapacitySnapshotsBefore defines a function that deletes the capacity snapshots created before a time.
+	DeleteCapacitySnapshotsBefore(context.Context, int64) error
+	// ListCapacitySnapshots defines a function that gets a list of the capacity snapshots created within a time range.
+	ListCapacitySnapshots(context.Context, int64, int64) ([]*api.CapacitySnapshot, error)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package capacitysnapshot
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableCapacitySnapshot represents the name of the table for capacity snapshots.
+	TableCapacitySnapshot = "capacity_snapshots"
+
+	// CreatePostgresTable represents a query to create the Postgres capacity_snapshots table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+capacity_snapshots (
+	id                SERIAL PRIMARY KEY,
+	active_workers    INTEGER,
+	inactive_workers  INTEGER,
+	build_limit       INTEGER,
+	running           INTEGER,
+	pending           INTEGER,
+	utilization       REAL,
+	created           INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite capacity_snapshots table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+capacity_snapshots (
+	id                INTEGER PRIMARY KEY AUTOINCREMENT,
+	active_workers    INTEGER,
+	inactive_workers  INTEGER,
+	build_limit       INTEGER,
+	running           INTEGER,
+	pending           INTEGER,
+	utilization       REAL,
+	created           INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL capacity_snapshots table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+capacity_snapshots (
+	id                INTEGER PRIMARY KEY AUTO_INCREMENT,
+	active_workers    INTEGER,
+	inactive_workers  INTEGER,
+	build_limit       INTEGER,
+	running           INTEGER,
+	pending           INTEGER,
+	utilization       REAL,
+	created           INTEGER,
+	INDEX capacity_snapshots_created (created)
+);
+`
+)
+
+// CreateCapacitySnapshotTable creates the capacity_snapshots table in the database.
+func (e *engine) CreateCapacitySnapshotTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating capacity_snapshots table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the capacity_snapshots table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the capacity_snapshots table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the capacity_snapshots table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCloneSetting_Engine_CreateCloneSetting(t *testing.T) {
+	// setup types
+	_setting := testCloneSetting()
+	_setting.SetID(1)
+	_setting.SetRepoID(1)
+	_setting.SetOrg("foo")
+	_setting.SetSubmodules("enabled")
+	_setting.SetSubmoduleDepth(1)
+	_setting.SetLFS("disabled")
+	_setting.SetDepth(1)
+	_setting.SetSingleBranch("enabled")
+	_setting.SetTags("disabled")
+	_setting.SetUpdatedAt(1)
+	_setting.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "clone_settings"
+("repo_id","org","submodules","submodule_depth","lfs","depth","single_branch","tags","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`).
+		WithArgs(1, "foo", "enabled", 1, "disabled", 1, "enabled", "disabled", 1, "octocat", 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateCloneSetting(context.TODO(), _setting)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateCloneSetting for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateCloneSetting for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
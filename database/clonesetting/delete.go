@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteCloneSetting deletes an existing clone setting from the database.
+func (e *engine) DeleteCloneSetting(ctx context.Context, s *api.CloneSetting) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo_id": s.GetRepoID(),
+	}).Tracef("deleting clone setting for repo %d from the database", s.GetRepoID())
+
+	// cast the API type to database type
+	setting := types.CloneSettingFromAPI(s)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableCloneSetting).
+		Delete(setting).
+		Error
+}
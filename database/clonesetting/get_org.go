@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// GetCloneSettingForOrg gets the default clone setting for an org from the database.
+func (e *engine) GetCloneSettingForOrg(ctx context.Context, org string) (*api.CloneSetting, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("getting clone setting for org %s from the database", org)
+
+	// variable to store query results
+	s := new(types.CloneSetting)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableCloneSetting).
+		Where("org = ?", org).
+		Where("repo_id IS NULL").
+		Take(s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ToAPI(), nil
+}
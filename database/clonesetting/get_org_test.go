@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCloneSetting_Engine_GetCloneSettingForOrg(t *testing.T) {
+	// setup types
+	_setting := testCloneSetting()
+	_setting.SetID(1)
+	_setting.SetOrg("foo")
+	_setting.SetSubmodules("auto")
+	_setting.SetLFS("auto")
+	_setting.SetDepth(50)
+	_setting.SetSingleBranch("enabled")
+	_setting.SetTags("disabled")
+	_setting.SetUpdatedAt(1)
+	_setting.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "org", "submodules", "submodule_depth", "lfs", "depth", "single_branch", "tags", "updated_at", "updated_by"}).
+		AddRow(1, 0, "foo", "auto", 0, "auto", 50, "enabled", "disabled", 1, "octocat")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "clone_settings" WHERE org = $1 AND repo_id IS NULL LIMIT 1`).WithArgs("foo").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCloneSetting(context.TODO(), _setting)
+	if err != nil {
+		t.Errorf("unable to create test clone setting for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.CloneSetting
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _setting,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _setting,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetCloneSettingForOrg(context.TODO(), "foo")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetCloneSettingForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetCloneSettingForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetCloneSettingForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
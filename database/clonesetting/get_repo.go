@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetCloneSettingForRepo gets a clone setting by repo from the database.
+func (e *engine) GetCloneSettingForRepo(ctx context.Context, r *library.Repo) (*api.CloneSetting, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("getting clone setting for repo %s from the database", r.GetFullName())
+
+	// variable to store query results
+	s := new(types.CloneSetting)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableCloneSetting).
+		Where("repo_id = ?", r.GetID()).
+		Take(s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ToAPI(), nil
+}
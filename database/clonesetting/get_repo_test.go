@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestCloneSetting_Engine_GetCloneSettingForRepo(t *testing.T) {
+	// setup types
+	_setting := testCloneSetting()
+	_setting.SetID(1)
+	_setting.SetRepoID(1)
+	_setting.SetOrg("foo")
+	_setting.SetSubmodules("enabled")
+	_setting.SetSubmoduleDepth(1)
+	_setting.SetLFS("disabled")
+	_setting.SetDepth(1)
+	_setting.SetSingleBranch("enabled")
+	_setting.SetTags("disabled")
+	_setting.SetUpdatedAt(1)
+	_setting.SetUpdatedBy("octocat")
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "org", "submodules", "submodule_depth", "lfs", "depth", "single_branch", "tags", "updated_at", "updated_by"}).
+		AddRow(1, 1, "foo", "enabled", 1, "disabled", 1, "enabled", "disabled", 1, "octocat")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "clone_settings" WHERE repo_id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCloneSetting(context.TODO(), _setting)
+	if err != nil {
+		t.Errorf("unable to create test clone setting for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.CloneSetting
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _setting,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _setting,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetCloneSettingForRepo(context.TODO(), _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetCloneSettingForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetCloneSettingForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetCloneSettingForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// CloneSettingService represents the Vela interface for clone setting
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type CloneSettingService interface {
+	// CloneSetting Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateCloneSettingTable defines a function that creates the clone_settings table.
+	CreateCloneSettingTable(context.Context, string) error
+
+	// CloneSetting Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateCloneSetting defines a function that creates a new clone setting.
+	CreateCloneSetting(context.Context, *api.CloneSetting) error
+	// DeleteCloneSetting defines a function that deletes an existing clone setting.
+	DeleteCloneSetting(context.Context, *api.CloneSetting) error
+	// GetCloneSettingForOrg defines a function that gets the default clone setting for an org.
+	GetCloneSettingForOrg(context.Context, string) (*api.CloneSetting, error)
+	// GetCloneSettingForRepo defines a function that gets a clone setting by repo.
+	GetCloneSettingForRepo(context.Context, *library.Repo) (*api.CloneSetting, error)
+	// UpdateCloneSetting defines a function that updates an existing clone setting.
+	UpdateCloneSetting(context.Context, *api.CloneSetting) error
+}
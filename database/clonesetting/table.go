@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableCloneSetting represents the name of the table for the clone settings of repos and orgs.
+	TableCloneSetting = "clone_settings"
+
+	// CreatePostgresTable represents a query to create the Postgres clone_settings table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+clone_settings (
+	id              SERIAL PRIMARY KEY,
+	repo_id         INTEGER,
+	org             VARCHAR(250),
+	submodules      VARCHAR(50),
+	submodule_depth INTEGER,
+	lfs             VARCHAR(50),
+	depth           INTEGER,
+	single_branch   VARCHAR(50),
+	tags            VARCHAR(50),
+	updated_at      INTEGER,
+	updated_by      VARCHAR(250),
+	UNIQUE(repo_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite clone_settings table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+clone_settings (
+	id              INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id         INTEGER,
+	org             TEXT,
+	submodules      TEXT,
+	submodule_depth INTEGER,
+	lfs             TEXT,
+	depth           INTEGER,
+	single_branch   TEXT,
+	tags            TEXT,
+	updated_at      INTEGER,
+	updated_by      TEXT,
+	UNIQUE(repo_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL clone_settings table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+clone_settings (
+	id              INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id         INTEGER,
+	org             VARCHAR(250),
+	submodules      VARCHAR(50),
+	submodule_depth INTEGER,
+	lfs             VARCHAR(50),
+	depth           INTEGER,
+	single_branch   VARCHAR(50),
+	tags            VARCHAR(50),
+	updated_at      INTEGER,
+	updated_by      VARCHAR(250),
+	UNIQUE(repo_id)
+);
+`
+)
+
+// CreateCloneSettingTable creates the clone_settings table in the database.
+func (e *engine) CreateCloneSettingTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating clone_settings table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the clone_settings table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the clone_settings table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the clone_settings table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
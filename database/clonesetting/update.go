@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateCloneSetting updates an existing clone setting in the database.
+func (e *engine) UpdateCloneSetting(ctx context.Context, s *api.CloneSetting) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo_id": s.GetRepoID(),
+	}).Tracef("updating clone setting for repo %d in the database", s.GetRepoID())
+
+	// cast the API type to database type
+	setting := types.CloneSettingFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := setting.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableCloneSetting).
+		Save(setting).
+		Error
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package clonesetting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCloneSetting_Engine_UpdateCloneSetting(t *testing.T) {
+	// setup types
+	_setting := testCloneSetting()
+	_setting.SetID(1)
+	_setting.SetRepoID(1)
+	_setting.SetOrg("foo")
+	_setting.SetSubmodules("enabled")
+	_setting.SetSubmoduleDepth(1)
+	_setting.SetLFS("disabled")
+	_setting.SetDepth(1)
+	_setting.SetSingleBranch("enabled")
+	_setting.SetTags("disabled")
+	_setting.SetUpdatedAt(1)
+	_setting.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "clone_settings"
+SET "repo_id"=$1,"org"=$2,"submodules"=$3,"submodule_depth"=$4,"lfs"=$5,"depth"=$6,"single_branch"=$7,"tags"=$8,"updated_at"=$9,"updated_by"=$10
+WHERE "id" = $11`).
+		WithArgs(1, "foo", "enabled", 1, "disabled", 1, "enabled", "disabled", 1, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCloneSetting(context.TODO(), _setting)
+	if err != nil {
+		t.Errorf("unable to create test clone setting for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateCloneSetting(context.TODO(), _setting)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateCloneSetting for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateCloneSetting for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the CostRateService interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the CostRate engine
+		SkipCreation bool
+	}
+
+	// engine represents the cost rate functionality that implements the CostRateService interface.
+	engine struct {
+		// engine configuration settings used in cost rate functions
+		config *config
+
+		// gorm.io/gorm database client used in cost rate functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in cost rate functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with cost rates in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new CostRate engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating cost rate database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of cost_rates table in the database")
+
+		return e, nil
+	}
+
+	// create the cost_rates table
+	err := e.CreateCostRateTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableCostRate, err)
+	}
+
+	return e, nil
+}
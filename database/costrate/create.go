@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateCostRate creates a new cost rate in the database.
+func (e *engine) CreateCostRate(ctx context.Context, r *api.CostRate) error {
+	e.logger.WithFields(logrus.Fields{
+		"class": r.GetClass(),
+	}).Tracef("creating cost rate for class %s in the database", r.GetClass())
+
+	// cast the API type to database type
+	rate := types.CostRateFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := rate.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableCostRate).
+		Create(rate).
+		Error
+}
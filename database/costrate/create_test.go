@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCostRate_Engine_CreateCostRate(t *testing.T) {
+	// setup types
+	_rate := testCostRate()
+	_rate.SetID(1)
+	_rate.SetClass("large:linux")
+	_rate.SetRate(0.016)
+	_rate.SetDescription("8 vCPU linux workers")
+	_rate.SetCreatedAt(1)
+	_rate.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "cost_rates"
+("class","rate","description","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs("large:linux", 0.016, "8 vCPU linux workers", 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateCostRate(context.TODO(), _rate)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateCostRate for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateCostRate for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteCostRate deletes an existing cost rate from the database.
+func (e *engine) DeleteCostRate(ctx context.Context, r *api.CostRate) error {
+	e.logger.WithFields(logrus.Fields{
+		"class": r.GetClass(),
+	}).Tracef("deleting cost rate for class %s from the database", r.GetClass())
+
+	// cast the API type to database type
+	rate := types.CostRateFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableCostRate).
+		Delete(rate).
+		Error
+}
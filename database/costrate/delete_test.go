@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCostRate_Engine_DeleteCostRate(t *testing.T) {
+	// setup types
+	_rate := testCostRate()
+	_rate.SetID(1)
+	_rate.SetClass("large:linux")
+	_rate.SetRate(0.016)
+	_rate.SetDescription("8 vCPU linux workers")
+	_rate.SetCreatedAt(1)
+	_rate.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "cost_rates" WHERE "cost_rates"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCostRate(context.TODO(), _rate)
+	if err != nil {
+		t.Errorf("unable to create test cost rate for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteCostRate(context.TODO(), _rate)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteCostRate for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteCostRate for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
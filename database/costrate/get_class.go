@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetCostRateForClass gets a cost rate by org name from the database.
+func (e *engine) GetCostRateForClass(ctx context.Context, class string) (*api.CostRate, error) {
+	e.logger.Tracef("getting cost rate for class %s from the database", class)
+
+	// variable to store query results
+	r := new(types.CostRate)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableCostRate).
+		Where("class = ?", class).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ToAPI(), nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCostRate_Engine_GetCostRateForClass(t *testing.T) {
+	// setup types
+	_rate := testCostRate()
+	_rate.SetID(1)
+	_rate.SetClass("large:linux")
+	_rate.SetRate(0.016)
+	_rate.SetDescription("8 vCPU linux workers")
+	_rate.SetCreatedAt(1)
+	_rate.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "class", "rate", "description", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "large:linux", 0.016, "8 vCPU linux workers", 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "cost_rates" WHERE class = $1 LIMIT 1`).WithArgs("large:linux").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCostRate(context.TODO(), _rate)
+	if err != nil {
+		t.Errorf("unable to create test cost rate for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.CostRate
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _rate,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _rate,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetCostRateForClass(context.TODO(), "large:linux")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetCostRateForClass for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetCostRateForClass for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetCostRateForClass for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListCostRates gets a list of all cost rates from the database.
+func (e *engine) ListCostRates(ctx context.Context) ([]*api.CostRate, error) {
+	e.logger.Tracef("listing all cost rates from the database")
+
+	// variables to store query results and return value
+	r := new([]types.CostRate)
+	rates := []*api.CostRate{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableCostRate).
+		Order("class").
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, rate := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := rate
+
+		// convert query result to API type
+		rates = append(rates, tmp.ToAPI())
+	}
+
+	return rates, nil
+}
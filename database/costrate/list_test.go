@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCostRate_Engine_ListCostRates(t *testing.T) {
+	// setup types
+	_rateOne := testCostRate()
+	_rateOne.SetID(1)
+	_rateOne.SetClass("large:linux")
+	_rateOne.SetRate(0.016)
+	_rateOne.SetCreatedAt(1)
+	_rateOne.SetCreatedBy("octocat")
+
+	_rateTwo := testCostRate()
+	_rateTwo.SetID(2)
+	_rateTwo.SetClass("vela")
+	_rateTwo.SetRate(0.008)
+	_rateTwo.SetDescription("2 vCPU linux workers")
+	_rateTwo.SetCreatedAt(1)
+	_rateTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "class", "rate", "description", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "large:linux", 0.016, "", 1, "octocat", 0, "").
+		AddRow(2, "vela", 0.008, "2 vCPU linux workers", 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "cost_rates" ORDER BY class`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCostRate(context.TODO(), _rateOne)
+	if err != nil {
+		t.Errorf("unable to create test cost rate for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateCostRate(context.TODO(), _rateTwo)
+	if err != nil {
+		t.Errorf("unable to create test cost rate for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.CostRate
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.CostRate{_rateOne, _rateTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.CostRate{_rateOne, _rateTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListCostRates(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListCostRates for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListCostRates for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListCostRates for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
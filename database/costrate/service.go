@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// CostRateService represents the Vela interface for cost rate
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type CostRateService interface {
+	// CostRate Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateCostRateTable defines a function that creates the cost_rates table.
+	CreateCostRateTable(context.Context, string) error
+
+	// CostRate Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateCostRate defines a function that creates a new cost rate.
+	CreateCostRate(context.Context, *api.CostRate) error
+	// DeleteCostRate defines a function that deletes an existing cost rate.
+	DeleteCostRate(context.Context, *api.CostRate) error
+	// GetCostRateForClass defines a function that gets a cost rate by class.
+	GetCostRateForClass(context.Context, string) (*api.CostRate, error)
+	// ListCostRates defines a function that gets a list of all cost rates.
+	ListCostRates(context.Context) ([]*api.CostRate, error)
+	// UpdateCostRate defines a function that updates an existing cost rate.
+	UpdateCostRate(context.Context, *api.CostRate) error
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableCostRate represents the name of the table for cost rates.
+	TableCostRate = "cost_rates"
+
+	// CreatePostgresTable represents a query to create the Postgres cost_rates table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+cost_rates (
+	id            SERIAL PRIMARY KEY,
+	class         VARCHAR(250),
+	rate          DOUBLE PRECISION,
+	description   VARCHAR(1000),
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(class)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite cost_rates table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+cost_rates (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	class         TEXT,
+	rate          REAL,
+	description   TEXT,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(class)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL cost_rates table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+cost_rates (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	class         VARCHAR(250),
+	rate          DOUBLE PRECISION,
+	description   VARCHAR(1000),
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(class)
+);
+`
+)
+
+// CreateCostRateTable creates the cost_rates table in the database.
+func (e *engine) CreateCostRateTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating cost_rates table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the cost_rates table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the cost_rates table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the cost_rates table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
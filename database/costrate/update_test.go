@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package costrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCostRate_Engine_UpdateCostRate(t *testing.T) {
+	// setup types
+	_rate := testCostRate()
+	_rate.SetID(1)
+	_rate.SetClass("large:linux")
+	_rate.SetRate(0.016)
+	_rate.SetDescription("8 vCPU linux workers")
+	_rate.SetCreatedAt(1)
+	_rate.SetCreatedBy("octocat")
+	_rate.SetUpdatedAt(2)
+	_rate.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "cost_rates"
+SET "class"=$1,"rate"=$2,"description"=$3,"created_at"=$4,"created_by"=$5,"updated_at"=$6,"updated_by"=$7
+WHERE "id" = $8`).
+		WithArgs("large:linux", 0.016, "8 vCPU linux workers", 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateCostRate(context.TODO(), _rate)
+	if err != nil {
+		t.Errorf("unable to create test cost rate for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateCostRate(context.TODO(), _rate)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateCostRate for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateCostRate for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package database
 import (
 	"fmt"
 
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/types/constants"
 
 	"github.com/sirupsen/logrus"
@@ -17,6 +18,7 @@ import (
 //
 // Currently the following database providers are supported:
 //
+// * MySQL
 // * Postgres
 // * Sqlite
 // .
@@ -37,6 +39,11 @@ func New(s *Setup) (Service, error) {
 		//
 		// https://pkg.go.dev/github.com/go-vela/server/database?tab=doc#Setup.Postgres
 		return s.Postgres()
+	case types.DriverMySQL:
+		// handle the MySQL database driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/database?tab=doc#Setup.MySQL
+		return s.MySQL()
 	case constants.DriverSqlite:
 		// handle the Sqlite database driver being provided
 		//
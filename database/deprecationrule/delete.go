@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteDeprecationRule deletes an existing deprecation rule from the database.
+func (e *engine) DeleteDeprecationRule(ctx context.Context, r *api.DeprecationRule) error {
+	e.logger.WithFields(logrus.Fields{
+		"rule": r.GetID(),
+	}).Tracef("deleting deprecation rule %d from the database", r.GetID())
+
+	// cast the API type to database type
+	rule := types.DeprecationRuleFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableDeprecationRule).
+		Delete(rule).
+		Error
+}
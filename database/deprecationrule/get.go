@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetDeprecationRule gets a deprecation rule by ID from the database.
+func (e *engine) GetDeprecationRule(ctx context.Context, id int64) (*api.DeprecationRule, error) {
+	e.logger.Tracef("getting deprecation rule %d from the database", id)
+
+	// variable to store query results
+	m := new(types.DeprecationRule)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableDeprecationRule).
+		Where("id = ?", id).
+		Take(m).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return m.ToAPI(), nil
+}
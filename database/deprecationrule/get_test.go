@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestDeprecationRule_Engine_GetDeprecationRule(t *testing.T) {
+	// setup types
+	_rule := testDeprecationRule()
+	_rule.SetID(1)
+	_rule.SetKind("image")
+	_rule.SetPattern("golang:1.1*")
+	_rule.SetMessage("upgrade")
+	_rule.SetSunset(1)
+	_rule.SetComment(true)
+	_rule.SetActive(true)
+	_rule.SetCreatedAt(1)
+	_rule.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "kind", "pattern", "message", "sunset", "comment", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "image", "golang:1.1*", "upgrade", 1, true, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "deprecation_rules" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateDeprecationRule(context.TODO(), _rule)
+	if err != nil {
+		t.Errorf("unable to create test deprecation rule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.DeprecationRule
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _rule,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _rule,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetDeprecationRule(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetDeprecationRule for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetDeprecationRule for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetDeprecationRule for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateKindIndex represents a query to create an
+	// index on the deprecation_rules table for the kind column.
+	CreateKindIndex = `
+CREATE INDEX
+IF NOT EXISTS
+deprecation_rules_kind
+ON deprecation_rules (kind);
+`
+)
+
+// CreateDeprecationRuleIndexes creates the indexes for the deprecation_rules table in the database.
+func (e *engine) CreateDeprecationRuleIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for deprecation_rules table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the kind column index for the deprecation_rules table
+	return e.client.WithContext(ctx).Exec(CreateKindIndex).Error
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListDeprecationRules gets a list of all deprecation rules from the database.
+func (e *engine) ListDeprecationRules(ctx context.Context) ([]*api.DeprecationRule, error) {
+	e.logger.Trace("listing all deprecation rules from the database")
+
+	// variables to store query results and return value
+	m := new([]types.DeprecationRule)
+	rules := []*api.DeprecationRule{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableDeprecationRule).
+		Order("id").
+		Find(&m).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, rule := range *m {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := rule
+
+		// convert query result to API type
+		rules = append(rules, tmp.ToAPI())
+	}
+
+	return rules, nil
+}
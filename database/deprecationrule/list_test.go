@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestDeprecationRule_Engine_ListDeprecationRules(t *testing.T) {
+	// setup types
+	_ruleOne := testDeprecationRule()
+	_ruleOne.SetID(1)
+	_ruleOne.SetKind("image")
+	_ruleOne.SetPattern("golang:1.1*")
+	_ruleOne.SetMessage("upgrade")
+	_ruleOne.SetSunset(1)
+	_ruleOne.SetComment(true)
+	_ruleOne.SetActive(true)
+	_ruleOne.SetCreatedAt(1)
+	_ruleOne.SetCreatedBy("octocat")
+
+	_ruleTwo := testDeprecationRule()
+	_ruleTwo.SetID(2)
+	_ruleTwo.SetKind("image")
+	_ruleTwo.SetPattern("node:14*")
+	_ruleTwo.SetMessage("upgrade")
+	_ruleTwo.SetSunset(1)
+	_ruleTwo.SetComment(true)
+	_ruleTwo.SetActive(true)
+	_ruleTwo.SetCreatedAt(1)
+	_ruleTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "kind", "pattern", "message", "sunset", "comment", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "image", "golang:1.1*", "upgrade", 1, true, true, 1, "octocat", 0, "").
+		AddRow(2, "image", "node:14*", "upgrade", 1, true, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "deprecation_rules" ORDER BY id`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateDeprecationRule(context.TODO(), _ruleOne)
+	if err != nil {
+		t.Errorf("unable to create test deprecation rule for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateDeprecationRule(context.TODO(), _ruleTwo)
+	if err != nil {
+		t.Errorf("unable to create test deprecation rule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.DeprecationRule
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.DeprecationRule{_ruleOne, _ruleTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.DeprecationRule{_ruleOne, _ruleTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListDeprecationRules(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListDeprecationRules for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListDeprecationRules for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListDeprecationRules for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
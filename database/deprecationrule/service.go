@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// DeprecationRuleService represents the Vela interface for deprecation rule
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type DeprecationRuleService interface {
+	// DeprecationRule Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateDeprecationRuleIndexes defines a function that creates the indexes for the deprecation_rules table.
+	CreateDeprecationRuleIndexes(context.Context) error
+	// CreateDeprecationRuleTable defines a function that creates the deprecation_rules table.
+	CreateDeprecationRuleTable(context.Context, string) error
+
+	// DeprecationRule Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateDeprecationRule defines a function that creates a new deprecation rule.
+	CreateDeprecationRule(context.Context, *api.DeprecationRule) error
+	// DeleteDeprecationRule defines a function that deletes an existing deprecation rule.
+	DeleteDeprecationRule(context.Context, *api.DeprecationRule) error
+	// GetDeprecationRule defines a function that gets a deprecation rule by ID.
+	GetDeprecationRule(context.Context, int64) (*api.DeprecationRule, error)
+	// ListDeprecationRules defines a function that gets a list of all deprecation rules.
+	ListDeprecationRules(context.Context) ([]*api.DeprecationRule, error)
+	// UpdateDeprecationRule defines a function that updates an existing deprecation rule.
+	UpdateDeprecationRule(context.Context, *api.DeprecationRule) error
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableDeprecationRule represents the name of the table for deprecation rules.
+	TableDeprecationRule = "deprecation_rules"
+
+	// CreatePostgresTable represents a query to create the Postgres deprecation_rules table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+deprecation_rules (
+	id            SERIAL PRIMARY KEY,
+	kind          VARCHAR(250),
+	pattern       VARCHAR(500),
+	message       VARCHAR(1000),
+	sunset        INTEGER,
+	comment       BOOLEAN,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(kind, pattern)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite deprecation_rules table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+deprecation_rules (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	kind          TEXT,
+	pattern       TEXT,
+	message       TEXT,
+	sunset        INTEGER,
+	comment       BOOLEAN,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(kind, pattern)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL deprecation_rules table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+deprecation_rules (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	kind          VARCHAR(250),
+	pattern       VARCHAR(500),
+	message       VARCHAR(1000),
+	sunset        INTEGER,
+	comment       BOOLEAN,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(kind, pattern),
+	INDEX deprecation_rules_kind (kind)
+);
+`
+)
+
+// CreateDeprecationRuleTable creates the deprecation_rules table in the database.
+func (e *engine) CreateDeprecationRuleTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating deprecation_rules table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the deprecation_rules table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the deprecation_rules table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the deprecation_rules table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateDeprecationRule updates an existing deprecation rule in the database.
+func (e *engine) UpdateDeprecationRule(ctx context.Context, r *api.DeprecationRule) error {
+	e.logger.WithFields(logrus.Fields{
+		"rule": r.GetID(),
+	}).Tracef("updating deprecation rule %d in the database", r.GetID())
+
+	// cast the API type to database type
+	rule := types.DeprecationRuleFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := rule.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableDeprecationRule).
+		Save(rule).
+		Error
+}
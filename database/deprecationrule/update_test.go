@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package deprecationrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestDeprecationRule_Engine_UpdateDeprecationRule(t *testing.T) {
+	// setup types
+	_rule := testDeprecationRule()
+	_rule.SetID(1)
+	_rule.SetKind("image")
+	_rule.SetPattern("golang:1.1*")
+	_rule.SetMessage("upgrade")
+	_rule.SetSunset(1)
+	_rule.SetComment(true)
+	_rule.SetActive(true)
+	_rule.SetCreatedAt(1)
+	_rule.SetCreatedBy("octocat")
+	_rule.SetUpdatedAt(2)
+	_rule.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "deprecation_rules"
+SET "kind"=$1,"pattern"=$2,"message"=$3,"sunset"=$4,"comment"=$5,"active"=$6,"created_at"=$7,"created_by"=$8,"updated_at"=$9,"updated_by"=$10
+WHERE "id" = $11`).
+		WithArgs("image", "golang:1.1*", "upgrade", 1, true, true, 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateDeprecationRule(context.TODO(), _rule)
+	if err != nil {
+		t.Errorf("unable to create test deprecation rule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateDeprecationRule(context.TODO(), _rule)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateDeprecationRule for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateDeprecationRule for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
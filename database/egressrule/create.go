@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateEgressRule creates a new egress rule in the database.
+func (e *engine) CreateEgressRule(ctx context.Context, r *api.EgressRule) error {
+	e.logger.WithFields(logrus.Fields{
+		"rule": r.GetID(),
+	}).Tracef("creating egress rule %d in the database", r.GetID())
+
+	// cast the API type to database type
+	rule := types.EgressRuleFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := rule.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableEgressRule).
+		Create(rule).
+		Error
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestEgressRule_Engine_CreateEgressRule(t *testing.T) {
+	// setup types
+	_rule := testEgressRule()
+	_rule.SetID(1)
+	_rule.SetOrg("github")
+	_rule.SetAction("allow")
+	_rule.SetDestination("*.github.com:443")
+	_rule.SetActive(true)
+	_rule.SetCreatedAt(1)
+	_rule.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "egress_rules"
+("org","action","destination","description","active","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`).
+		WithArgs("github", "allow", "*.github.com:443", nil, true, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateEgressRule(context.TODO(), _rule)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateEgressRule for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateEgressRule for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
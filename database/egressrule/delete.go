@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteEgressRule deletes an existing egress rule from the database.
+func (e *engine) DeleteEgressRule(ctx context.Context, r *api.EgressRule) error {
+	e.logger.WithFields(logrus.Fields{
+		"rule": r.GetID(),
+	}).Tracef("deleting egress rule %d from the database", r.GetID())
+
+	// cast the API type to database type
+	rule := types.EgressRuleFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableEgressRule).
+		Delete(rule).
+		Error
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the EgressRuleService interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the EgressRule engine
+		SkipCreation bool
+	}
+
+	// engine represents the egress rule functionality that implements the EgressRuleService interface.
+	engine struct {
+		// engine configuration settings used in egress rule functions
+		config *config
+
+		// gorm.io/gorm database client used in egress rule functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in egress rule functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with egress rules in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new EgressRule engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating egress rule database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of egress_rules table and indexes in the database")
+
+		return e, nil
+	}
+
+	// create the egress_rules table
+	err := e.CreateEgressRuleTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableEgressRule, err)
+	}
+
+	// create the indexes for the egress_rules table
+	err = e.CreateEgressRuleIndexes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create indexes for %s table: %w", TableEgressRule, err)
+	}
+
+	return e, nil
+}
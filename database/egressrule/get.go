@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetEgressRule gets an egress rule by ID from the database.
+func (e *engine) GetEgressRule(ctx context.Context, id int64) (*api.EgressRule, error) {
+	e.logger.Tracef("getting egress rule %d from the database", id)
+
+	// variable to store query results
+	r := new(types.EgressRule)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableEgressRule).
+		Where("id = ?", id).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ToAPI(), nil
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateOrgIndex represents a query to create an
+	// index on the egress_rules table for the org column.
+	CreateOrgIndex = `
+CREATE INDEX
+IF NOT EXISTS
+egress_rules_org
+ON egress_rules (org);
+`
+)
+
+// CreateEgressRuleIndexes creates the indexes for the egress_rules table in the database.
+func (e *engine) CreateEgressRuleIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for egress_rules table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the org column index for the egress_rules table
+	return e.client.WithContext(ctx).Exec(CreateOrgIndex).Error
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListEgressRulesForOrg gets a list of egress rules for an org from the database.
+func (e *engine) ListEgressRulesForOrg(ctx context.Context, org string) ([]*api.EgressRule, error) {
+	e.logger.Tracef("listing egress rules for org %s from the database", org)
+
+	// variables to store query results and return value
+	r := new([]types.EgressRule)
+	rules := []*api.EgressRule{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableEgressRule).
+		Where("org = ?", org).
+		Order("action").
+		Order("destination").
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, rule := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := rule
+
+		// convert query result to API type
+		rules = append(rules, tmp.ToAPI())
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestEgressRule_Engine_ListEgressRulesForOrg(t *testing.T) {
+	// setup types
+	_ruleOne := testEgressRule()
+	_ruleOne.SetID(1)
+	_ruleOne.SetOrg("github")
+	_ruleOne.SetAction("allow")
+	_ruleOne.SetDestination("*.github.com:443")
+	_ruleOne.SetActive(true)
+	_ruleOne.SetCreatedAt(1)
+	_ruleOne.SetCreatedBy("octocat")
+
+	_ruleTwo := testEgressRule()
+	_ruleTwo.SetID(2)
+	_ruleTwo.SetOrg("github")
+	_ruleTwo.SetAction("deny")
+	_ruleTwo.SetDestination("10.0.0.0/8")
+	_ruleTwo.SetActive(true)
+	_ruleTwo.SetCreatedAt(1)
+	_ruleTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "action", "destination", "description", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", "allow", "*.github.com:443", "", true, 1, "octocat", 0, "").
+		AddRow(2, "github", "deny", "10.0.0.0/8", "", true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "egress_rules" WHERE org = $1 ORDER BY action,destination`).WithArgs("github").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateEgressRule(context.TODO(), _ruleOne)
+	if err != nil {
+		t.Errorf("unable to create test egress rule for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateEgressRule(context.TODO(), _ruleTwo)
+	if err != nil {
+		t.Errorf("unable to create test egress rule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.EgressRule
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.EgressRule{_ruleOne, _ruleTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.EgressRule{_ruleOne, _ruleTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListEgressRulesForOrg(context.TODO(), "github")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListEgressRulesForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListEgressRulesForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListEgressRulesForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// EgressRuleService represents the Vela interface for egress rule
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type EgressRuleService interface {
+	// EgressRule Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateEgressRuleIndexes defines a function that creates the indexes for the egress_rules table.
+	CreateEgressRuleIndexes(context.Context) error
+	// CreateEgressRuleTable defines a function that creates the egress_rules table.
+	CreateEgressRuleTable(context.Context, string) error
+
+	// EgressRule Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateEgressRule defines a function that creates a new egress rule.
+	CreateEgressRule(context.Context, *api.EgressRule) error
+	// DeleteEgressRule defines a function that deletes an existing egress rule.
+	DeleteEgressRule(context.Context, *api.EgressRule) error
+	// GetEgressRule defines a function that gets an egress rule by ID.
+	GetEgressRule(context.Context, int64) (*api.EgressRule, error)
+	// ListEgressRulesForOrg defines a function that gets a list of egress rules for an org.
+	ListEgressRulesForOrg(context.Context, string) ([]*api.EgressRule, error)
+	// UpdateEgressRule defines a function that updates an existing egress rule.
+	UpdateEgressRule(context.Context, *api.EgressRule) error
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableEgressRule represents the name of the table for egress rules.
+	TableEgressRule = "egress_rules"
+
+	// CreatePostgresTable represents a query to create the Postgres egress_rules table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+egress_rules (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	action        VARCHAR(50),
+	destination   VARCHAR(500),
+	description   VARCHAR(1000),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, action, destination)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite egress_rules table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+egress_rules (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	action        TEXT,
+	destination   TEXT,
+	description   TEXT,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(org, action, destination)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL egress_rules table.
+	//
+	// The unique key indexes a prefix of the destination to fit the MySQL key length limit.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+egress_rules (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	action        VARCHAR(50),
+	destination   VARCHAR(500),
+	description   VARCHAR(1000),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, action, destination(450)),
+	INDEX egress_rules_org (org)
+);
+`
+)
+
+// CreateEgressRuleTable creates the egress_rules table in the database.
+func (e *engine) CreateEgressRuleTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating egress_rules table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the egress_rules table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the egress_rules table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the egress_rules table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
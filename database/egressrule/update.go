@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateEgressRule updates an existing egress rule in the database.
+func (e *engine) UpdateEgressRule(ctx context.Context, r *api.EgressRule) error {
+	e.logger.WithFields(logrus.Fields{
+		"rule": r.GetID(),
+	}).Tracef("updating egress rule %d in the database", r.GetID())
+
+	// cast the API type to database type
+	rule := types.EgressRuleFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := rule.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableEgressRule).
+		Save(rule).
+		Error
+}
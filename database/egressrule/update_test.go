@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package egressrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestEgressRule_Engine_UpdateEgressRule(t *testing.T) {
+	// setup types
+	_rule := testEgressRule()
+	_rule.SetID(1)
+	_rule.SetOrg("github")
+	_rule.SetAction("allow")
+	_rule.SetDestination("*.github.com:443")
+	_rule.SetActive(true)
+	_rule.SetCreatedAt(1)
+	_rule.SetCreatedBy("octocat")
+	_rule.SetUpdatedAt(2)
+	_rule.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "egress_rules"
+SET "org"=$1,"action"=$2,"destination"=$3,"description"=$4,"active"=$5,"created_at"=$6,"created_by"=$7,"updated_at"=$8,"updated_by"=$9
+WHERE "id" = $10`).
+		WithArgs("github", "allow", "*.github.com:443", nil, true, 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateEgressRule(context.TODO(), _rule)
+	if err != nil {
+		t.Errorf("unable to create test egress rule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateEgressRule(context.TODO(), _rule)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateEgressRule for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateEgressRule for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestEphemeralRegistration_Engine_CreateEphemeralRegistration(t *testing.T) {
+	// setup types
+	_registration := testEphemeralRegistration()
+	_registration.SetID(1)
+	_registration.SetBuildID(1)
+	_registration.SetRepoID(1)
+	_registration.SetHostname("vela-ephemeral-1")
+	_registration.SetRoute("vela")
+	_registration.SetRegistered(1)
+	_registration.SetCreated(1)
+	_registration.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "ephemeral_registrations"
+("build_id","repo_id","hostname","route","registered","created","created_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs(1, 1, "vela-ephemeral-1", "vela", 1, 1, "octocat", 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateEphemeralRegistration(context.TODO(), _registration)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateEphemeralRegistration for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateEphemeralRegistration for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
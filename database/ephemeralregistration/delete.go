@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteEphemeralRegistration deletes an existing ephemeral registration from the database.
+func (e *engine) DeleteEphemeralRegistration(ctx context.Context, r *api.EphemeralRegistration) error {
+	e.logger.WithFields(logrus.Fields{
+		"build_id": r.GetBuildID(),
+		"worker":   r.GetHostname(),
+	}).Tracef("deleting ephemeral registration for worker %s from the database", r.GetHostname())
+
+	// cast the API type to database type
+	registration := types.EphemeralRegistrationFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableEphemeralRegistration).
+		Delete(registration).
+		Error
+}
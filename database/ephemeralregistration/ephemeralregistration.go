@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the EphemeralRegistrationService interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the EphemeralRegistration engine
+		SkipCreation bool
+	}
+
+	// engine represents the ephemeral registration functionality that implements the EphemeralRegistrationService interface.
+	engine struct {
+		// engine configuration settings used in ephemeral registration functions
+		config *config
+
+		// gorm.io/gorm database client used in ephemeral registration functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in ephemeral registration functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with ephemeral registrations in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new EphemeralRegistration engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating ephemeral registration database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of ephemeral_registrations table in the database")
+
+		return e, nil
+	}
+
+	// create the ephemeral_registrations table
+	err := e.CreateEphemeralRegistrationTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableEphemeralRegistration, err)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetEphemeralRegistrationForBuild gets an ephemeral registration by build from the database.
+func (e *engine) GetEphemeralRegistrationForBuild(ctx context.Context, b *library.Build) (*api.EphemeralRegistration, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build_id": b.GetID(),
+	}).Tracef("getting ephemeral registration for build %d from the database", b.GetID())
+
+	// variable to store query results
+	r := new(types.EphemeralRegistration)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableEphemeralRegistration).
+		Where("build_id = ?", b.GetID()).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ToAPI(), nil
+}
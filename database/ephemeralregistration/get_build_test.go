@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestEphemeralRegistration_Engine_GetEphemeralRegistrationForBuild(t *testing.T) {
+	// setup types
+	_registration := testEphemeralRegistration()
+	_registration.SetID(1)
+	_registration.SetBuildID(1)
+	_registration.SetRepoID(1)
+	_registration.SetHostname("vela-ephemeral-1")
+	_registration.SetRoute("vela")
+	_registration.SetRegistered(1)
+	_registration.SetCreated(1)
+	_registration.SetCreatedBy("octocat")
+
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "build_id", "repo_id", "hostname", "route", "registered", "created", "created_by"}).
+		AddRow(1, 1, 1, "vela-ephemeral-1", "vela", 1, 1, "octocat")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "ephemeral_registrations" WHERE build_id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateEphemeralRegistration(context.TODO(), _registration)
+	if err != nil {
+		t.Errorf("unable to create test ephemeral registration for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.EphemeralRegistration
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _registration,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _registration,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetEphemeralRegistrationForBuild(context.TODO(), _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetEphemeralRegistrationForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetEphemeralRegistrationForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetEphemeralRegistrationForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
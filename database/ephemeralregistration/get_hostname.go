@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// GetEphemeralRegistrationForHostname gets an ephemeral registration by worker hostname from the database.
+func (e *engine) GetEphemeralRegistrationForHostname(ctx context.Context, hostname string) (*api.EphemeralRegistration, error) {
+	e.logger.WithFields(logrus.Fields{
+		"worker": hostname,
+	}).Tracef("getting ephemeral registration for worker %s from the database", hostname)
+
+	// variable to store query results
+	r := new(types.EphemeralRegistration)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableEphemeralRegistration).
+		Where("hostname = ?", hostname).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ToAPI(), nil
+}
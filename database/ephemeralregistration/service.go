@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// EphemeralRegistrationService represents the Vela interface for ephemeral registration
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type EphemeralRegistrationService interface {
+	// EphemeralRegistration Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateEphemeralRegistrationTable defines a function that creates the ephemeral_registrations table.
+	CreateEphemeralRegistrationTable(context.Context, string) error
+
+	// EphemeralRegistration Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateEphemeralRegistration defines a function that creates a new ephemeral registration.
+	CreateEphemeralRegistration(context.Context, *api.EphemeralRegistration) error
+	// DeleteEphemeralRegistration defines a function that deletes an existing ephemeral registration.
+	DeleteEphemeralRegistration(context.Context, *api.EphemeralRegistration) error
+	// GetEphemeralRegistrationForBuild defines a function that gets an ephemeral registration by build.
+	GetEphemeralRegistrationForBuild(context.Context, *library.Build) (*api.EphemeralRegistration, error)
+	// GetEphemeralRegistrationForHostname defines a function that gets an ephemeral registration by hostname.
+	GetEphemeralRegistrationForHostname(context.Context, string) (*api.EphemeralRegistration, error)
+	// UpdateEphemeralRegistration defines a function that updates an existing ephemeral registration.
+	UpdateEphemeralRegistration(context.Context, *api.EphemeralRegistration) error
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableEphemeralRegistration represents the name of the table for ephemeral registrations.
+	TableEphemeralRegistration = "ephemeral_registrations"
+
+	// CreatePostgresTable represents a query to create the Postgres ephemeral_registrations table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+ephemeral_registrations (
+	id          SERIAL PRIMARY KEY,
+	build_id    INTEGER,
+	repo_id     INTEGER,
+	hostname    VARCHAR(250),
+	route       VARCHAR(250),
+	registered  INTEGER,
+	created     INTEGER,
+	created_by  VARCHAR(250),
+	UNIQUE(build_id),
+	UNIQUE(hostname)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite ephemeral_registrations table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+ephemeral_registrations (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id    INTEGER,
+	repo_id     INTEGER,
+	hostname    TEXT,
+	route       TEXT,
+	registered  INTEGER,
+	created     INTEGER,
+	created_by  TEXT,
+	UNIQUE(build_id),
+	UNIQUE(hostname)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL ephemeral_registrations table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+ephemeral_registrations (
+	id          INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id    INTEGER,
+	repo_id     INTEGER,
+	hostname    VARCHAR(250),
+	route       VARCHAR(250),
+	registered  INTEGER,
+	created     INTEGER,
+	created_by  VARCHAR(250),
+	UNIQUE(build_id),
+	UNIQUE(hostname)
+);
+`
+)
+
+// CreateEphemeralRegistrationTable creates the ephemeral_registrations table in the database.
+func (e *engine) CreateEphemeralRegistrationTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating ephemeral_registrations table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the ephemeral_registrations table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the ephemeral_registrations table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the ephemeral_registrations table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
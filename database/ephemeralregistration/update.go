@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateEphemeralRegistration updates an existing ephemeral registration in the database.
+func (e *engine) UpdateEphemeralRegistration(ctx context.Context, r *api.EphemeralRegistration) error {
+	e.logger.WithFields(logrus.Fields{
+		"build_id": r.GetBuildID(),
+		"worker":   r.GetHostname(),
+	}).Tracef("updating ephemeral registration for worker %s in the database", r.GetHostname())
+
+	// cast the API type to database type
+	registration := types.EphemeralRegistrationFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := registration.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableEphemeralRegistration).
+		Save(registration).
+		Error
+}
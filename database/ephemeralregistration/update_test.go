@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ephemeralregistration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestEphemeralRegistration_Engine_UpdateEphemeralRegistration(t *testing.T) {
+	// setup types
+	_registration := testEphemeralRegistration()
+	_registration.SetID(1)
+	_registration.SetBuildID(1)
+	_registration.SetRepoID(1)
+	_registration.SetHostname("vela-ephemeral-1")
+	_registration.SetRoute("vela")
+	_registration.SetRegistered(1)
+	_registration.SetCreated(1)
+	_registration.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "ephemeral_registrations"
+SET "build_id"=$1,"repo_id"=$2,"hostname"=$3,"route"=$4,"registered"=$5,"created"=$6,"created_by"=$7
+WHERE "id" = $8`).
+		WithArgs(1, 1, "vela-ephemeral-1", "vela", 1, 1, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateEphemeralRegistration(context.TODO(), _registration)
+	if err != nil {
+		t.Errorf("unable to create test ephemeral registration for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateEphemeralRegistration(context.TODO(), _registration)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateEphemeralRegistration for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateEphemeralRegistration for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// CreateExport creates a new export in the database.
+func (e *engine) CreateExport(ctx context.Context, x *api.Export) error {
+	e.logger.Tracef("creating export %s in the database", x.GetObject())
+
+	// cast the API type to database type
+	export := types.ExportFromAPI(x)
+
+	// validate the necessary fields are populated
+	err := export.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	err = e.client.WithContext(ctx).
+		Table(TableExport).
+		Create(export).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// set the ID generated by the database
+	x.SetID(export.ID.Int64)
+
+	return nil
+}
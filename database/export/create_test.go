@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestExport_Engine_CreateExport(t *testing.T) {
+	// setup types
+	_exportOne := testExport()
+	_exportOne.SetID(1)
+	_exportOne.SetDataset("builds")
+	_exportOne.SetFormat("jsonl")
+	_exportOne.SetBucket("vela-exports")
+	_exportOne.SetObject("builds/builds-1-2.jsonl")
+	_exportOne.SetRecords(10)
+	_exportOne.SetSince(1)
+	_exportOne.SetWatermark(2)
+	_exportOne.SetCreated(3)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "exports"
+("dataset","format","bucket","object","records","since","watermark","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs("builds", "jsonl", "vela-exports", "builds/builds-1-2.jsonl", 10, 1, 2, 3, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateExport(context.TODO(), _exportOne)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateExport for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateExport for %s returned err: %v", test.name, err)
+			}
+
+			if _exportOne.GetID() != 1 {
+				t.Errorf("CreateExport for %s set ID %d, want 1", test.name, _exportOne.GetID())
+			}
+		})
+	}
+}
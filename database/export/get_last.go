@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetLastExport gets the last export for the provided dataset from the database.
+// When the dataset has never been exported, it returns nil without an error.
+func (e *engine) GetLastExport(ctx context.Context, dataset string) (*api.Export, error) {
+	e.logger.Tracef("getting last export for dataset %s from the database", dataset)
+
+	// variable to store query results
+	x := new(types.Export)
+
+	// send query to the database and store result in variable
+	result := e.client.WithContext(ctx).
+		Table(TableExport).
+		Where("dataset = ?", dataset).
+		Order("watermark DESC").
+		Limit(1).
+		Find(x)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	// the record will not exist if the dataset has never been exported
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return x.ToAPI(), nil
+}
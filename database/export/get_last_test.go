@@ -0,0 +1,131 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestExport_Engine_GetLastExport(t *testing.T) {
+	// setup types
+	_exportOne := testExport()
+	_exportOne.SetID(1)
+	_exportOne.SetDataset("builds")
+	_exportOne.SetFormat("jsonl")
+	_exportOne.SetBucket("vela-exports")
+	_exportOne.SetObject("builds/builds-1-2.jsonl")
+	_exportOne.SetRecords(10)
+	_exportOne.SetSince(1)
+	_exportOne.SetWatermark(2)
+	_exportOne.SetCreated(3)
+
+	_exportTwo := testExport()
+	_exportTwo.SetID(2)
+	_exportTwo.SetDataset("builds")
+	_exportTwo.SetFormat("jsonl")
+	_exportTwo.SetBucket("vela-exports")
+	_exportTwo.SetObject("builds/builds-2-4.jsonl")
+	_exportTwo.SetRecords(5)
+	_exportTwo.SetSince(2)
+	_exportTwo.SetWatermark(4)
+	_exportTwo.SetCreated(5)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "dataset", "format", "bucket", "object", "records", "since", "watermark", "created"}).
+		AddRow(2, "builds", "jsonl", "vela-exports", "builds/builds-2-4.jsonl", 5, 2, 4, 5)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "exports" WHERE dataset = $1 ORDER BY watermark DESC LIMIT 1`).
+		WithArgs("builds").
+		WillReturnRows(_rows)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "exports" WHERE dataset = $1 ORDER BY watermark DESC LIMIT 1`).
+		WithArgs("steps").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateExport(context.TODO(), _exportOne)
+	if err != nil {
+		t.Errorf("unable to create test export for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateExport(context.TODO(), _exportTwo)
+	if err != nil {
+		t.Errorf("unable to create test export for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		dataset  string
+		want     *api.Export
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			dataset:  "builds",
+			want:     _exportTwo,
+		},
+		{
+			failure:  false,
+			name:     "postgres without exports",
+			database: _postgres,
+			dataset:  "steps",
+			want:     nil,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			dataset:  "builds",
+			want:     _exportTwo,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 without exports",
+			database: _sqlite,
+			dataset:  "steps",
+			want:     nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetLastExport(context.TODO(), test.dataset)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetLastExport for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetLastExport for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetLastExport for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateDatasetCreatedIndex represents a query to create an index on
+	// the exports table for the dataset and created columns.
+	CreateDatasetCreatedIndex = `
+CREATE INDEX
+IF NOT EXISTS
+exports_dataset_created
+ON exports (dataset, created);
+`
+)
+
+// CreateExportIndexes creates the indexes for the exports table in the database.
+func (e *engine) CreateExportIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for exports table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the dataset and created columns index for the exports table
+	return e.client.WithContext(ctx).Exec(CreateDatasetCreatedIndex).Error
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListExports gets a list of the exports for the provided dataset created
+// after the provided unix timestamp from the database. When the dataset
+// is empty, the exports for every dataset are returned.
+func (e *engine) ListExports(ctx context.Context, dataset string, after int64) ([]*api.Export, error) {
+	e.logger.Tracef("listing exports for dataset %s created after %d from the database", dataset, after)
+
+	// variables to store query results and return value
+	x := new([]types.Export)
+	exports := []*api.Export{}
+
+	query := e.client.WithContext(ctx).
+		Table(TableExport).
+		Where("created > ?", after)
+
+	// filter the exports by dataset if provided
+	if len(dataset) > 0 {
+		query = query.Where("dataset = ?", dataset)
+	}
+
+	// send query to the database and store result in variable
+	err := query.
+		Order("created").
+		Order("id").
+		Find(&x).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, export := range *x {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := export
+
+		// convert query result to API type
+		exports = append(exports, tmp.ToAPI())
+	}
+
+	return exports, nil
+}
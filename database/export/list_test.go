@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestExport_Engine_ListExports(t *testing.T) {
+	// setup types
+	_exportOne := testExport()
+	_exportOne.SetID(1)
+	_exportOne.SetDataset("builds")
+	_exportOne.SetFormat("jsonl")
+	_exportOne.SetBucket("vela-exports")
+	_exportOne.SetObject("builds/builds-1-2.jsonl")
+	_exportOne.SetRecords(10)
+	_exportOne.SetSince(1)
+	_exportOne.SetWatermark(2)
+	_exportOne.SetCreated(3)
+
+	_exportTwo := testExport()
+	_exportTwo.SetID(2)
+	_exportTwo.SetDataset("steps")
+	_exportTwo.SetFormat("jsonl")
+	_exportTwo.SetBucket("vela-exports")
+	_exportTwo.SetObject("steps/steps-1-2.jsonl")
+	_exportTwo.SetRecords(20)
+	_exportTwo.SetSince(1)
+	_exportTwo.SetWatermark(2)
+	_exportTwo.SetCreated(3)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "dataset", "format", "bucket", "object", "records", "since", "watermark", "created"}).
+		AddRow(1, "builds", "jsonl", "vela-exports", "builds/builds-1-2.jsonl", 10, 1, 2, 3)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "exports" WHERE created > $1 AND dataset = $2 ORDER BY created,id`).
+		WithArgs(0, "builds").
+		WillReturnRows(_rows)
+
+	// create expected result in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "dataset", "format", "bucket", "object", "records", "since", "watermark", "created"}).
+		AddRow(1, "builds", "jsonl", "vela-exports", "builds/builds-1-2.jsonl", 10, 1, 2, 3).
+		AddRow(2, "steps", "jsonl", "vela-exports", "steps/steps-1-2.jsonl", 20, 1, 2, 3)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "exports" WHERE created > $1 ORDER BY created,id`).
+		WithArgs(0).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateExport(context.TODO(), _exportOne)
+	if err != nil {
+		t.Errorf("unable to create test export for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateExport(context.TODO(), _exportTwo)
+	if err != nil {
+		t.Errorf("unable to create test export for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		dataset  string
+		want     []*api.Export
+	}{
+		{
+			failure:  false,
+			name:     "postgres with dataset",
+			database: _postgres,
+			dataset:  "builds",
+			want:     []*api.Export{_exportOne},
+		},
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Export{_exportOne, _exportTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with dataset",
+			database: _sqlite,
+			dataset:  "builds",
+			want:     []*api.Export{_exportOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Export{_exportOne, _exportTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListExports(context.TODO(), test.dataset, 0)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListExports for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListExports for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListExports for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ExportService represents the Vela interface for export
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type ExportService interface {
+	// Export Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateExportIndexes defines a function that creates the indexes for the exports table.
+	CreateExportIndexes(context.Context) error
+	// CreateExportTable defines a function that creates the exports table.
+	CreateExportTable(context.Context, string) error
+
+	// Export Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateExport defines a function that creates a new export.
+	CreateExport(context.Context, *api.Export) error
+	// GetLastExport defines a function that gets the last export for a dataset.
+	GetLastExport(context.Context, string) (*api.Export, error)
+	// ListExports defines a function that gets a list of the exports for a dataset created after a time.
+	ListExports(context.Context, string, int64) ([]*api.Export, error)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableExport represents the name of the table for exports.
+	TableExport = "exports"
+
+	// CreatePostgresTable represents a query to create the Postgres exports table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+exports (
+	id         SERIAL PRIMARY KEY,
+	dataset    VARCHAR(250),
+	format     VARCHAR(250),
+	bucket     VARCHAR(250),
+	object     VARCHAR(1000),
+	records    INTEGER,
+	since      INTEGER,
+	watermark  INTEGER,
+	created    INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite exports table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+exports (
+	id         INTEGER PRIMARY KEY AUTOINCREMENT,
+	dataset    TEXT,
+	format     TEXT,
+	bucket     TEXT,
+	object     TEXT,
+	records    INTEGER,
+	since      INTEGER,
+	watermark  INTEGER,
+	created    INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL exports table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+exports (
+	id         INTEGER PRIMARY KEY AUTO_INCREMENT,
+	dataset    VARCHAR(250),
+	format     VARCHAR(250),
+	bucket     VARCHAR(250),
+	object     VARCHAR(1000),
+	records    INTEGER,
+	since      INTEGER,
+	watermark  INTEGER,
+	created    INTEGER,
+	INDEX exports_dataset_created (dataset, created)
+);
+`
+)
+
+// CreateExportTable creates the exports table in the database.
+func (e *engine) CreateExportTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating exports table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the exports table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the exports table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the exports table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
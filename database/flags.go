@@ -22,7 +22,7 @@ var Flags = []cli.Flag{
 		EnvVars:  []string{"VELA_DATABASE_DRIVER", "DATABASE_DRIVER"},
 		FilePath: "/vela/database/driver",
 		Name:     "database.driver",
-		Usage:    "driver to be used for the database system",
+		Usage:    "driver to be used for the database system (sqlite3, postgres or mysql)",
 		Value:    "sqlite3",
 	},
 	&cli.StringFlag{
@@ -32,6 +32,12 @@ var Flags = []cli.Flag{
 		Usage:    "fully qualified url (<scheme>://<host>) for the database",
 		Value:    "vela.sqlite",
 	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_DATABASE_REPLICAS", "DATABASE_REPLICAS"},
+		FilePath: "/vela/database/replicas",
+		Name:     "database.replicas",
+		Usage:    "fully qualified urls (<scheme>://<host>) for the read replicas of the database to send read-only queries to",
+	},
 	&cli.IntFlag{
 		EnvVars:  []string{"VELA_DATABASE_CONNECTION_OPEN", "DATABASE_CONNECTION_OPEN"},
 		FilePath: "/vela/database/connection_open",
@@ -53,6 +59,27 @@ var Flags = []cli.Flag{
 		Usage:    "duration of time a connection may be reused for the database",
 		Value:    30 * time.Minute,
 	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_DATABASE_STATEMENT_TIMEOUT", "DATABASE_STATEMENT_TIMEOUT"},
+		FilePath: "/vela/database/statement_timeout",
+		Name:     "database.statement.timeout",
+		Usage:    "maximum duration of time each statement may run for the database (0 disables the timeout)",
+		Value:    0,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_DATABASE_STATEMENT_SLOW_THRESHOLD", "DATABASE_STATEMENT_SLOW_THRESHOLD"},
+		FilePath: "/vela/database/statement_slow_threshold",
+		Name:     "database.statement.slow_threshold",
+		Usage:    "duration of time after which statements are logged as slow for the database (0 disables logging slow statements)",
+		Value:    0,
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_DATABASE_COMPRESSION_ALGORITHM", "DATABASE_COMPRESSION_ALGORITHM"},
+		FilePath: "/vela/database/compression_algorithm",
+		Name:     "database.compression.algorithm",
+		Usage:    "algorithm of compression for logs stored in the database (zlib, gzip or zstd)",
+		Value:    "zlib",
+	},
 	&cli.IntFlag{
 		EnvVars:  []string{"VELA_DATABASE_COMPRESSION_LEVEL", "DATABASE_COMPRESSION_LEVEL"},
 		FilePath: "/vela/database/compression_level",
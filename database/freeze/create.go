@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateFreeze creates a new freeze in the database.
+func (e *engine) CreateFreeze(ctx context.Context, f *api.Freeze) error {
+	e.logger.WithFields(logrus.Fields{
+		"freeze": f.GetName(),
+		"org":    f.GetOrg(),
+	}).Tracef("creating freeze %s in the database", f.GetName())
+
+	// cast the API type to database type
+	freeze := types.FreezeFromAPI(f)
+
+	// validate the necessary fields are populated
+	err := freeze.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableFreeze).
+		Create(freeze).
+		Error
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestFreeze_Engine_CreateFreeze(t *testing.T) {
+	// setup types
+	_freeze := testFreeze()
+	_freeze.SetID(1)
+	_freeze.SetOrg("github")
+	_freeze.SetName("holiday")
+	_freeze.SetScope("deploy")
+	_freeze.SetStarts(1)
+	_freeze.SetEnds(3)
+	_freeze.SetExemptRepos([]string{"github/octocat"})
+	_freeze.SetCreatedAt(1)
+	_freeze.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "freezes"
+("org","name","reason","scope","starts","ends","exempt_repos","exempt_events","created_at","created_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`).
+		WithArgs("github", "holiday", nil, "deploy", 1, 3, `{"github/octocat"}`, nil, 1, "octocat", 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateFreeze(context.TODO(), _freeze)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateFreeze for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateFreeze for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
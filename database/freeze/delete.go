@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteFreeze deletes an existing freeze from the database.
+func (e *engine) DeleteFreeze(ctx context.Context, f *api.Freeze) error {
+	e.logger.WithFields(logrus.Fields{
+		"freeze": f.GetName(),
+		"org":    f.GetOrg(),
+	}).Tracef("deleting freeze %s from the database", f.GetName())
+
+	// cast the API type to database type
+	freeze := types.FreezeFromAPI(f)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableFreeze).
+		Delete(freeze).
+		Error
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestFreeze_Engine_DeleteFreeze(t *testing.T) {
+	// setup types
+	_freeze := testFreeze()
+	_freeze.SetID(1)
+	_freeze.SetOrg("github")
+	_freeze.SetName("holiday")
+	_freeze.SetScope("deploy")
+	_freeze.SetStarts(1)
+	_freeze.SetEnds(3)
+	_freeze.SetExemptRepos([]string{"github/octocat"})
+	_freeze.SetCreatedAt(1)
+	_freeze.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "freezes" WHERE "freezes"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateFreeze(context.TODO(), _freeze)
+	if err != nil {
+		t.Errorf("unable to create test freeze for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteFreeze(context.TODO(), _freeze)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteFreeze for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteFreeze for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the FreezeService interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the Freeze engine
+		SkipCreation bool
+	}
+
+	// engine represents the freeze functionality that implements the FreezeService interface.
+	engine struct {
+		// engine configuration settings used in freeze functions
+		config *config
+
+		// gorm.io/gorm database client used in freeze functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in freeze functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with freezes in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new Freeze engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating freeze database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of freezes table and indexes in the database")
+
+		return e, nil
+	}
+
+	// create the freezes table
+	err := e.CreateFreezeTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableFreeze, err)
+	}
+
+	// create the indexes for the freezes table
+	err = e.CreateFreezeIndexes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create indexes for %s table: %w", TableFreeze, err)
+	}
+
+	return e, nil
+}
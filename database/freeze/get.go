@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetFreeze gets a freeze by ID from the database.
+func (e *engine) GetFreeze(ctx context.Context, id int64) (*api.Freeze, error) {
+	e.logger.Tracef("getting freeze %d from the database", id)
+
+	// variable to store query results
+	f := new(types.Freeze)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableFreeze).
+		Where("id = ?", id).
+		Take(f).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return f.ToAPI(), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestFreeze_Engine_GetFreeze(t *testing.T) {
+	// setup types
+	_freeze := testFreeze()
+	_freeze.SetID(1)
+	_freeze.SetOrg("github")
+	_freeze.SetName("holiday")
+	_freeze.SetScope("deploy")
+	_freeze.SetStarts(1)
+	_freeze.SetEnds(3)
+	_freeze.SetExemptRepos([]string{"github/octocat"})
+	_freeze.SetCreatedAt(1)
+	_freeze.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "name", "reason", "scope", "starts", "ends", "exempt_repos", "exempt_events", "created_at", "created_by"}).
+		AddRow(1, "github", "holiday", "", "deploy", 1, 3, `{"github/octocat"}`, nil, 1, "octocat")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "freezes" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateFreeze(context.TODO(), _freeze)
+	if err != nil {
+		t.Errorf("unable to create test freeze for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.Freeze
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _freeze,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _freeze,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetFreeze(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetFreeze for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetFreeze for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetFreeze for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
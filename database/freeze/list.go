@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListFreezes gets a list of the freezes for an org, or the
+// freezes for every org when the org is empty, from the database.
+func (e *engine) ListFreezes(ctx context.Context, org string) ([]*api.Freeze, error) {
+	e.logger.Tracef("listing freezes for org %s from the database", org)
+
+	// variables to store query results and return value
+	f := new([]types.Freeze)
+	freezes := []*api.Freeze{}
+
+	query := e.client.WithContext(ctx).Table(TableFreeze)
+
+	// freezes for every org are stored without an org
+	if len(org) == 0 {
+		query = query.Where("org IS NULL")
+	} else {
+		query = query.Where("org = ?", org)
+	}
+
+	// send query to the database and store result in variable
+	err := query.
+		Order("starts DESC").
+		Find(&f).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, freeze := range *f {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := freeze
+
+		// convert query result to API type
+		freezes = append(freezes, tmp.ToAPI())
+	}
+
+	return freezes, nil
+}
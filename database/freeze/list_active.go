@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListActiveFreezes gets a list of the freezes for an org, and for
+// every org, that are active at the time provided from the database.
+func (e *engine) ListActiveFreezes(ctx context.Context, org string, now int64) ([]*api.Freeze, error) {
+	e.logger.Tracef("listing active freezes for org %s from the database", org)
+
+	// variables to store query results and return value
+	f := new([]types.Freeze)
+	freezes := []*api.Freeze{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableFreeze).
+		Where("(org = ? OR org IS NULL) AND starts <= ? AND ends > ?", org, now, now).
+		Order("id").
+		Find(&f).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, freeze := range *f {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := freeze
+
+		// convert query result to API type
+		freezes = append(freezes, tmp.ToAPI())
+	}
+
+	return freezes, nil
+}
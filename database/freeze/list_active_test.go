@@ -0,0 +1,124 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestFreeze_Engine_ListActiveFreezes(t *testing.T) {
+	// setup types
+	_freezeOne := testFreeze()
+	_freezeOne.SetID(1)
+	_freezeOne.SetOrg("github")
+	_freezeOne.SetName("holiday")
+	_freezeOne.SetScope("deploy")
+	_freezeOne.SetStarts(1)
+	_freezeOne.SetEnds(3)
+	_freezeOne.SetCreatedAt(1)
+	_freezeOne.SetCreatedBy("octocat")
+
+	_freezeTwo := testFreeze()
+	_freezeTwo.SetID(2)
+	_freezeTwo.SetOrg("github")
+	_freezeTwo.SetName("release")
+	_freezeTwo.SetScope("all")
+	_freezeTwo.SetStarts(2)
+	_freezeTwo.SetEnds(4)
+	_freezeTwo.SetCreatedAt(1)
+	_freezeTwo.SetCreatedBy("octocat")
+
+	_freezeThree := testFreeze()
+	_freezeThree.SetID(3)
+	_freezeThree.SetName("outage")
+	_freezeThree.SetScope("all")
+	_freezeThree.SetStarts(1)
+	_freezeThree.SetEnds(3)
+	_freezeThree.SetCreatedAt(1)
+	_freezeThree.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	_freezeFour := testFreeze()
+	_freezeFour.SetID(4)
+	_freezeFour.SetOrg("octocat")
+	_freezeFour.SetName("holiday")
+	_freezeFour.SetScope("deploy")
+	_freezeFour.SetStarts(1)
+	_freezeFour.SetEnds(3)
+	_freezeFour.SetCreatedAt(1)
+	_freezeFour.SetCreatedBy("octocat")
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "name", "reason", "scope", "starts", "ends", "exempt_repos", "exempt_events", "created_at", "created_by"}).
+		AddRow(1, "github", "holiday", "", "deploy", 1, 3, nil, nil, 1, "octocat").
+		AddRow(3, nil, "outage", "", "all", 1, 3, nil, nil, 1, "octocat")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "freezes" WHERE (org = $1 OR org IS NULL) AND starts <= $2 AND ends > $3 ORDER BY id`).
+		WithArgs("github", 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, freeze := range []*api.Freeze{_freezeOne, _freezeTwo, _freezeThree, _freezeFour} {
+		err := _sqlite.CreateFreeze(context.TODO(), freeze)
+		if err != nil {
+			t.Errorf("unable to create test freeze for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Freeze
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Freeze{_freezeOne, _freezeThree},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Freeze{_freezeOne, _freezeThree},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListActiveFreezes(context.TODO(), "github", 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListActiveFreezes for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListActiveFreezes for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListActiveFreezes for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
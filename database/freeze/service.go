@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// FreezeService represents the Vela interface for freeze
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type FreezeService interface {
+	// Freeze Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateFreezeIndexes defines a function that creates the indexes for the freezes table.
+	CreateFreezeIndexes(context.Context) error
+	// CreateFreezeTable defines a function that creates the freezes table.
+	CreateFreezeTable(context.Context, string) error
+
+	// Freeze Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateFreeze defines a function that creates a new freeze.
+	CreateFreeze(context.Context, *api.Freeze) error
+	// DeleteFreeze defines a function that deletes an existing freeze.
+	DeleteFreeze(context.Context, *api.Freeze) error
+	// GetFreeze defines a function that gets a freeze by ID.
+	GetFreeze(context.Context, int64) (*api.Freeze, error)
+	// ListActiveFreezes defines a function that gets a list of the freezes
+	// for an org, and for every org, that are active at the time provided.
+	ListActiveFreezes(context.Context, string, int64) ([]*api.Freeze, error)
+	// ListFreezes defines a function that gets a list of the freezes for an
+	// org, or the freezes for every org when the org is empty.
+	ListFreezes(context.Context, string) ([]*api.Freeze, error)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freeze
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableFreeze represents the name of the table for freezes.
+	TableFreeze = "freezes"
+
+	// CreatePostgresTable represents a query to create the Postgres freezes table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+freezes (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	name          VARCHAR(250),
+	reason        VARCHAR(1000),
+	scope         VARCHAR(250),
+	starts        INTEGER,
+	ends          INTEGER,
+	exempt_repos  VARCHAR(1000),
+	exempt_events VARCHAR(1000),
+	created_at    INTEGER,
+	created_by    VARCHAR(250)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite freezes table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+freezes (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	name          TEXT,
+	reason        TEXT,
+	scope         TEXT,
+	starts        INTEGER,
+	ends          INTEGER,
+	exempt_repos  TEXT,
+	exempt_events TEXT,
+	created_at    INTEGER,
+	created_by    TEXT
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL freezes table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+freezes (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	name          VARCHAR(250),
+	reason        VARCHAR(1000),
+	scope         VARCHAR(250),
+	starts        INTEGER,
+	ends          INTEGER,
+	exempt_repos  VARCHAR(1000),
+	exempt_events VARCHAR(1000),
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	INDEX freezes_org (org)
+);
+`
+)
+
+// CreateFreezeTable creates the freezes table in the database.
+func (e *engine) CreateFreezeTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating freezes table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the freezes table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the freezes table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the freezes table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateFreezeAudit creates a record of a freeze being
+// created, deleted or overridden in the database.
+func (e *engine) CreateFreezeAudit(ctx context.Context, a *api.FreezeAudit) error {
+	e.logger.WithFields(logrus.Fields{
+		"freeze": a.GetFreezeID(),
+		"action": a.GetAction(),
+		"actor":  a.GetActor(),
+	}).Tracef("creating %s audit for freeze %d in the database", a.GetAction(), a.GetFreezeID())
+
+	// cast the API type to database type
+	audit := types.FreezeAuditFromAPI(a)
+
+	// validate the necessary fields are populated
+	err := audit.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableFreezeAudits).
+		Create(audit).
+		Error
+}
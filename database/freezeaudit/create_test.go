@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestFreezeAudit_Engine_CreateFreezeAudit(t *testing.T) {
+	// setup types
+	_audit := testFreezeAudit()
+	_audit.SetID(1)
+	_audit.SetFreezeID(1)
+	_audit.SetOrg("github")
+	_audit.SetAction("overridden")
+	_audit.SetActor("octocat")
+	_audit.SetRepo("github/octocat")
+	_audit.SetEvent("deployment")
+	_audit.SetIP("1.2.3.4")
+	_audit.SetUserAgent("vela-cli")
+	_audit.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "freeze_audits"
+("freeze_id","org","action","actor","repo","event","message","ip","user_agent","client","location","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13) RETURNING "id"`).
+		WithArgs(1, "github", "overridden", "octocat", "github/octocat", "deployment", nil, "1.2.3.4", "vela-cli", nil, nil, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateFreezeAudit(context.TODO(), _audit)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateFreezeAudit for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateFreezeAudit for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
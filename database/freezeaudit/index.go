@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateFreezeIDIndex represents a query to create an
+	// index on the freeze_audits table for the freeze_id column.
+	CreateFreezeIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+freeze_audits_freeze_id
+ON freeze_audits (freeze_id);
+`
+)
+
+// CreateFreezeAuditsIndexes creates the indexes for the freeze_audits table in the database.
+func (e *engine) CreateFreezeAuditsIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for freeze_audits table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the freeze_id column index for the freeze_audits table
+	return e.client.WithContext(ctx).Exec(CreateFreezeIDIndex).Error
+}
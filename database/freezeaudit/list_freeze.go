@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListFreezeAuditsForFreeze gets the records of a freeze being
+// created, deleted or overridden from the database.
+func (e *engine) ListFreezeAuditsForFreeze(ctx context.Context, f *api.Freeze) ([]*api.FreezeAudit, error) {
+	e.logger.Tracef("listing audits for freeze %d from the database", f.GetID())
+
+	// variables to store query results and return value
+	a := new([]types.FreezeAudit)
+	audits := []*api.FreezeAudit{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableFreezeAudits).
+		Where("freeze_id = ?", f.GetID()).
+		Order("id").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, audit := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := audit
+
+		// convert query result to API type
+		audits = append(audits, tmp.ToAPI())
+	}
+
+	return audits, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestFreezeAudit_Engine_ListFreezeAuditsForFreeze(t *testing.T) {
+	// setup types
+	_freeze := new(api.Freeze)
+	_freeze.SetID(1)
+
+	_auditOne := testFreezeAudit()
+	_auditOne.SetID(1)
+	_auditOne.SetFreezeID(1)
+	_auditOne.SetOrg("github")
+	_auditOne.SetAction("created")
+	_auditOne.SetActor("octocat")
+	_auditOne.SetCreated(1)
+
+	_auditTwo := testFreezeAudit()
+	_auditTwo.SetID(2)
+	_auditTwo.SetFreezeID(1)
+	_auditTwo.SetOrg("github")
+	_auditTwo.SetAction("overridden")
+	_auditTwo.SetActor("octocat")
+	_auditTwo.SetRepo("github/octocat")
+	_auditTwo.SetEvent("deployment")
+	_auditTwo.SetMessage("deploying hotfix for outage")
+	_auditTwo.SetIP("1.2.3.4")
+	_auditTwo.SetUserAgent("vela-cli")
+	_auditTwo.SetClient("Vela CLI")
+	_auditTwo.SetLocation("Minneapolis, Minnesota, US")
+	_auditTwo.SetCreated(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "freeze_id", "org", "action", "actor", "repo", "event", "message", "ip", "user_agent", "client", "location", "created"}).
+		AddRow(1, 1, "github", "created", "octocat", "", "", "", "", "", "", "", 1).
+		AddRow(2, 1, "github", "overridden", "octocat", "github/octocat", "deployment", "deploying hotfix for outage", "1.2.3.4", "vela-cli", "Vela CLI", "Minneapolis, Minnesota, US", 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "freeze_audits" WHERE freeze_id = $1 ORDER BY id`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateFreezeAudit(context.TODO(), _auditOne)
+	if err != nil {
+		t.Errorf("unable to create test freeze audit for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateFreezeAudit(context.TODO(), _auditTwo)
+	if err != nil {
+		t.Errorf("unable to create test freeze audit for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.FreezeAudit
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.FreezeAudit{_auditOne, _auditTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.FreezeAudit{_auditOne, _auditTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListFreezeAuditsForFreeze(context.TODO(), _freeze)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListFreezeAuditsForFreeze for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListFreezeAuditsForFreeze for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListFreezeAuditsForFreeze for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
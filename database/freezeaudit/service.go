@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// FreezeAuditService represents the Vela interface for freeze audit
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type FreezeAuditService interface {
+	// FreezeAudit Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateFreezeAuditsIndexes defines a function that creates the indexes for the freeze_audits table.
+	CreateFreezeAuditsIndexes(context.Context) error
+	// CreateFreezeAuditsTable defines a function that creates the freeze_audits table.
+	CreateFreezeAuditsTable(context.Context, string) error
+
+	// FreezeAudit Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateFreezeAudit defines a function that creates a record of a freeze being created, deleted or overridden.
+	CreateFreezeAudit(context.Context, *api.FreezeAudit) error
+	// ListFreezeAuditsForFreeze defines a function that gets the records of a freeze being created, deleted or overridden.
+	ListFreezeAuditsForFreeze(context.Context, *api.Freeze) ([]*api.FreezeAudit, error)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// TableFreezeAudits represents the name of the table for freeze audits.
+	TableFreezeAudits = "freeze_audits"
+
+	// CreatePostgresTable represents a query to create the Postgres freeze_audits table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+freeze_audits (
+	id            SERIAL PRIMARY KEY,
+	freeze_id     INTEGER,
+	org           VARCHAR(250),
+	action        VARCHAR(250),
+	actor         VARCHAR(250),
+	repo          VARCHAR(500),
+	event         VARCHAR(250),
+	message       VARCHAR(1000),
+	created       INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite freeze_audits table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+freeze_audits (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	freeze_id     INTEGER,
+	org           TEXT,
+	action        TEXT,
+	actor         TEXT,
+	repo          TEXT,
+	event         TEXT,
+	message       TEXT,
+	created       INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL freeze_audits table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+freeze_audits (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	freeze_id     INTEGER,
+	org           VARCHAR(250),
+	action        VARCHAR(250),
+	actor         VARCHAR(250),
+	repo          VARCHAR(500),
+	event         VARCHAR(250),
+	message       VARCHAR(1000),
+	created       INTEGER,
+	INDEX freeze_audits_freeze_id (freeze_id)
+);
+`
+)
+
+// CreateFreezeAuditsTable creates the freeze_audits table in the database.
+func (e *engine) CreateFreezeAuditsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating freeze_audits table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the freeze_audits table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the freeze_audits table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the freeze_audits table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
+
+// clientColumns represents the columns, and the type for each column,
+// capturing the client that made the request for each audit.
+var clientColumns = [][2]string{
+	{"ip", "VARCHAR(250)"},
+	{"user_agent", "VARCHAR(500)"},
+	{"client", "VARCHAR(250)"},
+	{"location", "VARCHAR(250)"},
+}
+
+// AddClientColumns adds the columns capturing the client that made
+// the request for each audit to the freeze_audits table in the
+// database. The columns are added by a schema migration so they
+// are added to the existing tables. Columns that already exist
+// are skipped so the function can be safely applied again.
+func AddClientColumns(tx *gorm.DB) error {
+	for _, column := range clientColumns {
+		// check if the column already exists
+		if tx.Migrator().HasColumn(TableFreezeAudits, column[0]) {
+			continue
+		}
+
+		err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", TableFreezeAudits, column[0], column[1])).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DropClientColumns drops the columns capturing the client that made
+// the request for each audit from the freeze_audits table in the
+// database when the schema migration is rolled back. Sqlite doesn't
+// support dropping a column, so the nullable columns are kept.
+func DropClientColumns(tx *gorm.DB) error {
+	// check if the database supports dropping a column
+	if name := tx.Dialector.Name(); name != constants.DriverPostgres && name != types.DriverMySQL {
+		return nil
+	}
+
+	for _, column := range clientColumns {
+		// check if the column doesn't exist
+		if !tx.Migrator().HasColumn(TableFreezeAudits, column[0]) {
+			continue
+		}
+
+		err := tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", TableFreezeAudits, column[0])).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package freezeaudit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestFreezeAudit_Engine_CreateFreezeAuditsTable(t *testing.T) {
+	// setup types
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateFreezeAuditsTable(context.TODO(), test.name)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateFreezeAuditsTable for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateFreezeAuditsTable for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
+
+func TestFreezeAudit_AddClientColumns(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create freeze_audits table: %v", err)
+	}
+
+	// run test
+	for _, column := range clientColumns {
+		if _sqlite.Migrator().HasColumn(TableFreezeAudits, column[0]) {
+			t.Errorf("freeze_audits table already has column %s", column[0])
+		}
+	}
+
+	// the existing columns are skipped when applied again
+	for i := 0; i < 2; i++ {
+		err = AddClientColumns(_sqlite)
+		if err != nil {
+			t.Errorf("AddClientColumns returned err: %v", err)
+		}
+	}
+
+	for _, column := range clientColumns {
+		if !_sqlite.Migrator().HasColumn(TableFreezeAudits, column[0]) {
+			t.Errorf("AddClientColumns did not add column %s", column[0])
+		}
+	}
+}
+
+func TestFreezeAudit_DropClientColumns(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create freeze_audits table: %v", err)
+	}
+
+	err = AddClientColumns(_sqlite)
+	if err != nil {
+		t.Errorf("AddClientColumns returned err: %v", err)
+	}
+
+	// run test
+	err = DropClientColumns(_sqlite)
+	if err != nil {
+		t.Errorf("DropClientColumns returned err: %v", err)
+	}
+
+	// sqlite doesn't support dropping a column
+	for _, column := range clientColumns {
+		if !_sqlite.Migrator().HasColumn(TableFreezeAudits, column[0]) {
+			t.Errorf("DropClientColumns dropped column %s from sqlite freeze_audits table", column[0])
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package hook
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// CleanHooksForRepo deletes the hooks for the repo created
+// before the provided timestamp from the database.
+func (e *engine) CleanHooksForRepo(ctx context.Context, r *library.Repo, before int64) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("cleaning hooks for repo %s created before %d in the database", r.GetFullName(), before)
+
+	// send query to the database
+	result := e.client.WithContext(ctx).
+		Table(constants.TableHook).
+		Where("repo_id = ?", r.GetID()).
+		Where("created < ?", before).
+		Delete(new(database.Hook))
+
+	return result.RowsAffected, result.Error
+}
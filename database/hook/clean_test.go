@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package hook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/library"
+)
+
+func TestHook_Engine_CleanHooksForRepo(t *testing.T) {
+	// setup types
+	_hookOne := testHook()
+	_hookOne.SetID(1)
+	_hookOne.SetRepoID(1)
+	_hookOne.SetBuildID(1)
+	_hookOne.SetNumber(1)
+	_hookOne.SetSourceID("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_hookOne.SetWebhookID(1)
+	_hookOne.SetCreated(1563474076)
+
+	_hookTwo := testHook()
+	_hookTwo.SetID(2)
+	_hookTwo.SetRepoID(1)
+	_hookTwo.SetBuildID(2)
+	_hookTwo.SetNumber(2)
+	_hookTwo.SetSourceID("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_hookTwo.SetWebhookID(1)
+	_hookTwo.SetCreated(1563474090)
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "hooks" WHERE repo_id = $1 AND created < $2`).
+		WithArgs(1, 1563474080).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateHook(context.TODO(), _hookOne)
+	if err != nil {
+		t.Errorf("unable to create test hook for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateHook(context.TODO(), _hookTwo)
+	if err != nil {
+		t.Errorf("unable to create test hook for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CleanHooksForRepo(context.TODO(), _repo, 1563474080)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CleanHooksForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CleanHooksForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("CleanHooksForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -5,13 +5,15 @@
 package hook
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
 
 // CountHooksForRepo gets the count of hooks by repo ID from the database.
-func (e *engine) CountHooksForRepo(r *library.Repo) (int64, error) {
+func (e *engine) CountHooksForRepo(ctx context.Context, r *library.Repo) (int64, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -21,7 +23,7 @@ func (e *engine) CountHooksForRepo(r *library.Repo) (int64, error) {
 	var h int64
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableHook).
 		Where("repo_id = ?", r.GetID()).
 		Count(&h).
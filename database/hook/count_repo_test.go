@@ -5,6 +5,7 @@
 package hook
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -48,12 +49,12 @@ func TestHook_Engine_CountHooksForRepo(t *testing.T) {
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateHook(_hookOne)
+	err := _sqlite.CreateHook(context.TODO(), _hookOne)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateHook(_hookTwo)
+	err = _sqlite.CreateHook(context.TODO(), _hookTwo)
 	if err != nil {
 		t.Errorf("unable to create test hook for sqlite: %v", err)
 	}
@@ -82,7 +83,7 @@ func TestHook_Engine_CountHooksForRepo(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.CountHooksForRepo(_repo)
+			got, err := test.database.CountHooksForRepo(context.TODO(), _repo)
 
 			if test.failure {
 				if err == nil {
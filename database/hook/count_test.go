@@ -5,6 +5,7 @@
 package hook
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -41,12 +42,12 @@ func TestHook_Engine_CountHooks(t *testing.T) {
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateHook(_hookOne)
+	err := _sqlite.CreateHook(context.TODO(), _hookOne)
 	if err != nil {
 		t.Errorf("unable to create test hook for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateHook(_hookTwo)
+	err = _sqlite.CreateHook(context.TODO(), _hookTwo)
 	if err != nil {
 		t.Errorf("unable to create test hook for sqlite: %v", err)
 	}
@@ -75,7 +76,7 @@ func TestHook_Engine_CountHooks(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.CountHooks()
+			got, err := test.database.CountHooks(context.TODO())
 
 			if test.failure {
 				if err == nil {
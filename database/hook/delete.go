@@ -5,6 +5,8 @@
 package hook
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // DeleteHook deletes an existing hook from the database.
-func (e *engine) DeleteHook(h *library.Hook) error {
+func (e *engine) DeleteHook(ctx context.Context, h *library.Hook) error {
 	e.logger.WithFields(logrus.Fields{
 		"hook": h.GetNumber(),
 	}).Tracef("deleting hook %d in the database", h.GetNumber())
@@ -23,7 +25,7 @@ func (e *engine) DeleteHook(h *library.Hook) error {
 	hook := database.HookFromLibrary(h)
 
 	// send query to the database
-	return e.client.
+	return e.client.WithContext(ctx).
 		Table(constants.TableHook).
 		Delete(hook).
 		Error
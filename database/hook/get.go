@@ -5,20 +5,22 @@
 package hook
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // GetHook gets a hook by ID from the database.
-func (e *engine) GetHook(id int64) (*library.Hook, error) {
+func (e *engine) GetHook(ctx context.Context, id int64) (*library.Hook, error) {
 	e.logger.Tracef("getting hook %d from the database", id)
 
 	// variable to store query results
 	h := new(database.Hook)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableHook).
 		Where("id = ?", id).
 		Take(h).
@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package hook
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetHookForBuild gets the hook that created a build from the database.
+func (e *engine) GetHookForBuild(ctx context.Context, b *library.Build) (*library.Hook, error) {
+	e.logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+	}).Tracef("getting hook for build %d from the database", b.GetID())
+
+	// variable to store query results
+	h := new(database.Hook)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(constants.TableHook).
+		Where("build_id = ?", b.GetID()).
+		Take(h).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// return the hook
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Hook.ToLibrary
+	return h.ToLibrary(), nil
+}
@@ -5,6 +5,8 @@
 package hook
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // GetHookForRepo gets a hook by repo ID and number from the database.
-func (e *engine) GetHookForRepo(r *library.Repo, number int) (*library.Hook, error) {
+func (e *engine) GetHookForRepo(ctx context.Context, r *library.Repo, number int) (*library.Hook, error) {
 	e.logger.WithFields(logrus.Fields{
 		"hook": number,
 		"org":  r.GetOrg(),
@@ -23,7 +25,7 @@ func (e *engine) GetHookForRepo(r *library.Repo, number int) (*library.Hook, err
 	h := new(database.Hook)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableHook).
 		Where("repo_id = ?", r.GetID()).
 		Where("number = ?", number).
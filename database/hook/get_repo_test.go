@@ -5,6 +5,7 @@
 package hook
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -43,7 +44,7 @@ func TestHook_Engine_GetHookForRepo(t *testing.T) {
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateHook(_hook)
+	err := _sqlite.CreateHook(context.TODO(), _hook)
 	if err != nil {
 		t.Errorf("unable to create test hook for sqlite: %v", err)
 	}
@@ -72,7 +73,7 @@ func TestHook_Engine_GetHookForRepo(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.GetHookForRepo(_repo, 1)
+			got, err := test.database.GetHookForRepo(context.TODO(), _repo, 1)
 
 			if test.failure {
 				if err == nil {
@@ -5,6 +5,7 @@
 package hook
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-vela/types/constants"
@@ -59,19 +60,19 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating hook database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of hooks table and indexes in the database")
+		e.logger.Trace("skipping creation of hooks table and indexes in the database")
 
 		return e, nil
 	}
 
 	// create the hooks table
-	err := e.CreateHookTable(e.client.Config.Dialector.Name())
+	err := e.CreateHookTable(context.Background(), e.client.Config.Dialector.Name())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create %s table: %w", constants.TableHook, err)
 	}
 
 	// create the indexes for the hooks table
-	err = e.CreateHookIndexes()
+	err = e.CreateHookIndexes(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create indexes for %s table: %w", constants.TableHook, err)
 	}
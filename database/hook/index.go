@@ -4,6 +4,12 @@
 
 package hook
 
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
 const (
 	// CreateRepoIDIndex represents a query to create an
 	// index on the hooks table for the repo_id column.
@@ -16,9 +22,14 @@ ON hooks (repo_id);
 )
 
 // CreateHookIndexes creates the indexes for the hooks table in the database.
-func (e *engine) CreateHookIndexes() error {
+func (e *engine) CreateHookIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for hooks table in the database")
 
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
 	// create the repo_id column index for the hooks table
-	return e.client.Exec(CreateRepoIDIndex).Error
+	return e.client.WithContext(ctx).Exec(CreateRepoIDIndex).Error
 }
@@ -5,13 +5,15 @@
 package hook
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // ListHooks gets a list of all hooks from the database.
-func (e *engine) ListHooks() ([]*library.Hook, error) {
+func (e *engine) ListHooks(ctx context.Context) ([]*library.Hook, error) {
 	e.logger.Trace("listing all hooks from the database")
 
 	// variables to store query results and return value
@@ -20,7 +22,7 @@ func (e *engine) ListHooks() ([]*library.Hook, error) {
 	hooks := []*library.Hook{}
 
 	// count the results
-	count, err := e.CountHooks()
+	count, err := e.CountHooks(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +33,7 @@ func (e *engine) ListHooks() ([]*library.Hook, error) {
 	}
 
 	// send query to the database and store result in variable
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TableHook).
 		Find(&h).
 		Error
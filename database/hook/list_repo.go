@@ -5,6 +5,8 @@
 package hook
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // ListHooksForRepo gets a list of hooks by repo ID from the database.
-func (e *engine) ListHooksForRepo(r *library.Repo, page, perPage int) ([]*library.Hook, int64, error) {
+func (e *engine) ListHooksForRepo(ctx context.Context, r *library.Repo, page, perPage int) ([]*library.Hook, int64, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -24,7 +26,7 @@ func (e *engine) ListHooksForRepo(r *library.Repo, page, perPage int) ([]*librar
 	hooks := []*library.Hook{}
 
 	// count the results
-	count, err := e.CountHooksForRepo(r)
+	count, err := e.CountHooksForRepo(ctx, r)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -38,7 +40,7 @@ func (e *engine) ListHooksForRepo(r *library.Repo, page, perPage int) ([]*librar
 	offset := perPage * (page - 1)
 
 	// send query to the database and store result in variable
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TableHook).
 		Where("repo_id = ?", r.GetID()).
 		Order("id DESC").
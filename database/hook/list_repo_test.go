@@ -5,6 +5,7 @@
 package hook
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -58,12 +59,12 @@ func TestHook_Engine_ListHooksForRepo(t *testing.T) {
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateHook(_hookOne)
+	err := _sqlite.CreateHook(context.TODO(), _hookOne)
 	if err != nil {
 		t.Errorf("unable to create test hook for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateHook(_hookTwo)
+	err = _sqlite.CreateHook(context.TODO(), _hookTwo)
 	if err != nil {
 		t.Errorf("unable to create test hook for sqlite: %v", err)
 	}
@@ -92,7 +93,7 @@ func TestHook_Engine_ListHooksForRepo(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, _, err := test.database.ListHooksForRepo(_repo, 1, 10)
+			got, _, err := test.database.ListHooksForRepo(context.TODO(), _repo, 1, 10)
 
 			if test.failure {
 				if err == nil {
@@ -5,6 +5,9 @@
 package hook
 
 import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/types/constants"
 )
 
@@ -53,22 +56,49 @@ hooks (
 	webhook_id   INTEGER,
 	UNIQUE(repo_id, build_id)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL hooks table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+hooks (
+	id           INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id      INTEGER,
+	build_id     INTEGER,
+	number       INTEGER,
+	source_id    VARCHAR(250),
+	created      INTEGER,
+	host         VARCHAR(250),
+	event        VARCHAR(250),
+	event_action VARCHAR(250),
+	branch       VARCHAR(500),
+	error        VARCHAR(500),
+	status       VARCHAR(250),
+	link         VARCHAR(1000),
+	webhook_id   INTEGER,
+	UNIQUE(repo_id, number),
+	INDEX hooks_repo_id (repo_id)
+);
 `
 )
 
 // CreateHookTable creates the hooks table in the database.
-func (e *engine) CreateHookTable(driver string) error {
+func (e *engine) CreateHookTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating hooks table in the database")
 
 	// handle the driver provided to create the table
 	switch driver {
 	case constants.DriverPostgres:
 		// create the hooks table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the hooks table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the hooks table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
 	}
 }
@@ -5,6 +5,8 @@
 package hook
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // UpdateHook updates an existing hook in the database.
-func (e *engine) UpdateHook(h *library.Hook) error {
+func (e *engine) UpdateHook(ctx context.Context, h *library.Hook) error {
 	e.logger.WithFields(logrus.Fields{
 		"hook": h.GetNumber(),
 	}).Tracef("updating hook %d in the database", h.GetNumber())
@@ -31,7 +33,7 @@ func (e *engine) UpdateHook(h *library.Hook) error {
 	}
 
 	// send query to the database
-	return e.client.
+	return e.client.WithContext(ctx).
 		Table(constants.TableHook).
 		Save(hook).
 		Error
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestIdempotencyKey_Engine_CreateIdempotencyKey(t *testing.T) {
+	// setup types
+	_key := testIdempotencyKey()
+	_key.SetID(1)
+	_key.SetRepoID(1)
+	_key.SetKey("7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a")
+	_key.SetFingerprint("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	_key.SetStatus(201)
+	_key.SetResponse(`{"id":1}`)
+	_key.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "idempotency_keys"
+("repo_id","key","fingerprint","status","response","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`).
+		WithArgs(1, "7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a", "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", 201, `{"id":1}`, 1563474076, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateIdempotencyKey(context.TODO(), _key)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateIdempotencyKey for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateIdempotencyKey for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteIdempotencyKey deletes an existing idempotency key from the database.
+func (e *engine) DeleteIdempotencyKey(ctx context.Context, k *api.IdempotencyKey) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo": k.GetRepoID(),
+		"key":  k.GetKey(),
+	}).Tracef("deleting idempotency key %s from the database", k.GetKey())
+
+	// cast the API type to database type
+	key := types.IdempotencyKeyFromAPI(k)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableIdempotencyKey).
+		Delete(key).
+		Error
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestIdempotencyKey_Engine_DeleteIdempotencyKey(t *testing.T) {
+	// setup types
+	_key := testIdempotencyKey()
+	_key.SetID(1)
+	_key.SetRepoID(1)
+	_key.SetKey("7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a")
+	_key.SetFingerprint("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	_key.SetStatus(201)
+	_key.SetResponse(`{"id":1}`)
+	_key.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "idempotency_keys" WHERE "idempotency_keys"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateIdempotencyKey(context.TODO(), _key)
+	if err != nil {
+		t.Errorf("unable to create test idempotency key for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.DeleteIdempotencyKey(context.TODO(), _key)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteIdempotencyKey for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteIdempotencyKey for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"gorm.io/gorm/clause"
+)
+
+// GetIdempotencyKeyForRepo gets an idempotency key by repo ID and key from the database.
+func (e *engine) GetIdempotencyKeyForRepo(ctx context.Context, r *library.Repo, key string) (*api.IdempotencyKey, error) {
+	e.logger.Tracef("getting idempotency key %s for repo %s from the database", key, r.GetFullName())
+
+	// variable to store query results
+	k := new(types.IdempotencyKey)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableIdempotencyKey).
+		Where("repo_id = ?", r.GetID()).
+		Where(clause.Eq{Column: clause.Column{Name: "key"}, Value: key}).
+		Take(k).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return k.ToAPI(), nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestIdempotencyKey_Engine_GetIdempotencyKeyForRepo(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	_key := testIdempotencyKey()
+	_key.SetID(1)
+	_key.SetRepoID(1)
+	_key.SetKey("7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a")
+	_key.SetFingerprint("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	_key.SetStatus(201)
+	_key.SetResponse(`{"id":1}`)
+	_key.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "key", "fingerprint", "status", "response", "created"}).
+		AddRow(1, 1, "7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a", "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", 201, `{"id":1}`, 1563474076)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "idempotency_keys" WHERE repo_id = $1 AND "key" = $2 LIMIT 1`).WithArgs(1, "7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateIdempotencyKey(context.TODO(), _key)
+	if err != nil {
+		t.Errorf("unable to create test idempotency key for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.IdempotencyKey
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _key,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _key,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetIdempotencyKeyForRepo(context.TODO(), _repo, "7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetIdempotencyKeyForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetIdempotencyKeyForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetIdempotencyKeyForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
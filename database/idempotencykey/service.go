@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// IdempotencyKeyService represents the Vela interface for idempotency key
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type IdempotencyKeyService interface {
+	// IdempotencyKey Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateIdempotencyKeyIndexes defines a function that creates the indexes for the idempotency_keys table.
+	CreateIdempotencyKeyIndexes(context.Context) error
+	// CreateIdempotencyKeyTable defines a function that creates the idempotency_keys table.
+	CreateIdempotencyKeyTable(context.Context, string) error
+
+	// IdempotencyKey Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateIdempotencyKey defines a function that creates a new idempotency key.
+	CreateIdempotencyKey(context.Context, *api.IdempotencyKey) error
+	// DeleteIdempotencyKey defines a function that deletes an existing idempotency key.
+	DeleteIdempotencyKey(context.Context, *api.IdempotencyKey) error
+	// GetIdempotencyKeyForRepo defines a function that gets an idempotency key by repo ID and key.
+	GetIdempotencyKeyForRepo(context.Context, *library.Repo, string) (*api.IdempotencyKey, error)
+	// UpdateIdempotencyKey defines a function that updates an existing idempotency key.
+	UpdateIdempotencyKey(context.Context, *api.IdempotencyKey) error
+}
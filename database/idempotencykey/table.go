@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableIdempotencyKey represents the name of the table for idempotency keys.
+	TableIdempotencyKey = "idempotency_keys"
+
+	// CreatePostgresTable represents a query to create the Postgres idempotency_keys table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+idempotency_keys (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	key           VARCHAR(255),
+	fingerprint   VARCHAR(64),
+	status        INTEGER,
+	response      TEXT,
+	created       INTEGER,
+	UNIQUE(repo_id, key)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite idempotency_keys table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+idempotency_keys (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	key           TEXT,
+	fingerprint   TEXT,
+	status        INTEGER,
+	response      TEXT,
+	created       INTEGER,
+	UNIQUE(repo_id, key)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL idempotency_keys table.
+	//
+	// The key column is quoted since it is a reserved word for MySQL.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+idempotency_keys (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	` + "`key`" + `         VARCHAR(255),
+	fingerprint   VARCHAR(64),
+	status        INTEGER,
+	response      TEXT,
+	created       INTEGER,
+	UNIQUE(repo_id, ` + "`key`" + `),
+	INDEX idempotency_keys_created (created)
+);
+`
+)
+
+// CreateIdempotencyKeyTable creates the idempotency_keys table in the database.
+func (e *engine) CreateIdempotencyKeyTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating idempotency_keys table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the idempotency_keys table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the idempotency_keys table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the idempotency_keys table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package idempotencykey
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateIdempotencyKey updates an existing idempotency key in the database.
+func (e *engine) UpdateIdempotencyKey(ctx context.Context, k *api.IdempotencyKey) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo": k.GetRepoID(),
+		"key":  k.GetKey(),
+	}).Tracef("updating idempotency key %s in the database", k.GetKey())
+
+	// cast the API type to database type
+	key := types.IdempotencyKeyFromAPI(k)
+
+	// validate the necessary fields are populated
+	err := key.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableIdempotencyKey).
+		Save(key).
+		Error
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package label
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateBuildLabels creates the labels for a build in the database.
+func (e *engine) CreateBuildLabels(ctx context.Context, l *api.BuildLabels) error {
+	e.logger.WithFields(logrus.Fields{
+		"build": l.GetBuildID(),
+	}).Tracef("creating labels for build %d in the database", l.GetBuildID())
+
+	// cast the API type to database type
+	labels := types.BuildLabelsFromAPI(l)
+
+	// validate the necessary fields are populated
+	err := labels.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableBuildLabels).
+		Create(labels).
+		Error
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package label
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLabel_Engine_CreateBuildLabels(t *testing.T) {
+	// setup types
+	_labels := testBuildLabels()
+	_labels.SetID(1)
+	_labels.SetBuildID(1)
+	_labels.SetRepoID(1)
+	_labels.SetAction("labeled")
+	_labels.SetLabels([]string{"deploy-preview"})
+	_labels.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "build_labels"
+("build_id","repo_id","action","labels","created","id")
+VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`).
+		WithArgs(1, 1, "labeled", `{"deploy-preview"}`, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateBuildLabels(context.TODO(), _labels)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateBuildLabels for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateBuildLabels for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
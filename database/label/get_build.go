@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package label
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// GetBuildLabelsForBuild gets the labels for a build from the database.
+func (e *engine) GetBuildLabelsForBuild(ctx context.Context, b *library.Build) (*api.BuildLabels, error) {
+	e.logger.Tracef("getting labels for build %d from the database", b.GetID())
+
+	// variable to store query results
+	l := new(types.BuildLabels)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableBuildLabels).
+		Where("build_id = ?", b.GetID()).
+		Take(l).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return l.ToAPI(), nil
+}
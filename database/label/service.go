@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package label
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// LabelService represents the Vela interface for label
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type LabelService interface {
+	// Label Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateBuildLabelsIndexes defines a function that creates the indexes for the build_labels table.
+	CreateBuildLabelsIndexes(context.Context) error
+	// CreateBuildLabelsTable defines a function that creates the build_labels table.
+	CreateBuildLabelsTable(context.Context, string) error
+
+	// Label Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateBuildLabels defines a function that creates the labels for a build.
+	CreateBuildLabels(context.Context, *api.BuildLabels) error
+	// GetBuildLabelsForBuild defines a function that gets the labels for a build.
+	GetBuildLabelsForBuild(context.Context, *library.Build) (*api.BuildLabels, error)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package label
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableBuildLabels represents the name of the table for build labels.
+	TableBuildLabels = "build_labels"
+
+	// CreatePostgresTable represents a query to create the Postgres build_labels table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_labels (
+	id            SERIAL PRIMARY KEY,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	action        VARCHAR(250),
+	labels        VARCHAR(1000),
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite build_labels table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_labels (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	action        TEXT,
+	labels        TEXT,
+	created       INTEGER,
+	UNIQUE(build_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL build_labels table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_labels (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	action        VARCHAR(250),
+	labels        VARCHAR(1000),
+	created       INTEGER,
+	UNIQUE(build_id),
+	INDEX build_labels_repo_id (repo_id)
+);
+`
+)
+
+// CreateBuildLabelsTable creates the build_labels table in the database.
+func (e *engine) CreateBuildLabelsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating build_labels table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the build_labels table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the build_labels table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the build_labels table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+)
+
+// CountLeakFindings gets the count of all leak findings from the database.
+func (e *engine) CountLeakFindings(ctx context.Context) (int64, error) {
+	e.logger.Tracef("getting count of all leak findings from the database")
+
+	// variable to store query results
+	var f int64
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableLeakFindings).
+		Count(&f).
+		Error
+
+	return f, err
+}
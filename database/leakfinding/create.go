@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateLeakFinding creates a new leak finding in the database.
+func (e *engine) CreateLeakFinding(ctx context.Context, f *api.LeakFinding) error {
+	e.logger.WithFields(logrus.Fields{
+		"build": f.GetBuildID(),
+		"kind":  f.GetKind(),
+	}).Tracef("creating %s leak finding for build %d in the database", f.GetKind(), f.GetBuildID())
+
+	// cast the API type to database type
+	finding := types.LeakFindingFromAPI(f)
+
+	// validate the necessary fields are populated
+	err := finding.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableLeakFindings).
+		Create(finding).
+		Error
+}
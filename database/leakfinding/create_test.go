@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLeakFinding_Engine_CreateLeakFinding(t *testing.T) {
+	// setup types
+	_finding := testLeakFinding()
+	_finding.SetID(1)
+	_finding.SetRepoID(1)
+	_finding.SetBuildID(1)
+	_finding.SetLogID(1)
+	_finding.SetKind("aws_access_key")
+	_finding.SetLine(3)
+	_finding.SetFingerprint("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	_finding.SetRedacted(true)
+	_finding.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "leak_findings"
+("repo_id","build_id","log_id","kind","line","fingerprint","redacted","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs(1, 1, 1, "aws_access_key", 3, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", true, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateLeakFinding(context.TODO(), _finding)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateLeakFinding for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateLeakFinding for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
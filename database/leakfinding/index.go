@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the leak_findings table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+leak_findings_build_id
+ON leak_findings (build_id);
+`
+)
+
+// CreateLeakFindingsIndexes creates the indexes for the leak_findings table in the database.
+func (e *engine) CreateLeakFindingsIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for leak_findings table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the build_id column index for the leak_findings table
+	return e.client.WithContext(ctx).Exec(CreateBuildIDIndex).Error
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListLeakFindings gets a list of all leak findings from the database
+// with the most recent findings first.
+func (e *engine) ListLeakFindings(ctx context.Context, page, perPage int) ([]*api.LeakFinding, int64, error) {
+	e.logger.Trace("listing all leak findings from the database")
+
+	// variables to store query results and return value
+	count := int64(0)
+	f := new([]types.LeakFinding)
+	findings := []*api.LeakFinding{}
+
+	// count the results
+	count, err := e.CountLeakFindings(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return findings, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = e.client.WithContext(ctx).
+		Table(TableLeakFindings).
+		Order("id DESC").
+		Limit(perPage).
+		Offset(offset).
+		Find(&f).
+		Error
+	if err != nil {
+		return nil, count, err
+	}
+
+	// iterate through all query results
+	for _, finding := range *f {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := finding
+
+		// convert query result to API type
+		findings = append(findings, tmp.ToAPI())
+	}
+
+	return findings, count, nil
+}
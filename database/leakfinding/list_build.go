@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListLeakFindingsForBuild gets the leak findings by build ID from the database.
+func (e *engine) ListLeakFindingsForBuild(ctx context.Context, b *library.Build) ([]*api.LeakFinding, error) {
+	e.logger.Tracef("listing leak findings for build %d from the database", b.GetID())
+
+	// variables to store query results and return value
+	f := new([]types.LeakFinding)
+	findings := []*api.LeakFinding{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableLeakFindings).
+		Where("build_id = ?", b.GetID()).
+		Order("id").
+		Find(&f).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, finding := range *f {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := finding
+
+		// convert query result to API type
+		findings = append(findings, tmp.ToAPI())
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestLeakFinding_Engine_ListLeakFindingsForBuild(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+
+	_findingOne := testLeakFinding()
+	_findingOne.SetID(1)
+	_findingOne.SetRepoID(1)
+	_findingOne.SetBuildID(1)
+	_findingOne.SetLogID(1)
+	_findingOne.SetKind("aws_access_key")
+	_findingOne.SetLine(3)
+	_findingOne.SetFingerprint("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	_findingOne.SetRedacted(true)
+	_findingOne.SetCreated(1)
+
+	_findingTwo := testLeakFinding()
+	_findingTwo.SetID(2)
+	_findingTwo.SetRepoID(1)
+	_findingTwo.SetBuildID(1)
+	_findingTwo.SetLogID(2)
+	_findingTwo.SetKind("jwt")
+	_findingTwo.SetLine(7)
+	_findingTwo.SetFingerprint("60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752")
+	_findingTwo.SetCreated(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "log_id", "kind", "line", "fingerprint", "redacted", "created"}).
+		AddRow(1, 1, 1, 1, "aws_access_key", 3, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", true, 1).
+		AddRow(2, 1, 1, 2, "jwt", 7, "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752", false, 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "leak_findings" WHERE build_id = $1 ORDER BY id`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, finding := range []*api.LeakFinding{_findingOne, _findingTwo} {
+		err := _sqlite.CreateLeakFinding(context.TODO(), finding)
+		if err != nil {
+			t.Errorf("unable to create test leak finding for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.LeakFinding
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.LeakFinding{_findingOne, _findingTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.LeakFinding{_findingOne, _findingTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListLeakFindingsForBuild(context.TODO(), _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListLeakFindingsForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListLeakFindingsForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListLeakFindingsForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// LeakFindingService represents the Vela interface for leak finding
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type LeakFindingService interface {
+	// LeakFinding Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateLeakFindingsIndexes defines a function that creates the indexes for the leak_findings table.
+	CreateLeakFindingsIndexes(context.Context) error
+	// CreateLeakFindingsTable defines a function that creates the leak_findings table.
+	CreateLeakFindingsTable(context.Context, string) error
+
+	// LeakFinding Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CountLeakFindings defines a function that gets the count of all leak findings.
+	CountLeakFindings(context.Context) (int64, error)
+	// CreateLeakFinding defines a function that creates a new leak finding.
+	CreateLeakFinding(context.Context, *api.LeakFinding) error
+	// ListLeakFindings defines a function that gets a list of all leak findings.
+	ListLeakFindings(context.Context, int, int) ([]*api.LeakFinding, int64, error)
+	// ListLeakFindingsForBuild defines a function that gets a list of leak findings by build ID.
+	ListLeakFindingsForBuild(context.Context, *library.Build) ([]*api.LeakFinding, error)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leakfinding
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableLeakFindings represents the name of the table for leak findings.
+	TableLeakFindings = "leak_findings"
+
+	// CreatePostgresTable represents a query to create the Postgres leak_findings table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+leak_findings (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	log_id        INTEGER,
+	kind          VARCHAR(250),
+	line          INTEGER,
+	fingerprint   VARCHAR(64),
+	redacted      BOOLEAN,
+	created       INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite leak_findings table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+leak_findings (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	log_id        INTEGER,
+	kind          TEXT,
+	line          INTEGER,
+	fingerprint   TEXT,
+	redacted      BOOLEAN,
+	created       INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL leak_findings table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+leak_findings (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	log_id        INTEGER,
+	kind          VARCHAR(250),
+	line          INTEGER,
+	fingerprint   VARCHAR(64),
+	redacted      BOOLEAN,
+	created       INTEGER,
+	INDEX leak_findings_build_id (build_id)
+);
+`
+)
+
+// CreateLeakFindingsTable creates the leak_findings table in the database.
+func (e *engine) CreateLeakFindingsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating leak_findings table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the leak_findings table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the leak_findings table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the leak_findings table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ClaimLease updates an existing lease in the database only when it
+// is still held by the holder and was last renewed at the time provided,
+// returning whether the lease was updated. This prevents two replicas
+// from claiming the same lease after observing it at the same time.
+func (e *engine) ClaimLease(ctx context.Context, l *api.Lease, holder string, renewed int64) (bool, error) {
+	e.logger.WithFields(logrus.Fields{
+		"lease":  l.GetName(),
+		"holder": l.GetHolder(),
+	}).Tracef("claiming lease %s in the database", l.GetName())
+
+	// cast the API type to database type
+	lease := types.LeaseFromAPI(l)
+
+	// validate the necessary fields are populated
+	err := lease.Validate()
+	if err != nil {
+		return false, err
+	}
+
+	// send query to the database
+	result := e.client.WithContext(ctx).
+		Table(TableLease).
+		Where("id = ? AND holder = ? AND renewed = ?", l.GetID(), holder, renewed).
+		Updates(map[string]interface{}{
+			"holder":   lease.Holder,
+			"state":    lease.State,
+			"acquired": lease.Acquired,
+			"renewed":  lease.Renewed,
+			"expires":  lease.Expires,
+			"changes":  lease.Changes,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLease_Engine_ClaimLease(t *testing.T) {
+	// setup types
+	_lease := testLease()
+	_lease.SetID(1)
+	_lease.SetName("janitor")
+	_lease.SetHolder("vela-server-0")
+	_lease.SetAcquired(1)
+	_lease.SetRenewed(1)
+	_lease.SetExpires(31)
+	_lease.SetChanges(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectExec(`UPDATE "leases" SET "acquired"=$1,"changes"=$2,"expires"=$3,"holder"=$4,"renewed"=$5,"state"=$6 WHERE id = $7 AND holder = $8 AND renewed = $9`).
+		WithArgs(41, 2, 71, "vela-server-1", 41, nil, 1, "vela-server-0", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_mock.ExpectExec(`UPDATE "leases" SET "acquired"=$1,"changes"=$2,"expires"=$3,"holder"=$4,"renewed"=$5,"state"=$6 WHERE id = $7 AND holder = $8 AND renewed = $9`).
+		WithArgs(41, 2, 71, "vela-server-1", 41, nil, 1, "vela-server-0", 1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLease(context.TODO(), _lease)
+	if err != nil {
+		t.Errorf("unable to create test lease for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     bool
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     true,
+		},
+		{
+			failure:  false,
+			name:     "postgres claimed",
+			database: _postgres,
+			want:     false,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     true,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 claimed",
+			database: _sqlite,
+			want:     false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claim := testLease()
+			claim.SetID(1)
+			claim.SetName("janitor")
+			claim.SetHolder("vela-server-1")
+			claim.SetAcquired(41)
+			claim.SetRenewed(41)
+			claim.SetExpires(71)
+			claim.SetChanges(2)
+
+			got, err := test.database.ClaimLease(context.TODO(), claim, "vela-server-0", 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ClaimLease for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ClaimLease for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("ClaimLease for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateLease creates a new lease in the database.
+func (e *engine) CreateLease(ctx context.Context, l *api.Lease) error {
+	e.logger.WithFields(logrus.Fields{
+		"lease":  l.GetName(),
+		"holder": l.GetHolder(),
+	}).Tracef("creating lease %s in the database", l.GetName())
+
+	// cast the API type to database type
+	lease := types.LeaseFromAPI(l)
+
+	// validate the necessary fields are populated
+	err := lease.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableLease).
+		Create(lease).
+		Error
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLease_Engine_CreateLease(t *testing.T) {
+	// setup types
+	_lease := testLease()
+	_lease.SetID(1)
+	_lease.SetName("janitor")
+	_lease.SetHolder("vela-server-0")
+	_lease.SetAcquired(1)
+	_lease.SetRenewed(1)
+	_lease.SetExpires(31)
+	_lease.SetChanges(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "leases"
+("name","holder","state","acquired","renewed","expires","changes","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs("janitor", "vela-server-0", nil, 1, 1, 31, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateLease(context.TODO(), _lease)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateLease for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateLease for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetLease gets a lease by name from the database.
+func (e *engine) GetLease(ctx context.Context, name string) (*api.Lease, error) {
+	e.logger.Tracef("getting lease %s from the database", name)
+
+	// variable to store query results
+	l := new(types.Lease)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableLease).
+		Where("name = ?", name).
+		Take(l).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return l.ToAPI(), nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLease_Engine_GetLease(t *testing.T) {
+	// setup types
+	_lease := testLease()
+	_lease.SetID(1)
+	_lease.SetName("janitor")
+	_lease.SetHolder("vela-server-0")
+	_lease.SetState("1")
+	_lease.SetAcquired(1)
+	_lease.SetRenewed(1)
+	_lease.SetExpires(31)
+	_lease.SetChanges(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "name", "holder", "state", "acquired", "renewed", "expires", "changes"}).
+		AddRow(1, "janitor", "vela-server-0", "1", 1, 1, 31, 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "leases" WHERE name = $1 LIMIT 1`).WithArgs("janitor").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLease(context.TODO(), _lease)
+	if err != nil {
+		t.Errorf("unable to create test lease for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.Lease
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _lease,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _lease,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetLease(context.TODO(), "janitor")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetLease for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetLease for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetLease for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListLeases gets a list of all leases from the database.
+func (e *engine) ListLeases(ctx context.Context) ([]*api.Lease, error) {
+	e.logger.Tracef("listing all leases from the database")
+
+	// variables to store query results and return value
+	l := new([]types.Lease)
+	leases := []*api.Lease{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableLease).
+		Order("name").
+		Find(&l).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, lease := range *l {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := lease
+
+		// convert query result to API type
+		leases = append(leases, tmp.ToAPI())
+	}
+
+	return leases, nil
+}
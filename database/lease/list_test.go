@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLease_Engine_ListLeases(t *testing.T) {
+	// setup types
+	_leaseOne := testLease()
+	_leaseOne.SetID(1)
+	_leaseOne.SetName("canary")
+	_leaseOne.SetHolder("vela-server-0")
+	_leaseOne.SetState("1")
+	_leaseOne.SetAcquired(1)
+	_leaseOne.SetRenewed(1)
+	_leaseOne.SetExpires(31)
+	_leaseOne.SetChanges(1)
+
+	_leaseTwo := testLease()
+	_leaseTwo.SetID(2)
+	_leaseTwo.SetName("janitor")
+	_leaseTwo.SetHolder("vela-server-1")
+	_leaseTwo.SetAcquired(1)
+	_leaseTwo.SetRenewed(11)
+	_leaseTwo.SetExpires(41)
+	_leaseTwo.SetChanges(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "name", "holder", "state", "acquired", "renewed", "expires", "changes"}).
+		AddRow(1, "canary", "vela-server-0", "1", 1, 1, 31, 1).
+		AddRow(2, "janitor", "vela-server-1", "", 1, 11, 41, 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "leases" ORDER BY name`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLease(context.TODO(), _leaseOne)
+	if err != nil {
+		t.Errorf("unable to create test lease for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateLease(context.TODO(), _leaseTwo)
+	if err != nil {
+		t.Errorf("unable to create test lease for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Lease
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Lease{_leaseOne, _leaseTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Lease{_leaseOne, _leaseTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListLeases(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListLeases for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListLeases for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListLeases for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// LeaseService represents the Vela interface for lease
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type LeaseService interface {
+	// Lease Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateLeaseTable defines a function that creates the leases table.
+	CreateLeaseTable(context.Context, string) error
+
+	// Lease Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// ClaimLease defines a function that updates an existing lease
+	// only when it was last renewed by the holder at the time provided.
+	ClaimLease(context.Context, *api.Lease, string, int64) (bool, error)
+	// CreateLease defines a function that creates a new lease.
+	CreateLease(context.Context, *api.Lease) error
+	// GetLease defines a function that gets a lease by name.
+	GetLease(context.Context, string) (*api.Lease, error)
+	// ListLeases defines a function that gets a list of all leases.
+	ListLeases(context.Context) ([]*api.Lease, error)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package lease
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableLease represents the name of the table for leases.
+	TableLease = "leases"
+
+	// CreatePostgresTable represents a query to create the Postgres leases table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+leases (
+	id            SERIAL PRIMARY KEY,
+	name          VARCHAR(250),
+	holder        VARCHAR(250),
+	state         VARCHAR(5000),
+	acquired      INTEGER,
+	renewed       INTEGER,
+	expires       INTEGER,
+	changes       INTEGER,
+	UNIQUE(name)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite leases table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+leases (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	name          TEXT,
+	holder        TEXT,
+	state         TEXT,
+	acquired      INTEGER,
+	renewed       INTEGER,
+	expires       INTEGER,
+	changes       INTEGER,
+	UNIQUE(name)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL leases table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+leases (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	name          VARCHAR(250),
+	holder        VARCHAR(250),
+	state         VARCHAR(5000),
+	acquired      INTEGER,
+	renewed       INTEGER,
+	expires       INTEGER,
+	changes       INTEGER,
+	UNIQUE(name)
+);
+`
+)
+
+// CreateLeaseTable creates the leases table in the database.
+func (e *engine) CreateLeaseTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating leases table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the leases table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the leases table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the leases table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-vela/types/database"
+)
+
+// record represents the log stored in the database with the
+// key for the object storing the data for the log in the
+// blob storage. The key is stored in a separate column so
+// the data for a log is never mistaken for a pointer to an
+// object, regardless of what the data contains.
+type record struct {
+	database.Log
+
+	// key for the object storing the data in the blob storage,
+	// the data for the log is empty when the key is set
+	Object sql.NullString `sql:"object"`
+}
+
+// objectKey is a helper function to create the key
+// for the object storing the data for the log.
+func objectKey(l *database.Log) string {
+	switch {
+	case l.ServiceID.Int64 > 0:
+		return fmt.Sprintf("logs/%d/service/%d", l.BuildID.Int64, l.ServiceID.Int64)
+	case l.StepID.Int64 > 0:
+		return fmt.Sprintf("logs/%d/step/%d", l.BuildID.Int64, l.StepID.Int64)
+	default:
+		return ""
+	}
+}
+
+// offload is a helper function to store the data for the log
+// in the blob storage and record the key for the object.
+func (e *engine) offload(ctx context.Context, l *record) error {
+	// check if the blob storage is enabled
+	if e.blob == nil || len(l.Data) == 0 {
+		return nil
+	}
+
+	key := objectKey(&l.Log)
+	if len(key) == 0 {
+		return nil
+	}
+
+	// send API call to store the data for the log
+	err := e.blob.Put(ctx, key, l.Data)
+	if err != nil {
+		return fmt.Errorf("unable to offload log data to blob storage: %w", err)
+	}
+
+	l.Data = []byte{}
+	l.Object = sql.NullString{String: key, Valid: true}
+
+	return nil
+}
+
+// retrieve is a helper function to replace the empty data
+// for the log with the data from the object in the blob storage.
+func (e *engine) retrieve(ctx context.Context, l *record) error {
+	// check if the data for the log is stored in the database
+	if !l.Object.Valid || len(l.Object.String) == 0 {
+		return nil
+	}
+
+	if e.blob == nil {
+		return fmt.Errorf("unable to retrieve log data from blob storage: no blob storage configured for %s", l.Object.String)
+	}
+
+	// send API call to capture the data for the log
+	data, err := e.blob.Get(ctx, l.Object.String)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve log data from blob storage: %w", err)
+	}
+
+	l.Data = data
+
+	return nil
+}
+
+// remove is a helper function to delete the
+// object storing the data for the log.
+func (e *engine) remove(ctx context.Context, l *database.Log) error {
+	// check if the blob storage is enabled
+	if e.blob == nil {
+		return nil
+	}
+
+	key := objectKey(l)
+	if len(key) == 0 {
+		return nil
+	}
+
+	// send API call to remove the data for the log
+	err := e.blob.Delete(ctx, key)
+	if err != nil {
+		return fmt.Errorf("unable to remove log data from blob storage: %w", err)
+	}
+
+	return nil
+}
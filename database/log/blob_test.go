@@ -0,0 +1,219 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/sirupsen/logrus"
+)
+
+// testBlob represents a blob storage service
+// that stores the objects in memory for tests.
+type testBlob struct {
+	objects map[string][]byte
+}
+
+// newTestBlob is a helper function to create a blob storage service for tests.
+func newTestBlob() *testBlob {
+	return &testBlob{objects: make(map[string][]byte)}
+}
+
+// Driver outputs the driver for the blob storage service.
+func (b *testBlob) Driver() string {
+	return "test"
+}
+
+// Put stores the data for the key in memory.
+func (b *testBlob) Put(_ context.Context, key string, data []byte) error {
+	b.objects[key] = data
+
+	return nil
+}
+
+// Get captures the data for the key from memory.
+func (b *testBlob) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := b.objects[key]
+	if !ok {
+		return nil, errors.New("object not found")
+	}
+
+	return data, nil
+}
+
+// Delete removes the data for the key from memory.
+func (b *testBlob) Delete(_ context.Context, key string) error {
+	delete(b.objects, key)
+
+	return nil
+}
+
+func TestLog_Engine_Blob(t *testing.T) {
+	// setup types
+	_step := testStep()
+	_step.SetID(1)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+	_step.SetNumber(1)
+	_step.SetName("foo")
+	_step.SetImage("bar")
+
+	_log := testLog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("foo"))
+
+	_blob := newTestBlob()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := WithBlob(_blob)(_sqlite)
+	if err != nil {
+		t.Errorf("unable to set blob storage for sqlite log engine: %v", err)
+	}
+
+	// run tests
+	err = _sqlite.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("CreateLog returned err: %v", err)
+	}
+
+	if _, ok := _blob.objects["logs/1/step/1"]; !ok {
+		t.Errorf("CreateLog objects are %v, want logs/1/step/1", _blob.objects)
+	}
+
+	// the database only stores the key for the object
+	row := new(record)
+
+	err = _sqlite.client.Table(constants.TableLog).Where("id = ?", 1).Take(row).Error
+	if err != nil {
+		t.Errorf("unable to capture log row: %v", err)
+	}
+
+	if len(row.Data) > 0 || row.Object.String != "logs/1/step/1" {
+		t.Errorf("CreateLog stored data %s and object %s, want key for object", row.Data, row.Object.String)
+	}
+
+	_log.SetData([]byte("foobar"))
+
+	err = _sqlite.UpdateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("UpdateLog returned err: %v", err)
+	}
+
+	got, err := _sqlite.GetLogForStep(context.TODO(), _step)
+	if err != nil {
+		t.Errorf("GetLogForStep returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _log) {
+		t.Errorf("GetLogForStep is %v, want %v", got, _log)
+	}
+
+	got, err = _sqlite.GetLog(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("GetLog returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _log) {
+		t.Errorf("GetLog is %v, want %v", got, _log)
+	}
+
+	// the pointer is unable to be resolved without the blob storage
+	_without, err := New(
+		WithClient(_sqlite.client),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithSkipCreation(true),
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite log engine: %v", err)
+	}
+
+	_, err = _without.GetLogForStep(context.TODO(), _step)
+	if err == nil {
+		t.Errorf("GetLogForStep without blob storage should have returned err")
+	}
+
+	err = _sqlite.DeleteLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("DeleteLog returned err: %v", err)
+	}
+
+	if len(_blob.objects) != 0 {
+		t.Errorf("DeleteLog objects are %v, want none", _blob.objects)
+	}
+}
+
+func TestLog_Engine_Blob_DataWithPrefix(t *testing.T) {
+	// setup types
+	_log := testLog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("blob://logs/1/step/1"))
+
+	_blob := newTestBlob()
+	_blob.objects["logs/1/step/1"] = []byte("foo")
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := WithBlob(_blob)(_sqlite)
+	if err != nil {
+		t.Errorf("unable to set blob storage for sqlite log engine: %v", err)
+	}
+
+	// store the data uncompressed in the database
+	// like the logs created before compression
+	err = _sqlite.client.Table(constants.TableLog).Create(database.LogFromLibrary(_log)).Error
+	if err != nil {
+		t.Errorf("unable to create test log for sqlite: %v", err)
+	}
+
+	// run test
+	got, err := _sqlite.GetLog(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("GetLog returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _log) {
+		t.Errorf("GetLog is %v, want %v", got, _log)
+	}
+
+	// the data is stored in the database without the blob storage
+	_without, err := New(
+		WithClient(_sqlite.client),
+		WithLogger(logrus.NewEntry(logrus.StandardLogger())),
+		WithSkipCreation(true),
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite log engine: %v", err)
+	}
+
+	_log.SetData([]byte("blob://logs/1/step/2"))
+
+	err = _without.UpdateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("UpdateLog returned err: %v", err)
+	}
+
+	got, err = _without.GetLog(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("GetLog without blob storage returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _log) {
+		t.Errorf("GetLog without blob storage is %v, want %v", got, _log)
+	}
+}
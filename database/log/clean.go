@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// CleanLogsForRepo deletes the logs and log lines for the builds
+// of the repo created before the provided timestamp from the database.
+func (e *engine) CleanLogsForRepo(ctx context.Context, r *library.Repo, before int64) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("cleaning logs for repo %s created before %d in the database", r.GetFullName(), before)
+
+	// subquery to capture the builds for the repo created before the timestamp
+	builds := e.client.
+		Table(constants.TableBuild).
+		Select("id").
+		Where("repo_id = ?", r.GetID()).
+		Where("created < ?", before)
+
+	// check if the blob storage is enabled
+	if e.blob != nil {
+		logs := new([]database.Log)
+
+		// send query to the database to capture the logs with data in the blob storage
+		err := e.client.WithContext(ctx).
+			Table(constants.TableLog).
+			Select("id", "build_id", "step_id", "service_id").
+			Where("build_id IN (?)", builds).
+			Find(logs).
+			Error
+		if err != nil {
+			return 0, err
+		}
+
+		for _, log := range *logs {
+			// https://golang.org/doc/faq#closures_and_goroutines
+			tmp := log
+
+			// remove log data for the resource from the blob storage
+			err = e.remove(ctx, &tmp)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	// send query to the database to remove the log lines
+	err := e.client.WithContext(ctx).
+		Table(TableLogLine).
+		Where("build_id IN (?)", builds).
+		Delete(new(types.LogLine)).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	// send query to the database to remove the logs
+	result := e.client.WithContext(ctx).
+		Table(constants.TableLog).
+		Where("build_id IN (?)", builds).
+		Delete(new(database.Log))
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestLog_Engine_CleanLogsForRepo(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	_logOne := testLog()
+	_logOne.SetID(1)
+	_logOne.SetRepoID(1)
+	_logOne.SetBuildID(1)
+	_logOne.SetStepID(1)
+
+	_logTwo := testLog()
+	_logTwo.SetID(2)
+	_logTwo.SetRepoID(1)
+	_logTwo.SetBuildID(2)
+	_logTwo.SetStepID(2)
+
+	_line := testLogLine()
+	_line.SetID(1)
+	_line.SetBuildID(1)
+	_line.SetLogID(1)
+	_line.SetNumber(1)
+	_line.SetStream("stdout")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectExec(`DELETE FROM "log_lines" WHERE build_id IN (SELECT id FROM "builds" WHERE repo_id = $1 AND created < $2)`).
+		WithArgs(1, 1563474080).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`DELETE FROM "logs" WHERE build_id IN (SELECT id FROM "builds" WHERE repo_id = $1 AND created < $2)`).
+		WithArgs(1, 1563474080).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// create a minimal builds table for the subquery
+	err := _sqlite.client.Exec(`CREATE TABLE IF NOT EXISTS builds (id INTEGER PRIMARY KEY, repo_id INTEGER, created INTEGER)`).Error
+	if err != nil {
+		t.Errorf("unable to create test builds table for sqlite: %v", err)
+	}
+
+	err = _sqlite.client.Exec(`INSERT INTO builds (id, repo_id, created) VALUES (1, 1, 1563474076), (2, 1, 1563474090)`).Error
+	if err != nil {
+		t.Errorf("unable to create test builds for sqlite: %v", err)
+	}
+
+	for _, log := range []*library.Log{_logOne, _logTwo} {
+		err = _sqlite.CreateLog(context.TODO(), log)
+		if err != nil {
+			t.Errorf("unable to create test log for sqlite: %v", err)
+		}
+	}
+
+	err = _sqlite.CreateLogLines(context.TODO(), []*api.LogLine{_line})
+	if err != nil {
+		t.Errorf("unable to create test log line for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CleanLogsForRepo(context.TODO(), _repo, 1563474080)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CleanLogsForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CleanLogsForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("CleanLogsForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
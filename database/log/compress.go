@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/go-vela/types/database"
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// CompressionZlib defines the algorithm for compressing log data with zlib.
+	CompressionZlib = "zlib"
+
+	// CompressionGzip defines the algorithm for compressing log data with gzip.
+	CompressionGzip = "gzip"
+
+	// CompressionZstd defines the algorithm for compressing log data with zstd.
+	CompressionZstd = "zstd"
+)
+
+var (
+	// gzipMagic represents the leading bytes of data compressed with gzip.
+	gzipMagic = []byte{0x1f, 0x8b}
+
+	// zstdMagic represents the leading bytes of data compressed with zstd.
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// compress is a helper function to compress the data for the
+// log with the compression algorithm configured for the engine.
+func (e *engine) compress(l *database.Log) error {
+	switch e.config.CompressionAlgorithm {
+	case CompressionGzip:
+		data, err := compressGzip(l.Data, e.config.CompressionLevel)
+		if err != nil {
+			return err
+		}
+
+		l.Data = data
+
+		return nil
+	case CompressionZstd:
+		data, err := compressZstd(l.Data, e.config.CompressionLevel)
+		if err != nil {
+			return err
+		}
+
+		l.Data = data
+
+		return nil
+	case CompressionZlib, "":
+		// https://pkg.go.dev/github.com/go-vela/types/database#Log.Compress
+		return l.Compress(e.config.CompressionLevel)
+	default:
+		return fmt.Errorf("unsupported log compression algorithm: %s", e.config.CompressionAlgorithm)
+	}
+}
+
+// decompress is a helper function to decompress the data for the log
+// with the compression algorithm detected from the leading bytes.
+//
+// This allows logs compressed with any algorithm to be read
+// regardless of the algorithm currently configured.
+func (e *engine) decompress(l *database.Log) error {
+	switch {
+	case bytes.HasPrefix(l.Data, gzipMagic):
+		r, err := gzip.NewReader(bytes.NewReader(l.Data))
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		l.Data = data
+
+		return nil
+	case bytes.HasPrefix(l.Data, zstdMagic):
+		d, err := zstd.NewReader(nil)
+		if err != nil {
+			return err
+		}
+		defer d.Close()
+
+		data, err := d.DecodeAll(l.Data, nil)
+		if err != nil {
+			return err
+		}
+
+		l.Data = data
+
+		return nil
+	default:
+		// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
+		return l.Decompress()
+	}
+}
+
+// compressGzip is a helper function to compress
+// the provided data with gzip at the provided level.
+func compressGzip(data []byte, level int) ([]byte, error) {
+	buffer := new(bytes.Buffer)
+
+	w, err := gzip.NewWriterLevel(buffer, level)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// compressZstd is a helper function to compress
+// the provided data with zstd at the provided level.
+//
+// The zlib levels are mapped to the closest zstd encoder
+// level with anything below one using the default level.
+func compressZstd(data []byte, level int) ([]byte, error) {
+	encoderLevel := zstd.SpeedDefault
+	if level > 0 {
+		encoderLevel = zstd.EncoderLevelFromZstd(level)
+	}
+
+	w, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(encoderLevel))
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	return w.EncodeAll(data, make([]byte, 0, len(data))), nil
+}
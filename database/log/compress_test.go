@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/database"
+)
+
+func TestLog_Engine_Compress(t *testing.T) {
+	// setup types
+	data := bytes.Repeat([]byte("hello from the build log\n"), 100)
+
+	// setup tests
+	tests := []struct {
+		failure   bool
+		name      string
+		algorithm string
+		level     int
+		prefix    []byte
+	}{
+		{
+			failure:   false,
+			name:      "default",
+			algorithm: "",
+			level:     3,
+		},
+		{
+			failure:   false,
+			name:      "zlib",
+			algorithm: CompressionZlib,
+			level:     3,
+		},
+		{
+			failure:   false,
+			name:      "gzip",
+			algorithm: CompressionGzip,
+			level:     3,
+			prefix:    gzipMagic,
+		},
+		{
+			failure:   false,
+			name:      "gzip with default level",
+			algorithm: CompressionGzip,
+			level:     -1,
+			prefix:    gzipMagic,
+		},
+		{
+			failure:   false,
+			name:      "zstd",
+			algorithm: CompressionZstd,
+			level:     3,
+			prefix:    zstdMagic,
+		},
+		{
+			failure:   false,
+			name:      "zstd with default level",
+			algorithm: CompressionZstd,
+			level:     0,
+			prefix:    zstdMagic,
+		},
+		{
+			failure:   true,
+			name:      "unsupported",
+			algorithm: "brotli",
+			level:     3,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &engine{config: &config{CompressionAlgorithm: test.algorithm, CompressionLevel: test.level}}
+
+			l := &database.Log{Data: data}
+
+			err := e.compress(l)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("compress for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("compress for %s returned err: %v", test.name, err)
+			}
+
+			if len(l.Data) >= len(data) {
+				t.Errorf("compress for %s is %d bytes, want less than %d", test.name, len(l.Data), len(data))
+			}
+
+			if len(test.prefix) > 0 && !bytes.HasPrefix(l.Data, test.prefix) {
+				t.Errorf("compress for %s is missing the %v prefix", test.name, test.prefix)
+			}
+
+			// decompress with a different algorithm configured
+			// to ensure the algorithm is detected from the data
+			err = (&engine{config: &config{CompressionAlgorithm: CompressionZstd}}).decompress(l)
+			if err != nil {
+				t.Errorf("decompress for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(l.Data, data) {
+				t.Errorf("decompress for %s is %s, want %s", test.name, l.Data, data)
+			}
+		})
+	}
+}
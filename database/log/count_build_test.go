@@ -5,6 +5,7 @@
 package log
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -43,12 +44,12 @@ func TestLog_Engine_CountLogsForBuild(t *testing.T) {
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateLog(_service)
+	err := _sqlite.CreateLog(context.TODO(), _service)
 	if err != nil {
 		t.Errorf("unable to create test service log for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateLog(_step)
+	err = _sqlite.CreateLog(context.TODO(), _step)
 	if err != nil {
 		t.Errorf("unable to create test step log for sqlite: %v", err)
 	}
@@ -77,7 +78,7 @@ func TestLog_Engine_CountLogsForBuild(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.CountLogsForBuild(_build)
+			got, err := test.database.CountLogsForBuild(context.TODO(), _build)
 
 			if test.failure {
 				if err == nil {
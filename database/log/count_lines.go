@@ -0,0 +1,28 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	"github.com/go-vela/types/library"
+)
+
+// CountLinesForLog gets the count of log lines by log ID from the database.
+func (e *engine) CountLinesForLog(ctx context.Context, l *library.Log) (int64, error) {
+	e.logger.Tracef("getting count of lines for log %d from the database", l.GetID())
+
+	// variable to store query results
+	var count int64
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableLogLine).
+		Where("log_id = ?", l.GetID()).
+		Count(&count).
+		Error
+
+	return count, err
+}
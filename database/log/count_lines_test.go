@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLog_Engine_CountLinesForLog(t *testing.T) {
+	// setup types
+	_log := testLog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+
+	_one := testLogLine()
+	_one.SetID(1)
+	_one.SetBuildID(1)
+	_one.SetLogID(1)
+	_one.SetNumber(1)
+	_one.SetStream("stdout")
+
+	_two := testLogLine()
+	_two.SetID(2)
+	_two.SetBuildID(1)
+	_two.SetLogID(1)
+	_two.SetNumber(2)
+	_two.SetStream("stderr")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT count(*) FROM "log_lines" WHERE log_id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLogLines(context.TODO(), []*api.LogLine{_one, _two})
+	if err != nil {
+		t.Errorf("unable to create test log lines for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     2,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CountLinesForLog(context.TODO(), _log)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CountLinesForLog for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CountLinesForLog for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CountLinesForLog for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
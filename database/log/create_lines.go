@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// CreateLogLines creates a list of new log lines in the database.
+func (e *engine) CreateLogLines(ctx context.Context, lines []*api.LogLine) error {
+	e.logger.Tracef("creating %d log lines in the database", len(lines))
+
+	// short-circuit if there are no lines to create
+	if len(lines) == 0 {
+		return nil
+	}
+
+	// variable to store the lines to create
+	l := make([]*types.LogLine, 0, len(lines))
+
+	for _, line := range lines {
+		// cast the API type to database type
+		tmp := types.LogLineFromAPI(line)
+
+		// validate the necessary fields are populated
+		err := tmp.Validate()
+		if err != nil {
+			return err
+		}
+
+		l = append(l, tmp)
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableLogLine).
+		Create(&l).
+		Error
+}
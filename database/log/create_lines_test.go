@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLog_Engine_CreateLogLines(t *testing.T) {
+	// setup types
+	_one := testLogLine()
+	_one.SetID(1)
+	_one.SetBuildID(1)
+	_one.SetLogID(1)
+	_one.SetNumber(1)
+	_one.SetStream("stdout")
+	_one.SetTimestamp(1563474077000)
+	_one.SetData("hello")
+
+	_two := testLogLine()
+	_two.SetID(2)
+	_two.SetBuildID(1)
+	_two.SetLogID(1)
+	_two.SetNumber(2)
+	_two.SetStream("stderr")
+	_two.SetTimestamp(1563474078000)
+	_two.SetData("world")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "log_lines"
+("build_id","log_id","number","stream","timestamp","data","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7),($8,$9,$10,$11,$12,$13,$14) RETURNING "id"`).
+		WithArgs(1, 1, 1, "stdout", 1563474077000, "hello", 1, 1, 1, 2, "stderr", 1563474078000, "world", 2).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		lines    []*api.LogLine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			lines:    []*api.LogLine{_one, _two},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			lines:    []*api.LogLine{_one, _two},
+		},
+		{
+			failure:  true,
+			name:     "sqlite3 invalid",
+			database: _sqlite,
+			lines:    []*api.LogLine{testLogLine()},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateLogLines(context.TODO(), test.lines)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateLogLines for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateLogLines for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+)
+
+// CreateLogs creates a list of new logs in the database with a single
+// query. The IDs from the created logs are populated in the provided logs.
+func (e *engine) CreateLogs(ctx context.Context, logs []*library.Log) error {
+	e.logger.Tracef("creating %d logs in the database", len(logs))
+
+	// short-circuit if there are no logs to create
+	if len(logs) == 0 {
+		return nil
+	}
+
+	// variable to store the logs to create
+	l := make([]*record, 0, len(logs))
+
+	for _, log := range logs {
+		// cast the library type to database type
+		//
+		// https://pkg.go.dev/github.com/go-vela/types/database#LogFromLibrary
+		tmp := &record{Log: *database.LogFromLibrary(log)}
+
+		// validate the necessary fields are populated
+		//
+		// https://pkg.go.dev/github.com/go-vela/types/database#Log.Validate
+		err := tmp.Validate()
+		if err != nil {
+			return err
+		}
+
+		// compress log data for the resource
+		err = e.compress(&tmp.Log)
+		if err != nil {
+			return fmt.Errorf("unable to compress log for build %d: %w", log.GetBuildID(), err)
+		}
+
+		// store log data for the resource in the blob storage
+		err = e.offload(ctx, tmp)
+		if err != nil {
+			return err
+		}
+
+		l = append(l, tmp)
+	}
+
+	// send query to the database
+	err := e.client.WithContext(ctx).
+		Table(constants.TableLog).
+		Create(&l).
+		Error
+	if err != nil {
+		return err
+	}
+
+	// populate the IDs from the created logs
+	for i, log := range l {
+		logs[i].SetID(log.ID.Int64)
+	}
+
+	return nil
+}
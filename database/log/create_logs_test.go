@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/library"
+)
+
+func TestLog_Engine_CreateLogs(t *testing.T) {
+	// setup types
+	_service := testLog()
+	_service.SetRepoID(1)
+	_service.SetBuildID(1)
+	_service.SetServiceID(1)
+
+	_step := testLog()
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+	_step.SetStepID(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "logs"
+("build_id","repo_id","service_id","step_id","data","object")
+VALUES ($1,$2,$3,$4,$5,$6),($7,$8,$9,$10,$11,$12) RETURNING "id"`).
+		WithArgs(1, 1, 1, nil, AnyArgument{}, nil, 1, 1, nil, 1, AnyArgument{}, nil).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		logs     func() []*library.Log
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			logs: func() []*library.Log {
+				return []*library.Log{copyLog(_service), copyLog(_step)}
+			},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			logs: func() []*library.Log {
+				return []*library.Log{copyLog(_service), copyLog(_step)}
+			},
+		},
+		{
+			failure:  true,
+			name:     "sqlite3 invalid",
+			database: _sqlite,
+			logs: func() []*library.Log {
+				return []*library.Log{testLog()}
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			logs := test.logs()
+
+			err := test.database.CreateLogs(context.TODO(), logs)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateLogs for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateLogs for %s returned err: %v", test.name, err)
+			}
+
+			for i, l := range logs {
+				if l.GetID() != int64(i+1) {
+					t.Errorf("CreateLogs for %s ID is %d, want %d", test.name, l.GetID(), i+1)
+				}
+			}
+		})
+	}
+}
+
+// copyLog is a test helper function to
+// create a copy of the provided log.
+func copyLog(l *library.Log) *library.Log {
+	tmp := *l
+
+	return &tmp
+}
@@ -5,6 +5,7 @@
 package log
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -33,16 +34,16 @@ func TestLog_Engine_CreateLog(t *testing.T) {
 
 	// ensure the mock expects the service query
 	_mock.ExpectQuery(`INSERT INTO "logs"
-("build_id","repo_id","service_id","step_id","data","id")
-VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`).
-		WithArgs(1, 1, 1, nil, AnyArgument{}, 1).
+("build_id","repo_id","service_id","step_id","data","object","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`).
+		WithArgs(1, 1, 1, nil, AnyArgument{}, nil, 1).
 		WillReturnRows(_rows)
 
 	// ensure the mock expects the step query
 	_mock.ExpectQuery(`INSERT INTO "logs"
-("build_id","repo_id","service_id","step_id","data","id")
-VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`).
-		WithArgs(1, 1, nil, 1, AnyArgument{}, 2).
+("build_id","repo_id","service_id","step_id","data","object","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`).
+		WithArgs(1, 1, nil, 1, AnyArgument{}, nil, 2).
 		WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
@@ -73,7 +74,7 @@ VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`).
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			for _, log := range test.logs {
-				err := test.database.CreateLog(log)
+				err := test.database.CreateLog(context.TODO(), log)
 
 				if test.failure {
 					if err == nil {
@@ -5,13 +5,15 @@
 package log
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // DeleteLog deletes an existing log from the database.
-func (e *engine) DeleteLog(l *library.Log) error {
+func (e *engine) DeleteLog(ctx context.Context, l *library.Log) error {
 	// check what the log entry is for
 	switch {
 	case l.GetServiceID() > 0:
@@ -26,8 +28,14 @@ func (e *engine) DeleteLog(l *library.Log) error {
 	log := database.LogFromLibrary(l)
 
 	// send query to the database
-	return e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableLog).
 		Delete(log).
 		Error
+	if err != nil {
+		return err
+	}
+
+	// remove log data for the resource from the blob storage
+	return e.remove(ctx, log)
 }
@@ -0,0 +1,24 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// DeleteLinesForLog deletes all log lines by log ID from the database.
+func (e *engine) DeleteLinesForLog(ctx context.Context, l *library.Log) error {
+	e.logger.Tracef("deleting lines for log %d from the database", l.GetID())
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableLogLine).
+		Where("log_id = ?", l.GetID()).
+		Delete(&types.LogLine{}).
+		Error
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLog_Engine_DeleteLinesForLog(t *testing.T) {
+	// setup types
+	_log := testLog()
+	_log.SetID(1)
+	_log.SetRepoID(1)
+	_log.SetBuildID(1)
+	_log.SetStepID(1)
+
+	_line := testLogLine()
+	_line.SetID(1)
+	_line.SetBuildID(1)
+	_line.SetLogID(1)
+	_line.SetNumber(1)
+	_line.SetStream("stdout")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "log_lines" WHERE log_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLogLines(context.TODO(), []*api.LogLine{_line})
+	if err != nil {
+		t.Errorf("unable to create test log line for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteLinesForLog(context.TODO(), _log)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteLinesForLog for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteLinesForLog for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -5,20 +5,21 @@
 package log
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // GetLog gets a log by ID from the database.
-func (e *engine) GetLog(id int64) (*library.Log, error) {
+func (e *engine) GetLog(ctx context.Context, id int64) (*library.Log, error) {
 	e.logger.Tracef("getting log %d from the database", id)
 
 	// variable to store query results
-	l := new(database.Log)
+	l := new(record)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableLog).
 		Where("id = ?", id).
 		Take(l).
@@ -27,10 +28,14 @@ func (e *engine) GetLog(id int64) (*library.Log, error) {
 		return nil, err
 	}
 
+	// retrieve log data from the blob storage
+	err = e.retrieve(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
 	// decompress log data
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
-	err = l.Decompress()
+	err = e.decompress(&l.Log)
 	if err != nil {
 		// ensures that the change is backwards compatible
 		// by logging the error instead of returning it
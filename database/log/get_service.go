@@ -6,20 +6,21 @@
 package log
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // GetLogForService gets a log by service ID from the database.
-func (e *engine) GetLogForService(s *library.Service) (*library.Log, error) {
+func (e *engine) GetLogForService(ctx context.Context, s *library.Service) (*library.Log, error) {
 	e.logger.Tracef("getting log for service %d for build %d from the database", s.GetID(), s.GetBuildID())
 
 	// variable to store query results
-	l := new(database.Log)
+	l := new(record)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableLog).
 		Where("service_id = ?", s.GetID()).
 		Take(l).
@@ -28,10 +29,14 @@ func (e *engine) GetLogForService(s *library.Service) (*library.Log, error) {
 		return nil, err
 	}
 
+	// retrieve log data from the blob storage
+	err = e.retrieve(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
 	// decompress log data for the service
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
-	err = l.Decompress()
+	err = e.decompress(&l.Log)
 	if err != nil {
 		// ensures that the change is backwards compatible
 		// by logging the error instead of returning it
@@ -6,20 +6,21 @@
 package log
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // GetLogForStep gets a log by step ID from the database.
-func (e *engine) GetLogForStep(s *library.Step) (*library.Log, error) {
+func (e *engine) GetLogForStep(ctx context.Context, s *library.Step) (*library.Log, error) {
 	e.logger.Tracef("getting log for step %d for build %d from the database", s.GetID(), s.GetBuildID())
 
 	// variable to store query results
-	l := new(database.Log)
+	l := new(record)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableLog).
 		Where("step_id = ?", s.GetID()).
 		Take(l).
@@ -28,10 +29,14 @@ func (e *engine) GetLogForStep(s *library.Step) (*library.Log, error) {
 		return nil, err
 	}
 
+	// retrieve log data from the blob storage
+	err = e.retrieve(ctx, l)
+	if err != nil {
+		return nil, err
+	}
+
 	// decompress log data for the step
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
-	err = l.Decompress()
+	err = e.decompress(&l.Log)
 	if err != nil {
 		// ensures that the change is backwards compatible
 		// by logging the error instead of returning it
@@ -4,6 +4,12 @@
 
 package log
 
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
 const (
 	// CreateBuildIDIndex represents a query to create an
 	// index on the logs table for the build_id column.
@@ -16,9 +22,14 @@ ON logs (build_id);
 )
 
 // CreateLogIndexes creates the indexes for the logs table in the database.
-func (e *engine) CreateLogIndexes() error {
+func (e *engine) CreateLogIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for logs table in the database")
 
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
 	// create the build_id column index for the logs table
-	return e.client.Exec(CreateBuildIDIndex).Error
+	return e.client.WithContext(ctx).Exec(CreateBuildIDIndex).Error
 }
@@ -0,0 +1,56 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// CreateLineBuildIDIndex represents a query to create an
+	// index on the log_lines table for the build_id column.
+	CreateLineBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_lines_build_id
+ON log_lines (build_id);
+`
+
+	// CreatePostgresLineSearchIndex represents a query to create a full-text
+	// search index on the log_lines table for the data column for Postgres.
+	CreatePostgresLineSearchIndex = `
+CREATE INDEX
+IF NOT EXISTS
+log_lines_search
+ON log_lines USING GIN (to_tsvector('simple', data));
+`
+)
+
+// CreateLogLineIndexes creates the indexes for the log_lines table in the database.
+func (e *engine) CreateLogLineIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for log_lines table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the build_id column index for the log_lines table
+	err := e.client.WithContext(ctx).Exec(CreateLineBuildIDIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// the full-text search index is only supported for Postgres
+	if e.client.Config.Dialector.Name() != constants.DriverPostgres {
+		return nil
+	}
+
+	// create the full-text search index for the log_lines table
+	return e.client.WithContext(ctx).Exec(CreatePostgresLineSearchIndex).Error
+}
@@ -5,22 +5,23 @@
 package log
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // ListLogs gets a list of all logs from the database.
-func (e *engine) ListLogs() ([]*library.Log, error) {
+func (e *engine) ListLogs(ctx context.Context) ([]*library.Log, error) {
 	e.logger.Trace("listing all logs from the database")
 
 	// variables to store query results and return value
 	count := int64(0)
-	l := new([]database.Log)
+	l := new([]record)
 	logs := []*library.Log{}
 
 	// count the results
-	count, err := e.CountLogs()
+	count, err := e.CountLogs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +32,7 @@ func (e *engine) ListLogs() ([]*library.Log, error) {
 	}
 
 	// send query to the database and store result in variable
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TableLog).
 		Find(&l).
 		Error
@@ -44,10 +45,14 @@ func (e *engine) ListLogs() ([]*library.Log, error) {
 		// https://golang.org/doc/faq#closures_and_goroutines
 		tmp := log
 
+		// retrieve log data from the blob storage
+		err = e.retrieve(ctx, &tmp)
+		if err != nil {
+			e.logger.Errorf("unable to retrieve logs: %v", err)
+		}
+
 		// decompress log data
-		//
-		// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
-		err = tmp.Decompress()
+		err = e.decompress(&tmp.Log)
 		if err != nil {
 			// ensures that the change is backwards compatible
 			// by logging the error instead of returning it
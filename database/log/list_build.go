@@ -5,22 +5,23 @@
 package log
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
-	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // ListLogsForBuild gets a list of logs by build ID from the database.
-func (e *engine) ListLogsForBuild(b *library.Build, page, perPage int) ([]*library.Log, int64, error) {
+func (e *engine) ListLogsForBuild(ctx context.Context, b *library.Build, page, perPage int) ([]*library.Log, int64, error) {
 	e.logger.Tracef("listing logs for build %d from the database", b.GetID())
 
 	// variables to store query results and return value
 	count := int64(0)
-	l := new([]database.Log)
+	l := new([]record)
 	logs := []*library.Log{}
 
 	// count the results
-	count, err := e.CountLogsForBuild(b)
+	count, err := e.CountLogsForBuild(ctx, b)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -34,7 +35,7 @@ func (e *engine) ListLogsForBuild(b *library.Build, page, perPage int) ([]*libra
 	offset := perPage * (page - 1)
 
 	// send query to the database and store result in variable
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TableLog).
 		Where("build_id = ?", b.GetID()).
 		Order("step_id ASC").
@@ -51,10 +52,14 @@ func (e *engine) ListLogsForBuild(b *library.Build, page, perPage int) ([]*libra
 		// https://golang.org/doc/faq#closures_and_goroutines
 		tmp := log
 
+		// retrieve log data from the blob storage
+		err = e.retrieve(ctx, &tmp)
+		if err != nil {
+			e.logger.Errorf("unable to retrieve logs for build %d: %v", b.GetID(), err)
+		}
+
 		// decompress log data for the build
-		//
-		// https://pkg.go.dev/github.com/go-vela/types/database#Log.Decompress
-		err = tmp.Decompress()
+		err = e.decompress(&tmp.Log)
 		if err != nil {
 			// ensures that the change is backwards compatible
 			// by logging the error instead of returning it
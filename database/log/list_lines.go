@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListLinesForLog gets a list of log lines by log ID from the database.
+func (e *engine) ListLinesForLog(ctx context.Context, l *library.Log, page, perPage int) ([]*api.LogLine, int64, error) {
+	e.logger.Tracef("listing lines for log %d from the database", l.GetID())
+
+	// variables to store query results and return value
+	count := int64(0)
+	ll := new([]types.LogLine)
+	lines := []*api.LogLine{}
+
+	// count the results
+	count, err := e.CountLinesForLog(ctx, l)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return lines, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = e.client.WithContext(ctx).
+		Table(TableLogLine).
+		Where("log_id = ?", l.GetID()).
+		Order("number ASC").
+		Limit(perPage).
+		Offset(offset).
+		Find(&ll).
+		Error
+	if err != nil {
+		return nil, count, err
+	}
+
+	// iterate through all query results
+	for _, line := range *ll {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := line
+
+		// convert query result to API type
+		lines = append(lines, tmp.ToAPI())
+	}
+
+	return lines, count, nil
+}
@@ -5,8 +5,10 @@
 package log
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/go-vela/server/blob"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 
@@ -16,6 +18,8 @@ import (
 type (
 	// config represents the settings required to create the engine that implements the LogService interface.
 	config struct {
+		// specifies the algorithm of compression to use for the Log engine
+		CompressionAlgorithm string
 		// specifies the level of compression to use for the Log engine
 		CompressionLevel int
 		// specifies to skip creating tables and indexes for the Log engine
@@ -32,6 +36,11 @@ type (
 		// https://pkg.go.dev/gorm.io/gorm#DB
 		client *gorm.DB
 
+		// blob storage service used to store the log data outside of the database
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/blob#Service
+		blob blob.Service
+
 		// sirupsen/logrus logger used in log functions
 		//
 		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
@@ -61,22 +70,34 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating log database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of logs table and indexes in the database")
+		e.logger.Trace("skipping creation of logs and log_lines tables and indexes in the database")
 
 		return e, nil
 	}
 
 	// create the logs table
-	err := e.CreateLogTable(e.client.Config.Dialector.Name())
+	err := e.CreateLogTable(context.Background(), e.client.Config.Dialector.Name())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create %s table: %w", constants.TableLog, err)
 	}
 
 	// create the indexes for the logs table
-	err = e.CreateLogIndexes()
+	err = e.CreateLogIndexes(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create indexes for %s table: %w", constants.TableLog, err)
 	}
 
+	// create the log_lines table
+	err = e.CreateLogLineTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableLogLine, err)
+	}
+
+	// create the indexes for the log_lines table
+	err = e.CreateLogLineIndexes(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create indexes for %s table: %w", TableLogLine, err)
+	}
+
 	return e, nil
 }
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 
@@ -30,6 +31,9 @@ func TestLog_New(t *testing.T) {
 
 	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresLineTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreateLineBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresLineSearchIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_config := &gorm.Config{SkipDefaultTransaction: true}
 
@@ -121,6 +125,9 @@ func testPostgres(t *testing.T) (*engine, sqlmock.Sqlmock) {
 
 	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresLineTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreateLineBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresLineSearchIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// create the new mock Postgres database client
 	//
@@ -164,6 +171,12 @@ func testSqlite(t *testing.T) *engine {
 		t.Errorf("unable to create new sqlite log engine: %v", err)
 	}
 
+	// add the column applied by the schema migration
+	err = AddObjectColumn(_sqlite)
+	if err != nil {
+		t.Errorf("unable to add object column to sqlite logs table: %v", err)
+	}
+
 	return _engine
 }
 
@@ -274,3 +287,17 @@ func testStep() *library.Step {
 		Distribution: new(string),
 	}
 }
+
+// testLogLine is a test helper function to create an API
+// LogLine type with all fields set to their zero values.
+func testLogLine() *api.LogLine {
+	return &api.LogLine{
+		ID:        new(int64),
+		BuildID:   new(int64),
+		LogID:     new(int64),
+		Number:    new(int),
+		Stream:    new(string),
+		Timestamp: new(int64),
+		Data:      new(string),
+	}
+}
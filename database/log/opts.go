@@ -5,6 +5,7 @@
 package log
 
 import (
+	"github.com/go-vela/server/blob"
 	"github.com/sirupsen/logrus"
 
 	"gorm.io/gorm"
@@ -13,6 +14,16 @@ import (
 // EngineOpt represents a configuration option to initialize the database engine for Logs.
 type EngineOpt func(*engine) error
 
+// WithBlob sets the blob storage service in the database engine for Logs.
+func WithBlob(service blob.Service) EngineOpt {
+	return func(e *engine) error {
+		// set the blob storage service in the log engine
+		e.blob = service
+
+		return nil
+	}
+}
+
 // WithClient sets the gorm.io/gorm client in the database engine for Logs.
 func WithClient(client *gorm.DB) EngineOpt {
 	return func(e *engine) error {
@@ -23,6 +34,16 @@ func WithClient(client *gorm.DB) EngineOpt {
 	}
 }
 
+// WithCompressionAlgorithm sets the compression algorithm in the database engine for Logs.
+func WithCompressionAlgorithm(algorithm string) EngineOpt {
+	return func(e *engine) error {
+		// set the compression algorithm in the log engine
+		e.config.CompressionAlgorithm = algorithm
+
+		return nil
+	}
+}
+
 // WithCompressionLevel sets the compression level in the database engine for Logs.
 func WithCompressionLevel(level int) EngineOpt {
 	return func(e *engine) error {
@@ -8,11 +8,63 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/go-vela/server/blob"
 	"github.com/sirupsen/logrus"
 
 	"gorm.io/gorm"
 )
 
+func TestLog_EngineOpt_WithBlob(t *testing.T) {
+	// setup types
+	e := &engine{config: new(config)}
+
+	_blob := newTestBlob()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		blob    blob.Service
+		want    blob.Service
+	}{
+		{
+			failure: false,
+			name:    "blob set to new service",
+			blob:    _blob,
+			want:    _blob,
+		},
+		{
+			failure: false,
+			name:    "blob set to nil",
+			blob:    nil,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithBlob(test.blob)(e)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("WithBlob for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WithBlob returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(e.blob, test.want) {
+				t.Errorf("WithBlob is %v, want %v", e.blob, test.want)
+			}
+		})
+	}
+}
+
 func TestLog_EngineOpt_WithClient(t *testing.T) {
 	// setup types
 	e := &engine{client: new(gorm.DB)}
@@ -62,6 +114,61 @@ func TestLog_EngineOpt_WithClient(t *testing.T) {
 	}
 }
 
+func TestLog_EngineOpt_WithCompressionAlgorithm(t *testing.T) {
+	// setup types
+	e := &engine{config: new(config)}
+
+	// setup tests
+	tests := []struct {
+		failure   bool
+		name      string
+		algorithm string
+		want      string
+	}{
+		{
+			failure:   false,
+			name:      "compression algorithm set to zlib",
+			algorithm: CompressionZlib,
+			want:      CompressionZlib,
+		},
+		{
+			failure:   false,
+			name:      "compression algorithm set to gzip",
+			algorithm: CompressionGzip,
+			want:      CompressionGzip,
+		},
+		{
+			failure:   false,
+			name:      "compression algorithm set to zstd",
+			algorithm: CompressionZstd,
+			want:      CompressionZstd,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithCompressionAlgorithm(test.algorithm)(e)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("WithCompressionAlgorithm for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WithCompressionAlgorithm returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(e.config.CompressionAlgorithm, test.want) {
+				t.Errorf("WithCompressionAlgorithm is %v, want %v", e.config.CompressionAlgorithm, test.want)
+			}
+		})
+	}
+}
+
 func TestLog_EngineOpt_WithCompressionLevel(t *testing.T) {
 	// setup types
 	e := &engine{config: new(config)}
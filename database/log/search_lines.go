@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// SearchLinesForBuild gets a list of log lines by build ID matching the search query from the database.
+func (e *engine) SearchLinesForBuild(ctx context.Context, b *library.Build, query string, limit int) ([]*api.LogLine, error) {
+	e.logger.Tracef("searching lines for build %d in the database", b.GetID())
+
+	// variables to store query results and return value
+	ll := new([]types.LogLine)
+	lines := []*api.LogLine{}
+
+	// use the full-text search index for Postgres and fall back to pattern matching otherwise
+	match, arg := "data LIKE ?", "%"+query+"%"
+	if e.client.Config.Dialector.Name() == constants.DriverPostgres {
+		match, arg = "to_tsvector('simple', data) @@ plainto_tsquery('simple', ?)", query
+	}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableLogLine).
+		Where("build_id = ?", b.GetID()).
+		Where(match, arg).
+		Order("log_id ASC").
+		Order("number ASC").
+		Limit(limit).
+		Find(&ll).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, line := range *ll {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := line
+
+		// convert query result to API type
+		lines = append(lines, tmp.ToAPI())
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLog_Engine_SearchLinesForBuild(t *testing.T) {
+	// setup types
+	_build := testBuild()
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+
+	_one := testLogLine()
+	_one.SetID(1)
+	_one.SetBuildID(1)
+	_one.SetLogID(1)
+	_one.SetNumber(1)
+	_one.SetStream("stdout")
+	_one.SetTimestamp(1563474077000)
+	_one.SetData("hello world")
+
+	_two := testLogLine()
+	_two.SetID(2)
+	_two.SetBuildID(1)
+	_two.SetLogID(1)
+	_two.SetNumber(2)
+	_two.SetStream("stderr")
+	_two.SetTimestamp(1563474078000)
+	_two.SetData("goodbye")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "build_id", "log_id", "number", "stream", "timestamp", "data"}).
+		AddRow(1, 1, 1, 1, "stdout", 1563474077000, "hello world")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "log_lines" WHERE build_id = $1 AND to_tsvector('simple', data) @@ plainto_tsquery('simple', $2) ORDER BY log_id ASC,number ASC LIMIT 10`).
+		WithArgs(1, "hello").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateLogLines(context.TODO(), []*api.LogLine{_one, _two})
+	if err != nil {
+		t.Errorf("unable to create test log lines for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.LogLine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.LogLine{_one},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.LogLine{_one},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.SearchLinesForBuild(context.TODO(), _build, "hello", 10)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("SearchLinesForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("SearchLinesForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("SearchLinesForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
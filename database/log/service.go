@@ -5,6 +5,9 @@
 package log
 
 import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/library"
 )
 
@@ -18,32 +21,50 @@ type LogService interface {
 	// https://en.wikipedia.org/wiki/Data_definition_language
 
 	// CreateLogIndexes defines a function that creates the indexes for the logs table.
-	CreateLogIndexes() error
+	CreateLogIndexes(context.Context) error
 	// CreateLogTable defines a function that creates the logs table.
-	CreateLogTable(string) error
+	CreateLogTable(context.Context, string) error
+	// CreateLogLineIndexes defines a function that creates the indexes for the log_lines table.
+	CreateLogLineIndexes(context.Context) error
+	// CreateLogLineTable defines a function that creates the log_lines table.
+	CreateLogLineTable(context.Context, string) error
 
 	// Log Data Manipulation Language Functions
 	//
 	// https://en.wikipedia.org/wiki/Data_manipulation_language
 
+	// CleanLogsForRepo defines a function that deletes logs and log lines by repo ID for builds created before a timestamp.
+	CleanLogsForRepo(context.Context, *library.Repo, int64) (int64, error)
 	// CountLogs defines a function that gets the count of all logs.
-	CountLogs() (int64, error)
+	CountLogs(context.Context) (int64, error)
 	// CountLogsForBuild defines a function that gets the count of logs by build ID.
-	CountLogsForBuild(*library.Build) (int64, error)
+	CountLogsForBuild(context.Context, *library.Build) (int64, error)
+	// CountLinesForLog defines a function that gets the count of log lines by log ID.
+	CountLinesForLog(context.Context, *library.Log) (int64, error)
 	// CreateLog defines a function that creates a new log.
-	CreateLog(*library.Log) error
+	CreateLog(context.Context, *library.Log) error
+	// CreateLogLines defines a function that creates a list of new log lines.
+	CreateLogLines(context.Context, []*api.LogLine) error
+	// CreateLogs defines a function that creates a list of new logs.
+	CreateLogs(context.Context, []*library.Log) error
 	// DeleteLog defines a function that deletes an existing log.
-	DeleteLog(*library.Log) error
+	DeleteLog(context.Context, *library.Log) error
+	// DeleteLinesForLog defines a function that deletes all log lines by log ID.
+	DeleteLinesForLog(context.Context, *library.Log) error
 	// GetLog defines a function that gets a log by ID.
-	GetLog(int64) (*library.Log, error)
+	GetLog(context.Context, int64) (*library.Log, error)
 	// GetLogForService defines a function that gets a log by service ID.
-	GetLogForService(*library.Service) (*library.Log, error)
+	GetLogForService(context.Context, *library.Service) (*library.Log, error)
 	// GetLogForStep defines a function that gets a log by step ID.
-	GetLogForStep(*library.Step) (*library.Log, error)
+	GetLogForStep(context.Context, *library.Step) (*library.Log, error)
 	// ListLogs defines a function that gets a list of all logs.
-	ListLogs() ([]*library.Log, error)
+	ListLogs(context.Context) ([]*library.Log, error)
 	// ListLogsForBuild defines a function that gets a list of logs by build ID.
-	ListLogsForBuild(*library.Build, int, int) ([]*library.Log, int64, error)
+	ListLogsForBuild(context.Context, *library.Build, int, int) ([]*library.Log, int64, error)
+	// ListLinesForLog defines a function that gets a list of log lines by log ID.
+	ListLinesForLog(context.Context, *library.Log, int, int) ([]*api.LogLine, int64, error)
+	// SearchLinesForBuild defines a function that gets a list of log lines by build ID matching a search query.
+	SearchLinesForBuild(context.Context, *library.Build, string, int) ([]*api.LogLine, error)
 	// UpdateLog defines a function that updates an existing log.
-	UpdateLog(*library.Log) error
+	UpdateLog(context.Context, *library.Log) error
 }
@@ -5,7 +5,13 @@
 package log
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/types/constants"
+
+	"gorm.io/gorm"
 )
 
 const (
@@ -39,22 +45,57 @@ logs (
 	UNIQUE(step_id),
 	UNIQUE(service_id)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL logs table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+logs (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	service_id    INTEGER,
+	step_id       INTEGER,
+	data          LONGBLOB,
+	UNIQUE(step_id),
+	UNIQUE(service_id),
+	INDEX logs_build_id (build_id)
+);
 `
 )
 
 // CreateLogTable creates the logs table in the database.
-func (e *engine) CreateLogTable(driver string) error {
+func (e *engine) CreateLogTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating logs table in the database")
 
 	// handle the driver provided to create the table
 	switch driver {
 	case constants.DriverPostgres:
 		// create the logs table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the logs table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the logs table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
 	}
 }
+
+// AddObjectColumn adds the object column storing the key for
+// the object in the blob storage holding the data for a log to
+// the logs table in the database. The column is added by a schema
+// migration so it is added to the existing table. The table is
+// skipped when it doesn't exist or already has the column so the
+// function can be safely applied again.
+func AddObjectColumn(tx *gorm.DB) error {
+	// check if the table is missing or the column already exists
+	if !tx.Migrator().HasTable(constants.TableLog) || tx.Migrator().HasColumn(constants.TableLog, "object") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN object VARCHAR(250)", constants.TableLog)).Error
+}
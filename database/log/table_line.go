@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package log
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableLogLine represents the name of the table for log lines.
+	TableLogLine = "log_lines"
+
+	// CreatePostgresLineTable represents a query to create the Postgres log_lines table.
+	CreatePostgresLineTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_lines (
+	id            SERIAL PRIMARY KEY,
+	build_id      INTEGER,
+	log_id        INTEGER,
+	number        INTEGER,
+	stream        VARCHAR(20),
+	timestamp     BIGINT,
+	data          TEXT,
+	UNIQUE(log_id, number)
+);
+`
+
+	// CreateSqliteLineTable represents a query to create the Sqlite log_lines table.
+	CreateSqliteLineTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_lines (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	log_id        INTEGER,
+	number        INTEGER,
+	stream        TEXT,
+	timestamp     INTEGER,
+	data          TEXT,
+	UNIQUE(log_id, number)
+);
+`
+
+	// CreateMySQLLineTable represents a query to create the MySQL log_lines table.
+	CreateMySQLLineTable = `
+CREATE TABLE
+IF NOT EXISTS
+log_lines (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id      INTEGER,
+	log_id        INTEGER,
+	number        INTEGER,
+	stream        VARCHAR(20),
+	timestamp     BIGINT,
+	data          TEXT,
+	UNIQUE(log_id, number),
+	INDEX log_lines_build_id (build_id)
+);
+`
+)
+
+// CreateLogLineTable creates the log_lines table in the database.
+func (e *engine) CreateLogLineTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating log_lines table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the log_lines table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresLineTable).Error
+	case types.DriverMySQL:
+		// create the log_lines table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLLineTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the log_lines table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteLineTable).Error
+	}
+}
@@ -5,9 +5,14 @@
 package log
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/constants"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 )
 
 func TestLog_Engine_CreateLogTable(t *testing.T) {
@@ -41,7 +46,7 @@ func TestLog_Engine_CreateLogTable(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := test.database.CreateLogTable(test.name)
+			err := test.database.CreateLogTable(context.TODO(), test.name)
 
 			if test.failure {
 				if err == nil {
@@ -57,3 +62,38 @@ func TestLog_Engine_CreateLogTable(t *testing.T) {
 		})
 	}
 }
+
+func TestLog_AddObjectColumn(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create logs table: %v", err)
+	}
+
+	// run test
+	if _sqlite.Migrator().HasColumn(constants.TableLog, "object") {
+		t.Errorf("logs table already has column object")
+	}
+
+	// the existing column is skipped when applied again
+	for i := 0; i < 2; i++ {
+		err = AddObjectColumn(_sqlite)
+		if err != nil {
+			t.Errorf("AddObjectColumn returned err: %v", err)
+		}
+	}
+
+	if !_sqlite.Migrator().HasColumn(constants.TableLog, "object") {
+		t.Errorf("AddObjectColumn did not add column object to logs table")
+	}
+}
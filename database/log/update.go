@@ -6,6 +6,7 @@
 package log
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-vela/types/constants"
@@ -14,7 +15,7 @@ import (
 )
 
 // UpdateLog updates an existing log in the database.
-func (e *engine) UpdateLog(l *library.Log) error {
+func (e *engine) UpdateLog(ctx context.Context, l *library.Log) error {
 	// check what the log entry is for
 	switch {
 	case l.GetServiceID() > 0:
@@ -26,7 +27,7 @@ func (e *engine) UpdateLog(l *library.Log) error {
 	// cast the library type to database type
 	//
 	// https://pkg.go.dev/github.com/go-vela/types/database#LogFromLibrary
-	log := database.LogFromLibrary(l)
+	log := &record{Log: *database.LogFromLibrary(l)}
 
 	// validate the necessary fields are populated
 	//
@@ -37,9 +38,7 @@ func (e *engine) UpdateLog(l *library.Log) error {
 	}
 
 	// compress log data for the resource
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#Log.Compress
-	err = log.Compress(e.config.CompressionLevel)
+	err = e.compress(&log.Log)
 	if err != nil {
 		switch {
 		case l.GetServiceID() > 0:
@@ -49,8 +48,14 @@ func (e *engine) UpdateLog(l *library.Log) error {
 		}
 	}
 
+	// store log data for the resource in the blob storage
+	err = e.offload(ctx, log)
+	if err != nil {
+		return err
+	}
+
 	// send query to the database
-	return e.client.
+	return e.client.WithContext(ctx).
 		Table(constants.TableLog).
 		Save(log).
 		Error
@@ -5,6 +5,7 @@
 package log
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -32,27 +33,27 @@ func TestLog_Engine_UpdateLog(t *testing.T) {
 
 	// ensure the mock expects the service query
 	_mock.ExpectExec(`UPDATE "logs"
-SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5
-WHERE "id" = $6`).
-		WithArgs(1, 1, 1, nil, AnyArgument{}, 1).
+SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5,"object"=$6
+WHERE "id" = $7`).
+		WithArgs(1, 1, 1, nil, AnyArgument{}, nil, 1).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// ensure the mock expects the step query
 	_mock.ExpectExec(`UPDATE "logs"
-SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5
-WHERE "id" = $6`).
-		WithArgs(1, 1, nil, 1, AnyArgument{}, 2).
+SET "build_id"=$1,"repo_id"=$2,"service_id"=$3,"step_id"=$4,"data"=$5,"object"=$6
+WHERE "id" = $7`).
+		WithArgs(1, 1, nil, 1, AnyArgument{}, nil, 2).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateLog(_service)
+	err := _sqlite.CreateLog(context.TODO(), _service)
 	if err != nil {
 		t.Errorf("unable to create test service log for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateLog(_step)
+	err = _sqlite.CreateLog(context.TODO(), _step)
 	if err != nil {
 		t.Errorf("unable to create test step log for sqlite: %v", err)
 	}
@@ -82,7 +83,7 @@ WHERE "id" = $6`).
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			for _, log := range test.logs {
-				err = test.database.UpdateLog(log)
+				err = test.database.UpdateLog(context.TODO(), log)
 
 				if test.failure {
 					if err == nil {
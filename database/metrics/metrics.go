@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package metrics provides the ability to measure how long
+// each statement sent to the database takes to run and to
+// log the statements slower than a configured threshold.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/database/metrics"
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// startKey represents the key for storing
+// the time the statement was started.
+const startKey = "metrics:start"
+
+// queryDuration represents the time spent running
+// statements for each table and operation.
+var queryDuration = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "vela_database_query_duration_seconds",
+		Help:    "The time spent running statements against the database by table and operation.",
+		Buckets: []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	},
+	[]string{"table", "operation"},
+)
+
+// Register registers callbacks with the provided database client to
+// observe the duration of each statement by table and operation. The
+// statements that run longer than the provided threshold are logged
+// with their duration.
+//
+// A threshold of 0 disables logging slow statements.
+func Register(db *gorm.DB, threshold time.Duration) error {
+	// start the timer for the statement
+	start := func(tx *gorm.DB) {
+		tx.InstanceSet(startKey, time.Now())
+	}
+
+	// stop the timer for the statement
+	stop := func(operation string) func(*gorm.DB) {
+		return func(tx *gorm.DB) {
+			value, ok := tx.InstanceGet(startKey)
+			if !ok {
+				return
+			}
+
+			started, ok := value.(time.Time)
+			if !ok {
+				return
+			}
+
+			duration := time.Since(started)
+
+			table := tx.Statement.Table
+			if len(table) == 0 {
+				table = "unknown"
+			}
+
+			queryDuration.WithLabelValues(table, operation).Observe(duration.Seconds())
+
+			// check if the statement is slower than the threshold
+			if threshold > 0 && duration >= threshold {
+				// the statement is logged without the values bound
+				// to it to avoid leaking sensitive data to the logs
+				logrus.WithFields(logrus.Fields{
+					"table":     table,
+					"operation": operation,
+					"duration":  duration.String(),
+				}).Warnf("slow database statement: %s", tx.Statement.SQL.String())
+			}
+		}
+	}
+
+	err := db.Callback().Create().Before("gorm:create").Register("metrics:start_create", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Create().After("gorm:create").Register("metrics:stop_create", stop("create"))
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Query().Before("gorm:query").Register("metrics:start_query", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Query().After("gorm:query").Register("metrics:stop_query", stop("query"))
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Row().Before("gorm:row").Register("metrics:start_row", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Row().After("gorm:row").Register("metrics:stop_row", stop("row"))
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Update().Before("gorm:update").Register("metrics:start_update", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Update().After("gorm:update").Register("metrics:stop_update", stop("update"))
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Delete().Before("gorm:delete").Register("metrics:start_delete", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Delete().After("gorm:delete").Register("metrics:stop_delete", stop("delete"))
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Raw().Before("gorm:raw").Register("metrics:start_raw", start)
+	if err != nil {
+		return err
+	}
+
+	return db.Callback().Raw().After("gorm:raw").Register("metrics:stop_raw", stop("raw"))
+}
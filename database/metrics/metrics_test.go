@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus/hooks/test"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestMetrics_Register(t *testing.T) {
+	// setup types
+	hook := test.NewGlobal()
+
+	// setup tests
+	tests := []struct {
+		name      string
+		threshold time.Duration
+		slow      bool
+	}{
+		{
+			name:      "with threshold",
+			threshold: time.Nanosecond,
+			slow:      true,
+		},
+		{
+			name:      "without threshold",
+			threshold: 0,
+			slow:      false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer hook.Reset()
+
+			db, err := gorm.Open(
+				sqlite.Open("file::memory:"),
+				&gorm.Config{SkipDefaultTransaction: true},
+			)
+			if err != nil {
+				t.Errorf("unable to create new sqlite database: %v", err)
+			}
+
+			err = Register(db, test.threshold)
+			if err != nil {
+				t.Errorf("Register returned err: %v", err)
+			}
+
+			err = db.Exec("CREATE TABLE IF NOT EXISTS widgets (id INTEGER)").Error
+			if err != nil {
+				t.Errorf("Exec returned err: %v", err)
+			}
+
+			var count int64
+
+			err = db.Table("widgets").Count(&count).Error
+			if err != nil {
+				t.Errorf("Count returned err: %v", err)
+			}
+
+			got := testutil.CollectAndCount(queryDuration)
+			if got < 2 {
+				t.Errorf("Register observed %d table and operation pairs, want at least %d", got, 2)
+			}
+
+			slow := false
+
+			for _, entry := range hook.AllEntries() {
+				if strings.HasPrefix(entry.Message, "slow database statement") {
+					slow = true
+
+					if entry.Data["table"] == nil || entry.Data["duration"] == nil {
+						t.Errorf("Register logged slow statement without table and duration: %v", entry.Data)
+					}
+				}
+			}
+
+			if slow != test.slow {
+				t.Errorf("Register logged slow statement is %v, want %v", slow, test.slow)
+			}
+		})
+	}
+}
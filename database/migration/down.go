@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// MigrateDown rolls back the applied schema migrations with a version
+// after the provided version, in the reverse order of their version,
+// in the database. A version of 0 rolls back every applied migration.
+//
+// Each migration is rolled back and removed in a single transaction.
+// Rolling back stops at the first migration that can't be rolled back.
+func (e *engine) MigrateDown(ctx context.Context, version int64) ([]*api.SchemaMigration, error) {
+	e.logger.WithFields(logrus.Fields{
+		"version": version,
+	}).Trace("rolling back schema migrations in the database")
+
+	// capture the applied migrations
+	applied, err := e.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	driver := e.client.Config.Dialector.Name()
+
+	// variable to store the migrations rolled back
+	migrations := []*api.SchemaMigration{}
+
+	for i := len(e.migrations) - 1; i >= 0; i-- {
+		m := e.migrations[i]
+
+		if m.Version <= version {
+			break
+		}
+
+		record, ok := applied[m.Version]
+		if !ok {
+			continue
+		}
+
+		e.logger.Infof("rolling back schema migration %d %s", m.Version, m.Name)
+
+		// send queries to the database in a transaction
+		err = e.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			err := m.Down(tx, driver)
+			if err != nil {
+				return err
+			}
+
+			return tx.Table(TableMigration).Delete(record).Error
+		})
+		if err != nil {
+			return migrations, fmt.Errorf("unable to roll back migration %d %s: %w", m.Version, m.Name, err)
+		}
+
+		migrations = append(migrations, record.ToAPI())
+	}
+
+	return migrations, nil
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestMigration_Engine_MigrateDown(t *testing.T) {
+	// setup types
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"version", "name", "applied"}).
+		AddRow(1, "baseline", 1).
+		AddRow(2, "widgets", 1)
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery(`SELECT * FROM "schema_migrations" ORDER BY version`).WillReturnRows(_rows)
+	_mock.ExpectBegin()
+	_mock.ExpectExec(DropWidgetTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`DELETE FROM "schema_migrations" WHERE "schema_migrations"."version" = $1`).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_, err := _sqlite.MigrateUp(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("unable to apply test migrations for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		version  int64
+		want     []int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			version:  1,
+			want:     []int64{2},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			version:  1,
+			want:     []int64{2},
+		},
+		{
+			failure:  true,
+			name:     "sqlite3 irreversible baseline",
+			database: _sqlite,
+			version:  0,
+			want:     []int64{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.MigrateDown(context.TODO(), test.version)
+
+			if test.failure {
+				if !errors.Is(err, ErrIrreversible) {
+					t.Errorf("MigrateDown for %s returned err %v, want %v", test.name, err, ErrIrreversible)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("MigrateDown for %s returned err: %v", test.name, err)
+			}
+
+			if len(got) != len(test.want) {
+				t.Fatalf("MigrateDown for %s rolled back %d migrations, want %d", test.name, len(got), len(test.want))
+			}
+
+			for i, m := range got {
+				if m.GetVersion() != test.want[i] {
+					t.Errorf("MigrateDown for %s rolled back %v, want version %d", test.name, m, test.want[i])
+				}
+			}
+		})
+	}
+
+	// the widgets table was dropped by the migration for sqlite
+	if _sqlite.client.Migrator().HasTable("widgets") {
+		t.Errorf("MigrateDown for sqlite3 did not drop the widgets table")
+	}
+}
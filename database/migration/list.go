@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+	"sort"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/replica"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListMigrations gets a list of all schema migrations from the database.
+//
+// The list includes the pending migrations, which have no applied
+// timestamp, and any applied migration unknown to this version of
+// the server, like one applied by a newer version of the server.
+func (e *engine) ListMigrations(ctx context.Context) ([]*api.SchemaMigration, error) {
+	e.logger.Trace("listing all schema migrations from the database")
+
+	// capture the applied migrations
+	applied, err := e.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	// variable to store the migrations
+	migrations := []*api.SchemaMigration{}
+
+	for _, m := range e.migrations {
+		migration := new(api.SchemaMigration)
+		migration.SetVersion(m.Version)
+		migration.SetName(m.Name)
+
+		if record, ok := applied[m.Version]; ok {
+			migration.SetApplied(record.Applied.Int64)
+
+			delete(applied, m.Version)
+		}
+
+		migrations = append(migrations, migration)
+	}
+
+	// add the applied migrations this server doesn't know about
+	for _, record := range applied {
+		migrations = append(migrations, record.ToAPI())
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].GetVersion() < migrations[j].GetVersion()
+	})
+
+	return migrations, nil
+}
+
+// applied is a helper function to capture the applied
+// migrations from the database by their version.
+func (e *engine) applied() (map[int64]*types.SchemaMigration, error) {
+	// variable to store query results
+	m := new([]types.SchemaMigration)
+
+	// send query to the primary database and store result in variable
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Primary
+	err := e.client.
+		WithContext(replica.Primary(context.Background())).
+		Table(TableMigration).
+		Order("version").
+		Find(&m).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]*types.SchemaMigration)
+
+	// iterate through all query results
+	for _, migration := range *m {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := migration
+
+		applied[tmp.Version.Int64] = &tmp
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestMigration_Engine_ListMigrations(t *testing.T) {
+	// setup types
+	_baseline := new(api.SchemaMigration)
+	_baseline.SetVersion(1)
+	_baseline.SetName("baseline")
+	_baseline.SetApplied(1)
+
+	_widgets := new(api.SchemaMigration)
+	_widgets.SetVersion(2)
+	_widgets.SetName("widgets")
+
+	_unknown := new(api.SchemaMigration)
+	_unknown.SetVersion(3)
+	_unknown.SetName("gadgets")
+	_unknown.SetApplied(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"version", "name", "applied"}).
+		AddRow(1, "baseline", 1).
+		AddRow(3, "gadgets", 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "schema_migrations" ORDER BY version`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.client.Table(TableMigration).Create(map[string]interface{}{"version": 1, "name": "baseline", "applied": 1}).Error
+	if err != nil {
+		t.Errorf("unable to create test migration for sqlite: %v", err)
+	}
+
+	err = _sqlite.client.Table(TableMigration).Create(map[string]interface{}{"version": 3, "name": "gadgets", "applied": 2}).Error
+	if err != nil {
+		t.Errorf("unable to create test migration for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.SchemaMigration
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.SchemaMigration{_baseline, _widgets, _unknown},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.SchemaMigration{_baseline, _widgets, _unknown},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListMigrations(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListMigrations for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListMigrations for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListMigrations for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
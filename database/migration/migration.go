@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the MigrationService interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the Migration engine
+		SkipCreation bool
+	}
+
+	// engine represents the schema migration functionality that implements the MigrationService interface.
+	engine struct {
+		// engine configuration settings used in migration functions
+		config *config
+
+		// gorm.io/gorm database client used in migration functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in migration functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+
+		// creates the tables and indexes for every resource for the baseline migration
+		baseline func(*gorm.DB, string) error
+
+		// ordered list of versioned migrations applied by the engine
+		migrations []*Migration
+	}
+)
+
+// New creates and returns a Vela service for integrating with schema migrations in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new Migration engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if the migrations were provided
+	if e.migrations == nil {
+		e.migrations = registered(e.baseline)
+	}
+
+	// verify the migrations are ordered by a unique version
+	err := validate(e.migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if we should skip creating migration database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of schema_migrations table in the database")
+
+		return e, nil
+	}
+
+	// create the schema_migrations table
+	err = e.CreateMigrationTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableMigration, err)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-vela/server/database/freezeaudit"
+	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/postgres/ddl"
+	"github.com/go-vela/server/database/postgres/dml"
+	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/schedule"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+
+	"gorm.io/gorm"
+)
+
+// ErrIrreversible defines the error type when rolling
+// back a migration that rejects being rolled back.
+var ErrIrreversible = errors.New("migration can not be rolled back")
+
+// Migration represents a versioned change to the schema of the
+// database. The up and down functions are provided a transaction
+// and the driver for the database so a migration can handle the
+// differences between the supported databases.
+type Migration struct {
+	// unique version for the migration, migrations are applied in order of version
+	Version int64
+	// short name describing the change made by the migration
+	Name string
+	// applies the change to the database
+	Up func(tx *gorm.DB, driver string) error
+	// reverts the change to the database, use irreversible to reject rolling back the change
+	Down func(tx *gorm.DB, driver string) error
+	// applies the change outside of a transaction, required for changes that can't
+	// be made in a transaction like creating an index concurrently for Postgres, so
+	// the up function must be safe to apply again after a failure
+	NoTransaction bool
+}
+
+// registered returns the ordered list of versioned
+// migrations for the schema of the database.
+//
+// The baseline migration creates the tables and indexes for every
+// resource with the provided function. A change to the schema must
+// be added to the end of this list with the next version instead of
+// editing the queries that create the tables, so the change is applied
+// to databases that already have the tables. This includes creating
+// a new table, since the baseline isn't applied again.
+func registered(baseline func(*gorm.DB, string) error) []*Migration {
+	return []*Migration{
+		{
+			Version: 1,
+			Name:    "baseline",
+			Up:      baseline,
+			// dropping the tables would delete every resource
+			Down: irreversible,
+			// the indexes for the builds table are created concurrently for Postgres
+			NoTransaction: true,
+		},
+		{
+			Version: 2,
+			Name:    "freeze audit client",
+			Up: func(tx *gorm.DB, _ string) error {
+				return freezeaudit.AddClientColumns(tx)
+			},
+			Down: func(tx *gorm.DB, _ string) error {
+				return freezeaudit.DropClientColumns(tx)
+			},
+		},
+		{
+			Version: 3,
+			Name:    "log blob objects",
+			Up: func(tx *gorm.DB, _ string) error {
+				return log.AddObjectColumn(tx)
+			},
+			// dropping the column would lose the logs stored in blob storage
+			Down: irreversible,
+		},
+		{
+			Version: 4,
+			Name:    "partition builds and logs",
+			Up: func(_ *gorm.DB, _ string) error {
+				// partitioning is opt-in with the partition.enabled flag, so
+				// the tables are converted by the partitioner instead
+				return nil
+			},
+			Down: func(_ *gorm.DB, _ string) error {
+				return nil
+			},
+		},
+		{
+			Version: 5,
+			Name:    "claim build numbers",
+			Up: func(tx *gorm.DB, driver string) error {
+				// the numbers are only claimed for Postgres, the only
+				// database where the builds table can be partitioned
+				if driver != constants.DriverPostgres {
+					return nil
+				}
+
+				err := tx.Exec(ddl.CreateBuildNumberTable).Error
+				if err != nil {
+					return err
+				}
+
+				// claim the numbers of the existing builds
+				return tx.Exec(dml.InsertBuildNumbers).Error
+			},
+			Down: func(tx *gorm.DB, driver string) error {
+				if driver != constants.DriverPostgres {
+					return nil
+				}
+
+				return tx.Exec(ddl.DropBuildNumberTable).Error
+			},
+		},
+		{
+			Version: 6,
+			Name:    "soft delete repos and builds",
+			Up: func(tx *gorm.DB, _ string) error {
+				return repo.AddDeletedColumns(tx)
+			},
+			// dropping the column would restore the archived repos and builds
+			Down: irreversible,
+		},
+		{
+			Version: 7,
+			Name:    "soft delete secrets",
+			Up: func(tx *gorm.DB, _ string) error {
+				return addDeletedColumn(tx, constants.TableSecret)
+			},
+			// dropping the column would restore the archived secrets
+			Down: irreversible,
+		},
+		{
+			Version: 8,
+			Name:    "version repos, secrets and schedules",
+			Up: func(tx *gorm.DB, _ string) error {
+				err := repo.AddVersionColumn(tx)
+				if err != nil {
+					return err
+				}
+
+				err = schedule.AddVersionColumn(tx)
+				if err != nil {
+					return err
+				}
+
+				return addVersionColumn(tx, constants.TableSecret)
+			},
+			Down: func(tx *gorm.DB, _ string) error {
+				err := repo.DropVersionColumn(tx)
+				if err != nil {
+					return err
+				}
+
+				err = schedule.DropVersionColumn(tx)
+				if err != nil {
+					return err
+				}
+
+				return dropVersionColumn(tx, constants.TableSecret)
+			},
+		},
+	}
+}
+
+// irreversible is a helper function to reject rolling back a
+// migration when reverting the change would lose or expose data.
+func irreversible(_ *gorm.DB, _ string) error {
+	return ErrIrreversible
+}
+
+// addDeletedColumn is a helper function to add the nullable deleted_at
+// column used to archive rows to the table. Tables that don't exist
+// or already have the column are skipped.
+func addDeletedColumn(tx *gorm.DB, table string) error {
+	// check if the table is missing or the column already exists
+	if !tx.Migrator().HasTable(table) || tx.Migrator().HasColumn(table, "deleted_at") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN deleted_at INTEGER", table)).Error
+}
+
+// addVersionColumn is a helper function to add the version column
+// used for conditional updates to the table. Tables that don't exist
+// or already have the column are skipped.
+func addVersionColumn(tx *gorm.DB, table string) error {
+	// check if the table is missing or the column already exists
+	if !tx.Migrator().HasTable(table) || tx.Migrator().HasColumn(table, "version") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN version INTEGER NOT NULL DEFAULT 0", table)).Error
+}
+
+// dropVersionColumn is a helper function to drop the version column
+// used for conditional updates from the table. Tables that don't exist
+// or don't have the column are skipped. Sqlite doesn't support dropping
+// a column, so the column is kept since it defaults to 0.
+func dropVersionColumn(tx *gorm.DB, table string) error {
+	// check if the database supports dropping a column, the table is missing or the column doesn't exist
+	if name := tx.Dialector.Name(); name != constants.DriverPostgres && name != types.DriverMySQL || !tx.Migrator().HasTable(table) || !tx.Migrator().HasColumn(table, "version") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN version", table)).Error
+}
+
+// validate is a helper function to verify the migrations
+// are ordered by a unique version and can be applied.
+func validate(list []*Migration) error {
+	var previous int64
+
+	for _, m := range list {
+		if m.Version <= previous {
+			return fmt.Errorf("migration %d %s is out of order: versions must be unique and ascending", m.Version, m.Name)
+		}
+
+		if len(m.Name) == 0 {
+			return fmt.Errorf("migration %d has no name", m.Version)
+		}
+
+		if m.Up == nil {
+			return fmt.Errorf("migration %d %s has no up function", m.Version, m.Name)
+		}
+
+		if m.Down == nil {
+			return fmt.Errorf("migration %d %s has no down function", m.Version, m.Name)
+		}
+
+		previous = m.Version
+	}
+
+	return nil
+}
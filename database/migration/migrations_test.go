@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"testing"
+)
+
+func TestMigration_validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure    bool
+		name       string
+		migrations []*Migration
+	}{
+		{
+			failure:    false,
+			name:       "registered migrations",
+			migrations: registered(testUp),
+		},
+		{
+			failure:    false,
+			name:       "test migrations",
+			migrations: testMigrations(),
+		},
+		{
+			failure: true,
+			name:    "duplicate version",
+			migrations: []*Migration{
+				{Version: 1, Name: "baseline", Up: testUp, Down: irreversible},
+				{Version: 1, Name: "widgets", Up: testUp, Down: testUp},
+			},
+		},
+		{
+			failure: true,
+			name:    "no name",
+			migrations: []*Migration{
+				{Version: 1, Up: testUp, Down: irreversible},
+			},
+		},
+		{
+			failure: true,
+			name:    "no up function",
+			migrations: []*Migration{
+				{Version: 1, Name: "baseline", Down: irreversible},
+			},
+		},
+		{
+			failure: true,
+			name:    "no down function",
+			migrations: []*Migration{
+				{Version: 1, Name: "baseline", Up: testUp},
+			},
+		},
+		{
+			failure:    true,
+			name:       "registered migrations without baseline",
+			migrations: registered(nil),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validate(test.migrations)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("validate for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("validate for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
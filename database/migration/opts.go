@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// EngineOpt represents a configuration option to initialize the database engine for Migrations.
+type EngineOpt func(*engine) error
+
+// WithClient sets the gorm.io/gorm client in the database engine for Migrations.
+func WithClient(client *gorm.DB) EngineOpt {
+	return func(e *engine) error {
+		// set the gorm.io/gorm client in the migration engine
+		e.client = client
+
+		return nil
+	}
+}
+
+// WithLogger sets the github.com/sirupsen/logrus logger in the database engine for Migrations.
+func WithLogger(logger *logrus.Entry) EngineOpt {
+	return func(e *engine) error {
+		// set the github.com/sirupsen/logrus logger in the migration engine
+		e.logger = logger
+
+		return nil
+	}
+}
+
+// WithSkipCreation sets the skip creation logic in the database engine for Migrations.
+func WithSkipCreation(skipCreation bool) EngineOpt {
+	return func(e *engine) error {
+		// set to skip creating tables and indexes in the migration engine
+		e.config.SkipCreation = skipCreation
+
+		return nil
+	}
+}
+
+// WithBaseline sets the function creating the tables and indexes for
+// every resource for the baseline migration in the database engine for Migrations.
+func WithBaseline(baseline func(*gorm.DB, string) error) EngineOpt {
+	return func(e *engine) error {
+		// set the baseline function in the migration engine
+		e.baseline = baseline
+
+		return nil
+	}
+}
+
+// WithMigrations sets the ordered list of versioned migrations in the database engine for Migrations.
+func WithMigrations(migrations []*Migration) EngineOpt {
+	return func(e *engine) error {
+		// set the migrations in the migration engine
+		e.migrations = migrations
+
+		return nil
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// MigrationService represents the Vela interface for schema migration
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type MigrationService interface {
+	// Migration Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateMigrationTable defines a function that creates the schema_migrations table.
+	CreateMigrationTable(context.Context, string) error
+
+	// Migration Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// ListMigrations defines a function that gets a list of all schema migrations and when they were applied.
+	ListMigrations(context.Context) ([]*api.SchemaMigration, error)
+	// MigrateDown defines a function that rolls back the applied schema migrations after a version.
+	MigrateDown(context.Context, int64) ([]*api.SchemaMigration, error)
+	// MigrateUp defines a function that applies the pending schema migrations up to a version.
+	MigrateUp(context.Context, int64) ([]*api.SchemaMigration, error)
+}
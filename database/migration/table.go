@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableMigration represents the name of the table for applied schema migrations.
+	TableMigration = "schema_migrations"
+
+	// CreatePostgresTable represents a query to create the Postgres schema_migrations table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+schema_migrations (
+	version    BIGINT PRIMARY KEY,
+	name       VARCHAR(250),
+	applied    INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite schema_migrations table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+schema_migrations (
+	version    INTEGER PRIMARY KEY,
+	name       TEXT,
+	applied    INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL schema_migrations table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+schema_migrations (
+	version    BIGINT PRIMARY KEY,
+	name       VARCHAR(250),
+	applied    INTEGER
+);
+`
+)
+
+// CreateMigrationTable creates the schema_migrations table in the database.
+func (e *engine) CreateMigrationTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating schema_migrations table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the schema_migrations table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the schema_migrations table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the schema_migrations table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
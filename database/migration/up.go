@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// MigrateUp applies the pending schema migrations in order of their
+// version, up to and including the provided version, in the database.
+// A version of 0 applies every pending migration.
+//
+// Each migration is applied and recorded in a single transaction,
+// unless the migration can't be applied in a transaction.
+// For databases that commit schema changes implicitly, like MySQL,
+// a failed migration might be partially applied.
+func (e *engine) MigrateUp(ctx context.Context, version int64) ([]*api.SchemaMigration, error) {
+	e.logger.WithFields(logrus.Fields{
+		"version": version,
+	}).Trace("applying schema migrations in the database")
+
+	// capture the applied migrations
+	applied, err := e.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	driver := e.client.Config.Dialector.Name()
+
+	// variable to store the migrations applied
+	migrations := []*api.SchemaMigration{}
+
+	for _, m := range e.migrations {
+		if version > 0 && m.Version > version {
+			break
+		}
+
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+
+		e.logger.Infof("applying schema migration %d %s", m.Version, m.Name)
+
+		migration := new(api.SchemaMigration)
+		migration.SetVersion(m.Version)
+		migration.SetName(m.Name)
+		migration.SetApplied(time.Now().UTC().Unix())
+
+		// cast the API type to database type
+		record := types.SchemaMigrationFromAPI(migration)
+
+		// send queries to the database in a transaction when supported by the migration
+		err = e.transaction(ctx, m.NoTransaction, func(tx *gorm.DB) error {
+			err := m.Up(tx, driver)
+			if err != nil {
+				return err
+			}
+
+			return tx.Table(TableMigration).Create(record).Error
+		})
+		if err != nil {
+			return migrations, fmt.Errorf("unable to apply migration %d %s: %w", m.Version, m.Name, err)
+		}
+
+		migrations = append(migrations, record.ToAPI())
+	}
+
+	return migrations, nil
+}
+
+// transaction is a helper function to run the provided function
+// in a transaction unless the migration can't be applied in one.
+func (e *engine) transaction(ctx context.Context, skip bool, fn func(*gorm.DB) error) error {
+	// check if the migration is applied outside of a transaction
+	if skip {
+		return fn(e.client.WithContext(ctx))
+	}
+
+	return e.client.WithContext(ctx).Transaction(fn)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"gorm.io/gorm"
+)
+
+func TestMigration_Engine_MigrateUp(t *testing.T) {
+	// setup types
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"version", "name", "applied"}).AddRow(1, "baseline", 1)
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery(`SELECT * FROM "schema_migrations" ORDER BY version`).WillReturnRows(_rows)
+	_mock.ExpectBegin()
+	_mock.ExpectExec(CreateWidgetTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`INSERT INTO "schema_migrations" ("version","name","applied") VALUES ($1,$2,$3)`).
+		WithArgs(2, "widgets", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		version  int64
+		want     []int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []int64{2},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 up to baseline",
+			database: _sqlite,
+			version:  1,
+			want:     []int64{1},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []int64{2},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 nothing pending",
+			database: _sqlite,
+			want:     []int64{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.MigrateUp(context.TODO(), test.version)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("MigrateUp for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("MigrateUp for %s returned err: %v", test.name, err)
+			}
+
+			if len(got) != len(test.want) {
+				t.Fatalf("MigrateUp for %s applied %d migrations, want %d", test.name, len(got), len(test.want))
+			}
+
+			for i, m := range got {
+				if m.GetVersion() != test.want[i] || m.GetApplied() == 0 {
+					t.Errorf("MigrateUp for %s applied %v, want version %d", test.name, m, test.want[i])
+				}
+			}
+		})
+	}
+
+	// the widgets table was created by the migration for sqlite
+	if !_sqlite.client.Migrator().HasTable("widgets") {
+		t.Errorf("MigrateUp for sqlite3 did not create the widgets table")
+	}
+}
+
+func TestMigration_Engine_MigrateUp_Failure(t *testing.T) {
+	// setup types
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	_sqlite.migrations = append(testMigrations(), &Migration{
+		Version: 3,
+		Name:    "broken",
+		Up: func(tx *gorm.DB, _ string) error {
+			return errors.New("broken")
+		},
+	})
+
+	// run test
+	got, err := _sqlite.MigrateUp(context.TODO(), 0)
+	if err == nil {
+		t.Errorf("MigrateUp should have returned err")
+	}
+
+	if len(got) != 2 {
+		t.Errorf("MigrateUp applied %d migrations, want 2", len(got))
+	}
+
+	// the broken migration was not recorded
+	migrations, err := _sqlite.ListMigrations(context.TODO())
+	if err != nil {
+		t.Errorf("ListMigrations returned err: %v", err)
+	}
+
+	if migrations[2].GetApplied() != 0 {
+		t.Errorf("MigrateUp recorded the broken migration")
+	}
+}
+
+func TestMigration_Engine_MigrateUp_NoTransaction(t *testing.T) {
+	// setup types
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	_postgres.migrations = append(testMigrations(), &Migration{
+		Version: 3,
+		Name:    "widgets name",
+		Up: func(tx *gorm.DB, _ string) error {
+			return tx.Exec(`CREATE INDEX CONCURRENTLY IF NOT EXISTS widgets_name ON widgets (name)`).Error
+		},
+		Down: func(tx *gorm.DB, _ string) error {
+			return tx.Exec(`DROP INDEX IF EXISTS widgets_name`).Error
+		},
+		NoTransaction: true,
+	})
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"version", "name", "applied"}).
+		AddRow(1, "baseline", 1).
+		AddRow(2, "widgets", 1)
+
+	// ensure the mock expects the queries outside of a transaction
+	_mock.ExpectQuery(`SELECT * FROM "schema_migrations" ORDER BY version`).WillReturnRows(_rows)
+	_mock.ExpectExec(`CREATE INDEX CONCURRENTLY IF NOT EXISTS widgets_name ON widgets (name)`).WillReturnResult(sqlmock.NewResult(0, 0))
+	_mock.ExpectExec(`INSERT INTO "schema_migrations" ("version","name","applied") VALUES ($1,$2,$3)`).
+		WithArgs(3, "widgets name", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// run test
+	got, err := _postgres.MigrateUp(context.TODO(), 0)
+	if err != nil {
+		t.Errorf("MigrateUp returned err: %v", err)
+	}
+
+	if len(got) != 1 {
+		t.Errorf("MigrateUp applied %d migrations, want 1", len(got))
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("MigrateUp didn't send the expected queries: %v", err)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/mysql/dml"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetBuildCount gets a count of all builds from the database.
+func (c *client) GetBuildCount(ctx context.Context) (int64, error) {
+	c.Logger.Trace("getting count of builds from the database")
+
+	// variable to store query results
+	var b int64
+
+	// send query to the database and store result in variable
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableBuild).
+		Raw(dml.SelectBuildsCount).
+		Pluck("count", &b).Error
+
+	return b, err
+}
+
+// GetBuildCountByStatus gets a count of all builds by status from the database.
+func (c *client) GetBuildCountByStatus(ctx context.Context, status string) (int64, error) {
+	c.Logger.Tracef("getting count of builds by status %s from the database", status)
+
+	// variable to store query results
+	var b int64
+
+	// send query to the database and store result in variable
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableBuild).
+		Raw(dml.SelectBuildsCountByStatus, status).
+		Pluck("count", &b).Error
+
+	return b, err
+}
+
+// GetBuildCountByHost gets a count of all builds by
+// status for each worker host from the database.
+func (c *client) GetBuildCountByHost(ctx context.Context, status string) (map[string]int64, error) {
+	c.Logger.Tracef("getting count of builds by status %s for each host from the database", status)
+
+	type hostCount struct {
+		Host  string
+		Count int64
+	}
+
+	// variables to store query results and return value
+	h := new([]hostCount)
+	counts := make(map[string]int64)
+
+	// send query to the database and store result in variable
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableBuild).
+		Select("host, count(*) AS count").
+		Where("status = ?", status).
+		Where("deleted_at IS NULL").
+		Group("host").
+		Scan(h).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, host := range *h {
+		counts[host.Host] = host.Count
+	}
+
+	return counts, nil
+}
+
+// GetOrgBuildCount gets the count of all builds by repo ID from the database.
+func (c *client) GetOrgBuildCount(ctx context.Context, org string, filters map[string]interface{}) (int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("getting count of builds for org %s from the database", org)
+
+	// variable to store query results
+	var b int64
+
+	// send query to the database and store result in variable
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableBuild).
+		Joins("JOIN repos ON builds.repo_id = repos.id and repos.org = ?", org).
+		Where("builds.deleted_at IS NULL").
+		Where(filters).
+		Count(&b).Error
+
+	return b, err
+}
+
+// GetRepoBuildCount gets the count of all builds by repo ID from the database.
+func (c *client) GetRepoBuildCount(ctx context.Context, r *library.Repo, filters map[string]interface{}) (int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"name": r.GetName(),
+	}).Tracef("getting count of builds for repo %s from the database", r.GetFullName())
+
+	// variable to store query results
+	var b int64
+
+	// send query to the database and store result in variable
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("repo_id = ?", r.GetID()).
+		Where(filters).
+		Count(&b).Error
+
+	return b, err
+}
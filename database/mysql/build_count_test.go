@@ -0,0 +1,398 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/go-vela/server/database/mysql/dml"
+
+	"gorm.io/gorm"
+)
+
+func TestMySQL_Client_GetBuildCount(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Raw(dml.SelectBuildsCount).Statement
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    int64
+	}{
+		{
+			failure: false,
+			want:    2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetBuildCount(context.TODO())
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetBuildCount should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetBuildCount returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetBuildCount is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetBuildCountByStatus(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Raw(dml.SelectBuildsCountByStatus, "running").Statement
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    int64
+	}{
+		{
+			failure: false,
+			want:    2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetBuildCountByStatus(context.TODO(), "running")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetBuildCountByStatus should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetBuildCountByStatus returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetBuildCountByStatus is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetBuildCountByHost(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"host", "count"}).
+		AddRow("worker-1.example.com", 2).
+		AddRow("worker-2.example.com", 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT host, count(*) AS count FROM `builds` WHERE status = ? AND deleted_at IS NULL GROUP BY `host`").
+		WithArgs("running").
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    map[string]int64
+	}{
+		{
+			failure: false,
+			want:    map[string]int64{"worker-1.example.com": 2, "worker-2.example.com": 1},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetBuildCountByHost(context.TODO(), "running")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetBuildCountByHost should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetBuildCountByHost returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetBuildCountByHost is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetOrgBuildCount(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    int64
+	}{
+		{
+			failure: false,
+			want:    2,
+		},
+	}
+	filters := map[string]interface{}{}
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetOrgBuildCount(context.TODO(), "foo", filters)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgBuildCount should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgBuildCount returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgBuildCount is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetOrgBuildCountByEvent(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL AND `event` = ?").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    int64
+	}{
+		{
+			failure: false,
+			want:    2,
+		},
+	}
+	filters := map[string]interface{}{
+		"event": "push",
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetOrgBuildCount(context.TODO(), "foo", filters)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgBuildCountByEvent should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgBuildCountByEvent returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgBuildCountByEvent is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetRepoBuildCount(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM `builds` WHERE repo_id = ?").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    int64
+	}{
+		{
+			failure: false,
+			want:    2,
+		},
+	}
+
+	filters := map[string]interface{}{}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetRepoBuildCount(context.TODO(), _repo, filters)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetRepoBuildCount should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetRepoBuildCount returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetRepoBuildCount is %v, want %v", got, test.want)
+		}
+	}
+}
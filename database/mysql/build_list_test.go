@@ -0,0 +1,744 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/go-vela/server/database/mysql/dml"
+	"github.com/go-vela/types/library"
+
+	"gorm.io/gorm"
+)
+
+func TestMySQL_Client_GetBuildList(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Raw(dml.ListBuilds).Statement
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetBuildList(context.TODO())
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetFinishedBuildListSince(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetCreated(2)
+	_buildOne.SetFinished(3)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetCreated(2)
+	_buildTwo.SetFinished(3)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 2, 0, 3, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 2, 0, 3, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM `builds` WHERE created > ? AND finished > 0").WithArgs(1).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetFinishedBuildListSince(context.TODO(), 1)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedBuildListSince should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedBuildListSince returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedBuildListSince is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetFinishedBuildListBetween(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetFinished(2)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetFinished(3)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 2, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 3, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM `builds` WHERE finished > ? AND finished <= ? ORDER BY finished,id").WithArgs(1, 3).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetFinishedBuildListBetween(context.TODO(), 1, 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedBuildListBetween should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedBuildListBetween returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedBuildListBetween is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetPendingBuildListBefore(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetStatus("pending")
+	_buildOne.SetCreated(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetStatus("pending")
+	_buildTwo.SetCreated(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "pending", "", 0, 1, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "pending", "", 0, 2, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM `builds` WHERE status = ? AND created < ? ORDER BY created,id").WithArgs("pending", 3).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetPendingBuildListBefore(context.TODO(), 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetPendingBuildListBefore should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetPendingBuildListBefore returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetPendingBuildListBefore is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetLastDeploymentBuildList(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetEvent("deployment")
+	_buildOne.SetStatus("success")
+	_buildOne.SetDeploy("production")
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(3)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(3)
+	_buildTwo.SetEvent("deployment")
+	_buildTwo.SetStatus("success")
+	_buildTwo.SetDeploy("staging")
+	_buildTwo.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "deployment", "", "success", "", 0, 0, 0, 0, "production", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(3, 1, nil, 3, 0, "deployment", "", "success", "", 0, 0, 0, 0, "staging", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM `builds` WHERE id IN (SELECT MAX(id) FROM `builds` WHERE repo_id = ? AND event = ? AND status = ? GROUP BY `deploy`) ORDER BY deploy").
+		WithArgs(1, "deployment", "success").
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetLastDeploymentBuildList(context.TODO(), _repo)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetLastDeploymentBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetLastDeploymentBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetLastDeploymentBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetDeploymentBuildList(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+	_buildOne.SetSource("https://github.com/github/octocat/deployments/1")
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+	_buildTwo.SetSource("https://github.com/github/octocat/deployments/1")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "https://github.com/github/octocat/deployments/1", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "https://github.com/github/octocat/deployments/1", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM `builds` WHERE `source` = ? ORDER BY number DESC LIMIT 3").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildTwo, _buildOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetDeploymentBuildList(context.TODO(), "https://github.com/github/octocat/deployments/1")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetDeploymentBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetDeploymentBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetDeploymentBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetOrgBuildList(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL").WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT builds.* FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	filters := map[string]interface{}{}
+
+	// run tests
+	for _, test := range tests {
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetOrgBuildList_NonAdmin(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL AND `visibility` = ?").WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT builds.* FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL AND `visibility` = ? ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne},
+		},
+	}
+
+	filters := map[string]interface{}{
+		"visibility": "public",
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetOrgBuildListByEvent(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL AND `event` = ?").WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT builds.* FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ? WHERE builds.deleted_at IS NULL AND `event` = ? ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	filters := map[string]interface{}{
+		"event": "push",
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgBuildListByEvent should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgBuildListByEvent returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgBuildListByEvent is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetRepoBuildList(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+	_buildOne.SetCreated(1)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+	_buildTwo.SetCreated(2)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM `builds` WHERE repo_id = ?").WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 1, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 2, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM `builds` WHERE repo_id = ? AND created < ? AND created > ? ORDER BY number DESC LIMIT 10").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		before  int64
+		after   int64
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			before:  time.Now().UTC().Unix(),
+			after:   0,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	filters := map[string]interface{}{}
+
+	// run tests
+	for _, test := range tests {
+		got, _, err := _database.GetRepoBuildList(context.TODO(), _repo, filters, test.before, test.after, 1, 10)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetRepoBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetRepoBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetRepoBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
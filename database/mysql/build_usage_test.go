@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestMySQL_Client_GetOrgActorUsage(t *testing.T) {
+	// setup types
+	_usage := new(api.ActorUsage)
+	_usage.SetActor("octocat")
+	_usage.SetBuilds(2)
+	_usage.SetSteps(6)
+	_usage.SetMinutes(3)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"actor", "builds", "seconds"}).AddRow("octocat", 2, 180)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT builds.sender AS actor, count(*) AS builds, sum(builds.finished - builds.started) AS seconds\n"+
+		"FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ?\n"+
+		"WHERE (builds.started > 0 AND builds.finished > 0) AND (builds.created > ? AND builds.created < ?)\n"+
+		"GROUP BY `builds`.`sender` ORDER BY seconds DESC").
+		WithArgs("foo", 1, 2).
+		WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows([]string{"actor", "steps"}).AddRow("octocat", 6)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT builds.sender AS actor, count(*) AS steps\n"+
+		"FROM `steps` JOIN builds ON steps.build_id = builds.id JOIN repos ON builds.repo_id = repos.id and repos.org = ?\n"+
+		"WHERE (builds.started > 0 AND builds.finished > 0) AND (builds.created > ? AND builds.created < ?)\n"+
+		"GROUP BY `builds`.`sender`").
+		WithArgs("foo", 1, 2).
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*api.ActorUsage
+	}{
+		{
+			failure: false,
+			want:    []*api.ActorUsage{_usage},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetOrgActorUsage(context.TODO(), "foo", 1, 2)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgActorUsage should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgActorUsage returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgActorUsage is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetOrgHostUsage(t *testing.T) {
+	// setup types
+	_usage := new(api.HostUsage)
+	_usage.SetHost("worker-1.example.com")
+	_usage.SetBuilds(2)
+	_usage.SetMinutes(3)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"host", "builds", "seconds"}).AddRow("worker-1.example.com", 2, 180)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT builds.host AS host, count(*) AS builds, sum(builds.finished - builds.started) AS seconds\n"+
+		"FROM `builds` JOIN repos ON builds.repo_id = repos.id and repos.org = ?\n"+
+		"WHERE (builds.started > 0 AND builds.finished > 0) AND (builds.created > ? AND builds.created < ?)\n"+
+		"GROUP BY `builds`.`host` ORDER BY seconds DESC").
+		WithArgs("foo", 1, 2).
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*api.HostUsage
+	}{
+		{
+			failure: false,
+			want:    []*api.HostUsage{_usage},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetOrgHostUsage(context.TODO(), "foo", 1, 2)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgHostUsage should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgHostUsage returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgHostUsage is %v, want %v", got, test.want)
+		}
+	}
+}
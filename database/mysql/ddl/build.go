@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ddl
+
+const (
+	// CreateBuildTable represents a query to
+	// create the builds table and indexes for Vela.
+	//
+	// The source index uses a prefix of the column to fit the MySQL key length limit.
+	CreateBuildTable = `
+CREATE TABLE
+IF NOT EXISTS
+builds (
+	id             INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id        INTEGER,
+	pipeline_id    INTEGER,
+	number         INTEGER,
+	parent         INTEGER,
+	event          VARCHAR(250),
+	event_action   VARCHAR(250),
+	status         VARCHAR(250),
+	error          VARCHAR(1000),
+	enqueued       INTEGER,
+	created        INTEGER,
+	started        INTEGER,
+	finished       INTEGER,
+	deploy         VARCHAR(500),
+	deploy_payload VARCHAR(2000),
+	clone          VARCHAR(1000),
+	source         VARCHAR(1000),
+	title          VARCHAR(1000),
+	message        VARCHAR(2000),
+	commit         VARCHAR(500),
+	sender         VARCHAR(250),
+	author         VARCHAR(250),
+	email          VARCHAR(500),
+	link           VARCHAR(1000),
+	branch         VARCHAR(500),
+	ref            VARCHAR(500),
+	base_ref       VARCHAR(500),
+	head_ref       VARCHAR(500),
+	host           VARCHAR(250),
+	runtime        VARCHAR(250),
+	distribution   VARCHAR(250),
+	timestamp      INTEGER,
+	UNIQUE(repo_id, number),
+	INDEX builds_repo_id (repo_id),
+	INDEX builds_status (status),
+	INDEX builds_created (created),
+	INDEX builds_source (source(768))
+);
+`
+)
@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package ddl provides the MySQL data definition language (DDL) for Vela.
+//
+// https://en.wikipedia.org/wiki/Data_definition_language
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/database/mysql/ddl"
+package ddl
@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ddl
+
+const (
+	// CreateSecretTable represents a query to
+	// create the secrets table and indexes for Vela.
+	//
+	// The unique keys use a prefix of the columns to fit the MySQL key length limit.
+	CreateSecretTable = `
+CREATE TABLE
+IF NOT EXISTS
+secrets (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	type          VARCHAR(100),
+	org           VARCHAR(250),
+	repo          VARCHAR(250),
+	team          VARCHAR(250),
+	name          VARCHAR(250),
+	value         LONGBLOB,
+	images        VARCHAR(1000),
+	events        VARCHAR(1000),
+	allow_command BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(type, org(200), repo(200), name(200)),
+	UNIQUE(type, org(200), team(200), name(200)),
+	INDEX secrets_type_org_repo (type, org, repo),
+	INDEX secrets_type_org_team (type, org, team),
+	INDEX secrets_type_org (type, org)
+);
+`
+)
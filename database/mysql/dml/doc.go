@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package dml provides the MySQL data manipulation language (DML) for Vela.
+//
+// https://en.wikipedia.org/wiki/Data_manipulation_language
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/database/mysql/dml"
+package dml
@@ -0,0 +1,11 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package mysql provides the ability for Vela to
+// integrate with MySQL as a SQL backend.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/database/mysql"
+package mysql
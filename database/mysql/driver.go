@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import "github.com/go-vela/server/database/types"
+
+// Driver outputs the configured database driver.
+func (c *client) Driver() string {
+	return types.DriverMySQL
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/database/types"
+)
+
+func TestMySQL_Client_Driver(t *testing.T) {
+	// setup types
+	want := types.DriverMySQL
+
+	// setup the test database client
+	_database, _, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// run test
+	got := _database.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
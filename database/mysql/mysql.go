@@ -0,0 +1,1100 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/blob"
+	"github.com/go-vela/server/database/announcement"
+	"github.com/go-vela/server/database/artifact"
+	"github.com/go-vela/server/database/buildbudget"
+	"github.com/go-vela/server/database/buildcredential"
+	"github.com/go-vela/server/database/builddeprecation"
+	"github.com/go-vela/server/database/buildimage"
+	"github.com/go-vela/server/database/buildredaction"
+	"github.com/go-vela/server/database/canaryrun"
+	"github.com/go-vela/server/database/capacitysnapshot"
+	"github.com/go-vela/server/database/clonesetting"
+	"github.com/go-vela/server/database/costrate"
+	"github.com/go-vela/server/database/deprecationrule"
+	"github.com/go-vela/server/database/egressrule"
+	"github.com/go-vela/server/database/ephemeralregistration"
+	"github.com/go-vela/server/database/export"
+	"github.com/go-vela/server/database/freeze"
+	"github.com/go-vela/server/database/freezeaudit"
+	"github.com/go-vela/server/database/hook"
+	"github.com/go-vela/server/database/idempotencykey"
+	"github.com/go-vela/server/database/label"
+	"github.com/go-vela/server/database/leakfinding"
+	"github.com/go-vela/server/database/lease"
+	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/metrics"
+	"github.com/go-vela/server/database/migration"
+	"github.com/go-vela/server/database/mysql/ddl"
+	"github.com/go-vela/server/database/orghook"
+	"github.com/go-vela/server/database/partition"
+	"github.com/go-vela/server/database/pipeline"
+	"github.com/go-vela/server/database/previewenvironment"
+	"github.com/go-vela/server/database/registrycredential"
+	"github.com/go-vela/server/database/registrymirror"
+	"github.com/go-vela/server/database/replica"
+	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/reposync"
+	"github.com/go-vela/server/database/repovariable"
+	"github.com/go-vela/server/database/requiredcontext"
+	"github.com/go-vela/server/database/retentionpolicy"
+	"github.com/go-vela/server/database/schedule"
+	"github.com/go-vela/server/database/servicereadiness"
+	"github.com/go-vela/server/database/stagedwebhook"
+	"github.com/go-vela/server/database/stepattempt"
+	"github.com/go-vela/server/database/stepskip"
+	"github.com/go-vela/server/database/template"
+	"github.com/go-vela/server/database/timeout"
+	"github.com/go-vela/server/database/triggertoken"
+	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/server/database/userscope"
+	"github.com/go-vela/server/database/usersession"
+	"github.com/go-vela/server/database/webhookdelivery"
+	"github.com/go-vela/server/database/webhookintake"
+	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/server/database/workergroup"
+	"github.com/go-vela/server/fault"
+	"github.com/go-vela/server/kms"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type (
+	config struct {
+		// specifies the address to use for the MySQL client
+		Address string
+		// specifies the algorithm of compression to use for the MySQL client
+		CompressionAlgorithm string
+		// specifies the level of compression to use for the MySQL client
+		CompressionLevel int
+		// specifies the connection duration to use for the MySQL client
+		ConnectionLife time.Duration
+		// specifies the maximum idle connections for the MySQL client
+		ConnectionIdle int
+		// specifies the maximum open connections for the MySQL client
+		ConnectionOpen int
+		// specifies the maximum duration of each statement for the MySQL client
+		StatementTimeout time.Duration
+		// specifies the duration after which statements are logged as slow for the MySQL client
+		SlowQueryThreshold time.Duration
+		// specifies the addresses of the read replicas for the MySQL client
+		Replicas []string
+		// specifies the encryption key to use for the MySQL client
+		EncryptionKey string
+		// specifies the key management service to use for the MySQL client
+		KMS kms.Service
+		// specifies the blob storage service to use for the MySQL client
+		Blob blob.Service
+		// specifies to skip creating tables and indexes for the MySQL client
+		SkipCreation bool
+	}
+
+	client struct {
+		config *config
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		MySQL *gorm.DB
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		Logger *logrus.Entry
+		// https://pkg.go.dev/github.com/go-vela/server/database/hook#HookService
+		hook.HookService
+		// https://pkg.go.dev/github.com/go-vela/server/database/label#LabelService
+		label.LabelService
+		// https://pkg.go.dev/github.com/go-vela/server/database/log#LogService
+		log.LogService
+		// https://pkg.go.dev/github.com/go-vela/server/database/pipeline#PipelineService
+		pipeline.PipelineService
+		// https://pkg.go.dev/github.com/go-vela/server/database/repo#RepoService
+		repo.RepoService
+		// https://pkg.go.dev/github.com/go-vela/server/database/schedule#ScheduleService
+		schedule.ScheduleService
+		// https://pkg.go.dev/github.com/go-vela/server/database/template#TemplateService
+		template.TemplateService
+		// https://pkg.go.dev/github.com/go-vela/server/database/triggertoken#TriggerTokenService
+		triggertoken.TriggerTokenService
+		// https://pkg.go.dev/github.com/go-vela/server/database/user#UserService
+		user.UserService
+		// https://pkg.go.dev/github.com/go-vela/server/database/worker#WorkerService
+		worker.WorkerService
+		// https://pkg.go.dev/github.com/go-vela/server/database/workergroup#WorkerGroupService
+		workergroup.WorkerGroupService
+		// https://pkg.go.dev/github.com/go-vela/server/database/canaryrun#CanaryRunService
+		canaryrun.CanaryRunService
+		// https://pkg.go.dev/github.com/go-vela/server/database/capacitysnapshot#CapacitySnapshotService
+		capacitysnapshot.CapacitySnapshotService
+		// https://pkg.go.dev/github.com/go-vela/server/database/export#ExportService
+		export.ExportService
+		// https://pkg.go.dev/github.com/go-vela/server/database/registrycredential#RegistryCredentialService
+		registrycredential.RegistryCredentialService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildcredential#BuildCredentialService
+		buildcredential.BuildCredentialService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildimage#BuildImageService
+		buildimage.BuildImageService
+		// https://pkg.go.dev/github.com/go-vela/server/database/registrymirror#RegistryMirrorService
+		registrymirror.RegistryMirrorService
+		// https://pkg.go.dev/github.com/go-vela/server/database/servicereadiness#ServiceReadinessService
+		servicereadiness.ServiceReadinessService
+		// https://pkg.go.dev/github.com/go-vela/server/database/stepattempt#StepAttemptService
+		stepattempt.StepAttemptService
+		// https://pkg.go.dev/github.com/go-vela/server/database/egressrule#EgressRuleService
+		egressrule.EgressRuleService
+		// https://pkg.go.dev/github.com/go-vela/server/database/previewenvironment#PreviewEnvironmentService
+		previewenvironment.PreviewEnvironmentService
+		// https://pkg.go.dev/github.com/go-vela/server/database/stagedwebhook#StagedWebhookService
+		stagedwebhook.StagedWebhookService
+		// https://pkg.go.dev/github.com/go-vela/server/database/idempotencykey#IdempotencyKeyService
+		idempotencykey.IdempotencyKeyService
+		// https://pkg.go.dev/github.com/go-vela/server/database/artifact#ArtifactService
+		artifact.ArtifactService
+		// https://pkg.go.dev/github.com/go-vela/server/database/orghook#OrgHookService
+		orghook.OrgHookService
+		// https://pkg.go.dev/github.com/go-vela/server/database/webhookdelivery#WebhookDeliveryService
+		webhookdelivery.WebhookDeliveryService
+		// https://pkg.go.dev/github.com/go-vela/server/database/requiredcontext#RequiredContextService
+		requiredcontext.RequiredContextService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildbudget#BuildBudgetService
+		buildbudget.BuildBudgetService
+		// https://pkg.go.dev/github.com/go-vela/server/database/stepskip#StepSkipService
+		stepskip.StepSkipService
+		// https://pkg.go.dev/github.com/go-vela/server/database/reposync#RepoSyncService
+		reposync.RepoSyncService
+		// https://pkg.go.dev/github.com/go-vela/server/database/lease#LeaseService
+		lease.LeaseService
+		// https://pkg.go.dev/github.com/go-vela/server/database/freeze#FreezeService
+		freeze.FreezeService
+		// https://pkg.go.dev/github.com/go-vela/server/database/freezeaudit#FreezeAuditService
+		freezeaudit.FreezeAuditService
+		// https://pkg.go.dev/github.com/go-vela/server/database/costrate#CostRateService
+		costrate.CostRateService
+		// https://pkg.go.dev/github.com/go-vela/server/database/userscope#UserScopeService
+		userscope.UserScopeService
+		// https://pkg.go.dev/github.com/go-vela/server/database/deprecationrule#DeprecationRuleService
+		deprecationrule.DeprecationRuleService
+		// https://pkg.go.dev/github.com/go-vela/server/database/builddeprecation#BuildDeprecationService
+		builddeprecation.BuildDeprecationService
+		// https://pkg.go.dev/github.com/go-vela/server/database/clonesetting#CloneSettingService
+		clonesetting.CloneSettingService
+		// https://pkg.go.dev/github.com/go-vela/server/database/ephemeralregistration#EphemeralRegistrationService
+		ephemeralregistration.EphemeralRegistrationService
+		// https://pkg.go.dev/github.com/go-vela/server/database/migration#MigrationService
+		migration.MigrationService
+		// https://pkg.go.dev/github.com/go-vela/server/database/webhookintake#WebhookIntakeService
+		webhookintake.WebhookIntakeService
+		// https://pkg.go.dev/github.com/go-vela/server/database/usersession#UserSessionService
+		usersession.UserSessionService
+		// https://pkg.go.dev/github.com/go-vela/server/database/repovariable#RepoVariableService
+		repovariable.RepoVariableService
+		// https://pkg.go.dev/github.com/go-vela/server/database/announcement#AnnouncementService
+		announcement.AnnouncementService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildredaction#BuildRedactionService
+		buildredaction.BuildRedactionService
+		// https://pkg.go.dev/github.com/go-vela/server/database/partition#PartitionService
+		partition.PartitionService
+		// https://pkg.go.dev/github.com/go-vela/server/database/retentionpolicy#RetentionPolicyService
+		retentionpolicy.RetentionPolicyService
+		// https://pkg.go.dev/github.com/go-vela/server/database/leakfinding#LeakFindingService
+		leakfinding.LeakFindingService
+	}
+)
+
+// New returns a Database implementation that integrates with a MySQL instance.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new MySQL client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.MySQL = new(gorm.DB)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("database", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// set the statement timeout for the MySQL database sessions
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/timeout?tab=doc#MySQL
+	address, err := timeout.MySQL(c.config.Address, c.config.StatementTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the new MySQL database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	_mysql, err := gorm.Open(mysql.Open(address), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	// set the MySQL database client in the MySQL client
+	c.MySQL = _mysql
+
+	// register the fault injection callbacks with the MySQL database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/fault?tab=doc#Database
+	err = fault.Database(c.MySQL)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the connection pools for the MySQL read replicas
+	replicas := []*sql.DB{}
+
+	for _, address := range c.config.Replicas {
+		// https://pkg.go.dev/github.com/go-vela/server/database/timeout?tab=doc#MySQL
+		address, err := timeout.MySQL(address, c.config.StatementTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Open
+		_replica, err := replica.Open(
+			mysql.Open(address),
+			c.config.ConnectionLife,
+			c.config.ConnectionIdle,
+			c.config.ConnectionOpen,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		replicas = append(replicas, _replica)
+	}
+
+	// register the read replica callbacks with the MySQL database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Register
+	err = replica.Register(c.MySQL, replicas...)
+	if err != nil {
+		return nil, err
+	}
+
+	// register the statement timeout callbacks with the MySQL database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/timeout?tab=doc#Register
+	err = timeout.Register(c.MySQL, c.config.StatementTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// register the query metrics callbacks with the MySQL database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/metrics?tab=doc#Register
+	err = metrics.Register(c.MySQL, c.config.SlowQueryThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	// setup database with proper configuration
+	err = setupDatabase(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the services for the database
+	err = createServices(c, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// NewTest returns a Database implementation that integrates with a fake MySQL instance.
+//
+// This function is intended for running tests only.
+//
+//nolint:revive // ignore returning unexported client
+func NewTest() (*client, sqlmock.Sqlmock, error) {
+	// create new MySQL client
+	c := new(client)
+
+	// create new fields
+	c.config = &config{
+		CompressionLevel: 3,
+		ConnectionLife:   30 * time.Minute,
+		ConnectionIdle:   2,
+		ConnectionOpen:   0,
+		EncryptionKey:    "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+		SkipCreation:     false,
+	}
+	c.MySQL = new(gorm.DB)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("database", c.Driver())
+
+	// create the new mock sql database
+	//
+	// https://pkg.go.dev/github.com/DATA-DOG/go-sqlmock#New
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ensure the mock expects the migration queries
+	_mock.ExpectExec(migration.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// create the new mock MySQL database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	c.MySQL, err = gorm.Open(
+		mysql.New(mysql.Config{Conn: _sql, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// setup database with proper configuration
+	err = createServices(c, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, _mock, nil
+}
+
+// setupDatabase is a helper function to setup
+// the database with the proper configuration.
+func setupDatabase(c *client) error {
+	// capture database/sql database from gorm database
+	//
+	// https://pkg.go.dev/gorm.io/gorm#DB.DB
+	_sql, err := c.MySQL.DB()
+	if err != nil {
+		return err
+	}
+
+	// set the maximum amount of time a connection may be reused
+	//
+	// https://golang.org/pkg/database/sql/#DB.SetConnMaxLifetime
+	_sql.SetConnMaxLifetime(c.config.ConnectionLife)
+
+	// set the maximum number of connections in the idle connection pool
+	//
+	// https://golang.org/pkg/database/sql/#DB.SetMaxIdleConns
+	_sql.SetMaxIdleConns(c.config.ConnectionIdle)
+
+	// set the maximum number of open connections to the database
+	//
+	// https://golang.org/pkg/database/sql/#DB.SetMaxOpenConns
+	_sql.SetMaxOpenConns(c.config.ConnectionOpen)
+
+	// verify connection to the database
+	err = c.Ping()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// baseline is a helper function to create the tables and
+// indexes for every resource in the database for the
+// baseline schema migration.
+func (c *client) baseline(db *gorm.DB, _ string) error {
+	c.Logger.Trace("creating baseline tables and indexes in the mysql database")
+
+	// create a copy of the client bound to the migration
+	// with the services creating their tables and indexes
+	_client, err := c.bind(db, false)
+	if err != nil {
+		return err
+	}
+
+	// create the tables in the database
+	err = createTables(_client)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createTables is a helper function to setup
+// the database with the necessary tables and indexes.
+func createTables(c *client) error {
+	c.Logger.Trace("creating data tables and indexes in the mysql database")
+
+	// create the builds table
+	err := c.MySQL.Exec(ddl.CreateBuildTable).Error
+	if err != nil {
+		return fmt.Errorf("unable to create %s table: %w", constants.TableBuild, err)
+	}
+
+	// create the secrets table
+	err = c.MySQL.Exec(ddl.CreateSecretTable).Error
+	if err != nil {
+		return fmt.Errorf("unable to create %s table: %w", constants.TableSecret, err)
+	}
+
+	// create the services table
+	err = c.MySQL.Exec(ddl.CreateServiceTable).Error
+	if err != nil {
+		return fmt.Errorf("unable to create %s table: %w", constants.TableService, err)
+	}
+
+	// create the steps table
+	err = c.MySQL.Exec(ddl.CreateStepTable).Error
+	if err != nil {
+		return fmt.Errorf("unable to create %s table: %w", constants.TableStep, err)
+	}
+
+	return nil
+}
+
+// createServices is a helper function to create the database services.
+//
+// The tables and indexes for the services are created by the baseline
+// schema migration, so the services only create them for the baseline.
+func createServices(c *client, skipCreation bool) error {
+	var err error
+
+	// variable to store the envelope for encrypting fields
+	var envelope *kms.Envelope
+
+	// check if a key management service is configured
+	if c.config.KMS != nil {
+		// create the envelope for encrypting fields
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/kms#NewEnvelope
+		envelope = kms.NewEnvelope(c.config.KMS, c.config.EncryptionKey)
+	}
+
+	// create the database agnostic hook service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/hook#New
+	c.HookService, err = hook.New(
+		hook.WithClient(c.MySQL),
+		hook.WithLogger(c.Logger),
+		hook.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic label service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/label#New
+	c.LabelService, err = label.New(
+		label.WithClient(c.MySQL),
+		label.WithLogger(c.Logger),
+		label.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic log service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/log#New
+	c.LogService, err = log.New(
+		log.WithBlob(c.config.Blob),
+		log.WithClient(c.MySQL),
+		log.WithCompressionAlgorithm(c.config.CompressionAlgorithm),
+		log.WithCompressionLevel(c.config.CompressionLevel),
+		log.WithLogger(c.Logger),
+		log.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic pipeline service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/pipeline#New
+	c.PipelineService, err = pipeline.New(
+		pipeline.WithClient(c.MySQL),
+		pipeline.WithCompressionLevel(c.config.CompressionLevel),
+		pipeline.WithLogger(c.Logger),
+		pipeline.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic repo service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/repo#New
+	c.RepoService, err = repo.New(
+		repo.WithClient(c.MySQL),
+		repo.WithEncryptionKey(c.config.EncryptionKey),
+		repo.WithEnvelope(envelope),
+		repo.WithLogger(c.Logger),
+		repo.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic schedule service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/schedule#New
+	c.ScheduleService, err = schedule.New(
+		schedule.WithClient(c.MySQL),
+		schedule.WithLogger(c.Logger),
+		schedule.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic template service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/template#New
+	c.TemplateService, err = template.New(
+		template.WithClient(c.MySQL),
+		template.WithLogger(c.Logger),
+		template.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic triggertoken service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/triggertoken#New
+	c.TriggerTokenService, err = triggertoken.New(
+		triggertoken.WithClient(c.MySQL),
+		triggertoken.WithLogger(c.Logger),
+		triggertoken.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic user service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/user#New
+	c.UserService, err = user.New(
+		user.WithClient(c.MySQL),
+		user.WithEncryptionKey(c.config.EncryptionKey),
+		user.WithEnvelope(envelope),
+		user.WithLogger(c.Logger),
+		user.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic worker service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/worker#New
+	c.WorkerService, err = worker.New(
+		worker.WithClient(c.MySQL),
+		worker.WithLogger(c.Logger),
+		worker.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic workergroup service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/workergroup#New
+	c.WorkerGroupService, err = workergroup.New(
+		workergroup.WithClient(c.MySQL),
+		workergroup.WithLogger(c.Logger),
+		workergroup.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic canaryrun service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/canaryrun#New
+	c.CanaryRunService, err = canaryrun.New(
+		canaryrun.WithClient(c.MySQL),
+		canaryrun.WithLogger(c.Logger),
+		canaryrun.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic capacitysnapshot service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/capacitysnapshot#New
+	c.CapacitySnapshotService, err = capacitysnapshot.New(
+		capacitysnapshot.WithClient(c.MySQL),
+		capacitysnapshot.WithLogger(c.Logger),
+		capacitysnapshot.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic export service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/export#New
+	c.ExportService, err = export.New(
+		export.WithClient(c.MySQL),
+		export.WithLogger(c.Logger),
+		export.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic registrycredential service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/registrycredential#New
+	c.RegistryCredentialService, err = registrycredential.New(
+		registrycredential.WithClient(c.MySQL),
+		registrycredential.WithEncryptionKey(c.config.EncryptionKey),
+		registrycredential.WithLogger(c.Logger),
+		registrycredential.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildcredential service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildcredential#New
+	c.BuildCredentialService, err = buildcredential.New(
+		buildcredential.WithClient(c.MySQL),
+		buildcredential.WithLogger(c.Logger),
+		buildcredential.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildimage service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildimage#New
+	c.BuildImageService, err = buildimage.New(
+		buildimage.WithClient(c.MySQL),
+		buildimage.WithLogger(c.Logger),
+		buildimage.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic registrymirror service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/registrymirror#New
+	c.RegistryMirrorService, err = registrymirror.New(
+		registrymirror.WithClient(c.MySQL),
+		registrymirror.WithLogger(c.Logger),
+		registrymirror.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic servicereadiness service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/servicereadiness#New
+	c.ServiceReadinessService, err = servicereadiness.New(
+		servicereadiness.WithClient(c.MySQL),
+		servicereadiness.WithLogger(c.Logger),
+		servicereadiness.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic stepattempt service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/stepattempt#New
+	c.StepAttemptService, err = stepattempt.New(
+		stepattempt.WithClient(c.MySQL),
+		stepattempt.WithLogger(c.Logger),
+		stepattempt.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic egressrule service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/egressrule#New
+	c.EgressRuleService, err = egressrule.New(
+		egressrule.WithClient(c.MySQL),
+		egressrule.WithLogger(c.Logger),
+		egressrule.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic previewenvironment service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/previewenvironment#New
+	c.PreviewEnvironmentService, err = previewenvironment.New(
+		previewenvironment.WithClient(c.MySQL),
+		previewenvironment.WithLogger(c.Logger),
+		previewenvironment.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic stagedwebhook service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/stagedwebhook#New
+	c.StagedWebhookService, err = stagedwebhook.New(
+		stagedwebhook.WithClient(c.MySQL),
+		stagedwebhook.WithLogger(c.Logger),
+		stagedwebhook.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic idempotencykey service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/idempotencykey#New
+	c.IdempotencyKeyService, err = idempotencykey.New(
+		idempotencykey.WithClient(c.MySQL),
+		idempotencykey.WithLogger(c.Logger),
+		idempotencykey.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic artifact service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/artifact#New
+	c.ArtifactService, err = artifact.New(
+		artifact.WithClient(c.MySQL),
+		artifact.WithLogger(c.Logger),
+		artifact.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic orghook service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/orghook#New
+	c.OrgHookService, err = orghook.New(
+		orghook.WithClient(c.MySQL),
+		orghook.WithEncryptionKey(c.config.EncryptionKey),
+		orghook.WithLogger(c.Logger),
+		orghook.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic webhookdelivery service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/webhookdelivery#New
+	c.WebhookDeliveryService, err = webhookdelivery.New(
+		webhookdelivery.WithClient(c.MySQL),
+		webhookdelivery.WithLogger(c.Logger),
+		webhookdelivery.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic requiredcontext service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/requiredcontext#New
+	c.RequiredContextService, err = requiredcontext.New(
+		requiredcontext.WithClient(c.MySQL),
+		requiredcontext.WithLogger(c.Logger),
+		requiredcontext.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildbudget service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildbudget#New
+	c.BuildBudgetService, err = buildbudget.New(
+		buildbudget.WithClient(c.MySQL),
+		buildbudget.WithLogger(c.Logger),
+		buildbudget.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic stepskip service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/stepskip#New
+	c.StepSkipService, err = stepskip.New(
+		stepskip.WithClient(c.MySQL),
+		stepskip.WithLogger(c.Logger),
+		stepskip.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic reposync service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/reposync#New
+	c.RepoSyncService, err = reposync.New(
+		reposync.WithClient(c.MySQL),
+		reposync.WithLogger(c.Logger),
+		reposync.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic lease service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/lease#New
+	c.LeaseService, err = lease.New(
+		lease.WithClient(c.MySQL),
+		lease.WithLogger(c.Logger),
+		lease.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic freeze service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/freeze#New
+	c.FreezeService, err = freeze.New(
+		freeze.WithClient(c.MySQL),
+		freeze.WithLogger(c.Logger),
+		freeze.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic freezeaudit service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/freezeaudit#New
+	c.FreezeAuditService, err = freezeaudit.New(
+		freezeaudit.WithClient(c.MySQL),
+		freezeaudit.WithLogger(c.Logger),
+		freezeaudit.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic costrate service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/costrate#New
+	c.CostRateService, err = costrate.New(
+		costrate.WithClient(c.MySQL),
+		costrate.WithLogger(c.Logger),
+		costrate.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic userscope service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/userscope#New
+	c.UserScopeService, err = userscope.New(
+		userscope.WithClient(c.MySQL),
+		userscope.WithLogger(c.Logger),
+		userscope.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic deprecationrule service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/deprecationrule#New
+	c.DeprecationRuleService, err = deprecationrule.New(
+		deprecationrule.WithClient(c.MySQL),
+		deprecationrule.WithLogger(c.Logger),
+		deprecationrule.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic builddeprecation service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/builddeprecation#New
+	c.BuildDeprecationService, err = builddeprecation.New(
+		builddeprecation.WithClient(c.MySQL),
+		builddeprecation.WithLogger(c.Logger),
+		builddeprecation.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic clonesetting service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/clonesetting#New
+	c.CloneSettingService, err = clonesetting.New(
+		clonesetting.WithClient(c.MySQL),
+		clonesetting.WithLogger(c.Logger),
+		clonesetting.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic ephemeralregistration service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/ephemeralregistration#New
+	c.EphemeralRegistrationService, err = ephemeralregistration.New(
+		ephemeralregistration.WithClient(c.MySQL),
+		ephemeralregistration.WithLogger(c.Logger),
+		ephemeralregistration.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic migration service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/migration#New
+	c.MigrationService, err = migration.New(
+		migration.WithClient(c.MySQL),
+		migration.WithLogger(c.Logger),
+		migration.WithSkipCreation(c.config.SkipCreation),
+		migration.WithBaseline(c.baseline),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic webhookintake service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/webhookintake#New
+	c.WebhookIntakeService, err = webhookintake.New(
+		webhookintake.WithClient(c.MySQL),
+		webhookintake.WithLogger(c.Logger),
+		webhookintake.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic usersession service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/usersession#New
+	c.UserSessionService, err = usersession.New(
+		usersession.WithClient(c.MySQL),
+		usersession.WithLogger(c.Logger),
+		usersession.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic repovariable service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/repovariable#New
+	c.RepoVariableService, err = repovariable.New(
+		repovariable.WithClient(c.MySQL),
+		repovariable.WithEncryptionKey(c.config.EncryptionKey),
+		repovariable.WithLogger(c.Logger),
+		repovariable.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic announcement service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/announcement#New
+	c.AnnouncementService, err = announcement.New(
+		announcement.WithClient(c.MySQL),
+		announcement.WithLogger(c.Logger),
+		announcement.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildredaction service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildredaction#New
+	c.BuildRedactionService, err = buildredaction.New(
+		buildredaction.WithClient(c.MySQL),
+		buildredaction.WithLogger(c.Logger),
+		buildredaction.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic partition service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/partition#New
+	c.PartitionService, err = partition.New(
+		partition.WithClient(c.MySQL),
+		partition.WithLogger(c.Logger),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic retentionpolicy service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/retentionpolicy#New
+	c.RetentionPolicyService, err = retentionpolicy.New(
+		retentionpolicy.WithClient(c.MySQL),
+		retentionpolicy.WithLogger(c.Logger),
+		retentionpolicy.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic leakfinding service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/leakfinding#New
+	c.LeakFindingService, err = leakfinding.New(
+		leakfinding.WithClient(c.MySQL),
+		leakfinding.WithLogger(c.Logger),
+		leakfinding.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
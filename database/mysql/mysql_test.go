@@ -0,0 +1,520 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/database/announcement"
+	"github.com/go-vela/server/database/artifact"
+	"github.com/go-vela/server/database/buildbudget"
+	"github.com/go-vela/server/database/buildcredential"
+	"github.com/go-vela/server/database/builddeprecation"
+	"github.com/go-vela/server/database/buildimage"
+	"github.com/go-vela/server/database/buildredaction"
+	"github.com/go-vela/server/database/canaryrun"
+	"github.com/go-vela/server/database/capacitysnapshot"
+	"github.com/go-vela/server/database/clonesetting"
+	"github.com/go-vela/server/database/costrate"
+	"github.com/go-vela/server/database/deprecationrule"
+	"github.com/go-vela/server/database/egressrule"
+	"github.com/go-vela/server/database/ephemeralregistration"
+	"github.com/go-vela/server/database/export"
+	"github.com/go-vela/server/database/freeze"
+	"github.com/go-vela/server/database/freezeaudit"
+	"github.com/go-vela/server/database/hook"
+	"github.com/go-vela/server/database/idempotencykey"
+	"github.com/go-vela/server/database/label"
+	"github.com/go-vela/server/database/leakfinding"
+	"github.com/go-vela/server/database/lease"
+	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/migration"
+	"github.com/go-vela/server/database/mysql/ddl"
+	"github.com/go-vela/server/database/orghook"
+	"github.com/go-vela/server/database/pipeline"
+	"github.com/go-vela/server/database/previewenvironment"
+	"github.com/go-vela/server/database/registrycredential"
+	"github.com/go-vela/server/database/registrymirror"
+	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/reposync"
+	"github.com/go-vela/server/database/repovariable"
+	"github.com/go-vela/server/database/requiredcontext"
+	"github.com/go-vela/server/database/retentionpolicy"
+	"github.com/go-vela/server/database/schedule"
+	"github.com/go-vela/server/database/servicereadiness"
+	"github.com/go-vela/server/database/stagedwebhook"
+	"github.com/go-vela/server/database/stepattempt"
+	"github.com/go-vela/server/database/stepskip"
+	"github.com/go-vela/server/database/template"
+	"github.com/go-vela/server/database/triggertoken"
+	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/server/database/userscope"
+	"github.com/go-vela/server/database/usersession"
+	"github.com/go-vela/server/database/webhookdelivery"
+	"github.com/go-vela/server/database/webhookintake"
+	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/server/database/workergroup"
+	"github.com/go-vela/types/library"
+)
+
+func TestMySQL_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+		want    string
+	}{
+		{
+			failure: true,
+			address: "foo:bar@tcp(localhost:3306)/vela",
+			want:    "foo:bar@tcp(localhost:3306)/vela",
+		},
+		{
+			failure: true,
+			address: "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(
+			WithAddress(test.address),
+			WithCompressionLevel(3),
+			WithConnectionLife(10*time.Second),
+			WithConnectionIdle(5),
+			WithConnectionOpen(20),
+			WithEncryptionKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+			WithSkipCreation(false),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
+
+func TestMySQL_setupDatabase(t *testing.T) {
+	// setup types
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the ping
+	_mock.ExpectPing()
+
+	// setup the skip test database client
+	_skipDatabase, _skipMock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new skip mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _skipDatabase.MySQL.DB(); _sql.Close() }()
+
+	err = WithSkipCreation(true)(_skipDatabase)
+	if err != nil {
+		t.Errorf("unable to set SkipCreation for mysql test database: %v", err)
+	}
+
+	// ensure the mock expects the ping
+	_skipMock.ExpectPing()
+
+	tests := []struct {
+		failure  bool
+		database *client
+	}{
+		{
+			failure:  false,
+			database: _database,
+		},
+		{
+			failure:  false,
+			database: _skipDatabase,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := setupDatabase(test.database)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("setupDatabase should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("setupDatabase returned err: %v", err)
+		}
+	}
+}
+
+func TestMySQL_baseline(t *testing.T) {
+	// setup types
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the hook queries
+	_mock.ExpectExec(hook.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the label queries
+	_mock.ExpectExec(label.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the log queries
+	_mock.ExpectExec(log.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreateMySQLLineTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the pipeline queries
+	_mock.ExpectExec(pipeline.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the repo queries
+	_mock.ExpectExec(repo.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the schedule queries
+	_mock.ExpectExec(schedule.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreateMySQLRunTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the template queries
+	_mock.ExpectExec(template.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the triggertoken queries
+	_mock.ExpectExec(triggertoken.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the user queries
+	_mock.ExpectExec(user.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the worker queries
+	_mock.ExpectExec(worker.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the workergroup queries
+	_mock.ExpectExec(workergroup.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the canaryrun queries
+	_mock.ExpectExec(canaryrun.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the capacitysnapshot queries
+	_mock.ExpectExec(capacitysnapshot.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the export queries
+	_mock.ExpectExec(export.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrycredential queries
+	_mock.ExpectExec(registrycredential.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildcredential queries
+	_mock.ExpectExec(buildcredential.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildimage queries
+	_mock.ExpectExec(buildimage.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrymirror queries
+	_mock.ExpectExec(registrymirror.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the servicereadiness queries
+	_mock.ExpectExec(servicereadiness.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepattempt queries
+	_mock.ExpectExec(stepattempt.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the egressrule queries
+	_mock.ExpectExec(egressrule.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the previewenvironment queries
+	_mock.ExpectExec(previewenvironment.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stagedwebhook queries
+	_mock.ExpectExec(stagedwebhook.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the idempotencykey queries
+	_mock.ExpectExec(idempotencykey.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the artifact queries
+	_mock.ExpectExec(artifact.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the orghook queries
+	_mock.ExpectExec(orghook.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookdelivery queries
+	_mock.ExpectExec(webhookdelivery.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the requiredcontext queries
+	_mock.ExpectExec(requiredcontext.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildbudget queries
+	_mock.ExpectExec(buildbudget.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepskip queries
+	_mock.ExpectExec(stepskip.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the reposync queries
+	_mock.ExpectExec(reposync.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the lease queries
+	_mock.ExpectExec(lease.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freeze queries
+	_mock.ExpectExec(freeze.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freezeaudit queries
+	_mock.ExpectExec(freezeaudit.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the costrate queries
+	_mock.ExpectExec(costrate.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the userscope queries
+	_mock.ExpectExec(userscope.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the deprecationrule queries
+	_mock.ExpectExec(deprecationrule.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the builddeprecation queries
+	_mock.ExpectExec(builddeprecation.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the clonesetting queries
+	_mock.ExpectExec(clonesetting.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the ephemeralregistration queries
+	_mock.ExpectExec(ephemeralregistration.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookintake queries
+	_mock.ExpectExec(webhookintake.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the usersession queries
+	_mock.ExpectExec(usersession.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the repovariable queries
+	_mock.ExpectExec(repovariable.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the announcement queries
+	_mock.ExpectExec(announcement.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildredaction queries
+	_mock.ExpectExec(buildredaction.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the retentionpolicy queries
+	_mock.ExpectExec(retentionpolicy.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the leakfinding queries
+	_mock.ExpectExec(leakfinding.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// ensure the mock expects the table queries
+	_mock.ExpectExec(ddl.CreateBuildTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateSecretTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateServiceTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateStepTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// run test
+	err = _database.baseline(_database.MySQL, _database.Driver())
+	if err != nil {
+		t.Errorf("baseline returned err: %v", err)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("baseline didn't send the expected queries: %v", err)
+	}
+}
+
+func TestMySQL_createTables(t *testing.T) {
+	// setup types
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the table queries
+	_mock.ExpectExec(ddl.CreateBuildTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateSecretTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateServiceTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateStepTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := createTables(_database)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("createTables should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("createTables returned err: %v", err)
+		}
+	}
+}
+
+func TestMySQL_createServices(t *testing.T) {
+	// setup types
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the hook queries
+	_mock.ExpectExec(hook.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the label queries
+	_mock.ExpectExec(label.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the log queries
+	_mock.ExpectExec(log.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreateMySQLLineTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the pipeline queries
+	_mock.ExpectExec(pipeline.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the repo queries
+	_mock.ExpectExec(repo.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the schedule queries
+	_mock.ExpectExec(schedule.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreateMySQLRunTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the template queries
+	_mock.ExpectExec(template.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the triggertoken queries
+	_mock.ExpectExec(triggertoken.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the user queries
+	_mock.ExpectExec(user.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the worker queries
+	_mock.ExpectExec(worker.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the workergroup queries
+	_mock.ExpectExec(workergroup.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the canaryrun queries
+	_mock.ExpectExec(canaryrun.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the capacitysnapshot queries
+	_mock.ExpectExec(capacitysnapshot.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the export queries
+	_mock.ExpectExec(export.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrycredential queries
+	_mock.ExpectExec(registrycredential.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildcredential queries
+	_mock.ExpectExec(buildcredential.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildimage queries
+	_mock.ExpectExec(buildimage.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrymirror queries
+	_mock.ExpectExec(registrymirror.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the servicereadiness queries
+	_mock.ExpectExec(servicereadiness.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepattempt queries
+	_mock.ExpectExec(stepattempt.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the egressrule queries
+	_mock.ExpectExec(egressrule.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the previewenvironment queries
+	_mock.ExpectExec(previewenvironment.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stagedwebhook queries
+	_mock.ExpectExec(stagedwebhook.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the idempotencykey queries
+	_mock.ExpectExec(idempotencykey.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the artifact queries
+	_mock.ExpectExec(artifact.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the orghook queries
+	_mock.ExpectExec(orghook.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookdelivery queries
+	_mock.ExpectExec(webhookdelivery.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the requiredcontext queries
+	_mock.ExpectExec(requiredcontext.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildbudget queries
+	_mock.ExpectExec(buildbudget.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepskip queries
+	_mock.ExpectExec(stepskip.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the reposync queries
+	_mock.ExpectExec(reposync.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the lease queries
+	_mock.ExpectExec(lease.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freeze queries
+	_mock.ExpectExec(freeze.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freezeaudit queries
+	_mock.ExpectExec(freezeaudit.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the costrate queries
+	_mock.ExpectExec(costrate.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the userscope queries
+	_mock.ExpectExec(userscope.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the deprecationrule queries
+	_mock.ExpectExec(deprecationrule.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the builddeprecation queries
+	_mock.ExpectExec(builddeprecation.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the clonesetting queries
+	_mock.ExpectExec(clonesetting.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the ephemeralregistration queries
+	_mock.ExpectExec(ephemeralregistration.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the migration queries
+	_mock.ExpectExec(migration.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookintake queries
+	_mock.ExpectExec(webhookintake.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the usersession queries
+	_mock.ExpectExec(usersession.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the repovariable queries
+	_mock.ExpectExec(repovariable.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the announcement queries
+	_mock.ExpectExec(announcement.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildredaction queries
+	_mock.ExpectExec(buildredaction.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the retentionpolicy queries
+	_mock.ExpectExec(retentionpolicy.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the leakfinding queries
+	_mock.ExpectExec(leakfinding.CreateMySQLTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := createServices(_database, false)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("createServices should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("createServices returned err: %v", err)
+		}
+	}
+}
+
+// This will be used with the github.com/DATA-DOG/go-sqlmock
+// library to compare values that are otherwise not easily
+// compared. These typically would be values generated before
+// adding or updating them in the database.
+//
+// https://github.com/DATA-DOG/go-sqlmock#matching-arguments-like-timetime
+type AnyArgument struct{}
+
+// Match satisfies sqlmock.Argument interface.
+func (a AnyArgument) Match(v driver.Value) bool {
+	return true
+}
+
+// testRepo is a test helper function to create a
+// library Repo type with all fields set to their
+// zero values.
+func testRepo() *library.Repo {
+	i64 := int64(0)
+	i := 0
+	str := ""
+	b := false
+
+	return &library.Repo{
+		ID:           &i64,
+		PipelineType: &str,
+		UserID:       &i64,
+		Hash:         &str,
+		Org:          &str,
+		Name:         &str,
+		FullName:     &str,
+		Link:         &str,
+		Clone:        &str,
+		Branch:       &str,
+		BuildLimit:   &i64,
+		Timeout:      &i64,
+		Counter:      &i,
+		Visibility:   &str,
+		Private:      &b,
+		Trusted:      &b,
+		Active:       &b,
+		AllowPull:    &b,
+		AllowPush:    &b,
+		AllowDeploy:  &b,
+		AllowTag:     &b,
+		AllowComment: &b,
+		PreviousName: &str,
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/blob"
+	"github.com/go-vela/server/kms"
+)
+
+// ClientOpt represents a configuration option to initialize the database client for MySQL.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address in the database client for MySQL.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in mysql database client")
+
+		// check if the MySQL address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no MySQL address provided")
+		}
+
+		// set the address in the mysql client
+		c.config.Address = address
+
+		return nil
+	}
+}
+
+// WithCompressionAlgorithm sets the compression algorithm in the database client for MySQL.
+func WithCompressionAlgorithm(algorithm string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring compression algorithm in mysql database client")
+
+		// set the compression algorithm in the mysql client
+		c.config.CompressionAlgorithm = algorithm
+
+		return nil
+	}
+}
+
+// WithCompressionLevel sets the compression level in the database client for MySQL.
+func WithCompressionLevel(level int) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring compression level in mysql database client")
+
+		// set the compression level in the mysql client
+		c.config.CompressionLevel = level
+
+		return nil
+	}
+}
+
+// WithConnectionLife sets the connection duration in the database client for MySQL.
+func WithConnectionLife(duration time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring connection duration in mysql database client")
+
+		// set the connection duration in the mysql client
+		c.config.ConnectionLife = duration
+
+		return nil
+	}
+}
+
+// WithConnectionIdle sets the maximum idle connections in the database client for MySQL.
+func WithConnectionIdle(idle int) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring maximum idle connections in mysql database client")
+
+		// set the maximum idle connections in the mysql client
+		c.config.ConnectionIdle = idle
+
+		return nil
+	}
+}
+
+// WithConnectionOpen sets the maximum open connections in the database client for MySQL.
+func WithConnectionOpen(open int) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring maximum open connections in mysql database client")
+
+		// set the maximum open connections in the mysql client
+		c.config.ConnectionOpen = open
+
+		return nil
+	}
+}
+
+// WithStatementTimeout sets the maximum duration of each statement in the database client for MySQL.
+func WithStatementTimeout(timeout time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring statement timeout in mysql database client")
+
+		// set the statement timeout in the mysql client
+		c.config.StatementTimeout = timeout
+
+		return nil
+	}
+}
+
+// WithSlowQueryThreshold sets the duration after which statements are logged as slow in the database client for MySQL.
+func WithSlowQueryThreshold(threshold time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring slow query threshold in mysql database client")
+
+		// set the slow query threshold in the mysql client
+		c.config.SlowQueryThreshold = threshold
+
+		return nil
+	}
+}
+
+// WithReplicas sets the read replica addresses in the database client for MySQL.
+func WithReplicas(replicas []string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring read replicas in mysql database client")
+
+		// set the read replicas in the mysql client
+		c.config.Replicas = replicas
+
+		return nil
+	}
+}
+
+// WithEncryptionKey sets the encryption key in the database client for MySQL.
+func WithEncryptionKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring encryption key in mysql database client")
+
+		// check if the MySQL encryption key provided is empty
+		if len(key) == 0 {
+			return fmt.Errorf("no MySQL encryption key provided")
+		}
+
+		// set the encryption key in the mysql client
+		c.config.EncryptionKey = key
+
+		return nil
+	}
+}
+
+// WithKMS sets the key management service in the database client for MySQL.
+func WithKMS(service kms.Service) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring key management service in mysql database client")
+
+		// set the key management service in the mysql client
+		c.config.KMS = service
+
+		return nil
+	}
+}
+
+// WithBlob sets the blob storage service in the database client for MySQL.
+func WithBlob(service blob.Service) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring blob storage service in mysql database client")
+
+		// set the blob storage service in the mysql client
+		c.config.Blob = service
+
+		return nil
+	}
+}
+
+// WithSkipCreation sets the skip creation logic in the database client for MySQL.
+func WithSkipCreation(skipCreation bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring skip creating objects in mysql database client")
+
+		// set to skip creating tables and indexes in the mysql client
+		c.config.SkipCreation = skipCreation
+
+		return nil
+	}
+}
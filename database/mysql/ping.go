@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Ping sends a "ping" request with backoff to the database.
+func (c *client) Ping() error {
+	c.Logger.Trace("sending ping requests to the database")
+
+	// create a loop to attempt ping requests 5 times
+	for i := 0; i < 5; i++ {
+		// capture database/sql database from gorm database
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB.DB
+		_sql, err := c.MySQL.DB()
+		if err != nil {
+			return err
+		}
+
+		// send ping request to database
+		//
+		// https://pkg.go.dev/database/sql#DB.Ping
+		err = _sql.Ping()
+		if err != nil {
+			c.Logger.Debugf("unable to ping database - retrying in %v", time.Duration(i)*time.Second)
+
+			// sleep for loop iteration in seconds
+			time.Sleep(time.Duration(i) * time.Second)
+
+			// continue to next iteration of the loop
+			continue
+		}
+
+		// able to ping database so return with no error
+		return nil
+	}
+
+	return fmt.Errorf("unable to successfully ping database")
+}
+
+// PingContext sends a single "ping" request to the database to
+// verify the connection is alive, without retrying on failure.
+func (c *client) PingContext(ctx context.Context) error {
+	c.Logger.Trace("sending ping request to the database")
+
+	// capture database/sql database from gorm database
+	//
+	// https://pkg.go.dev/gorm.io/gorm#DB.DB
+	_sql, err := c.MySQL.DB()
+	if err != nil {
+		return err
+	}
+
+	// send ping request to database
+	//
+	// https://pkg.go.dev/database/sql#DB.PingContext
+	return _sql.PingContext(ctx)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// ArchiveSecret archives a secret by unique ID in the database by
+// setting the time it was deleted instead of removing it. Archived
+// secrets are excluded from the other queries until they are restored.
+func (c *client) ArchiveSecret(ctx context.Context, id int64) error {
+	c.Logger.Tracef("archiving secret %d in the database", id)
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableSecret).
+		Where("id = ?", id).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", time.Now().UTC().Unix()).
+		Error
+}
+
+// RestoreSecret restores an archived secret by unique ID
+// in the database by clearing the time it was deleted.
+func (c *client) RestoreSecret(ctx context.Context, id int64) error {
+	c.Logger.Tracef("restoring secret %d in the database", id)
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableSecret).
+		Where("id = ?", id).
+		Where("deleted_at IS NOT NULL").
+		Update("deleted_at", nil).
+		Error
+}
+
+// GetArchivedSecret gets an archived secret by type, org, name
+// (repo or team) and secret name from the database.
+func (c *client) GetArchivedSecret(ctx context.Context, t, o, n, secretName string) (*library.Secret, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":    o,
+		"name":   n,
+		"secret": secretName,
+		"type":   t,
+	}).Tracef("getting archived %s secret %s for %s/%s from the database", t, secretName, o, n)
+
+	// variable to store query results
+	s := new(database.Secret)
+
+	query := c.MySQL.WithContext(ctx).
+		Table(constants.TableSecret).
+		Where("type = ?", t).
+		Where("org = ?", o).
+		Where("name = ?", secretName).
+		Where("deleted_at IS NOT NULL")
+
+	switch t {
+	case constants.SecretRepo:
+		query = query.Where("repo = ?", n)
+	case constants.SecretShared:
+		query = query.Where("team = ?", n)
+	}
+
+	// send query to the database and store result in variable
+	err := query.Take(s).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// archived secrets are returned without their value
+	s.Value = sql.NullString{}
+
+	return s.ToLibrary(), nil
+}
+
+// ListArchivedSecrets gets a list of the archived secrets from the
+// database, ordered by the most recently archived. The values of
+// the archived secrets are never returned.
+func (c *client) ListArchivedSecrets(ctx context.Context, page, perPage int) ([]*library.Secret, int64, error) {
+	c.Logger.Trace("listing archived secrets from the database")
+
+	// variables to store query results and return values
+	count := int64(0)
+	s := new([]database.Secret)
+	secrets := []*library.Secret{}
+
+	// count the results
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableSecret).
+		Where("deleted_at IS NOT NULL").
+		Count(&count).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return secrets, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = c.MySQL.WithContext(ctx).
+		Table(constants.TableSecret).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Order("id").
+		Limit(perPage).
+		Offset(offset).
+		Find(s).
+		Error
+	if err != nil {
+		return nil, count, err
+	}
+
+	// iterate through all query results
+	for _, secret := range *s {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := secret
+		tmp.Value = sql.NullString{}
+
+		// convert query result to library type
+		secrets = append(secrets, tmp.ToLibrary())
+	}
+
+	return secrets, count, nil
+}
+
+// PurgeArchivedSecrets permanently removes the secrets archived before
+// the provided time from the database and returns the count removed.
+func (c *client) PurgeArchivedSecrets(ctx context.Context, before int64) (int64, error) {
+	c.Logger.Tracef("purging secrets archived before %d from the database", before)
+
+	// send query to the database
+	result := c.MySQL.WithContext(ctx).
+		Table(constants.TableSecret).
+		Where("deleted_at IS NOT NULL").
+		Where("deleted_at < ?", before).
+		Delete(new(database.Secret))
+
+	return result.RowsAffected, result.Error
+}
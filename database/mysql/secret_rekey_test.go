@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestMySQL_Client_AuditSecretEncryption(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"id", "value"}).AddRow(1, "not encrypted")
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery("SELECT `id`,`value` FROM `secrets` WHERE id > ? ORDER BY id LIMIT 100").
+		WithArgs(0).
+		WillReturnRows(_rows)
+
+	_mock.ExpectQuery("SELECT `id`,`value` FROM `secrets` WHERE id > ? ORDER BY id LIMIT 100").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "value"}))
+
+	// run test
+	got, err := _database.AuditSecretEncryption(context.TODO())
+	if err != nil {
+		t.Errorf("AuditSecretEncryption returned err: %v", err)
+	}
+
+	if got.GetTotal() != 1 || got.GetReadable() != 0 || got.GetUnreadable() != 1 {
+		t.Errorf("AuditSecretEncryption is %v", got)
+	}
+}
+
+func TestMySQL_Client_RekeySecrets(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT `id`,`value` FROM `secrets` WHERE id > ? ORDER BY id LIMIT 100").
+		WithArgs(0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "value"}))
+
+	// run test
+	got, err := _database.RekeySecrets(context.TODO(), "Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE")
+	if err != nil {
+		t.Errorf("RekeySecrets returned err: %v", err)
+	}
+
+	if got != 0 {
+		t.Errorf("RekeySecrets is %v, want 0", got)
+	}
+}
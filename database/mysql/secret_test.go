@@ -0,0 +1,421 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/go-vela/server/database/mysql/dml"
+	"github.com/go-vela/types/library"
+
+	"gorm.io/gorm"
+)
+
+func TestMySQL_Client_GetSecret_Org(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("*")
+	_secret.SetName("bar")
+	_secret.SetValue("baz")
+	_secret.SetType("org")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Raw(dml.SelectOrgSecret, "foo", "bar").Statement
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "type", "org", "repo", "team", "name", "value", "images", "events", "allow_command", "created_at", "created_by", "updated_at", "updated_by"},
+	).AddRow(1, "org", "foo", "*", "", "bar", "baz", "{}", "{}", false, 1, "user", 1, "user2")
+
+	// ensure the mock expects the query for test case 1
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnRows(_rows)
+	// ensure the mock expects the error for test case 2
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnError(gorm.ErrRecordNotFound)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    *library.Secret
+	}{
+		{
+			failure: false,
+			want:    _secret,
+		},
+		{
+			failure: true,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetSecret(context.TODO(), "org", "foo", "*", "bar")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetSecret should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetSecret returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetSecret is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetSecret_Repo(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("repo")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Raw(dml.SelectRepoSecret, "foo", "bar", "baz").Statement
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "type", "org", "repo", "team", "name", "value", "images", "events", "allow_command", "created_at", "created_by", "updated_at", "updated_by"},
+	).AddRow(1, "repo", "foo", "bar", "", "baz", "foob", "{}", "{}", false, 1, "user", 1, "user2")
+
+	// ensure the mock expects the query for test case 1
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnRows(_rows)
+	// ensure the mock expects the error for test case 2
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnError(gorm.ErrRecordNotFound)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    *library.Secret
+	}{
+		{
+			failure: false,
+			want:    _secret,
+		},
+		{
+			failure: true,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetSecret(context.TODO(), "repo", "foo", "bar", "baz")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetSecret should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetSecret returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetSecret is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_GetSecret_Shared(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetTeam("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("shared")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Raw(dml.SelectSharedSecret, "foo", "bar", "baz").Statement
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "type", "org", "repo", "team", "name", "value", "images", "events", "allow_command", "created_at", "created_by", "updated_at", "updated_by"},
+	).AddRow(1, "shared", "foo", "", "bar", "baz", "foob", "{}", "{}", false, 1, "user", 1, "user2")
+
+	// ensure the mock expects the query for test case 1
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnRows(_rows)
+	// ensure the mock expects the error for test case 2
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnError(gorm.ErrRecordNotFound)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    *library.Secret
+	}{
+		{
+			failure: false,
+			want:    _secret,
+		},
+		{
+			failure: true,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetSecret(context.TODO(), "shared", "foo", "bar", "baz")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetSecret should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetSecret returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetSecret is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_CreateSecret(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("repo")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec("INSERT INTO `secrets` (`org`,`repo`,`team`,`name`,`value`,`type`,`images`,`events`,`allow_command`,`created_at`,`created_by`,`updated_at`,`updated_by`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs("foo", "bar", nil, "baz", AnyArgument{}, "repo", "{}", "{}", false, 1, "user", 1, "user2", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.CreateSecret(context.TODO(), _secret)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CreateSecret should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CreateSecret returned err: %v", err)
+		}
+	}
+}
+
+func TestMySQL_Client_UpdateSecret(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("repo")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec("UPDATE `secrets` SET `org`=?,`repo`=?,`team`=?,`name`=?,`value`=?,`type`=?,`images`=?,`events`=?,`allow_command`=?,`created_at`=?,`created_by`=?,`updated_at`=?,`updated_by`=? WHERE `id` = ?").
+		WithArgs("foo", "bar", nil, "baz", AnyArgument{}, "repo", "{}", "{}", false, 1, "user", time.Now().UTC().Unix(), "user2", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.UpdateSecret(context.TODO(), _secret)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("UpdateSecret should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("UpdateSecret returned err: %v", err)
+		}
+	}
+}
+
+func TestMySQL_Client_DeleteSecret(t *testing.T) {
+	// setup types
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Exec(dml.DeleteSecret, 1).Statement
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(_query.SQL.String()).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.DeleteSecret(context.TODO(), 1)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("DeleteSecret should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("DeleteSecret returned err: %v", err)
+		}
+	}
+}
+
+// testSecret is a test helper function to create a
+// library Secret type with all fields set to their
+// zero values.
+func testSecret() *library.Secret {
+	i64 := int64(0)
+	str := ""
+	arr := []string{}
+	booL := false
+
+	return &library.Secret{
+		ID:           &i64,
+		Org:          &str,
+		Repo:         &str,
+		Team:         &str,
+		Name:         &str,
+		Value:        &str,
+		Type:         &str,
+		Images:       &arr,
+		Events:       &arr,
+		AllowCommand: &booL,
+		CreatedAt:    &i64,
+		CreatedBy:    &str,
+		UpdatedAt:    &i64,
+		UpdatedBy:    &str,
+	}
+}
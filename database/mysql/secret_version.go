@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// GetSecretVersion gets the version of a
+// secret by unique ID from the database.
+func (c *client) GetSecretVersion(ctx context.Context, id int64) (int64, error) {
+	c.Logger.Tracef("getting version of secret %d from the database", id)
+
+	// variable to store query results
+	versions := []int64{}
+
+	// send query to the database and store result in variable
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableSecret).
+		Where("id = ?", id).
+		Where("deleted_at IS NULL").
+		Pluck("version", &versions).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	// check if the secret exists
+	if len(versions) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	return versions[0], nil
+}
+
+// UpdateSecretIfVersion updates a secret in the database when its
+// version still matches the provided version. It returns the new
+// version of the secret.
+//
+// ErrVersionConflict is returned when the secret was updated
+// since the provided version was captured.
+func (c *client) UpdateSecretIfVersion(ctx context.Context, s *library.Secret, version int64) (int64, error) {
+	// create log fields from secret metadata
+	fields := logrus.Fields{
+		"org":    s.GetOrg(),
+		"repo":   s.GetRepo(),
+		"secret": s.GetName(),
+		"type":   s.GetType(),
+	}
+
+	// check if secret is a shared secret
+	if strings.EqualFold(s.GetType(), constants.SecretShared) {
+		// update log fields from secret metadata
+		fields = logrus.Fields{
+			"org":    s.GetOrg(),
+			"team":   s.GetTeam(),
+			"secret": s.GetName(),
+			"type":   s.GetType(),
+		}
+	}
+
+	c.Logger.WithFields(fields).Tracef("updating %s secret %s at version %d in the database", s.GetType(), s.GetName(), version)
+
+	// cast to database type
+	secret := database.SecretFromLibrary(s)
+
+	// validate the necessary fields are populated
+	err := secret.Validate()
+	if err != nil {
+		return 0, err
+	}
+
+	// encrypt the value for the secret
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Secret.Encrypt
+	err = secret.Encrypt(c.config.EncryptionKey)
+	if err != nil {
+		return 0, fmt.Errorf("unable to encrypt secret %s: %w", s.GetName(), err)
+	}
+
+	// send queries to the database within a single transaction
+	err = c.MySQL.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// increment the version only when it still matches,
+		// locking the row until the secret is updated
+		result := tx.Table(constants.TableSecret).
+			Where("id = ?", s.GetID()).
+			Where("version = ?", version).
+			Where("deleted_at IS NULL").
+			Update("version", gorm.Expr("version + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return types.ErrVersionConflict
+		}
+
+		return tx.Table(constants.TableSecret).
+			Select("*").
+			Updates(secret.Nullify()).
+			Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version + 1, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/database/mysql/dml"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+
+	"gorm.io/gorm"
+)
+
+// GetService gets a service by number and build ID from the database.
+func (c *client) GetService(ctx context.Context, number int, b *library.Build) (*library.Service, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"build":   b.GetNumber(),
+		"service": number,
+	}).Tracef("getting service %d for build %d from the database", number, b.GetNumber())
+
+	// variable to store query results
+	s := new(database.Service)
+
+	// send query to the database and store result in variable
+	result := c.MySQL.WithContext(ctx).
+		Table(constants.TableService).
+		Raw(dml.SelectBuildService, b.GetID(), number).
+		Scan(s)
+
+	// check if the query returned a record not found error or no rows were returned
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) || result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.ToLibrary(), result.Error
+}
+
+// CreateService creates a new service in the database.
+func (c *client) CreateService(ctx context.Context, s *library.Service) error {
+	c.Logger.WithFields(logrus.Fields{
+		"service": s.GetNumber(),
+	}).Tracef("creating service %s in the database", s.GetName())
+
+	// cast to database type
+	service := database.ServiceFromLibrary(s)
+
+	// validate the necessary fields are populated
+	err := service.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableService).
+		Create(service).Error
+}
+
+// CreateServices creates a list of new services in the database
+// with a single query. The IDs from the created services
+// are populated in the provided services.
+func (c *client) CreateServices(ctx context.Context, s []*library.Service) error {
+	c.Logger.Tracef("creating %d services in the database", len(s))
+
+	// short-circuit if there are no services to create
+	if len(s) == 0 {
+		return nil
+	}
+
+	// variable to store the services to create
+	services := make([]*database.Service, 0, len(s))
+
+	for _, service := range s {
+		// cast to database type
+		tmp := database.ServiceFromLibrary(service)
+
+		// validate the necessary fields are populated
+		err := tmp.Validate()
+		if err != nil {
+			return err
+		}
+
+		services = append(services, tmp)
+	}
+
+	// send query to the database
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableService).
+		Create(&services).Error
+	if err != nil {
+		return err
+	}
+
+	// populate the IDs from the created services
+	for i, service := range services {
+		s[i].SetID(service.ID.Int64)
+	}
+
+	return nil
+}
+
+// UpdateService updates a service in the database.
+func (c *client) UpdateService(ctx context.Context, s *library.Service) error {
+	c.Logger.WithFields(logrus.Fields{
+		"service": s.GetNumber(),
+	}).Tracef("updating service %s in the database", s.GetName())
+
+	// cast to database type
+	service := database.ServiceFromLibrary(s)
+
+	// validate the necessary fields are populated
+	err := service.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableService).
+		Save(service).Error
+}
+
+// DeleteService deletes a service by unique ID from the database.
+func (c *client) DeleteService(ctx context.Context, id int64) error {
+	c.Logger.Tracef("deleting service %d from the database", id)
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableService).
+		Exec(dml.DeleteService, id).Error
+}
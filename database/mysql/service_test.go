@@ -0,0 +1,335 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/go-vela/server/database/mysql/dml"
+	"github.com/go-vela/types/library"
+
+	"gorm.io/gorm"
+)
+
+func TestMySQL_Client_GetService(t *testing.T) {
+	// setup types
+	_build := testBuild()
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+
+	_service := testService()
+	_service.SetID(1)
+	_service.SetRepoID(1)
+	_service.SetBuildID(1)
+	_service.SetNumber(1)
+	_service.SetName("foo")
+	_service.SetImage("bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Raw(dml.SelectBuildService, 1, 1).Statement
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "number", "name", "image", "status", "error", "exit_code", "created", "started", "finished", "host", "runtime", "distribution"},
+	).AddRow(1, 1, 1, 1, "foo", "bar", "", "", 0, 0, 0, 0, "", "", "")
+
+	// ensure the mock expects the query for test case 1
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnRows(_rows)
+	// ensure the mock expects the error for test case 2
+	_mock.ExpectQuery(_query.SQL.String()).WillReturnError(gorm.ErrRecordNotFound)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    *library.Service
+	}{
+		{
+			failure: false,
+			want:    _service,
+		},
+		{
+			failure: true,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetService(context.TODO(), 1, _build)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetService should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetService returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetService is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestMySQL_Client_CreateService(t *testing.T) {
+	// setup types
+	_service := testService()
+	_service.SetID(1)
+	_service.SetRepoID(1)
+	_service.SetBuildID(1)
+	_service.SetNumber(1)
+	_service.SetName("foo")
+	_service.SetImage("bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec("INSERT INTO `services` (`build_id`,`repo_id`,`number`,`name`,`image`,`status`,`error`,`exit_code`,`created`,`started`,`finished`,`host`,`runtime`,`distribution`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(1, 1, 1, "foo", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.CreateService(context.TODO(), _service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CreateService should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CreateService returned err: %v", err)
+		}
+	}
+}
+
+func TestMySQL_Client_CreateServices(t *testing.T) {
+	// setup types
+	_one := testService()
+	_one.SetID(1)
+	_one.SetRepoID(1)
+	_one.SetBuildID(1)
+	_one.SetNumber(1)
+	_one.SetName("foo")
+	_one.SetImage("bar")
+
+	_two := testService()
+	_two.SetID(2)
+	_two.SetRepoID(1)
+	_two.SetBuildID(1)
+	_two.SetNumber(2)
+	_two.SetName("baz")
+	_two.SetImage("bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec("INSERT INTO `services` (`build_id`,`repo_id`,`number`,`name`,`image`,`status`,`error`,`exit_code`,`created`,`started`,`finished`,`host`,`runtime`,`distribution`,`id`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)").
+		WithArgs(1, 1, 1, "foo", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, 1, 1, 1, 2, "baz", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, 2).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		services []*library.Service
+	}{
+		{
+			failure:  false,
+			services: []*library.Service{_one, _two},
+		},
+		{
+			failure:  false,
+			services: []*library.Service{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.CreateServices(context.TODO(), test.services)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CreateServices should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CreateServices returned err: %v", err)
+		}
+
+		for i, service := range test.services {
+			if service.GetID() != int64(i+1) {
+				t.Errorf("CreateServices ID is %d, want %d", service.GetID(), i+1)
+			}
+		}
+	}
+}
+
+func TestMySQL_Client_UpdateService(t *testing.T) {
+	// setup types
+	_service := testService()
+	_service.SetID(1)
+	_service.SetRepoID(1)
+	_service.SetBuildID(1)
+	_service.SetNumber(1)
+	_service.SetName("foo")
+	_service.SetImage("bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec("UPDATE `services` SET `build_id`=?,`repo_id`=?,`number`=?,`name`=?,`image`=?,`status`=?,`error`=?,`exit_code`=?,`created`=?,`started`=?,`finished`=?,`host`=?,`runtime`=?,`distribution`=? WHERE `id` = ?").
+		WithArgs(1, 1, 1, "foo", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.UpdateService(context.TODO(), _service)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("UpdateService should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("UpdateService returned err: %v", err)
+		}
+	}
+}
+
+func TestMySQL_Client_DeleteService(t *testing.T) {
+	// setup types
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new mysql test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _database.MySQL.Session(&gorm.Session{DryRun: true}).Exec(dml.DeleteService, 1).Statement
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(_query.SQL.String()).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+	}{
+		{
+			failure: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.DeleteService(context.TODO(), 1)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("DeleteService should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("DeleteService returned err: %v", err)
+		}
+	}
+}
+
+// testService is a test helper function to create a
+// library Service type with all fields set to their
+// zero values.
+func testService() *library.Service {
+	i64 := int64(0)
+	i := 0
+	str := ""
+
+	return &library.Service{
+		ID:           &i64,
+		BuildID:      &i64,
+		RepoID:       &i64,
+		Number:       &i,
+		Name:         &str,
+		Image:        &str,
+		Status:       &str,
+		Error:        &str,
+		ExitCode:     &i,
+		Created:      &i64,
+		Started:      &i64,
+		Finished:     &i64,
+		Host:         &str,
+		Runtime:      &str,
+		Distribution: &str,
+	}
+}
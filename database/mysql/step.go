@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/database/mysql/dml"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+
+	"gorm.io/gorm"
+)
+
+// GetStep gets a step by number and build ID from the database.
+func (c *client) GetStep(ctx context.Context, number int, b *library.Build) (*library.Step, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"step":  number,
+	}).Tracef("getting step %d for build %d from the database", number, b.GetNumber())
+
+	// variable to store query results
+	s := new(database.Step)
+
+	// send query to the database and store result in variable
+	result := c.MySQL.WithContext(ctx).
+		Table(constants.TableStep).
+		Raw(dml.SelectBuildStep, b.GetID(), number).
+		Scan(s)
+
+	// check if the query returned a record not found error or no rows were returned
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) || result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return s.ToLibrary(), result.Error
+}
+
+// CreateStep creates a new step in the database.
+func (c *client) CreateStep(ctx context.Context, s *library.Step) error {
+	c.Logger.WithFields(logrus.Fields{
+		"step": s.GetNumber(),
+	}).Tracef("creating step %s in the database", s.GetName())
+
+	// cast to database type
+	step := database.StepFromLibrary(s)
+
+	// validate the necessary fields are populated
+	err := step.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableStep).
+		Create(step).Error
+}
+
+// CreateSteps creates a list of new steps in the database
+// with a single query. The IDs from the created steps
+// are populated in the provided steps.
+func (c *client) CreateSteps(ctx context.Context, s []*library.Step) error {
+	c.Logger.Tracef("creating %d steps in the database", len(s))
+
+	// short-circuit if there are no steps to create
+	if len(s) == 0 {
+		return nil
+	}
+
+	// variable to store the steps to create
+	steps := make([]*database.Step, 0, len(s))
+
+	for _, step := range s {
+		// cast to database type
+		tmp := database.StepFromLibrary(step)
+
+		// validate the necessary fields are populated
+		err := tmp.Validate()
+		if err != nil {
+			return err
+		}
+
+		steps = append(steps, tmp)
+	}
+
+	// send query to the database
+	err := c.MySQL.WithContext(ctx).
+		Table(constants.TableStep).
+		Create(&steps).Error
+	if err != nil {
+		return err
+	}
+
+	// populate the IDs from the created steps
+	for i, step := range steps {
+		s[i].SetID(step.ID.Int64)
+	}
+
+	return nil
+}
+
+// UpdateStep updates a step in the database.
+func (c *client) UpdateStep(ctx context.Context, s *library.Step) error {
+	c.Logger.WithFields(logrus.Fields{
+		"step": s.GetNumber(),
+	}).Tracef("updating step %s in the database", s.GetName())
+
+	// cast to database type
+	step := database.StepFromLibrary(s)
+
+	// validate the necessary fields are populated
+	err := step.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableStep).
+		Save(step).Error
+}
+
+// DeleteStep deletes a step by unique ID from the database.
+func (c *client) DeleteStep(ctx context.Context, id int64) error {
+	c.Logger.Tracef("deleting step %d from the database", id)
+
+	// send query to the database
+	return c.MySQL.WithContext(ctx).
+		Table(constants.TableStep).
+		Exec(dml.DeleteStep, id).Error
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package mysql
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMySQL_Client_Transaction(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		err     error
+		failure bool
+	}{
+		{
+			name: "committed",
+		},
+		{
+			name:    "rolled back",
+			err:     errors.New("unable to create steps"),
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup the test database client
+			_database, _mock, err := NewTest()
+			if err != nil {
+				t.Errorf("unable to create new mysql test database: %v", err)
+			}
+
+			defer func() { _sql, _ := _database.MySQL.DB(); _sql.Close() }()
+
+			// ensure the mock expects the transaction
+			_mock.ExpectBegin()
+
+			if test.failure {
+				_mock.ExpectRollback()
+			} else {
+				_mock.ExpectCommit()
+			}
+
+			err = _database.Transaction(context.TODO(), func(tx interface{}) error {
+				_client, ok := tx.(*client)
+				if !ok {
+					t.Errorf("Transaction provided %T, want *client", tx)
+				}
+
+				if _client.MySQL == _database.MySQL {
+					t.Errorf("Transaction provided client not bound to the transaction")
+				}
+
+				if _client.PipelineService == nil {
+					t.Errorf("Transaction provided client without services")
+				}
+
+				return test.err
+			})
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Transaction should have returned err")
+				}
+			} else if err != nil {
+				t.Errorf("Transaction returned err: %v", err)
+			}
+
+			err = _mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("Transaction did not meet expectations: %v", err)
+			}
+		})
+	}
+}
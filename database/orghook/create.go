@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateOrgHook creates a new org hook in the database.
+func (e *engine) CreateOrgHook(ctx context.Context, o *api.OrgHook) error {
+	e.logger.WithFields(logrus.Fields{
+		"org": o.GetOrg(),
+	}).Tracef("creating org hook for %s in the database", o.GetOrg())
+
+	// cast the API type to database type
+	orgHook := types.OrgHookFromAPI(o)
+
+	// validate the necessary fields are populated
+	err := orgHook.Validate()
+	if err != nil {
+		return err
+	}
+
+	// encrypt the secret for the org hook
+	err = orgHook.Encrypt(e.config.EncryptionKey)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt org hook for %s: %w", o.GetOrg(), err)
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableOrgHook).
+		Create(orgHook).
+		Error
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestOrgHook_Engine_CreateOrgHook(t *testing.T) {
+	// setup types
+	_orgHook := testOrgHook()
+	_orgHook.SetID(1)
+	_orgHook.SetOrg("github")
+	_orgHook.SetWebhookID(123456)
+	_orgHook.SetSecret("superSecretHash")
+	_orgHook.SetAutoEnable(true)
+	_orgHook.SetUserID(1)
+	_orgHook.SetActive(true)
+	_orgHook.SetCreatedAt(1)
+	_orgHook.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "org_hooks"
+("org","webhook_id","secret","auto_enable","user_id","active","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`).
+		WithArgs("github", 123456, sqlmock.AnyArg(), true, 1, true, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateOrgHook(context.TODO(), _orgHook)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateOrgHook for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateOrgHook for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteOrgHook deletes an existing org hook from the database.
+func (e *engine) DeleteOrgHook(ctx context.Context, o *api.OrgHook) error {
+	e.logger.WithFields(logrus.Fields{
+		"org": o.GetOrg(),
+	}).Tracef("deleting org hook for %s from the database", o.GetOrg())
+
+	// cast the API type to database type
+	orgHook := types.OrgHookFromAPI(o)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableOrgHook).
+		Delete(orgHook).
+		Error
+}
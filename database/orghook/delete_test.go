@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestOrgHook_Engine_DeleteOrgHook(t *testing.T) {
+	// setup types
+	_orgHook := testOrgHook()
+	_orgHook.SetID(1)
+	_orgHook.SetOrg("github")
+	_orgHook.SetWebhookID(123456)
+	_orgHook.SetSecret("superSecretHash")
+	_orgHook.SetAutoEnable(true)
+	_orgHook.SetUserID(1)
+	_orgHook.SetActive(true)
+	_orgHook.SetCreatedAt(1)
+	_orgHook.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "org_hooks" WHERE "org_hooks"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateOrgHook(context.TODO(), _orgHook)
+	if err != nil {
+		t.Errorf("unable to create test org hook for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteOrgHook(context.TODO(), _orgHook)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteOrgHook for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteOrgHook for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
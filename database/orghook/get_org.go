@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// GetOrgHookForOrg gets an org hook by org from the database.
+func (e *engine) GetOrgHookForOrg(ctx context.Context, org string) (*api.OrgHook, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("getting org hook for %s from the database", org)
+
+	// variable to store query results
+	o := new(types.OrgHook)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableOrgHook).
+		Where("org = ?", org).
+		Take(o).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// decrypt the secret for the org hook
+	err = o.Decrypt(e.config.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt org hook for %s: %w", org, err)
+	}
+
+	return o.ToAPI(), nil
+}
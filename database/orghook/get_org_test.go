@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestOrgHook_Engine_GetOrgHookForOrg(t *testing.T) {
+	// setup types
+	_orgHook := testOrgHook()
+	_orgHook.SetID(1)
+	_orgHook.SetOrg("github")
+	_orgHook.SetWebhookID(123456)
+	_orgHook.SetSecret("superSecretHash")
+	_orgHook.SetAutoEnable(true)
+	_orgHook.SetUserID(1)
+	_orgHook.SetActive(true)
+	_orgHook.SetCreatedAt(1)
+	_orgHook.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "webhook_id", "secret", "auto_enable", "user_id", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", 123456, testEncrypted(t, _orgHook), true, 1, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "org_hooks" WHERE org = $1 LIMIT 1`).
+		WithArgs("github").
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateOrgHook(context.TODO(), _orgHook)
+	if err != nil {
+		t.Errorf("unable to create test org hook for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.OrgHook
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _orgHook,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _orgHook,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetOrgHookForOrg(context.TODO(), "github")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetOrgHookForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetOrgHookForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetOrgHookForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
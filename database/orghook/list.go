@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListOrgHooks gets a list of all org hooks from the database.
+func (e *engine) ListOrgHooks(ctx context.Context) ([]*api.OrgHook, error) {
+	e.logger.Trace("listing all org hooks from the database")
+
+	// variables to store query results and return value
+	o := new([]types.OrgHook)
+	hooks := []*api.OrgHook{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableOrgHook).
+		Order("org").
+		Find(&o).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, hook := range *o {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := hook
+
+		// decrypt the secret for the org hook
+		err = tmp.Decrypt(e.config.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt org hook for %s: %w", tmp.Org.String, err)
+		}
+
+		// convert query result to API type
+		hooks = append(hooks, tmp.ToAPI())
+	}
+
+	return hooks, nil
+}
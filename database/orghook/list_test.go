@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestOrgHook_Engine_ListOrgHooks(t *testing.T) {
+	// setup types
+	_orgHookOne := testOrgHook()
+	_orgHookOne.SetID(1)
+	_orgHookOne.SetOrg("github")
+	_orgHookOne.SetWebhookID(123456)
+	_orgHookOne.SetSecret("superSecretHash")
+	_orgHookOne.SetAutoEnable(true)
+	_orgHookOne.SetUserID(1)
+	_orgHookOne.SetActive(true)
+	_orgHookOne.SetCreatedAt(1)
+	_orgHookOne.SetCreatedBy("octocat")
+
+	_orgHookTwo := testOrgHook()
+	_orgHookTwo.SetID(2)
+	_orgHookTwo.SetOrg("octocat")
+	_orgHookTwo.SetWebhookID(654321)
+	_orgHookTwo.SetSecret("superSecretHash")
+	_orgHookTwo.SetAutoEnable(false)
+	_orgHookTwo.SetUserID(1)
+	_orgHookTwo.SetActive(true)
+	_orgHookTwo.SetCreatedAt(1)
+	_orgHookTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "webhook_id", "secret", "auto_enable", "user_id", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", 123456, testEncrypted(t, _orgHookOne), true, 1, true, 1, "octocat", 0, "").
+		AddRow(2, "octocat", 654321, testEncrypted(t, _orgHookTwo), false, 1, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "org_hooks" ORDER BY org`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateOrgHook(context.TODO(), _orgHookTwo)
+	if err != nil {
+		t.Errorf("unable to create test org hook for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateOrgHook(context.TODO(), _orgHookOne)
+	if err != nil {
+		t.Errorf("unable to create test org hook for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.OrgHook
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.OrgHook{_orgHookOne, _orgHookTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.OrgHook{_orgHookOne, _orgHookTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListOrgHooks(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListOrgHooks for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListOrgHooks for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListOrgHooks for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
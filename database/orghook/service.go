@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// OrgHookService represents the Vela interface for org
+// hook functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type OrgHookService interface {
+	// OrgHook Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateOrgHookTable defines a function that creates the org_hooks table.
+	CreateOrgHookTable(context.Context, string) error
+
+	// OrgHook Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateOrgHook defines a function that creates a new org hook.
+	CreateOrgHook(context.Context, *api.OrgHook) error
+	// DeleteOrgHook defines a function that deletes an existing org hook.
+	DeleteOrgHook(context.Context, *api.OrgHook) error
+	// GetOrgHookForOrg defines a function that gets an org hook by org.
+	GetOrgHookForOrg(context.Context, string) (*api.OrgHook, error)
+	// ListOrgHooks defines a function that gets a list of all org hooks.
+	ListOrgHooks(context.Context) ([]*api.OrgHook, error)
+	// UpdateOrgHook defines a function that updates an existing org hook.
+	UpdateOrgHook(context.Context, *api.OrgHook) error
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package orghook
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableOrgHook represents the name of the table for org hooks.
+	TableOrgHook = "org_hooks"
+
+	// CreatePostgresTable represents a query to create the Postgres org_hooks table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+org_hooks (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	webhook_id    INTEGER,
+	secret        VARCHAR(1000),
+	auto_enable   BOOLEAN,
+	user_id       INTEGER,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite org_hooks table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+org_hooks (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	webhook_id    INTEGER,
+	secret        TEXT,
+	auto_enable   BOOLEAN,
+	user_id       INTEGER,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(org)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL org_hooks table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+org_hooks (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	webhook_id    INTEGER,
+	secret        VARCHAR(1000),
+	auto_enable   BOOLEAN,
+	user_id       INTEGER,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org)
+);
+`
+)
+
+// CreateOrgHookTable creates the org_hooks table in the database.
+func (e *engine) CreateOrgHookTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating org_hooks table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the org_hooks table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the org_hooks table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the org_hooks table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-vela/server/database/replica"
+	"github.com/go-vela/types/constants"
+
+	"gorm.io/gorm"
+)
+
+// PartitionTables converts the builds and logs tables in the database into tables partitioned by month.
+//
+// Partitioning is opt-in, so the tables are only converted
+// when enabled for the partitioner. Tables that are already
+// partitioned are skipped.
+func (e *engine) PartitionTables(ctx context.Context) error {
+	e.logger.Trace("partitioning tables in the database")
+
+	// check if the database supports native partitioning
+	if e.client.Config.Dialector.Name() != constants.DriverPostgres {
+		return ErrUnsupported
+	}
+
+	// send queries to the primary database in a transaction
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Primary
+	return e.client.WithContext(replica.Primary(ctx)).Transaction(func(tx *gorm.DB) error {
+		return partitionTables(tx, time.Now().UTC())
+	})
+}
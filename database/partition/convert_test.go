@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPartition_Engine_PartitionTables(t *testing.T) {
+	// setup types
+	_partitioned := strings.Replace(SelectPartitioned, "?", "$1", 1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the tables are already partitioned
+	_mock.ExpectBegin()
+	_mock.ExpectQuery(_partitioned).WithArgs("builds").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	_mock.ExpectQuery(_partitioned).WithArgs("logs").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		name     string
+		database *engine
+		want     error
+	}{
+		{
+			name:     "postgres",
+			database: _postgres,
+			want:     nil,
+		},
+		{
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     ErrUnsupported,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.PartitionTables(context.TODO())
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("PartitionTables for %s returned err %v, want %v", test.name, err, test.want)
+			}
+		})
+	}
+
+	err := _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("PartitionTables didn't send the expected queries: %v", err)
+	}
+}
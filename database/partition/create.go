@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/replica"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// CreatePartition creates the partition of the table for the month of the provided time in the database.
+//
+// Any row created within the month that was stored in the default
+// partition of the table is moved to the new partition.
+func (e *engine) CreatePartition(ctx context.Context, table string, t time.Time) (*api.Partition, error) {
+	e.logger.WithFields(logrus.Fields{
+		"table": table,
+		"month": month(t).Format("2006-01"),
+	}).Tracef("creating partition for %s table in the database", table)
+
+	// check if the database supports native partitioning
+	if e.client.Config.Dialector.Name() != constants.DriverPostgres {
+		return nil, ErrUnsupported
+	}
+
+	// check if the table is partitioned by month
+	if !isTable(table) {
+		return nil, fmt.Errorf("%s table is not partitioned", table)
+	}
+
+	var p *api.Partition
+
+	// send queries to the primary database in a transaction
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Primary
+	err := e.client.WithContext(replica.Primary(ctx)).Transaction(func(tx *gorm.DB) error {
+		var err error
+
+		p, err = create(tx, table, t)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// create is a helper function to create the partition of the table
+// for the month of the provided time. The rows created within the
+// month are moved from the default partition before the partition
+// is attached, since attaching a partition fails when the default
+// partition holds rows that belong to it.
+func create(tx *gorm.DB, table string, t time.Time) (*api.Partition, error) {
+	start := month(t)
+	end := start.AddDate(0, 1, 0)
+
+	p := new(api.Partition)
+	p.SetTable(table)
+	p.SetName(Name(table, start))
+	p.SetStart(start.Unix())
+	p.SetEnd(end.Unix())
+
+	queries := []string{
+		fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS)", p.GetName(), table),
+		fmt.Sprintf("INSERT INTO %s SELECT * FROM %s_default WHERE created >= %d AND created < %d", p.GetName(), table, p.GetStart(), p.GetEnd()),
+		fmt.Sprintf("DELETE FROM %s_default WHERE created >= %d AND created < %d", table, p.GetStart(), p.GetEnd()),
+		fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM (%d) TO (%d)", table, p.GetName(), p.GetStart(), p.GetEnd()),
+	}
+
+	for _, query := range queries {
+		err := tx.Exec(query).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Name returns the name of the partition of the
+// table for the month of the provided time.
+func Name(table string, t time.Time) string {
+	m := month(t)
+
+	return fmt.Sprintf("%s_y%04dm%02d", table, m.Year(), m.Month())
+}
+
+// month is a helper function to return the
+// start of the month for the provided time.
+func month(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// isTable is a helper function to check if
+// the table is partitioned by month.
+func isTable(name string) bool {
+	for _, t := range tables {
+		if t.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
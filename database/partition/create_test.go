@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestPartition_Engine_CreatePartition(t *testing.T) {
+	// setup types
+	_month := time.Date(2023, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	_partition := new(api.Partition)
+	_partition.SetTable("builds")
+	_partition.SetName("builds_y2023m10")
+	_partition.SetStart(1696118400)
+	_partition.SetEnd(1698796800)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`CREATE TABLE builds_y2023m10 (LIKE builds INCLUDING DEFAULTS)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_mock.ExpectExec(`INSERT INTO builds_y2023m10 SELECT * FROM builds_default WHERE created >= 1696118400 AND created < 1698796800`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_mock.ExpectExec(`DELETE FROM builds_default WHERE created >= 1696118400 AND created < 1698796800`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_mock.ExpectExec(`ALTER TABLE builds ATTACH PARTITION builds_y2023m10 FOR VALUES FROM (1696118400) TO (1698796800)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		table    string
+		want     *api.Partition
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			table:    "builds",
+			want:     _partition,
+		},
+		{
+			failure:  true,
+			name:     "postgres with unpartitioned table",
+			database: _postgres,
+			table:    "steps",
+		},
+		{
+			failure:  true,
+			name:     "sqlite3",
+			database: _sqlite,
+			table:    "builds",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CreatePartition(context.TODO(), test.table, _month)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreatePartition for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreatePartition for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CreatePartition for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPartition_Name(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		table string
+		time  time.Time
+		want  string
+	}{
+		{
+			table: "builds",
+			time:  time.Date(2023, time.October, 31, 23, 59, 59, 0, time.UTC),
+			want:  "builds_y2023m10",
+		},
+		{
+			table: "logs",
+			time:  time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want:  "logs_y2024m01",
+		},
+		{
+			table: "logs",
+			time:  time.Date(2024, time.January, 1, 0, 0, 0, 0, time.FixedZone("CST", -6*60*60)),
+			want:  "logs_y2024m01",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := Name(test.table, test.time)
+
+		if got != test.want {
+			t.Errorf("Name is %s, want %s", got, test.want)
+		}
+	}
+}
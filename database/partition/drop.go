@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/replica"
+	"github.com/go-vela/types/constants"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// DropPartition detaches and drops the partition of a table from the database.
+//
+// The partition is detached first, which fails for any table
+// that isn't a partition of the table, so only partitions
+// can be dropped. Every row stored in the partition is deleted.
+func (e *engine) DropPartition(ctx context.Context, p *api.Partition) error {
+	e.logger.WithFields(logrus.Fields{
+		"table":     p.GetTable(),
+		"partition": p.GetName(),
+	}).Tracef("dropping partition %s for %s table in the database", p.GetName(), p.GetTable())
+
+	// check if the database supports native partitioning
+	if e.client.Config.Dialector.Name() != constants.DriverPostgres {
+		return ErrUnsupported
+	}
+
+	// check if the table is partitioned by month
+	if !isTable(p.GetTable()) {
+		return fmt.Errorf("%s table is not partitioned", p.GetTable())
+	}
+
+	name := pq.QuoteIdentifier(p.GetName())
+
+	// send queries to the primary database in a transaction
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Primary
+	return e.client.WithContext(replica.Primary(ctx)).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", p.GetTable(), name)).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(fmt.Sprintf("DROP TABLE %s", name)).Error
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestPartition_Engine_DropPartition(t *testing.T) {
+	// setup types
+	_partition := new(api.Partition)
+	_partition.SetTable("logs")
+	_partition.SetName("logs_y2023m10")
+	_partition.SetStart(1696118400)
+	_partition.SetEnd(1698796800)
+
+	_steps := new(api.Partition)
+	_steps.SetTable("steps")
+	_steps.SetName("steps_y2023m10")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`ALTER TABLE logs DETACH PARTITION "logs_y2023m10"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_mock.ExpectExec(`DROP TABLE "logs_y2023m10"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure   bool
+		name      string
+		database  *engine
+		partition *api.Partition
+	}{
+		{
+			failure:   false,
+			name:      "postgres",
+			database:  _postgres,
+			partition: _partition,
+		},
+		{
+			failure:   true,
+			name:      "postgres with unpartitioned table",
+			database:  _postgres,
+			partition: _steps,
+		},
+		{
+			failure:   true,
+			name:      "sqlite3",
+			database:  _sqlite,
+			partition: _partition,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.DropPartition(context.TODO(), test.partition)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DropPartition for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DropPartition for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/replica"
+	"github.com/go-vela/types/constants"
+)
+
+// SelectPartitions represents a query to list the partitions
+// of a table in the database with the bounds of each partition.
+const SelectPartitions = `
+SELECT c.relname AS name, pg_get_expr(c.relpartbound, c.oid) AS bound
+FROM pg_inherits i
+JOIN pg_class c ON c.oid = i.inhrelid
+WHERE i.inhparent = to_regclass(?)
+ORDER BY c.relname;
+`
+
+// bounds represents the expression for the bounds of a range partition.
+//
+// The default partition has no bounds and the legacy partition
+// has no lower bound, which are captured as a zero value.
+var bounds = regexp.MustCompile(`FROM \('?(\d+|MINVALUE)'?\) TO \('?(\d+)'?\)`)
+
+// ListPartitions gets a list of the partitions of a table from the database.
+func (e *engine) ListPartitions(ctx context.Context, table string) ([]*api.Partition, error) {
+	e.logger.Tracef("listing partitions for %s table from the database", table)
+
+	// check if the database supports native partitioning
+	if e.client.Config.Dialector.Name() != constants.DriverPostgres {
+		return nil, ErrUnsupported
+	}
+
+	// variable to store query results
+	rows := []struct {
+		Name  string
+		Bound string
+	}{}
+
+	// send query to the primary database and store result in variable
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Primary
+	err := e.client.
+		WithContext(replica.Primary(ctx)).
+		Raw(SelectPartitions, table).
+		Scan(&rows).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// variable to store the partitions
+	partitions := []*api.Partition{}
+
+	for _, row := range rows {
+		p := new(api.Partition)
+		p.SetTable(table)
+		p.SetName(row.Name)
+
+		// capture the bounds for the range partition
+		if match := bounds.FindStringSubmatch(row.Bound); match != nil {
+			// the legacy partition has no lower bound
+			start, _ := strconv.ParseInt(match[1], 10, 64)
+			end, _ := strconv.ParseInt(match[2], 10, 64)
+
+			p.SetStart(start)
+			p.SetEnd(end)
+		}
+
+		partitions = append(partitions, p)
+	}
+
+	return partitions, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestPartition_Engine_ListPartitions(t *testing.T) {
+	// setup types
+	_default := new(api.Partition)
+	_default.SetTable("builds")
+	_default.SetName("builds_default")
+
+	_legacy := new(api.Partition)
+	_legacy.SetTable("builds")
+	_legacy.SetName("builds_legacy")
+	_legacy.SetStart(0)
+	_legacy.SetEnd(1696118400)
+
+	_october := new(api.Partition)
+	_october.SetTable("builds")
+	_october.SetName("builds_y2023m10")
+	_october.SetStart(1696118400)
+	_october.SetEnd(1698796800)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"name", "bound"}).
+		AddRow("builds_default", "DEFAULT").
+		AddRow("builds_legacy", "FOR VALUES FROM (MINVALUE) TO (1696118400)").
+		AddRow("builds_y2023m10", "FOR VALUES FROM (1696118400) TO (1698796800)")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`
+SELECT c.relname AS name, pg_get_expr(c.relpartbound, c.oid) AS bound
+FROM pg_inherits i
+JOIN pg_class c ON c.oid = i.inhrelid
+WHERE i.inhparent = to_regclass($1)
+ORDER BY c.relname;
+`).WithArgs("builds").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Partition
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Partition{_default, _legacy, _october},
+		},
+		{
+			failure:  true,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListPartitions(context.TODO(), "builds")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListPartitions for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListPartitions for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListPartitions for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
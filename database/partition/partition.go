@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// ErrUnsupported defines the error type when managing the
+// partitions of a table with a database that doesn't support
+// native partitioning. Only Postgres is supported.
+var ErrUnsupported = errors.New("partitioning is only supported for the postgres driver")
+
+type (
+	// config represents the settings required to create the engine that implements the PartitionService interface.
+	config struct{}
+
+	// engine represents the table partition functionality that implements the PartitionService interface.
+	engine struct {
+		// engine configuration settings used in partition functions
+		config *config
+
+		// gorm.io/gorm database client used in partition functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in partition functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with table partitions in the database.
+//
+// The partitioned tables are converted by the partitioner so
+// the engine doesn't create any tables or indexes itself.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new Partition engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
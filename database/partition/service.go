@@ -0,0 +1,31 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"context"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// PartitionService represents the Vela interface for table partition
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type PartitionService interface {
+	// Partition Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreatePartition defines a function that creates the partition of a table for the month of a time.
+	CreatePartition(context.Context, string, time.Time) (*api.Partition, error)
+	// DropPartition defines a function that detaches and drops a partition of a table.
+	DropPartition(context.Context, *api.Partition) error
+	// ListPartitions defines a function that gets a list of the partitions of a table.
+	ListPartitions(context.Context, string) ([]*api.Partition, error)
+	// PartitionTables defines a function that converts the builds and logs tables into tables partitioned by month.
+	PartitionTables(context.Context) error
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-vela/server/database/postgres/ddl"
+	"github.com/go-vela/types/constants"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// SelectPartitioned represents a query to count the
+	// partitioned tables in the database with a name.
+	SelectPartitioned = `
+SELECT COUNT(*)
+FROM pg_partitioned_table
+WHERE partrelid = to_regclass(?);
+`
+
+	// AddLogCreatedColumn represents a query to add the created
+	// column used as the partition key to the logs table. The
+	// logs are inserted without a created timestamp so the
+	// column defaults to the time the log was inserted.
+	AddLogCreatedColumn = `
+ALTER TABLE logs
+ADD COLUMN IF NOT EXISTS
+created INTEGER DEFAULT EXTRACT(EPOCH FROM NOW())::INTEGER;
+`
+
+	// UpdateBuildCreated represents a query to set the created
+	// timestamp for builds without one so they are kept in the
+	// legacy partition of the builds table.
+	UpdateBuildCreated = `
+UPDATE builds
+SET created = 0
+WHERE created IS NULL;
+`
+)
+
+// table represents a table in the database that is
+// partitioned by month with the created column.
+type table struct {
+	// name of the table
+	Name string
+	// queries to prepare the existing table to become the legacy partition
+	Prepare []string
+	// names of the primary and unique keys that don't include the created column
+	Constraints []string
+	// definitions of the primary and unique keys for the partitioned table
+	Keys []string
+	// names and definitions of the indexes for the partitioned table
+	Indexes [][2]string
+}
+
+// tables represents the tables in the database that are partitioned by month.
+//
+// A partitioned table requires the primary and unique keys to include the
+// partition key, so the keys are widened with the created column. The
+// uniqueness of the keys is only enforced within a partition.
+var tables = []*table{
+	{
+		Name:        constants.TableBuild,
+		Prepare:     []string{UpdateBuildCreated},
+		Constraints: []string{"builds_pkey", "builds_repo_id_number_key"},
+		Keys:        []string{"PRIMARY KEY (id, created)", "UNIQUE (repo_id, number, created)"},
+		Indexes: [][2]string{
+			{"builds_repo_id", "(repo_id)"},
+			{"builds_status", "(status)"},
+			{"builds_created", "(created)"},
+			{"builds_source", "(source)"},
+			{"builds_search", "USING GIN (" + ddl.BuildSearchDocument + ")"},
+		},
+	},
+	{
+		Name:        constants.TableLog,
+		Prepare:     []string{AddLogCreatedColumn},
+		Constraints: []string{"logs_pkey", "logs_step_id_key", "logs_service_id_key"},
+		Keys:        []string{"PRIMARY KEY (id, created)", "UNIQUE (step_id, created)", "UNIQUE (service_id, created)"},
+		Indexes: [][2]string{
+			{"logs_build_id", "(build_id)"},
+		},
+	},
+}
+
+// Tables returns the names of the tables in the database that are partitioned by month.
+func Tables() []string {
+	names := []string{}
+
+	for _, t := range tables {
+		names = append(names, t.Name)
+	}
+
+	return names
+}
+
+// partitionTables is a helper function to convert the builds and logs
+// tables in the database into tables partitioned by month with the
+// created column.
+//
+// The existing table is renamed and attached as the legacy partition
+// holding every row created before the next month, so the rows aren't
+// copied. A default partition holds any row created outside of the
+// partitions and the partition for the next month is created.
+// Tables that are already partitioned are skipped so the function
+// can be safely applied again.
+func partitionTables(tx *gorm.DB, now time.Time) error {
+	next := month(now).AddDate(0, 1, 0)
+
+	for _, t := range tables {
+		partitioned, err := IsPartitioned(tx, t.Name)
+		if err != nil {
+			return err
+		}
+
+		if partitioned {
+			continue
+		}
+
+		err = t.partition(tx, next)
+		if err != nil {
+			return fmt.Errorf("unable to partition %s table: %w", t.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// IsPartitioned checks if the table in the database is partitioned.
+func IsPartitioned(tx *gorm.DB, name string) (bool, error) {
+	var count int64
+
+	err := tx.Raw(SelectPartitioned, name).Scan(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// partition is a helper function to convert the table into a table
+// partitioned by month with the existing table attached as the legacy
+// partition holding every row created before the provided month.
+func (t *table) partition(tx *gorm.DB, next time.Time) error {
+	legacy := t.Name + "_legacy"
+
+	queries := append([]string{}, t.Prepare...)
+
+	queries = append(queries,
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", t.Name, legacy),
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN created SET NOT NULL", legacy),
+	)
+
+	for _, c := range t.Constraints {
+		queries = append(queries, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s", legacy, c))
+	}
+
+	// the existing indexes are renamed so the partitioned table can take over their
+	// names and the indexes are attached to the indexes of the partitioned table
+	for _, i := range t.Indexes {
+		queries = append(queries, fmt.Sprintf("ALTER INDEX IF EXISTS %s RENAME TO %s", i[0], strings.Replace(i[0], t.Name, legacy, 1)))
+	}
+
+	queries = append(queries,
+		fmt.Sprintf("CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS, %s) PARTITION BY RANGE (created)", t.Name, legacy, strings.Join(t.Keys, ", ")),
+		// the sequence is owned by the partitioned table so it isn't dropped with the legacy partition
+		fmt.Sprintf("ALTER SEQUENCE IF EXISTS %s_id_seq OWNED BY %s.id", t.Name, t.Name),
+	)
+
+	for _, i := range t.Indexes {
+		queries = append(queries, fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s %s", i[0], t.Name, i[1]))
+	}
+
+	queries = append(queries,
+		fmt.Sprintf("CREATE TABLE %s_default PARTITION OF %s DEFAULT", t.Name, t.Name),
+		fmt.Sprintf("ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM (MINVALUE) TO (%d)", t.Name, legacy, next.Unix()),
+	)
+
+	for _, query := range queries {
+		err := tx.Exec(query).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := create(tx, t.Name, next)
+
+	return err
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partition
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/database/postgres/ddl"
+)
+
+func TestPartition_partitionTables(t *testing.T) {
+	// setup types
+	_now := time.Date(2023, time.September, 17, 12, 0, 0, 0, time.UTC)
+
+	_partitioned := strings.Replace(SelectPartitioned, "?", "$1", 1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries to partition the builds table
+	_mock.ExpectQuery(_partitioned).WithArgs("builds").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	for _, query := range []string{
+		UpdateBuildCreated,
+		`ALTER TABLE builds RENAME TO builds_legacy`,
+		`ALTER TABLE builds_legacy ALTER COLUMN created SET NOT NULL`,
+		`ALTER TABLE builds_legacy DROP CONSTRAINT IF EXISTS builds_pkey`,
+		`ALTER TABLE builds_legacy DROP CONSTRAINT IF EXISTS builds_repo_id_number_key`,
+		`ALTER INDEX IF EXISTS builds_repo_id RENAME TO builds_legacy_repo_id`,
+		`ALTER INDEX IF EXISTS builds_status RENAME TO builds_legacy_status`,
+		`ALTER INDEX IF EXISTS builds_created RENAME TO builds_legacy_created`,
+		`ALTER INDEX IF EXISTS builds_source RENAME TO builds_legacy_source`,
+		`ALTER INDEX IF EXISTS builds_search RENAME TO builds_legacy_search`,
+		`CREATE TABLE builds (LIKE builds_legacy INCLUDING DEFAULTS, PRIMARY KEY (id, created), UNIQUE (repo_id, number, created)) PARTITION BY RANGE (created)`,
+		`ALTER SEQUENCE IF EXISTS builds_id_seq OWNED BY builds.id`,
+		`CREATE INDEX IF NOT EXISTS builds_repo_id ON builds (repo_id)`,
+		`CREATE INDEX IF NOT EXISTS builds_status ON builds (status)`,
+		`CREATE INDEX IF NOT EXISTS builds_created ON builds (created)`,
+		`CREATE INDEX IF NOT EXISTS builds_source ON builds (source)`,
+		`CREATE INDEX IF NOT EXISTS builds_search ON builds USING GIN (` + ddl.BuildSearchDocument + `)`,
+		`CREATE TABLE builds_default PARTITION OF builds DEFAULT`,
+		`ALTER TABLE builds ATTACH PARTITION builds_legacy FOR VALUES FROM (MINVALUE) TO (1696118400)`,
+		`CREATE TABLE builds_y2023m10 (LIKE builds INCLUDING DEFAULTS)`,
+		`INSERT INTO builds_y2023m10 SELECT * FROM builds_default WHERE created >= 1696118400 AND created < 1698796800`,
+		`DELETE FROM builds_default WHERE created >= 1696118400 AND created < 1698796800`,
+		`ALTER TABLE builds ATTACH PARTITION builds_y2023m10 FOR VALUES FROM (1696118400) TO (1698796800)`,
+	} {
+		_mock.ExpectExec(query).WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	// ensure the mock expects the logs table is already partitioned
+	_mock.ExpectQuery(_partitioned).WithArgs("logs").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	// run test
+	err := partitionTables(_postgres.client, _now)
+	if err != nil {
+		t.Errorf("partitionTables returned err: %v", err)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("partitionTables didn't send the expected queries: %v", err)
+	}
+}
+
+func TestPartition_Tables(t *testing.T) {
+	// setup types
+	want := []string{"builds", "logs"}
+
+	// run test
+	got := Tables()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tables is %v, want %v", got, want)
+	}
+}
@@ -5,18 +5,20 @@
 package pipeline
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 )
 
 // CountPipelines gets the count of all pipelines from the database.
-func (e *engine) CountPipelines() (int64, error) {
+func (e *engine) CountPipelines(ctx context.Context) (int64, error) {
 	e.logger.Tracef("getting count of all pipelines from the database")
 
 	// variable to store query results
 	var p int64
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TablePipeline).
 		Count(&p).
 		Error
@@ -5,13 +5,15 @@
 package pipeline
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
 
 // CountPipelinesForRepo gets the count of pipelines by repo ID from the database.
-func (e *engine) CountPipelinesForRepo(r *library.Repo) (int64, error) {
+func (e *engine) CountPipelinesForRepo(ctx context.Context, r *library.Repo) (int64, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -21,7 +23,7 @@ func (e *engine) CountPipelinesForRepo(r *library.Repo) (int64, error) {
 	var p int64
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TablePipeline).
 		Where("repo_id = ?", r.GetID()).
 		Count(&p).
@@ -5,14 +5,17 @@
 package pipeline
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm/clause"
 )
 
 // GetPipelineForRepo gets a pipeline by number and repo ID from the database.
-func (e *engine) GetPipelineForRepo(commit string, r *library.Repo) (*library.Pipeline, error) {
+func (e *engine) GetPipelineForRepo(ctx context.Context, commit string, r *library.Repo) (*library.Pipeline, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org":      r.GetOrg(),
 		"pipeline": commit,
@@ -23,10 +26,10 @@ func (e *engine) GetPipelineForRepo(commit string, r *library.Repo) (*library.Pi
 	p := new(database.Pipeline)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TablePipeline).
 		Where("repo_id = ?", r.GetID()).
-		Where("\"commit\" = ?", commit).
+		Where(clause.Eq{Column: clause.Column{Name: "commit"}, Value: commit}).
 		Take(p).
 		Error
 	if err != nil {
@@ -4,6 +4,12 @@
 
 package pipeline
 
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
 const (
 	// CreateRepoIDIndex represents a query to create an
 	// index on the pipelines table for the repo_id column.
@@ -16,9 +22,14 @@ ON pipelines (repo_id);
 )
 
 // CreatePipelineIndexes creates the indexes for the pipelines table in the database.
-func (e *engine) CreatePipelineIndexes() error {
+func (e *engine) CreatePipelineIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for pipelines table in the database")
 
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
 	// create the repo_id column index for the pipelines table
-	return e.client.Exec(CreateRepoIDIndex).Error
+	return e.client.WithContext(ctx).Exec(CreateRepoIDIndex).Error
 }
@@ -5,6 +5,8 @@
 package pipeline
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -14,7 +16,7 @@ import (
 // ListPipelinesForRepo gets a list of pipelines by repo ID from the database.
 //
 //nolint:lll // ignore long line length due to variable names
-func (e *engine) ListPipelinesForRepo(r *library.Repo, page, perPage int) ([]*library.Pipeline, int64, error) {
+func (e *engine) ListPipelinesForRepo(ctx context.Context, r *library.Repo, page, perPage int) ([]*library.Pipeline, int64, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -26,7 +28,7 @@ func (e *engine) ListPipelinesForRepo(r *library.Repo, page, perPage int) ([]*li
 	pipelines := []*library.Pipeline{}
 
 	// count the results
-	count, err := e.CountPipelinesForRepo(r)
+	count, err := e.CountPipelinesForRepo(ctx, r)
 	if err != nil {
 		return pipelines, 0, err
 	}
@@ -39,7 +41,7 @@ func (e *engine) ListPipelinesForRepo(r *library.Repo, page, perPage int) ([]*li
 	// calculate offset for pagination through results
 	offset := perPage * (page - 1)
 
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TablePipeline).
 		Where("repo_id = ?", r.GetID()).
 		Limit(perPage).
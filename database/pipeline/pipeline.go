@@ -5,6 +5,7 @@
 package pipeline
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-vela/types/constants"
@@ -61,19 +62,19 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating pipeline database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of pipelines table and indexes in the database")
+		e.logger.Trace("skipping creation of pipelines table and indexes in the database")
 
 		return e, nil
 	}
 
 	// create the pipelines table
-	err := e.CreatePipelineTable(e.client.Config.Dialector.Name())
+	err := e.CreatePipelineTable(context.Background(), e.client.Config.Dialector.Name())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create %s table: %w", constants.TablePipeline, err)
 	}
 
 	// create the indexes for the pipelines table
-	err = e.CreatePipelineIndexes()
+	err = e.CreatePipelineIndexes(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create indexes for %s table: %w", constants.TablePipeline, err)
 	}
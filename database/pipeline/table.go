@@ -4,7 +4,12 @@
 
 package pipeline
 
-import "github.com/go-vela/types/constants"
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
 
 const (
 	// CreatePostgresTable represents a query to create the Postgres pipelines table.
@@ -53,22 +58,50 @@ pipelines (
 	data             BLOB,
 	UNIQUE(repo_id, 'commit')
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL pipelines table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+pipelines (
+	id               INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id          INTEGER,
+	commit           VARCHAR(500),
+	flavor           VARCHAR(100),
+	platform         VARCHAR(100),
+	ref              VARCHAR(500),
+	type             VARCHAR(100),
+	version          VARCHAR(50),
+	external_secrets BOOLEAN,
+	internal_secrets BOOLEAN,
+	services         BOOLEAN,
+	stages           BOOLEAN,
+	steps            BOOLEAN,
+	templates        BOOLEAN,
+	data             LONGBLOB,
+	UNIQUE(repo_id, commit),
+	INDEX pipelines_repo_id (repo_id)
+);
 `
 )
 
 // CreatePipelineTable creates the pipelines table in the database.
-func (e *engine) CreatePipelineTable(driver string) error {
+func (e *engine) CreatePipelineTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating pipelines table in the database")
 
 	// handle the driver provided to create the table
 	switch driver {
 	case constants.DriverPostgres:
 		// create the pipelines table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the pipelines table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the pipelines table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
 	}
 }
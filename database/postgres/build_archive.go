@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// ArchiveRepoBuilds archives the builds for a repo in the database by
+// setting the time they were deleted instead of removing them.
+func (c *client) ArchiveRepoBuilds(ctx context.Context, r *library.Repo) (int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("archiving builds for repo %s in the database", r.GetFullName())
+
+	// send query to the database
+	result := c.Postgres.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("repo_id = ?", r.GetID()).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", time.Now().UTC().Unix())
+
+	return result.RowsAffected, result.Error
+}
+
+// RestoreRepoBuilds restores the archived builds for a repo
+// in the database by clearing the time they were deleted.
+func (c *client) RestoreRepoBuilds(ctx context.Context, r *library.Repo) (int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("restoring builds for repo %s in the database", r.GetFullName())
+
+	// send query to the database
+	result := c.Postgres.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("repo_id = ?", r.GetID()).
+		Where("deleted_at IS NOT NULL").
+		Update("deleted_at", nil)
+
+	return result.RowsAffected, result.Error
+}
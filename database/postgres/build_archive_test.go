@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPostgres_Client_ArchiveRepoBuilds(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "builds" SET "deleted_at"=$1 WHERE repo_id = $2 AND deleted_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    int64
+	}{
+		{
+			failure: false,
+			want:    2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.ArchiveRepoBuilds(context.TODO(), _repo)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("ArchiveRepoBuilds should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("ArchiveRepoBuilds returned err: %v", err)
+		}
+
+		if got != test.want {
+			t.Errorf("ArchiveRepoBuilds is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestPostgres_Client_RestoreRepoBuilds(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "builds" SET "deleted_at"=$1 WHERE repo_id = $2 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    int64
+	}{
+		{
+			failure: false,
+			want:    2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.RestoreRepoBuilds(context.TODO(), _repo)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("RestoreRepoBuilds should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("RestoreRepoBuilds returned err: %v", err)
+		}
+
+		if got != test.want {
+			t.Errorf("RestoreRepoBuilds is %v, want %v", got, test.want)
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/go-vela/server/database/postgres/dml"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -12,14 +14,14 @@ import (
 )
 
 // GetBuildCount gets a count of all builds from the database.
-func (c *client) GetBuildCount() (int64, error) {
+func (c *client) GetBuildCount(ctx context.Context) (int64, error) {
 	c.Logger.Trace("getting count of builds from the database")
 
 	// variable to store query results
 	var b int64
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.SelectBuildsCount).
 		Pluck("count", &b).Error
@@ -28,14 +30,14 @@ func (c *client) GetBuildCount() (int64, error) {
 }
 
 // GetBuildCountByStatus gets a count of all builds by status from the database.
-func (c *client) GetBuildCountByStatus(status string) (int64, error) {
+func (c *client) GetBuildCountByStatus(ctx context.Context, status string) (int64, error) {
 	c.Logger.Tracef("getting count of builds by status %s from the database", status)
 
 	// variable to store query results
 	var b int64
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.SelectBuildsCountByStatus, status).
 		Pluck("count", &b).Error
@@ -43,8 +45,42 @@ func (c *client) GetBuildCountByStatus(status string) (int64, error) {
 	return b, err
 }
 
+// GetBuildCountByHost gets a count of all builds by
+// status for each worker host from the database.
+func (c *client) GetBuildCountByHost(ctx context.Context, status string) (map[string]int64, error) {
+	c.Logger.Tracef("getting count of builds by status %s for each host from the database", status)
+
+	type hostCount struct {
+		Host  string
+		Count int64
+	}
+
+	// variables to store query results and return value
+	h := new([]hostCount)
+	counts := make(map[string]int64)
+
+	// send query to the database and store result in variable
+	err := c.Postgres.WithContext(ctx).
+		Table(constants.TableBuild).
+		Select("host, count(*) AS count").
+		Where("status = ?", status).
+		Where("deleted_at IS NULL").
+		Group("host").
+		Scan(h).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, host := range *h {
+		counts[host.Host] = host.Count
+	}
+
+	return counts, nil
+}
+
 // GetOrgBuildCount gets the count of all builds by repo ID from the database.
-func (c *client) GetOrgBuildCount(org string, filters map[string]interface{}) (int64, error) {
+func (c *client) GetOrgBuildCount(ctx context.Context, org string, filters map[string]interface{}) (int64, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"org": org,
 	}).Tracef("getting count of builds for org %s from the database", org)
@@ -53,9 +89,10 @@ func (c *client) GetOrgBuildCount(org string, filters map[string]interface{}) (i
 	var b int64
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableBuild).
 		Joins("JOIN repos ON builds.repo_id = repos.id and repos.org = ?", org).
+		Where("builds.deleted_at IS NULL").
 		Where(filters).
 		Count(&b).Error
 
@@ -63,7 +100,7 @@ func (c *client) GetOrgBuildCount(org string, filters map[string]interface{}) (i
 }
 
 // GetRepoBuildCount gets the count of all builds by repo ID from the database.
-func (c *client) GetRepoBuildCount(r *library.Repo, filters map[string]interface{}) (int64, error) {
+func (c *client) GetRepoBuildCount(ctx context.Context, r *library.Repo, filters map[string]interface{}) (int64, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"name": r.GetName(),
@@ -73,7 +110,7 @@ func (c *client) GetRepoBuildCount(r *library.Repo, filters map[string]interface
 	var b int64
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableBuild).
 		Where("repo_id = ?", r.GetID()).
 		Where(filters).
@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -61,7 +62,7 @@ func TestPostgres_Client_GetBuildCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildCount()
+		got, err := _database.GetBuildCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -127,7 +128,7 @@ func TestPostgres_Client_GetBuildCountByStatus(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildCountByStatus("running")
+		got, err := _database.GetBuildCountByStatus(context.TODO(), "running")
 
 		if test.failure {
 			if err == nil {
@@ -147,6 +148,58 @@ func TestPostgres_Client_GetBuildCountByStatus(t *testing.T) {
 	}
 }
 
+func TestPostgres_Client_GetBuildCountByHost(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"host", "count"}).
+		AddRow("worker-1.example.com", 2).
+		AddRow("worker-2.example.com", 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT host, count(*) AS count FROM "builds" WHERE status = $1 AND deleted_at IS NULL GROUP BY "host"`).
+		WithArgs("running").
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    map[string]int64
+	}{
+		{
+			failure: false,
+			want:    map[string]int64{"worker-1.example.com": 2, "worker-2.example.com": 1},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetBuildCountByHost(context.TODO(), "running")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetBuildCountByHost should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetBuildCountByHost returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetBuildCountByHost is %v, want %v", got, test.want)
+		}
+	}
+}
+
 func TestPostgres_Client_GetOrgBuildCount(t *testing.T) {
 	// setup types
 	_buildOne := testBuild()
@@ -173,7 +226,7 @@ func TestPostgres_Client_GetOrgBuildCount(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL").WillReturnRows(_rows)
 
 	// setup tests
 	tests := []struct {
@@ -188,7 +241,7 @@ func TestPostgres_Client_GetOrgBuildCount(t *testing.T) {
 	filters := map[string]interface{}{}
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetOrgBuildCount("foo", filters)
+		got, err := _database.GetOrgBuildCount(context.TODO(), "foo", filters)
 
 		if test.failure {
 			if err == nil {
@@ -234,7 +287,7 @@ func TestPostgres_Client_GetOrgBuildCountByEvent(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE \"event\" = $2").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL AND \"event\" = $2").WillReturnRows(_rows)
 
 	// setup tests
 	tests := []struct {
@@ -252,7 +305,7 @@ func TestPostgres_Client_GetOrgBuildCountByEvent(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetOrgBuildCount("foo", filters)
+		got, err := _database.GetOrgBuildCount(context.TODO(), "foo", filters)
 
 		if test.failure {
 			if err == nil {
@@ -324,7 +377,7 @@ func TestPostgres_Client_GetRepoBuildCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetRepoBuildCount(_repo, filters)
+		got, err := _database.GetRepoBuildCount(context.TODO(), _repo, filters)
 
 		if test.failure {
 			if err == nil {
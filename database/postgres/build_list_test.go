@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
@@ -66,7 +67,7 @@ func TestPostgres_Client_GetBuildList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildList()
+		got, err := _database.GetBuildList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -86,6 +87,290 @@ func TestPostgres_Client_GetBuildList(t *testing.T) {
 	}
 }
 
+func TestPostgres_Client_GetFinishedBuildListSince(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetCreated(2)
+	_buildOne.SetFinished(3)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetCreated(2)
+	_buildTwo.SetFinished(3)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 2, 0, 3, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 2, 0, 3, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "builds" WHERE created > $1 AND finished > 0`).WithArgs(1).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetFinishedBuildListSince(context.TODO(), 1)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedBuildListSince should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedBuildListSince returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedBuildListSince is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestPostgres_Client_GetFinishedBuildListBetween(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetFinished(2)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetFinished(3)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 2, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 3, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "builds" WHERE finished > $1 AND finished <= $2 ORDER BY finished,id`).WithArgs(1, 3).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetFinishedBuildListBetween(context.TODO(), 1, 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedBuildListBetween should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedBuildListBetween returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedBuildListBetween is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestPostgres_Client_GetPendingBuildListBefore(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetStatus("pending")
+	_buildOne.SetCreated(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetStatus("pending")
+	_buildTwo.SetCreated(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "pending", "", 0, 1, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "pending", "", 0, 2, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "builds" WHERE status = $1 AND created < $2 ORDER BY created,id`).WithArgs("pending", 3).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetPendingBuildListBefore(context.TODO(), 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetPendingBuildListBefore should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetPendingBuildListBefore returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetPendingBuildListBefore is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestPostgres_Client_GetLastDeploymentBuildList(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetEvent("deployment")
+	_buildOne.SetStatus("success")
+	_buildOne.SetDeploy("production")
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(3)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(3)
+	_buildTwo.SetEvent("deployment")
+	_buildTwo.SetStatus("success")
+	_buildTwo.SetDeploy("staging")
+	_buildTwo.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "deployment", "", "success", "", 0, 0, 0, 0, "production", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(3, 1, nil, 3, 0, "deployment", "", "success", "", 0, 0, 0, 0, "staging", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "builds" WHERE id IN (SELECT MAX(id) FROM "builds" WHERE repo_id = $1 AND event = $2 AND status = $3 GROUP BY "deploy") ORDER BY deploy`).
+		WithArgs(1, "deployment", "success").
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetLastDeploymentBuildList(context.TODO(), _repo)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetLastDeploymentBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetLastDeploymentBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetLastDeploymentBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
+
 func TestPostgres_Client_GetDeploymentBuildList(t *testing.T) {
 	// setup types
 	_buildOne := testBuild()
@@ -132,7 +417,7 @@ func TestPostgres_Client_GetDeploymentBuildList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetDeploymentBuildList("https://github.com/github/octocat/deployments/1")
+		got, err := _database.GetDeploymentBuildList(context.TODO(), "https://github.com/github/octocat/deployments/1")
 
 		if test.failure {
 			if err == nil {
@@ -178,7 +463,7 @@ func TestPostgres_Client_GetOrgBuildList(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL").WillReturnRows(_rows)
 
 	// create expected return in mock
 	_rows = sqlmock.NewRows(
@@ -187,7 +472,7 @@ func TestPostgres_Client_GetOrgBuildList(t *testing.T) {
 		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT builds.* FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT builds.* FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
 
 	// setup tests
 	tests := []struct {
@@ -204,7 +489,7 @@ func TestPostgres_Client_GetOrgBuildList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, _, err := _database.GetOrgBuildList("foo", filters, 1, 10)
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -250,7 +535,7 @@ func TestPostgres_Client_GetOrgBuildList_NonAdmin(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE \"visibility\" = $2").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL AND \"visibility\" = $2").WillReturnRows(_rows)
 
 	// create expected return in mock
 	_rows = sqlmock.NewRows(
@@ -258,7 +543,7 @@ func TestPostgres_Client_GetOrgBuildList_NonAdmin(t *testing.T) {
 	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT builds.* FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE \"visibility\" = $2 ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT builds.* FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL AND \"visibility\" = $2 ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
 
 	// setup tests
 	tests := []struct {
@@ -277,7 +562,7 @@ func TestPostgres_Client_GetOrgBuildList_NonAdmin(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, _, err := _database.GetOrgBuildList("foo", filters, 1, 10)
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -323,7 +608,7 @@ func TestPostgres_Client_GetOrgBuildListByEvent(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE \"event\" = $2").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL AND \"event\" = $2").WillReturnRows(_rows)
 
 	// create expected return in mock
 	_rows = sqlmock.NewRows(
@@ -332,7 +617,7 @@ func TestPostgres_Client_GetOrgBuildListByEvent(t *testing.T) {
 		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT builds.* FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE \"event\" = $2 ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT builds.* FROM \"builds\" JOIN repos ON builds.repo_id = repos.id and repos.org = $1 WHERE builds.deleted_at IS NULL AND \"event\" = $2 ORDER BY created DESC,id LIMIT 10").WillReturnRows(_rows)
 
 	// setup tests
 	tests := []struct {
@@ -351,7 +636,7 @@ func TestPostgres_Client_GetOrgBuildListByEvent(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, _, err := _database.GetOrgBuildList("foo", filters, 1, 10)
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -438,7 +723,7 @@ func TestPostgres_Client_GetRepoBuildList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, _, err := _database.GetRepoBuildList(_repo, filters, test.before, test.after, 1, 10)
+		got, _, err := _database.GetRepoBuildList(context.TODO(), _repo, filters, test.before, test.after, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/database/postgres/ddl"
+	"github.com/go-vela/types/library"
+)
+
+func TestPostgres_Client_SearchBuilds(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	_match := ddl.BuildSearchDocument + " @@ plainto_tsquery('simple', $2)"
+	_logs := "(" + ddl.BuildSearchDocument + " @@ plainto_tsquery('simple', $2) OR id IN (SELECT build_id FROM log_lines WHERE to_tsvector('simple', data) @@ plainto_tsquery('simple', $3)))"
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" WHERE repo_id IN (SELECT id FROM \"repos\" WHERE \"org\" = $1) AND "+_match).
+		WithArgs("foo", "fix").WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0).
+		AddRow(2, 1, nil, 2, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM \"builds\" WHERE repo_id IN (SELECT id FROM \"repos\" WHERE \"org\" = $1) AND "+_match+" ORDER BY created DESC,id LIMIT 10").
+		WithArgs("foo", "fix").WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT count(*) FROM \"builds\" WHERE repo_id IN (SELECT id FROM \"repos\" WHERE \"org\" = $1) AND "+_logs).
+		WithArgs("foo", "fix", "fix").WillReturnRows(_rows)
+
+	// create expected return in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "repo_id", "pipeline_id", "number", "parent", "event", "event_action", "status", "error", "enqueued", "created", "started", "finished", "deploy", "deploy_payload", "clone", "source", "title", "message", "commit", "sender", "author", "email", "link", "branch", "ref", "base_ref", "head_ref", "host", "runtime", "distribution", "timestamp"},
+	).AddRow(1, 1, nil, 1, 0, "", "", "", "", 0, 0, 0, 0, "", nil, "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery("SELECT * FROM \"builds\" WHERE repo_id IN (SELECT id FROM \"repos\" WHERE \"org\" = $1) AND "+_logs+" ORDER BY created DESC,id LIMIT 10").
+		WithArgs("foo", "fix", "fix").WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		logs    bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			logs:    false,
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+		{
+			failure: false,
+			logs:    true,
+			want:    []*library.Build{_buildOne},
+		},
+	}
+
+	filters := map[string]interface{}{
+		"org": "foo",
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, _, err := _database.SearchBuilds(context.TODO(), "fix", filters, test.logs, 1, 10)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("SearchBuilds should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("SearchBuilds returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("SearchBuilds is %v, want %v", got, test.want)
+		}
+	}
+}
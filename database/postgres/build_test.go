@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -12,10 +13,15 @@ import (
 
 	"github.com/go-vela/server/database/postgres/dml"
 	"github.com/go-vela/types/library"
+	"github.com/lib/pq"
 
 	"gorm.io/gorm"
 )
 
+// errDuplicateNumber represents the error returned by Postgres
+// when the number of a build for a repo was already claimed.
+var errDuplicateNumber = &pq.Error{Code: "23505", Message: `duplicate key value violates unique constraint "build_numbers_pkey"`}
+
 func TestPostgres_Client_GetBuild(t *testing.T) {
 	// setup types
 	_build := testBuild()
@@ -73,7 +79,7 @@ func TestPostgres_Client_GetBuild(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuild(1, _repo)
+		got, err := _database.GetBuild(context.TODO(), 1, _repo)
 
 		if test.failure {
 			if err == nil {
@@ -141,7 +147,7 @@ func TestPostgres_Client_GetBuildByID(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildByID(1)
+		got, err := _database.GetBuildByID(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
@@ -218,7 +224,7 @@ func TestPostgres_Client_GetLastBuild(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetLastBuild(_repo)
+		got, err := _database.GetLastBuild(context.TODO(), _repo)
 
 		if test.failure {
 			if err == nil {
@@ -295,7 +301,7 @@ func TestPostgres_Client_GetLastBuildByBranch(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetLastBuildByBranch(_repo, "master")
+		got, err := _database.GetLastBuildByBranch(context.TODO(), _repo, "master")
 
 		if test.failure {
 			if err == nil {
@@ -368,7 +374,7 @@ func TestPostgres_Client_GetPendingAndRunningBuilds(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetPendingAndRunningBuilds("")
+		got, err := _database.GetPendingAndRunningBuilds(context.TODO(), "")
 
 		if test.failure {
 			if err == nil {
@@ -415,10 +421,23 @@ func TestPostgres_Client_CreateBuild(t *testing.T) {
 	// create expected return in mock
 	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
 
-	// ensure the mock expects the query
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_claim := _database.Postgres.Session(&gorm.Session{DryRun: true}).Exec(dml.InsertBuildNumber, 1, 1).Statement
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(_claim.SQL.String()).WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectQuery(`INSERT INTO "builds" ("repo_id","pipeline_id","number","parent","event","event_action","status","error","enqueued","created","started","finished","deploy","deploy_payload","clone","source","title","message","commit","sender","author","email","link","branch","ref","base_ref","head_ref","host","runtime","distribution","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31) RETURNING "id"`).
 		WithArgs(1, nil, 1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, AnyArgument{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 1).
 		WillReturnRows(_rows)
+	_mock.ExpectCommit()
+
+	// ensure the mock expects the claim of a duplicate number is rejected
+	_mock.ExpectBegin()
+	_mock.ExpectExec(_claim.SQL.String()).WithArgs(1, 1).WillReturnError(errDuplicateNumber)
+	_mock.ExpectRollback()
 
 	// setup tests
 	tests := []struct {
@@ -427,11 +446,14 @@ func TestPostgres_Client_CreateBuild(t *testing.T) {
 		{
 			failure: false,
 		},
+		{
+			failure: true,
+		},
 	}
 
 	// run tests
 	for _, test := range tests {
-		err := _database.CreateBuild(_build)
+		err := _database.CreateBuild(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -447,6 +469,71 @@ func TestPostgres_Client_CreateBuild(t *testing.T) {
 	}
 }
 
+func TestPostgres_Client_CreateBuild_Concurrent(t *testing.T) {
+	// setup types
+	_build := testBuild()
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// the builds are created concurrently so the
+	// order of the queries between them is unknown
+	_mock.MatchExpectationsInOrder(false)
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_claim := _database.Postgres.Session(&gorm.Session{DryRun: true}).Exec(dml.InsertBuildNumber, 1, 1).Statement
+
+	// ensure the mock expects the number to be claimed
+	// once and the second claim to be rejected by the
+	// primary key of the build_numbers table
+	_mock.ExpectBegin()
+	_mock.ExpectBegin()
+	_mock.ExpectExec(_claim.SQL.String()).WithArgs(1, 1).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(_claim.SQL.String()).WithArgs(1, 1).WillReturnError(errDuplicateNumber)
+	_mock.ExpectQuery(`INSERT INTO "builds" ("repo_id","pipeline_id","number","parent","event","event_action","status","error","enqueued","created","started","finished","deploy","deploy_payload","clone","source","title","message","commit","sender","author","email","link","branch","ref","base_ref","head_ref","host","runtime","distribution","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31) RETURNING "id"`).
+		WithArgs(1, nil, 1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, AnyArgument{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	_mock.ExpectCommit()
+	_mock.ExpectRollback()
+
+	// run test
+	errs := make(chan error, 2)
+
+	for i := 0; i < 2; i++ {
+		go func() {
+			errs <- _database.CreateBuild(context.TODO(), _build)
+		}()
+	}
+
+	var failures int
+
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			failures++
+		}
+	}
+
+	if failures != 1 {
+		t.Errorf("CreateBuild returned %d errors, want 1", failures)
+	}
+
+	// the build with the rejected number is never inserted
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("CreateBuild didn't send the expected queries: %v", err)
+	}
+}
+
 func TestPostgres_Client_UpdateBuild(t *testing.T) {
 	// setup types
 	_build := testBuild()
@@ -487,7 +574,7 @@ func TestPostgres_Client_UpdateBuild(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.UpdateBuild(_build)
+		err := _database.UpdateBuild(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -532,7 +619,7 @@ func TestPostgres_Client_DeleteBuild(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.DeleteBuild(1)
+		err := _database.DeleteBuild(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/sirupsen/logrus"
+)
+
+// GetOrgActorUsage gets the build minutes and step counts for each
+// actor that triggered builds for an org from the database.
+func (c *client) GetOrgActorUsage(ctx context.Context, org string, after, before int64) ([]*api.ActorUsage, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("getting actor usage for org %s from the database", org)
+
+	type buildUsage struct {
+		Actor   string
+		Builds  int64
+		Seconds int64
+	}
+
+	type stepUsage struct {
+		Actor string
+		Steps int64
+	}
+
+	// variables to store query results and return value
+	b := new([]buildUsage)
+	s := new([]stepUsage)
+	usage := []*api.ActorUsage{}
+
+	// send query to the database and store result in variable
+	err := c.Postgres.WithContext(ctx).
+		Table(constants.TableBuild).
+		Select("builds.sender AS actor, count(*) AS builds, sum(builds.finished - builds.started) AS seconds").
+		Joins("JOIN repos ON builds.repo_id = repos.id and repos.org = ?", org).
+		Where("builds.started > 0 AND builds.finished > 0").
+		Where("builds.created > ? AND builds.created < ?", after, before).
+		Group("builds.sender").
+		Order("seconds DESC").
+		Scan(b).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// send query to the database and store result in variable
+	err = c.Postgres.WithContext(ctx).
+		Table(constants.TableStep).
+		Select("builds.sender AS actor, count(*) AS steps").
+		Joins("JOIN builds ON steps.build_id = builds.id").
+		Joins("JOIN repos ON builds.repo_id = repos.id and repos.org = ?", org).
+		Where("builds.started > 0 AND builds.finished > 0").
+		Where("builds.created > ? AND builds.created < ?", after, before).
+		Group("builds.sender").
+		Scan(s).Error
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make(map[string]int64)
+	for _, step := range *s {
+		steps[step.Actor] = step.Steps
+	}
+
+	// iterate through all query results
+	for _, build := range *b {
+		u := new(api.ActorUsage)
+
+		u.SetActor(build.Actor)
+		u.SetBuilds(build.Builds)
+		u.SetSteps(steps[build.Actor])
+		u.SetMinutes(float64(build.Seconds) / 60)
+
+		usage = append(usage, u)
+	}
+
+	return usage, nil
+}
+
+// GetOrgHostUsage gets the build minutes for each worker
+// host that ran builds for an org from the database.
+func (c *client) GetOrgHostUsage(ctx context.Context, org string, after, before int64) ([]*api.HostUsage, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("getting host usage for org %s from the database", org)
+
+	type hostUsage struct {
+		Host    string
+		Builds  int64
+		Seconds int64
+	}
+
+	// variables to store query results and return value
+	h := new([]hostUsage)
+	usage := []*api.HostUsage{}
+
+	// send query to the database and store result in variable
+	err := c.Postgres.WithContext(ctx).
+		Table(constants.TableBuild).
+		Select("builds.host AS host, count(*) AS builds, sum(builds.finished - builds.started) AS seconds").
+		Joins("JOIN repos ON builds.repo_id = repos.id and repos.org = ?", org).
+		Where("builds.started > 0 AND builds.finished > 0").
+		Where("builds.created > ? AND builds.created < ?", after, before).
+		Group("builds.host").
+		Order("seconds DESC").
+		Scan(h).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, host := range *h {
+		u := new(api.HostUsage)
+
+		u.SetHost(host.Host)
+		u.SetBuilds(host.Builds)
+		u.SetMinutes(float64(host.Seconds) / 60)
+
+		usage = append(usage, u)
+	}
+
+	return usage, nil
+}
@@ -45,6 +45,29 @@ builds (
 	timestamp      INTEGER,
 	UNIQUE(repo_id, number)
 );
+`
+
+	// CreateBuildNumberTable represents a query to create the
+	// build_numbers table for Vela. The builds table can be
+	// partitioned by month, which only enforces the uniqueness
+	// of a key within a partition, so the number of each build
+	// for a repo is claimed in this table that isn't partitioned.
+	CreateBuildNumberTable = `
+CREATE TABLE
+IF NOT EXISTS
+build_numbers (
+	repo_id INTEGER,
+	number  INTEGER,
+	PRIMARY KEY (repo_id, number)
+);
+`
+
+	// DropBuildNumberTable represents a query to
+	// drop the build_numbers table for Vela.
+	DropBuildNumberTable = `
+DROP TABLE
+IF EXISTS
+build_numbers;
 `
 
 	// CreateBuildRepoIDIndex represents a query to create an
@@ -81,5 +104,30 @@ CREATE INDEX CONCURRENTLY
 IF NOT EXISTS
 builds_source
 ON builds (source);
+`
+
+	// BuildSearchDocument represents the full-text search document
+	// for a build built from the commit message, branch and author.
+	BuildSearchDocument = `to_tsvector('simple', coalesce(title, '') || ' ' || coalesce(message, '') || ' ' || coalesce(branch, '') || ' ' || coalesce(author, '') || ' ' || coalesce(sender, ''))`
+
+	// CreateBuildSearchIndex represents a query to create a
+	// full-text search index on the builds table for the
+	// commit message, branch and author columns.
+	CreateBuildSearchIndex = `
+CREATE INDEX CONCURRENTLY
+IF NOT EXISTS
+builds_search
+ON builds USING GIN (` + BuildSearchDocument + `);
+`
+
+	// CreatePartitionedBuildSearchIndex represents a query to create
+	// a full-text search index on the builds table for the commit
+	// message, branch and author columns when the builds table is
+	// partitioned, which doesn't support creating indexes concurrently.
+	CreatePartitionedBuildSearchIndex = `
+CREATE INDEX
+IF NOT EXISTS
+builds_search
+ON builds USING GIN (` + BuildSearchDocument + `);
 `
 )
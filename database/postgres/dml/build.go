@@ -5,11 +5,12 @@
 package dml
 
 const (
-	// ListBuilds represents a query to
-	// list all builds in the database.
+	// ListBuilds represents a query to list
+	// all builds that aren't archived in the database.
 	ListBuilds = `
 SELECT *
-FROM builds;
+FROM builds
+WHERE deleted_at IS NULL;
 `
 
 	// SelectBuildByID represents a query to select
@@ -53,19 +54,37 @@ ORDER BY number DESC
 LIMIT 1;
 `
 
-	// SelectBuildsCount represents a query to select
-	// the count of builds in the database.
+	// SelectBuildsCount represents a query to select the
+	// count of builds that aren't archived in the database.
 	SelectBuildsCount = `
 SELECT count(*) as count
-FROM builds;
+FROM builds
+WHERE deleted_at IS NULL;
 `
 
-	// SelectBuildsCountByStatus represents a query to select
-	// the count of builds for a status in the database.
+	// SelectBuildsCountByStatus represents a query to select the count
+	// of builds that aren't archived for a status in the database.
 	SelectBuildsCountByStatus = `
 SELECT count(*) as count
 FROM builds
-WHERE status = ?;
+WHERE status = ?
+AND deleted_at IS NULL;
+`
+
+	// InsertBuildNumber represents a query to claim
+	// the number of a build for a repo_id in the database.
+	InsertBuildNumber = `
+INSERT INTO build_numbers (repo_id, number)
+VALUES (?, ?);
+`
+
+	// InsertBuildNumbers represents a query to claim the
+	// number of every existing build in the database.
+	InsertBuildNumbers = `
+INSERT INTO build_numbers (repo_id, number)
+SELECT repo_id, number
+FROM builds
+ON CONFLICT DO NOTHING;
 `
 
 	// DeleteBuild represents a query to
@@ -85,6 +104,7 @@ SELECT builds.created, builds.number, builds.status, repos.full_name
 FROM builds INNER JOIN repos
 ON builds.repo_id = repos.id
 WHERE builds.created > ?
-AND (builds.status = 'running' OR builds.status = 'pending');
+AND (builds.status = 'running' OR builds.status = 'pending')
+ORDER BY builds.id;
 `
 )
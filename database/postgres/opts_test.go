@@ -9,6 +9,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-vela/server/blob"
+	"github.com/go-vela/server/kms"
 	"github.com/sirupsen/logrus"
 )
 
@@ -59,6 +61,42 @@ func TestPostgres_ClientOpt_WithAddress(t *testing.T) {
 	}
 }
 
+func TestPostgres_ClientOpt_WithCompressionAlgorithm(t *testing.T) {
+	// setup types
+	c := new(client)
+	c.config = new(config)
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger)
+
+	// setup tests
+	tests := []struct {
+		algorithm string
+		want      string
+	}{
+		{
+			algorithm: "zstd",
+			want:      "zstd",
+		},
+		{
+			algorithm: "",
+			want:      "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := WithCompressionAlgorithm(test.algorithm)(c)
+
+		if err != nil {
+			t.Errorf("WithCompressionAlgorithm returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(c.config.CompressionAlgorithm, test.want) {
+			t.Errorf("WithCompressionAlgorithm is %v, want %v", c.config.CompressionAlgorithm, test.want)
+		}
+	}
+}
+
 func TestPostgres_ClientOpt_WithCompressionLevel(t *testing.T) {
 	// setup types
 	c := new(client)
@@ -203,6 +241,114 @@ func TestPostgres_ClientOpt_WithConnectionOpen(t *testing.T) {
 	}
 }
 
+func TestPostgres_ClientOpt_WithStatementTimeout(t *testing.T) {
+	// setup types
+	c := new(client)
+	c.config = new(config)
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger)
+
+	// setup tests
+	tests := []struct {
+		timeout time.Duration
+		want    time.Duration
+	}{
+		{
+			timeout: 30 * time.Second,
+			want:    30 * time.Second,
+		},
+		{
+			timeout: 0,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := WithStatementTimeout(test.timeout)(c)
+
+		if err != nil {
+			t.Errorf("WithStatementTimeout returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(c.config.StatementTimeout, test.want) {
+			t.Errorf("WithStatementTimeout is %v, want %v", c.config.StatementTimeout, test.want)
+		}
+	}
+}
+
+func TestPostgres_ClientOpt_WithSlowQueryThreshold(t *testing.T) {
+	// setup types
+	c := new(client)
+	c.config = new(config)
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger)
+
+	// setup tests
+	tests := []struct {
+		threshold time.Duration
+		want      time.Duration
+	}{
+		{
+			threshold: time.Second,
+			want:      time.Second,
+		},
+		{
+			threshold: 0,
+			want:      0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := WithSlowQueryThreshold(test.threshold)(c)
+
+		if err != nil {
+			t.Errorf("WithSlowQueryThreshold returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(c.config.SlowQueryThreshold, test.want) {
+			t.Errorf("WithSlowQueryThreshold is %v, want %v", c.config.SlowQueryThreshold, test.want)
+		}
+	}
+}
+
+func TestPostgres_ClientOpt_WithReplicas(t *testing.T) {
+	// setup types
+	c := new(client)
+	c.config = new(config)
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger)
+
+	// setup tests
+	tests := []struct {
+		replicas []string
+		want     []string
+	}{
+		{
+			replicas: []string{"replica.example.com"},
+			want:     []string{"replica.example.com"},
+		},
+		{
+			replicas: nil,
+			want:     nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := WithReplicas(test.replicas)(c)
+
+		if err != nil {
+			t.Errorf("WithReplicas returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(c.config.Replicas, test.want) {
+			t.Errorf("WithReplicas is %v, want %v", c.config.Replicas, test.want)
+		}
+	}
+}
+
 func TestPostgres_ClientOpt_WithEncryptionKey(t *testing.T) {
 	// setup types
 	c := new(client)
@@ -250,6 +396,94 @@ func TestPostgres_ClientOpt_WithEncryptionKey(t *testing.T) {
 	}
 }
 
+func TestPostgres_ClientOpt_WithKMS(t *testing.T) {
+	// setup types
+	c := new(client)
+	c.config = new(config)
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger)
+
+	_kms, err := kms.New(&kms.Setup{
+		Driver:   "local",
+		LocalKey: "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+	})
+	if err != nil {
+		t.Errorf("unable to create new kms service: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		service kms.Service
+		want    kms.Service
+	}{
+		{
+			service: _kms,
+			want:    _kms,
+		},
+		{
+			service: nil,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := WithKMS(test.service)(c)
+
+		if err != nil {
+			t.Errorf("WithKMS returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(c.config.KMS, test.want) {
+			t.Errorf("WithKMS is %v, want %v", c.config.KMS, test.want)
+		}
+	}
+}
+
+func TestPostgres_ClientOpt_WithBlob(t *testing.T) {
+	// setup types
+	c := new(client)
+	c.config = new(config)
+	logger := logrus.StandardLogger()
+	c.Logger = logrus.NewEntry(logger)
+
+	_blob, err := blob.New(&blob.Setup{
+		Driver:   "s3",
+		S3Bucket: "vela",
+	})
+	if err != nil {
+		t.Errorf("unable to create new blob service: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		service blob.Service
+		want    blob.Service
+	}{
+		{
+			service: _blob,
+			want:    _blob,
+		},
+		{
+			service: nil,
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := WithBlob(test.service)(c)
+
+		if err != nil {
+			t.Errorf("WithBlob returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(c.config.Blob, test.want) {
+			t.Errorf("WithBlob is %v, want %v", c.config.Blob, test.want)
+		}
+	}
+}
+
 func TestPostgres_ClientOpt_WithSkipCreation(t *testing.T) {
 	// setup types
 	c := new(client)
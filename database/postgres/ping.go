@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"time"
 )
@@ -43,3 +44,22 @@ func (c *client) Ping() error {
 
 	return fmt.Errorf("unable to successfully ping database")
 }
+
+// PingContext sends a single "ping" request to the database to
+// verify the connection is alive, without retrying on failure.
+func (c *client) PingContext(ctx context.Context) error {
+	c.Logger.Trace("sending ping request to the database")
+
+	// capture database/sql database from gorm database
+	//
+	// https://pkg.go.dev/gorm.io/gorm#DB.DB
+	_sql, err := c.Postgres.DB()
+	if err != nil {
+		return err
+	}
+
+	// send ping request to database
+	//
+	// https://pkg.go.dev/database/sql#DB.PingContext
+	return _sql.PingContext(ctx)
+}
@@ -5,17 +5,67 @@
 package postgres
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/blob"
+	"github.com/go-vela/server/database/announcement"
+	"github.com/go-vela/server/database/artifact"
+	"github.com/go-vela/server/database/buildbudget"
+	"github.com/go-vela/server/database/buildcredential"
+	"github.com/go-vela/server/database/builddeprecation"
+	"github.com/go-vela/server/database/buildimage"
+	"github.com/go-vela/server/database/buildredaction"
+	"github.com/go-vela/server/database/canaryrun"
+	"github.com/go-vela/server/database/capacitysnapshot"
+	"github.com/go-vela/server/database/clonesetting"
+	"github.com/go-vela/server/database/costrate"
+	"github.com/go-vela/server/database/deprecationrule"
+	"github.com/go-vela/server/database/egressrule"
+	"github.com/go-vela/server/database/ephemeralregistration"
+	"github.com/go-vela/server/database/export"
+	"github.com/go-vela/server/database/freeze"
+	"github.com/go-vela/server/database/freezeaudit"
 	"github.com/go-vela/server/database/hook"
+	"github.com/go-vela/server/database/idempotencykey"
+	"github.com/go-vela/server/database/label"
+	"github.com/go-vela/server/database/leakfinding"
+	"github.com/go-vela/server/database/lease"
 	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/metrics"
+	"github.com/go-vela/server/database/migration"
+	"github.com/go-vela/server/database/orghook"
+	"github.com/go-vela/server/database/partition"
 	"github.com/go-vela/server/database/pipeline"
 	"github.com/go-vela/server/database/postgres/ddl"
+	"github.com/go-vela/server/database/previewenvironment"
+	"github.com/go-vela/server/database/registrycredential"
+	"github.com/go-vela/server/database/registrymirror"
+	"github.com/go-vela/server/database/replica"
 	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/reposync"
+	"github.com/go-vela/server/database/repovariable"
+	"github.com/go-vela/server/database/requiredcontext"
+	"github.com/go-vela/server/database/retentionpolicy"
+	"github.com/go-vela/server/database/schedule"
+	"github.com/go-vela/server/database/servicereadiness"
+	"github.com/go-vela/server/database/stagedwebhook"
+	"github.com/go-vela/server/database/stepattempt"
+	"github.com/go-vela/server/database/stepskip"
+	"github.com/go-vela/server/database/template"
+	"github.com/go-vela/server/database/timeout"
+	"github.com/go-vela/server/database/triggertoken"
 	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/server/database/userscope"
+	"github.com/go-vela/server/database/usersession"
+	"github.com/go-vela/server/database/webhookdelivery"
+	"github.com/go-vela/server/database/webhookintake"
 	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/server/database/workergroup"
+	"github.com/go-vela/server/fault"
+	"github.com/go-vela/server/kms"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 
@@ -27,6 +77,8 @@ type (
 	config struct {
 		// specifies the address to use for the Postgres client
 		Address string
+		// specifies the algorithm of compression to use for the Postgres client
+		CompressionAlgorithm string
 		// specifies the level of compression to use for the Postgres client
 		CompressionLevel int
 		// specifies the connection duration to use for the Postgres client
@@ -35,8 +87,18 @@ type (
 		ConnectionIdle int
 		// specifies the maximum open connections for the Postgres client
 		ConnectionOpen int
+		// specifies the maximum duration of each statement for the Postgres client
+		StatementTimeout time.Duration
+		// specifies the duration after which statements are logged as slow for the Postgres client
+		SlowQueryThreshold time.Duration
+		// specifies the addresses of the read replicas for the Postgres client
+		Replicas []string
 		// specifies the encryption key to use for the Postgres client
 		EncryptionKey string
+		// specifies the key management service to use for the Postgres client
+		KMS kms.Service
+		// specifies the blob storage service to use for the Postgres client
+		Blob blob.Service
 		// specifies to skip creating tables and indexes for the Postgres client
 		SkipCreation bool
 	}
@@ -49,16 +111,102 @@ type (
 		Logger *logrus.Entry
 		// https://pkg.go.dev/github.com/go-vela/server/database/hook#HookService
 		hook.HookService
+		// https://pkg.go.dev/github.com/go-vela/server/database/label#LabelService
+		label.LabelService
 		// https://pkg.go.dev/github.com/go-vela/server/database/log#LogService
 		log.LogService
 		// https://pkg.go.dev/github.com/go-vela/server/database/pipeline#PipelineService
 		pipeline.PipelineService
 		// https://pkg.go.dev/github.com/go-vela/server/database/repo#RepoService
 		repo.RepoService
+		// https://pkg.go.dev/github.com/go-vela/server/database/schedule#ScheduleService
+		schedule.ScheduleService
+		// https://pkg.go.dev/github.com/go-vela/server/database/template#TemplateService
+		template.TemplateService
+		// https://pkg.go.dev/github.com/go-vela/server/database/triggertoken#TriggerTokenService
+		triggertoken.TriggerTokenService
 		// https://pkg.go.dev/github.com/go-vela/server/database/user#UserService
 		user.UserService
 		// https://pkg.go.dev/github.com/go-vela/server/database/worker#WorkerService
 		worker.WorkerService
+		// https://pkg.go.dev/github.com/go-vela/server/database/workergroup#WorkerGroupService
+		workergroup.WorkerGroupService
+		// https://pkg.go.dev/github.com/go-vela/server/database/canaryrun#CanaryRunService
+		canaryrun.CanaryRunService
+		// https://pkg.go.dev/github.com/go-vela/server/database/capacitysnapshot#CapacitySnapshotService
+		capacitysnapshot.CapacitySnapshotService
+		// https://pkg.go.dev/github.com/go-vela/server/database/export#ExportService
+		export.ExportService
+		// https://pkg.go.dev/github.com/go-vela/server/database/registrycredential#RegistryCredentialService
+		registrycredential.RegistryCredentialService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildcredential#BuildCredentialService
+		buildcredential.BuildCredentialService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildimage#BuildImageService
+		buildimage.BuildImageService
+		// https://pkg.go.dev/github.com/go-vela/server/database/registrymirror#RegistryMirrorService
+		registrymirror.RegistryMirrorService
+		// https://pkg.go.dev/github.com/go-vela/server/database/servicereadiness#ServiceReadinessService
+		servicereadiness.ServiceReadinessService
+		// https://pkg.go.dev/github.com/go-vela/server/database/stepattempt#StepAttemptService
+		stepattempt.StepAttemptService
+		// https://pkg.go.dev/github.com/go-vela/server/database/egressrule#EgressRuleService
+		egressrule.EgressRuleService
+		// https://pkg.go.dev/github.com/go-vela/server/database/previewenvironment#PreviewEnvironmentService
+		previewenvironment.PreviewEnvironmentService
+		// https://pkg.go.dev/github.com/go-vela/server/database/stagedwebhook#StagedWebhookService
+		stagedwebhook.StagedWebhookService
+		// https://pkg.go.dev/github.com/go-vela/server/database/idempotencykey#IdempotencyKeyService
+		idempotencykey.IdempotencyKeyService
+		// https://pkg.go.dev/github.com/go-vela/server/database/artifact#ArtifactService
+		artifact.ArtifactService
+		// https://pkg.go.dev/github.com/go-vela/server/database/orghook#OrgHookService
+		orghook.OrgHookService
+		// https://pkg.go.dev/github.com/go-vela/server/database/webhookdelivery#WebhookDeliveryService
+		webhookdelivery.WebhookDeliveryService
+		// https://pkg.go.dev/github.com/go-vela/server/database/requiredcontext#RequiredContextService
+		requiredcontext.RequiredContextService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildbudget#BuildBudgetService
+		buildbudget.BuildBudgetService
+		// https://pkg.go.dev/github.com/go-vela/server/database/stepskip#StepSkipService
+		stepskip.StepSkipService
+		// https://pkg.go.dev/github.com/go-vela/server/database/reposync#RepoSyncService
+		reposync.RepoSyncService
+		// https://pkg.go.dev/github.com/go-vela/server/database/lease#LeaseService
+		lease.LeaseService
+		// https://pkg.go.dev/github.com/go-vela/server/database/freeze#FreezeService
+		freeze.FreezeService
+		// https://pkg.go.dev/github.com/go-vela/server/database/freezeaudit#FreezeAuditService
+		freezeaudit.FreezeAuditService
+		// https://pkg.go.dev/github.com/go-vela/server/database/costrate#CostRateService
+		costrate.CostRateService
+		// https://pkg.go.dev/github.com/go-vela/server/database/userscope#UserScopeService
+		userscope.UserScopeService
+		// https://pkg.go.dev/github.com/go-vela/server/database/deprecationrule#DeprecationRuleService
+		deprecationrule.DeprecationRuleService
+		// https://pkg.go.dev/github.com/go-vela/server/database/builddeprecation#BuildDeprecationService
+		builddeprecation.BuildDeprecationService
+		// https://pkg.go.dev/github.com/go-vela/server/database/clonesetting#CloneSettingService
+		clonesetting.CloneSettingService
+		// https://pkg.go.dev/github.com/go-vela/server/database/ephemeralregistration#EphemeralRegistrationService
+		ephemeralregistration.EphemeralRegistrationService
+		// https://pkg.go.dev/github.com/go-vela/server/database/migration#MigrationService
+		migration.MigrationService
+		// https://pkg.go.dev/github.com/go-vela/server/database/webhookintake#WebhookIntakeService
+		webhookintake.WebhookIntakeService
+		// https://pkg.go.dev/github.com/go-vela/server/database/usersession#UserSessionService
+		usersession.UserSessionService
+		// https://pkg.go.dev/github.com/go-vela/server/database/repovariable#RepoVariableService
+		repovariable.RepoVariableService
+		// https://pkg.go.dev/github.com/go-vela/server/database/announcement#AnnouncementService
+		announcement.AnnouncementService
+		// https://pkg.go.dev/github.com/go-vela/server/database/buildredaction#BuildRedactionService
+		buildredaction.BuildRedactionService
+		// https://pkg.go.dev/github.com/go-vela/server/database/partition#PartitionService
+		partition.PartitionService
+		// https://pkg.go.dev/github.com/go-vela/server/database/retentionpolicy#RetentionPolicyService
+		retentionpolicy.RetentionPolicyService
+		// https://pkg.go.dev/github.com/go-vela/server/database/leakfinding#LeakFindingService
+		leakfinding.LeakFindingService
 	}
 )
 
@@ -91,10 +239,18 @@ func New(opts ...ClientOpt) (*client, error) {
 		}
 	}
 
+	// set the statement timeout for the Postgres database sessions
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/timeout?tab=doc#Postgres
+	address, err := timeout.Postgres(c.config.Address, c.config.StatementTimeout)
+	if err != nil {
+		return nil, err
+	}
+
 	// create the new Postgres database client
 	//
 	// https://pkg.go.dev/gorm.io/gorm#Open
-	_postgres, err := gorm.Open(postgres.Open(c.config.Address), &gorm.Config{})
+	_postgres, err := gorm.Open(postgres.Open(address), &gorm.Config{})
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +258,62 @@ func New(opts ...ClientOpt) (*client, error) {
 	// set the Postgres database client in the Postgres client
 	c.Postgres = _postgres
 
+	// register the fault injection callbacks with the Postgres database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/fault?tab=doc#Database
+	err = fault.Database(c.Postgres)
+	if err != nil {
+		return nil, err
+	}
+
+	// create the connection pools for the Postgres read replicas
+	replicas := []*sql.DB{}
+
+	for _, address := range c.config.Replicas {
+		// https://pkg.go.dev/github.com/go-vela/server/database/timeout?tab=doc#Postgres
+		address, err := timeout.Postgres(address, c.config.StatementTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Open
+		_replica, err := replica.Open(
+			postgres.Open(address),
+			c.config.ConnectionLife,
+			c.config.ConnectionIdle,
+			c.config.ConnectionOpen,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		replicas = append(replicas, _replica)
+	}
+
+	// register the read replica callbacks with the Postgres database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/replica?tab=doc#Register
+	err = replica.Register(c.Postgres, replicas...)
+	if err != nil {
+		return nil, err
+	}
+
+	// register the statement timeout callbacks with the Postgres database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/timeout?tab=doc#Register
+	err = timeout.Register(c.Postgres, c.config.StatementTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// register the query metrics callbacks with the Postgres database client
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/metrics?tab=doc#Register
+	err = metrics.Register(c.Postgres, c.config.SlowQueryThreshold)
+	if err != nil {
+		return nil, err
+	}
+
 	// setup database with proper configuration
 	err = setupDatabase(c)
 	if err != nil {
@@ -109,7 +321,7 @@ func New(opts ...ClientOpt) (*client, error) {
 	}
 
 	// create the services for the database
-	err = createServices(c)
+	err = createServices(c, true)
 	if err != nil {
 		return nil, err
 	}
@@ -155,24 +367,8 @@ func NewTest() (*client, sqlmock.Sqlmock, error) {
 		return nil, nil, err
 	}
 
-	// ensure the mock expects the hook queries
-	_mock.ExpectExec(hook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(hook.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the log queries
-	_mock.ExpectExec(log.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(log.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the pipeline queries
-	_mock.ExpectExec(pipeline.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(pipeline.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the repo queries
-	_mock.ExpectExec(repo.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(repo.CreateOrgNameIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the user queries
-	_mock.ExpectExec(user.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(user.CreateUserRefreshIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the worker queries
-	_mock.ExpectExec(worker.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(worker.CreateHostnameAddressIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the migration queries
+	_mock.ExpectExec(migration.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	// create the new mock Postgres database client
 	//
@@ -186,7 +382,7 @@ func NewTest() (*client, sqlmock.Sqlmock, error) {
 	}
 
 	// setup database with proper configuration
-	err = createServices(c)
+	err = createServices(c, true)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -226,26 +422,30 @@ func setupDatabase(c *client) error {
 		return err
 	}
 
-	// check if we should skip creating database objects
-	if c.config.SkipCreation {
-		c.Logger.Warning("skipping creation of data tables and indexes in the postgres database")
+	return nil
+}
 
-		return nil
-	}
+// baseline is a helper function to create the tables and
+// indexes for every resource in the database for the
+// baseline schema migration.
+func (c *client) baseline(db *gorm.DB, _ string) error {
+	c.Logger.Trace("creating baseline tables and indexes in the postgres database")
 
-	// create the tables in the database
-	err = createTables(c)
+	// create a copy of the client bound to the migration
+	// with the services creating their tables and indexes
+	_client, err := c.bind(db, false)
 	if err != nil {
 		return err
 	}
 
-	// create the indexes in the database
-	err = createIndexes(c)
+	// create the tables in the database
+	err = createTables(_client)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	// create the indexes in the database
+	return createIndexes(_client)
 }
 
 // createTables is a helper function to setup
@@ -297,16 +497,41 @@ func createIndexes(c *client) error {
 		return fmt.Errorf("unable to create builds_status index for the %s table: %w", constants.TableBuild, err)
 	}
 
-	// create the builds_created index for the builds table
-	err = c.Postgres.Exec(ddl.CreateBuildCreatedIndex).Error
+	// check if the builds table is partitioned by month
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/partition#IsPartitioned
+	partitioned, err := partition.IsPartitioned(c.Postgres, constants.TableBuild)
 	if err != nil {
-		return fmt.Errorf("unable to create builds_created index for the %s table: %w", constants.TableBuild, err)
+		return fmt.Errorf("unable to check if the %s table is partitioned: %w", constants.TableBuild, err)
 	}
 
-	// create the builds_source index for the builds table
-	err = c.Postgres.Exec(ddl.CreateBuildSourceIndex).Error
-	if err != nil {
-		return fmt.Errorf("unable to create builds_source index for the %s table: %w", constants.TableBuild, err)
+	// indexes can't be created concurrently for a partitioned table
+	// so the indexes are created when the table is partitioned
+	if !partitioned {
+		// create the builds_created index for the builds table
+		err = c.Postgres.Exec(ddl.CreateBuildCreatedIndex).Error
+		if err != nil {
+			return fmt.Errorf("unable to create builds_created index for the %s table: %w", constants.TableBuild, err)
+		}
+
+		// create the builds_source index for the builds table
+		err = c.Postgres.Exec(ddl.CreateBuildSourceIndex).Error
+		if err != nil {
+			return fmt.Errorf("unable to create builds_source index for the %s table: %w", constants.TableBuild, err)
+		}
+
+		// create the builds_search index for the builds table
+		err = c.Postgres.Exec(ddl.CreateBuildSearchIndex).Error
+		if err != nil {
+			return fmt.Errorf("unable to create builds_search index for the %s table: %w", constants.TableBuild, err)
+		}
+	} else {
+		// create the builds_search index for the builds table
+		// partitioned before the index was introduced
+		err = c.Postgres.Exec(ddl.CreatePartitionedBuildSearchIndex).Error
+		if err != nil {
+			return fmt.Errorf("unable to create builds_search index for the %s table: %w", constants.TableBuild, err)
+		}
 	}
 
 	// create the secrets_type_org_repo index for the secrets table
@@ -331,16 +556,42 @@ func createIndexes(c *client) error {
 }
 
 // createServices is a helper function to create the database services.
-func createServices(c *client) error {
+//
+// The tables and indexes for the services are created by the baseline
+// schema migration, so the services only create them for the baseline.
+func createServices(c *client, skipCreation bool) error {
 	var err error
 
+	// variable to store the envelope for encrypting fields
+	var envelope *kms.Envelope
+
+	// check if a key management service is configured
+	if c.config.KMS != nil {
+		// create the envelope for encrypting fields
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/kms#NewEnvelope
+		envelope = kms.NewEnvelope(c.config.KMS, c.config.EncryptionKey)
+	}
+
 	// create the database agnostic hook service
 	//
 	// https://pkg.go.dev/github.com/go-vela/server/database/hook#New
 	c.HookService, err = hook.New(
 		hook.WithClient(c.Postgres),
 		hook.WithLogger(c.Logger),
-		hook.WithSkipCreation(c.config.SkipCreation),
+		hook.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic label service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/label#New
+	c.LabelService, err = label.New(
+		label.WithClient(c.Postgres),
+		label.WithLogger(c.Logger),
+		label.WithSkipCreation(skipCreation),
 	)
 	if err != nil {
 		return err
@@ -350,10 +601,12 @@ func createServices(c *client) error {
 	//
 	// https://pkg.go.dev/github.com/go-vela/server/database/log#New
 	c.LogService, err = log.New(
+		log.WithBlob(c.config.Blob),
 		log.WithClient(c.Postgres),
+		log.WithCompressionAlgorithm(c.config.CompressionAlgorithm),
 		log.WithCompressionLevel(c.config.CompressionLevel),
 		log.WithLogger(c.Logger),
-		log.WithSkipCreation(c.config.SkipCreation),
+		log.WithSkipCreation(skipCreation),
 	)
 	if err != nil {
 		return err
@@ -366,7 +619,7 @@ func createServices(c *client) error {
 		pipeline.WithClient(c.Postgres),
 		pipeline.WithCompressionLevel(c.config.CompressionLevel),
 		pipeline.WithLogger(c.Logger),
-		pipeline.WithSkipCreation(c.config.SkipCreation),
+		pipeline.WithSkipCreation(skipCreation),
 	)
 	if err != nil {
 		return err
@@ -378,8 +631,45 @@ func createServices(c *client) error {
 	c.RepoService, err = repo.New(
 		repo.WithClient(c.Postgres),
 		repo.WithEncryptionKey(c.config.EncryptionKey),
+		repo.WithEnvelope(envelope),
 		repo.WithLogger(c.Logger),
-		repo.WithSkipCreation(c.config.SkipCreation),
+		repo.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic schedule service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/schedule#New
+	c.ScheduleService, err = schedule.New(
+		schedule.WithClient(c.Postgres),
+		schedule.WithLogger(c.Logger),
+		schedule.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic template service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/template#New
+	c.TemplateService, err = template.New(
+		template.WithClient(c.Postgres),
+		template.WithLogger(c.Logger),
+		template.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic triggertoken service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/triggertoken#New
+	c.TriggerTokenService, err = triggertoken.New(
+		triggertoken.WithClient(c.Postgres),
+		triggertoken.WithLogger(c.Logger),
+		triggertoken.WithSkipCreation(skipCreation),
 	)
 	if err != nil {
 		return err
@@ -391,8 +681,9 @@ func createServices(c *client) error {
 	c.UserService, err = user.New(
 		user.WithClient(c.Postgres),
 		user.WithEncryptionKey(c.config.EncryptionKey),
+		user.WithEnvelope(envelope),
 		user.WithLogger(c.Logger),
-		user.WithSkipCreation(c.config.SkipCreation),
+		user.WithSkipCreation(skipCreation),
 	)
 	if err != nil {
 		return err
@@ -404,7 +695,478 @@ func createServices(c *client) error {
 	c.WorkerService, err = worker.New(
 		worker.WithClient(c.Postgres),
 		worker.WithLogger(c.Logger),
-		worker.WithSkipCreation(c.config.SkipCreation),
+		worker.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic workergroup service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/workergroup#New
+	c.WorkerGroupService, err = workergroup.New(
+		workergroup.WithClient(c.Postgres),
+		workergroup.WithLogger(c.Logger),
+		workergroup.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic canaryrun service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/canaryrun#New
+	c.CanaryRunService, err = canaryrun.New(
+		canaryrun.WithClient(c.Postgres),
+		canaryrun.WithLogger(c.Logger),
+		canaryrun.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic capacitysnapshot service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/capacitysnapshot#New
+	c.CapacitySnapshotService, err = capacitysnapshot.New(
+		capacitysnapshot.WithClient(c.Postgres),
+		capacitysnapshot.WithLogger(c.Logger),
+		capacitysnapshot.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic export service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/export#New
+	c.ExportService, err = export.New(
+		export.WithClient(c.Postgres),
+		export.WithLogger(c.Logger),
+		export.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic registrycredential service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/registrycredential#New
+	c.RegistryCredentialService, err = registrycredential.New(
+		registrycredential.WithClient(c.Postgres),
+		registrycredential.WithEncryptionKey(c.config.EncryptionKey),
+		registrycredential.WithLogger(c.Logger),
+		registrycredential.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildcredential service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildcredential#New
+	c.BuildCredentialService, err = buildcredential.New(
+		buildcredential.WithClient(c.Postgres),
+		buildcredential.WithLogger(c.Logger),
+		buildcredential.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildimage service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildimage#New
+	c.BuildImageService, err = buildimage.New(
+		buildimage.WithClient(c.Postgres),
+		buildimage.WithLogger(c.Logger),
+		buildimage.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic registrymirror service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/registrymirror#New
+	c.RegistryMirrorService, err = registrymirror.New(
+		registrymirror.WithClient(c.Postgres),
+		registrymirror.WithLogger(c.Logger),
+		registrymirror.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic servicereadiness service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/servicereadiness#New
+	c.ServiceReadinessService, err = servicereadiness.New(
+		servicereadiness.WithClient(c.Postgres),
+		servicereadiness.WithLogger(c.Logger),
+		servicereadiness.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic stepattempt service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/stepattempt#New
+	c.StepAttemptService, err = stepattempt.New(
+		stepattempt.WithClient(c.Postgres),
+		stepattempt.WithLogger(c.Logger),
+		stepattempt.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic egressrule service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/egressrule#New
+	c.EgressRuleService, err = egressrule.New(
+		egressrule.WithClient(c.Postgres),
+		egressrule.WithLogger(c.Logger),
+		egressrule.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic previewenvironment service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/previewenvironment#New
+	c.PreviewEnvironmentService, err = previewenvironment.New(
+		previewenvironment.WithClient(c.Postgres),
+		previewenvironment.WithLogger(c.Logger),
+		previewenvironment.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic stagedwebhook service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/stagedwebhook#New
+	c.StagedWebhookService, err = stagedwebhook.New(
+		stagedwebhook.WithClient(c.Postgres),
+		stagedwebhook.WithLogger(c.Logger),
+		stagedwebhook.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic idempotencykey service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/idempotencykey#New
+	c.IdempotencyKeyService, err = idempotencykey.New(
+		idempotencykey.WithClient(c.Postgres),
+		idempotencykey.WithLogger(c.Logger),
+		idempotencykey.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic artifact service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/artifact#New
+	c.ArtifactService, err = artifact.New(
+		artifact.WithClient(c.Postgres),
+		artifact.WithLogger(c.Logger),
+		artifact.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic orghook service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/orghook#New
+	c.OrgHookService, err = orghook.New(
+		orghook.WithClient(c.Postgres),
+		orghook.WithEncryptionKey(c.config.EncryptionKey),
+		orghook.WithLogger(c.Logger),
+		orghook.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic webhookdelivery service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/webhookdelivery#New
+	c.WebhookDeliveryService, err = webhookdelivery.New(
+		webhookdelivery.WithClient(c.Postgres),
+		webhookdelivery.WithLogger(c.Logger),
+		webhookdelivery.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic requiredcontext service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/requiredcontext#New
+	c.RequiredContextService, err = requiredcontext.New(
+		requiredcontext.WithClient(c.Postgres),
+		requiredcontext.WithLogger(c.Logger),
+		requiredcontext.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildbudget service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildbudget#New
+	c.BuildBudgetService, err = buildbudget.New(
+		buildbudget.WithClient(c.Postgres),
+		buildbudget.WithLogger(c.Logger),
+		buildbudget.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic stepskip service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/stepskip#New
+	c.StepSkipService, err = stepskip.New(
+		stepskip.WithClient(c.Postgres),
+		stepskip.WithLogger(c.Logger),
+		stepskip.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic reposync service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/reposync#New
+	c.RepoSyncService, err = reposync.New(
+		reposync.WithClient(c.Postgres),
+		reposync.WithLogger(c.Logger),
+		reposync.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic lease service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/lease#New
+	c.LeaseService, err = lease.New(
+		lease.WithClient(c.Postgres),
+		lease.WithLogger(c.Logger),
+		lease.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic freeze service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/freeze#New
+	c.FreezeService, err = freeze.New(
+		freeze.WithClient(c.Postgres),
+		freeze.WithLogger(c.Logger),
+		freeze.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic freezeaudit service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/freezeaudit#New
+	c.FreezeAuditService, err = freezeaudit.New(
+		freezeaudit.WithClient(c.Postgres),
+		freezeaudit.WithLogger(c.Logger),
+		freezeaudit.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic costrate service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/costrate#New
+	c.CostRateService, err = costrate.New(
+		costrate.WithClient(c.Postgres),
+		costrate.WithLogger(c.Logger),
+		costrate.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic userscope service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/userscope#New
+	c.UserScopeService, err = userscope.New(
+		userscope.WithClient(c.Postgres),
+		userscope.WithLogger(c.Logger),
+		userscope.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic deprecationrule service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/deprecationrule#New
+	c.DeprecationRuleService, err = deprecationrule.New(
+		deprecationrule.WithClient(c.Postgres),
+		deprecationrule.WithLogger(c.Logger),
+		deprecationrule.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic builddeprecation service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/builddeprecation#New
+	c.BuildDeprecationService, err = builddeprecation.New(
+		builddeprecation.WithClient(c.Postgres),
+		builddeprecation.WithLogger(c.Logger),
+		builddeprecation.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic clonesetting service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/clonesetting#New
+	c.CloneSettingService, err = clonesetting.New(
+		clonesetting.WithClient(c.Postgres),
+		clonesetting.WithLogger(c.Logger),
+		clonesetting.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic ephemeralregistration service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/ephemeralregistration#New
+	c.EphemeralRegistrationService, err = ephemeralregistration.New(
+		ephemeralregistration.WithClient(c.Postgres),
+		ephemeralregistration.WithLogger(c.Logger),
+		ephemeralregistration.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic migration service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/migration#New
+	c.MigrationService, err = migration.New(
+		migration.WithClient(c.Postgres),
+		migration.WithLogger(c.Logger),
+		migration.WithSkipCreation(c.config.SkipCreation),
+		migration.WithBaseline(c.baseline),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic webhookintake service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/webhookintake#New
+	c.WebhookIntakeService, err = webhookintake.New(
+		webhookintake.WithClient(c.Postgres),
+		webhookintake.WithLogger(c.Logger),
+		webhookintake.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic usersession service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/usersession#New
+	c.UserSessionService, err = usersession.New(
+		usersession.WithClient(c.Postgres),
+		usersession.WithLogger(c.Logger),
+		usersession.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic repovariable service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/repovariable#New
+	c.RepoVariableService, err = repovariable.New(
+		repovariable.WithClient(c.Postgres),
+		repovariable.WithEncryptionKey(c.config.EncryptionKey),
+		repovariable.WithLogger(c.Logger),
+		repovariable.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic announcement service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/announcement#New
+	c.AnnouncementService, err = announcement.New(
+		announcement.WithClient(c.Postgres),
+		announcement.WithLogger(c.Logger),
+		announcement.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic buildredaction service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/buildredaction#New
+	c.BuildRedactionService, err = buildredaction.New(
+		buildredaction.WithClient(c.Postgres),
+		buildredaction.WithLogger(c.Logger),
+		buildredaction.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic partition service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/partition#New
+	c.PartitionService, err = partition.New(
+		partition.WithClient(c.Postgres),
+		partition.WithLogger(c.Logger),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic retentionpolicy service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/retentionpolicy#New
+	c.RetentionPolicyService, err = retentionpolicy.New(
+		retentionpolicy.WithClient(c.Postgres),
+		retentionpolicy.WithLogger(c.Logger),
+		retentionpolicy.WithSkipCreation(skipCreation),
+	)
+	if err != nil {
+		return err
+	}
+
+	// create the database agnostic leakfinding service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/leakfinding#New
+	c.LeakFindingService, err = leakfinding.New(
+		leakfinding.WithClient(c.Postgres),
+		leakfinding.WithLogger(c.Logger),
+		leakfinding.WithSkipCreation(skipCreation),
 	)
 	if err != nil {
 		return err
@@ -6,20 +6,68 @@ package postgres
 
 import (
 	"database/sql/driver"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/database/announcement"
+	"github.com/go-vela/server/database/artifact"
+	"github.com/go-vela/server/database/buildbudget"
+	"github.com/go-vela/server/database/buildcredential"
+	"github.com/go-vela/server/database/builddeprecation"
+	"github.com/go-vela/server/database/buildimage"
+	"github.com/go-vela/server/database/buildredaction"
+	"github.com/go-vela/server/database/canaryrun"
+	"github.com/go-vela/server/database/capacitysnapshot"
+	"github.com/go-vela/server/database/clonesetting"
+	"github.com/go-vela/server/database/costrate"
+	"github.com/go-vela/server/database/deprecationrule"
+	"github.com/go-vela/server/database/egressrule"
+	"github.com/go-vela/server/database/ephemeralregistration"
+	"github.com/go-vela/server/database/export"
+	"github.com/go-vela/server/database/freeze"
+	"github.com/go-vela/server/database/freezeaudit"
 	"github.com/go-vela/server/database/hook"
+	"github.com/go-vela/server/database/idempotencykey"
+	"github.com/go-vela/server/database/label"
+	"github.com/go-vela/server/database/leakfinding"
+	"github.com/go-vela/server/database/lease"
 	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/migration"
+	"github.com/go-vela/server/database/orghook"
+	"github.com/go-vela/server/database/partition"
 	"github.com/go-vela/server/database/pipeline"
 	"github.com/go-vela/server/database/postgres/ddl"
+	"github.com/go-vela/server/database/previewenvironment"
+	"github.com/go-vela/server/database/registrycredential"
+	"github.com/go-vela/server/database/registrymirror"
 	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/reposync"
+	"github.com/go-vela/server/database/repovariable"
+	"github.com/go-vela/server/database/requiredcontext"
+	"github.com/go-vela/server/database/retentionpolicy"
+	"github.com/go-vela/server/database/schedule"
+	"github.com/go-vela/server/database/servicereadiness"
+	"github.com/go-vela/server/database/stagedwebhook"
+	"github.com/go-vela/server/database/stepattempt"
+	"github.com/go-vela/server/database/stepskip"
+	"github.com/go-vela/server/database/template"
+	"github.com/go-vela/server/database/triggertoken"
 	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/server/database/userscope"
+	"github.com/go-vela/server/database/usersession"
+	"github.com/go-vela/server/database/webhookdelivery"
+	"github.com/go-vela/server/database/webhookintake"
 	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/server/database/workergroup"
+	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 )
 
+// _partitioned represents the query to check if the builds table is partitioned by month.
+var _partitioned = strings.Replace(partition.SelectPartitioned, "?", "$1", 1)
+
 func TestPostgres_New(t *testing.T) {
 	// setup tests
 	tests := []struct {
@@ -78,40 +126,6 @@ func TestPostgres_setupDatabase(t *testing.T) {
 	// ensure the mock expects the ping
 	_mock.ExpectPing()
 
-	// ensure the mock expects the table queries
-	_mock.ExpectExec(ddl.CreateBuildTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateSecretTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateServiceTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateStepTable).WillReturnResult(sqlmock.NewResult(1, 1))
-
-	// ensure the mock expects the index queries
-	_mock.ExpectExec(ddl.CreateBuildRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateBuildStatusIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateBuildCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateBuildSourceIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateSecretTypeOrgRepo).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateSecretTypeOrgTeam).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(ddl.CreateSecretTypeOrg).WillReturnResult(sqlmock.NewResult(1, 1))
-
-	// ensure the mock expects the hook queries
-	_mock.ExpectExec(hook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(hook.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the log queries
-	_mock.ExpectExec(log.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(log.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the pipeline queries
-	_mock.ExpectExec(pipeline.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(pipeline.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the repo queries
-	_mock.ExpectExec(repo.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(repo.CreateOrgNameIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the user queries
-	_mock.ExpectExec(user.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(user.CreateUserRefreshIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-	// ensure the mock expects the worker queries
-	_mock.ExpectExec(worker.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
-	_mock.ExpectExec(worker.CreateHostnameAddressIndex).WillReturnResult(sqlmock.NewResult(1, 1))
-
 	// setup the skip test database client
 	_skipDatabase, _skipMock, err := NewTest()
 	if err != nil {
@@ -160,6 +174,183 @@ func TestPostgres_setupDatabase(t *testing.T) {
 	}
 }
 
+func TestPostgres_baseline(t *testing.T) {
+	// setup types
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// ensure the mock expects the hook queries
+	_mock.ExpectExec(hook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(hook.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the label queries
+	_mock.ExpectExec(label.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(label.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the log queries
+	_mock.ExpectExec(log.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreatePostgresLineTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreateLineBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreatePostgresLineSearchIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the pipeline queries
+	_mock.ExpectExec(pipeline.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(pipeline.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the repo queries
+	_mock.ExpectExec(repo.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(repo.CreateOrgNameIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the schedule queries
+	_mock.ExpectExec(schedule.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreatePostgresRunTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreateActiveIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreateRunScheduleIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the template queries
+	_mock.ExpectExec(template.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(template.CreateOrgRepoIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the triggertoken queries
+	_mock.ExpectExec(triggertoken.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(triggertoken.CreateRepoIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the user queries
+	_mock.ExpectExec(user.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(user.CreateUserRefreshIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the worker queries
+	_mock.ExpectExec(worker.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(worker.CreateHostnameAddressIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the workergroup queries
+	_mock.ExpectExec(workergroup.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(workergroup.CreateRouteIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the canaryrun queries
+	_mock.ExpectExec(canaryrun.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(canaryrun.CreateRouteCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(canaryrun.CreateStatusIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the capacitysnapshot queries
+	_mock.ExpectExec(capacitysnapshot.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(capacitysnapshot.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the export queries
+	_mock.ExpectExec(export.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(export.CreateDatasetCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrycredential queries
+	_mock.ExpectExec(registrycredential.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildcredential queries
+	_mock.ExpectExec(buildcredential.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(buildcredential.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildimage queries
+	_mock.ExpectExec(buildimage.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(buildimage.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrymirror queries
+	_mock.ExpectExec(registrymirror.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(registrymirror.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the servicereadiness queries
+	_mock.ExpectExec(servicereadiness.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(servicereadiness.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepattempt queries
+	_mock.ExpectExec(stepattempt.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(stepattempt.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the egressrule queries
+	_mock.ExpectExec(egressrule.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(egressrule.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the previewenvironment queries
+	_mock.ExpectExec(previewenvironment.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(previewenvironment.CreateRepoIDNumberIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stagedwebhook queries
+	_mock.ExpectExec(stagedwebhook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(stagedwebhook.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the idempotencykey queries
+	_mock.ExpectExec(idempotencykey.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(idempotencykey.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the artifact queries
+	_mock.ExpectExec(artifact.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(artifact.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(artifact.CreateRepoIDDigestIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the orghook queries
+	_mock.ExpectExec(orghook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookdelivery queries
+	_mock.ExpectExec(webhookdelivery.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(webhookdelivery.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the requiredcontext queries
+	_mock.ExpectExec(requiredcontext.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(requiredcontext.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildbudget queries
+	_mock.ExpectExec(buildbudget.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepskip queries
+	_mock.ExpectExec(stepskip.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(stepskip.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the reposync queries
+	_mock.ExpectExec(reposync.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the lease queries
+	_mock.ExpectExec(lease.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freeze queries
+	_mock.ExpectExec(freeze.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(freeze.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freezeaudit queries
+	_mock.ExpectExec(freezeaudit.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(freezeaudit.CreateFreezeIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the costrate queries
+	_mock.ExpectExec(costrate.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the userscope queries
+	_mock.ExpectExec(userscope.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the deprecationrule queries
+	_mock.ExpectExec(deprecationrule.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(deprecationrule.CreateKindIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the builddeprecation queries
+	_mock.ExpectExec(builddeprecation.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(builddeprecation.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the clonesetting queries
+	_mock.ExpectExec(clonesetting.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the ephemeralregistration queries
+	_mock.ExpectExec(ephemeralregistration.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookintake queries
+	_mock.ExpectExec(webhookintake.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(webhookintake.CreateStatusAvailableIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the usersession queries
+	_mock.ExpectExec(usersession.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(usersession.CreateUserIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the repovariable queries
+	_mock.ExpectExec(repovariable.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the announcement queries
+	_mock.ExpectExec(announcement.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(announcement.CreateActiveIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildredaction queries
+	_mock.ExpectExec(buildredaction.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(buildredaction.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the retentionpolicy queries
+	_mock.ExpectExec(retentionpolicy.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the leakfinding queries
+	_mock.ExpectExec(leakfinding.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(leakfinding.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// ensure the mock expects the table queries
+	_mock.ExpectExec(ddl.CreateBuildTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateSecretTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateServiceTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateStepTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// ensure the mock expects the index queries
+	_mock.ExpectExec(ddl.CreateBuildRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateBuildStatusIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectQuery(_partitioned).WithArgs(constants.TableBuild).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	_mock.ExpectExec(ddl.CreateBuildCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateBuildSourceIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateBuildSearchIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateSecretTypeOrgRepo).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateSecretTypeOrgTeam).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateSecretTypeOrg).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// run test
+	err = _database.baseline(_database.Postgres, _database.Driver())
+	if err != nil {
+		t.Errorf("baseline returned err: %v", err)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("baseline didn't send the expected queries: %v", err)
+	}
+}
+
 func TestPostgres_createTables(t *testing.T) {
 	// setup types
 	// setup the test database client
@@ -215,8 +406,10 @@ func TestPostgres_createIndexes(t *testing.T) {
 	// ensure the mock expects the index queries
 	_mock.ExpectExec(ddl.CreateBuildRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(ddl.CreateBuildStatusIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectQuery(_partitioned).WithArgs(constants.TableBuild).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 	_mock.ExpectExec(ddl.CreateBuildCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(ddl.CreateBuildSourceIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(ddl.CreateBuildSearchIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(ddl.CreateSecretTypeOrgRepo).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(ddl.CreateSecretTypeOrgTeam).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(ddl.CreateSecretTypeOrg).WillReturnResult(sqlmock.NewResult(1, 1))
@@ -260,21 +453,142 @@ func TestPostgres_createServices(t *testing.T) {
 	// ensure the mock expects the hook queries
 	_mock.ExpectExec(hook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(hook.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the label queries
+	_mock.ExpectExec(label.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(label.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	// ensure the mock expects the log queries
 	_mock.ExpectExec(log.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(log.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreatePostgresLineTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreateLineBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(log.CreatePostgresLineSearchIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	// ensure the mock expects the pipeline queries
 	_mock.ExpectExec(pipeline.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(pipeline.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	// ensure the mock expects the repo queries
 	_mock.ExpectExec(repo.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(repo.CreateOrgNameIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the schedule queries
+	_mock.ExpectExec(schedule.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreatePostgresRunTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreateActiveIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(schedule.CreateRunScheduleIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the template queries
+	_mock.ExpectExec(template.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(template.CreateOrgRepoIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the triggertoken queries
+	_mock.ExpectExec(triggertoken.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(triggertoken.CreateRepoIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	// ensure the mock expects the user queries
 	_mock.ExpectExec(user.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(user.CreateUserRefreshIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 	// ensure the mock expects the worker queries
 	_mock.ExpectExec(worker.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
 	_mock.ExpectExec(worker.CreateHostnameAddressIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the workergroup queries
+	_mock.ExpectExec(workergroup.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(workergroup.CreateRouteIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the canaryrun queries
+	_mock.ExpectExec(canaryrun.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(canaryrun.CreateRouteCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(canaryrun.CreateStatusIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the capacitysnapshot queries
+	_mock.ExpectExec(capacitysnapshot.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(capacitysnapshot.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the export queries
+	_mock.ExpectExec(export.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(export.CreateDatasetCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrycredential queries
+	_mock.ExpectExec(registrycredential.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildcredential queries
+	_mock.ExpectExec(buildcredential.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(buildcredential.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildimage queries
+	_mock.ExpectExec(buildimage.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(buildimage.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the registrymirror queries
+	_mock.ExpectExec(registrymirror.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(registrymirror.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the servicereadiness queries
+	_mock.ExpectExec(servicereadiness.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(servicereadiness.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepattempt queries
+	_mock.ExpectExec(stepattempt.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(stepattempt.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the egressrule queries
+	_mock.ExpectExec(egressrule.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(egressrule.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the previewenvironment queries
+	_mock.ExpectExec(previewenvironment.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(previewenvironment.CreateRepoIDNumberIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stagedwebhook queries
+	_mock.ExpectExec(stagedwebhook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(stagedwebhook.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the idempotencykey queries
+	_mock.ExpectExec(idempotencykey.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(idempotencykey.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the artifact queries
+	_mock.ExpectExec(artifact.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(artifact.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(artifact.CreateRepoIDDigestIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the orghook queries
+	_mock.ExpectExec(orghook.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookdelivery queries
+	_mock.ExpectExec(webhookdelivery.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(webhookdelivery.CreateCreatedIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the requiredcontext queries
+	_mock.ExpectExec(requiredcontext.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(requiredcontext.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildbudget queries
+	_mock.ExpectExec(buildbudget.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the stepskip queries
+	_mock.ExpectExec(stepskip.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(stepskip.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the reposync queries
+	_mock.ExpectExec(reposync.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the lease queries
+	_mock.ExpectExec(lease.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freeze queries
+	_mock.ExpectExec(freeze.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(freeze.CreateOrgIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the freezeaudit queries
+	_mock.ExpectExec(freezeaudit.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(freezeaudit.CreateFreezeIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the costrate queries
+	_mock.ExpectExec(costrate.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the userscope queries
+	_mock.ExpectExec(userscope.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the deprecationrule queries
+	_mock.ExpectExec(deprecationrule.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(deprecationrule.CreateKindIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the builddeprecation queries
+	_mock.ExpectExec(builddeprecation.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(builddeprecation.CreateRepoIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the clonesetting queries
+	_mock.ExpectExec(clonesetting.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the ephemeralregistration queries
+	_mock.ExpectExec(ephemeralregistration.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the migration queries
+	_mock.ExpectExec(migration.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the webhookintake queries
+	_mock.ExpectExec(webhookintake.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(webhookintake.CreateStatusAvailableIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the usersession queries
+	_mock.ExpectExec(usersession.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(usersession.CreateUserIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the repovariable queries
+	_mock.ExpectExec(repovariable.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the announcement queries
+	_mock.ExpectExec(announcement.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(announcement.CreateActiveIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the buildredaction queries
+	_mock.ExpectExec(buildredaction.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(buildredaction.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the retentionpolicy queries
+	_mock.ExpectExec(retentionpolicy.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	// ensure the mock expects the leakfinding queries
+	_mock.ExpectExec(leakfinding.CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(leakfinding.CreateBuildIDIndex).WillReturnResult(sqlmock.NewResult(1, 1))
 
 	tests := []struct {
 		failure bool
@@ -286,7 +600,7 @@ func TestPostgres_createServices(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := createServices(_database)
+		err := createServices(_database, false)
 
 		if test.failure {
 			if err == nil {
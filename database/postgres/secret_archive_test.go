@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPostgres_Client_ArchiveSecret(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "secrets" SET "deleted_at"=$1 WHERE id = $2 AND deleted_at IS NULL`).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// run test
+	err = _database.ArchiveSecret(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("ArchiveSecret returned err: %v", err)
+	}
+}
+
+func TestPostgres_Client_RestoreSecret(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "secrets" SET "deleted_at"=$1 WHERE id = $2 AND deleted_at IS NOT NULL`).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// run test
+	err = _database.RestoreSecret(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("RestoreSecret returned err: %v", err)
+	}
+}
+
+func TestPostgres_Client_GetArchivedSecret(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "type", "org", "repo", "team", "name", "value", "images", "events", "allow_command", "created_at", "created_by", "updated_at", "updated_by", "deleted_at"},
+	).AddRow(1, "repo", "foo", "bar", "", "baz", "foob", "{}", "{}", false, 1, "user", 1, "user2", 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "secrets" WHERE type = $1 AND org = $2 AND name = $3 AND deleted_at IS NOT NULL AND repo = $4 LIMIT 1`).
+		WithArgs("repo", "foo", "baz", "bar").
+		WillReturnRows(_rows)
+
+	// run test
+	got, err := _database.GetArchivedSecret(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("GetArchivedSecret returned err: %v", err)
+	}
+
+	if got.GetID() != 1 || len(got.GetValue()) > 0 {
+		t.Errorf("GetArchivedSecret is %v, want secret 1 without a value", got)
+	}
+}
+
+func TestPostgres_Client_ListArchivedSecrets(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_count := sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+	_rows := sqlmock.NewRows(
+		[]string{"id", "type", "org", "repo", "team", "name", "value", "images", "events", "allow_command", "created_at", "created_by", "updated_at", "updated_by", "deleted_at"},
+	).AddRow(1, "org", "foo", "*", "", "bar", "baz", "{}", "{}", false, 1, "user", 1, "user2", 2)
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery(`SELECT count(*) FROM "secrets" WHERE deleted_at IS NOT NULL`).WillReturnRows(_count)
+	_mock.ExpectQuery(`SELECT * FROM "secrets" WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC,id LIMIT 10`).WillReturnRows(_rows)
+
+	// run test
+	got, count, err := _database.ListArchivedSecrets(context.TODO(), 1, 10)
+	if err != nil {
+		t.Errorf("ListArchivedSecrets returned err: %v", err)
+	}
+
+	if count != 1 || len(got) != 1 || len(got[0].GetValue()) > 0 {
+		t.Errorf("ListArchivedSecrets is %v with count %d, want secret 1 without a value", got, count)
+	}
+}
+
+func TestPostgres_Client_PurgeArchivedSecrets(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "secrets" WHERE deleted_at IS NOT NULL AND deleted_at < $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+
+	// run test
+	got, err := _database.PurgeArchivedSecrets(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("PurgeArchivedSecrets returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("PurgeArchivedSecrets is %v, want 2", got)
+	}
+}
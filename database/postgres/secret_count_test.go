@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -65,7 +66,7 @@ func TestPostgres_Client_GetTypeSecretCount_Org(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretCount("org", "foo", "*", []string{})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "org", "foo", "*", []string{})
 
 		if test.failure {
 			if err == nil {
@@ -135,7 +136,7 @@ func TestPostgres_Client_GetTypeSecretCount_Repo(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretCount("repo", "foo", "bar", []string{})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "repo", "foo", "bar", []string{})
 
 		if test.failure {
 			if err == nil {
@@ -205,7 +206,7 @@ func TestPostgres_Client_GetTypeSecretCount_Shared(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretCount("shared", "foo", "bar", []string{})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "shared", "foo", "bar", []string{})
 
 		if test.failure {
 			if err == nil {
@@ -259,7 +260,7 @@ func TestPostgres_Client_GetTypeSecretCount_Shared_Wildcard(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT count(*) FROM \"secrets\" WHERE (type = 'shared' AND org = $1) AND LOWER(team) IN ($2,$3)").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT count(*) FROM \"secrets\" WHERE (type = 'shared' AND org = $1) AND LOWER(team) IN ($2,$3) AND deleted_at IS NULL").WillReturnRows(_rows)
 
 	// setup tests
 	tests := []struct {
@@ -274,7 +275,7 @@ func TestPostgres_Client_GetTypeSecretCount_Shared_Wildcard(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretCount("shared", "foo", "*", []string{"bar", "bared"})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "shared", "foo", "*", []string{"bar", "bared"})
 
 		if test.failure {
 			if err == nil {
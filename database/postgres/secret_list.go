@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -16,14 +17,14 @@ import (
 )
 
 // GetSecretList gets a list of all secrets from the database.
-func (c *client) GetSecretList() ([]*library.Secret, error) {
+func (c *client) GetSecretList(ctx context.Context) ([]*library.Secret, error) {
 	c.Logger.Tracef("listing secrets from the database")
 
 	// variable to store query results
 	s := new([]database.Secret)
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableSecret).
 		Raw(dml.ListSecrets).
 		Scan(s).Error
@@ -58,7 +59,7 @@ func (c *client) GetSecretList() ([]*library.Secret, error) {
 
 // GetTypeSecretList gets a list of secrets by type,
 // owner, and name (repo or team) from the database.
-func (c *client) GetTypeSecretList(t, o, n string, page, perPage int, teams []string) ([]*library.Secret, error) {
+func (c *client) GetTypeSecretList(ctx context.Context, t, o, n string, page, perPage int, teams []string) ([]*library.Secret, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":  o,
@@ -87,12 +88,12 @@ func (c *client) GetTypeSecretList(t, o, n string, page, perPage int, teams []st
 	// send query to the database and store result in variable
 	switch t {
 	case constants.SecretOrg:
-		err = c.Postgres.
+		err = c.Postgres.WithContext(ctx).
 			Table(constants.TableSecret).
 			Raw(dml.ListOrgSecrets, o, perPage, offset).
 			Scan(s).Error
 	case constants.SecretRepo:
-		err = c.Postgres.
+		err = c.Postgres.WithContext(ctx).
 			Table(constants.TableSecret).
 			Raw(dml.ListRepoSecrets, o, n, perPage, offset).
 			Scan(s).Error
@@ -104,16 +105,17 @@ func (c *client) GetTypeSecretList(t, o, n string, page, perPage int, teams []st
 				lowerTeams = append(lowerTeams, strings.ToLower(t))
 			}
 
-			err = c.Postgres.
+			err = c.Postgres.WithContext(ctx).
 				Table(constants.TableSecret).
 				Where("type = 'shared' AND org = ?", o).
 				Where("LOWER(team) IN (?)", lowerTeams).
+				Where("deleted_at IS NULL").
 				Order("id DESC").
 				Limit(perPage).
 				Offset(offset).
 				Scan(s).Error
 		} else {
-			err = c.Postgres.
+			err = c.Postgres.WithContext(ctx).
 				Table(constants.TableSecret).
 				Raw(dml.ListSharedSecrets, o, n, perPage, offset).
 				Scan(s).Error
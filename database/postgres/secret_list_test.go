@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -77,7 +78,7 @@ func TestPostgres_Client_GetSecretList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetSecretList()
+		got, err := _database.GetSecretList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -158,7 +159,7 @@ func TestPostgres_Client_GetTypeSecretList_Org(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretList("org", "foo", "*", 1, 10, []string{})
+		got, err := _database.GetTypeSecretList(context.TODO(), "org", "foo", "*", 1, 10, []string{})
 
 		if test.failure {
 			if err == nil {
@@ -239,7 +240,7 @@ func TestPostgres_Client_GetTypeSecretList_Repo(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretList("repo", "foo", "bar", 1, 10, []string{})
+		got, err := _database.GetTypeSecretList(context.TODO(), "repo", "foo", "bar", 1, 10, []string{})
 
 		if test.failure {
 			if err == nil {
@@ -320,7 +321,7 @@ func TestPostgres_Client_GetTypeSecretList_Shared(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretList("shared", "foo", "bar", 1, 10, []string{"bar"})
+		got, err := _database.GetTypeSecretList(context.TODO(), "shared", "foo", "bar", 1, 10, []string{"bar"})
 
 		if test.failure {
 			if err == nil {
@@ -381,7 +382,7 @@ func TestPostgres_Client_GetTypeSecretList_Shared_Wildcard(t *testing.T) {
 		AddRow(1, "shared", "foo", "", "bared", "foob", "baz", "{}", "{}", false, 1, "user", 1, "user2")
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery("SELECT * FROM \"secrets\" WHERE (type = 'shared' AND org = $1) AND LOWER(team) IN ($2,$3) ORDER BY id DESC LIMIT 10").WillReturnRows(_rows)
+	_mock.ExpectQuery("SELECT * FROM \"secrets\" WHERE (type = 'shared' AND org = $1) AND LOWER(team) IN ($2,$3) AND deleted_at IS NULL ORDER BY id DESC LIMIT 10").WillReturnRows(_rows)
 
 	// setup tests
 	tests := []struct {
@@ -396,7 +397,7 @@ func TestPostgres_Client_GetTypeSecretList_Shared_Wildcard(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetTypeSecretList("shared", "foo", "*", 1, 10, []string{"bar", "bared"})
+		got, err := _database.GetTypeSecretList(context.TODO(), "shared", "foo", "*", 1, 10, []string{"bar", "bared"})
 
 		if test.failure {
 			if err == nil {
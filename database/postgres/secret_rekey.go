@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+)
+
+// rekeyBatchSize represents the number of secrets scanned
+// in a single query when auditing or re-keying secrets.
+const rekeyBatchSize = 100
+
+// AuditSecretEncryption captures the number of secrets with
+// encrypted values that can and can't be decrypted with the
+// current key from the database.
+func (c *client) AuditSecretEncryption(ctx context.Context) (*api.EncryptionAudit, error) {
+	c.Logger.Trace("auditing encrypted values for secrets in the database")
+
+	var total, readable, unreadable int64
+
+	err := c.scanSecretValues(ctx, func(s *database.Secret) error {
+		total++
+
+		// https://pkg.go.dev/github.com/go-vela/types/database#Secret.Decrypt
+		err := s.Decrypt(c.config.EncryptionKey)
+		if err != nil {
+			c.Logger.Debugf("unable to decrypt secret %d: %v", s.ID.Int64, err)
+
+			unreadable++
+
+			return nil
+		}
+
+		readable++
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit := new(api.EncryptionAudit)
+	audit.SetTable(constants.TableSecret)
+	audit.SetColumns([]string{"value"})
+	audit.SetTotal(total)
+	audit.SetReadable(readable)
+	audit.SetUnreadable(unreadable)
+
+	return audit, nil
+}
+
+// RekeySecrets decrypts the value for every secret with the current
+// key and re-encrypts it with the provided key in the database. It
+// returns the number of secrets that were re-encrypted.
+//
+// Secrets that can't be decrypted with the current key are skipped
+// and the value is only updated when it has not changed since it
+// was read, so concurrent updates are not lost.
+func (c *client) RekeySecrets(ctx context.Context, key string) (int, error) {
+	c.Logger.Trace("re-keying encrypted values for secrets in the database")
+
+	count := 0
+
+	err := c.scanSecretValues(ctx, func(s *database.Secret) error {
+		// capture the encrypted value to detect concurrent updates
+		value := s.Value
+
+		// https://pkg.go.dev/github.com/go-vela/types/database#Secret.Decrypt
+		err := s.Decrypt(c.config.EncryptionKey)
+		if err != nil {
+			c.Logger.Warnf("unable to decrypt secret %d for re-keying: %v", s.ID.Int64, err)
+
+			return nil
+		}
+
+		// https://pkg.go.dev/github.com/go-vela/types/database#Secret.Encrypt
+		err = s.Encrypt(key)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt secret %d: %w", s.ID.Int64, err)
+		}
+
+		// send query to the database
+		result := c.Postgres.WithContext(ctx).
+			Table(constants.TableSecret).
+			Where("id = ? AND value = ?", s.ID, value).
+			Update("value", s.Value)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		count += int(result.RowsAffected)
+
+		return nil
+	})
+
+	return count, err
+}
+
+// scanSecretValues is a helper function to call the provided function
+// with the encrypted value for every secret, ordered by ID, reading
+// the secrets from the database in batches.
+func (c *client) scanSecretValues(ctx context.Context, fn func(*database.Secret) error) error {
+	last := int64(0)
+
+	for {
+		// variable to store query results
+		secrets := []database.Secret{}
+
+		// send query to the database and store result in variable
+		err := c.Postgres.WithContext(ctx).
+			Table(constants.TableSecret).
+			Select("id", "value").
+			Where("id > ?", last).
+			Order("id").
+			Limit(rekeyBatchSize).
+			Find(&secrets).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// break the loop if there are no more secrets
+		if len(secrets) == 0 {
+			return nil
+		}
+
+		for i := range secrets {
+			last = secrets[i].ID.Int64
+
+			err = fn(&secrets[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
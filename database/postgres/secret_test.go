@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
@@ -71,7 +72,7 @@ func TestPostgres_Client_GetSecret_Org(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetSecret("org", "foo", "*", "bar")
+		got, err := _database.GetSecret(context.TODO(), "org", "foo", "*", "bar")
 
 		if test.failure {
 			if err == nil {
@@ -145,7 +146,7 @@ func TestPostgres_Client_GetSecret_Repo(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetSecret("repo", "foo", "bar", "baz")
+		got, err := _database.GetSecret(context.TODO(), "repo", "foo", "bar", "baz")
 
 		if test.failure {
 			if err == nil {
@@ -219,7 +220,7 @@ func TestPostgres_Client_GetSecret_Shared(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetSecret("shared", "foo", "bar", "baz")
+		got, err := _database.GetSecret(context.TODO(), "shared", "foo", "bar", "baz")
 
 		if test.failure {
 			if err == nil {
@@ -280,7 +281,7 @@ func TestPostgres_Client_CreateSecret(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.CreateSecret(_secret)
+		err := _database.CreateSecret(context.TODO(), _secret)
 
 		if test.failure {
 			if err == nil {
@@ -334,7 +335,7 @@ func TestPostgres_Client_UpdateSecret(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.UpdateSecret(_secret)
+		err := _database.UpdateSecret(context.TODO(), _secret)
 
 		if test.failure {
 			if err == nil {
@@ -379,7 +380,7 @@ func TestPostgres_Client_DeleteSecret(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.DeleteSecret(1)
+		err := _database.DeleteSecret(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
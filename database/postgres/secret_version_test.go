@@ -0,0 +1,95 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestPostgres_Client_GetSecretVersion(t *testing.T) {
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"version"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "version" FROM "secrets" WHERE id = $1 AND deleted_at IS NULL`).WithArgs(1).WillReturnRows(_rows)
+
+	// run test
+	got, err := _database.GetSecretVersion(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("GetSecretVersion returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("GetSecretVersion is %d, want 2", got)
+	}
+}
+
+func TestPostgres_Client_UpdateSecretIfVersion(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("repo")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`UPDATE "secrets" SET "version"=version + 1 WHERE id = $1 AND version = $2 AND deleted_at IS NULL`).
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`UPDATE "secrets" SET "org"=$1,"repo"=$2,"team"=$3,"name"=$4,"value"=$5,"type"=$6,"images"=$7,"events"=$8,"allow_command"=$9,"created_at"=$10,"created_by"=$11,"updated_at"=$12,"updated_by"=$13 WHERE "id" = $14`).
+		WithArgs("foo", "bar", nil, "baz", AnyArgument{}, "repo", "{}", "{}", false, 1, "user", AnyArgument{}, "user2", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectCommit()
+
+	// ensure the mock rejects the stale version
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`UPDATE "secrets" SET "version"=version + 1 WHERE id = $1 AND version = $2 AND deleted_at IS NULL`).
+		WithArgs(1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+	_mock.ExpectRollback()
+
+	// run test
+	got, err := _database.UpdateSecretIfVersion(context.TODO(), _secret, 1)
+	if err != nil {
+		t.Errorf("UpdateSecretIfVersion returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("UpdateSecretIfVersion is %d, want 2", got)
+	}
+
+	_, err = _database.UpdateSecretIfVersion(context.TODO(), _secret, 1)
+	if !errors.Is(err, types.ErrVersionConflict) {
+		t.Errorf("UpdateSecretIfVersion for stale version returned err %v, want %v", err, types.ErrVersionConflict)
+	}
+}
@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -54,7 +55,7 @@ func TestPostgres_Client_GetBuildServiceCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildServiceCount(_build)
+		got, err := _database.GetBuildServiceCount(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -108,7 +109,7 @@ func TestPostgres_Client_GetServiceImageCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetServiceImageCount()
+		got, err := _database.GetServiceImageCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -173,7 +174,7 @@ func TestPostgres_Client_GetServiceStatusCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetServiceStatusCount()
+		got, err := _database.GetServiceStatusCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -69,7 +70,7 @@ func TestPostgres_Client_GetServiceList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetServiceList()
+		got, err := _database.GetServiceList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -147,7 +148,7 @@ func TestPostgres_Client_GetBuildServiceList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildServiceList(_build, 1, 10)
+		got, err := _database.GetBuildServiceList(context.TODO(), _build, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -71,7 +72,7 @@ func TestPostgres_Client_GetService(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetService(1, _build)
+		got, err := _database.GetService(context.TODO(), 1, _build)
 
 		if test.failure {
 			if err == nil {
@@ -128,7 +129,7 @@ func TestPostgres_Client_CreateService(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.CreateService(_service)
+		err := _database.CreateService(context.TODO(), _service)
 
 		if test.failure {
 			if err == nil {
@@ -144,6 +145,79 @@ func TestPostgres_Client_CreateService(t *testing.T) {
 	}
 }
 
+func TestPostgres_Client_CreateServices(t *testing.T) {
+	// setup types
+	_one := testService()
+	_one.SetID(1)
+	_one.SetRepoID(1)
+	_one.SetBuildID(1)
+	_one.SetNumber(1)
+	_one.SetName("foo")
+	_one.SetImage("bar")
+
+	_two := testService()
+	_two.SetID(2)
+	_two.SetRepoID(1)
+	_two.SetBuildID(1)
+	_two.SetNumber(2)
+	_two.SetName("baz")
+	_two.SetImage("bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "services" ("build_id","repo_id","number","name","image","status","error","exit_code","created","started","finished","host","runtime","distribution","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15),($16,$17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30) RETURNING "id"`).
+		WithArgs(1, 1, 1, "foo", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, 1, 1, 1, 2, "baz", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, 2).
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		services []*library.Service
+	}{
+		{
+			failure:  false,
+			services: []*library.Service{_one, _two},
+		},
+		{
+			failure:  false,
+			services: []*library.Service{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.CreateServices(context.TODO(), test.services)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CreateServices should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CreateServices returned err: %v", err)
+		}
+
+		for i, service := range test.services {
+			if service.GetID() != int64(i+1) {
+				t.Errorf("CreateServices ID is %d, want %d", service.GetID(), i+1)
+			}
+		}
+	}
+}
+
 func TestPostgres_Client_UpdateService(t *testing.T) {
 	// setup types
 	_service := testService()
@@ -178,7 +252,7 @@ func TestPostgres_Client_UpdateService(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.UpdateService(_service)
+		err := _database.UpdateService(context.TODO(), _service)
 
 		if test.failure {
 			if err == nil {
@@ -223,7 +297,7 @@ func TestPostgres_Client_DeleteService(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.DeleteService(1)
+		err := _database.DeleteService(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
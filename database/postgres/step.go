@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"errors"
 
 	"github.com/sirupsen/logrus"
@@ -18,7 +19,7 @@ import (
 )
 
 // GetStep gets a step by number and build ID from the database.
-func (c *client) GetStep(number int, b *library.Build) (*library.Step, error) {
+func (c *client) GetStep(ctx context.Context, number int, b *library.Build) (*library.Step, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build": b.GetNumber(),
 		"step":  number,
@@ -28,7 +29,7 @@ func (c *client) GetStep(number int, b *library.Build) (*library.Step, error) {
 	s := new(database.Step)
 
 	// send query to the database and store result in variable
-	result := c.Postgres.
+	result := c.Postgres.WithContext(ctx).
 		Table(constants.TableStep).
 		Raw(dml.SelectBuildStep, b.GetID(), number).
 		Scan(s)
@@ -42,7 +43,7 @@ func (c *client) GetStep(number int, b *library.Build) (*library.Step, error) {
 }
 
 // CreateStep creates a new step in the database.
-func (c *client) CreateStep(s *library.Step) error {
+func (c *client) CreateStep(ctx context.Context, s *library.Step) error {
 	c.Logger.WithFields(logrus.Fields{
 		"step": s.GetNumber(),
 	}).Tracef("creating step %s in the database", s.GetName())
@@ -57,13 +58,56 @@ func (c *client) CreateStep(s *library.Step) error {
 	}
 
 	// send query to the database
-	return c.Postgres.
+	return c.Postgres.WithContext(ctx).
 		Table(constants.TableStep).
 		Create(step).Error
 }
 
+// CreateSteps creates a list of new steps in the database
+// with a single query. The IDs from the created steps
+// are populated in the provided steps.
+func (c *client) CreateSteps(ctx context.Context, s []*library.Step) error {
+	c.Logger.Tracef("creating %d steps in the database", len(s))
+
+	// short-circuit if there are no steps to create
+	if len(s) == 0 {
+		return nil
+	}
+
+	// variable to store the steps to create
+	steps := make([]*database.Step, 0, len(s))
+
+	for _, step := range s {
+		// cast to database type
+		tmp := database.StepFromLibrary(step)
+
+		// validate the necessary fields are populated
+		err := tmp.Validate()
+		if err != nil {
+			return err
+		}
+
+		steps = append(steps, tmp)
+	}
+
+	// send query to the database
+	err := c.Postgres.WithContext(ctx).
+		Table(constants.TableStep).
+		Create(&steps).Error
+	if err != nil {
+		return err
+	}
+
+	// populate the IDs from the created steps
+	for i, step := range steps {
+		s[i].SetID(step.ID.Int64)
+	}
+
+	return nil
+}
+
 // UpdateStep updates a step in the database.
-func (c *client) UpdateStep(s *library.Step) error {
+func (c *client) UpdateStep(ctx context.Context, s *library.Step) error {
 	c.Logger.WithFields(logrus.Fields{
 		"step": s.GetNumber(),
 	}).Tracef("updating step %s in the database", s.GetName())
@@ -78,17 +122,17 @@ func (c *client) UpdateStep(s *library.Step) error {
 	}
 
 	// send query to the database
-	return c.Postgres.
+	return c.Postgres.WithContext(ctx).
 		Table(constants.TableStep).
 		Save(step).Error
 }
 
 // DeleteStep deletes a step by unique ID from the database.
-func (c *client) DeleteStep(id int64) error {
+func (c *client) DeleteStep(ctx context.Context, id int64) error {
 	c.Logger.Tracef("deleting step %d from the database", id)
 
 	// send query to the database
-	return c.Postgres.
+	return c.Postgres.WithContext(ctx).
 		Table(constants.TableStep).
 		Exec(dml.DeleteStep, id).Error
 }
@@ -5,6 +5,8 @@
 package postgres
 
 import (
+	"context"
+
 	"github.com/go-vela/server/database/postgres/dml"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // GetBuildStepCount gets a count of all steps by build ID from the database.
-func (c *client) GetBuildStepCount(b *library.Build) (int64, error) {
+func (c *client) GetBuildStepCount(ctx context.Context, b *library.Build) (int64, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build": b.GetNumber(),
 	}).Tracef("getting count of steps for build %d from the database", b.GetNumber())
@@ -21,7 +23,7 @@ func (c *client) GetBuildStepCount(b *library.Build) (int64, error) {
 	var s int64
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableStep).
 		Raw(dml.SelectBuildStepsCount, b.GetID()).
 		Pluck("count", &s).Error
@@ -31,7 +33,7 @@ func (c *client) GetBuildStepCount(b *library.Build) (int64, error) {
 
 // GetStepImageCount gets a count of all step images
 // and the count of their occurrence in the database.
-func (c *client) GetStepImageCount() (map[string]float64, error) {
+func (c *client) GetStepImageCount(ctx context.Context) (map[string]float64, error) {
 	c.Logger.Tracef("getting count of all images for steps from the database")
 
 	type imageCount struct {
@@ -44,7 +46,7 @@ func (c *client) GetStepImageCount() (map[string]float64, error) {
 	counts := make(map[string]float64)
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableStep).
 		Raw(dml.SelectStepImagesCount).
 		Scan(images).Error
@@ -58,7 +60,7 @@ func (c *client) GetStepImageCount() (map[string]float64, error) {
 
 // GetStepStatusCount gets a list of all step statuses
 // and the count of their occurrence in the database.
-func (c *client) GetStepStatusCount() (map[string]float64, error) {
+func (c *client) GetStepStatusCount(ctx context.Context) (map[string]float64, error) {
 	c.Logger.Trace("getting count of all statuses for steps from the database")
 
 	type statusCount struct {
@@ -77,7 +79,7 @@ func (c *client) GetStepStatusCount() (map[string]float64, error) {
 	}
 
 	// send query to the database and store result in variable
-	err := c.Postgres.
+	err := c.Postgres.WithContext(ctx).
 		Table(constants.TableStep).
 		Raw(dml.SelectStepStatusesCount).
 		Scan(s).Error
@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -54,7 +55,7 @@ func TestPostgres_Client_GetBuildStepCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildStepCount(_build)
+		got, err := _database.GetBuildStepCount(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -108,7 +109,7 @@ func TestPostgres_Client_GetStepImageCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetStepImageCount()
+		got, err := _database.GetStepImageCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -173,7 +174,7 @@ func TestPostgres_Client_GetStepStatusCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetStepStatusCount()
+		got, err := _database.GetStepStatusCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -69,7 +70,7 @@ func TestPostgres_Client_GetStepList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetStepList()
+		got, err := _database.GetStepList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -147,7 +148,7 @@ func TestPostgres_Client_GetBuildStepList(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetBuildStepList(_build, 1, 10)
+		got, err := _database.GetBuildStepList(context.TODO(), _build, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -166,3 +167,73 @@ func TestPostgres_Client_GetBuildStepList(t *testing.T) {
 		}
 	}
 }
+
+func TestPostgres_Client_GetFinishedStepListBetween(t *testing.T) {
+	// setup types
+	_stepOne := testStep()
+	_stepOne.SetID(1)
+	_stepOne.SetRepoID(1)
+	_stepOne.SetBuildID(1)
+	_stepOne.SetNumber(1)
+	_stepOne.SetName("foo")
+	_stepOne.SetImage("bar")
+	_stepOne.SetFinished(2)
+
+	_stepTwo := testStep()
+	_stepTwo.SetID(2)
+	_stepTwo.SetRepoID(1)
+	_stepTwo.SetBuildID(1)
+	_stepTwo.SetNumber(2)
+	_stepTwo.SetName("bar")
+	_stepTwo.SetImage("foo")
+	_stepTwo.SetFinished(3)
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "number", "name", "image", "stage", "status", "error", "exit_code", "created", "started", "finished", "host", "runtime", "distribution"},
+	).AddRow(1, 1, 1, 1, "foo", "bar", "", "", "", 0, 0, 0, 2, "", "", "").
+		AddRow(2, 1, 1, 2, "bar", "foo", "", "", "", 0, 0, 0, 3, "", "", "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "steps" WHERE finished > $1 AND finished <= $2 ORDER BY finished,id`).WithArgs(1, 3).WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Step
+	}{
+		{
+			failure: false,
+			want:    []*library.Step{_stepOne, _stepTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetFinishedStepListBetween(context.TODO(), 1, 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedStepListBetween should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedStepListBetween returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedStepListBetween is %v, want %v", got, test.want)
+		}
+	}
+}
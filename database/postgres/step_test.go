@@ -5,6 +5,7 @@
 package postgres
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -71,7 +72,7 @@ func TestPostgres_Client_GetStep(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetStep(1, _build)
+		got, err := _database.GetStep(context.TODO(), 1, _build)
 
 		if test.failure {
 			if err == nil {
@@ -128,7 +129,7 @@ func TestPostgres_Client_CreateStep(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.CreateStep(_step)
+		err := _database.CreateStep(context.TODO(), _step)
 
 		if test.failure {
 			if err == nil {
@@ -144,6 +145,79 @@ func TestPostgres_Client_CreateStep(t *testing.T) {
 	}
 }
 
+func TestPostgres_Client_CreateSteps(t *testing.T) {
+	// setup types
+	_one := testStep()
+	_one.SetID(1)
+	_one.SetRepoID(1)
+	_one.SetBuildID(1)
+	_one.SetNumber(1)
+	_one.SetName("foo")
+	_one.SetImage("bar")
+
+	_two := testStep()
+	_two.SetID(2)
+	_two.SetRepoID(1)
+	_two.SetBuildID(1)
+	_two.SetNumber(2)
+	_two.SetName("baz")
+	_two.SetImage("bar")
+
+	// setup the test database client
+	_database, _mock, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new postgres test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Postgres.DB(); _sql.Close() }()
+
+	// create expected return in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "steps" ("build_id","repo_id","number","name","image","stage","status","error","exit_code","created","started","finished","host","runtime","distribution","id") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16),($17,$18,$19,$20,$21,$22,$23,$24,$25,$26,$27,$28,$29,$30,$31,$32) RETURNING "id"`).
+		WithArgs(1, 1, 1, "foo", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 1, 1, 1, 2, "baz", "bar", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 2).
+		WillReturnRows(_rows)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		steps   []*library.Step
+	}{
+		{
+			failure: false,
+			steps:   []*library.Step{_one, _two},
+		},
+		{
+			failure: false,
+			steps:   []*library.Step{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := _database.CreateSteps(context.TODO(), test.steps)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CreateSteps should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CreateSteps returned err: %v", err)
+		}
+
+		for i, step := range test.steps {
+			if step.GetID() != int64(i+1) {
+				t.Errorf("CreateSteps ID is %d, want %d", step.GetID(), i+1)
+			}
+		}
+	}
+}
+
 func TestPostgres_Client_UpdateStep(t *testing.T) {
 	// setup types
 	_step := testStep()
@@ -178,7 +252,7 @@ func TestPostgres_Client_UpdateStep(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.UpdateStep(_step)
+		err := _database.UpdateStep(context.TODO(), _step)
 
 		if test.failure {
 			if err == nil {
@@ -223,7 +297,7 @@ func TestPostgres_Client_DeleteStep(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := _database.DeleteStep(1)
+		err := _database.DeleteStep(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPreviewEnvironment_Engine_CreatePreviewEnvironment(t *testing.T) {
+	// setup types
+	_preview := testPreviewEnvironment()
+	_preview.SetID(1)
+	_preview.SetRepoID(1)
+	_preview.SetBuildID(1)
+	_preview.SetNumber(1)
+	_preview.SetName("web")
+	_preview.SetURL("https://pr-1.preview.example.com")
+	_preview.SetStatus("active")
+	_preview.SetExpires(1563474077)
+	_preview.SetCreated(1563474076)
+	_preview.SetUpdated(1563474077)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "preview_environments"
+("repo_id","build_id","number","name","url","status","expires","created","updated","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`).
+		WithArgs(1, 1, 1, "web", "https://pr-1.preview.example.com", "active", 1563474077, 1563474076, 1563474077, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreatePreviewEnvironment(context.TODO(), _preview)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreatePreviewEnvironment for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreatePreviewEnvironment for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
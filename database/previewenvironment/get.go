@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetPreviewEnvironment gets a preview environment by ID from the database.
+func (e *engine) GetPreviewEnvironment(ctx context.Context, id int64) (*api.PreviewEnvironment, error) {
+	e.logger.Tracef("getting preview environment %d from the database", id)
+
+	// variable to store query results
+	p := new(types.PreviewEnvironment)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TablePreviewEnvironment).
+		Where("id = ?", id).
+		Take(p).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ToAPI(), nil
+}
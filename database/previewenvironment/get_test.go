@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestPreviewEnvironment_Engine_GetPreviewEnvironment(t *testing.T) {
+	// setup types
+	_preview := testPreviewEnvironment()
+	_preview.SetID(1)
+	_preview.SetRepoID(1)
+	_preview.SetBuildID(1)
+	_preview.SetNumber(1)
+	_preview.SetName("web")
+	_preview.SetURL("https://pr-1.preview.example.com")
+	_preview.SetStatus("active")
+	_preview.SetExpires(1563474077)
+	_preview.SetCreated(1563474076)
+	_preview.SetUpdated(1563474077)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "number", "name", "url", "status", "expires", "created", "updated"}).
+		AddRow(1, 1, 1, 1, "web", "https://pr-1.preview.example.com", "active", 1563474077, 1563474076, 1563474077)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "preview_environments" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreatePreviewEnvironment(context.TODO(), _preview)
+	if err != nil {
+		t.Errorf("unable to create test preview environment for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.PreviewEnvironment
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _preview,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _preview,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetPreviewEnvironment(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetPreviewEnvironment for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetPreviewEnvironment for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetPreviewEnvironment for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateRepoIDNumberIndex represents a query to create an
+	// index on the preview_environments table for the repo_id and number columns.
+	CreateRepoIDNumberIndex = `
+CREATE INDEX
+IF NOT EXISTS
+preview_environments_repo_id_number
+ON preview_environments (repo_id, number);
+`
+)
+
+// CreatePreviewEnvironmentIndexes creates the indexes for the preview_environments table in the database.
+func (e *engine) CreatePreviewEnvironmentIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for preview_environments table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the repo_id and number columns index for the preview_environments table
+	return e.client.WithContext(ctx).Exec(CreateRepoIDNumberIndex).Error
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestPreviewEnvironment_Engine_ListPreviewEnvironmentsForPull(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	_previewOne := testPreviewEnvironment()
+	_previewOne.SetID(1)
+	_previewOne.SetRepoID(1)
+	_previewOne.SetBuildID(1)
+	_previewOne.SetNumber(1)
+	_previewOne.SetName("web")
+	_previewOne.SetURL("https://pr-1.preview.example.com")
+	_previewOne.SetStatus("active")
+	_previewOne.SetExpires(1563474077)
+	_previewOne.SetCreated(1563474076)
+	_previewOne.SetUpdated(1563474077)
+
+	_previewTwo := testPreviewEnvironment()
+	_previewTwo.SetID(2)
+	_previewTwo.SetRepoID(1)
+	_previewTwo.SetBuildID(1)
+	_previewTwo.SetNumber(1)
+	_previewTwo.SetName("worker")
+	_previewTwo.SetURL("https://pr-1.worker.preview.example.com")
+	_previewTwo.SetStatus("active")
+	_previewTwo.SetExpires(1563474077)
+	_previewTwo.SetCreated(1563474076)
+	_previewTwo.SetUpdated(1563474077)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "build_id", "number", "name", "url", "status", "expires", "created", "updated"}).
+		AddRow(1, 1, 1, 1, "web", "https://pr-1.preview.example.com", "active", 1563474077, 1563474076, 1563474077).
+		AddRow(2, 1, 1, 1, "worker", "https://pr-1.worker.preview.example.com", "active", 1563474077, 1563474076, 1563474077)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "preview_environments" WHERE repo_id = $1 AND number = $2 ORDER BY name`).WithArgs(1, 1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreatePreviewEnvironment(context.TODO(), _previewOne)
+	if err != nil {
+		t.Errorf("unable to create test preview environment for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreatePreviewEnvironment(context.TODO(), _previewTwo)
+	if err != nil {
+		t.Errorf("unable to create test preview environment for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.PreviewEnvironment
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.PreviewEnvironment{_previewOne, _previewTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.PreviewEnvironment{_previewOne, _previewTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListPreviewEnvironmentsForPull(context.TODO(), _repo, 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListPreviewEnvironmentsForPull for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListPreviewEnvironmentsForPull for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListPreviewEnvironmentsForPull for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListPreviewEnvironmentsForRepo gets a list of preview environments by repo ID from the database.
+func (e *engine) ListPreviewEnvironmentsForRepo(ctx context.Context, r *library.Repo) ([]*api.PreviewEnvironment, error) {
+	e.logger.Tracef("listing preview environments for repo %s from the database", r.GetFullName())
+
+	// variables to store query results and return value
+	p := new([]types.PreviewEnvironment)
+	previews := []*api.PreviewEnvironment{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TablePreviewEnvironment).
+		Where("repo_id = ?", r.GetID()).
+		Order("number DESC").
+		Order("name").
+		Find(&p).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, preview := range *p {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := preview
+
+		// convert query result to API type
+		previews = append(previews, tmp.ToAPI())
+	}
+
+	return previews, nil
+}
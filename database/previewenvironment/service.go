@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// PreviewEnvironmentService represents the Vela interface for preview environment
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type PreviewEnvironmentService interface {
+	// PreviewEnvironment Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreatePreviewEnvironmentIndexes defines a function that creates the indexes for the preview_environments table.
+	CreatePreviewEnvironmentIndexes(context.Context) error
+	// CreatePreviewEnvironmentTable defines a function that creates the preview_environments table.
+	CreatePreviewEnvironmentTable(context.Context, string) error
+
+	// PreviewEnvironment Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreatePreviewEnvironment defines a function that creates a new preview environment.
+	CreatePreviewEnvironment(context.Context, *api.PreviewEnvironment) error
+	// GetPreviewEnvironment defines a function that gets a preview environment by ID.
+	GetPreviewEnvironment(context.Context, int64) (*api.PreviewEnvironment, error)
+	// ListPreviewEnvironmentsForPull defines a function that gets a list of preview environments for a pull request.
+	ListPreviewEnvironmentsForPull(context.Context, *library.Repo, int) ([]*api.PreviewEnvironment, error)
+	// ListPreviewEnvironmentsForRepo defines a function that gets a list of preview environments for a repo.
+	ListPreviewEnvironmentsForRepo(context.Context, *library.Repo) ([]*api.PreviewEnvironment, error)
+	// UpdatePreviewEnvironment defines a function that updates an existing preview environment.
+	UpdatePreviewEnvironment(context.Context, *api.PreviewEnvironment) error
+}
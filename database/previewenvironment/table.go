@@ -0,0 +1,95 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TablePreviewEnvironment represents the name of the table for preview environments.
+	TablePreviewEnvironment = "preview_environments"
+
+	// CreatePostgresTable represents a query to create the Postgres preview_environments table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+preview_environments (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	number        INTEGER,
+	name          VARCHAR(250),
+	url           VARCHAR(1000),
+	status        VARCHAR(20),
+	expires       INTEGER,
+	created       INTEGER,
+	updated       INTEGER,
+	UNIQUE(repo_id, number, name)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite preview_environments table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+preview_environments (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	number        INTEGER,
+	name          TEXT,
+	url           TEXT,
+	status        TEXT,
+	expires       INTEGER,
+	created       INTEGER,
+	updated       INTEGER,
+	UNIQUE(repo_id, number, name)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL preview_environments table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+preview_environments (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	build_id      INTEGER,
+	number        INTEGER,
+	name          VARCHAR(250),
+	url           VARCHAR(1000),
+	status        VARCHAR(20),
+	expires       INTEGER,
+	created       INTEGER,
+	updated       INTEGER,
+	UNIQUE(repo_id, number, name),
+	INDEX preview_environments_repo_id_number (repo_id, number)
+);
+`
+)
+
+// CreatePreviewEnvironmentTable creates the preview_environments table in the database.
+func (e *engine) CreatePreviewEnvironmentTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating preview_environments table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the preview_environments table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the preview_environments table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the preview_environments table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdatePreviewEnvironment updates an existing preview environment in the database.
+func (e *engine) UpdatePreviewEnvironment(ctx context.Context, p *api.PreviewEnvironment) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo":    p.GetRepoID(),
+		"number":  p.GetNumber(),
+		"preview": p.GetName(),
+	}).Tracef("updating preview environment %s in the database", p.GetName())
+
+	// cast the API type to database type
+	preview := types.PreviewEnvironmentFromAPI(p)
+
+	// validate the necessary fields are populated
+	err := preview.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TablePreviewEnvironment).
+		Save(preview).
+		Error
+}
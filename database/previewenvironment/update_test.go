@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package previewenvironment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPreviewEnvironment_Engine_UpdatePreviewEnvironment(t *testing.T) {
+	// setup types
+	_preview := testPreviewEnvironment()
+	_preview.SetID(1)
+	_preview.SetRepoID(1)
+	_preview.SetBuildID(1)
+	_preview.SetNumber(1)
+	_preview.SetName("web")
+	_preview.SetURL("https://pr-1.preview.example.com")
+	_preview.SetStatus("active")
+	_preview.SetExpires(1563474077)
+	_preview.SetCreated(1563474076)
+	_preview.SetUpdated(1563474077)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "preview_environments"
+SET "repo_id"=$1,"build_id"=$2,"number"=$3,"name"=$4,"url"=$5,"status"=$6,"expires"=$7,"created"=$8,"updated"=$9
+WHERE "id" = $10`).
+		WithArgs(1, 1, 1, "web", "https://pr-1.preview.example.com", "active", 1563474077, 1563474076, 1563474077, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreatePreviewEnvironment(context.TODO(), _preview)
+	if err != nil {
+		t.Errorf("unable to create test preview environment for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.UpdatePreviewEnvironment(context.TODO(), _preview)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdatePreviewEnvironment for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdatePreviewEnvironment for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRegistryCredential_Engine_CreateRegistryCredential(t *testing.T) {
+	// setup types
+	_credential := testRegistryCredential()
+	_credential.SetID(1)
+	_credential.SetOrg("github")
+	_credential.SetRegistry("ghcr.io")
+	_credential.SetUsername("octocat")
+	_credential.SetPassword("superSecretPassword")
+	_credential.SetActive(true)
+	_credential.SetCreatedAt(1)
+	_credential.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "registry_credentials"
+("org","registry","username","password","active","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`).
+		WithArgs("github", "ghcr.io", "octocat", sqlmock.AnyArg(), true, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateRegistryCredential(context.TODO(), _credential)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateRegistryCredential for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateRegistryCredential for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
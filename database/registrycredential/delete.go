@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteRegistryCredential deletes an existing registry credential from the database.
+func (e *engine) DeleteRegistryCredential(ctx context.Context, r *api.RegistryCredential) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":      r.GetOrg(),
+		"registry": r.GetRegistry(),
+	}).Tracef("deleting registry credential %s/%s from the database", r.GetOrg(), r.GetRegistry())
+
+	// cast the API type to database type
+	credential := types.RegistryCredentialFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRegistryCredential).
+		Delete(credential).
+		Error
+}
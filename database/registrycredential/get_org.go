@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// GetRegistryCredentialForOrg gets a registry credential by org and registry from the database.
+func (e *engine) GetRegistryCredentialForOrg(ctx context.Context, org, registry string) (*api.RegistryCredential, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":      org,
+		"registry": registry,
+	}).Tracef("getting registry credential %s/%s from the database", org, registry)
+
+	// variable to store query results
+	r := new(types.RegistryCredential)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRegistryCredential).
+		Where("org = ?", org).
+		Where("registry = ?", registry).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// decrypt the password for the registry credential
+	err = r.Decrypt(e.config.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt registry credential %s/%s: %w", org, registry, err)
+	}
+
+	return r.ToAPI(), nil
+}
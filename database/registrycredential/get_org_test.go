@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRegistryCredential_Engine_GetRegistryCredentialForOrg(t *testing.T) {
+	// setup types
+	_credential := testRegistryCredential()
+	_credential.SetID(1)
+	_credential.SetOrg("github")
+	_credential.SetRegistry("ghcr.io")
+	_credential.SetUsername("octocat")
+	_credential.SetPassword("superSecretPassword")
+	_credential.SetActive(true)
+	_credential.SetCreatedAt(1)
+	_credential.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "registry", "username", "password", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", "ghcr.io", "octocat", testEncrypted(t, _credential), true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "registry_credentials" WHERE org = $1 AND registry = $2 LIMIT 1`).
+		WithArgs("github", "ghcr.io").
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRegistryCredential(context.TODO(), _credential)
+	if err != nil {
+		t.Errorf("unable to create test registry credential for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.RegistryCredential
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _credential,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _credential,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetRegistryCredentialForOrg(context.TODO(), "github", "ghcr.io")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRegistryCredentialForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRegistryCredentialForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetRegistryCredentialForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
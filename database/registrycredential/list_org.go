@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ListRegistryCredentialsForOrg gets a list of registry credentials by org from the database.
+func (e *engine) ListRegistryCredentialsForOrg(ctx context.Context, org string) ([]*api.RegistryCredential, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("listing registry credentials for org %s from the database", org)
+
+	// variables to store query results and return value
+	r := new([]types.RegistryCredential)
+	credentials := []*api.RegistryCredential{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRegistryCredential).
+		Where("org = ?", org).
+		Order("registry").
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, credential := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := credential
+
+		// decrypt the password for the registry credential
+		err = tmp.Decrypt(e.config.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt registry credential %s/%s: %w", org, tmp.Registry.String, err)
+		}
+
+		// convert query result to API type
+		credentials = append(credentials, tmp.ToAPI())
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRegistryCredential_Engine_ListRegistryCredentialsForOrg(t *testing.T) {
+	// setup types
+	_credentialOne := testRegistryCredential()
+	_credentialOne.SetID(1)
+	_credentialOne.SetOrg("github")
+	_credentialOne.SetRegistry("docker.io")
+	_credentialOne.SetUsername("octocat")
+	_credentialOne.SetPassword("superSecretPassword")
+	_credentialOne.SetActive(true)
+	_credentialOne.SetCreatedAt(1)
+	_credentialOne.SetCreatedBy("octocat")
+
+	_credentialTwo := testRegistryCredential()
+	_credentialTwo.SetID(2)
+	_credentialTwo.SetOrg("github")
+	_credentialTwo.SetRegistry("ghcr.io")
+	_credentialTwo.SetUsername("octocat")
+	_credentialTwo.SetPassword("superSecretPassword")
+	_credentialTwo.SetActive(true)
+	_credentialTwo.SetCreatedAt(1)
+	_credentialTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "registry", "username", "password", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", "docker.io", "octocat", testEncrypted(t, _credentialOne), true, 1, "octocat", 0, "").
+		AddRow(2, "github", "ghcr.io", "octocat", testEncrypted(t, _credentialTwo), true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "registry_credentials" WHERE org = $1 ORDER BY registry`).
+		WithArgs("github").
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRegistryCredential(context.TODO(), _credentialTwo)
+	if err != nil {
+		t.Errorf("unable to create test registry credential for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateRegistryCredential(context.TODO(), _credentialOne)
+	if err != nil {
+		t.Errorf("unable to create test registry credential for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.RegistryCredential
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.RegistryCredential{_credentialOne, _credentialTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.RegistryCredential{_credentialOne, _credentialTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListRegistryCredentialsForOrg(context.TODO(), "github")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListRegistryCredentialsForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListRegistryCredentialsForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListRegistryCredentialsForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the RegistryCredentialService interface.
+	config struct {
+		// specifies the encryption key to use for the RegistryCredential engine
+		EncryptionKey string
+		// specifies to skip creating tables and indexes for the RegistryCredential engine
+		SkipCreation bool
+	}
+
+	// engine represents the registry credential functionality that implements the RegistryCredentialService interface.
+	engine struct {
+		// engine configuration settings used in registry credential functions
+		config *config
+
+		// gorm.io/gorm database client used in registry credential functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in registry credential functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with registry credentials in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new RegistryCredential engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating registry credential database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of registry_credentials table in the database")
+
+		return e, nil
+	}
+
+	// create the registry_credentials table
+	err := e.CreateRegistryCredentialTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableRegistryCredential, err)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// RegistryCredentialService represents the Vela interface for registry
+// credential functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type RegistryCredentialService interface {
+	// RegistryCredential Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateRegistryCredentialTable defines a function that creates the registry_credentials table.
+	CreateRegistryCredentialTable(context.Context, string) error
+
+	// RegistryCredential Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateRegistryCredential defines a function that creates a new registry credential.
+	CreateRegistryCredential(context.Context, *api.RegistryCredential) error
+	// DeleteRegistryCredential defines a function that deletes an existing registry credential.
+	DeleteRegistryCredential(context.Context, *api.RegistryCredential) error
+	// GetRegistryCredentialForOrg defines a function that gets a registry credential by org and registry.
+	GetRegistryCredentialForOrg(context.Context, string, string) (*api.RegistryCredential, error)
+	// ListRegistryCredentialsForOrg defines a function that gets a list of registry credentials by org.
+	ListRegistryCredentialsForOrg(context.Context, string) ([]*api.RegistryCredential, error)
+	// UpdateRegistryCredential defines a function that updates an existing registry credential.
+	UpdateRegistryCredential(context.Context, *api.RegistryCredential) error
+}
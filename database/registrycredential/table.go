@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableRegistryCredential represents the name of the table for registry credentials.
+	TableRegistryCredential = "registry_credentials"
+
+	// CreatePostgresTable represents a query to create the Postgres registry_credentials table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+registry_credentials (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	registry      VARCHAR(250),
+	username      VARCHAR(250),
+	password      VARCHAR(1000),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, registry)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite registry_credentials table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+registry_credentials (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	registry      TEXT,
+	username      TEXT,
+	password      TEXT,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(org, registry)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL registry_credentials table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+registry_credentials (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	registry      VARCHAR(250),
+	username      VARCHAR(250),
+	password      VARCHAR(1000),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, registry)
+);
+`
+)
+
+// CreateRegistryCredentialTable creates the registry_credentials table in the database.
+func (e *engine) CreateRegistryCredentialTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating registry_credentials table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the registry_credentials table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the registry_credentials table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the registry_credentials table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
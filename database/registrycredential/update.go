@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateRegistryCredential updates an existing registry credential in the database.
+func (e *engine) UpdateRegistryCredential(ctx context.Context, r *api.RegistryCredential) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":      r.GetOrg(),
+		"registry": r.GetRegistry(),
+	}).Tracef("updating registry credential %s/%s in the database", r.GetOrg(), r.GetRegistry())
+
+	// cast the API type to database type
+	credential := types.RegistryCredentialFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := credential.Validate()
+	if err != nil {
+		return err
+	}
+
+	// encrypt the password for the registry credential
+	err = credential.Encrypt(e.config.EncryptionKey)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt registry credential %s/%s: %w", r.GetOrg(), r.GetRegistry(), err)
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRegistryCredential).
+		Save(credential).
+		Error
+}
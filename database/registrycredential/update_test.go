@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrycredential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRegistryCredential_Engine_UpdateRegistryCredential(t *testing.T) {
+	// setup types
+	_credential := testRegistryCredential()
+	_credential.SetID(1)
+	_credential.SetOrg("github")
+	_credential.SetRegistry("ghcr.io")
+	_credential.SetUsername("octocat")
+	_credential.SetPassword("superSecretPassword")
+	_credential.SetActive(true)
+	_credential.SetCreatedAt(1)
+	_credential.SetCreatedBy("octocat")
+	_credential.SetUpdatedAt(2)
+	_credential.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "registry_credentials"
+SET "org"=$1,"registry"=$2,"username"=$3,"password"=$4,"active"=$5,"created_at"=$6,"created_by"=$7,"updated_at"=$8,"updated_by"=$9
+WHERE "id" = $10`).
+		WithArgs("github", "ghcr.io", "octocat", sqlmock.AnyArg(), true, 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRegistryCredential(context.TODO(), _credential)
+	if err != nil {
+		t.Errorf("unable to create test registry credential for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateRegistryCredential(context.TODO(), _credential)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateRegistryCredential for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateRegistryCredential for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateRegistryMirror creates a new registry mirror in the database.
+func (e *engine) CreateRegistryMirror(ctx context.Context, r *api.RegistryMirror) error {
+	e.logger.WithFields(logrus.Fields{
+		"mirror": r.GetID(),
+	}).Tracef("creating registry mirror %d in the database", r.GetID())
+
+	// cast the API type to database type
+	mirror := types.RegistryMirrorFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := mirror.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRegistryMirror).
+		Create(mirror).
+		Error
+}
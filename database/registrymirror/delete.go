@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteRegistryMirror deletes an existing registry mirror from the database.
+func (e *engine) DeleteRegistryMirror(ctx context.Context, r *api.RegistryMirror) error {
+	e.logger.WithFields(logrus.Fields{
+		"mirror": r.GetID(),
+	}).Tracef("deleting registry mirror %d from the database", r.GetID())
+
+	// cast the API type to database type
+	mirror := types.RegistryMirrorFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRegistryMirror).
+		Delete(mirror).
+		Error
+}
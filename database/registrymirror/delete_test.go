@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRegistryMirror_Engine_DeleteRegistryMirror(t *testing.T) {
+	// setup types
+	_mirror := testRegistryMirror()
+	_mirror.SetID(1)
+	_mirror.SetOrg("github")
+	_mirror.SetRegistry("docker.io")
+	_mirror.SetMirror("mirror.example.com/dockerhub")
+	_mirror.SetActive(true)
+	_mirror.SetCreatedAt(1)
+	_mirror.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "registry_mirrors" WHERE "registry_mirrors"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRegistryMirror(context.TODO(), _mirror)
+	if err != nil {
+		t.Errorf("unable to create test registry mirror for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteRegistryMirror(context.TODO(), _mirror)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteRegistryMirror for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteRegistryMirror for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetRegistryMirror gets a registry mirror by ID from the database.
+func (e *engine) GetRegistryMirror(ctx context.Context, id int64) (*api.RegistryMirror, error) {
+	e.logger.Tracef("getting registry mirror %d from the database", id)
+
+	// variable to store query results
+	m := new(types.RegistryMirror)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRegistryMirror).
+		Where("id = ?", id).
+		Take(m).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return m.ToAPI(), nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRegistryMirror_Engine_GetRegistryMirror(t *testing.T) {
+	// setup types
+	_mirror := testRegistryMirror()
+	_mirror.SetID(1)
+	_mirror.SetOrg("github")
+	_mirror.SetRegistry("docker.io")
+	_mirror.SetMirror("mirror.example.com/dockerhub")
+	_mirror.SetActive(true)
+	_mirror.SetCreatedAt(1)
+	_mirror.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "registry", "mirror", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", "docker.io", "mirror.example.com/dockerhub", true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "registry_mirrors" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRegistryMirror(context.TODO(), _mirror)
+	if err != nil {
+		t.Errorf("unable to create test registry mirror for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.RegistryMirror
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _mirror,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _mirror,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetRegistryMirror(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRegistryMirror for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRegistryMirror for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetRegistryMirror for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateOrgIndex represents a query to create an
+	// index on the registry_mirrors table for the org column.
+	CreateOrgIndex = `
+CREATE INDEX
+IF NOT EXISTS
+registry_mirrors_org
+ON registry_mirrors (org);
+`
+)
+
+// CreateRegistryMirrorIndexes creates the indexes for the registry_mirrors table in the database.
+func (e *engine) CreateRegistryMirrorIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for registry_mirrors table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the org column index for the registry_mirrors table
+	return e.client.WithContext(ctx).Exec(CreateOrgIndex).Error
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListRegistryMirrors gets a list of all registry mirrors from the database.
+func (e *engine) ListRegistryMirrors(ctx context.Context) ([]*api.RegistryMirror, error) {
+	e.logger.Trace("listing all registry mirrors from the database")
+
+	// variables to store query results and return value
+	m := new([]types.RegistryMirror)
+	mirrors := []*api.RegistryMirror{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRegistryMirror).
+		Order("registry").
+		Find(&m).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, mirror := range *m {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := mirror
+
+		// convert query result to API type
+		mirrors = append(mirrors, tmp.ToAPI())
+	}
+
+	return mirrors, nil
+}
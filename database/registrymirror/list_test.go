@@ -0,0 +1,104 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRegistryMirror_Engine_ListRegistryMirrors(t *testing.T) {
+	// setup types
+	_mirrorOne := testRegistryMirror()
+	_mirrorOne.SetID(1)
+	_mirrorOne.SetOrg("github")
+	_mirrorOne.SetRegistry("docker.io")
+	_mirrorOne.SetMirror("mirror.example.com/dockerhub")
+	_mirrorOne.SetActive(true)
+	_mirrorOne.SetCreatedAt(1)
+	_mirrorOne.SetCreatedBy("octocat")
+
+	_mirrorTwo := testRegistryMirror()
+	_mirrorTwo.SetID(2)
+	_mirrorTwo.SetOrg("octocat")
+	_mirrorTwo.SetRegistry("ghcr.io")
+	_mirrorTwo.SetMirror("mirror.example.com/ghcr")
+	_mirrorTwo.SetActive(true)
+	_mirrorTwo.SetCreatedAt(1)
+	_mirrorTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "registry", "mirror", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", "docker.io", "mirror.example.com/dockerhub", true, 1, "octocat", 0, "").
+		AddRow(2, "octocat", "ghcr.io", "mirror.example.com/ghcr", true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "registry_mirrors" ORDER BY registry`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRegistryMirror(context.TODO(), _mirrorOne)
+	if err != nil {
+		t.Errorf("unable to create test registry mirror for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateRegistryMirror(context.TODO(), _mirrorTwo)
+	if err != nil {
+		t.Errorf("unable to create test registry mirror for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.RegistryMirror
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.RegistryMirror{_mirrorOne, _mirrorTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.RegistryMirror{_mirrorOne, _mirrorTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListRegistryMirrors(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListRegistryMirrors for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListRegistryMirrors for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListRegistryMirrors for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
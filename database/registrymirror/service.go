@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// RegistryMirrorService represents the Vela interface for registry mirror
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type RegistryMirrorService interface {
+	// RegistryMirror Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateRegistryMirrorIndexes defines a function that creates the indexes for the registry_mirrors table.
+	CreateRegistryMirrorIndexes(context.Context) error
+	// CreateRegistryMirrorTable defines a function that creates the registry_mirrors table.
+	CreateRegistryMirrorTable(context.Context, string) error
+
+	// RegistryMirror Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateRegistryMirror defines a function that creates a new registry mirror.
+	CreateRegistryMirror(context.Context, *api.RegistryMirror) error
+	// DeleteRegistryMirror defines a function that deletes an existing registry mirror.
+	DeleteRegistryMirror(context.Context, *api.RegistryMirror) error
+	// GetRegistryMirror defines a function that gets a registry mirror by ID.
+	GetRegistryMirror(context.Context, int64) (*api.RegistryMirror, error)
+	// ListRegistryMirrors defines a function that gets a list of all registry mirrors.
+	ListRegistryMirrors(context.Context) ([]*api.RegistryMirror, error)
+	// UpdateRegistryMirror defines a function that updates an existing registry mirror.
+	UpdateRegistryMirror(context.Context, *api.RegistryMirror) error
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package registrymirror
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableRegistryMirror represents the name of the table for registry mirrors.
+	TableRegistryMirror = "registry_mirrors"
+
+	// CreatePostgresTable represents a query to create the Postgres registry_mirrors table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+registry_mirrors (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	registry      VARCHAR(250),
+	mirror        VARCHAR(500),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, registry)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite registry_mirrors table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+registry_mirrors (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	registry      TEXT,
+	mirror        TEXT,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(org, registry)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL registry_mirrors table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+registry_mirrors (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	registry      VARCHAR(250),
+	mirror        VARCHAR(500),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, registry),
+	INDEX registry_mirrors_org (org)
+);
+`
+)
+
+// CreateRegistryMirrorTable creates the registry_mirrors table in the database.
+func (e *engine) CreateRegistryMirrorTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating registry_mirrors table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the registry_mirrors table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the registry_mirrors table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the registry_mirrors table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
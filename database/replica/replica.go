@@ -0,0 +1,331 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package replica provides the ability to send the read-only
+// statements for the database to a set of read replicas.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/database/replica"
+package replica
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/callbacks"
+)
+
+const (
+	// replicaKey represents the key for storing
+	// the replica a statement was sent to.
+	replicaKey = "replica:replica"
+
+	// primaryKey represents the key for marking a
+	// context that must send statements to the primary.
+	primaryKey = "replica:primary"
+)
+
+// downtime represents the duration an unreachable
+// replica is skipped before statements are sent to it again.
+var downtime = 30 * time.Second
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+type (
+	// replica represents a read replica for the database.
+	replica struct {
+		pool *sql.DB
+
+		mu   sync.Mutex
+		down time.Time
+	}
+
+	// resolver represents the callbacks for
+	// choosing the database for each statement.
+	resolver struct {
+		primary  gorm.ConnPool
+		replicas []*replica
+		next     uint64
+	}
+)
+
+// Open creates the connection pool for a read replica with the provided
+// dialector and connection settings. The replica isn't contacted until
+// a statement is sent to it, so an unreachable replica doesn't prevent
+// the database client from starting.
+func Open(d gorm.Dialector, life time.Duration, idle, open int) (*sql.DB, error) {
+	// create the database client for the replica
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	db, err := gorm.Open(d, &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		return nil, err
+	}
+
+	// capture database/sql database from gorm database
+	//
+	// https://pkg.go.dev/gorm.io/gorm#DB.DB
+	pool, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	pool.SetConnMaxLifetime(life)
+	pool.SetMaxIdleConns(idle)
+	pool.SetMaxOpenConns(open)
+
+	return pool, nil
+}
+
+// Primary returns a copy of the provided context that
+// sends every statement to the primary database.
+func Primary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey, true)
+}
+
+// Register registers callbacks with the provided database client to
+// send the read-only statements to the provided replicas in turn,
+// while the other statements stay on the primary. Statements in a
+// transaction stay on the primary, as do the statements that follow
+// a write for a context that enables setting values, like the
+// context for a http.Request, so a request reads its own writes.
+//
+// A replica that is unreachable is skipped for a while, and the
+// statement is sent to the primary instead. Without replicas,
+// every statement is sent to the primary.
+//
+// The callbacks must be registered before any callbacks that
+// replace the context for the statement, like the timeout.
+func Register(db *gorm.DB, pools ...*sql.DB) error {
+	// check if any replicas are provided
+	if len(pools) == 0 {
+		return nil
+	}
+
+	r := &resolver{primary: db.ConnPool}
+
+	for _, pool := range pools {
+		r.replicas = append(r.replicas, &replica{pool: pool})
+	}
+
+	err := db.Callback().Query().Before("gorm:query").Register("replica:route_query", r.route)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Query().After("gorm:query").Register("replica:fallback_query", r.fallback(callbacks.Query))
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Row().Before("gorm:row").Register("replica:route_row", r.route)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Row().After("gorm:row").Register("replica:fallback_row", r.fallback(rowsQuery))
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Create().Before("gorm:create").Register("replica:write_create", write)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Update().Before("gorm:update").Register("replica:write_update", write)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Delete().Before("gorm:delete").Register("replica:write_delete", write)
+	if err != nil {
+		return err
+	}
+
+	return db.Callback().Raw().Before("gorm:raw").Register("replica:write_raw", write)
+}
+
+// route is a helper function to send the
+// statement to the next available replica.
+func (r *resolver) route(tx *gorm.DB) {
+	if tx.Error != nil {
+		return
+	}
+
+	// check if the statement is part of a transaction
+	if tx.Statement.ConnPool != r.primary {
+		return
+	}
+
+	if !readOnly(tx) {
+		write(tx)
+
+		return
+	}
+
+	// check if the statement must be sent to the primary
+	if primary(tx.Statement.Context) {
+		return
+	}
+
+	rep := r.pick()
+	if rep == nil {
+		return
+	}
+
+	tx.Statement.ConnPool = rep.pool
+	tx.InstanceSet(replicaKey, rep)
+}
+
+// fallback is a helper function to send the statement to
+// the primary with the provided query when the replica
+// the statement was sent to is unreachable.
+func (r *resolver) fallback(query func(*gorm.DB)) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		v, ok := tx.InstanceGet(replicaKey)
+		if !ok {
+			return
+		}
+
+		rep, ok := v.(*replica)
+		if !ok {
+			return
+		}
+
+		tx.Statement.ConnPool = r.primary
+
+		if !unreachable(tx.Error) {
+			return
+		}
+
+		logrus.Warnf("unable to reach database replica, skipping for %s: %v", downtime, tx.Error)
+
+		rep.fail()
+
+		tx.Error = nil
+
+		query(tx)
+	}
+}
+
+// rowsQuery is a helper function to send the statement for the
+// rows again, restoring the setting for the rows that is removed
+// by the row query when the statement is sent.
+func rowsQuery(tx *gorm.DB) {
+	tx.Statement.Settings.Store("rows", true)
+
+	callbacks.RowQuery(tx)
+}
+
+// pick is a helper function to capture the next
+// replica that isn't skipped for being unreachable.
+func (r *resolver) pick() *replica {
+	start := atomic.AddUint64(&r.next, 1)
+
+	for i := range r.replicas {
+		rep := r.replicas[(start+uint64(i))%uint64(len(r.replicas))]
+
+		if rep.available() {
+			return rep
+		}
+	}
+
+	return nil
+}
+
+// available is a helper function to check if
+// the replica isn't skipped for being unreachable.
+func (r *replica) available() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return time.Now().After(r.down)
+}
+
+// fail is a helper function to skip the replica
+// for the downtime after it was unreachable.
+func (r *replica) fail() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.down = time.Now().Add(downtime)
+}
+
+// write is a helper function to mark the context for the
+// statement so the statements that follow it are sent to
+// the primary, if the context enables setting values.
+func write(tx *gorm.DB) {
+	if s, ok := tx.Statement.Context.(Setter); ok {
+		s.Set(primaryKey, true)
+	}
+}
+
+// primary is a helper function to check if the
+// context must send statements to the primary.
+func primary(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	v, ok := ctx.Value(primaryKey).(bool)
+
+	return ok && v
+}
+
+// readOnly is a helper function to check if the
+// statement only reads rows from the database.
+func readOnly(tx *gorm.DB) bool {
+	// check if the statement locks the rows it reads
+	if _, ok := tx.Statement.Clauses["FOR"]; ok {
+		return false
+	}
+
+	// the single row query returns the error when the row
+	// is scanned, which is too late to fall back to the primary
+	if rows, ok := tx.Statement.Settings.Load("rows"); ok {
+		if isRows, ok := rows.(bool); ok && !isRows {
+			return false
+		}
+	}
+
+	// check if the statement is built by the query callback
+	if tx.Statement.SQL.Len() == 0 {
+		return true
+	}
+
+	query := strings.ToUpper(strings.TrimSpace(tx.Statement.SQL.String()))
+
+	return strings.HasPrefix(query, "SELECT") && !strings.Contains(query, " FOR UPDATE")
+}
+
+// unreachable is a helper function to check if
+// the error is from being unable to reach the database.
+func unreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, driver.ErrBadConn) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// ArchiveRepo archives an existing repo in the database by setting
+// the time it was deleted instead of removing it. Archived repos are
+// excluded from the other queries until they are restored.
+func (e *engine) ArchiveRepo(ctx context.Context, r *library.Repo) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("archiving repo %s in the database", r.GetFullName())
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(constants.TableRepo).
+		Where("id = ?", r.GetID()).
+		Update("deleted_at", time.Now().UTC().Unix()).
+		Error
+}
@@ -5,19 +5,22 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 )
 
 // CountRepos gets the count of all repos from the database.
-func (e *engine) CountRepos() (int64, error) {
+func (e *engine) CountRepos(ctx context.Context) (int64, error) {
 	e.logger.Tracef("getting count of all repos from the database")
 
 	// variable to store query results
 	var r int64
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableRepo).
+		Where("deleted_at IS NULL").
 		Count(&r).
 		Error
 
@@ -5,12 +5,14 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 )
 
 // CountReposForOrg gets the count of repos by org name from the database.
-func (e *engine) CountReposForOrg(org string, filters map[string]interface{}) (int64, error) {
+func (e *engine) CountReposForOrg(ctx context.Context, org string, filters map[string]interface{}) (int64, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org": org,
 	}).Tracef("getting count of repos for org %s from the database", org)
@@ -19,9 +21,10 @@ func (e *engine) CountReposForOrg(org string, filters map[string]interface{}) (i
 	var r int64
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableRepo).
 		Where("org = ?", org).
+		Where("deleted_at IS NULL").
 		Where(filters).
 		Count(&r).
 		Error
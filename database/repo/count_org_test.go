@@ -5,6 +5,7 @@
 package repo
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -38,17 +39,17 @@ func TestRepo_Engine_CountReposForOrg(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE org = $1`).WithArgs("foo").WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE org = $1 AND deleted_at IS NULL`).WithArgs("foo").WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateRepo(_repoOne)
+	err := _sqlite.CreateRepo(context.TODO(), _repoOne)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateRepo(_repoTwo)
+	err = _sqlite.CreateRepo(context.TODO(), _repoTwo)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
@@ -79,7 +80,7 @@ func TestRepo_Engine_CountReposForOrg(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.CountReposForOrg("foo", filters)
+			got, err := test.database.CountReposForOrg(context.TODO(), "foo", filters)
 
 			if test.failure {
 				if err == nil {
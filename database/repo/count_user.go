@@ -5,13 +5,15 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
 
 // CountReposForUser gets the count of repos by user ID from the database.
-func (e *engine) CountReposForUser(u *library.User, filters map[string]interface{}) (int64, error) {
+func (e *engine) CountReposForUser(ctx context.Context, u *library.User, filters map[string]interface{}) (int64, error) {
 	e.logger.WithFields(logrus.Fields{
 		"user": u.GetName(),
 	}).Tracef("getting count of repos for user %s from the database", u.GetName())
@@ -20,9 +22,10 @@ func (e *engine) CountReposForUser(u *library.User, filters map[string]interface
 	var r int64
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableRepo).
 		Where("user_id = ?", u.GetID()).
+		Where("deleted_at IS NULL").
 		Where(filters).
 		Count(&r).
 		Error
@@ -6,6 +6,7 @@
 package repo
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-vela/types/constants"
@@ -15,7 +16,7 @@ import (
 )
 
 // CreateRepo creates a new repo in the database.
-func (e *engine) CreateRepo(r *library.Repo) error {
+func (e *engine) CreateRepo(ctx context.Context, r *library.Repo) error {
 	e.logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -35,15 +36,13 @@ func (e *engine) CreateRepo(r *library.Repo) error {
 	}
 
 	// encrypt the fields for the repo
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#Repo.Encrypt
-	err = repo.Encrypt(e.config.EncryptionKey)
+	err = e.encrypt(repo)
 	if err != nil {
 		return fmt.Errorf("unable to encrypt repo %s: %w", r.GetFullName(), err)
 	}
 
 	// send query to the database
-	return e.client.
+	return e.client.WithContext(ctx).
 		Table(constants.TableRepo).
 		Create(repo).
 		Error
@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-vela/server/kms"
+	"github.com/go-vela/types/database"
+)
+
+func TestRepo_Engine_encrypt(t *testing.T) {
+	// setup types
+	_envelope := testEnvelope(t)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		envelope *kms.Envelope
+		sealed   bool
+	}{
+		{
+			name:     "static key",
+			envelope: nil,
+			sealed:   false,
+		},
+		{
+			name:     "envelope",
+			envelope: _envelope,
+			sealed:   true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &engine{config: &config{
+				EncryptionKey: "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+				Envelope:      test.envelope,
+			}}
+
+			want := testDatabaseRepo()
+			r := testDatabaseRepo()
+
+			err := e.encrypt(r)
+			if err != nil {
+				t.Errorf("encrypt for %s returned err: %v", test.name, err)
+			}
+
+			if strings.HasPrefix(r.Hash.String, "vela:") != test.sealed {
+				t.Errorf("encrypt for %s is %v, want sealed %t", test.name, r.Hash.String, test.sealed)
+			}
+
+			// the envelope can open values encrypted with the static key
+			e.config.Envelope = _envelope
+
+			err = e.decrypt(r)
+			if err != nil {
+				t.Errorf("decrypt for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(r, want) {
+				t.Errorf("decrypt for %s is %v, want %v", test.name, r, want)
+			}
+		})
+	}
+}
+
+// testEnvelope is a helper function to create an envelope for testing.
+func testEnvelope(t *testing.T) *kms.Envelope {
+	_kms, err := kms.New(&kms.Setup{
+		Driver:   "local",
+		LocalKey: "Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE",
+	})
+	if err != nil {
+		t.Errorf("unable to create new kms service: %v", err)
+	}
+
+	return kms.NewEnvelope(_kms, "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW")
+}
+
+// testDatabaseRepo is a helper function to create a
+// database Repo type with the encrypted fields set.
+func testDatabaseRepo() *database.Repo {
+	return &database.Repo{
+		ID:       sql.NullInt64{Int64: 1, Valid: true},
+		Org:      sql.NullString{String: "foo", Valid: true},
+		Name:     sql.NullString{String: "bar", Valid: true},
+		FullName: sql.NullString{String: "foo/bar", Valid: true},
+		Hash:     sql.NullString{String: "baz", Valid: true},
+	}
+}
@@ -5,22 +5,25 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // GetRepo gets a repo by ID from the database.
-func (e *engine) GetRepo(id int64) (*library.Repo, error) {
+func (e *engine) GetRepo(ctx context.Context, id int64) (*library.Repo, error) {
 	e.logger.Tracef("getting repo %d from the database", id)
 
 	// variable to store query results
 	r := new(database.Repo)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableRepo).
 		Where("id = ?", id).
+		Where("deleted_at IS NULL").
 		Take(r).
 		Error
 	if err != nil {
@@ -28,9 +31,7 @@ func (e *engine) GetRepo(id int64) (*library.Repo, error) {
 	}
 
 	// decrypt the fields for the repo
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#Repo.Decrypt
-	err = r.Decrypt(e.config.EncryptionKey)
+	err = e.decrypt(r)
 	if err != nil {
 		// TODO: remove backwards compatibility before 1.x.x release
 		//
@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetArchivedRepoForOrg gets an archived repo by org and repo name from the database.
+func (e *engine) GetArchivedRepoForOrg(ctx context.Context, org, name string) (*library.Repo, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": name,
+	}).Tracef("getting archived repo %s/%s from the database", org, name)
+
+	// variable to store query results
+	r := new(database.Repo)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(constants.TableRepo).
+		Where("org = ?", org).
+		Where("name = ?", name).
+		Where("deleted_at IS NOT NULL").
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// decrypt the fields for the repo
+	err = e.decrypt(r)
+	if err != nil {
+		// log the error instead of returning it so repos
+		// archived before they were encrypted can be fetched
+		e.logger.Errorf("unable to decrypt archived repo %s/%s: %v", org, name, err)
+	}
+
+	// return the archived repo
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Repo.ToLibrary
+	return r.ToLibrary(), nil
+}
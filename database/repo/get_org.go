@@ -5,6 +5,8 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // GetRepoForOrg gets a repo by org and repo name from the database.
-func (e *engine) GetRepoForOrg(org, name string) (*library.Repo, error) {
+func (e *engine) GetRepoForOrg(ctx context.Context, org, name string) (*library.Repo, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org":  org,
 		"repo": name,
@@ -22,10 +24,11 @@ func (e *engine) GetRepoForOrg(org, name string) (*library.Repo, error) {
 	r := new(database.Repo)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableRepo).
 		Where("org = ?", org).
 		Where("name = ?", name).
+		Where("deleted_at IS NULL").
 		Take(r).
 		Error
 	if err != nil {
@@ -33,9 +36,7 @@ func (e *engine) GetRepoForOrg(org, name string) (*library.Repo, error) {
 	}
 
 	// decrypt the fields for the repo
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#Repo.Decrypt
-	err = r.Decrypt(e.config.EncryptionKey)
+	err = e.decrypt(r)
 	if err != nil {
 		// TODO: remove backwards compatibility before 1.x.x release
 		//
@@ -5,6 +5,7 @@
 package repo
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -33,12 +34,12 @@ func TestRepo_Engine_GetRepoForName(t *testing.T) {
 		AddRow(1, 1, "baz", "foo", "bar", "foo/bar", "", "", "", 0, 0, 0, "public", false, false, false, false, false, false, false, false, "yaml", "")
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery(`SELECT * FROM "repos" WHERE org = $1 AND name = $2 LIMIT 1`).WithArgs("foo", "bar").WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT * FROM "repos" WHERE org = $1 AND name = $2 AND deleted_at IS NULL LIMIT 1`).WithArgs("foo", "bar").WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateRepo(_repo)
+	err := _sqlite.CreateRepo(context.TODO(), _repo)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
@@ -67,7 +68,7 @@ func TestRepo_Engine_GetRepoForName(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.GetRepoForOrg("foo", "bar")
+			got, err := test.database.GetRepoForOrg(context.TODO(), "foo", "bar")
 
 			if test.failure {
 				if err == nil {
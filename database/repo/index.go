@@ -4,6 +4,12 @@
 
 package repo
 
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
 const (
 	// CreateOrgNameIndex represents a query to create an
 	// index on the repos table for the org and name columns.
@@ -16,9 +22,14 @@ ON repos (org, name);
 )
 
 // CreateRepoIndexes creates the indexes for the repos table in the database.
-func (e *engine) CreateRepoIndexes() error {
+func (e *engine) CreateRepoIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for repos table in the database")
 
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
 	// create the org and name columns index for the repos table
-	return e.client.Exec(CreateOrgNameIndex).Error
+	return e.client.WithContext(ctx).Exec(CreateOrgNameIndex).Error
 }
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+)
+
+// ListArchivedRepos gets a list of the archived repos from
+// the database, ordered by the most recently archived.
+func (e *engine) ListArchivedRepos(ctx context.Context, page, perPage int) ([]*library.Repo, int64, error) {
+	e.logger.Trace("listing archived repos from the database")
+
+	// variables to store query results and return values
+	count := int64(0)
+	r := new([]database.Repo)
+	repos := []*library.Repo{}
+
+	// count the results
+	err := e.client.WithContext(ctx).
+		Table(constants.TableRepo).
+		Where("deleted_at IS NOT NULL").
+		Count(&count).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return repos, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = e.client.WithContext(ctx).
+		Table(constants.TableRepo).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Order("id").
+		Limit(perPage).
+		Offset(offset).
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, count, err
+	}
+
+	// iterate through all query results
+	for _, repo := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := repo
+
+		// decrypt the fields for the repo
+		err = e.decrypt(&tmp)
+		if err != nil {
+			// log the error instead of returning it so repos
+			// archived before they were encrypted can be listed
+			e.logger.Errorf("unable to decrypt archived repo %d: %v", tmp.ID.Int64, err)
+		}
+
+		// convert query result to library type
+		//
+		// https://pkg.go.dev/github.com/go-vela/types/database#Repo.ToLibrary
+		repos = append(repos, tmp.ToLibrary())
+	}
+
+	return repos, count, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+)
+
+// ListReposArchivedBefore gets a list of up to the provided limit
+// of repos archived before the provided unix time from the database.
+func (e *engine) ListReposArchivedBefore(ctx context.Context, before int64, limit int) ([]*library.Repo, error) {
+	e.logger.Tracef("listing repos archived before %d from the database", before)
+
+	// variables to store query results and return value
+	r := new([]database.Repo)
+	repos := []*library.Repo{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(constants.TableRepo).
+		Where("deleted_at IS NOT NULL").
+		Where("deleted_at < ?", before).
+		Order("deleted_at").
+		Order("id").
+		Limit(limit).
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, repo := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := repo
+
+		// decrypt the fields for the repo
+		err = e.decrypt(&tmp)
+		if err != nil {
+			// log the error instead of returning it so repos
+			// archived before they were encrypted can be purged
+			e.logger.Errorf("unable to decrypt archived repo %d: %v", tmp.ID.Int64, err)
+		}
+
+		// convert query result to library type
+		//
+		// https://pkg.go.dev/github.com/go-vela/types/database#Repo.ToLibrary
+		repos = append(repos, tmp.ToLibrary())
+	}
+
+	return repos, nil
+}
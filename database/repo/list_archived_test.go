@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/library"
+)
+
+func TestRepo_Engine_ListArchivedRepos(t *testing.T) {
+	// setup types
+	_repoOne := testRepo()
+	_repoOne.SetID(1)
+	_repoOne.SetUserID(1)
+	_repoOne.SetHash("baz")
+	_repoOne.SetOrg("foo")
+	_repoOne.SetName("bar")
+	_repoOne.SetFullName("foo/bar")
+	_repoOne.SetVisibility("public")
+	_repoOne.SetPipelineType("yaml")
+
+	_repoTwo := testRepo()
+	_repoTwo.SetID(2)
+	_repoTwo.SetUserID(1)
+	_repoTwo.SetHash("bar")
+	_repoTwo.SetOrg("foo")
+	_repoTwo.SetName("baz")
+	_repoTwo.SetFullName("foo/baz")
+	_repoTwo.SetVisibility("public")
+	_repoTwo.SetPipelineType("yaml")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected count query result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+	// ensure the mock expects the count query
+	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE deleted_at IS NOT NULL`).WillReturnRows(_rows)
+
+	// create expected query result in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "user_id", "hash", "org", "name", "full_name", "link", "clone", "branch", "build_limit", "timeout", "counter", "visibility", "private", "trusted", "active", "allow_pull", "allow_push", "allow_deploy", "allow_tag", "allow_comment", "pipeline_type", "previous_name"}).
+		AddRow(1, 1, "baz", "foo", "bar", "foo/bar", "", "", "", 0, 0, 0, "public", false, false, false, false, false, false, false, false, "yaml", "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "repos" WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC,id LIMIT 10`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepo(context.TODO(), _repoOne)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateRepo(context.TODO(), _repoTwo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	err = _sqlite.ArchiveRepo(context.TODO(), _repoOne)
+	if err != nil {
+		t.Errorf("unable to archive test repo for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*library.Repo
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*library.Repo{_repoOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*library.Repo{_repoOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, count, err := test.database.ListArchivedRepos(context.TODO(), 1, 10)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListArchivedRepos for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListArchivedRepos for %s returned err: %v", test.name, err)
+			}
+
+			if count != 1 {
+				t.Errorf("ListArchivedRepos for %s count is %d, want 1", test.name, count)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListArchivedRepos for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
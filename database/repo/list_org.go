@@ -5,6 +5,8 @@
 package repo
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -14,7 +16,7 @@ import (
 // ListReposForOrg gets a list of repos by org name from the database.
 //
 //nolint:lll // ignore long line length due to variable names
-func (e *engine) ListReposForOrg(org, sortBy string, filters map[string]interface{}, page, perPage int) ([]*library.Repo, int64, error) {
+func (e *engine) ListReposForOrg(ctx context.Context, org, sortBy string, filters map[string]interface{}, page, perPage int) ([]*library.Repo, int64, error) {
 	e.logger.WithFields(logrus.Fields{
 		"org": org,
 	}).Tracef("listing repos for org %s from the database", org)
@@ -25,7 +27,7 @@ func (e *engine) ListReposForOrg(org, sortBy string, filters map[string]interfac
 	repos := []*library.Repo{}
 
 	// count the results
-	count, err := e.CountReposForOrg(org, filters)
+	count, err := e.CountReposForOrg(ctx, org, filters)
 	if err != nil {
 		return repos, 0, err
 	}
@@ -40,18 +42,19 @@ func (e *engine) ListReposForOrg(org, sortBy string, filters map[string]interfac
 
 	switch sortBy {
 	case "latest":
-		query := e.client.
+		query := e.client.WithContext(ctx).
 			Table(constants.TableBuild).
 			Select("repos.id, MAX(builds.created) AS latest_build").
 			Joins("INNER JOIN repos repos ON builds.repo_id = repos.id").
 			Where("repos.org = ?", org).
 			Group("repos.id")
 
-		err = e.client.
+		err = e.client.WithContext(ctx).
 			Table(constants.TableRepo).
 			Select("repos.*").
 			Joins("LEFT JOIN (?) t on repos.id = t.id", query).
-			Order("latest_build DESC NULLS LAST").
+			Where("repos.deleted_at IS NULL").
+			Order("latest_build IS NULL, latest_build DESC").
 			Limit(perPage).
 			Offset(offset).
 			Find(&r).
@@ -62,9 +65,10 @@ func (e *engine) ListReposForOrg(org, sortBy string, filters map[string]interfac
 	case "name":
 		fallthrough
 	default:
-		err = e.client.
+		err = e.client.WithContext(ctx).
 			Table(constants.TableRepo).
 			Where("org = ?", org).
+			Where("deleted_at IS NULL").
 			Where(filters).
 			Order("name").
 			Limit(perPage).
@@ -82,9 +86,7 @@ func (e *engine) ListReposForOrg(org, sortBy string, filters map[string]interfac
 		tmp := repo
 
 		// decrypt the fields for the repo
-		//
-		// https://pkg.go.dev/github.com/go-vela/types/database#Repo.Decrypt
-		err = tmp.Decrypt(e.config.EncryptionKey)
+		err = e.decrypt(&tmp)
 		if err != nil {
 			// TODO: remove backwards compatibility before 1.x.x release
 			//
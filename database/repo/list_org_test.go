@@ -5,6 +5,7 @@
 package repo
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
@@ -56,7 +57,7 @@ func TestRepo_Engine_ListReposForOrg(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the name count query
-	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE org = $1`).WithArgs("foo").WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE org = $1 AND deleted_at IS NULL`).WithArgs("foo").WillReturnRows(_rows)
 
 	// create expected name query result in mock
 	_rows = sqlmock.NewRows(
@@ -65,13 +66,13 @@ func TestRepo_Engine_ListReposForOrg(t *testing.T) {
 		AddRow(2, 1, "bar", "foo", "baz", "foo/baz", "", "", "", 0, 0, "public", false, false, false, false, false, false, false, false, "yaml", nil)
 
 	// ensure the mock expects the name query
-	_mock.ExpectQuery(`SELECT * FROM "repos" WHERE org = $1 ORDER BY name LIMIT 10`).WithArgs("foo").WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT * FROM "repos" WHERE org = $1 AND deleted_at IS NULL ORDER BY name LIMIT 10`).WithArgs("foo").WillReturnRows(_rows)
 
 	// create expected latest count query result in mock
 	_rows = sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the latest count query
-	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE org = $1`).WithArgs("foo").WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE org = $1 AND deleted_at IS NULL`).WithArgs("foo").WillReturnRows(_rows)
 
 	// create expected latest query result in mock
 	_rows = sqlmock.NewRows(
@@ -80,17 +81,17 @@ func TestRepo_Engine_ListReposForOrg(t *testing.T) {
 		AddRow(2, 1, "bar", "foo", "baz", "foo/baz", "", "", "", 0, 0, "public", false, false, false, false, false, false, false, false, "yaml", nil)
 
 	// ensure the mock expects the latest query
-	_mock.ExpectQuery(`SELECT repos.* FROM "repos" LEFT JOIN (SELECT repos.id, MAX(builds.created) AS latest_build FROM "builds" INNER JOIN repos repos ON builds.repo_id = repos.id WHERE repos.org = $1 GROUP BY "repos"."id") t on repos.id = t.id ORDER BY latest_build DESC NULLS LAST LIMIT 10`).WithArgs("foo").WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT repos.* FROM "repos" LEFT JOIN (SELECT repos.id, MAX(builds.created) AS latest_build FROM "builds" INNER JOIN repos repos ON builds.repo_id = repos.id WHERE repos.org = $1 GROUP BY "repos"."id") t on repos.id = t.id WHERE repos.deleted_at IS NULL ORDER BY latest_build IS NULL, latest_build DESC LIMIT 10`).WithArgs("foo").WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateRepo(_repoOne)
+	err := _sqlite.CreateRepo(context.TODO(), _repoOne)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateRepo(_repoTwo)
+	err = _sqlite.CreateRepo(context.TODO(), _repoTwo)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
@@ -153,7 +154,7 @@ func TestRepo_Engine_ListReposForOrg(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, _, err := test.database.ListReposForOrg("foo", test.sort, filters, 1, 10)
+			got, _, err := test.database.ListReposForOrg(context.TODO(), "foo", test.sort, filters, 1, 10)
 
 			if test.failure {
 				if err == nil {
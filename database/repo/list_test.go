@@ -5,6 +5,7 @@
 package repo
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -41,7 +42,7 @@ func TestRepo_Engine_ListRepos(t *testing.T) {
 	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery(`SELECT count(*) FROM "repos"`).WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT count(*) FROM "repos" WHERE deleted_at IS NULL`).WillReturnRows(_rows)
 
 	// create expected result in mock
 	_rows = sqlmock.NewRows(
@@ -50,17 +51,17 @@ func TestRepo_Engine_ListRepos(t *testing.T) {
 		AddRow(2, 1, "baz", "bar", "foo", "bar/foo", "", "", "", 0, 0, "public", false, false, false, false, false, false, false, false, "yaml", nil)
 
 	// ensure the mock expects the query
-	_mock.ExpectQuery(`SELECT * FROM "repos"`).WillReturnRows(_rows)
+	_mock.ExpectQuery(`SELECT * FROM "repos" WHERE deleted_at IS NULL`).WillReturnRows(_rows)
 
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateRepo(_repoOne)
+	err := _sqlite.CreateRepo(context.TODO(), _repoOne)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateRepo(_repoTwo)
+	err = _sqlite.CreateRepo(context.TODO(), _repoTwo)
 	if err != nil {
 		t.Errorf("unable to create test repo for sqlite: %v", err)
 	}
@@ -89,7 +90,7 @@ func TestRepo_Engine_ListRepos(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.ListRepos()
+			got, err := test.database.ListRepos(context.TODO())
 
 			if test.failure {
 				if err == nil {
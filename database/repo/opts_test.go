@@ -8,6 +8,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/go-vela/server/kms"
+
 	"github.com/sirupsen/logrus"
 
 	"gorm.io/gorm"
@@ -111,6 +113,57 @@ func TestRepo_EngineOpt_WithEncryptionKey(t *testing.T) {
 	}
 }
 
+func TestRepo_EngineOpt_WithEnvelope(t *testing.T) {
+	// setup types
+	e := &engine{config: new(config)}
+
+	_envelope := testEnvelope(t)
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		envelope *kms.Envelope
+		want     *kms.Envelope
+	}{
+		{
+			failure:  false,
+			name:     "envelope set",
+			envelope: _envelope,
+			want:     _envelope,
+		},
+		{
+			failure:  false,
+			name:     "envelope not set",
+			envelope: nil,
+			want:     nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := WithEnvelope(test.envelope)(e)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("WithEnvelope for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("WithEnvelope returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(e.config.Envelope, test.want) {
+				t.Errorf("WithEnvelope is %v, want %v", e.config.Envelope, test.want)
+			}
+		})
+	}
+}
+
 func TestRepo_EngineOpt_WithLogger(t *testing.T) {
 	// setup types
 	e := &engine{logger: new(logrus.Entry)}
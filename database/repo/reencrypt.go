@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+)
+
+// reencryptBatchSize represents the number of repos
+// scanned in a single query when re-encrypting repos.
+const reencryptBatchSize = 100
+
+// ReencryptRepos re-encrypts the fields for up to the limit of repos
+// that are not sealed with the current key encryption key.
+//
+// Only the encrypted fields are updated and only when they have not
+// changed since they were read, so concurrent updates are not lost.
+func (e *engine) ReencryptRepos(ctx context.Context, limit int) (int, error) {
+	e.logger.Tracef("re-encrypting up to %d repos in the database", limit)
+
+	// short-circuit if there is no envelope to re-encrypt repos with
+	if e.config.Envelope == nil {
+		return 0, nil
+	}
+
+	count := 0
+	last := int64(0)
+
+	for count < limit {
+		// variable to store query results
+		repos := []database.Repo{}
+
+		// send query to the database and store result in variable
+		err := e.client.WithContext(ctx).
+			Table(constants.TableRepo).
+			Select("id", "hash").
+			Where("id > ?", last).
+			Order("id").
+			Limit(reencryptBatchSize).
+			Find(&repos).
+			Error
+		if err != nil {
+			return count, err
+		}
+
+		// break the loop if there are no more repos
+		if len(repos) == 0 {
+			break
+		}
+
+		for i := range repos {
+			r := &repos[i]
+			last = r.ID.Int64
+
+			// skip the repo if the fields are already current
+			if e.current(r) {
+				continue
+			}
+
+			// capture the encrypted fields to detect concurrent updates
+			hash := r.Hash
+
+			err = e.decrypt(r)
+			if err != nil {
+				e.logger.Warnf("unable to decrypt repo %d for re-encryption: %v", r.ID.Int64, err)
+
+				continue
+			}
+
+			err = e.encrypt(r)
+			if err != nil {
+				return count, fmt.Errorf("unable to encrypt repo %d: %w", r.ID.Int64, err)
+			}
+
+			// send query to the database
+			result := e.client.WithContext(ctx).
+				Table(constants.TableRepo).
+				Where("id = ? AND hash = ?", r.ID, hash).
+				Update("hash", r.Hash)
+			if result.Error != nil {
+				return count, result.Error
+			}
+
+			count += int(result.RowsAffected)
+
+			if count >= limit {
+				break
+			}
+		}
+	}
+
+	return count, nil
+}
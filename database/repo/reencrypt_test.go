@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+)
+
+func TestRepo_Engine_ReencryptRepos(t *testing.T) {
+	// setup types
+	_repoOne := testRepo()
+	_repoOne.SetID(1)
+	_repoOne.SetUserID(1)
+	_repoOne.SetHash("baz")
+	_repoOne.SetOrg("foo")
+	_repoOne.SetName("bar")
+	_repoOne.SetFullName("foo/bar")
+	_repoOne.SetVisibility("public")
+	_repoOne.SetPipelineType("yaml")
+
+	_repoTwo := testRepo()
+	_repoTwo.SetID(2)
+	_repoTwo.SetUserID(1)
+	_repoTwo.SetHash("baz")
+	_repoTwo.SetOrg("bar")
+	_repoTwo.SetName("foo")
+	_repoTwo.SetFullName("bar/foo")
+	_repoTwo.SetVisibility("public")
+	_repoTwo.SetPipelineType("yaml")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	_postgres.config.Envelope = testEnvelope(t)
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "hash"})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "id","hash" FROM "repos" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(0).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// create the repos encrypted with the static key
+	for _, r := range []*library.Repo{_repoOne, _repoTwo} {
+		err := _sqlite.CreateRepo(context.TODO(), r)
+		if err != nil {
+			t.Errorf("unable to create test repo for sqlite: %v", err)
+		}
+	}
+
+	_sqlite.config.Envelope = testEnvelope(t)
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		limit    int
+		want     int
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			limit:    10,
+			want:     0,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with limit",
+			database: _sqlite,
+			limit:    1,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 remaining",
+			database: _sqlite,
+			limit:    10,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 current",
+			database: _sqlite,
+			limit:    10,
+			want:     0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ReencryptRepos(context.TODO(), test.limit)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ReencryptRepos for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ReencryptRepos for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("ReencryptRepos for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+
+	// verify the repos were sealed by the envelope
+	repos := []database.Repo{}
+
+	err := _sqlite.client.Table(constants.TableRepo).Find(&repos).Error
+	if err != nil {
+		t.Errorf("unable to list repos for sqlite: %v", err)
+	}
+
+	for _, r := range repos {
+		if !strings.HasPrefix(r.Hash.String, "vela:") {
+			t.Errorf("ReencryptRepos hash for repo %d is %v, want sealed", r.ID.Int64, r.Hash.String)
+		}
+	}
+
+	// verify the repos can still be decrypted
+	for _, want := range []*library.Repo{_repoOne, _repoTwo} {
+		got, err := _sqlite.GetRepo(context.TODO(), want.GetID())
+		if err != nil {
+			t.Errorf("unable to get repo %d for sqlite: %v", want.GetID(), err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetRepo is %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+)
+
+// AuditRepoEncryption captures the number of repos, including archived
+// repos, with encrypted fields that can and can't be decrypted with the
+// current key along with the number sealed by the current envelope.
+func (e *engine) AuditRepoEncryption(ctx context.Context) (*api.EncryptionAudit, error) {
+	e.logger.Trace("auditing encrypted fields for repos in the database")
+
+	var total, readable, unreadable, sealed int64
+
+	err := e.scanEncrypted(ctx, func(r *database.Repo) error {
+		total++
+
+		// check if the fields are sealed with the current key encryption key
+		if e.config.Envelope != nil && e.current(r) {
+			sealed++
+		}
+
+		err := e.decrypt(r)
+		if err != nil {
+			e.logger.Debugf("unable to decrypt repo %d: %v", r.ID.Int64, err)
+
+			unreadable++
+
+			return nil
+		}
+
+		readable++
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit := new(api.EncryptionAudit)
+	audit.SetTable(constants.TableRepo)
+	audit.SetColumns([]string{"hash"})
+	audit.SetTotal(total)
+	audit.SetReadable(readable)
+	audit.SetUnreadable(unreadable)
+	audit.SetSealed(sealed)
+
+	return audit, nil
+}
+
+// RekeyRepos decrypts the fields for every repo, including archived
+// repos, with the current key and re-encrypts them with the provided
+// key. It returns the number of repos that were re-encrypted.
+//
+// Repos that can't be decrypted with the current key are skipped and
+// only the encrypted fields are updated when they have not changed
+// since they were read, so concurrent updates are not lost.
+func (e *engine) RekeyRepos(ctx context.Context, key string) (int, error) {
+	e.logger.Trace("re-keying encrypted fields for repos in the database")
+
+	count := 0
+
+	err := e.scanEncrypted(ctx, func(r *database.Repo) error {
+		// capture the encrypted fields to detect concurrent updates
+		hash := r.Hash
+
+		err := e.decrypt(r)
+		if err != nil {
+			e.logger.Warnf("unable to decrypt repo %d for re-keying: %v", r.ID.Int64, err)
+
+			return nil
+		}
+
+		// https://pkg.go.dev/github.com/go-vela/types/database#Repo.Encrypt
+		err = r.Encrypt(key)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt repo %d: %w", r.ID.Int64, err)
+		}
+
+		// send query to the database
+		result := e.client.WithContext(ctx).
+			Table(constants.TableRepo).
+			Where("id = ? AND hash = ?", r.ID, hash).
+			Update("hash", r.Hash)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		count += int(result.RowsAffected)
+
+		return nil
+	})
+
+	return count, err
+}
+
+// scanEncrypted is a helper function to call the provided function
+// with the encrypted fields for every repo, ordered by ID, reading
+// the repos from the database in batches.
+func (e *engine) scanEncrypted(ctx context.Context, fn func(*database.Repo) error) error {
+	last := int64(0)
+
+	for {
+		// variable to store query results
+		repos := []database.Repo{}
+
+		// send query to the database and store result in variable
+		err := e.client.WithContext(ctx).
+			Table(constants.TableRepo).
+			Select("id", "hash").
+			Where("id > ?", last).
+			Order("id").
+			Limit(reencryptBatchSize).
+			Find(&repos).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// break the loop if there are no more repos
+		if len(repos) == 0 {
+			return nil
+		}
+
+		for i := range repos {
+			last = repos[i].ID.Int64
+
+			err = fn(&repos[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/library"
+)
+
+func TestRepo_Engine_AuditRepoEncryption(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "hash"}).AddRow(1, "not encrypted")
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery(`SELECT "id","hash" FROM "repos" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(0).
+		WillReturnRows(_rows)
+
+	_mock.ExpectQuery(`SELECT "id","hash" FROM "repos" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "hash"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	// archived repos are audited as well
+	err = _sqlite.ArchiveRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to archive test repo for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure        bool
+		name           string
+		database       *engine
+		wantTotal      int64
+		wantReadable   int64
+		wantUnreadable int64
+	}{
+		{
+			failure:        false,
+			name:           "postgres",
+			database:       _postgres,
+			wantTotal:      1,
+			wantReadable:   0,
+			wantUnreadable: 1,
+		},
+		{
+			failure:        false,
+			name:           "sqlite3",
+			database:       _sqlite,
+			wantTotal:      1,
+			wantReadable:   1,
+			wantUnreadable: 0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.AuditRepoEncryption(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("AuditRepoEncryption for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("AuditRepoEncryption for %s returned err: %v", test.name, err)
+			}
+
+			if got.GetTotal() != test.wantTotal ||
+				got.GetReadable() != test.wantReadable ||
+				got.GetUnreadable() != test.wantUnreadable {
+				t.Errorf("AuditRepoEncryption for %s is %v", test.name, got)
+			}
+		})
+	}
+}
+
+func TestRepo_Engine_RekeyRepos(t *testing.T) {
+	// setup types
+	_repoOne := testRepo()
+	_repoOne.SetID(1)
+	_repoOne.SetUserID(1)
+	_repoOne.SetHash("baz")
+	_repoOne.SetOrg("foo")
+	_repoOne.SetName("bar")
+	_repoOne.SetFullName("foo/bar")
+	_repoOne.SetVisibility("public")
+	_repoOne.SetPipelineType("yaml")
+
+	_repoTwo := testRepo()
+	_repoTwo.SetID(2)
+	_repoTwo.SetUserID(1)
+	_repoTwo.SetHash("baz")
+	_repoTwo.SetOrg("bar")
+	_repoTwo.SetName("foo")
+	_repoTwo.SetFullName("bar/foo")
+	_repoTwo.SetVisibility("public")
+	_repoTwo.SetPipelineType("yaml")
+
+	key := "Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "id","hash" FROM "repos" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "hash"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, r := range []*library.Repo{_repoOne, _repoTwo} {
+		err := _sqlite.CreateRepo(context.TODO(), r)
+		if err != nil {
+			t.Errorf("unable to create test repo for sqlite: %v", err)
+		}
+	}
+
+	// archived repos are re-keyed as well
+	err := _sqlite.ArchiveRepo(context.TODO(), _repoTwo)
+	if err != nil {
+		t.Errorf("unable to archive test repo for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     0,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.RekeyRepos(context.TODO(), key)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("RekeyRepos for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("RekeyRepos for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("RekeyRepos for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+
+	// verify the repos can only be decrypted with the new key
+	old, err := _sqlite.GetRepo(context.TODO(), _repoOne.GetID())
+	if err != nil {
+		t.Errorf("GetRepo with the old key returned err: %v", err)
+	}
+
+	if old.GetHash() == _repoOne.GetHash() {
+		t.Errorf("GetRepo with the old key should not have decrypted the hash")
+	}
+
+	_sqlite.config.EncryptionKey = key
+
+	got, err := _sqlite.GetRepo(context.TODO(), _repoOne.GetID())
+	if err != nil {
+		t.Errorf("GetRepo with the new key returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _repoOne) {
+		t.Errorf("GetRepo is %v, want %v", got, _repoOne)
+	}
+}
@@ -5,8 +5,10 @@
 package repo
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/go-vela/server/kms"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 
@@ -18,6 +20,8 @@ type (
 	config struct {
 		// specifies the encryption key to use for the Repo engine
 		EncryptionKey string
+		// specifies the envelope to use for encrypting fields in the Repo engine
+		Envelope *kms.Envelope
 		// specifies to skip creating tables and indexes for the Repo engine
 		SkipCreation bool
 	}
@@ -61,19 +65,19 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating repo database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of repos table and indexes in the database")
+		e.logger.Trace("skipping creation of repos table and indexes in the database")
 
 		return e, nil
 	}
 
 	// create the repos table
-	err := e.CreateRepoTable(e.client.Config.Dialector.Name())
+	err := e.CreateRepoTable(context.Background(), e.client.Config.Dialector.Name())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create %s table: %w", constants.TableRepo, err)
 	}
 
 	// create the indexes for the repos table
-	err = e.CreateRepoIndexes()
+	err = e.CreateRepoIndexes(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create indexes for %s table: %w", constants.TableRepo, err)
 	}
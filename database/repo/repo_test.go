@@ -169,6 +169,17 @@ func testSqlite(t *testing.T) *engine {
 		t.Errorf("unable to create new sqlite repo engine: %v", err)
 	}
 
+	// add the column applied by the schema migration
+	err = AddDeletedColumns(_sqlite)
+	if err != nil {
+		t.Errorf("unable to add deleted column to sqlite repos table: %v", err)
+	}
+
+	err = AddVersionColumn(_sqlite)
+	if err != nil {
+		t.Errorf("unable to add version column to sqlite repos table: %v", err)
+	}
+
 	return _engine
 }
 
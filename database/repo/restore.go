@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// RestoreRepo restores an archived repo in the database
+// by clearing the time it was deleted.
+func (e *engine) RestoreRepo(ctx context.Context, r *library.Repo) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("restoring repo %s in the database", r.GetFullName())
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(constants.TableRepo).
+		Where("id = ?", r.GetID()).
+		Update("deleted_at", nil).
+		Error
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRepo_Engine_RestoreRepo(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "repos" SET "deleted_at"=$1 WHERE id = $2`).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo for sqlite: %v", err)
+	}
+
+	err = _sqlite.ArchiveRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to archive test repo for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.RestoreRepo(context.TODO(), _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("RestoreRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("RestoreRepo for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	// ensure the restored repo is included in the other queries
+	got, err := _sqlite.GetRepoForOrg(context.TODO(), "foo", "bar")
+	if err != nil {
+		t.Errorf("GetRepoForOrg for restored repo returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, _repo) {
+		t.Errorf("GetRepoForOrg for restored repo is %v, want %v", got, _repo)
+	}
+}
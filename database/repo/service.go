@@ -5,6 +5,9 @@
 package repo
 
 import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/library"
 )
 
@@ -18,34 +21,54 @@ type RepoService interface {
 	// https://en.wikipedia.org/wiki/Data_definition_language
 
 	// CreateRepoIndexes defines a function that creates the indexes for the repos table.
-	CreateRepoIndexes() error
+	CreateRepoIndexes(context.Context) error
 	// CreateRepoTable defines a function that creates the repos table.
-	CreateRepoTable(string) error
+	CreateRepoTable(context.Context, string) error
 
 	// Repo Data Manipulation Language Functions
 	//
 	// https://en.wikipedia.org/wiki/Data_manipulation_language
 
+	// ArchiveRepo defines a function that archives an existing repo.
+	ArchiveRepo(context.Context, *library.Repo) error
+	// AuditRepoEncryption defines a function that audits the encrypted fields for repos.
+	AuditRepoEncryption(context.Context) (*api.EncryptionAudit, error)
 	// CountRepos defines a function that gets the count of all repos.
-	CountRepos() (int64, error)
+	CountRepos(context.Context) (int64, error)
 	// CountReposForOrg defines a function that gets the count of repos by org name.
-	CountReposForOrg(string, map[string]interface{}) (int64, error)
+	CountReposForOrg(context.Context, string, map[string]interface{}) (int64, error)
 	// CountReposForUser defines a function that gets the count of repos by user ID.
-	CountReposForUser(*library.User, map[string]interface{}) (int64, error)
+	CountReposForUser(context.Context, *library.User, map[string]interface{}) (int64, error)
 	// CreateRepo defines a function that creates a new repo.
-	CreateRepo(*library.Repo) error
+	CreateRepo(context.Context, *library.Repo) error
 	// DeleteRepo defines a function that deletes an existing repo.
-	DeleteRepo(*library.Repo) error
+	DeleteRepo(context.Context, *library.Repo) error
+	// GetArchivedRepoForOrg defines a function that gets an archived repo by org and repo name.
+	GetArchivedRepoForOrg(context.Context, string, string) (*library.Repo, error)
 	// GetRepo defines a function that gets a repo by ID.
-	GetRepo(int64) (*library.Repo, error)
+	GetRepo(context.Context, int64) (*library.Repo, error)
+	// GetRepoVersion defines a function that gets the version of the settings for a repo.
+	GetRepoVersion(context.Context, *library.Repo) (int64, error)
 	// GetRepoForOrg defines a function that gets a repo by org and repo name.
-	GetRepoForOrg(string, string) (*library.Repo, error)
+	GetRepoForOrg(context.Context, string, string) (*library.Repo, error)
+	// ListArchivedRepos defines a function that gets a list of the archived repos.
+	ListArchivedRepos(context.Context, int, int) ([]*library.Repo, int64, error)
+	// ListReposArchivedBefore defines a function that gets a list of repos archived before a unix time.
+	ListReposArchivedBefore(context.Context, int64, int) ([]*library.Repo, error)
 	// ListRepos defines a function that gets a list of all repos.
-	ListRepos() ([]*library.Repo, error)
+	ListRepos(context.Context) ([]*library.Repo, error)
 	// ListReposForOrg defines a function that gets a list of repos by org name.
-	ListReposForOrg(string, string, map[string]interface{}, int, int) ([]*library.Repo, int64, error)
+	ListReposForOrg(context.Context, string, string, map[string]interface{}, int, int) ([]*library.Repo, int64, error)
 	// ListReposForUser defines a function that gets a list of repos by user ID.
-	ListReposForUser(*library.User, string, map[string]interface{}, int, int) ([]*library.Repo, int64, error)
+	ListReposForUser(context.Context, *library.User, string, map[string]interface{}, int, int) ([]*library.Repo, int64, error)
+	// ReencryptRepos defines a function that re-encrypts repos not sealed with the current key.
+	ReencryptRepos(context.Context, int) (int, error)
+	// RekeyRepos defines a function that re-encrypts repos with a new key.
+	RekeyRepos(context.Context, string) (int, error)
+	// RestoreRepo defines a function that restores an archived repo.
+	RestoreRepo(context.Context, *library.Repo) error
 	// UpdateRepo defines a function that updates an existing repo.
-	UpdateRepo(*library.Repo) error
+	UpdateRepo(context.Context, *library.Repo) error
+	// UpdateRepoIfVersion defines a function that updates an existing repo when its version matches.
+	UpdateRepoIfVersion(context.Context, *library.Repo, int64) (int64, error)
 }
@@ -4,7 +4,15 @@
 
 package repo
 
-import "github.com/go-vela/types/constants"
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+
+	"gorm.io/gorm"
+)
 
 const (
 	// CreatePostgresTable represents a query to create the Postgres repos table.
@@ -69,22 +77,116 @@ repos (
 	previous_name TEXT,
 	UNIQUE(full_name)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL repos table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+repos (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	user_id       INTEGER,
+	hash          VARCHAR(500),
+	org           VARCHAR(250),
+	name          VARCHAR(250),
+	full_name     VARCHAR(500),
+	link          VARCHAR(1000),
+	clone         VARCHAR(1000),
+	branch        VARCHAR(250),
+	build_limit   INTEGER,
+	timeout       INTEGER,
+	counter       INTEGER,
+	visibility    TEXT,
+	private       BOOLEAN,
+	trusted       BOOLEAN,
+	active        BOOLEAN,
+	allow_pull    BOOLEAN,
+	allow_push    BOOLEAN,
+	allow_deploy  BOOLEAN,
+	allow_tag     BOOLEAN,
+	allow_comment BOOLEAN,
+	pipeline_type TEXT,
+	previous_name VARCHAR(100),
+	UNIQUE(full_name),
+	INDEX repos_org_name (org, name)
+);
 `
 )
 
 // CreateRepoTable creates the repos table in the database.
-func (e *engine) CreateRepoTable(driver string) error {
+func (e *engine) CreateRepoTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating repos table in the database")
 
 	// handle the driver provided to create the table
 	switch driver {
 	case constants.DriverPostgres:
 		// create the repos table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the repos table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the repos table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
 	}
 }
+
+// deletedColumnTables represents the tables archived
+// along with the repo by setting the deleted_at column.
+var deletedColumnTables = []string{
+	constants.TableRepo,
+	constants.TableBuild,
+}
+
+// AddDeletedColumns adds the deleted_at column used to archive
+// repos along with their builds to the repos and builds tables
+// in the database. The column is added by a schema migration so
+// it is added to the existing tables. Tables that don't exist or
+// already have the column are skipped so the function can be
+// safely applied again.
+func AddDeletedColumns(tx *gorm.DB) error {
+	for _, table := range deletedColumnTables {
+		// check if the table is missing or the column already exists
+		if !tx.Migrator().HasTable(table) || tx.Migrator().HasColumn(table, "deleted_at") {
+			continue
+		}
+
+		err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN deleted_at INTEGER", table)).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddVersionColumn adds the version column used for conditional
+// updates of the settings for the repo to the repos table in the
+// database. The column is added by a schema migration so it is
+// added to the existing table. The table is skipped when it doesn't
+// exist or already has the column so the function can be safely
+// applied again.
+func AddVersionColumn(tx *gorm.DB) error {
+	// check if the table is missing or the column already exists
+	if !tx.Migrator().HasTable(constants.TableRepo) || tx.Migrator().HasColumn(constants.TableRepo, "version") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN version INTEGER NOT NULL DEFAULT 0", constants.TableRepo)).Error
+}
+
+// DropVersionColumn drops the version column used for conditional
+// updates of the settings for the repo from the repos table in the
+// database when the schema migration is rolled back. Sqlite doesn't
+// support dropping a column, so the column is kept since it defaults
+// to 0.
+func DropVersionColumn(tx *gorm.DB) error {
+	// check if the database supports dropping a column or the column doesn't exist
+	if name := tx.Dialector.Name(); name != constants.DriverPostgres && name != types.DriverMySQL || !tx.Migrator().HasColumn(constants.TableRepo, "version") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN version", constants.TableRepo)).Error
+}
@@ -5,9 +5,14 @@
 package repo
 
 import (
+	"context"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/constants"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
 )
 
 func TestRepo_Engine_CreateRepoTable(t *testing.T) {
@@ -41,7 +46,7 @@ func TestRepo_Engine_CreateRepoTable(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			err := test.database.CreateRepoTable(test.name)
+			err := test.database.CreateRepoTable(context.TODO(), test.name)
 
 			if test.failure {
 				if err == nil {
@@ -57,3 +62,116 @@ func TestRepo_Engine_CreateRepoTable(t *testing.T) {
 		})
 	}
 }
+
+func TestRepo_AddDeletedColumns(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create repos table: %v", err)
+	}
+
+	err = _sqlite.Exec("CREATE TABLE builds (id INTEGER PRIMARY KEY AUTOINCREMENT, repo_id INTEGER)").Error
+	if err != nil {
+		t.Errorf("unable to create builds table: %v", err)
+	}
+
+	// run test
+	for _, table := range deletedColumnTables {
+		if _sqlite.Migrator().HasColumn(table, "deleted_at") {
+			t.Errorf("%s table already has column deleted_at", table)
+		}
+	}
+
+	// the existing columns are skipped when applied again
+	for i := 0; i < 2; i++ {
+		err = AddDeletedColumns(_sqlite)
+		if err != nil {
+			t.Errorf("AddDeletedColumns returned err: %v", err)
+		}
+	}
+
+	for _, table := range []string{constants.TableRepo, constants.TableBuild} {
+		if !_sqlite.Migrator().HasColumn(table, "deleted_at") {
+			t.Errorf("AddDeletedColumns did not add column deleted_at to %s table", table)
+		}
+	}
+}
+
+func TestRepo_AddVersionColumn(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create repos table: %v", err)
+	}
+
+	// run test
+	if _sqlite.Migrator().HasColumn(constants.TableRepo, "version") {
+		t.Errorf("repos table already has column version")
+	}
+
+	// the existing column is skipped when applied again
+	for i := 0; i < 2; i++ {
+		err = AddVersionColumn(_sqlite)
+		if err != nil {
+			t.Errorf("AddVersionColumn returned err: %v", err)
+		}
+	}
+
+	if !_sqlite.Migrator().HasColumn(constants.TableRepo, "version") {
+		t.Errorf("AddVersionColumn did not add column version to repos table")
+	}
+}
+
+func TestRepo_DropVersionColumn(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create repos table: %v", err)
+	}
+
+	err = AddVersionColumn(_sqlite)
+	if err != nil {
+		t.Errorf("AddVersionColumn returned err: %v", err)
+	}
+
+	// run test
+	err = DropVersionColumn(_sqlite)
+	if err != nil {
+		t.Errorf("DropVersionColumn returned err: %v", err)
+	}
+
+	// sqlite doesn't support dropping a column
+	if !_sqlite.Migrator().HasColumn(constants.TableRepo, "version") {
+		t.Errorf("DropVersionColumn dropped column version from sqlite repos table")
+	}
+}
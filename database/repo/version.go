@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// GetRepoVersion gets the version of the settings
+// for an existing repo from the database.
+func (e *engine) GetRepoVersion(ctx context.Context, r *library.Repo) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("getting version of repo %s from the database", r.GetFullName())
+
+	// variable to store query results
+	versions := []int64{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(constants.TableRepo).
+		Where("id = ?", r.GetID()).
+		Pluck("version", &versions).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	// check if the repo exists
+	if len(versions) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	return versions[0], nil
+}
+
+// UpdateRepoIfVersion updates an existing repo in the database when
+// the version of its settings still matches the provided version.
+// It returns the new version of the settings for the repo.
+//
+// ErrVersionConflict is returned when the repo was updated since
+// the provided version was captured.
+func (e *engine) UpdateRepoIfVersion(ctx context.Context, r *library.Repo, version int64) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("updating repo %s at version %d in the database", r.GetFullName(), version)
+
+	// cast the library type to database type
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#RepoFromLibrary
+	repo := database.RepoFromLibrary(r)
+
+	// validate the necessary fields are populated
+	//
+	// https://pkg.go.dev/github.com/go-vela/types/database#Repo.Validate
+	err := repo.Validate()
+	if err != nil {
+		return 0, err
+	}
+
+	// encrypt the fields for the repo
+	err = e.encrypt(repo)
+	if err != nil {
+		return 0, fmt.Errorf("unable to encrypt repo %s: %w", r.GetFullName(), err)
+	}
+
+	// send queries to the database within a single transaction
+	err = e.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// increment the version only when it still matches,
+		// locking the row until the repo is updated
+		result := tx.Table(constants.TableRepo).
+			Where("id = ?", r.GetID()).
+			Where("version = ?", version).
+			Update("version", gorm.Expr("version + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return types.ErrVersionConflict
+		}
+
+		return tx.Table(constants.TableRepo).
+			Select("*").
+			Updates(repo).
+			Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version + 1, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRepoSync_Engine_CreateRepoSync(t *testing.T) {
+	// setup types
+	_sync := testRepoSync()
+	_sync.SetID(1)
+	_sync.SetRepoID(1)
+	_sync.SetOrg("github")
+	_sync.SetName("octocat")
+	_sync.SetLink("https://github.com/github/octocat")
+	_sync.SetClone("https://github.com/github/octocat.git")
+	_sync.SetBranch("main")
+	_sync.SetPrivate(true)
+	_sync.SetTopics([]string{"go"})
+	_sync.SetStatus("updated")
+	_sync.SetChanges([]string{"branch changed from master to main"})
+	_sync.SetSynced(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "repo_syncs"
+("repo_id","org","name","link","clone","branch","private","archived","topics","status","changes","error","synced","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14) RETURNING "id"`).
+		WithArgs(1, "github", "octocat", "https://github.com/github/octocat", "https://github.com/github/octocat.git", "main", true, false, `{"go"}`, "updated", `{"branch changed from master to main"}`, nil, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateRepoSync(context.TODO(), _sync)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateRepoSync for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateRepoSync for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
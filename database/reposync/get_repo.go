@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// GetRepoSyncForRepo gets the repo sync for a repo from the database.
+func (e *engine) GetRepoSyncForRepo(ctx context.Context, r *library.Repo) (*api.RepoSync, error) {
+	e.logger.Tracef("getting repo sync for repo %s from the database", r.GetFullName())
+
+	// variable to store query results
+	s := new(types.RepoSync)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRepoSync).
+		Where("repo_id = ?", r.GetID()).
+		Take(s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ToAPI(), nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestRepoSync_Engine_GetRepoSyncForRepo(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+
+	_sync := testRepoSync()
+	_sync.SetID(1)
+	_sync.SetRepoID(1)
+	_sync.SetOrg("github")
+	_sync.SetName("octocat")
+	_sync.SetLink("https://github.com/github/octocat")
+	_sync.SetClone("https://github.com/github/octocat.git")
+	_sync.SetBranch("main")
+	_sync.SetPrivate(true)
+	_sync.SetTopics([]string{"go"})
+	_sync.SetStatus("updated")
+	_sync.SetChanges([]string{"branch changed from master to main"})
+	_sync.SetSynced(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "org", "name", "link", "clone", "branch", "private", "archived", "topics", "status", "changes", "error", "synced"}).
+		AddRow(1, 1, "github", "octocat", "https://github.com/github/octocat", "https://github.com/github/octocat.git", "main", true, false, `{"go"}`, "updated", `{"branch changed from master to main"}`, "", 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "repo_syncs" WHERE repo_id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepoSync(context.TODO(), _sync)
+	if err != nil {
+		t.Errorf("unable to create test repo sync for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.RepoSync
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _sync,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _sync,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetRepoSyncForRepo(context.TODO(), _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRepoSyncForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRepoSyncForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetRepoSyncForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// RepoSyncService represents the Vela interface for repo sync
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type RepoSyncService interface {
+	// RepoSync Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateRepoSyncTable defines a function that creates the repo_syncs table.
+	CreateRepoSyncTable(context.Context, string) error
+
+	// RepoSync Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateRepoSync defines a function that creates a new repo sync.
+	CreateRepoSync(context.Context, *api.RepoSync) error
+	// GetRepoSyncForRepo defines a function that gets the repo sync for a repo.
+	GetRepoSyncForRepo(context.Context, *library.Repo) (*api.RepoSync, error)
+	// UpdateRepoSync defines a function that updates an existing repo sync.
+	UpdateRepoSync(context.Context, *api.RepoSync) error
+}
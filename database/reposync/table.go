@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableRepoSync represents the name of the table for repo syncs.
+	TableRepoSync = "repo_syncs"
+
+	// CreatePostgresTable represents a query to create the Postgres repo_syncs table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+repo_syncs (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	org           VARCHAR(250),
+	name          VARCHAR(250),
+	link          VARCHAR(1000),
+	clone         VARCHAR(1000),
+	branch        VARCHAR(250),
+	private       BOOLEAN,
+	archived      BOOLEAN,
+	topics        VARCHAR(1000),
+	status        VARCHAR(250),
+	changes       VARCHAR(2000),
+	error         VARCHAR(1000),
+	synced        INTEGER,
+	UNIQUE(repo_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite repo_syncs table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+repo_syncs (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	org           TEXT,
+	name          TEXT,
+	link          TEXT,
+	clone         TEXT,
+	branch        TEXT,
+	private       BOOLEAN,
+	archived      BOOLEAN,
+	topics        TEXT,
+	status        TEXT,
+	changes       TEXT,
+	error         TEXT,
+	synced        INTEGER,
+	UNIQUE(repo_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL repo_syncs table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+repo_syncs (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	org           VARCHAR(250),
+	name          VARCHAR(250),
+	link          VARCHAR(1000),
+	clone         VARCHAR(1000),
+	branch        VARCHAR(250),
+	private       BOOLEAN,
+	archived      BOOLEAN,
+	topics        VARCHAR(1000),
+	status        VARCHAR(250),
+	changes       VARCHAR(2000),
+	error         VARCHAR(1000),
+	synced        INTEGER,
+	UNIQUE(repo_id)
+);
+`
+)
+
+// CreateRepoSyncTable creates the repo_syncs table in the database.
+func (e *engine) CreateRepoSyncTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating repo_syncs table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the repo_syncs table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the repo_syncs table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the repo_syncs table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateRepoSync updates an existing repo sync in the database.
+func (e *engine) UpdateRepoSync(ctx context.Context, r *api.RepoSync) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("updating repo sync for repo %d in the database", r.GetRepoID())
+
+	// cast the API type to database type
+	sync := types.RepoSyncFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := sync.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRepoSync).
+		Save(sync).
+		Error
+}
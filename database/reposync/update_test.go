@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRepoSync_Engine_UpdateRepoSync(t *testing.T) {
+	// setup types
+	_sync := testRepoSync()
+	_sync.SetID(1)
+	_sync.SetRepoID(1)
+	_sync.SetOrg("github")
+	_sync.SetName("octocat")
+	_sync.SetLink("https://github.com/github/octocat")
+	_sync.SetClone("https://github.com/github/octocat.git")
+	_sync.SetBranch("main")
+	_sync.SetPrivate(true)
+	_sync.SetTopics([]string{"go"})
+	_sync.SetStatus("updated")
+	_sync.SetChanges([]string{"branch changed from master to main"})
+	_sync.SetSynced(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "repo_syncs"
+SET "repo_id"=$1,"org"=$2,"name"=$3,"link"=$4,"clone"=$5,"branch"=$6,"private"=$7,"archived"=$8,"topics"=$9,"status"=$10,"changes"=$11,"error"=$12,"synced"=$13
+WHERE "id" = $14`).
+		WithArgs(1, "github", "octocat", "https://github.com/github/octocat", "https://github.com/github/octocat.git", "main", true, false, `{"go"}`, "updated", `{"branch changed from master to main"}`, nil, 1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepoSync(context.TODO(), _sync)
+	if err != nil {
+		t.Errorf("unable to create test repo sync for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateRepoSync(context.TODO(), _sync)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateRepoSync for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateRepoSync for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRepoVariable_Engine_CreateRepoVariable(t *testing.T) {
+	// setup types
+	_variable := testRepoVariable()
+	_variable.SetID(1)
+	_variable.SetRepoID(1)
+	_variable.SetName("DEPLOY_REGION")
+	_variable.SetValue("us-east-1")
+	_variable.SetSensitive(true)
+	_variable.SetCreatedAt(1)
+	_variable.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "repo_variables"
+("repo_id","name","value","sensitive","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs(1, "DEPLOY_REGION", sqlmock.AnyArg(), true, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateRepoVariable(context.TODO(), _variable)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateRepoVariable for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateRepoVariable for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteRepoVariable deletes an existing repo variable from the database.
+func (e *engine) DeleteRepoVariable(ctx context.Context, r *api.RepoVariable) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo_id":  r.GetRepoID(),
+		"variable": r.GetName(),
+	}).Tracef("deleting repo variable %s for repo %d from the database", r.GetName(), r.GetRepoID())
+
+	// cast the API type to database type
+	variable := types.RepoVariableFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRepoVariable).
+		Delete(variable).
+		Error
+}
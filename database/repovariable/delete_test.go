@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRepoVariable_Engine_DeleteRepoVariable(t *testing.T) {
+	// setup types
+	_variable := testRepoVariable()
+	_variable.SetID(1)
+	_variable.SetRepoID(1)
+	_variable.SetName("DEPLOY_REGION")
+	_variable.SetValue("us-east-1")
+	_variable.SetSensitive(true)
+	_variable.SetCreatedAt(1)
+	_variable.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "repo_variables" WHERE "repo_variables"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepoVariable(context.TODO(), _variable)
+	if err != nil {
+		t.Errorf("unable to create test repo variable for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteRepoVariable(context.TODO(), _variable)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteRepoVariable for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteRepoVariable for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
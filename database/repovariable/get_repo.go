@@ -0,0 +1,46 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetRepoVariableForRepo gets a repo variable by repo and name from the database.
+func (e *engine) GetRepoVariableForRepo(ctx context.Context, r *library.Repo, name string) (*api.RepoVariable, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":      r.GetOrg(),
+		"repo":     r.GetName(),
+		"variable": name,
+	}).Tracef("getting repo variable %s for repo %s from the database", name, r.GetFullName())
+
+	// variable to store query results
+	v := new(types.RepoVariable)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRepoVariable).
+		Where("repo_id = ?", r.GetID()).
+		Where("name = ?", name).
+		Take(v).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// decrypt the value for the repo variable if it is sensitive
+	err = v.Decrypt(e.config.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt repo variable %s for repo %s: %w", name, r.GetFullName(), err)
+	}
+
+	return v.ToAPI(), nil
+}
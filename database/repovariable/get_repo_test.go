@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestRepoVariable_Engine_GetRepoVariableForRepo(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+
+	_variable := testRepoVariable()
+	_variable.SetID(1)
+	_variable.SetRepoID(1)
+	_variable.SetName("DEPLOY_REGION")
+	_variable.SetValue("us-east-1")
+	_variable.SetSensitive(true)
+	_variable.SetCreatedAt(1)
+	_variable.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "name", "value", "sensitive", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, 1, "DEPLOY_REGION", testEncrypted(t, _variable), true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "repo_variables" WHERE repo_id = $1 AND name = $2 LIMIT 1`).
+		WithArgs(1, "DEPLOY_REGION").
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepoVariable(context.TODO(), _variable)
+	if err != nil {
+		t.Errorf("unable to create test repo variable for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.RepoVariable
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _variable,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _variable,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetRepoVariableForRepo(context.TODO(), _repo, "DEPLOY_REGION")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRepoVariableForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRepoVariableForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetRepoVariableForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// ListRepoVariablesForRepo gets a list of repo variables by repo from the database.
+func (e *engine) ListRepoVariablesForRepo(ctx context.Context, r *library.Repo) ([]*api.RepoVariable, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("listing repo variables for repo %s from the database", r.GetFullName())
+
+	// variables to store query results and return value
+	v := new([]types.RepoVariable)
+	variables := []*api.RepoVariable{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRepoVariable).
+		Where("repo_id = ?", r.GetID()).
+		Order("name").
+		Find(&v).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, variable := range *v {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := variable
+
+		// decrypt the value for the repo variable if it is sensitive
+		err = tmp.Decrypt(e.config.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt repo variable %s for repo %s: %w", tmp.Name.String, r.GetFullName(), err)
+		}
+
+		// convert query result to API type
+		variables = append(variables, tmp.ToAPI())
+	}
+
+	return variables, nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestRepoVariable_Engine_ListRepoVariablesForRepo(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+
+	_variableOne := testRepoVariable()
+	_variableOne.SetID(1)
+	_variableOne.SetRepoID(1)
+	_variableOne.SetName("DEPLOY_REGION")
+	_variableOne.SetValue("us-east-1")
+	_variableOne.SetSensitive(false)
+	_variableOne.SetCreatedAt(1)
+	_variableOne.SetCreatedBy("octocat")
+
+	_variableTwo := testRepoVariable()
+	_variableTwo.SetID(2)
+	_variableTwo.SetRepoID(1)
+	_variableTwo.SetName("DEPLOY_URL")
+	_variableTwo.SetValue("https://deploy.example.com/hooks/abc123")
+	_variableTwo.SetSensitive(true)
+	_variableTwo.SetCreatedAt(1)
+	_variableTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "name", "value", "sensitive", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, 1, "DEPLOY_REGION", "us-east-1", false, 1, "octocat", 0, "").
+		AddRow(2, 1, "DEPLOY_URL", testEncrypted(t, _variableTwo), true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "repo_variables" WHERE repo_id = $1 ORDER BY name`).
+		WithArgs(1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepoVariable(context.TODO(), _variableOne)
+	if err != nil {
+		t.Errorf("unable to create test repo variable for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateRepoVariable(context.TODO(), _variableTwo)
+	if err != nil {
+		t.Errorf("unable to create test repo variable for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.RepoVariable
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.RepoVariable{_variableOne, _variableTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.RepoVariable{_variableOne, _variableTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListRepoVariablesForRepo(context.TODO(), _repo)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListRepoVariablesForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListRepoVariablesForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListRepoVariablesForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the RepoVariableService interface.
+	config struct {
+		// specifies the encryption key to use for the RepoVariable engine
+		EncryptionKey string
+		// specifies to skip creating tables and indexes for the RepoVariable engine
+		SkipCreation bool
+	}
+
+	// engine represents the repo variable functionality that implements the RepoVariableService interface.
+	engine struct {
+		// engine configuration settings used in repo variable functions
+		config *config
+
+		// gorm.io/gorm database client used in repo variable functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in repo variable functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with repo variables in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new RepoVariable engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating repo variable database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of repo_variables table in the database")
+
+		return e, nil
+	}
+
+	// create the repo_variables table
+	err := e.CreateRepoVariableTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableRepoVariable, err)
+	}
+
+	return e, nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// RepoVariableService represents the Vela interface for repo
+// variable functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type RepoVariableService interface {
+	// RepoVariable Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateRepoVariableTable defines a function that creates the repo_variables table.
+	CreateRepoVariableTable(context.Context, string) error
+
+	// RepoVariable Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateRepoVariable defines a function that creates a new repo variable.
+	CreateRepoVariable(context.Context, *api.RepoVariable) error
+	// DeleteRepoVariable defines a function that deletes an existing repo variable.
+	DeleteRepoVariable(context.Context, *api.RepoVariable) error
+	// GetRepoVariableForRepo defines a function that gets a repo variable by repo and name.
+	GetRepoVariableForRepo(context.Context, *library.Repo, string) (*api.RepoVariable, error)
+	// ListRepoVariablesForRepo defines a function that gets a list of repo variables by repo.
+	ListRepoVariablesForRepo(context.Context, *library.Repo) ([]*api.RepoVariable, error)
+	// UpdateRepoVariable defines a function that updates an existing repo variable.
+	UpdateRepoVariable(context.Context, *api.RepoVariable) error
+}
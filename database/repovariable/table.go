@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableRepoVariable represents the name of the table for the environment variables of repos.
+	TableRepoVariable = "repo_variables"
+
+	// CreatePostgresTable represents a query to create the Postgres repo_variables table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+repo_variables (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	name          VARCHAR(250),
+	value         VARCHAR(5000),
+	sensitive     BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(repo_id, name)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite repo_variables table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+repo_variables (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	name          TEXT,
+	value         TEXT,
+	sensitive     BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(repo_id, name)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL repo_variables table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+repo_variables (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	name          VARCHAR(250),
+	value         VARCHAR(5000),
+	sensitive     BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(repo_id, name)
+);
+`
+)
+
+// CreateRepoVariableTable creates the repo_variables table in the database.
+func (e *engine) CreateRepoVariableTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating repo_variables table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the repo_variables table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the repo_variables table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the repo_variables table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateRepoVariable updates an existing repo variable in the database.
+func (e *engine) UpdateRepoVariable(ctx context.Context, r *api.RepoVariable) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo_id":  r.GetRepoID(),
+		"variable": r.GetName(),
+	}).Tracef("updating repo variable %s for repo %d in the database", r.GetName(), r.GetRepoID())
+
+	// cast the API type to database type
+	variable := types.RepoVariableFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := variable.Validate()
+	if err != nil {
+		return err
+	}
+
+	// encrypt the value for the repo variable if it is sensitive
+	err = variable.Encrypt(e.config.EncryptionKey)
+	if err != nil {
+		return fmt.Errorf("unable to encrypt repo variable %s for repo %d: %w", r.GetName(), r.GetRepoID(), err)
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRepoVariable).
+		Save(variable).
+		Error
+}
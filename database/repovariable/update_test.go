@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package repovariable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRepoVariable_Engine_UpdateRepoVariable(t *testing.T) {
+	// setup types
+	_variable := testRepoVariable()
+	_variable.SetID(1)
+	_variable.SetRepoID(1)
+	_variable.SetName("DEPLOY_REGION")
+	_variable.SetValue("us-east-1")
+	_variable.SetSensitive(true)
+	_variable.SetCreatedAt(1)
+	_variable.SetCreatedBy("octocat")
+	_variable.SetUpdatedAt(2)
+	_variable.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "repo_variables"
+SET "repo_id"=$1,"name"=$2,"value"=$3,"sensitive"=$4,"created_at"=$5,"created_by"=$6,"updated_at"=$7,"updated_by"=$8
+WHERE "id" = $9`).
+		WithArgs(1, "DEPLOY_REGION", sqlmock.AnyArg(), true, 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRepoVariable(context.TODO(), _variable)
+	if err != nil {
+		t.Errorf("unable to create test repo variable for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateRepoVariable(context.TODO(), _variable)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateRepoVariable for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateRepoVariable for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
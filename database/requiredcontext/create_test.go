@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRequiredContext_Engine_CreateRequiredContext(t *testing.T) {
+	// setup types
+	_context := testRequiredContext()
+	_context.SetID(1)
+	_context.SetOrg("github")
+	_context.SetRepo("octocat")
+	_context.SetBranch("main")
+	_context.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	_context.SetStrict(true)
+	_context.SetActive(true)
+	_context.SetCreatedAt(1)
+	_context.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "required_contexts"
+("org","repo","branch","contexts","strict","active","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11) RETURNING "id"`).
+		WithArgs("github", "octocat", "main", `{"continuous-integration/vela/pull_request"}`, true, true, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateRequiredContext(context.TODO(), _context)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateRequiredContext for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateRequiredContext for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
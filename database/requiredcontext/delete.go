@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteRequiredContext deletes an existing required context from the database.
+func (e *engine) DeleteRequiredContext(ctx context.Context, r *api.RequiredContext) error {
+	e.logger.WithFields(logrus.Fields{
+		"context": r.GetID(),
+	}).Tracef("deleting required context %d from the database", r.GetID())
+
+	// cast the API type to database type
+	requiredContext := types.RequiredContextFromAPI(r)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRequiredContext).
+		Delete(requiredContext).
+		Error
+}
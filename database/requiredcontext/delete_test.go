@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRequiredContext_Engine_DeleteRequiredContext(t *testing.T) {
+	// setup types
+	_context := testRequiredContext()
+	_context.SetID(1)
+	_context.SetOrg("github")
+	_context.SetRepo("octocat")
+	_context.SetBranch("main")
+	_context.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	_context.SetStrict(true)
+	_context.SetActive(true)
+	_context.SetCreatedAt(1)
+	_context.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "required_contexts" WHERE "required_contexts"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRequiredContext(context.TODO(), _context)
+	if err != nil {
+		t.Errorf("unable to create test required context for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteRequiredContext(context.TODO(), _context)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteRequiredContext for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteRequiredContext for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
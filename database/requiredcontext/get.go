@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetRequiredContext gets a required context by ID from the database.
+func (e *engine) GetRequiredContext(ctx context.Context, id int64) (*api.RequiredContext, error) {
+	e.logger.Tracef("getting required context %d from the database", id)
+
+	// variable to store query results
+	r := new(types.RequiredContext)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRequiredContext).
+		Where("id = ?", id).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ToAPI(), nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetRequiredContextForRepo gets a required context by org and repo name from the database.
+func (e *engine) GetRequiredContextForRepo(ctx context.Context, org, repo string) (*api.RequiredContext, error) {
+	e.logger.Tracef("getting required context for %s/%s from the database", org, repo)
+
+	// variable to store query results
+	r := new(types.RequiredContext)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRequiredContext).
+		Where("org = ?", org).
+		Where("repo = ?", repo).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ToAPI(), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRequiredContext_Engine_GetRequiredContext(t *testing.T) {
+	// setup types
+	_context := testRequiredContext()
+	_context.SetID(1)
+	_context.SetOrg("github")
+	_context.SetRepo("octocat")
+	_context.SetBranch("main")
+	_context.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	_context.SetStrict(true)
+	_context.SetActive(true)
+	_context.SetCreatedAt(1)
+	_context.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "repo", "branch", "contexts", "strict", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", "octocat", "main", `{"continuous-integration/vela/pull_request"}`, true, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "required_contexts" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRequiredContext(context.TODO(), _context)
+	if err != nil {
+		t.Errorf("unable to create test required context for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.RequiredContext
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _context,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _context,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetRequiredContext(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetRequiredContext for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetRequiredContext for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetRequiredContext for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListRequiredContextsForOrg gets a list of required contexts for an org from the database.
+func (e *engine) ListRequiredContextsForOrg(ctx context.Context, org string) ([]*api.RequiredContext, error) {
+	e.logger.Tracef("listing required contexts for org %s from the database", org)
+
+	// variables to store query results and return value
+	r := new([]types.RequiredContext)
+	contexts := []*api.RequiredContext{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRequiredContext).
+		Where("org = ?", org).
+		Order("repo").
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, context := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := context
+
+		// convert query result to API type
+		contexts = append(contexts, tmp.ToAPI())
+	}
+
+	return contexts, nil
+}
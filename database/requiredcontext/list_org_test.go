@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRequiredContext_Engine_ListRequiredContextsForOrg(t *testing.T) {
+	// setup types
+	_contextOne := testRequiredContext()
+	_contextOne.SetID(1)
+	_contextOne.SetOrg("github")
+	_contextOne.SetRepo("*")
+	_contextOne.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	_contextOne.SetActive(true)
+	_contextOne.SetCreatedAt(1)
+	_contextOne.SetCreatedBy("octocat")
+
+	_contextTwo := testRequiredContext()
+	_contextTwo.SetID(2)
+	_contextTwo.SetOrg("github")
+	_contextTwo.SetRepo("octocat")
+	_contextTwo.SetBranch("main")
+	_contextTwo.SetContexts([]string{"continuous-integration/vela/pull_request", "continuous-integration/vela/push"})
+	_contextTwo.SetStrict(true)
+	_contextTwo.SetActive(true)
+	_contextTwo.SetCreatedAt(1)
+	_contextTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "repo", "branch", "contexts", "strict", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "github", "*", "", `{"continuous-integration/vela/pull_request"}`, false, true, 1, "octocat", 0, "").
+		AddRow(2, "github", "octocat", "main", `{"continuous-integration/vela/pull_request","continuous-integration/vela/push"}`, true, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "required_contexts" WHERE org = $1 ORDER BY repo`).WithArgs("github").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRequiredContext(context.TODO(), _contextOne)
+	if err != nil {
+		t.Errorf("unable to create test required context for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateRequiredContext(context.TODO(), _contextTwo)
+	if err != nil {
+		t.Errorf("unable to create test required context for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.RequiredContext
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.RequiredContext{_contextOne, _contextTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.RequiredContext{_contextOne, _contextTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListRequiredContextsForOrg(context.TODO(), "github")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListRequiredContextsForOrg for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListRequiredContextsForOrg for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListRequiredContextsForOrg for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// RequiredContextService represents the Vela interface for required context
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type RequiredContextService interface {
+	// RequiredContext Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateRequiredContextIndexes defines a function that creates the indexes for the required_contexts table.
+	CreateRequiredContextIndexes(context.Context) error
+	// CreateRequiredContextTable defines a function that creates the required_contexts table.
+	CreateRequiredContextTable(context.Context, string) error
+
+	// RequiredContext Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateRequiredContext defines a function that creates a new required context.
+	CreateRequiredContext(context.Context, *api.RequiredContext) error
+	// DeleteRequiredContext defines a function that deletes an existing required context.
+	DeleteRequiredContext(context.Context, *api.RequiredContext) error
+	// GetRequiredContext defines a function that gets a required context by ID.
+	GetRequiredContext(context.Context, int64) (*api.RequiredContext, error)
+	// GetRequiredContextForRepo defines a function that gets a required context by org and repo name.
+	GetRequiredContextForRepo(context.Context, string, string) (*api.RequiredContext, error)
+	// ListRequiredContextsForOrg defines a function that gets a list of required contexts for an org.
+	ListRequiredContextsForOrg(context.Context, string) ([]*api.RequiredContext, error)
+	// UpdateRequiredContext defines a function that updates an existing required context.
+	UpdateRequiredContext(context.Context, *api.RequiredContext) error
+}
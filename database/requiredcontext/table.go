@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableRequiredContext represents the name of the table for required contexts.
+	TableRequiredContext = "required_contexts"
+
+	// CreatePostgresTable represents a query to create the Postgres required_contexts table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+required_contexts (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	repo          VARCHAR(250),
+	branch        VARCHAR(250),
+	contexts      VARCHAR(1000),
+	strict        BOOLEAN,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, repo)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite required_contexts table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+required_contexts (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	repo          TEXT,
+	branch        TEXT,
+	contexts      TEXT,
+	strict        BOOLEAN,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(org, repo)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL required_contexts table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+required_contexts (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	repo          VARCHAR(250),
+	branch        VARCHAR(250),
+	contexts      VARCHAR(1000),
+	strict        BOOLEAN,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(org, repo),
+	INDEX required_contexts_org (org)
+);
+`
+)
+
+// CreateRequiredContextTable creates the required_contexts table in the database.
+func (e *engine) CreateRequiredContextTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating required_contexts table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the required_contexts table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the required_contexts table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the required_contexts table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
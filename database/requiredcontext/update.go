@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateRequiredContext updates an existing required context in the database.
+func (e *engine) UpdateRequiredContext(ctx context.Context, r *api.RequiredContext) error {
+	e.logger.WithFields(logrus.Fields{
+		"context": r.GetID(),
+	}).Tracef("updating required context %d in the database", r.GetID())
+
+	// cast the API type to database type
+	requiredContext := types.RequiredContextFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := requiredContext.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRequiredContext).
+		Save(requiredContext).
+		Error
+}
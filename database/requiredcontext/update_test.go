@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package requiredcontext
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRequiredContext_Engine_UpdateRequiredContext(t *testing.T) {
+	// setup types
+	_context := testRequiredContext()
+	_context.SetID(1)
+	_context.SetOrg("github")
+	_context.SetRepo("octocat")
+	_context.SetBranch("main")
+	_context.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	_context.SetStrict(true)
+	_context.SetActive(true)
+	_context.SetCreatedAt(1)
+	_context.SetCreatedBy("octocat")
+	_context.SetUpdatedAt(2)
+	_context.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "required_contexts"
+SET "org"=$1,"repo"=$2,"branch"=$3,"contexts"=$4,"strict"=$5,"active"=$6,"created_at"=$7,"created_by"=$8,"updated_at"=$9,"updated_by"=$10
+WHERE "id" = $11`).
+		WithArgs("github", "octocat", "main", `{"continuous-integration/vela/pull_request"}`, true, true, 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRequiredContext(context.TODO(), _context)
+	if err != nil {
+		t.Errorf("unable to create test required context for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateRequiredContext(context.TODO(), _context)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateRequiredContext for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateRequiredContext for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRetentionPolicy_Engine_CreateRetentionPolicy(t *testing.T) {
+	// setup types
+	_policy := testRetentionPolicy()
+	_policy.SetID(1)
+	_policy.SetRepoID(1)
+	_policy.SetKeepBuilds(100)
+	_policy.SetLogDays(30)
+	_policy.SetHookDays(90)
+	_policy.SetUpdatedAt(1)
+	_policy.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "retention_policies"
+("repo_id","keep_builds","log_days","hook_days","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`).
+		WithArgs(1, 100, 30, 90, 1, "octocat", 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateRetentionPolicy(context.TODO(), _policy)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateRetentionPolicy for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateRetentionPolicy for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
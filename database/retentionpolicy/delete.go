@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteRetentionPolicy deletes an existing retention policy from the database.
+func (e *engine) DeleteRetentionPolicy(ctx context.Context, p *api.RetentionPolicy) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo_id": p.GetRepoID(),
+	}).Tracef("deleting retention policy for repo %d from the database", p.GetRepoID())
+
+	// cast the API type to database type
+	policy := types.RetentionPolicyFromAPI(p)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRetentionPolicy).
+		Delete(policy).
+		Error
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRetentionPolicy_Engine_DeleteRetentionPolicy(t *testing.T) {
+	// setup types
+	_policy := testRetentionPolicy()
+	_policy.SetID(1)
+	_policy.SetRepoID(1)
+	_policy.SetKeepBuilds(100)
+	_policy.SetLogDays(30)
+	_policy.SetHookDays(90)
+	_policy.SetUpdatedAt(1)
+	_policy.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "retention_policies" WHERE "retention_policies"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRetentionPolicy(context.TODO(), _policy)
+	if err != nil {
+		t.Errorf("unable to create test retention policy for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteRetentionPolicy(context.TODO(), _policy)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteRetentionPolicy for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteRetentionPolicy for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetGlobalRetentionPolicy gets the global retention policy from the database.
+func (e *engine) GetGlobalRetentionPolicy(ctx context.Context) (*api.RetentionPolicy, error) {
+	e.logger.Trace("getting global retention policy from the database")
+
+	// variable to store query results
+	p := new(types.RetentionPolicy)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRetentionPolicy).
+		Where("repo_id IS NULL").
+		Order("id").
+		Take(p).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ToAPI(), nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRetentionPolicy_Engine_GetGlobalRetentionPolicy(t *testing.T) {
+	// setup types
+	_policy := testRetentionPolicy()
+	_policy.SetID(1)
+	_policy.SetKeepBuilds(500)
+	_policy.SetLogDays(90)
+	_policy.SetUpdatedAt(1)
+	_policy.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "keep_builds", "log_days", "hook_days", "updated_at", "updated_by"}).
+		AddRow(1, 0, 500, 90, 0, 1, "octocat")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "retention_policies" WHERE repo_id IS NULL ORDER BY id LIMIT 1`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRetentionPolicy(context.TODO(), _policy)
+	if err != nil {
+		t.Errorf("unable to create test retention policy for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.RetentionPolicy
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _policy,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _policy,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetGlobalRetentionPolicy(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetGlobalRetentionPolicy for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetGlobalRetentionPolicy for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetGlobalRetentionPolicy for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
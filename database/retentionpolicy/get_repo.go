@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetRetentionPolicyForRepo gets a retention policy by repo from the database.
+func (e *engine) GetRetentionPolicyForRepo(ctx context.Context, r *library.Repo) (*api.RetentionPolicy, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("getting retention policy for repo %s from the database", r.GetFullName())
+
+	// variable to store query results
+	p := new(types.RetentionPolicy)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRetentionPolicy).
+		Where("repo_id = ?", r.GetID()).
+		Take(p).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return p.ToAPI(), nil
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListRetentionPolicies gets a list of all retention policies from the database.
+func (e *engine) ListRetentionPolicies(ctx context.Context) ([]*api.RetentionPolicy, error) {
+	e.logger.Trace("listing all retention policies from the database")
+
+	// variables to store query results and return value
+	p := new([]types.RetentionPolicy)
+	policies := []*api.RetentionPolicy{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableRetentionPolicy).
+		Order("id").
+		Find(&p).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, policy := range *p {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := policy
+
+		// convert query result to API type
+		policies = append(policies, tmp.ToAPI())
+	}
+
+	return policies, nil
+}
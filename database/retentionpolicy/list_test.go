@@ -0,0 +1,102 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRetentionPolicy_Engine_ListRetentionPolicies(t *testing.T) {
+	// setup types
+	_global := testRetentionPolicy()
+	_global.SetID(1)
+	_global.SetKeepBuilds(500)
+	_global.SetLogDays(90)
+	_global.SetUpdatedAt(1)
+	_global.SetUpdatedBy("octocat")
+
+	_policy := testRetentionPolicy()
+	_policy.SetID(2)
+	_policy.SetRepoID(1)
+	_policy.SetKeepBuilds(100)
+	_policy.SetLogDays(30)
+	_policy.SetHookDays(90)
+	_policy.SetUpdatedAt(1)
+	_policy.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "repo_id", "keep_builds", "log_days", "hook_days", "updated_at", "updated_by"}).
+		AddRow(1, 0, 500, 90, 0, 1, "octocat").
+		AddRow(2, 1, 100, 30, 90, 1, "octocat")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "retention_policies" ORDER BY id`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateRetentionPolicy(context.TODO(), _global)
+	if err != nil {
+		t.Errorf("unable to create test retention policy for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateRetentionPolicy(context.TODO(), _policy)
+	if err != nil {
+		t.Errorf("unable to create test retention policy for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.RetentionPolicy
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.RetentionPolicy{_global, _policy},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.RetentionPolicy{_global, _policy},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListRetentionPolicies(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListRetentionPolicies for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListRetentionPolicies for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListRetentionPolicies for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
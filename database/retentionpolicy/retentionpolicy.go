@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+type (
+	// config represents the settings required to create the engine that implements the RetentionPolicyService interface.
+	config struct {
+		// specifies to skip creating tables and indexes for the RetentionPolicy engine
+		SkipCreation bool
+	}
+
+	// engine represents the retention policy functionality that implements the RetentionPolicyService interface.
+	engine struct {
+		// engine configuration settings used in retention policy functions
+		config *config
+
+		// gorm.io/gorm database client used in retention policy functions
+		//
+		// https://pkg.go.dev/gorm.io/gorm#DB
+		client *gorm.DB
+
+		// sirupsen/logrus logger used in retention policy functions
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		logger *logrus.Entry
+	}
+)
+
+// New creates and returns a Vela service for integrating with retention policies in the database.
+//
+//nolint:revive // ignore returning unexported engine
+func New(opts ...EngineOpt) (*engine, error) {
+	// create new RetentionPolicy engine
+	e := new(engine)
+
+	// create new fields
+	e.client = new(gorm.DB)
+	e.config = new(config)
+	e.logger = new(logrus.Entry)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if we should skip creating retention policy database objects
+	if e.config.SkipCreation {
+		e.logger.Trace("skipping creation of retention_policies table in the database")
+
+		return e, nil
+	}
+
+	// create the retention_policies table
+	err := e.CreateRetentionPolicyTable(context.Background(), e.client.Config.Dialector.Name())
+	if err != nil {
+		return nil, fmt.Errorf("unable to create %s table: %w", TableRetentionPolicy, err)
+	}
+
+	return e, nil
+}
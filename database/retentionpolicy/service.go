@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// RetentionPolicyService represents the Vela interface for retention policy
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type RetentionPolicyService interface {
+	// RetentionPolicy Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateRetentionPolicyTable defines a function that creates the retention_policies table.
+	CreateRetentionPolicyTable(context.Context, string) error
+
+	// RetentionPolicy Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateRetentionPolicy defines a function that creates a new retention policy.
+	CreateRetentionPolicy(context.Context, *api.RetentionPolicy) error
+	// DeleteRetentionPolicy defines a function that deletes an existing retention policy.
+	DeleteRetentionPolicy(context.Context, *api.RetentionPolicy) error
+	// GetGlobalRetentionPolicy defines a function that gets the global retention policy.
+	GetGlobalRetentionPolicy(context.Context) (*api.RetentionPolicy, error)
+	// GetRetentionPolicyForRepo defines a function that gets a retention policy by repo.
+	GetRetentionPolicyForRepo(context.Context, *library.Repo) (*api.RetentionPolicy, error)
+	// ListRetentionPolicies defines a function that gets a list of all retention policies.
+	ListRetentionPolicies(context.Context) ([]*api.RetentionPolicy, error)
+	// UpdateRetentionPolicy defines a function that updates an existing retention policy.
+	UpdateRetentionPolicy(context.Context, *api.RetentionPolicy) error
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableRetentionPolicy represents the name of the table for the retention policies of repos.
+	TableRetentionPolicy = "retention_policies"
+
+	// CreatePostgresTable represents a query to create the Postgres retention_policies table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+retention_policies (
+	id          SERIAL PRIMARY KEY,
+	repo_id     INTEGER,
+	keep_builds INTEGER,
+	log_days    INTEGER,
+	hook_days   INTEGER,
+	updated_at  INTEGER,
+	updated_by  VARCHAR(250),
+	UNIQUE(repo_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite retention_policies table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+retention_policies (
+	id          INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id     INTEGER,
+	keep_builds INTEGER,
+	log_days    INTEGER,
+	hook_days   INTEGER,
+	updated_at  INTEGER,
+	updated_by  TEXT,
+	UNIQUE(repo_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL retention_policies table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+retention_policies (
+	id          INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id     INTEGER,
+	keep_builds INTEGER,
+	log_days    INTEGER,
+	hook_days   INTEGER,
+	updated_at  INTEGER,
+	updated_by  VARCHAR(250),
+	UNIQUE(repo_id)
+);
+`
+)
+
+// CreateRetentionPolicyTable creates the retention_policies table in the database.
+func (e *engine) CreateRetentionPolicyTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating retention_policies table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the retention_policies table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the retention_policies table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the retention_policies table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
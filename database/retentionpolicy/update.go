@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retentionpolicy
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateRetentionPolicy updates an existing retention policy in the database.
+func (e *engine) UpdateRetentionPolicy(ctx context.Context, p *api.RetentionPolicy) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo_id": p.GetRepoID(),
+	}).Tracef("updating retention policy for repo %d in the database", p.GetRepoID())
+
+	// cast the API type to database type
+	policy := types.RetentionPolicyFromAPI(p)
+
+	// validate the necessary fields are populated
+	err := policy.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableRetentionPolicy).
+		Save(policy).
+		Error
+}
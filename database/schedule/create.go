@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateSchedule creates a new schedule in the database.
+func (e *engine) CreateSchedule(ctx context.Context, s *api.Schedule) error {
+	e.logger.WithFields(logrus.Fields{
+		"schedule": s.GetName(),
+	}).Tracef("creating schedule %s in the database", s.GetName())
+
+	// cast the API type to database type
+	schedule := types.ScheduleFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := schedule.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableSchedule).
+		Create(schedule).
+		Error
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateScheduleRun records a run of a schedule in the database.
+func (e *engine) CreateScheduleRun(ctx context.Context, r *api.ScheduleRun) error {
+	e.logger.WithFields(logrus.Fields{
+		"schedule": r.GetScheduleID(),
+		"status":   r.GetStatus(),
+	}).Tracef("creating %s run for schedule %d in the database", r.GetStatus(), r.GetScheduleID())
+
+	// cast the API type to database type
+	run := types.ScheduleRunFromAPI(r)
+
+	// validate the necessary fields are populated
+	err := run.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableScheduleRun).
+		Create(run).
+		Error
+}
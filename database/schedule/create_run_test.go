@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSchedule_Engine_CreateScheduleRun(t *testing.T) {
+	// setup types
+	_run := testScheduleRun()
+	_run.SetID(1)
+	_run.SetScheduleID(1)
+	_run.SetRepoID(1)
+	_run.SetScheduledFor(1563474000)
+	_run.SetStatus(api.ScheduleRunTriggered)
+	_run.SetBuildNumber(1)
+	_run.SetCreatedAt(1563474001)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "schedule_runs"
+("schedule_id","repo_id","scheduled_for","status","reason","build_number","created_at","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs(1, 1, 1563474000, "triggered", nil, 1, 1563474001, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateScheduleRun(context.TODO(), _run)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateScheduleRun for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateScheduleRun for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/gorm"
+)
+
+// DeleteSchedule deletes an existing schedule and its runs from the database.
+func (e *engine) DeleteSchedule(ctx context.Context, s *api.Schedule) error {
+	e.logger.WithFields(logrus.Fields{
+		"schedule": s.GetName(),
+	}).Tracef("deleting schedule %s from the database", s.GetName())
+
+	// cast the API type to database type
+	schedule := types.ScheduleFromAPI(s)
+
+	// send queries to the database in a single transaction
+	return e.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// delete the runs recorded for the schedule
+		err := tx.
+			Table(TableScheduleRun).
+			Where("schedule_id = ?", s.GetID()).
+			Delete(new(types.ScheduleRun)).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Table(TableSchedule).
+			Delete(schedule).
+			Error
+	})
+}
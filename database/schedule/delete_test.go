@@ -0,0 +1,98 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSchedule_Engine_DeleteSchedule(t *testing.T) {
+	// setup types
+	_schedule := testNightly()
+
+	_run := testScheduleRun()
+	_run.SetScheduleID(1)
+	_run.SetRepoID(1)
+	_run.SetScheduledFor(1)
+	_run.SetStatus(api.ScheduleRunTriggered)
+	_run.SetBuildNumber(1)
+	_run.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`DELETE FROM "schedule_runs" WHERE schedule_id = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`DELETE FROM "schedules" WHERE "schedules"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateSchedule(context.TODO(), _schedule)
+	if err != nil {
+		t.Errorf("unable to create test schedule for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateScheduleRun(context.TODO(), _run)
+	if err != nil {
+		t.Errorf("unable to create test schedule run for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteSchedule(context.TODO(), _schedule)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteSchedule for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteSchedule for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+
+	_, count, err := _sqlite.ListScheduleRuns(context.TODO(), _schedule, 1, 10)
+	if err != nil {
+		t.Errorf("ListScheduleRuns for sqlite3 returned err: %v", err)
+	}
+
+	if count != 0 {
+		t.Errorf("ListScheduleRuns for sqlite3 is %d, want 0 after deleting the schedule", count)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateActiveIndex represents a query to create an
+	// index on the schedules table for the active column.
+	CreateActiveIndex = `
+CREATE INDEX
+IF NOT EXISTS
+schedules_active
+ON schedules (active);
+`
+
+	// CreateRunScheduleIDIndex represents a query to create an
+	// index on the schedule_runs table for the schedule_id column.
+	CreateRunScheduleIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+schedule_runs_schedule_id
+ON schedule_runs (schedule_id);
+`
+)
+
+// CreateScheduleIndexes creates the indexes for the schedules and schedule_runs tables in the database.
+func (e *engine) CreateScheduleIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for schedules and schedule_runs tables in the database")
+
+	// the indexes for MySQL are created with the tables
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the active column index for the schedules table
+	err := e.client.WithContext(ctx).Exec(CreateActiveIndex).Error
+	if err != nil {
+		return err
+	}
+
+	// create the schedule_id column index for the schedule_runs table
+	return e.client.WithContext(ctx).Exec(CreateRunScheduleIDIndex).Error
+}
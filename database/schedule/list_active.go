@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListActiveSchedules gets a list of all active schedules from the database.
+func (e *engine) ListActiveSchedules(ctx context.Context) ([]*api.Schedule, error) {
+	e.logger.Trace("listing all active schedules from the database")
+
+	// variables to store query results and return value
+	s := new([]types.Schedule)
+	schedules := []*api.Schedule{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableSchedule).
+		Where("active = ?", true).
+		Order("id").
+		Find(&s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, schedule := range *s {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := schedule
+
+		// convert query result to API type
+		schedules = append(schedules, tmp.ToAPI())
+	}
+
+	return schedules, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// ListSchedulesForRepo gets a list of schedules by repo ID from the database.
+func (e *engine) ListSchedulesForRepo(ctx context.Context, r *library.Repo) ([]*api.Schedule, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("listing schedules for repo %s from the database", r.GetFullName())
+
+	// variables to store query results and return value
+	s := new([]types.Schedule)
+	schedules := []*api.Schedule{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableSchedule).
+		Where("repo_id = ?", r.GetID()).
+		Order("name").
+		Find(&s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, schedule := range *s {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := schedule
+
+		// convert query result to API type
+		schedules = append(schedules, tmp.ToAPI())
+	}
+
+	return schedules, nil
+}
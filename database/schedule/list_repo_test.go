@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSchedule_Engine_ListSchedulesForRepo(t *testing.T) {
+	// setup types
+	_scheduleOne := testNightly()
+
+	_scheduleTwo := testNightly()
+	_scheduleTwo.SetID(2)
+	_scheduleTwo.SetName("weekly")
+	_scheduleTwo.SetEntry("0 6 * * 1")
+	_scheduleTwo.SetTimeZone("UTC")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "repo_id", "active", "name", "entry", "time_zone", "branch", "created_at", "created_by", "updated_at", "updated_by", "scheduled_at"}).
+		AddRow(1, 1, true, "nightly", "0 2 * * *", "America/Chicago", "main", 1, "octocat", 0, "", 0).
+		AddRow(2, 1, true, "weekly", "0 6 * * 1", "UTC", "main", 1, "octocat", 0, "", 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "schedules" WHERE repo_id = $1 ORDER BY name`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateSchedule(context.TODO(), _scheduleOne)
+	if err != nil {
+		t.Errorf("unable to create test schedule for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateSchedule(context.TODO(), _scheduleTwo)
+	if err != nil {
+		t.Errorf("unable to create test schedule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.Schedule
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.Schedule{_scheduleOne, _scheduleTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.Schedule{_scheduleOne, _scheduleTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListSchedulesForRepo(context.TODO(), testRepo())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListSchedulesForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListSchedulesForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListSchedulesForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ListScheduleRuns gets a list of the runs for a schedule
+// from the database, from the most to least recent.
+func (e *engine) ListScheduleRuns(ctx context.Context, s *api.Schedule, page, perPage int) ([]*api.ScheduleRun, int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"schedule": s.GetName(),
+	}).Tracef("listing runs for schedule %s from the database", s.GetName())
+
+	// variables to store query results and return value
+	count := int64(0)
+	r := new([]types.ScheduleRun)
+	runs := []*api.ScheduleRun{}
+
+	// count the results
+	err := e.client.WithContext(ctx).
+		Table(TableScheduleRun).
+		Where("schedule_id = ?", s.GetID()).
+		Count(&count).
+		Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return runs, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = e.client.WithContext(ctx).
+		Table(TableScheduleRun).
+		Where("schedule_id = ?", s.GetID()).
+		Order("id DESC").
+		Limit(perPage).
+		Offset(offset).
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, count, err
+	}
+
+	// iterate through all query results
+	for _, run := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := run
+
+		// convert query result to API type
+		runs = append(runs, tmp.ToAPI())
+	}
+
+	return runs, count, nil
+}
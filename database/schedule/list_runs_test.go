@@ -0,0 +1,120 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSchedule_Engine_ListScheduleRuns(t *testing.T) {
+	// setup types
+	_schedule := testNightly()
+
+	_runOne := testScheduleRun()
+	_runOne.SetID(1)
+	_runOne.SetScheduleID(1)
+	_runOne.SetRepoID(1)
+	_runOne.SetScheduledFor(1563474000)
+	_runOne.SetStatus(api.ScheduleRunTriggered)
+	_runOne.SetBuildNumber(1)
+	_runOne.SetCreatedAt(1563474001)
+
+	_runTwo := testScheduleRun()
+	_runTwo.SetID(2)
+	_runTwo.SetScheduleID(1)
+	_runTwo.SetRepoID(1)
+	_runTwo.SetScheduledFor(1563560400)
+	_runTwo.SetStatus(api.ScheduleRunSkipped)
+	_runTwo.SetReason("blackout window is active for github/octocat")
+	_runTwo.SetCreatedAt(1563560401)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the count query
+	_mock.ExpectQuery(`SELECT count(*) FROM "schedule_runs" WHERE schedule_id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	// create expected result in mock
+	_rows = sqlmock.NewRows([]string{"id", "schedule_id", "repo_id", "scheduled_for", "status", "reason", "build_number", "created_at"}).
+		AddRow(2, 1, 1, 1563560400, "skipped", "blackout window is active for github/octocat", 0, 1563560401).
+		AddRow(1, 1, 1, 1563474000, "triggered", "", 1, 1563474001)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "schedule_runs" WHERE schedule_id = $1 ORDER BY id DESC LIMIT 10`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateSchedule(context.TODO(), _schedule)
+	if err != nil {
+		t.Errorf("unable to create test schedule for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateScheduleRun(context.TODO(), _runOne)
+	if err != nil {
+		t.Errorf("unable to create test schedule run for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateScheduleRun(context.TODO(), _runTwo)
+	if err != nil {
+		t.Errorf("unable to create test schedule run for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.ScheduleRun
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.ScheduleRun{_runTwo, _runOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.ScheduleRun{_runTwo, _runOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, count, err := test.database.ListScheduleRuns(context.TODO(), _schedule, 1, 10)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListScheduleRuns for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListScheduleRuns for %s returned err: %v", test.name, err)
+			}
+
+			if count != 2 {
+				t.Errorf("ListScheduleRuns for %s is %d, want 2", test.name, count)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListScheduleRuns for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// ScheduleService represents the Vela interface for schedule
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type ScheduleService interface {
+	// Schedule Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateScheduleIndexes defines a function that creates the indexes for the schedules and schedule_runs tables.
+	CreateScheduleIndexes(context.Context) error
+	// CreateScheduleTable defines a function that creates the schedules and schedule_runs tables.
+	CreateScheduleTable(context.Context, string) error
+
+	// Schedule Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateSchedule defines a function that creates a new schedule.
+	CreateSchedule(context.Context, *api.Schedule) error
+	// DeleteSchedule defines a function that deletes an existing schedule and its runs.
+	DeleteSchedule(context.Context, *api.Schedule) error
+	// GetSchedule defines a function that gets a schedule by ID.
+	GetSchedule(context.Context, int64) (*api.Schedule, error)
+	// GetScheduleVersion defines a function that gets the version of a schedule.
+	GetScheduleVersion(context.Context, *api.Schedule) (int64, error)
+	// GetScheduleForRepo defines a function that gets a schedule by repo ID and name.
+	GetScheduleForRepo(context.Context, *library.Repo, string) (*api.Schedule, error)
+	// ListActiveSchedules defines a function that gets a list of all active schedules.
+	ListActiveSchedules(context.Context) ([]*api.Schedule, error)
+	// ListSchedulesForRepo defines a function that gets a list of schedules by repo ID.
+	ListSchedulesForRepo(context.Context, *library.Repo) ([]*api.Schedule, error)
+	// UpdateSchedule defines a function that updates an existing schedule.
+	UpdateSchedule(context.Context, *api.Schedule) error
+	// UpdateScheduleIfVersion defines a function that updates an existing schedule when its version matches.
+	UpdateScheduleIfVersion(context.Context, *api.Schedule, int64) (int64, error)
+
+	// CreateScheduleRun defines a function that records a run of a schedule.
+	CreateScheduleRun(context.Context, *api.ScheduleRun) error
+	// ListScheduleRuns defines a function that gets a list of the runs for a schedule.
+	ListScheduleRuns(context.Context, *api.Schedule, int, int) ([]*api.ScheduleRun, int64, error)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+	"gorm.io/gorm"
+)
+
+const (
+	// TableSchedule represents the name of the table for schedules.
+	TableSchedule = "schedules"
+
+	// TableScheduleRun represents the name of the table for schedule runs.
+	TableScheduleRun = "schedule_runs"
+
+	// CreatePostgresTable represents a query to create the Postgres schedules table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+schedules (
+	id            SERIAL PRIMARY KEY,
+	repo_id       INTEGER,
+	active        BOOLEAN,
+	name          VARCHAR(100),
+	entry         VARCHAR(100),
+	time_zone     VARCHAR(100),
+	branch        VARCHAR(250),
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	scheduled_at  INTEGER,
+	UNIQUE(repo_id, name)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite schedules table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+schedules (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_id       INTEGER,
+	active        BOOLEAN,
+	name          TEXT,
+	entry         TEXT,
+	time_zone     TEXT,
+	branch        TEXT,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	scheduled_at  INTEGER,
+	UNIQUE(repo_id, name)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL schedules table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+schedules (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo_id       INTEGER,
+	active        BOOLEAN,
+	name          VARCHAR(100),
+	entry         VARCHAR(100),
+	time_zone     VARCHAR(100),
+	branch        VARCHAR(250),
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	scheduled_at  INTEGER,
+	UNIQUE(repo_id, name),
+	INDEX schedules_active (active)
+);
+`
+
+	// CreatePostgresRunTable represents a query to create the Postgres schedule_runs table.
+	CreatePostgresRunTable = `
+CREATE TABLE
+IF NOT EXISTS
+schedule_runs (
+	id             SERIAL PRIMARY KEY,
+	schedule_id    INTEGER,
+	repo_id        INTEGER,
+	scheduled_for  INTEGER,
+	status         VARCHAR(50),
+	reason         VARCHAR(1000),
+	build_number   INTEGER,
+	created_at     INTEGER
+);
+`
+
+	// CreateSqliteRunTable represents a query to create the Sqlite schedule_runs table.
+	CreateSqliteRunTable = `
+CREATE TABLE
+IF NOT EXISTS
+schedule_runs (
+	id             INTEGER PRIMARY KEY AUTOINCREMENT,
+	schedule_id    INTEGER,
+	repo_id        INTEGER,
+	scheduled_for  INTEGER,
+	status         TEXT,
+	reason         TEXT,
+	build_number   INTEGER,
+	created_at     INTEGER
+);
+`
+
+	// CreateMySQLRunTable represents a query to create the MySQL schedule_runs table.
+	CreateMySQLRunTable = `
+CREATE TABLE
+IF NOT EXISTS
+schedule_runs (
+	id             INTEGER PRIMARY KEY AUTO_INCREMENT,
+	schedule_id    INTEGER,
+	repo_id        INTEGER,
+	scheduled_for  INTEGER,
+	status         VARCHAR(50),
+	reason         VARCHAR(1000),
+	build_number   INTEGER,
+	created_at     INTEGER,
+	INDEX schedule_runs_schedule_id (schedule_id)
+);
+`
+)
+
+// CreateScheduleTable creates the schedules and schedule_runs tables in the database.
+func (e *engine) CreateScheduleTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating schedules and schedule_runs tables in the database")
+
+	// handle the driver provided to create the tables
+	switch driver {
+	case constants.DriverPostgres:
+		// create the schedules table for Postgres
+		err := e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+		if err != nil {
+			return err
+		}
+
+		// create the schedule_runs table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresRunTable).Error
+	case types.DriverMySQL:
+		// create the schedules table for MySQL
+		err := e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+		if err != nil {
+			return err
+		}
+
+		// create the schedule_runs table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLRunTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the schedules table for Sqlite
+		err := e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+		if err != nil {
+			return err
+		}
+
+		// create the schedule_runs table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteRunTable).Error
+	}
+}
+
+// AddVersionColumn adds the version column used for conditional
+// updates of the schedule to the schedules table in the database.
+// The column is added by a schema migration so it is added to the
+// existing table. The table is skipped when it doesn't exist or
+// already has the column so the function can be safely applied again.
+func AddVersionColumn(tx *gorm.DB) error {
+	// check if the table is missing or the column already exists
+	if !tx.Migrator().HasTable(TableSchedule) || tx.Migrator().HasColumn(TableSchedule, "version") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN version INTEGER NOT NULL DEFAULT 0", TableSchedule)).Error
+}
+
+// DropVersionColumn drops the version column used for conditional
+// updates of the schedule from the schedules table in the database
+// when the schema migration is rolled back. Sqlite doesn't support
+// dropping a column, so the column is kept since it defaults to 0.
+func DropVersionColumn(tx *gorm.DB) error {
+	// check if the database supports dropping a column or the column doesn't exist
+	if name := tx.Dialector.Name(); name != constants.DriverPostgres && name != types.DriverMySQL || !tx.Migrator().HasColumn(TableSchedule, "version") {
+		return nil
+	}
+
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP COLUMN version", TableSchedule)).Error
+}
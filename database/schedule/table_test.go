@@ -0,0 +1,132 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestSchedule_Engine_CreateScheduleTable(t *testing.T) {
+	// setup types
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreatePostgresRunTable).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateScheduleTable(context.TODO(), test.name)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateScheduleTable for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateScheduleTable for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
+
+func TestSchedule_AddVersionColumn(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create schedules table: %v", err)
+	}
+
+	// run test
+	if _sqlite.Migrator().HasColumn(TableSchedule, "version") {
+		t.Errorf("schedules table already has column version")
+	}
+
+	// the existing column is skipped when applied again
+	for i := 0; i < 2; i++ {
+		err = AddVersionColumn(_sqlite)
+		if err != nil {
+			t.Errorf("AddVersionColumn returned err: %v", err)
+		}
+	}
+
+	if !_sqlite.Migrator().HasColumn(TableSchedule, "version") {
+		t.Errorf("AddVersionColumn did not add column version to schedules table")
+	}
+}
+
+func TestSchedule_DropVersionColumn(t *testing.T) {
+	// setup types
+	_sqlite, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := _sqlite.DB(); _sql.Close() }()
+
+	err = _sqlite.Exec(CreateSqliteTable).Error
+	if err != nil {
+		t.Errorf("unable to create schedules table: %v", err)
+	}
+
+	err = AddVersionColumn(_sqlite)
+	if err != nil {
+		t.Errorf("AddVersionColumn returned err: %v", err)
+	}
+
+	// run test
+	err = DropVersionColumn(_sqlite)
+	if err != nil {
+		t.Errorf("DropVersionColumn returned err: %v", err)
+	}
+
+	// sqlite doesn't support dropping a column
+	if !_sqlite.Migrator().HasColumn(TableSchedule, "version") {
+		t.Errorf("DropVersionColumn dropped column version from sqlite schedules table")
+	}
+}
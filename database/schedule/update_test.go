@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestSchedule_Engine_UpdateSchedule(t *testing.T) {
+	// setup types
+	_schedule := testNightly()
+	_schedule.SetScheduledAt(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "schedules"
+SET "repo_id"=$1,"active"=$2,"name"=$3,"entry"=$4,"time_zone"=$5,"branch"=$6,"created_at"=$7,"created_by"=$8,"updated_at"=$9,"updated_by"=$10,"scheduled_at"=$11
+WHERE "id" = $12`).
+		WithArgs(1, true, "nightly", "0 2 * * *", "America/Chicago", "main", 1, "octocat", nil, nil, 2, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateSchedule(context.TODO(), _schedule)
+	if err != nil {
+		t.Errorf("unable to create test schedule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateSchedule(context.TODO(), _schedule)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateSchedule for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateSchedule for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// GetScheduleVersion gets the version of an
+// existing schedule from the database.
+func (e *engine) GetScheduleVersion(ctx context.Context, s *api.Schedule) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"schedule": s.GetName(),
+	}).Tracef("getting version of schedule %s from the database", s.GetName())
+
+	// variable to store query results
+	versions := []int64{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableSchedule).
+		Where("id = ?", s.GetID()).
+		Pluck("version", &versions).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	// check if the schedule exists
+	if len(versions) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	return versions[0], nil
+}
+
+// UpdateScheduleIfVersion updates an existing schedule in the database
+// when its version still matches the provided version. It returns the
+// new version of the schedule. Recording when the schedule was last
+// run with UpdateSchedule leaves the version unchanged.
+//
+// ErrVersionConflict is returned when the schedule was updated since
+// the provided version was captured.
+func (e *engine) UpdateScheduleIfVersion(ctx context.Context, s *api.Schedule, version int64) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"schedule": s.GetName(),
+	}).Tracef("updating schedule %s at version %d in the database", s.GetName(), version)
+
+	// cast the API type to database type
+	schedule := types.ScheduleFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := schedule.Validate()
+	if err != nil {
+		return 0, err
+	}
+
+	// send queries to the database within a single transaction
+	err = e.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// increment the version only when it still matches,
+		// locking the row until the schedule is updated
+		result := tx.Table(TableSchedule).
+			Where("id = ?", s.GetID()).
+			Where("version = ?", version).
+			Update("version", gorm.Expr("version + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return types.ErrVersionConflict
+		}
+
+		return tx.Table(TableSchedule).
+			Save(schedule).
+			Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return version + 1, nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/server/database/types"
+)
+
+func TestSchedule_Engine_GetScheduleVersion(t *testing.T) {
+	// setup types
+	_schedule := testNightly()
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"version"}).AddRow(2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "version" FROM "schedules" WHERE id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateSchedule(context.TODO(), _schedule)
+	if err != nil {
+		t.Errorf("unable to create test schedule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     2,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetScheduleVersion(context.TODO(), _schedule)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetScheduleVersion for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetScheduleVersion for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("GetScheduleVersion for %s is %d, want %d", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSchedule_Engine_UpdateScheduleIfVersion(t *testing.T) {
+	// setup types
+	_schedule := testNightly()
+	_schedule.SetScheduledAt(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectBegin()
+	_mock.ExpectExec(`UPDATE "schedules" SET "version"=version + 1 WHERE id = $1 AND version = $2`).
+		WithArgs(1, 0).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(`UPDATE "schedules"
+SET "repo_id"=$1,"active"=$2,"name"=$3,"entry"=$4,"time_zone"=$5,"branch"=$6,"created_at"=$7,"created_by"=$8,"updated_at"=$9,"updated_by"=$10,"scheduled_at"=$11
+WHERE "id" = $12`).
+		WithArgs(1, true, "nightly", "0 2 * * *", "America/Chicago", "main", 1, "octocat", nil, nil, 2, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectCommit()
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateSchedule(context.TODO(), _schedule)
+	if err != nil {
+		t.Errorf("unable to create test schedule for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.UpdateScheduleIfVersion(context.TODO(), _schedule, 0)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateScheduleIfVersion for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateScheduleIfVersion for %s returned err: %v", test.name, err)
+			}
+
+			if got != 1 {
+				t.Errorf("UpdateScheduleIfVersion for %s is %d, want 1", test.name, got)
+			}
+		})
+	}
+
+	// the stale version is rejected after the update
+	_, err = _sqlite.UpdateScheduleIfVersion(context.TODO(), _schedule, 0)
+	if !errors.Is(err, types.ErrVersionConflict) {
+		t.Errorf("UpdateScheduleIfVersion for stale version returned err %v, want %v", err, types.ErrVersionConflict)
+	}
+
+	// recording a run of the schedule leaves the version unchanged
+	err = _sqlite.UpdateSchedule(context.TODO(), _schedule)
+	if err != nil {
+		t.Errorf("unable to update test schedule for sqlite: %v", err)
+	}
+
+	version, err := _sqlite.GetScheduleVersion(context.TODO(), _schedule)
+	if err != nil || version != 1 {
+		t.Errorf("GetScheduleVersion after UpdateSchedule is %d (err %v), want 1", version, err)
+	}
+}
@@ -5,12 +5,58 @@
 package database
 
 import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/announcement"
+	"github.com/go-vela/server/database/artifact"
+	"github.com/go-vela/server/database/buildbudget"
+	"github.com/go-vela/server/database/buildcredential"
+	"github.com/go-vela/server/database/builddeprecation"
+	"github.com/go-vela/server/database/buildimage"
+	"github.com/go-vela/server/database/buildredaction"
+	"github.com/go-vela/server/database/canaryrun"
+	"github.com/go-vela/server/database/capacitysnapshot"
+	"github.com/go-vela/server/database/clonesetting"
+	"github.com/go-vela/server/database/costrate"
+	"github.com/go-vela/server/database/deprecationrule"
+	"github.com/go-vela/server/database/egressrule"
+	"github.com/go-vela/server/database/ephemeralregistration"
+	"github.com/go-vela/server/database/export"
+	"github.com/go-vela/server/database/freeze"
+	"github.com/go-vela/server/database/freezeaudit"
 	"github.com/go-vela/server/database/hook"
+	"github.com/go-vela/server/database/idempotencykey"
+	"github.com/go-vela/server/database/label"
+	"github.com/go-vela/server/database/leakfinding"
+	"github.com/go-vela/server/database/lease"
 	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/migration"
+	"github.com/go-vela/server/database/orghook"
+	"github.com/go-vela/server/database/partition"
 	"github.com/go-vela/server/database/pipeline"
+	"github.com/go-vela/server/database/previewenvironment"
+	"github.com/go-vela/server/database/registrycredential"
+	"github.com/go-vela/server/database/registrymirror"
 	"github.com/go-vela/server/database/repo"
+	"github.com/go-vela/server/database/reposync"
+	"github.com/go-vela/server/database/repovariable"
+	"github.com/go-vela/server/database/requiredcontext"
+	"github.com/go-vela/server/database/retentionpolicy"
+	"github.com/go-vela/server/database/schedule"
+	"github.com/go-vela/server/database/servicereadiness"
+	"github.com/go-vela/server/database/stagedwebhook"
+	"github.com/go-vela/server/database/stepattempt"
+	"github.com/go-vela/server/database/stepskip"
+	"github.com/go-vela/server/database/template"
+	"github.com/go-vela/server/database/triggertoken"
 	"github.com/go-vela/server/database/user"
+	"github.com/go-vela/server/database/userscope"
+	"github.com/go-vela/server/database/usersession"
+	"github.com/go-vela/server/database/webhookdelivery"
+	"github.com/go-vela/server/database/webhookintake"
 	"github.com/go-vela/server/database/worker"
+	"github.com/go-vela/server/database/workergroup"
 	"github.com/go-vela/types/library"
 )
 
@@ -22,62 +68,99 @@ type Service interface {
 	// Driver defines a function that outputs
 	// the configured database driver.
 	Driver() string
+	// PingContext defines a function that verifies
+	// the connection to the database is alive.
+	PingContext(context.Context) error
 
 	// Build Database Interface Functions
 
 	// GetBuild defines a function that
 	// gets a build by number and repo ID.
-	GetBuild(int, *library.Repo) (*library.Build, error)
+	GetBuild(context.Context, int, *library.Repo) (*library.Build, error)
 	// GetBuildByID defines a function that
 	// gets a build by its id.
-	GetBuildByID(int64) (*library.Build, error)
+	GetBuildByID(context.Context, int64) (*library.Build, error)
 	// GetLastBuild defines a function that
 	// gets the last build ran by repo ID.
-	GetLastBuild(*library.Repo) (*library.Build, error)
+	GetLastBuild(context.Context, *library.Repo) (*library.Build, error)
 	// GetLastBuildByBranch defines a function that
 	// gets the last build ran by repo ID and branch.
-	GetLastBuildByBranch(*library.Repo, string) (*library.Build, error)
+	GetLastBuildByBranch(context.Context, *library.Repo, string) (*library.Build, error)
 	// GetBuildCount defines a function that
 	// gets the count of builds.
-	GetBuildCount() (int64, error)
+	GetBuildCount(context.Context) (int64, error)
 	// GetBuildCountByStatus defines a function that
 	// gets a the count of builds by status.
-	GetBuildCountByStatus(string) (int64, error)
+	GetBuildCountByStatus(context.Context, string) (int64, error)
+	// GetBuildCountByHost defines a function that gets
+	// the count of builds by status for each worker host.
+	GetBuildCountByHost(context.Context, string) (map[string]int64, error)
 	// GetBuildList defines a function that gets
 	// a list of all builds.
-	GetBuildList() ([]*library.Build, error)
+	GetBuildList(context.Context) ([]*library.Build, error)
+	// GetFinishedBuildListSince defines a function that gets
+	// a list of finished builds created after a timestamp.
+	GetFinishedBuildListSince(context.Context, int64) ([]*library.Build, error)
+	// GetFinishedBuildListBetween defines a function that gets
+	// a list of builds finished within a time range.
+	GetFinishedBuildListBetween(context.Context, int64, int64) ([]*library.Build, error)
+	// GetPendingBuildListBefore defines a function that gets a list
+	// of all pending builds created before the provided time.
+	GetPendingBuildListBefore(context.Context, int64) ([]*library.Build, error)
 	// GetDeploymentBuildList defines a function that gets
 	// a list of builds related to a deployment.
-	GetDeploymentBuildList(string) ([]*library.Build, error)
+	GetDeploymentBuildList(context.Context, string) ([]*library.Build, error)
+	// GetLastDeploymentBuildList defines a function that gets a list
+	// of the last successful deployment build for each environment.
+	GetLastDeploymentBuildList(context.Context, *library.Repo) ([]*library.Build, error)
 	// GetRepoBuildList defines a function that
 	// gets a list of builds by repo ID.
-	GetRepoBuildList(*library.Repo, map[string]interface{}, int64, int64, int, int) ([]*library.Build, int64, error)
+	GetRepoBuildList(context.Context, *library.Repo, map[string]interface{}, int64, int64, int, int) ([]*library.Build, int64, error)
 	// GetOrgBuildList defines a function that
 	// gets a list of builds by org.
-	GetOrgBuildList(string, map[string]interface{}, int, int) ([]*library.Build, int64, error)
+	GetOrgBuildList(context.Context, string, map[string]interface{}, int, int) ([]*library.Build, int64, error)
 	// GetRepoBuildCount defines a function that
 	// gets the count of builds by repo ID.
-	GetRepoBuildCount(*library.Repo, map[string]interface{}) (int64, error)
+	GetRepoBuildCount(context.Context, *library.Repo, map[string]interface{}) (int64, error)
 	// GetOrgBuildCount defines a function that
 	// gets the count of builds by org.
-	GetOrgBuildCount(string, map[string]interface{}) (int64, error)
+	GetOrgBuildCount(context.Context, string, map[string]interface{}) (int64, error)
+	// SearchBuilds defines a function that gets a list
+	// of builds matching a search query.
+	SearchBuilds(context.Context, string, map[string]interface{}, bool, int, int) ([]*library.Build, int64, error)
+	// GetOrgActorUsage defines a function that gets the
+	// build minutes and step counts for each actor by org.
+	GetOrgActorUsage(context.Context, string, int64, int64) ([]*api.ActorUsage, error)
+	// GetOrgHostUsage defines a function that gets the
+	// build minutes for each worker host by org.
+	GetOrgHostUsage(context.Context, string, int64, int64) ([]*api.HostUsage, error)
 	// GetPendingAndRunningBuilds defines a function that
 	// gets the list of pending and running builds.
-	GetPendingAndRunningBuilds(string) ([]*library.BuildQueue, error)
+	GetPendingAndRunningBuilds(context.Context, string) ([]*library.BuildQueue, error)
 	// CreateBuild defines a function that
 	// creates a new build.
-	CreateBuild(*library.Build) error
+	CreateBuild(context.Context, *library.Build) error
 	// UpdateBuild defines a function that
 	// updates a build.
-	UpdateBuild(*library.Build) error
+	UpdateBuild(context.Context, *library.Build) error
 	// DeleteBuild defines a function that
 	// deletes a build by unique ID.
-	DeleteBuild(int64) error
+	DeleteBuild(context.Context, int64) error
+	// ArchiveRepoBuilds defines a function that archives
+	// the builds for a repo and returns the count archived.
+	ArchiveRepoBuilds(context.Context, *library.Repo) (int64, error)
+	// RestoreRepoBuilds defines a function that restores the
+	// archived builds for a repo and returns the count restored.
+	RestoreRepoBuilds(context.Context, *library.Repo) (int64, error)
 
 	// HookService provides the interface for functionality
 	// related to hooks stored in the database.
 	hook.HookService
 
+	// LabelService provides the interface for functionality
+	// related to build labels stored in the database.
+	label.LabelService
+
 	// LogService provides the interface for functionality
 	// related to logs stored in the database.
 	log.LogService
@@ -94,89 +177,129 @@ type Service interface {
 
 	// GetSecret defines a function that gets a secret
 	// by type, org, name (repo or team) and secret name.
-	GetSecret(string, string, string, string) (*library.Secret, error)
+	GetSecret(context.Context, string, string, string, string) (*library.Secret, error)
 	// GetSecretList defines a function that
 	// gets a list of all secrets.
-	GetSecretList() ([]*library.Secret, error)
+	GetSecretList(context.Context) ([]*library.Secret, error)
 	// GetTypeSecretList defines a function that gets a list
 	// of secrets by type, owner, and name (repo or team).
-	GetTypeSecretList(string, string, string, int, int, []string) ([]*library.Secret, error)
+	GetTypeSecretList(context.Context, string, string, string, int, int, []string) ([]*library.Secret, error)
 	// GetTypeSecretCount defines a function that gets a count
 	// of secrets by type, owner, and name (repo or team).
-	GetTypeSecretCount(string, string, string, []string) (int64, error)
+	GetTypeSecretCount(context.Context, string, string, string, []string) (int64, error)
 	// CreateSecret defines a function that
 	// creates a new secret.
-	CreateSecret(*library.Secret) error
+	CreateSecret(context.Context, *library.Secret) error
 	// UpdateSecret defines a function that
 	// updates a secret.
-	UpdateSecret(*library.Secret) error
+	UpdateSecret(context.Context, *library.Secret) error
 	// DeleteSecret defines a function that
 	// deletes a secret by unique ID.
-	DeleteSecret(int64) error
+	DeleteSecret(context.Context, int64) error
+	// GetSecretVersion defines a function that
+	// gets the version of a secret by unique ID.
+	GetSecretVersion(context.Context, int64) (int64, error)
+	// UpdateSecretIfVersion defines a function that updates
+	// a secret when its version matches the provided version.
+	UpdateSecretIfVersion(context.Context, *library.Secret, int64) (int64, error)
+	// ArchiveSecret defines a function that
+	// archives a secret by unique ID.
+	ArchiveSecret(context.Context, int64) error
+	// RestoreSecret defines a function that
+	// restores an archived secret by unique ID.
+	RestoreSecret(context.Context, int64) error
+	// GetArchivedSecret defines a function that gets an archived
+	// secret by type, org, name (repo or team) and secret name.
+	GetArchivedSecret(context.Context, string, string, string, string) (*library.Secret, error)
+	// ListArchivedSecrets defines a function that
+	// gets a list of the archived secrets.
+	ListArchivedSecrets(context.Context, int, int) ([]*library.Secret, int64, error)
+	// PurgeArchivedSecrets defines a function that removes the
+	// secrets archived before the provided time.
+	PurgeArchivedSecrets(context.Context, int64) (int64, error)
+	// AuditSecretEncryption defines a function that audits
+	// the encrypted values for secrets.
+	AuditSecretEncryption(context.Context) (*api.EncryptionAudit, error)
+	// RekeySecrets defines a function that re-encrypts
+	// the values for secrets with a new key.
+	RekeySecrets(context.Context, string) (int, error)
 
 	// Step Database Interface Functions
 
 	// GetStep defines a function that
 	// gets a step by number and build ID.
-	GetStep(int, *library.Build) (*library.Step, error)
+	GetStep(context.Context, int, *library.Build) (*library.Step, error)
 	// GetStepList defines a function that
 	// gets a list of all steps.
-	GetStepList() ([]*library.Step, error)
+	GetStepList(context.Context) ([]*library.Step, error)
 	// GetBuildStepList defines a function that
 	// gets a list of steps by build ID.
-	GetBuildStepList(*library.Build, int, int) ([]*library.Step, error)
+	GetBuildStepList(context.Context, *library.Build, int, int) ([]*library.Step, error)
+	// GetFinishedStepListBetween defines a function that
+	// gets a list of steps finished within a time range.
+	GetFinishedStepListBetween(context.Context, int64, int64) ([]*library.Step, error)
 	// GetBuildStepCount defines a function that
 	// gets the count of steps by build ID.
-	GetBuildStepCount(*library.Build) (int64, error)
+	GetBuildStepCount(context.Context, *library.Build) (int64, error)
 	// GetStepImageCount defines a function that
 	// gets a list of all step images and the
 	// count of their occurrence.
-	GetStepImageCount() (map[string]float64, error)
+	GetStepImageCount(context.Context) (map[string]float64, error)
 	// GetStepStatusCount defines a function that
 	// gets a list of all step statuses and the
 	// count of their occurrence.
-	GetStepStatusCount() (map[string]float64, error)
+	GetStepStatusCount(context.Context) (map[string]float64, error)
 	// CreateStep defines a function that
 	// creates a new step.
-	CreateStep(*library.Step) error
+	CreateStep(context.Context, *library.Step) error
+	// CreateSteps defines a function that
+	// creates a list of new steps.
+	CreateSteps(context.Context, []*library.Step) error
 	// UpdateStep defines a function that
 	// updates a step.
-	UpdateStep(*library.Step) error
+	UpdateStep(context.Context, *library.Step) error
 	// DeleteStep defines a function that
 	// deletes a step by unique ID.
-	DeleteStep(int64) error
+	DeleteStep(context.Context, int64) error
 
 	// Service Database Interface Functions
 
 	// GetService defines a function that
 	// gets a step by number and build ID.
-	GetService(int, *library.Build) (*library.Service, error)
+	GetService(context.Context, int, *library.Build) (*library.Service, error)
 	// GetServiceList defines a function that
 	// gets a list of all steps.
-	GetServiceList() ([]*library.Service, error)
+	GetServiceList(context.Context) ([]*library.Service, error)
 	// GetBuildServiceList defines a function
 	// that gets a list of steps by build ID.
-	GetBuildServiceList(*library.Build, int, int) ([]*library.Service, error)
+	GetBuildServiceList(context.Context, *library.Build, int, int) ([]*library.Service, error)
 	// GetBuildServiceCount defines a function
 	// that gets the count of steps by build ID.
-	GetBuildServiceCount(*library.Build) (int64, error)
+	GetBuildServiceCount(context.Context, *library.Build) (int64, error)
 	// GetServiceImageCount defines a function that
 	// gets a list of all service images and the
 	// count of their occurrence.
-	GetServiceImageCount() (map[string]float64, error)
+	GetServiceImageCount(context.Context) (map[string]float64, error)
 	// GetServiceStatusCount defines a function that
 	// gets a list of all service statuses and the
 	// count of their occurrence.
-	GetServiceStatusCount() (map[string]float64, error)
+	GetServiceStatusCount(context.Context) (map[string]float64, error)
 	// CreateService defines a function that
 	// creates a new step.
-	CreateService(*library.Service) error
+	CreateService(context.Context, *library.Service) error
+	// CreateServices defines a function that
+	// creates a list of new services.
+	CreateServices(context.Context, []*library.Service) error
 	// UpdateService defines a function that
 	// updates a step.
-	UpdateService(*library.Service) error
+	UpdateService(context.Context, *library.Service) error
 	// DeleteService defines a function that
 	// deletes a step by unique ID.
-	DeleteService(int64) error
+	DeleteService(context.Context, int64) error
+
+	// TemplateService provides the interface for functionality
+	// related to templates stored in the database.
+	template.TemplateService
 
 	// UserService provides the interface for functionality
 	// related to users stored in the database.
@@ -185,4 +308,168 @@ type Service interface {
 	// WorkerService provides the interface for functionality
 	// related to workers stored in the database.
 	worker.WorkerService
+
+	// WorkerGroupService provides the interface for functionality
+	// related to worker groups stored in the database.
+	workergroup.WorkerGroupService
+
+	// CanaryRunService provides the interface for functionality
+	// related to canary runs stored in the database.
+	canaryrun.CanaryRunService
+
+	// CapacitySnapshotService provides the interface for functionality
+	// related to capacity snapshots stored in the database.
+	capacitysnapshot.CapacitySnapshotService
+
+	// ExportService provides the interface for functionality
+	// related to exports stored in the database.
+	export.ExportService
+
+	// RegistryCredentialService provides the interface for functionality
+	// related to registry credentials stored in the database.
+	registrycredential.RegistryCredentialService
+
+	// BuildCredentialService provides the interface for functionality
+	// related to build credentials stored in the database.
+	buildcredential.BuildCredentialService
+
+	// BuildImageService provides the interface for functionality
+	// related to build images stored in the database.
+	buildimage.BuildImageService
+
+	// RegistryMirrorService provides the interface for functionality
+	// related to registry mirrors stored in the database.
+	registrymirror.RegistryMirrorService
+
+	// ServiceReadinessService provides the interface for functionality
+	// related to service readiness stored in the database.
+	servicereadiness.ServiceReadinessService
+
+	// StepAttemptService provides the interface for functionality
+	// related to step attempts stored in the database.
+	stepattempt.StepAttemptService
+
+	// EgressRuleService provides the interface for functionality
+	// related to egress rules stored in the database.
+	egressrule.EgressRuleService
+
+	// PreviewEnvironmentService provides the interface for functionality
+	// related to preview environments stored in the database.
+	previewenvironment.PreviewEnvironmentService
+
+	// StagedWebhookService provides the interface for functionality
+	// related to staged webhooks stored in the database.
+	stagedwebhook.StagedWebhookService
+
+	// IdempotencyKeyService provides the interface for functionality
+	// related to idempotency keys stored in the database.
+	idempotencykey.IdempotencyKeyService
+
+	// ArtifactService provides the interface for functionality
+	// related to artifacts stored in the database.
+	artifact.ArtifactService
+
+	// OrgHookService provides the interface for functionality
+	// related to org hooks stored in the database.
+	orghook.OrgHookService
+
+	// WebhookDeliveryService provides the interface for functionality
+	// related to webhook deliveries stored in the database.
+	webhookdelivery.WebhookDeliveryService
+
+	// RequiredContextService provides the interface for functionality
+	// related to required contexts stored in the database.
+	requiredcontext.RequiredContextService
+
+	// BuildBudgetService provides the interface for functionality
+	// related to build budgets stored in the database.
+	buildbudget.BuildBudgetService
+
+	// StepSkipService provides the interface for functionality
+	// related to step skips stored in the database.
+	stepskip.StepSkipService
+
+	// RepoSyncService provides the interface for functionality
+	// related to repo syncs stored in the database.
+	reposync.RepoSyncService
+
+	// LeaseService provides the interface for functionality
+	// related to leases stored in the database.
+	lease.LeaseService
+
+	// FreezeService provides the interface for functionality
+	// related to change freezes stored in the database.
+	freeze.FreezeService
+
+	// FreezeAuditService provides the interface for functionality
+	// related to change freeze audits stored in the database.
+	freezeaudit.FreezeAuditService
+
+	// CostRateService provides the interface for functionality
+	// related to cost rates stored in the database.
+	costrate.CostRateService
+
+	// UserScopeService provides the interface for functionality
+	// related to user scopes stored in the database.
+	userscope.UserScopeService
+
+	// DeprecationRuleService provides the interface for functionality
+	// related to deprecation rules stored in the database.
+	deprecationrule.DeprecationRuleService
+
+	// BuildDeprecationService provides the interface for functionality
+	// related to build deprecations stored in the database.
+	builddeprecation.BuildDeprecationService
+
+	// CloneSettingService provides the interface for functionality
+	// related to repo clone settings stored in the database.
+	clonesetting.CloneSettingService
+
+	// EphemeralRegistrationService provides the interface for functionality
+	// related to ephemeral registrations stored in the database.
+	ephemeralregistration.EphemeralRegistrationService
+
+	// MigrationService provides the interface for functionality
+	// related to schema migrations stored in the database.
+	migration.MigrationService
+
+	// WebhookIntakeService provides the interface for functionality
+	// related to webhook intakes stored in the database.
+	webhookintake.WebhookIntakeService
+
+	// UserSessionService provides the interface for functionality
+	// related to user sessions stored in the database.
+	usersession.UserSessionService
+
+	// RepoVariableService provides the interface for functionality
+	// related to repo variables stored in the database.
+	repovariable.RepoVariableService
+
+	// AnnouncementService provides the interface for functionality
+	// related to announcements stored in the database.
+	announcement.AnnouncementService
+
+	// BuildRedactionService provides the interface for functionality
+	// related to build redactions stored in the database.
+	buildredaction.BuildRedactionService
+
+	// PartitionService provides the interface for functionality
+	// related to partitions of tables stored in the database.
+	partition.PartitionService
+
+	// RetentionPolicyService provides the interface for functionality
+	// related to retention policies stored in the database.
+	retentionpolicy.RetentionPolicyService
+
+	// LeakFindingService provides the interface for functionality
+	// related to leak findings stored in the database.
+	leakfinding.LeakFindingService
+
+	// TriggerTokenService provides the interface for functionality
+	// related to trigger tokens stored in the database.
+	triggertoken.TriggerTokenService
+
+	// ScheduleService provides the interface for functionality
+	// related to schedules and schedule runs stored in the database.
+	schedule.ScheduleService
 }
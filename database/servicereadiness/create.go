@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateServiceReadiness creates the readiness for a service in the database.
+func (e *engine) CreateServiceReadiness(ctx context.Context, s *api.ServiceReadiness) error {
+	e.logger.WithFields(logrus.Fields{
+		"build":   s.GetBuildID(),
+		"service": s.GetServiceID(),
+	}).Tracef("creating readiness for service %d in the database", s.GetServiceID())
+
+	// cast the API type to database type
+	readiness := types.ServiceReadinessFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := readiness.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableServiceReadiness).
+		Create(readiness).
+		Error
+}
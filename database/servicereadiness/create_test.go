@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestServiceReadiness_Engine_CreateServiceReadiness(t *testing.T) {
+	// setup types
+	_readiness := testServiceReadiness()
+	_readiness.SetID(1)
+	_readiness.SetServiceID(1)
+	_readiness.SetBuildID(1)
+	_readiness.SetRepoID(1)
+	_readiness.SetStatus("ready")
+	_readiness.SetAttempts(3)
+	_readiness.SetStarted(1)
+	_readiness.SetReady(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "service_readiness"
+("service_id","build_id","repo_id","status","attempts","started","ready","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs(1, 1, 1, "ready", 3, 1, 2, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateServiceReadiness(context.TODO(), _readiness)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateServiceReadiness for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateServiceReadiness for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
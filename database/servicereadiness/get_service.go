@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// GetServiceReadinessForService gets the readiness for a service from the database.
+func (e *engine) GetServiceReadinessForService(ctx context.Context, s *library.Service) (*api.ServiceReadiness, error) {
+	e.logger.Tracef("getting readiness for service %d from the database", s.GetID())
+
+	// variable to store query results
+	r := new(types.ServiceReadiness)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableServiceReadiness).
+		Where("service_id = ?", s.GetID()).
+		Take(r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ToAPI(), nil
+}
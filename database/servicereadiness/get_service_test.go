@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestServiceReadiness_Engine_GetServiceReadinessForService(t *testing.T) {
+	// setup types
+	_service := new(library.Service)
+	_service.SetID(1)
+
+	_readiness := testServiceReadiness()
+	_readiness.SetID(1)
+	_readiness.SetServiceID(1)
+	_readiness.SetBuildID(1)
+	_readiness.SetRepoID(1)
+	_readiness.SetStatus("ready")
+	_readiness.SetAttempts(3)
+	_readiness.SetStarted(1)
+	_readiness.SetReady(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "service_id", "build_id", "repo_id", "status", "attempts", "started", "ready"}).
+		AddRow(1, 1, 1, 1, "ready", 3, 1, 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "service_readiness" WHERE service_id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateServiceReadiness(context.TODO(), _readiness)
+	if err != nil {
+		t.Errorf("unable to create test service readiness for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.ServiceReadiness
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _readiness,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _readiness,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetServiceReadinessForService(context.TODO(), _service)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetServiceReadinessForService for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetServiceReadinessForService for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetServiceReadinessForService for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the service_readiness table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+service_readiness_build_id
+ON service_readiness (build_id);
+`
+)
+
+// CreateServiceReadinessIndexes creates the indexes for the service_readiness table in the database.
+func (e *engine) CreateServiceReadinessIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for service_readiness table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the build_id column index for the service_readiness table
+	return e.client.WithContext(ctx).Exec(CreateBuildIDIndex).Error
+}
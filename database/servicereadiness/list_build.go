@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListServiceReadinessForBuild gets the readiness for the services of a build from the database.
+func (e *engine) ListServiceReadinessForBuild(ctx context.Context, b *library.Build) ([]*api.ServiceReadiness, error) {
+	e.logger.Tracef("listing service readiness for build %d from the database", b.GetID())
+
+	// variables to store query results and return value
+	r := new([]types.ServiceReadiness)
+	readiness := []*api.ServiceReadiness{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableServiceReadiness).
+		Where("build_id = ?", b.GetID()).
+		Order("service_id").
+		Find(&r).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, service := range *r {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := service
+
+		// convert query result to API type
+		readiness = append(readiness, tmp.ToAPI())
+	}
+
+	return readiness, nil
+}
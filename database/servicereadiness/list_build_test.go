@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestServiceReadiness_Engine_ListServiceReadinessForBuild(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_readinessOne := testServiceReadiness()
+	_readinessOne.SetID(1)
+	_readinessOne.SetServiceID(1)
+	_readinessOne.SetBuildID(1)
+	_readinessOne.SetRepoID(1)
+	_readinessOne.SetStatus("ready")
+	_readinessOne.SetAttempts(3)
+	_readinessOne.SetStarted(1)
+	_readinessOne.SetReady(2)
+
+	_readinessTwo := testServiceReadiness()
+	_readinessTwo.SetID(2)
+	_readinessTwo.SetServiceID(2)
+	_readinessTwo.SetBuildID(1)
+	_readinessTwo.SetRepoID(1)
+	_readinessTwo.SetStatus("failure")
+	_readinessTwo.SetAttempts(10)
+	_readinessTwo.SetStarted(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "service_id", "build_id", "repo_id", "status", "attempts", "started", "ready"}).
+		AddRow(1, 1, 1, 1, "ready", 3, 1, 2).
+		AddRow(2, 2, 1, 1, "failure", 10, 1, 0)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "service_readiness" WHERE build_id = $1 ORDER BY service_id`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateServiceReadiness(context.TODO(), _readinessOne)
+	if err != nil {
+		t.Errorf("unable to create test service readiness for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateServiceReadiness(context.TODO(), _readinessTwo)
+	if err != nil {
+		t.Errorf("unable to create test service readiness for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.ServiceReadiness
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.ServiceReadiness{_readinessOne, _readinessTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.ServiceReadiness{_readinessOne, _readinessTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListServiceReadinessForBuild(context.TODO(), _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListServiceReadinessForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListServiceReadinessForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListServiceReadinessForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
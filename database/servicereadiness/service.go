@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// ServiceReadinessService represents the Vela interface for service readiness
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type ServiceReadinessService interface {
+	// ServiceReadiness Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateServiceReadinessIndexes defines a function that creates the indexes for the service_readiness table.
+	CreateServiceReadinessIndexes(context.Context) error
+	// CreateServiceReadinessTable defines a function that creates the service_readiness table.
+	CreateServiceReadinessTable(context.Context, string) error
+
+	// ServiceReadiness Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateServiceReadiness defines a function that creates the readiness for a service.
+	CreateServiceReadiness(context.Context, *api.ServiceReadiness) error
+	// GetServiceReadinessForService defines a function that gets the readiness for a service.
+	GetServiceReadinessForService(context.Context, *library.Service) (*api.ServiceReadiness, error)
+	// ListServiceReadinessForBuild defines a function that gets the readiness for the services of a build.
+	ListServiceReadinessForBuild(context.Context, *library.Build) ([]*api.ServiceReadiness, error)
+	// UpdateServiceReadiness defines a function that updates the readiness for a service.
+	UpdateServiceReadiness(context.Context, *api.ServiceReadiness) error
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableServiceReadiness represents the name of the table for service readiness.
+	TableServiceReadiness = "service_readiness"
+
+	// CreatePostgresTable represents a query to create the Postgres service_readiness table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+service_readiness (
+	id            SERIAL PRIMARY KEY,
+	service_id    INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	status        VARCHAR(250),
+	attempts      INTEGER,
+	started       INTEGER,
+	ready         INTEGER,
+	UNIQUE(service_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite service_readiness table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+service_readiness (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	service_id    INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	status        TEXT,
+	attempts      INTEGER,
+	started       INTEGER,
+	ready         INTEGER,
+	UNIQUE(service_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL service_readiness table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+service_readiness (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	service_id    INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	status        VARCHAR(250),
+	attempts      INTEGER,
+	started       INTEGER,
+	ready         INTEGER,
+	UNIQUE(service_id),
+	INDEX service_readiness_build_id (build_id)
+);
+`
+)
+
+// CreateServiceReadinessTable creates the service_readiness table in the database.
+func (e *engine) CreateServiceReadinessTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating service_readiness table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the service_readiness table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the service_readiness table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the service_readiness table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package servicereadiness
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestServiceReadiness_Engine_UpdateServiceReadiness(t *testing.T) {
+	// setup types
+	_readiness := testServiceReadiness()
+	_readiness.SetID(1)
+	_readiness.SetServiceID(1)
+	_readiness.SetBuildID(1)
+	_readiness.SetRepoID(1)
+	_readiness.SetStatus("ready")
+	_readiness.SetAttempts(3)
+	_readiness.SetStarted(1)
+	_readiness.SetReady(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "service_readiness"
+SET "service_id"=$1,"build_id"=$2,"repo_id"=$3,"status"=$4,"attempts"=$5,"started"=$6,"ready"=$7
+WHERE "id" = $8`).
+		WithArgs(1, 1, 1, "ready", 3, 1, 2, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateServiceReadiness(context.TODO(), _readiness)
+	if err != nil {
+		t.Errorf("unable to create test service readiness for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.UpdateServiceReadiness(context.TODO(), _readiness)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateServiceReadiness for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateServiceReadiness for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
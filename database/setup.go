@@ -9,8 +9,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-vela/server/blob"
+	"github.com/go-vela/server/database/log"
+	"github.com/go-vela/server/database/mysql"
 	"github.com/go-vela/server/database/postgres"
 	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/kms"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 )
@@ -25,6 +29,10 @@ type Setup struct {
 	Driver string
 	// specifies the address to use for the database client
 	Address string
+	// specifies the addresses of the read replicas to use for the database client
+	Replicas []string
+	// specifies the algorithm of compression to use for the database client
+	CompressionAlgorithm string
 	// specifies the level of compression to use for the database client
 	CompressionLevel int
 	// specifies the connection duration to use for the database client
@@ -33,8 +41,16 @@ type Setup struct {
 	ConnectionIdle int
 	// specifies the maximum open connections for the database client
 	ConnectionOpen int
+	// specifies the maximum duration of each statement for the database client
+	StatementTimeout time.Duration
+	// specifies the duration after which statements are logged as slow for the database client
+	SlowQueryThreshold time.Duration
 	// specifies the encryption key to use for the database client
 	EncryptionKey string
+	// specifies the key management service to use for the database client
+	KMS kms.Service
+	// specifies the blob storage service to use for the database client
+	Blob blob.Service
 	// specifies to skip creating tables and indexes for the database client
 	SkipCreation bool
 }
@@ -49,15 +65,46 @@ func (s *Setup) Postgres() (Service, error) {
 	// https://pkg.go.dev/github.com/go-vela/server/database/postgres?tab=doc#New
 	return postgres.New(
 		postgres.WithAddress(s.Address),
+		postgres.WithCompressionAlgorithm(s.CompressionAlgorithm),
 		postgres.WithCompressionLevel(s.CompressionLevel),
 		postgres.WithConnectionLife(s.ConnectionLife),
 		postgres.WithConnectionIdle(s.ConnectionIdle),
 		postgres.WithConnectionOpen(s.ConnectionOpen),
+		postgres.WithStatementTimeout(s.StatementTimeout),
+		postgres.WithSlowQueryThreshold(s.SlowQueryThreshold),
+		postgres.WithReplicas(s.Replicas),
 		postgres.WithEncryptionKey(s.EncryptionKey),
+		postgres.WithKMS(s.KMS),
+		postgres.WithBlob(s.Blob),
 		postgres.WithSkipCreation(s.SkipCreation),
 	)
 }
 
+// MySQL creates and returns a Vela service capable of
+// integrating with a MySQL or MariaDB database system.
+func (s *Setup) MySQL() (Service, error) {
+	logrus.Trace("creating mysql database client from setup")
+
+	// create new MySQL database service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/database/mysql?tab=doc#New
+	return mysql.New(
+		mysql.WithAddress(s.Address),
+		mysql.WithCompressionAlgorithm(s.CompressionAlgorithm),
+		mysql.WithCompressionLevel(s.CompressionLevel),
+		mysql.WithConnectionLife(s.ConnectionLife),
+		mysql.WithConnectionIdle(s.ConnectionIdle),
+		mysql.WithConnectionOpen(s.ConnectionOpen),
+		mysql.WithStatementTimeout(s.StatementTimeout),
+		mysql.WithSlowQueryThreshold(s.SlowQueryThreshold),
+		mysql.WithReplicas(s.Replicas),
+		mysql.WithEncryptionKey(s.EncryptionKey),
+		mysql.WithKMS(s.KMS),
+		mysql.WithBlob(s.Blob),
+		mysql.WithSkipCreation(s.SkipCreation),
+	)
+}
+
 // Sqlite creates and returns a Vela service capable of
 // integrating with a Sqlite database system.
 func (s *Setup) Sqlite() (Service, error) {
@@ -68,11 +115,17 @@ func (s *Setup) Sqlite() (Service, error) {
 	// https://pkg.go.dev/github.com/go-vela/server/database/sqlite?tab=doc#New
 	return sqlite.New(
 		sqlite.WithAddress(s.Address),
+		sqlite.WithCompressionAlgorithm(s.CompressionAlgorithm),
 		sqlite.WithCompressionLevel(s.CompressionLevel),
 		sqlite.WithConnectionLife(s.ConnectionLife),
 		sqlite.WithConnectionIdle(s.ConnectionIdle),
 		sqlite.WithConnectionOpen(s.ConnectionOpen),
+		sqlite.WithStatementTimeout(s.StatementTimeout),
+		sqlite.WithSlowQueryThreshold(s.SlowQueryThreshold),
+		sqlite.WithReplicas(s.Replicas),
 		sqlite.WithEncryptionKey(s.EncryptionKey),
+		sqlite.WithKMS(s.KMS),
+		sqlite.WithBlob(s.Blob),
 		sqlite.WithSkipCreation(s.SkipCreation),
 	)
 }
@@ -97,11 +150,36 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("database address must not have trailing slash")
 	}
 
+	// check if any database replica address has a trailing slash
+	for _, replica := range s.Replicas {
+		if strings.HasSuffix(replica, "/") {
+			return fmt.Errorf("database replica address must not have trailing slash")
+		}
+	}
+
 	// verify a database encryption key was provided
 	if len(s.EncryptionKey) == 0 {
 		return fmt.Errorf("no database encryption key provided")
 	}
 
+	// verify the database statement timeout is not negative
+	if s.StatementTimeout < 0 {
+		return fmt.Errorf("database statement timeout must not be negative - provided timeout: %s", s.StatementTimeout)
+	}
+
+	// verify the database slow query threshold is not negative
+	if s.SlowQueryThreshold < 0 {
+		return fmt.Errorf("database slow query threshold must not be negative - provided threshold: %s", s.SlowQueryThreshold)
+	}
+
+	// verify the database compression algorithm is valid
+	switch s.CompressionAlgorithm {
+	case "", log.CompressionZlib, log.CompressionGzip, log.CompressionZstd:
+		break
+	default:
+		return fmt.Errorf("database compression algorithm must be one of %s, %s or %s - provided algorithm: %s", log.CompressionZlib, log.CompressionGzip, log.CompressionZstd, s.CompressionAlgorithm)
+	}
+
 	// verify the database compression level is valid
 	switch s.CompressionLevel {
 	case constants.CompressionNegOne:
@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"errors"
 
 	"github.com/sirupsen/logrus"
@@ -18,7 +19,7 @@ import (
 )
 
 // GetBuild gets a build by number and repo ID from the database.
-func (c *client) GetBuild(number int, r *library.Repo) (*library.Build, error) {
+func (c *client) GetBuild(ctx context.Context, number int, r *library.Repo) (*library.Build, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build": number,
 		"org":   r.GetOrg(),
@@ -29,7 +30,7 @@ func (c *client) GetBuild(number int, r *library.Repo) (*library.Build, error) {
 	b := new(database.Build)
 
 	// send query to the database and store result in variable
-	result := c.Sqlite.
+	result := c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.SelectRepoBuild, r.GetID(), number).
 		Scan(b)
@@ -43,7 +44,7 @@ func (c *client) GetBuild(number int, r *library.Repo) (*library.Build, error) {
 }
 
 // GetBuildByID gets a build by its ID from the database.
-func (c *client) GetBuildByID(id int64) (*library.Build, error) {
+func (c *client) GetBuildByID(ctx context.Context, id int64) (*library.Build, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build": id,
 	}).Tracef("getting build %d from the database", id)
@@ -52,7 +53,7 @@ func (c *client) GetBuildByID(id int64) (*library.Build, error) {
 	b := new(database.Build)
 
 	// send query to the database and store result in variable
-	result := c.Sqlite.
+	result := c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.SelectBuildByID, id).
 		Scan(b)
@@ -66,7 +67,7 @@ func (c *client) GetBuildByID(id int64) (*library.Build, error) {
 }
 
 // GetLastBuild gets the last build by repo ID from the database.
-func (c *client) GetLastBuild(r *library.Repo) (*library.Build, error) {
+func (c *client) GetLastBuild(ctx context.Context, r *library.Repo) (*library.Build, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -76,7 +77,7 @@ func (c *client) GetLastBuild(r *library.Repo) (*library.Build, error) {
 	b := new(database.Build)
 
 	// send query to the database and store result in variable
-	result := c.Sqlite.
+	result := c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.SelectLastRepoBuild, r.GetID()).
 		Scan(b)
@@ -91,7 +92,7 @@ func (c *client) GetLastBuild(r *library.Repo) (*library.Build, error) {
 }
 
 // GetLastBuildByBranch gets the last build by repo ID and branch from the database.
-func (c *client) GetLastBuildByBranch(r *library.Repo, branch string) (*library.Build, error) {
+func (c *client) GetLastBuildByBranch(ctx context.Context, r *library.Repo, branch string) (*library.Build, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -101,7 +102,7 @@ func (c *client) GetLastBuildByBranch(r *library.Repo, branch string) (*library.
 	b := new(database.Build)
 
 	// send query to the database and store result in variable
-	result := c.Sqlite.
+	result := c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.SelectLastRepoBuildByBranch, r.GetID(), branch).
 		Scan(b)
@@ -117,14 +118,14 @@ func (c *client) GetLastBuildByBranch(r *library.Repo, branch string) (*library.
 
 // GetPendingAndRunningBuilds returns the list of pending
 // and running builds within the given timeframe.
-func (c *client) GetPendingAndRunningBuilds(after string) ([]*library.BuildQueue, error) {
+func (c *client) GetPendingAndRunningBuilds(ctx context.Context, after string) ([]*library.BuildQueue, error) {
 	c.Logger.Trace("getting pending and running builds from the database")
 
 	// variable to store query results
 	b := new([]database.BuildQueue)
 
 	// send query to the database and store result in variable
-	result := c.Sqlite.
+	result := c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.SelectPendingAndRunningBuilds, after).
 		Scan(b)
@@ -145,7 +146,7 @@ func (c *client) GetPendingAndRunningBuilds(after string) ([]*library.BuildQueue
 }
 
 // CreateBuild creates a new build in the database.
-func (c *client) CreateBuild(b *library.Build) error {
+func (c *client) CreateBuild(ctx context.Context, b *library.Build) error {
 	c.Logger.WithFields(logrus.Fields{
 		"build": b.GetNumber(),
 	}).Tracef("creating build %d in the database", b.GetNumber())
@@ -160,13 +161,13 @@ func (c *client) CreateBuild(b *library.Build) error {
 	}
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Create(build.Crop()).Error
 }
 
 // UpdateBuild updates a build in the database.
-func (c *client) UpdateBuild(b *library.Build) error {
+func (c *client) UpdateBuild(ctx context.Context, b *library.Build) error {
 	c.Logger.WithFields(logrus.Fields{
 		"build": b.GetNumber(),
 	}).Tracef("updating build %d in the database", b.GetNumber())
@@ -181,17 +182,17 @@ func (c *client) UpdateBuild(b *library.Build) error {
 	}
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Save(build.Crop()).Error
 }
 
 // DeleteBuild deletes a build by unique ID from the database.
-func (c *client) DeleteBuild(id int64) error {
+func (c *client) DeleteBuild(ctx context.Context, id int64) error {
 	c.Logger.Tracef("deleting build %d in the database", id)
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Exec(dml.DeleteBuild, id).Error
 }
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestSqlite_Client_ArchiveRepoBuilds(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	_buildThree := testBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepoID(2)
+	_buildThree.SetNumber(1)
+	_buildThree.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the builds table
+	defer _database.Sqlite.Exec("delete from builds;")
+
+	// create the builds in the database
+	for _, build := range []*library.Build{_buildOne, _buildTwo, _buildThree} {
+		err := _database.CreateBuild(context.TODO(), build)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	// run test
+	got, err := _database.ArchiveRepoBuilds(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("ArchiveRepoBuilds returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("ArchiveRepoBuilds is %v, want 2", got)
+	}
+
+	// ensure the archived builds are excluded from the count
+	count, err := _database.GetBuildCount(context.TODO())
+	if err != nil {
+		t.Errorf("GetBuildCount returned err: %v", err)
+	}
+
+	if count != 1 {
+		t.Errorf("GetBuildCount is %v, want 1", count)
+	}
+}
+
+func TestSqlite_Client_RestoreRepoBuilds(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the builds table
+	defer _database.Sqlite.Exec("delete from builds;")
+
+	// create the builds in the database
+	for _, build := range []*library.Build{_buildOne, _buildTwo} {
+		err := _database.CreateBuild(context.TODO(), build)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	_, err = _database.ArchiveRepoBuilds(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to archive test builds: %v", err)
+	}
+
+	// run test
+	got, err := _database.RestoreRepoBuilds(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("RestoreRepoBuilds returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("RestoreRepoBuilds is %v, want 2", got)
+	}
+
+	// ensure the restored builds are included in the count
+	count, err := _database.GetBuildCount(context.TODO())
+	if err != nil {
+		t.Errorf("GetBuildCount returned err: %v", err)
+	}
+
+	if count != 2 {
+		t.Errorf("GetBuildCount is %v, want 2", count)
+	}
+}
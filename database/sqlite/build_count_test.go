@@ -5,12 +5,14 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
 
 	"github.com/go-vela/server/database/sqlite/ddl"
 	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
 )
 
 func init() {
@@ -66,17 +68,17 @@ func TestSqlite_Client_GetBuildCount(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from builds;")
 
 		// create the builds in the database
-		err := _database.CreateBuild(_buildOne)
+		err := _database.CreateBuild(context.TODO(), _buildOne)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		err = _database.CreateBuild(_buildTwo)
+		err = _database.CreateBuild(context.TODO(), _buildTwo)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		got, err := _database.GetBuildCount()
+		got, err := _database.GetBuildCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -137,17 +139,17 @@ func TestSqlite_Client_GetBuildCountByStatus(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from builds;")
 
 		// create the builds in the database
-		err := _database.CreateBuild(_buildOne)
+		err := _database.CreateBuild(context.TODO(), _buildOne)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		err = _database.CreateBuild(_buildTwo)
+		err = _database.CreateBuild(context.TODO(), _buildTwo)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		got, err := _database.GetBuildCountByStatus("running")
+		got, err := _database.GetBuildCountByStatus(context.TODO(), "running")
 
 		if test.failure {
 			if err == nil {
@@ -167,6 +169,84 @@ func TestSqlite_Client_GetBuildCountByStatus(t *testing.T) {
 	}
 }
 
+func TestSqlite_Client_GetBuildCountByHost(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetHost("worker-1.example.com")
+	_buildOne.SetStatus("running")
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetHost("worker-1.example.com")
+	_buildTwo.SetStatus("running")
+	_buildTwo.SetDeployPayload(nil)
+
+	_buildThree := testBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepoID(1)
+	_buildThree.SetNumber(3)
+	_buildThree.SetHost("worker-2.example.com")
+	_buildThree.SetStatus("success")
+	_buildThree.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    map[string]int64
+	}{
+		{
+			failure: false,
+			want:    map[string]int64{"worker-1.example.com": 2},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the builds table
+		defer _database.Sqlite.Exec("delete from builds;")
+
+		// create the builds in the database
+		for _, build := range []*library.Build{_buildOne, _buildTwo, _buildThree} {
+			err := _database.CreateBuild(context.TODO(), build)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+		}
+
+		got, err := _database.GetBuildCountByHost(context.TODO(), "running")
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetBuildCountByHost should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetBuildCountByHost returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetBuildCountByHost is %v, want %v", got, test.want)
+		}
+	}
+}
+
 func TestSqlite_Client_GetOrgBuildCount(t *testing.T) {
 	// setup types
 	_buildOne := testBuild()
@@ -217,7 +297,7 @@ func TestSqlite_Client_GetOrgBuildCount(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from repos;")
 
 		// create the repo in the database
-		err := _database.CreateRepo(_repo)
+		err := _database.CreateRepo(context.TODO(), _repo)
 		if err != nil {
 			t.Errorf("unable to create test repo: %v", err)
 		}
@@ -226,17 +306,17 @@ func TestSqlite_Client_GetOrgBuildCount(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from builds;")
 
 		// create the builds in the database
-		err = _database.CreateBuild(_buildOne)
+		err = _database.CreateBuild(context.TODO(), _buildOne)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		err = _database.CreateBuild(_buildTwo)
+		err = _database.CreateBuild(context.TODO(), _buildTwo)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		got, err := _database.GetOrgBuildCount("foo", filters)
+		got, err := _database.GetOrgBuildCount(context.TODO(), "foo", filters)
 
 		if test.failure {
 			if err == nil {
@@ -310,7 +390,7 @@ func TestSqlite_Client_GetOrgBuildCountByEvent(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from repos;")
 
 		// create the repo in the database
-		err := _database.CreateRepo(_repo)
+		err := _database.CreateRepo(context.TODO(), _repo)
 		if err != nil {
 			t.Errorf("unable to create test repo: %v", err)
 		}
@@ -319,17 +399,17 @@ func TestSqlite_Client_GetOrgBuildCountByEvent(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from builds;")
 
 		// create the builds in the database
-		err = _database.CreateBuild(_buildOne)
+		err = _database.CreateBuild(context.TODO(), _buildOne)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		err = _database.CreateBuild(_buildTwo)
+		err = _database.CreateBuild(context.TODO(), _buildTwo)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		got, err := _database.GetOrgBuildCount("foo", filters)
+		got, err := _database.GetOrgBuildCount(context.TODO(), "foo", filters)
 
 		if test.failure {
 			if err == nil {
@@ -399,7 +479,7 @@ func TestSqlite_Client_GetRepoBuildCount(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from repos;")
 
 		// create the repo in the database
-		err := _database.CreateRepo(_repo)
+		err := _database.CreateRepo(context.TODO(), _repo)
 		if err != nil {
 			t.Errorf("unable to create test repo: %v", err)
 		}
@@ -408,17 +488,17 @@ func TestSqlite_Client_GetRepoBuildCount(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from builds;")
 
 		// create the builds in the database
-		err = _database.CreateBuild(_buildOne)
+		err = _database.CreateBuild(context.TODO(), _buildOne)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		err = _database.CreateBuild(_buildTwo)
+		err = _database.CreateBuild(context.TODO(), _buildTwo)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		got, err := _database.GetRepoBuildCount(_repo, filters)
+		got, err := _database.GetRepoBuildCount(context.TODO(), _repo, filters)
 
 		if test.failure {
 			if err == nil {
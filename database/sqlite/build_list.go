@@ -5,6 +5,8 @@
 package sqlite
 
 import (
+	"context"
+
 	"github.com/go-vela/server/database/sqlite/dml"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
@@ -13,14 +15,14 @@ import (
 )
 
 // GetBuildList gets a list of all builds from the database.
-func (c *client) GetBuildList() ([]*library.Build, error) {
+func (c *client) GetBuildList(ctx context.Context) ([]*library.Build, error) {
 	c.Logger.Trace("listing builds from the database")
 
 	// variable to store query results
 	b := new([]database.Build)
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Raw(dml.ListBuilds).
 		Scan(b).Error
@@ -39,8 +41,135 @@ func (c *client) GetBuildList() ([]*library.Build, error) {
 	return builds, err
 }
 
+// GetFinishedBuildListSince gets a list of all finished
+// builds created after the provided time from the database.
+func (c *client) GetFinishedBuildListSince(ctx context.Context, after int64) ([]*library.Build, error) {
+	c.Logger.Tracef("listing finished builds created after %d from the database", after)
+
+	// variable to store query results
+	b := new([]database.Build)
+
+	// send query to the database and store result in variable
+	err := c.Sqlite.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("created > ? AND finished > 0", after).
+		Find(b).Error
+
+	// variable we want to return
+	builds := []*library.Build{}
+	// iterate through all query results
+	for _, build := range *b {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := build
+
+		// convert query result to library type
+		builds = append(builds, tmp.ToLibrary())
+	}
+
+	return builds, err
+}
+
+// GetFinishedBuildListBetween gets a list of all builds finished
+// after and at or before the provided times from the database.
+func (c *client) GetFinishedBuildListBetween(ctx context.Context, after, before int64) ([]*library.Build, error) {
+	c.Logger.Tracef("listing builds finished between %d and %d from the database", after, before)
+
+	// variable to store query results
+	b := new([]database.Build)
+
+	// send query to the database and store result in variable
+	err := c.Sqlite.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("finished > ? AND finished <= ?", after, before).
+		Order("finished").
+		Order("id").
+		Find(b).Error
+
+	// variable we want to return
+	builds := []*library.Build{}
+	// iterate through all query results
+	for _, build := range *b {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := build
+
+		// convert query result to library type
+		builds = append(builds, tmp.ToLibrary())
+	}
+
+	return builds, err
+}
+
+// GetPendingBuildListBefore gets a list of all pending
+// builds created before the provided time from the database.
+func (c *client) GetPendingBuildListBefore(ctx context.Context, before int64) ([]*library.Build, error) {
+	c.Logger.Tracef("listing pending builds created before %d from the database", before)
+
+	// variable to store query results
+	b := new([]database.Build)
+
+	// send query to the database and store result in variable
+	err := c.Sqlite.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("status = ? AND created < ?", constants.StatusPending, before).
+		Order("created").
+		Order("id").
+		Find(b).Error
+
+	// variable we want to return
+	builds := []*library.Build{}
+	// iterate through all query results
+	for _, build := range *b {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := build
+
+		// convert query result to library type
+		builds = append(builds, tmp.ToLibrary())
+	}
+
+	return builds, err
+}
+
+// GetLastDeploymentBuildList gets a list of the last successful
+// deployment build for each environment by repo ID from the database.
+func (c *client) GetLastDeploymentBuildList(ctx context.Context, r *library.Repo) ([]*library.Build, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("listing last deployment builds for repo %s from the database", r.GetFullName())
+
+	// variable to store query results
+	b := new([]database.Build)
+
+	// capture the last successful deployment build for each environment
+	last := c.Sqlite.WithContext(ctx).
+		Table(constants.TableBuild).
+		Select("MAX(id)").
+		Where("repo_id = ? AND event = ? AND status = ?", r.GetID(), constants.EventDeploy, constants.StatusSuccess).
+		Group("deploy")
+
+	// send query to the database and store result in variable
+	err := c.Sqlite.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("id IN (?)", last).
+		Order("deploy").
+		Find(b).Error
+
+	// variable we want to return
+	builds := []*library.Build{}
+	// iterate through all query results
+	for _, build := range *b {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := build
+
+		// convert query result to library type
+		builds = append(builds, tmp.ToLibrary())
+	}
+
+	return builds, err
+}
+
 // GetDeploymentBuildList gets a list of all builds from the database.
-func (c *client) GetDeploymentBuildList(deployment string) ([]*library.Build, error) {
+func (c *client) GetDeploymentBuildList(ctx context.Context, deployment string) ([]*library.Build, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"deployment": deployment,
 	}).Tracef("listing builds for deployment %s from the database", deployment)
@@ -53,7 +182,7 @@ func (c *client) GetDeploymentBuildList(deployment string) ([]*library.Build, er
 		filters["source"] = deployment
 	}
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Select("*").
 		Where(filters).
@@ -76,7 +205,7 @@ func (c *client) GetDeploymentBuildList(deployment string) ([]*library.Build, er
 }
 
 // GetOrgBuildList gets a list of all builds by org name from the database.
-func (c *client) GetOrgBuildList(org string, filters map[string]interface{}, page int, perPage int) ([]*library.Build, int64, error) {
+func (c *client) GetOrgBuildList(ctx context.Context, org string, filters map[string]interface{}, page int, perPage int) ([]*library.Build, int64, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"org": org,
 	}).Tracef("listing builds for org %s from the database", org)
@@ -87,7 +216,7 @@ func (c *client) GetOrgBuildList(org string, filters map[string]interface{}, pag
 	count := int64(0)
 
 	// // count the results
-	count, err := c.GetOrgBuildCount(org, filters)
+	count, err := c.GetOrgBuildCount(ctx, org, filters)
 
 	if err != nil {
 		return builds, 0, err
@@ -102,10 +231,11 @@ func (c *client) GetOrgBuildList(org string, filters map[string]interface{}, pag
 	offset := perPage * (page - 1)
 
 	// send query to the database and store result in variable
-	err = c.Sqlite.
+	err = c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Select("builds.*").
 		Joins("JOIN repos ON builds.repo_id = repos.id AND repos.org = ?", org).
+		Where("builds.deleted_at IS NULL").
 		Where(filters).
 		Order("created DESC").
 		Order("id").
@@ -126,7 +256,7 @@ func (c *client) GetOrgBuildList(org string, filters map[string]interface{}, pag
 }
 
 // GetRepoBuildList gets a list of all builds by repo ID from the database.
-func (c *client) GetRepoBuildList(r *library.Repo, filters map[string]interface{}, before, after int64, page, perPage int) ([]*library.Build, int64, error) {
+func (c *client) GetRepoBuildList(ctx context.Context, r *library.Repo, filters map[string]interface{}, before, after int64, page, perPage int) ([]*library.Build, int64, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
@@ -138,7 +268,7 @@ func (c *client) GetRepoBuildList(r *library.Repo, filters map[string]interface{
 	count := int64(0)
 
 	// count the results
-	count, err := c.GetRepoBuildCount(r, filters)
+	count, err := c.GetRepoBuildCount(ctx, r, filters)
 	if err != nil {
 		return builds, 0, err
 	}
@@ -152,7 +282,7 @@ func (c *client) GetRepoBuildList(r *library.Repo, filters map[string]interface{
 	offset := perPage * (page - 1)
 
 	// send query to the database and store result in variable
-	err = c.Sqlite.
+	err = c.Sqlite.WithContext(ctx).
 		Table(constants.TableBuild).
 		Where("repo_id = ?", r.GetID()).
 		Where("created < ?", before).
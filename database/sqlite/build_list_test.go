@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -69,13 +70,13 @@ func TestSqlite_Client_GetBuildList(t *testing.T) {
 
 		for _, build := range test.want {
 			// create the build in the database
-			err := _database.CreateBuild(build)
+			err := _database.CreateBuild(context.TODO(), build)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, err := _database.GetBuildList()
+		got, err := _database.GetBuildList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -95,6 +96,349 @@ func TestSqlite_Client_GetBuildList(t *testing.T) {
 	}
 }
 
+func TestSqlite_Client_GetFinishedBuildListSince(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetCreated(2)
+	_buildOne.SetFinished(3)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetCreated(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	_buildThree := testBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepoID(1)
+	_buildThree.SetNumber(3)
+	_buildThree.SetCreated(1)
+	_buildThree.SetFinished(3)
+	_buildThree.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the builds table
+		defer _database.Sqlite.Exec("delete from builds;")
+
+		for _, build := range []*library.Build{_buildOne, _buildTwo, _buildThree} {
+			// create the build in the database
+			err := _database.CreateBuild(context.TODO(), build)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+		}
+
+		got, err := _database.GetFinishedBuildListSince(context.TODO(), 1)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedBuildListSince should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedBuildListSince returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedBuildListSince is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSqlite_Client_GetFinishedBuildListBetween(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetFinished(3)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetFinished(2)
+	_buildTwo.SetDeployPayload(nil)
+
+	_buildThree := testBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepoID(1)
+	_buildThree.SetNumber(3)
+	_buildThree.SetFinished(5)
+	_buildThree.SetDeployPayload(nil)
+
+	_buildFour := testBuild()
+	_buildFour.SetID(4)
+	_buildFour.SetRepoID(1)
+	_buildFour.SetNumber(4)
+	_buildFour.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildTwo, _buildOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the builds table
+		defer _database.Sqlite.Exec("delete from builds;")
+
+		for _, build := range []*library.Build{_buildOne, _buildTwo, _buildThree, _buildFour} {
+			// create the build in the database
+			err := _database.CreateBuild(context.TODO(), build)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+		}
+
+		got, err := _database.GetFinishedBuildListBetween(context.TODO(), 1, 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedBuildListBetween should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedBuildListBetween returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedBuildListBetween is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSqlite_Client_GetPendingBuildListBefore(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetStatus("pending")
+	_buildOne.SetCreated(2)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetStatus("pending")
+	_buildTwo.SetCreated(1)
+	_buildTwo.SetDeployPayload(nil)
+
+	_buildThree := testBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepoID(1)
+	_buildThree.SetNumber(3)
+	_buildThree.SetStatus("pending")
+	_buildThree.SetCreated(5)
+	_buildThree.SetDeployPayload(nil)
+
+	_buildFour := testBuild()
+	_buildFour.SetID(4)
+	_buildFour.SetRepoID(1)
+	_buildFour.SetNumber(4)
+	_buildFour.SetStatus("running")
+	_buildFour.SetCreated(1)
+	_buildFour.SetDeployPayload(nil)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildTwo, _buildOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the builds table
+		defer _database.Sqlite.Exec("delete from builds;")
+
+		for _, build := range []*library.Build{_buildOne, _buildTwo, _buildThree, _buildFour} {
+			// create the build in the database
+			err := _database.CreateBuild(context.TODO(), build)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+		}
+
+		got, err := _database.GetPendingBuildListBefore(context.TODO(), 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetPendingBuildListBefore should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetPendingBuildListBefore returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetPendingBuildListBefore is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSqlite_Client_GetLastDeploymentBuildList(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetEvent("deployment")
+	_buildOne.SetStatus("success")
+	_buildOne.SetDeploy("production")
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetEvent("deployment")
+	_buildTwo.SetStatus("failure")
+	_buildTwo.SetDeploy("production")
+	_buildTwo.SetDeployPayload(nil)
+
+	_buildThree := testBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepoID(1)
+	_buildThree.SetNumber(3)
+	_buildThree.SetEvent("deployment")
+	_buildThree.SetStatus("success")
+	_buildThree.SetDeploy("staging")
+	_buildThree.SetDeployPayload(nil)
+
+	_buildFour := testBuild()
+	_buildFour.SetID(4)
+	_buildFour.SetRepoID(1)
+	_buildFour.SetNumber(4)
+	_buildFour.SetEvent("push")
+	_buildFour.SetStatus("success")
+	_buildFour.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+	_repo.SetPipelineType("yaml")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			want:    []*library.Build{_buildOne, _buildThree},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the builds table
+		defer _database.Sqlite.Exec("delete from builds;")
+
+		for _, build := range []*library.Build{_buildOne, _buildTwo, _buildThree, _buildFour} {
+			// create the build in the database
+			err := _database.CreateBuild(context.TODO(), build)
+			if err != nil {
+				t.Errorf("unable to create test build: %v", err)
+			}
+		}
+
+		got, err := _database.GetLastDeploymentBuildList(context.TODO(), _repo)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetLastDeploymentBuildList should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetLastDeploymentBuildList returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetLastDeploymentBuildList is %v, want %v", got, test.want)
+		}
+	}
+}
+
 func TestSqlite_Client_GetDeploymentBuildList(t *testing.T) {
 	// setup types
 	_buildOne := testBuild()
@@ -141,13 +485,13 @@ func TestSqlite_Client_GetDeploymentBuildList(t *testing.T) {
 
 		for _, build := range test.want {
 			// create the build in the database
-			err := _database.CreateBuild(build)
+			err := _database.CreateBuild(context.TODO(), build)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, err := _database.GetDeploymentBuildList("https://github.com/github/octocat/deployments/1")
+		got, err := _database.GetDeploymentBuildList(context.TODO(), "https://github.com/github/octocat/deployments/1")
 
 		if test.failure {
 			if err == nil {
@@ -219,7 +563,7 @@ func TestSqlite_Client_GetOrgBuildList(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from repos;")
 
 		// create the repo in the database
-		err := _database.CreateRepo(_repo)
+		err := _database.CreateRepo(context.TODO(), _repo)
 		if err != nil {
 			t.Errorf("unable to create test repo: %v", err)
 		}
@@ -229,13 +573,13 @@ func TestSqlite_Client_GetOrgBuildList(t *testing.T) {
 
 		for _, build := range test.want {
 			// create the build in the database
-			err := _database.CreateBuild(build)
+			err := _database.CreateBuild(context.TODO(), build)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, _, err := _database.GetOrgBuildList("foo", filters, 1, 10)
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -316,7 +660,7 @@ func TestSqlite_Client_GetOrgBuildList_NonAdmin(t *testing.T) {
 
 		for _, repo := range repos {
 			// create the repo in the database
-			err := _database.CreateRepo(repo)
+			err := _database.CreateRepo(context.TODO(), repo)
 			if err != nil {
 				t.Errorf("unable to create test repo: %v", err)
 			}
@@ -327,13 +671,13 @@ func TestSqlite_Client_GetOrgBuildList_NonAdmin(t *testing.T) {
 
 		for _, build := range test.want {
 			// create the build in the database
-			err := _database.CreateBuild(build)
+			err := _database.CreateBuild(context.TODO(), build)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, _, err := _database.GetOrgBuildList("foo", filters, 1, 10)
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -407,7 +751,7 @@ func TestSqlite_Client_GetOrgBuildListByEvent(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from repos;")
 
 		// create the repo in the database
-		err := _database.CreateRepo(_repo)
+		err := _database.CreateRepo(context.TODO(), _repo)
 		if err != nil {
 			t.Errorf("unable to create test repo: %v", err)
 		}
@@ -417,13 +761,13 @@ func TestSqlite_Client_GetOrgBuildListByEvent(t *testing.T) {
 
 		for _, build := range []*library.Build{_buildTwo, _buildOne} {
 			// create the build in the database
-			err := _database.CreateBuild(build)
+			err := _database.CreateBuild(context.TODO(), build)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, _, err := _database.GetOrgBuildList("foo", filters, 1, 10)
+		got, _, err := _database.GetOrgBuildList(context.TODO(), "foo", filters, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -495,7 +839,7 @@ func TestSqlite_Client_GetRepoBuildList(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from repos;")
 
 		// create the repo in the database
-		err := _database.CreateRepo(_repo)
+		err := _database.CreateRepo(context.TODO(), _repo)
 		if err != nil {
 			t.Errorf("unable to create test repo: %v", err)
 		}
@@ -505,13 +849,13 @@ func TestSqlite_Client_GetRepoBuildList(t *testing.T) {
 
 		for _, build := range test.want {
 			// create the build in the database
-			err := _database.CreateBuild(build)
+			err := _database.CreateBuild(context.TODO(), build)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, _, err := _database.GetRepoBuildList(_repo, filters, time.Now().UTC().Unix(), 0, 1, 10)
+		got, _, err := _database.GetRepoBuildList(context.TODO(), _repo, filters, time.Now().UTC().Unix(), 0, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// SearchBuilds gets a list of builds matching the search query from the
+// database, optionally including builds with log lines matching the query.
+func (c *client) SearchBuilds(ctx context.Context, query string, filters map[string]interface{}, logs bool, page, perPage int) ([]*library.Build, int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"logs": logs,
+	}).Tracef("searching builds for %s in the database", query)
+
+	// variables to store query results
+	b := new([]database.Build)
+	builds := []*library.Build{}
+	count := int64(0)
+
+	// count the results
+	err := c.searchBuilds(ctx, query, filters, logs).Count(&count).Error
+	if err != nil {
+		return builds, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return builds, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = c.searchBuilds(ctx, query, filters, logs).
+		Order("created DESC").
+		Order("id").
+		Limit(perPage).
+		Offset(offset).
+		Find(b).Error
+
+	// iterate through all query results
+	for _, build := range *b {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := build
+
+		// convert query result to library type
+		builds = append(builds, tmp.ToLibrary())
+	}
+
+	return builds, count, err
+}
+
+// searchBuilds is a helper function to build the query for
+// builds matching the search query. Full-text search indexes
+// are only available for Postgres so this falls back to
+// pattern matching on the builds and log_lines tables.
+func (c *client) searchBuilds(ctx context.Context, query string, filters map[string]interface{}, logs bool) *gorm.DB {
+	pattern := "%" + query + "%"
+
+	match := "title LIKE ? OR message LIKE ? OR branch LIKE ? OR author LIKE ? OR sender LIKE ?"
+	args := []interface{}{pattern, pattern, pattern, pattern, pattern}
+
+	if logs {
+		match += " OR id IN (SELECT build_id FROM log_lines WHERE data LIKE ?)"
+		args = append(args, pattern)
+	}
+
+	return c.Sqlite.WithContext(ctx).
+		Table(constants.TableBuild).
+		Where("repo_id IN (?)", c.Sqlite.Table(constants.TableRepo).Select("id").Where(filters)).
+		Where(match, args...)
+}
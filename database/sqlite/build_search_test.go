@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestSqlite_Client_SearchBuilds(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetMessage("fix the flaky test")
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetMessage("add a new feature")
+	_buildTwo.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_line := new(api.LogLine)
+	_line.SetBuildID(2)
+	_line.SetLogID(1)
+	_line.SetNumber(1)
+	_line.SetStream("stdout")
+	_line.SetData("applying fix for the new feature")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the repos, builds and log_lines tables
+	defer _database.Sqlite.Exec("delete from repos;")
+	defer _database.Sqlite.Exec("delete from builds;")
+	defer _database.Sqlite.Exec("delete from log_lines;")
+
+	// create the repo in the database
+	err = _database.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	for _, build := range []*library.Build{_buildOne, _buildTwo} {
+		// create the build in the database
+		err = _database.CreateBuild(context.TODO(), build)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	// create the log line in the database
+	err = _database.CreateLogLines(context.TODO(), []*api.LogLine{_line})
+	if err != nil {
+		t.Errorf("unable to create test log line: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		logs    bool
+		filters map[string]interface{}
+		want    []*library.Build
+	}{
+		{
+			failure: false,
+			logs:    false,
+			filters: map[string]interface{}{"org": "foo"},
+			want:    []*library.Build{_buildOne},
+		},
+		{
+			failure: false,
+			logs:    true,
+			filters: map[string]interface{}{"org": "foo"},
+			want:    []*library.Build{_buildOne, _buildTwo},
+		},
+		{
+			failure: false,
+			logs:    true,
+			filters: map[string]interface{}{"org": "bar"},
+			want:    []*library.Build{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, _, err := _database.SearchBuilds(context.TODO(), "fix", test.filters, test.logs, 1, 10)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("SearchBuilds should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("SearchBuilds returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("SearchBuilds is %v, want %v", got, test.want)
+		}
+	}
+}
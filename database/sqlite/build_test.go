@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -55,13 +56,13 @@ func TestSqlite_Client_GetBuild(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the build in the database
-			err := _database.CreateBuild(test.want)
+			err := _database.CreateBuild(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, err := _database.GetBuild(1, _repo)
+		got, err := _database.GetBuild(context.TODO(), 1, _repo)
 
 		// cleanup the builds table
 		_ = _database.Sqlite.Exec("DELETE FROM builds;")
@@ -119,13 +120,13 @@ func TestSqlite_Client_GetBuildByID(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the build in the database
-			err := _database.CreateBuild(test.want)
+			err := _database.CreateBuild(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, err := _database.GetBuildByID(1)
+		got, err := _database.GetBuildByID(context.TODO(), 1)
 
 		// cleanup the builds table
 		_ = _database.Sqlite.Exec("DELETE FROM builds;")
@@ -192,13 +193,13 @@ func TestSqlite_Client_GetLastBuild(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the build in the database
-			err := _database.CreateBuild(test.want)
+			err := _database.CreateBuild(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, err := _database.GetLastBuild(_repo)
+		got, err := _database.GetLastBuild(context.TODO(), _repo)
 
 		// cleanup the builds table
 		_ = _database.Sqlite.Exec("DELETE FROM builds;")
@@ -266,13 +267,13 @@ func TestSqlite_Client_GetLastBuildByBranch(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the build in the database
-			err := _database.CreateBuild(test.want)
+			err := _database.CreateBuild(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, err := _database.GetLastBuildByBranch(_repo, "master")
+		got, err := _database.GetLastBuildByBranch(context.TODO(), _repo, "master")
 
 		// cleanup the builds table
 		_ = _database.Sqlite.Exec("DELETE FROM builds;")
@@ -360,25 +361,25 @@ func TestSqlite_Client_GetPendingAndRunningBuilds(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		// create the repo in the database
-		err := _database.CreateRepo(_repo)
+		err := _database.CreateRepo(context.TODO(), _repo)
 		if err != nil {
 			t.Errorf("unable to create test repo: %v", err)
 		}
 
 		if len(test.want) > 0 {
 			// create the builds in the database
-			err = _database.CreateBuild(_buildOne)
+			err = _database.CreateBuild(context.TODO(), _buildOne)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 
-			err = _database.CreateBuild(_buildTwo)
+			err = _database.CreateBuild(context.TODO(), _buildTwo)
 			if err != nil {
 				t.Errorf("unable to create test build: %v", err)
 			}
 		}
 
-		got, err := _database.GetPendingAndRunningBuilds("0")
+		got, err := _database.GetPendingAndRunningBuilds(context.TODO(), "0")
 
 		// cleanup the repos table
 		_ = _database.Sqlite.Exec("DELETE FROM repos;")
@@ -441,7 +442,7 @@ func TestSqlite_Client_CreateBuild(t *testing.T) {
 		// defer cleanup of the builds table
 		defer _database.Sqlite.Exec("delete from builds;")
 
-		err := _database.CreateBuild(_build)
+		err := _database.CreateBuild(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -496,12 +497,12 @@ func TestSqlite_Client_UpdateBuild(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from builds;")
 
 		// create the build in the database
-		err = _database.CreateBuild(_build)
+		err = _database.CreateBuild(context.TODO(), _build)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		err := _database.UpdateBuild(_build)
+		err := _database.UpdateBuild(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -547,12 +548,12 @@ func TestSqlite_Client_DeleteBuild(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from builds;")
 
 		// create the build in the database
-		err = _database.CreateBuild(_build)
+		err = _database.CreateBuild(context.TODO(), _build)
 		if err != nil {
 			t.Errorf("unable to create test build: %v", err)
 		}
 
-		err = _database.DeleteBuild(1)
+		err = _database.DeleteBuild(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
@@ -0,0 +1,252 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestSqlite_Client_GetOrgActorUsage(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetSender("octocat")
+	_buildOne.SetCreated(10)
+	_buildOne.SetStarted(20)
+	_buildOne.SetFinished(80)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetSender("octocat")
+	_buildTwo.SetCreated(10)
+	_buildTwo.SetStarted(20)
+	_buildTwo.SetFinished(140)
+	_buildTwo.SetDeployPayload(nil)
+
+	_step := testStep()
+	_step.SetID(1)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+	_step.SetNumber(1)
+	_step.SetName("foo")
+	_step.SetImage("baz")
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_usage := new(api.ActorUsage)
+	_usage.SetActor("octocat")
+	_usage.SetBuilds(2)
+	_usage.SetSteps(1)
+	_usage.SetMinutes(3)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		org     string
+		want    []*api.ActorUsage
+	}{
+		{
+			failure: false,
+			org:     "foo",
+			want:    []*api.ActorUsage{_usage},
+		},
+		{
+			failure: false,
+			org:     "bar",
+			want:    []*api.ActorUsage{},
+		},
+	}
+
+	// defer cleanup of the repos, builds and steps tables
+	defer _database.Sqlite.Exec("delete from repos;")
+	defer _database.Sqlite.Exec("delete from builds;")
+	defer _database.Sqlite.Exec("delete from steps;")
+
+	// create the repo in the database
+	err = _database.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// create the builds in the database
+	err = _database.CreateBuild(context.TODO(), _buildOne)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	err = _database.CreateBuild(context.TODO(), _buildTwo)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	// create the step in the database
+	err = _database.CreateStep(context.TODO(), _step)
+	if err != nil {
+		t.Errorf("unable to create test step: %v", err)
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetOrgActorUsage(context.TODO(), test.org, 1, 100)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgActorUsage should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgActorUsage returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgActorUsage is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSqlite_Client_GetOrgHostUsage(t *testing.T) {
+	// setup types
+	_buildOne := testBuild()
+	_buildOne.SetID(1)
+	_buildOne.SetRepoID(1)
+	_buildOne.SetNumber(1)
+	_buildOne.SetHost("worker-1.example.com")
+	_buildOne.SetCreated(10)
+	_buildOne.SetStarted(20)
+	_buildOne.SetFinished(80)
+	_buildOne.SetDeployPayload(nil)
+
+	_buildTwo := testBuild()
+	_buildTwo.SetID(2)
+	_buildTwo.SetRepoID(1)
+	_buildTwo.SetNumber(2)
+	_buildTwo.SetHost("worker-1.example.com")
+	_buildTwo.SetCreated(10)
+	_buildTwo.SetStarted(20)
+	_buildTwo.SetFinished(140)
+	_buildTwo.SetDeployPayload(nil)
+
+	_buildThree := testBuild()
+	_buildThree.SetID(3)
+	_buildThree.SetRepoID(1)
+	_buildThree.SetNumber(3)
+	_buildThree.SetHost("worker-2.example.com")
+	_buildThree.SetCreated(10)
+	_buildThree.SetStarted(20)
+	_buildThree.SetFinished(50)
+	_buildThree.SetDeployPayload(nil)
+
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_usageOne := new(api.HostUsage)
+	_usageOne.SetHost("worker-1.example.com")
+	_usageOne.SetBuilds(2)
+	_usageOne.SetMinutes(3)
+
+	_usageTwo := new(api.HostUsage)
+	_usageTwo.SetHost("worker-2.example.com")
+	_usageTwo.SetBuilds(1)
+	_usageTwo.SetMinutes(0.5)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		org     string
+		want    []*api.HostUsage
+	}{
+		{
+			failure: false,
+			org:     "foo",
+			want:    []*api.HostUsage{_usageOne, _usageTwo},
+		},
+		{
+			failure: false,
+			org:     "bar",
+			want:    []*api.HostUsage{},
+		},
+	}
+
+	// defer cleanup of the repos and builds tables
+	defer _database.Sqlite.Exec("delete from repos;")
+	defer _database.Sqlite.Exec("delete from builds;")
+
+	// create the repo in the database
+	err = _database.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	// create the builds in the database
+	for _, build := range []*library.Build{_buildOne, _buildTwo, _buildThree} {
+		err = _database.CreateBuild(context.TODO(), build)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := _database.GetOrgHostUsage(context.TODO(), test.org, 1, 100)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgHostUsage should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgHostUsage returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgHostUsage is %v, want %v", got, test.want)
+		}
+	}
+}
@@ -5,13 +5,14 @@
 package dml
 
 const (
-	// ListSecrets represents a query to
-	// list all secrets in the database.
+	// ListSecrets represents a query to list
+	// all unarchived secrets in the database.
 	//
 	//nolint:gosec // ignore false positive
 	ListSecrets = `
 SELECT *
-FROM secrets;
+FROM secrets
+WHERE deleted_at IS NULL;
 `
 
 	// ListOrgSecrets represents a query to list all
@@ -23,6 +24,7 @@ SELECT *
 FROM secrets
 WHERE type = 'org'
 AND org = ?
+AND deleted_at IS NULL
 ORDER BY id DESC
 LIMIT ?
 OFFSET ?;
@@ -38,6 +40,7 @@ FROM secrets
 WHERE type = 'repo'
 AND org = ?
 AND repo = ?
+AND deleted_at IS NULL
 ORDER BY id DESC
 LIMIT ?
 OFFSET ?;
@@ -53,6 +56,7 @@ FROM secrets
 WHERE type = 'shared'
 AND org = ?
 AND team = ?
+AND deleted_at IS NULL
 ORDER BY id DESC
 LIMIT ?
 OFFSET ?;
@@ -66,7 +70,8 @@ OFFSET ?;
 SELECT count(*) as count
 FROM secrets
 WHERE type = 'org'
-AND org = ?;
+AND org = ?
+AND deleted_at IS NULL;
 `
 
 	// SelectRepoSecretsCount represents a query to select the
@@ -78,7 +83,8 @@ SELECT count(*) as count
 FROM secrets
 WHERE type = 'repo'
 AND org = ?
-AND repo = ?;
+AND repo = ?
+AND deleted_at IS NULL;
 `
 
 	// SelectSharedSecretsCount represents a query to select the
@@ -90,7 +96,8 @@ SELECT count(*) as count
 FROM secrets
 WHERE type = 'shared'
 AND org = ?
-AND team = ?;
+AND team = ?
+AND deleted_at IS NULL;
 `
 
 	// SelectOrgSecret represents a query to select a
@@ -103,6 +110,7 @@ FROM secrets
 WHERE type = 'org'
 AND org = ?
 AND name = ?
+AND deleted_at IS NULL
 LIMIT 1;
 `
 
@@ -117,6 +125,7 @@ WHERE type = 'repo'
 AND org = ?
 AND repo = ?
 AND name = ?
+AND deleted_at IS NULL
 LIMIT 1;
 `
 
@@ -131,6 +140,7 @@ WHERE type = 'shared'
 AND org = ?
 AND team = ?
 AND name = ?
+AND deleted_at IS NULL
 LIMIT 1;
 `
 
@@ -7,6 +7,9 @@ package sqlite
 import (
 	"fmt"
 	"time"
+
+	"github.com/go-vela/server/blob"
+	"github.com/go-vela/server/kms"
 )
 
 // ClientOpt represents a configuration option to initialize the database client for Sqlite.
@@ -29,6 +32,18 @@ func WithAddress(address string) ClientOpt {
 	}
 }
 
+// WithCompressionAlgorithm sets the compression algorithm in the database client for Sqlite.
+func WithCompressionAlgorithm(algorithm string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring compression algorithm in sqlite database client")
+
+		// set the compression algorithm in the sqlite client
+		c.config.CompressionAlgorithm = algorithm
+
+		return nil
+	}
+}
+
 // WithCompressionLevel sets the compression level in the database client for Sqlite.
 func WithCompressionLevel(level int) ClientOpt {
 	return func(c *client) error {
@@ -77,6 +92,42 @@ func WithConnectionOpen(open int) ClientOpt {
 	}
 }
 
+// WithStatementTimeout sets the maximum duration of each statement in the database client for Sqlite.
+func WithStatementTimeout(timeout time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring statement timeout in sqlite database client")
+
+		// set the statement timeout in the sqlite client
+		c.config.StatementTimeout = timeout
+
+		return nil
+	}
+}
+
+// WithSlowQueryThreshold sets the duration after which statements are logged as slow in the database client for Sqlite.
+func WithSlowQueryThreshold(threshold time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring slow query threshold in sqlite database client")
+
+		// set the slow query threshold in the sqlite client
+		c.config.SlowQueryThreshold = threshold
+
+		return nil
+	}
+}
+
+// WithReplicas sets the read replica addresses in the database client for Sqlite.
+func WithReplicas(replicas []string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring read replicas in sqlite database client")
+
+		// set the read replicas in the sqlite client
+		c.config.Replicas = replicas
+
+		return nil
+	}
+}
+
 // WithEncryptionKey sets the encryption key in the database client for Sqlite.
 func WithEncryptionKey(key string) ClientOpt {
 	return func(c *client) error {
@@ -94,6 +145,30 @@ func WithEncryptionKey(key string) ClientOpt {
 	}
 }
 
+// WithKMS sets the key management service in the database client for Sqlite.
+func WithKMS(service kms.Service) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring key management service in sqlite database client")
+
+		// set the key management service in the sqlite client
+		c.config.KMS = service
+
+		return nil
+	}
+}
+
+// WithBlob sets the blob storage service in the database client for Sqlite.
+func WithBlob(service blob.Service) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring blob storage service in sqlite database client")
+
+		// set the blob storage service in the sqlite client
+		c.config.Blob = service
+
+		return nil
+	}
+}
+
 // WithSkipCreation sets the skip creation logic in the database client for Sqlite.
 func WithSkipCreation(skipCreation bool) ClientOpt {
 	return func(c *client) error {
@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"testing"
 )
 
@@ -60,3 +61,56 @@ func TestSqlite_Client_Ping(t *testing.T) {
 		}
 	}
 }
+
+func TestSqlite_Client_PingContext(t *testing.T) {
+	// setup types
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() {
+		_sql, _ := _database.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_bad, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+	// close the bad database to simulate failures to ping
+	_sql, _ := _bad.Sqlite.DB()
+	_sql.Close()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		database *client
+	}{
+		{
+			failure:  false,
+			database: _database,
+		},
+		{
+			failure:  true,
+			database: _bad,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err = test.database.PingContext(context.TODO())
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("PingContext should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("PingContext returned err: %v", err)
+		}
+	}
+}
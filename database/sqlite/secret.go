@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -20,7 +21,7 @@ import (
 )
 
 // GetSecret gets a secret by type, org, name (repo or team) and secret name from the database.
-func (c *client) GetSecret(t, o, n, secretName string) (*library.Secret, error) {
+func (c *client) GetSecret(ctx context.Context, t, o, n, secretName string) (*library.Secret, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    o,
@@ -50,7 +51,7 @@ func (c *client) GetSecret(t, o, n, secretName string) (*library.Secret, error)
 	// send query to the database and store result in variable
 	switch t {
 	case constants.SecretOrg:
-		result := c.Sqlite.
+		result := c.Sqlite.WithContext(ctx).
 			Table(constants.TableSecret).
 			Raw(dml.SelectOrgSecret, o, secretName).
 			Scan(s)
@@ -62,7 +63,7 @@ func (c *client) GetSecret(t, o, n, secretName string) (*library.Secret, error)
 
 		err = result.Error
 	case constants.SecretRepo:
-		result := c.Sqlite.
+		result := c.Sqlite.WithContext(ctx).
 			Table(constants.TableSecret).
 			Raw(dml.SelectRepoSecret, o, n, secretName).
 			Scan(s)
@@ -74,7 +75,7 @@ func (c *client) GetSecret(t, o, n, secretName string) (*library.Secret, error)
 
 		err = result.Error
 	case constants.SecretShared:
-		result := c.Sqlite.
+		result := c.Sqlite.WithContext(ctx).
 			Table(constants.TableSecret).
 			Raw(dml.SelectSharedSecret, o, n, secretName).
 			Scan(s)
@@ -112,7 +113,7 @@ func (c *client) GetSecret(t, o, n, secretName string) (*library.Secret, error)
 // CreateSecret creates a new secret in the database.
 //
 //nolint:dupl // ignore similar code with update
-func (c *client) CreateSecret(s *library.Secret) error {
+func (c *client) CreateSecret(ctx context.Context, s *library.Secret) error {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    s.GetOrg(),
@@ -152,7 +153,7 @@ func (c *client) CreateSecret(s *library.Secret) error {
 	}
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableSecret).
 		Create(secret.Nullify()).Error
 }
@@ -160,7 +161,7 @@ func (c *client) CreateSecret(s *library.Secret) error {
 // UpdateSecret updates a secret in the database.
 //
 //nolint:dupl // ignore similar code with create
-func (c *client) UpdateSecret(s *library.Secret) error {
+func (c *client) UpdateSecret(ctx context.Context, s *library.Secret) error {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    s.GetOrg(),
@@ -200,17 +201,17 @@ func (c *client) UpdateSecret(s *library.Secret) error {
 	}
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableSecret).
 		Save(secret.Nullify()).Error
 }
 
 // DeleteSecret deletes a secret by unique ID from the database.
-func (c *client) DeleteSecret(id int64) error {
+func (c *client) DeleteSecret(ctx context.Context, id int64) error {
 	c.Logger.Tracef("Deleting secret %d from the database", id)
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableSecret).
 		Exec(dml.DeleteSecret, id).Error
 }
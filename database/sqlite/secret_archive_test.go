@@ -0,0 +1,220 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSqlite_Client_ArchiveSecret(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("repo")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the secrets table
+	defer _database.Sqlite.Exec("delete from secrets;")
+
+	// create the secret in the database
+	err = _database.CreateSecret(context.TODO(), _secret)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	// run test
+	err = _database.ArchiveSecret(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("ArchiveSecret returned err: %v", err)
+	}
+
+	// ensure the archived secret is excluded from the other queries
+	_, err = _database.GetSecret(context.TODO(), "repo", "foo", "bar", "baz")
+	if err == nil {
+		t.Errorf("GetSecret for archived secret should have returned err")
+	}
+
+	count, err := _database.GetTypeSecretCount(context.TODO(), "repo", "foo", "bar", nil)
+	if err != nil {
+		t.Errorf("GetTypeSecretCount returned err: %v", err)
+	}
+
+	if count != 0 {
+		t.Errorf("GetTypeSecretCount is %v, want 0", count)
+	}
+
+	// ensure the archived secret is returned without its value
+	got, err := _database.GetArchivedSecret(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("GetArchivedSecret returned err: %v", err)
+	}
+
+	if got.GetID() != 1 || len(got.GetValue()) > 0 {
+		t.Errorf("GetArchivedSecret is %v, want secret 1 without a value", got)
+	}
+
+	list, total, err := _database.ListArchivedSecrets(context.TODO(), 1, 10)
+	if err != nil {
+		t.Errorf("ListArchivedSecrets returned err: %v", err)
+	}
+
+	if total != 1 || len(list) != 1 || len(list[0].GetValue()) > 0 {
+		t.Errorf("ListArchivedSecrets is %v with total %d, want secret 1 without a value", list, total)
+	}
+}
+
+func TestSqlite_Client_RestoreSecret(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetTeam("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("shared")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the secrets table
+	defer _database.Sqlite.Exec("delete from secrets;")
+
+	// create the secret in the database
+	err = _database.CreateSecret(context.TODO(), _secret)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	err = _database.ArchiveSecret(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("unable to archive test secret: %v", err)
+	}
+
+	// run test
+	err = _database.RestoreSecret(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("RestoreSecret returned err: %v", err)
+	}
+
+	// ensure the restored secret is returned with its value
+	got, err := _database.GetSecret(context.TODO(), "shared", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("GetSecret returned err: %v", err)
+	}
+
+	if got.GetValue() != "foob" {
+		t.Errorf("GetSecret value is %s, want foob", got.GetValue())
+	}
+
+	_, err = _database.GetArchivedSecret(context.TODO(), "shared", "foo", "bar", "baz")
+	if err == nil {
+		t.Errorf("GetArchivedSecret for restored secret should have returned err")
+	}
+}
+
+func TestSqlite_Client_PurgeArchivedSecrets(t *testing.T) {
+	// setup types
+	_secretOne := testSecret()
+	_secretOne.SetID(1)
+	_secretOne.SetOrg("foo")
+	_secretOne.SetRepo("*")
+	_secretOne.SetName("bar")
+	_secretOne.SetValue("baz")
+	_secretOne.SetType("org")
+	_secretOne.SetCreatedAt(1)
+	_secretOne.SetUpdatedAt(1)
+
+	_secretTwo := testSecret()
+	_secretTwo.SetID(2)
+	_secretTwo.SetOrg("foo")
+	_secretTwo.SetRepo("*")
+	_secretTwo.SetName("baz")
+	_secretTwo.SetValue("bar")
+	_secretTwo.SetType("org")
+	_secretTwo.SetCreatedAt(1)
+	_secretTwo.SetUpdatedAt(1)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the secrets table
+	defer _database.Sqlite.Exec("delete from secrets;")
+
+	// create the secrets in the database
+	err = _database.CreateSecret(context.TODO(), _secretOne)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	err = _database.CreateSecret(context.TODO(), _secretTwo)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	err = _database.ArchiveSecret(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("unable to archive test secret: %v", err)
+	}
+
+	// run test
+	got, err := _database.PurgeArchivedSecrets(context.TODO(), time.Now().UTC().Add(time.Minute).Unix())
+	if err != nil {
+		t.Errorf("PurgeArchivedSecrets returned err: %v", err)
+	}
+
+	if got != 1 {
+		t.Errorf("PurgeArchivedSecrets is %v, want 1", got)
+	}
+
+	// ensure the secret that wasn't archived is kept
+	count, err := _database.GetTypeSecretCount(context.TODO(), "org", "foo", "*", nil)
+	if err != nil {
+		t.Errorf("GetTypeSecretCount returned err: %v", err)
+	}
+
+	if count != 1 {
+		t.Errorf("GetTypeSecretCount is %v, want 1", count)
+	}
+
+	_, total, err := _database.ListArchivedSecrets(context.TODO(), 1, 10)
+	if err != nil {
+		t.Errorf("ListArchivedSecrets returned err: %v", err)
+	}
+
+	if total != 0 {
+		t.Errorf("ListArchivedSecrets total is %v, want 0", total)
+	}
+}
@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -15,7 +16,7 @@ import (
 
 // GetTypeSecretCount gets a count of secrets by type,
 // owner, and name (repo or team) from the database.
-func (c *client) GetTypeSecretCount(t, o, n string, teams []string) (int64, error) {
+func (c *client) GetTypeSecretCount(ctx context.Context, t, o, n string, teams []string) (int64, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":  o,
@@ -43,12 +44,12 @@ func (c *client) GetTypeSecretCount(t, o, n string, teams []string) (int64, erro
 	// send query to the database and store result in variable
 	switch t {
 	case constants.SecretOrg:
-		err = c.Sqlite.
+		err = c.Sqlite.WithContext(ctx).
 			Table(constants.TableSecret).
 			Raw(dml.SelectOrgSecretsCount, o).
 			Pluck("count", &s).Error
 	case constants.SecretRepo:
-		err = c.Sqlite.
+		err = c.Sqlite.WithContext(ctx).
 			Table(constants.TableSecret).
 			Raw(dml.SelectRepoSecretsCount, o, n).
 			Pluck("count", &s).Error
@@ -60,14 +61,15 @@ func (c *client) GetTypeSecretCount(t, o, n string, teams []string) (int64, erro
 				lowerTeams = append(lowerTeams, strings.ToLower(t))
 			}
 
-			err = c.Sqlite.
+			err = c.Sqlite.WithContext(ctx).
 				Table(constants.TableSecret).
 				Select("count(*)").
 				Where("type = 'shared' AND org = ?", o).
 				Where("LOWER(team) IN (?)", lowerTeams).
+				Where("deleted_at IS NULL").
 				Pluck("count", &s).Error
 		} else {
-			err = c.Sqlite.
+			err = c.Sqlite.WithContext(ctx).
 				Table(constants.TableSecret).
 				Raw(dml.SelectSharedSecretsCount, o, n).
 				Pluck("count", &s).Error
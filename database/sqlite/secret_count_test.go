@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -78,17 +79,17 @@ func TestSqlite_Client_GetTypeSecretCount_Org(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from secrets;")
 
 		// create the secrets in the database
-		err := _database.CreateSecret(_secretOne)
+		err := _database.CreateSecret(context.TODO(), _secretOne)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		err = _database.CreateSecret(_secretTwo)
+		err = _database.CreateSecret(context.TODO(), _secretTwo)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		got, err := _database.GetTypeSecretCount("org", "foo", "*", []string{})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "org", "foo", "*", []string{})
 
 		if test.failure {
 			if err == nil {
@@ -159,17 +160,17 @@ func TestSqlite_Client_GetTypeSecretCount_Repo(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from secrets;")
 
 		// create the secrets in the database
-		err := _database.CreateSecret(_secretOne)
+		err := _database.CreateSecret(context.TODO(), _secretOne)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		err = _database.CreateSecret(_secretTwo)
+		err = _database.CreateSecret(context.TODO(), _secretTwo)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		got, err := _database.GetTypeSecretCount("repo", "foo", "bar", []string{})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "repo", "foo", "bar", []string{})
 
 		if test.failure {
 			if err == nil {
@@ -240,17 +241,17 @@ func TestSqlite_Client_GetTypeSecretCount_Shared(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from secrets;")
 
 		// create the secrets in the database
-		err := _database.CreateSecret(_secretOne)
+		err := _database.CreateSecret(context.TODO(), _secretOne)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		err = _database.CreateSecret(_secretTwo)
+		err = _database.CreateSecret(context.TODO(), _secretTwo)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		got, err := _database.GetTypeSecretCount("shared", "foo", "bar", []string{})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "shared", "foo", "bar", []string{})
 
 		if test.failure {
 			if err == nil {
@@ -321,17 +322,17 @@ func TestSqlite_Client_GetTypeSecretCount_Shared_Wildcard(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from secrets;")
 
 		// create the secrets in the database
-		err := _database.CreateSecret(_secretOne)
+		err := _database.CreateSecret(context.TODO(), _secretOne)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		err = _database.CreateSecret(_secretTwo)
+		err = _database.CreateSecret(context.TODO(), _secretTwo)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		got, err := _database.GetTypeSecretCount("shared", "foo", "*", []string{"bar", "bared"})
+		got, err := _database.GetTypeSecretCount(context.TODO(), "shared", "foo", "*", []string{"bar", "bared"})
 
 		if test.failure {
 			if err == nil {
@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -76,13 +77,13 @@ func TestSqlite_Client_GetSecretList(t *testing.T) {
 
 		for _, secret := range test.want {
 			// create the secret in the database
-			err := _database.CreateSecret(secret)
+			err := _database.CreateSecret(context.TODO(), secret)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetSecretList()
+		got, err := _database.GetSecretList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -150,13 +151,13 @@ func TestSqlite_Client_GetTypeSecretList_Org(t *testing.T) {
 
 		for _, secret := range test.want {
 			// create the secret in the database
-			err := _database.CreateSecret(secret)
+			err := _database.CreateSecret(context.TODO(), secret)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetTypeSecretList("org", "foo", "*", 1, 10, []string{})
+		got, err := _database.GetTypeSecretList(context.TODO(), "org", "foo", "*", 1, 10, []string{})
 
 		if test.failure {
 			if err == nil {
@@ -224,13 +225,13 @@ func TestSqlite_Client_GetTypeSecretList_Repo(t *testing.T) {
 
 		for _, secret := range test.want {
 			// create the secret in the database
-			err := _database.CreateSecret(secret)
+			err := _database.CreateSecret(context.TODO(), secret)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetTypeSecretList("repo", "foo", "bar", 1, 10, []string{})
+		got, err := _database.GetTypeSecretList(context.TODO(), "repo", "foo", "bar", 1, 10, []string{})
 
 		if test.failure {
 			if err == nil {
@@ -298,13 +299,13 @@ func TestSqlite_Client_GetTypeSecretList_Shared(t *testing.T) {
 
 		for _, secret := range test.want {
 			// create the secret in the database
-			err := _database.CreateSecret(secret)
+			err := _database.CreateSecret(context.TODO(), secret)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetTypeSecretList("shared", "foo", "bar", 1, 10, []string{})
+		got, err := _database.GetTypeSecretList(context.TODO(), "shared", "foo", "bar", 1, 10, []string{})
 
 		if test.failure {
 			if err == nil {
@@ -372,13 +373,13 @@ func TestSqlite_Client_GetTypeSecretList_Shared_wildcard(t *testing.T) {
 
 		for _, secret := range test.want {
 			// create the secret in the database
-			err := _database.CreateSecret(secret)
+			err := _database.CreateSecret(context.TODO(), secret)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetTypeSecretList("shared", "foo", "*", 1, 10, []string{"bar", "bared"})
+		got, err := _database.GetTypeSecretList(context.TODO(), "shared", "foo", "*", 1, 10, []string{"bar", "bared"})
 
 		if test.failure {
 			if err == nil {
@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestSqlite_Client_AuditSecretEncryption(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("*")
+	_secret.SetName("bar")
+	_secret.SetValue("baz")
+	_secret.SetType("org")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the secrets table
+	defer _database.Sqlite.Exec("delete from secrets;")
+
+	err = _database.CreateSecret(context.TODO(), _secret)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	// create a secret that can't be decrypted with the current key
+	err = _database.Sqlite.Exec("INSERT INTO secrets (id, org, repo, name, value, type) VALUES (2, 'foo', '*', 'baz', 'not encrypted', 'org');").Error
+	if err != nil {
+		t.Errorf("unable to create unencrypted test secret: %v", err)
+	}
+
+	// run test
+	got, err := _database.AuditSecretEncryption(context.TODO())
+	if err != nil {
+		t.Errorf("AuditSecretEncryption returned err: %v", err)
+	}
+
+	if got.GetTotal() != 2 || got.GetReadable() != 1 || got.GetUnreadable() != 1 {
+		t.Errorf("AuditSecretEncryption is %v", got)
+	}
+}
+
+func TestSqlite_Client_RekeySecrets(t *testing.T) {
+	// setup types
+	_secretOne := testSecret()
+	_secretOne.SetID(1)
+	_secretOne.SetOrg("foo")
+	_secretOne.SetRepo("*")
+	_secretOne.SetName("bar")
+	_secretOne.SetValue("baz")
+	_secretOne.SetType("org")
+	_secretOne.SetCreatedAt(1)
+	_secretOne.SetCreatedBy("user")
+	_secretOne.SetUpdatedAt(1)
+	_secretOne.SetUpdatedBy("user2")
+
+	_secretTwo := testSecret()
+	_secretTwo.SetID(2)
+	_secretTwo.SetOrg("foo")
+	_secretTwo.SetRepo("bar")
+	_secretTwo.SetName("baz")
+	_secretTwo.SetValue("foob")
+	_secretTwo.SetType("repo")
+	_secretTwo.SetCreatedAt(1)
+	_secretTwo.SetCreatedBy("user")
+	_secretTwo.SetUpdatedAt(1)
+	_secretTwo.SetUpdatedBy("user2")
+
+	key := "Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the secrets table
+	defer _database.Sqlite.Exec("delete from secrets;")
+
+	for _, s := range []*library.Secret{_secretOne, _secretTwo} {
+		err := _database.CreateSecret(context.TODO(), s)
+		if err != nil {
+			t.Errorf("unable to create test secret: %v", err)
+		}
+	}
+
+	// run test
+	got, err := _database.RekeySecrets(context.TODO(), key)
+	if err != nil {
+		t.Errorf("RekeySecrets returned err: %v", err)
+	}
+
+	if got != 2 {
+		t.Errorf("RekeySecrets is %v, want 2", got)
+	}
+
+	// verify the secrets can't be decrypted with the old key
+	audit, err := _database.AuditSecretEncryption(context.TODO())
+	if err != nil {
+		t.Errorf("AuditSecretEncryption returned err: %v", err)
+	}
+
+	if audit.GetUnreadable() != 2 {
+		t.Errorf("AuditSecretEncryption unreadable is %v, want 2", audit.GetUnreadable())
+	}
+
+	// verify the secrets can be decrypted with the new key
+	_database.config.EncryptionKey = key
+
+	secret, err := _database.GetSecret(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("GetSecret returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(secret, _secretTwo) {
+		t.Errorf("GetSecret is %v, want %v", secret, _secretTwo)
+	}
+}
@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -51,13 +52,13 @@ func TestSqlite_Client_GetSecret_Org(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the secret in the database
-			err := _database.CreateSecret(test.want)
+			err := _database.CreateSecret(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetSecret("org", "foo", "*", "bar")
+		got, err := _database.GetSecret(context.TODO(), "org", "foo", "*", "bar")
 
 		// cleanup the secrets table
 		_ = _database.Sqlite.Exec("DELETE FROM secrets;")
@@ -121,13 +122,13 @@ func TestSqlite_Client_GetSecret_Repo(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the secret in the database
-			err := _database.CreateSecret(test.want)
+			err := _database.CreateSecret(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetSecret("repo", "foo", "bar", "baz")
+		got, err := _database.GetSecret(context.TODO(), "repo", "foo", "bar", "baz")
 
 		// cleanup the secrets table
 		_ = _database.Sqlite.Exec("DELETE FROM secrets;")
@@ -191,13 +192,13 @@ func TestSqlite_Client_GetSecret_Shared(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the secret in the database
-			err := _database.CreateSecret(test.want)
+			err := _database.CreateSecret(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test secret: %v", err)
 			}
 		}
 
-		got, err := _database.GetSecret("shared", "foo", "bar", "baz")
+		got, err := _database.GetSecret(context.TODO(), "shared", "foo", "bar", "baz")
 
 		// cleanup the secrets table
 		_ = _database.Sqlite.Exec("DELETE FROM secrets;")
@@ -256,7 +257,7 @@ func TestSqlite_Client_CreateSecret(t *testing.T) {
 		// defer cleanup of the secrets table
 		defer _database.Sqlite.Exec("delete from secrets;")
 
-		err := _database.CreateSecret(_secret)
+		err := _database.CreateSecret(context.TODO(), _secret)
 
 		if test.failure {
 			if err == nil {
@@ -309,12 +310,12 @@ func TestSqlite_Client_UpdateSecret(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from secrets;")
 
 		// create the secret in the database
-		err := _database.CreateSecret(_secret)
+		err := _database.CreateSecret(context.TODO(), _secret)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		err = _database.UpdateSecret(_secret)
+		err = _database.UpdateSecret(context.TODO(), _secret)
 
 		if test.failure {
 			if err == nil {
@@ -367,12 +368,12 @@ func TestSqlite_Client_DeleteSecret(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from secrets;")
 
 		// create the secret in the database
-		err := _database.CreateSecret(_secret)
+		err := _database.CreateSecret(context.TODO(), _secret)
 		if err != nil {
 			t.Errorf("unable to create test secret: %v", err)
 		}
 
-		err = _database.DeleteSecret(1)
+		err = _database.DeleteSecret(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-vela/server/database/types"
+)
+
+func TestSqlite_Client_UpdateSecretIfVersion(t *testing.T) {
+	// setup types
+	_secret := testSecret()
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType("repo")
+	_secret.SetCreatedAt(1)
+	_secret.SetCreatedBy("user")
+	_secret.SetUpdatedAt(1)
+	_secret.SetUpdatedBy("user2")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// defer cleanup of the secrets table
+	defer _database.Sqlite.Exec("delete from secrets;")
+
+	// create the secret in the database
+	err = _database.CreateSecret(context.TODO(), _secret)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	// run test
+	version, err := _database.GetSecretVersion(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("GetSecretVersion returned err: %v", err)
+	}
+
+	if version != 0 {
+		t.Errorf("GetSecretVersion is %d, want 0", version)
+	}
+
+	_secret.SetValue("bazz")
+
+	got, err := _database.UpdateSecretIfVersion(context.TODO(), _secret, version)
+	if err != nil {
+		t.Errorf("UpdateSecretIfVersion returned err: %v", err)
+	}
+
+	if got != 1 {
+		t.Errorf("UpdateSecretIfVersion is %d, want 1", got)
+	}
+
+	secret, err := _database.GetSecret(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("GetSecret returned err: %v", err)
+	}
+
+	if secret.GetValue() != "bazz" {
+		t.Errorf("GetSecret value is %s, want bazz", secret.GetValue())
+	}
+
+	// the stale version is rejected after the update
+	_, err = _database.UpdateSecretIfVersion(context.TODO(), _secret, version)
+	if !errors.Is(err, types.ErrVersionConflict) {
+		t.Errorf("UpdateSecretIfVersion for stale version returned err %v, want %v", err, types.ErrVersionConflict)
+	}
+}
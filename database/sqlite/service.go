@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"errors"
 
 	"github.com/sirupsen/logrus"
@@ -18,7 +19,7 @@ import (
 )
 
 // GetService gets a service by number and build ID from the database.
-func (c *client) GetService(number int, b *library.Build) (*library.Service, error) {
+func (c *client) GetService(ctx context.Context, number int, b *library.Build) (*library.Service, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build":   b.GetNumber(),
 		"service": number,
@@ -28,7 +29,7 @@ func (c *client) GetService(number int, b *library.Build) (*library.Service, err
 	s := new(database.Service)
 
 	// send query to the database and store result in variable
-	result := c.Sqlite.
+	result := c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Raw(dml.SelectBuildService, b.GetID(), number).
 		Scan(s)
@@ -42,7 +43,7 @@ func (c *client) GetService(number int, b *library.Build) (*library.Service, err
 }
 
 // CreateService creates a new service in the database.
-func (c *client) CreateService(s *library.Service) error {
+func (c *client) CreateService(ctx context.Context, s *library.Service) error {
 	c.Logger.WithFields(logrus.Fields{
 		"service": s.GetNumber(),
 	}).Tracef("creating service %s in the database", s.GetName())
@@ -57,13 +58,56 @@ func (c *client) CreateService(s *library.Service) error {
 	}
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Create(service).Error
 }
 
+// CreateServices creates a list of new services in the database
+// with a single query. The IDs from the created services
+// are populated in the provided services.
+func (c *client) CreateServices(ctx context.Context, s []*library.Service) error {
+	c.Logger.Tracef("creating %d services in the database", len(s))
+
+	// short-circuit if there are no services to create
+	if len(s) == 0 {
+		return nil
+	}
+
+	// variable to store the services to create
+	services := make([]*database.Service, 0, len(s))
+
+	for _, service := range s {
+		// cast to database type
+		tmp := database.ServiceFromLibrary(service)
+
+		// validate the necessary fields are populated
+		err := tmp.Validate()
+		if err != nil {
+			return err
+		}
+
+		services = append(services, tmp)
+	}
+
+	// send query to the database
+	err := c.Sqlite.WithContext(ctx).
+		Table(constants.TableService).
+		Create(&services).Error
+	if err != nil {
+		return err
+	}
+
+	// populate the IDs from the created services
+	for i, service := range services {
+		s[i].SetID(service.ID.Int64)
+	}
+
+	return nil
+}
+
 // UpdateService updates a service in the database.
-func (c *client) UpdateService(s *library.Service) error {
+func (c *client) UpdateService(ctx context.Context, s *library.Service) error {
 	c.Logger.WithFields(logrus.Fields{
 		"service": s.GetNumber(),
 	}).Tracef("updating service %s in the database", s.GetName())
@@ -78,17 +122,17 @@ func (c *client) UpdateService(s *library.Service) error {
 	}
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Save(service).Error
 }
 
 // DeleteService deletes a service by unique ID from the database.
-func (c *client) DeleteService(id int64) error {
+func (c *client) DeleteService(ctx context.Context, id int64) error {
 	c.Logger.Tracef("deleting service %d from the database", id)
 
 	// send query to the database
-	return c.Sqlite.
+	return c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Exec(dml.DeleteService, id).Error
 }
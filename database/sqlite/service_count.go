@@ -5,6 +5,8 @@
 package sqlite
 
 import (
+	"context"
+
 	"github.com/go-vela/server/database/sqlite/dml"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // GetBuildServiceCount gets a count of all services by build ID from the database.
-func (c *client) GetBuildServiceCount(b *library.Build) (int64, error) {
+func (c *client) GetBuildServiceCount(ctx context.Context, b *library.Build) (int64, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build": b.GetNumber(),
 	}).Tracef("getting count of services for build %d from the database", b.GetNumber())
@@ -21,7 +23,7 @@ func (c *client) GetBuildServiceCount(b *library.Build) (int64, error) {
 	var s int64
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Raw(dml.SelectBuildServicesCount, b.GetID()).
 		Pluck("count", &s).Error
@@ -31,7 +33,7 @@ func (c *client) GetBuildServiceCount(b *library.Build) (int64, error) {
 
 // GetServiceImageCount gets a count of all service images
 // and the count of their occurrence in the database.
-func (c *client) GetServiceImageCount() (map[string]float64, error) {
+func (c *client) GetServiceImageCount(ctx context.Context) (map[string]float64, error) {
 	c.Logger.Tracef("getting count of all images for services from the database")
 
 	type imageCount struct {
@@ -44,7 +46,7 @@ func (c *client) GetServiceImageCount() (map[string]float64, error) {
 	counts := make(map[string]float64)
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Raw(dml.SelectServiceImagesCount).
 		Scan(images).Error
@@ -58,7 +60,7 @@ func (c *client) GetServiceImageCount() (map[string]float64, error) {
 
 // GetServiceStatusCount gets a list of all service statuses
 // and the count of their occurrence in the database.
-func (c *client) GetServiceStatusCount() (map[string]float64, error) {
+func (c *client) GetServiceStatusCount(ctx context.Context) (map[string]float64, error) {
 	c.Logger.Trace("getting count of all statuses for services from the database")
 
 	type statusCount struct {
@@ -77,7 +79,7 @@ func (c *client) GetServiceStatusCount() (map[string]float64, error) {
 	}
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Raw(dml.SelectServiceStatusesCount).
 		Scan(s).Error
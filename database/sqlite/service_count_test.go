@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -75,17 +76,17 @@ func TestSqlite_Client_GetBuildServiceCount(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from services;")
 
 		// create the services in the database
-		err := _database.CreateService(_serviceOne)
+		err := _database.CreateService(context.TODO(), _serviceOne)
 		if err != nil {
 			t.Errorf("unable to create test service: %v", err)
 		}
 
-		err = _database.CreateService(_serviceTwo)
+		err = _database.CreateService(context.TODO(), _serviceTwo)
 		if err != nil {
 			t.Errorf("unable to create test service: %v", err)
 		}
 
-		got, err := _database.GetBuildServiceCount(_build)
+		got, err := _database.GetBuildServiceCount(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -128,7 +129,7 @@ func TestSqlite_Client_GetServiceImageCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetServiceImageCount()
+		got, err := _database.GetServiceImageCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -177,7 +178,7 @@ func TestSqlite_Client_GetServiceStatusCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetServiceStatusCount()
+		got, err := _database.GetServiceStatusCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
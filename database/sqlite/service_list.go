@@ -5,6 +5,8 @@
 package sqlite
 
 import (
+	"context"
+
 	"github.com/go-vela/server/database/sqlite/dml"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
@@ -13,14 +15,14 @@ import (
 )
 
 // GetServiceList gets a list of all services from the database.
-func (c *client) GetServiceList() ([]*library.Service, error) {
+func (c *client) GetServiceList(ctx context.Context) ([]*library.Service, error) {
 	c.Logger.Trace("listing services from the database")
 
 	// variable to store query results
 	s := new([]database.Service)
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Raw(dml.ListServices).
 		Scan(s).Error
@@ -40,7 +42,7 @@ func (c *client) GetServiceList() ([]*library.Service, error) {
 }
 
 // GetBuildServiceList gets a list of services by build ID from the database.
-func (c *client) GetBuildServiceList(b *library.Build, page, perPage int) ([]*library.Service, error) {
+func (c *client) GetBuildServiceList(ctx context.Context, b *library.Build, page, perPage int) ([]*library.Service, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build": b.GetNumber(),
 	}).Tracef("listing services for build %d from the database", b.GetNumber())
@@ -51,7 +53,7 @@ func (c *client) GetBuildServiceList(b *library.Build, page, perPage int) ([]*li
 	offset := perPage * (page - 1)
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableService).
 		Raw(dml.ListBuildServices, b.GetID(), perPage, offset).
 		Scan(s).Error
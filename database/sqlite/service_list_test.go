@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -72,13 +73,13 @@ func TestSqlite_Client_GetServiceList(t *testing.T) {
 
 		for _, service := range test.want {
 			// create the service in the database
-			err := _database.CreateService(service)
+			err := _database.CreateService(context.TODO(), service)
 			if err != nil {
 				t.Errorf("unable to create test service: %v", err)
 			}
 		}
 
-		got, err := _database.GetServiceList()
+		got, err := _database.GetServiceList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -147,13 +148,13 @@ func TestSqlite_Client_GetBuildServiceList(t *testing.T) {
 
 		for _, service := range test.want {
 			// create the service in the database
-			err := _database.CreateService(service)
+			err := _database.CreateService(context.TODO(), service)
 			if err != nil {
 				t.Errorf("unable to create test service: %v", err)
 			}
 		}
 
-		got, err := _database.GetBuildServiceList(_build, 1, 10)
+		got, err := _database.GetBuildServiceList(context.TODO(), _build, 1, 10)
 
 		if test.failure {
 			if err == nil {
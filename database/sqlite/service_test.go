@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -53,13 +54,13 @@ func TestSqlite_Client_GetService(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the service in the database
-			err := _database.CreateService(test.want)
+			err := _database.CreateService(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test service: %v", err)
 			}
 		}
 
-		got, err := _database.GetService(1, _build)
+		got, err := _database.GetService(context.TODO(), 1, _build)
 
 		// cleanup the services table
 		_ = _database.Sqlite.Exec("DELETE FROM services;")
@@ -114,7 +115,7 @@ func TestSqlite_Client_CreateService(t *testing.T) {
 		// defer cleanup of the services table
 		defer _database.Sqlite.Exec("delete from services;")
 
-		err := _database.CreateService(_service)
+		err := _database.CreateService(context.TODO(), _service)
 
 		if test.failure {
 			if err == nil {
@@ -130,6 +131,74 @@ func TestSqlite_Client_CreateService(t *testing.T) {
 	}
 }
 
+func TestSqlite_Client_CreateServices(t *testing.T) {
+	// setup types
+	_one := testService()
+	_one.SetID(1)
+	_one.SetRepoID(1)
+	_one.SetBuildID(1)
+	_one.SetNumber(1)
+	_one.SetName("foo")
+	_one.SetImage("bar")
+
+	_two := testService()
+	_two.SetID(2)
+	_two.SetRepoID(1)
+	_two.SetBuildID(1)
+	_two.SetNumber(2)
+	_two.SetName("baz")
+	_two.SetImage("bar")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		services []*library.Service
+	}{
+		{
+			failure:  false,
+			services: []*library.Service{_one, _two},
+		},
+		{
+			failure:  false,
+			services: []*library.Service{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the services table
+		defer _database.Sqlite.Exec("delete from services;")
+
+		err := _database.CreateServices(context.TODO(), test.services)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CreateServices should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CreateServices returned err: %v", err)
+		}
+
+		for i, service := range test.services {
+			if service.GetID() != int64(i+1) {
+				t.Errorf("CreateServices ID is %d, want %d", service.GetID(), i+1)
+			}
+		}
+	}
+}
+
 func TestSqlite_Client_UpdateService(t *testing.T) {
 	// setup types
 	_service := testService()
@@ -163,12 +232,12 @@ func TestSqlite_Client_UpdateService(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from services;")
 
 		// create the service in the database
-		err := _database.CreateService(_service)
+		err := _database.CreateService(context.TODO(), _service)
 		if err != nil {
 			t.Errorf("unable to create test service: %v", err)
 		}
 
-		err = _database.UpdateService(_service)
+		err = _database.UpdateService(context.TODO(), _service)
 
 		if test.failure {
 			if err == nil {
@@ -217,12 +286,12 @@ func TestSqlite_Client_DeleteService(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from services;")
 
 		// create the service in the database
-		err := _database.CreateService(_service)
+		err := _database.CreateService(context.TODO(), _service)
 		if err != nil {
 			t.Errorf("unable to create test service: %v", err)
 		}
 
-		err = _database.DeleteService(1)
+		err = _database.DeleteService(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
@@ -203,7 +203,7 @@ func TestSqlite_createServices(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		err := createServices(_database)
+		err := createServices(_database, false)
 
 		if test.failure {
 			if err == nil {
@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -75,17 +76,17 @@ func TestSqlite_Client_GetBuildStepCount(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from steps;")
 
 		// create the steps in the database
-		err := _database.CreateStep(_stepOne)
+		err := _database.CreateStep(context.TODO(), _stepOne)
 		if err != nil {
 			t.Errorf("unable to create test step: %v", err)
 		}
 
-		err = _database.CreateStep(_stepTwo)
+		err = _database.CreateStep(context.TODO(), _stepTwo)
 		if err != nil {
 			t.Errorf("unable to create test step: %v", err)
 		}
 
-		got, err := _database.GetBuildStepCount(_build)
+		got, err := _database.GetBuildStepCount(context.TODO(), _build)
 
 		if test.failure {
 			if err == nil {
@@ -128,7 +129,7 @@ func TestSqlite_Client_GetStepImageCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetStepImageCount()
+		got, err := _database.GetStepImageCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -177,7 +178,7 @@ func TestSqlite_Client_GetStepStatusCount(t *testing.T) {
 
 	// run tests
 	for _, test := range tests {
-		got, err := _database.GetStepStatusCount()
+		got, err := _database.GetStepStatusCount(context.TODO())
 
 		if test.failure {
 			if err == nil {
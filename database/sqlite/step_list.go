@@ -5,6 +5,8 @@
 package sqlite
 
 import (
+	"context"
+
 	"github.com/go-vela/server/database/sqlite/dml"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
@@ -13,14 +15,14 @@ import (
 )
 
 // GetStepList gets a list of all steps from the database.
-func (c *client) GetStepList() ([]*library.Step, error) {
+func (c *client) GetStepList(ctx context.Context) ([]*library.Step, error) {
 	c.Logger.Trace("listing steps from the database")
 
 	// variable to store query results
 	s := new([]database.Step)
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableStep).
 		Raw(dml.ListSteps).
 		Scan(s).Error
@@ -40,7 +42,7 @@ func (c *client) GetStepList() ([]*library.Step, error) {
 }
 
 // GetBuildStepList gets a list of steps by build ID from the database.
-func (c *client) GetBuildStepList(b *library.Build, page, perPage int) ([]*library.Step, error) {
+func (c *client) GetBuildStepList(ctx context.Context, b *library.Build, page, perPage int) ([]*library.Step, error) {
 	c.Logger.WithFields(logrus.Fields{
 		"build": b.GetNumber(),
 	}).Tracef("listing steps for build %d from the database", b.GetNumber())
@@ -51,7 +53,7 @@ func (c *client) GetBuildStepList(b *library.Build, page, perPage int) ([]*libra
 	offset := perPage * (page - 1)
 
 	// send query to the database and store result in variable
-	err := c.Sqlite.
+	err := c.Sqlite.WithContext(ctx).
 		Table(constants.TableStep).
 		Raw(dml.ListBuildSteps, b.GetID(), perPage, offset).
 		Scan(s).Error
@@ -69,3 +71,33 @@ func (c *client) GetBuildStepList(b *library.Build, page, perPage int) ([]*libra
 
 	return steps, err
 }
+
+// GetFinishedStepListBetween gets a list of all steps finished
+// after and at or before the provided times from the database.
+func (c *client) GetFinishedStepListBetween(ctx context.Context, after, before int64) ([]*library.Step, error) {
+	c.Logger.Tracef("listing steps finished between %d and %d from the database", after, before)
+
+	// variable to store query results
+	s := new([]database.Step)
+
+	// send query to the database and store result in variable
+	err := c.Sqlite.WithContext(ctx).
+		Table(constants.TableStep).
+		Where("finished > ? AND finished <= ?", after, before).
+		Order("finished").
+		Order("id").
+		Find(s).Error
+
+	// variable we want to return
+	steps := []*library.Step{}
+	// iterate through all query results
+	for _, step := range *s {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := step
+
+		// convert query result to library type
+		steps = append(steps, tmp.ToLibrary())
+	}
+
+	return steps, err
+}
@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"testing"
@@ -72,13 +73,13 @@ func TestSqlite_Client_GetStepList(t *testing.T) {
 
 		for _, step := range test.want {
 			// create the step in the database
-			err := _database.CreateStep(step)
+			err := _database.CreateStep(context.TODO(), step)
 			if err != nil {
 				t.Errorf("unable to create test step: %v", err)
 			}
 		}
 
-		got, err := _database.GetStepList()
+		got, err := _database.GetStepList(context.TODO())
 
 		if test.failure {
 			if err == nil {
@@ -147,13 +148,13 @@ func TestSqlite_Client_GetBuildStepList(t *testing.T) {
 
 		for _, step := range test.want {
 			// create the step in the database
-			err := _database.CreateStep(step)
+			err := _database.CreateStep(context.TODO(), step)
 			if err != nil {
 				t.Errorf("unable to create test step: %v", err)
 			}
 		}
 
-		got, err := _database.GetBuildStepList(_build, 1, 10)
+		got, err := _database.GetBuildStepList(context.TODO(), _build, 1, 10)
 
 		if test.failure {
 			if err == nil {
@@ -172,3 +173,84 @@ func TestSqlite_Client_GetBuildStepList(t *testing.T) {
 		}
 	}
 }
+
+func TestSqlite_Client_GetFinishedStepListBetween(t *testing.T) {
+	// setup types
+	_stepOne := testStep()
+	_stepOne.SetID(1)
+	_stepOne.SetRepoID(1)
+	_stepOne.SetBuildID(1)
+	_stepOne.SetNumber(1)
+	_stepOne.SetName("foo")
+	_stepOne.SetImage("bar")
+	_stepOne.SetFinished(3)
+
+	_stepTwo := testStep()
+	_stepTwo.SetID(2)
+	_stepTwo.SetRepoID(1)
+	_stepTwo.SetBuildID(1)
+	_stepTwo.SetNumber(2)
+	_stepTwo.SetName("bar")
+	_stepTwo.SetImage("foo")
+	_stepTwo.SetFinished(2)
+
+	_stepThree := testStep()
+	_stepThree.SetID(3)
+	_stepThree.SetRepoID(1)
+	_stepThree.SetBuildID(1)
+	_stepThree.SetNumber(3)
+	_stepThree.SetName("baz")
+	_stepThree.SetImage("foo")
+	_stepThree.SetFinished(5)
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		want    []*library.Step
+	}{
+		{
+			failure: false,
+			want:    []*library.Step{_stepTwo, _stepOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the steps table
+		defer _database.Sqlite.Exec("delete from steps;")
+
+		for _, step := range []*library.Step{_stepOne, _stepTwo, _stepThree} {
+			// create the step in the database
+			err := _database.CreateStep(context.TODO(), step)
+			if err != nil {
+				t.Errorf("unable to create test step: %v", err)
+			}
+		}
+
+		got, err := _database.GetFinishedStepListBetween(context.TODO(), 1, 3)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetFinishedStepListBetween should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetFinishedStepListBetween returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetFinishedStepListBetween is %v, want %v", got, test.want)
+		}
+	}
+}
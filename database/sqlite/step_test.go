@@ -5,6 +5,7 @@
 package sqlite
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -53,13 +54,13 @@ func TestSqlite_Client_GetStep(t *testing.T) {
 	for _, test := range tests {
 		if test.want != nil {
 			// create the step in the database
-			err := _database.CreateStep(test.want)
+			err := _database.CreateStep(context.TODO(), test.want)
 			if err != nil {
 				t.Errorf("unable to create test step: %v", err)
 			}
 		}
 
-		got, err := _database.GetStep(1, _build)
+		got, err := _database.GetStep(context.TODO(), 1, _build)
 
 		// cleanup the steps table
 		_ = _database.Sqlite.Exec("DELETE FROM steps;")
@@ -114,7 +115,7 @@ func TestSqlite_Client_CreateStep(t *testing.T) {
 		// defer cleanup of the steps table
 		defer _database.Sqlite.Exec("delete from steps;")
 
-		err := _database.CreateStep(_step)
+		err := _database.CreateStep(context.TODO(), _step)
 
 		if test.failure {
 			if err == nil {
@@ -130,6 +131,74 @@ func TestSqlite_Client_CreateStep(t *testing.T) {
 	}
 }
 
+func TestSqlite_Client_CreateSteps(t *testing.T) {
+	// setup types
+	_one := testStep()
+	_one.SetID(1)
+	_one.SetRepoID(1)
+	_one.SetBuildID(1)
+	_one.SetNumber(1)
+	_one.SetName("foo")
+	_one.SetImage("bar")
+
+	_two := testStep()
+	_two.SetID(2)
+	_two.SetRepoID(1)
+	_two.SetBuildID(1)
+	_two.SetNumber(2)
+	_two.SetName("baz")
+	_two.SetImage("bar")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		steps   []*library.Step
+	}{
+		{
+			failure: false,
+			steps:   []*library.Step{_one, _two},
+		},
+		{
+			failure: false,
+			steps:   []*library.Step{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// defer cleanup of the steps table
+		defer _database.Sqlite.Exec("delete from steps;")
+
+		err := _database.CreateSteps(context.TODO(), test.steps)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("CreateSteps should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("CreateSteps returned err: %v", err)
+		}
+
+		for i, step := range test.steps {
+			if step.GetID() != int64(i+1) {
+				t.Errorf("CreateSteps ID is %d, want %d", step.GetID(), i+1)
+			}
+		}
+	}
+}
+
 func TestSqlite_Client_UpdateStep(t *testing.T) {
 	// setup types
 	_step := testStep()
@@ -163,12 +232,12 @@ func TestSqlite_Client_UpdateStep(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from steps;")
 
 		// create the step in the database
-		err := _database.CreateStep(_step)
+		err := _database.CreateStep(context.TODO(), _step)
 		if err != nil {
 			t.Errorf("unable to create test step: %v", err)
 		}
 
-		err = _database.UpdateStep(_step)
+		err = _database.UpdateStep(context.TODO(), _step)
 
 		if test.failure {
 			if err == nil {
@@ -217,12 +286,12 @@ func TestSqlite_Client_DeleteStep(t *testing.T) {
 		defer _database.Sqlite.Exec("delete from steps;")
 
 		// create the step in the database
-		err := _database.CreateStep(_step)
+		err := _database.CreateStep(context.TODO(), _step)
 		if err != nil {
 			t.Errorf("unable to create test step: %v", err)
 		}
 
-		err = _database.DeleteStep(1)
+		err = _database.DeleteStep(context.TODO(), 1)
 
 		if test.failure {
 			if err == nil {
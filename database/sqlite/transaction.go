@@ -0,0 +1,52 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Transaction runs the provided function with a copy of the client bound
+// to a single Sqlite transaction. The transaction is committed when the function
+// returns nil and rolled back when the function returns an error or panics.
+func (c *client) Transaction(ctx context.Context, fn func(interface{}) error) error {
+	c.Logger.Trace("running function in a sqlite transaction")
+
+	return c.Sqlite.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// create a copy of the client bound to the transaction
+		_client, err := c.bind(tx, true)
+		if err != nil {
+			return err
+		}
+
+		return fn(_client)
+	})
+}
+
+// bind is a helper function to create a copy of the client with
+// the services bound to the provided database client, like a
+// transaction. The services create their tables and indexes
+// unless skipped.
+func (c *client) bind(db *gorm.DB, skipCreation bool) (*client, error) {
+	// the schema_migrations table already exists for the client
+	_config := *c.config
+	_config.SkipCreation = true
+
+	_client := &client{
+		config: &_config,
+		Sqlite: db,
+		Logger: c.Logger,
+	}
+
+	// create the services for the client bound to the database client
+	err := createServices(_client, skipCreation)
+	if err != nil {
+		return nil, err
+	}
+
+	return _client, nil
+}
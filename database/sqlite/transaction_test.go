@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSqlite_Client_Transaction(t *testing.T) {
+	// setup types
+	_repo := testRepo()
+	_repo.SetID(1)
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+
+	// setup the test database client
+	_database, err := NewTest()
+	if err != nil {
+		t.Errorf("unable to create new sqlite test database: %v", err)
+	}
+
+	defer func() { _sql, _ := _database.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		name    string
+		number  int
+		err     error
+		failure bool
+	}{
+		{
+			name:   "committed",
+			number: 1,
+		},
+		{
+			name:    "rolled back",
+			number:  2,
+			err:     errors.New("unable to create steps"),
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_build := testBuild()
+			_build.SetRepoID(1)
+			_build.SetNumber(test.number)
+
+			err := _database.Transaction(context.TODO(), func(tx interface{}) error {
+				err := tx.(*client).CreateBuild(context.TODO(), _build)
+				if err != nil {
+					return err
+				}
+
+				return test.err
+			})
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Transaction should have returned err")
+				}
+			} else if err != nil {
+				t.Errorf("Transaction returned err: %v", err)
+			}
+
+			_, err = _database.GetBuild(context.TODO(), test.number, _repo)
+
+			if test.failure && err == nil {
+				t.Errorf("Transaction should have rolled back build %d", test.number)
+			}
+
+			if !test.failure && err != nil {
+				t.Errorf("Transaction should have committed build %d: %v", test.number, err)
+			}
+		})
+	}
+}
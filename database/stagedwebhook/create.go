@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateStagedWebhook creates a new staged webhook in the database.
+func (e *engine) CreateStagedWebhook(ctx context.Context, w *api.StagedWebhook) error {
+	e.logger.WithFields(logrus.Fields{
+		"source": w.GetSource(),
+	}).Tracef("creating staged webhook %d in the database", w.GetID())
+
+	// cast the API type to database type
+	webhook := types.StagedWebhookFromAPI(w)
+
+	// validate the necessary fields are populated
+	err := webhook.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableStagedWebhook).
+		Create(webhook).
+		Error
+}
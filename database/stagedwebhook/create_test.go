@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStagedWebhook_Engine_CreateStagedWebhook(t *testing.T) {
+	// setup types
+	_webhook := testStagedWebhook()
+	_webhook.SetID(1)
+	_webhook.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_webhook.SetHeader(`{"X-Github-Event":["push"]}`)
+	_webhook.SetPayload(`{"ref":"refs/heads/main"}`)
+	_webhook.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "staged_webhooks"
+("source","header","payload","created","id")
+VALUES ($1,$2,$3,$4,$5) RETURNING "id"`).
+		WithArgs("c8da1302-07d6-11ea-882f-4893bca275b8", `{"X-Github-Event":["push"]}`, `{"ref":"refs/heads/main"}`, 1563474076, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateStagedWebhook(context.TODO(), _webhook)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateStagedWebhook for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateStagedWebhook for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
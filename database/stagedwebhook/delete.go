@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteStagedWebhook deletes an existing staged webhook from the database.
+func (e *engine) DeleteStagedWebhook(ctx context.Context, w *api.StagedWebhook) error {
+	e.logger.WithFields(logrus.Fields{
+		"source": w.GetSource(),
+	}).Tracef("deleting staged webhook %d from the database", w.GetID())
+
+	// cast the API type to database type
+	webhook := types.StagedWebhookFromAPI(w)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableStagedWebhook).
+		Delete(webhook).
+		Error
+}
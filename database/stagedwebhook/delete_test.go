@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStagedWebhook_Engine_DeleteStagedWebhook(t *testing.T) {
+	// setup types
+	_webhook := testStagedWebhook()
+	_webhook.SetID(1)
+	_webhook.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_webhook.SetHeader(`{"X-Github-Event":["push"]}`)
+	_webhook.SetPayload(`{"ref":"refs/heads/main"}`)
+	_webhook.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "staged_webhooks" WHERE "staged_webhooks"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateStagedWebhook(context.TODO(), _webhook)
+	if err != nil {
+		t.Errorf("unable to create test staged webhook for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.DeleteStagedWebhook(context.TODO(), _webhook)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteStagedWebhook for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteStagedWebhook for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListStagedWebhooks gets a list of all staged webhooks from the database.
+func (e *engine) ListStagedWebhooks(ctx context.Context) ([]*api.StagedWebhook, error) {
+	e.logger.Trace("listing all staged webhooks from the database")
+
+	// variables to store query results and return value
+	w := new([]types.StagedWebhook)
+	webhooks := []*api.StagedWebhook{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableStagedWebhook).
+		Order("created").
+		Order("id").
+		Find(&w).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, webhook := range *w {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := webhook
+
+		// convert query result to API type
+		webhooks = append(webhooks, tmp.ToAPI())
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestStagedWebhook_Engine_ListStagedWebhooks(t *testing.T) {
+	// setup types
+	_webhookOne := testStagedWebhook()
+	_webhookOne.SetID(1)
+	_webhookOne.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_webhookOne.SetHeader(`{"X-Github-Event":["push"]}`)
+	_webhookOne.SetPayload(`{"ref":"refs/heads/main"}`)
+	_webhookOne.SetCreated(1563474076)
+
+	_webhookTwo := testStagedWebhook()
+	_webhookTwo.SetID(2)
+	_webhookTwo.SetSource("d8da1302-07d6-11ea-882f-4893bca275b8")
+	_webhookTwo.SetHeader(`{"X-Github-Event":["push"]}`)
+	_webhookTwo.SetPayload(`{"ref":"refs/heads/main"}`)
+	_webhookTwo.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "source", "header", "payload", "created"}).
+		AddRow(1, "c8da1302-07d6-11ea-882f-4893bca275b8", `{"X-Github-Event":["push"]}`, `{"ref":"refs/heads/main"}`, 1563474076).
+		AddRow(2, "d8da1302-07d6-11ea-882f-4893bca275b8", `{"X-Github-Event":["push"]}`, `{"ref":"refs/heads/main"}`, 1563474076)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "staged_webhooks" ORDER BY created,id`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateStagedWebhook(context.TODO(), _webhookOne)
+	if err != nil {
+		t.Errorf("unable to create test staged webhook for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateStagedWebhook(context.TODO(), _webhookTwo)
+	if err != nil {
+		t.Errorf("unable to create test staged webhook for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.StagedWebhook
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.StagedWebhook{_webhookOne, _webhookTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.StagedWebhook{_webhookOne, _webhookTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListStagedWebhooks(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListStagedWebhooks for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListStagedWebhooks for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListStagedWebhooks for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// StagedWebhookService represents the Vela interface for staged webhook
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type StagedWebhookService interface {
+	// StagedWebhook Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateStagedWebhookIndexes defines a function that creates the indexes for the staged_webhooks table.
+	CreateStagedWebhookIndexes(context.Context) error
+	// CreateStagedWebhookTable defines a function that creates the staged_webhooks table.
+	CreateStagedWebhookTable(context.Context, string) error
+
+	// StagedWebhook Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateStagedWebhook defines a function that creates a new staged webhook.
+	CreateStagedWebhook(context.Context, *api.StagedWebhook) error
+	// DeleteStagedWebhook defines a function that deletes an existing staged webhook.
+	DeleteStagedWebhook(context.Context, *api.StagedWebhook) error
+	// ListStagedWebhooks defines a function that gets a list of all staged webhooks.
+	ListStagedWebhooks(context.Context) ([]*api.StagedWebhook, error)
+}
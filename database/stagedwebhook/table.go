@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stagedwebhook
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableStagedWebhook represents the name of the table for staged webhooks.
+	TableStagedWebhook = "staged_webhooks"
+
+	// CreatePostgresTable represents a query to create the Postgres staged_webhooks table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+staged_webhooks (
+	id            SERIAL PRIMARY KEY,
+	source        VARCHAR(250),
+	header        TEXT,
+	payload       TEXT,
+	created       INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite staged_webhooks table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+staged_webhooks (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	source        TEXT,
+	header        TEXT,
+	payload       TEXT,
+	created       INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL staged_webhooks table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+staged_webhooks (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	source        VARCHAR(250),
+	header        TEXT,
+	payload       TEXT,
+	created       INTEGER,
+	INDEX staged_webhooks_created (created)
+);
+`
+)
+
+// CreateStagedWebhookTable creates the staged_webhooks table in the database.
+func (e *engine) CreateStagedWebhookTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating staged_webhooks table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the staged_webhooks table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the staged_webhooks table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the staged_webhooks table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
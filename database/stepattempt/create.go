@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepattempt
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateStepAttempt creates an attempt of a step in the database.
+func (e *engine) CreateStepAttempt(ctx context.Context, s *api.StepAttempt) error {
+	e.logger.WithFields(logrus.Fields{
+		"attempt": s.GetAttempt(),
+		"build":   s.GetBuildID(),
+		"step":    s.GetStepID(),
+	}).Tracef("creating attempt %d for step %d in the database", s.GetAttempt(), s.GetStepID())
+
+	// cast the API type to database type
+	attempt := types.StepAttemptFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := attempt.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableStepAttempts).
+		Create(attempt).
+		Error
+}
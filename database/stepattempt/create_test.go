@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepattempt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStepAttempt_Engine_CreateStepAttempt(t *testing.T) {
+	// setup types
+	_attempt := testStepAttempt()
+	_attempt.SetID(1)
+	_attempt.SetStepID(1)
+	_attempt.SetBuildID(1)
+	_attempt.SetRepoID(1)
+	_attempt.SetAttempt(1)
+	_attempt.SetStatus("failure")
+	_attempt.SetExitCode(1)
+	_attempt.SetStarted(1)
+	_attempt.SetFinished(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "step_attempts"
+("step_id","build_id","repo_id","attempt","status","error","exit_code","started","finished","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10) RETURNING "id"`).
+		WithArgs(1, 1, 1, 1, "failure", nil, 1, 1, 2, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateStepAttempt(context.TODO(), _attempt)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateStepAttempt for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateStepAttempt for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
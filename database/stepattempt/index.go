@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepattempt
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateBuildIDIndex represents a query to create an
+	// index on the step_attempts table for the build_id column.
+	CreateBuildIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+step_attempts_build_id
+ON step_attempts (build_id);
+`
+)
+
+// CreateStepAttemptsIndexes creates the indexes for the step_attempts table in the database.
+func (e *engine) CreateStepAttemptsIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for step_attempts table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the build_id column index for the step_attempts table
+	return e.client.WithContext(ctx).Exec(CreateBuildIDIndex).Error
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepattempt
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListStepAttemptsForStep gets the attempts of a step from the database.
+func (e *engine) ListStepAttemptsForStep(ctx context.Context, s *library.Step) ([]*api.StepAttempt, error) {
+	e.logger.Tracef("listing attempts for step %d from the database", s.GetID())
+
+	// variables to store query results and return value
+	a := new([]types.StepAttempt)
+	attempts := []*api.StepAttempt{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableStepAttempts).
+		Where("step_id = ?", s.GetID()).
+		Order("attempt").
+		Find(&a).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, attempt := range *a {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := attempt
+
+		// convert query result to API type
+		attempts = append(attempts, tmp.ToAPI())
+	}
+
+	return attempts, nil
+}
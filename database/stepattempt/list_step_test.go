@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepattempt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestStepAttempt_Engine_ListStepAttemptsForStep(t *testing.T) {
+	// setup types
+	_step := new(library.Step)
+	_step.SetID(1)
+
+	_attemptOne := testStepAttempt()
+	_attemptOne.SetID(1)
+	_attemptOne.SetStepID(1)
+	_attemptOne.SetBuildID(1)
+	_attemptOne.SetRepoID(1)
+	_attemptOne.SetAttempt(1)
+	_attemptOne.SetStatus("failure")
+	_attemptOne.SetError("exit code 1")
+	_attemptOne.SetExitCode(1)
+	_attemptOne.SetStarted(1)
+	_attemptOne.SetFinished(2)
+
+	_attemptTwo := testStepAttempt()
+	_attemptTwo.SetID(2)
+	_attemptTwo.SetStepID(1)
+	_attemptTwo.SetBuildID(1)
+	_attemptTwo.SetRepoID(1)
+	_attemptTwo.SetAttempt(2)
+	_attemptTwo.SetStatus("success")
+	_attemptTwo.SetStarted(3)
+	_attemptTwo.SetFinished(4)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "step_id", "build_id", "repo_id", "attempt", "status", "error", "exit_code", "started", "finished"}).
+		AddRow(1, 1, 1, 1, 1, "failure", "exit code 1", 1, 1, 2).
+		AddRow(2, 1, 1, 1, 2, "success", "", 0, 3, 4)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "step_attempts" WHERE step_id = $1 ORDER BY attempt`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateStepAttempt(context.TODO(), _attemptOne)
+	if err != nil {
+		t.Errorf("unable to create test step attempt for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateStepAttempt(context.TODO(), _attemptTwo)
+	if err != nil {
+		t.Errorf("unable to create test step attempt for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.StepAttempt
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.StepAttempt{_attemptOne, _attemptTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.StepAttempt{_attemptOne, _attemptTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListStepAttemptsForStep(context.TODO(), _step)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListStepAttemptsForStep for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListStepAttemptsForStep for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListStepAttemptsForStep for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
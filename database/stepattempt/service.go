@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepattempt
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// StepAttemptService represents the Vela interface for step attempt
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type StepAttemptService interface {
+	// StepAttempt Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateStepAttemptsIndexes defines a function that creates the indexes for the step_attempts table.
+	CreateStepAttemptsIndexes(context.Context) error
+	// CreateStepAttemptsTable defines a function that creates the step_attempts table.
+	CreateStepAttemptsTable(context.Context, string) error
+
+	// StepAttempt Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateStepAttempt defines a function that creates an attempt of a step.
+	CreateStepAttempt(context.Context, *api.StepAttempt) error
+	// ListStepAttemptsForStep defines a function that gets the attempts of a step.
+	ListStepAttemptsForStep(context.Context, *library.Step) ([]*api.StepAttempt, error)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepattempt
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableStepAttempts represents the name of the table for step attempts.
+	TableStepAttempts = "step_attempts"
+
+	// CreatePostgresTable represents a query to create the Postgres step_attempts table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+step_attempts (
+	id            SERIAL PRIMARY KEY,
+	step_id       INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	attempt       INTEGER,
+	status        VARCHAR(250),
+	error         VARCHAR(500),
+	exit_code     INTEGER,
+	started       INTEGER,
+	finished      INTEGER,
+	UNIQUE(step_id, attempt)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite step_attempts table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+step_attempts (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	step_id       INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	attempt       INTEGER,
+	status        TEXT,
+	error         TEXT,
+	exit_code     INTEGER,
+	started       INTEGER,
+	finished      INTEGER,
+	UNIQUE(step_id, attempt)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL step_attempts table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+step_attempts (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	step_id       INTEGER,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	attempt       INTEGER,
+	status        VARCHAR(250),
+	error         VARCHAR(500),
+	exit_code     INTEGER,
+	started       INTEGER,
+	finished      INTEGER,
+	UNIQUE(step_id, attempt),
+	INDEX step_attempts_build_id (build_id)
+);
+`
+)
+
+// CreateStepAttemptsTable creates the step_attempts table in the database.
+func (e *engine) CreateStepAttemptsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating step_attempts table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the step_attempts table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the step_attempts table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the step_attempts table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
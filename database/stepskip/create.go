@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepskip
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateStepSkip creates a skipped stage or step of a build in the database.
+func (e *engine) CreateStepSkip(ctx context.Context, s *api.StepSkip) error {
+	e.logger.WithFields(logrus.Fields{
+		"build": s.GetBuildID(),
+		"stage": s.GetStage(),
+		"step":  s.GetStep(),
+	}).Tracef("creating skip %s for build %d in the database", s.GetReason(), s.GetBuildID())
+
+	// cast the API type to database type
+	skip := types.StepSkipFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := skip.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableStepSkips).
+		Create(skip).
+		Error
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepskip
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestStepSkip_Engine_CreateStepSkip(t *testing.T) {
+	// setup types
+	_skip := testStepSkip()
+	_skip.SetID(1)
+	_skip.SetBuildID(1)
+	_skip.SetRepoID(1)
+	_skip.SetStep("deploy")
+	_skip.SetReason("ruleset_if")
+	_skip.SetMessage("ruleset if rules do not match the build")
+	_skip.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "step_skips"
+("build_id","repo_id","stage","step","reason","message","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs(1, 1, nil, "deploy", "ruleset_if", "ruleset if rules do not match the build", 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateStepSkip(context.TODO(), _skip)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateStepSkip for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateStepSkip for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepskip
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+)
+
+// ListStepSkipsForBuild gets the skipped stages and steps of a build from the database.
+func (e *engine) ListStepSkipsForBuild(ctx context.Context, b *library.Build) ([]*api.StepSkip, error) {
+	e.logger.Tracef("listing skips for build %d from the database", b.GetID())
+
+	// variables to store query results and return value
+	s := new([]types.StepSkip)
+	skips := []*api.StepSkip{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableStepSkips).
+		Where("build_id = ?", b.GetID()).
+		Order("id").
+		Find(&s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, skip := range *s {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := skip
+
+		// convert query result to API type
+		skips = append(skips, tmp.ToAPI())
+	}
+
+	return skips, nil
+}
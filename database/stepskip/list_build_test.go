@@ -0,0 +1,109 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepskip
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestStepSkip_Engine_ListStepSkipsForBuild(t *testing.T) {
+	// setup types
+	_build := new(library.Build)
+	_build.SetID(1)
+
+	_skipOne := testStepSkip()
+	_skipOne.SetID(1)
+	_skipOne.SetBuildID(1)
+	_skipOne.SetRepoID(1)
+	_skipOne.SetStage("deploy")
+	_skipOne.SetReason("ruleset_if")
+	_skipOne.SetMessage("ruleset if rules do not match the build")
+	_skipOne.SetCreated(1)
+
+	_skipTwo := testStepSkip()
+	_skipTwo.SetID(2)
+	_skipTwo.SetBuildID(1)
+	_skipTwo.SetRepoID(1)
+	_skipTwo.SetStage("test")
+	_skipTwo.SetStep("lint")
+	_skipTwo.SetReason("ruleset_unless")
+	_skipTwo.SetMessage("ruleset unless rules match the build")
+	_skipTwo.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "build_id", "repo_id", "stage", "step", "reason", "message", "created"}).
+		AddRow(1, 1, 1, "deploy", "", "ruleset_if", "ruleset if rules do not match the build", 1).
+		AddRow(2, 1, 1, "test", "lint", "ruleset_unless", "ruleset unless rules match the build", 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "step_skips" WHERE build_id = $1 ORDER BY id`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateStepSkip(context.TODO(), _skipOne)
+	if err != nil {
+		t.Errorf("unable to create test step skip for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateStepSkip(context.TODO(), _skipTwo)
+	if err != nil {
+		t.Errorf("unable to create test step skip for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.StepSkip
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.StepSkip{_skipOne, _skipTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.StepSkip{_skipOne, _skipTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListStepSkipsForBuild(context.TODO(), _build)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListStepSkipsForBuild for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListStepSkipsForBuild for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListStepSkipsForBuild for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
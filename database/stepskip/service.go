@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepskip
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// StepSkipService represents the Vela interface for step skip
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type StepSkipService interface {
+	// StepSkip Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateStepSkipsIndexes defines a function that creates the indexes for the step_skips table.
+	CreateStepSkipsIndexes(context.Context) error
+	// CreateStepSkipsTable defines a function that creates the step_skips table.
+	CreateStepSkipsTable(context.Context, string) error
+
+	// StepSkip Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateStepSkip defines a function that creates a skipped stage or step of a build.
+	CreateStepSkip(context.Context, *api.StepSkip) error
+	// ListStepSkipsForBuild defines a function that gets the skipped stages and steps of a build.
+	ListStepSkipsForBuild(context.Context, *library.Build) ([]*api.StepSkip, error)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package stepskip
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableStepSkips represents the name of the table for step skips.
+	TableStepSkips = "step_skips"
+
+	// CreatePostgresTable represents a query to create the Postgres step_skips table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+step_skips (
+	id            SERIAL PRIMARY KEY,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	stage         VARCHAR(250),
+	step          VARCHAR(250),
+	reason        VARCHAR(250),
+	message       VARCHAR(1000),
+	created       INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite step_skips table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+step_skips (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	stage         TEXT,
+	step          TEXT,
+	reason        TEXT,
+	message       TEXT,
+	created       INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL step_skips table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+step_skips (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	build_id      INTEGER,
+	repo_id       INTEGER,
+	stage         VARCHAR(250),
+	step          VARCHAR(250),
+	reason        VARCHAR(250),
+	message       VARCHAR(1000),
+	created       INTEGER,
+	INDEX step_skips_build_id (build_id)
+);
+`
+)
+
+// CreateStepSkipsTable creates the step_skips table in the database.
+func (e *engine) CreateStepSkipsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating step_skips table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the step_skips table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the step_skips table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the step_skips table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateTemplateDeprecation creates a new template deprecation in the database.
+func (e *engine) CreateTemplateDeprecation(ctx context.Context, d *api.TemplateDeprecation) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":  d.GetOrg(),
+		"repo": d.GetRepo(),
+	}).Tracef("creating template deprecation for %s/%s/%s@%s in the database", d.GetOrg(), d.GetRepo(), d.GetPath(), d.GetRef())
+
+	// cast the API type to database type
+	deprecation := types.TemplateDeprecationFromAPI(d)
+
+	// validate the necessary fields are populated
+	err := deprecation.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableTemplateDeprecation).
+		Create(deprecation).
+		Error
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTemplate_Engine_CreateTemplateDeprecation(t *testing.T) {
+	// setup types
+	_deprecation := testTemplateDeprecation()
+	_deprecation.SetID(1)
+	_deprecation.SetOrg("github")
+	_deprecation.SetRepo("octocat")
+	_deprecation.SetPath("template.yml")
+	_deprecation.SetRef("v1.0.0")
+	_deprecation.SetMessage("use v2.0.0 instead")
+	_deprecation.SetCreatedBy("octocat")
+	_deprecation.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "template_deprecations"
+("org","repo","path","ref","message","created_by","created_at","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8) RETURNING "id"`).
+		WithArgs("github", "octocat", "template.yml", "v1.0.0", "use v2.0.0 instead", "octocat", 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateTemplateDeprecation(context.TODO(), _deprecation)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateTemplateDeprecation for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateTemplateDeprecation for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteTemplateDeprecation deletes an existing template deprecation from the database.
+func (e *engine) DeleteTemplateDeprecation(ctx context.Context, d *api.TemplateDeprecation) error {
+	e.logger.WithFields(logrus.Fields{
+		"org":  d.GetOrg(),
+		"repo": d.GetRepo(),
+	}).Tracef("deleting template deprecation %d from the database", d.GetID())
+
+	// cast the API type to database type
+	deprecation := types.TemplateDeprecationFromAPI(d)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableTemplateDeprecation).
+		Delete(deprecation).
+		Error
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTemplate_Engine_DeleteTemplateDeprecation(t *testing.T) {
+	// setup types
+	_deprecation := testTemplateDeprecation()
+	_deprecation.SetID(1)
+	_deprecation.SetOrg("github")
+	_deprecation.SetRepo("octocat")
+	_deprecation.SetPath("template.yml")
+	_deprecation.SetRef("v1.0.0")
+	_deprecation.SetMessage("use v2.0.0 instead")
+	_deprecation.SetCreatedBy("octocat")
+	_deprecation.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "template_deprecations" WHERE "template_deprecations"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTemplateDeprecation(context.TODO(), _deprecation)
+	if err != nil {
+		t.Errorf("unable to create test template deprecation for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteTemplateDeprecation(context.TODO(), _deprecation)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteTemplateDeprecation for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteTemplateDeprecation for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
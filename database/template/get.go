@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetTemplateDeprecation gets a template deprecation by ID from the database.
+func (e *engine) GetTemplateDeprecation(ctx context.Context, id int64) (*api.TemplateDeprecation, error) {
+	e.logger.Tracef("getting template deprecation %d from the database", id)
+
+	// variable to store query results
+	d := new(types.TemplateDeprecation)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableTemplateDeprecation).
+		Where("id = ?", id).
+		Take(d).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ToAPI(), nil
+}
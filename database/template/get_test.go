@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTemplate_Engine_GetTemplateDeprecation(t *testing.T) {
+	// setup types
+	_deprecation := testTemplateDeprecation()
+	_deprecation.SetID(1)
+	_deprecation.SetOrg("github")
+	_deprecation.SetRepo("octocat")
+	_deprecation.SetPath("template.yml")
+	_deprecation.SetRef("v1.0.0")
+	_deprecation.SetMessage("use v2.0.0 instead")
+	_deprecation.SetCreatedBy("octocat")
+	_deprecation.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "repo", "path", "ref", "message", "created_by", "created_at"}).
+		AddRow(1, "github", "octocat", "template.yml", "v1.0.0", "use v2.0.0 instead", "octocat", 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "template_deprecations" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTemplateDeprecation(context.TODO(), _deprecation)
+	if err != nil {
+		t.Errorf("unable to create test template deprecation for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.TemplateDeprecation
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _deprecation,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _deprecation,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetTemplateDeprecation(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetTemplateDeprecation for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetTemplateDeprecation for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetTemplateDeprecation for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateOrgRepoIndex represents a query to create an
+	// index on the template_deprecations table for the org and repo columns.
+	CreateOrgRepoIndex = `
+CREATE INDEX
+IF NOT EXISTS
+template_deprecations_org_repo
+ON template_deprecations (org, repo);
+`
+)
+
+// CreateTemplateDeprecationIndexes creates the indexes for the template_deprecations table in the database.
+func (e *engine) CreateTemplateDeprecationIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for template_deprecations table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the org and repo columns index for the template_deprecations table
+	return e.client.WithContext(ctx).Exec(CreateOrgRepoIndex).Error
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ListTemplateDeprecationsForRepo gets a list of template deprecations by org and repo name from the database.
+func (e *engine) ListTemplateDeprecationsForRepo(ctx context.Context, org, repo string) ([]*api.TemplateDeprecation, error) {
+	e.logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+	}).Tracef("listing template deprecations for repo %s/%s from the database", org, repo)
+
+	// variables to store query results and return value
+	d := new([]types.TemplateDeprecation)
+	deprecations := []*api.TemplateDeprecation{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableTemplateDeprecation).
+		Where("org = ?", org).
+		Where("repo = ?", repo).
+		Order("id DESC").
+		Find(&d).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, deprecation := range *d {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := deprecation
+
+		// convert query result to API type
+		deprecations = append(deprecations, tmp.ToAPI())
+	}
+
+	return deprecations, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTemplate_Engine_ListTemplateDeprecationsForRepo(t *testing.T) {
+	// setup types
+	_deprecationOne := testTemplateDeprecation()
+	_deprecationOne.SetID(1)
+	_deprecationOne.SetOrg("github")
+	_deprecationOne.SetRepo("octocat")
+	_deprecationOne.SetPath("template.yml")
+	_deprecationOne.SetRef("v1.0.0")
+	_deprecationOne.SetMessage("use v2.0.0 instead")
+	_deprecationOne.SetCreatedBy("octocat")
+	_deprecationOne.SetCreatedAt(1)
+
+	_deprecationTwo := testTemplateDeprecation()
+	_deprecationTwo.SetID(2)
+	_deprecationTwo.SetOrg("github")
+	_deprecationTwo.SetRepo("octocat")
+	_deprecationTwo.SetPath("legacy.yml")
+	_deprecationTwo.SetMessage("use template.yml instead")
+	_deprecationTwo.SetCreatedBy("octocat")
+	_deprecationTwo.SetCreatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "org", "repo", "path", "ref", "message", "created_by", "created_at"}).
+		AddRow(2, "github", "octocat", "legacy.yml", "", "use template.yml instead", "octocat", 1).
+		AddRow(1, "github", "octocat", "template.yml", "v1.0.0", "use v2.0.0 instead", "octocat", 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "template_deprecations" WHERE org = $1 AND repo = $2 ORDER BY id DESC`).
+		WithArgs("github", "octocat").
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTemplateDeprecation(context.TODO(), _deprecationOne)
+	if err != nil {
+		t.Errorf("unable to create test template deprecation for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateTemplateDeprecation(context.TODO(), _deprecationTwo)
+	if err != nil {
+		t.Errorf("unable to create test template deprecation for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.TemplateDeprecation
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.TemplateDeprecation{_deprecationTwo, _deprecationOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.TemplateDeprecation{_deprecationTwo, _deprecationOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListTemplateDeprecationsForRepo(context.TODO(), "github", "octocat")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListTemplateDeprecationsForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListTemplateDeprecationsForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListTemplateDeprecationsForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
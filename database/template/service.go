@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// TemplateService represents the Vela interface for template
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type TemplateService interface {
+	// Template Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateTemplateDeprecationIndexes defines a function that creates the indexes for the template_deprecations table.
+	CreateTemplateDeprecationIndexes(context.Context) error
+	// CreateTemplateDeprecationTable defines a function that creates the template_deprecations table.
+	CreateTemplateDeprecationTable(context.Context, string) error
+
+	// Template Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateTemplateDeprecation defines a function that creates a new template deprecation.
+	CreateTemplateDeprecation(context.Context, *api.TemplateDeprecation) error
+	// DeleteTemplateDeprecation defines a function that deletes an existing template deprecation.
+	DeleteTemplateDeprecation(context.Context, *api.TemplateDeprecation) error
+	// GetTemplateDeprecation defines a function that gets a template deprecation by ID.
+	GetTemplateDeprecation(context.Context, int64) (*api.TemplateDeprecation, error)
+	// ListTemplateDeprecationsForRepo defines a function that gets a list of template deprecations by org and repo name.
+	ListTemplateDeprecationsForRepo(context.Context, string, string) ([]*api.TemplateDeprecation, error)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package template
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableTemplateDeprecation represents the name of the table for template deprecations.
+	TableTemplateDeprecation = "template_deprecations"
+
+	// CreatePostgresTable represents a query to create the Postgres template_deprecations table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+template_deprecations (
+	id            SERIAL PRIMARY KEY,
+	org           VARCHAR(250),
+	repo          VARCHAR(250),
+	path          VARCHAR(500),
+	ref           VARCHAR(250),
+	message       VARCHAR(1000),
+	created_by    VARCHAR(250),
+	created_at    INTEGER,
+	UNIQUE(org, repo, path, ref)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite template_deprecations table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+template_deprecations (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	org           TEXT,
+	repo          TEXT,
+	path          TEXT,
+	ref           TEXT,
+	message       TEXT,
+	created_by    TEXT,
+	created_at    INTEGER,
+	UNIQUE(org, repo, path, ref)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL template_deprecations table.
+	//
+	// The unique key indexes a prefix of the columns to fit the MySQL key length limit.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+template_deprecations (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	org           VARCHAR(250),
+	repo          VARCHAR(250),
+	path          VARCHAR(500),
+	ref           VARCHAR(250),
+	message       VARCHAR(1000),
+	created_by    VARCHAR(250),
+	created_at    INTEGER,
+	UNIQUE(org(150), repo(150), path(300), ref(150)),
+	INDEX template_deprecations_org_repo (org, repo)
+);
+`
+)
+
+// CreateTemplateDeprecationTable creates the template_deprecations table in the database.
+func (e *engine) CreateTemplateDeprecationTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating template_deprecations table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the template_deprecations table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the template_deprecations table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the template_deprecations table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package timeout
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// Postgres returns the provided Postgres address with the
+// statement_timeout runtime parameter set, so the server
+// cancels every statement on the session that runs longer
+// than the provided timeout, including the rows read by
+// the caller after the callbacks return.
+//
+// Both the URL and the keyword/value forms are supported.
+//
+// A timeout of 0 returns the address unchanged.
+func Postgres(address string, timeout time.Duration) (string, error) {
+	// check if the statement timeout is disabled
+	if timeout <= 0 {
+		return address, nil
+	}
+
+	ms := strconv.FormatInt(timeout.Milliseconds(), 10)
+
+	// check if the address is in the keyword/value form
+	//
+	// https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING
+	if !strings.HasPrefix(address, "postgres://") && !strings.HasPrefix(address, "postgresql://") {
+		return strings.TrimSpace(address) + " statement_timeout=" + ms, nil
+	}
+
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse postgres address: %w", err)
+	}
+
+	query := u.Query()
+	query.Set("statement_timeout", ms)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// MySQL returns the provided MySQL address with the
+// max_execution_time system variable set, so the server
+// cancels every read on the session that runs longer
+// than the provided timeout, including the rows read by
+// the caller after the callbacks return.
+//
+// A timeout of 0 returns the address unchanged.
+func MySQL(address string, timeout time.Duration) (string, error) {
+	// check if the statement timeout is disabled
+	if timeout <= 0 {
+		return address, nil
+	}
+
+	// https://pkg.go.dev/github.com/go-sql-driver/mysql#ParseDSN
+	cfg, err := mysql.ParseDSN(address)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse mysql address: %w", err)
+	}
+
+	if cfg.Params == nil {
+		cfg.Params = map[string]string{}
+	}
+
+	// https://dev.mysql.com/doc/refman/8.0/en/server-system-variables.html#sysvar_max_execution_time
+	cfg.Params["max_execution_time"] = strconv.FormatInt(timeout.Milliseconds(), 10)
+
+	return cfg.FormatDSN(), nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package timeout provides the ability to limit how long
+// each statement sent to the database is allowed to run.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/database/timeout"
+package timeout
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// cancelKey represents the key for storing the
+// function to cancel the timeout for a statement.
+const cancelKey = "timeout:cancel"
+
+// Register registers callbacks with the provided database client to
+// cancel each statement that runs longer than the provided timeout.
+// The timeout is applied on top of the context passed with the
+// statement, so a statement is cancelled by whichever ends first.
+//
+// The Postgres and MySQL addresses should also be passed through
+// Postgres and MySQL, so the server enforces the same timeout.
+//
+// A timeout of 0 disables the statement timeout.
+func Register(db *gorm.DB, timeout time.Duration) error {
+	// check if the statement timeout is disabled
+	if timeout <= 0 {
+		return nil
+	}
+
+	// start the timeout for the statement
+	start := func(tx *gorm.DB) {
+		ctx := tx.Statement.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+
+		tx.Statement.Context = ctx
+		tx.InstanceSet(cancelKey, cancel)
+	}
+
+	// stop the timeout for the statement
+	stop := func(tx *gorm.DB) {
+		cancel, ok := tx.InstanceGet(cancelKey)
+		if !ok {
+			return
+		}
+
+		if fn, ok := cancel.(context.CancelFunc); ok {
+			fn()
+		}
+	}
+
+	// stop the timeout for a row statement if it failed
+	//
+	// the rows are read by the caller after the callbacks
+	// return, so the timeout is left running to cut off
+	// the read and is released once the deadline passes
+	stopRow := func(tx *gorm.DB) {
+		if tx.Error == nil {
+			return
+		}
+
+		stop(tx)
+	}
+
+	err := db.Callback().Create().Before("gorm:create").Register("timeout:start_create", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Create().After("gorm:create").Register("timeout:stop_create", stop)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Query().Before("gorm:query").Register("timeout:start_query", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Query().After("gorm:query").Register("timeout:stop_query", stop)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Update().Before("gorm:update").Register("timeout:start_update", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Update().After("gorm:update").Register("timeout:stop_update", stop)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Delete().Before("gorm:delete").Register("timeout:start_delete", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Delete().After("gorm:delete").Register("timeout:stop_delete", stop)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Raw().Before("gorm:raw").Register("timeout:start_raw", start)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Raw().After("gorm:raw").Register("timeout:stop_raw", stop)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Row().Before("gorm:row").Register("timeout:start_row", start)
+	if err != nil {
+		return err
+	}
+
+	return db.Callback().Row().After("gorm:row").Register("timeout:stop_row", stopRow)
+}
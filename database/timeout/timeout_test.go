@@ -0,0 +1,135 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package timeout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestTimeout_Register(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		timeout  time.Duration
+		deadline bool
+	}{
+		{
+			name:     "with timeout",
+			timeout:  time.Minute,
+			deadline: true,
+		},
+		{
+			name:     "without timeout",
+			timeout:  0,
+			deadline: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := gorm.Open(
+				sqlite.Open("file::memory:?cache=shared"),
+				&gorm.Config{SkipDefaultTransaction: true},
+			)
+			if err != nil {
+				t.Errorf("unable to create new sqlite database: %v", err)
+			}
+
+			err = Register(db, test.timeout)
+			if err != nil {
+				t.Errorf("Register returned err: %v", err)
+			}
+
+			var deadline bool
+
+			// capture the deadline for the statement
+			err = db.Callback().Raw().Before("gorm:raw").Register("test:deadline", func(tx *gorm.DB) {
+				_, deadline = tx.Statement.Context.Deadline()
+			})
+			if err != nil {
+				t.Errorf("unable to register callback: %v", err)
+			}
+
+			err = db.WithContext(context.Background()).Exec("SELECT 1").Error
+			if err != nil {
+				t.Errorf("Exec returned err: %v", err)
+			}
+
+			if deadline != test.deadline {
+				t.Errorf("Register deadline is %v, want %v", deadline, test.deadline)
+			}
+		})
+	}
+}
+
+func TestTimeout_Register_Exceeded(t *testing.T) {
+	// setup types
+	db, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	err = Register(db, time.Nanosecond)
+	if err != nil {
+		t.Errorf("Register returned err: %v", err)
+	}
+
+	// run test
+	err = db.WithContext(context.Background()).Exec("SELECT 1").Error
+	if err == nil {
+		t.Errorf("Exec should have returned err")
+	}
+}
+
+func TestTimeout_Register_Scan(t *testing.T) {
+	// setup types
+	db, err := gorm.Open(
+		sqlite.Open("file::memory:?cache=shared"),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		t.Errorf("unable to create new sqlite database: %v", err)
+	}
+
+	err = Register(db, 50*time.Millisecond)
+	if err != nil {
+		t.Errorf("Register returned err: %v", err)
+	}
+
+	// count to a billion so the read runs past the timeout
+	query := `
+WITH RECURSIVE counter(n) AS (
+  SELECT 1 UNION ALL SELECT n + 1 FROM counter WHERE n < 1000000000
+)
+SELECT count(*) FROM counter
+`
+
+	var count int64
+
+	// run test
+	start := time.Now()
+
+	// gorm drops the error from the interrupted rows
+	// when scanning, so the cut off read is checked
+	// by the time taken and the count returned
+	_ = db.WithContext(context.Background()).Raw(query).Scan(&count)
+
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("Scan took %v, want it cut off near the timeout", time.Since(start))
+	}
+
+	if count == 1000000000 {
+		t.Errorf("Scan count is %d, want it cut off before the end", count)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package database
+
+import (
+	"context"
+)
+
+// transactor represents a database client capable of running
+// a function within a single database transaction.
+type transactor interface {
+	Transaction(context.Context, func(interface{}) error) error
+}
+
+// Transaction runs the provided function with a Service bound to a single
+// database transaction. Every call made through the Service provided to the
+// function is committed together when the function returns nil and rolled
+// back when the function returns an error, so a failure midway never leaves
+// partially created objects behind.
+//
+// Database clients that don't support transactions run
+// the function with the provided Service instead.
+func Transaction(ctx context.Context, s Service, fn func(tx Service) error) error {
+	t, ok := s.(transactor)
+	if !ok {
+		return fn(s)
+	}
+
+	return t.Transaction(ctx, func(tx interface{}) error {
+		return fn(tx.(Service))
+	})
+}
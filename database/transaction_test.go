@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func TestDatabase_Transaction(t *testing.T) {
+	// setup types
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		name    string
+		number  int
+		err     error
+		failure bool
+	}{
+		{
+			name:   "committed",
+			number: 1,
+		},
+		{
+			name:    "rolled back",
+			number:  2,
+			err:     errors.New("unable to create steps"),
+			failure: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			b := new(library.Build)
+			b.SetRepoID(1)
+			b.SetNumber(test.number)
+
+			err := Transaction(context.TODO(), db, func(tx Service) error {
+				err := tx.CreateBuild(context.TODO(), b)
+				if err != nil {
+					return err
+				}
+
+				// the build is visible within the transaction
+				_, err = tx.GetBuild(context.TODO(), test.number, _repo)
+				if err != nil {
+					return err
+				}
+
+				return test.err
+			})
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Transaction should have returned err")
+				}
+			} else if err != nil {
+				t.Errorf("Transaction returned err: %v", err)
+			}
+
+			_, err = db.GetBuild(context.TODO(), test.number, _repo)
+
+			if test.failure && err == nil {
+				t.Errorf("build %d should have been rolled back", test.number)
+			}
+
+			if !test.failure && err != nil {
+				t.Errorf("build %d should have been committed: %v", test.number, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateTriggerToken creates a new trigger token in the database.
+func (e *engine) CreateTriggerToken(ctx context.Context, t *api.TriggerToken) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo": t.GetRepo(),
+		"user": t.GetCreatedBy(),
+	}).Tracef("creating trigger token for %s in the database", t.GetRepo())
+
+	// cast the API type to database type
+	token := types.TriggerTokenFromAPI(t)
+
+	// validate the necessary fields are populated
+	err := token.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableTriggerToken).
+		Create(token).
+		Error
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTriggerToken_Engine_CreateTriggerToken(t *testing.T) {
+	// setup types
+	_token := testOctocat()
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "trigger_tokens"
+("repo","token_id","created_at","created_by","expires_at","id")
+VALUES ($1,$2,$3,$4,$5,$6) RETURNING "id"`).
+		WithArgs("github/octocat", "4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29", 1, "octocat", 2, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateTriggerToken(context.TODO(), _token)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateTriggerToken for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateTriggerToken for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteTriggerToken deletes an existing trigger token from the database.
+func (e *engine) DeleteTriggerToken(ctx context.Context, t *api.TriggerToken) error {
+	e.logger.WithFields(logrus.Fields{
+		"repo": t.GetRepo(),
+	}).Tracef("deleting trigger token %d from the database", t.GetID())
+
+	// cast the API type to database type
+	token := types.TriggerTokenFromAPI(t)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableTriggerToken).
+		Delete(token).
+		Error
+}
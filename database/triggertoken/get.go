@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetTriggerToken gets a trigger token by ID from the database.
+func (e *engine) GetTriggerToken(ctx context.Context, id int64) (*api.TriggerToken, error) {
+	e.logger.Tracef("getting trigger token %d from the database", id)
+
+	// variable to store query results
+	t := new(types.TriggerToken)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableTriggerToken).
+		Where("id = ?", id).
+		Take(t).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return t.ToAPI(), nil
+}
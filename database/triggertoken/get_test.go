@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTriggerToken_Engine_GetTriggerToken(t *testing.T) {
+	// setup types
+	_token := testOctocat()
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "repo", "token_id", "created_at", "created_by", "expires_at"}).AddRow(1, "github/octocat", "4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29", 1, "octocat", 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "trigger_tokens" WHERE id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTriggerToken(context.TODO(), _token)
+	if err != nil {
+		t.Errorf("unable to create test trigger token for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.TriggerToken
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _token,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _token,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetTriggerToken(context.TODO(), 1)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetTriggerToken for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetTriggerToken for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetTriggerToken for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
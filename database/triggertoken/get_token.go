@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetTriggerTokenForTokenID gets a trigger token by the ID embedded in the token from the database.
+func (e *engine) GetTriggerTokenForTokenID(ctx context.Context, id string) (*api.TriggerToken, error) {
+	e.logger.Tracef("getting trigger token %s from the database", id)
+
+	// variable to store query results
+	t := new(types.TriggerToken)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableTriggerToken).
+		Where("token_id = ?", id).
+		Take(t).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return t.ToAPI(), nil
+}
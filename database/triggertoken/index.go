@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateRepoIndex represents a query to create an
+	// index on the trigger_tokens table for the repo column.
+	CreateRepoIndex = `
+CREATE INDEX
+IF NOT EXISTS
+trigger_tokens_repo
+ON trigger_tokens (repo);
+`
+)
+
+// CreateTriggerTokenIndexes creates the indexes for the trigger_tokens table in the database.
+func (e *engine) CreateTriggerTokenIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for trigger_tokens table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the repo column index for the trigger_tokens table
+	return e.client.WithContext(ctx).Exec(CreateRepoIndex).Error
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ListTriggerTokensForRepo gets a list of trigger tokens by repo claim from the database.
+//
+// The repo claim is either the full name of a repo or the
+// claim for every repo in an org returned by token.OrgTrigger.
+func (e *engine) ListTriggerTokensForRepo(ctx context.Context, repo string) ([]*api.TriggerToken, error) {
+	e.logger.WithFields(logrus.Fields{
+		"repo": repo,
+	}).Tracef("listing trigger tokens for %s from the database", repo)
+
+	// variables to store query results and return value
+	t := new([]types.TriggerToken)
+	tokens := []*api.TriggerToken{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableTriggerToken).
+		Where("repo = ?", repo).
+		Order("id").
+		Find(&t).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, token := range *t {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := token
+
+		// convert query result to API type
+		tokens = append(tokens, tmp.ToAPI())
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTriggerToken_Engine_ListTriggerTokensForRepo(t *testing.T) {
+	// setup types
+	_tokenOne := testOctocat()
+
+	_tokenTwo := testOctocat()
+	_tokenTwo.SetID(2)
+	_tokenTwo.SetTokenID("9b2f7c1e-6a43-4f0e-8d7a-3c5e1f2a4b6d")
+
+	_tokenOrg := testOctocat()
+	_tokenOrg.SetID(3)
+	_tokenOrg.SetRepo("github/*")
+	_tokenOrg.SetTokenID("0d8e5a3b-2c71-4e9f-b6a4-7f1d3e5c9a20")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "repo", "token_id", "created_at", "created_by", "expires_at"}).
+		AddRow(1, "github/octocat", "4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29", 1, "octocat", 2).
+		AddRow(2, "github/octocat", "9b2f7c1e-6a43-4f0e-8d7a-3c5e1f2a4b6d", 1, "octocat", 2)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "trigger_tokens" WHERE repo = $1 ORDER BY id`).WithArgs("github/octocat").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateTriggerToken(context.TODO(), _tokenOne)
+	if err != nil {
+		t.Errorf("unable to create test trigger token for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateTriggerToken(context.TODO(), _tokenTwo)
+	if err != nil {
+		t.Errorf("unable to create test trigger token for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateTriggerToken(context.TODO(), _tokenOrg)
+	if err != nil {
+		t.Errorf("unable to create test trigger token for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.TriggerToken
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.TriggerToken{_tokenOne, _tokenTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.TriggerToken{_tokenOne, _tokenTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListTriggerTokensForRepo(context.TODO(), "github/octocat")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListTriggerTokensForRepo for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListTriggerTokensForRepo for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListTriggerTokensForRepo for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// TriggerTokenService represents the Vela interface for trigger token
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type TriggerTokenService interface {
+	// TriggerToken Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateTriggerTokenIndexes defines a function that creates the indexes for the trigger_tokens table.
+	CreateTriggerTokenIndexes(context.Context) error
+	// CreateTriggerTokenTable defines a function that creates the trigger_tokens table.
+	CreateTriggerTokenTable(context.Context, string) error
+
+	// TriggerToken Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateTriggerToken defines a function that creates a new trigger token.
+	CreateTriggerToken(context.Context, *api.TriggerToken) error
+	// DeleteTriggerToken defines a function that deletes an existing trigger token.
+	DeleteTriggerToken(context.Context, *api.TriggerToken) error
+	// GetTriggerToken defines a function that gets a trigger token by ID.
+	GetTriggerToken(context.Context, int64) (*api.TriggerToken, error)
+	// GetTriggerTokenForTokenID defines a function that gets a trigger token by the ID embedded in the token.
+	GetTriggerTokenForTokenID(context.Context, string) (*api.TriggerToken, error)
+	// ListTriggerTokensForRepo defines a function that gets a list of trigger tokens by repo claim.
+	ListTriggerTokensForRepo(context.Context, string) ([]*api.TriggerToken, error)
+}
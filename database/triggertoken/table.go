@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package triggertoken
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableTriggerToken represents the name of the table for trigger tokens.
+	TableTriggerToken = "trigger_tokens"
+
+	// CreatePostgresTable represents a query to create the Postgres trigger_tokens table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+trigger_tokens (
+	id            SERIAL PRIMARY KEY,
+	repo          VARCHAR(500),
+	token_id      VARCHAR(250),
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	expires_at    INTEGER,
+	UNIQUE(token_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite trigger_tokens table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+trigger_tokens (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo          TEXT,
+	token_id      TEXT,
+	created_at    INTEGER,
+	created_by    TEXT,
+	expires_at    INTEGER,
+	UNIQUE(token_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL trigger_tokens table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+trigger_tokens (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	repo          VARCHAR(500),
+	token_id      VARCHAR(250),
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	expires_at    INTEGER,
+	UNIQUE(token_id),
+	INDEX trigger_tokens_repo (repo)
+);
+`
+)
+
+// CreateTriggerTokenTable creates the trigger_tokens table in the database.
+func (e *engine) CreateTriggerTokenTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating trigger_tokens table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the trigger_tokens table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the trigger_tokens table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the trigger_tokens table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
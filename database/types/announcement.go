@@ -0,0 +1,174 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrInvalidAnnouncementKind defines the error type when an
+	// Announcement type has an invalid Kind field provided.
+	ErrInvalidAnnouncementKind = errors.New("invalid announcement kind provided")
+
+	// ErrEmptyAnnouncementMessage defines the error type when an
+	// Announcement type has an empty Message field provided.
+	ErrEmptyAnnouncementMessage = errors.New("empty announcement message provided")
+
+	// ErrInvalidAnnouncementWindow defines the error type when an
+	// Announcement type has an Ends field that is not after the
+	// Starts field provided.
+	ErrInvalidAnnouncementWindow = errors.New("invalid announcement window provided")
+)
+
+// Announcement is the database representation of a banner
+// published by an admin for the users of the platform.
+type Announcement struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Kind      sql.NullString `sql:"kind"`
+	Title     sql.NullString `sql:"title"`
+	Message   sql.NullString `sql:"message"`
+	Orgs      pq.StringArray `sql:"orgs" gorm:"type:varchar(1000)"`
+	Starts    sql.NullInt64  `sql:"starts"`
+	Ends      sql.NullInt64  `sql:"ends"`
+	Active    sql.NullBool   `sql:"active"`
+	CreatedAt sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Announcement type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (a *Announcement) Nullify() *Announcement {
+	if a == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if a.ID.Int64 == 0 {
+		a.ID.Valid = false
+	}
+
+	// check if the Kind field should be false
+	if len(a.Kind.String) == 0 {
+		a.Kind.Valid = false
+	}
+
+	// check if the Title field should be false
+	if len(a.Title.String) == 0 {
+		a.Title.Valid = false
+	}
+
+	// check if the Message field should be false
+	if len(a.Message.String) == 0 {
+		a.Message.Valid = false
+	}
+
+	// check if the Starts field should be false
+	if a.Starts.Int64 == 0 {
+		a.Starts.Valid = false
+	}
+
+	// check if the Ends field should be false
+	if a.Ends.Int64 == 0 {
+		a.Ends.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if a.CreatedAt.Int64 == 0 {
+		a.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(a.CreatedBy.String) == 0 {
+		a.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if a.UpdatedAt.Int64 == 0 {
+		a.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(a.UpdatedBy.String) == 0 {
+		a.UpdatedBy.Valid = false
+	}
+
+	return a
+}
+
+// ToAPI converts the Announcement type
+// to an API Announcement type.
+func (a *Announcement) ToAPI() *api.Announcement {
+	announcement := new(api.Announcement)
+
+	announcement.SetID(a.ID.Int64)
+	announcement.SetKind(a.Kind.String)
+	announcement.SetTitle(a.Title.String)
+	announcement.SetMessage(a.Message.String)
+	announcement.SetOrgs(a.Orgs)
+	announcement.SetStarts(a.Starts.Int64)
+	announcement.SetEnds(a.Ends.Int64)
+	announcement.SetActive(a.Active.Bool)
+	announcement.SetCreatedAt(a.CreatedAt.Int64)
+	announcement.SetCreatedBy(a.CreatedBy.String)
+	announcement.SetUpdatedAt(a.UpdatedAt.Int64)
+	announcement.SetUpdatedBy(a.UpdatedBy.String)
+
+	return announcement
+}
+
+// Validate verifies the necessary fields for
+// the Announcement type are populated correctly.
+func (a *Announcement) Validate() error {
+	// verify the Kind field is populated with a supported kind
+	switch a.Kind.String {
+	case api.AnnouncementKindInfo, api.AnnouncementKindMaintenance, api.AnnouncementKindDeprecation:
+	default:
+		return ErrInvalidAnnouncementKind
+	}
+
+	// verify the Message field is populated
+	if len(a.Message.String) == 0 {
+		return ErrEmptyAnnouncementMessage
+	}
+
+	// verify the Ends field is after the Starts field when both are set
+	if a.Ends.Int64 > 0 && a.Ends.Int64 <= a.Starts.Int64 {
+		return ErrInvalidAnnouncementWindow
+	}
+
+	return nil
+}
+
+// AnnouncementFromAPI converts the API Announcement type
+// to a database Announcement type.
+func AnnouncementFromAPI(a *api.Announcement) *Announcement {
+	announcement := &Announcement{
+		ID:        sql.NullInt64{Int64: a.GetID(), Valid: true},
+		Kind:      sql.NullString{String: a.GetKind(), Valid: true},
+		Title:     sql.NullString{String: a.GetTitle(), Valid: true},
+		Message:   sql.NullString{String: a.GetMessage(), Valid: true},
+		Orgs:      pq.StringArray(a.GetOrgs()),
+		Starts:    sql.NullInt64{Int64: a.GetStarts(), Valid: true},
+		Ends:      sql.NullInt64{Int64: a.GetEnds(), Valid: true},
+		Active:    sql.NullBool{Bool: a.GetActive(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: a.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: a.GetCreatedBy(), Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: a.GetUpdatedAt(), Valid: true},
+		UpdatedBy: sql.NullString{String: a.GetUpdatedBy(), Valid: true},
+	}
+
+	return announcement.Nullify()
+}
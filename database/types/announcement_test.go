@@ -0,0 +1,197 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+func TestAnnouncement_Nullify(t *testing.T) {
+	// setup types
+	var a *Announcement
+
+	want := &Announcement{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Kind:      sql.NullString{String: "", Valid: false},
+		Title:     sql.NullString{String: "", Valid: false},
+		Message:   sql.NullString{String: "", Valid: false},
+		Starts:    sql.NullInt64{Int64: 0, Valid: false},
+		Ends:      sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *Announcement
+		want *Announcement
+	}{
+		{
+			item: testAnnouncement(),
+			want: testAnnouncement(),
+		},
+		{
+			item: a,
+			want: nil,
+		},
+		{
+			item: new(Announcement),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestAnnouncement_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Announcement)
+
+	want.SetID(1)
+	want.SetKind("maintenance")
+	want.SetTitle("database upgrade")
+	want.SetMessage("builds will be paused during the upgrade")
+	want.SetOrgs([]string{"github"})
+	want.SetStarts(1563474076)
+	want.SetEnds(1563477676)
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testAnnouncement().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestAnnouncement_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *Announcement
+	}{
+		{
+			failure: false,
+			item:    testAnnouncement(),
+		},
+		{ // no Ends set for Announcement
+			failure: false,
+			item: func() *Announcement {
+				a := testAnnouncement()
+				a.Ends = sql.NullInt64{}
+
+				return a
+			}(),
+		},
+		{ // invalid Kind set for Announcement
+			failure: true,
+			item: func() *Announcement {
+				a := testAnnouncement()
+				a.Kind = sql.NullString{String: "foo", Valid: true}
+
+				return a
+			}(),
+		},
+		{ // no Message set for Announcement
+			failure: true,
+			item: func() *Announcement {
+				a := testAnnouncement()
+				a.Message = sql.NullString{}
+
+				return a
+			}(),
+		},
+		{ // Ends before Starts set for Announcement
+			failure: true,
+			item: func() *Announcement {
+				a := testAnnouncement()
+				a.Ends = sql.NullInt64{Int64: 1563474075, Valid: true}
+
+				return a
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestAnnouncementFromAPI(t *testing.T) {
+	// setup types
+	a := new(api.Announcement)
+
+	a.SetID(1)
+	a.SetKind("maintenance")
+	a.SetTitle("database upgrade")
+	a.SetMessage("builds will be paused during the upgrade")
+	a.SetOrgs([]string{"github"})
+	a.SetStarts(1563474076)
+	a.SetEnds(1563477676)
+	a.SetActive(true)
+	a.SetCreatedAt(1563474077)
+	a.SetCreatedBy("octocat")
+	a.SetUpdatedAt(1563474077)
+	a.SetUpdatedBy("octocat")
+
+	want := testAnnouncement()
+
+	// run test
+	got := AnnouncementFromAPI(a)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AnnouncementFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testAnnouncement is a test helper function to create an Announcement
+// type with all fields set to a fake value.
+func testAnnouncement() *Announcement {
+	return &Announcement{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Kind:      sql.NullString{String: "maintenance", Valid: true},
+		Title:     sql.NullString{String: "database upgrade", Valid: true},
+		Message:   sql.NullString{String: "builds will be paused during the upgrade", Valid: true},
+		Orgs:      pq.StringArray{"github"},
+		Starts:    sql.NullInt64{Int64: 1563474076, Valid: true},
+		Ends:      sql.NullInt64{Int64: 1563477676, Valid: true},
+		Active:    sql.NullBool{Bool: true, Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy: sql.NullString{String: "octocat", Valid: true},
+	}
+}
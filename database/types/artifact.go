@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+	"regexp"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyArtifactRepoID defines the error type when a
+	// Artifact type has an empty RepoID field provided.
+	ErrEmptyArtifactRepoID = errors.New("empty artifact repo_id provided")
+
+	// ErrEmptyArtifactBuildID defines the error type when a
+	// Artifact type has an empty BuildID field provided.
+	ErrEmptyArtifactBuildID = errors.New("empty artifact build_id provided")
+
+	// ErrEmptyArtifactName defines the error type when a
+	// Artifact type has an empty Name field provided.
+	ErrEmptyArtifactName = errors.New("empty artifact name provided")
+
+	// ErrInvalidArtifactDigest defines the error type when a
+	// Artifact type has an invalid Digest field provided.
+	ErrInvalidArtifactDigest = errors.New("invalid artifact digest provided")
+
+	// digestPattern represents the format of a digest
+	// as the algorithm and the hex encoded hash.
+	digestPattern = regexp.MustCompile(`^[a-z0-9]+:[a-f0-9]{32,128}$`)
+)
+
+// Artifact is the database representation of an artifact produced
+// by a build, identified by the digest of its contents.
+type Artifact struct {
+	ID        sql.NullInt64  `sql:"id"`
+	RepoID    sql.NullInt64  `sql:"repo_id"`
+	BuildID   sql.NullInt64  `sql:"build_id"`
+	Name      sql.NullString `sql:"name"`
+	Kind      sql.NullString `sql:"kind"`
+	Digest    sql.NullString `sql:"digest"`
+	URI       sql.NullString `sql:"uri"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	Created   sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Artifact type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (a *Artifact) Nullify() *Artifact {
+	if a == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if a.ID.Int64 == 0 {
+		a.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if a.RepoID.Int64 == 0 {
+		a.RepoID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if a.BuildID.Int64 == 0 {
+		a.BuildID.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(a.Name.String) == 0 {
+		a.Name.Valid = false
+	}
+
+	// check if the Kind field should be false
+	if len(a.Kind.String) == 0 {
+		a.Kind.Valid = false
+	}
+
+	// check if the Digest field should be false
+	if len(a.Digest.String) == 0 {
+		a.Digest.Valid = false
+	}
+
+	// check if the URI field should be false
+	if len(a.URI.String) == 0 {
+		a.URI.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(a.CreatedBy.String) == 0 {
+		a.CreatedBy.Valid = false
+	}
+
+	// check if the Created field should be false
+	if a.Created.Int64 == 0 {
+		a.Created.Valid = false
+	}
+
+	return a
+}
+
+// ToAPI converts the Artifact type
+// to an API Artifact type.
+func (a *Artifact) ToAPI() *api.Artifact {
+	artifact := new(api.Artifact)
+
+	artifact.SetID(a.ID.Int64)
+	artifact.SetRepoID(a.RepoID.Int64)
+	artifact.SetBuildID(a.BuildID.Int64)
+	artifact.SetName(a.Name.String)
+	artifact.SetKind(a.Kind.String)
+	artifact.SetDigest(a.Digest.String)
+	artifact.SetURI(a.URI.String)
+	artifact.SetCreatedBy(a.CreatedBy.String)
+	artifact.SetCreated(a.Created.Int64)
+
+	return artifact
+}
+
+// Validate verifies the necessary fields for
+// the Artifact type are populated correctly.
+func (a *Artifact) Validate() error {
+	// verify the RepoID field is populated
+	if a.RepoID.Int64 <= 0 {
+		return ErrEmptyArtifactRepoID
+	}
+
+	// verify the BuildID field is populated
+	if a.BuildID.Int64 <= 0 {
+		return ErrEmptyArtifactBuildID
+	}
+
+	// verify the Name field is populated
+	if len(a.Name.String) == 0 {
+		return ErrEmptyArtifactName
+	}
+
+	// verify the Digest field is a valid digest
+	if !digestPattern.MatchString(a.Digest.String) {
+		return ErrInvalidArtifactDigest
+	}
+
+	return nil
+}
+
+// ArtifactFromAPI converts the API Artifact type
+// to a database Artifact type.
+func ArtifactFromAPI(a *api.Artifact) *Artifact {
+	artifact := &Artifact{
+		ID:        sql.NullInt64{Int64: a.GetID(), Valid: true},
+		RepoID:    sql.NullInt64{Int64: a.GetRepoID(), Valid: true},
+		BuildID:   sql.NullInt64{Int64: a.GetBuildID(), Valid: true},
+		Name:      sql.NullString{String: a.GetName(), Valid: true},
+		Kind:      sql.NullString{String: a.GetKind(), Valid: true},
+		Digest:    sql.NullString{String: a.GetDigest(), Valid: true},
+		URI:       sql.NullString{String: a.GetURI(), Valid: true},
+		CreatedBy: sql.NullString{String: a.GetCreatedBy(), Valid: true},
+		Created:   sql.NullInt64{Int64: a.GetCreated(), Valid: true},
+	}
+
+	return artifact.Nullify()
+}
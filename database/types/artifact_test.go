@@ -0,0 +1,195 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestArtifact_Nullify(t *testing.T) {
+	// setup types
+	var a *Artifact
+
+	want := &Artifact{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:    sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:   sql.NullInt64{Int64: 0, Valid: false},
+		Name:      sql.NullString{String: "", Valid: false},
+		Kind:      sql.NullString{String: "", Valid: false},
+		Digest:    sql.NullString{String: "", Valid: false},
+		URI:       sql.NullString{String: "", Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		Created:   sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		artifact *Artifact
+		want     *Artifact
+	}{
+		{
+			artifact: testArtifact(),
+			want:     testArtifact(),
+		},
+		{
+			artifact: a,
+			want:     nil,
+		},
+		{
+			artifact: new(Artifact),
+			want:     want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.artifact.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestArtifact_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Artifact)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetBuildID(1)
+	want.SetName("vela-server")
+	want.SetKind("binary")
+	want.SetDigest("sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1")
+	want.SetURI("https://artifacts.example.com/vela-server")
+	want.SetCreatedBy("octocat")
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testArtifact().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestArtifact_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure  bool
+		artifact *Artifact
+	}{
+		{
+			failure:  false,
+			artifact: testArtifact(),
+		},
+		{ // no RepoID set for Artifact
+			failure: true,
+			artifact: func() *Artifact {
+				a := testArtifact()
+				a.RepoID = sql.NullInt64{}
+
+				return a
+			}(),
+		},
+		{ // no BuildID set for Artifact
+			failure: true,
+			artifact: func() *Artifact {
+				a := testArtifact()
+				a.BuildID = sql.NullInt64{}
+
+				return a
+			}(),
+		},
+		{ // no Name set for Artifact
+			failure: true,
+			artifact: func() *Artifact {
+				a := testArtifact()
+				a.Name = sql.NullString{}
+
+				return a
+			}(),
+		},
+		{ // no Digest set for Artifact
+			failure: true,
+			artifact: func() *Artifact {
+				a := testArtifact()
+				a.Digest = sql.NullString{}
+
+				return a
+			}(),
+		},
+		{ // no algorithm set for Artifact Digest
+			failure: true,
+			artifact: func() *Artifact {
+				a := testArtifact()
+				a.Digest = sql.NullString{String: "9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1", Valid: true}
+
+				return a
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.artifact.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestArtifactFromAPI(t *testing.T) {
+	// setup types
+	a := new(api.Artifact)
+
+	a.SetID(1)
+	a.SetRepoID(1)
+	a.SetBuildID(1)
+	a.SetName("vela-server")
+	a.SetKind("binary")
+	a.SetDigest("sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1")
+	a.SetURI("https://artifacts.example.com/vela-server")
+	a.SetCreatedBy("octocat")
+	a.SetCreated(1563474076)
+
+	want := testArtifact()
+
+	// run test
+	got := ArtifactFromAPI(a)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ArtifactFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testArtifact is a test helper function to create a Artifact
+// type with all fields set to a fake value.
+func testArtifact() *Artifact {
+	return &Artifact{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:    sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:   sql.NullInt64{Int64: 1, Valid: true},
+		Name:      sql.NullString{String: "vela-server", Valid: true},
+		Kind:      sql.NullString{String: "binary", Valid: true},
+		Digest:    sql.NullString{String: "sha256:9b2a3b3b2a9f5b5ac1e4bd9d2f0bd2c5a3bc8fbf0e4de1b0a7d3a3f0c8b0f2a1", Valid: true},
+		URI:       sql.NullString{String: "https://artifacts.example.com/vela-server", Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		Created:   sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyBuildBudgetOrg defines the error type when a
+	// BuildBudget type has an empty Org field provided.
+	ErrEmptyBuildBudgetOrg = errors.New("empty build budget org provided")
+
+	// ErrInvalidBuildBudgetMinutes defines the error type when a
+	// BuildBudget type has a Minutes field that is not positive.
+	ErrInvalidBuildBudgetMinutes = errors.New("invalid build budget minutes provided")
+)
+
+// BuildBudget is the database representation of
+// the monthly budget of build minutes for an org.
+type BuildBudget struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Org       sql.NullString `sql:"org"`
+	Minutes   sql.NullInt64  `sql:"minutes"`
+	Webhook   sql.NullString `sql:"webhook"`
+	Period    sql.NullString `sql:"period"`
+	Alerted   sql.NullInt64  `sql:"alerted"`
+	CreatedAt sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the BuildBudget type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (b *BuildBudget) Nullify() *BuildBudget {
+	if b == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if b.ID.Int64 == 0 {
+		b.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(b.Org.String) == 0 {
+		b.Org.Valid = false
+	}
+
+	// check if the Minutes field should be false
+	if b.Minutes.Int64 == 0 {
+		b.Minutes.Valid = false
+	}
+
+	// check if the Webhook field should be false
+	if len(b.Webhook.String) == 0 {
+		b.Webhook.Valid = false
+	}
+
+	// check if the Period field should be false
+	if len(b.Period.String) == 0 {
+		b.Period.Valid = false
+	}
+
+	// check if the Alerted field should be false
+	if b.Alerted.Int64 == 0 {
+		b.Alerted.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if b.CreatedAt.Int64 == 0 {
+		b.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(b.CreatedBy.String) == 0 {
+		b.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if b.UpdatedAt.Int64 == 0 {
+		b.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(b.UpdatedBy.String) == 0 {
+		b.UpdatedBy.Valid = false
+	}
+
+	return b
+}
+
+// ToAPI converts the BuildBudget type
+// to an API BuildBudget type.
+func (b *BuildBudget) ToAPI() *api.BuildBudget {
+	buildBudget := new(api.BuildBudget)
+
+	buildBudget.SetID(b.ID.Int64)
+	buildBudget.SetOrg(b.Org.String)
+	buildBudget.SetMinutes(b.Minutes.Int64)
+	buildBudget.SetWebhook(b.Webhook.String)
+	buildBudget.SetPeriod(b.Period.String)
+	buildBudget.SetAlerted(b.Alerted.Int64)
+	buildBudget.SetCreatedAt(b.CreatedAt.Int64)
+	buildBudget.SetCreatedBy(b.CreatedBy.String)
+	buildBudget.SetUpdatedAt(b.UpdatedAt.Int64)
+	buildBudget.SetUpdatedBy(b.UpdatedBy.String)
+
+	return buildBudget
+}
+
+// Validate verifies the necessary fields for
+// the BuildBudget type are populated correctly.
+func (b *BuildBudget) Validate() error {
+	// verify the Org field is populated
+	if len(b.Org.String) == 0 {
+		return ErrEmptyBuildBudgetOrg
+	}
+
+	// verify the Minutes field is positive
+	if b.Minutes.Int64 <= 0 {
+		return ErrInvalidBuildBudgetMinutes
+	}
+
+	return nil
+}
+
+// BuildBudgetFromAPI converts the API BuildBudget type
+// to a database BuildBudget type.
+func BuildBudgetFromAPI(b *api.BuildBudget) *BuildBudget {
+	buildBudget := &BuildBudget{
+		ID:        sql.NullInt64{Int64: b.GetID(), Valid: true},
+		Org:       sql.NullString{String: b.GetOrg(), Valid: true},
+		Minutes:   sql.NullInt64{Int64: b.GetMinutes(), Valid: true},
+		Webhook:   sql.NullString{String: b.GetWebhook(), Valid: true},
+		Period:    sql.NullString{String: b.GetPeriod(), Valid: true},
+		Alerted:   sql.NullInt64{Int64: b.GetAlerted(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: b.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: b.GetCreatedBy(), Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: b.GetUpdatedAt(), Valid: true},
+		UpdatedBy: sql.NullString{String: b.GetUpdatedBy(), Valid: true},
+	}
+
+	return buildBudget.Nullify()
+}
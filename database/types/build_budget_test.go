@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestBuildBudget_Nullify(t *testing.T) {
+	// setup types
+	var b *BuildBudget
+
+	want := &BuildBudget{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		Minutes:   sql.NullInt64{Int64: 0, Valid: false},
+		Webhook:   sql.NullString{String: "", Valid: false},
+		Period:    sql.NullString{String: "", Valid: false},
+		Alerted:   sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *BuildBudget
+		want *BuildBudget
+	}{
+		{
+			item: testBuildBudget(),
+			want: testBuildBudget(),
+		},
+		{
+			item: b,
+			want: nil,
+		},
+		{
+			item: new(BuildBudget),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestBuildBudget_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.BuildBudget)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetMinutes(1000)
+	want.SetWebhook("https://alerts.example.com/vela")
+	want.SetPeriod("2023-10")
+	want.SetAlerted(50)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testBuildBudget().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestBuildBudget_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *BuildBudget
+	}{
+		{
+			failure: false,
+			item:    testBuildBudget(),
+		},
+		{ // no Org set for BuildBudget
+			failure: true,
+			item: func() *BuildBudget {
+				b := testBuildBudget()
+				b.Org = sql.NullString{}
+
+				return b
+			}(),
+		},
+		{ // no Minutes set for BuildBudget
+			failure: true,
+			item: func() *BuildBudget {
+				b := testBuildBudget()
+				b.Minutes = sql.NullInt64{}
+
+				return b
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestBuildBudgetFromAPI(t *testing.T) {
+	// setup types
+	b := new(api.BuildBudget)
+
+	b.SetID(1)
+	b.SetOrg("github")
+	b.SetMinutes(1000)
+	b.SetWebhook("https://alerts.example.com/vela")
+	b.SetPeriod("2023-10")
+	b.SetAlerted(50)
+	b.SetCreatedAt(1563474077)
+	b.SetCreatedBy("octocat")
+	b.SetUpdatedAt(1563474077)
+	b.SetUpdatedBy("octocat")
+
+	want := testBuildBudget()
+
+	// run test
+	got := BuildBudgetFromAPI(b)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildBudgetFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testBuildBudget is a test helper function to create a BuildBudget
+// type with all fields set to a fake value.
+func testBuildBudget() *BuildBudget {
+	return &BuildBudget{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Org:       sql.NullString{String: "github", Valid: true},
+		Minutes:   sql.NullInt64{Int64: 1000, Valid: true},
+		Webhook:   sql.NullString{String: "https://alerts.example.com/vela", Valid: true},
+		Period:    sql.NullString{String: "2023-10", Valid: true},
+		Alerted:   sql.NullInt64{Int64: 50, Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy: sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyBuildCredentialsBuildID defines the error type when a
+	// BuildCredentials type has an empty BuildID field provided.
+	ErrEmptyBuildCredentialsBuildID = errors.New("empty build credentials build_id provided")
+
+	// ErrEmptyBuildCredentialsRepoID defines the error type when a
+	// BuildCredentials type has an empty RepoID field provided.
+	ErrEmptyBuildCredentialsRepoID = errors.New("empty build credentials repo_id provided")
+)
+
+// BuildCredentials is the database representation of the registries a build
+// was granted image pull credentials for when it was compiled.
+type BuildCredentials struct {
+	ID         sql.NullInt64  `sql:"id"`
+	BuildID    sql.NullInt64  `sql:"build_id"`
+	RepoID     sql.NullInt64  `sql:"repo_id"`
+	Registries pq.StringArray `sql:"registries" gorm:"type:varchar(1000)"`
+	Created    sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the BuildCredentials type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (b *BuildCredentials) Nullify() *BuildCredentials {
+	if b == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if b.ID.Int64 == 0 {
+		b.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if b.BuildID.Int64 == 0 {
+		b.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if b.RepoID.Int64 == 0 {
+		b.RepoID.Valid = false
+	}
+
+	// check if the Created field should be false
+	if b.Created.Int64 == 0 {
+		b.Created.Valid = false
+	}
+
+	return b
+}
+
+// ToAPI converts the BuildCredentials type
+// to an API BuildCredentials type.
+func (b *BuildCredentials) ToAPI() *api.BuildCredentials {
+	buildCredentials := new(api.BuildCredentials)
+
+	buildCredentials.SetID(b.ID.Int64)
+	buildCredentials.SetBuildID(b.BuildID.Int64)
+	buildCredentials.SetRepoID(b.RepoID.Int64)
+	buildCredentials.SetRegistries(b.Registries)
+	buildCredentials.SetCreated(b.Created.Int64)
+
+	return buildCredentials
+}
+
+// Validate verifies the necessary fields for
+// the BuildCredentials type are populated correctly.
+func (b *BuildCredentials) Validate() error {
+	// verify the BuildID field is populated
+	if b.BuildID.Int64 <= 0 {
+		return ErrEmptyBuildCredentialsBuildID
+	}
+
+	// verify the RepoID field is populated
+	if b.RepoID.Int64 <= 0 {
+		return ErrEmptyBuildCredentialsRepoID
+	}
+
+	return nil
+}
+
+// BuildCredentialsFromAPI converts the API BuildCredentials type
+// to a database BuildCredentials type.
+func BuildCredentialsFromAPI(b *api.BuildCredentials) *BuildCredentials {
+	buildCredentials := &BuildCredentials{
+		ID:         sql.NullInt64{Int64: b.GetID(), Valid: true},
+		BuildID:    sql.NullInt64{Int64: b.GetBuildID(), Valid: true},
+		RepoID:     sql.NullInt64{Int64: b.GetRepoID(), Valid: true},
+		Registries: pq.StringArray(b.GetRegistries()),
+		Created:    sql.NullInt64{Int64: b.GetCreated(), Valid: true},
+	}
+
+	return buildCredentials.Nullify()
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestBuildCredentials_Nullify(t *testing.T) {
+	// setup types
+	var b *BuildCredentials
+
+	want := &BuildCredentials{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID: sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:  sql.NullInt64{Int64: 0, Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *BuildCredentials
+		want *BuildCredentials
+	}{
+		{
+			item: testBuildCredentials(),
+			want: testBuildCredentials(),
+		},
+		{
+			item: b,
+			want: nil,
+		},
+		{
+			item: new(BuildCredentials),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestBuildCredentials_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.BuildCredentials)
+
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetRegistries([]string{"ghcr.io"})
+	want.SetCreated(1563474077)
+
+	// run test
+	got := testBuildCredentials().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestBuildCredentials_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *BuildCredentials
+	}{
+		{
+			failure: false,
+			item:    testBuildCredentials(),
+		},
+		{ // no BuildID set for BuildCredentials
+			failure: true,
+			item: func() *BuildCredentials {
+				b := testBuildCredentials()
+				b.BuildID = sql.NullInt64{}
+
+				return b
+			}(),
+		},
+		{ // no RepoID set for BuildCredentials
+			failure: true,
+			item: func() *BuildCredentials {
+				b := testBuildCredentials()
+				b.RepoID = sql.NullInt64{}
+
+				return b
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestBuildCredentialsFromAPI(t *testing.T) {
+	// setup types
+	b := new(api.BuildCredentials)
+
+	b.SetID(1)
+	b.SetBuildID(1)
+	b.SetRepoID(1)
+	b.SetRegistries([]string{"ghcr.io"})
+	b.SetCreated(1563474077)
+
+	want := testBuildCredentials()
+
+	// run test
+	got := BuildCredentialsFromAPI(b)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildCredentialsFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testBuildCredentials is a test helper function to create a BuildCredentials
+// type with all fields set to a fake value.
+func testBuildCredentials() *BuildCredentials {
+	return &BuildCredentials{
+		ID:         sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:    sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+		Registries: []string{"ghcr.io"},
+		Created:    sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyBuildDeprecationsBuildID defines the error type when a
+	// BuildDeprecations type has an empty BuildID field provided.
+	ErrEmptyBuildDeprecationsBuildID = errors.New("empty build deprecations build_id provided")
+
+	// ErrEmptyBuildDeprecationsRepoID defines the error type when a
+	// BuildDeprecations type has an empty RepoID field provided.
+	ErrEmptyBuildDeprecationsRepoID = errors.New("empty build deprecations repo_id provided")
+)
+
+// BuildDeprecations is the database representation of the warnings for
+// the deprecated images, templates and syntax used by a build when it was compiled.
+type BuildDeprecations struct {
+	ID       sql.NullInt64  `sql:"id"`
+	BuildID  sql.NullInt64  `sql:"build_id"`
+	RepoID   sql.NullInt64  `sql:"repo_id"`
+	Warnings pq.StringArray `sql:"warnings" gorm:"type:varchar(5000)"`
+	Created  sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the BuildDeprecations type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (b *BuildDeprecations) Nullify() *BuildDeprecations {
+	if b == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if b.ID.Int64 == 0 {
+		b.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if b.BuildID.Int64 == 0 {
+		b.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if b.RepoID.Int64 == 0 {
+		b.RepoID.Valid = false
+	}
+
+	// check if the Created field should be false
+	if b.Created.Int64 == 0 {
+		b.Created.Valid = false
+	}
+
+	return b
+}
+
+// ToAPI converts the BuildDeprecations type
+// to an API BuildDeprecations type.
+func (b *BuildDeprecations) ToAPI() *api.BuildDeprecations {
+	buildDeprecations := new(api.BuildDeprecations)
+
+	buildDeprecations.SetID(b.ID.Int64)
+	buildDeprecations.SetBuildID(b.BuildID.Int64)
+	buildDeprecations.SetRepoID(b.RepoID.Int64)
+	buildDeprecations.SetWarnings(b.Warnings)
+	buildDeprecations.SetCreated(b.Created.Int64)
+
+	return buildDeprecations
+}
+
+// Validate verifies the necessary fields for
+// the BuildDeprecations type are populated correctly.
+func (b *BuildDeprecations) Validate() error {
+	// verify the BuildID field is populated
+	if b.BuildID.Int64 <= 0 {
+		return ErrEmptyBuildDeprecationsBuildID
+	}
+
+	// verify the RepoID field is populated
+	if b.RepoID.Int64 <= 0 {
+		return ErrEmptyBuildDeprecationsRepoID
+	}
+
+	return nil
+}
+
+// BuildDeprecationsFromAPI converts the API BuildDeprecations type
+// to a database BuildDeprecations type.
+func BuildDeprecationsFromAPI(b *api.BuildDeprecations) *BuildDeprecations {
+	buildDeprecations := &BuildDeprecations{
+		ID:       sql.NullInt64{Int64: b.GetID(), Valid: true},
+		BuildID:  sql.NullInt64{Int64: b.GetBuildID(), Valid: true},
+		RepoID:   sql.NullInt64{Int64: b.GetRepoID(), Valid: true},
+		Warnings: pq.StringArray(b.GetWarnings()),
+		Created:  sql.NullInt64{Int64: b.GetCreated(), Valid: true},
+	}
+
+	return buildDeprecations.Nullify()
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestBuildDeprecations_Nullify(t *testing.T) {
+	// setup types
+	var b *BuildDeprecations
+
+	want := &BuildDeprecations{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID: sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:  sql.NullInt64{Int64: 0, Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *BuildDeprecations
+		want *BuildDeprecations
+	}{
+		{
+			item: testBuildDeprecations(),
+			want: testBuildDeprecations(),
+		},
+		{
+			item: b,
+			want: nil,
+		},
+		{
+			item: new(BuildDeprecations),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestBuildDeprecations_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.BuildDeprecations)
+
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetWarnings([]string{"image golang:1.15 is deprecated"})
+	want.SetCreated(1563474077)
+
+	// run test
+	got := testBuildDeprecations().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestBuildDeprecations_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *BuildDeprecations
+	}{
+		{
+			failure: false,
+			item:    testBuildDeprecations(),
+		},
+		{ // no BuildID set for BuildDeprecations
+			failure: true,
+			item: func() *BuildDeprecations {
+				b := testBuildDeprecations()
+				b.BuildID = sql.NullInt64{}
+
+				return b
+			}(),
+		},
+		{ // no RepoID set for BuildDeprecations
+			failure: true,
+			item: func() *BuildDeprecations {
+				b := testBuildDeprecations()
+				b.RepoID = sql.NullInt64{}
+
+				return b
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestBuildDeprecationsFromAPI(t *testing.T) {
+	// setup types
+	b := new(api.BuildDeprecations)
+
+	b.SetID(1)
+	b.SetBuildID(1)
+	b.SetRepoID(1)
+	b.SetWarnings([]string{"image golang:1.15 is deprecated"})
+	b.SetCreated(1563474077)
+
+	want := testBuildDeprecations()
+
+	// run test
+	got := BuildDeprecationsFromAPI(b)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildDeprecationsFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testBuildDeprecations is a test helper function to create a BuildDeprecations
+// type with all fields set to a fake value.
+func testBuildDeprecations() *BuildDeprecations {
+	return &BuildDeprecations{
+		ID:       sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:  sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:   sql.NullInt64{Int64: 1, Valid: true},
+		Warnings: []string{"image golang:1.15 is deprecated"},
+		Created:  sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
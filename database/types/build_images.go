@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyBuildImagesBuildID defines the error type when a
+	// BuildImages type has an empty BuildID field provided.
+	ErrEmptyBuildImagesBuildID = errors.New("empty build images build_id provided")
+
+	// ErrEmptyBuildImagesRepoID defines the error type when a
+	// BuildImages type has an empty RepoID field provided.
+	ErrEmptyBuildImagesRepoID = errors.New("empty build images repo_id provided")
+)
+
+// BuildImages is the database representation of the images of a build
+// pinned to the digests they resolved to when it was compiled.
+type BuildImages struct {
+	ID      sql.NullInt64  `sql:"id"`
+	BuildID sql.NullInt64  `sql:"build_id"`
+	RepoID  sql.NullInt64  `sql:"repo_id"`
+	Images  pq.StringArray `sql:"images" gorm:"type:varchar(5000)"`
+	Created sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the BuildImages type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (b *BuildImages) Nullify() *BuildImages {
+	if b == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if b.ID.Int64 == 0 {
+		b.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if b.BuildID.Int64 == 0 {
+		b.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if b.RepoID.Int64 == 0 {
+		b.RepoID.Valid = false
+	}
+
+	// check if the Created field should be false
+	if b.Created.Int64 == 0 {
+		b.Created.Valid = false
+	}
+
+	return b
+}
+
+// ToAPI converts the BuildImages type
+// to an API BuildImages type.
+func (b *BuildImages) ToAPI() *api.BuildImages {
+	buildImages := new(api.BuildImages)
+
+	buildImages.SetID(b.ID.Int64)
+	buildImages.SetBuildID(b.BuildID.Int64)
+	buildImages.SetRepoID(b.RepoID.Int64)
+	buildImages.SetImages(b.Images)
+	buildImages.SetCreated(b.Created.Int64)
+
+	return buildImages
+}
+
+// Validate verifies the necessary fields for
+// the BuildImages type are populated correctly.
+func (b *BuildImages) Validate() error {
+	// verify the BuildID field is populated
+	if b.BuildID.Int64 <= 0 {
+		return ErrEmptyBuildImagesBuildID
+	}
+
+	// verify the RepoID field is populated
+	if b.RepoID.Int64 <= 0 {
+		return ErrEmptyBuildImagesRepoID
+	}
+
+	return nil
+}
+
+// BuildImagesFromAPI converts the API BuildImages type
+// to a database BuildImages type.
+func BuildImagesFromAPI(b *api.BuildImages) *BuildImages {
+	buildImages := &BuildImages{
+		ID:      sql.NullInt64{Int64: b.GetID(), Valid: true},
+		BuildID: sql.NullInt64{Int64: b.GetBuildID(), Valid: true},
+		RepoID:  sql.NullInt64{Int64: b.GetRepoID(), Valid: true},
+		Images:  pq.StringArray(b.GetImages()),
+		Created: sql.NullInt64{Int64: b.GetCreated(), Valid: true},
+	}
+
+	return buildImages.Nullify()
+}
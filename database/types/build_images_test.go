@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestBuildImages_Nullify(t *testing.T) {
+	// setup types
+	var b *BuildImages
+
+	want := &BuildImages{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID: sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:  sql.NullInt64{Int64: 0, Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *BuildImages
+		want *BuildImages
+	}{
+		{
+			item: testBuildImages(),
+			want: testBuildImages(),
+		},
+		{
+			item: b,
+			want: nil,
+		},
+		{
+			item: new(BuildImages),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestBuildImages_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.BuildImages)
+
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetImages([]string{"alpine:latest@sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b"})
+	want.SetCreated(1563474077)
+
+	// run test
+	got := testBuildImages().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestBuildImages_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *BuildImages
+	}{
+		{
+			failure: false,
+			item:    testBuildImages(),
+		},
+		{ // no BuildID set for BuildImages
+			failure: true,
+			item: func() *BuildImages {
+				b := testBuildImages()
+				b.BuildID = sql.NullInt64{}
+
+				return b
+			}(),
+		},
+		{ // no RepoID set for BuildImages
+			failure: true,
+			item: func() *BuildImages {
+				b := testBuildImages()
+				b.RepoID = sql.NullInt64{}
+
+				return b
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestBuildImagesFromAPI(t *testing.T) {
+	// setup types
+	b := new(api.BuildImages)
+
+	b.SetID(1)
+	b.SetBuildID(1)
+	b.SetRepoID(1)
+	b.SetImages([]string{"alpine:latest@sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b"})
+	b.SetCreated(1563474077)
+
+	want := testBuildImages()
+
+	// run test
+	got := BuildImagesFromAPI(b)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildImagesFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testBuildImages is a test helper function to create a BuildImages
+// type with all fields set to a fake value.
+func testBuildImages() *BuildImages {
+	return &BuildImages{
+		ID:      sql.NullInt64{Int64: 1, Valid: true},
+		BuildID: sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+		Images:  []string{"alpine:latest@sha256:c5b1261d6d3e43071626931fc004f70149baeba2c8ec672bd4f27761f8e1ad6b"},
+		Created: sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyBuildLabelsBuildID defines the error type when a
+	// BuildLabels type has an empty BuildID field provided.
+	ErrEmptyBuildLabelsBuildID = errors.New("empty build labels build_id provided")
+
+	// ErrEmptyBuildLabelsRepoID defines the error type when a
+	// BuildLabels type has an empty RepoID field provided.
+	ErrEmptyBuildLabelsRepoID = errors.New("empty build labels repo_id provided")
+)
+
+// BuildLabels is the database representation of the pull request labels
+// captured when a build was created.
+type BuildLabels struct {
+	ID      sql.NullInt64  `sql:"id"`
+	BuildID sql.NullInt64  `sql:"build_id"`
+	RepoID  sql.NullInt64  `sql:"repo_id"`
+	Action  sql.NullString `sql:"action"`
+	Labels  pq.StringArray `sql:"labels" gorm:"type:varchar(1000)"`
+	Created sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the BuildLabels type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (l *BuildLabels) Nullify() *BuildLabels {
+	if l == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if l.ID.Int64 == 0 {
+		l.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if l.BuildID.Int64 == 0 {
+		l.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if l.RepoID.Int64 == 0 {
+		l.RepoID.Valid = false
+	}
+
+	// check if the Action field should be false
+	if len(l.Action.String) == 0 {
+		l.Action.Valid = false
+	}
+
+	// check if the Created field should be false
+	if l.Created.Int64 == 0 {
+		l.Created.Valid = false
+	}
+
+	return l
+}
+
+// ToAPI converts the BuildLabels type
+// to an API BuildLabels type.
+func (l *BuildLabels) ToAPI() *api.BuildLabels {
+	buildLabels := new(api.BuildLabels)
+
+	buildLabels.SetID(l.ID.Int64)
+	buildLabels.SetBuildID(l.BuildID.Int64)
+	buildLabels.SetRepoID(l.RepoID.Int64)
+	buildLabels.SetAction(l.Action.String)
+	buildLabels.SetLabels(l.Labels)
+	buildLabels.SetCreated(l.Created.Int64)
+
+	return buildLabels
+}
+
+// Validate verifies the necessary fields for
+// the BuildLabels type are populated correctly.
+func (l *BuildLabels) Validate() error {
+	// verify the BuildID field is populated
+	if l.BuildID.Int64 <= 0 {
+		return ErrEmptyBuildLabelsBuildID
+	}
+
+	// verify the RepoID field is populated
+	if l.RepoID.Int64 <= 0 {
+		return ErrEmptyBuildLabelsRepoID
+	}
+
+	return nil
+}
+
+// BuildLabelsFromAPI converts the API BuildLabels type
+// to a database BuildLabels type.
+func BuildLabelsFromAPI(l *api.BuildLabels) *BuildLabels {
+	buildLabels := &BuildLabels{
+		ID:      sql.NullInt64{Int64: l.GetID(), Valid: true},
+		BuildID: sql.NullInt64{Int64: l.GetBuildID(), Valid: true},
+		RepoID:  sql.NullInt64{Int64: l.GetRepoID(), Valid: true},
+		Action:  sql.NullString{String: l.GetAction(), Valid: true},
+		Labels:  pq.StringArray(l.GetLabels()),
+		Created: sql.NullInt64{Int64: l.GetCreated(), Valid: true},
+	}
+
+	return buildLabels.Nullify()
+}
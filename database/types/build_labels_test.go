@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestBuildLabels_Nullify(t *testing.T) {
+	// setup types
+	var l *BuildLabels
+
+	want := &BuildLabels{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID: sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:  sql.NullInt64{Int64: 0, Valid: false},
+		Action:  sql.NullString{String: "", Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *BuildLabels
+		want *BuildLabels
+	}{
+		{
+			item: testBuildLabels(),
+			want: testBuildLabels(),
+		},
+		{
+			item: l,
+			want: nil,
+		},
+		{
+			item: new(BuildLabels),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestBuildLabels_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.BuildLabels)
+
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetAction("labeled")
+	want.SetLabels([]string{"deploy-preview"})
+	want.SetCreated(1563474077)
+
+	// run test
+	got := testBuildLabels().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestBuildLabels_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *BuildLabels
+	}{
+		{
+			failure: false,
+			item:    testBuildLabels(),
+		},
+		{ // no BuildID set for BuildLabels
+			failure: true,
+			item: func() *BuildLabels {
+				l := testBuildLabels()
+				l.BuildID = sql.NullInt64{}
+
+				return l
+			}(),
+		},
+		{ // no RepoID set for BuildLabels
+			failure: true,
+			item: func() *BuildLabels {
+				l := testBuildLabels()
+				l.RepoID = sql.NullInt64{}
+
+				return l
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestBuildLabelsFromAPI(t *testing.T) {
+	// setup types
+	l := new(api.BuildLabels)
+
+	l.SetID(1)
+	l.SetBuildID(1)
+	l.SetRepoID(1)
+	l.SetAction("labeled")
+	l.SetLabels([]string{"deploy-preview"})
+	l.SetCreated(1563474077)
+
+	want := testBuildLabels()
+
+	// run test
+	got := BuildLabelsFromAPI(l)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildLabelsFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testBuildLabels is a test helper function to create a BuildLabels
+// type with all fields set to a fake value.
+func testBuildLabels() *BuildLabels {
+	return &BuildLabels{
+		ID:      sql.NullInt64{Int64: 1, Valid: true},
+		BuildID: sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+		Action:  sql.NullString{String: "labeled", Valid: true},
+		Labels:  []string{"deploy-preview"},
+		Created: sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
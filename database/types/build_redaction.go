@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyBuildRedactionBuildID defines the error type when a
+	// BuildRedaction type has an empty BuildID field provided.
+	ErrEmptyBuildRedactionBuildID = errors.New("empty build redaction build_id provided")
+
+	// ErrInvalidBuildRedactionAction defines the error type when a
+	// BuildRedaction type has an invalid Action field provided.
+	ErrInvalidBuildRedactionAction = errors.New("invalid build redaction action provided")
+
+	// ErrInvalidBuildRedactionTarget defines the error type when a
+	// BuildRedaction type has an invalid Targets field provided.
+	ErrInvalidBuildRedactionTarget = errors.New("invalid build redaction target provided")
+)
+
+// BuildRedaction is the database representation of a record
+// of the data captured for a build being redacted or deleted.
+//
+// The values that were redacted are never stored.
+type BuildRedaction struct {
+	ID      sql.NullInt64  `sql:"id"`
+	RepoID  sql.NullInt64  `sql:"repo_id"`
+	BuildID sql.NullInt64  `sql:"build_id"`
+	Action  sql.NullString `sql:"action"`
+	Targets pq.StringArray `sql:"targets" gorm:"type:varchar(1000)"`
+	Reason  sql.NullString `sql:"reason"`
+	Matches sql.NullInt64  `sql:"matches"`
+	Actor   sql.NullString `sql:"actor"`
+	Created sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the BuildRedaction type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *BuildRedaction) Nullify() *BuildRedaction {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if r.RepoID.Int64 == 0 {
+		r.RepoID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if r.BuildID.Int64 == 0 {
+		r.BuildID.Valid = false
+	}
+
+	// check if the Action field should be false
+	if len(r.Action.String) == 0 {
+		r.Action.Valid = false
+	}
+
+	// check if the Reason field should be false
+	if len(r.Reason.String) == 0 {
+		r.Reason.Valid = false
+	}
+
+	// check if the Actor field should be false
+	if len(r.Actor.String) == 0 {
+		r.Actor.Valid = false
+	}
+
+	// check if the Created field should be false
+	if r.Created.Int64 == 0 {
+		r.Created.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the BuildRedaction type
+// to an API BuildRedaction type.
+func (r *BuildRedaction) ToAPI() *api.BuildRedaction {
+	redaction := new(api.BuildRedaction)
+
+	redaction.SetID(r.ID.Int64)
+	redaction.SetRepoID(r.RepoID.Int64)
+	redaction.SetBuildID(r.BuildID.Int64)
+	redaction.SetAction(r.Action.String)
+	redaction.SetTargets(r.Targets)
+	redaction.SetReason(r.Reason.String)
+	redaction.SetMatches(r.Matches.Int64)
+	redaction.SetActor(r.Actor.String)
+	redaction.SetCreated(r.Created.Int64)
+
+	return redaction
+}
+
+// Validate verifies the necessary fields for
+// the BuildRedaction type are populated correctly.
+func (r *BuildRedaction) Validate() error {
+	// verify the BuildID field is populated
+	if r.BuildID.Int64 <= 0 {
+		return ErrEmptyBuildRedactionBuildID
+	}
+
+	// verify the Action field is populated with a supported action
+	switch r.Action.String {
+	case api.BuildRedactionRedacted, api.BuildRedactionDeleted:
+	default:
+		return ErrInvalidBuildRedactionAction
+	}
+
+	// verify the Targets field is populated with supported targets
+	if len(r.Targets) == 0 {
+		return ErrInvalidBuildRedactionTarget
+	}
+
+	for _, target := range r.Targets {
+		switch target {
+		case api.BuildRedactionTargetLogs, api.BuildRedactionTargetArtifacts, api.BuildRedactionTargetEnvironment:
+		default:
+			return ErrInvalidBuildRedactionTarget
+		}
+	}
+
+	return nil
+}
+
+// BuildRedactionFromAPI converts the API BuildRedaction type
+// to a database BuildRedaction type.
+func BuildRedactionFromAPI(r *api.BuildRedaction) *BuildRedaction {
+	redaction := &BuildRedaction{
+		ID:      sql.NullInt64{Int64: r.GetID(), Valid: true},
+		RepoID:  sql.NullInt64{Int64: r.GetRepoID(), Valid: true},
+		BuildID: sql.NullInt64{Int64: r.GetBuildID(), Valid: true},
+		Action:  sql.NullString{String: r.GetAction(), Valid: true},
+		Targets: pq.StringArray(r.GetTargets()),
+		Reason:  sql.NullString{String: r.GetReason(), Valid: true},
+		Matches: sql.NullInt64{Int64: r.GetMatches(), Valid: true},
+		Actor:   sql.NullString{String: r.GetActor(), Valid: true},
+		Created: sql.NullInt64{Int64: r.GetCreated(), Valid: true},
+	}
+
+	return redaction.Nullify()
+}
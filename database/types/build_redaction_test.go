@@ -0,0 +1,187 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+func TestBuildRedaction_Nullify(t *testing.T) {
+	// setup types
+	var r *BuildRedaction
+
+	want := &BuildRedaction{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:  sql.NullInt64{Int64: 0, Valid: false},
+		BuildID: sql.NullInt64{Int64: 0, Valid: false},
+		Action:  sql.NullString{String: "", Valid: false},
+		Reason:  sql.NullString{String: "", Valid: false},
+		Matches: sql.NullInt64{Int64: 0, Valid: false},
+		Actor:   sql.NullString{String: "", Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *BuildRedaction
+		want *BuildRedaction
+	}{
+		{
+			item: testBuildRedaction(),
+			want: testBuildRedaction(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(BuildRedaction),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestBuildRedaction_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.BuildRedaction)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetBuildID(1)
+	want.SetAction("redacted")
+	want.SetTargets([]string{"logs", "environment"})
+	want.SetReason("token printed by debug step")
+	want.SetMatches(2)
+	want.SetActor("octocat")
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testBuildRedaction().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestBuildRedaction_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *BuildRedaction
+	}{
+		{
+			failure: false,
+			item:    testBuildRedaction(),
+		},
+		{ // no BuildID set for BuildRedaction
+			failure: true,
+			item: func() *BuildRedaction {
+				r := testBuildRedaction()
+				r.BuildID = sql.NullInt64{}
+
+				return r
+			}(),
+		},
+		{ // invalid Action set for BuildRedaction
+			failure: true,
+			item: func() *BuildRedaction {
+				r := testBuildRedaction()
+				r.Action = sql.NullString{String: "updated", Valid: true}
+
+				return r
+			}(),
+		},
+		{ // no Targets set for BuildRedaction
+			failure: true,
+			item: func() *BuildRedaction {
+				r := testBuildRedaction()
+				r.Targets = nil
+
+				return r
+			}(),
+		},
+		{ // invalid Targets set for BuildRedaction
+			failure: true,
+			item: func() *BuildRedaction {
+				r := testBuildRedaction()
+				r.Targets = pq.StringArray{"logs", "secrets"}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestBuildRedactionFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.BuildRedaction)
+
+	r.SetID(1)
+	r.SetRepoID(1)
+	r.SetBuildID(1)
+	r.SetAction("redacted")
+	r.SetTargets([]string{"logs", "environment"})
+	r.SetValues([]string{"hunter2"})
+	r.SetReason("token printed by debug step")
+	r.SetMatches(2)
+	r.SetActor("octocat")
+	r.SetCreated(1563474076)
+
+	want := testBuildRedaction()
+
+	// run test
+	got := BuildRedactionFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildRedactionFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testBuildRedaction is a test helper function to create a BuildRedaction
+// type with all fields set to a fake value.
+func testBuildRedaction() *BuildRedaction {
+	return &BuildRedaction{
+		ID:      sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+		BuildID: sql.NullInt64{Int64: 1, Valid: true},
+		Action:  sql.NullString{String: "redacted", Valid: true},
+		Targets: pq.StringArray{"logs", "environment"},
+		Reason:  sql.NullString{String: "token printed by debug step", Valid: true},
+		Matches: sql.NullInt64{Int64: 2, Valid: true},
+		Actor:   sql.NullString{String: "octocat", Valid: true},
+		Created: sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyCanaryRunRoute defines the error type when a
+	// CanaryRun type has an empty Route field provided.
+	ErrEmptyCanaryRunRoute = errors.New("empty canary run route provided")
+)
+
+// CanaryRun is the database representation of a synthetic build run through
+// a route to verify the workers for the route are healthy.
+type CanaryRun struct {
+	ID          sql.NullInt64  `sql:"id"`
+	Route       sql.NullString `sql:"route"`
+	WorkerGroup sql.NullString `sql:"worker_group"`
+	RepoID      sql.NullInt64  `sql:"repo_id"`
+	BuildID     sql.NullInt64  `sql:"build_id"`
+	Status      sql.NullString `sql:"status"`
+	Error       sql.NullString `sql:"error"`
+	Created     sql.NullInt64  `sql:"created"`
+	Finished    sql.NullInt64  `sql:"finished"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the CanaryRun type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *CanaryRun) Nullify() *CanaryRun {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the Route field should be false
+	if len(r.Route.String) == 0 {
+		r.Route.Valid = false
+	}
+
+	// check if the WorkerGroup field should be false
+	if len(r.WorkerGroup.String) == 0 {
+		r.WorkerGroup.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if r.RepoID.Int64 == 0 {
+		r.RepoID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if r.BuildID.Int64 == 0 {
+		r.BuildID.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(r.Status.String) == 0 {
+		r.Status.Valid = false
+	}
+
+	// check if the Error field should be false
+	if len(r.Error.String) == 0 {
+		r.Error.Valid = false
+	}
+
+	// check if the Created field should be false
+	if r.Created.Int64 == 0 {
+		r.Created.Valid = false
+	}
+
+	// check if the Finished field should be false
+	if r.Finished.Int64 == 0 {
+		r.Finished.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the CanaryRun type
+// to an API CanaryRun type.
+func (r *CanaryRun) ToAPI() *api.CanaryRun {
+	canaryRun := new(api.CanaryRun)
+
+	canaryRun.SetID(r.ID.Int64)
+	canaryRun.SetRoute(r.Route.String)
+	canaryRun.SetWorkerGroup(r.WorkerGroup.String)
+	canaryRun.SetRepoID(r.RepoID.Int64)
+	canaryRun.SetBuildID(r.BuildID.Int64)
+	canaryRun.SetStatus(r.Status.String)
+	canaryRun.SetError(r.Error.String)
+	canaryRun.SetCreated(r.Created.Int64)
+	canaryRun.SetFinished(r.Finished.Int64)
+
+	return canaryRun
+}
+
+// Validate verifies the necessary fields for
+// the CanaryRun type are populated correctly.
+func (r *CanaryRun) Validate() error {
+	// verify the Route field is populated
+	if len(r.Route.String) == 0 {
+		return ErrEmptyCanaryRunRoute
+	}
+
+	// ensure that all CanaryRun string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	r.Error = sql.NullString{String: sanitize(r.Error.String), Valid: r.Error.Valid}
+
+	return nil
+}
+
+// CanaryRunFromAPI converts the API CanaryRun type
+// to a database CanaryRun type.
+func CanaryRunFromAPI(r *api.CanaryRun) *CanaryRun {
+	canaryRun := &CanaryRun{
+		ID:          sql.NullInt64{Int64: r.GetID(), Valid: true},
+		Route:       sql.NullString{String: r.GetRoute(), Valid: true},
+		WorkerGroup: sql.NullString{String: r.GetWorkerGroup(), Valid: true},
+		RepoID:      sql.NullInt64{Int64: r.GetRepoID(), Valid: true},
+		BuildID:     sql.NullInt64{Int64: r.GetBuildID(), Valid: true},
+		Status:      sql.NullString{String: r.GetStatus(), Valid: true},
+		Error:       sql.NullString{String: r.GetError(), Valid: true},
+		Created:     sql.NullInt64{Int64: r.GetCreated(), Valid: true},
+		Finished:    sql.NullInt64{Int64: r.GetFinished(), Valid: true},
+	}
+
+	return canaryRun.Nullify()
+}
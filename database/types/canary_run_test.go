@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCanaryRun_Nullify(t *testing.T) {
+	// setup types
+	var r *CanaryRun
+
+	want := &CanaryRun{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		Route:       sql.NullString{String: "", Valid: false},
+		WorkerGroup: sql.NullString{String: "", Valid: false},
+		RepoID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:     sql.NullInt64{Int64: 0, Valid: false},
+		Status:      sql.NullString{String: "", Valid: false},
+		Error:       sql.NullString{String: "", Valid: false},
+		Created:     sql.NullInt64{Int64: 0, Valid: false},
+		Finished:    sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *CanaryRun
+		want *CanaryRun
+	}{
+		{
+			item: testCanaryRun(),
+			want: testCanaryRun(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(CanaryRun),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestCanaryRun_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.CanaryRun)
+
+	want.SetID(1)
+	want.SetRoute("vela")
+	want.SetWorkerGroup("compliance")
+	want.SetRepoID(1)
+	want.SetBuildID(1)
+	want.SetStatus("success")
+	want.SetError("")
+	want.SetCreated(1563474077)
+	want.SetFinished(1563474087)
+
+	// run test
+	got := testCanaryRun().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestCanaryRun_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *CanaryRun
+	}{
+		{
+			failure: false,
+			item:    testCanaryRun(),
+		},
+		{ // no Route set for CanaryRun
+			failure: true,
+			item: func() *CanaryRun {
+				r := testCanaryRun()
+				r.Route = sql.NullString{}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestCanaryRunFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.CanaryRun)
+
+	r.SetID(1)
+	r.SetRoute("vela")
+	r.SetWorkerGroup("compliance")
+	r.SetRepoID(1)
+	r.SetBuildID(1)
+	r.SetStatus("success")
+	r.SetError("")
+	r.SetCreated(1563474077)
+	r.SetFinished(1563474087)
+
+	want := testCanaryRun()
+
+	// run test
+	got := CanaryRunFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CanaryRunFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testCanaryRun is a test helper function to create a CanaryRun
+// type with all fields set to a fake value.
+func testCanaryRun() *CanaryRun {
+	return &CanaryRun{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		Route:       sql.NullString{String: "vela", Valid: true},
+		WorkerGroup: sql.NullString{String: "compliance", Valid: true},
+		RepoID:      sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:     sql.NullInt64{Int64: 1, Valid: true},
+		Status:      sql.NullString{String: "success", Valid: true},
+		Error:       sql.NullString{String: "", Valid: false},
+		Created:     sql.NullInt64{Int64: 1563474077, Valid: true},
+		Finished:    sql.NullInt64{Int64: 1563474087, Valid: true},
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// CapacitySnapshot is the database representation of the capacity of the worker
+// fleet compared to the builds it was running at a point in time.
+type CapacitySnapshot struct {
+	ID              sql.NullInt64   `sql:"id"`
+	ActiveWorkers   sql.NullInt64   `sql:"active_workers"`
+	InactiveWorkers sql.NullInt64   `sql:"inactive_workers"`
+	BuildLimit      sql.NullInt64   `sql:"build_limit"`
+	Running         sql.NullInt64   `sql:"running"`
+	Pending         sql.NullInt64   `sql:"pending"`
+	Utilization     sql.NullFloat64 `sql:"utilization"`
+	Created         sql.NullInt64   `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the CapacitySnapshot type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *CapacitySnapshot) Nullify() *CapacitySnapshot {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the ActiveWorkers field should be false
+	if s.ActiveWorkers.Int64 == 0 {
+		s.ActiveWorkers.Valid = false
+	}
+
+	// check if the InactiveWorkers field should be false
+	if s.InactiveWorkers.Int64 == 0 {
+		s.InactiveWorkers.Valid = false
+	}
+
+	// check if the BuildLimit field should be false
+	if s.BuildLimit.Int64 == 0 {
+		s.BuildLimit.Valid = false
+	}
+
+	// check if the Running field should be false
+	if s.Running.Int64 == 0 {
+		s.Running.Valid = false
+	}
+
+	// check if the Pending field should be false
+	if s.Pending.Int64 == 0 {
+		s.Pending.Valid = false
+	}
+
+	// check if the Utilization field should be false
+	if s.Utilization.Float64 == 0 {
+		s.Utilization.Valid = false
+	}
+
+	// check if the Created field should be false
+	if s.Created.Int64 == 0 {
+		s.Created.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the CapacitySnapshot type
+// to an API CapacitySnapshot type.
+func (s *CapacitySnapshot) ToAPI() *api.CapacitySnapshot {
+	capacitySnapshot := new(api.CapacitySnapshot)
+
+	capacitySnapshot.SetID(s.ID.Int64)
+	capacitySnapshot.SetActiveWorkers(s.ActiveWorkers.Int64)
+	capacitySnapshot.SetInactiveWorkers(s.InactiveWorkers.Int64)
+	capacitySnapshot.SetBuildLimit(s.BuildLimit.Int64)
+	capacitySnapshot.SetRunning(s.Running.Int64)
+	capacitySnapshot.SetPending(s.Pending.Int64)
+	capacitySnapshot.SetUtilization(s.Utilization.Float64)
+	capacitySnapshot.SetCreated(s.Created.Int64)
+
+	return capacitySnapshot
+}
+
+// Validate verifies the necessary fields for
+// the CapacitySnapshot type are populated correctly.
+func (s *CapacitySnapshot) Validate() error {
+	return nil
+}
+
+// CapacitySnapshotFromAPI converts the API CapacitySnapshot type
+// to a database CapacitySnapshot type.
+func CapacitySnapshotFromAPI(s *api.CapacitySnapshot) *CapacitySnapshot {
+	capacitySnapshot := &CapacitySnapshot{
+		ID:              sql.NullInt64{Int64: s.GetID(), Valid: true},
+		ActiveWorkers:   sql.NullInt64{Int64: s.GetActiveWorkers(), Valid: true},
+		InactiveWorkers: sql.NullInt64{Int64: s.GetInactiveWorkers(), Valid: true},
+		BuildLimit:      sql.NullInt64{Int64: s.GetBuildLimit(), Valid: true},
+		Running:         sql.NullInt64{Int64: s.GetRunning(), Valid: true},
+		Pending:         sql.NullInt64{Int64: s.GetPending(), Valid: true},
+		Utilization:     sql.NullFloat64{Float64: s.GetUtilization(), Valid: true},
+		Created:         sql.NullInt64{Int64: s.GetCreated(), Valid: true},
+	}
+
+	return capacitySnapshot.Nullify()
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCapacitySnapshot_Nullify(t *testing.T) {
+	// setup types
+	var s *CapacitySnapshot
+
+	want := &CapacitySnapshot{
+		ID:              sql.NullInt64{Int64: 0, Valid: false},
+		ActiveWorkers:   sql.NullInt64{Int64: 0, Valid: false},
+		InactiveWorkers: sql.NullInt64{Int64: 0, Valid: false},
+		BuildLimit:      sql.NullInt64{Int64: 0, Valid: false},
+		Running:         sql.NullInt64{Int64: 0, Valid: false},
+		Pending:         sql.NullInt64{Int64: 0, Valid: false},
+		Utilization:     sql.NullFloat64{Float64: 0, Valid: false},
+		Created:         sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *CapacitySnapshot
+		want *CapacitySnapshot
+	}{
+		{
+			item: testCapacitySnapshot(),
+			want: testCapacitySnapshot(),
+		},
+		{
+			item: s,
+			want: nil,
+		},
+		{
+			item: new(CapacitySnapshot),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestCapacitySnapshot_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.CapacitySnapshot)
+
+	want.SetID(1)
+	want.SetActiveWorkers(3)
+	want.SetInactiveWorkers(1)
+	want.SetBuildLimit(6)
+	want.SetRunning(3)
+	want.SetPending(2)
+	want.SetUtilization(0.5)
+	want.SetCreated(1563474077)
+
+	// run test
+	got := testCapacitySnapshot().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestCapacitySnapshot_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *CapacitySnapshot
+	}{
+		{
+			failure: false,
+			item:    testCapacitySnapshot(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestCapacitySnapshotFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.CapacitySnapshot)
+
+	s.SetID(1)
+	s.SetActiveWorkers(3)
+	s.SetInactiveWorkers(1)
+	s.SetBuildLimit(6)
+	s.SetRunning(3)
+	s.SetPending(2)
+	s.SetUtilization(0.5)
+	s.SetCreated(1563474077)
+
+	want := testCapacitySnapshot()
+
+	// run test
+	got := CapacitySnapshotFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CapacitySnapshotFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testCapacitySnapshot is a test helper function to create a CapacitySnapshot
+// type with all fields set to a fake value.
+func testCapacitySnapshot() *CapacitySnapshot {
+	return &CapacitySnapshot{
+		ID:              sql.NullInt64{Int64: 1, Valid: true},
+		ActiveWorkers:   sql.NullInt64{Int64: 3, Valid: true},
+		InactiveWorkers: sql.NullInt64{Int64: 1, Valid: true},
+		BuildLimit:      sql.NullInt64{Int64: 6, Valid: true},
+		Running:         sql.NullInt64{Int64: 3, Valid: true},
+		Pending:         sql.NullInt64{Int64: 2, Valid: true},
+		Utilization:     sql.NullFloat64{Float64: 0.5, Valid: true},
+		Created:         sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyCloneSettingRepoID defines the error type when a
+	// CloneSetting type has an empty RepoID and Org field provided.
+	ErrEmptyCloneSettingRepoID = errors.New("empty clone setting repo_id and org provided")
+
+	// ErrInvalidCloneSettingMode defines the error type when a
+	// CloneSetting type has an invalid Submodules, LFS, SingleBranch
+	// or Tags field provided.
+	ErrInvalidCloneSettingMode = errors.New("invalid clone setting mode provided")
+
+	// ErrInvalidCloneSettingDepth defines the error type when a
+	// CloneSetting type has a negative Depth or SubmoduleDepth field provided.
+	ErrInvalidCloneSettingDepth = errors.New("invalid clone setting depth provided")
+)
+
+// CloneSetting is the database representation of the settings
+// controlling how the injected clone step fetches a repo. The
+// settings for an org have no repo and are the defaults for
+// the repos in the org.
+type CloneSetting struct {
+	ID             sql.NullInt64  `sql:"id"`
+	RepoID         sql.NullInt64  `sql:"repo_id"`
+	Org            sql.NullString `sql:"org"`
+	Submodules     sql.NullString `sql:"submodules"`
+	SubmoduleDepth sql.NullInt64  `sql:"submodule_depth"`
+	LFS            sql.NullString `sql:"lfs" gorm:"column:lfs"`
+	Depth          sql.NullInt64  `sql:"depth"`
+	SingleBranch   sql.NullString `sql:"single_branch"`
+	Tags           sql.NullString `sql:"tags"`
+	UpdatedAt      sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy      sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the CloneSetting type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *CloneSetting) Nullify() *CloneSetting {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if s.RepoID.Int64 == 0 {
+		s.RepoID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(s.Org.String) == 0 {
+		s.Org.Valid = false
+	}
+
+	// check if the Submodules field should be false
+	if len(s.Submodules.String) == 0 {
+		s.Submodules.Valid = false
+	}
+
+	// check if the SubmoduleDepth field should be false
+	if s.SubmoduleDepth.Int64 == 0 {
+		s.SubmoduleDepth.Valid = false
+	}
+
+	// check if the LFS field should be false
+	if len(s.LFS.String) == 0 {
+		s.LFS.Valid = false
+	}
+
+	// check if the Depth field should be false
+	if s.Depth.Int64 == 0 {
+		s.Depth.Valid = false
+	}
+
+	// check if the SingleBranch field should be false
+	if len(s.SingleBranch.String) == 0 {
+		s.SingleBranch.Valid = false
+	}
+
+	// check if the Tags field should be false
+	if len(s.Tags.String) == 0 {
+		s.Tags.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if s.UpdatedAt.Int64 == 0 {
+		s.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(s.UpdatedBy.String) == 0 {
+		s.UpdatedBy.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the CloneSetting type
+// to an API CloneSetting type.
+func (s *CloneSetting) ToAPI() *api.CloneSetting {
+	setting := new(api.CloneSetting)
+
+	setting.SetID(s.ID.Int64)
+	setting.SetRepoID(s.RepoID.Int64)
+	setting.SetOrg(s.Org.String)
+	setting.SetSubmodules(s.Submodules.String)
+	setting.SetSubmoduleDepth(s.SubmoduleDepth.Int64)
+	setting.SetLFS(s.LFS.String)
+	setting.SetDepth(s.Depth.Int64)
+	setting.SetSingleBranch(s.SingleBranch.String)
+	setting.SetTags(s.Tags.String)
+	setting.SetUpdatedAt(s.UpdatedAt.Int64)
+	setting.SetUpdatedBy(s.UpdatedBy.String)
+
+	return setting
+}
+
+// Validate verifies the necessary fields for
+// the CloneSetting type are populated correctly.
+func (s *CloneSetting) Validate() error {
+	// verify the RepoID or Org field is populated
+	if s.RepoID.Int64 <= 0 && len(s.Org.String) == 0 {
+		return ErrEmptyCloneSettingRepoID
+	}
+
+	// verify the mode fields are valid modes
+	for _, mode := range []string{s.Submodules.String, s.LFS.String, s.SingleBranch.String, s.Tags.String} {
+		switch mode {
+		case "", api.CloneModeAuto, api.CloneModeEnabled, api.CloneModeDisabled:
+		default:
+			return ErrInvalidCloneSettingMode
+		}
+	}
+
+	// verify the Depth and SubmoduleDepth fields are not negative
+	if s.Depth.Int64 < 0 || s.SubmoduleDepth.Int64 < 0 {
+		return ErrInvalidCloneSettingDepth
+	}
+
+	return nil
+}
+
+// CloneSettingFromAPI converts the API CloneSetting type
+// to a database CloneSetting type.
+func CloneSettingFromAPI(s *api.CloneSetting) *CloneSetting {
+	setting := &CloneSetting{
+		ID:             sql.NullInt64{Int64: s.GetID(), Valid: true},
+		RepoID:         sql.NullInt64{Int64: s.GetRepoID(), Valid: true},
+		Org:            sql.NullString{String: s.GetOrg(), Valid: true},
+		Submodules:     sql.NullString{String: s.GetSubmodules(), Valid: true},
+		SubmoduleDepth: sql.NullInt64{Int64: s.GetSubmoduleDepth(), Valid: true},
+		LFS:            sql.NullString{String: s.GetLFS(), Valid: true},
+		Depth:          sql.NullInt64{Int64: s.GetDepth(), Valid: true},
+		SingleBranch:   sql.NullString{String: s.GetSingleBranch(), Valid: true},
+		Tags:           sql.NullString{String: s.GetTags(), Valid: true},
+		UpdatedAt:      sql.NullInt64{Int64: s.GetUpdatedAt(), Valid: true},
+		UpdatedBy:      sql.NullString{String: s.GetUpdatedBy(), Valid: true},
+	}
+
+	return setting.Nullify()
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCloneSetting_Nullify(t *testing.T) {
+	// setup types
+	var s *CloneSetting
+
+	want := &CloneSetting{
+		ID:             sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:         sql.NullInt64{Int64: 0, Valid: false},
+		Org:            sql.NullString{String: "", Valid: false},
+		Submodules:     sql.NullString{String: "", Valid: false},
+		SubmoduleDepth: sql.NullInt64{Int64: 0, Valid: false},
+		LFS:            sql.NullString{String: "", Valid: false},
+		Depth:          sql.NullInt64{Int64: 0, Valid: false},
+		SingleBranch:   sql.NullString{String: "", Valid: false},
+		Tags:           sql.NullString{String: "", Valid: false},
+		UpdatedAt:      sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy:      sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *CloneSetting
+		want *CloneSetting
+	}{
+		{
+			item: testCloneSetting(),
+			want: testCloneSetting(),
+		},
+		{
+			item: s,
+			want: nil,
+		},
+		{
+			item: new(CloneSetting),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestCloneSetting_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.CloneSetting)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetOrg("github")
+	want.SetSubmodules("enabled")
+	want.SetSubmoduleDepth(1)
+	want.SetLFS("disabled")
+	want.SetDepth(1)
+	want.SetSingleBranch("enabled")
+	want.SetTags("disabled")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testCloneSetting().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestCloneSetting_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *CloneSetting
+	}{
+		{
+			failure: false,
+			item:    testCloneSetting(),
+		},
+		{
+			failure: false,
+			item:    &CloneSetting{RepoID: sql.NullInt64{Int64: 1, Valid: true}},
+		},
+		{ // defaults for an org
+			failure: false,
+			item:    &CloneSetting{Org: sql.NullString{String: "github", Valid: true}},
+		},
+		{ // no RepoID or Org set for CloneSetting
+			failure: true,
+			item: func() *CloneSetting {
+				s := testCloneSetting()
+				s.RepoID = sql.NullInt64{}
+				s.Org = sql.NullString{}
+
+				return s
+			}(),
+		},
+		{ // invalid Submodules set for CloneSetting
+			failure: true,
+			item: func() *CloneSetting {
+				s := testCloneSetting()
+				s.Submodules = sql.NullString{String: "recursive", Valid: true}
+
+				return s
+			}(),
+		},
+		{ // invalid LFS set for CloneSetting
+			failure: true,
+			item: func() *CloneSetting {
+				s := testCloneSetting()
+				s.LFS = sql.NullString{String: "always", Valid: true}
+
+				return s
+			}(),
+		},
+		{ // invalid Tags set for CloneSetting
+			failure: true,
+			item: func() *CloneSetting {
+				s := testCloneSetting()
+				s.Tags = sql.NullString{String: "all", Valid: true}
+
+				return s
+			}(),
+		},
+		{ // negative Depth set for CloneSetting
+			failure: true,
+			item: func() *CloneSetting {
+				s := testCloneSetting()
+				s.Depth = sql.NullInt64{Int64: -1, Valid: true}
+
+				return s
+			}(),
+		},
+		{ // negative SubmoduleDepth set for CloneSetting
+			failure: true,
+			item: func() *CloneSetting {
+				s := testCloneSetting()
+				s.SubmoduleDepth = sql.NullInt64{Int64: -1, Valid: true}
+
+				return s
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestCloneSettingFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.CloneSetting)
+
+	s.SetID(1)
+	s.SetRepoID(1)
+	s.SetOrg("github")
+	s.SetSubmodules("enabled")
+	s.SetSubmoduleDepth(1)
+	s.SetLFS("disabled")
+	s.SetDepth(1)
+	s.SetSingleBranch("enabled")
+	s.SetTags("disabled")
+	s.SetUpdatedAt(1563474077)
+	s.SetUpdatedBy("octocat")
+
+	want := testCloneSetting()
+
+	// run test
+	got := CloneSettingFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CloneSettingFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testCloneSetting is a test helper function to create a CloneSetting
+// type with all fields set to a fake value.
+func testCloneSetting() *CloneSetting {
+	return &CloneSetting{
+		ID:             sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:         sql.NullInt64{Int64: 1, Valid: true},
+		Org:            sql.NullString{String: "github", Valid: true},
+		Submodules:     sql.NullString{String: "enabled", Valid: true},
+		SubmoduleDepth: sql.NullInt64{Int64: 1, Valid: true},
+		LFS:            sql.NullString{String: "disabled", Valid: true},
+		Depth:          sql.NullInt64{Int64: 1, Valid: true},
+		SingleBranch:   sql.NullString{String: "enabled", Valid: true},
+		Tags:           sql.NullString{String: "disabled", Valid: true},
+		UpdatedAt:      sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy:      sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyCostRateClass defines the error type when a
+	// CostRate type has an empty Class field provided.
+	ErrEmptyCostRateClass = errors.New("empty cost rate class provided")
+
+	// ErrInvalidCostRate defines the error type when a
+	// CostRate type has a Rate field that is negative.
+	ErrInvalidCostRate = errors.New("invalid cost rate provided")
+)
+
+// CostRate is the database representation of the
+// cost per build minute for a class of workers.
+type CostRate struct {
+	ID          sql.NullInt64   `sql:"id"`
+	Class       sql.NullString  `sql:"class"`
+	Rate        sql.NullFloat64 `sql:"rate"`
+	Description sql.NullString  `sql:"description"`
+	CreatedAt   sql.NullInt64   `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy   sql.NullString  `sql:"created_by"`
+	UpdatedAt   sql.NullInt64   `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy   sql.NullString  `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the CostRate type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *CostRate) Nullify() *CostRate {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the Class field should be false
+	if len(r.Class.String) == 0 {
+		r.Class.Valid = false
+	}
+
+	// check if the Rate field should be false
+	if r.Rate.Float64 == 0 {
+		r.Rate.Valid = false
+	}
+
+	// check if the Description field should be false
+	if len(r.Description.String) == 0 {
+		r.Description.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if r.CreatedAt.Int64 == 0 {
+		r.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(r.CreatedBy.String) == 0 {
+		r.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if r.UpdatedAt.Int64 == 0 {
+		r.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(r.UpdatedBy.String) == 0 {
+		r.UpdatedBy.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the CostRate type
+// to an API CostRate type.
+func (r *CostRate) ToAPI() *api.CostRate {
+	rate := new(api.CostRate)
+
+	rate.SetID(r.ID.Int64)
+	rate.SetClass(r.Class.String)
+	rate.SetRate(r.Rate.Float64)
+	rate.SetDescription(r.Description.String)
+	rate.SetCreatedAt(r.CreatedAt.Int64)
+	rate.SetCreatedBy(r.CreatedBy.String)
+	rate.SetUpdatedAt(r.UpdatedAt.Int64)
+	rate.SetUpdatedBy(r.UpdatedBy.String)
+
+	return rate
+}
+
+// Validate verifies the necessary fields for
+// the CostRate type are populated correctly.
+func (r *CostRate) Validate() error {
+	// verify the Class field is populated
+	if len(r.Class.String) == 0 {
+		return ErrEmptyCostRateClass
+	}
+
+	// verify the Rate field is not negative
+	if r.Rate.Float64 < 0 {
+		return ErrInvalidCostRate
+	}
+
+	return nil
+}
+
+// CostRateFromAPI converts the API CostRate type
+// to a database CostRate type.
+func CostRateFromAPI(r *api.CostRate) *CostRate {
+	rate := &CostRate{
+		ID:          sql.NullInt64{Int64: r.GetID(), Valid: true},
+		Class:       sql.NullString{String: r.GetClass(), Valid: true},
+		Rate:        sql.NullFloat64{Float64: r.GetRate(), Valid: true},
+		Description: sql.NullString{String: r.GetDescription(), Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: r.GetCreatedAt(), Valid: true},
+		CreatedBy:   sql.NullString{String: r.GetCreatedBy(), Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: r.GetUpdatedAt(), Valid: true},
+		UpdatedBy:   sql.NullString{String: r.GetUpdatedBy(), Valid: true},
+	}
+
+	return rate.Nullify()
+}
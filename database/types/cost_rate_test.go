@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestCostRate_Nullify(t *testing.T) {
+	// setup types
+	var r *CostRate
+
+	want := &CostRate{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		Class:       sql.NullString{String: "", Valid: false},
+		Rate:        sql.NullFloat64{Float64: 0, Valid: false},
+		Description: sql.NullString{String: "", Valid: false},
+		CreatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy:   sql.NullString{String: "", Valid: false},
+		UpdatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy:   sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *CostRate
+		want *CostRate
+	}{
+		{
+			item: testCostRate(),
+			want: testCostRate(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(CostRate),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestCostRate_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.CostRate)
+
+	want.SetID(1)
+	want.SetClass("large:linux")
+	want.SetRate(0.016)
+	want.SetDescription("8 vCPU linux workers")
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testCostRate().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestCostRate_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *CostRate
+	}{
+		{
+			failure: false,
+			item:    testCostRate(),
+		},
+		{ // no Rate set for CostRate
+			failure: false,
+			item: func() *CostRate {
+				r := testCostRate()
+				r.Rate = sql.NullFloat64{}
+
+				return r
+			}(),
+		},
+		{ // no Class set for CostRate
+			failure: true,
+			item: func() *CostRate {
+				r := testCostRate()
+				r.Class = sql.NullString{}
+
+				return r
+			}(),
+		},
+		{ // negative Rate set for CostRate
+			failure: true,
+			item: func() *CostRate {
+				r := testCostRate()
+				r.Rate = sql.NullFloat64{Float64: -1, Valid: true}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestCostRateFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.CostRate)
+
+	r.SetID(1)
+	r.SetClass("large:linux")
+	r.SetRate(0.016)
+	r.SetDescription("8 vCPU linux workers")
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	want := testCostRate()
+
+	// run test
+	got := CostRateFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CostRateFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testCostRate is a test helper function to create a CostRate
+// type with all fields set to a fake value.
+func testCostRate() *CostRate {
+	return &CostRate{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		Class:       sql.NullString{String: "large:linux", Valid: true},
+		Rate:        sql.NullFloat64{Float64: 0.016, Valid: true},
+		Description: sql.NullString{String: "8 vCPU linux workers", Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy:   sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy:   sql.NullString{String: "octocat", Valid: true},
+	}
+}
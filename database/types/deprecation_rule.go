@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrInvalidDeprecationRuleKind defines the error type when a
+	// DeprecationRule type has an invalid Kind field provided.
+	ErrInvalidDeprecationRuleKind = errors.New("invalid deprecation rule kind provided")
+
+	// ErrEmptyDeprecationRulePattern defines the error type when a
+	// DeprecationRule type has an empty Pattern field provided.
+	ErrEmptyDeprecationRulePattern = errors.New("empty deprecation rule pattern provided")
+)
+
+// DeprecationRule is the database representation of a rule for attaching
+// a warning to the builds using a deprecated image, template or syntax.
+type DeprecationRule struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Kind      sql.NullString `sql:"kind"`
+	Pattern   sql.NullString `sql:"pattern"`
+	Message   sql.NullString `sql:"message"`
+	Sunset    sql.NullInt64  `sql:"sunset"`
+	Comment   sql.NullBool   `sql:"comment"`
+	Active    sql.NullBool   `sql:"active"`
+	CreatedAt sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the DeprecationRule type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *DeprecationRule) Nullify() *DeprecationRule {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the Kind field should be false
+	if len(r.Kind.String) == 0 {
+		r.Kind.Valid = false
+	}
+
+	// check if the Pattern field should be false
+	if len(r.Pattern.String) == 0 {
+		r.Pattern.Valid = false
+	}
+
+	// check if the Message field should be false
+	if len(r.Message.String) == 0 {
+		r.Message.Valid = false
+	}
+
+	// check if the Sunset field should be false
+	if r.Sunset.Int64 == 0 {
+		r.Sunset.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if r.CreatedAt.Int64 == 0 {
+		r.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(r.CreatedBy.String) == 0 {
+		r.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if r.UpdatedAt.Int64 == 0 {
+		r.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(r.UpdatedBy.String) == 0 {
+		r.UpdatedBy.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the DeprecationRule type
+// to an API DeprecationRule type.
+func (r *DeprecationRule) ToAPI() *api.DeprecationRule {
+	rule := new(api.DeprecationRule)
+
+	rule.SetID(r.ID.Int64)
+	rule.SetKind(r.Kind.String)
+	rule.SetPattern(r.Pattern.String)
+	rule.SetMessage(r.Message.String)
+	rule.SetSunset(r.Sunset.Int64)
+	rule.SetComment(r.Comment.Bool)
+	rule.SetActive(r.Active.Bool)
+	rule.SetCreatedAt(r.CreatedAt.Int64)
+	rule.SetCreatedBy(r.CreatedBy.String)
+	rule.SetUpdatedAt(r.UpdatedAt.Int64)
+	rule.SetUpdatedBy(r.UpdatedBy.String)
+
+	return rule
+}
+
+// Validate verifies the necessary fields for
+// the DeprecationRule type are populated correctly.
+func (r *DeprecationRule) Validate() error {
+	// verify the Kind field is populated with a supported kind
+	switch r.Kind.String {
+	case api.DeprecationKindImage, api.DeprecationKindTemplate, api.DeprecationKindSyntax:
+	default:
+		return ErrInvalidDeprecationRuleKind
+	}
+
+	// verify the Pattern field is populated
+	if len(r.Pattern.String) == 0 {
+		return ErrEmptyDeprecationRulePattern
+	}
+
+	return nil
+}
+
+// DeprecationRuleFromAPI converts the API DeprecationRule type
+// to a database DeprecationRule type.
+func DeprecationRuleFromAPI(r *api.DeprecationRule) *DeprecationRule {
+	rule := &DeprecationRule{
+		ID:        sql.NullInt64{Int64: r.GetID(), Valid: true},
+		Kind:      sql.NullString{String: r.GetKind(), Valid: true},
+		Pattern:   sql.NullString{String: r.GetPattern(), Valid: true},
+		Message:   sql.NullString{String: r.GetMessage(), Valid: true},
+		Sunset:    sql.NullInt64{Int64: r.GetSunset(), Valid: true},
+		Comment:   sql.NullBool{Bool: r.GetComment(), Valid: true},
+		Active:    sql.NullBool{Bool: r.GetActive(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: r.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: r.GetCreatedBy(), Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: r.GetUpdatedAt(), Valid: true},
+		UpdatedBy: sql.NullString{String: r.GetUpdatedBy(), Valid: true},
+	}
+
+	return rule.Nullify()
+}
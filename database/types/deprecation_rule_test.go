@@ -0,0 +1,174 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestDeprecationRule_Nullify(t *testing.T) {
+	// setup types
+	var r *DeprecationRule
+
+	want := &DeprecationRule{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Kind:      sql.NullString{String: "", Valid: false},
+		Pattern:   sql.NullString{String: "", Valid: false},
+		Message:   sql.NullString{String: "", Valid: false},
+		Sunset:    sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *DeprecationRule
+		want *DeprecationRule
+	}{
+		{
+			item: testDeprecationRule(),
+			want: testDeprecationRule(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(DeprecationRule),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestDeprecationRule_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.DeprecationRule)
+
+	want.SetID(1)
+	want.SetKind("image")
+	want.SetPattern("golang:1.1*")
+	want.SetMessage("upgrade to golang:1.21")
+	want.SetSunset(1704067200)
+	want.SetComment(true)
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testDeprecationRule().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestDeprecationRule_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *DeprecationRule
+	}{
+		{
+			failure: false,
+			item:    testDeprecationRule(),
+		},
+		{ // invalid Kind set for DeprecationRule
+			failure: true,
+			item: func() *DeprecationRule {
+				r := testDeprecationRule()
+				r.Kind = sql.NullString{String: "foo", Valid: true}
+
+				return r
+			}(),
+		},
+		{ // no Pattern set for DeprecationRule
+			failure: true,
+			item: func() *DeprecationRule {
+				r := testDeprecationRule()
+				r.Pattern = sql.NullString{}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestDeprecationRuleFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.DeprecationRule)
+
+	r.SetID(1)
+	r.SetKind("image")
+	r.SetPattern("golang:1.1*")
+	r.SetMessage("upgrade to golang:1.21")
+	r.SetSunset(1704067200)
+	r.SetComment(true)
+	r.SetActive(true)
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	want := testDeprecationRule()
+
+	// run test
+	got := DeprecationRuleFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DeprecationRuleFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testDeprecationRule is a test helper function to create a DeprecationRule
+// type with all fields set to a fake value.
+func testDeprecationRule() *DeprecationRule {
+	return &DeprecationRule{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Kind:      sql.NullString{String: "image", Valid: true},
+		Pattern:   sql.NullString{String: "golang:1.1*", Valid: true},
+		Message:   sql.NullString{String: "upgrade to golang:1.21", Valid: true},
+		Sunset:    sql.NullInt64{Int64: 1704067200, Valid: true},
+		Comment:   sql.NullBool{Bool: true, Valid: true},
+		Active:    sql.NullBool{Bool: true, Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy: sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+// DriverMySQL represents the driver for a MySQL or MariaDB database.
+//
+// The drivers for Postgres and Sqlite databases are provided
+// by the constants from github.com/go-vela/types/constants.
+const DriverMySQL = "mysql"
@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyEgressRuleOrg defines the error type when a
+	// EgressRule type has an empty Org field provided.
+	ErrEmptyEgressRuleOrg = errors.New("empty egress rule org provided")
+
+	// ErrEmptyEgressRuleAction defines the error type when a
+	// EgressRule type has an empty Action field provided.
+	ErrEmptyEgressRuleAction = errors.New("empty egress rule action provided")
+
+	// ErrEmptyEgressRuleDestination defines the error type when a
+	// EgressRule type has an empty Destination field provided.
+	ErrEmptyEgressRuleDestination = errors.New("empty egress rule destination provided")
+)
+
+// EgressRule is the database representation of a rule of the policy
+// for outbound network access from the steps of the builds for an org.
+type EgressRule struct {
+	ID          sql.NullInt64  `sql:"id"`
+	Org         sql.NullString `sql:"org"`
+	Action      sql.NullString `sql:"action"`
+	Destination sql.NullString `sql:"destination"`
+	Description sql.NullString `sql:"description"`
+	Active      sql.NullBool   `sql:"active"`
+	CreatedAt   sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy   sql.NullString `sql:"created_by"`
+	UpdatedAt   sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy   sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the EgressRule type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (e *EgressRule) Nullify() *EgressRule {
+	if e == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if e.ID.Int64 == 0 {
+		e.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(e.Org.String) == 0 {
+		e.Org.Valid = false
+	}
+
+	// check if the Action field should be false
+	if len(e.Action.String) == 0 {
+		e.Action.Valid = false
+	}
+
+	// check if the Destination field should be false
+	if len(e.Destination.String) == 0 {
+		e.Destination.Valid = false
+	}
+
+	// check if the Description field should be false
+	if len(e.Description.String) == 0 {
+		e.Description.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if e.CreatedAt.Int64 == 0 {
+		e.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(e.CreatedBy.String) == 0 {
+		e.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if e.UpdatedAt.Int64 == 0 {
+		e.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(e.UpdatedBy.String) == 0 {
+		e.UpdatedBy.Valid = false
+	}
+
+	return e
+}
+
+// ToAPI converts the EgressRule type
+// to an API EgressRule type.
+func (e *EgressRule) ToAPI() *api.EgressRule {
+	egressRule := new(api.EgressRule)
+
+	egressRule.SetID(e.ID.Int64)
+	egressRule.SetOrg(e.Org.String)
+	egressRule.SetAction(e.Action.String)
+	egressRule.SetDestination(e.Destination.String)
+	egressRule.SetDescription(e.Description.String)
+	egressRule.SetActive(e.Active.Bool)
+	egressRule.SetCreatedAt(e.CreatedAt.Int64)
+	egressRule.SetCreatedBy(e.CreatedBy.String)
+	egressRule.SetUpdatedAt(e.UpdatedAt.Int64)
+	egressRule.SetUpdatedBy(e.UpdatedBy.String)
+
+	return egressRule
+}
+
+// Validate verifies the necessary fields for
+// the EgressRule type are populated correctly.
+func (e *EgressRule) Validate() error {
+	// verify the Org field is populated
+	if len(e.Org.String) == 0 {
+		return ErrEmptyEgressRuleOrg
+	}
+
+	// verify the Action field is populated
+	if len(e.Action.String) == 0 {
+		return ErrEmptyEgressRuleAction
+	}
+
+	// verify the Destination field is populated
+	if len(e.Destination.String) == 0 {
+		return ErrEmptyEgressRuleDestination
+	}
+
+	return nil
+}
+
+// EgressRuleFromAPI converts the API EgressRule type
+// to a database EgressRule type.
+func EgressRuleFromAPI(e *api.EgressRule) *EgressRule {
+	egressRule := &EgressRule{
+		ID:          sql.NullInt64{Int64: e.GetID(), Valid: true},
+		Org:         sql.NullString{String: e.GetOrg(), Valid: true},
+		Action:      sql.NullString{String: e.GetAction(), Valid: true},
+		Destination: sql.NullString{String: e.GetDestination(), Valid: true},
+		Description: sql.NullString{String: e.GetDescription(), Valid: true},
+		Active:      sql.NullBool{Bool: e.GetActive(), Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: e.GetCreatedAt(), Valid: true},
+		CreatedBy:   sql.NullString{String: e.GetCreatedBy(), Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: e.GetUpdatedAt(), Valid: true},
+		UpdatedBy:   sql.NullString{String: e.GetUpdatedBy(), Valid: true},
+	}
+
+	return egressRule.Nullify()
+}
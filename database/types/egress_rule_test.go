@@ -0,0 +1,180 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestEgressRule_Nullify(t *testing.T) {
+	// setup types
+	var e *EgressRule
+
+	want := &EgressRule{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		Org:         sql.NullString{String: "", Valid: false},
+		Action:      sql.NullString{String: "", Valid: false},
+		Destination: sql.NullString{String: "", Valid: false},
+		Description: sql.NullString{String: "", Valid: false},
+		CreatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy:   sql.NullString{String: "", Valid: false},
+		UpdatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy:   sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *EgressRule
+		want *EgressRule
+	}{
+		{
+			item: testEgressRule(),
+			want: testEgressRule(),
+		},
+		{
+			item: e,
+			want: nil,
+		},
+		{
+			item: new(EgressRule),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestEgressRule_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.EgressRule)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetAction("allow")
+	want.SetDestination("*.github.com:443")
+	want.SetDescription("GitHub API")
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testEgressRule().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestEgressRule_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *EgressRule
+	}{
+		{
+			failure: false,
+			item:    testEgressRule(),
+		},
+		{ // no Org set for EgressRule
+			failure: true,
+			item: func() *EgressRule {
+				e := testEgressRule()
+				e.Org = sql.NullString{}
+
+				return e
+			}(),
+		},
+		{ // no Action set for EgressRule
+			failure: true,
+			item: func() *EgressRule {
+				e := testEgressRule()
+				e.Action = sql.NullString{}
+
+				return e
+			}(),
+		},
+		{ // no Destination set for EgressRule
+			failure: true,
+			item: func() *EgressRule {
+				e := testEgressRule()
+				e.Destination = sql.NullString{}
+
+				return e
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestEgressRuleFromAPI(t *testing.T) {
+	// setup types
+	e := new(api.EgressRule)
+
+	e.SetID(1)
+	e.SetOrg("github")
+	e.SetAction("allow")
+	e.SetDestination("*.github.com:443")
+	e.SetDescription("GitHub API")
+	e.SetActive(true)
+	e.SetCreatedAt(1563474077)
+	e.SetCreatedBy("octocat")
+	e.SetUpdatedAt(1563474077)
+	e.SetUpdatedBy("octocat")
+
+	want := testEgressRule()
+
+	// run test
+	got := EgressRuleFromAPI(e)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EgressRuleFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testEgressRule is a test helper function to create a EgressRule
+// type with all fields set to a fake value.
+func testEgressRule() *EgressRule {
+	return &EgressRule{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		Org:         sql.NullString{String: "github", Valid: true},
+		Action:      sql.NullString{String: "allow", Valid: true},
+		Destination: sql.NullString{String: "*.github.com:443", Valid: true},
+		Description: sql.NullString{String: "GitHub API", Valid: true},
+		Active:      sql.NullBool{Bool: true, Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy:   sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy:   sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"testing"
+)
+
+func TestTypes_encrypt_decrypt(t *testing.T) {
+	// setup types
+	key := "C639A572E14D5075C526FDDD43E4ECF6"
+	value := []byte("superSecretPassword")
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		name    string
+		key     string
+	}{
+		{
+			failure: false,
+			name:    "valid key",
+			key:     key,
+		},
+		{
+			failure: true,
+			name:    "invalid key",
+			key:     "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encrypted, err := encrypt(test.key, value)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("encrypt should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("encrypt returned err: %v", err)
+			}
+
+			got, err := decrypt(test.key, encrypted)
+			if err != nil {
+				t.Errorf("decrypt returned err: %v", err)
+			}
+
+			if string(got) != string(value) {
+				t.Errorf("decrypt is %s, want %s", got, value)
+			}
+		})
+	}
+}
+
+func TestTypes_decrypt_InvalidLength(t *testing.T) {
+	// run test
+	_, err := decrypt("C639A572E14D5075C526FDDD43E4ECF6", []byte("foo"))
+	if err == nil {
+		t.Errorf("decrypt should have returned err")
+	}
+}
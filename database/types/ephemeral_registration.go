@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyEphemeralRegistrationBuildID defines the error type when an
+	// EphemeralRegistration type has an empty BuildID field provided.
+	ErrEmptyEphemeralRegistrationBuildID = errors.New("empty ephemeral registration build_id provided")
+
+	// ErrEmptyEphemeralRegistrationHostname defines the error type when an
+	// EphemeralRegistration type has an empty Hostname field provided.
+	ErrEmptyEphemeralRegistrationHostname = errors.New("empty ephemeral registration hostname provided")
+)
+
+// EphemeralRegistration is the database representation of a
+// single-use worker registration bound to one build. The token
+// for the registration is only returned when it is created and
+// is never stored in the database.
+type EphemeralRegistration struct {
+	ID         sql.NullInt64  `sql:"id"`
+	BuildID    sql.NullInt64  `sql:"build_id"`
+	RepoID     sql.NullInt64  `sql:"repo_id"`
+	Hostname   sql.NullString `sql:"hostname"`
+	Route      sql.NullString `sql:"route"`
+	Registered sql.NullInt64  `sql:"registered"`
+	Created    sql.NullInt64  `sql:"created"`
+	CreatedBy  sql.NullString `sql:"created_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the EphemeralRegistration type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (e *EphemeralRegistration) Nullify() *EphemeralRegistration {
+	if e == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if e.ID.Int64 == 0 {
+		e.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if e.BuildID.Int64 == 0 {
+		e.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if e.RepoID.Int64 == 0 {
+		e.RepoID.Valid = false
+	}
+
+	// check if the Hostname field should be false
+	if len(e.Hostname.String) == 0 {
+		e.Hostname.Valid = false
+	}
+
+	// check if the Route field should be false
+	if len(e.Route.String) == 0 {
+		e.Route.Valid = false
+	}
+
+	// check if the Registered field should be false
+	if e.Registered.Int64 == 0 {
+		e.Registered.Valid = false
+	}
+
+	// check if the Created field should be false
+	if e.Created.Int64 == 0 {
+		e.Created.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(e.CreatedBy.String) == 0 {
+		e.CreatedBy.Valid = false
+	}
+
+	return e
+}
+
+// ToAPI converts the EphemeralRegistration type
+// to an API EphemeralRegistration type.
+func (e *EphemeralRegistration) ToAPI() *api.EphemeralRegistration {
+	registration := new(api.EphemeralRegistration)
+
+	registration.SetID(e.ID.Int64)
+	registration.SetBuildID(e.BuildID.Int64)
+	registration.SetRepoID(e.RepoID.Int64)
+	registration.SetHostname(e.Hostname.String)
+	registration.SetRoute(e.Route.String)
+	registration.SetRegistered(e.Registered.Int64)
+	registration.SetCreated(e.Created.Int64)
+	registration.SetCreatedBy(e.CreatedBy.String)
+
+	return registration
+}
+
+// Validate verifies the necessary fields for
+// the EphemeralRegistration type are populated correctly.
+func (e *EphemeralRegistration) Validate() error {
+	// verify the BuildID field is populated
+	if e.BuildID.Int64 <= 0 {
+		return ErrEmptyEphemeralRegistrationBuildID
+	}
+
+	// verify the Hostname field is populated
+	if len(e.Hostname.String) == 0 {
+		return ErrEmptyEphemeralRegistrationHostname
+	}
+
+	// ensure that all EphemeralRegistration string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	e.Hostname = sql.NullString{String: sanitize(e.Hostname.String), Valid: e.Hostname.Valid}
+	e.Route = sql.NullString{String: sanitize(e.Route.String), Valid: e.Route.Valid}
+
+	return nil
+}
+
+// EphemeralRegistrationFromAPI converts the API EphemeralRegistration
+// type to a database EphemeralRegistration type.
+func EphemeralRegistrationFromAPI(e *api.EphemeralRegistration) *EphemeralRegistration {
+	registration := &EphemeralRegistration{
+		ID:         sql.NullInt64{Int64: e.GetID(), Valid: true},
+		BuildID:    sql.NullInt64{Int64: e.GetBuildID(), Valid: true},
+		RepoID:     sql.NullInt64{Int64: e.GetRepoID(), Valid: true},
+		Hostname:   sql.NullString{String: e.GetHostname(), Valid: true},
+		Route:      sql.NullString{String: e.GetRoute(), Valid: true},
+		Registered: sql.NullInt64{Int64: e.GetRegistered(), Valid: true},
+		Created:    sql.NullInt64{Int64: e.GetCreated(), Valid: true},
+		CreatedBy:  sql.NullString{String: e.GetCreatedBy(), Valid: true},
+	}
+
+	return registration.Nullify()
+}
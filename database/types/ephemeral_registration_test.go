@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestEphemeralRegistration_Nullify(t *testing.T) {
+	// setup types
+	var e *EphemeralRegistration
+
+	want := &EphemeralRegistration{
+		ID:         sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:    sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:     sql.NullInt64{Int64: 0, Valid: false},
+		Hostname:   sql.NullString{String: "", Valid: false},
+		Route:      sql.NullString{String: "", Valid: false},
+		Registered: sql.NullInt64{Int64: 0, Valid: false},
+		Created:    sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy:  sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *EphemeralRegistration
+		want *EphemeralRegistration
+	}{
+		{
+			item: testEphemeralRegistration(),
+			want: testEphemeralRegistration(),
+		},
+		{
+			item: e,
+			want: nil,
+		},
+		{
+			item: new(EphemeralRegistration),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestEphemeralRegistration_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.EphemeralRegistration)
+
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetHostname("vela-ephemeral-1")
+	want.SetRoute("vela")
+	want.SetRegistered(1563474077)
+	want.SetCreated(1563474076)
+	want.SetCreatedBy("octocat")
+
+	// run test
+	got := testEphemeralRegistration().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestEphemeralRegistration_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *EphemeralRegistration
+	}{
+		{
+			failure: false,
+			item:    testEphemeralRegistration(),
+		},
+		{ // no BuildID set for EphemeralRegistration
+			failure: true,
+			item: func() *EphemeralRegistration {
+				e := testEphemeralRegistration()
+				e.BuildID = sql.NullInt64{}
+
+				return e
+			}(),
+		},
+		{ // no Hostname set for EphemeralRegistration
+			failure: true,
+			item: func() *EphemeralRegistration {
+				e := testEphemeralRegistration()
+				e.Hostname = sql.NullString{}
+
+				return e
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestEphemeralRegistrationFromAPI(t *testing.T) {
+	// setup types
+	e := new(api.EphemeralRegistration)
+
+	e.SetID(1)
+	e.SetBuildID(1)
+	e.SetRepoID(1)
+	e.SetHostname("vela-ephemeral-1")
+	e.SetRoute("vela")
+	e.SetToken("foo")
+	e.SetRegistered(1563474077)
+	e.SetCreated(1563474076)
+	e.SetCreatedBy("octocat")
+
+	want := testEphemeralRegistration()
+
+	// run test
+	got := EphemeralRegistrationFromAPI(e)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EphemeralRegistrationFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testEphemeralRegistration is a test helper function to create an
+// EphemeralRegistration type with all fields set to a fake value.
+func testEphemeralRegistration() *EphemeralRegistration {
+	return &EphemeralRegistration{
+		ID:         sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:    sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+		Hostname:   sql.NullString{String: "vela-ephemeral-1", Valid: true},
+		Route:      sql.NullString{String: "vela", Valid: true},
+		Registered: sql.NullInt64{Int64: 1563474077, Valid: true},
+		Created:    sql.NullInt64{Int64: 1563474076, Valid: true},
+		CreatedBy:  sql.NullString{String: "octocat", Valid: true},
+	}
+}
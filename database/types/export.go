@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyExportDataset defines the error type when a
+	// Export type has an empty Dataset field provided.
+	ErrEmptyExportDataset = errors.New("empty export dataset provided")
+
+	// ErrEmptyExportObject defines the error type when a
+	// Export type has an empty Object field provided.
+	ErrEmptyExportObject = errors.New("empty export object provided")
+)
+
+// Export is the database representation of a file of build
+// results exported to object storage for a data warehouse.
+type Export struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Dataset   sql.NullString `sql:"dataset"`
+	Format    sql.NullString `sql:"format"`
+	Bucket    sql.NullString `sql:"bucket"`
+	Object    sql.NullString `sql:"object"`
+	Records   sql.NullInt64  `sql:"records"`
+	Since     sql.NullInt64  `sql:"since"`
+	Watermark sql.NullInt64  `sql:"watermark"`
+	Created   sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Export type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (e *Export) Nullify() *Export {
+	if e == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if e.ID.Int64 == 0 {
+		e.ID.Valid = false
+	}
+
+	// check if the Dataset field should be false
+	if len(e.Dataset.String) == 0 {
+		e.Dataset.Valid = false
+	}
+
+	// check if the Format field should be false
+	if len(e.Format.String) == 0 {
+		e.Format.Valid = false
+	}
+
+	// check if the Bucket field should be false
+	if len(e.Bucket.String) == 0 {
+		e.Bucket.Valid = false
+	}
+
+	// check if the Object field should be false
+	if len(e.Object.String) == 0 {
+		e.Object.Valid = false
+	}
+
+	// check if the Records field should be false
+	if e.Records.Int64 == 0 {
+		e.Records.Valid = false
+	}
+
+	// check if the Since field should be false
+	if e.Since.Int64 == 0 {
+		e.Since.Valid = false
+	}
+
+	// check if the Watermark field should be false
+	if e.Watermark.Int64 == 0 {
+		e.Watermark.Valid = false
+	}
+
+	// check if the Created field should be false
+	if e.Created.Int64 == 0 {
+		e.Created.Valid = false
+	}
+
+	return e
+}
+
+// ToAPI converts the Export type
+// to an API Export type.
+func (e *Export) ToAPI() *api.Export {
+	export := new(api.Export)
+
+	export.SetID(e.ID.Int64)
+	export.SetDataset(e.Dataset.String)
+	export.SetFormat(e.Format.String)
+	export.SetBucket(e.Bucket.String)
+	export.SetObject(e.Object.String)
+	export.SetRecords(e.Records.Int64)
+	export.SetSince(e.Since.Int64)
+	export.SetWatermark(e.Watermark.Int64)
+	export.SetCreated(e.Created.Int64)
+
+	return export
+}
+
+// Validate verifies the necessary fields for
+// the Export type are populated correctly.
+func (e *Export) Validate() error {
+	// verify the Dataset field is populated
+	if len(e.Dataset.String) == 0 {
+		return ErrEmptyExportDataset
+	}
+
+	// verify the Object field is populated
+	if len(e.Object.String) == 0 {
+		return ErrEmptyExportObject
+	}
+
+	return nil
+}
+
+// ExportFromAPI converts the API Export type
+// to a database Export type.
+func ExportFromAPI(e *api.Export) *Export {
+	export := &Export{
+		ID:        sql.NullInt64{Int64: e.GetID(), Valid: true},
+		Dataset:   sql.NullString{String: e.GetDataset(), Valid: true},
+		Format:    sql.NullString{String: e.GetFormat(), Valid: true},
+		Bucket:    sql.NullString{String: e.GetBucket(), Valid: true},
+		Object:    sql.NullString{String: e.GetObject(), Valid: true},
+		Records:   sql.NullInt64{Int64: e.GetRecords(), Valid: true},
+		Since:     sql.NullInt64{Int64: e.GetSince(), Valid: true},
+		Watermark: sql.NullInt64{Int64: e.GetWatermark(), Valid: true},
+		Created:   sql.NullInt64{Int64: e.GetCreated(), Valid: true},
+	}
+
+	return export.Nullify()
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestExport_Nullify(t *testing.T) {
+	// setup types
+	var e *Export
+
+	want := &Export{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Dataset:   sql.NullString{String: "", Valid: false},
+		Format:    sql.NullString{String: "", Valid: false},
+		Bucket:    sql.NullString{String: "", Valid: false},
+		Object:    sql.NullString{String: "", Valid: false},
+		Records:   sql.NullInt64{Int64: 0, Valid: false},
+		Since:     sql.NullInt64{Int64: 0, Valid: false},
+		Watermark: sql.NullInt64{Int64: 0, Valid: false},
+		Created:   sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *Export
+		want *Export
+	}{
+		{
+			item: testExport(),
+			want: testExport(),
+		},
+		{
+			item: e,
+			want: nil,
+		},
+		{
+			item: new(Export),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestExport_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Export)
+
+	want.SetID(1)
+	want.SetDataset("builds")
+	want.SetFormat("jsonl")
+	want.SetBucket("vela-exports")
+	want.SetObject("vela/builds/builds-1563474000-1563477600.jsonl")
+	want.SetRecords(10)
+	want.SetSince(1563474000)
+	want.SetWatermark(1563477600)
+	want.SetCreated(1563477601)
+
+	// run test
+	got := testExport().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestExport_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *Export
+	}{
+		{
+			failure: false,
+			item:    testExport(),
+		},
+		{ // no Dataset set for Export
+			failure: true,
+			item: func() *Export {
+				e := testExport()
+				e.Dataset = sql.NullString{}
+
+				return e
+			}(),
+		},
+		{ // no Object set for Export
+			failure: true,
+			item: func() *Export {
+				e := testExport()
+				e.Object = sql.NullString{}
+
+				return e
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestExportFromAPI(t *testing.T) {
+	// setup types
+	e := new(api.Export)
+
+	e.SetID(1)
+	e.SetDataset("builds")
+	e.SetFormat("jsonl")
+	e.SetBucket("vela-exports")
+	e.SetObject("vela/builds/builds-1563474000-1563477600.jsonl")
+	e.SetRecords(10)
+	e.SetSince(1563474000)
+	e.SetWatermark(1563477600)
+	e.SetCreated(1563477601)
+
+	want := testExport()
+
+	// run test
+	got := ExportFromAPI(e)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExportFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testExport is a test helper function to create a Export
+// type with all fields set to a fake value.
+func testExport() *Export {
+	return &Export{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Dataset:   sql.NullString{String: "builds", Valid: true},
+		Format:    sql.NullString{String: "jsonl", Valid: true},
+		Bucket:    sql.NullString{String: "vela-exports", Valid: true},
+		Object:    sql.NullString{String: "vela/builds/builds-1563474000-1563477600.jsonl", Valid: true},
+		Records:   sql.NullInt64{Int64: 10, Valid: true},
+		Since:     sql.NullInt64{Int64: 1563474000, Valid: true},
+		Watermark: sql.NullInt64{Int64: 1563477600, Valid: true},
+		Created:   sql.NullInt64{Int64: 1563477601, Valid: true},
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyFreezeName defines the error type when a
+	// Freeze type has an empty Name field provided.
+	ErrEmptyFreezeName = errors.New("empty freeze name provided")
+
+	// ErrInvalidFreezeScope defines the error type when a
+	// Freeze type has an invalid Scope field provided.
+	ErrInvalidFreezeScope = errors.New("invalid freeze scope provided")
+
+	// ErrInvalidFreezeWindow defines the error type when a
+	// Freeze type has an Ends field that is not after the
+	// Starts field provided.
+	ErrInvalidFreezeWindow = errors.New("invalid freeze window provided")
+)
+
+// Freeze is the database representation of a change freeze
+// that blocks builds for an org, or for every org when the
+// org is empty, during a window of time.
+type Freeze struct {
+	ID           sql.NullInt64  `sql:"id"`
+	Org          sql.NullString `sql:"org"`
+	Name         sql.NullString `sql:"name"`
+	Reason       sql.NullString `sql:"reason"`
+	Scope        sql.NullString `sql:"scope"`
+	Starts       sql.NullInt64  `sql:"starts"`
+	Ends         sql.NullInt64  `sql:"ends"`
+	ExemptRepos  pq.StringArray `sql:"exempt_repos" gorm:"type:varchar(1000)"`
+	ExemptEvents pq.StringArray `sql:"exempt_events" gorm:"type:varchar(1000)"`
+	CreatedAt    sql.NullInt64  `sql:"created_at"`
+	CreatedBy    sql.NullString `sql:"created_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Freeze type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (f *Freeze) Nullify() *Freeze {
+	if f == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if f.ID.Int64 == 0 {
+		f.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(f.Org.String) == 0 {
+		f.Org.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(f.Name.String) == 0 {
+		f.Name.Valid = false
+	}
+
+	// check if the Reason field should be false
+	if len(f.Reason.String) == 0 {
+		f.Reason.Valid = false
+	}
+
+	// check if the Scope field should be false
+	if len(f.Scope.String) == 0 {
+		f.Scope.Valid = false
+	}
+
+	// check if the Starts field should be false
+	if f.Starts.Int64 == 0 {
+		f.Starts.Valid = false
+	}
+
+	// check if the Ends field should be false
+	if f.Ends.Int64 == 0 {
+		f.Ends.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if f.CreatedAt.Int64 == 0 {
+		f.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(f.CreatedBy.String) == 0 {
+		f.CreatedBy.Valid = false
+	}
+
+	return f
+}
+
+// ToAPI converts the Freeze type
+// to an API Freeze type.
+func (f *Freeze) ToAPI() *api.Freeze {
+	freeze := new(api.Freeze)
+
+	freeze.SetID(f.ID.Int64)
+	freeze.SetOrg(f.Org.String)
+	freeze.SetName(f.Name.String)
+	freeze.SetReason(f.Reason.String)
+	freeze.SetScope(f.Scope.String)
+	freeze.SetStarts(f.Starts.Int64)
+	freeze.SetEnds(f.Ends.Int64)
+	freeze.SetExemptRepos(f.ExemptRepos)
+	freeze.SetExemptEvents(f.ExemptEvents)
+	freeze.SetCreatedAt(f.CreatedAt.Int64)
+	freeze.SetCreatedBy(f.CreatedBy.String)
+
+	return freeze
+}
+
+// Validate verifies the necessary fields for
+// the Freeze type are populated correctly.
+func (f *Freeze) Validate() error {
+	// verify the Name field is populated
+	if len(f.Name.String) == 0 {
+		return ErrEmptyFreezeName
+	}
+
+	// verify the Scope field is valid
+	switch f.Scope.String {
+	case api.FreezeScopeDeploy, api.FreezeScopeAll:
+	default:
+		return ErrInvalidFreezeScope
+	}
+
+	// verify the Ends field is after the Starts field
+	if f.Ends.Int64 <= f.Starts.Int64 {
+		return ErrInvalidFreezeWindow
+	}
+
+	return nil
+}
+
+// FreezeFromAPI converts the API Freeze type
+// to a database Freeze type.
+func FreezeFromAPI(f *api.Freeze) *Freeze {
+	freeze := &Freeze{
+		ID:           sql.NullInt64{Int64: f.GetID(), Valid: true},
+		Org:          sql.NullString{String: f.GetOrg(), Valid: true},
+		Name:         sql.NullString{String: f.GetName(), Valid: true},
+		Reason:       sql.NullString{String: f.GetReason(), Valid: true},
+		Scope:        sql.NullString{String: f.GetScope(), Valid: true},
+		Starts:       sql.NullInt64{Int64: f.GetStarts(), Valid: true},
+		Ends:         sql.NullInt64{Int64: f.GetEnds(), Valid: true},
+		ExemptRepos:  pq.StringArray(f.GetExemptRepos()),
+		ExemptEvents: pq.StringArray(f.GetExemptEvents()),
+		CreatedAt:    sql.NullInt64{Int64: f.GetCreatedAt(), Valid: true},
+		CreatedBy:    sql.NullString{String: f.GetCreatedBy(), Valid: true},
+	}
+
+	return freeze.Nullify()
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyFreezeAuditFreezeID defines the error type when a
+	// FreezeAudit type has an empty FreezeID field provided.
+	ErrEmptyFreezeAuditFreezeID = errors.New("empty freeze audit freeze_id provided")
+
+	// ErrEmptyFreezeAuditAction defines the error type when a
+	// FreezeAudit type has an empty Action field provided.
+	ErrEmptyFreezeAuditAction = errors.New("empty freeze audit action provided")
+)
+
+// FreezeAudit is the database representation of a record
+// of a change freeze being created, deleted or overridden.
+type FreezeAudit struct {
+	ID        sql.NullInt64  `sql:"id"`
+	FreezeID  sql.NullInt64  `sql:"freeze_id"`
+	Org       sql.NullString `sql:"org"`
+	Action    sql.NullString `sql:"action"`
+	Actor     sql.NullString `sql:"actor"`
+	Repo      sql.NullString `sql:"repo"`
+	Event     sql.NullString `sql:"event"`
+	Message   sql.NullString `sql:"message"`
+	IP        sql.NullString `sql:"ip"`
+	UserAgent sql.NullString `sql:"user_agent"`
+	Client    sql.NullString `sql:"client"`
+	Location  sql.NullString `sql:"location"`
+	Created   sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the FreezeAudit type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (a *FreezeAudit) Nullify() *FreezeAudit {
+	if a == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if a.ID.Int64 == 0 {
+		a.ID.Valid = false
+	}
+
+	// check if the FreezeID field should be false
+	if a.FreezeID.Int64 == 0 {
+		a.FreezeID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(a.Org.String) == 0 {
+		a.Org.Valid = false
+	}
+
+	// check if the Action field should be false
+	if len(a.Action.String) == 0 {
+		a.Action.Valid = false
+	}
+
+	// check if the Actor field should be false
+	if len(a.Actor.String) == 0 {
+		a.Actor.Valid = false
+	}
+
+	// check if the Repo field should be false
+	if len(a.Repo.String) == 0 {
+		a.Repo.Valid = false
+	}
+
+	// check if the Event field should be false
+	if len(a.Event.String) == 0 {
+		a.Event.Valid = false
+	}
+
+	// check if the Message field should be false
+	if len(a.Message.String) == 0 {
+		a.Message.Valid = false
+	}
+
+	// check if the IP field should be false
+	if len(a.IP.String) == 0 {
+		a.IP.Valid = false
+	}
+
+	// check if the UserAgent field should be false
+	if len(a.UserAgent.String) == 0 {
+		a.UserAgent.Valid = false
+	}
+
+	// check if the Client field should be false
+	if len(a.Client.String) == 0 {
+		a.Client.Valid = false
+	}
+
+	// check if the Location field should be false
+	if len(a.Location.String) == 0 {
+		a.Location.Valid = false
+	}
+
+	// check if the Created field should be false
+	if a.Created.Int64 == 0 {
+		a.Created.Valid = false
+	}
+
+	return a
+}
+
+// ToAPI converts the FreezeAudit type
+// to an API FreezeAudit type.
+func (a *FreezeAudit) ToAPI() *api.FreezeAudit {
+	audit := new(api.FreezeAudit)
+
+	audit.SetID(a.ID.Int64)
+	audit.SetFreezeID(a.FreezeID.Int64)
+	audit.SetOrg(a.Org.String)
+	audit.SetAction(a.Action.String)
+	audit.SetActor(a.Actor.String)
+	audit.SetRepo(a.Repo.String)
+	audit.SetEvent(a.Event.String)
+	audit.SetMessage(a.Message.String)
+	audit.SetIP(a.IP.String)
+	audit.SetUserAgent(a.UserAgent.String)
+	audit.SetClient(a.Client.String)
+	audit.SetLocation(a.Location.String)
+	audit.SetCreated(a.Created.Int64)
+
+	return audit
+}
+
+// Validate verifies the necessary fields for
+// the FreezeAudit type are populated correctly.
+func (a *FreezeAudit) Validate() error {
+	// verify the FreezeID field is populated
+	if a.FreezeID.Int64 <= 0 {
+		return ErrEmptyFreezeAuditFreezeID
+	}
+
+	// verify the Action field is populated
+	if len(a.Action.String) == 0 {
+		return ErrEmptyFreezeAuditAction
+	}
+
+	return nil
+}
+
+// FreezeAuditFromAPI converts the API FreezeAudit type
+// to a database FreezeAudit type.
+func FreezeAuditFromAPI(a *api.FreezeAudit) *FreezeAudit {
+	audit := &FreezeAudit{
+		ID:        sql.NullInt64{Int64: a.GetID(), Valid: true},
+		FreezeID:  sql.NullInt64{Int64: a.GetFreezeID(), Valid: true},
+		Org:       sql.NullString{String: a.GetOrg(), Valid: true},
+		Action:    sql.NullString{String: a.GetAction(), Valid: true},
+		Actor:     sql.NullString{String: a.GetActor(), Valid: true},
+		Repo:      sql.NullString{String: a.GetRepo(), Valid: true},
+		Event:     sql.NullString{String: a.GetEvent(), Valid: true},
+		Message:   sql.NullString{String: a.GetMessage(), Valid: true},
+		IP:        sql.NullString{String: a.GetIP(), Valid: true},
+		UserAgent: sql.NullString{String: a.GetUserAgent(), Valid: true},
+		Client:    sql.NullString{String: a.GetClient(), Valid: true},
+		Location:  sql.NullString{String: a.GetLocation(), Valid: true},
+		Created:   sql.NullInt64{Int64: a.GetCreated(), Valid: true},
+	}
+
+	return audit.Nullify()
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestFreezeAudit_Nullify(t *testing.T) {
+	// setup types
+	var a *FreezeAudit
+
+	want := &FreezeAudit{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		FreezeID:  sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		Action:    sql.NullString{String: "", Valid: false},
+		Actor:     sql.NullString{String: "", Valid: false},
+		Repo:      sql.NullString{String: "", Valid: false},
+		Event:     sql.NullString{String: "", Valid: false},
+		Message:   sql.NullString{String: "", Valid: false},
+		IP:        sql.NullString{String: "", Valid: false},
+		UserAgent: sql.NullString{String: "", Valid: false},
+		Client:    sql.NullString{String: "", Valid: false},
+		Location:  sql.NullString{String: "", Valid: false},
+		Created:   sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *FreezeAudit
+		want *FreezeAudit
+	}{
+		{
+			item: testFreezeAudit(),
+			want: testFreezeAudit(),
+		},
+		{
+			item: a,
+			want: nil,
+		},
+		{
+			item: new(FreezeAudit),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestFreezeAudit_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.FreezeAudit)
+
+	want.SetID(1)
+	want.SetFreezeID(1)
+	want.SetOrg("github")
+	want.SetAction("overridden")
+	want.SetActor("octocat")
+	want.SetRepo("github/octocat")
+	want.SetEvent("deployment")
+	want.SetMessage("deploying hotfix for outage")
+	want.SetIP("1.2.3.4")
+	want.SetUserAgent("vela-cli")
+	want.SetClient("Vela CLI")
+	want.SetLocation("Minneapolis, Minnesota, US")
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testFreezeAudit().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestFreezeAudit_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *FreezeAudit
+	}{
+		{
+			failure: false,
+			item:    testFreezeAudit(),
+		},
+		{ // no FreezeID set for FreezeAudit
+			failure: true,
+			item: func() *FreezeAudit {
+				a := testFreezeAudit()
+				a.FreezeID = sql.NullInt64{}
+
+				return a
+			}(),
+		},
+		{ // no Action set for FreezeAudit
+			failure: true,
+			item: func() *FreezeAudit {
+				a := testFreezeAudit()
+				a.Action = sql.NullString{}
+
+				return a
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestFreezeAuditFromAPI(t *testing.T) {
+	// setup types
+	a := new(api.FreezeAudit)
+
+	a.SetID(1)
+	a.SetFreezeID(1)
+	a.SetOrg("github")
+	a.SetAction("overridden")
+	a.SetActor("octocat")
+	a.SetRepo("github/octocat")
+	a.SetEvent("deployment")
+	a.SetMessage("deploying hotfix for outage")
+	a.SetIP("1.2.3.4")
+	a.SetUserAgent("vela-cli")
+	a.SetClient("Vela CLI")
+	a.SetLocation("Minneapolis, Minnesota, US")
+	a.SetCreated(1563474076)
+
+	want := testFreezeAudit()
+
+	// run test
+	got := FreezeAuditFromAPI(a)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FreezeAuditFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testFreezeAudit is a test helper function to create a FreezeAudit
+// type with all fields set to a fake value.
+func testFreezeAudit() *FreezeAudit {
+	return &FreezeAudit{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		FreezeID:  sql.NullInt64{Int64: 1, Valid: true},
+		Org:       sql.NullString{String: "github", Valid: true},
+		Action:    sql.NullString{String: "overridden", Valid: true},
+		Actor:     sql.NullString{String: "octocat", Valid: true},
+		Repo:      sql.NullString{String: "github/octocat", Valid: true},
+		Event:     sql.NullString{String: "deployment", Valid: true},
+		Message:   sql.NullString{String: "deploying hotfix for outage", Valid: true},
+		IP:        sql.NullString{String: "1.2.3.4", Valid: true},
+		UserAgent: sql.NullString{String: "vela-cli", Valid: true},
+		Client:    sql.NullString{String: "Vela CLI", Valid: true},
+		Location:  sql.NullString{String: "Minneapolis, Minnesota, US", Valid: true},
+		Created:   sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+func TestFreeze_Nullify(t *testing.T) {
+	// setup types
+	var f *Freeze
+
+	want := &Freeze{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		Name:      sql.NullString{String: "", Valid: false},
+		Reason:    sql.NullString{String: "", Valid: false},
+		Scope:     sql.NullString{String: "", Valid: false},
+		Starts:    sql.NullInt64{Int64: 0, Valid: false},
+		Ends:      sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *Freeze
+		want *Freeze
+	}{
+		{
+			item: testFreeze(),
+			want: testFreeze(),
+		},
+		{
+			item: f,
+			want: nil,
+		},
+		{
+			item: new(Freeze),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestFreeze_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Freeze)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetName("holiday")
+	want.SetReason("end of year change freeze")
+	want.SetScope("deploy")
+	want.SetStarts(1563474076)
+	want.SetEnds(1563560476)
+	want.SetExemptRepos([]string{"github/octocat"})
+	want.SetExemptEvents([]string{"pull_request"})
+	want.SetCreatedAt(1563474000)
+	want.SetCreatedBy("octocat")
+
+	// run test
+	got := testFreeze().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestFreeze_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *Freeze
+	}{
+		{
+			failure: false,
+			item:    testFreeze(),
+		},
+		{ // no Name set for Freeze
+			failure: true,
+			item: func() *Freeze {
+				f := testFreeze()
+				f.Name = sql.NullString{}
+
+				return f
+			}(),
+		},
+		{ // invalid Scope set for Freeze
+			failure: true,
+			item: func() *Freeze {
+				f := testFreeze()
+				f.Scope = sql.NullString{String: "push", Valid: true}
+
+				return f
+			}(),
+		},
+		{ // Ends not after Starts set for Freeze
+			failure: true,
+			item: func() *Freeze {
+				f := testFreeze()
+				f.Ends = f.Starts
+
+				return f
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestFreezeFromAPI(t *testing.T) {
+	// setup types
+	f := new(api.Freeze)
+
+	f.SetID(1)
+	f.SetOrg("github")
+	f.SetName("holiday")
+	f.SetReason("end of year change freeze")
+	f.SetScope("deploy")
+	f.SetStarts(1563474076)
+	f.SetEnds(1563560476)
+	f.SetExemptRepos([]string{"github/octocat"})
+	f.SetExemptEvents([]string{"pull_request"})
+	f.SetCreatedAt(1563474000)
+	f.SetCreatedBy("octocat")
+
+	want := testFreeze()
+
+	// run test
+	got := FreezeFromAPI(f)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FreezeFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testFreeze is a test helper function to create a Freeze
+// type with all fields set to a fake value.
+func testFreeze() *Freeze {
+	return &Freeze{
+		ID:           sql.NullInt64{Int64: 1, Valid: true},
+		Org:          sql.NullString{String: "github", Valid: true},
+		Name:         sql.NullString{String: "holiday", Valid: true},
+		Reason:       sql.NullString{String: "end of year change freeze", Valid: true},
+		Scope:        sql.NullString{String: "deploy", Valid: true},
+		Starts:       sql.NullInt64{Int64: 1563474076, Valid: true},
+		Ends:         sql.NullInt64{Int64: 1563560476, Valid: true},
+		ExemptRepos:  pq.StringArray{"github/octocat"},
+		ExemptEvents: pq.StringArray{"pull_request"},
+		CreatedAt:    sql.NullInt64{Int64: 1563474000, Valid: true},
+		CreatedBy:    sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyIdempotencyKeyRepoID defines the error type when a
+	// IdempotencyKey type has an empty RepoID field provided.
+	ErrEmptyIdempotencyKeyRepoID = errors.New("empty idempotency key repo_id provided")
+
+	// ErrEmptyIdempotencyKeyKey defines the error type when a
+	// IdempotencyKey type has an empty Key field provided.
+	ErrEmptyIdempotencyKeyKey = errors.New("empty idempotency key key provided")
+
+	// ErrEmptyIdempotencyKeyFingerprint defines the error type when a
+	// IdempotencyKey type has an empty Fingerprint field provided.
+	ErrEmptyIdempotencyKeyFingerprint = errors.New("empty idempotency key fingerprint provided")
+)
+
+// IdempotencyKey is the database representation of a key provided with a build-triggering
+// request used to deduplicate retries of the request.
+type IdempotencyKey struct {
+	ID          sql.NullInt64  `sql:"id"`
+	RepoID      sql.NullInt64  `sql:"repo_id"`
+	Key         sql.NullString `sql:"key"`
+	Fingerprint sql.NullString `sql:"fingerprint"`
+	Status      sql.NullInt32  `sql:"status"`
+	Response    sql.NullString `sql:"response"`
+	Created     sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the IdempotencyKey type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (k *IdempotencyKey) Nullify() *IdempotencyKey {
+	if k == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if k.ID.Int64 == 0 {
+		k.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if k.RepoID.Int64 == 0 {
+		k.RepoID.Valid = false
+	}
+
+	// check if the Key field should be false
+	if len(k.Key.String) == 0 {
+		k.Key.Valid = false
+	}
+
+	// check if the Fingerprint field should be false
+	if len(k.Fingerprint.String) == 0 {
+		k.Fingerprint.Valid = false
+	}
+
+	// check if the Status field should be false
+	if k.Status.Int32 == 0 {
+		k.Status.Valid = false
+	}
+
+	// check if the Response field should be false
+	if len(k.Response.String) == 0 {
+		k.Response.Valid = false
+	}
+
+	// check if the Created field should be false
+	if k.Created.Int64 == 0 {
+		k.Created.Valid = false
+	}
+
+	return k
+}
+
+// ToAPI converts the IdempotencyKey type
+// to an API IdempotencyKey type.
+func (k *IdempotencyKey) ToAPI() *api.IdempotencyKey {
+	key := new(api.IdempotencyKey)
+
+	key.SetID(k.ID.Int64)
+	key.SetRepoID(k.RepoID.Int64)
+	key.SetKey(k.Key.String)
+	key.SetFingerprint(k.Fingerprint.String)
+	key.SetStatus(int(k.Status.Int32))
+	key.SetResponse(k.Response.String)
+	key.SetCreated(k.Created.Int64)
+
+	return key
+}
+
+// Validate verifies the necessary fields for
+// the IdempotencyKey type are populated correctly.
+func (k *IdempotencyKey) Validate() error {
+	// verify the RepoID field is populated
+	if k.RepoID.Int64 <= 0 {
+		return ErrEmptyIdempotencyKeyRepoID
+	}
+
+	// verify the Key field is populated
+	if len(k.Key.String) == 0 {
+		return ErrEmptyIdempotencyKeyKey
+	}
+
+	// verify the Fingerprint field is populated
+	if len(k.Fingerprint.String) == 0 {
+		return ErrEmptyIdempotencyKeyFingerprint
+	}
+
+	return nil
+}
+
+// IdempotencyKeyFromAPI converts the API IdempotencyKey type
+// to a database IdempotencyKey type.
+func IdempotencyKeyFromAPI(k *api.IdempotencyKey) *IdempotencyKey {
+	key := &IdempotencyKey{
+		ID:          sql.NullInt64{Int64: k.GetID(), Valid: true},
+		RepoID:      sql.NullInt64{Int64: k.GetRepoID(), Valid: true},
+		Key:         sql.NullString{String: k.GetKey(), Valid: true},
+		Fingerprint: sql.NullString{String: k.GetFingerprint(), Valid: true},
+		Status:      sql.NullInt32{Int32: int32(k.GetStatus()), Valid: true},
+		Response:    sql.NullString{String: k.GetResponse(), Valid: true},
+		Created:     sql.NullInt64{Int64: k.GetCreated(), Valid: true},
+	}
+
+	return key.Nullify()
+}
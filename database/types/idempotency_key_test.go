@@ -0,0 +1,169 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestIdempotencyKey_Nullify(t *testing.T) {
+	// setup types
+	var k *IdempotencyKey
+
+	want := &IdempotencyKey{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:      sql.NullInt64{Int64: 0, Valid: false},
+		Key:         sql.NullString{String: "", Valid: false},
+		Fingerprint: sql.NullString{String: "", Valid: false},
+		Status:      sql.NullInt32{Int32: 0, Valid: false},
+		Response:    sql.NullString{String: "", Valid: false},
+		Created:     sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *IdempotencyKey
+		want *IdempotencyKey
+	}{
+		{
+			item: testIdempotencyKey(),
+			want: testIdempotencyKey(),
+		},
+		{
+			item: k,
+			want: nil,
+		},
+		{
+			item: new(IdempotencyKey),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestIdempotencyKey_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.IdempotencyKey)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetKey("7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a")
+	want.SetFingerprint("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	want.SetStatus(201)
+	want.SetResponse(`{"id":1}`)
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testIdempotencyKey().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestIdempotencyKey_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *IdempotencyKey
+	}{
+		{
+			failure: false,
+			item:    testIdempotencyKey(),
+		},
+		{ // no RepoID set for IdempotencyKey
+			failure: true,
+			item: func() *IdempotencyKey {
+				k := testIdempotencyKey()
+				k.RepoID = sql.NullInt64{}
+
+				return k
+			}(),
+		},
+		{ // no Key set for IdempotencyKey
+			failure: true,
+			item: func() *IdempotencyKey {
+				k := testIdempotencyKey()
+				k.Key = sql.NullString{}
+
+				return k
+			}(),
+		},
+		{ // no Fingerprint set for IdempotencyKey
+			failure: true,
+			item: func() *IdempotencyKey {
+				k := testIdempotencyKey()
+				k.Fingerprint = sql.NullString{}
+
+				return k
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestIdempotencyKeyFromAPI(t *testing.T) {
+	// setup types
+	k := new(api.IdempotencyKey)
+
+	k.SetID(1)
+	k.SetRepoID(1)
+	k.SetKey("7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a")
+	k.SetFingerprint("2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae")
+	k.SetStatus(201)
+	k.SetResponse(`{"id":1}`)
+	k.SetCreated(1563474076)
+
+	want := testIdempotencyKey()
+
+	// run test
+	got := IdempotencyKeyFromAPI(k)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("IdempotencyKeyFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testIdempotencyKey is a test helper function to create an IdempotencyKey
+// type with all fields set to a fake value.
+func testIdempotencyKey() *IdempotencyKey {
+	return &IdempotencyKey{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:      sql.NullInt64{Int64: 1, Valid: true},
+		Key:         sql.NullString{String: "7c0f3a2e-5d0b-4f4e-9d1c-2f6f0c1b9e3a", Valid: true},
+		Fingerprint: sql.NullString{String: "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", Valid: true},
+		Status:      sql.NullInt32{Int32: 201, Valid: true},
+		Response:    sql.NullString{String: `{"id":1}`, Valid: true},
+		Created:     sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
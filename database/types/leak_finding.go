@@ -0,0 +1,160 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyLeakFindingBuildID defines the error type when a
+	// LeakFinding type has an empty BuildID field provided.
+	ErrEmptyLeakFindingBuildID = errors.New("empty leak finding build_id provided")
+
+	// ErrEmptyLeakFindingFingerprint defines the error type when a
+	// LeakFinding type has an empty Fingerprint field provided.
+	ErrEmptyLeakFindingFingerprint = errors.New("empty leak finding fingerprint provided")
+
+	// ErrEmptyLeakFindingKind defines the error type when a
+	// LeakFinding type has an empty Kind field provided.
+	ErrEmptyLeakFindingKind = errors.New("empty leak finding kind provided")
+
+	// ErrEmptyLeakFindingRepoID defines the error type when a
+	// LeakFinding type has an empty RepoID field provided.
+	ErrEmptyLeakFindingRepoID = errors.New("empty leak finding repo_id provided")
+)
+
+// LeakFinding is the database representation of a credential
+// found in a log for a build by the leak scanner.
+type LeakFinding struct {
+	ID          sql.NullInt64  `sql:"id"`
+	RepoID      sql.NullInt64  `sql:"repo_id"`
+	BuildID     sql.NullInt64  `sql:"build_id"`
+	LogID       sql.NullInt64  `sql:"log_id"`
+	Kind        sql.NullString `sql:"kind"`
+	Line        sql.NullInt64  `sql:"line"`
+	Fingerprint sql.NullString `sql:"fingerprint"`
+	Redacted    sql.NullBool   `sql:"redacted"`
+	Created     sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the LeakFinding type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (f *LeakFinding) Nullify() *LeakFinding {
+	if f == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if f.ID.Int64 == 0 {
+		f.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if f.RepoID.Int64 == 0 {
+		f.RepoID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if f.BuildID.Int64 == 0 {
+		f.BuildID.Valid = false
+	}
+
+	// check if the LogID field should be false
+	if f.LogID.Int64 == 0 {
+		f.LogID.Valid = false
+	}
+
+	// check if the Kind field should be false
+	if len(f.Kind.String) == 0 {
+		f.Kind.Valid = false
+	}
+
+	// check if the Line field should be false
+	if f.Line.Int64 == 0 {
+		f.Line.Valid = false
+	}
+
+	// check if the Fingerprint field should be false
+	if len(f.Fingerprint.String) == 0 {
+		f.Fingerprint.Valid = false
+	}
+
+	// check if the Created field should be false
+	if f.Created.Int64 == 0 {
+		f.Created.Valid = false
+	}
+
+	return f
+}
+
+// ToAPI converts the LeakFinding type
+// to an API LeakFinding type.
+func (f *LeakFinding) ToAPI() *api.LeakFinding {
+	finding := new(api.LeakFinding)
+
+	finding.SetID(f.ID.Int64)
+	finding.SetRepoID(f.RepoID.Int64)
+	finding.SetBuildID(f.BuildID.Int64)
+	finding.SetLogID(f.LogID.Int64)
+	finding.SetKind(f.Kind.String)
+	finding.SetLine(f.Line.Int64)
+	finding.SetFingerprint(f.Fingerprint.String)
+	finding.SetRedacted(f.Redacted.Bool)
+	finding.SetCreated(f.Created.Int64)
+
+	return finding
+}
+
+// Validate verifies the necessary fields for
+// the LeakFinding type are populated correctly.
+func (f *LeakFinding) Validate() error {
+	// verify the RepoID field is populated
+	if f.RepoID.Int64 <= 0 {
+		return ErrEmptyLeakFindingRepoID
+	}
+
+	// verify the BuildID field is populated
+	if f.BuildID.Int64 <= 0 {
+		return ErrEmptyLeakFindingBuildID
+	}
+
+	// verify the Kind field is populated
+	if len(f.Kind.String) == 0 {
+		return ErrEmptyLeakFindingKind
+	}
+
+	// verify the Fingerprint field is populated
+	if len(f.Fingerprint.String) == 0 {
+		return ErrEmptyLeakFindingFingerprint
+	}
+
+	return nil
+}
+
+// LeakFindingFromAPI converts the API LeakFinding type
+// to a database LeakFinding type.
+func LeakFindingFromAPI(f *api.LeakFinding) *LeakFinding {
+	finding := &LeakFinding{
+		ID:          sql.NullInt64{Int64: f.GetID(), Valid: true},
+		RepoID:      sql.NullInt64{Int64: f.GetRepoID(), Valid: true},
+		BuildID:     sql.NullInt64{Int64: f.GetBuildID(), Valid: true},
+		LogID:       sql.NullInt64{Int64: f.GetLogID(), Valid: true},
+		Kind:        sql.NullString{String: f.GetKind(), Valid: true},
+		Line:        sql.NullInt64{Int64: f.GetLine(), Valid: true},
+		Fingerprint: sql.NullString{String: f.GetFingerprint(), Valid: true},
+		Redacted:    sql.NullBool{Bool: f.GetRedacted(), Valid: true},
+		Created:     sql.NullInt64{Int64: f.GetCreated(), Valid: true},
+	}
+
+	return finding.Nullify()
+}
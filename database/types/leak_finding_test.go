@@ -0,0 +1,182 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLeakFinding_Nullify(t *testing.T) {
+	// setup types
+	var f *LeakFinding
+
+	want := &LeakFinding{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:     sql.NullInt64{Int64: 0, Valid: false},
+		LogID:       sql.NullInt64{Int64: 0, Valid: false},
+		Kind:        sql.NullString{String: "", Valid: false},
+		Line:        sql.NullInt64{Int64: 0, Valid: false},
+		Fingerprint: sql.NullString{String: "", Valid: false},
+		Redacted:    sql.NullBool{Bool: false, Valid: false},
+		Created:     sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *LeakFinding
+		want *LeakFinding
+	}{
+		{
+			item: testLeakFinding(),
+			want: testLeakFinding(),
+		},
+		{
+			item: f,
+			want: nil,
+		},
+		{
+			item: new(LeakFinding),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestLeakFinding_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.LeakFinding)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetBuildID(1)
+	want.SetLogID(1)
+	want.SetKind(api.LeakKindAWSAccessKey)
+	want.SetLine(3)
+	want.SetFingerprint("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	want.SetRedacted(true)
+	want.SetCreated(1563474077)
+
+	// run test
+	got := testLeakFinding().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestLeakFinding_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *LeakFinding
+	}{
+		{
+			failure: false,
+			item:    testLeakFinding(),
+		},
+		{ // no repo_id set for LeakFinding
+			failure: true,
+			item: &LeakFinding{
+				BuildID:     sql.NullInt64{Int64: 1, Valid: true},
+				Kind:        sql.NullString{String: api.LeakKindJWT, Valid: true},
+				Fingerprint: sql.NullString{String: "abc", Valid: true},
+			},
+		},
+		{ // no build_id set for LeakFinding
+			failure: true,
+			item: &LeakFinding{
+				RepoID:      sql.NullInt64{Int64: 1, Valid: true},
+				Kind:        sql.NullString{String: api.LeakKindJWT, Valid: true},
+				Fingerprint: sql.NullString{String: "abc", Valid: true},
+			},
+		},
+		{ // no kind set for LeakFinding
+			failure: true,
+			item: &LeakFinding{
+				RepoID:      sql.NullInt64{Int64: 1, Valid: true},
+				BuildID:     sql.NullInt64{Int64: 1, Valid: true},
+				Fingerprint: sql.NullString{String: "abc", Valid: true},
+			},
+		},
+		{ // no fingerprint set for LeakFinding
+			failure: true,
+			item: &LeakFinding{
+				RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+				BuildID: sql.NullInt64{Int64: 1, Valid: true},
+				Kind:    sql.NullString{String: api.LeakKindJWT, Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestLeakFindingFromAPI(t *testing.T) {
+	// setup types
+	f := new(api.LeakFinding)
+
+	f.SetID(1)
+	f.SetRepoID(1)
+	f.SetBuildID(1)
+	f.SetLogID(1)
+	f.SetKind(api.LeakKindAWSAccessKey)
+	f.SetLine(3)
+	f.SetFingerprint("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08")
+	f.SetRedacted(true)
+	f.SetCreated(1563474077)
+
+	want := testLeakFinding()
+
+	// run test
+	got := LeakFindingFromAPI(f)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LeakFindingFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testLeakFinding is a test helper function to create a LeakFinding
+// type with all fields set to a fake value.
+func testLeakFinding() *LeakFinding {
+	return &LeakFinding{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:      sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:     sql.NullInt64{Int64: 1, Valid: true},
+		LogID:       sql.NullInt64{Int64: 1, Valid: true},
+		Kind:        sql.NullString{String: api.LeakKindAWSAccessKey, Valid: true},
+		Line:        sql.NullInt64{Int64: 3, Valid: true},
+		Fingerprint: sql.NullString{String: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", Valid: true},
+		Redacted:    sql.NullBool{Bool: true, Valid: true},
+		Created:     sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyLeaseName defines the error type when a
+	// Lease type has an empty Name field provided.
+	ErrEmptyLeaseName = errors.New("empty lease name provided")
+
+	// ErrEmptyLeaseHolder defines the error type when a
+	// Lease type has an empty Holder field provided.
+	ErrEmptyLeaseHolder = errors.New("empty lease holder provided")
+)
+
+// Lease is the database representation of the lease a server
+// replica holds to run a singleton background component.
+type Lease struct {
+	ID       sql.NullInt64  `sql:"id"`
+	Name     sql.NullString `sql:"name"`
+	Holder   sql.NullString `sql:"holder"`
+	State    sql.NullString `sql:"state"`
+	Acquired sql.NullInt64  `sql:"acquired"`
+	Renewed  sql.NullInt64  `sql:"renewed"`
+	Expires  sql.NullInt64  `sql:"expires"`
+	Changes  sql.NullInt64  `sql:"changes"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Lease type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (l *Lease) Nullify() *Lease {
+	if l == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if l.ID.Int64 == 0 {
+		l.ID.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(l.Name.String) == 0 {
+		l.Name.Valid = false
+	}
+
+	// check if the Holder field should be false
+	if len(l.Holder.String) == 0 {
+		l.Holder.Valid = false
+	}
+
+	// check if the State field should be false
+	if len(l.State.String) == 0 {
+		l.State.Valid = false
+	}
+
+	// check if the Acquired field should be false
+	if l.Acquired.Int64 == 0 {
+		l.Acquired.Valid = false
+	}
+
+	// check if the Renewed field should be false
+	if l.Renewed.Int64 == 0 {
+		l.Renewed.Valid = false
+	}
+
+	// check if the Expires field should be false
+	if l.Expires.Int64 == 0 {
+		l.Expires.Valid = false
+	}
+
+	// check if the Changes field should be false
+	if l.Changes.Int64 == 0 {
+		l.Changes.Valid = false
+	}
+
+	return l
+}
+
+// ToAPI converts the Lease type
+// to an API Lease type.
+func (l *Lease) ToAPI() *api.Lease {
+	lease := new(api.Lease)
+
+	lease.SetID(l.ID.Int64)
+	lease.SetName(l.Name.String)
+	lease.SetHolder(l.Holder.String)
+	lease.SetState(l.State.String)
+	lease.SetAcquired(l.Acquired.Int64)
+	lease.SetRenewed(l.Renewed.Int64)
+	lease.SetExpires(l.Expires.Int64)
+	lease.SetChanges(l.Changes.Int64)
+
+	return lease
+}
+
+// Validate verifies the necessary fields for
+// the Lease type are populated correctly.
+func (l *Lease) Validate() error {
+	// verify the Name field is populated
+	if len(l.Name.String) == 0 {
+		return ErrEmptyLeaseName
+	}
+
+	// verify the Holder field is populated
+	if len(l.Holder.String) == 0 {
+		return ErrEmptyLeaseHolder
+	}
+
+	return nil
+}
+
+// LeaseFromAPI converts the API Lease type
+// to a database Lease type.
+func LeaseFromAPI(l *api.Lease) *Lease {
+	lease := &Lease{
+		ID:       sql.NullInt64{Int64: l.GetID(), Valid: true},
+		Name:     sql.NullString{String: l.GetName(), Valid: true},
+		Holder:   sql.NullString{String: l.GetHolder(), Valid: true},
+		State:    sql.NullString{String: l.GetState(), Valid: true},
+		Acquired: sql.NullInt64{Int64: l.GetAcquired(), Valid: true},
+		Renewed:  sql.NullInt64{Int64: l.GetRenewed(), Valid: true},
+		Expires:  sql.NullInt64{Int64: l.GetExpires(), Valid: true},
+		Changes:  sql.NullInt64{Int64: l.GetChanges(), Valid: true},
+	}
+
+	return lease.Nullify()
+}
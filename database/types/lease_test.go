@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLease_Nullify(t *testing.T) {
+	// setup types
+	var l *Lease
+
+	want := &Lease{
+		ID:       sql.NullInt64{Int64: 0, Valid: false},
+		Name:     sql.NullString{String: "", Valid: false},
+		Holder:   sql.NullString{String: "", Valid: false},
+		State:    sql.NullString{String: "", Valid: false},
+		Acquired: sql.NullInt64{Int64: 0, Valid: false},
+		Renewed:  sql.NullInt64{Int64: 0, Valid: false},
+		Expires:  sql.NullInt64{Int64: 0, Valid: false},
+		Changes:  sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *Lease
+		want *Lease
+	}{
+		{
+			item: testLease(),
+			want: testLease(),
+		},
+		{
+			item: l,
+			want: nil,
+		},
+		{
+			item: new(Lease),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestLease_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Lease)
+
+	want.SetID(1)
+	want.SetName("janitor")
+	want.SetHolder("vela-server-0")
+	want.SetState("1563474076")
+	want.SetAcquired(1563474076)
+	want.SetRenewed(1563474086)
+	want.SetExpires(1563474116)
+	want.SetChanges(1)
+
+	// run test
+	got := testLease().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestLease_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *Lease
+	}{
+		{
+			failure: false,
+			item:    testLease(),
+		},
+		{ // no Name set for Lease
+			failure: true,
+			item: func() *Lease {
+				l := testLease()
+				l.Name = sql.NullString{}
+
+				return l
+			}(),
+		},
+		{ // no Holder set for Lease
+			failure: true,
+			item: func() *Lease {
+				l := testLease()
+				l.Holder = sql.NullString{}
+
+				return l
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestLeaseFromAPI(t *testing.T) {
+	// setup types
+	l := new(api.Lease)
+
+	l.SetID(1)
+	l.SetName("janitor")
+	l.SetHolder("vela-server-0")
+	l.SetState("1563474076")
+	l.SetAcquired(1563474076)
+	l.SetRenewed(1563474086)
+	l.SetExpires(1563474116)
+	l.SetChanges(1)
+
+	want := testLease()
+
+	// run test
+	got := LeaseFromAPI(l)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LeaseFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testLease is a test helper function to create a Lease
+// type with all fields set to a fake value.
+func testLease() *Lease {
+	return &Lease{
+		ID:       sql.NullInt64{Int64: 1, Valid: true},
+		Name:     sql.NullString{String: "janitor", Valid: true},
+		Holder:   sql.NullString{String: "vela-server-0", Valid: true},
+		State:    sql.NullString{String: "1563474076", Valid: true},
+		Acquired: sql.NullInt64{Int64: 1563474076, Valid: true},
+		Renewed:  sql.NullInt64{Int64: 1563474086, Valid: true},
+		Expires:  sql.NullInt64{Int64: 1563474116, Valid: true},
+		Changes:  sql.NullInt64{Int64: 1, Valid: true},
+	}
+}
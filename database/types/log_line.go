@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyLogLineLogID defines the error type when a
+	// LogLine type has an empty LogID field provided.
+	ErrEmptyLogLineLogID = errors.New("empty log line log_id provided")
+
+	// ErrEmptyLogLineNumber defines the error type when a
+	// LogLine type has an empty Number field provided.
+	ErrEmptyLogLineNumber = errors.New("empty log line number provided")
+
+	// ErrEmptyLogLineStream defines the error type when a
+	// LogLine type has an empty Stream field provided.
+	ErrEmptyLogLineStream = errors.New("empty log line stream provided")
+)
+
+// LogLine is the database representation of a single line of output
+// captured from a step or service with its metadata.
+type LogLine struct {
+	ID        sql.NullInt64  `sql:"id"`
+	BuildID   sql.NullInt64  `sql:"build_id"`
+	LogID     sql.NullInt64  `sql:"log_id"`
+	Number    sql.NullInt32  `sql:"number"`
+	Stream    sql.NullString `sql:"stream"`
+	Timestamp sql.NullInt64  `sql:"timestamp"`
+	Data      sql.NullString `sql:"data"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the LogLine type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (l *LogLine) Nullify() *LogLine {
+	if l == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if l.ID.Int64 == 0 {
+		l.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if l.BuildID.Int64 == 0 {
+		l.BuildID.Valid = false
+	}
+
+	// check if the LogID field should be false
+	if l.LogID.Int64 == 0 {
+		l.LogID.Valid = false
+	}
+
+	// check if the Number field should be false
+	if l.Number.Int32 == 0 {
+		l.Number.Valid = false
+	}
+
+	// check if the Stream field should be false
+	if len(l.Stream.String) == 0 {
+		l.Stream.Valid = false
+	}
+
+	// check if the Timestamp field should be false
+	if l.Timestamp.Int64 == 0 {
+		l.Timestamp.Valid = false
+	}
+
+	// check if the Data field should be false
+	if len(l.Data.String) == 0 {
+		l.Data.Valid = false
+	}
+
+	return l
+}
+
+// ToAPI converts the LogLine type
+// to an API LogLine type.
+func (l *LogLine) ToAPI() *api.LogLine {
+	logLine := new(api.LogLine)
+
+	logLine.SetID(l.ID.Int64)
+	logLine.SetBuildID(l.BuildID.Int64)
+	logLine.SetLogID(l.LogID.Int64)
+	logLine.SetNumber(int(l.Number.Int32))
+	logLine.SetStream(l.Stream.String)
+	logLine.SetTimestamp(l.Timestamp.Int64)
+	logLine.SetData(l.Data.String)
+
+	return logLine
+}
+
+// Validate verifies the necessary fields for
+// the LogLine type are populated correctly.
+func (l *LogLine) Validate() error {
+	// verify the LogID field is populated
+	if l.LogID.Int64 <= 0 {
+		return ErrEmptyLogLineLogID
+	}
+
+	// verify the Number field is populated
+	if l.Number.Int32 <= 0 {
+		return ErrEmptyLogLineNumber
+	}
+
+	// verify the Stream field is populated
+	if len(l.Stream.String) == 0 {
+		return ErrEmptyLogLineStream
+	}
+
+	return nil
+}
+
+// LogLineFromAPI converts the API LogLine type
+// to a database LogLine type.
+func LogLineFromAPI(l *api.LogLine) *LogLine {
+	logLine := &LogLine{
+		ID:        sql.NullInt64{Int64: l.GetID(), Valid: true},
+		BuildID:   sql.NullInt64{Int64: l.GetBuildID(), Valid: true},
+		LogID:     sql.NullInt64{Int64: l.GetLogID(), Valid: true},
+		Number:    sql.NullInt32{Int32: int32(l.GetNumber()), Valid: true},
+		Stream:    sql.NullString{String: l.GetStream(), Valid: true},
+		Timestamp: sql.NullInt64{Int64: l.GetTimestamp(), Valid: true},
+		Data:      sql.NullString{String: l.GetData(), Valid: true},
+	}
+
+	return logLine.Nullify()
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestLogLine_Nullify(t *testing.T) {
+	// setup types
+	var l *LogLine
+
+	want := &LogLine{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:   sql.NullInt64{Int64: 0, Valid: false},
+		LogID:     sql.NullInt64{Int64: 0, Valid: false},
+		Number:    sql.NullInt32{Int32: 0, Valid: false},
+		Stream:    sql.NullString{String: "", Valid: false},
+		Timestamp: sql.NullInt64{Int64: 0, Valid: false},
+		Data:      sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *LogLine
+		want *LogLine
+	}{
+		{
+			item: testLogLine(),
+			want: testLogLine(),
+		},
+		{
+			item: l,
+			want: nil,
+		},
+		{
+			item: new(LogLine),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestLogLine_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.LogLine)
+
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetLogID(1)
+	want.SetNumber(1)
+	want.SetStream("stdout")
+	want.SetTimestamp(1563474077000)
+	want.SetData("hello world")
+
+	// run test
+	got := testLogLine().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestLogLine_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *LogLine
+	}{
+		{
+			failure: false,
+			item:    testLogLine(),
+		},
+		{ // no LogID set for LogLine
+			failure: true,
+			item: func() *LogLine {
+				l := testLogLine()
+				l.LogID = sql.NullInt64{}
+
+				return l
+			}(),
+		},
+		{ // no Number set for LogLine
+			failure: true,
+			item: func() *LogLine {
+				l := testLogLine()
+				l.Number = sql.NullInt32{}
+
+				return l
+			}(),
+		},
+		{ // no Stream set for LogLine
+			failure: true,
+			item: func() *LogLine {
+				l := testLogLine()
+				l.Stream = sql.NullString{}
+
+				return l
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestLogLineFromAPI(t *testing.T) {
+	// setup types
+	l := new(api.LogLine)
+
+	l.SetID(1)
+	l.SetBuildID(1)
+	l.SetLogID(1)
+	l.SetNumber(1)
+	l.SetStream("stdout")
+	l.SetTimestamp(1563474077000)
+	l.SetData("hello world")
+
+	want := testLogLine()
+
+	// run test
+	got := LogLineFromAPI(l)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LogLineFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testLogLine is a test helper function to create a LogLine
+// type with all fields set to a fake value.
+func testLogLine() *LogLine {
+	return &LogLine{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:   sql.NullInt64{Int64: 1, Valid: true},
+		LogID:     sql.NullInt64{Int64: 1, Valid: true},
+		Number:    sql.NullInt32{Int32: 1, Valid: true},
+		Stream:    sql.NullString{String: "stdout", Valid: true},
+		Timestamp: sql.NullInt64{Int64: 1563474077000, Valid: true},
+		Data:      sql.NullString{String: "hello world", Valid: true},
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyOrgHookOrg defines the error type when a
+	// OrgHook type has an empty Org field provided.
+	ErrEmptyOrgHookOrg = errors.New("empty org hook org provided")
+
+	// ErrEmptyOrgHookSecret defines the error type when a
+	// OrgHook type has an empty Secret field provided.
+	ErrEmptyOrgHookSecret = errors.New("empty org hook secret provided")
+)
+
+// OrgHook is the database representation of a webhook
+// registered for an org in the scm.
+type OrgHook struct {
+	ID         sql.NullInt64  `sql:"id"`
+	Org        sql.NullString `sql:"org"`
+	WebhookID  sql.NullInt64  `sql:"webhook_id"`
+	Secret     sql.NullString `sql:"secret"`
+	AutoEnable sql.NullBool   `sql:"auto_enable"`
+	UserID     sql.NullInt64  `sql:"user_id"`
+	Active     sql.NullBool   `sql:"active"`
+	CreatedAt  sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy  sql.NullString `sql:"created_by"`
+	UpdatedAt  sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy  sql.NullString `sql:"updated_by"`
+}
+
+// Decrypt will manipulate the existing secret by
+// base64 decoding that value. Then, a AES-256 cipher
+// block is created from the encryption key in order to
+// decrypt the base64 decoded secret.
+func (o *OrgHook) Decrypt(key string) error {
+	// base64 decode the encrypted secret
+	decoded, err := base64.StdEncoding.DecodeString(o.Secret.String)
+	if err != nil {
+		return err
+	}
+
+	// decrypt the base64 decoded secret
+	decrypted, err := decrypt(key, decoded)
+	if err != nil {
+		return err
+	}
+
+	// set the decrypted secret
+	o.Secret = sql.NullString{
+		String: string(decrypted),
+		Valid:  true,
+	}
+
+	return nil
+}
+
+// Encrypt will manipulate the existing secret by
+// creating a AES-256 cipher block from the encryption
+// key in order to encrypt the secret. Then, the
+// secret is base64 encoded for transport across
+// network boundaries.
+func (o *OrgHook) Encrypt(key string) error {
+	// encrypt the secret
+	encrypted, err := encrypt(key, []byte(o.Secret.String))
+	if err != nil {
+		return err
+	}
+
+	// base64 encode the encrypted secret to make it network safe
+	o.Secret = sql.NullString{
+		String: base64.StdEncoding.EncodeToString(encrypted),
+		Valid:  true,
+	}
+
+	return nil
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the OrgHook type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (o *OrgHook) Nullify() *OrgHook {
+	if o == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if o.ID.Int64 == 0 {
+		o.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(o.Org.String) == 0 {
+		o.Org.Valid = false
+	}
+
+	// check if the WebhookID field should be false
+	if o.WebhookID.Int64 == 0 {
+		o.WebhookID.Valid = false
+	}
+
+	// check if the Secret field should be false
+	if len(o.Secret.String) == 0 {
+		o.Secret.Valid = false
+	}
+
+	// check if the UserID field should be false
+	if o.UserID.Int64 == 0 {
+		o.UserID.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if o.CreatedAt.Int64 == 0 {
+		o.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(o.CreatedBy.String) == 0 {
+		o.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if o.UpdatedAt.Int64 == 0 {
+		o.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(o.UpdatedBy.String) == 0 {
+		o.UpdatedBy.Valid = false
+	}
+
+	return o
+}
+
+// ToAPI converts the OrgHook type
+// to an API OrgHook type.
+func (o *OrgHook) ToAPI() *api.OrgHook {
+	orgHook := new(api.OrgHook)
+
+	orgHook.SetID(o.ID.Int64)
+	orgHook.SetOrg(o.Org.String)
+	orgHook.SetWebhookID(o.WebhookID.Int64)
+	orgHook.SetSecret(o.Secret.String)
+	orgHook.SetAutoEnable(o.AutoEnable.Bool)
+	orgHook.SetUserID(o.UserID.Int64)
+	orgHook.SetActive(o.Active.Bool)
+	orgHook.SetCreatedAt(o.CreatedAt.Int64)
+	orgHook.SetCreatedBy(o.CreatedBy.String)
+	orgHook.SetUpdatedAt(o.UpdatedAt.Int64)
+	orgHook.SetUpdatedBy(o.UpdatedBy.String)
+
+	return orgHook
+}
+
+// Validate verifies the necessary fields for
+// the OrgHook type are populated correctly.
+func (o *OrgHook) Validate() error {
+	// verify the Org field is populated
+	if len(o.Org.String) == 0 {
+		return ErrEmptyOrgHookOrg
+	}
+
+	// verify the Secret field is populated
+	if len(o.Secret.String) == 0 {
+		return ErrEmptyOrgHookSecret
+	}
+
+	return nil
+}
+
+// OrgHookFromAPI converts the API OrgHook type
+// to a database OrgHook type.
+func OrgHookFromAPI(o *api.OrgHook) *OrgHook {
+	orgHook := &OrgHook{
+		ID:         sql.NullInt64{Int64: o.GetID(), Valid: true},
+		Org:        sql.NullString{String: o.GetOrg(), Valid: true},
+		WebhookID:  sql.NullInt64{Int64: o.GetWebhookID(), Valid: true},
+		Secret:     sql.NullString{String: o.GetSecret(), Valid: true},
+		AutoEnable: sql.NullBool{Bool: o.GetAutoEnable(), Valid: true},
+		UserID:     sql.NullInt64{Int64: o.GetUserID(), Valid: true},
+		Active:     sql.NullBool{Bool: o.GetActive(), Valid: true},
+		CreatedAt:  sql.NullInt64{Int64: o.GetCreatedAt(), Valid: true},
+		CreatedBy:  sql.NullString{String: o.GetCreatedBy(), Valid: true},
+		UpdatedAt:  sql.NullInt64{Int64: o.GetUpdatedAt(), Valid: true},
+		UpdatedBy:  sql.NullString{String: o.GetUpdatedBy(), Valid: true},
+	}
+
+	return orgHook.Nullify()
+}
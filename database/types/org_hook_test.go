@@ -0,0 +1,205 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestOrgHook_Decrypt_Encrypt(t *testing.T) {
+	// setup types
+	key := "C639A572E14D5075C526FDDD43E4ECF6"
+
+	o := testOrgHook()
+
+	// run test
+	err := o.Encrypt(key)
+	if err != nil {
+		t.Errorf("Encrypt returned err: %v", err)
+	}
+
+	if o.Secret.String == "superSecretHash" {
+		t.Errorf("Encrypt did not encrypt secret")
+	}
+
+	err = o.Decrypt(key)
+	if err != nil {
+		t.Errorf("Decrypt returned err: %v", err)
+	}
+
+	if o.Secret.String != "superSecretHash" {
+		t.Errorf("Decrypt is %s, want %s", o.Secret.String, "superSecretHash")
+	}
+
+	err = o.Decrypt("")
+	if err == nil {
+		t.Errorf("Decrypt should have returned err")
+	}
+}
+
+func TestOrgHook_Nullify(t *testing.T) {
+	// setup types
+	var o *OrgHook
+
+	want := &OrgHook{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		WebhookID: sql.NullInt64{Int64: 0, Valid: false},
+		Secret:    sql.NullString{String: "", Valid: false},
+		UserID:    sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *OrgHook
+		want *OrgHook
+	}{
+		{
+			item: testOrgHook(),
+			want: testOrgHook(),
+		},
+		{
+			item: o,
+			want: nil,
+		},
+		{
+			item: new(OrgHook),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestOrgHook_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.OrgHook)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetWebhookID(123456)
+	want.SetSecret("superSecretHash")
+	want.SetAutoEnable(true)
+	want.SetUserID(1)
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testOrgHook().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestOrgHook_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *OrgHook
+	}{
+		{
+			failure: false,
+			item:    testOrgHook(),
+		},
+		{ // no Org set for OrgHook
+			failure: true,
+			item: func() *OrgHook {
+				o := testOrgHook()
+				o.Org = sql.NullString{}
+
+				return o
+			}(),
+		},
+		{ // no Secret set for OrgHook
+			failure: true,
+			item: func() *OrgHook {
+				o := testOrgHook()
+				o.Secret = sql.NullString{}
+
+				return o
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestOrgHookFromAPI(t *testing.T) {
+	// setup types
+	o := new(api.OrgHook)
+
+	o.SetID(1)
+	o.SetOrg("github")
+	o.SetWebhookID(123456)
+	o.SetSecret("superSecretHash")
+	o.SetAutoEnable(true)
+	o.SetUserID(1)
+	o.SetActive(true)
+	o.SetCreatedAt(1563474077)
+	o.SetCreatedBy("octocat")
+	o.SetUpdatedAt(1563474077)
+	o.SetUpdatedBy("octocat")
+
+	want := testOrgHook()
+
+	// run test
+	got := OrgHookFromAPI(o)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("OrgHookFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testOrgHook is a test helper function to create a OrgHook
+// type with all fields set to a fake value.
+func testOrgHook() *OrgHook {
+	return &OrgHook{
+		ID:         sql.NullInt64{Int64: 1, Valid: true},
+		Org:        sql.NullString{String: "github", Valid: true},
+		WebhookID:  sql.NullInt64{Int64: 123456, Valid: true},
+		Secret:     sql.NullString{String: "superSecretHash", Valid: true},
+		AutoEnable: sql.NullBool{Bool: true, Valid: true},
+		UserID:     sql.NullInt64{Int64: 1, Valid: true},
+		Active:     sql.NullBool{Bool: true, Valid: true},
+		CreatedAt:  sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy:  sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt:  sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy:  sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyPreviewEnvironmentRepoID defines the error type when a
+	// PreviewEnvironment type has an empty RepoID field provided.
+	ErrEmptyPreviewEnvironmentRepoID = errors.New("empty preview environment repo_id provided")
+
+	// ErrEmptyPreviewEnvironmentNumber defines the error type when a
+	// PreviewEnvironment type has an empty Number field provided.
+	ErrEmptyPreviewEnvironmentNumber = errors.New("empty preview environment number provided")
+
+	// ErrEmptyPreviewEnvironmentName defines the error type when a
+	// PreviewEnvironment type has an empty Name field provided.
+	ErrEmptyPreviewEnvironmentName = errors.New("empty preview environment name provided")
+
+	// ErrEmptyPreviewEnvironmentStatus defines the error type when a
+	// PreviewEnvironment type has an empty Status field provided.
+	ErrEmptyPreviewEnvironmentStatus = errors.New("empty preview environment status provided")
+)
+
+// PreviewEnvironment is the database representation of an ephemeral environment
+// deployed by a pull request build for previewing changes.
+type PreviewEnvironment struct {
+	ID      sql.NullInt64  `sql:"id"`
+	RepoID  sql.NullInt64  `sql:"repo_id"`
+	BuildID sql.NullInt64  `sql:"build_id"`
+	Number  sql.NullInt32  `sql:"number"`
+	Name    sql.NullString `sql:"name"`
+	URL     sql.NullString `sql:"url"`
+	Status  sql.NullString `sql:"status"`
+	Expires sql.NullInt64  `sql:"expires"`
+	Created sql.NullInt64  `sql:"created"`
+	Updated sql.NullInt64  `sql:"updated"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the PreviewEnvironment type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (p *PreviewEnvironment) Nullify() *PreviewEnvironment {
+	if p == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if p.ID.Int64 == 0 {
+		p.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if p.RepoID.Int64 == 0 {
+		p.RepoID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if p.BuildID.Int64 == 0 {
+		p.BuildID.Valid = false
+	}
+
+	// check if the Number field should be false
+	if p.Number.Int32 == 0 {
+		p.Number.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(p.Name.String) == 0 {
+		p.Name.Valid = false
+	}
+
+	// check if the URL field should be false
+	if len(p.URL.String) == 0 {
+		p.URL.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(p.Status.String) == 0 {
+		p.Status.Valid = false
+	}
+
+	// check if the Expires field should be false
+	if p.Expires.Int64 == 0 {
+		p.Expires.Valid = false
+	}
+
+	// check if the Created field should be false
+	if p.Created.Int64 == 0 {
+		p.Created.Valid = false
+	}
+
+	// check if the Updated field should be false
+	if p.Updated.Int64 == 0 {
+		p.Updated.Valid = false
+	}
+
+	return p
+}
+
+// ToAPI converts the PreviewEnvironment type
+// to an API PreviewEnvironment type.
+func (p *PreviewEnvironment) ToAPI() *api.PreviewEnvironment {
+	preview := new(api.PreviewEnvironment)
+
+	preview.SetID(p.ID.Int64)
+	preview.SetRepoID(p.RepoID.Int64)
+	preview.SetBuildID(p.BuildID.Int64)
+	preview.SetNumber(int(p.Number.Int32))
+	preview.SetName(p.Name.String)
+	preview.SetURL(p.URL.String)
+	preview.SetStatus(p.Status.String)
+	preview.SetExpires(p.Expires.Int64)
+	preview.SetCreated(p.Created.Int64)
+	preview.SetUpdated(p.Updated.Int64)
+
+	return preview
+}
+
+// Validate verifies the necessary fields for
+// the PreviewEnvironment type are populated correctly.
+func (p *PreviewEnvironment) Validate() error {
+	// verify the RepoID field is populated
+	if p.RepoID.Int64 <= 0 {
+		return ErrEmptyPreviewEnvironmentRepoID
+	}
+
+	// verify the Number field is populated
+	if p.Number.Int32 <= 0 {
+		return ErrEmptyPreviewEnvironmentNumber
+	}
+
+	// verify the Name field is populated
+	if len(p.Name.String) == 0 {
+		return ErrEmptyPreviewEnvironmentName
+	}
+
+	// verify the Status field is populated
+	if len(p.Status.String) == 0 {
+		return ErrEmptyPreviewEnvironmentStatus
+	}
+
+	return nil
+}
+
+// PreviewEnvironmentFromAPI converts the API PreviewEnvironment type
+// to a database PreviewEnvironment type.
+func PreviewEnvironmentFromAPI(p *api.PreviewEnvironment) *PreviewEnvironment {
+	preview := &PreviewEnvironment{
+		ID:      sql.NullInt64{Int64: p.GetID(), Valid: true},
+		RepoID:  sql.NullInt64{Int64: p.GetRepoID(), Valid: true},
+		BuildID: sql.NullInt64{Int64: p.GetBuildID(), Valid: true},
+		Number:  sql.NullInt32{Int32: int32(p.GetNumber()), Valid: true},
+		Name:    sql.NullString{String: p.GetName(), Valid: true},
+		URL:     sql.NullString{String: p.GetURL(), Valid: true},
+		Status:  sql.NullString{String: p.GetStatus(), Valid: true},
+		Expires: sql.NullInt64{Int64: p.GetExpires(), Valid: true},
+		Created: sql.NullInt64{Int64: p.GetCreated(), Valid: true},
+		Updated: sql.NullInt64{Int64: p.GetUpdated(), Valid: true},
+	}
+
+	return preview.Nullify()
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestPreviewEnvironment_Nullify(t *testing.T) {
+	// setup types
+	var p *PreviewEnvironment
+
+	want := &PreviewEnvironment{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:  sql.NullInt64{Int64: 0, Valid: false},
+		BuildID: sql.NullInt64{Int64: 0, Valid: false},
+		Number:  sql.NullInt32{Int32: 0, Valid: false},
+		Name:    sql.NullString{String: "", Valid: false},
+		URL:     sql.NullString{String: "", Valid: false},
+		Status:  sql.NullString{String: "", Valid: false},
+		Expires: sql.NullInt64{Int64: 0, Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+		Updated: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *PreviewEnvironment
+		want *PreviewEnvironment
+	}{
+		{
+			item: testPreviewEnvironment(),
+			want: testPreviewEnvironment(),
+		},
+		{
+			item: p,
+			want: nil,
+		},
+		{
+			item: new(PreviewEnvironment),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestPreviewEnvironment_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.PreviewEnvironment)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetBuildID(1)
+	want.SetNumber(1)
+	want.SetName("web")
+	want.SetURL("https://pr-1.preview.example.com")
+	want.SetStatus("active")
+	want.SetExpires(1563474077)
+	want.SetCreated(1563474076)
+	want.SetUpdated(1563474077)
+
+	// run test
+	got := testPreviewEnvironment().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestPreviewEnvironment_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *PreviewEnvironment
+	}{
+		{
+			failure: false,
+			item:    testPreviewEnvironment(),
+		},
+		{ // no RepoID set for PreviewEnvironment
+			failure: true,
+			item: func() *PreviewEnvironment {
+				p := testPreviewEnvironment()
+				p.RepoID = sql.NullInt64{}
+
+				return p
+			}(),
+		},
+		{ // no Number set for PreviewEnvironment
+			failure: true,
+			item: func() *PreviewEnvironment {
+				p := testPreviewEnvironment()
+				p.Number = sql.NullInt32{}
+
+				return p
+			}(),
+		},
+		{ // no Name set for PreviewEnvironment
+			failure: true,
+			item: func() *PreviewEnvironment {
+				p := testPreviewEnvironment()
+				p.Name = sql.NullString{}
+
+				return p
+			}(),
+		},
+		{ // no Status set for PreviewEnvironment
+			failure: true,
+			item: func() *PreviewEnvironment {
+				p := testPreviewEnvironment()
+				p.Status = sql.NullString{}
+
+				return p
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestPreviewEnvironmentFromAPI(t *testing.T) {
+	// setup types
+	p := new(api.PreviewEnvironment)
+
+	p.SetID(1)
+	p.SetRepoID(1)
+	p.SetBuildID(1)
+	p.SetNumber(1)
+	p.SetName("web")
+	p.SetURL("https://pr-1.preview.example.com")
+	p.SetStatus("active")
+	p.SetExpires(1563474077)
+	p.SetCreated(1563474076)
+	p.SetUpdated(1563474077)
+
+	want := testPreviewEnvironment()
+
+	// run test
+	got := PreviewEnvironmentFromAPI(p)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PreviewEnvironmentFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testPreviewEnvironment is a test helper function to create a PreviewEnvironment
+// type with all fields set to a fake value.
+func testPreviewEnvironment() *PreviewEnvironment {
+	return &PreviewEnvironment{
+		ID:      sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+		BuildID: sql.NullInt64{Int64: 1, Valid: true},
+		Number:  sql.NullInt32{Int32: 1, Valid: true},
+		Name:    sql.NullString{String: "web", Valid: true},
+		URL:     sql.NullString{String: "https://pr-1.preview.example.com", Valid: true},
+		Status:  sql.NullString{String: "active", Valid: true},
+		Expires: sql.NullInt64{Int64: 1563474077, Valid: true},
+		Created: sql.NullInt64{Int64: 1563474076, Valid: true},
+		Updated: sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyRegistryCredentialOrg defines the error type when a
+	// RegistryCredential type has an empty Org field provided.
+	ErrEmptyRegistryCredentialOrg = errors.New("empty registry credential org provided")
+
+	// ErrEmptyRegistryCredentialRegistry defines the error type when a
+	// RegistryCredential type has an empty Registry field provided.
+	ErrEmptyRegistryCredentialRegistry = errors.New("empty registry credential registry provided")
+)
+
+// RegistryCredential is the database representation of the credentials for pulling
+// images from a container registry for the repos in an org.
+type RegistryCredential struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Org       sql.NullString `sql:"org"`
+	Registry  sql.NullString `sql:"registry"`
+	Username  sql.NullString `sql:"username"`
+	Password  sql.NullString `sql:"password"`
+	Active    sql.NullBool   `sql:"active"`
+	CreatedAt sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy sql.NullString `sql:"updated_by"`
+}
+
+// Decrypt will manipulate the existing password by
+// base64 decoding that value. Then, a AES-256 cipher
+// block is created from the encryption key in order to
+// decrypt the base64 decoded password.
+func (r *RegistryCredential) Decrypt(key string) error {
+	// base64 decode the encrypted password
+	decoded, err := base64.StdEncoding.DecodeString(r.Password.String)
+	if err != nil {
+		return err
+	}
+
+	// decrypt the base64 decoded password
+	decrypted, err := decrypt(key, decoded)
+	if err != nil {
+		return err
+	}
+
+	// set the decrypted password
+	r.Password = sql.NullString{
+		String: string(decrypted),
+		Valid:  true,
+	}
+
+	return nil
+}
+
+// Encrypt will manipulate the existing password by
+// creating a AES-256 cipher block from the encryption
+// key in order to encrypt the password. Then, the
+// password is base64 encoded for transport across
+// network boundaries.
+func (r *RegistryCredential) Encrypt(key string) error {
+	// encrypt the password
+	encrypted, err := encrypt(key, []byte(r.Password.String))
+	if err != nil {
+		return err
+	}
+
+	// base64 encode the encrypted password to make it network safe
+	r.Password = sql.NullString{
+		String: base64.StdEncoding.EncodeToString(encrypted),
+		Valid:  true,
+	}
+
+	return nil
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the RegistryCredential type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *RegistryCredential) Nullify() *RegistryCredential {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(r.Org.String) == 0 {
+		r.Org.Valid = false
+	}
+
+	// check if the Registry field should be false
+	if len(r.Registry.String) == 0 {
+		r.Registry.Valid = false
+	}
+
+	// check if the Username field should be false
+	if len(r.Username.String) == 0 {
+		r.Username.Valid = false
+	}
+
+	// check if the Password field should be false
+	if len(r.Password.String) == 0 {
+		r.Password.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if r.CreatedAt.Int64 == 0 {
+		r.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(r.CreatedBy.String) == 0 {
+		r.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if r.UpdatedAt.Int64 == 0 {
+		r.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(r.UpdatedBy.String) == 0 {
+		r.UpdatedBy.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the RegistryCredential type
+// to an API RegistryCredential type.
+func (r *RegistryCredential) ToAPI() *api.RegistryCredential {
+	registryCredential := new(api.RegistryCredential)
+
+	registryCredential.SetID(r.ID.Int64)
+	registryCredential.SetOrg(r.Org.String)
+	registryCredential.SetRegistry(r.Registry.String)
+	registryCredential.SetUsername(r.Username.String)
+	registryCredential.SetPassword(r.Password.String)
+	registryCredential.SetActive(r.Active.Bool)
+	registryCredential.SetCreatedAt(r.CreatedAt.Int64)
+	registryCredential.SetCreatedBy(r.CreatedBy.String)
+	registryCredential.SetUpdatedAt(r.UpdatedAt.Int64)
+	registryCredential.SetUpdatedBy(r.UpdatedBy.String)
+
+	return registryCredential
+}
+
+// Validate verifies the necessary fields for
+// the RegistryCredential type are populated correctly.
+func (r *RegistryCredential) Validate() error {
+	// verify the Org field is populated
+	if len(r.Org.String) == 0 {
+		return ErrEmptyRegistryCredentialOrg
+	}
+
+	// verify the Registry field is populated
+	if len(r.Registry.String) == 0 {
+		return ErrEmptyRegistryCredentialRegistry
+	}
+
+	return nil
+}
+
+// RegistryCredentialFromAPI converts the API RegistryCredential type
+// to a database RegistryCredential type.
+func RegistryCredentialFromAPI(r *api.RegistryCredential) *RegistryCredential {
+	registryCredential := &RegistryCredential{
+		ID:        sql.NullInt64{Int64: r.GetID(), Valid: true},
+		Org:       sql.NullString{String: r.GetOrg(), Valid: true},
+		Registry:  sql.NullString{String: r.GetRegistry(), Valid: true},
+		Username:  sql.NullString{String: r.GetUsername(), Valid: true},
+		Password:  sql.NullString{String: r.GetPassword(), Valid: true},
+		Active:    sql.NullBool{Bool: r.GetActive(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: r.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: r.GetCreatedBy(), Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: r.GetUpdatedAt(), Valid: true},
+		UpdatedBy: sql.NullString{String: r.GetUpdatedBy(), Valid: true},
+	}
+
+	return registryCredential.Nullify()
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRegistryCredential_Decrypt_Encrypt(t *testing.T) {
+	// setup types
+	key := "C639A572E14D5075C526FDDD43E4ECF6"
+
+	r := testRegistryCredential()
+
+	// run test
+	err := r.Encrypt(key)
+	if err != nil {
+		t.Errorf("Encrypt returned err: %v", err)
+	}
+
+	if r.Password.String == "superSecretPassword" {
+		t.Errorf("Encrypt did not encrypt password")
+	}
+
+	err = r.Decrypt(key)
+	if err != nil {
+		t.Errorf("Decrypt returned err: %v", err)
+	}
+
+	if r.Password.String != "superSecretPassword" {
+		t.Errorf("Decrypt is %s, want %s", r.Password.String, "superSecretPassword")
+	}
+
+	err = r.Decrypt("")
+	if err == nil {
+		t.Errorf("Decrypt should have returned err")
+	}
+}
+
+func TestRegistryCredential_Nullify(t *testing.T) {
+	// setup types
+	var r *RegistryCredential
+
+	want := &RegistryCredential{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		Registry:  sql.NullString{String: "", Valid: false},
+		Username:  sql.NullString{String: "", Valid: false},
+		Password:  sql.NullString{String: "", Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *RegistryCredential
+		want *RegistryCredential
+	}{
+		{
+			item: testRegistryCredential(),
+			want: testRegistryCredential(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(RegistryCredential),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestRegistryCredential_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.RegistryCredential)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetRegistry("ghcr.io")
+	want.SetUsername("octocat")
+	want.SetPassword("superSecretPassword")
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testRegistryCredential().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestRegistryCredential_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *RegistryCredential
+	}{
+		{
+			failure: false,
+			item:    testRegistryCredential(),
+		},
+		{ // no Org set for RegistryCredential
+			failure: true,
+			item: func() *RegistryCredential {
+				r := testRegistryCredential()
+				r.Org = sql.NullString{}
+
+				return r
+			}(),
+		},
+		{ // no Registry set for RegistryCredential
+			failure: true,
+			item: func() *RegistryCredential {
+				r := testRegistryCredential()
+				r.Registry = sql.NullString{}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestRegistryCredentialFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.RegistryCredential)
+
+	r.SetID(1)
+	r.SetOrg("github")
+	r.SetRegistry("ghcr.io")
+	r.SetUsername("octocat")
+	r.SetPassword("superSecretPassword")
+	r.SetActive(true)
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	want := testRegistryCredential()
+
+	// run test
+	got := RegistryCredentialFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RegistryCredentialFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testRegistryCredential is a test helper function to create a RegistryCredential
+// type with all fields set to a fake value.
+func testRegistryCredential() *RegistryCredential {
+	return &RegistryCredential{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Org:       sql.NullString{String: "github", Valid: true},
+		Registry:  sql.NullString{String: "ghcr.io", Valid: true},
+		Username:  sql.NullString{String: "octocat", Valid: true},
+		Password:  sql.NullString{String: "superSecretPassword", Valid: true},
+		Active:    sql.NullBool{Bool: true, Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy: sql.NullString{String: "octocat", Valid: true},
+	}
+}
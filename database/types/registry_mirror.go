@@ -0,0 +1,142 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyRegistryMirrorRegistry defines the error type when a
+	// RegistryMirror type has an empty Registry field provided.
+	ErrEmptyRegistryMirrorRegistry = errors.New("empty registry mirror registry provided")
+
+	// ErrEmptyRegistryMirrorMirror defines the error type when a
+	// RegistryMirror type has an empty Mirror field provided.
+	ErrEmptyRegistryMirrorMirror = errors.New("empty registry mirror mirror provided")
+)
+
+// RegistryMirror is the database representation of a rule for rewriting
+// the images hosted in a container registry to pull from a mirror.
+type RegistryMirror struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Org       sql.NullString `sql:"org"`
+	Registry  sql.NullString `sql:"registry"`
+	Mirror    sql.NullString `sql:"mirror"`
+	Active    sql.NullBool   `sql:"active"`
+	CreatedAt sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the RegistryMirror type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *RegistryMirror) Nullify() *RegistryMirror {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(r.Org.String) == 0 {
+		r.Org.Valid = false
+	}
+
+	// check if the Registry field should be false
+	if len(r.Registry.String) == 0 {
+		r.Registry.Valid = false
+	}
+
+	// check if the Mirror field should be false
+	if len(r.Mirror.String) == 0 {
+		r.Mirror.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if r.CreatedAt.Int64 == 0 {
+		r.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(r.CreatedBy.String) == 0 {
+		r.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if r.UpdatedAt.Int64 == 0 {
+		r.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(r.UpdatedBy.String) == 0 {
+		r.UpdatedBy.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the RegistryMirror type
+// to an API RegistryMirror type.
+func (r *RegistryMirror) ToAPI() *api.RegistryMirror {
+	registryMirror := new(api.RegistryMirror)
+
+	registryMirror.SetID(r.ID.Int64)
+	registryMirror.SetOrg(r.Org.String)
+	registryMirror.SetRegistry(r.Registry.String)
+	registryMirror.SetMirror(r.Mirror.String)
+	registryMirror.SetActive(r.Active.Bool)
+	registryMirror.SetCreatedAt(r.CreatedAt.Int64)
+	registryMirror.SetCreatedBy(r.CreatedBy.String)
+	registryMirror.SetUpdatedAt(r.UpdatedAt.Int64)
+	registryMirror.SetUpdatedBy(r.UpdatedBy.String)
+
+	return registryMirror
+}
+
+// Validate verifies the necessary fields for
+// the RegistryMirror type are populated correctly.
+func (r *RegistryMirror) Validate() error {
+	// verify the Registry field is populated
+	if len(r.Registry.String) == 0 {
+		return ErrEmptyRegistryMirrorRegistry
+	}
+
+	// verify the Mirror field is populated
+	if len(r.Mirror.String) == 0 {
+		return ErrEmptyRegistryMirrorMirror
+	}
+
+	return nil
+}
+
+// RegistryMirrorFromAPI converts the API RegistryMirror type
+// to a database RegistryMirror type.
+func RegistryMirrorFromAPI(r *api.RegistryMirror) *RegistryMirror {
+	registryMirror := &RegistryMirror{
+		ID:        sql.NullInt64{Int64: r.GetID(), Valid: true},
+		Org:       sql.NullString{String: r.GetOrg(), Valid: true},
+		Registry:  sql.NullString{String: r.GetRegistry(), Valid: true},
+		Mirror:    sql.NullString{String: r.GetMirror(), Valid: true},
+		Active:    sql.NullBool{Bool: r.GetActive(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: r.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: r.GetCreatedBy(), Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: r.GetUpdatedAt(), Valid: true},
+		UpdatedBy: sql.NullString{String: r.GetUpdatedBy(), Valid: true},
+	}
+
+	return registryMirror.Nullify()
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRegistryMirror_Nullify(t *testing.T) {
+	// setup types
+	var r *RegistryMirror
+
+	want := &RegistryMirror{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		Registry:  sql.NullString{String: "", Valid: false},
+		Mirror:    sql.NullString{String: "", Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *RegistryMirror
+		want *RegistryMirror
+	}{
+		{
+			item: testRegistryMirror(),
+			want: testRegistryMirror(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(RegistryMirror),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestRegistryMirror_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.RegistryMirror)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetRegistry("docker.io")
+	want.SetMirror("mirror.example.com/dockerhub")
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testRegistryMirror().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestRegistryMirror_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *RegistryMirror
+	}{
+		{
+			failure: false,
+			item:    testRegistryMirror(),
+		},
+		{ // no Registry set for RegistryMirror
+			failure: true,
+			item: func() *RegistryMirror {
+				r := testRegistryMirror()
+				r.Registry = sql.NullString{}
+
+				return r
+			}(),
+		},
+		{ // no Mirror set for RegistryMirror
+			failure: true,
+			item: func() *RegistryMirror {
+				r := testRegistryMirror()
+				r.Mirror = sql.NullString{}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestRegistryMirrorFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.RegistryMirror)
+
+	r.SetID(1)
+	r.SetOrg("github")
+	r.SetRegistry("docker.io")
+	r.SetMirror("mirror.example.com/dockerhub")
+	r.SetActive(true)
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	want := testRegistryMirror()
+
+	// run test
+	got := RegistryMirrorFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RegistryMirrorFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testRegistryMirror is a test helper function to create a RegistryMirror
+// type with all fields set to a fake value.
+func testRegistryMirror() *RegistryMirror {
+	return &RegistryMirror{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Org:       sql.NullString{String: "github", Valid: true},
+		Registry:  sql.NullString{String: "docker.io", Valid: true},
+		Mirror:    sql.NullString{String: "mirror.example.com/dockerhub", Valid: true},
+		Active:    sql.NullBool{Bool: true, Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy: sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyRepoSyncRepoID defines the error type when a
+	// RepoSync type has an empty RepoID field provided.
+	ErrEmptyRepoSyncRepoID = errors.New("empty repo sync repo_id provided")
+
+	// ErrEmptyRepoSyncStatus defines the error type when a
+	// RepoSync type has an empty Status field provided.
+	ErrEmptyRepoSyncStatus = errors.New("empty repo sync status provided")
+)
+
+// RepoSync is the database representation of the state of a repo
+// in the SCM the last time it was synced with the database.
+type RepoSync struct {
+	ID       sql.NullInt64  `sql:"id"`
+	RepoID   sql.NullInt64  `sql:"repo_id"`
+	Org      sql.NullString `sql:"org"`
+	Name     sql.NullString `sql:"name"`
+	Link     sql.NullString `sql:"link"`
+	Clone    sql.NullString `sql:"clone"`
+	Branch   sql.NullString `sql:"branch"`
+	Private  sql.NullBool   `sql:"private"`
+	Archived sql.NullBool   `sql:"archived"`
+	Topics   pq.StringArray `sql:"topics" gorm:"type:varchar(1000)"`
+	Status   sql.NullString `sql:"status"`
+	Changes  pq.StringArray `sql:"changes" gorm:"type:varchar(2000)"`
+	Error    sql.NullString `sql:"error"`
+	Synced   sql.NullInt64  `sql:"synced"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the RepoSync type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *RepoSync) Nullify() *RepoSync {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if r.RepoID.Int64 == 0 {
+		r.RepoID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(r.Org.String) == 0 {
+		r.Org.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(r.Name.String) == 0 {
+		r.Name.Valid = false
+	}
+
+	// check if the Link field should be false
+	if len(r.Link.String) == 0 {
+		r.Link.Valid = false
+	}
+
+	// check if the Clone field should be false
+	if len(r.Clone.String) == 0 {
+		r.Clone.Valid = false
+	}
+
+	// check if the Branch field should be false
+	if len(r.Branch.String) == 0 {
+		r.Branch.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(r.Status.String) == 0 {
+		r.Status.Valid = false
+	}
+
+	// check if the Error field should be false
+	if len(r.Error.String) == 0 {
+		r.Error.Valid = false
+	}
+
+	// check if the Synced field should be false
+	if r.Synced.Int64 == 0 {
+		r.Synced.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the RepoSync type
+// to an API RepoSync type.
+func (r *RepoSync) ToAPI() *api.RepoSync {
+	repoSync := new(api.RepoSync)
+
+	repoSync.SetID(r.ID.Int64)
+	repoSync.SetRepoID(r.RepoID.Int64)
+	repoSync.SetOrg(r.Org.String)
+	repoSync.SetName(r.Name.String)
+	repoSync.SetLink(r.Link.String)
+	repoSync.SetClone(r.Clone.String)
+	repoSync.SetBranch(r.Branch.String)
+	repoSync.SetPrivate(r.Private.Bool)
+	repoSync.SetArchived(r.Archived.Bool)
+	repoSync.SetTopics(r.Topics)
+	repoSync.SetStatus(r.Status.String)
+	repoSync.SetChanges(r.Changes)
+	repoSync.SetError(r.Error.String)
+	repoSync.SetSynced(r.Synced.Int64)
+
+	return repoSync
+}
+
+// Validate verifies the necessary fields for
+// the RepoSync type are populated correctly.
+func (r *RepoSync) Validate() error {
+	// verify the RepoID field is populated
+	if r.RepoID.Int64 <= 0 {
+		return ErrEmptyRepoSyncRepoID
+	}
+
+	// verify the Status field is populated
+	if len(r.Status.String) == 0 {
+		return ErrEmptyRepoSyncStatus
+	}
+
+	return nil
+}
+
+// RepoSyncFromAPI converts the API RepoSync type
+// to a database RepoSync type.
+func RepoSyncFromAPI(r *api.RepoSync) *RepoSync {
+	repoSync := &RepoSync{
+		ID:       sql.NullInt64{Int64: r.GetID(), Valid: true},
+		RepoID:   sql.NullInt64{Int64: r.GetRepoID(), Valid: true},
+		Org:      sql.NullString{String: r.GetOrg(), Valid: true},
+		Name:     sql.NullString{String: r.GetName(), Valid: true},
+		Link:     sql.NullString{String: r.GetLink(), Valid: true},
+		Clone:    sql.NullString{String: r.GetClone(), Valid: true},
+		Branch:   sql.NullString{String: r.GetBranch(), Valid: true},
+		Private:  sql.NullBool{Bool: r.GetPrivate(), Valid: true},
+		Archived: sql.NullBool{Bool: r.GetArchived(), Valid: true},
+		Topics:   pq.StringArray(r.GetTopics()),
+		Status:   sql.NullString{String: r.GetStatus(), Valid: true},
+		Changes:  pq.StringArray(r.GetChanges()),
+		Error:    sql.NullString{String: r.GetError(), Valid: true},
+		Synced:   sql.NullInt64{Int64: r.GetSynced(), Valid: true},
+	}
+
+	return repoSync.Nullify()
+}
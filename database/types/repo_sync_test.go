@@ -0,0 +1,184 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+func TestRepoSync_Nullify(t *testing.T) {
+	// setup types
+	var r *RepoSync
+
+	want := &RepoSync{
+		ID:     sql.NullInt64{Int64: 0, Valid: false},
+		RepoID: sql.NullInt64{Int64: 0, Valid: false},
+		Org:    sql.NullString{String: "", Valid: false},
+		Name:   sql.NullString{String: "", Valid: false},
+		Link:   sql.NullString{String: "", Valid: false},
+		Clone:  sql.NullString{String: "", Valid: false},
+		Branch: sql.NullString{String: "", Valid: false},
+		Status: sql.NullString{String: "", Valid: false},
+		Error:  sql.NullString{String: "", Valid: false},
+		Synced: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *RepoSync
+		want *RepoSync
+	}{
+		{
+			item: testRepoSync(),
+			want: testRepoSync(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(RepoSync),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestRepoSync_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.RepoSync)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetOrg("github")
+	want.SetName("octocat")
+	want.SetLink("https://github.com/github/octocat")
+	want.SetClone("https://github.com/github/octocat.git")
+	want.SetBranch("main")
+	want.SetPrivate(true)
+	want.SetArchived(false)
+	want.SetTopics([]string{"go", "vela"})
+	want.SetStatus("updated")
+	want.SetChanges([]string{"branch changed from master to main"})
+	want.SetError("")
+	want.SetSynced(1563474076)
+
+	// run test
+	got := testRepoSync().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestRepoSync_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *RepoSync
+	}{
+		{
+			failure: false,
+			item:    testRepoSync(),
+		},
+		{ // no RepoID set for RepoSync
+			failure: true,
+			item: func() *RepoSync {
+				r := testRepoSync()
+				r.RepoID = sql.NullInt64{}
+
+				return r
+			}(),
+		},
+		{ // no Status set for RepoSync
+			failure: true,
+			item: func() *RepoSync {
+				r := testRepoSync()
+				r.Status = sql.NullString{}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestRepoSyncFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.RepoSync)
+
+	r.SetID(1)
+	r.SetRepoID(1)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetLink("https://github.com/github/octocat")
+	r.SetClone("https://github.com/github/octocat.git")
+	r.SetBranch("main")
+	r.SetPrivate(true)
+	r.SetArchived(false)
+	r.SetTopics([]string{"go", "vela"})
+	r.SetStatus("updated")
+	r.SetChanges([]string{"branch changed from master to main"})
+	r.SetSynced(1563474076)
+
+	want := testRepoSync()
+
+	// run test
+	got := RepoSyncFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoSyncFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testRepoSync is a test helper function to create a RepoSync
+// type with all fields set to a fake value.
+func testRepoSync() *RepoSync {
+	return &RepoSync{
+		ID:       sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:   sql.NullInt64{Int64: 1, Valid: true},
+		Org:      sql.NullString{String: "github", Valid: true},
+		Name:     sql.NullString{String: "octocat", Valid: true},
+		Link:     sql.NullString{String: "https://github.com/github/octocat", Valid: true},
+		Clone:    sql.NullString{String: "https://github.com/github/octocat.git", Valid: true},
+		Branch:   sql.NullString{String: "main", Valid: true},
+		Private:  sql.NullBool{Bool: true, Valid: true},
+		Archived: sql.NullBool{Bool: false, Valid: true},
+		Topics:   pq.StringArray{"go", "vela"},
+		Status:   sql.NullString{String: "updated", Valid: true},
+		Changes:  pq.StringArray{"branch changed from master to main"},
+		Error:    sql.NullString{String: "", Valid: false},
+		Synced:   sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"regexp"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyRepoVariableRepoID defines the error type when a
+	// RepoVariable type has an empty RepoID field provided.
+	ErrEmptyRepoVariableRepoID = errors.New("empty repo variable repo_id provided")
+
+	// ErrEmptyRepoVariableName defines the error type when a
+	// RepoVariable type has an empty Name field provided.
+	ErrEmptyRepoVariableName = errors.New("empty repo variable name provided")
+
+	// ErrInvalidRepoVariableName defines the error type when a
+	// RepoVariable type has a Name field provided that is not
+	// a valid name for an environment variable.
+	ErrInvalidRepoVariableName = errors.New("invalid repo variable name provided")
+)
+
+// repoVariableName represents the pattern a name
+// for an environment variable must match.
+var repoVariableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// RepoVariable is the database representation of an environment
+// variable injected into the builds for a repo.
+type RepoVariable struct {
+	ID        sql.NullInt64  `sql:"id"`
+	RepoID    sql.NullInt64  `sql:"repo_id"`
+	Name      sql.NullString `sql:"name"`
+	Value     sql.NullString `sql:"value"`
+	Sensitive sql.NullBool   `sql:"sensitive"`
+	CreatedAt sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy sql.NullString `sql:"updated_by"`
+}
+
+// Decrypt will manipulate the existing value by
+// base64 decoding that value. Then, a AES-256 cipher
+// block is created from the encryption key in order to
+// decrypt the base64 decoded value.
+//
+// The value is only encrypted at rest for a
+// sensitive variable, so the value of any
+// other variable is left unchanged.
+func (r *RepoVariable) Decrypt(key string) error {
+	// check if the variable is sensitive
+	if !r.Sensitive.Bool {
+		return nil
+	}
+
+	// base64 decode the encrypted value
+	decoded, err := base64.StdEncoding.DecodeString(r.Value.String)
+	if err != nil {
+		return err
+	}
+
+	// decrypt the base64 decoded value
+	decrypted, err := decrypt(key, decoded)
+	if err != nil {
+		return err
+	}
+
+	// set the decrypted value
+	r.Value = sql.NullString{
+		String: string(decrypted),
+		Valid:  true,
+	}
+
+	return nil
+}
+
+// Encrypt will manipulate the existing value by
+// creating a AES-256 cipher block from the encryption
+// key in order to encrypt the value. Then, the
+// value is base64 encoded for transport across
+// network boundaries.
+//
+// The value is only encrypted at rest for a
+// sensitive variable, so the value of any
+// other variable is left unchanged.
+func (r *RepoVariable) Encrypt(key string) error {
+	// check if the variable is sensitive
+	if !r.Sensitive.Bool {
+		return nil
+	}
+
+	// encrypt the value
+	encrypted, err := encrypt(key, []byte(r.Value.String))
+	if err != nil {
+		return err
+	}
+
+	// base64 encode the encrypted value to make it network safe
+	r.Value = sql.NullString{
+		String: base64.StdEncoding.EncodeToString(encrypted),
+		Valid:  true,
+	}
+
+	return nil
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the RepoVariable type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *RepoVariable) Nullify() *RepoVariable {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if r.RepoID.Int64 == 0 {
+		r.RepoID.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(r.Name.String) == 0 {
+		r.Name.Valid = false
+	}
+
+	// check if the Value field should be false
+	if len(r.Value.String) == 0 {
+		r.Value.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if r.CreatedAt.Int64 == 0 {
+		r.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(r.CreatedBy.String) == 0 {
+		r.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if r.UpdatedAt.Int64 == 0 {
+		r.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(r.UpdatedBy.String) == 0 {
+		r.UpdatedBy.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the RepoVariable type
+// to an API RepoVariable type.
+func (r *RepoVariable) ToAPI() *api.RepoVariable {
+	variable := new(api.RepoVariable)
+
+	variable.SetID(r.ID.Int64)
+	variable.SetRepoID(r.RepoID.Int64)
+	variable.SetName(r.Name.String)
+	variable.SetValue(r.Value.String)
+	variable.SetSensitive(r.Sensitive.Bool)
+	variable.SetCreatedAt(r.CreatedAt.Int64)
+	variable.SetCreatedBy(r.CreatedBy.String)
+	variable.SetUpdatedAt(r.UpdatedAt.Int64)
+	variable.SetUpdatedBy(r.UpdatedBy.String)
+
+	return variable
+}
+
+// Validate verifies the necessary fields for
+// the RepoVariable type are populated correctly.
+func (r *RepoVariable) Validate() error {
+	// verify the RepoID field is populated
+	if r.RepoID.Int64 <= 0 {
+		return ErrEmptyRepoVariableRepoID
+	}
+
+	// verify the Name field is populated
+	if len(r.Name.String) == 0 {
+		return ErrEmptyRepoVariableName
+	}
+
+	// verify the Name field is a valid name for an environment variable
+	if !repoVariableName.MatchString(r.Name.String) {
+		return ErrInvalidRepoVariableName
+	}
+
+	return nil
+}
+
+// RepoVariableFromAPI converts the API RepoVariable type
+// to a database RepoVariable type.
+func RepoVariableFromAPI(r *api.RepoVariable) *RepoVariable {
+	variable := &RepoVariable{
+		ID:        sql.NullInt64{Int64: r.GetID(), Valid: true},
+		RepoID:    sql.NullInt64{Int64: r.GetRepoID(), Valid: true},
+		Name:      sql.NullString{String: r.GetName(), Valid: true},
+		Value:     sql.NullString{String: r.GetValue(), Valid: true},
+		Sensitive: sql.NullBool{Bool: r.GetSensitive(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: r.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: r.GetCreatedBy(), Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: r.GetUpdatedAt(), Valid: true},
+		UpdatedBy: sql.NullString{String: r.GetUpdatedBy(), Valid: true},
+	}
+
+	return variable.Nullify()
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRepoVariable_Decrypt_Encrypt(t *testing.T) {
+	// setup types
+	key := "C639A572E14D5075C526FDDD43E4ECF6"
+
+	r := testRepoVariable()
+
+	// run test
+	err := r.Encrypt(key)
+	if err != nil {
+		t.Errorf("Encrypt returned err: %v", err)
+	}
+
+	if r.Value.String == "us-east-1" {
+		t.Errorf("Encrypt did not encrypt value")
+	}
+
+	err = r.Decrypt(key)
+	if err != nil {
+		t.Errorf("Decrypt returned err: %v", err)
+	}
+
+	if r.Value.String != "us-east-1" {
+		t.Errorf("Decrypt is %s, want %s", r.Value.String, "us-east-1")
+	}
+
+	err = r.Decrypt("")
+	if err == nil {
+		t.Errorf("Decrypt should have returned err")
+	}
+}
+
+func TestRepoVariable_Decrypt_Encrypt_Plain(t *testing.T) {
+	// setup types
+	key := "C639A572E14D5075C526FDDD43E4ECF6"
+
+	r := testRepoVariable()
+	r.Sensitive = sql.NullBool{Bool: false, Valid: true}
+
+	// run test
+	err := r.Encrypt(key)
+	if err != nil {
+		t.Errorf("Encrypt returned err: %v", err)
+	}
+
+	if r.Value.String != "us-east-1" {
+		t.Errorf("Encrypt is %s, want %s", r.Value.String, "us-east-1")
+	}
+
+	err = r.Decrypt(key)
+	if err != nil {
+		t.Errorf("Decrypt returned err: %v", err)
+	}
+
+	if r.Value.String != "us-east-1" {
+		t.Errorf("Decrypt is %s, want %s", r.Value.String, "us-east-1")
+	}
+}
+
+func TestRepoVariable_Nullify(t *testing.T) {
+	// setup types
+	var r *RepoVariable
+
+	want := &RepoVariable{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:    sql.NullInt64{Int64: 0, Valid: false},
+		Name:      sql.NullString{String: "", Valid: false},
+		Value:     sql.NullString{String: "", Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *RepoVariable
+		want *RepoVariable
+	}{
+		{
+			item: testRepoVariable(),
+			want: testRepoVariable(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(RepoVariable),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestRepoVariable_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.RepoVariable)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetName("DEPLOY_REGION")
+	want.SetValue("us-east-1")
+	want.SetSensitive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testRepoVariable().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestRepoVariable_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *RepoVariable
+	}{
+		{
+			failure: false,
+			item:    testRepoVariable(),
+		},
+		{ // no RepoID set for RepoVariable
+			failure: true,
+			item: func() *RepoVariable {
+				r := testRepoVariable()
+				r.RepoID = sql.NullInt64{}
+
+				return r
+			}(),
+		},
+		{ // no Name set for RepoVariable
+			failure: true,
+			item: func() *RepoVariable {
+				r := testRepoVariable()
+				r.Name = sql.NullString{}
+
+				return r
+			}(),
+		},
+		{ // invalid Name set for RepoVariable
+			failure: true,
+			item: func() *RepoVariable {
+				r := testRepoVariable()
+				r.Name = sql.NullString{String: "1DEPLOY-REGION", Valid: true}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestRepoVariableFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.RepoVariable)
+
+	r.SetID(1)
+	r.SetRepoID(1)
+	r.SetName("DEPLOY_REGION")
+	r.SetValue("us-east-1")
+	r.SetSensitive(true)
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	want := testRepoVariable()
+
+	// run test
+	got := RepoVariableFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoVariableFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testRepoVariable is a test helper function to create a RepoVariable
+// type with all fields set to a fake value.
+func testRepoVariable() *RepoVariable {
+	return &RepoVariable{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:    sql.NullInt64{Int64: 1, Valid: true},
+		Name:      sql.NullString{String: "DEPLOY_REGION", Valid: true},
+		Value:     sql.NullString{String: "us-east-1", Valid: true},
+		Sensitive: sql.NullBool{Bool: true, Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy: sql.NullString{String: "octocat", Valid: true},
+	}
+}
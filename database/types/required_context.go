@@ -0,0 +1,149 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyRequiredContextOrg defines the error type when a
+	// RequiredContext type has an empty Org field provided.
+	ErrEmptyRequiredContextOrg = errors.New("empty required context org provided")
+
+	// ErrEmptyRequiredContextRepo defines the error type when a
+	// RequiredContext type has an empty Repo field provided.
+	ErrEmptyRequiredContextRepo = errors.New("empty required context repo provided")
+)
+
+// RequiredContext is the database representation of the status
+// contexts required by the branch protection for a repo in an org.
+type RequiredContext struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Org       sql.NullString `sql:"org"`
+	Repo      sql.NullString `sql:"repo"`
+	Branch    sql.NullString `sql:"branch"`
+	Contexts  pq.StringArray `sql:"contexts" gorm:"type:varchar(1000)"`
+	Strict    sql.NullBool   `sql:"strict"`
+	Active    sql.NullBool   `sql:"active"`
+	CreatedAt sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the RequiredContext type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *RequiredContext) Nullify() *RequiredContext {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(r.Org.String) == 0 {
+		r.Org.Valid = false
+	}
+
+	// check if the Repo field should be false
+	if len(r.Repo.String) == 0 {
+		r.Repo.Valid = false
+	}
+
+	// check if the Branch field should be false
+	if len(r.Branch.String) == 0 {
+		r.Branch.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if r.CreatedAt.Int64 == 0 {
+		r.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(r.CreatedBy.String) == 0 {
+		r.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if r.UpdatedAt.Int64 == 0 {
+		r.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(r.UpdatedBy.String) == 0 {
+		r.UpdatedBy.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the RequiredContext type
+// to an API RequiredContext type.
+func (r *RequiredContext) ToAPI() *api.RequiredContext {
+	requiredContext := new(api.RequiredContext)
+
+	requiredContext.SetID(r.ID.Int64)
+	requiredContext.SetOrg(r.Org.String)
+	requiredContext.SetRepo(r.Repo.String)
+	requiredContext.SetBranch(r.Branch.String)
+	requiredContext.SetContexts(r.Contexts)
+	requiredContext.SetStrict(r.Strict.Bool)
+	requiredContext.SetActive(r.Active.Bool)
+	requiredContext.SetCreatedAt(r.CreatedAt.Int64)
+	requiredContext.SetCreatedBy(r.CreatedBy.String)
+	requiredContext.SetUpdatedAt(r.UpdatedAt.Int64)
+	requiredContext.SetUpdatedBy(r.UpdatedBy.String)
+
+	return requiredContext
+}
+
+// Validate verifies the necessary fields for
+// the RequiredContext type are populated correctly.
+func (r *RequiredContext) Validate() error {
+	// verify the Org field is populated
+	if len(r.Org.String) == 0 {
+		return ErrEmptyRequiredContextOrg
+	}
+
+	// verify the Repo field is populated
+	if len(r.Repo.String) == 0 {
+		return ErrEmptyRequiredContextRepo
+	}
+
+	return nil
+}
+
+// RequiredContextFromAPI converts the API RequiredContext type
+// to a database RequiredContext type.
+func RequiredContextFromAPI(r *api.RequiredContext) *RequiredContext {
+	requiredContext := &RequiredContext{
+		ID:        sql.NullInt64{Int64: r.GetID(), Valid: true},
+		Org:       sql.NullString{String: r.GetOrg(), Valid: true},
+		Repo:      sql.NullString{String: r.GetRepo(), Valid: true},
+		Branch:    sql.NullString{String: r.GetBranch(), Valid: true},
+		Contexts:  pq.StringArray(r.GetContexts()),
+		Strict:    sql.NullBool{Bool: r.GetStrict(), Valid: true},
+		Active:    sql.NullBool{Bool: r.GetActive(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: r.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: r.GetCreatedBy(), Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: r.GetUpdatedAt(), Valid: true},
+		UpdatedBy: sql.NullString{String: r.GetUpdatedBy(), Valid: true},
+	}
+
+	return requiredContext.Nullify()
+}
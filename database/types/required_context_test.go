@@ -0,0 +1,174 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+func TestRequiredContext_Nullify(t *testing.T) {
+	// setup types
+	var r *RequiredContext
+
+	want := &RequiredContext{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		Repo:      sql.NullString{String: "", Valid: false},
+		Branch:    sql.NullString{String: "", Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy: sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *RequiredContext
+		want *RequiredContext
+	}{
+		{
+			item: testRequiredContext(),
+			want: testRequiredContext(),
+		},
+		{
+			item: r,
+			want: nil,
+		},
+		{
+			item: new(RequiredContext),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestRequiredContext_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.RequiredContext)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetRepo("octocat")
+	want.SetBranch("main")
+	want.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	want.SetStrict(true)
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testRequiredContext().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestRequiredContext_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *RequiredContext
+	}{
+		{
+			failure: false,
+			item:    testRequiredContext(),
+		},
+		{ // no Org set for RequiredContext
+			failure: true,
+			item: func() *RequiredContext {
+				r := testRequiredContext()
+				r.Org = sql.NullString{}
+
+				return r
+			}(),
+		},
+		{ // no Repo set for RequiredContext
+			failure: true,
+			item: func() *RequiredContext {
+				r := testRequiredContext()
+				r.Repo = sql.NullString{}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestRequiredContextFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.RequiredContext)
+
+	r.SetID(1)
+	r.SetOrg("github")
+	r.SetRepo("octocat")
+	r.SetBranch("main")
+	r.SetContexts([]string{"continuous-integration/vela/pull_request"})
+	r.SetStrict(true)
+	r.SetActive(true)
+	r.SetCreatedAt(1563474077)
+	r.SetCreatedBy("octocat")
+	r.SetUpdatedAt(1563474077)
+	r.SetUpdatedBy("octocat")
+
+	want := testRequiredContext()
+
+	// run test
+	got := RequiredContextFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RequiredContextFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testRequiredContext is a test helper function to create a RequiredContext
+// type with all fields set to a fake value.
+func testRequiredContext() *RequiredContext {
+	return &RequiredContext{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Org:       sql.NullString{String: "github", Valid: true},
+		Repo:      sql.NullString{String: "octocat", Valid: true},
+		Branch:    sql.NullString{String: "main", Valid: true},
+		Contexts:  pq.StringArray{"continuous-integration/vela/pull_request"},
+		Strict:    sql.NullBool{Bool: true, Valid: true},
+		Active:    sql.NullBool{Bool: true, Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy: sql.NullString{String: "octocat", Valid: true},
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ErrInvalidRetentionPolicyLimit defines the error type when a
+// RetentionPolicy type has a negative KeepBuilds, LogDays or
+// HookDays field provided.
+var ErrInvalidRetentionPolicyLimit = errors.New("invalid retention policy limit provided")
+
+// RetentionPolicy is the database representation of the policy
+// controlling how long the builds, logs and hooks for a repo are
+// kept. The global policy has no repo.
+type RetentionPolicy struct {
+	ID         sql.NullInt64  `sql:"id"`
+	RepoID     sql.NullInt64  `sql:"repo_id"`
+	KeepBuilds sql.NullInt64  `sql:"keep_builds"`
+	LogDays    sql.NullInt64  `sql:"log_days"`
+	HookDays   sql.NullInt64  `sql:"hook_days"`
+	UpdatedAt  sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy  sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the RetentionPolicy type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (p *RetentionPolicy) Nullify() *RetentionPolicy {
+	if p == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if p.ID.Int64 == 0 {
+		p.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if p.RepoID.Int64 == 0 {
+		p.RepoID.Valid = false
+	}
+
+	// check if the KeepBuilds field should be false
+	if p.KeepBuilds.Int64 == 0 {
+		p.KeepBuilds.Valid = false
+	}
+
+	// check if the LogDays field should be false
+	if p.LogDays.Int64 == 0 {
+		p.LogDays.Valid = false
+	}
+
+	// check if the HookDays field should be false
+	if p.HookDays.Int64 == 0 {
+		p.HookDays.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if p.UpdatedAt.Int64 == 0 {
+		p.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(p.UpdatedBy.String) == 0 {
+		p.UpdatedBy.Valid = false
+	}
+
+	return p
+}
+
+// ToAPI converts the RetentionPolicy type
+// to an API RetentionPolicy type.
+func (p *RetentionPolicy) ToAPI() *api.RetentionPolicy {
+	policy := new(api.RetentionPolicy)
+
+	policy.SetID(p.ID.Int64)
+	policy.SetRepoID(p.RepoID.Int64)
+	policy.SetKeepBuilds(p.KeepBuilds.Int64)
+	policy.SetLogDays(p.LogDays.Int64)
+	policy.SetHookDays(p.HookDays.Int64)
+	policy.SetUpdatedAt(p.UpdatedAt.Int64)
+	policy.SetUpdatedBy(p.UpdatedBy.String)
+
+	return policy
+}
+
+// Validate verifies the necessary fields for
+// the RetentionPolicy type are populated correctly.
+func (p *RetentionPolicy) Validate() error {
+	// verify the limit fields are not negative
+	if p.KeepBuilds.Int64 < 0 || p.LogDays.Int64 < 0 || p.HookDays.Int64 < 0 {
+		return ErrInvalidRetentionPolicyLimit
+	}
+
+	return nil
+}
+
+// RetentionPolicyFromAPI converts the API RetentionPolicy type
+// to a database RetentionPolicy type.
+func RetentionPolicyFromAPI(p *api.RetentionPolicy) *RetentionPolicy {
+	policy := &RetentionPolicy{
+		ID:         sql.NullInt64{Int64: p.GetID(), Valid: true},
+		RepoID:     sql.NullInt64{Int64: p.GetRepoID(), Valid: true},
+		KeepBuilds: sql.NullInt64{Int64: p.GetKeepBuilds(), Valid: true},
+		LogDays:    sql.NullInt64{Int64: p.GetLogDays(), Valid: true},
+		HookDays:   sql.NullInt64{Int64: p.GetHookDays(), Valid: true},
+		UpdatedAt:  sql.NullInt64{Int64: p.GetUpdatedAt(), Valid: true},
+		UpdatedBy:  sql.NullString{String: p.GetUpdatedBy(), Valid: true},
+	}
+
+	return policy.Nullify()
+}
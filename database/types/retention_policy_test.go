@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestRetentionPolicy_Nullify(t *testing.T) {
+	// setup types
+	var p *RetentionPolicy
+
+	want := &RetentionPolicy{
+		ID:         sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:     sql.NullInt64{Int64: 0, Valid: false},
+		KeepBuilds: sql.NullInt64{Int64: 0, Valid: false},
+		LogDays:    sql.NullInt64{Int64: 0, Valid: false},
+		HookDays:   sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedAt:  sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy:  sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *RetentionPolicy
+		want *RetentionPolicy
+	}{
+		{
+			item: testRetentionPolicy(),
+			want: testRetentionPolicy(),
+		},
+		{
+			item: p,
+			want: nil,
+		},
+		{
+			item: new(RetentionPolicy),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestRetentionPolicy_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.RetentionPolicy)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetKeepBuilds(100)
+	want.SetLogDays(30)
+	want.SetHookDays(90)
+	want.SetUpdatedAt(1563474077)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testRetentionPolicy().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestRetentionPolicy_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *RetentionPolicy
+	}{
+		{
+			failure: false,
+			item:    testRetentionPolicy(),
+		},
+		{ // global policy without any limits
+			failure: false,
+			item:    new(RetentionPolicy),
+		},
+		{ // negative KeepBuilds set for RetentionPolicy
+			failure: true,
+			item: func() *RetentionPolicy {
+				p := testRetentionPolicy()
+				p.KeepBuilds = sql.NullInt64{Int64: -1, Valid: true}
+
+				return p
+			}(),
+		},
+		{ // negative LogDays set for RetentionPolicy
+			failure: true,
+			item: func() *RetentionPolicy {
+				p := testRetentionPolicy()
+				p.LogDays = sql.NullInt64{Int64: -1, Valid: true}
+
+				return p
+			}(),
+		},
+		{ // negative HookDays set for RetentionPolicy
+			failure: true,
+			item: func() *RetentionPolicy {
+				p := testRetentionPolicy()
+				p.HookDays = sql.NullInt64{Int64: -1, Valid: true}
+
+				return p
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestRetentionPolicyFromAPI(t *testing.T) {
+	// setup types
+	p := new(api.RetentionPolicy)
+
+	p.SetID(1)
+	p.SetRepoID(1)
+	p.SetKeepBuilds(100)
+	p.SetLogDays(30)
+	p.SetHookDays(90)
+	p.SetUpdatedAt(1563474077)
+	p.SetUpdatedBy("octocat")
+
+	want := testRetentionPolicy()
+
+	// run test
+	got := RetentionPolicyFromAPI(p)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RetentionPolicyFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testRetentionPolicy is a test helper function to create a RetentionPolicy
+// type with all fields set to a fake value.
+func testRetentionPolicy() *RetentionPolicy {
+	return &RetentionPolicy{
+		ID:         sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+		KeepBuilds: sql.NullInt64{Int64: 100, Valid: true},
+		LogDays:    sql.NullInt64{Int64: 30, Valid: true},
+		HookDays:   sql.NullInt64{Int64: 90, Valid: true},
+		UpdatedAt:  sql.NullInt64{Int64: 1563474077, Valid: true},
+		UpdatedBy:  sql.NullString{String: "octocat", Valid: true},
+	}
+}
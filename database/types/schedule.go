@@ -0,0 +1,183 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyScheduleRepoID defines the error type when a
+	// Schedule type has an empty RepoID field provided.
+	ErrEmptyScheduleRepoID = errors.New("empty schedule repo_id provided")
+
+	// ErrEmptyScheduleName defines the error type when a
+	// Schedule type has an empty Name field provided.
+	ErrEmptyScheduleName = errors.New("empty schedule name provided")
+
+	// ErrEmptyScheduleEntry defines the error type when a
+	// Schedule type has an empty Entry field provided.
+	ErrEmptyScheduleEntry = errors.New("empty schedule entry provided")
+)
+
+// Schedule is the database representation of a cron schedule
+// that triggers builds for the branch of a repo in a time zone.
+type Schedule struct {
+	ID          sql.NullInt64  `sql:"id"`
+	RepoID      sql.NullInt64  `sql:"repo_id"`
+	Active      sql.NullBool   `sql:"active"`
+	Name        sql.NullString `sql:"name"`
+	Entry       sql.NullString `sql:"entry"`
+	TimeZone    sql.NullString `sql:"time_zone"`
+	Branch      sql.NullString `sql:"branch"`
+	CreatedAt   sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy   sql.NullString `sql:"created_by"`
+	UpdatedAt   sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy   sql.NullString `sql:"updated_by"`
+	ScheduledAt sql.NullInt64  `sql:"scheduled_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the Schedule type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *Schedule) Nullify() *Schedule {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if s.RepoID.Int64 == 0 {
+		s.RepoID.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(s.Name.String) == 0 {
+		s.Name.Valid = false
+	}
+
+	// check if the Entry field should be false
+	if len(s.Entry.String) == 0 {
+		s.Entry.Valid = false
+	}
+
+	// check if the TimeZone field should be false
+	if len(s.TimeZone.String) == 0 {
+		s.TimeZone.Valid = false
+	}
+
+	// check if the Branch field should be false
+	if len(s.Branch.String) == 0 {
+		s.Branch.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if s.CreatedAt.Int64 == 0 {
+		s.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(s.CreatedBy.String) == 0 {
+		s.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if s.UpdatedAt.Int64 == 0 {
+		s.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(s.UpdatedBy.String) == 0 {
+		s.UpdatedBy.Valid = false
+	}
+
+	// check if the ScheduledAt field should be false
+	if s.ScheduledAt.Int64 == 0 {
+		s.ScheduledAt.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the Schedule type
+// to an API Schedule type.
+func (s *Schedule) ToAPI() *api.Schedule {
+	schedule := new(api.Schedule)
+
+	schedule.SetID(s.ID.Int64)
+	schedule.SetRepoID(s.RepoID.Int64)
+	schedule.SetActive(s.Active.Bool)
+	schedule.SetName(s.Name.String)
+	schedule.SetEntry(s.Entry.String)
+	schedule.SetTimeZone(s.TimeZone.String)
+	schedule.SetBranch(s.Branch.String)
+	schedule.SetCreatedAt(s.CreatedAt.Int64)
+	schedule.SetCreatedBy(s.CreatedBy.String)
+	schedule.SetUpdatedAt(s.UpdatedAt.Int64)
+	schedule.SetUpdatedBy(s.UpdatedBy.String)
+	schedule.SetScheduledAt(s.ScheduledAt.Int64)
+
+	return schedule
+}
+
+// Validate verifies the necessary fields for
+// the Schedule type are populated correctly.
+func (s *Schedule) Validate() error {
+	// verify the RepoID field is populated
+	if s.RepoID.Int64 <= 0 {
+		return ErrEmptyScheduleRepoID
+	}
+
+	// verify the Name field is populated
+	if len(s.Name.String) == 0 {
+		return ErrEmptyScheduleName
+	}
+
+	// verify the Entry field is populated
+	if len(s.Entry.String) == 0 {
+		return ErrEmptyScheduleEntry
+	}
+
+	// ensure that all Schedule string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	s.Name = sql.NullString{String: sanitize(s.Name.String), Valid: s.Name.Valid}
+	s.Entry = sql.NullString{String: sanitize(s.Entry.String), Valid: s.Entry.Valid}
+	s.TimeZone = sql.NullString{String: sanitize(s.TimeZone.String), Valid: s.TimeZone.Valid}
+	s.Branch = sql.NullString{String: sanitize(s.Branch.String), Valid: s.Branch.Valid}
+
+	return nil
+}
+
+// ScheduleFromAPI converts the API Schedule type
+// to a database Schedule type.
+func ScheduleFromAPI(s *api.Schedule) *Schedule {
+	schedule := &Schedule{
+		ID:          sql.NullInt64{Int64: s.GetID(), Valid: true},
+		RepoID:      sql.NullInt64{Int64: s.GetRepoID(), Valid: true},
+		Active:      sql.NullBool{Bool: s.GetActive(), Valid: true},
+		Name:        sql.NullString{String: s.GetName(), Valid: true},
+		Entry:       sql.NullString{String: s.GetEntry(), Valid: true},
+		TimeZone:    sql.NullString{String: s.GetTimeZone(), Valid: true},
+		Branch:      sql.NullString{String: s.GetBranch(), Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: s.GetCreatedAt(), Valid: true},
+		CreatedBy:   sql.NullString{String: s.GetCreatedBy(), Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: s.GetUpdatedAt(), Valid: true},
+		UpdatedBy:   sql.NullString{String: s.GetUpdatedBy(), Valid: true},
+		ScheduledAt: sql.NullInt64{Int64: s.GetScheduledAt(), Valid: true},
+	}
+
+	return schedule.Nullify()
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyScheduleRunScheduleID defines the error type when a
+	// ScheduleRun type has an empty ScheduleID field provided.
+	ErrEmptyScheduleRunScheduleID = errors.New("empty schedule run schedule_id provided")
+
+	// ErrEmptyScheduleRunStatus defines the error type when a
+	// ScheduleRun type has an empty Status field provided.
+	ErrEmptyScheduleRunStatus = errors.New("empty schedule run status provided")
+)
+
+// ScheduleRun is the database representation of a time a schedule came
+// due, recording whether a build was triggered or the run was skipped.
+type ScheduleRun struct {
+	ID           sql.NullInt64  `sql:"id"`
+	ScheduleID   sql.NullInt64  `sql:"schedule_id"`
+	RepoID       sql.NullInt64  `sql:"repo_id"`
+	ScheduledFor sql.NullInt64  `sql:"scheduled_for"`
+	Status       sql.NullString `sql:"status"`
+	Reason       sql.NullString `sql:"reason"`
+	BuildNumber  sql.NullInt64  `sql:"build_number"`
+	CreatedAt    sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the ScheduleRun type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (r *ScheduleRun) Nullify() *ScheduleRun {
+	if r == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if r.ID.Int64 == 0 {
+		r.ID.Valid = false
+	}
+
+	// check if the ScheduleID field should be false
+	if r.ScheduleID.Int64 == 0 {
+		r.ScheduleID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if r.RepoID.Int64 == 0 {
+		r.RepoID.Valid = false
+	}
+
+	// check if the ScheduledFor field should be false
+	if r.ScheduledFor.Int64 == 0 {
+		r.ScheduledFor.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(r.Status.String) == 0 {
+		r.Status.Valid = false
+	}
+
+	// check if the Reason field should be false
+	if len(r.Reason.String) == 0 {
+		r.Reason.Valid = false
+	}
+
+	// check if the BuildNumber field should be false
+	if r.BuildNumber.Int64 == 0 {
+		r.BuildNumber.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if r.CreatedAt.Int64 == 0 {
+		r.CreatedAt.Valid = false
+	}
+
+	return r
+}
+
+// ToAPI converts the ScheduleRun type
+// to an API ScheduleRun type.
+func (r *ScheduleRun) ToAPI() *api.ScheduleRun {
+	run := new(api.ScheduleRun)
+
+	run.SetID(r.ID.Int64)
+	run.SetScheduleID(r.ScheduleID.Int64)
+	run.SetRepoID(r.RepoID.Int64)
+	run.SetScheduledFor(r.ScheduledFor.Int64)
+	run.SetStatus(r.Status.String)
+	run.SetReason(r.Reason.String)
+	run.SetBuildNumber(r.BuildNumber.Int64)
+	run.SetCreatedAt(r.CreatedAt.Int64)
+
+	return run
+}
+
+// Validate verifies the necessary fields for
+// the ScheduleRun type are populated correctly.
+func (r *ScheduleRun) Validate() error {
+	// verify the ScheduleID field is populated
+	if r.ScheduleID.Int64 <= 0 {
+		return ErrEmptyScheduleRunScheduleID
+	}
+
+	// verify the Status field is populated
+	if len(r.Status.String) == 0 {
+		return ErrEmptyScheduleRunStatus
+	}
+
+	// ensure that all ScheduleRun string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	r.Reason = sql.NullString{String: sanitize(r.Reason.String), Valid: r.Reason.Valid}
+
+	return nil
+}
+
+// ScheduleRunFromAPI converts the API ScheduleRun type
+// to a database ScheduleRun type.
+func ScheduleRunFromAPI(r *api.ScheduleRun) *ScheduleRun {
+	run := &ScheduleRun{
+		ID:           sql.NullInt64{Int64: r.GetID(), Valid: true},
+		ScheduleID:   sql.NullInt64{Int64: r.GetScheduleID(), Valid: true},
+		RepoID:       sql.NullInt64{Int64: r.GetRepoID(), Valid: true},
+		ScheduledFor: sql.NullInt64{Int64: r.GetScheduledFor(), Valid: true},
+		Status:       sql.NullString{String: r.GetStatus(), Valid: true},
+		Reason:       sql.NullString{String: r.GetReason(), Valid: true},
+		BuildNumber:  sql.NullInt64{Int64: r.GetBuildNumber(), Valid: true},
+		CreatedAt:    sql.NullInt64{Int64: r.GetCreatedAt(), Valid: true},
+	}
+
+	return run.Nullify()
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestScheduleRun_Nullify(t *testing.T) {
+	// setup types
+	var r *ScheduleRun
+
+	want := &ScheduleRun{
+		ID:           sql.NullInt64{Int64: 0, Valid: false},
+		ScheduleID:   sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:       sql.NullInt64{Int64: 0, Valid: false},
+		ScheduledFor: sql.NullInt64{Int64: 0, Valid: false},
+		Status:       sql.NullString{String: "", Valid: false},
+		Reason:       sql.NullString{String: "", Valid: false},
+		BuildNumber:  sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt:    sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		run  *ScheduleRun
+		want *ScheduleRun
+	}{
+		{
+			run:  testScheduleRun(),
+			want: testScheduleRun(),
+		},
+		{
+			run:  r,
+			want: nil,
+		},
+		{
+			run:  new(ScheduleRun),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.run.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestScheduleRun_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.ScheduleRun)
+
+	want.SetID(1)
+	want.SetScheduleID(1)
+	want.SetRepoID(1)
+	want.SetScheduledFor(1563474000)
+	want.SetStatus(api.ScheduleRunTriggered)
+	want.SetReason("")
+	want.SetBuildNumber(1)
+	want.SetCreatedAt(1563474077)
+
+	// run test
+	got := testScheduleRun().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestScheduleRun_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		run     *ScheduleRun
+	}{
+		{
+			failure: false,
+			run:     testScheduleRun(),
+		},
+		{ // no ScheduleID set for ScheduleRun
+			failure: true,
+			run: func() *ScheduleRun {
+				r := testScheduleRun()
+				r.ScheduleID = sql.NullInt64{}
+
+				return r
+			}(),
+		},
+		{ // no Status set for ScheduleRun
+			failure: true,
+			run: func() *ScheduleRun {
+				r := testScheduleRun()
+				r.Status = sql.NullString{}
+
+				return r
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.run.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestScheduleRunFromAPI(t *testing.T) {
+	// setup types
+	r := new(api.ScheduleRun)
+
+	r.SetID(1)
+	r.SetScheduleID(1)
+	r.SetRepoID(1)
+	r.SetScheduledFor(1563474000)
+	r.SetStatus(api.ScheduleRunTriggered)
+	r.SetBuildNumber(1)
+	r.SetCreatedAt(1563474077)
+
+	want := testScheduleRun()
+
+	// run test
+	got := ScheduleRunFromAPI(r)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScheduleRunFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testScheduleRun is a test helper function to create a ScheduleRun
+// type with all fields set to a fake value.
+func testScheduleRun() *ScheduleRun {
+	return &ScheduleRun{
+		ID:           sql.NullInt64{Int64: 1, Valid: true},
+		ScheduleID:   sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:       sql.NullInt64{Int64: 1, Valid: true},
+		ScheduledFor: sql.NullInt64{Int64: 1563474000, Valid: true},
+		Status:       sql.NullString{String: api.ScheduleRunTriggered, Valid: true},
+		Reason:       sql.NullString{String: "", Valid: false},
+		BuildNumber:  sql.NullInt64{Int64: 1, Valid: true},
+		CreatedAt:    sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
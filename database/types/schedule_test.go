@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSchedule_Nullify(t *testing.T) {
+	// setup types
+	var s *Schedule
+
+	want := &Schedule{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:      sql.NullInt64{Int64: 0, Valid: false},
+		Name:        sql.NullString{String: "", Valid: false},
+		Entry:       sql.NullString{String: "", Valid: false},
+		TimeZone:    sql.NullString{String: "", Valid: false},
+		Branch:      sql.NullString{String: "", Valid: false},
+		CreatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy:   sql.NullString{String: "", Valid: false},
+		UpdatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy:   sql.NullString{String: "", Valid: false},
+		ScheduledAt: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		schedule *Schedule
+		want     *Schedule
+	}{
+		{
+			schedule: testSchedule(),
+			want:     testSchedule(),
+		},
+		{
+			schedule: s,
+			want:     nil,
+		},
+		{
+			schedule: new(Schedule),
+			want:     want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.schedule.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSchedule_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.Schedule)
+
+	want.SetID(1)
+	want.SetRepoID(1)
+	want.SetActive(true)
+	want.SetName("nightly")
+	want.SetEntry("0 2 * * *")
+	want.SetTimeZone("America/Chicago")
+	want.SetBranch("main")
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474078)
+	want.SetUpdatedBy("octocat")
+	want.SetScheduledAt(1563474079)
+
+	// run test
+	got := testSchedule().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure  bool
+		schedule *Schedule
+	}{
+		{
+			failure:  false,
+			schedule: testSchedule(),
+		},
+		{ // no RepoID set for Schedule
+			failure: true,
+			schedule: func() *Schedule {
+				s := testSchedule()
+				s.RepoID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+		{ // no Name set for Schedule
+			failure: true,
+			schedule: func() *Schedule {
+				s := testSchedule()
+				s.Name = sql.NullString{}
+
+				return s
+			}(),
+		},
+		{ // no Entry set for Schedule
+			failure: true,
+			schedule: func() *Schedule {
+				s := testSchedule()
+				s.Entry = sql.NullString{}
+
+				return s
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.schedule.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestScheduleFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.Schedule)
+
+	s.SetID(1)
+	s.SetRepoID(1)
+	s.SetActive(true)
+	s.SetName("nightly")
+	s.SetEntry("0 2 * * *")
+	s.SetTimeZone("America/Chicago")
+	s.SetBranch("main")
+	s.SetCreatedAt(1563474077)
+	s.SetCreatedBy("octocat")
+	s.SetUpdatedAt(1563474078)
+	s.SetUpdatedBy("octocat")
+	s.SetScheduledAt(1563474079)
+
+	want := testSchedule()
+
+	// run test
+	got := ScheduleFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScheduleFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testSchedule is a test helper function to create a Schedule
+// type with all fields set to a fake value.
+func testSchedule() *Schedule {
+	return &Schedule{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:      sql.NullInt64{Int64: 1, Valid: true},
+		Active:      sql.NullBool{Bool: true, Valid: true},
+		Name:        sql.NullString{String: "nightly", Valid: true},
+		Entry:       sql.NullString{String: "0 2 * * *", Valid: true},
+		TimeZone:    sql.NullString{String: "America/Chicago", Valid: true},
+		Branch:      sql.NullString{String: "main", Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy:   sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: 1563474078, Valid: true},
+		UpdatedBy:   sql.NullString{String: "octocat", Valid: true},
+		ScheduledAt: sql.NullInt64{Int64: 1563474079, Valid: true},
+	}
+}
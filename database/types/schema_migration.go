@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptySchemaMigrationVersion defines the error type when a
+	// SchemaMigration type has an empty Version field provided.
+	ErrEmptySchemaMigrationVersion = errors.New("empty migration version provided")
+
+	// ErrEmptySchemaMigrationName defines the error type when a
+	// SchemaMigration type has an empty Name field provided.
+	ErrEmptySchemaMigrationName = errors.New("empty migration name provided")
+)
+
+// SchemaMigration is the database representation
+// of an applied schema migration.
+type SchemaMigration struct {
+	Version sql.NullInt64  `sql:"version" gorm:"primaryKey;autoIncrement:false"`
+	Name    sql.NullString `sql:"name"`
+	Applied sql.NullInt64  `sql:"applied"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the SchemaMigration type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (m *SchemaMigration) Nullify() *SchemaMigration {
+	if m == nil {
+		return nil
+	}
+
+	// check if the Version field should be false
+	if m.Version.Int64 == 0 {
+		m.Version.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(m.Name.String) == 0 {
+		m.Name.Valid = false
+	}
+
+	// check if the Applied field should be false
+	if m.Applied.Int64 == 0 {
+		m.Applied.Valid = false
+	}
+
+	return m
+}
+
+// ToAPI converts the SchemaMigration type
+// to an API SchemaMigration type.
+func (m *SchemaMigration) ToAPI() *api.SchemaMigration {
+	migration := new(api.SchemaMigration)
+
+	migration.SetVersion(m.Version.Int64)
+	migration.SetName(m.Name.String)
+	migration.SetApplied(m.Applied.Int64)
+
+	return migration
+}
+
+// Validate verifies the necessary fields for
+// the SchemaMigration type are populated correctly.
+func (m *SchemaMigration) Validate() error {
+	// verify the Version field is populated
+	if m.Version.Int64 <= 0 {
+		return ErrEmptySchemaMigrationVersion
+	}
+
+	// verify the Name field is populated
+	if len(m.Name.String) == 0 {
+		return ErrEmptySchemaMigrationName
+	}
+
+	return nil
+}
+
+// SchemaMigrationFromAPI converts the API SchemaMigration type
+// to a database SchemaMigration type.
+func SchemaMigrationFromAPI(m *api.SchemaMigration) *SchemaMigration {
+	migration := &SchemaMigration{
+		Version: sql.NullInt64{Int64: m.GetVersion(), Valid: true},
+		Name:    sql.NullString{String: m.GetName(), Valid: true},
+		Applied: sql.NullInt64{Int64: m.GetApplied(), Valid: true},
+	}
+
+	return migration.Nullify()
+}
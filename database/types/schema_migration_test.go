@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSchemaMigration_Nullify(t *testing.T) {
+	// setup types
+	var m *SchemaMigration
+
+	want := &SchemaMigration{
+		Version: sql.NullInt64{Int64: 0, Valid: false},
+		Name:    sql.NullString{String: "", Valid: false},
+		Applied: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *SchemaMigration
+		want *SchemaMigration
+	}{
+		{
+			item: testSchemaMigration(),
+			want: testSchemaMigration(),
+		},
+		{
+			item: m,
+			want: nil,
+		},
+		{
+			item: new(SchemaMigration),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSchemaMigration_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.SchemaMigration)
+
+	want.SetVersion(1)
+	want.SetName("baseline")
+	want.SetApplied(1563474076)
+
+	// run test
+	got := testSchemaMigration().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestSchemaMigration_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *SchemaMigration
+	}{
+		{
+			failure: false,
+			item:    testSchemaMigration(),
+		},
+		{ // no Version set for SchemaMigration
+			failure: true,
+			item: &SchemaMigration{
+				Name:    sql.NullString{String: "baseline", Valid: true},
+				Applied: sql.NullInt64{Int64: 1563474076, Valid: true},
+			},
+		},
+		{ // no Name set for SchemaMigration
+			failure: true,
+			item: &SchemaMigration{
+				Version: sql.NullInt64{Int64: 1, Valid: true},
+				Applied: sql.NullInt64{Int64: 1563474076, Valid: true},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestSchemaMigrationFromAPI(t *testing.T) {
+	// setup types
+	m := new(api.SchemaMigration)
+
+	m.SetVersion(1)
+	m.SetName("baseline")
+	m.SetApplied(1563474076)
+
+	want := testSchemaMigration()
+
+	// run test
+	got := SchemaMigrationFromAPI(m)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SchemaMigrationFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testSchemaMigration is a test helper function to create a SchemaMigration
+// type with all fields set to a fake value.
+func testSchemaMigration() *SchemaMigration {
+	return &SchemaMigration{
+		Version: sql.NullInt64{Int64: 1, Valid: true},
+		Name:    sql.NullString{String: "baseline", Valid: true},
+		Applied: sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyServiceReadinessServiceID defines the error type when a
+	// ServiceReadiness type has an empty ServiceID field provided.
+	ErrEmptyServiceReadinessServiceID = errors.New("empty service readiness service_id provided")
+
+	// ErrEmptyServiceReadinessBuildID defines the error type when a
+	// ServiceReadiness type has an empty BuildID field provided.
+	ErrEmptyServiceReadinessBuildID = errors.New("empty service readiness build_id provided")
+)
+
+// ServiceReadiness is the database representation of the readiness of a service
+// reported by the worker before the steps of a build start.
+type ServiceReadiness struct {
+	ID        sql.NullInt64  `sql:"id"`
+	ServiceID sql.NullInt64  `sql:"service_id"`
+	BuildID   sql.NullInt64  `sql:"build_id"`
+	RepoID    sql.NullInt64  `sql:"repo_id"`
+	Status    sql.NullString `sql:"status"`
+	Attempts  sql.NullInt64  `sql:"attempts"`
+	Started   sql.NullInt64  `sql:"started"`
+	Ready     sql.NullInt64  `sql:"ready"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the ServiceReadiness type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *ServiceReadiness) Nullify() *ServiceReadiness {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the ServiceID field should be false
+	if s.ServiceID.Int64 == 0 {
+		s.ServiceID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if s.BuildID.Int64 == 0 {
+		s.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if s.RepoID.Int64 == 0 {
+		s.RepoID.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(s.Status.String) == 0 {
+		s.Status.Valid = false
+	}
+
+	// check if the Attempts field should be false
+	if s.Attempts.Int64 == 0 {
+		s.Attempts.Valid = false
+	}
+
+	// check if the Started field should be false
+	if s.Started.Int64 == 0 {
+		s.Started.Valid = false
+	}
+
+	// check if the Ready field should be false
+	if s.Ready.Int64 == 0 {
+		s.Ready.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the ServiceReadiness type
+// to an API ServiceReadiness type.
+func (s *ServiceReadiness) ToAPI() *api.ServiceReadiness {
+	serviceReadiness := new(api.ServiceReadiness)
+
+	serviceReadiness.SetID(s.ID.Int64)
+	serviceReadiness.SetServiceID(s.ServiceID.Int64)
+	serviceReadiness.SetBuildID(s.BuildID.Int64)
+	serviceReadiness.SetRepoID(s.RepoID.Int64)
+	serviceReadiness.SetStatus(s.Status.String)
+	serviceReadiness.SetAttempts(s.Attempts.Int64)
+	serviceReadiness.SetStarted(s.Started.Int64)
+	serviceReadiness.SetReady(s.Ready.Int64)
+
+	return serviceReadiness
+}
+
+// Validate verifies the necessary fields for
+// the ServiceReadiness type are populated correctly.
+func (s *ServiceReadiness) Validate() error {
+	// verify the ServiceID field is populated
+	if s.ServiceID.Int64 <= 0 {
+		return ErrEmptyServiceReadinessServiceID
+	}
+
+	// verify the BuildID field is populated
+	if s.BuildID.Int64 <= 0 {
+		return ErrEmptyServiceReadinessBuildID
+	}
+
+	return nil
+}
+
+// ServiceReadinessFromAPI converts the API ServiceReadiness type
+// to a database ServiceReadiness type.
+func ServiceReadinessFromAPI(s *api.ServiceReadiness) *ServiceReadiness {
+	serviceReadiness := &ServiceReadiness{
+		ID:        sql.NullInt64{Int64: s.GetID(), Valid: true},
+		ServiceID: sql.NullInt64{Int64: s.GetServiceID(), Valid: true},
+		BuildID:   sql.NullInt64{Int64: s.GetBuildID(), Valid: true},
+		RepoID:    sql.NullInt64{Int64: s.GetRepoID(), Valid: true},
+		Status:    sql.NullString{String: s.GetStatus(), Valid: true},
+		Attempts:  sql.NullInt64{Int64: s.GetAttempts(), Valid: true},
+		Started:   sql.NullInt64{Int64: s.GetStarted(), Valid: true},
+		Ready:     sql.NullInt64{Int64: s.GetReady(), Valid: true},
+	}
+
+	return serviceReadiness.Nullify()
+}
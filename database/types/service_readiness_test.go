@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestServiceReadiness_Nullify(t *testing.T) {
+	// setup types
+	var s *ServiceReadiness
+
+	want := &ServiceReadiness{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		ServiceID: sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:   sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:    sql.NullInt64{Int64: 0, Valid: false},
+		Status:    sql.NullString{String: "", Valid: false},
+		Attempts:  sql.NullInt64{Int64: 0, Valid: false},
+		Started:   sql.NullInt64{Int64: 0, Valid: false},
+		Ready:     sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *ServiceReadiness
+		want *ServiceReadiness
+	}{
+		{
+			item: testServiceReadiness(),
+			want: testServiceReadiness(),
+		},
+		{
+			item: s,
+			want: nil,
+		},
+		{
+			item: new(ServiceReadiness),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestServiceReadiness_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.ServiceReadiness)
+
+	want.SetID(1)
+	want.SetServiceID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetStatus("ready")
+	want.SetAttempts(3)
+	want.SetStarted(1563474077)
+	want.SetReady(1563474083)
+
+	// run test
+	got := testServiceReadiness().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestServiceReadiness_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *ServiceReadiness
+	}{
+		{
+			failure: false,
+			item:    testServiceReadiness(),
+		},
+		{ // no ServiceID set for ServiceReadiness
+			failure: true,
+			item: func() *ServiceReadiness {
+				s := testServiceReadiness()
+				s.ServiceID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+		{ // no BuildID set for ServiceReadiness
+			failure: true,
+			item: func() *ServiceReadiness {
+				s := testServiceReadiness()
+				s.BuildID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestServiceReadinessFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.ServiceReadiness)
+
+	s.SetID(1)
+	s.SetServiceID(1)
+	s.SetBuildID(1)
+	s.SetRepoID(1)
+	s.SetStatus("ready")
+	s.SetAttempts(3)
+	s.SetStarted(1563474077)
+	s.SetReady(1563474083)
+
+	want := testServiceReadiness()
+
+	// run test
+	got := ServiceReadinessFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ServiceReadinessFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testServiceReadiness is a test helper function to create a ServiceReadiness
+// type with all fields set to a fake value.
+func testServiceReadiness() *ServiceReadiness {
+	return &ServiceReadiness{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		ServiceID: sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:   sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:    sql.NullInt64{Int64: 1, Valid: true},
+		Status:    sql.NullString{String: "ready", Valid: true},
+		Attempts:  sql.NullInt64{Int64: 3, Valid: true},
+		Started:   sql.NullInt64{Int64: 1563474077, Valid: true},
+		Ready:     sql.NullInt64{Int64: 1563474083, Valid: true},
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyStagedWebhookHeader defines the error type when a
+	// StagedWebhook type has an empty Header field provided.
+	ErrEmptyStagedWebhookHeader = errors.New("empty staged webhook header provided")
+
+	// ErrEmptyStagedWebhookPayload defines the error type when a
+	// StagedWebhook type has an empty Payload field provided.
+	ErrEmptyStagedWebhookPayload = errors.New("empty staged webhook payload provided")
+)
+
+// StagedWebhook is the database representation of a webhook that was still being
+// processed when the server shut down and is resumed on startup.
+type StagedWebhook struct {
+	ID      sql.NullInt64  `sql:"id"`
+	Source  sql.NullString `sql:"source"`
+	Header  sql.NullString `sql:"header"`
+	Payload sql.NullString `sql:"payload"`
+	Created sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the StagedWebhook type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (w *StagedWebhook) Nullify() *StagedWebhook {
+	if w == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if w.ID.Int64 == 0 {
+		w.ID.Valid = false
+	}
+
+	// check if the Source field should be false
+	if len(w.Source.String) == 0 {
+		w.Source.Valid = false
+	}
+
+	// check if the Header field should be false
+	if len(w.Header.String) == 0 {
+		w.Header.Valid = false
+	}
+
+	// check if the Payload field should be false
+	if len(w.Payload.String) == 0 {
+		w.Payload.Valid = false
+	}
+
+	// check if the Created field should be false
+	if w.Created.Int64 == 0 {
+		w.Created.Valid = false
+	}
+
+	return w
+}
+
+// ToAPI converts the StagedWebhook type
+// to an API StagedWebhook type.
+func (w *StagedWebhook) ToAPI() *api.StagedWebhook {
+	webhook := new(api.StagedWebhook)
+
+	webhook.SetID(w.ID.Int64)
+	webhook.SetSource(w.Source.String)
+	webhook.SetHeader(w.Header.String)
+	webhook.SetPayload(w.Payload.String)
+	webhook.SetCreated(w.Created.Int64)
+
+	return webhook
+}
+
+// Validate verifies the necessary fields for
+// the StagedWebhook type are populated correctly.
+func (w *StagedWebhook) Validate() error {
+	// verify the Header field is populated
+	if len(w.Header.String) == 0 {
+		return ErrEmptyStagedWebhookHeader
+	}
+
+	// verify the Payload field is populated
+	if len(w.Payload.String) == 0 {
+		return ErrEmptyStagedWebhookPayload
+	}
+
+	return nil
+}
+
+// StagedWebhookFromAPI converts the API StagedWebhook type
+// to a database StagedWebhook type.
+func StagedWebhookFromAPI(w *api.StagedWebhook) *StagedWebhook {
+	webhook := &StagedWebhook{
+		ID:      sql.NullInt64{Int64: w.GetID(), Valid: true},
+		Source:  sql.NullString{String: w.GetSource(), Valid: true},
+		Header:  sql.NullString{String: w.GetHeader(), Valid: true},
+		Payload: sql.NullString{String: w.GetPayload(), Valid: true},
+		Created: sql.NullInt64{Int64: w.GetCreated(), Valid: true},
+	}
+
+	return webhook.Nullify()
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestStagedWebhook_Nullify(t *testing.T) {
+	// setup types
+	var w *StagedWebhook
+
+	want := &StagedWebhook{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		Source:  sql.NullString{String: "", Valid: false},
+		Header:  sql.NullString{String: "", Valid: false},
+		Payload: sql.NullString{String: "", Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *StagedWebhook
+		want *StagedWebhook
+	}{
+		{
+			item: testStagedWebhook(),
+			want: testStagedWebhook(),
+		},
+		{
+			item: w,
+			want: nil,
+		},
+		{
+			item: new(StagedWebhook),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestStagedWebhook_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.StagedWebhook)
+
+	want.SetID(1)
+	want.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	want.SetHeader(`{"X-Github-Event":["push"]}`)
+	want.SetPayload(`{"ref":"refs/heads/main"}`)
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testStagedWebhook().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestStagedWebhook_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *StagedWebhook
+	}{
+		{
+			failure: false,
+			item:    testStagedWebhook(),
+		},
+		{ // no Header set for StagedWebhook
+			failure: true,
+			item: func() *StagedWebhook {
+				w := testStagedWebhook()
+				w.Header = sql.NullString{}
+
+				return w
+			}(),
+		},
+		{ // no Payload set for StagedWebhook
+			failure: true,
+			item: func() *StagedWebhook {
+				w := testStagedWebhook()
+				w.Payload = sql.NullString{}
+
+				return w
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestStagedWebhookFromAPI(t *testing.T) {
+	// setup types
+	w := new(api.StagedWebhook)
+
+	w.SetID(1)
+	w.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	w.SetHeader(`{"X-Github-Event":["push"]}`)
+	w.SetPayload(`{"ref":"refs/heads/main"}`)
+	w.SetCreated(1563474076)
+
+	want := testStagedWebhook()
+
+	// run test
+	got := StagedWebhookFromAPI(w)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StagedWebhookFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testStagedWebhook is a test helper function to create a StagedWebhook
+// type with all fields set to a fake value.
+func testStagedWebhook() *StagedWebhook {
+	return &StagedWebhook{
+		ID:      sql.NullInt64{Int64: 1, Valid: true},
+		Source:  sql.NullString{String: "c8da1302-07d6-11ea-882f-4893bca275b8", Valid: true},
+		Header:  sql.NullString{String: `{"X-Github-Event":["push"]}`, Valid: true},
+		Payload: sql.NullString{String: `{"ref":"refs/heads/main"}`, Valid: true},
+		Created: sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyStepAttemptStepID defines the error type when a
+	// StepAttempt type has an empty StepID field provided.
+	ErrEmptyStepAttemptStepID = errors.New("empty step attempt step_id provided")
+
+	// ErrEmptyStepAttemptBuildID defines the error type when a
+	// StepAttempt type has an empty BuildID field provided.
+	ErrEmptyStepAttemptBuildID = errors.New("empty step attempt build_id provided")
+
+	// ErrEmptyStepAttemptAttempt defines the error type when a
+	// StepAttempt type has an empty Attempt field provided.
+	ErrEmptyStepAttemptAttempt = errors.New("empty step attempt attempt provided")
+)
+
+// StepAttempt is the database representation of a single attempt of a step
+// run by the worker under the retry policy for the step.
+type StepAttempt struct {
+	ID       sql.NullInt64  `sql:"id"`
+	StepID   sql.NullInt64  `sql:"step_id"`
+	BuildID  sql.NullInt64  `sql:"build_id"`
+	RepoID   sql.NullInt64  `sql:"repo_id"`
+	Attempt  sql.NullInt64  `sql:"attempt"`
+	Status   sql.NullString `sql:"status"`
+	Error    sql.NullString `sql:"error"`
+	ExitCode sql.NullInt64  `sql:"exit_code"`
+	Started  sql.NullInt64  `sql:"started"`
+	Finished sql.NullInt64  `sql:"finished"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the StepAttempt type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *StepAttempt) Nullify() *StepAttempt {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the StepID field should be false
+	if s.StepID.Int64 == 0 {
+		s.StepID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if s.BuildID.Int64 == 0 {
+		s.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if s.RepoID.Int64 == 0 {
+		s.RepoID.Valid = false
+	}
+
+	// check if the Attempt field should be false
+	if s.Attempt.Int64 == 0 {
+		s.Attempt.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(s.Status.String) == 0 {
+		s.Status.Valid = false
+	}
+
+	// check if the Error field should be false
+	if len(s.Error.String) == 0 {
+		s.Error.Valid = false
+	}
+
+	// check if the ExitCode field should be false
+	if s.ExitCode.Int64 == 0 {
+		s.ExitCode.Valid = false
+	}
+
+	// check if the Started field should be false
+	if s.Started.Int64 == 0 {
+		s.Started.Valid = false
+	}
+
+	// check if the Finished field should be false
+	if s.Finished.Int64 == 0 {
+		s.Finished.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the StepAttempt type
+// to an API StepAttempt type.
+func (s *StepAttempt) ToAPI() *api.StepAttempt {
+	stepAttempt := new(api.StepAttempt)
+
+	stepAttempt.SetID(s.ID.Int64)
+	stepAttempt.SetStepID(s.StepID.Int64)
+	stepAttempt.SetBuildID(s.BuildID.Int64)
+	stepAttempt.SetRepoID(s.RepoID.Int64)
+	stepAttempt.SetAttempt(s.Attempt.Int64)
+	stepAttempt.SetStatus(s.Status.String)
+	stepAttempt.SetError(s.Error.String)
+	stepAttempt.SetExitCode(s.ExitCode.Int64)
+	stepAttempt.SetStarted(s.Started.Int64)
+	stepAttempt.SetFinished(s.Finished.Int64)
+
+	return stepAttempt
+}
+
+// Validate verifies the necessary fields for
+// the StepAttempt type are populated correctly.
+func (s *StepAttempt) Validate() error {
+	// verify the StepID field is populated
+	if s.StepID.Int64 <= 0 {
+		return ErrEmptyStepAttemptStepID
+	}
+
+	// verify the BuildID field is populated
+	if s.BuildID.Int64 <= 0 {
+		return ErrEmptyStepAttemptBuildID
+	}
+
+	// verify the Attempt field is populated
+	if s.Attempt.Int64 <= 0 {
+		return ErrEmptyStepAttemptAttempt
+	}
+
+	return nil
+}
+
+// StepAttemptFromAPI converts the API StepAttempt type
+// to a database StepAttempt type.
+func StepAttemptFromAPI(s *api.StepAttempt) *StepAttempt {
+	stepAttempt := &StepAttempt{
+		ID:       sql.NullInt64{Int64: s.GetID(), Valid: true},
+		StepID:   sql.NullInt64{Int64: s.GetStepID(), Valid: true},
+		BuildID:  sql.NullInt64{Int64: s.GetBuildID(), Valid: true},
+		RepoID:   sql.NullInt64{Int64: s.GetRepoID(), Valid: true},
+		Attempt:  sql.NullInt64{Int64: s.GetAttempt(), Valid: true},
+		Status:   sql.NullString{String: s.GetStatus(), Valid: true},
+		Error:    sql.NullString{String: s.GetError(), Valid: true},
+		ExitCode: sql.NullInt64{Int64: s.GetExitCode(), Valid: true},
+		Started:  sql.NullInt64{Int64: s.GetStarted(), Valid: true},
+		Finished: sql.NullInt64{Int64: s.GetFinished(), Valid: true},
+	}
+
+	return stepAttempt.Nullify()
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestStepAttempt_Nullify(t *testing.T) {
+	// setup types
+	var s *StepAttempt
+
+	want := &StepAttempt{
+		ID:       sql.NullInt64{Int64: 0, Valid: false},
+		StepID:   sql.NullInt64{Int64: 0, Valid: false},
+		BuildID:  sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:   sql.NullInt64{Int64: 0, Valid: false},
+		Attempt:  sql.NullInt64{Int64: 0, Valid: false},
+		Status:   sql.NullString{String: "", Valid: false},
+		Error:    sql.NullString{String: "", Valid: false},
+		ExitCode: sql.NullInt64{Int64: 0, Valid: false},
+		Started:  sql.NullInt64{Int64: 0, Valid: false},
+		Finished: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *StepAttempt
+		want *StepAttempt
+	}{
+		{
+			item: testStepAttempt(),
+			want: testStepAttempt(),
+		},
+		{
+			item: s,
+			want: nil,
+		},
+		{
+			item: new(StepAttempt),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestStepAttempt_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.StepAttempt)
+
+	want.SetID(1)
+	want.SetStepID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetAttempt(1)
+	want.SetStatus("failure")
+	want.SetError("exit code 1")
+	want.SetExitCode(1)
+	want.SetStarted(1563474077)
+	want.SetFinished(1563474083)
+
+	// run test
+	got := testStepAttempt().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestStepAttempt_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *StepAttempt
+	}{
+		{
+			failure: false,
+			item:    testStepAttempt(),
+		},
+		{ // no StepID set for StepAttempt
+			failure: true,
+			item: func() *StepAttempt {
+				s := testStepAttempt()
+				s.StepID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+		{ // no BuildID set for StepAttempt
+			failure: true,
+			item: func() *StepAttempt {
+				s := testStepAttempt()
+				s.BuildID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+		{ // no Attempt set for StepAttempt
+			failure: true,
+			item: func() *StepAttempt {
+				s := testStepAttempt()
+				s.Attempt = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestStepAttemptFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.StepAttempt)
+
+	s.SetID(1)
+	s.SetStepID(1)
+	s.SetBuildID(1)
+	s.SetRepoID(1)
+	s.SetAttempt(1)
+	s.SetStatus("failure")
+	s.SetError("exit code 1")
+	s.SetExitCode(1)
+	s.SetStarted(1563474077)
+	s.SetFinished(1563474083)
+
+	want := testStepAttempt()
+
+	// run test
+	got := StepAttemptFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StepAttemptFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testStepAttempt is a test helper function to create a StepAttempt
+// type with all fields set to a fake value.
+func testStepAttempt() *StepAttempt {
+	return &StepAttempt{
+		ID:       sql.NullInt64{Int64: 1, Valid: true},
+		StepID:   sql.NullInt64{Int64: 1, Valid: true},
+		BuildID:  sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:   sql.NullInt64{Int64: 1, Valid: true},
+		Attempt:  sql.NullInt64{Int64: 1, Valid: true},
+		Status:   sql.NullString{String: "failure", Valid: true},
+		Error:    sql.NullString{String: "exit code 1", Valid: true},
+		ExitCode: sql.NullInt64{Int64: 1, Valid: true},
+		Started:  sql.NullInt64{Int64: 1563474077, Valid: true},
+		Finished: sql.NullInt64{Int64: 1563474083, Valid: true},
+	}
+}
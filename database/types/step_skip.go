@@ -0,0 +1,148 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyStepSkipBuildID defines the error type when a
+	// StepSkip type has an empty BuildID field provided.
+	ErrEmptyStepSkipBuildID = errors.New("empty step skip build_id provided")
+
+	// ErrEmptyStepSkipName defines the error type when a
+	// StepSkip type has an empty Stage and Step field provided.
+	ErrEmptyStepSkipName = errors.New("empty step skip stage and step provided")
+
+	// ErrEmptyStepSkipReason defines the error type when a
+	// StepSkip type has an empty Reason field provided.
+	ErrEmptyStepSkipReason = errors.New("empty step skip reason provided")
+)
+
+// StepSkip is the database representation of a stage or step of a
+// build that was skipped when the build was compiled.
+type StepSkip struct {
+	ID      sql.NullInt64  `sql:"id"`
+	BuildID sql.NullInt64  `sql:"build_id"`
+	RepoID  sql.NullInt64  `sql:"repo_id"`
+	Stage   sql.NullString `sql:"stage"`
+	Step    sql.NullString `sql:"step"`
+	Reason  sql.NullString `sql:"reason"`
+	Message sql.NullString `sql:"message"`
+	Created sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the StepSkip type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *StepSkip) Nullify() *StepSkip {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the BuildID field should be false
+	if s.BuildID.Int64 == 0 {
+		s.BuildID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if s.RepoID.Int64 == 0 {
+		s.RepoID.Valid = false
+	}
+
+	// check if the Stage field should be false
+	if len(s.Stage.String) == 0 {
+		s.Stage.Valid = false
+	}
+
+	// check if the Step field should be false
+	if len(s.Step.String) == 0 {
+		s.Step.Valid = false
+	}
+
+	// check if the Reason field should be false
+	if len(s.Reason.String) == 0 {
+		s.Reason.Valid = false
+	}
+
+	// check if the Message field should be false
+	if len(s.Message.String) == 0 {
+		s.Message.Valid = false
+	}
+
+	// check if the Created field should be false
+	if s.Created.Int64 == 0 {
+		s.Created.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the StepSkip type
+// to an API StepSkip type.
+func (s *StepSkip) ToAPI() *api.StepSkip {
+	stepSkip := new(api.StepSkip)
+
+	stepSkip.SetID(s.ID.Int64)
+	stepSkip.SetBuildID(s.BuildID.Int64)
+	stepSkip.SetRepoID(s.RepoID.Int64)
+	stepSkip.SetStage(s.Stage.String)
+	stepSkip.SetStep(s.Step.String)
+	stepSkip.SetReason(s.Reason.String)
+	stepSkip.SetMessage(s.Message.String)
+	stepSkip.SetCreated(s.Created.Int64)
+
+	return stepSkip
+}
+
+// Validate verifies the necessary fields for
+// the StepSkip type are populated correctly.
+func (s *StepSkip) Validate() error {
+	// verify the BuildID field is populated
+	if s.BuildID.Int64 <= 0 {
+		return ErrEmptyStepSkipBuildID
+	}
+
+	// verify the Stage or Step field is populated
+	if len(s.Stage.String) == 0 && len(s.Step.String) == 0 {
+		return ErrEmptyStepSkipName
+	}
+
+	// verify the Reason field is populated
+	if len(s.Reason.String) == 0 {
+		return ErrEmptyStepSkipReason
+	}
+
+	return nil
+}
+
+// StepSkipFromAPI converts the API StepSkip type
+// to a database StepSkip type.
+func StepSkipFromAPI(s *api.StepSkip) *StepSkip {
+	stepSkip := &StepSkip{
+		ID:      sql.NullInt64{Int64: s.GetID(), Valid: true},
+		BuildID: sql.NullInt64{Int64: s.GetBuildID(), Valid: true},
+		RepoID:  sql.NullInt64{Int64: s.GetRepoID(), Valid: true},
+		Stage:   sql.NullString{String: s.GetStage(), Valid: true},
+		Step:    sql.NullString{String: s.GetStep(), Valid: true},
+		Reason:  sql.NullString{String: s.GetReason(), Valid: true},
+		Message: sql.NullString{String: s.GetMessage(), Valid: true},
+		Created: sql.NullInt64{Int64: s.GetCreated(), Valid: true},
+	}
+
+	return stepSkip.Nullify()
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestStepSkip_Nullify(t *testing.T) {
+	// setup types
+	var s *StepSkip
+
+	want := &StepSkip{
+		ID:      sql.NullInt64{Int64: 0, Valid: false},
+		BuildID: sql.NullInt64{Int64: 0, Valid: false},
+		RepoID:  sql.NullInt64{Int64: 0, Valid: false},
+		Stage:   sql.NullString{String: "", Valid: false},
+		Step:    sql.NullString{String: "", Valid: false},
+		Reason:  sql.NullString{String: "", Valid: false},
+		Message: sql.NullString{String: "", Valid: false},
+		Created: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *StepSkip
+		want *StepSkip
+	}{
+		{
+			item: testStepSkip(),
+			want: testStepSkip(),
+		},
+		{
+			item: s,
+			want: nil,
+		},
+		{
+			item: new(StepSkip),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestStepSkip_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.StepSkip)
+
+	want.SetID(1)
+	want.SetBuildID(1)
+	want.SetRepoID(1)
+	want.SetStage("test")
+	want.SetStep("deploy")
+	want.SetReason("ruleset_if")
+	want.SetMessage("ruleset if rules do not match the build")
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testStepSkip().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestStepSkip_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *StepSkip
+	}{
+		{
+			failure: false,
+			item:    testStepSkip(),
+		},
+		{ // no Step set for StepSkip of a stage
+			failure: false,
+			item: func() *StepSkip {
+				s := testStepSkip()
+				s.Step = sql.NullString{}
+
+				return s
+			}(),
+		},
+		{ // no BuildID set for StepSkip
+			failure: true,
+			item: func() *StepSkip {
+				s := testStepSkip()
+				s.BuildID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+		{ // no Stage or Step set for StepSkip
+			failure: true,
+			item: func() *StepSkip {
+				s := testStepSkip()
+				s.Stage = sql.NullString{}
+				s.Step = sql.NullString{}
+
+				return s
+			}(),
+		},
+		{ // no Reason set for StepSkip
+			failure: true,
+			item: func() *StepSkip {
+				s := testStepSkip()
+				s.Reason = sql.NullString{}
+
+				return s
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestStepSkipFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.StepSkip)
+
+	s.SetID(1)
+	s.SetBuildID(1)
+	s.SetRepoID(1)
+	s.SetStage("test")
+	s.SetStep("deploy")
+	s.SetReason("ruleset_if")
+	s.SetMessage("ruleset if rules do not match the build")
+	s.SetCreated(1563474076)
+
+	want := testStepSkip()
+
+	// run test
+	got := StepSkipFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("StepSkipFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testStepSkip is a test helper function to create a StepSkip
+// type with all fields set to a fake value.
+func testStepSkip() *StepSkip {
+	return &StepSkip{
+		ID:      sql.NullInt64{Int64: 1, Valid: true},
+		BuildID: sql.NullInt64{Int64: 1, Valid: true},
+		RepoID:  sql.NullInt64{Int64: 1, Valid: true},
+		Stage:   sql.NullString{String: "test", Valid: true},
+		Step:    sql.NullString{String: "deploy", Valid: true},
+		Reason:  sql.NullString{String: "ruleset_if", Valid: true},
+		Message: sql.NullString{String: "ruleset if rules do not match the build", Valid: true},
+		Created: sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
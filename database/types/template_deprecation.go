@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyTemplateDeprecationOrg defines the error type when a
+	// TemplateDeprecation type has an empty Org field provided.
+	ErrEmptyTemplateDeprecationOrg = errors.New("empty template deprecation org provided")
+
+	// ErrEmptyTemplateDeprecationRepo defines the error type when a
+	// TemplateDeprecation type has an empty Repo field provided.
+	ErrEmptyTemplateDeprecationRepo = errors.New("empty template deprecation repo provided")
+
+	// ErrEmptyTemplateDeprecationPath defines the error type when a
+	// TemplateDeprecation type has an empty Path field provided.
+	ErrEmptyTemplateDeprecationPath = errors.New("empty template deprecation path provided")
+)
+
+// TemplateDeprecation is the database representation of a version of a template
+// that has been marked deprecated by its owners.
+type TemplateDeprecation struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Org       sql.NullString `sql:"org"`
+	Repo      sql.NullString `sql:"repo"`
+	Path      sql.NullString `sql:"path"`
+	Ref       sql.NullString `sql:"ref"`
+	Message   sql.NullString `sql:"message"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	CreatedAt sql.NullInt64  `sql:"created_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the TemplateDeprecation type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (d *TemplateDeprecation) Nullify() *TemplateDeprecation {
+	if d == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if d.ID.Int64 == 0 {
+		d.ID.Valid = false
+	}
+
+	// check if the Org field should be false
+	if len(d.Org.String) == 0 {
+		d.Org.Valid = false
+	}
+
+	// check if the Repo field should be false
+	if len(d.Repo.String) == 0 {
+		d.Repo.Valid = false
+	}
+
+	// check if the Path field should be false
+	if len(d.Path.String) == 0 {
+		d.Path.Valid = false
+	}
+
+	// check if the Ref field should be false
+	if len(d.Ref.String) == 0 {
+		d.Ref.Valid = false
+	}
+
+	// check if the Message field should be false
+	if len(d.Message.String) == 0 {
+		d.Message.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(d.CreatedBy.String) == 0 {
+		d.CreatedBy.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if d.CreatedAt.Int64 == 0 {
+		d.CreatedAt.Valid = false
+	}
+
+	return d
+}
+
+// ToAPI converts the TemplateDeprecation type
+// to an API TemplateDeprecation type.
+func (d *TemplateDeprecation) ToAPI() *api.TemplateDeprecation {
+	templateDeprecation := new(api.TemplateDeprecation)
+
+	templateDeprecation.SetID(d.ID.Int64)
+	templateDeprecation.SetOrg(d.Org.String)
+	templateDeprecation.SetRepo(d.Repo.String)
+	templateDeprecation.SetPath(d.Path.String)
+	templateDeprecation.SetRef(d.Ref.String)
+	templateDeprecation.SetMessage(d.Message.String)
+	templateDeprecation.SetCreatedBy(d.CreatedBy.String)
+	templateDeprecation.SetCreatedAt(d.CreatedAt.Int64)
+
+	return templateDeprecation
+}
+
+// Validate verifies the necessary fields for
+// the TemplateDeprecation type are populated correctly.
+func (d *TemplateDeprecation) Validate() error {
+	// verify the Org field is populated
+	if len(d.Org.String) == 0 {
+		return ErrEmptyTemplateDeprecationOrg
+	}
+
+	// verify the Repo field is populated
+	if len(d.Repo.String) == 0 {
+		return ErrEmptyTemplateDeprecationRepo
+	}
+
+	// verify the Path field is populated
+	if len(d.Path.String) == 0 {
+		return ErrEmptyTemplateDeprecationPath
+	}
+
+	// ensure that all TemplateDeprecation string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	d.Message = sql.NullString{String: sanitize(d.Message.String), Valid: d.Message.Valid}
+
+	return nil
+}
+
+// TemplateDeprecationFromAPI converts the API TemplateDeprecation type
+// to a database TemplateDeprecation type.
+func TemplateDeprecationFromAPI(d *api.TemplateDeprecation) *TemplateDeprecation {
+	templateDeprecation := &TemplateDeprecation{
+		ID:        sql.NullInt64{Int64: d.GetID(), Valid: true},
+		Org:       sql.NullString{String: d.GetOrg(), Valid: true},
+		Repo:      sql.NullString{String: d.GetRepo(), Valid: true},
+		Path:      sql.NullString{String: d.GetPath(), Valid: true},
+		Ref:       sql.NullString{String: d.GetRef(), Valid: true},
+		Message:   sql.NullString{String: d.GetMessage(), Valid: true},
+		CreatedBy: sql.NullString{String: d.GetCreatedBy(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: d.GetCreatedAt(), Valid: true},
+	}
+
+	return templateDeprecation.Nullify()
+}
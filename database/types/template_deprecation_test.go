@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTemplateDeprecation_Nullify(t *testing.T) {
+	// setup types
+	var d *TemplateDeprecation
+
+	want := &TemplateDeprecation{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Org:       sql.NullString{String: "", Valid: false},
+		Repo:      sql.NullString{String: "", Valid: false},
+		Path:      sql.NullString{String: "", Valid: false},
+		Ref:       sql.NullString{String: "", Valid: false},
+		Message:   sql.NullString{String: "", Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *TemplateDeprecation
+		want *TemplateDeprecation
+	}{
+		{
+			item: testTemplateDeprecation(),
+			want: testTemplateDeprecation(),
+		},
+		{
+			item: d,
+			want: nil,
+		},
+		{
+			item: new(TemplateDeprecation),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestTemplateDeprecation_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.TemplateDeprecation)
+
+	want.SetID(1)
+	want.SetOrg("github")
+	want.SetRepo("octocat")
+	want.SetPath("template.yml")
+	want.SetRef("v1.0.0")
+	want.SetMessage("use v2.0.0 instead")
+	want.SetCreatedBy("octocat")
+	want.SetCreatedAt(1563474077)
+
+	// run test
+	got := testTemplateDeprecation().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestTemplateDeprecation_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *TemplateDeprecation
+	}{
+		{
+			failure: false,
+			item:    testTemplateDeprecation(),
+		},
+		{ // no Org set for TemplateDeprecation
+			failure: true,
+			item: func() *TemplateDeprecation {
+				d := testTemplateDeprecation()
+				d.Org = sql.NullString{}
+
+				return d
+			}(),
+		},
+		{ // no Repo set for TemplateDeprecation
+			failure: true,
+			item: func() *TemplateDeprecation {
+				d := testTemplateDeprecation()
+				d.Repo = sql.NullString{}
+
+				return d
+			}(),
+		},
+		{ // no Path set for TemplateDeprecation
+			failure: true,
+			item: func() *TemplateDeprecation {
+				d := testTemplateDeprecation()
+				d.Path = sql.NullString{}
+
+				return d
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestTemplateDeprecationFromAPI(t *testing.T) {
+	// setup types
+	d := new(api.TemplateDeprecation)
+
+	d.SetID(1)
+	d.SetOrg("github")
+	d.SetRepo("octocat")
+	d.SetPath("template.yml")
+	d.SetRef("v1.0.0")
+	d.SetMessage("use v2.0.0 instead")
+	d.SetCreatedBy("octocat")
+	d.SetCreatedAt(1563474077)
+
+	want := testTemplateDeprecation()
+
+	// run test
+	got := TemplateDeprecationFromAPI(d)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TemplateDeprecationFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testTemplateDeprecation is a test helper function to create a TemplateDeprecation
+// type with all fields set to a fake value.
+func testTemplateDeprecation() *TemplateDeprecation {
+	return &TemplateDeprecation{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Org:       sql.NullString{String: "github", Valid: true},
+		Repo:      sql.NullString{String: "octocat", Valid: true},
+		Path:      sql.NullString{String: "template.yml", Valid: true},
+		Ref:       sql.NullString{String: "v1.0.0", Valid: true},
+		Message:   sql.NullString{String: "use v2.0.0 instead", Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
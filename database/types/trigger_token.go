@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyTriggerTokenRepo defines the error type when a
+	// TriggerToken type has an empty Repo field provided.
+	ErrEmptyTriggerTokenRepo = errors.New("empty trigger token repo provided")
+
+	// ErrEmptyTriggerTokenTokenID defines the error type when a
+	// TriggerToken type has an empty TokenID field provided.
+	ErrEmptyTriggerTokenTokenID = errors.New("empty trigger token token_id provided")
+
+	// ErrEmptyTriggerTokenCreatedBy defines the error type when a
+	// TriggerToken type has an empty CreatedBy field provided.
+	ErrEmptyTriggerTokenCreatedBy = errors.New("empty trigger token created_by provided")
+)
+
+// TriggerToken is the database representation of a repo trigger token.
+type TriggerToken struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Repo      sql.NullString `sql:"repo"`
+	TokenID   sql.NullString `sql:"token_id"`
+	CreatedAt sql.NullInt64  `sql:"created_at"`
+	CreatedBy sql.NullString `sql:"created_by"`
+	ExpiresAt sql.NullInt64  `sql:"expires_at"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the TriggerToken type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (t *TriggerToken) Nullify() *TriggerToken {
+	if t == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if t.ID.Int64 == 0 {
+		t.ID.Valid = false
+	}
+
+	// check if the Repo field should be false
+	if len(t.Repo.String) == 0 {
+		t.Repo.Valid = false
+	}
+
+	// check if the TokenID field should be false
+	if len(t.TokenID.String) == 0 {
+		t.TokenID.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if t.CreatedAt.Int64 == 0 {
+		t.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(t.CreatedBy.String) == 0 {
+		t.CreatedBy.Valid = false
+	}
+
+	// check if the ExpiresAt field should be false
+	if t.ExpiresAt.Int64 == 0 {
+		t.ExpiresAt.Valid = false
+	}
+
+	return t
+}
+
+// ToAPI converts the TriggerToken type
+// to an API TriggerToken type.
+func (t *TriggerToken) ToAPI() *api.TriggerToken {
+	token := new(api.TriggerToken)
+
+	token.SetID(t.ID.Int64)
+	token.SetRepo(t.Repo.String)
+	token.SetTokenID(t.TokenID.String)
+	token.SetCreatedAt(t.CreatedAt.Int64)
+	token.SetCreatedBy(t.CreatedBy.String)
+	token.SetExpiresAt(t.ExpiresAt.Int64)
+
+	return token
+}
+
+// Validate verifies the necessary fields for
+// the TriggerToken type are populated correctly.
+func (t *TriggerToken) Validate() error {
+	// verify the Repo field is populated
+	if len(t.Repo.String) == 0 {
+		return ErrEmptyTriggerTokenRepo
+	}
+
+	// verify the TokenID field is populated
+	if len(t.TokenID.String) == 0 {
+		return ErrEmptyTriggerTokenTokenID
+	}
+
+	// verify the CreatedBy field is populated
+	if len(t.CreatedBy.String) == 0 {
+		return ErrEmptyTriggerTokenCreatedBy
+	}
+
+	// ensure that all TriggerToken string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	t.Repo = sql.NullString{String: sanitize(t.Repo.String), Valid: t.Repo.Valid}
+	t.CreatedBy = sql.NullString{String: sanitize(t.CreatedBy.String), Valid: t.CreatedBy.Valid}
+
+	return nil
+}
+
+// TriggerTokenFromAPI converts the API TriggerToken type
+// to a database TriggerToken type.
+func TriggerTokenFromAPI(t *api.TriggerToken) *TriggerToken {
+	token := &TriggerToken{
+		ID:        sql.NullInt64{Int64: t.GetID(), Valid: true},
+		Repo:      sql.NullString{String: t.GetRepo(), Valid: true},
+		TokenID:   sql.NullString{String: t.GetTokenID(), Valid: true},
+		CreatedAt: sql.NullInt64{Int64: t.GetCreatedAt(), Valid: true},
+		CreatedBy: sql.NullString{String: t.GetCreatedBy(), Valid: true},
+		ExpiresAt: sql.NullInt64{Int64: t.GetExpiresAt(), Valid: true},
+	}
+
+	return token.Nullify()
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestTriggerToken_Nullify(t *testing.T) {
+	// setup types
+	var tkn *TriggerToken
+
+	want := &TriggerToken{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Repo:      sql.NullString{String: "", Valid: false},
+		TokenID:   sql.NullString{String: "", Valid: false},
+		CreatedAt: sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy: sql.NullString{String: "", Valid: false},
+		ExpiresAt: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		token *TriggerToken
+		want  *TriggerToken
+	}{
+		{
+			token: testTriggerToken(),
+			want:  testTriggerToken(),
+		},
+		{
+			token: tkn,
+			want:  nil,
+		},
+		{
+			token: new(TriggerToken),
+			want:  want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.token.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestTriggerToken_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.TriggerToken)
+
+	want.SetID(1)
+	want.SetRepo("github/octocat")
+	want.SetTokenID("4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29")
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetExpiresAt(1563477677)
+
+	// run test
+	got := testTriggerToken().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestTriggerToken_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		token   *TriggerToken
+	}{
+		{
+			failure: false,
+			token:   testTriggerToken(),
+		},
+		{ // no Repo set for TriggerToken
+			failure: true,
+			token: func() *TriggerToken {
+				tkn := testTriggerToken()
+				tkn.Repo = sql.NullString{}
+
+				return tkn
+			}(),
+		},
+		{ // no TokenID set for TriggerToken
+			failure: true,
+			token: func() *TriggerToken {
+				tkn := testTriggerToken()
+				tkn.TokenID = sql.NullString{}
+
+				return tkn
+			}(),
+		},
+		{ // no CreatedBy set for TriggerToken
+			failure: true,
+			token: func() *TriggerToken {
+				tkn := testTriggerToken()
+				tkn.CreatedBy = sql.NullString{}
+
+				return tkn
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.token.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestTriggerTokenFromAPI(t *testing.T) {
+	// setup types
+	tkn := new(api.TriggerToken)
+
+	tkn.SetID(1)
+	tkn.SetRepo("github/octocat")
+	tkn.SetTokenID("4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29")
+	tkn.SetCreatedAt(1563474077)
+	tkn.SetCreatedBy("octocat")
+	tkn.SetExpiresAt(1563477677)
+
+	want := testTriggerToken()
+
+	// run test
+	got := TriggerTokenFromAPI(tkn)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TriggerTokenFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testTriggerToken is a test helper function to create a TriggerToken
+// type with all fields set to a fake value.
+func testTriggerToken() *TriggerToken {
+	return &TriggerToken{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Repo:      sql.NullString{String: "github/octocat", Valid: true},
+		TokenID:   sql.NullString{String: "4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29", Valid: true},
+		CreatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy: sql.NullString{String: "octocat", Valid: true},
+		ExpiresAt: sql.NullInt64{Int64: 1563477677, Valid: true},
+	}
+}
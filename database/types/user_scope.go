@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+// ErrEmptyUserScopeUserID defines the error type when a
+// UserScope type has an empty UserID field provided.
+var ErrEmptyUserScopeUserID = errors.New("empty user scope user_id provided")
+
+// UserScope is the database representation of the OAuth
+// scopes granted to the scm token for a user.
+type UserScope struct {
+	ID        sql.NullInt64  `sql:"id"`
+	UserID    sql.NullInt64  `sql:"user_id"`
+	Scopes    pq.StringArray `sql:"scopes" gorm:"type:varchar(1000)"`
+	UpdatedAt sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the UserScope type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *UserScope) Nullify() *UserScope {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the UserID field should be false
+	if s.UserID.Int64 == 0 {
+		s.UserID.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if s.UpdatedAt.Int64 == 0 {
+		s.UpdatedAt.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the UserScope type
+// to an API UserScope type.
+func (s *UserScope) ToAPI() *api.UserScope {
+	scope := new(api.UserScope)
+
+	scope.SetID(s.ID.Int64)
+	scope.SetUserID(s.UserID.Int64)
+	scope.SetScopes(s.Scopes)
+	scope.SetUpdatedAt(s.UpdatedAt.Int64)
+
+	return scope
+}
+
+// Validate verifies the necessary fields for
+// the UserScope type are populated correctly.
+func (s *UserScope) Validate() error {
+	// verify the UserID field is populated
+	if s.UserID.Int64 <= 0 {
+		return ErrEmptyUserScopeUserID
+	}
+
+	return nil
+}
+
+// UserScopeFromAPI converts the API UserScope type
+// to a database UserScope type.
+func UserScopeFromAPI(s *api.UserScope) *UserScope {
+	scope := &UserScope{
+		ID:        sql.NullInt64{Int64: s.GetID(), Valid: true},
+		UserID:    sql.NullInt64{Int64: s.GetUserID(), Valid: true},
+		Scopes:    pq.StringArray(s.GetScopes()),
+		UpdatedAt: sql.NullInt64{Int64: s.GetUpdatedAt(), Valid: true},
+	}
+
+	return scope.Nullify()
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+func TestUserScope_Nullify(t *testing.T) {
+	// setup types
+	var s *UserScope
+
+	want := &UserScope{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		UserID:    sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedAt: sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *UserScope
+		want *UserScope
+	}{
+		{
+			item: testUserScope(),
+			want: testUserScope(),
+		},
+		{
+			item: s,
+			want: nil,
+		},
+		{
+			item: new(UserScope),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestUserScope_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.UserScope)
+
+	want.SetID(1)
+	want.SetUserID(1)
+	want.SetScopes([]string{"repo", "read:org"})
+	want.SetUpdatedAt(1563474077)
+
+	// run test
+	got := testUserScope().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestUserScope_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *UserScope
+	}{
+		{
+			failure: false,
+			item:    testUserScope(),
+		},
+		{ // no UserID set for UserScope
+			failure: true,
+			item: func() *UserScope {
+				s := testUserScope()
+				s.UserID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestUserScopeFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.UserScope)
+
+	s.SetID(1)
+	s.SetUserID(1)
+	s.SetScopes([]string{"repo", "read:org"})
+	s.SetUpdatedAt(1563474077)
+
+	want := testUserScope()
+
+	// run test
+	got := UserScopeFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UserScopeFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testUserScope is a test helper function to create a UserScope
+// type with all fields set to a fake value.
+func testUserScope() *UserScope {
+	return &UserScope{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		UserID:    sql.NullInt64{Int64: 1, Valid: true},
+		Scopes:    pq.StringArray{"repo", "read:org"},
+		UpdatedAt: sql.NullInt64{Int64: 1563474077, Valid: true},
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyUserSessionUserID defines the error type when a
+	// UserSession type has an empty UserID field provided.
+	ErrEmptyUserSessionUserID = errors.New("empty user session user_id provided")
+
+	// ErrInvalidUserSessionAction defines the error type when a
+	// UserSession type has an invalid Action field provided.
+	ErrInvalidUserSessionAction = errors.New("invalid user session action provided")
+)
+
+// UserSession is the database representation of a record of a
+// user logging in or refreshing their access token.
+type UserSession struct {
+	ID        sql.NullInt64  `sql:"id"`
+	UserID    sql.NullInt64  `sql:"user_id"`
+	Action    sql.NullString `sql:"action"`
+	IP        sql.NullString `sql:"ip"`
+	UserAgent sql.NullString `sql:"user_agent"`
+	Client    sql.NullString `sql:"client"`
+	Location  sql.NullString `sql:"location"`
+	Created   sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the UserSession type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (s *UserSession) Nullify() *UserSession {
+	if s == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if s.ID.Int64 == 0 {
+		s.ID.Valid = false
+	}
+
+	// check if the UserID field should be false
+	if s.UserID.Int64 == 0 {
+		s.UserID.Valid = false
+	}
+
+	// check if the Action field should be false
+	if len(s.Action.String) == 0 {
+		s.Action.Valid = false
+	}
+
+	// check if the IP field should be false
+	if len(s.IP.String) == 0 {
+		s.IP.Valid = false
+	}
+
+	// check if the UserAgent field should be false
+	if len(s.UserAgent.String) == 0 {
+		s.UserAgent.Valid = false
+	}
+
+	// check if the Client field should be false
+	if len(s.Client.String) == 0 {
+		s.Client.Valid = false
+	}
+
+	// check if the Location field should be false
+	if len(s.Location.String) == 0 {
+		s.Location.Valid = false
+	}
+
+	// check if the Created field should be false
+	if s.Created.Int64 == 0 {
+		s.Created.Valid = false
+	}
+
+	return s
+}
+
+// ToAPI converts the UserSession type
+// to an API UserSession type.
+func (s *UserSession) ToAPI() *api.UserSession {
+	session := new(api.UserSession)
+
+	session.SetID(s.ID.Int64)
+	session.SetUserID(s.UserID.Int64)
+	session.SetAction(s.Action.String)
+	session.SetIP(s.IP.String)
+	session.SetUserAgent(s.UserAgent.String)
+	session.SetClient(s.Client.String)
+	session.SetLocation(s.Location.String)
+	session.SetCreated(s.Created.Int64)
+
+	return session
+}
+
+// Validate verifies the necessary fields for
+// the UserSession type are populated correctly.
+func (s *UserSession) Validate() error {
+	// verify the UserID field is populated
+	if s.UserID.Int64 <= 0 {
+		return ErrEmptyUserSessionUserID
+	}
+
+	// verify the Action field is valid
+	if s.Action.String != api.UserSessionLogin && s.Action.String != api.UserSessionRefresh {
+		return ErrInvalidUserSessionAction
+	}
+
+	return nil
+}
+
+// UserSessionFromAPI converts the API UserSession type
+// to a database UserSession type.
+func UserSessionFromAPI(s *api.UserSession) *UserSession {
+	session := &UserSession{
+		ID:        sql.NullInt64{Int64: s.GetID(), Valid: true},
+		UserID:    sql.NullInt64{Int64: s.GetUserID(), Valid: true},
+		Action:    sql.NullString{String: s.GetAction(), Valid: true},
+		IP:        sql.NullString{String: s.GetIP(), Valid: true},
+		UserAgent: sql.NullString{String: s.GetUserAgent(), Valid: true},
+		Client:    sql.NullString{String: s.GetClient(), Valid: true},
+		Location:  sql.NullString{String: s.GetLocation(), Valid: true},
+		Created:   sql.NullInt64{Int64: s.GetCreated(), Valid: true},
+	}
+
+	return session.Nullify()
+}
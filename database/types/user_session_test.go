@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestUserSession_Nullify(t *testing.T) {
+	// setup types
+	var s *UserSession
+
+	want := &UserSession{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		UserID:    sql.NullInt64{Int64: 0, Valid: false},
+		Action:    sql.NullString{String: "", Valid: false},
+		IP:        sql.NullString{String: "", Valid: false},
+		UserAgent: sql.NullString{String: "", Valid: false},
+		Client:    sql.NullString{String: "", Valid: false},
+		Location:  sql.NullString{String: "", Valid: false},
+		Created:   sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *UserSession
+		want *UserSession
+	}{
+		{
+			item: testUserSession(),
+			want: testUserSession(),
+		},
+		{
+			item: s,
+			want: nil,
+		},
+		{
+			item: new(UserSession),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestUserSession_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.UserSession)
+
+	want.SetID(1)
+	want.SetUserID(1)
+	want.SetAction("login")
+	want.SetIP("1.2.3.4")
+	want.SetUserAgent("vela-cli")
+	want.SetClient("Vela CLI")
+	want.SetLocation("Minneapolis, Minnesota, US")
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testUserSession().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestUserSession_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *UserSession
+	}{
+		{
+			failure: false,
+			item:    testUserSession(),
+		},
+		{ // no UserID set for UserSession
+			failure: true,
+			item: func() *UserSession {
+				s := testUserSession()
+				s.UserID = sql.NullInt64{}
+
+				return s
+			}(),
+		},
+		{ // invalid Action set for UserSession
+			failure: true,
+			item: func() *UserSession {
+				s := testUserSession()
+				s.Action = sql.NullString{String: "logout", Valid: true}
+
+				return s
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestUserSessionFromAPI(t *testing.T) {
+	// setup types
+	s := new(api.UserSession)
+
+	s.SetID(1)
+	s.SetUserID(1)
+	s.SetAction("login")
+	s.SetIP("1.2.3.4")
+	s.SetUserAgent("vela-cli")
+	s.SetClient("Vela CLI")
+	s.SetLocation("Minneapolis, Minnesota, US")
+	s.SetCreated(1563474076)
+
+	want := testUserSession()
+
+	// run test
+	got := UserSessionFromAPI(s)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UserSessionFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testUserSession is a test helper function to create a UserSession
+// type with all fields set to a fake value.
+func testUserSession() *UserSession {
+	return &UserSession{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		UserID:    sql.NullInt64{Int64: 1, Valid: true},
+		Action:    sql.NullString{String: "login", Valid: true},
+		IP:        sql.NullString{String: "1.2.3.4", Valid: true},
+		UserAgent: sql.NullString{String: "vela-cli", Valid: true},
+		Client:    sql.NullString{String: "Vela CLI", Valid: true},
+		Location:  sql.NullString{String: "Minneapolis, Minnesota, US", Valid: true},
+		Created:   sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
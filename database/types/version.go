@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import "errors"
+
+// ErrVersionConflict defines the error type when a conditional
+// update is rejected because the version of the resource in the
+// database no longer matches the version that was provided.
+var ErrVersionConflict = errors.New("resource was modified by another update")
@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyWebhookDeliverySource defines the error type when a
+	// WebhookDelivery type has an empty Source field provided.
+	ErrEmptyWebhookDeliverySource = errors.New("empty webhook delivery source provided")
+
+	// ErrEmptyWebhookDeliveryDeliveryID defines the error type when a
+	// WebhookDelivery type has an empty DeliveryID field provided.
+	ErrEmptyWebhookDeliveryDeliveryID = errors.New("empty webhook delivery delivery_id provided")
+)
+
+// WebhookDelivery is the database representation of a webhook
+// delivered by the scm, recorded to reject replayed deliveries.
+type WebhookDelivery struct {
+	ID         sql.NullInt64  `sql:"id"`
+	Source     sql.NullString `sql:"source"`
+	DeliveryID sql.NullString `sql:"delivery_id"`
+	RepoID     sql.NullInt64  `sql:"repo_id"`
+	Created    sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the WebhookDelivery type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (d *WebhookDelivery) Nullify() *WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if d.ID.Int64 == 0 {
+		d.ID.Valid = false
+	}
+
+	// check if the Source field should be false
+	if len(d.Source.String) == 0 {
+		d.Source.Valid = false
+	}
+
+	// check if the DeliveryID field should be false
+	if len(d.DeliveryID.String) == 0 {
+		d.DeliveryID.Valid = false
+	}
+
+	// check if the RepoID field should be false
+	if d.RepoID.Int64 == 0 {
+		d.RepoID.Valid = false
+	}
+
+	// check if the Created field should be false
+	if d.Created.Int64 == 0 {
+		d.Created.Valid = false
+	}
+
+	return d
+}
+
+// ToAPI converts the WebhookDelivery type
+// to an API WebhookDelivery type.
+func (d *WebhookDelivery) ToAPI() *api.WebhookDelivery {
+	delivery := new(api.WebhookDelivery)
+
+	delivery.SetID(d.ID.Int64)
+	delivery.SetSource(d.Source.String)
+	delivery.SetDeliveryID(d.DeliveryID.String)
+	delivery.SetRepoID(d.RepoID.Int64)
+	delivery.SetCreated(d.Created.Int64)
+
+	return delivery
+}
+
+// Validate verifies the necessary fields for
+// the WebhookDelivery type are populated correctly.
+func (d *WebhookDelivery) Validate() error {
+	// verify the Source field is populated
+	if len(d.Source.String) == 0 {
+		return ErrEmptyWebhookDeliverySource
+	}
+
+	// verify the DeliveryID field is populated
+	if len(d.DeliveryID.String) == 0 {
+		return ErrEmptyWebhookDeliveryDeliveryID
+	}
+
+	return nil
+}
+
+// WebhookDeliveryFromAPI converts the API WebhookDelivery type
+// to a database WebhookDelivery type.
+func WebhookDeliveryFromAPI(d *api.WebhookDelivery) *WebhookDelivery {
+	delivery := &WebhookDelivery{
+		ID:         sql.NullInt64{Int64: d.GetID(), Valid: true},
+		Source:     sql.NullString{String: d.GetSource(), Valid: true},
+		DeliveryID: sql.NullString{String: d.GetDeliveryID(), Valid: true},
+		RepoID:     sql.NullInt64{Int64: d.GetRepoID(), Valid: true},
+		Created:    sql.NullInt64{Int64: d.GetCreated(), Valid: true},
+	}
+
+	return delivery.Nullify()
+}
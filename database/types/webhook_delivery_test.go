@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWebhookDelivery_Nullify(t *testing.T) {
+	// setup types
+	var d *WebhookDelivery
+
+	want := &WebhookDelivery{
+		ID:         sql.NullInt64{Int64: 0, Valid: false},
+		Source:     sql.NullString{String: "", Valid: false},
+		DeliveryID: sql.NullString{String: "", Valid: false},
+		RepoID:     sql.NullInt64{Int64: 0, Valid: false},
+		Created:    sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *WebhookDelivery
+		want *WebhookDelivery
+	}{
+		{
+			item: testWebhookDelivery(),
+			want: testWebhookDelivery(),
+		},
+		{
+			item: d,
+			want: nil,
+		},
+		{
+			item: new(WebhookDelivery),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestWebhookDelivery_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.WebhookDelivery)
+
+	want.SetID(1)
+	want.SetSource("github")
+	want.SetDeliveryID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	want.SetRepoID(1)
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testWebhookDelivery().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestWebhookDelivery_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *WebhookDelivery
+	}{
+		{
+			failure: false,
+			item:    testWebhookDelivery(),
+		},
+		{ // no Source set for WebhookDelivery
+			failure: true,
+			item: func() *WebhookDelivery {
+				d := testWebhookDelivery()
+				d.Source = sql.NullString{}
+
+				return d
+			}(),
+		},
+		{ // no DeliveryID set for WebhookDelivery
+			failure: true,
+			item: func() *WebhookDelivery {
+				d := testWebhookDelivery()
+				d.DeliveryID = sql.NullString{}
+
+				return d
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestWebhookDeliveryFromAPI(t *testing.T) {
+	// setup types
+	d := new(api.WebhookDelivery)
+
+	d.SetID(1)
+	d.SetSource("github")
+	d.SetDeliveryID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	d.SetRepoID(1)
+	d.SetCreated(1563474076)
+
+	want := testWebhookDelivery()
+
+	// run test
+	got := WebhookDeliveryFromAPI(d)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WebhookDeliveryFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testWebhookDelivery is a test helper function to create a WebhookDelivery
+// type with all fields set to a fake value.
+func testWebhookDelivery() *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:         sql.NullInt64{Int64: 1, Valid: true},
+		Source:     sql.NullString{String: "github", Valid: true},
+		DeliveryID: sql.NullString{String: "7bd477e4-4415-11e9-9359-0d41fdf9567e", Valid: true},
+		RepoID:     sql.NullInt64{Int64: 1, Valid: true},
+		Created:    sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+var (
+	// ErrEmptyWebhookIntakeHeader defines the error type when a
+	// WebhookIntake type has an empty Header field provided.
+	ErrEmptyWebhookIntakeHeader = errors.New("empty webhook intake header provided")
+
+	// ErrEmptyWebhookIntakePayload defines the error type when a
+	// WebhookIntake type has an empty Payload field provided.
+	ErrEmptyWebhookIntakePayload = errors.New("empty webhook intake payload provided")
+
+	// ErrInvalidWebhookIntakeStatus defines the error type when a
+	// WebhookIntake type has an invalid Status field provided.
+	ErrInvalidWebhookIntakeStatus = errors.New("invalid webhook intake status provided")
+)
+
+// WebhookIntake is the database representation of a webhook received
+// from the scm that is buffered in the intake to be processed asynchronously.
+type WebhookIntake struct {
+	ID        sql.NullInt64  `sql:"id"`
+	Source    sql.NullString `sql:"source"`
+	Header    sql.NullString `sql:"header"`
+	Payload   sql.NullString `sql:"payload"`
+	Status    sql.NullString `sql:"status"`
+	Attempts  sql.NullInt64  `sql:"attempts"`
+	Error     sql.NullString `sql:"error"`
+	Available sql.NullInt64  `sql:"available"`
+	Created   sql.NullInt64  `sql:"created"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the WebhookIntake type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (w *WebhookIntake) Nullify() *WebhookIntake {
+	if w == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if w.ID.Int64 == 0 {
+		w.ID.Valid = false
+	}
+
+	// check if the Source field should be false
+	if len(w.Source.String) == 0 {
+		w.Source.Valid = false
+	}
+
+	// check if the Header field should be false
+	if len(w.Header.String) == 0 {
+		w.Header.Valid = false
+	}
+
+	// check if the Payload field should be false
+	if len(w.Payload.String) == 0 {
+		w.Payload.Valid = false
+	}
+
+	// check if the Status field should be false
+	if len(w.Status.String) == 0 {
+		w.Status.Valid = false
+	}
+
+	// check if the Attempts field should be false
+	if w.Attempts.Int64 == 0 {
+		w.Attempts.Valid = false
+	}
+
+	// check if the Error field should be false
+	if len(w.Error.String) == 0 {
+		w.Error.Valid = false
+	}
+
+	// check if the Available field should be false
+	if w.Available.Int64 == 0 {
+		w.Available.Valid = false
+	}
+
+	// check if the Created field should be false
+	if w.Created.Int64 == 0 {
+		w.Created.Valid = false
+	}
+
+	return w
+}
+
+// ToAPI converts the WebhookIntake type
+// to an API WebhookIntake type.
+func (w *WebhookIntake) ToAPI() *api.WebhookIntake {
+	intake := new(api.WebhookIntake)
+
+	intake.SetID(w.ID.Int64)
+	intake.SetSource(w.Source.String)
+	intake.SetHeader(w.Header.String)
+	intake.SetPayload(w.Payload.String)
+	intake.SetStatus(w.Status.String)
+	intake.SetAttempts(w.Attempts.Int64)
+	intake.SetError(w.Error.String)
+	intake.SetAvailable(w.Available.Int64)
+	intake.SetCreated(w.Created.Int64)
+
+	return intake
+}
+
+// Validate verifies the necessary fields for
+// the WebhookIntake type are populated correctly.
+func (w *WebhookIntake) Validate() error {
+	// verify the Header field is populated
+	if len(w.Header.String) == 0 {
+		return ErrEmptyWebhookIntakeHeader
+	}
+
+	// verify the Payload field is populated
+	if len(w.Payload.String) == 0 {
+		return ErrEmptyWebhookIntakePayload
+	}
+
+	// verify the Status field is a valid status
+	switch w.Status.String {
+	case api.WebhookIntakePending, api.WebhookIntakeFailed:
+	default:
+		return ErrInvalidWebhookIntakeStatus
+	}
+
+	return nil
+}
+
+// WebhookIntakeFromAPI converts the API WebhookIntake type
+// to a database WebhookIntake type.
+func WebhookIntakeFromAPI(w *api.WebhookIntake) *WebhookIntake {
+	intake := &WebhookIntake{
+		ID:        sql.NullInt64{Int64: w.GetID(), Valid: true},
+		Source:    sql.NullString{String: w.GetSource(), Valid: true},
+		Header:    sql.NullString{String: w.GetHeader(), Valid: true},
+		Payload:   sql.NullString{String: w.GetPayload(), Valid: true},
+		Status:    sql.NullString{String: w.GetStatus(), Valid: true},
+		Attempts:  sql.NullInt64{Int64: w.GetAttempts(), Valid: true},
+		Error:     sql.NullString{String: w.GetError(), Valid: true},
+		Available: sql.NullInt64{Int64: w.GetAvailable(), Valid: true},
+		Created:   sql.NullInt64{Int64: w.GetCreated(), Valid: true},
+	}
+
+	return intake.Nullify()
+}
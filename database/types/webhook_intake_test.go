@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWebhookIntake_Nullify(t *testing.T) {
+	// setup types
+	var w *WebhookIntake
+
+	want := &WebhookIntake{
+		ID:        sql.NullInt64{Int64: 0, Valid: false},
+		Source:    sql.NullString{String: "", Valid: false},
+		Header:    sql.NullString{String: "", Valid: false},
+		Payload:   sql.NullString{String: "", Valid: false},
+		Status:    sql.NullString{String: "", Valid: false},
+		Attempts:  sql.NullInt64{Int64: 0, Valid: false},
+		Error:     sql.NullString{String: "", Valid: false},
+		Available: sql.NullInt64{Int64: 0, Valid: false},
+		Created:   sql.NullInt64{Int64: 0, Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *WebhookIntake
+		want *WebhookIntake
+	}{
+		{
+			item: testWebhookIntake(),
+			want: testWebhookIntake(),
+		},
+		{
+			item: w,
+			want: nil,
+		},
+		{
+			item: new(WebhookIntake),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestWebhookIntake_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.WebhookIntake)
+
+	want.SetID(1)
+	want.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	want.SetHeader(`{"X-Github-Event":["push"]}`)
+	want.SetPayload(`{"ref":"refs/heads/main"}`)
+	want.SetStatus(api.WebhookIntakePending)
+	want.SetAttempts(1)
+	want.SetError("received status 500")
+	want.SetAvailable(1563474086)
+	want.SetCreated(1563474076)
+
+	// run test
+	got := testWebhookIntake().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestWebhookIntake_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *WebhookIntake
+	}{
+		{
+			failure: false,
+			item:    testWebhookIntake(),
+		},
+		{ // no Header set for WebhookIntake
+			failure: true,
+			item: func() *WebhookIntake {
+				w := testWebhookIntake()
+				w.Header = sql.NullString{}
+
+				return w
+			}(),
+		},
+		{ // no Payload set for WebhookIntake
+			failure: true,
+			item: func() *WebhookIntake {
+				w := testWebhookIntake()
+				w.Payload = sql.NullString{}
+
+				return w
+			}(),
+		},
+		{ // invalid Status set for WebhookIntake
+			failure: true,
+			item: func() *WebhookIntake {
+				w := testWebhookIntake()
+				w.Status = sql.NullString{String: "processing", Valid: true}
+
+				return w
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestWebhookIntakeFromAPI(t *testing.T) {
+	// setup types
+	w := new(api.WebhookIntake)
+
+	w.SetID(1)
+	w.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	w.SetHeader(`{"X-Github-Event":["push"]}`)
+	w.SetPayload(`{"ref":"refs/heads/main"}`)
+	w.SetStatus(api.WebhookIntakePending)
+	w.SetAttempts(1)
+	w.SetError("received status 500")
+	w.SetAvailable(1563474086)
+	w.SetCreated(1563474076)
+
+	want := testWebhookIntake()
+
+	// run test
+	got := WebhookIntakeFromAPI(w)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WebhookIntakeFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testWebhookIntake is a test helper function to create a WebhookIntake
+// type with all fields set to a fake value.
+func testWebhookIntake() *WebhookIntake {
+	return &WebhookIntake{
+		ID:        sql.NullInt64{Int64: 1, Valid: true},
+		Source:    sql.NullString{String: "c8da1302-07d6-11ea-882f-4893bca275b8", Valid: true},
+		Header:    sql.NullString{String: `{"X-Github-Event":["push"]}`, Valid: true},
+		Payload:   sql.NullString{String: `{"ref":"refs/heads/main"}`, Valid: true},
+		Status:    sql.NullString{String: api.WebhookIntakePending, Valid: true},
+		Attempts:  sql.NullInt64{Int64: 1, Valid: true},
+		Error:     sql.NullString{String: "received status 500", Valid: true},
+		Available: sql.NullInt64{Int64: 1563474086, Valid: true},
+		Created:   sql.NullInt64{Int64: 1563474076, Valid: true},
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"errors"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/lib/pq"
+)
+
+var (
+	// ErrEmptyWorkerGroupName defines the error type when a
+	// WorkerGroup type has an empty Name field provided.
+	ErrEmptyWorkerGroupName = errors.New("empty worker group name provided")
+
+	// ErrEmptyWorkerGroupRoute defines the error type when a
+	// WorkerGroup type has an empty Route field provided.
+	ErrEmptyWorkerGroupRoute = errors.New("empty worker group route provided")
+)
+
+// WorkerGroup is the database representation of a pool of workers dedicated
+// to the builds for a set of orgs and repos.
+type WorkerGroup struct {
+	ID          sql.NullInt64  `sql:"id"`
+	Name        sql.NullString `sql:"name"`
+	Description sql.NullString `sql:"description"`
+	Route       sql.NullString `sql:"route"`
+	BuildLimit  sql.NullInt64  `sql:"build_limit"`
+	Orgs        pq.StringArray `sql:"orgs" gorm:"type:varchar(1000)"`
+	Repos       pq.StringArray `sql:"repos" gorm:"type:varchar(1000)"`
+	Active      sql.NullBool   `sql:"active"`
+	CreatedAt   sql.NullInt64  `sql:"created_at" gorm:"autoCreateTime:false"`
+	CreatedBy   sql.NullString `sql:"created_by"`
+	UpdatedAt   sql.NullInt64  `sql:"updated_at" gorm:"autoUpdateTime:false"`
+	UpdatedBy   sql.NullString `sql:"updated_by"`
+}
+
+// Nullify ensures the valid flag for
+// the sql.Null types are properly set.
+//
+// When a field within the WorkerGroup type is the zero
+// value for the field, the valid flag is set to
+// false causing it to be NULL in the database.
+func (g *WorkerGroup) Nullify() *WorkerGroup {
+	if g == nil {
+		return nil
+	}
+
+	// check if the ID field should be false
+	if g.ID.Int64 == 0 {
+		g.ID.Valid = false
+	}
+
+	// check if the Name field should be false
+	if len(g.Name.String) == 0 {
+		g.Name.Valid = false
+	}
+
+	// check if the Description field should be false
+	if len(g.Description.String) == 0 {
+		g.Description.Valid = false
+	}
+
+	// check if the Route field should be false
+	if len(g.Route.String) == 0 {
+		g.Route.Valid = false
+	}
+
+	// check if the BuildLimit field should be false
+	if g.BuildLimit.Int64 == 0 {
+		g.BuildLimit.Valid = false
+	}
+
+	// check if the CreatedAt field should be false
+	if g.CreatedAt.Int64 == 0 {
+		g.CreatedAt.Valid = false
+	}
+
+	// check if the CreatedBy field should be false
+	if len(g.CreatedBy.String) == 0 {
+		g.CreatedBy.Valid = false
+	}
+
+	// check if the UpdatedAt field should be false
+	if g.UpdatedAt.Int64 == 0 {
+		g.UpdatedAt.Valid = false
+	}
+
+	// check if the UpdatedBy field should be false
+	if len(g.UpdatedBy.String) == 0 {
+		g.UpdatedBy.Valid = false
+	}
+
+	return g
+}
+
+// ToAPI converts the WorkerGroup type
+// to an API WorkerGroup type.
+func (g *WorkerGroup) ToAPI() *api.WorkerGroup {
+	workerGroup := new(api.WorkerGroup)
+
+	workerGroup.SetID(g.ID.Int64)
+	workerGroup.SetName(g.Name.String)
+	workerGroup.SetDescription(g.Description.String)
+	workerGroup.SetRoute(g.Route.String)
+	workerGroup.SetBuildLimit(g.BuildLimit.Int64)
+	workerGroup.SetOrgs(g.Orgs)
+	workerGroup.SetRepos(g.Repos)
+	workerGroup.SetActive(g.Active.Bool)
+	workerGroup.SetCreatedAt(g.CreatedAt.Int64)
+	workerGroup.SetCreatedBy(g.CreatedBy.String)
+	workerGroup.SetUpdatedAt(g.UpdatedAt.Int64)
+	workerGroup.SetUpdatedBy(g.UpdatedBy.String)
+
+	return workerGroup
+}
+
+// Validate verifies the necessary fields for
+// the WorkerGroup type are populated correctly.
+func (g *WorkerGroup) Validate() error {
+	// verify the Name field is populated
+	if len(g.Name.String) == 0 {
+		return ErrEmptyWorkerGroupName
+	}
+
+	// verify the Route field is populated
+	if len(g.Route.String) == 0 {
+		return ErrEmptyWorkerGroupRoute
+	}
+
+	// ensure that all WorkerGroup string fields
+	// that can be returned as JSON are sanitized
+	// to avoid unsafe HTML content
+	g.Description = sql.NullString{String: sanitize(g.Description.String), Valid: g.Description.Valid}
+
+	return nil
+}
+
+// WorkerGroupFromAPI converts the API WorkerGroup type
+// to a database WorkerGroup type.
+func WorkerGroupFromAPI(g *api.WorkerGroup) *WorkerGroup {
+	workerGroup := &WorkerGroup{
+		ID:          sql.NullInt64{Int64: g.GetID(), Valid: true},
+		Name:        sql.NullString{String: g.GetName(), Valid: true},
+		Description: sql.NullString{String: g.GetDescription(), Valid: true},
+		Route:       sql.NullString{String: g.GetRoute(), Valid: true},
+		BuildLimit:  sql.NullInt64{Int64: g.GetBuildLimit(), Valid: true},
+		Orgs:        pq.StringArray(g.GetOrgs()),
+		Repos:       pq.StringArray(g.GetRepos()),
+		Active:      sql.NullBool{Bool: g.GetActive(), Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: g.GetCreatedAt(), Valid: true},
+		CreatedBy:   sql.NullString{String: g.GetCreatedBy(), Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: g.GetUpdatedAt(), Valid: true},
+		UpdatedBy:   sql.NullString{String: g.GetUpdatedBy(), Valid: true},
+	}
+
+	return workerGroup.Nullify()
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package types
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWorkerGroup_Nullify(t *testing.T) {
+	// setup types
+	var g *WorkerGroup
+
+	want := &WorkerGroup{
+		ID:          sql.NullInt64{Int64: 0, Valid: false},
+		Name:        sql.NullString{String: "", Valid: false},
+		Description: sql.NullString{String: "", Valid: false},
+		Route:       sql.NullString{String: "", Valid: false},
+		BuildLimit:  sql.NullInt64{Int64: 0, Valid: false},
+		CreatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		CreatedBy:   sql.NullString{String: "", Valid: false},
+		UpdatedAt:   sql.NullInt64{Int64: 0, Valid: false},
+		UpdatedBy:   sql.NullString{String: "", Valid: false},
+	}
+
+	// setup tests
+	tests := []struct {
+		item *WorkerGroup
+		want *WorkerGroup
+	}{
+		{
+			item: testWorkerGroup(),
+			want: testWorkerGroup(),
+		},
+		{
+			item: g,
+			want: nil,
+		},
+		{
+			item: new(WorkerGroup),
+			want: want,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := test.item.Nullify()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Nullify is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestWorkerGroup_ToAPI(t *testing.T) {
+	// setup types
+	want := new(api.WorkerGroup)
+
+	want.SetID(1)
+	want.SetName("compliance")
+	want.SetDescription("builds subject to compliance review")
+	want.SetRoute("compliance")
+	want.SetBuildLimit(10)
+	want.SetOrgs([]string{"github"})
+	want.SetRepos([]string{"octocat/hello-world"})
+	want.SetActive(true)
+	want.SetCreatedAt(1563474077)
+	want.SetCreatedBy("octocat")
+	want.SetUpdatedAt(1563474078)
+	want.SetUpdatedBy("octocat")
+
+	// run test
+	got := testWorkerGroup().ToAPI()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToAPI is %v, want %v", got, want)
+	}
+}
+
+func TestWorkerGroup_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		item    *WorkerGroup
+	}{
+		{
+			failure: false,
+			item:    testWorkerGroup(),
+		},
+		{ // no Name set for WorkerGroup
+			failure: true,
+			item: func() *WorkerGroup {
+				g := testWorkerGroup()
+				g.Name = sql.NullString{}
+
+				return g
+			}(),
+		},
+		{ // no Route set for WorkerGroup
+			failure: true,
+			item: func() *WorkerGroup {
+				g := testWorkerGroup()
+				g.Route = sql.NullString{}
+
+				return g
+			}(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.item.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
+
+func TestWorkerGroupFromAPI(t *testing.T) {
+	// setup types
+	g := new(api.WorkerGroup)
+
+	g.SetID(1)
+	g.SetName("compliance")
+	g.SetDescription("builds subject to compliance review")
+	g.SetRoute("compliance")
+	g.SetBuildLimit(10)
+	g.SetOrgs([]string{"github"})
+	g.SetRepos([]string{"octocat/hello-world"})
+	g.SetActive(true)
+	g.SetCreatedAt(1563474077)
+	g.SetCreatedBy("octocat")
+	g.SetUpdatedAt(1563474078)
+	g.SetUpdatedBy("octocat")
+
+	want := testWorkerGroup()
+
+	// run test
+	got := WorkerGroupFromAPI(g)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WorkerGroupFromAPI is %v, want %v", got, want)
+	}
+}
+
+// testWorkerGroup is a test helper function to create a WorkerGroup
+// type with all fields set to a fake value.
+func testWorkerGroup() *WorkerGroup {
+	return &WorkerGroup{
+		ID:          sql.NullInt64{Int64: 1, Valid: true},
+		Name:        sql.NullString{String: "compliance", Valid: true},
+		Description: sql.NullString{String: "builds subject to compliance review", Valid: true},
+		Route:       sql.NullString{String: "compliance", Valid: true},
+		BuildLimit:  sql.NullInt64{Int64: 10, Valid: true},
+		Orgs:        []string{"github"},
+		Repos:       []string{"octocat/hello-world"},
+		Active:      sql.NullBool{Bool: true, Valid: true},
+		CreatedAt:   sql.NullInt64{Int64: 1563474077, Valid: true},
+		CreatedBy:   sql.NullString{String: "octocat", Valid: true},
+		UpdatedAt:   sql.NullInt64{Int64: 1563474078, Valid: true},
+		UpdatedBy:   sql.NullString{String: "octocat", Valid: true},
+	}
+}
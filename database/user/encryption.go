@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package user
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-vela/types/database"
+)
+
+// decrypt is a helper function to decrypt the fields for the user.
+//
+// When an envelope is configured, the fields can be sealed by the
+// envelope or encrypted with the static encryption key. Otherwise,
+// the fields are decrypted with the static encryption key.
+func (e *engine) decrypt(u *database.User) error {
+	// check if an envelope is configured for the engine
+	if e.config.Envelope == nil {
+		// https://pkg.go.dev/github.com/go-vela/types/database#User.Decrypt
+		return u.Decrypt(e.config.EncryptionKey)
+	}
+
+	for _, field := range fields(u) {
+		value, err := e.config.Envelope.Open(context.Background(), field.String)
+		if err != nil {
+			return err
+		}
+
+		*field = sql.NullString{
+			String: string(value),
+			Valid:  true,
+		}
+	}
+
+	return nil
+}
+
+// encrypt is a helper function to encrypt the fields for the user.
+//
+// When an envelope is configured, the fields are sealed by the
+// envelope. Otherwise, the fields are encrypted with the static
+// encryption key.
+func (e *engine) encrypt(u *database.User) error {
+	// check if an envelope is configured for the engine
+	if e.config.Envelope == nil {
+		// https://pkg.go.dev/github.com/go-vela/types/database#User.Encrypt
+		return u.Encrypt(e.config.EncryptionKey)
+	}
+
+	for _, field := range fields(u) {
+		value, err := e.config.Envelope.Seal(context.Background(), []byte(field.String))
+		if err != nil {
+			return err
+		}
+
+		*field = sql.NullString{
+			String: value,
+			Valid:  true,
+		}
+	}
+
+	return nil
+}
+
+// current is a helper function to determine if every field for
+// the user is sealed with the current key encryption key.
+func (e *engine) current(u *database.User) bool {
+	for _, field := range fields(u) {
+		if !e.config.Envelope.Current(field.String) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fields is a helper function to capture the encrypted fields for the user.
+func fields(u *database.User) []*sql.NullString {
+	return []*sql.NullString{&u.Hash, &u.Token, &u.RefreshToken}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package user
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-vela/server/kms"
+	"github.com/go-vela/types/database"
+)
+
+func TestUser_Engine_encrypt(t *testing.T) {
+	// setup types
+	_envelope := testEnvelope(t)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		envelope *kms.Envelope
+		sealed   bool
+	}{
+		{
+			name:     "static key",
+			envelope: nil,
+			sealed:   false,
+		},
+		{
+			name:     "envelope",
+			envelope: _envelope,
+			sealed:   true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &engine{config: &config{
+				EncryptionKey: "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+				Envelope:      test.envelope,
+			}}
+
+			want := testDatabaseUser()
+			u := testDatabaseUser()
+
+			err := e.encrypt(u)
+			if err != nil {
+				t.Errorf("encrypt for %s returned err: %v", test.name, err)
+			}
+
+			for _, field := range fields(u) {
+				if strings.HasPrefix(field.String, "vela:") != test.sealed {
+					t.Errorf("encrypt for %s is %v, want sealed %t", test.name, field.String, test.sealed)
+				}
+			}
+
+			// the envelope can open values encrypted with the static key
+			e.config.Envelope = _envelope
+
+			err = e.decrypt(u)
+			if err != nil {
+				t.Errorf("decrypt for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(u, want) {
+				t.Errorf("decrypt for %s is %v, want %v", test.name, u, want)
+			}
+		})
+	}
+}
+
+// testEnvelope is a helper function to create an envelope for testing.
+func testEnvelope(t *testing.T) *kms.Envelope {
+	_kms, err := kms.New(&kms.Setup{
+		Driver:   "local",
+		LocalKey: "Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE",
+	})
+	if err != nil {
+		t.Errorf("unable to create new kms service: %v", err)
+	}
+
+	return kms.NewEnvelope(_kms, "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW")
+}
+
+// testDatabaseUser is a helper function to create a
+// database User type with the encrypted fields set.
+func testDatabaseUser() *database.User {
+	return &database.User{
+		ID:           sql.NullInt64{Int64: 1, Valid: true},
+		Name:         sql.NullString{String: "foo", Valid: true},
+		RefreshToken: sql.NullString{String: "bar", Valid: true},
+		Token:        sql.NullString{String: "baz", Valid: true},
+		Hash:         sql.NullString{String: "qux", Valid: true},
+	}
+}
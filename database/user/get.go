@@ -5,20 +5,22 @@
 package user
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // GetUser gets a user by ID from the database.
-func (e *engine) GetUser(id int64) (*library.User, error) {
+func (e *engine) GetUser(ctx context.Context, id int64) (*library.User, error) {
 	e.logger.Tracef("getting user %d from the database", id)
 
 	// variable to store query results
 	u := new(database.User)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableUser).
 		Where("id = ?", id).
 		Take(u).
@@ -28,9 +30,7 @@ func (e *engine) GetUser(id int64) (*library.User, error) {
 	}
 
 	// decrypt the fields for the user
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#User.Decrypt
-	err = u.Decrypt(e.config.EncryptionKey)
+	err = e.decrypt(u)
 	if err != nil {
 		// TODO: remove backwards compatibility before 1.x.x release
 		//
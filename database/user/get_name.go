@@ -5,6 +5,8 @@
 package user
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -12,7 +14,7 @@ import (
 )
 
 // GetUserForName gets a user by name from the database.
-func (e *engine) GetUserForName(name string) (*library.User, error) {
+func (e *engine) GetUserForName(ctx context.Context, name string) (*library.User, error) {
 	e.logger.WithFields(logrus.Fields{
 		"user": name,
 	}).Tracef("getting user %s from the database", name)
@@ -21,7 +23,7 @@ func (e *engine) GetUserForName(name string) (*library.User, error) {
 	u := new(database.User)
 
 	// send query to the database and store result in variable
-	err := e.client.
+	err := e.client.WithContext(ctx).
 		Table(constants.TableUser).
 		Where("name = ?", name).
 		Take(u).
@@ -31,9 +33,7 @@ func (e *engine) GetUserForName(name string) (*library.User, error) {
 	}
 
 	// decrypt the fields for the user
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#User.Decrypt
-	err = u.Decrypt(e.config.EncryptionKey)
+	err = e.decrypt(u)
 	if err != nil {
 		// TODO: remove backwards compatibility before 1.x.x release
 		//
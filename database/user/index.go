@@ -4,6 +4,12 @@
 
 package user
 
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
 const (
 	// CreateUserRefreshIndex represents a query to create an
 	// index on the users table for the refresh_token column.
@@ -16,9 +22,14 @@ ON users (refresh_token);
 )
 
 // CreateUserIndexes creates the indexes for the users table in the database.
-func (e *engine) CreateUserIndexes() error {
+func (e *engine) CreateUserIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for users table in the database")
 
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
 	// create the refresh_token column index for the users table
-	return e.client.Exec(CreateUserRefreshIndex).Error
+	return e.client.WithContext(ctx).Exec(CreateUserRefreshIndex).Error
 }
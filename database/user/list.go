@@ -5,13 +5,15 @@
 package user
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // ListUsers gets a list of all users from the database.
-func (e *engine) ListUsers() ([]*library.User, error) {
+func (e *engine) ListUsers(ctx context.Context) ([]*library.User, error) {
 	e.logger.Trace("listing all users from the database")
 
 	// variables to store query results and return value
@@ -20,7 +22,7 @@ func (e *engine) ListUsers() ([]*library.User, error) {
 	users := []*library.User{}
 
 	// count the results
-	count, err := e.CountUsers()
+	count, err := e.CountUsers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +33,7 @@ func (e *engine) ListUsers() ([]*library.User, error) {
 	}
 
 	// send query to the database and store result in variable
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TableUser).
 		Find(&u).
 		Error
@@ -45,9 +47,7 @@ func (e *engine) ListUsers() ([]*library.User, error) {
 		tmp := user
 
 		// decrypt the fields for the user
-		//
-		// https://pkg.go.dev/github.com/go-vela/types/database#User.Decrypt
-		err = tmp.Decrypt(e.config.EncryptionKey)
+		err = e.decrypt(&tmp)
 		if err != nil {
 			// TODO: remove backwards compatibility before 1.x.x release
 			//
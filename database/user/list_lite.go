@@ -5,6 +5,8 @@
 package user
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
@@ -13,7 +15,7 @@ import (
 // ListLiteUsers gets a lite (only: id, name) list of users from the database.
 //
 //nolint:lll // ignore long line length due to variable names
-func (e *engine) ListLiteUsers(page, perPage int) ([]*library.User, int64, error) {
+func (e *engine) ListLiteUsers(ctx context.Context, page, perPage int) ([]*library.User, int64, error) {
 	e.logger.Trace("listing lite users from the database")
 
 	// variables to store query results and return values
@@ -22,7 +24,7 @@ func (e *engine) ListLiteUsers(page, perPage int) ([]*library.User, int64, error
 	users := []*library.User{}
 
 	// count the results
-	count, err := e.CountUsers()
+	count, err := e.CountUsers(ctx)
 	if err != nil {
 		return users, 0, err
 	}
@@ -35,7 +37,7 @@ func (e *engine) ListLiteUsers(page, perPage int) ([]*library.User, int64, error
 	// calculate offset for pagination through results
 	offset := perPage * (page - 1)
 
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TableUser).
 		Select("id", "name").
 		Limit(perPage).
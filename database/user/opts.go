@@ -5,6 +5,7 @@
 package user
 
 import (
+	"github.com/go-vela/server/kms"
 	"github.com/sirupsen/logrus"
 
 	"gorm.io/gorm"
@@ -33,6 +34,16 @@ func WithEncryptionKey(key string) EngineOpt {
 	}
 }
 
+// WithEnvelope sets the envelope used to encrypt fields in the database engine for Users.
+func WithEnvelope(envelope *kms.Envelope) EngineOpt {
+	return func(e *engine) error {
+		// set the envelope in the user engine
+		e.config.Envelope = envelope
+
+		return nil
+	}
+}
+
 // WithLogger sets the github.com/sirupsen/logrus logger in the database engine for Users.
 func WithLogger(logger *logrus.Entry) EngineOpt {
 	return func(e *engine) error {
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+)
+
+// reencryptBatchSize represents the number of users
+// scanned in a single query when re-encrypting users.
+const reencryptBatchSize = 100
+
+// ReencryptUsers re-encrypts the fields for up to the limit of users
+// that are not sealed with the current key encryption key.
+//
+// Only the encrypted fields are updated and only when they have not
+// changed since they were read, so concurrent updates are not lost.
+func (e *engine) ReencryptUsers(ctx context.Context, limit int) (int, error) {
+	e.logger.Tracef("re-encrypting up to %d users in the database", limit)
+
+	// short-circuit if there is no envelope to re-encrypt users with
+	if e.config.Envelope == nil {
+		return 0, nil
+	}
+
+	count := 0
+	last := int64(0)
+
+	for count < limit {
+		// variable to store query results
+		users := []database.User{}
+
+		// send query to the database and store result in variable
+		err := e.client.WithContext(ctx).
+			Table(constants.TableUser).
+			Select("id", "hash", "token", "refresh_token").
+			Where("id > ?", last).
+			Order("id").
+			Limit(reencryptBatchSize).
+			Find(&users).
+			Error
+		if err != nil {
+			return count, err
+		}
+
+		// break the loop if there are no more users
+		if len(users) == 0 {
+			break
+		}
+
+		for i := range users {
+			u := &users[i]
+			last = u.ID.Int64
+
+			// skip the user if the fields are already current
+			if e.current(u) {
+				continue
+			}
+
+			// capture the encrypted fields to detect concurrent updates
+			hash, token, refresh := u.Hash, u.Token, u.RefreshToken
+
+			err = e.decrypt(u)
+			if err != nil {
+				e.logger.Warnf("unable to decrypt user %d for re-encryption: %v", u.ID.Int64, err)
+
+				continue
+			}
+
+			err = e.encrypt(u)
+			if err != nil {
+				return count, fmt.Errorf("unable to encrypt user %d: %w", u.ID.Int64, err)
+			}
+
+			// send query to the database
+			result := e.client.WithContext(ctx).
+				Table(constants.TableUser).
+				Where("id = ? AND hash = ? AND token = ? AND refresh_token = ?", u.ID, hash, token, refresh).
+				Updates(map[string]interface{}{
+					"hash":          u.Hash,
+					"token":         u.Token,
+					"refresh_token": u.RefreshToken,
+				})
+			if result.Error != nil {
+				return count, result.Error
+			}
+
+			count += int(result.RowsAffected)
+
+			if count >= limit {
+				break
+			}
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package user
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+	"github.com/go-vela/types/library"
+)
+
+func TestUser_Engine_ReencryptUsers(t *testing.T) {
+	// setup types
+	_userOne := testUser()
+	_userOne.SetID(1)
+	_userOne.SetName("foo")
+	_userOne.SetToken("bar")
+	_userOne.SetHash("baz")
+
+	_userTwo := testUser()
+	_userTwo.SetID(2)
+	_userTwo.SetName("bar")
+	_userTwo.SetToken("foo")
+	_userTwo.SetHash("baz")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	_postgres.config.Envelope = testEnvelope(t)
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "hash", "token", "refresh_token"})
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "id","hash","token","refresh_token" FROM "users" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(0).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// create the users encrypted with the static key
+	for _, u := range []*library.User{_userOne, _userTwo} {
+		err := _sqlite.CreateUser(context.TODO(), u)
+		if err != nil {
+			t.Errorf("unable to create test user for sqlite: %v", err)
+		}
+	}
+
+	_sqlite.config.Envelope = testEnvelope(t)
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		limit    int
+		want     int
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			limit:    10,
+			want:     0,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 with limit",
+			database: _sqlite,
+			limit:    1,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 remaining",
+			database: _sqlite,
+			limit:    10,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 current",
+			database: _sqlite,
+			limit:    10,
+			want:     0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ReencryptUsers(context.TODO(), test.limit)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ReencryptUsers for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ReencryptUsers for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("ReencryptUsers for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+
+	// verify the users were sealed by the envelope
+	users := []database.User{}
+
+	err := _sqlite.client.Table(constants.TableUser).Find(&users).Error
+	if err != nil {
+		t.Errorf("unable to list users for sqlite: %v", err)
+	}
+
+	for _, u := range users {
+		if !strings.HasPrefix(u.Token.String, "vela:") {
+			t.Errorf("ReencryptUsers token for user %d is %v, want sealed", u.ID.Int64, u.Token.String)
+		}
+	}
+
+	// verify the users can still be decrypted
+	for _, want := range []*library.User{_userOne, _userTwo} {
+		got, err := _sqlite.GetUser(context.TODO(), want.GetID())
+		if err != nil {
+			t.Errorf("unable to get user %d for sqlite: %v", want.GetID(), err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetUser is %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/database"
+)
+
+// AuditUserEncryption captures the number of users with encrypted fields
+// that can and can't be decrypted with the current key along with the
+// number sealed by the current envelope.
+func (e *engine) AuditUserEncryption(ctx context.Context) (*api.EncryptionAudit, error) {
+	e.logger.Trace("auditing encrypted fields for users in the database")
+
+	var total, readable, unreadable, sealed int64
+
+	err := e.scanEncrypted(ctx, func(u *database.User) error {
+		total++
+
+		// check if the fields are sealed with the current key encryption key
+		if e.config.Envelope != nil && e.current(u) {
+			sealed++
+		}
+
+		err := e.decrypt(u)
+		if err != nil {
+			e.logger.Debugf("unable to decrypt user %d: %v", u.ID.Int64, err)
+
+			unreadable++
+
+			return nil
+		}
+
+		readable++
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	audit := new(api.EncryptionAudit)
+	audit.SetTable(constants.TableUser)
+	audit.SetColumns([]string{"hash", "token", "refresh_token"})
+	audit.SetTotal(total)
+	audit.SetReadable(readable)
+	audit.SetUnreadable(unreadable)
+	audit.SetSealed(sealed)
+
+	return audit, nil
+}
+
+// RekeyUsers decrypts the fields for every user with the current
+// key and re-encrypts them with the provided key. It returns the
+// number of users that were re-encrypted.
+//
+// Users that can't be decrypted with the current key are skipped and
+// only the encrypted fields are updated when they have not changed
+// since they were read, so concurrent updates are not lost.
+func (e *engine) RekeyUsers(ctx context.Context, key string) (int, error) {
+	e.logger.Trace("re-keying encrypted fields for users in the database")
+
+	count := 0
+
+	err := e.scanEncrypted(ctx, func(u *database.User) error {
+		// capture the encrypted fields to detect concurrent updates
+		hash, token, refresh := u.Hash, u.Token, u.RefreshToken
+
+		err := e.decrypt(u)
+		if err != nil {
+			e.logger.Warnf("unable to decrypt user %d for re-keying: %v", u.ID.Int64, err)
+
+			return nil
+		}
+
+		// https://pkg.go.dev/github.com/go-vela/types/database#User.Encrypt
+		err = u.Encrypt(key)
+		if err != nil {
+			return fmt.Errorf("unable to encrypt user %d: %w", u.ID.Int64, err)
+		}
+
+		// send query to the database
+		result := e.client.WithContext(ctx).
+			Table(constants.TableUser).
+			Where("id = ? AND hash = ? AND token = ? AND refresh_token = ?", u.ID, hash, token, refresh).
+			Updates(map[string]interface{}{
+				"hash":          u.Hash,
+				"token":         u.Token,
+				"refresh_token": u.RefreshToken,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		count += int(result.RowsAffected)
+
+		return nil
+	})
+
+	return count, err
+}
+
+// scanEncrypted is a helper function to call the provided function
+// with the encrypted fields for every user, ordered by ID, reading
+// the users from the database in batches.
+func (e *engine) scanEncrypted(ctx context.Context, fn func(*database.User) error) error {
+	last := int64(0)
+
+	for {
+		// variable to store query results
+		users := []database.User{}
+
+		// send query to the database and store result in variable
+		err := e.client.WithContext(ctx).
+			Table(constants.TableUser).
+			Select("id", "hash", "token", "refresh_token").
+			Where("id > ?", last).
+			Order("id").
+			Limit(reencryptBatchSize).
+			Find(&users).
+			Error
+		if err != nil {
+			return err
+		}
+
+		// break the loop if there are no more users
+		if len(users) == 0 {
+			return nil
+		}
+
+		for i := range users {
+			last = users[i].ID.Int64
+
+			err = fn(&users[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package user
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/library"
+)
+
+func TestUser_Engine_AuditUserEncryption(t *testing.T) {
+	// setup types
+	_userOne := testUser()
+	_userOne.SetID(1)
+	_userOne.SetName("foo")
+	_userOne.SetToken("bar")
+	_userOne.SetHash("baz")
+
+	_userTwo := testUser()
+	_userTwo.SetID(2)
+	_userTwo.SetName("bar")
+	_userTwo.SetToken("foo")
+	_userTwo.SetHash("baz")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id", "hash", "token", "refresh_token"}).
+		AddRow(1, "not", "encrypted", "")
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery(`SELECT "id","hash","token","refresh_token" FROM "users" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(0).
+		WillReturnRows(_rows)
+
+	_mock.ExpectQuery(`SELECT "id","hash","token","refresh_token" FROM "users" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "hash", "token", "refresh_token"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// create the users encrypted with the static key
+	for _, u := range []*library.User{_userOne, _userTwo} {
+		err := _sqlite.CreateUser(context.TODO(), u)
+		if err != nil {
+			t.Errorf("unable to create test user for sqlite: %v", err)
+		}
+	}
+
+	// seal one of the users with the envelope
+	_sqlite.config.Envelope = testEnvelope(t)
+
+	_, err := _sqlite.ReencryptUsers(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("unable to reencrypt test user for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure        bool
+		name           string
+		database       *engine
+		wantTotal      int64
+		wantReadable   int64
+		wantUnreadable int64
+		wantSealed     int64
+	}{
+		{
+			failure:        false,
+			name:           "postgres",
+			database:       _postgres,
+			wantTotal:      1,
+			wantReadable:   0,
+			wantUnreadable: 1,
+			wantSealed:     0,
+		},
+		{
+			failure:        false,
+			name:           "sqlite3",
+			database:       _sqlite,
+			wantTotal:      2,
+			wantReadable:   2,
+			wantUnreadable: 0,
+			wantSealed:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.AuditUserEncryption(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("AuditUserEncryption for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("AuditUserEncryption for %s returned err: %v", test.name, err)
+			}
+
+			if got.GetTotal() != test.wantTotal ||
+				got.GetReadable() != test.wantReadable ||
+				got.GetUnreadable() != test.wantUnreadable ||
+				got.GetSealed() != test.wantSealed {
+				t.Errorf("AuditUserEncryption for %s is %v", test.name, got)
+			}
+		})
+	}
+}
+
+func TestUser_Engine_RekeyUsers(t *testing.T) {
+	// setup types
+	_userOne := testUser()
+	_userOne.SetID(1)
+	_userOne.SetName("foo")
+	_userOne.SetToken("bar")
+	_userOne.SetHash("baz")
+
+	_userTwo := testUser()
+	_userTwo.SetID(2)
+	_userTwo.SetName("bar")
+	_userTwo.SetToken("foo")
+	_userTwo.SetHash("baz")
+
+	key := "Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT "id","hash","token","refresh_token" FROM "users" WHERE id > $1 ORDER BY id LIMIT 100`).
+		WithArgs(0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "hash", "token", "refresh_token"}))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// create the users encrypted with the static key
+	for _, u := range []*library.User{_userOne, _userTwo} {
+		err := _sqlite.CreateUser(context.TODO(), u)
+		if err != nil {
+			t.Errorf("unable to create test user for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     0,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.RekeyUsers(context.TODO(), key)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("RekeyUsers for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("RekeyUsers for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("RekeyUsers for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+
+	// verify the users can be decrypted with the new key
+	_sqlite.config.EncryptionKey = key
+
+	for _, want := range []*library.User{_userOne, _userTwo} {
+		got, err := _sqlite.GetUser(context.TODO(), want.GetID())
+		if err != nil {
+			t.Errorf("unable to get user %d for sqlite: %v", want.GetID(), err)
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetUser is %v, want %v", got, want)
+		}
+	}
+}
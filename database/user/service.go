@@ -5,6 +5,9 @@
 package user
 
 import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/library"
 )
 
@@ -18,28 +21,34 @@ type UserService interface {
 	// https://en.wikipedia.org/wiki/Data_definition_language
 
 	// CreateUserIndexes defines a function that creates the indexes for the users table.
-	CreateUserIndexes() error
+	CreateUserIndexes(context.Context) error
 	// CreateUserTable defines a function that creates the users table.
-	CreateUserTable(string) error
+	CreateUserTable(context.Context, string) error
 
 	// User Data Manipulation Language Functions
 	//
 	// https://en.wikipedia.org/wiki/Data_manipulation_language
 
+	// AuditUserEncryption defines a function that audits the encrypted fields for users.
+	AuditUserEncryption(context.Context) (*api.EncryptionAudit, error)
 	// CountUsers defines a function that gets the count of all users.
-	CountUsers() (int64, error)
+	CountUsers(context.Context) (int64, error)
 	// CreateUser defines a function that creates a new user.
-	CreateUser(*library.User) error
+	CreateUser(context.Context, *library.User) error
 	// DeleteUser defines a function that deletes an existing user.
-	DeleteUser(*library.User) error
+	DeleteUser(context.Context, *library.User) error
 	// GetUser defines a function that gets a user by ID.
-	GetUser(int64) (*library.User, error)
+	GetUser(context.Context, int64) (*library.User, error)
 	// GetUserForName defines a function that gets a user by name.
-	GetUserForName(string) (*library.User, error)
+	GetUserForName(context.Context, string) (*library.User, error)
 	// ListUsers defines a function that gets a list of all users.
-	ListUsers() ([]*library.User, error)
+	ListUsers(context.Context) ([]*library.User, error)
 	// ListLiteUsers defines a function that gets a lite list of users.
-	ListLiteUsers(int, int) ([]*library.User, int64, error)
+	ListLiteUsers(context.Context, int, int) ([]*library.User, int64, error)
+	// ReencryptUsers defines a function that re-encrypts users not sealed with the current key.
+	ReencryptUsers(context.Context, int) (int, error)
+	// RekeyUsers defines a function that re-encrypts users with a new key.
+	RekeyUsers(context.Context, string) (int, error)
 	// UpdateUser defines a function that updates an existing user.
-	UpdateUser(*library.User) error
+	UpdateUser(context.Context, *library.User) error
 }
@@ -5,6 +5,9 @@
 package user
 
 import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/types/constants"
 )
 
@@ -41,22 +44,43 @@ users (
 	admin          BOOLEAN,
 	UNIQUE(name)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL users table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+users (
+	id             INTEGER PRIMARY KEY AUTO_INCREMENT,
+	name           VARCHAR(250),
+	refresh_token  VARCHAR(500),
+	token          VARCHAR(500),
+	hash           VARCHAR(500),
+	favorites      VARCHAR(5000),
+	active         BOOLEAN,
+	admin          BOOLEAN,
+	UNIQUE(name),
+	INDEX users_refresh (refresh_token)
+);
 `
 )
 
 // CreateUserTable creates the users table in the database.
-func (e *engine) CreateUserTable(driver string) error {
+func (e *engine) CreateUserTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating users table in the database")
 
 	// handle the driver provided to create the table
 	switch driver {
 	case constants.DriverPostgres:
 		// create the users table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the users table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the users table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
 	}
 }
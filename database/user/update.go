@@ -6,6 +6,7 @@
 package user
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-vela/types/constants"
@@ -15,7 +16,7 @@ import (
 )
 
 // UpdateUser updates an existing user in the database.
-func (e *engine) UpdateUser(u *library.User) error {
+func (e *engine) UpdateUser(ctx context.Context, u *library.User) error {
 	e.logger.WithFields(logrus.Fields{
 		"user": u.GetName(),
 	}).Tracef("updating user %s in the database", u.GetName())
@@ -34,15 +35,13 @@ func (e *engine) UpdateUser(u *library.User) error {
 	}
 
 	// encrypt the fields for the user
-	//
-	// https://pkg.go.dev/github.com/go-vela/types/database#User.Encrypt
-	err = user.Encrypt(e.config.EncryptionKey)
+	err = e.encrypt(user)
 	if err != nil {
 		return fmt.Errorf("unable to encrypt user %s: %w", u.GetName(), err)
 	}
 
 	// send query to the database
-	return e.client.
+	return e.client.WithContext(ctx).
 		Table(constants.TableUser).
 		Save(user).
 		Error
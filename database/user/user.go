@@ -5,8 +5,10 @@
 package user
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/go-vela/server/kms"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 
@@ -18,6 +20,8 @@ type (
 	config struct {
 		// specifies the encryption key to use for the User engine
 		EncryptionKey string
+		// specifies the envelope to use for encrypting fields in the User engine
+		Envelope *kms.Envelope
 		// specifies to skip creating tables and indexes for the User engine
 		SkipCreation bool
 	}
@@ -61,19 +65,19 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating user database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of users table and indexes in the database")
+		e.logger.Trace("skipping creation of users table and indexes in the database")
 
 		return e, nil
 	}
 
 	// create the users table
-	err := e.CreateUserTable(e.client.Config.Dialector.Name())
+	err := e.CreateUserTable(context.Background(), e.client.Config.Dialector.Name())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create %s table: %w", constants.TableUser, err)
 	}
 
 	// create the indexes for the users table
-	err = e.CreateUserIndexes()
+	err = e.CreateUserIndexes(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create indexes for %s table: %w", constants.TableUser, err)
 	}
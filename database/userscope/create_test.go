@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUserScope_Engine_CreateUserScope(t *testing.T) {
+	// setup types
+	_scope := testUserScope()
+	_scope.SetID(1)
+	_scope.SetUserID(1)
+	_scope.SetScopes([]string{"repo", "read:org"})
+	_scope.SetUpdatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "user_scopes"
+("user_id","scopes","updated_at","id")
+VALUES ($1,$2,$3,$4) RETURNING "id"`).
+		WithArgs(1, `{"repo","read:org"}`, 1, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateUserScope(context.TODO(), _scope)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateUserScope for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateUserScope for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteUserScope deletes an existing user scope from the database.
+func (e *engine) DeleteUserScope(ctx context.Context, s *api.UserScope) error {
+	e.logger.WithFields(logrus.Fields{
+		"user_id": s.GetUserID(),
+	}).Tracef("deleting user scope for user %d from the database", s.GetUserID())
+
+	// cast the API type to database type
+	scope := types.UserScopeFromAPI(s)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableUserScope).
+		Delete(scope).
+		Error
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// GetUserScopeForUser gets a user scope by user from the database.
+func (e *engine) GetUserScopeForUser(ctx context.Context, u *library.User) (*api.UserScope, error) {
+	e.logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("getting user scope for user %s from the database", u.GetName())
+
+	// variable to store query results
+	s := new(types.UserScope)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableUserScope).
+		Where("user_id = ?", u.GetID()).
+		Take(s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return s.ToAPI(), nil
+}
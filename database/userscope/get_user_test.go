@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestUserScope_Engine_GetUserScopeForUser(t *testing.T) {
+	// setup types
+	_scope := testUserScope()
+	_scope.SetID(1)
+	_scope.SetUserID(1)
+	_scope.SetScopes([]string{"repo", "read:org"})
+	_scope.SetUpdatedAt(1)
+
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "user_id", "scopes", "updated_at"}).
+		AddRow(1, 1, `{"repo","read:org"}`, 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "user_scopes" WHERE user_id = $1 LIMIT 1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateUserScope(context.TODO(), _scope)
+	if err != nil {
+		t.Errorf("unable to create test user scope for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.UserScope
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _scope,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _scope,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetUserScopeForUser(context.TODO(), _user)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetUserScopeForUser for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetUserScopeForUser for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetUserScopeForUser for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
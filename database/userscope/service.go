@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// UserScopeService represents the Vela interface for user scope
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type UserScopeService interface {
+	// UserScope Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateUserScopeTable defines a function that creates the user_scopes table.
+	CreateUserScopeTable(context.Context, string) error
+
+	// UserScope Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateUserScope defines a function that creates a new user scope.
+	CreateUserScope(context.Context, *api.UserScope) error
+	// DeleteUserScope defines a function that deletes an existing user scope.
+	DeleteUserScope(context.Context, *api.UserScope) error
+	// GetUserScopeForUser defines a function that gets a user scope by user.
+	GetUserScopeForUser(context.Context, *library.User) (*api.UserScope, error)
+	// UpdateUserScope defines a function that updates an existing user scope.
+	UpdateUserScope(context.Context, *api.UserScope) error
+}
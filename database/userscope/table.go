@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableUserScope represents the name of the table for the OAuth scopes granted to users.
+	TableUserScope = "user_scopes"
+
+	// CreatePostgresTable represents a query to create the Postgres user_scopes table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+user_scopes (
+	id            SERIAL PRIMARY KEY,
+	user_id       INTEGER,
+	scopes        VARCHAR(1000),
+	updated_at    INTEGER,
+	UNIQUE(user_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite user_scopes table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+user_scopes (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id       INTEGER,
+	scopes        TEXT,
+	updated_at    INTEGER,
+	UNIQUE(user_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL user_scopes table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+user_scopes (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	user_id       INTEGER,
+	scopes        VARCHAR(1000),
+	updated_at    INTEGER,
+	UNIQUE(user_id)
+);
+`
+)
+
+// CreateUserScopeTable creates the user_scopes table in the database.
+func (e *engine) CreateUserScopeTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating user_scopes table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the user_scopes table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the user_scopes table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the user_scopes table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
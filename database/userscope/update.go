@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateUserScope updates an existing user scope in the database.
+func (e *engine) UpdateUserScope(ctx context.Context, s *api.UserScope) error {
+	e.logger.WithFields(logrus.Fields{
+		"user_id": s.GetUserID(),
+	}).Tracef("updating user scope for user %d in the database", s.GetUserID())
+
+	// cast the API type to database type
+	scope := types.UserScopeFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := scope.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableUserScope).
+		Save(scope).
+		Error
+}
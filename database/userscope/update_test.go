@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package userscope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUserScope_Engine_UpdateUserScope(t *testing.T) {
+	// setup types
+	_scope := testUserScope()
+	_scope.SetID(1)
+	_scope.SetUserID(1)
+	_scope.SetScopes([]string{"repo", "read:org"})
+	_scope.SetUpdatedAt(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "user_scopes"
+SET "user_id"=$1,"scopes"=$2,"updated_at"=$3
+WHERE "id" = $4`).
+		WithArgs(1, `{"repo","read:org"}`, 1, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateUserScope(context.TODO(), _scope)
+	if err != nil {
+		t.Errorf("unable to create test user scope for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateUserScope(context.TODO(), _scope)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateUserScope for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateUserScope for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// CountUserSessionsForUser gets the count of sessions by user ID from the database.
+func (e *engine) CountUserSessionsForUser(ctx context.Context, u *library.User) (int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("getting count of sessions for user %s from the database", u.GetName())
+
+	// variable to store query results
+	var s int64
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableUserSessions).
+		Where("user_id = ?", u.GetID()).
+		Count(&s).
+		Error
+
+	return s, err
+}
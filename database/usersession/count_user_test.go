@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-vela/types/library"
+)
+
+func TestUserSession_Engine_CountUserSessionsForUser(t *testing.T) {
+	// setup types
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+
+	_sessionOne := testUserSession()
+	_sessionOne.SetID(1)
+	_sessionOne.SetUserID(1)
+	_sessionOne.SetAction("login")
+	_sessionOne.SetCreated(1)
+
+	_sessionTwo := testUserSession()
+	_sessionTwo.SetID(2)
+	_sessionTwo.SetUserID(2)
+	_sessionTwo.SetAction("login")
+	_sessionTwo.SetCreated(1)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT count(*) FROM "user_sessions" WHERE user_id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateUserSession(context.TODO(), _sessionOne)
+	if err != nil {
+		t.Errorf("unable to create test user session for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateUserSession(context.TODO(), _sessionTwo)
+	if err != nil {
+		t.Errorf("unable to create test user session for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CountUserSessionsForUser(context.TODO(), _user)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CountUserSessionsForUser for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CountUserSessionsForUser for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CountUserSessionsForUser for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
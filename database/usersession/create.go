@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateUserSession creates a record of a user logging
+// in or refreshing their access token in the database.
+func (e *engine) CreateUserSession(ctx context.Context, s *api.UserSession) error {
+	e.logger.WithFields(logrus.Fields{
+		"user":   s.GetUserID(),
+		"action": s.GetAction(),
+	}).Tracef("creating %s session for user %d in the database", s.GetAction(), s.GetUserID())
+
+	// cast the API type to database type
+	session := types.UserSessionFromAPI(s)
+
+	// validate the necessary fields are populated
+	err := session.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableUserSessions).
+		Create(session).
+		Error
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateUserIDIndex represents a query to create an
+	// index on the user_sessions table for the user_id column.
+	CreateUserIDIndex = `
+CREATE INDEX
+IF NOT EXISTS
+user_sessions_user_id
+ON user_sessions (user_id);
+`
+)
+
+// CreateUserSessionsIndexes creates the indexes for the user_sessions table in the database.
+func (e *engine) CreateUserSessionsIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for user_sessions table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the user_id column index for the user_sessions table
+	return e.client.WithContext(ctx).Exec(CreateUserIDIndex).Error
+}
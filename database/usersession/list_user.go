@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// ListUserSessionsForUser gets a list of sessions by user ID,
+// with the most recent session first, from the database.
+func (e *engine) ListUserSessionsForUser(ctx context.Context, u *library.User, page, perPage int) ([]*api.UserSession, int64, error) {
+	e.logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("listing sessions for user %s from the database", u.GetName())
+
+	// variables to store query results and return value
+	s := new([]types.UserSession)
+	sessions := []*api.UserSession{}
+
+	// count the results
+	count, err := e.CountUserSessionsForUser(ctx, u)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// short-circuit if there are no results
+	if count == 0 {
+		return sessions, 0, nil
+	}
+
+	// calculate offset for pagination through results
+	offset := perPage * (page - 1)
+
+	// send query to the database and store result in variable
+	err = e.client.WithContext(ctx).
+		Table(TableUserSessions).
+		Where("user_id = ?", u.GetID()).
+		Order("id DESC").
+		Limit(perPage).
+		Offset(offset).
+		Find(&s).
+		Error
+	if err != nil {
+		return nil, count, err
+	}
+
+	// iterate through all query results
+	for _, session := range *s {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := session
+
+		// convert query result to API type
+		sessions = append(sessions, tmp.ToAPI())
+	}
+
+	return sessions, count, nil
+}
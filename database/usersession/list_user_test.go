@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestUserSession_Engine_ListUserSessionsForUser(t *testing.T) {
+	// setup types
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+
+	_sessionOne := testUserSession()
+	_sessionOne.SetID(1)
+	_sessionOne.SetUserID(1)
+	_sessionOne.SetAction("login")
+	_sessionOne.SetIP("1.2.3.4")
+	_sessionOne.SetUserAgent("vela-cli")
+	_sessionOne.SetClient("Vela CLI")
+	_sessionOne.SetCreated(1)
+
+	_sessionTwo := testUserSession()
+	_sessionTwo.SetID(2)
+	_sessionTwo.SetUserID(1)
+	_sessionTwo.SetAction("refresh")
+	_sessionTwo.SetIP("5.6.7.8")
+	_sessionTwo.SetLocation("Minneapolis, Minnesota, US")
+	_sessionTwo.SetCreated(2)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected count query result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+
+	// ensure the mock expects the count query
+	_mock.ExpectQuery(`SELECT count(*) FROM "user_sessions" WHERE user_id = $1`).WithArgs(1).WillReturnRows(_rows)
+
+	// create expected query result in mock
+	_rows = sqlmock.NewRows(
+		[]string{"id", "user_id", "action", "ip", "user_agent", "client", "location", "created"}).
+		AddRow(2, 1, "refresh", "5.6.7.8", "", "", "Minneapolis, Minnesota, US", 2).
+		AddRow(1, 1, "login", "1.2.3.4", "vela-cli", "Vela CLI", "", 1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "user_sessions" WHERE user_id = $1 ORDER BY id DESC LIMIT 10`).WithArgs(1).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateUserSession(context.TODO(), _sessionOne)
+	if err != nil {
+		t.Errorf("unable to create test user session for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateUserSession(context.TODO(), _sessionTwo)
+	if err != nil {
+		t.Errorf("unable to create test user session for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.UserSession
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.UserSession{_sessionTwo, _sessionOne},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.UserSession{_sessionTwo, _sessionOne},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, count, err := test.database.ListUserSessionsForUser(context.TODO(), _user, 1, 10)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListUserSessionsForUser for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListUserSessionsForUser for %s returned err: %v", test.name, err)
+			}
+
+			if count != 2 {
+				t.Errorf("ListUserSessionsForUser count for %s is %d, want 2", test.name, count)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListUserSessionsForUser for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
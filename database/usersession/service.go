@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// UserSessionService represents the Vela interface for user session
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type UserSessionService interface {
+	// UserSession Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateUserSessionsIndexes defines a function that creates the indexes for the user_sessions table.
+	CreateUserSessionsIndexes(context.Context) error
+	// CreateUserSessionsTable defines a function that creates the user_sessions table.
+	CreateUserSessionsTable(context.Context, string) error
+
+	// UserSession Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CountUserSessionsForUser defines a function that gets the count of sessions by user ID.
+	CountUserSessionsForUser(context.Context, *library.User) (int64, error)
+	// CreateUserSession defines a function that creates a record of a user logging in or refreshing their access token.
+	CreateUserSession(context.Context, *api.UserSession) error
+	// ListUserSessionsForUser defines a function that gets a list of sessions by user ID.
+	ListUserSessionsForUser(context.Context, *library.User, int, int) ([]*api.UserSession, int64, error)
+}
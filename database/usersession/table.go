@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package usersession
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableUserSessions represents the name of the table for user sessions.
+	TableUserSessions = "user_sessions"
+
+	// CreatePostgresTable represents a query to create the Postgres user_sessions table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+user_sessions (
+	id            SERIAL PRIMARY KEY,
+	user_id       INTEGER,
+	action        VARCHAR(250),
+	ip            VARCHAR(250),
+	user_agent    VARCHAR(500),
+	client        VARCHAR(250),
+	location      VARCHAR(250),
+	created       INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite user_sessions table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+user_sessions (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	user_id       INTEGER,
+	action        TEXT,
+	ip            TEXT,
+	user_agent    TEXT,
+	client        TEXT,
+	location      TEXT,
+	created       INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL user_sessions table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+user_sessions (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	user_id       INTEGER,
+	action        VARCHAR(250),
+	ip            VARCHAR(250),
+	user_agent    VARCHAR(500),
+	client        VARCHAR(250),
+	location      VARCHAR(250),
+	created       INTEGER,
+	INDEX user_sessions_user_id (user_id)
+);
+`
+)
+
+// CreateUserSessionsTable creates the user_sessions table in the database.
+func (e *engine) CreateUserSessionsTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating user_sessions table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the user_sessions table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the user_sessions table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the user_sessions table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+// CleanWebhookDeliveries deletes the webhook deliveries created
+// before the provided timestamp from the database.
+func (e *engine) CleanWebhookDeliveries(ctx context.Context, before int64) (int64, error) {
+	e.logger.Tracef("cleaning webhook deliveries created before %d in the database", before)
+
+	// send query to the database
+	result := e.client.WithContext(ctx).
+		Table(TableWebhookDelivery).
+		Where("created < ?", before).
+		Delete(new(types.WebhookDelivery))
+
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWebhookDelivery_Engine_CleanWebhookDeliveries(t *testing.T) {
+	// setup types
+	_deliveryOne := testWebhookDelivery()
+	_deliveryOne.SetID(1)
+	_deliveryOne.SetSource("github")
+	_deliveryOne.SetDeliveryID("72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	_deliveryOne.SetRepoID(1)
+	_deliveryOne.SetCreated(1563474076)
+
+	_deliveryTwo := testWebhookDelivery()
+	_deliveryTwo.SetID(2)
+	_deliveryTwo.SetSource("github")
+	_deliveryTwo.SetDeliveryID("8ac3ff5c-cc78-11e3-81ab-4c9367dc0958")
+	_deliveryTwo.SetRepoID(1)
+	_deliveryTwo.SetCreated(1563474090)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "webhook_deliveries" WHERE created < $1`).
+		WithArgs(1563474080).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWebhookDelivery(context.TODO(), _deliveryOne)
+	if err != nil {
+		t.Errorf("unable to create test webhook delivery for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateWebhookDelivery(context.TODO(), _deliveryTwo)
+	if err != nil {
+		t.Errorf("unable to create test webhook delivery for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CleanWebhookDeliveries(context.TODO(), 1563474080)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CleanWebhookDeliveries for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CleanWebhookDeliveries for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("CleanWebhookDeliveries for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
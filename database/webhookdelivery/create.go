@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateWebhookDelivery creates a new webhook delivery in the database.
+func (e *engine) CreateWebhookDelivery(ctx context.Context, d *api.WebhookDelivery) error {
+	e.logger.WithFields(logrus.Fields{
+		"source":   d.GetSource(),
+		"delivery": d.GetDeliveryID(),
+	}).Tracef("creating webhook delivery %s in the database", d.GetDeliveryID())
+
+	// cast the API type to database type
+	delivery := types.WebhookDeliveryFromAPI(d)
+
+	// validate the necessary fields are populated
+	err := delivery.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableWebhookDelivery).
+		Create(delivery).
+		Error
+}
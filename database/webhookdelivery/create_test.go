@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWebhookDelivery_Engine_CreateWebhookDelivery(t *testing.T) {
+	// setup types
+	_delivery := testWebhookDelivery()
+	_delivery.SetID(1)
+	_delivery.SetSource("github")
+	_delivery.SetDeliveryID("72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	_delivery.SetRepoID(1)
+	_delivery.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "webhook_deliveries"
+("source","delivery_id","repo_id","created","id")
+VALUES ($1,$2,$3,$4,$5) RETURNING "id"`).
+		WithArgs("github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1, 1563474076, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateWebhookDelivery(context.TODO(), _delivery)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateWebhookDelivery for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateWebhookDelivery for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteWebhookDelivery deletes an existing webhook delivery from the database.
+func (e *engine) DeleteWebhookDelivery(ctx context.Context, d *api.WebhookDelivery) error {
+	e.logger.WithFields(logrus.Fields{
+		"source":   d.GetSource(),
+		"delivery": d.GetDeliveryID(),
+	}).Tracef("deleting webhook delivery %s from the database", d.GetDeliveryID())
+
+	// cast the API type to database type
+	delivery := types.WebhookDeliveryFromAPI(d)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableWebhookDelivery).
+		Delete(delivery).
+		Error
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWebhookDelivery_Engine_DeleteWebhookDelivery(t *testing.T) {
+	// setup types
+	_delivery := testWebhookDelivery()
+	_delivery.SetID(1)
+	_delivery.SetSource("github")
+	_delivery.SetDeliveryID("72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	_delivery.SetRepoID(1)
+	_delivery.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "webhook_deliveries" WHERE "webhook_deliveries"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWebhookDelivery(context.TODO(), _delivery)
+	if err != nil {
+		t.Errorf("unable to create test webhook delivery for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.DeleteWebhookDelivery(context.TODO(), _delivery)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteWebhookDelivery for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteWebhookDelivery for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
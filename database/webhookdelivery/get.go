@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetWebhookDelivery gets a webhook delivery by delivery ID from the database.
+func (e *engine) GetWebhookDelivery(ctx context.Context, id string) (*api.WebhookDelivery, error) {
+	e.logger.Tracef("getting webhook delivery %s from the database", id)
+
+	// variable to store query results
+	d := new(types.WebhookDelivery)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableWebhookDelivery).
+		Where("delivery_id = ?", id).
+		Take(d).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return d.ToAPI(), nil
+}
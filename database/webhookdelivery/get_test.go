@@ -0,0 +1,87 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWebhookDelivery_Engine_GetWebhookDelivery(t *testing.T) {
+	// setup types
+	_delivery := testWebhookDelivery()
+	_delivery.SetID(1)
+	_delivery.SetSource("github")
+	_delivery.SetDeliveryID("72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	_delivery.SetRepoID(1)
+	_delivery.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "source", "delivery_id", "repo_id", "created"}).
+		AddRow(1, "github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1, 1563474076)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "webhook_deliveries" WHERE delivery_id = $1 LIMIT 1`).WithArgs("72d3162e-cc78-11e3-81ab-4c9367dc0958").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWebhookDelivery(context.TODO(), _delivery)
+	if err != nil {
+		t.Errorf("unable to create test webhook delivery for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.WebhookDelivery
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _delivery,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _delivery,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetWebhookDelivery(context.TODO(), "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetWebhookDelivery for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetWebhookDelivery for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetWebhookDelivery for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
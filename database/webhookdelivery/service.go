@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// WebhookDeliveryService represents the Vela interface for webhook delivery
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type WebhookDeliveryService interface {
+	// WebhookDelivery Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateWebhookDeliveryIndexes defines a function that creates the indexes for the webhook_deliveries table.
+	CreateWebhookDeliveryIndexes(context.Context) error
+	// CreateWebhookDeliveryTable defines a function that creates the webhook_deliveries table.
+	CreateWebhookDeliveryTable(context.Context, string) error
+
+	// WebhookDelivery Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CleanWebhookDeliveries defines a function that deletes webhook deliveries created before a timestamp.
+	CleanWebhookDeliveries(context.Context, int64) (int64, error)
+	// CreateWebhookDelivery defines a function that creates a new webhook delivery.
+	CreateWebhookDelivery(context.Context, *api.WebhookDelivery) error
+	// DeleteWebhookDelivery defines a function that deletes an existing webhook delivery.
+	DeleteWebhookDelivery(context.Context, *api.WebhookDelivery) error
+	// GetWebhookDelivery defines a function that gets a webhook delivery by delivery ID.
+	GetWebhookDelivery(context.Context, string) (*api.WebhookDelivery, error)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookdelivery
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableWebhookDelivery represents the name of the table for webhook deliveries.
+	TableWebhookDelivery = "webhook_deliveries"
+
+	// CreatePostgresTable represents a query to create the Postgres webhook_deliveries table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+webhook_deliveries (
+	id            SERIAL PRIMARY KEY,
+	source        VARCHAR(250),
+	delivery_id   VARCHAR(250),
+	repo_id       INTEGER,
+	created       INTEGER,
+	UNIQUE(delivery_id)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite webhook_deliveries table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+webhook_deliveries (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	source        TEXT,
+	delivery_id   TEXT,
+	repo_id       INTEGER,
+	created       INTEGER,
+	UNIQUE(delivery_id)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL webhook_deliveries table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+webhook_deliveries (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	source        VARCHAR(250),
+	delivery_id   VARCHAR(250),
+	repo_id       INTEGER,
+	created       INTEGER,
+	UNIQUE(delivery_id),
+	INDEX webhook_deliveries_created (created)
+);
+`
+)
+
+// CreateWebhookDeliveryTable creates the webhook_deliveries table in the database.
+func (e *engine) CreateWebhookDeliveryTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating webhook_deliveries table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the webhook_deliveries table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the webhook_deliveries table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the webhook_deliveries table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ClaimWebhookIntake updates an existing webhook intake in the database
+// only when it is still pending and was last available at the time
+// provided, returning whether the webhook intake was updated. This
+// prevents two replicas from processing the same webhook after
+// observing it at the same time.
+func (e *engine) ClaimWebhookIntake(ctx context.Context, w *api.WebhookIntake, available int64) (bool, error) {
+	e.logger.WithFields(logrus.Fields{
+		"source": w.GetSource(),
+	}).Tracef("claiming webhook intake %d in the database", w.GetID())
+
+	// cast the API type to database type
+	intake := types.WebhookIntakeFromAPI(w)
+
+	// validate the necessary fields are populated
+	err := intake.Validate()
+	if err != nil {
+		return false, err
+	}
+
+	// send query to the database
+	result := e.client.WithContext(ctx).
+		Table(TableWebhookIntake).
+		Where("id = ? AND status = ? AND available = ?", w.GetID(), api.WebhookIntakePending, available).
+		Updates(map[string]interface{}{
+			"attempts":  intake.Attempts,
+			"available": intake.Available,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWebhookIntake_Engine_ClaimWebhookIntake(t *testing.T) {
+	// setup types
+	_intake := testWebhookIntake()
+	_intake.SetID(1)
+	_intake.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_intake.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intake.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intake.SetStatus("pending")
+	_intake.SetAvailable(1563474076)
+	_intake.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the queries
+	_mock.ExpectExec(`UPDATE "webhook_intakes" SET "attempts"=$1,"available"=$2 WHERE id = $3 AND status = $4 AND available = $5`).
+		WithArgs(1, 1563474376, 1, "pending", 1563474076).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_mock.ExpectExec(`UPDATE "webhook_intakes" SET "attempts"=$1,"available"=$2 WHERE id = $3 AND status = $4 AND available = $5`).
+		WithArgs(1, 1563474376, 1, "pending", 1563474076).
+		WillReturnResult(sqlmock.NewResult(1, 0))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWebhookIntake(context.TODO(), _intake)
+	if err != nil {
+		t.Errorf("unable to create test webhook intake for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     bool
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     true,
+		},
+		{
+			failure:  false,
+			name:     "postgres claimed",
+			database: _postgres,
+			want:     false,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     true,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3 claimed",
+			database: _sqlite,
+			want:     false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			claim := testWebhookIntake()
+			claim.SetID(1)
+			claim.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+			claim.SetHeader(`{"X-Github-Event":["push"]}`)
+			claim.SetPayload(`{"ref":"refs/heads/main"}`)
+			claim.SetStatus("pending")
+			claim.SetAttempts(1)
+			claim.SetAvailable(1563474376)
+			claim.SetCreated(1563474076)
+
+			got, err := test.database.ClaimWebhookIntake(context.TODO(), claim, 1563474076)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ClaimWebhookIntake for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ClaimWebhookIntake for %s returned err: %v", test.name, err)
+			}
+
+			if got != test.want {
+				t.Errorf("ClaimWebhookIntake for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+)
+
+// CountWebhookIntakes gets the count of webhook intakes by status from the database.
+func (e *engine) CountWebhookIntakes(ctx context.Context, status string) (int64, error) {
+	e.logger.Tracef("getting count of %s webhook intakes from the database", status)
+
+	// variable to store query results
+	var w int64
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableWebhookIntake).
+		Where("status = ?", status).
+		Count(&w).
+		Error
+
+	return w, err
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWebhookIntake_Engine_CountWebhookIntakes(t *testing.T) {
+	// setup types
+	_intakeOne := testWebhookIntake()
+	_intakeOne.SetID(1)
+	_intakeOne.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_intakeOne.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intakeOne.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intakeOne.SetStatus("pending")
+	_intakeOne.SetAvailable(1563474076)
+	_intakeOne.SetCreated(1563474076)
+
+	_intakeTwo := testWebhookIntake()
+	_intakeTwo.SetID(2)
+	_intakeTwo.SetSource("d8da1302-07d6-11ea-882f-4893bca275b8")
+	_intakeTwo.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intakeTwo.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intakeTwo.SetStatus("failed")
+	_intakeTwo.SetAvailable(1563474076)
+	_intakeTwo.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT count(*) FROM "webhook_intakes" WHERE status = $1`).
+		WithArgs("pending").
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, intake := range []*api.WebhookIntake{_intakeOne, _intakeTwo} {
+		err := _sqlite.CreateWebhookIntake(context.TODO(), intake)
+		if err != nil {
+			t.Errorf("unable to create test webhook intake for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     int64
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     1,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.CountWebhookIntakes(context.TODO(), "pending")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CountWebhookIntakes for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CountWebhookIntakes for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("CountWebhookIntakes for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
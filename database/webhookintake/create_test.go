@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWebhookIntake_Engine_CreateWebhookIntake(t *testing.T) {
+	// setup types
+	_intake := testWebhookIntake()
+	_intake.SetID(1)
+	_intake.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_intake.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intake.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intake.SetStatus("pending")
+	_intake.SetAttempts(1)
+	_intake.SetAvailable(1563474076)
+	_intake.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "webhook_intakes"
+("source","header","payload","status","attempts","error","available","created","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9) RETURNING "id"`).
+		WithArgs("c8da1302-07d6-11ea-882f-4893bca275b8", `{"X-Github-Event":["push"]}`, `{"ref":"refs/heads/main"}`, "pending", 1, nil, 1563474076, 1563474076, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateWebhookIntake(context.TODO(), _intake)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateWebhookIntake for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateWebhookIntake for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
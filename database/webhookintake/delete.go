@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteWebhookIntake deletes an existing webhook intake from the database.
+func (e *engine) DeleteWebhookIntake(ctx context.Context, w *api.WebhookIntake) error {
+	e.logger.WithFields(logrus.Fields{
+		"source": w.GetSource(),
+	}).Tracef("deleting webhook intake %d from the database", w.GetID())
+
+	// cast the API type to database type
+	intake := types.WebhookIntakeFromAPI(w)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableWebhookIntake).
+		Delete(intake).
+		Error
+}
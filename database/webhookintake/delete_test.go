@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWebhookIntake_Engine_DeleteWebhookIntake(t *testing.T) {
+	// setup types
+	_intake := testWebhookIntake()
+	_intake.SetID(1)
+	_intake.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_intake.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intake.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intake.SetStatus("pending")
+	_intake.SetAttempts(1)
+	_intake.SetAvailable(1563474076)
+	_intake.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "webhook_intakes" WHERE "webhook_intakes"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWebhookIntake(context.TODO(), _intake)
+	if err != nil {
+		t.Errorf("unable to create test webhook intake for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.DeleteWebhookIntake(context.TODO(), _intake)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteWebhookIntake for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteWebhookIntake for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateStatusAvailableIndex represents a query to create an index on
+	// the webhook_intakes table for the status and available columns.
+	CreateStatusAvailableIndex = `
+CREATE INDEX
+IF NOT EXISTS
+webhook_intakes_status_available
+ON webhook_intakes (status, available);
+`
+)
+
+// CreateWebhookIntakeIndexes creates the indexes for the webhook_intakes table in the database.
+func (e *engine) CreateWebhookIntakeIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for webhook_intakes table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the status and available columns index for the webhook_intakes table
+	return e.client.WithContext(ctx).Exec(CreateStatusAvailableIndex).Error
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListAvailableWebhookIntakes gets a list of pending webhook intakes
+// available at the time provided from the database, in the order
+// they were received, up to the limit provided.
+func (e *engine) ListAvailableWebhookIntakes(ctx context.Context, now int64, limit int) ([]*api.WebhookIntake, error) {
+	e.logger.Tracef("listing webhook intakes available at %d from the database", now)
+
+	// variables to store query results and return value
+	w := new([]types.WebhookIntake)
+	intakes := []*api.WebhookIntake{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableWebhookIntake).
+		Where("status = ?", api.WebhookIntakePending).
+		Where("available <= ?", now).
+		Order("id").
+		Limit(limit).
+		Find(&w).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, intake := range *w {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := intake
+
+		// convert query result to API type
+		intakes = append(intakes, tmp.ToAPI())
+	}
+
+	return intakes, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWebhookIntake_Engine_ListAvailableWebhookIntakes(t *testing.T) {
+	// setup types
+	_intakeOne := testWebhookIntake()
+	_intakeOne.SetID(1)
+	_intakeOne.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_intakeOne.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intakeOne.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intakeOne.SetStatus("pending")
+	_intakeOne.SetAttempts(1)
+	_intakeOne.SetAvailable(1563474076)
+	_intakeOne.SetCreated(1563474076)
+
+	_intakeTwo := testWebhookIntake()
+	_intakeTwo.SetID(2)
+	_intakeTwo.SetSource("d8da1302-07d6-11ea-882f-4893bca275b8")
+	_intakeTwo.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intakeTwo.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intakeTwo.SetStatus("pending")
+	_intakeTwo.SetAttempts(1)
+	_intakeTwo.SetAvailable(1563474076)
+	_intakeTwo.SetCreated(1563474076)
+
+	_intakeLater := testWebhookIntake()
+	_intakeLater.SetID(3)
+	_intakeLater.SetSource("e8da1302-07d6-11ea-882f-4893bca275b8")
+	_intakeLater.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intakeLater.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intakeLater.SetStatus("pending")
+	_intakeLater.SetAttempts(1)
+	_intakeLater.SetAvailable(1563474176)
+	_intakeLater.SetCreated(1563474076)
+
+	_intakeFailed := testWebhookIntake()
+	_intakeFailed.SetID(4)
+	_intakeFailed.SetSource("f8da1302-07d6-11ea-882f-4893bca275b8")
+	_intakeFailed.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intakeFailed.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intakeFailed.SetStatus("failed")
+	_intakeFailed.SetAttempts(5)
+	_intakeFailed.SetAvailable(1563474076)
+	_intakeFailed.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "source", "header", "payload", "status", "attempts", "error", "available", "created"}).
+		AddRow(1, "c8da1302-07d6-11ea-882f-4893bca275b8", `{"X-Github-Event":["push"]}`, `{"ref":"refs/heads/main"}`, "pending", 1, "", 1563474076, 1563474076).
+		AddRow(2, "d8da1302-07d6-11ea-882f-4893bca275b8", `{"X-Github-Event":["push"]}`, `{"ref":"refs/heads/main"}`, "pending", 1, "", 1563474076, 1563474076)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "webhook_intakes" WHERE status = $1 AND available <= $2 ORDER BY id LIMIT 10`).
+		WithArgs("pending", 1563474086).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	for _, intake := range []*api.WebhookIntake{_intakeOne, _intakeTwo, _intakeLater, _intakeFailed} {
+		err := _sqlite.CreateWebhookIntake(context.TODO(), intake)
+		if err != nil {
+			t.Errorf("unable to create test webhook intake for sqlite: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.WebhookIntake
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.WebhookIntake{_intakeOne, _intakeTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.WebhookIntake{_intakeOne, _intakeTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListAvailableWebhookIntakes(context.TODO(), 1563474086, 10)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListAvailableWebhookIntakes for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListAvailableWebhookIntakes for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListAvailableWebhookIntakes for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
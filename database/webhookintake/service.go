@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// WebhookIntakeService represents the Vela interface for webhook intake
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type WebhookIntakeService interface {
+	// WebhookIntake Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateWebhookIntakeIndexes defines a function that creates the indexes for the webhook_intakes table.
+	CreateWebhookIntakeIndexes(context.Context) error
+	// CreateWebhookIntakeTable defines a function that creates the webhook_intakes table.
+	CreateWebhookIntakeTable(context.Context, string) error
+
+	// WebhookIntake Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// ClaimWebhookIntake defines a function that updates an existing webhook intake
+	// only when it was last available at the time provided.
+	ClaimWebhookIntake(context.Context, *api.WebhookIntake, int64) (bool, error)
+	// CountWebhookIntakes defines a function that gets the count of webhook intakes by status.
+	CountWebhookIntakes(context.Context, string) (int64, error)
+	// CreateWebhookIntake defines a function that creates a new webhook intake.
+	CreateWebhookIntake(context.Context, *api.WebhookIntake) error
+	// DeleteWebhookIntake defines a function that deletes an existing webhook intake.
+	DeleteWebhookIntake(context.Context, *api.WebhookIntake) error
+	// ListAvailableWebhookIntakes defines a function that gets a list
+	// of pending webhook intakes available at the time provided.
+	ListAvailableWebhookIntakes(context.Context, int64, int) ([]*api.WebhookIntake, error)
+	// UpdateWebhookIntake defines a function that updates an existing webhook intake.
+	UpdateWebhookIntake(context.Context, *api.WebhookIntake) error
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableWebhookIntake represents the name of the table for webhook intakes.
+	TableWebhookIntake = "webhook_intakes"
+
+	// CreatePostgresTable represents a query to create the Postgres webhook_intakes table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+webhook_intakes (
+	id            SERIAL PRIMARY KEY,
+	source        VARCHAR(250),
+	header        TEXT,
+	payload       TEXT,
+	status        VARCHAR(20),
+	attempts      INTEGER,
+	error         VARCHAR(1000),
+	available     INTEGER,
+	created       INTEGER
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite webhook_intakes table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+webhook_intakes (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	source        TEXT,
+	header        TEXT,
+	payload       TEXT,
+	status        TEXT,
+	attempts      INTEGER,
+	error         TEXT,
+	available     INTEGER,
+	created       INTEGER
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL webhook_intakes table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+webhook_intakes (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	source        VARCHAR(250),
+	header        TEXT,
+	payload       TEXT,
+	status        VARCHAR(20),
+	attempts      INTEGER,
+	error         VARCHAR(1000),
+	available     INTEGER,
+	created       INTEGER,
+	INDEX webhook_intakes_status_available (status, available)
+);
+`
+)
+
+// CreateWebhookIntakeTable creates the webhook_intakes table in the database.
+func (e *engine) CreateWebhookIntakeTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating webhook_intakes table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the webhook_intakes table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the webhook_intakes table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the webhook_intakes table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateWebhookIntake updates an existing webhook intake in the database.
+func (e *engine) UpdateWebhookIntake(ctx context.Context, w *api.WebhookIntake) error {
+	e.logger.WithFields(logrus.Fields{
+		"source": w.GetSource(),
+	}).Tracef("updating webhook intake %d in the database", w.GetID())
+
+	// cast the API type to database type
+	intake := types.WebhookIntakeFromAPI(w)
+
+	// validate the necessary fields are populated
+	err := intake.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableWebhookIntake).
+		Save(intake).
+		Error
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package webhookintake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWebhookIntake_Engine_UpdateWebhookIntake(t *testing.T) {
+	// setup types
+	_intake := testWebhookIntake()
+	_intake.SetID(1)
+	_intake.SetSource("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_intake.SetHeader(`{"X-Github-Event":["push"]}`)
+	_intake.SetPayload(`{"ref":"refs/heads/main"}`)
+	_intake.SetStatus("pending")
+	_intake.SetAttempts(1)
+	_intake.SetAvailable(1563474076)
+	_intake.SetCreated(1563474076)
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "webhook_intakes"
+SET "source"=$1,"header"=$2,"payload"=$3,"status"=$4,"attempts"=$5,"error"=$6,"available"=$7,"created"=$8
+WHERE "id" = $9`).
+		WithArgs("c8da1302-07d6-11ea-882f-4893bca275b8", `{"X-Github-Event":["push"]}`, `{"ref":"refs/heads/main"}`, "failed", 5, "received status 500", 1563474076, 1563474076, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWebhookIntake(context.TODO(), _intake)
+	if err != nil {
+		t.Errorf("unable to create test webhook intake for sqlite: %v", err)
+	}
+
+	_intake.SetStatus("failed")
+	_intake.SetAttempts(5)
+	_intake.SetError("received status 500")
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.UpdateWebhookIntake(context.TODO(), _intake)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateWebhookIntake for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateWebhookIntake for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@
 package worker
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -37,12 +38,12 @@ func TestWorker_Engine_CountWorkers(t *testing.T) {
 	_sqlite := testSqlite(t)
 	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
 
-	err := _sqlite.CreateWorker(_workerOne)
+	err := _sqlite.CreateWorker(context.TODO(), _workerOne)
 	if err != nil {
 		t.Errorf("unable to create test worker for sqlite: %v", err)
 	}
 
-	err = _sqlite.CreateWorker(_workerTwo)
+	err = _sqlite.CreateWorker(context.TODO(), _workerTwo)
 	if err != nil {
 		t.Errorf("unable to create test worker for sqlite: %v", err)
 	}
@@ -71,7 +72,7 @@ func TestWorker_Engine_CountWorkers(t *testing.T) {
 	// run tests
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got, err := test.database.CountWorkers()
+			got, err := test.database.CountWorkers(context.TODO())
 
 			if test.failure {
 				if err == nil {
@@ -4,6 +4,12 @@
 
 package worker
 
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
 const (
 	// CreateHostnameAddressIndex represents a query to create an
 	// index on the workers table for the hostname and address columns.
@@ -16,9 +22,14 @@ ON workers (hostname, address);
 )
 
 // CreateWorkerIndexes creates the indexes for the workers table in the database.
-func (e *engine) CreateWorkerIndexes() error {
+func (e *engine) CreateWorkerIndexes(ctx context.Context) error {
 	e.logger.Tracef("creating indexes for workers table in the database")
 
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
 	// create the hostname and address columns index for the workers table
-	return e.client.Exec(CreateHostnameAddressIndex).Error
+	return e.client.WithContext(ctx).Exec(CreateHostnameAddressIndex).Error
 }
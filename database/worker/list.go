@@ -5,13 +5,15 @@
 package worker
 
 import (
+	"context"
+
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/database"
 	"github.com/go-vela/types/library"
 )
 
 // ListWorkers gets a list of all workers from the database.
-func (e *engine) ListWorkers() ([]*library.Worker, error) {
+func (e *engine) ListWorkers(ctx context.Context) ([]*library.Worker, error) {
 	e.logger.Trace("listing all workers from the database")
 
 	// variables to store query results and return value
@@ -20,7 +22,7 @@ func (e *engine) ListWorkers() ([]*library.Worker, error) {
 	workers := []*library.Worker{}
 
 	// count the results
-	count, err := e.CountWorkers()
+	count, err := e.CountWorkers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +33,7 @@ func (e *engine) ListWorkers() ([]*library.Worker, error) {
 	}
 
 	// send query to the database and store result in variable
-	err = e.client.
+	err = e.client.WithContext(ctx).
 		Table(constants.TableWorker).
 		Find(&w).
 		Error
@@ -5,6 +5,8 @@
 package worker
 
 import (
+	"context"
+
 	"github.com/go-vela/types/library"
 )
 
@@ -18,26 +20,26 @@ type WorkerService interface {
 	// https://en.wikipedia.org/wiki/Data_definition_language
 
 	// CreateWorkerIndexes defines a function that creates the indexes for the workers table.
-	CreateWorkerIndexes() error
+	CreateWorkerIndexes(context.Context) error
 	// CreateWorkerTable defines a function that creates the workers table.
-	CreateWorkerTable(string) error
+	CreateWorkerTable(context.Context, string) error
 
 	// Worker Data Manipulation Language Functions
 	//
 	// https://en.wikipedia.org/wiki/Data_manipulation_language
 
 	// CountWorkers defines a function that gets the count of all workers.
-	CountWorkers() (int64, error)
+	CountWorkers(context.Context) (int64, error)
 	// CreateWorker defines a function that creates a new worker.
-	CreateWorker(*library.Worker) error
+	CreateWorker(context.Context, *library.Worker) error
 	// DeleteWorker defines a function that deletes an existing worker.
-	DeleteWorker(*library.Worker) error
+	DeleteWorker(context.Context, *library.Worker) error
 	// GetWorker defines a function that gets a worker by ID.
-	GetWorker(int64) (*library.Worker, error)
+	GetWorker(context.Context, int64) (*library.Worker, error)
 	// GetWorkerForHostname defines a function that gets a worker by hostname.
-	GetWorkerForHostname(string) (*library.Worker, error)
+	GetWorkerForHostname(context.Context, string) (*library.Worker, error)
 	// ListWorkers defines a function that gets a list of all workers.
-	ListWorkers() ([]*library.Worker, error)
+	ListWorkers(context.Context) ([]*library.Worker, error)
 	// UpdateWorker defines a function that updates an existing worker.
-	UpdateWorker(*library.Worker) error
+	UpdateWorker(context.Context, *library.Worker) error
 }
@@ -5,6 +5,9 @@
 package worker
 
 import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/types/constants"
 )
 
@@ -39,22 +42,42 @@ workers (
 	build_limit     INTEGER,
 	UNIQUE(hostname)
 );
+`
+
+	// CreateMySQLTable represents a query to create the MySQL workers table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+workers (
+	id               INTEGER PRIMARY KEY AUTO_INCREMENT,
+	hostname         VARCHAR(250),
+	address          VARCHAR(250),
+	routes           VARCHAR(1000),
+	active           BOOLEAN,
+	last_checked_in  INTEGER,
+	build_limit      INTEGER,
+	UNIQUE(hostname),
+	INDEX workers_hostname_address (hostname, address)
+);
 `
 )
 
 // CreateWorkerTable creates the workers table in the database.
-func (e *engine) CreateWorkerTable(driver string) error {
+func (e *engine) CreateWorkerTable(ctx context.Context, driver string) error {
 	e.logger.Tracef("creating workers table in the database")
 
 	// handle the driver provided to create the table
 	switch driver {
 	case constants.DriverPostgres:
 		// create the workers table for Postgres
-		return e.client.Exec(CreatePostgresTable).Error
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the workers table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
 	case constants.DriverSqlite:
 		fallthrough
 	default:
 		// create the workers table for Sqlite
-		return e.client.Exec(CreateSqliteTable).Error
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
 	}
 }
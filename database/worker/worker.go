@@ -5,6 +5,7 @@
 package worker
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-vela/types/constants"
@@ -59,19 +60,19 @@ func New(opts ...EngineOpt) (*engine, error) {
 
 	// check if we should skip creating worker database objects
 	if e.config.SkipCreation {
-		e.logger.Warning("skipping creation of workers table and indexes in the database")
+		e.logger.Trace("skipping creation of workers table and indexes in the database")
 
 		return e, nil
 	}
 
 	// create the workers table
-	err := e.CreateWorkerTable(e.client.Config.Dialector.Name())
+	err := e.CreateWorkerTable(context.Background(), e.client.Config.Dialector.Name())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create %s table: %w", constants.TableWorker, err)
 	}
 
 	// create the indexes for the workers table
-	err = e.CreateWorkerIndexes()
+	err = e.CreateWorkerIndexes(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("unable to create indexes for %s table: %w", constants.TableWorker, err)
 	}
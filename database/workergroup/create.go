@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateWorkerGroup creates a new worker group in the database.
+func (e *engine) CreateWorkerGroup(ctx context.Context, g *api.WorkerGroup) error {
+	e.logger.WithFields(logrus.Fields{
+		"group": g.GetName(),
+	}).Tracef("creating worker group %s in the database", g.GetName())
+
+	// cast the API type to database type
+	group := types.WorkerGroupFromAPI(g)
+
+	// validate the necessary fields are populated
+	err := group.Validate()
+	if err != nil {
+		return err
+	}
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableWorkerGroup).
+		Create(group).
+		Error
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWorkerGroup_Engine_CreateWorkerGroup(t *testing.T) {
+	// setup types
+	_group := testWorkerGroup()
+	_group.SetID(1)
+	_group.SetName("compliance")
+	_group.SetRoute("compliance")
+	_group.SetBuildLimit(10)
+	_group.SetOrgs([]string{"github"})
+	_group.SetActive(true)
+	_group.SetCreatedAt(1)
+	_group.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`INSERT INTO "worker_groups"
+("name","description","route","build_limit","orgs","repos","active","created_at","created_by","updated_at","updated_by","id")
+VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12) RETURNING "id"`).
+		WithArgs("compliance", nil, "compliance", 10, `{"github"}`, nil, true, 1, "octocat", nil, nil, 1).
+		WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.database.CreateWorkerGroup(context.TODO(), _group)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("CreateWorkerGroup for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("CreateWorkerGroup for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
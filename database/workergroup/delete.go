@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// DeleteWorkerGroup deletes an existing worker group from the database.
+func (e *engine) DeleteWorkerGroup(ctx context.Context, g *api.WorkerGroup) error {
+	e.logger.WithFields(logrus.Fields{
+		"group": g.GetName(),
+	}).Tracef("deleting worker group %s from the database", g.GetName())
+
+	// cast the API type to database type
+	group := types.WorkerGroupFromAPI(g)
+
+	// send query to the database
+	return e.client.WithContext(ctx).
+		Table(TableWorkerGroup).
+		Delete(group).
+		Error
+}
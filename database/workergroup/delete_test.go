@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWorkerGroup_Engine_DeleteWorkerGroup(t *testing.T) {
+	// setup types
+	_group := testWorkerGroup()
+	_group.SetID(1)
+	_group.SetName("compliance")
+	_group.SetRoute("compliance")
+	_group.SetBuildLimit(10)
+	_group.SetOrgs([]string{"github"})
+	_group.SetActive(true)
+	_group.SetCreatedAt(1)
+	_group.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`DELETE FROM "worker_groups" WHERE "worker_groups"."id" = $1`).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWorkerGroup(context.TODO(), _group)
+	if err != nil {
+		t.Errorf("unable to create test worker group for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.DeleteWorkerGroup(context.TODO(), _group)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("DeleteWorkerGroup for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("DeleteWorkerGroup for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
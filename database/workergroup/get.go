@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// GetWorkerGroup gets a worker group by ID from the database.
+func (e *engine) GetWorkerGroup(ctx context.Context, id int64) (*api.WorkerGroup, error) {
+	e.logger.Tracef("getting worker group %d from the database", id)
+
+	// variable to store query results
+	g := new(types.WorkerGroup)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableWorkerGroup).
+		Where("id = ?", id).
+		Take(g).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return g.ToAPI(), nil
+}
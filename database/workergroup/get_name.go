@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+	"github.com/sirupsen/logrus"
+)
+
+// GetWorkerGroupForName gets a worker group by name from the database.
+func (e *engine) GetWorkerGroupForName(ctx context.Context, name string) (*api.WorkerGroup, error) {
+	e.logger.WithFields(logrus.Fields{
+		"group": name,
+	}).Tracef("getting worker group %s from the database", name)
+
+	// variable to store query results
+	g := new(types.WorkerGroup)
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableWorkerGroup).
+		Where("name = ?", name).
+		Take(g).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return g.ToAPI(), nil
+}
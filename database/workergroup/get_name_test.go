@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWorkerGroup_Engine_GetWorkerGroupForName(t *testing.T) {
+	// setup types
+	_group := testWorkerGroup()
+	_group.SetID(1)
+	_group.SetName("compliance")
+	_group.SetRoute("compliance")
+	_group.SetBuildLimit(10)
+	_group.SetOrgs([]string{"github"})
+	_group.SetActive(true)
+	_group.SetCreatedAt(1)
+	_group.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "name", "description", "route", "build_limit", "orgs", "repos", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "compliance", "", "compliance", 10, `{"github"}`, nil, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "worker_groups" WHERE name = $1 LIMIT 1`).WithArgs("compliance").WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWorkerGroup(context.TODO(), _group)
+	if err != nil {
+		t.Errorf("unable to create test worker group for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     *api.WorkerGroup
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     _group,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     _group,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.GetWorkerGroupForName(context.TODO(), "compliance")
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetWorkerGroupForName for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetWorkerGroupForName for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetWorkerGroupForName for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
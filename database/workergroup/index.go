@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+)
+
+const (
+	// CreateRouteIndex represents a query to create an
+	// index on the worker_groups table for the route column.
+	CreateRouteIndex = `
+CREATE INDEX
+IF NOT EXISTS
+worker_groups_route
+ON worker_groups (route);
+`
+)
+
+// CreateWorkerGroupIndexes creates the indexes for the worker_groups table in the database.
+func (e *engine) CreateWorkerGroupIndexes(ctx context.Context) error {
+	e.logger.Tracef("creating indexes for worker_groups table in the database")
+
+	// the indexes for MySQL are created with the table
+	if e.client.Config.Dialector.Name() == types.DriverMySQL {
+		return nil
+	}
+
+	// create the route column index for the worker_groups table
+	return e.client.WithContext(ctx).Exec(CreateRouteIndex).Error
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/types"
+)
+
+// ListWorkerGroups gets a list of all worker groups from the database.
+func (e *engine) ListWorkerGroups(ctx context.Context) ([]*api.WorkerGroup, error) {
+	e.logger.Trace("listing all worker groups from the database")
+
+	// variables to store query results and return value
+	g := new([]types.WorkerGroup)
+	groups := []*api.WorkerGroup{}
+
+	// send query to the database and store result in variable
+	err := e.client.WithContext(ctx).
+		Table(TableWorkerGroup).
+		Order("name").
+		Find(&g).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate through all query results
+	for _, group := range *g {
+		// https://golang.org/doc/faq#closures_and_goroutines
+		tmp := group
+
+		// convert query result to API type
+		groups = append(groups, tmp.ToAPI())
+	}
+
+	return groups, nil
+}
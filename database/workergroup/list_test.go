@@ -0,0 +1,106 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestWorkerGroup_Engine_ListWorkerGroups(t *testing.T) {
+	// setup types
+	_groupOne := testWorkerGroup()
+	_groupOne.SetID(1)
+	_groupOne.SetName("compliance")
+	_groupOne.SetRoute("compliance")
+	_groupOne.SetBuildLimit(10)
+	_groupOne.SetOrgs([]string{"github"})
+	_groupOne.SetActive(true)
+	_groupOne.SetCreatedAt(1)
+	_groupOne.SetCreatedBy("octocat")
+
+	_groupTwo := testWorkerGroup()
+	_groupTwo.SetID(2)
+	_groupTwo.SetName("general")
+	_groupTwo.SetRoute("general")
+	_groupTwo.SetBuildLimit(10)
+	_groupTwo.SetOrgs([]string{"octocat"})
+	_groupTwo.SetActive(true)
+	_groupTwo.SetCreatedAt(1)
+	_groupTwo.SetCreatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// create expected result in mock
+	_rows := sqlmock.NewRows(
+		[]string{"id", "name", "description", "route", "build_limit", "orgs", "repos", "active", "created_at", "created_by", "updated_at", "updated_by"}).
+		AddRow(1, "compliance", "", "compliance", 10, `{"github"}`, nil, true, 1, "octocat", 0, "").
+		AddRow(2, "general", "", "general", 10, `{"octocat"}`, nil, true, 1, "octocat", 0, "")
+
+	// ensure the mock expects the query
+	_mock.ExpectQuery(`SELECT * FROM "worker_groups" ORDER BY name`).WillReturnRows(_rows)
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWorkerGroup(context.TODO(), _groupOne)
+	if err != nil {
+		t.Errorf("unable to create test worker group for sqlite: %v", err)
+	}
+
+	err = _sqlite.CreateWorkerGroup(context.TODO(), _groupTwo)
+	if err != nil {
+		t.Errorf("unable to create test worker group for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+		want     []*api.WorkerGroup
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+			want:     []*api.WorkerGroup{_groupOne, _groupTwo},
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+			want:     []*api.WorkerGroup{_groupOne, _groupTwo},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.database.ListWorkerGroups(context.TODO())
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ListWorkerGroups for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ListWorkerGroups for %s returned err: %v", test.name, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ListWorkerGroups for %s is %v, want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
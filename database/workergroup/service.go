@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// WorkerGroupService represents the Vela interface for worker group
+// functions with the supported Database backends.
+//
+//nolint:revive // ignore name stutter
+type WorkerGroupService interface {
+	// WorkerGroup Data Definition Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_definition_language
+
+	// CreateWorkerGroupIndexes defines a function that creates the indexes for the worker_groups table.
+	CreateWorkerGroupIndexes(context.Context) error
+	// CreateWorkerGroupTable defines a function that creates the worker_groups table.
+	CreateWorkerGroupTable(context.Context, string) error
+
+	// WorkerGroup Data Manipulation Language Functions
+	//
+	// https://en.wikipedia.org/wiki/Data_manipulation_language
+
+	// CreateWorkerGroup defines a function that creates a new worker group.
+	CreateWorkerGroup(context.Context, *api.WorkerGroup) error
+	// DeleteWorkerGroup defines a function that deletes an existing worker group.
+	DeleteWorkerGroup(context.Context, *api.WorkerGroup) error
+	// GetWorkerGroup defines a function that gets a worker group by ID.
+	GetWorkerGroup(context.Context, int64) (*api.WorkerGroup, error)
+	// GetWorkerGroupForName defines a function that gets a worker group by name.
+	GetWorkerGroupForName(context.Context, string) (*api.WorkerGroup, error)
+	// ListWorkerGroups defines a function that gets a list of all worker groups.
+	ListWorkerGroups(context.Context) ([]*api.WorkerGroup, error)
+	// UpdateWorkerGroup defines a function that updates an existing worker group.
+	UpdateWorkerGroup(context.Context, *api.WorkerGroup) error
+}
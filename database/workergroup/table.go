@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+
+	"github.com/go-vela/server/database/types"
+	"github.com/go-vela/types/constants"
+)
+
+const (
+	// TableWorkerGroup represents the name of the table for worker groups.
+	TableWorkerGroup = "worker_groups"
+
+	// CreatePostgresTable represents a query to create the Postgres worker_groups table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+worker_groups (
+	id            SERIAL PRIMARY KEY,
+	name          VARCHAR(250),
+	description   VARCHAR(1000),
+	route         VARCHAR(250),
+	build_limit   INTEGER,
+	orgs          VARCHAR(1000),
+	repos         VARCHAR(1000),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(name)
+);
+`
+
+	// CreateSqliteTable represents a query to create the Sqlite worker_groups table.
+	CreateSqliteTable = `
+CREATE TABLE
+IF NOT EXISTS
+worker_groups (
+	id            INTEGER PRIMARY KEY AUTOINCREMENT,
+	name          TEXT,
+	description   TEXT,
+	route         TEXT,
+	build_limit   INTEGER,
+	orgs          TEXT,
+	repos         TEXT,
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    TEXT,
+	updated_at    INTEGER,
+	updated_by    TEXT,
+	UNIQUE(name)
+);
+`
+
+	// CreateMySQLTable represents a query to create the MySQL worker_groups table.
+	CreateMySQLTable = `
+CREATE TABLE
+IF NOT EXISTS
+worker_groups (
+	id            INTEGER PRIMARY KEY AUTO_INCREMENT,
+	name          VARCHAR(250),
+	description   VARCHAR(1000),
+	route         VARCHAR(250),
+	build_limit   INTEGER,
+	orgs          VARCHAR(1000),
+	repos         VARCHAR(1000),
+	active        BOOLEAN,
+	created_at    INTEGER,
+	created_by    VARCHAR(250),
+	updated_at    INTEGER,
+	updated_by    VARCHAR(250),
+	UNIQUE(name),
+	INDEX worker_groups_route (route)
+);
+`
+)
+
+// CreateWorkerGroupTable creates the worker_groups table in the database.
+func (e *engine) CreateWorkerGroupTable(ctx context.Context, driver string) error {
+	e.logger.Tracef("creating worker_groups table in the database")
+
+	// handle the driver provided to create the table
+	switch driver {
+	case constants.DriverPostgres:
+		// create the worker_groups table for Postgres
+		return e.client.WithContext(ctx).Exec(CreatePostgresTable).Error
+	case types.DriverMySQL:
+		// create the worker_groups table for MySQL
+		return e.client.WithContext(ctx).Exec(CreateMySQLTable).Error
+	case constants.DriverSqlite:
+		fallthrough
+	default:
+		// create the worker_groups table for Sqlite
+		return e.client.WithContext(ctx).Exec(CreateSqliteTable).Error
+	}
+}
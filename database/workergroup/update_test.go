@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package workergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestWorkerGroup_Engine_UpdateWorkerGroup(t *testing.T) {
+	// setup types
+	_group := testWorkerGroup()
+	_group.SetID(1)
+	_group.SetName("compliance")
+	_group.SetRoute("compliance")
+	_group.SetBuildLimit(10)
+	_group.SetOrgs([]string{"github"})
+	_group.SetActive(true)
+	_group.SetCreatedAt(1)
+	_group.SetCreatedBy("octocat")
+	_group.SetUpdatedAt(2)
+	_group.SetUpdatedBy("octocat")
+
+	_postgres, _mock := testPostgres(t)
+	defer func() { _sql, _ := _postgres.client.DB(); _sql.Close() }()
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(`UPDATE "worker_groups"
+SET "name"=$1,"description"=$2,"route"=$3,"build_limit"=$4,"orgs"=$5,"repos"=$6,"active"=$7,"created_at"=$8,"created_by"=$9,"updated_at"=$10,"updated_by"=$11
+WHERE "id" = $12`).
+		WithArgs("compliance", nil, "compliance", 10, `{"github"}`, nil, true, 1, "octocat", 2, "octocat", 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	_sqlite := testSqlite(t)
+	defer func() { _sql, _ := _sqlite.client.DB(); _sql.Close() }()
+
+	err := _sqlite.CreateWorkerGroup(context.TODO(), _group)
+	if err != nil {
+		t.Errorf("unable to create test worker group for sqlite: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure  bool
+		name     string
+		database *engine
+	}{
+		{
+			failure:  false,
+			name:     "postgres",
+			database: _postgres,
+		},
+		{
+			failure:  false,
+			name:     "sqlite3",
+			database: _sqlite,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err = test.database.UpdateWorkerGroup(context.TODO(), _group)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("UpdateWorkerGroup for %s should have returned err", test.name)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("UpdateWorkerGroup for %s returned err: %v", test.name, err)
+			}
+		})
+	}
+}
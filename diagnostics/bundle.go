@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"runtime"
+	"runtime/pprof"
+	"time"
+
+	"github.com/go-vela/server/version"
+)
+
+// Runtime represents the statistics for the server runtime.
+type Runtime struct {
+	// seconds since the server started
+	Uptime int64 `json:"uptime"`
+	// number of goroutines running
+	Goroutines int `json:"goroutines"`
+	// number of logical cpus available
+	CPUs int `json:"cpus"`
+	// bytes of allocated heap objects
+	HeapAlloc uint64 `json:"heap_alloc"`
+	// bytes in in-use heap spans
+	HeapInuse uint64 `json:"heap_inuse"`
+	// bytes of memory obtained from the os
+	Sys uint64 `json:"sys"`
+	// number of completed garbage collection cycles
+	NumGC uint32 `json:"num_gc"`
+}
+
+// Bundle writes the diagnostics bundle to the writer as a zip archive
+// with the summary of the configuration, the recent errors, the health
+// of the dependencies, the version and runtime statistics for the server
+// along with the goroutine and heap profiles.
+//
+// ErrRunning is returned when another profile or bundle is being captured.
+func (d *Collector) Bundle(ctx context.Context, w io.Writer) error {
+	if !d.running.TryLock() {
+		return ErrRunning
+	}
+	defer d.running.Unlock()
+
+	stats := new(runtime.MemStats)
+	runtime.ReadMemStats(stats)
+
+	r := &Runtime{
+		Uptime:     int64(time.Since(d.started).Seconds()),
+		Goroutines: runtime.NumGoroutine(),
+		CPUs:       runtime.NumCPU(),
+		HeapAlloc:  stats.HeapAlloc,
+		HeapInuse:  stats.HeapInuse,
+		Sys:        stats.Sys,
+		NumGC:      stats.NumGC,
+	}
+
+	z := zip.NewWriter(w)
+
+	files := []struct {
+		name  string
+		write func(io.Writer) error
+	}{
+		{name: "config.json", write: writeJSON(d.config.Config)},
+		{name: "errors.json", write: writeJSON(d.Errors())},
+		{name: "health.json", write: writeJSON(d.Health(ctx))},
+		{name: "version.json", write: writeJSON(version.New())},
+		{name: "runtime.json", write: writeJSON(r)},
+		{name: "goroutine.txt", write: writeProfile("goroutine", 2)},
+		{name: "heap.pb.gz", write: writeProfile("heap", 0)},
+	}
+
+	for _, file := range files {
+		f, err := z.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = file.write(f)
+		if err != nil {
+			return err
+		}
+	}
+
+	return z.Close()
+}
+
+// writeJSON is a helper function to write the value as indented JSON.
+func writeJSON(v interface{}) func(io.Writer) error {
+	return func(w io.Writer) error {
+		e := json.NewEncoder(w)
+		e.SetIndent("", "  ")
+
+		return e.Encode(v)
+	}
+}
+
+// writeProfile is a helper function to write the runtime profile.
+func writeProfile(name string, debug int) func(io.Writer) error {
+	return func(w io.Writer) error {
+		return pprof.Lookup(name).WriteTo(w, debug)
+	}
+}
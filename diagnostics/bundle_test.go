@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDiagnostics_Collector_Bundle(t *testing.T) {
+	// setup types
+	d, err := New(
+		WithConfig(map[string]string{
+			"log-level":   "info",
+			"vela-secret": "superSecret",
+		}),
+	)
+	if err != nil {
+		t.Errorf("unable to create collector: %v", err)
+	}
+
+	want := []string{
+		"config.json",
+		"errors.json",
+		"goroutine.txt",
+		"health.json",
+		"heap.pb.gz",
+		"runtime.json",
+		"version.json",
+	}
+
+	// run test
+	buffer := new(bytes.Buffer)
+
+	err = d.Bundle(context.TODO(), buffer)
+	if err != nil {
+		t.Errorf("Bundle returned err: %v", err)
+	}
+
+	z, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatalf("unable to read bundle: %v", err)
+	}
+
+	got := []string{}
+
+	for _, f := range z.File {
+		got = append(got, f.Name)
+	}
+
+	sort.Strings(got)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Bundle files are %v, want %v", got, want)
+	}
+
+	// ensure the secrets are redacted from the configuration
+	f, err := z.Open("config.json")
+	if err != nil {
+		t.Fatalf("unable to open config.json: %v", err)
+	}
+
+	data, _ := io.ReadAll(f)
+
+	config := map[string]string{}
+
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		t.Errorf("unable to unmarshal config.json: %v", err)
+	}
+
+	if config["vela-secret"] != Redacted || config["log-level"] != "info" {
+		t.Errorf("Bundle config is %v", config)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the collector in the context.
+const key = "diagnostics"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the collector
+// associated with this context.
+func FromContext(c context.Context) *Collector {
+	// get collector value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast collector value to expected Collector type
+	d, ok := v.(*Collector)
+	if !ok {
+		return nil
+	}
+
+	return d
+}
+
+// ToContext adds the collector to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, d *Collector) {
+	c.Set(key, d)
+}
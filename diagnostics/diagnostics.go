@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+)
+
+// ErrRunning defines the error type when a profile or bundle
+// is requested while another one is being captured.
+var ErrRunning = errors.New("diagnostics already being captured")
+
+type (
+	// config represents the settings required to create the collector.
+	config struct {
+		// specifies whether the diagnostics can be captured
+		Enabled bool
+		// specifies the number of recent errors to keep
+		Errors int
+		// specifies the max duration to capture the cpu profile or execution trace for
+		MaxDuration time.Duration
+		// specifies the summary of the server configuration
+		Config map[string]string
+	}
+
+	// Collector represents the functionality for capturing runtime
+	// profiles and the diagnostics bundle for the server.
+	Collector struct {
+		// collector configuration settings
+		config *config
+
+		// database service checked for the health of the dependencies
+		database database.Service
+
+		// queue service checked for the health of the dependencies
+		queue queue.Service
+
+		// time the collector was created to capture the uptime
+		started time.Time
+
+		// mutex used to prevent capturing concurrent profiles
+		running sync.Mutex
+
+		// mutex used to guard the recent errors
+		mutex sync.Mutex
+
+		// recent errors logged by the server, oldest first
+		errors []*Error
+	}
+)
+
+// New creates and returns a collector for runtime diagnostics.
+func New(opts ...Opt) (*Collector, error) {
+	// create new collector
+	d := new(Collector)
+
+	// create new fields
+	d.started = time.Now().UTC()
+	d.errors = []*Error{}
+	d.config = &config{
+		Errors:      100,
+		MaxDuration: 30 * time.Second,
+		Config:      map[string]string{},
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(d)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return d, nil
+}
+
+// Enabled returns whether the collector is
+// configured to capture the diagnostics.
+func (d *Collector) Enabled() bool {
+	return d.config.Enabled
+}
+
+// MaxDuration returns the max duration to capture
+// the cpu profile or execution trace for.
+func (d *Collector) MaxDuration() time.Duration {
+	return d.config.MaxDuration
+}
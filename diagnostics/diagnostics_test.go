@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiagnostics_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		opts    []Opt
+	}{
+		{
+			failure: false,
+			opts: []Opt{
+				WithEnabled(true),
+				WithErrors(10),
+				WithMaxDuration(time.Minute),
+				WithConfig(map[string]string{"vela-secret": "foo"}),
+			},
+		},
+		{
+			failure: true,
+			opts:    []Opt{WithErrors(-1)},
+		},
+		{
+			failure: true,
+			opts:    []Opt{WithMaxDuration(0)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := New(test.opts...)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+
+		if !got.Enabled() {
+			t.Errorf("New is not enabled")
+		}
+
+		if got.MaxDuration() != time.Minute {
+			t.Errorf("New max duration is %v, want %v", got.MaxDuration(), time.Minute)
+		}
+
+		if got.config.Config["vela-secret"] != Redacted {
+			t.Errorf("New config is %v, want redacted", got.config.Config)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package diagnostics provides the ability for Vela to capture
+// runtime profiles and a diagnostics bundle, with a summary of the
+// configuration, the recent errors and the health of the services
+// the server depends on, to debug incidents in production.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/diagnostics"
+package diagnostics
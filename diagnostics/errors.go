@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Error represents an error logged by the server.
+type Error struct {
+	// unix time the error was logged at
+	Time int64 `json:"time"`
+	// level the error was logged at
+	Level string `json:"level"`
+	// message logged for the error
+	Message string `json:"message"`
+	// fields logged with the error, redacted with Redact
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// Levels returns the log levels the collector records
+// errors for, so it can be added as a hook to the logger.
+func (d *Collector) Levels() []logrus.Level {
+	return []logrus.Level{
+		logrus.PanicLevel,
+		logrus.FatalLevel,
+		logrus.ErrorLevel,
+	}
+}
+
+// Fire records the log entry as a recent error, dropping
+// the oldest error once the number of errors kept is reached.
+func (d *Collector) Fire(entry *logrus.Entry) error {
+	if d.config.Errors == 0 {
+		return nil
+	}
+
+	e := &Error{
+		Time:    entry.Time.UTC().Unix(),
+		Level:   entry.Level.String(),
+		Message: entry.Message,
+	}
+
+	if len(entry.Data) > 0 {
+		e.Fields = make(map[string]string, len(entry.Data))
+
+		for name, value := range entry.Data {
+			e.Fields[name] = Redact(name, fmt.Sprint(value))
+		}
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	// drop the oldest error once the limit is reached
+	if len(d.errors) >= d.config.Errors {
+		n := copy(d.errors, d.errors[len(d.errors)-d.config.Errors+1:])
+		d.errors = d.errors[:n]
+	}
+
+	d.errors = append(d.errors, e)
+
+	return nil
+}
+
+// Errors returns the recent errors logged by the server, oldest first.
+func (d *Collector) Errors() []*Error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	errors := make([]*Error, len(d.errors))
+	copy(errors, d.errors)
+
+	return errors
+}
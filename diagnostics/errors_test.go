@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestDiagnostics_Collector_Errors(t *testing.T) {
+	// setup types
+	d, err := New(WithErrors(2))
+	if err != nil {
+		t.Errorf("unable to create collector: %v", err)
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	logger.AddHook(d)
+
+	// run test
+	for i := 1; i <= 3; i++ {
+		logger.WithField("token", "foo").Errorf("error %d", i)
+	}
+
+	logger.Warn("warning")
+
+	got := d.Errors()
+
+	if len(got) != 2 {
+		t.Fatalf("Errors returned %d errors, want 2", len(got))
+	}
+
+	for i, e := range got {
+		want := fmt.Sprintf("error %d", i+2)
+
+		if e.Message != want {
+			t.Errorf("Errors %d message is %s, want %s", i, e.Message, want)
+		}
+
+		if e.Level != "error" {
+			t.Errorf("Errors %d level is %s, want error", i, e.Level)
+		}
+
+		if e.Fields["token"] != Redacted {
+			t.Errorf("Errors %d token is %s, want redacted", i, e.Fields["token"])
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the diagnostics.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Diagnostics Flags
+
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_DIAGNOSTICS_ENABLED", "DIAGNOSTICS_ENABLED"},
+		FilePath: "/vela/diagnostics/enabled",
+		Name:     "diagnostics.enabled",
+		Usage:    "enables the admin endpoints for capturing runtime profiles and the diagnostics bundle",
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_DIAGNOSTICS_ERRORS", "DIAGNOSTICS_ERRORS"},
+		FilePath: "/vela/diagnostics/errors",
+		Name:     "diagnostics.errors",
+		Usage:    "number of recent errors logged by the server to keep for the diagnostics bundle",
+		Value:    100,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_DIAGNOSTICS_MAX_DURATION", "DIAGNOSTICS_MAX_DURATION"},
+		FilePath: "/vela/diagnostics/max_duration",
+		Name:     "diagnostics.max-duration",
+		Usage:    "max duration to capture the cpu profile or execution trace for",
+		Value:    30 * time.Second,
+	},
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"context"
+	"time"
+)
+
+// healthTimeout represents the max duration to
+// wait for each dependency to respond to a ping.
+const healthTimeout = 5 * time.Second
+
+// Dependency represents the health of a service the server depends on.
+type Dependency struct {
+	// name of the dependency
+	Name string `json:"name"`
+	// driver configured for the dependency
+	Driver string `json:"driver"`
+	// whether the dependency responded to the ping
+	Healthy bool `json:"healthy"`
+	// milliseconds the dependency took to respond to the ping
+	Latency int64 `json:"latency_ms"`
+	// error returned when pinging the dependency
+	Error string `json:"error,omitempty"`
+}
+
+// Health pings the database and queue for the
+// server and returns the health of each of them.
+func (d *Collector) Health(ctx context.Context) []*Dependency {
+	dependencies := []*Dependency{}
+
+	if d.database != nil {
+		dependencies = append(dependencies, check(ctx, "database", d.database.Driver(), d.database.PingContext))
+	}
+
+	if d.queue != nil {
+		dependencies = append(dependencies, check(ctx, "queue", d.queue.Driver(), d.queue.Ping))
+	}
+
+	return dependencies
+}
+
+// check is a helper function to capture the health of
+// the dependency from the time it took to be pinged.
+func check(ctx context.Context, name, driver string, ping func(context.Context) error) *Dependency {
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+
+	start := time.Now()
+
+	err := ping(ctx)
+
+	dependency := &Dependency{
+		Name:    name,
+		Driver:  driver,
+		Healthy: err == nil,
+		Latency: time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		dependency.Error = err.Error()
+	}
+
+	return dependency
+}
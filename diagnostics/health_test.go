@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-vela/server/database/sqlite"
+)
+
+func TestDiagnostics_Collector_Health(t *testing.T) {
+	// setup types
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	d, err := New(WithDatabase(db))
+	if err != nil {
+		t.Errorf("unable to create collector: %v", err)
+	}
+
+	// run test
+	got := d.Health(context.TODO())
+
+	if len(got) != 1 || !got[0].Healthy || got[0].Name != "database" || got[0].Driver != "sqlite3" {
+		t.Errorf("Health is %v, want healthy database", got[0])
+	}
+
+	// close the database to simulate failures to ping
+	_sql, _ := db.Sqlite.DB()
+	_sql.Close()
+
+	got = d.Health(context.TODO())
+
+	if len(got) != 1 || got[0].Healthy || len(got[0].Error) == 0 {
+		t.Errorf("Health is %v, want unhealthy database", got[0])
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/queue"
+)
+
+// Opt represents a configuration option to initialize the collector.
+type Opt func(*Collector) error
+
+// WithConfig sets the summary of the server configuration in the collector.
+//
+// The values are redacted with Redact before they are set.
+func WithConfig(summary map[string]string) Opt {
+	return func(d *Collector) error {
+		config := make(map[string]string, len(summary))
+
+		for name, value := range summary {
+			config[name] = Redact(name, value)
+		}
+
+		// set the configuration summary in the collector
+		d.config.Config = config
+
+		return nil
+	}
+}
+
+// WithDatabase sets the database service in the collector.
+func WithDatabase(db database.Service) Opt {
+	return func(d *Collector) error {
+		// set the database service in the collector
+		d.database = db
+
+		return nil
+	}
+}
+
+// WithEnabled sets whether the diagnostics can be captured in the collector.
+func WithEnabled(enabled bool) Opt {
+	return func(d *Collector) error {
+		// set the enabled setting in the collector
+		d.config.Enabled = enabled
+
+		return nil
+	}
+}
+
+// WithErrors sets the number of recent errors to keep in the collector.
+func WithErrors(errors int) Opt {
+	return func(d *Collector) error {
+		// check if the number of errors provided is negative
+		if errors < 0 {
+			return fmt.Errorf("invalid diagnostics errors provided: %d", errors)
+		}
+
+		// set the number of errors in the collector
+		d.config.Errors = errors
+
+		return nil
+	}
+}
+
+// WithMaxDuration sets the max duration to capture the
+// cpu profile or execution trace for in the collector.
+func WithMaxDuration(duration time.Duration) Opt {
+	return func(d *Collector) error {
+		// check if the duration provided is positive
+		if duration <= 0 {
+			return fmt.Errorf("invalid diagnostics max duration provided: %s", duration)
+		}
+
+		// set the max duration in the collector
+		d.config.MaxDuration = duration
+
+		return nil
+	}
+}
+
+// WithQueue sets the queue service in the collector.
+func WithQueue(q queue.Service) Opt {
+	return func(d *Collector) error {
+		// set the queue service in the collector
+		d.queue = q
+
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime/pprof"
+	"runtime/trace"
+	"time"
+)
+
+const (
+	// ProfileCPU represents the cpu profile captured over a duration.
+	ProfileCPU = "profile"
+
+	// ProfileTrace represents the execution trace captured over a duration.
+	ProfileTrace = "trace"
+)
+
+// ErrUnknownProfile defines the error type when
+// a profile is requested that isn't supported.
+var ErrUnknownProfile = errors.New("unknown profile")
+
+// profiles represents the runtime profiles, captured at a point in
+// time, that can be requested in addition to the cpu profile and trace.
+var profiles = map[string]bool{
+	"allocs":       true,
+	"block":        true,
+	"goroutine":    true,
+	"heap":         true,
+	"mutex":        true,
+	"threadcreate": true,
+}
+
+// Timed returns whether the profile is captured over a duration.
+func Timed(name string) bool {
+	return name == ProfileCPU || name == ProfileTrace
+}
+
+// Profile writes the runtime profile to the writer. The cpu profile and
+// the execution trace are captured for the duration, capped at the max
+// duration, while the other profiles are written in the pprof format,
+// or as text when debug is greater than 0.
+//
+// ErrRunning is returned when another profile or bundle is being captured.
+func (d *Collector) Profile(ctx context.Context, w io.Writer, name string, duration time.Duration, debug int) error {
+	if !Timed(name) && !profiles[name] {
+		return ErrUnknownProfile
+	}
+
+	if !d.running.TryLock() {
+		return ErrRunning
+	}
+	defer d.running.Unlock()
+
+	if duration > d.config.MaxDuration {
+		duration = d.config.MaxDuration
+	}
+
+	switch name {
+	case ProfileCPU:
+		err := pprof.StartCPUProfile(w)
+		if err != nil {
+			return err
+		}
+
+		wait(ctx, duration)
+
+		pprof.StopCPUProfile()
+
+		return nil
+	case ProfileTrace:
+		err := trace.Start(w)
+		if err != nil {
+			return err
+		}
+
+		wait(ctx, duration)
+
+		trace.Stop()
+
+		return nil
+	default:
+		return pprof.Lookup(name).WriteTo(w, debug)
+	}
+}
+
+// wait is a helper function to wait for the duration
+// or until the context is done, whichever is first.
+func wait(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package diagnostics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiagnostics_Collector_Profile(t *testing.T) {
+	// setup types
+	d, err := New(WithMaxDuration(10 * time.Millisecond))
+	if err != nil {
+		t.Errorf("unable to create collector: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		profile  string
+		duration time.Duration
+		debug    int
+		want     error
+	}{
+		{
+			name:    "goroutine as text",
+			profile: "goroutine",
+			debug:   1,
+		},
+		{
+			name:    "heap",
+			profile: "heap",
+		},
+		{
+			name:     "cpu capped at the max duration",
+			profile:  ProfileCPU,
+			duration: time.Hour,
+		},
+		{
+			name:    "unknown",
+			profile: "foo",
+			want:    ErrUnknownProfile,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := new(bytes.Buffer)
+
+			err := d.Profile(context.TODO(), buffer, test.profile, test.duration, test.debug)
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("Profile returned err %v, want %v", err, test.want)
+			}
+
+			if test.want == nil && buffer.Len() == 0 {
+				t.Errorf("Profile wrote an empty profile")
+			}
+		})
+	}
+
+	// ensure only one profile is captured at a time
+	d.running.Lock()
+	defer d.running.Unlock()
+
+	err = d.Profile(context.TODO(), new(strings.Builder), "heap", 0, 0)
+	if !errors.Is(err, ErrRunning) {
+		t.Errorf("Profile returned err %v, want %v", err, ErrRunning)
+	}
+}
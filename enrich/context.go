@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the enricher in the context.
+const key = "enrich"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the enricher
+// associated with this context.
+func FromContext(c context.Context) *Enricher {
+	// get enricher value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast enricher value to expected Enricher type
+	e, ok := v.(*Enricher)
+	if !ok {
+		return nil
+	}
+
+	return e
+}
+
+// ToContext adds the enricher to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, e *Enricher) {
+	c.Set(key, e)
+}
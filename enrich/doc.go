@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package enrich provides the ability for Vela to enrich the
+// audit and session records with details about the client that
+// made the request, like where the request was made from and
+// the client used to make it, through pluggable providers.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/enrich"
+package enrich
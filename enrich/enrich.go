@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// limitIP defines the maximum length of the IP
+	// address captured for the client of a request.
+	limitIP = 250
+
+	// limitUserAgent defines the maximum length of the user
+	// agent captured for the client of a request.
+	limitUserAgent = 500
+
+	// limitDetail defines the maximum length of the client
+	// and location captured for the client of a request.
+	limitDetail = 250
+)
+
+type (
+	// config represents the settings required to create the enricher.
+	config struct {
+		// specifies to parse the client from the user agent
+		UserAgent bool
+		// specifies the headers set by a proxy with the location of the client
+		LocationHeaders []string
+		// specifies the URL to look up the location of the client with
+		LocationURL string
+		// specifies the timeout for looking up the location of the client
+		LocationTimeout time.Duration
+	}
+
+	// Enricher represents the functionality for enriching the
+	// audit and session records with details about the client
+	// that made the request through the configured providers.
+	Enricher struct {
+		// enricher configuration settings
+		config *config
+
+		// providers plugged in with the options
+		custom []Provider
+
+		// providers run in order to enrich the details
+		providers []Provider
+	}
+
+	// Details represents the details captured
+	// about the client that made a request.
+	Details struct {
+		// IP address of the client
+		IP string
+		// raw user agent sent by the client
+		UserAgent string
+		// client parsed from the user agent, like "Chrome 118 on macOS"
+		Client string
+		// location of the client, like "Minneapolis, Minnesota, US"
+		Location string
+	}
+
+	// Provider represents the interface for plugging in
+	// the enrichment of the details about the client
+	// that made a request.
+	Provider interface {
+		// Enrich defines a function that populates the
+		// details about the client from the request.
+		Enrich(context.Context, *http.Request, *Details) error
+	}
+)
+
+// New creates and returns an enricher for audit and session records.
+func New(opts ...Opt) (*Enricher, error) {
+	// create new enricher
+	e := new(Enricher)
+
+	// create new fields
+	e.config = &config{
+		LocationTimeout: 2 * time.Second,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if e.config.UserAgent {
+		e.providers = append(e.providers, new(userAgent))
+	}
+
+	if len(e.config.LocationHeaders) > 0 {
+		e.providers = append(e.providers, &locationHeader{headers: e.config.LocationHeaders})
+	}
+
+	if len(e.config.LocationURL) > 0 {
+		// check if the URL has a placeholder for the IP address
+		if !strings.Contains(e.config.LocationURL, placeholder) {
+			return nil, fmt.Errorf("enrich location url must contain the %s placeholder", placeholder)
+		}
+
+		e.providers = append(e.providers, newLocationLookup(e.config.LocationURL, e.config.LocationTimeout))
+	}
+
+	e.providers = append(e.providers, e.custom...)
+
+	return e, nil
+}
+
+// Enabled returns whether the enricher is configured with
+// providers to enrich the details about the client.
+func (e *Enricher) Enabled() bool {
+	return e != nil && len(e.providers) > 0
+}
+
+// Enrich captures the details about the client that made the
+// request from the provided IP address, which is expected to
+// account for any trusted proxies, and enriches them with the
+// configured providers. A provider failing to enrich the details
+// does not fail the request, so the details are always returned.
+//
+// The IP address and user agent are captured for a nil enricher.
+func (e *Enricher) Enrich(ctx context.Context, r *http.Request, ip string) *Details {
+	d := &Details{
+		IP:        truncate(ip, limitIP),
+		UserAgent: truncate(r.UserAgent(), limitUserAgent),
+	}
+
+	if !e.Enabled() {
+		return d
+	}
+
+	for _, p := range e.providers {
+		err := p.Enrich(ctx, r, d)
+		if err != nil {
+			logrus.Warnf("unable to enrich details for client %s: %v", d.IP, err)
+		}
+	}
+
+	d.Client = truncate(d.Client, limitDetail)
+	d.Location = truncate(d.Location, limitDetail)
+
+	return d
+}
+
+// truncate is a helper function to limit the
+// value to the provided number of characters.
+func truncate(value string, limit int) string {
+	if len(value) > limit {
+		return value[:limit]
+	}
+
+	return value
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testProvider represents a provider plugged in for tests.
+type testProvider struct {
+	location string
+	err      error
+}
+
+// Enrich sets the location for tests.
+func (p *testProvider) Enrich(_ context.Context, _ *http.Request, d *Details) error {
+	if p.err != nil {
+		return p.err
+	}
+
+	d.Location = p.location
+
+	return nil
+}
+
+func TestEnrich_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name      string
+		failure   bool
+		providers int
+		opts      []Opt
+	}{
+		{
+			name:      "defaults",
+			failure:   false,
+			providers: 0,
+			opts:      []Opt{},
+		},
+		{
+			name:      "every provider",
+			failure:   false,
+			providers: 4,
+			opts: []Opt{
+				WithUserAgent(true),
+				WithLocationHeaders([]string{"CF-IPCountry"}),
+				WithLocationURL("https://geo.example.com/{ip}"),
+				WithProvider(new(testProvider)),
+			},
+		},
+		{
+			name:      "empty location headers",
+			failure:   false,
+			providers: 0,
+			opts:      []Opt{WithLocationHeaders([]string{" ", ""})},
+		},
+		{
+			name:    "location url without placeholder",
+			failure: true,
+			opts:    []Opt{WithLocationURL("https://geo.example.com")},
+		},
+		{
+			name:    "invalid location timeout",
+			failure: true,
+			opts:    []Opt{WithLocationTimeout(0)},
+		},
+		{
+			name:    "empty provider",
+			failure: true,
+			opts:    []Opt{WithProvider(nil)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if len(got.providers) != test.providers {
+				t.Errorf("New providers is %d, want %d", len(got.providers), test.providers)
+			}
+
+			if got.Enabled() != (test.providers > 0) {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.providers > 0)
+			}
+		})
+	}
+}
+
+func TestEnrich_Enrich(t *testing.T) {
+	// setup types
+	req, _ := http.NewRequest(http.MethodGet, "/authenticate", nil)
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36")
+	req.Header.Set("CF-IPCity", "Minneapolis")
+	req.Header.Set("CF-IPCountry", "US")
+
+	enabled, _ := New(
+		WithUserAgent(true),
+		WithLocationHeaders([]string{"CF-IPCity", "CF-IPRegion", "CF-IPCountry"}),
+	)
+
+	plugged, _ := New(WithProvider(&testProvider{location: strings.Repeat("a", 300)}))
+
+	failing, _ := New(WithProvider(&testProvider{err: errors.New("unavailable")}))
+
+	// setup tests
+	tests := []struct {
+		name     string
+		enricher *Enricher
+		want     *Details
+	}{
+		{
+			name:     "nil enricher",
+			enricher: nil,
+			want: &Details{
+				IP:        "1.2.3.4",
+				UserAgent: req.UserAgent(),
+			},
+		},
+		{
+			name:     "user agent and location headers",
+			enricher: enabled,
+			want: &Details{
+				IP:        "1.2.3.4",
+				UserAgent: req.UserAgent(),
+				Client:    "Chrome 118 on macOS",
+				Location:  "Minneapolis, US",
+			},
+		},
+		{
+			name:     "plugged in provider",
+			enricher: plugged,
+			want: &Details{
+				IP:        "1.2.3.4",
+				UserAgent: req.UserAgent(),
+				Location:  strings.Repeat("a", limitDetail),
+			},
+		},
+		{
+			name:     "failing provider",
+			enricher: failing,
+			want: &Details{
+				IP:        "1.2.3.4",
+				UserAgent: req.UserAgent(),
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.enricher.Enrich(context.TODO(), req, "1.2.3.4")
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Enrich is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestEnrich_WithLocationTimeout(t *testing.T) {
+	// run test
+	got, err := New(WithLocationTimeout(5 * time.Second))
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	if got.config.LocationTimeout != 5*time.Second {
+		t.Errorf("WithLocationTimeout is %s, want %s", got.config.LocationTimeout, 5*time.Second)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the enricher.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Enrich Flags
+
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_ENRICH_USER_AGENT", "ENRICH_USER_AGENT"},
+		FilePath: "/vela/enrich/user_agent",
+		Name:     "enrich.user-agent",
+		Usage:    "enables parsing the client from the user agent for audit and session records",
+		Value:    false,
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_ENRICH_LOCATION_HEADERS", "ENRICH_LOCATION_HEADERS"},
+		FilePath: "/vela/enrich/location/headers",
+		Name:     "enrich.location.headers",
+		Usage:    "headers set by a trusted proxy with the location of the client for audit and session records (i.e. CF-IPCity,CF-IPCountry)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_ENRICH_LOCATION_URL", "ENRICH_LOCATION_URL"},
+		FilePath: "/vela/enrich/location/url",
+		Name:     "enrich.location.url",
+		Usage:    "url, with an {ip} placeholder, to look up the location of the client for audit and session records with (i.e. https://ipinfo.io/{ip}/json)",
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_ENRICH_LOCATION_TIMEOUT", "ENRICH_LOCATION_TIMEOUT"},
+		FilePath: "/vela/enrich/location/timeout",
+		Name:     "enrich.location.timeout",
+		Usage:    "timeout for looking up the location of the client",
+		Value:    2 * time.Second,
+	},
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// placeholder defines the placeholder replaced with
+	// the IP address in the URL for a location lookup.
+	placeholder = "{ip}"
+
+	// cacheLimit defines the maximum number of locations
+	// cached before the cache for the lookup is reset.
+	cacheLimit = 10000
+)
+
+// locationHeader represents the provider that captures the
+// location of the client from headers set by a trusted proxy,
+// like a CDN that geolocates the client.
+type locationHeader struct {
+	headers []string
+}
+
+// Enrich captures the location from the values of the
+// headers, in order, joined by a comma.
+func (l *locationHeader) Enrich(_ context.Context, r *http.Request, d *Details) error {
+	parts := []string{}
+
+	for _, header := range l.headers {
+		if value := strings.TrimSpace(r.Header.Get(header)); len(value) > 0 {
+			parts = append(parts, value)
+		}
+	}
+
+	if len(parts) > 0 {
+		d.Location = strings.Join(parts, ", ")
+	}
+
+	return nil
+}
+
+// locationLookup represents the provider that looks up the
+// location of the client by IP address with an HTTP service.
+type locationLookup struct {
+	url    string
+	client *http.Client
+
+	mu    sync.Mutex
+	cache map[string]string
+}
+
+// newLocationLookup is a helper function to create
+// the provider that looks up the location of the client.
+func newLocationLookup(url string, timeout time.Duration) *locationLookup {
+	return &locationLookup{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+		cache:  make(map[string]string),
+	}
+}
+
+// Enrich looks up the location for the IP address of the client,
+// unless the location was already captured or the IP address is
+// not public. The service is expected to respond with a JSON object
+// with the city, region and country for the IP address.
+func (l *locationLookup) Enrich(ctx context.Context, _ *http.Request, d *Details) error {
+	if len(d.Location) > 0 || !public(d.IP) {
+		return nil
+	}
+
+	l.mu.Lock()
+	location, ok := l.cache[d.IP]
+	l.mu.Unlock()
+
+	if ok {
+		d.Location = location
+
+		return nil
+	}
+
+	location, err := l.lookup(ctx, d.IP)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// reset the cache when it is full
+	if len(l.cache) >= cacheLimit {
+		l.cache = make(map[string]string)
+	}
+
+	l.cache[d.IP] = location
+
+	d.Location = location
+
+	return nil
+}
+
+// lookup is a helper function to send the request
+// to the service for the location of the IP address.
+func (l *locationLookup) lookup(ctx context.Context, ip string) (string, error) {
+	target := strings.ReplaceAll(l.url, placeholder, url.PathEscape(ip))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to look up location for %s: %w", ip, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to look up location for %s: received status %d", ip, resp.StatusCode)
+	}
+
+	body := map[string]interface{}{}
+
+	err = json.NewDecoder(resp.Body).Decode(&body)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse location for %s: %w", ip, err)
+	}
+
+	parts := []string{}
+
+	// capture the first field populated for each part of the location,
+	// supporting the field names used by the common geolocation services
+	for _, keys := range [][]string{
+		{"city"},
+		{"region", "regionName", "region_name"},
+		{"country", "country_name", "countryCode", "country_code"},
+	} {
+		for _, key := range keys {
+			if value, ok := body[key].(string); ok && len(value) > 0 {
+				parts = append(parts, value)
+
+				break
+			}
+		}
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+// public is a helper function to check if the
+// IP address is routable on the public internet.
+func public(ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+
+	return !addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsUnspecified()
+}
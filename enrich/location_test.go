@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEnrich_locationLookup(t *testing.T) {
+	// setup types
+	lookups := 0
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lookups++
+
+		switch r.URL.Path {
+		case "/8.8.8.8":
+			_, _ = w.Write([]byte(`{"ip":"8.8.8.8","city":"Mountain View","regionName":"California","countryCode":"US"}`))
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer s.Close()
+
+	l := newLocationLookup(s.URL+"/{ip}", time.Second)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		details *Details
+		want    string
+		lookups int
+	}{
+		{
+			name:    "public ip",
+			details: &Details{IP: "8.8.8.8"},
+			want:    "Mountain View, California, US",
+			lookups: 1,
+		},
+		{
+			name:    "cached ip",
+			details: &Details{IP: "8.8.8.8"},
+			want:    "Mountain View, California, US",
+			lookups: 1,
+		},
+		{
+			name:    "private ip",
+			details: &Details{IP: "10.0.0.1"},
+			want:    "",
+			lookups: 1,
+		},
+		{
+			name:    "location captured",
+			details: &Details{IP: "1.1.1.1", Location: "US"},
+			want:    "US",
+			lookups: 1,
+		},
+		{
+			name:    "failed lookup",
+			failure: true,
+			details: &Details{IP: "1.1.1.1"},
+			want:    "",
+			lookups: 2,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := l.Enrich(context.TODO(), nil, test.details)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Enrich should have returned err")
+				}
+			} else if err != nil {
+				t.Errorf("Enrich returned err: %v", err)
+			}
+
+			if test.details.Location != test.want {
+				t.Errorf("Enrich location is %s, want %s", test.details.Location, test.want)
+			}
+
+			if lookups != test.lookups {
+				t.Errorf("Enrich lookups is %d, want %d", lookups, test.lookups)
+			}
+		})
+	}
+}
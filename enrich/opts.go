@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Opt represents a configuration option to initialize the enricher.
+type Opt func(*Enricher) error
+
+// WithUserAgent sets whether to parse the client from the user agent in the enricher.
+func WithUserAgent(enabled bool) Opt {
+	return func(e *Enricher) error {
+		// set whether to parse the user agent in the enricher
+		e.config.UserAgent = enabled
+
+		return nil
+	}
+}
+
+// WithLocationHeaders sets the headers with the location of the client in the enricher.
+func WithLocationHeaders(headers []string) Opt {
+	return func(e *Enricher) error {
+		e.config.LocationHeaders = []string{}
+
+		for _, header := range headers {
+			header = strings.TrimSpace(header)
+
+			// check if the header is empty
+			if len(header) == 0 {
+				continue
+			}
+
+			e.config.LocationHeaders = append(e.config.LocationHeaders, header)
+		}
+
+		return nil
+	}
+}
+
+// WithLocationURL sets the URL to look up the location of the client in the enricher.
+func WithLocationURL(url string) Opt {
+	return func(e *Enricher) error {
+		// set the location url in the enricher
+		e.config.LocationURL = url
+
+		return nil
+	}
+}
+
+// WithLocationTimeout sets the timeout for looking up the location of the client in the enricher.
+func WithLocationTimeout(timeout time.Duration) Opt {
+	return func(e *Enricher) error {
+		// check if the timeout provided is valid
+		if timeout <= 0 {
+			return fmt.Errorf("enrich location timeout must be greater than 0")
+		}
+
+		// set the location timeout in the enricher
+		e.config.LocationTimeout = timeout
+
+		return nil
+	}
+}
+
+// WithProvider plugs in a provider to enrich the details about the client in the enricher.
+// The providers plugged in run after the providers configured with the other options.
+func WithProvider(p Provider) Opt {
+	return func(e *Enricher) error {
+		// check if the provider provided is empty
+		if p == nil {
+			return fmt.Errorf("no enrich provider provided")
+		}
+
+		// add the provider to the enricher
+		e.custom = append(e.custom, p)
+
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// product represents a client matched by
+// the product token in the user agent.
+type product struct {
+	// token for the product in the user agent
+	token string
+	// name of the client for the product
+	name string
+}
+
+// products represents the clients detected from the user agent,
+// in order of precedence since browsers include the tokens for
+// the browsers they are compatible with.
+var products = []product{
+	{token: "vela-cli", name: "Vela CLI"},
+	{token: "vela", name: "Vela"},
+	{token: "Edg/", name: "Edge"},
+	{token: "OPR/", name: "Opera"},
+	{token: "Firefox/", name: "Firefox"},
+	{token: "Chrome/", name: "Chrome"},
+	{token: "Version/", name: "Safari"},
+	{token: "curl/", name: "curl"},
+	{token: "Wget/", name: "Wget"},
+	{token: "PostmanRuntime/", name: "Postman"},
+	{token: "python-requests/", name: "Python"},
+	{token: "Go-http-client/", name: "Go"},
+}
+
+// platforms represents the operating systems detected from
+// the user agent, in order of precedence since mobile
+// platforms include the tokens for desktop platforms.
+var platforms = []product{
+	{token: "iPhone", name: "iOS"},
+	{token: "iPad", name: "iOS"},
+	{token: "Android", name: "Android"},
+	{token: "Windows", name: "Windows"},
+	{token: "Mac OS X", name: "macOS"},
+	{token: "CrOS", name: "ChromeOS"},
+	{token: "Linux", name: "Linux"},
+}
+
+// userAgent represents the provider that parses
+// the client from the user agent for the request.
+type userAgent struct{}
+
+// Enrich parses the client from the user agent, like
+// "Chrome 118 on macOS", when the user agent is recognized.
+func (u *userAgent) Enrich(_ context.Context, _ *http.Request, d *Details) error {
+	d.Client = parseUserAgent(d.UserAgent)
+
+	return nil
+}
+
+// parseUserAgent is a helper function to describe the client,
+// with its major version and platform, from the user agent.
+func parseUserAgent(ua string) string {
+	if len(ua) == 0 {
+		return ""
+	}
+
+	client := ""
+
+	for _, p := range products {
+		i := strings.Index(ua, p.token)
+		if i < 0 {
+			continue
+		}
+
+		client = p.name
+
+		// capture the major version following the product token
+		if strings.HasSuffix(p.token, "/") {
+			if version := major(ua[i+len(p.token):]); len(version) > 0 {
+				client = fmt.Sprintf("%s %s", client, version)
+			}
+		}
+
+		break
+	}
+
+	if len(client) == 0 {
+		return ""
+	}
+
+	for _, p := range platforms {
+		if strings.Contains(ua, p.token) {
+			return fmt.Sprintf("%s on %s", client, p.name)
+		}
+	}
+
+	return client
+}
+
+// major is a helper function to capture the major
+// version from the start of the provided value.
+func major(value string) string {
+	end := strings.IndexFunc(value, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+
+	if end < 0 {
+		return value
+	}
+
+	return value[:end]
+}
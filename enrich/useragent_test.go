@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package enrich
+
+import (
+	"testing"
+)
+
+func TestEnrich_parseUserAgent(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		ua   string
+		want string
+	}{
+		{
+			ua:   "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36",
+			want: "Chrome 118 on macOS",
+		},
+		{
+			ua:   "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36 Edg/118.0.2088.46",
+			want: "Edge 118 on Windows",
+		},
+		{
+			ua:   "Mozilla/5.0 (X11; Linux x86_64; rv:109.0) Gecko/20100101 Firefox/119.0",
+			want: "Firefox 119 on Linux",
+		},
+		{
+			ua:   "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+			want: "Safari 17 on iOS",
+		},
+		{
+			ua:   "vela-cli",
+			want: "Vela CLI",
+		},
+		{
+			ua:   "curl/8.1.2",
+			want: "curl 8",
+		},
+		{
+			ua:   "Go-http-client/1.1",
+			want: "Go 1",
+		},
+		{
+			ua:   "unknown/1.0",
+			want: "",
+		},
+		{
+			ua:   "",
+			want: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := parseUserAgent(test.ua)
+
+		if got != test.want {
+			t.Errorf("parseUserAgent for %s is %s, want %s", test.ua, got, test.want)
+		}
+	}
+}
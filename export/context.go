@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the exporter in the context.
+const key = "export"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the exporter
+// associated with this context.
+func FromContext(c context.Context) *Exporter {
+	// get exporter value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast exporter value to expected Exporter type
+	e, ok := v.(*Exporter)
+	if !ok {
+		return nil
+	}
+
+	return e
+}
+
+// ToContext adds the exporter to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, e *Exporter) {
+	c.Set(key, e)
+}
@@ -0,0 +1,15 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package export provides the ability for Vela to periodically export
+// the results of finished builds and steps to object storage, like S3 or
+// GCS, so they can be loaded into a data warehouse.
+//
+// Every export covers the builds or steps finished since the watermark
+// of the previous export and is recorded in a manifest in the database.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/export"
+package export
@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/go-vela/server/database"
+)
+
+const (
+	// FormatJSONL is the format for exporting one JSON object per line.
+	FormatJSONL = "jsonl"
+
+	// FormatParquet is the format for exporting Apache Parquet files.
+	FormatParquet = "parquet"
+)
+
+type (
+	// config represents the settings required to create the exporter.
+	config struct {
+		// specifies the bucket to upload the exports to
+		Bucket string
+		// specifies the prefix for the objects uploaded to the bucket
+		Prefix string
+		// specifies the S3 compatible endpoint for the bucket
+		Endpoint string
+		// specifies the region for the bucket
+		Region string
+		// specifies the access key used to authenticate with the bucket
+		AccessKey string
+		// specifies the secret key used to authenticate with the bucket
+		SecretKey string
+		// specifies the format of the exports
+		Format string
+		// specifies the interval at which to export the datasets
+		Interval time.Duration
+	}
+
+	// Exporter represents the functionality for exporting
+	// the results of builds and steps to object storage.
+	Exporter struct {
+		// exporter configuration settings
+		config *config
+
+		// database service used to capture builds, steps and exports
+		database database.Service
+
+		// client used to upload the exports to the bucket
+		client s3iface.S3API
+	}
+)
+
+// New creates and returns an exporter for build results.
+func New(opts ...Opt) (*Exporter, error) {
+	// create new exporter
+	e := new(Exporter)
+
+	// create new fields
+	e.config = &config{
+		Region: "us-east-1",
+		Format: FormatJSONL,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// skip creating the client if the exporter is disabled
+	if !e.Enabled() {
+		return e, nil
+	}
+
+	// check if the export is enabled without a bucket
+	if len(e.config.Bucket) == 0 {
+		return nil, fmt.Errorf("no export bucket provided")
+	}
+
+	// skip creating the client if one was provided
+	if e.client != nil {
+		return e, nil
+	}
+
+	cfg := &aws.Config{
+		Region: aws.String(e.config.Region),
+	}
+
+	// use path style addressing for S3 compatible endpoints
+	if len(e.config.Endpoint) > 0 {
+		cfg.Endpoint = aws.String(e.config.Endpoint)
+		cfg.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	// use static credentials when provided, otherwise
+	// fall back to the default AWS credential chain
+	if len(e.config.AccessKey) > 0 {
+		cfg.Credentials = credentials.NewStaticCredentials(e.config.AccessKey, e.config.SecretKey, "")
+	}
+
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create export session: %w", err)
+	}
+
+	e.client = s3.New(sess)
+
+	return e, nil
+}
+
+// Enabled returns whether the exporter is configured
+// to periodically export the datasets to a bucket.
+func (e *Exporter) Enabled() bool {
+	return e.config.Interval > 0
+}
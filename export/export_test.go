@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExport_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		enabled bool
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+		},
+		{
+			name:    "with interval",
+			failure: false,
+			opts: []Opt{
+				WithBucket("vela-exports"),
+				WithPrefix("/vela/"),
+				WithEndpoint("https://storage.googleapis.com"),
+				WithRegion("auto"),
+				WithCredentials("accessKey", "secretKey"),
+				WithFormat(FormatParquet),
+				WithInterval(time.Hour),
+			},
+			enabled: true,
+		},
+		{
+			name:    "interval without bucket",
+			failure: true,
+			opts:    []Opt{WithInterval(time.Hour)},
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Hour)},
+		},
+		{
+			name:    "invalid format",
+			failure: true,
+			opts:    []Opt{WithFormat("csv")},
+		},
+		{
+			name:    "empty region",
+			failure: true,
+			opts:    []Opt{WithRegion("")},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+		})
+	}
+}
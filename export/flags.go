@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for exporting build results.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Export Flags
+
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_EXPORT_BUCKET", "EXPORT_BUCKET"},
+		FilePath: "/vela/export/bucket",
+		Name:     "export.bucket",
+		Usage:    "bucket to upload the exports of build results to",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_EXPORT_PREFIX", "EXPORT_PREFIX"},
+		FilePath: "/vela/export/prefix",
+		Name:     "export.prefix",
+		Usage:    "prefix for the objects uploaded to the export bucket",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_EXPORT_ENDPOINT", "EXPORT_ENDPOINT"},
+		FilePath: "/vela/export/endpoint",
+		Name:     "export.endpoint",
+		Usage:    "S3 compatible endpoint for the export bucket (i.e. https://storage.googleapis.com for GCS)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_EXPORT_REGION", "EXPORT_REGION"},
+		FilePath: "/vela/export/region",
+		Name:     "export.region",
+		Usage:    "region for the export bucket",
+		Value:    "us-east-1",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_EXPORT_ACCESS_KEY", "EXPORT_ACCESS_KEY"},
+		FilePath: "/vela/export/access_key",
+		Name:     "export.access-key",
+		Usage:    "access key used to authenticate with the export bucket (uses the default AWS credentials when empty)",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_EXPORT_SECRET_KEY", "EXPORT_SECRET_KEY"},
+		FilePath: "/vela/export/secret_key",
+		Name:     "export.secret-key",
+		Usage:    "secret key used to authenticate with the export bucket",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_EXPORT_FORMAT", "EXPORT_FORMAT"},
+		FilePath: "/vela/export/format",
+		Name:     "export.format",
+		Usage:    "format of the exports of build results (jsonl or parquet)",
+		Value:    FormatJSONL,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_EXPORT_INTERVAL", "EXPORT_INTERVAL"},
+		FilePath: "/vela/export/interval",
+		Name:     "export.interval",
+		Usage:    "interval at which to export build results (disabled when set to 0)",
+		Value:    0,
+	},
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// jsonl encodes the table as one JSON object per line
+// with the keys in the order of the columns.
+func (t *table) jsonl() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	for _, row := range t.Rows {
+		buf.WriteByte('{')
+
+		for i, value := range row {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			key, err := json.Marshal(t.Columns[i].Name)
+			if err != nil {
+				return nil, err
+			}
+
+			data, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+
+			buf.Write(key)
+			buf.WriteByte(':')
+			buf.Write(data)
+		}
+
+		buf.WriteString("}\n")
+	}
+
+	return buf.Bytes(), nil
+}
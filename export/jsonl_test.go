@@ -0,0 +1,34 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"testing"
+)
+
+func TestExport_table_jsonl(t *testing.T) {
+	// setup types
+	tbl := &table{
+		Columns: []column{{"id", kindInt64}, {"status", kindString}},
+		Rows: [][]interface{}{
+			{int64(1), "success"},
+			{int64(2), "failure \"quoted\""},
+		},
+	}
+
+	want := `{"id":1,"status":"success"}
+{"id":2,"status":"failure \"quoted\""}
+`
+
+	// run test
+	got, err := tbl.jsonl()
+	if err != nil {
+		t.Errorf("jsonl returned err: %v", err)
+	}
+
+	if string(got) != want {
+		t.Errorf("jsonl is %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the exporter.
+type Opt func(*Exporter) error
+
+// WithDatabase sets the database service in the exporter.
+func WithDatabase(db database.Service) Opt {
+	return func(e *Exporter) error {
+		// set the database service in the exporter
+		e.database = db
+
+		return nil
+	}
+}
+
+// WithClient sets the client used to upload the exports in the exporter.
+func WithClient(client s3iface.S3API) Opt {
+	return func(e *Exporter) error {
+		// set the client in the exporter
+		e.client = client
+
+		return nil
+	}
+}
+
+// WithBucket sets the bucket to upload the exports to in the exporter.
+func WithBucket(bucket string) Opt {
+	return func(e *Exporter) error {
+		// set the bucket in the exporter
+		e.config.Bucket = bucket
+
+		return nil
+	}
+}
+
+// WithPrefix sets the prefix for the objects uploaded in the exporter.
+func WithPrefix(prefix string) Opt {
+	return func(e *Exporter) error {
+		// set the prefix in the exporter
+		e.config.Prefix = strings.Trim(prefix, "/")
+
+		return nil
+	}
+}
+
+// WithEndpoint sets the S3 compatible endpoint for the bucket in the exporter.
+func WithEndpoint(endpoint string) Opt {
+	return func(e *Exporter) error {
+		// set the endpoint in the exporter
+		e.config.Endpoint = endpoint
+
+		return nil
+	}
+}
+
+// WithRegion sets the region for the bucket in the exporter.
+func WithRegion(region string) Opt {
+	return func(e *Exporter) error {
+		// check if the region provided is empty
+		if len(region) == 0 {
+			return fmt.Errorf("no export region provided")
+		}
+
+		// set the region in the exporter
+		e.config.Region = region
+
+		return nil
+	}
+}
+
+// WithCredentials sets the access and secret key
+// used to authenticate with the bucket in the exporter.
+func WithCredentials(accessKey, secretKey string) Opt {
+	return func(e *Exporter) error {
+		// set the credentials in the exporter
+		e.config.AccessKey = accessKey
+		e.config.SecretKey = secretKey
+
+		return nil
+	}
+}
+
+// WithFormat sets the format of the exports in the exporter.
+func WithFormat(format string) Opt {
+	return func(e *Exporter) error {
+		// check if the format provided is supported
+		switch format {
+		case FormatJSONL, FormatParquet:
+		default:
+			return fmt.Errorf("invalid export format provided: %s", format)
+		}
+
+		// set the format in the exporter
+		e.config.Format = format
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to export the datasets in the exporter.
+func WithInterval(interval time.Duration) Opt {
+	return func(e *Exporter) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid export interval provided: %s", interval)
+		}
+
+		// set the interval in the exporter
+		e.config.Interval = interval
+
+		return nil
+	}
+}
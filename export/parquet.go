@@ -0,0 +1,299 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// https://github.com/apache/parquet-format/blob/master/src/main/thrift/parquet.thrift
+const (
+	// parquetMagic marks the start and end of a Parquet file.
+	parquetMagic = "PAR1"
+
+	// physical types for the values of a column
+	parquetInt64     = 2
+	parquetByteArray = 6
+
+	// converted type for UTF-8 encoded byte arrays
+	parquetUTF8 = 0
+
+	// repetition type for columns with a value in every row
+	parquetRequired = 0
+
+	// encodings for the values and levels of a page
+	parquetPlain = 0
+	parquetRLE   = 3
+
+	// page type for pages with the values of a column
+	parquetDataPage = 0
+
+	// compression codec for the pages of a column
+	parquetUncompressed = 0
+)
+
+// https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// parquet encodes the table as an Apache Parquet file with a single
+// row group. Every column is required, plain encoded and uncompressed,
+// which keeps the encoder small while remaining readable by Snowflake,
+// BigQuery and any other Parquet reader.
+func (t *table) parquet() ([]byte, error) {
+	buf := bytes.NewBufferString(parquetMagic)
+
+	// offsets and sizes of the column chunks
+	offsets := make([]int64, len(t.Columns))
+	sizes := make([]int64, len(t.Columns))
+
+	for i := range t.Columns {
+		page, err := t.page(i)
+		if err != nil {
+			return nil, err
+		}
+
+		// https://github.com/apache/parquet-format#data-pages
+		header := new(thriftWriter)
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(page)))
+		header.structBegin(5)
+		header.i32(1, int32(len(t.Rows)))
+		header.i32(2, parquetPlain)
+		header.i32(3, parquetRLE)
+		header.i32(4, parquetRLE)
+		header.structEnd()
+		header.stop()
+
+		offsets[i] = int64(buf.Len())
+		sizes[i] = int64(header.buf.Len() + len(page))
+
+		buf.Write(header.buf.Bytes())
+		buf.Write(page)
+	}
+
+	var total int64
+	for _, size := range sizes {
+		total += size
+	}
+
+	// https://github.com/apache/parquet-format#metadata
+	meta := new(thriftWriter)
+	meta.i32(1, 1)
+
+	// schema for the file with the columns as children of the root
+	meta.list(2, thriftStruct, len(t.Columns)+1)
+	meta.elemBegin()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(t.Columns)))
+	meta.elemEnd()
+
+	for _, c := range t.Columns {
+		meta.elemBegin()
+		meta.i32(1, c.physical())
+		meta.i32(3, parquetRequired)
+		meta.binary(4, c.Name)
+
+		if c.Kind == kindString {
+			meta.i32(6, parquetUTF8)
+		}
+
+		meta.elemEnd()
+	}
+
+	meta.i64(3, int64(len(t.Rows)))
+
+	// single row group with a chunk for every column
+	meta.list(4, thriftStruct, 1)
+	meta.elemBegin()
+	meta.list(1, thriftStruct, len(t.Columns))
+
+	for i, c := range t.Columns {
+		meta.elemBegin()
+		meta.i64(2, offsets[i])
+		meta.structBegin(3)
+		meta.i32(1, c.physical())
+		meta.list(2, thriftI32, 2)
+		meta.elemI32(parquetPlain)
+		meta.elemI32(parquetRLE)
+		meta.list(3, thriftBinary, 1)
+		meta.elemBinary(c.Name)
+		meta.i32(4, parquetUncompressed)
+		meta.i64(5, int64(len(t.Rows)))
+		meta.i64(6, sizes[i])
+		meta.i64(7, sizes[i])
+		meta.i64(9, offsets[i])
+		meta.structEnd()
+		meta.elemEnd()
+	}
+
+	meta.i64(2, total)
+	meta.i64(3, int64(len(t.Rows)))
+	meta.elemEnd()
+
+	meta.binary(6, "vela")
+	meta.stop()
+
+	buf.Write(meta.buf.Bytes())
+
+	// length of the metadata followed by the magic number
+	footer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footer, uint32(meta.buf.Len()))
+
+	buf.Write(footer)
+	buf.WriteString(parquetMagic)
+
+	return buf.Bytes(), nil
+}
+
+// page is a helper function to plain encode
+// the values of a column for a data page.
+func (t *table) page(i int) ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	for _, row := range t.Rows {
+		switch v := row[i].(type) {
+		case int64:
+			_ = binary.Write(buf, binary.LittleEndian, v)
+		case string:
+			_ = binary.Write(buf, binary.LittleEndian, uint32(len(v)))
+			buf.WriteString(v)
+		default:
+			return nil, fmt.Errorf("unsupported value %v for column %s", v, t.Columns[i].Name)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// physical is a helper function to capture
+// the Parquet physical type for the column.
+func (c column) physical() int32 {
+	if c.Kind == kindString {
+		return parquetByteArray
+	}
+
+	return parquetInt64
+}
+
+// thriftWriter represents a writer for structs
+// encoded with the Thrift compact protocol.
+type thriftWriter struct {
+	buf bytes.Buffer
+
+	// last field id written for the current struct
+	last int16
+	// last field ids for the enclosing structs
+	stack []int16
+}
+
+// field writes the header for a field of the current struct.
+func (w *thriftWriter) field(id int16, kind byte) {
+	delta := id - w.last
+
+	if delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | kind)
+	} else {
+		w.buf.WriteByte(kind)
+		w.varint(int64(id))
+	}
+
+	w.last = id
+}
+
+// varint writes a zigzag encoded variable length integer.
+func (w *thriftWriter) varint(v int64) {
+	w.uvarint(uint64((v << 1) ^ (v >> 63)))
+}
+
+// uvarint writes an unsigned variable length integer.
+func (w *thriftWriter) uvarint(v uint64) {
+	b := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(b, v)
+
+	w.buf.Write(b[:n])
+}
+
+// i32 writes an i32 field.
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.varint(int64(v))
+}
+
+// i64 writes an i64 field.
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.varint(v)
+}
+
+// binary writes a binary field.
+func (w *thriftWriter) binary(id int16, v string) {
+	w.field(id, thriftBinary)
+	w.elemBinary(v)
+}
+
+// list writes the header for a list field
+// with the provided kind and size of elements.
+func (w *thriftWriter) list(id int16, kind byte, size int) {
+	w.field(id, thriftList)
+
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | kind)
+
+		return
+	}
+
+	w.buf.WriteByte(0xf0 | kind)
+	w.uvarint(uint64(size))
+}
+
+// elemI32 writes an i32 element of a list.
+func (w *thriftWriter) elemI32(v int32) {
+	w.varint(int64(v))
+}
+
+// elemBinary writes a binary element of a list.
+func (w *thriftWriter) elemBinary(v string) {
+	w.uvarint(uint64(len(v)))
+	w.buf.WriteString(v)
+}
+
+// structBegin writes the header for a struct field.
+func (w *thriftWriter) structBegin(id int16) {
+	w.field(id, thriftStruct)
+	w.elemBegin()
+}
+
+// structEnd ends the current struct field.
+func (w *thriftWriter) structEnd() {
+	w.elemEnd()
+}
+
+// elemBegin begins a struct element of a list.
+func (w *thriftWriter) elemBegin() {
+	w.stack = append(w.stack, w.last)
+	w.last = 0
+}
+
+// elemEnd ends the current struct element of a list.
+func (w *thriftWriter) elemEnd() {
+	w.stop()
+
+	w.last = w.stack[len(w.stack)-1]
+	w.stack = w.stack[:len(w.stack)-1]
+}
+
+// stop writes the end of the current struct.
+func (w *thriftWriter) stop() {
+	w.buf.WriteByte(0)
+}
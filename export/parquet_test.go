@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestExport_table_parquet(t *testing.T) {
+	// setup types
+	tbl := &table{
+		Columns: []column{{"id", kindInt64}, {"status", kindString}},
+		Rows: [][]interface{}{
+			{int64(1), "success"},
+			{int64(2), "failure"},
+		},
+	}
+
+	// run test
+	got, err := tbl.parquet()
+	if err != nil {
+		t.Fatalf("parquet returned err: %v", err)
+	}
+
+	if !bytes.HasPrefix(got, []byte(parquetMagic)) || !bytes.HasSuffix(got, []byte(parquetMagic)) {
+		t.Errorf("parquet is missing the magic number: %v", got)
+	}
+
+	// capture the metadata from the footer
+	length := int(binary.LittleEndian.Uint32(got[len(got)-8:]))
+	if length <= 0 || length > len(got)-12 {
+		t.Fatalf("parquet has invalid metadata length %d", length)
+	}
+
+	meta := got[len(got)-8-length : len(got)-8]
+
+	for _, want := range []string{"schema", "id", "status", "vela"} {
+		if !bytes.Contains(meta, []byte(want)) {
+			t.Errorf("parquet metadata is missing %s", want)
+		}
+	}
+
+	// the pages hold the plain encoded values of each column
+	for _, want := range [][]byte{
+		{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0},
+		append([]byte{7, 0, 0, 0}, "success"...),
+		append([]byte{7, 0, 0, 0}, "failure"...),
+	} {
+		if !bytes.Contains(got, want) {
+			t.Errorf("parquet is missing values %v", want)
+		}
+	}
+}
+
+func TestExport_table_parquet_Failure(t *testing.T) {
+	// setup types
+	tbl := &table{
+		Columns: []column{{"ratio", kindInt64}},
+		Rows:    [][]interface{}{{0.5}},
+	}
+
+	// run test
+	_, err := tbl.parquet()
+	if err == nil {
+		t.Errorf("parquet should have returned err")
+	}
+}
+
+func TestExport_thriftWriter(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name  string
+		write func(w *thriftWriter)
+		want  []byte
+	}{
+		{
+			name:  "i32 with short field header",
+			write: func(w *thriftWriter) { w.i32(1, 3) },
+			want:  []byte{0x15, 0x06},
+		},
+		{
+			name:  "i64 with long field header",
+			write: func(w *thriftWriter) { w.i64(20, -1) },
+			want:  []byte{0x06, 0x28, 0x01},
+		},
+		{
+			name:  "binary",
+			write: func(w *thriftWriter) { w.binary(4, "id") },
+			want:  []byte{0x48, 0x02, 'i', 'd'},
+		},
+		{
+			name:  "long list",
+			write: func(w *thriftWriter) { w.list(2, thriftI32, 20) },
+			want:  []byte{0x29, 0xf5, 0x14},
+		},
+		{
+			name: "nested struct",
+			write: func(w *thriftWriter) {
+				w.i32(1, 0)
+				w.structBegin(5)
+				w.i32(1, 1)
+				w.structEnd()
+				w.i32(6, 1)
+			},
+			want: []byte{0x15, 0x00, 0x4c, 0x15, 0x02, 0x00, 0x15, 0x02},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := new(thriftWriter)
+
+			test.write(w)
+
+			if !bytes.Equal(w.buf.Bytes(), test.want) {
+				t.Errorf("thriftWriter wrote %x, want %x", w.buf.Bytes(), test.want)
+			}
+		})
+	}
+}
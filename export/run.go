@@ -0,0 +1,187 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	api "github.com/go-vela/server/api/types"
+	"github.com/sirupsen/logrus"
+)
+
+// delay represents the duration to wait before exporting finished
+// builds and steps, which ensures the updates for results finishing
+// at the watermark have been stored in the database.
+const delay = time.Minute
+
+// Start exports the datasets at the configured
+// interval until the provided channel is closed.
+func (e *Exporter) Start(dying <-chan struct{}) error {
+	logrus.Infof("exporting build results to bucket %s every %s", e.config.Bucket, e.config.Interval)
+
+	ticker := time.NewTicker(e.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, err := e.Export(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to export build results: %v", err)
+			}
+		}
+	}
+}
+
+// Export uploads the builds and steps finished since the watermark of
+// the last export for each dataset and records the uploaded files in the
+// manifest. It returns the exports created, skipping datasets without
+// new results.
+func (e *Exporter) Export(now time.Time) ([]*api.Export, error) {
+	logrus.Trace("exporting build results")
+
+	exports := []*api.Export{}
+
+	// capture the watermark for the exports
+	watermark := now.Add(-delay).Unix()
+
+	for _, dataset := range Datasets {
+		x, err := e.export(dataset, watermark, now)
+		if err != nil {
+			return exports, fmt.Errorf("unable to export %s: %w", dataset, err)
+		}
+
+		if x != nil {
+			exports = append(exports, x)
+		}
+	}
+
+	return exports, nil
+}
+
+// export is a helper function to upload the results for the dataset
+// finished between the last watermark and the provided watermark.
+func (e *Exporter) export(dataset string, watermark int64, now time.Time) (*api.Export, error) {
+	// send API call to capture the last export for the dataset
+	last, err := e.database.GetLastExport(context.Background(), dataset)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get last export: %w", err)
+	}
+
+	since := last.GetWatermark()
+
+	// skip the dataset if nothing could have finished since the last export
+	if since >= watermark {
+		return nil, nil
+	}
+
+	t, err := e.table(dataset, since, watermark)
+	if err != nil {
+		return nil, err
+	}
+
+	// skip the dataset if nothing finished since the last export
+	if len(t.Rows) == 0 {
+		return nil, nil
+	}
+
+	data, err := t.encode(e.config.Format)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode %s: %w", e.config.Format, err)
+	}
+
+	object := e.config.object(dataset, since, watermark)
+
+	// send API call to upload the export to the bucket
+	_, err = e.client.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(e.config.Bucket),
+		Key:         aws.String(object),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType(e.config.Format)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to upload %s to bucket %s: %w", object, e.config.Bucket, err)
+	}
+
+	x := new(api.Export)
+	x.SetDataset(dataset)
+	x.SetFormat(e.config.Format)
+	x.SetBucket(e.config.Bucket)
+	x.SetObject(object)
+	x.SetRecords(int64(len(t.Rows)))
+	x.SetSince(since)
+	x.SetWatermark(watermark)
+	x.SetCreated(now.Unix())
+
+	// send API call to record the export in the manifest
+	err = e.database.CreateExport(context.Background(), x)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create export for %s: %w", object, err)
+	}
+
+	return x, nil
+}
+
+// table is a helper function to capture the
+// results for the dataset within the time range.
+func (e *Exporter) table(dataset string, since, watermark int64) (*table, error) {
+	switch dataset {
+	case DatasetBuilds:
+		// send API call to capture the finished builds
+		builds, err := e.database.GetFinishedBuildListBetween(context.Background(), since, watermark)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list finished builds: %w", err)
+		}
+
+		return buildTable(builds), nil
+	case DatasetSteps:
+		// send API call to capture the finished steps
+		steps, err := e.database.GetFinishedStepListBetween(context.Background(), since, watermark)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list finished steps: %w", err)
+		}
+
+		return stepTable(steps), nil
+	default:
+		return nil, fmt.Errorf("invalid dataset provided: %s", dataset)
+	}
+}
+
+// encode is a helper function to encode the table in the provided format.
+func (t *table) encode(format string) ([]byte, error) {
+	if format == FormatParquet {
+		return t.parquet()
+	}
+
+	return t.jsonl()
+}
+
+// object is a helper function to create the name of the object
+// for an export, partitioned by the date of the watermark so
+// the objects can be loaded as an external table.
+func (c *config) object(dataset string, since, watermark int64) string {
+	date := time.Unix(watermark, 0).UTC().Format("2006-01-02")
+	name := fmt.Sprintf("%s-%d-%d.%s", dataset, since, watermark, c.Format)
+
+	return path.Join(c.Prefix, dataset, fmt.Sprintf("date=%s", date), name)
+}
+
+// contentType is a helper function to capture
+// the content type for the provided format.
+func contentType(format string) string {
+	if format == FormatParquet {
+		return "application/vnd.apache.parquet"
+	}
+
+	return "application/x-ndjson"
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+// testClient represents a client that captures the
+// objects uploaded instead of sending them to a bucket.
+type testClient struct {
+	s3iface.S3API
+
+	fail    bool
+	objects map[string]string
+}
+
+// PutObject captures the object uploaded to the bucket.
+func (c *testClient) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	if c.fail {
+		return nil, errors.New("access denied")
+	}
+
+	data, err := io.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	c.objects[aws.StringValue(input.Key)] = string(data)
+
+	return new(s3.PutObjectOutput), nil
+}
+
+func TestExport_Export(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from builds;")
+		db.Sqlite.Exec("delete from steps;")
+		db.Sqlite.Exec("delete from exports;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	now := time.Unix(1563477660, 0).UTC()
+
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetStatus("success")
+	_build.SetStarted(1563474000)
+	_build.SetFinished(1563474060)
+
+	_running := new(library.Build)
+	_running.SetID(2)
+	_running.SetRepoID(1)
+	_running.SetNumber(2)
+	_running.SetStatus("running")
+	_running.SetStarted(1563474000)
+
+	_step := new(library.Step)
+	_step.SetID(1)
+	_step.SetRepoID(1)
+	_step.SetBuildID(1)
+	_step.SetNumber(1)
+	_step.SetName("test")
+	_step.SetImage("golang:latest")
+	_step.SetFinished(1563474060)
+
+	for _, b := range []*library.Build{_build, _running} {
+		err := db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Fatalf("unable to create build: %v", err)
+		}
+	}
+
+	err := db.CreateStep(context.TODO(), _step)
+	if err != nil {
+		t.Fatalf("unable to create step: %v", err)
+	}
+
+	client := &testClient{objects: make(map[string]string)}
+
+	e, err := New(
+		WithDatabase(db),
+		WithClient(client),
+		WithBucket("vela-exports"),
+		WithPrefix("vela"),
+		WithInterval(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("unable to create exporter: %v", err)
+	}
+
+	// run test
+	got, err := e.Export(now)
+	if err != nil {
+		t.Fatalf("Export returned err: %v", err)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("Export returned %d exports, want 2", len(got))
+	}
+
+	want := "vela/builds/date=2019-07-18/builds-0-1563477600.jsonl"
+
+	if got[0].GetObject() != want {
+		t.Errorf("Export object is %s, want %s", got[0].GetObject(), want)
+	}
+
+	if got[0].GetRecords() != 1 {
+		t.Errorf("Export records is %d, want 1", got[0].GetRecords())
+	}
+
+	if !strings.Contains(client.objects[want], `"duration_seconds":60`) {
+		t.Errorf("Export uploaded %s, want the finished build", client.objects[want])
+	}
+
+	// the watermark prevents exporting the same results again
+	got, err = e.Export(now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Export returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("Export returned %d exports, want 0", len(got))
+	}
+
+	// the manifest records every export
+	manifest, err := db.ListExports(context.TODO(), "", 0)
+	if err != nil {
+		t.Fatalf("unable to list exports: %v", err)
+	}
+
+	if len(manifest) != 2 {
+		t.Errorf("ListExports returned %d exports, want 2", len(manifest))
+	}
+}
+
+func TestExport_Export_Failure(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from builds;")
+		db.Sqlite.Exec("delete from exports;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetFinished(1563474060)
+
+	err := db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Fatalf("unable to create build: %v", err)
+	}
+
+	e, err := New(
+		WithDatabase(db),
+		WithClient(&testClient{fail: true}),
+		WithBucket("vela-exports"),
+		WithInterval(time.Hour),
+	)
+	if err != nil {
+		t.Fatalf("unable to create exporter: %v", err)
+	}
+
+	// run test
+	_, err = e.Export(time.Unix(1563477660, 0))
+	if err == nil {
+		t.Errorf("Export should have returned err")
+	}
+
+	// the watermark is not moved when the upload fails
+	last, err := db.GetLastExport(context.TODO(), DatasetBuilds)
+	if err != nil {
+		t.Fatalf("unable to get last export: %v", err)
+	}
+
+	if last != nil {
+		t.Errorf("GetLastExport is %v, want nil", last)
+	}
+}
+
+func TestExport_config_object(t *testing.T) {
+	// setup types
+	c := &config{Format: FormatParquet}
+
+	want := "steps/date=2019-07-18/steps-1563474000-1563477600.parquet"
+
+	// run test
+	got := c.object(DatasetSteps, 1563474000, 1563477600)
+
+	if got != want {
+		t.Errorf("object is %s, want %s", got, want)
+	}
+}
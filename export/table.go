@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"github.com/go-vela/types/library"
+)
+
+const (
+	// DatasetBuilds is the dataset for the results of finished builds.
+	DatasetBuilds = "builds"
+
+	// DatasetSteps is the dataset for the results of finished steps.
+	DatasetSteps = "steps"
+)
+
+// Datasets represents the datasets in the order they are exported.
+var Datasets = []string{DatasetBuilds, DatasetSteps}
+
+const (
+	// kindInt64 is the kind of column with integer values.
+	kindInt64 = iota
+	// kindString is the kind of column with string values.
+	kindString
+)
+
+type (
+	// column represents a named and typed column of a table.
+	column struct {
+		Name string
+		Kind int
+	}
+
+	// table represents the rows of a dataset with a fixed set of
+	// columns. Every value is either an int64 or a string according
+	// to the kind of its column.
+	table struct {
+		Columns []column
+		Rows    [][]interface{}
+	}
+)
+
+// buildColumns represents the columns exported for builds.
+var buildColumns = []column{
+	{"id", kindInt64},
+	{"repo_id", kindInt64},
+	{"number", kindInt64},
+	{"parent", kindInt64},
+	{"event", kindString},
+	{"event_action", kindString},
+	{"status", kindString},
+	{"error", kindString},
+	{"enqueued", kindInt64},
+	{"created", kindInt64},
+	{"started", kindInt64},
+	{"finished", kindInt64},
+	{"deploy", kindString},
+	{"branch", kindString},
+	{"ref", kindString},
+	{"commit", kindString},
+	{"sender", kindString},
+	{"author", kindString},
+	{"host", kindString},
+	{"runtime", kindString},
+	{"distribution", kindString},
+	{"queued_seconds", kindInt64},
+	{"duration_seconds", kindInt64},
+}
+
+// stepColumns represents the columns exported for steps.
+var stepColumns = []column{
+	{"id", kindInt64},
+	{"repo_id", kindInt64},
+	{"build_id", kindInt64},
+	{"number", kindInt64},
+	{"name", kindString},
+	{"image", kindString},
+	{"stage", kindString},
+	{"status", kindString},
+	{"error", kindString},
+	{"exit_code", kindInt64},
+	{"created", kindInt64},
+	{"started", kindInt64},
+	{"finished", kindInt64},
+	{"host", kindString},
+	{"runtime", kindString},
+	{"distribution", kindString},
+	{"duration_seconds", kindInt64},
+}
+
+// buildTable is a helper function to create
+// the table for the provided builds.
+func buildTable(builds []*library.Build) *table {
+	t := &table{Columns: buildColumns}
+
+	for _, b := range builds {
+		t.Rows = append(t.Rows, []interface{}{
+			b.GetID(),
+			b.GetRepoID(),
+			int64(b.GetNumber()),
+			int64(b.GetParent()),
+			b.GetEvent(),
+			b.GetEventAction(),
+			b.GetStatus(),
+			b.GetError(),
+			b.GetEnqueued(),
+			b.GetCreated(),
+			b.GetStarted(),
+			b.GetFinished(),
+			b.GetDeploy(),
+			b.GetBranch(),
+			b.GetRef(),
+			b.GetCommit(),
+			b.GetSender(),
+			b.GetAuthor(),
+			b.GetHost(),
+			b.GetRuntime(),
+			b.GetDistribution(),
+			elapsed(b.GetEnqueued(), b.GetStarted()),
+			elapsed(b.GetStarted(), b.GetFinished()),
+		})
+	}
+
+	return t
+}
+
+// stepTable is a helper function to create
+// the table for the provided steps.
+func stepTable(steps []*library.Step) *table {
+	t := &table{Columns: stepColumns}
+
+	for _, s := range steps {
+		t.Rows = append(t.Rows, []interface{}{
+			s.GetID(),
+			s.GetRepoID(),
+			s.GetBuildID(),
+			int64(s.GetNumber()),
+			s.GetName(),
+			s.GetImage(),
+			s.GetStage(),
+			s.GetStatus(),
+			s.GetError(),
+			int64(s.GetExitCode()),
+			s.GetCreated(),
+			s.GetStarted(),
+			s.GetFinished(),
+			s.GetHost(),
+			s.GetRuntime(),
+			s.GetDistribution(),
+			elapsed(s.GetStarted(), s.GetFinished()),
+		})
+	}
+
+	return t
+}
+
+// elapsed is a helper function to calculate the seconds between
+// two unix timestamps, returning 0 when either is missing.
+func elapsed(from, to int64) int64 {
+	if from <= 0 || to < from {
+		return 0
+	}
+
+	return to - from
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package export
+
+import (
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestExport_buildTable(t *testing.T) {
+	// setup types
+	b := new(library.Build)
+	b.SetID(1)
+	b.SetStatus("success")
+	b.SetEnqueued(10)
+	b.SetStarted(15)
+	b.SetFinished(75)
+
+	// run test
+	got := buildTable([]*library.Build{b})
+
+	if len(got.Rows) != 1 {
+		t.Fatalf("buildTable returned %d rows, want 1", len(got.Rows))
+	}
+
+	row := got.Rows[0]
+
+	if len(row) != len(buildColumns) {
+		t.Errorf("buildTable row has %d values, want %d", len(row), len(buildColumns))
+	}
+
+	if row[6] != "success" {
+		t.Errorf("buildTable status is %v, want success", row[6])
+	}
+
+	if row[len(row)-2] != int64(5) {
+		t.Errorf("buildTable queued_seconds is %v, want 5", row[len(row)-2])
+	}
+
+	if row[len(row)-1] != int64(60) {
+		t.Errorf("buildTable duration_seconds is %v, want 60", row[len(row)-1])
+	}
+}
+
+func TestExport_stepTable(t *testing.T) {
+	// setup types
+	s := new(library.Step)
+	s.SetID(1)
+	s.SetName("test")
+	s.SetStarted(15)
+
+	// run test
+	got := stepTable([]*library.Step{s})
+
+	if len(got.Rows) != 1 {
+		t.Fatalf("stepTable returned %d rows, want 1", len(got.Rows))
+	}
+
+	row := got.Rows[0]
+
+	if len(row) != len(stepColumns) {
+		t.Errorf("stepTable row has %d values, want %d", len(row), len(stepColumns))
+	}
+
+	// the step has not finished so it has no duration
+	if row[len(row)-1] != int64(0) {
+		t.Errorf("stepTable duration_seconds is %v, want 0", row[len(row)-1])
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+//go:build chaos
+
+package fault
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Enabled represents whether faults are injected by this build.
+const Enabled = true
+
+// Inject applies the configured fault for the provided target by
+// sleeping for the configured delay and returning an error at the
+// configured rate.
+func Inject(target string) error {
+	f := lookup(target)
+	if f == nil {
+		return nil
+	}
+
+	if f.Delay > 0 {
+		time.Sleep(time.Duration(f.Delay) * time.Millisecond)
+	}
+
+	//nolint:gosec // randomness is not used for security purposes
+	if f.Rate > 0 && rand.Float64() < f.Rate {
+		logrus.Warnf("injecting fault for %s", target)
+
+		if len(f.Error) > 0 {
+			return fmt.Errorf("%w: %s", ErrInjected, f.Error)
+		}
+
+		return fmt.Errorf("%w for %s", ErrInjected, target)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+//go:build chaos
+
+package fault
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFault_Inject(t *testing.T) {
+	// setup types
+	defer Clear("")
+
+	_ = Set(&Fault{Target: TargetDatabase, Rate: 1, Error: "connection refused"})
+	_ = Set(&Fault{Target: TargetQueue, Delay: 50})
+
+	// run test
+	err := Inject(TargetDatabase)
+	if !errors.Is(err, ErrInjected) {
+		t.Errorf("Inject for %s returned %v, want %v", TargetDatabase, err, ErrInjected)
+	}
+
+	start := time.Now()
+
+	err = Inject(TargetQueue)
+	if err != nil {
+		t.Errorf("Inject for %s returned err: %v", TargetQueue, err)
+	}
+
+	if time.Since(start) < 50*time.Millisecond {
+		t.Errorf("Inject for %s did not delay", TargetQueue)
+	}
+
+	err = Inject(TargetSCM)
+	if err != nil {
+		t.Errorf("Inject for %s returned err: %v", TargetSCM, err)
+	}
+}
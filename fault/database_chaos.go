@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+//go:build chaos
+
+package fault
+
+import (
+	"gorm.io/gorm"
+)
+
+// Database registers callbacks with the provided
+// database client to inject faults before each call.
+func Database(db *gorm.DB) error {
+	inject := func(tx *gorm.DB) {
+		err := Inject(TargetDatabase)
+		if err != nil {
+			_ = tx.AddError(err)
+		}
+	}
+
+	err := db.Callback().Create().Before("gorm:create").Register("fault:create", inject)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Query().Before("gorm:query").Register("fault:query", inject)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Update().Before("gorm:update").Register("fault:update", inject)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Delete().Before("gorm:delete").Register("fault:delete", inject)
+	if err != nil {
+		return err
+	}
+
+	err = db.Callback().Row().Before("gorm:row").Register("fault:row", inject)
+	if err != nil {
+		return err
+	}
+
+	return db.Callback().Raw().Before("gorm:raw").Register("fault:raw", inject)
+}
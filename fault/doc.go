@@ -0,0 +1,18 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package fault provides the ability for Vela to inject
+// delays and errors into calls to the database, queue and
+// source control providers as well as drop webhooks, for
+// testing the resilience of the system end-to-end.
+//
+// Faults are only injected when Vela is built with the
+// "chaos" build tag and must never be enabled in production:
+//
+//	go build -tags chaos github.com/go-vela/server/cmd/vela-server
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/fault"
+package fault
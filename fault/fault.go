@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+const (
+	// TargetDatabase represents the target for calls to the database.
+	TargetDatabase = "database"
+
+	// TargetQueue represents the target for calls to the queue.
+	TargetQueue = "queue"
+
+	// TargetSCM represents the target for calls to the source control provider.
+	TargetSCM = "scm"
+
+	// TargetWebhook represents the target for webhooks received from the source control provider.
+	TargetWebhook = "webhook"
+)
+
+// ErrInjected defines the error type wrapped by all injected faults.
+var ErrInjected = errors.New("injected fault")
+
+// Fault represents a delay and/or error injected into calls for a target.
+//
+// swagger:model Fault
+type Fault struct {
+	// target to inject the fault into
+	Target string `json:"target"`
+	// delay in milliseconds before each call
+	Delay int64 `json:"delay,omitempty"`
+	// error message returned from failed calls
+	Error string `json:"error,omitempty"`
+	// rate of calls between 0 and 1 that fail
+	Rate float64 `json:"rate,omitempty"`
+}
+
+// Validate verifies the necessary fields for the fault are populated correctly.
+func (f *Fault) Validate() error {
+	switch f.Target {
+	case TargetDatabase, TargetQueue, TargetSCM, TargetWebhook:
+	default:
+		return fmt.Errorf("invalid fault target provided: %s", f.Target)
+	}
+
+	if f.Delay < 0 {
+		return fmt.Errorf("invalid fault delay provided: %d", f.Delay)
+	}
+
+	if f.Rate < 0 || f.Rate > 1 {
+		return fmt.Errorf("invalid fault rate provided: %v", f.Rate)
+	}
+
+	if f.Delay == 0 && f.Rate == 0 {
+		return fmt.Errorf("fault for %s must provide a delay or rate", f.Target)
+	}
+
+	return nil
+}
+
+var (
+	// mutex protecting the configured faults
+	mu sync.RWMutex
+
+	// faults configured for each target
+	faults = make(map[string]*Fault)
+)
+
+// Set validates and configures the provided fault for its target.
+func Set(f *Fault) error {
+	err := f.Validate()
+	if err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	faults[f.Target] = f
+
+	return nil
+}
+
+// List returns the configured faults sorted by target.
+func List() []*Fault {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	list := []*Fault{}
+	for _, f := range faults {
+		list = append(list, f)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Target < list[j].Target
+	})
+
+	return list
+}
+
+// Clear removes the configured fault for the provided target.
+// All faults are removed when the target provided is empty.
+func Clear(target string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(target) == 0 {
+		faults = make(map[string]*Fault)
+
+		return
+	}
+
+	delete(faults, target)
+}
+
+// lookup returns the configured fault for the provided target.
+func lookup(target string) *Fault {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return faults[target]
+}
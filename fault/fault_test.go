@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package fault
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFault_Fault_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		fault   *Fault
+	}{
+		{
+			name:    "delay",
+			failure: false,
+			fault:   &Fault{Target: TargetDatabase, Delay: 100},
+		},
+		{
+			name:    "rate",
+			failure: false,
+			fault:   &Fault{Target: TargetWebhook, Rate: 1, Error: "dropped"},
+		},
+		{
+			name:    "invalid target",
+			failure: true,
+			fault:   &Fault{Target: "foo", Delay: 100},
+		},
+		{
+			name:    "negative delay",
+			failure: true,
+			fault:   &Fault{Target: TargetQueue, Delay: -1},
+		},
+		{
+			name:    "invalid rate",
+			failure: true,
+			fault:   &Fault{Target: TargetSCM, Rate: 1.5},
+		},
+		{
+			name:    "empty fault",
+			failure: true,
+			fault:   &Fault{Target: TargetSCM},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.fault.Validate()
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Validate should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Validate returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestFault_Set(t *testing.T) {
+	// setup types
+	defer Clear("")
+
+	_database := &Fault{Target: TargetDatabase, Delay: 100}
+	_queue := &Fault{Target: TargetQueue, Rate: 0.5}
+
+	// run test
+	err := Set(_queue)
+	if err != nil {
+		t.Errorf("Set returned err: %v", err)
+	}
+
+	err = Set(_database)
+	if err != nil {
+		t.Errorf("Set returned err: %v", err)
+	}
+
+	err = Set(&Fault{Target: "foo"})
+	if err == nil {
+		t.Errorf("Set should have returned err")
+	}
+
+	want := []*Fault{_database, _queue}
+
+	got := List()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("List is %v, want %v", got, want)
+	}
+
+	Clear(TargetDatabase)
+
+	want = []*Fault{_queue}
+
+	got = List()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("List is %v, want %v", got, want)
+	}
+
+	Clear("")
+
+	got = List()
+
+	if len(got) != 0 {
+		t.Errorf("List is %v, want empty", got)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+//go:build !chaos
+
+package fault
+
+import (
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+
+	"gorm.io/gorm"
+)
+
+// Enabled represents whether faults are injected by this build.
+const Enabled = false
+
+// Inject is a no-op when built without the chaos build tag.
+func Inject(target string) error {
+	return nil
+}
+
+// Database is a no-op when built without the chaos build tag.
+func Database(db *gorm.DB) error {
+	return nil
+}
+
+// Queue returns the provided queue unchanged when
+// built without the chaos build tag.
+func Queue(q queue.Service) queue.Service {
+	return q
+}
+
+// SCM returns the provided scm unchanged when
+// built without the chaos build tag.
+func SCM(s scm.Service) scm.Service {
+	return s
+}
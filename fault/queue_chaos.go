@@ -0,0 +1,45 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+//go:build chaos
+
+package fault
+
+import (
+	"context"
+
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/types"
+)
+
+// faultQueue represents a queue that injects faults before each call.
+type faultQueue struct {
+	queue.Service
+}
+
+// Queue returns the provided queue wrapped to
+// inject faults before each Pop and Push call.
+func Queue(q queue.Service) queue.Service {
+	return &faultQueue{Service: q}
+}
+
+// Pop injects a fault before grabbing an item off the queue.
+func (q *faultQueue) Pop(ctx context.Context) (*types.Item, error) {
+	err := Inject(TargetQueue)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.Service.Pop(ctx)
+}
+
+// Push injects a fault before publishing an item to the queue.
+func (q *faultQueue) Push(ctx context.Context, channel string, item []byte) error {
+	err := Inject(TargetQueue)
+	if err != nil {
+		return err
+	}
+
+	return q.Service.Push(ctx, channel, item)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+//go:build chaos
+
+package fault
+
+import (
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/library"
+)
+
+// faultSCM represents a scm that injects faults before
+// the calls made while processing webhooks and builds.
+type faultSCM struct {
+	scm.Service
+}
+
+// SCM returns the provided scm wrapped to inject faults before
+// the calls made while processing webhooks and builds.
+func SCM(s scm.Service) scm.Service {
+	return &faultSCM{Service: s}
+}
+
+// OrgAccess injects a fault before capturing the user's access level for an org.
+func (s *faultSCM) OrgAccess(u *library.User, org string) (string, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Service.OrgAccess(u, org)
+}
+
+// RepoAccess injects a fault before capturing the user's access level for a repo.
+func (s *faultSCM) RepoAccess(u *library.User, token, org, repo string) (string, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return "", err
+	}
+
+	return s.Service.RepoAccess(u, token, org, repo)
+}
+
+// Changeset injects a fault before capturing the list of files changed for a commit.
+func (s *faultSCM) Changeset(u *library.User, r *library.Repo, sha string) ([]string, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Service.Changeset(u, r, sha)
+}
+
+// ChangesetPR injects a fault before capturing the list of files changed for a pull request.
+func (s *faultSCM) ChangesetPR(u *library.User, r *library.Repo, number int) ([]string, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Service.ChangesetPR(u, r, number)
+}
+
+// Config injects a fault before capturing the pipeline configuration for a commit.
+func (s *faultSCM) Config(u *library.User, r *library.Repo, ref string) ([]byte, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Service.Config(u, r, ref)
+}
+
+// ConfigBackoff injects a fault before capturing the pipeline configuration for a commit.
+func (s *faultSCM) ConfigBackoff(u *library.User, r *library.Repo, ref string) ([]byte, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Service.ConfigBackoff(u, r, ref)
+}
+
+// GetDirectoryContents injects a fault before capturing the contents of a directory for a commit.
+func (s *faultSCM) GetDirectoryContents(u *library.User, r *library.Repo, dir, ref string) (map[string][]byte, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Service.GetDirectoryContents(u, r, dir, ref)
+}
+
+// Status injects a fault before sending the commit status for a build.
+func (s *faultSCM) Status(u *library.User, b *library.Build, org, name string) error {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return err
+	}
+
+	return s.Service.Status(u, b, org, name)
+}
+
+// GetRepo injects a fault before capturing a repo.
+func (s *faultSCM) GetRepo(u *library.User, r *library.Repo) (*library.Repo, error) {
+	err := Inject(TargetSCM)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Service.GetRepo(u, r)
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package importer provides the ability for Vela to convert the
+// configuration of jobs in other CI systems, like Drone and
+// Jenkins, into repo settings, placeholders for secrets and a
+// starter pipeline to ease migrations to Vela.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/importer"
+package importer
@@ -0,0 +1,441 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/buildkite/yaml"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+)
+
+// SourceDrone is the source for migrations from Drone.
+const SourceDrone = "drone"
+
+type (
+	// droneResource represents a document in the Drone configuration.
+	droneResource struct {
+		Kind        string                 `yaml:"kind"`
+		Type        string                 `yaml:"type"`
+		Name        string                 `yaml:"name"`
+		Environment map[string]interface{} `yaml:"environment"`
+		Trigger     droneConditions        `yaml:"trigger"`
+		DependsOn   []string               `yaml:"depends_on"`
+		Services    []*droneContainer      `yaml:"services"`
+		Steps       []*droneContainer      `yaml:"steps"`
+	}
+
+	// droneContainer represents a step or service in a Drone pipeline.
+	droneContainer struct {
+		Name        string                 `yaml:"name"`
+		Image       string                 `yaml:"image"`
+		Pull        string                 `yaml:"pull"`
+		Detach      bool                   `yaml:"detach"`
+		Privileged  bool                   `yaml:"privileged"`
+		Failure     string                 `yaml:"failure"`
+		Environment map[string]interface{} `yaml:"environment"`
+		Settings    map[string]interface{} `yaml:"settings"`
+		Entrypoint  []string               `yaml:"entrypoint"`
+		Commands    []string               `yaml:"commands"`
+		When        droneConditions        `yaml:"when"`
+	}
+
+	// droneConditions represents the conditions for a Drone pipeline or step.
+	droneConditions struct {
+		Branch droneFilter `yaml:"branch"`
+		Cron   droneFilter `yaml:"cron"`
+		Event  droneFilter `yaml:"event"`
+		Paths  droneFilter `yaml:"paths"`
+		Ref    droneFilter `yaml:"ref"`
+		Repo   droneFilter `yaml:"repo"`
+		Status droneFilter `yaml:"status"`
+		Target droneFilter `yaml:"target"`
+	}
+
+	// droneFilter represents the values to include or exclude for a Drone condition.
+	droneFilter struct {
+		Include []string
+		Exclude []string
+	}
+)
+
+// UnmarshalYAML implements the Unmarshaler interface for the droneFilter type.
+//
+// A filter can be a single value, a list of values or
+// a map with the values to include and exclude.
+func (f *droneFilter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// attempt to unmarshal as a single value
+	var value string
+	if err := unmarshal(&value); err == nil {
+		f.Include = []string{value}
+
+		return nil
+	}
+
+	// attempt to unmarshal as a list of values
+	var values []string
+	if err := unmarshal(&values); err == nil {
+		f.Include = values
+
+		return nil
+	}
+
+	// attempt to unmarshal as values to include and exclude
+	filter := new(struct {
+		Include []string `yaml:"include"`
+		Exclude []string `yaml:"exclude"`
+	})
+
+	err := unmarshal(filter)
+	if err != nil {
+		return err
+	}
+
+	f.Include = filter.Include
+	f.Exclude = filter.Exclude
+
+	return nil
+}
+
+// Drone converts the Drone configuration for the provided
+// repo into repo settings, placeholders for secrets and a
+// starter pipeline. Each Drone pipeline becomes a stage
+// when the configuration contains more than one pipeline.
+func Drone(repo string, data []byte) (*api.Migration, error) {
+	m := newMigration(repo)
+
+	pipelines := []*droneResource{}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		resource := new(droneResource)
+
+		err := decoder.Decode(resource)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse drone configuration: %w", err)
+		}
+
+		switch resource.Kind {
+		case "pipeline", "":
+			// skip empty documents in the configuration
+			if len(resource.Steps) == 0 {
+				continue
+			}
+
+			pipelines = append(pipelines, resource)
+		case "secret":
+			m.secret(resource.Name)
+		case "signature":
+			continue
+		default:
+			m.warn("resource %s of kind %s is not supported", resource.Name, resource.Kind)
+		}
+	}
+
+	if len(pipelines) == 0 {
+		return nil, fmt.Errorf("no drone pipelines found in configuration")
+	}
+
+	p := &pipeline{Version: "1"}
+
+	for _, resource := range pipelines {
+		if len(resource.Type) > 0 && resource.Type != "docker" && resource.Type != "kubernetes" {
+			m.warn("pipeline %s of type %s runs outside of containers, add images to the steps", resource.Name, resource.Type)
+		}
+
+		m.droneTrigger(resource)
+
+		for _, s := range resource.Services {
+			p.Services = append(p.Services, m.droneService(s))
+		}
+
+		steps := []*container{}
+
+		for _, s := range resource.Steps {
+			steps = append(steps, m.droneStep(resource, s))
+		}
+
+		// a single pipeline is converted to steps
+		if len(pipelines) == 1 {
+			p.Steps = steps
+
+			break
+		}
+
+		p.Stages = append(p.Stages, yaml.MapItem{
+			Key:   resource.Name,
+			Value: &stage{Needs: resource.DependsOn, Steps: steps},
+		})
+	}
+
+	return m.result(SourceDrone, p)
+}
+
+// droneTrigger is a helper function to capture the events and
+// the default branch for the repo from the trigger of the pipeline.
+func (m *migration) droneTrigger(resource *droneResource) {
+	events := resource.Trigger.Event.Include
+
+	// Drone runs pipelines without event conditions for every event
+	if len(events) == 0 {
+		events = []string{"push", "pull_request", "tag", "promote"}
+	}
+
+	for _, event := range events {
+		e, ok := droneEvent(event)
+		if !ok {
+			m.warn("pipeline %s is triggered by the %s event which is not supported", resource.Name, event)
+
+			continue
+		}
+
+		m.event(e)
+	}
+
+	if len(resource.Trigger.Cron.Include) > 0 {
+		m.warn("pipeline %s is triggered by cron jobs which are not supported", resource.Name)
+	}
+
+	// capture the default branch when the pipeline only runs for one branch
+	if len(m.branch) == 0 && len(resource.Trigger.Branch.Include) == 1 &&
+		!strings.ContainsAny(resource.Trigger.Branch.Include[0], "*?[") {
+		m.branch = resource.Trigger.Branch.Include[0]
+	}
+}
+
+// droneStep is a helper function to convert a step in a Drone pipeline.
+func (m *migration) droneStep(resource *droneResource, s *droneContainer) *container {
+	c := &container{
+		Name:       s.Name,
+		Image:      s.Image,
+		Pull:       dronePull(s.Pull),
+		Detach:     s.Detach,
+		Privileged: s.Privileged,
+		Entrypoint: s.Entrypoint,
+		Commands:   s.Commands,
+	}
+
+	if len(c.Image) == 0 {
+		m.warn("step %s has no image", s.Name)
+	}
+
+	// pipeline environment is injected into every step
+	m.droneEnvironment(c, resource.Environment)
+	m.droneEnvironment(c, s.Environment)
+
+	if len(s.Settings) > 0 {
+		m.warn("step %s uses the Drone plugin %s, replace it with an equivalent Vela plugin", s.Name, s.Image)
+
+		c.Parameters = make(map[string]interface{})
+
+		for _, key := range sortedKeys(s.Settings) {
+			value := s.Settings[key]
+
+			// plugin settings from secrets are injected as secrets
+			if name, ok := droneSecret(value); ok {
+				m.secret(name)
+
+				c.Secrets = append(c.Secrets, &stepSecret{
+					Source: name,
+					Target: fmt.Sprintf("PLUGIN_%s", strings.ToUpper(key)),
+				})
+
+				continue
+			}
+
+			c.Parameters[key] = value
+		}
+	}
+
+	// the conditions of the step take precedence over the trigger of the pipeline
+	when := s.When
+	if len(when.Branch.Include) == 0 && len(when.Branch.Exclude) == 0 {
+		when.Branch = resource.Trigger.Branch
+	}
+
+	if len(when.Event.Include) == 0 && len(when.Event.Exclude) == 0 {
+		when.Event = resource.Trigger.Event
+	}
+
+	c.Ruleset = m.droneRuleset(s.Name, when)
+
+	if s.Failure == "ignore" {
+		if c.Ruleset == nil {
+			c.Ruleset = new(ruleset)
+		}
+
+		c.Ruleset.Continue = true
+	}
+
+	return c
+}
+
+// droneService is a helper function to convert a service in a Drone pipeline.
+func (m *migration) droneService(s *droneContainer) *container {
+	c := &container{
+		Name:       s.Name,
+		Image:      s.Image,
+		Pull:       dronePull(s.Pull),
+		Entrypoint: s.Entrypoint,
+	}
+
+	m.droneEnvironment(c, s.Environment)
+
+	if len(s.Commands) > 0 {
+		m.warn("service %s runs commands which are not supported for services, use an entrypoint", s.Name)
+	}
+
+	return c
+}
+
+// droneEnvironment is a helper function to add the Drone environment
+// to the container. Values from secrets are injected as secrets.
+func (m *migration) droneEnvironment(c *container, environment map[string]interface{}) {
+	for _, key := range sortedKeys(environment) {
+		value := environment[key]
+
+		if name, ok := droneSecret(value); ok {
+			m.secret(name)
+
+			c.Secrets = append(c.Secrets, &stepSecret{Source: name, Target: key})
+
+			continue
+		}
+
+		if c.Environment == nil {
+			c.Environment = make(map[string]string)
+		}
+
+		c.Environment[key] = fmt.Sprintf("%v", value)
+	}
+}
+
+// droneRuleset is a helper function to convert the conditions
+// for a Drone step into a ruleset. It returns nil when the
+// step has no conditions.
+func (m *migration) droneRuleset(name string, when droneConditions) *ruleset {
+	include := &rules{
+		Branch: when.Branch.Include,
+		Path:   when.Paths.Include,
+		Repo:   when.Repo.Include,
+		Status: when.Status.Include,
+		Target: when.Target.Include,
+	}
+
+	exclude := &rules{
+		Branch: when.Branch.Exclude,
+		Path:   when.Paths.Exclude,
+		Repo:   when.Repo.Exclude,
+		Status: when.Status.Exclude,
+		Target: when.Target.Exclude,
+	}
+
+	for _, event := range when.Event.Include {
+		if e, ok := droneEvent(event); ok {
+			include.Event = append(include.Event, e)
+		}
+	}
+
+	for _, event := range when.Event.Exclude {
+		if e, ok := droneEvent(event); ok {
+			exclude.Event = append(exclude.Event, e)
+		}
+	}
+
+	// only tag references can be converted to Vela rules
+	for _, ref := range when.Ref.Include {
+		if !strings.HasPrefix(ref, "refs/tags/") {
+			m.warn("step %s has a condition for the ref %s which is not supported", name, ref)
+
+			continue
+		}
+
+		include.Tag = append(include.Tag, strings.TrimPrefix(ref, "refs/tags/"))
+	}
+
+	r := new(ruleset)
+
+	if !include.empty() {
+		r.If = include
+	}
+
+	if !exclude.empty() {
+		r.Unless = exclude
+	}
+
+	if r.If == nil && r.Unless == nil {
+		return nil
+	}
+
+	return r
+}
+
+// droneSecret is a helper function to capture the name of
+// the secret when the value is sourced from a secret.
+func droneSecret(value interface{}) (string, bool) {
+	v, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return "", false
+	}
+
+	name, ok := v["from_secret"].(string)
+
+	return name, ok
+}
+
+// sortedKeys is a helper function to capture the keys of the
+// map in order, so the pipeline is the same for every request.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// droneEvent is a helper function to match a Drone event with a Vela event.
+func droneEvent(event string) (string, bool) {
+	switch event {
+	case "push":
+		return constants.EventPush, true
+	case "pull_request":
+		return constants.EventPull, true
+	case "tag":
+		return constants.EventTag, true
+	case "promote", "rollback":
+		return constants.EventDeploy, true
+	default:
+		return "", false
+	}
+}
+
+// dronePull is a helper function to match a Drone pull policy with a Vela pull policy.
+func dronePull(pull string) string {
+	switch pull {
+	case "always":
+		return constants.PullAlways
+	case "never":
+		return constants.PullNever
+	case "if-not-exists":
+		return constants.PullNotPresent
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package importer
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/buildkite/yaml"
+	types "github.com/go-vela/types/yaml"
+)
+
+func TestImporter_Drone(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		file     string
+		want     string
+		events   []string
+		secrets  []string
+		branch   string
+		warnings int
+	}{
+		{
+			name:     "single pipeline",
+			file:     "testdata/drone.yml",
+			want:     "testdata/drone_pipeline.yml",
+			events:   []string{"push", "pull_request", "tag"},
+			secrets:  []string{"slack_webhook", "database_password", "docker_password"},
+			branch:   "main",
+			warnings: 2,
+		},
+		{
+			name:     "multiple pipelines",
+			file:     "testdata/drone_multiple.yml",
+			want:     "testdata/drone_multiple_pipeline.yml",
+			events:   []string{"push", "pull_request", "tag", "deployment"},
+			secrets:  []string{},
+			warnings: 1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := os.ReadFile(test.file)
+			if err != nil {
+				t.Fatalf("unable to read file %s: %v", test.file, err)
+			}
+
+			want, err := os.ReadFile(test.want)
+			if err != nil {
+				t.Fatalf("unable to read file %s: %v", test.want, err)
+			}
+
+			got, err := Drone("github/octocat", data)
+			if err != nil {
+				t.Fatalf("Drone returned err: %v", err)
+			}
+
+			if got.GetSource() != SourceDrone {
+				t.Errorf("Drone source is %s, want %s", got.GetSource(), SourceDrone)
+			}
+
+			if got.GetPipeline() != string(want) {
+				t.Errorf("Drone pipeline is %s, want %s", got.GetPipeline(), want)
+			}
+
+			if !reflect.DeepEqual(got.GetEvents(), test.events) {
+				t.Errorf("Drone events are %v, want %v", got.GetEvents(), test.events)
+			}
+
+			if !reflect.DeepEqual(got.GetSecrets(), test.secrets) {
+				t.Errorf("Drone secrets are %v, want %v", got.GetSecrets(), test.secrets)
+			}
+
+			if got.GetBranch() != test.branch {
+				t.Errorf("Drone branch is %s, want %s", got.GetBranch(), test.branch)
+			}
+
+			if len(got.GetWarnings()) != test.warnings {
+				t.Errorf("Drone returned %d warnings, want %d: %v", len(got.GetWarnings()), test.warnings, got.GetWarnings())
+			}
+
+			// the pipeline must be valid Vela configuration
+			err = yaml.Unmarshal([]byte(got.GetPipeline()), new(types.Build))
+			if err != nil {
+				t.Errorf("Drone pipeline is not valid: %v", err)
+			}
+		})
+	}
+}
+
+func TestImporter_Drone_Failure(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "invalid yaml",
+			data: "steps: [",
+		},
+		{
+			name: "no pipelines",
+			data: "kind: secret\nname: token\n",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Drone("github/octocat", []byte(test.data))
+			if err == nil {
+				t.Errorf("Drone should have returned err")
+			}
+		})
+	}
+}
+
+func TestImporter_droneFilter(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		data string
+		want droneFilter
+	}{
+		{
+			name: "single value",
+			data: "main",
+			want: droneFilter{Include: []string{"main"}},
+		},
+		{
+			name: "list of values",
+			data: "[main, dev]",
+			want: droneFilter{Include: []string{"main", "dev"}},
+		},
+		{
+			name: "include and exclude",
+			data: "{include: [main], exclude: [dev]}",
+			want: droneFilter{Include: []string{"main"}, Exclude: []string{"dev"}},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := droneFilter{}
+
+			err := yaml.Unmarshal([]byte(test.data), &got)
+			if err != nil {
+				t.Fatalf("Unmarshal returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Unmarshal is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package importer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+)
+
+// SourceJenkins is the source for migrations from Jenkins.
+const SourceJenkins = "jenkins"
+
+type (
+	// jenkinsProject represents the configuration of a Jenkins freestyle job.
+	jenkinsProject struct {
+		XMLName    xml.Name           `xml:"project"`
+		Branches   []string           `xml:"scm>branches>hudson.plugins.git.BranchSpec>name"`
+		Parameters []jenkinsParameter `xml:"properties>hudson.model.ParametersDefinitionProperty>parameterDefinitions>hudson.model.StringParameterDefinition"`
+		Triggers   jenkinsElements    `xml:"triggers"`
+		Builders   jenkinsBuilders    `xml:"builders"`
+		Wrappers   jenkinsWrappers    `xml:"buildWrappers"`
+		Publishers jenkinsElements    `xml:"publishers"`
+	}
+
+	// jenkinsParameter represents a string parameter for a Jenkins job.
+	jenkinsParameter struct {
+		Name         string `xml:"name"`
+		DefaultValue string `xml:"defaultValue"`
+	}
+
+	// jenkinsBuilders represents the build steps for a Jenkins job.
+	jenkinsBuilders struct {
+		Elements []jenkinsElement `xml:",any"`
+	}
+
+	// jenkinsWrappers represents the build wrappers for a Jenkins job.
+	jenkinsWrappers struct {
+		Timeout  int64              `xml:"hudson.plugins.build__timeout.BuildTimeoutWrapper>strategy>timeoutMinutes"`
+		Bindings jenkinsCredentials `xml:"org.jenkinsci.plugins.credentialsbinding.impl.SecretBuildWrapper>bindings"`
+	}
+
+	// jenkinsCredentials represents the credentials bindings for a Jenkins job.
+	jenkinsCredentials struct {
+		Elements []jenkinsBinding `xml:",any"`
+	}
+
+	// jenkinsBinding represents a credentials binding for a Jenkins job.
+	jenkinsBinding struct {
+		XMLName          xml.Name
+		CredentialsID    string `xml:"credentialsId"`
+		Variable         string `xml:"variable"`
+		UsernameVariable string `xml:"usernameVariable"`
+		PasswordVariable string `xml:"passwordVariable"`
+	}
+
+	// jenkinsElements represents a list of plugin elements for a Jenkins job.
+	jenkinsElements struct {
+		Elements []jenkinsElement `xml:",any"`
+	}
+
+	// jenkinsElement represents a plugin element for a Jenkins job.
+	jenkinsElement struct {
+		XMLName xml.Name
+		Command string `xml:"command"`
+	}
+)
+
+// Jenkins converts the configuration (config.xml) of a Jenkins
+// freestyle job for the provided repo into repo settings,
+// placeholders for secrets and a starter pipeline. Each shell
+// build step becomes a step running in the provided image.
+func Jenkins(repo, image string, data []byte) (*api.Migration, error) {
+	m := newMigration(repo)
+
+	job := new(jenkinsProject)
+
+	// Jenkins declares XML version 1.1 which isn't supported
+	// by the decoder, so the declaration is removed
+	trimmed := bytes.TrimSpace(data)
+	if bytes.HasPrefix(trimmed, []byte("<?xml")) {
+		if end := bytes.Index(trimmed, []byte("?>")); end > 0 {
+			data = trimmed[end+2:]
+		}
+	}
+
+	err := xml.Unmarshal(data, job)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse jenkins configuration, only freestyle jobs are supported: %w", err)
+	}
+
+	m.jenkinsTriggers(job.Triggers)
+
+	// capture the default branch when the job only builds one branch
+	if len(job.Branches) == 1 {
+		branch := strings.TrimPrefix(job.Branches[0], "*/")
+
+		if !strings.ContainsAny(branch, "*?[") {
+			m.branch = branch
+		}
+	}
+
+	if job.Wrappers.Timeout > 0 {
+		m.timeout = job.Wrappers.Timeout
+	}
+
+	p := &pipeline{Version: "1"}
+
+	environment := map[string]string{}
+
+	// job parameters are injected into every step with the default value
+	for _, param := range job.Parameters {
+		environment[param.Name] = param.DefaultValue
+	}
+
+	if len(job.Parameters) > 0 {
+		m.warn("job parameters were converted to environment variables with their default values")
+	}
+
+	secrets := m.jenkinsBindings(job.Wrappers.Bindings.Elements)
+
+	for i, builder := range job.Builders.Elements {
+		if builder.XMLName.Local != "hudson.tasks.Shell" {
+			m.warn("build step %s is not supported", builder.XMLName.Local)
+
+			continue
+		}
+
+		c := &container{
+			Name:     "shell-" + strconv.Itoa(i+1),
+			Image:    image,
+			Secrets:  secrets,
+			Commands: jenkinsCommands(builder.Command),
+		}
+
+		if len(environment) > 0 {
+			c.Environment = environment
+		}
+
+		p.Steps = append(p.Steps, c)
+	}
+
+	if len(p.Steps) == 0 {
+		return nil, fmt.Errorf("no shell build steps found in jenkins configuration")
+	}
+
+	m.warn("shell build steps run in the %s image, replace it with an image containing the tools for the job", image)
+
+	for _, publisher := range job.Publishers.Elements {
+		m.warn("post-build action %s is not supported", publisher.XMLName.Local)
+	}
+
+	return m.result(SourceJenkins, p)
+}
+
+// jenkinsTriggers is a helper function to capture the
+// events for the repo from the triggers of the job.
+func (m *migration) jenkinsTriggers(triggers jenkinsElements) {
+	for _, trigger := range triggers.Elements {
+		switch trigger.XMLName.Local {
+		case "hudson.triggers.SCMTrigger", "com.cloudbees.jenkins.GitHubPushTrigger":
+			m.event(constants.EventPush)
+		case "org.jenkinsci.plugins.ghprb.GhprbTrigger":
+			m.event(constants.EventPull)
+		case "hudson.triggers.TimerTrigger":
+			m.warn("trigger %s for periodic builds is not supported", trigger.XMLName.Local)
+		default:
+			m.warn("trigger %s is not supported", trigger.XMLName.Local)
+		}
+	}
+}
+
+// jenkinsBindings is a helper function to convert the credentials
+// bound to the job into secrets injected into the steps.
+func (m *migration) jenkinsBindings(bindings []jenkinsBinding) []*stepSecret {
+	secrets := []*stepSecret{}
+
+	for _, binding := range bindings {
+		// username and password credentials are split into a secret for each
+		if len(binding.UsernameVariable) > 0 || len(binding.PasswordVariable) > 0 {
+			for _, variable := range []struct{ suffix, name string }{
+				{"username", binding.UsernameVariable},
+				{"password", binding.PasswordVariable},
+			} {
+				if len(variable.name) == 0 {
+					continue
+				}
+
+				name := fmt.Sprintf("%s_%s", binding.CredentialsID, variable.suffix)
+
+				m.secret(name)
+
+				secrets = append(secrets, &stepSecret{Source: name, Target: variable.name})
+			}
+
+			continue
+		}
+
+		if len(binding.Variable) == 0 {
+			m.warn("credentials binding %s is not supported", binding.XMLName.Local)
+
+			continue
+		}
+
+		m.secret(binding.CredentialsID)
+
+		secrets = append(secrets, &stepSecret{Source: binding.CredentialsID, Target: binding.Variable})
+	}
+
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	return secrets
+}
+
+// jenkinsCommands is a helper function to split the
+// script for a shell build step into commands.
+func jenkinsCommands(script string) []string {
+	commands := []string{}
+
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+
+		// skip empty lines and comments, including the shebang
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		commands = append(commands, line)
+	}
+
+	return commands
+}
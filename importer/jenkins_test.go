@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package importer
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/buildkite/yaml"
+	types "github.com/go-vela/types/yaml"
+)
+
+func TestImporter_Jenkins(t *testing.T) {
+	// setup types
+	data, err := os.ReadFile("testdata/jenkins.xml")
+	if err != nil {
+		t.Fatalf("unable to read file: %v", err)
+	}
+
+	want, err := os.ReadFile("testdata/jenkins_pipeline.yml")
+	if err != nil {
+		t.Fatalf("unable to read file: %v", err)
+	}
+
+	// run test
+	got, err := Jenkins("github/octocat", "golang:1.19", data)
+	if err != nil {
+		t.Fatalf("Jenkins returned err: %v", err)
+	}
+
+	if got.GetSource() != SourceJenkins {
+		t.Errorf("Jenkins source is %s, want %s", got.GetSource(), SourceJenkins)
+	}
+
+	if got.GetPipeline() != string(want) {
+		t.Errorf("Jenkins pipeline is %s, want %s", got.GetPipeline(), want)
+	}
+
+	if !reflect.DeepEqual(got.GetEvents(), []string{"push"}) {
+		t.Errorf("Jenkins events are %v, want [push]", got.GetEvents())
+	}
+
+	secrets := []string{"sonar_token", "artifactory_username", "artifactory_password"}
+
+	if !reflect.DeepEqual(got.GetSecrets(), secrets) {
+		t.Errorf("Jenkins secrets are %v, want %v", got.GetSecrets(), secrets)
+	}
+
+	if got.GetBranch() != "main" {
+		t.Errorf("Jenkins branch is %s, want main", got.GetBranch())
+	}
+
+	if got.GetTimeout() != 45 {
+		t.Errorf("Jenkins timeout is %d, want 45", got.GetTimeout())
+	}
+
+	if len(got.GetWarnings()) != 4 {
+		t.Errorf("Jenkins returned %d warnings, want 4: %v", len(got.GetWarnings()), got.GetWarnings())
+	}
+
+	// the pipeline must be valid Vela configuration
+	err = yaml.Unmarshal([]byte(got.GetPipeline()), new(types.Build))
+	if err != nil {
+		t.Errorf("Jenkins pipeline is not valid: %v", err)
+	}
+}
+
+func TestImporter_Jenkins_Failure(t *testing.T) {
+	// setup types
+	flow, err := os.ReadFile("testdata/jenkins_flow.xml")
+	if err != nil {
+		t.Fatalf("unable to read file: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "pipeline job",
+			data: flow,
+		},
+		{
+			name: "no shell build steps",
+			data: []byte("<project><builders></builders></project>"),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Jenkins("github/octocat", "alpine:latest", test.data)
+			if err == nil {
+				t.Errorf("Jenkins should have returned err")
+			}
+		})
+	}
+}
+
+func TestImporter_jenkinsCommands(t *testing.T) {
+	// setup types
+	script := "#!/bin/bash\n\n# run the tests\nmake test\n  make lint  \n"
+
+	want := []string{"make test", "make lint"}
+
+	// run test
+	got := jenkinsCommands(script)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("jenkinsCommands is %v, want %v", got, want)
+	}
+}
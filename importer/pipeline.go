@@ -0,0 +1,180 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package importer
+
+import (
+	"fmt"
+
+	"github.com/buildkite/yaml"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+)
+
+// placeholderRepo is the full name used in the keys for
+// the secrets when no repo is provided for the migration.
+const placeholderRepo = "org/repo"
+
+type (
+	// pipeline represents the starter Vela pipeline generated
+	// for a migration. The fields are declared in the order
+	// they are written to the pipeline.
+	pipeline struct {
+		Version  string        `yaml:"version"`
+		Secrets  []*secret     `yaml:"secrets,omitempty"`
+		Services []*container  `yaml:"services,omitempty"`
+		Stages   yaml.MapSlice `yaml:"stages,omitempty"`
+		Steps    []*container  `yaml:"steps,omitempty"`
+	}
+
+	// secret represents a secret declared for the pipeline.
+	secret struct {
+		Name   string `yaml:"name"`
+		Key    string `yaml:"key"`
+		Engine string `yaml:"engine"`
+		Type   string `yaml:"type"`
+	}
+
+	// stage represents a stage of steps in the pipeline.
+	stage struct {
+		Needs []string     `yaml:"needs,omitempty,flow"`
+		Steps []*container `yaml:"steps"`
+	}
+
+	// container represents a step or service in the pipeline.
+	container struct {
+		Name        string                 `yaml:"name"`
+		Image       string                 `yaml:"image"`
+		Pull        string                 `yaml:"pull,omitempty"`
+		Detach      bool                   `yaml:"detach,omitempty"`
+		Privileged  bool                   `yaml:"privileged,omitempty"`
+		Ruleset     *ruleset               `yaml:"ruleset,omitempty"`
+		Environment map[string]string      `yaml:"environment,omitempty"`
+		Secrets     []*stepSecret          `yaml:"secrets,omitempty"`
+		Parameters  map[string]interface{} `yaml:"parameters,omitempty"`
+		Entrypoint  []string               `yaml:"entrypoint,omitempty"`
+		Commands    []string               `yaml:"commands,omitempty"`
+	}
+
+	// stepSecret represents a secret injected into a step.
+	stepSecret struct {
+		Source string `yaml:"source"`
+		Target string `yaml:"target"`
+	}
+
+	// ruleset represents the conditions to run a step.
+	ruleset struct {
+		If       *rules `yaml:"if,omitempty"`
+		Unless   *rules `yaml:"unless,omitempty"`
+		Continue bool   `yaml:"continue,omitempty"`
+	}
+
+	// rules represents the rules for a ruleset.
+	rules struct {
+		Branch []string `yaml:"branch,omitempty,flow"`
+		Event  []string `yaml:"event,omitempty,flow"`
+		Path   []string `yaml:"path,omitempty,flow"`
+		Repo   []string `yaml:"repo,omitempty,flow"`
+		Status []string `yaml:"status,omitempty,flow"`
+		Tag    []string `yaml:"tag,omitempty,flow"`
+		Target []string `yaml:"target,omitempty,flow"`
+	}
+
+	// migration represents the state collected while
+	// converting the configuration of a job.
+	migration struct {
+		repo     string
+		branch   string
+		timeout  int64
+		events   []string
+		secrets  []string
+		warnings []string
+	}
+)
+
+// empty returns whether the rules have no conditions.
+func (r *rules) empty() bool {
+	return r == nil || (len(r.Branch) == 0 && len(r.Event) == 0 &&
+		len(r.Path) == 0 && len(r.Repo) == 0 && len(r.Status) == 0 &&
+		len(r.Tag) == 0 && len(r.Target) == 0)
+}
+
+// newMigration creates the state for converting the
+// configuration of a job for the provided repo.
+func newMigration(repo string) *migration {
+	m := &migration{
+		repo:     repo,
+		secrets:  []string{},
+		warnings: []string{},
+	}
+
+	if len(repo) == 0 {
+		m.repo = placeholderRepo
+
+		m.warn("replace %s in the keys for the secrets with the full name of the repo", placeholderRepo)
+	}
+
+	return m
+}
+
+// event adds the Vela event to the events enabled for the repo.
+func (m *migration) event(event string) {
+	for _, e := range m.events {
+		if e == event {
+			return
+		}
+	}
+
+	m.events = append(m.events, event)
+}
+
+// secret adds the secret to the placeholders for the repo.
+func (m *migration) secret(name string) {
+	for _, s := range m.secrets {
+		if s == name {
+			return
+		}
+	}
+
+	m.secrets = append(m.secrets, name)
+}
+
+// warn adds a warning about configuration that couldn't be converted.
+func (m *migration) warn(format string, args ...interface{}) {
+	m.warnings = append(m.warnings, fmt.Sprintf(format, args...))
+}
+
+// result renders the pipeline and creates the migration
+// with the settings, secrets and warnings collected.
+func (m *migration) result(source string, p *pipeline) (*api.Migration, error) {
+	// default the events to the events enabled for new repos
+	if len(m.events) == 0 {
+		m.events = []string{constants.EventPush, constants.EventPull}
+	}
+
+	for _, name := range m.secrets {
+		p.Secrets = append(p.Secrets, &secret{
+			Name:   name,
+			Key:    fmt.Sprintf("%s/%s", m.repo, name),
+			Engine: constants.DriverNative,
+			Type:   constants.SecretRepo,
+		})
+	}
+
+	out, err := yaml.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("unable to render pipeline: %w", err)
+	}
+
+	r := new(api.Migration)
+	r.SetSource(source)
+	r.SetBranch(m.branch)
+	r.SetTimeout(m.timeout)
+	r.SetEvents(m.events)
+	r.SetSecrets(m.secrets)
+	r.SetPipeline(string(out))
+	r.SetWarnings(m.warnings)
+
+	return r, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package intake
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the buffer in the context.
+const key = "intake"
+
+// processingKey defines the key type for marking
+// a request as processed from the intake.
+type processingKey struct{}
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the buffer
+// associated with this context.
+func FromContext(c context.Context) *Buffer {
+	// get buffer value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast buffer value to expected Buffer type
+	b, ok := v.(*Buffer)
+	if !ok {
+		return nil
+	}
+
+	return b
+}
+
+// ToContext adds the buffer to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, b *Buffer) {
+	c.Set(key, b)
+}
+
+// WithProcessing returns a copy of the context marking the
+// request as a webhook processed from the intake by the pool
+// of workers. The mark is only set in process, so it can't
+// be set by the client delivering the webhook.
+func WithProcessing(c context.Context) context.Context {
+	return context.WithValue(c, processingKey{}, true)
+}
+
+// Processing returns true when the request is
+// a webhook processed from the intake.
+func Processing(c context.Context) bool {
+	processing, ok := c.Value(processingKey{}).(bool)
+
+	return ok && processing
+}
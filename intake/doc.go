@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package intake provides the ability for Vela to buffer the
+// webhooks received from the scm in the database and process
+// them asynchronously with a pool of workers, so the scm
+// receives a response before the webhook is processed.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/intake"
+package intake
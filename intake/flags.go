@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package intake
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the webhook intake.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Intake Flags
+
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_WEBHOOK_INTAKE_WORKERS", "WEBHOOK_INTAKE_WORKERS"},
+		FilePath: "/vela/webhook/intake/workers",
+		Name:     "webhook.intake.workers",
+		Usage:    "number of workers processing the webhooks buffered in the intake (webhooks are processed when received when set to 0)",
+		Value:    0,
+	},
+	&cli.Int64Flag{
+		EnvVars:  []string{"VELA_WEBHOOK_INTAKE_CAPACITY", "WEBHOOK_INTAKE_CAPACITY"},
+		FilePath: "/vela/webhook/intake/capacity",
+		Name:     "webhook.intake.capacity",
+		Usage:    "maximum number of pending webhooks in the intake before new webhooks are rejected",
+		Value:    1000,
+	},
+	&cli.Int64Flag{
+		EnvVars:  []string{"VELA_WEBHOOK_INTAKE_RETRIES", "WEBHOOK_INTAKE_RETRIES"},
+		FilePath: "/vela/webhook/intake/retries",
+		Name:     "webhook.intake.retries",
+		Usage:    "number of times to retry a webhook in the intake that failed with a server error",
+		Value:    5,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_WEBHOOK_INTAKE_INTERVAL", "WEBHOOK_INTAKE_INTERVAL"},
+		FilePath: "/vela/webhook/intake/interval",
+		Name:     "webhook.intake.interval",
+		Usage:    "interval at which to poll the intake for webhooks to process and the initial delay between retries",
+		Value:    time.Second,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_WEBHOOK_INTAKE_VISIBILITY", "WEBHOOK_INTAKE_VISIBILITY"},
+		FilePath: "/vela/webhook/intake/visibility",
+		Name:     "webhook.intake.visibility",
+		Usage:    "duration a webhook being processed is hidden from other workers and the maximum delay between retries",
+		Value:    5 * time.Minute,
+	},
+}
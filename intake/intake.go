@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package intake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+)
+
+const (
+	// headerDelivery defines the header the scm
+	// sets with the unique ID for each webhook.
+	headerDelivery = "X-GitHub-Delivery"
+
+	// path defines the path webhooks are received on.
+	path = "/webhook"
+)
+
+// ErrFull defines the error type when the intake has reached
+// the maximum number of pending webhooks it can buffer.
+var ErrFull = errors.New("webhook intake is full")
+
+type (
+	// config represents the settings required to create the buffer.
+	config struct {
+		// specifies the number of workers processing the webhooks
+		Workers int
+		// specifies the maximum number of pending webhooks in the intake
+		Capacity int64
+		// specifies the number of times to retry a webhook that failed with a server error
+		Retries int64
+		// specifies the interval at which to poll the intake for webhooks
+		Interval time.Duration
+		// specifies the duration a webhook being processed is hidden from other workers
+		Visibility time.Duration
+	}
+
+	// Buffer represents the functionality for buffering
+	// the webhooks received from the scm in the database
+	// and processing them with a pool of workers.
+	Buffer struct {
+		// buffer configuration settings
+		config *config
+
+		// database service used to buffer the webhooks
+		database database.Service
+	}
+)
+
+// New creates and returns a buffer for webhooks.
+func New(opts ...Opt) (*Buffer, error) {
+	// create new buffer
+	b := new(Buffer)
+
+	// create new fields
+	b.config = &config{
+		Capacity:   1000,
+		Retries:    5,
+		Interval:   time.Second,
+		Visibility: 5 * time.Minute,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(b)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if the buffer is enabled without a database
+	if b.Enabled() && b.database == nil {
+		return nil, fmt.Errorf("no intake database provided")
+	}
+
+	return b, nil
+}
+
+// Enabled returns whether the buffer is configured
+// to process the webhooks received from the scm.
+func (b *Buffer) Enabled() bool {
+	return b.config.Workers > 0
+}
+
+// Accept buffers the webhook from the request, with the provided
+// payload, in the intake to be processed by the pool of workers.
+// It returns ErrFull when the intake has reached the maximum number
+// of pending webhooks, so the scm is told to back off instead of
+// the intake growing without a limit.
+func (b *Buffer) Accept(ctx context.Context, r *http.Request, payload []byte) error {
+	// send API call to capture the count of pending webhooks
+	count, err := b.database.CountWebhookIntakes(ctx, api.WebhookIntakePending)
+	if err != nil {
+		return fmt.Errorf("unable to count pending webhooks: %w", err)
+	}
+
+	// check if the intake has reached the maximum number of pending webhooks
+	if count >= b.config.Capacity {
+		return fmt.Errorf("%w: %d pending webhooks", ErrFull, count)
+	}
+
+	header, err := json.Marshal(r.Header)
+	if err != nil {
+		return fmt.Errorf("unable to capture webhook header: %w", err)
+	}
+
+	now := time.Now().UTC().Unix()
+
+	w := new(api.WebhookIntake)
+	w.SetSource(r.Header.Get(headerDelivery))
+	w.SetHeader(string(header))
+	w.SetPayload(string(payload))
+	w.SetStatus(api.WebhookIntakePending)
+	w.SetAvailable(now)
+	w.SetCreated(now)
+
+	// send API call to buffer the webhook
+	return b.database.CreateWebhookIntake(ctx, w)
+}
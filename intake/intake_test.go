@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package intake
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+)
+
+func TestIntake_New(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		enabled bool
+		opts    []Opt
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			enabled: false,
+			opts:    []Opt{},
+		},
+		{
+			name:    "enabled",
+			failure: false,
+			enabled: true,
+			opts:    []Opt{WithDatabase(db), WithWorkers(4)},
+		},
+		{
+			name:    "enabled without database",
+			failure: true,
+			opts:    []Opt{WithWorkers(4)},
+		},
+		{
+			name:    "empty database",
+			failure: true,
+			opts:    []Opt{WithDatabase(nil)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+		})
+	}
+}
+
+func TestIntake_Accept(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from webhook_intakes;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	buffer, err := New(WithDatabase(db), WithWorkers(1), WithCapacity(1))
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+	req.Header.Set("X-GitHub-Delivery", "c8da1302-07d6-11ea-882f-4893bca275b8")
+	req.Header.Set("X-GitHub-Event", "push")
+
+	// run test
+	err = buffer.Accept(context.TODO(), req, []byte(`{"ref":"refs/heads/main"}`))
+	if err != nil {
+		t.Errorf("Accept returned err: %v", err)
+	}
+
+	intakes, _ := db.ListAvailableWebhookIntakes(context.TODO(), 1<<40, 10)
+	if len(intakes) != 1 {
+		t.Errorf("ListAvailableWebhookIntakes is %d, want 1", len(intakes))
+
+		return
+	}
+
+	if intakes[0].GetSource() != "c8da1302-07d6-11ea-882f-4893bca275b8" {
+		t.Errorf("Accept source is %s", intakes[0].GetSource())
+	}
+
+	if intakes[0].GetStatus() != api.WebhookIntakePending {
+		t.Errorf("Accept status is %s, want %s", intakes[0].GetStatus(), api.WebhookIntakePending)
+	}
+
+	if intakes[0].GetPayload() != `{"ref":"refs/heads/main"}` {
+		t.Errorf("Accept payload is %s", intakes[0].GetPayload())
+	}
+
+	// the intake is full
+	err = buffer.Accept(context.TODO(), req, []byte(`{"ref":"refs/heads/main"}`))
+	if !errors.Is(err, ErrFull) {
+		t.Errorf("Accept is %v, want %v", err, ErrFull)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package intake
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the buffer.
+type Opt func(*Buffer) error
+
+// WithDatabase sets the database service in the buffer.
+func WithDatabase(db database.Service) Opt {
+	return func(b *Buffer) error {
+		// check if the database service provided is empty
+		if db == nil {
+			return fmt.Errorf("no intake database provided")
+		}
+
+		// set the database service in the buffer
+		b.database = db
+
+		return nil
+	}
+}
+
+// WithWorkers sets the number of workers processing the webhooks in the buffer.
+func WithWorkers(workers int) Opt {
+	return func(b *Buffer) error {
+		// check if the workers provided is negative
+		if workers < 0 {
+			return fmt.Errorf("invalid intake workers provided: %d", workers)
+		}
+
+		// set the workers in the buffer
+		b.config.Workers = workers
+
+		return nil
+	}
+}
+
+// WithCapacity sets the maximum number of pending webhooks in the buffer.
+func WithCapacity(capacity int64) Opt {
+	return func(b *Buffer) error {
+		// check if the capacity provided is positive
+		if capacity <= 0 {
+			return fmt.Errorf("invalid intake capacity provided: %d", capacity)
+		}
+
+		// set the capacity in the buffer
+		b.config.Capacity = capacity
+
+		return nil
+	}
+}
+
+// WithRetries sets the number of times to retry a failed webhook in the buffer.
+func WithRetries(retries int64) Opt {
+	return func(b *Buffer) error {
+		// check if the retries provided is negative
+		if retries < 0 {
+			return fmt.Errorf("invalid intake retries provided: %d", retries)
+		}
+
+		// set the retries in the buffer
+		b.config.Retries = retries
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to poll the intake for webhooks in the buffer.
+func WithInterval(interval time.Duration) Opt {
+	return func(b *Buffer) error {
+		// check if the interval provided is positive
+		if interval <= 0 {
+			return fmt.Errorf("invalid intake interval provided: %s", interval)
+		}
+
+		// set the interval in the buffer
+		b.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithVisibility sets the duration a webhook being processed is hidden in the buffer.
+func WithVisibility(visibility time.Duration) Opt {
+	return func(b *Buffer) error {
+		// check if the visibility provided is positive
+		if visibility <= 0 {
+			return fmt.Errorf("invalid intake visibility provided: %s", visibility)
+		}
+
+		// set the visibility in the buffer
+		b.config.Visibility = visibility
+
+		return nil
+	}
+}
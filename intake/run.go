@@ -0,0 +1,270 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package intake
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/scm/verify"
+	"github.com/sirupsen/logrus"
+)
+
+// errorLimit defines the maximum length of
+// the error recorded for a webhook in the intake.
+const errorLimit = 1000
+
+// Start processes the webhooks buffered in the intake through the
+// provided handler with the pool of workers until the channel is
+// closed. The webhooks being processed complete before it returns.
+func (b *Buffer) Start(h http.Handler, dying <-chan struct{}) error {
+	logrus.Infof("starting webhook intake with %d workers every %s", b.config.Workers, b.config.Interval)
+
+	// the channel is unbuffered so webhooks are only
+	// claimed from the intake when a worker is free
+	webhooks := make(chan *api.WebhookIntake)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < b.config.Workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for w := range webhooks {
+				b.process(h, w)
+			}
+		}()
+	}
+
+	defer wg.Wait()
+	defer close(webhooks)
+
+	ticker := time.NewTicker(b.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			b.dispatch(webhooks, dying)
+		}
+	}
+}
+
+// dispatch is a helper function to send the webhooks available
+// in the intake to the pool of workers until the intake is drained.
+func (b *Buffer) dispatch(webhooks chan<- *api.WebhookIntake, dying <-chan struct{}) {
+	for {
+		// send API call to capture the available webhooks
+		available, err := b.database.ListAvailableWebhookIntakes(context.Background(), time.Now().UTC().Unix(), b.config.Workers)
+		if err != nil {
+			logrus.Errorf("unable to list available webhooks in the intake: %v", err)
+
+			return
+		}
+
+		for _, w := range available {
+			select {
+			case webhooks <- w:
+			case <-dying:
+				return
+			}
+		}
+
+		// check if the intake may have more available webhooks
+		if len(available) < b.config.Workers {
+			return
+		}
+	}
+}
+
+// process is a helper function to claim the webhook from the intake
+// and send it through the handler. The webhook is removed from the
+// intake unless it failed with a server error, in which case it is
+// retried until it runs out of retries.
+func (b *Buffer) process(h http.Handler, w *api.WebhookIntake) {
+	ctx := context.Background()
+
+	claimed, err := b.claim(ctx, w)
+	if err != nil {
+		logrus.Errorf("unable to claim webhook %s from the intake: %v", w.GetSource(), err)
+
+		return
+	}
+
+	// check if the webhook was claimed by another worker
+	if !claimed {
+		return
+	}
+
+	// release the delivery recorded by a previous attempt that
+	// didn't complete, like when the server shut down while the
+	// webhook was being processed, so it is not rejected as a replay
+	if w.GetAttempts() > 1 {
+		b.release(ctx, w.GetSource())
+	}
+
+	status, err := replay(h, w)
+	if err == nil && status >= http.StatusInternalServerError {
+		err = fmt.Errorf("received status %d", status)
+	}
+
+	if err != nil {
+		b.retry(ctx, w, err)
+
+		return
+	}
+
+	// send API call to remove the processed webhook
+	err = b.database.DeleteWebhookIntake(ctx, w)
+	if err != nil {
+		logrus.Errorf("unable to delete webhook %s from the intake: %v", w.GetSource(), err)
+	}
+}
+
+// claim is a helper function to hide the webhook from the other
+// workers for the visibility duration while it is being processed.
+func (b *Buffer) claim(ctx context.Context, w *api.WebhookIntake) (bool, error) {
+	previous := w.GetAvailable()
+
+	w.SetAttempts(w.GetAttempts() + 1)
+	w.SetAvailable(time.Now().UTC().Add(b.config.Visibility).Unix())
+
+	// send API call to claim the webhook
+	return b.database.ClaimWebhookIntake(ctx, w, previous)
+}
+
+// retry is a helper function to record the failure for the webhook
+// and delay the next attempt, or mark the webhook as failed when
+// it has run out of retries.
+func (b *Buffer) retry(ctx context.Context, w *api.WebhookIntake, failure error) {
+	msg := failure.Error()
+	if len(msg) > errorLimit {
+		msg = msg[:errorLimit]
+	}
+
+	w.SetError(msg)
+
+	// check if the webhook has run out of retries
+	if w.GetAttempts() > b.config.Retries {
+		logrus.Errorf("unable to process webhook %s after %d attempts: %v", w.GetSource(), w.GetAttempts(), failure)
+
+		w.SetStatus(api.WebhookIntakeFailed)
+	} else {
+		delay := b.backoff(w.GetAttempts())
+
+		logrus.Warnf("unable to process webhook %s, retrying in %s: %v", w.GetSource(), delay, failure)
+
+		w.SetAvailable(time.Now().UTC().Add(delay).Unix())
+	}
+
+	// send API call to update the webhook
+	err := b.database.UpdateWebhookIntake(ctx, w)
+	if err != nil {
+		logrus.Errorf("unable to update webhook %s in the intake: %v", w.GetSource(), err)
+	}
+}
+
+// backoff is a helper function to calculate the delay before the
+// next attempt for a webhook. The delay starts at the interval and
+// doubles for every attempt, up to the visibility duration.
+func (b *Buffer) backoff(attempts int64) time.Duration {
+	delay := b.config.Interval
+
+	for i := int64(1); i < attempts && delay < b.config.Visibility; i++ {
+		delay *= 2
+	}
+
+	if delay > b.config.Visibility {
+		return b.config.Visibility
+	}
+
+	return delay
+}
+
+// release is a helper function to remove the delivery
+// recorded for the webhook with the provided ID.
+func (b *Buffer) release(ctx context.Context, id string) {
+	if len(id) == 0 {
+		return
+	}
+
+	// send API call to capture the recorded delivery
+	d, err := b.database.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		return
+	}
+
+	// send API call to remove the recorded delivery
+	err = b.database.DeleteWebhookDelivery(ctx, d)
+	if err != nil {
+		logrus.Errorf("unable to release delivery for webhook %s: %v", id, err)
+	}
+}
+
+// replay is a helper function to send the webhook from the
+// intake through the handler and return the resulting status code.
+func replay(h http.Handler, w *api.WebhookIntake) (int, error) {
+	header := http.Header{}
+
+	err := json.Unmarshal([]byte(w.GetHeader()), &header)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse header: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBufferString(w.GetPayload()))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header = header
+
+	// check the staleness of the webhook against when it was
+	// received since it is processed later from the intake
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/verify?tab=doc#WithReceived
+	ctx := verify.WithReceived(req.Context(), time.Unix(w.GetCreated(), 0))
+
+	// mark the webhook as processed from the intake
+	// so it is not buffered in the intake again
+	req = req.WithContext(WithProcessing(ctx))
+
+	resp := &response{header: http.Header{}, status: http.StatusOK}
+
+	h.ServeHTTP(resp, req)
+
+	return resp.status, nil
+}
+
+// response represents the http.ResponseWriter used
+// to capture the status of a webhook from the intake.
+type response struct {
+	header http.Header
+	status int
+}
+
+// Header returns the header map for the response.
+func (r *response) Header() http.Header {
+	return r.header
+}
+
+// Write discards the body for the response.
+func (r *response) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// WriteHeader captures the status code for the response.
+func (r *response) WriteHeader(status int) {
+	r.status = status
+}
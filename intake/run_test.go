@@ -0,0 +1,259 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package intake
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/scm/verify"
+)
+
+func TestIntake_Start(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from webhook_intakes;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	buffer, err := New(
+		WithDatabase(db),
+		WithWorkers(2),
+		WithInterval(10*time.Millisecond),
+	)
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	for _, event := range []string{"push", "pull_request", "deployment"} {
+		req, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+		req.Header.Set("X-GitHub-Event", event)
+
+		err = buffer.Accept(context.TODO(), req, []byte(`{}`))
+		if err != nil {
+			t.Errorf("Accept returned err: %v", err)
+		}
+	}
+
+	var mu sync.Mutex
+
+	events := map[string]int{}
+	done := make(chan struct{})
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !Processing(r.Context()) {
+			t.Errorf("processed webhook is not marked as processing")
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		events[r.Header.Get("X-GitHub-Event")]++
+
+		if len(events) == 3 {
+			close(done)
+		}
+	})
+
+	dying := make(chan struct{})
+	stopped := make(chan error)
+
+	// run test
+	go func() {
+		stopped <- buffer.Start(handler, dying)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Errorf("Start did not process the webhooks")
+	}
+
+	close(dying)
+
+	err = <-stopped
+	if err != nil {
+		t.Errorf("Start returned err: %v", err)
+	}
+
+	for event, count := range events {
+		if count != 1 {
+			t.Errorf("Start processed %s webhook %d times, want 1", event, count)
+		}
+	}
+
+	count, _ := db.CountWebhookIntakes(context.TODO(), api.WebhookIntakePending)
+	if count != 0 {
+		t.Errorf("CountWebhookIntakes is %d, want 0", count)
+	}
+}
+
+func TestIntake_process(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from webhook_intakes;")
+		db.Sqlite.Exec("delete from webhook_deliveries;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	buffer, err := New(
+		WithDatabase(db),
+		WithWorkers(1),
+		WithRetries(1),
+		WithInterval(time.Minute),
+		WithVisibility(time.Hour),
+	)
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+	req.Header.Set("X-GitHub-Delivery", "c8da1302-07d6-11ea-882f-4893bca275b8")
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("Date", "Mon, 02 Jan 2006 15:04:05 GMT")
+
+	err = buffer.Accept(context.TODO(), req, []byte(`{"ref":"refs/heads/main"}`))
+	if err != nil {
+		t.Errorf("Accept returned err: %v", err)
+	}
+
+	// receive the webhook earlier than it is processed
+	db.Sqlite.Exec("update webhook_intakes set created = 1563474076;")
+
+	status := http.StatusInternalServerError
+	payloads := []string{}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Date") != "Mon, 02 Jan 2006 15:04:05 GMT" {
+			t.Errorf("processed webhook is missing Date header: %v", r.Header)
+		}
+
+		if verify.Received(r.Context()).Unix() != 1563474076 {
+			t.Errorf("processed webhook received is %v, want 1563474076", verify.Received(r.Context()).Unix())
+		}
+
+		if _, err := db.GetWebhookDelivery(context.TODO(), "c8da1302-07d6-11ea-882f-4893bca275b8"); err == nil {
+			t.Errorf("processed webhook delivery was not released")
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		payloads = append(payloads, string(body))
+
+		// record the delivery like the webhook handler
+		d := new(api.WebhookDelivery)
+		d.SetSource("github")
+		d.SetDeliveryID("c8da1302-07d6-11ea-882f-4893bca275b8")
+		d.SetCreated(time.Now().UTC().Unix())
+
+		_ = db.CreateWebhookDelivery(context.TODO(), d)
+
+		w.WriteHeader(status)
+	})
+
+	// capture the webhook available in the intake
+	capture := func(now time.Time) *api.WebhookIntake {
+		intakes, _ := db.ListAvailableWebhookIntakes(context.TODO(), now.Unix(), 10)
+		if len(intakes) != 1 {
+			t.Errorf("ListAvailableWebhookIntakes is %d, want 1", len(intakes))
+
+			return nil
+		}
+
+		return intakes[0]
+	}
+
+	// run test
+	w := capture(time.Now().UTC())
+
+	// processing with a server error delays a retry
+	buffer.process(handler, w)
+
+	later := time.Now().UTC().Add(buffer.config.Interval)
+
+	retry := capture(later)
+	if retry.GetAttempts() != 1 || retry.GetError() != "received status 500" {
+		t.Errorf("process retry is %v", retry)
+	}
+
+	if retry.GetAvailable() > later.Unix() {
+		t.Errorf("process available is %d, want before %d", retry.GetAvailable(), later.Unix())
+	}
+
+	// processing a claimed webhook is skipped
+	stale := *retry
+	stale.SetAvailable(0)
+
+	buffer.process(handler, &stale)
+
+	if len(payloads) != 1 {
+		t.Errorf("process payloads are %v, want 1", payloads)
+	}
+
+	// processing with a server error without any retries fails the webhook
+	buffer.process(handler, retry)
+
+	count, _ := db.CountWebhookIntakes(context.TODO(), api.WebhookIntakeFailed)
+	if count != 1 {
+		t.Errorf("CountWebhookIntakes for failed is %d, want 1", count)
+	}
+
+	// processing successfully removes the webhook
+	db.Sqlite.Exec("update webhook_intakes set status = 'pending', attempts = 1;")
+
+	status = http.StatusOK
+
+	buffer.process(handler, capture(later.Add(time.Hour)))
+
+	count, _ = db.CountWebhookIntakes(context.TODO(), api.WebhookIntakePending)
+	if count != 0 {
+		t.Errorf("CountWebhookIntakes for pending is %d, want 0", count)
+	}
+
+	if len(payloads) != 3 {
+		t.Errorf("process payloads are %v, want 3", payloads)
+	}
+}
+
+func TestIntake_backoff(t *testing.T) {
+	// setup types
+	buffer, _ := New(
+		WithInterval(time.Second),
+		WithVisibility(10*time.Second),
+	)
+
+	// setup tests
+	tests := []struct {
+		attempts int64
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 50, want: 10 * time.Second},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := buffer.backoff(test.attempts)
+
+		if got != test.want {
+			t.Errorf("backoff for %d attempts is %s, want %s", test.attempts, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ansi
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// escape represents the ASCII escape character
+// that begins an ANSI control sequence.
+const escape = '\x1b'
+
+// colors represents the names of the standard
+// eight ANSI colors in the order of their codes.
+var colors = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+type (
+	// Style represents the display attributes
+	// applied to a segment of log output.
+	Style struct {
+		Foreground string `json:"fg,omitempty"`
+		Background string `json:"bg,omitempty"`
+		Bold       bool   `json:"bold,omitempty"`
+		Italic     bool   `json:"italic,omitempty"`
+		Underline  bool   `json:"underline,omitempty"`
+	}
+
+	// Segment represents a run of text within
+	// a line of log output sharing one style.
+	Segment struct {
+		Style
+		Text string `json:"text"`
+	}
+
+	// Line represents a single line of log
+	// output split into styled segments.
+	Line struct {
+		Number   int        `json:"number"`
+		Segments []*Segment `json:"segments"`
+	}
+)
+
+// Parse splits the provided log data into lines and
+// converts the ANSI SGR escape sequences within them
+// into styled segments. Any other control sequences
+// are stripped from the output.
+func Parse(data []byte) []*Line {
+	lines := []*Line{}
+
+	// the style is carried across lines to
+	// match the behavior of a terminal
+	style := Style{}
+
+	// trim the trailing newline to avoid an empty final line
+	data = bytes.TrimSuffix(data, []byte("\n"))
+
+	if len(data) == 0 {
+		return lines
+	}
+
+	for i, raw := range bytes.Split(data, []byte("\n")) {
+		line := &Line{Number: i + 1, Segments: []*Segment{}}
+
+		// a carriage return resets the cursor to the start of the line
+		// so only the output after the last one is visible
+		if idx := bytes.LastIndexByte(bytes.TrimSuffix(raw, []byte("\r")), '\r'); idx >= 0 {
+			raw = raw[idx+1:]
+		}
+
+		raw = bytes.TrimSuffix(raw, []byte("\r"))
+
+		text := new(strings.Builder)
+
+		// flush appends the buffered text as a segment
+		flush := func() {
+			if text.Len() == 0 {
+				return
+			}
+
+			line.Segments = append(line.Segments, &Segment{Style: style, Text: text.String()})
+
+			text.Reset()
+		}
+
+		for j := 0; j < len(raw); j++ {
+			if raw[j] != escape {
+				text.WriteByte(raw[j])
+
+				continue
+			}
+
+			// only CSI sequences (ESC [) are interpreted
+			if j+1 >= len(raw) || raw[j+1] != '[' {
+				continue
+			}
+
+			// find the final byte of the control sequence
+			end := j + 2
+			for end < len(raw) && (raw[end] < 0x40 || raw[end] > 0x7e) {
+				end++
+			}
+
+			// drop an unterminated sequence at the end of the line
+			if end >= len(raw) {
+				break
+			}
+
+			// only SGR sequences (ending in m) affect the style
+			if raw[end] == 'm' {
+				flush()
+
+				style = style.apply(string(raw[j+2 : end]))
+			}
+
+			j = end
+		}
+
+		flush()
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// apply returns the style produced by applying the
+// provided SGR parameters to the current style.
+func (s Style) apply(params string) Style {
+	// an empty parameter list is equivalent to a reset
+	if len(params) == 0 {
+		return Style{}
+	}
+
+	codes := strings.Split(params, ";")
+
+	for i := 0; i < len(codes); i++ {
+		code, err := strconv.Atoi(codes[i])
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case code == 0:
+			s = Style{}
+		case code == 1:
+			s.Bold = true
+		case code == 3:
+			s.Italic = true
+		case code == 4:
+			s.Underline = true
+		case code == 22:
+			s.Bold = false
+		case code == 23:
+			s.Italic = false
+		case code == 24:
+			s.Underline = false
+		case code >= 30 && code <= 37:
+			s.Foreground = colors[code-30]
+		case code == 39:
+			s.Foreground = ""
+		case code >= 40 && code <= 47:
+			s.Background = colors[code-40]
+		case code == 49:
+			s.Background = ""
+		case code >= 90 && code <= 97:
+			s.Foreground = "bright-" + colors[code-90]
+		case code >= 100 && code <= 107:
+			s.Background = "bright-" + colors[code-100]
+		case code == 38 || code == 48:
+			color, n := extended(codes[i+1:])
+
+			if code == 38 {
+				s.Foreground = color
+			} else {
+				s.Background = color
+			}
+
+			i += n
+		}
+	}
+
+	return s
+}
+
+// extended parses an 8-bit (5;n) or 24-bit (2;r;g;b) color
+// and returns the color with the number of codes consumed.
+func extended(codes []string) (string, int) {
+	if len(codes) == 0 {
+		return "", 0
+	}
+
+	switch codes[0] {
+	case "5":
+		if len(codes) < 2 {
+			return "", len(codes)
+		}
+
+		n, err := strconv.Atoi(codes[1])
+		if err != nil || n < 0 || n > 255 {
+			return "", 2
+		}
+
+		// map the first sixteen colors to their names
+		switch {
+		case n < 8:
+			return colors[n], 2
+		case n < 16:
+			return "bright-" + colors[n-8], 2
+		}
+
+		return strconv.Itoa(n), 2
+	case "2":
+		if len(codes) < 4 {
+			return "", len(codes)
+		}
+
+		rgb := make([]int, 3)
+
+		for i := range rgb {
+			v, err := strconv.Atoi(codes[i+1])
+			if err != nil || v < 0 || v > 255 {
+				return "", 4
+			}
+
+			rgb[i] = v
+		}
+
+		return fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2]), 4
+	}
+
+	return "", 1
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ansi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnsi_Parse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		data []byte
+		want []*Line
+	}{
+		{
+			name: "empty",
+			data: []byte{},
+			want: []*Line{},
+		},
+		{
+			name: "plain text",
+			data: []byte("hello\nworld\n"),
+			want: []*Line{
+				{Number: 1, Segments: []*Segment{{Text: "hello"}}},
+				{Number: 2, Segments: []*Segment{{Text: "world"}}},
+			},
+		},
+		{
+			name: "standard colors",
+			data: []byte("\x1b[31mred\x1b[0m plain \x1b[1;44mbold\x1b[m"),
+			want: []*Line{
+				{Number: 1, Segments: []*Segment{
+					{Style: Style{Foreground: "red"}, Text: "red"},
+					{Text: " plain "},
+					{Style: Style{Background: "blue", Bold: true}, Text: "bold"},
+				}},
+			},
+		},
+		{
+			name: "bright and extended colors",
+			data: []byte("\x1b[92mgreen\x1b[38;5;9mred\x1b[48;2;255;0;128mpink\x1b[38;5;200mindexed"),
+			want: []*Line{
+				{Number: 1, Segments: []*Segment{
+					{Style: Style{Foreground: "bright-green"}, Text: "green"},
+					{Style: Style{Foreground: "bright-red"}, Text: "red"},
+					{Style: Style{Foreground: "bright-red", Background: "#ff0080"}, Text: "pink"},
+					{Style: Style{Foreground: "200", Background: "#ff0080"}, Text: "indexed"},
+				}},
+			},
+		},
+		{
+			name: "style carried across lines",
+			data: []byte("\x1b[4mone\ntwo\x1b[24m three"),
+			want: []*Line{
+				{Number: 1, Segments: []*Segment{{Style: Style{Underline: true}, Text: "one"}}},
+				{Number: 2, Segments: []*Segment{
+					{Style: Style{Underline: true}, Text: "two"},
+					{Text: " three"},
+				}},
+			},
+		},
+		{
+			name: "non-SGR sequences and carriage returns",
+			data: []byte("10%\r50%\r100%\x1b[K done\r\n"),
+			want: []*Line{
+				{Number: 1, Segments: []*Segment{{Text: "100% done"}}},
+			},
+		},
+		{
+			name: "unterminated sequence",
+			data: []byte("text\x1b[31"),
+			want: []*Line{
+				{Number: 1, Segments: []*Segment{{Text: "text"}}},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Parse(test.data)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Parse is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
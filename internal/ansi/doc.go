@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package ansi provides the ability for Vela to parse
+// ANSI escape sequences within log output and render
+// the result as styled segments or HTML.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/internal/ansi"
+package ansi
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ansi
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// HTML renders the line as an HTML fragment where each styled
+// segment is wrapped in a span with "ansi-" prefixed classes.
+// Colors from the 24-bit palette are rendered as inline styles.
+func (l *Line) HTML() string {
+	b := new(strings.Builder)
+
+	fmt.Fprintf(b, `<div class="ansi-line" data-line="%d">`, l.Number)
+
+	for _, s := range l.Segments {
+		classes, styles := s.Style.attributes()
+
+		// render plain text without a wrapping span
+		if len(classes) == 0 && len(styles) == 0 {
+			b.WriteString(html.EscapeString(s.Text))
+
+			continue
+		}
+
+		b.WriteString("<span")
+
+		if len(classes) > 0 {
+			fmt.Fprintf(b, ` class="%s"`, strings.Join(classes, " "))
+		}
+
+		if len(styles) > 0 {
+			fmt.Fprintf(b, ` style="%s"`, strings.Join(styles, ";"))
+		}
+
+		fmt.Fprintf(b, ">%s</span>", html.EscapeString(s.Text))
+	}
+
+	b.WriteString("</div>")
+
+	return b.String()
+}
+
+// HTML renders the provided lines as a single HTML fragment.
+func HTML(lines []*Line) string {
+	b := new(strings.Builder)
+
+	for _, l := range lines {
+		b.WriteString(l.HTML())
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// attributes returns the HTML classes and inline
+// styles necessary to display the style.
+func (s Style) attributes() ([]string, []string) {
+	classes := []string{}
+	styles := []string{}
+
+	if len(s.Foreground) > 0 {
+		if strings.HasPrefix(s.Foreground, "#") {
+			styles = append(styles, "color:"+s.Foreground)
+		} else {
+			classes = append(classes, "ansi-fg-"+s.Foreground)
+		}
+	}
+
+	if len(s.Background) > 0 {
+		if strings.HasPrefix(s.Background, "#") {
+			styles = append(styles, "background-color:"+s.Background)
+		} else {
+			classes = append(classes, "ansi-bg-"+s.Background)
+		}
+	}
+
+	if s.Bold {
+		classes = append(classes, "ansi-bold")
+	}
+
+	if s.Italic {
+		classes = append(classes, "ansi-italic")
+	}
+
+	if s.Underline {
+		classes = append(classes, "ansi-underline")
+	}
+
+	return classes, styles
+}
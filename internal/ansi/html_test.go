@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package ansi
+
+import (
+	"testing"
+)
+
+func TestAnsi_Line_HTML(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		line *Line
+		want string
+	}{
+		{
+			name: "plain text",
+			line: &Line{Number: 1, Segments: []*Segment{{Text: "<b>hello</b>"}}},
+			want: `<div class="ansi-line" data-line="1">&lt;b&gt;hello&lt;/b&gt;</div>`,
+		},
+		{
+			name: "styled text",
+			line: &Line{Number: 2, Segments: []*Segment{
+				{Style: Style{Foreground: "red", Bold: true}, Text: "error"},
+				{Text: ": "},
+				{Style: Style{Foreground: "#ff0080", Background: "bright-black"}, Text: "details"},
+			}},
+			want: `<div class="ansi-line" data-line="2"><span class="ansi-fg-red ansi-bold">error</span>: ` +
+				`<span class="ansi-bg-bright-black" style="color:#ff0080">details</span></div>`,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.line.HTML()
+
+			if got != test.want {
+				t.Errorf("HTML is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAnsi_HTML(t *testing.T) {
+	// setup types
+	want := `<div class="ansi-line" data-line="1"><span class="ansi-fg-green">ok</span></div>` + "\n" +
+		`<div class="ansi-line" data-line="2">done</div>` + "\n"
+
+	// run test
+	got := HTML(Parse([]byte("\x1b[32mok\x1b[0m\ndone")))
+
+	if got != want {
+		t.Errorf("HTML is %s, want %s", got, want)
+	}
+}
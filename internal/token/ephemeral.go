@@ -0,0 +1,16 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package token
+
+import "strings"
+
+// EphemeralRegisterTokenType represents a token that can only
+// be used once to register an ephemeral worker for a build.
+const EphemeralRegisterTokenType = "EphemeralRegister"
+
+// IsEphemeralRegister checks if the claims are for an ephemeral registration token.
+func (c *Claims) IsEphemeralRegister() bool {
+	return strings.EqualFold(c.TokenType, EphemeralRegisterTokenType)
+}
@@ -26,6 +26,9 @@ type Manager struct {
 	// BuildTokenBufferDuration specifies the additional token duration of build tokens beyond repo timeout
 	BuildTokenBufferDuration time.Duration
 
+	// RepoTriggerTokenDuration specifies the token duration for repo trigger tokens
+	RepoTriggerTokenDuration time.Duration
+
 	// WorkerAuthTokenDuration specifies the token duration for worker auth (check in)
 	WorkerAuthTokenDuration time.Duration
 
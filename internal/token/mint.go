@@ -32,6 +32,7 @@ type MintTokenOpts struct {
 	Hostname      string
 	Repo          string
 	TokenDuration time.Duration
+	TokenID       string
 	TokenType     string
 	User          *library.User
 }
@@ -69,11 +70,42 @@ func (tm *Manager) MintToken(mto *MintTokenOpts) (string, error) {
 		claims.Repo = mto.Repo
 		claims.Subject = mto.Hostname
 
+	case RepoTriggerTokenType:
+		if mto.User == nil {
+			return "", errors.New("no user provided for repo trigger token")
+		}
+
+		if len(mto.Repo) == 0 {
+			return "", errors.New("missing repo for repo trigger token")
+		}
+
+		if len(mto.TokenID) == 0 {
+			return "", errors.New("missing token id for repo trigger token")
+		}
+
+		claims.ID = mto.TokenID
+		claims.Repo = mto.Repo
+		claims.Subject = mto.User.GetName()
+
+	case EphemeralRegisterTokenType:
+		if mto.BuildID == 0 {
+			return "", errors.New("missing build id for ephemeral registration token")
+		}
+
+		if len(mto.Hostname) == 0 {
+			return "", errors.New("missing host name for ephemeral registration token")
+		}
+
+		claims.BuildID = mto.BuildID
+		claims.Subject = mto.Hostname
+
 	case constants.WorkerAuthTokenType, constants.WorkerRegisterTokenType:
 		if len(mto.Hostname) == 0 {
 			return "", fmt.Errorf("missing host name for %s token", mto.TokenType)
 		}
 
+		// the build id is only set for ephemeral workers bound to a build
+		claims.BuildID = mto.BuildID
 		claims.Subject = mto.Hostname
 
 	default:
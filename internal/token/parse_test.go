@@ -94,6 +94,44 @@ func TestTokenManager_ParseToken(t *testing.T) {
 				},
 			},
 		},
+		{
+			TokenType: EphemeralRegisterTokenType,
+			Mto: &MintTokenOpts{
+				BuildID:       1,
+				Hostname:      "vela-ephemeral-1",
+				TokenType:     EphemeralRegisterTokenType,
+				TokenDuration: time.Minute * 5,
+			},
+			Want: &Claims{
+				BuildID:   1,
+				TokenType: EphemeralRegisterTokenType,
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject:   "vela-ephemeral-1",
+					IssuedAt:  jwt.NewNumericDate(now),
+					ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute * 5)),
+				},
+			},
+		},
+		{
+			TokenType: RepoTriggerTokenType,
+			Mto: &MintTokenOpts{
+				User:          u,
+				Repo:          "foo/bar",
+				TokenType:     RepoTriggerTokenType,
+				TokenDuration: time.Hour * 24,
+				TokenID:       "4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29",
+			},
+			Want: &Claims{
+				Repo:      "foo/bar",
+				TokenType: RepoTriggerTokenType,
+				RegisteredClaims: jwt.RegisteredClaims{
+					ID:        "4f5a0a5e-1d56-4c5b-9ab5-6d2c0b8f3b29",
+					Subject:   u.GetName(),
+					IssuedAt:  jwt.NewNumericDate(now),
+					ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour * 24)),
+				},
+			},
+		},
 	}
 
 	gin.SetMode(gin.TestMode)
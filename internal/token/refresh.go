@@ -21,7 +21,7 @@ func (tm *Manager) Refresh(c *gin.Context, refreshToken string) (string, error)
 	}
 
 	// look up user in database given claims subject
-	u, err := database.FromContext(c).GetUserForName(claims.Subject)
+	u, err := database.FromContext(c).GetUserForName(c, claims.Subject)
 	if err != nil {
 		return "", fmt.Errorf("unable to retrieve user %s from database from claims subject: %w", claims.Subject, err)
 	}
@@ -5,6 +5,7 @@
 package token
 
 import (
+	"context"
 	"net/http/httptest"
 	"testing"
 	"time"
@@ -53,7 +54,7 @@ func TestTokenManager_Refresh(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateUser(u)
+	_ = db.CreateUser(context.TODO(), u)
 
 	// set up context
 	gin.SetMode(gin.TestMode)
@@ -110,7 +111,7 @@ func TestTokenManager_Refresh_Expired(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateUser(u)
+	_ = db.CreateUser(context.TODO(), u)
 
 	// set up context
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package token
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/types/library"
+)
+
+// RepoTriggerTokenType represents a token that can only be
+// used to create or restart builds for a repo or an org.
+const RepoTriggerTokenType = "RepoTrigger"
+
+// OrgTrigger returns the repo claim for a trigger
+// token covering every repo in the provided org.
+func OrgTrigger(org string) string {
+	return fmt.Sprintf("%s/*", org)
+}
+
+// IsRepoTrigger checks if the claims are for a repo trigger token.
+func (c *Claims) IsRepoTrigger() bool {
+	return strings.EqualFold(c.TokenType, RepoTriggerTokenType)
+}
+
+// Triggers checks if the claims are for a repo trigger
+// token that is allowed to trigger builds for the repo.
+func (c *Claims) Triggers(r *library.Repo) bool {
+	if !c.IsRepoTrigger() {
+		return false
+	}
+
+	return strings.EqualFold(c.Repo, r.GetFullName()) ||
+		strings.EqualFold(c.Repo, OrgTrigger(r.GetOrg()))
+}
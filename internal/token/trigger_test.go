@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package token
+
+import (
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestToken_Claims_Triggers(t *testing.T) {
+	// setup types
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	// setup tests
+	tests := []struct {
+		name   string
+		claims *Claims
+		want   bool
+	}{
+		{
+			name:   "repo",
+			claims: &Claims{TokenType: RepoTriggerTokenType, Repo: "foo/bar"},
+			want:   true,
+		},
+		{
+			name:   "org",
+			claims: &Claims{TokenType: RepoTriggerTokenType, Repo: OrgTrigger("foo")},
+			want:   true,
+		},
+		{
+			name:   "other repo",
+			claims: &Claims{TokenType: RepoTriggerTokenType, Repo: "foo/baz"},
+			want:   false,
+		},
+		{
+			name:   "other org",
+			claims: &Claims{TokenType: RepoTriggerTokenType, Repo: OrgTrigger("baz")},
+			want:   false,
+		},
+		{
+			name:   "build token",
+			claims: &Claims{TokenType: constants.WorkerBuildTokenType, Repo: "foo/bar"},
+			want:   false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.claims.Triggers(r)
+
+			if got != test.want {
+				t.Errorf("Triggers is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package janitor provides the ability for Vela to periodically
+// find builds left dangling in the pending status, requeue or
+// resolve them and fix the commit status reported to the scm.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/janitor"
+package janitor
@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package janitor
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the janitor.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Janitor Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_JANITOR_INTERVAL", "JANITOR_INTERVAL"},
+		FilePath: "/vela/janitor/interval",
+		Name:     "janitor.interval",
+		Usage:    "interval at which to clean up builds left dangling in the pending status (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_JANITOR_THRESHOLD", "JANITOR_THRESHOLD"},
+		FilePath: "/vela/janitor/threshold",
+		Name:     "janitor.threshold",
+		Usage:    "duration a build can be pending before it is considered dangling",
+		Value:    30 * time.Minute,
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_JANITOR_REQUEUE", "JANITOR_REQUEUE"},
+		FilePath: "/vela/janitor/requeue",
+		Name:     "janitor.requeue",
+		Usage:    "requeue dangling builds instead of resolving them (builds published to the queue are never resolved)",
+		Value:    true,
+	},
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package janitor
+
+import (
+	"time"
+
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/placement"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types"
+)
+
+type (
+	// config represents the settings required to create the janitor.
+	config struct {
+		// specifies the interval at which to clean up dangling builds
+		Interval time.Duration
+		// specifies the duration a build can be pending before it is considered dangling
+		Threshold time.Duration
+		// specifies to requeue dangling builds instead of resolving them
+		Requeue bool
+	}
+
+	// Janitor represents the functionality for cleaning
+	// up builds left dangling in the pending status.
+	Janitor struct {
+		// janitor configuration settings
+		config *config
+
+		// compiler service used to compile the pipeline for requeued builds
+		compiler compiler.Engine
+
+		// database service used to capture and resolve dangling builds
+		database database.Service
+
+		// metadata used to compile the pipeline for requeued builds
+		metadata *types.Metadata
+
+		// placer used to route requeued builds to a preferred worker
+		placer *placement.Placer
+		// queue service used to publish requeued builds
+		queue queue.Service
+
+		// scm service used to fix the commit status for dangling builds
+		scm scm.Service
+	}
+)
+
+// New creates and returns a janitor for dangling builds.
+func New(opts ...Opt) (*Janitor, error) {
+	// create new janitor
+	j := new(Janitor)
+
+	// create new fields
+	j.config = &config{
+		Threshold: 30 * time.Minute,
+		Requeue:   true,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(j)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return j, nil
+}
+
+// Enabled returns whether the janitor is
+// configured to periodically clean up dangling builds.
+func (j *Janitor) Enabled() bool {
+	return j.config.Interval > 0
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package janitor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/compiler"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/placement"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types"
+)
+
+// Opt represents a configuration option to initialize the janitor.
+type Opt func(*Janitor) error
+
+// WithCompiler sets the compiler service in the janitor.
+func WithCompiler(c compiler.Engine) Opt {
+	return func(j *Janitor) error {
+		// set the compiler service in the janitor
+		j.compiler = c
+
+		return nil
+	}
+}
+
+// WithDatabase sets the database service in the janitor.
+func WithDatabase(db database.Service) Opt {
+	return func(j *Janitor) error {
+		// set the database service in the janitor
+		j.database = db
+
+		return nil
+	}
+}
+
+// WithMetadata sets the metadata in the janitor.
+func WithMetadata(m *types.Metadata) Opt {
+	return func(j *Janitor) error {
+		// set the metadata in the janitor
+		j.metadata = m
+
+		return nil
+	}
+}
+
+// WithPlacer sets the placer in the janitor.
+func WithPlacer(p *placement.Placer) Opt {
+	return func(j *Janitor) error {
+		// set the placer in the janitor
+		j.placer = p
+
+		return nil
+	}
+}
+
+// WithQueue sets the queue service in the janitor.
+func WithQueue(q queue.Service) Opt {
+	return func(j *Janitor) error {
+		// set the queue service in the janitor
+		j.queue = q
+
+		return nil
+	}
+}
+
+// WithSCM sets the scm service in the janitor.
+func WithSCM(s scm.Service) Opt {
+	return func(j *Janitor) error {
+		// set the scm service in the janitor
+		j.scm = s
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to clean up dangling builds in the janitor.
+func WithInterval(interval time.Duration) Opt {
+	return func(j *Janitor) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid janitor interval provided: %s", interval)
+		}
+
+		// set the interval in the janitor
+		j.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithThreshold sets the duration a build can be pending
+// before it is considered dangling in the janitor.
+func WithThreshold(threshold time.Duration) Opt {
+	return func(j *Janitor) error {
+		// check if the threshold provided is positive
+		if threshold <= 0 {
+			return fmt.Errorf("invalid janitor threshold provided: %s", threshold)
+		}
+
+		// set the threshold in the janitor
+		j.config.Threshold = threshold
+
+		return nil
+	}
+}
+
+// WithRequeue sets whether to requeue dangling
+// builds instead of resolving them in the janitor.
+func WithRequeue(requeue bool) Opt {
+	return func(j *Janitor) error {
+		// set the requeue behavior in the janitor
+		j.config.Requeue = requeue
+
+		return nil
+	}
+}
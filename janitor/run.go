@@ -0,0 +1,210 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package janitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/api"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// errNotPublished defines the error set on dangling
+// builds that were never published to the queue.
+const errNotPublished = "unable to publish build to queue"
+
+// Start cleans up dangling builds at the configured
+// interval until the provided channel is closed.
+func (j *Janitor) Start(dying <-chan struct{}) error {
+	logrus.Infof("cleaning up dangling builds every %s", j.config.Interval)
+
+	ticker := time.NewTicker(j.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, err := j.Run(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to clean up dangling builds: %v", err)
+			}
+		}
+	}
+}
+
+// Run captures the builds that have been pending for longer
+// than the configured threshold and either requeues or resolves
+// them. It returns the list of builds that were cleaned up.
+func (j *Janitor) Run(now time.Time) ([]*library.Build, error) {
+	logrus.Trace("cleaning up dangling builds")
+
+	// send API call to capture the builds pending before the threshold
+	builds, err := j.database.GetPendingBuildListBefore(context.Background(), now.Add(-j.config.Threshold).Unix())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pending builds: %w", err)
+	}
+
+	for _, b := range builds {
+		// send API call to capture the repo for the build
+		r, err := j.database.GetRepo(context.Background(), b.GetRepoID())
+		if err != nil {
+			logrus.Errorf("unable to get repo for dangling build %d: %v", b.GetID(), err)
+
+			continue
+		}
+
+		// send API call to capture the owner of the repo
+		u, err := j.database.GetUser(context.Background(), r.GetUserID())
+		if err != nil {
+			logrus.Errorf("unable to get owner for repo %s: %v", r.GetFullName(), err)
+
+			continue
+		}
+
+		if j.config.Requeue {
+			logrus.Infof("requeueing dangling build %s/%d", r.GetFullName(), b.GetNumber())
+
+			err = j.requeue(b, r, u)
+			if err == nil {
+				j.status(b, r, u)
+
+				continue
+			}
+
+			logrus.Errorf("unable to requeue dangling build %s/%d: %v", r.GetFullName(), b.GetNumber(), err)
+		}
+
+		// skip resolving builds that were published to the queue
+		// since a worker can still claim the item from the queue
+		if !shouldResolve(b) {
+			logrus.Warnf("leaving dangling build %s/%d pending on the queue", r.GetFullName(), b.GetNumber())
+
+			continue
+		}
+
+		logrus.Infof("resolving dangling build %s/%d", r.GetFullName(), b.GetNumber())
+
+		j.resolve(b, now)
+		j.status(b, r, u)
+	}
+
+	return builds, nil
+}
+
+// shouldResolve is a helper function to determine if the dangling
+// build can be resolved. Only builds that were never published to
+// the queue are resolved, since the item for a published build can
+// still be claimed by a worker after the build is resolved.
+func shouldResolve(b *library.Build) bool {
+	return b.GetEnqueued() == 0
+}
+
+// requeue compiles the stored pipeline configuration
+// for the dangling build and publishes it to the queue.
+// A build that was already published is published again,
+// so it is routed to the workers currently serving the repo.
+func (j *Janitor) requeue(b *library.Build, r *library.Repo, u *library.User) error {
+	// send API call to capture the pipeline for the build
+	pipeline, err := j.database.GetPipeline(context.Background(), b.GetPipelineID())
+	if err != nil {
+		return fmt.Errorf("unable to get pipeline: %w", err)
+	}
+
+	// compile the stored pipeline configuration
+	p, _, err := j.compiler.
+		Duplicate().
+		WithBuild(b).
+		WithMetadata(j.metadata).
+		WithRepo(r).
+		WithUser(u).
+		Compile(pipeline.GetData())
+	if err != nil {
+		return fmt.Errorf("unable to compile pipeline: %w", err)
+	}
+
+	// publish the build to the queue the same way the api does
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/api?tab=doc#PublishToQueue
+	return api.PublishToQueue(context.Background(), j.queue, j.database, j.placer, p, b, r, u)
+}
+
+// resolve errors out the dangling build and kills
+// the pending steps and services for the build.
+func (j *Janitor) resolve(b *library.Build, now time.Time) {
+	// update fields in build object
+	b.SetError(errNotPublished)
+	b.SetStatus(constants.StatusError)
+	b.SetFinished(now.Unix())
+
+	// send API call to update the build
+	err := j.database.UpdateBuild(context.Background(), b)
+	if err != nil {
+		logrus.Errorf("unable to resolve dangling build %d: %v", b.GetID(), err)
+	}
+
+	// send API call to capture the steps for the build
+	steps, err := j.database.GetBuildStepList(context.Background(), b, 1, 100)
+	if err != nil {
+		logrus.Errorf("unable to get steps for dangling build %d: %v", b.GetID(), err)
+	}
+
+	for _, s := range steps {
+		if s.GetStatus() != constants.StatusPending {
+			continue
+		}
+
+		// update fields in step object
+		s.SetStatus(constants.StatusKilled)
+		s.SetFinished(now.Unix())
+
+		// send API call to update the step
+		err := j.database.UpdateStep(context.Background(), s)
+		if err != nil {
+			logrus.Errorf("unable to kill step %s for dangling build %d: %v", s.GetName(), b.GetID(), err)
+		}
+	}
+
+	// send API call to capture the services for the build
+	services, err := j.database.GetBuildServiceList(context.Background(), b, 1, 100)
+	if err != nil {
+		logrus.Errorf("unable to get services for dangling build %d: %v", b.GetID(), err)
+	}
+
+	for _, s := range services {
+		if s.GetStatus() != constants.StatusPending {
+			continue
+		}
+
+		// update fields in service object
+		s.SetStatus(constants.StatusKilled)
+		s.SetFinished(now.Unix())
+
+		// send API call to update the service
+		err := j.database.UpdateService(context.Background(), s)
+		if err != nil {
+			logrus.Errorf("unable to kill service %s for dangling build %d: %v", s.GetName(), b.GetID(), err)
+		}
+	}
+}
+
+// status sends the commit status for the build to the scm.
+func (j *Janitor) status(b *library.Build, r *library.Repo, u *library.User) {
+	// check if the scm service is configured
+	if j.scm == nil {
+		return
+	}
+
+	// send API call to set the status on the commit
+	err := j.scm.Status(u, b, r.GetOrg(), r.GetName())
+	if err != nil {
+		logrus.Errorf("unable to set commit status for build %s/%d: %v", r.GetFullName(), b.GetNumber(), err)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package janitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestJanitor_shouldResolve(t *testing.T) {
+	// setup types
+	published := new(library.Build)
+	published.SetEnqueued(1)
+
+	// setup tests
+	tests := []struct {
+		name  string
+		build *library.Build
+		want  bool
+	}{
+		{
+			name:  "never published",
+			build: new(library.Build),
+			want:  true,
+		},
+		{
+			name:  "published",
+			build: published,
+			want:  false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := shouldResolve(test.build)
+
+			if got != test.want {
+				t.Errorf("shouldResolve is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestJanitor_Run(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from users;")
+		db.Sqlite.Exec("delete from repos;")
+		db.Sqlite.Exec("delete from builds;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	now := time.Now().UTC()
+
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+	_user.SetToken("superSecretToken")
+	_user.SetHash("baz")
+	_user.SetActive(true)
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("github")
+	_repo.SetName("octocat")
+	_repo.SetFullName("github/octocat")
+	_repo.SetVisibility("public")
+
+	_unpublished := new(library.Build)
+	_unpublished.SetID(1)
+	_unpublished.SetRepoID(1)
+	_unpublished.SetNumber(1)
+	_unpublished.SetStatus(constants.StatusPending)
+	_unpublished.SetCreated(now.Add(-time.Hour).Unix())
+
+	_published := new(library.Build)
+	_published.SetID(2)
+	_published.SetRepoID(1)
+	_published.SetNumber(2)
+	_published.SetStatus(constants.StatusPending)
+	_published.SetCreated(now.Add(-time.Hour).Unix())
+	_published.SetEnqueued(now.Add(-time.Hour).Unix())
+
+	err := db.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create user: %v", err)
+	}
+
+	err = db.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create repo: %v", err)
+	}
+
+	for _, b := range []*library.Build{_unpublished, _published} {
+		err = db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create build %d: %v", b.GetNumber(), err)
+		}
+	}
+
+	j, err := New(
+		WithDatabase(db),
+		WithThreshold(30*time.Minute),
+		WithRequeue(false),
+	)
+	if err != nil {
+		t.Errorf("unable to create janitor: %v", err)
+	}
+
+	// run test
+	_, err = j.Run(now)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name  string
+		build *library.Build
+		want  string
+	}{
+		{
+			name:  "never published",
+			build: _unpublished,
+			want:  constants.StatusError,
+		},
+		{
+			name:  "published",
+			build: _published,
+			want:  constants.StatusPending,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := db.GetBuild(context.TODO(), test.build.GetNumber(), _repo)
+			if err != nil {
+				t.Errorf("unable to get build: %v", err)
+			}
+
+			if got.GetStatus() != test.want {
+				t.Errorf("Run status for %s build is %s, want %s", test.name, got.GetStatus(), test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package awskms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/sirupsen/logrus"
+)
+
+// encryptionContext is the additional authenticated data
+// bound to every data key wrapped by the AWS KMS client.
+var encryptionContext = map[string]*string{
+	"service": aws.String("vela"),
+}
+
+type (
+	config struct {
+		// specifies the ID, ARN or alias of the key to use for the AWS KMS client
+		KeyID string
+		// specifies the region to use for the AWS KMS client
+		Region string
+	}
+
+	client struct {
+		config *config
+		KMS    kmsiface.KMSAPI
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		Logger *logrus.Entry
+	}
+)
+
+// New returns a KMS implementation that integrates with AWS KMS.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new AWS KMS client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("kms", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if an AWS KMS API client was provided
+	if c.KMS != nil {
+		return c, nil
+	}
+
+	// create new AWS session from the default credential chain
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/aws/session#NewSession
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(c.config.Region)})
+	if err != nil {
+		return nil, err
+	}
+
+	// create new AWS KMS API client
+	//
+	// https://pkg.go.dev/github.com/aws/aws-sdk-go/service/kms#New
+	c.KMS = kms.New(sess)
+
+	return c, nil
+}
+
+// KeyID outputs the identifier of the AWS KMS key.
+//
+// Automatic rotation of the AWS KMS key does not change
+// the identifier since AWS KMS tracks the key material
+// used for each wrapped data key.
+func (c *client) KeyID() string {
+	return fmt.Sprintf("%s:%s", DriverAWSKMS, c.config.KeyID)
+}
+
+// Wrap encrypts the data key with the AWS KMS key.
+//
+// https://docs.aws.amazon.com/kms/latest/APIReference/API_Encrypt.html
+func (c *client) Wrap(ctx context.Context, dek []byte) ([]byte, error) {
+	c.Logger.Tracef("wrapping data key with key %s", c.KeyID())
+
+	// send API call to encrypt the data key
+	out, err := c.KMS.EncryptWithContext(ctx, &kms.EncryptInput{
+		EncryptionContext: encryptionContext,
+		KeyId:             aws.String(c.config.KeyID),
+		Plaintext:         dek,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap data key with %s: %w", c.KeyID(), err)
+	}
+
+	return out.CiphertextBlob, nil
+}
+
+// Unwrap decrypts the data key with the AWS KMS key for the key ID.
+//
+// Data keys wrapped with a previous AWS KMS key can be unwrapped
+// as long as the key is still enabled and the client has access.
+//
+// https://docs.aws.amazon.com/kms/latest/APIReference/API_Decrypt.html
+func (c *client) Unwrap(ctx context.Context, id string, wrapped []byte) ([]byte, error) {
+	c.Logger.Tracef("unwrapping data key with key %s", id)
+
+	// verify the data key was wrapped with an AWS KMS key
+	if !strings.HasPrefix(id, DriverAWSKMS+":") {
+		return nil, fmt.Errorf("unknown key %s provided for aws kms", id)
+	}
+
+	// send API call to decrypt the data key
+	out, err := c.KMS.DecryptWithContext(ctx, &kms.DecryptInput{
+		CiphertextBlob:    wrapped,
+		EncryptionContext: encryptionContext,
+		KeyId:             aws.String(strings.TrimPrefix(id, DriverAWSKMS+":")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key with %s: %w", id, err)
+	}
+
+	return out.Plaintext, nil
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package awskms
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// mockKMS represents a fake AWS KMS API that
+// reverses the plaintext to wrap data keys.
+type mockKMS struct {
+	kmsiface.KMSAPI
+
+	keyID string
+}
+
+func (m *mockKMS) EncryptWithContext(ctx aws.Context, in *kms.EncryptInput, opts ...request.Option) (*kms.EncryptOutput, error) {
+	m.keyID = aws.StringValue(in.KeyId)
+
+	return &kms.EncryptOutput{CiphertextBlob: reverse(in.Plaintext), KeyId: in.KeyId}, nil
+}
+
+func (m *mockKMS) DecryptWithContext(ctx aws.Context, in *kms.DecryptInput, opts ...request.Option) (*kms.DecryptOutput, error) {
+	if aws.StringValue(in.EncryptionContext["service"]) != "vela" {
+		return nil, errors.New("invalid encryption context")
+	}
+
+	m.keyID = aws.StringValue(in.KeyId)
+
+	return &kms.DecryptOutput{Plaintext: reverse(in.CiphertextBlob), KeyId: in.KeyId}, nil
+}
+
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+
+	return r
+}
+
+func TestAWSKMS_WrapUnwrap(t *testing.T) {
+	// setup types
+	dek := []byte("0123456789abcdef0123456789abcdef")
+	api := new(mockKMS)
+
+	_service, err := New(
+		WithKeyID("alias/vela"),
+		WithAPI(api),
+	)
+	if err != nil {
+		t.Errorf("unable to create kms service: %v", err)
+	}
+
+	// run test
+	wrapped, err := _service.Wrap(context.Background(), dek)
+	if err != nil {
+		t.Errorf("Wrap returned err: %v", err)
+	}
+
+	if api.keyID != "alias/vela" {
+		t.Errorf("Wrap used key %v, want alias/vela", api.keyID)
+	}
+
+	got, err := _service.Unwrap(context.Background(), "awskms:alias/previous", wrapped)
+	if err != nil {
+		t.Errorf("Unwrap returned err: %v", err)
+	}
+
+	if api.keyID != "alias/previous" {
+		t.Errorf("Unwrap used key %v, want alias/previous", api.keyID)
+	}
+
+	if !reflect.DeepEqual(got, dek) {
+		t.Errorf("Unwrap is %v, want %v", got, dek)
+	}
+
+	_, err = _service.Unwrap(context.Background(), "local:0000000000000000", wrapped)
+	if err == nil {
+		t.Errorf("Unwrap should have returned err")
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package awskms provides the ability for Vela to wrap data keys
+// with the AWS Key Management Service. The keys can be backed by
+// a CloudHSM custom key store for HSM-backed deployments.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/kms/awskms"
+package awskms
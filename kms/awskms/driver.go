@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package awskms
+
+// DriverAWSKMS defines the driver type when integrating with AWS KMS.
+const DriverAWSKMS = "awskms"
+
+// Driver outputs the configured kms driver.
+func (c *client) Driver() string {
+	return DriverAWSKMS
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package awskms
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAWSKMS_Driver(t *testing.T) {
+	// setup types
+	want := DriverAWSKMS
+
+	_service, err := New(
+		WithKeyID("alias/vela"),
+		WithAPI(new(mockKMS)),
+	)
+	if err != nil {
+		t.Errorf("unable to create kms service: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package awskms
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// ClientOpt represents a configuration option to initialize the kms client for AWS KMS.
+type ClientOpt func(*client) error
+
+// WithKeyID sets the key ID in the kms client for AWS KMS.
+func WithKeyID(id string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring key ID in aws kms client")
+
+		// check if the AWS KMS key ID provided is empty
+		if len(id) == 0 {
+			return fmt.Errorf("no AWS KMS key ID provided")
+		}
+
+		// set the key ID in the aws kms client
+		c.config.KeyID = id
+
+		return nil
+	}
+}
+
+// WithRegion sets the region in the kms client for AWS KMS.
+func WithRegion(region string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring region in aws kms client")
+
+		// set the region in the aws kms client
+		c.config.Region = region
+
+		return nil
+	}
+}
+
+// WithAPI sets the AWS KMS API client in the kms client for AWS KMS.
+//
+// This function is intended for running tests only.
+func WithAPI(api kmsiface.KMSAPI) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring api in aws kms client")
+
+		// set the api in the aws kms client
+		c.KMS = api
+
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package awskms
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAWSKMS_ClientOpt_WithKeyID(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		id      string
+		want    string
+	}{
+		{
+			failure: false,
+			id:      "alias/vela",
+			want:    "alias/vela",
+		},
+		{
+			failure: true,
+			id:      "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithKeyID(test.id),
+			WithAPI(new(mockKMS)),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithKeyID should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithKeyID returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.KeyID, test.want) {
+			t.Errorf("WithKeyID is %v, want %v", _service.config.KeyID, test.want)
+		}
+	}
+}
+
+func TestAWSKMS_ClientOpt_WithRegion(t *testing.T) {
+	// setup types
+	want := "us-east-1"
+
+	_service, err := New(
+		WithKeyID("alias/vela"),
+		WithRegion(want),
+	)
+	if err != nil {
+		t.Errorf("WithRegion returned err: %v", err)
+	}
+
+	// run test
+	if !reflect.DeepEqual(_service.config.Region, want) {
+		t.Errorf("WithRegion is %v, want %v", _service.config.Region, want)
+	}
+
+	if _service.KMS == nil {
+		t.Errorf("New should have created the AWS KMS API client")
+	}
+}
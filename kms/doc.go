@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package kms provides the ability for Vela to integrate
+// with different supported Key Management Service backends
+// used for envelope encryption of sensitive database fields.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/kms"
+package kms
@@ -0,0 +1,295 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// envelopePrefix defines the prefix for values sealed by an Envelope.
+	envelopePrefix = "vela:v1:"
+
+	// dataKeyLifetime defines how long a data key is used to
+	// seal new values before a new data key is generated.
+	dataKeyLifetime = 15 * time.Minute
+
+	// maxCachedKeys defines the maximum number of unwrapped
+	// data keys cached in memory to open sealed values.
+	maxCachedKeys = 1024
+)
+
+type (
+	// sealed represents the value stored in the database
+	// for a field encrypted by an Envelope.
+	sealed struct {
+		// identifier of the key encryption key that wrapped the data key
+		KeyID string `json:"kid"`
+		// data key wrapped by the key encryption key
+		Key []byte `json:"key"`
+		// nonce and ciphertext for the value encrypted by the data key
+		Data []byte `json:"data"`
+	}
+
+	// dataKey represents a data key used to seal new values.
+	dataKey struct {
+		keyID   string
+		plain   []byte
+		wrapped []byte
+		created time.Time
+	}
+
+	// Envelope represents the envelope encryption for
+	// sensitive fields stored in the database. Every value
+	// is encrypted with a data key and the data key is
+	// wrapped with the key encryption key from the kms.
+	Envelope struct {
+		// kms service used to wrap and unwrap data keys
+		service Service
+		// static key used to open values encrypted before envelopes
+		legacyKey string
+
+		mutex sync.Mutex
+		// data key used to seal new values
+		current *dataKey
+		// unwrapped data keys indexed by the wrapped data key
+		keys map[string][]byte
+	}
+)
+
+// NewEnvelope creates and returns an envelope that wraps data
+// keys with the provided kms service. The legacy key is used to
+// open values encrypted with the static database encryption key.
+func NewEnvelope(s Service, legacyKey string) *Envelope {
+	return &Envelope{
+		service:   s,
+		legacyKey: legacyKey,
+		keys:      make(map[string][]byte),
+	}
+}
+
+// Seal encrypts the value with a data key wrapped by the kms.
+func (e *Envelope) Seal(ctx context.Context, value []byte) (string, error) {
+	key, err := e.dataKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := encrypt(key.plain, value)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := json.Marshal(&sealed{
+		KeyID: key.keyID,
+		Key:   key.wrapped,
+		Data:  data,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return envelopePrefix + base64.StdEncoding.EncodeToString(s), nil
+}
+
+// Open decrypts the value sealed by an envelope or
+// encrypted with the legacy static encryption key.
+func (e *Envelope) Open(ctx context.Context, value string) ([]byte, error) {
+	// check if the value was encrypted with the legacy key
+	if !strings.HasPrefix(value, envelopePrefix) {
+		if len(e.legacyKey) == 0 {
+			return nil, errors.New("no legacy key provided to open value")
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return decrypt([]byte(e.legacyKey), decoded)
+	}
+
+	s, err := parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := e.unwrap(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(key, s.Data)
+}
+
+// Current returns whether the value is sealed by a data
+// key wrapped with the current key encryption key.
+func (e *Envelope) Current(value string) bool {
+	if !strings.HasPrefix(value, envelopePrefix) {
+		return false
+	}
+
+	s, err := parse(value)
+	if err != nil {
+		return false
+	}
+
+	return s.KeyID == e.service.KeyID()
+}
+
+// dataKey is a helper function to capture the data key used to seal
+// new values. A new data key is generated and wrapped by the kms when
+// the lifetime expires or the key encryption key has changed.
+func (e *Envelope) dataKey(ctx context.Context) (*dataKey, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	id := e.service.KeyID()
+
+	// check if the current data key can still be used
+	if e.current != nil && e.current.keyID == id && time.Since(e.current.created) < dataKeyLifetime {
+		return e.current, nil
+	}
+
+	plain := make([]byte, 32)
+
+	// set data key from a cryptographically secure random number generator
+	_, err := io.ReadFull(rand.Reader, plain)
+	if err != nil {
+		return nil, err
+	}
+
+	// send API call to wrap the data key
+	wrapped, err := e.service.Wrap(ctx, plain)
+	if err != nil {
+		return nil, err
+	}
+
+	e.current = &dataKey{
+		keyID:   id,
+		plain:   plain,
+		wrapped: wrapped,
+		created: time.Now(),
+	}
+
+	e.cache(id, wrapped, plain)
+
+	return e.current, nil
+}
+
+// unwrap is a helper function to capture the data key for a sealed
+// value from the cache or by unwrapping the data key with the kms.
+func (e *Envelope) unwrap(ctx context.Context, s *sealed) ([]byte, error) {
+	e.mutex.Lock()
+	key, ok := e.keys[s.KeyID+string(s.Key)]
+	e.mutex.Unlock()
+
+	if ok {
+		return key, nil
+	}
+
+	// send API call to unwrap the data key
+	key, err := e.service.Unwrap(ctx, s.KeyID, s.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mutex.Lock()
+	e.cache(s.KeyID, s.Key, key)
+	e.mutex.Unlock()
+
+	return key, nil
+}
+
+// cache is a helper function to store an unwrapped data key.
+// The cache is reset when it reaches the maximum size.
+//
+// The mutex must be held by the caller.
+func (e *Envelope) cache(id string, wrapped, key []byte) {
+	if len(e.keys) >= maxCachedKeys {
+		e.keys = make(map[string][]byte)
+	}
+
+	e.keys[id+string(wrapped)] = key
+}
+
+// parse is a helper function to decode a sealed value.
+func parse(value string) (*sealed, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, envelopePrefix))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode sealed value: %w", err)
+	}
+
+	s := new(sealed)
+
+	err = json.Unmarshal(decoded, s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sealed value: %w", err)
+	}
+
+	return s, nil
+}
+
+// decrypt is a helper function to decrypt values with
+// a AES-256 Galois Counter Mode cipher block.
+func decrypt(key, value []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+
+	// verify the value has a length greater than the nonce
+	if len(value) < nonceSize {
+		return nil, fmt.Errorf("invalid value length for decrypt provided: %d", len(value))
+	}
+
+	// capture nonce and ciphertext from the value
+	nonce, ciphertext := value[:nonceSize], value[nonceSize:]
+
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// encrypt is a helper function to encrypt values with
+// a AES-256 Galois Counter Mode cipher block and a
+// randomly generated nonce.
+func encrypt(key, value []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	// set nonce from a cryptographically secure random number generator
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, value, nil), nil
+}
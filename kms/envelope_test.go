@@ -0,0 +1,193 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-vela/types/database"
+)
+
+const (
+	currentKey  = "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"
+	previousKey = "Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"
+)
+
+// countingService represents a kms service
+// that counts the data keys it unwraps.
+type countingService struct {
+	Service
+
+	unwraps int
+}
+
+func (s *countingService) Unwrap(ctx context.Context, id string, wrapped []byte) ([]byte, error) {
+	s.unwraps++
+
+	return s.Service.Unwrap(ctx, id, wrapped)
+}
+
+func TestKMS_Envelope_SealOpen(t *testing.T) {
+	// setup types
+	_service, _ := (&Setup{Driver: "local", LocalKey: currentKey}).Local()
+	e := NewEnvelope(_service, currentKey)
+
+	want := []byte("superSecretToken")
+
+	// run test
+	sealed, err := e.Seal(context.Background(), want)
+	if err != nil {
+		t.Errorf("Seal returned err: %v", err)
+	}
+
+	if !strings.HasPrefix(sealed, envelopePrefix) {
+		t.Errorf("Seal is %v, want prefix %s", sealed, envelopePrefix)
+	}
+
+	if strings.Contains(sealed, string(want)) {
+		t.Errorf("Seal %v should not contain the value", sealed)
+	}
+
+	if !e.Current(sealed) {
+		t.Errorf("Current is false, want true")
+	}
+
+	got, err := e.Open(context.Background(), sealed)
+	if err != nil {
+		t.Errorf("Open returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Open is %v, want %v", string(got), string(want))
+	}
+}
+
+func TestKMS_Envelope_Open_Legacy(t *testing.T) {
+	// setup types
+	_service, _ := (&Setup{Driver: "local", LocalKey: currentKey}).Local()
+
+	r := &database.Repo{Hash: sql.NullString{String: "superSecretHash", Valid: true}}
+
+	err := r.Encrypt(previousKey)
+	if err != nil {
+		t.Errorf("unable to encrypt repo: %v", err)
+	}
+
+	// run test
+	got, err := NewEnvelope(_service, previousKey).Open(context.Background(), r.Hash.String)
+	if err != nil {
+		t.Errorf("Open returned err: %v", err)
+	}
+
+	if string(got) != "superSecretHash" {
+		t.Errorf("Open is %v, want superSecretHash", string(got))
+	}
+
+	if NewEnvelope(_service, previousKey).Current(r.Hash.String) {
+		t.Errorf("Current is true, want false")
+	}
+
+	_, err = NewEnvelope(_service, "").Open(context.Background(), r.Hash.String)
+	if err == nil {
+		t.Errorf("Open should have returned err")
+	}
+}
+
+func TestKMS_Envelope_Rotation(t *testing.T) {
+	// setup types
+	_previous, _ := (&Setup{Driver: "local", LocalKey: previousKey}).Local()
+
+	_current, _ := (&Setup{
+		Driver:            "local",
+		LocalKey:          currentKey,
+		LocalPreviousKeys: []string{previousKey},
+	}).Local()
+
+	sealed, err := NewEnvelope(_previous, currentKey).Seal(context.Background(), []byte("foo"))
+	if err != nil {
+		t.Errorf("Seal returned err: %v", err)
+	}
+
+	e := NewEnvelope(_current, currentKey)
+
+	// run test
+	if e.Current(sealed) {
+		t.Errorf("Current is true, want false")
+	}
+
+	got, err := e.Open(context.Background(), sealed)
+	if err != nil {
+		t.Errorf("Open returned err: %v", err)
+	}
+
+	if string(got) != "foo" {
+		t.Errorf("Open is %v, want foo", string(got))
+	}
+
+	resealed, err := e.Seal(context.Background(), got)
+	if err != nil {
+		t.Errorf("Seal returned err: %v", err)
+	}
+
+	if !e.Current(resealed) {
+		t.Errorf("Current is false, want true")
+	}
+
+	_, err = NewEnvelope(_previous, currentKey).Open(context.Background(), resealed)
+	if err == nil {
+		t.Errorf("Open should have returned err")
+	}
+}
+
+func TestKMS_Envelope_Cache(t *testing.T) {
+	// setup types
+	_local, _ := (&Setup{Driver: "local", LocalKey: currentKey}).Local()
+	_service := &countingService{Service: _local}
+
+	sealed, _ := NewEnvelope(_service, currentKey).Seal(context.Background(), []byte("foo"))
+
+	e := NewEnvelope(_service, currentKey)
+
+	// run test
+	for i := 0; i < 3; i++ {
+		_, err := e.Open(context.Background(), sealed)
+		if err != nil {
+			t.Errorf("Open returned err: %v", err)
+		}
+	}
+
+	if _service.unwraps != 1 {
+		t.Errorf("Open unwrapped %d data keys, want 1", _service.unwraps)
+	}
+}
+
+func TestKMS_Envelope_Open_Invalid(t *testing.T) {
+	// setup types
+	_service, _ := (&Setup{Driver: "local", LocalKey: currentKey}).Local()
+	e := NewEnvelope(_service, currentKey)
+
+	// setup tests
+	tests := []string{
+		envelopePrefix + "!!!",
+		envelopePrefix + "Zm9v",
+		"!!!",
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := e.Open(context.Background(), test)
+		if err == nil {
+			t.Errorf("Open for %s should have returned err", test)
+		}
+
+		if e.Current(test) {
+			t.Errorf("Current for %s is true, want false", test)
+		}
+	}
+}
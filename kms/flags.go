@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the kms.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// KMS Flags
+
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_DRIVER", "KMS_DRIVER"},
+		FilePath: "/vela/kms/driver",
+		Name:     "kms.driver",
+		Usage:    "driver used to wrap the data keys for user tokens and repo hashes (local, vault or awskms)",
+		Value:    "local",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_LOCAL_KEY", "KMS_LOCAL_KEY"},
+		FilePath: "/vela/kms/local/key",
+		Name:     "kms.local.key",
+		Usage:    "AES-256 key used by the local driver to wrap data keys (defaults to the database encryption key)",
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_KMS_LOCAL_PREVIOUS_KEYS", "KMS_LOCAL_PREVIOUS_KEYS"},
+		FilePath: "/vela/kms/local/previous_keys",
+		Name:     "kms.local.previous-keys",
+		Usage:    "AES-256 keys previously used by the local driver that can still unwrap data keys",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_VAULT_ADDR", "KMS_VAULT_ADDR"},
+		FilePath: "/vela/kms/vault/addr",
+		Name:     "kms.vault.addr",
+		Usage:    "fully qualified url (<scheme>://<host>) for the vault system",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_VAULT_TOKEN", "KMS_VAULT_TOKEN"},
+		FilePath: "/vela/kms/vault/token",
+		Name:     "kms.vault.token",
+		Usage:    "token used to access the transit engine in the vault system",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_VAULT_MOUNT", "KMS_VAULT_MOUNT"},
+		FilePath: "/vela/kms/vault/mount",
+		Name:     "kms.vault.mount",
+		Usage:    "mount path for the transit engine in the vault system",
+		Value:    "transit",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_VAULT_KEY", "KMS_VAULT_KEY"},
+		FilePath: "/vela/kms/vault/key",
+		Name:     "kms.vault.key",
+		Usage:    "name of the transit key used to wrap data keys",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_AWS_KEY_ID", "KMS_AWS_KEY_ID"},
+		FilePath: "/vela/kms/aws/key_id",
+		Name:     "kms.aws.key-id",
+		Usage:    "ID, ARN or alias of the AWS KMS key used to wrap data keys",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_KMS_AWS_REGION", "KMS_AWS_REGION", "AWS_REGION"},
+		FilePath: "/vela/kms/aws/region",
+		Name:     "kms.aws.region",
+		Usage:    "region of the AWS KMS key used to wrap data keys",
+	},
+}
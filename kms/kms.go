@@ -0,0 +1,57 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import (
+	"fmt"
+
+	"github.com/go-vela/server/kms/awskms"
+	"github.com/go-vela/server/kms/local"
+	"github.com/go-vela/server/kms/vault"
+
+	"github.com/sirupsen/logrus"
+)
+
+// New creates and returns a Vela service capable of
+// integrating with the configured kms provider.
+//
+// Currently the following kms providers are supported:
+//
+// * Local
+// * Vault
+// * AWS KMS
+// .
+func New(s *Setup) (Service, error) {
+	// validate the setup being provided
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/kms?tab=doc#Setup.Validate
+	err := s.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debug("creating kms service from setup")
+	// process the kms driver being provided
+	switch s.Driver {
+	case local.DriverLocal:
+		// handle the Local kms driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/kms?tab=doc#Setup.Local
+		return s.Local()
+	case vault.DriverVault:
+		// handle the Vault kms driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/kms?tab=doc#Setup.Vault
+		return s.Vault()
+	case awskms.DriverAWSKMS:
+		// handle the AWS KMS driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/kms?tab=doc#Setup.AWSKMS
+		return s.AWSKMS()
+	default:
+		// handle an invalid kms driver being provided
+		return nil, fmt.Errorf("invalid kms driver provided: %s", s.Driver)
+	}
+}
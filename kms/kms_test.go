@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import (
+	"testing"
+)
+
+func TestKMS_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:   "local",
+				LocalKey: "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:       "vault",
+				VaultAddress: "https://vault.example.com",
+				VaultKey:     "vela",
+				VaultMount:   "transit",
+				VaultToken:   "foo",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:    "awskms",
+				AWSKeyID:  "alias/vela",
+				AWSRegion: "us-east-1",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:   "gcpkms",
+				LocalKey: "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(test.setup)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package local provides the ability for Vela to wrap
+// data keys with a static AES-256 key encryption key.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/kms/local"
+package local
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package local
+
+// DriverLocal defines the driver type when integrating with a static key.
+const DriverLocal = "local"
+
+// Driver outputs the configured kms driver.
+func (c *client) Driver() string {
+	return DriverLocal
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package local
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocal_Driver(t *testing.T) {
+	// setup types
+	want := DriverLocal
+
+	_service, err := New(
+		WithKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+	)
+	if err != nil {
+		t.Errorf("unable to create kms service: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
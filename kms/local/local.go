@@ -0,0 +1,163 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package local
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	config struct {
+		// specifies the key encryption key to use for the local client
+		Key string
+		// specifies the previous key encryption keys to use for the local client
+		PreviousKeys []string
+	}
+
+	client struct {
+		config *config
+		// key encryption keys indexed by their key ID
+		keys map[string]string
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		Logger *logrus.Entry
+	}
+)
+
+// New returns a KMS implementation that wraps data keys with a static key.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new local client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.keys = make(map[string]string)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("kms", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// index the key encryption keys so data keys
+	// wrapped with a previous key can still be unwrapped
+	for _, key := range append(c.config.PreviousKeys, c.config.Key) {
+		c.keys[keyID(key)] = key
+	}
+
+	return c, nil
+}
+
+// KeyID outputs the identifier of the key encryption key.
+func (c *client) KeyID() string {
+	return keyID(c.config.Key)
+}
+
+// Wrap encrypts the data key with the key encryption key.
+func (c *client) Wrap(ctx context.Context, dek []byte) ([]byte, error) {
+	c.Logger.Tracef("wrapping data key with key %s", c.KeyID())
+
+	return encrypt(c.config.Key, dek)
+}
+
+// Unwrap decrypts the data key with the key encryption key for the key ID.
+func (c *client) Unwrap(ctx context.Context, id string, wrapped []byte) ([]byte, error) {
+	c.Logger.Tracef("unwrapping data key with key %s", id)
+
+	key, ok := c.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %s provided for local kms", id)
+	}
+
+	return decrypt(key, wrapped)
+}
+
+// keyID is a helper function to create a stable identifier
+// for a key without revealing the key itself.
+func keyID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return fmt.Sprintf("%s:%s", DriverLocal, hex.EncodeToString(sum[:8]))
+}
+
+// decrypt is a helper function to decrypt values with
+// a AES-256 Galois Counter Mode cipher block created
+// from the key encryption key.
+func decrypt(key string, value []byte) ([]byte, error) {
+	// create a new cipher block from the key encryption key
+	block, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	// creates a new Galois Counter Mode cipher block
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := gcm.NonceSize()
+
+	// verify the value has a length greater than the nonce
+	if len(value) < nonceSize {
+		return nil, fmt.Errorf("invalid value length for decrypt provided: %d", len(value))
+	}
+
+	// capture nonce and ciphertext from the value
+	nonce, ciphertext := value[:nonceSize], value[nonceSize:]
+
+	// decrypt the value from the ciphertext
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// encrypt is a helper function to encrypt values with
+// a AES-256 Galois Counter Mode cipher block created
+// from the key encryption key and a random nonce.
+func encrypt(key string, value []byte) ([]byte, error) {
+	// create a new cipher block from the key encryption key
+	block, err := aes.NewCipher([]byte(key))
+	if err != nil {
+		return nil, err
+	}
+
+	// creates a new Galois Counter Mode cipher block
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	// set nonce from a cryptographically secure random number generator
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// encrypt the value with the randomly generated nonce
+	return gcm.Seal(nonce, nonce, value, nil), nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package local
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLocal_New(t *testing.T) {
+	// setup types
+	_service, err := New(
+		WithKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+	)
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	// run test
+	got := _service.KeyID()
+
+	if !strings.HasPrefix(got, DriverLocal+":") {
+		t.Errorf("KeyID is %v, want prefix %s:", got, DriverLocal)
+	}
+
+	if strings.Contains(got, "A1B2C3D4") {
+		t.Errorf("KeyID %v should not contain the key", got)
+	}
+}
+
+func TestLocal_WrapUnwrap(t *testing.T) {
+	// setup types
+	dek := []byte("0123456789abcdef0123456789abcdef")
+
+	previous, _ := New(
+		WithKey("Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"),
+	)
+
+	current, _ := New(
+		WithKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+		WithPreviousKeys([]string{"Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"}),
+	)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		wrapper *client
+	}{
+		{name: "current key", wrapper: current},
+		{name: "previous key", wrapper: previous},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			wrapped, err := test.wrapper.Wrap(context.Background(), dek)
+			if err != nil {
+				t.Errorf("Wrap returned err: %v", err)
+			}
+
+			if reflect.DeepEqual(wrapped, dek) {
+				t.Errorf("Wrap returned the unwrapped data key")
+			}
+
+			got, err := current.Unwrap(context.Background(), test.wrapper.KeyID(), wrapped)
+			if err != nil {
+				t.Errorf("Unwrap returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, dek) {
+				t.Errorf("Unwrap is %v, want %v", got, dek)
+			}
+		})
+	}
+}
+
+func TestLocal_Unwrap_Failure(t *testing.T) {
+	// setup types
+	_service, _ := New(
+		WithKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+	)
+
+	wrapped, _ := _service.Wrap(context.Background(), []byte("foo"))
+
+	tampered := append([]byte{}, wrapped...)
+	tampered[len(tampered)-1] ^= 0xff
+
+	// setup tests
+	tests := []struct {
+		name    string
+		id      string
+		wrapped []byte
+	}{
+		{name: "unknown key", id: "local:0000000000000000", wrapped: wrapped},
+		{name: "invalid length", id: _service.KeyID(), wrapped: []byte("foo")},
+		{name: "tampered", id: _service.KeyID(), wrapped: tampered},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := _service.Unwrap(context.Background(), test.id, test.wrapped)
+			if err == nil {
+				t.Errorf("Unwrap should have returned err")
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package local
+
+import (
+	"fmt"
+)
+
+// ClientOpt represents a configuration option to initialize the kms client for a static key.
+type ClientOpt func(*client) error
+
+// WithKey sets the key encryption key in the kms client for a static key.
+func WithKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring key in local kms client")
+
+		// enforce AES-256 for the key - explicitly check for 32 characters in the key
+		if len(key) != 32 {
+			return fmt.Errorf("local kms key must have 32 characters - provided length: %d", len(key))
+		}
+
+		// set the key in the local client
+		c.config.Key = key
+
+		return nil
+	}
+}
+
+// WithPreviousKeys sets the previous key encryption keys in the kms client for a static key.
+func WithPreviousKeys(keys []string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring previous keys in local kms client")
+
+		for _, key := range keys {
+			// enforce AES-256 for the key - explicitly check for 32 characters in the key
+			if len(key) != 32 {
+				return fmt.Errorf("local kms previous key must have 32 characters - provided length: %d", len(key))
+			}
+		}
+
+		// set the previous keys in the local client
+		c.config.PreviousKeys = keys
+
+		return nil
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package local
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLocal_ClientOpt_WithKey(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		key     string
+		want    string
+	}{
+		{
+			failure: false,
+			key:     "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+			want:    "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+		},
+		{
+			failure: true,
+			key:     "tooshort",
+			want:    "",
+		},
+		{
+			failure: true,
+			key:     "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithKey(test.key),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithKey should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithKey returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Key, test.want) {
+			t.Errorf("WithKey is %v, want %v", _service.config.Key, test.want)
+		}
+	}
+}
+
+func TestLocal_ClientOpt_WithPreviousKeys(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		keys    []string
+		want    []string
+	}{
+		{
+			failure: false,
+			keys:    []string{"Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"},
+			want:    []string{"Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"},
+		},
+		{
+			failure: false,
+			keys:    nil,
+			want:    nil,
+		},
+		{
+			failure: true,
+			keys:    []string{"tooshort"},
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithKey("A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW"),
+			WithPreviousKeys(test.keys),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithPreviousKeys should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithPreviousKeys returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.PreviousKeys, test.want) {
+			t.Errorf("WithPreviousKeys is %v, want %v", _service.config.PreviousKeys, test.want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import "context"
+
+// Service represents the interface for Vela integrating
+// with the different supported key management providers.
+//
+// The provider owns the key encryption key and is only
+// used to wrap and unwrap the data keys that encrypt
+// the sensitive fields stored in the database.
+type Service interface {
+	// Service Interface Functions
+
+	// Driver defines a function that outputs
+	// the configured kms driver.
+	Driver() string
+
+	// KeyID defines a function that outputs the identifier
+	// of the key encryption key used to wrap new data keys.
+	KeyID() string
+	// Wrap defines a function that encrypts a data key
+	// with the current key encryption key.
+	Wrap(context.Context, []byte) ([]byte, error)
+	// Unwrap defines a function that decrypts a data key
+	// with the key encryption key identified by the key ID.
+	Unwrap(context.Context, string, []byte) ([]byte, error)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/server/kms/awskms"
+	"github.com/go-vela/server/kms/local"
+	"github.com/go-vela/server/kms/vault"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Setup represents the configuration necessary for
+// creating a Vela service capable of integrating
+// with a configured kms system.
+type Setup struct {
+	// KMS Configuration
+
+	// specifies the driver to use for the kms client
+	Driver string
+
+	// specifies the key encryption key to use for the local kms client
+	LocalKey string
+	// specifies the previous key encryption keys to use for the local kms client
+	LocalPreviousKeys []string
+
+	// specifies the address to use for the vault kms client
+	VaultAddress string
+	// specifies the name of the transit key to use for the vault kms client
+	VaultKey string
+	// specifies the transit mount path to use for the vault kms client
+	VaultMount string
+	// specifies the token to use for the vault kms client
+	VaultToken string
+
+	// specifies the ID, ARN or alias of the key to use for the aws kms client
+	AWSKeyID string
+	// specifies the region to use for the aws kms client
+	AWSRegion string
+}
+
+// Local creates and returns a Vela service capable of
+// wrapping data keys with a static key encryption key.
+func (s *Setup) Local() (Service, error) {
+	logrus.Trace("creating local kms client from setup")
+
+	// create new local kms service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/kms/local?tab=doc#New
+	return local.New(
+		local.WithKey(s.LocalKey),
+		local.WithPreviousKeys(s.LocalPreviousKeys),
+	)
+}
+
+// Vault creates and returns a Vela service capable of
+// integrating with a Hashicorp Vault Transit engine.
+func (s *Setup) Vault() (Service, error) {
+	logrus.Trace("creating vault kms client from setup")
+
+	// create new Vault kms service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/kms/vault?tab=doc#New
+	return vault.New(
+		vault.WithAddress(s.VaultAddress),
+		vault.WithKey(s.VaultKey),
+		vault.WithMount(s.VaultMount),
+		vault.WithToken(s.VaultToken),
+	)
+}
+
+// AWSKMS creates and returns a Vela service capable of
+// integrating with the AWS Key Management Service.
+func (s *Setup) AWSKMS() (Service, error) {
+	logrus.Trace("creating aws kms client from setup")
+
+	// create new AWS KMS service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/kms/awskms?tab=doc#New
+	return awskms.New(
+		awskms.WithKeyID(s.AWSKeyID),
+		awskms.WithRegion(s.AWSRegion),
+	)
+}
+
+// Validate verifies the necessary fields for the
+// provided configuration are populated correctly.
+func (s *Setup) Validate() error {
+	logrus.Trace("validating kms setup for client")
+
+	// verify a kms driver was provided
+	if len(s.Driver) == 0 {
+		return fmt.Errorf("no kms driver provided")
+	}
+
+	// process the kms driver being provided
+	switch s.Driver {
+	case local.DriverLocal:
+		// enforce AES-256 for the key - explicitly check for 32 characters in the key
+		if len(s.LocalKey) != 32 {
+			return fmt.Errorf("kms local key must have 32 characters - provided length: %d", len(s.LocalKey))
+		}
+	case vault.DriverVault:
+		// verify a kms address was provided
+		if len(s.VaultAddress) == 0 {
+			return fmt.Errorf("no kms vault address provided")
+		}
+
+		// check if the kms address has a scheme
+		if !strings.Contains(s.VaultAddress, "://") {
+			return fmt.Errorf("kms vault address must be fully qualified (<scheme>://<host>)")
+		}
+
+		// check if the kms address has a trailing slash
+		if strings.HasSuffix(s.VaultAddress, "/") {
+			return fmt.Errorf("kms vault address must not have trailing slash")
+		}
+
+		// verify a kms token was provided
+		if len(s.VaultToken) == 0 {
+			return fmt.Errorf("no kms vault token provided")
+		}
+
+		// verify a kms transit key was provided
+		if len(s.VaultKey) == 0 {
+			return fmt.Errorf("no kms vault key provided")
+		}
+	case awskms.DriverAWSKMS:
+		// verify a kms key ID was provided
+		if len(s.AWSKeyID) == 0 {
+			return fmt.Errorf("no kms aws key ID provided")
+		}
+	default:
+		return fmt.Errorf("invalid kms driver provided: %s", s.Driver)
+	}
+
+	// setup is valid
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package kms
+
+import (
+	"testing"
+)
+
+func TestKMS_Setup_Local(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:            "local",
+		LocalKey:          "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+		LocalPreviousKeys: []string{"Z1Y2X3W4V5U6T7S8R9Q0PONMLKJIHGFE"},
+	}
+
+	// run test
+	got, err := _setup.Local()
+	if err != nil {
+		t.Errorf("Local returned err: %v", err)
+	}
+
+	if got.Driver() != "local" {
+		t.Errorf("Local driver is %v, want local", got.Driver())
+	}
+}
+
+func TestKMS_Setup_Vault(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:       "vault",
+		VaultAddress: "https://vault.example.com",
+		VaultKey:     "vela",
+		VaultMount:   "transit",
+		VaultToken:   "foo",
+	}
+
+	// run test
+	got, err := _setup.Vault()
+	if err != nil {
+		t.Errorf("Vault returned err: %v", err)
+	}
+
+	if got.KeyID() != "vault:transit/vela" {
+		t.Errorf("Vault key ID is %v, want vault:transit/vela", got.KeyID())
+	}
+}
+
+func TestKMS_Setup_AWSKMS(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:    "awskms",
+		AWSKeyID:  "alias/vela",
+		AWSRegion: "us-east-1",
+	}
+
+	// run test
+	got, err := _setup.AWSKMS()
+	if err != nil {
+		t.Errorf("AWSKMS returned err: %v", err)
+	}
+
+	if got.KeyID() != "awskms:alias/vela" {
+		t.Errorf("AWSKMS key ID is %v, want awskms:alias/vela", got.KeyID())
+	}
+}
+
+func TestKMS_Setup_Validate(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+	}{
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:   "local",
+				LocalKey: "A1B2C3D4E5G6H7I8J9K0LMNOPQRSTUVW",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:       "vault",
+				VaultAddress: "https://vault.example.com",
+				VaultKey:     "vela",
+				VaultToken:   "foo",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:   "awskms",
+				AWSKeyID: "alias/vela",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:   "local",
+				LocalKey: "tooshort",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:     "vault",
+				VaultKey:   "vela",
+				VaultToken: "foo",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:       "vault",
+				VaultAddress: "vault.example.com",
+				VaultKey:     "vela",
+				VaultToken:   "foo",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:       "vault",
+				VaultAddress: "https://vault.example.com/",
+				VaultKey:     "vela",
+				VaultToken:   "foo",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:       "vault",
+				VaultAddress: "https://vault.example.com",
+				VaultKey:     "vela",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:       "vault",
+				VaultAddress: "https://vault.example.com",
+				VaultToken:   "foo",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "awskms",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver: "",
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		err := test.setup.Validate()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Validate should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Validate returned err: %v", err)
+		}
+	}
+}
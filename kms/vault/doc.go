@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package vault provides the ability for Vela to wrap data keys
+// with the Hashicorp Vault Transit secrets engine. Vault can be
+// configured to seal or manage the transit keys with an HSM.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/kms/vault"
+package vault
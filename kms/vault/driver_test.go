@@ -0,0 +1,31 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package vault
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVault_Driver(t *testing.T) {
+	// setup types
+	want := DriverVault
+
+	_service, err := New(
+		WithAddress("https://vault.example.com"),
+		WithKey("vela"),
+		WithToken("foo"),
+	)
+	if err != nil {
+		t.Errorf("unable to create kms service: %v", err)
+	}
+
+	// run test
+	got := _service.Driver()
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Driver is %v, want %v", got, want)
+	}
+}
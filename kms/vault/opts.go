@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package vault
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClientOpt represents a configuration option to initialize the kms client for Vault.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address in the kms client for Vault.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in vault kms client")
+
+		// check if the Vault address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no Vault address provided")
+		}
+
+		// set the address in the vault client
+		c.config.Address = address
+
+		return nil
+	}
+}
+
+// WithKey sets the transit key in the kms client for Vault.
+func WithKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring key in vault kms client")
+
+		// check if the Vault key provided is empty
+		if len(key) == 0 {
+			return fmt.Errorf("no Vault transit key provided")
+		}
+
+		// set the key in the vault client
+		c.config.Key = key
+
+		return nil
+	}
+}
+
+// WithMount sets the transit mount path in the kms client for Vault.
+func WithMount(mount string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring mount in vault kms client")
+
+		// check if the Vault mount provided is empty
+		if len(mount) == 0 {
+			return fmt.Errorf("no Vault transit mount provided")
+		}
+
+		// set the mount in the vault client
+		c.config.Mount = strings.Trim(mount, "/")
+
+		return nil
+	}
+}
+
+// WithToken sets the token in the kms client for Vault.
+func WithToken(token string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring token in vault kms client")
+
+		// check if the Vault token provided is empty
+		if len(token) == 0 {
+			return fmt.Errorf("no Vault token provided")
+		}
+
+		// set the token in the vault client
+		c.config.Token = token
+
+		return nil
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package vault
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVault_ClientOpt_WithAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+		want    string
+	}{
+		{
+			failure: false,
+			address: "https://vault.example.com",
+			want:    "https://vault.example.com",
+		},
+		{
+			failure: true,
+			address: "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(test.address),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithAddress should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithAddress returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Address, test.want) {
+			t.Errorf("WithAddress is %v, want %v", _service.config.Address, test.want)
+		}
+	}
+}
+
+func TestVault_ClientOpt_WithKey(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		key     string
+		want    string
+	}{
+		{
+			failure: false,
+			key:     "vela",
+			want:    "vela",
+		},
+		{
+			failure: true,
+			key:     "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress("https://vault.example.com"),
+			WithKey(test.key),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithKey should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithKey returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Key, test.want) {
+			t.Errorf("WithKey is %v, want %v", _service.config.Key, test.want)
+		}
+	}
+}
+
+func TestVault_ClientOpt_WithMount(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		mount   string
+		want    string
+	}{
+		{
+			failure: false,
+			mount:   "transit",
+			want:    "transit",
+		},
+		{
+			failure: false,
+			mount:   "/vela/transit/",
+			want:    "vela/transit",
+		},
+		{
+			failure: true,
+			mount:   "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress("https://vault.example.com"),
+			WithMount(test.mount),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithMount should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithMount returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Mount, test.want) {
+			t.Errorf("WithMount is %v, want %v", _service.config.Mount, test.want)
+		}
+	}
+}
+
+func TestVault_ClientOpt_WithToken(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		token   string
+		want    string
+	}{
+		{
+			failure: false,
+			token:   "foo",
+			want:    "foo",
+		},
+		{
+			failure: true,
+			token:   "",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress("https://vault.example.com"),
+			WithToken(test.token),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithToken should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithToken returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Token, test.want) {
+			t.Errorf("WithToken is %v, want %v", _service.config.Token, test.want)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/sirupsen/logrus"
+)
+
+type (
+	config struct {
+		// specifies the address to use for the Vault client
+		Address string
+		// specifies the name of the transit key to use for the Vault client
+		Key string
+		// specifies the mount path of the transit engine to use for the Vault client
+		Mount string
+		// specifies the token to use for the Vault client
+		Token string
+	}
+
+	client struct {
+		config *config
+		Vault  *api.Client
+		// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+		Logger *logrus.Entry
+	}
+)
+
+// New returns a KMS implementation that integrates with a Vault Transit engine.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Vault client
+	c := new(client)
+
+	// create new fields
+	c.config = &config{
+		Mount: "transit",
+	}
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("kms", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create new Vault API client
+	//
+	// https://pkg.go.dev/github.com/hashicorp/vault/api#NewClient
+	_vault, err := api.NewClient(&api.Config{Address: c.config.Address})
+	if err != nil {
+		return nil, err
+	}
+
+	// set the token in the Vault client
+	_vault.SetToken(c.config.Token)
+
+	// set the Vault API client in the Vault client
+	c.Vault = _vault
+
+	return c, nil
+}
+
+// KeyID outputs the identifier of the transit key.
+//
+// The version of the transit key is recorded in the wrapped
+// data key by Vault, so rotating the transit key does not
+// change the identifier.
+func (c *client) KeyID() string {
+	return fmt.Sprintf("%s:%s/%s", DriverVault, c.config.Mount, c.config.Key)
+}
+
+// Wrap encrypts the data key with the transit key.
+//
+// https://developer.hashicorp.com/vault/api-docs/secret/transit#encrypt-data
+func (c *client) Wrap(ctx context.Context, dek []byte) ([]byte, error) {
+	c.Logger.Tracef("wrapping data key with key %s", c.KeyID())
+
+	// send API call to encrypt the data key
+	secret, err := c.Vault.Logical().WriteWithContext(ctx,
+		fmt.Sprintf("%s/encrypt/%s", c.config.Mount, c.config.Key),
+		map[string]interface{}{
+			"plaintext": base64.StdEncoding.EncodeToString(dek),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to wrap data key with %s: %w", c.KeyID(), err)
+	}
+
+	ciphertext, ok := field(secret, "ciphertext")
+	if !ok {
+		return nil, fmt.Errorf("no ciphertext returned from %s", c.KeyID())
+	}
+
+	return []byte(ciphertext), nil
+}
+
+// Unwrap decrypts the data key with the transit key.
+//
+// https://developer.hashicorp.com/vault/api-docs/secret/transit#decrypt-data
+func (c *client) Unwrap(ctx context.Context, id string, wrapped []byte) ([]byte, error) {
+	c.Logger.Tracef("unwrapping data key with key %s", id)
+
+	// verify the data key was wrapped with the transit key
+	if id != c.KeyID() {
+		return nil, fmt.Errorf("unknown key %s provided for vault kms", id)
+	}
+
+	// send API call to decrypt the data key
+	secret, err := c.Vault.Logical().WriteWithContext(ctx,
+		fmt.Sprintf("%s/decrypt/%s", c.config.Mount, c.config.Key),
+		map[string]interface{}{
+			"ciphertext": string(wrapped),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unwrap data key with %s: %w", id, err)
+	}
+
+	plaintext, ok := field(secret, "plaintext")
+	if !ok {
+		return nil, fmt.Errorf("no plaintext returned from %s", id)
+	}
+
+	return base64.StdEncoding.DecodeString(plaintext)
+}
+
+// field is a helper function to capture a string field from a Vault response.
+func field(secret *api.Secret, name string) (string, bool) {
+	if secret == nil || secret.Data == nil {
+		return "", false
+	}
+
+	value, ok := secret.Data[name].(string)
+
+	return value, ok && len(value) > 0
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestVault_WrapUnwrap(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.PUT("/v1/transit/encrypt/vela", func(c *gin.Context) {
+		body := make(map[string]string)
+		_ = c.BindJSON(&body)
+
+		c.JSON(http.StatusOK, gin.H{
+			"data": gin.H{"ciphertext": "vault:v1:" + body["plaintext"]},
+		})
+	})
+	engine.PUT("/v1/transit/decrypt/vela", func(c *gin.Context) {
+		body := make(map[string]string)
+		_ = c.BindJSON(&body)
+
+		c.JSON(http.StatusOK, gin.H{
+			"data": gin.H{"plaintext": body["ciphertext"][len("vault:v1:"):]},
+		})
+	})
+
+	fake := httptest.NewServer(engine)
+	defer fake.Close()
+
+	// setup types
+	dek := []byte("0123456789abcdef0123456789abcdef")
+
+	_service, err := New(
+		WithAddress(fake.URL),
+		WithKey("vela"),
+		WithToken("foo"),
+	)
+	if err != nil {
+		t.Errorf("unable to create kms service: %v", err)
+	}
+
+	// run test
+	wrapped, err := _service.Wrap(context.Background(), dek)
+	if err != nil {
+		t.Errorf("Wrap returned err: %v", err)
+	}
+
+	want := "vault:v1:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
+	if !reflect.DeepEqual(string(wrapped), want) {
+		t.Errorf("Wrap is %v, want %v", string(wrapped), want)
+	}
+
+	got, err := _service.Unwrap(context.Background(), _service.KeyID(), wrapped)
+	if err != nil {
+		t.Errorf("Unwrap returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, dek) {
+		t.Errorf("Unwrap is %v, want %v", got, dek)
+	}
+}
+
+func TestVault_Unwrap_Failure(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.PUT("/v1/transit/decrypt/vela", func(c *gin.Context) {
+		c.JSON(http.StatusBadRequest, gin.H{"errors": []string{"invalid ciphertext"}})
+	})
+
+	fake := httptest.NewServer(engine)
+	defer fake.Close()
+
+	_service, err := New(
+		WithAddress(fake.URL),
+		WithKey("vela"),
+		WithToken("foo"),
+	)
+	if err != nil {
+		t.Errorf("unable to create kms service: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{name: "unknown key", id: "vault:transit/other"},
+		{name: "vault error", id: _service.KeyID()},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := _service.Unwrap(context.Background(), test.id, []byte("vault:v1:foo"))
+			if err == nil {
+				t.Errorf("Unwrap should have returned err")
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package leader provides the ability for Vela to run singleton
+// background components, like the canary scheduler and the janitor,
+// on only one server replica at a time with automatic failover to
+// a standby replica when the leader stops renewing its lease.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/leader"
+package leader
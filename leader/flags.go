@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leader
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line interface
+// (CLI) flags for electing a leader among server replicas.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Leader Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_LEADER_TTL", "LEADER_TTL"},
+		FilePath: "/vela/leader/ttl",
+		Name:     "leader.ttl",
+		Usage:    "duration a replica holds the lease for a singleton component without renewing it (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_LEADER_INTERVAL", "LEADER_INTERVAL"},
+		FilePath: "/vela/leader/interval",
+		Name:     "leader.interval",
+		Usage:    "interval at which to renew or acquire the leases for singleton components (defaults to a third of the ttl)",
+		Value:    0,
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_LEADER_HOLDER", "LEADER_HOLDER"},
+		FilePath: "/vela/leader/holder",
+		Name:     "leader.holder",
+		Usage:    "name identifying the replica as the holder of leases (defaults to the hostname)",
+	},
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leader
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the elector.
+	config struct {
+		// specifies the name identifying this replica as the holder of leases
+		Holder string
+		// specifies the duration a lease is held without being renewed
+		TTL time.Duration
+		// specifies the interval at which to renew or acquire leases
+		Interval time.Duration
+	}
+
+	// Elector represents the functionality for electing a leader
+	// among the server replicas to run each singleton component.
+	Elector struct {
+		// elector configuration settings
+		config *config
+
+		// database service used to capture and claim leases
+		database database.Service
+	}
+
+	// Component represents a singleton background
+	// component run by the leader for the component.
+	Component interface {
+		// Start runs the component until the provided channel is closed.
+		Start(<-chan struct{}) error
+	}
+
+	// Handoff represents a component that hands off its state
+	// to the replica that next acquires the lease for the component.
+	Handoff interface {
+		// State captures the state of the component to persist in the lease.
+		State() string
+		// Resume restores the state persisted in the lease by the previous holder.
+		Resume(string)
+	}
+)
+
+// New creates and returns an elector for singleton components.
+func New(opts ...Opt) (*Elector, error) {
+	// create new elector
+	e := new(Elector)
+
+	// create new fields
+	e.config = new(config)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(e)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// default the holder to the hostname for the replica
+	if len(e.config.Holder) == 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("unable to capture hostname for leader holder: %w", err)
+		}
+
+		e.config.Holder = hostname
+	}
+
+	// default the interval to renew the lease three times before it expires
+	if e.config.Interval == 0 {
+		e.config.Interval = e.config.TTL / 3
+	}
+
+	// check if the lease would expire before it is renewed
+	if e.Enabled() && e.config.Interval >= e.config.TTL {
+		return nil, fmt.Errorf("leader interval %s must be less than leader ttl %s", e.config.Interval, e.config.TTL)
+	}
+
+	return e, nil
+}
+
+// Enabled returns whether the elector is configured
+// to run singleton components on only the leader.
+func (e *Elector) Enabled() bool {
+	return e.config.TTL > 0
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leader
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestLeader_New(t *testing.T) {
+	// setup types
+	hostname, _ := os.Hostname()
+
+	// setup tests
+	tests := []struct {
+		name     string
+		failure  bool
+		opts     []Opt
+		enabled  bool
+		holder   string
+		interval time.Duration
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+			holder:  hostname,
+		},
+		{
+			name:     "with ttl",
+			failure:  false,
+			opts:     []Opt{WithTTL(30 * time.Second), WithHolder("vela-server-0")},
+			enabled:  true,
+			holder:   "vela-server-0",
+			interval: 10 * time.Second,
+		},
+		{
+			name:     "with interval",
+			failure:  false,
+			opts:     []Opt{WithTTL(30 * time.Second), WithInterval(5 * time.Second)},
+			enabled:  true,
+			holder:   hostname,
+			interval: 5 * time.Second,
+		},
+		{
+			name:    "interval not less than ttl",
+			failure: true,
+			opts:    []Opt{WithTTL(30 * time.Second), WithInterval(30 * time.Second)},
+		},
+		{
+			name:    "negative ttl",
+			failure: true,
+			opts:    []Opt{WithTTL(-time.Second)},
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Second)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+
+			if got.config.Holder != test.holder {
+				t.Errorf("Holder is %v, want %v", got.config.Holder, test.holder)
+			}
+
+			if got.config.Interval != test.interval {
+				t.Errorf("Interval is %v, want %v", got.config.Interval, test.interval)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leader
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the elector.
+type Opt func(*Elector) error
+
+// WithDatabase sets the database service in the elector.
+func WithDatabase(db database.Service) Opt {
+	return func(e *Elector) error {
+		// set the database service in the elector
+		e.database = db
+
+		return nil
+	}
+}
+
+// WithHolder sets the name identifying the replica in the elector.
+func WithHolder(holder string) Opt {
+	return func(e *Elector) error {
+		// set the holder in the elector
+		e.config.Holder = holder
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to renew or acquire leases in the elector.
+func WithInterval(interval time.Duration) Opt {
+	return func(e *Elector) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid leader interval provided: %s", interval)
+		}
+
+		// set the interval in the elector
+		e.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithTTL sets the duration a lease is held without being renewed in the elector.
+func WithTTL(ttl time.Duration) Opt {
+	return func(e *Elector) error {
+		// check if the ttl provided is negative
+		if ttl < 0 {
+			return fmt.Errorf("invalid leader ttl provided: %s", ttl)
+		}
+
+		// set the ttl in the elector
+		e.config.TTL = ttl
+
+		return nil
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leader
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+var (
+	leading = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "vela_leader_is_leader",
+			Help: "Whether the replica holds the lease to run the singleton component.",
+		},
+		[]string{"component"},
+	)
+
+	changes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "vela_leader_changes_total",
+			Help: "The number of times the replica acquired or lost the lease to run the singleton component.",
+		},
+		[]string{"component", "change"},
+	)
+)
+
+// changes to the leadership of a component.
+const (
+	changeAcquired = "acquired"
+	changeLost     = "lost"
+	changeReleased = "released"
+)
+
+// Run runs the component on this replica only while it holds the
+// lease for the component, until the provided channel is closed.
+// Standby replicas acquire the lease once the leader stops renewing
+// it, or immediately when the leader releases it because the component
+// stopped or the server is shutting down. When leader election is not
+// enabled, the component is run on every replica.
+func (e *Elector) Run(name string, c Component, dying <-chan struct{}) error {
+	// run the component without a lease
+	if !e.Enabled() {
+		return c.Start(dying)
+	}
+
+	logrus.Infof("electing leader for %s every %s as %s", name, e.config.Interval, e.config.Holder)
+
+	ticker := time.NewTicker(e.config.Interval)
+	defer ticker.Stop()
+
+	var (
+		lease *types.Lease
+		stop  chan struct{}
+		done  chan error
+	)
+
+	for {
+		now := time.Now().UTC()
+
+		if lease == nil {
+			lease = e.acquire(name, c, now)
+
+			// start the component once the lease is acquired
+			if lease != nil {
+				stop, done = make(chan struct{}), make(chan error, 1)
+
+				go func(stop <-chan struct{}, done chan<- error) {
+					done <- c.Start(stop)
+				}(stop, done)
+			}
+		} else {
+			var held bool
+
+			lease, held = e.renew(lease, c, now)
+
+			// stop the component once the lease is lost
+			if !held {
+				close(stop)
+				<-done
+
+				lease, done = nil, nil
+			}
+		}
+
+		select {
+		case <-dying:
+			// stop the component and hand off the lease
+			if lease != nil {
+				close(stop)
+				<-done
+
+				e.release(lease, c)
+			}
+
+			return nil
+		case err := <-done:
+			// hand off the lease when the component stops on its own
+			logrus.Errorf("%s stopped while leader: %v", name, err)
+
+			e.release(lease, c)
+
+			lease, done = nil, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// acquire is a helper function to claim the lease for the component
+// when it was never held, is held by this replica or has expired.
+// The lease is returned when it was acquired.
+func (e *Elector) acquire(name string, c Component, now time.Time) *types.Lease {
+	// send API call to capture the lease
+	l, err := e.database.GetLease(context.Background(), name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		l = new(types.Lease)
+		l.SetName(name)
+		l.SetHolder(e.config.Holder)
+		l.SetRenewed(now.Unix())
+		l.SetExpires(now.Unix())
+
+		// send API call to create the lease already expired to claim it below
+		err = e.database.CreateLease(context.Background(), l)
+		if err != nil {
+			logrus.Debugf("unable to create lease for %s: %v", name, err)
+
+			return nil
+		}
+
+		// send API call to capture the lease created
+		l, err = e.database.GetLease(context.Background(), name)
+	}
+
+	if err != nil {
+		logrus.Errorf("unable to get lease for %s: %v", name, err)
+
+		return nil
+	}
+
+	// skip the lease while another replica holds it
+	if l.GetHolder() != e.config.Holder && l.GetExpires() > now.Unix() {
+		return nil
+	}
+
+	claim := *l
+	claim.SetHolder(e.config.Holder)
+	claim.SetAcquired(now.Unix())
+	claim.SetRenewed(now.Unix())
+	claim.SetExpires(now.Add(e.config.TTL).Unix())
+	claim.SetChanges(l.GetChanges() + 1)
+
+	// send API call to claim the lease
+	claimed, err := e.database.ClaimLease(context.Background(), &claim, l.GetHolder(), l.GetRenewed())
+	if err != nil {
+		logrus.Errorf("unable to claim lease for %s: %v", name, err)
+
+		return nil
+	}
+
+	if !claimed {
+		return nil
+	}
+
+	logrus.Infof("acquired lease for %s from %s", name, l.GetHolder())
+
+	// restore the state handed off by the previous holder
+	if h, ok := c.(Handoff); ok && len(l.GetState()) > 0 {
+		h.Resume(l.GetState())
+	}
+
+	leading.WithLabelValues(name).Set(1)
+	changes.WithLabelValues(name, changeAcquired).Inc()
+
+	return &claim
+}
+
+// renew is a helper function to extend the lease held for the
+// component. The lease is returned along with whether it is
+// still held by this replica.
+func (e *Elector) renew(l *types.Lease, c Component, now time.Time) (*types.Lease, bool) {
+	claim := *l
+	claim.SetRenewed(now.Unix())
+	claim.SetExpires(now.Add(e.config.TTL).Unix())
+	claim.SetState(state(l, c))
+
+	// send API call to claim the lease
+	renewed, err := e.database.ClaimLease(context.Background(), &claim, e.config.Holder, l.GetRenewed())
+	if err != nil {
+		logrus.Errorf("unable to renew lease for %s: %v", l.GetName(), err)
+
+		// keep the lease until it expires to retry renewing it
+		if l.GetExpires() > now.Unix() {
+			return l, true
+		}
+	}
+
+	if !renewed {
+		logrus.Warnf("lost lease for %s", l.GetName())
+
+		leading.WithLabelValues(l.GetName()).Set(0)
+		changes.WithLabelValues(l.GetName(), changeLost).Inc()
+
+		return nil, false
+	}
+
+	return &claim, true
+}
+
+// release is a helper function to expire the lease held for the
+// component, handing off its state, so a standby replica can
+// acquire the lease without waiting for it to expire.
+func (e *Elector) release(l *types.Lease, c Component) {
+	now := time.Now().UTC()
+
+	claim := *l
+	claim.SetRenewed(now.Unix())
+	claim.SetExpires(now.Unix())
+	claim.SetState(state(l, c))
+
+	leading.WithLabelValues(l.GetName()).Set(0)
+	changes.WithLabelValues(l.GetName(), changeReleased).Inc()
+
+	// send API call to claim the lease
+	released, err := e.database.ClaimLease(context.Background(), &claim, e.config.Holder, l.GetRenewed())
+	if err != nil {
+		logrus.Errorf("unable to release lease for %s: %v", l.GetName(), err)
+
+		return
+	}
+
+	if released {
+		logrus.Infof("released lease for %s", l.GetName())
+	}
+}
+
+// state is a helper function to capture the state
+// of the component to persist in the lease.
+func state(l *types.Lease, c Component) string {
+	h, ok := c.(Handoff)
+	if !ok {
+		return l.GetState()
+	}
+
+	return h.State()
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/database/sqlite"
+)
+
+// testComponent is a component that hands off
+// its state to the next holder of the lease.
+type testComponent struct {
+	state string
+}
+
+// Start returns once the provided channel is closed.
+func (c *testComponent) Start(dying <-chan struct{}) error {
+	<-dying
+
+	return nil
+}
+
+// State returns the state of the component.
+func (c *testComponent) State() string {
+	return c.state
+}
+
+// Resume restores the state of the component.
+func (c *testComponent) Resume(state string) {
+	c.state = state
+}
+
+func TestLeader_Failover(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from leases;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	primary, err := New(WithDatabase(db), WithHolder("vela-server-0"), WithTTL(30*time.Second))
+	if err != nil {
+		t.Errorf("unable to create primary elector: %v", err)
+	}
+
+	standby, err := New(WithDatabase(db), WithHolder("vela-server-1"), WithTTL(30*time.Second))
+	if err != nil {
+		t.Errorf("unable to create standby elector: %v", err)
+	}
+
+	primaryComponent := &testComponent{state: "primary"}
+	standbyComponent := new(testComponent)
+
+	// run test
+	lease := primary.acquire("janitor", primaryComponent, now)
+	if lease == nil {
+		t.Fatalf("primary should have acquired the lease")
+	}
+
+	if standby.acquire("janitor", standbyComponent, now.Add(10*time.Second)) != nil {
+		t.Errorf("standby should not acquire the lease held by the primary")
+	}
+
+	lease, held := primary.renew(lease, primaryComponent, now.Add(20*time.Second))
+	if !held {
+		t.Fatalf("primary should have renewed the lease")
+	}
+
+	// the primary stops renewing the lease
+	if standby.acquire("janitor", standbyComponent, now.Add(40*time.Second)) != nil {
+		t.Errorf("standby should not acquire the lease before it expires")
+	}
+
+	takeover := standby.acquire("janitor", standbyComponent, now.Add(50*time.Second))
+	if takeover == nil {
+		t.Fatalf("standby should have acquired the expired lease")
+	}
+
+	if standbyComponent.state != "primary" {
+		t.Errorf("standby state is %s, want the state handed off by the primary", standbyComponent.state)
+	}
+
+	if takeover.GetChanges() != 2 {
+		t.Errorf("lease changes is %d, want %d", takeover.GetChanges(), 2)
+	}
+
+	_, held = primary.renew(lease, primaryComponent, now.Add(50*time.Second))
+	if held {
+		t.Errorf("primary should have lost the lease taken over by the standby")
+	}
+
+	// the standby releases the lease when shutting down
+	standbyComponent.state = "standby"
+	standby.release(takeover, standbyComponent)
+
+	if primary.acquire("janitor", primaryComponent, time.Now().UTC()) == nil {
+		t.Fatalf("primary should have acquired the released lease")
+	}
+
+	if primaryComponent.state != "standby" {
+		t.Errorf("primary state is %s, want the state handed off by the standby", primaryComponent.state)
+	}
+
+	got, err := db.GetLease(context.TODO(), "janitor")
+	if err != nil {
+		t.Errorf("unable to get lease: %v", err)
+	}
+
+	if got.GetHolder() != "vela-server-0" || got.GetChanges() != 3 {
+		t.Errorf("lease is held by %s with %d changes, want vela-server-0 with 3", got.GetHolder(), got.GetChanges())
+	}
+}
+
+func TestLeader_Run_Disabled(t *testing.T) {
+	// setup types
+	e, err := New()
+	if err != nil {
+		t.Errorf("unable to create elector: %v", err)
+	}
+
+	dying := make(chan struct{})
+	close(dying)
+
+	// run test
+	err = e.Run("janitor", new(testComponent), dying)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+}
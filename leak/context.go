@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leak
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the scanner in the context.
+const key = "leak"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the scanner
+// associated with this context.
+func FromContext(c context.Context) *Scanner {
+	// get scanner value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast scanner value to expected Scanner type
+	s, ok := v.(*Scanner)
+	if !ok {
+		return nil
+	}
+
+	return s
+}
+
+// ToContext adds the scanner to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, s *Scanner) {
+	c.Set(key, s)
+}
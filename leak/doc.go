@@ -0,0 +1,14 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package leak provides the ability for Vela to periodically scan
+// the stored logs for finished builds for leaked credentials, like
+// AWS keys, JWTs and private keys. The affected builds are flagged
+// with findings, the repo owners are notified and the leaked values
+// can be automatically redacted from the logs.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/leak"
+package leak
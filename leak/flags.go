@@ -0,0 +1,46 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leak
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the leak scanner.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Leak Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_LEAK_INTERVAL", "LEAK_INTERVAL"},
+		FilePath: "/vela/leak/interval",
+		Name:     "leak.interval",
+		Usage:    "interval at which to scan the logs for finished builds for leaked credentials (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_LEAK_LOOKBACK", "LEAK_LOOKBACK"},
+		FilePath: "/vela/leak/lookback",
+		Name:     "leak.lookback",
+		Usage:    "window of finished builds to scan when the server starts",
+		Value:    time.Hour,
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_LEAK_REDACT", "LEAK_REDACT"},
+		FilePath: "/vela/leak/redact",
+		Name:     "leak.redact",
+		Usage:    "enables automatically redacting leaked credentials from the logs",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_LEAK_WEBHOOK", "LEAK_WEBHOOK"},
+		FilePath: "/vela/leak/webhook",
+		Name:     "leak.webhook",
+		Usage:    "optional url to send the leaked credentials found for a build, and the repo owner, to as a JSON payload",
+	},
+}
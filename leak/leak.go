@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leak
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// ErrRunning defines the error type when a scan
+// is requested while another scan is running.
+var ErrRunning = errors.New("leak scan already running")
+
+type (
+	// config represents the settings required to create the scanner.
+	config struct {
+		// specifies the interval at which to scan the logs
+		Interval time.Duration
+		// specifies the window of finished builds to scan on the first scan
+		Lookback time.Duration
+		// specifies whether to redact the leaked credentials from the logs
+		Redact bool
+		// specifies the url to send the leaked credentials found for a build to
+		Webhook string
+	}
+
+	// Scanner represents the functionality for scanning
+	// the logs for finished builds for leaked credentials.
+	Scanner struct {
+		// scanner configuration settings
+		config *config
+
+		// database service used to capture the logs and store the findings
+		database database.Service
+
+		// http client used to send notifications
+		client *http.Client
+
+		// mutex used to prevent running concurrent scans
+		mutex sync.Mutex
+
+		// time the builds were last scanned up to
+		watermark int64
+	}
+)
+
+// New creates and returns a scanner for leaked credentials.
+func New(opts ...Opt) (*Scanner, error) {
+	// create new scanner
+	s := new(Scanner)
+
+	// create new fields
+	s.client = &http.Client{Timeout: 10 * time.Second}
+	s.config = &config{
+		Lookback: time.Hour,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Enabled returns whether the scanner is configured
+// to periodically scan the logs for leaked credentials.
+func (s *Scanner) Enabled() bool {
+	return s.config.Interval > 0
+}
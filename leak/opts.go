@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leak
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the scanner.
+type Opt func(*Scanner) error
+
+// WithDatabase sets the database service in the scanner.
+func WithDatabase(db database.Service) Opt {
+	return func(s *Scanner) error {
+		// set the database service in the scanner
+		s.database = db
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to scan the logs in the scanner.
+func WithInterval(interval time.Duration) Opt {
+	return func(s *Scanner) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid leak interval provided: %s", interval)
+		}
+
+		// set the interval in the scanner
+		s.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithLookback sets the window of finished builds
+// to scan on the first scan in the scanner.
+func WithLookback(lookback time.Duration) Opt {
+	return func(s *Scanner) error {
+		// check if the lookback provided is positive
+		if lookback <= 0 {
+			return fmt.Errorf("invalid leak lookback provided: %s", lookback)
+		}
+
+		// set the lookback in the scanner
+		s.config.Lookback = lookback
+
+		return nil
+	}
+}
+
+// WithRedact sets whether to redact the leaked credentials from the logs in the scanner.
+func WithRedact(redact bool) Opt {
+	return func(s *Scanner) error {
+		// set the redact in the scanner
+		s.config.Redact = redact
+
+		return nil
+	}
+}
+
+// WithWebhook sets the url to send the leaked
+// credentials found for a build to in the scanner.
+func WithWebhook(webhook string) Opt {
+	return func(s *Scanner) error {
+		// skip validation if no webhook was provided
+		if len(webhook) == 0 {
+			return nil
+		}
+
+		// check if the webhook provided is a valid url
+		_, err := url.ParseRequestURI(webhook)
+		if err != nil {
+			return fmt.Errorf("invalid leak webhook provided: %w", err)
+		}
+
+		// set the webhook in the scanner
+		s.config.Webhook = webhook
+
+		return nil
+	}
+}
@@ -0,0 +1,344 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leak
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/util"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// actor represents the name recorded as the actor
+	// for the redactions made by the scanner.
+	actor = "vela-leak-scanner"
+
+	// delay represents the duration to wait before scanning
+	// finished builds, which ensures the logs uploaded as
+	// the builds finish have been stored in the database.
+	delay = time.Minute
+
+	// perPage represents the number of logs and log
+	// lines captured at a time when listing them.
+	perPage = 100
+)
+
+// notification represents the payload sent to the
+// webhook for the leaked credentials found for a build.
+type notification struct {
+	Repo     string             `json:"repo"`
+	Build    int                `json:"build"`
+	Owner    string             `json:"owner"`
+	Findings []*api.LeakFinding `json:"findings"`
+}
+
+// Start scans the logs for finished builds at the
+// configured interval until the provided channel is closed.
+func (s *Scanner) Start(dying <-chan struct{}) error {
+	logrus.Infof("scanning logs for leaked credentials every %s", s.config.Interval)
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			findings, err := s.Run(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to scan logs for leaked credentials: %v", err)
+
+				continue
+			}
+
+			if len(findings) > 0 {
+				logrus.Warnf("found %d leaked credentials in build logs", len(findings))
+			}
+		}
+	}
+}
+
+// Run scans the logs for the builds finished since the last scan for
+// leaked credentials. A finding is recorded for every leaked credential,
+// the owner of the repo is notified and, when enabled, the credentials
+// are redacted from the logs. It returns the findings for the scan.
+//
+// ErrRunning is returned when another scan is running.
+func (s *Scanner) Run(now time.Time) ([]*api.LeakFinding, error) {
+	// prevent running concurrent scans
+	if !s.mutex.TryLock() {
+		return nil, ErrRunning
+	}
+	defer s.mutex.Unlock()
+
+	logrus.Trace("scanning logs for leaked credentials")
+
+	ctx := context.Background()
+
+	findings := []*api.LeakFinding{}
+
+	// capture the watermark for the scan
+	watermark := now.Add(-delay).Unix()
+
+	// start from the lookback window on the first scan
+	if s.watermark == 0 {
+		s.watermark = now.Add(-delay - s.config.Lookback).Unix()
+	}
+
+	// skip the scan if nothing could have finished since the last scan
+	if s.watermark >= watermark {
+		return findings, nil
+	}
+
+	// send API call to capture the builds finished since the last scan
+	builds, err := s.database.GetFinishedBuildListBetween(ctx, s.watermark, watermark)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list finished builds: %w", err)
+	}
+
+	for _, b := range builds {
+		f, err := s.scanBuild(ctx, b, now)
+		if err != nil {
+			logrus.Errorf("unable to scan logs for build %d for repo %d: %v", b.GetNumber(), b.GetRepoID(), err)
+
+			continue
+		}
+
+		findings = append(findings, f...)
+	}
+
+	s.watermark = watermark
+
+	return findings, nil
+}
+
+// scanBuild is a helper function to scan the logs for the build
+// for leaked credentials and record the findings. Builds
+// that were already flagged with findings are skipped.
+func (s *Scanner) scanBuild(ctx context.Context, b *library.Build, now time.Time) ([]*api.LeakFinding, error) {
+	findings := []*api.LeakFinding{}
+
+	// send API call to capture the findings for the build
+	existing, err := s.database.ListLeakFindingsForBuild(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list leak findings: %w", err)
+	}
+
+	// skip the build if it was already scanned
+	if len(existing) > 0 {
+		return findings, nil
+	}
+
+	logs, err := s.logs(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list logs: %w", err)
+	}
+
+	for _, l := range logs {
+		matches := scan(l.GetData())
+		if len(matches) == 0 {
+			continue
+		}
+
+		// redact the credentials before recording the findings
+		if s.config.Redact {
+			err = s.redactLog(ctx, l, matches)
+			if err != nil {
+				return nil, fmt.Errorf("unable to redact log %d: %w", l.GetID(), err)
+			}
+		}
+
+		for _, m := range matches {
+			f := new(api.LeakFinding)
+			f.SetRepoID(b.GetRepoID())
+			f.SetBuildID(b.GetID())
+			f.SetLogID(l.GetID())
+			f.SetKind(m.kind)
+			f.SetLine(m.line)
+			f.SetFingerprint(m.fingerprint())
+			f.SetRedacted(s.config.Redact)
+			f.SetCreated(now.Unix())
+
+			// send API call to create the finding
+			err = s.database.CreateLeakFinding(ctx, f)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create leak finding: %w", err)
+			}
+
+			findings = append(findings, f)
+		}
+	}
+
+	if len(findings) == 0 {
+		return findings, nil
+	}
+
+	logrus.Warnf("found %d leaked credentials in logs for build %d for repo %d", len(findings), b.GetNumber(), b.GetRepoID())
+
+	if s.config.Redact {
+		// create the record of the redaction
+		redaction := new(api.BuildRedaction)
+		redaction.SetRepoID(b.GetRepoID())
+		redaction.SetBuildID(b.GetID())
+		redaction.SetAction(api.BuildRedactionRedacted)
+		redaction.SetTargets([]string{api.BuildRedactionTargetLogs})
+		redaction.SetReason(fmt.Sprintf("leak scanner found %d leaked credentials", len(findings)))
+		redaction.SetMatches(int64(len(findings)))
+		redaction.SetActor(actor)
+		redaction.SetCreated(now.Unix())
+
+		// send API call to create the record of the redaction
+		err = s.database.CreateBuildRedaction(ctx, redaction)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create redaction record: %w", err)
+		}
+	}
+
+	err = s.notify(ctx, b, findings)
+	if err != nil {
+		logrus.Errorf("unable to notify owner of leaked credentials for build %d for repo %d: %v", b.GetNumber(), b.GetRepoID(), err)
+	}
+
+	return findings, nil
+}
+
+// logs is a helper function to capture all logs for the build.
+func (s *Scanner) logs(ctx context.Context, b *library.Build) ([]*library.Log, error) {
+	logs := []*library.Log{}
+	page := 1
+
+	for page > 0 {
+		// send API call to capture the logs (per page) for the build
+		logsPart, _, err := s.database.ListLogsForBuild(ctx, b, page, perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		// add page of logs to list logs
+		logs = append(logs, logsPart...)
+
+		// assume no more pages exist if under the per page amount
+		if len(logsPart) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	return logs, nil
+}
+
+// redactLog is a helper function to replace the leaked credentials
+// in the log, and the structured lines for the log, with the
+// secret mask.
+func (s *Scanner) redactLog(ctx context.Context, l *library.Log, matches []*match) error {
+	l.SetData(redact(l.GetData(), matches))
+
+	// send API call to update the log
+	err := s.database.UpdateLog(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	lines := []*api.LogLine{}
+	page := 1
+
+	for page > 0 {
+		// send API call to capture the lines (per page) for the log
+		linesPart, _, err := s.database.ListLinesForLog(ctx, l, page, perPage)
+		if err != nil {
+			return err
+		}
+
+		// add page of lines to list lines
+		lines = append(lines, linesPart...)
+
+		// assume no more pages exist if under the per page amount
+		if len(linesPart) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	for _, line := range lines {
+		line.SetData(string(redact([]byte(line.GetData()), matches)))
+	}
+
+	// send API call to remove the lines for the log
+	err = s.database.DeleteLinesForLog(ctx, l)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(lines); start += perPage {
+		end := util.MinInt(start+perPage, len(lines))
+
+		// send API call to create the redacted lines (per page) for the log
+		err = s.database.CreateLogLines(ctx, lines[start:end])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notify is a helper function to send the leaked credentials
+// found for the build, and the owner of the repo, to the
+// configured webhook.
+func (s *Scanner) notify(ctx context.Context, b *library.Build, findings []*api.LeakFinding) error {
+	// skip the notification if no webhook is configured
+	if len(s.config.Webhook) == 0 {
+		return nil
+	}
+
+	// send API call to capture the repo for the build
+	r, err := s.database.GetRepo(ctx, b.GetRepoID())
+	if err != nil {
+		return fmt.Errorf("unable to get repo: %w", err)
+	}
+
+	// send API call to capture the owner of the repo
+	u, err := s.database.GetUser(ctx, r.GetUserID())
+	if err != nil {
+		return fmt.Errorf("unable to get owner: %w", err)
+	}
+
+	body, err := json.Marshal(&notification{
+		Repo:     r.GetFullName(),
+		Build:    b.GetNumber(),
+		Owner:    u.GetName(),
+		Findings: findings,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.client.Post(s.config.Webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook %s responded with status %d", s.config.Webhook, resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leak
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func TestLeak_Run(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+	_user.SetToken("bar")
+	_user.SetHash("baz")
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetStatus("success")
+	_build.SetFinished(now.Add(-10 * time.Minute).Unix())
+
+	_log := new(library.Log)
+	_log.SetID(1)
+	_log.SetBuildID(1)
+	_log.SetRepoID(1)
+	_log.SetStepID(1)
+	_log.SetData([]byte("$ env\nAWS_ACCESS_KEY_ID=" + testAccessKey + "\n"))
+
+	_line := new(api.LogLine)
+	_line.SetBuildID(1)
+	_line.SetLogID(1)
+	_line.SetNumber(2)
+	_line.SetStream(api.LogStreamStdout)
+	_line.SetData("AWS_ACCESS_KEY_ID=" + testAccessKey)
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	err = db.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	err = db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	err = db.CreateLog(context.TODO(), _log)
+	if err != nil {
+		t.Errorf("unable to create test log: %v", err)
+	}
+
+	err = db.CreateLogLines(context.TODO(), []*api.LogLine{_line})
+	if err != nil {
+		t.Errorf("unable to create test log line: %v", err)
+	}
+
+	notifications := []*notification{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := new(notification)
+
+		err := json.NewDecoder(r.Body).Decode(n)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		notifications = append(notifications, n)
+	}))
+	defer server.Close()
+
+	s, err := New(
+		WithDatabase(db),
+		WithRedact(true),
+		WithWebhook(server.URL),
+	)
+	if err != nil {
+		t.Errorf("unable to create scanner: %v", err)
+	}
+
+	// run test
+	got, err := s.Run(now)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("Run returned %d findings, want %d", len(got), 1)
+	}
+
+	if got[0].GetKind() != api.LeakKindAWSAccessKey || got[0].GetLine() != 2 || !got[0].GetRedacted() {
+		t.Errorf("Run finding is %v", got[0])
+	}
+
+	if len(notifications) != 1 || notifications[0].Owner != "octocat" || notifications[0].Repo != "foo/bar" {
+		t.Errorf("Run notifications are %v", notifications)
+	}
+
+	gotLog, err := db.GetLogForStep(context.TODO(), &library.Step{ID: _log.StepID})
+	if err != nil {
+		t.Errorf("unable to get step log: %v", err)
+	}
+
+	if strings.Contains(string(gotLog.GetData()), testAccessKey) {
+		t.Errorf("Run log data is %s", gotLog.GetData())
+	}
+
+	gotLines, _, err := db.ListLinesForLog(context.TODO(), gotLog, 1, 10)
+	if err != nil {
+		t.Errorf("unable to list log lines: %v", err)
+	}
+
+	if len(gotLines) != 1 || gotLines[0].GetData() != "AWS_ACCESS_KEY_ID=[secure]" {
+		t.Errorf("Run log lines are %v", gotLines)
+	}
+
+	redactions, err := db.ListBuildRedactionsForBuild(context.TODO(), _build)
+	if err != nil {
+		t.Errorf("unable to list build redactions: %v", err)
+	}
+
+	if len(redactions) != 1 || redactions[0].GetActor() != actor || redactions[0].GetMatches() != 1 {
+		t.Errorf("Run redactions are %v", redactions)
+	}
+
+	// run test again to ensure the build is not scanned again
+	s.watermark = 0
+
+	got, err = s.Run(now)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("Run returned %d findings, want %d", len(got), 0)
+	}
+}
+
+func TestLeak_Run_Running(t *testing.T) {
+	// setup types
+	s, _ := New()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// run test
+	_, err := s.Run(time.Now())
+	if err != ErrRunning {
+		t.Errorf("Run returned err %v, want %v", err, ErrRunning)
+	}
+}
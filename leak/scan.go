@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package leak
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"sort"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+)
+
+// pattern represents a kind of credential and the
+// expression used to find the credential in a log.
+//
+// When the expression has a capture group, only the
+// first group is considered the leaked credential.
+type pattern struct {
+	kind string
+	expr *regexp.Regexp
+}
+
+// patterns represents the kinds of credentials
+// the logs are scanned for by the scanner.
+var patterns = []pattern{
+	{
+		kind: api.LeakKindAWSAccessKey,
+		expr: regexp.MustCompile(`\b((?:AKIA|ASIA)[0-9A-Z]{16})\b`),
+	},
+	{
+		kind: api.LeakKindAWSSecretKey,
+		expr: regexp.MustCompile(`(?i)aws_secret_access_key["']?\s*[:=]\s*["']?([A-Za-z0-9/+]{40})\b`),
+	},
+	{
+		kind: api.LeakKindJWT,
+		expr: regexp.MustCompile(`\b(eyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,})`),
+	},
+	{
+		kind: api.LeakKindPrivateKey,
+		expr: regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY-----(?s:.*?)-----END (?:[A-Z0-9]+ )*PRIVATE KEY-----`),
+	},
+}
+
+// match represents a leaked credential found in a log.
+type match struct {
+	// specifies the kind of credential
+	kind string
+	// specifies the line number the credential starts on
+	line int64
+	// specifies the leaked credential
+	value []byte
+}
+
+// fingerprint returns the hex encoded SHA-256 hash of the
+// leaked credential, which identifies the credential in
+// a finding without storing the credential itself.
+func (m *match) fingerprint() string {
+	sum := sha256.Sum256(m.value)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// scan is a helper function to find the leaked credentials
+// in the provided data. The matches are returned in the
+// order they appear in the data.
+func scan(data []byte) []*match {
+	type found struct {
+		offset int
+		match  *match
+	}
+
+	results := []found{}
+
+	for _, p := range patterns {
+		for _, loc := range p.expr.FindAllSubmatchIndex(data, -1) {
+			start, end := loc[0], loc[1]
+
+			// use the capture group as the credential when provided
+			if len(loc) > 2 && loc[2] >= 0 {
+				start, end = loc[2], loc[3]
+			}
+
+			results = append(results, found{
+				offset: start,
+				match: &match{
+					kind:  p.kind,
+					line:  int64(bytes.Count(data[:start], []byte("\n")) + 1),
+					value: data[start:end],
+				},
+			})
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].offset < results[j].offset
+	})
+
+	matches := []*match{}
+	for _, r := range results {
+		matches = append(matches, r.match)
+	}
+
+	return matches
+}
+
+// redact is a helper function to replace the leaked credentials in the
+// provided data with the secret mask. A credential spanning multiple
+// lines is also replaced line by line, which covers the structured
+// lines for a log where each line of the credential is stored apart.
+func redact(data []byte, matches []*match) []byte {
+	values := [][]byte{}
+
+	for _, m := range matches {
+		values = append(values, m.value)
+
+		// check if the credential spans multiple lines
+		if !bytes.Contains(m.value, []byte("\n")) {
+			continue
+		}
+
+		for _, line := range bytes.Split(m.value, []byte("\n")) {
+			line = bytes.TrimSpace(line)
+			if len(line) > 0 {
+				values = append(values, line)
+			}
+		}
+	}
+
+	// replace the longest values first so a value containing
+	// another value is not partially replaced
+	sort.SliceStable(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+
+	for _, value := range values {
+		data = bytes.ReplaceAll(data, value, []byte(constants.SecretMask))
+	}
+
+	return data
+}
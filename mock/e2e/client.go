@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// Request sends an HTTP request to the Vela server
+// authenticated as the seeded admin user.
+func (h *Harness) Request(method, path string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, h.URL+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.Token))
+	req.Header.Set("Content-Type", "application/json")
+
+	return http.DefaultClient.Do(req)
+}
+
+// Push sends a push webhook from the fake scm to the
+// Vela server for the provided branch and commit.
+func (h *Harness) Push(branch, commit string) (*http.Response, error) {
+	payload := map[string]interface{}{
+		"ref":   fmt.Sprintf("refs/heads/%s", branch),
+		"after": commit,
+		"head_commit": map[string]interface{}{
+			"id":      commit,
+			"message": "e2e push",
+			"url":     fmt.Sprintf("%s/commit/%s", h.Repo.GetLink(), commit),
+			"author": map[string]interface{}{
+				"login": h.User.GetName(),
+				"email": fmt.Sprintf("%s@example.com", h.User.GetName()),
+			},
+		},
+		"repository": map[string]interface{}{
+			"id":             1,
+			"name":           h.Repo.GetName(),
+			"full_name":      h.Repo.GetFullName(),
+			"owner":          map[string]interface{}{"login": h.Repo.GetOrg()},
+			"html_url":       h.Repo.GetLink(),
+			"clone_url":      h.Repo.GetClone(),
+			"default_branch": h.Repo.GetBranch(),
+		},
+		"sender": map[string]interface{}{
+			"login": h.User.GetName(),
+		},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, h.URL+"/webhook", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-GitHub-Delivery", uuid.NewString())
+	req.Header.Set("X-GitHub-Hook-ID", "1")
+
+	return http.DefaultClient.Do(req)
+}
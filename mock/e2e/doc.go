@@ -0,0 +1,19 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package e2e provides the ability for integrators to run the
+// Vela server against real Postgres and Redis containers,
+// started with dockertest, along with seeded fixtures and a
+// fake source control provider for end-to-end testing.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/mock/e2e"
+//
+//	h, err := e2e.New()
+//	if err != nil {
+//		t.Skipf("unable to start harness: %v", err)
+//	}
+//	defer h.Close()
+package e2e
@@ -0,0 +1,377 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package e2e
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/go-vela/server/compiler/native"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/server/queue"
+	"github.com/go-vela/server/random"
+	"github.com/go-vela/server/router"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/server/secret"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// encryptionKey represents the key used to encrypt
+// values stored in the Postgres container.
+const encryptionKey = "C639A572E14D5075C526FDDD43E4ECF6"
+
+// defaultPipeline represents the pipeline returned
+// by the fake scm when one is not provided.
+const defaultPipeline = `version: "1"
+
+steps:
+  - name: test
+    image: alpine:latest
+    commands:
+      - echo hello
+`
+
+type (
+	// config represents the settings required to create the harness.
+	config struct {
+		// specifies the repository of the Postgres image to run
+		PostgresImage string
+		// specifies the tag of the Postgres image to run
+		PostgresTag string
+		// specifies the repository of the Redis image to run
+		RedisImage string
+		// specifies the tag of the Redis image to run
+		RedisTag string
+		// specifies the pipeline returned by the fake scm
+		Pipeline []byte
+		// specifies the maximum time to wait for the containers
+		Timeout time.Duration
+	}
+
+	// Harness represents a running Vela server backed by
+	// Postgres and Redis containers with seeded fixtures.
+	Harness struct {
+		// address of the running Vela server
+		URL string
+		// address of the fake scm used by the server
+		SCM string
+		// access token for the seeded admin user
+		Token string
+		// seeded admin user
+		User *library.User
+		// seeded repo owned by the admin user
+		Repo *library.Repo
+		// database service connected to the Postgres container
+		Database database.Service
+		// queue service connected to the Redis container
+		Queue queue.Service
+
+		config    *config
+		pool      *dockertest.Pool
+		resources []*dockertest.Resource
+		servers   []*httptest.Server
+	}
+)
+
+// New starts the Postgres and Redis containers, seeds the fixtures
+// and returns a harness with a running Vela server. The returned
+// harness must be closed to remove the containers.
+func New(opts ...Opt) (*Harness, error) {
+	// create new harness
+	h := new(Harness)
+
+	// create new fields
+	h.config = &config{
+		PostgresImage: "postgres",
+		PostgresTag:   "15-alpine",
+		RedisImage:    "redis",
+		RedisTag:      "7-alpine",
+		Pipeline:      []byte(defaultPipeline),
+		Timeout:       2 * time.Minute,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(h)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create the pool for the containers
+	//
+	// https://pkg.go.dev/github.com/ory/dockertest/v3#NewPool
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create docker pool: %w", err)
+	}
+
+	err = pool.Client.Ping()
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to docker: %w", err)
+	}
+
+	pool.MaxWait = h.config.Timeout
+	h.pool = pool
+
+	err = h.start()
+	if err != nil {
+		_ = h.Close()
+
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Close stops the Vela server and removes the containers.
+func (h *Harness) Close() error {
+	for _, s := range h.servers {
+		s.Close()
+	}
+
+	var err error
+
+	for _, r := range h.resources {
+		// https://pkg.go.dev/github.com/ory/dockertest/v3#Pool.Purge
+		e := h.pool.Purge(r)
+		if e != nil {
+			err = fmt.Errorf("unable to remove container %s: %w", r.Container.Name, e)
+		}
+	}
+
+	return err
+}
+
+// start is a helper function to start the containers,
+// seed the fixtures and run the Vela server.
+func (h *Harness) start() error {
+	// start the fake scm
+	_scm := httptest.NewServer(fakeSCM(h))
+	h.servers = append(h.servers, _scm)
+	h.SCM = _scm.URL
+
+	err := h.startDatabase()
+	if err != nil {
+		return err
+	}
+
+	err = h.startQueue()
+	if err != nil {
+		return err
+	}
+
+	return h.startServer()
+}
+
+// startDatabase is a helper function to start the
+// Postgres container and connect the database service.
+func (h *Harness) startDatabase() error {
+	logrus.Debug("starting postgres container for harness")
+
+	r, err := h.run(h.config.PostgresImage, h.config.PostgresTag, "POSTGRES_USER=vela", "POSTGRES_PASSWORD=vela", "POSTGRES_DB=vela")
+	if err != nil {
+		return err
+	}
+
+	address := fmt.Sprintf("postgres://vela:vela@%s/vela?sslmode=disable", r.GetHostPort("5432/tcp"))
+
+	// https://pkg.go.dev/github.com/ory/dockertest/v3#Pool.Retry
+	return h.pool.Retry(func() error {
+		h.Database, err = database.New(&database.Setup{
+			Driver:           constants.DriverPostgres,
+			Address:          address,
+			CompressionLevel: constants.CompressionThree,
+			ConnectionLife:   30 * time.Minute,
+			ConnectionIdle:   2,
+			ConnectionOpen:   0,
+			EncryptionKey:    encryptionKey,
+		})
+		if err != nil {
+			return err
+		}
+
+		// apply the schema migrations to create the tables
+		_, err = h.Database.MigrateUp(context.Background(), 0)
+
+		return err
+	})
+}
+
+// startQueue is a helper function to start the
+// Redis container and connect the queue service.
+func (h *Harness) startQueue() error {
+	logrus.Debug("starting redis container for harness")
+
+	r, err := h.run(h.config.RedisImage, h.config.RedisTag)
+	if err != nil {
+		return err
+	}
+
+	address := fmt.Sprintf("redis://%s", r.GetHostPort("6379/tcp"))
+
+	// https://pkg.go.dev/github.com/ory/dockertest/v3#Pool.Retry
+	return h.pool.Retry(func() error {
+		h.Queue, err = queue.New(&queue.Setup{
+			Driver:  constants.DriverRedis,
+			Address: address,
+			Routes:  []string{constants.DefaultRoute},
+			Timeout: 60 * time.Second,
+		})
+
+		return err
+	})
+}
+
+// startServer is a helper function to seed the
+// fixtures and run the Vela server.
+func (h *Harness) startServer() error {
+	logrus.Debug("starting vela server for harness")
+
+	s := httptest.NewUnstartedServer(nil)
+	h.servers = append(h.servers, s)
+	h.URL = fmt.Sprintf("http://%s", s.Listener.Addr().String())
+
+	source, err := scm.New(&scm.Setup{
+		Driver:        constants.DriverGithub,
+		Address:       h.SCM,
+		ClientID:      "e2e",
+		ClientSecret:  "e2e",
+		ServerAddress: h.URL,
+		StatusContext: "continuous-integration/vela",
+		WebUIAddress:  h.URL,
+		Scopes:        []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+	})
+	if err != nil {
+		return err
+	}
+
+	secrets, err := secret.New(&secret.Setup{
+		Driver:   constants.DriverNative,
+		Database: h.Database,
+	})
+	if err != nil {
+		return err
+	}
+
+	// create the compiler with the default settings
+	set := flag.NewFlagSet("e2e", flag.ContinueOnError)
+	set.String("clone-image", "target/vela-git:v0.7.0", "doc")
+
+	compiler, err := native.New(cli.NewContext(nil, set, nil))
+	if err != nil {
+		return err
+	}
+
+	// generate the keys used to sign tokens for the server
+	privateKey, err := random.GenerateRandomString(32)
+	if err != nil {
+		return err
+	}
+
+	workerSecret, err := random.GenerateRandomString(32)
+	if err != nil {
+		return err
+	}
+
+	tm := &token.Manager{
+		PrivateKey:                  privateKey,
+		SignMethod:                  jwt.SigningMethodHS256,
+		UserAccessTokenDuration:     time.Hour,
+		UserRefreshTokenDuration:    8 * time.Hour,
+		BuildTokenBufferDuration:    5 * time.Minute,
+		WorkerAuthTokenDuration:     20 * time.Minute,
+		WorkerRegisterTokenDuration: time.Minute,
+	}
+
+	err = h.seed(tm)
+	if err != nil {
+		return err
+	}
+
+	scmURL, err := url.Parse(h.SCM)
+	if err != nil {
+		return err
+	}
+
+	metadata := &types.Metadata{
+		Database: &types.Database{Driver: constants.DriverPostgres, Host: "postgres"},
+		Queue:    &types.Queue{Driver: constants.DriverRedis, Host: "redis"},
+		Source:   &types.Source{Driver: constants.DriverGithub, Host: scmURL.Host},
+		Vela: &types.Vela{
+			Address:              h.URL,
+			WebAddress:           h.URL,
+			AccessTokenDuration:  time.Hour,
+			RefreshTokenDuration: 8 * time.Hour,
+		},
+	}
+
+	s.Config.Handler = router.Load(
+		middleware.Compiler(compiler),
+		middleware.Database(h.Database),
+		middleware.Logger(logrus.StandardLogger(), time.RFC3339),
+		middleware.Metadata(metadata),
+		middleware.TokenManager(tm),
+		middleware.Queue(h.Queue),
+		middleware.RequestVersion,
+		middleware.Secret(workerSecret),
+		middleware.Secrets(map[string]secret.Service{constants.DriverNative: secrets}),
+		middleware.Scm(source),
+		middleware.Allowlist([]string{"*"}),
+		middleware.DefaultBuildLimit(constants.BuildLimitDefault),
+		middleware.DefaultTimeout(constants.BuildTimeoutDefault),
+		middleware.MaxBuildLimit(constants.BuildLimitMax),
+		middleware.WebhookValidation(false),
+		middleware.SecureCookie(false),
+		middleware.Worker(5*time.Minute),
+		middleware.DefaultRepoEvents([]string{constants.EventPush}),
+	)
+
+	logrus.Infof("running harness server on %s", h.URL)
+	s.Start()
+
+	return nil
+}
+
+// run is a helper function to run a container for
+// the provided image that is removed when stopped.
+func (h *Harness) run(repository, tag string, env ...string) (*dockertest.Resource, error) {
+	// https://pkg.go.dev/github.com/ory/dockertest/v3#Pool.RunWithOptions
+	r, err := h.pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: repository,
+		Tag:        tag,
+		Env:        env,
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{Name: "no"}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to run container for %s:%s: %w", repository, tag, err)
+	}
+
+	h.resources = append(h.resources, r)
+
+	// ensure the container is removed if the harness is never closed
+	err = r.Expire(uint(h.config.Timeout.Seconds()) + 600)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package e2e
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestE2E_New(t *testing.T) {
+	// setup types
+	h, err := New(WithTimeout(time.Minute))
+	if err != nil {
+		t.Skipf("unable to start harness: %v", err)
+	}
+
+	defer h.Close()
+
+	// run test
+	resp, err := h.Request(http.MethodGet, "/api/v1/repos/github/octocat", nil)
+	if err != nil {
+		t.Fatalf("Request returned err: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Request returned %v, want %v", resp.StatusCode, http.StatusOK)
+	}
+
+	got := new(library.Repo)
+
+	err = json.NewDecoder(resp.Body).Decode(got)
+	if err != nil {
+		t.Errorf("unable to decode repo: %v", err)
+	}
+
+	if got.GetFullName() != h.Repo.GetFullName() {
+		t.Errorf("Request returned repo %s, want %s", got.GetFullName(), h.Repo.GetFullName())
+	}
+
+	push, err := h.Push("main", "48afb5bdc41ad69bf22588491333f7cf71135163")
+	if err != nil {
+		t.Fatalf("Push returned err: %v", err)
+	}
+	defer push.Body.Close()
+
+	if push.StatusCode != http.StatusOK {
+		t.Errorf("Push returned %v, want %v", push.StatusCode, http.StatusOK)
+	}
+}
+
+func TestE2E_New_Options(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		opt  Opt
+	}{
+		{
+			name: "empty pipeline",
+			opt:  WithPipeline(nil),
+		},
+		{
+			name: "empty postgres image",
+			opt:  WithPostgresImage("", "15-alpine"),
+		},
+		{
+			name: "empty redis image",
+			opt:  WithRedisImage("redis", ""),
+		},
+		{
+			name: "invalid timeout",
+			opt:  WithTimeout(0),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(test.opt)
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+		})
+	}
+}
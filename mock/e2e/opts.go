@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package e2e
+
+import (
+	"fmt"
+	"time"
+)
+
+// Opt represents a configuration option to initialize the harness.
+type Opt func(*Harness) error
+
+// WithPipeline sets the pipeline returned by the fake scm in the harness.
+func WithPipeline(pipeline []byte) Opt {
+	return func(h *Harness) error {
+		// check if the pipeline provided is empty
+		if len(pipeline) == 0 {
+			return fmt.Errorf("no harness pipeline provided")
+		}
+
+		// set the pipeline in the harness
+		h.config.Pipeline = pipeline
+
+		return nil
+	}
+}
+
+// WithPostgresImage sets the Postgres image to run in the harness.
+func WithPostgresImage(repository, tag string) Opt {
+	return func(h *Harness) error {
+		// check if the image provided is empty
+		if len(repository) == 0 || len(tag) == 0 {
+			return fmt.Errorf("no harness postgres image provided")
+		}
+
+		// set the Postgres image in the harness
+		h.config.PostgresImage = repository
+		h.config.PostgresTag = tag
+
+		return nil
+	}
+}
+
+// WithRedisImage sets the Redis image to run in the harness.
+func WithRedisImage(repository, tag string) Opt {
+	return func(h *Harness) error {
+		// check if the image provided is empty
+		if len(repository) == 0 || len(tag) == 0 {
+			return fmt.Errorf("no harness redis image provided")
+		}
+
+		// set the Redis image in the harness
+		h.config.RedisImage = repository
+		h.config.RedisTag = tag
+
+		return nil
+	}
+}
+
+// WithTimeout sets the maximum time to wait for the containers in the harness.
+func WithTimeout(timeout time.Duration) Opt {
+	return func(h *Harness) error {
+		// check if the timeout provided is positive
+		if timeout <= 0 {
+			return fmt.Errorf("invalid harness timeout provided: %s", timeout)
+		}
+
+		// set the timeout in the harness
+		h.config.Timeout = timeout
+
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package e2e
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fakeSCM returns an http.Handler that responds to the subset of
+// the GitHub API used by the server while processing builds.
+func fakeSCM(h *Harness) http.Handler {
+	gin.SetMode(gin.TestMode)
+
+	e := gin.New()
+
+	// fake endpoints for user calls
+	e.GET("/api/v3/user", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": 1, "login": h.User.GetName()})
+	})
+
+	// fake endpoints for access calls
+	e.GET("/api/v3/orgs/:org/memberships/:user", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"role": "admin", "state": "active"})
+	})
+	e.GET("/api/v3/repos/:org/:repo/collaborators/:user/permission", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"permission": "admin"})
+	})
+
+	// fake endpoints for repo calls
+	e.GET("/api/v3/repos/:org/:repo", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"id":             1,
+			"name":           c.Param("repo"),
+			"full_name":      fmt.Sprintf("%s/%s", c.Param("org"), c.Param("repo")),
+			"owner":          gin.H{"login": c.Param("org")},
+			"html_url":       fmt.Sprintf("%s/%s/%s", h.SCM, c.Param("org"), c.Param("repo")),
+			"clone_url":      fmt.Sprintf("%s/%s/%s.git", h.SCM, c.Param("org"), c.Param("repo")),
+			"default_branch": "main",
+			"permissions":    gin.H{"admin": true, "push": true, "pull": true},
+		})
+	})
+	e.GET("/api/v3/repos/:org/:repo/contents/*path", func(c *gin.Context) {
+		// only the default pipeline file is available
+		if c.Param("path") != "/.vela.yml" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "Not Found"})
+
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"type":     "file",
+			"encoding": "base64",
+			"name":     ".vela.yml",
+			"path":     ".vela.yml",
+			"content":  base64.StdEncoding.EncodeToString(h.config.Pipeline),
+		})
+	})
+	e.GET("/api/v3/repos/:org/:repo/commits/:sha", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"sha":   c.Param("sha"),
+			"files": []gin.H{{"filename": ".vela.yml"}},
+		})
+	})
+	e.POST("/api/v3/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"state": "pending"})
+	})
+
+	// fake endpoints for hook calls
+	e.GET("/api/v3/repos/:org/:repo/hooks", func(c *gin.Context) {
+		c.JSON(http.StatusOK, []gin.H{})
+	})
+	e.POST("/api/v3/repos/:org/:repo/hooks", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": 1, "active": true})
+	})
+	e.PATCH("/api/v3/repos/:org/:repo/hooks/:hook", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": 1, "active": true})
+	})
+	e.DELETE("/api/v3/repos/:org/:repo/hooks/:hook", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	return e
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-vela/server/internal/token"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+
+	"github.com/google/uuid"
+)
+
+// seed is a helper function to create the fixtures in
+// the database and mint an access token for the user.
+func (h *Harness) seed(tm *token.Manager) error {
+	// seed the admin user
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("e2e")
+	u.SetHash(uuid.NewString())
+	u.SetActive(true)
+	u.SetAdmin(true)
+
+	// send API call to create the user
+	err := h.Database.CreateUser(context.TODO(), u)
+	if err != nil {
+		return fmt.Errorf("unable to seed user %s: %w", u.GetName(), err)
+	}
+
+	// send API call to capture the created user
+	h.User, err = h.Database.GetUserForName(context.TODO(), u.GetName())
+	if err != nil {
+		return fmt.Errorf("unable to get user %s: %w", u.GetName(), err)
+	}
+
+	// seed the repo owned by the admin user
+	r := new(library.Repo)
+	r.SetUserID(h.User.GetID())
+	r.SetHash(uuid.NewString())
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetLink(fmt.Sprintf("%s/github/octocat", h.SCM))
+	r.SetClone(fmt.Sprintf("%s/github/octocat.git", h.SCM))
+	r.SetBranch("main")
+	r.SetBuildLimit(constants.BuildLimitDefault)
+	r.SetTimeout(constants.BuildTimeoutDefault)
+	r.SetVisibility(constants.VisibilityPublic)
+	r.SetPipelineType(constants.PipelineTypeYAML)
+	r.SetActive(true)
+	r.SetAllowPush(true)
+	r.SetAllowPull(true)
+
+	// send API call to create the repo
+	err = h.Database.CreateRepo(context.TODO(), r)
+	if err != nil {
+		return fmt.Errorf("unable to seed repo %s: %w", r.GetFullName(), err)
+	}
+
+	// send API call to capture the created repo
+	h.Repo, err = h.Database.GetRepoForOrg(context.TODO(), r.GetOrg(), r.GetName())
+	if err != nil {
+		return fmt.Errorf("unable to get repo %s: %w", r.GetFullName(), err)
+	}
+
+	// mint an access token for the admin user
+	h.Token, err = tm.MintToken(&token.MintTokenOpts{
+		User:          h.User,
+		TokenType:     constants.UserAccessTokenType,
+		TokenDuration: tm.UserAccessTokenDuration,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to mint token for user %s: %w", h.User.GetName(), err)
+	}
+
+	return nil
+}
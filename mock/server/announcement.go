@@ -0,0 +1,154 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// AnnouncementResp represents a JSON return for a single announcement.
+	AnnouncementResp = `{
+  "id": 1,
+  "kind": "maintenance",
+  "title": "database upgrade",
+  "message": "builds will be paused during the upgrade",
+  "orgs": ["github"],
+  "starts": 1563474076,
+  "ends": 1563477676,
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// AnnouncementsResp represents a JSON return for one to many announcements.
+	AnnouncementsResp = `[
+  {
+    "id": 1,
+    "kind": "maintenance",
+    "title": "database upgrade",
+    "message": "builds will be paused during the upgrade",
+    "orgs": ["github"],
+    "starts": 1563474076,
+    "ends": 1563477676,
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "kind": "info",
+    "title": "welcome",
+    "message": "read the docs to get started",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+)
+
+// getAnnouncements returns mock JSON for a http GET.
+func getAnnouncements(c *gin.Context) {
+	data := []byte(AnnouncementsResp)
+
+	var body []api.Announcement
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getAnnouncement has a param :announcement returns mock JSON for a http GET.
+//
+// Pass "0" to :announcement to test receiving a http 404 response.
+func getAnnouncement(c *gin.Context) {
+	a := c.Param("announcement")
+
+	if strings.EqualFold(a, "0") {
+		msg := fmt.Sprintf("Announcement %s does not exist", a)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(AnnouncementResp)
+
+	var body api.Announcement
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addAnnouncement returns mock JSON for a http POST.
+func addAnnouncement(c *gin.Context) {
+	data := []byte(AnnouncementResp)
+
+	var body api.Announcement
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateAnnouncement has a param :announcement returns mock JSON for a http PUT.
+//
+// Pass "0" to :announcement to test receiving a http 404 response.
+func updateAnnouncement(c *gin.Context) {
+	a := c.Param("announcement")
+
+	if strings.EqualFold(a, "0") {
+		msg := fmt.Sprintf("Announcement %s does not exist", a)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(AnnouncementResp)
+
+	var body api.Announcement
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeAnnouncement has a param :announcement returns mock JSON for a http DELETE.
+//
+// Pass "0" to :announcement to test receiving a http 404 response.
+func removeAnnouncement(c *gin.Context) {
+	a := c.Param("announcement")
+
+	if strings.EqualFold(a, "0") {
+		msg := fmt.Sprintf("Announcement %s does not exist", a)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("announcement %s deleted", a))
+}
+
+// getUserAnnouncements returns mock JSON for a http GET.
+func getUserAnnouncements(c *gin.Context) {
+	data := []byte(AnnouncementsResp)
+
+	var body []api.Announcement
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+// AnomaliesResp represents a JSON return for one to many anomalies.
+const AnomaliesResp = `[
+  {
+    "repo_id": 1,
+    "repo": "github/octocat",
+    "kind": "duration",
+    "baseline": 60,
+    "recent": 120,
+    "score": 10,
+    "samples": 5,
+    "detected": 1563474077
+  },
+  {
+    "repo_id": 2,
+    "repo": "github/hello-world",
+    "kind": "failure_rate",
+    "baseline": 0.05,
+    "recent": 0.83,
+    "score": 8.72,
+    "samples": 6,
+    "detected": 1563474077
+  }
+]`
+
+// getAnomalies returns mock JSON for a http GET.
+func getAnomalies(c *gin.Context) {
+	data := []byte(AnomaliesResp)
+
+	var body []api.Anomaly
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
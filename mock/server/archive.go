@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// getArchivedRepos returns mock JSON for a http GET.
+func getArchivedRepos(c *gin.Context) {
+	getRepos(c)
+}
+
+// restoreArchivedRepo has a param :repo returns mock JSON for a http POST.
+//
+// Pass "not-found" to :repo to test receiving a http 404 response.
+func restoreArchivedRepo(c *gin.Context) {
+	getRepo(c)
+}
+
+// purgeArchivedRepo has a param :repo returns mock JSON for a http DELETE.
+//
+// Pass "not-found" to :repo to test receiving a http 404 response.
+func purgeArchivedRepo(c *gin.Context) {
+	o := c.Param("org")
+	r := c.Param("repo")
+
+	if strings.Contains(r, "not-found") {
+		msg := fmt.Sprintf("Archived repo %s/%s does not exist", o, r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("archived repo %s/%s purged along with 2 builds", o, r))
+}
+
+// getArchivedSecrets returns mock JSON for a http GET.
+func getArchivedSecrets(c *gin.Context) {
+	getSecrets(c)
+}
+
+// restoreArchivedSecret has a param :secret returns mock JSON for a http POST.
+//
+// Pass "not-found" to :secret to test receiving a http 404 response.
+func restoreArchivedSecret(c *gin.Context) {
+	s := c.Param("secret")
+
+	if strings.Contains(s, "not-found") {
+		msg := fmt.Sprintf("Archived secret %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(SecretResp)
+
+	var body library.Secret
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// purgeArchivedSecret has a param :secret returns mock JSON for a http DELETE.
+//
+// Pass "not-found" to :secret to test receiving a http 404 response.
+func purgeArchivedSecret(c *gin.Context) {
+	t := c.Param("type")
+	o := c.Param("org")
+	n := c.Param("name")
+	s := c.Param("secret")
+
+	if strings.Contains(s, "not-found") {
+		msg := fmt.Sprintf("Archived secret %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("archived %s secret %s/%s/%s purged", t, o, n, s))
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// StepAttemptResp represents a JSON return for a single attempt of a step.
+	StepAttemptResp = `{
+  "id": 2,
+  "step_id": 1,
+  "build_id": 1,
+  "repo_id": 1,
+  "attempt": 2,
+  "status": "success",
+  "started": 1563474085,
+  "finished": 1563474090
+}`
+
+	// StepAttemptsResp represents a JSON return for the attempts of a step.
+	StepAttemptsResp = `[
+  {
+    "id": 1,
+    "step_id": 1,
+    "build_id": 1,
+    "repo_id": 1,
+    "attempt": 1,
+    "status": "failure",
+    "error": "exit code 1",
+    "exit_code": 1,
+    "started": 1563474077,
+    "finished": 1563474083
+  },
+  {
+    "id": 2,
+    "step_id": 1,
+    "build_id": 1,
+    "repo_id": 1,
+    "attempt": 2,
+    "status": "success",
+    "started": 1563474085,
+    "finished": 1563474090
+  }
+]`
+)
+
+// getStepAttempts returns mock JSON for a http GET.
+func getStepAttempts(c *gin.Context) {
+	data := []byte(StepAttemptsResp)
+
+	var body []api.StepAttempt
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addStepAttempt has a param :step returns mock JSON for a http POST.
+//
+// Pass "0" to :step to test receiving a http 404 response.
+func addStepAttempt(c *gin.Context) {
+	s := c.Param("step")
+
+	if strings.EqualFold(s, "0") {
+		msg := fmt.Sprintf("Step %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(StepAttemptResp)
+
+	var body api.StepAttempt
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
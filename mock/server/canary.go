@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+// CanaryRunsResp represents a JSON return for one to many canary runs.
+const CanaryRunsResp = `[
+  {
+    "id": 2,
+    "route": "compliance",
+    "worker_group": "compliance",
+    "repo_id": 1,
+    "build_id": 2,
+    "status": "timeout",
+    "error": "canary build did not finish within 30m0s",
+    "created": 1563474077,
+    "finished": 1563475877
+  },
+  {
+    "id": 1,
+    "route": "vela",
+    "repo_id": 1,
+    "build_id": 1,
+    "status": "success",
+    "created": 1563474077,
+    "finished": 1563474090
+  }
+]`
+
+// getCanaryRuns returns mock JSON for a http GET.
+func getCanaryRuns(c *gin.Context) {
+	data := []byte(CanaryRunsResp)
+
+	var body []api.CanaryRun
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
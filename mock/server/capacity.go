@@ -0,0 +1,46 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+// CapacitySnapshotsResp represents a JSON return for one to many capacity snapshots.
+const CapacitySnapshotsResp = `[
+  {
+    "id": 1,
+    "active_workers": 3,
+    "inactive_workers": 1,
+    "build_limit": 6,
+    "running": 3,
+    "pending": 2,
+    "utilization": 0.5,
+    "created": 1563474077
+  },
+  {
+    "id": 2,
+    "active_workers": 3,
+    "build_limit": 6,
+    "running": 6,
+    "pending": 4,
+    "utilization": 1,
+    "created": 1563474137
+  }
+]`
+
+// getCapacitySnapshots returns mock JSON for a http GET.
+func getCapacitySnapshots(c *gin.Context) {
+	data := []byte(CapacitySnapshotsResp)
+
+	var body []api.CapacitySnapshot
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+const (
+	// CatalogComponentResp represents a JSON return for a single catalog component.
+	CatalogComponentResp = `{
+  "org": "github",
+  "repo": "octocat",
+  "full_name": "github/octocat",
+  "owner": "group:platform",
+  "link": "https://github.com/github/octocat",
+  "branch": "master",
+  "visibility": "public",
+  "active": true,
+  "build_number": 2,
+  "build_status": "success",
+  "build_commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+  "build_event": "deployment",
+  "build_finished": 1563474086,
+  "build_link": "https://example.company.com/github/octocat/2",
+  "deploys": [
+    {
+      "environment": "production",
+      "commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+      "ref": "refs/tags/v1.0.0",
+      "version": "v1.0.0",
+      "actor": "OctoKitty",
+      "build": 2,
+      "deployed": 1563474086,
+      "link": "https://example.company.com/github/octocat/2"
+    }
+  ]
+}`
+
+	// CatalogComponentsResp represents a JSON return for one to many catalog components.
+	CatalogComponentsResp = `[
+  {
+    "org": "github",
+    "repo": "octocat",
+    "full_name": "github/octocat",
+    "owner": "group:platform",
+    "link": "https://github.com/github/octocat",
+    "branch": "master",
+    "visibility": "public",
+    "active": true,
+    "build_number": 2,
+    "build_status": "success",
+    "build_commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+    "build_event": "deployment",
+    "build_finished": 1563474086,
+    "build_link": "https://example.company.com/github/octocat/2"
+  },
+  {
+    "org": "github",
+    "repo": "hello-world",
+    "full_name": "github/hello-world",
+    "owner": "github",
+    "link": "https://github.com/github/hello-world",
+    "branch": "master",
+    "visibility": "public",
+    "active": true
+  }
+]`
+)
+
+// getCatalogComponents returns mock JSON for a http GET.
+func getCatalogComponents(c *gin.Context) {
+	data := []byte(CatalogComponentsResp)
+
+	var body []api.CatalogComponent
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getCatalogComponent has a param :repo returns mock JSON for a http GET.
+func getCatalogComponent(c *gin.Context) {
+	data := []byte(CatalogComponentResp)
+
+	var body api.CatalogComponent
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// pushCatalog returns mock JSON for a http POST.
+func pushCatalog(c *gin.Context) {
+	c.JSON(http.StatusOK, "2 components pushed to the service catalog")
+}
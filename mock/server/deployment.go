@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/library"
 )
@@ -54,6 +55,30 @@ const (
     "description": "Deployment request from Vela"
   }
 ]`
+
+	// DeployEnvironmentsResp represents a JSON return for the last deployment to each environment.
+	DeployEnvironmentsResp = `[
+  {
+    "environment": "production",
+    "commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+    "ref": "refs/tags/v1.0.0",
+    "version": "v1.0.0",
+    "actor": "OctoKitty",
+    "build": 2,
+    "deployed": 1563474086,
+    "link": "https://example.company.com/github/octocat/2"
+  },
+  {
+    "environment": "staging",
+    "commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+    "ref": "refs/heads/master",
+    "version": "48afb5b",
+    "actor": "OctoKitty",
+    "build": 1,
+    "deployed": 1563474078,
+    "link": "https://example.company.com/github/octocat/1"
+  }
+]`
 )
 
 // getDeployments returns mock JSON for a http GET.
@@ -66,6 +91,16 @@ func getDeployments(c *gin.Context) {
 	c.JSON(http.StatusOK, body)
 }
 
+// getDeployEnvironments returns mock JSON for a http GET.
+func getDeployEnvironments(c *gin.Context) {
+	data := []byte(DeployEnvironmentsResp)
+
+	var body []api.EnvironmentDeploy
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
 // getDeployment has a param :deployment returns mock JSON for a http GET.
 func getDeployment(c *gin.Context) {
 	d := c.Param("deployment")
@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// DeprecationRuleResp represents a JSON return for a single deprecation rule.
+	DeprecationRuleResp = `{
+  "id": 1,
+  "kind": "image",
+  "pattern": "golang:1.1*",
+  "message": "upgrade to golang:1.21",
+  "sunset": 1704067200,
+  "comment": true,
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// DeprecationRulesResp represents a JSON return for one to many deprecation rules.
+	DeprecationRulesResp = `[
+  {
+    "id": 1,
+    "kind": "image",
+    "pattern": "golang:1.1*",
+    "message": "upgrade to golang:1.21",
+    "sunset": 1704067200,
+    "comment": true,
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "kind": "syntax",
+    "pattern": "steps.ruleset.branch",
+    "message": "use steps.ruleset.if.branch",
+    "sunset": 0,
+    "comment": false,
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+)
+
+// getDeprecationRules returns mock JSON for a http GET.
+func getDeprecationRules(c *gin.Context) {
+	data := []byte(DeprecationRulesResp)
+
+	var body []api.DeprecationRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getDeprecationRule has a param :deprecation returns mock JSON for a http GET.
+//
+// Pass "0" to :deprecation to test receiving a http 404 response.
+func getDeprecationRule(c *gin.Context) {
+	d := c.Param("deprecation")
+
+	if strings.EqualFold(d, "0") {
+		msg := fmt.Sprintf("Deprecation rule %s does not exist", d)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(DeprecationRuleResp)
+
+	var body api.DeprecationRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addDeprecationRule returns mock JSON for a http POST.
+func addDeprecationRule(c *gin.Context) {
+	data := []byte(DeprecationRuleResp)
+
+	var body api.DeprecationRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateDeprecationRule has a param :deprecation returns mock JSON for a http PUT.
+//
+// Pass "0" to :deprecation to test receiving a http 404 response.
+func updateDeprecationRule(c *gin.Context) {
+	d := c.Param("deprecation")
+
+	if strings.EqualFold(d, "0") {
+		msg := fmt.Sprintf("Deprecation rule %s does not exist", d)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(DeprecationRuleResp)
+
+	var body api.DeprecationRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeDeprecationRule has a param :deprecation returns mock JSON for a http DELETE.
+//
+// Pass "0" to :deprecation to test receiving a http 404 response.
+func removeDeprecationRule(c *gin.Context) {
+	d := c.Param("deprecation")
+
+	if strings.EqualFold(d, "0") {
+		msg := fmt.Sprintf("Deprecation rule %s does not exist", d)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("deprecation rule %s deleted", d))
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DiagnosticsProfileResp represents a text return for capturing a profile.
+const DiagnosticsProfileResp = `heap profile: 1: 1024 [1: 1024] @ heap/1048576
+1: 1024 [1: 1024] @ 0x1 0x2 0x3
+`
+
+// getDiagnosticsProfile has a param :profile returns mock text for a http GET.
+func getDiagnosticsProfile(c *gin.Context) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", c.Param("profile")+".txt"))
+	c.Data(http.StatusOK, "text/plain; charset=utf-8", []byte(DiagnosticsProfileResp))
+}
+
+// getDiagnosticsBundle returns a mock zip archive for a http GET.
+func getDiagnosticsBundle(c *gin.Context) {
+	// an empty zip archive with only the end of central directory record
+	data := append([]byte("PK\x05\x06"), make([]byte, 18)...)
+
+	c.Header("Content-Disposition", `attachment; filename="vela-diagnostics.zip"`)
+	c.Data(http.StatusOK, "application/zip", data)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// EgressRuleResp represents a JSON return for a single egress rule.
+	EgressRuleResp = `{
+  "id": 1,
+  "org": "github",
+  "action": "allow",
+  "destination": "*.github.com:443",
+  "description": "GitHub API",
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// EgressRulesResp represents a JSON return for one to many egress rules.
+	EgressRulesResp = `[
+  {
+    "id": 1,
+    "org": "github",
+    "action": "allow",
+    "destination": "*.github.com:443",
+    "description": "GitHub API",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "org": "github",
+    "action": "deny",
+    "destination": "10.0.0.0/8",
+    "description": "internal network",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+)
+
+// getEgressRules returns mock JSON for a http GET.
+func getEgressRules(c *gin.Context) {
+	data := []byte(EgressRulesResp)
+
+	var body []api.EgressRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getEgressRule has a param :rule returns mock JSON for a http GET.
+//
+// Pass "0" to :rule to test receiving a http 404 response.
+func getEgressRule(c *gin.Context) {
+	r := c.Param("rule")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Egress rule %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(EgressRuleResp)
+
+	var body api.EgressRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addEgressRule returns mock JSON for a http POST.
+func addEgressRule(c *gin.Context) {
+	data := []byte(EgressRuleResp)
+
+	var body api.EgressRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateEgressRule has a param :rule returns mock JSON for a http PUT.
+//
+// Pass "0" to :rule to test receiving a http 404 response.
+func updateEgressRule(c *gin.Context) {
+	r := c.Param("rule")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Egress rule %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(EgressRuleResp)
+
+	var body api.EgressRule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeEgressRule has a param :rule returns mock JSON for a http DELETE.
+//
+// Pass "0" to :rule to test receiving a http 404 response.
+func removeEgressRule(c *gin.Context) {
+	r := c.Param("rule")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Egress rule %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("egress rule %s deleted for org %s", r, c.Param("org")))
+}
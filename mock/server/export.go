@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+// ExportsResp represents a JSON return for one to many exports.
+const ExportsResp = `[
+  {
+    "id": 1,
+    "dataset": "builds",
+    "format": "jsonl",
+    "bucket": "vela-exports",
+    "object": "vela/builds/date=2019-07-18/builds-0-1563477600.jsonl",
+    "records": 10,
+    "since": 0,
+    "watermark": 1563477600,
+    "created": 1563477660
+  },
+  {
+    "id": 2,
+    "dataset": "steps",
+    "format": "jsonl",
+    "bucket": "vela-exports",
+    "object": "vela/steps/date=2019-07-18/steps-0-1563477600.jsonl",
+    "records": 40,
+    "since": 0,
+    "watermark": 1563477600,
+    "created": 1563477660
+  }
+]`
+
+// getExports returns mock JSON for a http GET.
+func getExports(c *gin.Context) {
+	data := []byte(ExportsResp)
+
+	var body []api.Export
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// FreezeResp represents a JSON return for a single freeze.
+	FreezeResp = `{
+  "id": 1,
+  "org": "github",
+  "name": "holiday",
+  "reason": "end of year change freeze",
+  "scope": "deploy",
+  "starts": 1563474076,
+  "ends": 1563560476,
+  "exempt_repos": ["github/octocat"],
+  "exempt_events": ["pull_request"],
+  "created_at": 1563474000,
+  "created_by": "octocat"
+}`
+
+	// FreezesResp represents a JSON return for one to many freezes.
+	FreezesResp = `[
+  {
+    "id": 2,
+    "org": "github",
+    "name": "release",
+    "reason": "release week",
+    "scope": "all",
+    "starts": 1563560476,
+    "ends": 1563646876,
+    "created_at": 1563474000,
+    "created_by": "octocat"
+  },
+  {
+    "id": 1,
+    "org": "github",
+    "name": "holiday",
+    "reason": "end of year change freeze",
+    "scope": "deploy",
+    "starts": 1563474076,
+    "ends": 1563560476,
+    "exempt_repos": ["github/octocat"],
+    "exempt_events": ["pull_request"],
+    "created_at": 1563474000,
+    "created_by": "octocat"
+  }
+]`
+
+	// FreezeAuditsResp represents a JSON return for one to many freeze audits.
+	FreezeAuditsResp = `[
+  {
+    "id": 1,
+    "freeze_id": 1,
+    "org": "github",
+    "action": "created",
+    "actor": "octocat",
+    "message": "freeze holiday created",
+    "created": 1563474000
+  },
+  {
+    "id": 2,
+    "freeze_id": 1,
+    "org": "github",
+    "action": "overridden",
+    "actor": "octocat",
+    "repo": "github/octocat",
+    "event": "deployment",
+    "message": "deployment build for commit 48afb5bdc41ad69bf22588491333f7cf71135163 overrode freeze holiday",
+    "ip": "1.2.3.4",
+    "user_agent": "vela-cli",
+    "client": "Vela CLI",
+    "location": "Minneapolis, Minnesota, US",
+    "created": 1563474076
+  }
+]`
+)
+
+// getFreezes returns mock JSON for a http GET.
+func getFreezes(c *gin.Context) {
+	data := []byte(FreezesResp)
+
+	var body []api.Freeze
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getFreeze has a param :freeze returns mock JSON for a http GET.
+//
+// Pass "0" to :freeze to test receiving a http 404 response.
+func getFreeze(c *gin.Context) {
+	f := c.Param("freeze")
+
+	if strings.EqualFold(f, "0") {
+		msg := fmt.Sprintf("Freeze %s does not exist", f)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(FreezeResp)
+
+	var body api.Freeze
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getFreezeAudits has a param :freeze returns mock JSON for a http GET.
+//
+// Pass "0" to :freeze to test receiving a http 404 response.
+func getFreezeAudits(c *gin.Context) {
+	f := c.Param("freeze")
+
+	if strings.EqualFold(f, "0") {
+		msg := fmt.Sprintf("Freeze %s does not exist", f)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(FreezeAuditsResp)
+
+	var body []api.FreezeAudit
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addFreeze returns mock JSON for a http POST.
+func addFreeze(c *gin.Context) {
+	data := []byte(FreezeResp)
+
+	var body api.Freeze
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// removeFreeze has a param :freeze returns mock JSON for a http DELETE.
+//
+// Pass "0" to :freeze to test receiving a http 404 response.
+func removeFreeze(c *gin.Context) {
+	f := c.Param("freeze")
+
+	if strings.EqualFold(f, "0") {
+		msg := fmt.Sprintf("Freeze %s does not exist", f)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("freeze %s deleted", f))
+}
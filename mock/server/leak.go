@@ -0,0 +1,49 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+// LeakFindingsResp represents a JSON return for one to many leak findings.
+const LeakFindingsResp = `[
+  {
+    "id": 2,
+    "repo_id": 1,
+    "build_id": 1,
+    "log_id": 2,
+    "kind": "jwt",
+    "line": 7,
+    "fingerprint": "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752",
+    "redacted": true,
+    "created": 1563474077
+  },
+  {
+    "id": 1,
+    "repo_id": 1,
+    "build_id": 1,
+    "log_id": 1,
+    "kind": "aws_access_key",
+    "line": 3,
+    "fingerprint": "1a5d44a2dca19669d72edf4c4f1c27c4c1ca4b4408fbb17f6ce4ad452d78ddb3",
+    "redacted": true,
+    "created": 1563474077
+  }
+]`
+
+// getLeakFindings returns mock JSON for a http GET.
+func getLeakFindings(c *gin.Context) {
+	data := []byte(LeakFindingsResp)
+
+	var body []api.LeakFinding
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+// LeasesResp represents a JSON return for one to many leases.
+const LeasesResp = `[
+  {
+    "id": 1,
+    "name": "canary",
+    "holder": "vela-server-0",
+    "state": "1563474076",
+    "acquired": 1563474076,
+    "renewed": 1563474086,
+    "expires": 1563474116,
+    "changes": 1
+  },
+  {
+    "id": 2,
+    "name": "janitor",
+    "holder": "vela-server-1",
+    "acquired": 1563474046,
+    "renewed": 1563474086,
+    "expires": 1563474116,
+    "changes": 3
+  }
+]`
+
+// getLeases returns mock JSON for a http GET.
+func getLeases(c *gin.Context) {
+	data := []byte(LeasesResp)
+
+	var body []api.Lease
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/library"
 )
@@ -25,6 +26,28 @@ const (
   "step_id": 1,
   "data": "SGVsbG8sIFdvcmxkIQ=="
 }`
+
+	// LogLinesResp represents a JSON return for one to many log lines.
+	LogLinesResp = `[
+  {
+    "id": 2,
+    "build_id": 1,
+    "log_id": 1,
+    "number": 2,
+    "stream": "stderr",
+    "timestamp": 1563474078000,
+    "data": "World!"
+  },
+  {
+    "id": 1,
+    "build_id": 1,
+    "log_id": 1,
+    "number": 1,
+    "stream": "stdout",
+    "timestamp": 1563474077000,
+    "data": "Hello,"
+  }
+]`
 )
 
 // getServiceLog has a param :service returns mock JSON for a http GET.
@@ -168,3 +191,23 @@ func removeStepLog(c *gin.Context) {
 
 	c.JSON(http.StatusOK, fmt.Sprintf("Log %s removed", s))
 }
+
+// getLogLines returns mock JSON for a http GET.
+func getLogLines(c *gin.Context) {
+	data := []byte(LogLinesResp)
+
+	var body []api.LogLine
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addLogLines returns mock JSON for a http POST.
+func addLogLines(c *gin.Context) {
+	data := []byte(LogLinesResp)
+
+	var body []api.LogLine
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
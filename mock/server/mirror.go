@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// RegistryMirrorResp represents a JSON return for a single registry mirror.
+	RegistryMirrorResp = `{
+  "id": 1,
+  "org": "",
+  "registry": "docker.io",
+  "mirror": "mirror.example.com/docker",
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// RegistryMirrorsResp represents a JSON return for one to many registry mirrors.
+	RegistryMirrorsResp = `[
+  {
+    "id": 1,
+    "org": "",
+    "registry": "docker.io",
+    "mirror": "mirror.example.com/docker",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "org": "github",
+    "registry": "docker.io",
+    "mirror": "mirror.github.com/docker",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+)
+
+// getRegistryMirrors returns mock JSON for a http GET.
+func getRegistryMirrors(c *gin.Context) {
+	data := []byte(RegistryMirrorsResp)
+
+	var body []api.RegistryMirror
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getRegistryMirror has a param :mirror returns mock JSON for a http GET.
+//
+// Pass "0" to :mirror to test receiving a http 404 response.
+func getRegistryMirror(c *gin.Context) {
+	m := c.Param("mirror")
+
+	if strings.EqualFold(m, "0") {
+		msg := fmt.Sprintf("Registry mirror %s does not exist", m)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(RegistryMirrorResp)
+
+	var body api.RegistryMirror
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addRegistryMirror returns mock JSON for a http POST.
+func addRegistryMirror(c *gin.Context) {
+	data := []byte(RegistryMirrorResp)
+
+	var body api.RegistryMirror
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateRegistryMirror has a param :mirror returns mock JSON for a http PUT.
+//
+// Pass "0" to :mirror to test receiving a http 404 response.
+func updateRegistryMirror(c *gin.Context) {
+	m := c.Param("mirror")
+
+	if strings.EqualFold(m, "0") {
+		msg := fmt.Sprintf("Registry mirror %s does not exist", m)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(RegistryMirrorResp)
+
+	var body api.RegistryMirror
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeRegistryMirror has a param :mirror returns mock JSON for a http DELETE.
+//
+// Pass "0" to :mirror to test receiving a http 404 response.
+func removeRegistryMirror(c *gin.Context) {
+	m := c.Param("mirror")
+
+	if strings.EqualFold(m, "0") {
+		msg := fmt.Sprintf("Registry mirror %s does not exist", m)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("registry mirror %s deleted", m))
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// OrgHookResp represents a JSON return for an org hook.
+	OrgHookResp = `{
+  "id": 1,
+  "org": "github",
+  "webhook_id": 123456,
+  "secret": "[secure]",
+  "auto_enable": true,
+  "user_id": 1,
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+)
+
+// getOrgHook has a param :org returns mock JSON for a http GET.
+//
+// Pass "0" to :org to test receiving a http 404 response.
+func getOrgHook(c *gin.Context) {
+	o := c.Param("org")
+
+	if strings.EqualFold(o, "0") {
+		msg := fmt.Sprintf("Org hook for org %s does not exist", o)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(OrgHookResp)
+
+	var body api.OrgHook
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getOrgHooks returns mock JSON for a http GET.
+func getOrgHooks(c *gin.Context) {
+	data := []byte(fmt.Sprintf("[%s]", OrgHookResp))
+
+	var body []api.OrgHook
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// updateAdminOrgHook returns mock JSON for a http PUT.
+func updateAdminOrgHook(c *gin.Context) {
+	data := []byte(OrgHookResp)
+
+	var body api.OrgHook
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addOrgHook returns mock JSON for a http POST.
+func addOrgHook(c *gin.Context) {
+	data := []byte(OrgHookResp)
+
+	var body api.OrgHook
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateOrgHook has a param :org returns mock JSON for a http PUT.
+//
+// Pass "0" to :org to test receiving a http 404 response.
+func updateOrgHook(c *gin.Context) {
+	o := c.Param("org")
+
+	if strings.EqualFold(o, "0") {
+		msg := fmt.Sprintf("Org hook for org %s does not exist", o)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(OrgHookResp)
+
+	var body api.OrgHook
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeOrgHook has a param :org returns mock JSON for a http DELETE.
+//
+// Pass "0" to :org to test receiving a http 404 response.
+func removeOrgHook(c *gin.Context) {
+	o := c.Param("org")
+
+	if strings.EqualFold(o, "0") {
+		msg := fmt.Sprintf("Org hook for org %s does not exist", o)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("org hook for org %s deleted", o))
+}
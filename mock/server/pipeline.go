@@ -13,6 +13,7 @@ import (
 	"github.com/go-vela/types/library"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/compiler/expression"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/yaml"
 
@@ -162,6 +163,29 @@ templates:
   }
 ]`
 
+	// ExplainResp represents a JSON return for the conditional expressions in a pipeline.
+	ExplainResp = `[
+  {
+    "step": "publish",
+    "expression": "branch == \"main\"",
+    "result": true,
+    "trace": {
+      "expression": "(branch == \"main\")",
+      "value": true,
+      "children": [
+        {
+          "expression": "branch",
+          "value": "main"
+        },
+        {
+          "expression": "\"main\"",
+          "value": "main"
+        }
+      ]
+    }
+  }
+]`
+
 	// TemplateResp represents a YAML return for templates in a pipeline.
 	TemplateResp = `---
 sample:
@@ -298,6 +322,28 @@ func expandPipeline(c *gin.Context) {
 	c.YAML(http.StatusOK, body)
 }
 
+// explainPipeline has a param :pipeline returns mock JSON for a http POST.
+//
+// Pass "0" to :pipeline to test receiving a http 404 response.
+func explainPipeline(c *gin.Context) {
+	p := c.Param("pipeline")
+
+	if strings.EqualFold(p, "0") {
+		msg := fmt.Sprintf("Pipeline %s does not exist", p)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ExplainResp)
+
+	var body []*expression.Explanation
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
 // getTemplates has a param :pipeline returns mock YAML for a http GET.
 //
 // Pass "0" to :pipeline to test receiving a http 404 response.
@@ -0,0 +1,113 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// PreviewEnvironmentResp represents a JSON return for a single preview environment.
+	PreviewEnvironmentResp = `{
+  "id": 1,
+  "repo_id": 1,
+  "build_id": 1,
+  "number": 1,
+  "name": "web",
+  "url": "https://pr-1.preview.example.com",
+  "status": "active",
+  "expires": 1563560477,
+  "created": 1563474077,
+  "updated": 1563474077
+}`
+
+	// PreviewEnvironmentsResp represents a JSON return for one to many preview environments.
+	PreviewEnvironmentsResp = `[
+  {
+    "id": 1,
+    "repo_id": 1,
+    "build_id": 1,
+    "number": 1,
+    "name": "web",
+    "url": "https://pr-1.preview.example.com",
+    "status": "active",
+    "expires": 1563560477,
+    "created": 1563474077,
+    "updated": 1563474077
+  },
+  {
+    "id": 2,
+    "repo_id": 1,
+    "build_id": 1,
+    "number": 1,
+    "name": "worker",
+    "url": "https://pr-1.worker.preview.example.com",
+    "status": "pending",
+    "created": 1563474077,
+    "updated": 1563474077
+  }
+]`
+)
+
+// getPreviewEnvironments returns mock JSON for a http GET.
+func getPreviewEnvironments(c *gin.Context) {
+	data := []byte(PreviewEnvironmentsResp)
+
+	var body []api.PreviewEnvironment
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addPreviewEnvironment has a param :build returns mock JSON for a http POST.
+//
+// Pass "0" to :build to test receiving a http 404 response.
+func addPreviewEnvironment(c *gin.Context) {
+	b := c.Param("build")
+
+	if strings.EqualFold(b, "0") {
+		msg := fmt.Sprintf("Build %s does not exist", b)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(PreviewEnvironmentResp)
+
+	var body api.PreviewEnvironment
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updatePreviewEnvironment has a param :preview returns mock JSON for a http PUT.
+//
+// Pass "0" to :preview to test receiving a http 404 response.
+func updatePreviewEnvironment(c *gin.Context) {
+	p := c.Param("preview")
+
+	if strings.EqualFold(p, "0") {
+		msg := fmt.Sprintf("Preview environment %s does not exist", p)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(PreviewEnvironmentResp)
+
+	var body api.PreviewEnvironment
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
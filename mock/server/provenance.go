@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// ArtifactResp represents a JSON return for a single artifact.
+	ArtifactResp = `{
+  "id": 1,
+  "repo_id": 1,
+  "build_id": 1,
+  "name": "vela-server",
+  "kind": "binary",
+  "digest": "sha256:5d41402abc4b2a76b9719d911017c5925d41402abc4b2a76b9719d911017c592",
+  "uri": "https://artifacts.example.com/vela-server",
+  "created_by": "octocat",
+  "created": 1563474077
+}`
+
+	// ArtifactsResp represents a JSON return for one to many artifacts.
+	ArtifactsResp = `[
+  {
+    "id": 1,
+    "repo_id": 1,
+    "build_id": 1,
+    "name": "vela-server",
+    "kind": "binary",
+    "digest": "sha256:5d41402abc4b2a76b9719d911017c5925d41402abc4b2a76b9719d911017c592",
+    "uri": "https://artifacts.example.com/vela-server",
+    "created_by": "octocat",
+    "created": 1563474077
+  },
+  {
+    "id": 2,
+    "repo_id": 1,
+    "build_id": 1,
+    "name": "vela-server-image",
+    "kind": "image",
+    "digest": "sha256:7d793037a0760186574b0282f2f435e77d793037a0760186574b0282f2f435e7",
+    "uri": "docker.io/octocat/vela-server:latest",
+    "created_by": "octocat",
+    "created": 1563474077
+  }
+]`
+
+	// ProvenancesResp represents a JSON return for one to many provenance records.
+	ProvenancesResp = `[
+  {
+    "repo": "github/octocat",
+    "build": {
+      "id": 1,
+      "repo_id": 1,
+      "pipeline_id": 1,
+      "number": 1,
+      "event": "push",
+      "status": "success",
+      "commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+      "branch": "main"
+    },
+    "pipeline": {
+      "id": 1,
+      "repo_id": 1,
+      "commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+      "type": "yaml",
+      "version": "1"
+    },
+    "templates": [
+      {
+        "name": "go",
+        "source": "github.com/github/octocat/go.yml@v1.0.0",
+        "type": "github"
+      }
+    ],
+    "images": [
+      "golang@sha256:3b2c56ba5f70e5c5f7ea6a4cd3c5b2f9ed0eb6c6c1e1d1b2f3e1e1a3d8e4d0b7"
+    ],
+    "artifacts": [
+      {
+        "id": 1,
+        "repo_id": 1,
+        "build_id": 1,
+        "name": "vela-server",
+        "kind": "binary",
+        "digest": "sha256:5d41402abc4b2a76b9719d911017c5925d41402abc4b2a76b9719d911017c592",
+        "uri": "https://artifacts.example.com/vela-server",
+        "created_by": "octocat",
+        "created": 1563474077
+      }
+    ],
+    "deployments": [
+      {
+        "id": 2,
+        "repo_id": 1,
+        "number": 2,
+        "event": "deployment",
+        "status": "success",
+        "commit": "48afb5bdc41ad69bf22588491333f7cf71135163",
+        "deploy": "production"
+      }
+    ]
+  }
+]`
+)
+
+// getArtifacts returns mock JSON for a http GET.
+func getArtifacts(c *gin.Context) {
+	data := []byte(ArtifactsResp)
+
+	var body []api.Artifact
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addArtifact has a param :build returns mock JSON for a http POST.
+//
+// Pass "0" to :build to test receiving a http 404 response.
+func addArtifact(c *gin.Context) {
+	b := c.Param("build")
+
+	if strings.EqualFold(b, "0") {
+		msg := fmt.Sprintf("Build %s does not exist", b)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ArtifactResp)
+
+	var body api.Artifact
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// getArtifactProvenance has a param :digest returns mock JSON for a http GET.
+//
+// Pass "sha256:0" to :digest to test receiving a http 404 response.
+func getArtifactProvenance(c *gin.Context) {
+	d := c.Param("digest")
+
+	if strings.EqualFold(d, "sha256:0") {
+		msg := fmt.Sprintf("Artifact %s does not exist", d)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ProvenancesResp)
+
+	var body []api.Provenance
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getDeploymentProvenance returns mock JSON for a http GET.
+func getDeploymentProvenance(c *gin.Context) {
+	data := []byte(ProvenancesResp)
+
+	var body []api.Provenance
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+// QueueSummaryResp represents a JSON return for inspecting the queue.
+const QueueSummaryResp = `{
+  "used_memory": 1048576,
+  "routes": [
+    {
+      "route": "vela",
+      "length": 2,
+      "oldest_age": 60,
+      "items": [
+        {
+          "build_id": 1,
+          "repo": "github/octocat",
+          "number": 1,
+          "enqueued": 1563474076,
+          "size": 2048,
+          "compressed": true
+        },
+        {
+          "build_id": 2,
+          "repo": "github/octocat",
+          "number": 2,
+          "enqueued": 1563474106,
+          "size": 2048,
+          "compressed": true
+        }
+      ]
+    }
+  ]
+}`
+
+// inspectQueue returns mock JSON for a http GET.
+func inspectQueue(c *gin.Context) {
+	data := []byte(QueueSummaryResp)
+
+	var body api.QueueSummary
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// purgeQueueRoute has a param :route returns mock JSON for a http DELETE.
+func purgeQueueRoute(c *gin.Context) {
+	c.JSON(http.StatusOK, fmt.Sprintf("2 items purged from queue route %s", c.Param("route")))
+}
+
+// moveQueueRoute has a param :route returns mock JSON for a http POST.
+//
+// Pass the same route to the to query parameter to test receiving a http 400 response.
+func moveQueueRoute(c *gin.Context) {
+	r := c.Param("route")
+	to := c.Query("to")
+
+	if len(to) == 0 || to == r {
+		msg := fmt.Sprintf("unable to move queue route %s: to query parameter must be a different route", r)
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("2 items moved from queue route %s to %s", r, to))
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// ServiceReadinessResp represents a JSON return for the readiness of a single service.
+	ServiceReadinessResp = `{
+  "id": 1,
+  "service_id": 1,
+  "build_id": 1,
+  "repo_id": 1,
+  "status": "ready",
+  "attempts": 3,
+  "started": 1563474077,
+  "ready": 1563474083
+}`
+
+	// BuildServiceReadinessResp represents a JSON return for the readiness of the services for a build.
+	BuildServiceReadinessResp = `[
+  {
+    "id": 1,
+    "service_id": 1,
+    "build_id": 1,
+    "repo_id": 1,
+    "status": "ready",
+    "attempts": 3,
+    "started": 1563474077,
+    "ready": 1563474083
+  },
+  {
+    "id": 2,
+    "service_id": 2,
+    "build_id": 1,
+    "repo_id": 1,
+    "status": "waiting",
+    "attempts": 1,
+    "started": 1563474077
+  }
+]`
+)
+
+// getBuildServiceReadiness returns mock JSON for a http GET.
+func getBuildServiceReadiness(c *gin.Context) {
+	data := []byte(BuildServiceReadinessResp)
+
+	var body []api.ServiceReadiness
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getServiceReadiness has a param :service returns mock JSON for a http GET.
+//
+// Pass "0" to :service to test receiving a http 404 response.
+func getServiceReadiness(c *gin.Context) {
+	s := c.Param("service")
+
+	if strings.EqualFold(s, "0") {
+		msg := fmt.Sprintf("Readiness for service %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ServiceReadinessResp)
+
+	var body api.ServiceReadiness
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// updateServiceReadiness has a param :service returns mock JSON for a http PUT.
+//
+// Pass "0" to :service to test receiving a http 404 response.
+func updateServiceReadiness(c *gin.Context) {
+	s := c.Param("service")
+
+	if strings.EqualFold(s, "0") {
+		msg := fmt.Sprintf("Service %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ServiceReadinessResp)
+
+	var body api.ServiceReadiness
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
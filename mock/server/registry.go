@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// RegistryCredentialResp represents a JSON return for a single registry credential.
+	RegistryCredentialResp = `{
+  "id": 1,
+  "org": "github",
+  "registry": "ghcr.io",
+  "username": "octocat",
+  "password": "[secure]",
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// RegistryCredentialsResp represents a JSON return for one to many registry credentials.
+	RegistryCredentialsResp = `[
+  {
+    "id": 1,
+    "org": "github",
+    "registry": "ghcr.io",
+    "username": "octocat",
+    "password": "[secure]",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "org": "github",
+    "registry": "docker.io",
+    "username": "octocat",
+    "password": "[secure]",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+
+	// BuildRegistryCredentialsResp represents a JSON return for the registry credentials granted to a build.
+	BuildRegistryCredentialsResp = `[
+  {
+    "id": 1,
+    "org": "github",
+    "registry": "ghcr.io",
+    "username": "octocat",
+    "password": "superSecretPassword",
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  }
+]`
+)
+
+// getRegistryCredentials returns mock JSON for a http GET.
+func getRegistryCredentials(c *gin.Context) {
+	data := []byte(RegistryCredentialsResp)
+
+	var body []api.RegistryCredential
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getRegistryCredential has a param :registry returns mock JSON for a http GET.
+//
+// Pass "0" to :registry to test receiving a http 404 response.
+func getRegistryCredential(c *gin.Context) {
+	r := c.Param("registry")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Registry credential %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(RegistryCredentialResp)
+
+	var body api.RegistryCredential
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addRegistryCredential returns mock JSON for a http POST.
+func addRegistryCredential(c *gin.Context) {
+	data := []byte(RegistryCredentialResp)
+
+	var body api.RegistryCredential
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateRegistryCredential has a param :registry returns mock JSON for a http PUT.
+//
+// Pass "0" to :registry to test receiving a http 404 response.
+func updateRegistryCredential(c *gin.Context) {
+	r := c.Param("registry")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Registry credential %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(RegistryCredentialResp)
+
+	var body api.RegistryCredential
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeRegistryCredential has a param :registry returns mock JSON for a http DELETE.
+//
+// Pass "0" to :registry to test receiving a http 404 response.
+func removeRegistryCredential(c *gin.Context) {
+	r := c.Param("registry")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Registry credential %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("registry credential %s for org %s deleted", r, c.Param("org")))
+}
+
+// getBuildRegistryCredentials has a param :build returns mock JSON for a http GET.
+//
+// Pass "0" to :build to test receiving a http 400 response.
+func getBuildRegistryCredentials(c *gin.Context) {
+	b := c.Param("build")
+
+	if strings.EqualFold(b, "0") {
+		msg := fmt.Sprintf("Build %s is not in progress", b)
+
+		c.AbortWithStatusJSON(http.StatusBadRequest, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(BuildRegistryCredentialsResp)
+
+	var body []api.RegistryCredential
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
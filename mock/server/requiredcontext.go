@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// RequiredContextResp represents a JSON return for a single required context.
+	RequiredContextResp = `{
+  "id": 1,
+  "org": "github",
+  "repo": "octocat",
+  "branch": "main",
+  "contexts": [
+    "continuous-integration/vela/pull_request"
+  ],
+  "strict": true,
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// RequiredContextsResp represents a JSON return for one to many required contexts.
+	RequiredContextsResp = `[
+  {
+    "id": 1,
+    "org": "github",
+    "repo": "octocat",
+    "branch": "main",
+    "contexts": [
+      "continuous-integration/vela/pull_request"
+    ],
+    "strict": true,
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "org": "github",
+    "repo": "*",
+    "contexts": [
+      "continuous-integration/vela/pull_request"
+    ],
+    "strict": false,
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+)
+
+// getRequiredContexts returns mock JSON for a http GET.
+func getRequiredContexts(c *gin.Context) {
+	data := []byte(RequiredContextsResp)
+
+	var body []api.RequiredContext
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getRequiredContext has a param :context returns mock JSON for a http GET.
+//
+// Pass "0" to :context to test receiving a http 404 response.
+func getRequiredContext(c *gin.Context) {
+	r := c.Param("context")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Required context %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(RequiredContextResp)
+
+	var body api.RequiredContext
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addRequiredContext returns mock JSON for a http POST.
+func addRequiredContext(c *gin.Context) {
+	data := []byte(RequiredContextResp)
+
+	var body api.RequiredContext
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateRequiredContext has a param :context returns mock JSON for a http PUT.
+//
+// Pass "0" to :context to test receiving a http 404 response.
+func updateRequiredContext(c *gin.Context) {
+	r := c.Param("context")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Required context %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(RequiredContextResp)
+
+	var body api.RequiredContext
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeRequiredContext has a param :context returns mock JSON for a http DELETE.
+//
+// Pass "0" to :context to test receiving a http 404 response.
+func removeRequiredContext(c *gin.Context) {
+	r := c.Param("context")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Required context %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("required context %s deleted for org %s", r, c.Param("org")))
+}
+
+// syncRequiredContext has a param :context returns mock JSON for a http POST.
+//
+// Pass "0" to :context to test receiving a http 404 response.
+func syncRequiredContext(c *gin.Context) {
+	r := c.Param("context")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Required context %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("required context %s synced for 1 repos in org %s", r, c.Param("org")))
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// RetentionPolicyResp represents a JSON return for a retention policy.
+	RetentionPolicyResp = `{
+  "id": 2,
+  "repo_id": 1,
+  "keep_builds": 100,
+  "log_days": 30,
+  "hook_days": 90,
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// RetentionPoliciesResp represents a JSON return for one to many retention policies.
+	RetentionPoliciesResp = `[
+  {
+    "id": 1,
+    "keep_builds": 500,
+    "log_days": 90,
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "repo_id": 1,
+    "keep_builds": 100,
+    "log_days": 30,
+    "hook_days": 90,
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  }
+]`
+
+	// RetentionSweepResp represents a JSON return for a retention sweep.
+	RetentionSweepResp = `{
+  "repos": 2,
+  "builds": 150,
+  "logs": 600,
+  "hooks": 40,
+  "started": 1563474077,
+  "finished": 1563474137
+}`
+)
+
+// getRetentionPolicies returns mock JSON for a http GET.
+func getRetentionPolicies(c *gin.Context) {
+	data := []byte(RetentionPoliciesResp)
+
+	var body []api.RetentionPolicy
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getRetentionPolicy has a param :repo returns mock JSON for a http GET.
+//
+// Pass "0" to :repo to test receiving a http 404 response.
+func getRetentionPolicy(c *gin.Context) {
+	r := c.Param("repo")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Retention policy for repo %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(RetentionPolicyResp)
+
+	var body api.RetentionPolicy
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// updateRetentionPolicy returns mock JSON for a http PUT.
+func updateRetentionPolicy(c *gin.Context) {
+	data := []byte(RetentionPolicyResp)
+
+	var body api.RetentionPolicy
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeRetentionPolicy has a param :repo returns mock JSON for a http DELETE.
+//
+// Pass "0" to :repo to test receiving a http 404 response.
+func removeRetentionPolicy(c *gin.Context) {
+	o := c.Param("org")
+	r := c.Param("repo")
+
+	if strings.EqualFold(r, "0") {
+		msg := fmt.Sprintf("Retention policy for repo %s does not exist", r)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("retention policy for repo %s/%s deleted", o, r))
+}
+
+// runRetentionSweep returns mock JSON for a http POST.
+func runRetentionSweep(c *gin.Context) {
+	data := []byte(RetentionSweepResp)
+
+	var body api.RetentionSweep
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
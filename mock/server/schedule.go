@@ -0,0 +1,189 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// ScheduleResp represents a JSON return for a single schedule.
+	ScheduleResp = `{
+  "id": 1,
+  "repo_id": 1,
+  "active": true,
+  "name": "nightly",
+  "entry": "0 2 * * *",
+  "time_zone": "America/Chicago",
+  "branch": "main",
+  "created_at": 1563474000,
+  "created_by": "octocat",
+  "updated_at": 1563474000,
+  "updated_by": "octocat",
+  "scheduled_at": 1563520800
+}`
+
+	// SchedulesResp represents a JSON return for one to many schedules.
+	SchedulesResp = `[
+  {
+    "id": 2,
+    "repo_id": 1,
+    "active": false,
+    "name": "hourly",
+    "entry": "@hourly",
+    "time_zone": "UTC",
+    "created_at": 1563474000,
+    "created_by": "octocat",
+    "updated_at": 1563474000,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 1,
+    "repo_id": 1,
+    "active": true,
+    "name": "nightly",
+    "entry": "0 2 * * *",
+    "time_zone": "America/Chicago",
+    "branch": "main",
+    "created_at": 1563474000,
+    "created_by": "octocat",
+    "updated_at": 1563474000,
+    "updated_by": "octocat",
+    "scheduled_at": 1563520800
+  }
+]`
+
+	// ScheduleRunsResp represents a JSON return for one to many schedule runs.
+	ScheduleRunsResp = `[
+  {
+    "id": 2,
+    "schedule_id": 1,
+    "repo_id": 1,
+    "scheduled_for": 1563606000,
+    "status": "skipped",
+    "reason": "blackout window for freeze holiday until 2019-07-19T18:21:16Z: end of year change freeze",
+    "created_at": 1563606000
+  },
+  {
+    "id": 1,
+    "schedule_id": 1,
+    "repo_id": 1,
+    "scheduled_for": 1563519600,
+    "status": "triggered",
+    "build_number": 1,
+    "created_at": 1563520800
+  }
+]`
+)
+
+// getSchedules returns mock JSON for a http GET.
+func getSchedules(c *gin.Context) {
+	data := []byte(SchedulesResp)
+
+	var body []api.Schedule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getSchedule has a param :schedule returns mock JSON for a http GET.
+//
+// Pass "not-found" to :schedule to test receiving a http 404 response.
+func getSchedule(c *gin.Context) {
+	s := c.Param("schedule")
+
+	if strings.EqualFold(s, "not-found") {
+		msg := fmt.Sprintf("Schedule %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ScheduleResp)
+
+	var body api.Schedule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getScheduleRuns has a param :schedule returns mock JSON for a http GET.
+//
+// Pass "not-found" to :schedule to test receiving a http 404 response.
+func getScheduleRuns(c *gin.Context) {
+	s := c.Param("schedule")
+
+	if strings.EqualFold(s, "not-found") {
+		msg := fmt.Sprintf("Schedule %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ScheduleRunsResp)
+
+	var body []api.ScheduleRun
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addSchedule returns mock JSON for a http POST.
+func addSchedule(c *gin.Context) {
+	data := []byte(ScheduleResp)
+
+	var body api.Schedule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateSchedule has a param :schedule returns mock JSON for a http PUT.
+//
+// Pass "not-found" to :schedule to test receiving a http 404 response.
+func updateSchedule(c *gin.Context) {
+	s := c.Param("schedule")
+
+	if strings.EqualFold(s, "not-found") {
+		msg := fmt.Sprintf("Schedule %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(ScheduleResp)
+
+	var body api.Schedule
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeSchedule has a param :schedule returns mock JSON for a http DELETE.
+//
+// Pass "not-found" to :schedule to test receiving a http 404 response.
+func removeSchedule(c *gin.Context) {
+	s := c.Param("schedule")
+
+	if strings.EqualFold(s, "not-found") {
+		msg := fmt.Sprintf("Schedule %s does not exist", s)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("schedule github/octocat/%s deleted", s))
+}
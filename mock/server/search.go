@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+// SearchResultsResp represents a JSON return for one to many search results.
+const SearchResultsResp = `[
+  {
+    "repo": "github/octocat",
+    "build": {
+      "id": 1,
+      "repo_id": 1,
+      "number": 1,
+      "event": "push",
+      "status": "success",
+      "message": "fix the flaky test",
+      "branch": "main",
+      "author": "OctoKitty"
+    },
+    "lines": [
+      {
+        "id": 1,
+        "build_id": 1,
+        "log_id": 1,
+        "number": 1,
+        "stream": "stdout",
+        "timestamp": 1563474077000,
+        "data": "applying fix for the flaky test"
+      }
+    ]
+  }
+]`
+
+// searchBuilds returns mock JSON for a http GET.
+func searchBuilds(c *gin.Context) {
+	data := []byte(SearchResultsResp)
+
+	var body []api.SearchResult
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
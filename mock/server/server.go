@@ -20,15 +20,46 @@ func FakeHandler() http.Handler {
 	e := gin.New()
 
 	// mock endpoints for admin calls
+	e.GET("/api/v1/admin/anomalies", getAnomalies)
+	e.POST("/api/v1/admin/anomalies", getAnomalies)
+	e.GET("/api/v1/admin/archived/repos", getArchivedRepos)
+	e.POST("/api/v1/admin/archived/repos/:org/:repo/restore", restoreArchivedRepo)
+	e.DELETE("/api/v1/admin/archived/repos/:org/:repo", purgeArchivedRepo)
+	e.GET("/api/v1/admin/archived/secrets", getArchivedSecrets)
+	e.POST("/api/v1/admin/archived/secrets/:type/:org/:name/:secret/restore", restoreArchivedSecret)
+	e.DELETE("/api/v1/admin/archived/secrets/:type/:org/:name/:secret", purgeArchivedSecret)
+	e.GET("/api/v1/admin/canaries", getCanaryRuns)
+	e.POST("/api/v1/admin/canaries", getCanaryRuns)
+	e.GET("/api/v1/admin/capacity", getCapacitySnapshots)
+	e.POST("/api/v1/admin/catalog", pushCatalog)
 	e.GET("/api/v1/admin/builds", getBuilds)
 	e.PUT("/api/v1/admin/build", updateBuild)
 	e.GET("/api/v1/admin/builds/queue", buildQueue)
+	e.GET("/api/v1/admin/builds/:id/explain", explainBuild)
 	e.GET("/api/v1/admin/deployments", getDeployments)
 	e.PUT("/api/v1/admin/deployment", updateDeployment)
+	e.GET("/api/v1/admin/exports", getExports)
+	e.POST("/api/v1/admin/exports", getExports)
 	e.GET("/api/v1/admin/hooks", getHooks)
 	e.PUT("/api/v1/admin/hook", updateHook)
+	e.GET("/api/v1/admin/leases", getLeases)
+	e.GET("/api/v1/admin/leaks", getLeakFindings)
+	e.POST("/api/v1/admin/leaks", getLeakFindings)
+	e.GET("/api/v1/admin/orghooks", getOrgHooks)
+	e.PUT("/api/v1/admin/orghook", updateAdminOrgHook)
+	e.GET("/api/v1/admin/diagnostics/bundle", getDiagnosticsBundle)
+	e.GET("/api/v1/admin/diagnostics/pprof/:profile", getDiagnosticsProfile)
+	e.GET("/api/v1/admin/queue", inspectQueue)
+	e.DELETE("/api/v1/admin/queue/routes/:route", purgeQueueRoute)
+	e.POST("/api/v1/admin/queue/routes/:route/move", moveQueueRoute)
 	e.GET("/api/v1/admin/repos", getRepos)
 	e.PUT("/api/v1/admin/repo", updateRepo)
+	e.GET("/api/v1/admin/retention", getRetentionPolicies)
+	e.PUT("/api/v1/admin/retention", updateRetentionPolicy)
+	e.GET("/api/v1/admin/retention/repos/:org/:repo", getRetentionPolicy)
+	e.PUT("/api/v1/admin/retention/repos/:org/:repo", updateRetentionPolicy)
+	e.DELETE("/api/v1/admin/retention/repos/:org/:repo", removeRetentionPolicy)
+	e.POST("/api/v1/admin/retention/sweep", runRetentionSweep)
 	e.GET("/api/v1/admin/secrets", getSecrets)
 	e.PUT("/api/v1/admin/secret", updateSecret)
 	e.GET("/api/v1/admin/services", getServices)
@@ -37,6 +68,8 @@ func FakeHandler() http.Handler {
 	e.PUT("/api/v1/admin/step", updateStep)
 	e.GET("/api/v1/admin/users", getUsers)
 	e.PUT("/api/v1/admin/user", updateUser)
+	e.POST("/api/v1/admin/workers/ephemeral", addEphemeralRegistration)
+	e.DELETE("/api/v1/admin/workers/ephemeral/:worker", removeEphemeralRegistration)
 	e.POST("/api/v1/admin/workers/:worker/register-token", registerToken)
 
 	// mock endpoints for build calls
@@ -44,17 +77,48 @@ func FakeHandler() http.Handler {
 	e.POST("/api/v1/repos/:org/:repo/builds/:build", restartBuild)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/cancel", cancelBuild)
 	e.GET("/api/v1/repos/:org/:repo/builds/:build/logs", getLogs)
+	e.PUT("/api/v1/repos/:org/:repo/builds/:build/logs", updateLogs)
 	e.GET("/api/v1/repos/:org/:repo/builds", getBuilds)
 	e.POST("/api/v1/repos/:org/:repo/builds", addBuild)
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build", updateBuild)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build", removeBuild)
 	e.GET("/api/v1/repos/:org/:repo/builds/:build/token", buildToken)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/images", getBuildImages)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/deprecations", getBuildDeprecations)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/cost", getBuildCost)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/labels", getBuildLabels)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/leaks", getLeakFindings)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/readiness", getBuildServiceReadiness)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/redactions", getBuildRedactions)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/redactions", addBuildRedaction)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/registry-credentials", getBuildRegistryCredentials)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/report", getCompileReport)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/skips", getStepSkips)
+
+	// mock endpoints for catalog calls
+	e.GET("/api/v1/catalog/components", getCatalogComponents)
+	e.GET("/api/v1/catalog/components/:org/:repo", getCatalogComponent)
 
 	// mock endpoints for deployment calls
 	e.GET("/api/v1/deployments/:org/:repo", getDeployments)
 	e.POST("/api/v1/deployments/:org/:repo", addDeployment)
+	e.GET("/api/v1/deployments/:org/:repo/environments", getDeployEnvironments)
 	e.GET("/api/v1/deployments/:org/:repo/:deployment", getDeployment)
 
+	// mock endpoints for egress rule calls
+	e.GET("/api/v1/egress/:org", getEgressRules)
+	e.GET("/api/v1/egress/:org/:rule", getEgressRule)
+	e.POST("/api/v1/egress/:org", addEgressRule)
+	e.PUT("/api/v1/egress/:org/:rule", updateEgressRule)
+	e.DELETE("/api/v1/egress/:org/:rule", removeEgressRule)
+
+	// mock endpoints for freeze calls
+	e.GET("/api/v1/freezes/:org", getFreezes)
+	e.GET("/api/v1/freezes/:org/:freeze", getFreeze)
+	e.GET("/api/v1/freezes/:org/:freeze/audits", getFreezeAudits)
+	e.POST("/api/v1/freezes/:org", addFreeze)
+	e.DELETE("/api/v1/freezes/:org/:freeze", removeFreeze)
+
 	// mock endpoints for hook calls
 	e.GET("/api/v1/hooks/:org/:repo", getHooks)
 	e.GET("/api/v1/hooks/:org/:repo/:hook", getHook)
@@ -67,10 +131,14 @@ func FakeHandler() http.Handler {
 	e.POST("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs", addServiceLog)
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs", updateServiceLog)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs", removeServiceLog)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs/lines", getLogLines)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/services/:service/logs/lines", addLogLines)
 	e.GET("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", getStepLog)
 	e.POST("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", addStepLog)
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", updateStepLog)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs", removeStepLog)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/lines", getLogLines)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/lines", addLogLines)
 
 	// mock endpoints for pipeline calls
 	e.POST("/api/v1/pipelines/:org/:repo", addPipeline)
@@ -80,9 +148,22 @@ func FakeHandler() http.Handler {
 	e.DELETE("/api/v1/pipelines/:org/:repo/:pipeline", removePipeline)
 	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/compile", compilePipeline)
 	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/expand", expandPipeline)
+	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/explain", explainPipeline)
 	e.GET("/api/v1/pipelines/:org/:repo/:pipeline/templates", getTemplates)
 	e.POST("/api/v1/pipelines/:org/:repo/:pipeline/validate", validatePipeline)
 
+	// mock endpoints for preview environment calls
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/previews", getPreviewEnvironments)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/previews", addPreviewEnvironment)
+	e.GET("/api/v1/repos/:org/:repo/previews", getPreviewEnvironments)
+	e.PUT("/api/v1/repos/:org/:repo/previews/:preview", updatePreviewEnvironment)
+
+	// mock endpoints for provenance calls
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/artifacts", getArtifacts)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/artifacts", addArtifact)
+	e.GET("/api/v1/repos/:org/:repo/provenance/artifacts/:digest", getArtifactProvenance)
+	e.GET("/api/v1/deployments/:org/:repo/:deployment/provenance", getDeploymentProvenance)
+
 	// mock endpoints for repo calls
 	e.GET("/api/v1/repos/:org/:repo", getRepo)
 	e.GET("/api/v1/repos", getRepos)
@@ -91,9 +172,34 @@ func FakeHandler() http.Handler {
 	e.DELETE("/api/v1/repos/:org/:repo", removeRepo)
 	e.PATCH("/api/v1/repos/:org/:repo/repair", repairRepo)
 	e.PATCH("/api/v1/repos/:org/:repo/chown", chownRepo)
+	e.GET("/api/v1/repos/:org/:repo/clone", getCloneSetting)
+	e.PUT("/api/v1/repos/:org/:repo/clone", updateCloneSetting)
+	e.DELETE("/api/v1/repos/:org/:repo/clone", removeCloneSetting)
+	e.GET("/api/v1/repos/:org/:repo/sync", getRepoSync)
+	e.POST("/api/v1/repos/:org/:repo/trigger-token", triggerToken)
+	e.GET("/api/v1/repos/:org/:repo/trigger-tokens", getTriggerTokens)
+	e.DELETE("/api/v1/repos/:org/:repo/trigger-tokens/:token", revokeTriggerToken)
+	e.POST("/api/v1/repos/:org/sync", syncReposForOrg)
+	e.POST("/api/v1/repos/:org/trigger-token", orgTriggerToken)
+	e.GET("/api/v1/repos/:org/trigger-tokens", getTriggerTokens)
+	e.DELETE("/api/v1/repos/:org/trigger-tokens/:token", revokeTriggerToken)
+	e.GET("/api/v1/repos/:org/clone", getOrgCloneSetting)
+	e.PUT("/api/v1/repos/:org/clone", updateOrgCloneSetting)
+	e.DELETE("/api/v1/repos/:org/clone", removeOrgCloneSetting)
 	e.GET("/api/v1/scm/repos/:org/:repo/sync", syncRepo)
 	e.GET("/api/v1/scm/orgs/:org/sync", syncRepos)
 
+	// mock endpoints for schedule calls
+	e.GET("/api/v1/schedules/:org/:repo", getSchedules)
+	e.GET("/api/v1/schedules/:org/:repo/:schedule", getSchedule)
+	e.GET("/api/v1/schedules/:org/:repo/:schedule/runs", getScheduleRuns)
+	e.POST("/api/v1/schedules/:org/:repo", addSchedule)
+	e.PUT("/api/v1/schedules/:org/:repo/:schedule", updateSchedule)
+	e.DELETE("/api/v1/schedules/:org/:repo/:schedule", removeSchedule)
+
+	// mock endpoints for search calls
+	e.GET("/api/v1/search", searchBuilds)
+
 	// mock endpoints for secret calls
 	e.GET("/api/v1/secrets/:engine/:type/:org/:name/:secret", getSecret)
 	e.GET("/api/v1/secrets/:engine/:type/:org/:name", getSecrets)
@@ -107,6 +213,8 @@ func FakeHandler() http.Handler {
 	e.POST("/api/v1/repos/:org/:repo/builds/:build/steps", addStep)
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build/steps/:step", updateStep)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/steps/:step", removeStep)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts", getStepAttempts)
+	e.POST("/api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts", addStepAttempt)
 
 	// mock endpoints for service calls
 	e.GET("/api/v1/repos/:org/:repo/builds/:build/services/:service", getService)
@@ -114,6 +222,66 @@ func FakeHandler() http.Handler {
 	e.POST("/api/v1/repos/:org/:repo/builds/:build/services", addService)
 	e.PUT("/api/v1/repos/:org/:repo/builds/:build/services/:service", updateService)
 	e.DELETE("/api/v1/repos/:org/:repo/builds/:build/services/:service", removeService)
+	e.GET("/api/v1/repos/:org/:repo/builds/:build/services/:service/readiness", getServiceReadiness)
+	e.PUT("/api/v1/repos/:org/:repo/builds/:build/services/:service/readiness", updateServiceReadiness)
+
+	// mock endpoints for template calls
+	e.GET("/api/v1/templates/:org/:repo/deprecations", getTemplateDeprecations)
+	e.POST("/api/v1/templates/:org/:repo/deprecations", addTemplateDeprecation)
+	e.DELETE("/api/v1/templates/:org/:repo/deprecations/:deprecation", removeTemplateDeprecation)
+
+	// mock endpoints for usage calls
+	e.GET("/api/v1/usage/orgs/:org/actors", getActorUsages)
+	e.GET("/api/v1/usage/orgs/:org/budget", getBudgetUsage)
+	e.PUT("/api/v1/usage/orgs/:org/budget", updateBuildBudget)
+	e.DELETE("/api/v1/usage/orgs/:org/budget", removeBuildBudget)
+	e.GET("/api/v1/usage/orgs/:org/costs", getClassCosts)
+	e.GET("/api/v1/usage/orgs/:org/teams/:team", getTeamUsage)
+	e.GET("/api/v1/usage/rates", getCostRates)
+	e.PUT("/api/v1/usage/rates/:class", updateCostRate)
+	e.DELETE("/api/v1/usage/rates/:class", removeCostRate)
+
+	// mock endpoints for announcement calls
+	e.GET("/api/v1/announcements", getAnnouncements)
+	e.GET("/api/v1/announcements/:announcement", getAnnouncement)
+	e.POST("/api/v1/announcements", addAnnouncement)
+	e.PUT("/api/v1/announcements/:announcement", updateAnnouncement)
+	e.DELETE("/api/v1/announcements/:announcement", removeAnnouncement)
+
+	// mock endpoints for deprecation rule calls
+	e.GET("/api/v1/deprecations", getDeprecationRules)
+	e.GET("/api/v1/deprecations/:deprecation", getDeprecationRule)
+	e.POST("/api/v1/deprecations", addDeprecationRule)
+	e.PUT("/api/v1/deprecations/:deprecation", updateDeprecationRule)
+	e.DELETE("/api/v1/deprecations/:deprecation", removeDeprecationRule)
+
+	// mock endpoints for registry mirror calls
+	e.GET("/api/v1/mirrors", getRegistryMirrors)
+	e.GET("/api/v1/mirrors/:mirror", getRegistryMirror)
+	e.POST("/api/v1/mirrors", addRegistryMirror)
+	e.PUT("/api/v1/mirrors/:mirror", updateRegistryMirror)
+	e.DELETE("/api/v1/mirrors/:mirror", removeRegistryMirror)
+
+	// mock endpoints for org hook calls
+	e.GET("/api/v1/orghooks/:org", getOrgHook)
+	e.POST("/api/v1/orghooks/:org", addOrgHook)
+	e.PUT("/api/v1/orghooks/:org", updateOrgHook)
+	e.DELETE("/api/v1/orghooks/:org", removeOrgHook)
+
+	// mock endpoints for registry credential calls
+	e.GET("/api/v1/registries/:org", getRegistryCredentials)
+	e.GET("/api/v1/registries/:org/:registry", getRegistryCredential)
+	e.POST("/api/v1/registries/:org", addRegistryCredential)
+	e.PUT("/api/v1/registries/:org/:registry", updateRegistryCredential)
+	e.DELETE("/api/v1/registries/:org/:registry", removeRegistryCredential)
+
+	// mock endpoints for required context calls
+	e.GET("/api/v1/requiredcontexts/:org", getRequiredContexts)
+	e.GET("/api/v1/requiredcontexts/:org/:context", getRequiredContext)
+	e.POST("/api/v1/requiredcontexts/:org", addRequiredContext)
+	e.PUT("/api/v1/requiredcontexts/:org/:context", updateRequiredContext)
+	e.DELETE("/api/v1/requiredcontexts/:org/:context", removeRequiredContext)
+	e.POST("/api/v1/requiredcontexts/:org/:context/sync", syncRequiredContext)
 
 	// mock endpoints for user calls
 	e.GET("/api/v1/users/:user", getUser)
@@ -121,6 +289,10 @@ func FakeHandler() http.Handler {
 	e.POST("/api/v1/users", addUser)
 	e.PUT("/api/v1/users/:user", updateUser)
 	e.DELETE("/api/v1/users/:user", removeUser)
+	e.GET("/api/v1/users/:user/sessions", getUserSessions)
+	e.GET("/api/v1/user/announcements", getUserAnnouncements)
+	e.GET("/api/v1/user/scopes", getUserScopes)
+	e.GET("/api/v1/user/sessions", getUserSessions)
 
 	// mock endpoints for worker calls
 	e.GET("/api/v1/workers", getWorkers)
@@ -130,6 +302,13 @@ func FakeHandler() http.Handler {
 	e.POST("/api/v1/workers/:worker/refresh", refreshWorkerAuth)
 	e.DELETE("/api/v1/workers/:worker", removeWorker)
 
+	// mock endpoints for worker group calls
+	e.GET("/api/v1/workergroups", getWorkerGroups)
+	e.GET("/api/v1/workergroups/:group", getWorkerGroup)
+	e.POST("/api/v1/workergroups", addWorkerGroup)
+	e.PUT("/api/v1/workergroups/:group", updateWorkerGroup)
+	e.DELETE("/api/v1/workergroups/:group", removeWorkerGroup)
+
 	// mock endpoints for authentication calls
 	e.GET("/token-refresh", getTokenRefresh)
 	e.GET("/authenticate", getAuthenticate)
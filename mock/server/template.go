@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// TemplateDeprecationResp represents a JSON return for a single template deprecation.
+	TemplateDeprecationResp = `{
+  "id": 1,
+  "org": "github",
+  "repo": "octocat",
+  "path": "template.yml",
+  "ref": "v1.0.0",
+  "message": "use v2.0.0 instead",
+  "created_by": "octocat",
+  "created_at": 1563474077
+}`
+
+	// TemplateDeprecationsResp represents a JSON return for one to many template deprecations.
+	TemplateDeprecationsResp = `[
+  {
+    "id": 2,
+    "org": "github",
+    "repo": "octocat",
+    "path": "legacy.yml",
+    "message": "use template.yml instead",
+    "created_by": "octocat",
+    "created_at": 1563474078
+  },
+  {
+    "id": 1,
+    "org": "github",
+    "repo": "octocat",
+    "path": "template.yml",
+    "ref": "v1.0.0",
+    "message": "use v2.0.0 instead",
+    "created_by": "octocat",
+    "created_at": 1563474077
+  }
+]`
+)
+
+// getTemplateDeprecations returns mock JSON for a http GET.
+func getTemplateDeprecations(c *gin.Context) {
+	data := []byte(TemplateDeprecationsResp)
+
+	var body []api.TemplateDeprecation
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addTemplateDeprecation returns mock JSON for a http POST.
+func addTemplateDeprecation(c *gin.Context) {
+	data := []byte(TemplateDeprecationResp)
+
+	var body api.TemplateDeprecation
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// removeTemplateDeprecation has a param :deprecation returns mock JSON for a http DELETE.
+//
+// Pass "0" to :deprecation to test receiving a http 404 response.
+func removeTemplateDeprecation(c *gin.Context) {
+	d := c.Param("deprecation")
+
+	if strings.EqualFold(d, "0") {
+		msg := fmt.Sprintf("Template deprecation %s does not exist", d)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("Template deprecation %s removed", d))
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+)
+
+const (
+	// ActorUsagesResp represents a JSON return for one to many actor usages.
+	ActorUsagesResp = `[
+  {
+    "actor": "octocat",
+    "builds": 2,
+    "steps": 6,
+    "minutes": 3
+  },
+  {
+    "actor": "hubot",
+    "builds": 1,
+    "steps": 2,
+    "minutes": 1.5
+  }
+]`
+
+	// BudgetUsageResp represents a JSON return for a single budget usage.
+	BudgetUsageResp = `{
+  "org": "github",
+  "period": "2023-10",
+  "budget": 1000,
+  "used": 850.5,
+  "remaining": 149.5,
+  "percent": 85.05,
+  "alerted": 80
+}`
+
+	// BuildBudgetResp represents a JSON return for a single build budget.
+	BuildBudgetResp = `{
+  "id": 1,
+  "org": "github",
+  "minutes": 1000,
+  "webhook": "https://alerts.example.com/vela",
+  "period": "2023-10",
+  "alerted": 80,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474077,
+  "updated_by": "octocat"
+}`
+
+	// ClassCostsResp represents a JSON return for one to many class costs.
+	ClassCostsResp = `[
+  {
+    "class": "large:linux",
+    "builds": 10,
+    "minutes": 125.5,
+    "rate": 0.016,
+    "cost": 2.008
+  },
+  {
+    "class": "default",
+    "builds": 4,
+    "minutes": 20,
+    "rate": 0.004,
+    "cost": 0.08
+  }
+]`
+
+	// CostRateResp represents a JSON return for a single cost rate.
+	CostRateResp = `{
+  "id": 1,
+  "class": "large:linux",
+  "rate": 0.016,
+  "description": "8 vCPU linux workers",
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474077,
+  "updated_by": "octocat"
+}`
+
+	// CostRatesResp represents a JSON return for one to many cost rates.
+	CostRatesResp = `[
+  {
+    "id": 2,
+    "class": "default",
+    "rate": 0.004,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 1,
+    "class": "large:linux",
+    "rate": 0.016,
+    "description": "8 vCPU linux workers",
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+
+	// TeamUsageResp represents a JSON return for a single team usage.
+	TeamUsageResp = `{
+  "org": "github",
+  "team": "justice-league",
+  "builds": 3,
+  "steps": 8,
+  "minutes": 4.5,
+  "actors": [
+    {
+      "actor": "octocat",
+      "builds": 2,
+      "steps": 6,
+      "minutes": 3
+    },
+    {
+      "actor": "hubot",
+      "builds": 1,
+      "steps": 2,
+      "minutes": 1.5
+    }
+  ]
+}`
+)
+
+// getActorUsages returns mock JSON for a http GET.
+func getActorUsages(c *gin.Context) {
+	data := []byte(ActorUsagesResp)
+
+	var body []api.ActorUsage
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getTeamUsage returns mock JSON for a http GET.
+func getTeamUsage(c *gin.Context) {
+	data := []byte(TeamUsageResp)
+
+	var body api.TeamUsage
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getBudgetUsage returns mock JSON for a http GET.
+func getBudgetUsage(c *gin.Context) {
+	data := []byte(BudgetUsageResp)
+
+	var body api.BudgetUsage
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// updateBuildBudget returns mock JSON for a http PUT.
+func updateBuildBudget(c *gin.Context) {
+	data := []byte(BuildBudgetResp)
+
+	var body api.BuildBudget
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeBuildBudget returns mock JSON for a http DELETE.
+func removeBuildBudget(c *gin.Context) {
+	c.JSON(http.StatusOK, fmt.Sprintf("build budget deleted for org %s", c.Param("org")))
+}
+
+// getClassCosts returns mock JSON for a http GET.
+func getClassCosts(c *gin.Context) {
+	data := []byte(ClassCostsResp)
+
+	var body []api.ClassCost
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getCostRates returns mock JSON for a http GET.
+func getCostRates(c *gin.Context) {
+	data := []byte(CostRatesResp)
+
+	var body []api.CostRate
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// updateCostRate returns mock JSON for a http PUT.
+func updateCostRate(c *gin.Context) {
+	data := []byte(CostRateResp)
+
+	var body api.CostRate
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeCostRate returns mock JSON for a http DELETE.
+func removeCostRate(c *gin.Context) {
+	c.JSON(http.StatusOK, fmt.Sprintf("cost rate deleted for class %s", c.Param("class")))
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/library"
 )
@@ -46,6 +47,41 @@ const (
     "admin": false
   }
 ]`
+
+	// UserScopeResp represents a JSON return for the scopes granted to a user.
+	UserScopeResp = `{
+  "id": 1,
+  "user_id": 1,
+  "scopes": ["repo", "read:org"],
+  "required": ["repo", "read:org", "user:email"],
+  "missing": ["user:email"],
+  "consent_url": "https://vela.example.com/login?type=web",
+  "updated_at": 1563474076
+}`
+
+	// UserSessionsResp represents a JSON return for one to many sessions for a user.
+	UserSessionsResp = `[
+  {
+    "id": 2,
+    "user_id": 1,
+    "action": "refresh",
+    "ip": "1.2.3.4",
+    "user_agent": "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/118.0.0.0 Safari/537.36",
+    "client": "Chrome 118 on macOS",
+    "location": "Minneapolis, Minnesota, US",
+    "created": 1563474976
+  },
+  {
+    "id": 1,
+    "user_id": 1,
+    "action": "login",
+    "ip": "1.2.3.4",
+    "user_agent": "vela-cli",
+    "client": "Vela CLI",
+    "location": "Minneapolis, Minnesota, US",
+    "created": 1563474076
+  }
+]`
 )
 
 // getUsers returns mock JSON for a http GET.
@@ -130,3 +166,23 @@ func removeUser(c *gin.Context) {
 
 	c.JSON(http.StatusOK, fmt.Sprintf("User %s removed", u))
 }
+
+// getUserScopes returns mock JSON for a http GET.
+func getUserScopes(c *gin.Context) {
+	data := []byte(UserScopeResp)
+
+	var body api.UserScope
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getUserSessions returns mock JSON for a http GET.
+func getUserSessions(c *gin.Context) {
+	data := []byte(UserSessionsResp)
+
+	var body []api.UserSession
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
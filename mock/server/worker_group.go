@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// WorkerGroupResp represents a JSON return for a single worker group.
+	WorkerGroupResp = `{
+  "id": 1,
+  "name": "compliance",
+  "description": "builds subject to compliance review",
+  "route": "compliance",
+  "build_limit": 10,
+  "orgs": [
+    "github"
+  ],
+  "repos": [
+    "octocat/hello-world"
+  ],
+  "active": true,
+  "created_at": 1563474077,
+  "created_by": "octocat",
+  "updated_at": 1563474078,
+  "updated_by": "octocat"
+}`
+
+	// WorkerGroupsResp represents a JSON return for one to many worker groups.
+	WorkerGroupsResp = `[
+  {
+    "id": 1,
+    "name": "compliance",
+    "description": "builds subject to compliance review",
+    "route": "compliance",
+    "build_limit": 10,
+    "orgs": [
+      "github"
+    ],
+    "repos": [
+      "octocat/hello-world"
+    ],
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474078,
+    "updated_by": "octocat"
+  },
+  {
+    "id": 2,
+    "name": "general",
+    "route": "vela",
+    "build_limit": 100,
+    "orgs": [
+      "octocat"
+    ],
+    "active": true,
+    "created_at": 1563474077,
+    "created_by": "octocat",
+    "updated_at": 1563474077,
+    "updated_by": "octocat"
+  }
+]`
+)
+
+// getWorkerGroups returns mock JSON for a http GET.
+func getWorkerGroups(c *gin.Context) {
+	data := []byte(WorkerGroupsResp)
+
+	var body []api.WorkerGroup
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// getWorkerGroup has a param :group returns mock JSON for a http GET.
+//
+// Pass "0" to :group to test receiving a http 404 response.
+func getWorkerGroup(c *gin.Context) {
+	g := c.Param("group")
+
+	if strings.EqualFold(g, "0") {
+		msg := fmt.Sprintf("Worker group %s does not exist", g)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(WorkerGroupResp)
+
+	var body api.WorkerGroup
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// addWorkerGroup returns mock JSON for a http POST.
+func addWorkerGroup(c *gin.Context) {
+	data := []byte(WorkerGroupResp)
+
+	var body api.WorkerGroup
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// updateWorkerGroup has a param :group returns mock JSON for a http PUT.
+//
+// Pass "0" to :group to test receiving a http 404 response.
+func updateWorkerGroup(c *gin.Context) {
+	g := c.Param("group")
+
+	if strings.EqualFold(g, "0") {
+		msg := fmt.Sprintf("Worker group %s does not exist", g)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	data := []byte(WorkerGroupResp)
+
+	var body api.WorkerGroup
+	_ = json.Unmarshal(data, &body)
+
+	c.JSON(http.StatusOK, body)
+}
+
+// removeWorkerGroup has a param :group returns mock JSON for a http DELETE.
+//
+// Pass "0" to :group to test receiving a http 404 response.
+func removeWorkerGroup(c *gin.Context) {
+	g := c.Param("group")
+
+	if strings.EqualFold(g, "0") {
+		msg := fmt.Sprintf("Worker group %s does not exist", g)
+
+		c.AbortWithStatusJSON(http.StatusNotFound, types.Error{Message: &msg})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, fmt.Sprintf("worker group %s deleted", g))
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package partitioner provides the ability for Vela to periodically
+// maintain the monthly partitions of the builds and logs tables by
+// creating the partitions for the upcoming months and dropping the
+// partitions older than the retention. Dropping a partition is a
+// cheap way to clean up old rows compared to deleting them.
+//
+// Partitioning is opt-in since the unique keys of a partitioned table
+// are only enforced within a partition. When enabled, the builds and
+// logs tables are converted into tables partitioned by month the first
+// time the partitions are maintained. Native partitioning is only
+// supported for Postgres.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/partitioner"
+package partitioner
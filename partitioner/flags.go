@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partitioner
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the partitioner.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Partitioner Flags
+
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_PARTITION_ENABLED", "PARTITION_ENABLED"},
+		FilePath: "/vela/partition/enabled",
+		Name:     "partition.enabled",
+		Usage:    "enables partitioning the builds and logs tables by month (only supported for postgres)",
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_PARTITION_INTERVAL", "PARTITION_INTERVAL"},
+		FilePath: "/vela/partition/interval",
+		Name:     "partition.interval",
+		Usage:    "interval at which to maintain the monthly partitions of the builds and logs tables",
+		Value:    24 * time.Hour,
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_PARTITION_PREMAKE", "PARTITION_PREMAKE"},
+		FilePath: "/vela/partition/premake",
+		Name:     "partition.premake",
+		Usage:    "number of monthly partitions to create ahead of the current month",
+		Value:    3,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_PARTITION_RETENTION", "PARTITION_RETENTION"},
+		FilePath: "/vela/partition/retention",
+		Name:     "partition.retention",
+		Usage:    "duration to keep the rows of a partition before the partition is dropped (kept forever when set to 0)",
+		Value:    0,
+	},
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partitioner
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the partitioner.
+type Opt func(*Partitioner) error
+
+// WithDatabase sets the database service in the partitioner.
+func WithDatabase(db database.Service) Opt {
+	return func(p *Partitioner) error {
+		// set the database service in the partitioner
+		p.database = db
+
+		return nil
+	}
+}
+
+// WithEnabled sets whether the builds and logs tables are partitioned in the partitioner.
+func WithEnabled(enabled bool) Opt {
+	return func(p *Partitioner) error {
+		// set the enabled setting in the partitioner
+		p.config.Enabled = enabled
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to maintain the partitions in the partitioner.
+func WithInterval(interval time.Duration) Opt {
+	return func(p *Partitioner) error {
+		// check if the interval provided is positive
+		if interval <= 0 {
+			return fmt.Errorf("invalid partition interval provided: %s", interval)
+		}
+
+		// set the interval in the partitioner
+		p.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithPremake sets the number of monthly partitions to
+// create ahead of the current month in the partitioner.
+func WithPremake(premake int) Opt {
+	return func(p *Partitioner) error {
+		// check if the premake provided is positive
+		if premake <= 0 {
+			return fmt.Errorf("invalid partition premake provided: %d", premake)
+		}
+
+		// set the premake in the partitioner
+		p.config.Premake = premake
+
+		return nil
+	}
+}
+
+// WithRetention sets the duration to keep the rows of
+// a partition before dropping it in the partitioner.
+func WithRetention(retention time.Duration) Opt {
+	return func(p *Partitioner) error {
+		// check if the retention provided is negative
+		if retention < 0 {
+			return fmt.Errorf("invalid partition retention provided: %s", retention)
+		}
+
+		// set the retention in the partitioner
+		p.config.Retention = retention
+
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partitioner
+
+import (
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the partitioner.
+	config struct {
+		// specifies whether the builds and logs tables are partitioned
+		Enabled bool
+		// specifies the interval at which to maintain the partitions
+		Interval time.Duration
+		// specifies the number of monthly partitions to create ahead of the current month
+		Premake int
+		// specifies the duration to keep the rows of a partition before dropping it
+		Retention time.Duration
+	}
+
+	// Partitioner represents the functionality for maintaining
+	// the monthly partitions of the builds and logs tables.
+	Partitioner struct {
+		// partitioner configuration settings
+		config *config
+
+		// database service used to create and drop the partitions
+		database database.Service
+	}
+)
+
+// New creates and returns a partitioner for the builds and logs tables.
+func New(opts ...Opt) (*Partitioner, error) {
+	// create new partitioner
+	p := new(Partitioner)
+
+	// create new fields
+	p.config = &config{
+		Interval: 24 * time.Hour,
+		Premake:  3,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Enabled returns whether the partitioner is configured
+// to partition the builds and logs tables by month.
+func (p *Partitioner) Enabled() bool {
+	return p.config.Enabled
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partitioner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPartitioner_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		enabled bool
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+		},
+		{
+			name:    "enabled",
+			failure: false,
+			opts: []Opt{
+				WithEnabled(true),
+				WithInterval(24 * time.Hour),
+				WithPremake(2),
+				WithRetention(90 * 24 * time.Hour),
+			},
+			enabled: true,
+		},
+		{
+			name:    "zero interval",
+			failure: true,
+			opts:    []Opt{WithInterval(0)},
+		},
+		{
+			name:    "negative interval",
+			failure: true,
+			opts:    []Opt{WithInterval(-time.Minute)},
+		},
+		{
+			name:    "invalid premake",
+			failure: true,
+			opts:    []Opt{WithPremake(0)},
+		},
+		{
+			name:    "negative retention",
+			failure: true,
+			opts:    []Opt{WithRetention(-time.Hour)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partitioner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/partition"
+	"github.com/sirupsen/logrus"
+)
+
+// Start maintains the partitions at the configured
+// interval until the provided channel is closed.
+//
+// The partitions are maintained once when started so the
+// partitions for the upcoming months exist without waiting
+// for the first interval to elapse.
+func (p *Partitioner) Start(dying <-chan struct{}) error {
+	logrus.Infof("maintaining partitions every %s", p.config.Interval)
+
+	_, _, err := p.Run(time.Now().UTC())
+	if err != nil {
+		logrus.Errorf("unable to maintain partitions: %v", err)
+	}
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, _, err := p.Run(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to maintain partitions: %v", err)
+			}
+		}
+	}
+}
+
+// Run converts the builds and logs tables that aren't partitioned yet,
+// creates the partitions for the current and upcoming months that don't
+// exist yet and drops the partitions holding only rows older than the
+// configured retention for each partitioned table. It returns the lists
+// of partitions that were created and dropped.
+func (p *Partitioner) Run(now time.Time) ([]*api.Partition, []*api.Partition, error) {
+	logrus.Trace("maintaining partitions")
+
+	// variables to store the partitions created and dropped
+	created := []*api.Partition{}
+	dropped := []*api.Partition{}
+
+	// send API call to convert the tables that aren't partitioned yet
+	err := p.database.PartitionTables(context.Background())
+	if err != nil {
+		return created, dropped, fmt.Errorf("unable to partition tables: %w", err)
+	}
+
+	for _, table := range partition.Tables() {
+		// send API call to capture the partitions for the table
+		partitions, err := p.database.ListPartitions(context.Background(), table)
+		if err != nil {
+			return created, dropped, fmt.Errorf("unable to list partitions for %s table: %w", table, err)
+		}
+
+		for _, month := range missing(partitions, now, p.config.Premake) {
+			logrus.Infof("creating partition %s", partition.Name(table, month))
+
+			// send API call to create the partition for the month
+			c, err := p.database.CreatePartition(context.Background(), table, month)
+			if err != nil {
+				logrus.Errorf("unable to create partition %s: %v", partition.Name(table, month), err)
+
+				continue
+			}
+
+			created = append(created, c)
+		}
+
+		// check if the partitions are kept forever
+		if p.config.Retention == 0 {
+			continue
+		}
+
+		for _, e := range expired(partitions, now.Add(-p.config.Retention)) {
+			logrus.Infof("dropping partition %s", e.GetName())
+
+			// send API call to drop the partition
+			err = p.database.DropPartition(context.Background(), e)
+			if err != nil {
+				logrus.Errorf("unable to drop partition %s: %v", e.GetName(), err)
+
+				continue
+			}
+
+			dropped = append(dropped, e)
+		}
+	}
+
+	return created, dropped, nil
+}
+
+// missing is a helper function to capture the start of the current
+// and upcoming months that aren't covered by any of the partitions.
+// The month is covered when a partition holds the start of the month.
+func missing(partitions []*api.Partition, now time.Time, premake int) []time.Time {
+	months := []time.Time{}
+
+	now = now.UTC()
+	current := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i <= premake; i++ {
+		month := current.AddDate(0, i, 0)
+
+		if !covered(partitions, month.Unix()) {
+			months = append(months, month)
+		}
+	}
+
+	return months
+}
+
+// covered is a helper function to check if any of the
+// range partitions holds the rows created at the timestamp.
+func covered(partitions []*api.Partition, timestamp int64) bool {
+	for _, p := range partitions {
+		// the default partition has no range
+		if p.GetEnd() == 0 {
+			continue
+		}
+
+		if p.GetStart() <= timestamp && timestamp < p.GetEnd() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expired is a helper function to capture the range
+// partitions holding only rows created before the cutoff.
+func expired(partitions []*api.Partition, cutoff time.Time) []*api.Partition {
+	list := []*api.Partition{}
+
+	for _, p := range partitions {
+		// the default partition has no range and is never dropped
+		if p.GetEnd() == 0 {
+			continue
+		}
+
+		if p.GetEnd() <= cutoff.Unix() {
+			list = append(list, p)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package partitioner
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestPartitioner_missing(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	october := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	november := time.Date(2023, time.November, 1, 0, 0, 0, 0, time.UTC)
+	december := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+
+	_default := testPartition("builds_default", 0, 0)
+	_legacy := testPartition("builds_legacy", 0, november.Unix())
+	_november := testPartition("builds_y2023m11", november.Unix(), december.Unix())
+
+	// setup tests
+	tests := []struct {
+		name       string
+		partitions []*api.Partition
+		premake    int
+		want       []time.Time
+	}{
+		{
+			name:       "no partitions",
+			partitions: []*api.Partition{},
+			premake:    2,
+			want:       []time.Time{october, november, december},
+		},
+		{
+			name:       "only default partition",
+			partitions: []*api.Partition{_default},
+			premake:    1,
+			want:       []time.Time{october, november},
+		},
+		{
+			name:       "legacy and monthly partitions",
+			partitions: []*api.Partition{_default, _legacy, _november},
+			premake:    2,
+			want:       []time.Time{december},
+		},
+		{
+			name:       "all months covered",
+			partitions: []*api.Partition{_default, _legacy, _november},
+			premake:    1,
+			want:       []time.Time{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := missing(test.partitions, now, test.premake)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("missing is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPartitioner_expired(t *testing.T) {
+	// setup types
+	august := time.Date(2023, time.August, 1, 0, 0, 0, 0, time.UTC)
+	september := time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC)
+	october := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	_default := testPartition("logs_default", 0, 0)
+	_legacy := testPartition("logs_legacy", 0, august.Unix())
+	_august := testPartition("logs_y2023m08", august.Unix(), september.Unix())
+	_september := testPartition("logs_y2023m09", september.Unix(), october.Unix())
+
+	partitions := []*api.Partition{_default, _legacy, _august, _september}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		cutoff time.Time
+		want   []*api.Partition
+	}{
+		{
+			name:   "cutoff before every partition ends",
+			cutoff: august.Add(-time.Hour),
+			want:   []*api.Partition{},
+		},
+		{
+			name:   "cutoff at the end of a partition",
+			cutoff: september,
+			want:   []*api.Partition{_legacy, _august},
+		},
+		{
+			name:   "cutoff within a partition",
+			cutoff: september.Add(14 * 24 * time.Hour),
+			want:   []*api.Partition{_legacy, _august},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := expired(partitions, test.cutoff)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("expired is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+// testPartition is a test helper function to create
+// a partition with the provided name and range.
+func testPartition(name string, start, end int64) *api.Partition {
+	p := new(api.Partition)
+	p.SetName(name)
+	p.SetStart(start)
+	p.SetEnd(end)
+
+	return p
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the placer in the context.
+const key = "placement"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the placer
+// associated with this context.
+func FromContext(c context.Context) *Placer {
+	// get placer value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast placer value to expected Placer type
+	p, ok := v.(*Placer)
+	if !ok {
+		return nil
+	}
+
+	return p
+}
+
+// ToContext adds the placer to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, p *Placer) {
+	c.Set(key, p)
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package placement provides the ability for Vela to hint which
+// worker a build should run on when multiple workers are eligible,
+// using a pluggable strategy and the historical build durations
+// for the repo.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/placement"
+package placement
@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for placing builds.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Placement Flags
+
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_PLACEMENT_STRATEGY", "PLACEMENT_STRATEGY"},
+		FilePath: "/vela/placement/strategy",
+		Name:     "placement.strategy",
+		Usage:    "strategy used to choose the worker for a build when multiple workers subscribed to a route named after their hostname are eligible (none or binpack)",
+		Value:    StrategyNone,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_PLACEMENT_LONG_BUILD", "PLACEMENT_LONG_BUILD"},
+		FilePath: "/vela/placement/long_build",
+		Name:     "placement.long-build",
+		Usage:    "predicted duration a build is considered long running for when placing builds",
+		Value:    10 * time.Minute,
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_PLACEMENT_HISTORY", "PLACEMENT_HISTORY"},
+		FilePath: "/vela/placement/history",
+		Name:     "placement.history",
+		Usage:    "number of recent successful builds for a repo used to predict the duration of a build",
+		Value:    10,
+	},
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the placer.
+type Opt func(*Placer) error
+
+// WithDatabase sets the database service in the placer.
+func WithDatabase(db database.Service) Opt {
+	return func(p *Placer) error {
+		// set the database service in the placer
+		p.database = db
+
+		return nil
+	}
+}
+
+// WithStrategy sets the strategy used to choose the worker in the placer.
+func WithStrategy(name string) Opt {
+	return func(p *Placer) error {
+		// capture the strategy registered with the name
+		s, ok := strategies[name]
+		if !ok {
+			return fmt.Errorf("invalid placement strategy provided: %s", name)
+		}
+
+		// set the strategy in the placer
+		p.config.Strategy = s
+
+		return nil
+	}
+}
+
+// WithLongBuild sets the predicted duration a
+// build is considered long running for in the placer.
+func WithLongBuild(duration time.Duration) Opt {
+	return func(p *Placer) error {
+		// check if the duration provided is positive
+		if duration <= 0 {
+			return fmt.Errorf("invalid placement long build duration provided: %s", duration)
+		}
+
+		// set the long build duration in the placer
+		p.config.LongBuild = duration
+
+		return nil
+	}
+}
+
+// WithHistory sets the number of recent builds for a
+// repo used to predict the duration in the placer.
+func WithHistory(history int) Opt {
+	return func(p *Placer) error {
+		// check if the history provided is positive
+		if history <= 0 {
+			return fmt.Errorf("invalid placement history provided: %d", history)
+		}
+
+		// set the history in the placer
+		p.config.History = history
+
+		return nil
+	}
+}
+
+// WithActiveInterval sets the duration since the last check-in
+// a worker is considered active for in the placer.
+func WithActiveInterval(interval time.Duration) Opt {
+	return func(p *Placer) error {
+		// check if the active interval provided is positive
+		if interval <= 0 {
+			return fmt.Errorf("invalid worker active interval provided: %s", interval)
+		}
+
+		// set the active interval in the placer
+		p.config.ActiveInterval = interval
+
+		return nil
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the placer.
+	config struct {
+		// specifies the strategy used to choose the worker for a build
+		Strategy Strategy
+		// specifies the predicted duration a build is considered long running for
+		LongBuild time.Duration
+		// specifies the number of recent builds for a repo used to predict the duration
+		History int
+		// specifies the duration since the last check-in a worker is considered active for
+		ActiveInterval time.Duration
+	}
+
+	// Placer represents the functionality for choosing
+	// the worker a build should prefer when published.
+	Placer struct {
+		// placer configuration settings
+		config *config
+
+		// database service used to capture the workers and builds
+		database database.Service
+	}
+)
+
+// New creates and returns a placer for builds.
+func New(opts ...Opt) (*Placer, error) {
+	// create new placer
+	p := new(Placer)
+
+	// create new fields
+	p.config = &config{
+		Strategy:       strategies[StrategyNone],
+		LongBuild:      10 * time.Minute,
+		History:        10,
+		ActiveInterval: 5 * time.Minute,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// Enabled returns whether the placer is configured
+// with a strategy that chooses workers for builds.
+func (p *Placer) Enabled() bool {
+	return p != nil && p.config.Strategy.Name() != StrategyNone
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlacement_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		enabled bool
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+			enabled: false,
+		},
+		{
+			name:    "with binpack strategy",
+			failure: false,
+			opts: []Opt{
+				WithStrategy(StrategyBinPack),
+				WithLongBuild(30 * time.Minute),
+				WithHistory(20),
+				WithActiveInterval(time.Minute),
+			},
+			enabled: true,
+		},
+		{
+			name:    "invalid strategy",
+			failure: true,
+			opts:    []Opt{WithStrategy("random")},
+		},
+		{
+			name:    "invalid long build",
+			failure: true,
+			opts:    []Opt{WithLongBuild(0)},
+		},
+		{
+			name:    "invalid history",
+			failure: true,
+			opts:    []Opt{WithHistory(0)},
+		},
+		{
+			name:    "invalid active interval",
+			failure: true,
+			opts:    []Opt{WithActiveInterval(0)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.Enabled() != test.enabled {
+				t.Errorf("Enabled is %v, want %v", got.Enabled(), test.enabled)
+			}
+		})
+	}
+}
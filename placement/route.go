@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// Route returns the route the build for the repo should be published
+// to. When multiple active workers subscribed to the route are also
+// subscribed to a route named after their hostname, the strategy
+// chooses one of them and its hostname is returned. Otherwise, or
+// when the workers can't be captured, the provided route is returned.
+func (p *Placer) Route(ctx context.Context, r *library.Repo, route string) string {
+	if !p.Enabled() {
+		return route
+	}
+
+	hint, err := p.place(ctx, r, route, time.Now().UTC())
+	if err != nil {
+		logrus.Errorf("unable to place build for %s on route %s: %v", r.GetFullName(), route, err)
+
+		return route
+	}
+
+	if len(hint) == 0 {
+		return route
+	}
+
+	logrus.Infof("Placing build for %s from route %s on worker %s", r.GetFullName(), route, hint)
+
+	return hint
+}
+
+// place is a helper function to capture the hostname of
+// the worker chosen by the strategy for the build.
+func (p *Placer) place(ctx context.Context, r *library.Repo, route string, now time.Time) (string, error) {
+	// send API call to capture the workers
+	workers, err := p.database.ListWorkers(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to list workers: %w", err)
+	}
+
+	eligible := p.eligible(workers, route, now)
+
+	// skip when there is no choice to make
+	if len(eligible) < 2 {
+		return "", nil
+	}
+
+	// send API call to capture the running builds for each worker
+	running, err := p.database.GetBuildCountByHost(ctx, constants.StatusRunning)
+	if err != nil {
+		return "", fmt.Errorf("unable to get count of running builds by host: %w", err)
+	}
+
+	candidates := []*Candidate{}
+
+	for _, w := range eligible {
+		candidates = append(candidates, &Candidate{
+			Worker:  w,
+			Running: running[w.GetHostname()],
+		})
+	}
+
+	duration, err := p.predict(ctx, r, now)
+	if err != nil {
+		return "", err
+	}
+
+	b := &Build{
+		Repo:     r,
+		Duration: duration,
+		Long:     duration >= p.config.LongBuild,
+	}
+
+	c := p.config.Strategy.Choose(b, candidates)
+	if c == nil {
+		return "", nil
+	}
+
+	return c.Worker.GetHostname(), nil
+}
+
+// eligible is a helper function to capture the active workers subscribed
+// to the route and to a route named after their hostname.
+func (p *Placer) eligible(workers []*library.Worker, route string, now time.Time) []*library.Worker {
+	eligible := []*library.Worker{}
+
+	// capture the unix time from the active interval ago
+	before := now.Add(-p.config.ActiveInterval).Unix()
+
+	for _, w := range workers {
+		if !w.GetActive() || w.GetLastCheckedIn() < before || w.GetBuildLimit() <= 0 {
+			continue
+		}
+
+		var shared, dedicated bool
+
+		for _, r := range w.GetRoutes() {
+			shared = shared || r == route
+			dedicated = dedicated || r == w.GetHostname()
+		}
+
+		if shared && dedicated {
+			eligible = append(eligible, w)
+		}
+	}
+
+	return eligible
+}
+
+// predict is a helper function to predict the duration of the
+// build from the average duration of the recent successful builds
+// for the repo. It returns 0 when the repo has no history.
+func (p *Placer) predict(ctx context.Context, r *library.Repo, now time.Time) (time.Duration, error) {
+	filters := map[string]interface{}{
+		"status": constants.StatusSuccess,
+	}
+
+	// send API call to capture the recent successful builds for the repo
+	builds, _, err := p.database.GetRepoBuildList(ctx, r, filters, now.Unix(), 0, 1, p.config.History)
+	if err != nil {
+		return 0, fmt.Errorf("unable to list builds for %s: %w", r.GetFullName(), err)
+	}
+
+	var total, count int64
+
+	for _, b := range builds {
+		if b.GetStarted() <= 0 || b.GetFinished() < b.GetStarted() {
+			continue
+		}
+
+		total += b.GetFinished() - b.GetStarted()
+		count++
+	}
+
+	if count == 0 {
+		return 0, nil
+	}
+
+	return time.Duration(total/count) * time.Second, nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestPlacement_Route(t *testing.T) {
+	// setup types
+	now := time.Now().UTC()
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	workers := []struct {
+		hostname string
+		routes   []string
+		checkIn  time.Time
+	}{
+		{hostname: "worker_0", routes: []string{"vela", "worker_0"}, checkIn: now},
+		{hostname: "worker_1", routes: []string{"vela", "worker_1"}, checkIn: now},
+		{hostname: "worker_2", routes: []string{"vela"}, checkIn: now},
+		{hostname: "worker_3", routes: []string{"vela", "worker_3"}, checkIn: now.Add(-time.Hour)},
+	}
+
+	for i, worker := range workers {
+		w := new(library.Worker)
+		w.SetID(int64(i + 1))
+		w.SetHostname(worker.hostname)
+		w.SetAddress("http://" + worker.hostname)
+		w.SetRoutes(worker.routes)
+		w.SetActive(true)
+		w.SetLastCheckedIn(worker.checkIn.Unix())
+		w.SetBuildLimit(4)
+
+		err = db.CreateWorker(context.TODO(), w)
+		if err != nil {
+			t.Errorf("unable to create test worker: %v", err)
+		}
+	}
+
+	// a short successful build for the repo along with
+	// a running build on one of the eligible workers
+	builds := []struct {
+		status   string
+		host     string
+		started  int64
+		finished int64
+	}{
+		{status: constants.StatusSuccess, host: "worker_0", started: 10, finished: 70},
+		{status: constants.StatusRunning, host: "worker_1", started: now.Unix()},
+	}
+
+	for i, build := range builds {
+		b := new(library.Build)
+		b.SetID(int64(i + 1))
+		b.SetRepoID(1)
+		b.SetNumber(i + 1)
+		b.SetStatus(build.status)
+		b.SetHost(build.host)
+		b.SetCreated(now.Add(-time.Hour).Unix())
+		b.SetStarted(build.started)
+		b.SetFinished(build.finished)
+
+		err = db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+	}
+
+	// setup tests
+	tests := []struct {
+		name     string
+		strategy string
+		route    string
+		want     string
+	}{
+		{
+			name:     "none strategy",
+			strategy: StrategyNone,
+			route:    "vela",
+			want:     "vela",
+		},
+		{
+			name:     "binpack strategy",
+			strategy: StrategyBinPack,
+			route:    "vela",
+			want:     "worker_1",
+		},
+		{
+			name:     "binpack strategy without eligible workers",
+			strategy: StrategyBinPack,
+			route:    "docker",
+			want:     "docker",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := New(
+				WithDatabase(db),
+				WithStrategy(test.strategy),
+			)
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			got := p.Route(context.TODO(), _repo, test.route)
+
+			if got != test.want {
+				t.Errorf("Route is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlacement_Route_Nil(t *testing.T) {
+	// setup types
+	var p *Placer
+
+	// run test
+	got := p.Route(context.TODO(), new(library.Repo), "vela")
+
+	if got != "vela" {
+		t.Errorf("Route is %s, want vela", got)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"time"
+
+	"github.com/go-vela/types/library"
+)
+
+const (
+	// StrategyNone represents not choosing a worker, leaving
+	// the build on the route for any eligible worker.
+	StrategyNone = "none"
+
+	// StrategyBinPack represents packing short builds onto busy workers
+	// and reserving idle workers for builds predicted to be long running.
+	StrategyBinPack = "binpack"
+)
+
+type (
+	// Build represents the build being placed along
+	// with the duration predicted from its history.
+	Build struct {
+		// repo the build is for
+		Repo *library.Repo
+		// duration predicted for the build, 0 when there is no history
+		Duration time.Duration
+		// whether the build is predicted to be long running
+		Long bool
+	}
+
+	// Candidate represents a worker eligible to run
+	// the build along with the builds it is running.
+	Candidate struct {
+		// worker eligible to run the build
+		Worker *library.Worker
+		// number of builds running on the worker
+		Running int64
+	}
+
+	// Strategy represents the interface for choosing the
+	// worker a build should prefer out of the candidates.
+	Strategy interface {
+		// Name returns the name the strategy is registered with.
+		Name() string
+
+		// Choose returns the candidate the build should
+		// prefer, or nil when it has no preference.
+		Choose(*Build, []*Candidate) *Candidate
+	}
+)
+
+// strategies represents the strategies available by name.
+var strategies = map[string]Strategy{
+	StrategyNone:    none{},
+	StrategyBinPack: binPack{},
+}
+
+// Register makes the strategy available to
+// the placer by the name it returns.
+func Register(s Strategy) {
+	strategies[s.Name()] = s
+}
+
+// Available returns the number of builds the
+// candidate can run in addition to its running builds.
+func (c *Candidate) Available() int64 {
+	return c.Worker.GetBuildLimit() - c.Running
+}
+
+// none represents the strategy that never chooses a worker.
+type none struct{}
+
+// Name returns the name the strategy is registered with.
+func (none) Name() string {
+	return StrategyNone
+}
+
+// Choose returns no preference for the build.
+func (none) Choose(*Build, []*Candidate) *Candidate {
+	return nil
+}
+
+// binPack represents the strategy that packs short builds onto busy
+// workers and reserves idle workers for long running builds.
+type binPack struct{}
+
+// Name returns the name the strategy is registered with.
+func (binPack) Name() string {
+	return StrategyBinPack
+}
+
+// Choose returns the candidate the build should prefer. Builds
+// predicted to be long running prefer the idle worker with the most
+// capacity, while short builds prefer the busy worker with the least
+// capacity left so idle workers stay free. Builds without any history
+// have no preference.
+func (binPack) Choose(b *Build, candidates []*Candidate) *Candidate {
+	if b.Duration <= 0 {
+		return nil
+	}
+
+	var idle, busy *Candidate
+
+	for _, c := range candidates {
+		// skip workers without capacity for the build
+		if c.Available() <= 0 {
+			continue
+		}
+
+		if c.Running == 0 {
+			// long builds prefer the largest idle worker while
+			// short builds fall back to the smallest idle worker
+			if idle == nil ||
+				(b.Long && c.Worker.GetBuildLimit() > idle.Worker.GetBuildLimit()) ||
+				(!b.Long && c.Worker.GetBuildLimit() < idle.Worker.GetBuildLimit()) {
+				idle = c
+			}
+
+			continue
+		}
+
+		// long builds fall back to the busy worker with the most capacity
+		// while short builds prefer the busy worker with the least capacity
+		if busy == nil ||
+			(b.Long && c.Available() > busy.Available()) ||
+			(!b.Long && c.Available() < busy.Available()) {
+			busy = c
+		}
+	}
+
+	if b.Long {
+		if idle != nil {
+			return idle
+		}
+
+		return busy
+	}
+
+	if busy != nil {
+		return busy
+	}
+
+	return idle
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package placement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-vela/types/library"
+)
+
+// testCandidate is a helper function to create a candidate
+// for the worker with the build limit and running builds.
+func testCandidate(hostname string, limit, running int64) *Candidate {
+	w := new(library.Worker)
+	w.SetHostname(hostname)
+	w.SetBuildLimit(limit)
+
+	return &Candidate{Worker: w, Running: running}
+}
+
+func TestPlacement_BinPack_Choose(t *testing.T) {
+	// setup types
+	candidates := []*Candidate{
+		testCandidate("idle-small", 2, 0),
+		testCandidate("idle-large", 4, 0),
+		testCandidate("busy-roomy", 4, 1),
+		testCandidate("busy-tight", 4, 3),
+		testCandidate("full", 2, 2),
+	}
+
+	// setup tests
+	tests := []struct {
+		name       string
+		build      *Build
+		candidates []*Candidate
+		want       string
+	}{
+		{
+			name:       "no history",
+			build:      &Build{},
+			candidates: candidates,
+			want:       "",
+		},
+		{
+			name:       "short build",
+			build:      &Build{Duration: time.Minute},
+			candidates: candidates,
+			want:       "busy-tight",
+		},
+		{
+			name:       "long build",
+			build:      &Build{Duration: time.Hour, Long: true},
+			candidates: candidates,
+			want:       "idle-large",
+		},
+		{
+			name:       "short build without busy workers",
+			build:      &Build{Duration: time.Minute},
+			candidates: candidates[:2],
+			want:       "idle-small",
+		},
+		{
+			name:       "long build without idle workers",
+			build:      &Build{Duration: time.Hour, Long: true},
+			candidates: candidates[2:],
+			want:       "busy-roomy",
+		},
+		{
+			name:       "no capacity",
+			build:      &Build{Duration: time.Minute},
+			candidates: candidates[4:],
+			want:       "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var got string
+
+			c := binPack{}.Choose(test.build, test.candidates)
+			if c != nil {
+				got = c.Worker.GetHostname()
+			}
+
+			if got != test.want {
+				t.Errorf("Choose is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPlacement_Register(t *testing.T) {
+	// setup types
+	s := named("custom")
+
+	// run test
+	Register(s)
+	defer delete(strategies, "custom")
+
+	p, err := New(WithStrategy("custom"))
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	if !p.Enabled() {
+		t.Errorf("Enabled is false, want true")
+	}
+}
+
+// named represents a strategy with a
+// custom name that never chooses a worker.
+type named string
+
+// Name returns the name the strategy is registered with.
+func (n named) Name() string {
+	return string(n)
+}
+
+// Choose returns no preference for the build.
+func (named) Choose(*Build, []*Candidate) *Candidate {
+	return nil
+}
@@ -34,7 +34,7 @@ var Flags = []cli.Flag{
 		EnvVars:  []string{"VELA_QUEUE_CLUSTER", "QUEUE_CLUSTER"},
 		FilePath: "/vela/queue/cluster",
 		Name:     "queue.cluster",
-		Usage:    "enables connecting to a queue cluster",
+		Usage:    "enables connecting to a queue cluster (replicates the stream across 3 servers for the nats driver)",
 	},
 	&cli.StringSliceFlag{
 		EnvVars:  []string{"VELA_QUEUE_ROUTES", "QUEUE_ROUTES"},
@@ -50,4 +50,36 @@ var Flags = []cli.Flag{
 		Usage:    "timeout for requests that pop items off the queue",
 		Value:    60 * time.Second,
 	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_QUEUE_FAIR_SHARE", "QUEUE_FAIR_SHARE"},
+		FilePath: "/vela/queue/fair_share",
+		Name:     "queue.fair-share",
+		Usage:    "schedule builds on a route fairly by org or repo using weighted round robin (empty disables fair scheduling, not supported by the nats or postgres drivers)",
+	},
+	&cli.StringSliceFlag{
+		EnvVars:  []string{"VELA_QUEUE_SHARE_WEIGHTS", "QUEUE_SHARE_WEIGHTS"},
+		FilePath: "/vela/queue/share_weights",
+		Name:     "queue.share-weights",
+		Usage:    "list of <org>=<weight> or <org>/<repo>=<weight> shares for scheduling builds fairly",
+	},
+	&cli.Int64Flag{
+		EnvVars:  []string{"VELA_QUEUE_DEFAULT_SHARE_WEIGHT", "QUEUE_DEFAULT_SHARE_WEIGHT"},
+		FilePath: "/vela/queue/default_share_weight",
+		Name:     "queue.default-share-weight",
+		Usage:    "weight for orgs or repos without a share when scheduling builds fairly",
+		Value:    1,
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_QUEUE_COMPRESSION", "QUEUE_COMPRESSION"},
+		FilePath: "/vela/queue/compression",
+		Name:     "queue.compression",
+		Usage:    "enables compressing items published to the queue with zstd (workers must support popping compressed items)",
+	},
+	&cli.Int64Flag{
+		EnvVars:  []string{"VELA_QUEUE_MAX_ITEM_SIZE", "QUEUE_MAX_ITEM_SIZE"},
+		FilePath: "/vela/queue/max_item_size",
+		Name:     "queue.max-item-size",
+		Usage:    "max size in bytes of items published to the queue after compression (0 disables the limit)",
+		Value:    10 * 1024 * 1024,
+	},
 }
@@ -0,0 +1,15 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package nats provides the ability for Vela to integrate
+// with a NATS JetStream server as a queue backend.
+//
+// Items are published to a work queue stream on a subject for each
+// route and popped through a durable pull consumer for each route,
+// so any number of servers and workers can share the same queue.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/queue/nats"
+package nats
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+// DriverNATS defines the driver type when integrating with a NATS JetStream queue.
+const DriverNATS = "nats"
+
+// Driver outputs the configured queue driver.
+func (c *client) Driver() string {
+	return DriverNATS
+}
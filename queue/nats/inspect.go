@@ -0,0 +1,253 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+	"github.com/nats-io/nats.go"
+)
+
+// moveBatch represents the max number of items
+// fetched at once when moving items between routes.
+const moveBatch = 100
+
+// Inspect captures the bytes stored by the stream and the items waiting
+// on the routes, defaulting to the configured channels, with the
+// metadata for up to the provided number of items on each route.
+func (c *client) Inspect(ctx context.Context, routes []string, sample int) (*api.QueueSummary, error) {
+	c.Logger.Tracef("inspecting queue routes %s", routes)
+
+	if len(routes) == 0 {
+		routes = c.config.Channels
+	}
+
+	summary := new(api.QueueSummary)
+
+	// capture the bytes stored by the stream
+	info, err := c.JetStream.StreamInfo(c.config.Stream, nats.Context(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to capture NATS stream %s: %w", c.config.Stream, err)
+	}
+
+	summary.SetUsedMemory(int64(info.State.Bytes))
+
+	result := []*api.QueueRoute{}
+
+	for _, route := range routes {
+		r, err := c.inspectRoute(ctx, route, sample)
+		if err != nil {
+			return nil, fmt.Errorf("unable to inspect queue route %s: %w", route, err)
+		}
+
+		result = append(result, r)
+	}
+
+	summary.SetRoutes(result)
+
+	return summary, nil
+}
+
+// Purge deletes the items waiting on the route and
+// returns the number of items that were deleted.
+func (c *client) Purge(ctx context.Context, route string) (int64, error) {
+	c.Logger.Tracef("purging items from queue %s", route)
+
+	count, _, err := c.routeState(ctx, route)
+	if err != nil {
+		return 0, err
+	}
+
+	if count == 0 {
+		return 0, nil
+	}
+
+	// send API request to purge the messages on the subject
+	//
+	// https://pkg.go.dev/github.com/nats-io/nats.go#JetStreamManager.PurgeStream
+	err = c.JetStream.PurgeStream(c.config.Stream, &nats.StreamPurgeRequest{
+		Subject: c.subject(route),
+	}, nats.Context(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Move moves the items waiting on the source route to the target
+// route and returns the number of items that were moved. Each item
+// is published to the target route before it is acknowledged on the
+// source route, so an item is never lost when the move is interrupted.
+func (c *client) Move(ctx context.Context, source, target string) (int64, error) {
+	c.Logger.Tracef("moving items from queue %s to %s", source, target)
+
+	// ensure the items are moved to another route
+	if strings.EqualFold(source, target) {
+		return 0, errors.New("source and target routes must be different")
+	}
+
+	sub, err := c.subscription(source)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+
+	for {
+		msgs, err := sub.Fetch(moveBatch, nats.MaxWait(pollInterval))
+		if err != nil {
+			// no more items are waiting on the source route
+			if errors.Is(err, nats.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+				return count, nil
+			}
+
+			return count, err
+		}
+
+		if len(msgs) == 0 {
+			return count, nil
+		}
+
+		for _, msg := range msgs {
+			_, err = c.JetStream.Publish(c.subject(target), msg.Data, nats.Context(ctx))
+			if err != nil {
+				// return the items that weren't moved to the source route
+				c.nakAll(msgs)
+
+				return count, err
+			}
+
+			err = msg.AckSync(nats.Context(ctx))
+			if err != nil {
+				c.nakAll(msgs)
+
+				return count, err
+			}
+
+			count++
+		}
+	}
+}
+
+// nakAll is a helper function to negatively acknowledge the messages
+// so they are redelivered. Messages that were already acknowledged
+// are ignored by the consumer.
+func (c *client) nakAll(msgs []*nats.Msg) {
+	for _, msg := range msgs {
+		_ = msg.Nak()
+	}
+}
+
+// routeState is a helper function to capture the number of
+// items waiting on the route and the sequence of the first.
+func (c *client) routeState(ctx context.Context, route string) (int64, uint64, error) {
+	info, err := c.JetStream.StreamInfo(c.config.Stream, &nats.StreamInfoRequest{SubjectsFilter: c.subject(route)}, nats.Context(ctx))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(info.State.Subjects[c.subject(route)]), info.State.FirstSeq, nil
+}
+
+// inspectRoute is a helper function to capture the items waiting on the
+// route with the metadata for up to the provided number of items.
+func (c *client) inspectRoute(ctx context.Context, route string, sample int) (*api.QueueRoute, error) {
+	r := new(api.QueueRoute)
+	r.SetRoute(route)
+
+	length, seq, err := c.routeState(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	r.SetLength(length)
+
+	// always capture the item at the front of
+	// the route to find the age of the oldest
+	limit := sample
+	if limit < 1 {
+		limit = 1
+	}
+
+	sampled := []*api.QueueItem{}
+
+	for length > 0 && len(sampled) < limit {
+		// capture the next message on the subject for the route
+		// without removing it from the stream
+		//
+		// https://pkg.go.dev/github.com/nats-io/nats.go#DirectGetNext
+		msg, err := c.JetStream.GetMsg(c.config.Stream, seq, nats.DirectGetNext(c.subject(route)), nats.Context(ctx))
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		sampled = append(sampled, c.itemMetadata(msg.Data))
+		seq = msg.Sequence + 1
+	}
+
+	// order the items from oldest to newest
+	sort.SliceStable(sampled, func(i, j int) bool {
+		return sampled[i].GetEnqueued() < sampled[j].GetEnqueued()
+	})
+
+	if len(sampled) > 0 && sampled[0].GetEnqueued() > 0 {
+		r.SetOldestAge(time.Now().UTC().Unix() - sampled[0].GetEnqueued())
+	}
+
+	if len(sampled) > sample {
+		sampled = sampled[:sample]
+	}
+
+	r.SetItems(sampled)
+
+	return r, nil
+}
+
+// itemMetadata is a helper function to capture the metadata
+// for the item without the pipeline or credentials.
+func (c *client) itemMetadata(data []byte) *api.QueueItem {
+	i := new(api.QueueItem)
+	i.SetSize(int64(len(data)))
+	i.SetCompressed(bytes.HasPrefix(data, zstdMagic))
+
+	// decompress the item when it was compressed
+	decoded, err := decode(data)
+	if err != nil {
+		c.Logger.Warnf("unable to decompress queue item: %v", err)
+
+		return i
+	}
+
+	item := new(types.Item)
+
+	// unmarshal the item to capture the build
+	err = json.Unmarshal(decoded, item)
+	if err != nil {
+		c.Logger.Warnf("unable to unmarshal queue item: %v", err)
+
+		return i
+	}
+
+	i.SetBuildID(item.Build.GetID())
+	i.SetRepo(item.Repo.GetFullName())
+	i.SetNumber(int64(item.Build.GetNumber()))
+	i.SetEnqueued(item.Build.GetEnqueued())
+
+	return i
+}
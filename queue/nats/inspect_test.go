@@ -0,0 +1,186 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNATS_Inspect(t *testing.T) {
+	// setup types
+	s := newServer(t)
+	now := time.Now().UTC().Unix()
+
+	_service, err := New(
+		WithAddress(s.ClientURL()),
+		WithChannels("vela"),
+		WithCompression(true),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	for i, enqueued := range []int64{now - 60, now - 30, now - 10} {
+		err = _service.Push(context.Background(), "vela", newItem(t, i+1, enqueued))
+		if err != nil {
+			t.Fatalf("Push returned err: %v", err)
+		}
+	}
+
+	// run test
+	got, err := _service.Inspect(context.Background(), nil, 2)
+	if err != nil {
+		t.Fatalf("Inspect returned err: %v", err)
+	}
+
+	if got.GetUsedMemory() == 0 {
+		t.Errorf("Inspect used memory is 0, want the bytes stored")
+	}
+
+	if len(got.GetRoutes()) != 1 {
+		t.Fatalf("Inspect returned %d routes, want 1", len(got.GetRoutes()))
+	}
+
+	route := got.GetRoutes()[0]
+
+	if route.GetLength() != 3 {
+		t.Errorf("Inspect length is %d, want 3", route.GetLength())
+	}
+
+	if route.GetOldestAge() < 60 {
+		t.Errorf("Inspect oldest age is %d, want at least 60", route.GetOldestAge())
+	}
+
+	if len(route.GetItems()) != 2 {
+		t.Fatalf("Inspect returned %d items, want 2", len(route.GetItems()))
+	}
+
+	for i, item := range route.GetItems() {
+		if item.GetNumber() != int64(i+1) {
+			t.Errorf("Inspect item %d number is %d, want %d", i, item.GetNumber(), i+1)
+		}
+
+		if item.GetRepo() != "github/octocat" {
+			t.Errorf("Inspect item %d repo is %s", i, item.GetRepo())
+		}
+
+		if !item.GetCompressed() || item.GetSize() == 0 {
+			t.Errorf("Inspect item %d is %v, want compressed with a size", i, item)
+		}
+	}
+}
+
+func TestNATS_Purge(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	_service, err := New(
+		WithAddress(s.ClientURL()),
+		WithChannels("vela"),
+		WithTimeout(time.Second),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		err = _service.Push(context.Background(), "vela", newItem(t, i, 0))
+		if err != nil {
+			t.Fatalf("Push returned err: %v", err)
+		}
+	}
+
+	err = _service.Push(context.Background(), "docker:linux", newItem(t, 4, 0))
+	if err != nil {
+		t.Fatalf("Push returned err: %v", err)
+	}
+
+	// run test
+	got, err := _service.Purge(context.Background(), "vela")
+	if err != nil {
+		t.Errorf("Purge returned err: %v", err)
+	}
+
+	if got != 3 {
+		t.Errorf("Purge is %d, want 3", got)
+	}
+
+	item, err := _service.Pop(context.Background())
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if item != nil {
+		t.Errorf("Pop is %v, want nil", item)
+	}
+
+	// items on other routes are kept
+	length, _, err := _service.routeState(context.Background(), "docker:linux")
+	if err != nil {
+		t.Errorf("routeState returned err: %v", err)
+	}
+
+	if length != 1 {
+		t.Errorf("routeState length is %d, want 1", length)
+	}
+}
+
+func TestNATS_Move(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	_service, err := New(
+		WithAddress(s.ClientURL()),
+		WithChannels("docker:linux"),
+		WithTimeout(time.Second),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		err = _service.Push(context.Background(), "vela", newItem(t, i, 0))
+		if err != nil {
+			t.Fatalf("Push returned err: %v", err)
+		}
+	}
+
+	// run test
+	_, err = _service.Move(context.Background(), "vela", "vela")
+	if err == nil {
+		t.Errorf("Move to the same route should have returned err")
+	}
+
+	got, err := _service.Move(context.Background(), "vela", "docker:linux")
+	if err != nil {
+		t.Errorf("Move returned err: %v", err)
+	}
+
+	if got != 3 {
+		t.Errorf("Move is %d, want 3", got)
+	}
+
+	for i := 1; i <= 3; i++ {
+		item, err := _service.Pop(context.Background())
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if item == nil || item.Build.GetNumber() != i {
+			t.Errorf("Pop is %v, want build %d", item, i)
+		}
+	}
+
+	length, _, err := _service.routeState(context.Background(), "vela")
+	if err != nil {
+		t.Errorf("routeState returned err: %v", err)
+	}
+
+	if length != 0 {
+		t.Errorf("routeState length is %d, want 0", length)
+	}
+}
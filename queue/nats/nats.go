@@ -0,0 +1,222 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultStream represents the default name of the
+	// stream the items in the queue are stored in.
+	DefaultStream = "VELA"
+
+	// DefaultSubjectPrefix represents the default prefix for the
+	// subjects items are published to, followed by the route.
+	DefaultSubjectPrefix = "vela.queue"
+
+	// clusterReplicas represents the number of replicas
+	// for the stream when connecting to a cluster.
+	clusterReplicas = 3
+
+	// maxDeliver represents the number of times an item is
+	// delivered before the consumer stops redelivering it.
+	maxDeliver = 5
+)
+
+type config struct {
+	// specifies the address to use for the NATS client
+	Address string
+	// specifies a list of channels for managing builds for the NATS client
+	Channels []string
+	// enables the NATS client to replicate the stream across a NATS cluster
+	Cluster bool
+	// specifies the timeout to use for the NATS client
+	Timeout time.Duration
+	// specifies the name of the stream to use for the NATS client
+	Stream string
+	// specifies the prefix for the subjects of each route for the NATS client
+	SubjectPrefix string
+	// enables compressing items pushed with zstd for the NATS client
+	Compression bool
+	// specifies the max size in bytes of items pushed for the NATS client
+	MaxItemSize int64
+}
+
+type client struct {
+	config    *config
+	NATS      *nats.Conn
+	JetStream nats.JetStreamContext
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+
+	// pull subscriptions for the consumer of each route
+	mutex         sync.Mutex
+	subscriptions map[string]*nats.Subscription
+}
+
+// New returns a Queue implementation that
+// integrates with a NATS JetStream queue instance.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new NATS client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.config.Timeout = 60 * time.Second
+	c.config.Stream = DefaultStream
+	c.config.SubjectPrefix = DefaultSubjectPrefix
+	c.subscriptions = make(map[string]*nats.Subscription)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("queue", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// connect to the NATS servers from the comma separated list of urls
+	//
+	// https://pkg.go.dev/github.com/nats-io/nats.go#Connect
+	conn, err := nats.Connect(
+		c.config.Address,
+		nats.Name("vela-server"),
+		nats.MaxReconnects(-1),
+		nats.RetryOnFailedConnect(true),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to NATS queue: %w", err)
+	}
+
+	c.NATS = conn
+
+	// create the JetStream context from the connection
+	//
+	// https://pkg.go.dev/github.com/nats-io/nats.go#Conn.JetStream
+	c.JetStream, err = conn.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("unable to create NATS JetStream context: %w", err)
+	}
+
+	// create the stream for the queue
+	err = c.createStream()
+	if err != nil {
+		return nil, err
+	}
+
+	// create the consumer for each route
+	for _, channel := range c.config.Channels {
+		_, err = c.subscription(channel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// createStream is a helper function to create the work queue stream
+// the items are stored in when it doesn't already exist.
+func (c *client) createStream() error {
+	_, err := c.JetStream.StreamInfo(c.config.Stream)
+	if err == nil {
+		return nil
+	}
+
+	if !errors.Is(err, nats.ErrStreamNotFound) {
+		return fmt.Errorf("unable to capture NATS stream %s: %w", c.config.Stream, err)
+	}
+
+	cfg := &nats.StreamConfig{
+		Name:     c.config.Stream,
+		Subjects: []string{c.config.SubjectPrefix + ".>"},
+		// remove items once they are acknowledged by a consumer
+		Retention:   nats.WorkQueuePolicy,
+		Storage:     nats.FileStorage,
+		Replicas:    1,
+		AllowDirect: true,
+	}
+
+	if c.config.Cluster {
+		cfg.Replicas = clusterReplicas
+	}
+
+	if c.config.MaxItemSize > 0 {
+		cfg.MaxMsgSize = int32(c.config.MaxItemSize)
+	}
+
+	c.Logger.Infof("creating NATS stream %s for subjects %s", cfg.Name, cfg.Subjects)
+
+	_, err = c.JetStream.AddStream(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to create NATS stream %s: %w", c.config.Stream, err)
+	}
+
+	return nil
+}
+
+// subscription is a helper function to capture the pull subscription
+// for the durable consumer of the route, creating it when necessary.
+func (c *client) subscription(route string) (*nats.Subscription, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if sub, ok := c.subscriptions[route]; ok {
+		return sub, nil
+	}
+
+	// create or bind to the durable consumer for the route
+	//
+	// https://pkg.go.dev/github.com/nats-io/nats.go#JetStreamContext.PullSubscribe
+	sub, err := c.JetStream.PullSubscribe(
+		c.subject(route),
+		consumer(route),
+		nats.BindStream(c.config.Stream),
+		nats.AckExplicit(),
+		nats.MaxDeliver(maxDeliver),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to subscribe to NATS queue route %s: %w", route, err)
+	}
+
+	c.subscriptions[route] = sub
+
+	return sub, nil
+}
+
+// subject is a helper function to capture the
+// subject items are published to for the route.
+func (c *client) subject(route string) string {
+	return fmt.Sprintf("%s.%s", c.config.SubjectPrefix, route)
+}
+
+// consumerName replaces the characters that aren't allowed in a consumer name.
+var consumerName = strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_", "/", "_", "\\", "_")
+
+// consumer is a helper function to capture the name of the
+// durable consumer shared by everything popping from the route.
+func consumer(route string) string {
+	return "vela_" + consumerName.Replace(route)
+}
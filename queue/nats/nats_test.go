@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+	"github.com/nats-io/nats-server/v2/server"
+)
+
+// newServer is a helper function to run a local NATS
+// server with JetStream enabled for the test.
+func newServer(t *testing.T) *server.Server {
+	t.Helper()
+
+	s, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("unable to create nats server: %v", err)
+	}
+
+	go s.Start()
+
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatalf("nats server is not ready for connections")
+	}
+
+	t.Cleanup(s.Shutdown)
+
+	return s
+}
+
+// newItem is a helper function to create the queue item
+// for the build number enqueued at the provided time.
+func newItem(t *testing.T, number int, enqueued int64) []byte {
+	t.Helper()
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+
+	b := new(library.Build)
+	b.SetID(int64(number))
+	b.SetNumber(number)
+	b.SetEnqueued(enqueued)
+
+	bytes, err := json.Marshal(&types.Item{Build: b, Repo: r})
+	if err != nil {
+		t.Fatalf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
+
+func TestNATS_New(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		opts    []ClientOpt
+	}{
+		{
+			failure: false,
+			opts: []ClientOpt{
+				WithAddress(s.ClientURL()),
+				WithChannels("vela", "docker:linux"),
+			},
+		},
+		{
+			failure: true,
+			opts: []ClientOpt{
+				WithAddress(s.ClientURL()),
+				WithChannels("vela.*"),
+			},
+		},
+		{
+			failure: true,
+			opts: []ClientOpt{
+				WithAddress(""),
+				WithChannels("vela"),
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_, err := New(test.opts...)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("New returned err: %v", err)
+		}
+	}
+
+	// creating the client again binds to the existing stream and consumers
+	_, err := New(WithAddress(s.ClientURL()), WithChannels("vela"))
+	if err != nil {
+		t.Errorf("New for existing stream returned err: %v", err)
+	}
+}
+
+func TestNATS_consumer(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		route string
+		want  string
+	}{
+		{route: "vela", want: "vela_vela"},
+		{route: "docker:linux", want: "vela_docker:linux"},
+		{route: "large.linux", want: "vela_large_linux"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got := consumer(test.route)
+
+		if got != test.want {
+			t.Errorf("consumer for %s is %s, want %s", test.route, got, test.want)
+		}
+	}
+}
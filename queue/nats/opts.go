@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ClientOpt represents a configuration option to initialize the queue client for NATS.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address in the queue client for NATS.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in nats queue client")
+
+		// check if the address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no NATS queue address provided")
+		}
+
+		// set the queue address in the nats client
+		c.config.Address = address
+
+		return nil
+	}
+}
+
+// WithChannels sets the channels in the queue client for NATS.
+func WithChannels(channels ...string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring channels in nats queue client")
+
+		// check if the channels provided are empty
+		if len(channels) == 0 {
+			return fmt.Errorf("no NATS queue channels provided")
+		}
+
+		// check if the channels provided are valid subject tokens
+		for _, channel := range channels {
+			if len(channel) == 0 || strings.ContainsAny(channel, "*> \t") {
+				return fmt.Errorf("invalid NATS queue channel provided: %q", channel)
+			}
+		}
+
+		// set the queue channels in the nats client
+		c.config.Channels = channels
+
+		return nil
+	}
+}
+
+// WithCluster sets the clustering mode in the queue client for NATS.
+func WithCluster(cluster bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring clustering mode in nats queue client")
+
+		// set the queue clustering mode in the nats client
+		c.config.Cluster = cluster
+
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout in the queue client for NATS.
+func WithTimeout(timeout time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring timeout in nats queue client")
+
+		// skip setting the timeout if none is provided
+		if timeout <= 0 {
+			return nil
+		}
+
+		// set the queue timeout in the nats client
+		c.config.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithStream sets the name of the stream in the queue client for NATS.
+func WithStream(stream string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring stream in nats queue client")
+
+		// check if the stream provided is empty
+		if len(stream) == 0 {
+			return fmt.Errorf("no NATS queue stream provided")
+		}
+
+		// set the queue stream in the nats client
+		c.config.Stream = stream
+
+		return nil
+	}
+}
+
+// WithSubjectPrefix sets the prefix for the subjects of each route in the queue client for NATS.
+func WithSubjectPrefix(prefix string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring subject prefix in nats queue client")
+
+		// check if the subject prefix provided is empty
+		if len(prefix) == 0 {
+			return fmt.Errorf("no NATS queue subject prefix provided")
+		}
+
+		// set the queue subject prefix in the nats client
+		c.config.SubjectPrefix = prefix
+
+		return nil
+	}
+}
+
+// WithCompression sets the compression mode in the queue client for NATS.
+func WithCompression(compression bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring compression mode in nats queue client")
+
+		// set the queue compression mode in the nats client
+		c.config.Compression = compression
+
+		return nil
+	}
+}
+
+// WithMaxItemSize sets the max size of items in the queue client for NATS.
+func WithMaxItemSize(size int64) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring max item size in nats queue client")
+
+		// check if the max item size provided is negative
+		if size < 0 {
+			return fmt.Errorf("invalid NATS queue max item size provided: %d", size)
+		}
+
+		// set the queue max item size in the nats client
+		c.config.MaxItemSize = size
+
+		return nil
+	}
+}
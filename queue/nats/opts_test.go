@@ -0,0 +1,111 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNATS_ClientOpt(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opt     ClientOpt
+		want    *config
+	}{
+		{
+			name: "defaults",
+			opt:  WithTimeout(0),
+			want: &config{
+				Address:       s.ClientURL(),
+				Channels:      []string{"vela"},
+				Timeout:       60 * time.Second,
+				Stream:        DefaultStream,
+				SubjectPrefix: DefaultSubjectPrefix,
+			},
+		},
+		{
+			name: "with options",
+			opt: func(c *client) error {
+				for _, opt := range []ClientOpt{
+					WithTimeout(5 * time.Second),
+					WithStream("QUEUE"),
+					WithSubjectPrefix("queue"),
+					WithCompression(true),
+					WithMaxItemSize(1024),
+				} {
+					err := opt(c)
+					if err != nil {
+						return err
+					}
+				}
+
+				return nil
+			},
+			want: &config{
+				Address:       s.ClientURL(),
+				Channels:      []string{"vela"},
+				Timeout:       5 * time.Second,
+				Stream:        "QUEUE",
+				SubjectPrefix: "queue",
+				Compression:   true,
+				MaxItemSize:   1024,
+			},
+		},
+		{
+			name:    "empty stream",
+			failure: true,
+			opt:     WithStream(""),
+		},
+		{
+			name:    "empty subject prefix",
+			failure: true,
+			opt:     WithSubjectPrefix(""),
+		},
+		{
+			name:    "negative max item size",
+			failure: true,
+			opt:     WithMaxItemSize(-1),
+		},
+		{
+			name:    "empty channels",
+			failure: true,
+			opt:     WithChannels(),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_service, err := New(
+				WithAddress(s.ClientURL()),
+				WithChannels("vela"),
+				test.opt,
+			)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(_service.config, test.want) {
+				t.Errorf("New config is %v, want %v", _service.config, test.want)
+			}
+		})
+	}
+}
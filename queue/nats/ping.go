@@ -0,0 +1,21 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Ping verifies the connection to the queue is still alive
+// and JetStream is available for the account.
+func (c *client) Ping(ctx context.Context) error {
+	c.Logger.Trace("pinging the queue")
+
+	_, err := c.JetStream.AccountInfo(nats.Context(ctx))
+
+	return err
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNATS_Ping(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	_service, err := New(
+		WithAddress(s.ClientURL()),
+		WithChannels("foo"),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	// run test
+	err = _service.Ping(context.TODO())
+	if err != nil {
+		t.Errorf("Ping returned err: %v", err)
+	}
+
+	s.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err = _service.Ping(ctx)
+	if err == nil {
+		t.Errorf("Ping for closed queue should have returned err")
+	}
+}
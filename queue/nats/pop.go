@@ -0,0 +1,131 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/types"
+	"github.com/nats-io/nats.go"
+)
+
+// pollInterval represents the time spent waiting on
+// each route when popping from multiple routes.
+const pollInterval = 100 * time.Millisecond
+
+// Pop grabs an item from the specified channels off the queue. The
+// channels are checked in order until an item is found or the timeout
+// is reached. The item is acknowledged once it is decoded so it won't
+// be redelivered, or negatively acknowledged when the acknowledgement
+// fails so it's redelivered to another consumer.
+func (c *client) Pop(ctx context.Context) (*types.Item, error) {
+	c.Logger.Tracef("popping item from queue %s", c.config.Channels)
+
+	deadline := time.Now().Add(c.config.Timeout)
+
+	for time.Now().Before(deadline) {
+		for _, channel := range c.config.Channels {
+			// wait the full timeout when there is only one route to pop from
+			wait := pollInterval
+			if len(c.config.Channels) == 1 {
+				wait = time.Until(deadline)
+			}
+
+			msg, err := c.fetch(ctx, channel, wait)
+			if err != nil {
+				return nil, err
+			}
+
+			if msg == nil {
+				continue
+			}
+
+			return c.item(ctx, msg)
+		}
+	}
+
+	return nil, nil
+}
+
+// fetch is a helper function to fetch the next message on the route,
+// returning nil when no message is available before the wait expires.
+func (c *client) fetch(ctx context.Context, route string, wait time.Duration) (*nats.Msg, error) {
+	sub, err := c.subscription(route)
+	if err != nil {
+		return nil, err
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	// request the next message from the consumer for the route
+	//
+	// https://pkg.go.dev/github.com/nats-io/nats.go#Subscription.Fetch
+	msgs, err := sub.Fetch(1, nats.Context(fetchCtx))
+	if err != nil {
+		// the wait expired without the request being canceled
+		if (errors.Is(err, context.DeadlineExceeded) || errors.Is(err, nats.ErrTimeout)) && ctx.Err() == nil {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+
+	return msgs[0], nil
+}
+
+// item is a helper function to decode the queue item from
+// the message and acknowledge it was removed from the queue.
+func (c *client) item(ctx context.Context, msg *nats.Msg) (*types.Item, error) {
+	// decompress the message when it was compressed
+	data, err := decode(msg.Data)
+	if err != nil {
+		return nil, c.terminate(msg, err)
+	}
+
+	item := new(types.Item)
+
+	// unmarshal message into queue item
+	err = json.Unmarshal(data, item)
+	if err != nil {
+		return nil, c.terminate(msg, err)
+	}
+
+	// wait for the consumer to confirm the acknowledgement so
+	// the item is never popped by more than one consumer
+	//
+	// https://pkg.go.dev/github.com/nats-io/nats.go#Msg.AckSync
+	err = msg.AckSync(nats.Context(ctx))
+	if err != nil {
+		// return the item to the queue for another consumer
+		nakErr := msg.Nak()
+		if nakErr != nil {
+			c.Logger.Errorf("unable to nack item on queue %s: %v", msg.Subject, nakErr)
+		}
+
+		return nil, fmt.Errorf("unable to ack item on queue %s: %w", msg.Subject, err)
+	}
+
+	return item, nil
+}
+
+// terminate is a helper function to stop redelivering a
+// message that can never be decoded into a queue item.
+func (c *client) terminate(msg *nats.Msg, err error) error {
+	termErr := msg.Term()
+	if termErr != nil {
+		c.Logger.Errorf("unable to terminate item on queue %s: %v", msg.Subject, termErr)
+	}
+
+	return fmt.Errorf("unable to decode item on queue %s: %w", msg.Subject, err)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNATS_Push_Pop(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	// setup tests
+	tests := []struct {
+		name        string
+		compression bool
+	}{
+		{
+			name: "without compression",
+		},
+		{
+			name:        "with compression",
+			compression: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_service, err := New(
+				WithAddress(s.ClientURL()),
+				WithStream(consumer(test.name)),
+				WithSubjectPrefix(consumer(test.name)),
+				WithChannels("vela", "docker:linux"),
+				WithTimeout(time.Second),
+				WithCompression(test.compression),
+			)
+			if err != nil {
+				t.Fatalf("unable to create queue service: %v", err)
+			}
+
+			err = _service.Push(context.Background(), "docker:linux", newItem(t, 1, 0))
+			if err != nil {
+				t.Errorf("Push returned err: %v", err)
+			}
+
+			err = _service.Push(context.Background(), "vela", newItem(t, 2, 0))
+			if err != nil {
+				t.Errorf("Push returned err: %v", err)
+			}
+
+			// the routes are popped in the order they're configured
+			for _, want := range []int{2, 1} {
+				got, err := _service.Pop(context.Background())
+				if err != nil {
+					t.Errorf("Pop returned err: %v", err)
+				}
+
+				if got == nil || got.Build.GetNumber() != want {
+					t.Errorf("Pop is %v, want build %d", got, want)
+				}
+			}
+
+			// the popped items were acked and removed from the queue
+			got, err := _service.Pop(context.Background())
+			if err != nil {
+				t.Errorf("Pop returned err: %v", err)
+			}
+
+			if got != nil {
+				t.Errorf("Pop is %v, want nil", got)
+			}
+		})
+	}
+}
+
+func TestNATS_Push_MaxItemSize(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	_service, err := New(
+		WithAddress(s.ClientURL()),
+		WithChannels("vela"),
+		WithMaxItemSize(10),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	// run test
+	err = _service.Push(context.Background(), "vela", newItem(t, 1, 0))
+	if !errors.Is(err, ErrItemTooLarge) {
+		t.Errorf("Push returned err %v, want %v", err, ErrItemTooLarge)
+	}
+
+	err = _service.Push(context.Background(), "vela", nil)
+	if err == nil {
+		t.Errorf("Push for nil item should have returned err")
+	}
+}
+
+func TestNATS_Pop_Invalid(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	_service, err := New(
+		WithAddress(s.ClientURL()),
+		WithChannels("vela"),
+		WithTimeout(time.Second),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	_, err = _service.JetStream.Publish(_service.subject("vela"), []byte("not an item"))
+	if err != nil {
+		t.Fatalf("unable to publish message: %v", err)
+	}
+
+	// run test
+	got, err := _service.Pop(context.Background())
+	if err == nil {
+		t.Errorf("Pop for invalid item should have returned err")
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+
+	// the invalid item is terminated instead of redelivered
+	got, err = _service.Pop(context.Background())
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+}
+
+func TestNATS_Pop_Canceled(t *testing.T) {
+	// setup types
+	s := newServer(t)
+
+	_service, err := New(
+		WithAddress(s.ClientURL()),
+		WithChannels("vela"),
+		WithTimeout(time.Minute),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// run test
+	_, err = _service.Pop(ctx)
+	if err == nil {
+		t.Errorf("Pop for canceled context should have returned err")
+	}
+}
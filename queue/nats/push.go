@@ -0,0 +1,38 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Push publishes an item to the subject for the specified channel in the queue.
+func (c *client) Push(ctx context.Context, channel string, item []byte) error {
+	c.Logger.Tracef("pushing item to queue %s", channel)
+
+	// ensure the item to be pushed is valid
+	if item == nil {
+		return errors.New("item is nil")
+	}
+
+	// compress the item and verify its size
+	data, err := c.encode(item)
+	if err != nil {
+		return err
+	}
+
+	// publish the item and wait for the stream to acknowledge it
+	//
+	// https://pkg.go.dev/github.com/nats-io/nats.go#JetStreamContext.Publish
+	_, err = c.JetStream.Publish(c.subject(channel), data, nats.Context(ctx))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
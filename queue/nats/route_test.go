@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package nats
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/pipeline"
+)
+
+func TestNATS_Client_Route(t *testing.T) {
+	// setup
+	s := newServer(t)
+
+	client, err := New(WithAddress(s.ClientURL()), WithChannels("vela"))
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	tests := []struct {
+		want   string
+		worker pipeline.Worker
+	}{
+
+		//  pipeline with not worker passed
+		{
+			want:   constants.DefaultRoute,
+			worker: pipeline.Worker{},
+		},
+		{
+			want:   "vela",
+			worker: pipeline.Worker{},
+		},
+		{
+			want:   "16cpu8gb",
+			worker: pipeline.Worker{Flavor: "16cpu8gb"},
+		},
+		{
+			want:   "16cpu8gb:gcp",
+			worker: pipeline.Worker{Flavor: "16cpu8gb", Platform: "gcp"},
+		},
+		{
+			want:   "gcp",
+			worker: pipeline.Worker{Platform: "gcp"},
+		},
+	}
+
+	// run
+	for _, test := range tests {
+		got, err := client.Route(&test.worker)
+
+		if err != nil {
+			t.Errorf("Route returned err: %v", err)
+		}
+
+		if !strings.EqualFold(got, test.want) {
+			t.Errorf("Route is %v, want %v", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package postgres provides the ability for Vela to integrate
+// with a Postgres database as a queue backend.
+//
+// Items are stored as rows in a table with a column for the route
+// and popped with SELECT ... FOR UPDATE SKIP LOCKED, so any number
+// of servers can share the queue without extra infrastructure
+// beyond the Postgres database already used by Vela.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/queue/postgres"
+package postgres
@@ -0,0 +1,245 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+)
+
+const (
+	// SelectTableSize represents a query to select the bytes
+	// used by the queue_items table, including its indexes.
+	SelectTableSize = `
+SELECT pg_total_relation_size('queue_items');
+`
+
+	// SelectRouteCount represents a query to select the
+	// count of items on a route in the queue_items table.
+	SelectRouteCount = `
+SELECT count(*)
+FROM queue_items
+WHERE route = ?;
+`
+
+	// SelectRouteItems represents a query to select the oldest
+	// items on a route in the queue_items table.
+	SelectRouteItems = `
+SELECT item, created
+FROM queue_items
+WHERE route = ?
+ORDER BY id
+LIMIT ?;
+`
+
+	// DeleteRouteItems represents a query to delete
+	// the items on a route in the queue_items table.
+	DeleteRouteItems = `
+DELETE FROM queue_items
+WHERE route = ?;
+`
+
+	// UpdateRouteItems represents a query to move the items on
+	// a route to another route in the queue_items table.
+	UpdateRouteItems = `
+UPDATE queue_items
+SET route = ?
+WHERE route = ?;
+`
+)
+
+// Inspect captures the bytes used by the table and the items waiting
+// on the routes, defaulting to the configured channels, with the
+// metadata for up to the provided number of items on each route.
+func (c *client) Inspect(ctx context.Context, routes []string, sample int) (*api.QueueSummary, error) {
+	c.Logger.Tracef("inspecting queue routes %s", routes)
+
+	if len(routes) == 0 {
+		routes = c.config.Channels
+	}
+
+	summary := new(api.QueueSummary)
+
+	var size int64
+
+	// send query to capture the bytes used by the table
+	err := c.Postgres.
+		WithContext(ctx).
+		Raw(SelectTableSize).
+		Row().
+		Scan(&size)
+	if err != nil {
+		return nil, fmt.Errorf("unable to capture size of queue_items table: %w", err)
+	}
+
+	summary.SetUsedMemory(size)
+
+	result := []*api.QueueRoute{}
+
+	for _, route := range routes {
+		r, err := c.inspectRoute(ctx, route, sample)
+		if err != nil {
+			return nil, fmt.Errorf("unable to inspect queue route %s: %w", route, err)
+		}
+
+		result = append(result, r)
+	}
+
+	summary.SetRoutes(result)
+
+	return summary, nil
+}
+
+// Purge deletes the items waiting on the route and
+// returns the number of items that were deleted.
+func (c *client) Purge(ctx context.Context, route string) (int64, error) {
+	c.Logger.Tracef("purging items from queue %s", route)
+
+	// send query to delete the items on the route
+	result := c.Postgres.
+		WithContext(ctx).
+		Exec(DeleteRouteItems, route)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Move moves the items waiting on the source route to the target
+// route and returns the number of items that were moved. The items
+// keep their position, so they're popped in the order they were
+// pushed among the items already waiting on the target route.
+func (c *client) Move(ctx context.Context, source, target string) (int64, error) {
+	c.Logger.Tracef("moving items from queue %s to %s", source, target)
+
+	// ensure the items are moved to another route
+	if strings.EqualFold(source, target) {
+		return 0, errors.New("source and target routes must be different")
+	}
+
+	// send query to move the items to the target route
+	result := c.Postgres.
+		WithContext(ctx).
+		Exec(UpdateRouteItems, target, source)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// inspectRoute is a helper function to capture the items waiting on the
+// route with the metadata for up to the provided number of items.
+func (c *client) inspectRoute(ctx context.Context, route string, sample int) (*api.QueueRoute, error) {
+	r := new(api.QueueRoute)
+	r.SetRoute(route)
+
+	var length int64
+
+	// send query to capture the number of items on the route
+	err := c.Postgres.
+		WithContext(ctx).
+		Raw(SelectRouteCount, route).
+		Row().
+		Scan(&length)
+	if err != nil {
+		return nil, err
+	}
+
+	r.SetLength(length)
+
+	// always capture the item at the front of
+	// the route to find the age of the oldest
+	limit := sample
+	if limit < 1 {
+		limit = 1
+	}
+
+	// send query to capture the oldest items on the route
+	rows, err := c.Postgres.
+		WithContext(ctx).
+		Raw(SelectRouteItems, route, limit).
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sampled := []*api.QueueItem{}
+
+	for rows.Next() {
+		var (
+			data    []byte
+			created int64
+		)
+
+		err = rows.Scan(&data, &created)
+		if err != nil {
+			return nil, err
+		}
+
+		// the items are ordered from oldest to newest
+		if len(sampled) == 0 && created > 0 {
+			r.SetOldestAge(time.Now().UTC().Unix() - created)
+		}
+
+		sampled = append(sampled, c.itemMetadata(data))
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sampled) > sample {
+		sampled = sampled[:sample]
+	}
+
+	r.SetItems(sampled)
+
+	return r, nil
+}
+
+// itemMetadata is a helper function to capture the metadata
+// for the item without the pipeline or credentials.
+func (c *client) itemMetadata(data []byte) *api.QueueItem {
+	i := new(api.QueueItem)
+	i.SetSize(int64(len(data)))
+	i.SetCompressed(bytes.HasPrefix(data, zstdMagic))
+
+	// decompress the item when it was compressed
+	decoded, err := decode(data)
+	if err != nil {
+		c.Logger.Warnf("unable to decompress queue item: %v", err)
+
+		return i
+	}
+
+	item := new(types.Item)
+
+	// unmarshal the item to capture the build
+	err = json.Unmarshal(decoded, item)
+	if err != nil {
+		c.Logger.Warnf("unable to unmarshal queue item: %v", err)
+
+		return i
+	}
+
+	i.SetBuildID(item.Build.GetID())
+	i.SetRepo(item.Repo.GetFullName())
+	i.SetNumber(int64(item.Build.GetNumber()))
+	i.SetEnqueued(item.Build.GetEnqueued())
+
+	return i
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/gorm"
+)
+
+func TestPostgres_Inspect(t *testing.T) {
+	// setup types
+	now := time.Now().UTC().Unix()
+
+	_service, _mock, err := NewTest("vela")
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	_service.config.Compression = true
+
+	first, err := _service.encode(newItem(t, 1, now-60))
+	if err != nil {
+		t.Fatalf("unable to encode queue item: %v", err)
+	}
+
+	second, err := _service.encode(newItem(t, 2, now-30))
+	if err != nil {
+		t.Fatalf("unable to encode queue item: %v", err)
+	}
+
+	// capture the current expected SQL queries
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_session := _service.Postgres.Session(&gorm.Session{DryRun: true})
+	_size := _session.Raw(SelectTableSize).Statement
+	_count := _session.Raw(SelectRouteCount, "vela").Statement
+	_items := _session.Raw(SelectRouteItems, "vela", 2).Statement
+
+	// ensure the mock expects the queries
+	_mock.ExpectQuery(_size.SQL.String()).WillReturnRows(sqlmock.NewRows([]string{"pg_total_relation_size"}).AddRow(8192))
+	_mock.ExpectQuery(_count.SQL.String()).WithArgs("vela").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	_mock.ExpectQuery(_items.SQL.String()).WithArgs("vela", 2).WillReturnRows(
+		sqlmock.NewRows([]string{"item", "created"}).AddRow(first, now-60).AddRow(second, now-30),
+	)
+
+	// run test
+	got, err := _service.Inspect(context.Background(), nil, 2)
+	if err != nil {
+		t.Fatalf("Inspect returned err: %v", err)
+	}
+
+	if got.GetUsedMemory() != 8192 {
+		t.Errorf("Inspect used memory is %d, want 8192", got.GetUsedMemory())
+	}
+
+	if len(got.GetRoutes()) != 1 {
+		t.Fatalf("Inspect returned %d routes, want 1", len(got.GetRoutes()))
+	}
+
+	route := got.GetRoutes()[0]
+
+	if route.GetRoute() != "vela" {
+		t.Errorf("Inspect route is %s, want vela", route.GetRoute())
+	}
+
+	if route.GetLength() != 3 {
+		t.Errorf("Inspect length is %d, want 3", route.GetLength())
+	}
+
+	if route.GetOldestAge() < 60 {
+		t.Errorf("Inspect oldest age is %d, want at least 60", route.GetOldestAge())
+	}
+
+	if len(route.GetItems()) != 2 {
+		t.Fatalf("Inspect returned %d items, want 2", len(route.GetItems()))
+	}
+
+	for i, item := range route.GetItems() {
+		if item.GetNumber() != int64(i+1) {
+			t.Errorf("Inspect item %d number is %d, want %d", i, item.GetNumber(), i+1)
+		}
+
+		if item.GetRepo() != "github/octocat" {
+			t.Errorf("Inspect item %d repo is %s", i, item.GetRepo())
+		}
+
+		if !item.GetCompressed() || item.GetSize() == 0 {
+			t.Errorf("Inspect item %d is %v, want compressed with a size", i, item)
+		}
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Inspect has unmet expectations: %v", err)
+	}
+}
+
+func TestPostgres_Purge(t *testing.T) {
+	// setup types
+	_service, _mock, err := NewTest("vela")
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _service.Postgres.Session(&gorm.Session{DryRun: true}).Exec(DeleteRouteItems, "vela").Statement
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(_query.SQL.String()).WithArgs("vela").WillReturnResult(sqlmock.NewResult(0, 3))
+
+	// run test
+	got, err := _service.Purge(context.Background(), "vela")
+	if err != nil {
+		t.Errorf("Purge returned err: %v", err)
+	}
+
+	if got != 3 {
+		t.Errorf("Purge is %d, want 3", got)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Purge has unmet expectations: %v", err)
+	}
+}
+
+func TestPostgres_Move(t *testing.T) {
+	// setup types
+	_service, _mock, err := NewTest("vela")
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _service.Postgres.Session(&gorm.Session{DryRun: true}).Exec(UpdateRouteItems, "docker:linux", "vela").Statement
+
+	// ensure the mock expects the query
+	_mock.ExpectExec(_query.SQL.String()).WithArgs("docker:linux", "vela").WillReturnResult(sqlmock.NewResult(0, 3))
+
+	// run tests
+	_, err = _service.Move(context.Background(), "vela", "VELA")
+	if err == nil {
+		t.Errorf("Move to the same route should have returned err")
+	}
+
+	got, err := _service.Move(context.Background(), "vela", "docker:linux")
+	if err != nil {
+		t.Errorf("Move returned err: %v", err)
+	}
+
+	if got != 3 {
+		t.Errorf("Move is %d, want 3", got)
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Move has unmet expectations: %v", err)
+	}
+}
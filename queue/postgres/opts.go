@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClientOpt represents a configuration option to initialize the queue client for Postgres.
+type ClientOpt func(*client) error
+
+// WithAddress sets the address in the queue client for Postgres.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in postgres queue client")
+
+		// check if the address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no Postgres queue address provided")
+		}
+
+		// set the queue address in the postgres client
+		c.config.Address = address
+
+		return nil
+	}
+}
+
+// WithChannels sets the channels in the queue client for Postgres.
+func WithChannels(channels ...string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring channels in postgres queue client")
+
+		// check if the channels provided are empty
+		if len(channels) == 0 {
+			return fmt.Errorf("no Postgres queue channels provided")
+		}
+
+		// check if the channels provided fit in the route column
+		for _, channel := range channels {
+			if len(channel) == 0 || len(channel) > maxRouteLength {
+				return fmt.Errorf("invalid Postgres queue channel provided: %q", channel)
+			}
+		}
+
+		// set the queue channels in the postgres client
+		c.config.Channels = channels
+
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout in the queue client for Postgres.
+func WithTimeout(timeout time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring timeout in postgres queue client")
+
+		// skip setting the timeout if none is provided
+		if timeout <= 0 {
+			return nil
+		}
+
+		// set the queue timeout in the postgres client
+		c.config.Timeout = timeout
+
+		return nil
+	}
+}
+
+// WithCompression sets the compression mode in the queue client for Postgres.
+func WithCompression(compression bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring compression mode in postgres queue client")
+
+		// set the queue compression mode in the postgres client
+		c.config.Compression = compression
+
+		return nil
+	}
+}
+
+// WithMaxItemSize sets the max size of items in the queue client for Postgres.
+func WithMaxItemSize(size int64) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring max item size in postgres queue client")
+
+		// check if the max item size provided is negative
+		if size < 0 {
+			return fmt.Errorf("invalid Postgres queue max item size provided: %d", size)
+		}
+
+		// set the queue max item size in the postgres client
+		c.config.MaxItemSize = size
+
+		return nil
+	}
+}
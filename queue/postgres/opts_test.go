@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPostgres_ClientOpt(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []ClientOpt
+		want    *config
+	}{
+		{
+			name: "defaults",
+			opts: []ClientOpt{WithTimeout(0)},
+			want: &config{
+				Channels: []string{"vela"},
+				Timeout:  time.Millisecond,
+			},
+		},
+		{
+			name: "with options",
+			opts: []ClientOpt{
+				WithAddress("postgres://localhost:5432/vela"),
+				WithChannels("vela", "docker:linux"),
+				WithTimeout(5 * time.Second),
+				WithCompression(true),
+				WithMaxItemSize(1024),
+			},
+			want: &config{
+				Address:     "postgres://localhost:5432/vela",
+				Channels:    []string{"vela", "docker:linux"},
+				Timeout:     5 * time.Second,
+				Compression: true,
+				MaxItemSize: 1024,
+			},
+		},
+		{
+			name:    "empty address",
+			failure: true,
+			opts:    []ClientOpt{WithAddress("")},
+		},
+		{
+			name:    "empty channels",
+			failure: true,
+			opts:    []ClientOpt{WithChannels()},
+		},
+		{
+			name:    "channel too long",
+			failure: true,
+			opts:    []ClientOpt{WithChannels(strings.Repeat("a", maxRouteLength+1))},
+		},
+		{
+			name:    "negative max item size",
+			failure: true,
+			opts:    []ClientOpt{WithMaxItemSize(-1)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_service, _, err := NewTest("vela")
+			if err != nil {
+				t.Fatalf("unable to create queue service: %v", err)
+			}
+
+			for _, opt := range test.opts {
+				err = opt(_service)
+				if err != nil {
+					break
+				}
+			}
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("ClientOpt should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ClientOpt returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(_service.config, test.want) {
+				t.Errorf("ClientOpt config is %v, want %v", _service.config, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import "context"
+
+// Ping verifies the connection to the queue is still alive.
+func (c *client) Ping(ctx context.Context) error {
+	c.Logger.Trace("pinging the queue")
+
+	// capture the underlying sql database
+	//
+	// https://pkg.go.dev/gorm.io/gorm#DB.DB
+	_sql, err := c.Postgres.DB()
+	if err != nil {
+		return err
+	}
+
+	return _sql.PingContext(ctx)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPostgres_Ping(t *testing.T) {
+	// setup types
+	_service, _, err := NewTest("vela")
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	// run test
+	err = _service.Ping(context.TODO())
+	if err != nil {
+		t.Errorf("Ping returned err: %v", err)
+	}
+
+	_sql, err := _service.Postgres.DB()
+	if err != nil {
+		t.Fatalf("unable to capture sql database: %v", err)
+	}
+
+	_sql.Close()
+
+	err = _service.Ping(context.TODO())
+	if err == nil {
+		t.Errorf("Ping for closed queue should have returned err")
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-vela/types"
+)
+
+// pollInterval represents the time spent waiting between
+// checking the routes when no item is found on any of them.
+const pollInterval = time.Second
+
+// DeleteNextItem represents a query to delete and return the oldest
+// item on a route in the queue_items table, skipping the rows locked
+// by another transaction so concurrent pops never claim the same item.
+const DeleteNextItem = `
+DELETE FROM queue_items
+WHERE id = (
+	SELECT id
+	FROM queue_items
+	WHERE route = ?
+	ORDER BY id
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING item;
+`
+
+// Pop grabs an item from the specified channels off the queue. The
+// channels are checked in order until an item is found or the timeout
+// is reached, waiting between each check of the channels.
+func (c *client) Pop(ctx context.Context) (*types.Item, error) {
+	c.Logger.Tracef("popping item from queue %s", c.config.Channels)
+
+	deadline := time.Now().Add(c.config.Timeout)
+
+	for {
+		for _, channel := range c.config.Channels {
+			data, err := c.next(ctx, channel)
+			if err != nil {
+				return nil, err
+			}
+
+			if data == nil {
+				continue
+			}
+
+			return c.item(channel, data)
+		}
+
+		// wait for the poll interval or until the timeout is reached
+		wait := time.Until(deadline)
+		if wait <= 0 {
+			return nil, nil
+		}
+
+		if wait > pollInterval {
+			wait = pollInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		if !time.Now().Before(deadline) {
+			return nil, nil
+		}
+	}
+}
+
+// next is a helper function to delete and return the oldest
+// item on the route, returning nil when the route is empty.
+func (c *client) next(ctx context.Context, route string) ([]byte, error) {
+	// send query to delete the oldest item on the route
+	rows, err := c.Postgres.
+		WithContext(ctx).
+		Raw(DeleteNextItem, route).
+		Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	var data []byte
+
+	err = rows.Scan(&data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// item is a helper function to decode the queue item
+// from the data deleted from the route.
+func (c *client) item(route string, data []byte) (*types.Item, error) {
+	// decompress the data when it was compressed
+	decoded, err := decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode item on queue %s: %w", route, err)
+	}
+
+	item := new(types.Item)
+
+	// unmarshal data into queue item
+	err = json.Unmarshal(decoded, item)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode item on queue %s: %w", route, err)
+	}
+
+	return item, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/gorm"
+)
+
+func TestPostgres_Push(t *testing.T) {
+	// setup types
+	item := newItem(t, 1, 0)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []ClientOpt
+		item    []byte
+	}{
+		{
+			name: "uncompressed",
+			item: item,
+		},
+		{
+			name: "compressed",
+			opts: []ClientOpt{WithCompression(true)},
+			item: item,
+		},
+		{
+			name:    "nil item",
+			failure: true,
+		},
+		{
+			name:    "item too large",
+			failure: true,
+			opts:    []ClientOpt{WithMaxItemSize(1)},
+			item:    item,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_service, _mock, err := NewTest("vela")
+			if err != nil {
+				t.Fatalf("unable to create queue service: %v", err)
+			}
+
+			for _, opt := range test.opts {
+				err = opt(_service)
+				if err != nil {
+					t.Fatalf("unable to configure queue service: %v", err)
+				}
+			}
+
+			// capture the current expected SQL query
+			//
+			// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+			_query := _service.Postgres.Session(&gorm.Session{DryRun: true}).Exec(InsertItem, "vela", []byte{}, 0).Statement
+
+			// ensure the mock expects the query
+			_mock.ExpectExec(_query.SQL.String()).
+				WithArgs("vela", AnyArgument{}, AnyArgument{}).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+
+			err = _service.Push(context.Background(), "vela", test.item)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Push should have returned err")
+				}
+
+				if test.item != nil && !errors.Is(err, ErrItemTooLarge) {
+					t.Errorf("Push returned err %v, want %v", err, ErrItemTooLarge)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Push returned err: %v", err)
+			}
+
+			err = _mock.ExpectationsWereMet()
+			if err != nil {
+				t.Errorf("Push has unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestPostgres_Pop(t *testing.T) {
+	// setup types
+	_service, _mock, err := NewTest("vela", "docker:linux")
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	_service.config.Compression = true
+
+	item := newItem(t, 1, 0)
+
+	data, err := _service.encode(item)
+	if err != nil {
+		t.Fatalf("unable to encode queue item: %v", err)
+	}
+
+	// capture the current expected SQL query
+	//
+	// https://gorm.io/docs/sql_builder.html#DryRun-Mode
+	_query := _service.Postgres.Session(&gorm.Session{DryRun: true}).Raw(DeleteNextItem, "vela").Statement
+
+	// ensure the mock expects the item on the second route for the first pop
+	_mock.ExpectQuery(_query.SQL.String()).WithArgs("vela").WillReturnRows(sqlmock.NewRows([]string{"item"}))
+	_mock.ExpectQuery(_query.SQL.String()).WithArgs("docker:linux").WillReturnRows(sqlmock.NewRows([]string{"item"}).AddRow(data))
+	// ensure the mock expects no items for the second pop
+	_mock.ExpectQuery(_query.SQL.String()).WithArgs("vela").WillReturnRows(sqlmock.NewRows([]string{"item"}))
+	_mock.ExpectQuery(_query.SQL.String()).WithArgs("docker:linux").WillReturnRows(sqlmock.NewRows([]string{"item"}))
+	// ensure the mock expects an invalid item for the third pop
+	_mock.ExpectQuery(_query.SQL.String()).WithArgs("vela").WillReturnRows(sqlmock.NewRows([]string{"item"}).AddRow([]byte("{")))
+
+	// run tests
+	got, err := _service.Pop(context.Background())
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got == nil || got.Build.GetNumber() != 1 {
+		t.Errorf("Pop is %v, want build 1", got)
+	}
+
+	got, err = _service.Pop(context.Background())
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("Pop is %v, want nil", got)
+	}
+
+	_, err = _service.Pop(context.Background())
+	if err == nil {
+		t.Errorf("Pop for invalid item should have returned err")
+	}
+
+	err = _mock.ExpectationsWereMet()
+	if err != nil {
+		t.Errorf("Pop has unmet expectations: %v", err)
+	}
+}
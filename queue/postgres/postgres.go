@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/sirupsen/logrus"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type config struct {
+	// specifies the address to use for the Postgres client
+	Address string
+	// specifies a list of channels for managing builds for the Postgres client
+	Channels []string
+	// specifies the timeout to use for the Postgres client
+	Timeout time.Duration
+	// enables compressing items pushed with zstd for the Postgres client
+	Compression bool
+	// specifies the max size in bytes of items pushed for the Postgres client
+	MaxItemSize int64
+}
+
+type client struct {
+	config *config
+	// https://pkg.go.dev/gorm.io/gorm#DB
+	Postgres *gorm.DB
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// New returns a Queue implementation that
+// integrates with a Postgres queue instance.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Postgres client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.config.Timeout = 60 * time.Second
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("queue", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create the new Postgres database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	_postgres, err := gorm.Open(postgres.Open(c.config.Address), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to Postgres queue: %w", err)
+	}
+
+	c.Postgres = _postgres
+
+	// create the table the items in the queue are stored in
+	err = c.createTable()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// NewTest returns a Queue implementation that
+// integrates with a fake Postgres queue instance.
+//
+// This function is intended for running tests only.
+//
+//nolint:revive // ignore returning unexported client
+func NewTest(channels ...string) (*client, sqlmock.Sqlmock, error) {
+	// create new Postgres client
+	c := new(client)
+
+	// create new fields
+	c.config = &config{
+		Channels: channels,
+		Timeout:  time.Millisecond,
+	}
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("queue", c.Driver())
+
+	// create the new mock sql database
+	//
+	// https://pkg.go.dev/github.com/DATA-DOG/go-sqlmock#New
+	_sql, _mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ensure the mock expects the table queries
+	_mock.ExpectExec(CreatePostgresTable).WillReturnResult(sqlmock.NewResult(1, 1))
+	_mock.ExpectExec(CreateRouteIndex).WillReturnResult(sqlmock.NewResult(1, 1))
+
+	// create the new mock Postgres database client
+	//
+	// https://pkg.go.dev/gorm.io/gorm#Open
+	c.Postgres, err = gorm.Open(
+		postgres.New(postgres.Config{Conn: _sql}),
+		&gorm.Config{SkipDefaultTransaction: true},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// create the table the items in the queue are stored in
+	err = c.createTable()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c, _mock, nil
+}
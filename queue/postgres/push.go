@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// InsertItem represents a query to insert an
+// item on a route in the queue_items table.
+const InsertItem = `
+INSERT INTO queue_items (route, item, created)
+VALUES (?, ?, ?);
+`
+
+// Push inserts an item for the specified channel in the queue.
+func (c *client) Push(ctx context.Context, channel string, item []byte) error {
+	c.Logger.Tracef("pushing item to queue %s", channel)
+
+	// ensure the item to be pushed is valid
+	if item == nil {
+		return errors.New("item is nil")
+	}
+
+	// compress the item and verify its size
+	data, err := c.encode(item)
+	if err != nil {
+		return err
+	}
+
+	// send query to insert the item on the route
+	return c.Postgres.
+		WithContext(ctx).
+		Exec(InsertItem, channel, data, time.Now().UTC().Unix()).
+		Error
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package postgres
+
+import "fmt"
+
+const (
+	// maxRouteLength represents the max length
+	// of the route column in the queue_items table.
+	maxRouteLength = 250
+
+	// CreatePostgresTable represents a query to create the Postgres queue_items table.
+	CreatePostgresTable = `
+CREATE TABLE
+IF NOT EXISTS
+queue_items (
+	id      BIGSERIAL PRIMARY KEY,
+	route   VARCHAR(250) NOT NULL,
+	item    BYTEA NOT NULL,
+	created BIGINT NOT NULL
+);
+`
+
+	// CreateRouteIndex represents a query to create an
+	// index on the queue_items table for the route and id columns.
+	CreateRouteIndex = `
+CREATE INDEX
+IF NOT EXISTS
+queue_items_route_id
+ON queue_items (route, id);
+`
+)
+
+// createTable is a helper function to create the table and
+// index the items in the queue are stored in when they don't
+// already exist.
+func (c *client) createTable() error {
+	c.Logger.Tracef("creating queue_items table in the database")
+
+	// send query to create the queue_items table
+	err := c.Postgres.Exec(CreatePostgresTable).Error
+	if err != nil {
+		return fmt.Errorf("unable to create queue_items table: %w", err)
+	}
+
+	// send query to create the index on the route and id columns
+	err = c.Postgres.Exec(CreateRouteIndex).Error
+	if err != nil {
+		return fmt.Errorf("unable to create queue_items route index: %w", err)
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ package queue
 import (
 	"fmt"
 
+	"github.com/go-vela/server/queue/nats"
+	"github.com/go-vela/server/queue/postgres"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 )
@@ -15,6 +17,8 @@ import (
 // integrating with the configured queue environment.
 // Currently, the following queues are supported:
 //
+// * nats
+// * postgres
 // * redis
 // .
 func New(s *Setup) (Service, error) {
@@ -34,6 +38,16 @@ func New(s *Setup) (Service, error) {
 		//
 		// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#Setup.Kafka
 		return s.Kafka()
+	case nats.DriverNATS:
+		// handle the NATS queue driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#Setup.NATS
+		return s.NATS()
+	case postgres.DriverPostgres:
+		// handle the Postgres queue driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/queue?tab=doc#Setup.Postgres
+		return s.Postgres()
 	case constants.DriverRedis:
 		// handle the Redis queue driver being provided
 		//
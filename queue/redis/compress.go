@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// ErrItemTooLarge represents the error returned when
+// pushing an item larger than the max item size.
+var ErrItemTooLarge = errors.New("queue item exceeds the max item size")
+
+var (
+	// zstdMagic represents the leading bytes of data compressed with zstd.
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	// encoder compresses items pushed to the queue and
+	// is safe for concurrent use with EncodeAll.
+	encoder, _ = zstd.NewWriter(nil)
+
+	// decoder decompresses items popped from the queue and
+	// is safe for concurrent use with DecodeAll.
+	decoder, _ = zstd.NewReader(nil)
+)
+
+// encode is a helper function to compress the item when compression
+// is enabled and verify it doesn't exceed the max item size.
+func (c *client) encode(item []byte) ([]byte, error) {
+	data := item
+
+	if c.config.Compression {
+		data = encoder.EncodeAll(item, make([]byte, 0, len(item)))
+	}
+
+	// check if the item exceeds the max item size
+	if c.config.MaxItemSize > 0 && int64(len(data)) > c.config.MaxItemSize {
+		return nil, fmt.Errorf("%w: item is %d bytes and the max is %d bytes", ErrItemTooLarge, len(data), c.config.MaxItemSize)
+	}
+
+	return data, nil
+}
+
+// decode is a helper function to decompress the item when
+// it was compressed, detected from the leading bytes.
+//
+// This allows items pushed before compression was
+// enabled to be popped off the queue.
+func decode(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, zstdMagic) {
+		return data, nil
+	}
+
+	return decoder.DecodeAll(data, nil)
+}
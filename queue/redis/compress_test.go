@@ -0,0 +1,149 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestRedis_Compression(t *testing.T) {
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	_service, err := New(
+		WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+		WithChannels("vela"),
+		WithTimeout(time.Second),
+		WithCompression(true),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	// push item to queue before compression was enabled
+	_, err = _redis.RPush("vela", string(fairItem(t, "github", "octocat", 1)))
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	err = _service.Push(context.Background(), "vela", fairItem(t, "github", "octocat", 2))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	// verify the item was compressed in the queue
+	values, err := _redis.List("vela")
+	if err != nil {
+		t.Errorf("unable to list items in queue: %v", err)
+	}
+
+	if len(values) != 2 || !bytes.HasPrefix([]byte(values[1]), zstdMagic) {
+		t.Errorf("Push did not compress item in queue")
+	}
+
+	for _, want := range []int{1, 2} {
+		item, err := _service.Pop(context.Background())
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if item.Build.GetNumber() != want {
+			t.Errorf("Pop is build %d, want %d", item.Build.GetNumber(), want)
+		}
+	}
+}
+
+func TestRedis_Compression_Fair(t *testing.T) {
+	// setup redis mock
+	_service, _redis := newFairTest(t, FairShareOrg, nil)
+	defer _redis.Close()
+
+	_service.config.Compression = true
+
+	err := _service.Push(context.Background(), "vela", fairItem(t, "github", "octocat", 1))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	item, err := _service.Pop(context.Background())
+	if err != nil {
+		t.Errorf("Pop returned err: %v", err)
+	}
+
+	if item.Build.GetNumber() != 1 {
+		t.Errorf("Pop is build %d, want %d", item.Build.GetNumber(), 1)
+	}
+}
+
+func TestRedis_MaxItemSize(t *testing.T) {
+	// setup types
+	_item := fairItem(t, "github", "octocat", 1)
+
+	// setup tests
+	tests := []struct {
+		failure     bool
+		compression bool
+		size        int64
+	}{
+		{
+			failure:     false,
+			compression: false,
+			size:        0,
+		},
+		{
+			failure:     false,
+			compression: false,
+			size:        int64(len(_item)),
+		},
+		{
+			failure:     true,
+			compression: false,
+			size:        int64(len(_item)) - 1,
+		},
+		{
+			failure:     true,
+			compression: true,
+			size:        1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := NewTest("vela")
+		if err != nil {
+			t.Errorf("unable to create queue service: %v", err)
+		}
+
+		_service.config.Compression = test.compression
+		_service.config.MaxItemSize = test.size
+
+		err = _service.Push(context.Background(), "vela", _item)
+
+		if test.failure {
+			if !errors.Is(err, ErrItemTooLarge) {
+				t.Errorf("Push should have returned %v, got %v", ErrItemTooLarge, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+}
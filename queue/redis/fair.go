@@ -0,0 +1,228 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-vela/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// FairShareOrg represents scheduling builds
+	// on a channel fairly across the orgs.
+	FairShareOrg = "org"
+
+	// FairShareRepo represents scheduling builds
+	// on a channel fairly across the repos.
+	FairShareRepo = "repo"
+
+	// fairToken represents the value pushed to a channel for each
+	// item queued for fair scheduling. Popping the token reserves
+	// an item from the channel, which is then chosen by weight.
+	fairToken = "vela:fair"
+
+	// fairWeightsKey represents the hash holding the weight for
+	// each org or repo, shared with the workers popping items.
+	fairWeightsKey = "vela:fair:weights"
+
+	// fairDefaultWeight represents the field in the weights hash
+	// holding the weight for orgs or repos without a weight.
+	fairDefaultWeight = "*"
+)
+
+var (
+	// fairPush atomically queues the item for the org or repo,
+	// adds the org or repo to the channel's rotation and pushes
+	// the token reserving the item to the channel.
+	fairPush = redis.NewScript(`
+redis.call('RPUSH', KEYS[4], ARGV[2])
+if redis.call('SADD', KEYS[3], ARGV[1]) == 1 then
+  redis.call('RPUSH', KEYS[2], ARGV[1])
+end
+redis.call('RPUSH', KEYS[1], ARGV[3])
+return 1
+`)
+
+	// fairPop atomically pops the next item in weighted round robin
+	// order. The org or repo at the head of the rotation is served
+	// until it has used its weight or has nothing queued, then it is
+	// moved to the tail of the rotation or removed from it.
+	fairPop = redis.NewScript(`
+for i = 1, redis.call('LLEN', KEYS[1]) do
+  local unit = redis.call('LINDEX', KEYS[1], 0)
+  local items = ARGV[1] .. unit
+  local item = redis.call('LPOP', items)
+  if item then
+    local weight = tonumber(redis.call('HGET', KEYS[4], unit) or redis.call('HGET', KEYS[4], ARGV[2]) or 1)
+    if weight == nil or weight < 1 then
+      weight = 1
+    end
+    local used = redis.call('HINCRBY', KEYS[3], unit, 1)
+    local remaining = redis.call('LLEN', items)
+    if used >= weight or remaining == 0 then
+      redis.call('HDEL', KEYS[3], unit)
+      redis.call('LPOP', KEYS[1])
+      if remaining > 0 then
+        redis.call('RPUSH', KEYS[1], unit)
+      else
+        redis.call('SREM', KEYS[2], unit)
+      end
+    end
+    return {unit, item}
+  end
+  redis.call('LPOP', KEYS[1])
+  redis.call('SREM', KEYS[2], unit)
+  redis.call('HDEL', KEYS[3], unit)
+end
+return false
+`)
+
+	shareWeight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "vela_queue_share_weight",
+			Help: "The weight of the org or repo when scheduling builds fairly on a channel.",
+		},
+		[]string{"unit"},
+	)
+
+	sharePushed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "vela_queue_share_pushed_total",
+			Help: "The number of items pushed for the org or repo when scheduling builds fairly on a channel.",
+		},
+		[]string{"channel", "unit"},
+	)
+
+	sharePopped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "vela_queue_share_popped_total",
+			Help: "The number of items popped for the org or repo when scheduling builds fairly on a channel.",
+		},
+		[]string{"channel", "unit"},
+	)
+)
+
+// fairKeys is a helper function to create the keys used
+// to schedule the items on the channel fairly.
+func fairKeys(channel string) (string, string, string, string) {
+	return channel + ":fair:ring",
+		channel + ":fair:active",
+		channel + ":fair:credits",
+		channel + ":fair:items:"
+}
+
+// fairUnit is a helper function to capture the org or
+// repo the item is scheduled fairly by on the channel.
+func (c *client) fairUnit(item []byte) (string, error) {
+	i := new(types.Item)
+
+	// unmarshal the item to capture the repo for the build
+	err := json.Unmarshal(item, i)
+	if err != nil {
+		return "", err
+	}
+
+	if c.config.FairShare == FairShareRepo {
+		return i.Repo.GetFullName(), nil
+	}
+
+	return i.Repo.GetOrg(), nil
+}
+
+// pushFair is a helper function to push the item to
+// the channel for scheduling fairly by org or repo.
+func (c *client) pushFair(ctx context.Context, channel, unit string, item []byte) error {
+	ring, active, _, items := fairKeys(channel)
+
+	// send script to queue the item for the org or repo
+	err := fairPush.Run(ctx, c.Redis,
+		[]string{channel, ring, active, items + unit},
+		unit, item, fairToken,
+	).Err()
+	if err != nil {
+		return err
+	}
+
+	sharePushed.WithLabelValues(channel, unit).Inc()
+
+	return nil
+}
+
+// popFair is a helper function to pop the next item
+// scheduled fairly by org or repo from the channel.
+func (c *client) popFair(ctx context.Context, channel string) (*types.Item, error) {
+	ring, active, credits, items := fairKeys(channel)
+
+	// send script to pop the next item by weight
+	result, err := fairPop.Run(ctx, c.Redis,
+		[]string{ring, active, credits, fairWeightsKey},
+		items, fairDefaultWeight,
+	).StringSlice()
+	if err != nil {
+		// no item was queued for the token
+		if errors.Is(err, redis.Nil) {
+			c.Logger.Warnf("no item queued for fair scheduling on %s", channel)
+
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	sharePopped.WithLabelValues(channel, result[0]).Inc()
+
+	// decompress the result when it was compressed
+	data, err := decode([]byte(result[1]))
+	if err != nil {
+		return nil, err
+	}
+
+	item := new(types.Item)
+
+	// unmarshal result into queue item
+	err = json.Unmarshal(data, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// setShareWeights is a helper function to publish the weights
+// for scheduling fairly by org or repo to the workers.
+func (c *client) setShareWeights(ctx context.Context) error {
+	weights := map[string]interface{}{
+		fairDefaultWeight: strconv.FormatInt(c.config.DefaultShareWeight, 10),
+	}
+
+	for unit, weight := range c.config.ShareWeights {
+		weights[unit] = strconv.FormatInt(weight, 10)
+
+		shareWeight.WithLabelValues(unit).Set(float64(weight))
+	}
+
+	shareWeight.WithLabelValues(fairDefaultWeight).Set(float64(c.config.DefaultShareWeight))
+
+	// replace the weights published by a previous configuration
+	_, err := c.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, fairWeightsKey)
+		pipe.HSet(ctx, fairWeightsKey, weights)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to set queue share weights: %w", err)
+	}
+
+	return nil
+}
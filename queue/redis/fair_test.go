@@ -0,0 +1,189 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// newFairTest is a helper function to create a queue
+// client scheduling builds fairly by the provided unit.
+func newFairTest(t *testing.T, unit string, weights map[string]int64) (*client, *miniredis.Miniredis) {
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("unable to create miniredis instance: %v", err)
+	}
+
+	_service, err := New(
+		WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+		WithChannels("vela"),
+		WithTimeout(time.Second),
+		WithFairShare(unit),
+		WithShareWeights(weights),
+	)
+	if err != nil {
+		t.Fatalf("unable to create queue service: %v", err)
+	}
+
+	return _service, _redis
+}
+
+// fairItem is a helper function to create the queue
+// item for the build number in the org and repo.
+func fairItem(t *testing.T, org, name string, number int) []byte {
+	r := new(library.Repo)
+	r.SetOrg(org)
+	r.SetName(name)
+	r.SetFullName(fmt.Sprintf("%s/%s", org, name))
+
+	b := new(library.Build)
+	b.SetNumber(number)
+
+	bytes, err := json.Marshal(&types.Item{Build: b, Repo: r})
+	if err != nil {
+		t.Fatalf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
+
+func TestRedis_Fair_Org(t *testing.T) {
+	// setup redis mock
+	_service, _redis := newFairTest(t, FairShareOrg, map[string]int64{"github": 2})
+	defer _redis.Close()
+
+	// push items to queue with one org pushing most of the builds
+	pushes := []struct {
+		org    string
+		number int
+	}{
+		{org: "github", number: 1},
+		{org: "github", number: 2},
+		{org: "github", number: 3},
+		{org: "github", number: 4},
+		{org: "octocat", number: 5},
+		{org: "octocat", number: 6},
+	}
+
+	for _, push := range pushes {
+		err := _service.Push(context.Background(), "vela", fairItem(t, push.org, "hello-world", push.number))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	got := []int{}
+
+	for range pushes {
+		item, err := _service.Pop(context.Background())
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		got = append(got, item.Build.GetNumber())
+	}
+
+	// github is served twice for each build served for octocat
+	want := []int{1, 2, 5, 3, 4, 6}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop order is %v, want %v", got, want)
+	}
+
+	// verify the rotation is empty once all items are popped
+	if _redis.Exists("vela:fair:ring") || _redis.Exists("vela:fair:active") {
+		t.Errorf("Pop left %v in the rotation", _redis.Keys())
+	}
+}
+
+func TestRedis_Fair_Repo(t *testing.T) {
+	// setup redis mock
+	_service, _redis := newFairTest(t, FairShareRepo, nil)
+	defer _redis.Close()
+
+	// push items to queue for two repos in the same org
+	for i, name := range []string{"octocat", "octocat", "hello-world"} {
+		err := _service.Push(context.Background(), "vela", fairItem(t, "github", name, i+1))
+		if err != nil {
+			t.Errorf("Push returned err: %v", err)
+		}
+	}
+
+	got := []int{}
+
+	for i := 0; i < 3; i++ {
+		item, err := _service.Pop(context.Background())
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		got = append(got, item.Build.GetNumber())
+	}
+
+	want := []int{1, 3, 2}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Pop order is %v, want %v", got, want)
+	}
+}
+
+func TestRedis_Fair_Legacy(t *testing.T) {
+	// setup redis mock
+	_service, _redis := newFairTest(t, FairShareOrg, nil)
+	defer _redis.Close()
+
+	// push item to queue before scheduling builds fairly
+	_, err := _redis.RPush("vela", string(fairItem(t, "github", "octocat", 1)))
+	if err != nil {
+		t.Errorf("unable to push item to queue: %v", err)
+	}
+
+	err = _service.Push(context.Background(), "vela", fairItem(t, "github", "octocat", 2))
+	if err != nil {
+		t.Errorf("Push returned err: %v", err)
+	}
+
+	for _, want := range []int{1, 2} {
+		item, err := _service.Pop(context.Background())
+		if err != nil {
+			t.Errorf("Pop returned err: %v", err)
+		}
+
+		if item.Build.GetNumber() != want {
+			t.Errorf("Pop is build %d, want %d", item.Build.GetNumber(), want)
+		}
+	}
+}
+
+func TestRedis_Fair_Weights(t *testing.T) {
+	// setup redis mock
+	_service, _redis := newFairTest(t, FairShareOrg, map[string]int64{"github": 2})
+	defer _redis.Close()
+
+	got, err := _redis.HKeys(fairWeightsKey)
+	if err != nil {
+		t.Errorf("unable to capture share weights: %v", err)
+	}
+
+	if len(got) != 2 || _redis.HGet(fairWeightsKey, "github") != "2" || _redis.HGet(fairWeightsKey, "*") != "1" {
+		t.Errorf("share weights are %v, want github=2 and *=1", got)
+	}
+
+	if _service.config.DefaultShareWeight != 1 {
+		t.Errorf("DefaultShareWeight is %d, want 1", _service.config.DefaultShareWeight)
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// queuePurge atomically deletes the items on the channel,
+	// including the items queued for fair scheduling, and
+	// returns the number of items that were deleted.
+	queuePurge = redis.NewScript(`
+local count = redis.call('LLEN', KEYS[1]) - redis.call('LREM', KEYS[1], 0, ARGV[1])
+for _, unit in ipairs(redis.call('SMEMBERS', KEYS[3])) do
+  count = count + redis.call('LLEN', ARGV[2] .. unit)
+  redis.call('DEL', ARGV[2] .. unit)
+end
+redis.call('DEL', KEYS[1], KEYS[2], KEYS[3], KEYS[4])
+return count
+`)
+
+	// queueMove atomically moves the items on the source channel,
+	// including the items queued for fair scheduling, to the tail
+	// of the target channel and returns the number of items moved.
+	queueMove = redis.NewScript(`
+redis.call('LREM', KEYS[1], 0, ARGV[1])
+local count = 0
+local item = redis.call('LPOP', KEYS[1])
+while item do
+  redis.call('RPUSH', KEYS[5], item)
+  count = count + 1
+  item = redis.call('LPOP', KEYS[1])
+end
+for _, unit in ipairs(redis.call('SMEMBERS', KEYS[3])) do
+  local source = ARGV[2] .. unit
+  local target = ARGV[3] .. unit
+  item = redis.call('LPOP', source)
+  while item do
+    redis.call('RPUSH', target, item)
+    redis.call('RPUSH', KEYS[5], ARGV[1])
+    count = count + 1
+    item = redis.call('LPOP', source)
+  end
+  if redis.call('SADD', KEYS[7], unit) == 1 then
+    redis.call('RPUSH', KEYS[6], unit)
+  end
+end
+redis.call('DEL', KEYS[2], KEYS[3], KEYS[4])
+return count
+`)
+)
+
+// Inspect captures the memory used by the queue and the items waiting
+// on the routes, defaulting to the configured channels, with the
+// metadata for up to the provided number of items on each route.
+func (c *client) Inspect(ctx context.Context, routes []string, sample int) (*api.QueueSummary, error) {
+	c.Logger.Tracef("inspecting queue routes %s", routes)
+
+	if len(routes) == 0 {
+		routes = c.config.Channels
+	}
+
+	summary := new(api.QueueSummary)
+
+	// capture the memory used by the queue
+	memory, err := c.usedMemory(ctx)
+	if err != nil {
+		// not every Redis compatible server supports the memory section
+		c.Logger.Debugf("unable to capture memory used by queue: %v", err)
+	} else {
+		summary.SetUsedMemory(memory)
+	}
+
+	result := []*api.QueueRoute{}
+
+	for _, route := range routes {
+		r, err := c.inspectRoute(ctx, route, sample)
+		if err != nil {
+			return nil, fmt.Errorf("unable to inspect queue route %s: %w", route, err)
+		}
+
+		result = append(result, r)
+	}
+
+	summary.SetRoutes(result)
+
+	return summary, nil
+}
+
+// Purge deletes the items waiting on the route and
+// returns the number of items that were deleted.
+func (c *client) Purge(ctx context.Context, route string) (int64, error) {
+	c.Logger.Tracef("purging items from queue %s", route)
+
+	ring, active, credits, items := fairKeys(route)
+
+	// send script to delete the items on the route
+	count, err := queuePurge.Run(ctx, c.Redis,
+		[]string{route, ring, active, credits},
+		fairToken, items,
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Move moves the items waiting on the source route to the target
+// route and returns the number of items that were moved.
+func (c *client) Move(ctx context.Context, source, target string) (int64, error) {
+	c.Logger.Tracef("moving items from queue %s to %s", source, target)
+
+	// ensure the items are moved to another route
+	if strings.EqualFold(source, target) {
+		return 0, errors.New("source and target routes must be different")
+	}
+
+	sourceRing, sourceActive, sourceCredits, sourceItems := fairKeys(source)
+	targetRing, targetActive, _, targetItems := fairKeys(target)
+
+	// send script to move the items to the target route
+	count, err := queueMove.Run(ctx, c.Redis,
+		[]string{source, sourceRing, sourceActive, sourceCredits, target, targetRing, targetActive},
+		fairToken, sourceItems, targetItems,
+	).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// inspectRoute is a helper function to capture the items waiting on the
+// route with the metadata for up to the provided number of items.
+func (c *client) inspectRoute(ctx context.Context, route string, sample int) (*api.QueueRoute, error) {
+	_, active, _, items := fairKeys(route)
+
+	r := new(api.QueueRoute)
+	r.SetRoute(route)
+
+	// capture the number of items on the route, where each
+	// item queued for fair scheduling is counted by its token
+	length, err := c.Redis.LLen(ctx, route).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	r.SetLength(length)
+
+	// always capture the items at the front of
+	// the route to find the age of the oldest
+	limit := int64(sample)
+	if limit < 1 {
+		limit = 1
+	}
+
+	raw, err := c.Redis.LRange(ctx, route, 0, limit-1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	// capture the items at the front of each org or repo
+	// queued for fair scheduling on the route
+	units, err := c.Redis.SMembers(ctx, active).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, unit := range units {
+		queued, err := c.Redis.LRange(ctx, items+unit, 0, limit-1).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		raw = append(raw, queued...)
+	}
+
+	sampled := []*api.QueueItem{}
+
+	for _, data := range raw {
+		if data == fairToken {
+			continue
+		}
+
+		sampled = append(sampled, c.itemMetadata([]byte(data)))
+	}
+
+	// order the items from oldest to newest
+	sort.SliceStable(sampled, func(i, j int) bool {
+		return sampled[i].GetEnqueued() < sampled[j].GetEnqueued()
+	})
+
+	if len(sampled) > 0 && sampled[0].GetEnqueued() > 0 {
+		r.SetOldestAge(time.Now().UTC().Unix() - sampled[0].GetEnqueued())
+	}
+
+	if len(sampled) > sample {
+		sampled = sampled[:sample]
+	}
+
+	r.SetItems(sampled)
+
+	return r, nil
+}
+
+// itemMetadata is a helper function to capture the metadata
+// for the item without the pipeline or credentials.
+func (c *client) itemMetadata(data []byte) *api.QueueItem {
+	i := new(api.QueueItem)
+	i.SetSize(int64(len(data)))
+	i.SetCompressed(bytes.HasPrefix(data, zstdMagic))
+
+	// decompress the item when it was compressed
+	decoded, err := decode(data)
+	if err != nil {
+		c.Logger.Warnf("unable to decompress queue item: %v", err)
+
+		return i
+	}
+
+	item := new(types.Item)
+
+	// unmarshal the item to capture the build
+	err = json.Unmarshal(decoded, item)
+	if err != nil {
+		c.Logger.Warnf("unable to unmarshal queue item: %v", err)
+
+		return i
+	}
+
+	i.SetBuildID(item.Build.GetID())
+	i.SetRepo(item.Repo.GetFullName())
+	i.SetNumber(int64(item.Build.GetNumber()))
+	i.SetEnqueued(item.Build.GetEnqueued())
+
+	return i
+}
+
+// usedMemory is a helper function to capture the
+// memory used by the queue in bytes.
+func (c *client) usedMemory(ctx context.Context) (int64, error) {
+	info, err := c.Redis.Info(ctx, "memory").Result()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, line := range strings.Split(info, "\n") {
+		line = strings.TrimSpace(line)
+
+		if !strings.HasPrefix(line, "used_memory:") {
+			continue
+		}
+
+		return strconv.ParseInt(strings.TrimPrefix(line, "used_memory:"), 10, 64)
+	}
+
+	return 0, errors.New("used_memory not found in memory info")
+}
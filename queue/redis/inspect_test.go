@@ -0,0 +1,279 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// inspectItem is a helper function to create the queue item
+// for the build number enqueued at the provided time.
+func inspectItem(t *testing.T, org string, number int, enqueued int64) []byte {
+	r := new(library.Repo)
+	r.SetOrg(org)
+	r.SetName("hello-world")
+	r.SetFullName(fmt.Sprintf("%s/hello-world", org))
+
+	b := new(library.Build)
+	b.SetID(int64(number))
+	b.SetNumber(number)
+	b.SetEnqueued(enqueued)
+
+	bytes, err := json.Marshal(&types.Item{Build: b, Repo: r})
+	if err != nil {
+		t.Fatalf("unable to marshal queue item: %v", err)
+	}
+
+	return bytes
+}
+
+func TestRedis_Inspect(t *testing.T) {
+	// setup types
+	now := time.Now().UTC().Unix()
+
+	// setup tests
+	tests := []struct {
+		name string
+		fair string
+	}{
+		{
+			name: "without fair share",
+		},
+		{
+			name: "with fair share",
+			fair: FairShareOrg,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup redis mock
+			_redis, err := miniredis.Run()
+			if err != nil {
+				t.Fatalf("unable to create miniredis instance: %v", err)
+			}
+			defer _redis.Close()
+
+			_service, err := New(
+				WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+				WithChannels("vela"),
+				WithFairShare(test.fair),
+				WithCompression(true),
+			)
+			if err != nil {
+				t.Fatalf("unable to create queue service: %v", err)
+			}
+
+			pushes := []struct {
+				org      string
+				number   int
+				enqueued int64
+			}{
+				{org: "github", number: 1, enqueued: now - 60},
+				{org: "octocat", number: 2, enqueued: now - 30},
+				{org: "github", number: 3, enqueued: now - 10},
+			}
+
+			for _, push := range pushes {
+				err = _service.Push(context.Background(), "vela", inspectItem(t, push.org, push.number, push.enqueued))
+				if err != nil {
+					t.Fatalf("Push returned err: %v", err)
+				}
+			}
+
+			got, err := _service.Inspect(context.Background(), nil, 2)
+			if err != nil {
+				t.Errorf("Inspect returned err: %v", err)
+			}
+
+			if len(got.GetRoutes()) != 1 {
+				t.Fatalf("Inspect returned %d routes, want 1", len(got.GetRoutes()))
+			}
+
+			route := got.GetRoutes()[0]
+
+			if route.GetRoute() != "vela" {
+				t.Errorf("Inspect route is %s, want vela", route.GetRoute())
+			}
+
+			if route.GetLength() != 3 {
+				t.Errorf("Inspect length is %d, want 3", route.GetLength())
+			}
+
+			if route.GetOldestAge() < 60 {
+				t.Errorf("Inspect oldest age is %d, want at least 60", route.GetOldestAge())
+			}
+
+			if len(route.GetItems()) != 2 {
+				t.Fatalf("Inspect returned %d items, want 2", len(route.GetItems()))
+			}
+
+			for i, item := range route.GetItems() {
+				if item.GetNumber() != int64(pushes[i].number) {
+					t.Errorf("Inspect item %d number is %d, want %d", i, item.GetNumber(), pushes[i].number)
+				}
+
+				if item.GetRepo() != fmt.Sprintf("%s/hello-world", pushes[i].org) {
+					t.Errorf("Inspect item %d repo is %s", i, item.GetRepo())
+				}
+
+				if !item.GetCompressed() || item.GetSize() == 0 {
+					t.Errorf("Inspect item %d is %v, want compressed with a size", i, item)
+				}
+			}
+		})
+	}
+}
+
+func TestRedis_Purge(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		fair string
+	}{
+		{
+			name: "without fair share",
+		},
+		{
+			name: "with fair share",
+			fair: FairShareRepo,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup redis mock
+			_redis, err := miniredis.Run()
+			if err != nil {
+				t.Fatalf("unable to create miniredis instance: %v", err)
+			}
+			defer _redis.Close()
+
+			_service, err := New(
+				WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+				WithChannels("vela"),
+				WithTimeout(time.Second),
+				WithFairShare(test.fair),
+			)
+			if err != nil {
+				t.Fatalf("unable to create queue service: %v", err)
+			}
+
+			for i := 1; i <= 3; i++ {
+				err = _service.Push(context.Background(), "vela", inspectItem(t, "github", i, 0))
+				if err != nil {
+					t.Fatalf("Push returned err: %v", err)
+				}
+			}
+
+			got, err := _service.Purge(context.Background(), "vela")
+			if err != nil {
+				t.Errorf("Purge returned err: %v", err)
+			}
+
+			if got != 3 {
+				t.Errorf("Purge is %d, want 3", got)
+			}
+
+			item, err := _service.Pop(context.Background())
+			if err != nil {
+				t.Errorf("Pop returned err: %v", err)
+			}
+
+			if item != nil {
+				t.Errorf("Pop is %v, want nil", item)
+			}
+		})
+	}
+}
+
+func TestRedis_Move(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		fair string
+	}{
+		{
+			name: "without fair share",
+		},
+		{
+			name: "with fair share",
+			fair: FairShareOrg,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup redis mock
+			_redis, err := miniredis.Run()
+			if err != nil {
+				t.Fatalf("unable to create miniredis instance: %v", err)
+			}
+			defer _redis.Close()
+
+			_service, err := New(
+				WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+				WithChannels("docker:linux"),
+				WithTimeout(time.Second),
+				WithFairShare(test.fair),
+			)
+			if err != nil {
+				t.Fatalf("unable to create queue service: %v", err)
+			}
+
+			for i := 1; i <= 3; i++ {
+				err = _service.Push(context.Background(), "vela", inspectItem(t, "github", i, 0))
+				if err != nil {
+					t.Fatalf("Push returned err: %v", err)
+				}
+			}
+
+			_, err = _service.Move(context.Background(), "vela", "vela")
+			if err == nil {
+				t.Errorf("Move to the same route should have returned err")
+			}
+
+			got, err := _service.Move(context.Background(), "vela", "docker:linux")
+			if err != nil {
+				t.Errorf("Move returned err: %v", err)
+			}
+
+			if got != 3 {
+				t.Errorf("Move is %d, want 3", got)
+			}
+
+			for i := 1; i <= 3; i++ {
+				item, err := _service.Pop(context.Background())
+				if err != nil {
+					t.Errorf("Pop returned err: %v", err)
+				}
+
+				if item.Build.GetNumber() != i {
+					t.Errorf("Pop is build %d, want %d", item.Build.GetNumber(), i)
+				}
+			}
+
+			length, err := _service.Redis.LLen(context.Background(), "vela").Result()
+			if err != nil {
+				t.Errorf("LLen returned err: %v", err)
+			}
+
+			if length != 0 {
+				t.Errorf("LLen is %d, want 0", length)
+			}
+		})
+	}
+}
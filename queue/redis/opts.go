@@ -69,3 +69,92 @@ func WithTimeout(timeout time.Duration) ClientOpt {
 		return nil
 	}
 }
+
+// WithFairShare sets the org or repo to schedule builds fairly by in the queue client for Redis.
+func WithFairShare(unit string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring fair share in redis queue client")
+
+		// check if the fair share provided is supported
+		switch unit {
+		case "", FairShareOrg, FairShareRepo:
+		default:
+			return fmt.Errorf("invalid Redis queue fair share provided: %s", unit)
+		}
+
+		// set the queue fair share in the redis client
+		c.config.FairShare = unit
+
+		return nil
+	}
+}
+
+// WithShareWeights sets the weight for each org or repo in the queue client for Redis.
+func WithShareWeights(weights map[string]int64) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring share weights in redis queue client")
+
+		// check if the share weights provided are positive
+		for unit, weight := range weights {
+			if weight < 1 {
+				return fmt.Errorf("invalid Redis queue share weight provided for %s: %d", unit, weight)
+			}
+		}
+
+		// set the queue share weights in the redis client
+		c.config.ShareWeights = weights
+
+		return nil
+	}
+}
+
+// WithDefaultShareWeight sets the weight for orgs or repos without a weight in the queue client for Redis.
+func WithDefaultShareWeight(weight int64) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring default share weight in redis queue client")
+
+		// check if the default share weight provided is negative
+		if weight < 0 {
+			return fmt.Errorf("invalid Redis queue default share weight provided: %d", weight)
+		}
+
+		// skip setting the default share weight if none is provided
+		if weight == 0 {
+			return nil
+		}
+
+		// set the queue default share weight in the redis client
+		c.config.DefaultShareWeight = weight
+
+		return nil
+	}
+}
+
+// WithCompression sets the compression mode in the queue client for Redis.
+func WithCompression(compression bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring compression mode in redis queue client")
+
+		// set the queue compression mode in the redis client
+		c.config.Compression = compression
+
+		return nil
+	}
+}
+
+// WithMaxItemSize sets the max size of items in the queue client for Redis.
+func WithMaxItemSize(size int64) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring max item size in redis queue client")
+
+		// check if the max item size provided is negative
+		if size < 0 {
+			return fmt.Errorf("invalid Redis queue max item size provided: %d", size)
+		}
+
+		// set the queue max item size in the redis client
+		c.config.MaxItemSize = size
+
+		return nil
+	}
+}
@@ -180,3 +180,276 @@ func TestRedis_ClientOpt_WithCluster(t *testing.T) {
 		}
 	}
 }
+
+func TestRedis_ClientOpt_WithFairShare(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		failure bool
+		unit    string
+		want    string
+	}{
+		{
+			failure: false,
+			unit:    "org",
+			want:    "org",
+		},
+		{
+			failure: false,
+			unit:    "repo",
+			want:    "repo",
+		},
+		{
+			failure: false,
+			unit:    "",
+			want:    "",
+		},
+		{
+			failure: true,
+			unit:    "user",
+			want:    "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithFairShare(test.unit),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithFairShare should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithFairShare returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.FairShare, test.want) {
+			t.Errorf("WithFairShare is %v, want %v", _service.config.FairShare, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithShareWeights(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		failure bool
+		weights map[string]int64
+		want    map[string]int64
+	}{
+		{
+			failure: false,
+			weights: map[string]int64{"github": 2},
+			want:    map[string]int64{"github": 2},
+		},
+		{
+			failure: true,
+			weights: map[string]int64{"github": 0},
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithShareWeights(test.weights),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithShareWeights should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithShareWeights returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.ShareWeights, test.want) {
+			t.Errorf("WithShareWeights is %v, want %v", _service.config.ShareWeights, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithDefaultShareWeight(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		failure bool
+		weight  int64
+		want    int64
+	}{
+		{
+			failure: false,
+			weight:  3,
+			want:    3,
+		},
+		{
+			failure: false,
+			weight:  0,
+			want:    1,
+		},
+		{
+			failure: true,
+			weight:  -1,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithDefaultShareWeight(test.weight),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithDefaultShareWeight should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithDefaultShareWeight returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.DefaultShareWeight, test.want) {
+			t.Errorf("WithDefaultShareWeight is %v, want %v", _service.config.DefaultShareWeight, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithCompression(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		compression bool
+		want        bool
+	}{
+		{
+			compression: true,
+			want:        true,
+		},
+		{
+			compression: false,
+			want:        false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithCompression(test.compression),
+		)
+		if err != nil {
+			t.Errorf("WithCompression returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Compression, test.want) {
+			t.Errorf("WithCompression is %v, want %v", _service.config.Compression, test.want)
+		}
+	}
+}
+
+func TestRedis_ClientOpt_WithMaxItemSize(t *testing.T) {
+	// setup tests
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+	defer _redis.Close()
+
+	tests := []struct {
+		failure bool
+		size    int64
+		want    int64
+	}{
+		{
+			failure: false,
+			size:    1024,
+			want:    1024,
+		},
+		{
+			failure: false,
+			size:    0,
+			want:    0,
+		},
+		{
+			failure: true,
+			size:    -1,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+			WithMaxItemSize(test.size),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithMaxItemSize should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithMaxItemSize returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.MaxItemSize, test.want) {
+			t.Errorf("WithMaxItemSize is %v, want %v", _service.config.MaxItemSize, test.want)
+		}
+	}
+}
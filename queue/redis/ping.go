@@ -0,0 +1,14 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import "context"
+
+// Ping verifies the connection to the queue is still alive.
+func (c *client) Ping(ctx context.Context) error {
+	c.Logger.Trace("pinging the queue")
+
+	return c.Redis.Ping(ctx).Err()
+}
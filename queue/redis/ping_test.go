@@ -0,0 +1,48 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestRedis_Ping(t *testing.T) {
+	// setup types
+	// create a local fake redis instance
+	//
+	// https://pkg.go.dev/github.com/alicebob/miniredis/v2#Run
+	_redis, err := miniredis.Run()
+	if err != nil {
+		t.Errorf("unable to create miniredis instance: %v", err)
+	}
+
+	_service, err := New(
+		WithAddress(fmt.Sprintf("redis://%s", _redis.Addr())),
+		WithChannels("foo"),
+		WithCluster(false),
+		WithTimeout(5*time.Second),
+	)
+	if err != nil {
+		t.Errorf("unable to create queue service: %v", err)
+	}
+
+	// run test
+	err = _service.Ping(context.TODO())
+	if err != nil {
+		t.Errorf("Ping returned err: %v", err)
+	}
+
+	_redis.Close()
+
+	err = _service.Ping(context.TODO())
+	if err == nil {
+		t.Errorf("Ping for closed queue should have returned err")
+	}
+}
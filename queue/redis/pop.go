@@ -35,10 +35,21 @@ func (c *client) Pop(ctx context.Context) (*types.Item, error) {
 		return nil, err
 	}
 
+	// check if the token for an item scheduled fairly was popped
+	if result[1] == fairToken {
+		return c.popFair(ctx, result[0])
+	}
+
+	// decompress the result when it was compressed
+	data, err := decode([]byte(result[1]))
+	if err != nil {
+		return nil, err
+	}
+
 	item := new(types.Item)
 
 	// unmarshal result into queue item
-	err = json.Unmarshal([]byte(result[1]), item)
+	err = json.Unmarshal(data, item)
 	if err != nil {
 		return nil, err
 	}
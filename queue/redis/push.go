@@ -21,15 +21,31 @@ func (c *client) Push(ctx context.Context, channel string, item []byte) error {
 		return errors.New("item is nil")
 	}
 
+	// compress the item and verify its size
+	data, err := c.encode(item)
+	if err != nil {
+		return err
+	}
+
+	// check if builds are scheduled fairly
+	if len(c.config.FairShare) > 0 {
+		unit, err := c.fairUnit(item)
+		if err == nil && len(unit) > 0 {
+			return c.pushFair(ctx, channel, unit, data)
+		}
+
+		c.Logger.Debugf("pushing item to queue %s without fair share: unable to capture %s", channel, c.config.FairShare)
+	}
+
 	// build a redis queue command to push an item to queue
 	//
 	// https://pkg.go.dev/github.com/go-redis/redis?tab=doc#Client.RPush
-	pushCmd := c.Redis.RPush(ctx, channel, item)
+	pushCmd := c.Redis.RPush(ctx, channel, data)
 
 	// blocking call to push an item to queue and return err
 	//
 	// https://pkg.go.dev/github.com/go-redis/redis?tab=doc#IntCmd.Err
-	err := pushCmd.Err()
+	err = pushCmd.Err()
 	if err != nil {
 		return err
 	}
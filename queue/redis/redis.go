@@ -25,6 +25,16 @@ type config struct {
 	Cluster bool
 	// specifies the timeout to use for the Redis client
 	Timeout time.Duration
+	// specifies the org or repo to schedule builds fairly by for the Redis client
+	FairShare string
+	// specifies the weight for each org or repo when scheduling builds fairly for the Redis client
+	ShareWeights map[string]int64
+	// specifies the weight for orgs or repos without a weight for the Redis client
+	DefaultShareWeight int64
+	// enables compressing items pushed with zstd for the Redis client
+	Compression bool
+	// specifies the max size in bytes of items pushed for the Redis client
+	MaxItemSize int64
 }
 
 type client struct {
@@ -45,6 +55,7 @@ func New(opts ...ClientOpt) (*client, error) {
 
 	// create new fields
 	c.config = new(config)
+	c.config.DefaultShareWeight = 1
 	c.Redis = new(redis.Client)
 	c.Options = new(redis.Options)
 
@@ -90,6 +101,15 @@ func New(opts ...ClientOpt) (*client, error) {
 		return nil, err
 	}
 
+	// check if builds are scheduled fairly
+	if len(c.config.FairShare) > 0 {
+		// publish the weights to the workers
+		err = c.setShareWeights(context.Background())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
@@ -7,10 +7,16 @@ package queue
 import (
 	"context"
 
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/queue/redis"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/pipeline"
 )
 
+// ErrItemTooLarge represents the error returned when
+// pushing an item larger than the max item size.
+var ErrItemTooLarge = redis.ErrItemTooLarge
+
 // Service represents the interface for Vela integrating
 // with the different supported Queue backends.
 type Service interface {
@@ -20,10 +26,27 @@ type Service interface {
 	// the configured queue driver.
 	Driver() string
 
+	// Ping defines a function that verifies
+	// the connection to the queue is alive.
+	Ping(context.Context) error
+
+	// Inspect defines a function that captures the
+	// memory used by the queue and the items waiting
+	// on the specified routes in the queue.
+	Inspect(context.Context, []string, int) (*api.QueueSummary, error)
+
+	// Move defines a function that moves the items
+	// waiting on a route to another route in the queue.
+	Move(context.Context, string, string) (int64, error)
+
 	// Pop defines a function that grabs an
 	// item off the queue.
 	Pop(context.Context) (*types.Item, error)
 
+	// Purge defines a function that deletes the items
+	// waiting on the specified route in the queue.
+	Purge(context.Context, string) (int64, error)
+
 	// Push defines a function that publishes an
 	// item to the specified route in the queue.
 	Push(context.Context, string, []byte) error
@@ -6,9 +6,12 @@ package queue
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/go-vela/server/queue/nats"
+	"github.com/go-vela/server/queue/postgres"
 	"github.com/go-vela/server/queue/redis"
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
@@ -30,6 +33,16 @@ type Setup struct {
 	Routes []string
 	// specifies the timeout for pop requests for the queue client
 	Timeout time.Duration
+	// specifies the org or repo to schedule builds fairly by on a route for the queue client
+	FairShare string
+	// specifies a list of <org>=<weight> or <org>/<repo>=<weight> shares for the queue client
+	ShareWeights []string
+	// specifies the weight for orgs or repos without a share for the queue client
+	DefaultShareWeight int64
+	// enables compressing items published for the queue client
+	Compression bool
+	// specifies the max size in bytes of items published for the queue client
+	MaxItemSize int64
 }
 
 // Redis creates and returns a Vela service capable
@@ -37,6 +50,12 @@ type Setup struct {
 func (s *Setup) Redis() (Service, error) {
 	logrus.Trace("creating redis queue client from setup")
 
+	// parse the share weights for scheduling builds fairly
+	weights, err := parseShareWeights(s.ShareWeights)
+	if err != nil {
+		return nil, err
+	}
+
 	// create new Redis queue service
 	//
 	// https://pkg.go.dev/github.com/go-vela/server/queue/redis?tab=doc#New
@@ -45,6 +64,46 @@ func (s *Setup) Redis() (Service, error) {
 		redis.WithChannels(s.Routes...),
 		redis.WithCluster(s.Cluster),
 		redis.WithTimeout(s.Timeout),
+		redis.WithFairShare(s.FairShare),
+		redis.WithShareWeights(weights),
+		redis.WithDefaultShareWeight(s.DefaultShareWeight),
+		redis.WithCompression(s.Compression),
+		redis.WithMaxItemSize(s.MaxItemSize),
+	)
+}
+
+// NATS creates and returns a Vela service capable
+// of integrating with a NATS JetStream queue.
+func (s *Setup) NATS() (Service, error) {
+	logrus.Trace("creating nats queue client from setup")
+
+	// create new NATS queue service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/queue/nats?tab=doc#New
+	return nats.New(
+		nats.WithAddress(s.Address),
+		nats.WithChannels(s.Routes...),
+		nats.WithCluster(s.Cluster),
+		nats.WithTimeout(s.Timeout),
+		nats.WithCompression(s.Compression),
+		nats.WithMaxItemSize(s.MaxItemSize),
+	)
+}
+
+// Postgres creates and returns a Vela service capable
+// of integrating with a Postgres queue.
+func (s *Setup) Postgres() (Service, error) {
+	logrus.Trace("creating postgres queue client from setup")
+
+	// create new Postgres queue service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/queue/postgres?tab=doc#New
+	return postgres.New(
+		postgres.WithAddress(s.Address),
+		postgres.WithChannels(s.Routes...),
+		postgres.WithTimeout(s.Timeout),
+		postgres.WithCompression(s.Compression),
+		postgres.WithMaxItemSize(s.MaxItemSize),
 	)
 }
 
@@ -86,6 +145,52 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("no queue routes provided")
 	}
 
+	// verify the queue max item size is valid
+	if s.MaxItemSize < 0 {
+		return fmt.Errorf("queue max item size must not be negative")
+	}
+
+	// check if builds are scheduled fairly
+	if len(s.FairShare) > 0 {
+		// verify the queue driver supports fair scheduling
+		if s.Driver == nats.DriverNATS || s.Driver == postgres.DriverPostgres {
+			return fmt.Errorf("queue fair share is not supported by the %s queue driver", s.Driver)
+		}
+
+		// verify the queue fair share is supported
+		if s.FairShare != redis.FairShareOrg && s.FairShare != redis.FairShareRepo {
+			return fmt.Errorf("queue fair share must be %s or %s", redis.FairShareOrg, redis.FairShareRepo)
+		}
+
+		// verify the queue share weights are valid
+		_, err := parseShareWeights(s.ShareWeights)
+		if err != nil {
+			return err
+		}
+	}
+
 	// setup is valid
 	return nil
 }
+
+// parseShareWeights is a helper function to parse the list of
+// <org>=<weight> or <org>/<repo>=<weight> shares for the queue.
+func parseShareWeights(shares []string) (map[string]int64, error) {
+	weights := make(map[string]int64)
+
+	for _, share := range shares {
+		unit, weight, ok := strings.Cut(share, "=")
+		if !ok || len(unit) == 0 {
+			return nil, fmt.Errorf("queue share weight %s must be <org>=<weight> or <org>/<repo>=<weight>", share)
+		}
+
+		w, err := strconv.ParseInt(weight, 10, 64)
+		if err != nil || w < 1 {
+			return nil, fmt.Errorf("queue share weight for %s must be a positive integer", unit)
+		}
+
+		weights[unit] = w
+	}
+
+	return weights, nil
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package reencrypt provides the ability for Vela to periodically
+// re-encrypt the user tokens and repo hashes that are not sealed
+// with the current key from the configured kms, so the fields are
+// moved off of the static encryption key or a rotated key online.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/reencrypt"
+package reencrypt
@@ -0,0 +1,34 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reencrypt
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the re-encrypter.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Re-encrypt Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_REENCRYPT_INTERVAL", "REENCRYPT_INTERVAL"},
+		FilePath: "/vela/reencrypt/interval",
+		Name:     "reencrypt.interval",
+		Usage:    "interval at which to re-encrypt user tokens and repo hashes not sealed with the current kms key (disabled when set to 0)",
+		Value:    time.Hour,
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_REENCRYPT_BATCH_SIZE", "REENCRYPT_BATCH_SIZE"},
+		FilePath: "/vela/reencrypt/batch_size",
+		Name:     "reencrypt.batch-size",
+		Usage:    "maximum number of users and repos to re-encrypt at each interval",
+		Value:    100,
+	},
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reencrypt
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the re-encrypter.
+type Opt func(*Reencrypter) error
+
+// WithDatabase sets the database service in the re-encrypter.
+func WithDatabase(db database.Service) Opt {
+	return func(r *Reencrypter) error {
+		// set the database service in the re-encrypter
+		r.database = db
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to re-encrypt fields in the re-encrypter.
+func WithInterval(interval time.Duration) Opt {
+	return func(r *Reencrypter) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid re-encrypt interval provided: %s", interval)
+		}
+
+		// set the interval in the re-encrypter
+		r.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithBatchSize sets the maximum number of users and
+// repos to re-encrypt in each run in the re-encrypter.
+func WithBatchSize(size int) Opt {
+	return func(r *Reencrypter) error {
+		// check if the batch size provided is positive
+		if size <= 0 {
+			return fmt.Errorf("invalid re-encrypt batch size provided: %d", size)
+		}
+
+		// set the batch size in the re-encrypter
+		r.config.BatchSize = size
+
+		return nil
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reencrypt
+
+import (
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+type (
+	// config represents the settings required to create the re-encrypter.
+	config struct {
+		// specifies the interval at which to re-encrypt fields
+		Interval time.Duration
+		// specifies the maximum number of users and repos to re-encrypt in each run
+		BatchSize int
+	}
+
+	// Reencrypter represents the functionality for re-encrypting
+	// fields not sealed with the current key from the kms.
+	Reencrypter struct {
+		// re-encrypter configuration settings
+		config *config
+
+		// database service used to re-encrypt users and repos
+		database database.Service
+	}
+)
+
+// New creates and returns a re-encrypter for users and repos.
+func New(opts ...Opt) (*Reencrypter, error) {
+	// create new re-encrypter
+	r := new(Reencrypter)
+
+	// create new fields
+	r.config = &config{
+		BatchSize: 100,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// Enabled returns whether the re-encrypter is
+// configured to periodically re-encrypt fields.
+func (r *Reencrypter) Enabled() bool {
+	return r.config.Interval > 0
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reencrypt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Start re-encrypts fields at the configured
+// interval until the provided channel is closed.
+func (r *Reencrypter) Start(dying <-chan struct{}) error {
+	logrus.Infof("re-encrypting user tokens and repo hashes every %s", r.config.Interval)
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, _, err := r.Run()
+			if err != nil {
+				logrus.Errorf("unable to re-encrypt fields: %v", err)
+			}
+		}
+	}
+}
+
+// Run re-encrypts up to the configured batch size of users and
+// repos not sealed with the current key from the kms. It returns
+// the number of users and repos that were re-encrypted.
+func (r *Reencrypter) Run() (int, int, error) {
+	logrus.Trace("re-encrypting user tokens and repo hashes")
+
+	// send API call to re-encrypt the users
+	users, err := r.database.ReencryptUsers(context.Background(), r.config.BatchSize)
+	if err != nil {
+		return users, 0, fmt.Errorf("unable to re-encrypt users: %w", err)
+	}
+
+	// send API call to re-encrypt the repos
+	repos, err := r.database.ReencryptRepos(context.Background(), r.config.BatchSize)
+	if err != nil {
+		return users, repos, fmt.Errorf("unable to re-encrypt repos: %w", err)
+	}
+
+	if users > 0 || repos > 0 {
+		logrus.Infof("re-encrypted %d users and %d repos", users, repos)
+	}
+
+	return users, repos, nil
+}
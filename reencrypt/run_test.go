@@ -0,0 +1,67 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reencrypt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func TestReencrypt_Run(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from users;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+	_user.SetToken("superSecretToken")
+	_user.SetHash("superSecretHash")
+	_user.SetActive(true)
+
+	err := db.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Fatalf("unable to create user: %v", err)
+	}
+
+	r, err := New(
+		WithDatabase(db),
+		WithInterval(time.Hour),
+		WithBatchSize(10),
+	)
+	if err != nil {
+		t.Fatalf("unable to create re-encrypter: %v", err)
+	}
+
+	// run test
+	users, repos, err := r.Run()
+	if err != nil {
+		t.Fatalf("Run returned err: %v", err)
+	}
+
+	// the test database has no kms configured so
+	// the fields remain on the static encryption key
+	if users != 0 || repos != 0 {
+		t.Errorf("Run re-encrypted %d users and %d repos, want 0", users, repos)
+	}
+
+	got, err := db.GetUser(context.TODO(), 1)
+	if err != nil {
+		t.Fatalf("unable to get user: %v", err)
+	}
+
+	if got.GetToken() != _user.GetToken() {
+		t.Errorf("GetUser token is %s, want %s", got.GetToken(), _user.GetToken())
+	}
+}
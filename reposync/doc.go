@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package reposync provides the ability for Vela to reconcile
+// the repos in the database with the repos in the SCM, following
+// renames and transfers, deactivating archived or deleted repos
+// and refreshing the default branch, topics and visibility.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/reposync"
+package reposync
@@ -0,0 +1,25 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for syncing repos.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Repo Sync Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_REPO_SYNC_INTERVAL", "REPO_SYNC_INTERVAL"},
+		FilePath: "/vela/repo_sync/interval",
+		Name:     "repo-sync.interval",
+		Usage:    "interval at which to sync the active repos with the scm (disabled when set to 0)",
+		Value:    0,
+	},
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scm"
+)
+
+// Opt represents a configuration option to initialize the syncer.
+type Opt func(*Syncer) error
+
+// WithDatabase sets the database service in the syncer.
+func WithDatabase(db database.Service) Opt {
+	return func(s *Syncer) error {
+		// set the database service in the syncer
+		s.database = db
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to sync the repos in the syncer.
+func WithInterval(interval time.Duration) Opt {
+	return func(s *Syncer) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid repo sync interval provided: %s", interval)
+		}
+
+		// set the interval in the syncer
+		s.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithSCM sets the scm service in the syncer.
+func WithSCM(service scm.Service) Opt {
+	return func(s *Syncer) error {
+		// set the scm service in the syncer
+		s.scm = service
+
+		return nil
+	}
+}
+
+// WithWebAddress sets the web address for the links to builds in the syncer.
+func WithWebAddress(address string) Opt {
+	return func(s *Syncer) error {
+		// set the web address, without a trailing slash, in the syncer
+		s.config.WebAddress = strings.TrimSuffix(address, "/")
+
+		return nil
+	}
+}
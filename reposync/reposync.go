@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"time"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scm"
+)
+
+type (
+	// config represents the settings required to create the syncer.
+	config struct {
+		// specifies the interval at which to sync the repos
+		Interval time.Duration
+		// specifies the web address for the links to the builds of a renamed repo
+		WebAddress string
+	}
+
+	// Syncer represents the functionality for reconciling
+	// the repos in the database with the repos in the SCM.
+	Syncer struct {
+		// syncer configuration settings
+		config *config
+
+		// database service used to capture and update repos
+		database database.Service
+
+		// scm service used to capture the state of repos
+		scm scm.Service
+	}
+)
+
+// New creates and returns a syncer for repos.
+func New(opts ...Opt) (*Syncer, error) {
+	// create new syncer
+	s := new(Syncer)
+
+	// create new fields
+	s.config = new(config)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Enabled returns whether the syncer is
+// configured to periodically sync the repos.
+func (s *Syncer) Enabled() bool {
+	return s.config.Interval > 0
+}
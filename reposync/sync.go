@@ -0,0 +1,354 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// perPage represents the number of repos, secrets
+// and builds captured from the database at a time.
+const perPage = 100
+
+// Start syncs the active repos at the configured
+// interval until the provided channel is closed.
+func (s *Syncer) Start(dying <-chan struct{}) error {
+	logrus.Infof("syncing repos with the scm every %s", s.config.Interval)
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			_, err := s.SyncAll()
+			if err != nil {
+				logrus.Errorf("unable to sync repos: %v", err)
+			}
+		}
+	}
+}
+
+// SyncAll syncs every active repo in the database with the
+// SCM and returns the result of the sync for each repo.
+func (s *Syncer) SyncAll() ([]*types.RepoSync, error) {
+	logrus.Trace("syncing repos with the scm")
+
+	// send API call to capture the repos
+	repos, err := s.database.ListRepos(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list repos: %w", err)
+	}
+
+	return s.syncRepos(repos), nil
+}
+
+// SyncOrg syncs every active repo in the database for the org
+// with the SCM and returns the result of the sync for each repo.
+func (s *Syncer) SyncOrg(org string) ([]*types.RepoSync, error) {
+	logrus.Tracef("syncing repos for org %s with the scm", org)
+
+	filters := map[string]interface{}{"active": true}
+	repos := []*library.Repo{}
+	page := 1
+
+	// capture all active repos for the org in the database
+	for {
+		r, _, err := s.database.ListReposForOrg(context.Background(), org, "name", filters, page, perPage)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list repos for org %s: %w", org, err)
+		}
+
+		repos = append(repos, r...)
+
+		if len(r) < perPage {
+			break
+		}
+
+		page++
+	}
+
+	return s.syncRepos(repos), nil
+}
+
+// syncRepos is a helper function to sync
+// the active repos provided with the SCM.
+func (s *Syncer) syncRepos(repos []*library.Repo) []*types.RepoSync {
+	results := []*types.RepoSync{}
+
+	for _, r := range repos {
+		if !r.GetActive() {
+			continue
+		}
+
+		result, err := s.SyncRepo(r)
+		if err != nil {
+			logrus.Errorf("unable to sync repo %s: %v", r.GetFullName(), err)
+
+			continue
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// SyncRepo reconciles the repo in the database with the SCM,
+// following a rename or transfer of the repo, deactivating the
+// repo when it was archived or deleted, and refreshing the default
+// branch and visibility. The result of the sync is recorded in the
+// database and returned.
+func (s *Syncer) SyncRepo(r *library.Repo) (*types.RepoSync, error) {
+	logrus.Tracef("syncing repo %s with the scm", r.GetFullName())
+
+	result := new(types.RepoSync)
+	result.SetRepoID(r.GetID())
+	result.SetOrg(r.GetOrg())
+	result.SetName(r.GetName())
+	result.SetLink(r.GetLink())
+	result.SetClone(r.GetClone())
+	result.SetBranch(r.GetBranch())
+	result.SetPrivate(r.GetPrivate())
+	result.SetStatus(types.RepoSyncUnchanged)
+	result.SetChanges([]string{})
+	result.SetSynced(time.Now().UTC().Unix())
+
+	// send API call to capture the previous sync for the repo
+	previous, err := s.database.GetRepoSyncForRepo(context.Background(), r)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("unable to get sync for repo %s: %w", r.GetFullName(), err)
+	}
+
+	// send API call to capture the owner of the repo
+	u, err := s.database.GetUser(context.Background(), r.GetUserID())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get owner for repo %s: %w", r.GetFullName(), err)
+	}
+
+	// send API call to capture the state of the repo in the scm
+	state, err := s.scm.GetRepoSync(u, r)
+	if err != nil {
+		result.SetStatus(types.RepoSyncFailed)
+		result.SetError(fmt.Sprintf("unable to get repo %s from scm: %v", r.GetFullName(), err))
+
+		return result, s.record(previous, result)
+	}
+
+	// deactivate the repo when it no longer exists in the scm
+	if state == nil {
+		r.SetActive(false)
+
+		result.SetStatus(types.RepoSyncDeleted)
+		result.SetChanges([]string{"repo no longer exists in the scm"})
+
+		// send API call to update the repo
+		err = s.database.UpdateRepo(context.Background(), r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to update repo %s: %w", r.GetFullName(), err)
+		}
+
+		return result, s.record(previous, result)
+	}
+
+	changes := []string{}
+	status := types.RepoSyncUnchanged
+
+	// follow the repo when it was renamed or transferred
+	if state.GetOrg() != r.GetOrg() || state.GetName() != r.GetName() {
+		from := r.GetFullName()
+
+		err = s.rename(r, state)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, fmt.Sprintf("repo renamed from %s to %s", from, r.GetFullName()))
+		status = types.RepoSyncRenamed
+	}
+
+	if state.GetBranch() != r.GetBranch() {
+		changes = append(changes, fmt.Sprintf("branch changed from %s to %s", r.GetBranch(), state.GetBranch()))
+		r.SetBranch(state.GetBranch())
+
+		if status == types.RepoSyncUnchanged {
+			status = types.RepoSyncUpdated
+		}
+	}
+
+	if state.GetPrivate() != r.GetPrivate() {
+		changes = append(changes, fmt.Sprintf("private changed from %t to %t", r.GetPrivate(), state.GetPrivate()))
+		r.SetPrivate(state.GetPrivate())
+
+		// restrict the visibility of the repo when it is no longer public in the scm
+		if state.GetPrivate() && r.GetVisibility() == constants.VisibilityPublic {
+			r.SetVisibility(constants.VisibilityPrivate)
+		}
+
+		if status == types.RepoSyncUnchanged {
+			status = types.RepoSyncUpdated
+		}
+	}
+
+	if previous != nil && !reflect.DeepEqual(previous.GetTopics(), state.GetTopics()) {
+		changes = append(changes, fmt.Sprintf("topics changed from %v to %v", previous.GetTopics(), state.GetTopics()))
+
+		if status == types.RepoSyncUnchanged {
+			status = types.RepoSyncUpdated
+		}
+	}
+
+	// deactivate the repo when it was archived in the scm
+	if state.GetArchived() {
+		r.SetActive(false)
+
+		changes = append(changes, "repo archived in the scm")
+		status = types.RepoSyncArchived
+	}
+
+	// send API call to update the repo
+	if len(changes) > 0 {
+		err = s.database.UpdateRepo(context.Background(), r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to update repo %s: %w", r.GetFullName(), err)
+		}
+	}
+
+	result.SetOrg(r.GetOrg())
+	result.SetName(r.GetName())
+	result.SetLink(r.GetLink())
+	result.SetClone(r.GetClone())
+	result.SetBranch(r.GetBranch())
+	result.SetPrivate(r.GetPrivate())
+	result.SetArchived(state.GetArchived())
+	result.SetTopics(state.GetTopics())
+	result.SetStatus(status)
+	result.SetChanges(changes)
+
+	return result, s.record(previous, result)
+}
+
+// rename is a helper function to follow a repo renamed or
+// transferred in the SCM, updating the repo along with the
+// secrets and the links to the builds for the repo.
+func (s *Syncer) rename(r *library.Repo, state *types.RepoSync) error {
+	fullName := fmt.Sprintf("%s/%s", state.GetOrg(), state.GetName())
+
+	// send API call to check for a repo already using the new name
+	existing, err := s.database.GetRepoForOrg(context.Background(), state.GetOrg(), state.GetName())
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("unable to get repo %s: %w", fullName, err)
+	}
+
+	if existing != nil && existing.GetID() != r.GetID() {
+		return fmt.Errorf("unable to rename repo %s to %s: repo already exists", r.GetFullName(), fullName)
+	}
+
+	previousOrg := r.GetOrg()
+	previousName := r.GetName()
+
+	// get total number of secrets associated with repository
+	t, err := s.database.GetTypeSecretCount(context.Background(), constants.SecretRepo, previousOrg, previousName, []string{})
+	if err != nil {
+		return fmt.Errorf("unable to get secret count for repo %s: %w", r.GetFullName(), err)
+	}
+
+	secrets := []*library.Secret{}
+	page := 1
+	// capture all secrets belonging to the repo in database
+	for repoSecrets := int64(0); repoSecrets < t; repoSecrets += perPage {
+		list, err := s.database.GetTypeSecretList(context.Background(), constants.SecretRepo, previousOrg, previousName, page, perPage, []string{})
+		if err != nil {
+			return fmt.Errorf("unable to get secret list for repo %s: %w", r.GetFullName(), err)
+		}
+
+		secrets = append(secrets, list...)
+
+		page++
+	}
+
+	// update secrets to point to the new repository name
+	for _, secret := range secrets {
+		secret.SetOrg(state.GetOrg())
+		secret.SetRepo(state.GetName())
+
+		err = s.database.UpdateSecret(context.Background(), secret)
+		if err != nil {
+			return fmt.Errorf("unable to update secret for repo %s: %w", r.GetFullName(), err)
+		}
+	}
+
+	r.SetOrg(state.GetOrg())
+	r.SetName(state.GetName())
+	r.SetFullName(fullName)
+	r.SetLink(state.GetLink())
+	r.SetClone(state.GetClone())
+	r.SetPreviousName(previousName)
+
+	// skip updating the links to builds without a web address
+	if len(s.config.WebAddress) == 0 {
+		return nil
+	}
+
+	// get total number of builds associated with repository
+	t, err = s.database.GetRepoBuildCount(context.Background(), r, nil)
+	if err != nil {
+		return fmt.Errorf("unable to get build count for repo %s: %w", r.GetFullName(), err)
+	}
+
+	builds := []*library.Build{}
+	page = 1
+	// capture all builds belonging to repo in database
+	for build := int64(0); build < t; build += perPage {
+		b, _, err := s.database.GetRepoBuildList(context.Background(), r, nil, time.Now().Unix(), 0, page, perPage)
+		if err != nil {
+			return fmt.Errorf("unable to get build list for repo %s: %w", r.GetFullName(), err)
+		}
+
+		builds = append(builds, b...)
+
+		page++
+	}
+
+	// update build link to route to proper repo name
+	for _, build := range builds {
+		build.SetLink(
+			fmt.Sprintf("%s/%s/%d", s.config.WebAddress, r.GetFullName(), build.GetNumber()),
+		)
+
+		err = s.database.UpdateBuild(context.Background(), build)
+		if err != nil {
+			return fmt.Errorf("unable to update build for repo %s: %w", r.GetFullName(), err)
+		}
+	}
+
+	return nil
+}
+
+// record is a helper function to persist the
+// result of the sync for the repo in the database.
+func (s *Syncer) record(previous, result *types.RepoSync) error {
+	// send API call to create the sync for the repo
+	if previous == nil {
+		return s.database.CreateRepoSync(context.Background(), result)
+	}
+
+	result.SetID(previous.GetID())
+
+	// send API call to update the sync for the repo
+	return s.database.UpdateRepoSync(context.Background(), result)
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package reposync
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/scm"
+	"github.com/go-vela/types/library"
+)
+
+// testSCM is a scm service that captures
+// the state of repos from a map by repo ID.
+type testSCM struct {
+	scm.Service
+
+	repos map[int64]*types.RepoSync
+}
+
+// GetRepoSync captures the state of the repo from the map.
+func (s *testSCM) GetRepoSync(u *library.User, r *library.Repo) (*types.RepoSync, error) {
+	return s.repos[r.GetID()], nil
+}
+
+func TestRepoSync_SyncOrg(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from users;")
+		db.Sqlite.Exec("delete from repos;")
+		db.Sqlite.Exec("delete from builds;")
+		db.Sqlite.Exec("delete from secrets;")
+		db.Sqlite.Exec("delete from repo_syncs;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_user := new(library.User)
+	_user.SetID(1)
+	_user.SetName("octocat")
+	_user.SetToken("superSecretToken")
+	_user.SetHash("baz")
+
+	err := db.CreateUser(context.TODO(), _user)
+	if err != nil {
+		t.Errorf("unable to create test user: %v", err)
+	}
+
+	for i, name := range []string{"octocat", "hello-world", "spoon-knife", "linguist"} {
+		r := new(library.Repo)
+		r.SetID(int64(i + 1))
+		r.SetUserID(1)
+		r.SetHash("baz")
+		r.SetOrg("github")
+		r.SetName(name)
+		r.SetFullName("github/" + name)
+		r.SetBranch("master")
+		r.SetVisibility("public")
+		r.SetActive(true)
+
+		err = db.CreateRepo(context.TODO(), r)
+		if err != nil {
+			t.Errorf("unable to create test repo: %v", err)
+		}
+	}
+
+	_secret := new(library.Secret)
+	_secret.SetID(1)
+	_secret.SetOrg("github")
+	_secret.SetRepo("hello-world")
+	_secret.SetName("foo")
+	_secret.SetValue("bar")
+	_secret.SetType("repo")
+	_secret.SetCreatedAt(1)
+	_secret.SetUpdatedAt(1)
+
+	err = db.CreateSecret(context.TODO(), _secret)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(2)
+	_build.SetNumber(1)
+	_build.SetCreated(1)
+	_build.SetLink("https://vela.example.com/github/hello-world/1")
+
+	err = db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	_scm := &testSCM{
+		repos: map[int64]*types.RepoSync{
+			// octocat changed its default branch and visibility
+			1: testState("github", "octocat", "main", true, false),
+			// hello-world was transferred to the octo-org org
+			2: testState("octo-org", "hello-world", "master", false, false),
+			// spoon-knife was archived
+			3: testState("github", "spoon-knife", "master", false, true),
+			// linguist was deleted
+		},
+	}
+
+	s, err := New(WithDatabase(db), WithSCM(_scm), WithWebAddress("https://vela.example.com"))
+	if err != nil {
+		t.Errorf("unable to create syncer: %v", err)
+	}
+
+	want := map[string]string{
+		"octo-org/hello-world": types.RepoSyncRenamed,
+		"github/linguist":      types.RepoSyncDeleted,
+		"github/octocat":       types.RepoSyncUpdated,
+		"github/spoon-knife":   types.RepoSyncArchived,
+	}
+
+	// run test
+	got, err := s.SyncOrg("github")
+	if err != nil {
+		t.Errorf("SyncOrg returned err: %v", err)
+	}
+
+	statuses := map[string]string{}
+	for _, r := range got {
+		statuses[r.GetOrg()+"/"+r.GetName()] = r.GetStatus()
+	}
+
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("SyncOrg is %v, want %v", statuses, want)
+	}
+
+	octocat, err := db.GetRepo(context.TODO(), 1)
+	if err != nil {
+		t.Errorf("unable to get repo: %v", err)
+	}
+
+	if octocat.GetBranch() != "main" || !octocat.GetPrivate() || octocat.GetVisibility() != "private" {
+		t.Errorf("SyncOrg updated octocat to %v, want private on branch main", octocat)
+	}
+
+	helloWorld, err := db.GetRepoForOrg(context.TODO(), "octo-org", "hello-world")
+	if err != nil {
+		t.Errorf("unable to get renamed repo: %v", err)
+	}
+
+	if helloWorld.GetPreviousName() != "hello-world" {
+		t.Errorf("PreviousName is %v, want %v", helloWorld.GetPreviousName(), "hello-world")
+	}
+
+	secret, err := db.GetSecret(context.TODO(), "repo", "octo-org", "hello-world", "foo")
+	if err != nil {
+		t.Errorf("unable to get moved secret: %v", err)
+	}
+
+	if secret.GetID() != 1 {
+		t.Errorf("secret ID is %v, want %v", secret.GetID(), 1)
+	}
+
+	build, err := db.GetBuild(context.TODO(), 1, helloWorld)
+	if err != nil {
+		t.Errorf("unable to get build: %v", err)
+	}
+
+	if build.GetLink() != "https://vela.example.com/octo-org/hello-world/1" {
+		t.Errorf("build link is %v, want %v", build.GetLink(), "https://vela.example.com/octo-org/hello-world/1")
+	}
+
+	for _, id := range []int64{3, 4} {
+		r, err := db.GetRepo(context.TODO(), id)
+		if err != nil {
+			t.Errorf("unable to get repo: %v", err)
+		}
+
+		if r.GetActive() {
+			t.Errorf("SyncOrg left repo %s active", r.GetFullName())
+		}
+	}
+
+	// sync the remaining active repo again to capture the recorded sync
+	_scm.repos[1].SetTopics([]string{"go"})
+
+	got, err = s.SyncOrg("github")
+	if err != nil {
+		t.Errorf("SyncOrg returned err: %v", err)
+	}
+
+	if len(got) != 1 || got[0].GetStatus() != types.RepoSyncUpdated || len(got[0].GetChanges()) != 1 {
+		t.Errorf("SyncOrg is %v, want topics updated for octocat", got)
+	}
+
+	recorded, err := db.GetRepoSyncForRepo(context.TODO(), octocat)
+	if err != nil {
+		t.Errorf("unable to get repo sync: %v", err)
+	}
+
+	if !reflect.DeepEqual(recorded.GetTopics(), []string{"go"}) {
+		t.Errorf("recorded topics are %v, want %v", recorded.GetTopics(), []string{"go"})
+	}
+}
+
+// testState is a test helper function to create
+// the state of a repo captured from the scm.
+func testState(org, name, branch string, private, archived bool) *types.RepoSync {
+	s := new(types.RepoSync)
+	s.SetOrg(org)
+	s.SetName(name)
+	s.SetLink("https://github.com/" + org + "/" + name)
+	s.SetClone("https://github.com/" + org + "/" + name + ".git")
+	s.SetBranch(branch)
+	s.SetPrivate(private)
+	s.SetArchived(archived)
+	s.SetTopics([]string{"go", "vela"})
+
+	return s
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retention
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the sweeper in the context.
+const key = "retention"
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the sweeper
+// associated with this context.
+func FromContext(c context.Context) *Sweeper {
+	// get sweeper value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast sweeper value to expected Sweeper type
+	s, ok := v.(*Sweeper)
+	if !ok {
+		return nil
+	}
+
+	return s
+}
+
+// ToContext adds the sweeper to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, s *Sweeper) {
+	c.Set(key, s)
+}
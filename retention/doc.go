@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package retention provides the ability for Vela to periodically
+// sweep the builds, logs and hooks for every repo that are expired
+// based on the retention policy for the repo or the global policy,
+// along with the repos and secrets archived past the archive window.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/retention"
+package retention
@@ -0,0 +1,41 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retention
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the retention sweeper.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Retention Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_RETENTION_INTERVAL", "RETENTION_INTERVAL"},
+		FilePath: "/vela/retention/interval",
+		Name:     "retention.interval",
+		Usage:    "interval at which to sweep the expired builds, logs and hooks (disabled when set to 0)",
+		Value:    0,
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_RETENTION_LIMIT", "RETENTION_LIMIT"},
+		FilePath: "/vela/retention/limit",
+		Name:     "retention.limit",
+		Usage:    "maximum number of builds to delete for a repo in a single sweep",
+		Value:    1000,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_RETENTION_ARCHIVE_WINDOW", "RETENTION_ARCHIVE_WINDOW"},
+		FilePath: "/vela/retention/archive_window",
+		Name:     "retention.archive-window",
+		Usage:    "duration to keep deleted repos and secrets archived before purging them (kept forever when set to 0)",
+		Value:    720 * time.Hour,
+	},
+}
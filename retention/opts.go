@@ -0,0 +1,72 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retention
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the sweeper.
+type Opt func(*Sweeper) error
+
+// WithDatabase sets the database service in the sweeper.
+func WithDatabase(db database.Service) Opt {
+	return func(s *Sweeper) error {
+		// set the database service in the sweeper
+		s.database = db
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to sweep the expired resources in the sweeper.
+func WithInterval(interval time.Duration) Opt {
+	return func(s *Sweeper) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid retention interval provided: %s", interval)
+		}
+
+		// set the interval in the sweeper
+		s.config.Interval = interval
+
+		return nil
+	}
+}
+
+// WithLimit sets the maximum number of builds to
+// delete for a repo in a single sweep in the sweeper.
+func WithLimit(limit int) Opt {
+	return func(s *Sweeper) error {
+		// check if the limit provided is positive
+		if limit <= 0 {
+			return fmt.Errorf("invalid retention limit provided: %d", limit)
+		}
+
+		// set the limit in the sweeper
+		s.config.Limit = limit
+
+		return nil
+	}
+}
+
+// WithArchiveWindow sets the duration to keep the
+// archived repos and secrets before purging them in the sweeper.
+func WithArchiveWindow(window time.Duration) Opt {
+	return func(s *Sweeper) error {
+		// check if the window provided is negative
+		if window < 0 {
+			return fmt.Errorf("invalid retention archive window provided: %s", window)
+		}
+
+		// set the archive window in the sweeper
+		s.config.ArchiveWindow = window
+
+		return nil
+	}
+}
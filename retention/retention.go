@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retention
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// ErrRunning defines the error type when a sweep
+// is requested while another sweep is running.
+var ErrRunning = errors.New("retention sweep already running")
+
+type (
+	// config represents the settings required to create the sweeper.
+	config struct {
+		// specifies the interval at which to sweep the expired resources
+		Interval time.Duration
+		// specifies the maximum number of builds to delete for a repo in a sweep
+		Limit int
+		// specifies the duration to keep archived repos and secrets before purging them
+		ArchiveWindow time.Duration
+	}
+
+	// Sweeper represents the functionality for deleting the builds,
+	// logs and hooks that are expired based on the retention policies.
+	Sweeper struct {
+		// sweeper configuration settings
+		config *config
+
+		// database service used to capture the policies and delete the resources
+		database database.Service
+
+		// mutex used to prevent running concurrent sweeps
+		mutex sync.Mutex
+	}
+)
+
+// New creates and returns a sweeper for the retention policies.
+func New(opts ...Opt) (*Sweeper, error) {
+	// create new sweeper
+	s := new(Sweeper)
+
+	// create new fields
+	s.config = &config{
+		Limit:         1000,
+		ArchiveWindow: 720 * time.Hour,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// Enabled returns whether the sweeper is configured
+// to periodically sweep the expired resources.
+func (s *Sweeper) Enabled() bool {
+	return s.config.Interval > 0
+}
@@ -0,0 +1,417 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// day represents the duration of a single day for the
+// log and hook limits of the retention policies.
+const day = 24 * time.Hour
+
+// perPage represents the number of resources
+// captured at a time when listing the resources.
+const perPage = 100
+
+// Start sweeps the expired resources at the configured
+// interval until the provided channel is closed.
+func (s *Sweeper) Start(dying <-chan struct{}) error {
+	logrus.Infof("sweeping expired builds, logs and hooks every %s", s.config.Interval)
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			sweep, err := s.Run(time.Now().UTC())
+			if err != nil {
+				logrus.Errorf("unable to sweep expired resources: %v", err)
+
+				continue
+			}
+
+			logrus.Infof("swept %d builds, %d logs and %d hooks for %d repos and purged %d archived repos and %d archived secrets",
+				sweep.GetBuilds(), sweep.GetLogs(), sweep.GetHooks(), sweep.GetRepos(),
+				sweep.GetArchivedRepos(), sweep.GetArchivedSecrets())
+		}
+	}
+}
+
+// Run deletes the builds, logs and hooks for every repo that are
+// expired based on the policy for the repo or the global policy
+// and purges the repos and secrets archived before the archive
+// window. It returns a summary of the resources that were deleted.
+//
+// ErrRunning is returned when another sweep is running.
+func (s *Sweeper) Run(now time.Time) (*api.RetentionSweep, error) {
+	// prevent running concurrent sweeps
+	if !s.mutex.TryLock() {
+		return nil, ErrRunning
+	}
+	defer s.mutex.Unlock()
+
+	logrus.Trace("sweeping expired builds, logs and hooks")
+
+	ctx := context.Background()
+
+	// variables to store the resources deleted
+	var repos, builds, logs, hooks int64
+
+	sweep := new(api.RetentionSweep)
+	sweep.SetStarted(now.Unix())
+
+	// send API call to capture the global retention policy
+	global, err := s.database.GetGlobalRetentionPolicy(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("unable to get global retention policy: %w", err)
+	}
+
+	// send API call to capture the list of retention policies
+	policies, err := s.database.ListRetentionPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list retention policies: %w", err)
+	}
+
+	overrides := make(map[int64]*api.RetentionPolicy)
+
+	for _, p := range policies {
+		if p.GetRepoID() > 0 {
+			overrides[p.GetRepoID()] = p
+		}
+	}
+
+	// send API call to capture the list of repos
+	list, err := s.database.ListRepos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list repos: %w", err)
+	}
+
+	for _, r := range list {
+		p := effective(global, overrides[r.GetID()])
+		if p == nil {
+			continue
+		}
+
+		b, l, h, err := s.sweep(ctx, r, p, now)
+		if err != nil {
+			logrus.Errorf("unable to sweep expired resources for repo %s: %v", r.GetFullName(), err)
+		}
+
+		if b+l+h > 0 {
+			repos++
+		}
+
+		builds += b
+		logs += l
+		hooks += h
+	}
+
+	sweep.SetRepos(repos)
+	sweep.SetBuilds(builds)
+	sweep.SetLogs(logs)
+	sweep.SetHooks(hooks)
+
+	// check if the archived resources are kept forever
+	if s.config.ArchiveWindow > 0 {
+		before := now.Add(-s.config.ArchiveWindow).Unix()
+
+		archived, err := s.purgeRepos(ctx, before)
+		if err != nil {
+			logrus.Errorf("unable to purge archived repos: %v", err)
+		}
+
+		sweep.SetArchivedRepos(archived)
+
+		// send API call to remove the secrets archived before the cutoff
+		archived, err = s.database.PurgeArchivedSecrets(ctx, before)
+		if err != nil {
+			logrus.Errorf("unable to purge archived secrets: %v", err)
+		}
+
+		sweep.SetArchivedSecrets(archived)
+	}
+
+	sweep.SetFinished(time.Now().UTC().Unix())
+
+	return sweep, nil
+}
+
+// sweep is a helper function to delete the builds, logs and hooks
+// for the repo that are expired based on the retention policy.
+// It returns the number of builds, logs and hooks deleted.
+func (s *Sweeper) sweep(ctx context.Context, r *library.Repo, p *api.RetentionPolicy, now time.Time) (int64, int64, int64, error) {
+	var builds, logs, hooks int64
+
+	// check if the builds for the repo are limited
+	if p.GetKeepBuilds() > 0 {
+		expired, err := s.expired(ctx, r, p.GetKeepBuilds(), now)
+		if err != nil {
+			return builds, logs, hooks, fmt.Errorf("unable to list expired builds: %w", err)
+		}
+
+		for _, b := range expired {
+			logrus.Debugf("deleting expired build %d for repo %d", b.GetNumber(), b.GetRepoID())
+
+			err = DeleteBuild(ctx, s.database, b)
+			if err != nil {
+				return builds, logs, hooks, fmt.Errorf("unable to delete build %d: %w", b.GetNumber(), err)
+			}
+
+			builds++
+		}
+	}
+
+	// check if the logs for the repo are limited
+	if p.GetLogDays() > 0 {
+		before := now.Add(-time.Duration(p.GetLogDays()) * day).Unix()
+
+		// send API call to remove the logs for the builds created before the cutoff
+		count, err := s.database.CleanLogsForRepo(ctx, r, before)
+		if err != nil {
+			return builds, logs, hooks, fmt.Errorf("unable to clean logs: %w", err)
+		}
+
+		logs += count
+	}
+
+	// check if the hooks for the repo are limited
+	if p.GetHookDays() > 0 {
+		before := now.Add(-time.Duration(p.GetHookDays()) * day).Unix()
+
+		// send API call to remove the hooks created before the cutoff
+		count, err := s.database.CleanHooksForRepo(ctx, r, before)
+		if err != nil {
+			return builds, logs, hooks, fmt.Errorf("unable to clean hooks: %w", err)
+		}
+
+		hooks += count
+	}
+
+	return builds, logs, hooks, nil
+}
+
+// expired is a helper function to capture the finished builds for the
+// repo created before the oldest of the builds to keep. The list is
+// capped at the maximum number of builds to delete in a single sweep.
+func (s *Sweeper) expired(ctx context.Context, r *library.Repo, keep int64, now time.Time) ([]*library.Build, error) {
+	builds := []*library.Build{}
+
+	// send API call to capture the oldest of the builds to keep
+	//
+	// the page number matching the number of builds to keep holds only that build
+	oldest, _, err := s.database.GetRepoBuildList(ctx, r, nil, now.Unix(), 0, int(keep), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if the repo has more builds than the ones to keep
+	if len(oldest) == 0 {
+		return builds, nil
+	}
+
+	page := 1
+
+	for page > 0 && len(builds) < s.config.Limit {
+		// send API call to capture the builds (per page) created before the oldest build to keep
+		list, _, err := s.database.GetRepoBuildList(ctx, r, nil, oldest[0].GetCreated(), 0, page, perPage)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range list {
+			if complete(b) && len(builds) < s.config.Limit {
+				builds = append(builds, b)
+			}
+		}
+
+		// assume no more pages exist if under the per page amount
+		if len(list) < perPage {
+			page = 0
+		} else {
+			page++
+		}
+	}
+
+	return builds, nil
+}
+
+// purgeRepos is a helper function to permanently delete the repos
+// archived before the provided unix time along with their builds.
+// It returns the number of archived repos that were deleted.
+func (s *Sweeper) purgeRepos(ctx context.Context, before int64) (int64, error) {
+	var repos int64
+
+	// send API call to capture the repos archived before the cutoff
+	list, err := s.database.ListReposArchivedBefore(ctx, before, s.config.Limit)
+	if err != nil {
+		return repos, fmt.Errorf("unable to list archived repos: %w", err)
+	}
+
+	for _, r := range list {
+		logrus.Debugf("purging archived repo %s", r.GetFullName())
+
+		_, err = PurgeRepo(ctx, s.database, r)
+		if err != nil {
+			return repos, fmt.Errorf("unable to purge archived repo %s: %w", r.GetFullName(), err)
+		}
+
+		repos++
+	}
+
+	return repos, nil
+}
+
+// PurgeRepo permanently deletes the repo from the database along
+// with its builds and the logs, steps, services and artifacts for
+// the builds. It returns the number of builds that were deleted.
+func PurgeRepo(ctx context.Context, db database.Service, r *library.Repo) (int64, error) {
+	var builds int64
+
+	for {
+		// send API call to capture the builds for the repo
+		list, _, err := db.GetRepoBuildList(ctx, r, nil, math.MaxInt64, 0, 1, perPage)
+		if err != nil {
+			return builds, fmt.Errorf("unable to list builds: %w", err)
+		}
+
+		if len(list) == 0 {
+			break
+		}
+
+		for _, b := range list {
+			// send API call to remove the build along with its resources
+			err = DeleteBuild(ctx, db, b)
+			if err != nil {
+				return builds, fmt.Errorf("unable to delete build %d: %w", b.GetNumber(), err)
+			}
+
+			builds++
+		}
+	}
+
+	// send API call to remove the repo
+	err := db.DeleteRepo(ctx, r)
+	if err != nil {
+		return builds, fmt.Errorf("unable to delete repo: %w", err)
+	}
+
+	return builds, nil
+}
+
+// DeleteBuild deletes the build from the database
+// along with the logs, steps, services and artifacts.
+func DeleteBuild(ctx context.Context, db database.Service, b *library.Build) error {
+	for {
+		// send API call to capture the logs for the build
+		logs, _, err := db.ListLogsForBuild(ctx, b, 1, perPage)
+		if err != nil {
+			return err
+		}
+
+		if len(logs) == 0 {
+			break
+		}
+
+		for _, l := range logs {
+			// send API call to remove the lines for the log
+			err = db.DeleteLinesForLog(ctx, l)
+			if err != nil {
+				return err
+			}
+
+			// send API call to remove the log
+			err = db.DeleteLog(ctx, l)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		// send API call to capture the steps for the build
+		steps, err := db.GetBuildStepList(ctx, b, 1, perPage)
+		if err != nil {
+			return err
+		}
+
+		if len(steps) == 0 {
+			break
+		}
+
+		for _, step := range steps {
+			// send API call to remove the step
+			err = db.DeleteStep(ctx, step.GetID())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		// send API call to capture the services for the build
+		services, err := db.GetBuildServiceList(ctx, b, 1, perPage)
+		if err != nil {
+			return err
+		}
+
+		if len(services) == 0 {
+			break
+		}
+
+		for _, service := range services {
+			// send API call to remove the service
+			err = db.DeleteService(ctx, service.GetID())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// send API call to remove the artifacts for the build
+	err := db.DeleteArtifactsForBuild(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	// send API call to remove the build
+	return db.DeleteBuild(ctx, b.GetID())
+}
+
+// effective is a helper function to capture the retention policy
+// enforced for a repo. The policy for the repo overrides the global
+// policy and nil is returned when neither policy exists.
+func effective(global, repo *api.RetentionPolicy) *api.RetentionPolicy {
+	if repo != nil {
+		return repo
+	}
+
+	return global
+}
+
+// complete is a helper function to check if
+// the build has finished and can be deleted.
+func complete(b *library.Build) bool {
+	switch b.GetStatus() {
+	case constants.StatusPending, constants.StatusRunning:
+		return false
+	default:
+		return true
+	}
+}
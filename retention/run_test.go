@@ -0,0 +1,317 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package retention
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestRetention_Run(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 17, 12, 0, 0, 0, time.UTC)
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_policy := new(api.RetentionPolicy)
+	_policy.SetKeepBuilds(1)
+	_policy.SetHookDays(30)
+	_policy.SetUpdatedAt(1)
+	_policy.SetUpdatedBy("octocat")
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	err = db.CreateRetentionPolicy(context.TODO(), _policy)
+	if err != nil {
+		t.Errorf("unable to create test retention policy: %v", err)
+	}
+
+	for i, status := range []string{constants.StatusSuccess, constants.StatusRunning, constants.StatusFailure, constants.StatusSuccess} {
+		b := new(library.Build)
+		b.SetID(int64(i + 1))
+		b.SetRepoID(1)
+		b.SetNumber(i + 1)
+		b.SetStatus(status)
+		b.SetCreated(now.Add(-time.Duration(4-i) * time.Hour).Unix())
+
+		err = db.CreateBuild(context.TODO(), b)
+		if err != nil {
+			t.Errorf("unable to create test build: %v", err)
+		}
+
+		s := new(library.Step)
+		s.SetID(int64(i + 1))
+		s.SetRepoID(1)
+		s.SetBuildID(int64(i + 1))
+		s.SetNumber(1)
+		s.SetName("clone")
+		s.SetImage("target/vela-git:v0.4.0")
+
+		err = db.CreateStep(context.TODO(), s)
+		if err != nil {
+			t.Errorf("unable to create test step: %v", err)
+		}
+
+		h := new(library.Hook)
+		h.SetID(int64(i + 1))
+		h.SetRepoID(1)
+		h.SetBuildID(int64(i + 1))
+		h.SetNumber(i + 1)
+		h.SetSourceID("c8da1302-07d6-11ea-882f-4893bca275b8")
+		h.SetWebhookID(1)
+		h.SetCreated(now.Add(-time.Duration(90-i*30) * 24 * time.Hour).Unix())
+
+		err = db.CreateHook(context.TODO(), h)
+		if err != nil {
+			t.Errorf("unable to create test hook: %v", err)
+		}
+	}
+
+	s, err := New(WithDatabase(db))
+	if err != nil {
+		t.Errorf("unable to create sweeper: %v", err)
+	}
+
+	// run test
+	got, err := s.Run(now)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	// the running build is kept along with the latest build
+	if got.GetBuilds() != 2 {
+		t.Errorf("Run builds is %d, want %d", got.GetBuilds(), 2)
+	}
+
+	// the hooks older than 30 days are deleted
+	if got.GetHooks() != 2 {
+		t.Errorf("Run hooks is %d, want %d", got.GetHooks(), 2)
+	}
+
+	if got.GetRepos() != 1 {
+		t.Errorf("Run repos is %d, want %d", got.GetRepos(), 1)
+	}
+
+	builds, _, err := db.GetRepoBuildList(context.TODO(), _repo, nil, now.Unix(), 0, 1, 10)
+	if err != nil {
+		t.Errorf("unable to list builds: %v", err)
+	}
+
+	numbers := []int{}
+	for _, b := range builds {
+		numbers = append(numbers, b.GetNumber())
+	}
+
+	if !reflect.DeepEqual(numbers, []int{4, 2}) {
+		t.Errorf("Run kept builds %v, want %v", numbers, []int{4, 2})
+	}
+
+	steps, err := db.GetBuildStepList(context.TODO(), &library.Build{ID: builds[0].ID}, 1, 10)
+	if err != nil {
+		t.Errorf("unable to list steps: %v", err)
+	}
+
+	if len(steps) != 1 {
+		t.Errorf("Run kept %d steps for build %d, want %d", len(steps), builds[0].GetNumber(), 1)
+	}
+
+	// run test again to ensure nothing else is deleted
+	got, err = s.Run(now)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	if got.GetBuilds() != 0 || got.GetHooks() != 0 || got.GetRepos() != 0 {
+		t.Errorf("Run is %v, want nothing deleted", got)
+	}
+}
+
+func TestRetention_Run_Archived(t *testing.T) {
+	// setup types
+	now := time.Now().UTC()
+
+	_repo := new(library.Repo)
+	_repo.SetID(1)
+	_repo.SetUserID(1)
+	_repo.SetHash("baz")
+	_repo.SetOrg("foo")
+	_repo.SetName("bar")
+	_repo.SetFullName("foo/bar")
+	_repo.SetVisibility("public")
+
+	_build := new(library.Build)
+	_build.SetID(1)
+	_build.SetRepoID(1)
+	_build.SetNumber(1)
+	_build.SetStatus(constants.StatusSuccess)
+	_build.SetCreated(now.Add(-time.Hour).Unix())
+
+	_secret := new(library.Secret)
+	_secret.SetID(1)
+	_secret.SetOrg("foo")
+	_secret.SetRepo("bar")
+	_secret.SetName("baz")
+	_secret.SetValue("foob")
+	_secret.SetType(constants.SecretRepo)
+	_secret.SetCreatedAt(1)
+	_secret.SetUpdatedAt(1)
+
+	db, err := sqlite.NewTest()
+	if err != nil {
+		t.Errorf("unable to create sqlite database: %v", err)
+	}
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	err = db.CreateRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to create test repo: %v", err)
+	}
+
+	err = db.CreateBuild(context.TODO(), _build)
+	if err != nil {
+		t.Errorf("unable to create test build: %v", err)
+	}
+
+	err = db.CreateSecret(context.TODO(), _secret)
+	if err != nil {
+		t.Errorf("unable to create test secret: %v", err)
+	}
+
+	err = db.ArchiveRepo(context.TODO(), _repo)
+	if err != nil {
+		t.Errorf("unable to archive test repo: %v", err)
+	}
+
+	err = db.ArchiveSecret(context.TODO(), _secret.GetID())
+	if err != nil {
+		t.Errorf("unable to archive test secret: %v", err)
+	}
+
+	s, err := New(WithDatabase(db), WithArchiveWindow(time.Hour))
+	if err != nil {
+		t.Errorf("unable to create sweeper: %v", err)
+	}
+
+	// run test within the archive window
+	got, err := s.Run(now)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	if got.GetArchivedRepos() != 0 || got.GetArchivedSecrets() != 0 {
+		t.Errorf("Run is %v, want nothing purged", got)
+	}
+
+	// run test after the archive window
+	got, err = s.Run(now.Add(2 * time.Hour))
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	if got.GetArchivedRepos() != 1 {
+		t.Errorf("Run archived repos is %d, want %d", got.GetArchivedRepos(), 1)
+	}
+
+	if got.GetArchivedSecrets() != 1 {
+		t.Errorf("Run archived secrets is %d, want %d", got.GetArchivedSecrets(), 1)
+	}
+
+	_, err = db.GetArchivedRepoForOrg(context.TODO(), "foo", "bar")
+	if err == nil {
+		t.Errorf("GetArchivedRepoForOrg should have returned err")
+	}
+
+	_, err = db.GetBuildByID(context.TODO(), 1)
+	if err == nil {
+		t.Errorf("GetBuildByID should have returned err")
+	}
+}
+
+func TestRetention_Run_Running(t *testing.T) {
+	// setup types
+	s, _ := New()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	// run test
+	_, err := s.Run(time.Now())
+	if err != ErrRunning {
+		t.Errorf("Run returned err %v, want %v", err, ErrRunning)
+	}
+}
+
+func TestRetention_effective(t *testing.T) {
+	// setup types
+	_global := new(api.RetentionPolicy)
+	_global.SetKeepBuilds(500)
+
+	_repo := new(api.RetentionPolicy)
+	_repo.SetRepoID(1)
+	_repo.SetLogDays(30)
+
+	// setup tests
+	tests := []struct {
+		name   string
+		global *api.RetentionPolicy
+		repo   *api.RetentionPolicy
+		want   *api.RetentionPolicy
+	}{
+		{
+			name:   "no policies",
+			global: nil,
+			repo:   nil,
+			want:   nil,
+		},
+		{
+			name:   "global policy",
+			global: _global,
+			repo:   nil,
+			want:   _global,
+		},
+		{
+			name:   "repo policy overrides global policy",
+			global: _global,
+			repo:   _repo,
+			want:   _repo,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := effective(test.global, test.repo)
+
+			if got != test.want {
+				t.Errorf("effective is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
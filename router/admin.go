@@ -6,42 +6,161 @@ package router
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api"
 	"github.com/go-vela/server/api/admin"
+	"github.com/go-vela/server/api/freeze"
+	"github.com/go-vela/server/fault"
+	"github.com/go-vela/server/router/middleware"
 	"github.com/go-vela/server/router/middleware/perm"
 )
 
 // AdminHandlers is a function that extends the provided base router group
 // with the API handlers for admin functionality.
 //
+// GET    /api/v1/admin/anomalies
+// POST   /api/v1/admin/anomalies
+// GET    /api/v1/admin/archived/repos
+// POST   /api/v1/admin/archived/repos/:org/:repo/restore
+// DELETE /api/v1/admin/archived/repos/:org/:repo
+// GET    /api/v1/admin/archived/secrets
+// POST   /api/v1/admin/archived/secrets/:type/:org/:name/:secret/restore
+// DELETE /api/v1/admin/archived/secrets/:type/:org/:name/:secret
 // GET    /api/v1/admin/builds/queue
+// GET    /api/v1/admin/builds/:id/explain
 // GET    /api/v1/admin/build/:id
 // PUT    /api/v1/admin/build
+// GET    /api/v1/admin/canaries
+// POST   /api/v1/admin/canaries
+// GET    /api/v1/admin/capacity
+// POST   /api/v1/admin/catalog
 // PUT    /api/v1/admin/deployment
+// GET    /api/v1/admin/diagnostics/bundle
+// GET    /api/v1/admin/diagnostics/pprof/:profile
+// GET    /api/v1/admin/exports
+// POST   /api/v1/admin/exports
+// GET    /api/v1/admin/faults
+// PUT    /api/v1/admin/fault
+// DELETE /api/v1/admin/faults
+// POST   /api/v1/admin/freezes
+// GET    /api/v1/admin/freezes
+// GET    /api/v1/admin/freezes/:freeze
+// DELETE /api/v1/admin/freezes/:freeze
+// GET    /api/v1/admin/freezes/:freeze/audits
 // PUT    /api/v1/admin/hook
+// GET    /api/v1/admin/leases
+// GET    /api/v1/admin/leaks
+// POST   /api/v1/admin/leaks
+// GET    /api/v1/admin/orghooks
+// PUT    /api/v1/admin/orghook
+// GET    /api/v1/admin/queue
+// DELETE /api/v1/admin/queue/routes/:route
+// POST   /api/v1/admin/queue/routes/:route/move
 // PUT    /api/v1/admin/repo
+// GET    /api/v1/admin/retention
+// PUT    /api/v1/admin/retention
+// GET    /api/v1/admin/retention/repos/:org/:repo
+// PUT    /api/v1/admin/retention/repos/:org/:repo
+// DELETE /api/v1/admin/retention/repos/:org/:repo
+// POST   /api/v1/admin/retention/sweep
 // PUT    /api/v1/admin/secret
 // PUT    /api/v1/admin/service
 // PUT    /api/v1/admin/step
-// PUT    /api/v1/admin/user.
+// PUT    /api/v1/admin/user
+// POST   /api/v1/admin/workers/ephemeral
+// DELETE /api/v1/admin/workers/ephemeral/:worker
+// POST   /api/v1/admin/workers/:worker/register-token .
 func AdminHandlers(base *gin.RouterGroup) {
 	// Admin endpoints
-	_admin := base.Group("/admin", perm.MustPlatformAdmin())
+	_admin := base.Group("/admin", perm.Enforce())
 	{
+		// Admin anomaly endpoints
+		_admin.GET("/anomalies", admin.AllAnomalies)
+		_admin.POST("/anomalies", admin.DetectAnomalies)
+
+		// Admin archived repo endpoints
+		_admin.GET("/archived/repos", admin.AllArchivedRepos)
+		_admin.POST("/archived/repos/:org/:repo/restore", admin.RestoreArchivedRepo)
+		_admin.DELETE("/archived/repos/:org/:repo", admin.PurgeArchivedRepo)
+
+		// Admin archived secret endpoints
+		_admin.GET("/archived/secrets", admin.AllArchivedSecrets)
+		_admin.POST("/archived/secrets/:type/:org/:name/:secret/restore", admin.RestoreArchivedSecret)
+		_admin.DELETE("/archived/secrets/:type/:org/:name/:secret", admin.PurgeArchivedSecret)
+
 		// Admin build queue endpoint
 		_admin.GET("/builds/queue", admin.AllBuildsQueue)
 
+		// Admin build explain endpoint
+		_admin.GET("/builds/:id/explain", api.ExplainBuild)
+
 		// Admin build endpoint
 		_admin.PUT("/build", admin.UpdateBuild)
 
+		// Admin canary endpoints
+		_admin.GET("/canaries", admin.AllCanaryRuns)
+		_admin.POST("/canaries", admin.RunCanaries)
+
+		// Admin capacity endpoint
+		_admin.GET("/capacity", admin.AllCapacitySnapshots)
+
+		// Admin catalog endpoint
+		_admin.POST("/catalog", admin.PushCatalog)
+
 		// Admin deployment endpoint
 		_admin.PUT("/deployment", admin.UpdateDeployment)
 
+		// Admin diagnostics endpoints
+		_admin.GET("/diagnostics/bundle", admin.GetDiagnosticsBundle)
+		_admin.GET("/diagnostics/pprof/:profile", admin.GetDiagnosticsProfile)
+
+		// Admin export endpoints
+		_admin.GET("/exports", admin.AllExports)
+		_admin.POST("/exports", admin.RunExport)
+
+		// Admin fault endpoints only exist when built for testing
+		if fault.Enabled {
+			_admin.GET("/faults", admin.AllFaults)
+			_admin.PUT("/fault", admin.UpdateFault)
+			_admin.DELETE("/faults", admin.DeleteFaults)
+		}
+
+		// Admin freeze endpoints
+		_admin.POST("/freezes", middleware.Payload(), freeze.CreateFreeze)
+		_admin.GET("/freezes", freeze.ListFreezes)
+		_admin.GET("/freezes/:freeze", freeze.GetFreeze)
+		_admin.DELETE("/freezes/:freeze", freeze.DeleteFreeze)
+		_admin.GET("/freezes/:freeze/audits", freeze.ListFreezeAudits)
+
 		// Admin hook endpoint
 		_admin.PUT("/hook", admin.UpdateHook)
 
+		// Admin lease endpoint
+		_admin.GET("/leases", admin.AllLeases)
+
+		// Admin leak endpoints
+		_admin.GET("/leaks", admin.AllLeakFindings)
+		_admin.POST("/leaks", admin.RunLeakScan)
+
+		// Admin org hook endpoints
+		_admin.GET("/orghooks", admin.AllOrgHooks)
+		_admin.PUT("/orghook", admin.UpdateOrgHook)
+
+		// Admin queue endpoints
+		_admin.GET("/queue", admin.InspectQueue)
+		_admin.DELETE("/queue/routes/:route", admin.PurgeQueueRoute)
+		_admin.POST("/queue/routes/:route/move", admin.MoveQueueRoute)
+
 		// Admin repo endpoint
 		_admin.PUT("/repo", admin.UpdateRepo)
 
+		// Admin retention endpoints
+		_admin.GET("/retention", admin.AllRetentionPolicies)
+		_admin.PUT("/retention", admin.UpdateGlobalRetentionPolicy)
+		_admin.GET("/retention/repos/:org/:repo", admin.GetRetentionPolicy)
+		_admin.PUT("/retention/repos/:org/:repo", admin.UpdateRetentionPolicy)
+		_admin.DELETE("/retention/repos/:org/:repo", admin.DeleteRetentionPolicy)
+		_admin.POST("/retention/sweep", admin.RunRetentionSweep)
+
 		// Admin secret endpoint
 		_admin.PUT("/secret", admin.UpdateSecret)
 
@@ -54,7 +173,9 @@ func AdminHandlers(base *gin.RouterGroup) {
 		// Admin user endpoint
 		_admin.PUT("/user", admin.UpdateUser)
 
-		// Admin worker endpoint
+		// Admin worker endpoints
+		_admin.POST("/workers/ephemeral", middleware.Payload(), api.CreateEphemeralRegistration)
+		_admin.DELETE("/workers/ephemeral/:worker", api.DeleteEphemeralRegistration)
 		_admin.POST("/workers/:worker/register-token", admin.RegisterToken)
 	} // end of admin endpoints
 }
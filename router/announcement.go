@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/announcement"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// AnnouncementHandlers is a function that extends the provided base router group
+// with the API handlers for announcement functionality.
+//
+// POST   /api/v1/announcements
+// GET    /api/v1/announcements
+// GET    /api/v1/announcements/:announcement
+// PUT    /api/v1/announcements/:announcement
+// DELETE /api/v1/announcements/:announcement .
+func AnnouncementHandlers(base *gin.RouterGroup) {
+	// Announcements endpoints
+	_announcements := base.Group("/announcements", perm.Enforce())
+	{
+		_announcements.POST("", middleware.Payload(), announcement.CreateAnnouncement)
+		_announcements.GET("", announcement.ListAnnouncements)
+		_announcements.GET("/:announcement", announcement.GetAnnouncement)
+		_announcements.PUT("/:announcement", middleware.Payload(), announcement.UpdateAnnouncement)
+		_announcements.DELETE("/:announcement", announcement.DeleteAnnouncement)
+	} // end of announcements endpoints
+}
@@ -22,8 +22,24 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build
 // PUT    /api/v1/repos/:org/:repo/builds/:build
 // DELETE /api/v1/repos/:org/:repo/builds/:build
+// POST   /api/v1/repos/:org/:repo/builds/:build/artifacts
+// GET    /api/v1/repos/:org/:repo/builds/:build/artifacts
 // DELETE /api/v1/repos/:org/:repo/builds/:build/cancel
+// GET    /api/v1/repos/:org/:repo/builds/:build/cost
+// GET    /api/v1/repos/:org/:repo/builds/:build/deprecations
+// GET    /api/v1/repos/:org/:repo/builds/:build/images
+// GET    /api/v1/repos/:org/:repo/builds/:build/labels
+// GET    /api/v1/repos/:org/:repo/builds/:build/leaks
 // GET    /api/v1/repos/:org/:repo/builds/:build/logs
+// PUT    /api/v1/repos/:org/:repo/builds/:build/logs
+// POST   /api/v1/repos/:org/:repo/builds/:build/previews
+// GET    /api/v1/repos/:org/:repo/builds/:build/previews
+// GET    /api/v1/repos/:org/:repo/builds/:build/readiness
+// POST   /api/v1/repos/:org/:repo/builds/:build/redactions
+// GET    /api/v1/repos/:org/:repo/builds/:build/redactions
+// GET    /api/v1/repos/:org/:repo/builds/:build/registry-credentials
+// GET    /api/v1/repos/:org/:repo/builds/:build/report
+// GET    /api/v1/repos/:org/:repo/builds/:build/skips
 // POST   /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service
@@ -33,11 +49,15 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/readiness
+// PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/readiness
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step
 // DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
@@ -46,19 +66,35 @@ func BuildHandlers(base *gin.RouterGroup) {
 	// Builds endpoints
 	builds := base.Group("/builds")
 	{
-		builds.POST("", perm.MustAdmin(), middleware.Payload(), api.CreateBuild)
-		builds.GET("", perm.MustRead(), api.GetBuilds)
+		builds.POST("", perm.Enforce(), middleware.Idempotency(), middleware.Payload(), api.CreateBuild)
+		builds.GET("", perm.Enforce(), api.GetBuilds)
 
 		// Build endpoints
 		build := builds.Group("/:build", build.Establish())
 		{
-			build.POST("", perm.MustWrite(), api.RestartBuild)
-			build.GET("", perm.MustRead(), api.GetBuild)
-			build.PUT("", perm.MustBuildAccess(), middleware.Payload(), api.UpdateBuild)
-			build.DELETE("", perm.MustPlatformAdmin(), api.DeleteBuild)
-			build.DELETE("/cancel", executors.Establish(), perm.MustWrite(), api.CancelBuild)
-			build.GET("/logs", perm.MustRead(), api.GetBuildLogs)
-			build.GET("/token", perm.MustWorkerAuthToken(), api.GetBuildToken)
+			build.POST("", perm.Enforce(), middleware.Idempotency(), api.RestartBuild)
+			build.GET("", perm.Enforce(), api.GetBuild)
+			build.PUT("", perm.Enforce(), middleware.Payload(), api.UpdateBuild)
+			build.DELETE("", perm.Enforce(), api.DeleteBuild)
+			build.POST("/artifacts", perm.Enforce(), middleware.Payload(), api.CreateArtifact)
+			build.GET("/artifacts", perm.Enforce(), api.GetBuildArtifacts)
+			build.DELETE("/cancel", executors.Establish(), perm.Enforce(), api.CancelBuild)
+			build.GET("/cost", perm.Enforce(), api.GetBuildCost)
+			build.GET("/deprecations", perm.Enforce(), api.GetBuildDeprecations)
+			build.GET("/images", perm.Enforce(), api.GetBuildImages)
+			build.GET("/labels", perm.Enforce(), api.GetBuildLabels)
+			build.GET("/leaks", perm.Enforce(), api.ListBuildLeakFindings)
+			build.GET("/logs", perm.Enforce(), api.GetBuildLogs)
+			build.PUT("/logs", perm.Enforce(), api.UpdateBuildLogs)
+			build.POST("/previews", perm.Enforce(), middleware.Payload(), api.CreatePreviewEnvironment)
+			build.GET("/previews", perm.Enforce(), api.GetBuildPreviewEnvironments)
+			build.GET("/readiness", perm.Enforce(), api.GetBuildServiceReadiness)
+			build.POST("/redactions", perm.Enforce(), middleware.Payload(), api.CreateBuildRedaction)
+			build.GET("/redactions", perm.Enforce(), api.ListBuildRedactions)
+			build.GET("/registry-credentials", perm.Enforce(), api.GetBuildRegistryCredentials)
+			build.GET("/report", perm.Enforce(), api.GetCompileReport)
+			build.GET("/skips", perm.Enforce(), api.ListStepSkips)
+			build.GET("/token", perm.Enforce(), api.GetBuildToken)
 
 			// Service endpoints
 			// * Log endpoints
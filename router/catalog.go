@@ -0,0 +1,27 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+	"github.com/go-vela/server/router/middleware/repo"
+)
+
+// CatalogHandlers is a function that extends the provided base router group
+// with the API handlers for service catalog functionality.
+//
+// GET    /api/v1/catalog/components
+// GET    /api/v1/catalog/components/:org/:repo .
+func CatalogHandlers(base *gin.RouterGroup) {
+	// Catalog endpoints
+	_catalog := base.Group("/catalog")
+	{
+		_catalog.GET("/components", perm.Enforce(), api.ListCatalogComponents)
+		_catalog.GET("/components/:org/:repo", org.Establish(), repo.Establish(), perm.Enforce(), api.GetCatalogComponent)
+	} // end of catalog endpoints
+}
@@ -18,13 +18,17 @@ import (
 //
 // POST   /api/v1/deployments/:org/:repo
 // GET    /api/v1/deployments/:org/:repo
-// GET    /api/v1/deployments/:org/:repo/:deployment .
+// GET    /api/v1/deployments/:org/:repo/environments
+// GET    /api/v1/deployments/:org/:repo/:deployment
+// GET    /api/v1/deployments/:org/:repo/:deployment/provenance .
 func DeploymentHandlers(base *gin.RouterGroup) {
 	// Deployments endpoints
 	deployments := base.Group("/deployments/:org/:repo", org.Establish(), repo.Establish())
 	{
-		deployments.POST("", perm.MustWrite(), api.CreateDeployment)
-		deployments.GET("", perm.MustRead(), api.GetDeployments)
-		deployments.GET("/:deployment", perm.MustRead(), api.GetDeployment)
+		deployments.POST("", perm.Enforce(), api.CreateDeployment)
+		deployments.GET("", perm.Enforce(), api.GetDeployments)
+		deployments.GET("/environments", perm.Enforce(), api.GetDeployEnvironments)
+		deployments.GET("/:deployment", perm.Enforce(), api.GetDeployment)
+		deployments.GET("/:deployment/provenance", perm.Enforce(), api.GetDeploymentProvenance)
 	} // end of deployments endpoints
 }
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/deprecation"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// DeprecationHandlers is a function that extends the provided base router group
+// with the API handlers for deprecation rule functionality.
+//
+// POST   /api/v1/deprecations
+// GET    /api/v1/deprecations
+// GET    /api/v1/deprecations/:deprecation
+// PUT    /api/v1/deprecations/:deprecation
+// DELETE /api/v1/deprecations/:deprecation .
+func DeprecationHandlers(base *gin.RouterGroup) {
+	// Deprecations endpoints
+	_deprecations := base.Group("/deprecations", perm.Enforce())
+	{
+		_deprecations.POST("", middleware.Payload(), deprecation.CreateDeprecationRule)
+		_deprecations.GET("", deprecation.ListDeprecationRules)
+		_deprecations.GET("/:deprecation", deprecation.GetDeprecationRule)
+		_deprecations.PUT("/:deprecation", middleware.Payload(), deprecation.UpdateDeprecationRule)
+		_deprecations.DELETE("/:deprecation", deprecation.DeleteDeprecationRule)
+	} // end of deprecations endpoints
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/egress"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// EgressHandlers is a function that extends the provided base router group
+// with the API handlers for egress rule functionality.
+//
+// POST   /api/v1/egress/:org
+// GET    /api/v1/egress/:org
+// GET    /api/v1/egress/:org/:rule
+// PUT    /api/v1/egress/:org/:rule
+// DELETE /api/v1/egress/:org/:rule .
+func EgressHandlers(base *gin.RouterGroup) {
+	// Egress endpoints
+	_egress := base.Group("/egress/:org", org.Establish(), perm.Enforce())
+	{
+		_egress.POST("", middleware.Payload(), egress.CreateEgressRule)
+		_egress.GET("", egress.ListEgressRules)
+		_egress.GET("/:rule", egress.GetEgressRule)
+		_egress.PUT("/:rule", middleware.Payload(), egress.UpdateEgressRule)
+		_egress.DELETE("/:rule", egress.DeleteEgressRule)
+	} // end of egress endpoints
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/freeze"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// FreezeHandlers is a function that extends the provided base router group
+// with the API handlers for change freeze functionality.
+//
+// POST   /api/v1/freezes/:org
+// GET    /api/v1/freezes/:org
+// GET    /api/v1/freezes/:org/:freeze
+// DELETE /api/v1/freezes/:org/:freeze
+// GET    /api/v1/freezes/:org/:freeze/audits .
+func FreezeHandlers(base *gin.RouterGroup) {
+	// Freeze endpoints
+	_freezes := base.Group("/freezes/:org", org.Establish(), perm.Enforce())
+	{
+		_freezes.POST("", middleware.Payload(), freeze.CreateFreeze)
+		_freezes.GET("", freeze.ListFreezes)
+		_freezes.GET("/:freeze", freeze.GetFreeze)
+		_freezes.DELETE("/:freeze", freeze.DeleteFreeze)
+		_freezes.GET("/:freeze/audits", freeze.ListFreezeAudits)
+	} // end of freeze endpoints
+}
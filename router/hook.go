@@ -25,11 +25,11 @@ func HookHandlers(base *gin.RouterGroup) {
 	// Hooks endpoints
 	hooks := base.Group("/hooks/:org/:repo", org.Establish(), repo.Establish())
 	{
-		hooks.POST("", perm.MustPlatformAdmin(), api.CreateHook)
-		hooks.GET("", perm.MustRead(), api.GetHooks)
-		hooks.GET("/:hook", perm.MustRead(), api.GetHook)
-		hooks.PUT("/:hook", perm.MustPlatformAdmin(), api.UpdateHook)
-		hooks.DELETE("/:hook", perm.MustPlatformAdmin(), api.DeleteHook)
-		hooks.POST("/:hook/redeliver", perm.MustWrite(), api.RedeliverHook)
+		hooks.POST("", perm.Enforce(), api.CreateHook)
+		hooks.GET("", perm.Enforce(), api.GetHooks)
+		hooks.GET("/:hook", perm.Enforce(), api.GetHook)
+		hooks.PUT("/:hook", perm.Enforce(), api.UpdateHook)
+		hooks.DELETE("/:hook", perm.Enforce(), api.DeleteHook)
+		hooks.POST("/:hook/redeliver", perm.Enforce(), api.RedeliverHook)
 	} // end of hooks endpoints
 }
@@ -0,0 +1,25 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// ImportHandlers is a function that extends the provided base router group
+// with the API handlers for migration import functionality.
+//
+// POST   /api/v1/import/drone
+// POST   /api/v1/import/jenkins .
+func ImportHandlers(base *gin.RouterGroup) {
+	// Import endpoints
+	_import := base.Group("/import")
+	{
+		_import.POST("/drone", perm.Enforce(), api.ImportDrone)
+		_import.POST("/jenkins", perm.Enforce(), api.ImportJenkins)
+	} // end of import endpoints
+}
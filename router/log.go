@@ -16,15 +16,21 @@ import (
 // POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/render
+// POST   /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/lines
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs/lines .
 func LogServiceHandlers(base *gin.RouterGroup) {
 	// Logs endpoints
 	logs := base.Group("/logs")
 	{
-		logs.POST("", perm.MustAdmin(), api.CreateServiceLog)
-		logs.GET("", perm.MustRead(), api.GetServiceLog)
-		logs.PUT("", perm.MustBuildAccess(), api.UpdateServiceLog)
-		logs.DELETE("", perm.MustPlatformAdmin(), api.DeleteServiceLog)
+		logs.POST("", perm.Enforce(), api.CreateServiceLog)
+		logs.GET("", perm.Enforce(), api.GetServiceLog)
+		logs.PUT("", perm.Enforce(), api.UpdateServiceLog)
+		logs.DELETE("", perm.Enforce(), api.DeleteServiceLog)
+		logs.GET("/render", perm.Enforce(), api.RenderServiceLog)
+		logs.POST("/lines", perm.Enforce(), api.CreateServiceLogLines)
+		logs.GET("/lines", perm.Enforce(), api.ListServiceLogLines)
 	} // end of logs endpoints
 }
 
@@ -34,14 +40,20 @@ func LogServiceHandlers(base *gin.RouterGroup) {
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
-// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs .
+// DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/render
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/lines
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/lines .
 func LogStepHandlers(base *gin.RouterGroup) {
 	// Logs endpoints
 	logs := base.Group("/logs")
 	{
-		logs.POST("", perm.MustAdmin(), api.CreateStepLog)
-		logs.GET("", perm.MustRead(), api.GetStepLog)
-		logs.PUT("", perm.MustBuildAccess(), api.UpdateStepLog)
-		logs.DELETE("", perm.MustPlatformAdmin(), api.DeleteStepLog)
+		logs.POST("", perm.Enforce(), api.CreateStepLog)
+		logs.GET("", perm.Enforce(), api.GetStepLog)
+		logs.PUT("", perm.Enforce(), api.UpdateStepLog)
+		logs.DELETE("", perm.Enforce(), api.DeleteStepLog)
+		logs.GET("/render", perm.Enforce(), api.RenderStepLog)
+		logs.POST("/lines", perm.Enforce(), api.CreateStepLogLines)
+		logs.GET("/lines", perm.Enforce(), api.ListStepLogLines)
 	} // end of logs endpoints
 }
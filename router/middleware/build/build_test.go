@@ -5,6 +5,7 @@
 package build
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -94,8 +95,8 @@ func TestBuild_Establish(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(want)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), want)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -172,7 +173,7 @@ func TestBuild_Establish_NoBuildParameter(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
+	_ = db.CreateRepo(context.TODO(), r)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -218,7 +219,7 @@ func TestBuild_Establish_InvalidBuildParameter(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
+	_ = db.CreateRepo(context.TODO(), r)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -264,7 +265,7 @@ func TestBuild_Establish_NoBuild(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
+	_ = db.CreateRepo(context.TODO(), r)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
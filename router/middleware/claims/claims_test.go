@@ -279,7 +279,7 @@ func TestClaims_Establish_BadToken(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateUser(u)
+	_ = db.CreateUser(context, u)
 
 	mto := &token.MintTokenOpts{
 		User:          u,
@@ -0,0 +1,28 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// CorsOrigins is a middleware function that attaches the additional
+// origins, beyond the web UI address, allowed to make cross-origin
+// requests with credentials to the API.
+func CorsOrigins(origins []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("corsOrigins", origins)
+		c.Next()
+	}
+}
+
+// ContentSecurityPolicy is a middleware function that attaches the
+// Content-Security-Policy header value set on every response.
+func ContentSecurityPolicy(policy string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("contentSecurityPolicy", policy)
+		c.Next()
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// DeployApprovals is a middleware function that attaches the number of
+// pull request approvals and the team review required for a commit
+// before a deployment build is compiled and published to the queue.
+func DeployApprovals(approvals int, team string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("deployApprovals", approvals)
+		c.Set("deployApprovalTeam", team)
+		c.Next()
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddleware_DeployApprovals(t *testing.T) {
+	// setup types
+	var (
+		gotApprovals int
+		gotTeam      string
+	)
+
+	wantApprovals := 2
+	wantTeam := "release"
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(DeployApprovals(wantApprovals, wantTeam))
+	engine.GET("/health", func(c *gin.Context) {
+		gotApprovals = c.Value("deployApprovals").(int)
+		gotTeam = c.Value("deployApprovalTeam").(string)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("DeployApprovals returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if gotApprovals != wantApprovals {
+		t.Errorf("DeployApprovals approvals is %v, want %v", gotApprovals, wantApprovals)
+	}
+
+	if gotTeam != wantTeam {
+		t.Errorf("DeployApprovals team is %v, want %v", gotTeam, wantTeam)
+	}
+}
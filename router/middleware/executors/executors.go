@@ -32,7 +32,7 @@ func Establish() gin.HandlerFunc {
 		e := new([]library.Executor)
 		b := build.Retrieve(c)
 		// retrieve the worker
-		w, err := database.FromContext(c).GetWorkerForHostname(b.GetHost())
+		w, err := database.FromContext(c).GetWorkerForHostname(c, b.GetHost())
 		if err != nil {
 			retErr := fmt.Errorf("unable to get worker: %w", err)
 			util.HandleError(c, http.StatusNotFound, retErr)
@@ -6,6 +6,7 @@ package middleware
 
 import (
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -31,13 +32,9 @@ func Options(c *gin.Context) {
 	if c.Request.Method != "OPTIONS" {
 		c.Next()
 	} else {
-		c.Header("Access-Control-Allow-Origin", "*")
-		if len(m.Vela.WebAddress) > 0 {
-			c.Header("Access-Control-Allow-Origin", m.Vela.WebAddress)
-			c.Header("Access-Control-Allow-Credentials", "true")
-		}
+		allowOrigin(c, m)
 		c.Header("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "authorization, origin, content-type, accept")
+		c.Header("Access-Control-Allow-Headers", "authorization, origin, content-type, accept, if-match, idempotency-key")
 		c.Header("Access-Control-Max-Age", "86400")
 		c.Header("Allow", "HEAD,GET,POST,PUT,PATCH,DELETE,OPTIONS")
 		c.Header("Content-Type", "application/json")
@@ -51,8 +48,13 @@ func Secure(c *gin.Context) {
 	c.Header("X-Frame-Options", "DENY")
 	c.Header("X-Content-Type-Options", "nosniff")
 	c.Header("X-XSS-Protection", "1; mode=block")
-	// TODO: consider adding Content-Security-Policy headers
-	// c.Header("Content-Security-Policy", "script-src 'self' https://cdnjs.cloudflare.com")
+	c.Header("Referrer-Policy", "no-referrer")
+
+	// check if a content security policy was configured
+	if policy := c.GetString("contentSecurityPolicy"); len(policy) > 0 {
+		c.Header("Content-Security-Policy", policy)
+	}
+
 	c.Header("Strict-Transport-Security", "max-age=63072000; includeSubDomains; preload")
 }
 
@@ -62,6 +64,19 @@ func Secure(c *gin.Context) {
 func Cors(c *gin.Context) {
 	m := c.MustGet("metadata").(*types.Metadata)
 
+	allowOrigin(c, m)
+
+	c.Header("Access-Control-Expose-Headers", "link, x-total-count, etag, idempotent-replayed")
+}
+
+// allowOrigin is a helper function to append the headers for
+// the origin allowed to make cross-origin requests to the API.
+//
+// The origin of the request is echoed back when it matches the
+// web UI address or one of the configured CORS origins. Otherwise,
+// the web UI address is used when one is configured and any origin
+// is allowed, without credentials, when one is not.
+func allowOrigin(c *gin.Context, m *types.Metadata) {
 	c.Header("Access-Control-Allow-Origin", "*")
 
 	if len(m.Vela.WebAddress) > 0 {
@@ -69,7 +84,56 @@ func Cors(c *gin.Context) {
 		c.Header("Access-Control-Allow-Credentials", "true")
 	}
 
-	c.Header("Access-Control-Expose-Headers", "link, x-total-count")
+	origins := c.GetStringSlice("corsOrigins")
+
+	// check if additional origins were configured
+	if len(origins) == 0 {
+		return
+	}
+
+	// the allowed origin now depends on the origin of the request
+	c.Writer.Header().Add("Vary", "Origin")
+
+	origin := c.GetHeader("Origin")
+
+	if len(origin) > 0 && (matchOrigin(origin, m.Vela.WebAddress) || matchOrigin(origin, origins...)) {
+		c.Header("Access-Control-Allow-Origin", origin)
+		c.Header("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+// matchOrigin is a helper function to determine if the origin
+// matches one of the provided allowed origins. An allowed origin
+// may use a leading wildcard for the host (https://*.example.com)
+// to match any of its subdomains.
+func matchOrigin(origin string, allowed ...string) bool {
+	origin = strings.ToLower(origin)
+
+	for _, a := range allowed {
+		a = strings.ToLower(strings.TrimSuffix(a, "/"))
+
+		if len(a) == 0 {
+			continue
+		}
+
+		if a == origin {
+			return true
+		}
+
+		scheme, domain, found := strings.Cut(a, "*.")
+		if !found {
+			continue
+		}
+
+		// check if the origin is a subdomain for the wildcard origin
+		if strings.HasPrefix(origin, scheme) &&
+			strings.HasSuffix(origin, "."+domain) &&
+			len(origin) > len(scheme)+len(domain)+1 {
+			return true
+		}
+	}
+
+	return false
 }
 
 // RequestVersion is a middleware function that injects the Vela API version
@@ -63,7 +63,7 @@ func TestMiddleware_Options(t *testing.T) {
 	// setup types
 	wantOrigin := "*"
 	wantMethods := "GET,POST,PUT,PATCH,DELETE,OPTIONS"
-	wantHeaders := "authorization, origin, content-type, accept"
+	wantHeaders := "authorization, origin, content-type, accept, if-match, idempotency-key"
 	wantAllow := "HEAD,GET,POST,PUT,PATCH,DELETE,OPTIONS"
 	wantContentType := "application/json"
 	m := &types.Metadata{
@@ -179,7 +179,7 @@ func TestMiddleware_Options_InvalidMethod(t *testing.T) {
 func TestMiddleware_Cors(t *testing.T) {
 	// setup types
 	wantOrigin := "*"
-	wantExposeHeaders := "link, x-total-count"
+	wantExposeHeaders := "link, x-total-count, etag, idempotent-replayed"
 	m := &types.Metadata{
 		Vela: &types.Vela{
 			Address: "http://localhost:8080",
@@ -311,3 +311,157 @@ func TestMiddleware_Secure_TLS(t *testing.T) {
 		t.Errorf("Secure Strict-Transport-Security is %v, want %v", gotSecurity, wantSecurity)
 	}
 }
+
+func TestMiddleware_Secure_ContentSecurityPolicy(t *testing.T) {
+	// setup types
+	wantPolicy := "default-src 'none'; frame-ancestors 'none'"
+	wantReferrer := "no-referrer"
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(ContentSecurityPolicy(wantPolicy))
+	engine.Use(Secure)
+	engine.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	gotPolicy := context.Writer.Header().Get("Content-Security-Policy")
+	gotReferrer := context.Writer.Header().Get("Referrer-Policy")
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("Secure returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if !reflect.DeepEqual(gotPolicy, wantPolicy) {
+		t.Errorf("Secure Content-Security-Policy is %v, want %v", gotPolicy, wantPolicy)
+	}
+
+	if !reflect.DeepEqual(gotReferrer, wantReferrer) {
+		t.Errorf("Secure Referrer-Policy is %v, want %v", gotReferrer, wantReferrer)
+	}
+}
+
+func TestMiddleware_Cors_Origins(t *testing.T) {
+	// setup types
+	m := &types.Metadata{
+		Vela: &types.Vela{
+			Address:    "http://localhost:8080",
+			WebAddress: "https://vela.example.com",
+		},
+	}
+
+	origins := []string{"https://tools.example.com", "https://*.ui.example.com/"}
+
+	// setup tests
+	tests := []struct {
+		name            string
+		origin          string
+		wantOrigin      string
+		wantCredentials string
+	}{
+		{
+			name:            "web address",
+			origin:          "https://vela.example.com",
+			wantOrigin:      "https://vela.example.com",
+			wantCredentials: "true",
+		},
+		{
+			name:            "configured origin",
+			origin:          "https://Tools.example.com",
+			wantOrigin:      "https://Tools.example.com",
+			wantCredentials: "true",
+		},
+		{
+			name:            "wildcard origin",
+			origin:          "https://staging.ui.example.com",
+			wantOrigin:      "https://staging.ui.example.com",
+			wantCredentials: "true",
+		},
+		{
+			name:            "wildcard origin without subdomain",
+			origin:          "https://.ui.example.com",
+			wantOrigin:      "https://vela.example.com",
+			wantCredentials: "true",
+		},
+		{
+			name:            "wildcard origin with different scheme",
+			origin:          "http://staging.ui.example.com",
+			wantOrigin:      "https://vela.example.com",
+			wantCredentials: "true",
+		},
+		{
+			name:            "unknown origin",
+			origin:          "https://evil.com",
+			wantOrigin:      "https://vela.example.com",
+			wantCredentials: "true",
+		},
+		{
+			name:            "no origin",
+			origin:          "",
+			wantOrigin:      "https://vela.example.com",
+			wantCredentials: "true",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			context, engine := gin.CreateTestContext(resp)
+			context.Request, _ = http.NewRequest(http.MethodOptions, "/health", nil)
+
+			if len(test.origin) > 0 {
+				context.Request.Header.Set("Origin", test.origin)
+			}
+
+			// setup mock server
+			engine.Use(Metadata(m))
+			engine.Use(CorsOrigins(origins))
+			engine.Use(Options)
+			engine.Use(Cors)
+			engine.GET("/health", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			// run preflight and actual requests
+			for _, method := range []string{http.MethodOptions, http.MethodGet} {
+				resp = httptest.NewRecorder()
+				context.Request.Method = method
+
+				engine.ServeHTTP(resp, context.Request)
+
+				gotOrigin := resp.Header().Get("Access-Control-Allow-Origin")
+				gotCredentials := resp.Header().Get("Access-Control-Allow-Credentials")
+				gotVary := resp.Header().Get("Vary")
+
+				if resp.Code != http.StatusOK {
+					t.Errorf("%s returned %v, want %v", method, resp.Code, http.StatusOK)
+				}
+
+				if !reflect.DeepEqual(gotOrigin, test.wantOrigin) {
+					t.Errorf("%s Access-Control-Allow-Origin is %v, want %v", method, gotOrigin, test.wantOrigin)
+				}
+
+				if !reflect.DeepEqual(gotCredentials, test.wantCredentials) {
+					t.Errorf("%s Access-Control-Allow-Credentials is %v, want %v", method, gotCredentials, test.wantCredentials)
+				}
+
+				if !reflect.DeepEqual(gotVary, "Origin") {
+					t.Errorf("%s Vary is %v, want Origin", method, gotVary)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/server/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// HeaderIdempotencyKey defines the header clients
+	// provide to deduplicate retries of a request.
+	HeaderIdempotencyKey = "Idempotency-Key"
+
+	// HeaderIdempotentReplayed defines the header set on
+	// responses replayed for a duplicate request.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	// idempotencyKeyLimit defines the maximum
+	// length for an idempotency key.
+	idempotencyKeyLimit = 255
+
+	// idempotencyKeyTTL defines the duration an idempotency
+	// key deduplicates retries of a request for.
+	idempotencyKeyTTL = 24 * time.Hour
+)
+
+// idempotencyWriter represents the gin.ResponseWriter used
+// to capture the response for a request with an idempotency key.
+type idempotencyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write captures the body while writing the response.
+func (w *idempotencyWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+
+	return w.ResponseWriter.Write(b)
+}
+
+// WriteString captures the body while writing the response.
+func (w *idempotencyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Idempotency is a middleware function that deduplicates retries
+// of a request for a repo that provide the same idempotency key.
+// The response for the first successful request is stored and
+// replayed for every retry within the idempotency key window.
+//
+//nolint:funlen // ignore function length
+func Idempotency() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(HeaderIdempotencyKey)
+
+		// check if an idempotency key was provided
+		if len(key) == 0 {
+			c.Next()
+
+			return
+		}
+
+		if len(key) > idempotencyKeyLimit {
+			retErr := fmt.Errorf("idempotency key must be %d characters or less", idempotencyKeyLimit)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		r := repo.Retrieve(c)
+		db := database.FromContext(c)
+
+		// read the request body to fingerprint the request
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			retErr := fmt.Errorf("unable to read request body: %w", err)
+
+			util.HandleError(c, http.StatusBadRequest, retErr)
+
+			return
+		}
+
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		fingerprint := fmt.Sprintf("%x", sha256.Sum256(
+			[]byte(fmt.Sprintf("%s %s\n%s", c.Request.Method, c.Request.URL.Path, body)),
+		))
+
+		k := new(api.IdempotencyKey)
+		k.SetRepoID(r.GetID())
+		k.SetKey(key)
+		k.SetFingerprint(fingerprint)
+		k.SetCreated(time.Now().UTC().Unix())
+
+		// send API call to capture an existing idempotency key
+		existing, err := db.GetIdempotencyKeyForRepo(c, r, key)
+		if err == nil {
+			// check if the existing idempotency key has expired
+			if existing.GetCreated() < time.Now().Add(-idempotencyKeyTTL).Unix() {
+				// send API call to remove the expired idempotency key
+				err = db.DeleteIdempotencyKey(c, existing)
+				if err != nil {
+					retErr := fmt.Errorf("unable to delete expired idempotency key %s: %w", key, err)
+
+					util.HandleError(c, http.StatusInternalServerError, retErr)
+
+					return
+				}
+			} else {
+				replayIdempotencyKey(c, existing, fingerprint)
+
+				return
+			}
+		}
+
+		// send API call to reserve the idempotency key
+		//
+		// the unique constraint on the repo and key ensures
+		// only one concurrent request processes the key
+		err = db.CreateIdempotencyKey(c, k)
+		if err != nil {
+			existing, gErr := db.GetIdempotencyKeyForRepo(c, r, key)
+			if gErr != nil {
+				retErr := fmt.Errorf("unable to reserve idempotency key %s: %w", key, err)
+
+				util.HandleError(c, http.StatusInternalServerError, retErr)
+
+				return
+			}
+
+			replayIdempotencyKey(c, existing, fingerprint)
+
+			return
+		}
+
+		// send API call to capture the reserved idempotency key
+		k, err = db.GetIdempotencyKeyForRepo(c, r, key)
+		if err != nil {
+			retErr := fmt.Errorf("unable to get idempotency key %s: %w", key, err)
+
+			util.HandleError(c, http.StatusInternalServerError, retErr)
+
+			return
+		}
+
+		w := &idempotencyWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		c.Next()
+
+		status := c.Writer.Status()
+
+		// release the idempotency key if the request was unsuccessful
+		// so the client can retry the request with the same key
+		if status < http.StatusOK || status >= http.StatusMultipleChoices {
+			err = db.DeleteIdempotencyKey(c, k)
+			if err != nil {
+				logrus.Errorf("unable to release idempotency key %s for %s: %v", key, r.GetFullName(), err)
+			}
+
+			return
+		}
+
+		// store the response to replay for retries of the request
+		k.SetStatus(status)
+		k.SetResponse(w.body.String())
+
+		// send API call to update the idempotency key
+		err = db.UpdateIdempotencyKey(c, k)
+		if err != nil {
+			logrus.Errorf("unable to update idempotency key %s for %s: %v", key, r.GetFullName(), err)
+		}
+	}
+}
+
+// replayIdempotencyKey is a helper function to respond to a retry of
+// a request with the response stored for the idempotency key.
+func replayIdempotencyKey(c *gin.Context, k *api.IdempotencyKey, fingerprint string) {
+	// check if the idempotency key was used for a different request
+	if k.GetFingerprint() != fingerprint {
+		retErr := fmt.Errorf("idempotency key %s was already used for a different request", k.GetKey())
+
+		util.HandleError(c, http.StatusUnprocessableEntity, retErr)
+
+		return
+	}
+
+	// check if the request for the idempotency key is still processing
+	if k.GetStatus() == 0 {
+		retErr := fmt.Errorf("request with idempotency key %s is still being processed", k.GetKey())
+
+		util.HandleError(c, http.StatusConflict, retErr)
+
+		return
+	}
+
+	c.Header(HeaderIdempotentReplayed, "true")
+	c.Data(k.GetStatus(), "application/json; charset=utf-8", []byte(k.GetResponse()))
+	c.Abort()
+}
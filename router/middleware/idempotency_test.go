@@ -0,0 +1,151 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/router/middleware/repo"
+	"github.com/go-vela/types/library"
+)
+
+func TestMiddleware_Idempotency(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from idempotency_keys;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	created := 0
+
+	// setup mock server
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.Use(Database(db))
+	engine.Use(func(c *gin.Context) {
+		repo.ToContext(c, r)
+		c.Next()
+	})
+	engine.POST("/builds", Idempotency(), func(c *gin.Context) {
+		created++
+
+		if c.GetHeader("X-Fail") == "true" {
+			c.AbortWithStatus(http.StatusInternalServerError)
+
+			return
+		}
+
+		c.JSON(http.StatusCreated, map[string]int{"number": created})
+	})
+
+	// setup tests
+	tests := []struct {
+		name     string
+		key      string
+		body     string
+		fail     bool
+		want     int
+		wantBody string
+		replayed bool
+		created  int
+	}{
+		{
+			name:     "without key",
+			body:     `{"branch":"main"}`,
+			want:     http.StatusCreated,
+			wantBody: `{"number":1}`,
+			created:  1,
+		},
+		{
+			name:    "failed request releases key",
+			key:     "retry",
+			body:    `{"branch":"main"}`,
+			fail:    true,
+			want:    http.StatusInternalServerError,
+			created: 2,
+		},
+		{
+			name:     "first request with key",
+			key:      "retry",
+			body:     `{"branch":"main"}`,
+			want:     http.StatusCreated,
+			wantBody: `{"number":3}`,
+			created:  3,
+		},
+		{
+			name:     "retry with key",
+			key:      "retry",
+			body:     `{"branch":"main"}`,
+			want:     http.StatusCreated,
+			wantBody: `{"number":3}`,
+			replayed: true,
+			created:  3,
+		},
+		{
+			name:    "key reused for different request",
+			key:     "retry",
+			body:    `{"branch":"dev"}`,
+			want:    http.StatusUnprocessableEntity,
+			created: 3,
+		},
+		{
+			name:    "key too long",
+			key:     strings.Repeat("a", 256),
+			body:    `{"branch":"main"}`,
+			want:    http.StatusBadRequest,
+			created: 3,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+
+			req, _ := http.NewRequest(http.MethodPost, "/builds", strings.NewReader(test.body))
+			if len(test.key) > 0 {
+				req.Header.Set(HeaderIdempotencyKey, test.key)
+			}
+
+			if test.fail {
+				req.Header.Set("X-Fail", "true")
+			}
+
+			engine.ServeHTTP(resp, req)
+
+			if resp.Code != test.want {
+				t.Errorf("Idempotency returned %v, want %v", resp.Code, test.want)
+			}
+
+			if len(test.wantBody) > 0 && resp.Body.String() != test.wantBody {
+				t.Errorf("Idempotency body is %s, want %s", resp.Body.String(), test.wantBody)
+			}
+
+			if (resp.Header().Get(HeaderIdempotentReplayed) == "true") != test.replayed {
+				t.Errorf("Idempotency replayed is %v, want %v", !test.replayed, test.replayed)
+			}
+
+			if created != test.created {
+				t.Errorf("Idempotency created %d builds, want %d", created, test.created)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics is a middleware function that attaches the bearer token and
+// whether verified client certificates are accepted for authenticating
+// scrapes of the metrics endpoint to the context of every http.Request.
+func Metrics(token string, mtls bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("metrics-token", token)
+		c.Set("metrics-mtls", mtls)
+		c.Next()
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddleware_Metrics(t *testing.T) {
+	// setup types
+	var (
+		gotToken string
+		gotMTLS  bool
+	)
+
+	wantToken := "foobar"
+	wantMTLS := true
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/metrics", nil)
+
+	// setup mock server
+	engine.Use(Metrics(wantToken, wantMTLS))
+	engine.GET("/metrics", func(c *gin.Context) {
+		gotToken = c.Value("metrics-token").(string)
+		gotMTLS = c.Value("metrics-mtls").(bool)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("Metrics returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if gotToken != wantToken {
+		t.Errorf("Metrics token is %v, want %v", gotToken, wantToken)
+	}
+
+	if gotMTLS != wantMTLS {
+		t.Errorf("Metrics mtls is %v, want %v", gotMTLS, wantMTLS)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// OrgTemplateRepo is a middleware function that attaches the name of the repo
+// in each org that shared templates and pipeline fragments resolve from.
+func OrgTemplateRepo(repo string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("orgTemplateRepo", repo)
+		c.Next()
+	}
+}
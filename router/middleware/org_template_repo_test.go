@@ -0,0 +1,46 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddleware_OrgTemplateRepo(t *testing.T) {
+	// setup types
+	var got string
+
+	want := ".vela"
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/health", nil)
+
+	// setup mock server
+	engine.Use(OrgTemplateRepo(want))
+	engine.GET("/health", func(c *gin.Context) {
+		got = c.Value("orgTemplateRepo").(string)
+
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("OrgTemplateRepo returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if got != want {
+		t.Errorf("OrgTemplateRepo is %v, want %v", got, want)
+	}
+}
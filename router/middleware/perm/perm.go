@@ -11,6 +11,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/internal/token"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/claims"
 	"github.com/go-vela/server/router/middleware/org"
@@ -19,6 +20,7 @@ import (
 	"github.com/go-vela/server/scm"
 	"github.com/go-vela/server/util"
 	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
 	"github.com/sirupsen/logrus"
 )
 
@@ -68,7 +70,7 @@ func MustWorkerRegisterToken() gin.HandlerFunc {
 		}).Debugf("verifying user %s has a registration token for worker", cl.Subject)
 
 		switch cl.TokenType {
-		case constants.WorkerRegisterTokenType:
+		case constants.WorkerRegisterTokenType, token.EphemeralRegisterTokenType:
 			return
 		case constants.ServerWorkerTokenType:
 			if strings.EqualFold(cl.Subject, "vela-worker") {
@@ -166,6 +168,108 @@ func MustBuildAccess() gin.HandlerFunc {
 	}
 }
 
+// AllowRepoTrigger allows a repo trigger token for the repo to
+// bypass the provided permission check. Requests made with any
+// other token are handed off to the provided permission check.
+func AllowRepoTrigger(h gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cl := claims.Retrieve(c)
+
+		if !cl.IsRepoTrigger() {
+			h(c)
+
+			return
+		}
+
+		r := repo.Retrieve(c)
+
+		// update engine logger with API metadata
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+		logger := logrus.WithFields(logrus.Fields{
+			"repo": r.GetFullName(),
+			"user": cl.Subject,
+		})
+
+		logger.Debugf("verifying repo trigger token from %s is valid for repo %s", cl.Subject, r.GetFullName())
+
+		if !cl.Triggers(r) {
+			logger.Warnf("repo trigger token for %s attempted to be used for repo %s", cl.Repo, r.GetFullName())
+
+			retErr := fmt.Errorf("repo trigger token for %s may not trigger builds for repo %s", cl.Repo, r.GetFullName())
+
+			util.HandleError(c, http.StatusUnauthorized, retErr)
+
+			return
+		}
+
+		// verify the token is stored and hasn't been revoked
+		_, err := database.FromContext(c).GetTriggerTokenForTokenID(c, cl.ID)
+		if err != nil {
+			logger.Warnf("revoked repo trigger token for %s attempted to be used for repo %s", cl.Repo, r.GetFullName())
+
+			retErr := fmt.Errorf("repo trigger token for %s has been revoked", cl.Repo)
+
+			util.HandleError(c, http.StatusUnauthorized, retErr)
+
+			return
+		}
+
+		// verify the user that minted the token is still allowed to mint it
+		err = canMintRepoTrigger(c, cl, r)
+		if err != nil {
+			logger.Warnf("repo trigger token for %s attempted to be used for repo %s: %v", cl.Repo, r.GetFullName(), err)
+
+			retErr := fmt.Errorf("repo trigger token for %s may not trigger builds for repo %s: %w", cl.Repo, r.GetFullName(), err)
+
+			util.HandleError(c, http.StatusUnauthorized, retErr)
+		}
+	}
+}
+
+// canMintRepoTrigger is a helper function to verify the user that minted
+// the repo trigger token still has the permission required to mint it,
+// which is admin access to the repo or to the org for org trigger tokens.
+func canMintRepoTrigger(c *gin.Context, cl *token.Claims, r *library.Repo) error {
+	u, err := database.FromContext(c).GetUserForName(c, cl.Subject)
+	if err != nil {
+		return fmt.Errorf("unable to get user %s: %w", cl.Subject, err)
+	}
+
+	if !u.GetActive() {
+		return fmt.Errorf("user %s is not active", u.GetName())
+	}
+
+	if u.GetAdmin() {
+		return nil
+	}
+
+	// the org trigger token is minted by an admin of the org
+	if strings.EqualFold(cl.Repo, token.OrgTrigger(r.GetOrg())) {
+		perm, err := scm.FromContext(c).OrgAccess(u, r.GetOrg())
+		if err != nil {
+			return fmt.Errorf("unable to get user %s access level for org %s: %w", u.GetName(), r.GetOrg(), err)
+		}
+
+		if !strings.EqualFold(perm, "admin") {
+			return fmt.Errorf("user %s no longer has 'admin' permissions for the org %s", u.GetName(), r.GetOrg())
+		}
+
+		return nil
+	}
+
+	perm, err := scm.FromContext(c).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
+	if err != nil {
+		return fmt.Errorf("unable to get user %s access level for repo %s: %w", u.GetName(), r.GetFullName(), err)
+	}
+
+	if !strings.EqualFold(perm, "admin") {
+		return fmt.Errorf("user %s no longer has 'admin' permissions for the repo %s", u.GetName(), r.GetFullName())
+	}
+
+	return nil
+}
+
 // MustSecretAdmin ensures the user has admin access to the org, repo or team.
 //
 //nolint:funlen // ignore function length
@@ -206,6 +310,10 @@ func MustSecretAdmin() gin.HandlerFunc {
 		// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
 		logger := logrus.WithFields(fields)
 
+		if rejectRepoTrigger(c, cl) {
+			return
+		}
+
 		if u.GetAdmin() {
 			return
 		}
@@ -340,9 +448,59 @@ func MustSecretAdmin() gin.HandlerFunc {
 	}
 }
 
+// MustOrgAdmin ensures the user has admin access to the org.
+func MustOrgAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cl := claims.Retrieve(c)
+		o := org.Retrieve(c)
+		u := user.Retrieve(c)
+
+		// update engine logger with API metadata
+		//
+		// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#Entry.WithFields
+		logger := logrus.WithFields(logrus.Fields{
+			"org":  o,
+			"user": u.GetName(),
+		})
+
+		if rejectRepoTrigger(c, cl) {
+			return
+		}
+
+		// only users may manage an org
+		if u.GetID() == 0 {
+			retErr := fmt.Errorf("subject %s does not have 'admin' permissions for the org %s", cl.Subject, o)
+
+			util.HandleError(c, http.StatusUnauthorized, retErr)
+
+			return
+		}
+
+		if u.GetAdmin() {
+			return
+		}
+
+		logger.Debugf("verifying user %s has 'admin' permissions for org %s", u.GetName(), o)
+
+		perm, err := scm.FromContext(c).OrgAccess(u, o)
+		if err != nil {
+			logger.Errorf("unable to get user %s access level for org %s: %v", u.GetName(), o, err)
+		}
+
+		if !strings.EqualFold(perm, "admin") {
+			retErr := fmt.Errorf("user %s does not have 'admin' permissions for the org %s", u.GetName(), o)
+
+			util.HandleError(c, http.StatusUnauthorized, retErr)
+
+			return
+		}
+	}
+}
+
 // MustAdmin ensures the user has admin access to the repo.
 func MustAdmin() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		cl := claims.Retrieve(c)
 		o := org.Retrieve(c)
 		r := repo.Retrieve(c)
 		u := user.Retrieve(c)
@@ -358,18 +516,22 @@ func MustAdmin() gin.HandlerFunc {
 
 		logger.Debugf("verifying user %s has 'admin' permissions for repo %s", u.GetName(), r.GetFullName())
 
+		if rejectRepoTrigger(c, cl) {
+			return
+		}
+
 		if u.GetAdmin() {
 			return
 		}
 
 		// query source to determine requesters permissions for the repo using the requester's token
-		perm, err := scm.FromContext(c).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
+		perm, err := scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
 		if err != nil {
 			// requester may not have permissions to use the Github API endpoint (requires read access)
 			// try again using the repo owner token
 			//
 			// https://docs.github.com/en/rest/reference/repos#get-repository-permissions-for-a-user
-			ro, err := database.FromContext(c).GetUser(r.GetUserID())
+			ro, err := database.FromContext(c).GetUser(c, r.GetUserID())
 			if err != nil {
 				retErr := fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
 
@@ -378,7 +540,7 @@ func MustAdmin() gin.HandlerFunc {
 				return
 			}
 
-			perm, err = scm.FromContext(c).RepoAccess(u, ro.GetToken(), r.GetOrg(), r.GetName())
+			perm, err = scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, ro.GetToken(), r.GetOrg(), r.GetName())
 			if err != nil {
 				logger.Errorf("unable to get user %s access level for repo %s", u.GetName(), r.GetFullName())
 			}
@@ -401,6 +563,7 @@ func MustAdmin() gin.HandlerFunc {
 // MustWrite ensures the user has admin or write access to the repo.
 func MustWrite() gin.HandlerFunc {
 	return func(c *gin.Context) {
+		cl := claims.Retrieve(c)
 		o := org.Retrieve(c)
 		r := repo.Retrieve(c)
 		u := user.Retrieve(c)
@@ -416,18 +579,22 @@ func MustWrite() gin.HandlerFunc {
 
 		logger.Debugf("verifying user %s has 'write' permissions for repo %s", u.GetName(), r.GetFullName())
 
+		if rejectRepoTrigger(c, cl) {
+			return
+		}
+
 		if u.GetAdmin() {
 			return
 		}
 
 		// query source to determine requesters permissions for the repo using the requester's token
-		perm, err := scm.FromContext(c).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
+		perm, err := scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
 		if err != nil {
 			// requester may not have permissions to use the Github API endpoint (requires read access)
 			// try again using the repo owner token
 			//
 			// https://docs.github.com/en/rest/reference/repos#get-repository-permissions-for-a-user
-			ro, err := database.FromContext(c).GetUser(r.GetUserID())
+			ro, err := database.FromContext(c).GetUser(c, r.GetUserID())
 			if err != nil {
 				retErr := fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
 
@@ -436,7 +603,7 @@ func MustWrite() gin.HandlerFunc {
 				return
 			}
 
-			perm, err = scm.FromContext(c).RepoAccess(u, ro.GetToken(), r.GetOrg(), r.GetName())
+			perm, err = scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, ro.GetToken(), r.GetOrg(), r.GetName())
 			if err != nil {
 				logger.Errorf("unable to get user %s access level for repo %s", u.GetName(), r.GetFullName())
 			}
@@ -474,6 +641,10 @@ func MustRead() gin.HandlerFunc {
 			"user": u.GetName(),
 		})
 
+		if rejectRepoTrigger(c, cl) {
+			return
+		}
+
 		// check if the repo visibility field is set to public
 		if strings.EqualFold(r.GetVisibility(), constants.VisibilityPublic) {
 			logger.Debugf("skipping 'read' check for repo %s with %s visibility for user %s", r.GetFullName(), r.GetVisibility(), u.GetName())
@@ -503,13 +674,13 @@ func MustRead() gin.HandlerFunc {
 		}
 
 		// query source to determine requesters permissions for the repo using the requester's token
-		perm, err := scm.FromContext(c).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
+		perm, err := scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, u.GetToken(), r.GetOrg(), r.GetName())
 		if err != nil {
 			// requester may not have permissions to use the Github API endpoint (requires read access)
 			// try again using the repo owner token
 			//
 			// https://docs.github.com/en/rest/reference/repos#get-repository-permissions-for-a-user
-			ro, err := database.FromContext(c).GetUser(r.GetUserID())
+			ro, err := database.FromContext(c).GetUser(c, r.GetUserID())
 			if err != nil {
 				retErr := fmt.Errorf("unable to get owner for %s: %w", r.GetFullName(), err)
 
@@ -518,7 +689,7 @@ func MustRead() gin.HandlerFunc {
 				return
 			}
 
-			perm, err = scm.FromContext(c).RepoAccess(u, ro.GetToken(), r.GetOrg(), r.GetName())
+			perm, err = scm.ForRepo(scm.FromContext(c), r).RepoAccess(u, ro.GetToken(), r.GetOrg(), r.GetName())
 			if err != nil {
 				logger.Errorf("unable to get user %s access level for repo %s", u.GetName(), r.GetFullName())
 			}
@@ -540,3 +711,23 @@ func MustRead() gin.HandlerFunc {
 		}
 	}
 }
+
+// rejectRepoTrigger is a helper function to reject repo trigger tokens
+// from endpoints they are not allowed to use. It returns true when
+// the request was rejected.
+func rejectRepoTrigger(c *gin.Context, cl *token.Claims) bool {
+	if !cl.IsRepoTrigger() {
+		return false
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"repo": cl.Repo,
+		"user": cl.Subject,
+	}).Warnf("attempted access of %s with repo trigger token from %s", c.FullPath(), cl.Subject)
+
+	retErr := fmt.Errorf("repo trigger token for %s may only be used to create or restart builds", cl.Repo)
+
+	util.HandleError(c, http.StatusUnauthorized, retErr)
+
+	return true
+}
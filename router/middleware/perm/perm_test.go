@@ -5,12 +5,14 @@
 package perm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/server/internal/token"
 	"github.com/go-vela/server/router/middleware/build"
 	"github.com/go-vela/server/router/middleware/claims"
@@ -63,7 +65,7 @@ func TestPerm_MustPlatformAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateUser(u)
+	_ = db.CreateUser(context.TODO(), u)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -149,7 +151,7 @@ func TestPerm_MustPlatformAdmin_NotAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateUser(u)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/admin/users", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -236,6 +238,55 @@ func TestPerm_MustWorkerRegisterToken(t *testing.T) {
 	}
 }
 
+func TestPerm_MustWorkerRegisterToken_Ephemeral(t *testing.T) {
+	// setup types
+	tm := &token.Manager{
+		PrivateKey:                  "123abc",
+		SignMethod:                  jwt.SigningMethodHS256,
+		UserAccessTokenDuration:     time.Minute * 5,
+		UserRefreshTokenDuration:    time.Minute * 30,
+		WorkerRegisterTokenDuration: time.Minute * 1,
+		WorkerAuthTokenDuration:     time.Minute * 15,
+	}
+
+	mto := &token.MintTokenOpts{
+		BuildID:       1,
+		Hostname:      "vela-ephemeral-1",
+		TokenDuration: tm.WorkerRegisterTokenDuration,
+		TokenType:     token.EphemeralRegisterTokenType,
+	}
+
+	tok, _ := tm.MintToken(mto)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+
+	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
+	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+
+	// setup vela mock server
+	engine.Use(func(c *gin.Context) { c.Set("token-manager", tm) })
+	engine.Use(claims.Establish())
+	engine.Use(user.Establish())
+	engine.Use(MustWorkerRegisterToken())
+	engine.GET("/test/:org/:repo", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	s1 := httptest.NewServer(engine)
+	defer s1.Close()
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("MustWorkerRegisterToken returned %v, want %v", resp.Code, http.StatusOK)
+	}
+}
+
 func TestPerm_MustWorkerRegisterToken_PlatAdmin(t *testing.T) {
 	tm := &token.Manager{
 		PrivateKey:               "123abc",
@@ -274,7 +325,7 @@ func TestPerm_MustWorkerRegisterToken_PlatAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateUser(u)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -441,8 +492,8 @@ func TestPerm_MustBuildAccess(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateBuild(context, b)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar/builds/1", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -529,9 +580,9 @@ func TestPerm_MustBuildAccess_PlatAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateBuild(context, b)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar/builds/1", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -612,8 +663,8 @@ func TestPerm_MustBuildToken_WrongBuild(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateBuild(context, b)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar/builds/1", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -694,8 +745,8 @@ func TestPerm_MustSecretAdmin_BuildToken_Repo(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateBuild(context, b)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/native/repo/foo/bar/baz", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -773,8 +824,8 @@ func TestPerm_MustSecretAdmin_BuildToken_Org(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateBuild(context, b)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/native/org/foo/*/baz", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -852,8 +903,8 @@ func TestPerm_MustSecretAdmin_BuildToken_Shared(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateBuild(context, b)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/native/shared/foo/*/*", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -880,6 +931,117 @@ func TestPerm_MustSecretAdmin_BuildToken_Shared(t *testing.T) {
 	}
 }
 
+func TestPerm_MustOrgAdmin(t *testing.T) {
+	// setup types
+	secret := "superSecret"
+
+	tm := &token.Manager{
+		PrivateKey:               "123abc",
+		SignMethod:               jwt.SigningMethodHS256,
+		UserAccessTokenDuration:  time.Minute * 5,
+		UserRefreshTokenDuration: time.Minute * 30,
+	}
+
+	u := new(library.User)
+	u.SetID(1)
+	u.SetName("foob")
+	u.SetToken("bar")
+	u.SetHash("baz")
+	u.SetAdmin(false)
+
+	mto := &token.MintTokenOpts{
+		User:          u,
+		TokenDuration: tm.UserAccessTokenDuration,
+		TokenType:     constants.UserAccessTokenType,
+	}
+
+	tok, _ := tm.MintToken(mto)
+
+	// setup tests
+	tests := []struct {
+		name string
+		org  string
+		want int
+	}{
+		{
+			name: "org admin",
+			org:  "admins",
+			want: http.StatusOK,
+		},
+		{
+			name: "org member",
+			org:  "members",
+			want: http.StatusUnauthorized,
+		},
+		{
+			name: "personal org",
+			org:  "foob",
+			want: http.StatusOK,
+		},
+	}
+
+	// setup database
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from users;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_ = db.CreateUser(context.TODO(), u)
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			context, engine := gin.CreateTestContext(resp)
+
+			context.Request, _ = http.NewRequest(http.MethodGet, "/test/"+test.org, nil)
+			context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+
+			// setup github mock server
+			engine.GET("/api/v3/orgs/:org/memberships/:username", func(c *gin.Context) {
+				role := "member"
+				if c.Param("org") == "admins" {
+					role = "admin"
+				}
+
+				c.String(http.StatusOK, fmt.Sprintf(`{"state": "active", "role": "%s"}`, role))
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			// setup client
+			client, _ := github.NewTest(s.URL)
+
+			// setup vela mock server
+			engine.Use(func(c *gin.Context) { c.Set("secret", secret) })
+			engine.Use(func(c *gin.Context) { c.Set("token-manager", tm) })
+			engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+			engine.Use(func(c *gin.Context) { scm.ToContext(c, client) })
+			engine.Use(claims.Establish())
+			engine.Use(user.Establish())
+			engine.Use(org.Establish())
+			engine.Use(MustOrgAdmin())
+			engine.GET("/test/:org", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			// run test
+			engine.ServeHTTP(context.Writer, context.Request)
+
+			if resp.Code != test.want {
+				t.Errorf("MustOrgAdmin returned %v, want %v", resp.Code, test.want)
+			}
+		})
+	}
+}
+
 func TestPerm_MustAdmin(t *testing.T) {
 	// setup types
 	secret := "superSecret"
@@ -931,8 +1093,8 @@ func TestPerm_MustAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1027,8 +1189,8 @@ func TestPerm_MustAdmin_PlatAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1123,8 +1285,8 @@ func TestPerm_MustAdmin_NotAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1219,8 +1381,8 @@ func TestPerm_MustWrite(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1315,8 +1477,8 @@ func TestPerm_MustWrite_PlatAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1411,8 +1573,8 @@ func TestPerm_MustWrite_RepoAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1507,8 +1669,8 @@ func TestPerm_MustWrite_NotWrite(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1603,8 +1765,8 @@ func TestPerm_MustRead(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1699,8 +1861,8 @@ func TestPerm_MustRead_PlatAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1795,8 +1957,8 @@ func TestPerm_MustRead_WorkerBuildToken(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateBuild(b)
-	_ = db.CreateRepo(r)
+	_ = db.CreateBuild(context, b)
+	_ = db.CreateRepo(context, r)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar/builds/1", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1877,8 +2039,8 @@ func TestPerm_MustRead_RepoAdmin(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -1973,8 +2135,8 @@ func TestPerm_MustRead_RepoWrite(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -2069,8 +2231,8 @@ func TestPerm_MustRead_RepoPublic(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -2165,8 +2327,8 @@ func TestPerm_MustRead_NotRead(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context, r)
+	_ = db.CreateUser(context, u)
 
 	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
 	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
@@ -2361,3 +2523,254 @@ const userPayload = `
   }
 }
 `
+
+func TestPerm_AllowRepoTrigger(t *testing.T) {
+	// setup types
+	secret := "superSecret"
+
+	tm := &token.Manager{
+		PrivateKey:               "123abc",
+		SignMethod:               jwt.SigningMethodHS256,
+		UserAccessTokenDuration:  time.Minute * 5,
+		UserRefreshTokenDuration: time.Minute * 30,
+	}
+
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetUserID(1)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("private")
+
+	u := new(library.User)
+	u.SetID(1)
+	u.SetName("octocat")
+	u.SetToken("bar")
+	u.SetHash("baz")
+	u.SetActive(true)
+
+	// setup tests
+	tests := []struct {
+		name    string
+		repo    string
+		stored  bool
+		payload string
+		role    string
+		want    int
+	}{
+		{
+			name:    "repo",
+			repo:    "foo/bar",
+			stored:  true,
+			payload: permAdminPayload,
+			role:    "admin",
+			want:    http.StatusOK,
+		},
+		{
+			name:    "org",
+			repo:    token.OrgTrigger("foo"),
+			stored:  true,
+			payload: permAdminPayload,
+			role:    "admin",
+			want:    http.StatusOK,
+		},
+		{
+			name:    "other repo",
+			repo:    "foo/baz",
+			stored:  true,
+			payload: permAdminPayload,
+			role:    "admin",
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name:    "revoked",
+			repo:    "foo/bar",
+			stored:  false,
+			payload: permAdminPayload,
+			role:    "admin",
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name:    "minter no longer admin",
+			repo:    "foo/bar",
+			stored:  true,
+			payload: permWritePayload,
+			role:    "admin",
+			want:    http.StatusUnauthorized,
+		},
+		{
+			name:    "minter no longer org admin",
+			repo:    token.OrgTrigger("foo"),
+			stored:  true,
+			payload: permAdminPayload,
+			role:    "member",
+			want:    http.StatusUnauthorized,
+		},
+	}
+
+	// setup database
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from repos;")
+		db.Sqlite.Exec("delete from users;")
+		db.Sqlite.Exec("delete from trigger_tokens;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateUser(context.TODO(), u)
+
+	// run tests
+	for i, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id := fmt.Sprintf("trigger-%d", i)
+
+			mto := &token.MintTokenOpts{
+				User:          u,
+				Repo:          test.repo,
+				TokenDuration: time.Minute * 35,
+				TokenID:       id,
+				TokenType:     token.RepoTriggerTokenType,
+			}
+
+			tok, _ := tm.MintToken(mto)
+
+			if test.stored {
+				tt := new(api.TriggerToken)
+				tt.SetRepo(test.repo)
+				tt.SetTokenID(id)
+				tt.SetCreatedAt(time.Now().UTC().Unix())
+				tt.SetCreatedBy(u.GetName())
+				tt.SetExpiresAt(time.Now().Add(time.Minute * 35).UTC().Unix())
+
+				err := db.CreateTriggerToken(context.TODO(), tt)
+				if err != nil {
+					t.Errorf("unable to create trigger token: %v", err)
+				}
+			}
+
+			// setup context
+			gin.SetMode(gin.TestMode)
+
+			resp := httptest.NewRecorder()
+			context, engine := gin.CreateTestContext(resp)
+
+			context.Request, _ = http.NewRequest(http.MethodPost, "/test/foo/bar", nil)
+			context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+
+			// setup github mock server
+			engine.GET("/api/v3/repos/:org/:repo/collaborators/:username/permission", func(c *gin.Context) {
+				c.String(http.StatusOK, test.payload)
+			})
+			engine.GET("/api/v3/orgs/:org/memberships/:username", func(c *gin.Context) {
+				c.String(http.StatusOK, fmt.Sprintf(`{"state": "active", "role": "%s"}`, test.role))
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			// setup client
+			client, _ := github.NewTest(s.URL)
+
+			// setup vela mock server
+			engine.Use(func(c *gin.Context) { c.Set("secret", secret) })
+			engine.Use(func(c *gin.Context) { c.Set("token-manager", tm) })
+			engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+			engine.Use(func(c *gin.Context) { scm.ToContext(c, client) })
+			engine.Use(claims.Establish())
+			engine.Use(user.Establish())
+			engine.Use(org.Establish())
+			engine.Use(repo.Establish())
+			engine.Use(AllowRepoTrigger(MustAdmin()))
+			engine.POST("/test/:org/:repo", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			// run test
+			engine.ServeHTTP(context.Writer, context.Request)
+
+			if resp.Code != test.want {
+				t.Errorf("AllowRepoTrigger returned %v, want %v", resp.Code, test.want)
+			}
+		})
+	}
+}
+
+func TestPerm_MustRead_RepoTrigger(t *testing.T) {
+	// setup types
+	secret := "superSecret"
+
+	tm := &token.Manager{
+		PrivateKey:               "123abc",
+		SignMethod:               jwt.SigningMethodHS256,
+		UserAccessTokenDuration:  time.Minute * 5,
+		UserRefreshTokenDuration: time.Minute * 30,
+	}
+
+	r := new(library.Repo)
+	r.SetID(1)
+	r.SetUserID(1)
+	r.SetHash("baz")
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetVisibility("public")
+
+	u := new(library.User)
+	u.SetID(1)
+	u.SetName("foo")
+
+	mto := &token.MintTokenOpts{
+		User:          u,
+		Repo:          "foo/bar",
+		TokenDuration: time.Minute * 35,
+		TokenID:       "trigger",
+		TokenType:     token.RepoTriggerTokenType,
+	}
+
+	tok, _ := tm.MintToken(mto)
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, engine := gin.CreateTestContext(resp)
+
+	// setup database
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from repos;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_ = db.CreateRepo(context, r)
+
+	context.Request, _ = http.NewRequest(http.MethodGet, "/test/foo/bar", nil)
+	context.Request.Header.Add("Authorization", fmt.Sprintf("Bearer %s", tok))
+
+	// setup vela mock server
+	engine.Use(func(c *gin.Context) { c.Set("secret", secret) })
+	engine.Use(func(c *gin.Context) { c.Set("token-manager", tm) })
+	engine.Use(func(c *gin.Context) { database.ToContext(c, db) })
+	engine.Use(claims.Establish())
+	engine.Use(user.Establish())
+	engine.Use(org.Establish())
+	engine.Use(repo.Establish())
+	engine.Use(MustRead())
+	engine.GET("/test/:org/:repo", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// run test
+	engine.ServeHTTP(context.Writer, context.Request)
+
+	if resp.Code != http.StatusUnauthorized {
+		t.Errorf("MustRead returned %v, want %v", resp.Code, http.StatusUnauthorized)
+	}
+}
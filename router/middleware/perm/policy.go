@@ -0,0 +1,448 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package perm
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/util"
+)
+
+type (
+	// Route represents the method and full path
+	// an API endpoint is registered with.
+	Route struct {
+		Method string
+		Path   string
+	}
+
+	// Policy represents the permission checks an API
+	// endpoint requires before its handler is run.
+	Policy []gin.HandlerFunc
+)
+
+var (
+	// Authenticated allows any authenticated user. Endpoints with this
+	// policy scope the resources they return to the user themselves.
+	Authenticated = Policy{}
+
+	// Read requires read access to the repo.
+	Read = Policy{MustRead()}
+
+	// Write requires write access to the repo.
+	Write = Policy{MustWrite()}
+
+	// Admin requires admin access to the repo.
+	Admin = Policy{MustAdmin()}
+
+	// TriggerWrite requires write access to the repo
+	// or a repo trigger token for the repo.
+	TriggerWrite = Policy{AllowRepoTrigger(MustWrite())}
+
+	// TriggerAdmin requires admin access to the repo
+	// or a repo trigger token for the repo.
+	TriggerAdmin = Policy{AllowRepoTrigger(MustAdmin())}
+
+	// OrgAdmin requires admin access to the org.
+	OrgAdmin = Policy{MustOrgAdmin()}
+
+	// SecretAdmin requires admin access to the org, repo
+	// or team the secret belongs to.
+	SecretAdmin = Policy{MustSecretAdmin()}
+
+	// PlatformAdmin requires admin access to the platform.
+	PlatformAdmin = Policy{MustPlatformAdmin()}
+
+	// BuildAccess requires a build token for the build.
+	BuildAccess = Policy{MustBuildAccess()}
+
+	// WorkerRegisterToken requires a worker registration token.
+	WorkerRegisterToken = Policy{MustWorkerRegisterToken()}
+
+	// WorkerAuthToken requires a worker auth token.
+	WorkerAuthToken = Policy{MustWorkerAuthToken()}
+)
+
+// Matrix represents the authorization policy for every API endpoint.
+// Endpoints missing from the matrix are denied by default, so a new
+// endpoint must be added here before it can be requested.
+//
+//nolint:lll // ignore long line length due to routes
+var Matrix = map[Route]Policy{
+	// Admin endpoints
+	{http.MethodGet, "/api/v1/admin/anomalies"}:                                          PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/anomalies"}:                                         PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/archived/repos"}:                                     PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/archived/repos/:org/:repo/restore"}:                 PlatformAdmin,
+	{http.MethodDelete, "/api/v1/admin/archived/repos/:org/:repo"}:                       PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/archived/secrets"}:                                   PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/archived/secrets/:type/:org/:name/:secret/restore"}: PlatformAdmin,
+	{http.MethodDelete, "/api/v1/admin/archived/secrets/:type/:org/:name/:secret"}:       PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/build"}:                                              PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/builds/queue"}:                                       PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/builds/:id/explain"}:                                 PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/canaries"}:                                           PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/canaries"}:                                          PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/capacity"}:                                           PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/catalog"}:                                           PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/deployment"}:                                         PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/diagnostics/bundle"}:                                 PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/diagnostics/pprof/:profile"}:                         PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/exports"}:                                            PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/exports"}:                                           PlatformAdmin,
+
+	// the fault endpoints only exist when built for testing
+	{http.MethodPut, "/api/v1/admin/fault"}:     PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/faults"}:    PlatformAdmin,
+	{http.MethodDelete, "/api/v1/admin/faults"}: PlatformAdmin,
+
+	{http.MethodGet, "/api/v1/admin/freezes"}:                         PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/freezes"}:                        PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/freezes/:freeze"}:                 PlatformAdmin,
+	{http.MethodDelete, "/api/v1/admin/freezes/:freeze"}:              PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/freezes/:freeze/audits"}:          PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/hook"}:                            PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/leases"}:                          PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/leaks"}:                           PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/leaks"}:                          PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/orghooks"}:                        PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/orghook"}:                         PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/queue"}:                           PlatformAdmin,
+	{http.MethodDelete, "/api/v1/admin/queue/routes/:route"}:          PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/queue/routes/:route/move"}:       PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/repo"}:                            PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/retention"}:                       PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/retention"}:                       PlatformAdmin,
+	{http.MethodGet, "/api/v1/admin/retention/repos/:org/:repo"}:      PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/retention/repos/:org/:repo"}:      PlatformAdmin,
+	{http.MethodDelete, "/api/v1/admin/retention/repos/:org/:repo"}:   PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/retention/sweep"}:                PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/secret"}:                          PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/service"}:                         PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/step"}:                            PlatformAdmin,
+	{http.MethodPut, "/api/v1/admin/user"}:                            PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/workers/ephemeral"}:              PlatformAdmin,
+	{http.MethodDelete, "/api/v1/admin/workers/ephemeral/:worker"}:    PlatformAdmin,
+	{http.MethodPost, "/api/v1/admin/workers/:worker/register-token"}: PlatformAdmin,
+
+	// Announcement endpoints
+	{http.MethodGet, "/api/v1/announcements"}:                  PlatformAdmin,
+	{http.MethodPost, "/api/v1/announcements"}:                 PlatformAdmin,
+	{http.MethodGet, "/api/v1/announcements/:announcement"}:    PlatformAdmin,
+	{http.MethodPut, "/api/v1/announcements/:announcement"}:    PlatformAdmin,
+	{http.MethodDelete, "/api/v1/announcements/:announcement"}: PlatformAdmin,
+
+	// Catalog endpoints
+	{http.MethodGet, "/api/v1/catalog/components"}:            PlatformAdmin,
+	{http.MethodGet, "/api/v1/catalog/components/:org/:repo"}: Read,
+
+	// Deployment endpoints
+	{http.MethodGet, "/api/v1/deployments/:org/:repo"}:                        Read,
+	{http.MethodPost, "/api/v1/deployments/:org/:repo"}:                       Write,
+	{http.MethodGet, "/api/v1/deployments/:org/:repo/:deployment"}:            Read,
+	{http.MethodGet, "/api/v1/deployments/:org/:repo/:deployment/provenance"}: Read,
+	{http.MethodGet, "/api/v1/deployments/:org/:repo/environments"}:           Read,
+
+	// Egress endpoints
+	{http.MethodGet, "/api/v1/egress/:org"}:          OrgAdmin,
+	{http.MethodPost, "/api/v1/egress/:org"}:         OrgAdmin,
+	{http.MethodGet, "/api/v1/egress/:org/:rule"}:    OrgAdmin,
+	{http.MethodPut, "/api/v1/egress/:org/:rule"}:    OrgAdmin,
+	{http.MethodDelete, "/api/v1/egress/:org/:rule"}: OrgAdmin,
+
+	// Freeze endpoints
+	{http.MethodGet, "/api/v1/freezes/:org"}:                Authenticated,
+	{http.MethodPost, "/api/v1/freezes/:org"}:               OrgAdmin,
+	{http.MethodGet, "/api/v1/freezes/:org/:freeze"}:        Authenticated,
+	{http.MethodDelete, "/api/v1/freezes/:org/:freeze"}:     OrgAdmin,
+	{http.MethodGet, "/api/v1/freezes/:org/:freeze/audits"}: OrgAdmin,
+
+	// Hook endpoints
+	{http.MethodGet, "/api/v1/hooks/:org/:repo"}:                  Read,
+	{http.MethodPost, "/api/v1/hooks/:org/:repo"}:                 PlatformAdmin,
+	{http.MethodGet, "/api/v1/hooks/:org/:repo/:hook"}:            Read,
+	{http.MethodPut, "/api/v1/hooks/:org/:repo/:hook"}:            PlatformAdmin,
+	{http.MethodDelete, "/api/v1/hooks/:org/:repo/:hook"}:         PlatformAdmin,
+	{http.MethodPost, "/api/v1/hooks/:org/:repo/:hook/redeliver"}: Write,
+
+	// Import endpoints
+	{http.MethodPost, "/api/v1/import/drone"}:   Authenticated,
+	{http.MethodPost, "/api/v1/import/jenkins"}: Authenticated,
+
+	// Deprecation endpoints
+	{http.MethodGet, "/api/v1/deprecations"}:                 PlatformAdmin,
+	{http.MethodPost, "/api/v1/deprecations"}:                PlatformAdmin,
+	{http.MethodGet, "/api/v1/deprecations/:deprecation"}:    PlatformAdmin,
+	{http.MethodPut, "/api/v1/deprecations/:deprecation"}:    PlatformAdmin,
+	{http.MethodDelete, "/api/v1/deprecations/:deprecation"}: PlatformAdmin,
+
+	// Mirror endpoints
+	{http.MethodGet, "/api/v1/mirrors"}:            PlatformAdmin,
+	{http.MethodPost, "/api/v1/mirrors"}:           PlatformAdmin,
+	{http.MethodGet, "/api/v1/mirrors/:mirror"}:    PlatformAdmin,
+	{http.MethodPut, "/api/v1/mirrors/:mirror"}:    PlatformAdmin,
+	{http.MethodDelete, "/api/v1/mirrors/:mirror"}: PlatformAdmin,
+
+	// Org hook endpoints
+	{http.MethodGet, "/api/v1/orghooks/:org"}:    OrgAdmin,
+	{http.MethodPost, "/api/v1/orghooks/:org"}:   OrgAdmin,
+	{http.MethodPut, "/api/v1/orghooks/:org"}:    OrgAdmin,
+	{http.MethodDelete, "/api/v1/orghooks/:org"}: OrgAdmin,
+
+	// Pipeline endpoints
+	{http.MethodGet, "/api/v1/pipelines/:org/:repo"}:                     Read,
+	{http.MethodPost, "/api/v1/pipelines/:org/:repo"}:                    Admin,
+	{http.MethodGet, "/api/v1/pipelines/:org/:repo/:pipeline"}:           Read,
+	{http.MethodPut, "/api/v1/pipelines/:org/:repo/:pipeline"}:           Write,
+	{http.MethodDelete, "/api/v1/pipelines/:org/:repo/:pipeline"}:        PlatformAdmin,
+	{http.MethodPost, "/api/v1/pipelines/:org/:repo/:pipeline/compile"}:  Read,
+	{http.MethodPost, "/api/v1/pipelines/:org/:repo/:pipeline/expand"}:   Read,
+	{http.MethodPost, "/api/v1/pipelines/:org/:repo/:pipeline/explain"}:  Read,
+	{http.MethodGet, "/api/v1/pipelines/:org/:repo/:pipeline/templates"}: Read,
+	{http.MethodPost, "/api/v1/pipelines/:org/:repo/:pipeline/validate"}: Read,
+
+	// Registry endpoints
+	{http.MethodGet, "/api/v1/registries/:org"}:              OrgAdmin,
+	{http.MethodPost, "/api/v1/registries/:org"}:             OrgAdmin,
+	{http.MethodGet, "/api/v1/registries/:org/:registry"}:    OrgAdmin,
+	{http.MethodPut, "/api/v1/registries/:org/:registry"}:    OrgAdmin,
+	{http.MethodDelete, "/api/v1/registries/:org/:registry"}: OrgAdmin,
+
+	// Required context endpoints
+	{http.MethodGet, "/api/v1/requiredcontexts/:org"}:                OrgAdmin,
+	{http.MethodPost, "/api/v1/requiredcontexts/:org"}:               OrgAdmin,
+	{http.MethodGet, "/api/v1/requiredcontexts/:org/:context"}:       OrgAdmin,
+	{http.MethodPut, "/api/v1/requiredcontexts/:org/:context"}:       OrgAdmin,
+	{http.MethodDelete, "/api/v1/requiredcontexts/:org/:context"}:    OrgAdmin,
+	{http.MethodPost, "/api/v1/requiredcontexts/:org/:context/sync"}: OrgAdmin,
+
+	// Repo endpoints
+	{http.MethodGet, "/api/v1/repos"}:                                                        Authenticated,
+	{http.MethodPost, "/api/v1/repos"}:                                                       Authenticated,
+	{http.MethodGet, "/api/v1/repos/:org"}:                                                   Authenticated,
+	{http.MethodGet, "/api/v1/repos/:org/:repo"}:                                             Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo"}:                                             Admin,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo"}:                                          Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds"}:                                      Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds"}:                                     TriggerAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build"}:                               Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build"}:                              TriggerWrite,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/builds/:build"}:                               BuildAccess,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/builds/:build"}:                            PlatformAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/artifacts"}:                     Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/artifacts"}:                    BuildAccess,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/builds/:build/cancel"}:                     Write,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/cost"}:                          Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/images"}:                        Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/deprecations"}:                  Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/labels"}:                        Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/leaks"}:                         Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/logs"}:                          Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/builds/:build/logs"}:                          BuildAccess,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/previews"}:                      Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/previews"}:                     BuildAccess,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/readiness"}:                     Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/redactions"}:                    Admin,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/redactions"}:                   Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/registry-credentials"}:          BuildAccess,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/report"}:                        Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/skips"}:                         Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/services"}:                      Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/services"}:                     PlatformAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/services/:service"}:             Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/builds/:build/services/:service"}:             BuildAccess,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/builds/:build/services/:service"}:          PlatformAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/services/:service/logs"}:        Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/services/:service/logs"}:       Admin,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/builds/:build/services/:service/logs"}:        BuildAccess,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/builds/:build/services/:service/logs"}:     PlatformAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/services/:service/logs/lines"}:  Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/services/:service/logs/lines"}: BuildAccess,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/services/:service/logs/render"}: Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/services/:service/readiness"}:   Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/builds/:build/services/:service/readiness"}:   BuildAccess,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/steps"}:                         Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/steps"}:                        PlatformAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/steps/:step"}:                   Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/builds/:build/steps/:step"}:                   BuildAccess,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/builds/:build/steps/:step"}:                PlatformAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts"}:          Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts"}:         BuildAccess,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs"}:              Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs"}:             Admin,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs"}:              BuildAccess,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs"}:           PlatformAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/lines"}:        Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/lines"}:       BuildAccess,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/steps/:step/logs/render"}:       Read,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/builds/:build/token"}:                         WorkerAuthToken,
+	{http.MethodPatch, "/api/v1/repos/:org/:repo/chown"}:                                     Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/clone"}:                                       Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/clone"}:                                       Admin,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/clone"}:                                    Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/previews"}:                                    Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/previews/:preview"}:                           Write,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/provenance/artifacts/:digest"}:                Read,
+	{http.MethodPatch, "/api/v1/repos/:org/:repo/repair"}:                                    Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/sync"}:                                        Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/trigger-token"}:                              Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/trigger-tokens"}:                              Admin,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/trigger-tokens/:token"}:                    Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/variables"}:                                   Read,
+	{http.MethodPost, "/api/v1/repos/:org/:repo/variables"}:                                  Admin,
+	{http.MethodGet, "/api/v1/repos/:org/:repo/variables/:variable"}:                         Read,
+	{http.MethodPut, "/api/v1/repos/:org/:repo/variables/:variable"}:                         Admin,
+	{http.MethodDelete, "/api/v1/repos/:org/:repo/variables/:variable"}:                      Admin,
+	{http.MethodGet, "/api/v1/repos/:org/builds"}:                                            Authenticated,
+	{http.MethodGet, "/api/v1/repos/:org/clone"}:                                             Authenticated,
+	{http.MethodPut, "/api/v1/repos/:org/clone"}:                                             OrgAdmin,
+	{http.MethodDelete, "/api/v1/repos/:org/clone"}:                                          OrgAdmin,
+	{http.MethodPost, "/api/v1/repos/:org/sync"}:                                             OrgAdmin,
+	{http.MethodPost, "/api/v1/repos/:org/trigger-token"}:                                    OrgAdmin,
+	{http.MethodGet, "/api/v1/repos/:org/trigger-tokens"}:                                    OrgAdmin,
+	{http.MethodDelete, "/api/v1/repos/:org/trigger-tokens/:token"}:                          OrgAdmin,
+
+	// Schedule endpoints
+	{http.MethodGet, "/api/v1/schedules/:org/:repo"}:                Read,
+	{http.MethodPost, "/api/v1/schedules/:org/:repo"}:               Admin,
+	{http.MethodGet, "/api/v1/schedules/:org/:repo/:schedule"}:      Read,
+	{http.MethodPut, "/api/v1/schedules/:org/:repo/:schedule"}:      Admin,
+	{http.MethodDelete, "/api/v1/schedules/:org/:repo/:schedule"}:   Admin,
+	{http.MethodGet, "/api/v1/schedules/:org/:repo/:schedule/runs"}: Read,
+
+	// Source code management endpoints
+	{http.MethodGet, "/api/v1/scm/orgs/:org/sync"}:        Authenticated,
+	{http.MethodGet, "/api/v1/scm/repos/:org/:repo/sync"}: Authenticated,
+
+	// Search endpoints
+	{http.MethodGet, "/api/v1/search"}:            Authenticated,
+	{http.MethodGet, "/api/v1/search/builds/:id"}: Authenticated,
+
+	// Secret endpoints
+	{http.MethodGet, "/api/v1/secrets/:engine/:type/:org/:name"}:            SecretAdmin,
+	{http.MethodPost, "/api/v1/secrets/:engine/:type/:org/:name"}:           SecretAdmin,
+	{http.MethodGet, "/api/v1/secrets/:engine/:type/:org/:name/*secret"}:    SecretAdmin,
+	{http.MethodPut, "/api/v1/secrets/:engine/:type/:org/:name/*secret"}:    SecretAdmin,
+	{http.MethodDelete, "/api/v1/secrets/:engine/:type/:org/:name/*secret"}: SecretAdmin,
+
+	// Template endpoints
+	{http.MethodGet, "/api/v1/templates/:org/:repo/deprecations"}:                 Read,
+	{http.MethodPost, "/api/v1/templates/:org/:repo/deprecations"}:                Admin,
+	{http.MethodDelete, "/api/v1/templates/:org/:repo/deprecations/:deprecation"}: Admin,
+
+	// Usage endpoints
+	{http.MethodGet, "/api/v1/usage/orgs/:org/actors"}:      Authenticated,
+	{http.MethodGet, "/api/v1/usage/orgs/:org/budget"}:      Authenticated,
+	{http.MethodPut, "/api/v1/usage/orgs/:org/budget"}:      PlatformAdmin,
+	{http.MethodDelete, "/api/v1/usage/orgs/:org/budget"}:   PlatformAdmin,
+	{http.MethodGet, "/api/v1/usage/orgs/:org/costs"}:       Authenticated,
+	{http.MethodGet, "/api/v1/usage/orgs/:org/teams/:team"}: Authenticated,
+	{http.MethodGet, "/api/v1/usage/rates"}:                 Authenticated,
+	{http.MethodPut, "/api/v1/usage/rates/:class"}:          PlatformAdmin,
+	{http.MethodDelete, "/api/v1/usage/rates/:class"}:       PlatformAdmin,
+
+	// Current user endpoints
+	{http.MethodGet, "/api/v1/user"}:               Authenticated,
+	{http.MethodPut, "/api/v1/user"}:               Authenticated,
+	{http.MethodGet, "/api/v1/user/announcements"}: Authenticated,
+	{http.MethodGet, "/api/v1/user/scopes"}:        Authenticated,
+	{http.MethodGet, "/api/v1/user/sessions"}:      Authenticated,
+	{http.MethodGet, "/api/v1/user/source/repos"}:  Authenticated,
+	{http.MethodPost, "/api/v1/user/token"}:        Authenticated,
+	{http.MethodDelete, "/api/v1/user/token"}:      Authenticated,
+
+	// User endpoints
+	{http.MethodGet, "/api/v1/users"}:                Authenticated,
+	{http.MethodPost, "/api/v1/users"}:               PlatformAdmin,
+	{http.MethodGet, "/api/v1/users/:user"}:          PlatformAdmin,
+	{http.MethodPut, "/api/v1/users/:user"}:          PlatformAdmin,
+	{http.MethodDelete, "/api/v1/users/:user"}:       PlatformAdmin,
+	{http.MethodGet, "/api/v1/users/:user/sessions"}: PlatformAdmin,
+
+	// Worker group endpoints
+	{http.MethodGet, "/api/v1/workergroups"}:           Authenticated,
+	{http.MethodPost, "/api/v1/workergroups"}:          PlatformAdmin,
+	{http.MethodGet, "/api/v1/workergroups/:group"}:    Authenticated,
+	{http.MethodPut, "/api/v1/workergroups/:group"}:    PlatformAdmin,
+	{http.MethodDelete, "/api/v1/workergroups/:group"}: PlatformAdmin,
+
+	// Worker endpoints
+	{http.MethodGet, "/api/v1/workers"}:                  Authenticated,
+	{http.MethodPost, "/api/v1/workers"}:                 WorkerRegisterToken,
+	{http.MethodGet, "/api/v1/workers/:worker"}:          Authenticated,
+	{http.MethodPut, "/api/v1/workers/:worker"}:          PlatformAdmin,
+	{http.MethodDelete, "/api/v1/workers/:worker"}:       PlatformAdmin,
+	{http.MethodPost, "/api/v1/workers/:worker/refresh"}: WorkerAuthToken,
+}
+
+// enforcer represents the name of the handler
+// returned by Enforce used to detect endpoints
+// that don't enforce their authorization policy.
+var enforcer = handlerName(Enforce())
+
+// Enforce ensures the request satisfies the authorization policy
+// in the matrix for the endpoint. The handler must be registered
+// after the middleware establishing the resources for the endpoint
+// since the permission checks for the policy depend on them.
+func Enforce() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy, ok := Matrix[Route{Method: c.Request.Method, Path: c.FullPath()}]
+		if !ok {
+			retErr := fmt.Errorf("no authorization policy for %s %s", c.Request.Method, c.FullPath())
+
+			util.HandleError(c, http.StatusForbidden, retErr)
+
+			return
+		}
+
+		for _, check := range policy {
+			check(c)
+
+			// stop at the first permission check that fails
+			if c.IsAborted() {
+				return
+			}
+		}
+	}
+}
+
+// DenyByDefault ensures the endpoint for the request enforces
+// its authorization policy. Requests to endpoints missing the
+// Enforce handler, or missing from the matrix, are denied.
+func DenyByDefault() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		_, ok := Matrix[Route{Method: c.Request.Method, Path: c.FullPath()}]
+
+		if ok && enforces(c.HandlerNames()) {
+			return
+		}
+
+		retErr := fmt.Errorf("no authorization policy enforced for %s %s", c.Request.Method, c.FullPath())
+
+		util.HandleError(c, http.StatusForbidden, retErr)
+	}
+}
+
+// enforces is a helper function to determine if
+// the provided handlers include the Enforce handler.
+func enforces(handlers []string) bool {
+	for _, h := range handlers {
+		if h == enforcer {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handlerName is a helper function to capture
+// the name of the function for the handler.
+func handlerName(h gin.HandlerFunc) string {
+	return runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name()
+}
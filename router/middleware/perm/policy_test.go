@@ -0,0 +1,152 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package perm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestPerm_Enforce(t *testing.T) {
+	// setup types
+	checked := 0
+
+	allow := func(c *gin.Context) { checked++ }
+	deny := func(c *gin.Context) { c.AbortWithStatus(http.StatusUnauthorized) }
+
+	Matrix[Route{http.MethodGet, "/test/allow"}] = Policy{allow, allow}
+	Matrix[Route{http.MethodGet, "/test/deny"}] = Policy{deny, allow}
+
+	defer func() {
+		delete(Matrix, Route{http.MethodGet, "/test/allow"})
+		delete(Matrix, Route{http.MethodGet, "/test/deny"})
+	}()
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	engine.Use(Enforce())
+
+	for _, path := range []string{"/test/allow", "/test/deny", "/test/missing"} {
+		engine.GET(path, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		path    string
+		want    int
+		checked int
+	}{
+		{
+			name:    "policy satisfied",
+			path:    "/test/allow",
+			want:    http.StatusOK,
+			checked: 2,
+		},
+		{
+			name:    "policy not satisfied",
+			path:    "/test/deny",
+			want:    http.StatusUnauthorized,
+			checked: 0,
+		},
+		{
+			name:    "missing policy",
+			path:    "/test/missing",
+			want:    http.StatusForbidden,
+			checked: 0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checked = 0
+			resp := httptest.NewRecorder()
+
+			req, _ := http.NewRequest(http.MethodGet, test.path, nil)
+
+			engine.ServeHTTP(resp, req)
+
+			if resp.Code != test.want {
+				t.Errorf("Enforce returned %v, want %v", resp.Code, test.want)
+			}
+
+			if checked != test.checked {
+				t.Errorf("Enforce ran %d checks, want %d", checked, test.checked)
+			}
+		})
+	}
+}
+
+func TestPerm_DenyByDefault(t *testing.T) {
+	// setup types
+	Matrix[Route{http.MethodGet, "/test/enforced"}] = Authenticated
+	Matrix[Route{http.MethodGet, "/test/forgotten"}] = Authenticated
+
+	defer func() {
+		delete(Matrix, Route{http.MethodGet, "/test/enforced"})
+		delete(Matrix, Route{http.MethodGet, "/test/forgotten"})
+	}()
+
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	handler := func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	}
+
+	engine.Use(DenyByDefault())
+	engine.GET("/test/enforced", Enforce(), handler)
+	engine.GET("/test/forgotten", handler)
+	engine.GET("/test/missing", Enforce(), handler)
+
+	// setup tests
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{
+			name: "enforced",
+			path: "/test/enforced",
+			want: http.StatusOK,
+		},
+		{
+			name: "enforce handler forgotten",
+			path: "/test/forgotten",
+			want: http.StatusForbidden,
+		},
+		{
+			name: "missing policy",
+			path: "/test/missing",
+			want: http.StatusForbidden,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := httptest.NewRecorder()
+
+			req, _ := http.NewRequest(http.MethodGet, test.path, nil)
+
+			engine.ServeHTTP(resp, req)
+
+			if resp.Code != test.want {
+				t.Errorf("DenyByDefault returned %v, want %v", resp.Code, test.want)
+			}
+		})
+	}
+}
@@ -62,7 +62,7 @@ func Establish() gin.HandlerFunc {
 			"user":     u.GetName(),
 		}).Debugf("reading pipeline %s", entry)
 
-		pipeline, err := database.FromContext(c).GetPipelineForRepo(p, r)
+		pipeline, err := database.FromContext(c).GetPipelineForRepo(c, p, r)
 		if err != nil { // assume the pipeline doesn't exist in the database yet (before pipeline support was added)
 			// send API call to capture the pipeline configuration file
 			config, err := scm.FromContext(c).ConfigBackoff(u, r, p)
@@ -77,6 +77,7 @@ func Establish() gin.HandlerFunc {
 			// parse and compile the pipeline configuration file
 			_, pipeline, err = compiler.FromContext(c).
 				Duplicate().
+				WithContext(c.Request.Context()).
 				WithMetadata(c.MustGet("metadata").(*types.Metadata)).
 				WithRepo(r).
 				WithUser(u).
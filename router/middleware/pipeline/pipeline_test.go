@@ -5,6 +5,7 @@
 package pipeline
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
@@ -106,8 +107,8 @@ func TestPipeline_Establish(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreatePipeline(want)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreatePipeline(context.TODO(), want)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -183,7 +184,7 @@ func TestPipeline_Establish_NoPipelineParameter(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
+	_ = db.CreateRepo(context.TODO(), r)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -285,8 +286,8 @@ func TestPipeline_Establish_NoPipeline(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateUser(u)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateUser(context.TODO(), u)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,19 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// PipelinePreview is a middleware function that attaches whether pipeline
+// changes in pull requests are previewed in a comment to the context
+// of every http.Request.
+func PipelinePreview(enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set("pipelinePreview", enabled)
+		c.Next()
+	}
+}
@@ -5,6 +5,7 @@
 package repo
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -74,7 +75,7 @@ func TestRepo_Establish(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(want)
+	_ = db.CreateRepo(context.TODO(), want)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,19 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/retention"
+)
+
+// Retention is a middleware function that initializes the
+// retention sweeper and attaches to the context of every http.Request.
+func Retention(s *retention.Sweeper) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		retention.ToContext(c, s)
+		c.Next()
+	}
+}
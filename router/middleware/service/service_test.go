@@ -5,6 +5,7 @@
 package service
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -84,9 +85,9 @@ func TestService_Establish(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
-	_ = db.CreateService(want)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
+	_ = db.CreateService(context.TODO(), want)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -167,7 +168,7 @@ func TestService_Establish_NoBuild(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
+	_ = db.CreateRepo(context.TODO(), r)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -219,8 +220,8 @@ func TestService_Establish_NoServiceParameter(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -273,8 +274,8 @@ func TestService_Establish_InvalidServiceParameter(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -327,8 +328,8 @@ func TestService_Establish_NoService(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
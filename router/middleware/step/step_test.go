@@ -5,6 +5,7 @@
 package step
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -86,9 +87,9 @@ func TestStep_Establish(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
-	_ = db.CreateStep(want)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
+	_ = db.CreateStep(context.TODO(), want)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -169,7 +170,7 @@ func TestStep_Establish_NoBuild(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
+	_ = db.CreateRepo(context.TODO(), r)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -221,8 +222,8 @@ func TestStep_Establish_NoStepParameter(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -275,8 +276,8 @@ func TestStep_Establish_InvalidStepParameter(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -329,8 +330,8 @@ func TestStep_Establish_NoStep(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateRepo(r)
-	_ = db.CreateBuild(b)
+	_ = db.CreateRepo(context.TODO(), r)
+	_ = db.CreateBuild(context.TODO(), b)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
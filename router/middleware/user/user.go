@@ -42,7 +42,7 @@ func Establish() gin.HandlerFunc {
 		logrus.Debugf("parsing user access token")
 
 		// lookup user in claims subject in the database
-		u, err := database.FromContext(c).GetUserForName(cl.Subject)
+		u, err := database.FromContext(c).GetUserForName(c, cl.Subject)
 		if err != nil {
 			util.HandleError(c, http.StatusUnauthorized, err)
 			return
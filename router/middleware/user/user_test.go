@@ -97,7 +97,7 @@ func TestUser_Establish(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateUser(want)
+	_ = db.CreateUser(context, want)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,19 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/scm/verify"
+)
+
+// Verifier is a middleware function that initializes the
+// verifier and attaches to the context of every http.Request.
+func Verifier(v *verify.Verifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		verify.ToContext(c, v)
+		c.Next()
+	}
+}
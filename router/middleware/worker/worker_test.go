@@ -5,6 +5,7 @@
 package worker
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -56,7 +57,7 @@ func TestWorker_Establish(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateWorker(want)
+	_ = db.CreateWorker(context.TODO(), want)
 
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/mirror"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// MirrorHandlers is a function that extends the provided base router group
+// with the API handlers for registry mirror functionality.
+//
+// POST   /api/v1/mirrors
+// GET    /api/v1/mirrors
+// GET    /api/v1/mirrors/:mirror
+// PUT    /api/v1/mirrors/:mirror
+// DELETE /api/v1/mirrors/:mirror .
+func MirrorHandlers(base *gin.RouterGroup) {
+	// Mirrors endpoints
+	_mirrors := base.Group("/mirrors", perm.Enforce())
+	{
+		_mirrors.POST("", middleware.Payload(), mirror.CreateRegistryMirror)
+		_mirrors.GET("", mirror.ListRegistryMirrors)
+		_mirrors.GET("/:mirror", mirror.GetRegistryMirror)
+		_mirrors.PUT("/:mirror", middleware.Payload(), mirror.UpdateRegistryMirror)
+		_mirrors.DELETE("/:mirror", mirror.DeleteRegistryMirror)
+	} // end of mirrors endpoints
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/orghook"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// OrgHookHandlers is a function that extends the provided base router group
+// with the API handlers for org webhook functionality.
+//
+// POST   /api/v1/orghooks/:org
+// GET    /api/v1/orghooks/:org
+// PUT    /api/v1/orghooks/:org
+// DELETE /api/v1/orghooks/:org .
+func OrgHookHandlers(base *gin.RouterGroup) {
+	// Org hooks endpoints
+	_orghooks := base.Group("/orghooks/:org", org.Establish(), perm.Enforce())
+	{
+		_orghooks.POST("", middleware.Payload(), orghook.CreateOrgHook)
+		_orghooks.GET("", orghook.GetOrgHook)
+		_orghooks.PUT("", middleware.Payload(), orghook.UpdateOrgHook)
+		_orghooks.DELETE("", orghook.DeleteOrgHook)
+	} // end of org hooks endpoints
+}
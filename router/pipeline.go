@@ -23,24 +23,26 @@ import (
 // DELETE /api/v1/pipelines/:org/:repo/:pipeline
 // GET    /api/v1/pipelines/:org/:repo/:pipeline/templates
 // POST   /api/v1/pipelines/:org/:repo/:pipeline/expand
+// POST   /api/v1/pipelines/:org/:repo/:pipeline/explain
 // POST   /api/v1/pipelines/:org/:repo/:pipeline/compile
 // POST   /api/v1/pipelines/:org/:repo/:pipeline/validate .
 func PipelineHandlers(base *gin.RouterGroup) {
 	// Pipelines endpoints
 	_pipelines := base.Group("pipelines/:org/:repo", org.Establish(), repo.Establish())
 	{
-		_pipelines.POST("", perm.MustAdmin(), pipeline.CreatePipeline)
-		_pipelines.GET("", perm.MustRead(), pipeline.ListPipelines)
+		_pipelines.POST("", perm.Enforce(), pipeline.CreatePipeline)
+		_pipelines.GET("", perm.Enforce(), pipeline.ListPipelines)
 
 		_pipeline := _pipelines.Group("/:pipeline", pmiddleware.Establish())
 		{
-			_pipeline.GET("", perm.MustRead(), pipeline.GetPipeline)
-			_pipeline.PUT("", perm.MustWrite(), pipeline.UpdatePipeline)
-			_pipeline.DELETE("", perm.MustPlatformAdmin(), pipeline.DeletePipeline)
-			_pipeline.GET("/templates", perm.MustRead(), pipeline.GetTemplates)
-			_pipeline.POST("/compile", perm.MustRead(), pipeline.CompilePipeline)
-			_pipeline.POST("/expand", perm.MustRead(), pipeline.ExpandPipeline)
-			_pipeline.POST("/validate", perm.MustRead(), pipeline.ValidatePipeline)
+			_pipeline.GET("", perm.Enforce(), pipeline.GetPipeline)
+			_pipeline.PUT("", perm.Enforce(), pipeline.UpdatePipeline)
+			_pipeline.DELETE("", perm.Enforce(), pipeline.DeletePipeline)
+			_pipeline.GET("/templates", perm.Enforce(), pipeline.GetTemplates)
+			_pipeline.POST("/compile", perm.Enforce(), pipeline.CompilePipeline)
+			_pipeline.POST("/expand", perm.Enforce(), pipeline.ExpandPipeline)
+			_pipeline.POST("/explain", perm.Enforce(), pipeline.ExplainPipeline)
+			_pipeline.POST("/validate", perm.Enforce(), pipeline.ValidatePipeline)
 		} // end of pipeline endpoints
 	} // end of pipelines endpoints
 }
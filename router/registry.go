@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/registry"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// RegistryHandlers is a function that extends the provided base router group
+// with the API handlers for image registry credential functionality.
+//
+// POST   /api/v1/registries/:org
+// GET    /api/v1/registries/:org
+// GET    /api/v1/registries/:org/:registry
+// PUT    /api/v1/registries/:org/:registry
+// DELETE /api/v1/registries/:org/:registry .
+func RegistryHandlers(base *gin.RouterGroup) {
+	// Registries endpoints
+	_registries := base.Group("/registries/:org", org.Establish(), perm.Enforce())
+	{
+		_registries.POST("", middleware.Payload(), registry.CreateRegistryCredential)
+		_registries.GET("", registry.ListRegistryCredentials)
+		_registries.GET("/:registry", registry.GetRegistryCredential)
+		_registries.PUT("/:registry", middleware.Payload(), registry.UpdateRegistryCredential)
+		_registries.DELETE("/:registry", registry.DeleteRegistryCredential)
+	} // end of registries endpoints
+}
@@ -21,11 +21,33 @@ import (
 // GET    /api/v1/repos
 // GET    /api/v1/repos/:org
 // GET    /api/v1/repos/:org/builds
+// POST   /api/v1/repos/:org/sync
+// POST   /api/v1/repos/:org/trigger-token
+// GET    /api/v1/repos/:org/trigger-tokens
+// DELETE /api/v1/repos/:org/trigger-tokens/:token
+// GET    /api/v1/repos/:org/clone
+// PUT    /api/v1/repos/:org/clone
+// DELETE /api/v1/repos/:org/clone
 // GET    /api/v1/repos/:org/:repo
 // PUT    /api/v1/repos/:org/:repo
 // DELETE /api/v1/repos/:org/:repo
 // PATCH  /api/v1/repos/:org/:repo/repair
 // PATCH  /api/v1/repos/:org/:repo/chown
+// GET    /api/v1/repos/:org/:repo/clone
+// PUT    /api/v1/repos/:org/:repo/clone
+// DELETE /api/v1/repos/:org/:repo/clone
+// GET    /api/v1/repos/:org/:repo/sync
+// GET    /api/v1/repos/:org/:repo/previews
+// PUT    /api/v1/repos/:org/:repo/previews/:preview
+// GET    /api/v1/repos/:org/:repo/provenance/artifacts/:digest
+// POST   /api/v1/repos/:org/:repo/trigger-token
+// GET    /api/v1/repos/:org/:repo/trigger-tokens
+// DELETE /api/v1/repos/:org/:repo/trigger-tokens/:token
+// POST   /api/v1/repos/:org/:repo/variables
+// GET    /api/v1/repos/:org/:repo/variables
+// GET    /api/v1/repos/:org/:repo/variables/:variable
+// PUT    /api/v1/repos/:org/:repo/variables/:variable
+// DELETE /api/v1/repos/:org/:repo/variables/:variable
 // POST   /api/v1/repos/:org/:repo/builds
 // GET    /api/v1/repos/:org/:repo/builds
 // POST   /api/v1/repos/:org/:repo/builds/:build
@@ -33,6 +55,7 @@ import (
 // PUT    /api/v1/repos/:org/:repo/builds/:build
 // DELETE /api/v1/repos/:org/:repo/builds/:build
 // GET    /api/v1/repos/:org/:repo/builds/:build/logs
+// PUT    /api/v1/repos/:org/:repo/builds/:build/logs
 // POST   /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service
@@ -55,23 +78,45 @@ func RepoHandlers(base *gin.RouterGroup) {
 	// Repos endpoints
 	_repos := base.Group("/repos")
 	{
-		_repos.POST("", middleware.Payload(), repo.CreateRepo)
-		_repos.GET("", repo.ListRepos)
+		_repos.POST("", perm.Enforce(), middleware.Payload(), repo.CreateRepo)
+		_repos.GET("", perm.Enforce(), repo.ListRepos)
 
 		// Org endpoints
 		org := _repos.Group("/:org", org.Establish())
 		{
-			org.GET("", repo.ListReposForOrg)
-			org.GET("/builds", api.GetOrgBuilds)
+			org.GET("", perm.Enforce(), repo.ListReposForOrg)
+			org.GET("/builds", perm.Enforce(), api.GetOrgBuilds)
+			org.POST("/sync", perm.Enforce(), repo.SyncReposForOrg)
+			org.POST("/trigger-token", perm.Enforce(), repo.CreateOrgTriggerToken)
+			org.GET("/trigger-tokens", perm.Enforce(), repo.ListOrgTriggerTokens)
+			org.DELETE("/trigger-tokens/:token", perm.Enforce(), repo.DeleteOrgTriggerToken)
+			org.GET("/clone", perm.Enforce(), repo.GetOrgCloneSetting)
+			org.PUT("/clone", perm.Enforce(), middleware.Payload(), repo.UpdateOrgCloneSetting)
+			org.DELETE("/clone", perm.Enforce(), repo.DeleteOrgCloneSetting)
 
 			// Repo endpoints
 			_repo := org.Group("/:repo", rmiddleware.Establish())
 			{
-				_repo.GET("", perm.MustRead(), repo.GetRepo)
-				_repo.PUT("", perm.MustAdmin(), middleware.Payload(), repo.UpdateRepo)
-				_repo.DELETE("", perm.MustAdmin(), repo.DeleteRepo)
-				_repo.PATCH("/repair", perm.MustAdmin(), repo.RepairRepo)
-				_repo.PATCH("/chown", perm.MustAdmin(), repo.ChownRepo)
+				_repo.GET("", perm.Enforce(), repo.GetRepo)
+				_repo.PUT("", perm.Enforce(), middleware.Payload(), repo.UpdateRepo)
+				_repo.DELETE("", perm.Enforce(), repo.DeleteRepo)
+				_repo.PATCH("/repair", perm.Enforce(), repo.RepairRepo)
+				_repo.PATCH("/chown", perm.Enforce(), repo.ChownRepo)
+				_repo.GET("/clone", perm.Enforce(), repo.GetCloneSetting)
+				_repo.PUT("/clone", perm.Enforce(), middleware.Payload(), repo.UpdateCloneSetting)
+				_repo.DELETE("/clone", perm.Enforce(), repo.DeleteCloneSetting)
+				_repo.GET("/sync", perm.Enforce(), repo.GetRepoSync)
+				_repo.GET("/previews", perm.Enforce(), api.ListPreviewEnvironments)
+				_repo.PUT("/previews/:preview", perm.Enforce(), middleware.Payload(), api.UpdatePreviewEnvironment)
+				_repo.GET("/provenance/artifacts/:digest", perm.Enforce(), api.GetArtifactProvenance)
+				_repo.POST("/trigger-token", perm.Enforce(), repo.CreateRepoTriggerToken)
+				_repo.GET("/trigger-tokens", perm.Enforce(), repo.ListRepoTriggerTokens)
+				_repo.DELETE("/trigger-tokens/:token", perm.Enforce(), repo.DeleteRepoTriggerToken)
+				_repo.POST("/variables", perm.Enforce(), middleware.Payload(), repo.CreateRepoVariable)
+				_repo.GET("/variables", perm.Enforce(), repo.ListRepoVariables)
+				_repo.GET("/variables/:variable", perm.Enforce(), repo.GetRepoVariable)
+				_repo.PUT("/variables/:variable", perm.Enforce(), middleware.Payload(), repo.UpdateRepoVariable)
+				_repo.DELETE("/variables/:variable", perm.Enforce(), repo.DeleteRepoVariable)
 
 				// Build endpoints
 				// * Service endpoints
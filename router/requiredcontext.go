@@ -0,0 +1,35 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/requiredcontext"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// RequiredContextHandlers is a function that extends the provided base router group
+// with the API handlers for required context functionality.
+//
+// POST   /api/v1/requiredcontexts/:org
+// GET    /api/v1/requiredcontexts/:org
+// GET    /api/v1/requiredcontexts/:org/:context
+// PUT    /api/v1/requiredcontexts/:org/:context
+// DELETE /api/v1/requiredcontexts/:org/:context
+// POST   /api/v1/requiredcontexts/:org/:context/sync .
+func RequiredContextHandlers(base *gin.RouterGroup) {
+	// Required context endpoints
+	_contexts := base.Group("/requiredcontexts/:org", org.Establish(), perm.Enforce())
+	{
+		_contexts.POST("", middleware.Payload(), requiredcontext.CreateRequiredContext)
+		_contexts.GET("", requiredcontext.ListRequiredContexts)
+		_contexts.GET("/:context", requiredcontext.GetRequiredContext)
+		_contexts.PUT("/:context", middleware.Payload(), requiredcontext.UpdateRequiredContext)
+		_contexts.DELETE("/:context", requiredcontext.DeleteRequiredContext)
+		_contexts.POST("/:context/sync", requiredcontext.SyncRequiredContext)
+	} // end of required context endpoints
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/go-vela/server/router/middleware"
 	"github.com/go-vela/server/router/middleware/claims"
 	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
 	"github.com/go-vela/server/router/middleware/repo"
 	"github.com/go-vela/server/router/middleware/user"
 
@@ -52,6 +53,10 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 	r := gin.New()
 	r.Use(gin.Recovery())
 
+	// pass the request context through the gin context
+	// so database calls are cancelled with the request
+	r.ContextWithFallback = true
+
 	r.Use(options...)
 	r.Use(middleware.RequestVersion)
 	r.Use(middleware.NoCache)
@@ -61,6 +66,7 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 
 	// Badge endpoint
 	r.GET("/badge/:org/:repo/status.svg", org.Establish(), repo.Establish(), api.GetBadge)
+	r.GET("/badge/:org/:repo/deploy.svg", org.Establish(), repo.Establish(), api.GetDeployBadge)
 
 	// Health endpoint
 	r.GET("/health", api.Health)
@@ -75,7 +81,7 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 	r.GET("/token-refresh", api.RefreshAccessToken)
 
 	// Metric endpoint
-	r.GET("/metrics", api.CustomMetrics, gin.WrapH(api.BaseMetrics()))
+	r.GET("/metrics", api.AuthorizeMetrics, api.CustomMetrics, gin.WrapH(api.BaseMetrics()))
 
 	// Validate Server Token endpoint
 	r.GET("/validate-token", claims.Establish(), api.ValidateServerToken)
@@ -96,17 +102,50 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 	}
 
 	// API endpoints
-	baseAPI := r.Group(base, claims.Establish(), user.Establish())
+	//
+	// every API endpoint must enforce the authorization
+	// policy for the endpoint or the request is denied
+	baseAPI := r.Group(base, claims.Establish(), user.Establish(), perm.DenyByDefault())
 	{
 		// Admin endpoints
 		AdminHandlers(baseAPI)
 
+		// Announcement endpoints
+		AnnouncementHandlers(baseAPI)
+
+		// Catalog endpoints
+		CatalogHandlers(baseAPI)
+
 		// Deployment endpoints
 		DeploymentHandlers(baseAPI)
 
+		// Deprecation endpoints
+		DeprecationHandlers(baseAPI)
+
+		// Egress endpoints
+		EgressHandlers(baseAPI)
+
+		// Freeze endpoints
+		FreezeHandlers(baseAPI)
+
 		// Hook endpoints
 		HookHandlers(baseAPI)
 
+		// Import endpoints
+		ImportHandlers(baseAPI)
+
+		// Mirror endpoints
+		MirrorHandlers(baseAPI)
+
+		// Org hook endpoints
+		OrgHookHandlers(baseAPI)
+
+		// Registry endpoints
+		RegistryHandlers(baseAPI)
+
+		// Required context endpoints
+		RequiredContextHandlers(baseAPI)
+
 		// Repo endpoints
 		// * Build endpoints
 		//   * Service endpoints
@@ -115,6 +154,9 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 		//     * Log endpoints
 		RepoHandlers(baseAPI)
 
+		// Schedule endpoints
+		ScheduleHandlers(baseAPI)
+
 		// Source code management endpoints
 		ScmHandlers(baseAPI)
 
@@ -124,12 +166,21 @@ func Load(options ...gin.HandlerFunc) *gin.Engine {
 		// Secret endpoints
 		SecretHandlers(baseAPI)
 
+		// Template endpoints
+		TemplateHandlers(baseAPI)
+
+		// Usage endpoints
+		UsageHandlers(baseAPI)
+
 		// User endpoints
 		UserHandlers(baseAPI)
 
 		// Worker endpoints
 		WorkerHandlers(baseAPI)
 
+		// Worker group endpoints
+		WorkerGroupHandlers(baseAPI)
+
 		// Pipeline endpoints
 		PipelineHandlers(baseAPI)
 	} // end of api
@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/fault"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+func TestRouter_Load_AuthorizationPolicy(t *testing.T) {
+	// setup types
+	enforcer := runtime.FuncForPC(reflect.ValueOf(perm.Enforce()).Pointer()).Name()
+	params := regexp.MustCompile(`[:*][^/]+`)
+	chains := make(map[perm.Route][]string)
+
+	gin.SetMode(gin.TestMode)
+
+	// capture the handlers for every route without running them
+	engine := Load(func(c *gin.Context) {
+		chains[perm.Route{Method: c.Request.Method, Path: c.FullPath()}] = c.HandlerNames()
+
+		c.AbortWithStatus(http.StatusNoContent)
+	})
+
+	routes := make(map[perm.Route]bool)
+
+	// run test
+	for _, r := range engine.Routes() {
+		// skip the endpoints outside of the API
+		if !strings.HasPrefix(r.Path, base) {
+			continue
+		}
+
+		route := perm.Route{Method: r.Method, Path: r.Path}
+		routes[route] = true
+
+		if _, ok := perm.Matrix[route]; !ok {
+			t.Errorf("%s %s is missing from the authorization policy matrix", r.Method, r.Path)
+		}
+
+		req, _ := http.NewRequest(r.Method, params.ReplaceAllString(r.Path, "foo"), nil)
+
+		engine.ServeHTTP(httptest.NewRecorder(), req)
+
+		handlers, ok := chains[route]
+		if !ok {
+			t.Errorf("%s %s was not routed to the endpoint", r.Method, r.Path)
+
+			continue
+		}
+
+		found := false
+
+		for _, h := range handlers {
+			if h == enforcer {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf("%s %s does not enforce its authorization policy", r.Method, r.Path)
+		}
+	}
+
+	for route := range perm.Matrix {
+		// skip the fault endpoints that only exist when built for testing
+		if !fault.Enabled && strings.HasPrefix(route.Path, base+"/admin/fault") {
+			continue
+		}
+
+		if !routes[route] {
+			t.Errorf("authorization policy for %s %s does not match an endpoint", route.Method, route.Path)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/schedule"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+	"github.com/go-vela/server/router/middleware/repo"
+)
+
+// ScheduleHandlers is a function that extends the provided base router group
+// with the API handlers for schedule functionality.
+//
+// POST   /api/v1/schedules/:org/:repo
+// GET    /api/v1/schedules/:org/:repo
+// GET    /api/v1/schedules/:org/:repo/:schedule
+// PUT    /api/v1/schedules/:org/:repo/:schedule
+// DELETE /api/v1/schedules/:org/:repo/:schedule
+// GET    /api/v1/schedules/:org/:repo/:schedule/runs .
+func ScheduleHandlers(base *gin.RouterGroup) {
+	// Schedules endpoints
+	_schedules := base.Group("/schedules/:org/:repo", org.Establish(), repo.Establish(), perm.Enforce())
+	{
+		_schedules.POST("", middleware.Payload(), schedule.CreateSchedule)
+		_schedules.GET("", schedule.ListSchedules)
+		_schedules.GET("/:schedule", schedule.GetSchedule)
+		_schedules.PUT("/:schedule", middleware.Payload(), schedule.UpdateSchedule)
+		_schedules.DELETE("/:schedule", schedule.DeleteSchedule)
+		_schedules.GET("/:schedule/runs", schedule.ListScheduleRuns)
+	} // end of schedules endpoints
+}
@@ -7,20 +7,24 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/router/middleware/perm"
 )
 
 // SearchHandlers is a function that extends the provided base router group
 // with the API handlers for resource search functionality.
 //
+// GET    /api/v1/search
 // GET    /api/v1/search/builds/:id .
 func SearchHandlers(base *gin.RouterGroup) {
 	// Search endpoints
 	search := base.Group("/search")
 	{
+		search.GET("", perm.Enforce(), api.SearchBuilds)
+
 		// Build endpoint
 		build := search.Group("/builds")
 		{
-			build.GET("/:id", api.GetBuildByID)
+			build.GET("/:id", perm.Enforce(), api.GetBuildByID)
 		}
 	} // end of search endpoints
 }
@@ -21,7 +21,7 @@ import (
 // DELETE /api/v1/secrets/:engine/:type/:org/:name/:secret .
 func SecretHandlers(base *gin.RouterGroup) {
 	// Secrets endpoints
-	secrets := base.Group("/secrets/:engine/:type/:org/:name", perm.MustSecretAdmin())
+	secrets := base.Group("/secrets/:engine/:type/:org/:name", perm.Enforce())
 	{
 		secrets.POST("", api.CreateSecret)
 		secrets.GET("", api.GetSecrets)
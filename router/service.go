@@ -25,19 +25,23 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
 // DELETE /api/v1/repos/:org/:repo/builds/:build/services/:service/logs
+// GET    /api/v1/repos/:org/:repo/builds/:build/services/:service/readiness
+// PUT    /api/v1/repos/:org/:repo/builds/:build/services/:service/readiness
 func ServiceHandlers(base *gin.RouterGroup) {
 	// Services endpoints
 	services := base.Group("/services")
 	{
-		services.POST("", perm.MustPlatformAdmin(), middleware.Payload(), api.CreateService)
-		services.GET("", perm.MustRead(), api.GetServices)
+		services.POST("", perm.Enforce(), middleware.Payload(), api.CreateService)
+		services.GET("", perm.Enforce(), api.GetServices)
 
 		// Service endpoints
 		service := services.Group("/:service", service.Establish())
 		{
-			service.GET("", perm.MustRead(), api.GetService)
-			service.PUT("", perm.MustBuildAccess(), middleware.Payload(), api.UpdateService)
-			service.DELETE("", perm.MustPlatformAdmin(), api.DeleteService)
+			service.GET("", perm.Enforce(), api.GetService)
+			service.PUT("", perm.Enforce(), middleware.Payload(), api.UpdateService)
+			service.DELETE("", perm.Enforce(), api.DeleteService)
+			service.GET("/readiness", perm.Enforce(), api.GetServiceReadiness)
+			service.PUT("/readiness", perm.Enforce(), middleware.Payload(), api.UpdateServiceReadiness)
 
 			// Log endpoints
 			LogServiceHandlers(service)
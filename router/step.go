@@ -21,6 +21,8 @@ import (
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step
 // DELETE /api/v1/repos/:org/:repo/builds/:build/steps/:step
+// POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts
+// GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/attempts
 // POST   /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // GET    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
 // PUT    /api/v1/repos/:org/:repo/builds/:build/steps/:step/logs
@@ -29,15 +31,17 @@ func StepHandlers(base *gin.RouterGroup) {
 	// Steps endpoints
 	steps := base.Group("/steps")
 	{
-		steps.POST("", perm.MustPlatformAdmin(), middleware.Payload(), api.CreateStep)
-		steps.GET("", perm.MustRead(), api.GetSteps)
+		steps.POST("", perm.Enforce(), middleware.Payload(), api.CreateStep)
+		steps.GET("", perm.Enforce(), api.GetSteps)
 
 		// Step endpoints
 		step := steps.Group("/:step", step.Establish())
 		{
-			step.GET("", perm.MustRead(), api.GetStep)
-			step.PUT("", perm.MustBuildAccess(), middleware.Payload(), api.UpdateStep)
-			step.DELETE("", perm.MustPlatformAdmin(), api.DeleteStep)
+			step.GET("", perm.Enforce(), api.GetStep)
+			step.PUT("", perm.Enforce(), middleware.Payload(), api.UpdateStep)
+			step.DELETE("", perm.Enforce(), api.DeleteStep)
+			step.POST("/attempts", perm.Enforce(), middleware.Payload(), api.CreateStepAttempt)
+			step.GET("/attempts", perm.Enforce(), api.GetStepAttempts)
 
 			// Log endpoints
 			LogStepHandlers(step)
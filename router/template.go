@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/template"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+	"github.com/go-vela/server/router/middleware/repo"
+)
+
+// TemplateHandlers is a function that extends the provided base router group
+// with the API handlers for template functionality.
+//
+// POST   /api/v1/templates/:org/:repo/deprecations
+// GET    /api/v1/templates/:org/:repo/deprecations
+// DELETE /api/v1/templates/:org/:repo/deprecations/:deprecation .
+func TemplateHandlers(base *gin.RouterGroup) {
+	// Templates endpoints
+	_templates := base.Group("/templates/:org/:repo", org.Establish(), repo.Establish())
+	{
+		// Deprecations endpoints
+		_deprecations := _templates.Group("/deprecations")
+		{
+			_deprecations.POST("", perm.Enforce(), middleware.Payload(), template.CreateTemplateDeprecation)
+			_deprecations.GET("", perm.Enforce(), template.ListTemplateDeprecations)
+			_deprecations.DELETE("/:deprecation", perm.Enforce(), template.DeleteTemplateDeprecation)
+		} // end of deprecations endpoints
+	} // end of templates endpoints
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/usage"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/org"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// UsageHandlers is a function that extends the provided base router group
+// with the API handlers for build usage reporting functionality.
+//
+// GET    /api/v1/usage/orgs/:org/actors
+// GET    /api/v1/usage/orgs/:org/budget
+// PUT    /api/v1/usage/orgs/:org/budget
+// DELETE /api/v1/usage/orgs/:org/budget
+// GET    /api/v1/usage/orgs/:org/costs
+// GET    /api/v1/usage/orgs/:org/teams/:team
+// GET    /api/v1/usage/rates
+// PUT    /api/v1/usage/rates/:class
+// DELETE /api/v1/usage/rates/:class .
+func UsageHandlers(base *gin.RouterGroup) {
+	// Usage endpoints
+	_usage := base.Group("/usage")
+	{
+		// Org endpoints
+		_org := _usage.Group("/orgs/:org", org.Establish())
+		{
+			_org.GET("/actors", perm.Enforce(), usage.ListActorUsageForOrg)
+			_org.GET("/budget", perm.Enforce(), usage.GetBudgetUsage)
+			_org.PUT("/budget", perm.Enforce(), middleware.Payload(), usage.UpdateBuildBudget)
+			_org.DELETE("/budget", perm.Enforce(), usage.DeleteBuildBudget)
+			_org.GET("/costs", perm.Enforce(), usage.ListClassCostForOrg)
+			_org.GET("/teams/:team", perm.Enforce(), usage.GetTeamUsage)
+		} // end of org endpoints
+
+		// Rate endpoints
+		_usage.GET("/rates", perm.Enforce(), usage.ListCostRates)
+		_usage.PUT("/rates/:class", perm.Enforce(), middleware.Payload(), usage.UpdateCostRate)
+		_usage.DELETE("/rates/:class", perm.Enforce(), usage.DeleteCostRate)
+	} // end of usage endpoints
+}
@@ -7,6 +7,7 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/server/api"
+	"github.com/go-vela/server/api/announcement"
 	"github.com/go-vela/server/router/middleware/perm"
 )
 
@@ -18,11 +19,15 @@ import (
 // GET    /api/v1/users/:user
 // PUT    /api/v1/users/:user
 // DELETE /api/v1/users/:user
+// GET    /api/v1/users/:user/sessions
 // GET    /api/v1/users/:user/source/repos
 // POST   /api/v1/users/:user/token
 // DELETE /api/v1/users/:user/token
 // GET    /api/v1/user
 // PUT    /api/v1/user
+// GET    /api/v1/user/announcements
+// GET    /api/v1/user/scopes
+// GET    /api/v1/user/sessions
 // GET    /api/v1/user/source/repos
 // POST   /api/v1/user/token
 // DELETE /api/v1/user/token .
@@ -30,20 +35,24 @@ func UserHandlers(base *gin.RouterGroup) {
 	// Users endpoints
 	users := base.Group("/users")
 	{
-		users.POST("", perm.MustPlatformAdmin(), api.CreateUser)
-		users.GET("", api.GetUsers)
-		users.GET("/:user", perm.MustPlatformAdmin(), api.GetUser)
-		users.PUT("/:user", perm.MustPlatformAdmin(), api.UpdateUser)
-		users.DELETE("/:user", perm.MustPlatformAdmin(), api.DeleteUser)
+		users.POST("", perm.Enforce(), api.CreateUser)
+		users.GET("", perm.Enforce(), api.GetUsers)
+		users.GET("/:user", perm.Enforce(), api.GetUser)
+		users.PUT("/:user", perm.Enforce(), api.UpdateUser)
+		users.DELETE("/:user", perm.Enforce(), api.DeleteUser)
+		users.GET("/:user/sessions", perm.Enforce(), api.GetUserSessions)
 	} // end of users endpoints
 
 	// User endpoints
 	user := base.Group("/user")
 	{
-		user.GET("", api.GetCurrentUser)
-		user.PUT("", api.UpdateCurrentUser)
-		user.GET("/source/repos", api.GetUserSourceRepos)
-		user.POST("/token", api.CreateToken)
-		user.DELETE("/token", api.DeleteToken)
+		user.GET("", perm.Enforce(), api.GetCurrentUser)
+		user.PUT("", perm.Enforce(), api.UpdateCurrentUser)
+		user.GET("/announcements", perm.Enforce(), announcement.ListUserAnnouncements)
+		user.GET("/scopes", perm.Enforce(), api.GetCurrentUserScopes)
+		user.GET("/sessions", perm.Enforce(), api.GetCurrentUserSessions)
+		user.GET("/source/repos", perm.Enforce(), api.GetUserSourceRepos)
+		user.POST("/token", perm.Enforce(), api.CreateToken)
+		user.DELETE("/token", perm.Enforce(), api.DeleteToken)
 	} // end of user endpoints
 }
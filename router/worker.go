@@ -25,16 +25,16 @@ func WorkerHandlers(base *gin.RouterGroup) {
 	// Workers endpoints
 	workers := base.Group("/workers")
 	{
-		workers.POST("", perm.MustWorkerRegisterToken(), middleware.Payload(), api.CreateWorker)
-		workers.GET("", api.GetWorkers)
+		workers.POST("", perm.Enforce(), middleware.Payload(), api.CreateWorker)
+		workers.GET("", perm.Enforce(), api.GetWorkers)
 
 		// Worker endpoints
 		w := workers.Group("/:worker")
 		{
-			w.GET("", worker.Establish(), api.GetWorker)
-			w.PUT("", perm.MustPlatformAdmin(), worker.Establish(), api.UpdateWorker)
-			w.POST("/refresh", perm.MustWorkerAuthToken(), worker.Establish(), api.RefreshWorkerAuth)
-			w.DELETE("", perm.MustPlatformAdmin(), worker.Establish(), api.DeleteWorker)
+			w.GET("", perm.Enforce(), worker.Establish(), api.GetWorker)
+			w.PUT("", perm.Enforce(), worker.Establish(), api.UpdateWorker)
+			w.POST("/refresh", perm.Enforce(), worker.Establish(), api.RefreshWorkerAuth)
+			w.DELETE("", perm.Enforce(), worker.Establish(), api.DeleteWorker)
 		} // end of worker endpoints
 	} // end of workers endpoints
 }
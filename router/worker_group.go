@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/server/api/workergroup"
+	"github.com/go-vela/server/router/middleware"
+	"github.com/go-vela/server/router/middleware/perm"
+)
+
+// WorkerGroupHandlers is a function that extends the provided base router group
+// with the API handlers for worker group functionality.
+//
+// POST   /api/v1/workergroups
+// GET    /api/v1/workergroups
+// GET    /api/v1/workergroups/:group
+// PUT    /api/v1/workergroups/:group
+// DELETE /api/v1/workergroups/:group .
+func WorkerGroupHandlers(base *gin.RouterGroup) {
+	// Worker groups endpoints
+	_groups := base.Group("/workergroups")
+	{
+		_groups.POST("", perm.Enforce(), middleware.Payload(), workergroup.CreateWorkerGroup)
+		_groups.GET("", perm.Enforce(), workergroup.ListWorkerGroups)
+		_groups.GET("/:group", perm.Enforce(), workergroup.GetWorkerGroup)
+		_groups.PUT("/:group", perm.Enforce(), middleware.Payload(), workergroup.UpdateWorkerGroup)
+		_groups.DELETE("/:group", perm.Enforce(), workergroup.DeleteWorkerGroup)
+	} // end of worker groups endpoints
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scheduler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// macros represents the supported shorthands for a cron entry.
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// horizon represents how far ahead to search for the next time
+// a cron entry is due before assuming it is never due, like an
+// entry for the 30th of February.
+const horizon = 5 * 366 * 24 * time.Hour
+
+// field represents the bounds of a field in a cron entry.
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+// fields represents the five fields of a cron entry in order.
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	// 7 is accepted as Sunday in addition to 0
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Cron represents a parsed five-field cron entry
+// of minute, hour, day of month, month and day of week.
+type Cron struct {
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	anyDom bool
+	anyDow bool
+}
+
+// Parse parses a five-field cron entry, or one of the @yearly,
+// @monthly, @weekly, @daily and @hourly shorthands. Each field
+// accepts a wildcard, a value, a range, a step and a list of those.
+func Parse(entry string) (*Cron, error) {
+	entry = strings.TrimSpace(entry)
+
+	if m, ok := macros[strings.ToLower(entry)]; ok {
+		entry = m
+	}
+
+	parts := strings.Fields(entry)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron entry %q: expected %d fields", entry, len(fields))
+	}
+
+	bits := make([]uint64, len(fields))
+
+	for i, part := range parts {
+		b, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron entry %q: %w", entry, err)
+		}
+
+		bits[i] = b
+	}
+
+	// fold Sunday as 7 into Sunday as 0
+	if bits[4]&(1<<7) > 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return &Cron{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		anyDom: strings.HasPrefix(parts[2], "*"),
+		anyDow: strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+// parseField is a helper function to parse a field of
+// a cron entry into the bits for the values it matches.
+func parseField(part string, f field) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(part, ",") {
+		lo, hi, step := f.min, f.max, 1
+
+		rng := item
+
+		// capture the step for the range
+		if i := strings.Index(item, "/"); i >= 0 {
+			s, err := strconv.Atoi(item[i+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("invalid step %q for %s", item[i+1:], f.name)
+			}
+
+			step = s
+			rng = item[:i]
+		}
+
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+
+			l, err := parseValue(bounds[0], f)
+			if err != nil {
+				return 0, err
+			}
+
+			h, err := parseValue(bounds[1], f)
+			if err != nil {
+				return 0, err
+			}
+
+			if l > h {
+				return 0, fmt.Errorf("invalid range %q for %s", rng, f.name)
+			}
+
+			lo, hi = l, h
+		default:
+			v, err := parseValue(rng, f)
+			if err != nil {
+				return 0, err
+			}
+
+			lo = v
+
+			// a single value only extends to the max with a step
+			if step == 1 {
+				hi = v
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+
+	return bits, nil
+}
+
+// parseValue is a helper function to parse a value
+// of a cron entry within the bounds of the field.
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q for %s: must be between %d and %d", s, f.name, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// Next returns the first time after the provided time that the
+// cron entry is due, evaluated in the location of the provided
+// time. When the cron entry is never due, the zero time is returned.
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+	end := t.Add(horizon)
+
+	// start at the next whole minute
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Before(end) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+
+			continue
+		}
+
+		if !c.day(t) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+
+			continue
+		}
+
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = nextHour(t)
+
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+// forward is a helper function to move ahead to the provided
+// time. When the wall clock time was skipped by daylight saving
+// time and normalizes to an earlier time, it moves ahead to
+// the next hour instead.
+func forward(t, next time.Time) time.Time {
+	if next.After(t) {
+		return next
+	}
+
+	return nextHour(t)
+}
+
+// nextHour is a helper function to move ahead to the start of
+// the next hour. The minutes are added instead of setting the
+// wall clock time, since the next hour may be skipped by daylight
+// saving time.
+func nextHour(t time.Time) time.Time {
+	return t.Add(time.Duration(60-t.Minute()) * time.Minute)
+}
+
+// day is a helper function to check if the cron entry is due on
+// the day of the provided time. Like cron, when neither the day
+// of month nor day of week start with a wildcard, either may match.
+func (c *Cron) day(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) > 0
+	dow := c.dow&(1<<uint(t.Weekday())) > 0
+
+	if c.anyDom || c.anyDow {
+		return dom && dow
+	}
+
+	return dom || dow
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduler_Parse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		entry   string
+	}{
+		{name: "wildcards", failure: false, entry: "* * * * *"},
+		{name: "values", failure: false, entry: "30 9 1 6 1"},
+		{name: "ranges and steps", failure: false, entry: "*/15 9-17 * * 1-5"},
+		{name: "lists", failure: false, entry: "0,30 8,20 1,15 * *"},
+		{name: "sunday as 7", failure: false, entry: "0 0 * * 7"},
+		{name: "shorthand", failure: false, entry: "@daily"},
+		{name: "empty", failure: true, entry: ""},
+		{name: "too few fields", failure: true, entry: "* * * *"},
+		{name: "too many fields", failure: true, entry: "* * * * * *"},
+		{name: "out of bounds", failure: true, entry: "60 * * * *"},
+		{name: "reversed range", failure: true, entry: "* 17-9 * * *"},
+		{name: "invalid step", failure: true, entry: "*/0 * * * *"},
+		{name: "not a number", failure: true, entry: "* * * JAN *"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.entry)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Parse should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestScheduler_Cron_Next(t *testing.T) {
+	// setup types
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Errorf("unable to load time zone: %v", err)
+	}
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Errorf("unable to load time zone: %v", err)
+	}
+
+	// Monday, October 16th 2023
+	monday := time.Date(2023, time.October, 16, 12, 10, 30, 0, time.UTC)
+
+	// setup tests
+	tests := []struct {
+		name  string
+		entry string
+		from  time.Time
+		want  time.Time
+	}{
+		{
+			name:  "every minute",
+			entry: "* * * * *",
+			from:  monday,
+			want:  time.Date(2023, time.October, 16, 12, 11, 0, 0, time.UTC),
+		},
+		{
+			name:  "every fifteen minutes",
+			entry: "*/15 * * * *",
+			from:  monday,
+			want:  time.Date(2023, time.October, 16, 12, 15, 0, 0, time.UTC),
+		},
+		{
+			name:  "next day",
+			entry: "0 9 * * *",
+			from:  monday,
+			want:  time.Date(2023, time.October, 17, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "weekdays skip the weekend",
+			entry: "0 9 * * 1-5",
+			from:  time.Date(2023, time.October, 20, 12, 0, 0, 0, time.UTC),
+			want:  time.Date(2023, time.October, 23, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "day of month or day of week",
+			entry: "0 0 1 * 5",
+			from:  monday,
+			want:  time.Date(2023, time.October, 20, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "next year",
+			entry: "@yearly",
+			from:  monday,
+			want:  time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "leap day",
+			entry: "0 0 29 2 *",
+			from:  monday,
+			want:  time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "time zone",
+			entry: "0 9 * * *",
+			from:  monday.In(chicago),
+			want:  time.Date(2023, time.October, 16, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "time zone with half hour offset",
+			entry: "0 * * * *",
+			from:  monday.In(kolkata),
+			want:  time.Date(2023, time.October, 16, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "skipped by daylight saving time",
+			entry: "30 2 * * *",
+			from:  time.Date(2024, time.March, 10, 0, 0, 0, 0, chicago),
+			want:  time.Date(2024, time.March, 11, 2, 30, 0, 0, chicago),
+		},
+		{
+			name:  "repeated by daylight saving time",
+			entry: "30 1 * * *",
+			from:  time.Date(2024, time.November, 3, 0, 0, 0, 0, chicago),
+			want:  time.Date(2024, time.November, 3, 6, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "never",
+			entry: "0 0 30 2 *",
+			from:  monday,
+			want:  time.Time{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := Parse(test.entry)
+			if err != nil {
+				t.Errorf("Parse returned err: %v", err)
+			}
+
+			got := c.Next(test.from)
+
+			if !got.Equal(test.want) {
+				t.Errorf("Next is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package scheduler provides the ability for Vela to trigger builds
+// for the branch of a repo on the cron schedules created for the repo.
+//
+// The cron entry for a schedule is evaluated in the time zone of
+// the schedule. A schedule that comes due while an active change
+// freeze blocks the builds for the repo falls in a blackout window,
+// so the build is skipped. Every time a schedule comes due is recorded
+// as a run with whether the build was triggered, skipped or failed.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scheduler"
+package scheduler
@@ -0,0 +1,27 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scheduler
+
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Flags represents all supported command line
+// interface (CLI) flags for the scheduler.
+//
+// https://pkg.go.dev/github.com/urfave/cli?tab=doc#Flag
+var Flags = []cli.Flag{
+	// Schedule Flags
+
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_SCHEDULE_INTERVAL", "SCHEDULE_INTERVAL"},
+		FilePath: "/vela/schedule/interval",
+		Name:     "schedule.interval",
+		Usage:    "interval at which to check for schedules that are due to trigger a build (disabled when set to 0)",
+		Value:    time.Minute,
+	},
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the scheduler.
+type Opt func(*Scheduler) error
+
+// WithDatabase sets the database service in the scheduler.
+func WithDatabase(db database.Service) Opt {
+	return func(s *Scheduler) error {
+		// set the database service in the scheduler
+		s.database = db
+
+		return nil
+	}
+}
+
+// WithTrigger sets the function to trigger the builds in the scheduler.
+func WithTrigger(t Trigger) Opt {
+	return func(s *Scheduler) error {
+		// set the function to trigger the builds in the scheduler
+		s.trigger = t
+
+		return nil
+	}
+}
+
+// WithInterval sets the interval to check for schedules that are due in the scheduler.
+func WithInterval(interval time.Duration) Opt {
+	return func(s *Scheduler) error {
+		// check if the interval provided is negative
+		if interval < 0 {
+			return fmt.Errorf("invalid schedule interval provided: %s", interval)
+		}
+
+		// set the interval in the scheduler
+		s.config.Interval = interval
+
+		return nil
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// Start triggers the builds for the schedules that are due at
+// the configured interval until the provided channel is closed.
+func (s *Scheduler) Start(dying <-chan struct{}) error {
+	logrus.Infof("checking for schedules that are due every %s", s.config.Interval)
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	// catch up on the schedules that came due while
+	// the lease for the scheduler was handed off
+	s.schedule()
+
+	for {
+		select {
+		case <-dying:
+			return nil
+		case <-ticker.C:
+			s.schedule()
+		}
+	}
+}
+
+// schedule is a helper function to trigger
+// the builds for the schedules that are due.
+func (s *Scheduler) schedule() {
+	_, err := s.Run(context.Background(), time.Now().UTC())
+	if err != nil {
+		logrus.Errorf("unable to run schedules: %v", err)
+	}
+}
+
+// Run triggers the builds for the active schedules that came
+// due since they were last scheduled and returns the runs
+// recorded for them. A schedule that came due more than once
+// since then, like while the server was down, only runs once.
+func (s *Scheduler) Run(ctx context.Context, now time.Time) ([]*api.ScheduleRun, error) {
+	logrus.Trace("running schedules")
+
+	// send API call to capture the active schedules
+	schedules, err := s.database.ListActiveSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list active schedules: %w", err)
+	}
+
+	runs := []*api.ScheduleRun{}
+
+	for _, schedule := range schedules {
+		due, err := Due(schedule, now)
+		if err != nil {
+			logrus.Errorf("unable to evaluate schedule %d: %v", schedule.GetID(), err)
+
+			continue
+		}
+
+		// check if the schedule is not due yet
+		if due.IsZero() {
+			continue
+		}
+
+		run, err := s.process(ctx, schedule, due, now)
+		if err != nil {
+			logrus.Errorf("unable to run schedule %d: %v", schedule.GetID(), err)
+
+			continue
+		}
+
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// Due returns the time the schedule came due after it was last
+// scheduled, evaluating the cron entry in the time zone of the
+// schedule. When the schedule is not due yet, the zero time is
+// returned.
+func Due(schedule *api.Schedule, now time.Time) (time.Time, error) {
+	c, err := Parse(schedule.GetEntry())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := time.LoadLocation(schedule.GetTimeZone())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time zone %q: %w", schedule.GetTimeZone(), err)
+	}
+
+	// the schedule is due again from the last time it was
+	// scheduled, or from when it was created or updated
+	since := schedule.GetCreatedAt()
+
+	if schedule.GetUpdatedAt() > since {
+		since = schedule.GetUpdatedAt()
+	}
+
+	if schedule.GetScheduledAt() > since {
+		since = schedule.GetScheduledAt()
+	}
+
+	next := c.Next(time.Unix(since, 0).In(loc))
+	if next.IsZero() || next.After(now) {
+		return time.Time{}, nil
+	}
+
+	return next, nil
+}
+
+// process is a helper function to trigger the build for the
+// schedule that came due, unless the repo is in a blackout
+// window, and record the run for the schedule.
+func (s *Scheduler) process(ctx context.Context, schedule *api.Schedule, due, now time.Time) (*api.ScheduleRun, error) {
+	// capture the schedule as scheduled before triggering the
+	// build, so a failure isn't retried at every interval
+	schedule.SetScheduledAt(now.Unix())
+
+	// send API call to update the schedule
+	err := s.database.UpdateSchedule(ctx, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update schedule %d: %w", schedule.GetID(), err)
+	}
+
+	run := new(api.ScheduleRun)
+	run.SetScheduleID(schedule.GetID())
+	run.SetRepoID(schedule.GetRepoID())
+	run.SetScheduledFor(due.Unix())
+	run.SetStatus(api.ScheduleRunTriggered)
+	run.SetCreatedAt(now.Unix())
+
+	b, reason, err := s.launch(ctx, schedule, now)
+
+	switch {
+	case err != nil:
+		run.SetStatus(api.ScheduleRunFailed)
+		run.SetReason(err.Error())
+	case len(reason) > 0:
+		run.SetStatus(api.ScheduleRunSkipped)
+		run.SetReason(reason)
+	default:
+		run.SetBuildNumber(int64(b.GetNumber()))
+	}
+
+	logrus.Infof("schedule %s for repo %d %s", schedule.GetName(), schedule.GetRepoID(), run.GetStatus())
+
+	// send API call to record the run of the schedule
+	err = s.database.CreateScheduleRun(ctx, run)
+	if err != nil {
+		return run, fmt.Errorf("unable to record run for schedule %d: %w", schedule.GetID(), err)
+	}
+
+	return run, nil
+}
+
+// launch is a helper function to trigger the build for the
+// schedule. When the repo is in a blackout window, no build
+// is triggered and the reason it was skipped is returned.
+func (s *Scheduler) launch(ctx context.Context, schedule *api.Schedule, now time.Time) (*library.Build, string, error) {
+	// send API call to capture the repo for the schedule
+	r, err := s.database.GetRepo(ctx, schedule.GetRepoID())
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to get repo %d: %w", schedule.GetRepoID(), err)
+	}
+
+	// check if the repo for the schedule is active
+	if !r.GetActive() {
+		return nil, "", fmt.Errorf("repo %s is not active", r.GetFullName())
+	}
+
+	reason, err := s.blackout(ctx, r, now)
+	if err != nil || len(reason) > 0 {
+		return nil, reason, err
+	}
+
+	b, err := s.trigger(ctx, schedule, r)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to trigger build for %s: %w", r.GetFullName(), err)
+	}
+
+	return b, "", nil
+}
+
+// blackout is a helper function to check if the repo is in a
+// blackout window because an active change freeze for the org
+// of the repo, or for every org, blocks its push builds. When
+// it is, the reason the schedule is skipped is returned.
+func (s *Scheduler) blackout(ctx context.Context, r *library.Repo, now time.Time) (string, error) {
+	// send API call to capture the active freezes for the org
+	freezes, err := s.database.ListActiveFreezes(ctx, r.GetOrg(), now.Unix())
+	if err != nil {
+		return "", fmt.Errorf("unable to list freezes for org %s: %w", r.GetOrg(), err)
+	}
+
+	for _, f := range freezes {
+		if !f.Blocks(r.GetFullName(), constants.EventPush, now.Unix()) {
+			continue
+		}
+
+		reason := fmt.Sprintf("blackout window for freeze %s until %s",
+			f.GetName(), time.Unix(f.GetEnds(), 0).UTC().Format(time.RFC3339),
+		)
+
+		if len(f.GetReason()) > 0 {
+			reason = fmt.Sprintf("%s: %s", reason, f.GetReason())
+		}
+
+		return reason, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/types/library"
+)
+
+func TestScheduler_Due(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	// setup tests
+	tests := []struct {
+		name     string
+		failure  bool
+		entry    string
+		timeZone string
+		since    time.Time
+		want     time.Time
+	}{
+		{
+			name:  "due",
+			entry: "0 * * * *",
+			since: now.Add(-90 * time.Minute),
+			want:  now.Add(-time.Hour),
+		},
+		{
+			name:  "not due",
+			entry: "0 9 * * *",
+			since: now.Add(-time.Hour),
+			want:  time.Time{},
+		},
+		{
+			name:     "due in time zone",
+			entry:    "0 7 * * *",
+			timeZone: "America/Chicago",
+			since:    now.Add(-time.Hour),
+			want:     now,
+		},
+		{
+			name:     "not due in time zone",
+			entry:    "0 12 * * *",
+			timeZone: "America/Chicago",
+			since:    now.Add(-time.Hour),
+			want:     time.Time{},
+		},
+		{
+			name:    "invalid entry",
+			failure: true,
+			entry:   "0 24 * * *",
+			since:   now.Add(-time.Hour),
+		},
+		{
+			name:     "invalid time zone",
+			failure:  true,
+			entry:    "0 * * * *",
+			timeZone: "Mars/Olympus_Mons",
+			since:    now.Add(-time.Hour),
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := new(api.Schedule)
+			s.SetEntry(test.entry)
+			s.SetTimeZone(test.timeZone)
+			s.SetCreatedAt(test.since.Add(-24 * time.Hour).Unix())
+			s.SetScheduledAt(test.since.Unix())
+
+			got, err := Due(s, now)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("Due should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("Due returned err: %v", err)
+			}
+
+			if !got.Equal(test.want) {
+				t.Errorf("Due is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestScheduler_Run(t *testing.T) {
+	// setup types
+	now := time.Date(2023, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from repos;")
+		db.Sqlite.Exec("delete from freezes;")
+		db.Sqlite.Exec("delete from schedules;")
+		db.Sqlite.Exec("delete from schedule_runs;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	for i, name := range []string{"octocat", "frozen", "broken"} {
+		r := new(library.Repo)
+		r.SetID(int64(i + 1))
+		r.SetUserID(1)
+		r.SetHash("baz")
+		r.SetOrg(name)
+		r.SetName(name)
+		r.SetFullName(name + "/" + name)
+		r.SetVisibility("public")
+		r.SetActive(true)
+
+		err := db.CreateRepo(context.TODO(), r)
+		if err != nil {
+			t.Errorf("unable to create test repo: %v", err)
+		}
+
+		s := new(api.Schedule)
+		s.SetRepoID(r.GetID())
+		s.SetActive(true)
+		s.SetName("nightly")
+		s.SetEntry("0 * * * *")
+		s.SetCreatedAt(now.Add(-90 * time.Minute).Unix())
+
+		err = db.CreateSchedule(context.TODO(), s)
+		if err != nil {
+			t.Errorf("unable to create test schedule: %v", err)
+		}
+	}
+
+	f := new(api.Freeze)
+	f.SetOrg("frozen")
+	f.SetName("holidays")
+	f.SetReason("end of year")
+	f.SetScope(api.FreezeScopeAll)
+	f.SetStarts(now.Add(-time.Hour).Unix())
+	f.SetEnds(now.Add(time.Hour).Unix())
+	f.SetCreatedAt(now.Add(-time.Hour).Unix())
+
+	err := db.CreateFreeze(context.TODO(), f)
+	if err != nil {
+		t.Errorf("unable to create test freeze: %v", err)
+	}
+
+	triggered := []string{}
+
+	trigger := func(_ context.Context, s *api.Schedule, r *library.Repo) (*library.Build, error) {
+		if r.GetName() == "broken" {
+			return nil, errors.New("no pipeline found")
+		}
+
+		triggered = append(triggered, r.GetFullName())
+
+		b := new(library.Build)
+		b.SetNumber(7)
+
+		return b, nil
+	}
+
+	s, err := New(WithDatabase(db), WithTrigger(trigger))
+	if err != nil {
+		t.Errorf("unable to create scheduler: %v", err)
+	}
+
+	// run test
+	got, err := s.Run(context.TODO(), now)
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	if len(triggered) != 1 || triggered[0] != "octocat/octocat" {
+		t.Errorf("Run triggered %v, want [octocat/octocat]", triggered)
+	}
+
+	want := map[int64]string{
+		1: api.ScheduleRunTriggered,
+		2: api.ScheduleRunSkipped,
+		3: api.ScheduleRunFailed,
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("Run returned %d runs, want %d", len(got), len(want))
+	}
+
+	for _, run := range got {
+		if run.GetStatus() != want[run.GetRepoID()] {
+			t.Errorf("Run status for repo %d is %s, want %s", run.GetRepoID(), run.GetStatus(), want[run.GetRepoID()])
+		}
+
+		if run.GetScheduledFor() != now.Add(-time.Hour).Unix() {
+			t.Errorf("Run scheduled for %d, want %d", run.GetScheduledFor(), now.Add(-time.Hour).Unix())
+		}
+	}
+
+	runs, count, err := db.ListScheduleRuns(context.TODO(), &api.Schedule{ID: got[1].ScheduleID}, 1, 10)
+	if err != nil {
+		t.Errorf("unable to list schedule runs: %v", err)
+	}
+
+	if count != 1 || !strings.Contains(runs[0].GetReason(), "freeze holidays") {
+		t.Errorf("skipped run is %v, want the blackout window for freeze holidays", runs)
+	}
+
+	if got[0].GetBuildNumber() != 7 {
+		t.Errorf("Run build number is %d, want 7", got[0].GetBuildNumber())
+	}
+
+	// the schedules are not due again within the hour
+	got, err = s.Run(context.TODO(), now.Add(30*time.Minute))
+	if err != nil {
+		t.Errorf("Run returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("Run returned %d runs, want 0", len(got))
+	}
+}
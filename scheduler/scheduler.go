@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/types/library"
+
+	// embed the time zone database so the time zones
+	// of schedules can be loaded on any host
+	_ "time/tzdata"
+)
+
+type (
+	// config represents the settings required to create the scheduler.
+	config struct {
+		// specifies the interval at which to check for schedules that are due
+		Interval time.Duration
+	}
+
+	// Trigger represents a function that creates a build for the
+	// branch of the schedule for the repo and publishes it to the queue.
+	Trigger func(context.Context, *api.Schedule, *library.Repo) (*library.Build, error)
+
+	// Scheduler represents the functionality for triggering
+	// builds for the repos on their cron schedules.
+	Scheduler struct {
+		// scheduler configuration settings
+		config *config
+
+		// database service used to capture the schedules and record their runs
+		database database.Service
+
+		// function used to trigger the builds for the schedules
+		trigger Trigger
+	}
+)
+
+// New creates and returns a scheduler for the cron schedules of repos.
+func New(opts ...Opt) (*Scheduler, error) {
+	// create new scheduler
+	s := new(Scheduler)
+
+	// create new fields
+	s.config = &config{
+		Interval: time.Minute,
+	}
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// check if the scheduler is enabled without a trigger for the builds
+	if s.Enabled() && s.trigger == nil {
+		return nil, fmt.Errorf("no schedule trigger provided")
+	}
+
+	return s, nil
+}
+
+// Enabled returns whether the scheduler is configured
+// to trigger builds for the cron schedules of repos.
+func (s *Scheduler) Enabled() bool {
+	return s.config.Interval > 0
+}
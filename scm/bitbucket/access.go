@@ -0,0 +1,289 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// permissions represents the Bitbucket permissions checked
+// for a project or repo in order of the highest access level.
+var permissions = []string{"ADMIN", "WRITE", "READ"}
+
+// grant represents a permission granted
+// to a user for a project or repo in Bitbucket.
+type grant struct {
+	User       user   `json:"user"`
+	Permission string `json:"permission"`
+}
+
+// OrgAccess captures the user's access level for an org.
+//
+// Orgs are represented by projects in Bitbucket.
+func (c *client) OrgAccess(u *library.User, org string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to org %s", u.GetName(), org)
+
+	// check if user is accessing personal org
+	if personal(u, org) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with org %s", u.GetName(), org)
+
+		//nolint:goconst // ignore making constant
+		return "admin", nil
+	}
+
+	for _, p := range []string{"ADMIN", "READ"} {
+		query := url.Values{}
+		query.Set("permission", "PROJECT_"+p)
+
+		// send API call to capture the projects the user has the permission for
+		projects, err := list[project](c, u.GetToken(), fmt.Sprintf("%s/projects", api), query)
+		if err != nil {
+			return "", err
+		}
+
+		for _, project := range projects {
+			if !strings.EqualFold(project.Key, org) {
+				continue
+			}
+
+			if p == "ADMIN" {
+				return "admin", nil
+			}
+
+			return "member", nil
+		}
+	}
+
+	return "", nil
+}
+
+// RepoAccess captures the user's access level for a repo.
+//
+// The effective access level is captured when the provided token
+// belongs to the user. Otherwise, only the access level granted
+// directly to the user for the repo or its project is captured.
+func (c *client) RepoAccess(u *library.User, token, org, repo string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to repo %s/%s", u.GetName(), org, repo)
+
+	// check if user is accessing repo in personal org
+	if personal(u, org) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": repo,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with repo %s/%s", u.GetName(), org, repo)
+
+		return "admin", nil
+	}
+
+	// check if the token belongs to another user
+	if token != u.GetToken() {
+		return c.grantedAccess(u, token, org, repo)
+	}
+
+	for _, p := range permissions {
+		query := url.Values{}
+		query.Set("projectkey", org)
+		query.Set("permission", "REPO_"+p)
+
+		// send API call to capture the repos the user has the permission for
+		repos, err := list[repository](c, token, fmt.Sprintf("%s/repos", api), query)
+		if err != nil {
+			return "", err
+		}
+
+		for _, r := range repos {
+			if strings.EqualFold(r.Slug, repo) && strings.EqualFold(r.Project.Key, org) {
+				return strings.ToLower(p), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// grantedAccess is a helper function to capture the highest access
+// level granted directly to the user for the repo or its project.
+func (c *client) grantedAccess(u *library.User, token, org, repo string) (string, error) {
+	query := url.Values{}
+	query.Set("filter", u.GetName())
+
+	paths := []string{
+		fmt.Sprintf("%s/permissions/users", repoPath(org, repo)),
+		fmt.Sprintf("%s/projects/%s/permissions/users", api, url.PathEscape(org)),
+	}
+
+	access := ""
+
+	for _, path := range paths {
+		// send API call to capture the permissions granted to users matching the filter
+		grants, err := list[grant](c, token, path, query)
+		if err != nil {
+			return "", err
+		}
+
+		for _, g := range grants {
+			if !strings.EqualFold(g.User.Name, u.GetName()) {
+				continue
+			}
+
+			access = higher(access, permission(g.Permission))
+		}
+	}
+
+	return access, nil
+}
+
+// TeamAccess captures the user's access level for a team.
+//
+// Teams are represented by groups in Bitbucket which are
+// not scoped to a project. Capturing the groups for a user
+// requires a token with the Bitbucket admin permission.
+func (c *client) TeamAccess(u *library.User, org, team string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to team %s/%s", u.GetName(), org, team)
+
+	// check if user is accessing team in personal org
+	if personal(u, org) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"team": team,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with team %s/%s", u.GetName(), org, team)
+
+		return "admin", nil
+	}
+
+	teams, err := c.listUserGroups(u)
+	if err != nil {
+		return "", err
+	}
+
+	// iterate through each element in the teams
+	for _, t := range teams {
+		// return admin access if the user is a part of that team
+		if strings.EqualFold(team, t) {
+			return "admin", nil
+		}
+	}
+
+	return "", nil
+}
+
+// ListUsersTeamsForOrg captures the user's teams for an org.
+//
+// Teams are represented by groups in Bitbucket which
+// are not scoped to a project so all groups are returned.
+func (c *client) ListUsersTeamsForOrg(u *library.User, org string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s team membership for org %s", u.GetName(), org)
+
+	teams, err := c.listUserGroups(u)
+	if err != nil {
+		return []string{""}, err
+	}
+
+	return teams, nil
+}
+
+// ListTeamMembers captures the login of each member of a team for an org.
+func (c *client) ListTeamMembers(u *library.User, org, team string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing members of team %s/%s", org, team)
+
+	query := url.Values{}
+	query.Set("context", team)
+
+	// send API call to list the members for the group
+	users, err := list[user](c, u.GetToken(), fmt.Sprintf("%s/admin/groups/more-members", api), query)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []string{}
+
+	for _, user := range users {
+		members = append(members, user.Name)
+	}
+
+	return members, nil
+}
+
+// listUserGroups is a helper function to capture
+// the names of the groups the user belongs to.
+func (c *client) listUserGroups(u *library.User) ([]string, error) {
+	query := url.Values{}
+	query.Set("context", u.GetName())
+
+	// send API call to list the groups for the user
+	groups, err := list[struct {
+		Name string `json:"name"`
+	}](c, u.GetToken(), fmt.Sprintf("%s/admin/users/more-members", api), query)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+
+	for _, g := range groups {
+		names = append(names, g.Name)
+	}
+
+	return names, nil
+}
+
+// personal is a helper function to determine if the org
+// is the personal project for the user in Bitbucket.
+func personal(u *library.User, org string) bool {
+	return strings.EqualFold(org, u.GetName()) || strings.EqualFold(org, "~"+u.GetName())
+}
+
+// permission is a helper function to convert the
+// Bitbucket permission to a Vela access level.
+func permission(p string) string {
+	for _, level := range permissions {
+		if strings.HasSuffix(p, "_"+level) {
+			return strings.ToLower(level)
+		}
+	}
+
+	return ""
+}
+
+// higher is a helper function to capture the higher
+// of the provided access levels.
+func higher(a, b string) string {
+	for _, level := range permissions {
+		if strings.EqualFold(a, level) || strings.EqualFold(b, level) {
+			return strings.ToLower(level)
+		}
+	}
+
+	return ""
+}
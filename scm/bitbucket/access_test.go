@@ -0,0 +1,285 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestBitbucket_OrgAccess(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		switch c.Query("permission") {
+		case "PROJECT_ADMIN":
+			c.File("testdata/empty.json")
+		default:
+			c.File("testdata/projects.json")
+		}
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		org  string
+		want string
+	}{
+		{org: "GITHUB", want: "member"},
+		{org: "~octocat", want: "admin"},
+		{org: "octocat", want: "admin"},
+		{org: "OTHER", want: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.OrgAccess(u, test.org)
+		if err != nil {
+			t.Errorf("OrgAccess for %s returned err: %v", test.org, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("OrgAccess for %s is %v, want %v", test.org, got, test.want)
+		}
+	}
+}
+
+func TestBitbucket_RepoAccess(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/repos", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Query("projectkey") != "GITHUB" || c.Query("permission") != "REPO_WRITE" {
+			c.File("testdata/empty.json")
+
+			return
+		}
+
+		c.File("testdata/repos.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		org  string
+		repo string
+		want string
+	}{
+		{org: "GITHUB", repo: "octocat", want: "write"},
+		{org: "GITHUB", repo: "other", want: ""},
+		{org: "~OCTOCAT", repo: "octocat", want: "admin"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.RepoAccess(u, u.GetToken(), test.org, test.repo)
+		if err != nil {
+			t.Errorf("RepoAccess for %s/%s returned err: %v", test.org, test.repo, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("RepoAccess for %s/%s is %v, want %v", test.org, test.repo, got, test.want)
+		}
+	}
+}
+
+func TestBitbucket_RepoAccess_OtherToken(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/permissions/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/repo_permissions.json")
+	})
+	engine.GET("/rest/api/1.0/projects/:org/permissions/users", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Query("filter") != "octocat" {
+			c.File("testdata/empty.json")
+
+			return
+		}
+
+		c.File("testdata/project_permissions.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := "admin"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.RepoAccess(u, "bar", "GITHUB", "octocat")
+	if err != nil {
+		t.Errorf("RepoAccess returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RepoAccess is %v, want %v", got, want)
+	}
+}
+
+func TestBitbucket_TeamAccess(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/admin/users/more-members", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/user_groups.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		team string
+		want string
+	}{
+		{team: "justice league", want: "admin"},
+		{team: "avengers", want: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.TeamAccess(u, "GITHUB", test.team)
+		if err != nil {
+			t.Errorf("TeamAccess for %s returned err: %v", test.team, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("TeamAccess for %s is %v, want %v", test.team, got, test.want)
+		}
+	}
+}
+
+func TestBitbucket_ListUsersTeamsForOrg(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/admin/users/more-members", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/user_groups.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := []string{"Justice League", "admins"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUsersTeamsForOrg(u, "GITHUB")
+	if err != nil {
+		t.Errorf("ListUsersTeamsForOrg returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUsersTeamsForOrg is %v, want %v", got, want)
+	}
+}
+
+func TestBitbucket_ListTeamMembers(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/admin/groups/more-members", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/group_members.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := []string{"octocat", "hubot"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListTeamMembers(u, "GITHUB", "Justice League")
+	if err != nil {
+		t.Errorf("ListTeamMembers returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListTeamMembers is %v, want %v", got, want)
+	}
+}
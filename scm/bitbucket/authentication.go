@@ -0,0 +1,160 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-vela/server/random"
+	"github.com/go-vela/types/library"
+)
+
+// user represents a user from Bitbucket.
+type user struct {
+	Name         string `json:"name"`
+	EmailAddress string `json:"emailAddress"`
+	DisplayName  string `json:"displayName"`
+	Slug         string `json:"slug"`
+}
+
+// Authorize uses the given access token to authorize the user.
+func (c *client) Authorize(token string) (string, error) {
+	c.Logger.Trace("authorizing user with token")
+
+	var name []byte
+
+	// send API call to capture the current user making the call
+	_, err := c.call(token, http.MethodGet, "/plugins/servlet/applinks/whoami", nil, &name)
+	if err != nil {
+		return "", err
+	}
+
+	// anonymous requests are answered without a user
+	if len(strings.TrimSpace(string(name))) == 0 {
+		return "", errors.New("unable to authorize user with token")
+	}
+
+	return strings.TrimSpace(string(name)), nil
+}
+
+// Login begins the authentication workflow for the session.
+func (c *client) Login(w http.ResponseWriter, r *http.Request) (string, error) {
+	c.Logger.Trace("processing login request")
+
+	// generate a random string for creating the OAuth state
+	oAuthState, err := random.GenerateRandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	// pass through the redirect if it exists
+	redirect := r.FormValue("redirect_uri")
+	if len(redirect) > 0 {
+		c.OAuth.RedirectURL = redirect
+	}
+
+	// temporarily redirect request to Bitbucket to begin workflow
+	http.Redirect(w, r, c.OAuth.AuthCodeURL(oAuthState), http.StatusTemporaryRedirect)
+
+	return oAuthState, nil
+}
+
+// Authenticate completes the authentication workflow for the session
+// and returns the remote user details.
+func (c *client) Authenticate(w http.ResponseWriter, r *http.Request, oAuthState string) (*library.User, error) {
+	c.Logger.Trace("authenticating user")
+
+	// get the OAuth code
+	code := r.FormValue("code")
+	if len(code) == 0 {
+		return nil, nil
+	}
+
+	// verify the OAuth state
+	state := r.FormValue("state")
+	if state != oAuthState {
+		return nil, fmt.Errorf("unexpected oauth state: want %s but got %s", oAuthState, state)
+	}
+
+	// pass through the redirect if it exists
+	redirect := r.FormValue("redirect_uri")
+	if len(redirect) > 0 {
+		c.OAuth.RedirectURL = redirect
+	}
+
+	// exchange OAuth code for token
+	token, err := c.OAuth.Exchange(context.Background(), code)
+	if err != nil {
+		return nil, err
+	}
+
+	// authorize the user for the token
+	u, err := c.Authorize(token.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token.AccessToken,
+	}, nil
+}
+
+// AuthenticateToken completes the authentication workflow
+// for the session and returns the remote user details.
+//
+// Bitbucket HTTP access tokens are provided as the token.
+func (c *client) AuthenticateToken(r *http.Request) (*library.User, error) {
+	c.Logger.Trace("authenticating user via token")
+
+	token := r.Header.Get("Token")
+	if len(token) == 0 {
+		return nil, errors.New("no token provided")
+	}
+
+	u, err := c.Authorize(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &library.User{
+		Name:  &u,
+		Token: &token,
+	}, nil
+}
+
+// GetScopes captures the OAuth scopes granted to the token for a user.
+//
+// Bitbucket does not report the scopes granted to a token
+// so no scopes are returned.
+func (c *client) GetScopes(u *library.User) ([]string, error) {
+	c.Logger.Tracef("capturing oauth scopes for user %s", u.GetName())
+
+	return nil, nil
+}
+
+// MissingScopes captures the OAuth scopes required by the
+// client that are not granted by the provided scopes.
+func (c *client) MissingScopes(scopes []string) []string {
+	granted := make(map[string]bool)
+
+	for _, scope := range scopes {
+		granted[scope] = true
+	}
+
+	missing := []string{}
+
+	for _, scope := range c.config.Scopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+
+	return missing
+}
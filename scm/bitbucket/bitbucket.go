@@ -0,0 +1,276 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// api defines the path prefix for the Bitbucket REST API.
+	api = "/rest/api/1.0"
+
+	// events for repo webhooks.
+	eventRefsChanged     = "repo:refs_changed"
+	eventRepoModified    = "repo:modified"
+	eventPROpened        = "pr:opened"
+	eventPRUpdated       = "pr:from_ref_updated"
+	eventPRMerged        = "pr:merged"
+	eventPRDeclined      = "pr:declined"
+	eventPRDeleted       = "pr:deleted"
+	eventPRCommentAdded  = "pr:comment:added"
+	eventPRCommentEdited = "pr:comment:edited"
+	eventPing            = "diagnostics:ping"
+	eventInitialize      = "initialize"
+)
+
+var ctx = context.Background()
+
+type config struct {
+	// specifies the address to use for the Bitbucket client
+	Address string
+	// specifies the OAuth client ID from Bitbucket to use for the Bitbucket client
+	ClientID string
+	// specifies the OAuth client secret from Bitbucket to use for the Bitbucket client
+	ClientSecret string
+	// specifies the Vela server address to use for the Bitbucket client
+	ServerAddress string
+	// specifies the Vela server address that the scm provider should use to send Vela webhooks
+	ServerWebhookAddress string
+	// specifies the context for the commit status to use for the Bitbucket client
+	StatusContext string
+	// specifies the Vela web UI address to use for the Bitbucket client
+	WebUIAddress string
+	// specifies the OAuth scopes to use for the Bitbucket client
+	Scopes []string
+}
+
+type client struct {
+	config *config
+	OAuth  *oauth2.Config
+	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
+	Logger *logrus.Entry
+}
+
+// Error represents an error response from the Bitbucket API.
+type Error struct {
+	Response *http.Response
+	Errors   []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Error returns the error message from the Bitbucket API.
+func (e *Error) Error() string {
+	messages := []string{}
+
+	for _, err := range e.Errors {
+		messages = append(messages, err.Message)
+	}
+
+	return fmt.Sprintf("%s %s: %d %s",
+		e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, strings.Join(messages, ", "))
+}
+
+// page represents a page of results from the Bitbucket API.
+type page[T any] struct {
+	Values        []T  `json:"values"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
+
+// New returns a SCM implementation that integrates with
+// a Bitbucket Data Center or a Bitbucket Server instance.
+//
+//nolint:revive // ignore returning unexported client
+func New(opts ...ClientOpt) (*client, error) {
+	// create new Bitbucket client
+	c := new(client)
+
+	// create new fields
+	c.config = new(config)
+	c.OAuth = new(oauth2.Config)
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#StandardLogger
+	logger := logrus.StandardLogger()
+
+	// create new logger for the client
+	//
+	// https://pkg.go.dev/github.com/sirupsen/logrus?tab=doc#NewEntry
+	c.Logger = logrus.NewEntry(logger).WithField("scm", c.Driver())
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// create the Bitbucket OAuth config object
+	//
+	// https://confluence.atlassian.com/bitbucketserver/bitbucket-oauth-2-0-provider-api-1108483661.html
+	c.OAuth = &oauth2.Config{
+		ClientID:     c.config.ClientID,
+		ClientSecret: c.config.ClientSecret,
+		Scopes:       c.config.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  fmt.Sprintf("%s/rest/oauth2/latest/authorize", c.config.Address),
+			TokenURL: fmt.Sprintf("%s/rest/oauth2/latest/token", c.config.Address),
+		},
+	}
+
+	return c, nil
+}
+
+// NewTest returns a SCM implementation that integrates with the provided
+// mock server. Only the url from the mock server is required.
+//
+// This function is intended for running tests only.
+//
+//nolint:revive // ignore returning unexported client
+func NewTest(urls ...string) (*client, error) {
+	address := urls[0]
+	server := address
+
+	// check if multiple URLs were provided
+	if len(urls) > 1 {
+		server = urls[1]
+	}
+
+	return New(
+		WithAddress(address),
+		WithClientID("foo"),
+		WithClientSecret("bar"),
+		WithServerAddress(server),
+		WithServerWebhookAddress(""),
+		WithStatusContext("continuous-integration/vela"),
+		WithWebUIAddress(address),
+		WithScopes([]string{"REPO_ADMIN"}),
+	)
+}
+
+// call is a helper function to send an API request to Bitbucket with
+// the provided token. The body is encoded as JSON for the request and
+// the JSON response is decoded into the provided value when not nil.
+func (c *client) call(token, method, path string, body, v interface{}) (*http.Response, error) {
+	var reader io.Reader
+
+	// check if a body was provided for the request
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.config.Address+path, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// check if the request was unsuccessful
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		e := &Error{Response: resp}
+
+		// capture the error messages from the response
+		_ = json.NewDecoder(resp.Body).Decode(e)
+
+		return resp, e
+	}
+
+	if v == nil {
+		return resp, nil
+	}
+
+	// check if the raw response was requested
+	if b, ok := v.(*[]byte); ok {
+		*b, err = io.ReadAll(resp.Body)
+
+		return resp, err
+	}
+
+	return resp, json.NewDecoder(resp.Body).Decode(v)
+}
+
+// list is a helper function to capture *ALL* results
+// for the provided paged resource from Bitbucket.
+func list[T any](c *client, token, path string, query url.Values) ([]T, error) {
+	results := []T{}
+
+	if query == nil {
+		query = url.Values{}
+	}
+
+	// set the max limit for the query to capture the list of results
+	query.Set("limit", "1000")
+
+	start := 0
+
+	for {
+		p := new(page[T])
+
+		// send API call to capture the page of results
+		_, err := c.call(token, http.MethodGet, fmt.Sprintf("%s?%s", path, query.Encode()), nil, p)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, p.Values...)
+
+		// break the loop if there is no more results to page through
+		if p.IsLastPage || p.NextPageStart <= start {
+			break
+		}
+
+		start = p.NextPageStart
+
+		query.Set("start", fmt.Sprint(start))
+	}
+
+	return results, nil
+}
+
+// repoPath is a helper function to create the API path for a repo.
+func repoPath(org, name string) string {
+	return fmt.Sprintf("%s/projects/%s/repos/%s", api, url.PathEscape(org), url.PathEscape(name))
+}
+
+// isNotFound is a helper function to determine if the
+// error is a not found response from the Bitbucket API.
+func isNotFound(err error) bool {
+	var e *Error
+
+	return errors.As(err, &e) && e.Response.StatusCode == http.StatusNotFound
+}
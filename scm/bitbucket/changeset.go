@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// change represents a file changed for a commit or pull request from Bitbucket.
+type change struct {
+	Path struct {
+		ToString string `json:"toString"`
+	} `json:"path"`
+}
+
+// Changeset captures the list of files changed for a commit.
+func (c *client) Changeset(u *library.User, r *library.Repo, sha string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit changeset for %s/commit/%s", r.GetFullName(), sha)
+
+	// send API call to capture the files changed for the commit
+	changes, err := list[change](c, u.GetToken(), fmt.Sprintf("%s/commits/%s/changes", repoPath(r.GetOrg(), r.GetName()), sha), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return paths(changes), nil
+}
+
+// ChangesetPR captures the list of files changed for a pull request.
+func (c *client) ChangesetPR(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing pull request changeset for %s/pull/%d", r.GetFullName(), number)
+
+	// send API call to capture the files changed for the pull request
+	changes, err := list[change](c, u.GetToken(), fmt.Sprintf("%s/pull-requests/%d/changes", repoPath(r.GetOrg(), r.GetName()), number), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return paths(changes), nil
+}
+
+// paths is a helper function to capture the path for each change.
+func paths(changes []change) []string {
+	s := []string{}
+
+	for _, c := range changes {
+		s = append(s, c.Path.ToString)
+	}
+
+	return s
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestBitbucket_Changeset(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/commits/:sha/changes", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		// page through the results to capture all changes
+		if c.Query("start") == "1" {
+			c.File("testdata/changes_page_2.json")
+
+			return
+		}
+
+		c.File("testdata/changes_page_1.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	want := []string{"README.md", "src/main.go"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Changeset(u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	if err != nil {
+		t.Errorf("Changeset returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Changeset is %v, want %v", got, want)
+	}
+}
+
+func TestBitbucket_ChangesetPR(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/pull-requests/:number/changes", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/changes_page_2.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	want := []string{"src/main.go"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ChangesetPR(u, r, 1)
+	if err != nil {
+		t.Errorf("ChangesetPR returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ChangesetPR is %v, want %v", got, want)
+	}
+}
+
+func TestBitbucket_Changeset_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/commits/:sha/changes", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, err := client.Changeset(u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	if !isNotFound(err) {
+		t.Errorf("Changeset returned err %v, want not found", err)
+	}
+}
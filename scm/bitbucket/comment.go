@@ -0,0 +1,86 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// commentMarker defines the hidden markdown prefixed to
+// comments to identify the comment for a key on updates.
+//
+// Bitbucket escapes HTML in comments so an empty link
+// reference definition is used to hide the marker.
+const commentMarker = "[//]: # (vela:%s)"
+
+// comment represents a pull request comment from Bitbucket.
+type comment struct {
+	ID      int64  `json:"id,omitempty"`
+	Version int    `json:"version"`
+	Text    string `json:"text"`
+}
+
+// activity represents a pull request activity from Bitbucket.
+type activity struct {
+	Action        string  `json:"action"`
+	CommentAction string  `json:"commentAction"`
+	Comment       comment `json:"comment"`
+}
+
+// UpsertPullRequestComment creates or updates the comment
+// identified by the key on a pull request.
+func (c *client) UpsertPullRequestComment(u *library.User, r *library.Repo, number int, key, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("upserting %s comment for %s/pull/%d", key, r.GetFullName(), number)
+
+	path := fmt.Sprintf("%s/pull-requests/%d", repoPath(r.GetOrg(), r.GetName()), number)
+	marker := fmt.Sprintf(commentMarker, key)
+	text := fmt.Sprintf("%s\n%s", marker, body)
+
+	// send API call to capture the activities on the pull request
+	activities, err := list[activity](c, u.GetToken(), fmt.Sprintf("%s/activities", path), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range activities {
+		if a.Action != "COMMENTED" || a.CommentAction != "ADDED" {
+			continue
+		}
+
+		if !strings.HasPrefix(a.Comment.Text, marker) {
+			continue
+		}
+
+		existing := &comment{
+			Version: a.Comment.Version,
+			Text:    text,
+		}
+
+		// send API call to update the existing comment
+		_, err = c.call(u.GetToken(), http.MethodPut, fmt.Sprintf("%s/comments/%d", path, a.Comment.ID), existing, nil)
+
+		return err
+	}
+
+	// send API call to create the comment
+	_, err = c.call(u.GetToken(), http.MethodPost, fmt.Sprintf("%s/comments", path), &comment{Text: text}, nil)
+
+	return err
+}
+
+// CommentOnPR is a no-op for the scm driver since the
+// summary of a build is only commented by the github driver.
+func (c *client) CommentOnPR(u *library.User, r *library.Repo, b *library.Build, steps []*library.Step) error {
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestBitbucket_UpsertPullRequestComment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	var (
+		updated *comment
+		created *comment
+	)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/pull-requests/:number/activities", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Param("number") == "2" {
+			c.File("testdata/empty.json")
+			return
+		}
+
+		c.File("testdata/activities.json")
+	})
+	engine.PUT("/rest/api/1.0/projects/:org/repos/:repo/pull-requests/:number/comments/:id", func(c *gin.Context) {
+		updated = new(comment)
+		updated.ID = 1
+
+		_ = json.NewDecoder(c.Request.Body).Decode(updated)
+
+		c.Status(http.StatusOK)
+	})
+	engine.POST("/rest/api/1.0/projects/:org/repos/:repo/pull-requests/:number/comments", func(c *gin.Context) {
+		created = new(comment)
+
+		_ = json.NewDecoder(c.Request.Body).Decode(created)
+
+		c.Status(http.StatusCreated)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	wantUpdated := &comment{ID: 1, Version: 4, Text: "[//]: # (vela:schema)\nnew report"}
+	wantCreated := &comment{Text: "[//]: # (vela:schema)\nnew report"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.UpsertPullRequestComment(u, r, 1, "schema", "new report")
+	if err != nil {
+		t.Errorf("UpsertPullRequestComment returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, wantUpdated) {
+		t.Errorf("UpsertPullRequestComment updated %v, want %v", updated, wantUpdated)
+	}
+
+	if created != nil {
+		t.Errorf("UpsertPullRequestComment created %v, want nil", created)
+	}
+
+	err = client.UpsertPullRequestComment(u, r, 2, "schema", "new report")
+	if err != nil {
+		t.Errorf("UpsertPullRequestComment returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(created, wantCreated) {
+		t.Errorf("UpsertPullRequestComment created %v, want %v", created, wantCreated)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// GetDirectoryContents gets the contents of every file in a directory from the Bitbucket repo.
+//
+// The files in the directory are captured recursively with a single paged
+// API call but Bitbucket does not provide an API to capture the contents
+// of multiple files at once so the contents of each file are captured
+// with a separate API call.
+//
+// The contents are keyed by the path to the file from the root of the repo
+// and an empty map is returned if the directory does not exist.
+func (c *client) GetDirectoryContents(u *library.User, r *library.Repo, dir, ref string) (map[string][]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing directory contents of %s for %s/commit/%s", dir, r.GetFullName(), ref)
+
+	contents := make(map[string][]byte)
+
+	// clean the directory to prevent escaping the repo
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+
+	query := url.Values{}
+	query.Set("at", ref)
+
+	// send API call to capture the paths to the files in the directory relative to the directory
+	files, err := list[string](c, u.GetToken(), fmt.Sprintf("%s/files/%s", repoPath(r.GetOrg(), r.GetName()), dir), query)
+	if err != nil {
+		if isNotFound(err) {
+			return contents, nil
+		}
+
+		return nil, err
+	}
+
+	for _, file := range files {
+		var data []byte
+
+		name := path.Join(dir, file)
+
+		// send API call to capture the contents of the file
+		_, err = c.call(u.GetToken(), http.MethodGet,
+			fmt.Sprintf("%s/raw/%s?at=%s", repoPath(r.GetOrg(), r.GetName()), name, url.QueryEscape(ref)), nil, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		contents[name] = data
+	}
+
+	return contents, nil
+}
+
+// GetFile gets the contents of a single file from the Bitbucket repo.
+//
+// The contents are nil if the file does not exist for the ref.
+func (c *client) GetFile(u *library.User, r *library.Repo, file, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing file %s for %s/commit/%s", file, r.GetFullName(), ref)
+
+	var data []byte
+
+	// send API call to capture the file
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/raw/%s?at=%s", repoPath(r.GetOrg(), r.GetName()), file, url.QueryEscape(ref)), nil, &data)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return data, nil
+}
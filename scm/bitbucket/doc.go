@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package bitbucket provides the ability for Vela to
+// integrate with Bitbucket Data Center or Bitbucket Server
+// as a scm provider.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scm/bitbucket"
+package bitbucket
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+// DriverBitbucket defines the driver type when integrating with a Bitbucket scm.
+const DriverBitbucket = "bitbucket"
+
+// Driver outputs the configured scm driver.
+func (c *client) Driver() string {
+	return DriverBitbucket
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClientOpt represents a configuration option to initialize the scm client for Bitbucket.
+type ClientOpt func(*client) error
+
+// WithAddress sets the Bitbucket address in the scm client for Bitbucket.
+func WithAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring address in bitbucket scm client")
+
+		// check if the address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no Bitbucket address provided")
+		}
+
+		// check if the address provided is for Bitbucket Cloud
+		//
+		// Bitbucket Cloud does not provide the REST API
+		// for Bitbucket Data Center and Bitbucket Server
+		if strings.Contains(address, "://bitbucket.org") {
+			return fmt.Errorf("Bitbucket Cloud is not supported, provide a Bitbucket Data Center or Server address")
+		}
+
+		// set the address in the bitbucket client
+		c.config.Address = strings.TrimSuffix(address, "/")
+
+		return nil
+	}
+}
+
+// WithClientID sets the OAuth client ID in the scm client for Bitbucket.
+func WithClientID(id string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring OAuth client ID in bitbucket scm client")
+
+		// check if the OAuth client ID provided is empty
+		if len(id) == 0 {
+			return fmt.Errorf("no Bitbucket OAuth client ID provided")
+		}
+
+		// set the OAuth client ID in the bitbucket client
+		c.config.ClientID = id
+
+		return nil
+	}
+}
+
+// WithClientSecret sets the OAuth client secret in the scm client for Bitbucket.
+func WithClientSecret(secret string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring OAuth client secret in bitbucket scm client")
+
+		// check if the OAuth client secret provided is empty
+		if len(secret) == 0 {
+			return fmt.Errorf("no Bitbucket OAuth client secret provided")
+		}
+
+		// set the OAuth client secret in the bitbucket client
+		c.config.ClientSecret = secret
+
+		return nil
+	}
+}
+
+// WithServerAddress sets the Vela server address in the scm client for Bitbucket.
+func WithServerAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring Vela server address in bitbucket scm client")
+
+		// check if the Vela server address provided is empty
+		if len(address) == 0 {
+			return fmt.Errorf("no Vela server address provided")
+		}
+
+		// set the Vela server address in the bitbucket client
+		c.config.ServerAddress = address
+
+		return nil
+	}
+}
+
+// WithServerWebhookAddress sets the Vela server webhook address in the scm client for Bitbucket.
+func WithServerWebhookAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring Vela server webhook address in bitbucket scm client")
+
+		// fallback to Vela server address if the provided Vela server webhook address is empty
+		if len(address) == 0 {
+			c.config.ServerWebhookAddress = c.config.ServerAddress
+			return nil
+		}
+
+		// set the Vela server webhook address in the bitbucket client
+		c.config.ServerWebhookAddress = address
+
+		return nil
+	}
+}
+
+// WithStatusContext sets the context for commit statuses in the scm client for Bitbucket.
+func WithStatusContext(context string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring context for commit statuses in bitbucket scm client")
+
+		// check if the context for the commit statuses provided is empty
+		if len(context) == 0 {
+			return fmt.Errorf("no Bitbucket context for commit statuses provided")
+		}
+
+		// set the context for the commit status in the bitbucket client
+		c.config.StatusContext = context
+
+		return nil
+	}
+}
+
+// WithWebUIAddress sets the Vela web UI address in the scm client for Bitbucket.
+func WithWebUIAddress(address string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring Vela web UI address in bitbucket scm client")
+
+		// set the Vela web UI address in the bitbucket client
+		c.config.WebUIAddress = address
+
+		return nil
+	}
+}
+
+// WithScopes sets the OAuth scopes in the scm client for Bitbucket.
+func WithScopes(scopes []string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring oauth scopes in bitbucket scm client")
+
+		// check if the scopes provided is empty
+		if len(scopes) == 0 {
+			return fmt.Errorf("no Bitbucket OAuth scopes provided")
+		}
+
+		// set the scopes in the bitbucket client
+		c.config.Scopes = scopes
+
+		return nil
+	}
+}
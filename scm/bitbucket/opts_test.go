@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBitbucket_ClientOpt_WithAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		address string
+		want    string
+	}{
+		{
+			failure: false,
+			address: "https://bitbucket.example.com/",
+			want:    "https://bitbucket.example.com",
+		},
+		{
+			failure: false,
+			address: "https://bitbucket.example.com",
+			want:    "https://bitbucket.example.com",
+		},
+		{
+			failure: true,
+			address: "https://bitbucket.org",
+		},
+		{
+			failure: true,
+			address: "",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithAddress(test.address),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithAddress should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithAddress returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Address, test.want) {
+			t.Errorf("WithAddress is %v, want %v", _service.config.Address, test.want)
+		}
+	}
+}
+
+func TestBitbucket_ClientOpt_WithServerWebhookAddress(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{
+			address: "https://vela-hooks.example.com",
+			want:    "https://vela-hooks.example.com",
+		},
+		{
+			address: "",
+			want:    "https://vela-server.example.com",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithServerAddress("https://vela-server.example.com"),
+			WithServerWebhookAddress(test.address),
+		)
+		if err != nil {
+			t.Errorf("WithServerWebhookAddress returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.ServerWebhookAddress, test.want) {
+			t.Errorf("WithServerWebhookAddress is %v, want %v", _service.config.ServerWebhookAddress, test.want)
+		}
+	}
+}
+
+func TestBitbucket_ClientOpt_Required(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		opt  ClientOpt
+	}{
+		{name: "client id", opt: WithClientID("")},
+		{name: "client secret", opt: WithClientSecret("")},
+		{name: "server address", opt: WithServerAddress("")},
+		{name: "status context", opt: WithStatusContext("")},
+		{name: "scopes", opt: WithScopes([]string{})},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(test.opt)
+			if err == nil {
+				t.Errorf("New should have returned err")
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// GetOrgName gets org name from Bitbucket.
+//
+// Orgs are represented by projects in Bitbucket.
+func (c *client) GetOrgName(u *library.User, o string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Tracef("retrieving org information for %s", o)
+
+	p := new(project)
+
+	// send an API call to get the project info
+	_, err := c.call(u.GetToken(), http.MethodGet, fmt.Sprintf("%s/projects/%s", api, url.PathEscape(o)), nil, p)
+	if err != nil {
+		// if project is not found, return the personal project
+		if isNotFound(err) {
+			return "~" + u.GetName(), nil
+		}
+
+		return "", err
+	}
+
+	return p.Key, nil
+}
+
+// EnableOrg creates the webhook for all repos in the org.
+//
+// Bitbucket does not support org webhooks.
+func (c *client) EnableOrg(u *library.User, org, secret string) (int64, error) {
+	return 0, fmt.Errorf("org webhooks are not supported by the %s scm driver", DriverBitbucket)
+}
+
+// DisableOrg deletes the webhook for all repos in the org.
+//
+// Bitbucket does not support org webhooks.
+func (c *client) DisableOrg(u *library.User, org string, webhookID int64) error {
+	return fmt.Errorf("org webhooks are not supported by the %s scm driver", DriverBitbucket)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestBitbucket_GetOrgName(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org", func(c *gin.Context) {
+		if c.Param("org") != "github" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/project.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		org  string
+		want string
+	}{
+		{org: "github", want: "GITHUB"},
+		{org: "octocat", want: "~octocat"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.GetOrgName(u, test.org)
+		if err != nil {
+			t.Errorf("GetOrgName for %s returned err: %v", test.org, err)
+		}
+
+		if got != test.want {
+			t.Errorf("GetOrgName for %s is %v, want %v", test.org, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// participant represents a participant
+// in a pull request from Bitbucket.
+type participant struct {
+	User     user   `json:"user"`
+	Approved bool   `json:"approved"`
+	Status   string `json:"status"`
+}
+
+// pullRequest represents a pull request from Bitbucket.
+type pullRequest struct {
+	ID        int           `json:"id"`
+	Title     string        `json:"title"`
+	State     string        `json:"state"`
+	FromRef   ref           `json:"fromRef"`
+	ToRef     ref           `json:"toRef"`
+	Author    participant   `json:"author"`
+	Reviewers []participant `json:"reviewers"`
+	Links     struct {
+		Self []link `json:"self"`
+	} `json:"links"`
+}
+
+// GetPullRequest defines a function that retrieves
+// a pull request for a repo.
+func (c *client) GetPullRequest(u *library.User, r *library.Repo, number int) (string, string, string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving pull request %d for repo %s", number, r.GetFullName())
+
+	pull := new(pullRequest)
+
+	// send API call to capture the pull request
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/pull-requests/%d", repoPath(r.GetOrg(), r.GetName()), number), nil, pull)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	commit := pull.FromRef.LatestCommit
+	branch := pull.ToRef.DisplayID
+	baseref := pull.ToRef.DisplayID
+	headref := pull.FromRef.DisplayID
+
+	return commit, branch, baseref, headref, nil
+}
+
+// ListPullRequestLabels captures the names of the labels applied to a pull request.
+//
+// Bitbucket does not support labels for pull requests.
+func (c *client) ListPullRequestLabels(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing labels for %s/pull/%d", r.GetFullName(), number)
+
+	return []string{}, nil
+}
+
+// ListPullRequestReviews captures the reviewers and number of approvals for a pull request.
+func (c *client) ListPullRequestReviews(u *library.User, r *library.Repo, number int) ([]string, int, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing reviews for %s/pull/%d", r.GetFullName(), number)
+
+	reviewers, decisions, err := c.listReviewDecisions(u, r, number)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	approvals := 0
+
+	for _, decision := range decisions {
+		if decision == "APPROVED" {
+			approvals++
+		}
+	}
+
+	return reviewers, approvals, nil
+}
+
+// ListPullRequestApprovers captures the reviewers whose
+// latest decision approves a pull request.
+func (c *client) ListPullRequestApprovers(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing approvers for %s/pull/%d", r.GetFullName(), number)
+
+	reviewers, decisions, err := c.listReviewDecisions(u, r, number)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := []string{}
+
+	for _, reviewer := range reviewers {
+		if decisions[reviewer] == "APPROVED" {
+			approvers = append(approvers, reviewer)
+		}
+	}
+
+	return approvers, nil
+}
+
+// ListCommitPullRequests captures the numbers of the pull requests associated with a commit.
+func (c *client) ListCommitPullRequests(u *library.User, r *library.Repo, sha string) ([]int, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing pull requests for %s commit %s", r.GetFullName(), sha)
+
+	// send API call to capture the pull requests for the commit
+	pulls, err := list[pullRequest](c, u.GetToken(),
+		fmt.Sprintf("%s/commits/%s/pull-requests", repoPath(r.GetOrg(), r.GetName()), sha), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	numbers := []int{}
+
+	for _, pull := range pulls {
+		numbers = append(numbers, pull.ID)
+	}
+
+	return numbers, nil
+}
+
+// listReviewDecisions is a helper function to capture the reviewers and
+// their latest decision for a pull request. The decisions are converted
+// to the review states used by the other scm providers.
+func (c *client) listReviewDecisions(u *library.User, r *library.Repo, number int) ([]string, map[string]string, error) {
+	pull := new(pullRequest)
+
+	// send API call to capture the pull request with its reviewers
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/pull-requests/%d", repoPath(r.GetOrg(), r.GetName()), number), nil, pull)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewers := []string{}
+	decisions := make(map[string]string)
+
+	for _, reviewer := range pull.Reviewers {
+		reviewers = append(reviewers, reviewer.User.Name)
+
+		switch reviewer.Status {
+		case "APPROVED":
+			decisions[reviewer.User.Name] = "APPROVED"
+		case "NEEDS_WORK":
+			decisions[reviewer.User.Name] = "CHANGES_REQUESTED"
+		default:
+			decisions[reviewer.User.Name] = ""
+		}
+	}
+
+	return reviewers, decisions, nil
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestBitbucket_GetPullRequest(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/pull-requests/:number", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/pull_request.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	commit, branch, baseref, headref, err := client.GetPullRequest(u, r, 1)
+	if err != nil {
+		t.Errorf("GetPullRequest returned err: %v", err)
+	}
+
+	if commit != "34c5c7793cb3b279e22454cb6750c80560547b3a" {
+		t.Errorf("GetPullRequest commit is %v, want 34c5c7793cb3b279e22454cb6750c80560547b3a", commit)
+	}
+
+	if branch != "main" {
+		t.Errorf("GetPullRequest branch is %v, want main", branch)
+	}
+
+	if baseref != "main" {
+		t.Errorf("GetPullRequest baseref is %v, want main", baseref)
+	}
+
+	if headref != "changes" {
+		t.Errorf("GetPullRequest headref is %v, want changes", headref)
+	}
+}
+
+func TestBitbucket_ListPullRequestReviews(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/pull-requests/:number", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/pull_request.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	wantReviewers := []string{"hubot", "monalisa", "octokitten"}
+	wantApprovers := []string{"hubot"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	reviewers, approvals, err := client.ListPullRequestReviews(u, r, 1)
+	if err != nil {
+		t.Errorf("ListPullRequestReviews returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(reviewers, wantReviewers) {
+		t.Errorf("ListPullRequestReviews reviewers is %v, want %v", reviewers, wantReviewers)
+	}
+
+	if approvals != 1 {
+		t.Errorf("ListPullRequestReviews approvals is %v, want 1", approvals)
+	}
+
+	approvers, err := client.ListPullRequestApprovers(u, r, 1)
+	if err != nil {
+		t.Errorf("ListPullRequestApprovers returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(approvers, wantApprovers) {
+		t.Errorf("ListPullRequestApprovers is %v, want %v", approvers, wantApprovers)
+	}
+}
+
+func TestBitbucket_ListPullRequestLabels(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	client, _ := NewTest("https://bitbucket.example.com")
+
+	// run test
+	got, err := client.ListPullRequestLabels(u, r, 1)
+	if err != nil {
+		t.Errorf("ListPullRequestLabels returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("ListPullRequestLabels is %v, want empty", got)
+	}
+}
+
+func TestBitbucket_ListCommitPullRequests(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/commits/:sha/pull-requests", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/commit_pull_requests.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	want := []int{1, 2}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListCommitPullRequests(u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	if err != nil {
+		t.Errorf("ListCommitPullRequests returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListCommitPullRequests is %v, want %v", got, want)
+	}
+}
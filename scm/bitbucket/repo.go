@@ -0,0 +1,553 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// project represents a project from Bitbucket.
+type project struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// link represents a link to a resource from Bitbucket.
+type link struct {
+	Href string `json:"href"`
+	Name string `json:"name"`
+}
+
+// repository represents a repo from Bitbucket.
+type repository struct {
+	Slug     string  `json:"slug"`
+	Name     string  `json:"name"`
+	Public   bool    `json:"public"`
+	Archived bool    `json:"archived"`
+	Project  project `json:"project"`
+	Links    struct {
+		Clone []link `json:"clone"`
+		Self  []link `json:"self"`
+	} `json:"links"`
+}
+
+// ref represents a branch or tag from Bitbucket.
+type ref struct {
+	ID           string      `json:"id"`
+	DisplayID    string      `json:"displayId"`
+	Type         string      `json:"type"`
+	LatestCommit string      `json:"latestCommit"`
+	Repository   *repository `json:"repository,omitempty"`
+}
+
+// webhook represents a repo webhook from Bitbucket.
+type webhook struct {
+	ID            int64             `json:"id,omitempty"`
+	Name          string            `json:"name"`
+	CreatedDate   int64             `json:"createdDate,omitempty"`
+	Events        []string          `json:"events"`
+	Configuration map[string]string `json:"configuration"`
+	URL           string            `json:"url"`
+	Active        bool              `json:"active"`
+}
+
+// buildStatus represents a build status for a commit in Bitbucket.
+type buildStatus struct {
+	State       string `json:"state"`
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
+
+// ConfigBackoff is a wrapper for Config that will retry five times if the function
+// fails to retrieve the yaml/yml file.
+func (c *client) ConfigBackoff(u *library.User, r *library.Repo, ref string) (data []byte, err error) {
+	// number of times to retry
+	retryLimit := 5
+
+	for i := 0; i < retryLimit; i++ {
+		logrus.Debugf("Fetching config file - Attempt %d", i+1)
+		// attempt to fetch the config
+		data, err = c.Config(u, r, ref)
+
+		// return err if the last attempt returns error
+		if err != nil && i == retryLimit-1 {
+			return
+		}
+
+		// if data is valid break the retry loop
+		if data != nil {
+			break
+		}
+
+		// sleep in between retries
+		sleep := time.Duration(i+1) * time.Second
+		time.Sleep(sleep)
+	}
+
+	return
+}
+
+// Config gets the pipeline configuration from the Bitbucket repo.
+func (c *client) Config(u *library.User, r *library.Repo, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing configuration file for %s/commit/%s", r.GetFullName(), ref)
+
+	files := []string{".vela.yml", ".vela.yaml"}
+
+	if strings.EqualFold(r.GetPipelineType(), constants.PipelineTypeStarlark) {
+		files = append(files, ".vela.star", ".vela.py")
+	}
+
+	for _, file := range files {
+		var data []byte
+
+		// send API call to capture the .vela.yml pipeline configuration
+		_, err := c.call(u.GetToken(), http.MethodGet,
+			fmt.Sprintf("%s/raw/%s?at=%s", repoPath(r.GetOrg(), r.GetName()), file, url.QueryEscape(ref)), nil, &data)
+		if err != nil {
+			if !isNotFound(err) {
+				return nil, err
+			}
+
+			continue
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("no valid pipeline configuration file (%s) found", strings.Join(files, ","))
+}
+
+// GetCommitSHA captures the commit SHA a ref points to from the Bitbucket repo.
+func (c *client) GetCommitSHA(u *library.User, r *library.Repo, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit for %s/commit/%s", r.GetFullName(), ref)
+
+	commit := new(struct {
+		ID string `json:"id"`
+	})
+
+	// send API call to capture the commit for the ref
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/commits/%s", repoPath(r.GetOrg(), r.GetName()), url.PathEscape(ref)), nil, commit)
+	if err != nil {
+		return "", err
+	}
+
+	return commit.ID, nil
+}
+
+// Disable deactivates a repo by deleting the webhook.
+func (c *client) Disable(u *library.User, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": name,
+		"user": u.GetName(),
+	}).Tracef("deleting repository webhooks for %s/%s", org, name)
+
+	// send API call to capture the hooks for the repo
+	hooks, err := list[webhook](c, u.GetToken(), fmt.Sprintf("%s/webhooks", repoPath(org, name)), nil)
+	if err != nil {
+		return err
+	}
+
+	// accounting for situations in which multiple hooks have been
+	// associated with this vela instance, which causes some
+	// disable, repair, enable operations to act in undesirable ways
+	var ids []int64
+
+	// iterate through each element in the hooks
+	for _, hook := range hooks {
+		// capture hook ID if the hook url matches
+		if hook.URL == fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress) {
+			ids = append(ids, hook.ID)
+		}
+	}
+
+	// skip if we have no hook IDs
+	if len(ids) == 0 {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": name,
+			"user": u.GetName(),
+		}).Warnf("no repository webhooks matching %s/webhook found for %s/%s", c.config.ServerWebhookAddress, org, name)
+
+		return nil
+	}
+
+	// go through all found hook IDs and delete them
+	for _, id := range ids {
+		// send API call to delete the webhook
+		_, err = c.call(u.GetToken(), http.MethodDelete, fmt.Sprintf("%s/webhooks/%d", repoPath(org, name), id), nil, nil)
+	}
+
+	return err
+}
+
+// Enable activates a repo by creating the webhook.
+func (c *client) Enable(u *library.User, r *library.Repo) (*library.Hook, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	hookInfo := new(webhook)
+
+	// send API call to create the webhook
+	resp, err := c.call(u.GetToken(), http.MethodPost,
+		fmt.Sprintf("%s/webhooks", repoPath(r.GetOrg(), r.GetName())), c.newWebhook(r), hookInfo)
+	if err != nil {
+		switch {
+		case resp == nil:
+			return nil, "", err
+		case resp.StatusCode == http.StatusConflict:
+			return nil, "", fmt.Errorf("repo already enabled")
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, "", fmt.Errorf("repo not found")
+		}
+
+		return nil, "", err
+	}
+
+	// create the first hook for the repo and record its ID from Bitbucket
+	hook := new(library.Hook)
+	hook.SetWebhookID(hookInfo.ID)
+	hook.SetSourceID(r.GetName() + "-" + eventInitialize)
+	hook.SetCreated(time.UnixMilli(hookInfo.CreatedDate).Unix())
+	hook.SetEvent(eventInitialize)
+	hook.SetNumber(1)
+
+	// create the URL for the repo
+	url := fmt.Sprintf("%s/projects/%s/repos/%s/browse", c.config.Address, r.GetOrg(), r.GetName())
+
+	return hook, url, nil
+}
+
+// Update edits a repo webhook.
+func (c *client) Update(u *library.User, r *library.Repo, hookID int64) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("updating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	// send API call to update the webhook
+	_, err := c.call(u.GetToken(), http.MethodPut,
+		fmt.Sprintf("%s/webhooks/%d", repoPath(r.GetOrg(), r.GetName()), hookID), c.newWebhook(r), nil)
+
+	return err
+}
+
+// newWebhook is a helper function to create the
+// webhook for the events allowed for the repo.
+func (c *client) newWebhook(r *library.Repo) *webhook {
+	// always listen to repository events in case of repo name change
+	events := []string{eventRepoModified}
+
+	if r.GetAllowComment() {
+		events = append(events, eventPRCommentAdded, eventPRCommentEdited)
+	}
+
+	// always listen to closed pull requests so the
+	// resources created for them can be cleaned up
+	if r.GetAllowPull() {
+		events = append(events, eventPROpened, eventPRUpdated, eventPRMerged, eventPRDeclined, eventPRDeleted)
+	}
+
+	if r.GetAllowPush() || r.GetAllowTag() {
+		events = append(events, eventRefsChanged)
+	}
+
+	return &webhook{
+		Name:   "Vela",
+		Events: events,
+		Configuration: map[string]string{
+			"secret": r.GetHash(),
+		},
+		URL:    fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress),
+		Active: true,
+	}
+}
+
+// Status sends the commit status for the given SHA from the Bitbucket repo.
+//
+// Bitbucket does not support deployments so the status
+// for deployment builds is sent for the commit as well.
+func (c *client) Status(u *library.User, b *library.Build, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("setting commit status for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
+
+	context := fmt.Sprintf("%s/%s", c.config.StatusContext, b.GetEvent())
+
+	// Bitbucket requires a link for every build status
+	url := fmt.Sprintf("%s/%s/%s/%d", c.config.ServerAddress, org, name, b.GetNumber())
+	if len(c.config.WebUIAddress) > 0 {
+		url = fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
+	}
+
+	var (
+		state       string
+		description string
+	)
+
+	// set the state and description for the status context
+	// depending on what the status of the build is
+	switch b.GetStatus() {
+	case constants.StatusRunning, constants.StatusPending:
+		state = "INPROGRESS"
+		description = fmt.Sprintf("the build is %s", b.GetStatus())
+	case constants.StatusSuccess:
+		state = "SUCCESSFUL"
+		description = "the build was successful"
+	case constants.StatusFailure:
+		state = "FAILED"
+		description = "the build has failed"
+	case constants.StatusCanceled:
+		state = "FAILED"
+		description = "the build was canceled"
+	case constants.StatusKilled:
+		state = "FAILED"
+		description = "the build was killed"
+	case constants.StatusSkipped:
+		state = "SUCCESSFUL"
+		description = "build was skipped as no steps/stages found"
+	default:
+		state = "FAILED"
+		description = "there was an error"
+	}
+
+	// create the status object to make the API call
+	status := &buildStatus{
+		State:       state,
+		Key:         context,
+		Name:        context,
+		URL:         url,
+		Description: description,
+	}
+
+	// send API call to create the build status for the commit
+	_, err := c.call(u.GetToken(), http.MethodPost,
+		fmt.Sprintf("/rest/build-status/1.0/commits/%s", b.GetCommit()), status, nil)
+
+	return err
+}
+
+// ReportSteps is a no-op for the scm driver since the steps
+// of a build are only reported with the GitHub Checks API.
+func (c *client) ReportSteps(u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	return nil
+}
+
+// ProtectBranch configures the branch protection for a branch of the repo.
+//
+// Bitbucket does not support requiring status contexts with branch protection.
+func (c *client) ProtectBranch(u *library.User, r *library.Repo, branch string, contexts []string, strict bool) error {
+	return fmt.Errorf("protecting branches is not supported by the %s scm driver", DriverBitbucket)
+}
+
+// GetRepo gets repo information from Bitbucket.
+func (c *client) GetRepo(u *library.User, r *library.Repo) (*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s", r.GetFullName())
+
+	repo := new(repository)
+
+	// send an API call to get the repo info
+	_, err := c.call(u.GetToken(), http.MethodGet, repoPath(r.GetOrg(), r.GetName()), nil, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	branch := new(ref)
+
+	// send an API call to get the default branch for the repo
+	_, err = c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/branches/default", repoPath(r.GetOrg(), r.GetName())), nil, branch)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	result := c.toLibraryRepo(repo)
+	result.SetBranch(branch.DisplayID)
+
+	return result, nil
+}
+
+// GetRepoSync captures the state of the repo in Bitbucket to sync it with
+// the database. Bitbucket doesn't redirect requests for a repo that was
+// renamed or moved to another project, so those repos appear deleted.
+// A nil state is returned when the repo was deleted. Bitbucket doesn't
+// support topics, so no topics are captured for the repo.
+func (c *client) GetRepoSync(u *library.User, r *library.Repo) (*types.RepoSync, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing repository state for %s", r.GetFullName())
+
+	repo := new(repository)
+
+	// send an API call to get the repo info
+	_, err := c.call(u.GetToken(), http.MethodGet, repoPath(r.GetOrg(), r.GetName()), nil, repo)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	branch := new(ref)
+
+	// send an API call to get the default branch for the repo
+	_, err = c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/branches/default", repoPath(r.GetOrg(), r.GetName())), nil, branch)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+
+	result := c.toLibraryRepo(repo)
+
+	s := new(types.RepoSync)
+	s.SetOrg(result.GetOrg())
+	s.SetName(result.GetName())
+	s.SetLink(result.GetLink())
+	s.SetClone(result.GetClone())
+	s.SetBranch(branch.DisplayID)
+	s.SetPrivate(result.GetPrivate())
+	s.SetArchived(repo.Archived)
+	s.SetTopics([]string{})
+
+	return s, nil
+}
+
+// GetOrgAndRepoName returns the name of the org and the repository in the SCM.
+func (c *client) GetOrgAndRepoName(u *library.User, o string, r string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r,
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s/%s", o, r)
+
+	repo := new(repository)
+
+	// send an API call to get the repo info
+	_, err := c.call(u.GetToken(), http.MethodGet, repoPath(o, r), nil, repo)
+	if err != nil {
+		return "", "", err
+	}
+
+	return repo.Project.Key, repo.Slug, nil
+}
+
+// ListUserRepos returns a list of all repos the user has access to.
+func (c *client) ListUserRepos(u *library.User) ([]*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("listing source repositories for %s", u.GetName())
+
+	query := url.Values{}
+	query.Set("permission", "REPO_ADMIN")
+
+	// send API call to capture the user's repos
+	repos, err := list[repository](c, u.GetToken(), fmt.Sprintf("%s/repos", api), query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list user repos: %w", err)
+	}
+
+	f := []*library.Repo{}
+
+	// iterate through each repo for the user
+	for i := range repos {
+		// skip if the repo is archived
+		if repos[i].Archived {
+			continue
+		}
+
+		f = append(f, c.toLibraryRepo(&repos[i]))
+	}
+
+	return f, nil
+}
+
+// toLibraryRepo does a partial conversion of a bitbucket repo to a library repo.
+func (c *client) toLibraryRepo(br *repository) *library.Repo {
+	r := new(library.Repo)
+	r.SetOrg(br.Project.Key)
+	r.SetName(br.Slug)
+	r.SetFullName(fmt.Sprintf("%s/%s", br.Project.Key, br.Slug))
+	r.SetLink(fmt.Sprintf("%s/projects/%s/repos/%s/browse", c.config.Address, br.Project.Key, br.Slug))
+	r.SetClone(fmt.Sprintf("%s/scm/%s/%s.git", c.config.Address, strings.ToLower(br.Project.Key), br.Slug))
+	r.SetPrivate(!br.Public)
+
+	if len(br.Links.Self) > 0 {
+		r.SetLink(br.Links.Self[0].Href)
+	}
+
+	for _, l := range br.Links.Clone {
+		if l.Name != "http" {
+			continue
+		}
+
+		// remove the user Bitbucket adds to the clone url for the requester
+		clone, err := url.Parse(l.Href)
+		if err == nil {
+			clone.User = nil
+
+			r.SetClone(clone.String())
+		}
+	}
+
+	return r
+}
+
+// GetHTMLURL retrieves the html_url from repository contents from the Bitbucket repo.
+func (c *client) GetHTMLURL(u *library.User, org, repo, name, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing html_url for %s/%s/%s@%s", org, repo, name, ref)
+
+	// send API call to verify the repository contents for org/repo/name exist at the ref provided
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/browse/%s?at=%s&limit=1", repoPath(org, repo), name, url.QueryEscape(ref)), nil, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return "", fmt.Errorf("no valid repository contents found")
+		}
+
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/projects/%s/repos/%s/browse/%s?at=%s",
+		c.config.Address, org, repo, name, url.QueryEscape(ref)), nil
+}
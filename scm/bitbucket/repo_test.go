@@ -0,0 +1,682 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestBitbucket_Config_YML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/raw/:path", func(c *gin.Context) {
+		if c.Param("path") != ".vela.yml" || c.Query("at") != "123abc" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(u, r, "123abc")
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", string(got), string(want))
+	}
+}
+
+func TestBitbucket_Config_YAML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/raw/:path", func(c *gin.Context) {
+		if c.Param("path") != ".vela.yaml" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(u, r, "123abc")
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", string(got), string(want))
+	}
+}
+
+func TestBitbucket_Config_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/raw/:path", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(u, r, "123abc")
+	if err == nil {
+		t.Error("Config should have returned err")
+	}
+
+	if got != nil {
+		t.Errorf("Config is %v, want nil", got)
+	}
+}
+
+func TestBitbucket_GetCommitSHA(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/commits/:sha", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"id": "6dcb09b5b57875f334f61aebed695e2e4193db5e"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	want := "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetCommitSHA(u, r, "main")
+	if err != nil {
+		t.Errorf("GetCommitSHA returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetCommitSHA is %v, want %v", got, want)
+	}
+}
+
+func TestBitbucket_GetCommitSHA_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/commits/:sha", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, err := client.GetCommitSHA(u, r, "missing")
+	if err == nil {
+		t.Error("GetCommitSHA should have returned err")
+	}
+}
+
+func TestBitbucket_Disable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	deleted := []string{}
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/webhooks", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/webhooks.json")
+	})
+	engine.DELETE("/rest/api/1.0/projects/:org/repos/:repo/webhooks/:id", func(c *gin.Context) {
+		deleted = append(deleted, c.Param("id"))
+
+		c.Status(http.StatusNoContent)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := []string{"1"}
+
+	client, _ := NewTest(s.URL)
+	client.config.ServerWebhookAddress = "https://foo.bar.com"
+
+	// run test
+	err := client.Disable(u, "GITHUB", "octocat")
+	if err != nil {
+		t.Errorf("Disable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(deleted, want) {
+		t.Errorf("Disable deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestBitbucket_Enable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	got := new(webhook)
+
+	// setup mock server
+	engine.POST("/rest/api/1.0/projects/:org/repos/:repo/webhooks", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(got)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/webhook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+	r.SetHash("secret")
+	r.SetAllowPush(true)
+	r.SetAllowPull(true)
+
+	wantHook := new(library.Hook)
+	wantHook.SetWebhookID(1)
+	wantHook.SetSourceID("octocat-initialize")
+	wantHook.SetCreated(time.UnixMilli(1513106011000).Unix())
+	wantHook.SetEvent("initialize")
+	wantHook.SetNumber(1)
+
+	wantWebhook := &webhook{
+		Name: "Vela",
+		Events: []string{
+			eventRepoModified,
+			eventPROpened, eventPRUpdated, eventPRMerged, eventPRDeclined, eventPRDeleted,
+			eventRefsChanged,
+		},
+		Configuration: map[string]string{"secret": "secret"},
+		URL:           "https://foo.bar.com/webhook",
+		Active:        true,
+	}
+
+	client, _ := NewTest(s.URL)
+	client.config.ServerWebhookAddress = "https://foo.bar.com"
+
+	// run test
+	hook, url, err := client.Enable(u, r)
+	if err != nil {
+		t.Errorf("Enable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(hook, wantHook) {
+		t.Errorf("Enable hook is %v, want %v", hook, wantHook)
+	}
+
+	if url != s.URL+"/projects/GITHUB/repos/octocat/browse" {
+		t.Errorf("Enable url is %v, want %v", url, s.URL+"/projects/GITHUB/repos/octocat/browse")
+	}
+
+	if !reflect.DeepEqual(got, wantWebhook) {
+		t.Errorf("Enable webhook is %v, want %v", got, wantWebhook)
+	}
+}
+
+func TestBitbucket_Enable_Conflict(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.POST("/rest/api/1.0/projects/:org/repos/:repo/webhooks", func(c *gin.Context) {
+		c.Status(http.StatusConflict)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, _, err := client.Enable(u, r)
+	if err == nil || err.Error() != "repo already enabled" {
+		t.Errorf("Enable returned err %v, want repo already enabled", err)
+	}
+}
+
+func TestBitbucket_Update(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.PUT("/rest/api/1.0/projects/:org/repos/:repo/webhooks/:id", func(c *gin.Context) {
+		if c.Param("id") != "1" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/webhook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+	r.SetAllowPush(true)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.Update(u, r, 1)
+	if err != nil {
+		t.Errorf("Update returned err: %v", err)
+	}
+
+	err = client.Update(u, r, 2)
+	if err == nil {
+		t.Error("Update should have returned err")
+	}
+}
+
+func TestBitbucket_Status(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	got := new(buildStatus)
+
+	// setup mock server
+	engine.POST("/rest/build-status/1.0/commits/:sha", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(got)
+
+		c.Status(http.StatusNoContent)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	// setup tests
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{status: constants.StatusRunning, want: "INPROGRESS"},
+		{status: constants.StatusSuccess, want: "SUCCESSFUL"},
+		{status: constants.StatusFailure, want: "FAILED"},
+		{status: constants.StatusCanceled, want: "FAILED"},
+		{status: constants.StatusSkipped, want: "SUCCESSFUL"},
+		{status: constants.StatusError, want: "FAILED"},
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	for _, test := range tests {
+		b := new(library.Build)
+		b.SetNumber(1)
+		b.SetEvent(constants.EventPush)
+		b.SetStatus(test.status)
+		b.SetCommit("6dcb09b5b57875f334f61aebed695e2e4193db5e")
+
+		err := client.Status(u, b, "GITHUB", "octocat")
+		if err != nil {
+			t.Errorf("Status for %s returned err: %v", test.status, err)
+		}
+
+		if got.State != test.want {
+			t.Errorf("Status for %s is %v, want %v", test.status, got.State, test.want)
+		}
+
+		if got.Key != "continuous-integration/vela/push" {
+			t.Errorf("Status key is %v, want continuous-integration/vela/push", got.Key)
+		}
+
+		if got.URL != s.URL+"/GITHUB/octocat/1" {
+			t.Errorf("Status url is %v, want %v", got.URL, s.URL+"/GITHUB/octocat/1")
+		}
+	}
+}
+
+func TestBitbucket_GetRepo(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/repo.json")
+	})
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/branches/default", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/default_branch.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+
+	want := new(library.Repo)
+	want.SetOrg("GITHUB")
+	want.SetName("octocat")
+	want.SetFullName("GITHUB/octocat")
+	want.SetLink("https://bitbucket.example.com/projects/GITHUB/repos/octocat/browse")
+	want.SetClone("https://bitbucket.example.com/scm/github/octocat.git")
+	want.SetBranch("main")
+	want.SetPrivate(true)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetRepo(u, r)
+	if err != nil {
+		t.Errorf("GetRepo returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRepo is %v, want %v", got, want)
+	}
+}
+
+func TestBitbucket_GetOrgAndRepoName(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/repo.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	org, name, err := client.GetOrgAndRepoName(u, "github", "octocat")
+	if err != nil {
+		t.Errorf("GetOrgAndRepoName returned err: %v", err)
+	}
+
+	if org != "GITHUB" {
+		t.Errorf("GetOrgAndRepoName org is %v, want GITHUB", org)
+	}
+
+	if name != "octocat" {
+		t.Errorf("GetOrgAndRepoName name is %v, want octocat", name)
+	}
+}
+
+func TestBitbucket_ListUserRepos(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/repos", func(c *gin.Context) {
+		if c.Query("permission") != "REPO_ADMIN" {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/repos.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+	r.SetFullName("GITHUB/octocat")
+	r.SetLink("https://bitbucket.example.com/projects/GITHUB/repos/octocat/browse")
+	r.SetClone("https://bitbucket.example.com/scm/github/octocat.git")
+	r.SetPrivate(true)
+
+	want := []*library.Repo{r}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUserRepos(u)
+	if err != nil {
+		t.Errorf("ListUserRepos returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUserRepos is %v, want %v", got, want)
+	}
+}
+
+func TestBitbucket_GetHTMLURL(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/rest/api/1.0/projects/:org/repos/:repo/browse/:path", func(c *gin.Context) {
+		if c.Param("path") != "README.md" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/empty.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := s.URL + "/projects/GITHUB/repos/octocat/browse/README.md?at=main"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetHTMLURL(u, "GITHUB", "octocat", "README.md", "main")
+	if err != nil {
+		t.Errorf("GetHTMLURL returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetHTMLURL is %v, want %v", got, want)
+	}
+
+	_, err = client.GetHTMLURL(u, "GITHUB", "octocat", "missing.md", "main")
+	if err == nil {
+		t.Error("GetHTMLURL should have returned err")
+	}
+}
+
+func TestBitbucket_ProtectBranch(t *testing.T) {
+	// setup client
+	client, _ := NewTest("https://bitbucket.example.com")
+
+	// run test
+	err := client.ProtectBranch(new(library.User), new(library.Repo), "main", nil, true)
+	if err == nil {
+		t.Error("ProtectBranch should have returned err")
+	}
+}
@@ -0,0 +1,332 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/scm/verify"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// event represents the payload for a webhook from Bitbucket.
+//
+// https://confluence.atlassian.com/bitbucketserver/event-payload-938025882.html
+type event struct {
+	EventKey    string      `json:"eventKey"`
+	Actor       user        `json:"actor"`
+	Repository  repository  `json:"repository"`
+	Changes     []refChange `json:"changes"`
+	PullRequest pullRequest `json:"pullRequest"`
+	Comment     comment     `json:"comment"`
+	Old         repository  `json:"old"`
+	New         repository  `json:"new"`
+}
+
+// refChange represents a change to a branch or tag from Bitbucket.
+type refChange struct {
+	Ref      ref    `json:"ref"`
+	RefID    string `json:"refId"`
+	FromHash string `json:"fromHash"`
+	ToHash   string `json:"toHash"`
+	Type     string `json:"type"`
+}
+
+// ProcessWebhook parses the webhook from a repo.
+//
+//nolint:nilerr // ignore webhook returning nil
+func (c *client) ProcessWebhook(request *http.Request) (*types.Webhook, error) {
+	c.Logger.Tracef("processing Bitbucket webhook")
+
+	h := new(library.Hook)
+	h.SetNumber(1)
+	h.SetSourceID(request.Header.Get("X-Request-Id"))
+	h.SetCreated(time.Now().UTC().Unix())
+	h.SetEvent(request.Header.Get("X-Event-Key"))
+	h.SetStatus(constants.StatusSuccess)
+
+	address, err := url.Parse(c.config.Address)
+	if err == nil {
+		h.SetHost(address.Host)
+	}
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// parse the payload from the webhook
+	e := new(event)
+
+	err = json.Unmarshal(payload, e)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// process the event from the webhook
+	switch request.Header.Get("X-Event-Key") {
+	case eventRefsChanged:
+		return c.processPushEvent(h, e)
+	case eventPROpened, eventPRUpdated, eventPRMerged, eventPRDeclined, eventPRDeleted:
+		return c.processPREvent(h, e)
+	case eventPRCommentAdded, eventPRCommentEdited:
+		return c.processCommentEvent(h, e)
+	case eventRepoModified:
+		return c.processRepositoryEvent(h, e)
+	}
+
+	return &types.Webhook{Hook: h}, nil
+}
+
+// VerifyWebhook verifies the webhook from a repo.
+//
+// Bitbucket signs the payload with the secret for the webhook
+// and provides the signature in the X-Hub-Signature header.
+func (c *client) VerifyWebhook(request *http.Request, r *library.Repo) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("verifying Bitbucket webhook for %s", r.GetFullName())
+
+	return verify.Payload(request, r.GetHash(),
+		verify.Signature{Header: "X-Hub-Signature", Prefix: "sha256=", Hash: sha256.New},
+	)
+}
+
+// RedeliverWebhook redelivers webhooks from Bitbucket.
+//
+// Bitbucket does not support redelivering webhooks.
+func (c *client) RedeliverWebhook(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) error {
+	return fmt.Errorf("redelivering webhooks is not supported by the %s scm driver", DriverBitbucket)
+}
+
+// processPushEvent is a helper function to process the refs changed event.
+func (c *client) processPushEvent(h *library.Hook, payload *event) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.Repository.Project.Key,
+		"repo": payload.Repository.Slug,
+	}).Tracef("processing push Bitbucket webhook for %s/%s", payload.Repository.Project.Key, payload.Repository.Slug)
+
+	// skip if no branch or tag was changed
+	if len(payload.Changes) == 0 {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	change := payload.Changes[0]
+
+	// skip if the branch or tag was deleted
+	if strings.EqualFold(change.Type, "DELETE") {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library repo
+	r := c.toLibraryRepo(&payload.Repository)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPush)
+	b.SetClone(r.GetClone())
+	b.SetSource(fmt.Sprintf("%s/projects/%s/repos/%s/commits/%s", c.config.Address, r.GetOrg(), r.GetName(), change.ToHash))
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPush, r.GetLink()))
+	b.SetCommit(change.ToHash)
+	b.SetSender(payload.Actor.Name)
+	b.SetAuthor(payload.Actor.Name)
+	b.SetEmail(payload.Actor.EmailAddress)
+	b.SetBranch(strings.TrimPrefix(change.RefID, "refs/heads/"))
+	b.SetRef(change.RefID)
+
+	// update the hook object
+	h.SetBranch(b.GetBranch())
+	h.SetEvent(constants.EventPush)
+	h.SetLink(c.hookLink(r))
+
+	// handle when push event is a tag
+	if strings.HasPrefix(b.GetRef(), "refs/tags/") {
+		// set the proper event for the hook
+		h.SetEvent(constants.EventTag)
+		// set the proper event for the build
+		b.SetEvent(constants.EventTag)
+	}
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
+
+// processPREvent is a helper function to process the pull request events.
+func (c *client) processPREvent(h *library.Hook, payload *event) (*types.Webhook, error) {
+	pull := payload.PullRequest
+
+	// skip if the pull request has no target repo
+	if pull.ToRef.Repository == nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	c.Logger.WithFields(logrus.Fields{
+		"org":  pull.ToRef.Repository.Project.Key,
+		"repo": pull.ToRef.Repository.Slug,
+	}).Tracef("processing pull_request Bitbucket webhook for %s/%s", pull.ToRef.Repository.Project.Key, pull.ToRef.Repository.Slug)
+
+	// convert payload to library repo
+	r := c.toLibraryRepo(pull.ToRef.Repository)
+
+	// update the hook object
+	h.SetBranch(pull.ToRef.DisplayID)
+	h.SetEvent(constants.EventPull)
+	h.SetLink(c.hookLink(r))
+
+	// if the pull request was closed, return the repo and number
+	// without a build so the resources for it can be cleaned up
+	switch payload.EventKey {
+	case eventPRMerged, eventPRDeclined, eventPRDeleted:
+		h.SetEventAction("closed")
+
+		return &types.Webhook{
+			PRNumber: pull.ID,
+			Hook:     h,
+			Repo:     r,
+		}, nil
+	}
+
+	// if the pull request state isn't open we ignore it
+	if !strings.EqualFold(pull.State, "OPEN") {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	action := "opened"
+	if payload.EventKey == eventPRUpdated {
+		action = "synchronize"
+	}
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPull)
+	b.SetEventAction(action)
+	b.SetClone(r.GetClone())
+	b.SetSource(c.pullLink(r, pull))
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPull, r.GetLink()))
+	b.SetMessage(pull.Title)
+	b.SetCommit(pull.FromRef.LatestCommit)
+	b.SetSender(payload.Actor.Name)
+	b.SetAuthor(pull.Author.User.Name)
+	b.SetEmail(pull.Author.User.EmailAddress)
+	b.SetBranch(pull.ToRef.DisplayID)
+	b.SetRef(fmt.Sprintf("refs/pull-requests/%d/from", pull.ID))
+	b.SetBaseRef(pull.ToRef.DisplayID)
+	b.SetHeadRef(pull.FromRef.DisplayID)
+
+	return &types.Webhook{
+		Comment:  "",
+		PRNumber: pull.ID,
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// processCommentEvent is a helper function to process the pull request comment events.
+func (c *client) processCommentEvent(h *library.Hook, payload *event) (*types.Webhook, error) {
+	pull := payload.PullRequest
+
+	// skip if the pull request has no target repo
+	if pull.ToRef.Repository == nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	c.Logger.WithFields(logrus.Fields{
+		"org":  pull.ToRef.Repository.Project.Key,
+		"repo": pull.ToRef.Repository.Slug,
+	}).Tracef("processing comment Bitbucket webhook for %s/%s", pull.ToRef.Repository.Project.Key, pull.ToRef.Repository.Slug)
+
+	// convert payload to library repo
+	r := c.toLibraryRepo(pull.ToRef.Repository)
+
+	// update the hook object
+	h.SetEvent(constants.EventComment)
+	h.SetLink(c.hookLink(r))
+
+	action := "created"
+	if payload.EventKey == eventPRCommentEdited {
+		action = "edited"
+	}
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventComment)
+	b.SetEventAction(action)
+	b.SetClone(r.GetClone())
+	b.SetSource(c.pullLink(r, pull))
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventComment, r.GetLink()))
+	b.SetMessage(pull.Title)
+	b.SetSender(payload.Actor.Name)
+	b.SetAuthor(pull.Author.User.Name)
+	b.SetEmail(pull.Author.User.EmailAddress)
+	b.SetRef(fmt.Sprintf("refs/pull-requests/%d/from", pull.ID))
+
+	return &types.Webhook{
+		Comment:  payload.Comment.Text,
+		PRNumber: pull.ID,
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// processRepositoryEvent is a helper function to process the repo modified event.
+//
+// Only renaming a repo is processed since the payload
+// does not provide the default branch for the repo.
+func (c *client) processRepositoryEvent(h *library.Hook, payload *event) (*types.Webhook, error) {
+	logrus.Tracef("processing repository event Bitbucket webhook for %s/%s", payload.New.Project.Key, payload.New.Slug)
+
+	// convert payload to library repo
+	r := c.toLibraryRepo(&payload.New)
+	r.SetActive(!payload.New.Archived)
+
+	h.SetEvent(constants.EventRepository)
+	h.SetEventAction("modified")
+	h.SetLink(c.hookLink(r))
+
+	// if the slug changed, then capture the previous name from payload
+	if !strings.EqualFold(payload.Old.Slug, payload.New.Slug) {
+		h.SetEventAction(constants.ActionRenamed)
+		r.SetPreviousName(payload.Old.Slug)
+	}
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+	}, nil
+}
+
+// hookLink is a helper function to create the link to the webhooks for the repo.
+func (c *client) hookLink(r *library.Repo) string {
+	return fmt.Sprintf("%s/plugins/servlet/webhooks/projects/%s/repos/%s", c.config.Address, r.GetOrg(), r.GetName())
+}
+
+// pullLink is a helper function to create the link to the pull request.
+func (c *client) pullLink(r *library.Repo, pull pullRequest) string {
+	if len(pull.Links.Self) > 0 {
+		return pull.Links.Self[0].Href
+	}
+
+	return fmt.Sprintf("%s/projects/%s/repos/%s/pull-requests/%d", c.config.Address, r.GetOrg(), r.GetName(), pull.ID)
+}
@@ -0,0 +1,311 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestBitbucket_ProcessWebhook_Push(t *testing.T) {
+	// setup request
+	body, err := os.Open("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequest(http.MethodPost, "http://localhost/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Request-Id", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Event-Key", eventRefsChanged)
+
+	// setup client
+	client, _ := NewTest("https://bitbucket.example.com")
+
+	// setup types
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetEvent(constants.EventPush)
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetHost("bitbucket.example.com")
+	wantHook.SetBranch("main")
+	wantHook.SetLink("https://bitbucket.example.com/plugins/servlet/webhooks/projects/GITHUB/repos/octocat")
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("GITHUB")
+	wantRepo.SetName("octocat")
+	wantRepo.SetFullName("GITHUB/octocat")
+	wantRepo.SetLink("https://bitbucket.example.com/projects/GITHUB/repos/octocat/browse")
+	wantRepo.SetClone("https://bitbucket.example.com/scm/github/octocat.git")
+	wantRepo.SetPrivate(true)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent(constants.EventPush)
+	wantBuild.SetClone("https://bitbucket.example.com/scm/github/octocat.git")
+	wantBuild.SetSource("https://bitbucket.example.com/projects/GITHUB/repos/octocat/commits/9c93babf58917cd6f6f6772b5df2b098f507ff95")
+	wantBuild.SetTitle("push received from https://bitbucket.example.com/projects/GITHUB/repos/octocat/browse")
+	wantBuild.SetCommit("9c93babf58917cd6f6f6772b5df2b098f507ff95")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetBranch("main")
+	wantBuild.SetRef("refs/heads/main")
+
+	// run test
+	got, err := client.ProcessWebhook(request)
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	// the created time is set when the webhook is processed
+	got.Hook.SetCreated(0)
+	wantHook.SetCreated(0)
+
+	if !reflect.DeepEqual(got.Hook, wantHook) {
+		t.Errorf("ProcessWebhook hook is %v, want %v", got.Hook, wantHook)
+	}
+
+	if !reflect.DeepEqual(got.Repo, wantRepo) {
+		t.Errorf("ProcessWebhook repo is %v, want %v", got.Repo, wantRepo)
+	}
+
+	if !reflect.DeepEqual(got.Build, wantBuild) {
+		t.Errorf("ProcessWebhook build is %v, want %v", got.Build, wantBuild)
+	}
+}
+
+func TestBitbucket_ProcessWebhook_Events(t *testing.T) {
+	// setup client
+	client, _ := NewTest("https://bitbucket.example.com")
+
+	// setup tests
+	tests := []struct {
+		file      string
+		event     string
+		hookEvent string
+		action    string
+		number    int
+		comment   string
+		ref       string
+		commit    string
+		build     bool
+	}{
+		{
+			file:      "testdata/hooks/push_tag.json",
+			event:     eventRefsChanged,
+			hookEvent: constants.EventTag,
+			ref:       "refs/tags/v0.1",
+			commit:    "9c93babf58917cd6f6f6772b5df2b098f507ff95",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/push_delete.json",
+			event:     eventRefsChanged,
+			hookEvent: eventRefsChanged,
+		},
+		{
+			file:      "testdata/hooks/pull_request.json",
+			event:     eventPROpened,
+			hookEvent: constants.EventPull,
+			action:    "opened",
+			number:    1,
+			ref:       "refs/pull-requests/1/from",
+			commit:    "34c5c7793cb3b279e22454cb6750c80560547b3a",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/pull_request_updated.json",
+			event:     eventPRUpdated,
+			hookEvent: constants.EventPull,
+			action:    "synchronize",
+			number:    1,
+			ref:       "refs/pull-requests/1/from",
+			commit:    "34c5c7793cb3b279e22454cb6750c80560547b3a",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/pull_request_merged.json",
+			event:     eventPRMerged,
+			hookEvent: constants.EventPull,
+			number:    1,
+		},
+		{
+			file:      "testdata/hooks/pull_request_comment.json",
+			event:     eventPRCommentAdded,
+			hookEvent: constants.EventComment,
+			action:    "created",
+			number:    1,
+			comment:   "ok to test",
+			ref:       "refs/pull-requests/1/from",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/ping.json",
+			event:     eventPing,
+			hookEvent: eventPing,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		body, err := os.Open(test.file)
+		if err != nil {
+			t.Errorf("unable to open file: %v", err)
+		}
+
+		request, _ := http.NewRequest(http.MethodPost, "http://localhost/test", body)
+		request.Header.Set("X-Request-Id", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+		request.Header.Set("X-Event-Key", test.event)
+
+		got, err := client.ProcessWebhook(request)
+		if err != nil {
+			t.Errorf("ProcessWebhook for %s returned err: %v", test.file, err)
+		}
+
+		body.Close()
+
+		if got.Hook.GetEvent() != test.hookEvent {
+			t.Errorf("ProcessWebhook for %s hook event is %v, want %v", test.file, got.Hook.GetEvent(), test.hookEvent)
+		}
+
+		if got.PRNumber != test.number {
+			t.Errorf("ProcessWebhook for %s PR number is %v, want %v", test.file, got.PRNumber, test.number)
+		}
+
+		if got.Comment != test.comment {
+			t.Errorf("ProcessWebhook for %s comment is %v, want %v", test.file, got.Comment, test.comment)
+		}
+
+		if !test.build {
+			if got.Build != nil {
+				t.Errorf("ProcessWebhook for %s build is %v, want nil", test.file, got.Build)
+			}
+
+			continue
+		}
+
+		if got.Build.GetEventAction() != test.action {
+			t.Errorf("ProcessWebhook for %s action is %v, want %v", test.file, got.Build.GetEventAction(), test.action)
+		}
+
+		if got.Build.GetRef() != test.ref {
+			t.Errorf("ProcessWebhook for %s ref is %v, want %v", test.file, got.Build.GetRef(), test.ref)
+		}
+
+		if got.Build.GetCommit() != test.commit {
+			t.Errorf("ProcessWebhook for %s commit is %v, want %v", test.file, got.Build.GetCommit(), test.commit)
+		}
+	}
+}
+
+func TestBitbucket_ProcessWebhook_RepositoryRenamed(t *testing.T) {
+	// setup request
+	body, err := os.Open("testdata/hooks/repository_renamed.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequest(http.MethodPost, "http://localhost/test", body)
+	request.Header.Set("X-Request-Id", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Event-Key", eventRepoModified)
+
+	// setup client
+	client, _ := NewTest("https://bitbucket.example.com")
+
+	// run test
+	got, err := client.ProcessWebhook(request)
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if got.Hook.GetEvent() != constants.EventRepository {
+		t.Errorf("ProcessWebhook hook event is %v, want %v", got.Hook.GetEvent(), constants.EventRepository)
+	}
+
+	if got.Hook.GetEventAction() != constants.ActionRenamed {
+		t.Errorf("ProcessWebhook hook action is %v, want %v", got.Hook.GetEventAction(), constants.ActionRenamed)
+	}
+
+	if got.Repo.GetName() != "octocat" {
+		t.Errorf("ProcessWebhook repo name is %v, want octocat", got.Repo.GetName())
+	}
+
+	if got.Repo.GetPreviousName() != "octocat-old" {
+		t.Errorf("ProcessWebhook repo previous name is %v, want octocat-old", got.Repo.GetPreviousName())
+	}
+}
+
+func TestBitbucket_VerifyWebhook(t *testing.T) {
+	// setup types
+	payload := []byte(`{"eventKey":"diagnostics:ping"}`)
+
+	r := new(library.Repo)
+	r.SetOrg("GITHUB")
+	r.SetName("octocat")
+	r.SetHash("secret")
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+
+	// setup client
+	client, _ := NewTest("https://bitbucket.example.com")
+
+	// setup tests
+	tests := []struct {
+		signature string
+		failure   bool
+	}{
+		{signature: "sha256=" + hex.EncodeToString(mac.Sum(nil)), failure: false},
+		{signature: "sha256=" + hex.EncodeToString([]byte("bad")), failure: true},
+		{signature: "sha256=zz", failure: true},
+		{signature: "", failure: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		request, _ := http.NewRequest(http.MethodPost, "http://localhost/test", bytes.NewReader(payload))
+		request.Header.Set("X-Hub-Signature", test.signature)
+
+		err := client.VerifyWebhook(request, r)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("VerifyWebhook for %s should have returned err", test.signature)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("VerifyWebhook for %s returned err: %v", test.signature, err)
+		}
+	}
+}
+
+func TestBitbucket_RedeliverWebhook(t *testing.T) {
+	// setup client
+	client, _ := NewTest("https://bitbucket.example.com")
+
+	// run test
+	err := client.RedeliverWebhook(context.Background(), new(library.User), new(library.Repo), new(library.Hook))
+	if err == nil {
+		t.Error("RedeliverWebhook should have returned err")
+	}
+}
@@ -5,6 +5,8 @@
 package scm
 
 import (
+	"time"
+
 	"github.com/go-vela/types/constants"
 	"github.com/urfave/cli/v2"
 )
@@ -22,7 +24,7 @@ var Flags = []cli.Flag{
 		EnvVars:  []string{"VELA_SCM_DRIVER", "SCM_DRIVER", "VELA_SOURCE_DRIVER", "SOURCE_DRIVER"},
 		FilePath: "/vela/scm/driver",
 		Name:     "scm.driver",
-		Usage:    "driver to be used for the version control system",
+		Usage:    "driver to be used for the version control system (github, bitbucket or gitea)",
 		Value:    constants.DriverGithub,
 	},
 	&cli.StringFlag{
@@ -58,6 +60,60 @@ var Flags = []cli.Flag{
 		Usage:    "OAuth scopes to be used for the version control system",
 		Value:    cli.NewStringSlice("repo", "repo:status", "user:email", "read:user", "read:org"),
 	},
+	&cli.Int64Flag{
+		EnvVars:  []string{"VELA_SCM_APP_ID", "SCM_APP_ID"},
+		FilePath: "/vela/scm/app_id",
+		Name:     "scm.app.id",
+		Usage:    "ID of the GitHub App used to mint installation tokens for the version control system",
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_SCM_APP_PRIVATE_KEY", "SCM_APP_PRIVATE_KEY"},
+		FilePath: "/vela/scm/app_private_key",
+		Name:     "scm.app.private-key",
+		Usage:    "PEM encoded private key of the GitHub App used to mint installation tokens for the version control system",
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_SCM_CHECKS", "SCM_CHECKS"},
+		FilePath: "/vela/scm/checks",
+		Name:     "scm.checks",
+		Usage:    "report builds with the GitHub Checks API instead of commit statuses (requires the GitHub App)",
+	},
+	&cli.BoolFlag{
+		EnvVars:  []string{"VELA_SCM_COMMENTS", "SCM_COMMENTS"},
+		FilePath: "/vela/scm/comments",
+		Name:     "scm.comments",
+		Usage:    "comment on GitHub pull requests with a summary of the results for each build",
+	},
+	&cli.IntFlag{
+		EnvVars:  []string{"VELA_SCM_RETRIES", "SCM_RETRIES"},
+		FilePath: "/vela/scm/retries",
+		Name:     "scm.retries",
+		Usage:    "number of times to retry GitHub API requests throttled by the rate limit or failed by a server error",
+		Value:    3,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_SCM_RETRY_BACKOFF", "SCM_RETRY_BACKOFF"},
+		FilePath: "/vela/scm/retry_backoff",
+		Name:     "scm.retry.backoff",
+		Usage:    "initial time to wait before retrying a GitHub API request failed by a server error, doubled for each retry",
+		Value:    time.Second,
+	},
+	&cli.DurationFlag{
+		EnvVars:  []string{"VELA_SCM_RETRY_MAX_WAIT", "SCM_RETRY_MAX_WAIT"},
+		FilePath: "/vela/scm/retry_max_wait",
+		Name:     "scm.retry.max-wait",
+		Usage:    "longest time to wait before retrying a GitHub API request, such as for the rate limit to reset",
+		Value:    time.Minute,
+	},
+	&cli.StringFlag{
+		EnvVars:  []string{"VELA_SCM_SOURCES", "SCM_SOURCES"},
+		FilePath: "/vela/scm/sources",
+		Name:     "scm.sources",
+		Usage: "JSON list of additional version control systems hosting repos alongside the scm, " +
+			"i.e. [{\"addr\":\"https://github.example.com\",\"client\":\"<id>\",\"secret\":\"<secret>\"}]. " +
+			"Repos are matched to a version control system by the host of their clone url. " +
+			"The driver, context, scopes, retries and addresses default to the ones for the scm.",
+	},
 	&cli.StringFlag{
 		EnvVars:  []string{"VELA_SCM_WEBHOOK_ADDR", "SCM_WEBHOOK_ADDR", "VELA_SOURCE_WEBHOOK_ADDR", "SOURCE_WEBHOOK_ADDR"},
 		FilePath: "/vela/scm/webhook_addr",
@@ -0,0 +1,213 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// orgPermissions represents the permissions
+// of a user for an organization from Gitea.
+type orgPermissions struct {
+	IsOwner bool `json:"is_owner"`
+	IsAdmin bool `json:"is_admin"`
+	CanRead bool `json:"can_read"`
+}
+
+// team represents a team from Gitea.
+type team struct {
+	ID           int64        `json:"id"`
+	Name         string       `json:"name"`
+	Organization organization `json:"organization"`
+}
+
+// OrgAccess captures the user's access level for an org.
+func (c *client) OrgAccess(u *library.User, org string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to org %s", u.GetName(), org)
+
+	// check if user is accessing personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with org %s", u.GetName(), org)
+
+		//nolint:goconst // ignore making constant
+		return "admin", nil
+	}
+
+	perms := new(orgPermissions)
+
+	// send API call to capture the permissions of the user for the org
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/users/%s/orgs/%s/permissions", api, url.PathEscape(u.GetName()), url.PathEscape(org)), nil, perms)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case perms.IsOwner, perms.IsAdmin:
+		return "admin", nil
+	case perms.CanRead:
+		return "member", nil
+	}
+
+	return "", nil
+}
+
+// RepoAccess captures the user's access level for a repo.
+func (c *client) RepoAccess(u *library.User, token, org, repo string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to repo %s/%s", u.GetName(), org, repo)
+
+	// check if user is accessing repo in personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": repo,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with repo %s/%s", u.GetName(), org, repo)
+
+		return "admin", nil
+	}
+
+	perm := new(struct {
+		Permission string `json:"permission"`
+	})
+
+	// send API call to capture the permission of the user for the repo
+	_, err := c.call(token, http.MethodGet,
+		fmt.Sprintf("%s/collaborators/%s/permission", repoPath(org, repo), url.PathEscape(u.GetName())), nil, perm)
+	if err != nil {
+		return "", err
+	}
+
+	return permission(perm.Permission), nil
+}
+
+// TeamAccess captures the user's access level for a team.
+func (c *client) TeamAccess(u *library.User, org, team string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing %s access level to team %s/%s", u.GetName(), org, team)
+
+	// check if user is accessing team in personal org
+	if strings.EqualFold(org, u.GetName()) {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"team": team,
+			"user": u.GetName(),
+		}).Debugf("skipping access level check for user %s with team %s/%s", u.GetName(), org, team)
+
+		return "admin", nil
+	}
+
+	teams, err := c.ListUsersTeamsForOrg(u, org)
+	if err != nil {
+		return "", err
+	}
+
+	// iterate through each element in the teams
+	for _, t := range teams {
+		// return admin access if the user is a part of that team
+		if strings.EqualFold(team, t) {
+			return "admin", nil
+		}
+	}
+
+	return "", nil
+}
+
+// ListUsersTeamsForOrg captures the user's teams for an org.
+func (c *client) ListUsersTeamsForOrg(u *library.User, org string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("capturing %s team membership for org %s", u.GetName(), org)
+
+	// send API call to list the teams for the user
+	teams, err := list[team](c, u.GetToken(), fmt.Sprintf("%s/user/teams", api), nil)
+	if err != nil {
+		return []string{""}, err
+	}
+
+	names := []string{}
+
+	for _, t := range teams {
+		if strings.EqualFold(t.Organization.login(), org) {
+			names = append(names, t.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// ListTeamMembers captures the login of each member of a team for an org.
+func (c *client) ListTeamMembers(u *library.User, org, team string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing members of team %s/%s", org, team)
+
+	// send API call to list the teams for the org
+	teams, err := list[struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}](c, u.GetToken(), fmt.Sprintf("%s/orgs/%s/teams", api, url.PathEscape(org)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	members := []string{}
+
+	for _, t := range teams {
+		if !strings.EqualFold(t.Name, team) {
+			continue
+		}
+
+		// send API call to list the members for the team
+		users, err := list[user](c, u.GetToken(), fmt.Sprintf("%s/teams/%d/members", api, t.ID), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users {
+			members = append(members, user.Login)
+		}
+	}
+
+	return members, nil
+}
+
+// permission is a helper function to convert the
+// Gitea access mode to a Vela access level.
+func permission(mode string) string {
+	switch strings.ToLower(mode) {
+	case "owner", "admin":
+		return "admin"
+	case "write":
+		return "write"
+	case "read":
+		return "read"
+	}
+
+	return ""
+}
@@ -0,0 +1,256 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_OrgAccess(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/users/:user/orgs/:org/permissions", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		switch c.Param("org") {
+		case "github":
+			c.File("testdata/org_permissions.json")
+		case "admins":
+			c.JSON(http.StatusOK, gin.H{"is_owner": true, "can_read": true})
+		default:
+			c.JSON(http.StatusOK, gin.H{})
+		}
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		org  string
+		want string
+	}{
+		{org: "github", want: "member"},
+		{org: "admins", want: "admin"},
+		{org: "octocat", want: "admin"},
+		{org: "other", want: ""},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.OrgAccess(u, test.org)
+		if err != nil {
+			t.Errorf("OrgAccess for %s returned err: %v", test.org, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("OrgAccess for %s is %v, want %v", test.org, got, test.want)
+		}
+	}
+}
+
+func TestGitea_RepoAccess(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/collaborators/:user/permission", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Param("repo") != "octocat" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.File("testdata/repo_permission.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.RepoAccess(u, u.GetToken(), "github", "octocat")
+	if err != nil {
+		t.Errorf("RepoAccess returned err: %v", err)
+	}
+
+	if got != "write" {
+		t.Errorf("RepoAccess is %v, want %v", got, "write")
+	}
+
+	got, err = client.RepoAccess(u, u.GetToken(), "octocat", "personal")
+	if err != nil {
+		t.Errorf("RepoAccess returned err: %v", err)
+	}
+
+	if got != "admin" {
+		t.Errorf("RepoAccess is %v, want %v", got, "admin")
+	}
+
+	_, err = client.RepoAccess(u, u.GetToken(), "github", "missing")
+	if err == nil {
+		t.Errorf("RepoAccess should have returned err")
+	}
+}
+
+func TestGitea_TeamAccess(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user/teams", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/user_teams.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		org  string
+		team string
+		want string
+	}{
+		{org: "github", team: "developers", want: "admin"},
+		{org: "github", team: "maintainers", want: ""},
+		{org: "other", team: "maintainers", want: "admin"},
+		{org: "octocat", team: "any", want: "admin"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.TeamAccess(u, test.org, test.team)
+		if err != nil {
+			t.Errorf("TeamAccess for %s/%s returned err: %v", test.org, test.team, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("TeamAccess for %s/%s is %v, want %v", test.org, test.team, got, test.want)
+		}
+	}
+}
+
+func TestGitea_ListUsersTeamsForOrg(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user/teams", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/user_teams.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := []string{"Owners", "developers"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUsersTeamsForOrg(u, "github")
+	if err != nil {
+		t.Errorf("ListUsersTeamsForOrg returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUsersTeamsForOrg is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ListTeamMembers(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/orgs/:org/teams", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/org_teams.json")
+	})
+	engine.GET("/api/v1/teams/:team/members", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Param("team") != "2" {
+			c.File("testdata/empty.json")
+
+			return
+		}
+
+		c.File("testdata/team_members.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := []string{"octocat", "octokitten"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListTeamMembers(u, "github", "developers")
+	if err != nil {
+		t.Errorf("ListTeamMembers returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListTeamMembers is %v, want %v", got, want)
+	}
+}
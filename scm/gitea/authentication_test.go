@@ -0,0 +1,213 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Authorize(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "token foobar" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "token is required"})
+
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	want := "octocat"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Authorize("foobar")
+	if err != nil {
+		t.Errorf("Authorize returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Authorize is %v, want %v", got, want)
+	}
+
+	_, err = client.Authorize("invalid")
+	if err == nil {
+		t.Errorf("Authorize should have returned err")
+	}
+}
+
+func TestGitea_Login(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	context, _ := gin.CreateTestContext(resp)
+	context.Request, _ = http.NewRequest(http.MethodGet, "/login", nil)
+
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run test
+	state, err := client.Login(context.Writer, context.Request)
+	if err != nil {
+		t.Errorf("Login returned err: %v", err)
+	}
+
+	if resp.Code != http.StatusTemporaryRedirect {
+		t.Errorf("Login returned %v, want %v", resp.Code, http.StatusTemporaryRedirect)
+	}
+
+	location, _ := url.Parse(resp.Header().Get("Location"))
+
+	if !strings.HasPrefix(location.String(), "https://gitea.example.com/login/oauth/authorize") {
+		t.Errorf("Login redirected to %s, want Gitea OAuth authorize", location)
+	}
+
+	if location.Query().Get("state") != state {
+		t.Errorf("Login redirected to %s, want state %s", location, state)
+	}
+}
+
+func TestGitea_Authenticate(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.POST("/login/oauth/access_token", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"access_token": "foobar",
+			"token_type":   "bearer",
+			"expires_in":   3600,
+		})
+	})
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := NewTest(s.URL)
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/authenticate?code=foo&state=bar", nil)
+
+	want := new(library.User)
+	want.SetName("octocat")
+	want.SetToken("foobar")
+
+	// run test
+	got, err := client.Authenticate(httptest.NewRecorder(), request, "bar")
+	if err != nil {
+		t.Errorf("Authenticate returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Authenticate is %v, want %v", got, want)
+	}
+
+	_, err = client.Authenticate(httptest.NewRecorder(), request, "baz")
+	if err == nil {
+		t.Errorf("Authenticate should have returned err")
+	}
+}
+
+func TestGitea_AuthenticateToken(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := NewTest(s.URL)
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/authenticate/token", nil)
+
+	// run test
+	_, err := client.AuthenticateToken(request)
+	if err == nil {
+		t.Errorf("AuthenticateToken should have returned err")
+	}
+
+	request.Header.Set("Token", "foobar")
+
+	want := new(library.User)
+	want.SetName("octocat")
+	want.SetToken("foobar")
+
+	got, err := client.AuthenticateToken(request)
+	if err != nil {
+		t.Errorf("AuthenticateToken returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AuthenticateToken is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_GetScopes(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run test
+	got, err := client.GetScopes(u)
+	if err != nil {
+		t.Errorf("GetScopes returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("GetScopes is %v, want nil", got)
+	}
+}
+
+func TestGitea_MissingScopes(t *testing.T) {
+	// setup types
+	client, _ := NewTest("https://gitea.example.com")
+
+	want := []string{"read:organization"}
+
+	// run test
+	got := client.MissingScopes([]string{"read:user", "write:repository"})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MissingScopes is %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// file represents a file changed for a commit or pull request from Gitea.
+type file struct {
+	Filename string `json:"filename"`
+}
+
+// Changeset captures the list of files changed for a commit.
+func (c *client) Changeset(u *library.User, r *library.Repo, sha string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit changeset for %s/commit/%s", r.GetFullName(), sha)
+
+	commit := new(struct {
+		Files []file `json:"files"`
+	})
+
+	// send API call to capture the commit with the files changed
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/git/commits/%s", repoPath(r.GetOrg(), r.GetName()), sha), nil, commit)
+	if err != nil {
+		return nil, err
+	}
+
+	return filenames(commit.Files), nil
+}
+
+// ChangesetPR captures the list of files changed for a pull request.
+func (c *client) ChangesetPR(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing pull request changeset for %s/pull/%d", r.GetFullName(), number)
+
+	// send API call to capture the files changed for the pull request
+	files, err := list[file](c, u.GetToken(), fmt.Sprintf("%s/pulls/%d/files", repoPath(r.GetOrg(), r.GetName()), number), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return filenames(files), nil
+}
+
+// filenames is a helper function to capture the name for each file.
+func filenames(files []file) []string {
+	s := []string{}
+
+	for _, f := range files {
+		s = append(s, f.Filename)
+	}
+
+	return s
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Changeset(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/commit.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	want := []string{"file1.txt"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Changeset(u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	if err != nil {
+		t.Errorf("Changeset returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Changeset is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ChangesetPR(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server returning a full first page of files
+	engine.GET("/api/v1/repos/:org/:repo/pulls/:number/files", func(c *gin.Context) {
+		files := []file{}
+
+		switch c.Query("page") {
+		case "1":
+			for i := 0; i < perPage; i++ {
+				files = append(files, file{Filename: fmt.Sprintf("file%d.txt", i)})
+			}
+		case "2":
+			files = append(files, file{Filename: "last.txt"})
+		}
+
+		c.JSON(http.StatusOK, files)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ChangesetPR(u, r, 1)
+	if err != nil {
+		t.Errorf("ChangesetPR returned err: %v", err)
+	}
+
+	if len(got) != perPage+1 {
+		t.Errorf("ChangesetPR returned %d files, want %d", len(got), perPage+1)
+	}
+
+	if got[len(got)-1] != "last.txt" {
+		t.Errorf("ChangesetPR last file is %v, want %v", got[len(got)-1], "last.txt")
+	}
+}
+
+func TestGitea_Changeset_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, err := client.Changeset(u, r, "missing")
+	if err == nil {
+		t.Errorf("Changeset should have returned err")
+	}
+
+	if !isNotFound(err) {
+		t.Errorf("Changeset returned err %v, want not found", err)
+	}
+}
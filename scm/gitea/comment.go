@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// commentMarker is the hidden marker added to the body of the
+// comment to identify the comment for a key on updates.
+const commentMarker = "<!-- vela:%s -->"
+
+// comment represents an issue or pull request comment from Gitea.
+type comment struct {
+	ID   int64  `json:"id,omitempty"`
+	Body string `json:"body"`
+	User *user  `json:"user,omitempty"`
+}
+
+// UpsertPullRequestComment creates or updates the comment
+// identified by the key on a pull request.
+func (c *client) UpsertPullRequestComment(u *library.User, r *library.Repo, number int, key, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("upserting %s comment for %s/pull/%d", key, r.GetFullName(), number)
+
+	marker := fmt.Sprintf(commentMarker, key)
+	text := fmt.Sprintf("%s\n%s", marker, body)
+
+	// send API call to capture the comments on the pull request
+	comments, err := list[comment](c, u.GetToken(),
+		fmt.Sprintf("%s/issues/%d/comments", repoPath(r.GetOrg(), r.GetName()), number), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range comments {
+		if !strings.HasPrefix(existing.Body, marker) {
+			continue
+		}
+
+		// send API call to update the existing comment
+		_, err = c.call(u.GetToken(), http.MethodPatch,
+			fmt.Sprintf("%s/issues/comments/%d", repoPath(r.GetOrg(), r.GetName()), existing.ID), &comment{Body: text}, nil)
+
+		return err
+	}
+
+	// send API call to create the comment
+	_, err = c.call(u.GetToken(), http.MethodPost,
+		fmt.Sprintf("%s/issues/%d/comments", repoPath(r.GetOrg(), r.GetName()), number), &comment{Body: text}, nil)
+
+	return err
+}
+
+// CommentOnPR is a no-op for the scm driver since the
+// summary of a build is only commented by the github driver.
+func (c *client) CommentOnPR(u *library.User, r *library.Repo, b *library.Build, steps []*library.Step) error {
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_UpsertPullRequestComment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	var (
+		updated *comment
+		created *comment
+	)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/issues/:number/comments", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Param("number") == "2" {
+			c.File("testdata/empty.json")
+			return
+		}
+
+		c.File("testdata/comments.json")
+	})
+	engine.PATCH("/api/v1/repos/:org/:repo/issues/comments/:id", func(c *gin.Context) {
+		updated = new(comment)
+		updated.ID = 2
+
+		_ = json.NewDecoder(c.Request.Body).Decode(updated)
+
+		c.Status(http.StatusOK)
+	})
+	engine.POST("/api/v1/repos/:org/:repo/issues/:number/comments", func(c *gin.Context) {
+		created = new(comment)
+
+		_ = json.NewDecoder(c.Request.Body).Decode(created)
+
+		c.Status(http.StatusCreated)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	wantUpdated := &comment{ID: 2, Body: "<!-- vela:schema -->\nnew report"}
+	wantCreated := &comment{Body: "<!-- vela:schema -->\nnew report"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.UpsertPullRequestComment(u, r, 1, "schema", "new report")
+	if err != nil {
+		t.Errorf("UpsertPullRequestComment returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated, wantUpdated) {
+		t.Errorf("UpsertPullRequestComment updated %v, want %v", updated, wantUpdated)
+	}
+
+	if created != nil {
+		t.Errorf("UpsertPullRequestComment created %v, want nil", created)
+	}
+
+	err = client.UpsertPullRequestComment(u, r, 2, "schema", "new report")
+	if err != nil {
+		t.Errorf("UpsertPullRequestComment returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(created, wantCreated) {
+		t.Errorf("UpsertPullRequestComment created %v, want %v", created, wantCreated)
+	}
+}
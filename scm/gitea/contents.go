@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// entry represents a file or directory in the contents of a repo from Gitea.
+type entry struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+// GetDirectoryContents gets the contents of every file in a directory from the Gitea repo.
+//
+// Gitea does not provide an API to capture the contents of multiple files
+// at once so the contents of each file in the directory are captured with
+// a separate API call.
+//
+// The contents are keyed by the path to the file from the root of the repo
+// and an empty map is returned if the directory does not exist.
+func (c *client) GetDirectoryContents(u *library.User, r *library.Repo, dir, ref string) (map[string][]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing directory contents of %s for %s/commit/%s", dir, r.GetFullName(), ref)
+
+	contents := make(map[string][]byte)
+
+	// clean the directory to prevent escaping the repo
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+
+	err := c.directoryContents(u, r, dir, ref, contents)
+	if err != nil {
+		return nil, err
+	}
+
+	return contents, nil
+}
+
+// directoryContents is a helper function to recursively
+// capture the contents of every file in a directory.
+func (c *client) directoryContents(u *library.User, r *library.Repo, dir, ref string, contents map[string][]byte) error {
+	entries := []*entry{}
+
+	// send API call to capture the entries in the directory
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/contents/%s?ref=%s", repoPath(r.GetOrg(), r.GetName()), dir, url.QueryEscape(ref)), nil, &entries)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, e := range entries {
+		switch e.Type {
+		case "dir":
+			err = c.directoryContents(u, r, e.Path, ref, contents)
+			if err != nil {
+				return err
+			}
+		case "file":
+			var data []byte
+
+			// send API call to capture the contents of the file
+			_, err = c.call(u.GetToken(), http.MethodGet,
+				fmt.Sprintf("%s/raw/%s?ref=%s", repoPath(r.GetOrg(), r.GetName()), e.Path, url.QueryEscape(ref)), nil, &data)
+			if err != nil {
+				return err
+			}
+
+			contents[e.Path] = data
+		}
+	}
+
+	return nil
+}
+
+// GetFile gets the contents of a single file from the Gitea repo.
+//
+// The contents are nil if the file does not exist for the ref.
+func (c *client) GetFile(u *library.User, r *library.Repo, file, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing file %s for %s/commit/%s", file, r.GetFullName(), ref)
+
+	var data []byte
+
+	// send API call to capture the file
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/raw/%s?ref=%s", repoPath(r.GetOrg(), r.GetName()), file, url.QueryEscape(ref)), nil, &data)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_GetDirectoryContents(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/contents/*path", func(c *gin.Context) {
+		if c.Query("ref") != "123abc" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "not found"})
+			return
+		}
+
+		switch c.Param("path") {
+		case "/.vela":
+			c.File("testdata/directory.json")
+		case "/.vela/templates":
+			c.File("testdata/templates.json")
+		default:
+			c.JSON(http.StatusNotFound, gin.H{"message": "not found"})
+		}
+	})
+	engine.GET("/api/v1/repos/:org/:repo/raw/*path", func(c *gin.Context) {
+		c.String(http.StatusOK, strings.TrimPrefix(c.Param("path"), "/"))
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	tests := []struct {
+		name string
+		dir  string
+		ref  string
+		want map[string][]byte
+	}{
+		{
+			name: "directory",
+			dir:  ".vela/",
+			ref:  "123abc",
+			want: map[string][]byte{
+				".vela/test.yml":         []byte(".vela/test.yml"),
+				".vela/templates/go.yml": []byte(".vela/templates/go.yml"),
+			},
+		},
+		{
+			name: "directory not found",
+			dir:  ".vela",
+			ref:  "foo",
+			want: map[string][]byte{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := client.GetDirectoryContents(u, r, test.dir, test.ref)
+			if err != nil {
+				t.Errorf("GetDirectoryContents returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetDirectoryContents is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGitea_GetFile(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/raw/*path", func(c *gin.Context) {
+		if c.Param("path") != "/.gitmodules" || c.Query("ref") != "123abc" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "not found"})
+			return
+		}
+
+		c.String(http.StatusOK, strings.TrimPrefix(c.Param("path"), "/"))
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	tests := []struct {
+		name string
+		file string
+		ref  string
+		want []byte
+	}{
+		{
+			name: "file",
+			file: ".gitmodules",
+			ref:  "123abc",
+			want: []byte(".gitmodules"),
+		},
+		{
+			name: "file not found",
+			file: ".gitattributes",
+			ref:  "123abc",
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := client.GetFile(u, r, test.file, test.ref)
+			if err != nil {
+				t.Errorf("GetFile returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetFile is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
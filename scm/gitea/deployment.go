@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// errDeployments defines the error returned for
+// deployments which Gitea does not support.
+var errDeployments = fmt.Errorf("deployments are not supported by the %s scm driver", DriverGitea)
+
+// GetDeployment gets a deployment from the Gitea repo.
+func (c *client) GetDeployment(u *library.User, r *library.Repo, id int64) (*library.Deployment, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing deployment %d for repo %s", id, r.GetFullName())
+
+	return nil, errDeployments
+}
+
+// GetDeploymentCount counts a list of deployments from the Gitea repo.
+func (c *client) GetDeploymentCount(u *library.User, r *library.Repo) (int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("counting deployments for repo %s", r.GetFullName())
+
+	return 0, errDeployments
+}
+
+// GetDeploymentList gets a list of deployments from the Gitea repo.
+func (c *client) GetDeploymentList(u *library.User, r *library.Repo, page, perPage int) ([]*library.Deployment, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("listing deployments for repo %s", r.GetFullName())
+
+	return nil, errDeployments
+}
+
+// CreateDeployment creates a new deployment for the Gitea repo.
+func (c *client) CreateDeployment(u *library.User, r *library.Repo, d *library.Deployment) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating deployment for repo %s", r.GetFullName())
+
+	return errDeployments
+}
+
+// UpdateDeploymentStatus sends the status of the build
+// for the deployment to the environment in the Gitea repo.
+func (c *client) UpdateDeploymentStatus(u *library.User, b *library.Build, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("setting deployment status for %s/%s/%d to %s", org, name, b.GetNumber(), b.GetDeploy())
+
+	return errDeployments
+}
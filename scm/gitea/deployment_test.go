@@ -0,0 +1,46 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Deployments(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	r := new(library.Repo)
+
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run tests
+	_, err := client.GetDeployment(u, r, 1)
+	if !errors.Is(err, errDeployments) {
+		t.Errorf("GetDeployment returned err %v, want %v", err, errDeployments)
+	}
+
+	_, err = client.GetDeploymentCount(u, r)
+	if !errors.Is(err, errDeployments) {
+		t.Errorf("GetDeploymentCount returned err %v, want %v", err, errDeployments)
+	}
+
+	_, err = client.GetDeploymentList(u, r, 1, 10)
+	if !errors.Is(err, errDeployments) {
+		t.Errorf("GetDeploymentList returned err %v, want %v", err, errDeployments)
+	}
+
+	err = client.CreateDeployment(u, r, new(library.Deployment))
+	if !errors.Is(err, errDeployments) {
+		t.Errorf("CreateDeployment returned err %v, want %v", err, errDeployments)
+	}
+
+	err = client.UpdateDeploymentStatus(u, new(library.Build), "foo", "bar")
+	if !errors.Is(err, errDeployments) {
+		t.Errorf("UpdateDeploymentStatus returned err %v, want %v", err, errDeployments)
+	}
+}
@@ -0,0 +1,11 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package gitea provides the ability for Vela to
+// integrate with Gitea or Forgejo as a scm provider.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scm/gitea"
+package gitea
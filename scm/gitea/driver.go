@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+// DriverGitea defines the driver type when integrating with a Gitea scm.
+const DriverGitea = "gitea"
+
+// Driver outputs the configured scm driver.
+func (c *client) Driver() string {
+	return DriverGitea
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+)
+
+// organization represents an organization from Gitea.
+type organization struct {
+	ID       int64  `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+// login is a helper function to capture the login for the
+// organization since older Gitea versions only set the username.
+func (o *organization) login() string {
+	if len(o.Name) > 0 {
+		return o.Name
+	}
+
+	return o.Username
+}
+
+// GetOrgName gets org name from Gitea.
+func (c *client) GetOrgName(u *library.User, o string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"user": u.GetName(),
+	}).Tracef("retrieving org information for %s", o)
+
+	org := new(organization)
+
+	// send an API call to get the org info
+	_, err := c.call(u.GetToken(), http.MethodGet, fmt.Sprintf("%s/orgs/%s", api, url.PathEscape(o)), nil, org)
+	if err == nil {
+		return org.login(), nil
+	}
+
+	if !isNotFound(err) {
+		return "", err
+	}
+
+	owner := new(user)
+
+	// send an API call to get the user info for personal orgs
+	_, err = c.call(u.GetToken(), http.MethodGet, fmt.Sprintf("%s/users/%s", api, url.PathEscape(o)), nil, owner)
+	if err != nil {
+		return "", err
+	}
+
+	return owner.Login, nil
+}
+
+// EnableOrg creates the webhook for all repos in the org.
+//
+// Gitea does not support org webhooks.
+func (c *client) EnableOrg(u *library.User, org, secret string) (int64, error) {
+	return 0, fmt.Errorf("org webhooks are not supported by the %s scm driver", DriverGitea)
+}
+
+// DisableOrg deletes the webhook for all repos in the org.
+//
+// Gitea does not support org webhooks.
+func (c *client) DisableOrg(u *library.User, org string, webhookID int64) error {
+	return fmt.Errorf("org webhooks are not supported by the %s scm driver", DriverGitea)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_GetOrgName(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/orgs/:org", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Param("org") != "GitHub" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.File("testdata/org.json")
+	})
+	engine.GET("/api/v1/users/:user", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+
+		if c.Param("user") != "OctoCat" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		org     string
+		want    string
+	}{
+		{org: "GitHub", want: "github"},
+		{org: "OctoCat", want: "octocat"},
+		{org: "missing", failure: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := client.GetOrgName(u, test.org)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("GetOrgName for %s should have returned err", test.org)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("GetOrgName for %s returned err: %v", test.org, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("GetOrgName for %s is %v, want %v", test.org, got, test.want)
+		}
+	}
+}
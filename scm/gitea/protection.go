@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// protection represents a branch protection from Gitea.
+type protection struct {
+	BranchName            string   `json:"branch_name,omitempty"`
+	EnableStatusCheck     bool     `json:"enable_status_check"`
+	StatusCheckContexts   []string `json:"status_check_contexts"`
+	BlockOnOutdatedBranch bool     `json:"block_on_outdated_branch"`
+}
+
+// ProtectBranch configures the branch protection for a branch of the repo
+// to require the status contexts before pull requests can be merged. The
+// default branch for the repo is protected when no branch is provided and
+// the status context for pull request builds is required when no contexts
+// are provided. Strict protection blocks merging outdated branches.
+func (c *client) ProtectBranch(u *library.User, r *library.Repo, branch string, contexts []string, strict bool) error {
+	// default the branch to the default branch for the repo
+	if len(branch) == 0 {
+		branch = r.GetBranch()
+	}
+
+	// default the contexts to the status context for pull request builds
+	if len(contexts) == 0 {
+		contexts = []string{fmt.Sprintf("%s/%s", c.config.StatusContext, constants.EventPull)}
+	}
+
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("protecting branch %s for %s to require %v", branch, r.GetFullName(), contexts)
+
+	path := fmt.Sprintf("%s/branch_protections/%s", repoPath(r.GetOrg(), r.GetName()), url.PathEscape(branch))
+
+	existing := new(protection)
+
+	// send API call to capture the protection for the branch
+	_, err := c.call(u.GetToken(), http.MethodGet, path, nil, existing)
+	if err != nil {
+		if !isNotFound(err) {
+			return err
+		}
+
+		// send API call to protect the branch with only the status checks
+		_, err = c.call(u.GetToken(), http.MethodPost,
+			fmt.Sprintf("%s/branch_protections", repoPath(r.GetOrg(), r.GetName())),
+			&protection{
+				BranchName:            branch,
+				EnableStatusCheck:     true,
+				StatusCheckContexts:   contexts,
+				BlockOnOutdatedBranch: strict,
+			}, nil)
+
+		return err
+	}
+
+	required := existing.StatusCheckContexts
+
+	// add the contexts not already required for the branch
+	for _, context := range contexts {
+		found := false
+
+		for _, r := range existing.StatusCheckContexts {
+			if r == context {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			required = append(required, context)
+		}
+	}
+
+	// skip updating protection already requiring the contexts
+	if existing.EnableStatusCheck &&
+		len(required) == len(existing.StatusCheckContexts) &&
+		(existing.BlockOnOutdatedBranch || !strict) {
+		return nil
+	}
+
+	// send API call to add the contexts to the existing protection
+	_, err = c.call(u.GetToken(), http.MethodPatch, path, &protection{
+		EnableStatusCheck:     true,
+		StatusCheckContexts:   required,
+		BlockOnOutdatedBranch: existing.BlockOnOutdatedBranch || strict,
+	}, nil)
+
+	return err
+}
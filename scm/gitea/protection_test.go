@@ -0,0 +1,125 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_ProtectBranch(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetBranch("main")
+
+	// setup tests
+	tests := []struct {
+		name        string
+		existing    *protection
+		strict      bool
+		wantCreated *protection
+		wantUpdated *protection
+	}{
+		{
+			name:   "unprotected branch",
+			strict: true,
+			wantCreated: &protection{
+				BranchName:            "main",
+				EnableStatusCheck:     true,
+				StatusCheckContexts:   []string{"continuous-integration/vela/pull_request"},
+				BlockOnOutdatedBranch: true,
+			},
+		},
+		{
+			name: "protected branch without status checks",
+			existing: &protection{
+				BranchName: "main",
+			},
+			wantUpdated: &protection{
+				EnableStatusCheck:   true,
+				StatusCheckContexts: []string{"continuous-integration/vela/pull_request"},
+			},
+		},
+		{
+			name: "protected branch already requiring contexts",
+			existing: &protection{
+				BranchName:          "main",
+				EnableStatusCheck:   true,
+				StatusCheckContexts: []string{"continuous-integration/vela/pull_request"},
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				created *protection
+				updated *protection
+			)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			// setup mock server
+			engine.GET("/api/v1/repos/:org/:repo/branch_protections/:branch", func(c *gin.Context) {
+				if test.existing == nil {
+					c.JSON(http.StatusNotFound, gin.H{"message": "branch protection not found"})
+					return
+				}
+
+				c.JSON(http.StatusOK, test.existing)
+			})
+			engine.POST("/api/v1/repos/:org/:repo/branch_protections", func(c *gin.Context) {
+				created = new(protection)
+
+				_ = json.NewDecoder(c.Request.Body).Decode(created)
+
+				c.Status(http.StatusCreated)
+			})
+			engine.PATCH("/api/v1/repos/:org/:repo/branch_protections/:branch", func(c *gin.Context) {
+				updated = new(protection)
+
+				_ = json.NewDecoder(c.Request.Body).Decode(updated)
+
+				c.Status(http.StatusOK)
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			client, _ := NewTest(s.URL)
+
+			err := client.ProtectBranch(u, r, "", nil, test.strict)
+			if err != nil {
+				t.Errorf("ProtectBranch returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(created, test.wantCreated) {
+				t.Errorf("ProtectBranch created %v, want %v", created, test.wantCreated)
+			}
+
+			if !reflect.DeepEqual(updated, test.wantUpdated) {
+				t.Errorf("ProtectBranch updated %v, want %v", updated, test.wantUpdated)
+			}
+		})
+	}
+}
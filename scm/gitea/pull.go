@@ -0,0 +1,217 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// label represents a label from Gitea.
+type label struct {
+	Name string `json:"name"`
+}
+
+// branch represents the head or base branch of a pull request from Gitea.
+type branch struct {
+	Ref  string      `json:"ref"`
+	SHA  string      `json:"sha"`
+	Repo *repository `json:"repo,omitempty"`
+}
+
+// pullRequest represents a pull request from Gitea.
+type pullRequest struct {
+	ID      int64   `json:"id"`
+	Number  int     `json:"number"`
+	Title   string  `json:"title"`
+	State   string  `json:"state"`
+	HTMLURL string  `json:"html_url"`
+	Merged  bool    `json:"merged"`
+	User    user    `json:"user"`
+	Labels  []label `json:"labels"`
+	Head    branch  `json:"head"`
+	Base    branch  `json:"base"`
+}
+
+// review represents a pull request review from Gitea.
+type review struct {
+	User  user   `json:"user"`
+	State string `json:"state"`
+	Stale bool   `json:"stale"`
+}
+
+// GetPullRequest defines a function that retrieves
+// a pull request for a repo.
+func (c *client) GetPullRequest(u *library.User, r *library.Repo, number int) (string, string, string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving pull request %d for repo %s", number, r.GetFullName())
+
+	pull, err := c.getPullRequest(u, r, number)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	commit := pull.Head.SHA
+	branch := pull.Base.Ref
+	baseref := pull.Base.Ref
+	headref := pull.Head.Ref
+
+	return commit, branch, baseref, headref, nil
+}
+
+// ListPullRequestLabels captures the names of the labels applied to a pull request.
+func (c *client) ListPullRequestLabels(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing labels for %s/pull/%d", r.GetFullName(), number)
+
+	pull, err := c.getPullRequest(u, r, number)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []string{}
+
+	for _, l := range pull.Labels {
+		labels = append(labels, l.Name)
+	}
+
+	return labels, nil
+}
+
+// ListPullRequestReviews captures the reviewers and number of approvals for a pull request.
+func (c *client) ListPullRequestReviews(u *library.User, r *library.Repo, number int) ([]string, int, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing reviews for %s/pull/%d", r.GetFullName(), number)
+
+	reviewers, decisions, err := c.listReviewDecisions(u, r, number)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	approvals := 0
+
+	for _, decision := range decisions {
+		if decision == "APPROVED" {
+			approvals++
+		}
+	}
+
+	return reviewers, approvals, nil
+}
+
+// ListPullRequestApprovers captures the reviewers whose
+// latest decision approves a pull request.
+func (c *client) ListPullRequestApprovers(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing approvers for %s/pull/%d", r.GetFullName(), number)
+
+	reviewers, decisions, err := c.listReviewDecisions(u, r, number)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := []string{}
+
+	for _, reviewer := range reviewers {
+		if decisions[reviewer] == "APPROVED" {
+			approvers = append(approvers, reviewer)
+		}
+	}
+
+	return approvers, nil
+}
+
+// ListCommitPullRequests captures the numbers of the pull requests associated with a commit.
+//
+// Gitea only provides the pull request that introduced the commit.
+func (c *client) ListCommitPullRequests(u *library.User, r *library.Repo, sha string) ([]int, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing pull requests for %s commit %s", r.GetFullName(), sha)
+
+	pull := new(pullRequest)
+
+	// send API call to capture the pull request for the commit
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/commits/%s/pull", repoPath(r.GetOrg(), r.GetName()), sha), nil, pull)
+	if err != nil {
+		if isNotFound(err) {
+			return []int{}, nil
+		}
+
+		return nil, err
+	}
+
+	return []int{pull.Number}, nil
+}
+
+// getPullRequest is a helper function to capture a pull request.
+func (c *client) getPullRequest(u *library.User, r *library.Repo, number int) (*pullRequest, error) {
+	pull := new(pullRequest)
+
+	// send API call to capture the pull request
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/pulls/%d", repoPath(r.GetOrg(), r.GetName()), number), nil, pull)
+	if err != nil {
+		return nil, err
+	}
+
+	return pull, nil
+}
+
+// listReviewDecisions is a helper function to capture the reviewers and
+// their latest decision for a pull request. The decisions are converted
+// to the review states used by the other scm providers.
+func (c *client) listReviewDecisions(u *library.User, r *library.Repo, number int) ([]string, map[string]string, error) {
+	// send API call to capture the reviews for the pull request
+	reviews, err := list[review](c, u.GetToken(),
+		fmt.Sprintf("%s/pulls/%d/reviews", repoPath(r.GetOrg(), r.GetName()), number), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewers := []string{}
+	decisions := make(map[string]string)
+
+	// reviews are ordered from oldest to newest so
+	// the latest decision for each reviewer is kept
+	for _, rv := range reviews {
+		if _, ok := decisions[rv.User.Login]; !ok {
+			reviewers = append(reviewers, rv.User.Login)
+			decisions[rv.User.Login] = ""
+		}
+
+		// skip reviews invalidated by later commits
+		if rv.Stale {
+			continue
+		}
+
+		switch rv.State {
+		case "APPROVED":
+			decisions[rv.User.Login] = "APPROVED"
+		case "REQUEST_CHANGES":
+			decisions[rv.User.Login] = "CHANGES_REQUESTED"
+		}
+	}
+
+	return reviewers, decisions, nil
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_GetPullRequest(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/pulls/:number", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/pull_request.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	commit, branch, baseref, headref, err := client.GetPullRequest(u, r, 1)
+	if err != nil {
+		t.Errorf("GetPullRequest returned err: %v", err)
+	}
+
+	if commit != "34c5c7793cb3b279e22454cb6750c80560547b3a" {
+		t.Errorf("GetPullRequest commit is %v, want %v", commit, "34c5c7793cb3b279e22454cb6750c80560547b3a")
+	}
+
+	if branch != "main" {
+		t.Errorf("GetPullRequest branch is %v, want %v", branch, "main")
+	}
+
+	if baseref != "main" {
+		t.Errorf("GetPullRequest baseref is %v, want %v", baseref, "main")
+	}
+
+	if headref != "changes" {
+		t.Errorf("GetPullRequest headref is %v, want %v", headref, "changes")
+	}
+}
+
+func TestGitea_ListPullRequestReviews(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/pulls/:number/reviews", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/reviews.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	wantReviewers := []string{"octokitten", "hubot", "monalisa"}
+	wantApprovers := []string{"octokitten", "hubot"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	reviewers, approvals, err := client.ListPullRequestReviews(u, r, 1)
+	if err != nil {
+		t.Errorf("ListPullRequestReviews returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(reviewers, wantReviewers) {
+		t.Errorf("ListPullRequestReviews reviewers is %v, want %v", reviewers, wantReviewers)
+	}
+
+	if approvals != 2 {
+		t.Errorf("ListPullRequestReviews approvals is %v, want %v", approvals, 2)
+	}
+
+	approvers, err := client.ListPullRequestApprovers(u, r, 1)
+	if err != nil {
+		t.Errorf("ListPullRequestApprovers returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(approvers, wantApprovers) {
+		t.Errorf("ListPullRequestApprovers is %v, want %v", approvers, wantApprovers)
+	}
+}
+
+func TestGitea_ListPullRequestLabels(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/pulls/:number", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/pull_request.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	want := []string{"enhancement", "documentation"}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListPullRequestLabels(u, r, 1)
+	if err != nil {
+		t.Errorf("ListPullRequestLabels returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListPullRequestLabels is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_ListCommitPullRequests(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/commits/:sha/pull", func(c *gin.Context) {
+		if c.Param("sha") != "34c5c7793cb3b279e22454cb6750c80560547b3a" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/pull_request.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListCommitPullRequests(u, r, "34c5c7793cb3b279e22454cb6750c80560547b3a")
+	if err != nil {
+		t.Errorf("ListCommitPullRequests returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, []int{1}) {
+		t.Errorf("ListCommitPullRequests is %v, want %v", got, []int{1})
+	}
+
+	got, err = client.ListCommitPullRequests(u, r, "9c93babf58917cd6f6f6772b5df2b098f507ff95")
+	if err != nil {
+		t.Errorf("ListCommitPullRequests returned err: %v", err)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("ListCommitPullRequests is %v, want none", got)
+	}
+}
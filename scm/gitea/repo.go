@@ -0,0 +1,508 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// repository represents a repo from Gitea.
+type repository struct {
+	ID            int64  `json:"id"`
+	Owner         user   `json:"owner"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Private       bool   `json:"private"`
+	Archived      bool   `json:"archived"`
+	HTMLURL       string `json:"html_url"`
+	CloneURL      string `json:"clone_url"`
+	DefaultBranch string `json:"default_branch"`
+	Permissions   struct {
+		Admin bool `json:"admin"`
+		Push  bool `json:"push"`
+		Pull  bool `json:"pull"`
+	} `json:"permissions"`
+}
+
+// webhook represents a repo webhook from Gitea.
+type webhook struct {
+	ID           int64             `json:"id,omitempty"`
+	Type         string            `json:"type"`
+	Config       map[string]string `json:"config"`
+	Events       []string          `json:"events"`
+	BranchFilter string            `json:"branch_filter"`
+	Active       bool              `json:"active"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+// commitStatus represents a commit status in Gitea.
+type commitStatus struct {
+	State       string `json:"state"`
+	TargetURL   string `json:"target_url"`
+	Description string `json:"description"`
+	Context     string `json:"context"`
+}
+
+// ConfigBackoff is a wrapper for Config that will retry five times if the function
+// fails to retrieve the yaml/yml file.
+func (c *client) ConfigBackoff(u *library.User, r *library.Repo, ref string) (data []byte, err error) {
+	// number of times to retry
+	retryLimit := 5
+
+	for i := 0; i < retryLimit; i++ {
+		logrus.Debugf("Fetching config file - Attempt %d", i+1)
+		// attempt to fetch the config
+		data, err = c.Config(u, r, ref)
+
+		// return err if the last attempt returns error
+		if err != nil && i == retryLimit-1 {
+			return
+		}
+
+		// if data is valid break the retry loop
+		if data != nil {
+			break
+		}
+
+		// sleep in between retries
+		sleep := time.Duration(i+1) * time.Second
+		time.Sleep(sleep)
+	}
+
+	return
+}
+
+// Config gets the pipeline configuration from the Gitea repo.
+func (c *client) Config(u *library.User, r *library.Repo, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing configuration file for %s/commit/%s", r.GetFullName(), ref)
+
+	files := []string{".vela.yml", ".vela.yaml"}
+
+	if strings.EqualFold(r.GetPipelineType(), constants.PipelineTypeStarlark) {
+		files = append(files, ".vela.star", ".vela.py")
+	}
+
+	for _, file := range files {
+		var data []byte
+
+		// send API call to capture the .vela.yml pipeline configuration
+		_, err := c.call(u.GetToken(), http.MethodGet,
+			fmt.Sprintf("%s/raw/%s?ref=%s", repoPath(r.GetOrg(), r.GetName()), file, url.QueryEscape(ref)), nil, &data)
+		if err != nil {
+			if !isNotFound(err) {
+				return nil, err
+			}
+
+			continue
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("no valid pipeline configuration file (%s) found", strings.Join(files, ","))
+}
+
+// GetCommitSHA captures the commit SHA a ref points to from the Gitea repo.
+func (c *client) GetCommitSHA(u *library.User, r *library.Repo, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit for %s/commit/%s", r.GetFullName(), ref)
+
+	commit := new(struct {
+		SHA string `json:"sha"`
+	})
+
+	// send API call to capture the commit for the ref
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/git/commits/%s", repoPath(r.GetOrg(), r.GetName()), url.PathEscape(ref)), nil, commit)
+	if err != nil {
+		return "", err
+	}
+
+	return commit.SHA, nil
+}
+
+// Disable deactivates a repo by deleting the webhook.
+func (c *client) Disable(u *library.User, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": name,
+		"user": u.GetName(),
+	}).Tracef("deleting repository webhooks for %s/%s", org, name)
+
+	// send API call to capture the hooks for the repo
+	hooks, err := list[webhook](c, u.GetToken(), fmt.Sprintf("%s/hooks", repoPath(org, name)), nil)
+	if err != nil {
+		return err
+	}
+
+	// accounting for situations in which multiple hooks have been
+	// associated with this vela instance, which causes some
+	// disable, repair, enable operations to act in undesirable ways
+	var ids []int64
+
+	// iterate through each element in the hooks
+	for _, hook := range hooks {
+		// capture hook ID if the hook url matches
+		if hook.Config["url"] == fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress) {
+			ids = append(ids, hook.ID)
+		}
+	}
+
+	// skip if we have no hook IDs
+	if len(ids) == 0 {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": name,
+			"user": u.GetName(),
+		}).Warnf("no repository webhooks matching %s/webhook found for %s/%s", c.config.ServerWebhookAddress, org, name)
+
+		return nil
+	}
+
+	// go through all found hook IDs and delete them
+	for _, id := range ids {
+		// send API call to delete the webhook
+		_, err = c.call(u.GetToken(), http.MethodDelete, fmt.Sprintf("%s/hooks/%d", repoPath(org, name), id), nil, nil)
+	}
+
+	return err
+}
+
+// Enable activates a repo by creating the webhook.
+func (c *client) Enable(u *library.User, r *library.Repo) (*library.Hook, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("creating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	hookInfo := new(webhook)
+
+	// send API call to create the webhook
+	resp, err := c.call(u.GetToken(), http.MethodPost,
+		fmt.Sprintf("%s/hooks", repoPath(r.GetOrg(), r.GetName())), c.newWebhook(r), hookInfo)
+	if err != nil {
+		switch {
+		case resp == nil:
+			return nil, "", err
+		case resp.StatusCode == http.StatusUnprocessableEntity:
+			return nil, "", fmt.Errorf("repo already enabled")
+		case resp.StatusCode == http.StatusNotFound:
+			return nil, "", fmt.Errorf("repo not found")
+		}
+
+		return nil, "", err
+	}
+
+	// create the first hook for the repo and record its ID from Gitea
+	hook := new(library.Hook)
+	hook.SetWebhookID(hookInfo.ID)
+	hook.SetSourceID(r.GetName() + "-" + eventInitialize)
+	hook.SetCreated(hookInfo.CreatedAt.Unix())
+	hook.SetEvent(eventInitialize)
+	hook.SetNumber(1)
+
+	// create the URL for the repo
+	url := fmt.Sprintf("%s/%s/%s", c.config.Address, r.GetOrg(), r.GetName())
+
+	return hook, url, nil
+}
+
+// Update edits a repo webhook.
+func (c *client) Update(u *library.User, r *library.Repo, hookID int64) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("updating repository webhook for %s/%s", r.GetOrg(), r.GetName())
+
+	// send API call to update the webhook
+	_, err := c.call(u.GetToken(), http.MethodPatch,
+		fmt.Sprintf("%s/hooks/%d", repoPath(r.GetOrg(), r.GetName()), hookID), c.newWebhook(r), nil)
+
+	return err
+}
+
+// newWebhook is a helper function to create the
+// webhook for the events allowed for the repo.
+func (c *client) newWebhook(r *library.Repo) *webhook {
+	// always listen to pull request events so the resources
+	// created for closed pull requests can be cleaned up
+	events := []string{eventPullRequest}
+
+	if r.GetAllowComment() {
+		events = append(events, "pull_request_comment")
+	}
+
+	if r.GetAllowPull() {
+		events = append(events, "pull_request_sync", "pull_request_label")
+	}
+
+	// Gitea sends push events for branches and tags
+	if r.GetAllowPush() || r.GetAllowTag() {
+		events = append(events, eventPush)
+	}
+
+	return &webhook{
+		Type: "gitea",
+		Config: map[string]string{
+			"url":          fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress),
+			"content_type": "json",
+			"secret":       r.GetHash(),
+		},
+		Events:       events,
+		BranchFilter: "*",
+		Active:       true,
+	}
+}
+
+// Status sends the commit status for the given SHA from the Gitea repo.
+//
+// Gitea does not support deployments so the status
+// for deployment builds is sent for the commit as well.
+func (c *client) Status(u *library.User, b *library.Build, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("setting commit status for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
+
+	context := fmt.Sprintf("%s/%s", c.config.StatusContext, b.GetEvent())
+
+	url := fmt.Sprintf("%s/%s/%s/%d", c.config.ServerAddress, org, name, b.GetNumber())
+	if len(c.config.WebUIAddress) > 0 {
+		url = fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
+	}
+
+	var (
+		state       string
+		description string
+	)
+
+	// set the state and description for the status context
+	// depending on what the status of the build is
+	switch b.GetStatus() {
+	case constants.StatusRunning, constants.StatusPending:
+		state = "pending"
+		description = fmt.Sprintf("the build is %s", b.GetStatus())
+	case constants.StatusSuccess:
+		state = "success"
+		description = "the build was successful"
+	case constants.StatusFailure:
+		state = "failure"
+		description = "the build has failed"
+	case constants.StatusCanceled:
+		state = "failure"
+		description = "the build was canceled"
+	case constants.StatusKilled:
+		state = "failure"
+		description = "the build was killed"
+	case constants.StatusSkipped:
+		state = "success"
+		description = "build was skipped as no steps/stages found"
+	default:
+		state = "error"
+		description = "there was an error"
+	}
+
+	// create the status object to make the API call
+	status := &commitStatus{
+		State:       state,
+		TargetURL:   url,
+		Description: description,
+		Context:     context,
+	}
+
+	// send API call to create the status for the commit
+	_, err := c.call(u.GetToken(), http.MethodPost,
+		fmt.Sprintf("%s/statuses/%s", repoPath(org, name), b.GetCommit()), status, nil)
+
+	return err
+}
+
+// ReportSteps is a no-op for the scm driver since the steps
+// of a build are only reported with the GitHub Checks API.
+func (c *client) ReportSteps(u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	return nil
+}
+
+// GetRepo gets repo information from Gitea.
+func (c *client) GetRepo(u *library.User, r *library.Repo) (*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s", r.GetFullName())
+
+	repo := new(repository)
+
+	// send an API call to get the repo info
+	_, err := c.call(u.GetToken(), http.MethodGet, repoPath(r.GetOrg(), r.GetName()), nil, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLibraryRepo(repo), nil
+}
+
+// GetRepoSync captures the state of the repo in Gitea to sync it with
+// the database. Gitea redirects requests for a repo that was renamed or
+// transferred to the repo under its new name, so the state is captured
+// for the new name. A nil state is returned when the repo was deleted.
+func (c *client) GetRepoSync(u *library.User, r *library.Repo) (*types.RepoSync, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing repository state for %s", r.GetFullName())
+
+	repo := new(repository)
+
+	// send an API call to get the repo info
+	_, err := c.call(u.GetToken(), http.MethodGet, repoPath(r.GetOrg(), r.GetName()), nil, repo)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	topics := new(struct {
+		Topics []string `json:"topics"`
+	})
+
+	// send an API call to get the topics for the repo
+	_, err = c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/topics", repoPath(repo.Owner.Login, repo.Name)), nil, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	s := new(types.RepoSync)
+	s.SetOrg(repo.Owner.Login)
+	s.SetName(repo.Name)
+	s.SetLink(repo.HTMLURL)
+	s.SetClone(repo.CloneURL)
+	s.SetBranch(repo.DefaultBranch)
+	s.SetPrivate(repo.Private)
+	s.SetArchived(repo.Archived)
+	s.SetTopics(topics.Topics)
+
+	return s, nil
+}
+
+// GetOrgAndRepoName returns the name of the org and the repository in the SCM.
+func (c *client) GetOrgAndRepoName(u *library.User, o string, r string) (string, string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  o,
+		"repo": r,
+		"user": u.GetName(),
+	}).Tracef("retrieving repository information for %s/%s", o, r)
+
+	repo := new(repository)
+
+	// send an API call to get the repo info
+	_, err := c.call(u.GetToken(), http.MethodGet, repoPath(o, r), nil, repo)
+	if err != nil {
+		return "", "", err
+	}
+
+	return repo.Owner.Login, repo.Name, nil
+}
+
+// ListUserRepos returns a list of all repos the user has access to.
+func (c *client) ListUserRepos(u *library.User) ([]*library.Repo, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"user": u.GetName(),
+	}).Tracef("listing source repositories for %s", u.GetName())
+
+	// send API call to capture the user's repos
+	repos, err := list[repository](c, u.GetToken(), fmt.Sprintf("%s/user/repos", api), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list user repos: %w", err)
+	}
+
+	f := []*library.Repo{}
+
+	// iterate through each repo for the user
+	for i := range repos {
+		// skip if the repo is archived
+		if repos[i].Archived {
+			continue
+		}
+
+		// skip if the user does not have admin access to the repo
+		if !repos[i].Permissions.Admin {
+			continue
+		}
+
+		f = append(f, toLibraryRepo(&repos[i]))
+	}
+
+	return f, nil
+}
+
+// toLibraryRepo does a partial conversion of a gitea repo to a library repo.
+func toLibraryRepo(gr *repository) *library.Repo {
+	r := new(library.Repo)
+	r.SetOrg(gr.Owner.Login)
+	r.SetName(gr.Name)
+	r.SetFullName(gr.FullName)
+	r.SetLink(gr.HTMLURL)
+	r.SetClone(gr.CloneURL)
+	r.SetBranch(gr.DefaultBranch)
+	r.SetPrivate(gr.Private)
+
+	return r
+}
+
+// GetHTMLURL retrieves the html_url from repository contents from the Gitea repo.
+func (c *client) GetHTMLURL(u *library.User, org, repo, name, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"repo": repo,
+		"user": u.GetName(),
+	}).Tracef("capturing html_url for %s/%s/%s@%s", org, repo, name, ref)
+
+	content := new(struct {
+		HTMLURL string `json:"html_url"`
+	})
+
+	// send API call to capture the repository contents for org/repo/name at the ref provided
+	_, err := c.call(u.GetToken(), http.MethodGet,
+		fmt.Sprintf("%s/contents/%s?ref=%s", repoPath(org, repo), name, url.QueryEscape(ref)), nil, content)
+	if err != nil {
+		if isNotFound(err) {
+			return "", fmt.Errorf("no valid repository contents found")
+		}
+
+		return "", err
+	}
+
+	if len(content.HTMLURL) == 0 {
+		return "", fmt.Errorf("no valid repository contents found")
+	}
+
+	return content.HTMLURL, nil
+}
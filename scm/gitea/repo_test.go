@@ -0,0 +1,667 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_Config_YML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/raw/:path", func(c *gin.Context) {
+		if c.Param("path") != ".vela.yml" || c.Query("ref") != "123abc" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(u, r, "123abc")
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", string(got), string(want))
+	}
+}
+
+func TestGitea_Config_YAML(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/raw/:path", func(c *gin.Context) {
+		if c.Param("path") != ".vela.yaml" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.File("testdata/pipeline.yml")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+
+	want, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(u, r, "123abc")
+	if err != nil {
+		t.Errorf("Config returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Config is %v, want %v", string(got), string(want))
+	}
+}
+
+func TestGitea_Config_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/raw/:path", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetPipelineType(constants.PipelineTypeYAML)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.Config(u, r, "123abc")
+	if err == nil {
+		t.Error("Config should have returned err")
+	}
+
+	if got != nil {
+		t.Errorf("Config is %v, want nil", got)
+	}
+}
+
+func TestGitea_GetCommitSHA(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/commit.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	want := "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetCommitSHA(u, r, "main")
+	if err != nil {
+		t.Errorf("GetCommitSHA returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetCommitSHA is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_GetCommitSHA_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/git/commits/:sha", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, err := client.GetCommitSHA(u, r, "missing")
+	if err == nil {
+		t.Error("GetCommitSHA should have returned err")
+	}
+}
+
+func TestGitea_Disable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	deleted := []string{}
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/hooks", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/hooks.json")
+	})
+	engine.DELETE("/api/v1/repos/:org/:repo/hooks/:id", func(c *gin.Context) {
+		deleted = append(deleted, c.Param("id"))
+
+		c.Status(http.StatusNoContent)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := []string{"1"}
+
+	client, _ := NewTest(s.URL)
+	client.config.ServerWebhookAddress = "https://vela-server.example.com"
+
+	// run test
+	err := client.Disable(u, "github", "octocat")
+	if err != nil {
+		t.Errorf("Disable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(deleted, want) {
+		t.Errorf("Disable deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestGitea_Enable(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	got := new(webhook)
+
+	// setup mock server
+	engine.POST("/api/v1/repos/:org/:repo/hooks", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(got)
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/hook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetHash("secret")
+	r.SetAllowPush(true)
+	r.SetAllowPull(true)
+
+	wantHook := new(library.Hook)
+	wantHook.SetWebhookID(1)
+	wantHook.SetSourceID("octocat-initialize")
+	wantHook.SetCreated(time.Date(2019, time.July, 18, 18, 21, 17, 0, time.UTC).Unix())
+	wantHook.SetEvent("initialize")
+	wantHook.SetNumber(1)
+
+	wantWebhook := &webhook{
+		Type: "gitea",
+		Config: map[string]string{
+			"url":          "https://vela-server.example.com/webhook",
+			"content_type": "json",
+			"secret":       "secret",
+		},
+		Events:       []string{eventPullRequest, "pull_request_sync", "pull_request_label", eventPush},
+		BranchFilter: "*",
+		Active:       true,
+	}
+
+	client, _ := NewTest(s.URL)
+	client.config.ServerWebhookAddress = "https://vela-server.example.com"
+
+	// run test
+	hook, url, err := client.Enable(u, r)
+	if err != nil {
+		t.Errorf("Enable returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(hook, wantHook) {
+		t.Errorf("Enable hook is %v, want %v", hook, wantHook)
+	}
+
+	if url != s.URL+"/github/octocat" {
+		t.Errorf("Enable url is %v, want %v", url, s.URL+"/github/octocat")
+	}
+
+	if !reflect.DeepEqual(got, wantWebhook) {
+		t.Errorf("Enable webhook is %v, want %v", got, wantWebhook)
+	}
+}
+
+func TestGitea_Enable_Conflict(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.POST("/api/v1/repos/:org/:repo/hooks", func(c *gin.Context) {
+		c.Status(http.StatusUnprocessableEntity)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, _, err := client.Enable(u, r)
+	if err == nil || err.Error() != "repo already enabled" {
+		t.Errorf("Enable returned err %v, want repo already enabled", err)
+	}
+}
+
+func TestGitea_Update(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.PATCH("/api/v1/repos/:org/:repo/hooks/:id", func(c *gin.Context) {
+		if c.Param("id") != "1" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/hook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetAllowPush(true)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	err := client.Update(u, r, 1)
+	if err != nil {
+		t.Errorf("Update returned err: %v", err)
+	}
+
+	err = client.Update(u, r, 2)
+	if err == nil {
+		t.Error("Update should have returned err")
+	}
+}
+
+func TestGitea_Status(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	got := new(commitStatus)
+
+	// setup mock server
+	engine.POST("/api/v1/repos/:org/:repo/statuses/:sha", func(c *gin.Context) {
+		_ = json.NewDecoder(c.Request.Body).Decode(got)
+
+		c.Status(http.StatusCreated)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	// setup tests
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{status: constants.StatusRunning, want: "pending"},
+		{status: constants.StatusSuccess, want: "success"},
+		{status: constants.StatusFailure, want: "failure"},
+		{status: constants.StatusCanceled, want: "failure"},
+		{status: constants.StatusSkipped, want: "success"},
+		{status: constants.StatusError, want: "error"},
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	for _, test := range tests {
+		b := new(library.Build)
+		b.SetNumber(1)
+		b.SetEvent(constants.EventPush)
+		b.SetStatus(test.status)
+		b.SetCommit("6dcb09b5b57875f334f61aebed695e2e4193db5e")
+
+		err := client.Status(u, b, "github", "octocat")
+		if err != nil {
+			t.Errorf("Status for %s returned err: %v", test.status, err)
+		}
+
+		if got.State != test.want {
+			t.Errorf("Status for %s is %v, want %v", test.status, got.State, test.want)
+		}
+
+		if got.Context != "continuous-integration/vela/push" {
+			t.Errorf("Status context is %v, want continuous-integration/vela/push", got.Context)
+		}
+
+		if got.TargetURL != s.URL+"/github/octocat/1" {
+			t.Errorf("Status url is %v, want %v", got.TargetURL, s.URL+"/github/octocat/1")
+		}
+	}
+}
+
+func TestGitea_GetRepo(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/repo.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	want := new(library.Repo)
+	want.SetOrg("github")
+	want.SetName("octocat")
+	want.SetFullName("github/octocat")
+	want.SetLink("https://gitea.example.com/github/octocat")
+	want.SetClone("https://gitea.example.com/github/octocat.git")
+	want.SetBranch("main")
+	want.SetPrivate(true)
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetRepo(u, r)
+	if err != nil {
+		t.Errorf("GetRepo returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRepo is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_GetOrgAndRepoName(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/repo.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	org, name, err := client.GetOrgAndRepoName(u, "GitHub", "Octocat")
+	if err != nil {
+		t.Errorf("GetOrgAndRepoName returned err: %v", err)
+	}
+
+	if org != "github" {
+		t.Errorf("GetOrgAndRepoName org is %v, want github", org)
+	}
+
+	if name != "octocat" {
+		t.Errorf("GetOrgAndRepoName name is %v, want octocat", name)
+	}
+}
+
+func TestGitea_ListUserRepos(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/user/repos", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/repos.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetFullName("github/octocat")
+	r.SetLink("https://gitea.example.com/github/octocat")
+	r.SetClone("https://gitea.example.com/github/octocat.git")
+	r.SetBranch("main")
+	r.SetPrivate(true)
+
+	want := []*library.Repo{r}
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListUserRepos(u)
+	if err != nil {
+		t.Errorf("ListUserRepos returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListUserRepos is %v, want %v", got, want)
+	}
+}
+
+func TestGitea_GetHTMLURL(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v1/repos/:org/:repo/contents/:path", func(c *gin.Context) {
+		if c.Param("path") != "README.md" || c.Query("ref") != "main" {
+			c.JSON(http.StatusNotFound, gin.H{"message": "The target couldn't be found."})
+
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.File("testdata/content.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("octocat")
+	u.SetToken("foo")
+
+	want := "https://gitea.example.com/github/octocat/src/branch/main/README.md"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetHTMLURL(u, "github", "octocat", "README.md", "main")
+	if err != nil {
+		t.Errorf("GetHTMLURL returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetHTMLURL is %v, want %v", got, want)
+	}
+
+	_, err = client.GetHTMLURL(u, "github", "octocat", "missing.md", "main")
+	if err == nil {
+		t.Error("GetHTMLURL should have returned err")
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/server/scm/verify"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+// emptySHA represents the commit SHA sent
+// by Gitea when a branch or tag is deleted.
+const emptySHA = "0000000000000000000000000000000000000000"
+
+// pullActions represents the pull request actions from Gitea
+// that create builds mapped to the actions used by Vela.
+var pullActions = map[string]string{
+	"opened":        constants.ActionOpened,
+	"reopened":      constants.ActionOpened,
+	"synchronized":  constants.ActionSynchronize,
+	"label_updated": "labeled",
+	"label_cleared": "unlabeled",
+}
+
+// event represents the payload for a webhook from Gitea.
+//
+// https://docs.gitea.com/usage/webhooks
+type event struct {
+	Action      string      `json:"action"`
+	Number      int         `json:"number"`
+	Ref         string      `json:"ref"`
+	Before      string      `json:"before"`
+	After       string      `json:"after"`
+	CompareURL  string      `json:"compare_url"`
+	HeadCommit  *headCommit `json:"head_commit"`
+	Repository  repository  `json:"repository"`
+	Pusher      user        `json:"pusher"`
+	Sender      user        `json:"sender"`
+	PullRequest pullRequest `json:"pull_request"`
+	Issue       issue       `json:"issue"`
+	Comment     comment     `json:"comment"`
+	IsPull      bool        `json:"is_pull"`
+}
+
+// headCommit represents the latest commit for a push from Gitea.
+type headCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	URL     string `json:"url"`
+	Author  struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Username string `json:"username"`
+	} `json:"author"`
+}
+
+// issue represents an issue or pull request for a comment from Gitea.
+type issue struct {
+	Number      int    `json:"number"`
+	Title       string `json:"title"`
+	HTMLURL     string `json:"html_url"`
+	User        user   `json:"user"`
+	PullRequest *struct {
+		Merged bool `json:"merged"`
+	} `json:"pull_request"`
+}
+
+// ProcessWebhook parses the webhook from a repo.
+//
+// Forgejo sends the same headers as Gitea
+// along with headers prefixed with X-Forgejo.
+//
+//nolint:nilerr // ignore webhook returning nil
+func (c *client) ProcessWebhook(request *http.Request) (*types.Webhook, error) {
+	c.Logger.Tracef("processing Gitea webhook")
+
+	kind := header(request, "Event")
+
+	h := new(library.Hook)
+	h.SetNumber(1)
+	h.SetSourceID(header(request, "Delivery"))
+	h.SetCreated(time.Now().UTC().Unix())
+	h.SetEvent(kind)
+	h.SetStatus(constants.StatusSuccess)
+
+	address, err := url.Parse(c.config.Address)
+	if err == nil {
+		h.SetHost(address.Host)
+	}
+
+	payload, err := io.ReadAll(request.Body)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// parse the payload from the webhook
+	e := new(event)
+
+	err = json.Unmarshal(payload, e)
+	if err != nil {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// process the event from the webhook
+	switch kind {
+	case eventPush:
+		return c.processPushEvent(h, e)
+	case eventPullRequest:
+		return c.processPREvent(h, e)
+	case eventIssueComment:
+		return c.processCommentEvent(h, e)
+	}
+
+	return &types.Webhook{Hook: h}, nil
+}
+
+// VerifyWebhook verifies the webhook from a repo.
+//
+// Gitea signs the payload with the secret for the webhook
+// and provides the hex encoded signature in the
+// X-Gitea-Signature or X-Forgejo-Signature header.
+func (c *client) VerifyWebhook(request *http.Request, r *library.Repo) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+	}).Tracef("verifying Gitea webhook for %s", r.GetFullName())
+
+	return verify.Payload(request, r.GetHash(),
+		verify.Signature{Header: "X-Gitea-Signature", Hash: sha256.New},
+		verify.Signature{Header: "X-Forgejo-Signature", Hash: sha256.New},
+	)
+}
+
+// RedeliverWebhook redelivers webhooks from Gitea.
+//
+// Gitea does not support redelivering webhooks.
+func (c *client) RedeliverWebhook(ctx context.Context, u *library.User, r *library.Repo, h *library.Hook) error {
+	return fmt.Errorf("redelivering webhooks is not supported by the %s scm driver", DriverGitea)
+}
+
+// processPushEvent is a helper function to process the push event.
+func (c *client) processPushEvent(h *library.Hook, payload *event) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.Repository.Owner.Login,
+		"repo": payload.Repository.Name,
+	}).Tracef("processing push Gitea webhook for %s", payload.Repository.FullName)
+
+	// skip if the branch or tag was deleted
+	if payload.After == emptySHA || len(payload.After) == 0 {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// convert payload to library repo
+	r := toLibraryRepo(&payload.Repository)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPush)
+	b.SetClone(r.GetClone())
+	b.SetSource(fmt.Sprintf("%s/commit/%s", r.GetLink(), payload.After))
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPush, r.GetLink()))
+	b.SetCommit(payload.After)
+	b.SetSender(payload.Sender.Login)
+	b.SetAuthor(payload.Pusher.Login)
+	b.SetEmail(payload.Pusher.Email)
+	b.SetBranch(strings.TrimPrefix(payload.Ref, "refs/heads/"))
+	b.SetRef(payload.Ref)
+
+	// capture the details for the latest commit
+	if payload.HeadCommit != nil {
+		b.SetMessage(payload.HeadCommit.Message)
+
+		if len(payload.HeadCommit.URL) > 0 {
+			b.SetSource(payload.HeadCommit.URL)
+		}
+
+		if len(payload.HeadCommit.Author.Username) > 0 {
+			b.SetAuthor(payload.HeadCommit.Author.Username)
+		}
+
+		if len(payload.HeadCommit.Author.Email) > 0 {
+			b.SetEmail(payload.HeadCommit.Author.Email)
+		}
+	}
+
+	// update the hook object
+	h.SetBranch(b.GetBranch())
+	h.SetEvent(constants.EventPush)
+	h.SetLink(hookLink(r))
+
+	// handle when push event is a tag
+	if strings.HasPrefix(b.GetRef(), "refs/tags/") {
+		// set the proper event for the hook
+		h.SetEvent(constants.EventTag)
+		// set the proper event for the build
+		b.SetEvent(constants.EventTag)
+
+		// set the tag name as the branch for the build
+		b.SetBranch(strings.TrimPrefix(b.GetRef(), "refs/tags/"))
+	}
+
+	return &types.Webhook{
+		Comment: "",
+		Hook:    h,
+		Repo:    r,
+		Build:   b,
+	}, nil
+}
+
+// processPREvent is a helper function to process the pull request events.
+func (c *client) processPREvent(h *library.Hook, payload *event) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.Repository.Owner.Login,
+		"repo": payload.Repository.Name,
+	}).Tracef("processing pull_request Gitea webhook for %s", payload.Repository.FullName)
+
+	pull := payload.PullRequest
+
+	// convert payload to library repo
+	r := toLibraryRepo(&payload.Repository)
+
+	// update the hook object
+	h.SetBranch(pull.Base.Ref)
+	h.SetEvent(constants.EventPull)
+	h.SetLink(hookLink(r))
+
+	// if the pull request was closed, return the repo and number
+	// without a build so the resources for it can be cleaned up
+	if strings.EqualFold(payload.Action, "closed") {
+		h.SetEventAction("closed")
+
+		return &types.Webhook{
+			PRNumber: payload.Number,
+			Hook:     h,
+			Repo:     r,
+		}, nil
+	}
+
+	// if the pull request state isn't open we ignore it
+	if !strings.EqualFold(pull.State, "open") {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// skip if the pull request action does not create a build
+	action, ok := pullActions[payload.Action]
+	if !ok {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	h.SetEventAction(action)
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventPull)
+	b.SetEventAction(action)
+	b.SetClone(r.GetClone())
+	b.SetSource(pull.HTMLURL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventPull, r.GetLink()))
+	b.SetMessage(pull.Title)
+	b.SetCommit(pull.Head.SHA)
+	b.SetSender(payload.Sender.Login)
+	b.SetAuthor(pull.User.Login)
+	b.SetEmail(pull.User.Email)
+	b.SetBranch(pull.Base.Ref)
+	b.SetRef(fmt.Sprintf("refs/pull/%d/head", payload.Number))
+	b.SetBaseRef(pull.Base.Ref)
+	b.SetHeadRef(pull.Head.Ref)
+
+	return &types.Webhook{
+		Comment:  "",
+		PRNumber: payload.Number,
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// processCommentEvent is a helper function to process the issue comment events.
+func (c *client) processCommentEvent(h *library.Hook, payload *event) (*types.Webhook, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  payload.Repository.Owner.Login,
+		"repo": payload.Repository.Name,
+	}).Tracef("processing issue_comment Gitea webhook for %s", payload.Repository.FullName)
+
+	// convert payload to library repo
+	r := toLibraryRepo(&payload.Repository)
+
+	// update the hook object
+	h.SetEvent(constants.EventComment)
+	h.SetEventAction(payload.Action)
+	h.SetLink(hookLink(r))
+
+	// skip if the comment action is deleted
+	if strings.EqualFold(payload.Action, "deleted") {
+		return &types.Webhook{
+			Comment: payload.Comment.Body,
+			Hook:    h,
+		}, nil
+	}
+
+	// convert payload to library build
+	b := new(library.Build)
+	b.SetEvent(constants.EventComment)
+	b.SetEventAction(payload.Action)
+	b.SetClone(r.GetClone())
+	b.SetSource(payload.Issue.HTMLURL)
+	b.SetTitle(fmt.Sprintf("%s received from %s", constants.EventComment, r.GetLink()))
+	b.SetMessage(payload.Issue.Title)
+	b.SetSender(payload.Sender.Login)
+	b.SetAuthor(payload.Issue.User.Login)
+	b.SetEmail(payload.Issue.User.Email)
+	// treat as non-pull-request comment by default and
+	// set ref to default branch for the repo
+	b.SetRef(fmt.Sprintf("refs/heads/%s", r.GetBranch()))
+
+	pr := 0
+	// override ref and pull request number if this is
+	// a comment on a pull request
+	if payload.IsPull || payload.Issue.PullRequest != nil {
+		b.SetRef(fmt.Sprintf("refs/pull/%d/head", payload.Issue.Number))
+		pr = payload.Issue.Number
+	}
+
+	return &types.Webhook{
+		Comment:  payload.Comment.Body,
+		PRNumber: pr,
+		Hook:     h,
+		Repo:     r,
+		Build:    b,
+	}, nil
+}
+
+// header is a helper function to capture the value for the
+// webhook header sent by Gitea or Forgejo with the suffix.
+func header(request *http.Request, suffix string) string {
+	value := request.Header.Get("X-Gitea-" + suffix)
+	if len(value) == 0 {
+		value = request.Header.Get("X-Forgejo-" + suffix)
+	}
+
+	return value
+}
+
+// hookLink is a helper function to create the link to the webhooks for the repo.
+func hookLink(r *library.Repo) string {
+	return fmt.Sprintf("%s/settings/hooks", r.GetLink())
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package gitea
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+)
+
+func TestGitea_ProcessWebhook_Push(t *testing.T) {
+	// setup request
+	body, err := os.Open("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequest(http.MethodPost, "http://localhost/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-Gitea-Event", eventPush)
+
+	// setup client
+	client, _ := NewTest("https://gitea.example.com")
+
+	// setup types
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetEvent(constants.EventPush)
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetHost("gitea.example.com")
+	wantHook.SetBranch("main")
+	wantHook.SetLink("https://gitea.example.com/github/octocat/settings/hooks")
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("github")
+	wantRepo.SetName("octocat")
+	wantRepo.SetFullName("github/octocat")
+	wantRepo.SetLink("https://gitea.example.com/github/octocat")
+	wantRepo.SetClone("https://gitea.example.com/github/octocat.git")
+	wantRepo.SetBranch("main")
+	wantRepo.SetPrivate(true)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent(constants.EventPush)
+	wantBuild.SetClone("https://gitea.example.com/github/octocat.git")
+	wantBuild.SetSource("https://gitea.example.com/github/octocat/commit/9c93babf58917cd6f6f6772b5df2b098f507ff95")
+	wantBuild.SetTitle("push received from https://gitea.example.com/github/octocat")
+	wantBuild.SetMessage("Update README.md")
+	wantBuild.SetCommit("9c93babf58917cd6f6f6772b5df2b098f507ff95")
+	wantBuild.SetSender("octocat")
+	wantBuild.SetAuthor("octocat")
+	wantBuild.SetEmail("octocat@github.com")
+	wantBuild.SetBranch("main")
+	wantBuild.SetRef("refs/heads/main")
+
+	// run test
+	got, err := client.ProcessWebhook(request)
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	// the created time is set when the webhook is processed
+	got.Hook.SetCreated(0)
+	wantHook.SetCreated(0)
+
+	if !reflect.DeepEqual(got.Hook, wantHook) {
+		t.Errorf("ProcessWebhook hook is %v, want %v", got.Hook, wantHook)
+	}
+
+	if !reflect.DeepEqual(got.Repo, wantRepo) {
+		t.Errorf("ProcessWebhook repo is %v, want %v", got.Repo, wantRepo)
+	}
+
+	if !reflect.DeepEqual(got.Build, wantBuild) {
+		t.Errorf("ProcessWebhook build is %v, want %v", got.Build, wantBuild)
+	}
+}
+
+func TestGitea_ProcessWebhook_Events(t *testing.T) {
+	// setup client
+	client, _ := NewTest("https://gitea.example.com")
+
+	// setup tests
+	tests := []struct {
+		file      string
+		header    string
+		event     string
+		hookEvent string
+		action    string
+		number    int
+		comment   string
+		ref       string
+		commit    string
+		build     bool
+	}{
+		{
+			file:      "testdata/hooks/push_tag.json",
+			header:    "X-Gitea-Event",
+			event:     eventPush,
+			hookEvent: constants.EventTag,
+			ref:       "refs/tags/v0.1",
+			commit:    "9c93babf58917cd6f6f6772b5df2b098f507ff95",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/push_delete.json",
+			header:    "X-Gitea-Event",
+			event:     eventPush,
+			hookEvent: eventPush,
+		},
+		{
+			file:      "testdata/hooks/pull_request.json",
+			header:    "X-Gitea-Event",
+			event:     eventPullRequest,
+			hookEvent: constants.EventPull,
+			action:    constants.ActionOpened,
+			number:    1,
+			ref:       "refs/pull/1/head",
+			commit:    "34c5c7793cb3b279e22454cb6750c80560547b3a",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/pull_request_synchronized.json",
+			header:    "X-Gitea-Event",
+			event:     eventPullRequest,
+			hookEvent: constants.EventPull,
+			action:    constants.ActionSynchronize,
+			number:    1,
+			ref:       "refs/pull/1/head",
+			commit:    "34c5c7793cb3b279e22454cb6750c80560547b3a",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/pull_request_closed.json",
+			header:    "X-Gitea-Event",
+			event:     eventPullRequest,
+			hookEvent: constants.EventPull,
+			number:    1,
+		},
+		{
+			file:      "testdata/hooks/issue_comment.json",
+			header:    "X-Gitea-Event",
+			event:     eventIssueComment,
+			hookEvent: constants.EventComment,
+			action:    "created",
+			number:    1,
+			comment:   "ok to test",
+			ref:       "refs/pull/1/head",
+			build:     true,
+		},
+		{
+			file:      "testdata/hooks/pull_request.json",
+			header:    "X-Forgejo-Event",
+			event:     eventPullRequest,
+			hookEvent: constants.EventPull,
+			action:    constants.ActionOpened,
+			number:    1,
+			ref:       "refs/pull/1/head",
+			commit:    "34c5c7793cb3b279e22454cb6750c80560547b3a",
+			build:     true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		body, err := os.Open(test.file)
+		if err != nil {
+			t.Errorf("unable to open file: %v", err)
+		}
+
+		request, _ := http.NewRequest(http.MethodPost, "http://localhost/test", body)
+		request.Header.Set("X-Gitea-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+		request.Header.Set(test.header, test.event)
+
+		got, err := client.ProcessWebhook(request)
+		if err != nil {
+			t.Errorf("ProcessWebhook for %s returned err: %v", test.file, err)
+		}
+
+		body.Close()
+
+		if got.Hook.GetEvent() != test.hookEvent {
+			t.Errorf("ProcessWebhook for %s hook event is %v, want %v", test.file, got.Hook.GetEvent(), test.hookEvent)
+		}
+
+		if got.PRNumber != test.number {
+			t.Errorf("ProcessWebhook for %s PR number is %v, want %v", test.file, got.PRNumber, test.number)
+		}
+
+		if got.Comment != test.comment {
+			t.Errorf("ProcessWebhook for %s comment is %v, want %v", test.file, got.Comment, test.comment)
+		}
+
+		if !test.build {
+			if got.Build != nil {
+				t.Errorf("ProcessWebhook for %s build is %v, want nil", test.file, got.Build)
+			}
+
+			continue
+		}
+
+		if got.Build.GetEventAction() != test.action {
+			t.Errorf("ProcessWebhook for %s action is %v, want %v", test.file, got.Build.GetEventAction(), test.action)
+		}
+
+		if got.Build.GetRef() != test.ref {
+			t.Errorf("ProcessWebhook for %s ref is %v, want %v", test.file, got.Build.GetRef(), test.ref)
+		}
+
+		if got.Build.GetCommit() != test.commit {
+			t.Errorf("ProcessWebhook for %s commit is %v, want %v", test.file, got.Build.GetCommit(), test.commit)
+		}
+	}
+}
+
+func TestGitea_VerifyWebhook(t *testing.T) {
+	// setup types
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+	r.SetHash("secret")
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+
+	// setup client
+	client, _ := NewTest("https://gitea.example.com")
+
+	// setup tests
+	tests := []struct {
+		header    string
+		signature string
+		failure   bool
+	}{
+		{header: "X-Gitea-Signature", signature: hex.EncodeToString(mac.Sum(nil)), failure: false},
+		{header: "X-Forgejo-Signature", signature: hex.EncodeToString(mac.Sum(nil)), failure: false},
+		{header: "X-Gitea-Signature", signature: hex.EncodeToString([]byte("bad")), failure: true},
+		{header: "X-Gitea-Signature", signature: "zz", failure: true},
+		{header: "X-Gitea-Signature", signature: "", failure: true},
+	}
+
+	// run tests
+	for _, test := range tests {
+		request, _ := http.NewRequest(http.MethodPost, "http://localhost/test", bytes.NewReader(payload))
+		request.Header.Set(test.header, test.signature)
+
+		err := client.VerifyWebhook(request, r)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("VerifyWebhook for %s should have returned err", test.signature)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("VerifyWebhook for %s returned err: %v", test.signature, err)
+		}
+	}
+}
+
+func TestGitea_RedeliverWebhook(t *testing.T) {
+	// setup client
+	client, _ := NewTest("https://gitea.example.com")
+
+	// run test
+	err := client.RedeliverWebhook(context.Background(), new(library.User), new(library.Repo), new(library.Hook))
+	if err == nil {
+		t.Error("RedeliverWebhook should have returned err")
+	}
+}
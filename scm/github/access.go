@@ -184,3 +184,42 @@ func (c *client) ListUsersTeamsForOrg(u *library.User, org string) ([]string, er
 
 	return userTeams, nil
 }
+
+// ListTeamMembers captures the login of each member of a team for an org.
+func (c *client) ListTeamMembers(u *library.User, org, team string) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"team": team,
+		"user": u.GetName(),
+	}).Tracef("capturing members of team %s/%s", org, team)
+
+	// create GitHub OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+	members := []string{}
+
+	// set the max per page for the options to capture the list of members
+	opts := &github.TeamListTeamMembersOptions{
+		ListOptions: github.ListOptions{PerPage: 100}, // 100 is max
+	}
+
+	for {
+		// send API call to list the members for the team
+		users, resp, err := client.Teams.ListTeamMembersBySlug(ctx, org, team, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users {
+			members = append(members, user.GetLogin())
+		}
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return members, nil
+}
@@ -412,3 +412,74 @@ func TestGithub_TeamList(t *testing.T) {
 		t.Errorf("TeamAccess is %v, want %v", got, want)
 	}
 }
+
+func TestGithub_ListTeamMembers(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/orgs/github/teams/justice-league/members", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/team_members.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := []string{"octocat", "hubot"}
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListTeamMembers(u, "github", "justice-league")
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("ListTeamMembers returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if err != nil {
+		t.Errorf("ListTeamMembers returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListTeamMembers is %v, want %v", got, want)
+	}
+}
+
+func TestGithub_ListTeamMembers_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListTeamMembers(u, "github", "justice-league")
+
+	if err == nil {
+		t.Errorf("ListTeamMembers should have returned err")
+	}
+
+	if got != nil {
+		t.Errorf("ListTeamMembers is %v, want nil", got)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-vela/types/library"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/go-github/v50/github"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// appTokenExpiration represents the lifetime of the JWT
+	// used to authenticate as the GitHub App. GitHub allows
+	// a maximum of 10 minutes for the JWT.
+	appTokenExpiration = 9 * time.Minute
+
+	// installationTokenBuffer represents the amount of time before
+	// an installation token expires that a new token is minted.
+	installationTokenBuffer = 5 * time.Minute
+)
+
+// installationToken represents a short-lived
+// token minted for a GitHub App installation.
+type installationToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// isApp is a helper function to determine if the
+// client is configured to authenticate as a GitHub App.
+func (c *client) isApp() bool {
+	return c.config.AppID > 0 && c.config.AppPrivateKey != nil
+}
+
+// newAppToken is a helper function to create the signed
+// JWT used to authenticate as the GitHub App.
+//
+// https://docs.github.com/en/apps/creating-github-apps/authenticating-with-a-github-app/generating-a-json-web-token-jwt-for-a-github-app
+func (c *client) newAppToken() (string, error) {
+	now := time.Now()
+
+	claims := jwt.RegisteredClaims{
+		// issued in the past to allow for clock drift
+		IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appTokenExpiration)),
+		Issuer:    strconv.FormatInt(c.config.AppID, 10),
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(c.config.AppPrivateKey)
+}
+
+// installationToken is a helper function to capture an installation
+// token for the GitHub App installed on the org. Tokens are cached
+// per org and minted again shortly before they expire.
+func (c *client) installationToken(org string) (string, error) {
+	c.installationsMu.Lock()
+	defer c.installationsMu.Unlock()
+
+	// check if a token that is not about to expire exists for the org
+	t, ok := c.installations[org]
+	if ok && time.Now().Add(installationTokenBuffer).Before(t.ExpiresAt) {
+		return t.Token, nil
+	}
+
+	c.Logger.WithFields(logrus.Fields{
+		"org": org,
+	}).Tracef("minting github app installation token for %s", org)
+
+	appToken, err := c.newAppToken()
+	if err != nil {
+		return "", fmt.Errorf("unable to create github app token: %w", err)
+	}
+
+	// create GitHub client authenticated as the app
+	client := c.newClientToken(appToken)
+
+	// send API call to capture the installation for the org
+	installation, resp, err := client.Apps.FindOrganizationInstallation(ctx, org)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound {
+		// send API call to capture the installation for the user account
+		installation, _, err = client.Apps.FindUserInstallation(ctx, org)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("unable to find github app installation for %s: %w", org, err)
+	}
+
+	// send API call to mint a token for the installation
+	token, _, err := client.Apps.CreateInstallationToken(ctx, installation.GetID(), nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to create github app installation token for %s: %w", org, err)
+	}
+
+	c.installations[org] = &installationToken{
+		Token:     token.GetToken(),
+		ExpiresAt: token.GetExpiresAt().Time,
+	}
+
+	return token.GetToken(), nil
+}
+
+// newClientForOrg is a helper function to return the GitHub client for
+// operations on a repo in the org. When the client is configured as a
+// GitHub App, an installation token for the org is used. Otherwise, or
+// when the app is not installed for the org, the user's token is used.
+func (c *client) newClientForOrg(u *library.User, org string) *github.Client {
+	if !c.isApp() {
+		return c.newClientToken(u.GetToken())
+	}
+
+	token, err := c.installationToken(org)
+	if err != nil {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"user": u.GetName(),
+		}).Debugf("falling back to user token: %v", err)
+
+		return c.newClientToken(u.GetToken())
+	}
+
+	return c.newClientToken(token)
+}
+
+// newAppClientForOrg is a helper function to return the GitHub client
+// authenticated as the GitHub App installed on the org. Unlike
+// newClientForOrg, an error is returned instead of falling back to the
+// user's token for the operations that require a GitHub App.
+func (c *client) newAppClientForOrg(org string) (*github.Client, error) {
+	if !c.isApp() {
+		return nil, fmt.Errorf("github scm client is not configured as a github app")
+	}
+
+	token, err := c.installationToken(org)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newClientToken(token), nil
+}
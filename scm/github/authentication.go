@@ -110,6 +110,8 @@ func (c *client) AuthenticateToken(r *http.Request) (*library.User, error) {
 	transport := github.BasicAuthTransport{
 		Username: c.config.ClientID,
 		Password: c.config.ClientSecret,
+		// retry requests throttled by the rate limit or failed by a server error
+		Transport: c.newRetryTransport(nil),
 	}
 	// create client to connect to GitHub API
 	client := github.NewClient(transport.Client())
@@ -21,8 +21,8 @@ func (c *client) Changeset(u *library.User, r *library.Repo, sha string) ([]stri
 		"user": u.GetName(),
 	}).Tracef("capturing commit changeset for %s/commit/%s", r.GetFullName(), sha)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
 	s := []string{}
 
 	// set the max per page for the options to capture the commit
@@ -50,8 +50,8 @@ func (c *client) ChangesetPR(u *library.User, r *library.Repo, number int) ([]st
 		"user": u.GetName(),
 	}).Tracef("capturing pull request changeset for %s/pull/%d", r.GetFullName(), number)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
 	s := []string{}
 	f := []*github.CommitFile{}
 
@@ -0,0 +1,249 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// checkAnnotationPath represents the file the annotations
+	// for the failed steps in a build are attached to.
+	checkAnnotationPath = ".vela.yml"
+
+	// maxCheckAnnotations represents the maximum number of
+	// annotations GitHub accepts in a single check run request.
+	maxCheckAnnotations = 50
+)
+
+// checkRunState is a helper function to convert the status of
+// a build or step to the status and conclusion for a check run.
+//
+// https://docs.github.com/en/rest/checks/runs#create-a-check-run
+func checkRunState(status string) (string, string) {
+	switch status {
+	case constants.StatusPending:
+		return "queued", ""
+	case constants.StatusRunning:
+		return "in_progress", ""
+	case constants.StatusSuccess:
+		return "completed", "success"
+	case constants.StatusCanceled, constants.StatusKilled:
+		return "completed", "cancelled"
+	case constants.StatusSkipped:
+		return "completed", "skipped"
+	default:
+		return "completed", "failure"
+	}
+}
+
+// findCheckRun is a helper function to capture the check run for the build.
+// The check run is matched by name and the external ID set to the build ID,
+// so a restarted build on the same commit creates a check run of its own.
+func findCheckRun(client *github.Client, b *library.Build, org, name, checkName string) (*github.CheckRun, error) {
+	opts := &github.ListCheckRunsOptions{
+		CheckName: github.String(checkName),
+		Filter:    github.String("all"),
+		ListOptions: github.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		// send API call to capture the check runs for the commit
+		results, resp, err := client.Checks.ListCheckRunsForRef(ctx, org, name, b.GetCommit(), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, run := range results.CheckRuns {
+			if run.GetExternalID() == strconv.FormatInt(b.GetID(), 10) {
+				return run, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
+
+// upsertCheckRun is a helper function to create or update
+// the check run for the build on the commit for the build.
+func upsertCheckRun(client *github.Client, b *library.Build, org, name, checkName, url string, output *github.CheckRunOutput) error {
+	status, conclusion := checkRunState(b.GetStatus())
+
+	var (
+		detailsURL  *string
+		completedAt *github.Timestamp
+	)
+
+	// provide "Details" link in GitHub UI if server was configured with it
+	if len(url) > 0 {
+		detailsURL = github.String(url)
+	}
+
+	if len(conclusion) > 0 {
+		completedAt = &github.Timestamp{Time: time.Now().UTC()}
+
+		if b.GetFinished() > 0 {
+			completedAt = &github.Timestamp{Time: time.Unix(b.GetFinished(), 0).UTC()}
+		}
+	}
+
+	run, err := findCheckRun(client, b, org, name, checkName)
+	if err != nil {
+		return err
+	}
+
+	if run != nil {
+		update := github.UpdateCheckRunOptions{
+			Name:        checkName,
+			DetailsURL:  detailsURL,
+			ExternalID:  github.String(strconv.FormatInt(b.GetID(), 10)),
+			Status:      github.String(status),
+			CompletedAt: completedAt,
+			Output:      output,
+		}
+
+		if len(conclusion) > 0 {
+			update.Conclusion = github.String(conclusion)
+		}
+
+		// send API call to update the check run for the build
+		_, _, err = client.Checks.UpdateCheckRun(ctx, org, name, run.GetID(), update)
+
+		return err
+	}
+
+	create := github.CreateCheckRunOptions{
+		Name:        checkName,
+		HeadSHA:     b.GetCommit(),
+		DetailsURL:  detailsURL,
+		ExternalID:  github.String(strconv.FormatInt(b.GetID(), 10)),
+		Status:      github.String(status),
+		CompletedAt: completedAt,
+		Output:      output,
+	}
+
+	if len(conclusion) > 0 {
+		create.Conclusion = github.String(conclusion)
+	}
+
+	if b.GetStarted() > 0 {
+		create.StartedAt = &github.Timestamp{Time: time.Unix(b.GetStarted(), 0).UTC()}
+	}
+
+	// send API call to create the check run for the build
+	_, _, err = client.Checks.CreateCheckRun(ctx, org, name, create)
+
+	return err
+}
+
+// ReportSteps sends the results for the steps of the build to the
+// GitHub repo. The steps are reported as the summary for the check
+// run of the build, with an annotation for each failed step, when
+// the client is configured to use the Checks API.
+func (c *client) ReportSteps(u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	// skip reporting steps without the checks api
+	if !c.config.UseChecks || strings.EqualFold(b.GetEvent(), constants.EventDeploy) {
+		return nil
+	}
+
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("reporting steps for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
+
+	// create GitHub client authenticated as the app for the org
+	client, err := c.newAppClientForOrg(org)
+	if err != nil {
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": name,
+		}).Debugf("skipping step report without github app: %v", err)
+
+		return nil
+	}
+
+	checkName := fmt.Sprintf("%s/%s", c.config.StatusContext, b.GetEvent())
+	url := fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
+
+	if len(c.config.WebUIAddress) == 0 {
+		url = ""
+	}
+
+	return upsertCheckRun(client, b, org, name, checkName, url, stepsOutput(b, steps))
+}
+
+// stepsOutput is a helper function to create the output for the check
+// run of the build with a summary of the steps in the build.
+func stepsOutput(b *library.Build, steps []*library.Step) *github.CheckRunOutput {
+	summary := new(strings.Builder)
+
+	fmt.Fprintf(summary, "Build #%d finished with status **%s**.\n\n", b.GetNumber(), b.GetStatus())
+	fmt.Fprintln(summary, "| Step | Status | Duration |")
+	fmt.Fprintln(summary, "| --- | --- | --- |")
+
+	annotations := []*github.CheckRunAnnotation{}
+
+	for _, s := range steps {
+		fmt.Fprintf(summary, "| %s | %s | %s |\n", s.GetName(), s.GetStatus(), duration(s.GetStarted(), s.GetFinished()))
+
+		// skip annotating steps that did not fail
+		if s.GetStatus() != constants.StatusFailure &&
+			s.GetStatus() != constants.StatusError &&
+			s.GetStatus() != constants.StatusKilled {
+			continue
+		}
+
+		// skip annotating steps over the limit for a single request
+		if len(annotations) >= maxCheckAnnotations {
+			continue
+		}
+
+		message := fmt.Sprintf("step %s exited with code %d", s.GetName(), s.GetExitCode())
+		if len(s.GetError()) > 0 {
+			message = fmt.Sprintf("%s: %s", message, s.GetError())
+		}
+
+		annotations = append(annotations, &github.CheckRunAnnotation{
+			Path:            github.String(checkAnnotationPath),
+			StartLine:       github.Int(1),
+			EndLine:         github.Int(1),
+			AnnotationLevel: github.String("failure"),
+			Title:           github.String(fmt.Sprintf("step %s: %s", s.GetName(), s.GetStatus())),
+			Message:         github.String(message),
+		})
+	}
+
+	return &github.CheckRunOutput{
+		Title:       github.String(fmt.Sprintf("the build is %s", b.GetStatus())),
+		Summary:     github.String(summary.String()),
+		Annotations: annotations,
+	}
+}
+
+// duration is a helper function to format the time
+// between the started and finished timestamps.
+func duration(started, finished int64) string {
+	if started <= 0 || finished < started {
+		return "-"
+	}
+
+	return (time.Duration(finished-started) * time.Second).String()
+}
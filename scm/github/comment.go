@@ -0,0 +1,154 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
+	"github.com/sirupsen/logrus"
+)
+
+// commentMarker is the hidden marker added to the body of the
+// comments created by Vela so they can be found and updated.
+const commentMarker = "<!-- vela:%s -->"
+
+// buildCommentKey represents the key for the pull request
+// comment that summarizes the results of the latest build.
+const buildCommentKey = "build"
+
+// UpsertPullRequestComment updates the comment Vela created with the key on
+// a pull request with the body provided. When no comment with the key exists
+// on the pull request, a new comment is created.
+func (c *client) UpsertPullRequestComment(u *library.User, r *library.Repo, number int, key, body string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("upserting %s comment for %s/pull/%d", key, r.GetFullName(), number)
+
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
+
+	marker := fmt.Sprintf(commentMarker, key)
+
+	comment := &github.IssueComment{
+		Body: github.String(fmt.Sprintf("%s\n%s", marker, body)),
+	}
+
+	// set the max per page for the options to capture the list of comments
+	opts := github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{PerPage: 100}, // 100 is max
+	}
+
+	for {
+		// send API call to capture the comments on the pull request
+		comments, resp, err := client.Issues.ListComments(ctx, r.GetOrg(), r.GetName(), number, &opts)
+		if err != nil {
+			return fmt.Errorf("Issues.ListComments returned error: %w", err)
+		}
+
+		for _, existing := range comments {
+			if !strings.HasPrefix(existing.GetBody(), marker) {
+				continue
+			}
+
+			// send API call to update the existing comment
+			_, _, err = client.Issues.EditComment(ctx, r.GetOrg(), r.GetName(), existing.GetID(), comment)
+			if err != nil {
+				return fmt.Errorf("Issues.EditComment returned error: %w", err)
+			}
+
+			return nil
+		}
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	// send API call to create the comment
+	_, _, err := client.Issues.CreateComment(ctx, r.GetOrg(), r.GetName(), number, comment)
+	if err != nil {
+		return fmt.Errorf("Issues.CreateComment returned error: %w", err)
+	}
+
+	return nil
+}
+
+// CommentOnPR creates or updates the comment on the pull request for the
+// build with a summary of the results and durations for the steps in the
+// build and a link to the build in the Vela UI, when the client is
+// configured to comment on pull requests.
+func (c *client) CommentOnPR(u *library.User, r *library.Repo, b *library.Build, steps []*library.Step) error {
+	// skip commenting without comments enabled or for builds not from a pull request
+	if !c.config.UseComments || !strings.EqualFold(b.GetEvent(), constants.EventPull) {
+		return nil
+	}
+
+	number, err := pullRequestNumber(b.GetRef())
+	if err != nil {
+		return err
+	}
+
+	return c.UpsertPullRequestComment(u, r, number, buildCommentKey, c.buildComment(r, b, steps))
+}
+
+// buildComment is a helper function to create the body of
+// the pull request comment summarizing the build.
+func (c *client) buildComment(r *library.Repo, b *library.Build, steps []*library.Step) string {
+	body := new(strings.Builder)
+
+	fmt.Fprintf(body, "### Vela build #%d\n\n", b.GetNumber())
+
+	commit := b.GetCommit()
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+
+	fmt.Fprintf(body, "Commit %s finished with status **%s** in %s.", commit, b.GetStatus(), duration(b.GetStarted(), b.GetFinished()))
+
+	// provide a link to the build if the server was configured with the web UI address
+	if len(c.config.WebUIAddress) > 0 {
+		fmt.Fprintf(body, " [View the build](%s/%s/%d)", c.config.WebUIAddress, r.GetFullName(), b.GetNumber())
+	}
+
+	fmt.Fprintln(body)
+
+	if len(steps) == 0 {
+		return body.String()
+	}
+
+	fmt.Fprintln(body)
+	fmt.Fprintln(body, "| Step | Status | Duration |")
+	fmt.Fprintln(body, "| --- | --- | --- |")
+
+	for _, s := range steps {
+		fmt.Fprintf(body, "| %s | %s | %s |\n", s.GetName(), s.GetStatus(), duration(s.GetStarted(), s.GetFinished()))
+	}
+
+	return body.String()
+}
+
+// pullRequestNumber is a helper function to capture
+// the pull request number from the ref for a build.
+//
+// pattern: refs/pull/1/head
+func pullRequestNumber(ref string) (int, error) {
+	parts := strings.Split(ref, "/")
+
+	if len(parts) < 3 || !strings.HasPrefix(ref, "refs/pull/") {
+		return 0, fmt.Errorf("invalid pull request ref: %s", ref)
+	}
+
+	return strconv.Atoi(parts[2])
+}
@@ -0,0 +1,230 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
+)
+
+func TestGithub_UpsertPullRequestComment(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	// setup tests
+	tests := []struct {
+		name    string
+		key     string
+		created bool
+		edited  bool
+	}{
+		{
+			name:   "existing comment",
+			key:    "previews",
+			edited: true,
+		},
+		{
+			name:    "new comment",
+			key:     "summary",
+			created: true,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			created, edited := false, false
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			// setup mock server
+			engine.GET("/api/v3/repos/:org/:repo/issues/:issue_number/comments", func(c *gin.Context) {
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusOK)
+				c.File("testdata/list_comments.json")
+			})
+			engine.POST("/api/v3/repos/:org/:repo/issues/:issue_number/comments", func(c *gin.Context) {
+				created = true
+
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusCreated)
+				c.File("testdata/comment.json")
+			})
+			engine.PATCH("/api/v3/repos/:org/:repo/issues/comments/:comment_id", func(c *gin.Context) {
+				edited = c.Param("comment_id") == "2"
+
+				c.Header("Content-Type", "application/json")
+				c.Status(http.StatusOK)
+				c.File("testdata/comment.json")
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			client, _ := NewTest(s.URL)
+
+			err := client.UpsertPullRequestComment(u, r, 1, test.key, "| Preview | Status |")
+			if err != nil {
+				t.Errorf("UpsertPullRequestComment returned err: %v", err)
+			}
+
+			if created != test.created {
+				t.Errorf("UpsertPullRequestComment created is %v, want %v", created, test.created)
+			}
+
+			if edited != test.edited {
+				t.Errorf("UpsertPullRequestComment edited is %v, want %v", edited, test.edited)
+			}
+		})
+	}
+}
+
+func TestGithub_CommentOnPR(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	var created *github.IssueComment
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/issues/:issue_number/comments", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.String(http.StatusOK, "[]")
+	})
+	engine.POST("/api/v3/repos/:org/:repo/issues/:issue_number/comments", func(c *gin.Context) {
+		if c.Param("issue_number") != "1" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		created = new(github.IssueComment)
+		_ = c.BindJSON(created)
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/comment.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+	r.SetFullName("octocat/Hello-World")
+
+	b := new(library.Build)
+	b.SetNumber(5)
+	b.SetEvent(constants.EventPull)
+	b.SetRef("refs/pull/1/head")
+	b.SetStatus(constants.StatusFailure)
+	b.SetCommit("7fd1a60b01f91b314f59955a4e4d4e80d8edf11d")
+	b.SetStarted(1563474077)
+	b.SetFinished(1563474167)
+
+	test := new(library.Step)
+	test.SetName("test")
+	test.SetStatus(constants.StatusFailure)
+	test.SetStarted(1563474079)
+	test.SetFinished(1563474090)
+
+	client, _ := NewTest(s.URL)
+	_ = WithUseComments(true)(client)
+
+	// run test
+	err := client.CommentOnPR(u, r, b, []*library.Step{test})
+	if err != nil {
+		t.Errorf("CommentOnPR returned err: %v", err)
+	}
+
+	if created == nil {
+		t.Fatalf("CommentOnPR did not create a comment")
+	}
+
+	for _, want := range []string{
+		"<!-- vela:build -->",
+		"Commit 7fd1a60 finished with status **failure** in 1m30s.",
+		"[View the build](" + s.URL + "/octocat/Hello-World/5)",
+		"| test | failure | 11s |",
+	} {
+		if !strings.Contains(created.GetBody(), want) {
+			t.Errorf("CommentOnPR body is %v, want %v", created.GetBody(), want)
+		}
+	}
+}
+
+func TestGithub_CommentOnPR_Skipped(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	push := new(library.Build)
+	push.SetEvent(constants.EventPush)
+	push.SetRef("refs/heads/main")
+
+	pull := new(library.Build)
+	pull.SetEvent(constants.EventPull)
+	pull.SetRef("refs/pull/1/head")
+
+	// setup tests
+	tests := []struct {
+		name     string
+		comments bool
+		build    *library.Build
+	}{
+		{
+			name:     "comments disabled",
+			comments: false,
+			build:    pull,
+		},
+		{
+			name:     "push build",
+			comments: true,
+			build:    push,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, _ := NewTest("https://github.com/")
+			_ = WithUseComments(test.comments)(client)
+
+			err := client.CommentOnPR(u, r, test.build, []*library.Step{})
+			if err != nil {
+				t.Errorf("CommentOnPR returned err: %v", err)
+			}
+		})
+	}
+}
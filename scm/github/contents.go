@@ -0,0 +1,212 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
+)
+
+// blobBatchSize represents the max number of files
+// captured in a single query to the GraphQL API.
+const blobBatchSize = 100
+
+// blob represents the contents of a file from the GraphQL API.
+type blob struct {
+	Text        *string `json:"text"`
+	IsBinary    bool    `json:"isBinary"`
+	IsTruncated bool    `json:"isTruncated"`
+}
+
+// blobQuery represents the response for a query
+// capturing the contents of files from the GraphQL API.
+type blobQuery struct {
+	Data struct {
+		Repository map[string]*blob `json:"repository"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GetDirectoryContents gets the contents of every file in a directory from the GitHub repo.
+//
+// The files in the directory are captured from the tree for the ref in a single
+// API call and the contents of the files are captured from the GraphQL API in
+// batches, rather than sending an API call for every file in the directory.
+//
+// The contents are keyed by the path to the file from the root of the repo.
+// Binary files are skipped and an empty map is returned if the directory
+// does not exist.
+func (c *client) GetDirectoryContents(u *library.User, r *library.Repo, dir, ref string) (map[string][]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing directory contents of %s for %s/commit/%s", dir, r.GetFullName(), ref)
+
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
+
+	// default to the branch for the repo when no reference is provided
+	if len(ref) == 0 {
+		ref = r.GetBranch()
+	}
+
+	// clean the directory to match the paths from the tree
+	dir = strings.Trim(path.Clean("/"+dir), "/")
+
+	contents := make(map[string][]byte)
+
+	// send API call to capture the recursive tree for the ref
+	tree, resp, err := client.Git.GetTree(ctx, r.GetOrg(), r.GetName(), ref, true)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return contents, nil
+		}
+
+		return nil, err
+	}
+
+	// the tree is truncated when the repo has too many files to list at once
+	if tree.GetTruncated() {
+		return nil, fmt.Errorf("unable to capture directory contents of %s: tree for %s is truncated", dir, ref)
+	}
+
+	entries := []*github.TreeEntry{}
+
+	for _, entry := range tree.Entries {
+		// skip entries that are not files, i.e. directories and submodules
+		if !strings.EqualFold(entry.GetType(), "blob") {
+			continue
+		}
+
+		// skip files that are not in the directory
+		if len(dir) > 0 && !strings.HasPrefix(entry.GetPath(), dir+"/") {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	for start := 0; start < len(entries); start += blobBatchSize {
+		end := start + blobBatchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		err = c.blobs(client, r, entries[start:end], contents)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return contents, nil
+}
+
+// blobs is a helper function to capture the contents of the
+// files for the tree entries in a single GraphQL API call.
+func (c *client) blobs(client *github.Client, r *library.Repo, entries []*github.TreeEntry, contents map[string][]byte) error {
+	fields := make([]string, 0, len(entries))
+
+	// the object IDs are hex encoded SHAs so they are safe to inline in the query
+	for i, entry := range entries {
+		fields = append(fields, fmt.Sprintf(
+			`f%d: object(oid: "%s") { ... on Blob { text isBinary isTruncated } }`, i, entry.GetSHA(),
+		))
+	}
+
+	body := map[string]interface{}{
+		"query": fmt.Sprintf(
+			"query($owner: String!, $name: String!) { repository(owner: $owner, name: $name) { %s } }",
+			strings.Join(fields, " "),
+		),
+		"variables": map[string]string{
+			"owner": r.GetOrg(),
+			"name":  r.GetName(),
+		},
+	}
+
+	// the GraphQL API is hosted alongside the REST API, i.e. api/v3/ and api/graphql
+	req, err := client.NewRequest(http.MethodPost, "../graphql", body)
+	if err != nil {
+		return err
+	}
+
+	result := new(blobQuery)
+
+	// send API call to capture the contents of the files
+	_, err = client.Do(ctx, req, result)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Errors) > 0 {
+		return fmt.Errorf("unable to capture directory contents for %s: %s", r.GetFullName(), result.Errors[0].Message)
+	}
+
+	for i, entry := range entries {
+		b, ok := result.Data.Repository[fmt.Sprintf("f%d", i)]
+		if !ok || b == nil || b.IsBinary || b.Text == nil {
+			continue
+		}
+
+		if b.IsTruncated {
+			return fmt.Errorf("unable to capture directory contents for %s: %s is too large", r.GetFullName(), entry.GetPath())
+		}
+
+		contents[entry.GetPath()] = []byte(*b.Text)
+	}
+
+	return nil
+}
+
+// GetFile gets the contents of a single file from the GitHub repo.
+//
+// The contents are nil if the file does not exist for the ref.
+func (c *client) GetFile(u *library.User, r *library.Repo, file, ref string) ([]byte, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing file %s for %s/commit/%s", file, r.GetFullName(), ref)
+
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
+
+	// set the reference for the options to capture the file
+	opts := &github.RepositoryContentGetOptions{
+		Ref: ref,
+	}
+
+	// send API call to capture the file
+	data, _, resp, err := client.Repositories.GetContents(ctx, r.GetOrg(), r.GetName(), file, opts)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	// data is nil if the path is a directory
+	if data == nil {
+		return nil, nil
+	}
+
+	content, err := data.GetContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
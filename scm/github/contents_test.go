@@ -0,0 +1,258 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGithub_GetDirectoryContents(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/git/trees/:ref", func(c *gin.Context) {
+		if c.Param("ref") != "main" || c.Query("recursive") != "1" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/tree.json")
+	})
+	engine.POST("/api/graphql", func(c *gin.Context) {
+		body := new(struct {
+			Query     string            `json:"query"`
+			Variables map[string]string `json:"variables"`
+		})
+
+		err := c.BindJSON(body)
+		if err != nil {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+
+		// the files outside of the directory should not be queried
+		if strings.Contains(body.Query, "3d21ec53a331a6f037a91c368710b99387d012c1") ||
+			body.Variables["owner"] != "foo" || body.Variables["name"] != "bar" {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/blobs.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetBranch("main")
+
+	want := map[string][]byte{
+		".vela/test.yml":         []byte("version: \"1\"\n\nsteps:\n  - name: test\n    image: golang:latest\n"),
+		".vela/templates/go.yml": []byte("steps:\n  - name: build\n    image: golang:latest\n    commands: [ go build ]\n"),
+	}
+
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	tests := []struct {
+		name    string
+		dir     string
+		ref     string
+		failure bool
+		want    map[string][]byte
+	}{
+		{
+			name:    "directory",
+			dir:     ".vela",
+			ref:     "main",
+			failure: false,
+			want:    want,
+		},
+		{
+			name:    "directory with trailing slash and default branch",
+			dir:     ".vela/",
+			ref:     "",
+			failure: false,
+			want:    want,
+		},
+		{
+			name:    "ref not found",
+			dir:     ".vela",
+			ref:     "foo",
+			failure: false,
+			want:    map[string][]byte{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := client.GetDirectoryContents(u, r, test.dir, test.ref)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("GetDirectoryContents should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("GetDirectoryContents returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetDirectoryContents is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGithub_GetDirectoryContents_Errors(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/git/trees/:ref", func(c *gin.Context) {
+		// the tree is truncated for the large ref
+		if c.Param("ref") == "large" {
+			c.JSON(http.StatusOK, map[string]interface{}{"sha": "foo", "truncated": true})
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/tree.json")
+	})
+	engine.POST("/api/graphql", func(c *gin.Context) {
+		c.JSON(http.StatusOK, map[string]interface{}{
+			"errors": []map[string]interface{}{{"message": "Something went wrong"}},
+		})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	for _, ref := range []string{"large", "main"} {
+		_, err := client.GetDirectoryContents(u, r, ".vela", ref)
+		if err == nil {
+			t.Errorf("GetDirectoryContents for %s should have returned err", ref)
+		}
+	}
+}
+
+func TestGithub_GetFile(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/contents/:path", func(c *gin.Context) {
+		if c.Param("path") != ".vela.yml" || c.Query("ref") != "main" {
+			c.Status(http.StatusNotFound)
+			return
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/yml.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	pipeline, err := os.ReadFile("testdata/pipeline.yml")
+	if err != nil {
+		t.Errorf("GetFile reading file returned err: %v", err)
+	}
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run tests
+	tests := []struct {
+		name string
+		file string
+		ref  string
+		want []byte
+	}{
+		{
+			name: "file",
+			file: ".vela.yml",
+			ref:  "main",
+			want: pipeline,
+		},
+		{
+			name: "file not found",
+			file: ".gitmodules",
+			ref:  "main",
+			want: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := client.GetFile(u, r, test.file, test.ref)
+			if err != nil {
+				t.Errorf("GetFile returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetFile is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -6,9 +6,13 @@ package github
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/go-vela/types/raw"
 	"github.com/google/go-github/v50/github"
@@ -22,8 +26,8 @@ func (c *client) GetDeployment(u *library.User, r *library.Repo, id int64) (*lib
 		"user": u.GetName(),
 	}).Tracef("capturing deployment %d for repo %s", id, r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
 
 	// send API call to capture the deployment
 	deployment, _, err := client.Repositories.GetDeployment(ctx, r.GetOrg(), r.GetName(), id)
@@ -60,8 +64,8 @@ func (c *client) GetDeploymentCount(u *library.User, r *library.Repo) (int64, er
 		"user": u.GetName(),
 	}).Tracef("counting deployments for repo %s", r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
 	// create variable to track the deployments
 	deployments := []*github.Deployment{}
 
@@ -102,8 +106,8 @@ func (c *client) GetDeploymentList(u *library.User, r *library.Repo, page, perPa
 		"user": u.GetName(),
 	}).Tracef("listing deployments for repo %s", r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
 
 	// set pagination options for listing deployments
 	opts := &github.DeploymentsListOptions{
@@ -195,3 +199,81 @@ func (c *client) CreateDeployment(u *library.User, r *library.Repo, d *library.D
 
 	return nil
 }
+
+// UpdateDeploymentStatus sends the status of the build
+// for the deployment to the environment in the GitHub repo.
+func (c *client) UpdateDeploymentStatus(u *library.User, b *library.Build, org, name string) error {
+	c.Logger.WithFields(logrus.Fields{
+		"build": b.GetNumber(),
+		"org":   org,
+		"repo":  name,
+		"user":  u.GetName(),
+	}).Tracef("setting deployment status for %s/%s/%d to %s", org, name, b.GetNumber(), b.GetDeploy())
+
+	// parse out deployment number from build source URL
+	//
+	// pattern: <org>/<repo>/deployments/<deployment_id>
+	_, id, found := strings.Cut(b.GetSource(), "/deployments/")
+	if !found {
+		return fmt.Errorf("unable to parse deployment from build source %s", b.GetSource())
+	}
+
+	number, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to parse deployment from build source %s: %w", b.GetSource(), err)
+	}
+
+	var (
+		state       string
+		description string
+	)
+
+	// set the state and description for the deployment
+	// depending on what the status of the build is
+	switch b.GetStatus() {
+	case constants.StatusPending:
+		state = "queued"
+		description = "the build is pending"
+	case constants.StatusRunning:
+		state = "in_progress"
+		description = "the build is running"
+	case constants.StatusSuccess:
+		state = "success"
+		description = "the build was successful"
+	case constants.StatusFailure:
+		state = "failure"
+		description = "the build has failed"
+	case constants.StatusCanceled:
+		state = "failure"
+		description = "the build was canceled"
+	case constants.StatusKilled:
+		state = "failure"
+		description = "the build was killed"
+	case constants.StatusSkipped:
+		state = "success"
+		description = "build was skipped as no steps/stages found"
+	default:
+		state = "error"
+		description = errorDescription(b)
+	}
+
+	// create the status object to make the API call
+	status := &github.DeploymentStatusRequest{
+		Description: github.String(description),
+		Environment: github.String(b.GetDeploy()),
+		State:       github.String(state),
+	}
+
+	// provide "View logs" link in GitHub UI if server was configured with it
+	if len(c.config.WebUIAddress) > 0 {
+		status.LogURL = github.String(fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber()))
+	}
+
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, org)
+
+	// send API call to create the status for the deployment
+	_, _, err = client.Repositories.CreateDeploymentStatus(ctx, org, name, number, status)
+
+	return err
+}
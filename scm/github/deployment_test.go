@@ -5,6 +5,8 @@
 package github
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 )
 
@@ -247,3 +250,106 @@ func TestGithub_GetDeploymentList(t *testing.T) {
 		t.Errorf("GetDeployment is %v, want %v", got, want)
 	}
 }
+
+func TestGithub_UpdateDeploymentStatus(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	// setup tests
+	tests := []struct {
+		status string
+		want   string
+	}{
+		{status: constants.StatusPending, want: "queued"},
+		{status: constants.StatusRunning, want: "in_progress"},
+		{status: constants.StatusSuccess, want: "success"},
+		{status: constants.StatusFailure, want: "failure"},
+		{status: constants.StatusCanceled, want: "failure"},
+		{status: constants.StatusError, want: "error"},
+	}
+
+	// run tests
+	for _, test := range tests {
+		// setup context
+		gin.SetMode(gin.TestMode)
+
+		resp := httptest.NewRecorder()
+		_, engine := gin.CreateTestContext(resp)
+
+		var (
+			got         map[string]string
+			gotID       string
+			gotEndpoint bool
+		)
+
+		// setup mock server
+		engine.POST("/api/v3/repos/:org/:repo/deployments/:deployment/statuses", func(c *gin.Context) {
+			gotEndpoint = true
+			gotID = c.Param("deployment")
+
+			_ = json.NewDecoder(c.Request.Body).Decode(&got)
+
+			c.Header("Content-Type", "application/json")
+			c.Status(http.StatusOK)
+			c.File("testdata/status.json")
+		})
+
+		s := httptest.NewServer(engine)
+
+		b := new(library.Build)
+		b.SetNumber(1)
+		b.SetEvent(constants.EventDeploy)
+		b.SetStatus(test.status)
+		b.SetDeploy("production")
+		b.SetSource(fmt.Sprintf("%s/%s/%s/deployments/2", s.URL, "foo", "bar"))
+
+		client, _ := NewTest(s.URL)
+
+		err := client.UpdateDeploymentStatus(u, b, "foo", "bar")
+
+		s.Close()
+
+		if err != nil {
+			t.Errorf("UpdateDeploymentStatus for %s returned err: %v", test.status, err)
+		}
+
+		if !gotEndpoint || gotID != "2" {
+			t.Errorf("UpdateDeploymentStatus for %s sent status for deployment %q, want %q", test.status, gotID, "2")
+		}
+
+		if got["state"] != test.want {
+			t.Errorf("UpdateDeploymentStatus for %s sent state %s, want %s", test.status, got["state"], test.want)
+		}
+
+		if got["environment"] != "production" {
+			t.Errorf("UpdateDeploymentStatus for %s sent environment %s, want %s", test.status, got["environment"], "production")
+		}
+
+		if got["log_url"] != s.URL+"/foo/bar/1" {
+			t.Errorf("UpdateDeploymentStatus for %s sent log url %s, want %s", test.status, got["log_url"], s.URL+"/foo/bar/1")
+		}
+	}
+}
+
+func TestGithub_UpdateDeploymentStatus_BadSource(t *testing.T) {
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	b := new(library.Build)
+	b.SetNumber(1)
+	b.SetEvent(constants.EventDeploy)
+	b.SetStatus(constants.StatusRunning)
+	b.SetSource("https://github.com/foo/bar/commit/abcd1234")
+
+	client, _ := NewTest("https://api.github.com")
+
+	// run test
+	err := client.UpdateDeploymentStatus(u, b, "foo", "bar")
+	if err == nil {
+		t.Errorf("UpdateDeploymentStatus should have returned err")
+	}
+}
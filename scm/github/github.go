@@ -6,8 +6,11 @@ package github
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
 	"net/url"
+	"sync"
+	"time"
 
 	"github.com/google/go-github/v50/github"
 	"github.com/sirupsen/logrus"
@@ -49,6 +52,20 @@ type config struct {
 	WebUIAddress string
 	// specifies the OAuth scopes to use for the GitHub client
 	Scopes []string
+	// specifies the ID of the GitHub App to use for the GitHub client
+	AppID int64
+	// specifies the private key of the GitHub App to use for the GitHub client
+	AppPrivateKey *rsa.PrivateKey
+	// specifies whether to report builds with the Checks API instead of commit statuses
+	UseChecks bool
+	// specifies whether to comment on pull requests with a summary of the build
+	UseComments bool
+	// specifies the number of times to retry throttled or failed requests to the API
+	Retries int
+	// specifies the initial time to wait before retrying a failed request to the API
+	RetryBackoff time.Duration
+	// specifies the longest time to wait before retrying a request to the API
+	RetryMaxWait time.Duration
 }
 
 type client struct {
@@ -57,6 +74,10 @@ type client struct {
 	AuthReq *github.AuthorizationRequest
 	// https://pkg.go.dev/github.com/sirupsen/logrus#Entry
 	Logger *logrus.Entry
+
+	// installation tokens for the GitHub App by org
+	installations   map[string]*installationToken
+	installationsMu sync.Mutex
 }
 
 // New returns a SCM implementation that integrates with
@@ -68,9 +89,14 @@ func New(opts ...ClientOpt) (*client, error) {
 	c := new(client)
 
 	// create new fields
-	c.config = new(config)
+	c.config = &config{
+		Retries:      3,
+		RetryBackoff: time.Second,
+		RetryMaxWait: time.Minute,
+	}
 	c.OAuth = new(oauth2.Config)
 	c.AuthReq = new(github.AuthorizationRequest)
+	c.installations = make(map[string]*installationToken)
 
 	// create new logger for the client
 	//
@@ -151,6 +177,10 @@ func (c *client) newClientToken(token string) *github.Client {
 
 	// create the OAuth client
 	tc := oauth2.NewClient(context.Background(), ts)
+
+	// retry requests throttled by the rate limit or failed by a server error
+	tc.Transport = c.newRetryTransport(tc.Transport)
+
 	// if c.SkipVerify {
 	// 	tc.Transport.(*oauth2.Transport).Base = &http.Transport{
 	// 		Proxy: http.ProxyFromEnvironment,
@@ -7,6 +7,9 @@ package github
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
 )
 
 // ClientOpt represents a configuration option to initialize the scm client for GitHub.
@@ -151,3 +154,118 @@ func WithScopes(scopes []string) ClientOpt {
 		return nil
 	}
 }
+
+// WithGithubAppID sets the ID of the GitHub App in the scm client for GitHub.
+func WithGithubAppID(id int64) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring app ID in github scm client")
+
+		// check if the app ID provided is invalid
+		if id < 0 {
+			return fmt.Errorf("invalid GitHub App ID provided: %d", id)
+		}
+
+		// set the app ID in the github client
+		c.config.AppID = id
+
+		return nil
+	}
+}
+
+// WithGithubPrivateKey sets the private key of the GitHub App in the scm client for GitHub.
+func WithGithubPrivateKey(key string) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring app private key in github scm client")
+
+		// skip if the private key provided is empty
+		if len(key) == 0 {
+			return nil
+		}
+
+		// parse the PEM encoded private key
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key))
+		if err != nil {
+			return fmt.Errorf("unable to parse GitHub App private key: %w", err)
+		}
+
+		// set the private key in the github client
+		c.config.AppPrivateKey = privateKey
+
+		return nil
+	}
+}
+
+// WithUseChecks sets whether builds are reported with the Checks API in the scm client for GitHub.
+func WithUseChecks(use bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring use of checks api in github scm client")
+
+		// set the use of the checks api in the github client
+		c.config.UseChecks = use
+
+		return nil
+	}
+}
+
+// WithUseComments sets whether pull requests are commented on with build summaries in the scm client for GitHub.
+func WithUseComments(use bool) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring use of pull request comments in github scm client")
+
+		// set the use of pull request comments in the github client
+		c.config.UseComments = use
+
+		return nil
+	}
+}
+
+// WithRetries sets the number of times to retry throttled or failed requests in the scm client for GitHub.
+func WithRetries(retries int) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring retries in github scm client")
+
+		// check if the retries provided is negative
+		if retries < 0 {
+			return fmt.Errorf("invalid retries provided: %d", retries)
+		}
+
+		// set the retries in the github client
+		c.config.Retries = retries
+
+		return nil
+	}
+}
+
+// WithRetryBackoff sets the initial time to wait before retrying a failed request in the scm client for GitHub.
+func WithRetryBackoff(backoff time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring retry backoff in github scm client")
+
+		// check if the backoff provided is negative
+		if backoff < 0 {
+			return fmt.Errorf("invalid retry backoff provided: %s", backoff)
+		}
+
+		// set the retry backoff in the github client
+		c.config.RetryBackoff = backoff
+
+		return nil
+	}
+}
+
+// WithRetryMaxWait sets the longest time to wait before retrying a request in the scm client for GitHub.
+func WithRetryMaxWait(wait time.Duration) ClientOpt {
+	return func(c *client) error {
+		c.Logger.Trace("configuring retry max wait in github scm client")
+
+		// check if the max wait provided is negative
+		if wait < 0 {
+			return fmt.Errorf("invalid retry max wait provided: %s", wait)
+		}
+
+		// set the retry max wait in the github client
+		c.config.RetryMaxWait = wait
+
+		return nil
+	}
+}
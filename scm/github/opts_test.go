@@ -5,8 +5,13 @@
 package github
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestGithub_ClientOpt_WithAddress(t *testing.T) {
@@ -350,3 +355,325 @@ func TestGithub_ClientOpt_WithScopes(t *testing.T) {
 		}
 	}
 }
+
+func TestGithub_ClientOpt_WithGithubAppID(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		id      int64
+		want    int64
+	}{
+		{
+			failure: false,
+			id:      1,
+			want:    1,
+		},
+		{
+			failure: false,
+			id:      0,
+			want:    0,
+		},
+		{
+			failure: true,
+			id:      -1,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithGithubAppID(test.id),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithGithubAppID should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithGithubAppID returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.AppID, test.want) {
+			t.Errorf("WithGithubAppID is %v, want %v", _service.config.AppID, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithGithubPrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Errorf("unable to generate key: %v", err)
+	}
+
+	pemKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		key     string
+		want    *rsa.PrivateKey
+	}{
+		{
+			failure: false,
+			key:     string(pemKey),
+			want:    key,
+		},
+		{
+			failure: false,
+			key:     "",
+			want:    nil,
+		},
+		{
+			failure: true,
+			key:     "foo",
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithGithubPrivateKey(test.key),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithGithubPrivateKey should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithGithubPrivateKey returned err: %v", err)
+		}
+
+		if test.want == nil {
+			if _service.config.AppPrivateKey != nil {
+				t.Errorf("WithGithubPrivateKey is %v, want nil", _service.config.AppPrivateKey)
+			}
+
+			continue
+		}
+
+		if !test.want.Equal(_service.config.AppPrivateKey) {
+			t.Errorf("WithGithubPrivateKey is %v, want %v", _service.config.AppPrivateKey, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithUseChecks(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		use  bool
+		want bool
+	}{
+		{
+			use:  true,
+			want: true,
+		},
+		{
+			use:  false,
+			want: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithUseChecks(test.use),
+		)
+
+		if err != nil {
+			t.Errorf("WithUseChecks returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.UseChecks, test.want) {
+			t.Errorf("WithUseChecks is %v, want %v", _service.config.UseChecks, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithUseComments(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		use  bool
+		want bool
+	}{
+		{
+			use:  true,
+			want: true,
+		},
+		{
+			use:  false,
+			want: false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithUseComments(test.use),
+		)
+
+		if err != nil {
+			t.Errorf("WithUseComments returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.UseComments, test.want) {
+			t.Errorf("WithUseComments is %v, want %v", _service.config.UseComments, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithRetries(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		retries int
+		want    int
+	}{
+		{
+			failure: false,
+			retries: 5,
+			want:    5,
+		},
+		{
+			failure: false,
+			retries: 0,
+			want:    0,
+		},
+		{
+			failure: true,
+			retries: -1,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithRetries(test.retries),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithRetries should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithRetries returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.Retries, test.want) {
+			t.Errorf("WithRetries is %v, want %v", _service.config.Retries, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithRetryBackoff(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		backoff time.Duration
+		want    time.Duration
+	}{
+		{
+			failure: false,
+			backoff: 2 * time.Second,
+			want:    2 * time.Second,
+		},
+		{
+			failure: false,
+			backoff: 0,
+			want:    0,
+		},
+		{
+			failure: true,
+			backoff: -time.Second,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithRetryBackoff(test.backoff),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithRetryBackoff should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithRetryBackoff returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.RetryBackoff, test.want) {
+			t.Errorf("WithRetryBackoff is %v, want %v", _service.config.RetryBackoff, test.want)
+		}
+	}
+}
+
+func TestGithub_ClientOpt_WithRetryMaxWait(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		wait    time.Duration
+		want    time.Duration
+	}{
+		{
+			failure: false,
+			wait:    time.Hour,
+			want:    time.Hour,
+		},
+		{
+			failure: false,
+			wait:    0,
+			want:    0,
+		},
+		{
+			failure: true,
+			wait:    -time.Hour,
+			want:    0,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		_service, err := New(
+			WithRetryMaxWait(test.wait),
+		)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("WithRetryMaxWait should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("WithRetryMaxWait returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(_service.config.RetryMaxWait, test.want) {
+			t.Errorf("WithRetryMaxWait is %v, want %v", _service.config.RetryMaxWait, test.want)
+		}
+	}
+}
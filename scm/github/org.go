@@ -5,11 +5,13 @@
 package github
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
 )
 
 // GetOrgName gets org name from Github.
@@ -41,3 +43,80 @@ func (c *client) GetOrgName(u *library.User, o string) (string, error) {
 
 	return orgName, nil
 }
+
+// EnableOrg creates the webhook for all repos in the org.
+func (c *client) EnableOrg(u *library.User, org, secret string) (int64, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("creating org webhook for %s", org)
+
+	// create GitHub OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// create the hook object to make the API call
+	//
+	// the org webhook listens to every event supported
+	// by Vela since the repo settings filter the events
+	hook := &github.Hook{
+		Events: []string{
+			eventDeployment,
+			eventIssueComment,
+			eventPullRequest,
+			eventPush,
+			eventRepository,
+		},
+		Config: map[string]interface{}{
+			"url":          fmt.Sprintf("%s/webhook", c.config.ServerWebhookAddress),
+			"content_type": "form",
+			"secret":       secret,
+		},
+		Active: github.Bool(true),
+	}
+
+	// send API call to create the webhook
+	hookInfo, resp, err := client.Organizations.CreateHook(ctx, org, hook)
+	if err != nil {
+		if resp != nil {
+			switch resp.StatusCode {
+			case http.StatusUnprocessableEntity:
+				return 0, fmt.Errorf("org already enabled")
+			case http.StatusNotFound:
+				return 0, fmt.Errorf("org not found")
+			}
+		}
+
+		return 0, err
+	}
+
+	return hookInfo.GetID(), nil
+}
+
+// DisableOrg deletes the webhook for all repos in the org.
+func (c *client) DisableOrg(u *library.User, org string, webhookID int64) error {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  org,
+		"user": u.GetName(),
+	}).Tracef("deleting org webhook for %s", org)
+
+	// create GitHub OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to delete the webhook
+	resp, err := client.Organizations.DeleteHook(ctx, org, webhookID)
+	if err != nil {
+		// skip if the webhook was already deleted
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			c.Logger.WithFields(logrus.Fields{
+				"org":  org,
+				"user": u.GetName(),
+			}).Warnf("no org webhook %d found for %s", webhookID, org)
+
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -129,3 +129,131 @@ func TestGithub_GetOrgName_Fail(t *testing.T) {
 		t.Error("GetOrgName should return error")
 	}
 }
+
+func TestGithub_EnableOrg(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.POST("/api/v3/orgs/:org/hooks", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusCreated)
+		c.File("testdata/org_hook.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	want := int64(1)
+
+	client, _ := NewTest(s.URL, "https://foo.bar.com")
+
+	// run test
+	got, err := client.EnableOrg(u, "foo", "secret")
+
+	if err != nil {
+		t.Errorf("EnableOrg returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("EnableOrg is %v, want %v", got, want)
+	}
+}
+
+func TestGithub_EnableOrg_AlreadyEnabled(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.POST("/api/v3/orgs/:org/hooks", func(c *gin.Context) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"message": "Validation Failed"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL, "https://foo.bar.com")
+
+	// run test
+	_, err := client.EnableOrg(u, "foo", "secret")
+
+	if err == nil {
+		t.Errorf("EnableOrg should have returned err")
+	}
+}
+
+func TestGithub_DisableOrg(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.DELETE("/api/v3/orgs/:org/hooks/:hook_id", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL, "https://foo.bar.com")
+
+	// run test
+	err := client.DisableOrg(u, "foo", 1)
+
+	if err != nil {
+		t.Errorf("DisableOrg returned err: %v", err)
+	}
+}
+
+func TestGithub_DisableOrg_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.DELETE("/api/v3/orgs/:org/hooks/:hook_id", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"message": "Not Found"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	client, _ := NewTest(s.URL, "https://foo.bar.com")
+
+	// run test
+	err := client.DisableOrg(u, "foo", 1)
+
+	if err != nil {
+		t.Errorf("DisableOrg returned err: %v", err)
+	}
+}
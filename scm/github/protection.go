@@ -0,0 +1,214 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-vela/types/constants"
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
+	"github.com/sirupsen/logrus"
+)
+
+// ProtectBranch configures the branch protection for a branch of the repo
+// to require the status contexts before pull requests can be merged. The
+// default branch for the repo is protected when no branch is provided and
+// the status context for pull request builds is required when no contexts
+// are provided. Existing protection for the branch is preserved and the
+// contexts are added to the status checks already required for the branch.
+func (c *client) ProtectBranch(u *library.User, r *library.Repo, branch string, contexts []string, strict bool) error {
+	// default the branch to the default branch for the repo
+	if len(branch) == 0 {
+		branch = r.GetBranch()
+	}
+
+	// default the contexts to the status context for pull request builds
+	if len(contexts) == 0 {
+		contexts = []string{fmt.Sprintf("%s/%s", c.config.StatusContext, constants.EventPull)}
+	}
+
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("protecting branch %s for %s to require %v", branch, r.GetFullName(), contexts)
+
+	// create GitHub OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to capture the protection for the branch
+	protection, _, err := client.Repositories.GetBranchProtection(ctx, r.GetOrg(), r.GetName(), branch)
+	if err != nil {
+		if !errors.Is(err, github.ErrBranchNotProtected) {
+			return fmt.Errorf("Repositories.GetBranchProtection returned error: %w", err)
+		}
+
+		// send API call to protect the branch with only the status checks
+		_, _, err = client.Repositories.UpdateBranchProtection(ctx, r.GetOrg(), r.GetName(), branch, &github.ProtectionRequest{
+			RequiredStatusChecks: &github.RequiredStatusChecks{
+				Strict:   strict,
+				Contexts: contexts,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Repositories.UpdateBranchProtection returned error: %w", err)
+		}
+
+		return nil
+	}
+
+	// check if the branch is protected without status checks
+	if protection.RequiredStatusChecks == nil {
+		request := protectionRequest(protection)
+
+		request.RequiredStatusChecks = &github.RequiredStatusChecks{
+			Strict:   strict,
+			Contexts: contexts,
+		}
+
+		// send API call to add the status checks to the existing protection
+		_, _, err = client.Repositories.UpdateBranchProtection(ctx, r.GetOrg(), r.GetName(), branch, request)
+		if err != nil {
+			return fmt.Errorf("Repositories.UpdateBranchProtection returned error: %w", err)
+		}
+
+		return nil
+	}
+
+	existing := protection.RequiredStatusChecks
+	required := existing.Contexts
+
+	// add the contexts not already required for the branch
+	for _, context := range contexts {
+		found := false
+
+		for _, r := range existing.Contexts {
+			if r == context {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			required = append(required, context)
+		}
+	}
+
+	// skip updating status checks already requiring the contexts
+	if len(required) == len(existing.Contexts) && (existing.Strict || !strict) {
+		return nil
+	}
+
+	// send API call to add the contexts to the required status checks
+	_, _, err = client.Repositories.UpdateRequiredStatusChecks(ctx, r.GetOrg(), r.GetName(), branch, &github.RequiredStatusChecksRequest{
+		Strict:   github.Bool(existing.Strict || strict),
+		Contexts: required,
+	})
+	if err != nil {
+		return fmt.Errorf("Repositories.UpdateRequiredStatusChecks returned error: %w", err)
+	}
+
+	return nil
+}
+
+// protectionRequest is a helper function to convert the existing
+// protection for a branch to a request that replaces the protection
+// for the branch with the same settings.
+func protectionRequest(p *github.Protection) *github.ProtectionRequest {
+	request := new(github.ProtectionRequest)
+
+	if p.EnforceAdmins != nil {
+		request.EnforceAdmins = p.EnforceAdmins.Enabled
+	}
+
+	if p.RequireLinearHistory != nil {
+		request.RequireLinearHistory = github.Bool(p.RequireLinearHistory.Enabled)
+	}
+
+	if p.AllowForcePushes != nil {
+		request.AllowForcePushes = github.Bool(p.AllowForcePushes.Enabled)
+	}
+
+	if p.AllowDeletions != nil {
+		request.AllowDeletions = github.Bool(p.AllowDeletions.Enabled)
+	}
+
+	if p.RequiredConversationResolution != nil {
+		request.RequiredConversationResolution = github.Bool(p.RequiredConversationResolution.Enabled)
+	}
+
+	if p.Restrictions != nil {
+		request.Restrictions = &github.BranchRestrictionsRequest{
+			Users: userLogins(p.Restrictions.Users),
+			Teams: teamSlugs(p.Restrictions.Teams),
+			Apps:  appSlugs(p.Restrictions.Apps),
+		}
+	}
+
+	if reviews := p.RequiredPullRequestReviews; reviews != nil {
+		request.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcementRequest{
+			DismissStaleReviews:          reviews.DismissStaleReviews,
+			RequireCodeOwnerReviews:      reviews.RequireCodeOwnerReviews,
+			RequiredApprovingReviewCount: reviews.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      github.Bool(reviews.RequireLastPushApproval),
+		}
+
+		if d := reviews.DismissalRestrictions; d != nil {
+			users, teams, apps := userLogins(d.Users), teamSlugs(d.Teams), appSlugs(d.Apps)
+
+			request.RequiredPullRequestReviews.DismissalRestrictionsRequest = &github.DismissalRestrictionsRequest{
+				Users: &users,
+				Teams: &teams,
+				Apps:  &apps,
+			}
+		}
+
+		if b := reviews.BypassPullRequestAllowances; b != nil {
+			request.RequiredPullRequestReviews.BypassPullRequestAllowancesRequest = &github.BypassPullRequestAllowancesRequest{
+				Users: userLogins(b.Users),
+				Teams: teamSlugs(b.Teams),
+				Apps:  appSlugs(b.Apps),
+			}
+		}
+	}
+
+	return request
+}
+
+// userLogins is a helper function to capture the logins for the users.
+func userLogins(users []*github.User) []string {
+	logins := []string{}
+
+	for _, u := range users {
+		logins = append(logins, u.GetLogin())
+	}
+
+	return logins
+}
+
+// teamSlugs is a helper function to capture the slugs for the teams.
+func teamSlugs(teams []*github.Team) []string {
+	slugs := []string{}
+
+	for _, t := range teams {
+		slugs = append(slugs, t.GetSlug())
+	}
+
+	return slugs
+}
+
+// appSlugs is a helper function to capture the slugs for the apps.
+func appSlugs(apps []*github.App) []string {
+	slugs := []string{}
+
+	for _, a := range apps {
+		slugs = append(slugs, a.GetSlug())
+	}
+
+	return slugs
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
+)
+
+func TestGithub_ProtectBranch(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+	r.SetFullName("foo/bar")
+	r.SetBranch("main")
+
+	// setup tests
+	tests := []struct {
+		name       string
+		existing   string
+		contexts   []string
+		strict     bool
+		wantBranch string
+		wantPut    *github.ProtectionRequest
+		wantPatch  *github.RequiredStatusChecksRequest
+	}{
+		{
+			name:       "unprotected branch",
+			existing:   "",
+			wantBranch: "main",
+			wantPut: &github.ProtectionRequest{
+				RequiredStatusChecks: &github.RequiredStatusChecks{
+					Contexts: []string{"continuous-integration/vela/pull_request"},
+				},
+			},
+		},
+		{
+			name:       "protected branch without status checks",
+			existing:   `{"required_pull_request_reviews": {"required_approving_review_count": 1}, "enforce_admins": {"enabled": true}}`,
+			strict:     true,
+			wantBranch: "main",
+			wantPut: &github.ProtectionRequest{
+				RequiredStatusChecks: &github.RequiredStatusChecks{
+					Strict:   true,
+					Contexts: []string{"continuous-integration/vela/pull_request"},
+				},
+				RequiredPullRequestReviews: &github.PullRequestReviewsEnforcementRequest{
+					RequiredApprovingReviewCount: 1,
+					RequireLastPushApproval:      github.Bool(false),
+				},
+				EnforceAdmins: true,
+			},
+		},
+		{
+			name:       "protected branch with status checks",
+			existing:   `{"required_status_checks": {"strict": true, "contexts": ["lint"]}}`,
+			contexts:   []string{"continuous-integration/vela/push"},
+			wantBranch: "main",
+			wantPatch: &github.RequiredStatusChecksRequest{
+				Strict:   github.Bool(true),
+				Contexts: []string{"lint", "continuous-integration/vela/push"},
+			},
+		},
+		{
+			name:       "protected branch already requiring contexts",
+			existing:   `{"required_status_checks": {"strict": false, "contexts": ["continuous-integration/vela/pull_request"]}}`,
+			wantBranch: "main",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var (
+				branch   string
+				gotPut   *github.ProtectionRequest
+				gotPatch *github.RequiredStatusChecksRequest
+			)
+
+			resp := httptest.NewRecorder()
+			_, engine := gin.CreateTestContext(resp)
+
+			// setup mock server
+			engine.GET("/api/v3/repos/:org/:repo/branches/:branch/protection", func(c *gin.Context) {
+				branch = c.Param("branch")
+
+				c.Header("Content-Type", "application/json")
+
+				if len(test.existing) == 0 {
+					c.String(http.StatusNotFound, `{"message": "Branch not protected"}`)
+					return
+				}
+
+				c.String(http.StatusOK, test.existing)
+			})
+			engine.PUT("/api/v3/repos/:org/:repo/branches/:branch/protection", func(c *gin.Context) {
+				gotPut = new(github.ProtectionRequest)
+				_ = c.BindJSON(gotPut)
+
+				c.Header("Content-Type", "application/json")
+				c.String(http.StatusOK, `{}`)
+			})
+			engine.PATCH("/api/v3/repos/:org/:repo/branches/:branch/protection/required_status_checks", func(c *gin.Context) {
+				gotPatch = new(github.RequiredStatusChecksRequest)
+				_ = c.BindJSON(gotPatch)
+
+				c.Header("Content-Type", "application/json")
+				c.String(http.StatusOK, `{}`)
+			})
+
+			s := httptest.NewServer(engine)
+			defer s.Close()
+
+			client, _ := NewTest(s.URL)
+
+			err := client.ProtectBranch(u, r, "", test.contexts, test.strict)
+			if err != nil {
+				t.Errorf("ProtectBranch returned err: %v", err)
+			}
+
+			if branch != test.wantBranch {
+				t.Errorf("ProtectBranch branch is %v, want %v", branch, test.wantBranch)
+			}
+
+			if !reflect.DeepEqual(gotPut, test.wantPut) {
+				t.Errorf("ProtectBranch protection is %v, want %v", gotPut, test.wantPut)
+			}
+
+			if !reflect.DeepEqual(gotPatch, test.wantPatch) {
+				t.Errorf("ProtectBranch status checks is %v, want %v", gotPatch, test.wantPatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-vela/types/library"
+	"github.com/google/go-github/v50/github"
+	"github.com/sirupsen/logrus"
+)
+
+// ListPullRequestLabels captures the names of the labels applied to a pull request.
+func (c *client) ListPullRequestLabels(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing labels for %s/pull/%d", r.GetFullName(), number)
+
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
+	s := []string{}
+
+	// set the max per page for the options to capture the list of labels
+	opts := github.ListOptions{PerPage: 100} // 100 is max
+
+	for {
+		// send API call to capture the labels from the pull request
+		labels, resp, err := client.Issues.ListLabelsByIssue(ctx, r.GetOrg(), r.GetName(), number, &opts)
+		if err != nil {
+			return nil, fmt.Errorf("Issues.ListLabelsByIssue returned error: %w", err)
+		}
+
+		for _, label := range labels {
+			s = append(s, label.GetName())
+		}
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return s, nil
+}
+
+// ListPullRequestReviews captures the reviewers and the
+// number of approvals for a pull request. Only the most
+// recent decision of each reviewer counts as an approval.
+func (c *client) ListPullRequestReviews(u *library.User, r *library.Repo, number int) ([]string, int, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing reviews for %s/pull/%d", r.GetFullName(), number)
+
+	reviewers, decisions, err := c.listReviewDecisions(u, r, number)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	approvals := 0
+
+	for _, decision := range decisions {
+		if decision == "APPROVED" {
+			approvals++
+		}
+	}
+
+	return reviewers, approvals, nil
+}
+
+// ListPullRequestApprovers captures the reviewers whose most
+// recent decision for a pull request is an approval.
+func (c *client) ListPullRequestApprovers(u *library.User, r *library.Repo, number int) ([]string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing approvers for %s/pull/%d", r.GetFullName(), number)
+
+	reviewers, decisions, err := c.listReviewDecisions(u, r, number)
+	if err != nil {
+		return nil, err
+	}
+
+	approvers := []string{}
+
+	for _, reviewer := range reviewers {
+		if decisions[reviewer] == "APPROVED" {
+			approvers = append(approvers, reviewer)
+		}
+	}
+
+	return approvers, nil
+}
+
+// ListCommitPullRequests captures the numbers of the
+// pull requests associated with a commit for a repo.
+func (c *client) ListCommitPullRequests(u *library.User, r *library.Repo, sha string) ([]int, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing pull requests for %s commit %s", r.GetFullName(), sha)
+
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
+	numbers := []int{}
+
+	// set the max per page for the options to capture the list of pull requests
+	opts := github.PullRequestListOptions{
+		ListOptions: github.ListOptions{PerPage: 100}, // 100 is max
+	}
+
+	for {
+		// send API call to capture the pull requests for the commit
+		pulls, resp, err := client.PullRequests.ListPullRequestsWithCommit(ctx, r.GetOrg(), r.GetName(), sha, &opts)
+		if err != nil {
+			return nil, fmt.Errorf("PullRequests.ListPullRequestsWithCommit returned error: %w", err)
+		}
+
+		for _, pull := range pulls {
+			numbers = append(numbers, pull.GetNumber())
+		}
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return numbers, nil
+}
+
+// listReviewDecisions is a helper function to capture the reviewers, in
+// the order they first reviewed, and the most recent decision of each
+// reviewer for a pull request. Comments do not change a decision.
+func (c *client) listReviewDecisions(u *library.User, r *library.Repo, number int) ([]string, map[string]string, error) {
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
+	reviewers := []string{}
+	decisions := make(map[string]string)
+
+	// set the max per page for the options to capture the list of reviews
+	opts := github.ListOptions{PerPage: 100} // 100 is max
+
+	for {
+		// send API call to capture the reviews from the pull request
+		reviews, resp, err := client.PullRequests.ListReviews(ctx, r.GetOrg(), r.GetName(), number, &opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("PullRequests.ListReviews returned error: %w", err)
+		}
+
+		// reviews are returned in chronological order
+		for _, review := range reviews {
+			login := review.GetUser().GetLogin()
+
+			if _, ok := decisions[login]; !ok {
+				reviewers = append(reviewers, login)
+				decisions[login] = ""
+			}
+
+			// comments do not change the decision of a reviewer
+			if !strings.EqualFold(review.GetState(), "COMMENTED") {
+				decisions[login] = strings.ToUpper(review.GetState())
+			}
+		}
+
+		// break the loop if there is no more results to page through
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	return reviewers, decisions, nil
+}
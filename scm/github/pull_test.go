@@ -0,0 +1,188 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-vela/types/library"
+)
+
+func TestGithub_ListPullRequestLabels(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/issues/:issue_number/labels", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/list_labels.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := []string{"bug", "deploy-preview"}
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListPullRequestLabels(u, r, 1)
+
+	if err != nil {
+		t.Errorf("ListPullRequestLabels returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListPullRequestLabels is %v, want %v", got, want)
+	}
+}
+
+func TestGithub_ListPullRequestReviews(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/pulls/:pull_number/reviews", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/list_reviews.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	wantReviewers := []string{"octocat", "hubot", "monalisa"}
+	wantApprovals := 2
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	gotReviewers, gotApprovals, err := client.ListPullRequestReviews(u, r, 1)
+
+	if err != nil {
+		t.Errorf("ListPullRequestReviews returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(gotReviewers, wantReviewers) {
+		t.Errorf("ListPullRequestReviews reviewers are %v, want %v", gotReviewers, wantReviewers)
+	}
+
+	if gotApprovals != wantApprovals {
+		t.Errorf("ListPullRequestReviews approvals are %d, want %d", gotApprovals, wantApprovals)
+	}
+}
+
+func TestGithub_ListPullRequestApprovers(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/pulls/:pull_number/reviews", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/list_reviews.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := []string{"octocat", "hubot"}
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListPullRequestApprovers(u, r, 1)
+
+	if err != nil {
+		t.Errorf("ListPullRequestApprovers returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListPullRequestApprovers is %v, want %v", got, want)
+	}
+}
+
+func TestGithub_ListCommitPullRequests(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:org/:repo/commits/:sha/pulls", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/list_commit_pulls.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	want := []int{1347, 1350}
+
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.ListCommitPullRequests(u, r, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+
+	if err != nil {
+		t.Errorf("ListCommitPullRequests returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListCommitPullRequests is %v, want %v", got, want)
+	}
+}
@@ -7,12 +7,12 @@ package github
 import (
 	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 	"github.com/google/go-github/v50/github"
@@ -55,8 +55,8 @@ func (c *client) Config(u *library.User, r *library.Repo, ref string) ([]byte, e
 		"user": u.GetName(),
 	}).Tracef("capturing configuration file for %s/commit/%s", r.GetFullName(), ref)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
 
 	files := []string{".vela.yml", ".vela.yaml"}
 
@@ -92,6 +92,26 @@ func (c *client) Config(u *library.User, r *library.Repo, ref string) ([]byte, e
 	return nil, fmt.Errorf("no valid pipeline configuration file (%s) found", strings.Join(files, ","))
 }
 
+// GetCommitSHA captures the commit SHA a ref points to from the GitHub repo.
+func (c *client) GetCommitSHA(u *library.User, r *library.Repo, ref string) (string, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing commit for %s/commit/%s", r.GetFullName(), ref)
+
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
+
+	// send API call to capture the commit SHA for the ref
+	sha, _, err := client.Repositories.GetCommitSHA1(ctx, r.GetOrg(), r.GetName(), ref, "")
+	if err != nil {
+		return "", fmt.Errorf("Repositories.GetCommitSHA1 returned error: %w", err)
+	}
+
+	return sha, nil
+}
+
 // Disable deactivates a repo by deleting the webhook.
 func (c *client) Disable(u *library.User, org, name string) error {
 	c.Logger.WithFields(logrus.Fields{
@@ -275,8 +295,8 @@ func (c *client) Status(u *library.User, b *library.Build, org, name string) err
 		"user":  u.GetName(),
 	}).Tracef("setting commit status for %s/%s/%d @ %s", org, name, b.GetNumber(), b.GetCommit())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, org)
 
 	context := fmt.Sprintf("%s/%s", c.config.StatusContext, b.GetEvent())
 	url := fmt.Sprintf("%s/%s/%s/%d", c.config.WebUIAddress, org, name, b.GetNumber())
@@ -310,44 +330,35 @@ func (c *client) Status(u *library.User, b *library.Build, org, name string) err
 		description = "build was skipped as no steps/stages found"
 	default:
 		state = "error"
-		description = "there was an error"
+		description = errorDescription(b)
 	}
 
-	// check if the build event is deployment
+	// report the status for the deployment instead of the commit
 	if strings.EqualFold(b.GetEvent(), constants.EventDeploy) {
-		// parse out deployment number from build source URL
-		//
-		// pattern: <org>/<repo>/deployments/<deployment_id>
-		var parts []string
-		if strings.Contains(b.GetSource(), "/deployments/") {
-			parts = strings.Split(b.GetSource(), "/deployments/")
-		}
+		return c.UpdateDeploymentStatus(u, b, org, name)
+	}
 
-		// capture number by converting from string
-		number, err := strconv.Atoi(parts[1])
-		if err != nil {
-			// capture number by scanning from string
-			_, err := fmt.Sscanf(b.GetSource(), "%s/%d", nil, &number)
-			if err != nil {
-				return err
+	// report the build with the checks api when configured
+	if c.config.UseChecks {
+		// create GitHub client authenticated as the app for the org
+		appClient, err := c.newAppClientForOrg(org)
+		if err == nil {
+			output := &github.CheckRunOutput{
+				Title:   github.String(description),
+				Summary: github.String(fmt.Sprintf("Build #%d is %s.", b.GetNumber(), b.GetStatus())),
 			}
-		}
 
-		// create the status object to make the API call
-		status := &github.DeploymentStatusRequest{
-			Description: github.String(description),
-			Environment: github.String(b.GetDeploy()),
-			State:       github.String(state),
-		}
+			if len(c.config.WebUIAddress) == 0 || b.GetStatus() == constants.StatusSkipped {
+				url = ""
+			}
 
-		// provide "Details" link in GitHub UI if server was configured with it
-		if len(c.config.WebUIAddress) > 0 {
-			status.LogURL = github.String(url)
+			return upsertCheckRun(appClient, b, org, name, context, url, output)
 		}
 
-		_, _, err = client.Repositories.CreateDeploymentStatus(ctx, org, name, int64(number), status)
-
-		return err
+		c.Logger.WithFields(logrus.Fields{
+			"org":  org,
+			"repo": name,
+		}).Debugf("falling back to commit status without github app: %v", err)
 	}
 
 	// create the status object to make the API call
@@ -368,6 +379,25 @@ func (c *client) Status(u *library.User, b *library.Build, org, name string) err
 	return err
 }
 
+// maxStatusDescription represents the maximum length
+// GitHub accepts for the description of a status.
+const maxStatusDescription = 140
+
+// errorDescription is a helper function to describe why the build
+// errored, truncated to the length GitHub allows for a status.
+func errorDescription(b *library.Build) string {
+	description := b.GetError()
+	if len(description) == 0 {
+		return "there was an error"
+	}
+
+	if len(description) > maxStatusDescription {
+		description = description[:maxStatusDescription-3] + "..."
+	}
+
+	return description
+}
+
 // GetRepo gets repo information from Github.
 func (c *client) GetRepo(u *library.User, r *library.Repo) (*library.Repo, error) {
 	c.Logger.WithFields(logrus.Fields{
@@ -388,6 +418,43 @@ func (c *client) GetRepo(u *library.User, r *library.Repo) (*library.Repo, error
 	return toLibraryRepo(*repo), nil
 }
 
+// GetRepoSync captures the state of the repo in GitHub to sync it with
+// the database. GitHub redirects requests for a repo that was renamed or
+// transferred to the repo under its new name, so the state is captured
+// for the new name. A nil state is returned when the repo was deleted.
+func (c *client) GetRepoSync(u *library.User, r *library.Repo) (*api.RepoSync, error) {
+	c.Logger.WithFields(logrus.Fields{
+		"org":  r.GetOrg(),
+		"repo": r.GetName(),
+		"user": u.GetName(),
+	}).Tracef("capturing repository state for %s", r.GetFullName())
+
+	// create GitHub OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send an API call to get the repo info
+	repo, resp, err := client.Repositories.Get(ctx, r.GetOrg(), r.GetName())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	s := new(api.RepoSync)
+	s.SetOrg(repo.GetOwner().GetLogin())
+	s.SetName(repo.GetName())
+	s.SetLink(repo.GetHTMLURL())
+	s.SetClone(repo.GetCloneURL())
+	s.SetBranch(repo.GetDefaultBranch())
+	s.SetPrivate(repo.GetPrivate())
+	s.SetArchived(repo.GetArchived() || repo.GetDisabled())
+	s.SetTopics(repo.Topics)
+
+	return s, nil
+}
+
 // GetOrgAndRepoName returns the name of the org and the repository in the SCM.
 func (c *client) GetOrgAndRepoName(u *library.User, o string, r string) (string, string, error) {
 	c.Logger.WithFields(logrus.Fields{
@@ -484,8 +551,8 @@ func (c *client) GetPullRequest(u *library.User, r *library.Repo, number int) (s
 		"user": u.GetName(),
 	}).Tracef("retrieving pull request %d for repo %s", number, r.GetFullName())
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(u.GetToken())
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, r.GetOrg())
 
 	pull, _, err := client.PullRequests.Get(ctx, r.GetOrg(), r.GetName(), number)
 	if err != nil {
@@ -508,8 +575,8 @@ func (c *client) GetHTMLURL(u *library.User, org, repo, name, ref string) (strin
 		"user": u.GetName(),
 	}).Tracef("capturing html_url for %s/%s/%s@%s", org, repo, name, ref)
 
-	// create GitHub OAuth client with user's token
-	client := c.newClientToken(*u.Token)
+	// create GitHub client for the org
+	client := c.newClientForOrg(u, org)
 
 	// set the reference for the options to capture the repository contents
 	opts := &github.RepositoryContentGetOptions{
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
 )
@@ -422,6 +423,80 @@ func TestGithub_Config_NotFound(t *testing.T) {
 	}
 }
 
+func TestGithub_GetCommitSHA(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/commits/:ref", func(c *gin.Context) {
+		c.String(http.StatusOK, "6dcb09b5b57875f334f61aebed695e2e4193db5e")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	want := "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetCommitSHA(u, r, "main")
+
+	if err != nil {
+		t.Errorf("GetCommitSHA returned err: %v", err)
+	}
+
+	if got != want {
+		t.Errorf("GetCommitSHA is %v, want %v", got, want)
+	}
+}
+
+func TestGithub_GetCommitSHA_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/foo/bar/commits/:ref", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("foo")
+	r.SetName("bar")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	_, err := client.GetCommitSHA(u, r, "missing")
+
+	if err == nil {
+		t.Error("GetCommitSHA should have returned err")
+	}
+}
+
 func TestGithub_Disable(t *testing.T) {
 	// setup context
 	gin.SetMode(gin.TestMode)
@@ -1080,6 +1155,99 @@ func TestGithub_GetRepo_Fail(t *testing.T) {
 	}
 }
 
+func TestGithub_GetRepoSync(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:owner/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/get_repo.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	want := new(api.RepoSync)
+	want.SetOrg("octocat")
+	want.SetName("Hello-World")
+	want.SetLink("https://github.com/octocat/Hello-World")
+	want.SetClone("https://github.com/octocat/Hello-World.git")
+	want.SetBranch("master")
+	want.SetPrivate(false)
+	want.SetArchived(false)
+	want.SetTopics([]string{"octocat", "atom", "electron", "api"})
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetRepoSync(u, r)
+
+	if resp.Code != http.StatusOK {
+		t.Errorf("GetRepoSync returned %v, want %v", resp.Code, http.StatusOK)
+	}
+
+	if err != nil {
+		t.Errorf("GetRepoSync returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRepoSync is %v, want %v", got, want)
+	}
+}
+
+func TestGithub_GetRepoSync_NotFound(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/repos/:owner/:repo", func(c *gin.Context) {
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusNotFound)
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	// setup types
+	u := new(library.User)
+	u.SetName("foo")
+	u.SetToken("bar")
+
+	r := new(library.Repo)
+	r.SetOrg("octocat")
+	r.SetName("Hello-World")
+
+	client, _ := NewTest(s.URL)
+
+	// run test
+	got, err := client.GetRepoSync(u, r)
+
+	if err != nil {
+		t.Errorf("GetRepoSync returned err: %v", err)
+	}
+
+	if got != nil {
+		t.Errorf("GetRepoSync is %v, want nil", got)
+	}
+}
+
 func TestGithub_GetOrgAndRepoName(t *testing.T) {
 	// setup context
 	gin.SetMode(gin.TestMode)
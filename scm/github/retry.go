@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// headers GitHub sends with the rate limit for the API.
+	//
+	// https://docs.github.com/en/rest/overview/resources-in-the-rest-api#rate-limit-http-headers
+	headerRateLimit     = "X-RateLimit-Limit"
+	headerRateRemaining = "X-RateLimit-Remaining"
+	headerRateReset     = "X-RateLimit-Reset"
+	headerRateResource  = "X-RateLimit-Resource"
+	headerRetryAfter    = "Retry-After"
+
+	// reasons a request to the API is retried.
+	retryRateLimit          = "rate_limit"
+	retrySecondaryRateLimit = "secondary_rate_limit"
+	retryServerError        = "server_error"
+)
+
+var (
+	rateLimitLimit = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "vela_scm_rate_limit_limit",
+			Help: "The number of requests allowed in the rate limit window for the GitHub API.",
+		},
+		[]string{"resource"},
+	)
+
+	rateLimitRemaining = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "vela_scm_rate_limit_remaining",
+			Help: "The number of requests remaining in the rate limit window for the GitHub API.",
+		},
+		[]string{"resource"},
+	)
+
+	rateLimitReset = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "vela_scm_rate_limit_reset_timestamp_seconds",
+			Help: "The unix time the rate limit window for the GitHub API resets.",
+		},
+		[]string{"resource"},
+	)
+
+	retries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "vela_scm_retries_total",
+			Help: "The number of requests to the GitHub API that were retried.",
+		},
+		[]string{"reason"},
+	)
+)
+
+// retryTransport represents an http.RoundTripper that retries
+// requests to the GitHub API throttled by the rate limit or
+// failed by a server error, waiting for the rate limit to
+// reset or backing off exponentially between attempts.
+type retryTransport struct {
+	// http.RoundTripper used to send the requests
+	base http.RoundTripper
+	// number of times to retry a request
+	retries int
+	// initial time to wait before retrying a server error
+	backoff time.Duration
+	// longest time to wait before retrying a request
+	maxWait time.Duration
+	// logger used to report the retried requests
+	logger *logrus.Entry
+}
+
+// newRetryTransport is a helper function to wrap the
+// transport with the retries configured for the client.
+func (c *client) newRetryTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &retryTransport{
+		base:    base,
+		retries: c.config.Retries,
+		backoff: c.config.RetryBackoff,
+		maxWait: c.config.RetryMaxWait,
+		logger:  c.Logger,
+	}
+}
+
+// RoundTrip sends the request and retries it while the response
+// is throttled or a server error, up to the configured retries.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		r := req
+
+		// rewind the body of the request for each retry
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			r = req.Clone(req.Context())
+			r.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+
+		record(resp)
+
+		wait, reason := t.delay(resp, attempt)
+
+		// return the response when it should not be retried
+		if len(reason) == 0 || attempt >= t.retries || wait > t.maxWait || !rewindable(req) {
+			return resp, nil
+		}
+
+		t.logger.WithFields(logrus.Fields{
+			"attempt": attempt + 1,
+			"reason":  reason,
+			"status":  resp.StatusCode,
+		}).Warnf("retrying %s %s in %s", req.Method, req.URL.Path, wait)
+
+		retries.WithLabelValues(reason).Inc()
+
+		// discard the response being retried
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// delay is a helper function to capture how long to wait before
+// retrying the response and the reason the response is retried.
+// No reason is returned for responses that should not be retried.
+func (t *retryTransport) delay(resp *http.Response, attempt int) (time.Duration, string) {
+	backoff := t.backoff * time.Duration(1<<attempt)
+
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusTooManyRequests:
+		// secondary rate limits provide the seconds to wait
+		//
+		// https://docs.github.com/en/rest/overview/resources-in-the-rest-api#secondary-rate-limits
+		if after := resp.Header.Get(headerRetryAfter); len(after) > 0 {
+			seconds, err := strconv.Atoi(after)
+			if err == nil {
+				return time.Duration(seconds) * time.Second, retrySecondaryRateLimit
+			}
+		}
+
+		// primary rate limits provide the time the window resets
+		if resp.Header.Get(headerRateRemaining) == "0" {
+			reset, err := strconv.ParseInt(resp.Header.Get(headerRateReset), 10, 64)
+			if err != nil {
+				return backoff, retryRateLimit
+			}
+
+			wait := time.Until(time.Unix(reset, 0))
+			if wait < t.backoff {
+				wait = t.backoff
+			}
+
+			return wait, retryRateLimit
+		}
+
+		// forbidden responses without a rate limit are not retried
+		if resp.StatusCode == http.StatusForbidden {
+			return 0, ""
+		}
+
+		return backoff, retryRateLimit
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return backoff, retryServerError
+	default:
+		return 0, ""
+	}
+}
+
+// rewindable is a helper function to determine if
+// the body of the request can be sent again.
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// record is a helper function to update the metrics
+// with the rate limit provided in the response.
+func record(resp *http.Response) {
+	remaining := resp.Header.Get(headerRateRemaining)
+	if len(remaining) == 0 {
+		return
+	}
+
+	resource := resp.Header.Get(headerRateResource)
+	if len(resource) == 0 {
+		resource = "core"
+	}
+
+	if v, err := strconv.ParseFloat(remaining, 64); err == nil {
+		rateLimitRemaining.WithLabelValues(resource).Set(v)
+	}
+
+	if v, err := strconv.ParseFloat(resp.Header.Get(headerRateLimit), 64); err == nil {
+		rateLimitLimit.WithLabelValues(resource).Set(v)
+	}
+
+	if v, err := strconv.ParseFloat(resp.Header.Get(headerRateReset), 64); err == nil {
+		rateLimitReset.WithLabelValues(resource).Set(v)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGithub_retryTransport(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name     string
+		statuses []int
+		headers  map[string]string
+		want     int
+		attempts int
+	}{
+		{
+			name:     "success",
+			statuses: []int{http.StatusOK},
+			want:     http.StatusOK,
+			attempts: 1,
+		},
+		{
+			name:     "server error",
+			statuses: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			want:     http.StatusOK,
+			attempts: 3,
+		},
+		{
+			name:     "server error exhausts retries",
+			statuses: []int{http.StatusInternalServerError},
+			want:     http.StatusInternalServerError,
+			attempts: 4,
+		},
+		{
+			name:     "rate limit",
+			statuses: []int{http.StatusForbidden, http.StatusOK},
+			headers: map[string]string{
+				headerRateRemaining: "0",
+				headerRateReset:     strconv.FormatInt(time.Now().Unix(), 10),
+			},
+			want:     http.StatusOK,
+			attempts: 2,
+		},
+		{
+			name:     "rate limit exceeds max wait",
+			statuses: []int{http.StatusForbidden, http.StatusOK},
+			headers: map[string]string{
+				headerRateRemaining: "0",
+				headerRateReset:     strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+			},
+			want:     http.StatusForbidden,
+			attempts: 1,
+		},
+		{
+			name:     "secondary rate limit",
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			headers:  map[string]string{headerRetryAfter: "0"},
+			want:     http.StatusOK,
+			attempts: 2,
+		},
+		{
+			name:     "forbidden",
+			statuses: []int{http.StatusForbidden, http.StatusOK},
+			want:     http.StatusForbidden,
+			attempts: 1,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			attempts := 0
+
+			// setup mock server
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != "payload" {
+					t.Errorf("request body is %s, want payload", body)
+				}
+
+				status := test.statuses[len(test.statuses)-1]
+				if attempts < len(test.statuses) {
+					status = test.statuses[attempts]
+				}
+
+				attempts++
+
+				if status != http.StatusOK {
+					for k, v := range test.headers {
+						w.Header().Set(k, v)
+					}
+				}
+
+				w.WriteHeader(status)
+			}))
+			defer s.Close()
+
+			c, _ := NewTest(s.URL)
+			_ = WithRetryBackoff(time.Millisecond)(c)
+
+			client := &http.Client{Transport: c.newRetryTransport(nil)}
+
+			// run test
+			resp, err := client.Post(s.URL, "text/plain", strings.NewReader("payload"))
+			if err != nil {
+				t.Fatalf("Post returned err: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != test.want {
+				t.Errorf("Post returned %d, want %d", resp.StatusCode, test.want)
+			}
+
+			if attempts != test.attempts {
+				t.Errorf("Post sent %d attempts, want %d", attempts, test.attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"strings"
+
+	"github.com/go-vela/types/library"
+)
+
+// headerScopes represents the header GitHub responds with
+// listing the OAuth scopes granted to the token for a request.
+const headerScopes = "X-OAuth-Scopes"
+
+// impliedScopes represents the OAuth scopes from GitHub that
+// implicitly grant other scopes, i.e. repo grants repo:status.
+//
+// https://docs.github.com/en/apps/oauth-apps/building-oauth-apps/scopes-for-oauth-apps#available-scopes
+var impliedScopes = map[string][]string{
+	"repo":                      {"repo:status", "repo_deployment", "public_repo", "repo:invite", "security_events"},
+	"admin:repo_hook":           {"write:repo_hook"},
+	"write:repo_hook":           {"read:repo_hook"},
+	"admin:org":                 {"write:org"},
+	"write:org":                 {"read:org"},
+	"admin:public_key":          {"write:public_key"},
+	"write:public_key":          {"read:public_key"},
+	"admin:gpg_key":             {"write:gpg_key"},
+	"write:gpg_key":             {"read:gpg_key"},
+	"user":                      {"read:user", "user:email", "user:follow"},
+	"write:packages":            {"read:packages"},
+	"project":                   {"read:project"},
+	"write:discussion":          {"read:discussion"},
+	"admin:enterprise":          {"manage_runners:enterprise", "manage_billing:enterprise", "read:enterprise"},
+	"manage_billing:enterprise": {"read:enterprise"},
+}
+
+// GetScopes captures the OAuth scopes granted to the token for a user.
+//
+// GitHub only reports the scopes for OAuth and classic personal access
+// tokens so no scopes are returned for other tokens, i.e. the tokens
+// for a GitHub App or fine-grained personal access tokens.
+func (c *client) GetScopes(u *library.User) ([]string, error) {
+	c.Logger.Tracef("capturing oauth scopes for user %s", u.GetName())
+
+	// create GitHub OAuth client with user's token
+	client := c.newClientToken(u.GetToken())
+
+	// send API call to capture the current user making the call
+	_, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// check if the scopes were reported for the token
+	if len(resp.Header.Values(headerScopes)) == 0 {
+		return nil, nil
+	}
+
+	scopes := []string{}
+
+	for _, scope := range strings.Split(resp.Header.Get(headerScopes), ",") {
+		scope = strings.TrimSpace(scope)
+		if len(scope) > 0 {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes, nil
+}
+
+// MissingScopes captures the OAuth scopes required by
+// the client that are not granted by the provided scopes,
+// accounting for the scopes that grant other scopes.
+func (c *client) MissingScopes(scopes []string) []string {
+	granted := make(map[string]bool)
+
+	// expand the provided scopes to include the scopes they grant
+	pending := append([]string{}, scopes...)
+	for len(pending) > 0 {
+		scope := pending[0]
+		pending = pending[1:]
+
+		if granted[scope] {
+			continue
+		}
+
+		granted[scope] = true
+
+		pending = append(pending, impliedScopes[scope]...)
+	}
+
+	missing := []string{}
+
+	for _, scope := range c.config.Scopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package github
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestGithub_GetScopes(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	resp := httptest.NewRecorder()
+	_, engine := gin.CreateTestContext(resp)
+
+	// setup mock server
+	engine.GET("/api/v3/user", func(c *gin.Context) {
+		switch c.GetHeader("Authorization") {
+		case "Bearer oauth":
+			c.Header(headerScopes, "repo, read:org,user:email")
+		case "Bearer empty":
+			c.Writer.Header()[http.CanonicalHeaderKey(headerScopes)] = []string{""}
+		}
+
+		c.Header("Content-Type", "application/json")
+		c.Status(http.StatusOK)
+		c.File("testdata/user.json")
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := NewTest(s.URL)
+
+	// setup tests
+	tests := []struct {
+		name  string
+		token string
+		want  []string
+	}{
+		{
+			name:  "oauth token",
+			token: "oauth",
+			want:  []string{"repo", "read:org", "user:email"},
+		},
+		{
+			name:  "token without scopes",
+			token: "empty",
+			want:  []string{},
+		},
+		{
+			name:  "scopes not reported",
+			token: "app",
+			want:  nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u := new(library.User)
+			u.SetName("octocat")
+			u.SetToken(test.token)
+
+			got, err := client.GetScopes(u)
+			if err != nil {
+				t.Errorf("GetScopes returned err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetScopes is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGithub_MissingScopes(t *testing.T) {
+	// setup types
+	client, _ := NewTest("https://github.com/")
+	client.config.Scopes = []string{"repo", "repo:status", "user:email", "read:user", "read:org"}
+
+	// setup tests
+	tests := []struct {
+		name   string
+		scopes []string
+		want   []string
+	}{
+		{
+			name:   "exact scopes",
+			scopes: []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+			want:   []string{},
+		},
+		{
+			name:   "implied scopes",
+			scopes: []string{"repo", "user", "admin:org"},
+			want:   []string{},
+		},
+		{
+			name:   "missing scopes",
+			scopes: []string{"public_repo", "read:user", "write:org"},
+			want:   []string{"repo", "repo:status", "user:email"},
+		},
+		{
+			name:   "no scopes",
+			scopes: []string{},
+			want:   []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := client.MissingScopes(test.scopes)
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("MissingScopes is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ package github
 
 import (
 	"context"
+	"crypto/sha1" //nolint:gosec // sha1 is only used for legacy webhook signatures
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/go-vela/server/scm/verify"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/constants"
 	"github.com/go-vela/types/library"
@@ -60,6 +63,23 @@ func (c *client) ProcessWebhook(request *http.Request) (*types.Webhook, error) {
 	}
 
 	// process the event from the webhook
+	webhook, err := c.processEvent(h, event)
+	if err != nil || webhook.Repo == nil {
+		return webhook, err
+	}
+
+	// webhooks delivered by the org hook link to the settings for the org
+	if strings.EqualFold(request.Header.Get("X-GitHub-Hook-Installation-Target-Type"), "organization") {
+		h.SetLink(
+			fmt.Sprintf("https://%s/organizations/%s/settings/hooks/%d", h.GetHost(), webhook.Repo.GetOrg(), h.GetWebhookID()),
+		)
+	}
+
+	return webhook, nil
+}
+
+// processEvent is a helper function to process the event parsed from the webhook.
+func (c *client) processEvent(h *library.Hook, event interface{}) (*types.Webhook, error) {
 	switch event := event.(type) {
 	case *github.PushEvent:
 		return c.processPushEvent(h, event)
@@ -77,18 +97,25 @@ func (c *client) ProcessWebhook(request *http.Request) (*types.Webhook, error) {
 }
 
 // VerifyWebhook verifies the webhook from a repo.
+//
+// GitHub signs the payload with the secret for the webhook and
+// provides the signature in the X-Hub-Signature-256 header, or the
+// X-Hub-Signature header for older GitHub Enterprise Server versions.
 func (c *client) VerifyWebhook(request *http.Request, r *library.Repo) error {
 	c.Logger.WithFields(logrus.Fields{
 		"org":  r.GetOrg(),
 		"repo": r.GetName(),
 	}).Tracef("verifying GitHub webhook for %s", r.GetFullName())
 
-	_, err := github.ValidatePayload(request, []byte(r.GetHash()))
-	if err != nil {
-		return err
+	// skip verifying webhooks for repos without a secret
+	if len(r.GetHash()) == 0 {
+		return nil
 	}
 
-	return nil
+	return verify.Payload(request, r.GetHash(),
+		verify.Signature{Header: github.SHA256SignatureHeader, Prefix: "sha256=", Hash: sha256.New},
+		verify.Signature{Header: github.SHA1SignatureHeader, Prefix: "sha1=", Hash: sha1.New},
+	)
 }
 
 // RedeliverWebhook redelivers webhooks from GitHub.
@@ -211,17 +238,6 @@ func (c *client) processPREvent(h *library.Hook, payload *github.PullRequestEven
 		fmt.Sprintf("https://%s/%s/settings/hooks", h.GetHost(), payload.GetRepo().GetFullName()),
 	)
 
-	// if the pull request state isn't open we ignore it
-	if payload.GetPullRequest().GetState() != "open" {
-		return &types.Webhook{Hook: h}, nil
-	}
-
-	// skip if the pull request action is not opened, synchronize
-	if !strings.EqualFold(payload.GetAction(), "opened") &&
-		!strings.EqualFold(payload.GetAction(), "synchronize") {
-		return &types.Webhook{Hook: h}, nil
-	}
-
 	// capture the repo from the payload
 	repo := payload.GetRepo()
 
@@ -235,6 +251,31 @@ func (c *client) processPREvent(h *library.Hook, payload *github.PullRequestEven
 	r.SetBranch(repo.GetDefaultBranch())
 	r.SetPrivate(repo.GetPrivate())
 
+	// if the pull request was closed, return the repo and number
+	// without a build so the resources for it can be cleaned up
+	if strings.EqualFold(payload.GetAction(), "closed") {
+		h.SetEventAction(payload.GetAction())
+
+		return &types.Webhook{
+			PRNumber: payload.GetNumber(),
+			Hook:     h,
+			Repo:     r,
+		}, nil
+	}
+
+	// if the pull request state isn't open we ignore it
+	if payload.GetPullRequest().GetState() != "open" {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	// skip if the pull request action is not opened, synchronize, labeled or unlabeled
+	if !strings.EqualFold(payload.GetAction(), "opened") &&
+		!strings.EqualFold(payload.GetAction(), "synchronize") &&
+		!strings.EqualFold(payload.GetAction(), "labeled") &&
+		!strings.EqualFold(payload.GetAction(), "unlabeled") {
+		return &types.Webhook{Hook: h}, nil
+	}
+
 	// convert payload to library build
 	b := new(library.Build)
 	b.SetEvent(constants.EventPull)
@@ -5,7 +5,11 @@
 package github
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -258,6 +262,87 @@ func TestGithub_ProcessWebhook_PullRequest(t *testing.T) {
 	}
 }
 
+func TestGithub_ProcessWebhook_PullRequest_LabeledAction(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/pull_request_labeled.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "GitHub-Hookshot/a22606a")
+	request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-GitHub-Hook-ID", "123456")
+	request.Header.Set("X-GitHub-Host", "github.com")
+	request.Header.Set("X-GitHub-Version", "2.16.0")
+	request.Header.Set("X-GitHub-Event", "pull_request")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetWebhookID(123456)
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("github.com")
+	wantHook.SetEvent("pull_request")
+	wantHook.SetBranch("master")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink("https://github.com/Codertocat/Hello-World/settings/hooks")
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("Codertocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("Codertocat/Hello-World")
+	wantRepo.SetLink("https://github.com/Codertocat/Hello-World")
+	wantRepo.SetClone("https://github.com/Codertocat/Hello-World.git")
+	wantRepo.SetBranch("master")
+	wantRepo.SetPrivate(false)
+
+	wantBuild := new(library.Build)
+	wantBuild.SetEvent("pull_request")
+	wantBuild.SetEventAction("labeled")
+	wantBuild.SetClone("https://github.com/Codertocat/Hello-World.git")
+	wantBuild.SetSource("https://github.com/Codertocat/Hello-World/pull/1")
+	wantBuild.SetTitle("pull_request received from https://github.com/Codertocat/Hello-World")
+	wantBuild.SetMessage("Update the README with new information")
+	wantBuild.SetCommit("34c5c7793cb3b279e22454cb6750c80560547b3a")
+	wantBuild.SetSender("Codertocat")
+	wantBuild.SetAuthor("Codertocat")
+	wantBuild.SetEmail("")
+	wantBuild.SetBranch("master")
+	wantBuild.SetRef("refs/pull/1/head")
+	wantBuild.SetBaseRef("master")
+	wantBuild.SetHeadRef("changes")
+
+	want := &types.Webhook{
+		Comment:  "",
+		PRNumber: wantHook.GetNumber(),
+		Hook:     wantHook,
+		Repo:     wantRepo,
+		Build:    wantBuild,
+	}
+
+	got, err := client.ProcessWebhook(request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
 func TestGithub_ProcessWebhook_PullRequest_ClosedAction(t *testing.T) {
 	// setup router
 	s := httptest.NewServer(http.NotFoundHandler())
@@ -294,12 +379,23 @@ func TestGithub_ProcessWebhook_PullRequest_ClosedAction(t *testing.T) {
 	wantHook.SetBranch("master")
 	wantHook.SetStatus(constants.StatusSuccess)
 	wantHook.SetLink("https://github.com/Codertocat/Hello-World/settings/hooks")
+	wantHook.SetEventAction("closed")
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetOrg("Codertocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("Codertocat/Hello-World")
+	wantRepo.SetLink("https://github.com/Codertocat/Hello-World")
+	wantRepo.SetClone("https://github.com/Codertocat/Hello-World.git")
+	wantRepo.SetBranch("master")
+	wantRepo.SetPrivate(false)
 
 	want := &types.Webhook{
-		Comment: "",
-		Hook:    wantHook,
-		Repo:    nil,
-		Build:   nil,
+		Comment:  "",
+		PRNumber: 1,
+		Hook:     wantHook,
+		Repo:     wantRepo,
+		Build:    nil,
 	}
 
 	got, err := client.ProcessWebhook(request)
@@ -723,6 +819,77 @@ func TestGithub_VerifyWebhook_NoSecret(t *testing.T) {
 	}
 }
 
+func TestGithub_VerifyWebhook_Signature(t *testing.T) {
+	// setup types
+	payload, err := os.ReadFile("testdata/hooks/push.json")
+	if err != nil {
+		t.Errorf("unable to read file: %v", err)
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+
+	r := new(library.Repo)
+	r.SetOrg("Codertocat")
+	r.SetName("Hello-World")
+	r.SetFullName("Codertocat/Hello-World")
+	r.SetHash("secret")
+
+	// setup tests
+	tests := []struct {
+		failure   bool
+		name      string
+		signature string
+	}{
+		{
+			failure:   false,
+			name:      "valid signature",
+			signature: "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+		},
+		{
+			failure:   true,
+			name:      "invalid signature",
+			signature: "sha256=" + hex.EncodeToString([]byte("invalid")),
+		},
+		{
+			failure:   true,
+			name:      "missing signature",
+			signature: "",
+		},
+	}
+
+	// setup client
+	client, _ := NewTest("https://github.com/")
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, _ := http.NewRequestWithContext(context.Background(), http.MethodPost, "/test", bytes.NewReader(payload))
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+			request.Header.Set("X-GitHub-Event", "push")
+
+			if len(test.signature) > 0 {
+				request.Header.Set("X-Hub-Signature-256", test.signature)
+			}
+
+			err := client.VerifyWebhook(request, r)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("VerifyWebhook should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("VerifyWebhook returned err: %v", err)
+			}
+		})
+	}
+}
+
 func TestGithub_ProcessWebhook_IssueComment_PR(t *testing.T) {
 	// setup router
 	s := httptest.NewServer(http.NotFoundHandler())
@@ -1057,6 +1224,70 @@ func TestGitHub_ProcessWebhook_RepositoryArchived(t *testing.T) {
 	}
 }
 
+func TestGitHub_ProcessWebhook_RepositoryCreated_OrgHook(t *testing.T) {
+	// setup router
+	s := httptest.NewServer(http.NotFoundHandler())
+	defer s.Close()
+
+	// setup request
+	body, err := os.Open("testdata/hooks/repository_created.json")
+	if err != nil {
+		t.Errorf("unable to open file: %v", err)
+	}
+
+	defer body.Close()
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "/test", body)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "GitHub-Hookshot/a22606a")
+	request.Header.Set("X-GitHub-Delivery", "7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	request.Header.Set("X-GitHub-Hook-ID", "123456")
+	request.Header.Set("X-GitHub-Hook-Installation-Target-Type", "organization")
+	request.Header.Set("X-GitHub-Event", "repository")
+
+	// setup client
+	client, _ := NewTest(s.URL)
+
+	// run test
+	wantHook := new(library.Hook)
+	wantHook.SetNumber(1)
+	wantHook.SetSourceID("7bd477e4-4415-11e9-9359-0d41fdf9567e")
+	wantHook.SetWebhookID(123456)
+	wantHook.SetCreated(time.Now().UTC().Unix())
+	wantHook.SetHost("github.com")
+	wantHook.SetEvent(constants.EventRepository)
+	wantHook.SetEventAction(constants.ActionCreated)
+	wantHook.SetBranch("master")
+	wantHook.SetStatus(constants.StatusSuccess)
+	wantHook.SetLink("https://github.com/organizations/Codertocat/settings/hooks/123456")
+
+	wantRepo := new(library.Repo)
+	wantRepo.SetActive(true)
+	wantRepo.SetOrg("Codertocat")
+	wantRepo.SetName("Hello-World")
+	wantRepo.SetFullName("Codertocat/Hello-World")
+	wantRepo.SetLink("https://octocoders.github.io/Codertocat/Hello-World")
+	wantRepo.SetClone("https://octocoders.github.io/Codertocat/Hello-World.git")
+	wantRepo.SetBranch("master")
+	wantRepo.SetPrivate(false)
+
+	want := &types.Webhook{
+		Comment: "",
+		Hook:    wantHook,
+		Repo:    wantRepo,
+	}
+
+	got, err := client.ProcessWebhook(request)
+
+	if err != nil {
+		t.Errorf("ProcessWebhook returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProcessWebhook is %v, want %v", got, want)
+	}
+}
+
 func TestGitHub_ProcessWebhook_RepositoryEdited(t *testing.T) {
 	// setup router
 	s := httptest.NewServer(http.NotFoundHandler())
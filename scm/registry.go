@@ -0,0 +1,422 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// Registry represents a Vela service hosting more than one
+// configured scm at once, i.e. GitHub and GitHub Enterprise
+// Server. Calls for a repo or build are sent to the scm hosting
+// the repo, matched by the host of the clone URL for the repo.
+// Calls without a repo, like authenticating users, are sent to
+// the primary scm.
+type Registry struct {
+	// primary scm the calls without a repo are sent to
+	primary Service
+	// scm services by host
+	services map[string]Service
+	// hosts of the scm services in the order registered
+	hosts []string
+}
+
+// NewRegistry creates and returns a Vela service hosting
+// the primary scm available at the provided address.
+func NewRegistry(primary Service, address string) (*Registry, error) {
+	r := &Registry{
+		primary:  primary,
+		services: make(map[string]Service),
+	}
+
+	err := r.Register(address, primary)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Register adds the scm available at the provided address to
+// the registry to handle the calls for repos hosted by it.
+func (r *Registry) Register(address string, s Service) error {
+	host, err := hostFor(address)
+	if err != nil {
+		return fmt.Errorf("invalid scm address %s: %w", address, err)
+	}
+
+	if _, ok := r.services[host]; ok {
+		return fmt.Errorf("scm for host %s already registered", host)
+	}
+
+	logrus.Debugf("registering %s scm for host %s", s.Driver(), host)
+
+	r.services[host] = s
+	r.hosts = append(r.hosts, host)
+
+	return nil
+}
+
+// Hosts returns the host of each scm in the registry.
+func (r *Registry) Hosts() []string {
+	return append([]string{}, r.hosts...)
+}
+
+// ForAddress returns the scm hosting the provided address,
+// falling back to the primary scm for an unknown host.
+func (r *Registry) ForAddress(address string) Service {
+	host, err := hostFor(address)
+	if err != nil {
+		return r.primary
+	}
+
+	s, ok := r.services[host]
+	if !ok {
+		return r.primary
+	}
+
+	return s
+}
+
+// ForRepo returns the scm hosting the provided repo.
+func (r *Registry) ForRepo(repo *library.Repo) Service {
+	if len(repo.GetClone()) > 0 {
+		return r.ForAddress(repo.GetClone())
+	}
+
+	return r.ForAddress(repo.GetLink())
+}
+
+// ForBuild returns the scm hosting the repo for the provided build.
+func (r *Registry) ForBuild(b *library.Build) Service {
+	if len(b.GetClone()) > 0 {
+		return r.ForAddress(b.GetClone())
+	}
+
+	return r.ForAddress(b.GetSource())
+}
+
+// ForRepo is a helper function to return the scm hosting the
+// repo when the provided scm is a registry. Otherwise, the
+// provided scm is returned.
+func ForRepo(s Service, repo *library.Repo) Service {
+	r, ok := s.(*Registry)
+	if !ok {
+		return s
+	}
+
+	return r.ForRepo(repo)
+}
+
+// hostFor is a helper function to capture
+// the host of the provided address.
+func hostFor(address string) (string, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", err
+	}
+
+	if len(u.Host) == 0 {
+		return "", fmt.Errorf("no host found")
+	}
+
+	return strings.ToLower(u.Host), nil
+}
+
+// Driver outputs the configured driver of the primary scm.
+func (r *Registry) Driver() string {
+	return r.primary.Driver()
+}
+
+// Authorize uses the given access token to authorize the user with the primary scm.
+func (r *Registry) Authorize(token string) (string, error) {
+	return r.primary.Authorize(token)
+}
+
+// Authenticate completes the OAuth workflow for the session with the primary scm.
+func (r *Registry) Authenticate(w http.ResponseWriter, req *http.Request, state string) (*library.User, error) {
+	return r.primary.Authenticate(w, req, state)
+}
+
+// AuthenticateToken completes the OAuth workflow for the session
+// using a personal access token with the primary scm.
+func (r *Registry) AuthenticateToken(req *http.Request) (*library.User, error) {
+	return r.primary.AuthenticateToken(req)
+}
+
+// Login begins the OAuth workflow for the session with the primary scm.
+func (r *Registry) Login(w http.ResponseWriter, req *http.Request) (string, error) {
+	return r.primary.Login(w, req)
+}
+
+// GetScopes captures the OAuth scopes granted to the token for a user from the primary scm.
+func (r *Registry) GetScopes(u *library.User) ([]string, error) {
+	return r.primary.GetScopes(u)
+}
+
+// MissingScopes captures the OAuth scopes required by the primary scm that are not granted.
+func (r *Registry) MissingScopes(scopes []string) []string {
+	return r.primary.MissingScopes(scopes)
+}
+
+// OrgAccess captures the user's access level for an org in the primary scm.
+func (r *Registry) OrgAccess(u *library.User, org string) (string, error) {
+	return r.primary.OrgAccess(u, org)
+}
+
+// RepoAccess captures the user's access level for a repo in the primary scm.
+//
+// Use ForRepo to capture the access level for a repo in the scm hosting it.
+func (r *Registry) RepoAccess(u *library.User, token, org, repo string) (string, error) {
+	return r.primary.RepoAccess(u, token, org, repo)
+}
+
+// TeamAccess captures the user's access level for a team in the primary scm.
+func (r *Registry) TeamAccess(u *library.User, org, team string) (string, error) {
+	return r.primary.TeamAccess(u, org, team)
+}
+
+// ListUsersTeamsForOrg captures the user's teams for an org in the primary scm.
+func (r *Registry) ListUsersTeamsForOrg(u *library.User, org string) ([]string, error) {
+	return r.primary.ListUsersTeamsForOrg(u, org)
+}
+
+// ListTeamMembers captures the members of a team for an org in the primary scm.
+func (r *Registry) ListTeamMembers(u *library.User, org, team string) ([]string, error) {
+	return r.primary.ListTeamMembers(u, org, team)
+}
+
+// Changeset captures the list of files changed for a commit.
+func (r *Registry) Changeset(u *library.User, repo *library.Repo, sha string) ([]string, error) {
+	return r.ForRepo(repo).Changeset(u, repo, sha)
+}
+
+// ChangesetPR captures the list of files changed for a pull request.
+func (r *Registry) ChangesetPR(u *library.User, repo *library.Repo, number int) ([]string, error) {
+	return r.ForRepo(repo).ChangesetPR(u, repo, number)
+}
+
+// GetDeployment gets a deployment by number and repo.
+func (r *Registry) GetDeployment(u *library.User, repo *library.Repo, id int64) (*library.Deployment, error) {
+	return r.ForRepo(repo).GetDeployment(u, repo, id)
+}
+
+// GetDeploymentCount counts a list of all deployments for a repo.
+func (r *Registry) GetDeploymentCount(u *library.User, repo *library.Repo) (int64, error) {
+	return r.ForRepo(repo).GetDeploymentCount(u, repo)
+}
+
+// GetDeploymentList gets a list of all deployments for a repo.
+func (r *Registry) GetDeploymentList(u *library.User, repo *library.Repo, page, perPage int) ([]*library.Deployment, error) {
+	return r.ForRepo(repo).GetDeploymentList(u, repo, page, perPage)
+}
+
+// CreateDeployment creates a new deployment.
+func (r *Registry) CreateDeployment(u *library.User, repo *library.Repo, d *library.Deployment) error {
+	return r.ForRepo(repo).CreateDeployment(u, repo, d)
+}
+
+// UpdateDeploymentStatus sends the status of a build for a deployment from a repo.
+func (r *Registry) UpdateDeploymentStatus(u *library.User, b *library.Build, org, name string) error {
+	return r.ForBuild(b).UpdateDeploymentStatus(u, b, org, name)
+}
+
+// Config captures the pipeline configuration from a repo.
+func (r *Registry) Config(u *library.User, repo *library.Repo, ref string) ([]byte, error) {
+	return r.ForRepo(repo).Config(u, repo, ref)
+}
+
+// ConfigBackoff is a truncated constant backoff wrapper for Config.
+func (r *Registry) ConfigBackoff(u *library.User, repo *library.Repo, ref string) ([]byte, error) {
+	return r.ForRepo(repo).ConfigBackoff(u, repo, ref)
+}
+
+// GetCommitSHA captures the commit SHA a ref points to for a repo.
+func (r *Registry) GetCommitSHA(u *library.User, repo *library.Repo, ref string) (string, error) {
+	return r.ForRepo(repo).GetCommitSHA(u, repo, ref)
+}
+
+// GetDirectoryContents captures the contents of every file in a directory from a repo.
+func (r *Registry) GetDirectoryContents(u *library.User, repo *library.Repo, dir, ref string) (map[string][]byte, error) {
+	return r.ForRepo(repo).GetDirectoryContents(u, repo, dir, ref)
+}
+
+// GetFile captures the contents of a single file from a repo.
+func (r *Registry) GetFile(u *library.User, repo *library.Repo, file, ref string) ([]byte, error) {
+	return r.ForRepo(repo).GetFile(u, repo, file, ref)
+}
+
+// Disable deactivates a repo in the primary scm by destroying the webhook.
+//
+// Use ForRepo to deactivate a repo in the scm hosting it.
+func (r *Registry) Disable(u *library.User, org, name string) error {
+	return r.primary.Disable(u, org, name)
+}
+
+// Enable activates a repo by creating the webhook.
+func (r *Registry) Enable(u *library.User, repo *library.Repo) (*library.Hook, string, error) {
+	return r.ForRepo(repo).Enable(u, repo)
+}
+
+// Update updates a webhook for a specified repo.
+func (r *Registry) Update(u *library.User, repo *library.Repo, hookID int64) error {
+	return r.ForRepo(repo).Update(u, repo, hookID)
+}
+
+// Status sends the commit status for the given SHA from a repo.
+func (r *Registry) Status(u *library.User, b *library.Build, org, name string) error {
+	return r.ForBuild(b).Status(u, b, org, name)
+}
+
+// ReportSteps sends the results for the steps of a build from a repo.
+func (r *Registry) ReportSteps(u *library.User, b *library.Build, steps []*library.Step, org, name string) error {
+	return r.ForBuild(b).ReportSteps(u, b, steps, org, name)
+}
+
+// ProtectBranch configures the branch protection for a repo to require the status contexts.
+func (r *Registry) ProtectBranch(u *library.User, repo *library.Repo, branch string, contexts []string, strict bool) error {
+	return r.ForRepo(repo).ProtectBranch(u, repo, branch, contexts, strict)
+}
+
+// ListUserRepos retrieves all repos with admin rights for the user in the primary scm.
+func (r *Registry) ListUserRepos(u *library.User) ([]*library.Repo, error) {
+	return r.primary.ListUserRepos(u)
+}
+
+// GetPullRequest retrieves a pull request for a repo.
+func (r *Registry) GetPullRequest(u *library.User, repo *library.Repo, number int) (string, string, string, string, error) {
+	return r.ForRepo(repo).GetPullRequest(u, repo, number)
+}
+
+// ListPullRequestLabels retrieves the names of the labels applied to a pull request.
+func (r *Registry) ListPullRequestLabels(u *library.User, repo *library.Repo, number int) ([]string, error) {
+	return r.ForRepo(repo).ListPullRequestLabels(u, repo, number)
+}
+
+// ListPullRequestReviews retrieves the reviewers and number of approvals for a pull request.
+func (r *Registry) ListPullRequestReviews(u *library.User, repo *library.Repo, number int) ([]string, int, error) {
+	return r.ForRepo(repo).ListPullRequestReviews(u, repo, number)
+}
+
+// ListPullRequestApprovers retrieves the reviewers whose latest decision approves a pull request.
+func (r *Registry) ListPullRequestApprovers(u *library.User, repo *library.Repo, number int) ([]string, error) {
+	return r.ForRepo(repo).ListPullRequestApprovers(u, repo, number)
+}
+
+// ListCommitPullRequests retrieves the numbers of the pull requests associated with a commit.
+func (r *Registry) ListCommitPullRequests(u *library.User, repo *library.Repo, sha string) ([]int, error) {
+	return r.ForRepo(repo).ListCommitPullRequests(u, repo, sha)
+}
+
+// UpsertPullRequestComment creates or updates the comment identified by a key on a pull request.
+func (r *Registry) UpsertPullRequestComment(u *library.User, repo *library.Repo, number int, key, body string) error {
+	return r.ForRepo(repo).UpsertPullRequestComment(u, repo, number, key, body)
+}
+
+// CommentOnPR creates or updates the comment summarizing a build on the pull request for the build.
+func (r *Registry) CommentOnPR(u *library.User, repo *library.Repo, b *library.Build, steps []*library.Step) error {
+	return r.ForRepo(repo).CommentOnPR(u, repo, b, steps)
+}
+
+// GetRepo retrieves details for a repo.
+func (r *Registry) GetRepo(u *library.User, repo *library.Repo) (*library.Repo, error) {
+	return r.ForRepo(repo).GetRepo(u, repo)
+}
+
+// GetRepoSync captures the state of a repo in the scm to sync it with the database.
+func (r *Registry) GetRepoSync(u *library.User, repo *library.Repo) (*api.RepoSync, error) {
+	return r.ForRepo(repo).GetRepoSync(u, repo)
+}
+
+// GetOrgAndRepoName retrieves the name of the org and repo in the primary scm.
+func (r *Registry) GetOrgAndRepoName(u *library.User, org, name string) (string, string, error) {
+	return r.primary.GetOrgAndRepoName(u, org, name)
+}
+
+// GetOrgName retrieves the name for an org in the primary scm.
+func (r *Registry) GetOrgName(u *library.User, org string) (string, error) {
+	return r.primary.GetOrgName(u, org)
+}
+
+// GetHTMLURL retrieves a repository file's html_url in the primary scm.
+func (r *Registry) GetHTMLURL(u *library.User, org, repo, name, ref string) (string, error) {
+	return r.primary.GetHTMLURL(u, org, repo, name, ref)
+}
+
+// ProcessWebhook parses the webhook from a repo with the scm hosting
+// the repo. The webhook is parsed by each scm in the order registered
+// until the repo for the webhook is found, and parsed again by the scm
+// hosting the repo when another scm found it. The webhook parsed by
+// the primary scm is returned when no scm found the repo.
+func (r *Registry) ProcessWebhook(req *http.Request) (*types.Webhook, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read webhook body: %w", err)
+	}
+
+	var (
+		primary    *types.Webhook
+		primaryErr error
+	)
+
+	for i, host := range r.hosts {
+		s := r.services[host]
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		webhook, err := s.ProcessWebhook(req)
+		if i == 0 {
+			primary, primaryErr = webhook, err
+		}
+
+		if err != nil || webhook == nil || webhook.Repo == nil {
+			continue
+		}
+
+		owner := r.ForRepo(webhook.Repo)
+		if owner == s {
+			return webhook, nil
+		}
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		return owner.ProcessWebhook(req)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return primary, primaryErr
+}
+
+// VerifyWebhook verifies the webhook from a repo.
+func (r *Registry) VerifyWebhook(req *http.Request, repo *library.Repo) error {
+	return r.ForRepo(repo).VerifyWebhook(req, repo)
+}
+
+// RedeliverWebhook redelivers the webhook from the scm hosting the repo.
+func (r *Registry) RedeliverWebhook(ctx context.Context, u *library.User, repo *library.Repo, h *library.Hook) error {
+	return r.ForRepo(repo).RedeliverWebhook(ctx, u, repo, h)
+}
+
+// EnableOrg creates the webhook for all repos in an org in the primary scm.
+func (r *Registry) EnableOrg(u *library.User, org, secret string) (int64, error) {
+	return r.primary.EnableOrg(u, org, secret)
+}
+
+// DisableOrg destroys the webhook for all repos in an org in the primary scm.
+func (r *Registry) DisableOrg(u *library.User, org string, hookID int64) error {
+	return r.primary.DisableOrg(u, org, hookID)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scm
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
+)
+
+// ensure the registry implements the Service interface.
+var _ Service = (*Registry)(nil)
+
+// testSource represents a scm parsing webhooks for a repo
+// cloned from the address provided in the body of the webhook.
+type testSource struct {
+	Service
+
+	name string
+}
+
+func (s *testSource) Driver() string {
+	return s.name
+}
+
+func (s *testSource) ProcessWebhook(req *http.Request) (*types.Webhook, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	h := new(library.Hook)
+	h.SetHost(s.name)
+
+	if len(body) == 0 {
+		return &types.Webhook{Hook: h}, nil
+	}
+
+	r := new(library.Repo)
+	r.SetClone(string(body))
+
+	return &types.Webhook{Hook: h, Repo: r}, nil
+}
+
+func TestSCM_Registry_ForRepo(t *testing.T) {
+	// setup types
+	primary := &testSource{name: "github"}
+	enterprise := &testSource{name: "enterprise"}
+
+	r, err := NewRegistry(primary, "https://github.com")
+	if err != nil {
+		t.Errorf("NewRegistry returned err: %v", err)
+	}
+
+	err = r.Register("https://GitHub.example.com", enterprise)
+	if err != nil {
+		t.Errorf("Register returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name  string
+		clone string
+		link  string
+		want  Service
+	}{
+		{
+			name:  "primary",
+			clone: "https://github.com/github/octocat.git",
+			want:  primary,
+		},
+		{
+			name:  "additional",
+			clone: "https://github.example.com/github/octocat.git",
+			want:  enterprise,
+		},
+		{
+			name: "link",
+			link: "https://github.example.com/github/octocat",
+			want: enterprise,
+		},
+		{
+			name:  "unknown",
+			clone: "https://gitea.example.com/github/octocat.git",
+			want:  primary,
+		},
+		{
+			name: "empty",
+			want: primary,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			repo := new(library.Repo)
+			repo.SetClone(test.clone)
+			repo.SetLink(test.link)
+
+			got := ForRepo(r, repo)
+
+			if got != test.want {
+				t.Errorf("ForRepo is %s, want %s", got.Driver(), test.want.Driver())
+			}
+		})
+	}
+
+	if got := ForRepo(primary, new(library.Repo)); got != primary {
+		t.Errorf("ForRepo for scm is %s, want %s", got.Driver(), primary.Driver())
+	}
+
+	if got := r.Hosts(); len(got) != 2 || got[1] != "github.example.com" {
+		t.Errorf("Hosts is %v, want [github.com github.example.com]", got)
+	}
+}
+
+func TestSCM_Registry_Register_Failure(t *testing.T) {
+	// setup types
+	r, err := NewRegistry(&testSource{name: "github"}, "https://github.com")
+	if err != nil {
+		t.Errorf("NewRegistry returned err: %v", err)
+	}
+
+	// run test
+	err = r.Register("https://github.com/", &testSource{name: "duplicate"})
+	if err == nil {
+		t.Errorf("Register for duplicate host should have returned err")
+	}
+
+	err = r.Register("github.example.com", &testSource{name: "invalid"})
+	if err == nil {
+		t.Errorf("Register for invalid address should have returned err")
+	}
+
+	_, err = NewRegistry(&testSource{name: "github"}, "")
+	if err == nil {
+		t.Errorf("NewRegistry for empty address should have returned err")
+	}
+}
+
+func TestSCM_Registry_ProcessWebhook(t *testing.T) {
+	// setup types
+	r, err := NewRegistry(&testSource{name: "github"}, "https://github.com")
+	if err != nil {
+		t.Errorf("NewRegistry returned err: %v", err)
+	}
+
+	err = r.Register("https://github.example.com", &testSource{name: "enterprise"})
+	if err != nil {
+		t.Errorf("Register returned err: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "primary",
+			body: "https://github.com/github/octocat.git",
+			want: "github",
+		},
+		{
+			name: "additional",
+			body: "https://github.example.com/github/octocat.git",
+			want: "enterprise",
+		},
+		{
+			name: "no repo",
+			body: "",
+			want: "github",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(test.body))
+
+			got, err := r.ProcessWebhook(req)
+			if err != nil {
+				t.Errorf("ProcessWebhook returned err: %v", err)
+			}
+
+			if got.Hook.GetHost() != test.want {
+				t.Errorf("ProcessWebhook parsed by %s, want %s", got.Hook.GetHost(), test.want)
+			}
+		})
+	}
+}
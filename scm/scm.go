@@ -7,6 +7,8 @@ package scm
 import (
 	"fmt"
 
+	"github.com/go-vela/server/scm/bitbucket"
+	"github.com/go-vela/server/scm/gitea"
 	"github.com/go-vela/types/constants"
 
 	"github.com/sirupsen/logrus"
@@ -18,6 +20,8 @@ import (
 // Currently the following scm providers are supported:
 //
 // * Github
+// * Bitbucket
+// * Gitea
 // .
 func New(s *Setup) (Service, error) {
 	// validate the setup being provided
@@ -36,6 +40,16 @@ func New(s *Setup) (Service, error) {
 		//
 		// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#Setup.Github
 		return s.Github()
+	case bitbucket.DriverBitbucket:
+		// handle the Bitbucket scm driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#Setup.Bitbucket
+		return s.Bitbucket()
+	case gitea.DriverGitea:
+		// handle the Gitea scm driver being provided
+		//
+		// https://pkg.go.dev/github.com/go-vela/server/scm?tab=doc#Setup.Gitea
+		return s.Gitea()
 	case constants.DriverGitlab:
 		// handle the Gitlab scm driver being provided
 		//
@@ -42,6 +42,34 @@ func TestSCM_New(t *testing.T) {
 				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
 			},
 		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:               "bitbucket",
+				Address:              "https://bitbucket.example.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"REPO_ADMIN"},
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:               "gitea",
+				Address:              "https://gitea.example.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"read:user", "write:repository", "read:organization"},
+			},
+		},
 		{
 			failure: true,
 			setup: &Setup{
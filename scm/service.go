@@ -8,6 +8,7 @@ import (
 	"context"
 	"net/http"
 
+	api "github.com/go-vela/server/api/types"
 	"github.com/go-vela/types"
 	"github.com/go-vela/types/library"
 )
@@ -37,6 +38,14 @@ type Service interface {
 	// Login defines a function that begins
 	// the OAuth workflow for the session.
 	Login(http.ResponseWriter, *http.Request) (string, error)
+	// GetScopes defines a function that captures the OAuth
+	// scopes granted to the token for a user. No scopes are
+	// returned when the scm does not report the scopes.
+	GetScopes(*library.User) ([]string, error)
+	// MissingScopes defines a function that captures the OAuth
+	// scopes required by Vela that are not satisfied by the
+	// scopes granted to the token for a user.
+	MissingScopes([]string) []string
 
 	// Access SCM Interface Functions
 
@@ -55,6 +64,9 @@ type Service interface {
 	// ListUsersTeamsForOrg defines a function that captures
 	// the user's teams for an org
 	ListUsersTeamsForOrg(*library.User, string) ([]string, error)
+	// ListTeamMembers defines a function that captures
+	// the login of each member of a team for an org
+	ListTeamMembers(*library.User, string, string) ([]string, error)
 
 	// Changeset SCM Interface Functions
 
@@ -83,6 +95,9 @@ type Service interface {
 	// CreateDeployment defines a function that
 	// creates a new deployment.
 	CreateDeployment(*library.User, *library.Repo, *library.Deployment) error
+	// UpdateDeploymentStatus defines a function that sends
+	// the status of a build for a deployment from a repo.
+	UpdateDeploymentStatus(*library.User, *library.Build, string, string) error
 
 	// Repo SCM Interface Functions
 
@@ -93,6 +108,15 @@ type Service interface {
 	// Retry again in five seconds if Config fails to retrieve yaml/yml file.
 	// Will return an error after five failed attempts.
 	ConfigBackoff(*library.User, *library.Repo, string) ([]byte, error)
+	// GetCommitSHA defines a function that captures
+	// the commit SHA a ref points to for a repo.
+	GetCommitSHA(*library.User, *library.Repo, string) (string, error)
+	// GetDirectoryContents defines a function that captures
+	// the contents of every file in a directory from a repo.
+	GetDirectoryContents(*library.User, *library.Repo, string, string) (map[string][]byte, error)
+	// GetFile defines a function that captures
+	// the contents of a single file from a repo.
+	GetFile(*library.User, *library.Repo, string, string) ([]byte, error)
 	// Disable defines a function that deactivates
 	// a repo by destroying the webhook.
 	Disable(*library.User, string, string) error
@@ -105,15 +129,42 @@ type Service interface {
 	// Status defines a function that sends the
 	// commit status for the given SHA from a repo.
 	Status(*library.User, *library.Build, string, string) error
+	// ReportSteps defines a function that sends the
+	// results for the steps of a build from a repo.
+	ReportSteps(*library.User, *library.Build, []*library.Step, string, string) error
+	// ProtectBranch defines a function that configures the branch
+	// protection for a repo to require the status contexts.
+	ProtectBranch(*library.User, *library.Repo, string, []string, bool) error
 	// ListUserRepos defines a function that retrieves
 	// all repos with admin rights for the user.
 	ListUserRepos(*library.User) ([]*library.Repo, error)
 	// GetPullRequest defines a function that retrieves
 	// a pull request for a repo.
 	GetPullRequest(*library.User, *library.Repo, int) (string, string, string, string, error)
+	// ListPullRequestLabels defines a function that retrieves
+	// the names of the labels applied to a pull request.
+	ListPullRequestLabels(*library.User, *library.Repo, int) ([]string, error)
+	// ListPullRequestReviews defines a function that retrieves
+	// the reviewers and number of approvals for a pull request.
+	ListPullRequestReviews(*library.User, *library.Repo, int) ([]string, int, error)
+	// ListPullRequestApprovers defines a function that retrieves
+	// the reviewers whose latest decision approves a pull request.
+	ListPullRequestApprovers(*library.User, *library.Repo, int) ([]string, error)
+	// ListCommitPullRequests defines a function that retrieves
+	// the numbers of the pull requests associated with a commit.
+	ListCommitPullRequests(*library.User, *library.Repo, string) ([]int, error)
+	// UpsertPullRequestComment defines a function that creates or
+	// updates the comment identified by a key on a pull request.
+	UpsertPullRequestComment(*library.User, *library.Repo, int, string, string) error
+	// CommentOnPR defines a function that creates or updates the
+	// comment summarizing a build on the pull request for the build.
+	CommentOnPR(*library.User, *library.Repo, *library.Build, []*library.Step) error
 	// GetRepo defines a function that retrieves
 	// details for a repo.
 	GetRepo(*library.User, *library.Repo) (*library.Repo, error)
+	// GetRepoSync defines a function that captures the state
+	// of a repo in the SCM to sync it with the database.
+	GetRepoSync(*library.User, *library.Repo) (*api.RepoSync, error)
 	// GetOrgAndRepoName defines a function that retrieves
 	// the name of the org and repo in the SCM.
 	GetOrgAndRepoName(*library.User, string, string) (string, string, error)
@@ -135,6 +186,12 @@ type Service interface {
 	// RedeliverWebhook defines a function that
 	// redelivers the webhook from the SCM.
 	RedeliverWebhook(context.Context, *library.User, *library.Repo, *library.Hook) error
+	// EnableOrg defines a function that creates
+	// the webhook for all repos in an org.
+	EnableOrg(*library.User, string, string) (int64, error)
+	// DisableOrg defines a function that destroys
+	// the webhook for all repos in an org.
+	DisableOrg(*library.User, string, int64) error
 
 	// TODO: Add convert functions to interface?
 }
@@ -7,7 +7,10 @@ package scm
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/go-vela/server/scm/bitbucket"
+	"github.com/go-vela/server/scm/gitea"
 	"github.com/go-vela/server/scm/github"
 	"github.com/go-vela/types/constants"
 
@@ -38,6 +41,20 @@ type Setup struct {
 	WebUIAddress string
 	// specifies the OAuth scopes to use for the scm client
 	Scopes []string
+	// specifies the ID of the GitHub App to use for the scm client
+	AppID int64
+	// specifies the PEM encoded private key of the GitHub App to use for the scm client
+	AppPrivateKey string
+	// specifies whether to report builds with the GitHub Checks API instead of commit statuses
+	UseChecks bool
+	// specifies whether to comment on GitHub pull requests with a summary of the build
+	UseComments bool
+	// specifies the number of times to retry throttled or failed requests to the GitHub API
+	Retries int
+	// specifies the initial time to wait before retrying a failed request to the GitHub API
+	RetryBackoff time.Duration
+	// specifies the longest time to wait before retrying a request to the GitHub API
+	RetryMaxWait time.Duration
 }
 
 // Github creates and returns a Vela service capable of
@@ -57,6 +74,53 @@ func (s *Setup) Github() (Service, error) {
 		github.WithStatusContext(s.StatusContext),
 		github.WithWebUIAddress(s.WebUIAddress),
 		github.WithScopes(s.Scopes),
+		github.WithGithubAppID(s.AppID),
+		github.WithGithubPrivateKey(s.AppPrivateKey),
+		github.WithUseChecks(s.UseChecks),
+		github.WithUseComments(s.UseComments),
+		github.WithRetries(s.Retries),
+		github.WithRetryBackoff(s.RetryBackoff),
+		github.WithRetryMaxWait(s.RetryMaxWait),
+	)
+}
+
+// Bitbucket creates and returns a Vela service capable of
+// integrating with a Bitbucket Data Center or Server scm system.
+func (s *Setup) Bitbucket() (Service, error) {
+	logrus.Trace("creating bitbucket scm client from setup")
+
+	// create new Bitbucket scm service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/bitbucket?tab=doc#New
+	return bitbucket.New(
+		bitbucket.WithAddress(s.Address),
+		bitbucket.WithClientID(s.ClientID),
+		bitbucket.WithClientSecret(s.ClientSecret),
+		bitbucket.WithServerAddress(s.ServerAddress),
+		bitbucket.WithServerWebhookAddress(s.ServerWebhookAddress),
+		bitbucket.WithStatusContext(s.StatusContext),
+		bitbucket.WithWebUIAddress(s.WebUIAddress),
+		bitbucket.WithScopes(s.Scopes),
+	)
+}
+
+// Gitea creates and returns a Vela service capable of
+// integrating with a Gitea or Forgejo scm system.
+func (s *Setup) Gitea() (Service, error) {
+	logrus.Trace("creating gitea scm client from setup")
+
+	// create new Gitea scm service
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/gitea?tab=doc#New
+	return gitea.New(
+		gitea.WithAddress(s.Address),
+		gitea.WithClientID(s.ClientID),
+		gitea.WithClientSecret(s.ClientSecret),
+		gitea.WithServerAddress(s.ServerAddress),
+		gitea.WithServerWebhookAddress(s.ServerWebhookAddress),
+		gitea.WithStatusContext(s.StatusContext),
+		gitea.WithWebUIAddress(s.WebUIAddress),
+		gitea.WithScopes(s.Scopes),
 	)
 }
 
@@ -93,6 +157,11 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("scm address must not have trailing slash")
 	}
 
+	// check if the scm address is for Bitbucket Cloud
+	if strings.EqualFold(s.Driver, bitbucket.DriverBitbucket) && strings.Contains(s.Address, "://bitbucket.org") {
+		return fmt.Errorf("scm address must be for Bitbucket Data Center or Server")
+	}
+
 	// verify a scm OAuth client ID was provided
 	if len(s.ClientID) == 0 {
 		return fmt.Errorf("no scm client id provided")
@@ -112,6 +181,37 @@ func (s *Setup) Validate() error {
 		return fmt.Errorf("no scm scopes provided")
 	}
 
+	// check if the scm is configured as a GitHub App
+	if s.AppID > 0 || len(s.AppPrivateKey) > 0 {
+		// verify the scm driver supports GitHub Apps
+		if !strings.EqualFold(s.Driver, constants.DriverGithub) {
+			return fmt.Errorf("scm app is only supported by the %s driver", constants.DriverGithub)
+		}
+
+		// verify a scm app ID was provided
+		if s.AppID <= 0 {
+			return fmt.Errorf("no scm app id provided")
+		}
+
+		// verify a scm app private key was provided
+		if len(s.AppPrivateKey) == 0 {
+			return fmt.Errorf("no scm app private key provided")
+		}
+	}
+
+	// check if the scm is configured to use the GitHub Checks API
+	if s.UseChecks {
+		// verify the scm is configured as a GitHub App
+		if s.AppID <= 0 || len(s.AppPrivateKey) == 0 {
+			return fmt.Errorf("scm checks require a scm app id and private key")
+		}
+	}
+
+	// check if the scm is configured to comment on pull requests
+	if s.UseComments && !strings.EqualFold(s.Driver, constants.DriverGithub) {
+		return fmt.Errorf("scm comments are only supported by the %s driver", constants.DriverGithub)
+	}
+
 	// setup is valid
 	return nil
 }
@@ -68,6 +68,124 @@ func TestSCM_Setup_Github(t *testing.T) {
 	}
 }
 
+func TestSCM_Setup_Bitbucket(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:               "bitbucket",
+		Address:              "https://bitbucket.example.com",
+		ClientID:             "foo",
+		ClientSecret:         "bar",
+		ServerAddress:        "https://vela-server.example.com",
+		ServerWebhookAddress: "",
+		StatusContext:        "continuous-integration/vela",
+		WebUIAddress:         "https://vela.example.com",
+		Scopes:               []string{"REPO_ADMIN"},
+	}
+
+	_bitbucket, err := _setup.Bitbucket()
+	if err != nil {
+		t.Errorf("unable to setup scm: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+		want    Service
+	}{
+		{
+			failure: false,
+			setup:   _setup,
+			want:    _bitbucket,
+		},
+		{
+			failure: true,
+			setup:   &Setup{Driver: "bitbucket"},
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := test.setup.Bitbucket()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Bitbucket should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Bitbucket returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Bitbucket is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSCM_Setup_Gitea(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:               "gitea",
+		Address:              "https://gitea.example.com",
+		ClientID:             "foo",
+		ClientSecret:         "bar",
+		ServerAddress:        "https://vela-server.example.com",
+		ServerWebhookAddress: "",
+		StatusContext:        "continuous-integration/vela",
+		WebUIAddress:         "https://vela.example.com",
+		Scopes:               []string{"read:user", "write:repository", "read:organization"},
+	}
+
+	_gitea, err := _setup.Gitea()
+	if err != nil {
+		t.Errorf("unable to setup scm: %v", err)
+	}
+
+	// setup tests
+	tests := []struct {
+		failure bool
+		setup   *Setup
+		want    Service
+	}{
+		{
+			failure: false,
+			setup:   _setup,
+			want:    _gitea,
+		},
+		{
+			failure: true,
+			setup:   &Setup{Driver: "gitea"},
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := test.setup.Gitea()
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("Gitea should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Gitea returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Gitea is %v, want %v", got, test.want)
+		}
+	}
+}
+
 func TestSCM_Setup_Gitlab(t *testing.T) {
 	// setup types
 	_setup := &Setup{
@@ -238,6 +356,131 @@ func TestSCM_Setup_Validate(t *testing.T) {
 				Scopes:               []string{},
 			},
 		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "bitbucket",
+				Address:              "https://bitbucket.org",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"REPO_ADMIN"},
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:               "github",
+				Address:              "https://github.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				AppID:                1,
+				AppPrivateKey:        "key",
+			},
+		},
+		{
+			failure: false,
+			setup: &Setup{
+				Driver:               "github",
+				Address:              "https://github.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				AppID:                1,
+				AppPrivateKey:        "key",
+				UseChecks:            true,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "github",
+				Address:              "https://github.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				UseChecks:            true,
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "github",
+				Address:              "https://github.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				AppID:                1,
+				AppPrivateKey:        "",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "github",
+				Address:              "https://github.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+				AppID:                0,
+				AppPrivateKey:        "key",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "gitea",
+				Address:              "https://gitea.example.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"read:user", "write:repository", "read:organization"},
+				AppID:                1,
+				AppPrivateKey:        "key",
+			},
+		},
+		{
+			failure: true,
+			setup: &Setup{
+				Driver:               "gitea",
+				Address:              "https://gitea.example.com",
+				ClientID:             "foo",
+				ClientSecret:         "bar",
+				ServerAddress:        "https://vela-server.example.com",
+				ServerWebhookAddress: "",
+				StatusContext:        "continuous-integration/vela",
+				WebUIAddress:         "https://vela.example.com",
+				Scopes:               []string{"read:user", "write:repository", "read:organization"},
+				UseComments:          true,
+			},
+		},
 	}
 
 	// run tests
@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scm
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Source represents the configuration for an additional scm
+// hosted alongside the primary scm, i.e. GitHub Enterprise
+// Server alongside GitHub. The fields not provided are
+// inherited from the setup for the primary scm.
+type Source struct {
+	// specifies the driver to use for the scm client
+	Driver string `json:"driver"`
+	// specifies the address to use for the scm client
+	Address string `json:"addr"`
+	// specifies the OAuth client ID from the scm system to use for the scm client
+	ClientID string `json:"client"`
+	// specifies the OAuth client secret from the scm system to use for the scm client
+	ClientSecret string `json:"secret"`
+	// specifies the context for the commit status to use for the scm client
+	StatusContext string `json:"context"`
+	// specifies the OAuth scopes to use for the scm client
+	Scopes []string `json:"scopes"`
+	// specifies the ID of the GitHub App to use for the scm client
+	AppID int64 `json:"app_id"`
+	// specifies the PEM encoded private key of the GitHub App to use for the scm client
+	AppPrivateKey string `json:"app_private_key"`
+	// specifies whether to report builds with the GitHub Checks API instead of commit statuses
+	UseChecks bool `json:"checks"`
+	// specifies whether to comment on GitHub pull requests with a summary of the build
+	UseComments bool `json:"comments"`
+}
+
+// ParseSources parses the provided JSON list
+// of the configuration for additional scms.
+func ParseSources(sources string) ([]*Source, error) {
+	s := []*Source{}
+
+	if len(sources) == 0 {
+		return s, nil
+	}
+
+	err := json.Unmarshal([]byte(sources), &s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse scm sources: %w", err)
+	}
+
+	return s, nil
+}
+
+// ForSource creates and returns the setup for the additional
+// scm, inheriting the fields not provided by the source from
+// the setup for the primary scm.
+func (s *Setup) ForSource(src *Source) *Setup {
+	setup := *s
+
+	setup.Driver = src.Driver
+	setup.Address = src.Address
+	setup.ClientID = src.ClientID
+	setup.ClientSecret = src.ClientSecret
+	setup.AppID = src.AppID
+	setup.AppPrivateKey = src.AppPrivateKey
+	setup.UseChecks = src.UseChecks
+	setup.UseComments = src.UseComments
+
+	if len(src.Driver) == 0 {
+		setup.Driver = s.Driver
+	}
+
+	if len(src.StatusContext) > 0 {
+		setup.StatusContext = src.StatusContext
+	}
+
+	if len(src.Scopes) > 0 {
+		setup.Scopes = src.Scopes
+	}
+
+	return &setup
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSCM_ParseSources(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		failure bool
+		sources string
+		want    []*Source
+	}{
+		{
+			failure: false,
+			sources: `[{"addr":"https://github.example.com","client":"foo","secret":"bar","app_id":1}]`,
+			want: []*Source{
+				{
+					Address:      "https://github.example.com",
+					ClientID:     "foo",
+					ClientSecret: "bar",
+					AppID:        1,
+				},
+			},
+		},
+		{
+			failure: false,
+			sources: "",
+			want:    []*Source{},
+		},
+		{
+			failure: true,
+			sources: "https://github.example.com",
+			want:    nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		got, err := ParseSources(test.sources)
+
+		if test.failure {
+			if err == nil {
+				t.Errorf("ParseSources should have returned err")
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("ParseSources returned err: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParseSources is %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestSCM_Setup_ForSource(t *testing.T) {
+	// setup types
+	_setup := &Setup{
+		Driver:        "github",
+		Address:       "https://github.com",
+		ClientID:      "foo",
+		ClientSecret:  "bar",
+		ServerAddress: "https://vela-server.example.com",
+		StatusContext: "continuous-integration/vela",
+		WebUIAddress:  "https://vela.example.com",
+		Scopes:        []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+		AppID:         1,
+		AppPrivateKey: "key",
+		UseChecks:     true,
+		Retries:       3,
+	}
+
+	want := &Setup{
+		Driver:        "github",
+		Address:       "https://github.example.com",
+		ClientID:      "baz",
+		ClientSecret:  "qux",
+		ServerAddress: "https://vela-server.example.com",
+		StatusContext: "continuous-integration/vela",
+		WebUIAddress:  "https://vela.example.com",
+		Scopes:        []string{"repo", "repo:status", "user:email", "read:user", "read:org"},
+		Retries:       3,
+	}
+
+	// run test
+	got := _setup.ForSource(&Source{
+		Address:      "https://github.example.com",
+		ClientID:     "baz",
+		ClientSecret: "qux",
+	})
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ForSource is %v, want %v", got, want)
+	}
+
+	if _setup.Address != "https://github.com" {
+		t.Errorf("ForSource modified the setup for the primary scm")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package verify
+
+import (
+	"context"
+	"time"
+)
+
+// key defines the key type for storing
+// the verifier in the context.
+const key = "verifier"
+
+// receivedKey defines the key type for storing the
+// time a webhook was received in the request context.
+type receivedKey struct{}
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the verifier
+// associated with this context.
+func FromContext(c context.Context) *Verifier {
+	// get verifier value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast verifier value to expected Verifier type
+	vr, ok := v.(*Verifier)
+	if !ok {
+		return nil
+	}
+
+	return vr
+}
+
+// ToContext adds the verifier to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, v *Verifier) {
+	c.Set(key, v)
+}
+
+// WithReceived returns a copy of the context with the time the
+// webhook was received by the server. It is set for webhooks that
+// are processed after they were received, like webhooks buffered
+// in the intake or staged on shutdown, so the staleness of the
+// delivery is checked against when it was received.
+func WithReceived(c context.Context, received time.Time) context.Context {
+	return context.WithValue(c, receivedKey{}, received)
+}
+
+// Received returns the time the webhook was received
+// by the server, or the current time when the webhook
+// is being processed as it is received.
+func Received(c context.Context) time.Time {
+	if received, ok := c.Value(receivedKey{}).(time.Time); ok {
+		return received.UTC()
+	}
+
+	return time.Now().UTC()
+}
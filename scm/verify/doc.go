@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package verify provides the ability for Vela to verify the
+// signature of the webhooks delivered by the scm drivers and
+// reject the deliveries that are replayed or stale.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scm/verify"
+package verify
@@ -0,0 +1,58 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package verify
+
+import (
+	"fmt"
+	"time"
+)
+
+// Opt represents a configuration option to initialize the verifier.
+type Opt func(*Verifier) error
+
+// WithDatabase sets the service used to record webhook deliveries in the verifier.
+func WithDatabase(db DeliveryService) Opt {
+	return func(v *Verifier) error {
+		// check if the database service provided is empty
+		if db == nil {
+			return fmt.Errorf("no webhook delivery database provided")
+		}
+
+		// set the database service in the verifier
+		v.database = db
+
+		return nil
+	}
+}
+
+// WithMaxAge sets the age a webhook delivery is accepted for in the verifier.
+func WithMaxAge(age time.Duration) Opt {
+	return func(v *Verifier) error {
+		// check if the max age provided is valid
+		if age <= 0 {
+			return fmt.Errorf("webhook max age must be greater than 0")
+		}
+
+		// set the max age in the verifier
+		v.maxAge = age
+
+		return nil
+	}
+}
+
+// WithRetention sets the duration a webhook delivery is recorded for in the verifier.
+func WithRetention(retention time.Duration) Opt {
+	return func(v *Verifier) error {
+		// check if the retention provided is valid
+		if retention <= 0 {
+			return fmt.Errorf("webhook delivery retention must be greater than 0")
+		}
+
+		// set the retention in the verifier
+		v.retention = retention
+
+		return nil
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package verify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrMissingSignature defines the error returned when
+	// the webhook does not provide a supported signature.
+	ErrMissingSignature = errors.New("missing signature for webhook")
+
+	// ErrSignatureMismatch defines the error returned when the
+	// signature does not match the payload for the webhook.
+	ErrSignatureMismatch = errors.New("payload signature check failed")
+)
+
+// Signature represents a header the scm provides the
+// HMAC signature of the payload for a webhook in.
+type Signature struct {
+	// name of the header providing the signature
+	Header string
+	// prefix before the hex encoded signature in the header
+	Prefix string
+	// hash function used to sign the payload
+	Hash func() hash.Hash
+}
+
+// Payload verifies the payload of the webhook was signed with
+// the provided secret. The first of the provided signatures
+// present in the request is checked, so the signatures should
+// be ordered from the strongest to the weakest hash function.
+//
+// The body of the request is restored after it is read.
+func Payload(request *http.Request, secret string, signatures ...Signature) error {
+	for _, s := range signatures {
+		value := request.Header.Get(s.Header)
+		if len(value) == 0 || !strings.HasPrefix(value, s.Prefix) {
+			continue
+		}
+
+		got, err := hex.DecodeString(strings.TrimPrefix(value, s.Prefix))
+		if err != nil {
+			return fmt.Errorf("unable to decode signature for webhook: %w", err)
+		}
+
+		payload, err := io.ReadAll(request.Body)
+		if err != nil {
+			return err
+		}
+
+		request.Body = io.NopCloser(bytes.NewReader(payload))
+
+		mac := hmac.New(s.Hash, []byte(secret))
+		mac.Write(payload)
+
+		if !hmac.Equal(got, mac.Sum(nil)) {
+			return ErrSignatureMismatch
+		}
+
+		return nil
+	}
+
+	return ErrMissingSignature
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package verify
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // sha1 is only used to test legacy signatures
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// sign is a helper function to create the hex
+// encoded signature of the payload for tests.
+func sign(h func() hash.Hash, secret, payload string) string {
+	mac := hmac.New(h, []byte(secret))
+	mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerify_Payload(t *testing.T) {
+	// setup types
+	payload := `{"action":"opened"}`
+
+	signatures := []Signature{
+		{Header: "X-Hub-Signature-256", Prefix: "sha256=", Hash: sha256.New},
+		{Header: "X-Hub-Signature", Prefix: "sha1=", Hash: sha1.New},
+	}
+
+	// setup tests
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    error
+	}{
+		{
+			name:    "sha256",
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + sign(sha256.New, "secret", payload)},
+			want:    nil,
+		},
+		{
+			name:    "sha1",
+			headers: map[string]string{"X-Hub-Signature": "sha1=" + sign(sha1.New, "secret", payload)},
+			want:    nil,
+		},
+		{
+			name: "strongest signature checked first",
+			headers: map[string]string{
+				"X-Hub-Signature-256": "sha256=" + sign(sha256.New, "wrong", payload),
+				"X-Hub-Signature":     "sha1=" + sign(sha1.New, "secret", payload),
+			},
+			want: ErrSignatureMismatch,
+		},
+		{
+			name:    "wrong secret",
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + sign(sha256.New, "wrong", payload)},
+			want:    ErrSignatureMismatch,
+		},
+		{
+			name:    "missing prefix",
+			headers: map[string]string{"X-Hub-Signature-256": sign(sha256.New, "secret", payload)},
+			want:    ErrMissingSignature,
+		},
+		{
+			name:    "missing signature",
+			headers: map[string]string{},
+			want:    ErrMissingSignature,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, _ := http.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+
+			for k, v := range test.headers {
+				request.Header.Set(k, v)
+			}
+
+			err := Payload(request, "secret", signatures...)
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("Payload is %v, want %v", err, test.want)
+			}
+
+			// ensure the body is restored for the request
+			if test.want == nil {
+				body, _ := io.ReadAll(request.Body)
+
+				if string(body) != payload {
+					t.Errorf("Payload body is %s, want %s", body, payload)
+				}
+			}
+		})
+	}
+}
+
+func TestVerify_Payload_BadSignature(t *testing.T) {
+	// setup types
+	request, _ := http.NewRequest(http.MethodPost, "/webhook", strings.NewReader("{}"))
+	request.Header.Set("X-Hub-Signature", "sha256=not-hex")
+
+	// run test
+	err := Payload(request, "secret", Signature{Header: "X-Hub-Signature", Prefix: "sha256=", Hash: sha256.New})
+	if err == nil {
+		t.Errorf("Payload should have returned err")
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package verify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultMaxAge defines the default age a webhook
+	// delivery is accepted for before it is stale.
+	DefaultMaxAge = 5 * time.Minute
+
+	// DefaultRetention defines the default duration a webhook
+	// delivery is recorded for to reject replays of it.
+	DefaultRetention = 72 * time.Hour
+
+	// cleanInterval defines how often the recorded webhook
+	// deliveries past the retention are removed.
+	cleanInterval = time.Hour
+)
+
+var (
+	// ErrMissingDelivery defines the error returned when
+	// the webhook does not provide a delivery ID.
+	ErrMissingDelivery = errors.New("missing delivery ID for webhook")
+
+	// ErrReplayed defines the error returned when the
+	// webhook delivery was already received.
+	ErrReplayed = errors.New("webhook delivery was already received")
+
+	// ErrStale defines the error returned when the webhook
+	// delivery was sent outside of the accepted age.
+	ErrStale = errors.New("webhook delivery is stale")
+)
+
+// DeliveryService represents the functionality
+// for recording the webhook deliveries received.
+//
+// The interface is satisfied by the database service.
+type DeliveryService interface {
+	CleanWebhookDeliveries(context.Context, int64) (int64, error)
+	CreateWebhookDelivery(context.Context, *api.WebhookDelivery) error
+	DeleteWebhookDelivery(context.Context, *api.WebhookDelivery) error
+	GetWebhookDelivery(context.Context, string) (*api.WebhookDelivery, error)
+}
+
+// Verifier represents the functionality for rejecting
+// webhook deliveries that are replayed or stale.
+type Verifier struct {
+	// service used to record the webhook deliveries
+	database DeliveryService
+
+	// age a webhook delivery is accepted for
+	maxAge time.Duration
+	// duration a webhook delivery is recorded for
+	retention time.Duration
+
+	mu      sync.Mutex
+	cleaned time.Time
+}
+
+// New creates and returns a verifier for webhook deliveries.
+func New(opts ...Opt) (*Verifier, error) {
+	// create new verifier
+	v := new(Verifier)
+
+	// create new fields
+	v.maxAge = DefaultMaxAge
+	v.retention = DefaultRetention
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+// Delivery records the webhook delivery with the provided ID
+// from the source and returns an error when the delivery was
+// already received or, when the scm provides the Date header,
+// was sent outside of the accepted age of when it was received.
+func (v *Verifier) Delivery(request *http.Request, source, id string, repoID int64) error {
+	if len(id) == 0 {
+		return ErrMissingDelivery
+	}
+
+	ctx := request.Context()
+
+	now := time.Now().UTC()
+
+	// capture when the webhook was received, which is earlier
+	// than now for webhooks processed after they were received
+	received := Received(ctx)
+
+	// check if the delivery was sent outside of the accepted age
+	if sent, err := http.ParseTime(request.Header.Get("Date")); err == nil {
+		if received.Sub(sent) > v.maxAge || sent.Sub(received) > v.maxAge {
+			return fmt.Errorf("%w: sent at %s", ErrStale, sent.Format(time.RFC3339))
+		}
+	}
+
+	// check if the database service is configured
+	if v.database == nil {
+		return nil
+	}
+
+	v.clean(ctx, now)
+
+	// send API call to capture the existing delivery
+	existing, err := v.database.GetWebhookDelivery(ctx, id)
+	if err == nil {
+		// check if the existing delivery is still recorded
+		if existing.GetCreated() >= now.Add(-v.retention).Unix() {
+			return ErrReplayed
+		}
+
+		// send API call to remove the expired delivery
+		err = v.database.DeleteWebhookDelivery(ctx, existing)
+		if err != nil {
+			return fmt.Errorf("unable to delete expired webhook delivery %s: %w", id, err)
+		}
+	}
+
+	d := new(api.WebhookDelivery)
+	d.SetSource(source)
+	d.SetDeliveryID(id)
+	d.SetRepoID(repoID)
+	d.SetCreated(now.Unix())
+
+	// send API call to record the delivery
+	//
+	// the delivery ID is unique, so a failure when the
+	// delivery was recorded by a concurrent request is
+	// a replay of the delivery
+	err = v.database.CreateWebhookDelivery(ctx, d)
+	if err != nil {
+		if _, gErr := v.database.GetWebhookDelivery(ctx, id); gErr == nil {
+			return ErrReplayed
+		}
+
+		return fmt.Errorf("unable to record webhook delivery %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// Release removes the record of the webhook delivery with the
+// provided ID so the scm is able to redeliver the webhook.
+func (v *Verifier) Release(ctx context.Context, id string) error {
+	// check if the database service is configured
+	if v.database == nil || len(id) == 0 {
+		return nil
+	}
+
+	// send API call to capture the delivery
+	d, err := v.database.GetWebhookDelivery(ctx, id)
+	if err != nil {
+		// the delivery was never recorded or already released
+		return nil
+	}
+
+	// send API call to remove the delivery
+	err = v.database.DeleteWebhookDelivery(ctx, d)
+	if err != nil {
+		return fmt.Errorf("unable to release webhook delivery %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// clean is a helper function to remove the recorded
+// webhook deliveries past the retention at most once
+// for every interval.
+func (v *Verifier) clean(ctx context.Context, now time.Time) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if now.Sub(v.cleaned) < cleanInterval {
+		return
+	}
+
+	v.cleaned = now
+
+	// send API call to remove the expired deliveries
+	count, err := v.database.CleanWebhookDeliveries(ctx, now.Add(-v.retention).Unix())
+	if err != nil {
+		logrus.Errorf("unable to clean webhook deliveries: %v", err)
+
+		return
+	}
+
+	if count > 0 {
+		logrus.Debugf("cleaned %d expired webhook deliveries", count)
+	}
+}
@@ -0,0 +1,355 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package verify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// deliveries is a helper type to record the
+// webhook deliveries in memory for tests.
+type deliveries struct {
+	mu      sync.Mutex
+	records map[string]*api.WebhookDelivery
+	cleaned int64
+}
+
+func newDeliveries() *deliveries {
+	return &deliveries{records: make(map[string]*api.WebhookDelivery)}
+}
+
+func (d *deliveries) CleanWebhookDeliveries(_ context.Context, before int64) (int64, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.cleaned = before
+
+	count := int64(0)
+
+	for id, record := range d.records {
+		if record.GetCreated() < before {
+			delete(d.records, id)
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (d *deliveries) CreateWebhookDelivery(_ context.Context, w *api.WebhookDelivery) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.records[w.GetDeliveryID()]; ok {
+		return errors.New("UNIQUE constraint failed: webhook_deliveries.delivery_id")
+	}
+
+	d.records[w.GetDeliveryID()] = w
+
+	return nil
+}
+
+func (d *deliveries) DeleteWebhookDelivery(_ context.Context, w *api.WebhookDelivery) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.records, w.GetDeliveryID())
+
+	return nil
+}
+
+func (d *deliveries) GetWebhookDelivery(_ context.Context, id string) (*api.WebhookDelivery, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	record, ok := d.records[id]
+	if !ok {
+		return nil, errors.New("record not found")
+	}
+
+	return record, nil
+}
+
+func TestVerify_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+		},
+		{
+			name:    "all options",
+			failure: false,
+			opts:    []Opt{WithDatabase(newDeliveries()), WithMaxAge(time.Minute), WithRetention(time.Hour)},
+		},
+		{
+			name:    "empty database",
+			failure: true,
+			opts:    []Opt{WithDatabase(nil)},
+		},
+		{
+			name:    "zero max age",
+			failure: true,
+			opts:    []Opt{WithMaxAge(0)},
+		},
+		{
+			name:    "negative retention",
+			failure: true,
+			opts:    []Opt{WithRetention(-time.Hour)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerify_Delivery(t *testing.T) {
+	// setup types
+	db := newDeliveries()
+
+	verifier, _ := New(WithDatabase(db))
+
+	request, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+
+	// run test
+	err := verifier.Delivery(request, "github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1)
+	if err != nil {
+		t.Errorf("Delivery returned err: %v", err)
+	}
+
+	got, err := db.GetWebhookDelivery(context.TODO(), "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	if err != nil {
+		t.Fatalf("Delivery did not record the delivery: %v", err)
+	}
+
+	if got.GetSource() != "github" || got.GetRepoID() != 1 {
+		t.Errorf("Delivery recorded %v, want source github and repo 1", got)
+	}
+
+	// replay the delivery
+	err = verifier.Delivery(request, "github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1)
+	if !errors.Is(err, ErrReplayed) {
+		t.Errorf("Delivery for replay is %v, want %v", err, ErrReplayed)
+	}
+
+	// release the delivery to accept it again
+	err = verifier.Release(context.TODO(), "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	if err != nil {
+		t.Errorf("Release returned err: %v", err)
+	}
+
+	err = verifier.Delivery(request, "github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1)
+	if err != nil {
+		t.Errorf("Delivery after release returned err: %v", err)
+	}
+}
+
+func TestVerify_Delivery_Expired(t *testing.T) {
+	// setup types
+	db := newDeliveries()
+
+	expired := new(api.WebhookDelivery)
+	expired.SetSource("github")
+	expired.SetDeliveryID("72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	expired.SetCreated(time.Now().Add(-2 * time.Hour).Unix())
+
+	_ = db.CreateWebhookDelivery(context.TODO(), expired)
+
+	verifier, _ := New(WithDatabase(db), WithRetention(time.Hour))
+
+	// skip cleaning to exercise the expiry of the existing delivery
+	verifier.cleaned = time.Now().UTC()
+
+	request, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+
+	// run test
+	err := verifier.Delivery(request, "github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1)
+	if err != nil {
+		t.Errorf("Delivery returned err: %v", err)
+	}
+
+	got, _ := db.GetWebhookDelivery(context.TODO(), "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	if got == expired {
+		t.Errorf("Delivery did not replace the expired delivery")
+	}
+}
+
+func TestVerify_Delivery_Clean(t *testing.T) {
+	// setup types
+	db := newDeliveries()
+
+	verifier, _ := New(WithDatabase(db), WithRetention(time.Hour))
+
+	request, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+
+	// run test
+	_ = verifier.Delivery(request, "github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1)
+
+	if db.cleaned == 0 {
+		t.Fatalf("Delivery did not clean expired deliveries")
+	}
+
+	cleaned := db.cleaned
+
+	_ = verifier.Delivery(request, "github", "8ac3ff5c-cc78-11e3-81ab-4c9367dc0958", 1)
+
+	if db.cleaned != cleaned {
+		t.Errorf("Delivery cleaned expired deliveries more than once within %v", cleanInterval)
+	}
+}
+
+func TestVerify_Delivery_Invalid(t *testing.T) {
+	// setup types
+	verifier, _ := New(WithDatabase(newDeliveries()), WithMaxAge(time.Minute))
+
+	// setup tests
+	tests := []struct {
+		name string
+		id   string
+		date string
+		want error
+	}{
+		{
+			name: "missing delivery",
+			id:   "",
+			want: ErrMissingDelivery,
+		},
+		{
+			name: "stale delivery",
+			id:   "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+			date: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+			want: ErrStale,
+		},
+		{
+			name: "future delivery",
+			id:   "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+			date: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			want: ErrStale,
+		},
+		{
+			name: "recent delivery",
+			id:   "8ac3ff5c-cc78-11e3-81ab-4c9367dc0958",
+			date: time.Now().UTC().Format(http.TimeFormat),
+			want: nil,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+
+			if len(test.date) > 0 {
+				request.Header.Set("Date", test.date)
+			}
+
+			err := verifier.Delivery(request, "github", test.id, 1)
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("Delivery is %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestVerify_Delivery_NoDatabase(t *testing.T) {
+	// setup types
+	verifier, _ := New()
+
+	request, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+
+	// run test
+	for i := 0; i < 2; i++ {
+		err := verifier.Delivery(request, "github", "72d3162e-cc78-11e3-81ab-4c9367dc0958", 1)
+		if err != nil {
+			t.Errorf("Delivery returned err: %v", err)
+		}
+	}
+
+	err := verifier.Release(context.TODO(), "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+	if err != nil {
+		t.Errorf("Release returned err: %v", err)
+	}
+}
+
+func TestVerify_Delivery_Received(t *testing.T) {
+	// setup types
+	verifier, _ := New(WithDatabase(newDeliveries()), WithMaxAge(time.Minute))
+
+	received := time.Now().Add(-time.Hour).UTC()
+
+	// setup tests
+	tests := []struct {
+		name string
+		id   string
+		date string
+		want error
+	}{
+		{
+			name: "sent when received",
+			id:   "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+			date: received.Add(-30 * time.Second).Format(http.TimeFormat),
+			want: nil,
+		},
+		{
+			name: "stale when received",
+			id:   "8ac3ff5c-cc78-11e3-81ab-4c9367dc0958",
+			date: received.Add(-time.Hour).Format(http.TimeFormat),
+			want: ErrStale,
+		},
+		{
+			name: "sent when processed",
+			id:   "9e8f1a2c-cc78-11e3-81ab-4c9367dc0958",
+			date: time.Now().UTC().Format(http.TimeFormat),
+			want: ErrStale,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request, _ := http.NewRequestWithContext(
+				WithReceived(context.Background(), received),
+				http.MethodPost, "/webhook", nil,
+			)
+
+			request.Header.Set("Date", test.date)
+
+			err := verifier.Delivery(request, "github", test.id, 1)
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("Delivery is %v, want %v", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package scmmigrate provides the ability for Vela to move the
+// repos, users and webhooks from one SCM address to another, i.e.
+// from GitHub Enterprise Server to github.com, without losing the
+// build history for the repos.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/scmmigrate"
+package scmmigrate
@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scmmigrate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scm"
+)
+
+// Opt represents a configuration option to initialize the migrator.
+type Opt func(*Migrator) error
+
+// WithDatabase sets the database service in the migrator.
+func WithDatabase(db database.Service) Opt {
+	return func(m *Migrator) error {
+		// set the database service in the migrator
+		m.database = db
+
+		return nil
+	}
+}
+
+// WithSCM sets the scm service for the new SCM in the migrator.
+func WithSCM(s scm.Service) Opt {
+	return func(m *Migrator) error {
+		// set the scm service in the migrator
+		m.scm = s
+
+		return nil
+	}
+}
+
+// WithFromAddress sets the address of the SCM to migrate from in the migrator.
+func WithFromAddress(address string) Opt {
+	return func(m *Migrator) error {
+		address, err := parseAddress(address)
+		if err != nil {
+			return fmt.Errorf("invalid scm migration from address provided: %w", err)
+		}
+
+		// set the from address in the migrator
+		m.config.FromAddress = address
+
+		return nil
+	}
+}
+
+// WithToAddress sets the address of the SCM to migrate to in the migrator.
+func WithToAddress(address string) Opt {
+	return func(m *Migrator) error {
+		address, err := parseAddress(address)
+		if err != nil {
+			return fmt.Errorf("invalid scm migration to address provided: %w", err)
+		}
+
+		// set the to address in the migrator
+		m.config.ToAddress = address
+
+		return nil
+	}
+}
+
+// WithDryRun sets whether the migrator only reports the changes.
+func WithDryRun(dryRun bool) Opt {
+	return func(m *Migrator) error {
+		// set the dry run in the migrator
+		m.config.DryRun = dryRun
+
+		return nil
+	}
+}
+
+// parseAddress is a helper function to verify the
+// provided address is an absolute URL and remove
+// the trailing slash so links can be rewritten.
+func parseAddress(address string) (string, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", err
+	}
+
+	if len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return "", fmt.Errorf("%s is not an absolute URL", address)
+	}
+
+	return strings.TrimSuffix(address, "/"), nil
+}
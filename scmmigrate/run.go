@@ -0,0 +1,303 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scmmigrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-vela/types/library"
+	"github.com/sirupsen/logrus"
+)
+
+// migrationActor represents the name recorded as the actor
+// for the org hooks updated by the migrator.
+const migrationActor = "vela-scm-migration"
+
+// Report represents the changes made by the migrator,
+// or the changes that would be made for a dry run.
+type Report struct {
+	DryRun bool `json:"dry_run"`
+	// repos with links rewritten to the new SCM address
+	Repos []string `json:"repos"`
+	// users that must log in again to authenticate with the new SCM
+	Users []string `json:"users"`
+	// repos with the webhook recreated on the new SCM
+	Hooks []string `json:"hooks"`
+	// orgs with the org hook recreated on the new SCM
+	OrgHooks []string `json:"org_hooks"`
+	// repos and orgs waiting on the owner to log in again
+	// before the webhook is recreated on the new SCM
+	Pending []string `json:"pending"`
+	// errors encountered while migrating individual repos and orgs
+	Errors []string `json:"errors"`
+}
+
+// Run moves the repos, users and webhooks to the new SCM address.
+//
+// The links for the repos are rewritten to the new address, users
+// without a token for the new SCM must log in again, and webhooks
+// are recreated for the repos and orgs with an owner that has logged
+// in to the new SCM. Run is safe to repeat until nothing is pending.
+func (m *Migrator) Run() (*Report, error) {
+	logrus.Infof("migrating scm from %s to %s", m.config.FromAddress, m.config.ToAddress)
+
+	report := &Report{
+		DryRun:   m.config.DryRun,
+		Repos:    []string{},
+		Users:    []string{},
+		Hooks:    []string{},
+		OrgHooks: []string{},
+		Pending:  []string{},
+		Errors:   []string{},
+	}
+
+	owners, err := m.migrateUsers(report)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.migrateRepos(report, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.migrateOrgHooks(report, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// migrateUsers is a helper function to capture the users with a
+// token for the new SCM. The refresh token is removed for the users
+// without one so they must log in again to authenticate with the new SCM.
+func (m *Migrator) migrateUsers(report *Report) (map[int64]*library.User, error) {
+	// send API call to capture all users
+	users, err := m.database.ListUsers(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("unable to list users: %w", err)
+	}
+
+	owners := make(map[int64]*library.User)
+
+	for _, u := range users {
+		// send API call to verify the token for the user with the new SCM
+		login, err := m.scm.Authorize(u.GetToken())
+		if err == nil && strings.EqualFold(login, u.GetName()) {
+			owners[u.GetID()] = u
+
+			continue
+		}
+
+		report.Users = append(report.Users, u.GetName())
+
+		// skip users that have already been logged out
+		if m.config.DryRun || len(u.GetRefreshToken()) == 0 {
+			continue
+		}
+
+		// remove the refresh token to end the sessions for the user
+		u.SetRefreshToken("")
+
+		// send API call to update the user
+		err = m.database.UpdateUser(context.Background(), u)
+		if err != nil {
+			return nil, fmt.Errorf("unable to update user %s: %w", u.GetName(), err)
+		}
+	}
+
+	return owners, nil
+}
+
+// migrateRepos is a helper function to rewrite the links for the repos
+// to the new SCM address and recreate the webhooks for the active repos.
+func (m *Migrator) migrateRepos(report *Report, owners map[int64]*library.User) error {
+	// send API call to capture all repos
+	repos, err := m.database.ListRepos(context.Background())
+	if err != nil {
+		return fmt.Errorf("unable to list repos: %w", err)
+	}
+
+	for _, r := range repos {
+		link, moved := m.rewrite(r.GetLink())
+		clone, cloneMoved := m.rewrite(r.GetClone())
+
+		// skip repos that do not belong to either SCM
+		if !moved && !cloneMoved && !strings.HasPrefix(r.GetLink(), m.config.ToAddress+"/") {
+			continue
+		}
+
+		if moved || cloneMoved {
+			report.Repos = append(report.Repos, r.GetFullName())
+
+			if !m.config.DryRun {
+				r.SetLink(link)
+				r.SetClone(clone)
+
+				// send API call to update the repo
+				err = m.database.UpdateRepo(context.Background(), r)
+				if err != nil {
+					return fmt.Errorf("unable to update repo %s: %w", r.GetFullName(), err)
+				}
+			}
+		}
+
+		// skip repos without a webhook
+		if !r.GetActive() {
+			continue
+		}
+
+		owner, ok := owners[r.GetUserID()]
+		if !ok {
+			report.Pending = append(report.Pending, r.GetFullName())
+
+			continue
+		}
+
+		if m.config.DryRun {
+			report.Hooks = append(report.Hooks, r.GetFullName())
+
+			continue
+		}
+
+		created, err := m.recreateHook(owner, r)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("unable to recreate webhook for %s: %v", r.GetFullName(), err))
+
+			continue
+		}
+
+		if created {
+			report.Hooks = append(report.Hooks, r.GetFullName())
+		}
+	}
+
+	return nil
+}
+
+// recreateHook is a helper function to create the webhook for the repo
+// on the new SCM. The initialization hook is numbered after the existing
+// hooks for the repo to preserve the hook history. It returns false
+// when the webhook already exists on the new SCM.
+func (m *Migrator) recreateHook(u *library.User, r *library.Repo) (bool, error) {
+	// send API call to create the webhook
+	hook, _, err := m.scm.Enable(u, r)
+	if err != nil {
+		if strings.EqualFold(err.Error(), "repo already enabled") {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	// send API call to capture the last hook for the repo
+	lastHook, err := m.database.LastHookForRepo(context.Background(), r)
+	if err != nil {
+		return false, fmt.Errorf("unable to get last hook: %w", err)
+	}
+
+	hook.SetRepoID(r.GetID())
+
+	if lastHook != nil {
+		hook.SetNumber(lastHook.GetNumber() + 1)
+	}
+
+	// send API call to create the initialization hook
+	err = m.database.CreateHook(context.Background(), hook)
+	if err != nil {
+		return false, fmt.Errorf("unable to create initialization webhook: %w", err)
+	}
+
+	return true, nil
+}
+
+// migrateOrgHooks is a helper function to recreate the org hooks on the new
+// SCM. Org hooks with an owner that has not logged in to the new SCM are
+// deactivated until they are recreated, so the webhooks for the repos in
+// the org are not skipped in favor of an org hook that no longer exists.
+func (m *Migrator) migrateOrgHooks(report *Report, owners map[int64]*library.User) error {
+	// send API call to capture all org hooks
+	orgHooks, err := m.database.ListOrgHooks(context.Background())
+	if err != nil {
+		return fmt.Errorf("unable to list org hooks: %w", err)
+	}
+
+	for _, oh := range orgHooks {
+		// skip org hooks deactivated by an org admin
+		if !oh.GetActive() && oh.GetUpdatedBy() != migrationActor {
+			continue
+		}
+
+		owner, ok := owners[oh.GetUserID()]
+		if !ok {
+			report.Pending = append(report.Pending, oh.GetOrg())
+
+			if m.config.DryRun || !oh.GetActive() {
+				continue
+			}
+
+			oh.SetActive(false)
+			oh.SetUpdatedAt(time.Now().UTC().Unix())
+			oh.SetUpdatedBy(migrationActor)
+
+			// send API call to update the org hook
+			err = m.database.UpdateOrgHook(context.Background(), oh)
+			if err != nil {
+				return fmt.Errorf("unable to update org hook for %s: %w", oh.GetOrg(), err)
+			}
+
+			continue
+		}
+
+		if m.config.DryRun {
+			report.OrgHooks = append(report.OrgHooks, oh.GetOrg())
+
+			continue
+		}
+
+		// send API call to create the webhook for the org
+		webhookID, err := m.scm.EnableOrg(owner, oh.GetOrg(), oh.GetSecret())
+		if err != nil {
+			// skip org hooks that already exist on the new SCM
+			if strings.EqualFold(err.Error(), "org already enabled") {
+				continue
+			}
+
+			report.Errors = append(report.Errors, fmt.Sprintf("unable to recreate org hook for %s: %v", oh.GetOrg(), err))
+
+			continue
+		}
+
+		oh.SetWebhookID(webhookID)
+		oh.SetActive(true)
+		oh.SetUpdatedAt(time.Now().UTC().Unix())
+		oh.SetUpdatedBy(migrationActor)
+
+		// send API call to update the org hook
+		err = m.database.UpdateOrgHook(context.Background(), oh)
+		if err != nil {
+			return fmt.Errorf("unable to update org hook for %s: %w", oh.GetOrg(), err)
+		}
+
+		report.OrgHooks = append(report.OrgHooks, oh.GetOrg())
+	}
+
+	return nil
+}
+
+// rewrite is a helper function to rewrite the provided link from the
+// SCM address migrated from to the new SCM address. It returns false
+// when the link does not belong to the SCM migrated from.
+func (m *Migrator) rewrite(link string) (string, bool) {
+	if !strings.HasPrefix(link, m.config.FromAddress+"/") {
+		return link, false
+	}
+
+	return m.config.ToAddress + strings.TrimPrefix(link, m.config.FromAddress), true
+}
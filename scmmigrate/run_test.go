@@ -0,0 +1,238 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scmmigrate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/scm/github"
+	"github.com/go-vela/types/library"
+)
+
+func TestSCMMigrate_Run(t *testing.T) {
+	// setup context
+	gin.SetMode(gin.TestMode)
+
+	_, engine := gin.CreateTestContext(httptest.NewRecorder())
+
+	// setup mock server
+	engine.GET("/api/v3/user", func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "Bearer valid" {
+			c.JSON(http.StatusUnauthorized, gin.H{"message": "Bad credentials"})
+
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"login": "octocat"})
+	})
+	engine.POST("/api/v3/repos/:org/:repo/hooks", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": 1, "created_at": "2011-09-06T17:26:27Z"})
+	})
+	engine.POST("/api/v3/orgs/:org/hooks", func(c *gin.Context) {
+		c.JSON(http.StatusCreated, gin.H{"id": 2, "created_at": "2011-09-06T17:26:27Z"})
+	})
+
+	s := httptest.NewServer(engine)
+	defer s.Close()
+
+	client, _ := github.NewTest(s.URL)
+
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() { _sql, _ := db.Sqlite.DB(); _sql.Close() }()
+
+	_octocat := testUser(1, "octocat", "valid")
+	_stale := testUser(2, "stale", "stale")
+
+	_migrated := testRepo(1, "octocat", _octocat.GetID(), "https://github.example.com")
+	_pending := testRepo(2, "stale", _stale.GetID(), "https://github.example.com")
+	_other := testRepo(3, "other", _octocat.GetID(), "https://gitlab.example.com")
+
+	_hook := new(library.Hook)
+	_hook.SetRepoID(_migrated.GetID())
+	_hook.SetNumber(1)
+	_hook.SetSourceID("c8da1302-07d6-11ea-882f-4893bca275b8")
+	_hook.SetWebhookID(123456)
+
+	_orgHook := testOrgHook("github", _octocat.GetID())
+	_staleOrgHook := testOrgHook("stale", _stale.GetID())
+
+	for _, u := range []*library.User{_octocat, _stale} {
+		err := db.CreateUser(context.TODO(), u)
+		if err != nil {
+			t.Fatalf("unable to create user: %v", err)
+		}
+	}
+
+	for _, r := range []*library.Repo{_migrated, _pending, _other} {
+		err := db.CreateRepo(context.TODO(), r)
+		if err != nil {
+			t.Fatalf("unable to create repo: %v", err)
+		}
+	}
+
+	err := db.CreateHook(context.TODO(), _hook)
+	if err != nil {
+		t.Fatalf("unable to create hook: %v", err)
+	}
+
+	for _, oh := range []*api.OrgHook{_orgHook, _staleOrgHook} {
+		err = db.CreateOrgHook(context.TODO(), oh)
+		if err != nil {
+			t.Fatalf("unable to create org hook: %v", err)
+		}
+	}
+
+	want := &Report{
+		Repos:    []string{"github/octocat", "github/stale"},
+		Users:    []string{"stale"},
+		Hooks:    []string{"github/octocat"},
+		OrgHooks: []string{"github"},
+		Pending:  []string{"github/stale", "stale"},
+		Errors:   []string{},
+	}
+
+	// run dry run test
+	m, err := New(
+		WithDatabase(db),
+		WithSCM(client),
+		WithFromAddress("https://github.example.com"),
+		WithToAddress("https://github.com"),
+		WithDryRun(true),
+	)
+	if err != nil {
+		t.Fatalf("unable to create migrator: %v", err)
+	}
+
+	got, err := m.Run()
+	if err != nil {
+		t.Fatalf("Run returned err: %v", err)
+	}
+
+	want.DryRun = true
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run for dry run is %v, want %v", got, want)
+	}
+
+	repo, _ := db.GetRepoForOrg(context.TODO(), "github", "octocat")
+	if repo.GetLink() != _migrated.GetLink() {
+		t.Errorf("Run for dry run link is %s, want %s", repo.GetLink(), _migrated.GetLink())
+	}
+
+	// run test
+	m.config.DryRun = false
+
+	got, err = m.Run()
+	if err != nil {
+		t.Fatalf("Run returned err: %v", err)
+	}
+
+	want.DryRun = false
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Run is %v, want %v", got, want)
+	}
+
+	repo, _ = db.GetRepoForOrg(context.TODO(), "github", "octocat")
+	if repo.GetLink() != "https://github.com/github/octocat" {
+		t.Errorf("Run link is %s, want %s", repo.GetLink(), "https://github.com/github/octocat")
+	}
+
+	if repo.GetClone() != "https://github.com/github/octocat.git" {
+		t.Errorf("Run clone is %s, want %s", repo.GetClone(), "https://github.com/github/octocat.git")
+	}
+
+	hook, err := db.GetHookForRepo(context.TODO(), repo, 2)
+	if err != nil {
+		t.Errorf("Run did not create initialization hook: %v", err)
+	}
+
+	if hook.GetWebhookID() != 1 {
+		t.Errorf("Run webhook ID is %d, want %d", hook.GetWebhookID(), 1)
+	}
+
+	other, _ := db.GetRepoForOrg(context.TODO(), "github", "other")
+	if other.GetLink() != _other.GetLink() {
+		t.Errorf("Run link is %s, want %s", other.GetLink(), _other.GetLink())
+	}
+
+	user, _ := db.GetUser(context.TODO(), _stale.GetID())
+	if len(user.GetRefreshToken()) > 0 {
+		t.Errorf("Run refresh token is %s, want empty", user.GetRefreshToken())
+	}
+
+	orgHook, _ := db.GetOrgHookForOrg(context.TODO(), "github")
+	if orgHook.GetWebhookID() != 2 || !orgHook.GetActive() {
+		t.Errorf("Run org hook is %v, want webhook ID 2 and active", orgHook)
+	}
+
+	staleOrgHook, _ := db.GetOrgHookForOrg(context.TODO(), "stale")
+	if staleOrgHook.GetActive() {
+		t.Errorf("Run org hook for stale is active, want inactive")
+	}
+}
+
+// testUser is a test helper function to create a
+// library User type with the provided fields.
+func testUser(id int64, name, token string) *library.User {
+	u := new(library.User)
+
+	u.SetID(id)
+	u.SetName(name)
+	u.SetToken(token)
+	u.SetRefreshToken("superSecretRefreshToken")
+	u.SetHash("superSecretHash")
+	u.SetActive(true)
+
+	return u
+}
+
+// testRepo is a test helper function to create a
+// library Repo type with the provided fields.
+func testRepo(id int64, name string, userID int64, address string) *library.Repo {
+	r := new(library.Repo)
+
+	r.SetID(id)
+	r.SetUserID(userID)
+	r.SetHash("superSecretHash")
+	r.SetOrg("github")
+	r.SetName(name)
+	r.SetFullName("github/" + name)
+	r.SetLink(address + "/github/" + name)
+	r.SetClone(address + "/github/" + name + ".git")
+	r.SetBranch("main")
+	r.SetTimeout(30)
+	r.SetVisibility("public")
+	r.SetPipelineType("yaml")
+	r.SetActive(true)
+
+	return r
+}
+
+// testOrgHook is a test helper function to create an
+// API OrgHook type with the provided fields.
+func testOrgHook(org string, userID int64) *api.OrgHook {
+	oh := new(api.OrgHook)
+
+	oh.SetOrg(org)
+	oh.SetWebhookID(123456)
+	oh.SetSecret("superSecretHash")
+	oh.SetAutoEnable(false)
+	oh.SetUserID(userID)
+	oh.SetActive(true)
+	oh.SetCreatedAt(1)
+	oh.SetCreatedBy("octocat")
+
+	return oh
+}
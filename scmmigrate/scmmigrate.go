@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scmmigrate
+
+import (
+	"fmt"
+
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/scm"
+)
+
+type (
+	// config represents the settings required to create the migrator.
+	config struct {
+		// specifies the address of the SCM to migrate from
+		FromAddress string
+		// specifies the address of the SCM to migrate to
+		ToAddress string
+		// specifies to report the changes without making them
+		DryRun bool
+	}
+
+	// Migrator represents the functionality for moving
+	// the repos, users and webhooks to a new SCM address.
+	Migrator struct {
+		// migrator configuration settings
+		config *config
+
+		// database service used to update the repos, users and org hooks
+		database database.Service
+		// scm service for the new SCM used to recreate webhooks
+		scm scm.Service
+	}
+)
+
+// New creates and returns a migrator for moving to a new SCM address.
+func New(opts ...Opt) (*Migrator, error) {
+	// create new migrator
+	m := new(Migrator)
+
+	// create new fields
+	m.config = new(config)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(m.config.FromAddress) == 0 || len(m.config.ToAddress) == 0 {
+		return nil, fmt.Errorf("no scm migration addresses provided")
+	}
+
+	if m.config.FromAddress == m.config.ToAddress {
+		return nil, fmt.Errorf("scm migration addresses must be different: %s", m.config.FromAddress)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package scmmigrate
+
+import (
+	"testing"
+)
+
+func TestSCMMigrate_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+		from    string
+	}{
+		{
+			name:    "addresses",
+			failure: false,
+			opts: []Opt{
+				WithFromAddress("https://github.example.com/"),
+				WithToAddress("https://github.com"),
+				WithDryRun(true),
+			},
+			from: "https://github.example.com",
+		},
+		{
+			name:    "no addresses",
+			failure: true,
+			opts:    []Opt{},
+		},
+		{
+			name:    "same addresses",
+			failure: true,
+			opts: []Opt{
+				WithFromAddress("https://github.com"),
+				WithToAddress("https://github.com/"),
+			},
+		},
+		{
+			name:    "relative address",
+			failure: true,
+			opts: []Opt{
+				WithFromAddress("github.example.com"),
+				WithToAddress("https://github.com"),
+			},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+
+			if got.config.FromAddress != test.from {
+				t.Errorf("New from address is %s, want %s", got.config.FromAddress, test.from)
+			}
+		})
+	}
+}
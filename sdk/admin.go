@@ -0,0 +1,505 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// AdminService handles the administrative server methods of the Vela API.
+type AdminService service
+
+// GetQueue returns the running and pending builds created
+// after the provided unix timestamp.
+func (s *AdminService) GetQueue(after int64) ([]*library.BuildQueue, *Response, error) {
+	v := []*library.BuildQueue{}
+
+	path := "/api/v1/admin/builds/queue"
+	if after > 0 {
+		path = fmt.Sprintf("%s?%s", path, url.Values{"after": {strconv.FormatInt(after, 10)}}.Encode())
+	}
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// GetAnomalies returns the build anomalies detected by the most recent check.
+func (s *AdminService) GetAnomalies() ([]*api.Anomaly, *Response, error) {
+	v := []*api.Anomaly{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/admin/anomalies", nil, &v)
+
+	return v, resp, err
+}
+
+// DetectAnomalies checks the builds for anomalies and returns the result.
+func (s *AdminService) DetectAnomalies() ([]*api.Anomaly, *Response, error) {
+	v := []*api.Anomaly{}
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/admin/anomalies", nil, &v)
+
+	return v, resp, err
+}
+
+// GetArchivedRepos returns a list of the repos archived when they were deleted.
+func (s *AdminService) GetArchivedRepos(opts *ListOptions) ([]*library.Repo, *Response, error) {
+	v := []*library.Repo{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions("/api/v1/admin/archived/repos", opts), nil, &v)
+
+	return v, resp, err
+}
+
+// RestoreArchivedRepo restores the archived repo along with its builds.
+func (s *AdminService) RestoreArchivedRepo(org, repo string) (*library.Repo, *Response, error) {
+	v := new(library.Repo)
+
+	u := fmt.Sprintf("/api/v1/admin/archived/repos/%s/%s/restore", org, repo)
+
+	resp, err := s.client.call(http.MethodPost, u, nil, v)
+
+	return v, resp, err
+}
+
+// PurgeArchivedRepo permanently deletes the archived repo along with its builds.
+func (s *AdminService) PurgeArchivedRepo(org, repo string) (*string, *Response, error) {
+	v := new(string)
+
+	u := fmt.Sprintf("/api/v1/admin/archived/repos/%s/%s", org, repo)
+
+	resp, err := s.client.call(http.MethodDelete, u, nil, v)
+
+	return v, resp, err
+}
+
+// GetArchivedSecrets returns a list of the native secrets archived when they were deleted.
+func (s *AdminService) GetArchivedSecrets(opts *ListOptions) ([]*library.Secret, *Response, error) {
+	v := []*library.Secret{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions("/api/v1/admin/archived/secrets", opts), nil, &v)
+
+	return v, resp, err
+}
+
+// RestoreArchivedSecret restores the archived native secret.
+func (s *AdminService) RestoreArchivedSecret(_type, org, name, secret string) (*library.Secret, *Response, error) {
+	v := new(library.Secret)
+
+	u := fmt.Sprintf("/api/v1/admin/archived/secrets/%s/%s/%s/%s/restore", _type, org, name, secret)
+
+	resp, err := s.client.call(http.MethodPost, u, nil, v)
+
+	return v, resp, err
+}
+
+// PurgeArchivedSecret permanently deletes the archived native secret.
+func (s *AdminService) PurgeArchivedSecret(_type, org, name, secret string) (*string, *Response, error) {
+	v := new(string)
+
+	u := fmt.Sprintf("/api/v1/admin/archived/secrets/%s/%s/%s/%s", _type, org, name, secret)
+
+	resp, err := s.client.call(http.MethodDelete, u, nil, v)
+
+	return v, resp, err
+}
+
+// GetCanaryRuns returns a list of the canary builds run through each route.
+func (s *AdminService) GetCanaryRuns(opts *ListOptions) ([]*api.CanaryRun, *Response, error) {
+	v := []*api.CanaryRun{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions("/api/v1/admin/canaries", opts), nil, &v)
+
+	return v, resp, err
+}
+
+// RunCanaries runs a canary build through each route and returns the result.
+func (s *AdminService) RunCanaries() ([]*api.CanaryRun, *Response, error) {
+	v := []*api.CanaryRun{}
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/admin/canaries", nil, &v)
+
+	return v, resp, err
+}
+
+// PushCatalog pushes every active repo as a component to the service catalog.
+func (s *AdminService) PushCatalog() (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/admin/catalog", nil, v)
+
+	return v, resp, err
+}
+
+// GetLeases returns the leases held by the server replicas to run singleton components.
+func (s *AdminService) GetLeases() ([]*api.Lease, *Response, error) {
+	v := []*api.Lease{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/admin/leases", nil, &v)
+
+	return v, resp, err
+}
+
+// GetLeakFindings returns a list of the leaked credentials found in the logs for builds.
+func (s *AdminService) GetLeakFindings(opts *ListOptions) ([]*api.LeakFinding, *Response, error) {
+	v := []*api.LeakFinding{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions("/api/v1/admin/leaks", opts), nil, &v)
+
+	return v, resp, err
+}
+
+// RunLeakScan scans the logs for the builds finished since the
+// last scan for leaked credentials and returns the findings.
+func (s *AdminService) RunLeakScan() ([]*api.LeakFinding, *Response, error) {
+	v := []*api.LeakFinding{}
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/admin/leaks", nil, &v)
+
+	return v, resp, err
+}
+
+// GetCapacity returns the snapshots of the worker capacity recorded
+// between the provided unix timestamps, averaged over the interval.
+func (s *AdminService) GetCapacity(after, before int64, interval time.Duration) ([]*api.CapacitySnapshot, *Response, error) {
+	v := []*api.CapacitySnapshot{}
+
+	params := url.Values{}
+	if after > 0 {
+		params.Set("after", strconv.FormatInt(after, 10))
+	}
+
+	if before > 0 {
+		params.Set("before", strconv.FormatInt(before, 10))
+	}
+
+	if interval > 0 {
+		params.Set("interval", interval.String())
+	}
+
+	path := "/api/v1/admin/capacity"
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// GetExports returns the manifest of the build results exported
+// to object storage for the dataset created after the unix timestamp.
+func (s *AdminService) GetExports(dataset string, after int64) ([]*api.Export, *Response, error) {
+	v := []*api.Export{}
+
+	params := url.Values{}
+	if len(dataset) > 0 {
+		params.Set("dataset", dataset)
+	}
+
+	if after > 0 {
+		params.Set("after", strconv.FormatInt(after, 10))
+	}
+
+	path := "/api/v1/admin/exports"
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// RunExport exports the build results finished since the last export to object storage.
+func (s *AdminService) RunExport() ([]*api.Export, *Response, error) {
+	v := []*api.Export{}
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/admin/exports", nil, &v)
+
+	return v, resp, err
+}
+
+// UpdateBuild modifies any build with the provided details.
+func (s *AdminService) UpdateBuild(b *library.Build) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/build", b, v)
+
+	return v, resp, err
+}
+
+// UpdateDeployment modifies any deployment with the provided details.
+func (s *AdminService) UpdateDeployment(d *library.Deployment) (*library.Deployment, *Response, error) {
+	v := new(library.Deployment)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/deployment", d, v)
+
+	return v, resp, err
+}
+
+// UpdateHook modifies any hook with the provided details.
+func (s *AdminService) UpdateHook(h *library.Hook) (*library.Hook, *Response, error) {
+	v := new(library.Hook)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/hook", h, v)
+
+	return v, resp, err
+}
+
+// GetOrgHooks returns all of the org hooks.
+func (s *AdminService) GetOrgHooks() ([]*api.OrgHook, *Response, error) {
+	v := []*api.OrgHook{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/admin/orghooks", nil, &v)
+
+	return v, resp, err
+}
+
+// UpdateOrgHook modifies the settings for any org hook with the provided details.
+func (s *AdminService) UpdateOrgHook(oh *api.OrgHook) (*api.OrgHook, *Response, error) {
+	v := new(api.OrgHook)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/orghook", oh, v)
+
+	return v, resp, err
+}
+
+// InspectQueue returns the memory used by the queue and the items waiting on
+// the routes, or the configured routes when none are provided, with the
+// metadata for up to the provided number of items on each route.
+func (s *AdminService) InspectQueue(routes []string, items int) (*api.QueueSummary, *Response, error) {
+	v := new(api.QueueSummary)
+
+	params := url.Values{}
+	for _, route := range routes {
+		params.Add("route", route)
+	}
+
+	if items > 0 {
+		params.Set("items", strconv.Itoa(items))
+	}
+
+	path := "/api/v1/admin/queue"
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	resp, err := s.client.call(http.MethodGet, path, nil, v)
+
+	return v, resp, err
+}
+
+// PurgeQueueRoute deletes the items waiting on the route in the queue.
+func (s *AdminService) PurgeQueueRoute(route string) (*string, *Response, error) {
+	v := new(string)
+
+	u := fmt.Sprintf("/api/v1/admin/queue/routes/%s", url.PathEscape(route))
+
+	resp, err := s.client.call(http.MethodDelete, u, nil, v)
+
+	return v, resp, err
+}
+
+// MoveQueueRoute moves the items waiting on the route to another route in the queue.
+func (s *AdminService) MoveQueueRoute(route, to string) (*string, *Response, error) {
+	v := new(string)
+
+	u := fmt.Sprintf("/api/v1/admin/queue/routes/%s/move?to=%s", url.PathEscape(route), url.QueryEscape(to))
+
+	resp, err := s.client.call(http.MethodPost, u, nil, v)
+
+	return v, resp, err
+}
+
+// GetDiagnosticsProfile captures the runtime profile for the server, where
+// seconds is the duration of the cpu profile or trace and debug is the
+// format of the point in time profiles.
+func (s *AdminService) GetDiagnosticsProfile(profile string, seconds, debug int) ([]byte, *Response, error) {
+	v := []byte{}
+
+	params := url.Values{}
+	if seconds > 0 {
+		params.Set("seconds", strconv.Itoa(seconds))
+	}
+
+	if debug > 0 {
+		params.Set("debug", strconv.Itoa(debug))
+	}
+
+	path := fmt.Sprintf("/api/v1/admin/diagnostics/pprof/%s", url.PathEscape(profile))
+	if len(params) > 0 {
+		path = fmt.Sprintf("%s?%s", path, params.Encode())
+	}
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// GetDiagnosticsBundle captures the diagnostics bundle for the server as a zip archive.
+func (s *AdminService) GetDiagnosticsBundle() ([]byte, *Response, error) {
+	v := []byte{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/admin/diagnostics/bundle", nil, &v)
+
+	return v, resp, err
+}
+
+// UpdateRepo modifies any repo with the provided details.
+func (s *AdminService) UpdateRepo(r *library.Repo) (*library.Repo, *Response, error) {
+	v := new(library.Repo)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/repo", r, v)
+
+	return v, resp, err
+}
+
+// GetRetentionPolicies returns the global retention policy and every repo retention policy.
+func (s *AdminService) GetRetentionPolicies() ([]*api.RetentionPolicy, *Response, error) {
+	v := []*api.RetentionPolicy{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/admin/retention", nil, &v)
+
+	return v, resp, err
+}
+
+// UpdateGlobalRetentionPolicy creates or modifies the global
+// retention policy applied to every repo without its own policy.
+func (s *AdminService) UpdateGlobalRetentionPolicy(p *api.RetentionPolicy) (*api.RetentionPolicy, *Response, error) {
+	v := new(api.RetentionPolicy)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/retention", p, v)
+
+	return v, resp, err
+}
+
+// GetRetentionPolicy returns the retention policy overriding the global policy for the repo.
+func (s *AdminService) GetRetentionPolicy(org, repo string) (*api.RetentionPolicy, *Response, error) {
+	v := new(api.RetentionPolicy)
+
+	u := fmt.Sprintf("/api/v1/admin/retention/repos/%s/%s", org, repo)
+
+	resp, err := s.client.call(http.MethodGet, u, nil, v)
+
+	return v, resp, err
+}
+
+// UpdateRetentionPolicy creates or modifies the retention
+// policy overriding the global policy for the repo.
+func (s *AdminService) UpdateRetentionPolicy(org, repo string, p *api.RetentionPolicy) (*api.RetentionPolicy, *Response, error) {
+	v := new(api.RetentionPolicy)
+
+	u := fmt.Sprintf("/api/v1/admin/retention/repos/%s/%s", org, repo)
+
+	resp, err := s.client.call(http.MethodPut, u, p, v)
+
+	return v, resp, err
+}
+
+// RemoveRetentionPolicy deletes the retention policy for
+// the repo so the global policy applies again.
+func (s *AdminService) RemoveRetentionPolicy(org, repo string) (*string, *Response, error) {
+	v := new(string)
+
+	u := fmt.Sprintf("/api/v1/admin/retention/repos/%s/%s", org, repo)
+
+	resp, err := s.client.call(http.MethodDelete, u, nil, v)
+
+	return v, resp, err
+}
+
+// RunRetentionSweep deletes the builds, logs and hooks
+// that are expired based on the retention policies.
+func (s *AdminService) RunRetentionSweep() (*api.RetentionSweep, *Response, error) {
+	v := new(api.RetentionSweep)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/admin/retention/sweep", nil, v)
+
+	return v, resp, err
+}
+
+// UpdateSecret modifies any secret with the provided details.
+func (s *AdminService) UpdateSecret(secret *library.Secret) (*library.Secret, *Response, error) {
+	v := new(library.Secret)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/secret", secret, v)
+
+	return v, resp, err
+}
+
+// UpdateService modifies any service with the provided details.
+func (s *AdminService) UpdateService(svc *library.Service) (*library.Service, *Response, error) {
+	v := new(library.Service)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/service", svc, v)
+
+	return v, resp, err
+}
+
+// UpdateStep modifies any step with the provided details.
+func (s *AdminService) UpdateStep(step *library.Step) (*library.Step, *Response, error) {
+	v := new(library.Step)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/step", step, v)
+
+	return v, resp, err
+}
+
+// UpdateUser modifies any user with the provided details.
+func (s *AdminService) UpdateUser(u *library.User) (*library.User, *Response, error) {
+	v := new(library.User)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/admin/user", u, v)
+
+	return v, resp, err
+}
+
+// RegisterToken returns a registration token for the provided worker.
+func (s *AdminService) RegisterToken(hostname string) (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/admin/workers/%s/register-token", hostname), nil, v)
+
+	return v, resp, err
+}
+
+// ExplainBuild returns everything that went into the decisions made
+// for the build with the provided ID, like the webhook that created it,
+// the queue route and worker it was assigned and any errors.
+func (s *AdminService) ExplainBuild(id int64) (*api.BuildExplanation, *Response, error) {
+	v := new(api.BuildExplanation)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/admin/builds/%d/explain", id), nil, v)
+
+	return v, resp, err
+}
+
+// CreateEphemeralRegistration creates a single-use registration for an
+// ephemeral worker bound to the provided build. The registration token
+// for the worker is only returned when the registration is created.
+func (s *AdminService) CreateEphemeralRegistration(r *api.EphemeralRegistration) (*api.EphemeralRegistration, *Response, error) {
+	v := new(api.EphemeralRegistration)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/admin/workers/ephemeral", r, v)
+
+	return v, resp, err
+}
+
+// RemoveEphemeralRegistration tears down the provided ephemeral worker and its registration.
+func (s *AdminService) RemoveEphemeralRegistration(hostname string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/admin/workers/ephemeral/%s", hostname), nil, v)
+
+	return v, resp, err
+}
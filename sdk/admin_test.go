@@ -0,0 +1,431 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_AdminService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "GetQueue",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetQueue(1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAnomalies",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetAnomalies()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "DetectAnomalies",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.DetectAnomalies()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetArchivedRepos",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetArchivedRepos(&ListOptions{Page: 1, PerPage: 10})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RestoreArchivedRepo",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RestoreArchivedRepo("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "PurgeArchivedRepo",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.PurgeArchivedRepo("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetArchivedSecrets",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetArchivedSecrets(&ListOptions{Page: 1, PerPage: 10})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RestoreArchivedSecret",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RestoreArchivedSecret("repo", "github", "octocat", "foo")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "PurgeArchivedSecret",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.PurgeArchivedSecret("repo", "github", "octocat", "foo")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetCanaryRuns",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetCanaryRuns(&ListOptions{Page: 1, PerPage: 10})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RunCanaries",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RunCanaries()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "PushCatalog",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.PushCatalog()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetLeases",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetLeases()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetLeakFindings",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetLeakFindings(&ListOptions{Page: 1, PerPage: 10})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RunLeakScan",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RunLeakScan()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetCapacity",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetCapacity(1563474077, 0, time.Hour)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetExports",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetExports("builds", 1563474077)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RunExport",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RunExport()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateBuild",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateBuild(new(library.Build))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateDeployment",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateDeployment(new(library.Deployment))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateHook",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateHook(new(library.Hook))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetOrgHooks",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetOrgHooks()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateOrgHook",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateOrgHook(new(api.OrgHook))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateRepo",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateRepo(new(library.Repo))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "InspectQueue",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.InspectQueue([]string{"vela"}, 2)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "PurgeQueueRoute",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.PurgeQueueRoute("vela")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "MoveQueueRoute",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.MoveQueueRoute("vela", "docker:linux")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetDiagnosticsProfile",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetDiagnosticsProfile("heap", 0, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetDiagnosticsBundle",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetDiagnosticsBundle()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetRetentionPolicies",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetRetentionPolicies()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateGlobalRetentionPolicy",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateGlobalRetentionPolicy(new(api.RetentionPolicy))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetRetentionPolicy",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.GetRetentionPolicy("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateRetentionPolicy",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateRetentionPolicy("github", "octocat", new(api.RetentionPolicy))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RemoveRetentionPolicy",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RemoveRetentionPolicy("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RunRetentionSweep",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RunRetentionSweep()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateSecret",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateSecret(new(library.Secret))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateService",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateService(new(library.Service))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateStep",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateStep(new(library.Step))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateUser",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.UpdateUser(new(library.User))
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "ExplainBuild",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.ExplainBuild(1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "CreateEphemeralRegistration",
+			call: func() (*Response, error) {
+				r := new(api.EphemeralRegistration)
+				r.SetBuildID(1)
+
+				_, resp, err := c.Admin.CreateEphemeralRegistration(r)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "RemoveEphemeralRegistration",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RemoveEphemeralRegistration("vela-ephemeral-1")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RegisterToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Admin.RegisterToken("worker_1")
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
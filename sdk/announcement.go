@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// AnnouncementService handles managing the announcements
+// published for the users of the platform from the server
+// methods of the Vela API.
+type AnnouncementService service
+
+// Get returns the provided announcement.
+func (s *AnnouncementService) Get(id int64) (*api.Announcement, *Response, error) {
+	v := new(api.Announcement)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/announcements/%d", id), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all announcements.
+func (s *AnnouncementService) GetAll() ([]*api.Announcement, *Response, error) {
+	v := []*api.Announcement{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/announcements", nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs an announcement with the provided details.
+func (s *AnnouncementService) Add(a *api.Announcement) (*api.Announcement, *Response, error) {
+	v := new(api.Announcement)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/announcements", a, v)
+
+	return v, resp, err
+}
+
+// Update modifies an announcement with the provided details.
+func (s *AnnouncementService) Update(id int64, a *api.Announcement) (*api.Announcement, *Response, error) {
+	v := new(api.Announcement)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/announcements/%d", id), a, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided announcement.
+func (s *AnnouncementService) Remove(id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/announcements/%d", id), nil, v)
+
+	return v, resp, err
+}
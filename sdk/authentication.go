@@ -0,0 +1,40 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+
+	"github.com/go-vela/types/library"
+)
+
+// AuthenticationService handles authentication with the server methods of the Vela API.
+type AuthenticationService service
+
+// AuthenticateWithToken exchanges the provided source control
+// personal access token for a Vela access token.
+func (s *AuthenticationService) AuthenticateWithToken(token string) (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	req, err := s.client.NewRequest(http.MethodPost, "/authenticate/token", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Token", token)
+
+	resp, err := s.client.Do(req, v)
+
+	return v, resp, err
+}
+
+// ValidateToken checks that the token for the client is a valid server token.
+func (s *AuthenticationService) ValidateToken() (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodGet, "/validate-token", nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSDK_AuthenticationService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "AuthenticateWithToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Authentication.AuthenticateWithToken("foobar")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "ValidateToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Authentication.ValidateToken()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
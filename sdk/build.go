@@ -0,0 +1,281 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// BuildService handles retrieving builds from the server methods of the Vela API.
+type BuildService service
+
+// Get returns the provided build.
+func (s *BuildService) Get(org, repo string, build int) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// GetByID returns the build for the provided id.
+func (s *BuildService) GetByID(id int64) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/search/builds/%d", id), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all builds for the provided repo.
+func (s *BuildService) GetAll(org, repo string, opts *BuildListOptions) ([]*library.Build, *Response, error) {
+	v := []*library.Build{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds", org, repo), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetAllForOrg returns a list of all builds for the provided org.
+func (s *BuildService) GetAllForOrg(org string, opts *BuildListOptions) ([]*library.Build, *Response, error) {
+	v := []*library.Build{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s/builds", org), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// Search returns a list of builds matching the provided search query.
+func (s *BuildService) Search(query string, opts *SearchOptions) ([]*api.SearchResult, *Response, error) {
+	v := []*api.SearchResult{}
+
+	q := opts.values()
+	q.Set("q", query)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/search?%s", q.Encode()), nil, &v)
+
+	return v, resp, err
+}
+
+// GetLogs returns a list of all logs for the provided build.
+func (s *BuildService) GetLogs(org, repo string, build int, opts *ListOptions) ([]*library.Log, *Response, error) {
+	v := []*library.Log{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/logs", org, repo, build), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// UpdateLogs modifies the logs for multiple steps and services of the provided build.
+func (s *BuildService) UpdateLogs(org, repo string, build int, logs []*library.Log) ([]*BulkLogResult, *Response, error) {
+	v := []*BulkLogResult{}
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/logs", org, repo, build), logs, &v)
+
+	return v, resp, err
+}
+
+// GetImages returns the images pinned to digests for the provided build.
+func (s *BuildService) GetImages(org, repo string, build int) (*api.BuildImages, *Response, error) {
+	v := new(api.BuildImages)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/images", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// GetDeprecations returns the deprecation warnings for the provided build.
+func (s *BuildService) GetDeprecations(org, repo string, build int) (*api.BuildDeprecations, *Response, error) {
+	v := new(api.BuildDeprecations)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/deprecations", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// GetCost returns the estimated cost of the provided build.
+func (s *BuildService) GetCost(org, repo string, build int) (*api.BuildCost, *Response, error) {
+	v := new(api.BuildCost)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/cost", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// GetLabels returns the pull request labels captured for the provided build.
+func (s *BuildService) GetLabels(org, repo string, build int) (*api.BuildLabels, *Response, error) {
+	v := new(api.BuildLabels)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/labels", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// GetLeakFindings returns the leaked credentials found in the logs for the provided build.
+func (s *BuildService) GetLeakFindings(org, repo string, build int) ([]*api.LeakFinding, *Response, error) {
+	v := []*api.LeakFinding{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/leaks", org, repo, build), nil, &v)
+
+	return v, resp, err
+}
+
+// GetRegistryCredentials returns the image pull credentials granted to the provided build.
+func (s *BuildService) GetRegistryCredentials(org, repo string, build int) ([]*api.RegistryCredential, *Response, error) {
+	v := []*api.RegistryCredential{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/registry-credentials", org, repo, build), nil, &v)
+
+	return v, resp, err
+}
+
+// GetReport returns the compilation report for the provided build.
+func (s *BuildService) GetReport(org, repo string, build int) (*api.CompileReport, *Response, error) {
+	v := new(api.CompileReport)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/report", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// GetSkips returns the stages and steps skipped for the provided build.
+func (s *BuildService) GetSkips(org, repo string, build int) ([]*api.StepSkip, *Response, error) {
+	v := []*api.StepSkip{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/skips", org, repo, build), nil, &v)
+
+	return v, resp, err
+}
+
+// GetServiceReadiness returns the readiness reported for the services of the provided build.
+func (s *BuildService) GetServiceReadiness(org, repo string, build int) ([]*api.ServiceReadiness, *Response, error) {
+	v := []*api.ServiceReadiness{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/readiness", org, repo, build), nil, &v)
+
+	return v, resp, err
+}
+
+// GetRedactions returns the records of the data for the provided build being redacted or deleted.
+func (s *BuildService) GetRedactions(org, repo string, build int) ([]*api.BuildRedaction, *Response, error) {
+	v := []*api.BuildRedaction{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/redactions", org, repo, build), nil, &v)
+
+	return v, resp, err
+}
+
+// Redact redacts the provided values from, or deletes, the data for the provided build.
+func (s *BuildService) Redact(org, repo string, build int, r *api.BuildRedaction) (*api.BuildRedaction, *Response, error) {
+	v := new(api.BuildRedaction)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/redactions", org, repo, build), r, v)
+
+	return v, resp, err
+}
+
+// GetToken returns an auth token for the provided build.
+func (s *BuildService) GetToken(org, repo string, build int) (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/token", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// Add constructs a build with the provided details.
+func (s *BuildService) Add(org, repo string, b *library.Build) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds", org, repo), b, v)
+
+	return v, resp, err
+}
+
+// AddWithPipelineRef constructs a build with the provided details using
+// the pipeline configuration from the provided ref instead of the commit
+// for the build, i.e. building a release branch with the pipeline from
+// the default branch.
+func (s *BuildService) AddWithPipelineRef(org, repo string, b *library.Build, ref string) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	u := fmt.Sprintf("/api/v1/repos/%s/%s/builds?pipeline_ref=%s", org, repo, url.QueryEscape(ref))
+
+	resp, err := s.client.call(http.MethodPost, u, b, v)
+
+	return v, resp, err
+}
+
+// Update modifies a build with the provided details.
+func (s *BuildService) Update(org, repo string, b *library.Build) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d", org, repo, b.GetNumber()), b, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided build.
+func (s *BuildService) Remove(org, repo string, build int) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// Restart takes the build provided and restarts it.
+func (s *BuildService) Restart(org, repo string, build int) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// Cancel takes the build provided and cancels it.
+func (s *BuildService) Cancel(org, repo string, build int) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/cancel", org, repo, build), nil, v)
+
+	return v, resp, err
+}
+
+// AddWithIdempotencyKey constructs a build with the provided details.
+// Retries of the request that provide the same idempotency key return
+// the originally created build instead of creating a duplicate build.
+func (s *BuildService) AddWithIdempotencyKey(org, repo string, b *library.Build, key string) (*library.Build, *Response, error) {
+	return s.idempotent(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds", org, repo), b, key)
+}
+
+// RestartWithIdempotencyKey takes the build provided and restarts it.
+// Retries of the request that provide the same idempotency key return
+// the originally restarted build instead of restarting it again.
+func (s *BuildService) RestartWithIdempotencyKey(org, repo string, build int, key string) (*library.Build, *Response, error) {
+	return s.idempotent(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d", org, repo, build), nil, key)
+}
+
+// idempotent is a helper function to send a build-triggering
+// request with the provided idempotency key.
+func (s *BuildService) idempotent(method, path string, body interface{}, key string) (*library.Build, *Response, error) {
+	v := new(library.Build)
+
+	req, err := s.client.NewRequest(method, path, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Idempotency-Key", key)
+
+	resp, err := s.client.Do(req, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_BuildService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	b := new(library.Build)
+	b.SetNumber(1)
+
+	r := new(api.BuildRedaction)
+	r.SetTargets([]string{"logs"})
+	r.SetValues([]string{"abc123"})
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.Get("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetAll("github", "octocat", &BuildListOptions{Branch: "main"})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Search",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.Search("fix", &SearchOptions{Org: "github", Logs: true})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetLogs",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetLogs("github", "octocat", 1, nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateLogs",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.UpdateLogs("github", "octocat", 1, []*library.Log{})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetImages",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetImages("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetDeprecations",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetDeprecations("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetCost",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetCost("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetLabels",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetLabels("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetLeakFindings",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetLeakFindings("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetRegistryCredentials",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetRegistryCredentials("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetReport",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetReport("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetSkips",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetSkips("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetServiceReadiness",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetServiceReadiness("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetRedactions",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetRedactions("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Redact",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.Redact("github", "octocat", 1, r)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "GetToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.GetToken("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.Add("github", "octocat", b)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "Update",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.Update("github", "octocat", b)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Remove",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.Remove("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Restart",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.Restart("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "AddWithPipelineRef",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.AddWithPipelineRef("github", "octocat", b, "main")
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "AddWithIdempotencyKey",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.AddWithIdempotencyKey("github", "octocat", b, "retry")
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "RestartWithIdempotencyKey",
+			call: func() (*Response, error) {
+				_, resp, err := c.Build.RestartWithIdempotencyKey("github", "octocat", 1, "retry")
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// CatalogService handles retrieving service catalog components from the server methods of the Vela API.
+type CatalogService service
+
+// GetAll returns every active repo as a component for a service catalog.
+func (s *CatalogService) GetAll() ([]*api.CatalogComponent, *Response, error) {
+	v := []*api.CatalogComponent{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/catalog/components", nil, &v)
+
+	return v, resp, err
+}
+
+// Get returns the provided repo as a component for a service catalog.
+func (s *CatalogService) Get(org, repo string) (*api.CatalogComponent, *Response, error) {
+	v := new(api.CatalogComponent)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/catalog/components/%s/%s", org, repo), nil, v)
+
+	return v, resp, err
+}
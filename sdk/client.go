@@ -0,0 +1,250 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-vela/server/version"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+type (
+	// Client represents a client for the Vela server API.
+	Client struct {
+		// base address of the Vela server
+		baseURL *url.URL
+		// http client used to send requests
+		client *http.Client
+		// retryable client used to configure retries
+		retry *retryablehttp.Client
+		// token used to authenticate requests
+		token string
+		// user agent sent with requests
+		userAgent string
+
+		// services used to interact with the API
+		Admin           *AdminService
+		Announcement    *AnnouncementService
+		Authentication  *AuthenticationService
+		Build           *BuildService
+		Catalog         *CatalogService
+		Deployment      *DeploymentService
+		Deprecation     *DeprecationService
+		Egress          *EgressService
+		Freeze          *FreezeService
+		Hook            *HookService
+		Log             *LogService
+		Mirror          *MirrorService
+		OrgHook         *OrgHookService
+		Pipeline        *PipelineService
+		Preview         *PreviewService
+		Provenance      *ProvenanceService
+		Registry        *RegistryService
+		Repo            *RepoService
+		RequiredContext *RequiredContextService
+		SCM             *SCMService
+		Schedule        *ScheduleService
+		Secret          *SecretService
+		Step            *StepService
+		Svc             *SvcService
+		Template        *TemplateService
+		Usage           *UsageService
+		User            *UserService
+		Worker          *WorkerService
+		WorkerGroup     *WorkerGroupService
+	}
+
+	// service represents the shared fields for the API services.
+	service struct {
+		client *Client
+	}
+
+	// Error represents an error returned by the Vela server API.
+	Error struct {
+		// response returned by the Vela server
+		Response *http.Response
+		// message returned by the Vela server
+		Message string `json:"error"`
+	}
+)
+
+// NewClient creates and returns a client for the Vela server
+// API hosted at the provided address.
+func NewClient(address string, opts ...ClientOpt) (*Client, error) {
+	// check if the address provided is empty
+	if len(address) == 0 {
+		return nil, fmt.Errorf("no Vela server address provided")
+	}
+
+	baseURL, err := url.Parse(strings.TrimSuffix(address, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Vela server address provided: %w", err)
+	}
+
+	// create new client
+	c := new(Client)
+
+	// create new fields
+	c.baseURL = baseURL
+	c.userAgent = "vela-sdk"
+
+	// include the version in the user agent when available
+	if len(version.Tag) > 0 {
+		c.userAgent = fmt.Sprintf("vela-sdk/%s", version.Tag)
+	}
+
+	c.retry = retryablehttp.NewClient()
+	c.retry.Logger = nil
+	c.retry.RetryMax = 3
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// https://pkg.go.dev/github.com/hashicorp/go-retryablehttp#Client.StandardClient
+	c.client = c.retry.StandardClient()
+
+	// create the services for the client
+	s := &service{client: c}
+
+	c.Admin = (*AdminService)(s)
+	c.Announcement = (*AnnouncementService)(s)
+	c.Authentication = (*AuthenticationService)(s)
+	c.Build = (*BuildService)(s)
+	c.Catalog = (*CatalogService)(s)
+	c.Deployment = (*DeploymentService)(s)
+	c.Deprecation = (*DeprecationService)(s)
+	c.Egress = (*EgressService)(s)
+	c.Freeze = (*FreezeService)(s)
+	c.Hook = (*HookService)(s)
+	c.Log = (*LogService)(s)
+	c.Mirror = (*MirrorService)(s)
+	c.OrgHook = (*OrgHookService)(s)
+	c.Pipeline = (*PipelineService)(s)
+	c.Preview = (*PreviewService)(s)
+	c.Provenance = (*ProvenanceService)(s)
+	c.Registry = (*RegistryService)(s)
+	c.Repo = (*RepoService)(s)
+	c.RequiredContext = (*RequiredContextService)(s)
+	c.SCM = (*SCMService)(s)
+	c.Schedule = (*ScheduleService)(s)
+	c.Secret = (*SecretService)(s)
+	c.Step = (*StepService)(s)
+	c.Svc = (*SvcService)(s)
+	c.Template = (*TemplateService)(s)
+	c.Usage = (*UsageService)(s)
+	c.User = (*UserService)(s)
+	c.Worker = (*WorkerService)(s)
+	c.WorkerGroup = (*WorkerGroupService)(s)
+
+	return c, nil
+}
+
+// NewRequest creates an API request for the provided path relative
+// to the Vela server address with the JSON encoded body.
+func (c *Client) NewRequest(method, path string, body interface{}) (*http.Request, error) {
+	u, err := url.Parse(c.baseURL.String() + path)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.Reader
+
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		buf = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if len(c.token) > 0 {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	}
+
+	req.Header.Set("User-Agent", c.userAgent)
+
+	return req, nil
+}
+
+// Do sends the API request and decodes the JSON response into
+// the provided value. An Error is returned for any response
+// with a status code outside of the 2xx range.
+func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	response := newResponse(resp)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		e := &Error{Response: resp}
+
+		data, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(data, e) != nil || len(e.Message) == 0 {
+			e.Message = strings.TrimSpace(string(data))
+		}
+
+		return response, e
+	}
+
+	// skip decoding the response if no value is provided
+	if v == nil {
+		return response, nil
+	}
+
+	// write the raw response for byte slices
+	if b, ok := v.(*[]byte); ok {
+		*b, err = io.ReadAll(resp.Body)
+
+		return response, err
+	}
+
+	err = json.NewDecoder(resp.Body).Decode(v)
+	if err == io.EOF {
+		err = nil
+	}
+
+	return response, err
+}
+
+// call is a helper function to create and send an API request.
+func (c *Client) call(method, path string, body, v interface{}) (*Response, error) {
+	req, err := c.NewRequest(method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req, v)
+}
+
+// Error implements the error interface for the Error type.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Message)
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-vela/server/mock/server"
+)
+
+// newTestClient returns a client for a mock Vela server
+// that is closed when the test completes.
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	s := httptest.NewServer(server.FakeHandler())
+	t.Cleanup(s.Close)
+
+	c, err := NewClient(s.URL, WithToken("foobar"), WithRetries(0, 0, 0))
+	if err != nil {
+		t.Fatalf("NewClient returned err: %v", err)
+	}
+
+	return c
+}
+
+func TestSDK_NewClient(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		address string
+		opts    []ClientOpt
+	}{
+		{
+			name:    "address",
+			failure: false,
+			address: "https://vela.example.com/",
+		},
+		{
+			name:    "with options",
+			failure: false,
+			address: "https://vela.example.com",
+			opts: []ClientOpt{
+				WithToken("foobar"),
+				WithHTTPClient(http.DefaultClient),
+				WithRetries(5, time.Second, 10*time.Second),
+				WithUserAgent("vela-cli"),
+			},
+		},
+		{
+			name:    "empty address",
+			failure: true,
+			address: "",
+		},
+		{
+			name:    "invalid address",
+			failure: true,
+			address: "!@#$%^&*()",
+		},
+		{
+			name:    "nil http client",
+			failure: true,
+			address: "https://vela.example.com",
+			opts:    []ClientOpt{WithHTTPClient(nil)},
+		},
+		{
+			name:    "invalid retries",
+			failure: true,
+			address: "https://vela.example.com",
+			opts:    []ClientOpt{WithRetries(-1, 0, 0)},
+		},
+		{
+			name:    "invalid retry wait",
+			failure: true,
+			address: "https://vela.example.com",
+			opts:    []ClientOpt{WithRetries(1, 10*time.Second, time.Second)},
+		},
+		{
+			name:    "empty user agent",
+			failure: true,
+			address: "https://vela.example.com",
+			opts:    []ClientOpt{WithUserAgent("")},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewClient(test.address, test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("NewClient should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("NewClient returned err: %v", err)
+			}
+
+			if got.baseURL.String() != "https://vela.example.com" {
+				t.Errorf("NewClient base URL is %s, want https://vela.example.com", got.baseURL)
+			}
+
+			if got.Repo == nil || got.Repo.client != got {
+				t.Errorf("NewClient did not create the services")
+			}
+		})
+	}
+}
+
+func TestSDK_Client_NewRequest(t *testing.T) {
+	// setup types
+	c, err := NewClient("https://vela.example.com", WithToken("foobar"), WithUserAgent("vela-cli"))
+	if err != nil {
+		t.Fatalf("NewClient returned err: %v", err)
+	}
+
+	// run test
+	got, err := c.NewRequest(http.MethodPost, "/api/v1/repos", map[string]string{"org": "github"})
+	if err != nil {
+		t.Fatalf("NewRequest returned err: %v", err)
+	}
+
+	if got.URL.String() != "https://vela.example.com/api/v1/repos" {
+		t.Errorf("NewRequest URL is %s, want https://vela.example.com/api/v1/repos", got.URL)
+	}
+
+	if got.Header.Get("Authorization") != "Bearer foobar" {
+		t.Errorf("NewRequest Authorization is %s, want Bearer foobar", got.Header.Get("Authorization"))
+	}
+
+	if got.Header.Get("User-Agent") != "vela-cli" {
+		t.Errorf("NewRequest User-Agent is %s, want vela-cli", got.Header.Get("User-Agent"))
+	}
+
+	if got.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("NewRequest Content-Type is %s, want application/json", got.Header.Get("Content-Type"))
+	}
+}
+
+func TestSDK_Client_Do_Error(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	// run test
+	_, resp, err := c.Repo.Get("github", "not-found")
+	if err == nil {
+		t.Fatalf("Get should have returned err")
+	}
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("Get returned err %v, want *Error", err)
+	}
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Get returned %v, want %v", resp.StatusCode, http.StatusNotFound)
+	}
+
+	if e.Message != "Repo not-found does not exist" {
+		t.Errorf("Get returned message %s, want Repo not-found does not exist", e.Message)
+	}
+}
+
+func TestSDK_Client_Do_Retry(t *testing.T) {
+	// setup types
+	attempts := 0
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`"vela-server"`))
+	}))
+	defer s.Close()
+
+	c, err := NewClient(s.URL, WithToken("foobar"), WithRetries(3, time.Millisecond, time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewClient returned err: %v", err)
+	}
+
+	// run test
+	got, _, err := c.Authentication.ValidateToken()
+	if err != nil {
+		t.Fatalf("ValidateToken returned err: %v", err)
+	}
+
+	if *got != "vela-server" {
+		t.Errorf("ValidateToken is %s, want vela-server", *got)
+	}
+
+	if attempts != 3 {
+		t.Errorf("ValidateToken made %d attempts, want 3", attempts)
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// DeploymentService handles retrieving deployments from the server methods of the Vela API.
+type DeploymentService service
+
+// Get returns the provided deployment.
+func (s *DeploymentService) Get(org, repo string, deployment int) (*library.Deployment, *Response, error) {
+	v := new(library.Deployment)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/deployments/%s/%s/%d", org, repo, deployment), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all deployments for the provided repo.
+func (s *DeploymentService) GetAll(org, repo string, opts *ListOptions) ([]*library.Deployment, *Response, error) {
+	v := []*library.Deployment{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/deployments/%s/%s", org, repo), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetEnvironments returns the last successful deployment
+// to each environment for the provided repo.
+func (s *DeploymentService) GetEnvironments(org, repo string) ([]*api.EnvironmentDeploy, *Response, error) {
+	v := []*api.EnvironmentDeploy{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/deployments/%s/%s/environments", org, repo), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a deployment with the provided details.
+func (s *DeploymentService) Add(org, repo string, d *library.Deployment) (*library.Deployment, *Response, error) {
+	v := new(library.Deployment)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/deployments/%s/%s", org, repo), d, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_DeploymentService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	d := new(library.Deployment)
+	d.SetTarget("production")
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deployment.Get("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deployment.GetAll("github", "octocat", nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetEnvironments",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deployment.GetEnvironments("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deployment.Add("github", "octocat", d)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
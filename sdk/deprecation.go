@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// DeprecationService handles managing the deprecation rules
+// applied to pipeline images, templates and syntax from
+// the server methods of the Vela API.
+type DeprecationService service
+
+// Get returns the provided deprecation rule.
+func (s *DeprecationService) Get(id int64) (*api.DeprecationRule, *Response, error) {
+	v := new(api.DeprecationRule)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/deprecations/%d", id), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all deprecation rules.
+func (s *DeprecationService) GetAll() ([]*api.DeprecationRule, *Response, error) {
+	v := []*api.DeprecationRule{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/deprecations", nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a deprecation rule with the provided details.
+func (s *DeprecationService) Add(d *api.DeprecationRule) (*api.DeprecationRule, *Response, error) {
+	v := new(api.DeprecationRule)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/deprecations", d, v)
+
+	return v, resp, err
+}
+
+// Update modifies a deprecation rule with the provided details.
+func (s *DeprecationService) Update(id int64, d *api.DeprecationRule) (*api.DeprecationRule, *Response, error) {
+	v := new(api.DeprecationRule)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/deprecations/%d", id), d, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided deprecation rule.
+func (s *DeprecationService) Remove(id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/deprecations/%d", id), nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSDK_DeprecationService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	d := new(api.DeprecationRule)
+	d.SetKind("image")
+	d.SetPattern("golang:1.1*")
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deprecation.Get(1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deprecation.GetAll()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deprecation.Add(d)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "Update",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deprecation.Update(1, d)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Remove",
+			call: func() (*Response, error) {
+				_, resp, err := c.Deprecation.Remove(1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package sdk provides the ability for Go tooling to
+// interact with the Vela server through typed methods
+// for each API endpoint, with retries and pagination.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/sdk"
+//
+//	client, err := sdk.NewClient("https://vela.example.com", sdk.WithToken(token))
+//	if err != nil {
+//		return err
+//	}
+//
+//	repo, _, err := client.Repo.Get("github", "octocat")
+package sdk
@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// EgressService handles managing the egress rules
+// enforced on the steps of the builds for an org
+// from the server methods of the Vela API.
+type EgressService service
+
+// Get returns the provided egress rule for the org.
+func (s *EgressService) Get(org string, id int64) (*api.EgressRule, *Response, error) {
+	v := new(api.EgressRule)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/egress/%s/%d", org, id), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all egress rules for the org.
+func (s *EgressService) GetAll(org string) ([]*api.EgressRule, *Response, error) {
+	v := []*api.EgressRule{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/egress/%s", org), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs an egress rule for the org with the provided details.
+func (s *EgressService) Add(org string, r *api.EgressRule) (*api.EgressRule, *Response, error) {
+	v := new(api.EgressRule)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/egress/%s", org), r, v)
+
+	return v, resp, err
+}
+
+// Update modifies an egress rule for the org with the provided details.
+func (s *EgressService) Update(org string, id int64, r *api.EgressRule) (*api.EgressRule, *Response, error) {
+	v := new(api.EgressRule)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/egress/%s/%d", org, id), r, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided egress rule for the org.
+func (s *EgressService) Remove(org string, id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/egress/%s/%d", org, id), nil, v)
+
+	return v, resp, err
+}
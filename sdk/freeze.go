@@ -0,0 +1,63 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// FreezeService handles managing the change freezes
+// that block the builds for an org from the server
+// methods of the Vela API.
+type FreezeService service
+
+// Get returns the provided freeze for the org.
+func (s *FreezeService) Get(org string, id int64) (*api.Freeze, *Response, error) {
+	v := new(api.Freeze)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/freezes/%s/%d", org, id), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all freezes for the org.
+func (s *FreezeService) GetAll(org string) ([]*api.Freeze, *Response, error) {
+	v := []*api.Freeze{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/freezes/%s", org), nil, &v)
+
+	return v, resp, err
+}
+
+// GetAudits returns a list of the records of the provided
+// freeze for the org being created, deleted or overridden.
+func (s *FreezeService) GetAudits(org string, id int64) ([]*api.FreezeAudit, *Response, error) {
+	v := []*api.FreezeAudit{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/freezes/%s/%d/audits", org, id), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a freeze for the org with the provided details.
+func (s *FreezeService) Add(org string, f *api.Freeze) (*api.Freeze, *Response, error) {
+	v := new(api.Freeze)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/freezes/%s", org), f, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided freeze for the org.
+func (s *FreezeService) Remove(org string, id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/freezes/%s/%d", org, id), nil, v)
+
+	return v, resp, err
+}
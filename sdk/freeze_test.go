@@ -0,0 +1,90 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSDK_FreezeService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	f := new(api.Freeze)
+	f.SetName("holiday")
+	f.SetScope(api.FreezeScopeDeploy)
+	f.SetStarts(1563474076)
+	f.SetEnds(1563560476)
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.Freeze.Get("github", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.Freeze.GetAll("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.Freeze.Add("github", f)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "GetAudits",
+			call: func() (*Response, error) {
+				_, resp, err := c.Freeze.GetAudits("github", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Remove",
+			call: func() (*Response, error) {
+				_, resp, err := c.Freeze.Remove("github", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/types/library"
+)
+
+// HookService handles retrieving hooks from the server methods of the Vela API.
+type HookService service
+
+// Get returns the provided hook.
+func (s *HookService) Get(org, repo string, hook int) (*library.Hook, *Response, error) {
+	v := new(library.Hook)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/hooks/%s/%s/%d", org, repo, hook), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all hooks for the provided repo.
+func (s *HookService) GetAll(org, repo string, opts *ListOptions) ([]*library.Hook, *Response, error) {
+	v := []*library.Hook{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/hooks/%s/%s", org, repo), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a hook with the provided details.
+func (s *HookService) Add(org, repo string, h *library.Hook) (*library.Hook, *Response, error) {
+	v := new(library.Hook)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/hooks/%s/%s", org, repo), h, v)
+
+	return v, resp, err
+}
+
+// Update modifies a hook with the provided details.
+func (s *HookService) Update(org, repo string, h *library.Hook) (*library.Hook, *Response, error) {
+	v := new(library.Hook)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/hooks/%s/%s/%d", org, repo, h.GetNumber()), h, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided hook.
+func (s *HookService) Remove(org, repo string, hook int) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/hooks/%s/%s/%d", org, repo, hook), nil, v)
+
+	return v, resp, err
+}
+
+// Redeliver sends the provided hook to the server again.
+func (s *HookService) Redeliver(org, repo string, hook int) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/hooks/%s/%s/%d/redeliver", org, repo, hook), nil, v)
+
+	return v, resp, err
+}
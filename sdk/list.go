@@ -0,0 +1,166 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type (
+	// ListOptions represents the pagination options for list endpoints.
+	ListOptions struct {
+		// page of results to retrieve
+		Page int
+		// number of results per page
+		PerPage int
+	}
+
+	// BuildListOptions represents the options for listing builds.
+	BuildListOptions struct {
+		ListOptions
+
+		// filter builds by branch
+		Branch string
+		// filter builds by event
+		Event string
+		// filter builds by status
+		Status string
+		// filter builds created before the unix timestamp
+		Before int64
+		// filter builds created after the unix timestamp
+		After int64
+	}
+
+	// SearchOptions represents the options for searching builds.
+	SearchOptions struct {
+		ListOptions
+
+		// limit the search to builds for the org
+		Org string
+		// limit the search to builds for the repo within the org
+		Repo string
+		// include builds with log lines matching the search
+		Logs bool
+	}
+
+	// values represents options that can be encoded as query parameters.
+	values interface {
+		values() url.Values
+	}
+)
+
+// values returns the query parameters for the list options.
+func (o *ListOptions) values() url.Values {
+	v := url.Values{}
+
+	if o == nil {
+		return v
+	}
+
+	if o.Page > 0 {
+		v.Set("page", strconv.Itoa(o.Page))
+	}
+
+	if o.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(o.PerPage))
+	}
+
+	return v
+}
+
+// values returns the query parameters for the build list options.
+func (o *BuildListOptions) values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+
+	v := o.ListOptions.values()
+
+	if len(o.Branch) > 0 {
+		v.Set("branch", o.Branch)
+	}
+
+	if len(o.Event) > 0 {
+		v.Set("event", o.Event)
+	}
+
+	if len(o.Status) > 0 {
+		v.Set("status", o.Status)
+	}
+
+	if o.Before > 0 {
+		v.Set("before", strconv.FormatInt(o.Before, 10))
+	}
+
+	if o.After > 0 {
+		v.Set("after", strconv.FormatInt(o.After, 10))
+	}
+
+	return v
+}
+
+// values returns the query parameters for the search options.
+func (o *SearchOptions) values() url.Values {
+	if o == nil {
+		return url.Values{}
+	}
+
+	v := o.ListOptions.values()
+
+	if len(o.Org) > 0 {
+		v.Set("org", o.Org)
+	}
+
+	if len(o.Repo) > 0 {
+		v.Set("repo", o.Repo)
+	}
+
+	if o.Logs {
+		v.Set("logs", "true")
+	}
+
+	return v
+}
+
+// withOptions is a helper function to add the
+// query parameters for the options to the path.
+func withOptions(path string, opts values) string {
+	if opts == nil {
+		return path
+	}
+
+	v := opts.values()
+	if len(v) == 0 {
+		return path
+	}
+
+	return fmt.Sprintf("%s?%s", path, v.Encode())
+}
+
+// All calls the provided list function for each page of
+// results, starting with the first page, and returns the
+// combined results from every page.
+func All[T any](list func(opts *ListOptions) ([]T, *Response, error)) ([]T, error) {
+	results := []T{}
+	opts := &ListOptions{Page: 1, PerPage: 100}
+
+	for {
+		page, resp, err := list(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, page...)
+
+		// check if there are more pages of results
+		if resp == nil || resp.NextPage == 0 {
+			return results, nil
+		}
+
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSDK_withOptions(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name string
+		opts values
+		want string
+	}{
+		{
+			name: "nil options",
+			opts: nil,
+			want: "/api/v1/repos",
+		},
+		{
+			name: "empty options",
+			opts: &ListOptions{},
+			want: "/api/v1/repos",
+		},
+		{
+			name: "list options",
+			opts: &ListOptions{Page: 2, PerPage: 50},
+			want: "/api/v1/repos?page=2&per_page=50",
+		},
+		{
+			name: "build list options",
+			opts: &BuildListOptions{
+				ListOptions: ListOptions{Page: 1},
+				Branch:      "main",
+				Event:       "push",
+				Status:      "success",
+				Before:      2,
+				After:       1,
+			},
+			want: "/api/v1/repos?after=1&before=2&branch=main&event=push&page=1&status=success",
+		},
+		{
+			name: "search options",
+			opts: &SearchOptions{
+				ListOptions: ListOptions{PerPage: 20},
+				Org:         "github",
+				Repo:        "octocat",
+				Logs:        true,
+			},
+			want: "/api/v1/repos?logs=true&org=github&per_page=20&repo=octocat",
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := withOptions("/api/v1/repos", test.opts)
+
+			if got != test.want {
+				t.Errorf("withOptions is %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSDK_All(t *testing.T) {
+	// setup types
+	pages := map[int][]int{
+		1: {1, 2},
+		2: {3, 4},
+		3: {5},
+	}
+
+	want := []int{1, 2, 3, 4, 5}
+
+	// run test
+	got, err := All(func(opts *ListOptions) ([]int, *Response, error) {
+		resp := new(Response)
+
+		if opts.Page < len(pages) {
+			resp.NextPage = opts.Page + 1
+		}
+
+		return pages[opts.Page], resp, nil
+	})
+	if err != nil {
+		t.Errorf("All returned err: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("All is %v, want %v", got, want)
+	}
+
+	_, err = All(func(opts *ListOptions) ([]int, *Response, error) {
+		return nil, nil, errors.New("failure")
+	})
+	if err == nil {
+		t.Errorf("All should have returned err")
+	}
+}
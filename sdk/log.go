@@ -0,0 +1,177 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// LogService handles retrieving logs from the server methods of the Vela API.
+type LogService service
+
+// BulkLogResult represents the outcome for a single
+// log provided in a bulk log update for a build.
+type BulkLogResult struct {
+	ServiceID int64  `json:"service_id,omitempty"`
+	StepID    int64  `json:"step_id,omitempty"`
+	Status    int    `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// GetService returns the logs for the provided service.
+func (s *LogService) GetService(org, repo string, build, service int) (*library.Log, *Response, error) {
+	v := new(library.Log)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/logs", org, repo, build, service), nil, v)
+
+	return v, resp, err
+}
+
+// AddService constructs the logs for the provided service.
+func (s *LogService) AddService(org, repo string, build, service int, l *library.Log) (*library.Log, *Response, error) {
+	v := new(library.Log)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/logs", org, repo, build, service), l, v)
+
+	return v, resp, err
+}
+
+// UpdateService modifies the logs for the provided service.
+func (s *LogService) UpdateService(org, repo string, build, service int, l *library.Log) (*library.Log, *Response, error) {
+	v := new(library.Log)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/logs", org, repo, build, service), l, v)
+
+	return v, resp, err
+}
+
+// RemoveService deletes the logs for the provided service.
+func (s *LogService) RemoveService(org, repo string, build, service int) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/logs", org, repo, build, service), nil, v)
+
+	return v, resp, err
+}
+
+// GetServiceLines returns a list of the log lines for the provided service.
+func (s *LogService) GetServiceLines(org, repo string, build, service int, opts *ListOptions) ([]*api.LogLine, *Response, error) {
+	v := []*api.LogLine{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/logs/lines", org, repo, build, service), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// AddServiceLines appends the provided log lines for the provided service.
+func (s *LogService) AddServiceLines(org, repo string, build, service int, lines []*api.LogLine) ([]*api.LogLine, *Response, error) {
+	v := []*api.LogLine{}
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/logs/lines", org, repo, build, service), lines, &v)
+
+	return v, resp, err
+}
+
+// RenderService returns the logs for the provided service with the ANSI
+// escape sequences rendered in the provided format (json or html).
+func (s *LogService) RenderService(org, repo string, build, service int, format string, opts *ListOptions) ([]byte, *Response, error) {
+	v := []byte{}
+
+	path := withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/logs/render", org, repo, build, service), &renderOptions{ListOptions: opts, Format: format})
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// GetStep returns the logs for the provided step.
+func (s *LogService) GetStep(org, repo string, build, step int) (*library.Log, *Response, error) {
+	v := new(library.Log)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/logs", org, repo, build, step), nil, v)
+
+	return v, resp, err
+}
+
+// AddStep constructs the logs for the provided step.
+func (s *LogService) AddStep(org, repo string, build, step int, l *library.Log) (*library.Log, *Response, error) {
+	v := new(library.Log)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/logs", org, repo, build, step), l, v)
+
+	return v, resp, err
+}
+
+// UpdateStep modifies the logs for the provided step.
+func (s *LogService) UpdateStep(org, repo string, build, step int, l *library.Log) (*library.Log, *Response, error) {
+	v := new(library.Log)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/logs", org, repo, build, step), l, v)
+
+	return v, resp, err
+}
+
+// RemoveStep deletes the logs for the provided step.
+func (s *LogService) RemoveStep(org, repo string, build, step int) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/logs", org, repo, build, step), nil, v)
+
+	return v, resp, err
+}
+
+// GetStepLines returns a list of the log lines for the provided step.
+func (s *LogService) GetStepLines(org, repo string, build, step int, opts *ListOptions) ([]*api.LogLine, *Response, error) {
+	v := []*api.LogLine{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/logs/lines", org, repo, build, step), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// AddStepLines appends the provided log lines for the provided step.
+func (s *LogService) AddStepLines(org, repo string, build, step int, lines []*api.LogLine) ([]*api.LogLine, *Response, error) {
+	v := []*api.LogLine{}
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/logs/lines", org, repo, build, step), lines, &v)
+
+	return v, resp, err
+}
+
+// RenderStep returns the logs for the provided step with the ANSI
+// escape sequences rendered in the provided format (json or html).
+func (s *LogService) RenderStep(org, repo string, build, step int, format string, opts *ListOptions) ([]byte, *Response, error) {
+	v := []byte{}
+
+	path := withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/logs/render", org, repo, build, step), &renderOptions{ListOptions: opts, Format: format})
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// renderOptions represents the options for rendering logs.
+type renderOptions struct {
+	*ListOptions
+
+	// format to render the logs in
+	Format string
+}
+
+// values returns the query parameters for the render options.
+func (o *renderOptions) values() url.Values {
+	v := o.ListOptions.values()
+
+	if len(o.Format) > 0 {
+		v.Set("format", o.Format)
+	}
+
+	return v
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_LogService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	l := new(library.Log)
+	l.SetData([]byte("hello"))
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "GetService",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.GetService("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "AddService",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.AddService("github", "octocat", 1, 1, l)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "UpdateService",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.UpdateService("github", "octocat", 1, 1, l)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RemoveService",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.RemoveService("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetServiceLines",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.GetServiceLines("github", "octocat", 1, 1, nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetStep",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.GetStep("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "AddStep",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.AddStep("github", "octocat", 1, 1, l)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "UpdateStep",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.UpdateStep("github", "octocat", 1, 1, l)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RemoveStep",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.RemoveStep("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetStepLines",
+			call: func() (*Response, error) {
+				_, resp, err := c.Log.GetStepLines("github", "octocat", 1, 1, nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// MirrorService handles managing the registry mirrors
+// applied to pipeline images from the server methods
+// of the Vela API.
+type MirrorService service
+
+// Get returns the provided registry mirror.
+func (s *MirrorService) Get(id int64) (*api.RegistryMirror, *Response, error) {
+	v := new(api.RegistryMirror)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/mirrors/%d", id), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all registry mirrors.
+func (s *MirrorService) GetAll() ([]*api.RegistryMirror, *Response, error) {
+	v := []*api.RegistryMirror{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/mirrors", nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a registry mirror with the provided details.
+func (s *MirrorService) Add(m *api.RegistryMirror) (*api.RegistryMirror, *Response, error) {
+	v := new(api.RegistryMirror)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/mirrors", m, v)
+
+	return v, resp, err
+}
+
+// Update modifies a registry mirror with the provided details.
+func (s *MirrorService) Update(id int64, m *api.RegistryMirror) (*api.RegistryMirror, *Response, error) {
+	v := new(api.RegistryMirror)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/mirrors/%d", id), m, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided registry mirror.
+func (s *MirrorService) Remove(id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/mirrors/%d", id), nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ClientOpt represents a configuration option to initialize the client.
+type ClientOpt func(*Client) error
+
+// WithToken sets the token used to authenticate requests in the client.
+func WithToken(token string) ClientOpt {
+	return func(c *Client) error {
+		// set the token in the client
+		c.token = token
+
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http client used to send requests in the client.
+func WithHTTPClient(client *http.Client) ClientOpt {
+	return func(c *Client) error {
+		// check if the http client provided is empty
+		if client == nil {
+			return fmt.Errorf("no http client provided")
+		}
+
+		// set the http client in the client
+		c.retry.HTTPClient = client
+
+		return nil
+	}
+}
+
+// WithRetries sets the maximum number of retries and the wait
+// bounds between retries for failed requests in the client.
+func WithRetries(retries int, minWait, maxWait time.Duration) ClientOpt {
+	return func(c *Client) error {
+		// check if the retries provided are valid
+		if retries < 0 || minWait < 0 || maxWait < minWait {
+			return fmt.Errorf("invalid retries provided: %d retries between %s and %s", retries, minWait, maxWait)
+		}
+
+		// set the retries in the client
+		c.retry.RetryMax = retries
+		c.retry.RetryWaitMin = minWait
+		c.retry.RetryWaitMax = maxWait
+
+		return nil
+	}
+}
+
+// WithUserAgent sets the user agent sent with requests in the client.
+func WithUserAgent(agent string) ClientOpt {
+	return func(c *Client) error {
+		// check if the user agent provided is empty
+		if len(agent) == 0 {
+			return fmt.Errorf("no user agent provided")
+		}
+
+		// set the user agent in the client
+		c.userAgent = agent
+
+		return nil
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// OrgHookService handles managing the webhook
+// for orgs from the server methods of the Vela API.
+type OrgHookService service
+
+// Get returns the org hook for the org.
+func (s *OrgHookService) Get(org string) (*api.OrgHook, *Response, error) {
+	v := new(api.OrgHook)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/orghooks/%s", org), nil, v)
+
+	return v, resp, err
+}
+
+// Add constructs the org hook for the org with the provided details.
+func (s *OrgHookService) Add(org string, oh *api.OrgHook) (*api.OrgHook, *Response, error) {
+	v := new(api.OrgHook)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/orghooks/%s", org), oh, v)
+
+	return v, resp, err
+}
+
+// Update modifies the org hook for the org with the provided details.
+func (s *OrgHookService) Update(org string, oh *api.OrgHook) (*api.OrgHook, *Response, error) {
+	v := new(api.OrgHook)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/orghooks/%s", org), oh, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the org hook for the org.
+func (s *OrgHookService) Remove(org string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/orghooks/%s", org), nil, v)
+
+	return v, resp, err
+}
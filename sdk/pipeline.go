@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/server/compiler/expression"
+	"github.com/go-vela/types/library"
+	"github.com/go-vela/types/pipeline"
+	"github.com/go-vela/types/yaml"
+)
+
+// PipelineService handles retrieving pipelines from the server methods of the Vela API.
+type PipelineService service
+
+// Get returns the provided pipeline.
+func (s *PipelineService) Get(org, repo, commit string) (*library.Pipeline, *Response, error) {
+	v := new(library.Pipeline)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s", org, repo, commit), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all pipelines for the provided repo.
+func (s *PipelineService) GetAll(org, repo string, opts *ListOptions) ([]*library.Pipeline, *Response, error) {
+	v := []*library.Pipeline{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/pipelines/%s/%s", org, repo), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a pipeline with the provided details.
+func (s *PipelineService) Add(org, repo string, p *library.Pipeline) (*library.Pipeline, *Response, error) {
+	v := new(library.Pipeline)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/pipelines/%s/%s", org, repo), p, v)
+
+	return v, resp, err
+}
+
+// Update modifies a pipeline with the provided details.
+func (s *PipelineService) Update(org, repo string, p *library.Pipeline) (*library.Pipeline, *Response, error) {
+	v := new(library.Pipeline)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s", org, repo, p.GetCommit()), p, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided pipeline.
+func (s *PipelineService) Remove(org, repo, commit string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s", org, repo, commit), nil, v)
+
+	return v, resp, err
+}
+
+// Compile returns the fully compiled pipeline for the provided commit.
+func (s *PipelineService) Compile(org, repo, commit string) (*pipeline.Build, *Response, error) {
+	v := new(pipeline.Build)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s/compile?output=json", org, repo, commit), nil, v)
+
+	return v, resp, err
+}
+
+// Expand returns the pipeline with the templates expanded for the provided commit.
+func (s *PipelineService) Expand(org, repo, commit string) (*yaml.Build, *Response, error) {
+	v := new(yaml.Build)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s/expand?output=json", org, repo, commit), nil, v)
+
+	return v, resp, err
+}
+
+// Explain evaluates the conditional expressions for the provided commit
+// against the provided build information and explains each result.
+func (s *PipelineService) Explain(org, repo, commit string, d *expression.Data) ([]*expression.Explanation, *Response, error) {
+	v := []*expression.Explanation{}
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s/explain", org, repo, commit), d, &v)
+
+	return v, resp, err
+}
+
+// Validate returns the pipeline for the provided commit
+// when it is valid and an error when it is not.
+func (s *PipelineService) Validate(org, repo, commit string) (*yaml.Build, *Response, error) {
+	v := new(yaml.Build)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s/validate?output=json", org, repo, commit), nil, v)
+
+	return v, resp, err
+}
+
+// Templates returns the templates referenced by the pipeline for the provided commit.
+func (s *PipelineService) Templates(org, repo, commit string) (map[string]*library.Template, *Response, error) {
+	v := make(map[string]*library.Template)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/pipelines/%s/%s/%s/templates?output=json", org, repo, commit), nil, &v)
+
+	return v, resp, err
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// PreviewService handles registering and tracking the
+// preview environments deployed by pull request builds
+// from the server methods of the Vela API.
+type PreviewService service
+
+// GetAll returns a list of all preview environments for the repo.
+// When a pull request number is provided, only the preview
+// environments for that pull request are returned.
+func (s *PreviewService) GetAll(org, repo string, number int) ([]*api.PreviewEnvironment, *Response, error) {
+	v := []*api.PreviewEnvironment{}
+
+	path := fmt.Sprintf("/api/v1/repos/%s/%s/previews", org, repo)
+	if number > 0 {
+		path = fmt.Sprintf("%s?number=%d", path, number)
+	}
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// GetForBuild returns the preview environments for the pull request of the provided build.
+func (s *PreviewService) GetForBuild(org, repo string, build int) ([]*api.PreviewEnvironment, *Response, error) {
+	v := []*api.PreviewEnvironment{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/previews", org, repo, build), nil, &v)
+
+	return v, resp, err
+}
+
+// Add registers a preview environment deployed by the provided build.
+func (s *PreviewService) Add(org, repo string, build int, p *api.PreviewEnvironment) (*api.PreviewEnvironment, *Response, error) {
+	v := new(api.PreviewEnvironment)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/previews", org, repo, build), p, v)
+
+	return v, resp, err
+}
+
+// Update modifies a preview environment for the repo with the provided details.
+func (s *PreviewService) Update(org, repo string, id int64, p *api.PreviewEnvironment) (*api.PreviewEnvironment, *Response, error) {
+	v := new(api.PreviewEnvironment)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/previews/%d", org, repo, id), p, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ProvenanceService handles recording the artifacts produced
+// by builds and tracing artifacts and deployments back to the
+// builds that produced them from the server methods of the Vela API.
+type ProvenanceService service
+
+// AddArtifact records an artifact produced by the provided build.
+func (s *ProvenanceService) AddArtifact(org, repo string, build int, a *api.Artifact) (*api.Artifact, *Response, error) {
+	v := new(api.Artifact)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/artifacts", org, repo, build), a, v)
+
+	return v, resp, err
+}
+
+// GetArtifacts returns the artifacts produced by the provided build.
+func (s *ProvenanceService) GetArtifacts(org, repo string, build int) ([]*api.Artifact, *Response, error) {
+	v := []*api.Artifact{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/artifacts", org, repo, build), nil, &v)
+
+	return v, resp, err
+}
+
+// GetForArtifact returns the provenance for the builds that produced the artifact with the provided digest.
+func (s *ProvenanceService) GetForArtifact(org, repo, digest string) ([]*api.Provenance, *Response, error) {
+	v := []*api.Provenance{}
+
+	path := fmt.Sprintf("/api/v1/repos/%s/%s/provenance/artifacts/%s", org, repo, url.PathEscape(digest))
+
+	resp, err := s.client.call(http.MethodGet, path, nil, &v)
+
+	return v, resp, err
+}
+
+// GetForDeployment returns the provenance for the builds that produced the provided deployment.
+func (s *ProvenanceService) GetForDeployment(org, repo string, deployment int) ([]*api.Provenance, *Response, error) {
+	v := []*api.Provenance{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/deployments/%s/%s/%d/provenance", org, repo, deployment), nil, &v)
+
+	return v, resp, err
+}
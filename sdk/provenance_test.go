@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSDK_ProvenanceService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	a := new(api.Artifact)
+	a.SetName("vela-server")
+	a.SetDigest("sha256:5d41402abc4b2a76b9719d911017c5925d41402abc4b2a76b9719d911017c592")
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "AddArtifact",
+			call: func() (*Response, error) {
+				_, resp, err := c.Provenance.AddArtifact("github", "octocat", 1, a)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "GetArtifacts",
+			call: func() (*Response, error) {
+				_, resp, err := c.Provenance.GetArtifacts("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetForArtifact",
+			call: func() (*Response, error) {
+				_, resp, err := c.Provenance.GetForArtifact("github", "octocat", a.GetDigest())
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetForDeployment",
+			call: func() (*Response, error) {
+				_, resp, err := c.Provenance.GetForDeployment("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
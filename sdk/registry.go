@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// RegistryService handles managing the image registry
+// credentials for orgs from the server methods of the Vela API.
+type RegistryService service
+
+// Get returns the provided registry credential for the org.
+func (s *RegistryService) Get(org, registry string) (*api.RegistryCredential, *Response, error) {
+	v := new(api.RegistryCredential)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/registries/%s/%s", org, registry), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all registry credentials for the org.
+func (s *RegistryService) GetAll(org string) ([]*api.RegistryCredential, *Response, error) {
+	v := []*api.RegistryCredential{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/registries/%s", org), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a registry credential for the org with the provided details.
+func (s *RegistryService) Add(org string, rc *api.RegistryCredential) (*api.RegistryCredential, *Response, error) {
+	v := new(api.RegistryCredential)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/registries/%s", org), rc, v)
+
+	return v, resp, err
+}
+
+// Update modifies a registry credential for the org with the provided details.
+func (s *RegistryService) Update(org, registry string, rc *api.RegistryCredential) (*api.RegistryCredential, *Response, error) {
+	v := new(api.RegistryCredential)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/registries/%s/%s", org, registry), rc, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided registry credential for the org.
+func (s *RegistryService) Remove(org, registry string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/registries/%s/%s", org, registry), nil, v)
+
+	return v, resp, err
+}
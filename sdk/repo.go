@@ -0,0 +1,226 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// RepoService handles retrieving repos from the server methods of the Vela API.
+type RepoService service
+
+// Get returns the provided repo.
+func (s *RepoService) Get(org, repo string) (*library.Repo, *Response, error) {
+	v := new(library.Repo)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all repos for the current user.
+func (s *RepoService) GetAll(opts *ListOptions) ([]*library.Repo, *Response, error) {
+	v := []*library.Repo{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions("/api/v1/repos", opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetAllForOrg returns a list of all repos for the provided org.
+func (s *RepoService) GetAllForOrg(org string, opts *ListOptions) ([]*library.Repo, *Response, error) {
+	v := []*library.Repo{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s", org), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a repo with the provided details.
+func (s *RepoService) Add(r *library.Repo) (*library.Repo, *Response, error) {
+	v := new(library.Repo)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/repos", r, v)
+
+	return v, resp, err
+}
+
+// Update modifies a repo with the provided details.
+func (s *RepoService) Update(org, repo string, r *library.Repo) (*library.Repo, *Response, error) {
+	v := new(library.Repo)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s", org, repo), r, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided repo.
+func (s *RepoService) Remove(org, repo string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// Repair modifies a damaged repo webhook.
+func (s *RepoService) Repair(org, repo string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodPatch, fmt.Sprintf("/api/v1/repos/%s/%s/repair", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// Chown modifies the owner of a repo to the current user.
+func (s *RepoService) Chown(org, repo string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodPatch, fmt.Sprintf("/api/v1/repos/%s/%s/chown", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// GetSync returns the result of the last sync of the provided repo with the SCM.
+func (s *RepoService) GetSync(org, repo string) (*api.RepoSync, *Response, error) {
+	v := new(api.RepoSync)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/sync", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// SyncOrg reconciles the active repos for the provided org with the SCM.
+func (s *RepoService) SyncOrg(org string) ([]*api.RepoSync, *Response, error) {
+	v := []*api.RepoSync{}
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/sync", org), nil, &v)
+
+	return v, resp, err
+}
+
+// TriggerToken creates a token that can only create
+// or restart builds for the provided repo.
+func (s *RepoService) TriggerToken(org, repo string) (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/trigger-token", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// OrgTriggerToken creates a token that can only create
+// or restart builds for the repos in the provided org.
+func (s *RepoService) OrgTriggerToken(org string) (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/trigger-token", org), nil, v)
+
+	return v, resp, err
+}
+
+// GetTriggerTokens returns the trigger
+// tokens minted for the provided repo.
+func (s *RepoService) GetTriggerTokens(org, repo string) (*[]api.TriggerToken, *Response, error) {
+	v := new([]api.TriggerToken)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/trigger-tokens", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// RevokeTriggerToken revokes the trigger
+// token minted for the provided repo.
+func (s *RepoService) RevokeTriggerToken(org, repo string, id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/trigger-tokens/%d", org, repo, id), nil, v)
+
+	return v, resp, err
+}
+
+// GetOrgTriggerTokens returns the trigger tokens
+// minted for the repos in the provided org.
+func (s *RepoService) GetOrgTriggerTokens(org string) (*[]api.TriggerToken, *Response, error) {
+	v := new([]api.TriggerToken)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/trigger-tokens", org), nil, v)
+
+	return v, resp, err
+}
+
+// RevokeOrgTriggerToken revokes the trigger token
+// minted for the repos in the provided org.
+func (s *RepoService) RevokeOrgTriggerToken(org string, id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/trigger-tokens/%d", org, id), nil, v)
+
+	return v, resp, err
+}
+
+// GetCloneSetting returns the settings controlling
+// how the clone step fetches the provided repo.
+func (s *RepoService) GetCloneSetting(org, repo string) (*api.CloneSetting, *Response, error) {
+	v := new(api.CloneSetting)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/clone", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// UpdateCloneSetting modifies the settings controlling how the clone
+// step fetches the provided repo with the provided details.
+func (s *RepoService) UpdateCloneSetting(org, repo string, cs *api.CloneSetting) (*api.CloneSetting, *Response, error) {
+	v := new(api.CloneSetting)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/clone", org, repo), cs, v)
+
+	return v, resp, err
+}
+
+// RemoveCloneSetting deletes the settings controlling
+// how the clone step fetches the provided repo.
+func (s *RepoService) RemoveCloneSetting(org, repo string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/clone", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// GetOrgCloneSetting returns the default settings controlling
+// how the clone step fetches the repos in the provided org.
+func (s *RepoService) GetOrgCloneSetting(org string) (*api.CloneSetting, *Response, error) {
+	v := new(api.CloneSetting)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/clone", org), nil, v)
+
+	return v, resp, err
+}
+
+// UpdateOrgCloneSetting modifies the default settings controlling how the
+// clone step fetches the repos in the provided org with the provided details.
+func (s *RepoService) UpdateOrgCloneSetting(org string, cs *api.CloneSetting) (*api.CloneSetting, *Response, error) {
+	v := new(api.CloneSetting)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/clone", org), cs, v)
+
+	return v, resp, err
+}
+
+// RemoveOrgCloneSetting deletes the default settings controlling
+// how the clone step fetches the repos in the provided org.
+func (s *RepoService) RemoveOrgCloneSetting(org string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/clone", org), nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_RepoService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	r := new(library.Repo)
+	r.SetOrg("github")
+	r.SetName("octocat")
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.Get("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.GetAll(&ListOptions{Page: 1, PerPage: 10})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.Add(r)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "Update",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.Update("github", "octocat", r)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Remove",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.Remove("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetSync",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.GetSync("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "SyncOrg",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.SyncOrg("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "TriggerToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.TriggerToken("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "OrgTriggerToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.OrgTriggerToken("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetTriggerTokens",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.GetTriggerTokens("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RevokeTriggerToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.RevokeTriggerToken("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetOrgTriggerTokens",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.GetOrgTriggerTokens("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RevokeOrgTriggerToken",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.RevokeOrgTriggerToken("github", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetCloneSetting",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.GetCloneSetting("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateCloneSetting",
+			call: func() (*Response, error) {
+				cs := new(api.CloneSetting)
+				cs.SetSubmodules(api.CloneModeEnabled)
+				cs.SetSubmoduleDepth(1)
+
+				_, resp, err := c.Repo.UpdateCloneSetting("github", "octocat", cs)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RemoveCloneSetting",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.RemoveCloneSetting("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetOrgCloneSetting",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.GetOrgCloneSetting("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateOrgCloneSetting",
+			call: func() (*Response, error) {
+				cs := new(api.CloneSetting)
+				cs.SetDepth(50)
+				cs.SetSingleBranch(api.CloneModeEnabled)
+
+				_, resp, err := c.Repo.UpdateOrgCloneSetting("github", cs)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RemoveOrgCloneSetting",
+			call: func() (*Response, error) {
+				_, resp, err := c.Repo.RemoveOrgCloneSetting("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
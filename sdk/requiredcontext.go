@@ -0,0 +1,72 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// RequiredContextService handles managing the status
+// contexts required by the branch protection for the
+// repos in an org from the server methods of the Vela API.
+type RequiredContextService service
+
+// Get returns the provided required context for the org.
+func (s *RequiredContextService) Get(org string, id int64) (*api.RequiredContext, *Response, error) {
+	v := new(api.RequiredContext)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/requiredcontexts/%s/%d", org, id), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all required contexts for the org.
+func (s *RequiredContextService) GetAll(org string) ([]*api.RequiredContext, *Response, error) {
+	v := []*api.RequiredContext{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/requiredcontexts/%s", org), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a required context for the org with the provided details.
+func (s *RequiredContextService) Add(org string, rc *api.RequiredContext) (*api.RequiredContext, *Response, error) {
+	v := new(api.RequiredContext)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/requiredcontexts/%s", org), rc, v)
+
+	return v, resp, err
+}
+
+// Update modifies a required context for the org with the provided details.
+func (s *RequiredContextService) Update(org string, id int64, rc *api.RequiredContext) (*api.RequiredContext, *Response, error) {
+	v := new(api.RequiredContext)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/requiredcontexts/%s/%d", org, id), rc, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided required context for the org.
+func (s *RequiredContextService) Remove(org string, id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/requiredcontexts/%s/%d", org, id), nil, v)
+
+	return v, resp, err
+}
+
+// Sync configures the branch protection for the enabled repos
+// the provided required context for the org applies to.
+func (s *RequiredContextService) Sync(org string, id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/requiredcontexts/%s/%d/sync", org, id), nil, v)
+
+	return v, resp, err
+}
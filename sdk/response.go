@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Response represents a response from the Vela server API
+// with the pagination details parsed from the Link header.
+type Response struct {
+	*http.Response
+
+	// page numbers parsed from the Link header
+	FirstPage int
+	PrevPage  int
+	NextPage  int
+	LastPage  int
+
+	// total number of results parsed from the X-Total-Count header
+	Total int64
+}
+
+// newResponse creates a response from the provided http.Response.
+func newResponse(r *http.Response) *Response {
+	response := &Response{Response: r}
+
+	response.Total, _ = strconv.ParseInt(r.Header.Get("X-Total-Count"), 10, 64)
+
+	// parse each link from the Link header
+	//
+	// <https://vela.example.com/api/v1/repos?per_page=10&page=2>; rel="next"
+	for _, link := range strings.Split(r.Header.Get("Link"), ",") {
+		segments := strings.Split(strings.TrimSpace(link), ";")
+		if len(segments) < 2 {
+			continue
+		}
+
+		u, err := url.Parse(strings.Trim(strings.TrimSpace(segments[0]), "<>"))
+		if err != nil {
+			continue
+		}
+
+		page, err := strconv.Atoi(u.Query().Get("page"))
+		if err != nil {
+			continue
+		}
+
+		for _, segment := range segments[1:] {
+			switch strings.TrimSpace(segment) {
+			case `rel="first"`:
+				response.FirstPage = page
+			case `rel="prev"`:
+				response.PrevPage = page
+			case `rel="next"`:
+				response.NextPage = page
+			case `rel="last"`:
+				response.LastPage = page
+			}
+		}
+	}
+
+	return response
+}
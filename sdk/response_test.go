@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestSDK_newResponse(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name   string
+		header http.Header
+		want   *Response
+	}{
+		{
+			name: "links",
+			header: http.Header{
+				"Link": []string{
+					`<https://vela.example.com/api/v1/repos?page=1&per_page=10>; rel="first", ` +
+						`<https://vela.example.com/api/v1/repos?page=2&per_page=10>; rel="prev", ` +
+						`<https://vela.example.com/api/v1/repos?page=4&per_page=10>; rel="next", ` +
+						`<https://vela.example.com/api/v1/repos?page=9&per_page=10>; rel="last"`,
+				},
+				"X-Total-Count": []string{"87"},
+			},
+			want: &Response{FirstPage: 1, PrevPage: 2, NextPage: 4, LastPage: 9, Total: 87},
+		},
+		{
+			name:   "empty",
+			header: http.Header{},
+			want:   &Response{},
+		},
+		{
+			name: "invalid links",
+			header: http.Header{
+				"Link": []string{`<https://vela.example.com/api/v1/repos?page=foo>; rel="next", invalid`},
+			},
+			want: &Response{},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := newResponse(&http.Response{Header: test.header})
+
+			if got.FirstPage != test.want.FirstPage ||
+				got.PrevPage != test.want.PrevPage ||
+				got.NextPage != test.want.NextPage ||
+				got.LastPage != test.want.LastPage ||
+				got.Total != test.want.Total {
+				t.Errorf("newResponse is %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
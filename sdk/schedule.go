@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// ScheduleService handles managing the cron schedules
+// that trigger the builds for a repo from the server
+// methods of the Vela API.
+type ScheduleService service
+
+// Get returns the provided schedule for the repo.
+func (s *ScheduleService) Get(org, repo, name string) (*api.Schedule, *Response, error) {
+	v := new(api.Schedule)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/schedules/%s/%s/%s", org, repo, name), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all schedules for the repo.
+func (s *ScheduleService) GetAll(org, repo string) ([]*api.Schedule, *Response, error) {
+	v := []*api.Schedule{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/schedules/%s/%s", org, repo), nil, &v)
+
+	return v, resp, err
+}
+
+// GetRuns returns a list of the records of the provided schedule
+// for the repo triggering a build or being skipped.
+func (s *ScheduleService) GetRuns(org, repo, name string, opts *ListOptions) ([]*api.ScheduleRun, *Response, error) {
+	v := []*api.ScheduleRun{}
+
+	u := withOptions(fmt.Sprintf("/api/v1/schedules/%s/%s/%s/runs", org, repo, name), opts)
+
+	resp, err := s.client.call(http.MethodGet, u, nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a schedule for the repo with the provided details.
+func (s *ScheduleService) Add(org, repo string, schedule *api.Schedule) (*api.Schedule, *Response, error) {
+	v := new(api.Schedule)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/schedules/%s/%s", org, repo), schedule, v)
+
+	return v, resp, err
+}
+
+// Update modifies the provided schedule for the repo with the provided details.
+func (s *ScheduleService) Update(org, repo string, schedule *api.Schedule) (*api.Schedule, *Response, error) {
+	v := new(api.Schedule)
+
+	u := fmt.Sprintf("/api/v1/schedules/%s/%s/%s", org, repo, schedule.GetName())
+
+	resp, err := s.client.call(http.MethodPut, u, schedule, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided schedule for the repo.
+func (s *ScheduleService) Remove(org, repo, name string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/schedules/%s/%s/%s", org, repo, name), nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// SCMService handles synchronizing repos with the source
+// control provider from the server methods of the Vela API.
+type SCMService service
+
+// Sync synchronizes the provided repo with the source control provider.
+func (s *SCMService) Sync(org, repo string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/scm/repos/%s/%s/sync", org, repo), nil, v)
+
+	return v, resp, err
+}
+
+// SyncAll synchronizes the repos for the provided org with the source control provider.
+func (s *SCMService) SyncAll(org string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/scm/orgs/%s/sync", org), nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/types/library"
+)
+
+// SecretService handles retrieving secrets from the server methods of the Vela API.
+type SecretService service
+
+// Get returns the provided secret.
+func (s *SecretService) Get(engine, sType, org, name, secret string) (*library.Secret, *Response, error) {
+	v := new(library.Secret)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/secrets/%s/%s/%s/%s/%s", engine, sType, org, name, secret), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all secrets for the provided type, org and name.
+func (s *SecretService) GetAll(engine, sType, org, name string, opts *ListOptions) ([]*library.Secret, *Response, error) {
+	v := []*library.Secret{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/secrets/%s/%s/%s/%s", engine, sType, org, name), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a secret with the provided details.
+func (s *SecretService) Add(engine, sType, org, name string, secret *library.Secret) (*library.Secret, *Response, error) {
+	v := new(library.Secret)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/secrets/%s/%s/%s/%s", engine, sType, org, name), secret, v)
+
+	return v, resp, err
+}
+
+// Update modifies a secret with the provided details.
+func (s *SecretService) Update(engine, sType, org, name string, secret *library.Secret) (*library.Secret, *Response, error) {
+	v := new(library.Secret)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/secrets/%s/%s/%s/%s/%s", engine, sType, org, name, secret.GetName()), secret, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided secret.
+func (s *SecretService) Remove(engine, sType, org, name, secret string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/secrets/%s/%s/%s/%s/%s", engine, sType, org, name, secret), nil, v)
+
+	return v, resp, err
+}
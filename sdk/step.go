@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// StepService handles retrieving steps for builds from the server methods of the Vela API.
+type StepService service
+
+// Get returns the provided step.
+func (s *StepService) Get(org, repo string, build, step int) (*library.Step, *Response, error) {
+	v := new(library.Step)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d", org, repo, build, step), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all steps for the provided build.
+func (s *StepService) GetAll(org, repo string, build int, opts *ListOptions) ([]*library.Step, *Response, error) {
+	v := []*library.Step{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps", org, repo, build), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a step with the provided details.
+func (s *StepService) Add(org, repo string, build int, in *library.Step) (*library.Step, *Response, error) {
+	v := new(library.Step)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps", org, repo, build), in, v)
+
+	return v, resp, err
+}
+
+// Update modifies a step with the provided details.
+func (s *StepService) Update(org, repo string, build int, in *library.Step) (*library.Step, *Response, error) {
+	v := new(library.Step)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d", org, repo, build, in.GetNumber()), in, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided step.
+func (s *StepService) Remove(org, repo string, build, step int) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d", org, repo, build, step), nil, v)
+
+	return v, resp, err
+}
+
+// GetAttempts returns the attempts recorded for the provided step.
+func (s *StepService) GetAttempts(org, repo string, build, step int) ([]*api.StepAttempt, *Response, error) {
+	v := []*api.StepAttempt{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/attempts", org, repo, build, step), nil, &v)
+
+	return v, resp, err
+}
+
+// AddAttempt records an attempt for the provided step.
+func (s *StepService) AddAttempt(org, repo string, build, step int, in *api.StepAttempt) (*api.StepAttempt, *Response, error) {
+	v := new(api.StepAttempt)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/steps/%d/attempts", org, repo, build, step), in, v)
+
+	return v, resp, err
+}
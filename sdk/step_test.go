@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_StepService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	s := new(library.Step)
+	s.SetNumber(1)
+
+	a := new(api.StepAttempt)
+	a.SetAttempt(2)
+	a.SetStatus("success")
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.Step.Get("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.Step.GetAll("github", "octocat", 1, nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.Step.Add("github", "octocat", 1, s)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "Update",
+			call: func() (*Response, error) {
+				_, resp, err := c.Step.Update("github", "octocat", 1, s)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAttempts",
+			call: func() (*Response, error) {
+				_, resp, err := c.Step.GetAttempts("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "AddAttempt",
+			call: func() (*Response, error) {
+				_, resp, err := c.Step.AddAttempt("github", "octocat", 1, 1, a)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "Remove",
+			call: func() (*Response, error) {
+				_, resp, err := c.Step.Remove("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
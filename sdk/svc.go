@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// SvcService handles retrieving services for builds from the server methods of the Vela API.
+type SvcService service
+
+// Get returns the provided service.
+func (s *SvcService) Get(org, repo string, build, service int) (*library.Service, *Response, error) {
+	v := new(library.Service)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d", org, repo, build, service), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all services for the provided build.
+func (s *SvcService) GetAll(org, repo string, build int, opts *ListOptions) ([]*library.Service, *Response, error) {
+	v := []*library.Service{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services", org, repo, build), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a service with the provided details.
+func (s *SvcService) Add(org, repo string, build int, in *library.Service) (*library.Service, *Response, error) {
+	v := new(library.Service)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services", org, repo, build), in, v)
+
+	return v, resp, err
+}
+
+// Update modifies a service with the provided details.
+func (s *SvcService) Update(org, repo string, build int, in *library.Service) (*library.Service, *Response, error) {
+	v := new(library.Service)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d", org, repo, build, in.GetNumber()), in, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided service.
+func (s *SvcService) Remove(org, repo string, build, service int) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d", org, repo, build, service), nil, v)
+
+	return v, resp, err
+}
+
+// GetReadiness returns the readiness reported for the provided service.
+func (s *SvcService) GetReadiness(org, repo string, build, service int) (*api.ServiceReadiness, *Response, error) {
+	v := new(api.ServiceReadiness)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/readiness", org, repo, build, service), nil, v)
+
+	return v, resp, err
+}
+
+// UpdateReadiness records the readiness for the provided service.
+func (s *SvcService) UpdateReadiness(org, repo string, build, service int, in *api.ServiceReadiness) (*api.ServiceReadiness, *Response, error) {
+	v := new(api.ServiceReadiness)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/repos/%s/%s/builds/%d/services/%d/readiness", org, repo, build, service), in, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_SvcService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	s := new(library.Service)
+	s.SetNumber(1)
+
+	sr := new(api.ServiceReadiness)
+	sr.SetStatus("ready")
+	sr.SetAttempts(3)
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.Svc.Get("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.Svc.GetAll("github", "octocat", 1, nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.Svc.Add("github", "octocat", 1, s)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "Update",
+			call: func() (*Response, error) {
+				_, resp, err := c.Svc.Update("github", "octocat", 1, s)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetReadiness",
+			call: func() (*Response, error) {
+				_, resp, err := c.Svc.GetReadiness("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateReadiness",
+			call: func() (*Response, error) {
+				_, resp, err := c.Svc.UpdateReadiness("github", "octocat", 1, 1, sr)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Remove",
+			call: func() (*Response, error) {
+				_, resp, err := c.Svc.Remove("github", "octocat", 1, 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// TemplateService handles managing templates hosted
+// in a repo from the server methods of the Vela API.
+type TemplateService service
+
+// ListDeprecations returns the deprecated versions of the templates hosted in the provided repo.
+func (s *TemplateService) ListDeprecations(org, repo string) ([]*api.TemplateDeprecation, *Response, error) {
+	v := []*api.TemplateDeprecation{}
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/templates/%s/%s/deprecations", org, repo), nil, &v)
+
+	return v, resp, err
+}
+
+// AddDeprecation marks a version of a template hosted in the provided repo as deprecated.
+func (s *TemplateService) AddDeprecation(org, repo string, d *api.TemplateDeprecation) (*api.TemplateDeprecation, *Response, error) {
+	v := new(api.TemplateDeprecation)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/templates/%s/%s/deprecations", org, repo), d, v)
+
+	return v, resp, err
+}
+
+// RemoveDeprecation removes the provided deprecation for a template hosted in the provided repo.
+func (s *TemplateService) RemoveDeprecation(org, repo string, id int64) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/templates/%s/%s/deprecations/%d", org, repo, id), nil, v)
+
+	return v, resp, err
+}
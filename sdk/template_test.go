@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSDK_TemplateService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "ListDeprecations",
+			call: func() (*Response, error) {
+				_, resp, err := c.Template.ListDeprecations("github", "octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "AddDeprecation",
+			call: func() (*Response, error) {
+				d := new(api.TemplateDeprecation)
+				d.SetPath("template.yml")
+				d.SetRef("v1.0.0")
+
+				_, resp, err := c.Template.AddDeprecation("github", "octocat", d)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "RemoveDeprecation",
+			call: func() (*Response, error) {
+				_, resp, err := c.Template.RemoveDeprecation("github", "octocat", 1)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
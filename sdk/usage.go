@@ -0,0 +1,129 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+type (
+	// UsageService handles retrieving usage reports from the server methods of the Vela API.
+	UsageService service
+
+	// UsageOptions represents the options for retrieving usage reports.
+	UsageOptions struct {
+		// include builds created before the unix timestamp
+		Before int64
+		// include builds created after the unix timestamp
+		After int64
+	}
+)
+
+// values returns the query parameters for the usage options.
+func (o *UsageOptions) values() url.Values {
+	v := url.Values{}
+
+	if o == nil {
+		return v
+	}
+
+	if o.Before > 0 {
+		v.Set("before", strconv.FormatInt(o.Before, 10))
+	}
+
+	if o.After > 0 {
+		v.Set("after", strconv.FormatInt(o.After, 10))
+	}
+
+	return v
+}
+
+// GetActors returns the usage for each actor within the provided org.
+func (s *UsageService) GetActors(org string, opts *UsageOptions) ([]*api.ActorUsage, *Response, error) {
+	v := []*api.ActorUsage{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/usage/orgs/%s/actors", org), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetTeam returns the usage for the provided team within the org.
+func (s *UsageService) GetTeam(org, team string, opts *UsageOptions) (*api.TeamUsage, *Response, error) {
+	v := new(api.TeamUsage)
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/usage/orgs/%s/teams/%s", org, team), opts), nil, v)
+
+	return v, resp, err
+}
+
+// GetBudget returns the build minutes used and remaining
+// in the monthly budget for the provided org.
+func (s *UsageService) GetBudget(org string) (*api.BudgetUsage, *Response, error) {
+	v := new(api.BudgetUsage)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/usage/orgs/%s/budget", org), nil, v)
+
+	return v, resp, err
+}
+
+// UpdateBudget sets the monthly budget of build minutes for the provided org.
+func (s *UsageService) UpdateBudget(org string, b *api.BuildBudget) (*api.BuildBudget, *Response, error) {
+	v := new(api.BuildBudget)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/usage/orgs/%s/budget", org), b, v)
+
+	return v, resp, err
+}
+
+// RemoveBudget deletes the monthly budget of build minutes for the provided org.
+func (s *UsageService) RemoveBudget(org string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/usage/orgs/%s/budget", org), nil, v)
+
+	return v, resp, err
+}
+
+// GetCosts returns the estimated cost of the builds within the
+// provided org for each class of worker they ran on.
+func (s *UsageService) GetCosts(org string, opts *UsageOptions) ([]*api.ClassCost, *Response, error) {
+	v := []*api.ClassCost{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/usage/orgs/%s/costs", org), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetRates returns the cost per build minute for each class of workers.
+func (s *UsageService) GetRates() ([]*api.CostRate, *Response, error) {
+	v := []*api.CostRate{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/usage/rates", nil, &v)
+
+	return v, resp, err
+}
+
+// UpdateRate sets the cost per build minute for the provided class of workers.
+func (s *UsageService) UpdateRate(class string, r *api.CostRate) (*api.CostRate, *Response, error) {
+	v := new(api.CostRate)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/usage/rates/%s", class), r, v)
+
+	return v, resp, err
+}
+
+// RemoveRate deletes the cost per build minute for the provided class of workers.
+func (s *UsageService) RemoveRate(class string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/usage/rates/%s", class), nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+func TestSDK_UsageService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "GetActors",
+			call: func() (*Response, error) {
+				_, resp, err := c.Usage.GetActors("github", &UsageOptions{After: 1})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetBudget",
+			call: func() (*Response, error) {
+				_, resp, err := c.Usage.GetBudget("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateBudget",
+			call: func() (*Response, error) {
+				b := new(api.BuildBudget)
+				b.SetMinutes(1000)
+
+				_, resp, err := c.Usage.UpdateBudget("github", b)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RemoveBudget",
+			call: func() (*Response, error) {
+				_, resp, err := c.Usage.RemoveBudget("github")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetCosts",
+			call: func() (*Response, error) {
+				_, resp, err := c.Usage.GetCosts("github", &UsageOptions{After: 1})
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetRates",
+			call: func() (*Response, error) {
+				_, resp, err := c.Usage.GetRates()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "UpdateRate",
+			call: func() (*Response, error) {
+				r := new(api.CostRate)
+				r.SetRate(0.016)
+
+				_, resp, err := c.Usage.UpdateRate("large:linux", r)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "RemoveRate",
+			call: func() (*Response, error) {
+				_, resp, err := c.Usage.RemoveRate("large:linux")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetTeam",
+			call: func() (*Response, error) {
+				_, resp, err := c.Usage.GetTeam("github", "octokitties", nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/types/library"
+)
+
+// UserService handles retrieving users from the server methods of the Vela API.
+type UserService service
+
+// Get returns the provided user.
+func (s *UserService) Get(user string) (*library.User, *Response, error) {
+	v := new(library.User)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/users/%s", user), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all users.
+func (s *UserService) GetAll(opts *ListOptions) ([]*library.User, *Response, error) {
+	v := []*library.User{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions("/api/v1/users", opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetCurrent returns the current user.
+func (s *UserService) GetCurrent() (*library.User, *Response, error) {
+	v := new(library.User)
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/user", nil, v)
+
+	return v, resp, err
+}
+
+// GetAnnouncements returns the active announcements targeting the current user.
+func (s *UserService) GetAnnouncements() ([]*api.Announcement, *Response, error) {
+	v := []*api.Announcement{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/user/announcements", nil, &v)
+
+	return v, resp, err
+}
+
+// GetScopes returns the OAuth scopes granted to the current user.
+func (s *UserService) GetScopes() (*api.UserScope, *Response, error) {
+	v := new(api.UserScope)
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/user/scopes", nil, v)
+
+	return v, resp, err
+}
+
+// GetSessions returns the sessions for the current user.
+func (s *UserService) GetSessions(opts *ListOptions) ([]*api.UserSession, *Response, error) {
+	v := []*api.UserSession{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions("/api/v1/user/sessions", opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetSessionsFor returns the sessions for the provided user.
+func (s *UserService) GetSessionsFor(user string, opts *ListOptions) ([]*api.UserSession, *Response, error) {
+	v := []*api.UserSession{}
+
+	resp, err := s.client.call(http.MethodGet, withOptions(fmt.Sprintf("/api/v1/users/%s/sessions", user), opts), nil, &v)
+
+	return v, resp, err
+}
+
+// GetSourceRepos returns the repos from the source provider
+// for the current user grouped by org.
+func (s *UserService) GetSourceRepos() (map[string][]library.Repo, *Response, error) {
+	v := make(map[string][]library.Repo)
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/user/source/repos", nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a user with the provided details.
+func (s *UserService) Add(u *library.User) (*library.User, *Response, error) {
+	v := new(library.User)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/users", u, v)
+
+	return v, resp, err
+}
+
+// Update modifies a user with the provided details.
+func (s *UserService) Update(user string, u *library.User) (*library.User, *Response, error) {
+	v := new(library.User)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/users/%s", user), u, v)
+
+	return v, resp, err
+}
+
+// UpdateCurrent modifies the current user with the provided details.
+func (s *UserService) UpdateCurrent(u *library.User) (*library.User, *Response, error) {
+	v := new(library.User)
+
+	resp, err := s.client.call(http.MethodPut, "/api/v1/user", u, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided user.
+func (s *UserService) Remove(user string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/users/%s", user), nil, v)
+
+	return v, resp, err
+}
+
+// CreateToken creates an access token for the current user.
+func (s *UserService) CreateToken() (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/user/token", nil, v)
+
+	return v, resp, err
+}
+
+// RemoveToken revokes the access tokens for the current user.
+func (s *UserService) RemoveToken() (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodDelete, "/api/v1/user/token", nil, v)
+
+	return v, resp, err
+}
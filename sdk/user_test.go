@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/go-vela/types/library"
+)
+
+func TestSDK_UserService(t *testing.T) {
+	// setup types
+	c := newTestClient(t)
+
+	u := new(library.User)
+	u.SetName("octocat")
+
+	// setup tests
+	tests := []struct {
+		name string
+		call func() (*Response, error)
+		want int
+	}{
+		{
+			name: "Get",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.Get("octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAll",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.GetAll(nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetAnnouncements",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.GetAnnouncements()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetScopes",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.GetScopes()
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetSessions",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.GetSessions(nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "GetSessionsFor",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.GetSessionsFor("octocat", nil)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Add",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.Add(u)
+
+				return resp, err
+			},
+			want: http.StatusCreated,
+		},
+		{
+			name: "Update",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.Update("octocat", u)
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+		{
+			name: "Remove",
+			call: func() (*Response, error) {
+				_, resp, err := c.User.Remove("octocat")
+
+				return resp, err
+			},
+			want: http.StatusOK,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := test.call()
+			if err != nil {
+				t.Fatalf("%s returned err: %v", test.name, err)
+			}
+
+			if resp.StatusCode != test.want {
+				t.Errorf("%s returned %v, want %v", test.name, resp.StatusCode, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-vela/types/library"
+)
+
+// WorkerService handles retrieving workers from the server methods of the Vela API.
+type WorkerService service
+
+// Get returns the provided worker.
+func (s *WorkerService) Get(hostname string) (*library.Worker, *Response, error) {
+	v := new(library.Worker)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/workers/%s", hostname), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all workers.
+func (s *WorkerService) GetAll() ([]*library.Worker, *Response, error) {
+	v := []*library.Worker{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/workers", nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a worker with the provided details
+// and returns the auth token for the worker.
+func (s *WorkerService) Add(w *library.Worker) (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/workers", w, v)
+
+	return v, resp, err
+}
+
+// Update modifies a worker with the provided details.
+func (s *WorkerService) Update(hostname string, w *library.Worker) (*library.Worker, *Response, error) {
+	v := new(library.Worker)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/workers/%s", hostname), w, v)
+
+	return v, resp, err
+}
+
+// RefreshAuth exchanges the auth token for the provided worker for a new one.
+func (s *WorkerService) RefreshAuth(hostname string) (*library.Token, *Response, error) {
+	v := new(library.Token)
+
+	resp, err := s.client.call(http.MethodPost, fmt.Sprintf("/api/v1/workers/%s/refresh", hostname), nil, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided worker.
+func (s *WorkerService) Remove(hostname string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/workers/%s", hostname), nil, v)
+
+	return v, resp, err
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package sdk
+
+import (
+	"fmt"
+	"net/http"
+
+	api "github.com/go-vela/server/api/types"
+)
+
+// WorkerGroupService handles managing the pools of
+// workers from the server methods of the Vela API.
+type WorkerGroupService service
+
+// Get returns the provided worker group.
+func (s *WorkerGroupService) Get(name string) (*api.WorkerGroup, *Response, error) {
+	v := new(api.WorkerGroup)
+
+	resp, err := s.client.call(http.MethodGet, fmt.Sprintf("/api/v1/workergroups/%s", name), nil, v)
+
+	return v, resp, err
+}
+
+// GetAll returns a list of all worker groups.
+func (s *WorkerGroupService) GetAll() ([]*api.WorkerGroup, *Response, error) {
+	v := []*api.WorkerGroup{}
+
+	resp, err := s.client.call(http.MethodGet, "/api/v1/workergroups", nil, &v)
+
+	return v, resp, err
+}
+
+// Add constructs a worker group with the provided details.
+func (s *WorkerGroupService) Add(g *api.WorkerGroup) (*api.WorkerGroup, *Response, error) {
+	v := new(api.WorkerGroup)
+
+	resp, err := s.client.call(http.MethodPost, "/api/v1/workergroups", g, v)
+
+	return v, resp, err
+}
+
+// Update modifies a worker group with the provided details.
+func (s *WorkerGroupService) Update(name string, g *api.WorkerGroup) (*api.WorkerGroup, *Response, error) {
+	v := new(api.WorkerGroup)
+
+	resp, err := s.client.call(http.MethodPut, fmt.Sprintf("/api/v1/workergroups/%s", name), g, v)
+
+	return v, resp, err
+}
+
+// Remove deletes the provided worker group.
+func (s *WorkerGroupService) Remove(name string) (*string, *Response, error) {
+	v := new(string)
+
+	resp, err := s.client.call(http.MethodDelete, fmt.Sprintf("/api/v1/workergroups/%s", name), nil, v)
+
+	return v, resp, err
+}
@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"strings"
 
 	"github.com/go-vela/types/constants"
@@ -12,7 +13,7 @@ import (
 )
 
 // Count counts a list of secrets.
-func (c *client) Count(sType, org, name string, teams []string) (int64, error) {
+func (c *client) Count(ctx context.Context, sType, org, name string, teams []string) (int64, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":  org,
@@ -33,7 +34,7 @@ func (c *client) Count(sType, org, name string, teams []string) (int64, error) {
 	c.Logger.WithFields(fields).Tracef("counting native %s secrets for %s/%s", sType, org, name)
 
 	// capture the count of secrets from the native service
-	s, err := c.Database.GetTypeSecretCount(sType, org, name, teams)
+	s, err := c.Database.GetTypeSecretCount(ctx, sType, org, name, teams)
 	if err != nil {
 		return 0, err
 	}
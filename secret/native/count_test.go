@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-vela/server/database/sqlite"
@@ -36,7 +37,7 @@ func TestNative_Count(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateSecret(sec)
+	_ = db.CreateSecret(context.TODO(), sec)
 
 	// run test
 	s, err := New(
@@ -46,7 +47,7 @@ func TestNative_Count(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	got, err := s.Count("repo", "foo", "bar", []string{})
+	got, err := s.Count(context.TODO(), "repo", "foo", "bar", []string{})
 	if err != nil {
 		t.Errorf("Count returned err: %v", err)
 	}
@@ -70,7 +71,7 @@ func TestNative_Count_Invalid(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	got, err := s.Count("repo", "foo", "bar", []string{})
+	got, err := s.Count(context.TODO(), "repo", "foo", "bar", []string{})
 	if err == nil {
 		t.Errorf("Count should have returned err")
 	}
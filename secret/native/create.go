@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,7 +16,7 @@ import (
 )
 
 // Create creates a new secret.
-func (c *client) Create(sType, org, name string, s *library.Secret) error {
+func (c *client) Create(ctx context.Context, sType, org, name string, s *library.Secret) error {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -44,7 +45,19 @@ func (c *client) Create(sType, org, name string, s *library.Secret) error {
 	case constants.SecretRepo:
 		fallthrough
 	case constants.SecretShared:
-		return c.Database.CreateSecret(s)
+		// capture the secret archived with the same name from the native service
+		archived, err := c.Database.GetArchivedSecret(ctx, sType, org, name, s.GetName())
+		if err == nil {
+			c.Logger.WithFields(fields).Debugf("purging archived native %s secret %s replaced for %s/%s", sType, s.GetName(), org, name)
+
+			// remove the archived secret so the name can be reused
+			err = c.Database.DeleteSecret(ctx, archived.GetID())
+			if err != nil {
+				return err
+			}
+		}
+
+		return c.Database.CreateSecret(ctx, s)
 	default:
 		return fmt.Errorf("invalid secret type: %v", sType)
 	}
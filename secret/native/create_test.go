@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -47,12 +48,12 @@ func TestNative_Create_Org(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Create("org", "foo", "*", want)
+	err = s.Create(context.TODO(), "org", "foo", "*", want)
 	if err != nil {
 		t.Errorf("Create returned err: %v", err)
 	}
 
-	got, _ := s.Get("org", "foo", "*", "bar")
+	got, _ := s.Get(context.TODO(), "org", "foo", "*", "bar")
 
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Create is %v, want %v", got, want)
@@ -94,12 +95,12 @@ func TestNative_Create_Repo(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Create("repo", "foo", "bar", want)
+	err = s.Create(context.TODO(), "repo", "foo", "bar", want)
 	if err != nil {
 		t.Errorf("Create returned err: %v", err)
 	}
 
-	got, _ := s.Get("repo", "foo", "bar", "baz")
+	got, _ := s.Get(context.TODO(), "repo", "foo", "bar", "baz")
 
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Create is %v, want %v", got, want)
@@ -141,12 +142,12 @@ func TestNative_Create_Shared(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Create("shared", "foo", "bar", want)
+	err = s.Create(context.TODO(), "shared", "foo", "bar", want)
 	if err != nil {
 		t.Errorf("Create returned err: %v", err)
 	}
 
-	got, _ := s.Get("shared", "foo", "bar", "baz")
+	got, _ := s.Get(context.TODO(), "shared", "foo", "bar", "baz")
 
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Create is %v, want %v", got, want)
@@ -188,7 +189,7 @@ func TestNative_Create_Invalid(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Create("invalid", "foo", "bar", sec)
+	err = s.Create(context.TODO(), "invalid", "foo", "bar", sec)
 	if err == nil {
 		t.Errorf("Create should have returned err")
 	}
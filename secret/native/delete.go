@@ -5,14 +5,16 @@
 package native
 
 import (
+	"context"
 	"strings"
 
 	"github.com/go-vela/types/constants"
 	"github.com/sirupsen/logrus"
 )
 
-// Delete deletes a secret.
-func (c *client) Delete(sType, org, name, path string) error {
+// Delete deletes a secret by archiving it, so it can be restored
+// by an admin until the archived secrets are purged.
+func (c *client) Delete(ctx context.Context, sType, org, name, path string) error {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -35,11 +37,11 @@ func (c *client) Delete(sType, org, name, path string) error {
 	c.Logger.WithFields(fields).Tracef("deleting native %s secret %s for %s/%s", sType, path, org, name)
 
 	// capture the secret from the native service
-	s, err := c.Database.GetSecret(sType, org, name, path)
+	s, err := c.Database.GetSecret(ctx, sType, org, name, path)
 	if err != nil {
 		return err
 	}
 
-	// delete the secret from the native service
-	return c.Database.DeleteSecret(s.GetID())
+	// archive the secret in the native service
+	return c.Database.ArchiveSecret(ctx, s.GetID())
 }
@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-vela/server/database/sqlite"
@@ -36,7 +37,7 @@ func TestNative_Delete(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateSecret(sec)
+	_ = db.CreateSecret(context.TODO(), sec)
 
 	// run test
 	s, err := New(
@@ -46,7 +47,7 @@ func TestNative_Delete(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Delete("repo", "foo", "bar", "baz")
+	err = s.Delete(context.TODO(), "repo", "foo", "bar", "baz")
 	if err != nil {
 		t.Errorf("Delete returned err: %v", err)
 	}
@@ -66,8 +67,79 @@ func TestNative_Delete_Invalid(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Delete("repo", "foo", "bar", "foob")
+	err = s.Delete(context.TODO(), "repo", "foo", "bar", "foob")
 	if err == nil {
 		t.Errorf("Delete should have returned err")
 	}
 }
+
+func TestNative_Delete_Recreate(t *testing.T) {
+	// setup types
+	sec := new(library.Secret)
+	sec.SetOrg("foo")
+	sec.SetRepo("bar")
+	sec.SetTeam("")
+	sec.SetName("baz")
+	sec.SetValue("foob")
+	sec.SetType("repo")
+	sec.SetImages([]string{"foo", "bar"})
+	sec.SetEvents([]string{"foo", "bar"})
+	sec.SetAllowCommand(false)
+	sec.SetCreatedAt(1)
+	sec.SetUpdatedAt(1)
+
+	// setup database
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from secrets;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	// run test
+	s, err := New(
+		WithDatabase(db),
+	)
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	err = s.Create(context.TODO(), "repo", "foo", "bar", sec)
+	if err != nil {
+		t.Errorf("Create returned err: %v", err)
+	}
+
+	err = s.Delete(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("Delete returned err: %v", err)
+	}
+
+	// the deleted secret is archived instead of removed
+	_, err = db.GetArchivedSecret(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("GetArchivedSecret returned err: %v", err)
+	}
+
+	// creating the secret again replaces the archived secret
+	sec.SetValue("bar")
+
+	err = s.Create(context.TODO(), "repo", "foo", "bar", sec)
+	if err != nil {
+		t.Errorf("Create for archived secret returned err: %v", err)
+	}
+
+	got, err := s.Get(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("Get returned err: %v", err)
+	}
+
+	if got.GetValue() != "bar" {
+		t.Errorf("Get value is %s, want bar", got.GetValue())
+	}
+
+	_, err = db.GetArchivedSecret(context.TODO(), "repo", "foo", "bar", "baz")
+	if err == nil {
+		t.Errorf("GetArchivedSecret for replaced secret should have returned err")
+	}
+}
@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"strings"
 
 	"github.com/go-vela/types/constants"
@@ -13,7 +14,7 @@ import (
 )
 
 // Get captures a secret.
-func (c *client) Get(sType, org, name, path string) (*library.Secret, error) {
+func (c *client) Get(ctx context.Context, sType, org, name, path string) (*library.Secret, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -36,7 +37,7 @@ func (c *client) Get(sType, org, name, path string) (*library.Secret, error) {
 	c.Logger.WithFields(fields).Tracef("getting native %s secret %s for %s/%s", sType, path, org, name)
 
 	// capture the secret from the native service
-	s, err := c.Database.GetSecret(sType, org, name, path)
+	s, err := c.Database.GetSecret(ctx, sType, org, name, path)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -47,9 +48,9 @@ func TestNative_Get(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	_ = s.Create("repo", "foo", "bar", want)
+	_ = s.Create(context.TODO(), "repo", "foo", "bar", want)
 
-	got, err := s.Get("repo", "foo", "bar", "baz")
+	got, err := s.Get(context.TODO(), "repo", "foo", "bar", "baz")
 	if err != nil {
 		t.Errorf("Get returned err: %v", err)
 	}
@@ -73,7 +74,7 @@ func TestNative_Get_Invalid(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	got, err := s.Get("repo", "foo", "bar", "foob")
+	got, err := s.Get(context.TODO(), "repo", "foo", "bar", "foob")
 	if err == nil {
 		t.Errorf("Get should have returned err")
 	}
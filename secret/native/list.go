@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"strings"
 
 	"github.com/go-vela/types/constants"
@@ -13,7 +14,7 @@ import (
 )
 
 // List captures a list of secrets.
-func (c *client) List(sType, org, name string, page, perPage int, teams []string) ([]*library.Secret, error) {
+func (c *client) List(ctx context.Context, sType, org, name string, page, perPage int, teams []string) ([]*library.Secret, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":  org,
@@ -34,7 +35,7 @@ func (c *client) List(sType, org, name string, page, perPage int, teams []string
 	c.Logger.WithFields(fields).Tracef("listing native %s secrets for %s/%s", sType, org, name)
 
 	// capture the list of secrets from the native service
-	s, err := c.Database.GetTypeSecretList(sType, org, name, page, perPage, teams)
+	s, err := c.Database.GetTypeSecretList(ctx, sType, org, name, page, perPage, teams)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -65,11 +66,11 @@ func TestNative_List(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	_ = s.Create("repo", "foo", "bar", sOne)
+	_ = s.Create(context.TODO(), "repo", "foo", "bar", sOne)
 
-	_ = s.Create("repo", "foo", "bar", sTwo)
+	_ = s.Create(context.TODO(), "repo", "foo", "bar", sTwo)
 
-	got, err := s.List("repo", "foo", "bar", 1, 10, []string{})
+	got, err := s.List(context.TODO(), "repo", "foo", "bar", 1, 10, []string{})
 	if err != nil {
 		t.Errorf("List returned err: %v", err)
 	}
@@ -93,7 +94,7 @@ func TestNative_List_Invalid(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	got, err := s.List("repo", "foo", "bar", 1, 10, []string{})
+	got, err := s.List(context.TODO(), "repo", "foo", "bar", 1, 10, []string{})
 	if err == nil {
 		t.Errorf("List should have returned err")
 	}
@@ -5,6 +5,7 @@
 package native
 
 import (
+	"context"
 	"strings"
 
 	"github.com/go-vela/types/constants"
@@ -13,7 +14,61 @@ import (
 )
 
 // Update updates an existing secret.
-func (c *client) Update(sType, org, name string, s *library.Secret) error {
+func (c *client) Update(ctx context.Context, sType, org, name string, s *library.Secret) error {
+	sec, err := c.merge(ctx, sType, org, name, s)
+	if err != nil {
+		return err
+	}
+
+	return c.Database.UpdateSecret(ctx, sec)
+}
+
+// UpdateIfVersion updates an existing secret when its version
+// matches the provided version and returns the new version.
+func (c *client) UpdateIfVersion(ctx context.Context, sType, org, name string, s *library.Secret, version int64) (int64, error) {
+	sec, err := c.merge(ctx, sType, org, name, s)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.Database.UpdateSecretIfVersion(ctx, sec, version)
+}
+
+// Version captures the version of an existing secret.
+func (c *client) Version(ctx context.Context, sType, org, name, path string) (int64, error) {
+	// create log fields from secret metadata
+	fields := logrus.Fields{
+		"org":    org,
+		"repo":   name,
+		"secret": path,
+		"type":   sType,
+	}
+
+	// check if secret is a shared secret
+	if strings.EqualFold(sType, constants.SecretShared) {
+		// update log fields from secret metadata
+		fields = logrus.Fields{
+			"org":    org,
+			"team":   name,
+			"secret": path,
+			"type":   sType,
+		}
+	}
+
+	c.Logger.WithFields(fields).Tracef("getting version of native %s secret %s for %s/%s", sType, path, org, name)
+
+	// capture the secret from the native service
+	s, err := c.Database.GetSecret(ctx, sType, org, name, path)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.Database.GetSecretVersion(ctx, s.GetID())
+}
+
+// merge is a helper function to capture the existing secret
+// with the fields set on the provided secret applied to it.
+func (c *client) merge(ctx context.Context, sType, org, name string, s *library.Secret) (*library.Secret, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -36,9 +91,9 @@ func (c *client) Update(sType, org, name string, s *library.Secret) error {
 	c.Logger.WithFields(fields).Tracef("updating native %s secret %s for %s/%s", sType, s.GetName(), org, name)
 
 	// capture the secret from the native service
-	sec, err := c.Database.GetSecret(sType, org, name, s.GetName())
+	sec, err := c.Database.GetSecret(ctx, sType, org, name, s.GetName())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// update the events if set
@@ -67,5 +122,5 @@ func (c *client) Update(sType, org, name string, s *library.Secret) error {
 	// update updated_by if set
 	sec.SetUpdatedBy(s.GetUpdatedBy())
 
-	return c.Database.UpdateSecret(sec)
+	return sec, nil
 }
@@ -5,11 +5,14 @@
 package native
 
 import (
+	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/database/types"
 	"github.com/go-vela/types/library"
 )
 
@@ -56,7 +59,7 @@ func TestNative_Update(t *testing.T) {
 		_sql.Close()
 	}()
 
-	_ = db.CreateSecret(original)
+	_ = db.CreateSecret(context.TODO(), original)
 
 	// run test
 	s, err := New(
@@ -66,12 +69,12 @@ func TestNative_Update(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Update("repo", "foo", "bar", want)
+	err = s.Update(context.TODO(), "repo", "foo", "bar", want)
 	if err != nil {
 		t.Errorf("Update returned err: %v", err)
 	}
 
-	got, _ := s.Get("repo", "foo", "bar", "baz")
+	got, _ := s.Get(context.TODO(), "repo", "foo", "bar", "baz")
 
 	if !reflect.DeepEqual(got, want) {
 		t.Errorf("Update is %v, want %v", got, want)
@@ -97,8 +100,71 @@ func TestNative_Update_Invalid(t *testing.T) {
 		t.Errorf("New returned err: %v", err)
 	}
 
-	err = s.Update("repo", "foo", "bar", sec)
+	err = s.Update(context.TODO(), "repo", "foo", "bar", sec)
 	if err == nil {
 		t.Errorf("Update should have returned err")
 	}
 }
+
+func TestNative_UpdateIfVersion(t *testing.T) {
+	// setup types
+	original := new(library.Secret)
+	original.SetID(1)
+	original.SetOrg("foo")
+	original.SetRepo("bar")
+	original.SetTeam("")
+	original.SetName("baz")
+	original.SetValue("secretValue")
+	original.SetType("repo")
+	original.SetImages([]string{"foo", "baz"})
+	original.SetEvents([]string{"foob", "bar"})
+	original.SetAllowCommand(true)
+	original.SetCreatedAt(1)
+	original.SetCreatedBy("user")
+	original.SetUpdatedAt(time.Now().UTC().Unix())
+	original.SetUpdatedBy("user")
+
+	input := new(library.Secret)
+	input.SetName("baz")
+	input.SetValue("foob")
+	input.SetUpdatedAt(time.Now().UTC().Unix())
+	input.SetUpdatedBy("user2")
+
+	// setup database
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from secrets;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	_ = db.CreateSecret(context.TODO(), original)
+
+	// run test
+	s, err := New(
+		WithDatabase(db),
+	)
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	version, err := s.Version(context.TODO(), "repo", "foo", "bar", "baz")
+	if err != nil {
+		t.Errorf("Version returned err: %v", err)
+	}
+
+	got, err := s.UpdateIfVersion(context.TODO(), "repo", "foo", "bar", input, version)
+	if err != nil {
+		t.Errorf("UpdateIfVersion returned err: %v", err)
+	}
+
+	if got != version+1 {
+		t.Errorf("UpdateIfVersion is %d, want %d", got, version+1)
+	}
+
+	_, err = s.UpdateIfVersion(context.TODO(), "repo", "foo", "bar", input, version)
+	if !errors.Is(err, types.ErrVersionConflict) {
+		t.Errorf("UpdateIfVersion for stale version returned err %v, want %v", err, types.ErrVersionConflict)
+	}
+}
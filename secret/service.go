@@ -4,7 +4,11 @@
 
 package secret
 
-import "github.com/go-vela/types/library"
+import (
+	"context"
+
+	"github.com/go-vela/types/library"
+)
 
 // Service represents the interface for Vela integrating
 // with the different supported secret providers.
@@ -16,17 +20,29 @@ type Service interface {
 	Driver() string
 
 	// Get defines a function that captures a secret.
-	Get(string, string, string, string) (*library.Secret, error)
+	Get(context.Context, string, string, string, string) (*library.Secret, error)
 	// List defines a function that captures a list of secrets.
-	List(string, string, string, int, int, []string) ([]*library.Secret, error)
+	List(context.Context, string, string, string, int, int, []string) ([]*library.Secret, error)
 	// Count defines a function that counts a list of secrets.
-	Count(string, string, string, []string) (int64, error)
+	Count(context.Context, string, string, string, []string) (int64, error)
 	// Create defines a function that creates a new secret.
-	Create(string, string, string, *library.Secret) error
+	Create(context.Context, string, string, string, *library.Secret) error
 	// Update defines a function that updates an existing secret.
-	Update(string, string, string, *library.Secret) error
+	Update(context.Context, string, string, string, *library.Secret) error
 	// Delete defines a function that deletes a secret.
-	Delete(string, string, string, string) error
+	Delete(context.Context, string, string, string, string) error
 
 	// TODO: Add convert functions to interface?
 }
+
+// Versioned represents the interface for the secret providers
+// that track the version of each secret to support conditional
+// updates. Not every provider supports it, so the services are
+// checked for the interface before it is used.
+type Versioned interface {
+	// Version defines a function that captures the version of a secret.
+	Version(context.Context, string, string, string, string) (int64, error)
+	// UpdateIfVersion defines a function that updates an existing secret
+	// when its version matches and returns the new version of the secret.
+	UpdateIfVersion(context.Context, string, string, string, *library.Secret, int64) (int64, error)
+}
@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,7 +16,7 @@ import (
 )
 
 // Count counts a list of secrets.
-func (c *client) Count(sType, org, name string, _ []string) (i int64, err error) {
+func (c *client) Count(ctx context.Context, sType, org, name string, _ []string) (i int64, err error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":  org,
@@ -42,11 +43,11 @@ func (c *client) Count(sType, org, name string, _ []string) (i int64, err error)
 	// capture the list of secrets from the Vault service
 	switch sType {
 	case constants.SecretOrg:
-		vault, err = c.listOrg(org)
+		vault, err = c.listOrg(ctx, org)
 	case constants.SecretRepo:
-		vault, err = c.listRepo(org, name)
+		vault, err = c.listRepo(ctx, org, name)
 	case constants.SecretShared:
-		vault, err = c.listShared(org, name)
+		vault, err = c.listShared(ctx, org, name)
 	default:
 		return 0, fmt.Errorf("invalid secret type: %v", sType)
 	}
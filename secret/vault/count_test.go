@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -88,7 +89,7 @@ func TestVault_Count_Org(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			got, err := s.Count("org", "foo", "*", []string{})
+			got, err := s.Count(context.TODO(), "org", "foo", "*", []string{})
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Count returned %v, want %v", resp.Code, http.StatusOK)
@@ -181,7 +182,7 @@ func TestVault_Count_Repo(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			got, err := s.Count("repo", "foo", "bar", []string{})
+			got, err := s.Count(context.TODO(), "repo", "foo", "bar", []string{})
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Count returned %v, want %v", resp.Code, http.StatusOK)
@@ -274,7 +275,7 @@ func TestVault_Count_Shared(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			got, err := s.Count("shared", "foo", "bar", []string{})
+			got, err := s.Count(context.TODO(), "shared", "foo", "bar", []string{})
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("List returned %v, want %v", resp.Code, http.StatusOK)
@@ -325,7 +326,7 @@ func TestVault_Count_InvalidType(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			got, err := s.Count("invalid", "foo", "bar", []string{})
+			got, err := s.Count(context.TODO(), "invalid", "foo", "bar", []string{})
 			if err == nil {
 				t.Errorf("Count should have returned err")
 			}
@@ -371,7 +372,7 @@ func TestVault_Count_ClosedServer(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			got, err := s.Count("repo", "foo", "bar", []string{})
+			got, err := s.Count(context.TODO(), "repo", "foo", "bar", []string{})
 			if err == nil {
 				t.Errorf("Count should have returned err")
 			}
@@ -441,7 +442,7 @@ func TestVault_Count_EmptyList(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			got, err := s.Count("repo", "foo", "bar", []string{})
+			got, err := s.Count(context.TODO(), "repo", "foo", "bar", []string{})
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Count returned %v, want %v", resp.Code, http.StatusOK)
@@ -516,7 +517,7 @@ func TestVault_Count_InvalidList(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			got, err := s.Count("repo", "foo", "bar", []string{})
+			got, err := s.Count(context.TODO(), "repo", "foo", "bar", []string{})
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Count returned %v, want %v", resp.Code, http.StatusOK)
@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -16,7 +17,7 @@ import (
 )
 
 // Create creates a new secret.
-func (c *client) Create(sType, org, name string, s *library.Secret) error {
+func (c *client) Create(ctx context.Context, sType, org, name string, s *library.Secret) error {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -50,11 +51,11 @@ func (c *client) Create(sType, org, name string, s *library.Secret) error {
 	// create the secret for the Vault service
 	switch sType {
 	case constants.SecretOrg:
-		return c.createOrg(org, s.GetName(), vault.Data)
+		return c.createOrg(ctx, org, s.GetName(), vault.Data)
 	case constants.SecretRepo:
-		return c.createRepo(org, name, s.GetName(), vault.Data)
+		return c.createRepo(ctx, org, name, s.GetName(), vault.Data)
 	case constants.SecretShared:
-		return c.createShared(org, name, s.GetName(), vault.Data)
+		return c.createShared(ctx, org, name, s.GetName(), vault.Data)
 	default:
 		return fmt.Errorf("invalid secret type: %v", sType)
 	}
@@ -62,25 +63,25 @@ func (c *client) Create(sType, org, name string, s *library.Secret) error {
 
 // createOrg is a helper function to create
 // the org secret for the provided path.
-func (c *client) createOrg(org, path string, data map[string]interface{}) error {
-	return c.create(fmt.Sprintf("%s/org/%s/%s", c.config.Prefix, org, path), data)
+func (c *client) createOrg(ctx context.Context, org, path string, data map[string]interface{}) error {
+	return c.create(ctx, fmt.Sprintf("%s/org/%s/%s", c.config.Prefix, org, path), data)
 }
 
 // createRepo is a helper function to create
 // the repo secret for the provided path.
-func (c *client) createRepo(org, repo, path string, data map[string]interface{}) error {
-	return c.create(fmt.Sprintf("%s/repo/%s/%s/%s", c.config.Prefix, org, repo, path), data)
+func (c *client) createRepo(ctx context.Context, org, repo, path string, data map[string]interface{}) error {
+	return c.create(ctx, fmt.Sprintf("%s/repo/%s/%s/%s", c.config.Prefix, org, repo, path), data)
 }
 
 // createShared is a helper function to create
 // the shared secret for the provided path.
-func (c *client) createShared(org, team, path string, data map[string]interface{}) error {
-	return c.create(fmt.Sprintf("%s/shared/%s/%s/%s", c.config.Prefix, org, team, path), data)
+func (c *client) createShared(ctx context.Context, org, team, path string, data map[string]interface{}) error {
+	return c.create(ctx, fmt.Sprintf("%s/shared/%s/%s/%s", c.config.Prefix, org, team, path), data)
 }
 
 // create is a helper function to create
 // the secret for the provided path.
-func (c *client) create(path string, data map[string]interface{}) error {
+func (c *client) create(ctx context.Context, path string, data map[string]interface{}) error {
 	if strings.HasPrefix("secret/data", c.config.Prefix) {
 		data = map[string]interface{}{
 			"data": data,
@@ -88,7 +89,7 @@ func (c *client) create(path string, data map[string]interface{}) error {
 	}
 
 	// send API call to create the secret
-	_, err := c.Vault.Logical().Write(path, data)
+	_, err := c.Vault.Logical().WriteWithContext(ctx, path, data)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -77,7 +78,7 @@ func TestVault_Create_Org(t *testing.T) {
 			if err != nil {
 				t.Errorf("New returned err: %v", err)
 			}
-			err = s.Create("org", "foo", "*", sec)
+			err = s.Create(context.TODO(), "org", "foo", "*", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Create returned %v, want %v", resp.Code, http.StatusOK)
@@ -153,7 +154,7 @@ func TestVault_Create_Repo(t *testing.T) {
 			if err != nil {
 				t.Errorf("New returned err: %v", err)
 			}
-			err = s.Create("repo", "foo", "bar", sec)
+			err = s.Create(context.TODO(), "repo", "foo", "bar", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Create returned %v, want %v", resp.Code, http.StatusOK)
@@ -227,7 +228,7 @@ func TestVault_Create_Shared(t *testing.T) {
 			if err != nil {
 				t.Errorf("New returned err: %v", err)
 			}
-			err = s.Create("shared", "foo", "bar", sec)
+			err = s.Create(context.TODO(), "shared", "foo", "bar", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Create returned %v, want %v", resp.Code, http.StatusOK)
@@ -303,7 +304,7 @@ func TestVault_Create_InvalidSecret(t *testing.T) {
 			if err != nil {
 				t.Errorf("New returned err: %v", err)
 			}
-			err = s.Create("repo", "foo", "bar", sec)
+			err = s.Create(context.TODO(), "repo", "foo", "bar", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Create returned %v, want %v", resp.Code, http.StatusOK)
@@ -362,7 +363,7 @@ func TestVault_Create_InvalidType(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Create("invalid", "foo", "bar", sec)
+			err = s.Create(context.TODO(), "invalid", "foo", "bar", sec)
 			if err == nil {
 				t.Errorf("Create should have returned err")
 			}
@@ -416,7 +417,7 @@ func TestVault_Create_ClosedServer(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Create("repo", "foo", "bar", sec)
+			err = s.Create(context.TODO(), "repo", "foo", "bar", sec)
 			if err == nil {
 				t.Errorf("Create should have returned err")
 			}
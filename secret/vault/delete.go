@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -14,7 +15,7 @@ import (
 )
 
 // Delete deletes a secret.
-func (c *client) Delete(sType, org, name, path string) error {
+func (c *client) Delete(ctx context.Context, sType, org, name, path string) error {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -39,11 +40,11 @@ func (c *client) Delete(sType, org, name, path string) error {
 	// delete the secret from the Vault service
 	switch sType {
 	case constants.SecretOrg:
-		return c.deleteOrg(org, path)
+		return c.deleteOrg(ctx, org, path)
 	case constants.SecretRepo:
-		return c.deleteRepo(org, name, path)
+		return c.deleteRepo(ctx, org, name, path)
 	case constants.SecretShared:
-		return c.deleteShared(org, name, path)
+		return c.deleteShared(ctx, org, name, path)
 	default:
 		return fmt.Errorf("invalid secret type: %v", sType)
 	}
@@ -51,27 +52,27 @@ func (c *client) Delete(sType, org, name, path string) error {
 
 // deleteOrg is a helper function to delete
 // the org secret for the provided path.
-func (c *client) deleteOrg(org, path string) error {
-	return c.delete(fmt.Sprintf("%s/org/%s/%s", c.config.Prefix, org, path))
+func (c *client) deleteOrg(ctx context.Context, org, path string) error {
+	return c.delete(ctx, fmt.Sprintf("%s/org/%s/%s", c.config.Prefix, org, path))
 }
 
 // deleteRepo is a helper function to delete
 // the repo secret for the provided path.
-func (c *client) deleteRepo(org, repo, path string) error {
-	return c.delete(fmt.Sprintf("%s/repo/%s/%s/%s", c.config.Prefix, org, repo, path))
+func (c *client) deleteRepo(ctx context.Context, org, repo, path string) error {
+	return c.delete(ctx, fmt.Sprintf("%s/repo/%s/%s/%s", c.config.Prefix, org, repo, path))
 }
 
 // deleteShared is a helper function to delete
 // the shared secret for the provided path.
-func (c *client) deleteShared(org, team, path string) error {
-	return c.delete(fmt.Sprintf("%s/shared/%s/%s/%s", c.config.Prefix, org, team, path))
+func (c *client) deleteShared(ctx context.Context, org, team, path string) error {
+	return c.delete(ctx, fmt.Sprintf("%s/shared/%s/%s/%s", c.config.Prefix, org, team, path))
 }
 
 // delete is a helper function to delete
 // the secret for the provided path.
-func (c *client) delete(path string) error {
+func (c *client) delete(ctx context.Context, path string) error {
 	// send API call to delete the secret
-	_, err := c.Vault.Logical().Delete(path)
+	_, err := c.Vault.Logical().DeleteWithContext(ctx, path)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -16,7 +17,7 @@ import (
 )
 
 // Get captures a secret.
-func (c *client) Get(sType, org, name, path string) (s *library.Secret, err error) {
+func (c *client) Get(ctx context.Context, sType, org, name, path string) (s *library.Secret, err error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -44,11 +45,11 @@ func (c *client) Get(sType, org, name, path string) (s *library.Secret, err erro
 	// capture the secret from the Vault service
 	switch sType {
 	case constants.SecretOrg:
-		vault, err = c.getOrg(org, path)
+		vault, err = c.getOrg(ctx, org, path)
 	case constants.SecretRepo:
-		vault, err = c.getRepo(org, name, path)
+		vault, err = c.getRepo(ctx, org, name, path)
 	case constants.SecretShared:
-		vault, err = c.getShared(org, name, path)
+		vault, err = c.getShared(ctx, org, name, path)
 	default:
 		return nil, fmt.Errorf("invalid secret type: %v", sType)
 	}
@@ -62,27 +63,27 @@ func (c *client) Get(sType, org, name, path string) (s *library.Secret, err erro
 
 // getOrg is a helper function to capture
 // the org secret for the provided path.
-func (c *client) getOrg(org, path string) (*api.Secret, error) {
-	return c.get(fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretOrg, org, path))
+func (c *client) getOrg(ctx context.Context, org, path string) (*api.Secret, error) {
+	return c.get(ctx, fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretOrg, org, path))
 }
 
 // getRepo is a helper function to capture
 // the repo secret for the provided path.
-func (c *client) getRepo(org, repo, path string) (*api.Secret, error) {
-	return c.get(fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretRepo, org, repo, path))
+func (c *client) getRepo(ctx context.Context, org, repo, path string) (*api.Secret, error) {
+	return c.get(ctx, fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretRepo, org, repo, path))
 }
 
 // getShared is a helper function to capture
 // the shared secret for the provided path.
-func (c *client) getShared(org, team, path string) (*api.Secret, error) {
-	return c.get(fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretShared, org, team, path))
+func (c *client) getShared(ctx context.Context, org, team, path string) (*api.Secret, error) {
+	return c.get(ctx, fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretShared, org, team, path))
 }
 
 // get is a helper function to capture
 // the secret for the provided path.
-func (c *client) get(path string) (*api.Secret, error) {
+func (c *client) get(ctx context.Context, path string) (*api.Secret, error) {
 	// send API call to capture the secret
-	vault, err := c.Vault.Logical().Read(path)
+	vault, err := c.Vault.Logical().ReadWithContext(ctx, path)
 	if err != nil {
 		return nil, err
 	}
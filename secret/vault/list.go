@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -20,7 +21,7 @@ import (
 // We drop page and perPage as we are always returning all results.
 // Vault API doesn't seem to support pagination. Might result in undesired
 // behavior for fetching Vault secrets in paginated manner.
-func (c *client) List(sType, org, name string, _, _ int, _ []string) ([]*library.Secret, error) {
+func (c *client) List(ctx context.Context, sType, org, name string, _, _ int, _ []string) ([]*library.Secret, error) {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":  org,
@@ -49,11 +50,11 @@ func (c *client) List(sType, org, name string, _, _ int, _ []string) ([]*library
 	// capture the list of secrets from the Vault service
 	switch sType {
 	case constants.SecretOrg:
-		vault, err = c.listOrg(org)
+		vault, err = c.listOrg(ctx, org)
 	case constants.SecretRepo:
-		vault, err = c.listRepo(org, name)
+		vault, err = c.listRepo(ctx, org, name)
 	case constants.SecretShared:
-		vault, err = c.listShared(org, name)
+		vault, err = c.listShared(ctx, org, name)
 	default:
 		return nil, fmt.Errorf("invalid secret type: %v", sType)
 	}
@@ -77,7 +78,7 @@ func (c *client) List(sType, org, name string, _, _ int, _ []string) ([]*library
 		}
 
 		// capture the secret from the Vault service
-		sec, err := c.Get(sType, org, name, key)
+		sec, err := c.Get(ctx, sType, org, name, key)
 		if err != nil {
 			return nil, err
 		}
@@ -90,25 +91,25 @@ func (c *client) List(sType, org, name string, _, _ int, _ []string) ([]*library
 
 // listOrg is a helper function to capture the
 // list of org secrets for the provided path.
-func (c *client) listOrg(org string) (*api.Secret, error) {
-	return c.list(fmt.Sprintf("%s/%s/%s", c.config.Prefix, constants.SecretOrg, org))
+func (c *client) listOrg(ctx context.Context, org string) (*api.Secret, error) {
+	return c.list(ctx, fmt.Sprintf("%s/%s/%s", c.config.Prefix, constants.SecretOrg, org))
 }
 
 // listRepo is a helper function to capture the
 // list of repo secrets for the provided path.
-func (c *client) listRepo(org, repo string) (*api.Secret, error) {
-	return c.list(fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretRepo, org, repo))
+func (c *client) listRepo(ctx context.Context, org, repo string) (*api.Secret, error) {
+	return c.list(ctx, fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretRepo, org, repo))
 }
 
 // listShared is a helper function to capture the
 // list of shared secrets for the provided path.
-func (c *client) listShared(org, team string) (*api.Secret, error) {
-	return c.list(fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretShared, org, team))
+func (c *client) listShared(ctx context.Context, org, team string) (*api.Secret, error) {
+	return c.list(ctx, fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretShared, org, team))
 }
 
 // list is a helper function to capture the
 // list of secrets for the provided path.
-func (c *client) list(path string) (*api.Secret, error) {
+func (c *client) list(ctx context.Context, path string) (*api.Secret, error) {
 	// handle k/v v2
 	if strings.HasPrefix(path, "secret/data/") {
 		// remove secret/data/ prefix
@@ -118,7 +119,7 @@ func (c *client) list(path string) (*api.Secret, error) {
 	}
 
 	// send API call to capture the list of secrets
-	vault, err := c.Vault.Logical().List(path)
+	vault, err := c.Vault.Logical().ListWithContext(ctx, path)
 	if err != nil {
 		return nil, err
 	}
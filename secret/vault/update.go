@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -16,7 +17,7 @@ import (
 )
 
 // Update updates a secret.
-func (c *client) Update(sType, org, name string, s *library.Secret) error {
+func (c *client) Update(ctx context.Context, sType, org, name string, s *library.Secret) error {
 	// create log fields from secret metadata
 	fields := logrus.Fields{
 		"org":    org,
@@ -39,7 +40,7 @@ func (c *client) Update(sType, org, name string, s *library.Secret) error {
 	c.Logger.WithFields(fields).Tracef("updating vault %s secret %s for %s/%s", sType, s.GetName(), org, name)
 
 	// capture the secret from the Vault service
-	sec, err := c.Get(sType, org, name, s.GetName())
+	sec, err := c.Get(ctx, sType, org, name, s.GetName())
 	if err != nil {
 		return err
 	}
@@ -71,44 +72,44 @@ func (c *client) Update(sType, org, name string, s *library.Secret) error {
 	// update the secret for the Vault service
 	switch sType {
 	case constants.SecretOrg:
-		return c.updateOrg(org, s.GetName(), vault.Data)
+		return c.updateOrg(ctx, org, s.GetName(), vault.Data)
 	case constants.SecretRepo:
-		return c.updateRepo(org, name, s.GetName(), vault.Data)
+		return c.updateRepo(ctx, org, name, s.GetName(), vault.Data)
 	case constants.SecretShared:
 		fallthrough
 	default:
-		return c.updateShared(org, name, s.GetName(), vault.Data)
+		return c.updateShared(ctx, org, name, s.GetName(), vault.Data)
 	}
 }
 
 // updateOrg is a helper function to update
 // the org secret for the provided path.
-func (c *client) updateOrg(org, path string, data map[string]interface{}) error {
-	return c.update(fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretOrg, org, path), data)
+func (c *client) updateOrg(ctx context.Context, org, path string, data map[string]interface{}) error {
+	return c.update(ctx, fmt.Sprintf("%s/%s/%s/%s", c.config.Prefix, constants.SecretOrg, org, path), data)
 }
 
 // updateRepo is a helper function to update
 // the repo secret for the provided path.
-func (c *client) updateRepo(org, repo, path string, data map[string]interface{}) error {
-	return c.update(fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretRepo, org, repo, path), data)
+func (c *client) updateRepo(ctx context.Context, org, repo, path string, data map[string]interface{}) error {
+	return c.update(ctx, fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretRepo, org, repo, path), data)
 }
 
 // updateShared is a helper function to update
 // the shared secret for the provided path.
-func (c *client) updateShared(org, team, path string, data map[string]interface{}) error {
-	return c.update(fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretShared, org, team, path), data)
+func (c *client) updateShared(ctx context.Context, org, team, path string, data map[string]interface{}) error {
+	return c.update(ctx, fmt.Sprintf("%s/%s/%s/%s/%s", c.config.Prefix, constants.SecretShared, org, team, path), data)
 }
 
 // update is a helper function to update
 // the secret for the provided path.
-func (c *client) update(path string, data map[string]interface{}) error {
+func (c *client) update(ctx context.Context, path string, data map[string]interface{}) error {
 	if strings.HasPrefix("secret/data", c.config.Prefix) {
 		data = map[string]interface{}{
 			"data": data,
 		}
 	}
 
-	_, err := c.Vault.Logical().Write(path, data)
+	_, err := c.Vault.Logical().WriteWithContext(ctx, path, data)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@
 package vault
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -93,7 +94,7 @@ func TestVault_Update_Org(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Update("org", "foo", "*", sec)
+			err = s.Update(context.TODO(), "org", "foo", "*", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Update returned %v, want %v", resp.Code, http.StatusOK)
@@ -184,7 +185,7 @@ func TestVault_Update_Repo(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Update("repo", "foo", "bar", sec)
+			err = s.Update(context.TODO(), "repo", "foo", "bar", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Update returned %v, want %v", resp.Code, http.StatusOK)
@@ -275,7 +276,7 @@ func TestVault_Update_Shared(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Update("shared", "foo", "bar", sec)
+			err = s.Update(context.TODO(), "shared", "foo", "bar", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Update returned %v, want %v", resp.Code, http.StatusOK)
@@ -366,7 +367,7 @@ func TestVault_Update_InvalidSecret(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Update("repo", "foo", "bar", sec)
+			err = s.Update(context.TODO(), "repo", "foo", "bar", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Update returned %v, want %v", resp.Code, http.StatusOK)
@@ -423,7 +424,7 @@ func TestVault_Update_InvalidType(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Update("invalid", "foo", "bar", sec)
+			err = s.Update(context.TODO(), "invalid", "foo", "bar", sec)
 			if err == nil {
 				t.Errorf("Update should have returned err")
 			}
@@ -475,7 +476,7 @@ func TestVault_Update_ClosedServer(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Update("repo", "foo", "bar", sec)
+			err = s.Update(context.TODO(), "repo", "foo", "bar", sec)
 			if err == nil {
 				t.Errorf("Update should have returned err")
 			}
@@ -560,7 +561,7 @@ func TestVault_Update_NoWrite(t *testing.T) {
 				t.Errorf("New returned err: %v", err)
 			}
 
-			err = s.Update("repo", "foo", "bar", sec)
+			err = s.Update(context.TODO(), "repo", "foo", "bar", sec)
 
 			if resp.Code != http.StatusOK {
 				t.Errorf("Update returned %v, want %v", resp.Code, http.StatusOK)
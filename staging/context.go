@@ -0,0 +1,62 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package staging
+
+import (
+	"context"
+)
+
+// key defines the key type for storing
+// the tracker in the context.
+const key = "staging"
+
+// replayingKey defines the key type for marking
+// a request as replayed from the staging table.
+type replayingKey struct{}
+
+// Setter defines a context that enables setting values.
+type Setter interface {
+	Set(string, interface{})
+}
+
+// FromContext returns the tracker
+// associated with this context.
+func FromContext(c context.Context) *Tracker {
+	// get tracker value from context
+	v := c.Value(key)
+	if v == nil {
+		return nil
+	}
+
+	// cast tracker value to expected Tracker type
+	t, ok := v.(*Tracker)
+	if !ok {
+		return nil
+	}
+
+	return t
+}
+
+// ToContext adds the tracker to this
+// context if it supports the Setter interface.
+func ToContext(c Setter, t *Tracker) {
+	c.Set(key, t)
+}
+
+// WithReplaying returns a copy of the context marking the
+// request as a webhook replayed from the staging table. The
+// mark is only set in process, so it can't be set by the
+// client delivering the webhook.
+func WithReplaying(c context.Context) context.Context {
+	return context.WithValue(c, replayingKey{}, true)
+}
+
+// Replaying returns true when the request is a
+// webhook replayed from the staging table.
+func Replaying(c context.Context) bool {
+	replaying, ok := c.Value(replayingKey{}).(bool)
+
+	return ok && replaying
+}
@@ -0,0 +1,12 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+// Package staging provides the ability for Vela to persist the
+// webhooks still being processed when the server shuts down
+// and resume processing them when the server starts up.
+//
+// Usage:
+//
+//	import "github.com/go-vela/server/staging"
+package staging
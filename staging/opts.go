@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package staging
+
+import (
+	"fmt"
+
+	"github.com/go-vela/server/database"
+)
+
+// Opt represents a configuration option to initialize the tracker.
+type Opt func(*Tracker) error
+
+// WithDatabase sets the database service in the tracker.
+func WithDatabase(db database.Service) Opt {
+	return func(t *Tracker) error {
+		// check if the database service provided is empty
+		if db == nil {
+			return fmt.Errorf("no staging database provided")
+		}
+
+		// set the database service in the tracker
+		t.database = db
+
+		return nil
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package staging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database"
+	"github.com/go-vela/server/intake"
+	"github.com/go-vela/server/scm/verify"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// headerDelivery defines the header the scm
+	// sets with the unique ID for each webhook.
+	headerDelivery = "X-GitHub-Delivery"
+
+	// path defines the path webhooks are received on.
+	path = "/webhook"
+)
+
+// Tracker represents the functionality for tracking
+// the webhooks being processed by the server so they
+// can be staged when the server shuts down.
+type Tracker struct {
+	// database service used to stage the webhooks
+	database database.Service
+
+	mu       sync.Mutex
+	next     uint64
+	inflight map[uint64]*api.StagedWebhook
+}
+
+// New creates and returns a tracker for in-flight webhooks.
+func New(opts ...Opt) (*Tracker, error) {
+	// create new tracker
+	t := new(Tracker)
+
+	// create new fields
+	t.inflight = make(map[uint64]*api.StagedWebhook)
+
+	// apply all provided configuration options
+	for _, opt := range opts {
+		err := opt(t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// Track captures the webhook from the request, with the
+// provided payload, as in-flight and returns the key to
+// mark the webhook as processed with when it completes.
+//
+// Webhooks replayed from the staging table are not tracked
+// since they remain staged until the replay completes, and
+// neither are webhooks processed from the intake since they
+// remain in the intake until the processing completes.
+func (t *Tracker) Track(r *http.Request, payload []byte) uint64 {
+	// check if the webhook was replayed from the staging table
+	if Replaying(r.Context()) {
+		return 0
+	}
+
+	// check if the webhook was processed from the intake
+	if intake.Processing(r.Context()) {
+		return 0
+	}
+
+	header, err := json.Marshal(r.Header)
+	if err != nil {
+		logrus.Errorf("unable to track webhook %s: %v", r.Header.Get(headerDelivery), err)
+
+		return 0
+	}
+
+	w := new(api.StagedWebhook)
+	w.SetSource(r.Header.Get(headerDelivery))
+	w.SetHeader(string(header))
+	w.SetPayload(string(payload))
+	w.SetCreated(time.Now().UTC().Unix())
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.next++
+	t.inflight[t.next] = w
+
+	return t.next
+}
+
+// Done marks the webhook for the provided key as processed.
+func (t *Tracker) Done(key uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.inflight, key)
+}
+
+// InFlight returns the number of webhooks being processed.
+func (t *Tracker) InFlight() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.inflight)
+}
+
+// Persist stages the webhooks still being processed in the
+// database so they can be resumed when the server starts up.
+func (t *Tracker) Persist() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// check if the database service is configured
+	if t.database == nil || len(t.inflight) == 0 {
+		return nil
+	}
+
+	logrus.Infof("staging %d in-flight webhooks", len(t.inflight))
+
+	for key, w := range t.inflight {
+		// send API call to stage the webhook
+		err := t.database.CreateStagedWebhook(context.Background(), w)
+		if err != nil {
+			return fmt.Errorf("unable to stage webhook %s: %w", w.GetSource(), err)
+		}
+
+		delete(t.inflight, key)
+	}
+
+	return nil
+}
+
+// Resume replays the webhooks staged when the server last
+// shut down through the provided handler. Webhooks that
+// fail with a server error remain staged for the next startup.
+func (t *Tracker) Resume(h http.Handler) error {
+	// check if the database service is configured
+	if t.database == nil {
+		return nil
+	}
+
+	// send API call to capture the staged webhooks
+	webhooks, err := t.database.ListStagedWebhooks(context.Background())
+	if err != nil {
+		return fmt.Errorf("unable to list staged webhooks: %w", err)
+	}
+
+	for _, w := range webhooks {
+		logrus.Infof("resuming staged webhook %s", w.GetSource())
+
+		// release the delivery recorded for the webhook
+		// so the replay is not rejected as a replay
+		t.release(w.GetSource())
+
+		status, err := replay(h, w)
+		if err != nil {
+			logrus.Errorf("unable to replay staged webhook %s: %v", w.GetSource(), err)
+
+			continue
+		}
+
+		// check if the webhook failed with a server error
+		if status >= http.StatusInternalServerError {
+			logrus.Errorf("unable to resume staged webhook %s: received status %d", w.GetSource(), status)
+
+			continue
+		}
+
+		// send API call to remove the staged webhook
+		err = t.database.DeleteStagedWebhook(context.Background(), w)
+		if err != nil {
+			logrus.Errorf("unable to delete staged webhook %s: %v", w.GetSource(), err)
+		}
+	}
+
+	return nil
+}
+
+// release is a helper function to remove the delivery
+// recorded for the staged webhook with the provided ID.
+func (t *Tracker) release(id string) {
+	if len(id) == 0 {
+		return
+	}
+
+	// send API call to capture the recorded delivery
+	d, err := t.database.GetWebhookDelivery(context.Background(), id)
+	if err != nil {
+		return
+	}
+
+	// send API call to remove the recorded delivery
+	err = t.database.DeleteWebhookDelivery(context.Background(), d)
+	if err != nil {
+		logrus.Errorf("unable to release delivery for staged webhook %s: %v", id, err)
+	}
+}
+
+// replay is a helper function to send the staged webhook
+// through the handler and return the resulting status code.
+func replay(h http.Handler, w *api.StagedWebhook) (int, error) {
+	header := http.Header{}
+
+	err := json.Unmarshal([]byte(w.GetHeader()), &header)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse header: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, path, bytes.NewBufferString(w.GetPayload()))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header = header
+
+	// check the staleness of the webhook against when it was
+	// received since it is replayed after the server restarts
+	//
+	// https://pkg.go.dev/github.com/go-vela/server/scm/verify?tab=doc#WithReceived
+	ctx := verify.WithReceived(req.Context(), time.Unix(w.GetCreated(), 0))
+
+	// mark the webhook as replayed from the staging
+	// table so it is not tracked again while replayed
+	req = req.WithContext(WithReplaying(ctx))
+
+	resp := &response{header: http.Header{}, status: http.StatusOK}
+
+	h.ServeHTTP(resp, req)
+
+	return resp.status, nil
+}
+
+// response represents the http.ResponseWriter
+// used to capture the status of a replayed webhook.
+type response struct {
+	header http.Header
+	status int
+}
+
+// Header returns the header map for the response.
+func (r *response) Header() http.Header {
+	return r.header
+}
+
+// Write discards the body for the response.
+func (r *response) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+// WriteHeader captures the status code for the response.
+func (r *response) WriteHeader(status int) {
+	r.status = status
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package staging
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	api "github.com/go-vela/server/api/types"
+	"github.com/go-vela/server/database/sqlite"
+	"github.com/go-vela/server/intake"
+	"github.com/go-vela/server/scm/verify"
+)
+
+func TestStaging_New(t *testing.T) {
+	// setup tests
+	tests := []struct {
+		name    string
+		failure bool
+		opts    []Opt
+	}{
+		{
+			name:    "defaults",
+			failure: false,
+			opts:    []Opt{},
+		},
+		{
+			name:    "empty database",
+			failure: true,
+			opts:    []Opt{WithDatabase(nil)},
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(test.opts...)
+
+			if test.failure {
+				if err == nil {
+					t.Errorf("New should have returned err")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Errorf("New returned err: %v", err)
+			}
+		})
+	}
+}
+
+func TestStaging_Track(t *testing.T) {
+	// setup types
+	tracker, _ := New()
+
+	push, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+	push.Header.Set("X-GitHub-Delivery", "c8da1302-07d6-11ea-882f-4893bca275b8")
+
+	replayed, _ := http.NewRequestWithContext(WithReplaying(context.Background()), http.MethodPost, "/webhook", nil)
+
+	buffered, _ := http.NewRequestWithContext(intake.WithProcessing(context.Background()), http.MethodPost, "/webhook", nil)
+
+	spoofed, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+	spoofed.Header.Set("X-Vela-Intake-Webhook", "1")
+	spoofed.Header.Set("X-Vela-Staged-Webhook", "1")
+
+	// run test
+	key := tracker.Track(push, []byte(`{"ref":"refs/heads/main"}`))
+
+	if tracker.InFlight() != 1 {
+		t.Errorf("InFlight is %d, want 1", tracker.InFlight())
+	}
+
+	if tracker.Track(replayed, []byte(`{}`)) != 0 {
+		t.Errorf("Track should not have tracked replayed webhook")
+	}
+
+	if tracker.Track(buffered, []byte(`{}`)) != 0 {
+		t.Errorf("Track should not have tracked webhook from the intake")
+	}
+
+	spoofedKey := tracker.Track(spoofed, []byte(`{}`))
+	if spoofedKey == 0 {
+		t.Errorf("Track should have tracked webhook with spoofed headers")
+	}
+
+	tracker.Done(spoofedKey)
+	tracker.Done(key)
+
+	if tracker.InFlight() != 0 {
+		t.Errorf("InFlight is %d, want 0", tracker.InFlight())
+	}
+}
+
+func TestStaging_PersistAndResume(t *testing.T) {
+	// setup types
+	db, _ := sqlite.NewTest()
+
+	defer func() {
+		db.Sqlite.Exec("delete from staged_webhooks;")
+		db.Sqlite.Exec("delete from webhook_deliveries;")
+		_sql, _ := db.Sqlite.DB()
+		_sql.Close()
+	}()
+
+	tracker, err := New(WithDatabase(db))
+	if err != nil {
+		t.Errorf("New returned err: %v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "/webhook", nil)
+	req.Header.Set("X-GitHub-Delivery", "c8da1302-07d6-11ea-882f-4893bca275b8")
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("Date", "Mon, 02 Jan 2006 15:04:05 GMT")
+
+	tracker.Track(req, []byte(`{"ref":"refs/heads/main"}`))
+
+	// run test
+	err = tracker.Persist()
+	if err != nil {
+		t.Errorf("Persist returned err: %v", err)
+	}
+
+	if tracker.InFlight() != 0 {
+		t.Errorf("InFlight is %d, want 0", tracker.InFlight())
+	}
+
+	// receive the webhook earlier than it is replayed
+	db.Sqlite.Exec("update staged_webhooks set created = 1563474076;")
+
+	// record the delivery as received before the server shut down
+	d := new(api.WebhookDelivery)
+	d.SetSource("github")
+	d.SetDeliveryID("c8da1302-07d6-11ea-882f-4893bca275b8")
+	d.SetCreated(1563474076)
+
+	err = db.CreateWebhookDelivery(context.TODO(), d)
+	if err != nil {
+		t.Errorf("unable to create webhook delivery: %v", err)
+	}
+
+	status := http.StatusInternalServerError
+	payloads := []string{}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !Replaying(r.Context()) || r.Header.Get("X-GitHub-Event") != "push" {
+			t.Errorf("replayed webhook is missing headers: %v", r.Header)
+		}
+
+		if r.Header.Get("Date") != "Mon, 02 Jan 2006 15:04:05 GMT" {
+			t.Errorf("replayed webhook is missing Date header: %v", r.Header)
+		}
+
+		if verify.Received(r.Context()).Unix() != 1563474076 {
+			t.Errorf("replayed webhook received is %v, want 1563474076", verify.Received(r.Context()).Unix())
+		}
+
+		if _, err := db.GetWebhookDelivery(context.TODO(), "c8da1302-07d6-11ea-882f-4893bca275b8"); err == nil {
+			t.Errorf("replayed webhook delivery was not released")
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		payloads = append(payloads, string(body))
+
+		w.WriteHeader(status)
+	})
+
+	// resume with a server error keeps the webhook staged
+	err = tracker.Resume(handler)
+	if err != nil {
+		t.Errorf("Resume returned err: %v", err)
+	}
+
+	staged, _ := db.ListStagedWebhooks(context.TODO())
+	if len(staged) != 1 {
+		t.Errorf("ListStagedWebhooks is %d, want 1", len(staged))
+	}
+
+	// resume with success removes the staged webhook
+	status = http.StatusOK
+
+	err = tracker.Resume(handler)
+	if err != nil {
+		t.Errorf("Resume returned err: %v", err)
+	}
+
+	staged, _ = db.ListStagedWebhooks(context.TODO())
+	if len(staged) != 0 {
+		t.Errorf("ListStagedWebhooks is %d, want 0", len(staged))
+	}
+
+	if strings.Join(payloads, ",") != `{"ref":"refs/heads/main"},{"ref":"refs/heads/main"}` {
+		t.Errorf("replayed payloads are %v", payloads)
+	}
+}
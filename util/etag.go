@@ -0,0 +1,54 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag creates an entity tag from the fields of a resource that
+// can be managed through the API. Fields that change without an
+// update from the API, like timestamps and counters, should be
+// left out so the entity tag only changes with the managed fields.
+func ETag(fields map[string]interface{}) string {
+	// json.Marshal sorts the keys of the map, so the
+	// same fields always produce the same entity tag
+	data, _ := json.Marshal(fields)
+
+	return fmt.Sprintf(`"%x"`, sha256.Sum256(data))
+}
+
+// SetETag sets the entity tag for the resource in the response.
+func SetETag(c *gin.Context, etag string) {
+	c.Header("ETag", etag)
+}
+
+// MatchETag checks the entity tag for the resource against the
+// If-Match header from the request. When the request doesn't
+// provide the header, it always matches.
+func MatchETag(c *gin.Context, etag string) bool {
+	header := c.GetHeader("If-Match")
+
+	if len(header) == 0 {
+		return true
+	}
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		// weak entity tags never match since the
+		// If-Match header requires a strong comparison
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
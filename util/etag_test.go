@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Target Brands, Inc. All rights reserved.
+//
+// Use of this source code is governed by the LICENSE file in this repository.
+
+package util
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestUtil_ETag(t *testing.T) {
+	// setup types
+	fields := map[string]interface{}{
+		"name":   "compliance",
+		"active": true,
+		"orgs":   []string{"github"},
+	}
+
+	// run test
+	got := ETag(fields)
+
+	if got != ETag(fields) {
+		t.Errorf("ETag is %s, want the same entity tag for the same fields", got)
+	}
+
+	fields["active"] = false
+
+	if got == ETag(fields) {
+		t.Errorf("ETag is %s, want a new entity tag for changed fields", got)
+	}
+}
+
+func TestUtil_MatchETag(t *testing.T) {
+	// setup types
+	etag := ETag(map[string]interface{}{"name": "compliance"})
+
+	// setup tests
+	tests := []struct {
+		name   string
+		header string
+		want   bool
+	}{
+		{
+			name:   "no header",
+			header: "",
+			want:   true,
+		},
+		{
+			name:   "matching",
+			header: etag,
+			want:   true,
+		},
+		{
+			name:   "matching in list",
+			header: `"foo", ` + etag,
+			want:   true,
+		},
+		{
+			name:   "wildcard",
+			header: "*",
+			want:   true,
+		},
+		{
+			name:   "weak",
+			header: "W/" + etag,
+			want:   false,
+		},
+		{
+			name:   "not matching",
+			header: `"foo"`,
+			want:   false,
+		},
+	}
+
+	// run tests
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+
+			context, _ := gin.CreateTestContext(nil)
+			context.Request, _ = http.NewRequest(http.MethodPut, "/api/v1/workergroups/compliance", nil)
+
+			if len(test.header) > 0 {
+				context.Request.Header.Set("If-Match", test.header)
+			}
+
+			got := MatchETag(context, etag)
+
+			if got != test.want {
+				t.Errorf("MatchETag is %v, want %v", got, test.want)
+			}
+		})
+	}
+}
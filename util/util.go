@@ -10,6 +10,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-vela/types"
+	"github.com/go-vela/types/library"
 )
 
 // HandleError appends the error to the handler chain for logging and outputs it.
@@ -70,3 +71,30 @@ func EscapeValue(value string) string {
 	// HTML escape the new line escaped value
 	return html.EscapeString(escaped)
 }
+
+// CheckAllowlist is a helper function to ensure only repos in the
+// allowlist are allowed to enable repos.
+//
+// a single entry of '*' allows any repo to be enabled.
+func CheckAllowlist(r *library.Repo, allowlist []string) bool {
+	// check if all repos are allowed to be enabled
+	if len(allowlist) == 1 && allowlist[0] == "*" {
+		return true
+	}
+
+	for _, repo := range allowlist {
+		// allow all repos in org
+		if strings.Contains(repo, "/*") {
+			if strings.HasPrefix(repo, r.GetOrg()) {
+				return true
+			}
+		}
+
+		// allow specific repo within org
+		if repo == r.GetFullName() {
+			return true
+		}
+	}
+
+	return false
+}